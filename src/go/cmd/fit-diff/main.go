@@ -0,0 +1,507 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/muktihari/fit/decoder"
+	"github.com/muktihari/fit/profile/basetype"
+	"github.com/muktihari/fit/profile/mesgdef"
+	"github.com/muktihari/fit/profile/typedef"
+	"github.com/muktihari/fit/proto"
+)
+
+// Exit codes follow diff(1): 0 when the files match, 1 when they differ and
+// 2 on errors, so the tool can gate scripts comparing generator output.
+const (
+	exitSame   = 0
+	exitDiffer = 1
+	exitError  = 2
+)
+
+// metric is a record value compared sample by sample. epsilon is the FIT
+// encoding resolution, so values that only differ by rounding still match.
+type metric struct {
+	name    string
+	unit    string
+	epsilon float64
+	get     func(r *mesgdef.Record) float64 // NaN when the record lacks the value
+}
+
+var metrics = []metric{
+	{"heart_rate", "bpm", 0.5, func(r *mesgdef.Record) float64 { return validUint(uint64(r.HeartRate), uint64(basetype.Uint8Invalid)) }},
+	{"power", "W", 0.5, func(r *mesgdef.Record) float64 { return validUint(uint64(r.Power), uint64(basetype.Uint16Invalid)) }},
+	{"cadence", "rpm", 0.5, func(r *mesgdef.Record) float64 { return validUint(uint64(r.Cadence), uint64(basetype.Uint8Invalid)) }},
+	{"speed", "m/s", 0.001, func(r *mesgdef.Record) float64 { return firstValid(r.EnhancedSpeedScaled(), r.SpeedScaled()) }},
+	{"distance", "m", 0.01, func(r *mesgdef.Record) float64 { return r.DistanceScaled() }},
+	{"altitude", "m", 0.2, func(r *mesgdef.Record) float64 { return firstValid(r.EnhancedAltitudeScaled(), r.AltitudeScaled()) }},
+}
+
+// positionEpsilon is the distance, in metres, below which positions match.
+const positionEpsilon = 1.0
+
+func validUint(v, invalid uint64) float64 {
+	if v == invalid {
+		return math.NaN()
+	}
+	return float64(v)
+}
+
+func firstValid(values ...float64) float64 {
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			return v
+		}
+	}
+	return math.NaN()
+}
+
+type sessionInfo struct {
+	startTime time.Time
+	duration  float64
+	distance  float64
+	sport     string
+	subSport  string
+}
+
+type lapInfo struct {
+	startTime time.Time
+	duration  float64
+	distance  float64
+}
+
+type sample struct {
+	timestamp time.Time
+	values    []float64 // indexed like metrics
+	lat, long float64   // NaN without a position
+}
+
+// fileSummary is everything compared between the two files.
+type fileSummary struct {
+	path     string
+	sessions []sessionInfo
+	laps     []lapInfo
+	samples  []sample
+	// fields counts record messages carrying each field, by name
+	fields map[string]int
+}
+
+func main() {
+	input1 := flag.String("input1", "", "Path to the reference FIT file (e.g. the original source file)")
+	input2 := flag.String("input2", "", "Path to the FIT file to compare (e.g. generator output)")
+	shift := flag.Duration("shift", 0, "Shift input2 timestamps by this amount before aligning samples (e.g. -1h)")
+	maxSamples := flag.Int("samples", 10, "Number of differing samples to list")
+	flag.Parse()
+
+	if *input1 == "" || *input2 == "" {
+		fmt.Println("Usage: fit-diff -input1 <a.fit> -input2 <b.fit> [-shift <duration>] [-samples <n>]")
+		os.Exit(exitError)
+	}
+
+	a, err := summarize(*input1, 0)
+	if err != nil {
+		fmt.Printf("Failed to read %s: %v\n", *input1, err)
+		os.Exit(exitError)
+	}
+	b, err := summarize(*input2, *shift)
+	if err != nil {
+		fmt.Printf("Failed to read %s: %v\n", *input2, err)
+		os.Exit(exitError)
+	}
+
+	fmt.Printf("A: %s\nB: %s\n", a.path, b.path)
+	if *shift != 0 {
+		fmt.Printf("(B timestamps shifted by %s)\n", *shift)
+	}
+
+	differences := diffSessions(a, b) + diffLaps(a, b) + diffFieldCoverage(a, b) + diffSamples(a, b, *maxSamples)
+
+	if differences == 0 {
+		fmt.Println("\nFiles match.")
+		os.Exit(exitSame)
+	}
+	fmt.Printf("\n%d difference(s) found.\n", differences)
+	os.Exit(exitDiffer)
+}
+
+func summarize(path string, shift time.Duration) (*fileSummary, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+	fit, err := decoder.New(bytes.NewReader(data)).Decode()
+	if err != nil {
+		return nil, fmt.Errorf("decode: %w", err)
+	}
+
+	s := &fileSummary{path: path, fields: map[string]int{}}
+	for i := range fit.Messages {
+		msg := &fit.Messages[i]
+		switch msg.Num {
+		case typedef.MesgNumSession:
+			m := mesgdef.NewSession(msg)
+			s.sessions = append(s.sessions, sessionInfo{
+				startTime: m.StartTime.UTC().Add(shift),
+				duration:  m.TotalElapsedTimeScaled(),
+				distance:  m.TotalDistanceScaled(),
+				sport:     m.Sport.String(),
+				subSport:  m.SubSport.String(),
+			})
+		case typedef.MesgNumLap:
+			m := mesgdef.NewLap(msg)
+			s.laps = append(s.laps, lapInfo{
+				startTime: m.StartTime.UTC().Add(shift),
+				duration:  m.TotalElapsedTimeScaled(),
+				distance:  m.TotalDistanceScaled(),
+			})
+		case typedef.MesgNumRecord:
+			s.addRecord(msg, shift)
+		}
+	}
+	sort.SliceStable(s.samples, func(i, j int) bool { return s.samples[i].timestamp.Before(s.samples[j].timestamp) })
+	return s, nil
+}
+
+func (s *fileSummary) addRecord(msg *proto.Message, shift time.Duration) {
+	for _, field := range msg.Fields {
+		name := field.Name
+		if name == "unknown" {
+			name = fmt.Sprintf("unknown_%d", field.Num)
+		}
+		s.fields[name]++
+	}
+
+	r := mesgdef.NewRecord(msg)
+	smp := sample{
+		timestamp: r.Timestamp.UTC().Add(shift),
+		values:    make([]float64, len(metrics)),
+		lat:       r.PositionLatDegrees(),
+		long:      r.PositionLongDegrees(),
+	}
+	for i, m := range metrics {
+		smp.values[i] = m.get(r)
+	}
+	s.samples = append(s.samples, smp)
+}
+
+// diffSessions prints session counts and any per-session differences.
+func diffSessions(a, b *fileSummary) int {
+	fmt.Printf("\n=== SESSIONS: A=%d B=%d ===\n", len(a.sessions), len(b.sessions))
+	differences := 0
+	if len(a.sessions) != len(b.sessions) {
+		differences++
+	}
+
+	rowDiffs := 0
+	var rows bytes.Buffer
+	w := tabwriter.NewWriter(&rows, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "#\tField\tA\tB\tΔ")
+	fmt.Fprintln(w, "-\t-----\t-\t-\t-")
+	for i := 0; i < min(len(a.sessions), len(b.sessions)); i++ {
+		sa, sb := a.sessions[i], b.sessions[i]
+		rowDiffs += diffRow(w, i+1, "start_time", sa.startTime, sb.startTime)
+		rowDiffs += diffNum(w, i+1, "duration_s", sa.duration, sb.duration, 0.001)
+		rowDiffs += diffNum(w, i+1, "distance_m", sa.distance, sb.distance, 0.01)
+		rowDiffs += diffRow(w, i+1, "sport", sa.sport, sb.sport)
+		rowDiffs += diffRow(w, i+1, "sub_sport", sa.subSport, sb.subSport)
+	}
+	w.Flush()
+	if rowDiffs > 0 {
+		rows.WriteTo(os.Stdout)
+	} else if differences == 0 {
+		fmt.Println("(sessions match)")
+	}
+	return differences + rowDiffs
+}
+
+// diffLaps prints lap counts and any per-lap differences.
+func diffLaps(a, b *fileSummary) int {
+	fmt.Printf("\n=== LAPS: A=%d B=%d ===\n", len(a.laps), len(b.laps))
+	differences := 0
+	if len(a.laps) != len(b.laps) {
+		differences++
+	}
+
+	rowDiffs := 0
+	var rows bytes.Buffer
+	w := tabwriter.NewWriter(&rows, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "#\tField\tA\tB\tΔ")
+	fmt.Fprintln(w, "-\t-----\t-\t-\t-")
+	for i := 0; i < min(len(a.laps), len(b.laps)); i++ {
+		la, lb := a.laps[i], b.laps[i]
+		rowDiffs += diffRow(w, i+1, "start_time", la.startTime, lb.startTime)
+		rowDiffs += diffNum(w, i+1, "duration_s", la.duration, lb.duration, 0.001)
+		rowDiffs += diffNum(w, i+1, "distance_m", la.distance, lb.distance, 0.01)
+	}
+	w.Flush()
+	if rowDiffs > 0 {
+		rows.WriteTo(os.Stdout)
+	} else if differences == 0 {
+		fmt.Println("(laps match)")
+	}
+	return differences + rowDiffs
+}
+
+func diffRow[T comparable](w *tabwriter.Writer, index int, field string, a, b T) int {
+	if a == b {
+		return 0
+	}
+	delta := ""
+	if ta, ok := any(a).(time.Time); ok {
+		delta = any(b).(time.Time).Sub(ta).String()
+	}
+	fmt.Fprintf(w, "%d\t%s\t%v\t%v\t%s\n", index, field, a, b, delta)
+	return 1
+}
+
+func diffNum(w *tabwriter.Writer, index int, field string, a, b, epsilon float64) int {
+	if math.IsNaN(a) && math.IsNaN(b) || math.Abs(a-b) < epsilon {
+		return 0
+	}
+	fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%+.2f\n", index, field, formatNum(a), formatNum(b), b-a)
+	return 1
+}
+
+func formatNum(v float64) string {
+	if math.IsNaN(v) {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f", v)
+}
+
+// diffFieldCoverage compares which record fields each file carries and how
+// often, listing only fields whose coverage differs. Coverage is compared as a
+// share of records so a dropped record doesn't flag every field.
+func diffFieldCoverage(a, b *fileSummary) int {
+	fmt.Printf("\n=== RECORDS: A=%d B=%d ===\n", len(a.samples), len(b.samples))
+	differences := 0
+	if len(a.samples) != len(b.samples) {
+		differences++
+	}
+	if len(a.samples) > 0 && len(b.samples) > 0 {
+		fmt.Printf("A: %s → %s\n", a.samples[0].timestamp.Format(time.RFC3339), a.samples[len(a.samples)-1].timestamp.Format(time.RFC3339))
+		fmt.Printf("B: %s → %s\n", b.samples[0].timestamp.Format(time.RFC3339), b.samples[len(b.samples)-1].timestamp.Format(time.RFC3339))
+	}
+
+	names := map[string]bool{}
+	for name := range a.fields {
+		names[name] = true
+	}
+	for name := range b.fields {
+		names[name] = true
+	}
+	share := func(count, total int) float64 {
+		if total == 0 {
+			return 0
+		}
+		return float64(count) / float64(total) * 100
+	}
+	var sorted []string
+	for name := range names {
+		ca, cb := a.fields[name], b.fields[name]
+		if (ca == 0) != (cb == 0) || math.Abs(share(ca, len(a.samples))-share(cb, len(b.samples))) >= 0.1 {
+			sorted = append(sorted, name)
+		}
+	}
+	sort.Strings(sorted)
+
+	fmt.Println("\nField Coverage:")
+	if len(sorted) == 0 {
+		fmt.Println("(field coverage matches)")
+		return differences
+	}
+	coverage := func(count, total int) string {
+		if total == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("%d (%.1f%%)", count, share(count, total))
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "Field\tA\tB")
+	fmt.Fprintln(w, "-----\t-\t-")
+	for _, name := range sorted {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", name, coverage(a.fields[name], len(a.samples)), coverage(b.fields[name], len(b.samples)))
+	}
+	w.Flush()
+	return differences + len(sorted)
+}
+
+// deltaStats accumulates B−A differences for one metric over aligned samples.
+type deltaStats struct {
+	compared int
+	sum      float64 // signed, so the mean shows drift in one direction
+	sumAbs   float64
+	maxAbs   float64
+	maxAt    time.Time
+	// onlyA/onlyB count aligned samples where just one file has the value
+	onlyA, onlyB int
+}
+
+func (d *deltaStats) add(t time.Time, delta float64) {
+	d.compared++
+	d.sum += delta
+	d.sumAbs += math.Abs(delta)
+	if math.Abs(delta) > d.maxAbs {
+		d.maxAbs, d.maxAt = math.Abs(delta), t
+	}
+}
+
+// diffSamples aligns records by timestamp and reports per-metric deltas, the
+// final distance mismatch, and the first differing samples.
+func diffSamples(a, b *fileSummary, maxSamples int) int {
+	fmt.Println("\n=== SAMPLE DELTAS (B − A) ===")
+
+	bByTime := make(map[int64]*sample, len(b.samples))
+	for i := range b.samples {
+		bByTime[b.samples[i].timestamp.Unix()] = &b.samples[i]
+	}
+
+	stats := make([]deltaStats, len(metrics))
+	var position deltaStats
+	aligned, onlyA := 0, 0
+	var listed []string
+	differences := 0
+
+	for i := range a.samples {
+		sa := &a.samples[i]
+		sb, ok := bByTime[sa.timestamp.Unix()]
+		if !ok {
+			onlyA++
+			continue
+		}
+		aligned++
+
+		var diffs []string
+		for m, met := range metrics {
+			va, vb := sa.values[m], sb.values[m]
+			switch {
+			case math.IsNaN(va) && math.IsNaN(vb):
+				continue
+			case math.IsNaN(vb):
+				stats[m].onlyA++
+				diffs = append(diffs, fmt.Sprintf("%s %s→-", met.name, formatNum(va)))
+				continue
+			case math.IsNaN(va):
+				stats[m].onlyB++
+				diffs = append(diffs, fmt.Sprintf("%s -→%s", met.name, formatNum(vb)))
+				continue
+			}
+			stats[m].add(sa.timestamp, vb-va)
+			if math.Abs(vb-va) >= met.epsilon {
+				diffs = append(diffs, fmt.Sprintf("%s %s→%s", met.name, formatNum(va), formatNum(vb)))
+			}
+		}
+		hasA, hasB := !math.IsNaN(sa.lat) && !math.IsNaN(sa.long), !math.IsNaN(sb.lat) && !math.IsNaN(sb.long)
+		switch {
+		case hasA && hasB:
+			d := haversineMeters(sa.lat, sa.long, sb.lat, sb.long)
+			position.add(sa.timestamp, d)
+			if d >= positionEpsilon {
+				diffs = append(diffs, fmt.Sprintf("position %.1fm apart", d))
+			}
+		case hasA:
+			position.onlyA++
+			diffs = append(diffs, "position only in A")
+		case hasB:
+			position.onlyB++
+			diffs = append(diffs, "position only in B")
+		}
+
+		if len(diffs) > 0 && len(listed) < maxSamples {
+			listed = append(listed, fmt.Sprintf("%s\t%s", sa.timestamp.Format("15:04:05"), strings.Join(diffs, ", ")))
+		}
+	}
+
+	onlyB := len(b.samples) - aligned
+	fmt.Printf("Aligned by timestamp: %d, only in A: %d, only in B: %d\n", aligned, onlyA, onlyB)
+	if aligned == 0 && len(a.samples) > 0 && len(b.samples) > 0 {
+		offset := b.samples[0].timestamp.Sub(a.samples[0].timestamp)
+		fmt.Printf("(no timestamps overlap; B starts %s after A, try -shift=%s)\n", offset, -offset)
+	}
+	if onlyA > 0 || onlyB > 0 {
+		differences++
+	}
+
+	var rows bytes.Buffer
+	w := tabwriter.NewWriter(&rows, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "\nMetric\tCompared\tMean Δ\tMean |Δ|\tMax |Δ|\tAt\tOnly A\tOnly B")
+	fmt.Fprintln(w, "------\t--------\t------\t--------\t-------\t--\t------\t------")
+	printed := 0
+	row := func(name, unit string, d deltaStats, epsilon float64, signed bool) {
+		if d.compared == 0 && d.onlyA == 0 && d.onlyB == 0 {
+			return
+		}
+		printed++
+		mean, meanAbs, at := "-", "-", "-"
+		if d.compared > 0 {
+			if signed {
+				mean = fmt.Sprintf("%+.3f %s", d.sum/float64(d.compared), unit)
+			}
+			meanAbs = fmt.Sprintf("%.3f %s", d.sumAbs/float64(d.compared), unit)
+		}
+		if !d.maxAt.IsZero() {
+			at = d.maxAt.Format("15:04:05")
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%.3f %s\t%s\t%d\t%d\n", name, d.compared, mean, meanAbs, d.maxAbs, unit, at, d.onlyA, d.onlyB)
+		if d.maxAbs >= epsilon || d.onlyA > 0 || d.onlyB > 0 {
+			differences++
+		}
+	}
+	for m, met := range metrics {
+		row(met.name, met.unit, stats[m], met.epsilon, true)
+	}
+	row("position", "m", position, positionEpsilon, false)
+	w.Flush()
+	if printed > 0 {
+		rows.WriteTo(os.Stdout)
+	}
+
+	if da, db := lastValid(a, "distance"), lastValid(b, "distance"); !math.IsNaN(da) && !math.IsNaN(db) {
+		fmt.Printf("\nFinal distance: A=%.2fm B=%.2fm (Δ %+.2fm", da, db, db-da)
+		if da > 0 {
+			fmt.Printf(", %+.2f%%", (db-da)/da*100)
+		}
+		fmt.Println(")")
+	}
+
+	if len(listed) > 0 {
+		fmt.Printf("\nFirst %d differing samples:\n", len(listed))
+		lw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, line := range listed {
+			fmt.Fprintln(lw, line)
+		}
+		lw.Flush()
+	}
+	return differences
+}
+
+// lastValid returns the last sample value of the named metric, or NaN.
+func lastValid(s *fileSummary, name string) float64 {
+	for m, met := range metrics {
+		if met.name != name {
+			continue
+		}
+		for i := len(s.samples) - 1; i >= 0; i-- {
+			if v := s.samples[i].values[m]; !math.IsNaN(v) {
+				return v
+			}
+		}
+	}
+	return math.NaN()
+}
+
+func haversineMeters(lat1, long1, lat2, long2 float64) float64 {
+	const earthRadius = 6371000.0
+	dLat := (lat2 - lat1) * math.Pi / 180
+	dLong := (long2 - long1) * math.Pi / 180
+	x := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*math.Pi/180)*math.Cos(lat2*math.Pi/180)*math.Sin(dLong/2)*math.Sin(dLong/2)
+	return earthRadius * 2 * math.Atan2(math.Sqrt(x), math.Sqrt(1-x))
+}
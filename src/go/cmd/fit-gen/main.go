@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
@@ -15,6 +16,13 @@ import (
 func main() {
 	inputFile := flag.String("input", "", "Path to input JSON file (StandardizedActivity)")
 	outputFile := flag.String("output", "output.fit", "Path to output FIT file")
+	smartRecording := flag.Bool("smart-recording", false, "Drop records that barely change from the previous kept record")
+	maxInterval := flag.Duration("max-record-interval", file_generators.DefaultMaxRecordInterval, "Longest gap between kept records with -smart-recording")
+	compressedTimestampHeaders := flag.Bool("compressed-timestamp-headers", false, "Write record timestamps as offsets in compressed headers")
+	validate := flag.Bool("validate", false, "Re-parse the written FIT file and fail if it drifts from the input")
+	maxRecordDrift := flag.Float64("max-record-drift", 0, "With -validate, allowed fractional difference in record count")
+	maxDistanceDrift := flag.Float64("max-distance-drift", 0.01, "With -validate, allowed fractional difference in total distance")
+	maxHRCoverageDrift := flag.Float64("max-hr-coverage-drift", 1, "With -validate, allowed difference in heart rate coverage (percentage points)")
 	flag.Parse()
 
 	if *inputFile == "" {
@@ -36,7 +44,11 @@ func main() {
 	}
 
 	// 3. Generate FIT
-	fitData, err := file_generators.GenerateFitFile(&activity)
+	fitData, stats, err := file_generators.GenerateFitFileWithOptions(&activity, file_generators.FitOptions{
+		SmartRecording:             *smartRecording,
+		MaxRecordInterval:          *maxInterval,
+		CompressedTimestampHeaders: *compressedTimestampHeaders,
+	})
 	if err != nil {
 		log.Fatalf("Failed to generate FIT file: %v", err)
 	}
@@ -46,5 +58,26 @@ func main() {
 		log.Fatalf("Failed to write output file: %v", err)
 	}
 
-	fmt.Printf("Successfully wrote FIT file to %s (%d bytes)\n", *outputFile, len(fitData))
+	fmt.Printf("Successfully wrote FIT file to %s (%d bytes, %d/%d records)\n", *outputFile, len(fitData), stats.WrittenRecords, stats.InputRecords)
+
+	// 6. Round-trip validation
+	if !*validate {
+		return
+	}
+	report, err := validateRoundTrip(&activity, fitData, stats.WrittenRecords, validationThresholds{
+		RecordDrift:     *maxRecordDrift,
+		DistanceDrift:   *maxDistanceDrift,
+		HRCoverageDrift: *maxHRCoverageDrift,
+	})
+	if err != nil {
+		log.Fatalf("Validation failed: %v", err)
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		log.Fatalf("Failed to write validation report: %v", err)
+	}
+	if !report.Passed {
+		os.Exit(1)
+	}
 }
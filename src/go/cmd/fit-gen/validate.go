@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/fitglue/server/src/go/pkg/domain/fit_parser"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+// validationThresholds bounds how far the re-parsed FIT file may drift from
+// the input activity before -validate fails.
+type validationThresholds struct {
+	RecordDrift     float64 // fraction of expected record count
+	DistanceDrift   float64 // fraction of input total distance
+	HRCoverageDrift float64 // percentage points
+}
+
+type validationCheck struct {
+	Name      string  `json:"name"`
+	Expected  float64 `json:"expected"`
+	Actual    float64 `json:"actual"`
+	Drift     float64 `json:"drift"`
+	Threshold float64 `json:"threshold"`
+	Unit      string  `json:"unit"`
+	Passed    bool    `json:"passed"`
+}
+
+type validationReport struct {
+	Passed bool              `json:"passed"`
+	Checks []validationCheck `json:"checks"`
+}
+
+// validateRoundTrip re-parses fitData and compares it against the activity it
+// was generated from. expectedRecords is the number of records the generator
+// reported writing, which is lower than the input when smart recording is on.
+func validateRoundTrip(input *pbactivity.StandardizedActivity, fitData []byte, expectedRecords int, t validationThresholds) (*validationReport, error) {
+	parsed, err := fit_parser.ParseFitFile(fitData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-parse generated FIT file: %w", err)
+	}
+
+	inStats := summarize(input)
+	outStats := summarize(parsed)
+
+	checks := []validationCheck{
+		relativeCheck("record_count", float64(expectedRecords), float64(outStats.records), t.RecordDrift),
+		relativeCheck("total_distance", inStats.distance, outStats.distance, t.DistanceDrift),
+		absoluteCheck("hr_coverage", inStats.hrCoverage(), outStats.hrCoverage(), t.HRCoverageDrift, "%"),
+	}
+
+	report := &validationReport{Passed: true, Checks: checks}
+	for _, c := range checks {
+		if !c.Passed {
+			report.Passed = false
+		}
+	}
+	return report, nil
+}
+
+type activitySummary struct {
+	records   int
+	hrRecords int
+	distance  float64
+}
+
+func (s activitySummary) hrCoverage() float64 {
+	if s.records == 0 {
+		return 0
+	}
+	return float64(s.hrRecords) / float64(s.records) * 100
+}
+
+// summarize totals the metrics compared by validateRoundTrip. Distance comes
+// from the sessions, falling back to the furthest record when no session has
+// a total.
+func summarize(act *pbactivity.StandardizedActivity) activitySummary {
+	var s activitySummary
+	var maxRecordDistance float64
+	for _, sess := range act.Sessions {
+		s.distance += sess.TotalDistance
+		for _, lap := range sess.Laps {
+			for _, rec := range lap.Records {
+				s.records++
+				if rec.HeartRate > 0 {
+					s.hrRecords++
+				}
+				maxRecordDistance = math.Max(maxRecordDistance, rec.Distance)
+			}
+		}
+	}
+	if s.distance == 0 {
+		s.distance = maxRecordDistance
+	}
+	return s
+}
+
+// relativeCheck passes when output is within threshold (a fraction) of input.
+func relativeCheck(name string, input, output, threshold float64) validationCheck {
+	drift := 0.0
+	if input != 0 {
+		drift = math.Abs(output-input) / input
+	} else if output != 0 {
+		drift = 1
+	}
+	return validationCheck{
+		Name:      name,
+		Expected:  input,
+		Actual:    output,
+		Drift:     drift,
+		Threshold: threshold,
+		Unit:      "fraction",
+		Passed:    drift <= threshold,
+	}
+}
+
+// absoluteCheck passes when output differs from input by at most threshold.
+func absoluteCheck(name string, input, output, threshold float64, unit string) validationCheck {
+	drift := math.Abs(output - input)
+	return validationCheck{
+		Name:      name,
+		Expected:  input,
+		Actual:    output,
+		Drift:     drift,
+		Threshold: threshold,
+		Unit:      unit,
+		Passed:    drift <= threshold,
+	}
+}
@@ -2,25 +2,34 @@ package main
 
 import (
 	"bytes"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"text/tabwriter"
 	"time"
 
 	"github.com/muktihari/fit/decoder"
 	"github.com/muktihari/fit/profile/mesgdef"
 	"github.com/muktihari/fit/profile/typedef"
+	"github.com/muktihari/fit/proto"
 )
 
 type FieldStats struct {
-	Name  string
-	Count int
-	Min   float64
-	Max   float64
-	Sum   float64
+	Name    string
+	Records int // records carrying the field, numeric or not
+	Count   int // numeric values, which feed Min/Max/Sum
+	Min     float64
+	Max     float64
+	Sum     float64
 }
 
 func NewFieldStats(name string) *FieldStats {
@@ -93,9 +102,249 @@ func (fs *FieldStats) Avg() float64 {
 	return fs.Sum / float64(fs.Count)
 }
 
+type sessionInfo struct {
+	startTime time.Time
+	duration  float64
+	distance  float64
+	sport     string
+	subSport  string
+	name      string
+}
+
+type lapInfo struct {
+	startTime time.Time
+	duration  float64
+	distance  float64
+}
+
+// devFieldKey identifies a developer field: the developer_data_index of its
+// app plus its field_definition_number.
+type devFieldKey struct {
+	devIndex uint8
+	fieldNum uint8
+}
+
+// devFieldInfo is a developer field's field_description metadata plus the
+// statistics of its values across records.
+type devFieldInfo struct {
+	name        string
+	units       string
+	baseType    string
+	nativeMesg  string
+	nativeField *uint8
+	stats       *FieldStats
+}
+
+// mesgInfo counts occurrences of one message number, along with any fields
+// the profile doesn't define (keyed by field number).
+type mesgInfo struct {
+	count         int
+	unknownFields map[byte]int
+}
+
+// unknownFieldName is the name the decoder gives fields missing from the FIT profile.
+const unknownFieldName = "unknown"
+
+// progressInterval is how many records pass between progress lines in streaming mode
+const progressInterval = 10000
+
+// inspector accumulates summaries from decoded messages. It implements
+// decoder.MesgListener so the same logic serves both the in-memory and
+// streaming modes; only the per-file summaries are retained, never the records.
+type inspector struct {
+	verbose bool
+	stats   map[string]*FieldStats
+
+	recordCount  int
+	sessionCount int
+	lapCount     int
+	sessions     []sessionInfo
+	laps         []lapInfo
+
+	devFields      map[devFieldKey]*devFieldInfo
+	applicationIDs map[uint8]string
+	mesgs          map[typedef.MesgNum]*mesgInfo
+
+	// log receives diagnostic output; stderr when stdout carries JSON or CSV
+	log io.Writer
+
+	// progress, when set, is called every progressInterval records
+	progress func(records int)
+}
+
+func newInspector(verbose bool, log io.Writer) *inspector {
+	return &inspector{
+		verbose:        verbose,
+		log:            log,
+		stats:          map[string]*FieldStats{},
+		devFields:      map[devFieldKey]*devFieldInfo{},
+		applicationIDs: map[uint8]string{},
+		mesgs:          map[typedef.MesgNum]*mesgInfo{},
+	}
+}
+
+// OnMesg processes a single decoded message. The decoder reuses the message
+// between calls in streaming mode, so nothing from it is kept by reference.
+func (in *inspector) OnMesg(msg proto.Message) {
+	mi, ok := in.mesgs[msg.Num]
+	if !ok {
+		mi = &mesgInfo{unknownFields: map[byte]int{}}
+		in.mesgs[msg.Num] = mi
+	}
+	mi.count++
+	for _, field := range msg.Fields {
+		if field.Name == unknownFieldName {
+			mi.unknownFields[field.Num]++
+		}
+	}
+
+	if msg.Num == typedef.MesgNumSession {
+		in.sessionCount++
+		sessionMsg := mesgdef.NewSession(&msg)
+		in.sessions = append(in.sessions, sessionInfo{
+			startTime: sessionMsg.StartTime.UTC(),
+			duration:  float64(sessionMsg.TotalElapsedTime) / 1000,
+			distance:  float64(sessionMsg.TotalDistance) / 100,
+			sport:     sessionMsg.Sport.String(),
+			subSport:  sessionMsg.SubSport.String(),
+			name:      sessionMsg.SportProfileName,
+		})
+	}
+
+	if msg.Num == typedef.MesgNumLap {
+		in.lapCount++
+		lapMsg := mesgdef.NewLap(&msg)
+		in.laps = append(in.laps, lapInfo{
+			startTime: lapMsg.StartTime.UTC(),
+			duration:  float64(lapMsg.TotalElapsedTime) / 1000,
+			distance:  float64(lapMsg.TotalDistance) / 100,
+		})
+	}
+
+	if msg.Num == typedef.MesgNumDeveloperDataId {
+		devMsg := mesgdef.NewDeveloperDataId(&msg)
+		in.applicationIDs[devMsg.DeveloperDataIndex] = hex.EncodeToString(devMsg.ApplicationId)
+	}
+
+	if msg.Num == typedef.MesgNumFieldDescription {
+		descMsg := mesgdef.NewFieldDescription(&msg)
+		df := in.devField(devFieldKey{descMsg.DeveloperDataIndex, descMsg.FieldDefinitionNumber})
+		df.name = strings.Join(descMsg.FieldName, " ")
+		df.units = strings.Join(descMsg.Units, " ")
+		df.baseType = descMsg.FitBaseTypeId.String()
+		if descMsg.NativeMesgNum != typedef.MesgNumInvalid {
+			df.nativeMesg = descMsg.NativeMesgNum.String()
+		}
+		if descMsg.NativeFieldNum != math.MaxUint8 {
+			nativeField := descMsg.NativeFieldNum
+			df.nativeField = &nativeField
+		}
+	}
+
+	if msg.Num == typedef.MesgNumRecord {
+		in.recordCount++
+		for _, devField := range msg.DeveloperFields {
+			df := in.devField(devFieldKey{devField.DeveloperDataIndex, devField.Num})
+			df.stats.Records++
+			df.stats.Update(devField.Value.Any())
+		}
+		for _, field := range msg.Fields {
+			if in.verbose {
+				// Dump all fields to see what's actually there
+				fmt.Fprintf(in.log, "Record %d: %q (Num: %d) = %v (Type: %T)\n", in.recordCount, field.Name, field.Num, field.Value, field.Value)
+			}
+			// Every field is tracked, so fields new to the profile or
+			// specific to a device show up without changes here
+			name := field.Name
+			if name == unknownFieldName {
+				name = fmt.Sprintf("unknown_%d", field.Num)
+			}
+			s, ok := in.stats[name]
+			if !ok {
+				s = NewFieldStats(name)
+				in.stats[name] = s
+			}
+			s.Records++
+			s.Update(field.Value.Any())
+		}
+		if in.progress != nil && in.recordCount%progressInterval == 0 {
+			in.progress(in.recordCount)
+		}
+	}
+}
+
+// devField returns the entry for key, creating it for fields seen before (or
+// without) their field_description.
+func (in *inspector) devField(key devFieldKey) *devFieldInfo {
+	df, ok := in.devFields[key]
+	if !ok {
+		df = &devFieldInfo{stats: NewFieldStats(fmt.Sprintf("dev_%d_%d", key.devIndex, key.fieldNum))}
+		in.devFields[key] = df
+	}
+	return df
+}
+
+// countingReader tracks bytes consumed so streaming progress can show a percentage.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// inspectInMemory reads and decodes the whole file before analysing it.
+func inspectInMemory(path string, in *inspector) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	fitData, err := decoder.New(bytes.NewReader(data)).Decode()
+	if err != nil {
+		return fmt.Errorf("failed to decode FIT file: %w", err)
+	}
+
+	for _, msg := range fitData.Messages {
+		in.OnMesg(msg)
+	}
+	return nil
+}
+
+// inspectStreaming analyses messages as they are decoded without retaining
+// them, so memory stays flat regardless of how many records the file holds.
+func inspectStreaming(path string, in *inspector) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	cr := &countingReader{r: f}
+	in.progress = func(records int) {
+		fmt.Fprintf(in.log, "  ...%d records decoded (%.0f%% of file)\n", records, float64(cr.n)/float64(info.Size())*100)
+	}
+
+	fitDec := decoder.New(cr, decoder.WithMesgListener(in), decoder.WithBroadcastOnly())
+	if _, err := fitDec.Decode(); err != nil {
+		return fmt.Errorf("failed to decode FIT file: %w", err)
+	}
+	return nil
+}
+
 func main() {
 	inputPath := flag.String("input", "", "Path to FIT file")
 	verbose := flag.Bool("detailed-dump", false, "Print detailed record info")
+	streaming := flag.Bool("streaming", false, "Decode incrementally without loading the file into memory (for very large files)")
+	format := flag.String("format", "table", "Output format: table, json or csv")
 	flag.Parse()
 
 	if *inputPath == "" {
@@ -103,102 +352,308 @@ func main() {
 		os.Exit(1)
 	}
 
-	data, err := os.ReadFile(*inputPath)
-	if err != nil {
-		fmt.Printf("Failed to read file: %v\n", err)
+	// Keep stdout clean for machine-readable formats so it can be piped
+	var log io.Writer = os.Stdout
+	switch *format {
+	case "table":
+	case "json", "csv":
+		log = os.Stderr
+	default:
+		fmt.Printf("Unknown format %q: expected table, json or csv\n", *format)
+		os.Exit(1)
+	}
+
+	in := newInspector(*verbose, log)
+
+	fmt.Fprintln(log, "Analyzing FIT file...")
+	inspect := inspectInMemory
+	if *streaming {
+		inspect = inspectStreaming
+	}
+	if err := inspect(*inputPath, in); err != nil {
+		fmt.Fprintln(log, err)
 		os.Exit(1)
 	}
 
-	fitDec := decoder.New(bytes.NewReader(data))
-	fitData, err := fitDec.Decode()
+	var err error
+	switch *format {
+	case "json":
+		err = writeJSON(os.Stdout, in.report(*inputPath))
+	case "csv":
+		err = writeCSV(os.Stdout, in.report(*inputPath))
+	default:
+		in.printSummary()
+	}
 	if err != nil {
-		fmt.Printf("Failed to decode FIT file: %v\n", err)
+		fmt.Fprintln(log, err)
 		os.Exit(1)
 	}
+}
 
-	stats := map[string]*FieldStats{
-		"heart_rate":           NewFieldStats("HeartRate"),
-		"power":                NewFieldStats("Power"),
-		"cadence":              NewFieldStats("Cadence"),
-		"speed":                NewFieldStats("Speed"),
-		"enhanced_speed":       NewFieldStats("EnhancedSpeed"),
-		"distance":             NewFieldStats("Distance"),
-		"altitude":             NewFieldStats("Altitude"),
-		"enhanced_altitude":    NewFieldStats("EnhancedAltitude"),
-		"position_lat":         NewFieldStats("PositionLat"),
-		"position_long":        NewFieldStats("PositionLong"),
-		"stance_time":          NewFieldStats("GroundContactTime"),
-		"vertical_oscillation": NewFieldStats("VerticalOscillation"),
-		"vertical_ratio":       NewFieldStats("VerticalRatio"),
-		"step_length":          NewFieldStats("StepLength"),
-		"accumulated_power":    NewFieldStats("AccumulatedPower"),
-	}
-
-	recordCount := 0
-	sessionCount := 0
-	lapCount := 0
-
-	type sessionInfo struct {
-		startTime time.Time
-		duration  float64
-		distance  float64
-		sport     string
-		subSport  string
-		name      string
-	}
-	var sessions []sessionInfo
-
-	type lapInfo struct {
-		startTime time.Time
-		duration  float64
-		distance  float64
-	}
-	var laps []lapInfo
-
-	fmt.Println("Analyzing FIT file...")
-	for _, msg := range fitData.Messages {
+// report is the machine-readable summary emitted by -format json and csv.
+type report struct {
+	File            string                 `json:"file"`
+	Records         int                    `json:"records"`
+	Sessions        []sessionReport        `json:"sessions"`
+	Laps            []lapReport            `json:"laps"`
+	Fields          []fieldReport          `json:"fields"`
+	DeveloperFields []developerFieldReport `json:"developer_fields"`
+	Temperatures    []temperatureReport    `json:"temperatures"`
+	Messages        []messageReport        `json:"messages"`
+}
 
-		if msg.Num == typedef.MesgNumSession {
-			sessionCount++
-			sessionMsg := mesgdef.NewSession(&msg)
-			sessions = append(sessions, sessionInfo{
-				startTime: sessionMsg.StartTime.UTC(),
-				duration:  float64(sessionMsg.TotalElapsedTime) / 1000,
-				distance:  float64(sessionMsg.TotalDistance) / 100,
-				sport:     sessionMsg.Sport.String(),
-				subSport:  sessionMsg.SubSport.String(),
-				name:      sessionMsg.SportProfileName,
-			})
-		}
-
-		if msg.Num == typedef.MesgNumLap {
-			lapCount++
-			lapMsg := mesgdef.NewLap(&msg)
-			laps = append(laps, lapInfo{
-				startTime: lapMsg.StartTime.UTC(),
-				duration:  float64(lapMsg.TotalElapsedTime) / 1000,
-				distance:  float64(lapMsg.TotalDistance) / 100,
-			})
-		}
-
-		if msg.Num == typedef.MesgNumRecord {
-			recordCount++
-			for _, field := range msg.Fields {
-				if *verbose {
-					// Dump all fields to see what's actually there
-					fmt.Printf("Record %d: %q (Num: %d) = %v (Type: %T)\n", recordCount, field.Name, field.Num, field.Value, field.Value)
-				}
-				if s, ok := stats[field.Name]; ok {
-					s.Update(field.Value)
-				} else if *verbose {
-					fmt.Printf("Field %q not found in stats map (Keys: %v)\n", field.Name, reflect.ValueOf(stats).MapKeys())
-				}
-			}
+type sessionReport struct {
+	StartTime       time.Time `json:"start_time"`
+	DurationSeconds float64   `json:"duration_s"`
+	DistanceMeters  float64   `json:"distance_m"`
+	Sport           string    `json:"sport"`
+	SubSport        string    `json:"sub_sport"`
+	Name            string    `json:"name"`
+}
+
+type lapReport struct {
+	StartTime       time.Time `json:"start_time"`
+	DurationSeconds float64   `json:"duration_s"`
+	DistanceMeters  float64   `json:"distance_m"`
+}
+
+type fieldReport struct {
+	Name        string  `json:"name"`
+	Records     int     `json:"records"`
+	Count       int     `json:"count"`
+	CoveragePct float64 `json:"coverage_pct"`
+	Min         float64 `json:"min"`
+	Max         float64 `json:"max"`
+	Avg         float64 `json:"avg"`
+}
+
+type developerFieldReport struct {
+	DeveloperDataIndex uint8        `json:"developer_data_index"`
+	FieldNumber        uint8        `json:"field_number"`
+	Name               string       `json:"name"`
+	Units              string       `json:"units,omitempty"`
+	BaseType           string       `json:"base_type,omitempty"`
+	ApplicationID      string       `json:"application_id,omitempty"`
+	NativeMesg         string       `json:"native_mesg,omitempty"`
+	NativeField        *uint8       `json:"native_field,omitempty"`
+	Records            int          `json:"records"`
+	Stats              *fieldReport `json:"stats,omitempty"`
+}
+
+// messageReport counts one message number. Known is false for numbers the FIT
+// profile doesn't define; manufacturer-specific numbers are flagged separately
+// since they're undocumented by design rather than unexpected.
+type messageReport struct {
+	Num                  uint16               `json:"num"`
+	Name                 string               `json:"name"`
+	Count                int                  `json:"count"`
+	Known                bool                 `json:"known"`
+	ManufacturerSpecific bool                 `json:"manufacturer_specific"`
+	UnknownFields        []unknownFieldReport `json:"unknown_fields,omitempty"`
+}
+
+// temperatureReport summarises one temperature stream in degrees Celsius,
+// scaled from the raw field values. Source is "ambient", "core" or "skin".
+type temperatureReport struct {
+	Source  string  `json:"source"`
+	Field   string  `json:"field"`
+	Records int     `json:"records"`
+	Min     float64 `json:"min"`
+	Max     float64 `json:"max"`
+	Avg     float64 `json:"avg"`
+}
+
+type unknownFieldReport struct {
+	Num   byte `json:"num"`
+	Count int  `json:"count"`
+}
+
+// report collects the accumulated summaries, with fields sorted by name so
+// output is stable between runs.
+func (in *inspector) report(path string) report {
+	r := report{
+		File:            path,
+		Records:         in.recordCount,
+		Sessions:        []sessionReport{},
+		Laps:            []lapReport{},
+		Fields:          []fieldReport{},
+		DeveloperFields: []developerFieldReport{},
+		Temperatures:    []temperatureReport{},
+		Messages:        []messageReport{},
+	}
+	for _, s := range in.sessions {
+		r.Sessions = append(r.Sessions, sessionReport{s.startTime, s.duration, s.distance, s.sport, s.subSport, s.name})
+	}
+	for _, l := range in.laps {
+		r.Laps = append(r.Laps, lapReport{l.startTime, l.duration, l.distance})
+	}
+	for name, s := range in.stats {
+		r.Fields = append(r.Fields, in.fieldReport(name, s))
+	}
+	sort.Slice(r.Fields, func(i, j int) bool { return r.Fields[i].Name < r.Fields[j].Name })
+
+	for key, df := range in.devFields {
+		dr := developerFieldReport{
+			DeveloperDataIndex: key.devIndex,
+			FieldNumber:        key.fieldNum,
+			Name:               df.name,
+			Units:              df.units,
+			BaseType:           df.baseType,
+			ApplicationID:      in.applicationIDs[key.devIndex],
+			NativeMesg:         df.nativeMesg,
+			NativeField:        df.nativeField,
+			Records:            df.stats.Records,
+		}
+		if dr.Name == "" {
+			dr.Name = df.stats.Name
+		}
+		if df.stats.Count > 0 {
+			stats := in.fieldReport(dr.Name, df.stats)
+			dr.Stats = &stats
+		}
+		r.DeveloperFields = append(r.DeveloperFields, dr)
+	}
+	sort.Slice(r.DeveloperFields, func(i, j int) bool {
+		a, b := r.DeveloperFields[i], r.DeveloperFields[j]
+		if a.DeveloperDataIndex != b.DeveloperDataIndex {
+			return a.DeveloperDataIndex < b.DeveloperDataIndex
+		}
+		return a.FieldNumber < b.FieldNumber
+	})
+
+	// Native fields: temperature is whole degrees, core_temperature has scale 100
+	for _, native := range []struct {
+		field, source string
+		scale         float64
+	}{{"temperature", "ambient", 1}, {"core_temperature", "core", 100}} {
+		if s, ok := in.stats[native.field]; ok && s.Count > 0 {
+			r.Temperatures = append(r.Temperatures, temperatureReport{native.source, native.field, s.Count, s.Min / native.scale, s.Max / native.scale, s.Avg() / native.scale})
+		}
+	}
+	// Developer fields (e.g. the CORE Connect IQ app) carry no scale
+	for _, d := range r.DeveloperFields {
+		if source := temperatureSource(d.Name); source != "" && d.Stats != nil {
+			r.Temperatures = append(r.Temperatures, temperatureReport{source, d.Name, d.Stats.Count, d.Stats.Min, d.Stats.Max, d.Stats.Avg})
+		}
+	}
+
+	for num, mi := range in.mesgs {
+		mr := messageReport{
+			Num:                  uint16(num),
+			Name:                 num.String(),
+			Count:                mi.count,
+			Known:                !strings.HasPrefix(num.String(), "MesgNumInvalid"),
+			ManufacturerSpecific: num >= typedef.MesgNumMfgRangeMin && num <= typedef.MesgNumMfgRangeMax,
+		}
+		if !mr.Known {
+			mr.Name = fmt.Sprintf("unknown_%d", num)
+		}
+		for fieldNum, count := range mi.unknownFields {
+			mr.UnknownFields = append(mr.UnknownFields, unknownFieldReport{fieldNum, count})
+		}
+		sort.Slice(mr.UnknownFields, func(i, j int) bool { return mr.UnknownFields[i].Num < mr.UnknownFields[j].Num })
+		r.Messages = append(r.Messages, mr)
+	}
+	sort.Slice(r.Messages, func(i, j int) bool { return r.Messages[i].Num < r.Messages[j].Num })
+	return r
+}
+
+// temperatureSource classifies a developer field name as a core or skin
+// temperature, or returns "" for anything else.
+func temperatureSource(name string) string {
+	name = strings.ToLower(name)
+	if !strings.Contains(name, "temp") {
+		return ""
+	}
+	switch {
+	case strings.Contains(name, "skin"):
+		return "skin"
+	case strings.Contains(name, "core"):
+		return "core"
+	}
+	return ""
+}
+
+// fieldReport summarises s. Coverage is the share of records carrying the
+// field; Min/Max/Avg stay zero for fields with no numeric values.
+func (in *inspector) fieldReport(name string, s *FieldStats) fieldReport {
+	f := fieldReport{
+		Name:    name,
+		Records: s.Records,
+		Count:   s.Count,
+	}
+	if in.recordCount > 0 {
+		f.CoveragePct = float64(s.Records) / float64(in.recordCount) * 100
+	}
+	if s.Count > 0 {
+		f.Min, f.Max, f.Avg = s.Min, s.Max, s.Avg()
+	}
+	return f
+}
+
+func writeJSON(w io.Writer, r report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(r); err != nil {
+		return fmt.Errorf("failed to write JSON: %w", err)
+	}
+	return nil
+}
+
+// writeCSV emits one row per session, lap, field, developer field,
+// temperature stream and message number, plus one per unknown field within a
+// message. The
+// section column tells them apart so tools can filter on it; columns that
+// don't apply to a section are left empty.
+func writeCSV(w io.Writer, r report) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"section", "index", "name", "units", "start_time", "duration_s", "distance_m", "sport", "sub_sport", "count", "coverage_pct", "min", "max", "avg"})
+
+	num := func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+	stats := func(f fieldReport) []string {
+		return []string{strconv.Itoa(f.Count), num(f.CoveragePct), num(f.Min), num(f.Max), num(f.Avg)}
+	}
+
+	for i, s := range r.Sessions {
+		cw.Write([]string{"session", strconv.Itoa(i + 1), s.Name, "", s.StartTime.Format(time.RFC3339), num(s.DurationSeconds), num(s.DistanceMeters), s.Sport, s.SubSport, "", "", "", "", ""})
+	}
+	for i, l := range r.Laps {
+		cw.Write([]string{"lap", strconv.Itoa(i + 1), "", "", l.StartTime.Format(time.RFC3339), num(l.DurationSeconds), num(l.DistanceMeters), "", "", "", "", "", "", ""})
+	}
+	for _, f := range r.Fields {
+		cw.Write(append([]string{"field", "", f.Name, "", "", "", "", "", ""}, stats(f)...))
+	}
+	for _, d := range r.DeveloperFields {
+		row := []string{"developer_field", fmt.Sprintf("%d:%d", d.DeveloperDataIndex, d.FieldNumber), d.Name, d.Units, "", "", "", "", ""}
+		if d.Stats != nil {
+			row = append(row, stats(*d.Stats)...)
+		} else {
+			row = append(row, strconv.Itoa(d.Records), "", "", "", "")
+		}
+		cw.Write(row)
+	}
+	for _, tr := range r.Temperatures {
+		cw.Write([]string{"temperature", tr.Source, tr.Field, "C", "", "", "", "", "", strconv.Itoa(tr.Records), "", num(tr.Min), num(tr.Max), num(tr.Avg)})
+	}
+	for _, m := range r.Messages {
+		cw.Write([]string{"message", strconv.Itoa(int(m.Num)), m.Name, "", "", "", "", "", "", strconv.Itoa(m.Count), "", "", "", ""})
+		for _, f := range m.UnknownFields {
+			cw.Write([]string{"unknown_field", fmt.Sprintf("%d:%d", m.Num, f.Num), m.Name, "", "", "", "", "", "", strconv.Itoa(f.Count), "", "", "", ""})
 		}
 	}
 
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+func (in *inspector) printSummary() {
+	sessions, laps, recordCount := in.sessions, in.laps, in.recordCount
+
 	// Print session summary
-	fmt.Printf("\n=== SESSIONS: %d ===\n", sessionCount)
+	fmt.Printf("\n=== SESSIONS: %d ===\n", in.sessionCount)
 	if len(sessions) > 0 {
 		sw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 		fmt.Fprintln(sw, "#\tStart Time\tDuration\tDistance\tSport\tSubSport\tName")
@@ -213,7 +668,7 @@ func main() {
 	}
 
 	// Print lap summary
-	fmt.Printf("\n=== LAPS: %d ===\n", lapCount)
+	fmt.Printf("\n=== LAPS: %d ===\n", in.lapCount)
 	if len(laps) > 0 && len(laps) <= 20 { // Only show if reasonable number
 		lw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 		fmt.Fprintln(lw, "#\tStart Time\tDuration\tDistance")
@@ -234,15 +689,71 @@ func main() {
 	fmt.Println("\nField Statistics:")
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-	fmt.Fprintln(w, "Field\tCount\tCoverage\tMin\tMax\tAvg")
-	fmt.Fprintln(w, "-----\t-----\t--------\t---\t---\t---")
+	fmt.Fprintln(w, "Field\tRecords\tCoverage\tMin\tMax\tAvg")
+	fmt.Fprintln(w, "-----\t-------\t--------\t---\t---\t---")
 
-	for name, s := range stats {
-		if s.Count > 0 {
-			coverage := float64(s.Count) / float64(recordCount) * 100
-			fmt.Fprintf(w, "%s\t%d\t%.1f%%\t%.2f\t%.2f\t%.2f\n",
-				name, s.Count, coverage, s.Min, s.Max, s.Avg())
+	r := in.report("")
+	for _, f := range r.Fields {
+		min, max, avg := "-", "-", "-"
+		if f.Count > 0 {
+			min, max, avg = fmt.Sprintf("%.2f", f.Min), fmt.Sprintf("%.2f", f.Max), fmt.Sprintf("%.2f", f.Avg)
 		}
+		fmt.Fprintf(w, "%s\t%d\t%.1f%%\t%s\t%s\t%s\n",
+			f.Name, f.Records, f.CoveragePct, min, max, avg)
 	}
 	w.Flush()
+
+	if len(r.DeveloperFields) > 0 {
+		fmt.Printf("\n=== DEVELOPER FIELDS: %d ===\n", len(r.DeveloperFields))
+		dw := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(dw, "Dev:Field\tName\tUnits\tType\tRecords\tMin\tMax\tAvg")
+		fmt.Fprintln(dw, "---------\t----\t-----\t----\t-------\t---\t---\t---")
+		for _, d := range r.DeveloperFields {
+			min, max, avg := "-", "-", "-"
+			if d.Stats != nil {
+				min, max, avg = fmt.Sprintf("%.2f", d.Stats.Min), fmt.Sprintf("%.2f", d.Stats.Max), fmt.Sprintf("%.2f", d.Stats.Avg)
+			}
+			fmt.Fprintf(dw, "%d:%d\t%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+				d.DeveloperDataIndex, d.FieldNumber, d.Name, d.Units, d.BaseType, d.Records, min, max, avg)
+		}
+		dw.Flush()
+	}
+
+	if len(r.Temperatures) > 0 {
+		fmt.Printf("\n=== TEMPERATURE: %d ===\n", len(r.Temperatures))
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(tw, "Source\tField\tRecords\tMin\tMax\tAvg")
+		fmt.Fprintln(tw, "------\t-----\t-------\t---\t---\t---")
+		for _, tr := range r.Temperatures {
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%.1f°C\t%.1f°C\t%.1f°C\n", tr.Source, tr.Field, tr.Records, tr.Min, tr.Max, tr.Avg)
+		}
+		tw.Flush()
+	}
+
+	// Only messages that are undocumented, or that carry undocumented fields,
+	// are worth a closer look
+	var unknown []messageReport
+	for _, m := range r.Messages {
+		if !m.Known || len(m.UnknownFields) > 0 {
+			unknown = append(unknown, m)
+		}
+	}
+	if len(unknown) > 0 {
+		fmt.Printf("\n=== UNKNOWN MESSAGES / FIELDS: %d ===\n", len(unknown))
+		uw := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(uw, "Num\tName\tCount\tMfg\tUnknown Fields")
+		fmt.Fprintln(uw, "---\t----\t-----\t---\t--------------")
+		for _, m := range unknown {
+			var fields []string
+			for _, f := range m.UnknownFields {
+				fields = append(fields, fmt.Sprintf("%d (x%d)", f.Num, f.Count))
+			}
+			mfg := ""
+			if m.ManufacturerSpecific {
+				mfg = "yes"
+			}
+			fmt.Fprintf(uw, "%d\t%s\t%d\t%s\t%s\n", m.Num, m.Name, m.Count, mfg, strings.Join(fields, ", "))
+		}
+		uw.Flush()
+	}
 }
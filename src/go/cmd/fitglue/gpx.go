@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	activitytypes "github.com/fitglue/server/src/go/pkg/domain/activity"
+	"github.com/fitglue/server/src/go/pkg/domain/file_generators"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+// GPX is read by local element name, so files from any app parse the same
+// whether or not they declare the Garmin TrackPointExtension namespace.
+type gpxFile struct {
+	Metadata struct {
+		Name string `xml:"name"`
+		Time string `xml:"time"`
+	} `xml:"metadata"`
+	Tracks []struct {
+		Name     string `xml:"name"`
+		Desc     string `xml:"desc"`
+		Type     string `xml:"type"`
+		Segments []struct {
+			Points []gpxPoint `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+}
+
+type gpxPoint struct {
+	Lat        float64  `xml:"lat,attr"`
+	Lon        float64  `xml:"lon,attr"`
+	Ele        *float64 `xml:"ele"`
+	Time       string   `xml:"time"`
+	Extensions struct {
+		TrackPoint struct {
+			HeartRate int32 `xml:"hr"`
+			Cadence   int32 `xml:"cad"`
+		} `xml:"TrackPointExtension"`
+		Power int32 `xml:"power"`
+	} `xml:"extensions"`
+}
+
+// gpxToFit converts a GPX track to a FIT activity, since imports only accept
+// FIT files. Each track segment becomes a lap. activityType overrides the
+// track's own <type> when set.
+func gpxToFit(data []byte, activityType string) ([]byte, error) {
+	var doc gpxFile
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid GPX: %w", err)
+	}
+	if len(doc.Tracks) == 0 {
+		return nil, fmt.Errorf("GPX has no tracks")
+	}
+	track := doc.Tracks[0]
+
+	session := &pbactivity.Session{}
+	var start, end time.Time
+	var prev *gpxPoint
+	var distance float64
+	for _, segment := range track.Segments {
+		lap := &pbactivity.Lap{}
+		for i := range segment.Points {
+			point := &segment.Points[i]
+			ts, err := time.Parse(time.RFC3339, point.Time)
+			if err != nil {
+				// Routes without timestamps can't become an activity
+				return nil, fmt.Errorf("track point %d has no valid time", i+1)
+			}
+			if prev != nil {
+				distance += haversineMeters(prev.Lat, prev.Lon, point.Lat, point.Lon)
+			}
+			prev = point
+
+			record := &pbactivity.Record{
+				Timestamp:    timestamppb.New(ts),
+				PositionLat:  point.Lat,
+				PositionLong: point.Lon,
+				HeartRate:    point.Extensions.TrackPoint.HeartRate,
+				Cadence:      point.Extensions.TrackPoint.Cadence,
+				Power:        point.Extensions.Power,
+				Distance:     distance,
+			}
+			if point.Ele != nil {
+				record.Altitude = *point.Ele
+			}
+			lap.Records = append(lap.Records, record)
+
+			if start.IsZero() {
+				start = ts
+			}
+			end = ts
+		}
+		if len(lap.Records) == 0 {
+			continue
+		}
+		lap.StartTime = lap.Records[0].Timestamp
+		lap.TotalElapsedTime = lap.Records[len(lap.Records)-1].Timestamp.AsTime().Sub(lap.StartTime.AsTime()).Seconds()
+		lap.TotalDistance = lap.Records[len(lap.Records)-1].Distance - lap.Records[0].Distance
+		session.Laps = append(session.Laps, lap)
+	}
+	if len(session.Laps) == 0 {
+		return nil, fmt.Errorf("GPX has no track points")
+	}
+	session.StartTime = timestamppb.New(start)
+	session.TotalElapsedTime = end.Sub(start).Seconds()
+	session.TotalDistance = distance
+
+	name := track.Name
+	if name == "" {
+		name = doc.Metadata.Name
+	}
+	if activityType == "" {
+		activityType = track.Type
+	}
+
+	return file_generators.GenerateFitFile(&pbactivity.StandardizedActivity{
+		Source:      pbactivity.ActivitySource_SOURCE_FILE_UPLOAD,
+		Name:        name,
+		Description: track.Desc,
+		Type:        activitytypes.ParseActivityTypeFromString(activityType),
+		StartTime:   timestamppb.New(start),
+		Sessions:    []*pbactivity.Session{session},
+	})
+}
+
+func haversineMeters(lat1, lon1, lat2, lon2 float64) float64 {
+	const earthRadius = 6371000.0
+	toRad := math.Pi / 180
+	dLat := (lat2 - lat1) * toRad
+	dLon := (lon2 - lon1) * toRad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1*toRad)*math.Cos(lat2*toRad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
@@ -0,0 +1,319 @@
+// Command fitglue is a scriptable client for the FitGlue API: it uploads
+// FIT/GPX files, lists pipeline runs, resolves pending inputs and triggers
+// reposts. It authenticates with a personal API key created in the web app
+// (or via POST /api/v2/users/me/api-keys).
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	gatewaypb "github.com/fitglue/server/src/go/pkg/types/pb/gateway"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+const defaultAPIURL = "https://fitglue.tech/api/v2"
+
+const usage = `Usage: fitglue <command> [flags] [args]
+
+Commands:
+  pipelines                          List your pipelines
+  upload [-pipeline ID] FILE...      Import FIT or GPX files into a pipeline
+  runs [-pipeline ID] [-limit N]     List recent pipeline runs
+  inputs                             List activities waiting for your input
+  resolve INPUT_ID FIELD=VALUE...    Submit a pending input and resume its run
+  repost ACTIVITY_ID                 Re-run an activity through its pipeline
+
+Run "fitglue <command> -h" for a command's flags.
+
+Environment:
+  FITGLUE_API_KEY   Personal API key (required)
+  FITGLUE_API_URL   API base URL (default ` + defaultAPIURL + `)
+`
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("fitglue: ")
+
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	commands := map[string]func(ctx context.Context, c *client, args []string) error{
+		"pipelines": runPipelines,
+		"upload":    runUpload,
+		"runs":      runRuns,
+		"inputs":    runInputs,
+		"resolve":   runResolve,
+		"repost":    runRepost,
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	apiKey := os.Getenv("FITGLUE_API_KEY")
+	if apiKey == "" {
+		log.Fatal("FITGLUE_API_KEY is not set; create a key under Settings → API keys")
+	}
+	apiURL := os.Getenv("FITGLUE_API_URL")
+	if apiURL == "" {
+		apiURL = defaultAPIURL
+	}
+
+	if err := cmd(context.Background(), newClient(apiURL, apiKey), os.Args[2:]); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func runPipelines(ctx context.Context, c *client, args []string) error {
+	fs := flag.NewFlagSet("pipelines", flag.ExitOnError)
+	fs.Parse(args)
+
+	var res gatewaypb.ListPipelinesGatewayResponse
+	if err := c.call(ctx, http.MethodGet, "/users/me/pipelines", nil, nil, &res); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tNAME\tSOURCE\tDESTINATIONS")
+	for _, p := range res.Pipelines {
+		var dests []string
+		for _, d := range p.Destinations {
+			dests = append(dests, strings.ToLower(strings.TrimPrefix(d.String(), "DESTINATION_")))
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", p.Id, p.Name, p.Source, strings.Join(dests, ","))
+	}
+	return tw.Flush()
+}
+
+func runUpload(ctx context.Context, c *client, args []string) error {
+	fs := flag.NewFlagSet("upload", flag.ExitOnError)
+	pipelineID := fs.String("pipeline", "", "Pipeline to import into (required if you have more than one)")
+	activityType := fs.String("type", "", "Activity type for GPX files, e.g. run or ride (default: the track's <type>)")
+	wait := fs.Bool("wait", false, "Wait for the import to finish and print each file's outcome")
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		return fmt.Errorf("upload needs at least one FIT or GPX file")
+	}
+
+	// Read and convert everything first, so a bad file fails before the
+	// import is created
+	var names []string
+	var files [][]byte
+	for _, path := range fs.Args() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		name := filepath.Base(path)
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".fit":
+		case ".gpx":
+			if data, err = gpxToFit(data, *activityType); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			name = strings.TrimSuffix(name, filepath.Ext(name)) + ".fit"
+		default:
+			return fmt.Errorf("%s: only .fit and .gpx files can be uploaded", path)
+		}
+		names = append(names, name)
+		files = append(files, data)
+	}
+
+	pipeline, err := resolvePipeline(ctx, c, *pipelineID)
+	if err != nil {
+		return err
+	}
+	base := "/users/me/pipelines/" + url.PathEscape(pipeline)
+
+	var session pbpipeline.ImportSession
+	if err := c.call(ctx, http.MethodPost, base+"/imports", nil, &gatewaypb.CreateImportGatewayRequest{FileNames: names}, &session); err != nil {
+		return err
+	}
+	importPath := base + "/imports/" + url.PathEscape(session.Id)
+
+	for i, data := range files {
+		req := &gatewaypb.UploadImportFileGatewayRequest{FitFileContent: data}
+		if err := c.call(ctx, http.MethodPut, importPath+"/files/"+strconv.Itoa(i), nil, req, nil); err != nil {
+			return fmt.Errorf("%s: %w", names[i], err)
+		}
+		fmt.Fprintf(os.Stderr, "uploaded %s\n", names[i])
+	}
+
+	if err := c.call(ctx, http.MethodPost, importPath+"/start", nil, nil, &session); err != nil {
+		return err
+	}
+	fmt.Printf("import %s started with %d files\n", session.Id, len(files))
+	if !*wait {
+		return nil
+	}
+
+	for session.Status == pbpipeline.ImportStatus_IMPORT_STATUS_RUNNING {
+		time.Sleep(3 * time.Second)
+		if err := c.call(ctx, http.MethodGet, importPath, nil, nil, &session); err != nil {
+			return err
+		}
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "FILE\tSTATUS\tERROR")
+	for _, f := range session.Files {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", f.Name, enumLabel(f.Status.String(), "IMPORT_FILE_STATUS_"), f.GetError())
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if session.FilesFailed > 0 {
+		return fmt.Errorf("%d of %d files failed", session.FilesFailed, len(session.Files))
+	}
+	return nil
+}
+
+// resolvePipeline returns id, or the user's only pipeline when id is empty.
+func resolvePipeline(ctx context.Context, c *client, id string) (string, error) {
+	if id != "" {
+		return id, nil
+	}
+	var res gatewaypb.ListPipelinesGatewayResponse
+	if err := c.call(ctx, http.MethodGet, "/users/me/pipelines", nil, nil, &res); err != nil {
+		return "", err
+	}
+	switch len(res.Pipelines) {
+	case 0:
+		return "", fmt.Errorf("you have no pipelines")
+	case 1:
+		return res.Pipelines[0].Id, nil
+	default:
+		return "", fmt.Errorf("you have %d pipelines; choose one with -pipeline (see \"fitglue pipelines\")", len(res.Pipelines))
+	}
+}
+
+func runRuns(ctx context.Context, c *client, args []string) error {
+	fs := flag.NewFlagSet("runs", flag.ExitOnError)
+	pipelineID := fs.String("pipeline", "", "Only list this pipeline's runs (default: all pipelines)")
+	limit := fs.Int("limit", 20, "Number of runs to list, at most 100")
+	fs.Parse(args)
+
+	var pipelines []string
+	if *pipelineID != "" {
+		pipelines = []string{*pipelineID}
+	} else {
+		var res gatewaypb.ListPipelinesGatewayResponse
+		if err := c.call(ctx, http.MethodGet, "/users/me/pipelines", nil, nil, &res); err != nil {
+			return err
+		}
+		for _, p := range res.Pipelines {
+			pipelines = append(pipelines, p.Id)
+		}
+	}
+
+	var runs []*pbpipeline.PipelineRun
+	query := url.Values{"limit": {strconv.Itoa(*limit)}}
+	for _, id := range pipelines {
+		var res gatewaypb.ListPipelineRunsGatewayResponse
+		if err := c.call(ctx, http.MethodGet, "/users/me/pipelines/"+url.PathEscape(id)+"/runs", query, nil, &res); err != nil {
+			return err
+		}
+		runs = append(runs, res.Runs...)
+	}
+	slices.SortFunc(runs, func(a, b *pbpipeline.PipelineRun) int {
+		return b.CreatedAt.AsTime().Compare(a.CreatedAt.AsTime())
+	})
+	if len(runs) > *limit {
+		runs = runs[:*limit]
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "CREATED\tSTATUS\tACTIVITY\tPIPELINE\tRUN\tTITLE")
+	for _, run := range runs {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			run.CreatedAt.AsTime().Local().Format("2006-01-02 15:04"),
+			enumLabel(run.Status.String(), "PIPELINE_RUN_STATUS_"),
+			run.ActivityId, run.PipelineId, run.Id, run.Title)
+	}
+	return tw.Flush()
+}
+
+func runInputs(ctx context.Context, c *client, args []string) error {
+	fs := flag.NewFlagSet("inputs", flag.ExitOnError)
+	fs.Parse(args)
+
+	var res gatewaypb.ListPendingInputsGatewayResponse
+	if err := c.call(ctx, http.MethodGet, "/users/me/pending-inputs", nil, nil, &res); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "INPUT\tCREATED\tENRICHER\tFIELDS")
+	for _, input := range res.Inputs {
+		// Pending inputs are keyed by their activity ID
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n",
+			input.ActivityId,
+			input.CreatedAt.AsTime().Local().Format("2006-01-02 15:04"),
+			input.EnricherProviderId,
+			strings.Join(input.RequiredFields, ","))
+	}
+	return tw.Flush()
+}
+
+func runResolve(ctx context.Context, c *client, args []string) error {
+	fs := flag.NewFlagSet("resolve", flag.ExitOnError)
+	confirmOverwrite := fs.Bool("confirm-overwrite", false, "Overwrite description text edited on the destination since upload")
+	fs.Parse(args)
+	if fs.NArg() < 2 {
+		return fmt.Errorf("usage: fitglue resolve INPUT_ID FIELD=VALUE...")
+	}
+
+	req := &gatewaypb.SubmitInputGatewayRequest{
+		InputData:                   map[string]string{},
+		ConfirmDescriptionOverwrite: *confirmOverwrite,
+	}
+	for _, arg := range fs.Args()[1:] {
+		field, value, ok := strings.Cut(arg, "=")
+		if !ok || field == "" {
+			return fmt.Errorf("%q is not FIELD=VALUE", arg)
+		}
+		req.InputData[field] = value
+	}
+
+	inputID := fs.Arg(0)
+	if err := c.call(ctx, http.MethodPost, "/users/me/pending-inputs/"+url.PathEscape(inputID)+"/submit", nil, req, nil); err != nil {
+		return err
+	}
+	fmt.Printf("resolved %s\n", inputID)
+	return nil
+}
+
+func runRepost(ctx context.Context, c *client, args []string) error {
+	fs := flag.NewFlagSet("repost", flag.ExitOnError)
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: fitglue repost ACTIVITY_ID")
+	}
+
+	activityID := fs.Arg(0)
+	if err := c.call(ctx, http.MethodPost, "/users/me/activities/"+url.PathEscape(activityID)+"/repost", nil, nil, nil); err != nil {
+		return err
+	}
+	fmt.Printf("repost of %s queued\n", activityID)
+	return nil
+}
+
+// enumLabel turns e.g. PIPELINE_RUN_STATUS_SYNCED into "synced".
+func enumLabel(name, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
+}
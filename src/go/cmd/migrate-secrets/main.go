@@ -0,0 +1,55 @@
+// migrate-secrets moves the Hevy and Intervals API keys left in user
+// documents, and the WebDAV passwords left in pipeline configs and plugin
+// defaults, into users/{uid}/integration_secrets. Services fall back to the
+// user document for API keys until then, but WebDAV uploads need the move, so
+// run it once per project when deploying; it is safe to run again.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	"cloud.google.com/go/firestore"
+
+	storage "github.com/fitglue/server/src/go/pkg/storage/firestore"
+)
+
+func main() {
+	projectID := flag.String("project", os.Getenv("GOOGLE_CLOUD_PROJECT"), "GCP project holding the users collection")
+	keyName := flag.String("key", os.Getenv(storage.TokenKeyEnv), "Cloud KMS key name (projects/.../cryptoKeys/...) used to encrypt plaintext keys; optional")
+	flag.Parse()
+
+	if *projectID == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+	if *keyName != "" {
+		wrapper, err := storage.NewKMSKeyWrapper(ctx, *keyName)
+		if err != nil {
+			log.Fatalf("Failed to create KMS client: %v", err)
+		}
+		storage.SetTokenCipher(storage.NewTokenCipher(wrapper))
+	}
+
+	client, err := firestore.NewClient(ctx, *projectID)
+	if err != nil {
+		log.Fatalf("Failed to create Firestore client: %v", err)
+	}
+	defer client.Close()
+
+	migrated, err := storage.MigrateIntegrationSecrets(ctx, client)
+	if err != nil {
+		log.Fatalf("Migration stopped after %d users: %v", migrated, err)
+	}
+	log.Printf("Moved API keys for %d users", migrated)
+
+	moved, err := storage.MigrateDestinationSecrets(ctx, client)
+	if err != nil {
+		log.Fatalf("Destination migration stopped after %d documents: %v", moved, err)
+	}
+	log.Printf("Moved destination credentials out of %d documents", moved)
+}
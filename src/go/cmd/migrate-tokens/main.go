@@ -0,0 +1,46 @@
+// migrate-tokens encrypts the plaintext integration tokens left in user
+// documents from before token encryption was enabled. Services already read
+// plaintext tokens and encrypt them on the next write, so this only needs to
+// run once per project and is safe to run again.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	"cloud.google.com/go/firestore"
+
+	storage "github.com/fitglue/server/src/go/pkg/storage/firestore"
+)
+
+func main() {
+	projectID := flag.String("project", os.Getenv("GOOGLE_CLOUD_PROJECT"), "GCP project holding the users collection")
+	keyName := flag.String("key", os.Getenv(storage.TokenKeyEnv), "Cloud KMS key name (projects/.../cryptoKeys/...)")
+	flag.Parse()
+
+	if *projectID == "" || *keyName == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+	wrapper, err := storage.NewKMSKeyWrapper(ctx, *keyName)
+	if err != nil {
+		log.Fatalf("Failed to create KMS client: %v", err)
+	}
+	storage.SetTokenCipher(storage.NewTokenCipher(wrapper))
+
+	client, err := firestore.NewClient(ctx, *projectID)
+	if err != nil {
+		log.Fatalf("Failed to create Firestore client: %v", err)
+	}
+	defer client.Close()
+
+	migrated, err := storage.MigrateUserTokens(ctx, client)
+	if err != nil {
+		log.Fatalf("Migration stopped after %d users: %v", migrated, err)
+	}
+	log.Printf("Encrypted tokens for %d users", migrated)
+}
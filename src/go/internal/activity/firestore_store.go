@@ -4,6 +4,8 @@ package activity
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"cloud.google.com/go/firestore"
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
@@ -414,6 +416,132 @@ func (s *FirestoreStore) DeleteShowcaseProfileEntry(ctx context.Context, userID,
 	return err
 }
 
+func (s *FirestoreStore) GetShowcaseComparison(ctx context.Context, id string) (*pbactivity.ShowcaseComparison, error) {
+	doc, err := s.client.Collection("showcase_comparisons").Doc(id).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var comparison pbactivity.ShowcaseComparison
+	if err := decodeProtoMap(doc.Data(), &comparison); err != nil {
+		return nil, err
+	}
+	return &comparison, nil
+}
+
+func (s *FirestoreStore) SetShowcaseComparison(ctx context.Context, comparison *pbactivity.ShowcaseComparison) error {
+	data, err := encodeProtoMap(comparison)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.Collection("showcase_comparisons").Doc(comparison.Id).Set(ctx, data)
+	return err
+}
+
+func (s *FirestoreStore) ListShowcaseComparisons(ctx context.Context) ([]*pbactivity.ShowcaseComparison, error) {
+	iter := s.client.Collection("showcase_comparisons").Documents(ctx)
+	defer iter.Stop()
+
+	var comparisons []*pbactivity.ShowcaseComparison
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var comparison pbactivity.ShowcaseComparison
+		if err := decodeProtoMap(doc.Data(), &comparison); err != nil {
+			return nil, err
+		}
+		comparisons = append(comparisons, &comparison)
+	}
+	return comparisons, nil
+}
+
+func (s *FirestoreStore) DeleteShowcaseComparison(ctx context.Context, id string) error {
+	_, err := s.client.Collection("showcase_comparisons").Doc(id).Delete(ctx)
+	return err
+}
+
+func (s *FirestoreStore) RecordShowcaseComparisonRequest(ctx context.Context, id string, at time.Time, expiry time.Duration) (int64, error) {
+	ref := s.client.Collection("showcase_comparison_requests").Doc(id)
+	var count int64
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		count = 0
+		doc, err := tx.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			if n, ok := doc.Data()["count"].(int64); ok {
+				count = n
+			}
+		}
+		count++
+		return tx.Set(ref, map[string]interface{}{
+			"count":             count,
+			"last_requested_at": at,
+			// Cleared by the collection's TTL policy if not asked for again
+			"expires_at": at.Add(expiry),
+		})
+	})
+	return count, err
+}
+
+func (s *FirestoreStore) IncrementShowcaseComparisonHits(ctx context.Context, slug string, window time.Time) (int64, error) {
+	ref := s.client.Collection("showcase_comparison_hits").Doc(fmt.Sprintf("%s_%d", slug, window.Unix()))
+	var count int64
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		count = 0
+		doc, err := tx.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			if n, ok := doc.Data()["count"].(int64); ok {
+				count = n
+			}
+		}
+		count++
+		return tx.Set(ref, map[string]interface{}{
+			"slug":  slug,
+			"count": count,
+			// Cleared by the collection's TTL policy once the window is long past
+			"expires_at": window.Add(24 * time.Hour),
+		})
+	})
+	return count, err
+}
+
+func (s *FirestoreStore) IncrementShowcaseEmbedHits(ctx context.Context, userID string, window time.Time) (int64, error) {
+	ref := s.client.Collection("showcase_embed_hits").Doc(fmt.Sprintf("%s_%d", userID, window.Unix()))
+	var count int64
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		count = 0
+		doc, err := tx.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			if n, ok := doc.Data()["count"].(int64); ok {
+				count = n
+			}
+		}
+		count++
+		return tx.Set(ref, map[string]interface{}{
+			"user_id": userID,
+			"count":   count,
+			// Cleared by the collection's TTL policy once the window is long past
+			"expires_at": window.Add(24 * time.Hour),
+		})
+	})
+	return count, err
+}
+
 // Helpers
 func encodeProtoMap(msg protoreflect.ProtoMessage) (map[string]interface{}, error) {
 	b, err := protojson.MarshalOptions{EmitUnpopulated: true, UseProtoNames: true}.Marshal(msg)
@@ -33,6 +33,15 @@ type MockActivityStore struct {
 	ListShowcaseProfileEntriesFunc func(ctx context.Context, userID string) ([]*pbactivity.ShowcaseProfileEntry, error)
 	SetShowcaseProfileEntryFunc    func(ctx context.Context, userID string, entry *pbactivity.ShowcaseProfileEntry) error
 	DeleteShowcaseProfileEntryFunc func(ctx context.Context, userID, showcaseID string) error
+
+	GetShowcaseComparisonFunc    func(ctx context.Context, id string) (*pbactivity.ShowcaseComparison, error)
+	SetShowcaseComparisonFunc    func(ctx context.Context, comparison *pbactivity.ShowcaseComparison) error
+	ListShowcaseComparisonsFunc  func(ctx context.Context) ([]*pbactivity.ShowcaseComparison, error)
+	DeleteShowcaseComparisonFunc func(ctx context.Context, id string) error
+
+	IncrementShowcaseEmbedHitsFunc      func(ctx context.Context, userID string, window time.Time) (int64, error)
+	RecordShowcaseComparisonRequestFunc func(ctx context.Context, id string, at time.Time, expiry time.Duration) (int64, error)
+	IncrementShowcaseComparisonHitsFunc func(ctx context.Context, slug string, window time.Time) (int64, error)
 }
 
 func (m *MockActivityStore) GetPipelineRun(ctx context.Context, userID, runID string) (*pbpipeline.PipelineRun, error) {
@@ -168,6 +177,55 @@ func (m *MockActivityStore) DeleteShowcaseProfileEntry(ctx context.Context, user
 	return nil
 }
 
+func (m *MockActivityStore) GetShowcaseComparison(ctx context.Context, id string) (*pbactivity.ShowcaseComparison, error) {
+	if m.GetShowcaseComparisonFunc != nil {
+		return m.GetShowcaseComparisonFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockActivityStore) SetShowcaseComparison(ctx context.Context, comparison *pbactivity.ShowcaseComparison) error {
+	if m.SetShowcaseComparisonFunc != nil {
+		return m.SetShowcaseComparisonFunc(ctx, comparison)
+	}
+	return nil
+}
+
+func (m *MockActivityStore) ListShowcaseComparisons(ctx context.Context) ([]*pbactivity.ShowcaseComparison, error) {
+	if m.ListShowcaseComparisonsFunc != nil {
+		return m.ListShowcaseComparisonsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockActivityStore) DeleteShowcaseComparison(ctx context.Context, id string) error {
+	if m.DeleteShowcaseComparisonFunc != nil {
+		return m.DeleteShowcaseComparisonFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockActivityStore) RecordShowcaseComparisonRequest(ctx context.Context, id string, at time.Time, expiry time.Duration) (int64, error) {
+	if m.RecordShowcaseComparisonRequestFunc != nil {
+		return m.RecordShowcaseComparisonRequestFunc(ctx, id, at, expiry)
+	}
+	return 1, nil
+}
+
+func (m *MockActivityStore) IncrementShowcaseComparisonHits(ctx context.Context, slug string, window time.Time) (int64, error) {
+	if m.IncrementShowcaseComparisonHitsFunc != nil {
+		return m.IncrementShowcaseComparisonHitsFunc(ctx, slug, window)
+	}
+	return 1, nil
+}
+
+func (m *MockActivityStore) IncrementShowcaseEmbedHits(ctx context.Context, userID string, window time.Time) (int64, error) {
+	if m.IncrementShowcaseEmbedHitsFunc != nil {
+		return m.IncrementShowcaseEmbedHitsFunc(ctx, userID, window)
+	}
+	return 1, nil
+}
+
 // MockBlobStore implements BlobStore for testing
 type MockBlobStore struct {
 	GetFunc       func(ctx context.Context, bucket, object string) ([]byte, error)
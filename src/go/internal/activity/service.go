@@ -13,6 +13,7 @@ type Service struct {
 	publisher            Publisher
 	bucketName           string
 	showcaseAssetsBucket string
+	embedSigningKey      []byte
 	logger               infra.Logger
 }
 
@@ -0,0 +1,276 @@
+package activity
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// comparisonWeeks is how many weeks a comparison covers, ending with the
+	// current week.
+	comparisonWeeks = 12
+	week            = 7 * 24 * time.Hour
+
+	// comparisonUnusedExpiry is how long a stored comparison, or the count of
+	// requests for one that isn't stored, is kept without being asked for.
+	comparisonUnusedExpiry = 30 * 24 * time.Hour
+	// comparisonTouchInterval limits how often serving a stored comparison
+	// rewrites its last_requested_at.
+	comparisonTouchInterval = 24 * time.Hour
+	// comparisonHourlyLimit caps the comparisons computed on request for each
+	// profile every hour, so pairs can't be enumerated to run up reads.
+	// Stored comparisons are served without limit.
+	comparisonHourlyLimit = 60
+)
+
+// showcaseComparisonID keys a comparison by both slugs, sorted, so A vs B
+// and B vs A share a document. Slugs never contain underscores.
+func showcaseComparisonID(slugA, slugB string) string {
+	slugs := []string{slugA, slugB}
+	sort.Strings(slugs)
+	return strings.Join(slugs, "__")
+}
+
+// GetPublicShowcaseComparison returns a head-to-head of two public showcase
+// profiles, with slug_a's profile as side A. Pairs asked for more than once
+// are stored and refreshed nightly; others are computed on each request,
+// which is rate limited per profile.
+func (s *Service) GetPublicShowcaseComparison(ctx context.Context, req *pbsvc.GetPublicShowcaseComparisonRequest) (*pbactivity.ShowcaseComparison, error) {
+	slugA := strings.ToLower(strings.TrimSpace(req.SlugA))
+	slugB := strings.ToLower(strings.TrimSpace(req.SlugB))
+	if slugA == "" || slugB == "" {
+		return nil, status.Error(codes.InvalidArgument, "slug_a and slug_b are required")
+	}
+	if slugA == slugB {
+		return nil, status.Error(codes.InvalidArgument, "a profile cannot be compared with itself")
+	}
+
+	// Visibility is checked on every read so hiding a profile takes effect
+	// before the nightly refresh removes its comparisons
+	profileA, profileB, err := s.comparisonProfiles(ctx, slugA, slugB)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	id := showcaseComparisonID(slugA, slugB)
+	comparison, err := s.store.GetShowcaseComparison(ctx, id)
+	if err != nil {
+		s.logger.Error(ctx, "failed to read showcase comparison", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to read comparison")
+	}
+	if comparison != nil {
+		if last := comparison.LastRequestedAt; last == nil || now.Sub(last.AsTime()) >= comparisonTouchInterval {
+			comparison.LastRequestedAt = timestamppb.New(now)
+			if err := s.store.SetShowcaseComparison(ctx, comparison); err != nil {
+				s.logger.Warn(ctx, "failed to update showcase comparison", "error", err, "id", id)
+			}
+		}
+		return orientShowcaseComparison(comparison, slugA), nil
+	}
+
+	window := now.UTC().Truncate(time.Hour)
+	for _, slug := range []string{slugA, slugB} {
+		hits, err := s.store.IncrementShowcaseComparisonHits(ctx, slug, window)
+		if err != nil {
+			s.logger.Warn(ctx, "failed to count showcase comparison request", "error", err, "slug", slug)
+		} else if hits > comparisonHourlyLimit {
+			return nil, status.Error(codes.ResourceExhausted, "comparison rate limit reached, try again later")
+		}
+	}
+
+	comparison, err = s.computeShowcaseComparison(ctx, profileA, profileB, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// One-off pairs aren't stored, so stored comparisons grow with the pairs
+	// people come back to rather than every pair anyone tried
+	requests, err := s.store.RecordShowcaseComparisonRequest(ctx, id, now, comparisonUnusedExpiry)
+	if err != nil {
+		s.logger.Warn(ctx, "failed to count showcase comparison request", "error", err, "id", id)
+	} else if requests > 1 {
+		comparison.LastRequestedAt = timestamppb.New(now)
+		if err := s.store.SetShowcaseComparison(ctx, comparison); err != nil {
+			s.logger.Warn(ctx, "failed to store showcase comparison", "error", err, "id", id)
+		}
+	}
+
+	return orientShowcaseComparison(comparison, slugA), nil
+}
+
+// RefreshShowcaseComparisons recomputes every stored comparison. Comparisons
+// not asked for in comparisonUnusedExpiry, or whose profiles were hidden or
+// renamed, are removed; they are computed again if someone asks for them.
+func (s *Service) RefreshShowcaseComparisons(ctx context.Context, req *pbsvc.RefreshShowcaseComparisonsRequest) (*pbsvc.RefreshShowcaseComparisonsResponse, error) {
+	comparisons, err := s.store.ListShowcaseComparisons(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list showcase comparisons", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list comparisons")
+	}
+
+	now := time.Now()
+	resp := &pbsvc.RefreshShowcaseComparisonsResponse{}
+	for _, stored := range comparisons {
+		unused := stored.LastRequestedAt == nil || now.Sub(stored.LastRequestedAt.AsTime()) > comparisonUnusedExpiry
+		profileA, profileB, err := s.comparisonProfiles(ctx, stored.GetA().GetSlug(), stored.GetB().GetSlug())
+		if unused || status.Code(err) == codes.NotFound || status.Code(err) == codes.InvalidArgument {
+			if err := s.store.DeleteShowcaseComparison(ctx, stored.Id); err != nil {
+				s.logger.Warn(ctx, "failed to remove showcase comparison", "error", err, "id", stored.Id)
+				continue
+			}
+			resp.Removed++
+			continue
+		}
+		if err != nil {
+			s.logger.Warn(ctx, "failed to read profiles for showcase comparison", "error", err, "id", stored.Id)
+			continue
+		}
+
+		comparison, err := s.computeShowcaseComparison(ctx, profileA, profileB, now)
+		if err != nil {
+			s.logger.Warn(ctx, "failed to compute showcase comparison", "error", err, "id", stored.Id)
+			continue
+		}
+		comparison.LastRequestedAt = stored.LastRequestedAt
+		if err := s.store.SetShowcaseComparison(ctx, comparison); err != nil {
+			s.logger.Warn(ctx, "failed to store showcase comparison", "error", err, "id", stored.Id)
+			continue
+		}
+		resp.Refreshed++
+	}
+
+	s.logger.Info(ctx, "Refreshed showcase comparisons", "refreshed", resp.Refreshed, "removed", resp.Removed)
+	return resp, nil
+}
+
+// comparisonProfiles looks up both profiles, failing with NotFound unless
+// both are public.
+func (s *Service) comparisonProfiles(ctx context.Context, slugA, slugB string) (*pbactivity.ShowcaseProfile, *pbactivity.ShowcaseProfile, error) {
+	if slugA == "" || slugB == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "slug_a and slug_b are required")
+	}
+	var profiles [2]*pbactivity.ShowcaseProfile
+	for i, slug := range []string{slugA, slugB} {
+		profile, err := s.store.GetShowcaseProfileBySlug(ctx, slug)
+		if err != nil {
+			s.logger.Error(ctx, "failed to get showcase profile by slug", "error", err)
+			return nil, nil, status.Error(codes.Internal, "failed to read showcase profile")
+		}
+		if profile == nil || !profile.Visible {
+			return nil, nil, status.Error(codes.NotFound, "showcase profile not found")
+		}
+		profiles[i] = profile
+	}
+	return profiles[0], profiles[1], nil
+}
+
+// computeShowcaseComparison builds the stored form of a comparison, with the
+// profiles in slug order.
+func (s *Service) computeShowcaseComparison(ctx context.Context, profileA, profileB *pbactivity.ShowcaseProfile, now time.Time) (*pbactivity.ShowcaseComparison, error) {
+	if profileB.Slug < profileA.Slug {
+		profileA, profileB = profileB, profileA
+	}
+	entriesA, err := s.store.ListShowcaseProfileEntries(ctx, profileA.UserId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list showcase profile entries", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list profile entries")
+	}
+	entriesB, err := s.store.ListShowcaseProfileEntries(ctx, profileB.UserId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list showcase profile entries", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list profile entries")
+	}
+	return buildShowcaseComparison(profileA, entriesA, profileB, entriesB, now), nil
+}
+
+// buildShowcaseComparison compares two profiles over the comparisonWeeks
+// weeks ending with the one containing now.
+func buildShowcaseComparison(profileA *pbactivity.ShowcaseProfile, entriesA []*pbactivity.ShowcaseProfileEntry, profileB *pbactivity.ShowcaseProfile, entriesB []*pbactivity.ShowcaseProfileEntry, now time.Time) *pbactivity.ShowcaseComparison {
+	windowStart := comparisonWeekStart(now).Add(-(comparisonWeeks - 1) * week)
+	return &pbactivity.ShowcaseComparison{
+		Id:          showcaseComparisonID(profileA.Slug, profileB.Slug),
+		A:           buildComparisonSide(profileA, entriesA, windowStart),
+		B:           buildComparisonSide(profileB, entriesB, windowStart),
+		WindowStart: timestamppb.New(windowStart),
+		Weeks:       comparisonWeeks,
+		ComputedAt:  timestamppb.New(now),
+	}
+}
+
+func buildComparisonSide(profile *pbactivity.ShowcaseProfile, entries []*pbactivity.ShowcaseProfileEntry, windowStart time.Time) *pbactivity.ShowcaseComparisonSide {
+	side := &pbactivity.ShowcaseComparisonSide{
+		Slug:                 profile.Slug,
+		DisplayName:          profile.DisplayName,
+		ProfilePictureUrl:    profile.ProfilePictureUrl,
+		WeeklyDistanceMeters: make([]float64, comparisonWeeks),
+		WeeklyActivities:     make([]int32, comparisonWeeks),
+	}
+
+	for _, e := range entries {
+		if e.StartTime == nil {
+			continue
+		}
+		offset := e.StartTime.AsTime().Sub(windowStart)
+		if offset < 0 {
+			continue
+		}
+		i := int(offset / week)
+		if i >= comparisonWeeks {
+			continue
+		}
+		side.WeeklyDistanceMeters[i] += e.DistanceMeters
+		side.WeeklyActivities[i]++
+		side.PrCount += e.PrCount
+		side.TotalActivities++
+		side.TotalDistanceMeters += e.DistanceMeters
+		side.TotalDurationSeconds += e.DurationSeconds
+	}
+
+	var streak int32
+	for _, count := range side.WeeklyActivities {
+		if count == 0 {
+			streak = 0
+			continue
+		}
+		side.ActiveWeeks++
+		streak++
+		if streak > side.LongestStreakWeeks {
+			side.LongestStreakWeeks = streak
+		}
+	}
+	side.Consistency = float64(side.ActiveWeeks) / comparisonWeeks
+	return side
+}
+
+// orientShowcaseComparison returns the comparison as served, with slugA's
+// side as A and without the stored bookkeeping.
+func orientShowcaseComparison(c *pbactivity.ShowcaseComparison, slugA string) *pbactivity.ShowcaseComparison {
+	a, b := c.A, c.B
+	if c.GetA().GetSlug() != slugA {
+		a, b = b, a
+	}
+	return &pbactivity.ShowcaseComparison{
+		Id:          c.Id,
+		A:           a,
+		B:           b,
+		WindowStart: c.WindowStart,
+		Weeks:       c.Weeks,
+		ComputedAt:  c.ComputedAt,
+	}
+}
+
+// comparisonWeekStart returns the Monday 00:00 UTC on or before t.
+func comparisonWeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+}
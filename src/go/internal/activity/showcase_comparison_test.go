@@ -0,0 +1,245 @@
+package activity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBuildShowcaseComparison(t *testing.T) {
+	// Wednesday; the current week starts Monday 2026-06-15
+	now := time.Date(2026, 6, 17, 12, 0, 0, 0, time.UTC)
+	thisWeek := time.Date(2026, 6, 15, 9, 0, 0, 0, time.UTC)
+	entry := func(weeksAgo int, meters float64, prs int32) *pbactivity.ShowcaseProfileEntry {
+		return &pbactivity.ShowcaseProfileEntry{
+			StartTime:       timestamppb.New(thisWeek.AddDate(0, 0, -7*weeksAgo)),
+			DistanceMeters:  meters,
+			DurationSeconds: 1800,
+			PrCount:         prs,
+		}
+	}
+
+	alice := &pbactivity.ShowcaseProfile{Slug: "alice", DisplayName: "Alice"}
+	bob := &pbactivity.ShowcaseProfile{Slug: "bob", DisplayName: "Bob"}
+	c := buildShowcaseComparison(alice, []*pbactivity.ShowcaseProfileEntry{
+		entry(0, 5000, 1),
+		entry(0, 3000, 0),
+		entry(1, 10000, 2),
+		entry(3, 4000, 0),
+		entry(12, 42000, 5), // before the window
+	}, bob, nil, now)
+
+	assert.Equal(t, "alice__bob", c.Id)
+	assert.Equal(t, int32(comparisonWeeks), c.Weeks)
+	assert.Equal(t, time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC), c.WindowStart.AsTime())
+
+	a := c.A
+	require.Len(t, a.WeeklyDistanceMeters, comparisonWeeks)
+	assert.Equal(t, 8000.0, a.WeeklyDistanceMeters[11])
+	assert.Equal(t, int32(2), a.WeeklyActivities[11])
+	assert.Equal(t, 10000.0, a.WeeklyDistanceMeters[10])
+	assert.Equal(t, 4000.0, a.WeeklyDistanceMeters[8])
+	assert.Equal(t, int32(3), a.PrCount)
+	assert.Equal(t, int32(4), a.TotalActivities)
+	assert.Equal(t, 22000.0, a.TotalDistanceMeters)
+	assert.Equal(t, int32(3), a.ActiveWeeks)
+	assert.Equal(t, int32(2), a.LongestStreakWeeks)
+	assert.InDelta(t, 0.25, a.Consistency, 1e-9)
+
+	assert.Equal(t, "bob", c.B.Slug)
+	assert.Zero(t, c.B.ActiveWeeks)
+	assert.Len(t, c.B.WeeklyActivities, comparisonWeeks)
+}
+
+func TestGetPublicShowcaseComparison(t *testing.T) {
+	ctx := context.Background()
+	profiles := map[string]*pbactivity.ShowcaseProfile{
+		"alice": {UserId: "u-alice", Slug: "alice", Visible: true},
+		"bob":   {UserId: "u-bob", Slug: "bob", Visible: true},
+		"carol": {UserId: "u-carol", Slug: "carol", Visible: false},
+	}
+	newStore := func() *MockActivityStore {
+		store := &MockActivityStore{}
+		store.GetShowcaseProfileBySlugFunc = func(ctx context.Context, slug string) (*pbactivity.ShowcaseProfile, error) {
+			return profiles[slug], nil
+		}
+		return store
+	}
+
+	t.Run("InvalidSlugs", func(t *testing.T) {
+		svc := newTestService(newStore(), &MockBlobStore{})
+		_, err := svc.GetPublicShowcaseComparison(ctx, &pbsvc.GetPublicShowcaseComparisonRequest{SlugA: "alice"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = svc.GetPublicShowcaseComparison(ctx, &pbsvc.GetPublicShowcaseComparisonRequest{SlugA: "alice", SlugB: " Alice"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("HiddenProfile", func(t *testing.T) {
+		store := newStore()
+		store.GetShowcaseComparisonFunc = func(ctx context.Context, id string) (*pbactivity.ShowcaseComparison, error) {
+			t.Fatal("a stored comparison must not be served for a hidden profile")
+			return nil, nil
+		}
+		svc := newTestService(store, &MockBlobStore{})
+		_, err := svc.GetPublicShowcaseComparison(ctx, &pbsvc.GetPublicShowcaseComparisonRequest{SlugA: "alice", SlugB: "carol"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	entriesFunc := func(ctx context.Context, userID string) ([]*pbactivity.ShowcaseProfileEntry, error) {
+		if userID != "u-bob" {
+			return nil, nil
+		}
+		return []*pbactivity.ShowcaseProfileEntry{{StartTime: timestamppb.Now(), DistanceMeters: 5000}}, nil
+	}
+
+	t.Run("ComputesWithoutStoringOnFirstRequest", func(t *testing.T) {
+		store := newStore()
+		store.SetShowcaseComparisonFunc = func(ctx context.Context, c *pbactivity.ShowcaseComparison) error {
+			t.Fatal("a pair asked for once must not be stored")
+			return nil
+		}
+		store.ListShowcaseProfileEntriesFunc = entriesFunc
+		svc := newTestService(store, &MockBlobStore{})
+
+		res, err := svc.GetPublicShowcaseComparison(ctx, &pbsvc.GetPublicShowcaseComparisonRequest{SlugA: "Bob", SlugB: "alice"})
+		require.NoError(t, err)
+		assert.Equal(t, "bob", res.A.Slug, "responses put slug_a first")
+		assert.Equal(t, 5000.0, res.A.TotalDistanceMeters)
+		assert.Equal(t, "alice", res.B.Slug)
+	})
+
+	t.Run("StoresOnRepeatRequest", func(t *testing.T) {
+		store := newStore()
+		var stored *pbactivity.ShowcaseComparison
+		store.SetShowcaseComparisonFunc = func(ctx context.Context, c *pbactivity.ShowcaseComparison) error {
+			stored = c
+			return nil
+		}
+		store.RecordShowcaseComparisonRequestFunc = func(ctx context.Context, id string, at time.Time, expiry time.Duration) (int64, error) {
+			assert.Equal(t, "alice__bob", id)
+			assert.Equal(t, comparisonUnusedExpiry, expiry)
+			return 2, nil
+		}
+		store.ListShowcaseProfileEntriesFunc = entriesFunc
+		svc := newTestService(store, &MockBlobStore{})
+
+		res, err := svc.GetPublicShowcaseComparison(ctx, &pbsvc.GetPublicShowcaseComparisonRequest{SlugA: "Bob", SlugB: "alice"})
+		require.NoError(t, err)
+		require.NotNil(t, stored)
+		assert.Equal(t, "alice__bob", stored.Id)
+		assert.Equal(t, "alice", stored.A.Slug, "stored comparisons are in slug order")
+		assert.NotNil(t, stored.LastRequestedAt)
+		assert.Nil(t, res.LastRequestedAt, "bookkeeping is not returned")
+		assert.Equal(t, "bob", res.A.Slug)
+	})
+
+	t.Run("RateLimitsComputedComparisons", func(t *testing.T) {
+		store := newStore()
+		store.IncrementShowcaseComparisonHitsFunc = func(ctx context.Context, slug string, window time.Time) (int64, error) {
+			if slug == "bob" {
+				return comparisonHourlyLimit + 1, nil
+			}
+			return 1, nil
+		}
+		store.ListShowcaseProfileEntriesFunc = func(ctx context.Context, userID string) ([]*pbactivity.ShowcaseProfileEntry, error) {
+			t.Fatal("nothing should be computed over the limit")
+			return nil, nil
+		}
+		svc := newTestService(store, &MockBlobStore{})
+
+		_, err := svc.GetPublicShowcaseComparison(ctx, &pbsvc.GetPublicShowcaseComparisonRequest{SlugA: "alice", SlugB: "bob"})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("ServesStoredComparison", func(t *testing.T) {
+		store := newStore()
+		lastRequested := time.Now().Add(-time.Hour)
+		store.GetShowcaseComparisonFunc = func(ctx context.Context, id string) (*pbactivity.ShowcaseComparison, error) {
+			assert.Equal(t, "alice__bob", id)
+			return &pbactivity.ShowcaseComparison{
+				Id:              id,
+				A:               &pbactivity.ShowcaseComparisonSide{Slug: "alice", PrCount: 4},
+				B:               &pbactivity.ShowcaseComparisonSide{Slug: "bob"},
+				LastRequestedAt: timestamppb.New(lastRequested),
+			}, nil
+		}
+		var touched *pbactivity.ShowcaseComparison
+		store.SetShowcaseComparisonFunc = func(ctx context.Context, c *pbactivity.ShowcaseComparison) error {
+			touched = c
+			return nil
+		}
+		store.IncrementShowcaseComparisonHitsFunc = func(ctx context.Context, slug string, window time.Time) (int64, error) {
+			t.Fatal("stored comparisons are not rate limited")
+			return 0, nil
+		}
+		store.ListShowcaseProfileEntriesFunc = func(ctx context.Context, userID string) ([]*pbactivity.ShowcaseProfileEntry, error) {
+			t.Fatal("stored comparisons must not be recomputed")
+			return nil, nil
+		}
+		svc := newTestService(store, &MockBlobStore{})
+
+		res, err := svc.GetPublicShowcaseComparison(ctx, &pbsvc.GetPublicShowcaseComparisonRequest{SlugA: "alice", SlugB: "bob"})
+		require.NoError(t, err)
+		assert.Equal(t, int32(4), res.A.PrCount)
+		assert.Nil(t, touched, "last_requested_at is only rewritten once a day")
+
+		lastRequested = time.Now().Add(-2 * comparisonTouchInterval)
+		_, err = svc.GetPublicShowcaseComparison(ctx, &pbsvc.GetPublicShowcaseComparisonRequest{SlugA: "alice", SlugB: "bob"})
+		require.NoError(t, err)
+		require.NotNil(t, touched)
+		assert.WithinDuration(t, time.Now(), touched.LastRequestedAt.AsTime(), time.Minute)
+	})
+}
+
+func TestRefreshShowcaseComparisons(t *testing.T) {
+	ctx := context.Background()
+	store := &MockActivityStore{}
+	store.GetShowcaseProfileBySlugFunc = func(ctx context.Context, slug string) (*pbactivity.ShowcaseProfile, error) {
+		switch slug {
+		case "alice", "bob", "erin":
+			return &pbactivity.ShowcaseProfile{UserId: "u-" + slug, Slug: slug, Visible: true}, nil
+		case "carol":
+			return &pbactivity.ShowcaseProfile{UserId: "u-carol", Slug: slug, Visible: false}, nil
+		}
+		return nil, nil
+	}
+	store.ListShowcaseComparisonsFunc = func(ctx context.Context) ([]*pbactivity.ShowcaseComparison, error) {
+		side := func(slug string) *pbactivity.ShowcaseComparisonSide {
+			return &pbactivity.ShowcaseComparisonSide{Slug: slug}
+		}
+		recent := timestamppb.New(time.Now().Add(-24 * time.Hour))
+		return []*pbactivity.ShowcaseComparison{
+			{Id: "alice__bob", A: side("alice"), B: side("bob"), LastRequestedAt: recent},
+			{Id: "alice__carol", A: side("alice"), B: side("carol"), LastRequestedAt: recent},
+			{Id: "alice__erin", A: side("alice"), B: side("erin"), LastRequestedAt: timestamppb.New(time.Now().Add(-comparisonUnusedExpiry - time.Hour))},
+			{Id: "bob__dave", A: side("bob"), B: side("dave"), LastRequestedAt: recent},
+			{Id: "bob__erin", A: side("bob"), B: side("erin")},
+		}, nil
+	}
+	var set, deleted []string
+	store.SetShowcaseComparisonFunc = func(ctx context.Context, c *pbactivity.ShowcaseComparison) error {
+		set = append(set, c.Id)
+		assert.NotNil(t, c.LastRequestedAt, "refreshing keeps when the pair was last asked for")
+		return nil
+	}
+	store.DeleteShowcaseComparisonFunc = func(ctx context.Context, id string) error {
+		deleted = append(deleted, id)
+		return nil
+	}
+	svc := newTestService(store, &MockBlobStore{})
+
+	res, err := svc.RefreshShowcaseComparisons(ctx, &pbsvc.RefreshShowcaseComparisonsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), res.Refreshed)
+	assert.Equal(t, int32(4), res.Removed)
+	assert.Equal(t, []string{"alice__bob"}, set)
+	assert.Equal(t, []string{"alice__carol", "alice__erin", "bob__dave", "bob__erin"}, deleted, "hidden, renamed and unused pairs are removed")
+}
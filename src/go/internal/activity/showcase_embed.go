@@ -0,0 +1,240 @@
+package activity
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	showcaseEmbedSVGContentType  = "image/svg+xml; charset=utf-8"
+	showcaseEmbedHTMLContentType = "text/html; charset=utf-8"
+
+	// embedLinkTTL is how long a signed embed link works. Embeds live in blog
+	// posts that are rarely revisited, so links are long-lived; rotating the
+	// signing key revokes every link at once.
+	embedLinkTTL = 365 * 24 * time.Hour
+
+	// embedSignedHourlyLimit caps signed embed requests per profile each hour
+	// so a leaked link to a hidden profile can't be scraped freely.
+	embedSignedHourlyLimit = 300
+
+	embedTitleMaxRunes = 48
+)
+
+// SetEmbedSigningKey sets the HMAC key for embed links to hidden profiles.
+// Without one, hidden profiles can't be embedded.
+func (s *Service) SetEmbedSigningKey(key string) {
+	s.embedSigningKey = []byte(key)
+}
+
+// GetPublicShowcaseEmbed renders a small card of one profile entry, the
+// latest by default, as SVG for <img> tags or as an HTML page for iframes.
+// Hidden profiles are only rendered for a valid signed link, which is rate
+// limited per profile.
+func (s *Service) GetPublicShowcaseEmbed(ctx context.Context, req *pbsvc.GetPublicShowcaseEmbedRequest) (*pbsvc.GetPublicShowcaseEmbedResponse, error) {
+	slug := strings.ToLower(strings.TrimSpace(req.Slug))
+	if slug == "" {
+		return nil, status.Error(codes.InvalidArgument, "slug is required")
+	}
+	format := strings.ToLower(req.Format)
+	if format != "" && format != "svg" && format != "html" {
+		return nil, status.Error(codes.InvalidArgument, "format must be svg or html")
+	}
+
+	profile, err := s.store.GetShowcaseProfileBySlug(ctx, slug)
+	if err != nil {
+		s.logger.Error(ctx, "failed to get showcase profile by slug", "error", err)
+		return nil, status.Error(codes.Internal, "failed to read showcase profile")
+	}
+	if profile == nil {
+		return nil, status.Error(codes.NotFound, "showcase profile not found")
+	}
+
+	now := time.Now()
+	signed := false
+	if !profile.Visible {
+		// Hidden profiles look absent unless the link is signed
+		if !s.validEmbedSignature(profile.UserId, req.ShowcaseId, req.Expires, req.Signature, now) {
+			return nil, status.Error(codes.NotFound, "showcase profile not found")
+		}
+		signed = true
+
+		hits, err := s.store.IncrementShowcaseEmbedHits(ctx, profile.UserId, now.UTC().Truncate(time.Hour))
+		if err != nil {
+			s.logger.Warn(ctx, "failed to count showcase embed request", "error", err, "user_id", profile.UserId)
+		} else if hits > embedSignedHourlyLimit {
+			return nil, status.Error(codes.ResourceExhausted, "embed rate limit reached, try again later")
+		}
+	}
+
+	entries, err := s.store.ListShowcaseProfileEntries(ctx, profile.UserId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list showcase profile entries", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list profile entries")
+	}
+	entry := findEmbedEntry(entries, req.ShowcaseId)
+	if entry == nil {
+		return nil, status.Error(codes.NotFound, "showcase entry not found")
+	}
+
+	body := buildShowcaseEmbedSVG(profile, entry, entries, now)
+	contentType := showcaseEmbedSVGContentType
+	if format == "html" {
+		body = buildShowcaseEmbedHTML(entry, body)
+		contentType = showcaseEmbedHTMLContentType
+	}
+
+	sum := sha256.Sum256([]byte(body))
+	return &pbsvc.GetPublicShowcaseEmbedResponse{
+		ContentType: contentType,
+		Body:        body,
+		Etag:        `"` + hex.EncodeToString(sum[:16]) + `"`,
+		Signed:      signed,
+	}, nil
+}
+
+// CreateShowcaseEmbedLink signs embed link parameters for the user's
+// profile, so it can be embedded while hidden.
+func (s *Service) CreateShowcaseEmbedLink(ctx context.Context, req *pbsvc.CreateShowcaseEmbedLinkRequest) (*pbsvc.CreateShowcaseEmbedLinkResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if len(s.embedSigningKey) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "embed links are not configured")
+	}
+
+	profile, err := s.store.GetShowcasePreferences(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to get showcase preferences", "error", err)
+		return nil, status.Error(codes.Internal, "failed to read showcase profile")
+	}
+	if profile == nil || profile.Slug == "" {
+		return nil, status.Error(codes.FailedPrecondition, "set a showcase profile URL before creating embed links")
+	}
+
+	if req.ShowcaseId != "" {
+		entries, err := s.store.ListShowcaseProfileEntries(ctx, req.UserId)
+		if err != nil {
+			s.logger.Error(ctx, "failed to list showcase profile entries", "error", err)
+			return nil, status.Error(codes.Internal, "failed to list profile entries")
+		}
+		if findEmbedEntry(entries, req.ShowcaseId) == nil {
+			return nil, status.Error(codes.NotFound, "showcase entry not found")
+		}
+	}
+
+	expires := time.Now().Add(embedLinkTTL).Unix()
+	return &pbsvc.CreateShowcaseEmbedLinkResponse{
+		Slug:       profile.Slug,
+		ShowcaseId: req.ShowcaseId,
+		Expires:    expires,
+		Signature:  s.signEmbedLink(req.UserId, req.ShowcaseId, expires),
+	}, nil
+}
+
+// signEmbedLink signs the owner rather than the slug, so a link stops working
+// if the slug is later claimed by someone else.
+func (s *Service) signEmbedLink(userID, showcaseID string, expires int64) string {
+	mac := hmac.New(sha256.New, s.embedSigningKey)
+	fmt.Fprintf(mac, "%s\n%s\n%d", userID, showcaseID, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Service) validEmbedSignature(userID, showcaseID string, expires int64, signature string, now time.Time) bool {
+	if len(s.embedSigningKey) == 0 || signature == "" || expires < now.Unix() {
+		return false
+	}
+	expected := s.signEmbedLink(userID, showcaseID, expires)
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
+
+// findEmbedEntry returns the entry with showcaseID, or the most recent entry
+// when showcaseID is empty.
+func findEmbedEntry(entries []*pbactivity.ShowcaseProfileEntry, showcaseID string) *pbactivity.ShowcaseProfileEntry {
+	if showcaseID == "" {
+		if recent := recentShowcaseEntries(entries); len(recent) > 0 {
+			return recent[0]
+		}
+		return nil
+	}
+	for _, e := range entries {
+		if e.ShowcaseId == showcaseID {
+			return e
+		}
+	}
+	return nil
+}
+
+// embedWeekColors shades the weekly activity strip by activity count.
+var embedWeekColors = []string{"#ebedf0", "#fed7aa", "#fb923c", "#ea580c"}
+
+// buildShowcaseEmbedSVG renders a self-contained 480x150 card: the entry's
+// title, date and stats, its PRs, and a strip of the profile's weekly
+// activity over the comparison window.
+func buildShowcaseEmbedSVG(profile *pbactivity.ShowcaseProfile, entry *pbactivity.ShowcaseProfileEntry, entries []*pbactivity.ShowcaseProfileEntry, now time.Time) string {
+	title := html.EscapeString(truncateRunes(showcaseEntryTitle(entry), embedTitleMaxRunes))
+	name := profile.DisplayName
+	if name == "" {
+		name = "FitGlue Athlete"
+	}
+
+	var b strings.Builder
+	b.WriteString(`<svg xmlns="http://www.w3.org/2000/svg" width="480" height="150" viewBox="0 0 480 150" role="img" aria-label="` + title + `">`)
+	b.WriteString(`<title>` + title + `</title>`)
+	b.WriteString(`<style>text{font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',Helvetica,Arial,sans-serif}</style>`)
+	b.WriteString(`<rect x="0.5" y="0.5" width="479" height="149" rx="8" fill="#ffffff" stroke="#e1e4e8"/>`)
+	b.WriteString(`<text x="20" y="36" font-size="16" font-weight="600" fill="#24292f">` + title + `</text>`)
+	if entry.StartTime != nil {
+		b.WriteString(`<text x="20" y="56" font-size="12" fill="#57606a">` + entry.StartTime.AsTime().UTC().Format("Mon 2 Jan 2006") + `</text>`)
+	}
+	b.WriteString(`<text x="20" y="82" font-size="13" fill="#24292f">` + html.EscapeString(summarizeShowcaseEntry(entry)) + `</text>`)
+	switch {
+	case entry.PrCount == 1:
+		b.WriteString(`<text x="20" y="104" font-size="12" font-weight="600" fill="#c2410c">🏆 New PR</text>`)
+	case entry.PrCount > 1:
+		b.WriteString(fmt.Sprintf(`<text x="20" y="104" font-size="12" font-weight="600" fill="#c2410c">🏆 %d new PRs</text>`, entry.PrCount))
+	}
+
+	windowStart := comparisonWeekStart(now).Add(-(comparisonWeeks - 1) * week)
+	weeks := buildComparisonSide(profile, entries, windowStart).WeeklyActivities
+	for i, count := range weeks {
+		shade := int(count)
+		if shade >= len(embedWeekColors) {
+			shade = len(embedWeekColors) - 1
+		}
+		b.WriteString(fmt.Sprintf(`<rect x="%d" y="120" width="10" height="10" rx="2" fill="%s"/>`, 20+i*14, embedWeekColors[shade]))
+	}
+	b.WriteString(fmt.Sprintf(`<text x="%d" y="129" font-size="10" fill="#57606a">last %d weeks</text>`, 26+len(weeks)*14, comparisonWeeks))
+	b.WriteString(`<text x="460" y="129" font-size="11" fill="#57606a" text-anchor="end">` + html.EscapeString(name) + ` · FitGlue</text>`)
+	b.WriteString(`</svg>`)
+	return b.String()
+}
+
+// buildShowcaseEmbedHTML wraps the card in a page for iframes, linking it to
+// the entry's showcase page.
+func buildShowcaseEmbedHTML(entry *pbactivity.ShowcaseProfileEntry, svg string) string {
+	return `<!DOCTYPE html><html lang="en"><head><meta charset="utf-8">` +
+		`<meta name="viewport" content="width=device-width,initial-scale=1">` +
+		`<title>` + html.EscapeString(showcaseEntryTitle(entry)) + `</title>` +
+		`<style>html,body{margin:0;background:transparent}a{display:block;max-width:480px}svg{display:block;width:100%;height:auto}</style>` +
+		`</head><body><a href="` + html.EscapeString(showcaseEntryLink(entry)) + `" target="_blank" rel="noopener">` + svg + `</a></body></html>`
+}
+
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
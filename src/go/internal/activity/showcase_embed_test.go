@@ -0,0 +1,136 @@
+package activity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func embedTestStore(visible bool) *MockActivityStore {
+	profile := &pbactivity.ShowcaseProfile{UserId: "u1", Slug: "runner", DisplayName: "Sam <3", Visible: visible}
+	store := &MockActivityStore{}
+	store.GetShowcaseProfileBySlugFunc = func(ctx context.Context, slug string) (*pbactivity.ShowcaseProfile, error) {
+		if slug == "runner" {
+			return profile, nil
+		}
+		return nil, nil
+	}
+	store.GetShowcasePreferencesFunc = func(ctx context.Context, userID string) (*pbactivity.ShowcaseProfile, error) {
+		return profile, nil
+	}
+	store.ListShowcaseProfileEntriesFunc = func(ctx context.Context, userID string) ([]*pbactivity.ShowcaseProfileEntry, error) {
+		now := time.Now()
+		return []*pbactivity.ShowcaseProfileEntry{
+			{ShowcaseId: "old", Title: "Easy Jog", StartTime: timestamppb.New(now.AddDate(0, 0, -10))},
+			{ShowcaseId: "new", Title: "Parkrun & PBs", ActivityType: pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+				StartTime: timestamppb.New(now.Add(-time.Hour)), DistanceMeters: 5000, DurationSeconds: 1200, PrCount: 2},
+		}, nil
+	}
+	return store
+}
+
+func TestGetPublicShowcaseEmbed(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("LatestEntryAsSVG", func(t *testing.T) {
+		svc := newTestService(embedTestStore(true), &MockBlobStore{})
+		res, err := svc.GetPublicShowcaseEmbed(ctx, &pbsvc.GetPublicShowcaseEmbedRequest{Slug: "Runner"})
+		require.NoError(t, err)
+		assert.Equal(t, showcaseEmbedSVGContentType, res.ContentType)
+		assert.False(t, res.Signed)
+		assert.Contains(t, res.Body, "Parkrun &amp; PBs")
+		assert.Contains(t, res.Body, "5.00 km")
+		assert.Contains(t, res.Body, "2 new PRs")
+		assert.Contains(t, res.Body, "Sam &lt;3 · FitGlue")
+		assert.NotEmpty(t, res.Etag)
+
+		again, err := svc.GetPublicShowcaseEmbed(ctx, &pbsvc.GetPublicShowcaseEmbedRequest{Slug: "runner"})
+		require.NoError(t, err)
+		assert.Equal(t, res.Etag, again.Etag, "unchanged cards keep their ETag")
+	})
+
+	t.Run("ChosenEntryAsHTML", func(t *testing.T) {
+		svc := newTestService(embedTestStore(true), &MockBlobStore{})
+		res, err := svc.GetPublicShowcaseEmbed(ctx, &pbsvc.GetPublicShowcaseEmbedRequest{Slug: "runner", ShowcaseId: "old", Format: "html"})
+		require.NoError(t, err)
+		assert.Equal(t, showcaseEmbedHTMLContentType, res.ContentType)
+		assert.Contains(t, res.Body, "Easy Jog")
+		assert.Contains(t, res.Body, `href="https://fitglue.tech/showcase/old"`)
+		assert.Contains(t, res.Body, "<svg")
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		svc := newTestService(embedTestStore(true), &MockBlobStore{})
+		_, err := svc.GetPublicShowcaseEmbed(ctx, &pbsvc.GetPublicShowcaseEmbedRequest{Slug: "runner", Format: "png"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = svc.GetPublicShowcaseEmbed(ctx, &pbsvc.GetPublicShowcaseEmbedRequest{Slug: "nobody"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = svc.GetPublicShowcaseEmbed(ctx, &pbsvc.GetPublicShowcaseEmbedRequest{Slug: "runner", ShowcaseId: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("HiddenProfileNeedsSignedLink", func(t *testing.T) {
+		svc := newTestService(embedTestStore(false), &MockBlobStore{})
+		svc.SetEmbedSigningKey("secret")
+
+		_, err := svc.GetPublicShowcaseEmbed(ctx, &pbsvc.GetPublicShowcaseEmbedRequest{Slug: "runner"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		link, err := svc.CreateShowcaseEmbedLink(ctx, &pbsvc.CreateShowcaseEmbedLinkRequest{UserId: "u1"})
+		require.NoError(t, err)
+		assert.Equal(t, "runner", link.Slug)
+
+		res, err := svc.GetPublicShowcaseEmbed(ctx, &pbsvc.GetPublicShowcaseEmbedRequest{Slug: "runner", Expires: link.Expires, Signature: link.Signature})
+		require.NoError(t, err)
+		assert.True(t, res.Signed)
+
+		// The signature covers the entry, so a link can't be pointed elsewhere
+		_, err = svc.GetPublicShowcaseEmbed(ctx, &pbsvc.GetPublicShowcaseEmbedRequest{Slug: "runner", ShowcaseId: "old", Expires: link.Expires, Signature: link.Signature})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		expired := time.Now().Add(-time.Minute).Unix()
+		_, err = svc.GetPublicShowcaseEmbed(ctx, &pbsvc.GetPublicShowcaseEmbedRequest{Slug: "runner", Expires: expired, Signature: svc.signEmbedLink("u1", "", expired)})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("SignedLinksAreRateLimited", func(t *testing.T) {
+		store := embedTestStore(false)
+		store.IncrementShowcaseEmbedHitsFunc = func(ctx context.Context, userID string, window time.Time) (int64, error) {
+			assert.Equal(t, "u1", userID)
+			assert.Equal(t, window, window.Truncate(time.Hour))
+			return embedSignedHourlyLimit + 1, nil
+		}
+		svc := newTestService(store, &MockBlobStore{})
+		svc.SetEmbedSigningKey("secret")
+
+		link, err := svc.CreateShowcaseEmbedLink(ctx, &pbsvc.CreateShowcaseEmbedLinkRequest{UserId: "u1"})
+		require.NoError(t, err)
+		_, err = svc.GetPublicShowcaseEmbed(ctx, &pbsvc.GetPublicShowcaseEmbedRequest{Slug: "runner", Expires: link.Expires, Signature: link.Signature})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
+
+func TestCreateShowcaseEmbedLink(t *testing.T) {
+	ctx := context.Background()
+
+	svc := newTestService(embedTestStore(false), &MockBlobStore{})
+	_, err := svc.CreateShowcaseEmbedLink(ctx, &pbsvc.CreateShowcaseEmbedLinkRequest{UserId: "u1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "links need a signing key")
+
+	svc.SetEmbedSigningKey("secret")
+	_, err = svc.CreateShowcaseEmbedLink(ctx, &pbsvc.CreateShowcaseEmbedLinkRequest{UserId: "u1", ShowcaseId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	link, err := svc.CreateShowcaseEmbedLink(ctx, &pbsvc.CreateShowcaseEmbedLinkRequest{UserId: "u1", ShowcaseId: "old"})
+	require.NoError(t, err)
+	assert.Equal(t, "old", link.ShowcaseId)
+	assert.Greater(t, link.Expires, time.Now().Add(embedLinkTTL-time.Minute).Unix())
+}
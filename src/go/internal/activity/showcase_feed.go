@@ -0,0 +1,385 @@
+package activity
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/types/formatters"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// showcaseSiteURL is the public web origin serving showcase pages; feed
+	// links point here rather than at the API.
+	showcaseSiteURL = "https://fitglue.tech"
+
+	showcaseFeedContentType = "application/rss+xml; charset=utf-8"
+	showcaseAtomContentType = "application/atom+xml; charset=utf-8"
+	maxShowcaseFeedItems    = 50
+)
+
+// showcaseFeedFormat is one rendering of a profile's feed. Each is stored
+// at a stable path in the showcase assets bucket, which the assets CDN serves.
+type showcaseFeedFormat struct {
+	name        string
+	file        string
+	contentType string
+	build       func(profile *pbactivity.ShowcaseProfile, entries []*pbactivity.ShowcaseProfileEntry, now time.Time) ([]byte, error)
+}
+
+// showcaseFeedFormats lists every feed kept for a profile; the first is the
+// default.
+var showcaseFeedFormats = []showcaseFeedFormat{
+	{name: "rss", file: "feed.xml", contentType: showcaseFeedContentType, build: buildShowcaseFeed},
+	{name: "atom", file: "atom.xml", contentType: showcaseAtomContentType, build: buildShowcaseAtomFeed},
+}
+
+// lookupShowcaseFeedFormat returns the named format, or false if there is
+// none. An empty name selects RSS.
+func lookupShowcaseFeedFormat(name string) (showcaseFeedFormat, bool) {
+	if name == "" {
+		return showcaseFeedFormats[0], true
+	}
+	for _, f := range showcaseFeedFormats {
+		if strings.EqualFold(f.name, name) {
+			return f, true
+		}
+	}
+	return showcaseFeedFormat{}, false
+}
+
+// showcaseFeedPath is the object path of a user's rendered feed in the
+// showcase assets bucket.
+func showcaseFeedPath(userID string, format showcaseFeedFormat) string {
+	return fmt.Sprintf("showcase_feeds/%s/%s", userID, format.file)
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate,omitempty"`
+	Category    string  `xml:"category,omitempty"`
+	Description string  `xml:"description"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"feed"`
+	Xmlns    string      `xml:"xmlns,attr"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Author   atomPerson  `xml:"author"`
+	Icon     string      `xml:"icon,omitempty"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Links      []atomLink     `xml:"link"`
+	Published  string         `xml:"published,omitempty"`
+	Updated    string         `xml:"updated"`
+	Categories []atomCategory `xml:"category"`
+	Summary    string         `xml:"summary,omitempty"`
+	Content    atomContent    `xml:"content"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri,omitempty"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// GetPublicShowcaseFeed returns the RSS or Atom feed of a public showcase
+// profile. The feed is normally served from the copy rendered on the last
+// showcase write; profiles that predate feeds are rendered on first request.
+func (s *Service) GetPublicShowcaseFeed(ctx context.Context, req *pbsvc.GetPublicShowcaseFeedRequest) (*pbsvc.GetPublicShowcaseFeedResponse, error) {
+	if req.Slug == "" {
+		return nil, status.Error(codes.InvalidArgument, "slug is required")
+	}
+	format, ok := lookupShowcaseFeedFormat(req.Format)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "format must be rss or atom")
+	}
+
+	profile, err := s.store.GetShowcaseProfileBySlug(ctx, req.Slug)
+	if err != nil {
+		s.logger.Error(ctx, "failed to get showcase profile by slug", "error", err)
+		return nil, status.Error(codes.Internal, "failed to read showcase profile")
+	}
+	if profile == nil || !profile.Visible {
+		return nil, status.Error(codes.NotFound, "showcase profile not found")
+	}
+
+	path := showcaseFeedPath(profile.UserId, format)
+	if data, err := s.blobStore.Get(ctx, s.showcaseAssetsBucket, path); err == nil && len(data) > 0 {
+		return &pbsvc.GetPublicShowcaseFeedResponse{ContentType: format.contentType, Body: string(data)}, nil
+	}
+
+	entries, err := s.store.ListShowcaseProfileEntries(ctx, profile.UserId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list showcase profile entries", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list profile entries")
+	}
+
+	data, err := format.build(profile, entries, time.Now())
+	if err != nil {
+		s.logger.Error(ctx, "failed to render showcase feed", "error", err, "format", format.name)
+		return nil, status.Error(codes.Internal, "failed to render feed")
+	}
+	if err := s.blobStore.Write(ctx, s.showcaseAssetsBucket, path, data); err != nil {
+		s.logger.Warn(ctx, "failed to store showcase feed", "error", err, "user_id", profile.UserId)
+	}
+
+	return &pbsvc.GetPublicShowcaseFeedResponse{ContentType: format.contentType, Body: string(data)}, nil
+}
+
+// refreshShowcaseFeed re-renders the user's stored feeds after a showcase
+// write. Hidden or unnamed profiles have their feeds removed. Failures are
+// logged only: a feed is rebuilt on the next write or request.
+func (s *Service) refreshShowcaseFeed(ctx context.Context, userID string) {
+	profile, err := s.store.GetShowcasePreferences(ctx, userID)
+	if err != nil {
+		s.logger.Warn(ctx, "failed to read showcase profile for feed", "error", err, "user_id", userID)
+		return
+	}
+	if profile == nil || !profile.Visible || profile.Slug == "" {
+		for _, format := range showcaseFeedFormats {
+			if err := s.blobStore.Delete(ctx, s.showcaseAssetsBucket, showcaseFeedPath(userID, format)); err != nil {
+				s.logger.Warn(ctx, "failed to remove showcase feed", "error", err, "user_id", userID, "format", format.name)
+			}
+		}
+		return
+	}
+
+	entries, err := s.store.ListShowcaseProfileEntries(ctx, userID)
+	if err != nil {
+		s.logger.Warn(ctx, "failed to list showcase entries for feed", "error", err, "user_id", userID)
+		return
+	}
+
+	now := time.Now()
+	for _, format := range showcaseFeedFormats {
+		data, err := format.build(profile, entries, now)
+		if err != nil {
+			s.logger.Warn(ctx, "failed to render showcase feed", "error", err, "user_id", userID, "format", format.name)
+			continue
+		}
+		if err := s.blobStore.Write(ctx, s.showcaseAssetsBucket, showcaseFeedPath(userID, format), data); err != nil {
+			s.logger.Warn(ctx, "failed to store showcase feed", "error", err, "user_id", userID, "format", format.name)
+		}
+	}
+}
+
+// recentShowcaseEntries returns the entries a feed lists, newest first.
+func recentShowcaseEntries(entries []*pbactivity.ShowcaseProfileEntry) []*pbactivity.ShowcaseProfileEntry {
+	sorted := make([]*pbactivity.ShowcaseProfileEntry, len(entries))
+	copy(sorted, entries)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetStartTime().AsTime().After(sorted[j].GetStartTime().AsTime())
+	})
+	if len(sorted) > maxShowcaseFeedItems {
+		sorted = sorted[:maxShowcaseFeedItems]
+	}
+	return sorted
+}
+
+// showcaseFeedHeader returns a profile feed's title and description.
+func showcaseFeedHeader(profile *pbactivity.ShowcaseProfile) (name, title, description string) {
+	name = profile.DisplayName
+	if name == "" {
+		name = "FitGlue Athlete"
+	}
+	description = profile.Bio
+	if description == "" {
+		description = fmt.Sprintf("Activities showcased by %s on FitGlue", name)
+	}
+	return name, fmt.Sprintf("%s on FitGlue", name), description
+}
+
+func showcaseProfileLink(profile *pbactivity.ShowcaseProfile) string {
+	return fmt.Sprintf("%s/showcase/profile/%s", showcaseSiteURL, profile.Slug)
+}
+
+func showcaseEntryLink(e *pbactivity.ShowcaseProfileEntry) string {
+	return fmt.Sprintf("%s/showcase/%s", showcaseSiteURL, e.ShowcaseId)
+}
+
+func showcaseEntryTitle(e *pbactivity.ShowcaseProfileEntry) string {
+	if e.Title != "" {
+		return e.Title
+	}
+	return formatters.FormatActivityType(e.ActivityType)
+}
+
+// buildShowcaseFeed renders the most recent profile entries as an RSS 2.0
+// document, newest first.
+func buildShowcaseFeed(profile *pbactivity.ShowcaseProfile, entries []*pbactivity.ShowcaseProfileEntry, now time.Time) ([]byte, error) {
+	_, title, description := showcaseFeedHeader(profile)
+	channel := rssChannel{
+		Title:         title,
+		Link:          showcaseProfileLink(profile),
+		Description:   description,
+		LastBuildDate: now.UTC().Format(time.RFC1123Z),
+	}
+
+	for _, e := range recentShowcaseEntries(entries) {
+		link := showcaseEntryLink(e)
+		item := rssItem{
+			Title:       showcaseEntryTitle(e),
+			Link:        link,
+			GUID:        rssGUID{IsPermaLink: true, Value: link},
+			Description: summarizeShowcaseEntry(e),
+		}
+		if e.StartTime != nil {
+			item.PubDate = e.StartTime.AsTime().UTC().Format(time.RFC1123Z)
+		}
+		if e.ActivityType != pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED {
+			item.Category = formatters.FormatActivityType(e.ActivityType)
+		}
+		channel.Items = append(channel.Items, item)
+	}
+
+	out, err := xml.MarshalIndent(rssFeed{Version: "2.0", Channel: channel}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+// buildShowcaseAtomFeed renders the most recent profile entries as an Atom
+// document, newest first. Unlike the RSS feed, entries carry the activity's
+// description and images as HTML content.
+func buildShowcaseAtomFeed(profile *pbactivity.ShowcaseProfile, entries []*pbactivity.ShowcaseProfileEntry, now time.Time) ([]byte, error) {
+	name, title, description := showcaseFeedHeader(profile)
+	profileLink := showcaseProfileLink(profile)
+
+	feed := atomFeed{
+		Xmlns:    "http://www.w3.org/2005/Atom",
+		ID:       profileLink,
+		Title:    title,
+		Subtitle: description,
+		Updated:  now.UTC().Format(time.RFC3339),
+		Links:    []atomLink{{Rel: "alternate", Type: "text/html", Href: profileLink}},
+		Author:   atomPerson{Name: name, URI: profileLink},
+		Icon:     profile.ProfilePictureUrl,
+	}
+
+	for _, e := range recentShowcaseEntries(entries) {
+		link := showcaseEntryLink(e)
+		entry := atomEntry{
+			ID:      link,
+			Title:   showcaseEntryTitle(e),
+			Links:   []atomLink{{Rel: "alternate", Type: "text/html", Href: link}},
+			Summary: summarizeShowcaseEntry(e),
+			Content: atomContent{Type: "html", Body: showcaseEntryHTML(e)},
+			Updated: feed.Updated,
+		}
+		if e.StartTime != nil {
+			entry.Published = e.StartTime.AsTime().UTC().Format(time.RFC3339)
+			entry.Updated = entry.Published
+		}
+		if e.ActivityType != pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED {
+			entry.Categories = []atomCategory{{Term: formatters.FormatActivityType(e.ActivityType)}}
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	out, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+// showcaseEntryHTML is an Atom entry's content: the banner, the description
+// with its line breaks kept, the stats summary and the route map.
+func showcaseEntryHTML(e *pbactivity.ShowcaseProfileEntry) string {
+	var b strings.Builder
+	if e.BannerUrl != "" {
+		fmt.Fprintf(&b, `<p><img src="%s" alt=""/></p>`, html.EscapeString(e.BannerUrl))
+	}
+	if e.Description != "" {
+		fmt.Fprintf(&b, "<p>%s</p>", strings.ReplaceAll(html.EscapeString(e.Description), "\n", "<br/>"))
+	}
+	fmt.Fprintf(&b, "<p>%s</p>", html.EscapeString(summarizeShowcaseEntry(e)))
+	if e.RouteThumbnailUrl != "" {
+		fmt.Fprintf(&b, `<p><img src="%s" alt="Route map"/></p>`, html.EscapeString(e.RouteThumbnailUrl))
+	}
+	return b.String()
+}
+
+// summarizeShowcaseEntry builds a one-line summary such as
+// "Run · 10.02 km · 52:14" or "Weight Training · 45:00 · 12 sets · 96 reps".
+func summarizeShowcaseEntry(e *pbactivity.ShowcaseProfileEntry) string {
+	parts := []string{formatters.FormatActivityType(e.ActivityType)}
+	if e.DistanceMeters > 0 {
+		parts = append(parts, fmt.Sprintf("%.2f km", e.DistanceMeters/1000))
+	}
+	if e.DurationSeconds > 0 {
+		parts = append(parts, formatFeedDuration(e.DurationSeconds))
+	}
+	if e.TotalSets > 0 {
+		parts = append(parts, fmt.Sprintf("%d sets", e.TotalSets))
+	}
+	if e.TotalReps > 0 {
+		parts = append(parts, fmt.Sprintf("%d reps", e.TotalReps))
+	}
+	if e.TotalWeightKg > 0 {
+		parts = append(parts, fmt.Sprintf("%.0f kg lifted", e.TotalWeightKg))
+	}
+	return strings.Join(parts, " · ")
+}
+
+func formatFeedDuration(seconds float64) string {
+	total := int(seconds + 0.5)
+	h, m, sec := total/3600, (total%3600)/60, total%60
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, sec)
+	}
+	return fmt.Sprintf("%d:%02d", m, sec)
+}
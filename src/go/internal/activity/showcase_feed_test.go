@@ -0,0 +1,234 @@
+package activity
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"testing"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBuildShowcaseFeed(t *testing.T) {
+	base := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	profile := &pbactivity.ShowcaseProfile{UserId: "u1", Slug: "runner", DisplayName: "Jo <Runner>", Visible: true}
+	entries := []*pbactivity.ShowcaseProfileEntry{
+		{
+			ShowcaseId:      "older",
+			Title:           "Easy Run",
+			ActivityType:    pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+			StartTime:       timestamppb.New(base),
+			DistanceMeters:  10020,
+			DurationSeconds: 3134,
+		},
+		{
+			ShowcaseId:      "newer",
+			ActivityType:    pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING,
+			StartTime:       timestamppb.New(base.AddDate(0, 0, 1)),
+			DurationSeconds: 2700,
+			TotalSets:       12,
+			TotalReps:       96,
+		},
+	}
+
+	data, err := buildShowcaseFeed(profile, entries, base.AddDate(0, 0, 2))
+	require.NoError(t, err)
+
+	var feed rssFeed
+	require.NoError(t, xml.Unmarshal(data, &feed))
+	assert.Equal(t, "2.0", feed.Version)
+	assert.Equal(t, "Jo <Runner> on FitGlue", feed.Channel.Title)
+	assert.Equal(t, "https://fitglue.tech/showcase/profile/runner", feed.Channel.Link)
+
+	require.Len(t, feed.Channel.Items, 2)
+	first := feed.Channel.Items[0]
+	assert.Equal(t, "Weight Training", first.Title)
+	assert.Equal(t, "https://fitglue.tech/showcase/newer", first.Link)
+	assert.Equal(t, "Weight Training · 45:00 · 12 sets · 96 reps", first.Description)
+	assert.Equal(t, "Run · 10.02 km · 52:14", feed.Channel.Items[1].Description)
+	assert.Equal(t, "Sun, 01 Mar 2026 08:00:00 +0000", feed.Channel.Items[1].PubDate)
+}
+
+func TestBuildShowcaseAtomFeed(t *testing.T) {
+	start := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	profile := &pbactivity.ShowcaseProfile{UserId: "u1", Slug: "runner", DisplayName: "Jo", ProfilePictureUrl: "https://cdn.example/jo.png", Visible: true}
+	entries := []*pbactivity.ShowcaseProfileEntry{{
+		ShowcaseId:        "a1",
+		Title:             "Parkrun",
+		ActivityType:      pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		StartTime:         timestamppb.New(start),
+		DistanceMeters:    5000,
+		DurationSeconds:   1500,
+		Description:       "New PB!\n<3 the course",
+		BannerUrl:         "https://assets.example/banner.png",
+		RouteThumbnailUrl: "https://assets.example/route.png",
+	}}
+
+	data, err := buildShowcaseAtomFeed(profile, entries, start.Add(time.Hour))
+	require.NoError(t, err)
+
+	var feed atomFeed
+	require.NoError(t, xml.Unmarshal(data, &feed))
+	assert.Equal(t, "https://fitglue.tech/showcase/profile/runner", feed.ID)
+	assert.Equal(t, "Jo on FitGlue", feed.Title)
+	assert.Equal(t, "2026-03-01T09:00:00Z", feed.Updated)
+	assert.Equal(t, "https://cdn.example/jo.png", feed.Icon)
+
+	require.Len(t, feed.Entries, 1)
+	entry := feed.Entries[0]
+	assert.Equal(t, "https://fitglue.tech/showcase/a1", entry.ID)
+	assert.Equal(t, "2026-03-01T08:00:00Z", entry.Published)
+	assert.Equal(t, "Run · 5.00 km · 25:00", entry.Summary)
+	assert.Equal(t, "html", entry.Content.Type)
+	assert.Equal(t, `<p><img src="https://assets.example/banner.png" alt=""/></p>`+
+		`<p>New PB!<br/>&lt;3 the course</p>`+
+		`<p>Run · 5.00 km · 25:00</p>`+
+		`<p><img src="https://assets.example/route.png" alt="Route map"/></p>`, entry.Content.Body)
+}
+
+func TestGetPublicShowcaseFeed(t *testing.T) {
+	ctx := context.Background()
+	visible := func(ctx context.Context, slug string) (*pbactivity.ShowcaseProfile, error) {
+		return &pbactivity.ShowcaseProfile{UserId: "u1", Slug: slug, Visible: true}, nil
+	}
+
+	t.Run("EmptySlug", func(t *testing.T) {
+		svc := newTestService(&MockActivityStore{}, &MockBlobStore{})
+		_, err := svc.GetPublicShowcaseFeed(ctx, &pbsvc.GetPublicShowcaseFeedRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("UnknownFormat", func(t *testing.T) {
+		svc := newTestService(&MockActivityStore{}, &MockBlobStore{})
+		_, err := svc.GetPublicShowcaseFeed(ctx, &pbsvc.GetPublicShowcaseFeedRequest{Slug: "runner", Format: "json"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ServesStoredAtomFeed", func(t *testing.T) {
+		blob := &MockBlobStore{
+			GetFunc: func(ctx context.Context, bucket, object string) ([]byte, error) {
+				assert.Equal(t, "showcase_feeds/u1/atom.xml", object)
+				return []byte("<feed/>"), nil
+			},
+		}
+		svc := newTestService(&MockActivityStore{GetShowcaseProfileBySlugFunc: visible}, blob)
+
+		resp, err := svc.GetPublicShowcaseFeed(ctx, &pbsvc.GetPublicShowcaseFeedRequest{Slug: "runner", Format: "atom"})
+		require.NoError(t, err)
+		assert.Equal(t, "<feed/>", resp.Body)
+		assert.Equal(t, showcaseAtomContentType, resp.ContentType)
+	})
+
+	t.Run("HiddenProfile", func(t *testing.T) {
+		store := &MockActivityStore{}
+		store.GetShowcaseProfileBySlugFunc = func(ctx context.Context, slug string) (*pbactivity.ShowcaseProfile, error) {
+			return &pbactivity.ShowcaseProfile{UserId: "u1", Visible: false}, nil
+		}
+		svc := newTestService(store, &MockBlobStore{})
+		_, err := svc.GetPublicShowcaseFeed(ctx, &pbsvc.GetPublicShowcaseFeedRequest{Slug: "runner"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("ServesStoredFeed", func(t *testing.T) {
+		store := &MockActivityStore{GetShowcaseProfileBySlugFunc: visible}
+		store.ListShowcaseProfileEntriesFunc = func(ctx context.Context, userID string) ([]*pbactivity.ShowcaseProfileEntry, error) {
+			t.Fatal("entries should not be read when a stored feed exists")
+			return nil, nil
+		}
+		blob := &MockBlobStore{
+			GetFunc: func(ctx context.Context, bucket, object string) ([]byte, error) {
+				assert.Equal(t, "test-showcase-bucket", bucket)
+				assert.Equal(t, "showcase_feeds/u1/feed.xml", object)
+				return []byte("<rss/>"), nil
+			},
+		}
+		svc := newTestService(store, blob)
+
+		resp, err := svc.GetPublicShowcaseFeed(ctx, &pbsvc.GetPublicShowcaseFeedRequest{Slug: "runner"})
+		require.NoError(t, err)
+		assert.Equal(t, "<rss/>", resp.Body)
+		assert.Equal(t, showcaseFeedContentType, resp.ContentType)
+	})
+
+	t.Run("RendersAndStoresMissingFeed", func(t *testing.T) {
+		store := &MockActivityStore{GetShowcaseProfileBySlugFunc: visible}
+		store.ListShowcaseProfileEntriesFunc = func(ctx context.Context, userID string) ([]*pbactivity.ShowcaseProfileEntry, error) {
+			return []*pbactivity.ShowcaseProfileEntry{{ShowcaseId: "a1", Title: "Parkrun"}}, nil
+		}
+		var written []byte
+		blob := &MockBlobStore{
+			GetFunc: func(ctx context.Context, bucket, object string) ([]byte, error) {
+				return nil, errors.New("object not found")
+			},
+			WriteFunc: func(ctx context.Context, bucket, object string, data []byte) error {
+				written = data
+				return nil
+			},
+		}
+		svc := newTestService(store, blob)
+
+		resp, err := svc.GetPublicShowcaseFeed(ctx, &pbsvc.GetPublicShowcaseFeedRequest{Slug: "runner"})
+		require.NoError(t, err)
+		assert.Contains(t, resp.Body, "<title>Parkrun</title>")
+		assert.Equal(t, resp.Body, string(written))
+	})
+}
+
+func TestRefreshShowcaseFeed(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("HiddenProfileRemovesFeed", func(t *testing.T) {
+		store := &MockActivityStore{}
+		store.GetShowcasePreferencesFunc = func(ctx context.Context, userID string) (*pbactivity.ShowcaseProfile, error) {
+			return &pbactivity.ShowcaseProfile{UserId: userID, Slug: "runner", Visible: false}, nil
+		}
+		var deleted []string
+		blob := &MockBlobStore{
+			DeleteFunc: func(ctx context.Context, bucket, object string) error {
+				deleted = append(deleted, object)
+				return nil
+			},
+			WriteFunc: func(ctx context.Context, bucket, object string, data []byte) error {
+				t.Fatal("hidden profile feed should not be written")
+				return nil
+			},
+		}
+		svc := newTestService(store, blob)
+
+		svc.refreshShowcaseFeed(ctx, "u1")
+		assert.Equal(t, []string{"showcase_feeds/u1/feed.xml", "showcase_feeds/u1/atom.xml"}, deleted)
+	})
+
+	t.Run("AddEntryRegeneratesFeed", func(t *testing.T) {
+		store := &MockActivityStore{}
+		store.GetShowcaseFunc = func(ctx context.Context, userID, showcaseID string) (*pbactivity.ShowcasedActivity, error) {
+			return &pbactivity.ShowcasedActivity{ShowcaseId: showcaseID, UserId: userID, Title: "Long Ride"}, nil
+		}
+		store.GetShowcasePreferencesFunc = func(ctx context.Context, userID string) (*pbactivity.ShowcaseProfile, error) {
+			return &pbactivity.ShowcaseProfile{UserId: userID, Slug: "rider", Visible: true}, nil
+		}
+		store.ListShowcaseProfileEntriesFunc = func(ctx context.Context, userID string) ([]*pbactivity.ShowcaseProfileEntry, error) {
+			return []*pbactivity.ShowcaseProfileEntry{{ShowcaseId: "s1", Title: "Long Ride"}}, nil
+		}
+		written := map[string]string{}
+		blob := &MockBlobStore{
+			WriteFunc: func(ctx context.Context, bucket, object string, data []byte) error {
+				written[object] = string(data)
+				return nil
+			},
+		}
+		svc := newTestService(store, blob)
+
+		_, err := svc.AddShowcaseEntry(ctx, &pbsvc.AddShowcaseEntryRequest{UserId: "u1", ShowcaseId: "s1"})
+		require.NoError(t, err)
+		assert.Contains(t, written["showcase_feeds/u1/feed.xml"], "https://fitglue.tech/showcase/s1")
+		assert.Contains(t, written["showcase_feeds/u1/atom.xml"], "https://fitglue.tech/showcase/s1")
+	})
+}
@@ -0,0 +1,198 @@
+package activity
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// routeCellDegrees is the grid size used to snap start/end points, roughly
+	// 250m of latitude. Activities starting and finishing in the same cells
+	// are treated as the same course.
+	routeCellDegrees = 0.0025
+	// routeDistanceBucketMeters separates courses that share start/end points
+	// but differ in length (e.g. a 5k and 10k loop from the same car park).
+	routeDistanceBucketMeters = 500.0
+
+	maxShowcaseRoutes       = 10
+	maxRouteFastestEfforts  = 3
+	minRouteRepeatsForStats = 2
+)
+
+// computeRouteKey fingerprints the GPS course of an activity from its
+// snapped start/end points and distance. Returns "" when the activity has
+// no GPS data.
+func computeRouteKey(act *pbactivity.StandardizedActivity, activityType pbactivity.ActivityType, distanceMeters float64) string {
+	if act == nil {
+		return ""
+	}
+
+	var startLat, startLong, endLat, endLong float64
+	found := false
+	for _, sess := range act.Sessions {
+		for _, lap := range sess.Laps {
+			for _, rec := range lap.Records {
+				if rec.PositionLat == 0 && rec.PositionLong == 0 {
+					continue
+				}
+				if !found {
+					startLat, startLong = rec.PositionLat, rec.PositionLong
+					found = true
+				}
+				endLat, endLong = rec.PositionLat, rec.PositionLong
+			}
+		}
+	}
+	if !found {
+		return ""
+	}
+
+	return fmt.Sprintf("%d:%s:%s:%d",
+		int32(activityType),
+		routeCell(startLat, startLong),
+		routeCell(endLat, endLong),
+		int64(math.Round(distanceMeters/routeDistanceBucketMeters)),
+	)
+}
+
+// publicRouteKey is the route key public endpoints return in place of the
+// stored one. The stored key holds the snapped start and end cells, which
+// can pinpoint someone's home, so it is replaced by an HMAC under the
+// showcase signing key: routes can still be told apart, but the cells can't
+// be read back or brute forced.
+func (s *Service) publicRouteKey(userID, key string) string {
+	if key == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, s.embedSigningKey)
+	fmt.Fprintf(mac, "route\n%s\n%s", userID, key)
+	return hex.EncodeToString(mac.Sum(nil))[:32]
+}
+
+func routeCell(lat, long float64) string {
+	return fmt.Sprintf("%d,%d",
+		int64(math.Floor(lat/routeCellDegrees)),
+		int64(math.Floor(long/routeCellDegrees)),
+	)
+}
+
+// GetPublicShowcaseRouteStats returns per-route aggregates for a public showcase
+// profile: the most repeated routes and the fastest efforts on each.
+func (s *Service) GetPublicShowcaseRouteStats(ctx context.Context, req *pbsvc.GetPublicShowcaseRouteStatsRequest) (*pbsvc.GetPublicShowcaseRouteStatsResponse, error) {
+	if req.Slug == "" {
+		return nil, status.Error(codes.InvalidArgument, "slug is required")
+	}
+
+	profile, err := s.store.GetShowcaseProfileBySlug(ctx, req.Slug)
+	if err != nil {
+		s.logger.Error(ctx, "failed to get showcase profile by slug", "error", err)
+		return nil, status.Error(codes.Internal, "failed to read showcase profile")
+	}
+	if profile == nil || !profile.Visible {
+		return nil, status.Error(codes.NotFound, "showcase profile not found")
+	}
+
+	entries, err := s.store.ListShowcaseProfileEntries(ctx, profile.UserId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list showcase profile entries", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list profile entries")
+	}
+
+	routes := aggregateRouteStats(entries)
+	for _, route := range routes {
+		route.RouteKey = s.publicRouteKey(profile.UserId, route.RouteKey)
+	}
+	return &pbsvc.GetPublicShowcaseRouteStatsResponse{
+		Routes: routes,
+	}, nil
+}
+
+// aggregateRouteStats groups entries by route key and returns routes completed
+// at least twice, most repeated first (ties broken by most recent activity).
+func aggregateRouteStats(entries []*pbactivity.ShowcaseProfileEntry) []*pbactivity.ShowcaseRouteStats {
+	groups := make(map[string][]*pbactivity.ShowcaseProfileEntry)
+	for _, e := range entries {
+		if e.RouteKey == "" {
+			continue
+		}
+		groups[e.RouteKey] = append(groups[e.RouteKey], e)
+	}
+
+	var routes []*pbactivity.ShowcaseRouteStats
+	for key, group := range groups {
+		if len(group) < minRouteRepeatsForStats {
+			continue
+		}
+
+		stats := &pbactivity.ShowcaseRouteStats{
+			RouteKey:      key,
+			ActivityCount: int32(len(group)),
+		}
+		var efforts []*pbactivity.ShowcaseRouteEffort
+		for _, e := range group {
+			stats.TotalDistanceMeters += e.DistanceMeters
+
+			if e.StartTime != nil {
+				if stats.FirstActivityAt == nil || e.StartTime.AsTime().Before(stats.FirstActivityAt.AsTime()) {
+					stats.FirstActivityAt = e.StartTime
+				}
+				if stats.LatestActivityAt == nil || e.StartTime.AsTime().After(stats.LatestActivityAt.AsTime()) {
+					stats.LatestActivityAt = e.StartTime
+					stats.Name = e.Title
+					stats.ActivityType = e.ActivityType
+					stats.RouteThumbnailUrl = e.RouteThumbnailUrl
+				}
+			} else if stats.Name == "" {
+				stats.Name = e.Title
+				stats.ActivityType = e.ActivityType
+				stats.RouteThumbnailUrl = e.RouteThumbnailUrl
+			}
+
+			if e.DurationSeconds > 0 {
+				efforts = append(efforts, &pbactivity.ShowcaseRouteEffort{
+					ShowcaseId:      e.ShowcaseId,
+					Title:           e.Title,
+					StartTime:       e.StartTime,
+					DurationSeconds: e.DurationSeconds,
+					DistanceMeters:  e.DistanceMeters,
+				})
+			}
+		}
+		stats.AverageDistanceMeters = stats.TotalDistanceMeters / float64(len(group))
+
+		sort.SliceStable(efforts, func(i, j int) bool {
+			return efforts[i].DurationSeconds < efforts[j].DurationSeconds
+		})
+		if len(efforts) > maxRouteFastestEfforts {
+			efforts = efforts[:maxRouteFastestEfforts]
+		}
+		stats.FastestEfforts = efforts
+
+		routes = append(routes, stats)
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].ActivityCount != routes[j].ActivityCount {
+			return routes[i].ActivityCount > routes[j].ActivityCount
+		}
+		ti, tj := routes[i].LatestActivityAt, routes[j].LatestActivityAt
+		if ti != nil && tj != nil && !ti.AsTime().Equal(tj.AsTime()) {
+			return ti.AsTime().After(tj.AsTime())
+		}
+		return routes[i].RouteKey < routes[j].RouteKey
+	})
+	if len(routes) > maxShowcaseRoutes {
+		routes = routes[:maxShowcaseRoutes]
+	}
+	return routes
+}
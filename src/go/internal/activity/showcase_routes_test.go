@@ -0,0 +1,141 @@
+package activity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func gpsActivity(points ...[2]float64) *pbactivity.StandardizedActivity {
+	var records []*pbactivity.Record
+	for _, p := range points {
+		records = append(records, &pbactivity.Record{PositionLat: p[0], PositionLong: p[1]})
+	}
+	return &pbactivity.StandardizedActivity{
+		Sessions: []*pbactivity.Session{{Laps: []*pbactivity.Lap{{Records: records}}}},
+	}
+}
+
+func TestComputeRouteKey(t *testing.T) {
+	run := pbactivity.ActivityType_ACTIVITY_TYPE_RUN
+
+	t.Run("NoGPS", func(t *testing.T) {
+		assert.Empty(t, computeRouteKey(nil, run, 5000))
+		assert.Empty(t, computeRouteKey(gpsActivity([2]float64{0, 0}), run, 5000))
+	})
+
+	t.Run("SameCourseMatches", func(t *testing.T) {
+		a := computeRouteKey(gpsActivity([2]float64{51.5001, -0.1201}, [2]float64{51.51, -0.13}, [2]float64{51.5002, -0.1202}), run, 5020)
+		b := computeRouteKey(gpsActivity([2]float64{0, 0}, [2]float64{51.5003, -0.1203}, [2]float64{51.5004, -0.1204}), run, 4960)
+		assert.NotEmpty(t, a)
+		assert.Equal(t, a, b)
+	})
+
+	t.Run("DifferentDistanceOrTypeDiffers", func(t *testing.T) {
+		act := gpsActivity([2]float64{51.5001, -0.1201}, [2]float64{51.5002, -0.1202})
+		base := computeRouteKey(act, run, 5000)
+		assert.NotEqual(t, base, computeRouteKey(act, run, 10000))
+		assert.NotEqual(t, base, computeRouteKey(act, pbactivity.ActivityType_ACTIVITY_TYPE_RIDE, 5000))
+	})
+}
+
+func TestGetPublicShowcaseRouteStats(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	t.Run("EmptySlug", func(t *testing.T) {
+		svc := newTestService(&MockActivityStore{}, &MockBlobStore{})
+		_, err := svc.GetPublicShowcaseRouteStats(ctx, &pbsvc.GetPublicShowcaseRouteStatsRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("HiddenProfile", func(t *testing.T) {
+		store := &MockActivityStore{}
+		store.GetShowcaseProfileBySlugFunc = func(ctx context.Context, slug string) (*pbactivity.ShowcaseProfile, error) {
+			return &pbactivity.ShowcaseProfile{UserId: "u1", Visible: false}, nil
+		}
+		svc := newTestService(store, &MockBlobStore{})
+		_, err := svc.GetPublicShowcaseRouteStats(ctx, &pbsvc.GetPublicShowcaseRouteStatsRequest{Slug: "runner"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("AggregatesRepeatedRoutes", func(t *testing.T) {
+		entry := func(id, routeKey string, day int, duration float64) *pbactivity.ShowcaseProfileEntry {
+			return &pbactivity.ShowcaseProfileEntry{
+				ShowcaseId:      id,
+				Title:           "Activity " + id,
+				RouteKey:        routeKey,
+				StartTime:       timestamppb.New(base.AddDate(0, 0, day)),
+				DistanceMeters:  5000,
+				DurationSeconds: duration,
+			}
+		}
+		store := &MockActivityStore{}
+		store.GetShowcaseProfileBySlugFunc = func(ctx context.Context, slug string) (*pbactivity.ShowcaseProfile, error) {
+			return &pbactivity.ShowcaseProfile{UserId: "u1", Visible: true}, nil
+		}
+		store.ListShowcaseProfileEntriesFunc = func(ctx context.Context, userID string) ([]*pbactivity.ShowcaseProfileEntry, error) {
+			return []*pbactivity.ShowcaseProfileEntry{
+				entry("a1", "park", 0, 1500),
+				entry("a2", "park", 1, 1400),
+				entry("a3", "park", 2, 1600),
+				entry("a4", "park", 3, 1450),
+				entry("b1", "river", 0, 2000),
+				entry("b2", "river", 5, 1900),
+				entry("c1", "once", 0, 1000),
+				entry("d1", "", 0, 900),
+			}, nil
+		}
+		svc := newTestService(store, &MockBlobStore{})
+
+		resp, err := svc.GetPublicShowcaseRouteStats(ctx, &pbsvc.GetPublicShowcaseRouteStatsRequest{Slug: "runner"})
+		require.NoError(t, err)
+		require.Len(t, resp.Routes, 2)
+
+		park := resp.Routes[0]
+		assert.Equal(t, svc.publicRouteKey("u1", "park"), park.RouteKey)
+		assert.NotContains(t, park.RouteKey, "park")
+		assert.Equal(t, int32(4), park.ActivityCount)
+		assert.Equal(t, "Activity a4", park.Name)
+		assert.Equal(t, 20000.0, park.TotalDistanceMeters)
+		assert.Equal(t, base, park.FirstActivityAt.AsTime())
+		require.Len(t, park.FastestEfforts, 3)
+		assert.Equal(t, "a2", park.FastestEfforts[0].ShowcaseId)
+		assert.Equal(t, "a4", park.FastestEfforts[1].ShowcaseId)
+		assert.Equal(t, "a1", park.FastestEfforts[2].ShowcaseId)
+
+		assert.Equal(t, svc.publicRouteKey("u1", "river"), resp.Routes[1].RouteKey)
+		assert.NotEqual(t, park.RouteKey, resp.Routes[1].RouteKey)
+		assert.Equal(t, int32(2), resp.Routes[1].ActivityCount)
+	})
+}
+
+func TestGetPublicShowcaseProfile_HidesRouteKeys(t *testing.T) {
+	raw := computeRouteKey(gpsActivity([2]float64{51.5001, -0.1201}, [2]float64{51.5002, -0.1202}), pbactivity.ActivityType_ACTIVITY_TYPE_RUN, 5000)
+	store := &MockActivityStore{}
+	store.GetShowcaseProfileBySlugFunc = func(ctx context.Context, slug string) (*pbactivity.ShowcaseProfile, error) {
+		return &pbactivity.ShowcaseProfile{UserId: "u1", Slug: "runner", Visible: true}, nil
+	}
+	store.ListShowcaseProfileEntriesFunc = func(ctx context.Context, userID string) ([]*pbactivity.ShowcaseProfileEntry, error) {
+		return []*pbactivity.ShowcaseProfileEntry{{ShowcaseId: "a1", RouteKey: raw}}, nil
+	}
+	svc := newTestService(store, &MockBlobStore{})
+	svc.SetEmbedSigningKey("secret")
+
+	resp, err := svc.GetPublicShowcaseProfile(context.Background(), &pbsvc.GetPublicShowcaseProfileRequest{Slug: "runner", Page: 1})
+	require.NoError(t, err)
+	require.Len(t, resp.Profile.Entries, 1)
+
+	got := resp.Profile.Entries[0].RouteKey
+	assert.Equal(t, svc.publicRouteKey("u1", raw), got)
+	assert.NotContains(t, got, ",", "grid cells must not be exposed")
+	assert.Len(t, got, 32)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -85,6 +87,8 @@ func (s *Service) UpdateShowcaseSettings(ctx context.Context, req *pbsvc.UpdateS
 		return nil, status.Error(codes.Internal, "failed to update showcase settings")
 	}
 
+	s.refreshShowcaseFeed(ctx, req.UserId)
+
 	return updated, nil
 }
 
@@ -110,6 +114,8 @@ func (s *Service) UpdateShowcaseSlug(ctx context.Context, req *pbsvc.UpdateShowc
 		return nil, status.Error(codes.Internal, "failed to update showcase slug")
 	}
 
+	s.refreshShowcaseFeed(ctx, req.UserId)
+
 	return &pbsvc.UpdateShowcaseSlugResponse{
 		Slug: slug,
 	}, nil
@@ -152,6 +158,12 @@ func (s *Service) AddShowcaseEntry(ctx context.Context, req *pbsvc.AddShowcaseEn
 		Source:            showcase.Source,
 		StartTime:         showcase.StartTime,
 		RouteThumbnailUrl: showcase.EnrichmentMetadata["asset_route_thumbnail"],
+		Description:       showcase.Description,
+		BannerUrl:         showcase.EnrichmentMetadata["asset_ai_banner"],
+	}
+	if showcase.EnrichmentMetadata["pr_status"] == "pr_detected" {
+		prCount, _ := strconv.Atoi(showcase.EnrichmentMetadata["pr_count"])
+		newEntry.PrCount = int32(prCount)
 	}
 
 	// Populate metrics from ActivityData if available
@@ -169,6 +181,7 @@ func (s *Service) AddShowcaseEntry(ctx context.Context, req *pbsvc.AddShowcaseEn
 		}
 		newEntry.TotalReps = totalReps
 		newEntry.TotalWeightKg = totalWeight
+		newEntry.RouteKey = computeRouteKey(showcase.ActivityData, showcase.ActivityType, newEntry.DistanceMeters)
 	}
 
 	// Write entry to sub-collection (idempotent via MergeAll)
@@ -199,6 +212,8 @@ func (s *Service) AddShowcaseEntry(ctx context.Context, req *pbsvc.AddShowcaseEn
 		s.logger.Error(ctx, "failed to update profile stats after add", "error", err)
 	}
 
+	s.refreshShowcaseFeed(ctx, req.UserId)
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -268,6 +283,8 @@ func (s *Service) RemoveShowcaseEntry(ctx context.Context, req *pbsvc.RemoveShow
 		s.logger.Error(ctx, "failed to update profile stats after remove", "error", err)
 	}
 
+	s.refreshShowcaseFeed(ctx, req.UserId)
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -364,7 +381,12 @@ func (s *Service) GetPublicShowcaseProfile(ctx context.Context, req *pbsvc.GetPu
 	// Populate profile.Entries directly with the page entries
 	// This preserves all fields (totalSets, totalReps, totalWeightKg, etc.)
 	// without lossy conversion to ShowcasedActivity.
-	profile.Entries = pageEntries
+	profile.Entries = make([]*pbactivity.ShowcaseProfileEntry, 0, len(pageEntries))
+	for _, e := range pageEntries {
+		e = proto.Clone(e).(*pbactivity.ShowcaseProfileEntry)
+		e.RouteKey = s.publicRouteKey(profile.UserId, e.RouteKey)
+		profile.Entries = append(profile.Entries, e)
+	}
 
 	// Double-check display name fallback if still empty
 	if profile.DisplayName == "" {
@@ -2,6 +2,7 @@ package activity
 
 import (
 	"context"
+	"time"
 
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
@@ -34,6 +35,25 @@ type ActivityStore interface {
 	SetShowcaseProfileEntry(ctx context.Context, userID string, entry *pbactivity.ShowcaseProfileEntry) error
 	DeleteShowcaseProfileEntry(ctx context.Context, userID, showcaseID string) error
 
+	// Showcase Comparisons (collection: showcase_comparisons/{id})
+	GetShowcaseComparison(ctx context.Context, id string) (*pbactivity.ShowcaseComparison, error)
+	SetShowcaseComparison(ctx context.Context, comparison *pbactivity.ShowcaseComparison) error
+	ListShowcaseComparisons(ctx context.Context) ([]*pbactivity.ShowcaseComparison, error)
+	DeleteShowcaseComparison(ctx context.Context, id string) error
+	// RecordShowcaseComparisonRequest counts a request for a comparison that
+	// isn't stored yet and returns how many there have been recently
+	// (collection: showcase_comparison_requests/{id}, expiring after expiry).
+	RecordShowcaseComparisonRequest(ctx context.Context, id string, at time.Time, expiry time.Duration) (int64, error)
+	// IncrementShowcaseComparisonHits counts a comparison computed for the
+	// profile in the window and returns the window's new total
+	// (collection: showcase_comparison_hits/{slug}_{windowUnix}).
+	IncrementShowcaseComparisonHits(ctx context.Context, slug string, window time.Time) (int64, error)
+
+	// Showcase Embed Hits (collection: showcase_embed_hits/{userId}_{windowUnix})
+	// IncrementShowcaseEmbedHits counts a signed embed request in the user's
+	// window and returns the window's new total.
+	IncrementShowcaseEmbedHits(ctx context.Context, userID string, window time.Time) (int64, error)
+
 	// Activity Stats
 	CountPipelineRunsByStatus(ctx context.Context, userID, status string) (int32, error)
 	CountShowcasedActivities(ctx context.Context, userID string) (int32, error)
@@ -0,0 +1,303 @@
+// nolint:proto-json
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	shared "github.com/fitglue/server/src/go/pkg"
+	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+// DefaultBranch is the branch a GitHub Pages export is committed to when the
+// caller does not choose one.
+const DefaultBranch = "gh-pages"
+
+const (
+	// zipLinkExpiry is how long the download link of a zip export works.
+	zipLinkExpiry = 24 * time.Hour
+	// maxThumbnailBytes caps a single route thumbnail copied into the site.
+	maxThumbnailBytes = 1 << 20
+)
+
+// Publisher defines the contract for publishing events (e.g., to Pub/Sub).
+type Publisher interface {
+	PublishCloudEvent(ctx context.Context, topic string, ce cloudevents.Event) (string, error)
+}
+
+// RequestExport publishes the event that makes the destination service build
+// and deliver the user's archive.
+func RequestExport(ctx context.Context, publisher Publisher, req *pbevents.ArchiveExportRequestedEvent) error {
+	ce, err := infrapubsub.NewCloudEvent(
+		infrapubsub.GetCloudEventSource(pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_ARCHIVE_EXPORT),
+		infrapubsub.GetCloudEventType(pbevents.CloudEventType_CLOUD_EVENT_TYPE_ARCHIVE_EXPORT_REQUESTED),
+		req,
+	)
+	if err != nil {
+		return fmt.Errorf("create cloud event: %w", err)
+	}
+	if _, err := publisher.PublishCloudEvent(ctx, shared.TopicArchiveExportRequested, ce); err != nil {
+		return fmt.Errorf("publish: %w", err)
+	}
+	return nil
+}
+
+// BlobStore is where zip exports are written and signed for download.
+type BlobStore interface {
+	Write(ctx context.Context, bucket, object string, data []byte) error
+	SignedURL(ctx context.Context, bucket, object, contentType string, contentLength int64, expiry time.Duration) (string, error)
+}
+
+// PagesPublisher commits a site as the contents of a GitHub repository
+// branch and returns the GitHub Pages URL it is served from.
+type PagesPublisher interface {
+	PublishSite(ctx context.Context, userID, repo, branch string, site Site) (string, error)
+}
+
+// Exporter builds a user's public archive from their showcased activities
+// and delivers it as a zip download or to GitHub Pages.
+type Exporter struct {
+	activitySvc   activitypb.ActivityServiceClient
+	userSvc       userpb.UserServiceClient
+	store         BlobStore
+	bucket        string
+	pages         PagesPublisher
+	notifications shared.NotificationService
+	httpClient    *http.Client
+	logger        infra.Logger
+}
+
+func NewExporter(activitySvc activitypb.ActivityServiceClient, userSvc userpb.UserServiceClient, store BlobStore, bucket string, pages PagesPublisher, notifications shared.NotificationService, logger infra.Logger) *Exporter {
+	return &Exporter{
+		activitySvc:   activitySvc,
+		userSvc:       userSvc,
+		store:         store,
+		bucket:        bucket,
+		pages:         pages,
+		notifications: notifications,
+		httpClient:    &http.Client{Timeout: 10 * time.Second},
+		logger:        logger,
+	}
+}
+
+// HandlePubSubPush unwraps a Pub/Sub push envelope carrying an ArchiveExportRequestedEvent
+func (e *Exporter) HandlePubSubPush(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		e.logger.Error(ctx, "Failed to read request body", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	var msg struct {
+		Message struct {
+			Data []byte `json:"data"`
+			ID   string `json:"messageId"`
+		} `json:"message"`
+	}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		e.logger.Error(ctx, "Failed to unmarshal pub/sub envelope", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	var ce event.Event
+	if err := json.Unmarshal(msg.Message.Data, &ce); err != nil {
+		e.logger.Error(ctx, "Failed to unmarshal inner CloudEvent", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	if err := e.Process(ctx, &ce); err != nil {
+		e.logger.Error(ctx, "Failed to export archive", "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "OK")
+}
+
+// Process unmarshals an ArchiveExportRequestedEvent and runs the export.
+// Returned errors are retried by Pub/Sub.
+func (e *Exporter) Process(ctx context.Context, ce *event.Event) error {
+	var req pbevents.ArchiveExportRequestedEvent
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(ce.Data(), &req); err != nil {
+		e.logger.Error(ctx, "Failed to unmarshal ArchiveExportRequestedEvent", "error", err)
+		return nil // Ack malformed payloads
+	}
+	return e.Export(ctx, &req)
+}
+
+// Export renders the archive and delivers it to the requested target, then
+// notifies the user where to find it. Requests that can never succeed are
+// logged and dropped rather than returned as errors, so they are not retried.
+func (e *Exporter) Export(ctx context.Context, req *pbevents.ArchiveExportRequestedEvent) error {
+	if req.UserId == "" {
+		e.logger.Warn(ctx, "Archive export requested without a user")
+		return nil
+	}
+
+	if req.Target == pbevents.ArchiveExportTarget_ARCHIVE_EXPORT_TARGET_GITHUB_PAGES {
+		integrations, err := e.userSvc.ListIntegrations(ctx, &userpb.ListIntegrationsRequest{UserId: req.UserId})
+		if err != nil {
+			return fmt.Errorf("getting user integrations: %w", err)
+		}
+		if integrations.GetGithub() == nil || !integrations.GetGithub().Enabled {
+			e.logger.Warn(ctx, "GitHub Pages export requested without a GitHub integration", "user_id", req.UserId)
+			return nil
+		}
+	}
+
+	showcases, err := e.listShowcases(ctx, req.UserId)
+	if err != nil {
+		return err
+	}
+
+	ownerName := ""
+	if len(showcases) > 0 {
+		ownerName = showcases[0].OwnerDisplayName
+	}
+	site := BuildSite(ownerName, showcases, e.fetchRouteThumbnails(ctx, showcases))
+
+	var url string
+	switch req.Target {
+	case pbevents.ArchiveExportTarget_ARCHIVE_EXPORT_TARGET_GITHUB_PAGES:
+		branch := req.GithubBranch
+		if branch == "" {
+			branch = DefaultBranch
+		}
+		url, err = e.pages.PublishSite(ctx, req.UserId, req.GithubRepo, branch, site)
+		if err != nil {
+			return fmt.Errorf("publishing to GitHub Pages: %w", err)
+		}
+	default:
+		url, err = e.writeZip(ctx, req.UserId, site)
+		if err != nil {
+			return err
+		}
+	}
+
+	e.logger.Info(ctx, "Archive export completed", "user_id", req.UserId, "target", req.Target.String(), "activities", len(showcases), "files", len(site))
+	e.notify(ctx, req, url)
+	return nil
+}
+
+// listShowcases reads the full showcased activity behind every profile
+// entry, skipping any that expired since the entry was listed.
+func (e *Exporter) listShowcases(ctx context.Context, userID string) ([]*pbactivity.ShowcasedActivity, error) {
+	resp, err := e.activitySvc.ListShowcases(ctx, &activitypb.ListShowcasesRequest{UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("listing showcases: %w", err)
+	}
+
+	now := time.Now()
+	var showcases []*pbactivity.ShowcasedActivity
+	for _, entry := range resp.Showcases {
+		sc, err := e.activitySvc.GetShowcase(ctx, &activitypb.GetShowcaseRequest{UserId: userID, ShowcaseId: entry.ShowcaseId})
+		if err != nil {
+			return nil, fmt.Errorf("getting showcase %s: %w", entry.ShowcaseId, err)
+		}
+		if sc.ExpiresAt != nil && sc.ExpiresAt.AsTime().Before(now) {
+			continue
+		}
+		showcases = append(showcases, sc)
+	}
+	return showcases, nil
+}
+
+// fetchRouteThumbnails downloads the route thumbnails generated for each
+// activity. A thumbnail that can't be fetched is left out of the site.
+func (e *Exporter) fetchRouteThumbnails(ctx context.Context, showcases []*pbactivity.ShowcasedActivity) map[string][]byte {
+	thumbnails := make(map[string][]byte)
+	for _, sc := range showcases {
+		url := sc.EnrichmentMetadata["asset_route_thumbnail"]
+		if url == "" {
+			continue
+		}
+		data, err := e.fetchAsset(ctx, url)
+		if err != nil {
+			e.logger.Warn(ctx, "Failed to fetch route thumbnail", "showcase_id", sc.ShowcaseId, "error", err)
+			continue
+		}
+		thumbnails[sc.ShowcaseId] = data
+	}
+	return thumbnails
+}
+
+func (e *Exporter) fetchAsset(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxThumbnailBytes))
+}
+
+func (e *Exporter) writeZip(ctx context.Context, userID string, site Site) (string, error) {
+	now := time.Now()
+	data, err := site.Zip(now)
+	if err != nil {
+		return "", fmt.Errorf("building zip: %w", err)
+	}
+
+	objectPath := fmt.Sprintf("exports/%s/archive-%d.zip", userID, now.UnixMilli())
+	if err := e.store.Write(ctx, e.bucket, objectPath, data); err != nil {
+		return "", fmt.Errorf("writing zip: %w", err)
+	}
+
+	url, err := e.store.SignedURL(ctx, e.bucket, objectPath, "application/zip", int64(len(data)), zipLinkExpiry)
+	if err != nil {
+		return "", fmt.Errorf("signing zip download: %w", err)
+	}
+	return url, nil
+}
+
+// notify tells the user their export is ready. Failures are only logged; the
+// export itself has already been delivered.
+func (e *Exporter) notify(ctx context.Context, req *pbevents.ArchiveExportRequestedEvent, url string) {
+	profile, err := e.userSvc.GetProfile(ctx, &userpb.GetProfileRequest{UserId: req.UserId})
+	if err != nil {
+		e.logger.Warn(ctx, "Failed to fetch profile for archive export notification", "user_id", req.UserId, "error", err)
+		return
+	}
+	if len(profile.FcmTokens) == 0 {
+		return
+	}
+
+	body := "Your activity archive is ready to download."
+	if req.Target == pbevents.ArchiveExportTarget_ARCHIVE_EXPORT_TARGET_GITHUB_PAGES {
+		body = fmt.Sprintf("Your activity archive was published to %s.", strings.TrimSuffix(url, "/"))
+	}
+	data := map[string]string{
+		"type":    "ARCHIVE_EXPORT_READY",
+		"url":     url,
+		"user_id": req.UserId,
+	}
+	if err := e.notifications.SendPushNotification(ctx, req.UserId, "Archive export ready", body, profile.FcmTokens, data); err != nil {
+		e.logger.Warn(ctx, "Failed to send archive export notification", "user_id", req.UserId, "error", err)
+	}
+}
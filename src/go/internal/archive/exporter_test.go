@@ -0,0 +1,195 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+type mockActivityService struct {
+	activitypb.ActivityServiceClient
+	showcases map[string]*pbactivity.ShowcasedActivity
+}
+
+func (m *mockActivityService) ListShowcases(ctx context.Context, in *activitypb.ListShowcasesRequest, opts ...grpc.CallOption) (*activitypb.ListShowcasesResponse, error) {
+	resp := &activitypb.ListShowcasesResponse{}
+	for id := range m.showcases {
+		resp.Showcases = append(resp.Showcases, &pbactivity.ShowcaseProfileEntry{ShowcaseId: id})
+	}
+	return resp, nil
+}
+
+func (m *mockActivityService) GetShowcase(ctx context.Context, in *activitypb.GetShowcaseRequest, opts ...grpc.CallOption) (*pbactivity.ShowcasedActivity, error) {
+	return m.showcases[in.ShowcaseId], nil
+}
+
+type mockUserService struct {
+	userpb.UserServiceClient
+	integrations *pbuser.UserIntegrations
+}
+
+func (m *mockUserService) GetProfile(ctx context.Context, in *userpb.GetProfileRequest, opts ...grpc.CallOption) (*pbuser.UserProfile, error) {
+	return &pbuser.UserProfile{UserId: in.UserId, FcmTokens: []string{"token1"}}, nil
+}
+
+func (m *mockUserService) ListIntegrations(ctx context.Context, in *userpb.ListIntegrationsRequest, opts ...grpc.CallOption) (*pbuser.UserIntegrations, error) {
+	if m.integrations == nil {
+		return &pbuser.UserIntegrations{}, nil
+	}
+	return m.integrations, nil
+}
+
+type mockBlobStore struct {
+	objects map[string][]byte
+}
+
+func (m *mockBlobStore) Write(ctx context.Context, bucket, object string, data []byte) error {
+	m.objects[bucket+"/"+object] = data
+	return nil
+}
+
+func (m *mockBlobStore) SignedURL(ctx context.Context, bucket, object, contentType string, contentLength int64, expiry time.Duration) (string, error) {
+	return "https://signed.example/" + object, nil
+}
+
+type mockPages struct {
+	repo, branch string
+	site         Site
+}
+
+func (m *mockPages) PublishSite(ctx context.Context, userID, repo, branch string, site Site) (string, error) {
+	m.repo, m.branch, m.site = repo, branch, site
+	return "https://jo.github.io/activities/", nil
+}
+
+type mockNotifications struct {
+	data map[string]string
+}
+
+func (m *mockNotifications) SendPushNotification(ctx context.Context, userID string, title, body string, tokens []string, data map[string]string) error {
+	m.data = data
+	return nil
+}
+
+func newTestExporter(t *testing.T, users *mockUserService) (*Exporter, *mockBlobStore, *mockPages, *mockNotifications) {
+	thumbnails := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("<svg/>"))
+	}))
+	t.Cleanup(thumbnails.Close)
+
+	start := timestamppb.New(time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC))
+	activities := &mockActivityService{showcases: map[string]*pbactivity.ShowcasedActivity{
+		"s1": {
+			ShowcaseId:         "s1",
+			Title:              "Parkrun",
+			StartTime:          start,
+			OwnerDisplayName:   "Jo",
+			EnrichmentMetadata: map[string]string{"asset_route_thumbnail": thumbnails.URL + "/s1/route-thumbnail.svg"},
+		},
+		"expired": {
+			ShowcaseId: "expired",
+			Title:      "Old Ride",
+			StartTime:  start,
+			ExpiresAt:  timestamppb.New(time.Now().Add(-time.Hour)),
+		},
+	}}
+
+	store := &mockBlobStore{objects: map[string][]byte{}}
+	pages := &mockPages{}
+	notifications := &mockNotifications{}
+	e := NewExporter(activities, users, store, "artifacts", pages, notifications, infra.NewLogger())
+	return e, store, pages, notifications
+}
+
+func TestExport_Zip(t *testing.T) {
+	e, store, _, notifications := newTestExporter(t, &mockUserService{})
+
+	err := e.Export(context.Background(), &pbevents.ArchiveExportRequestedEvent{UserId: "u1", Target: pbevents.ArchiveExportTarget_ARCHIVE_EXPORT_TARGET_ZIP})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(store.objects) != 1 {
+		t.Fatalf("expected one zip to be written, got %d", len(store.objects))
+	}
+
+	var names []string
+	for _, data := range store.objects {
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Fatalf("invalid zip: %v", err)
+		}
+		for _, f := range zr.File {
+			names = append(names, f.Name)
+		}
+	}
+	expected := []string{"_config.yml", "activities/2026-03-01-parkrun/index.md", "activities/2026-03-01-parkrun/route.svg", "index.md"}
+	if len(names) != len(expected) {
+		t.Fatalf("expected %v, got %v (expired showcases must be skipped)", expected, names)
+	}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, names)
+			break
+		}
+	}
+
+	if notifications.data["type"] != "ARCHIVE_EXPORT_READY" || notifications.data["url"] == "" {
+		t.Errorf("expected a ready notification with the download link, got %v", notifications.data)
+	}
+}
+
+func TestExport_GitHubPages(t *testing.T) {
+	users := &mockUserService{integrations: &pbuser.UserIntegrations{Github: &pbuser.GitHubIntegration{Enabled: true}}}
+	e, store, pages, notifications := newTestExporter(t, users)
+
+	err := e.Export(context.Background(), &pbevents.ArchiveExportRequestedEvent{
+		UserId:     "u1",
+		Target:     pbevents.ArchiveExportTarget_ARCHIVE_EXPORT_TARGET_GITHUB_PAGES,
+		GithubRepo: "jo/activities",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pages.repo != "jo/activities" || pages.branch != DefaultBranch {
+		t.Errorf("expected publish to jo/activities@%s, got %s@%s", DefaultBranch, pages.repo, pages.branch)
+	}
+	if _, ok := pages.site["activities/2026-03-01-parkrun/index.md"]; !ok {
+		t.Errorf("expected activity page in published site, got %v", keys(pages.site))
+	}
+	if len(store.objects) != 0 {
+		t.Error("GitHub Pages exports must not write a zip")
+	}
+	if notifications.data["url"] != "https://jo.github.io/activities/" {
+		t.Errorf("expected Pages URL in notification, got %v", notifications.data)
+	}
+}
+
+func TestExport_GitHubPagesWithoutIntegration(t *testing.T) {
+	e, _, pages, _ := newTestExporter(t, &mockUserService{})
+
+	err := e.Export(context.Background(), &pbevents.ArchiveExportRequestedEvent{
+		UserId:     "u1",
+		Target:     pbevents.ArchiveExportTarget_ARCHIVE_EXPORT_TARGET_GITHUB_PAGES,
+		GithubRepo: "jo/activities",
+	})
+	if err != nil {
+		t.Fatalf("expected request to be dropped without error, got %v", err)
+	}
+	if pages.site != nil {
+		t.Error("nothing should be published without a GitHub integration")
+	}
+}
@@ -0,0 +1,88 @@
+// Package archive renders activities as markdown pages with YAML front
+// matter, as committed by the GitHub destination, and bundles a user's
+// public showcase into a static site that GitHub Pages (Jekyll) can serve.
+package archive
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// EndMarker closes the generated part of a page. Anything a user writes
+// below it is preserved when the page is regenerated.
+const EndMarker = "<!-- fitglue:end -->"
+
+// Document is the content of one activity page.
+type Document struct {
+	Title       string
+	Type        string // ActivityType enum name; the ACTIVITY_TYPE_ prefix is dropped
+	Date        time.Time
+	Source      string
+	ActivityID  string
+	PipelineID  string
+	FitFile     string // File name next to the page, if the FIT file was committed
+	Enrichments []string
+	Tags        []string
+	Description string
+}
+
+// RenderMarkdown renders a document as front matter, a heading and the
+// description, followed by EndMarker.
+func RenderMarkdown(doc Document) string {
+	var sb strings.Builder
+
+	sb.WriteString("---\n")
+	sb.WriteString(fmt.Sprintf("title: %q\n", doc.Title))
+	sb.WriteString(fmt.Sprintf("type: %s\n", strings.TrimPrefix(doc.Type, "ACTIVITY_TYPE_")))
+
+	if !doc.Date.IsZero() {
+		sb.WriteString(fmt.Sprintf("date: %s\n", doc.Date.Format("2006-01-02T15:04:05Z07:00")))
+	}
+
+	sb.WriteString(fmt.Sprintf("source: %s\n", doc.Source))
+	if doc.ActivityID != "" {
+		sb.WriteString(fmt.Sprintf("activity_id: %s\n", doc.ActivityID))
+	}
+	if doc.PipelineID != "" {
+		sb.WriteString(fmt.Sprintf("pipeline_id: %s\n", doc.PipelineID))
+	}
+
+	if doc.FitFile != "" {
+		sb.WriteString(fmt.Sprintf("fit_file: %s\n", doc.FitFile))
+	}
+	if len(doc.Enrichments) > 0 {
+		sb.WriteString(fmt.Sprintf("enrichments: [%s]\n", strings.Join(doc.Enrichments, ",")))
+	}
+	if len(doc.Tags) > 0 {
+		sb.WriteString(fmt.Sprintf("tags: [%s]\n", strings.Join(doc.Tags, ",")))
+	}
+	sb.WriteString("---\n\n")
+
+	sb.WriteString(fmt.Sprintf("# %s\n\n", doc.Title))
+
+	if doc.Description != "" {
+		sb.WriteString(doc.Description)
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n" + EndMarker + "\n")
+
+	return sb.String()
+}
+
+// SanitizeFileName turns an activity name into a lowercase, dash-separated
+// path segment.
+func SanitizeFileName(name string) string {
+	lower := strings.ToLower(name)
+	replacer := strings.NewReplacer(
+		" ", "-", "/", "-", "\\", "-", ":", "-",
+		"'", "", "\"", "", "(", "", ")", "",
+		".", "-", ",", "",
+	)
+	result := replacer.Replace(lower)
+	for strings.Contains(result, "--") {
+		result = strings.ReplaceAll(result, "--", "-")
+	}
+	return strings.Trim(result, "-")
+}
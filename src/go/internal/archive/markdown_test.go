@@ -0,0 +1,47 @@
+package archive
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	doc := Document{
+		Title:       "Morning Run",
+		Type:        "ACTIVITY_TYPE_RUN",
+		Date:        time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC),
+		Source:      "SOURCE_STRAVA",
+		ActivityID:  "a1",
+		Tags:        []string{"parkrun", "pb"},
+		Description: "🏃 5k in 21:04",
+	}
+
+	expected := "---\n" +
+		"title: \"Morning Run\"\n" +
+		"type: RUN\n" +
+		"date: 2026-03-01T08:00:00Z\n" +
+		"source: SOURCE_STRAVA\n" +
+		"activity_id: a1\n" +
+		"tags: [parkrun,pb]\n" +
+		"---\n\n" +
+		"# Morning Run\n\n" +
+		"🏃 5k in 21:04\n" +
+		"\n<!-- fitglue:end -->\n"
+	if got := RenderMarkdown(doc); got != expected {
+		t.Errorf("unexpected markdown:\n%s\nwant:\n%s", got, expected)
+	}
+}
+
+func TestSanitizeFileName(t *testing.T) {
+	tests := map[string]string{
+		"Morning Run":           "morning-run",
+		"Push/Pull (Heavy)":     "push-pull-heavy",
+		"Parkrun: St. Albans":   "parkrun-st-albans",
+		"  Bob's \"Big\" Ride ": "bobs-big-ride",
+	}
+	for in, want := range tests {
+		if got := SanitizeFileName(in); got != want {
+			t.Errorf("SanitizeFileName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/types/formatters"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+// Site is a static site bundle: file contents keyed by slash-separated path.
+type Site map[string][]byte
+
+// routeThumbnailFile is the asset name of a route thumbnail, next to the
+// activity page it belongs to.
+const routeThumbnailFile = "route.svg"
+
+// BuildSite renders showcased activities as a Jekyll site: a _config.yml, an
+// index page listing every activity by year, newest first, and one page per
+// activity under activities/{date}-{name}/. routeThumbnails holds SVG route
+// thumbnails keyed by showcase ID, copied next to their pages.
+func BuildSite(ownerName string, showcases []*pbactivity.ShowcasedActivity, routeThumbnails map[string][]byte) Site {
+	sorted := make([]*pbactivity.ShowcasedActivity, len(showcases))
+	copy(sorted, showcases)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetStartTime().AsTime().Before(sorted[j].GetStartTime().AsTime())
+	})
+
+	title := "Activities"
+	if ownerName != "" {
+		title = ownerName + "'s activities"
+	}
+
+	site := Site{
+		"_config.yml": []byte(fmt.Sprintf("title: %q\ndescription: \"Exported from FitGlue\"\ntheme: jekyll-theme-minimal\ndefaults:\n  - scope:\n      path: \"\"\n    values:\n      layout: default\n", title)),
+	}
+
+	var index strings.Builder
+	index.WriteString(fmt.Sprintf("---\ntitle: %q\n---\n\n# %s\n", title, title))
+
+	// Directories are assigned oldest first, so a later activity with the
+	// same date and name never renames an existing page
+	dirs := make([]string, len(sorted))
+	used := make(map[string]int)
+	for i, sc := range sorted {
+		slug := sc.GetStartTime().AsTime().Format("2006-01-02") + "-" + SanitizeFileName(activityName(sc))
+		used[slug]++
+		if n := used[slug]; n > 1 {
+			slug = fmt.Sprintf("%s-%d", slug, n)
+		}
+		dirs[i] = "activities/" + slug + "/"
+	}
+
+	year := ""
+	for i := len(sorted) - 1; i >= 0; i-- {
+		sc, dir := sorted[i], dirs[i]
+		start := sc.GetStartTime().AsTime()
+		name := activityName(sc)
+
+		description := sc.Description
+		if svg, ok := routeThumbnails[sc.ShowcaseId]; ok {
+			site[dir+routeThumbnailFile] = svg
+			description = strings.TrimSpace(fmt.Sprintf("![Route](%s)\n\n%s", routeThumbnailFile, description))
+		}
+
+		site[dir+"index.md"] = []byte(RenderMarkdown(Document{
+			Title:       name,
+			Type:        sc.ActivityType.String(),
+			Date:        start,
+			Source:      sc.Source.String(),
+			ActivityID:  sc.ActivityId,
+			Enrichments: sc.AppliedEnrichments,
+			Tags:        sc.Tags,
+			Description: description,
+		}))
+
+		if y := start.Format("2006"); y != year {
+			year = y
+			index.WriteString(fmt.Sprintf("\n## %s\n\n", year))
+		}
+		index.WriteString(fmt.Sprintf("- %s · [%s](%s) · %s\n",
+			start.Format("Jan 2"), escapeLinkText(name), dir, formatters.FormatActivityType(sc.ActivityType)))
+	}
+
+	if len(sorted) == 0 {
+		index.WriteString("\nNo activities yet.\n")
+	}
+	site["index.md"] = []byte(index.String())
+
+	return site
+}
+
+// activityName is the page title, falling back to the activity type.
+func activityName(sc *pbactivity.ShowcasedActivity) string {
+	if sc.Title != "" {
+		return sc.Title
+	}
+	return formatters.FormatActivityType(sc.ActivityType)
+}
+
+// escapeLinkText stops brackets in a title from breaking a markdown link.
+func escapeLinkText(s string) string {
+	return strings.NewReplacer("[", "\\[", "]", "\\]").Replace(s)
+}
+
+// Zip packs the site into a zip archive, files in path order.
+func (s Site) Zip(modified time.Time) ([]byte, error) {
+	paths := make([]string, 0, len(s))
+	for p := range s {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, p := range paths {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: p, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			return nil, fmt.Errorf("add %s: %w", p, err)
+		}
+		if _, err := w.Write(s[p]); err != nil {
+			return nil, fmt.Errorf("write %s: %w", p, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
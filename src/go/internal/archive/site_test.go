@@ -0,0 +1,108 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+func testShowcases() []*pbactivity.ShowcasedActivity {
+	base := time.Date(2025, 12, 30, 8, 0, 0, 0, time.UTC)
+	return []*pbactivity.ShowcasedActivity{
+		{
+			ShowcaseId:   "s1",
+			Title:        "Parkrun",
+			ActivityType: pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+			Source:       pbactivity.ActivitySource_SOURCE_STRAVA,
+			StartTime:    timestamppb.New(base),
+			Description:  "🏃 5k",
+		},
+		{
+			ShowcaseId:   "s2",
+			Title:        "Parkrun",
+			ActivityType: pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+			StartTime:    timestamppb.New(base.Add(2 * time.Hour)),
+		},
+		{
+			ShowcaseId:   "s3",
+			ActivityType: pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING,
+			StartTime:    timestamppb.New(base.AddDate(0, 0, 5)),
+		},
+	}
+}
+
+func TestBuildSite(t *testing.T) {
+	thumbnails := map[string][]byte{"s1": []byte("<svg/>")}
+	site := BuildSite("Jo", testShowcases(), thumbnails)
+
+	for _, path := range []string{
+		"_config.yml",
+		"index.md",
+		"activities/2025-12-30-parkrun/index.md",
+		"activities/2025-12-30-parkrun/route.svg",
+		"activities/2025-12-30-parkrun-2/index.md",
+		"activities/2026-01-04-weight-training/index.md",
+	} {
+		if _, ok := site[path]; !ok {
+			t.Errorf("expected %s in site, got %v", path, keys(site))
+		}
+	}
+	if len(site) != 6 {
+		t.Errorf("expected 6 files, got %v", keys(site))
+	}
+
+	index := string(site["index.md"])
+	newer := strings.Index(index, "## 2026")
+	older := strings.Index(index, "## 2025")
+	if newer == -1 || older == -1 || newer > older {
+		t.Errorf("expected years newest first:\n%s", index)
+	}
+	if !strings.Contains(index, "- Jan 4 · [Weight Training](activities/2026-01-04-weight-training/) · Weight Training\n") {
+		t.Errorf("unexpected index:\n%s", index)
+	}
+	if !strings.Contains(string(site["_config.yml"]), `title: "Jo's activities"`) {
+		t.Errorf("unexpected config:\n%s", site["_config.yml"])
+	}
+
+	page := string(site["activities/2025-12-30-parkrun/index.md"])
+	if !strings.Contains(page, "# Parkrun\n\n![Route](route.svg)\n\n🏃 5k\n") {
+		t.Errorf("expected route thumbnail above description:\n%s", page)
+	}
+}
+
+func TestBuildSite_Empty(t *testing.T) {
+	site := BuildSite("", nil, nil)
+	if !strings.Contains(string(site["index.md"]), "No activities yet.") {
+		t.Errorf("unexpected index:\n%s", site["index.md"])
+	}
+}
+
+func TestSiteZip(t *testing.T) {
+	site := Site{"index.md": []byte("# Hi"), "activities/a/index.md": []byte("# A")}
+	data, err := site.Zip(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("invalid zip: %v", err)
+	}
+	if len(zr.File) != 2 || zr.File[0].Name != "activities/a/index.md" || zr.File[1].Name != "index.md" {
+		t.Errorf("unexpected zip entries: %v", zr.File)
+	}
+}
+
+func keys(site Site) []string {
+	var out []string
+	for k := range site {
+		out = append(out, k)
+	}
+	return out
+}
@@ -0,0 +1,253 @@
+// nolint:proto-json
+package backfill
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/fitglue/server/src/go/pkg/loopprevention"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// CollectionBackfillJobs is the top-level collection the web app polls for progress
+	CollectionBackfillJobs = "backfill_jobs"
+	// CollectionImportSessions holds file import sessions, polled the same way
+	CollectionImportSessions = "import_sessions"
+)
+
+type FirestoreStore struct {
+	client *firestore.Client
+}
+
+func NewFirestoreStore(client *firestore.Client) *FirestoreStore {
+	return &FirestoreStore{client: client}
+}
+
+func (s *FirestoreStore) CreateJob(ctx context.Context, job *pipeline.BackfillJob) error {
+	data, err := encodeProto(job)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.Collection(CollectionBackfillJobs).Doc(job.Id).Create(ctx, data)
+	return err
+}
+
+func (s *FirestoreStore) GetJob(ctx context.Context, jobID string) (*pipeline.BackfillJob, error) {
+	doc, err := s.client.Collection(CollectionBackfillJobs).Doc(jobID).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeJob(doc)
+}
+
+func (s *FirestoreStore) UpdateJob(ctx context.Context, job *pipeline.BackfillJob) error {
+	data, err := encodeProto(job)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.Collection(CollectionBackfillJobs).Doc(job.Id).Set(ctx, data)
+	return err
+}
+
+func (s *FirestoreStore) AdvanceJob(ctx context.Context, job *pipeline.BackfillJob, fromPage int32, fromCursor string) (bool, error) {
+	data, err := encodeProto(job)
+	if err != nil {
+		return false, err
+	}
+	ref := s.client.Collection(CollectionBackfillJobs).Doc(job.Id)
+	var advanced bool
+	err = s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		advanced = false
+		doc, err := tx.Get(ref)
+		if err != nil {
+			return err
+		}
+		current, err := decodeJob(doc)
+		if err != nil {
+			return err
+		}
+		if current.PagesFetched != fromPage || current.Cursor != fromCursor || isFinished(current) {
+			return nil
+		}
+		advanced = true
+		return tx.Set(ref, data)
+	})
+	if err != nil {
+		return false, err
+	}
+	return advanced, nil
+}
+
+// StartJob creates a user-requested job unless its pipeline already has
+// one in progress, which it returns instead. The check and the create run
+// in one transaction so two requests can't both start a backfill. A job that
+// has made no progress for longer than MaxStall no longer counts.
+func (s *FirestoreStore) StartJob(ctx context.Context, job *pipeline.BackfillJob) (*pipeline.BackfillJob, error) {
+	data, err := encodeProto(job)
+	if err != nil {
+		return nil, err
+	}
+	query := s.client.Collection(CollectionBackfillJobs).
+		Where("user_id", "==", job.UserId).
+		Where("pipeline_id", "==", job.PipelineId)
+	var active *pipeline.BackfillJob
+	err = s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		active = nil
+		docs, err := tx.Documents(query).GetAll()
+		if err != nil {
+			return err
+		}
+		for _, doc := range docs {
+			existing, err := decodeJob(doc)
+			if err != nil {
+				return err
+			}
+			if InProgress(existing, time.Now()) {
+				active = existing
+				return nil
+			}
+		}
+		return tx.Create(s.client.Collection(CollectionBackfillJobs).Doc(job.Id), data)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return active, nil
+}
+
+func decodeJob(doc *firestore.DocumentSnapshot) (*pipeline.BackfillJob, error) {
+	b, err := json.Marshal(doc.Data())
+	if err != nil {
+		return nil, err
+	}
+	var job pipeline.BackfillJob
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(b, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (s *FirestoreStore) CreateImport(ctx context.Context, session *pipeline.ImportSession) error {
+	data, err := encodeProto(session)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.Collection(CollectionImportSessions).Doc(session.Id).Create(ctx, data)
+	return err
+}
+
+func (s *FirestoreStore) GetImport(ctx context.Context, sessionID string) (*pipeline.ImportSession, error) {
+	doc, err := s.client.Collection(CollectionImportSessions).Doc(sessionID).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeImport(doc)
+}
+
+func (s *FirestoreStore) UpdateImport(ctx context.Context, session *pipeline.ImportSession) error {
+	data, err := encodeProto(session)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.Collection(CollectionImportSessions).Doc(session.Id).Set(ctx, data)
+	return err
+}
+
+func (s *FirestoreStore) ModifyImport(ctx context.Context, sessionID string, fn func(*pipeline.ImportSession) error) (*pipeline.ImportSession, error) {
+	ref := s.client.Collection(CollectionImportSessions).Doc(sessionID)
+	var session *pipeline.ImportSession
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		session = nil
+		doc, err := tx.Get(ref)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil
+			}
+			return err
+		}
+		current, err := decodeImport(doc)
+		if err != nil {
+			return err
+		}
+		if err := fn(current); err != nil {
+			return err
+		}
+		data, err := encodeProto(current)
+		if err != nil {
+			return err
+		}
+		session = current
+		return tx.Set(ref, data)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func decodeImport(doc *firestore.DocumentSnapshot) (*pipeline.ImportSession, error) {
+	b, err := json.Marshal(doc.Data())
+	if err != nil {
+		return nil, err
+	}
+	var session pipeline.ImportSession
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(b, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (s *FirestoreStore) ActivitySeen(ctx context.Context, userID string, source pbactivity.ActivitySource, externalID string) (bool, error) {
+	userDoc := s.client.Collection("users").Doc(userID)
+
+	runs, err := userDoc.Collection("pipeline_runs").
+		Where("source", "==", source.String()).
+		Where("source_activity_id", "==", externalID).
+		Limit(1).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return false, err
+	}
+	if len(runs) > 0 {
+		return true, nil
+	}
+
+	dest := loopprevention.GetCorrespondingDestination(source)
+	if dest == pbplugin.DestinationType_DESTINATION_UNSPECIFIED {
+		return false, nil
+	}
+	uploads, err := userDoc.Collection("uploaded_activities").
+		Where("destination", "==", int32(dest)).
+		Where("destination_id", "==", externalID).
+		Limit(1).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return false, err
+	}
+	return len(uploads) > 0, nil
+}
+
+func encodeProto(m proto.Message) (map[string]interface{}, error) {
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var data map[string]interface{}
+	err = json.Unmarshal(b, &data)
+	return data, err
+}
@@ -0,0 +1,98 @@
+// nolint:proto-json
+package backfill
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+// fitbitPageSize is the maximum page size of the Fitbit activity log list
+const fitbitPageSize = 100
+
+// FitbitPager walks the activity log list oldest first. Unlike the webhook,
+// which forwards a whole day's summary, each logged activity is published
+// individually.
+type FitbitPager struct {
+	baseURL string
+	http    *rateLimitedClient
+}
+
+// NewFitbitPager paces requests to stay within Fitbit's 150 requests per
+// user per hour.
+func NewFitbitPager() *FitbitPager {
+	return &FitbitPager{
+		baseURL: "https://api.fitbit.com/1",
+		http:    newRateLimitedClient(30 * time.Second),
+	}
+}
+
+func (p *FitbitPager) Source() pbactivity.ActivitySource {
+	return pbactivity.ActivitySource_SOURCE_FITBIT
+}
+
+func (p *FitbitPager) FetchPage(ctx context.Context, userSvc userpb.UserServiceClient, userID string, since time.Time, cursor string) (*Page, error) {
+	integResp, err := userSvc.GetIntegration(ctx, &userpb.GetIntegrationRequest{
+		UserId:   userID,
+		Provider: "fitbit",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get integration for user: %w", err)
+	}
+	fitbitInteg := integResp.Integrations.Fitbit
+	if fitbitInteg == nil || fitbitInteg.AccessToken == "" {
+		return nil, fmt.Errorf("fitbit integration not found or access token missing")
+	}
+
+	offset := 0
+	if cursor != "" {
+		if offset, err = strconv.Atoi(cursor); err != nil {
+			return nil, fmt.Errorf("invalid fitbit cursor %q: %w", cursor, err)
+		}
+	}
+
+	url := fmt.Sprintf("%s/user/-/activities/list.json?afterDate=%s&sort=asc&offset=%d&limit=%d",
+		p.baseURL, since.Format("2006-01-02"), offset, fitbitPageSize)
+	body, err := p.http.get(ctx, url, map[string]string{"Authorization": "Bearer " + fitbitInteg.AccessToken})
+	if err != nil {
+		return nil, fmt.Errorf("fitbit list activities: %w", err)
+	}
+
+	var listing struct {
+		Activities []json.RawMessage `json:"activities"`
+		Pagination struct {
+			Next string `json:"next"`
+		} `json:"pagination"`
+	}
+	if err := json.Unmarshal(body, &listing); err != nil {
+		return nil, fmt.Errorf("failed to decode fitbit activity list: %w", err)
+	}
+
+	result := &Page{}
+	for _, raw := range listing.Activities {
+		var item struct {
+			LogID json.Number `json:"logId"`
+		}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, fmt.Errorf("failed to decode fitbit activity: %w", err)
+		}
+		logID := item.LogID.String()
+		result.Activities = append(result.Activities, &pbevents.ActivityPayload{
+			Source:              pbactivity.ActivitySource_SOURCE_FITBIT,
+			UserId:              userID,
+			OriginalPayloadJson: string(raw),
+			ActivityId:          &logID,
+		})
+	}
+
+	if listing.Pagination.Next != "" && len(listing.Activities) > 0 {
+		result.NextCursor = strconv.Itoa(offset + len(listing.Activities))
+	}
+	return result, nil
+}
@@ -0,0 +1,97 @@
+// nolint:proto-json
+package backfill
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/domain/activity"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+// hevyPageSize is the maximum page size the Hevy workouts endpoint accepts
+const hevyPageSize = 10
+
+// HevyPager lists workouts newest first and stops at the first workout that
+// started before the backfill window.
+type HevyPager struct {
+	baseURL string
+	http    *rateLimitedClient
+}
+
+func NewHevyPager() *HevyPager {
+	return &HevyPager{
+		baseURL: "https://api.hevyapp.com/v1",
+		http:    newRateLimitedClient(time.Second),
+	}
+}
+
+func (p *HevyPager) Source() pbactivity.ActivitySource {
+	return pbactivity.ActivitySource_SOURCE_HEVY
+}
+
+func (p *HevyPager) FetchPage(ctx context.Context, userSvc userpb.UserServiceClient, userID string, since time.Time, cursor string) (*Page, error) {
+	secretResp, err := userSvc.GetIntegrationSecret(ctx, &userpb.GetIntegrationSecretRequest{
+		UserId:   userID,
+		Provider: "hevy",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hevy api key for user: %w", err)
+	}
+	if secretResp.Secret == "" {
+		return nil, fmt.Errorf("hevy integration not found or api key missing")
+	}
+
+	page := 1
+	if cursor != "" {
+		if page, err = strconv.Atoi(cursor); err != nil {
+			return nil, fmt.Errorf("invalid hevy cursor %q: %w", cursor, err)
+		}
+	}
+
+	url := fmt.Sprintf("%s/workouts?page=%d&pageSize=%d", p.baseURL, page, hevyPageSize)
+	body, err := p.http.get(ctx, url, map[string]string{"api-key": secretResp.Secret})
+	if err != nil {
+		return nil, fmt.Errorf("hevy list workouts: %w", err)
+	}
+
+	var listing struct {
+		PageCount int               `json:"page_count"`
+		Workouts  []json.RawMessage `json:"workouts"`
+	}
+	if err := json.Unmarshal(body, &listing); err != nil {
+		return nil, fmt.Errorf("failed to decode hevy workout list: %w", err)
+	}
+
+	result := &Page{}
+	reachedWindowStart := false
+	for _, raw := range listing.Workouts {
+		stdActivity, err := activity.MapHevyWorkout(raw, userID, pbactivity.ActivitySource_SOURCE_HEVY)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse hevy workout to standardized activity: %w", err)
+		}
+		if stdActivity.StartTime.AsTime().Before(since) {
+			reachedWindowStart = true
+			break
+		}
+
+		workoutID := stdActivity.ExternalId
+		result.Activities = append(result.Activities, &pbevents.ActivityPayload{
+			Source:               pbactivity.ActivitySource_SOURCE_HEVY,
+			UserId:               userID,
+			OriginalPayloadJson:  string(raw),
+			ActivityId:           &workoutID,
+			StandardizedActivity: stdActivity,
+		})
+	}
+
+	if !reachedWindowStart && page < listing.PageCount {
+		result.NextCursor = strconv.Itoa(page + 1)
+	}
+	return result, nil
+}
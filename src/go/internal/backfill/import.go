@@ -0,0 +1,301 @@
+// nolint:proto-json
+package backfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/domain/fit_parser"
+	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+const (
+	// MaxImportFiles caps the files in one import session.
+	MaxImportFiles = 1000
+	// MaxImportFileBytes caps the size of each uploaded file.
+	MaxImportFileBytes = 10 << 20
+)
+
+// ErrImportState is returned when an import session is not in a state that
+// allows the requested change.
+var ErrImportState = errors.New("import session state")
+
+// NewImport builds a session waiting for the named files to be uploaded.
+func NewImport(userID, pipelineID string, fileNames []string, now time.Time) *pipeline.ImportSession {
+	files := make([]*pipeline.ImportFile, len(fileNames))
+	for i, name := range fileNames {
+		files[i] = &pipeline.ImportFile{Name: name, Status: pipeline.ImportFileStatus_IMPORT_FILE_STATUS_PENDING}
+	}
+	return &pipeline.ImportSession{
+		Id:         uuid.NewString(),
+		UserId:     userID,
+		PipelineId: pipelineID,
+		Status:     pipeline.ImportStatus_IMPORT_STATUS_UPLOADING,
+		Files:      files,
+		CreatedAt:  timestamppb.New(now),
+		UpdatedAt:  timestamppb.New(now),
+	}
+}
+
+// ImportFileURI is where the file at index of a session is stored.
+func ImportFileURI(bucket string, session *pipeline.ImportSession, index int) string {
+	return fmt.Sprintf("gs://%s/imports/%s/%s/%d.fit", bucket, session.UserId, session.Id, index)
+}
+
+// MarkImportFileUploaded records the file at index as uploaded to uri. A file
+// that already completed cannot be replaced, and nothing can be uploaded
+// while the session is running.
+func MarkImportFileUploaded(session *pipeline.ImportSession, index int, uri string, now time.Time) error {
+	if session.Status == pipeline.ImportStatus_IMPORT_STATUS_RUNNING {
+		return fmt.Errorf("%w: import is running", ErrImportState)
+	}
+	if index < 0 || index >= len(session.Files) {
+		return fmt.Errorf("%w: session has no file %d", ErrImportState, index)
+	}
+	file := session.Files[index]
+	switch file.Status {
+	case pipeline.ImportFileStatus_IMPORT_FILE_STATUS_COMPLETED:
+		return fmt.Errorf("%w: file %d was already imported", ErrImportState, index)
+	case pipeline.ImportFileStatus_IMPORT_FILE_STATUS_FAILED:
+		session.FilesFailed--
+	}
+	file.Status = pipeline.ImportFileStatus_IMPORT_FILE_STATUS_UPLOADED
+	file.Uri = uri
+	file.Error = nil
+	session.UpdatedAt = timestamppb.New(now)
+	return nil
+}
+
+// StartImport sets the session running, for the first time or to resume it.
+// Completed files are kept, failed files are tried again, and every file must
+// have been uploaded.
+func StartImport(session *pipeline.ImportSession, now time.Time) error {
+	if session.Status == pipeline.ImportStatus_IMPORT_STATUS_RUNNING {
+		return fmt.Errorf("%w: import is already running", ErrImportState)
+	}
+	pending := 0
+	for _, file := range session.Files {
+		if file.Status == pipeline.ImportFileStatus_IMPORT_FILE_STATUS_PENDING {
+			pending++
+		}
+	}
+	if pending > 0 {
+		return fmt.Errorf("%w: %d of %d files have not been uploaded", ErrImportState, pending, len(session.Files))
+	}
+
+	remaining := 0
+	for _, file := range session.Files {
+		switch file.Status {
+		case pipeline.ImportFileStatus_IMPORT_FILE_STATUS_FAILED:
+			file.Status = pipeline.ImportFileStatus_IMPORT_FILE_STATUS_UPLOADED
+			file.Error = nil
+			remaining++
+		case pipeline.ImportFileStatus_IMPORT_FILE_STATUS_UPLOADED:
+			remaining++
+		}
+	}
+	if remaining == 0 {
+		return fmt.Errorf("%w: every file has already been imported", ErrImportState)
+	}
+
+	session.Status = pipeline.ImportStatus_IMPORT_STATUS_RUNNING
+	session.FilesFailed = 0
+	session.Error = nil
+	session.CompletedAt = nil
+	session.UpdatedAt = timestamppb.New(now)
+	return nil
+}
+
+// RequestImport publishes the event that makes the backfill service process
+// the session's next files.
+func RequestImport(ctx context.Context, publisher Publisher, sessionID string) error {
+	ce, err := infrapubsub.NewCloudEvent(
+		infrapubsub.GetCloudEventSource(pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_BACKFILL),
+		infrapubsub.GetCloudEventType(pbevents.CloudEventType_CLOUD_EVENT_TYPE_IMPORT_REQUESTED),
+		&pbevents.ImportRequestedEvent{SessionId: sessionID},
+	)
+	if err != nil {
+		return fmt.Errorf("create cloud event: %w", err)
+	}
+	if _, err := publisher.PublishCloudEvent(ctx, shared.TopicImportRequested, ce); err != nil {
+		return fmt.Errorf("publish: %w", err)
+	}
+	return nil
+}
+
+// Importer processes import sessions one activity batch per Pub/Sub message,
+// saving each file's status as it goes. Completed files are never processed
+// again, so redelivery and resumed sessions pick up where they stopped.
+type Importer struct {
+	store     ImportStore
+	files     FileStore
+	publisher Publisher
+	logger    infra.Logger
+}
+
+func NewImporter(store ImportStore, files FileStore, publisher Publisher, logger infra.Logger) *Importer {
+	return &Importer{
+		store:     store,
+		files:     files,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// HandlePubSubPush unwraps a Pub/Sub push envelope carrying an ImportRequestedEvent
+func (i *Importer) HandlePubSubPush(w http.ResponseWriter, r *http.Request) {
+	handlePush(i.logger, w, r, "import", i.ProcessImport)
+}
+
+// ProcessImport parses the session's next uploaded files, publishes them as
+// one activity batch targeted at the session's pipeline and schedules the
+// rest. A batch that cannot be published fails the session, which the user
+// can then start again to resume. If saving progress fails after a batch was
+// published, the redelivery publishes those files again.
+func (i *Importer) ProcessImport(ctx context.Context, ce *event.Event) error {
+	var req pbevents.ImportRequestedEvent
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(ce.Data(), &req); err != nil {
+		i.logger.Error(ctx, "Failed to unmarshal ImportRequestedEvent", "error", err)
+		return nil // Ack malformed payloads
+	}
+
+	session, err := i.store.GetImport(ctx, req.SessionId)
+	if err != nil {
+		return fmt.Errorf("get import: %w", err)
+	}
+	if session == nil {
+		i.logger.Warn(ctx, "Import session not found, dropping request", "session_id", req.SessionId)
+		return nil
+	}
+	if session.Status != pipeline.ImportStatus_IMPORT_STATUS_RUNNING {
+		i.logger.Info(ctx, "Import session not running, ignoring request", "session_id", session.Id, "status", session.Status.String())
+		return nil
+	}
+
+	var payloads []*pbevents.ActivityPayload
+	var indexes []int
+	attempted := 0
+	for idx, file := range session.Files {
+		if attempted == infrapubsub.MaxBatchActivities {
+			break
+		}
+		if file.Status != pipeline.ImportFileStatus_IMPORT_FILE_STATUS_UPLOADED {
+			continue
+		}
+		attempted++
+		payload, err := i.loadFile(ctx, session, idx)
+		if err != nil {
+			i.logger.Warn(ctx, "Failed to load import file", "session_id", session.Id, "index", idx, "error", err)
+			msg := err.Error()
+			file.Status = pipeline.ImportFileStatus_IMPORT_FILE_STATUS_FAILED
+			file.Error = &msg
+			session.FilesFailed++
+			continue
+		}
+		payloads = append(payloads, payload)
+		indexes = append(indexes, idx)
+	}
+
+	if err := i.publish(ctx, session, payloads, indexes); err != nil {
+		i.logger.Error(ctx, "Failed to publish import batch", "session_id", session.Id, "error", err)
+		msg := err.Error()
+		session.Status = pipeline.ImportStatus_IMPORT_STATUS_FAILED
+		session.Error = &msg
+	}
+
+	now := timestamppb.Now()
+	session.UpdatedAt = now
+	remaining := false
+	for _, file := range session.Files {
+		if file.Status == pipeline.ImportFileStatus_IMPORT_FILE_STATUS_UPLOADED {
+			remaining = true
+			break
+		}
+	}
+	if !remaining && session.Status == pipeline.ImportStatus_IMPORT_STATUS_RUNNING {
+		session.Status = pipeline.ImportStatus_IMPORT_STATUS_COMPLETED
+		session.CompletedAt = now
+	}
+
+	if err := i.store.UpdateImport(ctx, session); err != nil {
+		return fmt.Errorf("update import: %w", err)
+	}
+
+	i.logger.Info(ctx, "Processed import files", "session_id", session.Id, "completed", session.FilesCompleted, "failed", session.FilesFailed, "total", len(session.Files), "status", session.Status.String())
+
+	if session.Status != pipeline.ImportStatus_IMPORT_STATUS_RUNNING {
+		return nil
+	}
+	return RequestImport(ctx, i.publisher, session.Id)
+}
+
+// loadFile parses an uploaded FIT file into a payload targeted at the
+// session's pipeline.
+func (i *Importer) loadFile(ctx context.Context, session *pipeline.ImportSession, index int) (*pbevents.ActivityPayload, error) {
+	data, err := i.files.Get(ctx, "", session.Files[index].Uri)
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+	activity, err := fit_parser.ParseFitFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse FIT file: %w", err)
+	}
+
+	// Stable per file, so a file published twice is recognizably the same
+	externalID := fmt.Sprintf("import_%s_%d", session.Id, index)
+	activity.UserId = session.UserId
+	activity.ExternalId = externalID
+
+	pipelineID := session.PipelineId
+	execID := uuid.NewString()
+	return &pbevents.ActivityPayload{
+		Source:               pbactivity.ActivitySource_SOURCE_FILE_UPLOAD,
+		UserId:               session.UserId,
+		Timestamp:            timestamppb.Now(),
+		StandardizedActivity: activity,
+		ActivityId:           &externalID,
+		PipelineId:           &pipelineID,
+		PipelineExecutionId:  &execID,
+		IsBackfill:           true,
+	}, nil
+}
+
+// publish sends the payloads as activity batches and marks the files of each
+// published batch completed. It stops at the first batch that fails.
+func (i *Importer) publish(ctx context.Context, session *pipeline.ImportSession, payloads []*pbevents.ActivityPayload, indexes []int) error {
+	batches, err := infrapubsub.BatchActivityPayloads(payloads)
+	if err != nil {
+		return fmt.Errorf("batch activities: %w", err)
+	}
+	next := 0
+	for _, batch := range batches {
+		ce, err := infrapubsub.NewActivityBatchEvent(infrapubsub.GetCloudEventSource(pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_BACKFILL), batch)
+		if err != nil {
+			return fmt.Errorf("create cloud event: %w", err)
+		}
+		if _, err := i.publisher.PublishCloudEvent(ctx, shared.TopicPipelineActivity, ce); err != nil {
+			return fmt.Errorf("publish: %w", err)
+		}
+		for _, payload := range batch.Payloads {
+			file := session.Files[indexes[next]]
+			file.Status = pipeline.ImportFileStatus_IMPORT_FILE_STATUS_COMPLETED
+			file.PipelineExecutionId = payload.GetPipelineExecutionId()
+			session.FilesCompleted++
+			next++
+		}
+	}
+	return nil
+}
@@ -0,0 +1,282 @@
+// nolint:proto-json
+package backfill
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/domain/file_generators"
+	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+type mockImportStore struct {
+	sessions map[string]*pipeline.ImportSession
+}
+
+func (m *mockImportStore) CreateImport(ctx context.Context, session *pipeline.ImportSession) error {
+	m.sessions[session.Id] = proto.Clone(session).(*pipeline.ImportSession)
+	return nil
+}
+
+func (m *mockImportStore) GetImport(ctx context.Context, sessionID string) (*pipeline.ImportSession, error) {
+	session, ok := m.sessions[sessionID]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(session).(*pipeline.ImportSession), nil
+}
+
+func (m *mockImportStore) UpdateImport(ctx context.Context, session *pipeline.ImportSession) error {
+	m.sessions[session.Id] = proto.Clone(session).(*pipeline.ImportSession)
+	return nil
+}
+
+func (m *mockImportStore) ModifyImport(ctx context.Context, sessionID string, fn func(*pipeline.ImportSession) error) (*pipeline.ImportSession, error) {
+	session, _ := m.GetImport(ctx, sessionID)
+	if session == nil {
+		return nil, nil
+	}
+	if err := fn(session); err != nil {
+		return nil, err
+	}
+	return session, m.UpdateImport(ctx, session)
+}
+
+type mockFileStore struct {
+	files map[string][]byte
+}
+
+func (m *mockFileStore) Write(ctx context.Context, bucket, object string, data []byte) error {
+	m.files[object] = data
+	return nil
+}
+
+func (m *mockFileStore) Get(ctx context.Context, bucket, object string) ([]byte, error) {
+	data, ok := m.files[object]
+	if !ok {
+		return nil, errors.New("object not found")
+	}
+	return data, nil
+}
+
+// failingPublisher fails activity batches once it has published limit of them
+type failingPublisher struct {
+	mockPublisher
+	limit int
+}
+
+func (m *failingPublisher) PublishCloudEvent(ctx context.Context, topic string, ce cloudevents.Event) (string, error) {
+	if topic == shared.TopicPipelineActivity && len(m.onTopic(topic)) >= m.limit {
+		return "", errors.New("pubsub unavailable")
+	}
+	return m.mockPublisher.PublishCloudEvent(ctx, topic, ce)
+}
+
+func importRequest(t *testing.T, sessionID string) *cloudevents.Event {
+	t.Helper()
+	pub := &mockPublisher{}
+	if err := RequestImport(context.Background(), pub, sessionID); err != nil {
+		t.Fatalf("RequestImport: %v", err)
+	}
+	return &pub.events[0].ce
+}
+
+func TestProcessImport(t *testing.T) {
+	ctx := context.Background()
+	logger := infra.NewLogger()
+
+	start := time.Date(2026, 5, 1, 18, 0, 0, 0, time.UTC)
+	fitData, err := file_generators.GenerateFitFile(&pbactivity.StandardizedActivity{
+		StartTime: timestamppb.New(start),
+		Type:      pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		Sessions: []*pbactivity.Session{
+			{StartTime: timestamppb.New(start), TotalElapsedTime: 60},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GenerateFitFile: %v", err)
+	}
+
+	// newFixture creates a running session of n uploaded files, the one at
+	// badIndex (if any) not being a FIT file
+	newFixture := func(n, badIndex int) (*mockImportStore, *mockFileStore, *pipeline.ImportSession) {
+		store := &mockImportStore{sessions: map[string]*pipeline.ImportSession{}}
+		files := &mockFileStore{files: map[string][]byte{}}
+		names := make([]string, n)
+		for i := range names {
+			names[i] = "activity.fit"
+		}
+		session := NewImport("user-1", "pipe-1", names, time.Now())
+		for i := range names {
+			uri := ImportFileURI("bucket", session, i)
+			data := fitData
+			if i == badIndex {
+				data = []byte("not a fit file")
+			}
+			_ = files.Write(ctx, "", uri, data)
+			if err := MarkImportFileUploaded(session, i, uri, time.Now()); err != nil {
+				t.Fatalf("MarkImportFileUploaded: %v", err)
+			}
+		}
+		if err := StartImport(session, time.Now()); err != nil {
+			t.Fatalf("StartImport: %v", err)
+		}
+		_ = store.CreateImport(ctx, session)
+		return store, files, session
+	}
+
+	t.Run("publishes one batch per message and schedules the rest", func(t *testing.T) {
+		store, files, session := newFixture(infrapubsub.MaxBatchActivities+2, 1)
+		pub := &mockPublisher{}
+		importer := NewImporter(store, files, pub, logger)
+
+		if err := importer.ProcessImport(ctx, importRequest(t, session.Id)); err != nil {
+			t.Fatalf("ProcessImport: %v", err)
+		}
+
+		batches := pub.onTopic(shared.TopicPipelineActivity)
+		if len(batches) != 1 {
+			t.Fatalf("expected 1 activity batch, got %d", len(batches))
+		}
+		var batch pbevents.ActivityPayloadBatch
+		if err := protojson.Unmarshal(batches[0].Data(), &batch); err != nil {
+			t.Fatalf("unmarshal batch: %v", err)
+		}
+		if len(batch.Payloads) != infrapubsub.MaxBatchActivities-1 {
+			t.Errorf("expected %d payloads, got %d", infrapubsub.MaxBatchActivities-1, len(batch.Payloads))
+		}
+		p := batch.Payloads[0]
+		if p.GetPipelineId() != "pipe-1" || !p.IsBackfill || p.Source != pbactivity.ActivitySource_SOURCE_FILE_UPLOAD {
+			t.Errorf("expected a file upload targeted at pipe-1, got %+v", p)
+		}
+		if next := pub.onTopic(shared.TopicImportRequested); len(next) != 1 {
+			t.Errorf("expected the rest to be requested, got %d requests", len(next))
+		}
+
+		saved := store.sessions[session.Id]
+		if saved.Status != pipeline.ImportStatus_IMPORT_STATUS_RUNNING || saved.FilesCompleted != int32(infrapubsub.MaxBatchActivities-1) || saved.FilesFailed != 1 {
+			t.Errorf("unexpected progress: status=%s completed=%d failed=%d", saved.Status, saved.FilesCompleted, saved.FilesFailed)
+		}
+		if bad := saved.Files[1]; bad.Status != pipeline.ImportFileStatus_IMPORT_FILE_STATUS_FAILED || !strings.Contains(bad.GetError(), "parse FIT file") {
+			t.Errorf("expected the bad file to fail parsing, got %s %q", bad.Status, bad.GetError())
+		}
+		if saved.Files[0].PipelineExecutionId != p.GetPipelineExecutionId() {
+			t.Errorf("expected the file to record its pipeline execution id")
+		}
+
+		// The follow-up message finishes the session
+		if err := importer.ProcessImport(ctx, importRequest(t, session.Id)); err != nil {
+			t.Fatalf("ProcessImport: %v", err)
+		}
+		saved = store.sessions[session.Id]
+		if saved.Status != pipeline.ImportStatus_IMPORT_STATUS_COMPLETED || saved.CompletedAt == nil || saved.FilesCompleted != int32(infrapubsub.MaxBatchActivities+1) {
+			t.Errorf("expected COMPLETED with every good file, got %s completed=%d", saved.Status, saved.FilesCompleted)
+		}
+		if next := pub.onTopic(shared.TopicImportRequested); len(next) != 1 {
+			t.Errorf("expected no further requests, got %d in total", len(next))
+		}
+	})
+
+	t.Run("resumes after a failure without repeating completed files", func(t *testing.T) {
+		store, files, session := newFixture(infrapubsub.MaxBatchActivities*2, -1)
+		pub := &failingPublisher{limit: 1}
+		importer := NewImporter(store, files, pub, logger)
+
+		for i := 0; i < 2; i++ {
+			if err := importer.ProcessImport(ctx, importRequest(t, session.Id)); err != nil {
+				t.Fatalf("ProcessImport: %v", err)
+			}
+		}
+		saved := store.sessions[session.Id]
+		if saved.Status != pipeline.ImportStatus_IMPORT_STATUS_FAILED || saved.GetError() == "" {
+			t.Fatalf("expected FAILED with an error, got %s", saved.Status)
+		}
+		if saved.FilesCompleted != int32(infrapubsub.MaxBatchActivities) {
+			t.Errorf("expected the first batch to stay completed, got %d", saved.FilesCompleted)
+		}
+
+		// Redelivery of the request is ignored while the session is failed
+		if err := importer.ProcessImport(ctx, importRequest(t, session.Id)); err != nil {
+			t.Fatalf("ProcessImport: %v", err)
+		}
+
+		if err := StartImport(saved, time.Now()); err != nil {
+			t.Fatalf("StartImport: %v", err)
+		}
+		_ = store.UpdateImport(ctx, saved)
+		pub.limit = 2
+		if err := importer.ProcessImport(ctx, importRequest(t, session.Id)); err != nil {
+			t.Fatalf("ProcessImport: %v", err)
+		}
+
+		saved = store.sessions[session.Id]
+		if saved.Status != pipeline.ImportStatus_IMPORT_STATUS_COMPLETED || saved.FilesCompleted != int32(infrapubsub.MaxBatchActivities*2) {
+			t.Errorf("expected COMPLETED with every file, got %s completed=%d", saved.Status, saved.FilesCompleted)
+		}
+		if batches := pub.onTopic(shared.TopicPipelineActivity); len(batches) != 2 {
+			t.Errorf("expected each file to be published once, got %d batches", len(batches))
+		}
+	})
+
+	t.Run("drops requests for unknown sessions", func(t *testing.T) {
+		store := &mockImportStore{sessions: map[string]*pipeline.ImportSession{}}
+		pub := &mockPublisher{}
+		if err := NewImporter(store, &mockFileStore{}, pub, logger).ProcessImport(ctx, importRequest(t, "missing")); err != nil {
+			t.Fatalf("ProcessImport: %v", err)
+		}
+		if len(pub.events) != 0 {
+			t.Errorf("expected nothing published, got %d events", len(pub.events))
+		}
+	})
+}
+
+func TestStartImport(t *testing.T) {
+	session := NewImport("user-1", "pipe-1", []string{"a.fit", "b.fit"}, time.Now())
+
+	if err := StartImport(session, time.Now()); !errors.Is(err, ErrImportState) || !strings.Contains(err.Error(), "2 of 2 files") {
+		t.Errorf("expected un-uploaded files to block the start, got %v", err)
+	}
+
+	_ = MarkImportFileUploaded(session, 0, "gs://b/0.fit", time.Now())
+	_ = MarkImportFileUploaded(session, 1, "gs://b/1.fit", time.Now())
+	if err := StartImport(session, time.Now()); err != nil {
+		t.Fatalf("StartImport: %v", err)
+	}
+	if err := StartImport(session, time.Now()); !errors.Is(err, ErrImportState) {
+		t.Errorf("expected a running import not to start again, got %v", err)
+	}
+	if err := MarkImportFileUploaded(session, 0, "gs://b/0.fit", time.Now()); !errors.Is(err, ErrImportState) {
+		t.Errorf("expected uploads to be refused while running, got %v", err)
+	}
+
+	// A finished session with a failure retries just that file
+	session.Status = pipeline.ImportStatus_IMPORT_STATUS_COMPLETED
+	session.Files[0].Status = pipeline.ImportFileStatus_IMPORT_FILE_STATUS_COMPLETED
+	session.Files[1].Status = pipeline.ImportFileStatus_IMPORT_FILE_STATUS_FAILED
+	session.FilesCompleted, session.FilesFailed = 1, 1
+	if err := StartImport(session, time.Now()); err != nil {
+		t.Fatalf("StartImport resume: %v", err)
+	}
+	if session.Files[0].Status != pipeline.ImportFileStatus_IMPORT_FILE_STATUS_COMPLETED || session.Files[1].Status != pipeline.ImportFileStatus_IMPORT_FILE_STATUS_UPLOADED || session.FilesFailed != 0 {
+		t.Errorf("expected only the failed file to be retried, got %v", session.Files)
+	}
+
+	session.Status = pipeline.ImportStatus_IMPORT_STATUS_COMPLETED
+	session.Files[1].Status = pipeline.ImportFileStatus_IMPORT_FILE_STATUS_COMPLETED
+	if err := StartImport(session, time.Now()); !errors.Is(err, ErrImportState) {
+		t.Errorf("expected a fully imported session not to start again, got %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package backfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+// Page is a single page of a source's activity history.
+type Page struct {
+	// Activities are source payloads in the same shape the webhook providers
+	// publish; the service stamps the pipeline and backfill fields.
+	Activities []*pbevents.ActivityPayload
+	// NextCursor resumes paging on the next call; empty once history is exhausted.
+	NextCursor string
+}
+
+// Pager walks a source API's history from a start time, one page per call.
+type Pager interface {
+	Source() pbactivity.ActivitySource
+	FetchPage(ctx context.Context, userSvc userpb.UserServiceClient, userID string, since time.Time, cursor string) (*Page, error)
+}
+
+// rateLimitedClient spaces out requests to stay under a source's API quota.
+// Backfills share quota with live webhooks, so limits are deliberately
+// conservative.
+type rateLimitedClient struct {
+	client  *http.Client
+	limiter *rate.Limiter
+}
+
+func newRateLimitedClient(every time.Duration) *rateLimitedClient {
+	return &rateLimitedClient{
+		client:  &http.Client{Timeout: 30 * time.Second},
+		limiter: rate.NewLimiter(rate.Every(every), 1),
+	}
+}
+
+// get performs a GET after waiting for the limiter and returns the body of a 200 response
+func (c *rateLimitedClient) get(ctx context.Context, url string, headers map[string]string) ([]byte, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &StatusError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+	return body, nil
+}
+
+// StatusError is a non-200 response from a source API.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("status=%d body=%s", e.StatusCode, e.Body)
+}
+
+// retryable reports whether a failed page fetch is worth trying again: rate
+// limits, server errors, timeouts and an unavailable user service are.
+// Anything else, such as a revoked token or a missing integration, will fail
+// the same way next time.
+func retryable(err error) bool {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= http.StatusInternalServerError
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+type mockUserServiceClient struct {
+	userpb.UserServiceClient
+	integrations *pbuser.UserIntegrations
+	secret       string
+}
+
+func (m *mockUserServiceClient) GetIntegration(ctx context.Context, in *userpb.GetIntegrationRequest, opts ...grpc.CallOption) (*userpb.GetIntegrationResponse, error) {
+	return &userpb.GetIntegrationResponse{Integrations: m.integrations}, nil
+}
+
+func (m *mockUserServiceClient) GetIntegrationSecret(ctx context.Context, in *userpb.GetIntegrationSecretRequest, opts ...grpc.CallOption) (*userpb.GetIntegrationSecretResponse, error) {
+	return &userpb.GetIntegrationSecretResponse{Secret: m.secret}, nil
+}
+
+func testClient() *rateLimitedClient {
+	return newRateLimitedClient(time.Millisecond)
+}
+
+func TestStravaPager_FetchPage(t *testing.T) {
+	since := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer tok" {
+			t.Errorf("missing bearer token")
+		}
+		switch r.URL.Path {
+		case "/athlete/activities":
+			if got := r.URL.Query().Get("after"); got != fmt.Sprint(since.Unix()) {
+				t.Errorf("expected after=%d, got %s", since.Unix(), got)
+			}
+			if r.URL.Query().Get("page") != "3" {
+				t.Errorf("expected page 3 from cursor, got %s", r.URL.Query().Get("page"))
+			}
+			fmt.Fprint(w, `[{"id": 11}, {"id": 12}]`)
+		default:
+			fmt.Fprintf(w, `{"id": %s, "name": "detail"}`, r.URL.Path[len("/activities/"):])
+		}
+	}))
+	defer srv.Close()
+
+	p := &StravaPager{baseURL: srv.URL, http: testClient()}
+	userSvc := &mockUserServiceClient{integrations: &pbuser.UserIntegrations{
+		Strava: &pbuser.StravaIntegration{AccessToken: "tok"},
+	}}
+
+	page, err := p.FetchPage(context.Background(), userSvc, "user-1", since, "3")
+	if err != nil {
+		t.Fatalf("FetchPage: %v", err)
+	}
+	if len(page.Activities) != 2 {
+		t.Fatalf("expected 2 activities, got %d", len(page.Activities))
+	}
+	if page.Activities[1].GetActivityId() != "12" || page.Activities[1].OriginalPayloadJson != `{"id": 12, "name": "detail"}` {
+		t.Errorf("unexpected payload: %+v", page.Activities[1])
+	}
+	if page.NextCursor != "" {
+		t.Errorf("expected short page to end paging, got cursor %q", page.NextCursor)
+	}
+}
+
+func TestHevyPager_FetchPage(t *testing.T) {
+	since := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("continues while the page is inside the window", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("api-key") != "key" {
+				t.Errorf("missing api key")
+			}
+			fmt.Fprint(w, `{"page": 1, "page_count": 4, "workouts": [
+				{"id": "w2", "title": "Pull", "start_time": "2026-09-02T08:00:00Z", "end_time": "2026-09-02T09:00:00Z"},
+				{"id": "w1", "title": "Push", "start_time": "2026-09-01T08:00:00Z", "end_time": "2026-09-01T09:00:00Z"}
+			]}`)
+		}))
+		defer srv.Close()
+
+		p := &HevyPager{baseURL: srv.URL, http: testClient()}
+		userSvc := &mockUserServiceClient{integrations: &pbuser.UserIntegrations{Hevy: &pbuser.HevyIntegration{Enabled: true}}, secret: "key"}
+
+		page, err := p.FetchPage(context.Background(), userSvc, "user-1", since, "")
+		if err != nil {
+			t.Fatalf("FetchPage: %v", err)
+		}
+		if len(page.Activities) != 2 || page.NextCursor != "2" {
+			t.Fatalf("expected 2 activities and cursor 2, got %d %q", len(page.Activities), page.NextCursor)
+		}
+		if page.Activities[0].StandardizedActivity == nil || page.Activities[0].StandardizedActivity.Name != "Pull" {
+			t.Errorf("expected standardized activity to be mapped")
+		}
+	})
+
+	t.Run("stops at the first workout before the window", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"page": 2, "page_count": 4, "workouts": [
+				{"id": "w2", "start_time": "2026-07-02T08:00:00Z"},
+				{"id": "w1", "start_time": "2026-06-30T08:00:00Z"}
+			]}`)
+		}))
+		defer srv.Close()
+
+		p := &HevyPager{baseURL: srv.URL, http: testClient()}
+		userSvc := &mockUserServiceClient{integrations: &pbuser.UserIntegrations{Hevy: &pbuser.HevyIntegration{Enabled: true}}, secret: "key"}
+
+		page, err := p.FetchPage(context.Background(), userSvc, "user-1", since, "2")
+		if err != nil {
+			t.Fatalf("FetchPage: %v", err)
+		}
+		if len(page.Activities) != 1 || page.NextCursor != "" {
+			t.Fatalf("expected 1 activity and no cursor, got %d %q", len(page.Activities), page.NextCursor)
+		}
+	})
+}
+
+func TestFitbitPager_FetchPage(t *testing.T) {
+	since := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("afterDate") != "2026-07-01" || q.Get("offset") != "100" || q.Get("sort") != "asc" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"activities": [{"logId": 98765432101, "activityName": "Walk"}], "pagination": {"next": "https://api.fitbit.com/next"}}`)
+	}))
+	defer srv.Close()
+
+	p := &FitbitPager{baseURL: srv.URL, http: testClient()}
+	userSvc := &mockUserServiceClient{integrations: &pbuser.UserIntegrations{Fitbit: &pbuser.FitbitIntegration{AccessToken: "tok"}}}
+
+	page, err := p.FetchPage(context.Background(), userSvc, "user-1", since, "100")
+	if err != nil {
+		t.Fatalf("FetchPage: %v", err)
+	}
+	if len(page.Activities) != 1 || page.Activities[0].GetActivityId() != "98765432101" {
+		t.Fatalf("unexpected activities: %+v", page.Activities)
+	}
+	if page.NextCursor != "101" {
+		t.Errorf("expected cursor 101, got %q", page.NextCursor)
+	}
+}
+
+func TestPager_MissingIntegration(t *testing.T) {
+	userSvc := &mockUserServiceClient{integrations: &pbuser.UserIntegrations{}}
+	for _, p := range []Pager{NewStravaPager(), NewHevyPager(), NewFitbitPager()} {
+		if _, err := p.FetchPage(context.Background(), userSvc, "user-1", time.Now(), ""); err == nil {
+			t.Errorf("%s: expected error without integration", p.Source())
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+// ReconcileDays is how far back the daily reconciliation looks for activities
+// whose webhook never arrived. It overlaps the previous run so an activity
+// uploaded late to the source is still caught.
+const ReconcileDays = 2
+
+const reconcileUserPageSize = 100
+
+// NewReconcileJob builds a pending job that re-lists the user's recent source
+// history and publishes only what the pipeline has not seen, fanned out
+// through the splitter like a live webhook.
+func NewReconcileJob(userID string, source pbactivity.ActivitySource, now time.Time) *pipeline.BackfillJob {
+	job := NewJob(userID, "", source, ReconcileDays, now)
+	job.Reconcile = true
+	return job
+}
+
+// HandleReconcilePush receives the daily Cloud Scheduler trigger via Pub/Sub
+// push and starts a reconcile job for every connected source.
+func (s *Service) HandleReconcilePush(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if err := s.StartReconciliation(ctx, time.Now()); err != nil {
+		s.logger.Error(ctx, "Failed to start reconciliation", "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "OK")
+}
+
+// StartReconciliation creates one reconcile job per user and connected source
+// the service has a pager for. Jobs then page independently, so the trigger
+// itself stays well inside a push deadline.
+func (s *Service) StartReconciliation(ctx context.Context, now time.Time) error {
+	started, failed := 0, 0
+	pageToken := ""
+	for {
+		page, err := s.userSvc.ListUsers(ctx, &userpb.ListUsersRequest{
+			Limit:     reconcileUserPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("list users: %w", err)
+		}
+
+		for _, profile := range page.Users {
+			n, err := s.startUserReconciliation(ctx, profile.UserId, now)
+			started += n
+			if err != nil {
+				s.logger.Warn(ctx, "Failed to start reconciliation for user", "user_id", profile.UserId, "error", err)
+				failed++
+			}
+		}
+
+		if page.NextPageToken == "" {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+
+	s.logger.Info(ctx, "Started reconciliation", "jobs", started, "failed_users", failed)
+	return nil
+}
+
+func (s *Service) startUserReconciliation(ctx context.Context, userID string, now time.Time) (int, error) {
+	resp, err := s.userSvc.GetIntegration(ctx, &userpb.GetIntegrationRequest{UserId: userID})
+	if err != nil {
+		return 0, fmt.Errorf("get integrations: %w", err)
+	}
+
+	started := 0
+	for _, source := range connectedSources(resp.GetIntegrations()) {
+		if _, ok := s.pagers[source]; !ok {
+			continue
+		}
+		job := NewReconcileJob(userID, source, now)
+		if err := s.store.CreateJob(ctx, job); err != nil {
+			return started, fmt.Errorf("create %s job: %w", source.String(), err)
+		}
+		if err := RequestPage(ctx, s.publisher, job); err != nil {
+			return started, fmt.Errorf("request %s page: %w", source.String(), err)
+		}
+		started++
+	}
+	return started, nil
+}
+
+// connectedSources lists the backfillable sources the user has an enabled,
+// credentialed integration for.
+func connectedSources(integrations *pbuser.UserIntegrations) []pbactivity.ActivitySource {
+	var sources []pbactivity.ActivitySource
+	if i := integrations.GetStrava(); i.GetEnabled() && i.GetAccessToken() != "" {
+		sources = append(sources, pbactivity.ActivitySource_SOURCE_STRAVA)
+	}
+	// Hevy's API key lives in the integration secret store; HevyPager fails
+	// the page if it is missing
+	if i := integrations.GetHevy(); i.GetEnabled() {
+		sources = append(sources, pbactivity.ActivitySource_SOURCE_HEVY)
+	}
+	if i := integrations.GetFitbit(); i.GetEnabled() && i.GetAccessToken() != "" {
+		sources = append(sources, pbactivity.ActivitySource_SOURCE_FITBIT)
+	}
+	return sources
+}
@@ -0,0 +1,129 @@
+// nolint:proto-json
+package backfill
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	shared "github.com/fitglue/server/src/go/pkg"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+// mockActivityIndex reports activities in seen as already processed
+type mockActivityIndex struct {
+	seen map[string]bool
+}
+
+func (m *mockActivityIndex) ActivitySeen(ctx context.Context, userID string, source pbactivity.ActivitySource, externalID string) (bool, error) {
+	return m.seen[externalID], nil
+}
+
+// mockUserService serves users across two pages with per-user integrations
+type mockUserService struct {
+	userpb.UserServiceClient
+	integrations map[string]*pbuser.UserIntegrations
+}
+
+func (m *mockUserService) SearchUsers(ctx context.Context, in *userpb.SearchUsersRequest, opts ...grpc.CallOption) (*userpb.SearchUsersResponse, error) {
+	return &userpb.SearchUsersResponse{}, nil
+}
+func (m *mockUserService) ListUsers(ctx context.Context, in *userpb.ListUsersRequest, opts ...grpc.CallOption) (*userpb.ListUsersResponse, error) {
+	if in.PageToken == "" {
+		return &userpb.ListUsersResponse{
+			Users:         []*pbuser.UserProfile{{UserId: "user-1"}, {UserId: "user-2"}},
+			NextPageToken: "page-2",
+		}, nil
+	}
+	return &userpb.ListUsersResponse{Users: []*pbuser.UserProfile{{UserId: "user-3"}}}, nil
+}
+
+func (m *mockUserService) GetIntegration(ctx context.Context, in *userpb.GetIntegrationRequest, opts ...grpc.CallOption) (*userpb.GetIntegrationResponse, error) {
+	return &userpb.GetIntegrationResponse{Integrations: m.integrations[in.UserId]}, nil
+}
+
+func TestStartReconciliation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 10, 4, 0, 0, 0, time.UTC)
+	store := &mockJobStore{jobs: map[string]*pipeline.BackfillJob{}}
+	pub := &mockPublisher{}
+	userSvc := &mockUserService{integrations: map[string]*pbuser.UserIntegrations{
+		"user-1": {Strava: &pbuser.StravaIntegration{Enabled: true, AccessToken: "token"}},
+		"user-2": {Strava: &pbuser.StravaIntegration{Enabled: false, AccessToken: "token"}},
+		"user-3": {
+			Strava: &pbuser.StravaIntegration{Enabled: true, AccessToken: "token"},
+			Hevy:   &pbuser.HevyIntegration{Enabled: true},
+		},
+	}}
+	svc := NewService(store, &mockActivityIndex{}, pub, userSvc, infra.NewLogger(), &mockPager{})
+
+	if err := svc.StartReconciliation(ctx, now); err != nil {
+		t.Fatalf("StartReconciliation: %v", err)
+	}
+
+	// Only enabled Strava integrations have a pager; Hevy is skipped
+	if len(store.jobs) != 2 {
+		t.Fatalf("expected 2 reconcile jobs, got %d", len(store.jobs))
+	}
+	users := map[string]bool{}
+	for _, job := range store.jobs {
+		users[job.UserId] = true
+		if !job.Reconcile || job.PipelineId != "" || job.Source != pbactivity.ActivitySource_SOURCE_STRAVA {
+			t.Errorf("unexpected job %+v", job)
+		}
+		if !job.Since.AsTime().Equal(now.AddDate(0, 0, -ReconcileDays)) {
+			t.Errorf("expected since %s, got %s", now.AddDate(0, 0, -ReconcileDays), job.Since.AsTime())
+		}
+	}
+	if !users["user-1"] || !users["user-3"] {
+		t.Errorf("expected jobs for user-1 and user-3, got %v", users)
+	}
+	if requests := pub.onTopic(shared.TopicBackfillRequested); len(requests) != 2 {
+		t.Errorf("expected 2 page requests, got %d", len(requests))
+	}
+}
+
+func TestProcessPage_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	store := &mockJobStore{jobs: map[string]*pipeline.BackfillJob{}}
+	pub := &mockPublisher{}
+	index := &mockActivityIndex{seen: map[string]bool{"a1": true}}
+	pager := &mockPager{pages: map[string]*Page{
+		"": {Activities: []*pbevents.ActivityPayload{payload("a1"), payload("a2")}},
+	}}
+	svc := NewService(store, index, pub, nil, infra.NewLogger(), pager)
+	job := NewReconcileJob("user-1", pbactivity.ActivitySource_SOURCE_STRAVA, time.Now())
+	_ = store.CreateJob(ctx, job)
+
+	if err := svc.ProcessPage(ctx, requestEvent(t, job)); err != nil {
+		t.Fatalf("ProcessPage: %v", err)
+	}
+
+	raw := pub.onTopic(shared.TopicRawActivity)
+	if len(raw) != 1 {
+		t.Fatalf("expected only the missed activity to be published, got %d", len(raw))
+	}
+	var got pbevents.ActivityPayload
+	if err := protojson.Unmarshal(raw[0].Data(), &got); err != nil {
+		t.Fatalf("unmarshal payload: %v", err)
+	}
+	if got.GetActivityId() != "a2" || !got.IsReconciled || got.IsBackfill {
+		t.Errorf("expected reconciled a2, got activity=%q is_reconciled=%v is_backfill=%v", got.GetActivityId(), got.IsReconciled, got.IsBackfill)
+	}
+	if got.PipelineId != nil || got.GetPipelineExecutionId() == "" {
+		t.Errorf("expected untargeted payload with its own execution ID, got pipeline_id=%v exec=%q", got.PipelineId, got.GetPipelineExecutionId())
+	}
+
+	saved := store.jobs[job.Id]
+	if saved.Status != pipeline.BackfillStatus_BACKFILL_STATUS_COMPLETED || saved.ActivitiesPublished != 1 || saved.ActivitiesSkipped != 1 {
+		t.Errorf("unexpected progress: status=%s published=%d skipped=%d", saved.Status, saved.ActivitiesPublished, saved.ActivitiesSkipped)
+	}
+}
@@ -0,0 +1,350 @@
+// nolint:proto-json
+package backfill
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	shared "github.com/fitglue/server/src/go/pkg"
+	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+// MaxDays is the longest history window a backfill may replay, and the
+// default when the caller does not choose one.
+const MaxDays = 90
+
+// MaxStall is how long a job keeps retrying a source that is rate limiting
+// or failing before it is marked failed. A job that has made no progress for
+// this long also no longer blocks a new backfill of its pipeline.
+const MaxStall = 24 * time.Hour
+
+// Publisher defines the contract for publishing events (e.g., to Pub/Sub).
+type Publisher interface {
+	PublishCloudEvent(ctx context.Context, topic string, ce cloudevents.Event) (string, error)
+}
+
+// SupportsSource reports whether history can be backfilled from the source
+func SupportsSource(source pbactivity.ActivitySource) bool {
+	switch source {
+	case pbactivity.ActivitySource_SOURCE_STRAVA,
+		pbactivity.ActivitySource_SOURCE_HEVY,
+		pbactivity.ActivitySource_SOURCE_FITBIT:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewJob builds a pending job that replays the last days of source history
+// into a single pipeline.
+func NewJob(userID, pipelineID string, source pbactivity.ActivitySource, days int, now time.Time) *pipeline.BackfillJob {
+	return &pipeline.BackfillJob{
+		Id:         uuid.NewString(),
+		UserId:     userID,
+		PipelineId: pipelineID,
+		Source:     source,
+		Status:     pipeline.BackfillStatus_BACKFILL_STATUS_PENDING,
+		Since:      timestamppb.New(now.AddDate(0, 0, -days)),
+		CreatedAt:  timestamppb.New(now),
+		UpdatedAt:  timestamppb.New(now),
+	}
+}
+
+// RequestPage publishes the event that makes the backfill service process
+// the job's next page, identified by its progress so far.
+func RequestPage(ctx context.Context, publisher Publisher, job *pipeline.BackfillJob) error {
+	ce, err := infrapubsub.NewCloudEvent(
+		infrapubsub.GetCloudEventSource(pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_BACKFILL),
+		infrapubsub.GetCloudEventType(pbevents.CloudEventType_CLOUD_EVENT_TYPE_BACKFILL_REQUESTED),
+		&pbevents.BackfillRequestedEvent{JobId: job.Id, Page: job.PagesFetched, Cursor: job.Cursor},
+	)
+	if err != nil {
+		return fmt.Errorf("create cloud event: %w", err)
+	}
+	if _, err := publisher.PublishCloudEvent(ctx, shared.TopicBackfillRequested, ce); err != nil {
+		return fmt.Errorf("publish: %w", err)
+	}
+	return nil
+}
+
+// InProgress reports whether the job is pending or running and has made
+// progress within MaxStall of now.
+func InProgress(job *pipeline.BackfillJob, now time.Time) bool {
+	if isFinished(job) {
+		return false
+	}
+	return job.UpdatedAt == nil || now.Sub(job.UpdatedAt.AsTime()) < MaxStall
+}
+
+func isFinished(job *pipeline.BackfillJob) bool {
+	return job.Status == pipeline.BackfillStatus_BACKFILL_STATUS_COMPLETED || job.Status == pipeline.BackfillStatus_BACKFILL_STATUS_FAILED
+}
+
+// Service processes backfill jobs one source page per Pub/Sub message, so a
+// long history never outlives a single push deadline and progress survives
+// restarts.
+type Service struct {
+	store     JobStore
+	index     ActivityIndex
+	publisher Publisher
+	userSvc   userpb.UserServiceClient
+	pagers    map[pbactivity.ActivitySource]Pager
+	logger    infra.Logger
+}
+
+func NewService(store JobStore, index ActivityIndex, publisher Publisher, userSvc userpb.UserServiceClient, logger infra.Logger, pagers ...Pager) *Service {
+	s := &Service{
+		store:     store,
+		index:     index,
+		publisher: publisher,
+		userSvc:   userSvc,
+		pagers:    make(map[pbactivity.ActivitySource]Pager),
+		logger:    logger,
+	}
+	for _, p := range pagers {
+		s.pagers[p.Source()] = p
+	}
+	return s
+}
+
+// HandlePubSubPush unwraps a Pub/Sub push envelope carrying a BackfillRequestedEvent
+func (s *Service) HandlePubSubPush(w http.ResponseWriter, r *http.Request) {
+	handlePush(s.logger, w, r, "backfill page", s.ProcessPage)
+}
+
+// handlePush unwraps the CloudEvent in a Pub/Sub push envelope and hands it
+// to process. Errors from process are returned as 500s so Pub/Sub retries.
+func handlePush(logger infra.Logger, w http.ResponseWriter, r *http.Request, what string, process func(context.Context, *event.Event) error) {
+	ctx := r.Context()
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		logger.Error(ctx, "Failed to read request body", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	var msg struct {
+		Message struct {
+			Data []byte `json:"data"`
+			ID   string `json:"messageId"`
+		} `json:"message"`
+	}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		logger.Error(ctx, "Failed to unmarshal pub/sub envelope", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	var ce event.Event
+	if err := json.Unmarshal(msg.Message.Data, &ce); err != nil {
+		logger.Error(ctx, "Failed to unmarshal inner CloudEvent", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	if err := process(ctx, &ce); err != nil {
+		logger.Error(ctx, "Failed to process "+what, "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "OK")
+}
+
+// ProcessPage fetches the job's next source page, publishes its activities in
+// batches targeted at the job's pipeline, schedules the following page and
+// records progress. Returned errors are retried by Pub/Sub.
+//
+// Each request names the page it is for. A redelivered request for a page
+// that has been processed is dropped, and the job only moves on if it is
+// still at the requested page when progress is saved, so duplicate
+// deliveries can never fork the chain of page requests.
+func (s *Service) ProcessPage(ctx context.Context, ce *event.Event) error {
+	var req pbevents.BackfillRequestedEvent
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(ce.Data(), &req); err != nil {
+		s.logger.Error(ctx, "Failed to unmarshal BackfillRequestedEvent", "error", err)
+		return nil // Ack malformed payloads
+	}
+
+	job, err := s.store.GetJob(ctx, req.JobId)
+	if err != nil {
+		return fmt.Errorf("get job: %w", err)
+	}
+	if job == nil {
+		s.logger.Warn(ctx, "Backfill job not found, dropping request", "job_id", req.JobId)
+		return nil
+	}
+	if isFinished(job) {
+		s.logger.Info(ctx, "Backfill job already finished, ignoring redelivery", "job_id", job.Id, "status", job.Status.String())
+		return nil
+	}
+	if req.Page > job.PagesFetched {
+		// The next page is requested before the job's progress is saved;
+		// retry until that save lands
+		return fmt.Errorf("job %s is at page %d, not yet at requested page %d", job.Id, job.PagesFetched, req.Page)
+	}
+	if req.Page < job.PagesFetched || req.Cursor != job.Cursor {
+		s.logger.Info(ctx, "Backfill page already processed, ignoring redelivery", "job_id", job.Id, "page", req.Page, "job_page", job.PagesFetched)
+		return nil
+	}
+
+	pager, ok := s.pagers[job.Source]
+	if !ok {
+		return s.fail(ctx, job, fmt.Sprintf("backfill is not supported for source %s", job.Source.String()))
+	}
+
+	job.Status = pipeline.BackfillStatus_BACKFILL_STATUS_RUNNING
+	page, err := pager.FetchPage(ctx, s.userSvc, job.UserId, job.Since.AsTime(), job.Cursor)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to fetch backfill page", "job_id", job.Id, "source", job.Source.String(), "cursor", job.Cursor, "error", err)
+		if retryable(err) && InProgress(job, time.Now()) {
+			return fmt.Errorf("fetch page: %w", err)
+		}
+		return s.fail(ctx, job, err.Error())
+	}
+
+	if job.Reconcile {
+		s.reconcilePage(ctx, job, page.Activities)
+	} else {
+		s.publishBatches(ctx, job, page.Activities)
+	}
+
+	job.PagesFetched++
+	job.Cursor = page.NextCursor
+	now := timestamppb.Now()
+	job.UpdatedAt = now
+	if page.NextCursor == "" {
+		job.Status = pipeline.BackfillStatus_BACKFILL_STATUS_COMPLETED
+		job.CompletedAt = now
+	} else if err := RequestPage(ctx, s.publisher, job); err != nil {
+		// Nothing is saved, so the retry processes this page again
+		return fmt.Errorf("request next page: %w", err)
+	}
+
+	advanced, err := s.store.AdvanceJob(ctx, job, req.Page, req.Cursor)
+	if err != nil {
+		return fmt.Errorf("update job: %w", err)
+	}
+	if !advanced {
+		s.logger.Info(ctx, "Backfill page processed by another delivery", "job_id", job.Id, "page", req.Page)
+		return nil
+	}
+
+	s.logger.Info(ctx, "Processed backfill page", "job_id", job.Id, "pages", job.PagesFetched, "published", job.ActivitiesPublished, "skipped", job.ActivitiesSkipped, "reconcile", job.Reconcile, "status", job.Status.String())
+	return nil
+}
+
+// publishBatches targets each payload at the job's pipeline and publishes
+// the page as activity batches straight to the pipeline-activity topic, so
+// the enricher works through them in as few invocations as possible. The
+// splitter would only pass targeted payloads through, so it is skipped.
+func (s *Service) publishBatches(ctx context.Context, job *pipeline.BackfillJob, payloads []*pbevents.ActivityPayload) {
+	for _, payload := range payloads {
+		pipelineID := job.PipelineId
+		payload.PipelineId = &pipelineID
+		payload.IsBackfill = true
+		execID := uuid.NewString()
+		payload.PipelineExecutionId = &execID
+	}
+
+	batches, err := infrapubsub.BatchActivityPayloads(payloads)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to batch backfilled activities", "job_id", job.Id, "error", err)
+		job.ActivitiesFailed += int32(len(payloads))
+		return
+	}
+	for _, batch := range batches {
+		size := int32(len(batch.Payloads))
+		if err := s.publishBatch(ctx, batch); err != nil {
+			s.logger.Error(ctx, "Failed to publish backfill activity batch", "job_id", job.Id, "size", size, "error", err)
+			job.ActivitiesFailed += size
+			continue
+		}
+		job.ActivitiesPublished += size
+	}
+}
+
+func (s *Service) publishBatch(ctx context.Context, batch *pbevents.ActivityPayloadBatch) error {
+	ce, err := infrapubsub.NewActivityBatchEvent(infrapubsub.GetCloudEventSource(pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_BACKFILL), batch)
+	if err != nil {
+		return fmt.Errorf("create cloud event: %w", err)
+	}
+	_, err = s.publisher.PublishCloudEvent(ctx, shared.TopicPipelineActivity, ce)
+	return err
+}
+
+// reconcilePage publishes each activity in the page that the activity index
+// has not seen.
+func (s *Service) reconcilePage(ctx context.Context, job *pipeline.BackfillJob, payloads []*pbevents.ActivityPayload) {
+	for _, payload := range payloads {
+		seen, err := s.index.ActivitySeen(ctx, job.UserId, job.Source, payload.GetActivityId())
+		if err != nil {
+			s.logger.Error(ctx, "Failed to check reconciled activity", "job_id", job.Id, "activity_id", payload.GetActivityId(), "error", err)
+			job.ActivitiesFailed++
+			continue
+		}
+		if seen {
+			job.ActivitiesSkipped++
+			continue
+		}
+		s.logger.Info(ctx, "Reconciling missed activity", "job_id", job.Id, "user_id", job.UserId, "source", job.Source.String(), "activity_id", payload.GetActivityId())
+		if err := s.publishReconciled(ctx, payload); err != nil {
+			s.logger.Error(ctx, "Failed to publish reconciled activity", "job_id", job.Id, "activity_id", payload.GetActivityId(), "error", err)
+			job.ActivitiesFailed++
+			continue
+		}
+		job.ActivitiesPublished++
+	}
+}
+
+// publishReconciled publishes a missed activity to the raw activity topic,
+// untargeted so it fans out exactly as the missed webhook would have.
+func (s *Service) publishReconciled(ctx context.Context, payload *pbevents.ActivityPayload) error {
+	// The splitter derives each fanned-out run ID from this, so give every
+	// reconciled activity its own rather than the shared fallback
+	execID := uuid.NewString()
+	payload.PipelineExecutionId = &execID
+	payload.IsReconciled = true
+
+	ce, err := infrapubsub.NewCloudEvent(
+		infrapubsub.GetCloudEventSource(pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_BACKFILL),
+		infrapubsub.GetCloudEventType(pbevents.CloudEventType_CLOUD_EVENT_TYPE_ACTIVITY_CREATED),
+		payload,
+	)
+	if err != nil {
+		return fmt.Errorf("create cloud event: %w", err)
+	}
+	_, err = s.publisher.PublishCloudEvent(ctx, shared.TopicRawActivity, ce)
+	return err
+}
+
+// fail marks the job failed; the request is acked so a broken integration
+// does not redeliver forever.
+func (s *Service) fail(ctx context.Context, job *pipeline.BackfillJob, reason string) error {
+	job.Status = pipeline.BackfillStatus_BACKFILL_STATUS_FAILED
+	job.Error = &reason
+	now := timestamppb.Now()
+	job.UpdatedAt = now
+	job.CompletedAt = now
+	if err := s.store.UpdateJob(ctx, job); err != nil {
+		return fmt.Errorf("update job: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,304 @@
+// nolint:proto-json
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	shared "github.com/fitglue/server/src/go/pkg"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+type mockJobStore struct {
+	jobs map[string]*pipeline.BackfillJob
+}
+
+func (m *mockJobStore) CreateJob(ctx context.Context, job *pipeline.BackfillJob) error {
+	m.jobs[job.Id] = proto.Clone(job).(*pipeline.BackfillJob)
+	return nil
+}
+
+func (m *mockJobStore) GetJob(ctx context.Context, jobID string) (*pipeline.BackfillJob, error) {
+	job, ok := m.jobs[jobID]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(job).(*pipeline.BackfillJob), nil
+}
+
+func (m *mockJobStore) UpdateJob(ctx context.Context, job *pipeline.BackfillJob) error {
+	m.jobs[job.Id] = proto.Clone(job).(*pipeline.BackfillJob)
+	return nil
+}
+
+func (m *mockJobStore) AdvanceJob(ctx context.Context, job *pipeline.BackfillJob, fromPage int32, fromCursor string) (bool, error) {
+	current := m.jobs[job.Id]
+	if current == nil || current.PagesFetched != fromPage || current.Cursor != fromCursor || isFinished(current) {
+		return false, nil
+	}
+	m.jobs[job.Id] = proto.Clone(job).(*pipeline.BackfillJob)
+	return true, nil
+}
+
+type publishedEvent struct {
+	topic string
+	ce    cloudevents.Event
+}
+
+type mockPublisher struct {
+	events []publishedEvent
+}
+
+func (m *mockPublisher) PublishCloudEvent(ctx context.Context, topic string, ce cloudevents.Event) (string, error) {
+	m.events = append(m.events, publishedEvent{topic: topic, ce: ce})
+	return "msg-id", nil
+}
+
+func (m *mockPublisher) onTopic(topic string) []cloudevents.Event {
+	var out []cloudevents.Event
+	for _, e := range m.events {
+		if e.topic == topic {
+			out = append(out, e.ce)
+		}
+	}
+	return out
+}
+
+// mockPager serves pre-baked pages keyed by cursor
+type mockPager struct {
+	pages map[string]*Page
+	err   error
+}
+
+func (m *mockPager) Source() pbactivity.ActivitySource {
+	return pbactivity.ActivitySource_SOURCE_STRAVA
+}
+
+func (m *mockPager) FetchPage(ctx context.Context, userSvc userpb.UserServiceClient, userID string, since time.Time, cursor string) (*Page, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.pages[cursor], nil
+}
+
+func payload(id string) *pbevents.ActivityPayload {
+	return &pbevents.ActivityPayload{
+		Source:     pbactivity.ActivitySource_SOURCE_STRAVA,
+		UserId:     "user-1",
+		ActivityId: &id,
+	}
+}
+
+func requestEvent(t *testing.T, job *pipeline.BackfillJob) *cloudevents.Event {
+	t.Helper()
+	pub := &mockPublisher{}
+	if err := RequestPage(context.Background(), pub, job); err != nil {
+		t.Fatalf("RequestPage: %v", err)
+	}
+	return &pub.events[0].ce
+}
+
+func TestProcessPage(t *testing.T) {
+	ctx := context.Background()
+	logger := infra.NewLogger()
+
+	newFixture := func(pager *mockPager, source pbactivity.ActivitySource) (*Service, *mockJobStore, *mockPublisher, *pipeline.BackfillJob) {
+		store := &mockJobStore{jobs: map[string]*pipeline.BackfillJob{}}
+		pub := &mockPublisher{}
+		job := NewJob("user-1", "pipe-1", source, MaxDays, time.Now())
+		_ = store.CreateJob(ctx, job)
+		return NewService(store, nil, pub, nil, logger, pager), store, pub, job
+	}
+
+	t.Run("publishes targeted backfill payloads and schedules the next page", func(t *testing.T) {
+		pager := &mockPager{pages: map[string]*Page{
+			"": {Activities: []*pbevents.ActivityPayload{payload("a1"), payload("a2")}, NextCursor: "2"},
+		}}
+		svc, store, pub, job := newFixture(pager, pbactivity.ActivitySource_SOURCE_STRAVA)
+
+		if err := svc.ProcessPage(ctx, requestEvent(t, job)); err != nil {
+			t.Fatalf("ProcessPage: %v", err)
+		}
+
+		if raw := pub.onTopic(shared.TopicRawActivity); len(raw) != 0 {
+			t.Errorf("expected backfill to skip the raw activity topic, got %d events", len(raw))
+		}
+		batches := pub.onTopic(shared.TopicPipelineActivity)
+		if len(batches) != 1 {
+			t.Fatalf("expected 1 activity batch, got %d", len(batches))
+		}
+		if batches[0].Type() != "com.fitglue.activity.batch" {
+			t.Errorf("expected an activity batch event, got %q", batches[0].Type())
+		}
+		var got pbevents.ActivityPayloadBatch
+		if err := protojson.Unmarshal(batches[0].Data(), &got); err != nil {
+			t.Fatalf("unmarshal batch: %v", err)
+		}
+		if len(got.Payloads) != 2 {
+			t.Fatalf("expected 2 payloads in the batch, got %d", len(got.Payloads))
+		}
+		for _, p := range got.Payloads {
+			if !p.IsBackfill || p.GetPipelineId() != "pipe-1" {
+				t.Errorf("expected backfill payload targeted at pipe-1, got is_backfill=%v pipeline_id=%q", p.IsBackfill, p.GetPipelineId())
+			}
+		}
+		if got.Payloads[0].GetPipelineExecutionId() == "" || got.Payloads[0].GetPipelineExecutionId() == got.Payloads[1].GetPipelineExecutionId() {
+			t.Errorf("expected each payload to get its own pipeline execution id")
+		}
+
+		if next := pub.onTopic(shared.TopicBackfillRequested); len(next) != 1 {
+			t.Fatalf("expected next page to be requested, got %d requests", len(next))
+		}
+
+		saved := store.jobs[job.Id]
+		if saved.Status != pipeline.BackfillStatus_BACKFILL_STATUS_RUNNING {
+			t.Errorf("expected RUNNING, got %s", saved.Status)
+		}
+		if saved.Cursor != "2" || saved.PagesFetched != 1 || saved.ActivitiesPublished != 2 {
+			t.Errorf("unexpected progress: cursor=%q pages=%d published=%d", saved.Cursor, saved.PagesFetched, saved.ActivitiesPublished)
+		}
+	})
+
+	t.Run("completes on the last page without requesting another", func(t *testing.T) {
+		pager := &mockPager{pages: map[string]*Page{
+			"": {Activities: []*pbevents.ActivityPayload{payload("a1")}},
+		}}
+		svc, store, pub, job := newFixture(pager, pbactivity.ActivitySource_SOURCE_STRAVA)
+
+		if err := svc.ProcessPage(ctx, requestEvent(t, job)); err != nil {
+			t.Fatalf("ProcessPage: %v", err)
+		}
+
+		if next := pub.onTopic(shared.TopicBackfillRequested); len(next) != 0 {
+			t.Errorf("expected no further page requests, got %d", len(next))
+		}
+		saved := store.jobs[job.Id]
+		if saved.Status != pipeline.BackfillStatus_BACKFILL_STATUS_COMPLETED || saved.CompletedAt == nil {
+			t.Errorf("expected COMPLETED with completed_at, got %s", saved.Status)
+		}
+
+		// Redelivery of the same request is ignored
+		if err := svc.ProcessPage(ctx, requestEvent(t, job)); err != nil {
+			t.Fatalf("ProcessPage redelivery: %v", err)
+		}
+		if batches := pub.onTopic(shared.TopicPipelineActivity); len(batches) != 1 {
+			t.Errorf("expected redelivery to publish nothing, got %d batches total", len(batches))
+		}
+	})
+
+	t.Run("marks the job failed when the source rejects the request", func(t *testing.T) {
+		pager := &mockPager{err: fmt.Errorf("strava list activities: %w", &StatusError{StatusCode: http.StatusUnauthorized})}
+		svc, store, pub, job := newFixture(pager, pbactivity.ActivitySource_SOURCE_STRAVA)
+
+		if err := svc.ProcessPage(ctx, requestEvent(t, job)); err != nil {
+			t.Fatalf("expected failure to be acked, got %v", err)
+		}
+
+		saved := store.jobs[job.Id]
+		if saved.Status != pipeline.BackfillStatus_BACKFILL_STATUS_FAILED || saved.GetError() == "" {
+			t.Errorf("expected FAILED with error, got %s %q", saved.Status, saved.GetError())
+		}
+		if len(pub.events) != 0 {
+			t.Errorf("expected nothing published, got %d events", len(pub.events))
+		}
+	})
+
+	t.Run("retries a rate limited page", func(t *testing.T) {
+		pager := &mockPager{err: fmt.Errorf("strava list activities: %w", &StatusError{StatusCode: http.StatusTooManyRequests})}
+		svc, store, pub, job := newFixture(pager, pbactivity.ActivitySource_SOURCE_STRAVA)
+
+		if err := svc.ProcessPage(ctx, requestEvent(t, job)); err == nil {
+			t.Fatal("expected an error so Pub/Sub retries")
+		}
+		if saved := store.jobs[job.Id]; saved.Status != pipeline.BackfillStatus_BACKFILL_STATUS_PENDING {
+			t.Errorf("expected the job to stay PENDING, got %s", saved.Status)
+		}
+		if len(pub.events) != 0 {
+			t.Errorf("expected nothing published, got %d events", len(pub.events))
+		}
+
+		// A job stalled for longer than MaxStall gives up
+		stalled := store.jobs[job.Id]
+		stalled.UpdatedAt = timestamppb.New(time.Now().Add(-MaxStall - time.Hour))
+		if err := svc.ProcessPage(ctx, requestEvent(t, job)); err != nil {
+			t.Fatalf("expected the stalled job's failure to be acked, got %v", err)
+		}
+		if saved := store.jobs[job.Id]; saved.Status != pipeline.BackfillStatus_BACKFILL_STATUS_FAILED {
+			t.Errorf("expected FAILED, got %s", saved.Status)
+		}
+	})
+
+	t.Run("drops a redelivered request for a page already processed", func(t *testing.T) {
+		pager := &mockPager{pages: map[string]*Page{
+			"":  {Activities: []*pbevents.ActivityPayload{payload("a1")}, NextCursor: "2"},
+			"2": {Activities: []*pbevents.ActivityPayload{payload("a2")}, NextCursor: "3"},
+		}}
+		svc, store, pub, job := newFixture(pager, pbactivity.ActivitySource_SOURCE_STRAVA)
+		first := requestEvent(t, job)
+
+		if err := svc.ProcessPage(ctx, first); err != nil {
+			t.Fatalf("ProcessPage: %v", err)
+		}
+		if err := svc.ProcessPage(ctx, first); err != nil {
+			t.Fatalf("ProcessPage redelivery: %v", err)
+		}
+
+		if batches := pub.onTopic(shared.TopicPipelineActivity); len(batches) != 1 {
+			t.Errorf("expected the redelivery to publish nothing, got %d batches", len(batches))
+		}
+		if next := pub.onTopic(shared.TopicBackfillRequested); len(next) != 1 {
+			t.Errorf("expected a single next page request, got %d", len(next))
+		}
+		if saved := store.jobs[job.Id]; saved.PagesFetched != 1 || saved.Cursor != "2" {
+			t.Errorf("expected the job at page 1, got pages=%d cursor=%q", saved.PagesFetched, saved.Cursor)
+		}
+	})
+
+	t.Run("retries a request for a page the job has not reached", func(t *testing.T) {
+		svc, _, pub, job := newFixture(&mockPager{}, pbactivity.ActivitySource_SOURCE_STRAVA)
+		ahead := proto.Clone(job).(*pipeline.BackfillJob)
+		ahead.PagesFetched = 1
+		ahead.Cursor = "2"
+
+		if err := svc.ProcessPage(ctx, requestEvent(t, ahead)); err == nil {
+			t.Fatal("expected an error so Pub/Sub retries")
+		}
+		if len(pub.events) != 0 {
+			t.Errorf("expected nothing published, got %d events", len(pub.events))
+		}
+	})
+
+	t.Run("fails jobs for sources without a pager", func(t *testing.T) {
+		svc, store, _, job := newFixture(&mockPager{}, pbactivity.ActivitySource_SOURCE_OURA)
+
+		if err := svc.ProcessPage(ctx, requestEvent(t, job)); err != nil {
+			t.Fatalf("ProcessPage: %v", err)
+		}
+		if store.jobs[job.Id].Status != pipeline.BackfillStatus_BACKFILL_STATUS_FAILED {
+			t.Errorf("expected FAILED, got %s", store.jobs[job.Id].Status)
+		}
+	})
+
+	t.Run("drops requests for unknown jobs", func(t *testing.T) {
+		svc, _, pub, _ := newFixture(&mockPager{}, pbactivity.ActivitySource_SOURCE_STRAVA)
+
+		if err := svc.ProcessPage(ctx, requestEvent(t, &pipeline.BackfillJob{Id: "missing"})); err != nil {
+			t.Fatalf("ProcessPage: %v", err)
+		}
+		if len(pub.events) != 0 {
+			t.Errorf("expected nothing published, got %d events", len(pub.events))
+		}
+	})
+}
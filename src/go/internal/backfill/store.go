@@ -0,0 +1,46 @@
+package backfill
+
+import (
+	"context"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+// JobStore defines the data access contract for backfill jobs.
+type JobStore interface {
+	CreateJob(ctx context.Context, job *pipeline.BackfillJob) error
+	// GetJob returns nil, nil when the job does not exist.
+	GetJob(ctx context.Context, jobID string) (*pipeline.BackfillJob, error)
+	UpdateJob(ctx context.Context, job *pipeline.BackfillJob) error
+	// AdvanceJob saves job in a transaction, only if the stored job is still
+	// at page fromPage with cursor fromCursor, and reports whether it did. Of
+	// two deliveries of the same page request, only one moves the job on.
+	AdvanceJob(ctx context.Context, job *pipeline.BackfillJob, fromPage int32, fromCursor string) (bool, error)
+}
+
+// ActivityIndex answers whether a source activity has already reached
+// FitGlue, used by reconcile jobs to find activities whose webhook was lost.
+type ActivityIndex interface {
+	// ActivitySeen reports whether the activity produced a pipeline run, or
+	// is one of our own uploads bouncing back from the source.
+	ActivitySeen(ctx context.Context, userID string, source pbactivity.ActivitySource, externalID string) (bool, error)
+}
+
+// ImportStore defines the data access contract for import sessions.
+type ImportStore interface {
+	CreateImport(ctx context.Context, session *pipeline.ImportSession) error
+	// GetImport returns nil, nil when the session does not exist.
+	GetImport(ctx context.Context, sessionID string) (*pipeline.ImportSession, error)
+	UpdateImport(ctx context.Context, session *pipeline.ImportSession) error
+	// ModifyImport applies fn to the stored session in a transaction, so
+	// parallel uploads to one session do not overwrite each other, and saves
+	// it unless fn fails. It returns nil, nil when the session does not exist.
+	ModifyImport(ctx context.Context, sessionID string, fn func(*pipeline.ImportSession) error) (*pipeline.ImportSession, error)
+}
+
+// FileStore holds the files uploaded to import sessions.
+type FileStore interface {
+	Write(ctx context.Context, bucket, object string, data []byte) error
+	Get(ctx context.Context, bucket, object string) ([]byte, error)
+}
@@ -0,0 +1,93 @@
+// nolint:proto-json
+package backfill
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+// stravaPageSize keeps a page (one list call plus a detail call per activity)
+// well inside a single Pub/Sub push deadline at the Strava request rate.
+const stravaPageSize = 20
+
+// StravaPager lists athlete activities after the start time, oldest first,
+// and fetches each activity's detail exactly as the Strava webhook does.
+type StravaPager struct {
+	baseURL string
+	http    *rateLimitedClient
+}
+
+// NewStravaPager paces requests to stay within Strava's 100 requests per
+// 15 minutes application limit alongside webhook traffic.
+func NewStravaPager() *StravaPager {
+	return &StravaPager{
+		baseURL: "https://www.strava.com/api/v3",
+		http:    newRateLimitedClient(10 * time.Second),
+	}
+}
+
+func (p *StravaPager) Source() pbactivity.ActivitySource {
+	return pbactivity.ActivitySource_SOURCE_STRAVA
+}
+
+func (p *StravaPager) FetchPage(ctx context.Context, userSvc userpb.UserServiceClient, userID string, since time.Time, cursor string) (*Page, error) {
+	integResp, err := userSvc.GetIntegration(ctx, &userpb.GetIntegrationRequest{
+		UserId:   userID,
+		Provider: "strava",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get integration for user: %w", err)
+	}
+	stravaInteg := integResp.Integrations.Strava
+	if stravaInteg == nil || stravaInteg.AccessToken == "" {
+		return nil, fmt.Errorf("strava integration not found or access token missing")
+	}
+	headers := map[string]string{"Authorization": "Bearer " + stravaInteg.AccessToken}
+
+	page := 1
+	if cursor != "" {
+		if page, err = strconv.Atoi(cursor); err != nil {
+			return nil, fmt.Errorf("invalid strava cursor %q: %w", cursor, err)
+		}
+	}
+
+	listURL := fmt.Sprintf("%s/athlete/activities?after=%d&page=%d&per_page=%d", p.baseURL, since.Unix(), page, stravaPageSize)
+	body, err := p.http.get(ctx, listURL, headers)
+	if err != nil {
+		return nil, fmt.Errorf("strava list activities: %w", err)
+	}
+
+	var summaries []struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.Unmarshal(body, &summaries); err != nil {
+		return nil, fmt.Errorf("failed to decode strava activity list: %w", err)
+	}
+
+	result := &Page{}
+	for _, s := range summaries {
+		activityID := strconv.FormatInt(s.ID, 10)
+		detail, err := p.http.get(ctx, fmt.Sprintf("%s/activities/%s?include_all_efforts=true", p.baseURL, activityID), headers)
+		if err != nil {
+			return nil, fmt.Errorf("strava get activity %s: %w", activityID, err)
+		}
+		result.Activities = append(result.Activities, &pbevents.ActivityPayload{
+			Source:              pbactivity.ActivitySource_SOURCE_STRAVA,
+			UserId:              userID,
+			OriginalPayloadJson: string(detail),
+			ActivityId:          &activityID,
+		})
+	}
+
+	if len(summaries) == stravaPageSize {
+		result.NextCursor = strconv.Itoa(page + 1)
+	}
+	return result, nil
+}
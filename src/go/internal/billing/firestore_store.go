@@ -145,3 +145,37 @@ func (s *FirestoreStore) GetTierStatus(ctx context.Context, userID string) (pbus
 
 	return tier, isAdmin, trialEnds, nil
 }
+
+func (s *FirestoreStore) UpdateUserAccess(ctx context.Context, userID string, enabled bool) error {
+	_, err := s.client.Collection("users").Doc(userID).Update(ctx, []firestore.Update{
+		{Path: "access_enabled", Value: enabled},
+	})
+	return err
+}
+
+// webhookEventRetention is how long processed event IDs are kept, well past
+// the three days Stripe keeps redelivering an event.
+const webhookEventRetention = 30 * 24 * time.Hour
+
+// ClaimWebhookEvent creates stripe_events/{eventId}; the create fails if the
+// event was already claimed. Records expire via a TTL on expires_at.
+func (s *FirestoreStore) ClaimWebhookEvent(ctx context.Context, eventID, eventType string) (bool, error) {
+	now := time.Now()
+	_, err := s.client.Collection("stripe_events").Doc(eventID).Create(ctx, map[string]interface{}{
+		"type":         eventType,
+		"processed_at": now,
+		"expires_at":   now.Add(webhookEventRetention),
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *FirestoreStore) ReleaseWebhookEvent(ctx context.Context, eventID string) error {
+	_, err := s.client.Collection("stripe_events").Doc(eventID).Delete(ctx)
+	return err
+}
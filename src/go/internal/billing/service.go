@@ -3,16 +3,13 @@ package billing
 
 import (
 	"context"
-	"encoding/json"
 	"time"
 
 	"github.com/fitglue/server/src/go/internal/infra"
 	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/billing"
-	"github.com/stripe/stripe-go/v76"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -211,69 +208,3 @@ func (s *Service) CreateBillingPortalSession(ctx context.Context, req *pbsvc.Cre
 		Url: session.URL,
 	}, nil
 }
-
-func (s *Service) HandleWebhookEvent(ctx context.Context, req *pbsvc.HandleWebhookEventRequest) (*emptypb.Empty, error) {
-	// Webhook signature verification should be done by the webhook gateway before it reaches this RPC.
-	// But in Stripe's case, signature verification requires the raw body.
-	// Our API gateway will pass the raw payload here.
-
-	var event stripe.Event
-	if err := json.Unmarshal(req.Payload, &event); err != nil {
-		s.logger.Error(ctx, "failed to unmarshal stripe event", "error", err)
-		return nil, status.Error(codes.InvalidArgument, "invalid payload")
-	}
-
-	switch event.Type {
-	case "checkout.session.completed":
-		var session stripe.CheckoutSession
-		if err := json.Unmarshal(event.Data.Raw, &session); err != nil {
-			return nil, err
-		}
-
-		userID := session.Metadata["fitglue_user_id"]
-		if userID != "" {
-			err := s.store.UpdateUserTier(ctx, userID, pbuser.UserTier_USER_TIER_ATHLETE, nil)
-			if err != nil {
-				s.logger.Error(ctx, "failed to update user tier to athlete", "error", err, "userId", userID)
-			}
-
-			sub, _ := s.store.GetSubscription(ctx, userID)
-			if sub == nil {
-				sub = &pbuser.SubscriptionState{UserId: userID}
-			}
-			if session.Customer != nil {
-				sub.StripeCustomerId = session.Customer.ID
-			}
-			if session.Subscription != nil {
-				sub.StripeSubscriptionId = session.Subscription.ID
-			}
-			_ = s.store.UpsertSubscription(ctx, sub)
-		}
-
-	case "customer.subscription.deleted":
-		var subscription stripe.Subscription
-		if err := json.Unmarshal(event.Data.Raw, &subscription); err != nil {
-			return nil, err
-		}
-
-		customerID := ""
-		if subscription.Customer != nil {
-			customerID = subscription.Customer.ID
-		}
-
-		if customerID != "" {
-			userID, err := s.store.GetUserIDByStripeCustomer(ctx, customerID)
-			if err == nil && userID != "" {
-				s.store.UpdateUserTier(ctx, userID, pbuser.UserTier_USER_TIER_HOBBYIST, nil)
-
-				sub, _ := s.store.GetSubscription(ctx, userID)
-				if sub != nil {
-					sub.Status = string(subscription.Status)
-					_ = s.store.UpsertSubscription(ctx, sub)
-				}
-			}
-		}
-	}
-
-	return &emptypb.Empty{}, nil
-}
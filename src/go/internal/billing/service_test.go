@@ -2,6 +2,7 @@ package billing
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -9,6 +10,9 @@ import (
 	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/billing"
 	"github.com/stripe/stripe-go/v76"
+	"github.com/stripe/stripe-go/v76/webhook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type mockLogger struct{}
@@ -23,6 +27,7 @@ type MockStore struct {
 	Subs          map[string]*pbuser.SubscriptionState
 	Users         map[string]*MockUser
 	Customers     map[string]string // customerID -> userID
+	Events        map[string]string // eventID -> type
 	UpsertSubErr  error
 	GetSubErr     error
 	GetTierErr    error
@@ -30,9 +35,10 @@ type MockStore struct {
 }
 
 type MockUser struct {
-	Tier        pbuser.UserTier
-	IsAdmin     bool
-	TrialEndsAt *time.Time
+	Tier          pbuser.UserTier
+	IsAdmin       bool
+	TrialEndsAt   *time.Time
+	AccessEnabled bool
 }
 
 func NewMockStore() *MockStore {
@@ -40,6 +46,7 @@ func NewMockStore() *MockStore {
 		Subs:      make(map[string]*pbuser.SubscriptionState),
 		Users:     make(map[string]*MockUser),
 		Customers: make(map[string]string),
+		Events:    make(map[string]string),
 	}
 }
 
@@ -91,6 +98,27 @@ func (m *MockStore) GetTierStatus(ctx context.Context, userID string) (pbuser.Us
 	return pbuser.UserTier_USER_TIER_HOBBYIST, false, nil, nil
 }
 
+func (m *MockStore) UpdateUserAccess(ctx context.Context, userID string, enabled bool) error {
+	if _, ok := m.Users[userID]; !ok {
+		m.Users[userID] = &MockUser{}
+	}
+	m.Users[userID].AccessEnabled = enabled
+	return nil
+}
+
+func (m *MockStore) ClaimWebhookEvent(ctx context.Context, eventID, eventType string) (bool, error) {
+	if _, ok := m.Events[eventID]; ok {
+		return false, nil
+	}
+	m.Events[eventID] = eventType
+	return true, nil
+}
+
+func (m *MockStore) ReleaseWebhookEvent(ctx context.Context, eventID string) error {
+	delete(m.Events, eventID)
+	return nil
+}
+
 type MockStripe struct {
 	Customers map[string]*stripe.Customer
 	Sessions  map[string]*stripe.CheckoutSession
@@ -274,6 +302,16 @@ func TestStartTrial(t *testing.T) {
 	}
 }
 
+// signedEvent signs payload with the test webhook secret, at ts.
+func signedEvent(payload string, ts time.Time) *pbsvc.HandleWebhookEventRequest {
+	signed := webhook.GenerateTestSignedPayload(&webhook.UnsignedPayload{
+		Payload:   []byte(payload),
+		Secret:    "whsec_123",
+		Timestamp: ts,
+	})
+	return &pbsvc.HandleWebhookEventRequest{Payload: signed.Payload, Signature: signed.Header}
+}
+
 func TestHandleWebhookEvent(t *testing.T) {
 	store := NewMockStore()
 	stripeClient := NewMockStripe()
@@ -283,6 +321,7 @@ func TestHandleWebhookEvent(t *testing.T) {
 
 	// 1. checkout.session.completed
 	sessionPayload := `{
+		"id": "evt_checkout",
 		"type": "checkout.session.completed",
 		"data": {
 			"object": {
@@ -296,10 +335,11 @@ func TestHandleWebhookEvent(t *testing.T) {
 		}
 	}`
 
-	// Create mock user before webhook
-	store.Users["user1"] = &MockUser{Tier: pbuser.UserTier_USER_TIER_HOBBYIST}
+	// Create a waitlisted trial user before webhook
+	trialEnd := time.Now().Add(24 * time.Hour)
+	store.Users["user1"] = &MockUser{Tier: pbuser.UserTier_USER_TIER_HOBBYIST, TrialEndsAt: &trialEnd}
 
-	_, err := svc.HandleWebhookEvent(ctx, &pbsvc.HandleWebhookEventRequest{Payload: []byte(sessionPayload)})
+	_, err := svc.HandleWebhookEvent(ctx, signedEvent(sessionPayload, time.Now()))
 	if err != nil {
 		t.Fatalf("unexpected err: %v", err)
 	}
@@ -307,13 +347,44 @@ func TestHandleWebhookEvent(t *testing.T) {
 	if store.Users["user1"].Tier != pbuser.UserTier_USER_TIER_ATHLETE {
 		t.Errorf("user should be upgraded to athlete")
 	}
+	if store.Users["user1"].TrialEndsAt != nil {
+		t.Errorf("trial should end on checkout")
+	}
+	if !store.Users["user1"].AccessEnabled {
+		t.Errorf("paying user should have access enabled")
+	}
 	if store.Subs["user1"].StripeCustomerId != "cus_123" {
 		t.Errorf("customer id not saved")
 	}
+	if store.Subs["user1"].Status != "active" {
+		t.Errorf("sub status should be active, got %q", store.Subs["user1"].Status)
+	}
+
+	// 2. invoice.payment_failed with retries left keeps the tier
+	failedPayload := `{
+		"id": "evt_failed_1",
+		"type": "invoice.payment_failed",
+		"data": {
+			"object": {
+				"id": "in_1",
+				"customer": "cus_123",
+				"next_payment_attempt": 1893456000
+			}
+		}
+	}`
+	if _, err := svc.HandleWebhookEvent(ctx, signedEvent(failedPayload, time.Now())); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if store.Users["user1"].Tier != pbuser.UserTier_USER_TIER_ATHLETE {
+		t.Errorf("user should stay athlete while stripe retries")
+	}
+	if store.Subs["user1"].Status != "past_due" {
+		t.Errorf("sub status should be past_due, got %q", store.Subs["user1"].Status)
+	}
 
-	// 2. customer.subscription.deleted
-	stripeClient.Subs["sub_123"] = &stripe.Subscription{ID: "sub_123", Status: stripe.SubscriptionStatusCanceled, Customer: &stripe.Customer{ID: "cus_123"}}
+	// 3. customer.subscription.deleted
 	delPayload := `{
+		"id": "evt_deleted",
 		"type": "customer.subscription.deleted",
 		"data": {
 			"object": {
@@ -324,7 +395,7 @@ func TestHandleWebhookEvent(t *testing.T) {
 		}
 	}`
 
-	_, err2 := svc.HandleWebhookEvent(ctx, &pbsvc.HandleWebhookEventRequest{Payload: []byte(delPayload)})
+	_, err2 := svc.HandleWebhookEvent(ctx, signedEvent(delPayload, time.Now()))
 	if err2 != nil {
 		t.Fatalf("unexpected err: %v", err2)
 	}
@@ -332,7 +403,97 @@ func TestHandleWebhookEvent(t *testing.T) {
 	if store.Users["user1"].Tier != pbuser.UserTier_USER_TIER_HOBBYIST {
 		t.Errorf("user should be downgraded to hobbyist")
 	}
+	if !store.Users["user1"].AccessEnabled {
+		t.Errorf("cancelling should not disable access")
+	}
 	if store.Subs["user1"].Status != "canceled" {
 		t.Errorf("sub status should be canceled")
 	}
+
+	// 4. Redelivering the checkout event must not re-upgrade the user
+	if _, err := svc.HandleWebhookEvent(ctx, signedEvent(sessionPayload, time.Now())); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if store.Users["user1"].Tier != pbuser.UserTier_USER_TIER_HOBBYIST {
+		t.Errorf("replayed checkout should be ignored")
+	}
+}
+
+func TestHandleWebhookEvent_Signature(t *testing.T) {
+	store := NewMockStore()
+	svc := NewService(store, mockLogger{}, NewMockStripe(), "price_123", "whsec_123")
+	ctx := context.Background()
+	payload := `{"id": "evt_1", "type": "checkout.session.completed", "data": {"object": {"metadata": {"fitglue_user_id": "user1"}}}}`
+
+	cases := map[string]*pbsvc.HandleWebhookEventRequest{
+		"missing":      {Payload: []byte(payload)},
+		"wrong secret": {Payload: []byte(payload), Signature: webhook.GenerateTestSignedPayload(&webhook.UnsignedPayload{Payload: []byte(payload), Secret: "whsec_other"}).Header},
+		"stale":        signedEvent(payload, time.Now().Add(-10*time.Minute)),
+	}
+	for name, req := range cases {
+		_, err := svc.HandleWebhookEvent(ctx, req)
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("%s: expected Unauthenticated, got %v", name, err)
+		}
+	}
+	if len(store.Users) != 0 || len(store.Events) != 0 {
+		t.Errorf("rejected events should not be applied")
+	}
+}
+
+func TestHandleWebhookEvent_FinalPaymentFailure(t *testing.T) {
+	store := NewMockStore()
+	svc := NewService(store, mockLogger{}, NewMockStripe(), "price_123", "whsec_123")
+	ctx := context.Background()
+	store.Users["user1"] = &MockUser{Tier: pbuser.UserTier_USER_TIER_ATHLETE, AccessEnabled: true}
+	store.Customers["cus_123"] = "user1"
+
+	payload := `{"id": "evt_final", "type": "invoice.payment_failed", "data": {"object": {"id": "in_1", "customer": "cus_123", "next_payment_attempt": null}}}`
+	if _, err := svc.HandleWebhookEvent(ctx, signedEvent(payload, time.Now())); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if store.Users["user1"].Tier != pbuser.UserTier_USER_TIER_HOBBYIST {
+		t.Errorf("user should be downgraded after the last attempt fails")
+	}
+	if store.Subs["user1"].Status != "unpaid" {
+		t.Errorf("sub status should be unpaid, got %q", store.Subs["user1"].Status)
+	}
+}
+
+func TestHandleWebhookEvent_ReplacedSubscriptionDeleted(t *testing.T) {
+	store := NewMockStore()
+	svc := NewService(store, mockLogger{}, NewMockStripe(), "price_123", "whsec_123")
+	ctx := context.Background()
+	store.Users["user1"] = &MockUser{Tier: pbuser.UserTier_USER_TIER_ATHLETE}
+	store.Customers["cus_123"] = "user1"
+	store.Subs["user1"] = &pbuser.SubscriptionState{UserId: "user1", StripeCustomerId: "cus_123", StripeSubscriptionId: "sub_new", Status: "active"}
+
+	payload := `{"id": "evt_old", "type": "customer.subscription.deleted", "data": {"object": {"id": "sub_old", "customer": "cus_123", "status": "canceled"}}}`
+	if _, err := svc.HandleWebhookEvent(ctx, signedEvent(payload, time.Now())); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if store.Users["user1"].Tier != pbuser.UserTier_USER_TIER_ATHLETE {
+		t.Errorf("deleting a replaced subscription should not downgrade")
+	}
+}
+
+func TestHandleWebhookEvent_FailureIsRetried(t *testing.T) {
+	store := NewMockStore()
+	svc := NewService(store, mockLogger{}, NewMockStripe(), "price_123", "whsec_123")
+	ctx := context.Background()
+	payload := `{"id": "evt_retry", "type": "checkout.session.completed", "data": {"object": {"metadata": {"fitglue_user_id": "user1"}}}}`
+
+	store.UpdateTierErr = errors.New("firestore unavailable")
+	_, err := svc.HandleWebhookEvent(ctx, signedEvent(payload, time.Now()))
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("expected Internal so stripe retries, got %v", err)
+	}
+
+	store.UpdateTierErr = nil
+	if _, err := svc.HandleWebhookEvent(ctx, signedEvent(payload, time.Now())); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if store.Users["user1"].Tier != pbuser.UserTier_USER_TIER_ATHLETE {
+		t.Errorf("redelivered event should be applied after a failure")
+	}
 }
@@ -19,4 +19,12 @@ type Store interface {
 
 	// Get effective tier (needs to read user doc fields: tier, is_admin, trial_ends_at)
 	GetTierStatus(ctx context.Context, userID string) (pbuser.UserTier, bool, *time.Time, error)
+
+	// Enable or disable the user's access (the waitlist gate on the user document)
+	UpdateUserAccess(ctx context.Context, userID string, enabled bool) error
+
+	// Record a webhook event as processed; false when it already was
+	ClaimWebhookEvent(ctx context.Context, eventID, eventType string) (bool, error)
+	// Forget a claimed event whose processing failed, so a redelivery is applied
+	ReleaseWebhookEvent(ctx context.Context, eventID string) error
 }
@@ -0,0 +1,194 @@
+package billing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/billing"
+	"github.com/stripe/stripe-go/v76"
+	"github.com/stripe/stripe-go/v76/webhook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// HandleWebhookEvent applies a Stripe event to the user's tier and billing
+// state. The payload must carry a Stripe-Signature made with the webhook
+// secret in the last five minutes, and each event is applied once, so a
+// replayed or redelivered upgrade can't undo a later cancellation.
+//
+// Paying enables a waitlisted user's access; losing the subscription only
+// drops them to the hobbyist tier and never disables access.
+func (s *Service) HandleWebhookEvent(ctx context.Context, req *pbsvc.HandleWebhookEventRequest) (*emptypb.Empty, error) {
+	event, err := webhook.ConstructEventWithOptions(req.Payload, req.Signature, s.webhookSecret, webhook.ConstructEventOptions{
+		// Events use the account's API version; only fields stable across versions are read
+		IgnoreAPIVersionMismatch: true,
+	})
+	if err != nil {
+		s.logger.Warn(ctx, "rejected stripe webhook", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid stripe signature")
+	}
+	if event.ID == "" {
+		return nil, status.Error(codes.InvalidArgument, "event id is required")
+	}
+
+	var apply func(context.Context, stripe.Event) error
+	switch event.Type {
+	case "checkout.session.completed":
+		apply = s.applyCheckoutCompleted
+	case "invoice.payment_failed":
+		apply = s.applyPaymentFailed
+	case "customer.subscription.deleted":
+		apply = s.applySubscriptionDeleted
+	default:
+		return &emptypb.Empty{}, nil
+	}
+
+	claimed, err := s.store.ClaimWebhookEvent(ctx, event.ID, string(event.Type))
+	if err != nil {
+		s.logger.Error(ctx, "failed to record stripe event", "error", err, "eventId", event.ID)
+		return nil, status.Error(codes.Internal, "failed to record event")
+	}
+	if !claimed {
+		s.logger.Info(ctx, "skipping already processed stripe event", "eventId", event.ID, "type", event.Type)
+		return &emptypb.Empty{}, nil
+	}
+
+	if err := apply(ctx, event); err != nil {
+		// Release the event so Stripe's retry applies it
+		if err := s.store.ReleaseWebhookEvent(ctx, event.ID); err != nil {
+			s.logger.Warn(ctx, "failed to release stripe event", "error", err, "eventId", event.ID)
+		}
+		s.logger.Error(ctx, "failed to apply stripe event", "error", err, "eventId", event.ID, "type", event.Type)
+		return nil, status.Error(codes.Internal, "failed to apply event")
+	}
+
+	s.logger.Info(ctx, "applied stripe event", "eventId", event.ID, "type", event.Type)
+	return &emptypb.Empty{}, nil
+}
+
+// applyCheckoutCompleted upgrades the user who paid, ending any trial.
+func (s *Service) applyCheckoutCompleted(ctx context.Context, event stripe.Event) error {
+	var session stripe.CheckoutSession
+	if err := json.Unmarshal(event.Data.Raw, &session); err != nil {
+		return fmt.Errorf("decode checkout session: %w", err)
+	}
+	userID := session.Metadata["fitglue_user_id"]
+	if userID == "" {
+		s.logger.Warn(ctx, "checkout session has no FitGlue user", "sessionId", session.ID)
+		return nil
+	}
+
+	if err := s.store.UpdateUserTier(ctx, userID, pbuser.UserTier_USER_TIER_ATHLETE, nil); err != nil {
+		return fmt.Errorf("update tier: %w", err)
+	}
+	if err := s.store.UpdateUserAccess(ctx, userID, true); err != nil {
+		return fmt.Errorf("enable access: %w", err)
+	}
+
+	sub, err := s.store.GetSubscription(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("get subscription: %w", err)
+	}
+	if sub == nil {
+		sub = &pbuser.SubscriptionState{UserId: userID}
+	}
+	if session.Customer != nil {
+		sub.StripeCustomerId = session.Customer.ID
+	}
+	if session.Subscription != nil {
+		sub.StripeSubscriptionId = session.Subscription.ID
+	}
+	sub.SubscriptionTier = pbuser.UserTier_USER_TIER_ATHLETE
+	sub.Status = string(stripe.SubscriptionStatusActive)
+	sub.TrialEndsAt = nil
+	sub.CancelAtPeriodEnd = false
+	return s.store.UpsertSubscription(ctx, sub)
+}
+
+// applyPaymentFailed marks the subscription past due. Stripe retries failed
+// payments on its own schedule, so the user keeps the athlete tier until the
+// last attempt fails.
+func (s *Service) applyPaymentFailed(ctx context.Context, event stripe.Event) error {
+	var invoice stripe.Invoice
+	if err := json.Unmarshal(event.Data.Raw, &invoice); err != nil {
+		return fmt.Errorf("decode invoice: %w", err)
+	}
+	userID, err := s.userForCustomer(ctx, invoice.Customer)
+	if err != nil || userID == "" {
+		return err
+	}
+
+	sub, err := s.store.GetSubscription(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("get subscription: %w", err)
+	}
+	if sub == nil {
+		sub = &pbuser.SubscriptionState{UserId: userID}
+	}
+	sub.Status = string(stripe.SubscriptionStatusPastDue)
+
+	if invoice.NextPaymentAttempt == 0 {
+		if err := s.store.UpdateUserTier(ctx, userID, pbuser.UserTier_USER_TIER_HOBBYIST, nil); err != nil {
+			return fmt.Errorf("update tier: %w", err)
+		}
+		sub.SubscriptionTier = pbuser.UserTier_USER_TIER_HOBBYIST
+		sub.Status = string(stripe.SubscriptionStatusUnpaid)
+		sub.TrialEndsAt = nil
+	}
+	return s.store.UpsertSubscription(ctx, sub)
+}
+
+// applySubscriptionDeleted drops the user to the hobbyist tier, unless they
+// have since started another subscription.
+func (s *Service) applySubscriptionDeleted(ctx context.Context, event stripe.Event) error {
+	var subscription stripe.Subscription
+	if err := json.Unmarshal(event.Data.Raw, &subscription); err != nil {
+		return fmt.Errorf("decode subscription: %w", err)
+	}
+	userID, err := s.userForCustomer(ctx, subscription.Customer)
+	if err != nil || userID == "" {
+		return err
+	}
+
+	sub, err := s.store.GetSubscription(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("get subscription: %w", err)
+	}
+	if sub != nil && sub.StripeSubscriptionId != "" && sub.StripeSubscriptionId != subscription.ID {
+		s.logger.Info(ctx, "ignoring deletion of a replaced subscription", "userId", userID, "subscriptionId", subscription.ID)
+		return nil
+	}
+
+	if err := s.store.UpdateUserTier(ctx, userID, pbuser.UserTier_USER_TIER_HOBBYIST, nil); err != nil {
+		return fmt.Errorf("update tier: %w", err)
+	}
+	if sub == nil {
+		sub = &pbuser.SubscriptionState{UserId: userID, StripeSubscriptionId: subscription.ID}
+	}
+	sub.SubscriptionTier = pbuser.UserTier_USER_TIER_HOBBYIST
+	sub.Status = string(subscription.Status)
+	sub.TrialEndsAt = nil
+	sub.CancelAtPeriodEnd = false
+	return s.store.UpsertSubscription(ctx, sub)
+}
+
+// userForCustomer finds the user billed as customer. Events for customers
+// FitGlue doesn't know, such as ones created by hand in the dashboard, are
+// logged and ignored.
+func (s *Service) userForCustomer(ctx context.Context, customer *stripe.Customer) (string, error) {
+	if customer == nil || customer.ID == "" {
+		return "", nil
+	}
+	userID, err := s.store.GetUserIDByStripeCustomer(ctx, customer.ID)
+	if status.Code(err) == codes.NotFound || (err == nil && userID == "") {
+		s.logger.Warn(ctx, "no user for stripe customer", "customerId", customer.ID)
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("find user for customer: %w", err)
+	}
+	return userID, nil
+}
@@ -0,0 +1,343 @@
+// nolint:proto-json
+package dataexport
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"cloud.google.com/go/storage"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	shared "github.com/fitglue/server/src/go/pkg"
+	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+// linkExpiry is how long the download link works. It is the longest a V4
+// signed URL can last, and matches the artifacts bucket lifecycle, which
+// deletes the zip itself after 7 days.
+const linkExpiry = 7 * 24 * time.Hour
+
+// collections are the users/{uid} subcollections exported as-is, each as
+// {name}.json. Pipeline runs are exported separately with their outcomes.
+var collections = []string{"pipelines", "pending_inputs", "personal_records"}
+
+// credentialFields are removed from the user document wherever they appear.
+// They are our access to the user's other accounts, not data about the user.
+var credentialFields = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"api_key":       true,
+}
+
+// Publisher defines the contract for publishing events (e.g., to Pub/Sub).
+type Publisher interface {
+	PublishCloudEvent(ctx context.Context, topic string, ce cloudevents.Event) (string, error)
+}
+
+// RequestExport publishes the event that makes the destination service build
+// the user's data export and send them the download link.
+func RequestExport(ctx context.Context, publisher Publisher, userID string) error {
+	ce, err := infrapubsub.NewCloudEvent(
+		infrapubsub.GetCloudEventSource(pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_DATA_EXPORT),
+		infrapubsub.GetCloudEventType(pbevents.CloudEventType_CLOUD_EVENT_TYPE_DATA_EXPORT_REQUESTED),
+		&pbevents.DataExportRequestedEvent{UserId: userID},
+	)
+	if err != nil {
+		return fmt.Errorf("create cloud event: %w", err)
+	}
+	if _, err := publisher.PublishCloudEvent(ctx, shared.TopicDataExportRequested, ce); err != nil {
+		return fmt.Errorf("publish: %w", err)
+	}
+	return nil
+}
+
+// BlobStore is where stored payloads and FIT files are read from, and where
+// the export zip is streamed to and signed for download.
+type BlobStore interface {
+	NewReader(ctx context.Context, bucket, object string) (io.ReadCloser, error)
+	NewWriter(ctx context.Context, bucket, object string) io.WriteCloser
+	SignedURL(ctx context.Context, bucket, object, contentType string, contentLength int64, expiry time.Duration) (string, error)
+}
+
+// Exporter builds a zip of everything held for a user: their user document,
+// pipelines, pipeline runs, pending inputs and personal records, plus the
+// stored payload and FIT file of each run.
+type Exporter struct {
+	store         Store
+	blobs         BlobStore
+	bucket        string
+	userSvc       userpb.UserServiceClient
+	notifications shared.NotificationService
+	logger        infra.Logger
+}
+
+func NewExporter(store Store, blobs BlobStore, bucket string, userSvc userpb.UserServiceClient, notifications shared.NotificationService, logger infra.Logger) *Exporter {
+	return &Exporter{
+		store:         store,
+		blobs:         blobs,
+		bucket:        bucket,
+		userSvc:       userSvc,
+		notifications: notifications,
+		logger:        logger,
+	}
+}
+
+// HandlePubSubPush unwraps a Pub/Sub push envelope carrying a DataExportRequestedEvent
+func (e *Exporter) HandlePubSubPush(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		e.logger.Error(ctx, "Failed to read request body", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	var msg struct {
+		Message struct {
+			Data []byte `json:"data"`
+			ID   string `json:"messageId"`
+		} `json:"message"`
+	}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		e.logger.Error(ctx, "Failed to unmarshal pub/sub envelope", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	var ce event.Event
+	if err := json.Unmarshal(msg.Message.Data, &ce); err != nil {
+		e.logger.Error(ctx, "Failed to unmarshal inner CloudEvent", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	if err := e.Process(ctx, &ce); err != nil {
+		e.logger.Error(ctx, "Failed to export user data", "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "OK")
+}
+
+// Process unmarshals a DataExportRequestedEvent and runs the export.
+// Returned errors are retried by Pub/Sub.
+func (e *Exporter) Process(ctx context.Context, ce *event.Event) error {
+	var req pbevents.DataExportRequestedEvent
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(ce.Data(), &req); err != nil {
+		e.logger.Error(ctx, "Failed to unmarshal DataExportRequestedEvent", "error", err)
+		return nil // Ack malformed payloads
+	}
+	return e.Export(ctx, req.UserId)
+}
+
+// Export writes the user's data to a zip in the artifacts bucket and sends
+// them a push notification with a download link.
+func (e *Exporter) Export(ctx context.Context, userID string) error {
+	if userID == "" {
+		e.logger.Warn(ctx, "Data export requested without a user")
+		return nil
+	}
+
+	user, err := e.store.GetUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("getting user: %w", err)
+	}
+	if user == nil {
+		e.logger.Warn(ctx, "Data export requested for unknown user", "user_id", userID)
+		return nil
+	}
+	redactCredentials(user)
+
+	// The zip is streamed to the bucket entry by entry, so only one file is
+	// held in memory at a time however much history the user has. Cancelling
+	// the context abandons the upload if any entry fails.
+	now := time.Now()
+	objectPath := fmt.Sprintf("exports/%s/data-%d.zip", userID, now.UnixMilli())
+	uploadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w := e.blobs.NewWriter(uploadCtx, e.bucket, objectPath)
+	z := &exportZip{zw: zip.NewWriter(w), modified: now, names: map[string]bool{}}
+
+	runs, err := e.writeEntries(ctx, z, userID, user)
+	if err == nil {
+		err = z.zw.Close()
+	}
+	if err != nil {
+		cancel()
+		_ = w.Close()
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("writing zip: %w", err)
+	}
+
+	// No content type, so the link is a download rather than an upload
+	url, err := e.blobs.SignedURL(ctx, e.bucket, objectPath, "", 0, linkExpiry)
+	if err != nil {
+		return fmt.Errorf("signing zip download: %w", err)
+	}
+
+	e.logger.Info(ctx, "Data export completed", "user_id", userID, "pipeline_runs", runs, "files", len(z.names))
+	e.notify(ctx, userID, url)
+	return nil
+}
+
+// writeEntries adds every exported file to the zip and returns the number of
+// pipeline runs exported.
+func (e *Exporter) writeEntries(ctx context.Context, z *exportZip, userID string, user Document) (int, error) {
+	if err := z.addJSON("user.json", user); err != nil {
+		return 0, err
+	}
+	for _, name := range collections {
+		docs, err := e.store.ListCollection(ctx, userID, name)
+		if err != nil {
+			return 0, fmt.Errorf("listing %s: %w", name, err)
+		}
+		if err := z.addJSON(name+".json", docs); err != nil {
+			return 0, err
+		}
+	}
+
+	runs, err := e.store.ListPipelineRuns(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("listing pipeline runs: %w", err)
+	}
+	if err := z.addJSON("pipeline_runs.json", runs); err != nil {
+		return 0, err
+	}
+	return len(runs), e.addArtifacts(ctx, z, userID, runs)
+}
+
+// addArtifacts adds the stored payload and FIT file behind each run.
+// Artifacts expire from the bucket after a few days, so missing ones are
+// skipped.
+func (e *Exporter) addArtifacts(ctx context.Context, z *exportZip, userID string, runs []Document) error {
+	for _, run := range runs {
+		runID, _ := run["id"].(string)
+		if uri, _ := run["original_payload_uri"].(string); uri != "" && runID != "" {
+			if err := e.addBlob(ctx, z, "payloads/"+runID+".json", "", uri); err != nil {
+				return err
+			}
+		}
+
+		activityID, _ := run["activity_id"].(string)
+		name := "fit/" + activityID + ".fit"
+		if activityID == "" || z.names[name] {
+			continue
+		}
+		object := fmt.Sprintf("activities/%s/%s.fit", userID, activityID)
+		if err := e.addBlob(ctx, z, name, e.bucket, object); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addBlob copies a stored object into the zip without reading it into memory.
+func (e *Exporter) addBlob(ctx context.Context, z *exportZip, name, bucket, object string) error {
+	rc, err := e.blobs.NewReader(ctx, bucket, object)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", object, err)
+	}
+	defer rc.Close()
+
+	w, err := z.create(name)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, rc); err != nil {
+		return fmt.Errorf("copying %s: %w", object, err)
+	}
+	return nil
+}
+
+// notify tells the user their export is ready. Failures are only logged; the
+// export itself has already been written.
+func (e *Exporter) notify(ctx context.Context, userID, url string) {
+	profile, err := e.userSvc.GetProfile(ctx, &userpb.GetProfileRequest{UserId: userID})
+	if err != nil {
+		e.logger.Warn(ctx, "Failed to fetch profile for data export notification", "user_id", userID, "error", err)
+		return
+	}
+	if len(profile.FcmTokens) == 0 {
+		return
+	}
+
+	data := map[string]string{
+		"type":    "DATA_EXPORT_READY",
+		"url":     url,
+		"user_id": userID,
+	}
+	body := "Your data export is ready to download. The link expires in 7 days."
+	if err := e.notifications.SendPushNotification(ctx, userID, "Data export ready", body, profile.FcmTokens, data); err != nil {
+		e.logger.Warn(ctx, "Failed to send data export notification", "user_id", userID, "error", err)
+	}
+}
+
+func redactCredentials(v interface{}) {
+	switch t := v.(type) {
+	case Document:
+		redactCredentials(map[string]interface{}(t))
+	case map[string]interface{}:
+		for k, e := range t {
+			if credentialFields[k] {
+				delete(t, k)
+				continue
+			}
+			redactCredentials(e)
+		}
+	case []interface{}:
+		for _, e := range t {
+			redactCredentials(e)
+		}
+	}
+}
+
+// exportZip writes entries to the export zip as they are produced and
+// remembers their names, so FIT files shared by several runs are added once.
+type exportZip struct {
+	zw       *zip.Writer
+	modified time.Time
+	names    map[string]bool
+}
+
+func (z *exportZip) create(name string) (io.Writer, error) {
+	w, err := z.zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: z.modified})
+	if err != nil {
+		return nil, fmt.Errorf("add %s: %w", name, err)
+	}
+	z.names[name] = true
+	return w, nil
+}
+
+func (z *exportZip) addJSON(name string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", name, err)
+	}
+	w, err := z.create(name)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package dataexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/grpc"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+type mockStore struct {
+	user        Document
+	collections map[string][]Document
+	runs        []Document
+	runsErr     error
+}
+
+func (m *mockStore) GetUser(ctx context.Context, userID string) (Document, error) {
+	return m.user, nil
+}
+
+func (m *mockStore) ListCollection(ctx context.Context, userID, collection string) ([]Document, error) {
+	return m.collections[collection], nil
+}
+
+func (m *mockStore) ListPipelineRuns(ctx context.Context, userID string) ([]Document, error) {
+	return m.runs, m.runsErr
+}
+
+type mockBlobStore struct {
+	objects map[string][]byte
+	expiry  time.Duration
+}
+
+func (m *mockBlobStore) NewReader(ctx context.Context, bucket, object string) (io.ReadCloser, error) {
+	data, ok := m.objects[bucket+"/"+object]
+	if !ok {
+		return nil, storage.ErrObjectNotExist
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *mockBlobStore) NewWriter(ctx context.Context, bucket, object string) io.WriteCloser {
+	return &mockObjectWriter{ctx: ctx, key: bucket + "/" + object, objects: m.objects}
+}
+
+// mockObjectWriter behaves like a GCS writer: the object only appears on
+// Close, and not at all if the context was cancelled first.
+type mockObjectWriter struct {
+	bytes.Buffer
+	ctx     context.Context
+	key     string
+	objects map[string][]byte
+}
+
+func (w *mockObjectWriter) Close() error {
+	if err := w.ctx.Err(); err != nil {
+		return err
+	}
+	w.objects[w.key] = w.Bytes()
+	return nil
+}
+
+func (m *mockBlobStore) SignedURL(ctx context.Context, bucket, object, contentType string, contentLength int64, expiry time.Duration) (string, error) {
+	m.expiry = expiry
+	return "https://signed.example/" + object, nil
+}
+
+type mockUserService struct {
+	userpb.UserServiceClient
+}
+
+func (m *mockUserService) GetProfile(ctx context.Context, in *userpb.GetProfileRequest, opts ...grpc.CallOption) (*pbuser.UserProfile, error) {
+	return &pbuser.UserProfile{UserId: in.UserId, FcmTokens: []string{"token1"}}, nil
+}
+
+type mockNotifications struct {
+	data map[string]string
+}
+
+func (m *mockNotifications) SendPushNotification(ctx context.Context, userID string, title, body string, tokens []string, data map[string]string) error {
+	m.data = data
+	return nil
+}
+
+func readZip(t *testing.T, blobs *mockBlobStore) map[string][]byte {
+	t.Helper()
+	for object, data := range blobs.objects {
+		if !strings.HasPrefix(object, "artifacts/exports/u1/data-") {
+			continue
+		}
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Fatalf("invalid zip: %v", err)
+		}
+		files := map[string][]byte{}
+		for _, f := range zr.File {
+			rc, err := f.Open()
+			if err != nil {
+				t.Fatalf("open %s: %v", f.Name, err)
+			}
+			files[f.Name], _ = io.ReadAll(rc)
+			rc.Close()
+		}
+		return files
+	}
+	t.Fatal("no export zip written")
+	return nil
+}
+
+func TestExport(t *testing.T) {
+	store := &mockStore{
+		user: Document{
+			"id": "u1",
+			"integrations": map[string]interface{}{
+				"strava": map[string]interface{}{"enabled": true, "athlete_id": "42", "access_token": "secret", "refresh_token": "secret"},
+				"hevy":   map[string]interface{}{"enabled": true, "api_key": "secret"},
+			},
+		},
+		collections: map[string][]Document{
+			"pipelines":        {{"id": "p1", "name": "Morning runs"}},
+			"personal_records": {{"id": "5k", "value": 1200.0}},
+		},
+		runs: []Document{
+			{"id": "r1", "activity_id": "a1", "original_payload_uri": "gs://artifacts/payloads/u1/a1.json",
+				"destination_outcomes": []Document{{"id": "strava", "external_id": "99"}}},
+			{"id": "r2", "activity_id": "a1", "original_payload_uri": "gs://artifacts/payloads/u1/a1.json"},
+			{"id": "r3", "activity_id": "expired", "original_payload_uri": "gs://artifacts/payloads/u1/expired.json"},
+		},
+	}
+	blobs := &mockBlobStore{objects: map[string][]byte{
+		"/gs://artifacts/payloads/u1/a1.json": []byte(`{"source":"SOURCE_STRAVA"}`),
+		"artifacts/activities/u1/a1.fit":      []byte("FIT"),
+	}}
+	notifications := &mockNotifications{}
+	e := NewExporter(store, blobs, "artifacts", &mockUserService{}, notifications, infra.NewLogger())
+
+	if err := e.Export(context.Background(), "u1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	files := readZip(t, blobs)
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	expected := []string{
+		"fit/a1.fit",
+		"payloads/r1.json",
+		"payloads/r2.json",
+		"pending_inputs.json",
+		"personal_records.json",
+		"pipeline_runs.json",
+		"pipelines.json",
+		"user.json",
+	}
+	if strings.Join(names, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected %v, got %v (expired artifacts must be skipped)", expected, names)
+	}
+
+	if strings.Contains(string(files["user.json"]), "secret") {
+		t.Errorf("credentials must not be exported: %s", files["user.json"])
+	}
+	var user map[string]interface{}
+	if err := json.Unmarshal(files["user.json"], &user); err != nil {
+		t.Fatalf("invalid user.json: %v", err)
+	}
+	strava := user["integrations"].(map[string]interface{})["strava"].(map[string]interface{})
+	if strava["athlete_id"] != "42" {
+		t.Errorf("expected non-credential integration fields to be kept, got %v", strava)
+	}
+	if !strings.Contains(string(files["pipeline_runs.json"]), `"external_id": "99"`) {
+		t.Errorf("expected destination outcomes nested in runs: %s", files["pipeline_runs.json"])
+	}
+
+	if blobs.expiry != 7*24*time.Hour {
+		t.Errorf("expected a 7 day link, got %v", blobs.expiry)
+	}
+	if notifications.data["type"] != "DATA_EXPORT_READY" || notifications.data["url"] == "" {
+		t.Errorf("expected a ready notification with the download link, got %v", notifications.data)
+	}
+}
+
+func TestExport_UnknownUser(t *testing.T) {
+	blobs := &mockBlobStore{objects: map[string][]byte{}}
+	notifications := &mockNotifications{}
+	e := NewExporter(&mockStore{}, blobs, "artifacts", &mockUserService{}, notifications, infra.NewLogger())
+
+	if err := e.Export(context.Background(), "u1"); err != nil {
+		t.Fatalf("expected request to be dropped without error, got %v", err)
+	}
+	if len(blobs.objects) != 0 || notifications.data != nil {
+		t.Error("nothing should be exported for an unknown user")
+	}
+}
+
+func TestExport_FailureAbandonsUpload(t *testing.T) {
+	store := &mockStore{user: Document{"id": "u1"}, runsErr: errors.New("unavailable")}
+	blobs := &mockBlobStore{objects: map[string][]byte{}}
+	notifications := &mockNotifications{}
+	e := NewExporter(store, blobs, "artifacts", &mockUserService{}, notifications, infra.NewLogger())
+
+	if err := e.Export(context.Background(), "u1"); err == nil {
+		t.Fatal("expected the error to be returned so the export is retried")
+	}
+	if len(blobs.objects) != 0 || notifications.data != nil {
+		t.Errorf("a partial zip must not be written or announced, got %v", blobs.objects)
+	}
+}
@@ -0,0 +1,121 @@
+package dataexport
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Document is a Firestore document as stored, with its ID under "id".
+type Document map[string]interface{}
+
+// Store reads everything held for a user in Firestore.
+type Store interface {
+	// GetUser returns the user document, or nil if the user doesn't exist.
+	GetUser(ctx context.Context, userID string) (Document, error)
+	// ListCollection returns every document of a users/{userID} subcollection.
+	ListCollection(ctx context.Context, userID, collection string) ([]Document, error)
+	// ListPipelineRuns returns every pipeline run with its destination
+	// outcomes under "destination_outcomes".
+	ListPipelineRuns(ctx context.Context, userID string) ([]Document, error)
+}
+
+type FirestoreStore struct {
+	client *firestore.Client
+}
+
+func NewFirestoreStore(client *firestore.Client) *FirestoreStore {
+	return &FirestoreStore{client: client}
+}
+
+func (s *FirestoreStore) GetUser(ctx context.Context, userID string) (Document, error) {
+	doc, err := s.client.Collection("users").Doc(userID).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDocument(doc), nil
+}
+
+func (s *FirestoreStore) ListCollection(ctx context.Context, userID, collection string) ([]Document, error) {
+	return readAll(s.client.Collection("users").Doc(userID).Collection(collection).Documents(ctx))
+}
+
+func (s *FirestoreStore) ListPipelineRuns(ctx context.Context, userID string) ([]Document, error) {
+	iter := s.client.Collection("users").Doc(userID).Collection("pipeline_runs").Documents(ctx)
+	defer iter.Stop()
+
+	var runs []Document
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		outcomes, err := readAll(doc.Ref.Collection("destination_outcomes").Documents(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("destination outcomes of run %s: %w", doc.Ref.ID, err)
+		}
+		run := toDocument(doc)
+		if len(outcomes) > 0 {
+			run["destination_outcomes"] = outcomes
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
+func readAll(iter *firestore.DocumentIterator) ([]Document, error) {
+	defer iter.Stop()
+	var docs []Document
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, toDocument(doc))
+	}
+	return docs, nil
+}
+
+func toDocument(doc *firestore.DocumentSnapshot) Document {
+	d := Document(flattenRefs(doc.Data()).(map[string]interface{}))
+	d["id"] = doc.Ref.ID
+	return d
+}
+
+// flattenRefs replaces document references with their path, which is all a
+// reference means outside Firestore.
+func flattenRefs(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			t[k] = flattenRefs(e)
+		}
+		return t
+	case []interface{}:
+		for i, e := range t {
+			t[i] = flattenRefs(e)
+		}
+		return t
+	case *firestore.DocumentRef:
+		if t == nil {
+			return nil
+		}
+		_, path, _ := strings.Cut(t.Path, "/documents/")
+		return path
+	}
+	return v
+}
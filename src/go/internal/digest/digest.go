@@ -0,0 +1,198 @@
+// Package digest sends the weekly email digest: a summary of each opted-in
+// user's activities over the past week, with totals and PRs.
+package digest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/domain/email"
+	"github.com/fitglue/server/src/go/pkg/types/formatters"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+const baseURL = "https://fitglue.tech"
+
+// Summary is the totals of one week's synced activities.
+type Summary struct {
+	Activities      int
+	DistanceMeters  float64
+	DurationSeconds float64
+	PRCount         int
+	ByType          map[pbactivity.ActivityType]int
+	PRActivities    []string // Titles of the activities that set PRs, in start order
+}
+
+// Summarize totals the runs that delivered an activity. Test runs and
+// replays are left out, as are runs that failed or are still waiting.
+func Summarize(runs []*pbpipeline.PipelineRun) Summary {
+	sorted := make([]*pbpipeline.PipelineRun, len(runs))
+	copy(sorted, runs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetStartTime().AsTime().Before(sorted[j].GetStartTime().AsTime())
+	})
+
+	s := Summary{ByType: map[pbactivity.ActivityType]int{}}
+	for _, run := range sorted {
+		if run.IsTest || run.ReplayOf != nil {
+			continue
+		}
+		if run.Status != pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED &&
+			run.Status != pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_PARTIAL {
+			continue
+		}
+
+		s.Activities++
+		s.DistanceMeters += run.DistanceMeters
+		s.DurationSeconds += run.DurationSeconds
+		s.ByType[run.Type]++
+
+		if prs := prCount(run); prs > 0 {
+			s.PRCount += prs
+			title := run.Title
+			if title == "" {
+				title = formatters.FormatActivityType(run.Type)
+			}
+			s.PRActivities = append(s.PRActivities, title)
+		}
+	}
+	return s
+}
+
+// prCount reads the number of new PRs from the run's personal records booster.
+func prCount(run *pbpipeline.PipelineRun) int {
+	total := 0
+	for _, booster := range run.Boosters {
+		if booster.Metadata["pr_status"] != "pr_detected" {
+			continue
+		}
+		n, _ := strconv.Atoi(booster.Metadata["pr_count"])
+		total += n
+	}
+	return total
+}
+
+// Digester sends the weekly digest to every user who turned it on.
+type Digester struct {
+	store         Store
+	notifications shared.NotificationService
+	logger        infra.Logger
+	now           func() time.Time
+}
+
+func NewDigester(store Store, notifications shared.NotificationService, logger infra.Logger) *Digester {
+	return &Digester{
+		store:         store,
+		notifications: notifications,
+		logger:        logger,
+		now:           time.Now,
+	}
+}
+
+// HandlePubSubPush runs the digest when Cloud Scheduler fires it (via Pub/Sub).
+// The message carries nothing; the week is the one that ended at the most
+// recent Monday 00:00 UTC.
+func (d *Digester) HandlePubSubPush(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	sent, err := d.Run(ctx)
+	if err != nil {
+		d.logger.Error(ctx, "Weekly digest failed", "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	d.logger.Info(ctx, "Completed weekly digest", "sent", sent)
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "OK")
+}
+
+// Run sends last week's digest and returns how many were sent. Failures for
+// one user are logged and skipped: returning them would make Pub/Sub retry
+// the whole run and email everyone else twice.
+func (d *Digester) Run(ctx context.Context) (int, error) {
+	sender, ok := d.notifications.(shared.EmailNotificationService)
+	if !ok {
+		return 0, errors.New("email delivery is not configured")
+	}
+
+	to := weekStart(d.now())
+	from := to.AddDate(0, 0, -7)
+
+	users, err := d.store.ListDigestUsers(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list digest users: %w", err)
+	}
+
+	sent := 0
+	for _, u := range users {
+		if u.GetEmail() == "" {
+			continue
+		}
+		runs, err := d.store.ListPipelineRuns(ctx, u.GetUserId(), from, to)
+		if err != nil {
+			d.logger.Error(ctx, "Failed to list pipeline runs for digest", "user_id", u.GetUserId(), "error", err)
+			continue
+		}
+		summary := Summarize(runs)
+		// A week with nothing in it isn't worth an email
+		if summary.Activities == 0 {
+			continue
+		}
+
+		html := email.WeeklyDigestTemplate(buildDigest(from, summary), baseURL)
+		if err := sender.SendEmail(ctx, u.GetEmail(), "Your FitGlue week", html); err != nil {
+			d.logger.Error(ctx, "Failed to send weekly digest", "user_id", u.GetUserId(), "error", err)
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// weekStart returns the Monday 00:00 UTC on or before t.
+func weekStart(t time.Time) time.Time {
+	t = t.UTC()
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+}
+
+func buildDigest(from time.Time, s Summary) email.WeeklyDigest {
+	digest := email.WeeklyDigest{
+		WeekOf:       from.Format("2 Jan 2006"),
+		Activities:   s.Activities,
+		PRCount:      s.PRCount,
+		PRActivities: s.PRActivities,
+	}
+	if s.DistanceMeters > 0 {
+		digest.Distance = fmt.Sprintf("%.1f km", s.DistanceMeters/1000)
+	}
+	if s.DurationSeconds > 0 {
+		minutes := int(s.DurationSeconds) / 60
+		digest.Duration = fmt.Sprintf("%dh %02dm", minutes/60, minutes%60)
+	}
+
+	for activityType, count := range s.ByType {
+		name := "Other"
+		if activityType != pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED {
+			name = formatters.FormatActivityType(activityType)
+		}
+		digest.ByType = append(digest.ByType, email.DigestTypeCount{Type: name, Count: count})
+	}
+	// Most frequent first, then by name so the order is stable
+	sort.Slice(digest.ByType, func(i, j int) bool {
+		if digest.ByType[i].Count != digest.ByType[j].Count {
+			return digest.ByType[i].Count > digest.ByType[j].Count
+		}
+		return digest.ByType[i].Type < digest.ByType[j].Type
+	})
+	return digest
+}
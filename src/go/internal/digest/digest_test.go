@@ -0,0 +1,143 @@
+package digest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+type mockStore struct {
+	users    []*user.Record
+	runs     map[string][]*pbpipeline.PipelineRun
+	from, to time.Time
+}
+
+func (m *mockStore) ListDigestUsers(ctx context.Context) ([]*user.Record, error) {
+	return m.users, nil
+}
+
+func (m *mockStore) ListPipelineRuns(ctx context.Context, userID string, from, to time.Time) ([]*pbpipeline.PipelineRun, error) {
+	m.from, m.to = from, to
+	return m.runs[userID], nil
+}
+
+type sentEmail struct {
+	to, subject, html string
+}
+
+type mockEmail struct {
+	sent []sentEmail
+	fail map[string]bool
+}
+
+func (m *mockEmail) SendPushNotification(ctx context.Context, userID string, title, body string, tokens []string, data map[string]string) error {
+	return nil
+}
+
+func (m *mockEmail) SendEmail(ctx context.Context, to, subject, html string) error {
+	if m.fail[to] {
+		return errors.New("rejected")
+	}
+	m.sent = append(m.sent, sentEmail{to, subject, html})
+	return nil
+}
+
+type pushOnly struct{}
+
+func (pushOnly) SendPushNotification(ctx context.Context, userID string, title, body string, tokens []string, data map[string]string) error {
+	return nil
+}
+
+func run(title string, day int, activityType pbactivity.ActivityType, km float64, status pbpipeline.PipelineRunStatus) *pbpipeline.PipelineRun {
+	return &pbpipeline.PipelineRun{
+		Title:           title,
+		Type:            activityType,
+		StartTime:       timestamppb.New(time.Date(2026, 6, day, 7, 0, 0, 0, time.UTC)),
+		Status:          status,
+		DistanceMeters:  km * 1000,
+		DurationSeconds: 1800,
+	}
+}
+
+func testUser(id, email string) *user.Record {
+	return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id, Email: email}}
+}
+
+func TestSummarize(t *testing.T) {
+	synced := pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED
+	runType := pbactivity.ActivityType_ACTIVITY_TYPE_RUN
+
+	prRun := run("Parkrun", 13, runType, 5, synced)
+	prRun.Boosters = []*pbpipeline.BoosterExecution{
+		{ProviderName: "personal-records", Metadata: map[string]string{"pr_status": "pr_detected", "pr_count": "2"}},
+	}
+	testRun := run("Sample", 10, runType, 5, synced)
+	testRun.IsTest = true
+	replayOf := "run-1"
+	replay := run("Replay", 10, runType, 5, synced)
+	replay.ReplayOf = &replayOf
+
+	s := Summarize([]*pbpipeline.PipelineRun{
+		prRun,
+		run("Easy Run", 9, runType, 8, synced),
+		run("Commute", 11, pbactivity.ActivityType_ACTIVITY_TYPE_RIDE, 12, pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_PARTIAL),
+		run("Broken", 12, runType, 10, pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_FAILED),
+		testRun,
+		replay,
+	})
+
+	assert.Equal(t, 3, s.Activities)
+	assert.InDelta(t, 25000, s.DistanceMeters, 0.01)
+	assert.InDelta(t, 5400, s.DurationSeconds, 0.01)
+	assert.Equal(t, 2, s.PRCount)
+	assert.Equal(t, []string{"Parkrun"}, s.PRActivities)
+	assert.Equal(t, map[pbactivity.ActivityType]int{runType: 2, pbactivity.ActivityType_ACTIVITY_TYPE_RIDE: 1}, s.ByType)
+}
+
+func TestWeekStart(t *testing.T) {
+	monday := time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, monday, weekStart(time.Date(2026, 6, 15, 8, 0, 0, 0, time.UTC)))
+	assert.Equal(t, monday, weekStart(time.Date(2026, 6, 21, 23, 59, 0, 0, time.UTC)))
+}
+
+func TestDigester_Run(t *testing.T) {
+	synced := pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED
+	store := &mockStore{
+		users: []*user.Record{testUser("u1", "a@example.com"), testUser("u2", "b@example.com"), testUser("u3", ""), testUser("u4", "d@example.com")},
+		runs: map[string][]*pbpipeline.PipelineRun{
+			"u1": {run("Long <Run>", 14, pbactivity.ActivityType_ACTIVITY_TYPE_RUN, 21.1, synced)},
+			"u3": {run("Easy Run", 14, pbactivity.ActivityType_ACTIVITY_TYPE_RUN, 5, synced)},
+			"u4": {run("Easy Run", 14, pbactivity.ActivityType_ACTIVITY_TYPE_RUN, 5, synced)},
+		},
+	}
+	sender := &mockEmail{fail: map[string]bool{"d@example.com": true}}
+	d := NewDigester(store, sender, infra.NewLogger())
+	d.now = func() time.Time { return time.Date(2026, 6, 15, 8, 0, 0, 0, time.UTC) }
+
+	sent, err := d.Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent, "users without activities, email or a working inbox are skipped")
+	require.Len(t, sender.sent, 1)
+	assert.Equal(t, "a@example.com", sender.sent[0].to)
+	assert.Contains(t, sender.sent[0].html, "21.1 km")
+	assert.Contains(t, sender.sent[0].html, "8 Jun 2026")
+	assert.Equal(t, time.Date(2026, 6, 8, 0, 0, 0, 0, time.UTC), store.from)
+	assert.Equal(t, time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC), store.to)
+}
+
+func TestDigester_RunWithoutEmail(t *testing.T) {
+	d := NewDigester(&mockStore{}, pushOnly{}, infra.NewLogger())
+	_, err := d.Run(context.Background())
+	assert.EqualError(t, err, "email delivery is not configured")
+}
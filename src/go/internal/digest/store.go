@@ -0,0 +1,71 @@
+package digest
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	storage "github.com/fitglue/server/src/go/pkg/storage/firestore"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+// Store reads the users and pipeline runs a weekly digest covers.
+type Store interface {
+	// ListDigestUsers returns the users who turned on the weekly digest.
+	ListDigestUsers(ctx context.Context) ([]*user.Record, error)
+	// ListPipelineRuns returns a user's pipeline runs for activities that
+	// started in [from, to).
+	ListPipelineRuns(ctx context.Context, userID string, from, to time.Time) ([]*pbpipeline.PipelineRun, error)
+}
+
+type FirestoreStore struct {
+	client *firestore.Client
+}
+
+func NewFirestoreStore(client *firestore.Client) *FirestoreStore {
+	return &FirestoreStore{client: client}
+}
+
+func (s *FirestoreStore) ListDigestUsers(ctx context.Context) ([]*user.Record, error) {
+	iter := s.client.Collection("users").Where("notification_preferences.email_weekly_digest", "==", true).Documents(ctx)
+	defer iter.Stop()
+
+	var users []*user.Record
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		u := storage.FirestoreToUser(doc.Data())
+		u.UserId = doc.Ref.ID
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+func (s *FirestoreStore) ListPipelineRuns(ctx context.Context, userID string, from, to time.Time) ([]*pbpipeline.PipelineRun, error) {
+	iter := s.client.Collection("users").Doc(userID).Collection("pipeline_runs").
+		Where("start_time", ">=", from).
+		Where("start_time", "<", to).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var runs []*pbpipeline.PipelineRun
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, storage.FirestoreToPipelineRun(doc.Data()))
+	}
+	return runs, nil
+}
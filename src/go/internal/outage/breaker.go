@@ -0,0 +1,208 @@
+// Package outage detects platform outages and defers affected work until the
+// platform recovers, so webhooks and uploads aren't retried into failures
+// while Strava (or any other platform) is down.
+package outage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+const (
+	// FailureThreshold is the number of consecutive platform errors, across
+	// all users and instances, that opens the breaker.
+	FailureThreshold = 3
+
+	// cacheTTL bounds how stale an instance's view of a platform can be, so
+	// a healthy platform doesn't cost a Firestore read per event.
+	cacheTTL = 30 * time.Second
+
+	// DrainBatchSize caps how much queued work a single check replays per
+	// platform, keeping the first burst after recovery gentle.
+	DrainBatchSize = 50
+)
+
+// Replayer re-submits a queued work item through the normal path. A
+// failure leaves the item queued for the next check.
+type Replayer func(ctx context.Context, work *pipeline.QueuedPlatformWork) error
+
+// Breaker is a circuit breaker over the shared platform health documents.
+// A nil *Breaker is always closed and records nothing.
+type Breaker struct {
+	store  Store
+	logger infra.Logger
+	now    func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedHealth
+}
+
+type cachedHealth struct {
+	health    *pipeline.PlatformHealth
+	fetchedAt time.Time
+}
+
+// NewBreaker creates a breaker backed by store.
+func NewBreaker(store Store, logger infra.Logger) *Breaker {
+	return &Breaker{
+		store:  store,
+		logger: logger,
+		now:    time.Now,
+		cache:  make(map[string]cachedHealth),
+	}
+}
+
+// IsOpen reports whether the platform is in outage. Store errors are logged
+// and treated as healthy so a Firestore blip never blocks work. Untracked
+// platforms are never in outage.
+func (b *Breaker) IsOpen(ctx context.Context, platform string) bool {
+	if b == nil || !Tracked(platform) {
+		return false
+	}
+	h := b.health(ctx, platform)
+	return h != nil && h.State == pipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_OUTAGE
+}
+
+// RecordFailure counts a platform error and reports whether the platform is
+// now in outage, in which case the caller should queue the work. Errors from
+// untracked platforms aren't counted.
+func (b *Breaker) RecordFailure(ctx context.Context, platform string, cause error) bool {
+	if b == nil || !Tracked(platform) {
+		return false
+	}
+	opened := false
+	h, err := b.store.UpdateHealth(ctx, platform, func(h *pipeline.PlatformHealth) {
+		h.ConsecutiveFailures++
+		msg := cause.Error()
+		h.LastError = &msg
+		if h.ConsecutiveFailures >= FailureThreshold && h.State != pipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_OUTAGE {
+			h.State = pipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_OUTAGE
+			h.OutageStartedAt = timestamppb.New(b.now())
+			opened = true
+		}
+	})
+	if err != nil {
+		b.logger.Warn(ctx, "Failed to record platform failure", "platform", platform, "error", err)
+		return false
+	}
+	b.remember(platform, h)
+
+	if opened {
+		b.logger.Warn(ctx, "Platform outage detected, queueing work until it recovers", "platform", platform, "consecutive_failures", h.ConsecutiveFailures, "error", cause)
+	}
+	return h.State == pipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_OUTAGE
+}
+
+// RecordSuccess resets the platform's failure count, closing the breaker if
+// it was open. Already-healthy platforms cost no write.
+func (b *Breaker) RecordSuccess(ctx context.Context, platform string) {
+	if b == nil || !Tracked(platform) {
+		return
+	}
+	if h := b.health(ctx, platform); h == nil || (h.State != pipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_OUTAGE && h.ConsecutiveFailures == 0) {
+		return
+	}
+	b.markHealthy(ctx, platform)
+}
+
+// Queue defers work until its platform recovers.
+func (b *Breaker) Queue(ctx context.Context, work *pipeline.QueuedPlatformWork) error {
+	work.Id = uuid.NewString()
+	work.QueuedAt = timestamppb.New(b.now())
+	return b.store.Enqueue(ctx, work)
+}
+
+// Drain checks each platform and replays its queued work of the given kind
+// once it is healthy. Platforms in outage are probed first; a failed probe
+// leaves their work queued for the next scheduled check. Returns the number
+// of items replayed.
+func (b *Breaker) Drain(ctx context.Context, kind WorkKind, platforms []string, check HealthCheck, replay Replayer) int {
+	replayed := 0
+	for _, platform := range platforms {
+		h, err := b.store.GetHealth(ctx, platform)
+		if err != nil {
+			b.logger.Warn(ctx, "Skipping platform during outage check", "platform", platform, "error", err)
+			continue
+		}
+
+		if h != nil && h.State == pipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_OUTAGE {
+			if err := check(ctx, platform); err != nil {
+				b.logger.Info(ctx, "Platform still in outage", "platform", platform, "error", err)
+				if _, err := b.store.UpdateHealth(ctx, platform, func(h *pipeline.PlatformHealth) {
+					h.LastCheckedAt = timestamppb.New(b.now())
+				}); err != nil {
+					b.logger.Warn(ctx, "Failed to record outage check", "platform", platform, "error", err)
+				}
+				continue
+			}
+			b.logger.Info(ctx, "Platform recovered from outage", "platform", platform, "outage_started_at", h.OutageStartedAt.AsTime())
+			b.markHealthy(ctx, platform)
+		}
+
+		queued, err := b.store.ListQueued(ctx, kind, platform, DrainBatchSize)
+		if err != nil {
+			b.logger.Warn(ctx, "Failed to list queued work", "platform", platform, "error", err)
+			continue
+		}
+		n := 0
+		for _, work := range queued {
+			if err := replay(ctx, work); err != nil {
+				b.logger.Warn(ctx, "Failed to replay queued work", "platform", platform, "work_id", work.Id, "user_id", work.UserId, "error", err)
+				continue
+			}
+			if err := b.store.DeleteQueued(ctx, work); err != nil {
+				b.logger.Warn(ctx, "Failed to delete replayed work", "platform", platform, "work_id", work.Id, "error", err)
+			}
+			n++
+		}
+		if n > 0 {
+			b.logger.Info(ctx, "Replayed queued platform work", "platform", platform, "count", n, "remaining_in_batch", len(queued)-n)
+		}
+		replayed += n
+	}
+	return replayed
+}
+
+func (b *Breaker) markHealthy(ctx context.Context, platform string) {
+	h, err := b.store.UpdateHealth(ctx, platform, func(h *pipeline.PlatformHealth) {
+		h.State = pipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_HEALTHY
+		h.ConsecutiveFailures = 0
+		h.LastError = nil
+		h.OutageStartedAt = nil
+	})
+	if err != nil {
+		b.logger.Warn(ctx, "Failed to reset platform health", "platform", platform, "error", err)
+		return
+	}
+	b.remember(platform, h)
+}
+
+func (b *Breaker) health(ctx context.Context, platform string) *pipeline.PlatformHealth {
+	b.mu.Lock()
+	cached, ok := b.cache[platform]
+	b.mu.Unlock()
+	if ok && b.now().Sub(cached.fetchedAt) < cacheTTL {
+		return cached.health
+	}
+
+	h, err := b.store.GetHealth(ctx, platform)
+	if err != nil {
+		b.logger.Warn(ctx, "Failed to read platform health", "platform", platform, "error", err)
+		return nil
+	}
+	b.remember(platform, h)
+	return h
+}
+
+func (b *Breaker) remember(platform string, h *pipeline.PlatformHealth) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.cache[platform] = cachedHealth{health: h, fetchedAt: b.now()}
+}
@@ -0,0 +1,240 @@
+package outage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	httputil "github.com/fitglue/server/src/go/pkg/infrastructure/http"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	"google.golang.org/protobuf/proto"
+)
+
+type memStore struct {
+	health map[string]*pipeline.PlatformHealth
+	queue  map[string]*pipeline.QueuedPlatformWork
+}
+
+func newMemStore() *memStore {
+	return &memStore{
+		health: make(map[string]*pipeline.PlatformHealth),
+		queue:  make(map[string]*pipeline.QueuedPlatformWork),
+	}
+}
+
+func (m *memStore) GetHealth(ctx context.Context, platform string) (*pipeline.PlatformHealth, error) {
+	if h, ok := m.health[platform]; ok {
+		return proto.Clone(h).(*pipeline.PlatformHealth), nil
+	}
+	return nil, nil
+}
+
+func (m *memStore) UpdateHealth(ctx context.Context, platform string, fn func(h *pipeline.PlatformHealth)) (*pipeline.PlatformHealth, error) {
+	h, ok := m.health[platform]
+	if !ok {
+		h = &pipeline.PlatformHealth{Platform: platform, State: pipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_HEALTHY}
+	}
+	fn(h)
+	m.health[platform] = h
+	return proto.Clone(h).(*pipeline.PlatformHealth), nil
+}
+
+func (m *memStore) ListOutages(ctx context.Context) ([]*pipeline.PlatformHealth, error) {
+	var out []*pipeline.PlatformHealth
+	for _, h := range m.health {
+		if h.State == pipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_OUTAGE {
+			out = append(out, h)
+		}
+	}
+	return out, nil
+}
+
+func (m *memStore) Enqueue(ctx context.Context, work *pipeline.QueuedPlatformWork) error {
+	m.queue[work.Id] = work
+	return nil
+}
+
+func (m *memStore) ListQueued(ctx context.Context, kind WorkKind, platform string, limit int) ([]*pipeline.QueuedPlatformWork, error) {
+	var out []*pipeline.QueuedPlatformWork
+	for _, w := range m.queue {
+		if w.Platform == platform && KindOf(w) == kind && len(out) < limit {
+			out = append(out, w)
+		}
+	}
+	return out, nil
+}
+
+func (m *memStore) DeleteQueued(ctx context.Context, work *pipeline.QueuedPlatformWork) error {
+	delete(m.queue, work.Id)
+	return nil
+}
+
+func TestBreaker_OpensAfterThreshold(t *testing.T) {
+	ctx := context.Background()
+	b := NewBreaker(newMemStore(), infra.NewLogger())
+	cause := &httputil.HTTPError{StatusCode: 503, Status: "Strava upload failed"}
+
+	for i := 1; i < FailureThreshold; i++ {
+		if b.RecordFailure(ctx, "strava", cause) {
+			t.Fatalf("breaker opened after %d failures", i)
+		}
+	}
+	if !b.RecordFailure(ctx, "strava", cause) {
+		t.Fatal("expected breaker to open at threshold")
+	}
+	if !b.IsOpen(ctx, "strava") {
+		t.Error("expected IsOpen after threshold")
+	}
+	if b.IsOpen(ctx, "hevy") {
+		t.Error("other platforms must stay closed")
+	}
+}
+
+func TestBreaker_SuccessResetsFailures(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	b := NewBreaker(store, infra.NewLogger())
+	cause := errors.New("timeout")
+
+	for i := 1; i < FailureThreshold; i++ {
+		b.RecordFailure(ctx, "strava", cause)
+	}
+	b.RecordSuccess(ctx, "strava")
+	if got := store.health["strava"].ConsecutiveFailures; got != 0 {
+		t.Fatalf("expected failures reset, got %d", got)
+	}
+	if b.RecordFailure(ctx, "strava", cause) {
+		t.Error("a single failure after a success must not open the breaker")
+	}
+}
+
+func TestBreaker_IgnoresPerUserPlatforms(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	b := NewBreaker(store, infra.NewLogger())
+
+	// A user's own WebDAV server failing, or one Hevy key being rate limited,
+	// says nothing about anyone else's uploads
+	for _, platform := range []string{"webdav", "hevy", "discord"} {
+		for i := 0; i <= FailureThreshold; i++ {
+			if b.RecordFailure(ctx, platform, &httputil.HTTPError{StatusCode: 429}) {
+				t.Fatalf("breaker opened for %s", platform)
+			}
+		}
+		if b.IsOpen(ctx, platform) {
+			t.Errorf("expected %s to stay closed", platform)
+		}
+	}
+	if len(store.health) != 0 {
+		t.Errorf("expected no health recorded, got %v", store.health)
+	}
+}
+
+func TestBreaker_NilIsClosed(t *testing.T) {
+	var b *Breaker
+	if b.IsOpen(context.Background(), "strava") || b.RecordFailure(context.Background(), "strava", errors.New("x")) {
+		t.Error("nil breaker must stay closed")
+	}
+	b.RecordSuccess(context.Background(), "strava")
+}
+
+func TestBreaker_Drain(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		probeErr     error
+		wantReplayed int
+		wantState    pipeline.PlatformHealthState
+	}{
+		{"recovered platform replays queue", nil, 1, pipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_HEALTHY},
+		{"failed probe keeps queue", fmt.Errorf("status 502"), 0, pipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_OUTAGE},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemStore()
+			b := NewBreaker(store, infra.NewLogger())
+			for i := 0; i < FailureThreshold; i++ {
+				b.RecordFailure(ctx, "strava", errors.New("503"))
+			}
+
+			upload := &pipeline.QueuedPlatformWork{
+				Platform: "strava",
+				UserId:   "user-1",
+				Work:     &pipeline.QueuedPlatformWork_Upload{Upload: &events.EnrichedActivityEvent{ActivityId: "act-1"}},
+			}
+			source := &pipeline.QueuedPlatformWork{
+				Platform: "strava",
+				UserId:   "user-1",
+				Work:     &pipeline.QueuedPlatformWork_SourceEvent{SourceEvent: &pipeline.QueuedSourceEvent{ActivityId: "123"}},
+			}
+			if err := b.Queue(ctx, upload); err != nil {
+				t.Fatal(err)
+			}
+			if err := b.Queue(ctx, source); err != nil {
+				t.Fatal(err)
+			}
+
+			var replayedIDs []string
+			n := b.Drain(ctx, WorkUpload, []string{"strava"},
+				func(ctx context.Context, platform string) error { return tt.probeErr },
+				func(ctx context.Context, work *pipeline.QueuedPlatformWork) error {
+					replayedIDs = append(replayedIDs, work.GetUpload().GetActivityId())
+					return nil
+				})
+
+			if n != tt.wantReplayed || len(replayedIDs) != tt.wantReplayed {
+				t.Errorf("expected %d replayed, got %d (%v)", tt.wantReplayed, n, replayedIDs)
+			}
+			if got := store.health["strava"].State; got != tt.wantState {
+				t.Errorf("expected state %v, got %v", tt.wantState, got)
+			}
+			if _, ok := store.queue[source.Id]; !ok {
+				t.Error("source events must not be drained by the upload queue")
+			}
+			_, uploadQueued := store.queue[upload.Id]
+			if uploadQueued != (tt.wantReplayed == 0) {
+				t.Errorf("unexpected upload queue state: queued=%v", uploadQueued)
+			}
+		})
+	}
+}
+
+func TestIsOutageError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"server error", &httputil.HTTPError{StatusCode: http.StatusBadGateway}, true},
+		{"wrapped server error", fmt.Errorf("upload: %w", &httputil.HTTPError{StatusCode: 500}), true},
+		{"rate limited", &httputil.HTTPError{StatusCode: http.StatusTooManyRequests}, true},
+		{"unauthorized", &httputil.HTTPError{StatusCode: http.StatusUnauthorized}, false},
+		{"deadline", fmt.Errorf("get: %w", context.DeadlineExceeded), true},
+		{"plain error", errors.New("missing fit_file_uri in metadata"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsOutageError(tt.err); got != tt.want {
+				t.Errorf("IsOutageError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlatformNames(t *testing.T) {
+	if got := DestinationPlatform(pbplugin.DestinationType_DESTINATION_STRAVA); got != "strava" {
+		t.Errorf("DestinationPlatform = %q", got)
+	}
+	if got := SourcePlatform(pbactivity.ActivitySource_SOURCE_STRAVA); got != "strava" {
+		t.Errorf("SourcePlatform = %q", got)
+	}
+}
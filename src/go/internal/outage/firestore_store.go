@@ -0,0 +1,171 @@
+// nolint:proto-json
+package outage
+
+import (
+	"context"
+	"encoding/json"
+
+	"cloud.google.com/go/firestore"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// CollectionPlatformHealth holds one breaker document per platform, read by
+	// the web app's outage banner via the client API.
+	CollectionPlatformHealth = "platform_health"
+
+	CollectionQueuedUploads      = "platform_outage_uploads"
+	CollectionQueuedSourceEvents = "platform_outage_source_events"
+)
+
+type FirestoreStore struct {
+	client *firestore.Client
+}
+
+func NewFirestoreStore(client *firestore.Client) *FirestoreStore {
+	return &FirestoreStore{client: client}
+}
+
+func (s *FirestoreStore) GetHealth(ctx context.Context, platform string) (*pipeline.PlatformHealth, error) {
+	doc, err := s.client.Collection(CollectionPlatformHealth).Doc(platform).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var h pipeline.PlatformHealth
+	if err := decode(doc, &h); err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+func (s *FirestoreStore) UpdateHealth(ctx context.Context, platform string, fn func(h *pipeline.PlatformHealth)) (*pipeline.PlatformHealth, error) {
+	ref := s.client.Collection(CollectionPlatformHealth).Doc(platform)
+	var result *pipeline.PlatformHealth
+
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		h := &pipeline.PlatformHealth{
+			Platform: platform,
+			State:    pipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_HEALTHY,
+		}
+		doc, err := tx.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			if err := decode(doc, h); err != nil {
+				return err
+			}
+		}
+
+		fn(h)
+		h.UpdatedAt = timestamppb.Now()
+
+		data, err := encode(h)
+		if err != nil {
+			return err
+		}
+		result = h
+		return tx.Set(ref, data)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *FirestoreStore) ListOutages(ctx context.Context) ([]*pipeline.PlatformHealth, error) {
+	iter := s.client.Collection(CollectionPlatformHealth).
+		Where("state", "==", pipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_OUTAGE.String()).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var outages []*pipeline.PlatformHealth
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var h pipeline.PlatformHealth
+		if err := decode(doc, &h); err != nil {
+			return nil, err
+		}
+		outages = append(outages, &h)
+	}
+	return outages, nil
+}
+
+func (s *FirestoreStore) Enqueue(ctx context.Context, work *pipeline.QueuedPlatformWork) error {
+	data, err := encode(work)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.Collection(queueCollection(KindOf(work))).Doc(work.Id).Set(ctx, data)
+	return err
+}
+
+func (s *FirestoreStore) ListQueued(ctx context.Context, kind WorkKind, platform string, limit int) ([]*pipeline.QueuedPlatformWork, error) {
+	iter := s.client.Collection(queueCollection(kind)).
+		Where("platform", "==", platform).
+		Limit(limit).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var queued []*pipeline.QueuedPlatformWork
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var w pipeline.QueuedPlatformWork
+		if err := decode(doc, &w); err != nil {
+			return nil, err
+		}
+		queued = append(queued, &w)
+	}
+	return queued, nil
+}
+
+func (s *FirestoreStore) DeleteQueued(ctx context.Context, work *pipeline.QueuedPlatformWork) error {
+	_, err := s.client.Collection(queueCollection(KindOf(work))).Doc(work.Id).Delete(ctx)
+	return err
+}
+
+func queueCollection(kind WorkKind) string {
+	if kind == WorkSourceEvent {
+		return CollectionQueuedSourceEvents
+	}
+	return CollectionQueuedUploads
+}
+
+func encode(m proto.Message) (map[string]interface{}, error) {
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var data map[string]interface{}
+	err = json.Unmarshal(b, &data)
+	return data, err
+}
+
+func decode(doc *firestore.DocumentSnapshot, m proto.Message) error {
+	b, err := json.Marshal(doc.Data())
+	if err != nil {
+		return err
+	}
+	return (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(b, m)
+}
@@ -0,0 +1,118 @@
+package outage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	httputil "github.com/fitglue/server/src/go/pkg/infrastructure/http"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// DestinationPlatform returns the platform id shared with the matching
+// source, so a Strava outage trips uploads and webhooks alike.
+func DestinationPlatform(dest pbplugin.DestinationType) string {
+	return strings.ToLower(strings.TrimPrefix(dest.String(), "DESTINATION_"))
+}
+
+// SourcePlatform returns the platform id for an activity source.
+func SourcePlatform(source pbactivity.ActivitySource) string {
+	return strings.ToLower(strings.TrimPrefix(source.String(), "SOURCE_"))
+}
+
+// perUserPlatforms fail for reasons that are specific to one user, so their
+// errors never count towards an outage: WebDAV servers are hosted by each
+// user, Discord and Slack posts go to each user's own webhook with its own
+// rate limit, and Hevy, Intervals.icu and Notion rate limit each user's API
+// key.
+var perUserPlatforms = map[string]bool{
+	"webdav":    true,
+	"discord":   true,
+	"slack":     true,
+	"hevy":      true,
+	"intervals": true,
+	"notion":    true,
+}
+
+// Tracked reports whether the breaker covers the platform. Errors from
+// untracked platforms only fail the user's own work.
+func Tracked(platform string) bool {
+	return platform != "" && !perUserPlatforms[platform]
+}
+
+// IsOutageError reports whether err looks like the platform itself is down
+// (5xx responses, app-wide rate limiting, timeouts, unreachable hosts) rather
+// than a problem with the user's data or credentials, which must keep failing
+// normally.
+func IsOutageError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var httpErr *httputil.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= 500 || httpErr.StatusCode == http.StatusTooManyRequests
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr)
+}
+
+// probeURLs are unauthenticated endpoints on each platform's API host. Any
+// non-5xx response (usually 401) proves the API is serving again.
+var probeURLs = map[string]string{
+	"strava":        "https://www.strava.com/api/v3/athlete",
+	"hevy":          "https://api.hevyapp.com/v1/workouts/count",
+	"intervals":     "https://intervals.icu/api/v1/athlete/0",
+	"trainingpeaks": "https://api.trainingpeaks.com/v1/athlete/profile",
+	"komoot":        "https://external-api.komoot.de/v007/account/email",
+	"github":        "https://api.github.com/user",
+	"dropbox":       "https://api.dropboxapi.com/2/users/get_current_account",
+	"notion":        "https://api.notion.com/v1/users/me",
+	"discord":       "https://discord.com/api/v10/gateway",
+	"slack":         "https://slack.com/api/api.test",
+	"fitbit":        "https://api.fitbit.com/1/user/-/profile.json",
+	"oura":          "https://api.ouraring.com/v2/usercollection/personal_info",
+	"polar":         "https://www.polaraccesslink.com/v3/users",
+	"wahoo":         "https://api.wahooligan.com/v1/user",
+	"whoop":         "https://api.prod.whoop.com/developer/v1/user/profile/basic",
+}
+
+// HealthCheck reports whether a platform in outage is reachable again.
+type HealthCheck func(ctx context.Context, platform string) error
+
+// ProbeHTTP returns a HealthCheck that calls each platform's probe URL.
+// Platforms without one are assumed recovered, letting the first replayed
+// item act as the probe; the breaker reopens if it fails.
+func ProbeHTTP(client *http.Client) HealthCheck {
+	return func(ctx context.Context, platform string) error {
+		url, ok := probeURLs[platform]
+		if !ok {
+			return nil
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= 500 {
+			return fmt.Errorf("%s health probe returned status %d", platform, resp.StatusCode)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,40 @@
+package outage
+
+import (
+	"context"
+
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+// WorkKind separates the two queues so each service drains only the work it
+// can replay: destination uploads and source webhook events.
+type WorkKind int
+
+const (
+	WorkUpload WorkKind = iota
+	WorkSourceEvent
+)
+
+// Store defines the data access contract for platform health and the
+// deferred work queue.
+type Store interface {
+	// GetHealth returns nil, nil when the platform has never been tracked.
+	GetHealth(ctx context.Context, platform string) (*pipeline.PlatformHealth, error)
+	// UpdateHealth applies fn to the platform's health inside a transaction and
+	// returns the stored result. fn receives a fresh record for new platforms.
+	UpdateHealth(ctx context.Context, platform string, fn func(h *pipeline.PlatformHealth)) (*pipeline.PlatformHealth, error)
+	// ListOutages returns every platform currently in outage.
+	ListOutages(ctx context.Context) ([]*pipeline.PlatformHealth, error)
+
+	Enqueue(ctx context.Context, work *pipeline.QueuedPlatformWork) error
+	ListQueued(ctx context.Context, kind WorkKind, platform string, limit int) ([]*pipeline.QueuedPlatformWork, error)
+	DeleteQueued(ctx context.Context, work *pipeline.QueuedPlatformWork) error
+}
+
+// KindOf reports which queue a work item belongs to.
+func KindOf(work *pipeline.QueuedPlatformWork) WorkKind {
+	if work.GetSourceEvent() != nil {
+		return WorkSourceEvent
+	}
+	return WorkUpload
+}
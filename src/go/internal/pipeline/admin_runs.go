@@ -0,0 +1,145 @@
+package pipeline
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultAdminRuns = 50
+	maxAdminRuns     = 200
+)
+
+// errorRunStatuses are the statuses AdminListPipelineRuns' errors_only
+// filter matches: runs where the pipeline or a destination failed.
+var errorRunStatuses = []pipeline.PipelineRunStatus{
+	pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_FAILED,
+	pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_PARTIAL,
+}
+
+// AdminListPipelineRuns returns recent runs across all users, newest first.
+// The page token is the creation time of the last run returned.
+func (s *Service) AdminListPipelineRuns(ctx context.Context, req *pbsvc.AdminListPipelineRunsRequest) (*pbsvc.AdminListPipelineRunsResponse, error) {
+	filter := RunFilter{UserID: req.UserId, Source: req.Source}
+	switch {
+	case req.ErrorsOnly:
+		filter.Statuses = errorRunStatuses
+	case req.Status != "":
+		name := strings.ToUpper(req.Status)
+		if !strings.HasPrefix(name, "PIPELINE_RUN_STATUS_") {
+			name = "PIPELINE_RUN_STATUS_" + name
+		}
+		v, ok := pipeline.PipelineRunStatus_value[name]
+		if !ok || v == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown status: %s", req.Status)
+		}
+		filter.Statuses = []pipeline.PipelineRunStatus{pipeline.PipelineRunStatus(v)}
+	}
+
+	limit := defaultAdminRuns
+	if req.Limit > 0 && req.Limit <= maxAdminRuns {
+		limit = int(req.Limit)
+	}
+	before := time.Now()
+	if req.PageToken != "" {
+		t, err := time.Parse(time.RFC3339Nano, req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		before = t
+	}
+
+	runs, err := s.store.ListRecentPipelineRuns(ctx, filter, before, limit)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list recent pipeline runs", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list pipeline runs")
+	}
+
+	resp := &pbsvc.AdminListPipelineRunsResponse{Runs: runs}
+	if len(runs) == limit && runs[len(runs)-1].CreatedAt != nil {
+		resp.NextPageToken = runs[len(runs)-1].CreatedAt.AsTime().Format(time.RFC3339Nano)
+	}
+	return resp, nil
+}
+
+// AdminForceCompletePipelineRun finishes a run stuck waiting on input or a
+// retry that will never come. Nothing further runs for it; the status message
+// records who finished it and why.
+func (s *Service) AdminForceCompletePipelineRun(ctx context.Context, req *pbsvc.AdminForceCompletePipelineRunRequest) (*pipeline.PipelineRun, error) {
+	if req.UserId == "" || req.PipelineRunId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and pipeline_run_id are required")
+	}
+	if req.Reason == "" || req.AdminUserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason and admin_user_id are required")
+	}
+	final := req.Status
+	switch final {
+	case pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_UNSPECIFIED:
+		final = pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED
+	case pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED,
+		pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_FAILED,
+		pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SKIPPED:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "status must be SYNCED, FAILED or SKIPPED")
+	}
+
+	// The check and the write share a transaction so a run that finishes
+	// concurrently isn't overwritten with the admin's status.
+	message := "Completed by admin: " + req.Reason
+	now := time.Now()
+	var run *pipeline.PipelineRun
+	var rejected error
+	err := s.store.TransitionPipelineRun(ctx, req.UserId, req.PipelineRunId, func(current *pipeline.PipelineRun) (map[string]interface{}, error) {
+		run, rejected = nil, nil
+		switch {
+		case current == nil:
+			rejected = status.Error(codes.NotFound, "pipeline run not found")
+		case current.Status != pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_PENDING && current.Status != pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING:
+			rejected = status.Errorf(codes.FailedPrecondition, "only pending or running runs can be force-completed, run is %s", current.Status)
+		}
+		if rejected != nil {
+			return nil, rejected
+		}
+		run = current
+		return map[string]interface{}{
+			"status":         int32(final),
+			"status_message": message,
+			"next_retry_at":  nil,
+			"completed_at":   now,
+			"updated_at":     now,
+		}, nil
+	})
+	if rejected != nil {
+		return nil, rejected
+	}
+	if err != nil {
+		s.logger.Error(ctx, "failed to force-complete pipeline run", "error", err, "runId", req.PipelineRunId)
+		return nil, status.Error(codes.Internal, "failed to update pipeline run")
+	}
+
+	// A run waiting on input would otherwise keep prompting the user
+	if run.GetPendingInputId() != "" {
+		input, err := s.store.GetPendingInput(ctx, req.UserId, run.GetPendingInputId())
+		if err == nil && input != nil && input.Status == pipeline.PendingInput_STATUS_WAITING {
+			input.Status = pipeline.PendingInput_STATUS_COMPLETED
+			if err := s.store.UpdatePendingInput(ctx, req.UserId, input); err != nil {
+				s.logger.Warn(ctx, "failed to close pending input of force-completed run", "error", err, "runId", run.Id)
+			}
+		}
+	}
+
+	s.logger.Info(ctx, "pipeline run force-completed", "user_id", req.UserId, "runId", run.Id, "status", final.String(), "admin_user_id", req.AdminUserId, "reason", req.Reason)
+	run.Status = final
+	run.StatusMessage = &message
+	run.NextRetryAt = nil
+	run.CompletedAt = timestamppb.New(now)
+	run.UpdatedAt = timestamppb.New(now)
+	return run, nil
+}
@@ -0,0 +1,73 @@
+package pipeline
+
+import (
+	"context"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultCalendarDays = 365
+	maxCalendarDays     = 366
+)
+
+// GetPipelineCalendar returns daily run counts for one pipeline, for the
+// execution history heatmap. It reads the pre-aggregated daily stats rather
+// than the runs themselves, so a year of history is at most one document per
+// day.
+func (s *Service) GetPipelineCalendar(ctx context.Context, req *pbsvc.GetPipelineCalendarRequest) (*pbsvc.GetPipelineCalendarResponse, error) {
+	if req.UserId == "" || req.PipelineId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and pipeline_id are required")
+	}
+
+	days := req.Days
+	if days <= 0 {
+		days = defaultCalendarDays
+	}
+	if days > maxCalendarDays {
+		days = maxCalendarDays
+	}
+	since := time.Now().UTC().AddDate(0, 0, -int(days-1)).Format(time.DateOnly)
+
+	stats, err := s.store.ListPipelineDailyStats(ctx, req.UserId, req.PipelineId, since)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list pipeline daily stats", "error", err, "pipeline_id", req.PipelineId)
+		return nil, status.Error(codes.Internal, "failed to load calendar")
+	}
+
+	calendar := make([]*pipeline.PipelineCalendarDay, 0, len(stats))
+	for _, day := range stats {
+		if c := countCalendarDay(day); c.Total > 0 {
+			calendar = append(calendar, c)
+		}
+	}
+	return &pbsvc.GetPipelineCalendarResponse{Days: calendar}, nil
+}
+
+// countCalendarDay buckets each run's latest status into the heatmap's
+// outcome counts.
+func countCalendarDay(stats *pipeline.PipelineDailyStats) *pipeline.PipelineCalendarDay {
+	day := &pipeline.PipelineCalendarDay{Date: stats.Date}
+	for _, runStatus := range stats.Runs {
+		day.Total++
+		switch runStatus {
+		case pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED:
+			day.Synced++
+		case pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_PARTIAL:
+			day.Partial++
+		case pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_FAILED:
+			day.Failed++
+		case pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SKIPPED,
+			pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_ARCHIVED,
+			pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_TIER_BLOCKED:
+			day.Skipped++
+		default:
+			day.InProgress++
+		}
+	}
+	return day
+}
@@ -0,0 +1,119 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"strings"
+
+	storage "github.com/fitglue/server/src/go/pkg/storage/firestore"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+// redactedValue replaces secrets in debug bundles, which users attach to
+// support tickets.
+const redactedValue = "[redacted]"
+
+// secretKeySuffixes mark config and metadata keys holding credentials. Keys
+// are matched case-insensitively, so "discord_webhook_url" and "apiKey"
+// both count.
+var secretKeySuffixes = []string{"password", "webhook_url", "webhookurl", "token", "secret", "api_key", "apikey"}
+
+// webhookURLMarkers identify URLs that are themselves credentials, such as
+// Discord and Slack webhook URLs recorded in API calls.
+var webhookURLMarkers = []string{"/api/webhooks/", "hooks.slack.com/services/"}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, suffix := range secretKeySuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSecretValue(value string) bool {
+	for _, marker := range webhookURLMarkers {
+		if strings.Contains(value, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactConfig blanks the credentials in a pipeline config, including a
+// destination's secret key when it predates the move to integration secrets.
+func redactConfig(cfg *pipeline.PipelineConfig) {
+	redactMap(cfg.SourceConfig, "")
+	for _, e := range cfg.Enrichers {
+		redactMap(e.TypedConfig, "")
+	}
+	for destID, d := range cfg.DestinationConfigs {
+		redactMap(d.GetConfig(), destID)
+	}
+	for _, e := range cfg.GetRaceMode().GetEnrichers() {
+		redactMap(e.TypedConfig, "")
+	}
+	for destID, d := range cfg.GetRaceMode().GetDestinationConfigs() {
+		redactMap(d.GetConfig(), destID)
+	}
+}
+
+func redactMap(m map[string]string, destID string) {
+	secretKey, _ := storage.DestinationSecretKey(destID)
+	for k, v := range m {
+		if v != "" && (k == secretKey || isSecretKey(k) || isSecretValue(v)) {
+			m[k] = redactedValue
+		}
+	}
+}
+
+// redactJSON blanks credentials anywhere in a JSON document, such as the
+// destination configs copied into an enriched event's metadata or webhook
+// URLs in recorded API calls. Documents that aren't JSON are returned as is.
+func redactJSON(doc string) string {
+	if doc == "" {
+		return doc
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(doc), &v); err != nil {
+		return doc
+	}
+	if !redactValue(v) {
+		return doc
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		return doc
+	}
+	return string(out)
+}
+
+// redactValue redacts v in place and reports whether anything changed.
+func redactValue(v interface{}) bool {
+	changed := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			if s, ok := child.(string); ok {
+				if s != "" && s != redactedValue && (isSecretKey(k) || isSecretValue(s)) {
+					v[k] = redactedValue
+					changed = true
+				}
+				continue
+			}
+			changed = redactValue(child) || changed
+		}
+	case []interface{}:
+		for i, child := range v {
+			if s, ok := child.(string); ok {
+				if isSecretValue(s) {
+					v[i] = redactedValue
+					changed = true
+				}
+				continue
+			}
+			changed = redactValue(child) || changed
+		}
+	}
+	return changed
+}
@@ -0,0 +1,117 @@
+package pipeline
+
+import (
+	"context"
+	"strings"
+
+	"github.com/fitglue/server/src/go/pkg/description"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DescriptionFetcher reads an activity's current description from a
+// destination platform. The enricher package provides it, using the user's
+// integrations.
+type DescriptionFetcher func(ctx context.Context, userId string, destination plugin.DestinationType, externalId string) (string, error)
+
+// descriptionPreviewDestinations are the destinations whose uploaders merge
+// into the existing description on update.
+var descriptionPreviewDestinations = map[plugin.DestinationType]bool{
+	plugin.DestinationType_DESTINATION_STRAVA: true,
+	plugin.DestinationType_DESTINATION_HEVY:   true,
+}
+
+// SetDescriptionFetcher enables PreviewDescriptionMerge.
+func (s *Service) SetDescriptionFetcher(f DescriptionFetcher) {
+	s.descriptions = f
+}
+
+// PreviewDescriptionMerge shows what updating an activity with its latest
+// run's description would do to the description on a destination, flagging
+// same-source overwrites that would lose text the user wrote there.
+func (s *Service) PreviewDescriptionMerge(ctx context.Context, req *pbsvc.PreviewDescriptionMergeRequest) (*pipeline.DescriptionMergePreview, error) {
+	if req.UserId == "" || req.ActivityId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and activity_id are required")
+	}
+	if !descriptionPreviewDestinations[req.Destination] {
+		return nil, status.Error(codes.InvalidArgument, "description previews are only available for Strava and Hevy")
+	}
+	if s.descriptions == nil {
+		return nil, status.Error(codes.Unimplemented, "description previews are not available")
+	}
+
+	run, err := s.store.FindPipelineRunByActivityId(ctx, req.UserId, req.ActivityId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to find pipeline run by activity", "error", err, "activityId", req.ActivityId)
+		return nil, status.Error(codes.Internal, "failed to look up pipeline run")
+	}
+	if run == nil {
+		return nil, status.Error(codes.NotFound, "no pipeline run found for activity")
+	}
+
+	// Same-source: the activity came from this platform, so updates replace
+	// its description rather than merging into it (see the enricher)
+	sameSource := strings.TrimPrefix(run.Source, "SOURCE_") == strings.TrimPrefix(req.Destination.String(), "DESTINATION_")
+
+	externalID := ""
+	for _, d := range run.Destinations {
+		if d.Destination == req.Destination && d.GetExternalId() != "" {
+			externalID = d.GetExternalId()
+			break
+		}
+	}
+	if externalID == "" && sameSource {
+		externalID = run.SourceActivityId
+	}
+	if externalID == "" {
+		return nil, status.Error(codes.FailedPrecondition, "activity has not been posted to this destination")
+	}
+
+	current, err := s.descriptions(ctx, req.UserId, req.Destination, externalID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to fetch destination description", "error", err, "destination", req.Destination.String(), "activityId", req.ActivityId)
+		return nil, status.Error(codes.Unavailable, "failed to read the description from the destination")
+	}
+
+	merged := description.Merge(current, run.Description, sharedSectionHeader(current, run.Description), sameSource)
+	preview := &pipeline.DescriptionMergePreview{
+		Destination:        req.Destination,
+		ExternalId:         externalID,
+		CurrentDescription: current,
+		MergedDescription:  merged,
+		Overwrite:          sameSource,
+	}
+	for _, line := range description.DiffLines(current, merged) {
+		preview.Diff = append(preview.Diff, &pipeline.DescriptionDiffLine{Op: diffOpToProto(line.Op), Text: line.Text})
+	}
+	if sameSource {
+		preview.DroppedUserLines = description.DroppedUserLines(current, merged)
+		preview.RequiresConfirmation = len(preview.DroppedUserLines) > 0
+	}
+	return preview, nil
+}
+
+// sharedSectionHeader stands in for the section header the enrichers declared,
+// which runs don't keep: the first section in incoming that existing also has.
+func sharedSectionHeader(existing, incoming string) string {
+	for _, h := range description.SectionHeaders(incoming) {
+		if description.HasSection(existing, h) {
+			return h
+		}
+	}
+	return ""
+}
+
+func diffOpToProto(op description.LineOp) pipeline.DescriptionDiffOp {
+	switch op {
+	case description.LineAdded:
+		return pipeline.DescriptionDiffOp_DESCRIPTION_DIFF_OP_ADDED
+	case description.LineRemoved:
+		return pipeline.DescriptionDiffOp_DESCRIPTION_DIFF_OP_REMOVED
+	default:
+		return pipeline.DescriptionDiffOp_DESCRIPTION_DIFF_OP_EQUAL
+	}
+}
@@ -0,0 +1,100 @@
+package enricher
+
+import (
+	"context"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/framework"
+	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+)
+
+// enrichBatch processes an activity batch (see
+// infrapubsub.BatchActivityPayloads) one payload at a time, sharing one
+// orchestrator and its initialized providers across them. Each payload gets
+// its own PipelineRun, as if it had been sent alone.
+//
+// The batch is always acknowledged: redelivering it would run the activities
+// that succeeded again. A payload that fails is republished on its own
+// instead, so it still gets the normal Pub/Sub retries and dead-lettering.
+func enrichBatch(ctx context.Context, e cloudevents.Event, fwCtx *framework.FrameworkContext) (interface{}, error) {
+	var batch pbevents.ActivityPayloadBatch
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(e.Data(), &batch); err != nil {
+		return nil, fmt.Errorf("protojson unmarshal batch: %v", err)
+	}
+
+	fwCtx.Logger.Info("Starting batch enrichment", "size", len(batch.Payloads))
+	orchestrator := newOrchestrator(fwCtx.Service, fwCtx.Service.Notifications)
+	doNotRetry := lagExhausted(e, fwCtx.Logger)
+
+	results := make([]map[string]interface{}, 0, len(batch.Payloads))
+	var succeeded, republished, failed int
+	for i, payload := range batch.Payloads {
+		if payload.UserId == "" {
+			fwCtx.Logger.Error("Dropping batch payload without userId", "batch_index", i)
+			results = append(results, map[string]interface{}{"status": "FAILED", "reason": "missing userId in payload"})
+			failed++
+			continue
+		}
+
+		// Every payload needs its own run, even those the producer left unset
+		if payload.GetPipelineExecutionId() == "" {
+			execID := fmt.Sprintf("%s-%d", fwCtx.ExecutionID, i)
+			payload.PipelineExecutionId = &execID
+		}
+		pipelineExecID := payload.GetPipelineExecutionId()
+		logger := fwCtx.Logger.With("batch_index", i, "pipeline_execution_id", pipelineExecID)
+
+		// Processing modifies the payload's activity in place
+		original := proto.Clone(payload).(*pbevents.ActivityPayload)
+
+		result, err := enrichPayload(ctx, fwCtx, logger, orchestrator, payload, pipelineExecID, doNotRetry)
+		if err == nil {
+			results = append(results, result)
+			succeeded++
+			continue
+		}
+
+		if pubErr := republishPayload(ctx, fwCtx, original); pubErr != nil {
+			logger.Error("Failed to republish batch payload", "error", pubErr)
+			results = append(results, map[string]interface{}{"status": "FAILED", "reason": err.Error()})
+			failed++
+			continue
+		}
+		results = append(results, map[string]interface{}{"status": "REPUBLISHED", "reason": err.Error()})
+		republished++
+	}
+
+	fwCtx.Logger.Info("Batch enrichment complete", "size", len(batch.Payloads), "succeeded", succeeded, "republished", republished, "failed", failed)
+	return map[string]interface{}{
+		"status":      "BATCH",
+		"size":        len(batch.Payloads),
+		"succeeded":   succeeded,
+		"republished": republished,
+		"failed":      failed,
+		"results":     results,
+	}, nil
+}
+
+// republishPayload sends a payload from a batch back to the pipeline-activity
+// topic on its own.
+func republishPayload(ctx context.Context, fwCtx *framework.FrameworkContext, payload *pbevents.ActivityPayload) error {
+	ce, err := infrapubsub.NewCloudEvent(
+		infrapubsub.GetCloudEventSource(pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_ENRICHER),
+		"com.fitglue.activity.pipeline",
+		payload,
+	)
+	if err != nil {
+		return fmt.Errorf("create cloud event: %w", err)
+	}
+	ce.SetExtension("pipeline_execution_id", payload.GetPipelineExecutionId())
+	if _, err := fwCtx.Service.Pub.PublishCloudEvent(ctx, shared.TopicPipelineActivity, ce); err != nil {
+		return fmt.Errorf("publish: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,300 @@
+package enricher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/user_input"
+	infrasentry "github.com/fitglue/server/src/go/pkg/infrastructure/sentry"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+const (
+	// circuitFailureThreshold is the number of consecutive failures, across
+	// all users and instances, that opens a provider's circuit.
+	circuitFailureThreshold = 5
+
+	// circuitCoolDown is how long an open circuit skips its provider. The
+	// first run after it tries the provider again; one more failure reopens it.
+	circuitCoolDown = 15 * time.Minute
+
+	// circuitCacheTTL bounds how stale an instance's view of a circuit can be,
+	// so a healthy provider doesn't cost a Firestore read per run.
+	circuitCacheTTL = 30 * time.Second
+
+	// circuitStatsFlushInterval is how often an instance adds its successful
+	// calls to the shared SLA counters. Failures are written straight away.
+	circuitStatsFlushInterval = 5 * time.Minute
+
+	// circuitBreakerActor is the history actor for automatic changes.
+	circuitBreakerActor = "circuit_breaker"
+)
+
+// CircuitStore is the part of shared.Database the circuit breaker uses.
+type CircuitStore interface {
+	GetProviderCircuit(ctx context.Context, providerType pbplugin.EnricherProviderType) (*pbpipeline.ProviderCircuit, error)
+	UpdateProviderCircuit(ctx context.Context, providerType pbplugin.EnricherProviderType, fn func(c *pbpipeline.ProviderCircuit)) (*pbpipeline.ProviderCircuit, error)
+	AddProviderCircuitChange(ctx context.Context, change *pbpipeline.ProviderCircuitChange) error
+}
+
+// CircuitBreaker skips enricher providers that keep failing, keyed by provider
+// type and shared across users through the provider_circuits collection.
+// Admins can override a circuit's mode to always skip or never skip a provider.
+// A nil *CircuitBreaker is always closed and records nothing.
+type CircuitBreaker struct {
+	store CircuitStore
+	now   func() time.Time
+
+	mu    sync.Mutex
+	cache map[pbplugin.EnricherProviderType]cachedCircuit
+	// successes not yet added to total_calls, and when they were last flushed
+	pending   map[pbplugin.EnricherProviderType]int64
+	flushedAt map[pbplugin.EnricherProviderType]time.Time
+}
+
+type cachedCircuit struct {
+	circuit   *pbpipeline.ProviderCircuit
+	fetchedAt time.Time
+}
+
+// NewCircuitBreaker creates a breaker backed by store.
+func NewCircuitBreaker(store CircuitStore) *CircuitBreaker {
+	return &CircuitBreaker{
+		store:     store,
+		now:       time.Now,
+		cache:     make(map[pbplugin.EnricherProviderType]cachedCircuit),
+		pending:   make(map[pbplugin.EnricherProviderType]int64),
+		flushedAt: make(map[pbplugin.EnricherProviderType]time.Time),
+	}
+}
+
+// Skip reports whether to skip the provider, and the execution to record if
+// so. A provider is skipped while an admin has disabled it or, in automatic
+// mode, while its circuit is open.
+func (b *CircuitBreaker) Skip(ctx context.Context, logger *slog.Logger, providerType pbplugin.EnricherProviderType, providerName string) (ProviderExecution, bool) {
+	if b == nil {
+		return ProviderExecution{}, false
+	}
+	c := b.circuit(ctx, logger, providerType)
+	if c.GetMode() == pbpipeline.ProviderCircuitMode_PROVIDER_CIRCUIT_MODE_DISABLED {
+		return circuitDisabledExecution(providerName, c.GetModeReason()), true
+	}
+	if until, open := b.openUntil(c); open {
+		return circuitOpenExecution(providerName, until), true
+	}
+	return ProviderExecution{}, false
+}
+
+// OpenUntil reports whether the provider's circuit is open and when it closes.
+// Store errors are logged and treated as closed so a Firestore blip never
+// skips a provider.
+func (b *CircuitBreaker) OpenUntil(ctx context.Context, logger *slog.Logger, providerType pbplugin.EnricherProviderType) (time.Time, bool) {
+	if b == nil {
+		return time.Time{}, false
+	}
+	return b.openUntil(b.circuit(ctx, logger, providerType))
+}
+
+// openUntil ignores the failure-driven state unless the mode is automatic.
+func (b *CircuitBreaker) openUntil(c *pbpipeline.ProviderCircuit) (time.Time, bool) {
+	if c == nil || c.OpenUntil == nil || c.Mode != pbpipeline.ProviderCircuitMode_PROVIDER_CIRCUIT_MODE_AUTOMATIC {
+		return time.Time{}, false
+	}
+	until := c.OpenUntil.AsTime()
+	return until, b.now().Before(until)
+}
+
+// Record counts the outcome of a provider call. Retries and waits for user
+// input are expected control flow and leave the circuit as it is.
+func (b *CircuitBreaker) Record(ctx context.Context, logger *slog.Logger, providerType pbplugin.EnricherProviderType, providerName string, err error) {
+	if b == nil {
+		return
+	}
+	var retryErr *providers.RetryableError
+	var waitErr *user_input.WaitForInputError
+	switch {
+	case err == nil:
+		b.recordSuccess(ctx, logger, providerType)
+	case errors.As(err, &retryErr), errors.As(err, &waitErr):
+		return
+	default:
+		b.recordFailure(ctx, logger, providerType, providerName, err)
+	}
+}
+
+// recordFailure counts a failure, opening the circuit and raising a Sentry
+// message once the threshold is reached. Only automatic circuits open.
+func (b *CircuitBreaker) recordFailure(ctx context.Context, logger *slog.Logger, providerType pbplugin.EnricherProviderType, providerName string, cause error) {
+	now := b.now()
+	successes := b.takePending(providerType)
+	opened := false
+	c, err := b.store.UpdateProviderCircuit(ctx, providerType, func(c *pbpipeline.ProviderCircuit) {
+		c.ConsecutiveFailures++
+		c.TotalCalls += successes + 1
+		c.TotalFailures++
+		msg := cause.Error()
+		c.LastError = &msg
+		alreadyOpen := c.OpenUntil != nil && now.Before(c.OpenUntil.AsTime())
+		opened = false
+		if c.ConsecutiveFailures >= circuitFailureThreshold && !alreadyOpen && c.Mode == pbpipeline.ProviderCircuitMode_PROVIDER_CIRCUIT_MODE_AUTOMATIC {
+			c.OpenedAt = timestamppb.New(now)
+			c.OpenUntil = timestamppb.New(now.Add(circuitCoolDown))
+			c.TimesOpened++
+			opened = true
+		}
+	})
+	if err != nil {
+		b.restorePending(providerType, successes)
+		logger.Warn("Failed to record enricher failure", "provider", providerName, "error", err)
+		return
+	}
+	b.remember(providerType, c)
+
+	if opened {
+		b.addChange(ctx, logger, c, pbpipeline.ProviderCircuitEvent_PROVIDER_CIRCUIT_EVENT_OPENED, cause.Error())
+		logger.Warn("Enricher circuit opened, skipping provider until cool-down ends", "provider", providerName, "consecutive_failures", c.ConsecutiveFailures, "open_until", c.OpenUntil.AsTime(), "error", cause)
+		infrasentry.CaptureMessage(
+			fmt.Sprintf("Enricher circuit opened: %s", providerName),
+			"warning",
+			map[string]interface{}{
+				"provider_type":        providerType.String(),
+				"consecutive_failures": c.ConsecutiveFailures,
+				"open_until":           c.OpenUntil.AsTime().Format(time.RFC3339),
+				"last_error":           cause.Error(),
+			},
+			logger,
+		)
+	}
+}
+
+// recordSuccess resets the failure count, closing the circuit. Successes of
+// providers with no failures are batched into total_calls every
+// circuitStatsFlushInterval instead of costing a write each.
+func (b *CircuitBreaker) recordSuccess(ctx context.Context, logger *slog.Logger, providerType pbplugin.EnricherProviderType) {
+	now := b.now()
+	b.mu.Lock()
+	b.pending[providerType]++
+	flushedAt, seen := b.flushedAt[providerType]
+	if !seen {
+		b.flushedAt[providerType] = now
+	}
+	b.mu.Unlock()
+
+	c := b.circuit(ctx, logger, providerType)
+	failing := c != nil && (c.ConsecutiveFailures > 0 || c.OpenUntil != nil)
+	if !failing && (!seen || now.Sub(flushedAt) < circuitStatsFlushInterval) {
+		return
+	}
+
+	successes := b.takePending(providerType)
+	closed := false
+	c, err := b.store.UpdateProviderCircuit(ctx, providerType, func(c *pbpipeline.ProviderCircuit) {
+		c.TotalCalls += successes
+		closed = c.OpenUntil != nil
+		c.ConsecutiveFailures = 0
+		c.LastError = nil
+		c.OpenedAt = nil
+		c.OpenUntil = nil
+	})
+	if err != nil {
+		b.restorePending(providerType, successes)
+		logger.Warn("Failed to update enricher circuit", "provider_type", providerType.String(), "error", err)
+		return
+	}
+	b.remember(providerType, c)
+	if closed {
+		b.addChange(ctx, logger, c, pbpipeline.ProviderCircuitEvent_PROVIDER_CIRCUIT_EVENT_CLOSED, "")
+	}
+}
+
+// takePending returns and clears the provider's unflushed successes.
+func (b *CircuitBreaker) takePending(providerType pbplugin.EnricherProviderType) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	n := b.pending[providerType]
+	delete(b.pending, providerType)
+	b.flushedAt[providerType] = b.now()
+	return n
+}
+
+// restorePending puts back successes whose flush failed.
+func (b *CircuitBreaker) restorePending(providerType pbplugin.EnricherProviderType, n int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.pending[providerType] += n
+}
+
+// addChange records an automatic open or close in the circuit's history.
+func (b *CircuitBreaker) addChange(ctx context.Context, logger *slog.Logger, c *pbpipeline.ProviderCircuit, event pbpipeline.ProviderCircuitEvent, reason string) {
+	change := &pbpipeline.ProviderCircuitChange{
+		ProviderType: c.ProviderType,
+		Event:        event,
+		Mode:         c.Mode,
+		Actor:        circuitBreakerActor,
+		CreatedAt:    timestamppb.New(b.now()),
+	}
+	if reason != "" {
+		change.Reason = &reason
+	}
+	if err := b.store.AddProviderCircuitChange(ctx, change); err != nil {
+		logger.Warn("Failed to record enricher circuit change", "provider_type", c.ProviderType.String(), "event", event.String(), "error", err)
+	}
+}
+
+func (b *CircuitBreaker) circuit(ctx context.Context, logger *slog.Logger, providerType pbplugin.EnricherProviderType) *pbpipeline.ProviderCircuit {
+	b.mu.Lock()
+	cached, ok := b.cache[providerType]
+	b.mu.Unlock()
+	if ok && b.now().Sub(cached.fetchedAt) < circuitCacheTTL {
+		return cached.circuit
+	}
+
+	c, err := b.store.GetProviderCircuit(ctx, providerType)
+	if err != nil {
+		logger.Warn("Failed to read enricher circuit", "provider_type", providerType.String(), "error", err)
+		return nil
+	}
+	b.remember(providerType, c)
+	return c
+}
+
+func (b *CircuitBreaker) remember(providerType pbplugin.EnricherProviderType, c *pbpipeline.ProviderCircuit) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.cache[providerType] = cachedCircuit{circuit: c, fetchedAt: b.now()}
+}
+
+// circuitDisabledExecution records a provider an admin has disabled.
+func circuitDisabledExecution(providerName string, reason string) ProviderExecution {
+	pe := ProviderExecution{
+		ProviderName: providerName,
+		Status:       "SKIPPED",
+		Error:        "provider temporarily disabled",
+		Metadata:     map[string]string{"skip_reason": "provider_disabled"},
+	}
+	if reason != "" {
+		pe.Metadata["disabled_reason"] = reason
+	}
+	return pe
+}
+
+// circuitOpenExecution records a provider skipped because its circuit is open.
+func circuitOpenExecution(providerName string, openUntil time.Time) ProviderExecution {
+	return ProviderExecution{
+		ProviderName: providerName,
+		Status:       "SKIPPED",
+		Error:        "circuit open after repeated failures",
+		Metadata: map[string]string{
+			"skip_reason": "circuit_open",
+			"open_until":  openUntil.UTC().Format(time.RFC3339),
+		},
+	}
+}
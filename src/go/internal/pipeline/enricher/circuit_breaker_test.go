@@ -0,0 +1,304 @@
+package enricher
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/user_input"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+// memCircuitStore is an in-memory CircuitStore
+type memCircuitStore struct {
+	circuits map[pbplugin.EnricherProviderType]*pbpipeline.ProviderCircuit
+	changes  []*pbpipeline.ProviderCircuitChange
+	reads    int
+}
+
+func newMemCircuitStore() *memCircuitStore {
+	return &memCircuitStore{circuits: make(map[pbplugin.EnricherProviderType]*pbpipeline.ProviderCircuit)}
+}
+
+func (m *memCircuitStore) GetProviderCircuit(ctx context.Context, providerType pbplugin.EnricherProviderType) (*pbpipeline.ProviderCircuit, error) {
+	m.reads++
+	if c, ok := m.circuits[providerType]; ok {
+		return proto.Clone(c).(*pbpipeline.ProviderCircuit), nil
+	}
+	return nil, nil
+}
+
+func (m *memCircuitStore) UpdateProviderCircuit(ctx context.Context, providerType pbplugin.EnricherProviderType, fn func(c *pbpipeline.ProviderCircuit)) (*pbpipeline.ProviderCircuit, error) {
+	c, ok := m.circuits[providerType]
+	if !ok {
+		c = &pbpipeline.ProviderCircuit{ProviderType: providerType}
+	}
+	fn(c)
+	m.circuits[providerType] = c
+	return proto.Clone(c).(*pbpipeline.ProviderCircuit), nil
+}
+
+func (m *memCircuitStore) AddProviderCircuitChange(ctx context.Context, change *pbpipeline.ProviderCircuitChange) error {
+	m.changes = append(m.changes, change)
+	return nil
+}
+
+const weatherType = pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER
+
+func TestCircuitBreaker_OpensAfterThreshold(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 5, 2, 8, 0, 0, 0, time.UTC)
+	store := newMemCircuitStore()
+	b := NewCircuitBreaker(store)
+	b.now = func() time.Time { return now }
+
+	for i := 0; i < circuitFailureThreshold-1; i++ {
+		b.Record(ctx, slog.Default(), weatherType, "weather", errors.New("503 from upstream"))
+	}
+	if _, open := b.OpenUntil(ctx, slog.Default(), weatherType); open {
+		t.Fatal("Expected the circuit closed below the threshold")
+	}
+
+	b.Record(ctx, slog.Default(), weatherType, "weather", errors.New("503 from upstream"))
+	until, open := b.OpenUntil(ctx, slog.Default(), weatherType)
+	if !open || !until.Equal(now.Add(circuitCoolDown)) {
+		t.Fatalf("Expected the circuit open until %v, got %v (open=%v)", now.Add(circuitCoolDown), until, open)
+	}
+	if got := store.circuits[weatherType].GetLastError(); got != "503 from upstream" {
+		t.Errorf("Expected the last error stored, got %q", got)
+	}
+
+	// After the cool-down the next run tries the provider; one more failure reopens it
+	now = now.Add(circuitCoolDown + time.Minute)
+	if _, open := b.OpenUntil(ctx, slog.Default(), weatherType); open {
+		t.Fatal("Expected the circuit closed after the cool-down")
+	}
+	b.Record(ctx, slog.Default(), weatherType, "weather", errors.New("503 from upstream"))
+	if _, open := b.OpenUntil(ctx, slog.Default(), weatherType); !open {
+		t.Error("Expected a failure after the cool-down to reopen the circuit")
+	}
+}
+
+func TestCircuitBreaker_SuccessResets(t *testing.T) {
+	ctx := context.Background()
+	store := newMemCircuitStore()
+	b := NewCircuitBreaker(store)
+
+	for i := 0; i < circuitFailureThreshold-1; i++ {
+		b.Record(ctx, slog.Default(), weatherType, "weather", errors.New("timeout"))
+	}
+	b.Record(ctx, slog.Default(), weatherType, "weather", nil)
+	if got := store.circuits[weatherType].GetConsecutiveFailures(); got != 0 {
+		t.Fatalf("Expected the failure count reset, got %d", got)
+	}
+
+	// A healthy provider is served from the cache
+	reads := store.reads
+	b.Record(ctx, slog.Default(), weatherType, "weather", nil)
+	b.OpenUntil(ctx, slog.Default(), weatherType)
+	if store.reads != reads {
+		t.Errorf("Expected no reads while cached, got %d", store.reads-reads)
+	}
+}
+
+func TestCircuitBreaker_RecordsHistoryAndStats(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 5, 2, 8, 0, 0, 0, time.UTC)
+	store := newMemCircuitStore()
+	b := NewCircuitBreaker(store)
+	b.now = func() time.Time { return now }
+
+	b.Record(ctx, slog.Default(), weatherType, "weather", nil)
+	b.Record(ctx, slog.Default(), weatherType, "weather", nil)
+	if _, ok := store.circuits[weatherType]; ok {
+		t.Fatal("Expected successes of a healthy provider to be batched")
+	}
+
+	for i := 0; i < circuitFailureThreshold; i++ {
+		b.Record(ctx, slog.Default(), weatherType, "weather", errors.New("503 from upstream"))
+	}
+	now = now.Add(circuitCoolDown + time.Minute)
+	b.Record(ctx, slog.Default(), weatherType, "weather", nil)
+
+	c := store.circuits[weatherType]
+	if c.TotalCalls != 8 || c.TotalFailures != 5 || c.TimesOpened != 1 {
+		t.Errorf("Expected 8 calls, 5 failures and 1 open, got %d, %d and %d", c.TotalCalls, c.TotalFailures, c.TimesOpened)
+	}
+	if len(store.changes) != 2 ||
+		store.changes[0].Event != pbpipeline.ProviderCircuitEvent_PROVIDER_CIRCUIT_EVENT_OPENED ||
+		store.changes[0].GetReason() != "503 from upstream" ||
+		store.changes[1].Event != pbpipeline.ProviderCircuitEvent_PROVIDER_CIRCUIT_EVENT_CLOSED ||
+		store.changes[1].Actor != circuitBreakerActor {
+		t.Errorf("Expected an OPENED then CLOSED change, got %v", store.changes)
+	}
+
+	// Later successes are flushed once the interval passes
+	b.Record(ctx, slog.Default(), weatherType, "weather", nil)
+	now = now.Add(circuitStatsFlushInterval)
+	b.Record(ctx, slog.Default(), weatherType, "weather", nil)
+	if got := store.circuits[weatherType].TotalCalls; got != 10 {
+		t.Errorf("Expected batched successes flushed, got %d calls", got)
+	}
+}
+
+func TestCircuitBreaker_ModeOverrides(t *testing.T) {
+	ctx := context.Background()
+	store := newMemCircuitStore()
+	reason := "Met Office outage"
+	store.circuits[weatherType] = &pbpipeline.ProviderCircuit{
+		ProviderType: weatherType,
+		Mode:         pbpipeline.ProviderCircuitMode_PROVIDER_CIRCUIT_MODE_DISABLED,
+		ModeReason:   &reason,
+	}
+	b := NewCircuitBreaker(store)
+
+	pe, skip := b.Skip(ctx, slog.Default(), weatherType, "weather")
+	if !skip || pe.Metadata["skip_reason"] != "provider_disabled" || pe.Metadata["disabled_reason"] != reason {
+		t.Fatalf("Expected a disabled provider skipped with its reason, got %+v (skip=%v)", pe, skip)
+	}
+
+	// Forced on: failures are counted but never open the circuit
+	store.circuits[weatherType] = &pbpipeline.ProviderCircuit{
+		ProviderType: weatherType,
+		Mode:         pbpipeline.ProviderCircuitMode_PROVIDER_CIRCUIT_MODE_ENABLED,
+	}
+	b = NewCircuitBreaker(store)
+	for i := 0; i < circuitFailureThreshold*2; i++ {
+		b.Record(ctx, slog.Default(), weatherType, "weather", errors.New("timeout"))
+	}
+	if _, skip := b.Skip(ctx, slog.Default(), weatherType, "weather"); skip {
+		t.Error("Expected an enabled provider never to be skipped")
+	}
+	if got := store.circuits[weatherType].TotalFailures; got != int64(circuitFailureThreshold*2) {
+		t.Errorf("Expected failures still counted, got %d", got)
+	}
+	if len(store.changes) != 0 {
+		t.Errorf("Expected no automatic changes while enabled, got %v", store.changes)
+	}
+}
+
+func TestCircuitBreaker_IgnoresControlFlow(t *testing.T) {
+	ctx := context.Background()
+	store := newMemCircuitStore()
+	b := NewCircuitBreaker(store)
+
+	for i := 0; i < circuitFailureThreshold; i++ {
+		b.Record(ctx, slog.Default(), weatherType, "weather", providers.NewRetryableError(errors.New("not ready"), time.Minute, "not ready"))
+		b.Record(ctx, slog.Default(), weatherType, "weather", &user_input.WaitForInputError{ActivityID: "a1"})
+	}
+	if _, ok := store.circuits[weatherType]; ok {
+		t.Error("Expected retries and waits for input not to count as failures")
+	}
+}
+
+func TestCircuitBreaker_Nil(t *testing.T) {
+	var b *CircuitBreaker
+	b.Record(context.Background(), slog.Default(), weatherType, "weather", errors.New("boom"))
+	if _, open := b.OpenUntil(context.Background(), slog.Default(), weatherType); open {
+		t.Error("Expected a nil breaker to be closed")
+	}
+}
+
+func TestOrchestrator_OpenCircuitSkipsProvider(t *testing.T) {
+	ctx := context.Background()
+	openUntil := time.Now().Add(10 * time.Minute)
+
+	mockDB := &MockDatabase{
+		GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+			return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+		},
+		GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+			return []*pbpipeline.PipelineConfig{{
+				Id:           "p1",
+				Source:       "SOURCE_HEVY",
+				Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+				Enrichers: []*pbpipeline.EnricherConfig{
+					{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ACTIVITY_FILTER},
+					{ProviderType: weatherType},
+				},
+			}}, nil
+		},
+	}
+
+	store := newMemCircuitStore()
+	for _, pt := range []pbplugin.EnricherProviderType{weatherType, pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ACTIVITY_FILTER} {
+		store.circuits[pt] = &pbpipeline.ProviderCircuit{
+			ProviderType:        pt,
+			ConsecutiveFailures: circuitFailureThreshold,
+			OpenUntil:           timestamppb.New(openUntil),
+		}
+	}
+
+	o := NewOrchestrator(mockDB, &MockBlobStore{}, "test-bucket", nil)
+	o.SetCircuitBreaker(NewCircuitBreaker(store))
+
+	filterRan, weatherRan := false, false
+	o.Register(&MockEssentialProvider{MockProvider{
+		NameFunc: func() string { return "activity_filter" },
+		ProviderTypeFunc: func() pbplugin.EnricherProviderType {
+			return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ACTIVITY_FILTER
+		},
+		EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+			filterRan = true
+			return &providers.EnrichmentResult{}, nil
+		},
+	}})
+	o.Register(&MockProvider{
+		NameFunc:         func() string { return "weather" },
+		ProviderTypeFunc: func() pbplugin.EnricherProviderType { return weatherType },
+		EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+			weatherRan = true
+			return &providers.EnrichmentResult{}, nil
+		},
+	})
+
+	pipelineID := "p1"
+	payload := &pbevents.ActivityPayload{
+		UserId:     "user-1",
+		Source:     pbactivity.ActivitySource_SOURCE_HEVY,
+		PipelineId: &pipelineID,
+		Timestamp:  timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			Name: "Morning Run",
+			Sessions: []*pbactivity.Session{{
+				StartTime:        timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+				TotalElapsedTime: 60,
+			}},
+		},
+	}
+
+	result, err := o.Process(ctx, slog.Default(), payload, "exec-1", "pipe-exec-1", false)
+	if err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+	if weatherRan {
+		t.Error("Expected weather to be skipped while its circuit is open")
+	}
+	if !filterRan {
+		t.Error("Expected the essential provider to run despite its open circuit")
+	}
+	if store.circuits[pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ACTIVITY_FILTER].GetConsecutiveFailures() != 0 {
+		t.Error("Expected the essential provider's success to reset its circuit")
+	}
+
+	var skipped *ProviderExecution
+	for i, pe := range result.ProviderExecutions {
+		if pe.ProviderName == "weather" {
+			skipped = &result.ProviderExecutions[i]
+		}
+	}
+	if skipped == nil || skipped.Status != "SKIPPED" || skipped.Metadata["skip_reason"] != "circuit_open" {
+		t.Errorf("Expected weather recorded as skipped with circuit_open, got %+v", skipped)
+	}
+}
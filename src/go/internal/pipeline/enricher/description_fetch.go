@@ -0,0 +1,100 @@
+package enricher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/oauth"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// FetchDestinationDescription reads an activity's current description from
+// Strava or Hevy, for description merge previews.
+func FetchDestinationDescription(ctx context.Context, userId string, destination pbplugin.DestinationType, externalId string) (string, error) {
+	svc, err := initService(ctx)
+	if err != nil {
+		return "", fmt.Errorf("service init failed: %w", err)
+	}
+
+	switch destination {
+	case pbplugin.DestinationType_DESTINATION_STRAVA:
+		tokenSource := oauth.NewFirestoreTokenSource(svc, userId, "strava")
+		client := oauth.NewClientWithUsageTracking(tokenSource, svc, userId, "strava", infra.NewLogger())
+		return fetchDescription(ctx, client, fmt.Sprintf("https://www.strava.com/api/v3/activities/%s", externalId), nil)
+	case pbplugin.DestinationType_DESTINATION_HEVY:
+		apiKey, err := hevyAPIKey(ctx, svc, userId)
+		if err != nil {
+			return "", err
+		}
+		client := &http.Client{Timeout: 30 * time.Second}
+		return fetchDescription(ctx, client, fmt.Sprintf("https://api.hevyapp.com/v1/workouts/%s", externalId), map[string]string{"api-key": apiKey})
+	}
+	return "", fmt.Errorf("description previews are not supported for %s", destination)
+}
+
+func hevyAPIKey(ctx context.Context, svc *bootstrap.Service, userId string) (string, error) {
+	user, err := svc.DB.GetUser(ctx, userId)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil || user.Integrations == nil || user.Integrations.Hevy == nil {
+		return "", fmt.Errorf("user has no Hevy API key configured")
+	}
+	apiKey, err := svc.DB.GetIntegrationSecret(ctx, userId, "hevy")
+	if err != nil {
+		return "", fmt.Errorf("failed to get Hevy API key: %w", err)
+	}
+	if apiKey == "" {
+		return "", fmt.Errorf("user has no Hevy API key configured")
+	}
+	return apiKey, nil
+}
+
+// fetchDescription GETs an activity and returns its description. Hevy wraps
+// the workout in a "workout" object; Strava returns the activity bare.
+func fetchDescription(ctx context.Context, client *http.Client, url string, headers map[string]string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create GET request: %w", err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to GET activity: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read activity: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("GET activity failed: status %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	var activity struct {
+		Description *string `json:"description"`
+		Workout     *struct {
+			Description *string `json:"description"`
+		} `json:"workout"`
+	}
+	if err := json.Unmarshal(body, &activity); err != nil {
+		return "", fmt.Errorf("failed to decode activity: %w", err)
+	}
+	if activity.Workout != nil && activity.Workout.Description != nil {
+		return *activity.Workout.Description, nil
+	}
+	if activity.Description != nil {
+		return *activity.Description, nil
+	}
+	return "", nil
+}
@@ -0,0 +1,74 @@
+package enricher
+
+import (
+	"log/slog"
+	"strings"
+
+	"github.com/fitglue/server/src/go/pkg/description"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// renderDescription builds the final description from the description slots
+// (original, one per enricher, then branding if applied). Without a template
+// the non-empty slots are joined in pipeline order. With one, the original and
+// each enricher's section are template fields, and branding is appended after
+// the rendered text so a template can't drop it. A template that fails to
+// render falls back to the default layout.
+func renderDescription(logger *slog.Logger, tmplText string, slots []string, configs []configuredEnricher) string {
+	if tmplText == "" {
+		return buildDescriptionFromSlots(slots)
+	}
+
+	tmpl, err := description.ParseTemplate(tmplText)
+	if err == nil {
+		data := description.NewTemplateData(slots[0])
+		for i, cfg := range configs {
+			data.AddSection(cfg.ProviderType, slots[i+1])
+		}
+
+		var rendered string
+		if rendered, err = description.RenderTemplate(tmpl, data); err == nil {
+			return buildDescriptionFromSlots(append([]string{rendered}, slots[len(configs)+1:]...))
+		}
+	}
+
+	logger.Warn("Description template failed, using default layout", "error", err)
+	return buildDescriptionFromSlots(slots)
+}
+
+// renderTitle renders a destination's title template against the enriched
+// activity, keeping the enriched title when the template fails or renders
+// nothing.
+func renderTitle(logger *slog.Logger, tmplText string, activity *pbactivity.StandardizedActivity) string {
+	tmpl, err := description.ParseTemplate(tmplText)
+	if err == nil {
+		var title string
+		if title, err = description.RenderTitle(tmpl, description.NewTitleData(activity)); err == nil {
+			return title
+		}
+	}
+
+	logger.Warn("Title template failed, keeping enriched title", "error", err)
+	return activity.Name
+}
+
+// destinationDescriptionTemplate returns the template a destination's
+// description is rendered with: its own when set, otherwise the pipeline's.
+func destinationDescriptionTemplate(dest pbplugin.DestinationType, pipeline *configuredPipeline) string {
+	if cfg := pipeline.DestinationConfigs[destinationID(dest)]; cfg != nil && cfg.DescriptionTemplate != "" {
+		return cfg.DescriptionTemplate
+	}
+	return pipeline.DescriptionTemplate
+}
+
+// destinationTitleTemplate returns the template a destination's title is
+// rendered with, or "" to keep the enriched title.
+func destinationTitleTemplate(dest pbplugin.DestinationType, pipeline *configuredPipeline) string {
+	return pipeline.DestinationConfigs[destinationID(dest)].GetTitleTemplate()
+}
+
+// destinationID is the destination's key in DestinationConfigs, e.g. "strava".
+func destinationID(dest pbplugin.DestinationType) string {
+	return strings.ToLower(strings.TrimPrefix(dest.String(), "DESTINATION_"))
+}
@@ -0,0 +1,177 @@
+package enricher
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+func TestRenderDescription(t *testing.T) {
+	configs := []configuredEnricher{
+		{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_HEART_RATE_ZONES},
+		{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER},
+	}
+	slots := []string{"Easy run", "❤️ Zones", "🌤️ Sunny", "Posted with FitGlue"}
+
+	t.Run("NoTemplate", func(t *testing.T) {
+		got := renderDescription(slog.Default(), "", slots, configs)
+		if got != "Easy run\n\n❤️ Zones\n\n🌤️ Sunny\n\nPosted with FitGlue" {
+			t.Errorf("Expected slots joined in order, got %q", got)
+		}
+	})
+
+	t.Run("TemplateKeepsBranding", func(t *testing.T) {
+		got := renderDescription(slog.Default(), "{{.Weather}}\n{{.HRZones}}", slots, configs)
+		if got != "🌤️ Sunny\n❤️ Zones\n\nPosted with FitGlue" {
+			t.Errorf("Expected template output followed by branding, got %q", got)
+		}
+	})
+
+	t.Run("BrokenTemplateFallsBack", func(t *testing.T) {
+		got := renderDescription(slog.Default(), "{{.Weather", slots[:3], configs)
+		if got != "Easy run\n\n❤️ Zones\n\n🌤️ Sunny" {
+			t.Errorf("Expected default layout, got %q", got)
+		}
+	})
+}
+
+func TestGroupDestinationsByTemplate(t *testing.T) {
+	p := &configuredPipeline{
+		DescriptionTemplate: "{{.Sections}}",
+		DestinationConfigs: map[string]*pbpipeline.DestinationConfig{
+			"strava": {DescriptionTemplate: "{{.Original}}"},
+		},
+	}
+	exclusionGroups := map[string][]pbplugin.DestinationType{
+		"": {
+			pbplugin.DestinationType_DESTINATION_STRAVA,
+			pbplugin.DestinationType_DESTINATION_HEVY,
+			pbplugin.DestinationType_DESTINATION_INTERVALS,
+		},
+	}
+
+	groups := groupDestinationsByTemplate(exclusionGroups, p)
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 groups, got %d", len(groups))
+	}
+	if groups[0].template != "{{.Original}}" || len(groups[0].destinations) != 1 {
+		t.Errorf("Expected strava in its own group, got %+v", groups[0])
+	}
+	if groups[1].template != "{{.Sections}}" || len(groups[1].destinations) != 2 {
+		t.Errorf("Expected the pipeline template for the rest, got %+v", groups[1])
+	}
+
+	// A title template alone splits a destination off too
+	p.DestinationConfigs = map[string]*pbpipeline.DestinationConfig{
+		"hevy": {TitleTemplate: "{{.Emoji}} {{.Title}}"},
+	}
+	groups = groupDestinationsByTemplate(exclusionGroups, p)
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 groups, got %d", len(groups))
+	}
+	if groups[1].titleTemplate != "{{.Emoji}} {{.Title}}" || groups[1].destinations[0] != pbplugin.DestinationType_DESTINATION_HEVY {
+		t.Errorf("Expected hevy in its own title group, got %+v", groups[1])
+	}
+	if !groups[0].isDefault(p) || groups[1].isDefault(p) {
+		t.Error("Expected only the untitled group to be the default")
+	}
+}
+
+func TestOrchestrator_DescriptionTemplate(t *testing.T) {
+	ctx := context.Background()
+	pipelineTemplate := "{{.Weather}}\n\n{{.Original}}"
+
+	mockDB := &MockDatabase{
+		GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+			return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+		},
+		GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+			return []*pbpipeline.PipelineConfig{
+				{
+					Id:     "p1",
+					Source: "SOURCE_STRAVA",
+					Destinations: []pbplugin.DestinationType{
+						pbplugin.DestinationType_DESTINATION_HEVY,
+						pbplugin.DestinationType_DESTINATION_STRAVA,
+					},
+					Enrichers: []*pbpipeline.EnricherConfig{
+						{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_HEART_RATE_ZONES},
+						{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER},
+					},
+					DestinationConfigs: map[string]*pbpipeline.DestinationConfig{
+						"strava": {DescriptionTemplate: "## Effort\n{{.HRZones}}", TitleTemplate: "{{.Emoji}} {{.Title}}"},
+					},
+					DescriptionTemplate: &pipelineTemplate,
+				},
+			}, nil
+		},
+	}
+
+	mockProvider := func(name string, pt pbplugin.EnricherProviderType) *MockProvider {
+		return &MockProvider{
+			NameFunc:         func() string { return name },
+			ProviderTypeFunc: func() pbplugin.EnricherProviderType { return pt },
+			EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+				return &providers.EnrichmentResult{Description: name}, nil
+			},
+		}
+	}
+
+	orchestrator := NewOrchestrator(mockDB, &MockBlobStore{}, "test-bucket", nil)
+	orchestrator.Register(mockProvider("zones", pbplugin.EnricherProviderType_ENRICHER_PROVIDER_HEART_RATE_ZONES))
+	orchestrator.Register(mockProvider("weather", pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER))
+	orchestrator.Register(mockProvider("branding", pbplugin.EnricherProviderType_ENRICHER_PROVIDER_UNSPECIFIED))
+
+	pipelineID := "p1"
+	start := timestamppb.Now()
+	result, err := orchestrator.Process(ctx, slog.Default(), &pbevents.ActivityPayload{
+		UserId:     "user-1",
+		Source:     pbactivity.ActivitySource_SOURCE_STRAVA,
+		PipelineId: &pipelineID,
+		Timestamp:  start,
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			Name:        "Lunch Run",
+			Type:        pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+			Description: "Felt good",
+			StartTime:   start,
+			Sessions:    []*pbactivity.Session{{StartTime: start, TotalElapsedTime: 1800}},
+		},
+	}, "exec-1", "pipe-exec-1", false)
+	if err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+	if len(result.Events) != 2 {
+		t.Fatalf("Expected one event per template, got %d", len(result.Events))
+	}
+
+	descriptions := map[pbplugin.DestinationType]string{}
+	titles := map[pbplugin.DestinationType]string{}
+	for _, evt := range result.Events {
+		for _, dest := range evt.Destinations {
+			descriptions[dest] = evt.Description
+			titles[dest] = evt.Name
+		}
+	}
+	if got := descriptions[pbplugin.DestinationType_DESTINATION_HEVY]; got != "weather\n\nFelt good\n\nbranding" {
+		t.Errorf("Expected the pipeline template for hevy, got %q", got)
+	}
+	if got := descriptions[pbplugin.DestinationType_DESTINATION_STRAVA]; got != "## Effort\nzones\n\nbranding" {
+		t.Errorf("Expected strava's own template, got %q", got)
+	}
+	if got := titles[pbplugin.DestinationType_DESTINATION_HEVY]; got != "Lunch Run" {
+		t.Errorf("Expected hevy to keep the enriched title, got %q", got)
+	}
+	if got := titles[pbplugin.DestinationType_DESTINATION_STRAVA]; got != "🏃 Lunch Run" {
+		t.Errorf("Expected strava's decorated title, got %q", got)
+	}
+}
@@ -0,0 +1,304 @@
+package enricher
+
+import (
+	"context"
+	"time"
+
+	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// dryRunService returns a copy of svc whose database and blob store drop
+// writes made under a dry-run context. Providers are initialized once per
+// instance and shared by real runs and previews, so they are always given
+// this copy and the context decides.
+func dryRunService(svc *bootstrap.Service) *bootstrap.Service {
+	guarded := *svc
+	guarded.DB = &dryRunDatabase{Database: svc.DB}
+	guarded.Store = &dryRunBlobStore{BlobStore: svc.Store}
+	return &guarded
+}
+
+// dryRunDatabase passes reads through and drops writes when the context is a
+// dry run. Every write method of shared.Database must be overridden here;
+// embedding passes anything missed straight to Firestore.
+//
+// UpdateUser is the exception and always writes: OAuth token sources use it to
+// persist rotated refresh tokens, and dropping one would disconnect the
+// integration.
+type dryRunDatabase struct {
+	shared.Database
+}
+
+func (d *dryRunDatabase) SetExecution(ctx context.Context, record *pbpipeline.ExecutionRecord) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.SetExecution(ctx, record)
+}
+
+func (d *dryRunDatabase) UpdateExecution(ctx context.Context, userId string, id string, data map[string]interface{}) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.UpdateExecution(ctx, userId, id, data)
+}
+
+func (d *dryRunDatabase) IncrementSyncCount(ctx context.Context, userID string) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.IncrementSyncCount(ctx, userID)
+}
+
+func (d *dryRunDatabase) IncrementPreventedSyncCount(ctx context.Context, userID string) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.IncrementPreventedSyncCount(ctx, userID)
+}
+
+func (d *dryRunDatabase) IncrementUsage(ctx context.Context, userID string, period string, feature string, amount int64) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.IncrementUsage(ctx, userID, period, feature, amount)
+}
+
+func (d *dryRunDatabase) ResetSyncCount(ctx context.Context, userID string) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.ResetSyncCount(ctx, userID)
+}
+
+func (d *dryRunDatabase) CreatePendingInput(ctx context.Context, userId string, input *pbpipeline.PendingInput) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.CreatePendingInput(ctx, userId, input)
+}
+
+func (d *dryRunDatabase) UpdatePendingInput(ctx context.Context, userId string, id string, data map[string]interface{}) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.UpdatePendingInput(ctx, userId, id, data)
+}
+
+func (d *dryRunDatabase) DeletePendingInput(ctx context.Context, userId string, id string) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.DeletePendingInput(ctx, userId, id)
+}
+
+func (d *dryRunDatabase) SetCounter(ctx context.Context, userId string, counter *pbuser.Counter) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.SetCounter(ctx, userId, counter)
+}
+
+func (d *dryRunDatabase) DeleteCounter(ctx context.Context, userId string, id string) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.DeleteCounter(ctx, userId, id)
+}
+
+func (d *dryRunDatabase) SetPersonalRecord(ctx context.Context, userId string, record *pbuser.PersonalRecord) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.SetPersonalRecord(ctx, userId, record)
+}
+
+func (d *dryRunDatabase) DeletePersonalRecord(ctx context.Context, userId string, recordType string) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.DeletePersonalRecord(ctx, userId, recordType)
+}
+
+// RecordGearUse in a preview returns the gear with the activity's distance
+// added, but records nothing.
+func (d *dryRunDatabase) RecordGearUse(ctx context.Context, userId string, gearId string, activityId string, distanceMeters float64, usedAt time.Time) (*pbuser.Gear, bool, error) {
+	if !providers.IsDryRun(ctx) {
+		return d.Database.RecordGearUse(ctx, userId, gearId, activityId, distanceMeters, usedAt)
+	}
+	gear, err := d.Database.GetGear(ctx, userId, gearId)
+	if err != nil || gear == nil {
+		return gear, false, err
+	}
+	gear.DistanceMeters += distanceMeters
+	gear.ActivityCount++
+	gear.LastActivityId = activityId
+	gear.LastUsedAt = timestamppb.New(usedAt)
+	return gear, true, nil
+}
+
+func (d *dryRunDatabase) SetGoal(ctx context.Context, userId string, goal *pbuser.Goal) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.SetGoal(ctx, userId, goal)
+}
+
+func (d *dryRunDatabase) DeleteGoal(ctx context.Context, userId string, goalId string) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.DeleteGoal(ctx, userId, goalId)
+}
+
+func (d *dryRunDatabase) SetPluginDefault(ctx context.Context, userId string, pluginDefault *pbpipeline.PluginDefault) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.SetPluginDefault(ctx, userId, pluginDefault)
+}
+
+func (d *dryRunDatabase) MarkActivityTypeRuleApplied(ctx context.Context, userId string, ruleId string) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.MarkActivityTypeRuleApplied(ctx, userId, ruleId)
+}
+
+func (d *dryRunDatabase) SetShowcasedActivity(ctx context.Context, activity *pbactivity.ShowcasedActivity) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.SetShowcasedActivity(ctx, activity)
+}
+
+func (d *dryRunDatabase) SetShowcaseProfile(ctx context.Context, profile *pbactivity.ShowcaseProfile) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.SetShowcaseProfile(ctx, profile)
+}
+
+func (d *dryRunDatabase) DeleteShowcaseProfile(ctx context.Context, slug string) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.DeleteShowcaseProfile(ctx, slug)
+}
+
+func (d *dryRunDatabase) SetShowcaseProfileEntry(ctx context.Context, userID string, entry *pbactivity.ShowcaseProfileEntry) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.SetShowcaseProfileEntry(ctx, userID, entry)
+}
+
+func (d *dryRunDatabase) SetUploadedActivity(ctx context.Context, userId string, record *pbactivity.UploadedActivityRecord) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.SetUploadedActivity(ctx, userId, record)
+}
+
+func (d *dryRunDatabase) CreatePipelineRun(ctx context.Context, userId string, run *pbpipeline.PipelineRun) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.CreatePipelineRun(ctx, userId, run)
+}
+
+func (d *dryRunDatabase) UpdatePipelineRun(ctx context.Context, userId string, id string, data map[string]interface{}) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.UpdatePipelineRun(ctx, userId, id, data)
+}
+
+func (d *dryRunDatabase) AddPipelineRunCost(ctx context.Context, userId string, id string, cost *pbpipeline.RunCost) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.AddPipelineRunCost(ctx, userId, id, cost)
+}
+
+func (d *dryRunDatabase) SetDestinationOutcome(ctx context.Context, userId string, pipelineRunId string, outcome *pbpipeline.DestinationOutcome) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.SetDestinationOutcome(ctx, userId, pipelineRunId, outcome)
+}
+
+func (d *dryRunDatabase) SetBoosterData(ctx context.Context, userId string, boosterId string, data map[string]interface{}) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.SetBoosterData(ctx, userId, boosterId, data)
+}
+
+func (d *dryRunDatabase) DeleteBoosterData(ctx context.Context, userId string, boosterId string) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.DeleteBoosterData(ctx, userId, boosterId)
+}
+
+// UpdateProviderCircuit returns the stored circuit unchanged in a dry run.
+func (d *dryRunDatabase) UpdateProviderCircuit(ctx context.Context, providerType pbplugin.EnricherProviderType, fn func(c *pbpipeline.ProviderCircuit)) (*pbpipeline.ProviderCircuit, error) {
+	if providers.IsDryRun(ctx) {
+		return d.Database.GetProviderCircuit(ctx, providerType)
+	}
+	return d.Database.UpdateProviderCircuit(ctx, providerType, fn)
+}
+
+func (d *dryRunDatabase) AddProviderCircuitChange(ctx context.Context, change *pbpipeline.ProviderCircuitChange) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.AddProviderCircuitChange(ctx, change)
+}
+
+func (d *dryRunDatabase) SetEnricherResultShare(ctx context.Context, userId string, share *pbpipeline.EnricherResultShare) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return d.Database.SetEnricherResultShare(ctx, userId, share)
+}
+
+// dryRunBlobStore passes reads through and drops writes when the context is a
+// dry run.
+type dryRunBlobStore struct {
+	shared.BlobStore
+}
+
+func (s *dryRunBlobStore) Write(ctx context.Context, bucket, object string, data []byte) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return s.BlobStore.Write(ctx, bucket, object, data)
+}
+
+func (s *dryRunBlobStore) Delete(ctx context.Context, bucket, object string) error {
+	if providers.IsDryRun(ctx) {
+		return nil
+	}
+	return s.BlobStore.Delete(ctx, bucket, object)
+}
+
+// WriteGzip keeps artifact compression available to real runs.
+func (s *dryRunBlobStore) WriteGzip(ctx context.Context, bucket, object string, data []byte) (int, error) {
+	if providers.IsDryRun(ctx) {
+		return len(data), nil
+	}
+	if gz, ok := s.BlobStore.(gzipBlobStore); ok {
+		return gz.WriteGzip(ctx, bucket, object, data)
+	}
+	return len(data), s.BlobStore.Write(ctx, bucket, object, data)
+}
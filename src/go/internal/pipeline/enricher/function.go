@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"os"
 	"sync"
 	"time"
 
@@ -22,8 +24,10 @@ import (
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
 
 	activityPkg "github.com/fitglue/server/src/go/pkg/domain/activity"
+	fit "github.com/fitglue/server/src/go/pkg/domain/file_generators"
 	"github.com/fitglue/server/src/go/pkg/framework"
 
+	"github.com/fitglue/server/src/go/pkg/infrastructure/metrics"
 	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
 
 	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
@@ -32,41 +36,56 @@ import (
 	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 
 	// Register providers
+	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/active_recovery"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/activity_filter"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/ai_banner"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/ai_companion"
+	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/anomaly_check"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/auto_increment"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/branding"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/cadence_summary"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/calories_burned"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/condition_matcher"
+	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/consistency"
+	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/data_quality"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/distance_milestones"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/effort_score"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/elevation_summary"
+	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/energy_expenditure"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/fit_file_heart_rate"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/fitbit_heart_rate"
+	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/gear_tracker"
+	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/goal_progress"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/goal_tracker"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/heart_rate_summary"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/heart_rate_zones"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/hybrid_race_tagger"
+	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/interval_detection"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/intervals"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/location_naming"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/logic_gate"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/mock"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/muscle_heatmap"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/muscle_heatmap_image"
+	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/oura_readiness"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/pace_summary"
+	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/pace_target"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/parkrun"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/personal_records"
+	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/photo_geotag"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/power_summary"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/recovery_advisor"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/route_thumbnail"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/running_dynamics"
+	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/running_power"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/source_link"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/speed_summary"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/spotify_tracks"
+	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/strava_segments"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/streak_tracker"
+	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/timestamp_sanity"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/training_load"
+	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/type_learner"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/type_mapper"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/user_input"
 	_ "github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/virtual_gps"
@@ -78,13 +97,18 @@ var (
 	svc     *bootstrap.Service
 	svcOnce sync.Once
 	svcErr  error
+
+	// circuits outlives each invocation so its cache spares healthy
+	// providers a Firestore read per run.
+	circuits     *CircuitBreaker
+	circuitsOnce sync.Once
 )
 
 func init() {
 	// CloudEvent handler for EventArc triggers (raw-activity topic)
 	functions.CloudEvent("EnrichActivity", EnrichActivity)
 
-	// HTTP handler for push subscriptions (lag topic) - properly returns HTTP 500 on error
+	// HTTP handler for push subscriptions (pipeline-activity topic) - properly returns HTTP 500 on error
 	functions.HTTP("EnrichActivityHTTP", EnrichActivityHTTP)
 }
 
@@ -107,7 +131,7 @@ func EnrichActivity(ctx context.Context, e cloudevents.Event) error {
 	return framework.WrapCloudEvent("enricher", svc, enrichHandler)(ctx, e)
 }
 
-// EnrichActivityHTTP is the HTTP handler for push subscriptions (lag topic).
+// EnrichActivityHTTP is the HTTP handler for push subscriptions (pipeline-activity topic).
 // This handler properly returns HTTP 500 on errors, allowing Pub/Sub to NACK and retry.
 func EnrichActivityHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -194,15 +218,44 @@ func parseCloudEventFromPubSubPush(r *http.Request) (*cloudevents.Event, error)
 	return &event, nil
 }
 
+// newOrchestrator builds an orchestrator over every registered provider. The
+// orchestrator and the providers share a copy of svc that drops writes in dry
+// runs (see dryRunService). Previews pass nil notifications.
+func newOrchestrator(svc *bootstrap.Service, notifications shared.NotificationService) *Orchestrator {
+	bucketName := svc.Config.GCSArtifactBucket
+	if bucketName == "" {
+		bucketName = "fitglue-server-dev-artifacts" // Fallback for local development
+	}
+
+	guarded := dryRunService(svc)
+	orchestrator := NewOrchestrator(guarded.DB, guarded.Store, bucketName, notifications)
+
+	// Register Providers from registry. Initialization (service injection, external
+	// clients) is deferred until a provider is actually used by the resolved pipeline,
+	// and is remembered across warm invocations.
+	for _, provider := range providers.GetAll() {
+		orchestrator.Register(provider)
+	}
+	orchestrator.SetProviderInitializer(func(ctx context.Context, p providers.Provider) error {
+		return providers.Initialize(ctx, p, guarded)
+	})
+	orchestrator.SetArtifactOptions(artifactOptionsFromEnv())
+	circuitsOnce.Do(func() {
+		circuits = NewCircuitBreaker(guarded.DB)
+	})
+	orchestrator.SetCircuitBreaker(circuits)
+	return orchestrator
+}
+
 // enrichHandler contains the business logic
 func enrichHandler(ctx context.Context, e cloudevents.Event, fwCtx *framework.FrameworkContext) (interface{}, error) {
+	if e.Type() == infrapubsub.GetCloudEventType(pbevents.CloudEventType_CLOUD_EVENT_TYPE_ACTIVITY_BATCH) {
+		return enrichBatch(ctx, e, fwCtx)
+	}
+
 	// Extract payload and attributes
 	// We assume strict CloudEvent input (legacy Pub/Sub messages are no longer supported)
 	rawData := e.Data()
-	isLagRetry := false
-	if val, ok := e.Extensions()["origin"].(string); ok && val == "lag-queue" {
-		isLagRetry = true
-	}
 
 	var rawEvent pbevents.ActivityPayload
 	// Use protojson to unmarshal, which supports both camelCase (canonical) and snake_case field names
@@ -217,97 +270,76 @@ func enrichHandler(ctx context.Context, e cloudevents.Event, fwCtx *framework.Fr
 		return nil, fmt.Errorf("missing userId in payload")
 	}
 
-	fwCtx.Logger.Info("Starting enrichment", "timestamp", rawEvent.Timestamp, "source", rawEvent.Source)
-
 	// Extract pipeline_execution_id from payload or use current execution ID
 	pipelineExecID := rawEvent.PipelineExecutionId
 	if pipelineExecID == nil || *pipelineExecID == "" {
 		pipelineExecID = &fwCtx.ExecutionID
 	}
 
-	// Initialize Orchestrator
-	bucketName := fwCtx.Service.Config.GCSArtifactBucket
-	if bucketName == "" {
-		bucketName = "fitglue-server-dev-artifacts" // Fallback for local development
-	}
-
-	orchestrator := NewOrchestrator(fwCtx.Service.DB, fwCtx.Service.Store, bucketName, fwCtx.Service.Notifications)
-
-	// Register Providers from registry
-	for _, provider := range providers.GetAll() {
-		// Set service if the provider supports it
-		if sp, ok := provider.(interface{ SetService(*bootstrap.Service) }); ok {
-			sp.SetService(fwCtx.Service)
-		}
-		orchestrator.Register(provider)
-	}
+	orchestrator := newOrchestrator(fwCtx.Service, fwCtx.Service.Notifications)
+	return enrichPayload(ctx, fwCtx, fwCtx.Logger, orchestrator, &rawEvent, *pipelineExecID, lagExhausted(e, fwCtx.Logger))
+}
 
-	// Calculate lag exhaustion (Force mode / Do Not Retry)
-	doNotRetry := false
+// lagExhausted reports whether the event is old enough that providers should
+// settle for partial data (Force mode / Do Not Retry) rather than ask to retry.
+func lagExhausted(e cloudevents.Event, logger *slog.Logger) bool {
 	// For Pub/Sub events, e.Time() is the publish time.
 	// We want to force if the message is older than our max backoff (20 mins + buffer)
 	// Note: For unwrapped events, e.Time() is the original event time, which is what we want.
 	if !e.Time().IsZero() {
 		lagDuration := time.Since(e.Time())
 		if lagDuration > 15*time.Minute {
-			fwCtx.Logger.Warn("Activity lag exhausted, forcing partial enrichment", "age", lagDuration)
-			doNotRetry = true
+			logger.Warn("Activity lag exhausted, forcing partial enrichment", "age", lagDuration)
+			return true
 		}
 	}
+	return false
+}
+
+// enrichPayload runs one payload through the orchestrator and publishes the
+// enriched events to the router.
+func enrichPayload(ctx context.Context, fwCtx *framework.FrameworkContext, logger *slog.Logger, orchestrator *Orchestrator, rawEvent *pbevents.ActivityPayload, pipelineExecID string, doNotRetry bool) (map[string]interface{}, error) {
+	logger.Info("Starting enrichment", "timestamp", rawEvent.Timestamp, "source", rawEvent.Source)
 
 	// Process
-	processResult, err := orchestrator.Process(ctx, fwCtx.Logger, &rawEvent, fwCtx.ExecutionID, *pipelineExecID, doNotRetry)
+	processResult, err := orchestrator.Process(ctx, logger, rawEvent, fwCtx.ExecutionID, pipelineExecID, doNotRetry)
+	if processResult != nil {
+		for _, exec := range processResult.ProviderExecutions {
+			metrics.RecordEnricherExecution(ctx, exec.ProviderName, exec.Status, time.Duration(exec.DurationMs)*time.Millisecond)
+		}
+	}
+
+	// Report providers whose lazy initialization has failed on this instance
+	initFailures := providers.InitFailures()
+	if len(initFailures) > 0 {
+		logger.Warn("Provider initialization failures", "failures", initFailures)
+	}
 
 	if err != nil {
-		// Check if the error is retryable (e.g. data lag)
-		if ok := isRetryable(err); ok {
-
-			if isLagRetry {
-				fwCtx.Logger.Warn("Lag Retry failed (will retry with backoff)", "error", err)
-				// Return error to trigger Pub/Sub retry with backoff (keep status for execution tracking)
-				fwCtx.Logger.Info("Returning error to trigger retry", "status", "STATUS_LAGGED_RETRY")
-				return map[string]interface{}{
-					"status": "STATUS_LAGGED_RETRY",
-					"error":  err.Error(),
-				}, fmt.Errorf("lagged retry failed (status=STATUS_LAGGED_RETRY): %w", err)
-			} else {
-				// Preserve the original error before it gets shadowed
-				originalErr := err
-				fwCtx.Logger.Info("Activity data lagging, offloading to lag queue", "error", originalErr)
-
-				// Publish to Lag Topic with "origin=lag-queue" to break infinite loop on next consumption
-				// Create CloudEvent
-				lagEvent, err := infrapubsub.NewCloudEvent("/enricher", "com.fitglue.enrichment.lag", rawData)
-				if err != nil {
-					fwCtx.Logger.Error("Failed to create lag event", "error", err)
-					return nil, err
-				}
-				lagEvent.SetExtension("origin", "lag-queue")
-
-				_, pubErr := fwCtx.Service.Pub.PublishCloudEvent(ctx, shared.TopicEnrichmentLag, lagEvent)
-				if pubErr != nil {
-					fwCtx.Logger.Error("Failed to publish to lag topic", "error", pubErr)
-					return nil, pubErr // Fail execution to trigger retry of this offload attempt
-				}
-
-				return map[string]interface{}{
-					"status": "LAGGED_RETRY",
-					"reason": originalErr.Error(),
-				}, nil // ACK original message since we've successfully moved it to the delay queue
-			}
+		// A retryable error (e.g. data lag) has been scheduled on the run; the
+		// pipeline service resumes it once the retry is due (see
+		// pipeline.Service.RetryDueRuns), so ACK this message
+		if isRetryable(err) {
+			logger.Info("Activity data lagging, enricher retry scheduled", "error", err, "status", "STATUS_LAGGED_RETRY")
+			return map[string]interface{}{
+				"status":              "LAGGED_RETRY",
+				"reason":              err.Error(),
+				"provider_executions": processResult.ProviderExecutions,
+			}, nil
 		}
 
-		fwCtx.Logger.Error("Orchestrator failed", "error", err)
+		logger.Error("Orchestrator failed", "error", err)
 		return nil, err
 	}
 
 	if len(processResult.Events) == 0 {
-		fwCtx.Logger.Info("No pipelines matched, skipping enrichment")
+		logger.Info("No pipelines matched, skipping enrichment")
 		return map[string]interface{}{
-			"status":              "SKIPPED",
-			"reason":              "No enriched event created - possibly halted by a provider",
-			"published_events":    []interface{}{},
-			"provider_executions": processResult.ProviderExecutions,
+			"status":                 "SKIPPED",
+			"reason":                 "No enriched event created - possibly halted by a provider",
+			"published_events":       []interface{}{},
+			"provider_executions":    processResult.ProviderExecutions,
+			"provider_init_failures": initFailures,
 		}, nil
 	}
 
@@ -327,7 +359,7 @@ func enrichHandler(ctx context.Context, e cloudevents.Event, fwCtx *framework.Fr
 
 	for _, event := range processResult.Events {
 		// Propagate pipeline execution ID
-		event.PipelineExecutionId = pipelineExecID
+		event.PipelineExecutionId = &pipelineExecID
 
 		// Always offload activity data to GCS for consistent behavior
 		// This ensures all destinations (especially Showcase) have access to the data
@@ -336,10 +368,10 @@ func enrichHandler(ctx context.Context, e cloudevents.Event, fwCtx *framework.Fr
 		if bucketName != "" {
 			preparedEvent, uploadedSize, err := activityPkg.PrepareForPublish(ctx, event, fwCtx.Service.Store, bucketName)
 			if err != nil {
-				fwCtx.Logger.Warn("Failed to offload activity data to GCS, publishing inline", "error", err)
+				logger.Warn("Failed to offload activity data to GCS, publishing inline", "error", err)
 			} else if uploadedSize > 0 {
 				eventToPublish = preparedEvent
-				fwCtx.Logger.Info("Offloaded activity data to GCS",
+				logger.Info("Offloaded activity data to GCS",
 					"uri", preparedEvent.ActivityDataUri,
 					"size_bytes", uploadedSize)
 			}
@@ -347,19 +379,19 @@ func enrichHandler(ctx context.Context, e cloudevents.Event, fwCtx *framework.Fr
 
 		resultEvent, err := infrapubsub.NewCloudEvent("/enricher", "com.fitglue.activity.enriched", eventToPublish)
 		if err != nil {
-			fwCtx.Logger.Error("Failed to create result event", "error", err)
+			logger.Error("Failed to create result event", "error", err)
 			continue
 		}
 
 		// Add as CloudEvent extension for framework to extract
-		resultEvent.SetExtension("pipeline_execution_id", *pipelineExecID)
+		resultEvent.SetExtension("pipeline_execution_id", pipelineExecID)
 
 		msgID, err := fwCtx.Service.Pub.PublishCloudEvent(ctx, shared.TopicEnrichedActivity, resultEvent)
 		if err != nil {
-			fwCtx.Logger.Error("Failed to publish result", "error", err, "pipeline_id", event.PipelineId)
+			logger.Error("Failed to publish result", "error", err, "pipeline_id", event.PipelineId)
 		} else {
 			publishedCount++
-			fwCtx.Logger.Info("Published enriched event",
+			logger.Info("Published enriched event",
 				"activity_id", event.ActivityId,
 				"pipeline_id", event.PipelineId,
 				"destinations", event.Destinations,
@@ -376,7 +408,7 @@ func enrichHandler(ctx context.Context, e cloudevents.Event, fwCtx *framework.Fr
 		}
 	}
 
-	fwCtx.Logger.Info("Enrichment complete", "published_count", publishedCount)
+	logger.Info("Enrichment complete", "published_count", publishedCount)
 
 	finalStatus := "SUCCESS"
 	if processResult.Status == pbpipeline.ExecutionStatus_STATUS_WAITING {
@@ -384,11 +416,12 @@ func enrichHandler(ctx context.Context, e cloudevents.Event, fwCtx *framework.Fr
 	}
 
 	return map[string]interface{}{
-		"status":              finalStatus,
-		"published_count":     publishedCount,
-		"total_events":        len(processResult.Events),
-		"published_events":    publishedEvents,
-		"provider_executions": processResult.ProviderExecutions,
+		"status":                 finalStatus,
+		"published_count":        publishedCount,
+		"total_events":           len(processResult.Events),
+		"published_events":       publishedEvents,
+		"provider_executions":    processResult.ProviderExecutions,
+		"provider_init_failures": initFailures,
 	}, nil
 }
 
@@ -404,3 +437,21 @@ func destinationsToStrings(dests []pbplugin.DestinationType) []string {
 	}
 	return strs
 }
+
+// artifactOptionsFromEnv reads FIT size optimization and artifact compression
+// settings. Every optimization is off unless explicitly enabled.
+func artifactOptionsFromEnv() ArtifactOptions {
+	opts := ArtifactOptions{
+		Fit: fit.FitOptions{
+			SmartRecording:             os.Getenv("FIT_SMART_RECORDING") == "true",
+			CompressedTimestampHeaders: os.Getenv("FIT_COMPRESSED_TIMESTAMP_HEADERS") == "true",
+		},
+		Gzip: os.Getenv("ARTIFACT_GZIP") == "true",
+	}
+	if v := os.Getenv("FIT_MAX_RECORD_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			opts.Fit.MaxRecordInterval = d
+		}
+	}
+	return opts
+}
@@ -15,6 +15,7 @@ import (
 
 	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"testing"
 	"time"
@@ -23,7 +24,9 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	shared "github.com/fitglue/server/src/go/pkg"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
 	"github.com/fitglue/server/src/go/pkg/testing/mocks"
 
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
@@ -152,3 +155,107 @@ func TestEnrichActivity(t *testing.T) {
 		t.Fatalf("EnrichActivity failed: %v", err)
 	}
 }
+
+func TestEnrichActivity_Batch(t *testing.T) {
+	mockDB := &mocks.MockDatabase{
+		SetExecutionFunc: func(ctx context.Context, record *pbpipeline.ExecutionRecord) error {
+			return nil
+		},
+		UpdateExecutionFunc: func(ctx context.Context, userId string, id string, data map[string]interface{}) error {
+			return nil
+		},
+		GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+			if id == "user_broken" {
+				return nil, errors.New("firestore unavailable")
+			}
+			return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+		},
+		GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+			return []*pbpipeline.PipelineConfig{{
+				Id:           "test-pipeline-1",
+				Source:       "SOURCE_HEVY",
+				Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+				Enrichers: []*pbpipeline.EnricherConfig{{
+					ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MOCK,
+					TypedConfig:  map[string]string{},
+				}},
+			}}, nil
+		},
+	}
+	published := map[string][]cloudevents.Event{}
+	mockPub := &mocks.MockPublisher{
+		PublishCloudEventFunc: func(ctx context.Context, topic string, e cloudevents.Event) (string, error) {
+			published[topic] = append(published[topic], e)
+			return "msg-123", nil
+		},
+	}
+
+	providers.ClearRegistry()
+	providers.Register(&MockProvider{
+		NameFunc:         func() string { return "mock-enricher" },
+		ProviderTypeFunc: func() pbplugin.EnricherProviderType { return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MOCK },
+		EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+			return &providers.EnrichmentResult{Description: "Enriched by mock provider"}, nil
+		},
+	})
+	defer providers.ClearRegistry()
+
+	svc = &bootstrap.Service{
+		DB:    mockDB,
+		Pub:   mockPub,
+		Store: &mocks.MockBlobStore{},
+		Config: &bootstrap.Config{
+			ProjectID: "test-project",
+		},
+	}
+
+	newPayload := func(userID, execID string) *pbevents.ActivityPayload {
+		pipelineID := "test-pipeline-1"
+		return &pbevents.ActivityPayload{
+			Source:              pbactivity.ActivitySource_SOURCE_HEVY,
+			UserId:              userID,
+			PipelineId:          &pipelineID,
+			PipelineExecutionId: &execID,
+			IsBackfill:          true,
+			Timestamp:           timestamppb.New(time.Now()),
+			StandardizedActivity: &pbactivity.StandardizedActivity{
+				StartTime: timestamppb.New(time.Now()),
+				Type:      pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING,
+				Sessions:  []*pbactivity.Session{{TotalElapsedTime: 3600}},
+			},
+		}
+	}
+	batches, err := infrapubsub.BatchActivityPayloads([]*pbevents.ActivityPayload{
+		newPayload("user_123", "exec-a"),
+		newPayload("user_broken", "exec-b"),
+		newPayload("user_123", "exec-c"),
+	})
+	if err != nil || len(batches) != 1 {
+		t.Fatalf("BatchActivityPayloads = %d batches, %v", len(batches), err)
+	}
+	e, err := infrapubsub.NewActivityBatchEvent("/backfill", batches[0])
+	if err != nil {
+		t.Fatalf("NewActivityBatchEvent: %v", err)
+	}
+	e.SetID("event-123")
+
+	if err := EnrichActivity(context.Background(), e); err != nil {
+		t.Fatalf("EnrichActivity failed: %v", err)
+	}
+
+	if got := len(published[shared.TopicEnrichedActivity]); got != 2 {
+		t.Errorf("Expected 2 enriched events, got %d", got)
+	}
+	// The failed payload goes back alone, for Pub/Sub to retry
+	republished := published[shared.TopicPipelineActivity]
+	if len(republished) != 1 {
+		t.Fatalf("Expected 1 republished payload, got %d", len(republished))
+	}
+	var got pbevents.ActivityPayload
+	if err := protojson.Unmarshal(republished[0].Data(), &got); err != nil {
+		t.Fatalf("unmarshal republished payload: %v", err)
+	}
+	if got.UserId != "user_broken" || got.GetPipelineExecutionId() != "exec-b" {
+		t.Errorf("Expected the broken payload to be republished, got user=%q exec=%q", got.UserId, got.GetPipelineExecutionId())
+	}
+}
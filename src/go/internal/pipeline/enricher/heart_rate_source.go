@@ -0,0 +1,89 @@
+package enricher
+
+import (
+	"strings"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/domain/streams"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+// heartRateSourceActivity names heart rate that arrived with the source activity.
+const heartRateSourceActivity = "activity"
+
+// selectHeartRateSource decides whether an enricher's heart rate stream
+// replaces the heart rate already on the session, which came from current.
+// The pipeline's heart rate source setting decides between the activity and
+// an enricher; between two enrichers, or when unset, the merge policy ranks
+// their sensors. BEST_QUALITY compares quality scores, falling back to the
+// policy on a tie.
+//
+// When the session already has heart rate, the quality of both streams and
+// the winner are recorded in res.Metadata to show why it won.
+func selectHeartRateSource(session *pbactivity.Session, res *providers.EnrichmentResult, source pbpipeline.HeartRateSource, policy streams.MergePolicy, currentSensor streams.Sensor, current, incoming string) bool {
+	duration := int(session.TotalElapsedTime)
+	if duration <= 0 {
+		duration = len(res.HeartRateStream)
+	}
+	existing := streams.MeasureQuality(sessionHeartRate(session, duration), duration)
+	offered := streams.MeasureQuality(res.HeartRateStream, duration)
+
+	wins := policy.Overrides(streams.HeartRate, currentSensor, res.HeartRateSensor)
+	// An activity without heart rate has nothing to keep, whatever the setting
+	fromActivity := current == heartRateSourceActivity && existing.Coverage > 0
+	switch source {
+	case pbpipeline.HeartRateSource_HEART_RATE_SOURCE_ACTIVITY:
+		if fromActivity {
+			wins = false
+		}
+	case pbpipeline.HeartRateSource_HEART_RATE_SOURCE_ENRICHER:
+		if fromActivity {
+			wins = true
+		}
+	case pbpipeline.HeartRateSource_HEART_RATE_SOURCE_BEST_QUALITY:
+		if existing.Score() != offered.Score() {
+			wins = offered.Score() > existing.Score()
+		}
+	}
+
+	if existing.Coverage == 0 {
+		return wins // Nothing to choose between
+	}
+
+	winner := current
+	if wins {
+		winner = incoming
+	}
+	selection := "sensor_priority"
+	if source != pbpipeline.HeartRateSource_HEART_RATE_SOURCE_UNSPECIFIED {
+		selection = strings.ToLower(strings.TrimPrefix(source.String(), "HEART_RATE_SOURCE_"))
+	}
+	if res.Metadata == nil {
+		res.Metadata = make(map[string]string)
+	}
+	res.Metadata["hr_source"] = winner
+	res.Metadata["hr_source_selection"] = selection
+	res.Metadata["hr_quality_"+current] = existing.String()
+	res.Metadata["hr_quality_"+incoming] = offered.String()
+	return wins
+}
+
+// sessionHeartRate lays the session's recorded heart rate out one sample per
+// second from the session start, zero where there is no reading.
+func sessionHeartRate(session *pbactivity.Session, duration int) []int {
+	samples := make([]int, duration)
+	start := session.StartTime.AsTime()
+	for _, lap := range session.Laps {
+		for _, record := range lap.Records {
+			if record.Timestamp == nil || record.HeartRate <= 0 {
+				continue
+			}
+			offset := int(record.Timestamp.AsTime().Sub(start).Seconds())
+			if offset >= 0 && offset < duration {
+				samples[offset] = int(record.HeartRate)
+			}
+		}
+	}
+	return samples
+}
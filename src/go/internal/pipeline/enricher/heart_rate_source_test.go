@@ -0,0 +1,87 @@
+package enricher
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/domain/streams"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+func TestSelectHeartRateSource(t *testing.T) {
+	start := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	const duration = 60
+
+	// The activity's strap records the first 30 seconds, then drops out
+	newSession := func() *pbactivity.Session {
+		lap := &pbactivity.Lap{}
+		for i := 0; i < 30; i++ {
+			lap.Records = append(lap.Records, &pbactivity.Record{
+				Timestamp: timestamppb.New(start.Add(time.Duration(i) * time.Second)),
+				HeartRate: 140,
+			})
+		}
+		return &pbactivity.Session{StartTime: timestamppb.New(start), TotalElapsedTime: duration, Laps: []*pbactivity.Lap{lap}}
+	}
+	fullStream := func() []int {
+		hr := make([]int, duration)
+		for i := range hr {
+			hr[i] = 135
+		}
+		return hr
+	}
+
+	tests := []struct {
+		name       string
+		source     pbpipeline.HeartRateSource
+		sensor     streams.Sensor
+		wantWins   bool
+		wantReason string
+	}{
+		{"SensorPriorityKeepsChestStrap", pbpipeline.HeartRateSource_HEART_RATE_SOURCE_UNSPECIFIED, streams.SensorOptical, false, "sensor_priority"},
+		{"ActivityKeepsActivity", pbpipeline.HeartRateSource_HEART_RATE_SOURCE_ACTIVITY, streams.SensorChestStrap, false, "activity"},
+		{"EnricherReplacesActivity", pbpipeline.HeartRateSource_HEART_RATE_SOURCE_ENRICHER, streams.SensorOptical, true, "enricher"},
+		{"BestQualityPrefersFullerStream", pbpipeline.HeartRateSource_HEART_RATE_SOURCE_BEST_QUALITY, streams.SensorOptical, true, "best_quality"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &providers.EnrichmentResult{HeartRateStream: fullStream(), HeartRateSensor: tt.sensor}
+			wins := selectHeartRateSource(newSession(), res, tt.source, streams.DefaultMergePolicy(), streams.SensorChestStrap, heartRateSourceActivity, "fitbit")
+
+			if wins != tt.wantWins {
+				t.Errorf("Expected wins=%v, got %v", tt.wantWins, wins)
+			}
+			if got := res.Metadata["hr_source_selection"]; got != tt.wantReason {
+				t.Errorf("Expected selection %q, got %q", tt.wantReason, got)
+			}
+			wantSource := heartRateSourceActivity
+			if tt.wantWins {
+				wantSource = "fitbit"
+			}
+			if got := res.Metadata["hr_source"]; got != wantSource {
+				t.Errorf("Expected hr_source %q, got %q", wantSource, got)
+			}
+			if got := res.Metadata["hr_quality_activity"]; got != "score=50 coverage=50% dropouts=0" {
+				t.Errorf("Unexpected activity quality %q", got)
+			}
+			if got := res.Metadata["hr_quality_fitbit"]; got != "score=100 coverage=100% dropouts=0" {
+				t.Errorf("Unexpected fitbit quality %q", got)
+			}
+		})
+	}
+
+	t.Run("NoExistingHeartRate", func(t *testing.T) {
+		session := &pbactivity.Session{StartTime: timestamppb.New(start), TotalElapsedTime: duration}
+		res := &providers.EnrichmentResult{HeartRateStream: fullStream(), HeartRateSensor: streams.SensorOptical}
+		if !selectHeartRateSource(session, res, pbpipeline.HeartRateSource_HEART_RATE_SOURCE_ACTIVITY, streams.DefaultMergePolicy(), streams.SensorUnknown, heartRateSourceActivity, "fitbit") {
+			t.Error("Expected the enricher to fill an activity without heart rate")
+		}
+		if len(res.Metadata) != 0 {
+			t.Errorf("Expected no selection metadata, got %v", res.Metadata)
+		}
+	})
+}
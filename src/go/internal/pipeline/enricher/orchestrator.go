@@ -4,6 +4,7 @@ package enricher
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sort"
@@ -15,11 +16,18 @@ import (
 
 	shared "github.com/fitglue/server/src/go/pkg"
 
+	activityPkg "github.com/fitglue/server/src/go/pkg/domain/activity"
+	"github.com/fitglue/server/src/go/pkg/domain/cost"
 	fit "github.com/fitglue/server/src/go/pkg/domain/file_generators"
+	"github.com/fitglue/server/src/go/pkg/domain/streams"
 	"github.com/fitglue/server/src/go/pkg/domain/tier"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
 
+	"github.com/fitglue/server/src/go/pkg/destination"
 	"github.com/fitglue/server/src/go/pkg/framework"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/metrics"
 	infrasentry "github.com/fitglue/server/src/go/pkg/infrastructure/sentry"
+	"github.com/fitglue/server/src/go/pkg/loopprevention"
 
 	pendinginput "github.com/fitglue/server/src/go/pkg/pending_input"
 
@@ -37,12 +45,14 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// temporarilyUnavailableEnrichers is a skip-list for enrichers that are awaiting API access.
-// When an enricher is added here, it will be skipped during pipeline execution even if configured.
-// Remove entries from this map once API access is granted and the enricher is ready.
-var temporarilyUnavailableEnrichers = map[pbplugin.EnricherProviderType]bool{
-	// Example: pb.EnricherProviderType_ENRICHER_PROVIDER_POLAR_TRACKS: true,
-}
+const (
+	// defaultProviderTimeout bounds each provider call when neither its
+	// enricher config nor the provider sets a timeout.
+	defaultProviderTimeout = 30 * time.Second
+	// maxProviderTimeout caps configured timeouts so one provider can't claim
+	// the whole function timeout.
+	maxProviderTimeout = 5 * time.Minute
+)
 
 type Orchestrator struct {
 	database        shared.Database
@@ -51,16 +61,53 @@ type Orchestrator struct {
 	providersByName map[string]providers.Provider
 	providersByType map[pbplugin.EnricherProviderType]providers.Provider
 	notifications   shared.NotificationService
+
+	// enrichmentBudget resolves the per-run provider time budget for a user (tier-based by default).
+	enrichmentBudget func(*user.Record) time.Duration
+
+	// providerTimeout is the deadline for each provider call when neither the
+	// enricher config nor the provider sets one.
+	providerTimeout time.Duration
+
+	// providerInit lazily initializes a provider before its first use (nil = no initialization).
+	providerInit func(ctx context.Context, p providers.Provider) error
+
+	// circuits skips providers that keep failing across users (nil = always closed).
+	circuits *CircuitBreaker
+
+	// artifacts controls FIT size optimizations and compression of stored artifacts.
+	artifacts ArtifactOptions
+
+	// mergePolicy decides which sensor wins when enricher streams overlap data
+	// already on the activity (e.g. a chest strap over a wrist sensor).
+	mergePolicy streams.MergePolicy
+}
+
+// ArtifactOptions controls how the orchestrator encodes and stores the
+// artifacts it writes to the bucket (generated FIT files, original payloads).
+type ArtifactOptions struct {
+	Fit fit.FitOptions
+	// Gzip stores artifacts gzip-encoded when the blob store supports it.
+	Gzip bool
+}
+
+// gzipBlobStore is implemented by blob stores that can persist objects
+// gzip-encoded while still serving the original bytes on read.
+type gzipBlobStore interface {
+	WriteGzip(ctx context.Context, bucket, object string, data []byte) (int, error)
 }
 
 func NewOrchestrator(db shared.Database, storage shared.BlobStore, bucketName string, notifications shared.NotificationService) *Orchestrator {
 	return &Orchestrator{
-		database:        db,
-		storage:         storage,
-		bucketName:      bucketName,
-		providersByName: make(map[string]providers.Provider),
-		providersByType: make(map[pbplugin.EnricherProviderType]providers.Provider),
-		notifications:   notifications,
+		database:         db,
+		storage:          storage,
+		bucketName:       bucketName,
+		providersByName:  make(map[string]providers.Provider),
+		providersByType:  make(map[pbplugin.EnricherProviderType]providers.Provider),
+		notifications:    notifications,
+		enrichmentBudget: tier.EnrichmentBudget,
+		providerTimeout:  defaultProviderTimeout,
+		mergePolicy:      streams.DefaultMergePolicy(),
 	}
 }
 
@@ -71,6 +118,41 @@ func (o *Orchestrator) Register(p providers.Provider) {
 	}
 }
 
+// SetProviderInitializer sets the hook used to lazily initialize providers the
+// first time a resolved pipeline uses them.
+func (o *Orchestrator) SetProviderInitializer(init func(ctx context.Context, p providers.Provider) error) {
+	o.providerInit = init
+}
+
+// SetCircuitBreaker sets the breaker shared by every run on this instance.
+func (o *Orchestrator) SetCircuitBreaker(b *CircuitBreaker) {
+	o.circuits = b
+}
+
+// SetArtifactOptions configures FIT size optimizations and artifact compression.
+func (o *Orchestrator) SetArtifactOptions(opts ArtifactOptions) {
+	o.artifacts = opts
+}
+
+// writeArtifact stores an artifact, gzip-encoding it when enabled and supported.
+// It returns the number of bytes actually stored.
+func (o *Orchestrator) writeArtifact(ctx context.Context, objName string, data []byte) (int, error) {
+	if o.artifacts.Gzip {
+		if gz, ok := o.storage.(gzipBlobStore); ok {
+			return gz.WriteGzip(ctx, o.bucketName, objName, data)
+		}
+	}
+	return len(data), o.storage.Write(ctx, o.bucketName, objName, data)
+}
+
+// initProvider runs the lazy initializer for a provider, if one is set.
+func (o *Orchestrator) initProvider(ctx context.Context, p providers.Provider) error {
+	if o.providerInit == nil {
+		return nil
+	}
+	return o.providerInit(ctx, p)
+}
+
 // ProcessResult contains detailed information about the enrichment process
 type ProcessResult struct {
 	Events             []*pbevents.EnrichedActivityEvent
@@ -86,6 +168,9 @@ type ProviderExecution struct {
 	Error        string
 	DurationMs   int64
 	Metadata     map[string]string
+	// ContributedDescription is set when the provider added text to the
+	// activity description, for per-enricher usage stats.
+	ContributedDescription bool
 }
 
 // Process executes the enrichment pipelines for the activity
@@ -108,6 +193,7 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 	allowed, reason := tier.CanSync(userRec)
 	if !allowed {
 		logger.Info("Sync blocked by tier limit", "userId", payload.UserId, "reason", reason)
+		metrics.RecordTierBlocked(ctx, string(tier.GetEffectiveTier(userRec)))
 		// Track prevented sync
 		if err := o.database.IncrementPreventedSyncCount(ctx, payload.UserId); err != nil {
 			logger.Warn("Failed to increment prevented sync count", "error", err, "userId", payload.UserId)
@@ -178,7 +264,7 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 	logger.Info("Processing targeted pipeline", "pipeline_id", pipelineID, "is_resume", payload.IsResume)
 
 	// 2.1 Resolve the targeted pipeline by ID
-	pipeline, err := o.resolvePipeline(ctx, pipelineID, userRec.UserId, logger)
+	pipeline, err := o.resolvePipeline(ctx, pipelineID, payload.PipelineConfigVersion, userRec.UserId, payload.StandardizedActivity.GetStartTime().AsTime(), logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve pipeline: %w", err)
 	}
@@ -204,6 +290,18 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 			"pipeline_id", pipelineID)
 	}
 
+	if override := payload.GetReplayOverride(); override != nil {
+		applyReplayOverride(pipeline, override)
+		logger.Info("Replaying with config override",
+			"replay_of", payload.GetReplayOf(),
+			"enrichers", len(override.Enrichers),
+			"destinations", len(override.Destinations))
+	}
+
+	// Pin the config version so payloads stored for resume and repost replay
+	// against the same config
+	payload.PipelineConfigVersion = pipeline.Version
+
 	var providerExecutions []ProviderExecution
 
 	// 3. Execute the Pipeline (Single Pipeline Mode)
@@ -233,20 +331,48 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 			logger.Warn("Invalid repost destination provided", "repost_destination", payload.RepostDestination)
 		}
 	}
+	if payload.IsSourceUpdate {
+		// The edit came from the source platform, so only the other destinations need it
+		sourceDest := loopprevention.GetCorrespondingDestination(payload.Source)
+		filtered := make([]pbplugin.DestinationType, 0, len(activeDestinations))
+		for _, dest := range activeDestinations {
+			if dest != sourceDest {
+				filtered = append(filtered, dest)
+			}
+		}
+		activeDestinations = filtered
+		logger.Info("Source update, propagating metadata to other destinations", "source", payload.Source.String(), "destinations", len(activeDestinations))
+	}
+	if payload.IsTest {
+		// Onboarding test activities must never reach the user's real accounts
+		activeDestinations = []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_MOCK}
+		logger.Info("Test activity, routing to the sandbox destination only")
+	}
 
 	// Create initial pipeline run document for lifecycle tracking (RUNNING status)
 	// This ensures we track the pipeline execution even if it fails partway through
-	o.createInitialPipelineRun(ctx, logger, payload.UserId, pipelineExecutionID, pipeline.ID, activityId, payload, activeDestinations)
+	o.createInitialPipelineRun(ctx, logger, payload.UserId, pipelineExecutionID, pipeline, activityId, payload, activeDestinations)
+
+	// Meter what providers spend on this pass so it can be attributed to the run
+	meter := &cost.Meter{}
+	ctx = cost.WithMeter(ctx, meter)
+	defer o.recordRunCost(ctx, logger, payload.UserId, pipelineExecutionID, meter, time.Now())
 
 	// Upload original payload to GCS for Magic Actions (retry/repost) BEFORE any mutations
 	// This ensures the stored payload has the clean original description (Rule E22: Reset-on-Repost)
 	originalPayloadUri := ""
-	if o.storage != nil && o.bucketName != "" {
+	if o.storage != nil && o.bucketName != "" && !providers.IsDryRun(ctx) {
 		payloadPath := fmt.Sprintf("payloads/%s/%s.json", payload.UserId, activityId)
-		payloadBytes, err := protojson.Marshal(payload)
+		// Retries and reposts replay the stored payload, and must run in full
+		stored := payload
+		if payload.IsSourceUpdate {
+			stored = proto.Clone(payload).(*pbevents.ActivityPayload)
+			stored.IsSourceUpdate = false
+		}
+		payloadBytes, err := protojson.Marshal(stored)
 		if err != nil {
 			logger.Warn("Failed to marshal original payload for GCS", "error", err)
-		} else if err := o.storage.Write(ctx, o.bucketName, payloadPath, payloadBytes); err != nil {
+		} else if _, err := o.writeArtifact(ctx, payloadPath, payloadBytes); err != nil {
 			logger.Warn("Failed to upload original payload to GCS", "error", err)
 		} else {
 			originalPayloadUri = fmt.Sprintf("gs://%s/%s", o.bucketName, payloadPath)
@@ -263,7 +389,7 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 	}
 
 	// 3a. Execute Enrichers Sequentially
-	configs := pipeline.Enrichers
+	configs := timestampChecksFirst(pipeline.Enrichers)
 	results := make([]*providers.EnrichmentResult, len(configs))
 
 	// Use the activity directly - no cloning needed since we process exactly one pipeline
@@ -288,9 +414,48 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 
 	var deferredEnrichers []deferredEnricher
 
+	// Results other pipelines of the same splitter fan-out already got from
+	// shareable providers (nil when the activity went to one pipeline)
+	shares := newResultShares(o.database, payload, currentActivity, pipeline.ID)
+
 	// Map to track excluded downstream enrichers (type -> excluder name)
 	excludedEnrichers := make(map[pbplugin.EnricherProviderType]string)
 
+	// Sensor that last won each stream channel, starting from the channels
+	// the source activity recorded itself
+	recordedSensors := sourceSensors(currentActivity)
+	// Where the session's heart rate currently comes from
+	heartRateSource := heartRateSourceActivity
+
+	// Cumulative provider wall-clock time; once it exceeds the budget, optional
+	// enrichers are skipped so the run completes before the function times out.
+	budget := o.enrichmentBudget(userRec)
+	var budgetSpent time.Duration
+
+	// Apply activity types learned from the user's own corrections before the
+	// pipeline's enrichers, so a configured type mapper can still override them.
+	// Resumed runs already had their type settled on the first pass, unless
+	// that pass stopped early for an enricher retry.
+	if learner, ok := o.providersByName["type-learner"]; ok && (!isResumeMode || len(payload.GetRetryAttempts()) > 0) {
+		learnerStart := time.Now()
+		var learnerRes *providers.EnrichmentResult
+		err := o.initProvider(ctx, learner)
+		if err == nil {
+			learnerRes, err = learner.Enrich(ctx, logger.With("provider", learner.Name()), currentActivity, userRec, map[string]string{"source": payload.Source.String()}, doNotRetry)
+		}
+		if err != nil {
+			logger.Warn("Type learner failed", "error", err)
+		} else if learnerRes != nil && learnerRes.ActivityType != pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED {
+			currentActivity.Type = learnerRes.ActivityType
+			providerExecutions = append(providerExecutions, ProviderExecution{
+				ProviderName: learner.Name(),
+				Status:       "SUCCESS",
+				DurationMs:   time.Since(learnerStart).Milliseconds(),
+				Metadata:     learnerRes.Metadata,
+			})
+		}
+	}
+
 	// ---- Phase 1: Execute non-deferred enrichers, collect deferred ones ----
 	for i, cfg := range configs {
 		var provider providers.Provider
@@ -319,16 +484,15 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 			continue
 		}
 
-		// Skip temporarily unavailable enrichers
-		if temporarilyUnavailableEnrichers[cfg.ProviderType] {
-			logger.Info("Skipping temporarily unavailable enricher", "type", cfg.ProviderType, "name", provider.Name())
-			providerExecutions = append(providerExecutions, ProviderExecution{
-				ProviderName: provider.Name(),
-				Status:       "SKIPPED",
-				Error:        "temporarily unavailable",
-				Metadata:     map[string]string{"skip_reason": "temporarily_unavailable"},
-			})
-			continue
+		// Skip providers an admin has disabled or whose circuit opened after
+		// repeated failures across users. Essential providers still run, as
+		// with the execution budget.
+		if !isEssential(provider) {
+			if skipped, skip := o.circuits.Skip(ctx, logger, cfg.ProviderType, provider.Name()); skip {
+				logger.Info("Skipping unavailable enricher", "type", cfg.ProviderType, "name", provider.Name(), "skip_reason", skipped.Metadata["skip_reason"])
+				providerExecutions = append(providerExecutions, skipped)
+				continue
+			}
 		}
 
 		// Skip explicitly excluded enrichers by upstream providers
@@ -362,6 +526,17 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 			}
 		}
 
+		// 3a.1b Source Update: Metadata-only pass skips stream and input enrichers
+		if payload.IsSourceUpdate && skipsSourceUpdate(provider) {
+			logger.Debug("Skipping enricher for source update", "name", provider.Name())
+			providerExecutions = append(providerExecutions, ProviderExecution{
+				ProviderName: provider.Name(),
+				Status:       "SKIPPED",
+				Metadata:     map[string]string{"skip_reason": "source_update"},
+			})
+			continue
+		}
+
 		// 3a.2 Deferred Execution: Collect deferrable providers for Phase 2
 		if deferrable, isDeferrable := provider.(providers.DeferrableProvider); isDeferrable && deferrable.ShouldDefer() && !isResumeMode {
 			logger.Info("Deferring enricher to Phase 2", "name", provider.Name(), "index", i)
@@ -373,6 +548,20 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 			continue
 		}
 
+		// 3a.3 Execution Budget: Skip optional enrichers once the run's budget is spent
+		if budgetExhausted(provider, budgetSpent, budget) {
+			logger.Warn("Skipping enricher, execution budget exceeded", "name", provider.Name(), "spent_ms", budgetSpent.Milliseconds(), "budget_ms", budget.Milliseconds())
+			providerExecutions = append(providerExecutions, budgetSkippedExecution(provider.Name(), budgetSpent, budget))
+			continue
+		}
+
+		// 3a.4 Lazy Initialization: Create external clients only for providers this pipeline uses
+		if err := o.initProvider(ctx, provider); err != nil {
+			logger.Warn("Skipping enricher, initialization failed", "name", provider.Name(), "error", err)
+			providerExecutions = append(providerExecutions, initFailedExecution(provider.Name(), err))
+			continue
+		}
+
 		startTime := time.Now()
 		execID := uuid.NewString()
 
@@ -412,57 +601,77 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 		var res *providers.EnrichmentResult
 		var err error
 
-		// Resume Mode: Check if provider supports EnrichResume and we have a pending input to resolve
-		if isResumeMode && payload.ResumePendingInputId != nil && *payload.ResumePendingInputId != "" {
+		// Each call gets its own deadline so a hanging provider (e.g. an
+		// external LLM call) can't use up the whole function timeout
+		timeout := o.callTimeout(cfg, provider, budgetSpent, budget)
+		providerCtx, cancel := context.WithTimeout(ctx, timeout)
+
+		// A provider on its last scheduled retry settles for what it can get
+		providerDoNotRetry := doNotRetry || retriesExhausted(payload, provider.Name())
+
+		sharedRes, sharedFrom := shares.load(ctx, logger, provider, cfg)
+		if sharedRes != nil {
+			// Another pipeline of this fan-out already ran the provider
+			logger.Info("Reusing shared enricher result", "name", provider.Name(), "shared_from_pipeline", sharedFrom)
+			res = sharedRes
+		} else if isResumeMode && payload.ResumePendingInputId != nil && *payload.ResumePendingInputId != "" {
+			// Resume Mode: Check if provider supports EnrichResume and we have a pending input to resolve
 			if resumable, ok := provider.(providers.ResumableProvider); ok {
 				// Fetch the resolved pending input from database
 				pendingInput, fetchErr := o.database.GetPendingInput(ctx, payload.UserId, *payload.ResumePendingInputId)
 				if fetchErr != nil {
 					logger.Warn("Failed to fetch pending input for resume", "error", fetchErr, "pending_input_id", *payload.ResumePendingInputId)
 					// Fall back to regular Enrich
-					res, err = provider.Enrich(ctx, providerLogger, currentActivity, userRec, enricherConfig, doNotRetry)
+					res, err = provider.Enrich(providerCtx, providerLogger, currentActivity, userRec, enricherConfig, providerDoNotRetry)
 				} else if pendingInput == nil || pendingInput.Status != pbpipeline.PendingInput_STATUS_COMPLETED {
 					logger.Warn("Pending input not found or not completed", "pending_input_id", *payload.ResumePendingInputId, "status", pendingInput.GetStatus())
 					// Fall back to regular Enrich
-					res, err = provider.Enrich(ctx, providerLogger, currentActivity, userRec, enricherConfig, doNotRetry)
+					res, err = provider.Enrich(providerCtx, providerLogger, currentActivity, userRec, enricherConfig, providerDoNotRetry)
+				} else if owner := pendingInput.EnricherProviderId; owner != "" && owner != provider.Name() {
+					// The resolved input belongs to another resumable enricher in this pipeline
+					res, err = provider.Enrich(providerCtx, providerLogger, currentActivity, userRec, enricherConfig, providerDoNotRetry)
 				} else {
 					// Call EnrichResume with the resolved pending input
 					logger.Info("Calling EnrichResume with resolved pending input", "provider", provider.Name(), "pending_input_id", *payload.ResumePendingInputId)
-					res, err = resumable.EnrichResume(ctx, currentActivity, userRec, pendingInput)
+					res, err = resumable.EnrichResume(providerCtx, currentActivity, userRec, pendingInput)
 				}
 			} else {
 				// Provider doesn't support resume mode, use regular Enrich
-				res, err = provider.Enrich(ctx, providerLogger, currentActivity, userRec, enricherConfig, doNotRetry)
+				res, err = provider.Enrich(providerCtx, providerLogger, currentActivity, userRec, enricherConfig, providerDoNotRetry)
 			}
 		} else {
 			// Normal mode: call regular Enrich
-			res, err = provider.Enrich(ctx, providerLogger, currentActivity, userRec, enricherConfig, doNotRetry)
+			res, err = provider.Enrich(providerCtx, providerLogger, currentActivity, userRec, enricherConfig, providerDoNotRetry)
 		}
-		duration := time.Since(startTime).Milliseconds()
+		cancel()
+		err = rateLimitRetry(err)
+		elapsed := time.Since(startTime)
+		budgetSpent += elapsed
+		duration := elapsed.Milliseconds()
 		pe.DurationMs = duration
+		if sharedRes == nil {
+			o.circuits.Record(ctx, logger, cfg.ProviderType, provider.Name(), err)
+		}
+
+		if err != nil && providerTimedOut(ctx, providerCtx) {
+			logger.Warn(fmt.Sprintf("Provider timed out: %v", provider.Name()), "name", provider.Name(), "timeout_ms", timeout.Milliseconds(), "execution_id", execID)
+			pe = timedOutExecution(pe, timeout)
+			if !isEssential(provider) {
+				providerExecutions = append(providerExecutions, pe)
+				continue
+			}
+			// An essential provider can't be left out, so the run fails below
+		}
 
 		if err != nil {
 			// Check for expected control flow errors BEFORE logging at ERROR level
 			// to prevent Sentry from capturing them as exceptions.
 			if retryErr, ok := err.(*providers.RetryableError); ok {
 				logger.Info(fmt.Sprintf("Provider requires retry: %v", provider.Name()), "name", provider.Name(), "reason", retryErr.Reason, "retry_after", retryErr.RetryAfter, "duration_ms", duration, "execution_id", execID)
-				pe.Status = "RETRY"
-				pe.Error = retryErr.Reason
-				pe.Metadata = map[string]string{
-					"retry_after":  retryErr.RetryAfter.String(),
-					"retry_reason": retryErr.Reason,
+				if result, retryResultErr := o.handleRetryableError(ctx, logger, payload, pipelineExecutionID, pe, providerExecutions, retryErr); result != nil {
+					return result, retryResultErr
 				}
-				providerExecutions = append(providerExecutions, pe)
-				// Keep RUNNING status - retry is in progress, will be retried automatically
-				o.updatePipelineRunStatus(ctx, logger, payload.UserId, pipelineExecutionID,
-					pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING,
-					fmt.Sprintf("Retry scheduled: %s", retryErr.Reason),
-					providerExecutions)
-				return &ProcessResult{
-					Events:             []*pbevents.EnrichedActivityEvent{},
-					ProviderExecutions: providerExecutions,
-					Status:             pbpipeline.ExecutionStatus_STATUS_LAGGED_RETRY,
-				}, retryErr
+				err = fmt.Errorf("retries exhausted after %d attempts: %s", MaxEnricherRetries, retryErr.Reason)
 			}
 			if waitErr, ok := err.(*user_input.WaitForInputError); ok {
 				logger.Info(fmt.Sprintf("Provider waiting for user input: %v", provider.Name()), "name", provider.Name(), "activity_id", waitErr.ActivityID, "required_fields", waitErr.RequiredFields, "duration_ms", duration, "execution_id", execID)
@@ -482,8 +691,10 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 
 			// This is a genuine error - log at ERROR level for Sentry capture
 			logger.Error(fmt.Sprintf("Provider failed: %v", provider.Name()), "name", provider.Name(), "error", err, "duration_ms", duration, "execution_id", execID)
-			pe.Status = "FAILED"
-			pe.Error = err.Error()
+			if pe.Status != "TIMEOUT" {
+				pe.Status = "FAILED"
+				pe.Error = err.Error()
+			}
 			providerExecutions = append(providerExecutions, pe)
 
 			// Update pipeline run to FAILED status
@@ -572,14 +783,30 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 			continue
 		}
 
+		if sharedRes != nil {
+			res.Metadata["shared_from_pipeline"] = sharedFrom
+		} else {
+			shares.store(ctx, logger, provider, cfg, res)
+		}
+
 		pe.Status = "SUCCESS"
 		pe.Metadata = res.Metadata
+		pe.ContributedDescription = strings.TrimSpace(res.Description) != ""
 		results[i] = res
 		providerExecutions = append(providerExecutions, pe)
 
 		logger.Info(fmt.Sprintf("Provider completed: %v", provider.Name()), "name", provider.Name(), "duration_ms", duration, "execution_id", execID)
 
 		// Apply changes to currentActivity immediately so next provider sees them
+		if res.TimeShift != 0 {
+			activityPkg.ShiftTimestamps(currentActivity, res.TimeShift)
+		}
+		if len(res.TrimRanges) > 0 {
+			activityPkg.TrimRecords(currentActivity, res.TrimRanges)
+		}
+		if len(res.ClearRanges) > 0 {
+			activityPkg.ClearRecords(currentActivity, res.ClearRanges)
+		}
 		if res.Name != "" {
 			currentActivity.Name = res.Name
 		}
@@ -598,6 +825,19 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 		if res.HybridRaceSummary != nil {
 			currentActivity.HybridRaceSummary = res.HybridRaceSummary
 		}
+		if res.TotalWork != nil {
+			currentActivity.Sessions[0].TotalWork = res.TotalWork
+		}
+		if res.TotalCalories != nil {
+			currentActivity.Sessions[0].TotalCalories = res.TotalCalories
+		}
+		if len(res.TimeInHrZone) > 0 {
+			currentActivity.Sessions[0].TimeInHrZone = res.TimeInHrZone
+			currentActivity.Sessions[0].HrZoneHighBoundary = res.HrZoneHighBoundary
+		}
+		if res.DataQualityScore != nil {
+			currentActivity.DataQualityScore = res.DataQualityScore
+		}
 
 		// Apply description to slot (preserves pipeline ordering for deferred enrichers)
 		logger.Debug(fmt.Sprintf("Applying description from provider: %v, length: %v", provider.Name(), len(res.Description)), "name", provider.Name())
@@ -628,7 +868,8 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 
 		// Check if enricher provides any stream data that needs to be applied
 		hasStreamData := len(res.HeartRateStream) > 0 || len(res.PowerStream) > 0 ||
-			len(res.PositionLatStream) > 0 || len(res.PositionLongStream) > 0
+			len(res.PositionLatStream) > 0 || len(res.PositionLongStream) > 0 ||
+			len(res.CadenceStream) > 0 || len(res.AltitudeStream) > 0 || len(res.TemperatureStream) > 0
 
 		// Count total existing records across ALL laps to detect multi-lap activities
 		// (e.g., from FIT file uploads where records are properly distributed)
@@ -668,46 +909,16 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 		// For activities with existing records (like FIT files), apply to those records
 		// For newly expanded activities, apply to the expanded placeholder records
 		if hasStreamData {
-			// Apply stream data to ALL laps' records using timestamp-based matching
-			// This handles both single-lap expanded activities and multi-lap FIT activities
-			activityStart := enricherSession.StartTime.AsTime()
-
-			for _, lap := range enricherSession.Laps {
-				for _, record := range lap.Records {
-					if record.Timestamp == nil {
-						continue
-					}
-					// Calculate the second offset from activity start
-					offsetSec := int(record.Timestamp.AsTime().Sub(activityStart).Seconds())
-					if offsetSec < 0 {
-						continue
-					}
-
-					// Apply HR stream value at this offset
-					if len(res.HeartRateStream) > 0 && offsetSec < len(res.HeartRateStream) {
-						val := res.HeartRateStream[offsetSec]
-						if val > 0 {
-							record.HeartRate = int32(val)
-						}
-					}
-
-					// Apply Power stream value at this offset
-					if len(res.PowerStream) > 0 && offsetSec < len(res.PowerStream) {
-						val := res.PowerStream[offsetSec]
-						if val > 0 {
-							record.Power = int32(val)
-						}
-					}
-
-					// Apply GPS position streams at this offset
-					if len(res.PositionLatStream) > 0 && offsetSec < len(res.PositionLatStream) {
-						record.PositionLat = res.PositionLatStream[offsetSec]
-					}
-					if len(res.PositionLongStream) > 0 && offsetSec < len(res.PositionLongStream) {
-						record.PositionLong = res.PositionLongStream[offsetSec]
-					}
+			alignStreamsToSeconds(res)
+			forced := map[streams.Channel]bool{}
+			if len(res.HeartRateStream) > 0 {
+				wins := selectHeartRateSource(enricherSession, res, pipeline.HeartRateSource, o.mergePolicy, recordedSensors[streams.HeartRate], heartRateSource, provider.Name())
+				forced[streams.HeartRate] = wins
+				if wins {
+					heartRateSource = provider.Name()
 				}
 			}
+			mergeStreams(enricherSession, res, o.mergePolicy, recordedSensors, forced)
 		}
 	}
 
@@ -715,6 +926,7 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 	if len(deferredEnrichers) > 0 {
 		// Build the Phase 1 accumulated description to inject into deferred enricher configs
 		phase1Description := buildDescriptionFromSlots(descriptionSlots)
+		locationSections := o.locationSections(configs, descriptionSlots)
 		logger.Info("Starting Phase 2: deferred enricher execution",
 			"deferred_count", len(deferredEnrichers),
 			"phase1_description_length", len(phase1Description),
@@ -736,6 +948,18 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 				continue
 			}
 
+			if budgetExhausted(provider, budgetSpent, budget) {
+				logger.Warn("Skipping deferred enricher, execution budget exceeded", "name", provider.Name(), "spent_ms", budgetSpent.Milliseconds(), "budget_ms", budget.Milliseconds())
+				providerExecutions = append(providerExecutions, budgetSkippedExecution(provider.Name(), budgetSpent, budget))
+				continue
+			}
+
+			if err := o.initProvider(ctx, provider); err != nil {
+				logger.Warn("Skipping deferred enricher, initialization failed", "name", provider.Name(), "error", err)
+				providerExecutions = append(providerExecutions, initFailedExecution(provider.Name(), err))
+				continue
+			}
+
 			startTime := time.Now()
 			execID := uuid.NewString()
 
@@ -754,35 +978,58 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 			enricherConfig["pipeline_id"] = pipeline.ID
 			enricherConfig["activity_id"] = activityId
 			enricherConfig["enriched_description"] = phase1Description // Phase 2 context injection
+			if locationSections != "" {
+				enricherConfig[providers.InputLocationSections] = locationSections
+			}
 
 			// Execute
 			providerLogger := logger.With("provider", provider.Name(), "phase", "deferred")
-			res, err := provider.Enrich(ctx, providerLogger, currentActivity, userRec, enricherConfig, doNotRetry)
-			duration := time.Since(startTime).Milliseconds()
+			timeout := o.callTimeout(cfg, provider, budgetSpent, budget)
+			providerCtx, cancel := context.WithTimeout(ctx, timeout)
+			var res *providers.EnrichmentResult
+			var err error
+			sharedRes, sharedFrom := shares.load(ctx, logger, provider, cfg)
+			if sharedRes != nil {
+				logger.Info("Reusing shared enricher result", "name", provider.Name(), "shared_from_pipeline", sharedFrom)
+				res = sharedRes
+			} else {
+				res, err = provider.Enrich(providerCtx, providerLogger, currentActivity, userRec, enricherConfig, doNotRetry || retriesExhausted(payload, provider.Name()))
+			}
+			cancel()
+			err = rateLimitRetry(err)
+			elapsed := time.Since(startTime)
+			budgetSpent += elapsed
+			duration := elapsed.Milliseconds()
 			pe.DurationMs = duration
+			if sharedRes == nil {
+				o.circuits.Record(ctx, logger, cfg.ProviderType, provider.Name(), err)
+			}
+
+			if err != nil && providerTimedOut(ctx, providerCtx) {
+				logger.Warn(fmt.Sprintf("Deferred provider timed out: %v", provider.Name()), "name", provider.Name(), "timeout_ms", timeout.Milliseconds())
+				pe = timedOutExecution(pe, timeout)
+				if !isEssential(provider) {
+					providerExecutions = append(providerExecutions, pe)
+					continue
+				}
+			}
 
 			if err != nil {
 				// Check for expected control flow errors
 				if retryErr, ok := err.(*providers.RetryableError); ok {
 					logger.Info(fmt.Sprintf("Deferred provider requires retry: %v", provider.Name()), "name", provider.Name(), "reason", retryErr.Reason)
-					pe.Status = "RETRY"
-					pe.Error = retryErr.Reason
-					providerExecutions = append(providerExecutions, pe)
-					o.updatePipelineRunStatus(ctx, logger, payload.UserId, pipelineExecutionID,
-						pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING,
-						fmt.Sprintf("Retry scheduled: %s", retryErr.Reason),
-						providerExecutions)
-					return &ProcessResult{
-						Events:             []*pbevents.EnrichedActivityEvent{},
-						ProviderExecutions: providerExecutions,
-						Status:             pbpipeline.ExecutionStatus_STATUS_LAGGED_RETRY,
-					}, retryErr
+					if result, retryResultErr := o.handleRetryableError(ctx, logger, payload, pipelineExecutionID, pe, providerExecutions, retryErr); result != nil {
+						return result, retryResultErr
+					}
+					err = fmt.Errorf("retries exhausted after %d attempts: %s", MaxEnricherRetries, retryErr.Reason)
 				}
 
 				// Genuine error
 				logger.Error(fmt.Sprintf("Deferred provider failed: %v", provider.Name()), "name", provider.Name(), "error", err, "duration_ms", duration)
-				pe.Status = "FAILED"
-				pe.Error = err.Error()
+				if pe.Status != "TIMEOUT" {
+					pe.Status = "FAILED"
+					pe.Error = err.Error()
+				}
 				providerExecutions = append(providerExecutions, pe)
 
 				o.updatePipelineRunStatus(ctx, logger, payload.UserId, pipelineExecutionID,
@@ -819,8 +1066,15 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 				continue
 			}
 
+			if sharedRes != nil {
+				res.Metadata["shared_from_pipeline"] = sharedFrom
+			} else {
+				shares.store(ctx, logger, provider, cfg, res)
+			}
+
 			pe.Status = "SUCCESS"
 			pe.Metadata = res.Metadata
+			pe.ContributedDescription = strings.TrimSpace(res.Description) != ""
 			results[i] = res
 			providerExecutions = append(providerExecutions, pe)
 
@@ -860,9 +1114,13 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 
 	brandingApplied := false
 	// Run branding provider last (for non-paying users only)
-	if brandingProvider, ok := o.providersByName["branding"]; ok && tier.ShouldShowBranding(userRec) {
+	if brandingProvider, ok := o.providersByName["branding"]; ok && tier.ShouldShowBranding(userRec) && !pipeline.SkipBranding {
 		brandingLogger := logger.With("provider", "branding")
-		brandingRes, err := brandingProvider.Enrich(ctx, brandingLogger, currentActivity, userRec, map[string]string{}, doNotRetry)
+		var brandingRes *providers.EnrichmentResult
+		err := o.initProvider(ctx, brandingProvider)
+		if err == nil {
+			brandingRes, err = brandingProvider.Enrich(ctx, brandingLogger, currentActivity, userRec, map[string]string{}, doNotRetry)
+		}
 		if err != nil {
 			logger.Warn("Branding provider failed", "error", err)
 		} else if brandingRes != nil && brandingRes.Description != "" {
@@ -876,8 +1134,8 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 		}
 	}
 
-	// Build final description from slots
-	finalDescription := buildDescriptionFromSlots(descriptionSlots)
+	// Build final description from slots, laid out by the pipeline's template if it has one
+	finalDescription := renderDescription(logger, pipeline.DescriptionTemplate, descriptionSlots, configs)
 	currentActivity.Description = finalDescription
 
 	// Build final event structure (no Fan-In needed - currentActivity is already fully enriched)
@@ -902,6 +1160,9 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 		if useUpdateMethod {
 			finalEvent.EnrichmentMetadata["use_update_method"] = "true"
 		}
+		if payload.ConfirmDescriptionOverwrite {
+			finalEvent.EnrichmentMetadata["confirm_description_overwrite"] = "true"
+		}
 	}
 
 	// Same-Source Detection: When source matches a destination, signal uploaders
@@ -960,15 +1221,11 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 	for destId, destCfg := range pipeline.DestinationConfigs {
 		processedDests[destId] = true
 		if destCfg != nil && len(destCfg.Config) > 0 {
-			for k, v := range destCfg.Config {
-				finalEvent.EnrichmentMetadata[destId+"_"+k] = v
-			}
+			destination.AddConfigMetadata(finalEvent.EnrichmentMetadata, destId, destCfg.Config)
 		} else {
 			// Fall back to user plugin default for this destination
 			if def, err := o.database.GetPluginDefault(ctx, payload.UserId, destId); err == nil && def != nil {
-				for k, v := range def.Config {
-					finalEvent.EnrichmentMetadata[destId+"_"+k] = v
-				}
+				destination.AddConfigMetadata(finalEvent.EnrichmentMetadata, destId, def.Config)
 				logger.Info("Using user default for destination config", "destination", destId)
 			}
 		}
@@ -983,24 +1240,22 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 		}
 		// Fall back to user plugin default
 		if def, err := o.database.GetPluginDefault(ctx, payload.UserId, destId); err == nil && def != nil {
-			for k, v := range def.Config {
-				finalEvent.EnrichmentMetadata[destId+"_"+k] = v
-			}
+			destination.AddConfigMetadata(finalEvent.EnrichmentMetadata, destId, def.Config)
 			logger.Info("Using user default for destination config (from Destinations list)", "destination", destId)
 		}
 	}
 
-	// Generate FIT file artifact
-	fitBytes, err := fit.GenerateFitFile(currentActivity)
-	if err != nil {
-		logger.Error("Failed to generate FIT file", "error", err) // Don't fail the whole event, just log
-	} else if len(fitBytes) > 0 {
-		objName := fmt.Sprintf("activities/%s/%s.fit", payload.UserId, finalEvent.ActivityId)
-		if err := o.storage.Write(ctx, o.bucketName, objName, fitBytes); err != nil {
-			logger.Error("Failed to write FIT file artifact", "error", err)
-		} else {
-			finalEvent.FitFileUri = fmt.Sprintf("gs://%s/%s", o.bucketName, objName)
-		}
+	// Race mode destination overrides win over both pipeline config and user defaults
+	for destId, overrides := range pipeline.DestinationOverrides {
+		destination.AddConfigMetadata(finalEvent.EnrichmentMetadata, destId, overrides)
+	}
+	if pipeline.RaceMode {
+		finalEvent.EnrichmentMetadata["race_mode"] = "true"
+	}
+
+	// Generate FIT file artifact (previews stop at the description)
+	if !providers.IsDryRun(ctx) {
+		o.writeFitArtifact(ctx, logger, payload.UserId, currentActivity, finalEvent)
 	}
 
 	// Finalize PipelineRun with enriched data (initial run was created at start)
@@ -1009,14 +1264,16 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 	// Note: Success/partial notifications are now sent by destination.UpdateStatus
 	// when all destinations have reported their final status (SYNCED or PARTIAL).
 
-	// --- Destination-specific enricher exclusions ---
-	// Group destinations by their exclusion sets. Destinations with identical
-	// ExcludedEnrichers lists share a single event; different sets get separate events
-	// with filtered descriptions and appliedEnrichments.
+	// --- Destination-specific enricher exclusions and templates ---
+	// Group destinations by their exclusion sets, then by description and title
+	// template. Destinations with identical ExcludedEnrichers lists and templates
+	// share a single event; each other combination gets a separate event with
+	// its own title, description and appliedEnrichments.
 	groups := groupDestinationsByExclusions(activeDestinations, pipeline.DestinationConfigs)
+	descriptionGroups := groupDestinationsByTemplate(groups, pipeline)
 
-	if len(groups) <= 1 {
-		// No exclusion diversity — all destinations get the same event (common case)
+	if len(descriptionGroups) == 0 || (len(groups) <= 1 && len(descriptionGroups) == 1 && descriptionGroups[0].isDefault(pipeline)) {
+		// No exclusion or template diversity — all destinations get the same event (common case)
 		return &ProcessResult{
 			Events:             []*pbevents.EnrichedActivityEvent{finalEvent},
 			ProviderExecutions: providerExecutions,
@@ -1024,11 +1281,12 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 		}, nil
 	}
 
-	// Multiple exclusion groups — emit one event per group
+	// Multiple groups — emit one event per group
 	var events []*pbevents.EnrichedActivityEvent
-	for exclusionKey, dests := range groups {
-		if exclusionKey == "" {
-			// Default group (no exclusions) — use the full event with narrowed destinations
+	for _, group := range descriptionGroups {
+		exclusionKey, dests := group.exclusionKey, group.destinations
+		if group.isDefault(pipeline) {
+			// Default group (no exclusions or overrides) — use the full event with narrowed destinations
 			evt := cloneEnrichedEvent(finalEvent)
 			evt.Destinations = dests
 			events = append(events, evt)
@@ -1037,8 +1295,10 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 
 		// Build excluded set from the comma-separated key
 		excludedSet := make(map[string]bool)
-		for _, e := range strings.Split(exclusionKey, ",") {
-			excludedSet[e] = true
+		if exclusionKey != "" {
+			for _, e := range strings.Split(exclusionKey, ",") {
+				excludedSet[e] = true
+			}
 		}
 
 		// Build filtered description by zeroing excluded slots
@@ -1049,7 +1309,7 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 				filteredSlots[i+1] = "" // Zero the excluded enricher's slot
 			}
 		}
-		filteredDesc := buildDescriptionFromSlots(filteredSlots)
+		filteredDesc := renderDescription(logger, group.template, filteredSlots, configs)
 
 		// Filter appliedEnrichments
 		var filteredApplied []string
@@ -1063,10 +1323,15 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 		evt.Description = filteredDesc
 		evt.AppliedEnrichments = filteredApplied
 		evt.Destinations = dests
+		if group.titleTemplate != "" {
+			evt.Name = renderTitle(logger, group.titleTemplate, currentActivity)
+		}
 		events = append(events, evt)
 
 		logger.Info("Emitting filtered event for destination group",
 			"excluded", exclusionKey,
+			"custom_template", group.template != "",
+			"custom_title", group.titleTemplate != "",
 			"destinations", len(dests),
 			"appliedEnrichments", len(filteredApplied))
 	}
@@ -1081,6 +1346,29 @@ func (o *Orchestrator) Process(ctx context.Context, logger *slog.Logger, payload
 // buildDescriptionFromSlots joins non-empty description slots with double newlines.
 // This preserves pipeline ordering: each enricher's description appears at its
 // configured position regardless of execution order (Phase 1 vs Phase 2).
+// locationSections returns the description sections written by providers that
+// reveal where the activity took place, as the JSON array AI providers redact
+// when the user doesn't share locations. It is "" when there are none.
+func (o *Orchestrator) locationSections(configs []configuredEnricher, slots []string) string {
+	var sections []string
+	for i, cfg := range configs {
+		if slots[i+1] == "" {
+			continue
+		}
+		if revealer, ok := o.providersByType[cfg.ProviderType].(providers.LocationRevealingProvider); ok && revealer.RevealsLocation() {
+			sections = append(sections, slots[i+1])
+		}
+	}
+	if len(sections) == 0 {
+		return ""
+	}
+	encoded, err := json.Marshal(sections)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
+
 func buildDescriptionFromSlots(slots []string) string {
 	var parts []string
 	for _, s := range slots {
@@ -1111,6 +1399,47 @@ func groupDestinationsByExclusions(destinations []pbplugin.DestinationType, dest
 	return groups
 }
 
+// destinationGroup is a set of destinations that share one enriched event.
+type destinationGroup struct {
+	exclusionKey  string
+	template      string // Description template
+	titleTemplate string
+	destinations  []pbplugin.DestinationType
+}
+
+// isDefault reports whether the group gets the pipeline's event unchanged.
+func (g destinationGroup) isDefault(pipeline *configuredPipeline) bool {
+	return g.exclusionKey == "" && g.template == pipeline.DescriptionTemplate && g.titleTemplate == ""
+}
+
+// groupDestinationsByTemplate splits each exclusion group by the description
+// and title templates its destinations render with. Groups are ordered by
+// exclusion key, then by first appearance of the templates.
+func groupDestinationsByTemplate(exclusionGroups map[string][]pbplugin.DestinationType, pipeline *configuredPipeline) []destinationGroup {
+	keys := make([]string, 0, len(exclusionGroups))
+	for key := range exclusionGroups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var groups []destinationGroup
+	for _, key := range keys {
+		byTemplates := map[[2]string]int{} // description and title template -> index in groups
+		for _, dest := range exclusionGroups[key] {
+			tmpl := destinationDescriptionTemplate(dest, pipeline)
+			titleTmpl := destinationTitleTemplate(dest, pipeline)
+			idx, ok := byTemplates[[2]string{tmpl, titleTmpl}]
+			if !ok {
+				idx = len(groups)
+				byTemplates[[2]string{tmpl, titleTmpl}] = idx
+				groups = append(groups, destinationGroup{exclusionKey: key, template: tmpl, titleTemplate: titleTmpl})
+			}
+			groups[idx].destinations = append(groups[idx].destinations, dest)
+		}
+	}
+	return groups
+}
+
 // cloneEnrichedEvent creates a deep copy of an EnrichedActivityEvent using proto.Clone.
 // ActivityData is shared (not deep-cloned) since only description text is filtered.
 func cloneEnrichedEvent(src *pbevents.EnrichedActivityEvent) *pbevents.EnrichedActivityEvent {
@@ -1119,21 +1448,78 @@ func cloneEnrichedEvent(src *pbevents.EnrichedActivityEvent) *pbevents.EnrichedA
 
 type configuredPipeline struct {
 	ID                 string
+	Version            int32
 	Source             string
 	Enrichers          []configuredEnricher
 	Destinations       []pbplugin.DestinationType
 	SourceConfig       map[string]string
 	DestinationConfigs map[string]*pbpipeline.DestinationConfig
+
+	// Set when the activity falls inside the pipeline's race mode window
+	RaceMode             bool
+	SkipBranding         bool
+	DestinationOverrides map[string]map[string]string // destination ID -> config keys forced by race mode
+
+	// Lays out the enriched description; empty joins the sections in pipeline order
+	DescriptionTemplate string
+	// Which heart rate wins when the activity and an enricher both have it
+	HeartRateSource pbpipeline.HeartRateSource
 }
 
 type configuredEnricher struct {
 	ProviderType pbplugin.EnricherProviderType
 	TypedConfig  map[string]string
+	// Deadline for each provider call; zero uses the orchestrator default
+	Timeout time.Duration
 }
 
-// resolvePipeline looks up a single pipeline by ID from the user's pipelines collection.
-// Returns nil if the pipeline is not found or is disabled.
-func (o *Orchestrator) resolvePipeline(ctx context.Context, pipelineID string, userID string, logger *slog.Logger) (*configuredPipeline, error) {
+// timestampChecksFirst moves timestamp sanity checks to the front of the
+// chain so a misdated activity is corrected (or held for the user) before any
+// other enricher reads its times. The remaining order is kept.
+func timestampChecksFirst(configs []configuredEnricher) []configuredEnricher {
+	ordered := make([]configuredEnricher, 0, len(configs))
+	for _, cfg := range configs {
+		if cfg.ProviderType == pbplugin.EnricherProviderType_ENRICHER_PROVIDER_TIMESTAMP_SANITY {
+			ordered = append(ordered, cfg)
+		}
+	}
+	for _, cfg := range configs {
+		if cfg.ProviderType != pbplugin.EnricherProviderType_ENRICHER_PROVIDER_TIMESTAMP_SANITY {
+			ordered = append(ordered, cfg)
+		}
+	}
+	return ordered
+}
+
+// applyReplayOverride swaps in a replay's enrichers and destinations. Lists
+// the override leaves empty keep the resolved config. Enrichers the pipeline
+// already had keep their configured timeout.
+func applyReplayOverride(pipeline *configuredPipeline, override *pbevents.ReplayOverride) {
+	if len(override.Enrichers) > 0 {
+		timeouts := make(map[pbplugin.EnricherProviderType]time.Duration, len(pipeline.Enrichers))
+		for _, e := range pipeline.Enrichers {
+			timeouts[e.ProviderType] = e.Timeout
+		}
+		enrichers := make([]configuredEnricher, 0, len(override.Enrichers))
+		for _, e := range override.Enrichers {
+			enrichers = append(enrichers, configuredEnricher{
+				ProviderType: e.ProviderType,
+				TypedConfig:  e.TypedConfig,
+				Timeout:      timeouts[e.ProviderType],
+			})
+		}
+		pipeline.Enrichers = enrichers
+	}
+	if len(override.Destinations) > 0 {
+		pipeline.Destinations = override.Destinations
+	}
+}
+
+// resolvePipeline looks up a single pipeline by ID from the user's pipelines collection,
+// switching to its race mode setup when activityStart falls inside the race mode window.
+// A non-zero version (set on reposts) replays that version's config snapshot instead of
+// the current one. Returns nil if the pipeline is not found or is disabled.
+func (o *Orchestrator) resolvePipeline(ctx context.Context, pipelineID string, version int32, userID string, activityStart time.Time, logger *slog.Logger) (*configuredPipeline, error) {
 	userPipelines, err := o.database.GetUserPipelines(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user pipelines: %w", err)
@@ -1146,21 +1532,51 @@ func (o *Orchestrator) resolvePipeline(ctx context.Context, pipelineID string, u
 				return nil, nil
 			}
 
+			if version > 0 && version != p.Version {
+				snapshot, err := o.database.GetPipelineConfigVersion(ctx, userID, p.Id, version)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get pipeline config version %d: %w", version, err)
+				}
+				if snapshot.GetConfig() == nil {
+					logger.Warn("Pipeline config version not found, using current config",
+						"pipeline_id", p.Id, "version", version, "current_version", p.Version)
+				} else {
+					logger.Info("Replaying pipeline config version",
+						"pipeline_id", p.Id, "version", version, "current_version", p.Version)
+					p = snapshot.Config
+					p.Id = pipelineID
+					p.Version = version
+				}
+			}
+
 			var enrichers []configuredEnricher
 			for _, e := range p.Enrichers {
 				enrichers = append(enrichers, configuredEnricher{
 					ProviderType: e.ProviderType,
 					TypedConfig:  e.TypedConfig,
+					Timeout:      time.Duration(e.TimeoutSeconds) * time.Second,
 				})
 			}
-			return &configuredPipeline{
+			resolved := &configuredPipeline{
 				ID:                 p.Id,
+				Version:            p.Version,
 				Source:             p.Source,
 				Enrichers:          enrichers,
 				Destinations:       p.Destinations,
 				SourceConfig:       p.SourceConfig,
 				DestinationConfigs: p.DestinationConfigs,
-			}, nil
+
+				DescriptionTemplate: p.GetDescriptionTemplate(),
+				HeartRateSource:     p.HeartRateSource,
+			}
+			if raceModeActive(p.RaceMode, activityStart) {
+				applyRaceMode(resolved, p.RaceMode)
+				logger.Info("Race mode active for pipeline",
+					"pipeline_id", p.Id,
+					"enrichers", len(resolved.Enrichers),
+					"skip_branding", resolved.SkipBranding)
+			}
+			return resolved, nil
 		}
 	}
 
@@ -1173,7 +1589,8 @@ func (o *Orchestrator) handleWaitError(ctx context.Context, logger *slog.Logger,
 	// SAFETY CHECK: Verify that we're not overwriting a completed pending input
 	// This can happen when resume mode falls back to regular Enrich due to status mismatch
 	existingInput, fetchErr := o.database.GetPendingInput(ctx, payload.UserId, waitErr.ActivityID)
-	if fetchErr == nil && existingInput != nil && existingInput.Status == pbpipeline.PendingInput_STATUS_COMPLETED {
+	// A provider that rejected the user's answer reopens the input on purpose.
+	if fetchErr == nil && existingInput != nil && existingInput.Status == pbpipeline.PendingInput_STATUS_COMPLETED && !waitErr.Reopen {
 		logger.Warn("Pending input already exists and is completed - skipping creation to prevent overwrite",
 			"activity_id", waitErr.ActivityID,
 			"existing_status", existingInput.Status.String())
@@ -1193,7 +1610,7 @@ func (o *Orchestrator) handleWaitError(ctx context.Context, logger *slog.Logger,
 		payloadBytes, err := protojson.Marshal(payload)
 		if err != nil {
 			logger.Warn("Failed to marshal payload for GCS", "error", err)
-		} else if err := o.storage.Write(ctx, o.bucketName, payloadPath, payloadBytes); err != nil {
+		} else if _, err := o.writeArtifact(ctx, payloadPath, payloadBytes); err != nil {
 			logger.Warn("Failed to upload payload to GCS", "error", err)
 		} else {
 			payloadUri = fmt.Sprintf("gs://%s/%s", o.bucketName, payloadPath)
@@ -1250,7 +1667,7 @@ func (o *Orchestrator) handleWaitError(ctx context.Context, logger *slog.Logger,
 
 // createInitialPipelineRun creates a minimal PipelineRun document with RUNNING status
 // Called early in the pipeline execution to ensure lifecycle tracking even if pipeline fails
-func (o *Orchestrator) createInitialPipelineRun(ctx context.Context, logger *slog.Logger, userId string, pipelineExecutionID string, pipelineID string, activityId string, payload *pbevents.ActivityPayload, destinations []pbplugin.DestinationType) {
+func (o *Orchestrator) createInitialPipelineRun(ctx context.Context, logger *slog.Logger, userId string, pipelineExecutionID string, pipeline *configuredPipeline, activityId string, payload *pbevents.ActivityPayload, destinations []pbplugin.DestinationType) {
 	activity := payload.GetStandardizedActivity()
 
 	// Build destination outcomes (all pending at this point)
@@ -1263,19 +1680,26 @@ func (o *Orchestrator) createInitialPipelineRun(ctx context.Context, logger *slo
 	}
 
 	pipelineRun := &pbpipeline.PipelineRun{
-		Id:               pipelineExecutionID,
-		PipelineId:       pipelineID,
-		ActivityId:       activityId,
-		Source:           payload.Source.String(),
-		SourceActivityId: activity.GetExternalId(),
-		Title:            activity.GetName(),
-		Description:      activity.GetDescription(),
-		Type:             activity.GetType(),
-		StartTime:        activity.GetSessions()[0].GetStartTime(),
-		Status:           pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING,
-		CreatedAt:        timestamppb.Now(),
-		UpdatedAt:        timestamppb.Now(),
-		Destinations:     destOutcomes,
+		Id:                    pipelineExecutionID,
+		PipelineId:            pipeline.ID,
+		ActivityId:            activityId,
+		Source:                payload.Source.String(),
+		SourceActivityId:      activity.GetExternalId(),
+		Title:                 activity.GetName(),
+		Description:           activity.GetDescription(),
+		Type:                  activity.GetType(),
+		StartTime:             activity.GetSessions()[0].GetStartTime(),
+		DistanceMeters:        activity.GetSessions()[0].GetTotalDistance(),
+		DurationSeconds:       activity.GetSessions()[0].GetTotalElapsedTime(),
+		Status:                pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING,
+		CreatedAt:             timestamppb.Now(),
+		UpdatedAt:             timestamppb.Now(),
+		Destinations:          destOutcomes,
+		PipelineConfigVersion: pipeline.Version,
+		IsTest:                payload.IsTest,
+		RetryAttempts:         payload.GetRetryAttempts(),
+		ReplayOf:              payload.ReplayOf,
+		ReplayOverride:        payload.GetReplayOverride(),
 	}
 
 	if err := o.database.CreatePipelineRun(ctx, userId, pipelineRun); err != nil {
@@ -1293,6 +1717,42 @@ func (o *Orchestrator) createInitialPipelineRun(ctx context.Context, logger *slo
 	}
 }
 
+// writeFitArtifact generates the activity's FIT file, stores it and points the
+// event at it. Failures are logged and never fail the event.
+func (o *Orchestrator) writeFitArtifact(ctx context.Context, logger *slog.Logger, userId string, activity *pbactivity.StandardizedActivity, event *pbevents.EnrichedActivityEvent) {
+	fitBytes, fitStats, err := fit.GenerateFitFileWithOptions(activity, o.artifacts.Fit)
+	if err != nil {
+		logger.Error("Failed to generate FIT file", "error", err)
+		return
+	}
+	if len(fitBytes) == 0 {
+		return
+	}
+	objName := fmt.Sprintf("activities/%s/%s.fit", userId, event.ActivityId)
+	storedBytes, err := o.writeArtifact(ctx, objName, fitBytes)
+	if err != nil {
+		logger.Error("Failed to write FIT file artifact", "error", err)
+		return
+	}
+	event.FitFileUri = fmt.Sprintf("gs://%s/%s", o.bucketName, objName)
+	event.EnrichmentMetadata["fit_file_records_input"] = fmt.Sprintf("%d", fitStats.InputRecords)
+	event.EnrichmentMetadata["fit_file_records_written"] = fmt.Sprintf("%d", fitStats.WrittenRecords)
+	event.EnrichmentMetadata["fit_file_bytes"] = fmt.Sprintf("%d", fitStats.Bytes)
+	event.EnrichmentMetadata["fit_file_stored_bytes"] = fmt.Sprintf("%d", storedBytes)
+}
+
+// recordRunCost prices the usage metered since start and adds it to the run.
+// Cost is internal bookkeeping, so a failed write is logged and never fails
+// the pipeline.
+func (o *Orchestrator) recordRunCost(ctx context.Context, logger *slog.Logger, userId string, pipelineRunId string, meter *cost.Meter, start time.Time) {
+	runCost := cost.Estimate(meter.Usage(), time.Since(start).Seconds()*cost.MemoryGB)
+	if err := o.database.AddPipelineRunCost(ctx, userId, pipelineRunId, runCost); err != nil {
+		logger.Warn("Failed to record pipeline run cost", "error", err, "pipeline_run_id", pipelineRunId)
+		return
+	}
+	logger.Debug("Recorded pipeline run cost", "pipeline_run_id", pipelineRunId, "estimated_usd", runCost.EstimatedUsd)
+}
+
 // updatePipelineRunStatus updates the pipeline run with a new status and optional message
 func (o *Orchestrator) updatePipelineRunStatus(ctx context.Context, logger *slog.Logger, userId string, pipelineRunId string, status pbpipeline.PipelineRunStatus, statusMessage string, providerExecs []ProviderExecution) {
 	// Convert ProviderExecutions to snake_case maps for Firestore
@@ -1335,6 +1795,7 @@ func (o *Orchestrator) finalizePipelineRun(ctx context.Context, logger *slog.Log
 		"updated_at":           time.Now(),
 		"status":               int32(pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING),
 		"status_message":       nil, // Clear pending input message on successful resume
+		"next_retry_at":        nil, // Any scheduled enricher retry has now run
 		"boosters":             boosters,
 		"original_payload_uri": originalPayloadUri,
 	}
@@ -1360,11 +1821,94 @@ func boostersToFirestoreMaps(providerExecs []ProviderExecution) []map[string]int
 		if pe.Error != "" {
 			booster["error"] = pe.Error
 		}
+		if pe.ContributedDescription {
+			booster["contributed_description"] = true
+		}
 		boosters = append(boosters, booster)
 	}
 	return boosters
 }
 
+// budgetExhausted reports whether the provider should be skipped because the run
+// has already spent its execution budget. Essential providers are never skipped.
+func budgetExhausted(provider providers.Provider, spent, budget time.Duration) bool {
+	if budget <= 0 || spent < budget {
+		return false
+	}
+	return !isEssential(provider)
+}
+
+func isEssential(provider providers.Provider) bool {
+	essential, ok := provider.(providers.EssentialProvider)
+	return ok && essential.IsEssential()
+}
+
+func skipsSourceUpdate(provider providers.Provider) bool {
+	skipper, ok := provider.(providers.SourceUpdateSkipper)
+	return ok && skipper.SkipOnSourceUpdate()
+}
+
+// callTimeout is the deadline for one provider call in a run: timeoutFor, cut
+// to what is left of the run's budget for optional providers so one started
+// just before the budget runs out can't overrun it.
+func (o *Orchestrator) callTimeout(cfg configuredEnricher, provider providers.Provider, spent, budget time.Duration) time.Duration {
+	timeout := o.timeoutFor(cfg, provider)
+	if budget <= 0 || isEssential(provider) {
+		return timeout
+	}
+	return max(min(timeout, budget-spent), 0)
+}
+
+// timeoutFor is the deadline for one call to the enricher's provider: its
+// configured timeout, else the provider's own default, else the orchestrator
+// default. Configured and provider defaults are capped at maxProviderTimeout.
+func (o *Orchestrator) timeoutFor(cfg configuredEnricher, provider providers.Provider) time.Duration {
+	if cfg.Timeout > 0 {
+		return min(cfg.Timeout, maxProviderTimeout)
+	}
+	if p, ok := provider.(providers.TimeoutProvider); ok && p.DefaultTimeout() > 0 {
+		return min(p.DefaultTimeout(), maxProviderTimeout)
+	}
+	return o.providerTimeout
+}
+
+// providerTimedOut reports whether a provider call ended because its own
+// deadline passed, rather than the whole run being cancelled.
+func providerTimedOut(parent, call context.Context) bool {
+	return errors.Is(call.Err(), context.DeadlineExceeded) && parent.Err() == nil
+}
+
+// timedOutExecution records a provider whose call ran past its deadline.
+func timedOutExecution(pe ProviderExecution, timeout time.Duration) ProviderExecution {
+	pe.Status = "TIMEOUT"
+	pe.Error = fmt.Sprintf("timed out after %s", timeout)
+	pe.Metadata = map[string]string{"timeout_ms": fmt.Sprintf("%d", timeout.Milliseconds())}
+	return pe
+}
+
+// budgetSkippedExecution records a provider that was skipped for budget.
+func budgetSkippedExecution(providerName string, spent, budget time.Duration) ProviderExecution {
+	return ProviderExecution{
+		ProviderName: providerName,
+		Status:       "SKIPPED_BUDGET",
+		Metadata: map[string]string{
+			"skip_reason": "execution_budget_exceeded",
+			"spent_ms":    fmt.Sprintf("%d", spent.Milliseconds()),
+			"budget_ms":   fmt.Sprintf("%d", budget.Milliseconds()),
+		},
+	}
+}
+
+// initFailedExecution records a provider that was skipped because it could not be initialized.
+func initFailedExecution(providerName string, err error) ProviderExecution {
+	return ProviderExecution{
+		ProviderName: providerName,
+		Status:       "SKIPPED",
+		Error:        fmt.Sprintf("initialization failed: %v", err),
+		Metadata:     map[string]string{"skip_reason": "init_failed"},
+	}
+}
+
 // buildPendingInputStatusMessage creates a user-friendly status message for pending input.
 // It uses the display.summary from the provider metadata if available, falling back
 // to display.field_labels for humanized field names, and finally to Title-Cased field names.
@@ -1408,3 +1952,145 @@ func humanizeFieldName(s string) string {
 	}
 	return strings.Join(parts, " ")
 }
+
+// alignStreamsToSeconds resamples a result's raw streams to 1Hz so they can be
+// indexed by second offset. Physiological and environmental channels are
+// interpolated linearly; position uses the nearest sample so points stay on the recorded route.
+func alignStreamsToSeconds(res *providers.EnrichmentResult) {
+	if res.StreamInterval <= 0 || res.StreamInterval == time.Second {
+		return
+	}
+	res.HeartRateStream = streams.ResampleInts(res.HeartRateStream, res.StreamInterval, time.Second, streams.Linear)
+	res.PowerStream = streams.ResampleInts(res.PowerStream, res.StreamInterval, time.Second, streams.Linear)
+	res.PositionLatStream = streams.ResampleFloats(res.PositionLatStream, res.StreamInterval, time.Second, streams.Nearest)
+	res.PositionLongStream = streams.ResampleFloats(res.PositionLongStream, res.StreamInterval, time.Second, streams.Nearest)
+	res.CadenceStream = streams.ResampleInts(res.CadenceStream, res.StreamInterval, time.Second, streams.Linear)
+	res.AltitudeStream = streams.ResampleFloats(res.AltitudeStream, res.StreamInterval, time.Second, streams.Linear)
+	res.TemperatureStream = streams.ResampleInts(res.TemperatureStream, res.StreamInterval, time.Second, streams.Linear)
+	res.StreamInterval = time.Second
+}
+
+// sourceSensors marks each channel the activity already has samples on as
+// recorded by the source device, so the merge policy weighs enricher streams
+// against it rather than against undeclared data.
+func sourceSensors(activity *pbactivity.StandardizedActivity) map[streams.Channel]streams.Sensor {
+	recorded := make(map[streams.Channel]streams.Sensor)
+	for _, session := range activity.GetSessions() {
+		for _, lap := range session.Laps {
+			for _, record := range lap.Records {
+				if record.HeartRate > 0 {
+					recorded[streams.HeartRate] = streams.SensorSource
+				}
+				if record.Power > 0 {
+					recorded[streams.Power] = streams.SensorSource
+				}
+				if record.PositionLat != 0 || record.PositionLong != 0 {
+					recorded[streams.Position] = streams.SensorSource
+				}
+				if record.Cadence > 0 {
+					recorded[streams.Cadence] = streams.SensorSource
+				}
+				if record.Altitude != 0 {
+					recorded[streams.Altitude] = streams.SensorSource
+				}
+				if record.Temperature != nil {
+					recorded[streams.Temperature] = streams.SensorSource
+				}
+			}
+		}
+	}
+	return recorded
+}
+
+// mergeStreams applies a result's 1Hz streams to every record in the session by
+// second offset from the session start. Each channel only replaces existing
+// values when the policy ranks the result's sensor at least as high as the one
+// that last wrote the channel; otherwise it fills records missing a value.
+// recorded tracks the winning sensor per channel across enrichers, and the
+// session is flagged when its power is estimated. forced settles a channel
+// regardless of the policy (e.g. the pipeline's heart rate source).
+func mergeStreams(session *pbactivity.Session, res *providers.EnrichmentResult, policy streams.MergePolicy, recorded map[streams.Channel]streams.Sensor, forced map[streams.Channel]bool) {
+	hrWins := policy.Overrides(streams.HeartRate, recorded[streams.HeartRate], res.HeartRateSensor)
+	if wins, ok := forced[streams.HeartRate]; ok {
+		hrWins = wins
+	}
+	powerWins := policy.Overrides(streams.Power, recorded[streams.Power], res.PowerSensor)
+	positionWins := policy.Overrides(streams.Position, recorded[streams.Position], res.PositionSensor)
+	cadenceWins := policy.Overrides(streams.Cadence, recorded[streams.Cadence], res.CadenceSensor)
+	altitudeWins := policy.Overrides(streams.Altitude, recorded[streams.Altitude], res.AltitudeSensor)
+	temperatureWins := policy.Overrides(streams.Temperature, recorded[streams.Temperature], res.TemperatureSensor)
+	hasPosition := len(res.PositionLatStream) > 0 || len(res.PositionLongStream) > 0
+	powerApplied := false
+
+	activityStart := session.StartTime.AsTime()
+	for _, lap := range session.Laps {
+		for _, record := range lap.Records {
+			if record.Timestamp == nil {
+				continue
+			}
+			offsetSec := int(record.Timestamp.AsTime().Sub(activityStart).Seconds())
+			if offsetSec < 0 {
+				continue
+			}
+
+			if offsetSec < len(res.HeartRateStream) {
+				if val := res.HeartRateStream[offsetSec]; val > 0 && (hrWins || record.HeartRate == 0) {
+					record.HeartRate = int32(val)
+				}
+			}
+			if offsetSec < len(res.PowerStream) {
+				if val := res.PowerStream[offsetSec]; val > 0 && (powerWins || record.Power == 0) {
+					record.Power = int32(val)
+					powerApplied = true
+				}
+			}
+			if hasPosition && (positionWins || (record.PositionLat == 0 && record.PositionLong == 0)) {
+				if offsetSec < len(res.PositionLatStream) {
+					record.PositionLat = res.PositionLatStream[offsetSec]
+				}
+				if offsetSec < len(res.PositionLongStream) {
+					record.PositionLong = res.PositionLongStream[offsetSec]
+				}
+			}
+			if offsetSec < len(res.CadenceStream) {
+				if val := res.CadenceStream[offsetSec]; val > 0 && (cadenceWins || record.Cadence == 0) {
+					record.Cadence = int32(val)
+				}
+			}
+			// Altitude and temperature can legitimately be zero or negative, so
+			// every sample is applied; gaps are judged from the record instead.
+			if offsetSec < len(res.AltitudeStream) && (altitudeWins || record.Altitude == 0) {
+				record.Altitude = res.AltitudeStream[offsetSec]
+			}
+			if offsetSec < len(res.TemperatureStream) && (temperatureWins || record.Temperature == nil) {
+				val := int32(res.TemperatureStream[offsetSec])
+				record.Temperature = &val
+			}
+		}
+	}
+
+	if len(res.HeartRateStream) > 0 && hrWins {
+		recorded[streams.HeartRate] = res.HeartRateSensor
+	}
+	if len(res.PowerStream) > 0 && powerWins {
+		recorded[streams.Power] = res.PowerSensor
+	}
+	// Flag estimated power so the FIT file doesn't pass it off as measured.
+	// Estimates usually only fill gaps, so any applied sample sets the flag;
+	// measured power clears it only when it replaced what was there.
+	if estimated := res.PowerSensor == streams.SensorEstimated; powerApplied && (estimated || powerWins) {
+		session.PowerEstimated = &estimated
+	}
+	if hasPosition && positionWins {
+		recorded[streams.Position] = res.PositionSensor
+	}
+	if len(res.CadenceStream) > 0 && cadenceWins {
+		recorded[streams.Cadence] = res.CadenceSensor
+	}
+	if len(res.AltitudeStream) > 0 && altitudeWins {
+		recorded[streams.Altitude] = res.AltitudeSensor
+	}
+	if len(res.TemperatureStream) > 0 && temperatureWins {
+		recorded[streams.Temperature] = res.TemperatureSensor
+	}
+}
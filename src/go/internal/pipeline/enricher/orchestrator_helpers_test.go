@@ -1,9 +1,18 @@
 package enricher
 
 import (
+	"context"
+	"log/slog"
 	"testing"
+	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/user_input"
+	"github.com/fitglue/server/src/go/pkg/domain/streams"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
@@ -116,6 +125,91 @@ func TestBuildPendingInputStatusMessage(t *testing.T) {
 	})
 }
 
+// TestTimestampChecksFirst tests that timestamp sanity checks run before
+// every other enricher.
+func TestTimestampChecksFirst(t *testing.T) {
+	configs := []configuredEnricher{
+		{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_LOCATION_NAMING},
+		{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER},
+		{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_TIMESTAMP_SANITY},
+	}
+
+	ordered := timestampChecksFirst(configs)
+
+	require.Len(t, ordered, 3)
+	assert.Equal(t, pbplugin.EnricherProviderType_ENRICHER_PROVIDER_TIMESTAMP_SANITY, ordered[0].ProviderType)
+	assert.Equal(t, pbplugin.EnricherProviderType_ENRICHER_PROVIDER_LOCATION_NAMING, ordered[1].ProviderType)
+	assert.Equal(t, pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER, ordered[2].ProviderType)
+	assert.Equal(t, pbplugin.EnricherProviderType_ENRICHER_PROVIDER_LOCATION_NAMING, configs[0].ProviderType, "input should be left untouched")
+}
+
+// TestHandleWaitError_CompletedInput tests that a completed pending input is
+// only replaced when the provider asks to reopen it.
+func TestHandleWaitError_CompletedInput(t *testing.T) {
+	for _, reopen := range []bool{false, true} {
+		var created *pbpipeline.PendingInput
+		db := &mocks.MockDatabase{
+			GetPendingInputFunc: func(ctx context.Context, userId string, id string) (*pbpipeline.PendingInput, error) {
+				return &pbpipeline.PendingInput{ActivityId: id, Status: pbpipeline.PendingInput_STATUS_COMPLETED}, nil
+			},
+			CreatePendingInputFunc: func(ctx context.Context, userId string, input *pbpipeline.PendingInput) error {
+				created = input
+				return nil
+			},
+		}
+		o := NewOrchestrator(db, nil, "", nil)
+		pipelineID := "pipe-1"
+		payload := &pbevents.ActivityPayload{UserId: "user-1", PipelineId: &pipelineID}
+		waitErr := &user_input.WaitForInputError{ActivityID: "pi-1", RequiredFields: []string{"clock_offset"}, Reopen: reopen}
+
+		result, err := o.handleWaitError(context.Background(), slog.Default(), payload, nil, waitErr, "act-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, pbpipeline.ExecutionStatus_STATUS_WAITING, result.Status)
+		if reopen {
+			require.NotNil(t, created, "reopened input should be recreated")
+			assert.Equal(t, pbpipeline.PendingInput_STATUS_WAITING, created.Status)
+		} else {
+			assert.Nil(t, created, "completed input should not be overwritten")
+		}
+	}
+}
+
+// locationMockProvider is a MockProvider whose description names a place.
+type locationMockProvider struct{ MockProvider }
+
+func (m *locationMockProvider) RevealsLocation() bool { return true }
+
+// TestLocationSections tests that only sections from location-revealing
+// providers are listed for AI scrubbing.
+func TestLocationSections(t *testing.T) {
+	o := NewOrchestrator(&mocks.MockDatabase{}, nil, "", nil)
+	o.Register(&locationMockProvider{MockProvider{
+		NameFunc: func() string { return "location_naming" },
+		ProviderTypeFunc: func() pbplugin.EnricherProviderType {
+			return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_LOCATION_NAMING
+		},
+	}})
+	o.Register(&MockProvider{
+		NameFunc:         func() string { return "weather" },
+		ProviderTypeFunc: func() pbplugin.EnricherProviderType { return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER },
+	})
+	configs := []configuredEnricher{
+		{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER},
+		{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_LOCATION_NAMING},
+	}
+
+	t.Run("ListsLocationSections", func(t *testing.T) {
+		slots := []string{"Morning run", "🌤️ Weather: 12°C", "📍 Location: Richmond Park, London"}
+		assert.JSONEq(t, `["📍 Location: Richmond Park, London"]`, o.locationSections(configs, slots))
+	})
+
+	t.Run("EmptyWithoutLocationOutput", func(t *testing.T) {
+		slots := []string{"Morning run", "🌤️ Weather: 12°C", ""}
+		assert.Empty(t, o.locationSections(configs, slots))
+	})
+}
+
 // TestGroupDestinationsByExclusions tests the groupDestinationsByExclusions function.
 func TestGroupDestinationsByExclusions(t *testing.T) {
 	t.Run("EmptyDestinations", func(t *testing.T) {
@@ -225,6 +319,17 @@ func TestBoostersToFirestoreMaps(t *testing.T) {
 		assert.Equal(t, "connection timeout", result[0]["error"])
 	})
 
+	t.Run("ContributedDescription", func(t *testing.T) {
+		result := boostersToFirestoreMaps([]ProviderExecution{
+			{ProviderName: "weather", Status: "SUCCESS", ContributedDescription: true},
+			{ProviderName: "branding", Status: "SUCCESS"},
+		})
+		require.Len(t, result, 2)
+		assert.Equal(t, true, result[0]["contributed_description"])
+		_, hasFlag := result[1]["contributed_description"]
+		assert.False(t, hasFlag, "contributed_description should only be written when set")
+	})
+
 	t.Run("MultipleExecutions", func(t *testing.T) {
 		execs := []ProviderExecution{
 			{ProviderName: "hr_zones", Status: "SUCCESS"},
@@ -268,3 +373,147 @@ func TestCloneEnrichedEvent(t *testing.T) {
 		assert.NotContains(t, src.EnrichmentMetadata, "new_key")
 	})
 }
+
+// TestMergeStreams tests sensor-priority merging of enricher streams into records.
+func TestMergeStreams(t *testing.T) {
+	start := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	newSession := func(hr ...int32) *pbactivity.Session {
+		lap := &pbactivity.Lap{}
+		for i, v := range hr {
+			lap.Records = append(lap.Records, &pbactivity.Record{
+				Timestamp: timestamppb.New(start.Add(time.Duration(i) * time.Second)),
+				HeartRate: v,
+			})
+		}
+		return &pbactivity.Session{StartTime: timestamppb.New(start), Laps: []*pbactivity.Lap{lap}}
+	}
+	heartRates := func(s *pbactivity.Session) []int32 {
+		var out []int32
+		for _, r := range s.Laps[0].Records {
+			out = append(out, r.HeartRate)
+		}
+		return out
+	}
+	policy := streams.DefaultMergePolicy()
+
+	t.Run("ChestStrapOverridesOptical", func(t *testing.T) {
+		session := newSession(0, 0, 0)
+		recorded := map[streams.Channel]streams.Sensor{}
+
+		mergeStreams(session, &providers.EnrichmentResult{HeartRateStream: []int{120, 121, 0}, HeartRateSensor: streams.SensorOptical}, policy, recorded, nil)
+		mergeStreams(session, &providers.EnrichmentResult{HeartRateStream: []int{140, 141, 142}, HeartRateSensor: streams.SensorChestStrap}, policy, recorded, nil)
+
+		assert.Equal(t, []int32{140, 141, 142}, heartRates(session))
+		assert.Equal(t, streams.SensorChestStrap, recorded[streams.HeartRate])
+	})
+
+	t.Run("OpticalOnlyFillsChestStrapGaps", func(t *testing.T) {
+		session := newSession(0, 0, 0)
+		recorded := map[streams.Channel]streams.Sensor{}
+
+		mergeStreams(session, &providers.EnrichmentResult{HeartRateStream: []int{140, 0, 142}, HeartRateSensor: streams.SensorChestStrap}, policy, recorded, nil)
+		mergeStreams(session, &providers.EnrichmentResult{HeartRateStream: []int{120, 121, 122}, HeartRateSensor: streams.SensorOptical}, policy, recorded, nil)
+
+		assert.Equal(t, []int32{140, 121, 142}, heartRates(session))
+		assert.Equal(t, streams.SensorChestStrap, recorded[streams.HeartRate])
+	})
+
+	t.Run("UndeclaredStreamReplacesUndeclaredData", func(t *testing.T) {
+		session := newSession(100, 100, 100)
+		recorded := map[streams.Channel]streams.Sensor{}
+
+		mergeStreams(session, &providers.EnrichmentResult{HeartRateStream: []int{130, 0, 132}}, policy, recorded, nil)
+
+		assert.Equal(t, []int32{130, 100, 132}, heartRates(session))
+	})
+
+	t.Run("SourceChestStrapBeatsFitbitOptical", func(t *testing.T) {
+		// Heart rate recorded by the source device's paired chest strap
+		session := newSession(150, 0, 152)
+		recorded := sourceSensors(&pbactivity.StandardizedActivity{Sessions: []*pbactivity.Session{session}})
+		assert.Equal(t, streams.SensorSource, recorded[streams.HeartRate])
+
+		mergeStreams(session, &providers.EnrichmentResult{HeartRateStream: []int{120, 121, 122}, HeartRateSensor: streams.SensorOptical}, policy, recorded, nil)
+
+		assert.Equal(t, []int32{150, 121, 152}, heartRates(session), "Fitbit optical should only fill the gap")
+		assert.Equal(t, streams.SensorSource, recorded[streams.HeartRate])
+	})
+
+	t.Run("EstimatedPositionKeepsRecordedRoute", func(t *testing.T) {
+		session := newSession(0, 0)
+		session.Laps[0].Records[0].PositionLat = 51.5
+		session.Laps[0].Records[0].PositionLong = -0.1
+		recorded := map[streams.Channel]streams.Sensor{}
+
+		mergeStreams(session, &providers.EnrichmentResult{
+			PositionLatStream:  []float64{40.7, 40.8},
+			PositionLongStream: []float64{-74.0, -74.1},
+			PositionSensor:     streams.SensorEstimated,
+		}, policy, recorded, nil)
+
+		records := session.Laps[0].Records
+		assert.Equal(t, 51.5, records[0].PositionLat)
+		assert.Equal(t, -0.1, records[0].PositionLong)
+		assert.Equal(t, 40.8, records[1].PositionLat)
+		assert.Equal(t, -74.1, records[1].PositionLong)
+	})
+
+	t.Run("EstimatedPowerFlagsSession", func(t *testing.T) {
+		session := newSession(0, 0)
+		recorded := map[streams.Channel]streams.Sensor{}
+
+		mergeStreams(session, &providers.EnrichmentResult{PowerStream: []int{250, 255}, PowerSensor: streams.SensorEstimated}, policy, recorded, nil)
+		require.NotNil(t, session.PowerEstimated)
+		assert.True(t, *session.PowerEstimated)
+
+		mergeStreams(session, &providers.EnrichmentResult{PowerStream: []int{240, 260}, PowerSensor: streams.SensorPowerMeter}, policy, recorded, nil)
+		assert.False(t, *session.PowerEstimated)
+		assert.Equal(t, int32(260), session.Laps[0].Records[1].Power)
+	})
+
+	t.Run("CadenceAltitudeTemperatureByOffset", func(t *testing.T) {
+		session := newSession(0, 0, 0)
+		records := session.Laps[0].Records
+		records[0].Cadence = 85
+		// Drop the middle record so later samples must be matched by timestamp
+		records[1].Timestamp = timestamppb.New(start.Add(2 * time.Second))
+		session.Laps[0].Records = records[:2]
+		recorded := map[streams.Channel]streams.Sensor{}
+
+		mergeStreams(session, &providers.EnrichmentResult{
+			CadenceStream:     []int{0, 90, 92},
+			AltitudeStream:    []float64{-3.5, 10, 12.5},
+			TemperatureStream: []int{0, 1, 2},
+		}, policy, recorded, nil)
+
+		assert.Equal(t, int32(85), records[0].Cadence)
+		assert.Equal(t, int32(92), records[1].Cadence)
+		assert.Equal(t, -3.5, records[0].Altitude)
+		assert.Equal(t, 12.5, records[1].Altitude)
+		require.NotNil(t, records[0].Temperature)
+		assert.Equal(t, int32(0), *records[0].Temperature)
+		assert.Equal(t, int32(2), *records[1].Temperature)
+		assert.Equal(t, streams.SensorUnknown, recorded[streams.Temperature])
+	})
+
+	t.Run("EstimatedAltitudeOnlyFillsGaps", func(t *testing.T) {
+		session := newSession(0, 0)
+		records := session.Laps[0].Records
+		records[0].Altitude = 100
+		temp := int32(18)
+		records[0].Temperature = &temp
+		recorded := map[streams.Channel]streams.Sensor{}
+
+		mergeStreams(session, &providers.EnrichmentResult{
+			AltitudeStream:    []float64{50, 51},
+			AltitudeSensor:    streams.SensorEstimated,
+			TemperatureStream: []int{25, 26},
+			TemperatureSensor: streams.SensorEstimated,
+		}, policy, recorded, nil)
+
+		assert.Equal(t, 100.0, records[0].Altitude)
+		assert.Equal(t, 51.0, records[1].Altitude)
+		assert.Equal(t, int32(18), *records[0].Temperature)
+		assert.Equal(t, int32(26), *records[1].Temperature)
+	})
+}
@@ -1,6 +1,8 @@
 package enricher
 
 import (
+	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/domain/cost"
 	user "github.com/fitglue/server/src/go/pkg/domain/user"
 
 	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
@@ -13,6 +15,7 @@ import (
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 
 	"context"
+	"fmt"
 	"log/slog"
 	"strings"
 	"testing"
@@ -25,8 +28,15 @@ import (
 
 // MockDatabase implements shared.Database
 type MockDatabase struct {
-	GetUserFunc          func(ctx context.Context, id string) (*user.Record, error)
-	GetUserPipelinesFunc func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error)
+	GetUserFunc                  func(ctx context.Context, id string) (*user.Record, error)
+	GetUserPipelinesFunc         func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error)
+	GetPipelineConfigVersionFunc func(ctx context.Context, userId string, pipelineId string, version int32) (*pbpipeline.PipelineConfigVersion, error)
+	ListActivityTypeRulesFunc    func(ctx context.Context, userId string) ([]*pbpipeline.ActivityTypeRule, error)
+	AddPipelineRunCostFunc       func(ctx context.Context, userId string, id string, cost *pbpipeline.RunCost) error
+	CreatePipelineRunFunc        func(ctx context.Context, userId string, run *pbpipeline.PipelineRun) error
+	UpdatePipelineRunFunc        func(ctx context.Context, userId string, id string, data map[string]interface{}) error
+	// ResultShares backs Get/SetEnricherResultShare when non-nil, keyed by share ID
+	ResultShares map[string]*pbpipeline.EnricherResultShare
 }
 
 func (m *MockDatabase) GetUser(ctx context.Context, id string) (*user.Record, error) {
@@ -44,6 +54,12 @@ func (m *MockDatabase) UpdateExecution(ctx context.Context, userId string, id st
 func (m *MockDatabase) UpdateUser(ctx context.Context, id string, data map[string]interface{}) error {
 	return nil
 }
+func (m *MockDatabase) GetIntegrationSecret(ctx context.Context, userId string, provider string) (string, error) {
+	return "", nil
+}
+func (m *MockDatabase) SetIntegrationSecret(ctx context.Context, userId string, provider string, secret string) error {
+	return nil
+}
 func (m *MockDatabase) CreatePendingInput(ctx context.Context, userId string, input *pbpipeline.PendingInput) error {
 	return nil
 }
@@ -77,6 +93,12 @@ func (m *MockDatabase) IncrementSyncCount(ctx context.Context, userID string) er
 func (m *MockDatabase) IncrementPreventedSyncCount(ctx context.Context, userID string) error {
 	return nil
 }
+func (m *MockDatabase) GetUsage(ctx context.Context, userID string, period string) (map[string]int64, error) {
+	return map[string]int64{}, nil
+}
+func (m *MockDatabase) IncrementUsage(ctx context.Context, userID string, period string, feature string, amount int64) error {
+	return nil
+}
 func (m *MockDatabase) ResetSyncCount(ctx context.Context, userID string) error {
 	return nil
 }
@@ -119,18 +141,51 @@ func (m *MockDatabase) ListPersonalRecords(ctx context.Context, userId string) (
 func (m *MockDatabase) DeletePersonalRecord(ctx context.Context, userId string, recordType string) error {
 	return nil
 }
+func (m *MockDatabase) GetGear(ctx context.Context, userId string, gearId string) (*pbuser.Gear, error) {
+	return nil, nil
+}
+func (m *MockDatabase) RecordGearUse(ctx context.Context, userId string, gearId string, activityId string, distanceMeters float64, usedAt time.Time) (*pbuser.Gear, bool, error) {
+	return nil, false, nil
+}
+func (m *MockDatabase) ListGoals(ctx context.Context, userId string) ([]*pbuser.Goal, error) {
+	return nil, nil
+}
+func (m *MockDatabase) GetGoal(ctx context.Context, userId string, goalId string) (*pbuser.Goal, error) {
+	return nil, nil
+}
+func (m *MockDatabase) SetGoal(ctx context.Context, userId string, goal *pbuser.Goal) error {
+	return nil
+}
+func (m *MockDatabase) DeleteGoal(ctx context.Context, userId string, goalId string) error {
+	return nil
+}
 func (m *MockDatabase) GetUserPipelines(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
 	if m.GetUserPipelinesFunc != nil {
 		return m.GetUserPipelinesFunc(ctx, userId)
 	}
 	return []*pbpipeline.PipelineConfig{}, nil
 }
+func (m *MockDatabase) GetPipelineConfigVersion(ctx context.Context, userId string, pipelineId string, version int32) (*pbpipeline.PipelineConfigVersion, error) {
+	if m.GetPipelineConfigVersionFunc != nil {
+		return m.GetPipelineConfigVersionFunc(ctx, userId, pipelineId, version)
+	}
+	return nil, nil
+}
 func (m *MockDatabase) GetPluginDefault(ctx context.Context, userId string, pluginId string) (*pbpipeline.PluginDefault, error) {
 	return nil, nil
 }
 func (m *MockDatabase) SetPluginDefault(ctx context.Context, userId string, pluginDefault *pbpipeline.PluginDefault) error {
 	return nil
 }
+func (m *MockDatabase) ListActivityTypeRules(ctx context.Context, userId string) ([]*pbpipeline.ActivityTypeRule, error) {
+	if m.ListActivityTypeRulesFunc != nil {
+		return m.ListActivityTypeRulesFunc(ctx, userId)
+	}
+	return nil, nil
+}
+func (m *MockDatabase) MarkActivityTypeRuleApplied(ctx context.Context, userId string, ruleId string) error {
+	return nil
+}
 func (m *MockDatabase) SetUploadedActivity(ctx context.Context, userId string, record *pbactivity.UploadedActivityRecord) error {
 	return nil
 }
@@ -138,6 +193,9 @@ func (m *MockDatabase) GetUploadedActivity(ctx context.Context, userId string, d
 	return nil, nil
 }
 func (m *MockDatabase) CreatePipelineRun(ctx context.Context, userId string, run *pbpipeline.PipelineRun) error {
+	if m.CreatePipelineRunFunc != nil {
+		return m.CreatePipelineRunFunc(ctx, userId, run)
+	}
 	return nil
 }
 func (m *MockDatabase) GetPipelineRun(ctx context.Context, userId string, id string) (*pbpipeline.PipelineRun, error) {
@@ -147,6 +205,18 @@ func (m *MockDatabase) GetPipelineRunByActivityId(ctx context.Context, userId st
 	return nil, nil
 }
 func (m *MockDatabase) UpdatePipelineRun(ctx context.Context, userId string, id string, data map[string]interface{}) error {
+	if m.UpdatePipelineRunFunc != nil {
+		return m.UpdatePipelineRunFunc(ctx, userId, id, data)
+	}
+	return nil
+}
+func (m *MockDatabase) GetActivityDayCounts(ctx context.Context, userId string, since time.Time) (map[string]int, error) {
+	return nil, nil
+}
+func (m *MockDatabase) AddPipelineRunCost(ctx context.Context, userId string, id string, cost *pbpipeline.RunCost) error {
+	if m.AddPipelineRunCostFunc != nil {
+		return m.AddPipelineRunCostFunc(ctx, userId, id, cost)
+	}
 	return nil
 }
 func (m *MockDatabase) SetDestinationOutcome(ctx context.Context, userId string, pipelineRunId string, outcome *pbpipeline.DestinationOutcome) error {
@@ -155,6 +225,12 @@ func (m *MockDatabase) SetDestinationOutcome(ctx context.Context, userId string,
 func (m *MockDatabase) GetDestinationOutcomes(ctx context.Context, userId string, pipelineRunId string) ([]*pbpipeline.DestinationOutcome, error) {
 	return nil, nil
 }
+func (m *MockDatabase) UpdateDestinationOutcomes(ctx context.Context, userId string, pipelineRunId string, fn func(run *pbpipeline.PipelineRun, outcomes []*pbpipeline.DestinationOutcome) ([]*pbpipeline.DestinationOutcome, map[string]interface{})) error {
+	return nil
+}
+func (m *MockDatabase) ListStuckPipelineRuns(ctx context.Context, before time.Time, limit int) ([]*shared.UserPipelineRun, error) {
+	return nil, nil
+}
 func (m *MockDatabase) GetBoosterData(ctx context.Context, userId string, boosterId string) (map[string]interface{}, error) {
 	return nil, nil
 }
@@ -164,6 +240,26 @@ func (m *MockDatabase) SetBoosterData(ctx context.Context, userId string, booste
 func (m *MockDatabase) DeleteBoosterData(ctx context.Context, userId string, boosterId string) error {
 	return nil
 }
+func (m *MockDatabase) GetProviderCircuit(ctx context.Context, providerType pbplugin.EnricherProviderType) (*pbpipeline.ProviderCircuit, error) {
+	return nil, nil
+}
+func (m *MockDatabase) UpdateProviderCircuit(ctx context.Context, providerType pbplugin.EnricherProviderType, fn func(c *pbpipeline.ProviderCircuit)) (*pbpipeline.ProviderCircuit, error) {
+	c := &pbpipeline.ProviderCircuit{ProviderType: providerType}
+	fn(c)
+	return c, nil
+}
+func (m *MockDatabase) AddProviderCircuitChange(ctx context.Context, change *pbpipeline.ProviderCircuitChange) error {
+	return nil
+}
+func (m *MockDatabase) GetEnricherResultShare(ctx context.Context, userId string, id string) (*pbpipeline.EnricherResultShare, error) {
+	return m.ResultShares[id], nil
+}
+func (m *MockDatabase) SetEnricherResultShare(ctx context.Context, userId string, share *pbpipeline.EnricherResultShare) error {
+	if m.ResultShares != nil {
+		m.ResultShares[share.Id] = share
+	}
+	return nil
+}
 
 type MockBlobStore struct {
 	WriteFunc  func(ctx context.Context, bucket, object string, data []byte) error
@@ -472,6 +568,71 @@ func TestOrchestrator_Process(t *testing.T) {
 		}
 	})
 
+	t.Run("Resamples non-1Hz streams before applying to Records", func(t *testing.T) {
+		mockDB := &MockDatabase{
+			GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+				return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+			},
+			GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+				return []*pbpipeline.PipelineConfig{
+					{
+						Id:     "p1",
+						Source: "SOURCE_HEVY",
+						Enrichers: []*pbpipeline.EnricherConfig{
+							{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MOCK},
+						},
+					},
+				}, nil
+			},
+		}
+		mockProvider := &MockProvider{
+			NameFunc: func() string { return "mock-enricher" },
+			EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+				return &providers.EnrichmentResult{
+					HeartRateStream: []int{100, 150}, // one sample every 5s
+					StreamInterval:  5 * time.Second,
+				}, nil
+			},
+		}
+		orchestrator := NewOrchestrator(mockDB, &MockBlobStore{}, "test-bucket", nil)
+		orchestrator.Register(mockProvider)
+
+		pipelineID := "p1"
+		payload := &pbevents.ActivityPayload{
+			Source:     pbactivity.ActivitySource_SOURCE_HEVY,
+			UserId:     "u1",
+			PipelineId: &pipelineID,
+			StandardizedActivity: &pbactivity.StandardizedActivity{
+				StartTime: timestamppb.New(time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)),
+				Sessions: []*pbactivity.Session{
+					{
+						StartTime:        timestamppb.New(time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)),
+						TotalElapsedTime: 10,
+					},
+				},
+			},
+		}
+
+		result, err := orchestrator.Process(ctx, slog.Default(), payload, "exec-1", "pipe-1", false)
+		if err != nil {
+			t.Fatalf("Process failed: %v", err)
+		}
+		if len(result.Events) == 0 {
+			t.Fatal("Expected at least one event")
+		}
+
+		records := result.Events[0].ActivityData.Sessions[0].Laps[0].Records
+		if len(records) != 10 {
+			t.Fatalf("Expected 10 records, got %d", len(records))
+		}
+		expected := []int32{100, 110, 120, 130, 140, 150, 150, 150, 150, 150}
+		for i, want := range expected {
+			if records[i].HeartRate != want {
+				t.Errorf("Record %d: expected HR %d, got %d", i, want, records[i].HeartRate)
+			}
+		}
+	})
+
 	t.Run("Single pipeline execution - targeted by pipeline_id", func(t *testing.T) {
 		// With the Pipeline Splitter Architecture (Rule E25), each enricher invocation
 		// processes exactly one pipeline. Verify that only the targeted pipeline is executed.
@@ -886,3 +1047,862 @@ func TestOrchestrator_DeferredExecution(t *testing.T) {
 		}
 	})
 }
+
+// MockEssentialProvider implements both providers.Provider and providers.EssentialProvider
+type MockEssentialProvider struct {
+	MockProvider
+}
+
+func (m *MockEssentialProvider) IsEssential() bool {
+	return true
+}
+
+func TestOrchestrator_ExecutionBudget(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Skips optional enrichers once budget is spent", func(t *testing.T) {
+		mockDB := &MockDatabase{
+			GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+				return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+			},
+			GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+				return []*pbpipeline.PipelineConfig{
+					{
+						Id:           "p1",
+						Source:       "SOURCE_HEVY",
+						Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+						Enrichers: []*pbpipeline.EnricherConfig{
+							{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER},
+							{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ACTIVITY_FILTER},
+							{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_AI_COMPANION},
+						},
+					},
+				}, nil
+			},
+		}
+
+		executed := []string{}
+
+		slowProvider := &MockProvider{
+			NameFunc:         func() string { return "weather" },
+			ProviderTypeFunc: func() pbplugin.EnricherProviderType { return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER },
+			EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+				executed = append(executed, "weather")
+				time.Sleep(5 * time.Millisecond)
+				return &providers.EnrichmentResult{Description: "☀️ Weather"}, nil
+			},
+		}
+
+		essentialProvider := &MockEssentialProvider{MockProvider{
+			NameFunc: func() string { return "activity_filter" },
+			ProviderTypeFunc: func() pbplugin.EnricherProviderType {
+				return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ACTIVITY_FILTER
+			},
+			EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+				executed = append(executed, "activity_filter")
+				return &providers.EnrichmentResult{}, nil
+			},
+		}}
+
+		deferredProvider := &MockDeferrableProvider{
+			NameFunc: func() string { return "ai-companion" },
+			ProviderTypeFunc: func() pbplugin.EnricherProviderType {
+				return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_AI_COMPANION
+			},
+			EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+				executed = append(executed, "ai-companion")
+				return &providers.EnrichmentResult{Description: "✨ AI Summary"}, nil
+			},
+		}
+
+		orchestrator := NewOrchestrator(mockDB, &MockBlobStore{}, "test-bucket", nil)
+		orchestrator.enrichmentBudget = func(*user.Record) time.Duration { return time.Millisecond }
+		orchestrator.Register(slowProvider)
+		orchestrator.Register(essentialProvider)
+		orchestrator.Register(deferredProvider)
+
+		pipelineID := "p1"
+		payload := &pbevents.ActivityPayload{
+			UserId:     "user-1",
+			Source:     pbactivity.ActivitySource_SOURCE_HEVY,
+			PipelineId: &pipelineID,
+			Timestamp:  timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+			StandardizedActivity: &pbactivity.StandardizedActivity{
+				Name: "Morning Run",
+				Sessions: []*pbactivity.Session{
+					{
+						StartTime:        timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+						TotalElapsedTime: 60,
+					},
+				},
+			},
+		}
+
+		result, err := orchestrator.Process(ctx, slog.Default(), payload, "exec-1", "pipe-exec-1", false)
+		if err != nil {
+			t.Fatalf("Process failed: %v", err)
+		}
+
+		if strings.Join(executed, ",") != "weather,activity_filter" {
+			t.Errorf("Expected weather and activity_filter to run, got: %v", executed)
+		}
+
+		var skipped *ProviderExecution
+		for i := range result.ProviderExecutions {
+			if result.ProviderExecutions[i].ProviderName == "ai-companion" {
+				skipped = &result.ProviderExecutions[i]
+			}
+		}
+		if skipped == nil {
+			t.Fatal("Expected ai-companion to be recorded as a provider execution")
+		}
+		if skipped.Status != "SKIPPED_BUDGET" {
+			t.Errorf("Expected ai-companion status SKIPPED_BUDGET, got %s", skipped.Status)
+		}
+
+		if len(result.Events) != 1 {
+			t.Fatalf("Expected 1 event, got %d", len(result.Events))
+		}
+		if strings.Contains(result.Events[0].Description, "AI Summary") {
+			t.Errorf("Expected skipped enricher description to be absent, got: %q", result.Events[0].Description)
+		}
+	})
+}
+
+func TestOrchestrator_ProviderTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	// hanging blocks until its deadline, like a stuck external API call
+	hanging := func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	newOrchestrator := func(enrichers ...*pbpipeline.EnricherConfig) *Orchestrator {
+		mockDB := &MockDatabase{
+			GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+				return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+			},
+			GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+				return []*pbpipeline.PipelineConfig{{
+					Id:           "p1",
+					Source:       "SOURCE_HEVY",
+					Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+					Enrichers:    enrichers,
+				}}, nil
+			},
+		}
+		o := NewOrchestrator(mockDB, &MockBlobStore{}, "test-bucket", nil)
+		o.providerTimeout = 10 * time.Millisecond
+		return o
+	}
+
+	pipelineID := "p1"
+	payload := &pbevents.ActivityPayload{
+		UserId:     "user-1",
+		Source:     pbactivity.ActivitySource_SOURCE_HEVY,
+		PipelineId: &pipelineID,
+		Timestamp:  timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			Name: "Morning Run",
+			Sessions: []*pbactivity.Session{{
+				StartTime:        timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+				TotalElapsedTime: 60,
+			}},
+		},
+	}
+
+	t.Run("Optional provider is recorded as TIMEOUT and the run continues", func(t *testing.T) {
+		o := newOrchestrator(
+			&pbpipeline.EnricherConfig{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_AI_COMPANION},
+			&pbpipeline.EnricherConfig{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER},
+		)
+		o.Register(&MockProvider{
+			NameFunc: func() string { return "ai-companion" },
+			ProviderTypeFunc: func() pbplugin.EnricherProviderType {
+				return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_AI_COMPANION
+			},
+			EnrichFunc: hanging,
+		})
+		o.Register(&MockProvider{
+			NameFunc:         func() string { return "weather" },
+			ProviderTypeFunc: func() pbplugin.EnricherProviderType { return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER },
+			EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+				return &providers.EnrichmentResult{Description: "☀️ Weather"}, nil
+			},
+		})
+
+		result, err := o.Process(ctx, slog.Default(), payload, "exec-1", "pipe-exec-1", false)
+		if err != nil {
+			t.Fatalf("Process failed: %v", err)
+		}
+		if len(result.ProviderExecutions) < 2 {
+			t.Fatalf("Expected two provider executions, got %+v", result.ProviderExecutions)
+		}
+		timedOut := result.ProviderExecutions[0]
+		if timedOut.Status != "TIMEOUT" || timedOut.Metadata["timeout_ms"] != "10" {
+			t.Errorf("Expected ai-companion to time out after 10ms, got %+v", timedOut)
+		}
+		if len(result.Events) != 1 || !strings.Contains(result.Events[0].Description, "Weather") {
+			t.Errorf("Expected the run to publish with the weather section, got %+v", result.Events)
+		}
+	})
+
+	t.Run("Essential provider fails the run", func(t *testing.T) {
+		o := newOrchestrator(&pbpipeline.EnricherConfig{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ACTIVITY_FILTER})
+		o.Register(&MockEssentialProvider{MockProvider{
+			NameFunc: func() string { return "activity_filter" },
+			ProviderTypeFunc: func() pbplugin.EnricherProviderType {
+				return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ACTIVITY_FILTER
+			},
+			EnrichFunc: hanging,
+		}})
+
+		result, err := o.Process(ctx, slog.Default(), payload, "exec-1", "pipe-exec-1", false)
+		if err == nil {
+			t.Fatal("Expected the run to fail")
+		}
+		if pe := result.ProviderExecutions[len(result.ProviderExecutions)-1]; pe.Status != "TIMEOUT" {
+			t.Errorf("Expected activity_filter status TIMEOUT, got %s", pe.Status)
+		}
+	})
+}
+
+// MockSlowProvider declares its own default call deadline.
+type MockSlowProvider struct {
+	MockProvider
+	timeout time.Duration
+}
+
+func (m *MockSlowProvider) DefaultTimeout() time.Duration {
+	return m.timeout
+}
+
+func TestOrchestrator_TimeoutFor(t *testing.T) {
+	o := NewOrchestrator(&MockDatabase{}, &MockBlobStore{}, "test-bucket", nil)
+	slow := &MockSlowProvider{timeout: 90 * time.Second}
+	tests := []struct {
+		configured time.Duration
+		provider   providers.Provider
+		want       time.Duration
+	}{
+		{0, &MockProvider{}, defaultProviderTimeout},
+		{90 * time.Second, &MockProvider{}, 90 * time.Second},
+		{time.Hour, &MockProvider{}, maxProviderTimeout},
+		{0, slow, 90 * time.Second},
+		{20 * time.Second, slow, 20 * time.Second},
+		{0, &MockSlowProvider{timeout: time.Hour}, maxProviderTimeout},
+	}
+	for _, tt := range tests {
+		if got := o.timeoutFor(configuredEnricher{Timeout: tt.configured}, tt.provider); got != tt.want {
+			t.Errorf("timeoutFor(%v, %T) = %v, want %v", tt.configured, tt.provider, got, tt.want)
+		}
+	}
+}
+
+func TestOrchestrator_CallTimeout(t *testing.T) {
+	o := NewOrchestrator(&MockDatabase{}, &MockBlobStore{}, "test-bucket", nil)
+	optional := &MockProvider{}
+	essential := &MockEssentialProvider{}
+	cfg := configuredEnricher{Timeout: 2 * time.Minute}
+	tests := []struct {
+		name     string
+		provider providers.Provider
+		spent    time.Duration
+		budget   time.Duration
+		want     time.Duration
+	}{
+		{"no budget", optional, 0, 0, 2 * time.Minute},
+		{"budget to spare", optional, 10 * time.Second, 5 * time.Minute, 2 * time.Minute},
+		{"capped to the remaining budget", optional, 59 * time.Second, time.Minute, time.Second},
+		{"essential providers keep their deadline", essential, 59 * time.Second, time.Minute, 2 * time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := o.callTimeout(cfg, tt.provider, tt.spent, tt.budget); got != tt.want {
+				t.Errorf("callTimeout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrchestrator_LazyInitialization(t *testing.T) {
+	ctx := context.Background()
+
+	mockDB := &MockDatabase{
+		GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+			return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+		},
+		GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+			return []*pbpipeline.PipelineConfig{
+				{
+					Id:           "p1",
+					Source:       "SOURCE_HEVY",
+					Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+					Enrichers: []*pbpipeline.EnricherConfig{
+						{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER},
+						{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_AI_COMPANION},
+					},
+				},
+			}, nil
+		},
+	}
+
+	weatherProvider := &MockProvider{
+		NameFunc:         func() string { return "weather" },
+		ProviderTypeFunc: func() pbplugin.EnricherProviderType { return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER },
+		EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+			return &providers.EnrichmentResult{Description: "☀️ Weather"}, nil
+		},
+	}
+	aiProvider := &MockDeferrableProvider{
+		NameFunc: func() string { return "ai-companion" },
+		ProviderTypeFunc: func() pbplugin.EnricherProviderType {
+			return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_AI_COMPANION
+		},
+		EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+			t.Error("Expected ai-companion not to run after failed initialization")
+			return &providers.EnrichmentResult{}, nil
+		},
+	}
+	unusedProvider := &MockProvider{
+		NameFunc:         func() string { return "parkrun" },
+		ProviderTypeFunc: func() pbplugin.EnricherProviderType { return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PARKRUN },
+	}
+
+	orchestrator := NewOrchestrator(mockDB, &MockBlobStore{}, "test-bucket", nil)
+	orchestrator.Register(weatherProvider)
+	orchestrator.Register(aiProvider)
+	orchestrator.Register(unusedProvider)
+
+	initialized := []string{}
+	orchestrator.SetProviderInitializer(func(ctx context.Context, p providers.Provider) error {
+		initialized = append(initialized, p.Name())
+		if p.Name() == "ai-companion" {
+			return fmt.Errorf("gemini unavailable")
+		}
+		return nil
+	})
+
+	pipelineID := "p1"
+	payload := &pbevents.ActivityPayload{
+		UserId:     "user-1",
+		Source:     pbactivity.ActivitySource_SOURCE_HEVY,
+		PipelineId: &pipelineID,
+		Timestamp:  timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			Name: "Morning Run",
+			Sessions: []*pbactivity.Session{
+				{
+					StartTime:        timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+					TotalElapsedTime: 60,
+				},
+			},
+		},
+	}
+
+	result, err := orchestrator.Process(ctx, slog.Default(), payload, "exec-1", "pipe-exec-1", false)
+	if err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+
+	if strings.Join(initialized, ",") != "weather,ai-companion" {
+		t.Errorf("Expected only configured providers to be initialized, got: %v", initialized)
+	}
+
+	var aiExec *ProviderExecution
+	for i := range result.ProviderExecutions {
+		if result.ProviderExecutions[i].ProviderName == "ai-companion" {
+			aiExec = &result.ProviderExecutions[i]
+		}
+	}
+	if aiExec == nil || aiExec.Status != "SKIPPED" || aiExec.Metadata["skip_reason"] != "init_failed" {
+		t.Errorf("Expected ai-companion to be skipped with init_failed, got: %+v", aiExec)
+	}
+}
+
+func TestOrchestrator_RecordsRunCost(t *testing.T) {
+	ctx := context.Background()
+
+	var recorded *pbpipeline.RunCost
+	var recordedRunID string
+	mockDB := &MockDatabase{
+		GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+			return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+		},
+		GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+			return []*pbpipeline.PipelineConfig{{
+				Id:           "p1",
+				Source:       "SOURCE_HEVY",
+				Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+				Enrichers:    []*pbpipeline.EnricherConfig{{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER}},
+			}}, nil
+		},
+		AddPipelineRunCostFunc: func(ctx context.Context, userId string, id string, c *pbpipeline.RunCost) error {
+			recordedRunID, recorded = id, c
+			return nil
+		},
+	}
+
+	o := NewOrchestrator(mockDB, &MockBlobStore{}, "test-bucket", nil)
+	o.Register(&MockProvider{
+		NameFunc: func() string { return "weather" },
+		ProviderTypeFunc: func() pbplugin.EnricherProviderType {
+			return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER
+		},
+		EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+			cost.Record(ctx, cost.Usage{ExternalAPICalls: 2})
+			return &providers.EnrichmentResult{}, nil
+		},
+	})
+
+	pipelineID := "p1"
+	payload := &pbevents.ActivityPayload{
+		UserId:     "user-1",
+		Source:     pbactivity.ActivitySource_SOURCE_HEVY,
+		PipelineId: &pipelineID,
+		Timestamp:  timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			Name: "Morning Run",
+			Sessions: []*pbactivity.Session{{
+				StartTime:        timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+				TotalElapsedTime: 60,
+			}},
+		},
+	}
+
+	if _, err := o.Process(ctx, slog.Default(), payload, "exec-1", "pipe-exec-1", false); err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+	if recorded == nil {
+		t.Fatal("Expected the run cost to be recorded")
+	}
+	if recordedRunID != "pipe-exec-1" {
+		t.Errorf("Expected cost recorded on run pipe-exec-1, got %q", recordedRunID)
+	}
+	if recorded.ExternalApiCalls != 2 || recorded.EstimatedUsd <= 0 {
+		t.Errorf("Expected the provider's API calls priced, got %+v", recorded)
+	}
+}
+
+func TestOrchestrator_ReplaysPipelineConfigVersion(t *testing.T) {
+	ctx := context.Background()
+
+	var run *pbpipeline.PipelineRun
+	mockDB := &MockDatabase{
+		GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+			return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+		},
+		GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+			return []*pbpipeline.PipelineConfig{{
+				Id:           "p1",
+				Version:      3,
+				Source:       "SOURCE_HEVY",
+				Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+				Enrichers:    []*pbpipeline.EnricherConfig{{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER}},
+			}}, nil
+		},
+		GetPipelineConfigVersionFunc: func(ctx context.Context, userId string, pipelineId string, version int32) (*pbpipeline.PipelineConfigVersion, error) {
+			if version != 2 {
+				return nil, nil
+			}
+			return &pbpipeline.PipelineConfigVersion{
+				PipelineId: pipelineId,
+				Version:    2,
+				Config: &pbpipeline.PipelineConfig{
+					Id:           pipelineId,
+					Version:      2,
+					Source:       "SOURCE_HEVY",
+					Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+					Enrichers:    []*pbpipeline.EnricherConfig{{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MOCK}},
+				},
+			}, nil
+		},
+		CreatePipelineRunFunc: func(ctx context.Context, userId string, r *pbpipeline.PipelineRun) error {
+			run = r
+			return nil
+		},
+	}
+
+	var ran []string
+	o := NewOrchestrator(mockDB, &MockBlobStore{}, "test-bucket", nil)
+	for _, pt := range []pbplugin.EnricherProviderType{pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER, pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MOCK} {
+		pt := pt
+		o.Register(&MockProvider{
+			NameFunc:         func() string { return pt.String() },
+			ProviderTypeFunc: func() pbplugin.EnricherProviderType { return pt },
+			EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+				ran = append(ran, pt.String())
+				return &providers.EnrichmentResult{}, nil
+			},
+		})
+	}
+
+	pipelineID := "p1"
+	payload := &pbevents.ActivityPayload{
+		UserId:                "user-1",
+		Source:                pbactivity.ActivitySource_SOURCE_HEVY,
+		PipelineId:            &pipelineID,
+		IsRepost:              true,
+		RepostMode:            "full-pipeline",
+		PipelineConfigVersion: 2,
+		Timestamp:             timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			Name: "Morning Run",
+			Sessions: []*pbactivity.Session{{
+				StartTime:        timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+				TotalElapsedTime: 60,
+			}},
+		},
+	}
+
+	if _, err := o.Process(ctx, slog.Default(), payload, "exec-1", "pipe-exec-1", false); err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+	if len(ran) != 1 || ran[0] != pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MOCK.String() {
+		t.Errorf("Expected only version 2's enricher to run, got %v", ran)
+	}
+	if run == nil || run.PipelineConfigVersion != 2 {
+		t.Errorf("Expected the run to record config version 2, got %v", run.GetPipelineConfigVersion())
+	}
+}
+
+func TestOrchestrator_ReplayOverride(t *testing.T) {
+	ctx := context.Background()
+
+	var run *pbpipeline.PipelineRun
+	mockDB := &MockDatabase{
+		GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+			return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+		},
+		GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+			return []*pbpipeline.PipelineConfig{{
+				Id:           "p1",
+				Source:       "SOURCE_HEVY",
+				Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+				Enrichers:    []*pbpipeline.EnricherConfig{{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER}},
+			}}, nil
+		},
+		CreatePipelineRunFunc: func(ctx context.Context, userId string, r *pbpipeline.PipelineRun) error {
+			run = r
+			return nil
+		},
+	}
+
+	var ran []string
+	var gotConfig map[string]string
+	o := NewOrchestrator(mockDB, &MockBlobStore{}, "test-bucket", nil)
+	for _, pt := range []pbplugin.EnricherProviderType{pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER, pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MOCK} {
+		pt := pt
+		o.Register(&MockProvider{
+			NameFunc:         func() string { return pt.String() },
+			ProviderTypeFunc: func() pbplugin.EnricherProviderType { return pt },
+			EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+				ran = append(ran, pt.String())
+				gotConfig = inputConfig
+				return &providers.EnrichmentResult{}, nil
+			},
+		})
+	}
+
+	pipelineID := "p1"
+	replayOf := "run-1"
+	payload := &pbevents.ActivityPayload{
+		UserId:     "user-1",
+		Source:     pbactivity.ActivitySource_SOURCE_HEVY,
+		PipelineId: &pipelineID,
+		IsRepost:   true,
+		RepostMode: "full-pipeline",
+		ReplayOf:   &replayOf,
+		ReplayOverride: &pbevents.ReplayOverride{
+			Enrichers: []*pbevents.ReplayEnricher{{
+				ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MOCK,
+				TypedConfig:  map[string]string{"mode": "trial"},
+			}},
+			Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_INTERVALS},
+		},
+		Timestamp: timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			Name: "Morning Run",
+			Sessions: []*pbactivity.Session{{
+				StartTime:        timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+				TotalElapsedTime: 60,
+			}},
+		},
+	}
+
+	result, err := o.Process(ctx, slog.Default(), payload, "exec-1", "run-1-replay-1", false)
+	if err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+	if len(ran) != 1 || ran[0] != pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MOCK.String() || gotConfig["mode"] != "trial" {
+		t.Errorf("Expected only the override's enricher to run with its config, got %v %v", ran, gotConfig)
+	}
+	for _, evt := range result.Events {
+		if len(evt.Destinations) != 1 || evt.Destinations[0] != pbplugin.DestinationType_DESTINATION_INTERVALS {
+			t.Errorf("Expected only the override's destination, got %v", evt.Destinations)
+		}
+	}
+	if run == nil || run.GetReplayOf() != "run-1" || run.GetReplayOverride() == nil {
+		t.Fatalf("Expected the run to be labeled as a replay of run-1, got %v", run)
+	}
+	if len(run.Destinations) != 1 || run.Destinations[0].Destination != pbplugin.DestinationType_DESTINATION_INTERVALS {
+		t.Errorf("Expected the run to track the override's destination, got %v", run.Destinations)
+	}
+}
+
+func TestOrchestrator_TestActivityUsesSandboxDestination(t *testing.T) {
+	ctx := context.Background()
+
+	var run *pbpipeline.PipelineRun
+	mockDB := &MockDatabase{
+		GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+			return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+		},
+		GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+			return []*pbpipeline.PipelineConfig{{
+				Id:           "p1",
+				Source:       "SOURCE_HEVY",
+				Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+			}}, nil
+		},
+		CreatePipelineRunFunc: func(ctx context.Context, userId string, r *pbpipeline.PipelineRun) error {
+			run = r
+			return nil
+		},
+	}
+
+	o := NewOrchestrator(mockDB, &MockBlobStore{}, "test-bucket", nil)
+
+	pipelineID := "p1"
+	payload := &pbevents.ActivityPayload{
+		UserId:     "user-1",
+		Source:     pbactivity.ActivitySource_SOURCE_HEVY,
+		PipelineId: &pipelineID,
+		IsTest:     true,
+		Timestamp:  timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			Name: "Test Workout",
+			Sessions: []*pbactivity.Session{{
+				StartTime:        timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+				TotalElapsedTime: 60,
+			}},
+		},
+	}
+
+	result, err := o.Process(ctx, slog.Default(), payload, "exec-1", "pipe-exec-1", false)
+	if err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+	for _, evt := range result.Events {
+		if len(evt.Destinations) != 1 || evt.Destinations[0] != pbplugin.DestinationType_DESTINATION_MOCK {
+			t.Errorf("Expected only the sandbox destination, got %v", evt.Destinations)
+		}
+	}
+	if len(result.Events) == 0 {
+		t.Error("Expected an enriched event")
+	}
+	if run == nil || !run.IsTest {
+		t.Error("Expected the run to be marked as a test")
+	}
+	if len(run.GetDestinations()) != 1 || run.Destinations[0].Destination != pbplugin.DestinationType_DESTINATION_MOCK {
+		t.Errorf("Expected the run to track only the sandbox destination, got %v", run.GetDestinations())
+	}
+}
+
+// streamProvider is a MockProvider that sits out source updates.
+type streamProvider struct {
+	MockProvider
+}
+
+func (p *streamProvider) SkipOnSourceUpdate() bool { return true }
+
+func TestOrchestrator_SourceUpdateIsMetadataOnly(t *testing.T) {
+	ctx := context.Background()
+
+	mockDB := &MockDatabase{
+		GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+			return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+		},
+		GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+			return []*pbpipeline.PipelineConfig{{
+				Id:     "p1",
+				Source: "SOURCE_STRAVA",
+				Destinations: []pbplugin.DestinationType{
+					pbplugin.DestinationType_DESTINATION_STRAVA,
+					pbplugin.DestinationType_DESTINATION_HEVY,
+				},
+				Enrichers: []*pbpipeline.EnricherConfig{
+					{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_FITBIT_HEART_RATE},
+					{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER},
+				},
+			}}, nil
+		},
+	}
+
+	executed := []string{}
+	o := NewOrchestrator(mockDB, &MockBlobStore{}, "test-bucket", nil)
+	o.Register(&streamProvider{MockProvider{
+		NameFunc: func() string { return "fitbit-hr" },
+		ProviderTypeFunc: func() pbplugin.EnricherProviderType {
+			return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_FITBIT_HEART_RATE
+		},
+		EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+			executed = append(executed, "fitbit-hr")
+			return &providers.EnrichmentResult{}, nil
+		},
+	}})
+	o.Register(&MockProvider{
+		NameFunc:         func() string { return "weather" },
+		ProviderTypeFunc: func() pbplugin.EnricherProviderType { return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER },
+		EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+			executed = append(executed, "weather")
+			return &providers.EnrichmentResult{}, nil
+		},
+	})
+
+	pipelineID := "p1"
+	activityID := "act-1"
+	payload := &pbevents.ActivityPayload{
+		UserId:          "user-1",
+		Source:          pbactivity.ActivitySource_SOURCE_STRAVA,
+		PipelineId:      &pipelineID,
+		ActivityId:      &activityID,
+		IsResume:        true,
+		UseUpdateMethod: true,
+		IsSourceUpdate:  true,
+		Timestamp:       timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			ExternalId: "123",
+			Name:       "Renamed Run",
+			Sessions: []*pbactivity.Session{{
+				StartTime:        timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+				TotalElapsedTime: 60,
+			}},
+		},
+	}
+
+	result, err := o.Process(ctx, slog.Default(), payload, "exec-1", "pipe-exec-1", false)
+	if err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+	if strings.Join(executed, ",") != "weather" {
+		t.Errorf("Expected only weather to run, got: %v", executed)
+	}
+	for _, exec := range result.ProviderExecutions {
+		if exec.ProviderName == "fitbit-hr" && exec.Metadata["skip_reason"] != "source_update" {
+			t.Errorf("Expected fitbit-hr to be skipped for the source update, got %+v", exec)
+		}
+	}
+	if len(result.Events) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(result.Events))
+	}
+	evt := result.Events[0]
+	if len(evt.Destinations) != 1 || evt.Destinations[0] != pbplugin.DestinationType_DESTINATION_HEVY {
+		t.Errorf("Expected only the non-source destination, got %v", evt.Destinations)
+	}
+	if evt.Name != "Renamed Run" || evt.EnrichmentMetadata["use_update_method"] != "true" {
+		t.Errorf("Expected the edited title in update mode, got %q %v", evt.Name, evt.EnrichmentMetadata)
+	}
+}
+
+// sharingProvider is a MockProvider that opts in to cross-pipeline result sharing.
+type sharingProvider struct {
+	*MockProvider
+}
+
+func (p *sharingProvider) ShareResult(res *providers.EnrichmentResult) bool {
+	return res.Metadata["status"] == "success"
+}
+
+func TestOrchestrator_SharesResultsAcrossFanOut(t *testing.T) {
+	ctx := context.Background()
+
+	weatherFor := func(id string, config map[string]string) *pbpipeline.PipelineConfig {
+		return &pbpipeline.PipelineConfig{
+			Id:           id,
+			Source:       "SOURCE_HEVY",
+			Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+			Enrichers: []*pbpipeline.EnricherConfig{{
+				ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER,
+				TypedConfig:  config,
+			}},
+		}
+	}
+	mockDB := &MockDatabase{
+		GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+			return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+		},
+		GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+			return []*pbpipeline.PipelineConfig{
+				weatherFor("p1", nil),
+				weatherFor("p2", nil),
+				weatherFor("p3", map[string]string{"include_wind": "false"}),
+			}, nil
+		},
+		ResultShares: map[string]*pbpipeline.EnricherResultShare{},
+	}
+
+	calls := 0
+	o := NewOrchestrator(mockDB, &MockBlobStore{}, "test-bucket", nil)
+	o.Register(&sharingProvider{&MockProvider{
+		NameFunc:         func() string { return "weather" },
+		ProviderTypeFunc: func() pbplugin.EnricherProviderType { return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER },
+		EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+			calls++
+			return &providers.EnrichmentResult{
+				Description: fmt.Sprintf("🌤️ Weather: call %d", calls),
+				Metadata:    map[string]string{"status": "success"},
+			}, nil
+		},
+	}})
+
+	process := func(pipelineID, fanOutID string, isRepost bool) *pbevents.EnrichedActivityEvent {
+		t.Helper()
+		payload := &pbevents.ActivityPayload{
+			UserId:     "user-1",
+			Source:     pbactivity.ActivitySource_SOURCE_HEVY,
+			PipelineId: &pipelineID,
+			FanOutId:   &fanOutID,
+			IsRepost:   isRepost,
+			RepostMode: "full-pipeline",
+			Timestamp:  timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+			StandardizedActivity: &pbactivity.StandardizedActivity{
+				ExternalId: "hevy-1",
+				Name:       "Morning Run",
+				Sessions: []*pbactivity.Session{{
+					StartTime:        timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+					TotalElapsedTime: 60,
+				}},
+			},
+		}
+		result, err := o.Process(ctx, slog.Default(), payload, "exec-"+pipelineID, "exec-1-"+pipelineID, false)
+		if err != nil {
+			t.Fatalf("Process %s failed: %v", pipelineID, err)
+		}
+		if len(result.Events) == 0 {
+			t.Fatalf("Process %s published no events", pipelineID)
+		}
+		return result.Events[0]
+	}
+
+	process("p1", "exec-1", false)
+	second := process("p2", "exec-1", false)
+	if calls != 1 {
+		t.Errorf("Expected the second pipeline to reuse the first's result, got %d calls", calls)
+	}
+	if !strings.Contains(second.Description, "call 1") || second.EnrichmentMetadata["shared_from_pipeline"] != "p1" {
+		t.Errorf("Expected p2 to get p1's shared result, got %q %v", second.Description, second.EnrichmentMetadata)
+	}
+
+	process("p3", "exec-1", false)
+	if calls != 2 {
+		t.Errorf("Expected a differently configured provider to run again, got %d calls", calls)
+	}
+
+	process("p2", "exec-2", false)
+	process("p2", "exec-2", true)
+	if calls != 4 {
+		t.Errorf("Expected other fan-outs and reposts to run the provider, got %d calls", calls)
+	}
+}
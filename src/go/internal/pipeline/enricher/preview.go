@@ -0,0 +1,101 @@
+package enricher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+// Preview runs a pipeline's enrichers over an activity as a dry run and
+// returns what the destinations would receive. Nothing is written to Firestore
+// or storage, no FIT file is generated, no events are published and no
+// notifications are sent. Invalid activities return a framework.TerminalError.
+func Preview(ctx context.Context, userId string, pipelineId string, activity *pbactivity.StandardizedActivity) (*pbpipeline.PipelinePreview, error) {
+	svc, err := initService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service init failed: %w", err)
+	}
+	return preview(ctx, svc, userId, pipelineId, activity)
+}
+
+func preview(ctx context.Context, svc *bootstrap.Service, userId string, pipelineId string, activity *pbactivity.StandardizedActivity) (*pbpipeline.PipelinePreview, error) {
+	ctx = providers.WithDryRun(ctx)
+	previewID := "preview-" + uuid.NewString()
+	logger := bootstrap.NewLogger("enricher", false).With("user_id", userId, "pipeline_id", pipelineId, "preview_id", previewID)
+
+	activity = proto.Clone(activity).(*pbactivity.StandardizedActivity)
+	activity.UserId = userId
+	payload := &pbevents.ActivityPayload{
+		UserId:               userId,
+		Source:               activity.Source,
+		PipelineId:           &pipelineId,
+		Timestamp:            timestamppb.Now(),
+		StandardizedActivity: activity,
+	}
+
+	// doNotRetry: a preview can't wait for lagging data, so providers return
+	// what they have
+	result, err := newOrchestrator(svc, nil).Process(ctx, logger, payload, previewID, previewID, true)
+	if err != nil {
+		return nil, err
+	}
+	return buildPreview(result), nil
+}
+
+// buildPreview summarizes a dry run's result. The first event carries the
+// full enrichment; the others only differ in which destinations get it.
+func buildPreview(result *ProcessResult) *pbpipeline.PipelinePreview {
+	p := &pbpipeline.PipelinePreview{
+		Status:   result.Status,
+		Boosters: boostersToProto(result.ProviderExecutions),
+	}
+	if len(result.Events) == 0 {
+		if p.Status == pbpipeline.ExecutionStatus_STATUS_SUCCESS {
+			p.Status = pbpipeline.ExecutionStatus_STATUS_SKIPPED
+		}
+		return p
+	}
+
+	first := result.Events[0]
+	p.Name = first.Name
+	p.Description = first.Description
+	p.ActivityType = first.ActivityType
+	p.AppliedEnrichments = first.AppliedEnrichments
+	p.EnrichmentMetadata = first.EnrichmentMetadata
+	for _, e := range result.Events {
+		p.Destinations = append(p.Destinations, &pbpipeline.DestinationPreview{
+			Destinations: e.Destinations,
+			Name:         e.Name,
+			Description:  e.Description,
+		})
+	}
+	return p
+}
+
+func boostersToProto(execs []ProviderExecution) []*pbpipeline.BoosterExecution {
+	boosters := make([]*pbpipeline.BoosterExecution, 0, len(execs))
+	for _, pe := range execs {
+		b := &pbpipeline.BoosterExecution{
+			ProviderName:           pe.ProviderName,
+			Status:                 pe.Status,
+			DurationMs:             pe.DurationMs,
+			Metadata:               pe.Metadata,
+			ContributedDescription: pe.ContributedDescription,
+		}
+		if pe.Error != "" {
+			errMsg := pe.Error
+			b.Error = &errMsg
+		}
+		boosters = append(boosters, b)
+	}
+	return boosters
+}
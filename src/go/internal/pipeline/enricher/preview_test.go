@@ -0,0 +1,147 @@
+package enricher
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	user "github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+func TestPreview_WritesNothing(t *testing.T) {
+	var writes []string
+	mockDB := &mocks.MockDatabase{
+		SetExecutionFunc: func(ctx context.Context, record *pbpipeline.ExecutionRecord) error {
+			writes = append(writes, "execution:"+record.Service)
+			return nil
+		},
+		UpdateExecutionFunc: func(ctx context.Context, userId string, id string, data map[string]interface{}) error {
+			writes = append(writes, "execution update:"+id)
+			return nil
+		},
+		AddPipelineRunCostFunc: func(ctx context.Context, userId string, id string, cost *pbpipeline.RunCost) error {
+			writes = append(writes, "run cost:"+id)
+			return nil
+		},
+		GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+			return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+		},
+		GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+			return []*pbpipeline.PipelineConfig{
+				{
+					Id:           "pipe-1",
+					Source:       "SOURCE_HEVY",
+					Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+					Enrichers: []*pbpipeline.EnricherConfig{
+						{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MOCK},
+					},
+				},
+			}, nil
+		},
+	}
+	mockStore := &mocks.MockBlobStore{
+		WriteFunc: func(ctx context.Context, bucket, object string, data []byte) error {
+			writes = append(writes, "blob:"+object)
+			return nil
+		},
+	}
+	mockPub := &mocks.MockPublisher{
+		PublishCloudEventFunc: func(ctx context.Context, topic string, e cloudevents.Event) (string, error) {
+			writes = append(writes, "publish:"+topic)
+			return "msg-1", nil
+		},
+	}
+
+	providers.ClearRegistry()
+	defer providers.ClearRegistry()
+	providers.Register(&MockProvider{
+		NameFunc:         func() string { return "mock-enricher" },
+		ProviderTypeFunc: func() pbplugin.EnricherProviderType { return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MOCK },
+		EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+			if !providers.IsDryRun(ctx) {
+				t.Error("Expected provider to see a dry-run context")
+			}
+			return &providers.EnrichmentResult{
+				Name:        "Leg Day",
+				Description: "Enriched by mock provider",
+			}, nil
+		},
+	})
+
+	testSvc := &bootstrap.Service{
+		DB:     mockDB,
+		Pub:    mockPub,
+		Store:  mockStore,
+		Config: &bootstrap.Config{ProjectID: "test-project"},
+	}
+	activity := &pbactivity.StandardizedActivity{
+		Source:    pbactivity.ActivitySource_SOURCE_HEVY,
+		StartTime: timestamppb.New(time.Now()),
+		Type:      pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING,
+		Sessions:  []*pbactivity.Session{{TotalElapsedTime: 3600}},
+	}
+
+	got, err := preview(context.Background(), testSvc, "user-1", "pipe-1", activity)
+	if err != nil {
+		t.Fatalf("preview failed: %v", err)
+	}
+
+	if len(writes) > 0 {
+		t.Errorf("Expected no writes during a preview, got %v", writes)
+	}
+	if got.Status != pbpipeline.ExecutionStatus_STATUS_SUCCESS {
+		t.Errorf("Expected SUCCESS, got %v", got.Status)
+	}
+	if got.Name != "Leg Day" {
+		t.Errorf("Expected name 'Leg Day', got %q", got.Name)
+	}
+	if len(got.Destinations) != 1 || got.Destinations[0].Destinations[0] != pbplugin.DestinationType_DESTINATION_STRAVA {
+		t.Errorf("Expected one Strava destination, got %v", got.Destinations)
+	}
+	if len(got.Boosters) != 1 || got.Boosters[0].ProviderName != "mock-enricher" {
+		t.Errorf("Expected the mock booster execution, got %v", got.Boosters)
+	}
+	if activity.UserId != "" {
+		t.Error("Expected the caller's activity to be left untouched")
+	}
+}
+
+func TestDryRunDatabase_KeepsUserUpdates(t *testing.T) {
+	var setExecutions, userUpdates int
+	db := &dryRunDatabase{Database: &mocks.MockDatabase{
+		SetExecutionFunc: func(ctx context.Context, record *pbpipeline.ExecutionRecord) error {
+			setExecutions++
+			return nil
+		},
+		UpdateUserFunc: func(ctx context.Context, id string, data map[string]interface{}) error {
+			userUpdates++
+			return nil
+		},
+	}}
+	ctx := providers.WithDryRun(context.Background())
+
+	_ = db.SetExecution(ctx, &pbpipeline.ExecutionRecord{})
+	_ = db.UpdateUser(ctx, "user-1", map[string]interface{}{"integrations.strava.refresh_token": "rotated"})
+	if setExecutions != 0 {
+		t.Errorf("Expected SetExecution to be dropped in a dry run, got %d calls", setExecutions)
+	}
+	if userUpdates != 1 {
+		t.Errorf("Expected UpdateUser to pass through in a dry run, got %d calls", userUpdates)
+	}
+
+	_ = db.SetExecution(context.Background(), &pbpipeline.ExecutionRecord{})
+	if setExecutions != 1 {
+		t.Errorf("Expected SetExecution to write outside a dry run, got %d calls", setExecutions)
+	}
+}
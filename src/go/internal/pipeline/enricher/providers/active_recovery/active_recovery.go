@@ -0,0 +1,305 @@
+package active_recovery
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/description"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/oauth"
+	oura "github.com/fitglue/server/src/go/pkg/integrations/oura"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+const (
+	boosterId = "active_recovery"
+
+	// recoveryAdvisorBoosterId holds the daily TRIMP Recovery Advisor keeps,
+	// which covers days before this enricher was added to a pipeline.
+	recoveryAdvisorBoosterId = "recovery_advisor"
+
+	ouraBaseURL = "https://api.ouraring.com"
+
+	// easyTrimp is the session load below which an activity counts as easy,
+	// matching the Recovery and Easy bands of Training Load.
+	easyTrimp = 60.0
+)
+
+// restDayTypes are treated as easy when the activity has no heart rate.
+var restDayTypes = map[pbactivity.ActivityType]bool{
+	pbactivity.ActivityType_ACTIVITY_TYPE_WALK:    true,
+	pbactivity.ActivityType_ACTIVITY_TYPE_YOGA:    true,
+	pbactivity.ActivityType_ACTIVITY_TYPE_PILATES: true,
+}
+
+// ActiveRecovery appends a short suggestion to easy and rest-day activities
+// based on the previous day's training load and, if Oura is connected, the
+// day's readiness score.
+//
+// Every activity's load is recorded per local day so the next day's
+// suggestion sees hard sessions too, even though only easy ones get a
+// suggestion.
+type ActiveRecovery struct {
+	Service *bootstrap.Service
+}
+
+func init() {
+	providers.Register(NewActiveRecovery())
+}
+
+func NewActiveRecovery() *ActiveRecovery {
+	return &ActiveRecovery{}
+}
+
+func (p *ActiveRecovery) SetService(service *bootstrap.Service) {
+	p.Service = service
+}
+
+func (p *ActiveRecovery) Name() string {
+	return "active-recovery"
+}
+
+func (p *ActiveRecovery) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ACTIVE_RECOVERY
+}
+
+func (p *ActiveRecovery) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	return p.EnrichWithClient(ctx, logger, activity, user, inputs, nil, doNotRetry)
+}
+
+// EnrichWithClient allows HTTP client injection for testing
+func (p *ActiveRecovery) EnrichWithClient(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, httpClient *http.Client, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	if activity.StartTime == nil {
+		return nil, fmt.Errorf("invalid start time: missing")
+	}
+
+	// 1. Resolve the activity's local day and the day before it
+	loc := time.UTC
+	if tz := strings.TrimSpace(inputs["timezone"]); tz != "" {
+		l, err := time.LoadLocation(tz)
+		if err != nil {
+			return skipped(fmt.Sprintf("Invalid timezone %q", tz)), nil
+		}
+		loc = l
+	}
+	localStart := activity.StartTime.AsTime().In(loc)
+	day := localStart.Format("2006-01-02")
+	yesterday := localStart.AddDate(0, 0, -1).Format("2006-01-02")
+
+	// 2. Session load, recorded whether or not the activity is easy
+	trimp, hasHR := sessionTrimp(activity, inputs)
+	sessionKey := inputs["external_id"]
+	if sessionKey == "" {
+		sessionKey = strconv.FormatInt(localStart.Unix(), 10)
+	}
+
+	var data, advisorData map[string]interface{}
+	if p.Service != nil && p.Service.DB != nil {
+		var err error
+		if data, err = p.Service.DB.GetBoosterData(ctx, user.UserId, boosterId); err != nil {
+			logger.Warn("Failed to fetch active recovery data", "error", err)
+		}
+		if advisorData, err = p.Service.DB.GetBoosterData(ctx, user.UserId, recoveryAdvisorBoosterId); err != nil {
+			logger.Warn("Failed to fetch recovery advisor data", "error", err)
+		}
+
+		// Keyed by activity so re-processing the same activity doesn't add its load twice
+		update := map[string]interface{}{
+			day: map[string]interface{}{sessionKey: trimp},
+		}
+		if err := p.Service.DB.SetBoosterData(ctx, user.UserId, boosterId, update); err != nil {
+			logger.Warn("Failed to save active recovery data", "error", err)
+		}
+	}
+
+	easy := trimp < easyTrimp
+	if !hasHR {
+		easy = restDayTypes[activity.Type]
+	}
+	if !easy {
+		logger.Debug("active_recovery: not an easy activity", "trimp", trimp, "type", activity.Type.String())
+		return skipped("Not a rest-day or easy activity"), nil
+	}
+
+	// 3. Yesterday's load, preferring whichever source saw more of it
+	load, hasLoad := yesterdayLoad(data, advisorData, yesterday)
+
+	// 4. Today's readiness, if Oura is connected
+	var readiness *int
+	if user.Integrations != nil && user.Integrations.Oura != nil && user.Integrations.Oura.Enabled {
+		score, err := p.fetchReadiness(ctx, logger, user, day, httpClient)
+		if err != nil {
+			logger.Warn("Failed to fetch Oura readiness, continuing without it", "error", err)
+		}
+		readiness = score
+	}
+
+	if !hasLoad && readiness == nil {
+		return skipped("No training load for yesterday or readiness for today"), nil
+	}
+
+	// 5. Build description
+	suggestion := suggest(load, readiness)
+	sectionHeader := providers.SectionHeader(user, description.SectionActiveRecovery)
+	var sb strings.Builder
+	sb.WriteString(sectionHeader + "\n")
+	sb.WriteString("💡 " + suggestion)
+
+	var details []string
+	if hasLoad {
+		details = append(details, fmt.Sprintf("Yesterday: %.0f TRIMP", load))
+	}
+	if readiness != nil {
+		details = append(details, fmt.Sprintf("Readiness: %d", *readiness))
+	}
+	sb.WriteString("\n• " + strings.Join(details, " • "))
+
+	metadata := map[string]string{
+		"active_recovery_status": "success",
+		"active_recovery_day":    day,
+		"yesterday_load":         fmt.Sprintf("%.0f", load),
+		"session_trimp":          fmt.Sprintf("%.0f", trimp),
+	}
+	if readiness != nil {
+		metadata["readiness_score"] = strconv.Itoa(*readiness)
+	}
+
+	logger.Info("Added active recovery suggestion", "day", day, "yesterday_load", load, "readiness", metadata["readiness_score"])
+
+	return &providers.EnrichmentResult{
+		Description:   sb.String(),
+		SectionHeader: sectionHeader,
+		Metadata:      metadata,
+	}, nil
+}
+
+func skipped(detail string) *providers.EnrichmentResult {
+	return &providers.EnrichmentResult{
+		Metadata: map[string]string{
+			"active_recovery_status": "skipped",
+			"status_detail":          detail,
+		},
+	}
+}
+
+// sessionTrimp estimates the activity's TRIMP from its average heart rate,
+// using the same model and inputs as Recovery Advisor. Reports false if the
+// activity has no heart rate, in which case the load is estimated from
+// duration.
+func sessionTrimp(activity *pbactivity.StandardizedActivity, inputs map[string]string) (float64, bool) {
+	maxHR := 190.0
+	restHR := 60.0
+	if v, err := strconv.ParseFloat(inputs["max_hr"], 64); err == nil {
+		maxHR = v
+	}
+	if v, err := strconv.ParseFloat(inputs["rest_hr"], 64); err == nil {
+		restHR = v
+	}
+	genderCoeff := 1.92
+	if inputs["gender"] == "female" {
+		genderCoeff = 1.67
+	}
+
+	var durationMinutes, hrSum float64
+	var hrSamples int
+	for _, session := range activity.Sessions {
+		durationMinutes += session.TotalElapsedTime / 60
+		for _, lap := range session.Laps {
+			for _, record := range lap.Records {
+				if record.HeartRate > 0 {
+					hrSum += float64(record.HeartRate)
+					hrSamples++
+				}
+			}
+		}
+	}
+
+	hrRange := maxHR - restHR
+	if hrSamples == 0 || hrRange <= 0 {
+		return durationMinutes * 0.5, false
+	}
+	hrReserve := math.Max(0, math.Min(1, (hrSum/float64(hrSamples)-restHR)/hrRange))
+	return durationMinutes * hrReserve * 0.64 * math.Exp(genderCoeff*hrReserve), true
+}
+
+// yesterdayLoad returns the TRIMP recorded for day, as the larger of this
+// enricher's per-activity loads and Recovery Advisor's daily total.
+func yesterdayLoad(data, advisorData map[string]interface{}, day string) (float64, bool) {
+	var load float64
+	found := false
+	if sessions, ok := data[day].(map[string]interface{}); ok {
+		for _, v := range sessions {
+			load += providers.ToFloat64(v)
+			found = true
+		}
+	}
+	if v, ok := advisorData[day]; ok {
+		load = math.Max(load, providers.ToFloat64(v))
+		found = true
+	}
+	return load, found
+}
+
+// fetchReadiness returns the Oura readiness score filed under day, or nil if
+// there is none yet. Readiness only sharpens the suggestion, so a missing
+// score is never worth retrying for.
+func (p *ActiveRecovery) fetchReadiness(ctx context.Context, logger *slog.Logger, user *user.Record, day string, httpClient *http.Client) (*int, error) {
+	if httpClient == nil {
+		tokenSource := oauth.NewFirestoreTokenSource(p.Service, user.UserId, "oura")
+		httpClient = oauth.NewClientWithUsageTracking(tokenSource, p.Service, user.UserId, "oura", infra.WrapSlogLogger(logger))
+	}
+	client, err := oura.NewClientWithResponses(ouraBaseURL, oura.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create oura client: %w", err)
+	}
+
+	start := day
+	d, _ := time.Parse("2006-01-02", day)
+	end := d.AddDate(0, 0, 1).Format("2006-01-02")
+	resp, err := client.MultipleDailyReadinessDocumentsV2UsercollectionDailyReadinessGetWithResponse(ctx, &oura.MultipleDailyReadinessDocumentsV2UsercollectionDailyReadinessGetParams{StartDate: &start, EndDate: &end})
+	if err != nil {
+		return nil, fmt.Errorf("oura readiness request failed: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("oura readiness api error %d: %s", resp.StatusCode(), string(resp.Body))
+	}
+	for _, r := range resp.JSON200.Data {
+		if r.Day.String() == day && r.Score != nil {
+			return r.Score, nil
+		}
+	}
+	return nil, nil
+}
+
+// suggest picks the advice line. Low readiness outranks yesterday's load,
+// and the two together call for the most caution.
+func suggest(load float64, readiness *int) string {
+	lowReadiness := readiness != nil && *readiness < 70
+	switch {
+	case lowReadiness && load >= 90:
+		return "Hard day yesterday and readiness is low — take a full rest day or keep it under 30 min Z1"
+	case lowReadiness:
+		return "Readiness is low — keep today under 45 min Z1"
+	case load >= 150:
+		return "Yesterday was high load — keep today under 60 min Z1/Z2"
+	case load >= 90:
+		return "Hard day yesterday — keep today easy, under 75 min Z1/Z2"
+	case readiness != nil && *readiness >= 85:
+		return "Well recovered — an easy day now keeps you fresh for the next hard session"
+	default:
+		return "Light load yesterday — easy aerobic work of any length is fine today"
+	}
+}
@@ -0,0 +1,177 @@
+package active_recovery
+
+import (
+	"github.com/fitglue/server/src/go/pkg/description"
+	user "github.com/fitglue/server/src/go/pkg/domain/user"
+
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var startTime = time.Date(2026, 5, 12, 18, 0, 0, 0, time.UTC)
+
+func makeActivity(activityType pbactivity.ActivityType, durationMinutes int, heartRate int32) *pbactivity.StandardizedActivity {
+	var records []*pbactivity.Record
+	for i := 0; i < durationMinutes; i++ {
+		records = append(records, &pbactivity.Record{HeartRate: heartRate})
+	}
+	return &pbactivity.StandardizedActivity{
+		Name:      "Evening Walk",
+		Type:      activityType,
+		StartTime: timestamppb.New(startTime),
+		Sessions: []*pbactivity.Session{{
+			TotalElapsedTime: float64(durationMinutes * 60),
+			Laps:             []*pbactivity.Lap{{Records: records}},
+		}},
+	}
+}
+
+// boosterDB serves fixed booster data and records what the provider saves.
+func boosterDB(data, advisor map[string]interface{}, saved *map[string]interface{}) *mocks.MockDatabase {
+	return &mocks.MockDatabase{
+		GetBoosterDataFunc: func(ctx context.Context, userId string, boosterId string) (map[string]interface{}, error) {
+			if boosterId == recoveryAdvisorBoosterId {
+				return advisor, nil
+			}
+			return data, nil
+		},
+		SetBoosterDataFunc: func(ctx context.Context, userId string, boosterId string, d map[string]interface{}) error {
+			if saved != nil {
+				*saved = d
+			}
+			return nil
+		},
+	}
+}
+
+func ouraClient(t *testing.T, readinessJSON string) *http.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/usercollection/daily_readiness" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(readinessJSON))
+	}))
+	t.Cleanup(server.Close)
+	return &http.Client{Transport: &mockTransport{testServer: server.URL}}
+}
+
+func testUser(oura bool) *user.Record {
+	u := &user.Record{UserProfile: &pbuser.UserProfile{UserId: "test-user"}}
+	if oura {
+		u.Integrations = &pbuser.UserIntegrations{Oura: &pbuser.OuraIntegration{Enabled: true}}
+	}
+	return u
+}
+
+func TestActiveRecovery_HighLoadYesterday(t *testing.T) {
+	var saved map[string]interface{}
+	data := map[string]interface{}{
+		"2026-05-11": map[string]interface{}{"strava:1": 120.0, "strava:2": 45.0},
+	}
+	provider := NewActiveRecovery()
+	provider.SetService(&bootstrap.Service{DB: boosterDB(data, nil, &saved)})
+
+	res, err := provider.Enrich(context.Background(), slog.Default(), makeActivity(pbactivity.ActivityType_ACTIVITY_TYPE_WALK, 30, 95), testUser(false), map[string]string{"external_id": "strava:3"}, false)
+	if err != nil {
+		t.Fatalf("Enrich failed: %v", err)
+	}
+
+	header := description.Header(description.SectionActiveRecovery, nil)
+	for _, want := range []string{header, "Yesterday was high load — keep today under 60 min Z1/Z2", "Yesterday: 165 TRIMP"} {
+		if !strings.Contains(res.Description, want) {
+			t.Errorf("Expected %q in description, got:\n%s", want, res.Description)
+		}
+	}
+	if res.SectionHeader != header {
+		t.Errorf("Expected section header %q, got %q", header, res.SectionHeader)
+	}
+
+	today, ok := saved["2026-05-12"].(map[string]interface{})
+	if !ok || today["strava:3"] == nil {
+		t.Errorf("Expected the session load saved under its day and external ID, got %v", saved)
+	}
+}
+
+func TestActiveRecovery_UsesRecoveryAdvisorLoad(t *testing.T) {
+	provider := NewActiveRecovery()
+	provider.SetService(&bootstrap.Service{DB: boosterDB(nil, map[string]interface{}{"2026-05-11": 100.0}, nil)})
+
+	res, err := provider.Enrich(context.Background(), slog.Default(), makeActivity(pbactivity.ActivityType_ACTIVITY_TYPE_RUN, 30, 110), testUser(false), map[string]string{}, false)
+	if err != nil {
+		t.Fatalf("Enrich failed: %v", err)
+	}
+	if !strings.Contains(res.Description, "Hard day yesterday — keep today easy") || res.Metadata["yesterday_load"] != "100" {
+		t.Errorf("Expected a hard-day suggestion from Recovery Advisor's load, got:\n%s\n%v", res.Description, res.Metadata)
+	}
+}
+
+func TestActiveRecovery_LowReadiness(t *testing.T) {
+	provider := NewActiveRecovery()
+	provider.SetService(&bootstrap.Service{DB: boosterDB(map[string]interface{}{"2026-05-11": map[string]interface{}{"a": 95.0}}, nil, nil)})
+	client := ouraClient(t, `{"data": [{"id": "r1", "day": "2026-05-12", "score": 62, "contributors": {}, "timestamp": "2026-05-12T00:00:00+00:00"}]}`)
+
+	res, err := provider.EnrichWithClient(context.Background(), slog.Default(), makeActivity(pbactivity.ActivityType_ACTIVITY_TYPE_YOGA, 45, 0), testUser(true), map[string]string{}, client, false)
+	if err != nil {
+		t.Fatalf("Enrich failed: %v", err)
+	}
+	if !strings.Contains(res.Description, "take a full rest day or keep it under 30 min Z1") || res.Metadata["readiness_score"] != "62" {
+		t.Errorf("Expected a rest-day suggestion, got:\n%s\n%v", res.Description, res.Metadata)
+	}
+}
+
+func TestActiveRecovery_Skips(t *testing.T) {
+	load := map[string]interface{}{"2026-05-11": map[string]interface{}{"a": 160.0}}
+
+	tests := []struct {
+		name     string
+		activity *pbactivity.StandardizedActivity
+		data     map[string]interface{}
+		inputs   map[string]string
+	}{
+		{"hard session", makeActivity(pbactivity.ActivityType_ACTIVITY_TYPE_RUN, 60, 165), load, nil},
+		{"run without heart rate", makeActivity(pbactivity.ActivityType_ACTIVITY_TYPE_RUN, 30, 0), load, nil},
+		{"no load or readiness", makeActivity(pbactivity.ActivityType_ACTIVITY_TYPE_WALK, 30, 0), nil, nil},
+		{"invalid timezone", makeActivity(pbactivity.ActivityType_ACTIVITY_TYPE_WALK, 30, 0), load, map[string]string{"timezone": "Mars/Olympus"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := NewActiveRecovery()
+			provider.SetService(&bootstrap.Service{DB: boosterDB(tt.data, nil, nil)})
+
+			res, err := provider.Enrich(context.Background(), slog.Default(), tt.activity, testUser(false), tt.inputs, false)
+			if err != nil {
+				t.Fatalf("Enrich failed: %v", err)
+			}
+			if res.Description != "" || res.Metadata["active_recovery_status"] != "skipped" {
+				t.Errorf("Expected skipped result, got %q %v", res.Description, res.Metadata)
+			}
+		})
+	}
+}
+
+type mockTransport struct {
+	testServer string
+}
+
+func (m *mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Redirect to test server
+	req.URL.Scheme = "http"
+	req.URL.Host = m.testServer[7:] // Remove "http://"
+	return http.DefaultTransport.RoundTrip(req)
+}
@@ -34,6 +34,11 @@ func (p *ActivityFilterProvider) ProviderType() pbplugin.EnricherProviderType {
 	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ACTIVITY_FILTER
 }
 
+// IsEssential keeps the filter running past the execution budget; skipping it would publish filtered activities.
+func (p *ActivityFilterProvider) IsEssential() bool {
+	return true
+}
+
 func (p *ActivityFilterProvider) Enrich(ctx context.Context, logger *slog.Logger, act *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
 	logger.Debug("activity_filter: starting",
 		"activity_type", act.Type.String(),
@@ -13,10 +13,12 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/cost"
 	"github.com/fitglue/server/src/go/pkg/domain/tier"
 
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
@@ -32,6 +34,10 @@ import (
 // Generated images are stored in Cloud Storage and referenced in activity metadata.
 type AIBannerProvider struct {
 	Service *bootstrap.Service
+
+	// Clients are created lazily by Init and shared across warm invocations.
+	genaiClient   *genai.Client
+	storageClient *storage.Client
 }
 
 func init() {
@@ -46,6 +52,30 @@ func (p *AIBannerProvider) SetService(service *bootstrap.Service) {
 	p.Service = service
 }
 
+// Init creates the shared Gemini and Cloud Storage clients. A missing API key is
+// not an init failure; Enrich reports it as a skip.
+func (p *AIBannerProvider) Init(ctx context.Context, svc *bootstrap.Service) error {
+	apiKey := os.Getenv("GEMINI_API_KEY")
+	if apiKey == "" {
+		return nil
+	}
+	clientCtx := context.WithoutCancel(ctx)
+
+	genaiClient, err := genai.NewClient(clientCtx, option.WithAPIKey(apiKey))
+	if err != nil {
+		return fmt.Errorf("failed to create Gemini client: %w", err)
+	}
+	storageClient, err := storage.NewClient(clientCtx)
+	if err != nil {
+		genaiClient.Close()
+		return fmt.Errorf("failed to create storage client: %w", err)
+	}
+
+	p.genaiClient = genaiClient
+	p.storageClient = storageClient
+	return nil
+}
+
 func (p *AIBannerProvider) Name() string {
 	return "ai-banner"
 }
@@ -58,6 +88,17 @@ func (p *AIBannerProvider) ShouldDefer() bool {
 	return true
 }
 
+// DefaultTimeout covers a text model call followed by image generation.
+func (p *AIBannerProvider) DefaultTimeout() time.Duration {
+	return 2 * time.Minute
+}
+
+// ShareResult lets the other pipelines of a fan-out reuse a generated
+// banner rather than paying for another image of the same activity.
+func (p *AIBannerProvider) ShareResult(res *providers.EnrichmentResult) bool {
+	return res.Metadata["status"] == "success"
+}
+
 func (p *AIBannerProvider) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
 	// Tier check - Athlete tier only
 	if tier.GetEffectiveTier(user) != tier.TierAthlete {
@@ -74,6 +115,16 @@ func (p *AIBannerProvider) Enrich(ctx context.Context, logger *slog.Logger, acti
 		}, nil
 	}
 
+	// Previews don't generate images: each one costs an Imagen call and a stored file
+	if providers.IsDryRun(ctx) {
+		return &providers.EnrichmentResult{
+			Metadata: map[string]string{
+				"status": "skipped",
+				"reason": "preview",
+			},
+		}, nil
+	}
+
 	// Get style configuration
 	style := inputs["style"]
 	if style == "" {
@@ -116,25 +167,47 @@ func (p *AIBannerProvider) Enrich(ctx context.Context, logger *slog.Logger, acti
 		}, nil
 	}
 
-	// Step 1: Build activity context (structured data)
-	activityContext := buildActivityContext(activity)
+	// Banners draw on two quotas: the images themselves and the tokens of
+	// the prompt that describes them
+	bannerQuota := providers.CheckQuota(ctx, logger, p.Service, user, tier.FeatureAIBanner)
+	if !bannerQuota.Allowed() {
+		logger.Info("AI Banner skipped: monthly banner quota used", "limit", bannerQuota.Limit)
+		return bannerQuota.ExceededResult(), nil
+	}
+	tokenQuota := providers.CheckQuota(ctx, logger, p.Service, user, tier.FeatureAITokens)
+	if !tokenQuota.Allowed() {
+		logger.Info("AI Banner skipped: monthly AI token quota used", "limit", tokenQuota.Limit)
+		return tokenQuota.ExceededResult(), nil
+	}
+	meteredCtx, meter := cost.WithSubMeter(ctx)
+	defer func() {
+		usage := meter.Usage()
+		tokenQuota.Record(ctx, usage.AIInputTokens+usage.AIOutputTokens)
+	}()
+
+	// Step 1: Build activity context (structured data), without the personal
+	// data the user hasn't agreed to share with the model
+	scrubber := providers.NewAIScrubber(user, activity, inputs)
+	scrubPolicy := scrubber.Policy()
+	activityContext := buildActivityContext(scrubber.Activity(activity))
 
 	// Include enriched description from other enrichers (injected by orchestrator Phase 2)
 	// The full booster output (muscle heatmap, heart rate, training load, etc.) provides
 	// valuable context for generating relevant fitness imagery.
 	if enrichedDesc := inputs["enriched_description"]; enrichedDesc != "" {
-		activityContext += "\n\nEnriched Activity Description:\n" + enrichedDesc
+		activityContext += "\n\nEnriched Activity Description:\n" + scrubber.Text(enrichedDesc)
 	}
 
 	// Step 2: Use text LLM to generate an image description
-	imagePrompt, err := p.generateImagePromptWithLLM(ctx, apiKey, activityContext, style, subject)
+	imagePrompt, err := p.generateImagePromptWithLLM(meteredCtx, apiKey, activityContext, style, subject)
 	if err != nil {
 		logger.Error("Failed to generate image prompt with LLM", "error", err)
 		return &providers.EnrichmentResult{
 			Metadata: map[string]string{
-				"status":        "error",
-				"reason":        "prompt_generation_failed",
-				"status_detail": err.Error(),
+				"status":                        "error",
+				"reason":                        "prompt_generation_failed",
+				"status_detail":                 err.Error(),
+				providers.MetadataAIScrubPolicy: scrubPolicy,
 			},
 		}, nil
 	}
@@ -145,14 +218,15 @@ func (p *AIBannerProvider) Enrich(ctx context.Context, logger *slog.Logger, acti
 	)
 
 	// Step 3: Generate image using Imagen with the LLM-generated prompt
-	imageData, err := p.generateBannerWithGemini(ctx, apiKey, imagePrompt)
+	imageData, err := p.generateBannerWithGemini(meteredCtx, apiKey, imagePrompt)
 	if err != nil {
 		logger.Error("Failed to generate AI banner", "error", err)
 		return &providers.EnrichmentResult{
 			Metadata: map[string]string{
-				"status":        "error",
-				"reason":        "generation_failed",
-				"status_detail": err.Error(),
+				"status":                        "error",
+				"reason":                        "generation_failed",
+				"status_detail":                 err.Error(),
+				providers.MetadataAIScrubPolicy: scrubPolicy,
 			},
 		}, nil // Don't return error to avoid pipeline failure
 	}
@@ -169,9 +243,10 @@ func (p *AIBannerProvider) Enrich(ctx context.Context, logger *slog.Logger, acti
 		logger.Error("Failed to store AI banner", "error", err)
 		return &providers.EnrichmentResult{
 			Metadata: map[string]string{
-				"status":        "error",
-				"reason":        "storage_failed",
-				"status_detail": err.Error(),
+				"status":                        "error",
+				"reason":                        "storage_failed",
+				"status_detail":                 err.Error(),
+				providers.MetadataAIScrubPolicy: scrubPolicy,
 			},
 		}, nil
 	}
@@ -182,13 +257,16 @@ func (p *AIBannerProvider) Enrich(ctx context.Context, logger *slog.Logger, acti
 		"style", style,
 	)
 
+	bannerQuota.Record(ctx, 1)
+
 	return &providers.EnrichmentResult{
-		Metadata: map[string]string{
-			"status":          "success",
-			"asset_ai_banner": bannerURL,
-			"style":           style,
-			"image_prompt":    imagePrompt,
-		},
+		Metadata: bannerQuota.AddMetadata(map[string]string{
+			"status":                        "success",
+			"asset_ai_banner":               bannerURL,
+			"style":                         style,
+			"image_prompt":                  imagePrompt,
+			providers.MetadataAIScrubPolicy: scrubPolicy,
+		}),
 	}, nil
 }
 
@@ -227,11 +305,15 @@ const fallbackPrompt = "Abstract digital art with colorful geometric shapes and
 // generateImagePromptWithLLM uses Gemini text model to generate a clean image description
 // from the activity context. This ensures the prompt is purely visual with no text elements.
 func (p *AIBannerProvider) generateImagePromptWithLLM(ctx context.Context, apiKey, activityContext, style, subject string) (string, error) {
-	client, err := genai.NewClient(ctx, option.WithAPIKey(apiKey))
-	if err != nil {
-		return "", fmt.Errorf("failed to create Gemini client: %w", err)
+	client := p.genaiClient
+	if client == nil {
+		c, err := genai.NewClient(ctx, option.WithAPIKey(apiKey))
+		if err != nil {
+			return "", fmt.Errorf("failed to create Gemini client: %w", err)
+		}
+		defer c.Close()
+		client = c
 	}
-	defer client.Close()
 
 	model := client.GenerativeModel("gemini-2.0-flash")
 
@@ -246,6 +328,12 @@ func (p *AIBannerProvider) generateImagePromptWithLLM(ctx context.Context, apiKe
 	if err != nil {
 		return "", fmt.Errorf("failed to generate image prompt: %w", err)
 	}
+	if resp.UsageMetadata != nil {
+		cost.Record(ctx, cost.Usage{
+			AIInputTokens:  int64(resp.UsageMetadata.PromptTokenCount),
+			AIOutputTokens: int64(resp.UsageMetadata.CandidatesTokenCount),
+		})
+	}
 
 	if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
 		return "", fmt.Errorf("no content generated")
@@ -633,6 +721,7 @@ func (p *AIBannerProvider) callImagenAPI(ctx context.Context, apiKey, prompt str
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
+	cost.Record(ctx, cost.Usage{ImageGenerations: 1})
 	defer resp.Body.Close()
 
 	// Read response body
@@ -685,11 +774,15 @@ func (p *AIBannerProvider) callImagenAPI(ctx context.Context, apiKey, prompt str
 }
 
 func (p *AIBannerProvider) storeImage(ctx context.Context, bucketName, objectPath string, data []byte) (string, error) {
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return "", fmt.Errorf("failed to create storage client: %w", err)
+	client := p.storageClient
+	if client == nil {
+		c, err := storage.NewClient(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to create storage client: %w", err)
+		}
+		defer c.Close()
+		client = c
 	}
-	defer client.Close()
 
 	bucket := client.Bucket(bucketName)
 	obj := bucket.Object(objectPath)
@@ -14,7 +14,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/tier"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -418,3 +421,36 @@ func equalFoldPrefix(s, prefix string) bool {
 	}
 	return true
 }
+
+func TestAIBanner_Enrich_QuotaExceeded(t *testing.T) {
+	t.Setenv("GEMINI_API_KEY", "test-key")
+	provider := NewAIBannerProvider()
+	provider.Service = &bootstrap.Service{DB: &mocks.MockDatabase{
+		GetUsageFunc: func(ctx context.Context, userID string, period string) (map[string]int64, error) {
+			return map[string]int64{string(tier.FeatureAIBanner): tier.AthleteTierAIBannersPerMonth}, nil
+		},
+	}}
+
+	activity := &pbactivity.StandardizedActivity{
+		ExternalId: "test-activity-123",
+		StartTime:  timestamppb.New(time.Now()),
+		Type:       pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+	}
+	user := &user.Record{
+		UserProfile: &pbuser.UserProfile{
+			UserId: "test-user",
+			Tier:   pbuser.UserTier_USER_TIER_ATHLETE,
+		},
+	}
+
+	result, err := provider.Enrich(context.Background(), slog.Default(), activity, user, nil, false)
+	if err != nil {
+		t.Fatalf("Enrich failed: %v", err)
+	}
+	if result.Metadata["reason"] != "quota_exceeded" {
+		t.Errorf("Expected reason=quota_exceeded, got %s", result.Metadata["reason"])
+	}
+	if result.Metadata[providers.MetadataQuotaRemaining] != "0" {
+		t.Errorf("Expected no quota remaining, got %s", result.Metadata[providers.MetadataQuotaRemaining])
+	}
+}
@@ -3,13 +3,16 @@ package ai_companion
 import (
 	"context"
 	"fmt"
+	"github.com/fitglue/server/src/go/pkg/description"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
 	"log/slog"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/cost"
 	"github.com/fitglue/server/src/go/pkg/domain/tier"
 
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
@@ -23,6 +26,9 @@ import (
 // This is an Athlete-tier only feature.
 type AICompanionProvider struct {
 	Service *bootstrap.Service
+
+	// client is created lazily by Init and shared across warm invocations.
+	client *genai.Client
 }
 
 func init() {
@@ -37,6 +43,21 @@ func (p *AICompanionProvider) SetService(service *bootstrap.Service) {
 	p.Service = service
 }
 
+// Init creates the shared Gemini client. A missing API key is not an init failure;
+// Enrich reports it as a skip.
+func (p *AICompanionProvider) Init(ctx context.Context, svc *bootstrap.Service) error {
+	apiKey := os.Getenv("GEMINI_API_KEY")
+	if apiKey == "" {
+		return nil
+	}
+	client, err := genai.NewClient(context.WithoutCancel(ctx), option.WithAPIKey(apiKey))
+	if err != nil {
+		return fmt.Errorf("failed to create Gemini client: %w", err)
+	}
+	p.client = client
+	return nil
+}
+
 func (p *AICompanionProvider) Name() string {
 	return "ai-companion"
 }
@@ -49,6 +70,11 @@ func (p *AICompanionProvider) ShouldDefer() bool {
 	return true
 }
 
+// DefaultTimeout leaves room for a slow model response.
+func (p *AICompanionProvider) DefaultTimeout() time.Duration {
+	return 90 * time.Second
+}
+
 func (p *AICompanionProvider) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
 	// Tier check - Athlete tier only
 	if tier.GetEffectiveTier(user) != tier.TierAthlete {
@@ -75,12 +101,15 @@ func (p *AICompanionProvider) Enrich(ctx context.Context, logger *slog.Logger, a
 
 	showSectionHeader := inputs["section_header"] != "false" // Default to true
 
-	// Build context from activity
-	activityContext := buildActivityContext(activity)
+	// Build context from activity, without the personal data the user hasn't
+	// agreed to share with the model
+	scrubber := providers.NewAIScrubber(user, activity, inputs)
+	scrubPolicy := scrubber.Policy()
+	activityContext := buildActivityContext(scrubber.Activity(activity))
 
 	// Include enriched description from other enrichers (injected by orchestrator Phase 2)
 	if enrichedDesc := inputs["enriched_description"]; enrichedDesc != "" {
-		activityContext += "\n\nOther Enricher Descriptions:\n" + enrichedDesc
+		activityContext += "\n\nOther Enricher Descriptions:\n" + scrubber.Text(enrichedDesc)
 	}
 
 	// Get Gemini API key
@@ -98,21 +127,31 @@ func (p *AICompanionProvider) Enrich(ctx context.Context, logger *slog.Logger, a
 		}, nil
 	}
 
-	// Generate content using Gemini
-	result, err := p.generateWithGemini(ctx, apiKey, mode, activityContext)
+	quota := providers.CheckQuota(ctx, logger, p.Service, user, tier.FeatureAITokens)
+	if !quota.Allowed() {
+		logger.Info("AI Companion skipped: monthly AI token quota used", "limit", quota.Limit)
+		return quota.ExceededResult(), nil
+	}
+
+	// Generate content using Gemini, counting the tokens it uses
+	meteredCtx, meter := cost.WithSubMeter(ctx)
+	result, err := p.generateWithGemini(meteredCtx, apiKey, mode, activityContext)
+	usage := meter.Usage()
+	quota.Record(ctx, usage.AIInputTokens+usage.AIOutputTokens)
 	if err != nil {
 		logger.Error("Failed to generate AI companion content", "error", err)
 		return &providers.EnrichmentResult{
-			Metadata: map[string]string{
-				"status":        "error",
-				"reason":        "generation_failed",
-				"status_detail": err.Error(),
-			},
+			Metadata: quota.AddMetadata(map[string]string{
+				"status":                        "error",
+				"reason":                        "generation_failed",
+				"status_detail":                 err.Error(),
+				providers.MetadataAIScrubPolicy: scrubPolicy,
+			}),
 		}, nil // Don't return error to avoid pipeline failure
 	}
 
 	if showSectionHeader && result.Description != "" {
-		result.Description = "✨ AI Summary:\n" + result.Description
+		result.Description = providers.SectionHeader(user, description.SectionAISummary) + "\n" + result.Description
 	}
 
 	logger.Info("AI Companion content generated successfully",
@@ -124,10 +163,11 @@ func (p *AICompanionProvider) Enrich(ctx context.Context, logger *slog.Logger, a
 	return &providers.EnrichmentResult{
 		Name:        result.Title,
 		Description: result.Description,
-		Metadata: map[string]string{
-			"status": "success",
-			"mode":   mode,
-		},
+		Metadata: quota.AddMetadata(map[string]string{
+			"status":                        "success",
+			"mode":                          mode,
+			providers.MetadataAIScrubPolicy: scrubPolicy,
+		}),
 	}, nil
 }
 
@@ -137,11 +177,15 @@ type aiResult struct {
 }
 
 func (p *AICompanionProvider) generateWithGemini(ctx context.Context, apiKey, mode, activityContext string) (*aiResult, error) {
-	client, err := genai.NewClient(ctx, option.WithAPIKey(apiKey))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Gemini client: %w", err)
+	client := p.client
+	if client == nil {
+		c, err := genai.NewClient(ctx, option.WithAPIKey(apiKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Gemini client: %w", err)
+		}
+		defer c.Close()
+		client = c
 	}
-	defer client.Close()
 
 	model := client.GenerativeModel("gemini-2.0-flash")
 
@@ -156,6 +200,12 @@ func (p *AICompanionProvider) generateWithGemini(ctx context.Context, apiKey, mo
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate content: %w", err)
 	}
+	if resp.UsageMetadata != nil {
+		cost.Record(ctx, cost.Usage{
+			AIInputTokens:  int64(resp.UsageMetadata.PromptTokenCount),
+			AIOutputTokens: int64(resp.UsageMetadata.CandidatesTokenCount),
+		})
+	}
 
 	if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
 		return nil, fmt.Errorf("no content generated")
@@ -11,8 +11,11 @@ import (
 
 	"context"
 	"log/slog"
+	"strings"
 	"testing"
 
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -223,3 +226,22 @@ func TestCleanTitle_Truncation(t *testing.T) {
 		t.Errorf("Expected title truncation to 100 characters, but got length: %d", len(result))
 	}
 }
+
+func TestBuildActivityContext_ScrubsActivityName(t *testing.T) {
+	activity := &pbactivity.StandardizedActivity{
+		Name: "Leg day with Sam",
+		Type: pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING,
+	}
+	u := &user.Record{UserProfile: &pbuser.UserProfile{UserId: "user-1"}}
+
+	scrubbed := buildActivityContext(providers.NewAIScrubber(u, activity, nil).Activity(activity))
+	if strings.Contains(scrubbed, "Leg day with Sam") {
+		t.Errorf("expected activity name scrubbed from context:\n%s", scrubbed)
+	}
+
+	u.AiPrivacy = &pbuser.AIPrivacyPreferences{ShareNames: true}
+	shared := buildActivityContext(providers.NewAIScrubber(u, activity, nil).Activity(activity))
+	if !strings.Contains(shared, "Original Name: Leg day with Sam") {
+		t.Errorf("expected activity name in context after opting in:\n%s", shared)
+	}
+}
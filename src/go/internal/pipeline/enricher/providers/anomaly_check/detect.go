@@ -0,0 +1,216 @@
+package anomaly_check
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	activityPkg "github.com/fitglue/server/src/go/pkg/domain/activity"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+const (
+	defaultMaxHeartRate = 230
+	defaultMinPace      = 150 * time.Second // per km
+	defaultMaxFTP       = 1000.0
+
+	// paceWindow is how long a pace has to be held, in seconds, so a single
+	// GPS glitch isn't reported as a sprint.
+	paceWindow = 60
+	// ftpWindow and ftpFactor estimate FTP as 95% of the best 20 minutes.
+	ftpWindow = 20 * 60
+	ftpFactor = 0.95
+)
+
+// Anomaly kinds, used in metadata and to pick how a stretch is corrected.
+const (
+	kindHeartRate = "heart_rate"
+	kindPace      = "pace"
+	kindPower     = "power"
+)
+
+// limits are the values beyond which a reading is physiologically impossible.
+type limits struct {
+	MaxHeartRate int           // bpm, any single reading
+	MinPace      time.Duration // per km, held for paceWindow; runs only
+	MaxFTP       float64       // watts, estimated from the best 20 minutes
+}
+
+// parseLimits reads the configured limits, falling back to the defaults for
+// missing or unusable values so a typo never switches the check off.
+func parseLimits(inputs map[string]string) limits {
+	l := limits{MaxHeartRate: defaultMaxHeartRate, MinPace: defaultMinPace, MaxFTP: defaultMaxFTP}
+	if v, err := strconv.Atoi(strings.TrimSpace(inputs["max_heart_rate"])); err == nil && v > 0 {
+		l.MaxHeartRate = v
+	}
+	if v, err := parsePace(inputs["min_pace"]); err == nil {
+		l.MinPace = v
+	}
+	if v, err := strconv.ParseFloat(strings.TrimSpace(inputs["max_ftp"]), 64); err == nil && v > 0 {
+		l.MaxFTP = v
+	}
+	return l
+}
+
+// metadata stores the limits on the pending input so EnrichResume finds the
+// same stretches without the enricher's config.
+func (l limits) metadata() map[string]string {
+	return map[string]string{
+		"max_heart_rate": strconv.Itoa(l.MaxHeartRate),
+		"min_pace":       formatPace(l.MinPace),
+		"max_ftp":        strconv.FormatFloat(l.MaxFTP, 'f', -1, 64),
+	}
+}
+
+// parsePace accepts a pace per km as "m:ss".
+func parsePace(raw string) (time.Duration, error) {
+	mins, secs, ok := strings.Cut(strings.TrimSpace(raw), ":")
+	if !ok {
+		return 0, fmt.Errorf("expected m:ss")
+	}
+	m, err1 := strconv.Atoi(mins)
+	s, err2 := strconv.Atoi(secs)
+	if err1 != nil || err2 != nil || m < 0 || s < 0 || s >= 60 || m+s == 0 {
+		return 0, fmt.Errorf("expected m:ss")
+	}
+	return time.Duration(m*60+s) * time.Second, nil
+}
+
+func formatPace(d time.Duration) string {
+	total := int(d.Round(time.Second).Seconds())
+	return fmt.Sprintf("%d:%02d", total/60, total%60)
+}
+
+// anomaly is one kind of impossible value and the stretches it covers.
+type anomaly struct {
+	Kind   string
+	Field  activityPkg.RecordField // cleared when the user picks "correct"
+	Ranges []activityPkg.TimeRange
+	Peak   float64 // bpm, m/s or estimated FTP in watts
+}
+
+// describe explains the anomaly to the user, e.g. "Heart rate reached 252 bpm".
+func (a anomaly) describe() string {
+	switch a.Kind {
+	case kindHeartRate:
+		return fmt.Sprintf("Heart rate reached %.0f bpm", a.Peak)
+	case kindPace:
+		return fmt.Sprintf("Pace of %s/km held for a minute", formatPace(time.Duration(1000/a.Peak*float64(time.Second))))
+	case kindPower:
+		return fmt.Sprintf("Estimated FTP of %.0fW", a.Peak)
+	}
+	return a.Kind
+}
+
+// detect finds every reading beyond the limits. Each session is laid out on
+// a one-second timeline; seconds without a reading count as zero, which only
+// ever makes a sustained effort look easier.
+func detect(activity *pbactivity.StandardizedActivity, l limits) []anomaly {
+	found := map[string]*anomaly{}
+	add := func(kind string, field activityPkg.RecordField, start time.Time, spans [][2]int, peak float64) {
+		if len(spans) == 0 {
+			return
+		}
+		a, ok := found[kind]
+		if !ok {
+			a = &anomaly{Kind: kind, Field: field}
+			found[kind] = a
+		}
+		for _, s := range spans {
+			a.Ranges = append(a.Ranges, activityPkg.TimeRange{
+				Start: start.Add(time.Duration(s[0]) * time.Second),
+				End:   start.Add(time.Duration(s[1]) * time.Second),
+			})
+		}
+		a.Peak = max(a.Peak, peak)
+	}
+
+	checkPace := isRun(activity.Type) && l.MinPace > 0
+	for _, session := range activity.Sessions {
+		duration := int(session.TotalElapsedTime)
+		if duration <= 0 || session.StartTime == nil {
+			continue
+		}
+		start := session.StartTime.AsTime()
+		hr := make([]float64, duration)
+		speed := make([]float64, duration)
+		power := make([]float64, duration)
+
+		for _, lap := range session.Laps {
+			for i, r := range lap.Records {
+				if r.Timestamp == nil {
+					continue
+				}
+				offset := int(r.Timestamp.AsTime().Sub(start).Seconds())
+				if offset < 0 || offset >= duration {
+					continue
+				}
+				hr[offset] = float64(r.HeartRate)
+				power[offset] = float64(r.Power)
+				if checkPace {
+					speed[offset] = activityPkg.RecordSpeed(lap.Records, i)
+				}
+			}
+		}
+
+		spans, peak := sustained(hr, 1, float64(l.MaxHeartRate))
+		add(kindHeartRate, activityPkg.FieldHeartRate, start, spans, peak)
+		if checkPace {
+			spans, peak = sustained(speed, paceWindow, 1000/l.MinPace.Seconds())
+			add(kindPace, activityPkg.FieldDistance, start, spans, peak)
+		}
+		spans, peak = sustained(power, ftpWindow, l.MaxFTP/ftpFactor)
+		add(kindPower, activityPkg.FieldPower, start, spans, peak*ftpFactor)
+	}
+
+	var out []anomaly
+	for _, kind := range []string{kindHeartRate, kindPace, kindPower} {
+		if a, ok := found[kind]; ok {
+			out = append(out, *a)
+		}
+	}
+	return out
+}
+
+// sustained returns the spans of seconds where the rolling mean over window
+// exceeds limit, merged where they overlap, and the highest mean in them.
+func sustained(values []float64, window int, limit float64) ([][2]int, float64) {
+	if window <= 0 || len(values) < window {
+		return nil, 0
+	}
+
+	var sum float64
+	for _, v := range values[:window] {
+		sum += v
+	}
+
+	var spans [][2]int
+	var peak float64
+	for i := 0; ; i++ {
+		if mean := sum / float64(window); mean > limit {
+			peak = max(peak, mean)
+			if n := len(spans); n > 0 && i <= spans[n-1][1] {
+				spans[n-1][1] = i + window
+			} else {
+				spans = append(spans, [2]int{i, i + window})
+			}
+		}
+		if i+window >= len(values) {
+			break
+		}
+		sum += values[i+window] - values[i]
+	}
+	return spans, peak
+}
+
+func isRun(t pbactivity.ActivityType) bool {
+	switch t {
+	case pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		pbactivity.ActivityType_ACTIVITY_TYPE_TRAIL_RUN,
+		pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_RUN:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,177 @@
+// Package anomaly_check holds activities with physiologically impossible
+// readings for review before records are set or destinations updated.
+package anomaly_check
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/user_input"
+
+	activityPkg "github.com/fitglue/server/src/go/pkg/domain/activity"
+	pendinginput "github.com/fitglue/server/src/go/pkg/pending_input"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// Review actions, for the "action" config option and the pending input.
+const (
+	actionAsk     = "ask"
+	actionAccept  = "accept"  // keep the readings as recorded
+	actionTrim    = "trim"    // remove the records in the flagged stretches
+	actionCorrect = "correct" // keep the records but clear the impossible values
+)
+
+// AnomalyCheckProvider flags activities with readings no amateur could
+// produce (e.g. a 250 bpm heart rate, a 2:30/km pace held for a minute or a
+// 1000W FTP) and holds them until the user accepts, trims or corrects them.
+// Placed before Personal Records, a glitch can't set a PR.
+type AnomalyCheckProvider struct{}
+
+func init() {
+	providers.Register(NewAnomalyCheckProvider())
+}
+
+func NewAnomalyCheckProvider() *AnomalyCheckProvider {
+	return &AnomalyCheckProvider{}
+}
+
+func (p *AnomalyCheckProvider) Name() string {
+	return "anomaly-check"
+}
+
+func (p *AnomalyCheckProvider) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ANOMALY_CHECK
+}
+
+// SkipOnSourceUpdate avoids holding an activity for review again when the
+// user only edited its metadata.
+func (p *AnomalyCheckProvider) SkipOnSourceUpdate() bool { return true }
+
+// IsEssential keeps the check running past the execution budget; skipping it
+// would let impossible data reach destinations.
+func (p *AnomalyCheckProvider) IsEssential() bool { return true }
+
+func (p *AnomalyCheckProvider) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	l := parseLimits(inputs)
+	found := detect(activity, l)
+	if len(found) == 0 {
+		return &providers.EnrichmentResult{
+			Metadata: map[string]string{"anomaly_status": "ok"},
+		}, nil
+	}
+
+	summary := summarize(found)
+	logger.Info("anomaly-check: impossible readings detected",
+		"anomalies", kinds(found),
+		"summary", summary,
+	)
+
+	action := inputs["action"]
+	if action == "" {
+		action = actionAsk
+	}
+	if action != actionAsk {
+		return resolve(found, action)
+	}
+
+	linkedActivityId := inputs["activity_id"]
+	if linkedActivityId == "" {
+		return nil, fmt.Errorf("activity_id not provided in enricher inputs")
+	}
+
+	metadata := l.metadata()
+	for k, v := range map[string]string{
+		"source_activity_id":   activity.ExternalId,
+		"source_activity_type": activity.Source.String(),
+		"linked_activity_id":   linkedActivityId,
+		"pipeline_id":          inputs["pipeline_id"],
+		"anomalies":            kinds(found),
+		"display.field_labels": `{"action":"Action"}`,
+		"display.field_types":  `{"action":"select:options=accept,trim,correct"}`,
+		"display.summary":      summary,
+		"display.title":        "Review Impossible Readings",
+		"display.help":         "Accept keeps the data as recorded. Trim removes the flagged stretches. Correct keeps them but clears the impossible heart rate, power or distance",
+	} {
+		metadata[k] = v
+	}
+
+	// Halt until the user reviews the readings; later enrichers (including
+	// personal records) and destinations wait with it.
+	return nil, &user_input.WaitForInputError{
+		ActivityID:         pendinginput.GenerateID(activity.Source.String(), activity.ExternalId, p.Name()),
+		RequiredFields:     []string{"action"},
+		EnricherProviderID: p.Name(),
+		Metadata:           metadata,
+	}
+}
+
+// EnrichResume applies the action chosen by the user. The stretches are found
+// again with the limits stored on the pending input.
+func (p *AnomalyCheckProvider) EnrichResume(ctx context.Context, activity *pbactivity.StandardizedActivity, user *user.Record, pendingInput *pbpipeline.PendingInput) (*providers.EnrichmentResult, error) {
+	action := strings.ToLower(strings.TrimSpace(pendingInput.InputData["action"]))
+	found := detect(activity, parseLimits(pendingInput.ProviderMetadata))
+	return resolve(found, action)
+}
+
+// resolve turns the review action into changes to the activity.
+func resolve(found []anomaly, action string) (*providers.EnrichmentResult, error) {
+	res := &providers.EnrichmentResult{
+		Metadata: map[string]string{
+			"anomalies":      kinds(found),
+			"anomaly_action": action,
+		},
+	}
+
+	switch action {
+	case actionAccept:
+		res.Metadata["anomaly_status"] = "accepted"
+	case actionTrim:
+		var seconds time.Duration
+		for _, a := range found {
+			res.TrimRanges = append(res.TrimRanges, a.Ranges...)
+			for _, r := range a.Ranges {
+				seconds += r.End.Sub(r.Start)
+			}
+		}
+		res.Metadata["anomaly_status"] = "trimmed"
+		res.Metadata["flagged_seconds"] = strconv.Itoa(int(seconds.Seconds()))
+	case actionCorrect:
+		for _, a := range found {
+			for _, r := range a.Ranges {
+				res.ClearRanges = append(res.ClearRanges, activityPkg.FieldRange{Field: a.Field, TimeRange: r})
+			}
+		}
+		res.Metadata["anomaly_status"] = "corrected"
+	default:
+		return nil, fmt.Errorf("unknown anomaly action %q: expected accept, trim or correct", action)
+	}
+	return res, nil
+}
+
+func summarize(found []anomaly) string {
+	parts := make([]string, len(found))
+	for i, a := range found {
+		parts[i] = a.describe()
+	}
+	return strings.Join(parts, "; ")
+}
+
+func kinds(found []anomaly) string {
+	parts := make([]string, len(found))
+	for i, a := range found {
+		parts[i] = a.Kind
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,201 @@
+package anomaly_check
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/user_input"
+	activityPkg "github.com/fitglue/server/src/go/pkg/domain/activity"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+var testStart = time.Date(2026, 5, 2, 8, 0, 0, 0, time.UTC)
+
+// newRun builds a run of n seconds at 4 m/s and 150 bpm, one record per
+// second; edit lets a test add impossible readings.
+func newRun(n int, edit func(i int, r *pbactivity.Record)) *pbactivity.StandardizedActivity {
+	lap := &pbactivity.Lap{}
+	for i := 0; i < n; i++ {
+		r := &pbactivity.Record{
+			Timestamp: timestamppb.New(testStart.Add(time.Duration(i) * time.Second)),
+			HeartRate: 150,
+			Speed:     4,
+			Distance:  float64(i) * 4,
+		}
+		if edit != nil {
+			edit(i, r)
+		}
+		lap.Records = append(lap.Records, r)
+	}
+	return &pbactivity.StandardizedActivity{
+		Source:     pbactivity.ActivitySource_SOURCE_FILE_UPLOAD,
+		ExternalId: "ext-1",
+		Type:       pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		Sessions: []*pbactivity.Session{{
+			StartTime:        timestamppb.New(testStart),
+			TotalElapsedTime: float64(n),
+			TotalDistance:    float64(n-1) * 4,
+			Laps:             []*pbactivity.Lap{lap},
+		}},
+	}
+}
+
+// heartRateSpike has three seconds at 252 bpm from second 100.
+func heartRateSpike(i int, r *pbactivity.Record) {
+	if i >= 100 && i < 103 {
+		r.HeartRate = 252
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name     string
+		activity *pbactivity.StandardizedActivity
+		want     string
+		summary  string
+	}{
+		{
+			name:     "Heart rate",
+			activity: newRun(600, heartRateSpike),
+			want:     kindHeartRate,
+			summary:  "Heart rate reached 252 bpm",
+		},
+		{
+			name: "Pace held for a minute",
+			activity: newRun(600, func(i int, r *pbactivity.Record) {
+				if i >= 200 && i < 290 {
+					r.Speed = 8 // 2:05/km
+				}
+			}),
+			want:    kindPace,
+			summary: "Pace of 2:05/km held for a minute",
+		},
+		{
+			name: "FTP",
+			activity: func() *pbactivity.StandardizedActivity {
+				a := newRun(1800, func(i int, r *pbactivity.Record) { r.Power = 1100 })
+				a.Type = pbactivity.ActivityType_ACTIVITY_TYPE_RIDE
+				return a
+			}(),
+			want:    kindPower,
+			summary: "Estimated FTP of 1045W",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found := detect(tt.activity, parseLimits(nil))
+			if len(found) != 1 || found[0].Kind != tt.want {
+				t.Fatalf("Expected one %s anomaly, got %+v", tt.want, found)
+			}
+			if got := summarize(found); got != tt.summary {
+				t.Errorf("Expected summary %q, got %q", tt.summary, got)
+			}
+		})
+	}
+}
+
+func TestDetect_PlausibleActivity(t *testing.T) {
+	activity := newRun(600, func(i int, r *pbactivity.Record) {
+		if i >= 200 && i < 230 {
+			r.Speed = 8 // a 30 second sprint is fine
+		}
+		r.Power = 900 // short of a 1000W FTP
+	})
+	if found := detect(activity, parseLimits(nil)); len(found) != 0 {
+		t.Errorf("Expected no anomalies, got %+v", found)
+	}
+}
+
+func TestParseLimits(t *testing.T) {
+	l := parseLimits(map[string]string{"max_heart_rate": "210", "min_pace": "3:00", "max_ftp": "fast"})
+	if l.MaxHeartRate != 210 || l.MinPace != 3*time.Minute || l.MaxFTP != defaultMaxFTP {
+		t.Errorf("Unexpected limits %+v", l)
+	}
+}
+
+func TestEnrich_AsksForReview(t *testing.T) {
+	inputs := map[string]string{"activity_id": "a1", "pipeline_id": "p1", "max_heart_rate": "240"}
+	_, err := NewAnomalyCheckProvider().Enrich(context.Background(), slog.Default(), newRun(600, heartRateSpike), nil, inputs, false)
+
+	var waitErr *user_input.WaitForInputError
+	if !errors.As(err, &waitErr) {
+		t.Fatalf("Expected WaitForInputError, got %v", err)
+	}
+	if len(waitErr.RequiredFields) != 1 || waitErr.RequiredFields[0] != "action" {
+		t.Errorf("Unexpected required fields %v", waitErr.RequiredFields)
+	}
+	for k, want := range map[string]string{
+		"anomalies":          kindHeartRate,
+		"max_heart_rate":     "240",
+		"linked_activity_id": "a1",
+		"display.summary":    "Heart rate reached 252 bpm",
+	} {
+		if got := waitErr.Metadata[k]; got != want {
+			t.Errorf("Expected %s=%q, got %q", k, want, got)
+		}
+	}
+}
+
+func TestEnrich_CleanActivityPasses(t *testing.T) {
+	res, err := NewAnomalyCheckProvider().Enrich(context.Background(), slog.Default(), newRun(600, nil), nil, map[string]string{"activity_id": "a1"}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.Metadata["anomaly_status"] != "ok" {
+		t.Errorf("Expected status ok, got %q", res.Metadata["anomaly_status"])
+	}
+}
+
+func TestEnrichResume(t *testing.T) {
+	pending := func(action string) *pbpipeline.PendingInput {
+		return &pbpipeline.PendingInput{
+			InputData:        map[string]string{"action": action},
+			ProviderMetadata: parseLimits(nil).metadata(),
+		}
+	}
+	spike := activityPkg.TimeRange{Start: testStart.Add(100 * time.Second), End: testStart.Add(103 * time.Second)}
+
+	t.Run("Accept", func(t *testing.T) {
+		res, err := NewAnomalyCheckProvider().EnrichResume(context.Background(), newRun(600, heartRateSpike), nil, pending("accept"))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if res.Metadata["anomaly_status"] != "accepted" || len(res.TrimRanges)+len(res.ClearRanges) != 0 {
+			t.Errorf("Expected the data kept, got %+v", res)
+		}
+	})
+
+	t.Run("Trim", func(t *testing.T) {
+		res, err := NewAnomalyCheckProvider().EnrichResume(context.Background(), newRun(600, heartRateSpike), nil, pending("trim"))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(res.TrimRanges) != 1 || res.TrimRanges[0] != spike {
+			t.Errorf("Expected the spike trimmed, got %+v", res.TrimRanges)
+		}
+	})
+
+	t.Run("Correct", func(t *testing.T) {
+		res, err := NewAnomalyCheckProvider().EnrichResume(context.Background(), newRun(600, heartRateSpike), nil, pending(" Correct "))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := activityPkg.FieldRange{Field: activityPkg.FieldHeartRate, TimeRange: spike}
+		if len(res.ClearRanges) != 1 || res.ClearRanges[0] != want {
+			t.Errorf("Expected the spike's heart rate cleared, got %+v", res.ClearRanges)
+		}
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		if _, err := NewAnomalyCheckProvider().EnrichResume(context.Background(), newRun(600, heartRateSpike), nil, pending("ignore")); err == nil {
+			t.Error("Expected an error for an unknown action")
+		}
+	})
+}
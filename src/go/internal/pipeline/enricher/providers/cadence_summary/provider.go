@@ -3,6 +3,7 @@ package cadence_summary
 import (
 	"context"
 	"fmt"
+	"github.com/fitglue/server/src/go/pkg/description"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
 	"log/slog"
 	"math"
@@ -109,7 +110,7 @@ func (p *CadenceSummary) Enrich(ctx context.Context, logger *slog.Logger, activi
 
 	if showCorrelation {
 		// Multi-line bullet format with correlation
-		sb.WriteString("🦶 Cadence:\n")
+		sb.WriteString(providers.SectionHeader(user, description.SectionCadence) + "\n")
 		sb.WriteString(fmt.Sprintf("• %.0f %s avg\n", avgCadence, unit))
 		sb.WriteString(fmt.Sprintf("• %d %s max\n", maxCadence, unit))
 
@@ -0,0 +1,186 @@
+package consistency
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// lookbackDays bounds how far back a streak is counted, keeping the
+// pipeline_runs scan small. Longer streaks are shown as "90+ days".
+const lookbackDays = 90
+
+const dateLayout = "2006-01-02"
+
+// Consistency reports the user's activity streak, weekly totals and a
+// month-over-month comparison. Unlike the streak tracker it keeps no state of
+// its own: everything is counted from the user's pipeline runs, so
+// backfilled and out-of-order activities are always counted correctly.
+type Consistency struct {
+	Service *bootstrap.Service
+}
+
+func init() {
+	providers.Register(NewConsistency())
+}
+
+func NewConsistency() *Consistency {
+	return &Consistency{}
+}
+
+func (p *Consistency) SetService(service *bootstrap.Service) {
+	p.Service = service
+}
+
+func (p *Consistency) Name() string {
+	return "consistency"
+}
+
+func (p *Consistency) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_CONSISTENCY
+}
+
+func (p *Consistency) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	logger.Debug("consistency: starting", "activity_name", activity.Name)
+
+	showWeekly := inputs["show_weekly"] != "false"   // default true
+	showMonthly := inputs["show_monthly"] != "false" // default true
+
+	if p.Service == nil || p.Service.DB == nil {
+		return nil, fmt.Errorf("service not initialized")
+	}
+
+	now := time.Now()
+	if activity.StartTime != nil {
+		now = activity.StartTime.AsTime()
+	}
+	day := truncateDay(now)
+
+	// The query has to reach both the start of the streak window and the
+	// start of last month
+	since := day.AddDate(0, 0, -lookbackDays)
+	if lastMonth := time.Date(day.Year(), day.Month()-1, 1, 0, 0, 0, 0, time.UTC); lastMonth.Before(since) {
+		since = lastMonth
+	}
+	counts, err := p.Service.DB.GetActivityDayCounts(ctx, user.UserId, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activity stats: %w", err)
+	}
+	if counts == nil {
+		counts = map[string]int{}
+	}
+	// This activity's own run may not have been written yet
+	if counts[day.Format(dateLayout)] == 0 {
+		counts[day.Format(dateLayout)] = 1
+	}
+
+	s := computeStats(counts, day)
+	logger.Info("Consistency calculated",
+		"streak_days", s.streak,
+		"week", s.thisWeek,
+		"last_week", s.lastWeek,
+		"month", s.thisMonth,
+		"last_month", s.lastMonth,
+	)
+
+	var sb strings.Builder
+	if s.streak > lookbackDays {
+		sb.WriteString(fmt.Sprintf("🔥 Streak: %d+ days", lookbackDays))
+	} else {
+		sb.WriteString(fmt.Sprintf("🔥 Streak: %s", plural(s.streak, "day", "days")))
+	}
+	if showWeekly {
+		sb.WriteString(fmt.Sprintf("\n• This week: %s (last week: %d)", plural(s.thisWeek, "activity", "activities"), s.lastWeek))
+	}
+	if showMonthly {
+		sb.WriteString(fmt.Sprintf("\n• This month: %s — %s", plural(s.thisMonth, "activity", "activities"), monthTrend(s.thisMonth, s.lastMonth)))
+	}
+
+	return &providers.EnrichmentResult{
+		Description: sb.String(),
+		Metadata: map[string]string{
+			"consistency_status": "success",
+			"streak_days":        fmt.Sprintf("%d", s.streak),
+			"week_count":         fmt.Sprintf("%d", s.thisWeek),
+			"last_week_count":    fmt.Sprintf("%d", s.lastWeek),
+			"month_count":        fmt.Sprintf("%d", s.thisMonth),
+			"last_month_count":   fmt.Sprintf("%d", s.lastMonth),
+		},
+	}, nil
+}
+
+// stats are the activity counts around a given day.
+type stats struct {
+	streak    int // consecutive days with an activity, ending on the day
+	thisWeek  int // Monday to Sunday
+	lastWeek  int
+	thisMonth int // month to date
+	lastMonth int // the same span of last month
+}
+
+func computeStats(counts map[string]int, day time.Time) stats {
+	var s stats
+
+	// One day past the lookback window is enough to know the streak is longer
+	for d := day; counts[d.Format(dateLayout)] > 0 && s.streak <= lookbackDays; d = d.AddDate(0, 0, -1) {
+		s.streak++
+	}
+
+	weekStart := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	s.thisWeek = sumDays(counts, weekStart, weekStart.AddDate(0, 0, 7))
+	s.lastWeek = sumDays(counts, weekStart.AddDate(0, 0, -7), weekStart)
+
+	monthStart := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	s.thisMonth = sumDays(counts, monthStart, day.AddDate(0, 0, 1))
+	lastMonthStart := monthStart.AddDate(0, -1, 0)
+	lastMonthEnd := lastMonthStart.AddDate(0, 0, day.Day())
+	if lastMonthEnd.After(monthStart) {
+		lastMonthEnd = monthStart
+	}
+	s.lastMonth = sumDays(counts, lastMonthStart, lastMonthEnd)
+
+	return s
+}
+
+// sumDays totals the counts from start up to, but not including, end.
+func sumDays(counts map[string]int, start, end time.Time) int {
+	var total int
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		total += counts[d.Format(dateLayout)]
+	}
+	return total
+}
+
+func monthTrend(thisMonth, lastMonth int) string {
+	switch {
+	case thisMonth > lastMonth:
+		return fmt.Sprintf("📈 %d more than this point last month", thisMonth-lastMonth)
+	case thisMonth < lastMonth:
+		return fmt.Sprintf("📉 %d fewer than this point last month", lastMonth-thisMonth)
+	default:
+		return "➡️ level with this point last month"
+	}
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func plural(n int, singular, pluralForm string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", singular)
+	}
+	return fmt.Sprintf("%d %s", n, pluralForm)
+}
@@ -0,0 +1,111 @@
+package consistency
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	user "github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var testUser = &user.Record{UserProfile: &pbuser.UserProfile{UserId: "u1"}}
+
+func activityOn(date string) *pbactivity.StandardizedActivity {
+	t, _ := time.Parse(dateLayout, date)
+	return &pbactivity.StandardizedActivity{StartTime: timestamppb.New(t.Add(7 * time.Hour))}
+}
+
+func countsDB(counts map[string]int, since *time.Time) *mocks.MockDatabase {
+	return &mocks.MockDatabase{
+		GetActivityDayCountsFunc: func(ctx context.Context, userId string, s time.Time) (map[string]int, error) {
+			if since != nil {
+				*since = s
+			}
+			return counts, nil
+		},
+	}
+}
+
+func TestConsistency_Enrich(t *testing.T) {
+	// Wednesday 14 October: a 14-day streak, with a second activity on Monday
+	counts := map[string]int{"2026-09-29": 1, "2026-10-12": 1}
+	for d := 1; d <= 14; d++ {
+		counts[time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC).Format(dateLayout)]++
+	}
+	counts["2026-09-10"] = 1 // last month, before the same point
+	counts["2026-09-20"] = 1 // last month, after it
+
+	var since time.Time
+	p := NewConsistency()
+	p.SetService(&bootstrap.Service{DB: countsDB(counts, &since)})
+
+	res, err := p.Enrich(context.Background(), slog.Default(), activityOn("2026-10-14"), testUser, map[string]string{}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := "🔥 Streak: 14 days\n• This week: 4 activities (last week: 7)\n• This month: 15 activities — 📈 14 more than this point last month"
+	if res.Description != want {
+		t.Errorf("Expected %q, got %q", want, res.Description)
+	}
+	if res.Metadata["streak_days"] != "14" || res.Metadata["last_month_count"] != "1" {
+		t.Errorf("Unexpected metadata: %v", res.Metadata)
+	}
+	if want := time.Date(2026, 7, 16, 0, 0, 0, 0, time.UTC); !since.Equal(want) {
+		t.Errorf("Expected the query to start %v, got %v", want, since)
+	}
+}
+
+func TestConsistency_FirstActivity(t *testing.T) {
+	p := NewConsistency()
+	p.SetService(&bootstrap.Service{DB: countsDB(nil, nil)})
+
+	inputs := map[string]string{"show_weekly": "false"}
+	res, err := p.Enrich(context.Background(), slog.Default(), activityOn("2026-10-14"), testUser, inputs, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The activity's own run isn't stored yet, but it still counts
+	want := "🔥 Streak: 1 day\n• This month: 1 activity — 📈 1 more than this point last month"
+	if res.Description != want {
+		t.Errorf("Expected %q, got %q", want, res.Description)
+	}
+}
+
+func TestConsistency_QueryError(t *testing.T) {
+	p := NewConsistency()
+	p.SetService(&bootstrap.Service{DB: &mocks.MockDatabase{
+		GetActivityDayCountsFunc: func(ctx context.Context, userId string, since time.Time) (map[string]int, error) {
+			return nil, errors.New("unavailable")
+		},
+	}})
+
+	if _, err := p.Enrich(context.Background(), slog.Default(), activityOn("2026-10-14"), testUser, map[string]string{}, false); err == nil {
+		t.Error("Expected an error when the stats query fails")
+	}
+}
+
+func TestComputeStats(t *testing.T) {
+	day := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	counts := map[string]int{"2026-03-31": 1}
+	for d := day.AddDate(0, 0, -120); d.Before(day); d = d.AddDate(0, 0, 1) {
+		counts[d.Format(dateLayout)] = 1
+	}
+
+	s := computeStats(counts, day)
+	if s.streak != lookbackDays+1 {
+		t.Errorf("Expected the streak to stop one past the lookback, got %d", s.streak)
+	}
+	// The 31st compares against all of February
+	if s.thisMonth != 31 || s.lastMonth != 28 {
+		t.Errorf("Expected 31 vs 28, got %d vs %d", s.thisMonth, s.lastMonth)
+	}
+}
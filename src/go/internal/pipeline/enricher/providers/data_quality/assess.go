@@ -0,0 +1,195 @@
+package data_quality
+
+import (
+	"math"
+
+	"github.com/fitglue/server/src/go/pkg/domain/streams"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+// Score deductions, each capped so one bad stream can't zero the score alone.
+const (
+	gpsJumpPenalty        = 5  // per GPS jump
+	maxGPSJumpPenalty     = 30 // cap for GPS jumps
+	maxHeartRatePenalty   = 30 // cap for heart rate coverage and dropouts
+	missingStreamPenalty  = 15 // per missing stream
+	improbableSpeedPoints = 2  // per second recorded at an improbable speed
+	maxImprobablePenalty  = 30 // cap for improbable speed samples
+	improbableAvgPenalty  = 40 // session average speed is improbable
+
+	// minJumpMeters ignores small position steps, where rounding alone can
+	// imply a high speed.
+	minJumpMeters = 25.0
+	// unknownJumpSpeed (m/s) flags GPS jumps on types without a speed limit.
+	unknownJumpSpeed = 60.0
+)
+
+// assessment is what the enricher found wrong with an activity's data.
+type assessment struct {
+	GPSJumps          int
+	HasHeartRate      bool
+	HeartRate         streams.Quality
+	MissingHeartRate  bool
+	MissingGPS        bool
+	ImprobableSeconds int     // records faster than the type's speed limit
+	ImprobableAverage float64 // m/s; non-zero when a session average is beyond the limit
+	SpeedLimit        float64 // m/s; zero for types without one
+}
+
+// Score rates the activity's data from 0 to 100.
+func (a assessment) Score() int {
+	score := 100
+	score -= min(a.GPSJumps*gpsJumpPenalty, maxGPSJumpPenalty)
+	if a.HasHeartRate {
+		score -= min((100-a.HeartRate.Score())/3, maxHeartRatePenalty)
+	}
+	if a.MissingHeartRate {
+		score -= missingStreamPenalty
+	}
+	if a.MissingGPS {
+		score -= missingStreamPenalty
+	}
+	score -= min(a.ImprobableSeconds*improbableSpeedPoints, maxImprobablePenalty)
+	if a.ImprobableAverage > 0 {
+		score -= improbableAvgPenalty
+	}
+	return max(score, 0)
+}
+
+// assess checks every session of the activity for GPS jumps, heart rate
+// dropouts, missing streams and improbable speeds.
+func assess(activity *pbactivity.StandardizedActivity) assessment {
+	a := assessment{SpeedLimit: speedLimit(activity.Type)}
+	jumpSpeed := unknownJumpSpeed
+	if a.SpeedLimit > 0 {
+		jumpSpeed = 2 * a.SpeedLimit
+	}
+
+	hasGPS := false
+	var distance, hrCovered, hrSeconds float64
+	for _, session := range activity.Sessions {
+		distance += session.TotalDistance
+		if a.SpeedLimit > 0 && session.TotalElapsedTime > 0 {
+			if avg := session.TotalDistance / session.TotalElapsedTime; avg > a.SpeedLimit {
+				a.ImprobableAverage = math.Max(a.ImprobableAverage, avg)
+			}
+		}
+
+		duration := int(session.TotalElapsedTime)
+		hr := make([]int, max(duration, 0))
+		start := session.StartTime.AsTime()
+
+		var prev *pbactivity.Record
+		for _, lap := range session.Laps {
+			for _, r := range lap.Records {
+				if r.Timestamp == nil {
+					continue
+				}
+				if r.HeartRate > 0 {
+					a.HasHeartRate = true
+					if offset := int(r.Timestamp.AsTime().Sub(start).Seconds()); offset >= 0 && offset < duration {
+						hr[offset] = int(r.HeartRate)
+					}
+				}
+				if a.SpeedLimit > 0 && r.Speed > a.SpeedLimit {
+					a.ImprobableSeconds++
+				}
+				if r.PositionLat == 0 && r.PositionLong == 0 {
+					continue
+				}
+				hasGPS = true
+				if prev != nil {
+					dt := r.Timestamp.AsTime().Sub(prev.Timestamp.AsTime()).Seconds()
+					meters := haversineMeters(prev.PositionLat, prev.PositionLong, r.PositionLat, r.PositionLong)
+					if dt > 0 && meters > minJumpMeters && meters/dt > jumpSpeed {
+						a.GPSJumps++
+					}
+				}
+				prev = r
+			}
+		}
+
+		if duration > 0 {
+			// Smart recording spaces readings a few seconds apart; only
+			// longer gaps are dropouts
+			q := streams.MeasureQuality(streams.FillGaps(hr, streams.DropoutSeconds-1), duration)
+			hrCovered += q.Coverage * float64(duration)
+			hrSeconds += float64(duration)
+			a.HeartRate.Dropouts += q.Dropouts
+		}
+	}
+	if hrSeconds > 0 {
+		a.HeartRate.Coverage = hrCovered / hrSeconds
+	}
+
+	a.MissingHeartRate = !a.HasHeartRate
+	a.MissingGPS = !hasGPS && distance > 0 && isOutdoor(activity.Type)
+	return a
+}
+
+// hasRecords reports whether the activity has any time-series data to assess.
+func hasRecords(activity *pbactivity.StandardizedActivity) bool {
+	for _, session := range activity.Sessions {
+		for _, lap := range session.Laps {
+			if len(lap.Records) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// speedLimit is the fastest plausible sustained speed for an activity type in
+// m/s, or zero for types that aren't checked.
+func speedLimit(t pbactivity.ActivityType) float64 {
+	switch t {
+	case pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		pbactivity.ActivityType_ACTIVITY_TYPE_TRAIL_RUN,
+		pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_RUN:
+		return 12.5 // 45 km/h
+	case pbactivity.ActivityType_ACTIVITY_TYPE_WALK,
+		pbactivity.ActivityType_ACTIVITY_TYPE_HIKE:
+		return 5 // 18 km/h
+	case pbactivity.ActivityType_ACTIVITY_TYPE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_GRAVEL_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_MOUNTAIN_BIKE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_EBIKE_RIDE:
+		return 28 // 100 km/h
+	case pbactivity.ActivityType_ACTIVITY_TYPE_SWIM:
+		return 3
+	case pbactivity.ActivityType_ACTIVITY_TYPE_ROWING,
+		pbactivity.ActivityType_ACTIVITY_TYPE_CANOEING,
+		pbactivity.ActivityType_ACTIVITY_TYPE_KAYAKING:
+		return 7
+	default:
+		return 0
+	}
+}
+
+// isOutdoor reports whether an activity type with distance is expected to
+// carry GPS.
+func isOutdoor(t pbactivity.ActivityType) bool {
+	switch t {
+	case pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		pbactivity.ActivityType_ACTIVITY_TYPE_TRAIL_RUN,
+		pbactivity.ActivityType_ACTIVITY_TYPE_WALK,
+		pbactivity.ActivityType_ACTIVITY_TYPE_HIKE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_GRAVEL_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_MOUNTAIN_BIKE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_EBIKE_RIDE:
+		return true
+	default:
+		return false
+	}
+}
+
+func haversineMeters(lat1, long1, lat2, long2 float64) float64 {
+	const earthRadius = 6371000.0
+	dLat := (lat2 - lat1) * math.Pi / 180
+	dLong := (long2 - long1) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*math.Pi/180)*math.Cos(lat2*math.Pi/180)*math.Sin(dLong/2)*math.Sin(dLong/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
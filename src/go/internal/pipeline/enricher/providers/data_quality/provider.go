@@ -0,0 +1,142 @@
+// Package data_quality scores how trustworthy an activity's recorded data is
+// and warns about the problems it finds.
+package data_quality
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/types/formatters"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// heartRateWarnScore is the heart rate quality score below which its
+// coverage and dropouts are called out.
+const heartRateWarnScore = 90
+
+// DataQualityProvider checks an activity for GPS jumps, heart rate dropouts,
+// missing streams and improbable speeds. The resulting score is recorded on
+// the activity, so enrichers placed after it (e.g. personal records) can
+// ignore untrustworthy data.
+type DataQualityProvider struct{}
+
+func init() {
+	providers.Register(NewDataQualityProvider())
+}
+
+func NewDataQualityProvider() *DataQualityProvider {
+	return &DataQualityProvider{}
+}
+
+func (p *DataQualityProvider) Name() string {
+	return "data-quality"
+}
+
+func (p *DataQualityProvider) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_DATA_QUALITY
+}
+
+func (p *DataQualityProvider) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	if !hasRecords(activity) {
+		return &providers.EnrichmentResult{
+			Skipped:    true,
+			SkipReason: "No recorded data to check",
+			Metadata: map[string]string{
+				"data_quality_status": "skipped",
+				"reason":              "no_records",
+			},
+		}, nil
+	}
+
+	a := assess(activity)
+	score := int32(a.Score())
+	warnings := a.warnings(activity.Type)
+
+	metadata := map[string]string{
+		"data_quality_status":      "success",
+		"data_quality_score":       fmt.Sprintf("%d", score),
+		"data_quality_warnings":    fmt.Sprintf("%d", len(warnings)),
+		"gps_jumps":                fmt.Sprintf("%d", a.GPSJumps),
+		"improbable_speed_seconds": fmt.Sprintf("%d", a.ImprobableSeconds),
+	}
+	if a.HasHeartRate {
+		metadata["hr_coverage_pct"] = fmt.Sprintf("%.0f", a.HeartRate.Coverage*100)
+		metadata["hr_dropouts"] = fmt.Sprintf("%d", a.HeartRate.Dropouts)
+	}
+	var missing []string
+	if a.MissingHeartRate {
+		missing = append(missing, "heart_rate")
+	}
+	if a.MissingGPS {
+		missing = append(missing, "gps")
+	}
+	if len(missing) > 0 {
+		metadata["missing_streams"] = strings.Join(missing, ",")
+	}
+
+	logger.Info("data_quality: activity scored",
+		"score", score,
+		"warnings", len(warnings),
+	)
+
+	result := &providers.EnrichmentResult{
+		DataQualityScore: &score,
+		Metadata:         metadata,
+	}
+	if len(warnings) > 0 || inputs["show_when_clean"] == "true" {
+		result.Description = formatDescription(score, warnings)
+	}
+	return result, nil
+}
+
+// warnings describes each problem found, most serious first.
+func (a assessment) warnings(activityType pbactivity.ActivityType) []string {
+	var warnings []string
+	if a.ImprobableAverage > 0 {
+		warnings = append(warnings, fmt.Sprintf("Average speed %.1f km/h is improbable for a %s",
+			a.ImprobableAverage*3.6, strings.ToLower(formatters.FormatActivityType(activityType))))
+	}
+	if a.ImprobableSeconds > 0 {
+		warnings = append(warnings, fmt.Sprintf("%ds recorded faster than %.0f km/h", a.ImprobableSeconds, a.SpeedLimit*3.6))
+	}
+	if a.GPSJumps > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d GPS %s", a.GPSJumps, plural(a.GPSJumps, "jump", "jumps")))
+	}
+	if a.MissingGPS {
+		warnings = append(warnings, "No GPS")
+	}
+	if a.MissingHeartRate {
+		warnings = append(warnings, "No heart rate")
+	} else if a.HeartRate.Score() < heartRateWarnScore {
+		warnings = append(warnings, fmt.Sprintf("Heart rate covers %.0f%% with %d %s",
+			a.HeartRate.Coverage*100, a.HeartRate.Dropouts, plural(a.HeartRate.Dropouts, "dropout", "dropouts")))
+	}
+	return warnings
+}
+
+func formatDescription(score int32, warnings []string) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("🩺 Data Quality: %d/100", score))
+	if len(warnings) == 0 {
+		sb.WriteString(" ✅")
+	}
+	for _, w := range warnings {
+		sb.WriteString("\n⚠️ " + w)
+	}
+	return sb.String()
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
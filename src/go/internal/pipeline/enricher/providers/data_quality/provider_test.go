@@ -0,0 +1,194 @@
+package data_quality
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+var (
+	testStart = time.Date(2026, 5, 2, 8, 0, 0, 0, time.UTC)
+	testUser  = &user.Record{UserProfile: &pbuser.UserProfile{UserId: "u1"}}
+)
+
+// newRun builds a run of n seconds at 3 m/s heading north, one record per
+// second with heart rate and GPS; edit lets a test damage individual records.
+func newRun(n int, edit func(i int, r *pbactivity.Record)) *pbactivity.StandardizedActivity {
+	lap := &pbactivity.Lap{}
+	for i := 0; i < n; i++ {
+		r := &pbactivity.Record{
+			Timestamp:    timestamppb.New(testStart.Add(time.Duration(i) * time.Second)),
+			HeartRate:    150,
+			Speed:        3,
+			PositionLat:  51.5 + float64(i)*3/111320,
+			PositionLong: -0.1,
+		}
+		if edit != nil {
+			edit(i, r)
+		}
+		lap.Records = append(lap.Records, r)
+	}
+	return &pbactivity.StandardizedActivity{
+		Type: pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		Sessions: []*pbactivity.Session{{
+			StartTime:        timestamppb.New(testStart),
+			TotalElapsedTime: float64(n),
+			TotalDistance:    float64(n) * 3,
+			Laps:             []*pbactivity.Lap{lap},
+		}},
+	}
+}
+
+func enrich(t *testing.T, activity *pbactivity.StandardizedActivity, inputs map[string]string) (score int32, description string, metadata map[string]string) {
+	t.Helper()
+	res, err := NewDataQualityProvider().Enrich(context.Background(), slog.Default(), activity, testUser, inputs, false)
+	if err != nil {
+		t.Fatalf("Enrich failed: %v", err)
+	}
+	if res.DataQualityScore == nil {
+		t.Fatalf("Expected a score, got result %+v", res)
+	}
+	return *res.DataQualityScore, res.Description, res.Metadata
+}
+
+func TestEnrich_CleanActivity(t *testing.T) {
+	score, desc, meta := enrich(t, newRun(600, nil), nil)
+	if score != 100 {
+		t.Errorf("Expected a perfect score, got %d (%v)", score, meta)
+	}
+	if desc != "" {
+		t.Errorf("Expected no description for clean data, got %q", desc)
+	}
+
+	_, desc, _ = enrich(t, newRun(600, nil), map[string]string{"show_when_clean": "true"})
+	if desc != "🩺 Data Quality: 100/100 ✅" {
+		t.Errorf("Expected a clean summary, got %q", desc)
+	}
+}
+
+func TestEnrich_SmartRecordingIsNotADropout(t *testing.T) {
+	activity := newRun(600, func(i int, r *pbactivity.Record) {
+		if i%3 != 0 {
+			r.HeartRate = 0
+		}
+	})
+	score, _, meta := enrich(t, activity, nil)
+	if score != 100 || meta["hr_dropouts"] != "0" {
+		t.Errorf("Expected readings every 3s to count as full coverage, got score %d (%v)", score, meta)
+	}
+}
+
+func TestEnrich_Problems(t *testing.T) {
+	tests := []struct {
+		name        string
+		activity    *pbactivity.StandardizedActivity
+		wantScore   int32
+		wantWarning string
+		wantMeta    map[string]string
+	}{
+		{
+			name: "GPS jumps",
+			activity: newRun(600, func(i int, r *pbactivity.Record) {
+				if i == 100 || i == 300 {
+					r.PositionLat += 0.01 // ~1.1 km away for one second
+				}
+			}),
+			wantScore:   80, // Out and back again for each of the two glitches
+			wantWarning: "⚠️ 4 GPS jumps",
+			wantMeta:    map[string]string{"gps_jumps": "4"},
+		},
+		{
+			name: "Heart rate dropout",
+			activity: newRun(600, func(i int, r *pbactivity.Record) {
+				if i >= 200 && i < 320 {
+					r.HeartRate = 0
+				}
+			}),
+			wantScore:   93, // 80% coverage less one dropout is 78, a third of 22 off
+			wantWarning: "⚠️ Heart rate covers 80% with 1 dropout",
+			wantMeta:    map[string]string{"hr_coverage_pct": "80", "hr_dropouts": "1"},
+		},
+		{
+			name: "Missing heart rate",
+			activity: newRun(600, func(i int, r *pbactivity.Record) {
+				r.HeartRate = 0
+			}),
+			wantScore:   85,
+			wantWarning: "⚠️ No heart rate",
+			wantMeta:    map[string]string{"missing_streams": "heart_rate"},
+		},
+		{
+			name: "Missing GPS",
+			activity: newRun(600, func(i int, r *pbactivity.Record) {
+				r.PositionLat, r.PositionLong = 0, 0
+			}),
+			wantScore:   85,
+			wantWarning: "⚠️ No GPS",
+			wantMeta:    map[string]string{"missing_streams": "gps"},
+		},
+		{
+			name: "Improbable speed",
+			activity: newRun(600, func(i int, r *pbactivity.Record) {
+				if i < 5 {
+					r.Speed = 20
+				}
+			}),
+			wantScore:   90,
+			wantWarning: "⚠️ 5s recorded faster than 45 km/h",
+			wantMeta:    map[string]string{"improbable_speed_seconds": "5"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, desc, meta := enrich(t, tt.activity, nil)
+			if score != tt.wantScore {
+				t.Errorf("Expected score %d, got %d (%v)", tt.wantScore, score, meta)
+			}
+			if !strings.Contains(desc, tt.wantWarning) {
+				t.Errorf("Expected %q in description, got %q", tt.wantWarning, desc)
+			}
+			for k, v := range tt.wantMeta {
+				if meta[k] != v {
+					t.Errorf("Expected metadata %s=%s, got %q", k, v, meta[k])
+				}
+			}
+		})
+	}
+}
+
+func TestEnrich_ImprobableAverage(t *testing.T) {
+	activity := newRun(600, nil)
+	activity.Type = pbactivity.ActivityType_ACTIVITY_TYPE_WALK // 3 m/s is fine, but a 20 km walk in 10 minutes isn't
+	activity.Sessions[0].TotalDistance = 20000
+
+	score, desc, _ := enrich(t, activity, nil)
+	if score != 60 {
+		t.Errorf("Expected score 60, got %d", score)
+	}
+	if !strings.Contains(desc, "Average speed 120.0 km/h is improbable for a walk") {
+		t.Errorf("Expected an average speed warning, got %q", desc)
+	}
+}
+
+func TestEnrich_NoRecords(t *testing.T) {
+	activity := &pbactivity.StandardizedActivity{
+		Type:     pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING,
+		Sessions: []*pbactivity.Session{{TotalElapsedTime: 3600}},
+	}
+	res, err := NewDataQualityProvider().Enrich(context.Background(), slog.Default(), activity, testUser, nil, false)
+	if err != nil {
+		t.Fatalf("Enrich failed: %v", err)
+	}
+	if !res.Skipped || res.Metadata["reason"] != "no_records" || res.DataQualityScore != nil {
+		t.Errorf("Expected a skip without a score, got %+v", res)
+	}
+}
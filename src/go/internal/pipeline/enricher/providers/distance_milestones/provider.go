@@ -9,6 +9,7 @@ import (
 
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/description"
 
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 
@@ -141,7 +142,7 @@ func (p *DistanceMilestones) Enrich(ctx context.Context, logger *slog.Logger, ac
 		// Celebrate milestone!
 		biggest := crossedMilestones[len(crossedMilestones)-1]
 		emoji := getMilestoneEmoji(biggest)
-		sb.WriteString(fmt.Sprintf("%s Lifetime %s:\n", emoji, getSportLabel(sport)))
+		sb.WriteString(lifetimeHeader(user, emoji, sport) + "\n")
 		sb.WriteString(fmt.Sprintf("🎉 MILESTONE: %.0f km reached!\n", biggest))
 		sb.WriteString(fmt.Sprintf("• Total: %.1f km\n", newDistance))
 		sb.WriteString(fmt.Sprintf("• This %s: +%.1f km", getSportLabel(sport), distanceKm))
@@ -160,7 +161,7 @@ func (p *DistanceMilestones) Enrich(ctx context.Context, logger *slog.Logger, ac
 		nextMilestone := getNextMilestone(newDistance)
 		remaining := nextMilestone - newDistance
 
-		sb.WriteString(fmt.Sprintf("📊 Lifetime %s:\n", getSportLabel(sport)))
+		sb.WriteString(lifetimeHeader(user, description.DefaultSection(description.SectionLifetimeDistance).Emoji, sport) + "\n")
 		sb.WriteString(fmt.Sprintf("• %.1f km total\n", newDistance))
 		sb.WriteString(fmt.Sprintf("• Next milestone: %.0f km (%.1f km to go)", nextMilestone, remaining))
 
@@ -198,6 +199,12 @@ func (p *DistanceMilestones) Enrich(ctx context.Context, logger *slog.Logger, ac
 	}, nil
 }
 
+// lifetimeHeader renders the section header, e.g. "📊 Lifetime Running:".
+func lifetimeHeader(u *user.Record, emoji, sport string) string {
+	title := description.DefaultSection(description.SectionLifetimeDistance).Title + " " + getSportLabel(sport)
+	return providers.SectionHeaderTitled(u, description.SectionLifetimeDistance, emoji, title)
+}
+
 func getMilestoneEmoji(km float64) string {
 	switch {
 	case km >= 10000:
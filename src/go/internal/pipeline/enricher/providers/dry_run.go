@@ -0,0 +1,18 @@
+package providers
+
+import "context"
+
+type dryRunKey struct{}
+
+// WithDryRun marks a context as a pipeline preview. Writes made through the
+// service's database and blob store are dropped, and providers with costly
+// side effects (e.g. image generation) should skip themselves.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// IsDryRun reports whether ctx belongs to a pipeline preview.
+func IsDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
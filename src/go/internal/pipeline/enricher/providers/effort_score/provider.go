@@ -13,6 +13,7 @@ import (
 
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	activityPkg "github.com/fitglue/server/src/go/pkg/domain/activity"
 
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 
@@ -39,6 +40,10 @@ type activitySnapshot struct {
 	Duration float64 `json:"duration"`  // minutes
 	ElevGain float64 `json:"elev_gain"` // meters
 	TRIMP    float64 `json:"trimp"`
+
+	// GradeAdjusted is set when AvgPace is grade-adjusted pace, so a hilly
+	// run isn't scored as an easy one for being slow.
+	GradeAdjusted bool `json:"-"`
 }
 
 // EffortScore computes a relative difficulty score 0-100 against the user's rolling history.
@@ -188,6 +193,12 @@ func extractMetrics(activity *pbactivity.StandardizedActivity) activitySnapshot
 			snap.AvgPace = (1000 / avgSpeed) / 60 // min/km
 		}
 	}
+	if snap.AvgPace > 0 && isRun(activity.Type) && activityPkg.IsHilly(activity) {
+		if samples := activityPkg.GradeAdjust(activity); samples != nil {
+			snap.AvgPace *= activityPkg.GradeAdjustmentFactor(samples)
+			snap.GradeAdjusted = true
+		}
+	}
 
 	// Calculate simplified TRIMP
 	if snap.AvgHR > 0 {
@@ -314,10 +325,14 @@ func computeEffortScore(current activitySnapshot, avgHR, avgPace, avgDuration, a
 	// Pace factor (lower pace = harder, so invert: avgPace/currentPace)
 	if current.AvgPace > 0 && avgPace > 0 {
 		ratio := avgPace / current.AvgPace // faster = higher ratio
+		name := "Pace"
+		if current.GradeAdjusted {
+			name = "Pace (GAP)"
+		}
 		factors = append(factors, weightedFactor{
 			weight: weightPace,
 			ratio:  ratio,
-			detail: factorDetail{Name: "Pace", Ratio: ratio, Emoji: "🏃"},
+			detail: factorDetail{Name: name, Ratio: ratio, Emoji: "🏃"},
 		})
 	}
 
@@ -394,6 +409,18 @@ func getScoreLabel(score float64) string {
 	}
 }
 
+// isRun reports whether grade-adjusted pace applies to the activity type.
+func isRun(activityType pbactivity.ActivityType) bool {
+	switch activityType {
+	case pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		pbactivity.ActivityType_ACTIVITY_TYPE_TRAIL_RUN,
+		pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_RUN:
+		return true
+	default:
+		return false
+	}
+}
+
 // buildDescription formats the effort score output using Rule G52 multi-line bullets.
 func buildDescription(score float64, label string, factors []factorDetail) string {
 	var sb strings.Builder
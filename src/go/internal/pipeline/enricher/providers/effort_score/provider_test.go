@@ -10,9 +10,11 @@ import (
 	"context"
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
 	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func makeActivity(durationMinutes int, heartRate int32, speed float64, altitude float64) *pbactivity.StandardizedActivity {
@@ -318,3 +320,38 @@ func TestComputeEffortScore_EasierEffort(t *testing.T) {
 		t.Errorf("Expected score < 40 for easier effort, got %.0f", score)
 	}
 }
+
+func TestExtractMetrics_GradeAdjustedPace(t *testing.T) {
+	// 1 km at 3 m/s up a steady 5% climb
+	start := time.Date(2026, 5, 12, 18, 0, 0, 0, time.UTC)
+	var records []*pbactivity.Record
+	for i := 0; i <= 333; i++ {
+		d := float64(i) * 3
+		records = append(records, &pbactivity.Record{
+			Timestamp: timestamppb.New(start.Add(time.Duration(i) * time.Second)),
+			Speed:     3,
+			Distance:  d,
+			Altitude:  100 + d*0.05,
+		})
+	}
+	activity := &pbactivity.StandardizedActivity{
+		Type:     pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		Sessions: []*pbactivity.Session{{TotalDistance: 999, TotalElapsedTime: 333, Laps: []*pbactivity.Lap{{Records: records}}}},
+	}
+
+	snap := extractMetrics(activity)
+	if !snap.GradeAdjusted || snap.AvgPace >= 5 {
+		t.Errorf("Expected a grade-adjusted pace faster than the 5:33/km run, got %.2f (adjusted=%v)", snap.AvgPace, snap.GradeAdjusted)
+	}
+
+	_, factors := computeEffortScore(snap, 0, 5.5, 0, 0, 0)
+	if len(factors) != 1 || factors[0].Name != "Pace (GAP)" {
+		t.Errorf("Expected a Pace (GAP) factor, got %v", factors)
+	}
+
+	// Rides aren't grade-adjusted
+	activity.Type = pbactivity.ActivityType_ACTIVITY_TYPE_RIDE
+	if snap := extractMetrics(activity); snap.GradeAdjusted {
+		t.Error("Expected no grade adjustment for a ride")
+	}
+}
@@ -0,0 +1,162 @@
+package energy_expenditure
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	activityPkg "github.com/fitglue/server/src/go/pkg/domain/activity"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// maxSampleGap caps how long one heart rate sample is held for, so pauses
+// and recording gaps don't count as exercise.
+const maxSampleGap = 10.0 // seconds
+
+// EnergyExpenditure fills in session calories when the source omits them,
+// from the power stream when there is one and otherwise from heart rate
+// using the Keytel et al. (2005) equations. The total is written to the
+// session so the generated FIT file carries it to destinations.
+type EnergyExpenditure struct {
+	Service *bootstrap.Service
+}
+
+func init() {
+	providers.Register(NewEnergyExpenditure())
+}
+
+func NewEnergyExpenditure() *EnergyExpenditure {
+	return &EnergyExpenditure{}
+}
+
+func (p *EnergyExpenditure) SetService(service *bootstrap.Service) {
+	p.Service = service
+}
+
+func (p *EnergyExpenditure) Name() string {
+	return "energy-expenditure"
+}
+
+func (p *EnergyExpenditure) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ENERGY_EXPENDITURE
+}
+
+func (p *EnergyExpenditure) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	logger.Debug("energy_expenditure: starting", "activity_name", activity.Name)
+
+	// Config defaults
+	weightKg := 70.0
+	age := 35.0
+	female := inputs["gender"] == "female"
+	efficiency := activityPkg.DefaultEfficiency
+	overwrite := inputs["overwrite"] == "true"
+	showInDescription := inputs["show_in_description"] != "false" // default true
+
+	if f, err := strconv.ParseFloat(inputs["weight_kg"], 64); err == nil && f >= 30 && f <= 250 {
+		weightKg = f
+	}
+	if f, err := strconv.ParseFloat(inputs["age"], 64); err == nil && f >= 10 && f <= 100 {
+		age = f
+	}
+	if f, err := strconv.ParseFloat(inputs["efficiency"], 64); err == nil && f >= 10 && f <= 35 {
+		efficiency = f / 100
+	}
+
+	if len(activity.Sessions) == 0 {
+		return skipped("no_session", "Activity has no session"), nil
+	}
+	if existing := activity.Sessions[0].GetTotalCalories(); existing > 0 && !overwrite {
+		return skipped("source_calories", fmt.Sprintf("Source already reports %.0f kcal", existing)), nil
+	}
+
+	// Power measures the work done directly, so prefer it over heart rate
+	var kcal float64
+	var method string
+	if work := activityPkg.MechanicalWork(activity); work > 0 {
+		kcal = activityPkg.CaloriesFromWork(work, efficiency)
+		method = "power"
+	} else if hrKcal := keytelCalories(activity, weightKg, age, female); hrKcal > 0 {
+		kcal = hrKcal
+		method = "heart_rate"
+	} else {
+		return skipped("no_stream_data", "No power or heart rate data"), nil
+	}
+
+	logger.Info("Energy expenditure estimated", "kcal", kcal, "method", method)
+
+	result := &providers.EnrichmentResult{
+		TotalCalories: &kcal,
+		Metadata: map[string]string{
+			"energy_status": "success",
+			"calories":      fmt.Sprintf("%.0f", kcal),
+			"method":        method,
+		},
+	}
+	if showInDescription {
+		source := "power"
+		if method == "heart_rate" {
+			source = "heart rate"
+		}
+		result.Description = fmt.Sprintf("🔥 Energy: %.0f kcal (estimated from %s)", kcal, source)
+	}
+	return result, nil
+}
+
+// keytelCalories integrates the Keytel et al. (2005) energy expenditure
+// equations over the heart rate stream. Returns 0 without heart rate data.
+func keytelCalories(activity *pbactivity.StandardizedActivity, weightKg, age float64, female bool) float64 {
+	var kcal float64
+	for _, session := range activity.Sessions {
+		var prev *pbactivity.Record
+		for _, lap := range session.Laps {
+			for _, record := range lap.Records {
+				if record.Timestamp == nil {
+					continue
+				}
+				if prev != nil && prev.HeartRate > 0 {
+					dt := record.Timestamp.AsTime().Sub(prev.Timestamp.AsTime()).Seconds()
+					if dt > 0 && dt <= maxSampleGap {
+						kcal += keytelKcalPerMinute(float64(prev.HeartRate), weightKg, age, female) * dt / 60
+					}
+				}
+				prev = record
+			}
+		}
+	}
+	return kcal
+}
+
+// keytelKcalPerMinute is the Keytel equation without VO2max. Heart rates low
+// enough to give a negative rate count as zero.
+func keytelKcalPerMinute(hr, weightKg, age float64, female bool) float64 {
+	var kj float64
+	if female {
+		kj = -20.4022 + 0.4472*hr - 0.1263*weightKg + 0.074*age
+	} else {
+		kj = -55.0969 + 0.6309*hr + 0.1988*weightKg + 0.2017*age
+	}
+	if kj < 0 {
+		return 0
+	}
+	return kj / 4.184
+}
+
+func skipped(reason, detail string) *providers.EnrichmentResult {
+	return &providers.EnrichmentResult{
+		Skipped:    true,
+		SkipReason: detail,
+		Metadata: map[string]string{
+			"energy_status": "skipped",
+			"reason":        reason,
+			"status_detail": detail,
+		},
+	}
+}
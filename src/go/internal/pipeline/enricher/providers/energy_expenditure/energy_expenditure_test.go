@@ -0,0 +1,110 @@
+package energy_expenditure
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	user "github.com/fitglue/server/src/go/pkg/domain/user"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+// steadyActivity is 30 minutes of one-second records at a fixed heart rate
+// and power.
+func steadyActivity(hr, power int32) *pbactivity.StandardizedActivity {
+	start := time.Date(2026, 5, 12, 18, 0, 0, 0, time.UTC)
+	var records []*pbactivity.Record
+	for i := 0; i <= 1800; i++ {
+		records = append(records, &pbactivity.Record{
+			Timestamp: timestamppb.New(start.Add(time.Duration(i) * time.Second)),
+			HeartRate: hr,
+			Power:     power,
+		})
+	}
+	return &pbactivity.StandardizedActivity{
+		Name:      "Evening Ride",
+		StartTime: timestamppb.New(start),
+		Sessions: []*pbactivity.Session{{
+			StartTime:        timestamppb.New(start),
+			TotalElapsedTime: 1800,
+			Laps:             []*pbactivity.Lap{{Records: records}},
+		}},
+	}
+}
+
+func TestEnergyExpenditure_HeartRate(t *testing.T) {
+	tests := []struct {
+		name   string
+		inputs map[string]string
+		want   float64
+	}{
+		// (-55.0969 + 0.6309*150 + 0.1988*70 + 0.2017*35) / 4.184 × 30 min
+		{"male defaults", map[string]string{}, 433.9},
+		// (-20.4022 + 0.4472*150 - 0.1263*60 + 0.074*30) / 4.184 × 30 min
+		{"female", map[string]string{"gender": "female", "weight_kg": "60", "age": "30"}, 296.3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := NewEnergyExpenditure().Enrich(context.Background(), slog.Default(), steadyActivity(150, 0), &user.Record{}, tt.inputs, false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if res.Metadata["method"] != "heart_rate" {
+				t.Errorf("Expected heart_rate method, got %v", res.Metadata)
+			}
+			if res.TotalCalories == nil || math.Abs(*res.TotalCalories-tt.want) > 0.5 {
+				t.Errorf("Expected ~%.1f kcal, got %v", tt.want, res.TotalCalories)
+			}
+		})
+	}
+}
+
+func TestEnergyExpenditure_PrefersPower(t *testing.T) {
+	res, err := NewEnergyExpenditure().Enrich(context.Background(), slog.Default(), steadyActivity(150, 200), &user.Record{}, map[string]string{}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.Metadata["method"] != "power" || res.Metadata["calories"] != "359" {
+		t.Errorf("Expected 359 kcal from power, got %v", res.Metadata)
+	}
+	if res.Description != "🔥 Energy: 359 kcal (estimated from power)" {
+		t.Errorf("Unexpected description: %q", res.Description)
+	}
+}
+
+func TestEnergyExpenditure_SourceCalories(t *testing.T) {
+	act := steadyActivity(150, 0)
+	kcal := 512.0
+	act.Sessions[0].TotalCalories = &kcal
+
+	res, err := NewEnergyExpenditure().Enrich(context.Background(), slog.Default(), act, &user.Record{}, map[string]string{}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !res.Skipped || res.Metadata["reason"] != "source_calories" || res.TotalCalories != nil {
+		t.Errorf("Expected skip when the source reports calories, got %+v", res)
+	}
+
+	res, err = NewEnergyExpenditure().Enrich(context.Background(), slog.Default(), act, &user.Record{}, map[string]string{"overwrite": "true", "show_in_description": "false"}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.TotalCalories == nil || res.Description != "" {
+		t.Errorf("Expected overwrite without description, got %+v", res)
+	}
+}
+
+func TestEnergyExpenditure_NoData(t *testing.T) {
+	res, err := NewEnergyExpenditure().Enrich(context.Background(), slog.Default(), steadyActivity(0, 0), &user.Record{}, map[string]string{}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !res.Skipped || res.Metadata["reason"] != "no_stream_data" {
+		t.Errorf("Expected no_stream_data skip, got %v", res.Metadata)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/user_input"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
 	"github.com/fitglue/server/src/go/pkg/domain/fit_parser"
+	"github.com/fitglue/server/src/go/pkg/domain/streams"
 
 	pendinginput "github.com/fitglue/server/src/go/pkg/pending_input"
 
@@ -50,6 +51,10 @@ func (p *FitFileHRProvider) ProviderType() pbplugin.EnricherProviderType {
 	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_FIT_FILE_HEART_RATE
 }
 
+// SkipOnSourceUpdate avoids asking for another FIT file when the user only
+// edited the activity's metadata.
+func (p *FitFileHRProvider) SkipOnSourceUpdate() bool { return true }
+
 // Enrich checks if the activity already has heart rate data. If not, it creates
 // a pending input requesting a FIT file upload.
 func (p *FitFileHRProvider) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
@@ -201,6 +206,7 @@ func (p *FitFileHRProvider) EnrichResume(ctx context.Context, activity *pbactivi
 
 	return &providers.EnrichmentResult{
 		HeartRateStream: stream,
+		HeartRateSensor: streams.SensorChestStrap,
 		TimeMarkers:     fitTimeMarkers,
 		Metadata: mergeMetadata(map[string]string{
 			"hr_source":     "fit_file",
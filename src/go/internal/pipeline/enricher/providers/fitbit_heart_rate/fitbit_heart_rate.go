@@ -8,6 +8,7 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/fitglue/server/src/go/pkg/domain/user"
@@ -15,6 +16,7 @@ import (
 	"github.com/fitglue/server/src/go/internal/infra"
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/streams"
 	"github.com/fitglue/server/src/go/pkg/infrastructure/oauth"
 
 	fitbit "github.com/fitglue/server/src/go/pkg/integrations/fitbit"
@@ -48,6 +50,10 @@ func (p *FitBitHeartRate) ProviderType() pbplugin.EnricherProviderType {
 	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_FITBIT_HEART_RATE
 }
 
+// SkipOnSourceUpdate leaves the heart rate stream alone when only the
+// activity's title, description or type changed.
+func (p *FitBitHeartRate) SkipOnSourceUpdate() bool { return true }
+
 func (p *FitBitHeartRate) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
 	return p.EnrichWithClient(ctx, logger, activity, user, inputs, nil, doNotRetry)
 }
@@ -153,8 +159,10 @@ func (p *FitBitHeartRate) EnrichWithClient(ctx context.Context, logger *slog.Log
 		// Convert HR response to timed samples
 		hrSamples := providers.ConvertHRResponseToSamples(hrResponse.ActivitiesHeartIntraday.Dataset, startTime)
 
-		// Extract GPS timestamps from activity records
+		// Extract GPS timestamps from activity records. AlignTimeSeries returns
+		// values in timestamp order, so sort to keep the two in step.
 		gpsTimestamps := extractGPSTimestamps(activity)
+		sort.Slice(gpsTimestamps, func(i, j int) bool { return gpsTimestamps[i].Before(gpsTimestamps[j]) })
 
 		if len(gpsTimestamps) > 0 && len(hrSamples) > 0 {
 			alignResult, err := providers.AlignTimeSeries(gpsTimestamps, hrSamples, providers.DefaultAlignmentConfig, logger)
@@ -162,7 +170,9 @@ func (p *FitBitHeartRate) EnrichWithClient(ctx context.Context, logger *slog.Log
 				logger.Warn("HR alignment failed, falling back to index-based mapping", "error", err)
 				stream = buildStreamIndexBased(hrResponse.ActivitiesHeartIntraday.Dataset, startTimeStr, durationSec)
 			} else {
-				stream = alignResult.AlignedHR
+				// Records may be sparser than 1Hz (smart recording), so place the
+				// per-record values by offset rather than by index.
+				stream = alignedToSeconds(gpsTimestamps, alignResult.AlignedHR, sessionStartTime(activity), durationSec)
 				for k, v := range alignResult.Metadata {
 					alignmentMetadata[k] = v
 				}
@@ -238,6 +248,7 @@ func (p *FitBitHeartRate) EnrichWithClient(ctx context.Context, logger *slog.Log
 	return &providers.EnrichmentResult{
 		Name:            "", // Don't wipe name
 		HeartRateStream: stream,
+		HeartRateSensor: streams.SensorOptical,
 		Metadata: mergeMetadata(map[string]string{
 			"hr_source":      "fitbit",
 			"query_date":     startDate,
@@ -299,7 +310,9 @@ func buildStreamIndexBased(dataset []struct {
 		}
 	}
 
-	// Fill gaps (Forward Fill)
+	// Intraday HR arrives every 1-15s depending on the device, so interpolate
+	// between readings, then hold the last reading to the end of the activity.
+	streams.FillGaps(stream, 0)
 	lastVal := 0
 	for i := 0; i < len(stream); i++ {
 		if stream[i] != 0 {
@@ -312,6 +325,31 @@ func buildStreamIndexBased(dataset []struct {
 	return stream
 }
 
+// alignedToSeconds spreads values aligned to record timestamps onto a 1Hz
+// stream starting at start, interpolating between records.
+func alignedToSeconds(timestamps []time.Time, values []int, start time.Time, durationSec int) []int {
+	stream := make([]int, durationSec)
+	for i, ts := range timestamps {
+		if i >= len(values) {
+			break
+		}
+		offset := int(ts.Sub(start).Seconds())
+		if offset >= 0 && offset < durationSec {
+			stream[offset] = values[i]
+		}
+	}
+	return streams.FillGaps(stream, 0)
+}
+
+// sessionStartTime returns the time stream offsets are measured from when
+// they are applied to records.
+func sessionStartTime(activity *pbactivity.StandardizedActivity) time.Time {
+	if len(activity.Sessions) > 0 && activity.Sessions[0].StartTime != nil {
+		return activity.Sessions[0].StartTime.AsTime()
+	}
+	return activity.StartTime.AsTime()
+}
+
 // hasExistingHeartRateData checks if the activity already has heart rate data in its records
 func hasExistingHeartRateData(activity *pbactivity.StandardizedActivity) bool {
 	for _, session := range activity.Sessions {
@@ -517,3 +517,43 @@ func TestFitBitHeartRate_Enrich_SpansMidnight(t *testing.T) {
 		t.Errorf("Expected heart rate stream of 720 seconds, got %d", len(result.HeartRateStream))
 	}
 }
+
+func TestBuildStreamIndexBased_InterpolatesCoarseGranularity(t *testing.T) {
+	dataset := []struct {
+		Time  string `json:"time"`
+		Value int    `json:"value"`
+	}{
+		{Time: "10:00:05", Value: 100},
+		{Time: "10:00:10", Value: 110},
+		{Time: "10:00:25", Value: 140},
+	}
+
+	stream := buildStreamIndexBased(dataset, "10:00", 30)
+
+	if stream[0] != 0 || stream[4] != 0 {
+		t.Errorf("Expected no HR before the first sample, got %v", stream[:5])
+	}
+	if stream[7] != 104 || stream[15] != 120 || stream[20] != 130 {
+		t.Errorf("Expected interpolated readings between samples, got %v", stream[5:26])
+	}
+	if stream[29] != 140 {
+		t.Errorf("Expected last reading held to the end, got %d", stream[29])
+	}
+}
+
+func TestAlignedToSeconds_SparseRecords(t *testing.T) {
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	var timestamps []time.Time
+	for i := 0; i < 4; i++ {
+		timestamps = append(timestamps, start.Add(time.Duration(i*10)*time.Second))
+	}
+
+	stream := alignedToSeconds(timestamps, []int{100, 120, 140, 160}, start, 31)
+
+	if len(stream) != 31 {
+		t.Fatalf("Expected a 31 second stream, got %d", len(stream))
+	}
+	if stream[5] != 110 || stream[25] != 150 || stream[30] != 160 {
+		t.Errorf("Expected records spread by offset across the activity, got %v", stream)
+	}
+}
@@ -0,0 +1,166 @@
+package gear_tracker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultShoeWarningKm is where most running shoes have lost their cushioning.
+const defaultShoeWarningKm = 700.0
+
+// GearTracker assigns one of the user's registered shoes or bikes to the
+// activity and adds its distance to the gear's mileage in users/{id}/gear.
+type GearTracker struct {
+	Service *bootstrap.Service
+}
+
+func init() {
+	providers.Register(NewGearTracker())
+}
+
+func NewGearTracker() *GearTracker {
+	return &GearTracker{}
+}
+
+func (p *GearTracker) SetService(service *bootstrap.Service) {
+	p.Service = service
+}
+
+func (p *GearTracker) Name() string {
+	return "gear-tracker"
+}
+
+// SkipOnSourceUpdate keeps metadata edits from counting the activity's
+// mileage again.
+func (p *GearTracker) SkipOnSourceUpdate() bool {
+	return true
+}
+
+func (p *GearTracker) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_GEAR_TRACKER
+}
+
+func (p *GearTracker) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	logger.Debug("gear_tracker: starting", "activity_name", activity.Name, "activity_type", activity.Type)
+
+	warningKm := defaultShoeWarningKm
+	if f, err := strconv.ParseFloat(inputs["shoe_warning_km"], 64); err == nil && f > 0 {
+		warningKm = f
+	}
+	showInDescription := inputs["show_in_description"] != "false" // default true
+
+	gearID := resolveGearID(logger, activity.Type, inputs)
+	if gearID == "" {
+		return skipped("no_gear_assigned", "No gear assigned to this activity type"), nil
+	}
+
+	if p.Service == nil || p.Service.DB == nil {
+		return nil, fmt.Errorf("service not initialized")
+	}
+
+	gear, err := p.Service.DB.GetGear(ctx, user.UserId, gearID)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, fmt.Errorf("failed to get gear: %w", err)
+	}
+	if gear == nil {
+		return skipped("gear_not_found", fmt.Sprintf("Gear %q no longer exists", gearID)), nil
+	}
+	if gear.Retired {
+		return skipped("gear_retired", fmt.Sprintf("%s is retired", gear.Name)), nil
+	}
+
+	var distance float64
+	for _, session := range activity.Sessions {
+		distance += session.TotalDistance
+	}
+
+	// Each activity is counted once per gear, however often it is re-run or
+	// redelivered: the database records a use marker per activity
+	activityID := inputs["external_id"]
+	if activityID == "" {
+		activityID = activity.ExternalId
+	}
+	if activityID == "" {
+		return skipped("no_activity_id", "The activity has no ID to count it against"), nil
+	}
+	usedAt := time.Now()
+	if activity.StartTime != nil {
+		usedAt = activity.StartTime.AsTime()
+	}
+	gear, counted, err := p.Service.DB.RecordGearUse(ctx, user.UserId, gearID, activityID, distance, usedAt)
+	if status.Code(err) == codes.NotFound {
+		return skipped("gear_not_found", fmt.Sprintf("Gear %q no longer exists", gearID)), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update gear: %w", err)
+	}
+
+	totalKm := gear.DistanceMeters / 1000
+	worn := gear.Type == pbuser.GearType_GEAR_TYPE_SHOES && totalKm >= warningKm
+	logger.Info("Gear mileage updated", "gear_id", gear.Id, "total_km", totalKm, "added_m", distance, "counted", counted)
+
+	result := &providers.EnrichmentResult{
+		Metadata: map[string]string{
+			"gear_tracker_status": "success",
+			"gear_id":             gear.Id,
+			"gear_name":           gear.Name,
+			"gear_distance_km":    fmt.Sprintf("%.1f", totalKm),
+			"gear_activity_count": fmt.Sprintf("%d", gear.ActivityCount),
+			"shoe_warning":        strconv.FormatBool(worn),
+		},
+	}
+	if showInDescription {
+		icon := "👟"
+		if gear.Type == pbuser.GearType_GEAR_TYPE_BIKE {
+			icon = "🚲"
+		}
+		result.Description = fmt.Sprintf("%s Gear: %s (%.0f km)", icon, gear.Name, totalKm)
+		if worn {
+			result.Description += fmt.Sprintf("\n⚠️ Over %.0f km — time to think about replacing them", warningKm)
+		}
+	}
+	return result, nil
+}
+
+// resolveGearID picks the gear for the activity: a gear_rules entry for its
+// activity type wins, otherwise default_gear.
+func resolveGearID(logger *slog.Logger, activityType pbactivity.ActivityType, inputs map[string]string) string {
+	if rulesJSON := inputs["gear_rules"]; rulesJSON != "" {
+		var rules map[string]string
+		if err := json.Unmarshal([]byte(rulesJSON), &rules); err != nil {
+			logger.Warn("gear_tracker: failed to parse gear_rules", "error", err)
+		} else if id := rules[activityType.String()]; id != "" {
+			return id
+		}
+	}
+	return inputs["default_gear"]
+}
+
+func skipped(reason, detail string) *providers.EnrichmentResult {
+	return &providers.EnrichmentResult{
+		Skipped:    true,
+		SkipReason: detail,
+		Metadata: map[string]string{
+			"gear_tracker_status": "skipped",
+			"reason":              reason,
+			"status_detail":       detail,
+		},
+	}
+}
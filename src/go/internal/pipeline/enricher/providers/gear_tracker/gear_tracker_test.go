@@ -0,0 +1,201 @@
+package gear_tracker
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	user "github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+var testUser = &user.Record{UserProfile: &pbuser.UserProfile{UserId: "u1"}}
+
+func run(km float64) *pbactivity.StandardizedActivity {
+	return &pbactivity.StandardizedActivity{
+		Type:     pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		Sessions: []*pbactivity.Session{{TotalDistance: km * 1000}},
+	}
+}
+
+// gearDB holds a single piece of gear, counting each activity on it once
+// like the use markers do, and records the last counted write.
+func gearDB(gear *pbuser.Gear, saved **pbuser.Gear) *mocks.MockDatabase {
+	uses := map[string]bool{}
+	if gear != nil && gear.LastActivityId != "" {
+		uses[gear.LastActivityId] = true
+	}
+	return &mocks.MockDatabase{
+		GetGearFunc: func(ctx context.Context, userId, gearId string) (*pbuser.Gear, error) {
+			if gear == nil || gearId != gear.Id {
+				return nil, status.Error(codes.NotFound, "not found")
+			}
+			return proto.Clone(gear).(*pbuser.Gear), nil
+		},
+		RecordGearUseFunc: func(ctx context.Context, userId, gearId, activityId string, distanceMeters float64, usedAt time.Time) (*pbuser.Gear, bool, error) {
+			if gear == nil || gearId != gear.Id {
+				return nil, false, status.Error(codes.NotFound, "not found")
+			}
+			if uses[activityId] {
+				return proto.Clone(gear).(*pbuser.Gear), false, nil
+			}
+			uses[activityId] = true
+			gear.DistanceMeters += distanceMeters
+			gear.ActivityCount++
+			gear.LastActivityId = activityId
+			gear.LastUsedAt = timestamppb.New(usedAt)
+			*saved = proto.Clone(gear).(*pbuser.Gear)
+			return proto.Clone(gear).(*pbuser.Gear), true, nil
+		},
+	}
+}
+
+func TestGearTracker_AddsDistance(t *testing.T) {
+	var saved *pbuser.Gear
+	p := NewGearTracker()
+	p.SetService(&bootstrap.Service{DB: gearDB(&pbuser.Gear{
+		Id:             "pegasus",
+		Name:           "Pegasus 40",
+		Type:           pbuser.GearType_GEAR_TYPE_SHOES,
+		DistanceMeters: 600000,
+		ActivityCount:  57,
+	}, &saved)})
+
+	inputs := map[string]string{"default_gear": "pegasus", "external_id": "act-58"}
+	res, err := p.Enrich(context.Background(), slog.Default(), run(12), testUser, inputs, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if saved == nil || saved.DistanceMeters != 612000 || saved.ActivityCount != 58 || saved.LastActivityId != "act-58" {
+		t.Fatalf("Expected 612km over 58 activities, got %v", saved)
+	}
+	if res.Description != "👟 Gear: Pegasus 40 (612 km)" {
+		t.Errorf("Unexpected description: %q", res.Description)
+	}
+	if res.Metadata["shoe_warning"] != "false" {
+		t.Errorf("Expected no shoe warning, got %v", res.Metadata)
+	}
+}
+
+func TestGearTracker_ShoeWarning(t *testing.T) {
+	var saved *pbuser.Gear
+	p := NewGearTracker()
+	p.SetService(&bootstrap.Service{DB: gearDB(&pbuser.Gear{
+		Id:             "pegasus",
+		Name:           "Pegasus 40",
+		Type:           pbuser.GearType_GEAR_TYPE_SHOES,
+		DistanceMeters: 495000,
+	}, &saved)})
+
+	inputs := map[string]string{"default_gear": "pegasus", "shoe_warning_km": "500", "external_id": "act-1"}
+	res, _ := p.Enrich(context.Background(), slog.Default(), run(10), testUser, inputs, false)
+
+	want := "👟 Gear: Pegasus 40 (505 km)\n⚠️ Over 500 km — time to think about replacing them"
+	if res.Description != want {
+		t.Errorf("Expected %q, got %q", want, res.Description)
+	}
+}
+
+func TestGearTracker_RulesByActivityType(t *testing.T) {
+	var saved *pbuser.Gear
+	p := NewGearTracker()
+	p.SetService(&bootstrap.Service{DB: gearDB(&pbuser.Gear{
+		Id:   "canyon",
+		Name: "Canyon Endurace",
+		Type: pbuser.GearType_GEAR_TYPE_BIKE,
+	}, &saved)})
+
+	ride := run(40)
+	ride.Type = pbactivity.ActivityType_ACTIVITY_TYPE_RIDE
+	inputs := map[string]string{
+		"gear_rules":   `{"ACTIVITY_TYPE_RIDE": "canyon", "ACTIVITY_TYPE_RUN": "pegasus"}`,
+		"default_gear": "pegasus",
+		"external_id":  "act-1",
+	}
+	res, err := p.Enrich(context.Background(), slog.Default(), ride, testUser, inputs, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.Description != "🚲 Gear: Canyon Endurace (40 km)" {
+		t.Errorf("Unexpected description: %q", res.Description)
+	}
+}
+
+func TestGearTracker_SameActivityCountedOnce(t *testing.T) {
+	var saved *pbuser.Gear
+	p := NewGearTracker()
+	p.SetService(&bootstrap.Service{DB: gearDB(&pbuser.Gear{
+		Id:             "pegasus",
+		Name:           "Pegasus 40",
+		Type:           pbuser.GearType_GEAR_TYPE_SHOES,
+		DistanceMeters: 600000,
+	}, &saved)})
+
+	for _, id := range []string{"act-58", "act-59"} {
+		inputs := map[string]string{"default_gear": "pegasus", "external_id": id}
+		if _, err := p.Enrich(context.Background(), slog.Default(), run(12), testUser, inputs, false); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	// act-58 redelivered after act-59 has been counted
+	saved = nil
+	inputs := map[string]string{"default_gear": "pegasus", "external_id": "act-58"}
+	res, _ := p.Enrich(context.Background(), slog.Default(), run(12), testUser, inputs, false)
+
+	if saved != nil {
+		t.Errorf("Expected no write for an already counted activity, got %v", saved)
+	}
+	if res.Metadata["gear_distance_km"] != "624.0" || res.Metadata["gear_activity_count"] != "2" {
+		t.Errorf("Expected 624km over 2 activities, got %v", res.Metadata)
+	}
+}
+
+func TestGearTracker_SkipsOnSourceUpdate(t *testing.T) {
+	if !NewGearTracker().SkipOnSourceUpdate() {
+		t.Error("Expected gear tracker to skip source updates")
+	}
+}
+
+func TestGearTracker_Skips(t *testing.T) {
+	retired := &pbuser.Gear{Id: "old", Name: "Old Pair", Type: pbuser.GearType_GEAR_TYPE_SHOES, Retired: true}
+
+	tests := []struct {
+		name   string
+		gear   *pbuser.Gear
+		inputs map[string]string
+		reason string
+	}{
+		{"no gear assigned", retired, map[string]string{"gear_rules": `{"ACTIVITY_TYPE_RIDE": "old"}`}, "no_gear_assigned"},
+		{"gear deleted", nil, map[string]string{"default_gear": "gone"}, "gear_not_found"},
+		{"gear retired", retired, map[string]string{"default_gear": "old"}, "gear_retired"},
+		{"no activity ID", &pbuser.Gear{Id: "new", Name: "New Pair"}, map[string]string{"default_gear": "new"}, "no_activity_id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved *pbuser.Gear
+			p := NewGearTracker()
+			p.SetService(&bootstrap.Service{DB: gearDB(tt.gear, &saved)})
+
+			res, err := p.Enrich(context.Background(), slog.Default(), run(5), testUser, tt.inputs, false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !res.Skipped || res.Metadata["reason"] != tt.reason {
+				t.Errorf("Expected skip %q, got %v", tt.reason, res.Metadata)
+			}
+			if saved != nil {
+				t.Errorf("Expected no write, got %v", saved)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package goal_progress
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/fitglue/server/src/go/pkg/description"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// barLength is the number of cells in a progress bar.
+const barLength = 10
+
+// GoalProgress adds the activity to each of the user's goals in
+// users/{id}/goals that it counts towards, and shows a progress bar for each.
+type GoalProgress struct {
+	Service *bootstrap.Service
+}
+
+func init() {
+	providers.Register(NewGoalProgress())
+}
+
+func NewGoalProgress() *GoalProgress {
+	return &GoalProgress{}
+}
+
+func (p *GoalProgress) SetService(service *bootstrap.Service) {
+	p.Service = service
+}
+
+func (p *GoalProgress) Name() string {
+	return "goal-progress"
+}
+
+func (p *GoalProgress) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_GOAL_PROGRESS
+}
+
+func (p *GoalProgress) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	logger.Debug("goal_progress: starting", "activity_name", activity.Name, "activity_type", activity.Type)
+
+	if p.Service == nil || p.Service.DB == nil {
+		return nil, fmt.Errorf("service not initialized")
+	}
+
+	goals, err := p.Service.DB.ListGoals(ctx, user.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list goals: %w", err)
+	}
+
+	var selected map[string]bool
+	if ids := inputs["goal_ids"]; ids != "" {
+		selected = make(map[string]bool)
+		for _, id := range strings.Split(ids, ",") {
+			selected[strings.TrimSpace(id)] = true
+		}
+	}
+
+	var distance, duration float64
+	for _, session := range activity.Sessions {
+		distance += session.TotalDistance
+		duration += session.TotalElapsedTime
+	}
+	now := timestamppb.Now()
+	if activity.StartTime != nil {
+		now = activity.StartTime
+	}
+	externalID := inputs["external_id"]
+
+	var lines, completed []string
+	for _, goal := range goals {
+		if selected != nil && !selected[goal.Id] {
+			continue
+		}
+		if !counts(goal, activity.Type, now) {
+			continue
+		}
+
+		// A re-run of the same activity must not count it twice
+		if externalID == "" || externalID != goal.LastActivityId {
+			switch goal.Metric {
+			case pbuser.GoalMetric_GOAL_METRIC_DISTANCE:
+				goal.Progress += distance
+			case pbuser.GoalMetric_GOAL_METRIC_DURATION:
+				goal.Progress += duration
+			case pbuser.GoalMetric_GOAL_METRIC_ACTIVITIES:
+				goal.Progress++
+			}
+			goal.ActivityCount++
+			goal.LastActivityId = externalID
+			if goal.CompletedAt == nil && goal.Progress >= goal.Target {
+				goal.CompletedAt = now
+				completed = append(completed, goal.Name)
+			}
+			if err := p.Service.DB.SetGoal(ctx, user.UserId, goal); err != nil {
+				return nil, fmt.Errorf("failed to update goal %s: %w", goal.Id, err)
+			}
+		}
+
+		lines = append(lines, renderGoal(goal))
+	}
+
+	if len(lines) == 0 {
+		return &providers.EnrichmentResult{
+			Skipped:    true,
+			SkipReason: "No goals this activity counts towards",
+			Metadata: map[string]string{
+				"goal_progress_status": "skipped",
+				"reason":               "no_matching_goals",
+			},
+		}, nil
+	}
+
+	logger.Info("Goal progress updated", "goals", len(lines), "completed", len(completed))
+
+	return &providers.EnrichmentResult{
+		Description: providers.SectionHeader(user, description.SectionGoals) + "\n" + strings.Join(lines, "\n"),
+		Metadata: map[string]string{
+			"goal_progress_status": "success",
+			"goals_updated":        fmt.Sprintf("%d", len(lines)),
+			"goals_completed":      strings.Join(completed, ", "),
+		},
+	}, nil
+}
+
+// counts reports whether an activity of the given type at the given time
+// counts towards the goal.
+func counts(goal *pbuser.Goal, activityType pbactivity.ActivityType, at *timestamppb.Timestamp) bool {
+	if goal.Metric == pbuser.GoalMetric_GOAL_METRIC_UNSPECIFIED || goal.Target <= 0 {
+		return false
+	}
+	if goal.ActivityType != pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED && goal.ActivityType != activityType {
+		return false
+	}
+	t := at.AsTime()
+	if goal.StartDate != nil && t.Before(goal.StartDate.AsTime()) {
+		return false
+	}
+	if goal.EndDate != nil && !t.Before(goal.EndDate.AsTime()) {
+		return false
+	}
+	return true
+}
+
+// renderGoal formats a goal as "name: ▓▓▓▓▓▓░░░░ 62% (620 / 1000 km)".
+func renderGoal(goal *pbuser.Goal) string {
+	fraction := goal.Progress / goal.Target
+	filled := int(fraction * barLength)
+	if filled > barLength {
+		filled = barLength
+	}
+	bar := strings.Repeat("▓", filled) + strings.Repeat("░", barLength-filled)
+
+	line := fmt.Sprintf("• %s: %s %.0f%% (%s)", goal.Name, bar, fraction*100, formatAmount(goal.Metric, goal.Progress, goal.Target))
+	if goal.CompletedAt != nil {
+		line += " ✅"
+	}
+	return line
+}
+
+func formatAmount(metric pbuser.GoalMetric, progress, target float64) string {
+	switch metric {
+	case pbuser.GoalMetric_GOAL_METRIC_DISTANCE:
+		return fmt.Sprintf("%s / %s km", trimFloat(progress/1000), trimFloat(target/1000))
+	case pbuser.GoalMetric_GOAL_METRIC_DURATION:
+		return fmt.Sprintf("%s / %s h", trimFloat(progress/3600), trimFloat(target/3600))
+	default:
+		return fmt.Sprintf("%.0f / %.0f", progress, target)
+	}
+}
+
+// trimFloat shows one decimal place only for small amounts, where it matters.
+func trimFloat(v float64) string {
+	if v >= 100 {
+		return fmt.Sprintf("%.0f", v)
+	}
+	return strings.TrimSuffix(fmt.Sprintf("%.1f", v), ".0")
+}
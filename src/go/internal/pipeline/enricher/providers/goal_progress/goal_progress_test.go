@@ -0,0 +1,141 @@
+package goal_progress
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	user "github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+var testUser = &user.Record{UserProfile: &pbuser.UserProfile{UserId: "u1"}}
+
+func date(y int, m time.Month, d int) *timestamppb.Timestamp {
+	return timestamppb.New(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
+
+func run(km float64) *pbactivity.StandardizedActivity {
+	return &pbactivity.StandardizedActivity{
+		Type:      pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		StartTime: date(2025, 6, 1),
+		Sessions:  []*pbactivity.Session{{TotalDistance: km * 1000, TotalElapsedTime: 3600}},
+	}
+}
+
+// goalsDB holds the user's goals and records writes to them.
+func goalsDB(goals []*pbuser.Goal, saved map[string]*pbuser.Goal) *mocks.MockDatabase {
+	return &mocks.MockDatabase{
+		ListGoalsFunc: func(ctx context.Context, userId string) ([]*pbuser.Goal, error) {
+			return goals, nil
+		},
+		SetGoalFunc: func(ctx context.Context, userId string, g *pbuser.Goal) error {
+			saved[g.Id] = g
+			return nil
+		},
+	}
+}
+
+func TestGoalProgress_UpdatesMatchingGoals(t *testing.T) {
+	saved := map[string]*pbuser.Goal{}
+	p := NewGoalProgress()
+	p.SetService(&bootstrap.Service{DB: goalsDB([]*pbuser.Goal{
+		{
+			Id: "1000k", Name: "1000 km in 2025", Metric: pbuser.GoalMetric_GOAL_METRIC_DISTANCE, Target: 1000000,
+			ActivityType: pbactivity.ActivityType_ACTIVITY_TYPE_RUN, StartDate: date(2025, 1, 1), EndDate: date(2026, 1, 1),
+			Progress: 608000,
+		},
+		{Id: "100", Name: "100 workouts", Metric: pbuser.GoalMetric_GOAL_METRIC_ACTIVITIES, Target: 100, Progress: 99},
+		{Id: "rides", Name: "Ride 5000 km", Metric: pbuser.GoalMetric_GOAL_METRIC_DISTANCE, Target: 5000000, ActivityType: pbactivity.ActivityType_ACTIVITY_TYPE_RIDE},
+		{Id: "2024", Name: "2024", Metric: pbuser.GoalMetric_GOAL_METRIC_ACTIVITIES, Target: 200, EndDate: date(2025, 1, 1)},
+	}, saved)})
+
+	inputs := map[string]string{"external_id": "act-1"}
+	res, err := p.Enrich(context.Background(), slog.Default(), run(12), testUser, inputs, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := "🎯 Goals:\n• 1000 km in 2025: ▓▓▓▓▓▓░░░░ 62% (620 / 1000 km)\n• 100 workouts: ▓▓▓▓▓▓▓▓▓▓ 100% (100 / 100) ✅"
+	if res.Description != want {
+		t.Errorf("Expected %q, got %q", want, res.Description)
+	}
+	if len(saved) != 2 || saved["1000k"].Progress != 620000 || saved["1000k"].LastActivityId != "act-1" {
+		t.Fatalf("Expected two goals saved, got %v", saved)
+	}
+	if saved["100"].CompletedAt == nil || res.Metadata["goals_completed"] != "100 workouts" {
+		t.Errorf("Expected 100 workouts to be completed, got %v", res.Metadata)
+	}
+}
+
+func TestGoalProgress_RerunNotCountedTwice(t *testing.T) {
+	saved := map[string]*pbuser.Goal{}
+	p := NewGoalProgress()
+	p.SetService(&bootstrap.Service{DB: goalsDB([]*pbuser.Goal{
+		{Id: "100", Name: "100 workouts", Metric: pbuser.GoalMetric_GOAL_METRIC_ACTIVITIES, Target: 100, Progress: 42, LastActivityId: "act-1"},
+	}, saved)})
+
+	res, err := p.Enrich(context.Background(), slog.Default(), run(5), testUser, map[string]string{"external_id": "act-1"}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(saved) != 0 {
+		t.Errorf("Expected no writes for a re-run, got %v", saved)
+	}
+	if res.Description != "🎯 Goals:\n• 100 workouts: ▓▓▓▓░░░░░░ 42% (42 / 100)" {
+		t.Errorf("Unexpected description: %q", res.Description)
+	}
+}
+
+func TestGoalProgress_GoalIDsAndDuration(t *testing.T) {
+	saved := map[string]*pbuser.Goal{}
+	p := NewGoalProgress()
+	p.SetService(&bootstrap.Service{DB: goalsDB([]*pbuser.Goal{
+		{Id: "hours", Name: "50 hours", Metric: pbuser.GoalMetric_GOAL_METRIC_DURATION, Target: 50 * 3600, Progress: 4 * 3600},
+		{Id: "100", Name: "100 workouts", Metric: pbuser.GoalMetric_GOAL_METRIC_ACTIVITIES, Target: 100},
+	}, saved)})
+
+	res, err := p.Enrich(context.Background(), slog.Default(), run(5), testUser, map[string]string{"goal_ids": "hours"}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.Description != "🎯 Goals:\n• 50 hours: ▓░░░░░░░░░ 10% (5 / 50 h)" {
+		t.Errorf("Unexpected description: %q", res.Description)
+	}
+	if _, ok := saved["100"]; ok {
+		t.Error("Expected goals outside goal_ids to be left alone")
+	}
+}
+
+func TestGoalProgress_NoGoals(t *testing.T) {
+	p := NewGoalProgress()
+	p.SetService(&bootstrap.Service{DB: goalsDB(nil, map[string]*pbuser.Goal{})})
+
+	res, err := p.Enrich(context.Background(), slog.Default(), run(5), testUser, map[string]string{}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !res.Skipped || res.Metadata["reason"] != "no_matching_goals" {
+		t.Errorf("Expected skip with no_matching_goals, got %v", res.Metadata)
+	}
+}
+
+func TestGoalProgress_ListError(t *testing.T) {
+	p := NewGoalProgress()
+	p.SetService(&bootstrap.Service{DB: &mocks.MockDatabase{
+		ListGoalsFunc: func(ctx context.Context, userId string) ([]*pbuser.Goal, error) {
+			return nil, errors.New("unavailable")
+		},
+	}})
+
+	if _, err := p.Enrich(context.Background(), slog.Default(), run(5), testUser, map[string]string{}, false); err == nil {
+		t.Error("Expected an error when goals can't be listed")
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/description"
 
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 
@@ -133,7 +134,8 @@ func (p *GoalTracker) Enrich(ctx context.Context, logger *slog.Logger, activity
 	// Progress bar
 	progressBar := buildProgressBar(percentage)
 
-	sb.WriteString(fmt.Sprintf("🎯 %s Goal Progress:\n", periodLabel))
+	goalSection := description.DefaultSection(description.SectionGoalProgress)
+	sb.WriteString(providers.SectionHeaderTitled(user, description.SectionGoalProgress, goalSection.Emoji, periodLabel+" "+goalSection.Title) + "\n")
 	sb.WriteString(fmt.Sprintf("• %s %.1f/%.0f %s\n", progressBar, newTotal, target, metricLabel))
 	sb.WriteString(fmt.Sprintf("• ➕ This activity: +%.1f %s", activityValue, metricLabel))
 
@@ -0,0 +1,24 @@
+package providers
+
+import (
+	"github.com/fitglue/server/src/go/pkg/description"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+)
+
+// SectionHeader renders the description section header registered under key
+// (see pkg/description/headers.go) with the user's header preferences.
+func SectionHeader(u *user.Record, key string) string {
+	if u == nil {
+		return description.Header(key, nil)
+	}
+	return description.Header(key, u.GetDescriptionHeaders())
+}
+
+// SectionHeaderTitled is SectionHeader for a header whose emoji and title
+// depend on the activity.
+func SectionHeaderTitled(u *user.Record, key, emoji, title string) string {
+	if u == nil {
+		return description.HeaderTitled(key, emoji, title, nil)
+	}
+	return description.HeaderTitled(key, emoji, title, u.GetDescriptionHeaders())
+}
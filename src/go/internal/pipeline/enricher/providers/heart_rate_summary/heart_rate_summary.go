@@ -3,6 +3,7 @@ package heart_rate_summary
 import (
 	"context"
 	"fmt"
+	"github.com/fitglue/server/src/go/pkg/description"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
 	"log/slog"
 	"strings"
@@ -123,7 +124,7 @@ func (p *HeartRateSummary) Enrich(ctx context.Context, logger *slog.Logger, acti
 
 	if showDrift {
 		// Multi-line bullet format
-		sb.WriteString("❤️ Heart Rate:\n")
+		sb.WriteString(providers.SectionHeader(user, description.SectionHeartRate) + "\n")
 		sb.WriteString(fmt.Sprintf("• %d bpm min\n", minHR))
 		sb.WriteString(fmt.Sprintf("• %.0f bpm avg\n", avgHR))
 		sb.WriteString(fmt.Sprintf("• %d bpm max\n", maxHR))
@@ -2,9 +2,12 @@ package heart_rate_zones
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"github.com/fitglue/server/src/go/pkg/description"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
 	"log/slog"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -35,6 +38,69 @@ var StandardZones = []HeartRateZone{
 	{Name: "Zone 5 (VO2 Max)", MinPct: 0.90, MaxPct: 1.00, Emoji: "🟥"},
 }
 
+// maxHeartRateZonePcts are the upper boundaries of StandardZones 0-4 as a
+// share of max HR.
+var maxHeartRateZonePcts = zoneBounds{0.50, 0.60, 0.70, 0.80, 0.90}
+
+// lactateThresholdZonePcts are the upper boundaries of zones 0-4 as a share of
+// lactate threshold heart rate; zone 5 is everything above threshold.
+var lactateThresholdZonePcts = zoneBounds{0.75, 0.85, 0.90, 0.95, 1.00}
+
+// zoneBounds holds the upper boundary of zones 0-4. Zone 5 is open-ended.
+type zoneBounds [5]float64
+
+// boundsFromPercentages scales percentage-based zones to bpm for the given
+// reference heart rate.
+func boundsFromPercentages(pcts zoneBounds, reference float64) zoneBounds {
+	var b zoneBounds
+	for i, pct := range pcts {
+		b[i] = pct * reference
+	}
+	return b
+}
+
+// index returns the zone (0-5) a heart rate falls into.
+func (b zoneBounds) index(hr float64) int {
+	for i, upper := range b {
+		if hr < upper {
+			return i
+		}
+	}
+	return len(b)
+}
+
+// parseCustomZones parses five strictly increasing bpm values, the upper
+// boundaries of zones 0-4.
+func parseCustomZones(v string) (zoneBounds, error) {
+	var b zoneBounds
+	parts := strings.Split(v, ",")
+	if len(parts) != len(b) {
+		return b, fmt.Errorf("expected %d zone boundaries, got %d", len(b), len(parts))
+	}
+	for i, part := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || f <= 0 {
+			return b, fmt.Errorf("invalid zone boundary %q", part)
+		}
+		if i > 0 && f <= b[i-1] {
+			return b, fmt.Errorf("zone boundaries must increase, got %q after %.0f", part, b[i-1])
+		}
+		b[i] = f
+	}
+	return b, nil
+}
+
+// zoneChartEntry is one zone in the hr_zones_chart metadata, which the
+// showcase page renders as a time-in-zone chart.
+type zoneChartEntry struct {
+	Zone    int     `json:"zone"`
+	Name    string  `json:"name"`
+	MinBpm  int     `json:"min_bpm"`
+	MaxBpm  int     `json:"max_bpm,omitempty"` // 0 for the open-ended top zone
+	Seconds int     `json:"seconds"`
+	Percent float64 `json:"percent"`
+}
+
 type HeartRateZonesProvider struct {
 	Service *bootstrap.Service
 }
@@ -65,14 +131,47 @@ func (p *HeartRateZonesProvider) Enrich(ctx context.Context, logger *slog.Logger
 		"session_count", len(activity.Sessions),
 	)
 
-	// Parse config options
+	// Parse config options. Thresholds set on the enricher win over the ones
+	// stored on the user's profile.
 	maxHR := 190.0
+	if user != nil && user.GetMaxHeartRate() > 0 {
+		maxHR = float64(user.GetMaxHeartRate())
+	}
 	if v, ok := inputs["max_hr"]; ok {
 		if f, err := strconv.ParseFloat(v, 64); err == nil && f > 0 {
 			maxHR = f
 		}
 	}
 
+	lthr := 0.0
+	if user != nil && user.GetLactateThresholdHeartRate() > 0 {
+		lthr = float64(user.GetLactateThresholdHeartRate())
+	}
+	if v, ok := inputs["lthr"]; ok {
+		if f, err := strconv.ParseFloat(v, 64); err == nil && f > 0 {
+			lthr = f
+		}
+	}
+
+	basis := "max_hr"
+	bounds := boundsFromPercentages(maxHeartRateZonePcts, maxHR)
+	switch inputs["zone_basis"] {
+	case "lthr":
+		if lthr > 0 {
+			basis = "lthr"
+			bounds = boundsFromPercentages(lactateThresholdZonePcts, lthr)
+		} else {
+			logger.Warn("heart_rate_zones: no lactate threshold set, falling back to max HR zones")
+		}
+	case "custom":
+		if custom, err := parseCustomZones(inputs["custom_zones"]); err == nil {
+			basis = "custom"
+			bounds = custom
+		} else {
+			logger.Warn("heart_rate_zones: invalid custom zones, falling back to max HR zones", "error", err)
+		}
+	}
+
 	style := "emoji" // Default style
 	if v, ok := inputs["style"]; ok {
 		style = v
@@ -95,6 +194,7 @@ func (p *HeartRateZonesProvider) Enrich(ctx context.Context, logger *slog.Logger
 	zoneDurations := make([]time.Duration, len(StandardZones))
 	var lastTime *time.Time
 	var totalDuration time.Duration
+	var peakHR int32
 
 	for _, session := range activity.Sessions {
 		for _, lap := range session.Laps {
@@ -102,6 +202,9 @@ func (p *HeartRateZonesProvider) Enrich(ctx context.Context, logger *slog.Logger
 				if record.HeartRate <= 0 {
 					continue
 				}
+				if record.HeartRate > peakHR {
+					peakHR = record.HeartRate
+				}
 
 				currentTime := record.Timestamp.AsTime()
 				if lastTime != nil {
@@ -113,8 +216,7 @@ func (p *HeartRateZonesProvider) Enrich(ctx context.Context, logger *slog.Logger
 
 					if delta > 0 {
 						// Determine which zone this HR falls into
-						hrPct := float64(record.HeartRate) / maxHR
-						zoneIdx := getZoneIndex(hrPct)
+						zoneIdx := bounds.index(float64(record.HeartRate))
 						if zoneIdx < len(zoneDurations) {
 							zoneDurations[zoneIdx] += delta
 							totalDuration += delta
@@ -138,7 +240,7 @@ func (p *HeartRateZonesProvider) Enrich(ctx context.Context, logger *slog.Logger
 
 	// Generate output based on style
 	var sb strings.Builder
-	sb.WriteString("❤️ Heart Rate Zones:\n")
+	sb.WriteString(providers.SectionHeader(user, description.SectionHeartRateZones) + "\n")
 
 	for i, zone := range StandardZones {
 		duration := zoneDurations[i]
@@ -149,38 +251,66 @@ func (p *HeartRateZonesProvider) Enrich(ctx context.Context, logger *slog.Logger
 
 	logger.Info("Heart rate zones calculated",
 		"total_duration", totalDuration,
+		"zone_basis", basis,
 		"max_hr", maxHR,
 	)
 
-	return &providers.EnrichmentResult{
-		Description: sb.String(),
-		Metadata: map[string]string{
-			"hr_zones_status": "success",
-			"max_hr":          fmt.Sprintf("%.0f", maxHR),
-			"total_duration":  fmt.Sprintf("%.0f", totalDuration.Minutes()),
-			"zone0_minutes":   fmt.Sprintf("%d", int(zoneDurations[0].Minutes())),
-			"zone1_minutes":   fmt.Sprintf("%d", int(zoneDurations[1].Minutes())),
-			"zone2_minutes":   fmt.Sprintf("%d", int(zoneDurations[2].Minutes())),
-			"zone3_minutes":   fmt.Sprintf("%d", int(zoneDurations[3].Minutes())),
-			"zone4_minutes":   fmt.Sprintf("%d", int(zoneDurations[4].Minutes())),
-			"zone5_minutes":   fmt.Sprintf("%d", int(zoneDurations[5].Minutes())),
-		},
-	}, nil
-}
+	metadata := map[string]string{
+		"hr_zones_status": "success",
+		"zone_basis":      basis,
+		"max_hr":          fmt.Sprintf("%.0f", maxHR),
+		"total_duration":  fmt.Sprintf("%.0f", totalDuration.Minutes()),
+	}
+	if basis == "lthr" {
+		metadata["lthr"] = fmt.Sprintf("%.0f", lthr)
+	}
 
-// getZoneIndex returns the zone index (0-5) for a given HR percentage
-func getZoneIndex(hrPct float64) int {
+	chart := make([]zoneChartEntry, len(StandardZones))
 	for i, zone := range StandardZones {
-		if hrPct >= zone.MinPct && hrPct < zone.MaxPct {
-			return i
+		metadata[fmt.Sprintf("zone%d_minutes", i)] = fmt.Sprintf("%d", int(zoneDurations[i].Minutes()))
+		metadata[fmt.Sprintf("zone%d_seconds", i)] = fmt.Sprintf("%d", int(zoneDurations[i].Seconds()))
+
+		entry := zoneChartEntry{
+			Zone:    i,
+			Name:    zone.Name,
+			Seconds: int(zoneDurations[i].Seconds()),
+			Percent: math.Round(float64(zoneDurations[i])/float64(totalDuration)*1000) / 10,
+		}
+		if i > 0 {
+			entry.MinBpm = int(math.Round(bounds[i-1]))
+		}
+		if i < len(bounds) {
+			entry.MaxBpm = int(math.Round(bounds[i]))
 		}
+		chart[i] = entry
 	}
-	// Handle edge case: exactly at max HR (100%)
-	if hrPct >= 1.0 {
-		return 5 // Zone 5
+	if chartJSON, err := json.Marshal(chart); err == nil {
+		metadata["hr_zones_chart"] = string(chartJSON)
+	}
+
+	// Native FIT zone fields. The open-ended top zone is bounded by the max
+	// HR, or the highest heart rate recorded if that's above it.
+	timeInZone := make([]float64, len(StandardZones))
+	highBoundary := make([]int32, len(StandardZones))
+	for i := range StandardZones {
+		timeInZone[i] = zoneDurations[i].Seconds()
+		if i < len(bounds) {
+			highBoundary[i] = int32(math.Round(bounds[i]))
+		}
 	}
-	// Should not reach here since Zone 0 covers 0-50%
-	return 0
+	highBoundary[len(bounds)] = int32(math.Round(math.Max(math.Max(maxHR, float64(peakHR)), bounds[len(bounds)-1])))
+
+	return &providers.EnrichmentResult{
+		Description:        sb.String(),
+		Metadata:           metadata,
+		TimeInHrZone:       timeInZone,
+		HrZoneHighBoundary: highBoundary,
+	}, nil
+}
+
+// getZoneIndex returns the zone index (0-5) for a given percentage of max HR
+func getZoneIndex(hrPct float64) int {
+	return boundsFromPercentages(maxHeartRateZonePcts, 1).index(hrPct)
 }
 
 // formatZoneRow formats a single zone row based on style
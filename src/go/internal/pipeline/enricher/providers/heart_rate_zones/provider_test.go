@@ -10,6 +10,7 @@ import (
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 
 	"context"
+	"encoding/json"
 	"log/slog"
 	"testing"
 	"time"
@@ -275,6 +276,121 @@ func TestGetZoneIndex(t *testing.T) {
 	}
 }
 
+// steadyActivity returns an activity with one record per minute at each of the
+// given heart rates in turn.
+func steadyActivity(hrs ...int32) *pbactivity.StandardizedActivity {
+	baseTime := time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+	var records []*pbactivity.Record
+	for i, hr := range hrs {
+		records = append(records, &pbactivity.Record{HeartRate: hr, Timestamp: timestamppb.New(baseTime.Add(time.Duration(i) * time.Minute))})
+	}
+	return &pbactivity.StandardizedActivity{
+		StartTime: timestamppb.New(baseTime),
+		Sessions:  []*pbactivity.Session{{Laps: []*pbactivity.Lap{{Records: records}}}},
+	}
+}
+
+func TestHeartRateZones_Enrich_ZoneBasis(t *testing.T) {
+	maxHR := int32(200)
+	lthr := int32(170)
+	profileUser := &user.Record{UserProfile: &pbuser.UserProfile{UserId: "test-user", MaxHeartRate: &maxHR, LactateThresholdHeartRate: &lthr}}
+
+	tests := []struct {
+		name      string
+		user      *user.Record
+		inputs    map[string]string
+		wantBasis string
+		wantZone  string // zone expected to hold all the time for a steady 150 bpm
+	}{
+		{"profile_max_hr", profileUser, nil, "max_hr", "zone3_seconds"}, // 150/200 = 75%
+		{"config_max_hr_wins", profileUser, map[string]string{"max_hr": "160"}, "max_hr", "zone5_seconds"},
+		{"profile_lthr", profileUser, map[string]string{"zone_basis": "lthr"}, "lthr", "zone2_seconds"}, // 150/170 = 88%
+		{"lthr_missing_falls_back", &user.Record{UserProfile: &pbuser.UserProfile{}}, map[string]string{"zone_basis": "lthr"}, "max_hr", "zone3_seconds"},
+		{"custom", nil, map[string]string{"zone_basis": "custom", "custom_zones": "100, 120, 140, 155, 170"}, "custom", "zone3_seconds"},
+		{"custom_invalid_falls_back", nil, map[string]string{"zone_basis": "custom", "custom_zones": "100,90,140,155,170"}, "max_hr", "zone3_seconds"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := NewHeartRateZonesProvider()
+			result, err := provider.Enrich(context.Background(), slog.Default(), steadyActivity(150, 150, 150, 150), tt.user, tt.inputs, false)
+			if err != nil {
+				t.Fatalf("Enrich failed: %v", err)
+			}
+			if got := result.Metadata["zone_basis"]; got != tt.wantBasis {
+				t.Errorf("zone_basis = %q, want %q", got, tt.wantBasis)
+			}
+			if got := result.Metadata[tt.wantZone]; got != "180" {
+				t.Errorf("%s = %q, want 180 (metadata %v)", tt.wantZone, got, result.Metadata)
+			}
+		})
+	}
+}
+
+func TestHeartRateZones_Enrich_ChartMetadata(t *testing.T) {
+	provider := NewHeartRateZonesProvider()
+	result, err := provider.Enrich(context.Background(), slog.Default(), steadyActivity(100, 100, 130, 130, 130), nil, map[string]string{"max_hr": "200"}, false)
+	if err != nil {
+		t.Fatalf("Enrich failed: %v", err)
+	}
+
+	var chart []zoneChartEntry
+	if err := json.Unmarshal([]byte(result.Metadata["hr_zones_chart"]), &chart); err != nil {
+		t.Fatalf("invalid hr_zones_chart: %v", err)
+	}
+	if len(chart) != len(StandardZones) {
+		t.Fatalf("expected %d zones, got %d", len(StandardZones), len(chart))
+	}
+	// Each record's time is attributed to the zone of the record that ends it:
+	// 100 bpm for one minute (zone 1) and 130 bpm for three minutes (zone 2).
+	if chart[1].Seconds != 60 || chart[2].Seconds != 180 || chart[2].Percent != 75 {
+		t.Errorf("unexpected zone durations: %+v", chart)
+	}
+	if chart[2].MinBpm != 120 || chart[2].MaxBpm != 140 {
+		t.Errorf("expected zone 2 to span 120-140 bpm, got %+v", chart[2])
+	}
+	if chart[0].MinBpm != 0 || chart[5].MaxBpm != 0 {
+		t.Errorf("expected open-ended outer zones, got %+v / %+v", chart[0], chart[5])
+	}
+}
+
+func TestHeartRateZones_Enrich_FitZoneFields(t *testing.T) {
+	provider := NewHeartRateZonesProvider()
+	result, err := provider.Enrich(context.Background(), slog.Default(), steadyActivity(100, 100, 130, 130, 210), nil, map[string]string{"max_hr": "200"}, false)
+	if err != nil {
+		t.Fatalf("Enrich failed: %v", err)
+	}
+
+	wantTimes := []float64{0, 60, 120, 0, 0, 60}
+	if len(result.TimeInHrZone) != len(wantTimes) {
+		t.Fatalf("expected %d zone times, got %v", len(wantTimes), result.TimeInHrZone)
+	}
+	for i, want := range wantTimes {
+		if result.TimeInHrZone[i] != want {
+			t.Errorf("zone %d: expected %v s, got %v", i, want, result.TimeInHrZone[i])
+		}
+	}
+	// The top zone reaches the recorded 210 bpm peak, above the 200 bpm max HR
+	wantBounds := []int32{100, 120, 140, 160, 180, 210}
+	for i, want := range wantBounds {
+		if result.HrZoneHighBoundary[i] != want {
+			t.Errorf("zone %d: expected upper bound %d, got %v", i, want, result.HrZoneHighBoundary)
+			break
+		}
+	}
+}
+
+func TestParseCustomZones(t *testing.T) {
+	if b, err := parseCustomZones("100,120,140,155,170"); err != nil || b != (zoneBounds{100, 120, 140, 155, 170}) {
+		t.Errorf("unexpected result: %v, %v", b, err)
+	}
+	for _, v := range []string{"", "100,120,140", "100,120,abc,155,170", "100,120,120,155,170", "0,120,140,155,170"} {
+		if _, err := parseCustomZones(v); err == nil {
+			t.Errorf("expected error for %q", v)
+		}
+	}
+}
+
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > 0 && containsHelper(s, substr))
 }
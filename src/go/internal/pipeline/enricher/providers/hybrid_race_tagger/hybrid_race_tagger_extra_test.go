@@ -91,7 +91,7 @@ func TestGenerateDescription_RunOnly(t *testing.T) {
 		{Name: "Run 1", Duration: 300, IsRun: true},
 		{Name: "Run 2", Duration: 280, IsRun: true},
 	}
-	desc := generateDescription(preset, results)
+	desc := generateDescription("🏁 "+preset.Name+":", results)
 	if !strings.Contains(desc, "Test Race") {
 		t.Errorf("expected preset name in description, got %q", desc)
 	}
@@ -108,7 +108,7 @@ func TestGenerateDescription_StationWithWeight(t *testing.T) {
 	results := []StationResult{
 		{Name: "Sled Push", Duration: 120, IsRun: false, Weight: 102.0},
 	}
-	desc := generateDescription(preset, results)
+	desc := generateDescription("🏁 "+preset.Name+":", results)
 	if !strings.Contains(desc, "102") {
 		t.Errorf("expected weight in description, got %q", desc)
 	}
@@ -119,7 +119,7 @@ func TestGenerateDescription_StationWithReps(t *testing.T) {
 	results := []StationResult{
 		{Name: "Wall Ball", Duration: 180, IsRun: false, ExpectedReps: 100},
 	}
-	desc := generateDescription(preset, results)
+	desc := generateDescription("🏁 "+preset.Name+":", results)
 	if !strings.Contains(desc, "100 reps") {
 		t.Errorf("expected reps in description, got %q", desc)
 	}
@@ -130,7 +130,7 @@ func TestGenerateDescription_StationWithRepsAndWeight(t *testing.T) {
 	results := []StationResult{
 		{Name: "Wall Ball", Duration: 180, IsRun: false, ExpectedReps: 100, Weight: 9.0},
 	}
-	desc := generateDescription(preset, results)
+	desc := generateDescription("🏁 "+preset.Name+":", results)
 	if !strings.Contains(desc, "reps") || !strings.Contains(desc, "9") {
 		t.Errorf("expected reps and weight in description, got %q", desc)
 	}
@@ -141,7 +141,7 @@ func TestGenerateDescription_PlainStation(t *testing.T) {
 	results := []StationResult{
 		{Name: "SkiErg", Duration: 200, IsRun: false},
 	}
-	desc := generateDescription(preset, results)
+	desc := generateDescription("🏁 "+preset.Name+":", results)
 	if !strings.Contains(desc, "SkiErg") {
 		t.Errorf("expected station name in description, got %q", desc)
 	}
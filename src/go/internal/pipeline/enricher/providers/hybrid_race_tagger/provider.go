@@ -13,6 +13,7 @@ import (
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/user_input"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/description"
 
 	pendinginput "github.com/fitglue/server/src/go/pkg/pending_input"
 
@@ -257,7 +258,9 @@ func (p *HybridRaceTaggerProvider) EnrichResume(ctx context.Context, activity *p
 	hybridSummary := generateHybridSummary(stationResults)
 
 	// Generate description
-	description := generateDescription(preset, stationResults)
+	race := description.DefaultSection(description.SectionHybridRace)
+	header := providers.SectionHeaderTitled(user, description.SectionHybridRace, race.Emoji, preset.Name)
+	desc := generateDescription(header, stationResults)
 
 	// Update session with transformed data
 	session.Laps = newLaps
@@ -283,7 +286,7 @@ func (p *HybridRaceTaggerProvider) EnrichResume(ctx context.Context, activity *p
 	// (don't modify activity.Description directly - orchestrator overwrites it)
 	return &providers.EnrichmentResult{
 		ActivityType: pbactivity.ActivityType_ACTIVITY_TYPE_WORKOUT,
-		Description:  description,
+		Description:  desc,
 		Tags:         []string{raceTypeTag},
 		ExcludeEnrichers: []pbplugin.EnricherProviderType{
 			pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PACE_SUMMARY, // Disable pace summary duplication
@@ -333,10 +336,10 @@ func generateHybridSummary(results []StationResult) *pbactivity.HybridRaceSummar
 // - Runs: just duration (1km is always the distance)
 // - Stations with weight: duration + weight
 // - Stations with reps (e.g., Wall Balls): duration + reps + weight
-func generateDescription(preset RacePreset, results []StationResult) string {
+func generateDescription(header string, results []StationResult) string {
 	var sb strings.Builder
 
-	sb.WriteString(fmt.Sprintf("🏁 %s:\n", preset.Name))
+	sb.WriteString(header + "\n")
 
 	var totalDuration float64
 	runCount := 0
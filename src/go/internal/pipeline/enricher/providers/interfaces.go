@@ -4,6 +4,11 @@ import (
 	"context"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
 	"log/slog"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/activity"
+	"github.com/fitglue/server/src/go/pkg/domain/streams"
 
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 
@@ -33,6 +38,35 @@ type EnrichmentResult struct {
 	PowerStream        []int
 	PositionLatStream  []float64
 	PositionLongStream []float64
+	CadenceStream      []int
+	AltitudeStream     []float64
+	TemperatureStream  []int // degrees Celsius
+
+	// StreamInterval is the spacing between samples in the streams above.
+	// Zero means one sample per second; other rates are resampled to 1Hz
+	// before being applied to records.
+	StreamInterval time.Duration
+
+	// Sensors that recorded the streams above. The orchestrator's merge policy
+	// uses them to decide whether a stream replaces data already on the
+	// activity or only fills its gaps. Unset means streams.SensorUnknown.
+	HeartRateSensor   streams.Sensor
+	PowerSensor       streams.Sensor
+	PositionSensor    streams.Sensor
+	CadenceSensor     streams.Sensor
+	AltitudeSensor    streams.Sensor
+	TemperatureSensor streams.Sensor
+
+	// TimeShift moves every timestamp on the activity by this amount before
+	// later enrichers run (e.g. correcting a device with a wrong clock).
+	TimeShift time.Duration
+
+	// TrimRanges removes the records inside each range, and the distance
+	// covered across them, before later enrichers run (e.g. a stretch
+	// recorded in a car). ClearRanges clears a single field on the records
+	// inside each range but keeps the records.
+	TrimRanges  []activity.TimeRange
+	ClearRanges []activity.FieldRange
 
 	// TimeMarkers from enricher (e.g., exercise transitions from FIT file uploads)
 	TimeMarkers []*pbactivity.TimeMarker
@@ -40,6 +74,20 @@ type EnrichmentResult struct {
 	// Dedicated UI structure for complex hybrid races
 	HybridRaceSummary *pbactivity.HybridRaceSummary
 
+	// Session totals computed by the enricher (e.g., work and calories from
+	// power). When set they replace the values on the activity's session.
+	TotalWork     *float64 // joules
+	TotalCalories *float64 // kcal
+
+	// Time in each heart rate zone and the zones' upper bounds in bpm, lowest
+	// zone first. Written to the FIT file's native zone fields.
+	TimeInHrZone       []float64 // seconds
+	HrZoneHighBoundary []int32
+
+	// DataQualityScore (0-100) is recorded on the activity so later enrichers
+	// can judge how far to trust its streams (e.g. personal records).
+	DataQualityScore *int32
+
 	// Artifacts (Providers can still generate specific artifacts if independent)
 	// But main FIT generation should normally happen in Orchestrator fan-in.
 	FitFileContent []byte
@@ -101,3 +149,66 @@ type DeferrableProvider interface {
 	// ShouldDefer returns true if this provider should be deferred to Phase 2.
 	ShouldDefer() bool
 }
+
+// EssentialProvider is an optional interface for providers that must run even
+// after the pipeline run's execution budget has been exhausted (e.g., filters
+// and gates that decide whether the activity is published at all).
+// Providers that don't implement it are treated as optional.
+type EssentialProvider interface {
+	Provider
+	// IsEssential returns true if this provider must never be skipped for budget.
+	IsEssential() bool
+}
+
+// InitializableProvider is an optional interface for providers that need external
+// clients (e.g., Gemini, Cloud Storage). Init is called lazily via Initialize the
+// first time a resolved pipeline uses the provider, and at most once per instance
+// on success, so cold starts don't pay for providers no pipeline has configured.
+type InitializableProvider interface {
+	Provider
+	// Init creates any clients the provider needs. Returning an error leaves the
+	// provider uninitialized; initialization is retried on the next pipeline run.
+	Init(ctx context.Context, svc *bootstrap.Service) error
+}
+
+// SharedResultProvider is an optional interface for providers whose result
+// depends only on the source activity and their own config, not on the rest
+// of the pipeline (e.g. weather, AI banners). When the splitter fans an
+// activity out to several pipelines, the first pipeline to run the provider
+// shares its result and the others reuse it instead of calling it again.
+type SharedResultProvider interface {
+	Provider
+	// ShareResult reports whether res may be reused by other pipelines.
+	// Errors and transient skips should not be.
+	ShareResult(res *EnrichmentResult) bool
+}
+
+// SourceUpdateSkipper is an optional interface for providers that must not run
+// in the metadata-only pass made when the user edits an activity on its source
+// platform (e.g. renames it on Strava). Providers that fetch or rewrite data
+// streams skip it, since the streams haven't changed, as do providers that ask
+// the user for input, since the edit is the user's input.
+type SourceUpdateSkipper interface {
+	Provider
+	// SkipOnSourceUpdate returns true if this provider must not run for source updates.
+	SkipOnSourceUpdate() bool
+}
+
+// TimeoutProvider is an optional interface for providers that routinely need
+// longer than the orchestrator's default call deadline (e.g. LLM calls). The
+// enricher's configured timeout still takes precedence.
+type TimeoutProvider interface {
+	Provider
+	// DefaultTimeout returns the deadline for a call when none is configured.
+	DefaultTimeout() time.Duration
+}
+
+// LocationRevealingProvider is an optional interface for providers whose
+// description places the activity, e.g. a named park or segment. AI providers
+// redact those sections from the enriched description they send to a model
+// unless the user shares locations.
+type LocationRevealingProvider interface {
+	Provider
+	// RevealsLocation returns true if this provider's description names a place.
+	RevealsLocation() bool
+}
@@ -0,0 +1,526 @@
+package interval_detection
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/description"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// minSamples is the shortest stream worth analysing (~2 minutes at 1 Hz).
+	minSamples = 120
+	// smoothingWindow is the span of the centred rolling mean applied before
+	// classifying samples, so GPS jitter doesn't split a rep in two.
+	smoothingWindow = 10 * time.Second
+	// minRestGap is the shortest recovery that separates two reps. Shorter
+	// dips (a tight bend, a watch hiccup) are folded into the rep.
+	minRestGap = 20 * time.Second
+	// minContrast is the work/rest mean ratio required before the stream is
+	// treated as intervals rather than a steady effort with noise.
+	minContrast = 1.25
+	// similarTolerance is how far a rep may stray from the median distance or
+	// duration and still count as a repeat of the same rep.
+	similarTolerance = 0.1
+)
+
+// IntervalDetection finds work/rest intervals in activities without a
+// structured workout by clustering the pace or power stream, then marks each
+// rep on the timeline and summarises the session as e.g. "6×800m @ 3:45/km".
+type IntervalDetection struct {
+	Service *bootstrap.Service
+}
+
+func init() {
+	providers.Register(NewIntervalDetection())
+}
+
+func NewIntervalDetection() *IntervalDetection {
+	return &IntervalDetection{}
+}
+
+func (p *IntervalDetection) SetService(service *bootstrap.Service) {
+	p.Service = service
+}
+
+func (p *IntervalDetection) Name() string {
+	return "interval-detection"
+}
+
+func (p *IntervalDetection) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_INTERVAL_DETECTION
+}
+
+// sample is one record reduced to what detection needs.
+type sample struct {
+	ts       time.Time
+	value    float64 // the detection metric: speed in m/s or power in W
+	distance float64 // cumulative metres, 0 when the record has none
+	speed    float64
+	power    float64
+}
+
+// rep is one detected work interval.
+type rep struct {
+	start    time.Time
+	duration time.Duration
+	distance float64 // metres
+	avgPower float64 // W, 0 without power data
+}
+
+func (r rep) pace() time.Duration {
+	if r.distance <= 0 {
+		return 0
+	}
+	return time.Duration(float64(r.duration) / (r.distance / 1000))
+}
+
+func (p *IntervalDetection) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	logger.Debug("interval_detection: starting", "activity_name", activity.Name)
+
+	minReps := 3
+	if raw := inputs["min_reps"]; raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n >= 2 {
+			minReps = n
+		}
+	}
+	minWork := 30 * time.Second
+	if raw := inputs["min_work_seconds"]; raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n > 0 {
+			minWork = time.Duration(n) * time.Second
+		}
+	}
+	showReps := inputs["show_reps"] != "false" // default true
+
+	// Structured workouts already carry the real intervals; leave those to
+	// the Intervals enricher.
+	if hasStructuredLaps(activity) {
+		return skipped("structured_workout", "Laps already carry interval intensities"), nil
+	}
+
+	samples := collectSamples(activity)
+	metric := chooseMetric(activity, samples, inputs["metric"])
+	if metric == "" {
+		return skipped("no_stream_data", "No pace or power data on records"), nil
+	}
+	for i := range samples {
+		if metric == "power" {
+			samples[i].value = samples[i].power
+		} else {
+			samples[i].value = samples[i].speed
+		}
+	}
+	if len(samples) < minSamples {
+		return skipped("no_stream_data", fmt.Sprintf("Only %d records", len(samples))), nil
+	}
+
+	smoothed := smooth(samples)
+	threshold, workMean, restMean := splitThreshold(smoothed)
+	if workMean <= 0 || (restMean > 0 && workMean/restMean < minContrast) {
+		return skipped("no_clear_intervals", "No clear difference between work and rest"), nil
+	}
+
+	reps := detectReps(samples, smoothed, threshold, minWork)
+	if len(reps) < minReps {
+		return skipped("no_clear_intervals", fmt.Sprintf("Found %d reps, need %d", len(reps), minReps)), nil
+	}
+
+	summary := summarise(reps, metric)
+
+	// sectionHeader identifies the section for UPDATE-mode replacement
+	sectionHeader := providers.SectionHeader(user, description.SectionDetectedIntervals)
+	var sb strings.Builder
+	sb.WriteString(sectionHeader)
+	sb.WriteString("\nIntervals: " + summary)
+	if showReps {
+		for i, r := range reps {
+			sb.WriteString(fmt.Sprintf("\n• Rep %d: %s", i+1, formatRep(r, metric)))
+		}
+	}
+	if rest := averageRest(reps); rest > 0 {
+		sb.WriteString(fmt.Sprintf("\n😮‍💨 Recovery: %s avg", formatClock(rest)))
+	}
+
+	markers := make([]*pbactivity.TimeMarker, 0, len(reps))
+	for i, r := range reps {
+		markers = append(markers, &pbactivity.TimeMarker{
+			Timestamp:       timestamppb.New(r.start),
+			Label:           fmt.Sprintf("💨 Rep %d", i+1),
+			MarkerType:      "interval_start",
+			DurationSeconds: int32(r.duration.Seconds()),
+		})
+	}
+
+	logger.Info("Intervals detected",
+		"metric", metric,
+		"reps", len(reps),
+		"summary", summary,
+	)
+
+	return &providers.EnrichmentResult{
+		Description:   sb.String(),
+		SectionHeader: sectionHeader,
+		TimeMarkers:   markers,
+		Metadata: map[string]string{
+			"interval_detection_status":  "success",
+			"interval_detection_metric":  metric,
+			"interval_detection_reps":    strconv.Itoa(len(reps)),
+			"interval_detection_summary": summary,
+		},
+	}, nil
+}
+
+func skipped(reason, detail string) *providers.EnrichmentResult {
+	return &providers.EnrichmentResult{
+		Metadata: map[string]string{
+			"interval_detection_status": "skipped",
+			"reason":                    reason,
+			"status_detail":             detail,
+		},
+	}
+}
+
+// hasStructuredLaps reports whether the laps carry at least two intensity
+// types, the same test the Intervals enricher uses to accept an activity.
+func hasStructuredLaps(activity *pbactivity.StandardizedActivity) bool {
+	intensities := make(map[string]bool)
+	for _, session := range activity.Sessions {
+		for _, lap := range session.Laps {
+			if lap.Intensity != "" {
+				intensities[lap.Intensity] = true
+			}
+		}
+	}
+	return len(intensities) >= 2
+}
+
+// collectSamples flattens the records of every lap in time order.
+func collectSamples(activity *pbactivity.StandardizedActivity) []sample {
+	var samples []sample
+	for _, session := range activity.Sessions {
+		for _, lap := range session.Laps {
+			for _, record := range lap.Records {
+				if record.Timestamp == nil {
+					continue
+				}
+				samples = append(samples, sample{
+					ts:       record.Timestamp.AsTime(),
+					distance: record.Distance,
+					speed:    record.Speed,
+					power:    float64(record.Power),
+				})
+			}
+		}
+	}
+	sort.SliceStable(samples, func(i, j int) bool { return samples[i].ts.Before(samples[j].ts) })
+	deriveSpeed(samples)
+	return samples
+}
+
+// deriveSpeed fills in speed from cumulative distance for records that lack
+// it, which is common for files exported without an enhanced_speed field.
+func deriveSpeed(samples []sample) {
+	for i := 1; i < len(samples); i++ {
+		if samples[i].speed > 0 || samples[i].distance <= 0 || samples[i-1].distance <= 0 {
+			continue
+		}
+		dt := samples[i].ts.Sub(samples[i-1].ts).Seconds()
+		if dt > 0 {
+			samples[i].speed = math.Max(0, (samples[i].distance-samples[i-1].distance)/dt)
+		}
+	}
+}
+
+// chooseMetric picks "power" or "pace" for detection. "auto" prefers power
+// for rides, where speed follows the terrain, and pace for everything else.
+// It returns "" when the chosen stream is missing from most records.
+func chooseMetric(activity *pbactivity.StandardizedActivity, samples []sample, requested string) string {
+	var withSpeed, withPower int
+	for _, s := range samples {
+		if s.speed > 0 {
+			withSpeed++
+		}
+		if s.power > 0 {
+			withPower++
+		}
+	}
+	hasSpeed := len(samples) > 0 && withSpeed*2 >= len(samples)
+	hasPower := len(samples) > 0 && withPower*2 >= len(samples)
+
+	switch requested {
+	case "power":
+		if hasPower {
+			return "power"
+		}
+		return ""
+	case "pace":
+		if hasSpeed {
+			return "pace"
+		}
+		return ""
+	}
+
+	switch {
+	case hasPower && (isCyclingActivity(activity.Type) || !hasSpeed):
+		return "power"
+	case hasSpeed:
+		return "pace"
+	default:
+		return ""
+	}
+}
+
+func isCyclingActivity(activityType pbactivity.ActivityType) bool {
+	switch activityType {
+	case pbactivity.ActivityType_ACTIVITY_TYPE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_GRAVEL_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_EBIKE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_MOUNTAIN_BIKE_RIDE:
+		return true
+	default:
+		return false
+	}
+}
+
+// smooth returns a centred rolling mean of the metric over smoothingWindow.
+func smooth(samples []sample) []float64 {
+	out := make([]float64, len(samples))
+	half := smoothingWindow / 2
+	lo, hi := 0, 0
+	var sum float64
+	for i, s := range samples {
+		for hi < len(samples) && samples[hi].ts.Sub(s.ts) <= half {
+			sum += samples[hi].value
+			hi++
+		}
+		for s.ts.Sub(samples[lo].ts) > half {
+			sum -= samples[lo].value
+			lo++
+		}
+		out[i] = sum / float64(hi-lo)
+	}
+	return out
+}
+
+// splitThreshold separates the values into a work and a rest cluster by
+// iterating the midpoint between the two cluster means, starting from the
+// midpoint of the 10th and 90th percentiles.
+func splitThreshold(values []float64) (threshold, workMean, restMean float64) {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	threshold = (sorted[len(sorted)/10] + sorted[len(sorted)*9/10]) / 2
+
+	for i := 0; i < 20; i++ {
+		var workSum, restSum float64
+		var workN, restN int
+		for _, v := range values {
+			if v > threshold {
+				workSum += v
+				workN++
+			} else {
+				restSum += v
+				restN++
+			}
+		}
+		if workN == 0 || restN == 0 {
+			return threshold, 0, 0
+		}
+		workMean, restMean = workSum/float64(workN), restSum/float64(restN)
+		next := (workMean + restMean) / 2
+		if math.Abs(next-threshold) < 1e-6 {
+			break
+		}
+		threshold = next
+	}
+	return threshold, workMean, restMean
+}
+
+// detectReps turns the samples above threshold into reps. Rest gaps shorter
+// than minRestGap are bridged, then work shorter than minWork is dropped.
+func detectReps(samples []sample, smoothed []float64, threshold float64, minWork time.Duration) []rep {
+	type span struct{ from, to int } // sample indices, to exclusive
+	var spans []span
+	for i := 0; i < len(samples); {
+		if smoothed[i] <= threshold {
+			i++
+			continue
+		}
+		j := i
+		for j < len(samples) && smoothed[j] > threshold {
+			j++
+		}
+		if n := len(spans); n > 0 && samples[i].ts.Sub(samples[spans[n-1].to-1].ts) < minRestGap {
+			spans[n-1].to = j
+		} else {
+			spans = append(spans, span{i, j})
+		}
+		i = j
+	}
+
+	var reps []rep
+	for _, sp := range spans {
+		// A rep ends where the first rest sample begins.
+		end := samples[sp.to-1].ts
+		if sp.to < len(samples) {
+			end = samples[sp.to].ts
+		}
+		r := rep{start: samples[sp.from].ts, duration: end.Sub(samples[sp.from].ts)}
+		if r.duration < minWork {
+			continue
+		}
+
+		var powerSum float64
+		var powerN int
+		for k := sp.from; k < sp.to; k++ {
+			if samples[k].power > 0 {
+				powerSum += samples[k].power
+				powerN++
+			}
+		}
+		if powerN > 0 {
+			r.avgPower = powerSum / float64(powerN)
+		}
+		r.distance = spanDistance(samples, sp.from, sp.to)
+		reps = append(reps, r)
+	}
+	return reps
+}
+
+// spanDistance measures the span from cumulative record distance when
+// present, otherwise by integrating speed.
+func spanDistance(samples []sample, from, to int) float64 {
+	last := to
+	if last >= len(samples) {
+		last = len(samples) - 1
+	}
+	if samples[from].distance > 0 && samples[last].distance > samples[from].distance {
+		return samples[last].distance - samples[from].distance
+	}
+	var d float64
+	for k := from; k < last; k++ {
+		d += samples[k].speed * samples[k+1].ts.Sub(samples[k].ts).Seconds()
+	}
+	return d
+}
+
+// summarise renders the session as repeats of a distance, e.g. "6×800m @
+// 3:45/km", repeats of a duration, e.g. "5×4:00 @ 310W", or plain "7 reps @
+// 3:50/km" when the reps vary too much to name one.
+func summarise(reps []rep, metric string) string {
+	var totalDistance float64
+	var totalDuration time.Duration
+	var powerSum float64
+	distances := make([]float64, len(reps))
+	durations := make([]float64, len(reps))
+	for i, r := range reps {
+		totalDistance += r.distance
+		totalDuration += r.duration
+		powerSum += r.avgPower
+		distances[i] = r.distance
+		durations[i] = r.duration.Seconds()
+	}
+
+	var effort string
+	if metric == "power" {
+		effort = fmt.Sprintf("%dW", int(math.Round(powerSum/float64(len(reps)))))
+	} else {
+		effort = formatClock(time.Duration(float64(totalDuration)/(totalDistance/1000))) + "/km"
+	}
+
+	if metric == "pace" && similar(distances) {
+		return fmt.Sprintf("%d×%s @ %s", len(reps), formatRepDistance(median(distances)), effort)
+	}
+	if similar(durations) {
+		d := time.Duration(median(durations) * float64(time.Second))
+		return fmt.Sprintf("%d×%s @ %s", len(reps), formatClock(roundRepDuration(d)), effort)
+	}
+	return fmt.Sprintf("%d reps @ %s", len(reps), effort)
+}
+
+func formatRep(r rep, metric string) string {
+	if metric == "power" {
+		return fmt.Sprintf("%s @ %dW", formatClock(r.duration), int(math.Round(r.avgPower)))
+	}
+	return fmt.Sprintf("%dm in %s (%s/km)", int(math.Round(r.distance)), formatClock(r.duration), formatClock(r.pace()))
+}
+
+// averageRest is the mean gap between the end of one rep and the start of the next.
+func averageRest(reps []rep) time.Duration {
+	if len(reps) < 2 {
+		return 0
+	}
+	var total time.Duration
+	for i := 1; i < len(reps); i++ {
+		total += reps[i].start.Sub(reps[i-1].start.Add(reps[i-1].duration))
+	}
+	return total / time.Duration(len(reps)-1)
+}
+
+// similar reports whether every value is within similarTolerance of the median.
+func similar(values []float64) bool {
+	m := median(values)
+	if m <= 0 {
+		return false
+	}
+	for _, v := range values {
+		if math.Abs(v-m)/m > similarTolerance {
+			return false
+		}
+	}
+	return true
+}
+
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// formatRepDistance rounds a rep to the distance it was most likely meant to
+// be: the nearest 50m below 1km ("800m") and the nearest 100m above ("1.2km").
+func formatRepDistance(metres float64) string {
+	if metres < 975 {
+		return fmt.Sprintf("%dm", int(math.Round(metres/50)*50))
+	}
+	km := math.Round(metres/100) / 10
+	return fmt.Sprintf("%skm", strconv.FormatFloat(km, 'f', -1, 64))
+}
+
+// roundRepDuration rounds to the nearest 5s under two minutes and the
+// nearest 15s from there up, so "3:58" reads as the "4:00" it was meant to be.
+func roundRepDuration(d time.Duration) time.Duration {
+	if d < 2*time.Minute {
+		return d.Round(5 * time.Second)
+	}
+	return d.Round(15 * time.Second)
+}
+
+// formatClock renders a duration as m:ss, or h:mm:ss from an hour up.
+func formatClock(d time.Duration) string {
+	total := int(math.Round(d.Seconds()))
+	h, m, s := total/3600, (total%3600)/60, total%60
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%d:%02d", m, s)
+}
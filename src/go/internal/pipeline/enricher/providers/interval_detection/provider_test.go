@@ -0,0 +1,201 @@
+package interval_detection
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/pkg/description"
+	user "github.com/fitglue/server/src/go/pkg/domain/user"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+// sectionHeader is the header the provider renders for a user without preferences.
+var sectionHeader = description.Header(description.SectionDetectedIntervals, nil)
+
+// segment is a stretch of steady effort in a synthetic activity.
+type segment struct {
+	seconds int
+	speed   float64 // m/s
+	power   int32
+}
+
+// buildActivity lays the segments end to end with one record per second
+// carrying speed, power and cumulative distance.
+func buildActivity(activityType pbactivity.ActivityType, segments []segment) *pbactivity.StandardizedActivity {
+	start := time.Date(2026, 5, 12, 18, 0, 0, 0, time.UTC)
+	var records []*pbactivity.Record
+	var distance float64
+	sec := 0
+	for _, seg := range segments {
+		for i := 0; i < seg.seconds; i++ {
+			distance += seg.speed
+			records = append(records, &pbactivity.Record{
+				Timestamp: timestamppb.New(start.Add(time.Duration(sec) * time.Second)),
+				Speed:     seg.speed,
+				Power:     seg.power,
+				Distance:  distance,
+			})
+			sec++
+		}
+	}
+	return &pbactivity.StandardizedActivity{
+		Name:      "Track Tuesday",
+		Type:      activityType,
+		StartTime: timestamppb.New(start),
+		Sessions: []*pbactivity.Session{{
+			StartTime:        timestamppb.New(start),
+			TotalElapsedTime: float64(sec),
+			TotalDistance:    distance,
+			Laps:             []*pbactivity.Lap{{StartTime: timestamppb.New(start), Records: records}},
+		}},
+	}
+}
+
+// trackSession is a warm-up, 6×800m at 3:45/km with 90s jog recoveries and a cool-down.
+func trackSession() []segment {
+	work := 1000 / 225.0 // 3:45/km
+	segments := []segment{{seconds: 600, speed: 3.0}}
+	for i := 0; i < 6; i++ {
+		segments = append(segments, segment{seconds: 180, speed: work})
+		if i < 5 {
+			segments = append(segments, segment{seconds: 90, speed: 2.0})
+		}
+	}
+	return append(segments, segment{seconds: 300, speed: 3.0})
+}
+
+func TestIntervalDetection_TrackRepeats(t *testing.T) {
+	p := NewIntervalDetection()
+	act := buildActivity(pbactivity.ActivityType_ACTIVITY_TYPE_RUN, trackSession())
+
+	res, err := p.Enrich(context.Background(), slog.Default(), act, &user.Record{}, map[string]string{}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if res.Metadata["interval_detection_status"] != "success" {
+		t.Fatalf("Expected success, got %v", res.Metadata)
+	}
+	if !strings.Contains(res.Description, "Intervals: 6×800m @ 3:45/km") {
+		t.Errorf("Expected 6×800m summary, got:\n%s", res.Description)
+	}
+	if !strings.Contains(res.Description, "• Rep 6:") {
+		t.Errorf("Expected per-rep lines, got:\n%s", res.Description)
+	}
+	if res.SectionHeader != sectionHeader || !strings.HasPrefix(res.Description, sectionHeader) {
+		t.Errorf("Expected section header %q", sectionHeader)
+	}
+
+	if len(res.TimeMarkers) != 6 {
+		t.Fatalf("Expected 6 time markers, got %d", len(res.TimeMarkers))
+	}
+	first := res.TimeMarkers[0]
+	wantStart := act.StartTime.AsTime().Add(10 * time.Minute)
+	if d := first.Timestamp.AsTime().Sub(wantStart); d < -5*time.Second || d > 5*time.Second {
+		t.Errorf("Expected first rep near %v, got %v", wantStart, first.Timestamp.AsTime())
+	}
+	if first.Label != "💨 Rep 1" || first.MarkerType != "interval_start" {
+		t.Errorf("Unexpected marker: %+v", first)
+	}
+	if first.DurationSeconds < 170 || first.DurationSeconds > 190 {
+		t.Errorf("Expected ~180s rep, got %ds", first.DurationSeconds)
+	}
+}
+
+func TestIntervalDetection_HideReps(t *testing.T) {
+	p := NewIntervalDetection()
+	act := buildActivity(pbactivity.ActivityType_ACTIVITY_TYPE_RUN, trackSession())
+
+	res, err := p.Enrich(context.Background(), slog.Default(), act, &user.Record{}, map[string]string{"show_reps": "false"}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.Contains(res.Description, "• Rep") {
+		t.Errorf("Expected no per-rep lines, got:\n%s", res.Description)
+	}
+}
+
+func TestIntervalDetection_PowerRide(t *testing.T) {
+	p := NewIntervalDetection()
+	segments := []segment{{seconds: 600, speed: 8, power: 180}}
+	for i := 0; i < 5; i++ {
+		segments = append(segments,
+			segment{seconds: 238, speed: 10, power: 310},
+			segment{seconds: 120, speed: 8, power: 120},
+		)
+	}
+	act := buildActivity(pbactivity.ActivityType_ACTIVITY_TYPE_RIDE, segments)
+
+	res, err := p.Enrich(context.Background(), slog.Default(), act, &user.Record{}, map[string]string{}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.Metadata["interval_detection_metric"] != "power" {
+		t.Errorf("Expected power metric, got %v", res.Metadata)
+	}
+	if !strings.Contains(res.Description, "Intervals: 5×4:00 @ 310W") {
+		t.Errorf("Expected 5×4:00 summary, got:\n%s", res.Description)
+	}
+}
+
+func TestIntervalDetection_Skips(t *testing.T) {
+	steady := buildActivity(pbactivity.ActivityType_ACTIVITY_TYPE_RUN, []segment{{seconds: 1800, speed: 3.3}})
+
+	structured := buildActivity(pbactivity.ActivityType_ACTIVITY_TYPE_RUN, trackSession())
+	lap := structured.Sessions[0].Laps[0]
+	lap.Intensity = "warmup"
+	structured.Sessions[0].Laps = append(structured.Sessions[0].Laps, &pbactivity.Lap{Intensity: "active"})
+
+	twoReps := buildActivity(pbactivity.ActivityType_ACTIVITY_TYPE_RUN, []segment{
+		{seconds: 600, speed: 3.0},
+		{seconds: 180, speed: 4.4},
+		{seconds: 90, speed: 2.0},
+		{seconds: 180, speed: 4.4},
+		{seconds: 300, speed: 3.0},
+	})
+
+	noStream := buildActivity(pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING, []segment{{seconds: 1800}})
+
+	tests := []struct {
+		name       string
+		activity   *pbactivity.StandardizedActivity
+		wantReason string
+	}{
+		{"steady run", steady, "no_clear_intervals"},
+		{"structured workout", structured, "structured_workout"},
+		{"too few reps", twoReps, "no_clear_intervals"},
+		{"no stream", noStream, "no_stream_data"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := NewIntervalDetection().Enrich(context.Background(), slog.Default(), tt.activity, &user.Record{}, map[string]string{}, false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if res.Metadata["interval_detection_status"] != "skipped" || res.Metadata["reason"] != tt.wantReason {
+				t.Errorf("Expected skip %q, got %v", tt.wantReason, res.Metadata)
+			}
+		})
+	}
+}
+
+func TestFormatRepDistance(t *testing.T) {
+	cases := map[float64]string{
+		396:  "400m",
+		787:  "800m",
+		1190: "1.2km",
+		1010: "1km",
+		1590: "1.6km",
+	}
+	for in, want := range cases {
+		if got := formatRepDistance(in); got != want {
+			t.Errorf("formatRepDistance(%v) = %q, want %q", in, got, want)
+		}
+	}
+}
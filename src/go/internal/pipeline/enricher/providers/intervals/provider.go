@@ -10,6 +10,7 @@ import (
 
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/description"
 
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 
@@ -216,7 +217,7 @@ func (p *Intervals) Enrich(
 		"time_markers", len(timeMarkers))
 
 	// Prepend section header to description (same pattern as Parkrun and AI Companion)
-	sectionHeader := formatSectionHeader(workoutName)
+	sectionHeader := formatSectionHeader(user, workoutName)
 	desc := sectionHeader + "\n" + strings.TrimLeft(sb.String(), "\n")
 
 	return &providers.EnrichmentResult{
@@ -480,11 +481,12 @@ func formatDuration(seconds float64) string {
 // formatSectionHeader builds the G40 section header.
 // With a workout name: "⏱️ Intervals — 4×6:"
 // Without: "⏱️ Intervals:"
-func formatSectionHeader(workoutName string) string {
+func formatSectionHeader(u *user.Record, workoutName string) string {
+	s := description.DefaultSection(description.SectionIntervals)
 	if workoutName == "" {
-		return "⏱️ Intervals:"
+		return providers.SectionHeader(u, description.SectionIntervals)
 	}
-	return fmt.Sprintf("⏱️ Intervals — %s:", workoutName)
+	return providers.SectionHeaderTitled(u, description.SectionIntervals, s.Emoji, fmt.Sprintf("%s — %s", s.Title, workoutName))
 }
 
 // generateIntervalTimeMarkers creates TimeMarker entries for each interval
@@ -16,6 +16,7 @@ import (
 
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/cost"
 
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 
@@ -55,6 +56,10 @@ func (p *LocationNaming) ProviderType() pbplugin.EnricherProviderType {
 	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_LOCATION_NAMING
 }
 
+// RevealsLocation keeps the place name out of AI prompts unless the user
+// shares locations.
+func (p *LocationNaming) RevealsLocation() bool { return true }
+
 func (p *LocationNaming) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
 	// Extract GPS coordinates from first record
 	var latitude, longitude float64
@@ -139,6 +144,7 @@ func (p *LocationNaming) Enrich(ctx context.Context, logger *slog.Logger, activi
 
 		client := &http.Client{Timeout: 10 * time.Second}
 		resp, err := client.Do(req)
+		cost.Record(ctx, cost.Usage{ExternalAPICalls: 1})
 		if err != nil {
 			logger.Error("Failed to fetch location data", "error", err)
 			return nil, &providers.RetryableError{Err: fmt.Errorf("nominatim API request failed: %w", err)}
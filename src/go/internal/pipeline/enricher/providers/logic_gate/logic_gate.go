@@ -37,6 +37,9 @@ func (p *LogicGateProvider) ProviderType() pbplugin.EnricherProviderType {
 	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_LOGIC_GATE
 }
 
+// IsEssential keeps the gate running past the execution budget; skipping it would bypass its halt rules.
+func (p *LogicGateProvider) IsEssential() bool { return true }
+
 type Rule struct {
 	Field  string   `json:"field"`
 	Op     string   `json:"op"`
@@ -3,6 +3,7 @@ package muscle_heatmap
 import (
 	"context"
 	"fmt"
+	"github.com/fitglue/server/src/go/pkg/description"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
 	"log/slog"
 	"sort"
@@ -238,7 +239,7 @@ func (p *MuscleHeatmapProvider) Enrich(ctx context.Context, logger *slog.Logger,
 	})
 
 	var sb strings.Builder
-	sb.WriteString("🔥 Muscle Heatmap:\n")
+	sb.WriteString(providers.SectionHeader(user, description.SectionMuscleHeatmap) + "\n")
 
 	for _, k := range keys {
 		score := volumeScores[k]
@@ -0,0 +1,298 @@
+package oura_readiness
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/description"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/oauth"
+	oura "github.com/fitglue/server/src/go/pkg/integrations/oura"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// syncWindow is how long after an activity ends Oura may still be missing
+// last night's data because the ring hasn't synced with the app yet.
+const syncWindow = 6 * time.Hour
+
+const ouraBaseURL = "https://api.ouraring.com"
+
+// OuraReadiness adds last night's sleep score, HRV and readiness from the
+// user's Oura Ring to the activity description.
+//
+// Oura only has the night's data once the ring syncs, so a recent activity
+// without it returns a RetryableError and goes through the lag queue like
+// Fitbit HR. It is not a DeferrableProvider: the AI enrichers in Phase 2
+// should see the recovery context in the accumulated description.
+type OuraReadiness struct {
+	Service *bootstrap.Service
+}
+
+func init() {
+	providers.Register(NewOuraReadiness())
+}
+
+func NewOuraReadiness() *OuraReadiness {
+	return &OuraReadiness{}
+}
+
+func (p *OuraReadiness) SetService(service *bootstrap.Service) {
+	p.Service = service
+}
+
+func (p *OuraReadiness) Name() string {
+	return "oura-readiness"
+}
+
+func (p *OuraReadiness) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_OURA_READINESS
+}
+
+func (p *OuraReadiness) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	return p.EnrichWithClient(ctx, logger, activity, user, inputs, nil, doNotRetry)
+}
+
+// recovery is the night's data for one day. Nil fields were not reported.
+type recovery struct {
+	readiness  *int
+	sleepScore *int
+	hrv        *int          // ms, average over the main sleep
+	restingHR  *int          // bpm, lowest during the main sleep
+	asleep     time.Duration // total sleep in the main sleep
+	tempDelta  *float32      // °C from baseline
+}
+
+// EnrichWithClient allows HTTP client injection for testing
+func (p *OuraReadiness) EnrichWithClient(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, httpClient *http.Client, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	// 1. Check Credentials
+	if user.Integrations == nil || user.Integrations.Oura == nil || !user.Integrations.Oura.Enabled {
+		logger.Info("Oura integration not enabled, skipping")
+		return &providers.EnrichmentResult{
+			Skipped:    true,
+			SkipReason: "Oura integration not enabled",
+			Metadata: map[string]string{
+				"oura_status":   "skipped",
+				"status_detail": "Oura integration not enabled",
+			},
+		}, nil
+	}
+
+	// 2. Resolve the activity's local day, which is the day Oura files the
+	// preceding night's sleep and readiness under.
+	if activity.StartTime == nil {
+		return nil, fmt.Errorf("invalid start time: missing")
+	}
+	startTime := activity.StartTime.AsTime()
+	loc := time.UTC
+	if tz := strings.TrimSpace(inputs["timezone"]); tz != "" {
+		l, err := time.LoadLocation(tz)
+		if err != nil {
+			return &providers.EnrichmentResult{
+				Metadata: map[string]string{
+					"oura_status":   "skipped",
+					"status_detail": fmt.Sprintf("Invalid timezone %q", tz),
+				},
+			}, nil
+		}
+		loc = l
+	}
+	day := startTime.In(loc).Format("2006-01-02")
+	showDetails := inputs["show_details"] != "false" // default true
+
+	// 3. Initialize OAuth HTTP Client if not provided (for testing)
+	if httpClient == nil {
+		tokenSource := oauth.NewFirestoreTokenSource(p.Service, user.UserId, "oura")
+		httpClient = oauth.NewClientWithUsageTracking(tokenSource, p.Service, user.UserId, "oura", infra.WrapSlogLogger(logger))
+	}
+
+	client, err := oura.NewClientWithResponses(ouraBaseURL, oura.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create oura client: %w", err)
+	}
+
+	// 4. Fetch the night's data
+	rec, err := fetchRecovery(ctx, client, day)
+	if err != nil {
+		return nil, err
+	}
+
+	if rec.readiness == nil && rec.sleepScore == nil {
+		var durationSec float64
+		for _, s := range activity.Sessions {
+			durationSec += s.TotalElapsedTime
+		}
+		sinceEnd := time.Since(startTime.Add(time.Duration(durationSec) * time.Second))
+		if sinceEnd < syncWindow && !doNotRetry {
+			reason := fmt.Sprintf("no Oura data for %s yet (activity ended %v ago)", day, sinceEnd.Round(time.Minute))
+			logger.Warn("Oura data not synced: " + reason)
+			return nil, providers.NewRetryableError(fmt.Errorf("oura data not synced"), 30*time.Minute, reason)
+		}
+
+		logger.Info("No Oura data for activity day", "day", day)
+		return &providers.EnrichmentResult{
+			Metadata: map[string]string{
+				"oura_status":   "skipped",
+				"oura_day":      day,
+				"status_detail": "No sleep or readiness data for the activity day",
+			},
+		}, nil
+	}
+
+	// 5. Build description
+	// sectionHeader identifies the section for UPDATE-mode replacement
+	sectionHeader := providers.SectionHeader(user, description.SectionRecoveryContext)
+	var sb strings.Builder
+	sb.WriteString(sectionHeader)
+
+	var scores []string
+	if rec.readiness != nil {
+		scores = append(scores, fmt.Sprintf("Readiness %d (%s)", *rec.readiness, readinessLabel(*rec.readiness)))
+	}
+	if rec.sleepScore != nil {
+		scores = append(scores, fmt.Sprintf("Sleep %d", *rec.sleepScore))
+	}
+	sb.WriteString("\n🔋 " + strings.Join(scores, " • "))
+
+	if showDetails {
+		var vitals []string
+		if rec.hrv != nil {
+			vitals = append(vitals, fmt.Sprintf("HRV %d ms", *rec.hrv))
+		}
+		if rec.restingHR != nil {
+			vitals = append(vitals, fmt.Sprintf("Resting HR %d bpm", *rec.restingHR))
+		}
+		if len(vitals) > 0 {
+			sb.WriteString("\n💓 " + strings.Join(vitals, " • "))
+		}
+		if rec.asleep > 0 {
+			sb.WriteString(fmt.Sprintf("\n🛌 %s asleep", formatSleep(rec.asleep)))
+		}
+		if rec.tempDelta != nil && (*rec.tempDelta >= 0.3 || *rec.tempDelta <= -0.3) {
+			sb.WriteString(fmt.Sprintf("\n🌡️ Body temperature %+.1f°C", *rec.tempDelta))
+		}
+	}
+
+	metadata := map[string]string{
+		"oura_status": "success",
+		"oura_day":    day,
+	}
+	if rec.readiness != nil {
+		metadata["readiness_score"] = strconv.Itoa(*rec.readiness)
+	}
+	if rec.sleepScore != nil {
+		metadata["sleep_score"] = strconv.Itoa(*rec.sleepScore)
+	}
+	if rec.hrv != nil {
+		metadata["hrv_ms"] = strconv.Itoa(*rec.hrv)
+	}
+
+	logger.Info("Added Oura recovery context", "day", day, "readiness", metadata["readiness_score"], "sleep", metadata["sleep_score"])
+
+	return &providers.EnrichmentResult{
+		Description:   sb.String(),
+		SectionHeader: sectionHeader,
+		Metadata:      metadata,
+	}, nil
+}
+
+// fetchRecovery reads the daily readiness, daily sleep and sleep period
+// documents for day. Missing documents leave their fields nil.
+func fetchRecovery(ctx context.Context, client *oura.ClientWithResponses, day string) (*recovery, error) {
+	rec := &recovery{}
+
+	// Oura's end_date is exclusive for sleep periods, so ask for the next day too
+	// and keep only documents filed under day.
+	start := day
+	d, _ := time.Parse("2006-01-02", day)
+	end := d.AddDate(0, 0, 1).Format("2006-01-02")
+
+	readinessResp, err := client.MultipleDailyReadinessDocumentsV2UsercollectionDailyReadinessGetWithResponse(ctx, &oura.MultipleDailyReadinessDocumentsV2UsercollectionDailyReadinessGetParams{StartDate: &start, EndDate: &end})
+	if err != nil {
+		return nil, fmt.Errorf("oura readiness request failed: %w", err)
+	}
+	if readinessResp.JSON200 == nil {
+		return nil, fmt.Errorf("oura readiness api error %d: %s", readinessResp.StatusCode(), string(readinessResp.Body))
+	}
+	for _, r := range readinessResp.JSON200.Data {
+		if r.Day.String() == day {
+			rec.readiness = r.Score
+			rec.tempDelta = r.TemperatureDeviation
+		}
+	}
+
+	sleepResp, err := client.MultipleDailySleepDocumentsV2UsercollectionDailySleepGetWithResponse(ctx, &oura.MultipleDailySleepDocumentsV2UsercollectionDailySleepGetParams{StartDate: &start, EndDate: &end})
+	if err != nil {
+		return nil, fmt.Errorf("oura daily sleep request failed: %w", err)
+	}
+	if sleepResp.JSON200 == nil {
+		return nil, fmt.Errorf("oura daily sleep api error %d: %s", sleepResp.StatusCode(), string(sleepResp.Body))
+	}
+	for _, s := range sleepResp.JSON200.Data {
+		if s.Day.String() == day {
+			rec.sleepScore = s.Score
+		}
+	}
+
+	periodsResp, err := client.MultipleSleepDocumentsV2UsercollectionSleepGetWithResponse(ctx, &oura.MultipleSleepDocumentsV2UsercollectionSleepGetParams{StartDate: &start, EndDate: &end})
+	if err != nil {
+		return nil, fmt.Errorf("oura sleep request failed: %w", err)
+	}
+	if periodsResp.JSON200 == nil {
+		return nil, fmt.Errorf("oura sleep api error %d: %s", periodsResp.StatusCode(), string(periodsResp.Body))
+	}
+
+	// The main sleep is the long_sleep period; naps are separate documents.
+	var main *oura.SleepModel
+	for i, s := range periodsResp.JSON200.Data {
+		if s.Day.String() != day || s.Type != oura.SleepTypeLongSleep {
+			continue
+		}
+		if main == nil || sleepSeconds(&s) > sleepSeconds(main) {
+			main = &periodsResp.JSON200.Data[i]
+		}
+	}
+	if main != nil {
+		rec.hrv = main.AverageHrv
+		rec.restingHR = main.LowestHeartRate
+		rec.asleep = time.Duration(sleepSeconds(main)) * time.Second
+	}
+
+	return rec, nil
+}
+
+func sleepSeconds(s *oura.SleepModel) int {
+	if s.TotalSleepDuration == nil {
+		return 0
+	}
+	return *s.TotalSleepDuration
+}
+
+// readinessLabel uses the bands shown in the Oura app.
+func readinessLabel(score int) string {
+	switch {
+	case score >= 85:
+		return "Optimal"
+	case score >= 70:
+		return "Good"
+	default:
+		return "Pay attention"
+	}
+}
+
+// formatSleep renders a sleep duration as e.g. "7h 12m".
+func formatSleep(d time.Duration) string {
+	total := int(d.Round(time.Minute).Minutes())
+	return fmt.Sprintf("%dh %02dm", total/60, total%60)
+}
@@ -0,0 +1,192 @@
+package oura_readiness
+
+import (
+	"github.com/fitglue/server/src/go/pkg/description"
+	user "github.com/fitglue/server/src/go/pkg/domain/user"
+
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sectionHeader is the header the provider renders for a user without preferences.
+var sectionHeader = description.Header(description.SectionRecoveryContext, nil)
+
+const (
+	readinessJSON  = `{"data": [{"id": "r1", "day": "2026-05-12", "score": 82, "temperature_deviation": -0.4, "contributors": {}, "timestamp": "2026-05-12T00:00:00+00:00"}]}`
+	dailySleepJSON = `{"data": [{"id": "s1", "day": "2026-05-12", "score": 76, "contributors": {}, "timestamp": "2026-05-12T00:00:00+00:00"}]}`
+	sleepJSON      = `{"data": [
+		{"id": "nap", "day": "2026-05-12", "type": "sleep", "total_sleep_duration": 1800, "average_hrv": 30, "lowest_heart_rate": 60},
+		{"id": "main", "day": "2026-05-12", "type": "long_sleep", "total_sleep_duration": 25920, "average_hrv": 48, "lowest_heart_rate": 52},
+		{"id": "next", "day": "2026-05-13", "type": "long_sleep", "total_sleep_duration": 28800, "average_hrv": 55, "lowest_heart_rate": 50}
+	]}`
+	emptyJSON = `{"data": []}`
+)
+
+// ouraServer serves fixed documents per collection.
+func ouraServer(t *testing.T, readiness, dailySleep, sleep string) *http.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/usercollection/daily_readiness":
+			w.Write([]byte(readiness))
+		case "/v2/usercollection/daily_sleep":
+			w.Write([]byte(dailySleep))
+		case "/v2/usercollection/sleep":
+			w.Write([]byte(sleep))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return &http.Client{Transport: &mockTransport{testServer: server.URL}}
+}
+
+func ouraUser() *user.Record {
+	return &user.Record{
+		UserProfile:  &pbuser.UserProfile{UserId: "test-user"},
+		Integrations: &pbuser.UserIntegrations{Oura: &pbuser.OuraIntegration{Enabled: true}},
+	}
+}
+
+func activityAt(start time.Time) *pbactivity.StandardizedActivity {
+	return &pbactivity.StandardizedActivity{
+		Name:      "Morning Run",
+		StartTime: timestamppb.New(start),
+		Sessions:  []*pbactivity.Session{{TotalElapsedTime: 3600}},
+	}
+}
+
+func TestOuraReadiness_RecoveryContext(t *testing.T) {
+	provider := NewOuraReadiness()
+	provider.SetService(&bootstrap.Service{})
+	client := ouraServer(t, readinessJSON, dailySleepJSON, sleepJSON)
+
+	act := activityAt(time.Date(2026, 5, 12, 7, 30, 0, 0, time.UTC))
+	res, err := provider.EnrichWithClient(context.Background(), slog.Default(), act, ouraUser(), map[string]string{}, client, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for _, want := range []string{
+		sectionHeader,
+		"🔋 Readiness 82 (Good) • Sleep 76",
+		"💓 HRV 48 ms • Resting HR 52 bpm",
+		"🛌 7h 12m asleep",
+		"🌡️ Body temperature -0.4°C",
+	} {
+		if !strings.Contains(res.Description, want) {
+			t.Errorf("Expected %q in description, got:\n%s", want, res.Description)
+		}
+	}
+	if res.SectionHeader != sectionHeader {
+		t.Errorf("Expected section header %q, got %q", sectionHeader, res.SectionHeader)
+	}
+	if res.Metadata["readiness_score"] != "82" || res.Metadata["hrv_ms"] != "48" {
+		t.Errorf("Unexpected metadata: %v", res.Metadata)
+	}
+}
+
+func TestOuraReadiness_HideDetails(t *testing.T) {
+	provider := NewOuraReadiness()
+	client := ouraServer(t, readinessJSON, dailySleepJSON, sleepJSON)
+
+	act := activityAt(time.Date(2026, 5, 12, 7, 30, 0, 0, time.UTC))
+	res, err := provider.EnrichWithClient(context.Background(), slog.Default(), act, ouraUser(), map[string]string{"show_details": "false"}, client, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if strings.Contains(res.Description, "HRV") || strings.Contains(res.Description, "asleep") {
+		t.Errorf("Expected scores only, got:\n%s", res.Description)
+	}
+}
+
+func TestOuraReadiness_UsesLocalDay(t *testing.T) {
+	provider := NewOuraReadiness()
+	client := ouraServer(t, readinessJSON, dailySleepJSON, sleepJSON)
+
+	// 23:30 UTC on the 11th is already the 12th in Sydney.
+	act := activityAt(time.Date(2026, 5, 11, 23, 30, 0, 0, time.UTC))
+	res, err := provider.EnrichWithClient(context.Background(), slog.Default(), act, ouraUser(), map[string]string{"timezone": "Australia/Sydney"}, client, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if res.Metadata["oura_day"] != "2026-05-12" || res.Metadata["oura_status"] != "success" {
+		t.Errorf("Expected success for 2026-05-12, got %v", res.Metadata)
+	}
+}
+
+func TestOuraReadiness_IntegrationDisabled(t *testing.T) {
+	provider := NewOuraReadiness()
+	u := ouraUser()
+	u.Integrations.Oura.Enabled = false
+
+	res, err := provider.Enrich(context.Background(), slog.Default(), activityAt(time.Now()), u, map[string]string{}, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !res.Skipped || res.Metadata["oura_status"] != "skipped" {
+		t.Errorf("Expected skipped result, got %v", res.Metadata)
+	}
+}
+
+func TestOuraReadiness_NotSyncedYet(t *testing.T) {
+	recent := activityAt(time.Now().Add(-90 * time.Minute))
+	old := activityAt(time.Now().Add(-48 * time.Hour))
+
+	tests := []struct {
+		name       string
+		activity   *pbactivity.StandardizedActivity
+		doNotRetry bool
+		wantRetry  bool
+	}{
+		{"recent activity retries", recent, false, true},
+		{"recent activity with retries exhausted", recent, true, false},
+		{"old activity", old, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := ouraServer(t, emptyJSON, emptyJSON, emptyJSON)
+			res, err := NewOuraReadiness().EnrichWithClient(context.Background(), slog.Default(), tt.activity, ouraUser(), map[string]string{}, client, tt.doNotRetry)
+
+			var retryErr *providers.RetryableError
+			if tt.wantRetry {
+				if !errors.As(err, &retryErr) {
+					t.Fatalf("Expected RetryableError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if res.Metadata["oura_status"] != "skipped" {
+				t.Errorf("Expected skipped result, got %v", res.Metadata)
+			}
+		})
+	}
+}
+
+type mockTransport struct {
+	testServer string
+}
+
+func (m *mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Redirect to test server
+	req.URL.Scheme = "http"
+	req.URL.Host = m.testServer[7:] // Remove "http://"
+	return http.DefaultTransport.RoundTrip(req)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	activityPkg "github.com/fitglue/server/src/go/pkg/domain/activity"
 
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 
@@ -20,7 +21,8 @@ import (
 
 // PaceSummary calculates and appends pace statistics (min/km) to the activity description.
 // Uses speed (m/s) data from records, converts to pace, and shows avg/best pace.
-// Enhanced features: splits, negative split detection, fatigue analysis.
+// Enhanced features: splits, negative split detection, fatigue analysis, and
+// grade-adjusted pace per split on hilly runs.
 type PaceSummary struct {
 	Service *bootstrap.Service
 }
@@ -30,6 +32,7 @@ type Split struct {
 	Distance  float64                // in meters
 	Duration  time.Duration          // time for this split
 	Pace      float64                // min/km
+	GAPPace   float64                // grade-adjusted min/km, 0 when not computed
 	StartTime *timestamppb.Timestamp // original lap start time for time markers
 }
 
@@ -60,6 +63,7 @@ func (p *PaceSummary) Enrich(ctx context.Context, logger *slog.Logger, activity
 	showSplits := inputs["show_splits"] == "true"
 	showNegativeSplit := inputs["negative_split_alert"] == "true"
 	showFatigue := inputs["show_fatigue"] == "true"
+	showGAP := inputs["show_gap"] != "false" // default true
 
 	// Collect all speed values from the activity (m/s)
 	var speeds []float64
@@ -118,6 +122,12 @@ func (p *PaceSummary) Enrich(ctx context.Context, logger *slog.Logger, activity
 		splits = calculateSplitsFromLaps(activity)
 	}
 
+	// Grade-adjusted pace only tells the runner something on hilly ground
+	gapApplied := false
+	if showSplits && showGAP && len(splits) > 0 && activityPkg.IsHilly(activity) {
+		gapApplied = applySplitGAP(splits, activityPkg.GradeAdjust(activity))
+	}
+
 	// Show splits
 	if showSplits && len(splits) > 0 {
 		sb.WriteString("\n📊 Splits:")
@@ -137,7 +147,11 @@ func (p *PaceSummary) Enrich(ctx context.Context, logger *slog.Logger, activity
 			} else if i == slowestIdx {
 				marker = " 🐢"
 			}
-			sb.WriteString(fmt.Sprintf("\n• Km %d: %s%s", i+1, formatPace(split.Pace), marker))
+			gap := ""
+			if split.GAPPace > 0 {
+				gap = fmt.Sprintf(" (GAP %s)", formatPace(split.GAPPace))
+			}
+			sb.WriteString(fmt.Sprintf("\n• Km %d: %s%s%s", i+1, formatPace(split.Pace), gap, marker))
 		}
 	}
 
@@ -196,6 +210,9 @@ func (p *PaceSummary) Enrich(ctx context.Context, logger *slog.Logger, activity
 	if len(splits) > 0 {
 		metadata["splits_count"] = fmt.Sprintf("%d", len(splits))
 	}
+	if gapApplied {
+		metadata["splits_gap"] = "true"
+	}
 
 	// Generate time markers for split boundaries
 	var timeMarkers []*pbactivity.TimeMarker
@@ -254,6 +271,36 @@ func calculateSplitsFromLaps(activity *pbactivity.StandardizedActivity) []Split
 	return splits
 }
 
+// applySplitGAP sets each split's grade-adjusted pace from the samples
+// recorded during it. Splits without a start time are left unadjusted. It
+// reports whether any split was adjusted.
+func applySplitGAP(splits []Split, samples []*activityPkg.GradeAdjustedSample) bool {
+	if len(samples) == 0 {
+		return false
+	}
+	applied := false
+	for i := range splits {
+		if splits[i].StartTime == nil {
+			continue
+		}
+		start := splits[i].StartTime.AsTime()
+		end := start.Add(splits[i].Duration)
+		var window []*activityPkg.GradeAdjustedSample
+		for _, s := range samples {
+			t := s.Record.Timestamp.AsTime()
+			if t.After(start) && !t.After(end) {
+				window = append(window, s)
+			}
+		}
+		if len(window) == 0 {
+			continue
+		}
+		splits[i].GAPPace = splits[i].Pace * activityPkg.GradeAdjustmentFactor(window)
+		applied = true
+	}
+	return applied
+}
+
 // generateSplitTimeMarkers creates TimeMarker entries for each km split boundary.
 func generateSplitTimeMarkers(splits []Split) []*pbactivity.TimeMarker {
 	var markers []*pbactivity.TimeMarker
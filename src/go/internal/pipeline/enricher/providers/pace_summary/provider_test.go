@@ -11,6 +11,8 @@ import (
 
 	"context"
 	"log/slog"
+	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -202,3 +204,76 @@ func TestPaceSummary_SplitTimeMarkers(t *testing.T) {
 		t.Errorf("Expected metadata time_markers='3', got %q", result.Metadata["time_markers"])
 	}
 }
+
+// hillyRun builds two 1 km laps at 3 m/s: a 5% climb, then flat.
+func hillyRun(start time.Time) *pbactivity.StandardizedActivity {
+	var laps []*pbactivity.Lap
+	for lap := 0; lap < 2; lap++ {
+		lapStart := start.Add(time.Duration(lap*333) * time.Second)
+		var records []*pbactivity.Record
+		for i := 1; i <= 333; i++ {
+			d := float64(lap*1000 + i*3)
+			alt := 100 + math.Min(d, 1000)*0.05
+			records = append(records, &pbactivity.Record{
+				Timestamp: timestamppb.New(lapStart.Add(time.Duration(i) * time.Second)),
+				Speed:     3,
+				Distance:  d,
+				Altitude:  alt,
+			})
+		}
+		laps = append(laps, &pbactivity.Lap{
+			StartTime:        timestamppb.New(lapStart),
+			TotalElapsedTime: 333,
+			TotalDistance:    1000,
+			Records:          records,
+		})
+	}
+	return &pbactivity.StandardizedActivity{
+		StartTime: timestamppb.New(start),
+		Sessions:  []*pbactivity.Session{{TotalDistance: 2000, Laps: laps}},
+	}
+}
+
+func TestPaceSummary_SplitGAP(t *testing.T) {
+	provider := NewPaceSummary()
+	provider.Service = &bootstrap.Service{}
+	user := &user.Record{UserProfile: &pbuser.UserProfile{UserId: "test-user"}}
+
+	result, err := provider.Enrich(context.Background(), slog.Default(), hillyRun(time.Now()), user, map[string]string{"show_splits": "true"}, false)
+	if err != nil {
+		t.Fatalf("Enrich failed: %v", err)
+	}
+
+	// A 5:32/km climb at 5% costs what 4:16/km does on the flat
+	if !strings.Contains(result.Description, "• Km 1: 5:32 (GAP 4:16) 🏆") {
+		t.Errorf("Expected a grade-adjusted first split, got %q", result.Description)
+	}
+	if !strings.Contains(result.Description, "• Km 2: 5:32 (GAP 5:32)") {
+		t.Errorf("Expected the flat split to keep its pace, got %q", result.Description)
+	}
+	if result.Metadata["splits_gap"] != "true" {
+		t.Errorf("Expected splits_gap metadata, got %v", result.Metadata)
+	}
+
+	result, _ = provider.Enrich(context.Background(), slog.Default(), hillyRun(time.Now()), user, map[string]string{"show_splits": "true", "show_gap": "false"}, false)
+	if strings.Contains(result.Description, "GAP") {
+		t.Errorf("Expected no GAP with show_gap=false, got %q", result.Description)
+	}
+}
+
+func TestPaceSummary_SplitGAP_FlatRun(t *testing.T) {
+	provider := NewPaceSummary()
+	provider.Service = &bootstrap.Service{}
+	user := &user.Record{UserProfile: &pbuser.UserProfile{UserId: "test-user"}}
+
+	activity := hillyRun(time.Now())
+	for _, lap := range activity.Sessions[0].Laps {
+		for _, r := range lap.Records {
+			r.Altitude = 100
+		}
+	}
+	result, _ := provider.Enrich(context.Background(), slog.Default(), activity, user, map[string]string{"show_splits": "true"}, false)
+	if strings.Contains(result.Description, "GAP") {
+		t.Errorf("Expected no GAP on a flat run, got %q", result.Description)
+	}
+}
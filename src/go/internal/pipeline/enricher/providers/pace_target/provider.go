@@ -0,0 +1,346 @@
+package pace_target
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/description"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// minGoalCoverage is the share of the goal distance an activity must cover
+// before its finish time is compared to the goal time. GPS routinely reads a
+// little short of a measured course.
+const minGoalCoverage = 0.97
+
+// PaceTarget compares an activity against a goal time or pace from the
+// pipeline config, like a watch's virtual partner, and reports how far ahead
+// or behind the goal the athlete finished.
+type PaceTarget struct {
+	Service *bootstrap.Service
+}
+
+func init() {
+	providers.Register(NewPaceTarget())
+}
+
+func NewPaceTarget() *PaceTarget {
+	return &PaceTarget{}
+}
+
+func (p *PaceTarget) SetService(service *bootstrap.Service) {
+	p.Service = service
+}
+
+func (p *PaceTarget) Name() string {
+	return "pace-target"
+}
+
+func (p *PaceTarget) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PACE_TARGET
+}
+
+func (p *PaceTarget) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	logger.Debug("pace_target: starting", "activity_name", activity.Name)
+
+	goal, err := parseGoal(inputs)
+	if err != nil {
+		return skipped("invalid_config", err.Error()), nil
+	}
+
+	showSplits := inputs["show_splits"] != "false" // default true
+	bannerOverlay := inputs["banner_overlay"] == "true"
+
+	var distance float64
+	var elapsedSec float64
+	for _, session := range activity.Sessions {
+		distance += session.TotalDistance
+		elapsedSec += session.TotalElapsedTime
+	}
+	if distance <= 0 || elapsedSec <= 0 {
+		return skipped("no_distance", "No distance data"), nil
+	}
+	elapsed := time.Duration(elapsedSec * float64(time.Second))
+
+	// Against a goal distance compare finish times directly; against a bare
+	// pace compare with the time that pace would take over the actual distance.
+	var expected time.Duration
+	if goal.distance > 0 {
+		if distance < goal.distance*minGoalCoverage {
+			return skipped("distance_below_goal", fmt.Sprintf("Covered %.2f of %.2f km", distance/1000, goal.distance/1000)), nil
+		}
+		expected = goal.time
+	} else {
+		expected = time.Duration(float64(goal.pace) * distance / 1000)
+	}
+	diff := (expected - elapsed).Round(time.Second) // positive = ahead
+
+	result := "on_target"
+	var headline string
+	switch {
+	case diff >= time.Second:
+		result = "ahead"
+		headline = fmt.Sprintf("✅ Finished %s ahead of %s", formatClock(diff), goal.label())
+	case diff <= -time.Second:
+		result = "behind"
+		headline = fmt.Sprintf("⏳ Finished %s behind %s", formatClock(-diff), goal.label())
+	default:
+		headline = fmt.Sprintf("🎯 Finished right on %s", goal.label())
+	}
+
+	actualPace := time.Duration(float64(elapsed) / (distance / 1000))
+
+	// sectionHeader identifies the section for UPDATE-mode replacement
+	sectionHeader := providers.SectionHeader(user, description.SectionPaceTarget)
+	var sb strings.Builder
+	sb.WriteString(sectionHeader)
+	sb.WriteString("\n" + headline)
+	sb.WriteString(fmt.Sprintf("\n📏 %s/km target • %s/km actual", formatClock(goal.pace), formatClock(actualPace)))
+
+	splits := kmSplits(activity)
+	if showSplits && len(splits) > 0 {
+		for i, d := range splits {
+			delta := (d - goal.pace).Round(time.Second)
+			sb.WriteString(fmt.Sprintf("\n• Km %d: %s (%s)", i+1, formatClock(d), formatDelta(delta)))
+		}
+	}
+
+	metadata := map[string]string{
+		"pace_target_status":       "success",
+		"pace_target_goal":         goal.label(),
+		"pace_target_result":       result,
+		"pace_target_diff_seconds": strconv.Itoa(int(diff.Seconds())),
+		"splits_count":             strconv.Itoa(len(splits)),
+	}
+	if bannerOverlay {
+		metadata["banner_overlay_title"] = overlayTitle(result, diff)
+		metadata["banner_overlay_subtitle"] = fmt.Sprintf("%s • %s", goal.label(), formatClock(elapsed.Round(time.Second)))
+	}
+
+	logger.Info("Pace target compared",
+		"goal", goal.label(),
+		"result", result,
+		"diff_seconds", int(diff.Seconds()),
+		"splits", len(splits),
+	)
+
+	return &providers.EnrichmentResult{
+		Description:   sb.String(),
+		SectionHeader: sectionHeader,
+		Metadata:      metadata,
+	}, nil
+}
+
+func skipped(reason, detail string) *providers.EnrichmentResult {
+	return &providers.EnrichmentResult{
+		Metadata: map[string]string{
+			"pace_target_status": "skipped",
+			"reason":             reason,
+			"status_detail":      detail,
+		},
+	}
+}
+
+// goal is the resolved target. pace is always set; distance and time are set
+// together when the goal is a finish time for a distance.
+type goal struct {
+	distance float64       // metres, 0 for a pace-only goal
+	time     time.Duration // finish time for distance
+	pace     time.Duration // per km
+}
+
+// parseGoal reads either target_distance + target_time or target_pace.
+func parseGoal(inputs map[string]string) (goal, error) {
+	var g goal
+	var err error
+
+	if raw := strings.TrimSpace(inputs["target_distance"]); raw != "" {
+		if g.distance, err = parseDistance(raw); err != nil {
+			return g, err
+		}
+	}
+	if raw := strings.TrimSpace(inputs["target_time"]); raw != "" {
+		if g.time, err = parseClock(raw); err != nil {
+			return g, fmt.Errorf("invalid target_time %q: %w", raw, err)
+		}
+	}
+	if raw := strings.TrimSpace(inputs["target_pace"]); raw != "" {
+		if g.pace, err = parseClock(raw); err != nil {
+			return g, fmt.Errorf("invalid target_pace %q: %w", raw, err)
+		}
+	}
+
+	switch {
+	case g.distance > 0 && g.time > 0:
+		g.pace = time.Duration(float64(g.time) / (g.distance / 1000))
+	case g.distance > 0 && g.pace > 0:
+		g.time = time.Duration(float64(g.pace) * g.distance / 1000)
+	case g.pace > 0:
+		g.distance, g.time = 0, 0
+	default:
+		return g, fmt.Errorf("set target_pace, or target_distance with target_time")
+	}
+	return g, nil
+}
+
+// label renders the goal for display, e.g. "50-min 10K goal" or "4:45/km goal pace".
+func (g goal) label() string {
+	if g.distance == 0 {
+		return fmt.Sprintf("%s/km goal pace", formatClock(g.pace))
+	}
+	t := formatClock(g.time.Round(time.Second))
+	if g.time < time.Hour && g.time%time.Minute == 0 {
+		t = fmt.Sprintf("%d-min", int(g.time.Minutes()))
+	}
+	return fmt.Sprintf("%s %s goal", t, formatDistance(g.distance))
+}
+
+// namedDistances maps common race names to metres.
+var namedDistances = map[string]float64{
+	"5k":            5000,
+	"10k":           10000,
+	"half":          21097.5,
+	"half marathon": 21097.5,
+	"marathon":      42195,
+}
+
+// parseDistance accepts race names, or a number in km with an optional
+// "k"/"km" or "mi" suffix.
+func parseDistance(raw string) (float64, error) {
+	s := strings.ToLower(strings.TrimSpace(raw))
+	if d, ok := namedDistances[s]; ok {
+		return d, nil
+	}
+
+	unit := 1000.0
+	switch {
+	case strings.HasSuffix(s, "km"):
+		s = strings.TrimSuffix(s, "km")
+	case strings.HasSuffix(s, "mi"):
+		s = strings.TrimSuffix(s, "mi")
+		unit = 1609.344
+	case strings.HasSuffix(s, "k"):
+		s = strings.TrimSuffix(s, "k")
+	}
+	v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil || v <= 0 {
+		return 0, fmt.Errorf("invalid target_distance %q", raw)
+	}
+	return v * unit, nil
+}
+
+// parseClock accepts "m:ss", "h:mm:ss" or a plain number of minutes.
+func parseClock(raw string) (time.Duration, error) {
+	parts := strings.Split(raw, ":")
+	if len(parts) == 1 {
+		mins, err := strconv.ParseFloat(parts[0], 64)
+		if err != nil || mins <= 0 {
+			return 0, fmt.Errorf("expected m:ss, h:mm:ss or minutes")
+		}
+		return time.Duration(mins * float64(time.Minute)), nil
+	}
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("expected m:ss, h:mm:ss or minutes")
+	}
+
+	var total time.Duration
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("expected m:ss, h:mm:ss or minutes")
+		}
+		total = total*60 + time.Duration(n)
+	}
+	if total == 0 {
+		return 0, fmt.Errorf("must be greater than zero")
+	}
+	return total * time.Second, nil
+}
+
+// kmSplits derives full-kilometre split times from the cumulative distance on
+// records. Activities without record distances have no splits.
+func kmSplits(activity *pbactivity.StandardizedActivity) []time.Duration {
+	var splits []time.Duration
+	var boundary time.Time
+	if activity.StartTime != nil {
+		boundary = activity.StartTime.AsTime()
+	}
+	nextKm := 1.0
+
+	for _, session := range activity.Sessions {
+		for _, lap := range session.Laps {
+			for _, record := range lap.Records {
+				if record.Timestamp == nil || record.Distance <= 0 {
+					continue
+				}
+				ts := record.Timestamp.AsTime()
+				if boundary.IsZero() {
+					boundary = ts
+				}
+				for record.Distance >= nextKm*1000 {
+					splits = append(splits, ts.Sub(boundary))
+					boundary = ts
+					nextKm++
+				}
+			}
+		}
+	}
+	return splits
+}
+
+func overlayTitle(result string, diff time.Duration) string {
+	switch result {
+	case "ahead":
+		return fmt.Sprintf("%s ahead of goal", formatClock(diff))
+	case "behind":
+		return fmt.Sprintf("%s behind goal", formatClock(-diff))
+	default:
+		return "Right on goal"
+	}
+}
+
+// formatClock renders a duration as m:ss, or h:mm:ss from an hour up.
+func formatClock(d time.Duration) string {
+	total := int(math.Round(d.Seconds()))
+	h, m, s := total/3600, (total%3600)/60, total%60
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%d:%02d", m, s)
+}
+
+// formatDelta renders a split's difference from target pace, negative meaning faster.
+func formatDelta(d time.Duration) string {
+	switch {
+	case d == 0:
+		return "on pace"
+	case d < 0:
+		return fmt.Sprintf("-%ds", int(-d.Seconds()))
+	default:
+		return fmt.Sprintf("+%ds", int(d.Seconds()))
+	}
+}
+
+// formatDistance renders a goal distance, e.g. "10K", "Half Marathon" or "12.5K".
+func formatDistance(metres float64) string {
+	switch metres {
+	case namedDistances["half"]:
+		return "Half Marathon"
+	case namedDistances["marathon"]:
+		return "Marathon"
+	}
+	km := math.Round(metres/10) / 100
+	return fmt.Sprintf("%sK", strconv.FormatFloat(km, 'f', -1, 64))
+}
@@ -0,0 +1,168 @@
+package pace_target
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/pkg/description"
+	user "github.com/fitglue/server/src/go/pkg/domain/user"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+// sectionHeader is the header the provider renders for a user without preferences.
+var sectionHeader = description.Header(description.SectionPaceTarget, nil)
+
+// steadyActivity builds an activity covering km kilometres at a constant pace
+// with one record per second carrying cumulative distance.
+func steadyActivity(km int, pace time.Duration) *pbactivity.StandardizedActivity {
+	start := time.Date(2026, 4, 5, 9, 0, 0, 0, time.UTC)
+	total := time.Duration(km) * pace
+	speed := 1000 / pace.Seconds()
+
+	var records []*pbactivity.Record
+	for sec := 0; sec <= int(total.Seconds()); sec++ {
+		records = append(records, &pbactivity.Record{
+			Timestamp: timestamppb.New(start.Add(time.Duration(sec) * time.Second)),
+			Distance:  float64(sec) * speed,
+		})
+	}
+	return &pbactivity.StandardizedActivity{
+		Name:      "Sunday 10K",
+		StartTime: timestamppb.New(start),
+		Sessions: []*pbactivity.Session{{
+			StartTime:        timestamppb.New(start),
+			TotalElapsedTime: total.Seconds(),
+			TotalDistance:    float64(km) * 1000,
+			Laps:             []*pbactivity.Lap{{StartTime: timestamppb.New(start), Records: records}},
+		}},
+	}
+}
+
+func TestPaceTarget_AheadOfGoalTime(t *testing.T) {
+	p := NewPaceTarget()
+	act := steadyActivity(10, 4*time.Minute+52*time.Second+800*time.Millisecond) // 48:48
+
+	res, err := p.Enrich(context.Background(), slog.Default(), act, &user.Record{}, map[string]string{
+		"target_distance": "10k",
+		"target_time":     "50:00",
+	}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !strings.Contains(res.Description, "Finished 1:12 ahead of 50-min 10K goal") {
+		t.Errorf("Expected ahead headline, got:\n%s", res.Description)
+	}
+	if !strings.Contains(res.Description, "5:00/km target • 4:53/km actual") {
+		t.Errorf("Expected pace comparison, got:\n%s", res.Description)
+	}
+	if res.SectionHeader != sectionHeader || !strings.HasPrefix(res.Description, sectionHeader) {
+		t.Errorf("Expected description to start with section header %q", sectionHeader)
+	}
+	if res.Metadata["pace_target_result"] != "ahead" || res.Metadata["pace_target_diff_seconds"] != "72" {
+		t.Errorf("Unexpected metadata: %v", res.Metadata)
+	}
+	if res.Metadata["splits_count"] != "10" {
+		t.Errorf("Expected 10 splits, got %s", res.Metadata["splits_count"])
+	}
+	if _, ok := res.Metadata["banner_overlay_title"]; ok {
+		t.Error("Expected no banner overlay metadata unless enabled")
+	}
+}
+
+func TestPaceTarget_BehindGoalPace(t *testing.T) {
+	p := NewPaceTarget()
+	act := steadyActivity(5, 5*time.Minute+10*time.Second)
+
+	res, err := p.Enrich(context.Background(), slog.Default(), act, &user.Record{}, map[string]string{
+		"target_pace":    "5:00",
+		"show_splits":    "false",
+		"banner_overlay": "true",
+	}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !strings.Contains(res.Description, "Finished 0:50 behind 5:00/km goal pace") {
+		t.Errorf("Expected behind headline, got:\n%s", res.Description)
+	}
+	if strings.Contains(res.Description, "Km 1") {
+		t.Errorf("Expected splits to be hidden, got:\n%s", res.Description)
+	}
+	if res.Metadata["banner_overlay_title"] != "0:50 behind goal" {
+		t.Errorf("Unexpected overlay title %q", res.Metadata["banner_overlay_title"])
+	}
+	if res.Metadata["banner_overlay_subtitle"] != "5:00/km goal pace • 25:50" {
+		t.Errorf("Unexpected overlay subtitle %q", res.Metadata["banner_overlay_subtitle"])
+	}
+}
+
+func TestPaceTarget_SplitDeltas(t *testing.T) {
+	p := NewPaceTarget()
+	act := steadyActivity(2, 4*time.Minute+10*time.Second)
+
+	res, err := p.Enrich(context.Background(), slog.Default(), act, &user.Record{}, map[string]string{"target_pace": "5:00"}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(res.Description, "• Km 1: 4:10 (-50s)") || !strings.Contains(res.Description, "• Km 2: 4:10 (-50s)") {
+		t.Errorf("Expected split deltas, got:\n%s", res.Description)
+	}
+}
+
+func TestPaceTarget_Skips(t *testing.T) {
+	tests := []struct {
+		name       string
+		activity   *pbactivity.StandardizedActivity
+		inputs     map[string]string
+		wantReason string
+	}{
+		{"no goal configured", steadyActivity(5, 5*time.Minute), map[string]string{}, "invalid_config"},
+		{"time without distance", steadyActivity(5, 5*time.Minute), map[string]string{"target_time": "25:00"}, "invalid_config"},
+		{"bad pace", steadyActivity(5, 5*time.Minute), map[string]string{"target_pace": "fast"}, "invalid_config"},
+		{"short of goal distance", steadyActivity(5, 5*time.Minute), map[string]string{"target_distance": "10k", "target_time": "50:00"}, "distance_below_goal"},
+		{"no distance", &pbactivity.StandardizedActivity{}, map[string]string{"target_pace": "5:00"}, "no_distance"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := NewPaceTarget().Enrich(context.Background(), slog.Default(), tt.activity, &user.Record{}, tt.inputs, false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if res.Metadata["pace_target_status"] != "skipped" || res.Metadata["reason"] != tt.wantReason {
+				t.Errorf("Expected skipped with reason %q, got %v", tt.wantReason, res.Metadata)
+			}
+			if res.Description != "" {
+				t.Errorf("Expected no description, got %q", res.Description)
+			}
+		})
+	}
+}
+
+func TestParseGoal_Labels(t *testing.T) {
+	tests := []struct {
+		inputs map[string]string
+		want   string
+	}{
+		{map[string]string{"target_distance": "10k", "target_time": "50"}, "50-min 10K goal"},
+		{map[string]string{"target_distance": "half", "target_time": "1:45:00"}, "1:45:00 Half Marathon goal"},
+		{map[string]string{"target_distance": "12.5km", "target_time": "1:02:30"}, "1:02:30 12.5K goal"},
+		{map[string]string{"target_distance": "marathon", "target_pace": "5:00"}, "3:30:59 Marathon goal"},
+		{map[string]string{"target_pace": "4:45"}, "4:45/km goal pace"},
+	}
+	for _, tt := range tests {
+		g, err := parseGoal(tt.inputs)
+		if err != nil {
+			t.Errorf("parseGoal(%v) error: %v", tt.inputs, err)
+			continue
+		}
+		if got := g.label(); got != tt.want {
+			t.Errorf("parseGoal(%v).label() = %q, want %q", tt.inputs, got, tt.want)
+		}
+	}
+}
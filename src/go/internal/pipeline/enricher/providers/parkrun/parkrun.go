@@ -12,6 +12,7 @@ import (
 
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/description"
 
 	parkrunutil "github.com/fitglue/server/src/go/pkg/parkrun"
 
@@ -65,17 +66,28 @@ func (p *ParkrunProvider) ProviderType() pbplugin.EnricherProviderType {
 	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PARKRUN
 }
 
+// RevealsLocation marks the results section, which names the parkrun event.
+func (p *ParkrunProvider) RevealsLocation() bool { return true }
+
 // EnrichResume is called during resume mode to apply resolved pending input data
 func (p *ParkrunProvider) EnrichResume(ctx context.Context, activity *pbactivity.StandardizedActivity, user *user.Record, pendingInput *pbpipeline.PendingInput) (*providers.EnrichmentResult, error) {
 	// Extract resolved data from the pending input
-	description := pendingInput.InputData["description"]
+	// The results description arrives with the default header; swap in the
+	// user's so updates keep replacing the same section
+	header := providers.SectionHeader(user, description.SectionParkrun)
+	desc := pendingInput.InputData["description"]
+	if defaultHeader := description.Header(description.SectionParkrun, nil); header != defaultHeader {
+		if rest, ok := strings.CutPrefix(desc, defaultHeader); ok {
+			desc = header + rest
+		}
+	}
 	position := pendingInput.InputData["position"]
 	timeStr := pendingInput.InputData["time"]
 	ageGrade := pendingInput.InputData["age_grade"]
 
 	result := &providers.EnrichmentResult{
-		Description:   description, // description already contains the header from FormatResultsDescription
-		SectionHeader: "🏃 Parkrun Results:",
+		Description:   desc,
+		SectionHeader: header,
 		Metadata: map[string]string{
 			"status":                "success",
 			"is_parkrun":            "true",
@@ -332,9 +344,9 @@ func (p *ParkrunProvider) Enrich(ctx context.Context, logger *slog.Logger, activ
 					"time", parkrunResults.Time,
 					"age_grade", parkrunResults.AgeGrade)
 
-				desc := parkrunutil.FormatResultsDescription(parkrunResults, matchedLocation.Name)
-				result.Description = desc
-				result.SectionHeader = "🏃 Parkrun Results:"
+				header := providers.SectionHeader(user, description.SectionParkrun)
+				result.Description = parkrunutil.FormatResultsDescription(parkrunResults, matchedLocation.Name, header)
+				result.SectionHeader = header
 
 				result.Metadata["parkrun_results_state"] = "IMMEDIATE"
 				result.Metadata["parkrun_position"] = fmt.Sprintf("%d", parkrunResults.Position)
@@ -398,8 +410,9 @@ func (p *ParkrunProvider) Enrich(ctx context.Context, logger *slog.Logger, activ
 				}
 
 				// Add placeholder description for destinations while waiting for official results
-				result.Description = "🏃 Parkrun Results:\nWaiting for results to be released..."
-				result.SectionHeader = "🏃 Parkrun Results:"
+				header := providers.SectionHeader(user, description.SectionParkrun)
+				result.Description = header + "\nWaiting for results to be released..."
+				result.SectionHeader = header
 
 				result.Metadata["parkrun_results_state"] = "PENDING"
 			} else {
@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"sync"
 	"time"
+
+	"github.com/fitglue/server/src/go/pkg/domain/cost"
 )
 
 const (
@@ -119,6 +121,7 @@ func (s *ParkrunLocationsService) RefreshFromSource(ctx context.Context) error {
 	req.Header.Set("User-Agent", "FitGlue/1.0 (https://fitglue.com)")
 
 	resp, err := s.client.Do(req)
+	cost.Record(ctx, cost.Usage{ExternalAPICalls: 1})
 	if err != nil {
 		return fmt.Errorf("fetching events.json: %w", err)
 	}
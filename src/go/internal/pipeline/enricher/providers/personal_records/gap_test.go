@@ -0,0 +1,141 @@
+package personal_records
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	user "github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// hillClimb builds a 1.2 km run at 3 m/s (5:33/km) up a steady 5% grade.
+func hillClimb() *pbactivity.StandardizedActivity {
+	start := time.Date(2026, 5, 12, 18, 0, 0, 0, time.UTC)
+	var records []*pbactivity.Record
+	for i := 0; i <= 400; i++ {
+		d := float64(i) * 3
+		records = append(records, &pbactivity.Record{
+			Timestamp: timestamppb.New(start.Add(time.Duration(i) * time.Second)),
+			Speed:     3,
+			Distance:  d,
+			Altitude:  100 + d*0.05,
+		})
+	}
+	return &pbactivity.StandardizedActivity{
+		Type:      pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		StartTime: timestamppb.New(start),
+		Sessions:  []*pbactivity.Session{{TotalDistance: 1200, TotalElapsedTime: 400, Laps: []*pbactivity.Lap{{Records: records}}}},
+	}
+}
+
+func TestEnrich_GradeAdjustedBest(t *testing.T) {
+	var saved []string
+	db := &mocks.MockDatabase{
+		GetPersonalRecordFunc: func(ctx context.Context, userId, recordType string) (*pbuser.PersonalRecord, error) {
+			switch recordType {
+			case string(RecordFastest1K):
+				return &pbuser.PersonalRecord{RecordType: recordType, Value: 300}, nil
+			case string(RecordLongestRun):
+				return &pbuser.PersonalRecord{RecordType: recordType, Value: 50000}, nil
+			default:
+				return &pbuser.PersonalRecord{RecordType: recordType, Value: 1}, nil
+			}
+		},
+		SetPersonalRecordFunc: func(ctx context.Context, userId string, record *pbuser.PersonalRecord) error {
+			saved = append(saved, record.RecordType)
+			return nil
+		},
+	}
+	p := NewPersonalRecordsProvider()
+	p.SetService(&bootstrap.Service{DB: db})
+	u := &user.Record{UserProfile: &pbuser.UserProfile{UserId: "u1"}}
+
+	res, err := p.Enrich(context.Background(), slog.Default(), hillClimb(), u, map[string]string{}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// 5:33 up a 5% grade is worth about 4:16 on the flat, beating the 5:00 PR
+	if !strings.Contains(res.Description, "⛰️ Grade-Adjusted Bests:\n• 1K: 4:1") || !strings.Contains(res.Description, "(PR 5:00)") {
+		t.Errorf("Expected a grade-adjusted 1K best, got %q", res.Description)
+	}
+	if strings.Contains(res.Description, "Personal Records") {
+		t.Errorf("Expected no actual PRs, got %q", res.Description)
+	}
+	if res.Metadata["pr_status"] != "no_new_prs" || res.Metadata["gap_effort_count"] != "1" {
+		t.Errorf("Unexpected metadata: %v", res.Metadata)
+	}
+	if len(saved) != 0 {
+		t.Errorf("Expected grade-adjusted bests not to be saved, got %v", saved)
+	}
+}
+
+func TestEnrich_GradeAdjustedBest_FlatRun(t *testing.T) {
+	db := &mocks.MockDatabase{
+		GetPersonalRecordFunc: func(ctx context.Context, userId, recordType string) (*pbuser.PersonalRecord, error) {
+			switch recordType {
+			case string(RecordFastest1K):
+				return &pbuser.PersonalRecord{RecordType: recordType, Value: 300}, nil
+			case string(RecordLongestRun):
+				return &pbuser.PersonalRecord{RecordType: recordType, Value: 50000}, nil
+			default:
+				return &pbuser.PersonalRecord{RecordType: recordType, Value: 1}, nil
+			}
+		},
+	}
+	p := NewPersonalRecordsProvider()
+	p.SetService(&bootstrap.Service{DB: db})
+	u := &user.Record{UserProfile: &pbuser.UserProfile{UserId: "u1"}}
+
+	activity := hillClimb()
+	for _, r := range activity.Sessions[0].Laps[0].Records {
+		r.Altitude = 100
+	}
+	res, _ := p.Enrich(context.Background(), slog.Default(), activity, u, map[string]string{}, false)
+	if res.Description != "" || res.Metadata["gap_effort_count"] != "" {
+		t.Errorf("Expected nothing for a flat run, got %q %v", res.Description, res.Metadata)
+	}
+}
+
+func TestEnrich_LowDataQualitySkipsCardioRecords(t *testing.T) {
+	var checked []string
+	db := &mocks.MockDatabase{
+		GetPersonalRecordFunc: func(ctx context.Context, userId, recordType string) (*pbuser.PersonalRecord, error) {
+			checked = append(checked, recordType)
+			return nil, nil
+		},
+		SetPersonalRecordFunc: func(ctx context.Context, userId string, record *pbuser.PersonalRecord) error {
+			return nil
+		},
+	}
+	p := NewPersonalRecordsProvider()
+	p.SetService(&bootstrap.Service{DB: db})
+	u := &user.Record{UserProfile: &pbuser.UserProfile{UserId: "u1"}}
+
+	activity := hillClimb()
+	score := int32(40)
+	activity.DataQualityScore = &score
+	res, err := p.Enrich(context.Background(), slog.Default(), activity, u, map[string]string{}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(checked) != 0 || res.Description != "" {
+		t.Errorf("Expected no records checked, got %v and %q", checked, res.Description)
+	}
+	if res.Metadata["pr_status"] != "low_data_quality" || res.Metadata["data_quality_score"] != "40" {
+		t.Errorf("Unexpected metadata: %v", res.Metadata)
+	}
+
+	// A lower threshold accepts the same activity
+	res, _ = p.Enrich(context.Background(), slog.Default(), activity, u, map[string]string{"min_data_quality": "30"}, false)
+	if len(checked) == 0 || res.Metadata["pr_status"] == "low_data_quality" {
+		t.Errorf("Expected records checked with min_data_quality 30, got %v", res.Metadata)
+	}
+}
@@ -4,14 +4,17 @@ package personal_records
 import (
 	"context"
 	"fmt"
+	"github.com/fitglue/server/src/go/pkg/description"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
 	"log/slog"
 	"math"
+	"strconv"
 	"strings"
 
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/muscle_heatmap"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	activityPkg "github.com/fitglue/server/src/go/pkg/domain/activity"
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
@@ -53,6 +56,21 @@ func (p *PersonalRecordsProvider) Enrich(ctx context.Context, logger *slog.Logge
 	trackCardio := inputs["cardio_records"] != "false"     // Default true
 	trackStrength := inputs["strength_records"] != "false" // Default true
 	celebrateInTitle := inputs["celebrate_in_title"] == "true"
+	oneRM := oneRMConfig{
+		formula: ParseOneRMFormula(inputs["one_rm_formula"]),
+		maxReps: DefaultMax1RMReps,
+	}
+	if v, err := strconv.Atoi(inputs["max_1rm_reps"]); err == nil && v > 0 {
+		oneRM.maxReps = int32(v)
+	}
+	// Cardio records come from GPS and speed streams, so they are only
+	// accepted when a data quality score (if any) clears the threshold
+	minDataQuality := int32(DefaultMinDataQuality)
+	if v, err := strconv.Atoi(inputs["min_data_quality"]); err == nil && v >= 0 {
+		minDataQuality = int32(v)
+	}
+	lowDataQuality := trackCardio && IsCardioActivity(activity.Type) &&
+		activity.DataQualityScore != nil && activity.GetDataQualityScore() < minDataQuality
 
 	// Same-source dedup: check if this activity was already processed
 	externalId := inputs["external_id"]
@@ -96,6 +114,15 @@ func (p *PersonalRecordsProvider) Enrich(ctx context.Context, logger *slog.Logge
 	var newPRs []NewPRResult
 	userID := user.UserId
 
+	// Cardio records and grade-adjusted bests aren't trusted from poor data
+	if lowDataQuality {
+		logger.Info("Skipping cardio records: low data quality",
+			"data_quality_score", activity.GetDataQualityScore(),
+			"min_data_quality", minDataQuality,
+		)
+		trackCardio = false
+	}
+
 	// Check cardio records
 	if trackCardio && IsCardioActivity(activity.Type) {
 		cardioPRs, err := p.checkCardioRecords(ctx, logger, activity, userID)
@@ -108,7 +135,7 @@ func (p *PersonalRecordsProvider) Enrich(ctx context.Context, logger *slog.Logge
 
 	// Check strength records
 	if trackStrength && IsStrengthActivity(activity.Type) {
-		strengthPRs, err := p.checkStrengthRecords(ctx, logger, activity, userID)
+		strengthPRs, err := p.checkStrengthRecords(ctx, logger, activity, userID, oneRM)
 		if err != nil {
 			logger.Warn("Failed to check strength records", "error", err)
 		} else {
@@ -127,7 +154,13 @@ func (p *PersonalRecordsProvider) Enrich(ctx context.Context, logger *slog.Logge
 		}
 	}
 
-	if len(newPRs) == 0 {
+	// Grade-adjusted bests on hilly runs are reported but never stored
+	var gapEfforts []string
+	if trackCardio && IsRunningActivity(activity.Type) {
+		gapEfforts = p.checkGradeAdjustedEfforts(ctx, logger, activity, userID, newPRs)
+	}
+
+	if len(newPRs) == 0 && len(gapEfforts) == 0 {
 		// Cache "no PRs" result for dedup
 		if externalId != "" && p.Service != nil && p.Service.DB != nil {
 			cacheData := map[string]interface{}{
@@ -138,29 +171,53 @@ func (p *PersonalRecordsProvider) Enrich(ctx context.Context, logger *slog.Logge
 			}
 			_ = p.Service.DB.SetBoosterData(ctx, user.UserId, "personal_records_cache", cacheData)
 		}
-		return &providers.EnrichmentResult{
+		result := &providers.EnrichmentResult{
 			Metadata: map[string]string{
 				"pr_status": "no_new_prs",
 			},
-		}, nil
+		}
+		if lowDataQuality {
+			result.Metadata["pr_status"] = "low_data_quality"
+			result.Metadata["data_quality_score"] = fmt.Sprintf("%d", activity.GetDataQualityScore())
+		}
+		return result, nil
 	}
 
 	// Build the output with section title (matching other enrichers like heart_rate_zones)
 	var sb strings.Builder
-	sb.WriteString("🏆 Personal Records:\n")
-	for _, pr := range newPRs {
-		sb.WriteString("• " + pr.DisplayMessage)
-		sb.WriteString("\n")
+	if len(newPRs) > 0 {
+		sb.WriteString(providers.SectionHeader(user, description.SectionPersonalRecords) + "\n")
+		for _, pr := range newPRs {
+			sb.WriteString("• " + pr.DisplayMessage)
+			sb.WriteString("\n")
+		}
+	}
+	if len(gapEfforts) > 0 {
+		sb.WriteString(providers.SectionHeader(user, description.SectionGradeAdjustedBests) + "\n")
+		for _, effort := range gapEfforts {
+			sb.WriteString("• " + effort)
+			sb.WriteString("\n")
+		}
 	}
 	prDescription := sb.String()
 
+	prStatus := "pr_detected"
+	if len(newPRs) == 0 {
+		prStatus = "no_new_prs"
+	}
 	result := &providers.EnrichmentResult{
 		Description: prDescription,
 		Metadata: map[string]string{
-			"pr_status": "pr_detected",
+			"pr_status": prStatus,
 			"pr_count":  fmt.Sprintf("%d", len(newPRs)),
 		},
 	}
+	if len(gapEfforts) > 0 {
+		result.Metadata["gap_effort_count"] = fmt.Sprintf("%d", len(gapEfforts))
+	}
+	if lowDataQuality {
+		result.Metadata["cardio_records_skipped"] = "low_data_quality"
+	}
 
 	// Optionally add celebration to name
 	if celebrateInTitle && len(newPRs) > 0 {
@@ -266,13 +323,59 @@ func (p *PersonalRecordsProvider) checkCardioRecords(ctx context.Context, logger
 	return results, nil
 }
 
+// checkGradeAdjustedEfforts compares the fastest grade-adjusted segments of a
+// hilly run against the user's stored records, for distances where the run
+// didn't already set a PR. A grade-adjusted time says what the effort was
+// worth on the flat, so it's reported alongside PRs but never saved as one.
+func (p *PersonalRecordsProvider) checkGradeAdjustedEfforts(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, userID string, newPRs []NewPRResult) []string {
+	if p.Service == nil || p.Service.DB == nil || !activityPkg.IsHilly(activity) {
+		return nil
+	}
+	points := buildGradeAdjustedPoints(activityPkg.GradeAdjust(activity))
+	if len(points) < 2 {
+		return nil
+	}
+	totalFlatDistance := points[len(points)-1].CumulativeDistanceM
+
+	setPRs := make(map[string]bool, len(newPRs))
+	for _, pr := range newPRs {
+		setPRs[pr.RecordType] = true
+	}
+
+	var efforts []string
+	for _, threshold := range AllDistanceThresholds() {
+		// Sub-kilometre segments are too short to grade reliably
+		if threshold.DistanceM < Distance1K || setPRs[string(threshold.RecordType)] {
+			continue
+		}
+		if totalFlatDistance < threshold.DistanceM {
+			break
+		}
+
+		gapTime := slidingWindowMinTime(points, threshold.DistanceM)
+		if gapTime <= 0 {
+			continue
+		}
+		existing, err := p.Service.DB.GetPersonalRecord(ctx, userID, string(threshold.RecordType))
+		if err != nil || existing == nil || gapTime >= existing.Value {
+			continue
+		}
+
+		logger.Info("Grade-adjusted effort beats PR", "record_type", threshold.RecordType, "gap_time", gapTime, "pr", existing.Value)
+		efforts = append(efforts, fmt.Sprintf("%s: %s (PR %s)",
+			strings.TrimPrefix(threshold.Display, "Fastest "), formatDuration(gapTime), formatDuration(existing.Value)))
+	}
+	return efforts
+}
+
 // checkStrengthRecords checks for strength PRs and persists them to Firestore
-func (p *PersonalRecordsProvider) checkStrengthRecords(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, userID string) ([]NewPRResult, error) {
+func (p *PersonalRecordsProvider) checkStrengthRecords(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, userID string, oneRM oneRMConfig) ([]NewPRResult, error) {
 	var results []NewPRResult
 
 	// Group sets by normalized exercise name
 	exerciseData := make(map[string]struct {
 		Best1RM       float64
+		Best1RMSet    oneRMEvidence
 		BestSetVolume float64
 		TotalVolume   float64
 		MaxReps       int32
@@ -292,10 +395,13 @@ func (p *PersonalRecordsProvider) checkStrengthRecords(ctx context.Context, logg
 
 			data := exerciseData[normalizedName]
 
-			// Calculate 1RM for this set
-			estimated1RM := Calculate1RM(set.WeightKg, set.Reps)
-			if estimated1RM > data.Best1RM {
-				data.Best1RM = estimated1RM
+			// Calculate 1RM for this set, ignoring sets above the rep cap
+			if set.Reps <= oneRM.maxReps {
+				estimated1RM := Estimate1RM(oneRM.formula, set.WeightKg, set.Reps)
+				if estimated1RM > data.Best1RM {
+					data.Best1RM = estimated1RM
+					data.Best1RMSet = oneRMEvidence{formula: oneRM.formula, weightKg: set.WeightKg, reps: set.Reps}
+				}
 			}
 
 			// Track volume
@@ -319,7 +425,8 @@ func (p *PersonalRecordsProvider) checkStrengthRecords(ctx context.Context, logg
 		// Check 1RM
 		if data.Best1RM > 0 {
 			recordType := exerciseName + string(Suffix1RM)
-			pr, err := p.checkAndUpdateRecord(ctx, userID, recordType, data.Best1RM, "kg", activity, false)
+			evidence := data.Best1RMSet
+			pr, err := p.checkAndUpdateRecordWithEvidence(ctx, userID, recordType, data.Best1RM, "kg", activity, false, &evidence)
 			if err != nil {
 				logger.Warn("Failed to check 1RM record", "error", err, "exercise", exerciseName)
 			} else if pr != nil {
@@ -460,8 +567,29 @@ func normalizeStationName(exerciseName string) string {
 	}
 }
 
+// oneRMConfig holds the user's 1RM estimation settings
+type oneRMConfig struct {
+	formula OneRMFormula
+	maxReps int32 // sets with more reps are not used for 1RM estimation
+}
+
+// oneRMEvidence is the set an estimated 1RM was calculated from
+type oneRMEvidence struct {
+	formula  OneRMFormula
+	weightKg float64
+	reps     int32
+}
+
 // checkAndUpdateRecord compares the new value with the existing record and updates if it's a PR
 func (p *PersonalRecordsProvider) checkAndUpdateRecord(ctx context.Context, userID, recordType string, newValue float64, unit string, activity *pbactivity.StandardizedActivity, lowerIsBetter bool) (*NewPRResult, error) {
+	return p.checkAndUpdateRecordWithEvidence(ctx, userID, recordType, newValue, unit, activity, lowerIsBetter, nil)
+}
+
+// checkAndUpdateRecordWithEvidence is checkAndUpdateRecord for estimated 1RMs.
+// The formula and source set are stored on the record, and an existing record
+// estimated with a different formula is re-estimated from its source set so
+// the two values are comparable.
+func (p *PersonalRecordsProvider) checkAndUpdateRecordWithEvidence(ctx context.Context, userID, recordType string, newValue float64, unit string, activity *pbactivity.StandardizedActivity, lowerIsBetter bool, evidence *oneRMEvidence) (*NewPRResult, error) {
 	// Get existing record from Firestore
 	existingRecord, err := p.Service.DB.GetPersonalRecord(ctx, userID, recordType)
 	if err != nil {
@@ -471,6 +599,9 @@ func (p *PersonalRecordsProvider) checkAndUpdateRecord(ctx context.Context, user
 		}
 		existingRecord = nil
 	}
+	if existingRecord != nil && evidence != nil {
+		existingRecord.Value = comparable1RM(existingRecord, evidence.formula)
+	}
 
 	// Determine if this is a new PR
 	isNewPR := false
@@ -511,6 +642,12 @@ func (p *PersonalRecordsProvider) checkAndUpdateRecord(ctx context.Context, user
 	if improvement != nil {
 		newRecord.Improvement = improvement
 	}
+	if evidence != nil {
+		formula := string(evidence.formula)
+		newRecord.EstimationFormula = &formula
+		newRecord.SourceWeightKg = &evidence.weightKg
+		newRecord.SourceReps = &evidence.reps
+	}
 
 	// Save to Firestore
 	if err := p.Service.DB.SetPersonalRecord(ctx, userID, newRecord); err != nil {
@@ -519,6 +656,9 @@ func (p *PersonalRecordsProvider) checkAndUpdateRecord(ctx context.Context, user
 
 	// Format display message
 	displayMessage := p.formatPRMessage(recordType, newValue, previousValue, improvement, unit, lowerIsBetter)
+	if evidence != nil && evidence.reps > 1 {
+		displayMessage += fmt.Sprintf(" [%s × %d, %s]", formatWeight(evidence.weightKg), evidence.reps, formatFormulaName(evidence.formula))
+	}
 
 	return &NewPRResult{
 		RecordType:     recordType,
@@ -530,6 +670,35 @@ func (p *PersonalRecordsProvider) checkAndUpdateRecord(ctx context.Context, user
 	}, nil
 }
 
+// comparable1RM returns an existing 1RM record's value under formula. Records
+// saved before the formula was stored were estimated with Epley; records without
+// a source set keep their stored value.
+func comparable1RM(record *pbuser.PersonalRecord, formula OneRMFormula) float64 {
+	recordFormula := FormulaEpley
+	if record.EstimationFormula != nil {
+		recordFormula = ParseOneRMFormula(*record.EstimationFormula)
+	}
+	if recordFormula == formula || record.SourceWeightKg == nil || record.SourceReps == nil {
+		return record.Value
+	}
+	if v := Estimate1RM(formula, *record.SourceWeightKg, *record.SourceReps); v > 0 {
+		return v
+	}
+	return record.Value
+}
+
+// formatFormulaName returns the display name of a 1RM formula
+func formatFormulaName(formula OneRMFormula) string {
+	switch formula {
+	case FormulaBrzycki:
+		return "Brzycki"
+	case FormulaLombardi:
+		return "Lombardi"
+	default:
+		return "Epley"
+	}
+}
+
 // formatPRMessage creates a user-friendly PR announcement
 func (p *PersonalRecordsProvider) formatPRMessage(recordType string, newValue float64, previousValue, improvement *float64, unit string, lowerIsBetter bool) string {
 	// Determine emoji based on record type
@@ -2,6 +2,7 @@ package personal_records
 
 import (
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 
 	"testing"
 
@@ -58,6 +59,77 @@ func TestCalculate1RM(t *testing.T) {
 	}
 }
 
+func TestEstimate1RM(t *testing.T) {
+	tests := []struct {
+		name     string
+		formula  OneRMFormula
+		weightKg float64
+		reps     int32
+		want     float64
+	}{
+		{"Epley for 5 reps", FormulaEpley, 100, 5, 116.67},              // 100 * (1 + 5/30)
+		{"Brzycki for 5 reps", FormulaBrzycki, 100, 5, 112.5},           // 100 * 36 / 32
+		{"Lombardi for 5 reps", FormulaLombardi, 100, 5, 117.46},        // 100 * 5^0.1
+		{"single rep ignores formula", FormulaBrzycki, 100, 1, 100},     // weight directly
+		{"Brzycki undefined at 37 reps", FormulaBrzycki, 100, 37, 0},    // division by zero
+		{"zero reps returns zero", FormulaLombardi, 100, 0, 0},          // no estimate
+		{"unknown formula uses Epley", OneRMFormula("x"), 80, 5, 93.33}, // 80 * (1 + 5/30)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Estimate1RM(tt.formula, tt.weightKg, tt.reps)
+			if !approximatelyEqual(got, tt.want, 0.01) {
+				t.Errorf("Estimate1RM(%q, %v, %v) = %v, want approximately %v", tt.formula, tt.weightKg, tt.reps, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseOneRMFormula(t *testing.T) {
+	cases := map[string]OneRMFormula{
+		"":          FormulaEpley,
+		"epley":     FormulaEpley,
+		" Brzycki ": FormulaBrzycki,
+		"LOMBARDI":  FormulaLombardi,
+		"mayhew":    FormulaEpley,
+	}
+	for in, want := range cases {
+		if got := ParseOneRMFormula(in); got != want {
+			t.Errorf("ParseOneRMFormula(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestComparable1RM(t *testing.T) {
+	brzycki := "brzycki"
+	weight := 100.0
+	reps := int32(5)
+
+	withEvidence := &pbuser.PersonalRecord{Value: 112.5, EstimationFormula: &brzycki, SourceWeightKg: &weight, SourceReps: &reps}
+	legacy := &pbuser.PersonalRecord{Value: 116.67}
+
+	tests := []struct {
+		name    string
+		record  *pbuser.PersonalRecord
+		formula OneRMFormula
+		want    float64
+	}{
+		{"same formula keeps value", withEvidence, FormulaBrzycki, 112.5},
+		{"other formula re-estimates from source set", withEvidence, FormulaEpley, 116.67},
+		{"legacy record is Epley", legacy, FormulaEpley, 116.67},
+		{"legacy record without source set keeps value", legacy, FormulaLombardi, 116.67},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := comparable1RM(tt.record, tt.formula); !approximatelyEqual(got, tt.want, 0.01) {
+				t.Errorf("comparable1RM() = %v, want approximately %v", got, tt.want)
+			}
+		})
+	}
+}
+
 // approximatelyEqual checks if two floats are equal within a tolerance
 func approximatelyEqual(a, b, tolerance float64) bool {
 	diff := a - b
@@ -2,6 +2,9 @@
 package personal_records
 
 import (
+	"math"
+	"strings"
+
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 )
 
@@ -177,17 +180,67 @@ type NewPRResult struct {
 	DisplayMessage string // Formatted message for description
 }
 
-// Calculate1RM calculates the estimated 1 Rep Max using the Epley formula
-// If reps == 1, returns the weight directly
-// Otherwise: weight * (1 + reps/30)
-func Calculate1RM(weightKg float64, reps int32) float64 {
+// OneRMFormula identifies the formula used to estimate a 1 Rep Max
+type OneRMFormula string
+
+const (
+	FormulaEpley    OneRMFormula = "epley"
+	FormulaBrzycki  OneRMFormula = "brzycki"
+	FormulaLombardi OneRMFormula = "lombardi"
+)
+
+// DefaultMax1RMReps is the highest rep count used for 1RM estimation by default.
+// All three formulas drift badly beyond ~12 reps.
+const DefaultMax1RMReps = 12
+
+// DefaultMinDataQuality is the data quality score below which cardio records
+// are not accepted. It only applies when a data quality enricher ran first.
+const DefaultMinDataQuality = 50
+
+// ParseOneRMFormula parses a formula config value, defaulting to Epley
+func ParseOneRMFormula(s string) OneRMFormula {
+	switch OneRMFormula(strings.ToLower(strings.TrimSpace(s))) {
+	case FormulaBrzycki:
+		return FormulaBrzycki
+	case FormulaLombardi:
+		return FormulaLombardi
+	default:
+		return FormulaEpley
+	}
+}
+
+// Estimate1RM estimates the 1 Rep Max with the given formula.
+// If reps == 1, returns the weight directly. Returns 0 when the formula
+// is undefined for the rep count (Brzycki at 37+ reps).
+func Estimate1RM(formula OneRMFormula, weightKg float64, reps int32) float64 {
 	if reps <= 0 {
 		return 0
 	}
 	if reps == 1 {
 		return weightKg
 	}
-	return weightKg * (1 + float64(reps)/30)
+	r := float64(reps)
+	switch formula {
+	case FormulaBrzycki:
+		// weight * 36 / (37 - reps)
+		if reps >= 37 {
+			return 0
+		}
+		return weightKg * 36 / (37 - r)
+	case FormulaLombardi:
+		// weight * reps^0.10
+		return weightKg * math.Pow(r, 0.10)
+	default:
+		// weight * (1 + reps/30)
+		return weightKg * (1 + r/30)
+	}
+}
+
+// Calculate1RM calculates the estimated 1 Rep Max using the Epley formula
+// If reps == 1, returns the weight directly
+// Otherwise: weight * (1 + reps/30)
+func Calculate1RM(weightKg float64, reps int32) float64 {
+	return Estimate1RM(FormulaEpley, weightKg, reps)
 }
 
 // CalculateSetVolume calculates the total volume for a set (weight * reps)
@@ -5,6 +5,7 @@ import (
 	"math"
 	"sort"
 
+	activityPkg "github.com/fitglue/server/src/go/pkg/domain/activity"
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 )
 
@@ -198,6 +199,29 @@ func buildFromSpeedDerived(activity *pbactivity.StandardizedActivity) []distance
 	return points
 }
 
+// buildGradeAdjustedPoints builds cumulative distance/time points from
+// grade-adjusted speed, so each point's distance is what the effort so far
+// would have covered on flat ground.
+func buildGradeAdjustedPoints(samples []*activityPkg.GradeAdjustedSample) []distanceTimePoint {
+	if len(samples) == 0 {
+		return nil
+	}
+	points := []distanceTimePoint{{0, 0}}
+	var cumulativeDistance, cumulativeTime float64
+	for _, s := range samples[1:] {
+		if s.Seconds <= 0 {
+			continue
+		}
+		cumulativeDistance += s.GAPSpeed * s.Seconds
+		cumulativeTime += s.Seconds
+		points = append(points, distanceTimePoint{
+			CumulativeDistanceM: cumulativeDistance,
+			ElapsedTimeSec:      cumulativeTime,
+		})
+	}
+	return points
+}
+
 // slidingWindowMinTime uses a two-pointer technique on cumulative distance/time points
 // to find the minimum elapsed time for a contiguous segment covering targetDistanceM.
 // It interpolates the exact start point for precision.
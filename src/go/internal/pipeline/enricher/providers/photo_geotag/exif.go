@@ -0,0 +1,378 @@
+package photo_geotag
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// EXIF tags read from a JPEG. Only the capture time and GPS position are
+// needed, so this deliberately isn't a general-purpose EXIF reader.
+const (
+	tagOrientation        = 0x0112
+	tagExifIFD            = 0x8769
+	tagGPSIFD             = 0x8825
+	tagDateTime           = 0x0132
+	tagDateTimeOriginal   = 0x9003
+	tagOffsetTimeOriginal = 0x9011
+
+	tagGPSLatitudeRef  = 0x0001
+	tagGPSLatitude     = 0x0002
+	tagGPSLongitudeRef = 0x0003
+	tagGPSLongitude    = 0x0004
+	tagGPSTimeStamp    = 0x0007
+	tagGPSDateStamp    = 0x001D
+)
+
+// TIFF field types used by the tags above.
+const (
+	typeASCII    = 2
+	typeShort    = 3
+	typeLong     = 4
+	typeRational = 5
+)
+
+const exifDateLayout = "2006:01:02 15:04:05"
+
+var errNoExif = errors.New("no EXIF data found")
+
+// photoExif holds the parts of a photo's EXIF data used for matching.
+type photoExif struct {
+	// localTime is the camera's wall-clock capture time, parsed as UTC since
+	// EXIF doesn't record a zone alongside it.
+	localTime time.Time
+	// utcOffset is from OffsetTimeOriginal, when the camera wrote it.
+	utcOffset *time.Duration
+	gpsTime   time.Time
+	hasGPS    bool
+	lat, long float64
+	// orientation is how the image is rotated for display, 1 when upright.
+	orientation uint16
+}
+
+// captureTime returns when the photo was taken. GPS time is UTC by definition
+// and preferred; otherwise the camera's wall-clock time is interpreted using
+// its recorded offset, falling back to loc.
+func (e *photoExif) captureTime(loc *time.Location) (time.Time, bool) {
+	if !e.gpsTime.IsZero() {
+		return e.gpsTime, true
+	}
+	if e.localTime.IsZero() {
+		return time.Time{}, false
+	}
+	if e.utcOffset != nil {
+		return e.localTime.Add(-*e.utcOffset), true
+	}
+	l := e.localTime
+	return time.Date(l.Year(), l.Month(), l.Day(), l.Hour(), l.Minute(), l.Second(), 0, loc).UTC(), true
+}
+
+// parseJPEGExif extracts capture time and GPS position from a JPEG's APP1
+// EXIF segment.
+func parseJPEGExif(data []byte) (*photoExif, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, errors.New("not a JPEG image")
+	}
+
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return nil, fmt.Errorf("invalid JPEG marker at offset %d", pos)
+		}
+		marker := data[pos+1]
+		// Start of scan: image data follows and no more metadata segments
+		if marker == 0xDA || marker == 0xD9 {
+			break
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if length < 2 || pos+2+length > len(data) {
+			return nil, errors.New("truncated JPEG segment")
+		}
+		segment := data[pos+4 : pos+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return parseTIFF(segment[6:])
+		}
+		pos += 2 + length
+	}
+	return nil, errNoExif
+}
+
+// stripJPEGMetadata returns the photo without the metadata segments cameras
+// and phones write (EXIF with its GPS position and device serials, XMP, IPTC
+// and comments), so it can be published without revealing where it was
+// taken. The image data and colour profile are copied unchanged, and the
+// orientation is kept in a minimal EXIF segment so the photo displays the
+// right way up.
+func stripJPEGMetadata(data []byte) ([]byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, errors.New("not a JPEG image")
+	}
+	var orientation uint16
+	if ex, err := parseJPEGExif(data); err == nil {
+		orientation = ex.orientation
+	}
+	orientationWritten := orientation <= 1
+
+	out := make([]byte, 0, len(data))
+	out = append(out, 0xFF, 0xD8)
+	pos := 2
+	for pos+2 <= len(data) {
+		if data[pos] != 0xFF {
+			return nil, fmt.Errorf("invalid JPEG marker at offset %d", pos)
+		}
+		marker := data[pos+1]
+		if marker == 0xFF { // Fill byte
+			pos++
+			continue
+		}
+		// The image data follows the start of scan, with no more metadata
+		if marker == 0xDA || marker == 0xD9 {
+			if !orientationWritten {
+				out = append(out, orientationSegment(orientation)...)
+			}
+			return append(out, data[pos:]...), nil
+		}
+		if pos+4 > len(data) {
+			break
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if length < 2 || pos+2+length > len(data) {
+			return nil, errors.New("truncated JPEG segment")
+		}
+		segment := data[pos : pos+2+length]
+		pos += 2 + length
+		if !keepSegment(marker, segment[4:]) {
+			continue
+		}
+		// JFIF must stay first, so orientation goes after it
+		if !orientationWritten && marker != 0xE0 {
+			out = append(out, orientationSegment(orientation)...)
+			orientationWritten = true
+		}
+		out = append(out, segment...)
+	}
+	return nil, errors.New("JPEG has no image data")
+}
+
+// keepSegment reports whether a JPEG segment is needed to display the image.
+// Application segments are dropped except the JFIF header, ICC colour
+// profile and Adobe colour transform.
+func keepSegment(marker byte, payload []byte) bool {
+	switch marker {
+	case 0xE0:
+		return bytes.HasPrefix(payload, []byte("JFIF\x00"))
+	case 0xE2:
+		return bytes.HasPrefix(payload, []byte("ICC_PROFILE\x00"))
+	case 0xEE:
+		return bytes.HasPrefix(payload, []byte("Adobe"))
+	case 0xFE: // Comment
+		return false
+	}
+	return marker < 0xE0 || marker > 0xEF
+}
+
+// orientationSegment is an APP1 EXIF segment holding only the orientation.
+func orientationSegment(orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a")
+	tiff = binary.BigEndian.AppendUint32(tiff, 8)
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, tagOrientation)
+	tiff = binary.BigEndian.AppendUint16(tiff, typeShort)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0)
+	tiff = binary.BigEndian.AppendUint32(tiff, 0)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+type tiffReader struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+type ifdEntry struct {
+	typ    uint16
+	count  uint32
+	offset []byte // the 4-byte value/offset field
+}
+
+func parseTIFF(data []byte) (*photoExif, error) {
+	if len(data) < 8 {
+		return nil, errors.New("truncated TIFF header")
+	}
+	r := &tiffReader{data: data}
+	switch string(data[:2]) {
+	case "II":
+		r.order = binary.LittleEndian
+	case "MM":
+		r.order = binary.BigEndian
+	default:
+		return nil, errors.New("invalid TIFF byte order")
+	}
+	if r.order.Uint16(data[2:]) != 42 {
+		return nil, errors.New("invalid TIFF magic number")
+	}
+
+	ifd0, err := r.readIFD(r.order.Uint32(data[4:]))
+	if err != nil {
+		return nil, err
+	}
+
+	ex := &photoExif{}
+	if e, ok := ifd0[tagOrientation]; ok && e.typ == typeShort && e.count == 1 {
+		ex.orientation = r.order.Uint16(e.offset)
+	}
+	if e, ok := ifd0[tagDateTime]; ok {
+		ex.localTime, _ = time.Parse(exifDateLayout, r.ascii(e))
+	}
+
+	if e, ok := ifd0[tagExifIFD]; ok {
+		exifIFD, err := r.readIFD(r.order.Uint32(e.offset))
+		if err != nil {
+			return nil, err
+		}
+		if e, ok := exifIFD[tagDateTimeOriginal]; ok {
+			if t, err := time.Parse(exifDateLayout, r.ascii(e)); err == nil {
+				ex.localTime = t
+			}
+		}
+		if e, ok := exifIFD[tagOffsetTimeOriginal]; ok {
+			if offset, ok := parseUTCOffset(r.ascii(e)); ok {
+				ex.utcOffset = &offset
+			}
+		}
+	}
+
+	if e, ok := ifd0[tagGPSIFD]; ok {
+		gpsIFD, err := r.readIFD(r.order.Uint32(e.offset))
+		if err != nil {
+			return nil, err
+		}
+		r.readGPS(gpsIFD, ex)
+	}
+	return ex, nil
+}
+
+func (r *tiffReader) readIFD(offset uint32) (map[uint16]ifdEntry, error) {
+	if int(offset)+2 > len(r.data) {
+		return nil, errors.New("IFD offset out of range")
+	}
+	n := int(r.order.Uint16(r.data[offset:]))
+	start := int(offset) + 2
+	if start+n*12 > len(r.data) {
+		return nil, errors.New("truncated IFD")
+	}
+	entries := make(map[uint16]ifdEntry, n)
+	for i := 0; i < n; i++ {
+		b := r.data[start+i*12:]
+		entries[r.order.Uint16(b)] = ifdEntry{
+			typ:    r.order.Uint16(b[2:]),
+			count:  r.order.Uint32(b[4:]),
+			offset: b[8:12],
+		}
+	}
+	return entries, nil
+}
+
+// value returns the raw bytes of e, which are stored inline when they fit in
+// four bytes and at an offset otherwise.
+func (r *tiffReader) value(e ifdEntry) []byte {
+	var size uint32
+	switch e.typ {
+	case typeASCII:
+		size = 1
+	case typeShort:
+		size = 2
+	case typeLong:
+		size = 4
+	case typeRational:
+		size = 8
+	default:
+		return nil
+	}
+	total := size * e.count
+	if total <= 4 {
+		return e.offset[:total]
+	}
+	off := r.order.Uint32(e.offset)
+	if uint64(off)+uint64(total) > uint64(len(r.data)) {
+		return nil
+	}
+	return r.data[off : off+total]
+}
+
+func (r *tiffReader) ascii(e ifdEntry) string {
+	if e.typ != typeASCII {
+		return ""
+	}
+	return strings.TrimRight(string(r.value(e)), "\x00 ")
+}
+
+func (r *tiffReader) rationals(e ifdEntry) []float64 {
+	if e.typ != typeRational {
+		return nil
+	}
+	b := r.value(e)
+	var out []float64
+	for i := 0; i+8 <= len(b); i += 8 {
+		num, den := r.order.Uint32(b[i:]), r.order.Uint32(b[i+4:])
+		if den == 0 {
+			return nil
+		}
+		out = append(out, float64(num)/float64(den))
+	}
+	return out
+}
+
+func (r *tiffReader) readGPS(ifd map[uint16]ifdEntry, ex *photoExif) {
+	lat := degrees(r.rationals(ifd[tagGPSLatitude]))
+	long := degrees(r.rationals(ifd[tagGPSLongitude]))
+	if lat != nil && long != nil {
+		ex.lat, ex.long = *lat, *long
+		if r.ascii(ifd[tagGPSLatitudeRef]) == "S" {
+			ex.lat = -ex.lat
+		}
+		if r.ascii(ifd[tagGPSLongitudeRef]) == "W" {
+			ex.long = -ex.long
+		}
+		ex.hasGPS = true
+	}
+
+	date, err := time.Parse("2006:01:02", r.ascii(ifd[tagGPSDateStamp]))
+	hms := r.rationals(ifd[tagGPSTimeStamp])
+	if err == nil && len(hms) == 3 {
+		ex.gpsTime = date.Add(time.Duration((hms[0]*3600 + hms[1]*60 + hms[2]) * float64(time.Second))).Truncate(time.Second)
+	}
+}
+
+// degrees converts a degrees/minutes/seconds triple to decimal degrees.
+func degrees(dms []float64) *float64 {
+	if len(dms) != 3 {
+		return nil
+	}
+	d := dms[0] + dms[1]/60 + dms[2]/3600
+	return &d
+}
+
+// parseUTCOffset parses an EXIF offset such as "+01:00" or "-05:30".
+func parseUTCOffset(s string) (time.Duration, bool) {
+	if len(s) != 6 || (s[0] != '+' && s[0] != '-') || s[3] != ':' {
+		return 0, false
+	}
+	t, err := time.Parse("15:04", s[1:])
+	if err != nil {
+		return 0, false
+	}
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	if s[0] == '-' {
+		offset = -offset
+	}
+	return offset, true
+}
@@ -0,0 +1,468 @@
+package photo_geotag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/user_input"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+
+	pendinginput "github.com/fitglue/server/src/go/pkg/pending_input"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// photosField is the pending input field listing the uploaded photos: one
+// object path per line, each uploaded through a signed URL from
+// CreatePendingInputUpload, so the photos never pass through the pending
+// input document.
+const photosField = "photos"
+
+// maxPhotos bounds how many photos one activity takes.
+const maxPhotos = 30
+
+// MarkerType identifies photo TimeMarkers for destinations and for the
+// orchestrator's marker reconciliation.
+const MarkerType = "photo"
+
+const (
+	defaultMaxDistanceMeters = 100.0
+
+	// timeTolerance lets photos taken just before the start or after the end
+	// of the activity (e.g. at the start line) still be placed on it.
+	timeTolerance = 5 * time.Minute
+)
+
+// PhotoGeotagProvider asks for photos taken during an activity, places each on
+// the activity timeline by its EXIF capture time (or GPS position when the
+// time doesn't fit), stores the photos as public assets with their metadata
+// removed and adds a TimeMarker at each capture point.
+type PhotoGeotagProvider struct {
+	service *bootstrap.Service
+}
+
+func init() {
+	providers.Register(NewPhotoGeotagProvider())
+}
+
+func NewPhotoGeotagProvider() *PhotoGeotagProvider {
+	return &PhotoGeotagProvider{}
+}
+
+func (p *PhotoGeotagProvider) SetService(s *bootstrap.Service) {
+	p.service = s
+}
+
+func (p *PhotoGeotagProvider) Name() string {
+	return "photo-geotag"
+}
+
+func (p *PhotoGeotagProvider) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PHOTO_GEOTAG
+}
+
+// options are the matching settings, read from the enricher config in Enrich
+// and carried to EnrichResume through the pending input's metadata.
+type options struct {
+	location    *time.Location
+	offset      time.Duration
+	maxDistance float64
+}
+
+func parseOptions(cfg map[string]string) (options, error) {
+	opts := options{location: time.UTC, maxDistance: defaultMaxDistanceMeters}
+	if tz := strings.TrimSpace(cfg["camera_timezone"]); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return opts, fmt.Errorf("invalid camera_timezone %q", tz)
+		}
+		opts.location = loc
+	}
+	if s := strings.TrimSpace(cfg["camera_offset"]); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return opts, fmt.Errorf("invalid camera_offset %q", s)
+		}
+		opts.offset = d
+	}
+	if s := strings.TrimSpace(cfg["max_distance_m"]); s != "" {
+		d, err := strconv.ParseFloat(s, 64)
+		if err != nil || d <= 0 {
+			return opts, fmt.Errorf("invalid max_distance_m %q", s)
+		}
+		opts.maxDistance = d
+	}
+	return opts, nil
+}
+
+// Enrich requests the photos via a pending input. The pipeline halts until the
+// user uploads them (or continues without them).
+func (p *PhotoGeotagProvider) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	if _, err := parseOptions(inputs); err != nil {
+		return skipped("invalid_config", err.Error()), nil
+	}
+	if len(buildTrack(activity)) == 0 {
+		logger.Info("photo-geotag: skipping, activity has no timed records")
+		return skipped("no_records", "Activity has no timed records to place photos on"), nil
+	}
+
+	if p.service == nil {
+		return nil, fmt.Errorf("service not initialized")
+	}
+
+	stableID := pendinginput.GenerateID(activity.Source.String(), activity.ExternalId, p.Name())
+
+	pending, err := p.service.DB.GetPendingInput(ctx, user.UserId, stableID)
+	if err == nil && pending != nil && pending.Status == pbpipeline.PendingInput_STATUS_WAITING {
+		logger.Debug("photo-geotag: already waiting for photo upload")
+		return &providers.EnrichmentResult{
+			Metadata: map[string]string{
+				"photo_geotag_status": "pending",
+				"status_detail":       "Waiting for photo upload",
+			},
+		}, nil
+	}
+
+	linkedActivityId := inputs["activity_id"]
+	if linkedActivityId == "" {
+		return nil, fmt.Errorf("activity_id not provided in enricher inputs")
+	}
+
+	logger.Info("photo-geotag: requesting photos via pending input", "activity_id", stableID)
+
+	return nil, &user_input.WaitForInputError{
+		ActivityID:         stableID,
+		RequiredFields:     []string{photosField},
+		EnricherProviderID: p.Name(),
+		Metadata: map[string]string{
+			"source_activity_id":    activity.ExternalId,
+			"source_activity_type":  activity.Source.String(),
+			"linked_activity_id":    linkedActivityId,
+			"pipeline_id":           inputs["pipeline_id"],
+			"pipeline_execution_id": inputs["pipeline_execution_id"],
+			"camera_timezone":       inputs["camera_timezone"],
+			"camera_offset":         inputs["camera_offset"],
+			"max_distance_m":        inputs["max_distance_m"],
+			"display.field_labels":  `{"photos":"Photos"}`,
+			"display.field_types":   `{"photos":"upload:accept=.jpg,.jpeg;multiple=true"}`,
+			"display.summary":       "Upload photos taken during this activity",
+			"display.title":         "Add Activity Photos",
+			"display.help":          "Photos are placed on your route using the time and location the camera saved with them",
+		},
+	}
+}
+
+// EnrichResume matches the uploaded photos to the activity timeline. The
+// uploads are deleted once the photos are stored, whether or not they
+// matched; a failed run keeps them for the retry.
+func (p *PhotoGeotagProvider) EnrichResume(ctx context.Context, activity *pbactivity.StandardizedActivity, user *user.Record, pendingInput *pbpipeline.PendingInput) (*providers.EnrichmentResult, error) {
+	refs := uploadRefs(pendingInput.InputData[photosField])
+	if len(refs) == 0 {
+		return skipped("no_photos", "No photos were uploaded"), nil
+	}
+	if len(refs) > maxPhotos {
+		return skipped("too_many_photos", fmt.Sprintf("At most %d photos can be added to an activity", maxPhotos)), nil
+	}
+
+	opts, err := parseOptions(pendingInput.ProviderMetadata)
+	if err != nil {
+		return skipped("invalid_config", err.Error()), nil
+	}
+	if p.service == nil {
+		return nil, fmt.Errorf("service not initialized")
+	}
+
+	pendingInputID := pendinginput.GenerateID(activity.Source.String(), activity.ExternalId, p.Name())
+	photos, err := p.readUploads(ctx, user.UserId, pendingInputID, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	track := buildTrack(activity)
+	var matches []photoMatch
+	unmatched := len(refs) - len(photos)
+	for i, photo := range photos {
+		ex, err := parseJPEGExif(photo)
+		if err != nil {
+			slog.Debug("photo-geotag: unreadable EXIF", "photo", i+1, "error", err)
+			unmatched++
+			continue
+		}
+		m, ok := matchPhoto(track, ex, opts)
+		if !ok {
+			unmatched++
+			continue
+		}
+		// Only the image is published, never where or with what it was taken
+		if m.data, err = stripJPEGMetadata(photo); err != nil {
+			slog.Debug("photo-geotag: unreadable JPEG", "photo", i+1, "error", err)
+			unmatched++
+			continue
+		}
+		matches = append(matches, m)
+	}
+
+	metadata := map[string]string{
+		"photos_received":  strconv.Itoa(len(refs)),
+		"photos_matched":   strconv.Itoa(len(matches)),
+		"photos_unmatched": strconv.Itoa(unmatched),
+	}
+	if len(matches) == 0 {
+		metadata["photo_geotag_status"] = "skipped"
+		metadata["reason"] = "no_matches"
+		metadata["status_detail"] = "No photos could be matched to the activity by time or location"
+		p.deleteUploads(ctx, user.UserId, pendingInputID, refs)
+		return &providers.EnrichmentResult{Metadata: metadata}, nil
+	}
+
+	// Number photos in the order they were taken
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].timestamp.Before(matches[j].timestamp) })
+
+	bucketName := os.Getenv("SHOWCASE_ASSETS_BUCKET")
+	if bucketName == "" {
+		bucketName = "fitglue-server-dev-showcase-assets" // Fallback for local development
+	}
+	assetFolderID := pendingInput.ProviderMetadata["pipeline_execution_id"]
+	if assetFolderID == "" {
+		assetFolderID = activity.ExternalId
+	}
+	if assetFolderID == "" {
+		assetFolderID = "unknown"
+	}
+
+	var markers []*pbactivity.TimeMarker
+	for i, m := range matches {
+		n := i + 1
+		objectPath := fmt.Sprintf("%s/photo-%d.jpg", assetFolderID, n)
+		if err := p.service.Store.Write(ctx, bucketName, objectPath, m.data); err != nil {
+			return nil, fmt.Errorf("failed to upload photo to GCS: %w", err)
+		}
+		url := assetURL(bucketName, objectPath)
+
+		marker := &pbactivity.TimeMarker{
+			Timestamp:  timestamppb.New(m.timestamp),
+			Label:      fmt.Sprintf("Photo %d", n),
+			MarkerType: MarkerType,
+			AssetUrl:   url,
+		}
+		if m.hasPosition {
+			marker.PositionLat = proto.Float64(m.lat)
+			marker.PositionLong = proto.Float64(m.long)
+		}
+		markers = append(markers, marker)
+
+		metadata[fmt.Sprintf("asset_photo_%d", n)] = url
+		metadata[fmt.Sprintf("photo_%d_match", n)] = m.method
+	}
+	metadata["photo_geotag_status"] = "matched"
+	p.deleteUploads(ctx, user.UserId, pendingInputID, refs)
+
+	return &providers.EnrichmentResult{
+		TimeMarkers: markers,
+		Metadata:    metadata,
+	}, nil
+}
+
+func skipped(reason, detail string) *providers.EnrichmentResult {
+	return &providers.EnrichmentResult{
+		Metadata: map[string]string{
+			"photo_geotag_status": "skipped",
+			"reason":              reason,
+			"status_detail":       detail,
+		},
+	}
+}
+
+// uploadRefs splits the submitted field into one object path per non-empty
+// line.
+func uploadRefs(field string) []string {
+	var refs []string
+	for _, line := range strings.Split(field, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			refs = append(refs, line)
+		}
+	}
+	return refs
+}
+
+// readUploads reads the photos uploaded for the user's pending input from the
+// artifacts bucket. Paths outside the input's upload prefix are ignored, and
+// an upload that has gone (e.g. on a replay) is skipped rather than failing
+// the run.
+func (p *PhotoGeotagProvider) readUploads(ctx context.Context, userID, pendingInputID string, refs []string) ([][]byte, error) {
+	bucketName := p.uploadBucket()
+	var photos [][]byte
+	for _, ref := range refs {
+		if !pendinginput.IsUploadFor(ref, userID, pendingInputID) {
+			slog.Warn("photo-geotag: ignoring photo not uploaded for this input", "ref", ref)
+			continue
+		}
+		data, err := p.service.Store.Get(ctx, bucketName, ref)
+		if err != nil {
+			if errors.Is(err, storage.ErrObjectNotExist) {
+				slog.Warn("photo-geotag: uploaded photo not found", "ref", ref)
+				continue
+			}
+			return nil, fmt.Errorf("failed to read uploaded photo: %w", err)
+		}
+		photos = append(photos, data)
+	}
+	return photos, nil
+}
+
+// deleteUploads removes the uploaded originals, which still carry their
+// metadata. Any left behind are removed by the bucket's lifecycle rule.
+func (p *PhotoGeotagProvider) deleteUploads(ctx context.Context, userID, pendingInputID string, refs []string) {
+	bucketName := p.uploadBucket()
+	for _, ref := range refs {
+		if !pendinginput.IsUploadFor(ref, userID, pendingInputID) {
+			continue
+		}
+		if err := p.service.Store.Delete(ctx, bucketName, ref); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			slog.Warn("photo-geotag: failed to delete uploaded photo", "ref", ref, "error", err)
+		}
+	}
+}
+
+// uploadBucket is the private artifacts bucket pending input uploads go to.
+func (p *PhotoGeotagProvider) uploadBucket() string {
+	if p.service.Config != nil && p.service.Config.GCSArtifactBucket != "" {
+		return p.service.Config.GCSArtifactBucket
+	}
+	return "fitglue-server-dev-artifacts" // Fallback for local development
+}
+
+// assetURL uses the custom assets domain when configured, otherwise the raw GCS URL.
+func assetURL(bucketName, objectPath string) string {
+	if base := os.Getenv("ASSETS_BASE_URL"); base != "" {
+		return fmt.Sprintf("%s/%s", base, objectPath)
+	}
+	return fmt.Sprintf("https://storage.googleapis.com/%s/%s", bucketName, objectPath)
+}
+
+// trackPoint is a timed record, with its position when it has one.
+type trackPoint struct {
+	t           time.Time
+	lat, long   float64
+	hasPosition bool
+}
+
+func buildTrack(activity *pbactivity.StandardizedActivity) []trackPoint {
+	var track []trackPoint
+	for _, session := range activity.Sessions {
+		for _, lap := range session.Laps {
+			for _, record := range lap.Records {
+				if record.Timestamp == nil {
+					continue
+				}
+				track = append(track, trackPoint{
+					t:           record.Timestamp.AsTime(),
+					lat:         record.PositionLat,
+					long:        record.PositionLong,
+					hasPosition: record.PositionLat != 0 || record.PositionLong != 0,
+				})
+			}
+		}
+	}
+	sort.SliceStable(track, func(i, j int) bool { return track[i].t.Before(track[j].t) })
+	return track
+}
+
+type photoMatch struct {
+	timestamp   time.Time
+	lat, long   float64
+	hasPosition bool
+	method      string // "time" or "gps"
+	data        []byte
+}
+
+// matchPhoto places a photo on the track. A capture time within the activity
+// (plus tolerance) wins, since it's what the camera recorded most reliably;
+// otherwise a GPS-tagged photo is placed at the nearest point of the route
+// within opts.maxDistance.
+func matchPhoto(track []trackPoint, ex *photoExif, opts options) (photoMatch, bool) {
+	if len(track) == 0 {
+		return photoMatch{}, false
+	}
+	first, last := track[0].t, track[len(track)-1].t
+
+	if t, ok := ex.captureTime(opts.location); ok {
+		t = t.Add(opts.offset)
+		if !t.Before(first.Add(-timeTolerance)) && !t.After(last.Add(timeTolerance)) {
+			// Keep the marker on the activity even when taken just outside it
+			if t.Before(first) {
+				t = first
+			} else if t.After(last) {
+				t = last
+			}
+			m := photoMatch{timestamp: t, method: "time"}
+			if ex.hasGPS {
+				m.lat, m.long, m.hasPosition = ex.lat, ex.long, true
+			} else if pt := nearestByTime(track, t); pt.hasPosition {
+				m.lat, m.long, m.hasPosition = pt.lat, pt.long, true
+			}
+			return m, true
+		}
+	}
+
+	if ex.hasGPS {
+		pt, dist, ok := nearestByDistance(track, ex.lat, ex.long)
+		if ok && dist <= opts.maxDistance {
+			return photoMatch{timestamp: pt.t, lat: ex.lat, long: ex.long, hasPosition: true, method: "gps"}, true
+		}
+	}
+	return photoMatch{}, false
+}
+
+func nearestByTime(track []trackPoint, t time.Time) trackPoint {
+	i := sort.Search(len(track), func(i int) bool { return !track[i].t.Before(t) })
+	if i == len(track) {
+		return track[len(track)-1]
+	}
+	if i > 0 && t.Sub(track[i-1].t) < track[i].t.Sub(t) {
+		return track[i-1]
+	}
+	return track[i]
+}
+
+func nearestByDistance(track []trackPoint, lat, long float64) (trackPoint, float64, bool) {
+	var best trackPoint
+	bestDist := math.MaxFloat64
+	for _, pt := range track {
+		if !pt.hasPosition {
+			continue
+		}
+		if d := haversineMeters(lat, long, pt.lat, pt.long); d < bestDist {
+			best, bestDist = pt, d
+		}
+	}
+	return best, bestDist, bestDist < math.MaxFloat64
+}
+
+func haversineMeters(lat1, long1, lat2, long2 float64) float64 {
+	const earthRadius = 6371000.0
+	dLat := (lat2 - lat1) * math.Pi / 180
+	dLong := (long2 - long1) * math.Pi / 180
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*math.Pi/180)*math.Cos(lat2*math.Pi/180)*math.Sin(dLong/2)*math.Sin(dLong/2)
+	return earthRadius * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
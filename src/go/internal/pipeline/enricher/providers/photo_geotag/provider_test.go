@@ -0,0 +1,497 @@
+package photo_geotag
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/user_input"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	pendinginput "github.com/fitglue/server/src/go/pkg/pending_input"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+var activityStart = time.Date(2026, 5, 10, 8, 0, 0, 0, time.UTC)
+
+// testPhoto describes the EXIF data written by buildJPEG.
+type testPhoto struct {
+	dateTimeOriginal string // "2006:01:02 15:04:05"
+	offsetTime       string // "+01:00"
+	gps              bool
+	lat, long        float64
+	gpsTime          time.Time
+	orientation      uint16
+}
+
+// buildJPEG writes a minimal big-endian EXIF JPEG carrying p's fields.
+func buildJPEG(t *testing.T, p testPhoto) []byte {
+	t.Helper()
+
+	type entry struct {
+		tag, typ uint16
+		count    uint32
+		data     []byte
+	}
+	ascii := func(s string) []byte { return append([]byte(s), 0) }
+	rationals := func(vals ...float64) []byte {
+		var b []byte
+		for _, v := range vals {
+			b = binary.BigEndian.AppendUint32(b, uint32(math.Round(v*10000)))
+			b = binary.BigEndian.AppendUint32(b, 10000)
+		}
+		return b
+	}
+	dms := func(v float64) []byte {
+		v = math.Abs(v)
+		d := math.Floor(v)
+		m := math.Floor((v - d) * 60)
+		return rationals(d, m, ((v-d)*60-m)*60)
+	}
+
+	var ifd0, exifIFD, gpsIFD []entry
+	if p.dateTimeOriginal != "" {
+		exifIFD = append(exifIFD, entry{tagDateTimeOriginal, typeASCII, 20, ascii(p.dateTimeOriginal)})
+	}
+	if p.offsetTime != "" {
+		exifIFD = append(exifIFD, entry{tagOffsetTimeOriginal, typeASCII, 7, ascii(p.offsetTime)})
+	}
+	if p.gps {
+		latRef, longRef := "N", "E"
+		if p.lat < 0 {
+			latRef = "S"
+		}
+		if p.long < 0 {
+			longRef = "W"
+		}
+		gpsIFD = append(gpsIFD,
+			entry{tagGPSLatitudeRef, typeASCII, 2, ascii(latRef)},
+			entry{tagGPSLatitude, typeRational, 3, dms(p.lat)},
+			entry{tagGPSLongitudeRef, typeASCII, 2, ascii(longRef)},
+			entry{tagGPSLongitude, typeRational, 3, dms(p.long)},
+		)
+	}
+	if !p.gpsTime.IsZero() {
+		g := p.gpsTime
+		gpsIFD = append(gpsIFD,
+			entry{tagGPSTimeStamp, typeRational, 3, rationals(float64(g.Hour()), float64(g.Minute()), float64(g.Second()))},
+			entry{tagGPSDateStamp, typeASCII, 11, ascii(g.Format("2006:01:02"))},
+		)
+	}
+
+	// Layout: header, IFD0, Exif IFD, GPS IFD, then out-of-line values
+	ifdSize := func(entries []entry) int { return 2 + len(entries)*12 + 4 }
+	ifd0Count := 0
+	if p.orientation != 0 {
+		ifd0Count++
+	}
+	if len(exifIFD) > 0 {
+		ifd0Count++
+	}
+	if len(gpsIFD) > 0 {
+		ifd0Count++
+	}
+	ifd0Offset := 8
+	exifOffset := ifd0Offset + 2 + ifd0Count*12 + 4
+	gpsOffset := exifOffset + ifdSize(exifIFD)
+	dataOffset := gpsOffset + ifdSize(gpsIFD)
+	if p.orientation != 0 {
+		ifd0 = append(ifd0, entry{tagOrientation, typeShort, 1, binary.BigEndian.AppendUint16(nil, p.orientation)})
+	}
+	if len(exifIFD) > 0 {
+		ifd0 = append(ifd0, entry{tagExifIFD, typeLong, 1, binary.BigEndian.AppendUint32(nil, uint32(exifOffset))})
+	}
+	if len(gpsIFD) > 0 {
+		ifd0 = append(ifd0, entry{tagGPSIFD, typeLong, 1, binary.BigEndian.AppendUint32(nil, uint32(gpsOffset))})
+	}
+
+	tiff := []byte("MM\x00\x2a")
+	tiff = binary.BigEndian.AppendUint32(tiff, uint32(ifd0Offset))
+	var extra []byte
+	writeIFD := func(entries []entry) {
+		tiff = binary.BigEndian.AppendUint16(tiff, uint16(len(entries)))
+		for _, e := range entries {
+			tiff = binary.BigEndian.AppendUint16(tiff, e.tag)
+			tiff = binary.BigEndian.AppendUint16(tiff, e.typ)
+			tiff = binary.BigEndian.AppendUint32(tiff, e.count)
+			if len(e.data) <= 4 {
+				tiff = append(tiff, append(e.data, make([]byte, 4-len(e.data))...)...)
+			} else {
+				tiff = binary.BigEndian.AppendUint32(tiff, uint32(dataOffset+len(extra)))
+				extra = append(extra, e.data...)
+			}
+		}
+		tiff = binary.BigEndian.AppendUint32(tiff, 0)
+	}
+	writeIFD(ifd0)
+	writeIFD(exifIFD)
+	writeIFD(gpsIFD)
+	tiff = append(tiff, extra...)
+
+	app1 := append([]byte("Exif\x00\x00"), tiff...)
+	var buf bytes.Buffer
+	buf.Write([]byte{0xFF, 0xD8, 0xFF, 0xE1})
+	binary.Write(&buf, binary.BigEndian, uint16(len(app1)+2))
+	buf.Write(app1)
+	buf.Write([]byte{0xFF, 0xFE, 0x00, 0x06})
+	buf.WriteString("iPho") // Comment
+	buf.Write([]byte{0xFF, 0xDA, 0x00, 0x02, 0xFF, 0xD9})
+	return buf.Bytes()
+}
+
+// routeActivity builds a one-hour activity heading north one record per
+// second, moving roughly 2.8m per record.
+func routeActivity() *pbactivity.StandardizedActivity {
+	var records []*pbactivity.Record
+	for sec := 0; sec <= 3600; sec++ {
+		records = append(records, &pbactivity.Record{
+			Timestamp:    timestamppb.New(activityStart.Add(time.Duration(sec) * time.Second)),
+			PositionLat:  51.5 + float64(sec)*0.000025,
+			PositionLong: -0.1,
+		})
+	}
+	return &pbactivity.StandardizedActivity{
+		Source:     pbactivity.ActivitySource_SOURCE_STRAVA,
+		ExternalId: "strava-123",
+		StartTime:  timestamppb.New(activityStart),
+		Sessions: []*pbactivity.Session{{
+			StartTime:        timestamppb.New(activityStart),
+			TotalElapsedTime: 3600,
+			Laps:             []*pbactivity.Lap{{StartTime: timestamppb.New(activityStart), Records: records}},
+		}},
+	}
+}
+
+const testPendingInputID = "SOURCE_STRAVA:strava-123:photo-geotag"
+
+// uploadPhotos stores photos in blobs as uploads for the test pending input
+// and returns the submitted field.
+func uploadPhotos(blobs map[string][]byte, photos ...[]byte) string {
+	var refs []string
+	for i, p := range photos {
+		ref := fmt.Sprintf("%s%d.jpg", pendinginput.UploadPrefix("user-1", testPendingInputID), i)
+		blobs[ref] = p
+		refs = append(refs, ref)
+	}
+	return strings.Join(refs, "\n")
+}
+
+func testUser() *user.Record {
+	return &user.Record{UserProfile: &pbuser.UserProfile{UserId: "user-1"}}
+}
+
+func TestParseJPEGExif(t *testing.T) {
+	gpsTime := time.Date(2026, 5, 10, 8, 15, 30, 0, time.UTC)
+	ex, err := parseJPEGExif(buildJPEG(t, testPhoto{
+		dateTimeOriginal: "2026:05:10 10:15:30",
+		offsetTime:       "+02:00",
+		gps:              true,
+		lat:              -33.8568,
+		long:             151.2153,
+		gpsTime:          gpsTime,
+	}))
+	if err != nil {
+		t.Fatalf("parseJPEGExif failed: %v", err)
+	}
+	if !ex.hasGPS || math.Abs(ex.lat+33.8568) > 1e-6 || math.Abs(ex.long-151.2153) > 1e-6 {
+		t.Errorf("Unexpected position: hasGPS=%v lat=%f long=%f", ex.hasGPS, ex.lat, ex.long)
+	}
+	if !ex.gpsTime.Equal(gpsTime) {
+		t.Errorf("gpsTime = %v, want %v", ex.gpsTime, gpsTime)
+	}
+	if ex.utcOffset == nil || *ex.utcOffset != 2*time.Hour {
+		t.Errorf("Unexpected UTC offset %v", ex.utcOffset)
+	}
+
+	if _, err := parseJPEGExif([]byte("not a jpeg")); err == nil {
+		t.Error("Expected error for non-JPEG data")
+	}
+	if _, err := parseJPEGExif([]byte{0xFF, 0xD8, 0xFF, 0xDA, 0x00, 0x02}); !errors.Is(err, errNoExif) {
+		t.Errorf("Expected errNoExif, got %v", err)
+	}
+}
+
+func TestCaptureTime(t *testing.T) {
+	london, _ := time.LoadLocation("Europe/London")
+	wallClock := time.Date(2026, 5, 10, 9, 30, 0, 0, time.UTC)
+	offset := 2 * time.Hour
+
+	tests := []struct {
+		name string
+		ex   photoExif
+		want time.Time
+	}{
+		{"GPS time preferred", photoExif{localTime: wallClock, gpsTime: activityStart}, activityStart},
+		{"recorded offset", photoExif{localTime: wallClock, utcOffset: &offset}, wallClock.Add(-2 * time.Hour)},
+		{"configured timezone", photoExif{localTime: wallClock}, wallClock.Add(-time.Hour)}, // BST
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.ex.captureTime(london)
+			if !ok || !got.Equal(tt.want) {
+				t.Errorf("captureTime() = %v, %v; want %v", got, ok, tt.want)
+			}
+		})
+	}
+
+	if _, ok := (&photoExif{}).captureTime(time.UTC); ok {
+		t.Error("Expected no capture time without EXIF times")
+	}
+}
+
+func TestMatchPhoto(t *testing.T) {
+	track := buildTrack(routeActivity())
+	opts := options{location: time.UTC, maxDistance: defaultMaxDistanceMeters}
+
+	tests := []struct {
+		name       string
+		ex         photoExif
+		opts       options
+		wantOK     bool
+		wantMethod string
+		wantTime   time.Time
+	}{
+		{
+			name:       "time inside activity",
+			ex:         photoExif{localTime: activityStart.Add(10 * time.Minute)},
+			wantOK:     true,
+			wantMethod: "time",
+			wantTime:   activityStart.Add(10 * time.Minute),
+		},
+		{
+			name:       "camera offset applied",
+			ex:         photoExif{localTime: activityStart.Add(70 * time.Minute)},
+			opts:       options{location: time.UTC, offset: -30 * time.Minute, maxDistance: defaultMaxDistanceMeters},
+			wantOK:     true,
+			wantMethod: "time",
+			wantTime:   activityStart.Add(40 * time.Minute),
+		},
+		{
+			name:       "just before start is clamped to start",
+			ex:         photoExif{localTime: activityStart.Add(-2 * time.Minute)},
+			wantOK:     true,
+			wantMethod: "time",
+			wantTime:   activityStart,
+		},
+		{
+			name:       "wrong camera time falls back to GPS",
+			ex:         photoExif{localTime: activityStart.Add(-48 * time.Hour), hasGPS: true, lat: 51.5 + 600*0.000025, long: -0.1},
+			wantOK:     true,
+			wantMethod: "gps",
+			wantTime:   activityStart.Add(600 * time.Second),
+		},
+		{
+			name:   "GPS too far from route",
+			ex:     photoExif{hasGPS: true, lat: 51.6, long: -0.1},
+			wantOK: false,
+		},
+		{
+			name:   "time outside activity without GPS",
+			ex:     photoExif{localTime: activityStart.Add(3 * time.Hour)},
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := opts
+			if tt.opts.location != nil {
+				o = tt.opts
+			}
+			m, ok := matchPhoto(track, &tt.ex, o)
+			if ok != tt.wantOK {
+				t.Fatalf("matchPhoto() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if m.method != tt.wantMethod || !m.timestamp.Equal(tt.wantTime) {
+				t.Errorf("matchPhoto() = %s at %v, want %s at %v", m.method, m.timestamp, tt.wantMethod, tt.wantTime)
+			}
+			if !m.hasPosition {
+				t.Error("Expected matched photo to have a position")
+			}
+		})
+	}
+}
+
+func TestPhotoGeotag_Enrich_RequestsPhotos(t *testing.T) {
+	p := NewPhotoGeotagProvider()
+	p.SetService(&bootstrap.Service{DB: &mocks.MockDatabase{
+		GetPendingInputFunc: func(ctx context.Context, userId string, id string) (*pbpipeline.PendingInput, error) {
+			return nil, errors.New("not found")
+		},
+	}})
+
+	_, err := p.Enrich(context.Background(), slog.Default(), routeActivity(), testUser(), map[string]string{
+		"activity_id":           "act-1",
+		"pipeline_id":           "pipe-1",
+		"pipeline_execution_id": "exec-1",
+		"camera_timezone":       "Europe/London",
+	}, false)
+
+	var waitErr *user_input.WaitForInputError
+	if !errors.As(err, &waitErr) {
+		t.Fatalf("Expected WaitForInputError, got %v", err)
+	}
+	if len(waitErr.RequiredFields) != 1 || waitErr.RequiredFields[0] != photosField {
+		t.Errorf("Unexpected required fields %v", waitErr.RequiredFields)
+	}
+	if waitErr.Metadata["pipeline_execution_id"] != "exec-1" || waitErr.Metadata["camera_timezone"] != "Europe/London" {
+		t.Errorf("Expected matching settings carried in metadata, got %v", waitErr.Metadata)
+	}
+}
+
+func TestPhotoGeotag_Enrich_Skips(t *testing.T) {
+	p := NewPhotoGeotagProvider()
+
+	res, err := p.Enrich(context.Background(), slog.Default(), routeActivity(), testUser(), map[string]string{"camera_timezone": "Mars/Olympus"}, false)
+	if err != nil || res.Metadata["reason"] != "invalid_config" {
+		t.Errorf("Expected invalid_config skip, got %v, %v", res, err)
+	}
+
+	res, err = p.Enrich(context.Background(), slog.Default(), &pbactivity.StandardizedActivity{}, testUser(), map[string]string{}, false)
+	if err != nil || res.Metadata["reason"] != "no_records" {
+		t.Errorf("Expected no_records skip, got %v, %v", res, err)
+	}
+}
+
+func TestPhotoGeotag_EnrichResume(t *testing.T) {
+	t.Setenv("ASSETS_BASE_URL", "https://assets.example.com")
+
+	uploads := map[string][]byte{}
+	written := map[string][]byte{}
+	p := NewPhotoGeotagProvider()
+	p.SetService(&bootstrap.Service{Store: &mocks.MockBlobStore{
+		GetFunc: func(ctx context.Context, bucket, object string) ([]byte, error) {
+			data, ok := uploads[object]
+			if !ok {
+				return nil, storage.ErrObjectNotExist
+			}
+			return data, nil
+		},
+		WriteFunc: func(ctx context.Context, bucket, object string, data []byte) error {
+			written[object] = data
+			return nil
+		},
+		DeleteFunc: func(ctx context.Context, bucket, object string) error {
+			delete(uploads, object)
+			return nil
+		},
+	}})
+
+	late := buildJPEG(t, testPhoto{dateTimeOriginal: "2026:05:10 09:40:00", offsetTime: "+01:00"})                     // 08:40 UTC
+	early := buildJPEG(t, testPhoto{gpsTime: activityStart.Add(5 * time.Minute), gps: true, lat: 51.5075, long: -0.1}) // 08:05 UTC
+	unplaced := buildJPEG(t, testPhoto{dateTimeOriginal: "2025:01:01 12:00:00"})
+	field := uploadPhotos(uploads, late, early, unplaced, []byte("not a jpeg"))
+
+	// Paths that weren't uploaded for this input are never read
+	uploads["pending_uploads/user-2/other/secret.jpg"] = early
+	field += "\npending_uploads/user-2/other/secret.jpg"
+
+	res, err := p.EnrichResume(context.Background(), routeActivity(), testUser(), &pbpipeline.PendingInput{
+		InputData:        map[string]string{photosField: field},
+		ProviderMetadata: map[string]string{"pipeline_execution_id": "exec-1"},
+	})
+	if err != nil {
+		t.Fatalf("EnrichResume failed: %v", err)
+	}
+
+	if res.Metadata["photos_received"] != "5" || res.Metadata["photos_matched"] != "2" || res.Metadata["photos_unmatched"] != "3" {
+		t.Errorf("Unexpected counts: %v", res.Metadata)
+	}
+	if len(res.TimeMarkers) != 2 {
+		t.Fatalf("Expected 2 markers, got %d", len(res.TimeMarkers))
+	}
+
+	// Numbered in capture order, not upload order
+	first, second := res.TimeMarkers[0], res.TimeMarkers[1]
+	if !first.Timestamp.AsTime().Equal(activityStart.Add(5*time.Minute)) || first.Label != "Photo 1" {
+		t.Errorf("Unexpected first marker %v", first)
+	}
+	if !second.Timestamp.AsTime().Equal(activityStart.Add(40*time.Minute)) || second.Label != "Photo 2" {
+		t.Errorf("Unexpected second marker %v", second)
+	}
+	if first.MarkerType != MarkerType || first.AssetUrl != "https://assets.example.com/exec-1/photo-1.jpg" {
+		t.Errorf("Unexpected marker asset %q (%s)", first.AssetUrl, first.MarkerType)
+	}
+	if first.GetPositionLat() != 51.5075 || second.PositionLat == nil {
+		t.Errorf("Expected marker positions, got %v and %v", first.PositionLat, second.PositionLat)
+	}
+	if res.Metadata["asset_photo_2"] != "https://assets.example.com/exec-1/photo-2.jpg" {
+		t.Errorf("Unexpected asset metadata %v", res.Metadata)
+	}
+
+	// Published without their EXIF
+	if len(written) != 2 {
+		t.Fatalf("Expected 2 stored photos, got %d", len(written))
+	}
+	for name, data := range written {
+		if _, err := parseJPEGExif(data); !errors.Is(err, errNoExif) {
+			t.Errorf("Expected %s stored without EXIF, got %v", name, err)
+		}
+	}
+
+	// The originals are deleted, and the other user's file left alone
+	if len(uploads) != 1 {
+		t.Errorf("Expected uploads deleted, %d left", len(uploads))
+	}
+}
+
+func TestStripJPEGMetadata(t *testing.T) {
+	photo := buildJPEG(t, testPhoto{dateTimeOriginal: "2026:05:10 09:40:00", gps: true, lat: 51.5, long: -0.1, orientation: 6})
+
+	stripped, err := stripJPEGMetadata(photo)
+	if err != nil {
+		t.Fatalf("stripJPEGMetadata failed: %v", err)
+	}
+	ex, err := parseJPEGExif(stripped)
+	if err != nil {
+		t.Fatalf("Expected orientation EXIF kept, got %v", err)
+	}
+	if ex.hasGPS || !ex.localTime.IsZero() || ex.orientation != 6 {
+		t.Errorf("Expected only orientation kept, got %+v", ex)
+	}
+	if bytes.Contains(stripped, []byte("iPho")) {
+		t.Error("Expected comment removed")
+	}
+	if !bytes.HasSuffix(stripped, []byte{0xFF, 0xDA, 0x00, 0x02, 0xFF, 0xD9}) {
+		t.Error("Expected image data copied unchanged")
+	}
+
+	// Upright photos need no EXIF at all
+	stripped, err = stripJPEGMetadata(buildJPEG(t, testPhoto{gps: true, lat: 51.5, long: -0.1}))
+	if err != nil {
+		t.Fatalf("stripJPEGMetadata failed: %v", err)
+	}
+	if _, err := parseJPEGExif(stripped); !errors.Is(err, errNoExif) {
+		t.Errorf("Expected no EXIF, got %v", err)
+	}
+
+	if _, err := stripJPEGMetadata([]byte("not a jpeg")); err == nil {
+		t.Error("Expected error for non-JPEG data")
+	}
+}
+
+func TestPhotoGeotag_EnrichResume_NoPhotos(t *testing.T) {
+	res, err := NewPhotoGeotagProvider().EnrichResume(context.Background(), routeActivity(), testUser(), &pbpipeline.PendingInput{})
+	if err != nil {
+		t.Fatalf("EnrichResume failed: %v", err)
+	}
+	if res.Metadata["photo_geotag_status"] != "skipped" || res.Metadata["reason"] != "no_photos" {
+		t.Errorf("Expected no_photos skip, got %v", res.Metadata)
+	}
+}
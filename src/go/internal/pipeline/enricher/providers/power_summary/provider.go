@@ -3,6 +3,7 @@ package power_summary
 import (
 	"context"
 	"fmt"
+	"github.com/fitglue/server/src/go/pkg/description"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
 	"log/slog"
 	"strings"
@@ -96,7 +97,7 @@ func (p *PowerSummary) Enrich(ctx context.Context, logger *slog.Logger, activity
 
 	if showCurve && len(powers) >= 5 {
 		// Multi-line bullet format with power curve
-		sb.WriteString("⚡ Power:\n")
+		sb.WriteString(providers.SectionHeader(user, description.SectionPower) + "\n")
 		sb.WriteString(fmt.Sprintf("• %.0fW avg\n", avgPower))
 		sb.WriteString(fmt.Sprintf("• %dW max\n", maxPower))
 
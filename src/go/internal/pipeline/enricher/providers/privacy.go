@@ -0,0 +1,156 @@
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+// Privacy classes of activity data that AI enrichers scrub before calling an
+// external model, unless the user's ai_privacy preferences opt in.
+const (
+	PrivacyClassNames     = "names"     // activity title, the user's name and email
+	PrivacyClassLocations = "locations" // precise coordinates
+	PrivacyClassNotes     = "notes"     // activity notes and description
+)
+
+// AIScrubPolicyVersion identifies the scrubbing rules below. Bump it whenever
+// what a class removes changes, so recorded policies stay auditable.
+const AIScrubPolicyVersion = "2"
+
+// MetadataAIScrubPolicy is the provider metadata key recording the policy
+// applied to a request, e.g. "v2 names=scrubbed locations=scrubbed notes=shared".
+const MetadataAIScrubPolicy = "ai_scrub_policy"
+
+// InputLocationSections is set alongside enriched_description for deferred
+// providers: a JSON array of the description sections written by
+// LocationRevealingProviders.
+const InputLocationSections = "enriched_description_location_sections"
+
+const (
+	redactedName     = "[name]"
+	redactedLocation = "[location]"
+	redactedNote     = "[note]"
+)
+
+// coordinatePattern matches a decimal latitude/longitude pair precise enough
+// to place someone, e.g. "51.4101, -0.3372".
+var coordinatePattern = regexp.MustCompile(`-?\d{1,3}\.\d{3,}\s*,\s*-?\d{1,3}\.\d{3,}`)
+
+// AIScrubber removes the privacy classes a user hasn't opted in to from the
+// activity context sent to an external AI model.
+type AIScrubber struct {
+	shareNames     bool
+	shareLocations bool
+	shareNotes     bool
+
+	// Strings from the user and activity to redact from free text, such as
+	// the enriched description.
+	names     []string
+	notes     []string
+	locations []string
+}
+
+// NewAIScrubber builds the scrubber for an activity from the user's
+// ai_privacy preferences and the provider's inputs. Every class is scrubbed
+// by default.
+func NewAIScrubber(u *user.Record, activity *pbactivity.StandardizedActivity, inputs map[string]string) *AIScrubber {
+	s := &AIScrubber{}
+	if u != nil && u.UserProfile != nil {
+		prefs := u.GetAiPrivacy()
+		s.shareNames = prefs.GetShareNames()
+		s.shareLocations = prefs.GetShareLocations()
+		s.shareNotes = prefs.GetShareNotes()
+		s.names = append(s.names, u.GetDisplayName(), u.GetEmail())
+	}
+	s.names = append(s.names, activity.GetName())
+	s.notes = append(s.notes, activity.GetNotes(), activity.GetDescription())
+	if raw := inputs[InputLocationSections]; raw != "" {
+		// A malformed list leaves only coordinates to redact
+		_ = json.Unmarshal([]byte(raw), &s.locations)
+	}
+	return s
+}
+
+// Activity returns a copy of activity with the scrubbed classes cleared. The
+// original is left untouched for the rest of the pipeline.
+func (s *AIScrubber) Activity(activity *pbactivity.StandardizedActivity) *pbactivity.StandardizedActivity {
+	if activity == nil {
+		return nil
+	}
+	scrubbed := proto.Clone(activity).(*pbactivity.StandardizedActivity)
+	if !s.shareNames {
+		scrubbed.Name = ""
+	}
+	if !s.shareNotes {
+		scrubbed.Notes = ""
+		scrubbed.Description = ""
+	}
+	if !s.shareLocations {
+		for _, session := range scrubbed.Sessions {
+			for _, lap := range session.Laps {
+				for _, record := range lap.Records {
+					record.PositionLat = 0
+					record.PositionLong = 0
+				}
+			}
+		}
+		for _, marker := range scrubbed.TimeMarkers {
+			marker.PositionLat = nil
+			marker.PositionLong = nil
+		}
+	}
+	return scrubbed
+}
+
+// Text redacts the scrubbed classes from free text built by other enrichers:
+// the user's and activity's names, the activity's own notes, sections from
+// location-revealing enrichers, and coordinates.
+func (s *AIScrubber) Text(text string) string {
+	if !s.shareNotes {
+		text = redact(text, s.notes, redactedNote)
+	}
+	if !s.shareNames {
+		text = redact(text, s.names, redactedName)
+	}
+	if !s.shareLocations {
+		text = redact(text, s.locations, redactedLocation)
+		text = coordinatePattern.ReplaceAllString(text, redactedLocation)
+	}
+	return text
+}
+
+// Policy describes the classes shared and scrubbed, for MetadataAIScrubPolicy.
+func (s *AIScrubber) Policy() string {
+	return fmt.Sprintf("v%s %s=%s %s=%s %s=%s", AIScrubPolicyVersion,
+		PrivacyClassNames, policyState(s.shareNames),
+		PrivacyClassLocations, policyState(s.shareLocations),
+		PrivacyClassNotes, policyState(s.shareNotes))
+}
+
+func policyState(shared bool) string {
+	if shared {
+		return "shared"
+	}
+	return "scrubbed"
+}
+
+// redact replaces each term in text, ignoring case. Terms shorter than three
+// characters are skipped, as they would match inside ordinary words.
+func redact(text string, terms []string, replacement string) string {
+	for _, term := range terms {
+		term = strings.TrimSpace(term)
+		if len(term) < 3 {
+			continue
+		}
+		re := regexp.MustCompile(`(?i)` + regexp.QuoteMeta(term))
+		text = re.ReplaceAllString(text, replacement)
+	}
+	return text
+}
@@ -0,0 +1,140 @@
+package providers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+func privacyTestActivity() *pbactivity.StandardizedActivity {
+	lat, long := 51.4101, -0.3372
+	return &pbactivity.StandardizedActivity{
+		Name:        "Bushy Park loop with Sam",
+		Notes:       "Knee felt sore after the hill",
+		Description: "Morning run before work",
+		Sessions: []*pbactivity.Session{{
+			TotalDistance: 5000,
+			Laps: []*pbactivity.Lap{{
+				Records: []*pbactivity.Record{{HeartRate: 150, PositionLat: lat, PositionLong: long}},
+			}},
+		}},
+		TimeMarkers: []*pbactivity.TimeMarker{{Label: "Photo", PositionLat: &lat, PositionLong: &long}},
+	}
+}
+
+func privacyTestUser(prefs *pbuser.AIPrivacyPreferences) *user.Record {
+	return &user.Record{UserProfile: &pbuser.UserProfile{
+		UserId:      "user-1",
+		DisplayName: "Alex Runner",
+		Email:       "alex@example.com",
+		AiPrivacy:   prefs,
+	}}
+}
+
+func TestAIScrubber_ScrubsByDefault(t *testing.T) {
+	activity := privacyTestActivity()
+	s := NewAIScrubber(privacyTestUser(nil), activity, nil)
+
+	scrubbed := s.Activity(activity)
+	if scrubbed.Name != "" || scrubbed.Notes != "" || scrubbed.Description != "" {
+		t.Errorf("expected name and notes scrubbed, got name=%q notes=%q description=%q", scrubbed.Name, scrubbed.Notes, scrubbed.Description)
+	}
+	record := scrubbed.Sessions[0].Laps[0].Records[0]
+	if record.PositionLat != 0 || record.PositionLong != 0 {
+		t.Errorf("expected record coordinates scrubbed, got %v,%v", record.PositionLat, record.PositionLong)
+	}
+	if record.HeartRate != 150 {
+		t.Errorf("expected heart rate kept, got %d", record.HeartRate)
+	}
+	if scrubbed.TimeMarkers[0].PositionLat != nil || scrubbed.TimeMarkers[0].Label != "Photo" {
+		t.Errorf("expected marker position scrubbed and label kept, got %+v", scrubbed.TimeMarkers[0])
+	}
+
+	// The pipeline's own activity is untouched
+	if activity.Name == "" || activity.Sessions[0].Laps[0].Records[0].PositionLat == 0 {
+		t.Error("scrubbing modified the original activity")
+	}
+
+	if got, want := s.Policy(), "v2 names=scrubbed locations=scrubbed notes=scrubbed"; got != want {
+		t.Errorf("Policy() = %q, want %q", got, want)
+	}
+}
+
+func TestAIScrubber_Text(t *testing.T) {
+	activity := privacyTestActivity()
+	text := "🏃 Bushy Park loop with Sam by ALEX RUNNER (alex@example.com)\n" +
+		"📍 Start: 51.41012, -0.33721\n" +
+		"📝 Knee felt sore after the hill"
+
+	got := NewAIScrubber(privacyTestUser(nil), activity, nil).Text(text)
+	for _, leaked := range []string{"Bushy Park loop", "ALEX RUNNER", "alex@example.com", "51.41012", "Knee felt sore"} {
+		if strings.Contains(got, leaked) {
+			t.Errorf("scrubbed text still contains %q:\n%s", leaked, got)
+		}
+	}
+	for _, want := range []string{"[name]", "[location]", "[note]"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("scrubbed text missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestAIScrubber_TextLocationSections(t *testing.T) {
+	activity := privacyTestActivity()
+	location := "📍 Location: Richmond Park, London"
+	text := "🌤️ Weather: 12°C, Cloudy\n\n" + location
+	inputs := map[string]string{InputLocationSections: `["📍 Location: Richmond Park, London"]`}
+
+	got := NewAIScrubber(privacyTestUser(nil), activity, inputs).Text(text)
+	if want := "🌤️ Weather: 12°C, Cloudy\n\n[location]"; got != want {
+		t.Errorf("Text() = %q, want %q", got, want)
+	}
+
+	shared := NewAIScrubber(privacyTestUser(&pbuser.AIPrivacyPreferences{ShareLocations: true}), activity, inputs)
+	if got := shared.Text(text); got != text {
+		t.Errorf("Text() = %q, want unchanged when locations are shared", got)
+	}
+}
+
+func TestAIScrubber_OptIns(t *testing.T) {
+	activity := privacyTestActivity()
+	s := NewAIScrubber(privacyTestUser(&pbuser.AIPrivacyPreferences{
+		ShareNames:     true,
+		ShareLocations: true,
+		ShareNotes:     true,
+	}), activity, nil)
+
+	scrubbed := s.Activity(activity)
+	if scrubbed.Name != activity.Name || scrubbed.Notes != activity.Notes {
+		t.Errorf("expected name and notes shared, got name=%q notes=%q", scrubbed.Name, scrubbed.Notes)
+	}
+	if scrubbed.Sessions[0].Laps[0].Records[0].PositionLat == 0 {
+		t.Error("expected coordinates shared")
+	}
+	text := "Alex Runner at 51.41012, -0.33721"
+	if got := s.Text(text); got != text {
+		t.Errorf("Text() = %q, want unchanged", got)
+	}
+	if got, want := s.Policy(), "v2 names=shared locations=shared notes=shared"; got != want {
+		t.Errorf("Policy() = %q, want %q", got, want)
+	}
+}
+
+func TestAIScrubber_PartialOptIn(t *testing.T) {
+	activity := privacyTestActivity()
+	s := NewAIScrubber(privacyTestUser(&pbuser.AIPrivacyPreferences{ShareNames: true}), activity, nil)
+
+	scrubbed := s.Activity(activity)
+	if scrubbed.Name != activity.Name {
+		t.Errorf("expected name shared, got %q", scrubbed.Name)
+	}
+	if scrubbed.Notes != "" {
+		t.Errorf("expected notes scrubbed, got %q", scrubbed.Notes)
+	}
+	if got, want := s.Policy(), "v2 names=shared locations=scrubbed notes=scrubbed"; got != want {
+		t.Errorf("Policy() = %q, want %q", got, want)
+	}
+}
@@ -3,6 +3,7 @@ package recovery_advisor
 import (
 	"context"
 	"fmt"
+	"github.com/fitglue/server/src/go/pkg/description"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
 	"log/slog"
 	"math"
@@ -225,7 +226,7 @@ func (p *RecoveryAdvisor) Enrich(ctx context.Context, logger *slog.Logger, activ
 	// Build output
 	var sb strings.Builder
 
-	sb.WriteString("💤 Recovery Advisor:\n")
+	sb.WriteString(providers.SectionHeader(user, description.SectionRecoveryAdvisor) + "\n")
 	sb.WriteString(fmt.Sprintf("• Session load: %.0f TRIMP (%s)\n", trimp, intensity))
 	sb.WriteString(fmt.Sprintf("• 7-day load: %.0f TRIMP • 28-day avg: %.0f TRIMP\n", totalAcuteLoad, totalChronicLoad))
 
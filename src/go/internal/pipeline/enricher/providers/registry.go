@@ -1,9 +1,12 @@
 package providers
 
 import (
+	"context"
 	"log"
 	"sync"
 
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+
 	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 )
 
@@ -11,6 +14,10 @@ var (
 	registryMu   sync.RWMutex
 	registry     = make(map[string]Provider)
 	typeRegistry = make(map[pbplugin.EnricherProviderType]Provider)
+
+	initMu       sync.Mutex
+	initialized  = make(map[string]bool)
+	initFailures = make(map[string]string)
 )
 
 // Register adds a provider to the registry.
@@ -67,10 +74,56 @@ func GetByType(t pbplugin.EnricherProviderType) (Provider, bool) {
 	return p, ok
 }
 
+// Initialize prepares a provider on first use: it injects the service and calls
+// Init for providers that need external clients. Success is remembered for the
+// lifetime of the instance so warm starts skip it; failures are recorded in the
+// init report and retried on the next call.
+func Initialize(ctx context.Context, p Provider, svc *bootstrap.Service) error {
+	initMu.Lock()
+	defer initMu.Unlock()
+
+	name := p.Name()
+	if initialized[name] {
+		return nil
+	}
+
+	if sp, ok := p.(interface{ SetService(*bootstrap.Service) }); ok {
+		sp.SetService(svc)
+	}
+	if ip, ok := p.(InitializableProvider); ok {
+		if err := ip.Init(ctx, svc); err != nil {
+			initFailures[name] = err.Error()
+			return err
+		}
+	}
+
+	delete(initFailures, name)
+	initialized[name] = true
+	return nil
+}
+
+// InitFailures returns the providers whose most recent initialization failed,
+// keyed by provider name.
+func InitFailures() map[string]string {
+	initMu.Lock()
+	defer initMu.Unlock()
+
+	failures := make(map[string]string, len(initFailures))
+	for name, errMsg := range initFailures {
+		failures[name] = errMsg
+	}
+	return failures
+}
+
 // ClearRegistry removes all providers (useful for tests)
 func ClearRegistry() {
 	registryMu.Lock()
 	defer registryMu.Unlock()
 	registry = make(map[string]Provider)
 	typeRegistry = make(map[pbplugin.EnricherProviderType]Provider)
+
+	initMu.Lock()
+	defer initMu.Unlock()
+	initialized = make(map[string]bool)
+	initFailures = make(map[string]string)
 }
@@ -0,0 +1,83 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+type initCountingProvider struct {
+	initCalls int
+	initErr   error
+	service   *bootstrap.Service
+}
+
+func (p *initCountingProvider) Name() string { return "init-counting" }
+func (p *initCountingProvider) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_UNSPECIFIED
+}
+func (p *initCountingProvider) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*EnrichmentResult, error) {
+	return &EnrichmentResult{}, nil
+}
+func (p *initCountingProvider) SetService(s *bootstrap.Service) { p.service = s }
+func (p *initCountingProvider) Init(ctx context.Context, svc *bootstrap.Service) error {
+	p.initCalls++
+	return p.initErr
+}
+
+func TestInitialize_OncePerInstance(t *testing.T) {
+	ClearRegistry()
+	defer ClearRegistry()
+
+	ctx := context.Background()
+	svc := &bootstrap.Service{}
+	p := &initCountingProvider{}
+
+	for i := 0; i < 3; i++ {
+		if err := Initialize(ctx, p, svc); err != nil {
+			t.Fatalf("Initialize failed: %v", err)
+		}
+	}
+
+	if p.initCalls != 1 {
+		t.Errorf("Expected Init to be called once, got %d", p.initCalls)
+	}
+	if p.service != svc {
+		t.Error("Expected service to be injected")
+	}
+	if len(InitFailures()) != 0 {
+		t.Errorf("Expected no init failures, got %v", InitFailures())
+	}
+}
+
+func TestInitialize_RecordsAndRetriesFailures(t *testing.T) {
+	ClearRegistry()
+	defer ClearRegistry()
+
+	ctx := context.Background()
+	p := &initCountingProvider{initErr: errors.New("client unavailable")}
+
+	if err := Initialize(ctx, p, &bootstrap.Service{}); err == nil {
+		t.Fatal("Expected Initialize to fail")
+	}
+	if got := InitFailures()["init-counting"]; got != "client unavailable" {
+		t.Errorf("Expected init failure to be reported, got %q", got)
+	}
+
+	p.initErr = nil
+	if err := Initialize(ctx, p, &bootstrap.Service{}); err != nil {
+		t.Fatalf("Expected retry to succeed, got %v", err)
+	}
+	if p.initCalls != 2 {
+		t.Errorf("Expected Init to be retried, got %d calls", p.initCalls)
+	}
+	if len(InitFailures()) != 0 {
+		t.Errorf("Expected failure to be cleared after successful retry, got %v", InitFailures())
+	}
+}
@@ -79,6 +79,12 @@ func (p *RouteThumbnailProvider) Enrich(ctx context.Context, logger *slog.Logger
 		return &providers.EnrichmentResult{Skipped: true, SkipReason: "Insufficient GPS data"}, nil
 	}
 
+	quota := providers.CheckQuota(ctx, logger, p.service, user, tier.FeatureMapRender)
+	if !quota.Allowed() {
+		logger.Info("Skipping route thumbnail - monthly map render quota used", "limit", quota.Limit)
+		return quota.ExceededResult(), nil
+	}
+
 	// Simplify the route if too many points (Douglas-Peucker)
 	if len(points) > 200 {
 		points = simplifyRoute(points, 0.0001) // ~10m tolerance
@@ -123,10 +129,12 @@ func (p *RouteThumbnailProvider) Enrich(ctx context.Context, logger *slog.Logger
 
 	logger.Info("Generated route thumbnail", "asset_folder_id", assetFolderID, "url", assetURL, "points", len(points))
 
+	quota.Record(ctx, 1)
+
 	return &providers.EnrichmentResult{
-		Metadata: map[string]string{
+		Metadata: quota.AddMetadata(map[string]string{
 			"asset_route_thumbnail": assetURL,
-		},
+		}),
 	}, nil
 }
 
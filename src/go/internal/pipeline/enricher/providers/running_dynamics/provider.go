@@ -47,6 +47,7 @@ func (p *RunningDynamics) Enrich(ctx context.Context, logger *slog.Logger, activ
 	var vos []int32
 	var vrs []int32
 	var sls []float64
+	var cadences []int32
 
 	for _, session := range activity.Sessions {
 		for _, lap := range session.Laps {
@@ -63,6 +64,9 @@ func (p *RunningDynamics) Enrich(ctx context.Context, logger *slog.Logger, activ
 				if record.StepLength != nil && *record.StepLength > 0 {
 					sls = append(sls, *record.StepLength)
 				}
+				if record.Cadence > 0 {
+					cadences = append(cadences, record.Cadence)
+				}
 			}
 		}
 	}
@@ -80,14 +84,21 @@ func (p *RunningDynamics) Enrich(ctx context.Context, logger *slog.Logger, activ
 
 	// Calculate averages
 	var summaryParts []string
+	metadata := map[string]string{
+		"running_dynamics_status": "success",
+	}
 
 	if len(gcts) > 0 {
-		var sum int64
-		for _, v := range gcts {
-			sum += int64(v)
-		}
-		avg := float64(sum) / float64(len(gcts))
+		avg := averageInt(gcts)
 		summaryParts = append(summaryParts, fmt.Sprintf("⏱️ GCT: %.0f ms", avg))
+		metadata["running_dynamics_gct_avg_ms"] = fmt.Sprintf("%.0f", avg)
+	}
+
+	if len(cadences) > 0 {
+		// Record cadence is per foot (strides per minute); runners quote steps
+		avg := averageInt(cadences) * 2
+		summaryParts = append(summaryParts, fmt.Sprintf("👣 Cadence: %.0f spm", avg))
+		metadata["running_dynamics_cadence_avg_spm"] = fmt.Sprintf("%.0f", avg)
 	}
 
 	if len(sls) > 0 {
@@ -97,19 +108,19 @@ func (p *RunningDynamics) Enrich(ctx context.Context, logger *slog.Logger, activ
 		}
 		avg := sum / float64(len(sls))
 		summaryParts = append(summaryParts, fmt.Sprintf("📏 Stride: %.2f m", avg))
+		metadata["running_dynamics_stride_avg_m"] = fmt.Sprintf("%.2f", avg)
 	}
 
 	if len(vos) > 0 {
-		var sum int64
-		for _, v := range vos {
-			sum += int64(v)
-		}
-		avg := float64(sum) / float64(len(vos))
-		summaryParts = append(summaryParts, fmt.Sprintf("↕️ Vert: %.1f cm", avg/10.0)) // mm to cm
+		avg := averageInt(vos) / 10.0 // mm to cm
+		summaryParts = append(summaryParts, fmt.Sprintf("↕️ Vert: %.1f cm", avg))
+		metadata["running_dynamics_vo_avg_cm"] = fmt.Sprintf("%.1f", avg)
 	}
 
-	if len(summaryParts) == 0 {
-		return &providers.EnrichmentResult{Skipped: true, SkipReason: "No summary parts generated"}, nil
+	if len(vrs) > 0 {
+		avg := averageInt(vrs) / 10.0 // stored in tenths of a percent
+		summaryParts = append(summaryParts, fmt.Sprintf("📐 Ratio: %.1f%%", avg))
+		metadata["running_dynamics_vertical_ratio_avg_pct"] = fmt.Sprintf("%.1f", avg)
 	}
 
 	// Build summary line
@@ -123,8 +134,14 @@ func (p *RunningDynamics) Enrich(ctx context.Context, logger *slog.Logger, activ
 
 	return &providers.EnrichmentResult{
 		Description: summaryText,
-		Metadata: map[string]string{
-			"running_dynamics_status": "success",
-		},
+		Metadata:    metadata,
 	}, nil
 }
+
+func averageInt(values []int32) float64 {
+	var sum int64
+	for _, v := range values {
+		sum += int64(v)
+	}
+	return float64(sum) / float64(len(values))
+}
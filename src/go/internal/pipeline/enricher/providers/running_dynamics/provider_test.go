@@ -85,6 +85,53 @@ func TestRunningDynamics_Enrich_Success(t *testing.T) {
 	}
 }
 
+func TestRunningDynamics_Enrich_CadenceAndRatio(t *testing.T) {
+	provider := NewRunningDynamics()
+	provider.Service = &bootstrap.Service{}
+
+	activity := &pbactivity.StandardizedActivity{
+		Sessions: []*pbactivity.Session{
+			{
+				Laps: []*pbactivity.Lap{
+					{
+						Records: []*pbactivity.Record{
+							{Cadence: 85, GroundContactTime: intPointer(240), VerticalRatio: intPointer(80)},
+							{Cadence: 87, GroundContactTime: intPointer(250), VerticalRatio: intPointer(84)},
+							{Cadence: 0},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := provider.Enrich(context.Background(), slog.Default(), activity, nil, nil, false)
+	if err != nil {
+		t.Fatalf("Enrich failed: %v", err)
+	}
+
+	// Per-foot cadence (85+87)/2 = 86 is doubled to steps per minute
+	for _, part := range []string{"245 ms", "172 spm", "8.2%"} {
+		if !contains(result.Description, part) {
+			t.Errorf("Expected description to contain %q, but got %q", part, result.Description)
+		}
+	}
+	if contains(result.Description, "Stride") || contains(result.Description, "Vert:") {
+		t.Errorf("Expected metrics without samples to be omitted, got %q", result.Description)
+	}
+
+	expected := map[string]string{
+		"running_dynamics_gct_avg_ms":             "245",
+		"running_dynamics_cadence_avg_spm":        "172",
+		"running_dynamics_vertical_ratio_avg_pct": "8.2",
+	}
+	for key, want := range expected {
+		if got := result.Metadata[key]; got != want {
+			t.Errorf("Expected metadata %s=%s, got %q", key, want, got)
+		}
+	}
+}
+
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && func() bool {
 		for i := 0; i <= len(s)-len(substr); i++ {
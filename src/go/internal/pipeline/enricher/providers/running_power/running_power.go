@@ -0,0 +1,164 @@
+package running_power
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	activityPkg "github.com/fitglue/server/src/go/pkg/domain/activity"
+	"github.com/fitglue/server/src/go/pkg/domain/streams"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+const (
+	// flatCost is the watts per kg needed for each m/s of flat running, in
+	// line with the footpod power meters most runners compare against.
+	flatCost = 1.04
+
+	// minSpeed is the speed below which the runner counts as stopped.
+	minSpeed = 0.5 // m/s
+)
+
+// RunningPower estimates running power for runs recorded without a power
+// meter. Speed is converted to its flat-ground equivalent using the Minetti
+// et al. (2002) energy cost of running on a gradient, then scaled by weight.
+// The stream is marked as estimated so measured power always wins over it and
+// the FIT file flags it.
+type RunningPower struct {
+	Service *bootstrap.Service
+}
+
+func init() {
+	providers.Register(NewRunningPower())
+}
+
+func NewRunningPower() *RunningPower {
+	return &RunningPower{}
+}
+
+func (p *RunningPower) SetService(service *bootstrap.Service) {
+	p.Service = service
+}
+
+func (p *RunningPower) Name() string {
+	return "running-power"
+}
+
+func (p *RunningPower) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_RUNNING_POWER
+}
+
+// SkipOnSourceUpdate keeps metadata edits from recomputing the power stream.
+func (p *RunningPower) SkipOnSourceUpdate() bool { return true }
+
+func (p *RunningPower) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	logger.Debug("running_power: starting", "activity_name", activity.Name)
+
+	weightKg := 70.0
+	if f, err := strconv.ParseFloat(inputs["weight_kg"], 64); err == nil && f >= 30 && f <= 250 {
+		weightKg = f
+	}
+	showInDescription := inputs["show_in_description"] != "false" // default true
+
+	switch activity.Type {
+	case pbactivity.ActivityType_ACTIVITY_TYPE_RUN, pbactivity.ActivityType_ACTIVITY_TYPE_TRAIL_RUN, pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_RUN:
+	default:
+		return skipped("not_a_run", "Activity is not a run"), nil
+	}
+	if len(activity.Sessions) == 0 {
+		return skipped("no_session", "Activity has no session"), nil
+	}
+
+	session := activity.Sessions[0]
+	var records []*pbactivity.Record
+	hasCadence := false
+	for _, lap := range session.Laps {
+		for _, record := range lap.Records {
+			if record.Timestamp == nil {
+				continue
+			}
+			if record.Power > 0 {
+				return skipped("measured_power", "Activity already has power"), nil
+			}
+			if record.Cadence > 0 {
+				hasCadence = true
+			}
+			records = append(records, record)
+		}
+	}
+
+	// The orchestrator applies streams by second offset from the session start
+	sessionStart := activity.StartTime.AsTime()
+	if session.StartTime != nil {
+		sessionStart = session.StartTime.AsTime()
+	}
+
+	var stream []int
+	var sum float64
+	var count int
+	for i, record := range records {
+		speed := activityPkg.RecordSpeed(records, i)
+		// Cadence drops to zero when the runner stops, even if GPS drift
+		// still reports movement
+		if speed < minSpeed || (hasCadence && record.Cadence == 0) {
+			continue
+		}
+
+		offset := int(record.Timestamp.AsTime().Sub(sessionStart).Seconds())
+		if offset < 0 {
+			continue
+		}
+		watts := int(math.Round(flatCost * weightKg * activityPkg.GradeAdjustedSpeed(speed, activityPkg.Grade(records, i))))
+		if watts <= 0 {
+			continue
+		}
+		for len(stream) <= offset {
+			stream = append(stream, 0)
+		}
+		stream[offset] = watts
+		sum += float64(watts)
+		count++
+	}
+
+	if count == 0 {
+		return skipped("no_pace_data", "No speed or distance data"), nil
+	}
+
+	avg := sum / float64(count)
+	logger.Info("Running power estimated", "avg_watts", avg, "weight_kg", weightKg, "samples", count)
+
+	result := &providers.EnrichmentResult{
+		PowerStream: stream,
+		PowerSensor: streams.SensorEstimated,
+		Metadata: map[string]string{
+			"running_power_status": "success",
+			"avg_power":            fmt.Sprintf("%.0f", avg),
+			"w_per_kg":             fmt.Sprintf("%.1f", avg/weightKg),
+		},
+	}
+	if showInDescription {
+		result.Description = fmt.Sprintf("⚡ Est. Running Power: %.0f W avg • %.1f W/kg", avg, avg/weightKg)
+	}
+	return result, nil
+}
+
+func skipped(reason, detail string) *providers.EnrichmentResult {
+	return &providers.EnrichmentResult{
+		Skipped:    true,
+		SkipReason: detail,
+		Metadata: map[string]string{
+			"running_power_status": "skipped",
+			"reason":               reason,
+			"status_detail":        detail,
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package running_power
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/pkg/domain/streams"
+	user "github.com/fitglue/server/src/go/pkg/domain/user"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+// steadyRun is 10 minutes of one-second records at a fixed speed, climbing
+// at the given grade.
+func steadyRun(speed, grade float64, cadence int32) *pbactivity.StandardizedActivity {
+	start := time.Date(2026, 5, 12, 7, 0, 0, 0, time.UTC)
+	var records []*pbactivity.Record
+	for i := 0; i <= 600; i++ {
+		dist := speed * float64(i)
+		records = append(records, &pbactivity.Record{
+			Timestamp: timestamppb.New(start.Add(time.Duration(i) * time.Second)),
+			Speed:     speed,
+			Distance:  dist,
+			Altitude:  100 + dist*grade,
+			Cadence:   cadence,
+		})
+	}
+	return &pbactivity.StandardizedActivity{
+		Type:      pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		StartTime: timestamppb.New(start),
+		Sessions: []*pbactivity.Session{{
+			StartTime:        timestamppb.New(start),
+			TotalElapsedTime: 600,
+			Laps:             []*pbactivity.Lap{{Records: records}},
+		}},
+	}
+}
+
+func TestRunningPower_Flat(t *testing.T) {
+	res, err := NewRunningPower().Enrich(context.Background(), slog.Default(), steadyRun(3.5, 0, 85), &user.Record{}, map[string]string{}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// 1.04 W/kg per m/s × 70 kg × 3.5 m/s
+	if len(res.PowerStream) != 601 || res.PowerStream[300] != 255 {
+		t.Fatalf("Expected 255W at 1Hz, got %d samples", len(res.PowerStream))
+	}
+	if res.PowerSensor != streams.SensorEstimated {
+		t.Errorf("Expected estimated sensor, got %v", res.PowerSensor)
+	}
+	if res.Description != "⚡ Est. Running Power: 255 W avg • 3.6 W/kg" {
+		t.Errorf("Unexpected description: %q", res.Description)
+	}
+}
+
+func TestRunningPower_Grade(t *testing.T) {
+	flat, _ := NewRunningPower().Enrich(context.Background(), slog.Default(), steadyRun(3, 0, 0), &user.Record{}, map[string]string{"weight_kg": "60"}, false)
+	uphill, _ := NewRunningPower().Enrich(context.Background(), slog.Default(), steadyRun(3, 0.08, 0), &user.Record{}, map[string]string{"weight_kg": "60"}, false)
+
+	// The first 20m has no grade yet, so compare once the window is full
+	if uphill.PowerStream[300] <= flat.PowerStream[300]*3/2 {
+		t.Errorf("Expected 8%% climb to cost over 1.5× flat power, got %d vs %d", uphill.PowerStream[300], flat.PowerStream[300])
+	}
+}
+
+func TestRunningPower_StoppedCadence(t *testing.T) {
+	act := steadyRun(3.5, 0, 85)
+	records := act.Sessions[0].Laps[0].Records
+	for _, r := range records[100:200] {
+		r.Cadence = 0
+	}
+
+	res, err := NewRunningPower().Enrich(context.Background(), slog.Default(), act, &user.Record{}, map[string]string{}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.PowerStream[150] != 0 || res.PowerStream[250] == 0 {
+		t.Errorf("Expected no power while cadence is zero, got %d and %d", res.PowerStream[150], res.PowerStream[250])
+	}
+}
+
+func TestRunningPower_Skips(t *testing.T) {
+	measured := steadyRun(3.5, 0, 85)
+	measured.Sessions[0].Laps[0].Records[10].Power = 250
+
+	ride := steadyRun(8, 0, 85)
+	ride.Type = pbactivity.ActivityType_ACTIVITY_TYPE_RIDE
+
+	stationary := steadyRun(0, 0, 0)
+
+	tests := []struct {
+		name     string
+		activity *pbactivity.StandardizedActivity
+		reason   string
+	}{
+		{"measured power", measured, "measured_power"},
+		{"not a run", ride, "not_a_run"},
+		{"no pace", stationary, "no_pace_data"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := NewRunningPower().Enrich(context.Background(), slog.Default(), tt.activity, &user.Record{}, map[string]string{}, false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !res.Skipped || res.Metadata["reason"] != tt.reason {
+				t.Errorf("Expected skip %q, got %v", tt.reason, res.Metadata)
+			}
+		})
+	}
+}
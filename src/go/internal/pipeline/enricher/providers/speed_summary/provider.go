@@ -3,6 +3,7 @@ package speed_summary
 import (
 	"context"
 	"fmt"
+	"github.com/fitglue/server/src/go/pkg/description"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
 	"log/slog"
 	"math"
@@ -99,7 +100,7 @@ func (p *SpeedSummary) Enrich(ctx context.Context, logger *slog.Logger, activity
 
 	if showAnalysis && len(speeds) >= 10 {
 		// Multi-line bullet format with consistency analysis
-		sb.WriteString("🚀 Speed:\n")
+		sb.WriteString(providers.SectionHeader(user, description.SectionSpeed) + "\n")
 		sb.WriteString(fmt.Sprintf("• %.1f km/h avg\n", avgSpeedKmh))
 		sb.WriteString(fmt.Sprintf("• %.1f km/h max\n", maxSpeedKmh))
 
@@ -9,9 +9,13 @@ import (
 	"log/slog"
 	"net/http"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/fitglue/server/src/go/pkg/description"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/fitglue/server/src/go/internal/infra"
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
@@ -23,6 +27,14 @@ import (
 	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 )
 
+// trailingWindow is how far past the activity end we ask Spotify for plays.
+// played_at is when a track finished, so a song that was playing when the
+// activity ended is reported after the end time.
+const trailingWindow = 15 * time.Minute
+
+// SpotifyTracks lists what the user listened to during the activity, using
+// Spotify's recently-played history. Spotify only keeps the last 50 plays, so
+// activities synced long after the fact may find nothing.
 type SpotifyTracks struct {
 	Service *bootstrap.Service
 }
@@ -51,6 +63,14 @@ func (p *SpotifyTracks) Enrich(ctx context.Context, logger *slog.Logger, activit
 	return p.EnrichWithClient(ctx, logger, activity, user, inputs, nil, doNotRetry)
 }
 
+// play is one track played during the activity, clipped to the activity window.
+type play struct {
+	track  string
+	artist string
+	start  time.Time
+	end    time.Time
+}
+
 // EnrichWithClient allows HTTP client injection for testing
 func (p *SpotifyTracks) EnrichWithClient(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, httpClient *http.Client, doNotRetry bool) (*providers.EnrichmentResult, error) {
 	// 1. Check Credentials
@@ -73,15 +93,20 @@ func (p *SpotifyTracks) EnrichWithClient(ctx context.Context, logger *slog.Logge
 	}
 
 	// Calculate end time
-	durationSec := 3600 // Default
-	if len(activity.Sessions) > 0 {
-		durationSec = int(activity.Sessions[0].TotalElapsedTime)
+	var durationSec float64
+	for _, s := range activity.Sessions {
+		durationSec += s.TotalElapsedTime
+	}
+	if durationSec <= 0 {
+		durationSec = 3600 // Default
 	}
 	endTime := startTime.Add(time.Duration(durationSec) * time.Second)
 
-	// Convert to Unix milliseconds for Spotify API
-	afterMs := startTime.UnixMilli()
-	beforeMs := endTime.UnixMilli()
+	topCount := 3
+	if v, err := strconv.Atoi(inputs["top_count"]); err == nil && v > 0 && v <= 10 {
+		topCount = v
+	}
+	songMarkers := inputs["song_markers"] != "false" // default true
 
 	// 3. Initialize OAuth HTTP Client if not provided (for testing)
 	if httpClient == nil {
@@ -89,8 +114,10 @@ func (p *SpotifyTracks) EnrichWithClient(ctx context.Context, logger *slog.Logge
 		httpClient = oauth.NewClientWithUsageTracking(tokenSource, p.Service, user.UserId, "spotify", infra.WrapSlogLogger(logger))
 	}
 
-	// 4. Request Recently Played Tracks
-	url := fmt.Sprintf("https://api.spotify.com/v1/me/player/recently-played?after=%d&before=%d&limit=50", afterMs, beforeMs)
+	// 4. Request Recently Played Tracks. Spotify accepts either after or
+	// before, not both, so ask for plays before the end and filter.
+	beforeMs := endTime.Add(trailingWindow).UnixMilli()
+	url := fmt.Sprintf("https://api.spotify.com/v1/me/player/recently-played?before=%d&limit=50", beforeMs)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -112,8 +139,9 @@ func (p *SpotifyTracks) EnrichWithClient(ctx context.Context, logger *slog.Logge
 	var recentlyPlayed struct {
 		Items []struct {
 			Track struct {
-				Name    string `json:"name"`
-				Artists []struct {
+				Name       string `json:"name"`
+				DurationMs int64  `json:"duration_ms"`
+				Artists    []struct {
 					Name string `json:"name"`
 				} `json:"artists"`
 			} `json:"track"`
@@ -129,8 +157,38 @@ func (p *SpotifyTracks) EnrichWithClient(ctx context.Context, logger *slog.Logge
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// 6. Process Tracks
-	if len(recentlyPlayed.Items) == 0 {
+	// 6. Keep plays overlapping the activity. played_at marks the end of the
+	// play, so the track started duration_ms earlier.
+	var plays []play
+	var playlistURI string
+	for _, item := range recentlyPlayed.Items {
+		playedAt, err := time.Parse(time.RFC3339, item.PlayedAt)
+		if err != nil {
+			continue
+		}
+		trackStart := playedAt.Add(-time.Duration(item.Track.DurationMs) * time.Millisecond)
+		if !trackStart.Before(endTime) || !playedAt.After(startTime) {
+			continue
+		}
+
+		pl := play{track: item.Track.Name, start: trackStart, end: playedAt}
+		if len(item.Track.Artists) > 0 {
+			pl.artist = item.Track.Artists[0].Name
+		}
+		if pl.start.Before(startTime) {
+			pl.start = startTime
+		}
+		if pl.end.After(endTime) {
+			pl.end = endTime
+		}
+		plays = append(plays, pl)
+
+		if playlistURI == "" && item.Context != nil && item.Context.Type == "playlist" {
+			playlistURI = item.Context.URI
+		}
+	}
+
+	if len(plays) == 0 {
 		logger.Info("No tracks played during activity time window")
 		return &providers.EnrichmentResult{
 			Metadata: map[string]string{
@@ -141,96 +199,127 @@ func (p *SpotifyTracks) EnrichWithClient(ctx context.Context, logger *slog.Logge
 		}, nil
 	}
 
-	// Count track plays
-	trackCounts := make(map[string]int)
-	trackInfo := make(map[string]string) // track name -> artist
-	var playlistName string
+	// Spotify returns the most recent play first
+	sort.SliceStable(plays, func(i, j int) bool { return plays[i].start.Before(plays[j].start) })
 
-	for _, item := range recentlyPlayed.Items {
-		trackName := item.Track.Name
-		trackCounts[trackName]++
+	var listened time.Duration
+	for _, pl := range plays {
+		listened += pl.end.Sub(pl.start)
+	}
 
-		// Store artist info
-		if len(item.Track.Artists) > 0 {
-			trackInfo[trackName] = item.Track.Artists[0].Name
+	topTracks := rankTop(plays, topCount, func(pl play) string {
+		if pl.artist == "" {
+			return pl.track
 		}
+		return pl.track + " – " + pl.artist
+	})
+	topArtists := rankTop(plays, topCount, func(pl play) string { return pl.artist })
 
-		// Extract playlist name from first item with playlist context
-		if playlistName == "" && item.Context != nil && item.Context.Type == "playlist" {
-			// We'll need to fetch playlist name via API, but for now use URI
-			// Format: spotify:playlist:37i9dQZF1DXcBWIGoYBM5M
-			playlistName = extractPlaylistID(item.Context.URI)
+	// 7. Format Output
+	// sectionHeader identifies the section for UPDATE-mode replacement
+	sectionHeader := providers.SectionHeader(user, description.SectionSoundtrack)
+	var sb strings.Builder
+	sb.WriteString(sectionHeader)
+	sb.WriteString(fmt.Sprintf("\n🎧 %d tracks • %s of music", len(plays), formatListened(listened)))
+
+	var trackParts []string
+	for _, t := range topTracks {
+		if t.count > 1 {
+			trackParts = append(trackParts, fmt.Sprintf("%s (×%d)", t.name, t.count))
+		} else {
+			trackParts = append(trackParts, t.name)
 		}
 	}
+	sb.WriteString("\n🔝 Top tracks: " + strings.Join(trackParts, ", "))
 
-	// Find most played track
-	var topTrack string
-	var topCount int
-	for track, count := range trackCounts {
-		if count > topCount {
-			topCount = count
-			topTrack = track
-		}
+	var artistParts []string
+	for _, a := range topArtists {
+		artistParts = append(artistParts, fmt.Sprintf("%s (%d)", a.name, a.count))
+	}
+	if len(artistParts) > 0 {
+		sb.WriteString("\n🎤 Top artists: " + strings.Join(artistParts, ", "))
 	}
 
-	// If there's a tie, sort alphabetically for consistency
-	if topCount > 0 {
-		var tiedTracks []string
-		for track, count := range trackCounts {
-			if count == topCount {
-				tiedTracks = append(tiedTracks, track)
+	// 8. Mark each song change on the timeline
+	var markers []*pbactivity.TimeMarker
+	if songMarkers {
+		for _, pl := range plays {
+			label := "🎵 " + pl.track
+			if pl.artist != "" {
+				label += " – " + pl.artist
 			}
-		}
-		if len(tiedTracks) > 1 {
-			sort.Strings(tiedTracks)
-			topTrack = tiedTracks[0]
+			markers = append(markers, &pbactivity.TimeMarker{
+				Timestamp:       timestamppb.New(pl.start),
+				Label:           label,
+				MarkerType:      "song_change",
+				DurationSeconds: int32(pl.end.Sub(pl.start).Seconds()),
+			})
 		}
 	}
 
-	// 7. Format Output
-	// "🎵 Soundtrack: 12 tracks • Top played: Blinding Lights - The Weeknd • From playlist: Running Hits 2026"
-	summaryText := fmt.Sprintf("🎵 Soundtrack: %d tracks", len(recentlyPlayed.Items))
-
-	if topTrack != "" {
-		artist := trackInfo[topTrack]
-		if artist != "" {
-			summaryText += fmt.Sprintf(" • Top played: %s - %s", topTrack, artist)
-		} else {
-			summaryText += fmt.Sprintf(" • Top played: %s", topTrack)
-		}
+	metadata := map[string]string{
+		"spotify_status":    "success",
+		"track_count":       strconv.Itoa(len(plays)),
+		"listened_minutes":  strconv.Itoa(int(listened.Minutes())),
+		"top_track":         topTracks[0].track,
+		"playlist":          playlistURI,
+		"status_detail":     "Successfully added soundtrack",
+		"song_marker_count": strconv.Itoa(len(markers)),
 	}
-
-	if playlistName != "" {
-		summaryText += fmt.Sprintf(" • From playlist: %s", playlistName)
+	if len(topArtists) > 0 {
+		metadata["top_artist"] = topArtists[0].name
 	}
 
-	// Append to existing description
-	newDescription := summaryText
-
 	logger.Info("Spotify tracks enrichment complete",
-		"track_count", len(recentlyPlayed.Items),
-		"top_track", topTrack,
-		"playlist", playlistName,
+		"track_count", len(plays),
+		"top_track", metadata["top_track"],
+		"playlist", playlistURI,
 	)
 
 	return &providers.EnrichmentResult{
-		Description: newDescription,
-		Metadata: map[string]string{
-			"spotify_status": "success",
-			"track_count":    fmt.Sprintf("%d", len(recentlyPlayed.Items)),
-			"top_track":      topTrack,
-			"top_artist":     trackInfo[topTrack],
-			"playlist":       playlistName,
-			"status_detail":  "Successfully added soundtrack",
-		},
+		Description:   sb.String(),
+		SectionHeader: sectionHeader,
+		TimeMarkers:   markers,
+		Metadata:      metadata,
 	}, nil
 }
 
-// extractPlaylistID extracts playlist ID from Spotify URI
-// Format: spotify:playlist:37i9dQZF1DXcBWIGoYBM5M
-func extractPlaylistID(uri string) string {
-	// For now, just return the URI as-is
-	// In production, you'd want to fetch the playlist name via API
-	// GET https://api.spotify.com/v1/playlists/{playlist_id}
-	return uri
+// ranked is a track or artist with its play count.
+type ranked struct {
+	name  string
+	track string // track title, for track rankings
+	count int
+}
+
+// rankTop counts plays by key and returns the n most played, breaking ties
+// by which was heard first. Plays with an empty key are ignored.
+func rankTop(plays []play, n int, key func(play) string) []ranked {
+	index := map[string]int{}
+	var out []ranked
+	for _, pl := range plays {
+		k := key(pl)
+		if k == "" {
+			continue
+		}
+		if i, ok := index[k]; ok {
+			out[i].count++
+			continue
+		}
+		index[k] = len(out)
+		out = append(out, ranked{name: k, track: pl.track, count: 1})
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].count > out[j].count })
+	if len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
+
+// formatListened renders listening time as e.g. "48 min" or "1h 12m".
+func formatListened(d time.Duration) string {
+	total := int(d.Round(time.Minute).Minutes())
+	if total < 60 {
+		return fmt.Sprintf("%d min", total)
+	}
+	return fmt.Sprintf("%dh %02dm", total/60, total%60)
 }
@@ -1,6 +1,7 @@
 package spotify_tracks
 
 import (
+	"github.com/fitglue/server/src/go/pkg/description"
 	user "github.com/fitglue/server/src/go/pkg/domain/user"
 
 	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
@@ -13,6 +14,8 @@ import (
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -20,6 +23,9 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// sectionHeader is the header the provider renders for a user without preferences.
+var sectionHeader = description.Header(description.SectionSoundtrack, nil)
+
 func TestSpotifyTracks_ProviderType(t *testing.T) {
 	provider := NewSpotifyTracks()
 	if provider.ProviderType() != pbplugin.EnricherProviderType_ENRICHER_PROVIDER_SPOTIFY_TRACKS {
@@ -120,99 +126,123 @@ func TestSpotifyTracks_NoTracksPlayed(t *testing.T) {
 	}
 }
 
+// recentlyPlayedJSON has plays around a 10:00–10:30 activity. played_at is
+// when each track finished; Spotify lists the most recent first.
+const recentlyPlayedJSON = `{
+	"items": [
+		{"track": {"name": "After Hours", "duration_ms": 240000, "artists": [{"name": "The Weeknd"}]}, "played_at": "2026-01-21T10:40:00Z", "context": null},
+		{"track": {"name": "One More Time", "duration_ms": 320000, "artists": [{"name": "Daft Punk"}]}, "played_at": "2026-01-21T10:33:00Z", "context": null},
+		{"track": {"name": "Levitating", "duration_ms": 203000, "artists": [{"name": "Dua Lipa"}]}, "played_at": "2026-01-21T10:07:40Z", "context": null},
+		{"track": {"name": "Blinding Lights", "duration_ms": 200000, "artists": [{"name": "The Weeknd"}]}, "played_at": "2026-01-21T10:04:17Z", "context": {"type": "playlist", "uri": "spotify:playlist:37i9dQZF1DXcBWIGoYBM5M"}},
+		{"track": {"name": "Blinding Lights", "duration_ms": 200000, "artists": [{"name": "The Weeknd"}]}, "played_at": "2026-01-21T10:00:57Z", "context": {"type": "playlist", "uri": "spotify:playlist:37i9dQZF1DXcBWIGoYBM5M"}},
+		{"track": {"name": "Warm Up", "duration_ms": 180000, "artists": [{"name": "Someone"}]}, "played_at": "2026-01-21T09:55:00Z", "context": null}
+	]
+}`
+
+func spotifyUser() *user.Record {
+	return &user.Record{
+		UserProfile: &pbuser.UserProfile{UserId: "test-user"},
+		Integrations: &pbuser.UserIntegrations{
+			Spotify: &pbuser.SpotifyIntegration{Enabled: true, AccessToken: "test-token", RefreshToken: "test-refresh"},
+		},
+	}
+}
+
+func spotifyActivity() *pbactivity.StandardizedActivity {
+	return &pbactivity.StandardizedActivity{
+		StartTime:   timestamppb.New(time.Date(2026, 1, 21, 10, 0, 0, 0, time.UTC)),
+		Description: "Morning Run",
+		Sessions:    []*pbactivity.Session{{TotalElapsedTime: 1800}},
+	}
+}
+
 func TestSpotifyTracks_SuccessfulEnrichment(t *testing.T) {
-	// Mock Spotify API server
+	var query url.Values
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(`{
-			"items": [
-				{
-					"track": {
-						"name": "Blinding Lights",
-						"artists": [{"name": "The Weeknd"}]
-					},
-					"played_at": "2026-01-21T10:00:00Z",
-					"context": {
-						"type": "playlist",
-						"uri": "spotify:playlist:37i9dQZF1DXcBWIGoYBM5M"
-					}
-				},
-				{
-					"track": {
-						"name": "Blinding Lights",
-						"artists": [{"name": "The Weeknd"}]
-					},
-					"played_at": "2026-01-21T10:03:30Z",
-					"context": {
-						"type": "playlist",
-						"uri": "spotify:playlist:37i9dQZF1DXcBWIGoYBM5M"
-					}
-				},
-				{
-					"track": {
-						"name": "Levitating",
-						"artists": [{"name": "Dua Lipa"}]
-					},
-					"played_at": "2026-01-21T10:07:00Z",
-					"context": null
-				}
-			]
-		}`))
+		w.Write([]byte(recentlyPlayedJSON))
 	}))
 	defer server.Close()
 
-	// Create mock HTTP client
-	mockClient := &http.Client{
-		Transport: &mockTransport{
-			testServer: server.URL,
-		},
-	}
+	mockClient := &http.Client{Transport: &mockTransport{testServer: server.URL}}
 
 	provider := NewSpotifyTracks()
 	provider.SetService(&bootstrap.Service{})
 
-	activity := &pbactivity.StandardizedActivity{
-		StartTime:   timestamppb.New(time.Now().Add(-1 * time.Hour)),
-		Description: "Morning Run",
-		Sessions: []*pbactivity.Session{
-			{TotalElapsedTime: 3600},
-		},
+	result, err := provider.EnrichWithClient(context.Background(), slog.Default(), spotifyActivity(), spotifyUser(), map[string]string{}, mockClient, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
 	}
 
-	user := &user.Record{
-		UserProfile: &pbuser.UserProfile{
-			UserId: "test-user",
-		},
-		Integrations: &pbuser.UserIntegrations{
-			Spotify: &pbuser.SpotifyIntegration{
-				Enabled:      true,
-				AccessToken:  "test-token",
-				RefreshToken: "test-refresh",
-			},
-		},
+	// Spotify rejects requests with both cursors
+	if query.Get("after") != "" || query.Get("before") == "" {
+		t.Errorf("Expected only a before cursor, got %v", query)
 	}
 
-	result, err := provider.EnrichWithClient(context.Background(), slog.Default(), activity, user, map[string]string{}, mockClient, false)
-	if err != nil {
-		t.Fatalf("Expected no error, got %v", err)
+	// The warm-up track and the post-run track fall outside the activity
+	if result.Metadata["track_count"] != "4" {
+		t.Errorf("Expected track_count '4', got %s", result.Metadata["track_count"])
+	}
+	if result.Metadata["top_track"] != "Blinding Lights" || result.Metadata["top_artist"] != "The Weeknd" {
+		t.Errorf("Unexpected top track/artist: %v", result.Metadata)
+	}
+	if result.Metadata["playlist"] != "spotify:playlist:37i9dQZF1DXcBWIGoYBM5M" {
+		t.Errorf("Expected playlist URI, got %s", result.Metadata["playlist"])
 	}
 
-	if result.Metadata["track_count"] != "3" {
-		t.Errorf("Expected track_count '3', got %s", result.Metadata["track_count"])
+	expectedDesc := "🎵 Soundtrack:\n" +
+		"🎧 4 tracks • 10 min of music\n" +
+		"🔝 Top tracks: Blinding Lights – The Weeknd (×2), Levitating – Dua Lipa, One More Time – Daft Punk\n" +
+		"🎤 Top artists: The Weeknd (2), Dua Lipa (1), Daft Punk (1)"
+	if result.Description != expectedDesc {
+		t.Errorf("Expected description:\n%s\nGot:\n%s", expectedDesc, result.Description)
 	}
+	if result.SectionHeader != sectionHeader {
+		t.Errorf("Expected section header %q, got %q", sectionHeader, result.SectionHeader)
+	}
+}
+
+func TestSpotifyTracks_SongMarkers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(recentlyPlayedJSON))
+	}))
+	defer server.Close()
+	mockClient := &http.Client{Transport: &mockTransport{testServer: server.URL}}
 
-	if result.Metadata["top_track"] != "Blinding Lights" {
-		t.Errorf("Expected top_track 'Blinding Lights', got %s", result.Metadata["top_track"])
+	result, err := NewSpotifyTracks().EnrichWithClient(context.Background(), slog.Default(), spotifyActivity(), spotifyUser(), map[string]string{}, mockClient, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result.TimeMarkers) != 4 {
+		t.Fatalf("Expected 4 song markers, got %d", len(result.TimeMarkers))
 	}
 
-	if result.Metadata["top_artist"] != "The Weeknd" {
-		t.Errorf("Expected top_artist 'The Weeknd', got %s", result.Metadata["top_artist"])
+	// The first song started before the activity and is clipped to its start
+	first := result.TimeMarkers[0]
+	if !first.Timestamp.AsTime().Equal(time.Date(2026, 1, 21, 10, 0, 0, 0, time.UTC)) || first.DurationSeconds != 57 {
+		t.Errorf("Expected first marker at 10:00:00 for 57s, got %v for %ds", first.Timestamp.AsTime(), first.DurationSeconds)
+	}
+	if first.Label != "🎵 Blinding Lights – The Weeknd" || first.MarkerType != "song_change" {
+		t.Errorf("Unexpected marker: %+v", first)
+	}
+	// The last song ran past the end and is clipped to the activity end
+	last := result.TimeMarkers[3]
+	if !last.Timestamp.AsTime().Equal(time.Date(2026, 1, 21, 10, 27, 40, 0, time.UTC)) || last.DurationSeconds != 140 {
+		t.Errorf("Expected last marker at 10:27:40 for 140s, got %v for %ds", last.Timestamp.AsTime(), last.DurationSeconds)
 	}
 
-	expectedDesc := "🎵 Soundtrack: 3 tracks • Top played: Blinding Lights - The Weeknd • From playlist: spotify:playlist:37i9dQZF1DXcBWIGoYBM5M"
-	if result.Description != expectedDesc {
-		t.Errorf("Expected description:\n%s\nGot:\n%s", expectedDesc, result.Description)
+	result, err = NewSpotifyTracks().EnrichWithClient(context.Background(), slog.Default(), spotifyActivity(), spotifyUser(), map[string]string{"song_markers": "false", "top_count": "1"}, mockClient, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result.TimeMarkers) != 0 {
+		t.Errorf("Expected no markers when disabled, got %d", len(result.TimeMarkers))
+	}
+	if !strings.Contains(result.Description, "🔝 Top tracks: Blinding Lights – The Weeknd (×2)\n") {
+		t.Errorf("Expected a single top track, got:\n%s", result.Description)
 	}
 }
 
@@ -0,0 +1,278 @@
+package strava_segments
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/description"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/oauth"
+	strava "github.com/fitglue/server/src/go/pkg/integrations/strava"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+const (
+	stravaAPIBaseURL = "https://www.strava.com/api/v3"
+
+	defaultMaxSegments = 10
+	// historyPageSize is how many earlier efforts are read when looking for
+	// the PR a new PR beat. Strava's maximum page size.
+	historyPageSize = 200
+)
+
+// StravaSegments compares the segment efforts of a Strava activity with the
+// athlete's PRs on those segments. Only activities sourced from Strava have
+// segment efforts, so other sources are skipped.
+type StravaSegments struct {
+	Service *bootstrap.Service
+}
+
+func init() {
+	providers.Register(NewStravaSegments())
+}
+
+func NewStravaSegments() *StravaSegments {
+	return &StravaSegments{}
+}
+
+func (p *StravaSegments) SetService(service *bootstrap.Service) {
+	p.Service = service
+}
+
+func (p *StravaSegments) Name() string {
+	return "strava-segments"
+}
+
+func (p *StravaSegments) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_STRAVA_SEGMENTS
+}
+
+// RevealsLocation marks the section listing segment names, which are often
+// local landmarks.
+func (p *StravaSegments) RevealsLocation() bool { return true }
+
+func (p *StravaSegments) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	return p.EnrichWithClient(ctx, logger, activity, user, inputs, nil, doNotRetry)
+}
+
+// segmentResult is one effort on the activity, compared with the athlete's PR.
+type segmentResult struct {
+	name    string
+	elapsed int
+	prTime  int // current PR on the segment, 0 if unknown
+	prRank  int // 1-3 when the effort made the athlete's top 3
+	isPR    bool
+	// previousPR is the PR this effort beat, 0 if unknown or first effort
+	previousPR int
+	firstTime  bool
+}
+
+// EnrichWithClient allows HTTP client injection for testing
+func (p *StravaSegments) EnrichWithClient(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, httpClient *http.Client, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	// 1. Only Strava activities have segment efforts
+	if activity.Source != pbactivity.ActivitySource_SOURCE_STRAVA {
+		return skipped("not_strava_source", "Activity is not from Strava"), nil
+	}
+	activityID, err := strconv.ParseInt(activity.ExternalId, 10, 64)
+	if err != nil {
+		return skipped("no_external_id", "Activity has no Strava ID"), nil
+	}
+
+	// 2. Check Credentials
+	if user.Integrations == nil || user.Integrations.Strava == nil || !user.Integrations.Strava.Enabled {
+		logger.Info("Strava integration not enabled, skipping")
+		return skipped("integration_disabled", "Strava integration not enabled"), nil
+	}
+
+	maxSegments := defaultMaxSegments
+	if v, err := strconv.Atoi(inputs["max_segments"]); err == nil && v > 0 && v <= 30 {
+		maxSegments = v
+	}
+	comparePrevious := inputs["compare_previous"] != "false" // default true
+
+	// 3. Initialize OAuth HTTP Client if not provided (for testing)
+	if httpClient == nil {
+		tokenSource := oauth.NewFirestoreTokenSource(p.Service, user.UserId, "strava")
+		httpClient = oauth.NewClientWithUsageTracking(tokenSource, p.Service, user.UserId, "strava", infra.WrapSlogLogger(logger))
+	}
+
+	client, err := strava.NewClientWithResponses(stravaAPIBaseURL, strava.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create strava client: %w", err)
+	}
+
+	// 4. Fetch the activity's segment efforts
+	efforts, err := client.ActivitySegmentEfforts(ctx, activityID)
+	if err != nil {
+		return nil, fmt.Errorf("strava segment efforts request failed: %w", err)
+	}
+
+	// 5. Compare each effort with the athlete's PR, in the order ridden
+	var results []segmentResult
+	for _, e := range efforts {
+		if e.Hidden != nil && *e.Hidden {
+			continue
+		}
+		if e.Name == nil || e.ElapsedTime == nil || *e.ElapsedTime <= 0 {
+			continue
+		}
+		r := segmentResult{name: strings.TrimSpace(*e.Name), elapsed: *e.ElapsedTime}
+		if e.PrRank != nil {
+			r.prRank = *e.PrRank
+		}
+
+		if e.Segment != nil && e.Segment.AthletePrEffort != nil {
+			pr := e.Segment.AthletePrEffort
+			if pr.PrElapsedTime != nil {
+				r.prTime = *pr.PrElapsedTime
+			}
+			if pr.PrActivityId != nil && *pr.PrActivityId == activityID {
+				r.isPR = true
+			}
+			if pr.EffortCount != nil && *pr.EffortCount == 1 {
+				r.firstTime = true
+			}
+		}
+		if r.prRank == 1 {
+			r.isPR = true
+		}
+
+		if r.isPR && !r.firstTime && comparePrevious && e.Segment != nil && e.Segment.Id != nil && e.StartDateLocal != nil {
+			r.previousPR = previousBest(ctx, logger, client, *e.Segment.Id, activityID, *e.StartDateLocal)
+		}
+
+		results = append(results, r)
+		if len(results) >= maxSegments {
+			break
+		}
+	}
+
+	if len(results) == 0 {
+		logger.Info("No segment efforts on activity", "activity_id", activityID)
+		return skipped("no_segment_efforts", "No segment efforts on activity"), nil
+	}
+
+	// 6. Format Output
+	// sectionHeader identifies the section for UPDATE-mode replacement
+	sectionHeader := providers.SectionHeader(user, description.SectionSegments)
+	var sb strings.Builder
+	sb.WriteString(sectionHeader)
+	prCount, topThreeCount := 0, 0
+	for _, r := range results {
+		sb.WriteString("\n" + formatSegment(r))
+		if r.isPR && !r.firstTime {
+			prCount++
+		}
+		if r.prRank >= 1 && r.prRank <= 3 {
+			topThreeCount++
+		}
+	}
+
+	logger.Info("Strava segments enrichment complete",
+		"segment_count", len(results),
+		"pr_count", prCount,
+	)
+
+	return &providers.EnrichmentResult{
+		Description:   sb.String(),
+		SectionHeader: sectionHeader,
+		Metadata: map[string]string{
+			"strava_segments_status": "success",
+			"segment_count":          strconv.Itoa(len(results)),
+			"pr_count":               strconv.Itoa(prCount),
+			"top3_count":             strconv.Itoa(topThreeCount),
+		},
+	}, nil
+}
+
+// previousBest finds the athlete's fastest effort on a segment before the
+// given one, ignoring efforts from the same activity (laps of a loop).
+// Returns 0 when there is none or the lookup fails; the PR is still shown.
+func previousBest(ctx context.Context, logger *slog.Logger, client *strava.ClientWithResponses, segmentID, activityID int64, before time.Time) int {
+	history, err := client.AthleteSegmentEfforts(ctx, segmentID, before.AddDate(-20, 0, 0), before.Add(-time.Second), historyPageSize)
+	if err != nil {
+		logger.Warn("Failed to read segment history", "segment_id", segmentID, "error", err)
+		return 0
+	}
+
+	best := 0
+	for _, h := range history {
+		if h.ElapsedTime == nil || *h.ElapsedTime <= 0 {
+			continue
+		}
+		if h.ActivityId != nil && *h.ActivityId == activityID {
+			continue
+		}
+		if h.Activity != nil && h.Activity.Id != nil && *h.Activity.Id == activityID {
+			continue
+		}
+		if best == 0 || *h.ElapsedTime < best {
+			best = *h.ElapsedTime
+		}
+	}
+	return best
+}
+
+// formatSegment renders one effort, e.g. "⬆️ Hill Climb: 4:12 🏆 PR (-0:08)"
+// or "⬇️ River Path: 6:30 (+0:18 vs PR)".
+func formatSegment(r segmentResult) string {
+	line := fmt.Sprintf("%s: %s", r.name, formatDuration(r.elapsed))
+
+	switch {
+	case r.firstTime:
+		return "🆕 " + line + " (first effort)"
+	case r.isPR && r.previousPR > r.elapsed:
+		return fmt.Sprintf("⬆️ %s 🏆 PR (-%s)", line, formatDuration(r.previousPR-r.elapsed))
+	case r.isPR:
+		return "⬆️ " + line + " 🏆 PR"
+	case r.prTime <= 0:
+		return "• " + line
+	case r.elapsed == r.prTime:
+		return "➡️ " + line + " (equals PR)"
+	}
+
+	suffix := fmt.Sprintf("(+%s vs PR)", formatDuration(r.elapsed-r.prTime))
+	switch r.prRank {
+	case 2:
+		suffix = "🥈 " + suffix
+	case 3:
+		suffix = "🥉 " + suffix
+	}
+	return fmt.Sprintf("⬇️ %s %s", line, suffix)
+}
+
+// formatDuration renders seconds as m:ss or h:mm:ss.
+func formatDuration(seconds int) string {
+	if seconds < 0 {
+		seconds = -seconds
+	}
+	h, m, s := seconds/3600, (seconds%3600)/60, seconds%60
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%d:%02d", m, s)
+}
+
+func skipped(reason, detail string) *providers.EnrichmentResult {
+	return &providers.EnrichmentResult{
+		Skipped:    true,
+		SkipReason: detail,
+		Metadata: map[string]string{
+			"strava_segments_status": "skipped",
+			"reason":                 reason,
+			"status_detail":          detail,
+		},
+	}
+}
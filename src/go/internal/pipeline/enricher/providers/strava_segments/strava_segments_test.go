@@ -0,0 +1,182 @@
+package strava_segments
+
+import (
+	user "github.com/fitglue/server/src/go/pkg/domain/user"
+
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestStravaSegments_ProviderType(t *testing.T) {
+	provider := NewStravaSegments()
+	if provider.ProviderType() != pbplugin.EnricherProviderType_ENRICHER_PROVIDER_STRAVA_SEGMENTS {
+		t.Errorf("Expected ENRICHER_PROVIDER_STRAVA_SEGMENTS, got %v", provider.ProviderType())
+	}
+	if provider.Name() != "strava-segments" {
+		t.Errorf("Expected 'strava-segments', got %s", provider.Name())
+	}
+}
+
+func stravaUser(enabled bool) *user.Record {
+	return &user.Record{
+		UserProfile: &pbuser.UserProfile{UserId: "test-user"},
+		Integrations: &pbuser.UserIntegrations{
+			Strava: &pbuser.StravaIntegration{Enabled: enabled, AccessToken: "test-token"},
+		},
+	}
+}
+
+func stravaActivity() *pbactivity.StandardizedActivity {
+	return &pbactivity.StandardizedActivity{
+		Source:     pbactivity.ActivitySource_SOURCE_STRAVA,
+		ExternalId: "1001",
+	}
+}
+
+func TestStravaSegments_Skips(t *testing.T) {
+	provider := NewStravaSegments()
+
+	hevy := &pbactivity.StandardizedActivity{Source: pbactivity.ActivitySource_SOURCE_HEVY, ExternalId: "abc"}
+	result, err := provider.EnrichWithClient(context.Background(), slog.Default(), hevy, stravaUser(true), map[string]string{}, http.DefaultClient, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !result.Skipped || result.Metadata["reason"] != "not_strava_source" {
+		t.Errorf("Expected not_strava_source skip, got %+v", result)
+	}
+
+	result, err = provider.EnrichWithClient(context.Background(), slog.Default(), stravaActivity(), stravaUser(false), map[string]string{}, http.DefaultClient, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !result.Skipped || result.Metadata["reason"] != "integration_disabled" {
+		t.Errorf("Expected integration_disabled skip, got %+v", result)
+	}
+}
+
+// activityJSON has one new PR, one second-best effort, one first effort, a
+// hidden duplicate and a plain effort 18s off the PR.
+const activityJSON = `{
+	"id": 1001,
+	"segment_efforts": [
+		{"id": 1, "name": "Hill Climb", "elapsed_time": 252, "pr_rank": 1, "start_date_local": "2026-03-01T08:05:00Z",
+		 "segment": {"id": 501, "athlete_pr_effort": {"pr_elapsed_time": 252, "pr_activity_id": 1001, "effort_count": 14}}},
+		{"id": 2, "name": "Hill Climb", "elapsed_time": 252, "hidden": true,
+		 "segment": {"id": 501}},
+		{"id": 3, "name": "Bridge Sprint", "elapsed_time": 65, "pr_rank": 2,
+		 "segment": {"id": 502, "athlete_pr_effort": {"pr_elapsed_time": 61, "pr_activity_id": 900, "effort_count": 30}}},
+		{"id": 4, "name": "New Trail", "elapsed_time": 300,
+		 "segment": {"id": 503, "athlete_pr_effort": {"pr_elapsed_time": 300, "pr_activity_id": 1001, "effort_count": 1}}},
+		{"id": 5, "name": "River Path", "elapsed_time": 390,
+		 "segment": {"id": 504, "athlete_pr_effort": {"pr_elapsed_time": 372, "pr_activity_id": 800, "effort_count": 8}}}
+	]
+}`
+
+// historyJSON is the athlete's earlier efforts on Hill Climb, including a
+// lap from the same activity that must be ignored.
+const historyJSON = `[
+	{"id": 90, "elapsed_time": 275, "activity_id": 700},
+	{"id": 91, "elapsed_time": 260, "activity_id": 600},
+	{"id": 92, "elapsed_time": 240, "activity_id": 1001}
+]`
+
+func TestStravaSegments_ComparesWithPRs(t *testing.T) {
+	var historyRequests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/v3/activities/1001":
+			w.Write([]byte(activityJSON))
+		case r.URL.Path == "/api/v3/segment_efforts" && r.URL.Query().Get("segment_id") == "501":
+			historyRequests++
+			w.Write([]byte(historyJSON))
+		default:
+			t.Errorf("Unexpected request %s", r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	mockClient := &http.Client{Transport: &mockTransport{testServer: server.URL}}
+	provider := NewStravaSegments()
+
+	result, err := provider.EnrichWithClient(context.Background(), slog.Default(), stravaActivity(), stravaUser(true), map[string]string{}, mockClient, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := strings.Join([]string{
+		"🏁 Segments:",
+		"⬆️ Hill Climb: 4:12 🏆 PR (-0:08)",
+		"⬇️ Bridge Sprint: 1:05 🥈 (+0:04 vs PR)",
+		"🆕 New Trail: 5:00 (first effort)",
+		"⬇️ River Path: 6:30 (+0:18 vs PR)",
+	}, "\n")
+	if result.Description != expected {
+		t.Errorf("Unexpected description:\n%s\nwant:\n%s", result.Description, expected)
+	}
+	if historyRequests != 1 {
+		t.Errorf("Expected history lookup only for the new PR, got %d", historyRequests)
+	}
+	if result.Metadata["segment_count"] != "4" || result.Metadata["pr_count"] != "1" || result.Metadata["top3_count"] != "2" {
+		t.Errorf("Unexpected metadata: %v", result.Metadata)
+	}
+}
+
+func TestStravaSegments_MaxSegments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(activityJSON))
+	}))
+	defer server.Close()
+
+	mockClient := &http.Client{Transport: &mockTransport{testServer: server.URL}}
+	provider := NewStravaSegments()
+
+	inputs := map[string]string{"max_segments": "1", "compare_previous": "false"}
+	result, err := provider.EnrichWithClient(context.Background(), slog.Default(), stravaActivity(), stravaUser(true), inputs, mockClient, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Description != "🏁 Segments:\n⬆️ Hill Climb: 4:12 🏆 PR" {
+		t.Errorf("Unexpected description: %q", result.Description)
+	}
+}
+
+func TestStravaSegments_NoEfforts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 1001, "segment_efforts": []}`))
+	}))
+	defer server.Close()
+
+	mockClient := &http.Client{Transport: &mockTransport{testServer: server.URL}}
+	result, err := NewStravaSegments().EnrichWithClient(context.Background(), slog.Default(), stravaActivity(), stravaUser(true), map[string]string{}, mockClient, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !result.Skipped || result.Metadata["reason"] != "no_segment_efforts" {
+		t.Errorf("Expected no_segment_efforts skip, got %+v", result)
+	}
+}
+
+type mockTransport struct {
+	testServer string
+}
+
+func (m *mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Redirect to test server
+	req.URL.Scheme = "http"
+	req.URL.Host = m.testServer[7:] // Remove "http://"
+	return http.DefaultTransport.RoundTrip(req)
+}
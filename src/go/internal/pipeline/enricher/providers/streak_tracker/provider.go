@@ -3,6 +3,7 @@ package streak_tracker
 import (
 	"context"
 	"fmt"
+	"github.com/fitglue/server/src/go/pkg/description"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
 	"log/slog"
 	"strings"
@@ -148,7 +149,7 @@ func (p *StreakTracker) Enrich(ctx context.Context, logger *slog.Logger, activit
 	// Build output
 	activityLabel := getActivityLabel(activityTypes)
 	var sb strings.Builder
-	sb.WriteString("🔥 Streak Tracker:\n")
+	sb.WriteString(providers.SectionHeader(user, description.SectionStreakTracker) + "\n")
 
 	if streakBroken {
 		sb.WriteString(fmt.Sprintf("• Day 1 of your %s streak - starting fresh!", activityLabel))
@@ -0,0 +1,211 @@
+package timestamp_sanity
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/user_input"
+
+	pendinginput "github.com/fitglue/server/src/go/pkg/pending_input"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// earliestPlausibleStart sits well after the FIT epoch (1989-12-31), which is
+// where devices with an unset clock date their activities.
+var earliestPlausibleStart = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// futureTolerance absorbs ordinary clock skew between the device and FitGlue.
+const futureTolerance = 15 * time.Minute
+
+// Correction modes for the "correction" config option.
+const (
+	correctionAsk        = "ask"
+	correctionUploadTime = "upload_time"
+)
+
+// uploadTimeInput is accepted in place of an offset to shift to upload time.
+const uploadTimeInput = "upload"
+
+// TimestampSanityProvider detects activities dated by a device with a wrong
+// clock (e.g. 1989 or the future) and shifts every timestamp to a corrected
+// time, either automatically or once the user supplies an offset.
+type TimestampSanityProvider struct {
+	// now is overridable for tests
+	now func() time.Time
+}
+
+func init() {
+	providers.Register(NewTimestampSanityProvider())
+}
+
+func NewTimestampSanityProvider() *TimestampSanityProvider {
+	return &TimestampSanityProvider{now: time.Now}
+}
+
+func (p *TimestampSanityProvider) Name() string {
+	return "timestamp-sanity"
+}
+
+func (p *TimestampSanityProvider) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_TIMESTAMP_SANITY
+}
+
+// SkipOnSourceUpdate avoids shifting timestamps again, or asking for an
+// offset, when the user only edited the activity's metadata.
+func (p *TimestampSanityProvider) SkipOnSourceUpdate() bool { return true }
+
+// IsEssential keeps the check running past the execution budget; skipping it
+// would let a misdated activity reach destinations.
+func (p *TimestampSanityProvider) IsEssential() bool { return true }
+
+func (p *TimestampSanityProvider) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	now := p.now()
+	start := activity.GetStartTime().AsTime()
+
+	reason := implausibleReason(start, now)
+	if reason == "" {
+		return &providers.EnrichmentResult{
+			Metadata: map[string]string{"timestamp_status": "ok"},
+		}, nil
+	}
+
+	uploadOffset := offsetToUploadTime(activity, now)
+	logger.Info("timestamp-sanity: implausible start time detected",
+		"start_time", start.Format(time.RFC3339),
+		"reason", reason,
+		"upload_time_offset", uploadOffset.String(),
+	)
+
+	correction := inputs["correction"]
+	if correction == "" {
+		correction = correctionAsk
+	}
+
+	if correction == correctionUploadTime {
+		return correctedResult(start, uploadOffset, reason), nil
+	}
+
+	linkedActivityId := inputs["activity_id"]
+	if linkedActivityId == "" {
+		return nil, fmt.Errorf("activity_id not provided in enricher inputs")
+	}
+
+	// Halt until the user picks a correction; nothing is published to
+	// destinations while the pending input is waiting.
+	return nil, &user_input.WaitForInputError{
+		ActivityID:         pendinginput.GenerateID(activity.Source.String(), activity.ExternalId, p.Name()),
+		RequiredFields:     []string{"clock_offset"},
+		EnricherProviderID: p.Name(),
+		Metadata: map[string]string{
+			"source_activity_id":   activity.ExternalId,
+			"source_activity_type": activity.Source.String(),
+			"linked_activity_id":   linkedActivityId,
+			"pipeline_id":          inputs["pipeline_id"],
+			"detected_start_time":  start.Format(time.RFC3339),
+			"detection_reason":     reason,
+			"upload_time_offset":   uploadOffset.String(),
+			"display.field_labels": `{"clock_offset":"Clock Offset"}`,
+			"display.field_types":  `{"clock_offset":"text:placeholder=e.g. +2h, -1h30m or upload"}`,
+			"display.summary":      fmt.Sprintf("This activity is dated %s, which looks wrong (%s)", start.Format("2 Jan 2006 15:04"), reason),
+			"display.title":        "Fix Activity Date",
+			"display.help":         "Enter how far to move every timestamp (e.g. +2h or -1h30m), or enter \"upload\" to move the activity so it ends when it was uploaded",
+		},
+	}
+}
+
+// EnrichResume applies the offset chosen by the user.
+func (p *TimestampSanityProvider) EnrichResume(ctx context.Context, activity *pbactivity.StandardizedActivity, user *user.Record, pendingInput *pbpipeline.PendingInput) (*providers.EnrichmentResult, error) {
+	raw := strings.TrimSpace(pendingInput.InputData["clock_offset"])
+
+	var offset time.Duration
+	if raw == "" || strings.EqualFold(raw, uploadTimeInput) {
+		// Use the offset computed at detection so a late resume lands on the
+		// original upload time rather than the time the input was resolved.
+		stored, err := time.ParseDuration(pendingInput.ProviderMetadata["upload_time_offset"])
+		if err != nil {
+			return nil, fmt.Errorf("upload time offset missing from pending input: %w", err)
+		}
+		offset = stored
+	} else {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid clock offset %q: %w", raw, err)
+		}
+		offset = parsed
+	}
+
+	// The corrected time gets the same check as the original, so a typo
+	// (e.g. -2h for +2h on a future date) can't slip through to destinations.
+	start := activity.GetStartTime().AsTime()
+	corrected := start.Add(offset)
+	if reason := implausibleReason(corrected, p.now()); reason != "" {
+		return nil, p.askAgain(pendingInput, corrected, offset, reason)
+	}
+
+	return correctedResult(start, offset, pendingInput.ProviderMetadata["detection_reason"]), nil
+}
+
+// askAgain reopens the pending input after the user's offset produced another
+// implausible start time.
+func (p *TimestampSanityProvider) askAgain(pendingInput *pbpipeline.PendingInput, corrected time.Time, offset time.Duration, reason string) *user_input.WaitForInputError {
+	metadata := make(map[string]string, len(pendingInput.ProviderMetadata))
+	for k, v := range pendingInput.ProviderMetadata {
+		metadata[k] = v
+	}
+	metadata["rejected_clock_offset"] = offset.String()
+	metadata["display.summary"] = fmt.Sprintf("Moving this activity by %s dates it %s, which still looks wrong (%s)", offset, corrected.Format("2 Jan 2006 15:04"), reason)
+
+	return &user_input.WaitForInputError{
+		ActivityID:         pendingInput.ActivityId,
+		RequiredFields:     []string{"clock_offset"},
+		EnricherProviderID: p.Name(),
+		Metadata:           metadata,
+		Reopen:             true,
+	}
+}
+
+// implausibleReason returns why start can't be a real activity start time,
+// or "" if it is plausible.
+func implausibleReason(start, now time.Time) string {
+	if start.Before(earliestPlausibleStart) {
+		return "start time is before 2000"
+	}
+	if start.After(now.Add(futureTolerance)) {
+		return "start time is in the future"
+	}
+	return ""
+}
+
+// offsetToUploadTime returns the shift that makes the activity end at now.
+func offsetToUploadTime(activity *pbactivity.StandardizedActivity, now time.Time) time.Duration {
+	var elapsed float64
+	for _, session := range activity.Sessions {
+		elapsed += session.TotalElapsedTime
+	}
+	correctedStart := now.Add(-time.Duration(elapsed * float64(time.Second)))
+	return correctedStart.Sub(activity.GetStartTime().AsTime()).Round(time.Second)
+}
+
+func correctedResult(start time.Time, offset time.Duration, reason string) *providers.EnrichmentResult {
+	return &providers.EnrichmentResult{
+		TimeShift: offset,
+		Metadata: map[string]string{
+			"timestamp_status":    "corrected",
+			"detection_reason":    reason,
+			"clock_offset":        offset.String(),
+			"original_start_time": start.Format(time.RFC3339),
+			"corrected_start":     start.Add(offset).Format(time.RFC3339),
+		},
+	}
+}
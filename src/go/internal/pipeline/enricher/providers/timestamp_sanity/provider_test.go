@@ -0,0 +1,196 @@
+package timestamp_sanity
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/user_input"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+var testNow = time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)
+
+func newTestProvider() *TimestampSanityProvider {
+	return &TimestampSanityProvider{now: func() time.Time { return testNow }}
+}
+
+func activityAt(start time.Time, elapsed time.Duration) *pbactivity.StandardizedActivity {
+	return &pbactivity.StandardizedActivity{
+		Source:     pbactivity.ActivitySource_SOURCE_FILE_UPLOAD,
+		ExternalId: "ext-1",
+		StartTime:  timestamppb.New(start),
+		Sessions: []*pbactivity.Session{{
+			StartTime:        timestamppb.New(start),
+			TotalElapsedTime: elapsed.Seconds(),
+		}},
+	}
+}
+
+func TestTimestampSanity_PlausibleStartPasses(t *testing.T) {
+	p := newTestProvider()
+	act := activityAt(testNow.Add(-2*time.Hour), time.Hour)
+
+	res, err := p.Enrich(context.Background(), slog.Default(), act, nil, map[string]string{"activity_id": "a1"}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.TimeShift != 0 {
+		t.Errorf("expected no shift, got %v", res.TimeShift)
+	}
+	if res.Metadata["timestamp_status"] != "ok" {
+		t.Errorf("expected status ok, got %q", res.Metadata["timestamp_status"])
+	}
+}
+
+func TestTimestampSanity_AsksForOffsetByDefault(t *testing.T) {
+	tests := []struct {
+		name       string
+		start      time.Time
+		wantReason string
+	}{
+		{"unset device clock", time.Date(1989, 12, 31, 0, 10, 0, 0, time.UTC), "start time is before 2000"},
+		{"future", testNow.Add(26 * time.Hour), "start time is in the future"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestProvider()
+			act := activityAt(tt.start, 30*time.Minute)
+
+			_, err := p.Enrich(context.Background(), slog.Default(), act, nil, map[string]string{"activity_id": "a1", "pipeline_id": "p1"}, false)
+
+			var waitErr *user_input.WaitForInputError
+			if !errors.As(err, &waitErr) {
+				t.Fatalf("expected WaitForInputError, got %v", err)
+			}
+			if waitErr.EnricherProviderID != "timestamp-sanity" {
+				t.Errorf("unexpected enricher id %q", waitErr.EnricherProviderID)
+			}
+			if len(waitErr.RequiredFields) != 1 || waitErr.RequiredFields[0] != "clock_offset" {
+				t.Errorf("unexpected required fields %v", waitErr.RequiredFields)
+			}
+			if waitErr.Metadata["detection_reason"] != tt.wantReason {
+				t.Errorf("expected reason %q, got %q", tt.wantReason, waitErr.Metadata["detection_reason"])
+			}
+			if waitErr.Metadata["linked_activity_id"] != "a1" {
+				t.Errorf("expected linked activity a1, got %q", waitErr.Metadata["linked_activity_id"])
+			}
+		})
+	}
+}
+
+func TestTimestampSanity_FutureWithinToleranceIsPlausible(t *testing.T) {
+	p := newTestProvider()
+	act := activityAt(testNow.Add(10*time.Minute), time.Minute)
+
+	res, err := p.Enrich(context.Background(), slog.Default(), act, nil, map[string]string{"activity_id": "a1"}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Metadata["timestamp_status"] != "ok" {
+		t.Errorf("expected status ok, got %q", res.Metadata["timestamp_status"])
+	}
+}
+
+func TestTimestampSanity_AutoCorrectsToUploadTime(t *testing.T) {
+	p := newTestProvider()
+	start := time.Date(1989, 12, 31, 0, 0, 0, 0, time.UTC)
+	act := activityAt(start, 45*time.Minute)
+
+	res, err := p.Enrich(context.Background(), slog.Default(), act, nil, map[string]string{"correction": "upload_time"}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The activity should end exactly at upload time
+	wantStart := testNow.Add(-45 * time.Minute)
+	if got := start.Add(res.TimeShift); !got.Equal(wantStart) {
+		t.Errorf("expected corrected start %v, got %v", wantStart, got)
+	}
+	if res.Metadata["timestamp_status"] != "corrected" {
+		t.Errorf("expected status corrected, got %q", res.Metadata["timestamp_status"])
+	}
+}
+
+func TestTimestampSanity_EnrichResume(t *testing.T) {
+	start := time.Date(1989, 12, 31, 0, 0, 0, 0, time.UTC)
+	pending := func(offset string) *pbpipeline.PendingInput {
+		return &pbpipeline.PendingInput{
+			InputData: map[string]string{"clock_offset": offset},
+			ProviderMetadata: map[string]string{
+				"upload_time_offset": "315000h0m0s",
+				"detection_reason":   "start time is before 2000",
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"user offset", "+300000h30m", 300000*time.Hour + 30*time.Minute, false},
+		{"upload keyword", "upload", 315000 * time.Hour, false},
+		{"blank uses upload time", " ", 315000 * time.Hour, false},
+		{"invalid offset", "two hours", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestProvider()
+			res, err := p.EnrichResume(context.Background(), activityAt(start, time.Hour), nil, pending(tt.input))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.TimeShift != tt.want {
+				t.Errorf("expected shift %v, got %v", tt.want, res.TimeShift)
+			}
+		})
+	}
+}
+
+func TestTimestampSanity_EnrichResumeRejectsImplausibleCorrection(t *testing.T) {
+	start := time.Date(1989, 12, 31, 0, 0, 0, 0, time.UTC)
+	pending := &pbpipeline.PendingInput{
+		ActivityId: "pi-1",
+		InputData:  map[string]string{"clock_offset": "+2h30m"},
+		ProviderMetadata: map[string]string{
+			"upload_time_offset": "315000h0m0s",
+			"detection_reason":   "start time is before 2000",
+			"linked_activity_id": "act-1",
+		},
+	}
+
+	p := newTestProvider()
+	res, err := p.EnrichResume(context.Background(), activityAt(start, time.Hour), nil, pending)
+	if res != nil {
+		t.Fatalf("expected no result, got %+v", res)
+	}
+	var waitErr *user_input.WaitForInputError
+	if !errors.As(err, &waitErr) {
+		t.Fatalf("expected WaitForInputError, got %v", err)
+	}
+	if waitErr.ActivityID != "pi-1" || !waitErr.Reopen {
+		t.Errorf("expected pi-1 to be reopened, got %q (reopen %v)", waitErr.ActivityID, waitErr.Reopen)
+	}
+	if waitErr.Metadata["linked_activity_id"] != "act-1" {
+		t.Errorf("expected original metadata to be kept, got %v", waitErr.Metadata)
+	}
+	if waitErr.Metadata["rejected_clock_offset"] != "2h30m0s" {
+		t.Errorf("expected rejected offset 2h30m0s, got %q", waitErr.Metadata["rejected_clock_offset"])
+	}
+	if _, ok := pending.ProviderMetadata["rejected_clock_offset"]; ok {
+		t.Error("expected the pending input's metadata to be left untouched")
+	}
+}
@@ -7,16 +7,25 @@ import (
 	"log/slog"
 	"math"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	activityPkg "github.com/fitglue/server/src/go/pkg/domain/activity"
 
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 
 	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 )
 
+// Heat stress thresholds. Heart rate drifts upwards in the heat, so TRIMP on
+// a hot day overstates the training effect of the same work.
+const (
+	hotAmbientC = 25.0 // average ambient temperature
+	hotCoreC    = 38.5 // peak core body temperature
+)
+
 type TrainingLoad struct {
 	Service *bootstrap.Service
 }
@@ -61,6 +70,13 @@ func (p *TrainingLoad) Enrich(ctx context.Context, logger *slog.Logger, activity
 	if v, ok := inputs["gender"]; ok {
 		gender = v
 	}
+	// Gross efficiency as a percentage, for calories from power
+	efficiency := activityPkg.DefaultEfficiency
+	if v, ok := inputs["efficiency"]; ok {
+		if f, err := strconv.ParseFloat(v, 64); err == nil && f >= 10 && f <= 35 {
+			efficiency = f / 100
+		}
+	}
 
 	b := 1.92
 	if gender == "female" {
@@ -118,7 +134,11 @@ func (p *TrainingLoad) Enrich(ctx context.Context, logger *slog.Logger, activity
 		}
 	}
 
-	if totalTRIMP == 0 {
+	// Mechanical work from power, which gives a better calorie figure than
+	// the device's HR-based estimate
+	work := activityPkg.MechanicalWork(activity)
+
+	if totalTRIMP == 0 && work == 0 {
 		return &providers.EnrichmentResult{
 			Metadata: map[string]string{
 				"training_load_status": "skipped",
@@ -127,19 +147,74 @@ func (p *TrainingLoad) Enrich(ctx context.Context, logger *slog.Logger, activity
 		}, nil
 	}
 
-	zone := getTrainingLoadZone(totalTRIMP)
-	summaryText := fmt.Sprintf("💪 Training Load: %.0f (%s)", totalTRIMP, zone)
-
-	logger.Info("Training Load calculated", "trimp", totalTRIMP, "zone", zone)
-
-	return &providers.EnrichmentResult{
-		Description: summaryText,
+	result := &providers.EnrichmentResult{
 		Metadata: map[string]string{
 			"training_load_status": "success",
-			"trimp":                fmt.Sprintf("%.0f", totalTRIMP),
-			"trimp_zone":           zone,
 		},
-	}, nil
+	}
+	var lines []string
+
+	if totalTRIMP > 0 {
+		zone := getTrainingLoadZone(totalTRIMP)
+		lines = append(lines, fmt.Sprintf("💪 Training Load: %.0f (%s)", totalTRIMP, zone))
+		result.Metadata["trimp"] = fmt.Sprintf("%.0f", totalTRIMP)
+		result.Metadata["trimp_zone"] = zone
+		logger.Info("Training Load calculated", "trimp", totalTRIMP, "zone", zone)
+	}
+
+	if work > 0 {
+		kcal := activityPkg.CaloriesFromWork(work, efficiency)
+		lines = append(lines, fmt.Sprintf("⚡ Work: %.0f kJ • %.0f kcal", work/1000, kcal))
+		result.TotalWork = &work
+		result.TotalCalories = &kcal
+		result.Metadata["work_kj"] = fmt.Sprintf("%.0f", work/1000)
+		result.Metadata["power_calories"] = fmt.Sprintf("%.0f", kcal)
+		result.Metadata["efficiency"] = fmt.Sprintf("%.0f%%", efficiency*100)
+		logger.Info("Work calculated from power", "work_kj", work/1000, "kcal", kcal, "efficiency", efficiency)
+	}
+
+	avgAmbient, peakCore := temperatures(activity)
+	if totalTRIMP > 0 && (avgAmbient >= hotAmbientC || peakCore >= hotCoreC) {
+		var parts []string
+		if avgAmbient >= hotAmbientC {
+			parts = append(parts, fmt.Sprintf("%.0f°C avg", avgAmbient))
+			result.Metadata["avg_temperature"] = fmt.Sprintf("%.0f", avgAmbient)
+		}
+		if peakCore > 0 {
+			parts = append(parts, fmt.Sprintf("core peak %.1f°C", peakCore))
+			result.Metadata["peak_core_temperature"] = fmt.Sprintf("%.1f", peakCore)
+		}
+		lines = append(lines, fmt.Sprintf("🌡️ Heat stress: %s (heart rate runs higher in the heat)", strings.Join(parts, " • ")))
+		result.Metadata["heat_stress"] = "true"
+		logger.Info("Heat stress noted", "avg_temperature", avgAmbient, "peak_core_temperature", peakCore)
+	}
+
+	result.Description = strings.Join(lines, "\n")
+	return result, nil
+}
+
+// temperatures returns the average ambient and peak core body temperature
+// over the activity's records, each 0 when the stream is missing.
+func temperatures(activity *pbactivity.StandardizedActivity) (avgAmbient, peakCore float64) {
+	var sum float64
+	var count int
+	for _, session := range activity.Sessions {
+		for _, lap := range session.Laps {
+			for _, record := range lap.Records {
+				if record.Temperature != nil {
+					sum += float64(*record.Temperature)
+					count++
+				}
+				if record.CoreTemperature != nil && *record.CoreTemperature > peakCore {
+					peakCore = *record.CoreTemperature
+				}
+			}
+		}
+	}
+	if count > 0 {
+		avgAmbient = sum / float64(count)
+	}
+	return avgAmbient, peakCore
 }
 
 func getTrainingLoadZone(trimp float64) string {
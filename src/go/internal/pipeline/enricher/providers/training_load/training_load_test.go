@@ -8,6 +8,7 @@ import (
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 
 	"context"
+	"fmt"
 	"log/slog"
 	"strings"
 	"testing"
@@ -124,3 +125,134 @@ func TestTrainingLoad_Enrich_NoData(t *testing.T) {
 		t.Errorf("Expected skipped, got %s", result.Metadata["training_load_status"])
 	}
 }
+
+func TestTrainingLoad_Enrich_Power(t *testing.T) {
+	now := time.Now()
+	// 20 minutes at 250W, one record per second, half of them with HR
+	var records []*pbactivity.Record
+	for i := 0; i <= 1200; i++ {
+		r := &pbactivity.Record{
+			Timestamp: timestamppb.New(now.Add(time.Duration(i) * time.Second)),
+			Power:     250,
+		}
+		if i%2 == 0 {
+			r.HeartRate = 150
+		}
+		records = append(records, r)
+	}
+	deviceCalories := 400.0
+	activity := &pbactivity.StandardizedActivity{
+		Sessions: []*pbactivity.Session{{
+			TotalCalories: &deviceCalories,
+			Laps:          []*pbactivity.Lap{{Records: records}},
+		}},
+	}
+
+	tests := []struct {
+		name       string
+		inputs     map[string]string
+		wantKcal   string
+		wantInDesc string
+	}{
+		{"default efficiency", map[string]string{}, "299", "⚡ Work: 300 kJ • 299 kcal"},
+		{"custom efficiency", map[string]string{"efficiency": "20"}, "359", "⚡ Work: 300 kJ • 359 kcal"},
+		{"out of range efficiency", map[string]string{"efficiency": "80"}, "299", "⚡ Work: 300 kJ • 299 kcal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewTrainingLoad().Enrich(context.Background(), slog.Default(), activity, &user.Record{}, tt.inputs, false)
+			if err != nil {
+				t.Fatalf("Enrich failed: %v", err)
+			}
+			if !strings.Contains(result.Description, "💪 Training Load:") || !strings.Contains(result.Description, tt.wantInDesc) {
+				t.Errorf("Expected TRIMP and %q, got:\n%s", tt.wantInDesc, result.Description)
+			}
+			if result.Metadata["work_kj"] != "300" || result.Metadata["power_calories"] != tt.wantKcal {
+				t.Errorf("Unexpected metadata: %v", result.Metadata)
+			}
+			if result.TotalWork == nil || *result.TotalWork != 300000 {
+				t.Errorf("Expected TotalWork 300000 J, got %v", result.TotalWork)
+			}
+			if result.TotalCalories == nil || fmt.Sprintf("%.0f", *result.TotalCalories) != tt.wantKcal {
+				t.Errorf("Expected TotalCalories %s kcal, got %v", tt.wantKcal, result.TotalCalories)
+			}
+		})
+	}
+}
+
+func TestTrainingLoad_Enrich_PowerOnly(t *testing.T) {
+	now := time.Now()
+	var records []*pbactivity.Record
+	for i := 0; i <= 600; i++ {
+		records = append(records, &pbactivity.Record{
+			Timestamp: timestamppb.New(now.Add(time.Duration(i) * time.Second)),
+			Power:     200,
+		})
+	}
+	activity := &pbactivity.StandardizedActivity{
+		Sessions: []*pbactivity.Session{{Laps: []*pbactivity.Lap{{Records: records}}}},
+	}
+
+	result, err := NewTrainingLoad().Enrich(context.Background(), slog.Default(), activity, &user.Record{}, nil, false)
+	if err != nil {
+		t.Fatalf("Enrich failed: %v", err)
+	}
+	if result.Metadata["training_load_status"] != "success" || result.Metadata["trimp"] != "" {
+		t.Errorf("Expected success without TRIMP, got %v", result.Metadata)
+	}
+	if result.Description != "⚡ Work: 120 kJ • 120 kcal" {
+		t.Errorf("Unexpected description: %q", result.Description)
+	}
+}
+
+func TestTrainingLoad_Enrich_HeatStress(t *testing.T) {
+	tests := []struct {
+		name    string
+		ambient int32
+		core    float64
+		want    string
+	}{
+		{"hot ambient", 31, 0, "🌡️ Heat stress: 31°C avg (heart rate runs higher in the heat)"},
+		{"hot core", 18, 38.9, "🌡️ Heat stress: core peak 38.9°C (heart rate runs higher in the heat)"},
+		{"both", 30, 38.2, "🌡️ Heat stress: 30°C avg • core peak 38.2°C (heart rate runs higher in the heat)"},
+		{"mild", 20, 38.0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			var records []*pbactivity.Record
+			for i := 0; i < 11; i++ {
+				ambient := tt.ambient
+				rec := &pbactivity.Record{
+					Timestamp:   timestamppb.New(now.Add(time.Duration(i) * time.Minute)),
+					HeartRate:   150,
+					Temperature: &ambient,
+				}
+				if tt.core > 0 {
+					core := tt.core - 0.1*float64(10-i) // rising to the peak
+					rec.CoreTemperature = &core
+				}
+				records = append(records, rec)
+			}
+			activity := &pbactivity.StandardizedActivity{
+				Sessions: []*pbactivity.Session{{Laps: []*pbactivity.Lap{{Records: records}}}},
+			}
+
+			result, err := NewTrainingLoad().Enrich(context.Background(), slog.Default(), activity, &user.Record{}, map[string]string{}, false)
+			if err != nil {
+				t.Fatalf("Enrich failed: %v", err)
+			}
+			if tt.want == "" {
+				if strings.Contains(result.Description, "Heat stress") || result.Metadata["heat_stress"] != "" {
+					t.Errorf("Expected no heat note, got %q", result.Description)
+				}
+				return
+			}
+			if !strings.Contains(result.Description, tt.want) || result.Metadata["heat_stress"] != "true" {
+				t.Errorf("Expected %q in description, got %q", tt.want, result.Description)
+			}
+		})
+	}
+}
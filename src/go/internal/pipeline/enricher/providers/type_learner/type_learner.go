@@ -0,0 +1,92 @@
+package type_learner
+
+import (
+	"context"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	"log/slog"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/activity"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// TypeLearnerProvider applies the activity type rules learned from the
+// user's own type corrections. It is a core provider: the orchestrator runs
+// it before the pipeline's enrichers, so a configured type mapper still wins.
+type TypeLearnerProvider struct {
+	Service *bootstrap.Service
+}
+
+func init() {
+	providers.Register(NewTypeLearnerProvider())
+}
+
+func NewTypeLearnerProvider() *TypeLearnerProvider {
+	return &TypeLearnerProvider{}
+}
+
+// SetService sets the bootstrap service for Firestore access
+func (p *TypeLearnerProvider) SetService(service *bootstrap.Service) {
+	p.Service = service
+}
+
+func (p *TypeLearnerProvider) Name() string {
+	return "type-learner"
+}
+
+func (p *TypeLearnerProvider) ProviderType() pbplugin.EnricherProviderType {
+	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_UNSPECIFIED
+}
+
+// Enrich expects the activity source name (e.g. "SOURCE_HEVY") in
+// inputConfig["source"], since learned rules are per source.
+func (p *TypeLearnerProvider) Enrich(ctx context.Context, logger *slog.Logger, act *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+	if p.Service == nil || p.Service.DB == nil || user == nil || user.UserProfile == nil {
+		return &providers.EnrichmentResult{
+			Metadata: map[string]string{"status": "skipped", "reason": "no_database"},
+		}, nil
+	}
+
+	rules, err := p.Service.DB.ListActivityTypeRules(ctx, user.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := activity.MatchTypeRule(rules, inputConfig["source"], act.Name)
+	if rule == nil {
+		logger.Debug("type_learner: no learned rule matches", "rule_count", len(rules), "title", act.Name)
+		return &providers.EnrichmentResult{
+			Metadata: map[string]string{"status": "skipped", "reason": "no_matching_rule"},
+		}, nil
+	}
+	if rule.TargetType == act.Type {
+		return &providers.EnrichmentResult{
+			Metadata: map[string]string{"status": "skipped", "reason": "type_already_matches", "rule_id": rule.Id},
+		}, nil
+	}
+
+	if err := p.Service.DB.MarkActivityTypeRuleApplied(ctx, user.UserId, rule.Id); err != nil {
+		logger.Warn("type_learner: failed to record rule use", "rule_id", rule.Id, "error", err)
+	}
+
+	logger.Debug("type_learner: applying learned type",
+		"rule_id", rule.Id,
+		"pattern", rule.TitlePattern,
+		"original_type", act.Type.String(),
+		"new_type", rule.TargetType.String(),
+	)
+
+	return &providers.EnrichmentResult{
+		ActivityType: rule.TargetType,
+		Metadata: map[string]string{
+			"rule_id":         rule.Id,
+			"matched_pattern": rule.TitlePattern,
+			"original_type":   activity.GetStravaActivityType(act.Type),
+			"new_type":        activity.GetStravaActivityType(rule.TargetType),
+		},
+	}, nil
+}
@@ -0,0 +1,93 @@
+package type_learner
+
+import (
+	user "github.com/fitglue/server/src/go/pkg/domain/user"
+
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/activity"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+)
+
+func TestTypeLearnerProvider_Enrich(t *testing.T) {
+	ctx := context.Background()
+	rules := []*pbpipeline.ActivityTypeRule{
+		{Id: "confident", Source: "SOURCE_HEVY", TitlePattern: "hyrox sim", TargetType: pbactivity.ActivityType_ACTIVITY_TYPE_CROSSFIT, CorrectionCount: activity.TypeRuleLearnThreshold},
+		{Id: "tentative", Source: "SOURCE_HEVY", TitlePattern: "leg day", TargetType: pbactivity.ActivityType_ACTIVITY_TYPE_CROSSFIT, CorrectionCount: 1},
+		{Id: "disabled", Source: "SOURCE_HEVY", TitlePattern: "wod", TargetType: pbactivity.ActivityType_ACTIVITY_TYPE_CROSSFIT, CorrectionCount: 5, Disabled: true},
+	}
+
+	tests := []struct {
+		name     string
+		title    string
+		source   string
+		wantType pbactivity.ActivityType
+		wantRule string
+	}{
+		{"applies confident rule", "Hyrox Sim #4", "SOURCE_HEVY", pbactivity.ActivityType_ACTIVITY_TYPE_CROSSFIT, "confident"},
+		{"ignores other sources", "Hyrox Sim #4", "SOURCE_STRAVA", pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED, ""},
+		{"ignores rules below threshold", "Leg Day", "SOURCE_HEVY", pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED, ""},
+		{"ignores disabled rules", "Morning WOD", "SOURCE_HEVY", pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED, ""},
+		{"requires whole words", "Hyrox Simulation", "SOURCE_HEVY", pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var applied []string
+			mockDB := &mocks.MockDatabase{
+				ListActivityTypeRulesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.ActivityTypeRule, error) {
+					return rules, nil
+				},
+			}
+			provider := NewTypeLearnerProvider()
+			provider.SetService(&bootstrap.Service{DB: &markingDB{MockDatabase: mockDB, applied: &applied}})
+
+			act := &pbactivity.StandardizedActivity{Name: tt.title, Type: pbactivity.ActivityType_ACTIVITY_TYPE_WORKOUT}
+			u := &user.Record{UserProfile: &pbuser.UserProfile{UserId: "u1"}}
+
+			res, err := provider.Enrich(ctx, slog.Default(), act, u, map[string]string{"source": tt.source}, false)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.ActivityType != tt.wantType {
+				t.Errorf("ActivityType = %v, want %v", res.ActivityType, tt.wantType)
+			}
+			if res.Metadata["rule_id"] != tt.wantRule {
+				t.Errorf("rule_id = %q, want %q", res.Metadata["rule_id"], tt.wantRule)
+			}
+			if (tt.wantRule != "") != (len(applied) == 1) {
+				t.Errorf("unexpected applied marks: %v", applied)
+			}
+		})
+	}
+}
+
+func TestTypeLearnerProvider_NoService(t *testing.T) {
+	res, err := NewTypeLearnerProvider().Enrich(context.Background(), slog.Default(), &pbactivity.StandardizedActivity{Name: "Hyrox Sim"}, nil, nil, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Metadata["status"] != "skipped" {
+		t.Errorf("expected skipped result, got %v", res.Metadata)
+	}
+}
+
+// markingDB records MarkActivityTypeRuleApplied calls.
+type markingDB struct {
+	*mocks.MockDatabase
+	applied *[]string
+}
+
+func (m *markingDB) MarkActivityTypeRuleApplied(ctx context.Context, userId string, ruleId string) error {
+	*m.applied = append(*m.applied, ruleId)
+	return nil
+}
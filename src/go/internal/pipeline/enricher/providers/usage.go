@@ -0,0 +1,111 @@
+package providers
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/tier"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+)
+
+// Provider metadata keys surfacing a metered feature's monthly quota, so the
+// UI can show what's left.
+const (
+	MetadataQuotaFeature   = "quota_feature"
+	MetadataQuotaLimit     = "quota_limit"
+	MetadataQuotaRemaining = "quota_remaining"
+)
+
+// Quota is a user's allowance of a metered feature this month.
+type Quota struct {
+	Feature tier.Feature
+	Limit   int64
+	Used    int64
+
+	allowed bool
+	reason  string
+	period  string
+	userID  string
+	db      shared.Database
+	logger  *slog.Logger
+}
+
+// CheckQuota reads how much of feature the user has used this month. Without
+// a database, as in unit tests, nothing has been used. A failed read is
+// logged and also counts as nothing used, so metering never fails a run.
+func CheckQuota(ctx context.Context, logger *slog.Logger, svc *bootstrap.Service, user *user.Record, feature tier.Feature) *Quota {
+	q := &Quota{
+		Feature: feature,
+		Limit:   tier.Quota(user, feature),
+		period:  tier.UsagePeriod(time.Now()),
+		userID:  user.UserId,
+		logger:  logger,
+	}
+	if svc != nil && svc.DB != nil {
+		q.db = svc.DB
+		usage, err := q.db.GetUsage(ctx, q.userID, q.period)
+		if err != nil {
+			logger.Warn("Failed to read feature usage", "feature", feature, "error", err)
+		}
+		q.Used = usage[string(feature)]
+	}
+	q.allowed, _, q.reason = tier.CheckUsage(user, feature, q.Used)
+	return q
+}
+
+// Allowed reports whether any of the quota remains.
+func (q *Quota) Allowed() bool {
+	return q.allowed
+}
+
+// Remaining is how much of the quota is left, never negative.
+func (q *Quota) Remaining() int64 {
+	if q.Used >= q.Limit {
+		return 0
+	}
+	return q.Limit - q.Used
+}
+
+// Record adds amount to the user's usage this month. Previews record nothing:
+// the dry-run database drops the write.
+func (q *Quota) Record(ctx context.Context, amount int64) {
+	if amount <= 0 {
+		return
+	}
+	q.Used += amount
+	if q.db == nil {
+		return
+	}
+	if err := q.db.IncrementUsage(ctx, q.userID, q.period, string(q.Feature), amount); err != nil {
+		q.logger.Warn("Failed to record feature usage", "feature", q.Feature, "amount", amount, "error", err)
+	}
+}
+
+// AddMetadata sets the quota metadata keys on m and returns it.
+func (q *Quota) AddMetadata(m map[string]string) map[string]string {
+	if m == nil {
+		m = map[string]string{}
+	}
+	m[MetadataQuotaFeature] = string(q.Feature)
+	m[MetadataQuotaLimit] = strconv.FormatInt(q.Limit, 10)
+	m[MetadataQuotaRemaining] = strconv.FormatInt(q.Remaining(), 10)
+	return m
+}
+
+// ExceededResult is the result of a provider skipped because the quota is
+// used up. The rest of the run carries on.
+func (q *Quota) ExceededResult() *EnrichmentResult {
+	return &EnrichmentResult{
+		Skipped:    true,
+		SkipReason: q.reason,
+		Metadata: q.AddMetadata(map[string]string{
+			"status":        "skipped",
+			"reason":        "quota_exceeded",
+			"status_detail": q.reason,
+		}),
+	}
+}
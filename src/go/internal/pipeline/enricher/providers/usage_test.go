@@ -0,0 +1,82 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/tier"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+func TestQuota(t *testing.T) {
+	athlete := &user.Record{UserProfile: &pbuser.UserProfile{UserId: "u1", Tier: pbuser.UserTier_USER_TIER_ATHLETE}}
+
+	recorded := map[string]int64{}
+	db := &mocks.MockDatabase{
+		GetUsageFunc: func(ctx context.Context, userID string, period string) (map[string]int64, error) {
+			return map[string]int64{string(tier.FeatureAIBanner): tier.AthleteTierAIBannersPerMonth - 1}, nil
+		},
+		IncrementUsageFunc: func(ctx context.Context, userID string, period string, feature string, amount int64) error {
+			if userID != "u1" || len(period) != len("2006-01") {
+				t.Errorf("IncrementUsage(%q, %q)", userID, period)
+			}
+			recorded[feature] += amount
+			return nil
+		},
+	}
+	svc := &bootstrap.Service{DB: db}
+
+	q := CheckQuota(context.Background(), slog.Default(), svc, athlete, tier.FeatureAIBanner)
+	if !q.Allowed() || q.Remaining() != 1 {
+		t.Fatalf("Expected one banner left, got allowed=%v remaining=%d", q.Allowed(), q.Remaining())
+	}
+
+	q.Record(context.Background(), 1)
+	if recorded[string(tier.FeatureAIBanner)] != 1 {
+		t.Errorf("Expected usage to be recorded, got %v", recorded)
+	}
+	meta := q.AddMetadata(nil)
+	if meta[MetadataQuotaRemaining] != "0" || meta[MetadataQuotaLimit] != "60" || meta[MetadataQuotaFeature] != "ai_banner_generations" {
+		t.Errorf("Unexpected quota metadata %v", meta)
+	}
+
+	q = CheckQuota(context.Background(), slog.Default(), &bootstrap.Service{DB: &mocks.MockDatabase{
+		GetUsageFunc: func(ctx context.Context, userID string, period string) (map[string]int64, error) {
+			return map[string]int64{string(tier.FeatureAIBanner): tier.AthleteTierAIBannersPerMonth}, nil
+		},
+	}}, athlete, tier.FeatureAIBanner)
+	if q.Allowed() {
+		t.Fatal("Expected quota to be used up")
+	}
+	res := q.ExceededResult()
+	if !res.Skipped || res.Metadata["reason"] != "quota_exceeded" || res.Metadata[MetadataQuotaRemaining] != "0" {
+		t.Errorf("Unexpected exceeded result %+v", res)
+	}
+}
+
+func TestQuota_UnreadableUsage(t *testing.T) {
+	athlete := &user.Record{UserProfile: &pbuser.UserProfile{UserId: "u1", Tier: pbuser.UserTier_USER_TIER_ATHLETE}}
+	db := &mocks.MockDatabase{
+		GetUsageFunc: func(ctx context.Context, userID string, period string) (map[string]int64, error) {
+			return nil, errors.New("unavailable")
+		},
+	}
+
+	// Metering failures don't block the feature
+	q := CheckQuota(context.Background(), slog.Default(), &bootstrap.Service{DB: db}, athlete, tier.FeatureMapRender)
+	if !q.Allowed() || q.Remaining() != tier.AthleteTierMapRendersPerMonth {
+		t.Errorf("Expected full quota, got allowed=%v remaining=%d", q.Allowed(), q.Remaining())
+	}
+
+	// Nor does having no database
+	q = CheckQuota(context.Background(), slog.Default(), nil, athlete, tier.FeatureMapRender)
+	q.Record(context.Background(), 1)
+	if !q.Allowed() || q.Used != 1 {
+		t.Errorf("Expected unmetered quota, got %+v", q)
+	}
+}
@@ -28,6 +28,7 @@ type WaitForInputError struct {
 	RequiredFields     []string
 	Metadata           map[string]string // Optional metadata to store with pending input (e.g., lap info)
 	EnricherProviderID string            // The enricher that created this pending input
+	Reopen             bool              // Replace a completed pending input with the same ID (the answer was rejected)
 }
 
 func (e *WaitForInputError) Error() string {
@@ -58,6 +59,13 @@ func (p *UserInputProvider) ProviderType() pbplugin.EnricherProviderType {
 	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_USER_INPUT
 }
 
+// SkipOnSourceUpdate keeps the title and description the user gave before
+// from overwriting the edit they just made on the source platform.
+func (p *UserInputProvider) SkipOnSourceUpdate() bool { return true }
+
+// IsEssential keeps user input prompts running past the execution budget.
+func (p *UserInputProvider) IsEssential() bool { return true }
+
 func (p *UserInputProvider) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
 	stableID := pendinginput.GenerateID(activity.Source.String(), activity.ExternalId, p.Name())
 
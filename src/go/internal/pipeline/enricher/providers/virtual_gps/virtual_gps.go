@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/domain/streams"
 
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 
@@ -32,6 +33,9 @@ func (p *VirtualGPSProvider) ProviderType() pbplugin.EnricherProviderType {
 	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_VIRTUAL_GPS
 }
 
+// SkipOnSourceUpdate keeps metadata edits from redrawing the route.
+func (p *VirtualGPSProvider) SkipOnSourceUpdate() bool { return true }
+
 func (p *VirtualGPSProvider) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
 	logger.Debug("virtual_gps: starting",
 		"activity_type", activity.Type.String(),
@@ -182,6 +186,7 @@ func (p *VirtualGPSProvider) Enrich(ctx context.Context, logger *slog.Logger, ac
 	return &providers.EnrichmentResult{
 		PositionLatStream:  latStream,
 		PositionLongStream: longStream,
+		PositionSensor:     streams.SensorEstimated,
 		Description:        fmt.Sprintf("🗺️ Took a virtual tour of %s (GPS generated for this indoor workout)\n", route.Name),
 		Metadata: map[string]string{
 			"virtual_gps_route": route.Name,
@@ -14,6 +14,7 @@ import (
 
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/cost"
 
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 
@@ -44,6 +45,12 @@ func (p *Weather) ProviderType() pbplugin.EnricherProviderType {
 	return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER
 }
 
+// ShareResult lets the other pipelines of a fan-out reuse a successful
+// lookup; weather at the activity's start doesn't depend on the pipeline.
+func (p *Weather) ShareResult(res *providers.EnrichmentResult) bool {
+	return res.Metadata["weather_status"] == "success"
+}
+
 func (p *Weather) Enrich(ctx context.Context, logger *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputs map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
 	// Extract GPS coordinates from first record
 	var latitude, longitude float64
@@ -96,6 +103,7 @@ func (p *Weather) Enrich(ctx context.Context, logger *slog.Logger, activity *pba
 	)
 
 	resp, err := http.Get(url)
+	cost.Record(ctx, cost.Usage{ExternalAPICalls: 1})
 	if err != nil {
 		logger.Error("Failed to fetch weather data", "error", err)
 		return nil, &providers.RetryableError{Err: fmt.Errorf("weather API request failed: %w", err)}
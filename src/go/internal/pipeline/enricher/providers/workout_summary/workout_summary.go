@@ -3,6 +3,7 @@ package workout_summary
 import (
 	"context"
 	"fmt"
+	"github.com/fitglue/server/src/go/pkg/description"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
 	"log/slog"
 	"strings"
@@ -137,7 +138,7 @@ func (p *WorkoutSummaryProvider) Enrich(ctx context.Context, logger *slog.Logger
 	}
 
 	var sb strings.Builder
-	sb.WriteString("📋 Workout Summary:\n")
+	sb.WriteString(providers.SectionHeader(user, description.SectionWorkoutSummary) + "\n")
 
 	if showStats {
 		var statParts []string
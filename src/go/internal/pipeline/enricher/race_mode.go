@@ -0,0 +1,76 @@
+package enricher
+
+import (
+	"time"
+
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+// raceModeActive reports whether rm is enabled and start falls inside its
+// [starts_at, ends_at) window. A window missing either bound is never active.
+func raceModeActive(rm *pbpipeline.RaceModeConfig, start time.Time) bool {
+	if rm == nil || !rm.Enabled || rm.StartsAt == nil || rm.EndsAt == nil {
+		return false
+	}
+	return !start.Before(rm.StartsAt.AsTime()) && start.Before(rm.EndsAt.AsTime())
+}
+
+// applyRaceMode switches p to its race-mode setup: the alternate enricher set
+// replaces the pipeline's (when one is configured), destination config keys
+// become overrides applied on top of the resolved destination config, and
+// per-destination enricher exclusions and description templates replace the
+// pipeline's.
+func applyRaceMode(p *configuredPipeline, rm *pbpipeline.RaceModeConfig) {
+	p.RaceMode = true
+	p.SkipBranding = rm.SkipBranding
+
+	if len(rm.Enrichers) > 0 {
+		p.Enrichers = nil
+		for _, e := range rm.Enrichers {
+			p.Enrichers = append(p.Enrichers, configuredEnricher{
+				ProviderType: e.ProviderType,
+				TypedConfig:  e.TypedConfig,
+				Timeout:      time.Duration(e.TimeoutSeconds) * time.Second,
+			})
+		}
+	}
+
+	if len(rm.DestinationConfigs) == 0 {
+		return
+	}
+
+	// Copy so the pipeline's own map (shared with the resolved config) isn't mutated
+	destConfigs := make(map[string]*pbpipeline.DestinationConfig, len(p.DestinationConfigs))
+	for destId, cfg := range p.DestinationConfigs {
+		destConfigs[destId] = cfg
+	}
+	p.DestinationOverrides = make(map[string]map[string]string)
+	for destId, override := range rm.DestinationConfigs {
+		if override == nil {
+			continue
+		}
+		if len(override.Config) > 0 {
+			p.DestinationOverrides[destId] = override.Config
+		}
+		if len(override.ExcludedEnrichers) > 0 || override.DescriptionTemplate != "" || override.TitleTemplate != "" {
+			merged := &pbpipeline.DestinationConfig{}
+			if base := destConfigs[destId]; base != nil {
+				merged.Config = base.Config
+				merged.ExcludedEnrichers = base.ExcludedEnrichers
+				merged.DescriptionTemplate = base.DescriptionTemplate
+				merged.TitleTemplate = base.TitleTemplate
+			}
+			if len(override.ExcludedEnrichers) > 0 {
+				merged.ExcludedEnrichers = override.ExcludedEnrichers
+			}
+			if override.DescriptionTemplate != "" {
+				merged.DescriptionTemplate = override.DescriptionTemplate
+			}
+			if override.TitleTemplate != "" {
+				merged.TitleTemplate = override.TitleTemplate
+			}
+			destConfigs[destId] = merged
+		}
+	}
+	p.DestinationConfigs = destConfigs
+}
@@ -0,0 +1,237 @@
+package enricher
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+var (
+	raceStart = time.Date(2026, 4, 25, 0, 0, 0, 0, time.UTC)
+	raceEnd   = time.Date(2026, 4, 27, 0, 0, 0, 0, time.UTC)
+)
+
+func TestRaceModeActive(t *testing.T) {
+	window := &pbpipeline.RaceModeConfig{
+		Enabled:  true,
+		StartsAt: timestamppb.New(raceStart),
+		EndsAt:   timestamppb.New(raceEnd),
+	}
+
+	tests := []struct {
+		name  string
+		rm    *pbpipeline.RaceModeConfig
+		start time.Time
+		want  bool
+	}{
+		{"nil config", nil, raceStart, false},
+		{"inside window", window, raceStart.Add(9 * time.Hour), true},
+		{"window start is inclusive", window, raceStart, true},
+		{"window end is exclusive", window, raceEnd, false},
+		{"before window", window, raceStart.Add(-time.Minute), false},
+		{"disabled", &pbpipeline.RaceModeConfig{StartsAt: window.StartsAt, EndsAt: window.EndsAt}, raceStart.Add(time.Hour), false},
+		{"open-ended window", &pbpipeline.RaceModeConfig{Enabled: true, StartsAt: window.StartsAt}, raceStart.Add(time.Hour), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := raceModeActive(tt.rm, tt.start); got != tt.want {
+				t.Errorf("raceModeActive() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyRaceMode(t *testing.T) {
+	baseConfigs := map[string]*pbpipeline.DestinationConfig{
+		"strava": {Config: map[string]string{"visibility": "followers"}},
+	}
+	p := &configuredPipeline{
+		Enrichers: []configuredEnricher{
+			{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER},
+		},
+		DestinationConfigs: baseConfigs,
+	}
+
+	applyRaceMode(p, &pbpipeline.RaceModeConfig{
+		Enrichers: []*pbpipeline.EnricherConfig{
+			{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_AI_COMPANION, TypedConfig: map[string]string{"style": "race_report"}},
+		},
+		DestinationConfigs: map[string]*pbpipeline.DestinationConfig{
+			"hevy":   {Config: map[string]string{"is_private": "false"}},
+			"strava": {ExcludedEnrichers: []string{"ENRICHER_PROVIDER_AI_COMPANION"}},
+		},
+		SkipBranding: true,
+	})
+
+	if !p.RaceMode || !p.SkipBranding {
+		t.Errorf("Expected race mode with branding skipped, got RaceMode=%v SkipBranding=%v", p.RaceMode, p.SkipBranding)
+	}
+	if len(p.Enrichers) != 1 || p.Enrichers[0].ProviderType != pbplugin.EnricherProviderType_ENRICHER_PROVIDER_AI_COMPANION {
+		t.Errorf("Expected race mode enrichers to replace the pipeline's, got %v", p.Enrichers)
+	}
+	if p.DestinationOverrides["hevy"]["is_private"] != "false" {
+		t.Errorf("Expected hevy override, got %v", p.DestinationOverrides)
+	}
+	strava := p.DestinationConfigs["strava"]
+	if strava.Config["visibility"] != "followers" || len(strava.ExcludedEnrichers) != 1 {
+		t.Errorf("Expected strava exclusions merged over its config, got %+v", strava)
+	}
+	if len(baseConfigs["strava"].ExcludedEnrichers) != 0 {
+		t.Error("Expected the pipeline's own destination configs to be left untouched")
+	}
+}
+
+func TestApplyRaceMode_KeepsEnrichersWhenNoneConfigured(t *testing.T) {
+	p := &configuredPipeline{
+		Enrichers: []configuredEnricher{{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER}},
+	}
+	applyRaceMode(p, &pbpipeline.RaceModeConfig{Enabled: true, SkipBranding: true})
+
+	if len(p.Enrichers) != 1 || p.Enrichers[0].ProviderType != pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER {
+		t.Errorf("Expected pipeline enrichers to be kept, got %v", p.Enrichers)
+	}
+	if p.DestinationOverrides != nil {
+		t.Errorf("Expected no destination overrides, got %v", p.DestinationOverrides)
+	}
+}
+
+func TestApplyRaceMode_DescriptionTemplate(t *testing.T) {
+	p := &configuredPipeline{
+		DestinationConfigs: map[string]*pbpipeline.DestinationConfig{
+			"strava": {ExcludedEnrichers: []string{"ENRICHER_PROVIDER_WEATHER"}, DescriptionTemplate: "{{.Sections}}", TitleTemplate: "{{.Title}}"},
+		},
+	}
+	applyRaceMode(p, &pbpipeline.RaceModeConfig{
+		Enabled: true,
+		DestinationConfigs: map[string]*pbpipeline.DestinationConfig{
+			"strava": {DescriptionTemplate: "🏁 {{.Original}}"},
+		},
+	})
+
+	strava := p.DestinationConfigs["strava"]
+	if strava.DescriptionTemplate != "🏁 {{.Original}}" {
+		t.Errorf("Expected race mode template, got %q", strava.DescriptionTemplate)
+	}
+	if len(strava.ExcludedEnrichers) != 1 {
+		t.Errorf("Expected the pipeline's exclusions to be kept, got %v", strava.ExcludedEnrichers)
+	}
+	if strava.TitleTemplate != "{{.Title}}" {
+		t.Errorf("Expected the pipeline's title template to be kept, got %q", strava.TitleTemplate)
+	}
+}
+
+func TestOrchestrator_RaceMode(t *testing.T) {
+	ctx := context.Background()
+
+	mockDB := &MockDatabase{
+		GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+			return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+		},
+		GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+			return []*pbpipeline.PipelineConfig{
+				{
+					Id:           "p1",
+					Source:       "SOURCE_STRAVA",
+					Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_HEVY},
+					Enrichers: []*pbpipeline.EnricherConfig{
+						{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER},
+					},
+					DestinationConfigs: map[string]*pbpipeline.DestinationConfig{
+						"hevy": {Config: map[string]string{"is_private": "true"}},
+					},
+					RaceMode: &pbpipeline.RaceModeConfig{
+						Enabled:  true,
+						StartsAt: timestamppb.New(raceStart),
+						EndsAt:   timestamppb.New(raceEnd),
+						Enrichers: []*pbpipeline.EnricherConfig{
+							{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_AI_COMPANION},
+						},
+						DestinationConfigs: map[string]*pbpipeline.DestinationConfig{
+							"hevy": {Config: map[string]string{"is_private": "false"}},
+						},
+						SkipBranding: true,
+					},
+				},
+			}, nil
+		},
+	}
+
+	ran := map[string]bool{}
+	mockProvider := func(name string, pt pbplugin.EnricherProviderType) *MockProvider {
+		return &MockProvider{
+			NameFunc:         func() string { return name },
+			ProviderTypeFunc: func() pbplugin.EnricherProviderType { return pt },
+			EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+				ran[name] = true
+				return &providers.EnrichmentResult{Description: name}, nil
+			},
+		}
+	}
+
+	orchestrator := NewOrchestrator(mockDB, &MockBlobStore{}, "test-bucket", nil)
+	orchestrator.Register(mockProvider("weather", pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER))
+	orchestrator.Register(mockProvider("ai-companion", pbplugin.EnricherProviderType_ENRICHER_PROVIDER_AI_COMPANION))
+	orchestrator.Register(mockProvider("branding", pbplugin.EnricherProviderType_ENRICHER_PROVIDER_UNSPECIFIED))
+
+	process := func(start time.Time) *pbevents.EnrichedActivityEvent {
+		for k := range ran {
+			delete(ran, k)
+		}
+		pipelineID := "p1"
+		result, err := orchestrator.Process(ctx, slog.Default(), &pbevents.ActivityPayload{
+			UserId:     "user-1",
+			Source:     pbactivity.ActivitySource_SOURCE_STRAVA,
+			PipelineId: &pipelineID,
+			Timestamp:  timestamppb.New(start),
+			StandardizedActivity: &pbactivity.StandardizedActivity{
+				Name:      "Spring Half",
+				StartTime: timestamppb.New(start),
+				Sessions:  []*pbactivity.Session{{StartTime: timestamppb.New(start), TotalElapsedTime: 5400}},
+			},
+		}, "exec-1", "pipe-exec-1", false)
+		if err != nil {
+			t.Fatalf("Process failed: %v", err)
+		}
+		if len(result.Events) != 1 {
+			t.Fatalf("Expected 1 event, got %d", len(result.Events))
+		}
+		return result.Events[0]
+	}
+
+	t.Run("inside window", func(t *testing.T) {
+		evt := process(raceStart.Add(9 * time.Hour))
+		if !ran["ai-companion"] || ran["weather"] || ran["branding"] {
+			t.Errorf("Expected only the race mode enricher to run, got %v", ran)
+		}
+		if evt.EnrichmentMetadata["hevy_is_private"] != "false" {
+			t.Errorf("Expected race mode to force hevy public, got %q", evt.EnrichmentMetadata["hevy_is_private"])
+		}
+		if evt.EnrichmentMetadata["race_mode"] != "true" {
+			t.Error("Expected race_mode metadata to be set")
+		}
+	})
+
+	t.Run("outside window", func(t *testing.T) {
+		evt := process(raceEnd.Add(24 * time.Hour))
+		if !ran["weather"] || ran["ai-companion"] || !ran["branding"] {
+			t.Errorf("Expected the normal pipeline to run, got %v", ran)
+		}
+		if evt.EnrichmentMetadata["hevy_is_private"] != "true" {
+			t.Errorf("Expected pipeline destination config, got %q", evt.EnrichmentMetadata["hevy_is_private"])
+		}
+		if _, ok := evt.EnrichmentMetadata["race_mode"]; ok {
+			t.Error("Expected no race_mode metadata outside the window")
+		}
+	})
+}
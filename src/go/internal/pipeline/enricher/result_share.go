@@ -0,0 +1,140 @@
+package enricher
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	shared "github.com/fitglue/server/src/go/pkg"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// resultShareTTL is how long a shared result can be reused. A fan-out's
+// pipelines run within minutes of each other; the TTL only bounds storage.
+const resultShareTTL = 24 * time.Hour
+
+// resultShares lets the pipelines of one splitter fan-out reuse each other's
+// SharedResultProvider results, keyed on the fan-out, the activity's external
+// ID, the provider type and a hash of its config. A nil *resultShares never
+// shares.
+type resultShares struct {
+	db         shared.Database
+	userID     string
+	pipelineID string
+	fanOutID   string
+	externalID string
+}
+
+// newResultShares returns nil unless the activity was fanned out to several
+// pipelines. Resumes and reposts always call providers afresh.
+func newResultShares(db shared.Database, payload *pbevents.ActivityPayload, activity *pbactivity.StandardizedActivity, pipelineID string) *resultShares {
+	if payload.GetFanOutId() == "" || activity.GetExternalId() == "" || payload.IsResume || payload.IsRepost {
+		return nil
+	}
+	return &resultShares{
+		db:         db,
+		userID:     payload.UserId,
+		pipelineID: pipelineID,
+		fanOutID:   payload.GetFanOutId(),
+		externalID: activity.GetExternalId(),
+	}
+}
+
+// configHash hashes a provider's configured inputs, so pipelines that set a
+// provider up differently (e.g. weather without wind) don't share.
+func configHash(config map[string]string) string {
+	keys := make([]string, 0, len(config))
+	for k := range config {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s=%s\n", k, config[k])
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+func (s *resultShares) id(providerType pbplugin.EnricherProviderType, hash string) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{s.fanOutID, s.externalID, providerType.String(), hash}, "|")))
+	return hex.EncodeToString(sum[:])
+}
+
+// load returns the result another pipeline of the fan-out shared for this
+// provider and config, and that pipeline's ID, or nil if there is none.
+func (s *resultShares) load(ctx context.Context, logger *slog.Logger, provider providers.Provider, cfg configuredEnricher) (*providers.EnrichmentResult, string) {
+	if s == nil {
+		return nil, ""
+	}
+	if _, ok := provider.(providers.SharedResultProvider); !ok {
+		return nil, ""
+	}
+
+	share, err := s.db.GetEnricherResultShare(ctx, s.userID, s.id(cfg.ProviderType, configHash(cfg.TypedConfig)))
+	if err != nil {
+		logger.Warn("Failed to read shared enricher result", "name", provider.Name(), "error", err)
+		return nil, ""
+	}
+	if share == nil || share.PipelineId == s.pipelineID {
+		return nil, ""
+	}
+
+	res := &providers.EnrichmentResult{
+		Description:   share.Description,
+		SectionHeader: share.SectionHeader,
+		Name:          share.Name,
+		NameSuffix:    share.NameSuffix,
+		Tags:          share.Tags,
+		Metadata:      make(map[string]string, len(share.Metadata)+1),
+	}
+	for k, v := range share.Metadata {
+		res.Metadata[k] = v
+	}
+	return res, share.PipelineId
+}
+
+// store shares a provider's successful result with the fan-out's other
+// pipelines, if the provider allows it.
+func (s *resultShares) store(ctx context.Context, logger *slog.Logger, provider providers.Provider, cfg configuredEnricher, res *providers.EnrichmentResult) {
+	if s == nil || res == nil {
+		return
+	}
+	sp, ok := provider.(providers.SharedResultProvider)
+	if !ok || !sp.ShareResult(res) {
+		return
+	}
+
+	hash := configHash(cfg.TypedConfig)
+	now := time.Now()
+	share := &pbpipeline.EnricherResultShare{
+		Id:                 s.id(cfg.ProviderType, hash),
+		FanOutId:           s.fanOutID,
+		ActivityExternalId: s.externalID,
+		ProviderType:       cfg.ProviderType,
+		ConfigHash:         hash,
+		PipelineId:         s.pipelineID,
+		Description:        res.Description,
+		SectionHeader:      res.SectionHeader,
+		Name:               res.Name,
+		NameSuffix:         res.NameSuffix,
+		Tags:               res.Tags,
+		Metadata:           res.Metadata,
+		CreatedAt:          timestamppb.New(now),
+		ExpiresAt:          timestamppb.New(now.Add(resultShareTTL)),
+	}
+	if err := s.db.SetEnricherResultShare(ctx, s.userID, share); err != nil {
+		logger.Warn("Failed to share enricher result", "name", provider.Name(), "error", err)
+	}
+}
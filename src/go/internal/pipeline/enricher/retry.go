@@ -0,0 +1,130 @@
+package enricher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/ratelimit"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+const (
+	// MaxEnricherRetries is how many scheduled retries each provider gets per
+	// run. The last one passes doNotRetry so the provider settles for partial
+	// data; a provider that still asks for a retry fails the run.
+	MaxEnricherRetries = 5
+
+	// defaultRetryAfter is the first delay for a RetryableError without one.
+	defaultRetryAfter = time.Minute
+	// maxRetryDelay caps the backoff, before jitter.
+	maxRetryDelay = 6 * time.Hour
+)
+
+// retryJitter returns a random duration in [0, max]. Replaced in tests.
+var retryJitter = func(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max) + 1))
+}
+
+// retryDelay returns the wait before a provider's attempt-th scheduled retry:
+// its RetryAfter, doubling on each attempt up to 6 hours, plus up to 20%
+// jitter so runs held up by the same outage don't all retry at once. It is
+// never shorter than RetryAfter.
+func retryDelay(attempt int32, retryAfter time.Duration) time.Duration {
+	base := retryAfter
+	if base <= 0 {
+		base = defaultRetryAfter
+	}
+	d := base
+	for i := int32(1); i < attempt && d < maxRetryDelay; i++ {
+		d *= 2
+	}
+	if d > maxRetryDelay {
+		d = maxRetryDelay
+	}
+	if d < retryAfter {
+		d = retryAfter
+	}
+	return d + retryJitter(d/5)
+}
+
+// rateLimitRetry turns a provider API's exhausted rate limit budget into a
+// RetryableError, so the provider is retried once the budget resets instead
+// of failing the run or counting against its circuit.
+func rateLimitRetry(err error) error {
+	var limitErr *ratelimit.Error
+	if errors.As(err, &limitErr) {
+		return providers.NewRetryableError(err, limitErr.RetryAfter, limitErr.Error())
+	}
+	return err
+}
+
+// retriesExhausted reports whether the provider has had all its scheduled
+// retries for this run.
+func retriesExhausted(payload *pbevents.ActivityPayload, providerName string) bool {
+	return payload.GetRetryAttempts()[providerName] >= MaxEnricherRetries
+}
+
+// handleRetryableError schedules the next attempt of a provider that returned
+// a RetryableError and builds the result Process returns. It returns a nil
+// result once the provider has used up its retries, so the caller fails it
+// like any other provider error.
+func (o *Orchestrator) handleRetryableError(ctx context.Context, logger *slog.Logger, payload *pbevents.ActivityPayload, runID string, pe ProviderExecution, providerExecs []ProviderExecution, retryErr *providers.RetryableError) (*ProcessResult, error) {
+	attempt := payload.GetRetryAttempts()[pe.ProviderName] + 1
+	if attempt > MaxEnricherRetries {
+		return nil, nil
+	}
+
+	retryAt := time.Now().Add(retryDelay(attempt, retryErr.RetryAfter))
+	pe.Status = "RETRY"
+	pe.Error = retryErr.Reason
+	pe.Metadata = map[string]string{
+		"retry_after":   retryErr.RetryAfter.String(),
+		"retry_reason":  retryErr.Reason,
+		"retry_attempt": strconv.Itoa(int(attempt)),
+		"retry_at":      retryAt.UTC().Format(time.RFC3339),
+	}
+	providerExecs = append(providerExecs, pe)
+
+	// The run stays RUNNING until the pipeline service resumes it
+	if err := o.scheduleRetry(ctx, logger, payload.UserId, runID, pe.ProviderName, attempt, retryAt, retryErr.Reason, providerExecs); err != nil {
+		// Nothing would resume the run, so let Pub/Sub redeliver instead
+		return &ProcessResult{
+			Events:             []*pbevents.EnrichedActivityEvent{},
+			ProviderExecutions: providerExecs,
+		}, err
+	}
+	return &ProcessResult{
+		Events:             []*pbevents.EnrichedActivityEvent{},
+		ProviderExecutions: providerExecs,
+		Status:             pbpipeline.ExecutionStatus_STATUS_LAGGED_RETRY,
+	}, retryErr
+}
+
+// scheduleRetry records a provider's RetryableError on the run: the attempt
+// count and when the pipeline service should resume the run (see
+// pipeline.Service.RetryDueRuns).
+func (o *Orchestrator) scheduleRetry(ctx context.Context, logger *slog.Logger, userID, runID, providerName string, attempt int32, retryAt time.Time, reason string, providerExecs []ProviderExecution) error {
+	update := map[string]interface{}{
+		"status":         int32(pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING),
+		"status_message": fmt.Sprintf("Retry %d/%d scheduled: %s", attempt, MaxEnricherRetries, reason),
+		"updated_at":     time.Now(),
+		"boosters":       boostersToFirestoreMaps(providerExecs),
+		"retry_attempts": map[string]interface{}{providerName: attempt},
+		"next_retry_at":  retryAt,
+	}
+	if err := o.database.UpdatePipelineRun(ctx, userID, runID, update); err != nil {
+		return fmt.Errorf("schedule retry of %s: %w", providerName, err)
+	}
+	logger.Info("Scheduled enricher retry", "name", providerName, "attempt", attempt, "retry_at", retryAt, "reason", reason)
+	return nil
+}
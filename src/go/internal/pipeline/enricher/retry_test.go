@@ -0,0 +1,208 @@
+package enricher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers"
+	user "github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/ratelimit"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+func TestRetryDelay(t *testing.T) {
+	jitter := retryJitter
+	defer func() { retryJitter = jitter }()
+	retryJitter = func(time.Duration) time.Duration { return 0 }
+
+	tests := []struct {
+		name       string
+		attempt    int32
+		retryAfter time.Duration
+		want       time.Duration
+	}{
+		{"first attempt uses RetryAfter", 1, 2 * time.Minute, 2 * time.Minute},
+		{"doubles per attempt", 3, 2 * time.Minute, 8 * time.Minute},
+		{"defaults without RetryAfter", 1, 0, time.Minute},
+		{"capped", 20, time.Hour, 6 * time.Hour},
+		{"never below RetryAfter", 1, 12 * time.Hour, 12 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryDelay(tt.attempt, tt.retryAfter); got != tt.want {
+				t.Errorf("retryDelay(%d, %v) = %v, want %v", tt.attempt, tt.retryAfter, got, tt.want)
+			}
+		})
+	}
+
+	retryJitter = jitter
+	for i := 0; i < 100; i++ {
+		if got := retryDelay(1, 10*time.Minute); got < 10*time.Minute || got > 12*time.Minute {
+			t.Fatalf("retryDelay with jitter = %v, want within [10m, 12m]", got)
+		}
+	}
+}
+
+func TestRateLimitRetry(t *testing.T) {
+	wrapped := fmt.Errorf("strava api: %w", &ratelimit.Error{Provider: "strava", RetryAfter: 7 * time.Minute})
+	var retryErr *providers.RetryableError
+	if !errors.As(rateLimitRetry(wrapped), &retryErr) || retryErr.RetryAfter != 7*time.Minute {
+		t.Errorf("rateLimitRetry(%v) = %v, want a RetryableError after 7m", wrapped, rateLimitRetry(wrapped))
+	}
+
+	other := errors.New("boom")
+	if got := rateLimitRetry(other); got != other {
+		t.Errorf("rateLimitRetry(%v) = %v, want it unchanged", other, got)
+	}
+	if rateLimitRetry(nil) != nil {
+		t.Error("rateLimitRetry(nil) should be nil")
+	}
+}
+
+func TestOrchestrator_ScheduledRetry(t *testing.T) {
+	ctx := context.Background()
+
+	newOrchestrator := func(updates *[]map[string]interface{}) *Orchestrator {
+		mockDB := &MockDatabase{
+			GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+				return &user.Record{UserProfile: &pbuser.UserProfile{UserId: id}}, nil
+			},
+			GetUserPipelinesFunc: func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
+				return []*pbpipeline.PipelineConfig{{
+					Id:           "p1",
+					Source:       "SOURCE_HEVY",
+					Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+					Enrichers:    []*pbpipeline.EnricherConfig{{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER}},
+				}}, nil
+			},
+			UpdatePipelineRunFunc: func(ctx context.Context, userId string, id string, data map[string]interface{}) error {
+				*updates = append(*updates, data)
+				return nil
+			},
+		}
+		return NewOrchestrator(mockDB, &MockBlobStore{}, "test-bucket", nil)
+	}
+
+	newPayload := func(retryAttempts map[string]int32) *pbevents.ActivityPayload {
+		pipelineID := "p1"
+		return &pbevents.ActivityPayload{
+			UserId:        "user-1",
+			Source:        pbactivity.ActivitySource_SOURCE_HEVY,
+			PipelineId:    &pipelineID,
+			Timestamp:     timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+			RetryAttempts: retryAttempts,
+			StandardizedActivity: &pbactivity.StandardizedActivity{
+				Name: "Morning Run",
+				Sessions: []*pbactivity.Session{{
+					StartTime:        timestamppb.New(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)),
+					TotalElapsedTime: 60,
+				}},
+			},
+		}
+	}
+
+	// lagging asks for a retry unless told not to, like a source still
+	// processing the activity
+	var gotDoNotRetry bool
+	lagging := &MockProvider{
+		NameFunc:         func() string { return "weather" },
+		ProviderTypeFunc: func() pbplugin.EnricherProviderType { return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER },
+		EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+			gotDoNotRetry = doNotRetry
+			if doNotRetry {
+				return &providers.EnrichmentResult{Description: "☀️ Weather"}, nil
+			}
+			return nil, providers.NewRetryableError(errors.New("not ready"), 2*time.Minute, "streams pending")
+		},
+	}
+
+	t.Run("Schedules the next attempt on the run", func(t *testing.T) {
+		var updates []map[string]interface{}
+		o := newOrchestrator(&updates)
+		o.Register(lagging)
+
+		start := time.Now()
+		result, err := o.Process(ctx, slog.Default(), newPayload(map[string]int32{"weather": 1}), "exec-1", "pipe-exec-1", false)
+		var retryErr *providers.RetryableError
+		if !errors.As(err, &retryErr) {
+			t.Fatalf("Expected a RetryableError, got %v", err)
+		}
+		if result.Status != pbpipeline.ExecutionStatus_STATUS_LAGGED_RETRY {
+			t.Errorf("Expected status LAGGED_RETRY, got %v", result.Status)
+		}
+		pe := result.ProviderExecutions[len(result.ProviderExecutions)-1]
+		if pe.Status != "RETRY" || pe.Metadata["retry_attempt"] != "2" {
+			t.Errorf("Expected weather RETRY on attempt 2, got %+v", pe)
+		}
+
+		var scheduled map[string]interface{}
+		for _, u := range updates {
+			if _, ok := u["next_retry_at"]; ok {
+				scheduled = u
+			}
+		}
+		if scheduled == nil {
+			t.Fatal("Expected the run to be updated with next_retry_at")
+		}
+		if got := scheduled["retry_attempts"].(map[string]interface{})["weather"]; got != int32(2) {
+			t.Errorf("Expected retry_attempts weather=2, got %v", got)
+		}
+		// The second attempt doubles RetryAfter, plus up to 20% jitter
+		retryAt := scheduled["next_retry_at"].(time.Time)
+		if delay := retryAt.Sub(start); delay < 4*time.Minute || delay > 5*time.Minute {
+			t.Errorf("Expected retry in 4-5 minutes, got %v", delay)
+		}
+		if msg := scheduled["status_message"].(string); !strings.HasPrefix(msg, "Retry 2/5 scheduled") {
+			t.Errorf("Unexpected status message %q", msg)
+		}
+	})
+
+	t.Run("Last attempt settles for partial data", func(t *testing.T) {
+		var updates []map[string]interface{}
+		o := newOrchestrator(&updates)
+		o.Register(lagging)
+
+		result, err := o.Process(ctx, slog.Default(), newPayload(map[string]int32{"weather": MaxEnricherRetries}), "exec-1", "pipe-exec-1", false)
+		if err != nil {
+			t.Fatalf("Process failed: %v", err)
+		}
+		if !gotDoNotRetry {
+			t.Error("Expected the provider to be called with doNotRetry")
+		}
+		if len(result.Events) != 1 || !strings.Contains(result.Events[0].Description, "Weather") {
+			t.Errorf("Expected the run to publish with the weather section, got %+v", result.Events)
+		}
+	})
+
+	t.Run("Fails once retries are exhausted", func(t *testing.T) {
+		var updates []map[string]interface{}
+		o := newOrchestrator(&updates)
+		o.Register(&MockProvider{
+			NameFunc:         func() string { return "weather" },
+			ProviderTypeFunc: func() pbplugin.EnricherProviderType { return pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER },
+			EnrichFunc: func(ctx context.Context, _ *slog.Logger, activity *pbactivity.StandardizedActivity, user *user.Record, inputConfig map[string]string, doNotRetry bool) (*providers.EnrichmentResult, error) {
+				return nil, providers.NewRetryableError(errors.New("not ready"), time.Minute, "streams pending")
+			},
+		})
+
+		_, err := o.Process(ctx, slog.Default(), newPayload(map[string]int32{"weather": MaxEnricherRetries}), "exec-1", "pipe-exec-1", false)
+		if err == nil || !strings.Contains(err.Error(), "retries exhausted") {
+			t.Fatalf("Expected retries exhausted error, got %v", err)
+		}
+		last := updates[len(updates)-1]
+		if last["status"] != int32(pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_FAILED) {
+			t.Errorf("Expected the run to be FAILED, got %v", last["status"])
+		}
+	})
+}
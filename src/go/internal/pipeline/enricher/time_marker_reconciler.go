@@ -4,6 +4,8 @@ import (
 	"math"
 	"time"
 
+	"github.com/fitglue/server/src/go/internal/pipeline/enricher/providers/photo_geotag"
+
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 )
 
@@ -13,8 +15,8 @@ import (
 // FIT category names on the TimeMarkers (e.g., "Bench Press" from category enum).
 //
 // Two matching strategies are used:
-//  1. Position-based: When all StrengthSets share the same timestamp (e.g., Hevy sets
-//     all start times to the workout start), group consecutive same-name sets into
+//  1. Position-based: When all StrengthSets share the same timestamp (e.g., Hevy
+//     workouts without per-set times), group consecutive same-name sets into
 //     exercise blocks and match TimeMarker[i] → block[i] by order.
 //  2. Timestamp-based: When sets have distinct timestamps (e.g., FIT file uploads),
 //     find the StrengthSet with the closest start time for each TimeMarker.
@@ -38,7 +40,7 @@ func reconcileTimeMarkerLabels(activity *pbactivity.StandardizedActivity) {
 	}
 
 	// Detect if all sets share the same timestamp (within 1 second).
-	// Sources like Hevy don't provide per-set times, so all sets get the workout start time.
+	// Hevy workouts without per-set times leave every set at the workout start time.
 	// Position-based matching only activates with 2+ sets sharing the same timestamp.
 	allSameTimestamp := false
 	if len(sets) > 1 {
@@ -59,10 +61,18 @@ func reconcileTimeMarkerLabels(activity *pbactivity.StandardizedActivity) {
 		}
 	}
 
+	// Photo markers are labelled by the photo enricher, not by exercise
+	var markers []*pbactivity.TimeMarker
+	for _, m := range activity.TimeMarkers {
+		if m.MarkerType != photo_geotag.MarkerType {
+			markers = append(markers, m)
+		}
+	}
+
 	if allSameTimestamp {
-		reconcileByPosition(activity.TimeMarkers, sets)
+		reconcileByPosition(markers, sets)
 	} else {
-		reconcileByTimestamp(activity.TimeMarkers, sets)
+		reconcileByTimestamp(markers, sets)
 	}
 }
 
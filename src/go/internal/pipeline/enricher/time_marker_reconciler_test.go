@@ -129,6 +129,23 @@ func TestReconcileTimeMarkerLabels(t *testing.T) {
 			// Only first 2 markers get relabeled; third keeps original
 			expectedLabels: []string{"Bench Press", "Squat", "Exercise C"},
 		},
+		{
+			name: "photo markers keep their labels and don't shift exercise positions",
+			activity: &pbactivity.StandardizedActivity{
+				TimeMarkers: []*pbactivity.TimeMarker{
+					{Label: "Photo 1", Timestamp: timestamppb.New(baseTime), MarkerType: "photo"},
+					{Label: "Exercise A", Timestamp: timestamppb.New(baseTime), MarkerType: "exercise_start"},
+					{Label: "Exercise B", Timestamp: timestamppb.New(baseTime.Add(5 * time.Minute)), MarkerType: "exercise_start"},
+				},
+				Sessions: []*pbactivity.Session{{
+					StrengthSets: []*pbactivity.StrengthSet{
+						{ExerciseName: "Bench Press", StartTime: timestamppb.New(baseTime)},
+						{ExerciseName: "Squat", StartTime: timestamppb.New(baseTime)},
+					},
+				}},
+			},
+			expectedLabels: []string{"Photo 1", "Bench Press", "Squat"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,99 @@
+package pipeline
+
+import (
+	"context"
+	"sort"
+
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultEnricherUsageRuns = 200
+	maxEnricherUsageRuns     = 500
+)
+
+// GetEnricherUsage summarises how each enricher has behaved across the user's
+// most recent pipeline runs, so they can spot boosters that rarely add anything.
+func (s *Service) GetEnricherUsage(ctx context.Context, req *pbsvc.GetEnricherUsageRequest) (*pbsvc.GetEnricherUsageResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	limit := req.MaxRuns
+	if limit <= 0 {
+		limit = defaultEnricherUsageRuns
+	}
+	if limit > maxEnricherUsageRuns {
+		limit = maxEnricherUsageRuns
+	}
+
+	runs, _, err := s.store.ListPipelineRuns(ctx, req.UserId, req.PipelineId, limit, "")
+	if err != nil {
+		s.logger.Error(ctx, "failed to list pipeline runs for enricher usage", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list runs")
+	}
+
+	return &pbsvc.GetEnricherUsageResponse{
+		Enrichers:    aggregateEnricherUsage(runs),
+		RunsAnalyzed: int32(len(runs)),
+	}, nil
+}
+
+// aggregateEnricherUsage folds the booster executions recorded on each run into
+// one summary per provider, most used first.
+func aggregateEnricherUsage(runs []*pipeline.PipelineRun) []*pipeline.EnricherUsage {
+	byProvider := make(map[string]*pipeline.EnricherUsage)
+	durations := make(map[string][2]int64) // total ms, samples
+
+	for _, run := range runs {
+		for _, b := range run.Boosters {
+			if b.ProviderName == "" {
+				continue
+			}
+			usage, ok := byProvider[b.ProviderName]
+			if !ok {
+				usage = &pipeline.EnricherUsage{ProviderName: b.ProviderName}
+				byProvider[b.ProviderName] = usage
+			}
+
+			usage.Uses++
+			switch b.Status {
+			case "SUCCESS":
+				usage.Succeeded++
+				if b.ContributedDescription {
+					usage.DescriptionContributions++
+				}
+			case "FAILED", "TIMEOUT":
+				usage.Failed++
+			case "SKIPPED", "SKIPPED_BUDGET":
+				usage.Skipped++
+			}
+
+			if b.DurationMs > 0 {
+				d := durations[b.ProviderName]
+				durations[b.ProviderName] = [2]int64{d[0] + b.DurationMs, d[1] + 1}
+			}
+			if run.CreatedAt != nil && (usage.LastRunAt == nil || run.CreatedAt.AsTime().After(usage.LastRunAt.AsTime())) {
+				usage.LastRunAt = run.CreatedAt
+			}
+		}
+	}
+
+	result := make([]*pipeline.EnricherUsage, 0, len(byProvider))
+	for name, usage := range byProvider {
+		if d := durations[name]; d[1] > 0 {
+			usage.AverageDurationMs = d[0] / d[1]
+		}
+		result = append(result, usage)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Uses != result[j].Uses {
+			return result[i].Uses > result[j].Uses
+		}
+		return result[i].ProviderName < result[j].ProviderName
+	})
+	return result
+}
@@ -0,0 +1,84 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultFailedEvents = 50
+	maxFailedEvents     = 200
+	// maxRedriveBatch caps how many events one admin request republishes.
+	maxRedriveBatch = 100
+)
+
+// FailedEventRedriver republishes failed events to their source topics and
+// stores their new state. The redrive package provides it; it is injected
+// because that package builds on this one.
+type FailedEventRedriver func(ctx context.Context, events []*pipeline.FailedEvent) error
+
+// SetRedriver enables AdminRedriveFailedEvents.
+func (s *Service) SetRedriver(r FailedEventRedriver) {
+	s.redriver = r
+}
+
+// AdminListFailedEvents returns a user's dead-lettered pipeline events, most
+// recently failed first.
+func (s *Service) AdminListFailedEvents(ctx context.Context, req *pbsvc.AdminListFailedEventsRequest) (*pbsvc.AdminListFailedEventsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if _, ok := pipeline.FailedEventStatus_name[int32(req.Status)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown status")
+	}
+	limit := defaultFailedEvents
+	if req.Limit > 0 && req.Limit <= maxFailedEvents {
+		limit = int(req.Limit)
+	}
+
+	events, err := s.store.ListFailedEvents(ctx, req.UserId, req.Status, limit)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list failed events", "error", err, "user_id", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to list failed events")
+	}
+	return &pbsvc.AdminListFailedEventsResponse{Events: events}, nil
+}
+
+// AdminRedriveFailedEvents republishes the selected events now, whatever
+// their status or next attempt time. Each redrive counts as an attempt, so an
+// exhausted event that fails again stays exhausted.
+func (s *Service) AdminRedriveFailedEvents(ctx context.Context, req *pbsvc.AdminRedriveFailedEventsRequest) (*pbsvc.AdminRedriveFailedEventsResponse, error) {
+	if req.UserId == "" || len(req.EventIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and event_ids are required")
+	}
+	if len(req.EventIds) > maxRedriveBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d events can be redriven at once", maxRedriveBatch)
+	}
+	if s.redriver == nil {
+		return nil, status.Error(codes.Unimplemented, "failed event redrive is not available")
+	}
+
+	events := make([]*pipeline.FailedEvent, 0, len(req.EventIds))
+	for _, id := range req.EventIds {
+		e, err := s.store.GetFailedEvent(ctx, req.UserId, id)
+		if err != nil {
+			s.logger.Error(ctx, "failed to get failed event", "error", err, "user_id", req.UserId, "event_id", id)
+			return nil, status.Error(codes.Internal, "failed to read failed event")
+		}
+		if e == nil {
+			return nil, status.Errorf(codes.NotFound, "failed event not found: %s", id)
+		}
+		events = append(events, e)
+	}
+
+	if err := s.redriver(ctx, events); err != nil {
+		s.logger.Error(ctx, "failed to redrive failed events", "error", err, "user_id", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to redrive failed events")
+	}
+	s.logger.Info(ctx, "failed events redriven", "user_id", req.UserId, "count", len(events))
+	return &pbsvc.AdminRedriveFailedEventsResponse{Events: events}, nil
+}
@@ -4,14 +4,21 @@ package pipeline
 import (
 	"context"
 	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
 
 	"cloud.google.com/go/firestore"
+	storage "github.com/fitglue/server/src/go/pkg/storage/firestore"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type FirestoreStore struct {
@@ -61,27 +68,77 @@ func (s *FirestoreStore) GetPipeline(ctx context.Context, userID, pipelineID str
 	return &cfg, nil
 }
 
-func (s *FirestoreStore) CreatePipeline(ctx context.Context, userID string, cfg *pipeline.PipelineConfig) (*pipeline.PipelineConfig, error) {
-	data, err := encodeProtoMap(cfg)
+func (s *FirestoreStore) GetPipelineVersion(ctx context.Context, userID, pipelineID string, version int32) (*pipeline.PipelineConfigVersion, error) {
+	doc, err := s.client.Collection("users").Doc(userID).Collection("pipelines").Doc(pipelineID).
+		Collection("versions").Doc(strconv.Itoa(int(version))).Get(ctx)
 	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
 		return nil, err
 	}
 
-	_, err = s.client.Collection("users").Doc(userID).Collection("pipelines").Doc(cfg.Id).Set(ctx, data)
-	if err != nil {
+	var v pipeline.PipelineConfigVersion
+	if err := decodeProtoMap(doc.Data(), &v); err != nil {
 		return nil, err
 	}
-	return cfg, nil
+	return &v, nil
+}
+
+func (s *FirestoreStore) CreatePipeline(ctx context.Context, userID string, cfg *pipeline.PipelineConfig) (*pipeline.PipelineConfig, error) {
+	return s.savePipelineVersion(ctx, userID, cfg)
 }
 
 func (s *FirestoreStore) UpdatePipeline(ctx context.Context, userID string, cfg *pipeline.PipelineConfig) (*pipeline.PipelineConfig, error) {
 	// Full copy update based on the given proto message
-	data, err := encodeProtoMap(cfg)
-	if err != nil {
+	return s.savePipelineVersion(ctx, userID, cfg)
+}
+
+// savePipelineVersion writes the pipeline with the next version number and
+// an immutable snapshot of it under versions/{version}, in one transaction so
+// concurrent edits can't claim the same version. Destination credentials are
+// moved to the user's integration secrets first, so neither holds them.
+func (s *FirestoreStore) savePipelineVersion(ctx context.Context, userID string, cfg *pipeline.PipelineConfig) (*pipeline.PipelineConfig, error) {
+	if err := storage.SplitDestinationSecrets(ctx, s.client, userID, cfg.DestinationConfigs); err != nil {
+		return nil, err
+	}
+	if err := storage.SplitDestinationSecrets(ctx, s.client, userID, cfg.GetRaceMode().GetDestinationConfigs()); err != nil {
 		return nil, err
 	}
+	ref := s.client.Collection("users").Doc(userID).Collection("pipelines").Doc(cfg.Id)
+
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		var current pipeline.PipelineConfig
+		doc, err := tx.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			if err := decodeProtoMap(doc.Data(), &current); err != nil {
+				return err
+			}
+		}
+
+		cfg.Version = current.Version + 1
+		data, err := encodeProtoMap(cfg)
+		if err != nil {
+			return err
+		}
+		snapshot, err := encodeProtoMap(&pipeline.PipelineConfigVersion{
+			PipelineId: cfg.Id,
+			Version:    cfg.Version,
+			Config:     cfg,
+			CreatedAt:  timestamppb.Now(),
+		})
+		if err != nil {
+			return err
+		}
 
-	_, err = s.client.Collection("users").Doc(userID).Collection("pipelines").Doc(cfg.Id).Set(ctx, data)
+		if err := tx.Set(ref, data); err != nil {
+			return err
+		}
+		return tx.Create(ref.Collection("versions").Doc(strconv.Itoa(int(cfg.Version))), snapshot)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -218,11 +275,506 @@ func (s *FirestoreStore) ListPipelineRuns(ctx context.Context, userID, pipelineI
 	return runs, "", nil
 }
 
+func (s *FirestoreStore) ListPipelineRunsBySourceActivity(ctx context.Context, userID string, source activity.ActivitySource, sourceActivityID string) ([]*pipeline.PipelineRun, error) {
+	iter := s.client.Collection("users").Doc(userID).Collection("pipeline_runs").
+		Where("source", "==", source.String()).
+		Where("source_activity_id", "==", sourceActivityID).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var runs []*pipeline.PipelineRun
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		run := storage.FirestoreToPipelineRun(doc.Data())
+		if run.Id == "" {
+			run.Id = doc.Ref.ID
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
 func (s *FirestoreStore) UpdatePipelineRun(ctx context.Context, userID, runID string, updateData map[string]interface{}) error {
 	_, err := s.client.Collection("users").Doc(userID).Collection("pipeline_runs").Doc(runID).Set(ctx, updateData, firestore.MergeAll)
 	return err
 }
 
+func (s *FirestoreStore) TransitionPipelineRun(ctx context.Context, userID, runID string, fn func(run *pipeline.PipelineRun) (map[string]interface{}, error)) error {
+	ref := s.client.Collection("users").Doc(userID).Collection("pipeline_runs").Doc(runID)
+	return s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		var run *pipeline.PipelineRun
+		doc, err := tx.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			run = &pipeline.PipelineRun{}
+			if err := decodeProtoMap(doc.Data(), run); err != nil {
+				return err
+			}
+		}
+
+		updateData, err := fn(run)
+		if err != nil {
+			return err
+		}
+		return tx.Set(ref, updateData, firestore.MergeAll)
+	})
+}
+
+// CreatePipelineRun uses the shared converter so status is stored as an int32,
+// matching runs written by the enricher.
+func (s *FirestoreStore) CreatePipelineRun(ctx context.Context, userID string, run *pipeline.PipelineRun) error {
+	_, err := s.client.Collection("users").Doc(userID).Collection("pipeline_runs").Doc(run.Id).Set(ctx, storage.PipelineRunToFirestore(run), firestore.MergeAll)
+	return err
+}
+
+func (s *FirestoreStore) ListDeferredRuns(ctx context.Context, userID, pipelineID string) ([]*pipeline.PipelineRun, error) {
+	query := s.client.Collection("users").Doc(userID).Collection("pipeline_runs").
+		Where("status", "==", int32(pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DEFERRED))
+	if pipelineID != "" {
+		query = query.Where("pipeline_id", "==", pipelineID)
+	}
+
+	iter := query.Documents(ctx)
+	defer iter.Stop()
+
+	var runs []*pipeline.PipelineRun
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, storage.FirestoreToPipelineRun(doc.Data()))
+	}
+
+	// Sorted in memory to avoid a composite index on status + created_at
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].GetCreatedAt().AsTime().Before(runs[j].GetCreatedAt().AsTime())
+	})
+	return runs, nil
+}
+
+func (s *FirestoreStore) GetPipelinesPausedUntil(ctx context.Context, userID string) (time.Time, error) {
+	doc, err := s.client.Collection("users").Doc(userID).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	until, _ := doc.Data()["pipelines_paused_until"].(time.Time)
+	return until, nil
+}
+
+func (s *FirestoreStore) SetPipelinesPausedUntil(ctx context.Context, userID string, until time.Time) error {
+	var value interface{} = until
+	if until.IsZero() {
+		value = firestore.Delete
+	}
+	_, err := s.client.Collection("users").Doc(userID).Set(ctx, map[string]interface{}{
+		"pipelines_paused_until": value,
+	}, firestore.MergeAll)
+	return err
+}
+
+func (s *FirestoreStore) ClaimStarterPipeline(ctx context.Context, userID string, at time.Time) (bool, error) {
+	ref := s.client.Collection("users").Doc(userID)
+	claimed := false
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		claimed = false
+		doc, err := tx.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			if _, ok := doc.Data()["starter_pipeline_at"]; ok {
+				return nil
+			}
+		}
+		claimed = true
+		return tx.Set(ref, map[string]interface{}{
+			"starter_pipeline_at": at,
+		}, firestore.MergeAll)
+	})
+	return claimed, err
+}
+
+func (s *FirestoreStore) ListDueRetries(ctx context.Context, before time.Time, limit int) ([]*DueRetry, error) {
+	iter := s.client.CollectionGroup("pipeline_runs").
+		Where("status", "==", int32(pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING)).
+		Where("next_retry_at", "<=", before).
+		OrderBy("next_retry_at", firestore.Asc).
+		Limit(limit).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var due []*DueRetry
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		// users/{userID}/pipeline_runs/{runID}
+		due = append(due, &DueRetry{
+			UserID: doc.Ref.Parent.Parent.ID,
+			Run:    storage.FirestoreToPipelineRun(doc.Data()),
+		})
+	}
+	return due, nil
+}
+
+func (s *FirestoreStore) ListRecentPipelineRuns(ctx context.Context, filter RunFilter, before time.Time, limit int) ([]*pipeline.PipelineRun, error) {
+	query := s.client.CollectionGroup("pipeline_runs").Query
+	if filter.UserID != "" {
+		query = s.client.Collection("users").Doc(filter.UserID).Collection("pipeline_runs").Query
+	}
+	switch len(filter.Statuses) {
+	case 0:
+	case 1:
+		query = query.Where("status", "==", int32(filter.Statuses[0]))
+	default:
+		statuses := make([]interface{}, len(filter.Statuses))
+		for i, st := range filter.Statuses {
+			statuses[i] = int32(st)
+		}
+		query = query.Where("status", "in", statuses)
+	}
+	if filter.Source != "" {
+		query = query.Where("source", "==", filter.Source)
+	}
+
+	iter := query.Where("created_at", "<", before).
+		OrderBy("created_at", firestore.Desc).
+		Limit(limit).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var runs []*pipeline.PipelineRun
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		run := storage.FirestoreToPipelineRun(doc.Data())
+		// users/{userID}/pipeline_runs/{runID}
+		run.UserId = doc.Ref.Parent.Parent.ID
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
+func (s *FirestoreStore) ListPipelineDailyStats(ctx context.Context, userID, pipelineID, since string) ([]*pipeline.PipelineDailyStats, error) {
+	iter := s.client.Collection("users").Doc(userID).Collection("pipelines").Doc(pipelineID).Collection("daily_stats").
+		Where("date", ">=", since).
+		OrderBy("date", firestore.Asc).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var stats []*pipeline.PipelineDailyStats
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var day pipeline.PipelineDailyStats
+		if err := decodeProtoMap(doc.Data(), &day); err != nil {
+			return nil, err
+		}
+		stats = append(stats, &day)
+	}
+	return stats, nil
+}
+
+// ListExecutionsForRun returns the execution records tagged with a pipeline run,
+// oldest first.
+func (s *FirestoreStore) ListExecutionsForRun(ctx context.Context, userID, runID string) ([]*pipeline.ExecutionRecord, error) {
+	iter := s.client.Collection("users").Doc(userID).Collection("executions").
+		Where("pipeline_execution_id", "==", runID).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var records []*pipeline.ExecutionRecord
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var rec pipeline.ExecutionRecord
+		if err := decodeProtoMap(doc.Data(), &rec); err != nil {
+			return nil, err
+		}
+		records = append(records, &rec)
+	}
+
+	// Sorted in memory to avoid needing a composite index
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].GetTimestamp().AsTime().Before(records[j].GetTimestamp().AsTime())
+	})
+	return records, nil
+}
+
+func (s *FirestoreStore) GetEnricherRecommendations(ctx context.Context, userID string) (*pipeline.EnricherRecommendations, error) {
+	doc, err := s.client.Collection("users").Doc(userID).Collection("recommendations").Doc("enrichers").Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var recs pipeline.EnricherRecommendations
+	if err := decodeProtoMap(doc.Data(), &recs); err != nil {
+		return nil, err
+	}
+	return &recs, nil
+}
+
+func (s *FirestoreStore) SetEnricherRecommendations(ctx context.Context, userID string, recs *pipeline.EnricherRecommendations) error {
+	data, err := encodeProtoMap(recs)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.Collection("users").Doc(userID).Collection("recommendations").Doc("enrichers").Set(ctx, data)
+	return err
+}
+
+func (s *FirestoreStore) ListActivityTypeRules(ctx context.Context, userID string) ([]*pipeline.ActivityTypeRule, error) {
+	iter := s.client.Collection("users").Doc(userID).Collection("activity_type_rules").Documents(ctx)
+	defer iter.Stop()
+
+	var rules []*pipeline.ActivityTypeRule
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, storage.FirestoreToActivityTypeRule(doc.Data()))
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].GetUpdatedAt().AsTime().After(rules[j].GetUpdatedAt().AsTime())
+	})
+	return rules, nil
+}
+
+func (s *FirestoreStore) GetActivityTypeRule(ctx context.Context, userID, ruleID string) (*pipeline.ActivityTypeRule, error) {
+	doc, err := s.client.Collection("users").Doc(userID).Collection("activity_type_rules").Doc(ruleID).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return storage.FirestoreToActivityTypeRule(doc.Data()), nil
+}
+
+func (s *FirestoreStore) SetActivityTypeRule(ctx context.Context, userID string, rule *pipeline.ActivityTypeRule) error {
+	_, err := s.client.Collection("users").Doc(userID).Collection("activity_type_rules").Doc(rule.Id).Set(ctx, storage.ActivityTypeRuleToFirestore(rule))
+	return err
+}
+
+func (s *FirestoreStore) DeleteActivityTypeRule(ctx context.Context, userID, ruleID string) error {
+	_, err := s.client.Collection("users").Doc(userID).Collection("activity_type_rules").Doc(ruleID).Delete(ctx)
+	return err
+}
+
+// ListActiveUserIDs returns the users with at least one pipeline run created
+// since the given time.
+func (s *FirestoreStore) ListActiveUserIDs(ctx context.Context, since time.Time) ([]string, error) {
+	iter := s.client.CollectionGroup("pipeline_runs").
+		Where("created_at", ">=", since).
+		Documents(ctx)
+	defer iter.Stop()
+
+	seen := make(map[string]bool)
+	var userIDs []string
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// users/{userID}/pipeline_runs/{runID}
+		userDoc := doc.Ref.Parent.Parent
+		if userDoc == nil || seen[userDoc.ID] {
+			continue
+		}
+		seen[userDoc.ID] = true
+		userIDs = append(userIDs, userDoc.ID)
+	}
+	return userIDs, nil
+}
+
+func (s *FirestoreStore) ListProviderCircuits(ctx context.Context) ([]*pipeline.ProviderCircuit, error) {
+	iter := s.client.Collection("provider_circuits").Documents(ctx)
+	defer iter.Stop()
+
+	var circuits []*pipeline.ProviderCircuit
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		circuits = append(circuits, storage.FirestoreToProviderCircuit(doc.Data()))
+	}
+	return circuits, nil
+}
+
+// SetProviderCircuitMode sets the mode and starts the failure count afresh,
+// so a provider switched back to automatic gets a clean run.
+func (s *FirestoreStore) SetProviderCircuitMode(ctx context.Context, providerType plugin.EnricherProviderType, mode pipeline.ProviderCircuitMode, reason, actor string) (*pipeline.ProviderCircuit, error) {
+	ref := s.client.Collection("provider_circuits").Doc(providerType.String())
+	historyRef := ref.Collection("history").NewDoc()
+	var result *pipeline.ProviderCircuit
+
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		c := &pipeline.ProviderCircuit{ProviderType: providerType}
+		doc, err := tx.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			c = storage.FirestoreToProviderCircuit(doc.Data())
+			c.ProviderType = providerType
+		}
+
+		now := timestamppb.Now()
+		c.Mode = mode
+		c.ModeReason = nil
+		if reason != "" {
+			c.ModeReason = &reason
+		}
+		c.ModeSetBy = &actor
+		c.ConsecutiveFailures = 0
+		c.OpenedAt = nil
+		c.OpenUntil = nil
+		c.UpdatedAt = now
+		result = c
+
+		change := &pipeline.ProviderCircuitChange{
+			Id:           historyRef.ID,
+			ProviderType: providerType,
+			Event:        pipeline.ProviderCircuitEvent_PROVIDER_CIRCUIT_EVENT_MODE_CHANGED,
+			Mode:         mode,
+			Reason:       c.ModeReason,
+			Actor:        actor,
+			CreatedAt:    now,
+		}
+		if err := tx.Set(ref, storage.ProviderCircuitToFirestore(c)); err != nil {
+			return err
+		}
+		return tx.Set(historyRef, storage.ProviderCircuitChangeToFirestore(change))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *FirestoreStore) ListProviderCircuitChanges(ctx context.Context, providerType plugin.EnricherProviderType, limit int) ([]*pipeline.ProviderCircuitChange, error) {
+	iter := s.client.Collection("provider_circuits").Doc(providerType.String()).Collection("history").
+		OrderBy("created_at", firestore.Desc).
+		Limit(limit).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var changes []*pipeline.ProviderCircuitChange
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, storage.FirestoreToProviderCircuitChange(doc.Data()))
+	}
+	return changes, nil
+}
+
+func (s *FirestoreStore) GetFailedEvent(ctx context.Context, userID, eventID string) (*pipeline.FailedEvent, error) {
+	doc, err := s.client.Collection("users").Doc(userID).Collection("failed_events").Doc(eventID).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return storage.FirestoreToFailedEvent(doc.Data()), nil
+}
+
+func (s *FirestoreStore) SetFailedEvent(ctx context.Context, userID string, event *pipeline.FailedEvent) error {
+	_, err := s.client.Collection("users").Doc(userID).Collection("failed_events").Doc(event.Id).Set(ctx, storage.FailedEventToFirestore(event))
+	return err
+}
+
+func (s *FirestoreStore) ListFailedEvents(ctx context.Context, userID string, eventStatus pipeline.FailedEventStatus, limit int) ([]*pipeline.FailedEvent, error) {
+	q := s.client.Collection("users").Doc(userID).Collection("failed_events").Query
+	if eventStatus != pipeline.FailedEventStatus_FAILED_EVENT_STATUS_UNSPECIFIED {
+		q = q.Where("status", "==", int32(eventStatus))
+	}
+	return listFailedEvents(ctx, q.OrderBy("last_failed_at", firestore.Desc).Limit(limit))
+}
+
+func (s *FirestoreStore) ListDueFailedEvents(ctx context.Context, before time.Time, limit int) ([]*pipeline.FailedEvent, error) {
+	q := s.client.CollectionGroup("failed_events").
+		Where("status", "==", int32(pipeline.FailedEventStatus_FAILED_EVENT_STATUS_PENDING)).
+		Where("next_attempt_at", "<=", before).
+		OrderBy("next_attempt_at", firestore.Asc).
+		Limit(limit)
+	return listFailedEvents(ctx, q)
+}
+
+func listFailedEvents(ctx context.Context, q firestore.Query) ([]*pipeline.FailedEvent, error) {
+	iter := q.Documents(ctx)
+	defer iter.Stop()
+
+	var events []*pipeline.FailedEvent
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, storage.FirestoreToFailedEvent(doc.Data()))
+	}
+	return events, nil
+}
+
 // Helpers
 func encodeProtoMap(msg protoreflect.ProtoMessage) (map[string]interface{}, error) {
 	b, err := protojson.MarshalOptions{EmitUnpopulated: false, UseProtoNames: true}.Marshal(msg)
@@ -0,0 +1,278 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/types/formatters"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testActivityDuration is how long the onboarding test activity lasts.
+const testActivityDuration = 30 * time.Minute
+
+// starterSource is an integration a starter pipeline can read from, with the
+// enrichers recommended for the activities it usually sends.
+type starterSource struct {
+	integration string // UserIntegrations field name
+	source      pbactivity.ActivitySource
+	// testType is the type of the synthetic test activity
+	testType  pbactivity.ActivityType
+	enrichers []pbplugin.EnricherProviderType
+}
+
+var (
+	strengthStarter = []pbplugin.EnricherProviderType{
+		pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WORKOUT_SUMMARY,
+		pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MUSCLE_HEATMAP,
+	}
+	runStarter = []pbplugin.EnricherProviderType{
+		pbplugin.EnricherProviderType_ENRICHER_PROVIDER_HEART_RATE_SUMMARY,
+		pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PACE_SUMMARY,
+		pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER,
+	}
+	rideStarter = []pbplugin.EnricherProviderType{
+		pbplugin.EnricherProviderType_ENRICHER_PROVIDER_HEART_RATE_SUMMARY,
+		pbplugin.EnricherProviderType_ENRICHER_PROVIDER_POWER_SUMMARY,
+		pbplugin.EnricherProviderType_ENRICHER_PROVIDER_SPEED_SUMMARY,
+	}
+	wellnessStarter = []pbplugin.EnricherProviderType{
+		pbplugin.EnricherProviderType_ENRICHER_PROVIDER_HEART_RATE_SUMMARY,
+		pbplugin.EnricherProviderType_ENRICHER_PROVIDER_HEART_RATE_ZONES,
+	}
+)
+
+// starterSources is in order of preference: when several are connected the
+// first one not also chosen as the destination feeds the starter pipeline.
+var starterSources = []starterSource{
+	{"hevy", pbactivity.ActivitySource_SOURCE_HEVY, pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING, strengthStarter},
+	{"strava", pbactivity.ActivitySource_SOURCE_STRAVA, pbactivity.ActivityType_ACTIVITY_TYPE_RUN, runStarter},
+	{"zwift", pbactivity.ActivitySource_SOURCE_ZWIFT, pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_RIDE, rideStarter},
+	{"wahoo", pbactivity.ActivitySource_SOURCE_WAHOO, pbactivity.ActivityType_ACTIVITY_TYPE_RIDE, rideStarter},
+	{"polar", pbactivity.ActivitySource_SOURCE_POLAR, pbactivity.ActivityType_ACTIVITY_TYPE_RUN, runStarter},
+	{"apple_health", pbactivity.ActivitySource_SOURCE_APPLE_HEALTH, pbactivity.ActivityType_ACTIVITY_TYPE_RUN, runStarter},
+	{"health_connect", pbactivity.ActivitySource_SOURCE_HEALTH_CONNECT, pbactivity.ActivityType_ACTIVITY_TYPE_RUN, runStarter},
+	{"fitbit", pbactivity.ActivitySource_SOURCE_FITBIT, pbactivity.ActivityType_ACTIVITY_TYPE_WALK, wellnessStarter},
+	{"whoop", pbactivity.ActivitySource_SOURCE_WHOOP, pbactivity.ActivityType_ACTIVITY_TYPE_WORKOUT, wellnessStarter},
+	{"oura", pbactivity.ActivitySource_SOURCE_OURA, pbactivity.ActivityType_ACTIVITY_TYPE_WALK, wellnessStarter},
+	{"intervals", pbactivity.ActivitySource_SOURCE_INTERVALS, pbactivity.ActivityType_ACTIVITY_TYPE_RUN, runStarter},
+	{"trainingpeaks", pbactivity.ActivitySource_SOURCE_TRAININGPEAKS, pbactivity.ActivityType_ACTIVITY_TYPE_RUN, runStarter},
+}
+
+// starterDestinations is in order of preference, keyed like starterSources.
+var starterDestinations = []struct {
+	integration string
+	destination pbplugin.DestinationType
+}{
+	{"strava", pbplugin.DestinationType_DESTINATION_STRAVA},
+	{"intervals", pbplugin.DestinationType_DESTINATION_INTERVALS},
+	{"trainingpeaks", pbplugin.DestinationType_DESTINATION_TRAININGPEAKS},
+	{"komoot", pbplugin.DestinationType_DESTINATION_KOMOOT},
+	{"hevy", pbplugin.DestinationType_DESTINATION_HEVY},
+	{"google", pbplugin.DestinationType_DESTINATION_GOOGLESHEETS},
+	{"dropbox", pbplugin.DestinationType_DESTINATION_DROPBOX},
+	{"github", pbplugin.DestinationType_DESTINATION_GITHUB},
+}
+
+// chooseStarter picks the source and destination for a starter pipeline from
+// the user's connected integrations. They must be different integrations.
+func chooseStarter(integrations []string) (starterSource, pbplugin.DestinationType, bool) {
+	connected := make(map[string]bool, len(integrations))
+	for _, name := range integrations {
+		connected[name] = true
+	}
+	for _, src := range starterSources {
+		if !connected[src.integration] {
+			continue
+		}
+		for _, dest := range starterDestinations {
+			if connected[dest.integration] && dest.integration != src.integration {
+				return src, dest.destination, true
+			}
+		}
+	}
+	return starterSource{}, pbplugin.DestinationType_DESTINATION_UNSPECIFIED, false
+}
+
+// ProvisionStarterPipeline creates a starter pipeline, marked auto_created,
+// once the user has connected a source and a destination, then pushes a test
+// activity through it to the sandbox destination so the first run can be
+// shown straight away. Users who have a pipeline, or were already given a
+// starter one, get an empty response.
+func (s *Service) ProvisionStarterPipeline(ctx context.Context, req *pbsvc.ProvisionStarterPipelineRequest) (*pbsvc.ProvisionStarterPipelineResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	src, dest, ok := chooseStarter(req.Integrations)
+	if !ok {
+		return &pbsvc.ProvisionStarterPipelineResponse{}, nil
+	}
+
+	existing, err := s.store.ListPipelines(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list pipelines for starter pipeline", "error", err, "userId", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to read pipelines")
+	}
+	if len(existing) > 0 {
+		return &pbsvc.ProvisionStarterPipelineResponse{}, nil
+	}
+
+	claimed, err := s.store.ClaimStarterPipeline(ctx, req.UserId, time.Now())
+	if err != nil {
+		s.logger.Error(ctx, "failed to claim starter pipeline", "error", err, "userId", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to provision starter pipeline")
+	}
+	if !claimed {
+		return &pbsvc.ProvisionStarterPipelineResponse{}, nil
+	}
+
+	enrichers := make([]*pipeline.EnricherConfig, 0, len(src.enrichers))
+	for _, e := range src.enrichers {
+		enrichers = append(enrichers, &pipeline.EnricherConfig{ProviderType: e})
+	}
+	created, err := s.CreatePipeline(ctx, &pbsvc.CreatePipelineRequest{
+		UserId: req.UserId,
+		Pipeline: &pipeline.PipelineConfig{
+			Name:         fmt.Sprintf("%s to %s", formatters.FormatActivitySource(src.source), formatters.FormatDestination(dest)),
+			Source:       src.source.String(),
+			Enrichers:    enrichers,
+			Destinations: []pbplugin.DestinationType{dest},
+			AutoCreated:  true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbsvc.ProvisionStarterPipelineResponse{Pipeline: created}
+	runID, err := s.publishTestActivity(ctx, req.UserId, created, src, time.Now())
+	if err != nil {
+		// The pipeline is still usable; the user just doesn't get a first run
+		s.logger.Warn(ctx, "failed to publish starter test activity", "error", err, "pipelineId", created.Id)
+	} else {
+		resp.TestRunId = runID
+	}
+
+	s.logger.Info(ctx, "Provisioned starter pipeline", "userId", req.UserId, "pipelineId", created.Id, "source", created.Source, "destination", dest.String(), "testRunId", resp.TestRunId)
+	return resp, nil
+}
+
+// publishTestActivity sends a synthetic activity straight to the pipeline,
+// bypassing the splitter. It is flagged is_test, so the enricher delivers it
+// only to the sandbox destination. Returns the run ID it will be tracked as.
+func (s *Service) publishTestActivity(ctx context.Context, userID string, cfg *pipeline.PipelineConfig, src starterSource, now time.Time) (string, error) {
+	runID := fmt.Sprintf("onboarding-%d-%s", now.UnixMilli(), cfg.Id)
+	pipelineID := cfg.Id
+	payload := &pbevents.ActivityPayload{
+		Source:               src.source,
+		UserId:               userID,
+		Timestamp:            timestamppb.New(now),
+		StandardizedActivity: testActivity(userID, src, runID, now),
+		PipelineId:           &pipelineID,
+		PipelineExecutionId:  &runID,
+		IsTest:               true,
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("marshal test activity: %w", err)
+	}
+
+	ce := cloudevents.NewEvent()
+	ce.SetID(fmt.Sprintf("%d", now.UnixNano()))
+	ce.SetSource("com.fitglue.onboarding")
+	ce.SetType("com.fitglue.cloud_event.test_activity")
+	if err := ce.SetData(cloudevents.ApplicationJSON, data); err != nil {
+		return "", fmt.Errorf("set test activity data: %w", err)
+	}
+
+	if _, err := s.publisher.PublishCloudEvent(ctx, shared.TopicPipelineActivity, ce); err != nil {
+		return "", fmt.Errorf("publish test activity: %w", err)
+	}
+	return runID, nil
+}
+
+// testActivity builds the synthetic activity for a starter pipeline's first
+// run: a short strength workout, or a steady cardio session with heart rate
+// and speed (and power for rides) but no GPS.
+func testActivity(userID string, src starterSource, externalID string, now time.Time) *pbactivity.StandardizedActivity {
+	start := now.Add(-testActivityDuration).Truncate(time.Minute)
+	session := &pbactivity.Session{
+		StartTime:        timestamppb.New(start),
+		TotalElapsedTime: testActivityDuration.Seconds(),
+	}
+
+	if src.testType == pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING {
+		exercises := []struct {
+			name   string
+			muscle pbactivity.MuscleGroup
+			weight float64
+		}{
+			{"Bench Press (Barbell)", pbactivity.MuscleGroup_MUSCLE_GROUP_CHEST, 60},
+			{"Squat (Barbell)", pbactivity.MuscleGroup_MUSCLE_GROUP_QUADRICEPS, 80},
+			{"Bent Over Row (Barbell)", pbactivity.MuscleGroup_MUSCLE_GROUP_UPPER_BACK, 50},
+		}
+		setStart := start
+		for _, ex := range exercises {
+			for i := 0; i < 3; i++ {
+				session.StrengthSets = append(session.StrengthSets, &pbactivity.StrengthSet{
+					ExerciseName:       ex.name,
+					Reps:               8,
+					WeightKg:           ex.weight,
+					StartTime:          timestamppb.New(setStart),
+					DurationSeconds:    45,
+					PrimaryMuscleGroup: ex.muscle,
+				})
+				setStart = setStart.Add(3 * time.Minute)
+			}
+		}
+	} else {
+		speed := 2.8 // m/s, a relaxed run
+		power := int32(0)
+		switch src.testType {
+		case pbactivity.ActivityType_ACTIVITY_TYPE_RIDE, pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_RIDE:
+			speed, power = 8.0, 180
+		case pbactivity.ActivityType_ACTIVITY_TYPE_WALK, pbactivity.ActivityType_ACTIVITY_TYPE_WORKOUT:
+			speed = 1.4
+		}
+
+		lap := &pbactivity.Lap{StartTime: session.StartTime, TotalElapsedTime: session.TotalElapsedTime}
+		const step = 10 * time.Second
+		for elapsed := time.Duration(0); elapsed <= testActivityDuration; elapsed += step {
+			distance := speed * elapsed.Seconds()
+			lap.Records = append(lap.Records, &pbactivity.Record{
+				Timestamp: timestamppb.New(start.Add(elapsed)),
+				HeartRate: int32(120 + 30*elapsed/testActivityDuration),
+				Power:     power,
+				Speed:     speed,
+				Distance:  distance,
+			})
+		}
+		lap.TotalDistance = speed * testActivityDuration.Seconds()
+		session.TotalDistance = lap.TotalDistance
+		session.Laps = []*pbactivity.Lap{lap}
+	}
+
+	return &pbactivity.StandardizedActivity{
+		Source:      src.source,
+		ExternalId:  externalID,
+		UserId:      userID,
+		StartTime:   timestamppb.New(start),
+		Name:        "FitGlue Test Activity",
+		Type:        src.testType,
+		Description: "A sample activity showing what your new pipeline does. It was only sent to the FitGlue sandbox, not to your accounts.",
+		Sessions:    []*pbactivity.Session{session},
+	}
+}
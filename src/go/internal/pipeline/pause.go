@@ -0,0 +1,225 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	shared "github.com/fitglue/server/src/go/pkg"
+	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PausePipelines pauses one pipeline, or all of them (vacation mode) when no
+// pipeline_id is given. While paused, the splitter records incoming activities
+// as DEFERRED runs instead of processing them. An unset paused_until clears
+// the pause without touching already-deferred runs.
+func (s *Service) PausePipelines(ctx context.Context, req *pbsvc.PausePipelinesRequest) (*emptypb.Empty, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	var until time.Time
+	if req.PausedUntil != nil {
+		until = req.PausedUntil.AsTime()
+		if !until.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "paused_until must be in the future")
+		}
+	}
+
+	if err := s.setPausedUntil(ctx, req.UserId, req.PipelineId, until); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Pipelines pause updated", "userId", req.UserId, "pipelineId", req.PipelineId, "pausedUntil", until)
+	return &emptypb.Empty{}, nil
+}
+
+// ResumePipelines ends the pause in the same scope as PausePipelines and
+// either releases every deferred run into the pipeline, oldest first, or
+// discards them. Runs that fail to release stay DEFERRED so the call can be
+// retried. Ending vacation mode leaves the runs of pipelines that are still
+// paused on their own DEFERRED until those pipelines are resumed.
+func (s *Service) ResumePipelines(ctx context.Context, req *pbsvc.ResumePipelinesRequest) (*pbsvc.ResumePipelinesResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.setPausedUntil(ctx, req.UserId, req.PipelineId, time.Time{}); err != nil {
+		return nil, err
+	}
+
+	runs, err := s.store.ListDeferredRuns(ctx, req.UserId, req.PipelineId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list deferred runs", "error", err, "userId", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to list deferred activities")
+	}
+
+	var stillPaused map[string]bool
+	if req.PipelineId == "" {
+		if stillPaused, err = s.pausedPipelines(ctx, req.UserId); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &pbsvc.ResumePipelinesResponse{}
+	for _, run := range runs {
+		if stillPaused[run.PipelineId] {
+			continue
+		}
+
+		if req.Discard {
+			claimed, err := s.claimDeferredRun(ctx, req.UserId, run.Id, map[string]interface{}{
+				"status":         int32(pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SKIPPED),
+				"status_message": "Discarded after pause",
+				"updated_at":     time.Now(),
+			})
+			if err != nil {
+				s.logger.Error(ctx, "failed to discard deferred run", "error", err, "runId", run.Id)
+				continue
+			}
+			if claimed {
+				resp.Discarded++
+			}
+			continue
+		}
+
+		released, err := s.releaseDeferredRun(ctx, req.UserId, run)
+		if err != nil {
+			s.logger.Error(ctx, "failed to release deferred run", "error", err, "runId", run.Id)
+			continue
+		}
+		if released {
+			resp.Released++
+		}
+	}
+
+	s.logger.Info(ctx, "Pipelines resumed", "userId", req.UserId, "pipelineId", req.PipelineId, "released", resp.Released, "discarded", resp.Discarded)
+	return resp, nil
+}
+
+// setPausedUntil stores the pause on the pipeline, or on the user when
+// pipelineID is empty. A zero until clears it.
+func (s *Service) setPausedUntil(ctx context.Context, userID, pipelineID string, until time.Time) error {
+	if pipelineID == "" {
+		if err := s.store.SetPipelinesPausedUntil(ctx, userID, until); err != nil {
+			s.logger.Error(ctx, "failed to set pipelines paused until", "error", err, "userId", userID)
+			return status.Error(codes.Internal, "failed to update pause")
+		}
+		return nil
+	}
+
+	cfg, err := s.store.GetPipeline(ctx, userID, pipelineID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to get pipeline", "error", err)
+		return status.Error(codes.Internal, "failed to read pipeline config")
+	}
+	if cfg == nil {
+		return status.Error(codes.NotFound, "pipeline not found")
+	}
+
+	cfg.PausedUntil = nil
+	if !until.IsZero() {
+		cfg.PausedUntil = timestamppb.New(until)
+	}
+	if _, err := s.store.UpdatePipeline(ctx, userID, cfg); err != nil {
+		s.logger.Error(ctx, "failed to update pipeline pause", "error", err, "pipelineId", pipelineID)
+		return status.Error(codes.Internal, "failed to update pause")
+	}
+	return nil
+}
+
+// pausedPipelines returns the IDs of the user's pipelines paused on their
+// own until a time still in the future.
+func (s *Service) pausedPipelines(ctx context.Context, userID string) (map[string]bool, error) {
+	cfgs, err := s.store.ListPipelines(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list pipelines", "error", err, "userId", userID)
+		return nil, status.Error(codes.Internal, "failed to read pipelines")
+	}
+	now := time.Now()
+	paused := map[string]bool{}
+	for _, cfg := range cfgs {
+		if cfg.PausedUntil != nil && cfg.PausedUntil.AsTime().After(now) {
+			paused[cfg.Id] = true
+		}
+	}
+	return paused, nil
+}
+
+// claimDeferredRun writes updateData to the run only if it is still DEFERRED,
+// so concurrent resumes act on each run once. Returns false if another call
+// already took it.
+func (s *Service) claimDeferredRun(ctx context.Context, userID, runID string, updateData map[string]interface{}) (bool, error) {
+	err := s.store.TransitionPipelineRun(ctx, userID, runID, func(run *pipeline.PipelineRun) (map[string]interface{}, error) {
+		if run == nil || run.Status != pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DEFERRED {
+			return nil, errRunNotDeferred
+		}
+		return updateData, nil
+	})
+	if errors.Is(err, errRunNotDeferred) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// errRunNotDeferred aborts claimDeferredRun's transaction without writing.
+var errRunNotDeferred = errors.New("run is no longer deferred")
+
+// releaseDeferredRun publishes the stored per-pipeline payload to the
+// pipeline-activity topic, exactly as the splitter would have. The run is
+// claimed as RUNNING before publishing so the enricher's own status updates
+// are never overwritten, and put back to DEFERRED if the publish fails.
+// Returns false if another resume already released it.
+func (s *Service) releaseDeferredRun(ctx context.Context, userID string, run *pipeline.PipelineRun) (bool, error) {
+	if run.OriginalPayloadUri == "" {
+		return false, fmt.Errorf("deferred run has no payload URI")
+	}
+	data, err := s.blobStore.Get(ctx, run.OriginalPayloadUri)
+	if err != nil {
+		return false, fmt.Errorf("fetch deferred payload: %w", err)
+	}
+
+	ce, err := infrapubsub.NewCloudEvent(
+		infrapubsub.GetCloudEventSource(pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_PIPELINE_SPLITTER),
+		"com.fitglue.activity.pipeline",
+		data,
+	)
+	if err != nil {
+		return false, fmt.Errorf("create cloud event: %w", err)
+	}
+	ce.SetExtension("pipeline_execution_id", run.Id)
+
+	claimed, err := s.claimDeferredRun(ctx, userID, run.Id, map[string]interface{}{
+		"status":         int32(pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING),
+		"status_message": nil,
+		"updated_at":     time.Now(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("update run: %w", err)
+	}
+	if !claimed {
+		return false, nil
+	}
+
+	if _, err := s.publisher.PublishCloudEvent(ctx, shared.TopicPipelineActivity, ce); err != nil {
+		if rbErr := s.store.UpdatePipelineRun(ctx, userID, run.Id, map[string]interface{}{
+			"status":         int32(pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DEFERRED),
+			"status_message": run.GetStatusMessage(),
+		}); rbErr != nil {
+			s.logger.Warn(ctx, "failed to restore deferred run status", "error", rbErr, "runId", run.Id)
+		}
+		return false, fmt.Errorf("publish: %w", err)
+	}
+	return true, nil
+}
@@ -5,13 +5,22 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 	"github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ErrorStore wraps MockPipelineStore to inject errors on specific methods.
@@ -28,6 +37,7 @@ type ErrorStore struct {
 	getPipelineRunErr    error
 	listPipelineRunsErr  error
 	findRunByActivityErr error
+	listDailyStatsErr    error
 }
 
 func (e *ErrorStore) ListPipelines(ctx context.Context, userID string) ([]*pipeline.PipelineConfig, error) {
@@ -99,6 +109,12 @@ func (e *ErrorStore) FindPipelineRunByActivityId(ctx context.Context, userID, ac
 	}
 	return e.MockPipelineStore.FindPipelineRunByActivityId(ctx, userID, activityID)
 }
+func (e *ErrorStore) ListPipelineDailyStats(ctx context.Context, userID, pipelineID, since string) ([]*pipeline.PipelineDailyStats, error) {
+	if e.listDailyStatsErr != nil {
+		return nil, e.listDailyStatsErr
+	}
+	return e.MockPipelineStore.ListPipelineDailyStats(ctx, userID, pipelineID, since)
+}
 
 // --- Validation error tests ---
 
@@ -442,6 +458,77 @@ func TestPipeline_HappyPaths(t *testing.T) {
 		if p["activityId"] != "a1" {
 			t.Errorf("expected activityId=a1")
 		}
+		if _, ok := p["pipelineConfigVersion"]; ok {
+			t.Errorf("expected no pipelineConfigVersion for an unversioned run")
+		}
+	})
+
+	t.Run("RepostActivity_replayOverride", func(t *testing.T) {
+		store := NewMockStore()
+		uri := "gs://bucket/original/a4.json"
+		store.Runs["u1_r4"] = &pipeline.PipelineRun{Id: "r4", ActivityId: "a4", OriginalPayloadUri: uri}
+		pub := &MockPublisher{}
+		blob := &MockBlobStore{Blobs: map[string][]byte{uri: []byte(`{"source":"SOURCE_HEVY","pipelineId":"p1","pipelineExecutionId":"r4","retryAttempts":{"weather":1}}`)}}
+		svc := NewService(store, pub, blob, mockLogger{})
+		override := &pbevents.ReplayOverride{
+			Enrichers: []*pbevents.ReplayEnricher{{ProviderType: plugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER}},
+		}
+
+		_, err := svc.RepostActivity(ctx, &pbsvc.RepostActivityRequest{UserId: "u1", ActivityId: "a4", Mode: "full-pipeline", ReplayOverride: override})
+		if err != nil {
+			t.Fatalf("expected success, got %v", err)
+		}
+		if len(pub.PublishedEvents) != 1 {
+			t.Fatalf("expected 1 event published, got %d", len(pub.PublishedEvents))
+		}
+
+		var p pbevents.ActivityPayload
+		if err := protojson.Unmarshal(pub.PublishedEvents[0].Data(), &p); err != nil {
+			t.Fatalf("unmarshal payload: %v", err)
+		}
+		if p.GetReplayOf() != "r4" || !proto.Equal(p.ReplayOverride, override) {
+			t.Errorf("expected a replay of r4 with the override, got %v", &p)
+		}
+		if !strings.HasPrefix(p.GetPipelineExecutionId(), "r4-replay-") {
+			t.Errorf("expected a new run id, got %q", p.GetPipelineExecutionId())
+		}
+		if len(p.RetryAttempts) != 0 {
+			t.Errorf("expected retry attempts to be reset, got %v", p.RetryAttempts)
+		}
+	})
+
+	t.Run("RepostActivity_invalidReplayOverride", func(t *testing.T) {
+		svc := NewService(NewMockStore(), &MockPublisher{}, &MockBlobStore{Blobs: map[string][]byte{}}, mockLogger{})
+		for name, req := range map[string]*pbsvc.RepostActivityRequest{
+			"targeted mode":     {Mode: "retry-destination", Destination: "strava", ReplayOverride: &pbevents.ReplayOverride{Destinations: []plugin.DestinationType{plugin.DestinationType_DESTINATION_STRAVA}}},
+			"empty":             {Mode: "full-pipeline", ReplayOverride: &pbevents.ReplayOverride{}},
+			"unset enricher":    {Mode: "full-pipeline", ReplayOverride: &pbevents.ReplayOverride{Enrichers: []*pbevents.ReplayEnricher{{}}}},
+			"unset destination": {Mode: "full-pipeline", ReplayOverride: &pbevents.ReplayOverride{Destinations: []plugin.DestinationType{plugin.DestinationType_DESTINATION_UNSPECIFIED}}},
+		} {
+			req.UserId, req.ActivityId = "u1", "a1"
+			if _, err := svc.RepostActivity(ctx, req); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("RepostActivity_pinsConfigVersion", func(t *testing.T) {
+		store := NewMockStore()
+		uri := "gs://bucket/original/a3.json"
+		store.Runs["u1_r3"] = &pipeline.PipelineRun{Id: "r3", ActivityId: "a3", OriginalPayloadUri: uri, PipelineConfigVersion: 4}
+		pub := &MockPublisher{}
+		blob := &MockBlobStore{Blobs: map[string][]byte{uri: []byte(`{"source":"hevy"}`)}}
+		svc := NewService(store, pub, blob, mockLogger{})
+
+		if _, err := svc.RepostActivity(ctx, &pbsvc.RepostActivityRequest{UserId: "u1", ActivityId: "a3", Mode: "full-pipeline"}); err != nil {
+			t.Fatalf("expected success, got %v", err)
+		}
+
+		var p map[string]interface{}
+		json.Unmarshal(pub.PublishedEvents[0].Data(), &p)
+		if p["pipelineConfigVersion"] != float64(4) {
+			t.Errorf("expected pipelineConfigVersion=4, got %v", p["pipelineConfigVersion"])
+		}
 	})
 
 	t.Run("RepostActivity_missedDestination_success", func(t *testing.T) {
@@ -466,3 +553,872 @@ func TestPipeline_HappyPaths(t *testing.T) {
 		}
 	})
 }
+
+func TestRetryPipelineRun(t *testing.T) {
+	ctx := context.Background()
+	uri := "gs://bucket/payloads/u1/a1.json"
+	newRun := func() *pipeline.PipelineRun {
+		return &pipeline.PipelineRun{
+			Id:                 "r1",
+			PipelineId:         "p1",
+			ActivityId:         "a1",
+			OriginalPayloadUri: uri,
+			Boosters: []*pipeline.BoosterExecution{
+				{ProviderName: "weather"},
+				{ProviderName: "ai-banner"},
+			},
+		}
+	}
+
+	t.Run("missing_fields", func(t *testing.T) {
+		svc := NewService(NewMockStore(), &MockPublisher{}, &MockBlobStore{Blobs: map[string][]byte{}}, mockLogger{})
+		_, err := svc.RetryPipelineRun(ctx, &pbsvc.RetryPipelineRunRequest{UserId: "u1"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("run_of_another_pipeline", func(t *testing.T) {
+		store := NewMockStore()
+		store.Runs["u1_r1"] = newRun()
+		svc := NewService(store, &MockPublisher{}, &MockBlobStore{Blobs: map[string][]byte{}}, mockLogger{})
+		_, err := svc.RetryPipelineRun(ctx, &pbsvc.RetryPipelineRunRequest{UserId: "u1", PipelineRunId: "r1", PipelineId: "p2"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("no_payload_uri", func(t *testing.T) {
+		store := NewMockStore()
+		run := newRun()
+		run.OriginalPayloadUri = ""
+		store.Runs["u1_r1"] = run
+		svc := NewService(store, &MockPublisher{}, &MockBlobStore{Blobs: map[string][]byte{}}, mockLogger{})
+		_, err := svc.RetryPipelineRun(ctx, &pbsvc.RetryPipelineRunRequest{UserId: "u1", PipelineRunId: "r1"})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("expected FailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("unknown_enricher", func(t *testing.T) {
+		store := NewMockStore()
+		store.Runs["u1_r1"] = newRun()
+		pub := &MockPublisher{}
+		svc := NewService(store, pub, &MockBlobStore{Blobs: map[string][]byte{uri: []byte(`{}`)}}, mockLogger{})
+		_, err := svc.RetryPipelineRun(ctx, &pbsvc.RetryPipelineRunRequest{UserId: "u1", PipelineRunId: "r1", Enrichers: []string{"parkrun"}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err)
+		}
+		if len(pub.PublishedEvents) != 0 {
+			t.Errorf("expected nothing published, got %d events", len(pub.PublishedEvents))
+		}
+	})
+
+	t.Run("publishes_targeted_resume", func(t *testing.T) {
+		store := NewMockStore()
+		run := newRun()
+		run.PipelineConfigVersion = 3
+		run.Destinations = []*pipeline.DestinationOutcome{{ExternalId: proto.String("strava-1")}}
+		store.Runs["u1_r1"] = run
+		pub := &MockPublisher{}
+		blob := &MockBlobStore{Blobs: map[string][]byte{uri: []byte(`{"source":"SOURCE_HEVY","pipelineId":"p1","resumePendingInputId":"old","retryAttempts":{"weather":2}}`)}}
+		svc := NewService(store, pub, blob, mockLogger{})
+
+		_, err := svc.RetryPipelineRun(ctx, &pbsvc.RetryPipelineRunRequest{UserId: "u1", PipelineRunId: "r1", PipelineId: "p1", Enrichers: []string{"weather"}})
+		if err != nil {
+			t.Fatalf("expected success, got %v", err)
+		}
+		if len(pub.PublishedEvents) != 1 {
+			t.Fatalf("expected 1 event published, got %d", len(pub.PublishedEvents))
+		}
+
+		var p map[string]interface{}
+		json.Unmarshal(pub.PublishedEvents[0].Data(), &p)
+		if p["isResume"] != true || p["useUpdateMethod"] != true {
+			t.Errorf("expected isResume and useUpdateMethod, got %v", p)
+		}
+		if p["activityId"] != "a1" || p["pipelineExecutionId"] != "r1" {
+			t.Errorf("expected the original activity and run, got %v", p)
+		}
+		if only, _ := p["resumeOnlyEnrichers"].([]interface{}); len(only) != 1 || only[0] != "weather" {
+			t.Errorf("expected resumeOnlyEnrichers=[weather], got %v", p["resumeOnlyEnrichers"])
+		}
+		if _, ok := p["resumePendingInputId"]; ok {
+			t.Errorf("expected resumePendingInputId to be dropped")
+		}
+		if _, ok := p["retryAttempts"]; ok {
+			t.Errorf("expected retryAttempts to be reset")
+		}
+		if p["pipelineConfigVersion"] != float64(3) {
+			t.Errorf("expected pipelineConfigVersion=3, got %v", p["pipelineConfigVersion"])
+		}
+	})
+}
+
+func TestRetryDueRuns(t *testing.T) {
+	ctx := context.Background()
+	uri := "gs://bucket/payloads/u1/a1.json"
+	store := NewMockStore()
+	store.Runs["u1_due"] = &pipeline.PipelineRun{
+		Id:                 "due",
+		ActivityId:         "a1",
+		Status:             pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING,
+		OriginalPayloadUri: uri,
+		RetryAttempts:      map[string]int32{"weather": 2},
+		NextRetryAt:        timestamppb.New(time.Now().Add(-time.Minute)),
+	}
+	store.Runs["u1_later"] = &pipeline.PipelineRun{
+		Id:                 "later",
+		ActivityId:         "a2",
+		Status:             pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING,
+		OriginalPayloadUri: uri,
+		NextRetryAt:        timestamppb.New(time.Now().Add(time.Hour)),
+	}
+	pub := &MockPublisher{}
+	blob := &MockBlobStore{Blobs: map[string][]byte{uri: []byte(`{"source":"SOURCE_HEVY","pipelineId":"p1"}`)}}
+	svc := NewService(store, pub, blob, mockLogger{})
+
+	if err := svc.RetryDueRuns(ctx, cloudevents.NewEvent()); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+	if len(pub.PublishedEvents) != 1 {
+		t.Fatalf("expected 1 event published, got %d", len(pub.PublishedEvents))
+	}
+
+	var p map[string]interface{}
+	json.Unmarshal(pub.PublishedEvents[0].Data(), &p)
+	if p["isResume"] != true || p["pipelineExecutionId"] != "due" {
+		t.Errorf("expected a resume of run due, got %v", p)
+	}
+	if attempts, _ := p["retryAttempts"].(map[string]interface{}); attempts["weather"] != float64(2) {
+		t.Errorf("expected retryAttempts weather=2, got %v", p["retryAttempts"])
+	}
+	if store.Runs["u1_due"].NextRetryAt != nil {
+		t.Error("expected next_retry_at cleared on the resumed run")
+	}
+	if store.Runs["u1_later"].NextRetryAt == nil {
+		t.Error("expected the run not yet due to keep its next_retry_at")
+	}
+}
+
+func TestGetPipelineRunDebugBundle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("missing_run", func(t *testing.T) {
+		svc := NewService(NewMockStore(), &MockPublisher{}, &MockBlobStore{Blobs: map[string][]byte{}}, mockLogger{})
+		_, err := svc.GetPipelineRunDebugBundle(ctx, &pbsvc.GetPipelineRunDebugBundleRequest{UserId: "u1", PipelineId: "p1", RunId: "missing"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("assembles_bundle", func(t *testing.T) {
+		store := NewMockStore()
+		store.Runs["u1_r1"] = &pipeline.PipelineRun{
+			Id:                 "r1",
+			PipelineId:         "p1",
+			OriginalPayloadUri: "gs://payloads/r1/original.json",
+			EnrichedEventUri:   "gs://payloads/r1/enriched.json",
+		}
+		store.Pipelines["u1_p1"] = &pipeline.PipelineConfig{Id: "p1", Name: "Morning Runs"}
+		store.Executions["u1_r1"] = []*pipeline.ExecutionRecord{
+			{ExecutionId: "e1", Service: "enricher"},
+			{ExecutionId: "e2", Service: "strava-uploader"},
+		}
+		blobs := &MockBlobStore{Blobs: map[string][]byte{
+			"gs://payloads/r1/original.json": []byte(`{"source":"SOURCE_HEVY"}`),
+		}}
+		svc := NewService(store, &MockPublisher{}, blobs, mockLogger{})
+
+		bundle, err := svc.GetPipelineRunDebugBundle(ctx, &pbsvc.GetPipelineRunDebugBundleRequest{UserId: "u1", PipelineId: "p1", RunId: "r1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if bundle.Run.GetId() != "r1" || bundle.Pipeline.GetName() != "Morning Runs" {
+			t.Errorf("expected run r1 with pipeline Morning Runs, got %v / %v", bundle.Run, bundle.Pipeline)
+		}
+		if len(bundle.Executions) != 2 {
+			t.Errorf("expected 2 executions, got %d", len(bundle.Executions))
+		}
+		if bundle.OriginalPayloadJson != `{"source":"SOURCE_HEVY"}` {
+			t.Errorf("unexpected original payload: %q", bundle.OriginalPayloadJson)
+		}
+		if bundle.EnrichedEventJson != "" || len(bundle.Warnings) != 2 {
+			t.Errorf("expected warnings for the unversioned config and the missing enriched event, got %v", bundle.Warnings)
+		}
+		if bundle.GeneratedAt == nil {
+			t.Error("expected generated_at to be set")
+		}
+	})
+
+	t.Run("deleted_pipeline_is_a_warning", func(t *testing.T) {
+		store := NewMockStore()
+		store.Runs["u1_r1"] = &pipeline.PipelineRun{Id: "r1", PipelineId: "gone"}
+		svc := NewService(store, &MockPublisher{}, &MockBlobStore{Blobs: map[string][]byte{}}, mockLogger{})
+
+		bundle, err := svc.GetPipelineRunDebugBundle(ctx, &pbsvc.GetPipelineRunDebugBundleRequest{UserId: "u1", PipelineId: "gone", RunId: "r1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if bundle.Pipeline != nil || len(bundle.Warnings) != 1 {
+			t.Errorf("expected no pipeline and one warning, got %v / %v", bundle.Pipeline, bundle.Warnings)
+		}
+	})
+}
+
+func TestGetPipelineRunDebugBundle_VersionedAndRedacted(t *testing.T) {
+	ctx := context.Background()
+	store := NewMockStore()
+	store.Runs["u1_r1"] = &pipeline.PipelineRun{
+		Id:                    "r1",
+		PipelineId:            "p1",
+		PipelineConfigVersion: 2,
+		EnrichedEventUri:      "gs://payloads/r1/enriched.json",
+	}
+	store.Pipelines["u1_p1"] = &pipeline.PipelineConfig{Id: "p1", Name: "Renamed since", Version: 3}
+	store.Versions["u1_p1_2"] = &pipeline.PipelineConfigVersion{PipelineId: "p1", Version: 2, Config: &pipeline.PipelineConfig{
+		Id:      "p1",
+		Name:    "Morning Runs",
+		Version: 2,
+		DestinationConfigs: map[string]*pipeline.DestinationConfig{
+			"webdav":  {Config: map[string]string{"url": "https://dav.example.com", "password": "hunter2"}},
+			"discord": {Config: map[string]string{"webhook_url": "https://discord.com/api/webhooks/1/abc"}},
+		},
+	}}
+	store.Executions["u1_r1"] = []*pipeline.ExecutionRecord{
+		{ExecutionId: "e1", Service: "discord-uploader", InputsJson: proto.String(`{"calls":[{"url":"https://discord.com/api/webhooks/1/abc"}]}`)},
+	}
+	blobs := &MockBlobStore{Blobs: map[string][]byte{
+		"gs://payloads/r1/enriched.json": []byte(`{"enrichment_metadata":{"discord_webhook_url":"https://discord.com/api/webhooks/1/abc","webdav_url":"https://dav.example.com"}}`),
+	}}
+	svc := NewService(store, &MockPublisher{}, blobs, mockLogger{})
+
+	t.Run("uses_the_version_the_run_used", func(t *testing.T) {
+		bundle, err := svc.GetPipelineRunDebugBundle(ctx, &pbsvc.GetPipelineRunDebugBundleRequest{UserId: "u1", PipelineId: "p1", RunId: "r1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if bundle.Pipeline.GetName() != "Morning Runs" || len(bundle.Warnings) != 0 {
+			t.Errorf("expected version 2 without warnings, got %v / %v", bundle.Pipeline, bundle.Warnings)
+		}
+
+		dests := bundle.Pipeline.DestinationConfigs
+		if dests["webdav"].Config["password"] != redactedValue || dests["discord"].Config["webhook_url"] != redactedValue {
+			t.Errorf("expected credentials redacted, got %v", dests)
+		}
+		if dests["webdav"].Config["url"] != "https://dav.example.com" {
+			t.Errorf("expected other config kept, got %v", dests["webdav"].Config)
+		}
+		if strings.Contains(bundle.EnrichedEventJson, "api/webhooks") || !strings.Contains(bundle.EnrichedEventJson, "dav.example.com") {
+			t.Errorf("expected the webhook URL redacted from the enriched event, got %s", bundle.EnrichedEventJson)
+		}
+		if strings.Contains(bundle.Executions[0].GetInputsJson(), "api/webhooks") {
+			t.Errorf("expected the webhook URL redacted from executions, got %s", bundle.Executions[0].GetInputsJson())
+		}
+		if store.Versions["u1_p1_2"].Config.DestinationConfigs["webdav"].Config["password"] != "hunter2" {
+			t.Error("expected the stored version to be left alone")
+		}
+	})
+
+	t.Run("run_from_another_pipeline", func(t *testing.T) {
+		_, err := svc.GetPipelineRunDebugBundle(ctx, &pbsvc.GetPipelineRunDebugBundleRequest{UserId: "u1", PipelineId: "other", RunId: "r1"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+}
+
+func TestGetEnricherUsage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("missing_user", func(t *testing.T) {
+		svc := NewService(NewMockStore(), &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+		_, err := svc.GetEnricherUsage(ctx, &pbsvc.GetEnricherUsageRequest{})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("store_error", func(t *testing.T) {
+		es := &ErrorStore{MockPipelineStore: NewMockStore(), listPipelineRunsErr: errors.New("db down")}
+		svc := NewService(es, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+		_, err := svc.GetEnricherUsage(ctx, &pbsvc.GetEnricherUsageRequest{UserId: "u1"})
+		if status.Code(err) != codes.Internal {
+			t.Errorf("expected Internal, got %v", err)
+		}
+	})
+
+	t.Run("aggregates_boosters", func(t *testing.T) {
+		earlier := time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+		later := earlier.Add(24 * time.Hour)
+		failure := "api down"
+		store := NewMockStore()
+		store.Runs["u1_r1"] = &pipeline.PipelineRun{Id: "r1", PipelineId: "p1", CreatedAt: timestamppb.New(earlier), Boosters: []*pipeline.BoosterExecution{
+			{ProviderName: "weather", Status: "SUCCESS", DurationMs: 100, ContributedDescription: true},
+			{ProviderName: "branding", Status: "SUCCESS", DurationMs: 5},
+		}}
+		store.Runs["u1_r2"] = &pipeline.PipelineRun{Id: "r2", PipelineId: "p1", CreatedAt: timestamppb.New(later), Boosters: []*pipeline.BoosterExecution{
+			{ProviderName: "weather", Status: "FAILED", DurationMs: 300, Error: &failure},
+		}}
+		store.Runs["u1_r3"] = &pipeline.PipelineRun{Id: "r3", PipelineId: "p2", CreatedAt: timestamppb.New(later), Boosters: []*pipeline.BoosterExecution{
+			{ProviderName: "weather", Status: "SKIPPED_BUDGET"},
+		}}
+		svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+
+		resp, err := svc.GetEnricherUsage(ctx, &pbsvc.GetEnricherUsageRequest{UserId: "u1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.RunsAnalyzed != 3 || len(resp.Enrichers) != 2 {
+			t.Fatalf("expected 3 runs and 2 enrichers, got %d runs, %v", resp.RunsAnalyzed, resp.Enrichers)
+		}
+		weather := resp.Enrichers[0]
+		if weather.ProviderName != "weather" || weather.Uses != 3 || weather.Succeeded != 1 || weather.Failed != 1 || weather.Skipped != 1 {
+			t.Errorf("unexpected weather counts: %v", weather)
+		}
+		if weather.DescriptionContributions != 1 || weather.AverageDurationMs != 200 {
+			t.Errorf("expected 1 description contribution and 200ms average, got %v", weather)
+		}
+		if !weather.LastRunAt.AsTime().Equal(later) {
+			t.Errorf("expected last run at %v, got %v", later, weather.LastRunAt.AsTime())
+		}
+
+		resp, err = svc.GetEnricherUsage(ctx, &pbsvc.GetEnricherUsageRequest{UserId: "u1", PipelineId: "p2"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.RunsAnalyzed != 1 || len(resp.Enrichers) != 1 || resp.Enrichers[0].Skipped != 1 {
+			t.Errorf("expected only the p2 run, got %v", resp)
+		}
+	})
+}
+
+func TestGetPipelineCalendar(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("missing_pipeline", func(t *testing.T) {
+		svc := NewService(NewMockStore(), &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+		_, err := svc.GetPipelineCalendar(ctx, &pbsvc.GetPipelineCalendarRequest{UserId: "u1"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("store_error", func(t *testing.T) {
+		es := &ErrorStore{MockPipelineStore: NewMockStore(), listDailyStatsErr: errors.New("db down")}
+		svc := NewService(es, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+		_, err := svc.GetPipelineCalendar(ctx, &pbsvc.GetPipelineCalendarRequest{UserId: "u1", PipelineId: "p1"})
+		if status.Code(err) != codes.Internal {
+			t.Errorf("expected Internal, got %v", err)
+		}
+	})
+
+	t.Run("counts_runs_within_window", func(t *testing.T) {
+		today := time.Now().UTC()
+		store := NewMockStore()
+		store.DailyStats["p1"] = []*pipeline.PipelineDailyStats{
+			{PipelineId: "p1", Date: today.AddDate(0, 0, -40).Format(time.DateOnly), Runs: map[string]pipeline.PipelineRunStatus{
+				"old": pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED,
+			}},
+			{PipelineId: "p1", Date: today.AddDate(0, 0, -1).Format(time.DateOnly), Runs: map[string]pipeline.PipelineRunStatus{
+				"r1": pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED,
+				"r2": pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED,
+				"r3": pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_FAILED,
+				"r4": pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_TIER_BLOCKED,
+			}},
+			{PipelineId: "p1", Date: today.Format(time.DateOnly), Runs: map[string]pipeline.PipelineRunStatus{
+				"r5": pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_PARTIAL,
+				"r6": pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING,
+			}},
+		}
+		svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+
+		resp, err := svc.GetPipelineCalendar(ctx, &pbsvc.GetPipelineCalendarRequest{UserId: "u1", PipelineId: "p1", Days: 30})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.Days) != 2 {
+			t.Fatalf("expected 2 days inside the window, got %v", resp.Days)
+		}
+		yesterday := resp.Days[0]
+		if yesterday.Total != 4 || yesterday.Synced != 2 || yesterday.Failed != 1 || yesterday.Skipped != 1 {
+			t.Errorf("unexpected counts for yesterday: %v", yesterday)
+		}
+		if got := resp.Days[1]; got.Total != 2 || got.Partial != 1 || got.InProgress != 1 {
+			t.Errorf("unexpected counts for today: %v", got)
+		}
+
+		resp, err = svc.GetPipelineCalendar(ctx, &pbsvc.GetPipelineCalendarRequest{UserId: "u1", PipelineId: "p1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.Days) != 3 {
+			t.Errorf("expected the default window to cover all 3 days, got %d", len(resp.Days))
+		}
+	})
+}
+
+func TestPausePipelines(t *testing.T) {
+	ctx := context.Background()
+	future := timestamppb.New(time.Now().Add(7 * 24 * time.Hour))
+
+	t.Run("rejects_past_pause", func(t *testing.T) {
+		svc := NewService(NewMockStore(), &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+		_, err := svc.PausePipelines(ctx, &pbsvc.PausePipelinesRequest{UserId: "u1", PausedUntil: timestamppb.New(time.Now().Add(-time.Hour))})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("vacation_mode", func(t *testing.T) {
+		store := NewMockStore()
+		svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+		if _, err := svc.PausePipelines(ctx, &pbsvc.PausePipelinesRequest{UserId: "u1", PausedUntil: future}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !store.PausedUntil["u1"].Equal(future.AsTime()) {
+			t.Errorf("expected user pause %v, got %v", future.AsTime(), store.PausedUntil["u1"])
+		}
+
+		if _, err := svc.PausePipelines(ctx, &pbsvc.PausePipelinesRequest{UserId: "u1"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := store.PausedUntil["u1"]; ok {
+			t.Error("expected an unset paused_until to clear vacation mode")
+		}
+	})
+
+	t.Run("single_pipeline", func(t *testing.T) {
+		store := NewMockStore()
+		store.Pipelines["u1_p1"] = &pipeline.PipelineConfig{Id: "p1"}
+		svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+		if _, err := svc.PausePipelines(ctx, &pbsvc.PausePipelinesRequest{UserId: "u1", PipelineId: "p1", PausedUntil: future}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !store.Pipelines["u1_p1"].PausedUntil.AsTime().Equal(future.AsTime()) {
+			t.Errorf("expected pipeline pause to be set, got %v", store.Pipelines["u1_p1"].PausedUntil)
+		}
+		if _, ok := store.PausedUntil["u1"]; ok {
+			t.Error("pausing one pipeline must not enable vacation mode")
+		}
+
+		_, err := svc.PausePipelines(ctx, &pbsvc.PausePipelinesRequest{UserId: "u1", PipelineId: "missing", PausedUntil: future})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+}
+
+func TestResumePipelines(t *testing.T) {
+	ctx := context.Background()
+
+	deferredStore := func() (*MockPipelineStore, *MockBlobStore) {
+		store := NewMockStore()
+		store.PausedUntil["u1"] = time.Now().Add(time.Hour)
+		blobs := &MockBlobStore{Blobs: map[string][]byte{}}
+		for _, id := range []string{"exec1-p1", "exec2-p2"} {
+			uri := "gs://bucket/deferred/u1/" + id + ".json"
+			blobs.Blobs[uri] = []byte(`{"user_id":"u1"}`)
+			store.Runs["u1_"+id] = &pipeline.PipelineRun{
+				Id:                 id,
+				PipelineId:         id[len(id)-2:],
+				Status:             pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DEFERRED,
+				OriginalPayloadUri: uri,
+			}
+		}
+		return store, blobs
+	}
+
+	t.Run("releases_deferred_runs", func(t *testing.T) {
+		store, blobs := deferredStore()
+		pub := &MockPublisher{}
+		svc := NewService(store, pub, blobs, mockLogger{})
+
+		resp, err := svc.ResumePipelines(ctx, &pbsvc.ResumePipelinesRequest{UserId: "u1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Released != 2 || resp.Discarded != 0 {
+			t.Errorf("expected 2 released, got %v", resp)
+		}
+		if len(pub.PublishedEvents) != 2 {
+			t.Fatalf("expected 2 published events, got %d", len(pub.PublishedEvents))
+		}
+		if got := pub.PublishedEvents[0].Type(); got != "com.fitglue.activity.pipeline" {
+			t.Errorf("expected released event to match the splitter's, got type %q", got)
+		}
+		if _, ok := store.PausedUntil["u1"]; ok {
+			t.Error("expected vacation mode to be cleared")
+		}
+		if got := store.Runs["u1_exec1-p1"].Status; got != pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING {
+			t.Errorf("expected released run to be RUNNING, got %v", got)
+		}
+	})
+
+	t.Run("discards_one_pipeline", func(t *testing.T) {
+		store, blobs := deferredStore()
+		store.Pipelines["u1_p1"] = &pipeline.PipelineConfig{Id: "p1", PausedUntil: timestamppb.New(time.Now().Add(time.Hour))}
+		pub := &MockPublisher{}
+		svc := NewService(store, pub, blobs, mockLogger{})
+
+		resp, err := svc.ResumePipelines(ctx, &pbsvc.ResumePipelinesRequest{UserId: "u1", PipelineId: "p1", Discard: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Discarded != 1 || resp.Released != 0 || len(pub.PublishedEvents) != 0 {
+			t.Errorf("expected 1 discarded and nothing published, got %v", resp)
+		}
+		if store.Pipelines["u1_p1"].PausedUntil != nil {
+			t.Error("expected pipeline pause to be cleared")
+		}
+		if got := store.Runs["u1_exec1-p1"].Status; got != pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SKIPPED {
+			t.Errorf("expected discarded run to be SKIPPED, got %v", got)
+		}
+		if got := store.Runs["u1_exec2-p2"].Status; got != pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DEFERRED {
+			t.Errorf("expected other pipeline's run to stay DEFERRED, got %v", got)
+		}
+	})
+
+	t.Run("keeps_individually_paused_pipelines_deferred", func(t *testing.T) {
+		store, blobs := deferredStore()
+		store.Pipelines["u1_p2"] = &pipeline.PipelineConfig{Id: "p2", PausedUntil: timestamppb.New(time.Now().Add(time.Hour))}
+		pub := &MockPublisher{}
+		svc := NewService(store, pub, blobs, mockLogger{})
+
+		resp, err := svc.ResumePipelines(ctx, &pbsvc.ResumePipelinesRequest{UserId: "u1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Released != 1 || len(pub.PublishedEvents) != 1 {
+			t.Errorf("expected only the unpaused pipeline's run released, got %v", resp)
+		}
+		if got := store.Runs["u1_exec2-p2"].Status; got != pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DEFERRED {
+			t.Errorf("expected paused pipeline's run to stay DEFERRED, got %v", got)
+		}
+	})
+
+	t.Run("concurrent_resumes_release_once", func(t *testing.T) {
+		store, blobs := deferredStore()
+		pub := &MockPublisher{}
+		svc := NewService(store, pub, blobs, mockLogger{})
+		// Both calls listed the run while it was still DEFERRED
+		first := proto.Clone(store.Runs["u1_exec1-p1"]).(*pipeline.PipelineRun)
+		second := proto.Clone(first).(*pipeline.PipelineRun)
+
+		if released, err := svc.releaseDeferredRun(ctx, "u1", first); err != nil || !released {
+			t.Fatalf("expected the first resume to release the run, got %v, %v", released, err)
+		}
+		if released, err := svc.releaseDeferredRun(ctx, "u1", second); err != nil || released {
+			t.Errorf("expected the second resume to skip the run, got %v, %v", released, err)
+		}
+		if len(pub.PublishedEvents) != 1 {
+			t.Errorf("expected 1 published event, got %d", len(pub.PublishedEvents))
+		}
+	})
+
+	t.Run("missing_payload_stays_deferred", func(t *testing.T) {
+		store, _ := deferredStore()
+		svc := NewService(store, &MockPublisher{}, &MockBlobStore{Blobs: map[string][]byte{}}, mockLogger{})
+
+		resp, err := svc.ResumePipelines(ctx, &pbsvc.ResumePipelinesRequest{UserId: "u1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Released != 0 {
+			t.Errorf("expected nothing released, got %v", resp)
+		}
+		if got := store.Runs["u1_exec1-p1"].Status; got != pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DEFERRED {
+			t.Errorf("expected run to stay DEFERRED, got %v", got)
+		}
+	})
+}
+
+func TestCorrectActivityType(t *testing.T) {
+	ctx := context.Background()
+	crossfit := pbactivity.ActivityType_ACTIVITY_TYPE_CROSSFIT
+	workout := pbactivity.ActivityType_ACTIVITY_TYPE_WORKOUT
+
+	newStore := func() *MockPipelineStore {
+		store := NewMockStore()
+		for i, title := range []string{"Hyrox Sim #3", "hyrox sim 4"} {
+			id := fmt.Sprintf("run%d", i)
+			store.Runs["u1_"+id] = &pipeline.PipelineRun{
+				Id:         id,
+				ActivityId: fmt.Sprintf("act%d", i),
+				Source:     "SOURCE_HEVY",
+				Title:      title,
+				Type:       workout,
+			}
+		}
+		return store
+	}
+
+	t.Run("repeated_corrections_learn_one_rule", func(t *testing.T) {
+		store := newStore()
+		svc := NewService(store, &MockPublisher{}, nil, mockLogger{})
+
+		var rule *pipeline.ActivityTypeRule
+		for _, act := range []string{"act0", "act1"} {
+			resp, err := svc.CorrectActivityType(ctx, &pbsvc.CorrectActivityTypeRequest{UserId: "u1", ActivityId: act, ActivityType: crossfit})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			rule = resp.Rule
+		}
+
+		if len(store.TypeRules) != 1 {
+			t.Fatalf("expected a single rule, got %d", len(store.TypeRules))
+		}
+		if rule.TitlePattern != "hyrox sim" || rule.Source != "SOURCE_HEVY" || rule.TargetType != crossfit {
+			t.Errorf("unexpected rule: %v", rule)
+		}
+		if rule.CorrectionCount != 2 {
+			t.Errorf("expected 2 corrections, got %d", rule.CorrectionCount)
+		}
+		if got := store.Runs["u1_run0"].Type; got != crossfit {
+			t.Errorf("expected run type updated, got %v", got)
+		}
+	})
+
+	t.Run("different_type_restarts_count", func(t *testing.T) {
+		store := newStore()
+		svc := NewService(store, &MockPublisher{}, nil, mockLogger{})
+
+		if _, err := svc.CorrectActivityType(ctx, &pbsvc.CorrectActivityTypeRequest{UserId: "u1", ActivityId: "act0", ActivityType: crossfit}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp, err := svc.CorrectActivityType(ctx, &pbsvc.CorrectActivityTypeRequest{UserId: "u1", ActivityId: "act1", ActivityType: pbactivity.ActivityType_ACTIVITY_TYPE_RUN})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Rule.TargetType != pbactivity.ActivityType_ACTIVITY_TYPE_RUN || resp.Rule.CorrectionCount != 1 {
+			t.Errorf("expected rule retargeted with count 1, got %v", resp.Rule)
+		}
+	})
+
+	t.Run("unchanged_type_learns_nothing", func(t *testing.T) {
+		store := newStore()
+		svc := NewService(store, &MockPublisher{}, nil, mockLogger{})
+
+		resp, err := svc.CorrectActivityType(ctx, &pbsvc.CorrectActivityTypeRequest{UserId: "u1", ActivityId: "act0", ActivityType: workout})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Rule != nil || len(store.TypeRules) != 0 {
+			t.Errorf("expected no rule, got %v", resp.Rule)
+		}
+	})
+
+	t.Run("unknown_activity", func(t *testing.T) {
+		svc := NewService(newStore(), &MockPublisher{}, nil, mockLogger{})
+
+		_, err := svc.CorrectActivityType(ctx, &pbsvc.CorrectActivityTypeRequest{UserId: "u1", ActivityId: "missing", ActivityType: crossfit})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+}
+
+func TestActivityTypeRuleManagement(t *testing.T) {
+	ctx := context.Background()
+	store := NewMockStore()
+	store.TypeRules["u1_r1"] = &pipeline.ActivityTypeRule{Id: "r1", TitlePattern: "hyrox sim", CorrectionCount: 3}
+	svc := NewService(store, &MockPublisher{}, nil, mockLogger{})
+
+	list, err := svc.ListActivityTypeRules(ctx, &pbsvc.ListActivityTypeRulesRequest{UserId: "u1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Rules) != 1 || list.LearnThreshold == 0 {
+		t.Errorf("unexpected list response: %v", list)
+	}
+
+	rule, err := svc.UpdateActivityTypeRule(ctx, &pbsvc.UpdateActivityTypeRuleRequest{UserId: "u1", RuleId: "r1", Disabled: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !rule.Disabled || !store.TypeRules["u1_r1"].Disabled {
+		t.Error("expected rule disabled")
+	}
+
+	if _, err := svc.UpdateActivityTypeRule(ctx, &pbsvc.UpdateActivityTypeRuleRequest{UserId: "u1", RuleId: "missing"}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+
+	if _, err := svc.DeleteActivityTypeRule(ctx, &pbsvc.DeleteActivityTypeRuleRequest{UserId: "u1", RuleId: "r1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(store.TypeRules) != 0 {
+		t.Error("expected rule deleted")
+	}
+}
+
+func recommendationRuns(now time.Time, activityType pbactivity.ActivityType, count int, prefix string) map[string]*pipeline.PipelineRun {
+	runs := make(map[string]*pipeline.PipelineRun, count)
+	for i := 0; i < count; i++ {
+		id := fmt.Sprintf("%s%d", prefix, i)
+		runs["u1_"+id] = &pipeline.PipelineRun{
+			Id:         id,
+			PipelineId: "p1",
+			ActivityId: id,
+			Type:       activityType,
+			CreatedAt:  timestamppb.New(now.Add(-time.Duration(i+1) * time.Hour)),
+		}
+	}
+	return runs
+}
+
+func TestComputeEnricherRecommendations(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	collect := func(maps ...map[string]*pipeline.PipelineRun) []*pipeline.PipelineRun {
+		var runs []*pipeline.PipelineRun
+		for _, m := range maps {
+			for _, r := range m {
+				runs = append(runs, r)
+			}
+		}
+		return runs
+	}
+
+	t.Run("strength_user_gets_muscle_heatmap", func(t *testing.T) {
+		runs := collect(recommendationRuns(now, pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING, 6, "s"))
+		recs := computeEnricherRecommendations(runs, nil, now)
+		if recs.ActivitiesAnalyzed != 6 || len(recs.Recommendations) != 2 {
+			t.Fatalf("expected 2 recommendations from 6 activities, got %v", recs)
+		}
+		first := recs.Recommendations[0]
+		if first.ProviderType != plugin.EnricherProviderType_ENRICHER_PROVIDER_MUSCLE_HEATMAP || first.MatchingActivities != 6 || first.Score != 1 {
+			t.Errorf("expected muscle heatmap first, got %v", first)
+		}
+		if first.Reason != "You log strength workouts — try the Muscle Heatmap booster" {
+			t.Errorf("unexpected reason: %q", first.Reason)
+		}
+	})
+
+	t.Run("skips_enabled_enrichers", func(t *testing.T) {
+		runs := collect(recommendationRuns(now, pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING, 6, "s"))
+		pipelines := []*pipeline.PipelineConfig{
+			{Id: "p1", Enrichers: []*pipeline.EnricherConfig{{ProviderType: plugin.EnricherProviderType_ENRICHER_PROVIDER_MUSCLE_HEATMAP}}},
+			{Id: "p2", RaceMode: &pipeline.RaceModeConfig{Enrichers: []*pipeline.EnricherConfig{{ProviderType: plugin.EnricherProviderType_ENRICHER_PROVIDER_WORKOUT_SUMMARY}}}},
+		}
+		recs := computeEnricherRecommendations(runs, pipelines, now)
+		if len(recs.Recommendations) != 0 {
+			t.Errorf("expected no recommendations, got %v", recs.Recommendations)
+		}
+	})
+
+	t.Run("thresholds_and_window", func(t *testing.T) {
+		// Two rides is below the minimum; the old runs fall outside the window.
+		old := recommendationRuns(now.Add(-100*24*time.Hour), pbactivity.ActivityType_ACTIVITY_TYPE_RIDE, 5, "old")
+		runs := collect(
+			recommendationRuns(now, pbactivity.ActivityType_ACTIVITY_TYPE_RIDE, 2, "r"),
+			recommendationRuns(now, pbactivity.ActivityType_ACTIVITY_TYPE_YOGA, 4, "y"),
+			old,
+		)
+		recs := computeEnricherRecommendations(runs, nil, now)
+		if recs.ActivitiesAnalyzed != 6 {
+			t.Errorf("expected 6 activities in the window, got %d", recs.ActivitiesAnalyzed)
+		}
+		if len(recs.Recommendations) != 0 {
+			t.Errorf("expected no recommendations, got %v", recs.Recommendations)
+		}
+	})
+
+	t.Run("counts_each_activity_once", func(t *testing.T) {
+		runs := collect(recommendationRuns(now, pbactivity.ActivityType_ACTIVITY_TYPE_RUN, 2, "a"))
+		// The same two activities fanned out to a second pipeline.
+		for _, r := range collect(recommendationRuns(now, pbactivity.ActivityType_ACTIVITY_TYPE_RUN, 2, "a")) {
+			r.Id += "-p2"
+			r.PipelineId = "p2"
+			runs = append(runs, r)
+		}
+		recs := computeEnricherRecommendations(runs, nil, now)
+		if recs.ActivitiesAnalyzed != 2 || len(recs.Recommendations) != 0 {
+			t.Errorf("expected 2 activities and no recommendations, got %v", recs)
+		}
+	})
+
+	t.Run("caps_and_orders_by_score", func(t *testing.T) {
+		runs := collect(
+			recommendationRuns(now, pbactivity.ActivityType_ACTIVITY_TYPE_TRAIL_RUN, 10, "t"),
+			recommendationRuns(now, pbactivity.ActivityType_ACTIVITY_TYPE_RIDE, 5, "r"),
+		)
+		recs := computeEnricherRecommendations(runs, nil, now)
+		if len(recs.Recommendations) != maxRecommendations {
+			t.Fatalf("expected %d recommendations, got %d", maxRecommendations, len(recs.Recommendations))
+		}
+		for i := 1; i < len(recs.Recommendations); i++ {
+			if recs.Recommendations[i].Score > recs.Recommendations[i-1].Score {
+				t.Errorf("recommendations not sorted by score: %v", recs.Recommendations)
+			}
+		}
+		if recs.Recommendations[0].ProviderType != plugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER {
+			t.Errorf("expected weather (all activities outdoors) first, got %v", recs.Recommendations[0])
+		}
+	})
+}
+
+func TestGetEnricherRecommendations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("missing_user", func(t *testing.T) {
+		svc := NewService(NewMockStore(), &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+		_, err := svc.GetEnricherRecommendations(ctx, &pbsvc.GetEnricherRecommendationsRequest{})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("filters_stored_by_enabled", func(t *testing.T) {
+		store := NewMockStore()
+		store.Recommendations["u1"] = &pipeline.EnricherRecommendations{Recommendations: []*pipeline.EnricherRecommendation{
+			{ProviderType: plugin.EnricherProviderType_ENRICHER_PROVIDER_MUSCLE_HEATMAP},
+			{ProviderType: plugin.EnricherProviderType_ENRICHER_PROVIDER_WORKOUT_SUMMARY},
+		}}
+		store.Pipelines["u1_p1"] = &pipeline.PipelineConfig{Id: "p1", Enrichers: []*pipeline.EnricherConfig{
+			{ProviderType: plugin.EnricherProviderType_ENRICHER_PROVIDER_MUSCLE_HEATMAP},
+		}}
+		svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+
+		resp, err := svc.GetEnricherRecommendations(ctx, &pbsvc.GetEnricherRecommendationsRequest{UserId: "u1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.Recommendations) != 1 || resp.Recommendations[0].ProviderType != plugin.EnricherProviderType_ENRICHER_PROVIDER_WORKOUT_SUMMARY {
+			t.Errorf("expected only workout summary, got %v", resp.Recommendations)
+		}
+	})
+
+	t.Run("computes_when_not_stored", func(t *testing.T) {
+		store := NewMockStore()
+		store.Runs = recommendationRuns(time.Now(), pbactivity.ActivityType_ACTIVITY_TYPE_RUN, 4, "r")
+		svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+
+		resp, err := svc.GetEnricherRecommendations(ctx, &pbsvc.GetEnricherRecommendationsRequest{UserId: "u1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.ActivitiesAnalyzed != 4 || len(resp.Recommendations) == 0 {
+			t.Errorf("expected recommendations from 4 runs, got %v", resp)
+		}
+	})
+}
+
+func TestRefreshEnricherRecommendations(t *testing.T) {
+	store := NewMockStore()
+	store.ActiveUserIDs = []string{"u1", "u2"}
+	store.Runs = recommendationRuns(time.Now(), pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING, 3, "s")
+	svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+
+	refreshed, err := svc.RefreshEnricherRecommendations(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if refreshed != 2 || len(store.Recommendations) != 2 {
+		t.Fatalf("expected 2 users refreshed, got %d (%d stored)", refreshed, len(store.Recommendations))
+	}
+	if recs := store.Recommendations["u2"]; recs == nil || len(recs.Recommendations) == 0 {
+		t.Errorf("expected stored recommendations for u2, got %v", recs)
+	}
+}
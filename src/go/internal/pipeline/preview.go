@@ -0,0 +1,58 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fitglue/server/src/go/pkg/framework"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Previewer dry-runs a pipeline's enrichers over an activity. The enricher
+// package provides it; it is injected so this package doesn't depend on every
+// enricher provider.
+type Previewer func(ctx context.Context, userId string, pipelineId string, activity *pbactivity.StandardizedActivity) (*pipeline.PipelinePreview, error)
+
+// SetPreviewer enables PreviewPipeline.
+func (s *Service) SetPreviewer(p Previewer) {
+	s.previewer = p
+}
+
+// PreviewPipeline shows what a pipeline would do to an activity without
+// writing anything, so the web UI can show a live preview while the user
+// edits the pipeline.
+func (s *Service) PreviewPipeline(ctx context.Context, req *pbsvc.PreviewPipelineRequest) (*pipeline.PipelinePreview, error) {
+	if req.UserId == "" || req.PipelineId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and pipeline_id are required")
+	}
+	if req.Activity == nil {
+		return nil, status.Error(codes.InvalidArgument, "activity is required")
+	}
+	if s.previewer == nil {
+		return nil, status.Error(codes.Unimplemented, "pipeline previews are not available")
+	}
+
+	cfg, err := s.store.GetPipeline(ctx, req.UserId, req.PipelineId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to get pipeline for preview", "error", err)
+		return nil, status.Error(codes.Internal, "failed to read pipeline config")
+	}
+	if cfg == nil {
+		return nil, status.Error(codes.NotFound, "pipeline not found")
+	}
+
+	preview, err := s.previewer(ctx, req.UserId, req.PipelineId, req.Activity)
+	if err != nil {
+		var terminal *framework.TerminalError
+		if errors.As(err, &terminal) {
+			return nil, status.Error(codes.InvalidArgument, terminal.Message)
+		}
+		s.logger.Error(ctx, "pipeline preview failed", "error", err, "pipeline_id", req.PipelineId)
+		return nil, status.Error(codes.Internal, "failed to preview pipeline")
+	}
+	return preview, nil
+}
@@ -0,0 +1,70 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultProviderCircuitChanges = 50
+	maxProviderCircuitChanges     = 200
+)
+
+// AdminListProviderCircuits returns every enricher provider that has a
+// circuit, i.e. has failed or had its mode set by an admin.
+func (s *Service) AdminListProviderCircuits(ctx context.Context, req *pbsvc.AdminListProviderCircuitsRequest) (*pbsvc.AdminListProviderCircuitsResponse, error) {
+	circuits, err := s.store.ListProviderCircuits(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list provider circuits", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list provider circuits")
+	}
+	return &pbsvc.AdminListProviderCircuitsResponse{Circuits: circuits}, nil
+}
+
+// AdminSetProviderCircuitMode disables a provider for everyone, forces it on
+// regardless of failures, or hands it back to the circuit breaker.
+func (s *Service) AdminSetProviderCircuitMode(ctx context.Context, req *pbsvc.AdminSetProviderCircuitModeRequest) (*pipeline.ProviderCircuit, error) {
+	if req.ProviderType == plugin.EnricherProviderType_ENRICHER_PROVIDER_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "provider_type is required")
+	}
+	if _, ok := pipeline.ProviderCircuitMode_name[int32(req.Mode)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown mode")
+	}
+	if req.Mode != pipeline.ProviderCircuitMode_PROVIDER_CIRCUIT_MODE_AUTOMATIC && req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required when overriding a provider")
+	}
+	if req.AdminUserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "admin_user_id is required")
+	}
+
+	c, err := s.store.SetProviderCircuitMode(ctx, req.ProviderType, req.Mode, req.Reason, req.AdminUserId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to set provider circuit mode", "error", err, "provider_type", req.ProviderType.String())
+		return nil, status.Error(codes.Internal, "failed to set provider circuit mode")
+	}
+	s.logger.Info(ctx, "provider circuit mode changed", "provider_type", req.ProviderType.String(), "mode", req.Mode.String(), "admin_user_id", req.AdminUserId)
+	return c, nil
+}
+
+// AdminListProviderCircuitChanges returns a provider's circuit history, newest first.
+func (s *Service) AdminListProviderCircuitChanges(ctx context.Context, req *pbsvc.AdminListProviderCircuitChangesRequest) (*pbsvc.AdminListProviderCircuitChangesResponse, error) {
+	if req.ProviderType == plugin.EnricherProviderType_ENRICHER_PROVIDER_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "provider_type is required")
+	}
+	limit := defaultProviderCircuitChanges
+	if req.Limit > 0 && req.Limit <= maxProviderCircuitChanges {
+		limit = int(req.Limit)
+	}
+
+	changes, err := s.store.ListProviderCircuitChanges(ctx, req.ProviderType, limit)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list provider circuit changes", "error", err, "provider_type", req.ProviderType.String())
+		return nil, status.Error(codes.Internal, "failed to list provider circuit changes")
+	}
+	return &pbsvc.AdminListProviderCircuitChangesResponse{Changes: changes}, nil
+}
@@ -0,0 +1,254 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/types/formatters"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// recommendationWindow bounds the activity history inspected per user.
+	recommendationWindow   = 90 * 24 * time.Hour
+	recommendationRunLimit = 200
+	// recommendationActiveWindow selects which users the scheduled refresh
+	// recomputes; everyone else keeps their last stored set.
+	recommendationActiveWindow = 30 * 24 * time.Hour
+
+	minRecommendationMatches = 3
+	minRecommendationShare   = 0.1
+	maxRecommendations       = 5
+)
+
+// recommendationRule suggests an enricher to users who log enough activities
+// it applies to.
+type recommendationRule struct {
+	provider pbplugin.EnricherProviderType
+	label    string // how the matching activities are described to the user
+	types    map[pbactivity.ActivityType]bool
+}
+
+var (
+	strengthTypes = activityTypeSet(
+		pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING,
+		pbactivity.ActivityType_ACTIVITY_TYPE_CROSSFIT,
+		pbactivity.ActivityType_ACTIVITY_TYPE_WORKOUT,
+		pbactivity.ActivityType_ACTIVITY_TYPE_HIGH_INTENSITY_INTERVAL_TRAINING,
+	)
+	runTypes = activityTypeSet(
+		pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		pbactivity.ActivityType_ACTIVITY_TYPE_TRAIL_RUN,
+		pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_RUN,
+	)
+	rideTypes = activityTypeSet(
+		pbactivity.ActivityType_ACTIVITY_TYPE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_GRAVEL_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_MOUNTAIN_BIKE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_EBIKE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_EMOUNTAIN_BIKE_RIDE,
+	)
+	outdoorTypes = activityTypeSet(
+		pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		pbactivity.ActivityType_ACTIVITY_TYPE_TRAIL_RUN,
+		pbactivity.ActivityType_ACTIVITY_TYPE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_GRAVEL_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_MOUNTAIN_BIKE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_EBIKE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_EMOUNTAIN_BIKE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_HIKE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_WALK,
+	)
+	hillyTypes = activityTypeSet(
+		pbactivity.ActivityType_ACTIVITY_TYPE_HIKE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_TRAIL_RUN,
+		pbactivity.ActivityType_ACTIVITY_TYPE_MOUNTAIN_BIKE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_EMOUNTAIN_BIKE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_SNOWSHOE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_BACKCOUNTRY_SKI,
+	)
+)
+
+var recommendationRules = []recommendationRule{
+	{pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MUSCLE_HEATMAP, "strength workouts", strengthTypes},
+	{pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WORKOUT_SUMMARY, "strength workouts", strengthTypes},
+	{pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PACE_SUMMARY, "runs", runTypes},
+	{pbplugin.EnricherProviderType_ENRICHER_PROVIDER_POWER_SUMMARY, "rides", rideTypes},
+	{pbplugin.EnricherProviderType_ENRICHER_PROVIDER_SPEED_SUMMARY, "rides", rideTypes},
+	{pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ELEVATION_SUMMARY, "hikes and trail activities", hillyTypes},
+	{pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER, "outdoor activities", outdoorTypes},
+	{pbplugin.EnricherProviderType_ENRICHER_PROVIDER_LOCATION_NAMING, "outdoor activities", outdoorTypes},
+	{pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ROUTE_THUMBNAIL, "outdoor activities", outdoorTypes},
+}
+
+func activityTypeSet(types ...pbactivity.ActivityType) map[pbactivity.ActivityType]bool {
+	set := make(map[pbactivity.ActivityType]bool, len(types))
+	for _, t := range types {
+		set[t] = true
+	}
+	return set
+}
+
+// GetEnricherRecommendations returns the user's stored recommendations, or
+// computes them on demand if the scheduled job hasn't reached the user yet.
+// Enrichers added to a pipeline since the last refresh are left out.
+func (s *Service) GetEnricherRecommendations(ctx context.Context, req *pbsvc.GetEnricherRecommendationsRequest) (*pipeline.EnricherRecommendations, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	stored, err := s.store.GetEnricherRecommendations(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to get enricher recommendations", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get recommendations")
+	}
+	if stored == nil {
+		recs, err := s.buildEnricherRecommendations(ctx, req.UserId, time.Now())
+		if err != nil {
+			s.logger.Error(ctx, "failed to compute enricher recommendations", "error", err)
+			return nil, status.Error(codes.Internal, "failed to compute recommendations")
+		}
+		return recs, nil
+	}
+
+	pipelines, err := s.store.ListPipelines(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list pipelines for recommendations", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list pipelines")
+	}
+	enabled := enabledEnrichers(pipelines)
+	current := stored.Recommendations[:0]
+	for _, rec := range stored.Recommendations {
+		if !enabled[rec.ProviderType] {
+			current = append(current, rec)
+		}
+	}
+	stored.Recommendations = current
+	return stored, nil
+}
+
+// RefreshEnricherRecommendations recomputes and stores recommendations for
+// every user with a pipeline run in the last 30 days. It is run daily by
+// Cloud Scheduler through the recommendations Pub/Sub topic. A failure for
+// one user is logged and doesn't stop the rest.
+func (s *Service) RefreshEnricherRecommendations(ctx context.Context) (int, error) {
+	now := time.Now()
+	userIDs, err := s.store.ListActiveUserIDs(ctx, now.Add(-recommendationActiveWindow))
+	if err != nil {
+		return 0, fmt.Errorf("failed to list active users: %w", err)
+	}
+
+	refreshed := 0
+	for _, userID := range userIDs {
+		recs, err := s.buildEnricherRecommendations(ctx, userID, now)
+		if err != nil {
+			s.logger.Warn(ctx, "failed to compute enricher recommendations", "error", err, "user_id", userID)
+			continue
+		}
+		if err := s.store.SetEnricherRecommendations(ctx, userID, recs); err != nil {
+			s.logger.Warn(ctx, "failed to store enricher recommendations", "error", err, "user_id", userID)
+			continue
+		}
+		refreshed++
+	}
+
+	s.logger.Info(ctx, "Refreshed enricher recommendations", "active_users", len(userIDs), "refreshed", refreshed)
+	return refreshed, nil
+}
+
+func (s *Service) buildEnricherRecommendations(ctx context.Context, userID string, now time.Time) (*pipeline.EnricherRecommendations, error) {
+	pipelines, err := s.store.ListPipelines(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pipelines: %w", err)
+	}
+	runs, _, err := s.store.ListPipelineRuns(ctx, userID, "", recommendationRunLimit, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pipeline runs: %w", err)
+	}
+	return computeEnricherRecommendations(runs, pipelines, now), nil
+}
+
+// computeEnricherRecommendations matches the user's recent activity mix
+// against recommendationRules, skipping enrichers already in any pipeline.
+// An activity processed by several pipelines is only counted once, and test
+// runs not at all.
+func computeEnricherRecommendations(runs []*pipeline.PipelineRun, pipelines []*pipeline.PipelineConfig, now time.Time) *pipeline.EnricherRecommendations {
+	cutoff := now.Add(-recommendationWindow)
+	activityTypes := make(map[string]pbactivity.ActivityType)
+	for _, run := range runs {
+		// The onboarding test activity says nothing about what the user logs
+		if run.IsTest || run.CreatedAt == nil || run.CreatedAt.AsTime().Before(cutoff) {
+			continue
+		}
+		key := run.ActivityId
+		if key == "" {
+			key = run.Id
+		}
+		activityTypes[key] = run.Type
+	}
+
+	result := &pipeline.EnricherRecommendations{
+		ActivitiesAnalyzed: int32(len(activityTypes)),
+		GeneratedAt:        timestamppb.New(now),
+	}
+	if len(activityTypes) == 0 {
+		return result
+	}
+
+	enabled := enabledEnrichers(pipelines)
+	for _, rule := range recommendationRules {
+		if enabled[rule.provider] {
+			continue
+		}
+		matches := 0
+		for _, t := range activityTypes {
+			if rule.types[t] {
+				matches++
+			}
+		}
+		share := float64(matches) / float64(len(activityTypes))
+		if matches < minRecommendationMatches || share < minRecommendationShare {
+			continue
+		}
+		result.Recommendations = append(result.Recommendations, &pipeline.EnricherRecommendation{
+			ProviderType:       rule.provider,
+			Reason:             fmt.Sprintf("You log %s — try the %s booster", rule.label, formatters.FormatEnricherProviderType(rule.provider)),
+			MatchingActivities: int32(matches),
+			Score:              share,
+		})
+	}
+
+	// Rules are listed in priority order, so a stable sort keeps that order
+	// between equally scored suggestions.
+	sort.SliceStable(result.Recommendations, func(i, j int) bool {
+		return result.Recommendations[i].Score > result.Recommendations[j].Score
+	})
+	if len(result.Recommendations) > maxRecommendations {
+		result.Recommendations = result.Recommendations[:maxRecommendations]
+	}
+	return result
+}
+
+// enabledEnrichers returns every enricher type configured on any pipeline,
+// including race mode overrides.
+func enabledEnrichers(pipelines []*pipeline.PipelineConfig) map[pbplugin.EnricherProviderType]bool {
+	enabled := make(map[pbplugin.EnricherProviderType]bool)
+	for _, p := range pipelines {
+		for _, e := range p.Enrichers {
+			enabled[e.ProviderType] = true
+		}
+		for _, e := range p.GetRaceMode().GetEnrichers() {
+			enabled[e.ProviderType] = true
+		}
+	}
+	return enabled
+}
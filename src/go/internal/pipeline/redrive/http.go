@@ -0,0 +1,126 @@
+package redrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	shared "github.com/fitglue/server/src/go/pkg"
+)
+
+// maxAttributeBytes is Pub/Sub's limit on an attribute value.
+const maxAttributeBytes = 1024
+
+// pushRequest is the body of a Pub/Sub push. DeliveryAttempt is only set on
+// subscriptions with a dead letter policy.
+type pushRequest struct {
+	Message struct {
+		Data       []byte            `json:"data"`
+		Attributes map[string]string `json:"attributes"`
+		MessageID  string            `json:"messageId"`
+	} `json:"message"`
+	Subscription    string `json:"subscription"`
+	DeliveryAttempt int    `json:"deliveryAttempt"`
+}
+
+// HandleDeadLetterPush receives pushes from the dead letter topic and
+// records them. Returning 500 makes Pub/Sub retry a push that couldn't be
+// stored.
+func (r *Redriver) HandleDeadLetterPush(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var push pushRequest
+	if err := json.NewDecoder(req.Body).Decode(&push); err != nil || len(push.Message.Data) == 0 {
+		r.logger.Error(ctx, "Failed to parse dead letter push", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	if err := r.Record(ctx, push.Message.MessageID, push.Message.Data, push.Message.Attributes); err != nil {
+		r.logger.Error(ctx, "Failed to record dead-lettered event", "error", err, "message_id", push.Message.MessageID)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// CaptureFailures wraps a Pub/Sub push handler for messages from topic. When
+// the handler fails a message's final delivery attempt, the message is
+// dead-lettered here with the handler's error, which Pub/Sub's own
+// dead-lettering would lose, and the push is acknowledged. If that publish
+// fails the handler's response stands and Pub/Sub dead-letters the message
+// itself.
+func (r *Redriver) CaptureFailures(topic string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+			return
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		var push pushRequest
+		if json.Unmarshal(body, &push) != nil || push.DeliveryAttempt < MaxDeliveryAttempts {
+			next(w, req)
+			return
+		}
+
+		rec := &responseRecorder{header: make(http.Header), status: http.StatusOK}
+		next(rec, req)
+		if rec.status < http.StatusInternalServerError {
+			rec.copyTo(w)
+			return
+		}
+
+		ctx := req.Context()
+		attributes := make(map[string]string, len(push.Message.Attributes)+2)
+		for k, v := range push.Message.Attributes {
+			attributes[k] = v
+		}
+		attributes[AttrSourceTopic] = topic
+		attributes[AttrError] = truncate(strings.TrimSpace(rec.body.String()), maxAttributeBytes)
+
+		if _, err := r.publisher.PublishMessage(ctx, shared.TopicPipelineDeadLetter, push.Message.Data, attributes); err != nil {
+			r.logger.Error(ctx, "Failed to dead-letter event", "error", err, "topic", topic, "message_id", push.Message.MessageID)
+			rec.copyTo(w)
+			return
+		}
+		r.logger.Warn(ctx, "Dead-lettered pipeline event", "topic", topic, "message_id", push.Message.MessageID, "delivery_attempt", push.DeliveryAttempt)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// responseRecorder buffers a handler's response so CaptureFailures can
+// replace it.
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header         { return r.header }
+func (r *responseRecorder) Write(b []byte) (int, error) { return r.body.Write(b) }
+func (r *responseRecorder) WriteHeader(status int)      { r.status = status }
+
+func (r *responseRecorder) copyTo(w http.ResponseWriter) {
+	for k, v := range r.header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(r.status)
+	w.Write(r.body.Bytes())
+}
+
+// truncate shortens s to at most n bytes without splitting a character.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	s = s[:n]
+	for !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+	return s
+}
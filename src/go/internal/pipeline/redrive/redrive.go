@@ -0,0 +1,274 @@
+// Package redrive stores dead-lettered pipeline events and republishes them,
+// automatically with exponential backoff or when an admin selects them.
+package redrive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+const (
+	// MaxDeliveryAttempts matches max_delivery_attempts in the dead letter
+	// policy of the pipeline subscriptions (terraform/pubsub.tf).
+	MaxDeliveryAttempts = 5
+	// MaxRedriveAttempts is how many times an event is redriven automatically
+	// before it is left for an admin.
+	MaxRedriveAttempts = 5
+
+	baseBackoff = 5 * time.Minute
+	maxBackoff  = 6 * time.Hour
+	// retention is how long a failed event is kept after it last changed.
+	retention = 30 * 24 * time.Hour
+	// dueBatchSize caps how many events one scheduled run republishes.
+	dueBatchSize = 100
+)
+
+// Attributes FitGlue adds to dead-lettered messages. They are stripped
+// before a redrive, apart from AttrFailedEventID, which ties a message that
+// fails again back to its failed event.
+const (
+	AttrSourceTopic   = "fitglue_source_topic"
+	AttrError         = "fitglue_error"
+	AttrFailedEventID = "fitglue_failed_event_id"
+
+	attrPrefix      = "fitglue_"
+	pubsubAttrStart = "CloudPubSubDeadLetter"
+	// attrSourceSubscription is set by Pub/Sub on messages it dead-letters.
+	attrSourceSubscription = "CloudPubSubDeadLetterSourceSubscription"
+)
+
+// subscriptionTopics maps each pipeline subscription to its topic, for
+// messages Pub/Sub dead-lettered itself without AttrSourceTopic.
+var subscriptionTopics = map[string]string{
+	"sub-pipeline-raw":      shared.TopicRawActivity,
+	"sub-pipeline-run":      shared.TopicPipelineActivity,
+	"sub-pipeline-enriched": shared.TopicEnrichedActivity,
+}
+
+// Store is the part of pipeline.PipelineStore the redriver needs.
+type Store interface {
+	GetFailedEvent(ctx context.Context, userID, eventID string) (*pipeline.FailedEvent, error)
+	SetFailedEvent(ctx context.Context, userID string, event *pipeline.FailedEvent) error
+	ListDueFailedEvents(ctx context.Context, before time.Time, limit int) ([]*pipeline.FailedEvent, error)
+}
+
+// Publisher publishes raw Pub/Sub messages, so a redrive replays the
+// original message byte for byte.
+type Publisher interface {
+	PublishMessage(ctx context.Context, topic string, data []byte, attributes map[string]string) (string, error)
+}
+
+type Redriver struct {
+	store     Store
+	publisher Publisher
+	logger    infra.Logger
+	now       func() time.Time
+}
+
+func NewRedriver(store Store, publisher Publisher, logger infra.Logger) *Redriver {
+	return &Redriver{
+		store:     store,
+		publisher: publisher,
+		logger:    logger.With("component", "redrive"),
+		now:       time.Now,
+	}
+}
+
+// Backoff returns the wait before the next automatic redrive of an event
+// that has been redriven attempts times: 5 minutes, doubling each time, up
+// to 6 hours.
+func Backoff(attempts int32) time.Duration {
+	d := baseBackoff
+	for i := int32(0); i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		return maxBackoff
+	}
+	return d
+}
+
+// Record stores a dead-lettered message under its user's failed_events. A
+// message carrying AttrFailedEventID is a redrive that failed again and
+// updates that event; anything else becomes a new event keyed by messageID,
+// so a redelivered dead letter is stored once. Messages that can't be
+// attributed to a user or replayed are logged and dropped.
+func (r *Redriver) Record(ctx context.Context, messageID string, data []byte, attributes map[string]string) error {
+	userID, pipelineID := eventOwner(data)
+	if userID == "" {
+		r.logger.Error(ctx, "Dropping dead-lettered event without a user", "message_id", messageID)
+		return nil
+	}
+
+	var event *pipeline.FailedEvent
+	if id := attributes[AttrFailedEventID]; id != "" {
+		existing, err := r.store.GetFailedEvent(ctx, userID, id)
+		if err != nil {
+			return fmt.Errorf("get failed event %s: %w", id, err)
+		}
+		event = existing
+	}
+
+	now := r.now()
+	if event == nil {
+		topic := attributes[AttrSourceTopic]
+		if topic == "" {
+			topic = subscriptionTopics[path.Base(attributes[attrSourceSubscription])]
+		}
+		if topic == "" {
+			r.logger.Error(ctx, "Dropping dead-lettered event from an unknown source", "message_id", messageID, "user_id", userID)
+			return nil
+		}
+		event = &pipeline.FailedEvent{
+			Id:            messageID,
+			UserId:        userID,
+			PipelineId:    pipelineID,
+			SourceTopic:   topic,
+			Data:          data,
+			Attributes:    originalAttributes(attributes),
+			FirstFailedAt: timestamppb.New(now),
+		}
+	}
+
+	event.Error = attributes[AttrError]
+	event.LastFailedAt = timestamppb.New(now)
+	event.ExpiresAt = timestamppb.New(now.Add(retention))
+	if event.Attempts >= MaxRedriveAttempts {
+		event.Status = pipeline.FailedEventStatus_FAILED_EVENT_STATUS_EXHAUSTED
+		event.NextAttemptAt = nil
+	} else {
+		event.Status = pipeline.FailedEventStatus_FAILED_EVENT_STATUS_PENDING
+		event.NextAttemptAt = timestamppb.New(now.Add(Backoff(event.Attempts)))
+	}
+
+	if err := r.store.SetFailedEvent(ctx, userID, event); err != nil {
+		return fmt.Errorf("store failed event: %w", err)
+	}
+	r.logger.Warn(ctx, "Recorded failed pipeline event",
+		"user_id", userID,
+		"event_id", event.Id,
+		"source_topic", event.SourceTopic,
+		"attempts", event.Attempts,
+		"status", event.Status.String(),
+		"error", event.Error)
+	return nil
+}
+
+// Redrive republishes events to their source topics and stores them as
+// REDRIVEN. The event is updated in place.
+func (r *Redriver) Redrive(ctx context.Context, events []*pipeline.FailedEvent) error {
+	for _, event := range events {
+		if err := r.redrive(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RedriveDue republishes every PENDING event whose next attempt is due. It
+// runs on a schedule; events that fail to publish stay PENDING for the next
+// run.
+func (r *Redriver) RedriveDue(ctx context.Context, _ cloudevents.Event) error {
+	events, err := r.store.ListDueFailedEvents(ctx, r.now(), dueBatchSize)
+	if err != nil {
+		return fmt.Errorf("list due failed events: %w", err)
+	}
+
+	redriven := 0
+	for _, event := range events {
+		if err := r.redrive(ctx, event); err != nil {
+			r.logger.Error(ctx, "Failed to redrive event", "error", err, "user_id", event.UserId, "event_id", event.Id)
+			continue
+		}
+		redriven++
+	}
+	if len(events) > 0 {
+		r.logger.Info(ctx, "Redrove due failed events", "due", len(events), "redriven", redriven)
+	}
+	return nil
+}
+
+func (r *Redriver) redrive(ctx context.Context, event *pipeline.FailedEvent) error {
+	attributes := make(map[string]string, len(event.Attributes)+1)
+	for k, v := range event.Attributes {
+		attributes[k] = v
+	}
+	attributes[AttrFailedEventID] = event.Id
+
+	if _, err := r.publisher.PublishMessage(ctx, event.SourceTopic, event.Data, attributes); err != nil {
+		return fmt.Errorf("republish event %s to %s: %w", event.Id, event.SourceTopic, err)
+	}
+
+	now := r.now()
+	event.Attempts++
+	event.Status = pipeline.FailedEventStatus_FAILED_EVENT_STATUS_REDRIVEN
+	event.LastRedrivenAt = timestamppb.New(now)
+	event.NextAttemptAt = nil
+	event.ExpiresAt = timestamppb.New(now.Add(retention))
+	if err := r.store.SetFailedEvent(ctx, event.UserId, event); err != nil {
+		return fmt.Errorf("store redriven event %s: %w", event.Id, err)
+	}
+	return nil
+}
+
+// eventOwner reads the user and pipeline from a message holding a
+// serialized CloudEvent, whose data is one of the pipeline payloads.
+func eventOwner(data []byte) (userID, pipelineID string) {
+	var ce struct {
+		Data       json.RawMessage `json:"data"`
+		DataBase64 []byte          `json:"data_base64"`
+	}
+	if err := json.Unmarshal(data, &ce); err != nil {
+		return "", ""
+	}
+	payload := []byte(ce.Data)
+	if len(payload) == 0 {
+		payload = ce.DataBase64
+	}
+
+	var owner struct {
+		UserID          string `json:"user_id"`
+		UserIDCamel     string `json:"userId"`
+		PipelineID      string `json:"pipeline_id"`
+		PipelineIDCamel string `json:"pipelineId"`
+	}
+	if err := json.Unmarshal(payload, &owner); err != nil {
+		return "", ""
+	}
+	userID = owner.UserID
+	if userID == "" {
+		userID = owner.UserIDCamel
+	}
+	pipelineID = owner.PipelineID
+	if pipelineID == "" {
+		pipelineID = owner.PipelineIDCamel
+	}
+	return userID, pipelineID
+}
+
+// originalAttributes drops the attributes FitGlue and Pub/Sub add when
+// dead-lettering, leaving those the message was first published with.
+func originalAttributes(attributes map[string]string) map[string]string {
+	var original map[string]string
+	for k, v := range attributes {
+		if strings.HasPrefix(k, attrPrefix) || strings.HasPrefix(k, pubsubAttrStart) {
+			continue
+		}
+		if original == nil {
+			original = make(map[string]string)
+		}
+		original[k] = v
+	}
+	return original
+}
@@ -0,0 +1,331 @@
+package redrive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+type mockStore struct {
+	events map[string]*pipeline.FailedEvent
+}
+
+func newMockStore() *mockStore {
+	return &mockStore{events: make(map[string]*pipeline.FailedEvent)}
+}
+
+func (m *mockStore) GetFailedEvent(_ context.Context, userID, eventID string) (*pipeline.FailedEvent, error) {
+	return m.events[userID+"/"+eventID], nil
+}
+
+func (m *mockStore) SetFailedEvent(_ context.Context, userID string, event *pipeline.FailedEvent) error {
+	m.events[userID+"/"+event.Id] = event
+	return nil
+}
+
+func (m *mockStore) ListDueFailedEvents(_ context.Context, before time.Time, limit int) ([]*pipeline.FailedEvent, error) {
+	var due []*pipeline.FailedEvent
+	for _, e := range m.events {
+		if e.Status == pipeline.FailedEventStatus_FAILED_EVENT_STATUS_PENDING && !e.NextAttemptAt.AsTime().After(before) && len(due) < limit {
+			due = append(due, e)
+		}
+	}
+	return due, nil
+}
+
+type published struct {
+	topic      string
+	data       []byte
+	attributes map[string]string
+}
+
+type mockPublisher struct {
+	messages []published
+	err      error
+}
+
+func (m *mockPublisher) PublishMessage(_ context.Context, topic string, data []byte, attributes map[string]string) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	m.messages = append(m.messages, published{topic: topic, data: data, attributes: attributes})
+	return "msg-id", nil
+}
+
+var testNow = time.Date(2026, 5, 3, 8, 0, 0, 0, time.UTC)
+
+func newTestRedriver() (*Redriver, *mockStore, *mockPublisher) {
+	store := newMockStore()
+	pub := &mockPublisher{}
+	r := NewRedriver(store, pub, infra.NewLogger())
+	r.now = func() time.Time { return testNow }
+	return r, store, pub
+}
+
+const eventData = `{"specversion":"1.0","type":"com.fitglue.activity","data":{"user_id":"u1","pipeline_id":"p1"}}`
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		expected time.Duration
+	}{
+		{0, 5 * time.Minute},
+		{1, 10 * time.Minute},
+		{4, 80 * time.Minute},
+		{10, 6 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got := Backoff(tt.attempts); got != tt.expected {
+			t.Errorf("Backoff(%d) = %v, want %v", tt.attempts, got, tt.expected)
+		}
+	}
+}
+
+func TestRecord(t *testing.T) {
+	t.Run("New event from Pub/Sub dead-lettering", func(t *testing.T) {
+		r, store, _ := newTestRedriver()
+		attrs := map[string]string{
+			attrSourceSubscription:                     "projects/fitglue/subscriptions/sub-pipeline-run",
+			"CloudPubSubDeadLetterSourceDeliveryCount": "5",
+			"origin": "webhook",
+		}
+		if err := r.Record(context.Background(), "dlq-1", []byte(eventData), attrs); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+
+		e := store.events["u1/dlq-1"]
+		if e == nil {
+			t.Fatal("expected the event to be stored under its user")
+		}
+		if e.SourceTopic != shared.TopicPipelineActivity || e.PipelineId != "p1" {
+			t.Errorf("unexpected source %q / pipeline %q", e.SourceTopic, e.PipelineId)
+		}
+		if len(e.Attributes) != 1 || e.Attributes["origin"] != "webhook" {
+			t.Errorf("expected only the original attributes, got %v", e.Attributes)
+		}
+		if e.Status != pipeline.FailedEventStatus_FAILED_EVENT_STATUS_PENDING || !e.NextAttemptAt.AsTime().Equal(testNow.Add(5*time.Minute)) {
+			t.Errorf("expected PENDING in 5 minutes, got %v at %v", e.Status, e.NextAttemptAt.AsTime())
+		}
+	})
+
+	t.Run("Captured handler error", func(t *testing.T) {
+		r, store, _ := newTestRedriver()
+		attrs := map[string]string{AttrSourceTopic: shared.TopicRawActivity, AttrError: "splitter: db unavailable"}
+		if err := r.Record(context.Background(), "dlq-2", []byte(eventData), attrs); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+		e := store.events["u1/dlq-2"]
+		if e.SourceTopic != shared.TopicRawActivity || e.Error != "splitter: db unavailable" || e.Attributes != nil {
+			t.Errorf("unexpected event %+v", e)
+		}
+	})
+
+	t.Run("Redriven event fails again", func(t *testing.T) {
+		r, store, _ := newTestRedriver()
+		store.events["u1/e1"] = &pipeline.FailedEvent{
+			Id: "e1", UserId: "u1", SourceTopic: shared.TopicPipelineActivity, Attempts: 2,
+			Status: pipeline.FailedEventStatus_FAILED_EVENT_STATUS_REDRIVEN,
+		}
+		attrs := map[string]string{AttrFailedEventID: "e1", AttrSourceTopic: shared.TopicPipelineActivity, AttrError: "still broken"}
+		if err := r.Record(context.Background(), "dlq-3", []byte(eventData), attrs); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+		if _, ok := store.events["u1/dlq-3"]; ok {
+			t.Error("expected the existing event to be updated, not a new one")
+		}
+		e := store.events["u1/e1"]
+		if e.Status != pipeline.FailedEventStatus_FAILED_EVENT_STATUS_PENDING || e.Error != "still broken" {
+			t.Errorf("unexpected event %+v", e)
+		}
+		if !e.NextAttemptAt.AsTime().Equal(testNow.Add(20 * time.Minute)) {
+			t.Errorf("expected the third attempt in 20 minutes, got %v", e.NextAttemptAt.AsTime())
+		}
+	})
+
+	t.Run("Out of attempts", func(t *testing.T) {
+		r, store, _ := newTestRedriver()
+		store.events["u1/e1"] = &pipeline.FailedEvent{Id: "e1", UserId: "u1", SourceTopic: shared.TopicPipelineActivity, Attempts: MaxRedriveAttempts}
+		if err := r.Record(context.Background(), "dlq-4", []byte(eventData), map[string]string{AttrFailedEventID: "e1"}); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+		e := store.events["u1/e1"]
+		if e.Status != pipeline.FailedEventStatus_FAILED_EVENT_STATUS_EXHAUSTED || e.NextAttemptAt != nil {
+			t.Errorf("expected EXHAUSTED with no next attempt, got %+v", e)
+		}
+	})
+
+	t.Run("Dropped without a user or source", func(t *testing.T) {
+		r, store, _ := newTestRedriver()
+		_ = r.Record(context.Background(), "dlq-5", []byte(`{"data":{}}`), map[string]string{AttrSourceTopic: shared.TopicRawActivity})
+		_ = r.Record(context.Background(), "dlq-6", []byte(eventData), map[string]string{attrSourceSubscription: "projects/fitglue/subscriptions/sub-other"})
+		if len(store.events) != 0 {
+			t.Errorf("expected nothing stored, got %v", store.events)
+		}
+	})
+}
+
+func TestRedriveDue(t *testing.T) {
+	r, store, pub := newTestRedriver()
+	store.events["u1/due"] = &pipeline.FailedEvent{
+		Id: "due", UserId: "u1", SourceTopic: shared.TopicPipelineActivity, Data: []byte(eventData),
+		Attributes:    map[string]string{"origin": "webhook"},
+		Status:        pipeline.FailedEventStatus_FAILED_EVENT_STATUS_PENDING,
+		NextAttemptAt: timestamppb.New(testNow.Add(-time.Minute)),
+	}
+	store.events["u1/later"] = &pipeline.FailedEvent{
+		Id: "later", UserId: "u1", SourceTopic: shared.TopicPipelineActivity,
+		Status:        pipeline.FailedEventStatus_FAILED_EVENT_STATUS_PENDING,
+		NextAttemptAt: timestamppb.New(testNow.Add(time.Minute)),
+	}
+
+	if err := r.RedriveDue(context.Background(), cloudevents.NewEvent()); err != nil {
+		t.Fatalf("RedriveDue() error = %v", err)
+	}
+
+	if len(pub.messages) != 1 {
+		t.Fatalf("expected one redrive, got %d", len(pub.messages))
+	}
+	msg := pub.messages[0]
+	if msg.topic != shared.TopicPipelineActivity || string(msg.data) != eventData {
+		t.Errorf("expected the original message on its source topic, got %q on %s", msg.data, msg.topic)
+	}
+	if msg.attributes[AttrFailedEventID] != "due" || msg.attributes["origin"] != "webhook" {
+		t.Errorf("unexpected attributes %v", msg.attributes)
+	}
+
+	e := store.events["u1/due"]
+	if e.Status != pipeline.FailedEventStatus_FAILED_EVENT_STATUS_REDRIVEN || e.Attempts != 1 || e.NextAttemptAt != nil {
+		t.Errorf("expected REDRIVEN after one attempt, got %+v", e)
+	}
+	if store.events["u1/later"].Status != pipeline.FailedEventStatus_FAILED_EVENT_STATUS_PENDING {
+		t.Error("expected the event that isn't due to be left alone")
+	}
+}
+
+func TestRedrivePublishFailure(t *testing.T) {
+	r, store, pub := newTestRedriver()
+	pub.err = errors.New("pubsub down")
+	event := &pipeline.FailedEvent{Id: "e1", UserId: "u1", SourceTopic: shared.TopicPipelineActivity, Status: pipeline.FailedEventStatus_FAILED_EVENT_STATUS_EXHAUSTED}
+	store.events["u1/e1"] = event
+
+	if err := r.Redrive(context.Background(), []*pipeline.FailedEvent{event}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if event.Attempts != 0 || event.Status != pipeline.FailedEventStatus_FAILED_EVENT_STATUS_EXHAUSTED {
+		t.Errorf("expected the event unchanged, got %+v", event)
+	}
+}
+
+func pushBody(t *testing.T, deliveryAttempt int) []byte {
+	t.Helper()
+	var push pushRequest
+	push.Message.Data = []byte(eventData)
+	push.Message.MessageID = "m1"
+	push.Message.Attributes = map[string]string{"origin": "webhook"}
+	push.DeliveryAttempt = deliveryAttempt
+	b, err := json.Marshal(push)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestCaptureFailures(t *testing.T) {
+	failing := func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "enricher: provider exploded", http.StatusInternalServerError)
+	}
+
+	t.Run("Earlier attempts are retried by Pub/Sub", func(t *testing.T) {
+		r, _, pub := newTestRedriver()
+		w := httptest.NewRecorder()
+		r.CaptureFailures(shared.TopicPipelineActivity, failing)(w, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(pushBody(t, 2))))
+		if w.Code != http.StatusInternalServerError || len(pub.messages) != 0 {
+			t.Errorf("expected a plain 500, got %d and %d dead letters", w.Code, len(pub.messages))
+		}
+	})
+
+	t.Run("Final attempt is dead-lettered with its error", func(t *testing.T) {
+		r, _, pub := newTestRedriver()
+		w := httptest.NewRecorder()
+		r.CaptureFailures(shared.TopicPipelineActivity, failing)(w, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(pushBody(t, MaxDeliveryAttempts))))
+		if w.Code != http.StatusOK {
+			t.Errorf("expected the push to be acknowledged, got %d", w.Code)
+		}
+		if len(pub.messages) != 1 {
+			t.Fatalf("expected one dead letter, got %d", len(pub.messages))
+		}
+		msg := pub.messages[0]
+		if msg.topic != shared.TopicPipelineDeadLetter || string(msg.data) != eventData {
+			t.Errorf("unexpected dead letter %q on %s", msg.data, msg.topic)
+		}
+		if msg.attributes[AttrError] != "enricher: provider exploded" || msg.attributes[AttrSourceTopic] != shared.TopicPipelineActivity || msg.attributes["origin"] != "webhook" {
+			t.Errorf("unexpected attributes %v", msg.attributes)
+		}
+	})
+
+	t.Run("Dead letter publish failure keeps the 500", func(t *testing.T) {
+		r, _, pub := newTestRedriver()
+		pub.err = errors.New("pubsub down")
+		w := httptest.NewRecorder()
+		r.CaptureFailures(shared.TopicPipelineActivity, failing)(w, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(pushBody(t, MaxDeliveryAttempts))))
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("expected Pub/Sub to dead-letter it, got %d", w.Code)
+		}
+	})
+
+	t.Run("Successful final attempt passes through", func(t *testing.T) {
+		r, _, pub := newTestRedriver()
+		ok := func(w http.ResponseWriter, req *http.Request) {
+			var push pushRequest
+			if err := json.NewDecoder(req.Body).Decode(&push); err != nil || push.Message.MessageID != "m1" {
+				t.Errorf("expected the handler to read the push body, got %v", err)
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"status":"ok"}`))
+		}
+		w := httptest.NewRecorder()
+		r.CaptureFailures(shared.TopicPipelineActivity, ok)(w, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(pushBody(t, MaxDeliveryAttempts))))
+		if w.Code != http.StatusOK || w.Body.String() != `{"status":"ok"}` || len(pub.messages) != 0 {
+			t.Errorf("expected the handler's response, got %d %q", w.Code, w.Body.String())
+		}
+	})
+}
+
+func TestHandleDeadLetterPush(t *testing.T) {
+	r, store, _ := newTestRedriver()
+	var push pushRequest
+	push.Message.Data = []byte(eventData)
+	push.Message.MessageID = "dlq-1"
+	push.Message.Attributes = map[string]string{AttrSourceTopic: shared.TopicEnrichedActivity, AttrError: "router failed"}
+	body, _ := json.Marshal(push)
+
+	w := httptest.NewRecorder()
+	r.HandleDeadLetterPush(w, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if e := store.events["u1/dlq-1"]; e == nil || e.Error != "router failed" {
+		t.Errorf("expected the event to be recorded, got %+v", e)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	if got := truncate("héllo", 2); got != "h" {
+		t.Errorf("truncate() = %q, want a whole character", got)
+	}
+	if got := truncate("short", 10); got != "short" {
+		t.Errorf("truncate() = %q", got)
+	}
+}
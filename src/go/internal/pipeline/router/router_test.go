@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/event"
@@ -12,6 +13,7 @@ import (
 	"github.com/fitglue/server/src/go/internal/infra"
 	"github.com/fitglue/server/src/go/internal/pipeline"
 	"github.com/fitglue/server/src/go/internal/pipeline/router"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
@@ -31,6 +33,9 @@ func (m *mockRouterStore) ListPipelines(_ context.Context, _ string) ([]*pbpipel
 func (m *mockRouterStore) GetPipeline(_ context.Context, _, _ string) (*pbpipeline.PipelineConfig, error) {
 	return nil, nil
 }
+func (m *mockRouterStore) GetPipelineVersion(_ context.Context, _, _ string, _ int32) (*pbpipeline.PipelineConfigVersion, error) {
+	return nil, nil
+}
 func (m *mockRouterStore) CreatePipeline(_ context.Context, _ string, cfg *pbpipeline.PipelineConfig) (*pbpipeline.PipelineConfig, error) {
 	return cfg, nil
 }
@@ -56,9 +61,85 @@ func (m *mockRouterStore) ListPipelineRuns(_ context.Context, _, _ string, _ int
 func (m *mockRouterStore) UpdatePipelineRun(_ context.Context, _, _ string, _ map[string]interface{}) error {
 	return m.updateErr
 }
+func (m *mockRouterStore) TransitionPipelineRun(_ context.Context, _, _ string, _ func(*pbpipeline.PipelineRun) (map[string]interface{}, error)) error {
+	return nil
+}
+func (m *mockRouterStore) ListExecutionsForRun(_ context.Context, _, _ string) ([]*pbpipeline.ExecutionRecord, error) {
+	return nil, nil
+}
+func (m *mockRouterStore) GetEnricherRecommendations(_ context.Context, _ string) (*pbpipeline.EnricherRecommendations, error) {
+	return nil, nil
+}
+func (m *mockRouterStore) SetEnricherRecommendations(_ context.Context, _ string, _ *pbpipeline.EnricherRecommendations) error {
+	return nil
+}
+func (m *mockRouterStore) ListPipelineDailyStats(_ context.Context, _, _, _ string) ([]*pbpipeline.PipelineDailyStats, error) {
+	return nil, nil
+}
+func (m *mockRouterStore) ListActivityTypeRules(_ context.Context, _ string) ([]*pbpipeline.ActivityTypeRule, error) {
+	return nil, nil
+}
+func (m *mockRouterStore) GetActivityTypeRule(_ context.Context, _, _ string) (*pbpipeline.ActivityTypeRule, error) {
+	return nil, nil
+}
+func (m *mockRouterStore) SetActivityTypeRule(_ context.Context, _ string, _ *pbpipeline.ActivityTypeRule) error {
+	return nil
+}
+func (m *mockRouterStore) DeleteActivityTypeRule(_ context.Context, _, _ string) error {
+	return nil
+}
+func (m *mockRouterStore) ListActiveUserIDs(_ context.Context, _ time.Time) ([]string, error) {
+	return nil, nil
+}
+func (m *mockRouterStore) ListProviderCircuits(_ context.Context) ([]*pbpipeline.ProviderCircuit, error) {
+	return nil, nil
+}
+func (m *mockRouterStore) SetProviderCircuitMode(_ context.Context, _ pbplugin.EnricherProviderType, _ pbpipeline.ProviderCircuitMode, _, _ string) (*pbpipeline.ProviderCircuit, error) {
+	return nil, nil
+}
+func (m *mockRouterStore) ListProviderCircuitChanges(_ context.Context, _ pbplugin.EnricherProviderType, _ int) ([]*pbpipeline.ProviderCircuitChange, error) {
+	return nil, nil
+}
+func (m *mockRouterStore) GetFailedEvent(_ context.Context, _, _ string) (*pbpipeline.FailedEvent, error) {
+	return nil, nil
+}
+func (m *mockRouterStore) SetFailedEvent(_ context.Context, _ string, _ *pbpipeline.FailedEvent) error {
+	return nil
+}
+func (m *mockRouterStore) ListFailedEvents(_ context.Context, _ string, _ pbpipeline.FailedEventStatus, _ int) ([]*pbpipeline.FailedEvent, error) {
+	return nil, nil
+}
+func (m *mockRouterStore) ListDueFailedEvents(_ context.Context, _ time.Time, _ int) ([]*pbpipeline.FailedEvent, error) {
+	return nil, nil
+}
 func (m *mockRouterStore) FindPipelineRunByActivityId(_ context.Context, _, _ string) (*pbpipeline.PipelineRun, error) {
 	return nil, nil
 }
+func (m *mockRouterStore) ListPipelineRunsBySourceActivity(_ context.Context, _ string, _ pbactivity.ActivitySource, _ string) ([]*pbpipeline.PipelineRun, error) {
+	return nil, nil
+}
+func (m *mockRouterStore) CreatePipelineRun(_ context.Context, _ string, _ *pbpipeline.PipelineRun) error {
+	return nil
+}
+func (m *mockRouterStore) ListRecentPipelineRuns(_ context.Context, _ pipeline.RunFilter, _ time.Time, _ int) ([]*pbpipeline.PipelineRun, error) {
+	return nil, nil
+}
+func (m *mockRouterStore) ListDueRetries(_ context.Context, _ time.Time, _ int) ([]*pipeline.DueRetry, error) {
+	return nil, nil
+}
+func (m *mockRouterStore) ListDeferredRuns(_ context.Context, _, _ string) ([]*pbpipeline.PipelineRun, error) {
+	return nil, nil
+}
+func (m *mockRouterStore) GetPipelinesPausedUntil(_ context.Context, _ string) (time.Time, error) {
+	return time.Time{}, nil
+}
+func (m *mockRouterStore) SetPipelinesPausedUntil(_ context.Context, _ string, _ time.Time) error {
+	return nil
+}
+
+func (m *mockRouterStore) ClaimStarterPipeline(_ context.Context, _ string, _ time.Time) (bool, error) {
+	return false, nil
+}
 
 var _ pipeline.PipelineStore = (*mockRouterStore)(nil)
 
@@ -0,0 +1,61 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// dueRetryBatchSize caps how many runs one scheduled sweep resumes.
+const dueRetryBatchSize = 100
+
+// RetryDueRuns resumes every run whose scheduled enricher retry is due. The
+// enricher schedules them when a provider returns a RetryableError (see
+// enricher.MaxEnricherRetries); this runs on a schedule, and runs that fail
+// to resume stay due for the next sweep.
+func (s *Service) RetryDueRuns(ctx context.Context, _ cloudevents.Event) error {
+	due, err := s.store.ListDueRetries(ctx, time.Now(), dueRetryBatchSize)
+	if err != nil {
+		return fmt.Errorf("list due retries: %w", err)
+	}
+
+	resumed := 0
+	for _, d := range due {
+		if err := s.retryDueRun(ctx, d); err != nil {
+			s.logger.Error(ctx, "Failed to resume run for enricher retry", "error", err, "user_id", d.UserID, "run_id", d.Run.Id)
+			continue
+		}
+		resumed++
+	}
+	if len(due) > 0 {
+		s.logger.Info(ctx, "Resumed runs with due enricher retries", "due", len(due), "resumed", resumed)
+	}
+	return nil
+}
+
+func (s *Service) retryDueRun(ctx context.Context, d *DueRetry) error {
+	run := d.Run
+	if run.OriginalPayloadUri == "" || run.ActivityId == "" {
+		// Nothing to resume from; stop it coming back every sweep
+		if err := s.store.UpdatePipelineRun(ctx, d.UserID, run.Id, map[string]interface{}{"next_retry_at": nil}); err != nil {
+			return fmt.Errorf("clear unresumable retry: %w", err)
+		}
+		return fmt.Errorf("run has no original payload")
+	}
+
+	payload, err := s.resumePayload(ctx, run)
+	if err != nil {
+		return err
+	}
+	payload["retryAttempts"] = run.RetryAttempts
+	if err := s.publishResume(ctx, "com.fitglue.retry_scheduler", payload); err != nil {
+		return err
+	}
+
+	if err := s.store.UpdatePipelineRun(ctx, d.UserID, run.Id, map[string]interface{}{"next_retry_at": nil}); err != nil {
+		return fmt.Errorf("clear next retry: %w", err)
+	}
+	return nil
+}
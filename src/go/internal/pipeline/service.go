@@ -9,14 +9,21 @@ import (
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/internal/uploadschedule"
 	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/description"
 	"github.com/fitglue/server/src/go/pkg/types/formatters"
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Service implements the pbsvc.PipelineServiceServer interface.
@@ -26,6 +33,13 @@ type Service struct {
 	publisher Publisher
 	blobStore BlobStore
 	logger    infra.Logger
+
+	// previewer runs enrichers for PreviewPipeline (nil = unavailable).
+	previewer Previewer
+	// descriptions reads destination descriptions for PreviewDescriptionMerge (nil = unavailable).
+	descriptions DescriptionFetcher
+	// redriver republishes failed events for AdminRedriveFailedEvents (nil = unavailable).
+	redriver FailedEventRedriver
 }
 
 func NewService(store PipelineStore, publisher Publisher, blobStore BlobStore, logger infra.Logger) *Service {
@@ -84,6 +98,55 @@ func validateAndNormalizeSource(source string) (string, error) {
 	return parsed.String(), nil
 }
 
+// validateRaceMode checks that an enabled race mode has a usable window.
+func validateRaceMode(rm *pipeline.RaceModeConfig) error {
+	if rm == nil || !rm.Enabled {
+		return nil
+	}
+	if rm.StartsAt == nil || rm.EndsAt == nil {
+		return fmt.Errorf("starts_at and ends_at are required when race mode is enabled")
+	}
+	if !rm.EndsAt.AsTime().After(rm.StartsAt.AsTime()) {
+		return fmt.Errorf("ends_at must be after starts_at")
+	}
+	return nil
+}
+
+// validateDescriptionTemplates checks that the pipeline's description template
+// and every per-destination description and title template, including race
+// mode's, parse and render.
+func validateDescriptionTemplates(p *pipeline.PipelineConfig) error {
+	if _, err := description.ParseTemplate(p.GetDescriptionTemplate()); err != nil {
+		return err
+	}
+	destConfigs := []map[string]*pipeline.DestinationConfig{p.DestinationConfigs, p.GetRaceMode().GetDestinationConfigs()}
+	for _, configs := range destConfigs {
+		for destId, cfg := range configs {
+			if _, err := description.ParseTemplate(cfg.GetDescriptionTemplate()); err != nil {
+				return fmt.Errorf("%s: %w", destId, err)
+			}
+			if _, err := description.ParseTemplate(cfg.GetTitleTemplate()); err != nil {
+				return fmt.Errorf("%s title: %w", destId, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateDestinationSchedules checks every per-destination posting schedule,
+// including race mode's.
+func validateDestinationSchedules(p *pipeline.PipelineConfig) error {
+	destConfigs := []map[string]*pipeline.DestinationConfig{p.DestinationConfigs, p.GetRaceMode().GetDestinationConfigs()}
+	for _, configs := range destConfigs {
+		for destId, cfg := range configs {
+			if _, err := uploadschedule.Parse(cfg.GetConfig()); err != nil {
+				return fmt.Errorf("%s: %w", destId, err)
+			}
+		}
+	}
+	return nil
+}
+
 func (s *Service) CreatePipeline(ctx context.Context, req *pbsvc.CreatePipelineRequest) (*pipeline.PipelineConfig, error) {
 	if req.UserId == "" || req.Pipeline == nil {
 		return nil, status.Error(codes.InvalidArgument, "user_id and pipeline config are required")
@@ -99,6 +162,18 @@ func (s *Service) CreatePipeline(ctx context.Context, req *pbsvc.CreatePipelineR
 		return nil, status.Error(codes.InvalidArgument, "Missing required field: destinations (must be non-empty array)")
 	}
 
+	if err := validateRaceMode(req.Pipeline.RaceMode); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid race mode: %s", err))
+	}
+
+	if err := validateDescriptionTemplates(req.Pipeline); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid description template: %s", err))
+	}
+
+	if err := validateDestinationSchedules(req.Pipeline); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid posting schedule: %s", err))
+	}
+
 	// Generate pipeline ID
 	req.Pipeline.Id = fmt.Sprintf("pipe_%d", time.Now().UnixMilli())
 	req.Pipeline.Disabled = false
@@ -155,8 +230,30 @@ func (s *Service) UpdatePipeline(ctx context.Context, req *pbsvc.UpdatePipelineR
 		if req.Pipeline.DestinationConfigs != nil {
 			existing.DestinationConfigs = req.Pipeline.DestinationConfigs
 		}
+		if req.Pipeline.RaceMode != nil {
+			if err := validateRaceMode(req.Pipeline.RaceMode); err != nil {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid race mode: %s", err))
+			}
+			existing.RaceMode = req.Pipeline.RaceMode
+		}
+		// Set (even to "") only when sent; "" restores the default layout
+		if req.Pipeline.DescriptionTemplate != nil {
+			existing.DescriptionTemplate = req.Pipeline.DescriptionTemplate
+			if existing.GetDescriptionTemplate() == "" {
+				existing.DescriptionTemplate = nil
+			}
+		}
 		// Disabled is a bool — always apply from request
 		existing.Disabled = req.Pipeline.Disabled
+		// Heart rate source likewise; UNSPECIFIED restores sensor priority
+		existing.HeartRateSource = req.Pipeline.HeartRateSource
+
+		if err := validateDescriptionTemplates(existing); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid description template: %s", err))
+		}
+		if err := validateDestinationSchedules(existing); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid posting schedule: %s", err))
+		}
 	}
 
 	updated, err := s.store.UpdatePipeline(ctx, req.UserId, existing)
@@ -218,6 +315,9 @@ func (s *Service) SubmitInput(ctx context.Context, req *pbsvc.SubmitInputRequest
 	payload["isResume"] = true
 	payload["resumePendingInputId"] = req.PendingInputId
 	payload["activityId"] = input.LinkedActivityId
+	if req.ConfirmDescriptionOverwrite {
+		payload["confirmDescriptionOverwrite"] = true
+	}
 
 	// Re-serialize payload
 	updatedPayloadBytes, err := json.Marshal(payload)
@@ -300,6 +400,11 @@ func (s *Service) RepostActivity(ctx context.Context, req *pbsvc.RepostActivityR
 	default:
 		return nil, status.Error(codes.InvalidArgument, "mode must be one of: full-pipeline, missed-destination, retry-destination")
 	}
+	if req.ReplayOverride != nil {
+		if err := validateReplayOverride(req.Mode, req.ReplayOverride); err != nil {
+			return nil, err
+		}
+	}
 
 	// Look up the most recent pipeline run for this activity (Rule E35)
 	run, err := s.store.FindPipelineRunByActivityId(ctx, req.UserId, req.ActivityId)
@@ -335,6 +440,21 @@ func (s *Service) RepostActivity(ctx context.Context, req *pbsvc.RepostActivityR
 	if req.Destination != "" {
 		payload["repostDestination"] = req.Destination
 	}
+	// Replay against the config the run originally used, not today's
+	if run.PipelineConfigVersion > 0 {
+		payload["pipelineConfigVersion"] = run.PipelineConfigVersion
+	}
+	// A replay is a new run alongside the original, labeled with where it came from
+	if req.ReplayOverride != nil {
+		override, err := protojson.Marshal(req.ReplayOverride)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to serialize replay override")
+		}
+		payload["replayOverride"] = json.RawMessage(override)
+		payload["replayOf"] = run.Id
+		payload["pipelineExecutionId"] = fmt.Sprintf("%s-replay-%d", run.Id, time.Now().Unix())
+		delete(payload, "retryAttempts")
+	}
 
 	updatedPayloadBytes, err := json.Marshal(payload)
 	if err != nil {
@@ -357,6 +477,135 @@ func (s *Service) RepostActivity(ctx context.Context, req *pbsvc.RepostActivityR
 	return &emptypb.Empty{}, nil
 }
 
+// validateReplayOverride checks that an override replaces something and names
+// only real enrichers and destinations. Overrides replace the whole config, so
+// targeted destination modes cannot take one.
+func validateReplayOverride(mode string, override *pbevents.ReplayOverride) error {
+	if mode != "full-pipeline" {
+		return status.Error(codes.InvalidArgument, "replay_override is only supported in full-pipeline mode")
+	}
+	if len(override.Enrichers) == 0 && len(override.Destinations) == 0 {
+		return status.Error(codes.InvalidArgument, "replay_override must list enrichers or destinations")
+	}
+	for _, e := range override.Enrichers {
+		if e.ProviderType == pbplugin.EnricherProviderType_ENRICHER_PROVIDER_UNSPECIFIED {
+			return status.Error(codes.InvalidArgument, "replay_override enrichers must set provider_type")
+		}
+	}
+	for _, d := range override.Destinations {
+		if d == pbplugin.DestinationType_DESTINATION_UNSPECIFIED {
+			return status.Error(codes.InvalidArgument, "replay_override destinations must be set")
+		}
+	}
+	return nil
+}
+
+// RetryPipelineRun re-runs one pipeline run from its stored original payload.
+// Unlike RepostActivity it bypasses the splitter and resumes the same run,
+// optionally limited to the named enrichers; destinations that already hold
+// the activity are updated rather than re-created.
+func (s *Service) RetryPipelineRun(ctx context.Context, req *pbsvc.RetryPipelineRunRequest) (*emptypb.Empty, error) {
+	if req.UserId == "" || req.PipelineRunId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and pipeline_run_id are required")
+	}
+
+	run, err := s.store.GetPipelineRun(ctx, req.UserId, req.PipelineRunId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to get pipeline run", "error", err, "runId", req.PipelineRunId)
+		return nil, status.Error(codes.Internal, "failed to read run")
+	}
+	if run == nil || (req.PipelineId != "" && run.PipelineId != req.PipelineId) {
+		return nil, status.Error(codes.NotFound, "run not found")
+	}
+	if run.Status == pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DEFERRED {
+		return nil, status.Error(codes.FailedPrecondition, "run is deferred; resume the pipeline instead")
+	}
+	if run.OriginalPayloadUri == "" || run.ActivityId == "" {
+		return nil, status.Error(codes.FailedPrecondition, "pipeline run has no original payload URI; run is not retryable")
+	}
+
+	if len(req.Enrichers) > 0 && len(run.Boosters) > 0 {
+		known := make(map[string]bool, len(run.Boosters))
+		for _, b := range run.Boosters {
+			known[b.ProviderName] = true
+		}
+		for _, name := range req.Enrichers {
+			if !known[name] {
+				return nil, status.Error(codes.InvalidArgument, "enricher did not take part in this run: "+name)
+			}
+		}
+	}
+
+	payload, err := s.resumePayload(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Enrichers) > 0 {
+		payload["resumeOnlyEnrichers"] = req.Enrichers
+	}
+	if err := s.publishResume(ctx, "com.fitglue.retry_handler", payload); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Pipeline run retry published", "runId", run.Id, "enrichers", req.Enrichers, "topic", shared.TopicPipelineActivity)
+	return &emptypb.Empty{}, nil
+}
+
+// resumePayload loads the run's original payload and marks it to resume the
+// same run rather than start a new one. Its enricher retry counts are reset;
+// scheduled retries set them again (see RetryDueRuns).
+func (s *Service) resumePayload(ctx context.Context, run *pipeline.PipelineRun) (map[string]interface{}, error) {
+	payloadBytes, err := s.blobStore.Get(ctx, run.OriginalPayloadUri)
+	if err != nil {
+		s.logger.Error(ctx, "failed to fetch original payload from GCS", "error", err, "uri", run.OriginalPayloadUri)
+		return nil, status.Error(codes.Internal, "failed to fetch original payload")
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+		s.logger.Error(ctx, "failed to parse original payload", "error", err)
+		return nil, status.Error(codes.Internal, "failed to parse original payload")
+	}
+
+	payload["isResume"] = true
+	payload["activityId"] = run.ActivityId
+	payload["pipelineExecutionId"] = run.Id
+	delete(payload, "resumePendingInputId")
+	delete(payload, "resumeOnlyEnrichers")
+	delete(payload, "retryAttempts")
+	for _, d := range run.Destinations {
+		if d.GetExternalId() != "" {
+			payload["useUpdateMethod"] = true
+			break
+		}
+	}
+	if run.PipelineConfigVersion > 0 {
+		payload["pipelineConfigVersion"] = run.PipelineConfigVersion
+	}
+	return payload, nil
+}
+
+// publishResume publishes a resume payload straight to the enricher,
+// bypassing the splitter.
+func (s *Service) publishResume(ctx context.Context, source string, payload map[string]interface{}) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to serialize retry payload")
+	}
+
+	ce := cloudevents.NewEvent()
+	ce.SetID(fmt.Sprintf("%d", time.Now().UnixNano()))
+	ce.SetSource(source)
+	ce.SetType("com.fitglue.cloud_event.retry")
+	ce.SetData(cloudevents.ApplicationJSON, payloadBytes)
+
+	if _, err := s.publisher.PublishCloudEvent(ctx, shared.TopicPipelineActivity, ce); err != nil {
+		s.logger.Error(ctx, "failed to publish retry event", "error", err)
+		return status.Error(codes.Internal, "failed to publish retry event")
+	}
+	return nil
+}
+
 func (s *Service) GetPipelineRun(ctx context.Context, req *pbsvc.GetPipelineRunRequest) (*pipeline.PipelineRun, error) {
 	if req.UserId == "" || req.RunId == "" {
 		return nil, status.Error(codes.InvalidArgument, "missing required fields")
@@ -374,6 +623,97 @@ func (s *Service) GetPipelineRun(ctx context.Context, req *pbsvc.GetPipelineRunR
 	return run, nil
 }
 
+// GetPipelineRunDebugBundle assembles a run, the pipeline config it ran with, execution
+// records and payloads into a single document for support. Only a missing run, or one
+// from another pipeline, is fatal; anything else that can't be loaded is reported in the
+// bundle's warnings. Credentials are redacted, since bundles are shared.
+func (s *Service) GetPipelineRunDebugBundle(ctx context.Context, req *pbsvc.GetPipelineRunDebugBundleRequest) (*pipeline.PipelineRunDebugBundle, error) {
+	if req.PipelineId == "" {
+		return nil, status.Error(codes.InvalidArgument, "pipeline_id is required")
+	}
+	run, err := s.GetPipelineRun(ctx, &pbsvc.GetPipelineRunRequest{UserId: req.UserId, RunId: req.RunId})
+	if err != nil {
+		return nil, err
+	}
+	if run.PipelineId != req.PipelineId {
+		return nil, status.Error(codes.NotFound, "run not found")
+	}
+
+	bundle := &pipeline.PipelineRunDebugBundle{
+		Run:         run,
+		GeneratedAt: timestamppb.Now(),
+	}
+
+	if cfg := s.debugPipelineConfig(ctx, req.UserId, run, bundle); cfg != nil {
+		bundle.Pipeline = proto.Clone(cfg).(*pipeline.PipelineConfig)
+		redactConfig(bundle.Pipeline)
+	}
+
+	executions, err := s.store.ListExecutionsForRun(ctx, req.UserId, req.RunId)
+	if err != nil {
+		s.logger.Warn(ctx, "debug bundle: failed to list executions", "error", err, "runId", req.RunId)
+		bundle.Warnings = append(bundle.Warnings, "failed to list executions")
+	}
+	for _, e := range executions {
+		if e.InputsJson != nil {
+			e.InputsJson = proto.String(redactJSON(*e.InputsJson))
+		}
+		if e.OutputsJson != nil {
+			e.OutputsJson = proto.String(redactJSON(*e.OutputsJson))
+		}
+	}
+	bundle.Executions = executions
+
+	bundle.OriginalPayloadJson = redactJSON(s.readDebugBlob(ctx, run.OriginalPayloadUri, "original payload", bundle))
+	bundle.EnrichedEventJson = redactJSON(s.readDebugBlob(ctx, run.EnrichedEventUri, "enriched event", bundle))
+
+	return bundle, nil
+}
+
+// debugPipelineConfig returns the config version the run used. Runs from
+// before versioning, or whose version is missing, fall back to the current
+// config with a warning, since it may have been edited since.
+func (s *Service) debugPipelineConfig(ctx context.Context, userID string, run *pipeline.PipelineRun, bundle *pipeline.PipelineRunDebugBundle) *pipeline.PipelineConfig {
+	if run.PipelineConfigVersion > 0 {
+		version, err := s.store.GetPipelineVersion(ctx, userID, run.PipelineId, run.PipelineConfigVersion)
+		if err != nil {
+			s.logger.Warn(ctx, "debug bundle: failed to read pipeline version", "error", err, "pipelineId", run.PipelineId, "version", run.PipelineConfigVersion)
+		}
+		if version.GetConfig() != nil {
+			return version.GetConfig()
+		}
+	}
+
+	cfg, err := s.store.GetPipeline(ctx, userID, run.PipelineId)
+	switch {
+	case err != nil:
+		s.logger.Warn(ctx, "debug bundle: failed to read pipeline", "error", err, "pipelineId", run.PipelineId)
+		bundle.Warnings = append(bundle.Warnings, fmt.Sprintf("failed to read pipeline %s", run.PipelineId))
+	case cfg == nil:
+		bundle.Warnings = append(bundle.Warnings, fmt.Sprintf("pipeline %s no longer exists", run.PipelineId))
+	case run.PipelineConfigVersion == 0:
+		bundle.Warnings = append(bundle.Warnings, fmt.Sprintf("run predates config versioning; showing the current config (version %d)", cfg.Version))
+	default:
+		bundle.Warnings = append(bundle.Warnings, fmt.Sprintf("config version %d is unavailable; showing the current config (version %d)", run.PipelineConfigVersion, cfg.Version))
+	}
+	return cfg
+}
+
+// readDebugBlob fetches a payload for a debug bundle, recording a warning instead of
+// failing when it's unavailable (e.g. expired by the bucket lifecycle).
+func (s *Service) readDebugBlob(ctx context.Context, uri, name string, bundle *pipeline.PipelineRunDebugBundle) string {
+	if uri == "" {
+		return ""
+	}
+	data, err := s.blobStore.Get(ctx, uri)
+	if err != nil {
+		s.logger.Warn(ctx, "debug bundle: failed to read blob", "error", err, "uri", uri)
+		bundle.Warnings = append(bundle.Warnings, fmt.Sprintf("%s unavailable at %s", name, uri))
+		return ""
+	}
+	return string(data)
+}
+
 func (s *Service) ListPipelineRuns(ctx context.Context, req *pbsvc.ListPipelineRunsRequest) (*pbsvc.ListPipelineRunsResponse, error) {
 	if req.UserId == "" {
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
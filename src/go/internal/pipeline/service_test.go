@@ -5,29 +5,64 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/pkg/framework"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 	"github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // MockStore
 type MockPipelineStore struct {
-	Pipelines     map[string]*pipeline.PipelineConfig
+	Pipelines map[string]*pipeline.PipelineConfig
+	// Versions is keyed by user ID, pipeline ID and version.
+	Versions      map[string]*pipeline.PipelineConfigVersion
 	PendingInputs map[string]*pipeline.PendingInput
 	Runs          map[string]*pipeline.PipelineRun
+	Executions    map[string][]*pipeline.ExecutionRecord
+	// Recommendations is keyed by user ID.
+	Recommendations map[string]*pipeline.EnricherRecommendations
+	ActiveUserIDs   []string
+	// DailyStats is keyed by pipeline ID.
+	DailyStats map[string][]*pipeline.PipelineDailyStats
+	// PausedUntil and StarterClaims are keyed by user ID.
+	PausedUntil   map[string]time.Time
+	StarterClaims map[string]time.Time
+	TypeRules     map[string]*pipeline.ActivityTypeRule
+	Circuits      map[plugin.EnricherProviderType]*pipeline.ProviderCircuit
+	// CircuitChanges is newest first.
+	CircuitChanges []*pipeline.ProviderCircuitChange
+	FailedEvents   map[string]*pipeline.FailedEvent
 }
 
 func NewMockStore() *MockPipelineStore {
 	return &MockPipelineStore{
-		Pipelines:     make(map[string]*pipeline.PipelineConfig),
-		PendingInputs: make(map[string]*pipeline.PendingInput),
-		Runs:          make(map[string]*pipeline.PipelineRun),
+		Pipelines:       make(map[string]*pipeline.PipelineConfig),
+		Versions:        make(map[string]*pipeline.PipelineConfigVersion),
+		PendingInputs:   make(map[string]*pipeline.PendingInput),
+		Runs:            make(map[string]*pipeline.PipelineRun),
+		Executions:      make(map[string][]*pipeline.ExecutionRecord),
+		Recommendations: make(map[string]*pipeline.EnricherRecommendations),
+		DailyStats:      make(map[string][]*pipeline.PipelineDailyStats),
+		PausedUntil:     make(map[string]time.Time),
+		StarterClaims:   make(map[string]time.Time),
+		TypeRules:       make(map[string]*pipeline.ActivityTypeRule),
+		Circuits:        make(map[plugin.EnricherProviderType]*pipeline.ProviderCircuit),
+		FailedEvents:    make(map[string]*pipeline.FailedEvent),
 	}
 }
 
@@ -48,12 +83,23 @@ func (m *MockPipelineStore) GetPipeline(ctx context.Context, userID, pipelineID
 	return m.Pipelines[m.key(userID, pipelineID)], nil
 }
 
+func (m *MockPipelineStore) GetPipelineVersion(ctx context.Context, userID, pipelineID string, version int32) (*pipeline.PipelineConfigVersion, error) {
+	return m.Versions[fmt.Sprintf("%s_%s_%d", userID, pipelineID, version)], nil
+}
+
 func (m *MockPipelineStore) CreatePipeline(ctx context.Context, userID string, cfg *pipeline.PipelineConfig) (*pipeline.PipelineConfig, error) {
+	cfg.Version = 1
 	m.Pipelines[m.key(userID, cfg.Id)] = cfg
 	return cfg, nil
 }
 
 func (m *MockPipelineStore) UpdatePipeline(ctx context.Context, userID string, cfg *pipeline.PipelineConfig) (*pipeline.PipelineConfig, error) {
+	// Mirrors FirestoreStore: every save takes the next version
+	var current int32
+	if existing, ok := m.Pipelines[m.key(userID, cfg.Id)]; ok {
+		current = existing.Version
+	}
+	cfg.Version = current + 1
 	m.Pipelines[m.key(userID, cfg.Id)] = cfg
 	return cfg, nil
 }
@@ -93,6 +139,16 @@ func (m *MockPipelineStore) FindPipelineRunByActivityId(ctx context.Context, use
 	return nil, nil
 }
 
+func (m *MockPipelineStore) ListPipelineRunsBySourceActivity(ctx context.Context, userID string, source pbactivity.ActivitySource, sourceActivityID string) ([]*pipeline.PipelineRun, error) {
+	var results []*pipeline.PipelineRun
+	for _, r := range m.Runs {
+		if r.Source == source.String() && r.SourceActivityId == sourceActivityID {
+			results = append(results, r)
+		}
+	}
+	return results, nil
+}
+
 func (m *MockPipelineStore) ListPipelineRuns(ctx context.Context, userID, pipelineID string, limit int32, pageToken string) ([]*pipeline.PipelineRun, string, error) {
 	var results []*pipeline.PipelineRun
 	for _, r := range m.Runs {
@@ -104,10 +160,232 @@ func (m *MockPipelineStore) ListPipelineRuns(ctx context.Context, userID, pipeli
 }
 
 func (m *MockPipelineStore) UpdatePipelineRun(ctx context.Context, userID, runID string, updateData map[string]interface{}) error {
-	// For a mock, we can just update the internal map or do nothing.
+	// Only status, type and next_retry_at changes are tracked; other fields
+	// are ignored.
+	if run, ok := m.Runs[m.key(userID, runID)]; ok {
+		if st, ok := updateData["status"].(int32); ok {
+			run.Status = pipeline.PipelineRunStatus(st)
+		}
+		if t, ok := updateData["type"].(int32); ok {
+			run.Type = pbactivity.ActivityType(t)
+		}
+		if v, ok := updateData["next_retry_at"]; ok {
+			if at, isTime := v.(time.Time); isTime {
+				run.NextRetryAt = timestamppb.New(at)
+			} else {
+				run.NextRetryAt = nil
+			}
+		}
+	}
+	return nil
+}
+
+func (m *MockPipelineStore) TransitionPipelineRun(ctx context.Context, userID, runID string, fn func(run *pipeline.PipelineRun) (map[string]interface{}, error)) error {
+	var current *pipeline.PipelineRun
+	if run, ok := m.Runs[m.key(userID, runID)]; ok {
+		current = proto.Clone(run).(*pipeline.PipelineRun)
+	}
+	updateData, err := fn(current)
+	if err != nil {
+		return err
+	}
+	if at, ok := updateData["completed_at"].(time.Time); ok && current != nil {
+		m.Runs[m.key(userID, runID)].CompletedAt = timestamppb.New(at)
+	}
+	return m.UpdatePipelineRun(ctx, userID, runID, updateData)
+}
+
+func (m *MockPipelineStore) CreatePipelineRun(ctx context.Context, userID string, run *pipeline.PipelineRun) error {
+	m.Runs[m.key(userID, run.Id)] = run
 	return nil
 }
 
+func (m *MockPipelineStore) ListDeferredRuns(ctx context.Context, userID, pipelineID string) ([]*pipeline.PipelineRun, error) {
+	var results []*pipeline.PipelineRun
+	for _, r := range m.Runs {
+		if r.Status == pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DEFERRED && (pipelineID == "" || r.PipelineId == pipelineID) {
+			results = append(results, r)
+		}
+	}
+	return results, nil
+}
+
+func (m *MockPipelineStore) ListDueRetries(ctx context.Context, before time.Time, limit int) ([]*DueRetry, error) {
+	var due []*DueRetry
+	for key, r := range m.Runs {
+		if r.Status == pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING && r.NextRetryAt != nil && !r.NextRetryAt.AsTime().After(before) && len(due) < limit {
+			userID, _, _ := strings.Cut(key, "_")
+			due = append(due, &DueRetry{UserID: userID, Run: r})
+		}
+	}
+	return due, nil
+}
+
+func (m *MockPipelineStore) ListRecentPipelineRuns(ctx context.Context, filter RunFilter, before time.Time, limit int) ([]*pipeline.PipelineRun, error) {
+	var runs []*pipeline.PipelineRun
+	for key, r := range m.Runs {
+		userID, _, _ := strings.Cut(key, "_")
+		if filter.UserID != "" && userID != filter.UserID {
+			continue
+		}
+		if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, r.Status) {
+			continue
+		}
+		if filter.Source != "" && r.Source != filter.Source {
+			continue
+		}
+		if r.CreatedAt == nil || !r.CreatedAt.AsTime().Before(before) {
+			continue
+		}
+		run := proto.Clone(r).(*pipeline.PipelineRun)
+		run.UserId = userID
+		runs = append(runs, run)
+	}
+	sort.Slice(runs, func(i, j int) bool { return runs[i].CreatedAt.AsTime().After(runs[j].CreatedAt.AsTime()) })
+	if len(runs) > limit {
+		runs = runs[:limit]
+	}
+	return runs, nil
+}
+
+func (m *MockPipelineStore) GetPipelinesPausedUntil(ctx context.Context, userID string) (time.Time, error) {
+	return m.PausedUntil[userID], nil
+}
+
+func (m *MockPipelineStore) SetPipelinesPausedUntil(ctx context.Context, userID string, until time.Time) error {
+	if until.IsZero() {
+		delete(m.PausedUntil, userID)
+		return nil
+	}
+	m.PausedUntil[userID] = until
+	return nil
+}
+
+func (m *MockPipelineStore) ClaimStarterPipeline(ctx context.Context, userID string, at time.Time) (bool, error) {
+	if _, ok := m.StarterClaims[userID]; ok {
+		return false, nil
+	}
+	m.StarterClaims[userID] = at
+	return true, nil
+}
+
+func (m *MockPipelineStore) ListPipelineDailyStats(ctx context.Context, userID, pipelineID, since string) ([]*pipeline.PipelineDailyStats, error) {
+	var results []*pipeline.PipelineDailyStats
+	for _, day := range m.DailyStats[pipelineID] {
+		if day.Date >= since {
+			results = append(results, day)
+		}
+	}
+	return results, nil
+}
+
+func (m *MockPipelineStore) ListActivityTypeRules(ctx context.Context, userID string) ([]*pipeline.ActivityTypeRule, error) {
+	var results []*pipeline.ActivityTypeRule
+	for k, r := range m.TypeRules {
+		if k == m.key(userID, r.Id) {
+			results = append(results, r)
+		}
+	}
+	return results, nil
+}
+
+func (m *MockPipelineStore) GetActivityTypeRule(ctx context.Context, userID, ruleID string) (*pipeline.ActivityTypeRule, error) {
+	return m.TypeRules[m.key(userID, ruleID)], nil
+}
+
+func (m *MockPipelineStore) SetActivityTypeRule(ctx context.Context, userID string, rule *pipeline.ActivityTypeRule) error {
+	m.TypeRules[m.key(userID, rule.Id)] = rule
+	return nil
+}
+
+func (m *MockPipelineStore) DeleteActivityTypeRule(ctx context.Context, userID, ruleID string) error {
+	delete(m.TypeRules, m.key(userID, ruleID))
+	return nil
+}
+
+func (m *MockPipelineStore) ListExecutionsForRun(ctx context.Context, userID, runID string) ([]*pipeline.ExecutionRecord, error) {
+	return m.Executions[m.key(userID, runID)], nil
+}
+
+func (m *MockPipelineStore) GetEnricherRecommendations(ctx context.Context, userID string) (*pipeline.EnricherRecommendations, error) {
+	return m.Recommendations[userID], nil
+}
+
+func (m *MockPipelineStore) SetEnricherRecommendations(ctx context.Context, userID string, recs *pipeline.EnricherRecommendations) error {
+	m.Recommendations[userID] = recs
+	return nil
+}
+
+func (m *MockPipelineStore) ListActiveUserIDs(ctx context.Context, since time.Time) ([]string, error) {
+	return m.ActiveUserIDs, nil
+}
+
+func (m *MockPipelineStore) ListProviderCircuits(ctx context.Context) ([]*pipeline.ProviderCircuit, error) {
+	var circuits []*pipeline.ProviderCircuit
+	for _, c := range m.Circuits {
+		circuits = append(circuits, c)
+	}
+	return circuits, nil
+}
+
+func (m *MockPipelineStore) SetProviderCircuitMode(ctx context.Context, providerType plugin.EnricherProviderType, mode pipeline.ProviderCircuitMode, reason, actor string) (*pipeline.ProviderCircuit, error) {
+	c, ok := m.Circuits[providerType]
+	if !ok {
+		c = &pipeline.ProviderCircuit{ProviderType: providerType}
+		m.Circuits[providerType] = c
+	}
+	c.Mode = mode
+	c.ModeReason = &reason
+	c.ModeSetBy = &actor
+	m.CircuitChanges = append([]*pipeline.ProviderCircuitChange{{
+		ProviderType: providerType,
+		Event:        pipeline.ProviderCircuitEvent_PROVIDER_CIRCUIT_EVENT_MODE_CHANGED,
+		Mode:         mode,
+		Reason:       &reason,
+		Actor:        actor,
+	}}, m.CircuitChanges...)
+	return c, nil
+}
+
+func (m *MockPipelineStore) ListProviderCircuitChanges(ctx context.Context, providerType plugin.EnricherProviderType, limit int) ([]*pipeline.ProviderCircuitChange, error) {
+	var changes []*pipeline.ProviderCircuitChange
+	for _, c := range m.CircuitChanges {
+		if c.ProviderType == providerType && len(changes) < limit {
+			changes = append(changes, c)
+		}
+	}
+	return changes, nil
+}
+
+func (m *MockPipelineStore) GetFailedEvent(ctx context.Context, userID, eventID string) (*pipeline.FailedEvent, error) {
+	return m.FailedEvents[m.key(userID, eventID)], nil
+}
+
+func (m *MockPipelineStore) SetFailedEvent(ctx context.Context, userID string, event *pipeline.FailedEvent) error {
+	m.FailedEvents[m.key(userID, event.Id)] = event
+	return nil
+}
+
+func (m *MockPipelineStore) ListFailedEvents(ctx context.Context, userID string, status pipeline.FailedEventStatus, limit int) ([]*pipeline.FailedEvent, error) {
+	var events []*pipeline.FailedEvent
+	for _, e := range m.FailedEvents {
+		if e.UserId == userID && (status == pipeline.FailedEventStatus_FAILED_EVENT_STATUS_UNSPECIFIED || e.Status == status) && len(events) < limit {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (m *MockPipelineStore) ListDueFailedEvents(ctx context.Context, before time.Time, limit int) ([]*pipeline.FailedEvent, error) {
+	var events []*pipeline.FailedEvent
+	for _, e := range m.FailedEvents {
+		if e.Status == pipeline.FailedEventStatus_FAILED_EVENT_STATUS_PENDING && !e.NextAttemptAt.AsTime().After(before) && len(events) < limit {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
 // MockPublisher
 type MockPublisher struct {
 	PublishedEvents []cloudevents.Event
@@ -416,3 +694,566 @@ func TestUpdatePipeline_NormalizesSource(t *testing.T) {
 		t.Errorf("expected normalized source 'SOURCE_HEVY', got %q", res.Source)
 	}
 }
+
+func TestUpdatePipeline_RaceMode(t *testing.T) {
+	start := time.Date(2026, 4, 25, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		raceMode *pipeline.RaceModeConfig
+		wantCode codes.Code
+	}{
+		{"valid window", &pipeline.RaceModeConfig{Enabled: true, StartsAt: timestamppb.New(start), EndsAt: timestamppb.New(start.Add(48 * time.Hour))}, codes.OK},
+		{"disabled without window", &pipeline.RaceModeConfig{}, codes.OK},
+		{"missing end", &pipeline.RaceModeConfig{Enabled: true, StartsAt: timestamppb.New(start)}, codes.InvalidArgument},
+		{"end before start", &pipeline.RaceModeConfig{Enabled: true, StartsAt: timestamppb.New(start), EndsAt: timestamppb.New(start.Add(-time.Hour))}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMockStore()
+			svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+			store.Pipelines["user1_pipe1"] = &pipeline.PipelineConfig{
+				Id:           "pipe1",
+				Source:       "SOURCE_STRAVA",
+				Destinations: []plugin.DestinationType{1},
+			}
+
+			res, err := svc.UpdatePipeline(context.Background(), &pbsvc.UpdatePipelineRequest{
+				UserId:     "user1",
+				PipelineId: "pipe1",
+				Pipeline:   &pipeline.PipelineConfig{RaceMode: tt.raceMode},
+			})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("expected %v, got %v", tt.wantCode, err)
+			}
+			if err == nil && res.RaceMode != tt.raceMode {
+				t.Errorf("expected race mode to be stored")
+			}
+		})
+	}
+}
+
+func TestUpdatePipeline_DescriptionTemplate(t *testing.T) {
+	valid := "{{.Weather}}\n\n{{.Original}}"
+	broken := "{{.Weather"
+	empty := ""
+
+	tests := []struct {
+		name         string
+		template     *string
+		destConfigs  map[string]*pipeline.DestinationConfig
+		wantCode     codes.Code
+		wantTemplate string
+	}{
+		{"set", &valid, nil, codes.OK, valid},
+		{"not sent keeps existing", nil, nil, codes.OK, "{{.Sections}}"},
+		{"empty clears", &empty, nil, codes.OK, ""},
+		{"invalid", &broken, nil, codes.InvalidArgument, ""},
+		{"invalid destination template", nil, map[string]*pipeline.DestinationConfig{"strava": {DescriptionTemplate: "{{if}}"}}, codes.InvalidArgument, ""},
+		{"invalid title template", nil, map[string]*pipeline.DestinationConfig{"strava": {TitleTemplate: "{{.Emoji"}}, codes.InvalidArgument, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMockStore()
+			svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+			existing := "{{.Sections}}"
+			store.Pipelines["user1_pipe1"] = &pipeline.PipelineConfig{
+				Id:                  "pipe1",
+				Source:              "SOURCE_STRAVA",
+				Destinations:        []plugin.DestinationType{1},
+				DescriptionTemplate: &existing,
+			}
+
+			res, err := svc.UpdatePipeline(context.Background(), &pbsvc.UpdatePipelineRequest{
+				UserId:     "user1",
+				PipelineId: "pipe1",
+				Pipeline:   &pipeline.PipelineConfig{DescriptionTemplate: tt.template, DestinationConfigs: tt.destConfigs},
+			})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("expected %v, got %v", tt.wantCode, err)
+			}
+			if err == nil && res.GetDescriptionTemplate() != tt.wantTemplate {
+				t.Errorf("expected template %q, got %q", tt.wantTemplate, res.GetDescriptionTemplate())
+			}
+		})
+	}
+}
+
+func TestCreatePipeline_InvalidDescriptionTemplate(t *testing.T) {
+	svc := NewService(NewMockStore(), &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+	broken := "{{.Original"
+
+	_, err := svc.CreatePipeline(context.Background(), &pbsvc.CreatePipelineRequest{
+		UserId: "user1",
+		Pipeline: &pipeline.PipelineConfig{
+			Source:              "SOURCE_STRAVA",
+			Destinations:        []plugin.DestinationType{1},
+			DescriptionTemplate: &broken,
+		},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestCreatePipeline_InvalidPostingSchedule(t *testing.T) {
+	svc := NewService(NewMockStore(), &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+
+	_, err := svc.CreatePipeline(context.Background(), &pbsvc.CreatePipelineRequest{
+		UserId: "user1",
+		Pipeline: &pipeline.PipelineConfig{
+			Source:       "SOURCE_STRAVA",
+			Destinations: []plugin.DestinationType{1},
+			DestinationConfigs: map[string]*pipeline.DestinationConfig{
+				"strava": {Config: map[string]string{"schedule_window": "22:00-22:00"}},
+			},
+		},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestAdminSetProviderCircuitMode(t *testing.T) {
+	weather := plugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER
+	disabled := pipeline.ProviderCircuitMode_PROVIDER_CIRCUIT_MODE_DISABLED
+
+	tests := []struct {
+		name     string
+		req      *pbsvc.AdminSetProviderCircuitModeRequest
+		wantCode codes.Code
+	}{
+		{name: "disable", req: &pbsvc.AdminSetProviderCircuitModeRequest{ProviderType: weather, Mode: disabled, Reason: "upstream outage", AdminUserId: "admin-1"}, wantCode: codes.OK},
+		{name: "back to automatic without reason", req: &pbsvc.AdminSetProviderCircuitModeRequest{ProviderType: weather, AdminUserId: "admin-1"}, wantCode: codes.OK},
+		{name: "override without reason", req: &pbsvc.AdminSetProviderCircuitModeRequest{ProviderType: weather, Mode: disabled, AdminUserId: "admin-1"}, wantCode: codes.InvalidArgument},
+		{name: "missing provider", req: &pbsvc.AdminSetProviderCircuitModeRequest{Mode: disabled, Reason: "outage", AdminUserId: "admin-1"}, wantCode: codes.InvalidArgument},
+		{name: "unknown mode", req: &pbsvc.AdminSetProviderCircuitModeRequest{ProviderType: weather, Mode: 42, Reason: "outage", AdminUserId: "admin-1"}, wantCode: codes.InvalidArgument},
+		{name: "missing admin", req: &pbsvc.AdminSetProviderCircuitModeRequest{ProviderType: weather, Mode: disabled, Reason: "outage"}, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMockStore()
+			svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+
+			c, err := svc.AdminSetProviderCircuitMode(context.Background(), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("expected %v, got %v", tt.wantCode, err)
+			}
+			if err != nil {
+				return
+			}
+			if c.Mode != tt.req.Mode || c.GetModeSetBy() != "admin-1" {
+				t.Errorf("unexpected circuit %v", c)
+			}
+
+			res, err := svc.AdminListProviderCircuitChanges(context.Background(), &pbsvc.AdminListProviderCircuitChangesRequest{ProviderType: weather})
+			if err != nil || len(res.Changes) != 1 || res.Changes[0].Actor != "admin-1" {
+				t.Errorf("expected the change in the history, got %v (err %v)", res.GetChanges(), err)
+			}
+		})
+	}
+}
+
+func TestAdminRedriveFailedEvents(t *testing.T) {
+	exhausted := &pipeline.FailedEvent{Id: "e1", UserId: "user1", Status: pipeline.FailedEventStatus_FAILED_EVENT_STATUS_EXHAUSTED}
+
+	tests := []struct {
+		name     string
+		req      *pbsvc.AdminRedriveFailedEventsRequest
+		noDriver bool
+		wantCode codes.Code
+	}{
+		{name: "redrives selected events", req: &pbsvc.AdminRedriveFailedEventsRequest{UserId: "user1", EventIds: []string{"e1"}}, wantCode: codes.OK},
+		{name: "unknown event", req: &pbsvc.AdminRedriveFailedEventsRequest{UserId: "user1", EventIds: []string{"e1", "nope"}}, wantCode: codes.NotFound},
+		{name: "no events", req: &pbsvc.AdminRedriveFailedEventsRequest{UserId: "user1"}, wantCode: codes.InvalidArgument},
+		{name: "redrive unavailable", req: &pbsvc.AdminRedriveFailedEventsRequest{UserId: "user1", EventIds: []string{"e1"}}, noDriver: true, wantCode: codes.Unimplemented},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMockStore()
+			store.FailedEvents["user1_e1"] = exhausted
+			svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+
+			var redriven []*pipeline.FailedEvent
+			if !tt.noDriver {
+				svc.SetRedriver(func(ctx context.Context, events []*pipeline.FailedEvent) error {
+					redriven = events
+					return nil
+				})
+			}
+
+			res, err := svc.AdminRedriveFailedEvents(context.Background(), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("expected %v, got %v", tt.wantCode, err)
+			}
+			if err != nil {
+				if redriven != nil {
+					t.Error("expected nothing redriven")
+				}
+				return
+			}
+			if len(redriven) != 1 || redriven[0] != exhausted || len(res.Events) != 1 {
+				t.Errorf("expected the exhausted event to be redriven, got %v", redriven)
+			}
+		})
+	}
+}
+
+func TestAdminListFailedEvents(t *testing.T) {
+	store := NewMockStore()
+	store.FailedEvents["user1_e1"] = &pipeline.FailedEvent{Id: "e1", UserId: "user1", Status: pipeline.FailedEventStatus_FAILED_EVENT_STATUS_PENDING}
+	store.FailedEvents["user1_e2"] = &pipeline.FailedEvent{Id: "e2", UserId: "user1", Status: pipeline.FailedEventStatus_FAILED_EVENT_STATUS_EXHAUSTED}
+	store.FailedEvents["user2_e3"] = &pipeline.FailedEvent{Id: "e3", UserId: "user2", Status: pipeline.FailedEventStatus_FAILED_EVENT_STATUS_EXHAUSTED}
+	svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+
+	res, err := svc.AdminListFailedEvents(context.Background(), &pbsvc.AdminListFailedEventsRequest{
+		UserId: "user1",
+		Status: pipeline.FailedEventStatus_FAILED_EVENT_STATUS_EXHAUSTED,
+	})
+	if err != nil || len(res.Events) != 1 || res.Events[0].Id != "e2" {
+		t.Errorf("expected the user's exhausted event, got %v (err %v)", res.GetEvents(), err)
+	}
+
+	if _, err := svc.AdminListFailedEvents(context.Background(), &pbsvc.AdminListFailedEventsRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument without a user, got %v", err)
+	}
+}
+
+func TestAdminListPipelineRuns(t *testing.T) {
+	now := time.Now()
+	run := func(id, source string, st pipeline.PipelineRunStatus, ago time.Duration) *pipeline.PipelineRun {
+		return &pipeline.PipelineRun{Id: id, Source: source, Status: st, CreatedAt: timestamppb.New(now.Add(-ago))}
+	}
+	store := NewMockStore()
+	store.Runs["user1_r1"] = run("r1", "SOURCE_STRAVA", pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_FAILED, time.Hour)
+	store.Runs["user1_r2"] = run("r2", "SOURCE_STRAVA", pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED, 2*time.Hour)
+	store.Runs["user2_r3"] = run("r3", "SOURCE_HEVY", pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_PARTIAL, 3*time.Hour)
+	store.Runs["user2_r4"] = run("r4", "SOURCE_HEVY", pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_PENDING, 4*time.Hour)
+	svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+	ctx := context.Background()
+
+	res, err := svc.AdminListPipelineRuns(ctx, &pbsvc.AdminListPipelineRunsRequest{ErrorsOnly: true, Limit: 1})
+	if err != nil || len(res.Runs) != 1 || res.Runs[0].Id != "r1" || res.Runs[0].UserId != "user1" {
+		t.Fatalf("expected the newest failed run with its user, got %v (err %v)", res.GetRuns(), err)
+	}
+	if res.NextPageToken == "" {
+		t.Fatal("expected a next page token for a full page")
+	}
+	res, err = svc.AdminListPipelineRuns(ctx, &pbsvc.AdminListPipelineRunsRequest{ErrorsOnly: true, Limit: 1, PageToken: res.NextPageToken})
+	if err != nil || len(res.Runs) != 1 || res.Runs[0].Id != "r3" {
+		t.Fatalf("expected the partial run on the next page, got %v (err %v)", res.GetRuns(), err)
+	}
+
+	res, err = svc.AdminListPipelineRuns(ctx, &pbsvc.AdminListPipelineRunsRequest{Status: "pending"})
+	if err != nil || len(res.Runs) != 1 || res.Runs[0].Id != "r4" || res.NextPageToken != "" {
+		t.Errorf("expected the pending run by short status name, got %v (err %v)", res.GetRuns(), err)
+	}
+	res, err = svc.AdminListPipelineRuns(ctx, &pbsvc.AdminListPipelineRunsRequest{UserId: "user1", Source: "SOURCE_STRAVA"})
+	if err != nil || len(res.Runs) != 2 {
+		t.Errorf("expected user1's strava runs, got %v (err %v)", res.GetRuns(), err)
+	}
+
+	if _, err := svc.AdminListPipelineRuns(ctx, &pbsvc.AdminListPipelineRunsRequest{Status: "STUCK"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for an unknown status, got %v", err)
+	}
+}
+
+func TestAdminForceCompletePipelineRun(t *testing.T) {
+	store := NewMockStore()
+	inputID := "input1"
+	store.Runs["user1_r1"] = &pipeline.PipelineRun{Id: "r1", Status: pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_PENDING, PendingInputId: &inputID}
+	store.Runs["user1_r2"] = &pipeline.PipelineRun{Id: "r2", Status: pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED}
+	store.PendingInputs["user1_input1"] = &pipeline.PendingInput{ActivityId: "input1", Status: pipeline.PendingInput_STATUS_WAITING}
+	svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+	ctx := context.Background()
+	req := func(runID string, st pipeline.PipelineRunStatus) *pbsvc.AdminForceCompletePipelineRunRequest {
+		return &pbsvc.AdminForceCompletePipelineRunRequest{UserId: "user1", PipelineRunId: runID, Status: st, Reason: "stuck input", AdminUserId: "admin1"}
+	}
+
+	if _, err := svc.AdminForceCompletePipelineRun(ctx, req("r1", pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_PARTIAL)); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for a non-final status, got %v", err)
+	}
+	if _, err := svc.AdminForceCompletePipelineRun(ctx, req("r2", 0)); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition for a finished run, got %v", err)
+	}
+	if _, err := svc.AdminForceCompletePipelineRun(ctx, req("missing", 0)); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+
+	run, err := svc.AdminForceCompletePipelineRun(ctx, req("r1", 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if run.Status != pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED || store.Runs["user1_r1"].Status != pipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED {
+		t.Errorf("expected the run to be SYNCED, got %v", run.Status)
+	}
+	if run.GetStatusMessage() != "Completed by admin: stuck input" {
+		t.Errorf("unexpected status message %q", run.GetStatusMessage())
+	}
+	if run.CompletedAt == nil || store.Runs["user1_r1"].CompletedAt == nil {
+		t.Error("expected completed_at to be set")
+	}
+	if store.PendingInputs["user1_input1"].Status != pipeline.PendingInput_STATUS_COMPLETED {
+		t.Error("expected the run's pending input to be closed")
+	}
+
+	// A second force-complete sees the finished run inside the transaction
+	if _, err := svc.AdminForceCompletePipelineRun(ctx, req("r1", 0)); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition once the run is complete, got %v", err)
+	}
+}
+
+func TestPreviewPipeline(t *testing.T) {
+	store := NewMockStore()
+	store.Pipelines["user1_pipe1"] = &pipeline.PipelineConfig{Id: "pipe1", Source: "SOURCE_STRAVA"}
+	activity := &pbactivity.StandardizedActivity{Type: pbactivity.ActivityType_ACTIVITY_TYPE_RUN}
+
+	tests := []struct {
+		name       string
+		pipelineID string
+		activity   *pbactivity.StandardizedActivity
+		previewErr error
+		noPreview  bool
+		wantCode   codes.Code
+	}{
+		{name: "success", pipelineID: "pipe1", activity: activity, wantCode: codes.OK},
+		{name: "missing activity", pipelineID: "pipe1", wantCode: codes.InvalidArgument},
+		{name: "unknown pipeline", pipelineID: "nope", activity: activity, wantCode: codes.NotFound},
+		{name: "previews disabled", pipelineID: "pipe1", activity: activity, noPreview: true, wantCode: codes.Unimplemented},
+		{name: "invalid activity", pipelineID: "pipe1", activity: activity, previewErr: framework.NewTerminalError("activity has no start time"), wantCode: codes.InvalidArgument},
+		{name: "preview failure", pipelineID: "pipe1", activity: activity, previewErr: fmt.Errorf("boom"), wantCode: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+			if !tt.noPreview {
+				svc.SetPreviewer(func(ctx context.Context, userId, pipelineId string, a *pbactivity.StandardizedActivity) (*pipeline.PipelinePreview, error) {
+					if tt.previewErr != nil {
+						return nil, tt.previewErr
+					}
+					return &pipeline.PipelinePreview{Status: pipeline.ExecutionStatus_STATUS_SUCCESS, Description: "preview for " + pipelineId}, nil
+				})
+			}
+
+			res, err := svc.PreviewPipeline(context.Background(), &pbsvc.PreviewPipelineRequest{
+				UserId:     "user1",
+				PipelineId: tt.pipelineID,
+				Activity:   tt.activity,
+			})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("expected %v, got %v", tt.wantCode, err)
+			}
+			if err == nil && res.GetDescription() != "preview for pipe1" {
+				t.Errorf("unexpected preview: %v", res)
+			}
+		})
+	}
+}
+
+func TestPreviewDescriptionMerge(t *testing.T) {
+	stravaID := "987"
+	store := NewMockStore()
+	store.Runs["user1_run1"] = &pipeline.PipelineRun{
+		Id:               "run1",
+		ActivityId:       "act1",
+		Source:           "SOURCE_STRAVA",
+		SourceActivityId: "987",
+		Description:      "Morning run\n\n🌤️ Weather:\nSunny",
+		Destinations: []*pipeline.DestinationOutcome{
+			{Destination: plugin.DestinationType_DESTINATION_STRAVA, ExternalId: &stravaID},
+		},
+	}
+	store.Runs["user1_run2"] = &pipeline.PipelineRun{
+		Id:          "run2",
+		ActivityId:  "act2",
+		Source:      "SOURCE_HEVY",
+		Description: "🌤️ Weather:\nSunny",
+	}
+
+	tests := []struct {
+		name        string
+		activityID  string
+		destination plugin.DestinationType
+		current     string
+		fetchErr    error
+		noFetcher   bool
+		wantCode    codes.Code
+		wantMerged  string
+		wantConfirm bool
+	}{
+		{
+			name:        "same-source overwrite of user edits needs confirmation",
+			activityID:  "act1",
+			destination: plugin.DestinationType_DESTINATION_STRAVA,
+			current:     "Morning run\nLegs felt heavy\n\n🌤️ Weather:\nCloudy",
+			wantCode:    codes.OK,
+			wantMerged:  "Morning run\n\n🌤️ Weather:\nSunny",
+			wantConfirm: true,
+		},
+		{
+			name:        "same-source refresh without user edits",
+			activityID:  "act1",
+			destination: plugin.DestinationType_DESTINATION_STRAVA,
+			current:     "Morning run\n\n🌤️ Weather:\nCloudy",
+			wantCode:    codes.OK,
+			wantMerged:  "Morning run\n\n🌤️ Weather:\nSunny",
+		},
+		{name: "unsupported destination", activityID: "act1", destination: plugin.DestinationType_DESTINATION_SHOWCASE, wantCode: codes.InvalidArgument},
+		{name: "unknown activity", activityID: "nope", destination: plugin.DestinationType_DESTINATION_STRAVA, wantCode: codes.NotFound},
+		{name: "not posted to destination", activityID: "act2", destination: plugin.DestinationType_DESTINATION_STRAVA, wantCode: codes.FailedPrecondition},
+		{name: "previews disabled", activityID: "act1", destination: plugin.DestinationType_DESTINATION_STRAVA, noFetcher: true, wantCode: codes.Unimplemented},
+		{name: "fetch failure", activityID: "act1", destination: plugin.DestinationType_DESTINATION_STRAVA, fetchErr: fmt.Errorf("boom"), wantCode: codes.Unavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(store, &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+			if !tt.noFetcher {
+				svc.SetDescriptionFetcher(func(ctx context.Context, userId string, destination plugin.DestinationType, externalId string) (string, error) {
+					if externalId != stravaID {
+						t.Errorf("fetched external ID %q, want %q", externalId, stravaID)
+					}
+					return tt.current, tt.fetchErr
+				})
+			}
+
+			res, err := svc.PreviewDescriptionMerge(context.Background(), &pbsvc.PreviewDescriptionMergeRequest{
+				UserId:      "user1",
+				ActivityId:  tt.activityID,
+				Destination: tt.destination,
+			})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("expected %v, got %v", tt.wantCode, err)
+			}
+			if err != nil {
+				return
+			}
+			if res.MergedDescription != tt.wantMerged {
+				t.Errorf("merged = %q, want %q", res.MergedDescription, tt.wantMerged)
+			}
+			if res.RequiresConfirmation != tt.wantConfirm {
+				t.Errorf("requires_confirmation = %v, want %v (dropped %q)", res.RequiresConfirmation, tt.wantConfirm, res.DroppedUserLines)
+			}
+			if !res.Overwrite {
+				t.Error("expected a same-source preview to overwrite")
+			}
+		})
+	}
+}
+
+func TestChooseStarter(t *testing.T) {
+	tests := []struct {
+		name         string
+		integrations []string
+		wantSource   pbactivity.ActivitySource
+		wantDest     plugin.DestinationType
+		wantOK       bool
+	}{
+		{name: "hevy to strava", integrations: []string{"strava", "hevy"}, wantSource: pbactivity.ActivitySource_SOURCE_HEVY, wantDest: plugin.DestinationType_DESTINATION_STRAVA, wantOK: true},
+		{name: "strava is a source when it can't be the destination", integrations: []string{"strava", "intervals"}, wantSource: pbactivity.ActivitySource_SOURCE_STRAVA, wantDest: plugin.DestinationType_DESTINATION_INTERVALS, wantOK: true},
+		{name: "one integration", integrations: []string{"strava"}},
+		{name: "sources only", integrations: []string{"fitbit", "oura"}},
+		{name: "unknown integrations", integrations: []string{"spotify", "mock"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src, dest, ok := chooseStarter(tt.integrations)
+			if ok != tt.wantOK {
+				t.Fatalf("chooseStarter() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && (src.source != tt.wantSource || dest != tt.wantDest) {
+				t.Errorf("chooseStarter() = %v to %v, want %v to %v", src.source, dest, tt.wantSource, tt.wantDest)
+			}
+		})
+	}
+}
+
+func TestProvisionStarterPipeline(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("creates the pipeline and publishes a test activity", func(t *testing.T) {
+		store := NewMockStore()
+		pub := &MockPublisher{}
+		svc := NewService(store, pub, &MockBlobStore{}, mockLogger{})
+
+		res, err := svc.ProvisionStarterPipeline(ctx, &pbsvc.ProvisionStarterPipelineRequest{UserId: "user1", Integrations: []string{"hevy", "strava"}})
+		if err != nil {
+			t.Fatalf("ProvisionStarterPipeline() error = %v", err)
+		}
+		p := res.GetPipeline()
+		if p == nil || !p.AutoCreated {
+			t.Fatalf("Expected an auto-created pipeline, got %v", p)
+		}
+		if p.Source != "SOURCE_HEVY" || len(p.Destinations) != 1 || p.Destinations[0] != plugin.DestinationType_DESTINATION_STRAVA {
+			t.Errorf("Expected Hevy to Strava, got %s to %v", p.Source, p.Destinations)
+		}
+		if len(p.Enrichers) == 0 || p.Enrichers[0].ProviderType != plugin.EnricherProviderType_ENRICHER_PROVIDER_WORKOUT_SUMMARY {
+			t.Errorf("Expected the strength starter enrichers, got %v", p.Enrichers)
+		}
+
+		if len(pub.PublishedEvents) != 1 {
+			t.Fatalf("Expected one test activity, got %d events", len(pub.PublishedEvents))
+		}
+		var payload pbevents.ActivityPayload
+		if err := protojson.Unmarshal(pub.PublishedEvents[0].Data(), &payload); err != nil {
+			t.Fatalf("Failed to decode test activity: %v", err)
+		}
+		if !payload.IsTest || payload.GetPipelineId() != p.Id || payload.GetPipelineExecutionId() != res.TestRunId {
+			t.Errorf("Expected a test activity for %s tracked as %s, got %v", p.Id, res.TestRunId, &payload)
+		}
+		sessions := payload.GetStandardizedActivity().GetSessions()
+		if len(sessions) != 1 || sessions[0].TotalElapsedTime == 0 || len(sessions[0].StrengthSets) == 0 {
+			t.Errorf("Expected one strength session the enricher accepts, got %v", sessions)
+		}
+
+		// A second connection doesn't provision another
+		delete(store.Pipelines, "user1_"+p.Id)
+		res, err = svc.ProvisionStarterPipeline(ctx, &pbsvc.ProvisionStarterPipelineRequest{UserId: "user1", Integrations: []string{"hevy", "strava"}})
+		if err != nil || res.GetPipeline() != nil {
+			t.Errorf("Expected nothing provisioned twice, got %v (%v)", res.GetPipeline(), err)
+		}
+	})
+
+	t.Run("skips users with a pipeline", func(t *testing.T) {
+		store := NewMockStore()
+		store.Pipelines["user1_p1"] = &pipeline.PipelineConfig{Id: "p1"}
+		pub := &MockPublisher{}
+		svc := NewService(store, pub, &MockBlobStore{}, mockLogger{})
+
+		res, err := svc.ProvisionStarterPipeline(ctx, &pbsvc.ProvisionStarterPipelineRequest{UserId: "user1", Integrations: []string{"hevy", "strava"}})
+		if err != nil || res.GetPipeline() != nil || len(pub.PublishedEvents) != 0 {
+			t.Errorf("Expected nothing provisioned, got %v (%v)", res.GetPipeline(), err)
+		}
+		if _, claimed := store.StarterClaims["user1"]; claimed {
+			t.Error("Expected the starter pipeline to stay unclaimed")
+		}
+	})
+
+	t.Run("requires user_id", func(t *testing.T) {
+		svc := NewService(NewMockStore(), &MockPublisher{}, &MockBlobStore{}, mockLogger{})
+		_, err := svc.ProvisionStarterPipeline(ctx, &pbsvc.ProvisionStarterPipelineRequest{Integrations: []string{"hevy", "strava"}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+}
+
+func TestTestActivityCardio(t *testing.T) {
+	src, _, _ := chooseStarter([]string{"zwift", "strava"})
+	activity := testActivity("user1", src, "run1", time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC))
+	session := activity.Sessions[0]
+	if activity.Type != pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_RIDE || len(session.Laps) != 1 {
+		t.Fatalf("Expected a virtual ride with one lap, got %v with %d laps", activity.Type, len(session.Laps))
+	}
+	records := session.Laps[0].Records
+	if len(records) == 0 || records[0].Power == 0 || records[0].HeartRate == 0 {
+		t.Errorf("Expected records with power and heart rate, got %v", records[:1])
+	}
+	if last := records[len(records)-1]; last.Distance != session.TotalDistance {
+		t.Errorf("Expected the last record at the total distance %v, got %v", session.TotalDistance, last.Distance)
+	}
+}
@@ -0,0 +1,123 @@
+package splitter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/destination"
+	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
+	"github.com/fitglue/server/src/go/pkg/types/formatters"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// propagateSourceDeletion marks each run of an activity the user deleted on
+// its source platform DELETED_AT_SOURCE, and sends the destination service a
+// removal request for whatever the run uploaded.
+func (s *Splitter) propagateSourceDeletion(ctx context.Context, deletion *pbevents.ActivityPayload) error {
+	sourceActivityID := deletion.GetActivityId()
+	if sourceActivityID == "" {
+		s.logger.Warn(ctx, "Source deletion has no activity, dropping", "source", deletion.Source.String())
+		return nil
+	}
+
+	runs, err := s.store.ListPipelineRunsBySourceActivity(ctx, deletion.UserId, deletion.Source, sourceActivityID)
+	if err != nil {
+		return fmt.Errorf("list runs for source activity: %w", err)
+	}
+	if len(runs) == 0 {
+		s.logger.Info(ctx, "No runs for deleted source activity", "source", deletion.Source.String(), "sourceActivityId", sourceActivityID)
+		return nil
+	}
+
+	for _, run := range runs {
+		if run.Status == pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DELETED_AT_SOURCE {
+			continue
+		}
+		if err := s.store.UpdatePipelineRun(ctx, deletion.UserId, run.Id, map[string]interface{}{
+			"status":         int32(pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DELETED_AT_SOURCE),
+			"status_message": "Deleted on " + formatters.FormatActivitySource(deletion.Source),
+			"updated_at":     time.Now(),
+		}); err != nil {
+			return fmt.Errorf("mark run %s deleted at source: %w", run.Id, err)
+		}
+
+		removal := s.removalEvent(ctx, deletion, run)
+		if removal == nil {
+			continue
+		}
+		if err := s.publishRemoval(ctx, removal); err != nil {
+			s.logger.Error(ctx, "Failed to publish destination removal", "pipelineRunId", run.Id, "error", err)
+			continue
+		}
+		s.logger.Info(ctx, "Requested removal at destinations", "pipelineRunId", run.Id, "destinations", removal.Destinations)
+	}
+	return nil
+}
+
+// removalEvent builds the upload event asking for the run's uploads to be
+// taken down, or nil if the run uploaded nothing. It carries the pipeline's
+// destination config, as the enricher would, so destinations can find what
+// they uploaded (e.g. GitHub's repository).
+func (s *Splitter) removalEvent(ctx context.Context, deletion *pbevents.ActivityPayload, run *pbpipeline.PipelineRun) *pbevents.EnrichedActivityEvent {
+	if run.IsTest || run.ReplayOf != nil {
+		return nil
+	}
+	var destinations []pbplugin.DestinationType
+	for _, d := range run.Destinations {
+		if d.GetExternalId() != "" {
+			destinations = append(destinations, d.Destination)
+		}
+	}
+	if len(destinations) == 0 {
+		return nil
+	}
+
+	metadata := map[string]string{destination.RemoveMetadataKey: "true"}
+	cfg, err := s.store.GetPipeline(ctx, deletion.UserId, run.PipelineId)
+	if err != nil {
+		s.logger.Warn(ctx, "Failed to load pipeline for destination removal", "pipelineId", run.PipelineId, "error", err)
+	}
+	for destID, destCfg := range cfg.GetDestinationConfigs() {
+		destination.AddConfigMetadata(metadata, destID, destCfg.GetConfig())
+	}
+
+	runID := run.Id
+	return &pbevents.EnrichedActivityEvent{
+		ActivityId:          run.ActivityId,
+		UserId:              deletion.UserId,
+		PipelineId:          run.PipelineId,
+		Name:                run.Title,
+		Source:              deletion.Source,
+		EnrichmentMetadata:  metadata,
+		Destinations:        destinations,
+		PipelineExecutionId: &runID,
+	}
+}
+
+func (s *Splitter) publishRemoval(ctx context.Context, removal *pbevents.EnrichedActivityEvent) error {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(removal)
+	if err != nil {
+		return fmt.Errorf("marshal removal: %w", err)
+	}
+
+	ce, err := infrapubsub.NewCloudEvent(
+		infrapubsub.GetCloudEventSource(pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_PIPELINE_SPLITTER),
+		"com.fitglue.job.removal",
+		data,
+	)
+	if err != nil {
+		return fmt.Errorf("create cloud event: %w", err)
+	}
+	ce.SetExtension("pipeline_execution_id", removal.GetPipelineExecutionId())
+
+	if _, err := s.publisher.PublishCloudEvent(ctx, shared.TopicDestinationUpload, ce); err != nil {
+		return fmt.Errorf("publish: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package splitter
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/fitglue/server/src/go/pkg/domain/activity"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+// propagateSourceUpdate resumes each synced run of an activity the user edited
+// on its source platform, with the edited title, description and type, so the
+// run's other destinations are updated to match. Runs already matching the
+// edit are left alone, which also ignores the update event our own
+// same-source write triggers.
+func (s *Splitter) propagateSourceUpdate(ctx context.Context, update *pbevents.ActivityPayload) error {
+	edited := update.GetStandardizedActivity()
+	if edited.GetExternalId() == "" {
+		s.logger.Warn(ctx, "Source update has no activity, dropping", "source", update.Source.String())
+		return nil
+	}
+
+	runs, err := s.store.ListPipelineRunsBySourceActivity(ctx, update.UserId, update.Source, edited.ExternalId)
+	if err != nil {
+		return fmt.Errorf("list runs for source activity: %w", err)
+	}
+	if len(runs) == 0 {
+		s.logger.Info(ctx, "No runs for updated source activity", "source", update.Source.String(), "sourceActivityId", edited.ExternalId)
+		return nil
+	}
+
+	for _, run := range runs {
+		if reason := skipSourceUpdate(run, edited); reason != "" {
+			s.logger.Info(ctx, "Not propagating source update to run", "pipelineRunId", run.Id, "reason", reason)
+			continue
+		}
+
+		payload, err := s.sourceUpdatePayload(ctx, run, edited)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to build source update payload", "pipelineRunId", run.Id, "error", err)
+			continue
+		}
+		if err := s.publishToPipelineActivity(ctx, payload); err != nil {
+			s.logger.Error(ctx, "Failed to publish source update", "pipelineRunId", run.Id, "error", err)
+			continue
+		}
+		s.logger.Info(ctx, "Propagating source update", "pipelineId", run.PipelineId, "pipelineRunId", run.Id)
+	}
+	return nil
+}
+
+// skipSourceUpdate returns why the run shouldn't take the edit, or "" if it should
+func skipSourceUpdate(run *pbpipeline.PipelineRun, edited *pbactivity.StandardizedActivity) string {
+	switch {
+	case run.IsTest || run.ReplayOf != nil:
+		return "test or replay run"
+	case run.Status != pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED &&
+		run.Status != pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_PARTIAL:
+		return "run has not synced"
+	case run.OriginalPayloadUri == "":
+		return "no stored payload"
+	case run.Title == edited.Name && run.Description == edited.Description &&
+		(edited.Type == pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED || run.Type == edited.Type):
+		return "metadata unchanged"
+	}
+	for _, d := range run.Destinations {
+		if d.GetExternalId() != "" {
+			return ""
+		}
+	}
+	return "no uploaded destinations"
+}
+
+// sourceUpdatePayload rebuilds the run's stored payload with the edited
+// metadata, as a resume of the run in update mode.
+func (s *Splitter) sourceUpdatePayload(ctx context.Context, run *pbpipeline.PipelineRun, edited *pbactivity.StandardizedActivity) (*pbevents.ActivityPayload, error) {
+	data, err := s.blobStore.Get(ctx, run.OriginalPayloadUri)
+	if err != nil {
+		return nil, fmt.Errorf("read stored payload: %w", err)
+	}
+
+	var payload pbevents.ActivityPayload
+	unmarshalOpts := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err := unmarshalOpts.Unmarshal(activity.SanitizeActivityPayloadJSON(data), &payload); err != nil {
+		return nil, fmt.Errorf("protojson unmarshal: %w", err)
+	}
+	act := payload.GetStandardizedActivity()
+	if act == nil {
+		return nil, fmt.Errorf("stored payload has no activity")
+	}
+
+	act.Name = edited.Name
+	act.Description = edited.Description
+	if edited.Type != pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED {
+		act.Type = edited.Type
+	}
+
+	payload.PipelineId = &run.PipelineId
+	payload.PipelineExecutionId = &run.Id
+	payload.ActivityId = &run.ActivityId
+	payload.IsResume = true
+	payload.UseUpdateMethod = true
+	payload.IsSourceUpdate = true
+	payload.ResumeOnlyEnrichers = nil
+	payload.ResumePendingInputId = nil
+	payload.RetryAttempts = nil
+	if run.PipelineConfigVersion > 0 {
+		payload.PipelineConfigVersion = run.PipelineConfigVersion
+	}
+	return &payload, nil
+}
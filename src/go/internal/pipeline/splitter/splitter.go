@@ -3,10 +3,12 @@ package splitter
 import (
 	"context"
 	"fmt"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/fitglue/server/src/go/internal/infra"
 	"github.com/fitglue/server/src/go/internal/pipeline"
@@ -20,16 +22,20 @@ import (
 )
 
 type Splitter struct {
-	store     pipeline.PipelineStore
-	publisher pipeline.Publisher
-	logger    infra.Logger
+	store      pipeline.PipelineStore
+	publisher  pipeline.Publisher
+	blobStore  pipeline.BlobStore
+	bucketName string
+	logger     infra.Logger
 }
 
-func NewSplitter(store pipeline.PipelineStore, publisher pipeline.Publisher, logger infra.Logger) *Splitter {
+func NewSplitter(store pipeline.PipelineStore, publisher pipeline.Publisher, blobStore pipeline.BlobStore, bucketName string, logger infra.Logger) *Splitter {
 	return &Splitter{
-		store:     store,
-		publisher: publisher,
-		logger:    logger,
+		store:      store,
+		publisher:  publisher,
+		blobStore:  blobStore,
+		bucketName: bucketName,
+		logger:     logger,
 	}
 }
 
@@ -53,6 +59,14 @@ func (s *Splitter) SplitByPipeline(ctx context.Context, e cloudevents.Event) err
 		return s.passThrough(ctx, &payload)
 	}
 
+	// Edits made on the source platform update the activity's existing runs
+	if payload.IsSourceUpdate {
+		return s.propagateSourceUpdate(ctx, &payload)
+	}
+	if payload.IsSourceDeletion {
+		return s.propagateSourceDeletion(ctx, &payload)
+	}
+
 	// Resolve matching pipelines for this source
 	pipelines, err := s.resolvePipelinesForSource(ctx, payload.UserId, payload.Source)
 	if err != nil {
@@ -74,8 +88,15 @@ func (s *Splitter) SplitByPipeline(ctx context.Context, e cloudevents.Event) err
 		basePipelineExecId = "exec-unknown" // It should realistically come from the webhook
 	}
 
+	// Vacation mode pauses every pipeline; each pipeline can also be paused on its own
+	userPausedUntil, err := s.store.GetPipelinesPausedUntil(ctx, payload.UserId)
+	if err != nil {
+		return fmt.Errorf("get pipelines paused until: %w", err)
+	}
+
 	s.logger.Info(ctx, "Fanning out to pipelines", "count", len(pipelines), "source", payload.Source.String())
 
+	now := time.Now()
 	publishedCount := 0
 	for _, p := range pipelines {
 		pipelineId := p.Id
@@ -85,6 +106,20 @@ func (s *Splitter) SplitByPipeline(ctx context.Context, e cloudevents.Event) err
 		clonedPayload := proto.Clone(&payload).(*pbevents.ActivityPayload)
 		clonedPayload.PipelineId = &pipelineId
 		clonedPayload.PipelineExecutionId = &pipelineExecId
+		if len(pipelines) > 1 {
+			// Lets pipelines reuse each other's shareable enricher results
+			clonedPayload.FanOutId = &basePipelineExecId
+		}
+
+		// Paused pipelines park the activity until the user releases or discards it
+		if until := pausedUntil(now, userPausedUntil, p.GetPausedUntil()); !until.IsZero() {
+			if err := s.deferActivity(ctx, clonedPayload, until); err != nil {
+				s.logger.Error(ctx, "Failed to defer pipeline message", "pipelineId", pipelineId, "error", err)
+				continue
+			}
+			s.logger.Info(ctx, "Deferred pipeline message while paused", "pipelineId", pipelineId, "pipelineExecId", pipelineExecId, "pausedUntil", until)
+			continue
+		}
 
 		// Publish to pipeline-activity topic
 		if err := s.publishToPipelineActivity(ctx, clonedPayload); err != nil {
@@ -107,6 +142,63 @@ func (s *Splitter) passThrough(ctx context.Context, payload *pbevents.ActivityPa
 	return nil
 }
 
+// pausedUntil returns the later of the user and pipeline pauses that are
+// still in effect, or the zero time when neither is.
+func pausedUntil(now, userPausedUntil time.Time, pipelinePausedUntil *timestamppb.Timestamp) time.Time {
+	var until time.Time
+	if userPausedUntil.After(now) {
+		until = userPausedUntil
+	}
+	if pipelinePausedUntil != nil {
+		if t := pipelinePausedUntil.AsTime(); t.After(now) && t.After(until) {
+			until = t
+		}
+	}
+	return until
+}
+
+// deferActivity stores the per-pipeline payload in GCS and records a DEFERRED
+// run pointing at it, so ResumePipelines can later publish it unchanged.
+func (s *Splitter) deferActivity(ctx context.Context, payload *pbevents.ActivityPayload, until time.Time) error {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+
+	gcsPath := fmt.Sprintf("deferred/%s/%s.json", payload.UserId, payload.GetPipelineExecutionId())
+	if err := s.blobStore.Write(ctx, s.bucketName, gcsPath, data); err != nil {
+		return fmt.Errorf("write deferred payload: %w", err)
+	}
+
+	activity := payload.GetStandardizedActivity()
+	var startTime *timestamppb.Timestamp
+	if sessions := activity.GetSessions(); len(sessions) > 0 {
+		startTime = sessions[0].GetStartTime()
+	}
+	statusMessage := fmt.Sprintf("Paused until %s", until.UTC().Format(time.RFC3339))
+
+	run := &pbpipeline.PipelineRun{
+		Id:                 payload.GetPipelineExecutionId(),
+		PipelineId:         payload.GetPipelineId(),
+		ActivityId:         payload.GetActivityId(),
+		Source:             payload.Source.String(),
+		SourceActivityId:   activity.GetExternalId(),
+		Title:              activity.GetName(),
+		Description:        activity.GetDescription(),
+		Type:               activity.GetType(),
+		StartTime:          startTime,
+		Status:             pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DEFERRED,
+		StatusMessage:      &statusMessage,
+		OriginalPayloadUri: fmt.Sprintf("gs://%s/%s", s.bucketName, gcsPath),
+		CreatedAt:          timestamppb.Now(),
+		UpdatedAt:          timestamppb.Now(),
+	}
+	if err := s.store.CreatePipelineRun(ctx, payload.UserId, run); err != nil {
+		return fmt.Errorf("create deferred run: %w", err)
+	}
+	return nil
+}
+
 // resolvePipelinesForSource finds all pipelines matching the given source
 func (s *Splitter) resolvePipelinesForSource(ctx context.Context, userId string, source pbactivity.ActivitySource) ([]*pbpipeline.PipelineConfig, error) {
 	userPipelines, err := s.store.ListPipelines(ctx, userId)
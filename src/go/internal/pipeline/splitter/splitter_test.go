@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/event"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/fitglue/server/src/go/internal/infra"
 	"github.com/fitglue/server/src/go/internal/pipeline"
@@ -15,6 +17,7 @@ import (
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 )
 
 // =============================================================
@@ -22,8 +25,12 @@ import (
 // =============================================================
 
 type mockSplitterStore struct {
-	pipelines []*pbpipeline.PipelineConfig
-	err       error
+	pipelines   []*pbpipeline.PipelineConfig
+	err         error
+	pausedUntil time.Time
+	createdRuns []*pbpipeline.PipelineRun
+	runs        []*pbpipeline.PipelineRun
+	runUpdates  map[string]map[string]interface{}
 }
 
 func (m *mockSplitterStore) ListPipelines(_ context.Context, _ string) ([]*pbpipeline.PipelineConfig, error) {
@@ -32,7 +39,15 @@ func (m *mockSplitterStore) ListPipelines(_ context.Context, _ string) ([]*pbpip
 	}
 	return m.pipelines, nil
 }
-func (m *mockSplitterStore) GetPipeline(_ context.Context, _, _ string) (*pbpipeline.PipelineConfig, error) {
+func (m *mockSplitterStore) GetPipeline(_ context.Context, _, pipelineID string) (*pbpipeline.PipelineConfig, error) {
+	for _, p := range m.pipelines {
+		if p.Id == pipelineID {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+func (m *mockSplitterStore) GetPipelineVersion(_ context.Context, _, _ string, _ int32) (*pbpipeline.PipelineConfigVersion, error) {
 	return nil, nil
 }
 func (m *mockSplitterStore) CreatePipeline(_ context.Context, _ string, cfg *pbpipeline.PipelineConfig) (*pbpipeline.PipelineConfig, error) {
@@ -57,12 +72,99 @@ func (m *mockSplitterStore) GetPipelineRun(_ context.Context, _, _ string) (*pbp
 func (m *mockSplitterStore) ListPipelineRuns(_ context.Context, _, _ string, _ int32, _ string) ([]*pbpipeline.PipelineRun, string, error) {
 	return nil, "", nil
 }
-func (m *mockSplitterStore) UpdatePipelineRun(_ context.Context, _, _ string, _ map[string]interface{}) error {
+func (m *mockSplitterStore) UpdatePipelineRun(_ context.Context, _, runID string, updateData map[string]interface{}) error {
+	if m.runUpdates == nil {
+		m.runUpdates = make(map[string]map[string]interface{})
+	}
+	m.runUpdates[runID] = updateData
+	return nil
+}
+func (m *mockSplitterStore) TransitionPipelineRun(_ context.Context, _, _ string, _ func(*pbpipeline.PipelineRun) (map[string]interface{}, error)) error {
+	return nil
+}
+func (m *mockSplitterStore) ListExecutionsForRun(_ context.Context, _, _ string) ([]*pbpipeline.ExecutionRecord, error) {
+	return nil, nil
+}
+func (m *mockSplitterStore) GetEnricherRecommendations(_ context.Context, _ string) (*pbpipeline.EnricherRecommendations, error) {
+	return nil, nil
+}
+func (m *mockSplitterStore) SetEnricherRecommendations(_ context.Context, _ string, _ *pbpipeline.EnricherRecommendations) error {
+	return nil
+}
+func (m *mockSplitterStore) ListPipelineDailyStats(_ context.Context, _, _, _ string) ([]*pbpipeline.PipelineDailyStats, error) {
+	return nil, nil
+}
+func (m *mockSplitterStore) ListActivityTypeRules(_ context.Context, _ string) ([]*pbpipeline.ActivityTypeRule, error) {
+	return nil, nil
+}
+func (m *mockSplitterStore) GetActivityTypeRule(_ context.Context, _, _ string) (*pbpipeline.ActivityTypeRule, error) {
+	return nil, nil
+}
+func (m *mockSplitterStore) SetActivityTypeRule(_ context.Context, _ string, _ *pbpipeline.ActivityTypeRule) error {
+	return nil
+}
+func (m *mockSplitterStore) DeleteActivityTypeRule(_ context.Context, _, _ string) error {
+	return nil
+}
+func (m *mockSplitterStore) ListActiveUserIDs(_ context.Context, _ time.Time) ([]string, error) {
+	return nil, nil
+}
+func (m *mockSplitterStore) ListProviderCircuits(_ context.Context) ([]*pbpipeline.ProviderCircuit, error) {
+	return nil, nil
+}
+func (m *mockSplitterStore) SetProviderCircuitMode(_ context.Context, _ pbplugin.EnricherProviderType, _ pbpipeline.ProviderCircuitMode, _, _ string) (*pbpipeline.ProviderCircuit, error) {
+	return nil, nil
+}
+func (m *mockSplitterStore) ListProviderCircuitChanges(_ context.Context, _ pbplugin.EnricherProviderType, _ int) ([]*pbpipeline.ProviderCircuitChange, error) {
+	return nil, nil
+}
+func (m *mockSplitterStore) GetFailedEvent(_ context.Context, _, _ string) (*pbpipeline.FailedEvent, error) {
+	return nil, nil
+}
+func (m *mockSplitterStore) SetFailedEvent(_ context.Context, _ string, _ *pbpipeline.FailedEvent) error {
 	return nil
 }
+func (m *mockSplitterStore) ListFailedEvents(_ context.Context, _ string, _ pbpipeline.FailedEventStatus, _ int) ([]*pbpipeline.FailedEvent, error) {
+	return nil, nil
+}
+func (m *mockSplitterStore) ListDueFailedEvents(_ context.Context, _ time.Time, _ int) ([]*pbpipeline.FailedEvent, error) {
+	return nil, nil
+}
 func (m *mockSplitterStore) FindPipelineRunByActivityId(_ context.Context, _, _ string) (*pbpipeline.PipelineRun, error) {
 	return nil, nil
 }
+func (m *mockSplitterStore) ListPipelineRunsBySourceActivity(_ context.Context, _ string, source pbactivity.ActivitySource, sourceActivityID string) ([]*pbpipeline.PipelineRun, error) {
+	var runs []*pbpipeline.PipelineRun
+	for _, r := range m.runs {
+		if r.Source == source.String() && r.SourceActivityId == sourceActivityID {
+			runs = append(runs, r)
+		}
+	}
+	return runs, nil
+}
+func (m *mockSplitterStore) CreatePipelineRun(_ context.Context, _ string, run *pbpipeline.PipelineRun) error {
+	m.createdRuns = append(m.createdRuns, run)
+	return nil
+}
+func (m *mockSplitterStore) ListRecentPipelineRuns(_ context.Context, _ pipeline.RunFilter, _ time.Time, _ int) ([]*pbpipeline.PipelineRun, error) {
+	return nil, nil
+}
+func (m *mockSplitterStore) ListDueRetries(_ context.Context, _ time.Time, _ int) ([]*pipeline.DueRetry, error) {
+	return nil, nil
+}
+func (m *mockSplitterStore) ListDeferredRuns(_ context.Context, _, _ string) ([]*pbpipeline.PipelineRun, error) {
+	return nil, nil
+}
+func (m *mockSplitterStore) GetPipelinesPausedUntil(_ context.Context, _ string) (time.Time, error) {
+	return m.pausedUntil, nil
+}
+func (m *mockSplitterStore) SetPipelinesPausedUntil(_ context.Context, _ string, _ time.Time) error {
+	return nil
+}
+
+func (m *mockSplitterStore) ClaimStarterPipeline(_ context.Context, _ string, _ time.Time) (bool, error) {
+	return false, nil
+}
 
 var _ pipeline.PipelineStore = (*mockSplitterStore)(nil)
 
@@ -81,6 +183,24 @@ func (m *mockSplitterPublisher) PublishCloudEvent(_ context.Context, _ string, e
 
 var _ pipeline.Publisher = (*mockSplitterPublisher)(nil)
 
+type mockSplitterBlobStore struct {
+	written map[string][]byte
+	blobs   map[string][]byte
+}
+
+func (m *mockSplitterBlobStore) Get(_ context.Context, uri string) ([]byte, error) {
+	return m.blobs[uri], nil
+}
+func (m *mockSplitterBlobStore) Write(_ context.Context, _, path string, data []byte) error {
+	if m.written == nil {
+		m.written = make(map[string][]byte)
+	}
+	m.written[path] = data
+	return nil
+}
+
+var _ pipeline.BlobStore = (*mockSplitterBlobStore)(nil)
+
 // mockLogger implements infra.Logger with all required methods
 type mockLogger struct{}
 
@@ -112,7 +232,7 @@ func makeEvent(payload *pbevents.ActivityPayload) cloudevents.Event {
 func TestSplitByPipeline_NoPipelines(t *testing.T) {
 	store := &mockSplitterStore{pipelines: []*pbpipeline.PipelineConfig{}}
 	pub := &mockSplitterPublisher{}
-	s := splitter.NewSplitter(store, pub, &mockLogger{})
+	s := splitter.NewSplitter(store, pub, &mockSplitterBlobStore{}, "my-bucket", &mockLogger{})
 
 	execID := "exec-123"
 	payload := &pbevents.ActivityPayload{
@@ -137,7 +257,7 @@ func TestSplitByPipeline_WithMatchingPipeline(t *testing.T) {
 		},
 	}
 	pub := &mockSplitterPublisher{}
-	s := splitter.NewSplitter(store, pub, &mockLogger{})
+	s := splitter.NewSplitter(store, pub, &mockSplitterBlobStore{}, "my-bucket", &mockLogger{})
 
 	execID := "exec-123"
 	payload := &pbevents.ActivityPayload{
@@ -151,7 +271,14 @@ func TestSplitByPipeline_WithMatchingPipeline(t *testing.T) {
 		t.Errorf("expected no error, got %v", err)
 	}
 	if len(pub.published) != 1 {
-		t.Errorf("expected 1 published event, got %d", len(pub.published))
+		t.Fatalf("expected 1 published event, got %d", len(pub.published))
+	}
+	var out pbevents.ActivityPayload
+	if err := protojson.Unmarshal(pub.published[0].Data(), &out); err != nil {
+		t.Fatalf("unmarshal published payload: %v", err)
+	}
+	if out.FanOutId != nil {
+		t.Errorf("expected no fan-out ID for a single pipeline, got %q", out.GetFanOutId())
 	}
 }
 
@@ -162,7 +289,7 @@ func TestSplitByPipeline_DisabledPipeline(t *testing.T) {
 		},
 	}
 	pub := &mockSplitterPublisher{}
-	s := splitter.NewSplitter(store, pub, &mockLogger{})
+	s := splitter.NewSplitter(store, pub, &mockSplitterBlobStore{}, "my-bucket", &mockLogger{})
 
 	execID := "exec-123"
 	payload := &pbevents.ActivityPayload{
@@ -183,7 +310,7 @@ func TestSplitByPipeline_DisabledPipeline(t *testing.T) {
 func TestSplitByPipeline_PipelineIdAlreadySet_PassThrough(t *testing.T) {
 	store := &mockSplitterStore{pipelines: []*pbpipeline.PipelineConfig{}}
 	pub := &mockSplitterPublisher{}
-	s := splitter.NewSplitter(store, pub, &mockLogger{})
+	s := splitter.NewSplitter(store, pub, &mockSplitterBlobStore{}, "my-bucket", &mockLogger{})
 
 	execID := "exec-123"
 	pipelineID := "existing-pipe"
@@ -210,7 +337,7 @@ func TestSplitByPipeline_MissingExecID(t *testing.T) {
 		},
 	}
 	pub := &mockSplitterPublisher{}
-	s := splitter.NewSplitter(store, pub, &mockLogger{})
+	s := splitter.NewSplitter(store, pub, &mockSplitterBlobStore{}, "my-bucket", &mockLogger{})
 
 	payload := &pbevents.ActivityPayload{
 		UserId: "user1",
@@ -227,7 +354,7 @@ func TestSplitByPipeline_MissingExecID(t *testing.T) {
 func TestSplitByPipeline_StoreError(t *testing.T) {
 	store := &mockSplitterStore{err: context.DeadlineExceeded}
 	pub := &mockSplitterPublisher{}
-	s := splitter.NewSplitter(store, pub, &mockLogger{})
+	s := splitter.NewSplitter(store, pub, &mockSplitterBlobStore{}, "my-bucket", &mockLogger{})
 
 	execID := "exec-123"
 	payload := &pbevents.ActivityPayload{
@@ -250,7 +377,7 @@ func TestSplitByPipeline_PublisherError_Continue(t *testing.T) {
 		},
 	}
 	pub := &mockSplitterPublisher{err: context.DeadlineExceeded}
-	s := splitter.NewSplitter(store, pub, &mockLogger{})
+	s := splitter.NewSplitter(store, pub, &mockSplitterBlobStore{}, "my-bucket", &mockLogger{})
 
 	execID := "exec-123"
 	payload := &pbevents.ActivityPayload{
@@ -269,7 +396,7 @@ func TestSplitByPipeline_PublisherError_Continue(t *testing.T) {
 func TestSplitByPipeline_InvalidEventData(t *testing.T) {
 	store := &mockSplitterStore{}
 	pub := &mockSplitterPublisher{}
-	s := splitter.NewSplitter(store, pub, &mockLogger{})
+	s := splitter.NewSplitter(store, pub, &mockSplitterBlobStore{}, "my-bucket", &mockLogger{})
 
 	e := cloudevents.NewEvent()
 	e.SetType("com.fitglue.activity.raw")
@@ -291,7 +418,7 @@ func TestSplitByPipeline_MultiplePipelines(t *testing.T) {
 		},
 	}
 	pub := &mockSplitterPublisher{}
-	s := splitter.NewSplitter(store, pub, &mockLogger{})
+	s := splitter.NewSplitter(store, pub, &mockSplitterBlobStore{}, "my-bucket", &mockLogger{})
 
 	execID := "exec-123"
 	payload := &pbevents.ActivityPayload{
@@ -305,7 +432,16 @@ func TestSplitByPipeline_MultiplePipelines(t *testing.T) {
 		t.Errorf("expected no error, got %v", err)
 	}
 	if len(pub.published) != 2 {
-		t.Errorf("expected 2 published events (matching source only), got %d", len(pub.published))
+		t.Fatalf("expected 2 published events (matching source only), got %d", len(pub.published))
+	}
+	for _, e := range pub.published {
+		var out pbevents.ActivityPayload
+		if err := protojson.Unmarshal(e.Data(), &out); err != nil {
+			t.Fatalf("unmarshal published payload: %v", err)
+		}
+		if out.GetFanOutId() != execID {
+			t.Errorf("expected fan-out ID %q shared by every pipeline, got %q", execID, out.GetFanOutId())
+		}
 	}
 }
 
@@ -317,7 +453,7 @@ func TestSplitByPipeline_ShortFormatSourceMatching(t *testing.T) {
 		},
 	}
 	pub := &mockSplitterPublisher{}
-	s := splitter.NewSplitter(store, pub, &mockLogger{})
+	s := splitter.NewSplitter(store, pub, &mockSplitterBlobStore{}, "my-bucket", &mockLogger{})
 
 	execID := "exec-456"
 	payload := &pbevents.ActivityPayload{
@@ -334,3 +470,224 @@ func TestSplitByPipeline_ShortFormatSourceMatching(t *testing.T) {
 		t.Errorf("expected 1 published event for short-format source, got %d", len(pub.published))
 	}
 }
+
+func TestSplitByPipeline_PausedPipelineDefers(t *testing.T) {
+	future := time.Now().Add(24 * time.Hour)
+	store := &mockSplitterStore{
+		pipelines: []*pbpipeline.PipelineConfig{
+			{Id: "pipe1", Name: "Paused Pipe", Source: "SOURCE_HEVY", PausedUntil: timestamppb.New(future)},
+			{Id: "pipe2", Name: "Active Pipe", Source: "SOURCE_HEVY", PausedUntil: timestamppb.New(time.Now().Add(-time.Hour))},
+		},
+	}
+	pub := &mockSplitterPublisher{}
+	blobs := &mockSplitterBlobStore{}
+	s := splitter.NewSplitter(store, pub, blobs, "my-bucket", &mockLogger{})
+
+	execID := "exec-123"
+	payload := &pbevents.ActivityPayload{
+		UserId:              "user1",
+		Source:              pbactivity.ActivitySource_SOURCE_HEVY,
+		PipelineExecutionId: &execID,
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			Name: "Morning Lift",
+		},
+	}
+
+	if err := s.SplitByPipeline(context.Background(), makeEvent(payload)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(pub.published) != 1 {
+		t.Errorf("expected only the unpaused pipeline to be published, got %d", len(pub.published))
+	}
+	if len(store.createdRuns) != 1 {
+		t.Fatalf("expected 1 deferred run, got %d", len(store.createdRuns))
+	}
+	run := store.createdRuns[0]
+	if run.Id != "exec-123-pipe1" || run.Status != pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DEFERRED || run.Title != "Morning Lift" {
+		t.Errorf("unexpected deferred run: %v", run)
+	}
+	if run.OriginalPayloadUri != "gs://my-bucket/deferred/user1/exec-123-pipe1.json" {
+		t.Errorf("unexpected payload URI %q", run.OriginalPayloadUri)
+	}
+	if _, ok := blobs.written["deferred/user1/exec-123-pipe1.json"]; !ok {
+		t.Error("expected deferred payload to be written")
+	}
+}
+
+func TestSplitByPipeline_VacationModeDefersAll(t *testing.T) {
+	store := &mockSplitterStore{
+		pipelines: []*pbpipeline.PipelineConfig{
+			{Id: "pipe1", Source: "SOURCE_HEVY"},
+			{Id: "pipe2", Source: "SOURCE_HEVY"},
+		},
+		pausedUntil: time.Now().Add(time.Hour),
+	}
+	pub := &mockSplitterPublisher{}
+	s := splitter.NewSplitter(store, pub, &mockSplitterBlobStore{}, "my-bucket", &mockLogger{})
+
+	execID := "exec-123"
+	payload := &pbevents.ActivityPayload{
+		UserId:              "user1",
+		Source:              pbactivity.ActivitySource_SOURCE_HEVY,
+		PipelineExecutionId: &execID,
+	}
+
+	if err := s.SplitByPipeline(context.Background(), makeEvent(payload)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(pub.published) != 0 {
+		t.Errorf("expected nothing published during vacation mode, got %d", len(pub.published))
+	}
+	if len(store.createdRuns) != 2 {
+		t.Errorf("expected 2 deferred runs, got %d", len(store.createdRuns))
+	}
+}
+
+func TestSplitByPipeline_SourceUpdateResumesSyncedRuns(t *testing.T) {
+	stored, _ := protojson.Marshal(&pbevents.ActivityPayload{
+		UserId: "user1",
+		Source: pbactivity.ActivitySource_SOURCE_STRAVA,
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			ExternalId:  "123",
+			Name:        "Morning Run",
+			Description: "Easy",
+			Type:        pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		},
+	})
+	hevyID := "hevy-1"
+	synced := func(id, title string, status pbpipeline.PipelineRunStatus) *pbpipeline.PipelineRun {
+		return &pbpipeline.PipelineRun{
+			Id:                 id,
+			PipelineId:         "pipe-" + id,
+			ActivityId:         "act-" + id,
+			Source:             "SOURCE_STRAVA",
+			SourceActivityId:   "123",
+			Title:              title,
+			Description:        "Easy",
+			Type:               pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+			Status:             status,
+			OriginalPayloadUri: "gs://my-bucket/payloads/" + id + ".json",
+			Destinations: []*pbpipeline.DestinationOutcome{
+				{Destination: pbplugin.DestinationType_DESTINATION_HEVY, ExternalId: &hevyID},
+			},
+			PipelineConfigVersion: 3,
+		}
+	}
+	store := &mockSplitterStore{runs: []*pbpipeline.PipelineRun{
+		synced("run1", "Morning Run", pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED),
+		synced("run2", "Trail Run", pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED), // already matches
+		synced("run3", "Morning Run", pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING),
+	}}
+	blobs := &mockSplitterBlobStore{blobs: map[string][]byte{
+		"gs://my-bucket/payloads/run1.json": stored,
+		"gs://my-bucket/payloads/run2.json": stored,
+		"gs://my-bucket/payloads/run3.json": stored,
+	}}
+	pub := &mockSplitterPublisher{}
+	s := splitter.NewSplitter(store, pub, blobs, "my-bucket", &mockLogger{})
+
+	activityID := "123"
+	update := &pbevents.ActivityPayload{
+		UserId:         "user1",
+		Source:         pbactivity.ActivitySource_SOURCE_STRAVA,
+		ActivityId:     &activityID,
+		IsSourceUpdate: true,
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			ExternalId:  "123",
+			Name:        "Trail Run",
+			Description: "Easy",
+			Type:        pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		},
+	}
+
+	if err := s.SplitByPipeline(context.Background(), makeEvent(update)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(pub.published) != 1 {
+		t.Fatalf("expected only the synced, out of date run to be resumed, got %d", len(pub.published))
+	}
+
+	var out pbevents.ActivityPayload
+	if err := protojson.Unmarshal(pub.published[0].Data(), &out); err != nil {
+		t.Fatalf("unmarshal published payload: %v", err)
+	}
+	if out.GetPipelineId() != "pipe-run1" || out.GetPipelineExecutionId() != "run1" || out.GetActivityId() != "act-run1" {
+		t.Errorf("expected a resume of run1, got %v", &out)
+	}
+	if !out.IsResume || !out.UseUpdateMethod || !out.IsSourceUpdate || out.PipelineConfigVersion != 3 {
+		t.Errorf("expected a source update resume in update mode, got %v", &out)
+	}
+	if out.StandardizedActivity.GetName() != "Trail Run" {
+		t.Errorf("expected the edited title, got %q", out.StandardizedActivity.GetName())
+	}
+	if len(store.createdRuns) != 0 {
+		t.Errorf("expected no new runs, got %d", len(store.createdRuns))
+	}
+}
+
+func TestSplitByPipeline_SourceDeletionMarksRunsAndRequestsRemoval(t *testing.T) {
+	ghPath := "workouts/2026-03-01-leg-day.md"
+	run := func(id string, status pbpipeline.PipelineRunStatus, externalID *string) *pbpipeline.PipelineRun {
+		return &pbpipeline.PipelineRun{
+			Id:               id,
+			PipelineId:       "pipe1",
+			ActivityId:       "act-" + id,
+			Source:           "SOURCE_HEVY",
+			SourceActivityId: "w1",
+			Title:            "Leg Day",
+			Status:           status,
+			Destinations: []*pbpipeline.DestinationOutcome{
+				{Destination: pbplugin.DestinationType_DESTINATION_GITHUB, ExternalId: externalID},
+			},
+		}
+	}
+	store := &mockSplitterStore{
+		pipelines: []*pbpipeline.PipelineConfig{{
+			Id: "pipe1",
+			DestinationConfigs: map[string]*pbpipeline.DestinationConfig{
+				"github": {Config: map[string]string{"repo": "jo/workouts"}},
+			},
+		}},
+		runs: []*pbpipeline.PipelineRun{
+			run("run1", pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED, &ghPath),
+			run("run2", pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_FAILED, nil),                // uploaded nothing
+			run("run3", pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DELETED_AT_SOURCE, &ghPath), // already handled
+		},
+	}
+	pub := &mockSplitterPublisher{}
+	s := splitter.NewSplitter(store, pub, &mockSplitterBlobStore{}, "my-bucket", &mockLogger{})
+
+	workoutID := "w1"
+	deletion := &pbevents.ActivityPayload{
+		UserId:           "user1",
+		Source:           pbactivity.ActivitySource_SOURCE_HEVY,
+		ActivityId:       &workoutID,
+		IsSourceDeletion: true,
+	}
+	if err := s.SplitByPipeline(context.Background(), makeEvent(deletion)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(store.runUpdates) != 2 {
+		t.Fatalf("expected run1 and run2 to be marked, got %v", store.runUpdates)
+	}
+	for _, id := range []string{"run1", "run2"} {
+		if got := store.runUpdates[id]["status"]; got != int32(pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DELETED_AT_SOURCE) {
+			t.Errorf("expected %s to be DELETED_AT_SOURCE, got %v", id, got)
+		}
+	}
+
+	if len(pub.published) != 1 {
+		t.Fatalf("expected one removal request, got %d", len(pub.published))
+	}
+	var removal pbevents.EnrichedActivityEvent
+	if err := protojson.Unmarshal(pub.published[0].Data(), &removal); err != nil {
+		t.Fatalf("unmarshal removal: %v", err)
+	}
+	if removal.GetPipelineExecutionId() != "run1" || len(removal.Destinations) != 1 || removal.Destinations[0] != pbplugin.DestinationType_DESTINATION_GITHUB {
+		t.Errorf("expected a GitHub removal for run1, got %v", &removal)
+	}
+	if removal.EnrichmentMetadata["remove_at_destination"] != "true" || removal.EnrichmentMetadata["github_repo"] != "jo/workouts" {
+		t.Errorf("expected the removal flag and destination config, got %v", removal.EnrichmentMetadata)
+	}
+}
@@ -2,8 +2,11 @@ package pipeline
 
 import (
 	"context"
+	"time"
 
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 )
 
 // PipelineStore defines the data access contract for pipeline configurations, runs, and pending inputs.
@@ -11,6 +14,9 @@ type PipelineStore interface {
 	// Pipeline Configurations
 	ListPipelines(ctx context.Context, userID string) ([]*pipeline.PipelineConfig, error)
 	GetPipeline(ctx context.Context, userID, pipelineID string) (*pipeline.PipelineConfig, error)
+	// GetPipelineVersion returns a config snapshot saved with the pipeline,
+	// or nil if the version was never recorded.
+	GetPipelineVersion(ctx context.Context, userID, pipelineID string, version int32) (*pipeline.PipelineConfigVersion, error)
 	CreatePipeline(ctx context.Context, userID string, cfg *pipeline.PipelineConfig) (*pipeline.PipelineConfig, error)
 	UpdatePipeline(ctx context.Context, userID string, cfg *pipeline.PipelineConfig) (*pipeline.PipelineConfig, error)
 	DeletePipeline(ctx context.Context, userID, pipelineID string) error
@@ -24,5 +30,84 @@ type PipelineStore interface {
 	GetPipelineRun(ctx context.Context, userID, runID string) (*pipeline.PipelineRun, error)
 	FindPipelineRunByActivityId(ctx context.Context, userID, activityID string) (*pipeline.PipelineRun, error)
 	ListPipelineRuns(ctx context.Context, userID, pipelineID string, limit int32, pageToken string) ([]*pipeline.PipelineRun, string, error)
+	// ListPipelineRunsBySourceActivity returns the user's runs of an activity
+	// from its source platform, e.g. one per pipeline it was fanned out to.
+	ListPipelineRunsBySourceActivity(ctx context.Context, userID string, source activity.ActivitySource, sourceActivityID string) ([]*pipeline.PipelineRun, error)
 	UpdatePipelineRun(ctx context.Context, userID, runID string, updateData map[string]interface{}) error
+	// TransitionPipelineRun reads the run and writes the fields fn returns in
+	// one transaction, so a concurrent status change makes Firestore retry and
+	// call fn again. fn gets nil when the run doesn't exist; an error from fn
+	// aborts without writing and is returned as is.
+	TransitionPipelineRun(ctx context.Context, userID, runID string, fn func(run *pipeline.PipelineRun) (map[string]interface{}, error)) error
+	// CreatePipelineRun stores a run the splitter creates itself, such as one
+	// deferred while its pipeline is paused.
+	CreatePipelineRun(ctx context.Context, userID string, run *pipeline.PipelineRun) error
+	// ListDeferredRuns returns the user's DEFERRED runs, oldest first. An empty
+	// pipelineID lists deferred runs across all pipelines.
+	ListDeferredRuns(ctx context.Context, userID, pipelineID string) ([]*pipeline.PipelineRun, error)
+	// ListDueRetries returns RUNNING runs across all users with an enricher
+	// retry due at or before the given time, soonest first.
+	ListDueRetries(ctx context.Context, before time.Time, limit int) ([]*DueRetry, error)
+	// ListRecentPipelineRuns returns runs created before the given time,
+	// newest first, with UserId set. Every user's runs are searched unless
+	// filter.UserID is set.
+	ListRecentPipelineRuns(ctx context.Context, filter RunFilter, before time.Time, limit int) ([]*pipeline.PipelineRun, error)
+	// ListPipelineDailyStats returns the pipeline's daily stats from since
+	// (YYYY-MM-DD, UTC) onwards, oldest first.
+	ListPipelineDailyStats(ctx context.Context, userID, pipelineID, since string) ([]*pipeline.PipelineDailyStats, error)
+
+	// Pausing (vacation mode). A zero time means pipelines are not paused.
+	GetPipelinesPausedUntil(ctx context.Context, userID string) (time.Time, error)
+	SetPipelinesPausedUntil(ctx context.Context, userID string, until time.Time) error
+
+	// ClaimStarterPipeline records that the user's starter pipeline is being
+	// provisioned. It reports false if it already was, so racing connections
+	// provision at most one, and deleting it doesn't bring it back.
+	ClaimStarterPipeline(ctx context.Context, userID string, at time.Time) (bool, error)
+
+	// Activity type rules learned from the user's type corrections
+	ListActivityTypeRules(ctx context.Context, userID string) ([]*pipeline.ActivityTypeRule, error)
+	GetActivityTypeRule(ctx context.Context, userID, ruleID string) (*pipeline.ActivityTypeRule, error)
+	SetActivityTypeRule(ctx context.Context, userID string, rule *pipeline.ActivityTypeRule) error
+	DeleteActivityTypeRule(ctx context.Context, userID, ruleID string) error
+
+	// Executions
+	ListExecutionsForRun(ctx context.Context, userID, runID string) ([]*pipeline.ExecutionRecord, error)
+
+	// Recommendations
+	GetEnricherRecommendations(ctx context.Context, userID string) (*pipeline.EnricherRecommendations, error)
+	SetEnricherRecommendations(ctx context.Context, userID string, recs *pipeline.EnricherRecommendations) error
+	ListActiveUserIDs(ctx context.Context, since time.Time) ([]string, error)
+
+	// Provider circuits (shared enricher skip-list, see enricher.CircuitBreaker)
+	ListProviderCircuits(ctx context.Context) ([]*pipeline.ProviderCircuit, error)
+	// SetProviderCircuitMode sets the circuit's mode and records the change in
+	// its history in one transaction.
+	SetProviderCircuitMode(ctx context.Context, providerType plugin.EnricherProviderType, mode pipeline.ProviderCircuitMode, reason, actor string) (*pipeline.ProviderCircuit, error)
+	// ListProviderCircuitChanges returns the circuit's history, newest first.
+	ListProviderCircuitChanges(ctx context.Context, providerType plugin.EnricherProviderType, limit int) ([]*pipeline.ProviderCircuitChange, error)
+
+	// Failed events (dead-lettered Pub/Sub messages, see redrive.Redriver)
+	GetFailedEvent(ctx context.Context, userID, eventID string) (*pipeline.FailedEvent, error)
+	SetFailedEvent(ctx context.Context, userID string, event *pipeline.FailedEvent) error
+	// ListFailedEvents returns the user's failed events, most recently failed
+	// first. An unspecified status lists every status.
+	ListFailedEvents(ctx context.Context, userID string, status pipeline.FailedEventStatus, limit int) ([]*pipeline.FailedEvent, error)
+	// ListDueFailedEvents returns PENDING failed events across all users whose
+	// next attempt is at or before the given time.
+	ListDueFailedEvents(ctx context.Context, before time.Time, limit int) ([]*pipeline.FailedEvent, error)
+}
+
+// RunFilter narrows ListRecentPipelineRuns. Zero fields match every run.
+type RunFilter struct {
+	UserID   string
+	Statuses []pipeline.PipelineRunStatus
+	Source   string // As stored, e.g. SOURCE_STRAVA
+}
+
+// DueRetry is a pipeline run whose scheduled enricher retry is due, with the
+// user it belongs to.
+type DueRetry struct {
+	UserID string
+	Run    *pipeline.PipelineRun
 }
@@ -0,0 +1,143 @@
+package pipeline
+
+import (
+	"context"
+	"time"
+
+	domainactivity "github.com/fitglue/server/src/go/pkg/domain/activity"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CorrectActivityType records the user's type for an activity and learns
+// from it: the run's source and normalised title become an ActivityTypeRule
+// that the type learner applies automatically once the same correction has
+// been made TypeRuleLearnThreshold times. The response rule is unset when the
+// title has nothing to learn from or the type didn't change.
+func (s *Service) CorrectActivityType(ctx context.Context, req *pbsvc.CorrectActivityTypeRequest) (*pbsvc.CorrectActivityTypeResponse, error) {
+	if req.UserId == "" || req.ActivityId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and activity_id are required")
+	}
+	if req.ActivityType == pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "activity_type is required")
+	}
+
+	run, err := s.store.FindPipelineRunByActivityId(ctx, req.UserId, req.ActivityId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to find pipeline run by activity", "error", err, "activityId", req.ActivityId)
+		return nil, status.Error(codes.Internal, "failed to look up pipeline run")
+	}
+	if run == nil {
+		return nil, status.Error(codes.NotFound, "no pipeline run found for activity")
+	}
+	if run.Type == req.ActivityType {
+		return &pbsvc.CorrectActivityTypeResponse{}, nil
+	}
+
+	now := time.Now()
+	if err := s.store.UpdatePipelineRun(ctx, req.UserId, run.Id, map[string]interface{}{
+		"type":       int32(req.ActivityType),
+		"updated_at": now,
+	}); err != nil {
+		s.logger.Error(ctx, "failed to update pipeline run type", "error", err, "runId", run.Id)
+		return nil, status.Error(codes.Internal, "failed to update activity type")
+	}
+
+	pattern := domainactivity.NormalizeTitlePattern(run.Title)
+	if pattern == "" || run.Source == "" {
+		return &pbsvc.CorrectActivityTypeResponse{}, nil
+	}
+
+	id := domainactivity.TypeRuleID(run.Source, pattern)
+	rule, err := s.store.GetActivityTypeRule(ctx, req.UserId, id)
+	if err != nil {
+		s.logger.Error(ctx, "failed to get activity type rule", "error", err, "ruleId", id)
+		return nil, status.Error(codes.Internal, "failed to read activity type rule")
+	}
+
+	switch {
+	case rule == nil:
+		rule = &pipeline.ActivityTypeRule{
+			Id:              id,
+			Source:          run.Source,
+			TitlePattern:    pattern,
+			TargetType:      req.ActivityType,
+			CorrectionCount: 1,
+			CreatedAt:       timestamppb.New(now),
+		}
+	case rule.TargetType == req.ActivityType:
+		rule.CorrectionCount++
+	default:
+		// The user changed their mind; start counting towards the new type.
+		rule.TargetType = req.ActivityType
+		rule.CorrectionCount = 1
+	}
+	rule.UpdatedAt = timestamppb.New(now)
+
+	if err := s.store.SetActivityTypeRule(ctx, req.UserId, rule); err != nil {
+		s.logger.Error(ctx, "failed to save activity type rule", "error", err, "ruleId", id)
+		return nil, status.Error(codes.Internal, "failed to save activity type rule")
+	}
+
+	s.logger.Info(ctx, "Activity type corrected", "userId", req.UserId, "activityId", req.ActivityId, "ruleId", id, "type", req.ActivityType.String(), "corrections", rule.CorrectionCount)
+	return &pbsvc.CorrectActivityTypeResponse{Rule: rule}, nil
+}
+
+func (s *Service) ListActivityTypeRules(ctx context.Context, req *pbsvc.ListActivityTypeRulesRequest) (*pbsvc.ListActivityTypeRulesResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	rules, err := s.store.ListActivityTypeRules(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list activity type rules", "error", err, "userId", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to read activity type rules")
+	}
+
+	return &pbsvc.ListActivityTypeRulesResponse{
+		Rules:          rules,
+		LearnThreshold: domainactivity.TypeRuleLearnThreshold,
+	}, nil
+}
+
+// UpdateActivityTypeRule enables or disables a learned rule. Disabled rules
+// keep counting corrections but are never applied.
+func (s *Service) UpdateActivityTypeRule(ctx context.Context, req *pbsvc.UpdateActivityTypeRuleRequest) (*pipeline.ActivityTypeRule, error) {
+	if req.UserId == "" || req.RuleId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and rule_id are required")
+	}
+
+	rule, err := s.store.GetActivityTypeRule(ctx, req.UserId, req.RuleId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to get activity type rule", "error", err, "ruleId", req.RuleId)
+		return nil, status.Error(codes.Internal, "failed to read activity type rule")
+	}
+	if rule == nil {
+		return nil, status.Error(codes.NotFound, "activity type rule not found")
+	}
+
+	rule.Disabled = req.Disabled
+	rule.UpdatedAt = timestamppb.Now()
+	if err := s.store.SetActivityTypeRule(ctx, req.UserId, rule); err != nil {
+		s.logger.Error(ctx, "failed to save activity type rule", "error", err, "ruleId", req.RuleId)
+		return nil, status.Error(codes.Internal, "failed to save activity type rule")
+	}
+	return rule, nil
+}
+
+func (s *Service) DeleteActivityTypeRule(ctx context.Context, req *pbsvc.DeleteActivityTypeRuleRequest) (*emptypb.Empty, error) {
+	if req.UserId == "" || req.RuleId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and rule_id are required")
+	}
+
+	if err := s.store.DeleteActivityTypeRule(ctx, req.UserId, req.RuleId); err != nil {
+		s.logger.Error(ctx, "failed to delete activity type rule", "error", err, "ruleId", req.RuleId)
+		return nil, status.Error(codes.Internal, "failed to delete activity type rule")
+	}
+	return &emptypb.Empty{}, nil
+}
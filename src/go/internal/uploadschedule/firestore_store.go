@@ -0,0 +1,93 @@
+// nolint:proto-json
+package uploadschedule
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// CollectionScheduledUploads holds uploads waiting for their destination's
+// posting schedule.
+const CollectionScheduledUploads = "scheduled_uploads"
+
+type FirestoreStore struct {
+	client *firestore.Client
+}
+
+func NewFirestoreStore(client *firestore.Client) *FirestoreStore {
+	return &FirestoreStore{client: client}
+}
+
+// Enqueue writes the upload under its ID, replacing any copy already held.
+func (s *FirestoreStore) Enqueue(ctx context.Context, upload *pipeline.ScheduledUpload) error {
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(upload)
+	if err != nil {
+		return err
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	// Stored as a timestamp so ListDue can range over it
+	data["due_at"] = upload.DueAt.AsTime()
+
+	_, err = s.client.Collection(CollectionScheduledUploads).Doc(upload.Id).Set(ctx, data)
+	return err
+}
+
+func (s *FirestoreStore) ListDue(ctx context.Context, now time.Time, limit int) ([]*pipeline.ScheduledUpload, error) {
+	iter := s.client.Collection(CollectionScheduledUploads).
+		Where("due_at", "<=", now).
+		OrderBy("due_at", firestore.Asc).
+		Limit(limit).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var due []*pipeline.ScheduledUpload
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		b, err := json.Marshal(doc.Data())
+		if err != nil {
+			return nil, err
+		}
+		var upload pipeline.ScheduledUpload
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(b, &upload); err != nil {
+			return nil, err
+		}
+		due = append(due, &upload)
+	}
+	return due, nil
+}
+
+// Claim deletes the upload in a transaction, reporting false if another sweep
+// already removed it.
+func (s *FirestoreStore) Claim(ctx context.Context, id string) (bool, error) {
+	ref := s.client.Collection(CollectionScheduledUploads).Doc(id)
+	claimed := false
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		claimed = false
+		if _, err := tx.Get(ref); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil
+			}
+			return err
+		}
+		claimed = true
+		return tx.Delete(ref)
+	})
+	return claimed, err
+}
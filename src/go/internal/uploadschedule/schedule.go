@@ -0,0 +1,131 @@
+// Package uploadschedule holds destination uploads back until the
+// destination's posting schedule allows them, so users can batch or time
+// their social posts.
+package uploadschedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Destination config keys for the posting schedule. Like every destination
+// config key they reach the destination service in the upload's enrichment
+// metadata prefixed with the destination ID, e.g. "strava_schedule_delay".
+const (
+	// ConfigDelay holds uploads back for a Go duration, e.g. "90m" or "2h".
+	ConfigDelay = "schedule_delay"
+	// ConfigWindow only posts between two local times, e.g. "08:00-22:00".
+	// A window ending before it starts spans midnight.
+	ConfigWindow = "schedule_window"
+	// ConfigTimezone is the IANA zone the window is in; UTC when unset.
+	ConfigTimezone = "schedule_timezone"
+)
+
+// ReleasedMetadataKey, set to "true" in an upload event's enrichment
+// metadata, marks a scheduled upload being released, which is sent without
+// checking the schedule again.
+const ReleasedMetadataKey = "schedule_released"
+
+// maxDelay caps the delay so uploads aren't held indefinitely.
+const maxDelay = 7 * 24 * time.Hour
+
+// Schedule is a destination's posting schedule.
+type Schedule struct {
+	Delay time.Duration
+
+	// Minutes after local midnight; both zero when there's no window
+	WindowStart int
+	WindowEnd   int
+	Location    *time.Location
+}
+
+// Parse reads a posting schedule from a destination's config. It returns
+// nil when the config sets none.
+func Parse(config map[string]string) (*Schedule, error) {
+	delay := strings.TrimSpace(config[ConfigDelay])
+	window := strings.TrimSpace(config[ConfigWindow])
+	if delay == "" && window == "" {
+		return nil, nil
+	}
+
+	s := &Schedule{Location: time.UTC}
+	if delay != "" {
+		d, err := time.ParseDuration(delay)
+		if err != nil || d < 0 || d > maxDelay {
+			return nil, fmt.Errorf("invalid %s %q: must be a duration of at most 7 days, e.g. \"2h\"", ConfigDelay, delay)
+		}
+		s.Delay = d
+	}
+	if window != "" {
+		start, end, ok := strings.Cut(window, "-")
+		var err error
+		if ok {
+			if s.WindowStart, err = parseClock(start); err == nil {
+				s.WindowEnd, err = parseClock(end)
+			}
+		}
+		if !ok || err != nil || s.WindowStart == s.WindowEnd {
+			return nil, fmt.Errorf("invalid %s %q: must be two different times, e.g. \"08:00-22:00\"", ConfigWindow, window)
+		}
+	}
+	if tz := strings.TrimSpace(config[ConfigTimezone]); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q", ConfigTimezone, tz)
+		}
+		s.Location = loc
+	}
+	return s, nil
+}
+
+// FromMetadata reads the posting schedule of the destination with the given
+// ID from an upload's enrichment metadata.
+func FromMetadata(metadata map[string]string, destID string) (*Schedule, error) {
+	return Parse(map[string]string{
+		ConfigDelay:    metadata[destID+"_"+ConfigDelay],
+		ConfigWindow:   metadata[destID+"_"+ConfigWindow],
+		ConfigTimezone: metadata[destID+"_"+ConfigTimezone],
+	})
+}
+
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// hasWindow reports whether the schedule restricts posting to local hours.
+func (s *Schedule) hasWindow() bool {
+	return s.WindowStart != s.WindowEnd
+}
+
+// inWindow reports whether minute, after local midnight, is inside the window.
+func (s *Schedule) inWindow(minute int) bool {
+	if s.WindowStart < s.WindowEnd {
+		return minute >= s.WindowStart && minute < s.WindowEnd
+	}
+	return minute >= s.WindowStart || minute < s.WindowEnd
+}
+
+// ReleaseAt returns the earliest time an upload arriving at now may be
+// posted: after the delay, moved to the next opening of the window if it
+// falls outside it. A result not after now means post straight away.
+func (s *Schedule) ReleaseAt(now time.Time) time.Time {
+	at := now.Add(s.Delay)
+	if !s.hasWindow() {
+		return at
+	}
+
+	local := at.In(s.Location)
+	if s.inWindow(local.Hour()*60 + local.Minute()) {
+		return at
+	}
+	opens := time.Date(local.Year(), local.Month(), local.Day(), s.WindowStart/60, s.WindowStart%60, 0, 0, s.Location)
+	if !opens.After(local) {
+		opens = opens.AddDate(0, 0, 1)
+	}
+	return opens
+}
@@ -0,0 +1,77 @@
+package uploadschedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]string
+		want    *Schedule
+		wantErr bool
+	}{
+		{name: "unset", config: map[string]string{"visibility": "public"}},
+		{name: "delay", config: map[string]string{ConfigDelay: "2h"}, want: &Schedule{Delay: 2 * time.Hour, Location: time.UTC}},
+		{name: "window", config: map[string]string{ConfigWindow: "08:00-22:30"}, want: &Schedule{WindowStart: 8 * 60, WindowEnd: 22*60 + 30, Location: time.UTC}},
+		{name: "bad delay", config: map[string]string{ConfigDelay: "soon"}, wantErr: true},
+		{name: "delay too long", config: map[string]string{ConfigDelay: "200h"}, wantErr: true},
+		{name: "bad window", config: map[string]string{ConfigWindow: "8am"}, wantErr: true},
+		{name: "empty window", config: map[string]string{ConfigWindow: "08:00-08:00"}, wantErr: true},
+		{name: "bad timezone", config: map[string]string{ConfigWindow: "08:00-22:00", ConfigTimezone: "Mars/Olympus"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("Parse() = %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil || *got != *tt.want {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReleaseAt(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip("tzdata unavailable")
+	}
+	at := func(s string) time.Time {
+		tm, err := time.ParseInLocation("2006-01-02 15:04", s, london)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		now      string
+		want     string
+	}{
+		{name: "delay", schedule: Schedule{Delay: 90 * time.Minute, Location: london}, now: "2026-06-01 10:00", want: "2026-06-01 11:30"},
+		{name: "inside window", schedule: Schedule{WindowStart: 8 * 60, WindowEnd: 22 * 60, Location: london}, now: "2026-06-01 10:00", want: "2026-06-01 10:00"},
+		{name: "before window", schedule: Schedule{WindowStart: 8 * 60, WindowEnd: 22 * 60, Location: london}, now: "2026-06-01 06:15", want: "2026-06-01 08:00"},
+		{name: "after window", schedule: Schedule{WindowStart: 8 * 60, WindowEnd: 22 * 60, Location: london}, now: "2026-06-01 22:00", want: "2026-06-02 08:00"},
+		{name: "delay pushes past window", schedule: Schedule{Delay: 3 * time.Hour, WindowStart: 8 * 60, WindowEnd: 22 * 60, Location: london}, now: "2026-06-01 20:00", want: "2026-06-02 08:00"},
+		{name: "window over midnight", schedule: Schedule{WindowStart: 22 * 60, WindowEnd: 2 * 60, Location: london}, now: "2026-06-02 01:00", want: "2026-06-02 01:00"},
+		{name: "before window over midnight", schedule: Schedule{WindowStart: 22 * 60, WindowEnd: 2 * 60, Location: london}, now: "2026-06-01 12:00", want: "2026-06-01 22:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.ReleaseAt(at(tt.now)); !got.Equal(at(tt.want)) {
+				t.Errorf("ReleaseAt(%s) = %s, want %s", tt.now, got.In(london), tt.want)
+			}
+		})
+	}
+}
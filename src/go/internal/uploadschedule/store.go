@@ -0,0 +1,18 @@
+package uploadschedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+// Store defines the data access contract for the scheduled upload queue.
+type Store interface {
+	Enqueue(ctx context.Context, upload *pipeline.ScheduledUpload) error
+	// ListDue returns uploads due at or before now, earliest first.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*pipeline.ScheduledUpload, error)
+	// Claim removes the upload so only one release sweep publishes it; false
+	// when it was already claimed.
+	Claim(ctx context.Context, id string) (bool, error)
+}
@@ -5,9 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strconv"
 	"time"
 
 	"github.com/fitglue/server/src/go/pkg/domain/apikey"
+	storage "github.com/fitglue/server/src/go/pkg/storage/firestore"
 
 	"cloud.google.com/go/firestore"
 	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
@@ -91,6 +93,49 @@ func (s *FirestoreStore) UpdateProfile(ctx context.Context, userID string, profi
 	return err
 }
 
+// userSubCollections are the users/{userId} sub-collections DeleteUser
+// removes, besides pipelines, pipeline runs and gear which have their own.
+// TestUserSubCollectionsCoverWrites fails when code writes one not listed.
+var userSubCollections = []string{
+	"synchronized_activities",
+	"raw_activities",
+	"activities",
+	"executions",
+	"pending_inputs",
+	"counters",
+	"booster_data",
+	"encryption_keys",
+	"integration_secrets",
+	"integrations",
+	"personal_records",
+	"goals",
+	"uploaded_activities",
+	"plugin_defaults",
+	"activity_type_rules",
+	"enricher_result_shares",
+	"failed_events",
+	"recommendations",
+	"settings",
+	"showcase_profile_entries",
+	"billing",
+	"usage",
+	"monthly_costs",
+}
+
+// userOwnedCollections are the top-level collections whose documents belong
+// to a user through their user_id field.
+var userOwnedCollections = []string{
+	"ingress_api_keys",
+	"showcased_activities",
+	"showcase_profiles",
+	"showcase_slugs",
+	"backfill_jobs",
+	"import_sessions",
+	"platform_outage_uploads",
+	"platform_outage_source_events",
+	"scheduled_uploads",
+}
+
 func (s *FirestoreStore) DeleteUser(ctx context.Context, userID string) error {
 	userDocRef := s.client.Collection("users").Doc(userID)
 
@@ -126,60 +171,59 @@ func (s *FirestoreStore) DeleteUser(ctx context.Context, userID string) error {
 		return nil
 	}
 
-	// 1. Delete pipeline_runs & destination_outcomes
-	runsIter := userDocRef.Collection("pipeline_runs").Documents(ctx)
-	defer runsIter.Stop()
-	for {
-		doc, err := runsIter.Next()
-		if err == iterator.Done {
-			break
-		}
-		if err != nil {
-			return err
-		}
-		outcomesIter := doc.Ref.Collection("destination_outcomes").Documents(ctx)
-		if err := deleteDocs(outcomesIter); err != nil {
-			return err
-		}
-		_, err = doc.Ref.Delete(ctx)
-		if err != nil {
-			return err
+	// deleteWithChildren deletes each doc of a collection after the named
+	// sub-collections under it
+	deleteWithChildren := func(col *firestore.CollectionRef, children ...string) error {
+		iter := col.Documents(ctx)
+		defer iter.Stop()
+		for {
+			doc, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			for _, child := range children {
+				if err := deleteDocs(doc.Ref.Collection(child).Documents(ctx)); err != nil {
+					return err
+				}
+			}
+			if _, err := doc.Ref.Delete(ctx); err != nil {
+				return err
+			}
 		}
+		return nil
 	}
 
-	// 2-9. Delete user sub-collections
-	subCollections := []string{
-		"synchronized_activities",
-		"raw_activities",
-		"executions",
-		"pending_inputs",
-		"pipelines",
-		"counters",
-		"booster_data",
-		"personal_records",
-		"uploaded_activities",
-		"plugin_defaults",
+	// 1. Delete pipeline_runs & destination_outcomes, pipelines with their
+	// versions and daily stats, and gear with its use markers
+	if err := deleteWithChildren(userDocRef.Collection("pipeline_runs"), "destination_outcomes"); err != nil {
+		return err
 	}
-	for _, sub := range subCollections {
+	if err := deleteWithChildren(userDocRef.Collection("pipelines"), "versions", "daily_stats"); err != nil {
+		return err
+	}
+	if err := deleteWithChildren(userDocRef.Collection("gear"), "uses"); err != nil {
+		return err
+	}
+
+	// 2. Delete user sub-collections
+	for _, sub := range userSubCollections {
 		if err := deleteDocs(userDocRef.Collection(sub).Documents(ctx)); err != nil {
 			return err
 		}
 	}
 
-	// 10-12. Delete top-level collections by user_id
-	topCollections := []string{
-		"ingress_api_keys",
-		"showcased_activities",
-		"showcase_profiles",
-	}
-	for _, col := range topCollections {
+	// 3. Delete top-level collections by user_id
+	for _, col := range userOwnedCollections {
 		iter := s.client.Collection(col).Where("user_id", "==", userID).Documents(ctx)
 		if err := deleteDocs(iter); err != nil {
 			return err
 		}
 	}
 
-	// 13. Delete user document
+	// 4. Delete user document
 	_, err := userDocRef.Delete(ctx)
 	return err
 }
@@ -227,6 +271,9 @@ func (s *FirestoreStore) GetIntegrations(ctx context.Context, userID string) (*p
 		// Field doesn't exist or isn't a map, return empty
 		return &pbuser.UserIntegrations{}, nil
 	}
+	if err := storage.DecryptUserTokens(ctx, map[string]interface{}{"integrations": val}); err != nil {
+		return nil, err
+	}
 
 	// Normalize numeric expires_at fields into RFC-3339 strings
 	if m, ok := val.(map[string]interface{}); ok {
@@ -258,11 +305,26 @@ func (s *FirestoreStore) GetIntegrations(ctx context.Context, userID string) (*p
 	return &integrations, nil
 }
 
+// SetIntegration writes the integration to the user document, moving any API
+// key into integration_secrets.
 func (s *FirestoreStore) SetIntegration(ctx context.Context, userID, provider string, data interface{}) error {
+	if m, ok := data.(map[string]interface{}); ok {
+		if secret, ok := storage.SplitIntegrationSecret(provider, m); ok {
+			if err := storage.SetIntegrationSecret(ctx, s.client, userID, provider, secret); err != nil {
+				return err
+			}
+		}
+	}
+
+	path := "integrations." + provider
+	update := map[string]interface{}{path: data}
+	if err := storage.EncryptUserTokens(ctx, update); err != nil {
+		return err
+	}
 	_, err := s.client.Collection("users").Doc(userID).Update(ctx, []firestore.Update{
 		{
-			Path:  "integrations." + provider,
-			Value: data,
+			Path:  path,
+			Value: update[path],
 		},
 	})
 	return err
@@ -275,7 +337,17 @@ func (s *FirestoreStore) DeleteIntegration(ctx context.Context, userID, provider
 			Value: firestore.Delete,
 		},
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	if _, ok := storage.IntegrationSecretField(provider); ok {
+		return storage.DeleteIntegrationSecret(ctx, s.client, userID, provider)
+	}
+	return nil
+}
+
+func (s *FirestoreStore) GetIntegrationSecret(ctx context.Context, userID, provider string) (string, error) {
+	return storage.GetIntegrationSecret(ctx, s.client, userID, provider)
 }
 
 func isApiKeyProvider(provider string) bool {
@@ -329,6 +401,14 @@ func (s *FirestoreStore) FindUserByIntegration(ctx context.Context, provider str
 		fieldPath = "integrations.github.github_user_id"
 	case "spotify":
 		fieldPath = "integrations.spotify.spotify_user_id"
+	case "komoot":
+		fieldPath = "integrations.komoot.komoot_user_id"
+	case "dropbox":
+		fieldPath = "integrations.dropbox.dropbox_user_id"
+	case "whoop":
+		fieldPath = "integrations.whoop.whoop_user_id"
+	case "zwift":
+		fieldPath = "integrations.zwift.zwift_profile_id"
 	case "intervals":
 		fieldPath = "integrations.intervals.athlete_id"
 	case "trainingpeaks":
@@ -339,18 +419,16 @@ func (s *FirestoreStore) FindUserByIntegration(ctx context.Context, provider str
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported provider for ResolveUser: %s", provider)
 	}
 
-	var queryValue interface{} = providerUID
+	query := s.client.Collection("users").Where(fieldPath, "==", providerUID)
 	if provider == "strava" {
-		// Attempt to parse to int64, since Strava athlete_id is stored as int64
-		// But if it's stored in Firestore as a number, we must query it as a number.
-		// providerUID comes from the webhook, which might be a string.
-		// We'll decode using json unmarshal to handle strings. Actually,
-		// if we know it's a number:
-		importStrconv := true
-		_ = importStrconv
+		// Strava athlete IDs are stored as numbers, but match strings too in
+		// case an integration was saved with a string ID
+		if id, err := strconv.ParseInt(providerUID, 10, 64); err == nil {
+			query = s.client.Collection("users").Where(fieldPath, "in", []interface{}{id, providerUID})
+		}
 	}
 
-	iter := s.client.Collection("users").Where(fieldPath, "==", queryValue).Limit(1).Documents(ctx)
+	iter := query.Limit(1).Documents(ctx)
 	defer iter.Stop()
 
 	doc, err := iter.Next()
@@ -492,7 +570,11 @@ func (s *FirestoreStore) GetBoosterData(ctx context.Context, userID, boosterID s
 	res := make(map[string]*structpb.Struct)
 
 	parseDoc := func(doc *firestore.DocumentSnapshot) (*structpb.Struct, error) {
-		b, err := json.Marshal(doc.Data())
+		data := doc.Data()
+		if err := storage.DecryptBoosterData(ctx, userID, doc.Ref.ID, data); err != nil {
+			return nil, err
+		}
+		b, err := json.Marshal(data)
 		if err != nil {
 			return nil, err
 		}
@@ -543,6 +625,9 @@ func (s *FirestoreStore) SetBoosterData(ctx context.Context, userID, boosterID s
 		return errors.New("data cannot be nil")
 	}
 	m := data.AsMap()
+	if err := storage.EncryptBoosterData(ctx, userID, boosterID, m); err != nil {
+		return err
+	}
 	m["last_updated"] = time.Now()
 	_, err := s.client.Collection("users").Doc(userID).Collection("booster_data").Doc(boosterID).Set(ctx, m, firestore.MergeAll)
 	return err
@@ -609,6 +694,209 @@ func (s *FirestoreStore) DeletePersonalRecord(ctx context.Context, userID, recor
 	return err
 }
 
+func (s *FirestoreStore) ListGear(ctx context.Context, userID string) ([]*pbuser.Gear, error) {
+	var gear []*pbuser.Gear
+	iter := s.client.Collection("users").Doc(userID).Collection("gear").Documents(ctx)
+	defer iter.Stop()
+
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		g := storage.FirestoreToGear(doc.Data())
+		if g.Id == "" {
+			g.Id = doc.Ref.ID
+		}
+		gear = append(gear, g)
+	}
+	return gear, nil
+}
+
+// SetGear creates or updates a piece of gear. The mileage and usage the gear
+// tracker has accumulated are kept, so only the user-editable fields change.
+func (s *FirestoreStore) SetGear(ctx context.Context, userID string, gear *pbuser.Gear) (*pbuser.Gear, error) {
+	if gear == nil {
+		return nil, errors.New("gear cannot be nil")
+	}
+
+	ref := s.client.Collection("users").Doc(userID).Collection("gear").Doc(gear.Id)
+	var result *pbuser.Gear
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		var existing *pbuser.Gear
+		doc, err := tx.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			existing = storage.FirestoreToGear(doc.Data())
+		}
+
+		result = mergeGear(existing, gear, time.Now())
+		return tx.Set(ref, storage.GearToFirestore(result))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// mergeGear applies the user-editable fields of update on top of existing.
+// Changing the starting distance shifts the total by the same amount rather
+// than discarding the distance tracked since.
+func mergeGear(existing, update *pbuser.Gear, now time.Time) *pbuser.Gear {
+	merged := &pbuser.Gear{
+		Id:                    update.Id,
+		Name:                  update.Name,
+		Type:                  update.Type,
+		InitialDistanceMeters: update.InitialDistanceMeters,
+		DistanceMeters:        update.InitialDistanceMeters,
+		Retired:               update.Retired,
+		CreatedAt:             timestamppb.New(now),
+	}
+	if existing != nil {
+		merged.DistanceMeters = existing.DistanceMeters - existing.InitialDistanceMeters + update.InitialDistanceMeters
+		merged.ActivityCount = existing.ActivityCount
+		merged.LastUsedAt = existing.LastUsedAt
+		merged.LastActivityId = existing.LastActivityId
+		if existing.CreatedAt != nil {
+			merged.CreatedAt = existing.CreatedAt
+		}
+	}
+	return merged
+}
+
+// DeleteGear deletes a piece of gear along with the per-activity use
+// markers its mileage was counted from.
+func (s *FirestoreStore) DeleteGear(ctx context.Context, userID, gearID string) error {
+	gearRef := s.client.Collection("users").Doc(userID).Collection("gear").Doc(gearID)
+
+	iter := gearRef.Collection("uses").Documents(ctx)
+	defer iter.Stop()
+	batch := s.client.Batch()
+	count := 0
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		batch.Delete(doc.Ref)
+		count++
+		if count == 500 {
+			if _, err := batch.Commit(ctx); err != nil {
+				return err
+			}
+			batch = s.client.Batch()
+			count = 0
+		}
+	}
+	if count > 0 {
+		if _, err := batch.Commit(ctx); err != nil {
+			return err
+		}
+	}
+
+	_, err := gearRef.Delete(ctx)
+	return err
+}
+
+func (s *FirestoreStore) ListGoals(ctx context.Context, userID string) ([]*pbuser.Goal, error) {
+	var goals []*pbuser.Goal
+	iter := s.client.Collection("users").Doc(userID).Collection("goals").Documents(ctx)
+	defer iter.Stop()
+
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		g := storage.FirestoreToGoal(doc.Data())
+		if g.Id == "" {
+			g.Id = doc.Ref.ID
+		}
+		goals = append(goals, g)
+	}
+	return goals, nil
+}
+
+// SetGoal creates or updates a goal, keeping the progress the goal progress
+// enricher has accumulated so only the user-editable fields change.
+func (s *FirestoreStore) SetGoal(ctx context.Context, userID string, goal *pbuser.Goal) (*pbuser.Goal, error) {
+	if goal == nil {
+		return nil, errors.New("goal cannot be nil")
+	}
+
+	ref := s.client.Collection("users").Doc(userID).Collection("goals").Doc(goal.Id)
+	var result *pbuser.Goal
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		var existing *pbuser.Goal
+		doc, err := tx.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			existing = storage.FirestoreToGoal(doc.Data())
+		}
+
+		result = mergeGoal(existing, goal, time.Now())
+		return tx.Set(ref, storage.GoalToFirestore(result))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// mergeGoal applies the user-editable fields of update on top of existing.
+// Progress is in the metric's unit, so changing the metric starts it again;
+// completion follows the (possibly changed) target.
+func mergeGoal(existing, update *pbuser.Goal, now time.Time) *pbuser.Goal {
+	merged := &pbuser.Goal{
+		Id:           update.Id,
+		Name:         update.Name,
+		Metric:       update.Metric,
+		Target:       update.Target,
+		ActivityType: update.ActivityType,
+		StartDate:    update.StartDate,
+		EndDate:      update.EndDate,
+		CreatedAt:    timestamppb.New(now),
+	}
+	if existing == nil {
+		return merged
+	}
+	if existing.CreatedAt != nil {
+		merged.CreatedAt = existing.CreatedAt
+	}
+	if existing.Metric == update.Metric {
+		merged.Progress = existing.Progress
+		merged.ActivityCount = existing.ActivityCount
+		merged.LastActivityId = existing.LastActivityId
+	}
+	if merged.Progress >= merged.Target {
+		merged.CompletedAt = existing.CompletedAt
+		if merged.CompletedAt == nil {
+			merged.CompletedAt = timestamppb.New(now)
+		}
+	}
+	return merged
+}
+
+func (s *FirestoreStore) DeleteGoal(ctx context.Context, userID, goalID string) error {
+	_, err := s.client.Collection("users").Doc(userID).Collection("goals").Doc(goalID).Delete(ctx)
+	return err
+}
+
 func (s *FirestoreStore) ListPluginDefaults(ctx context.Context, userID string) (map[string]*structpb.Struct, error) {
 	res := make(map[string]*structpb.Struct)
 	iter := s.client.Collection("users").Doc(userID).Collection("plugin_defaults").Documents(ctx)
@@ -641,6 +929,16 @@ func (s *FirestoreStore) SetPluginDefaults(ctx context.Context, userID, pluginID
 		return errors.New("defaults cannot be nil")
 	}
 	m := defaults.AsMap()
+	if key, ok := storage.DestinationSecretKey(pluginID); ok {
+		if config, ok := m["config"].(map[string]interface{}); ok {
+			if secret, _ := config[key].(string); secret != "" {
+				if err := storage.SetIntegrationSecret(ctx, s.client, userID, pluginID, secret); err != nil {
+					return err
+				}
+			}
+			delete(config, key)
+		}
+	}
 	m["last_updated"] = time.Now()
 	_, err := s.client.Collection("users").Doc(userID).Collection("plugin_defaults").Doc(pluginID).Set(ctx, m, firestore.MergeAll)
 	return err
@@ -2,6 +2,13 @@ package user
 
 import (
 	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/api/option"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // setupOfflineFirestore creates a client that will fail fast since there's no emulator
@@ -124,8 +132,253 @@ func TestFirestoreStore_OfflineCoverage(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("ListGear", func(t *testing.T) {
+		_, err := store.ListGear(ctx, "user1")
+		assert.Error(t, err)
+	})
+
+	t.Run("SetGear", func(t *testing.T) {
+		_, err := store.SetGear(ctx, "user1", &pbuser.Gear{Id: "g1"})
+		assert.Error(t, err)
+
+		_, err = store.SetGear(ctx, "user1", nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("DeleteGear", func(t *testing.T) {
+		err := store.DeleteGear(ctx, "user1", "g1")
+		assert.Error(t, err)
+	})
+
+	t.Run("ListGoals", func(t *testing.T) {
+		_, err := store.ListGoals(ctx, "user1")
+		assert.Error(t, err)
+	})
+
+	t.Run("SetGoal", func(t *testing.T) {
+		_, err := store.SetGoal(ctx, "user1", &pbuser.Goal{Id: "goal1"})
+		assert.Error(t, err)
+
+		_, err = store.SetGoal(ctx, "user1", nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("DeleteGoal", func(t *testing.T) {
+		err := store.DeleteGoal(ctx, "user1", "goal1")
+		assert.Error(t, err)
+	})
+
 	t.Run("CreateUser", func(t *testing.T) {
 		_, err := store.CreateUser(ctx, "user1")
 		assert.Error(t, err)
 	})
 }
+
+func TestMergeGear(t *testing.T) {
+	now := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	created := timestamppb.New(now.Add(-30 * 24 * time.Hour))
+
+	t.Run("New gear starts at its initial distance", func(t *testing.T) {
+		g := mergeGear(nil, &pbuser.Gear{Id: "g1", Name: "Pegasus 40", InitialDistanceMeters: 50000}, now)
+		assert.Equal(t, 50000.0, g.DistanceMeters)
+		assert.Equal(t, now, g.CreatedAt.AsTime())
+	})
+
+	t.Run("Editing keeps tracked distance and usage", func(t *testing.T) {
+		existing := &pbuser.Gear{
+			Id:                    "g1",
+			Name:                  "Pegasus",
+			DistanceMeters:        150000,
+			InitialDistanceMeters: 50000,
+			ActivityCount:         12,
+			LastActivityId:        "act-12",
+			CreatedAt:             created,
+		}
+		g := mergeGear(existing, &pbuser.Gear{Id: "g1", Name: "Pegasus 40", InitialDistanceMeters: 80000, Retired: true}, now)
+		assert.Equal(t, 180000.0, g.DistanceMeters)
+		assert.Equal(t, int32(12), g.ActivityCount)
+		assert.Equal(t, "act-12", g.LastActivityId)
+		assert.Equal(t, created, g.CreatedAt)
+		assert.True(t, g.Retired)
+	})
+}
+
+func TestMergeGoal(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	created := timestamppb.New(now.Add(-30 * 24 * time.Hour))
+	existing := &pbuser.Goal{
+		Id:             "1000k",
+		Name:           "1000 km",
+		Metric:         pbuser.GoalMetric_GOAL_METRIC_DISTANCE,
+		Target:         1000000,
+		Progress:       620000,
+		ActivityCount:  61,
+		LastActivityId: "act-61",
+		CreatedAt:      created,
+	}
+
+	t.Run("Editing keeps progress", func(t *testing.T) {
+		g := mergeGoal(existing, &pbuser.Goal{Id: "1000k", Name: "1000 km in 2025", Metric: pbuser.GoalMetric_GOAL_METRIC_DISTANCE, Target: 1200000}, now)
+		assert.Equal(t, 620000.0, g.Progress)
+		assert.Equal(t, int32(61), g.ActivityCount)
+		assert.Equal(t, "act-61", g.LastActivityId)
+		assert.Equal(t, created, g.CreatedAt)
+		assert.Nil(t, g.CompletedAt)
+	})
+
+	t.Run("Lowering the target past progress completes the goal", func(t *testing.T) {
+		g := mergeGoal(existing, &pbuser.Goal{Id: "1000k", Metric: pbuser.GoalMetric_GOAL_METRIC_DISTANCE, Target: 500000}, now)
+		assert.Equal(t, now, g.CompletedAt.AsTime())
+	})
+
+	t.Run("Changing the metric restarts progress", func(t *testing.T) {
+		g := mergeGoal(existing, &pbuser.Goal{Id: "1000k", Metric: pbuser.GoalMetric_GOAL_METRIC_ACTIVITIES, Target: 100}, now)
+		assert.Zero(t, g.Progress)
+		assert.Zero(t, g.ActivityCount)
+		assert.Empty(t, g.LastActivityId)
+	})
+}
+
+// TestUserSubCollectionsCoverWrites scans the module for users/{id}/{name}
+// references and checks DeleteUser removes every one of them, so a new
+// per-user collection can't outlive account deletion unnoticed.
+func TestUserSubCollectionsCoverWrites(t *testing.T) {
+	// Deleted by DeleteUser together with their own sub-collections
+	deletedWithChildren := map[string]bool{"pipeline_runs": true, "pipelines": true, "gear": true}
+	deleted := map[string]bool{}
+	for _, name := range userSubCollections {
+		deleted[name] = true
+	}
+
+	found := userSubCollectionsReferenced(t, filepath.Join("..", ".."))
+	if len(found) == 0 {
+		t.Fatal("expected to find users/{id} sub-collections in the source tree")
+	}
+	for name, at := range found {
+		if !deleted[name] && !deletedWithChildren[name] {
+			t.Errorf("users/{id}/%s (%s) is not deleted by DeleteUser; add it to userSubCollections", name, at)
+		}
+	}
+}
+
+// userSubCollectionsReferenced returns the names passed to .Collection() on a
+// users/{id} document, either directly or through a variable holding one,
+// with the position of the first reference. Names given as constants are
+// resolved; names only known at run time are skipped.
+func userSubCollectionsReferenced(t *testing.T, root string) map[string]string {
+	t.Helper()
+	fset := token.NewFileSet()
+	var files []*ast.File
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") || strings.Contains(path, "/pb/") {
+			return nil
+		}
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		files = append(files, f)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("scanning source: %v", err)
+	}
+
+	consts := map[string]string{}
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			if spec, ok := n.(*ast.ValueSpec); ok {
+				for i, name := range spec.Names {
+					if i < len(spec.Values) {
+						if s, ok := stringLit(spec.Values[i]); ok {
+							consts[name.Name] = s
+						}
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	found := map[string]string{}
+	for _, f := range files {
+		userDocs := map[string]bool{}
+		ast.Inspect(f, func(n ast.Node) bool {
+			// Variables are only tracked within the function assigning them
+			if _, ok := n.(*ast.FuncDecl); ok {
+				userDocs = map[string]bool{}
+			}
+			if assign, ok := n.(*ast.AssignStmt); ok {
+				for i, rhs := range assign.Rhs {
+					if isUserDoc(rhs) && i < len(assign.Lhs) {
+						if id, ok := assign.Lhs[i].(*ast.Ident); ok {
+							userDocs[id.Name] = true
+						}
+					}
+				}
+			}
+			arg, ok := collectionCall(n)
+			if !ok {
+				return true
+			}
+			parent := n.(*ast.CallExpr).Fun.(*ast.SelectorExpr).X
+			if id, isIdent := parent.(*ast.Ident); !isUserDoc(parent) && !(isIdent && userDocs[id.Name]) {
+				return true
+			}
+			name, ok := stringLit(arg)
+			if id, isIdent := arg.(*ast.Ident); !ok && isIdent {
+				name, ok = consts[id.Name]
+			}
+			if ok {
+				if _, seen := found[name]; !seen {
+					found[name] = fset.Position(n.Pos()).String()
+				}
+			}
+			return true
+		})
+	}
+	return found
+}
+
+// collectionCall matches x.Collection(arg) and returns arg.
+func collectionCall(n ast.Node) (ast.Expr, bool) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return nil, false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Collection" {
+		return nil, false
+	}
+	return call.Args[0], true
+}
+
+// isUserDoc matches x.Collection("users").Doc(id).
+func isUserDoc(e ast.Expr) bool {
+	call, ok := e.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Doc" {
+		return false
+	}
+	arg, ok := collectionCall(sel.X)
+	if !ok {
+		return false
+	}
+	name, ok := stringLit(arg)
+	return ok && name == "users"
+}
+
+func stringLit(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	firebaseAuth "firebase.google.com/go/v4/auth" // Renamed to avoid conflict with local auth package
 	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/pkg/description"
 	"github.com/fitglue/server/src/go/pkg/domain/email"
 	emailsender "github.com/fitglue/server/src/go/pkg/infrastructure/email" // New import
+	storage "github.com/fitglue/server/src/go/pkg/storage/firestore"
 	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
 	"google.golang.org/api/iterator"
@@ -25,6 +28,7 @@ type UserIterator interface {
 
 type AuthClient interface {
 	GetUser(ctx context.Context, uid string) (*firebaseAuth.UserRecord, error)
+	GetUserByEmail(ctx context.Context, email string) (*firebaseAuth.UserRecord, error)
 	EmailVerificationLinkWithSettings(ctx context.Context, email string, settings *firebaseAuth.ActionCodeSettings) (string, error)
 	PasswordResetLinkWithSettings(ctx context.Context, email string, settings *firebaseAuth.ActionCodeSettings) (string, error)
 	Users(ctx context.Context, nextPageToken string) UserIterator
@@ -70,6 +74,9 @@ func (s *Service) UpdateProfile(ctx context.Context, req *pbsvc.UpdateProfileReq
 	if req.UserId == "" || req.Profile == nil {
 		return nil, status.Error(codes.InvalidArgument, "user_id and profile are required")
 	}
+	if err := description.ValidateHeaderPreferences(req.Profile.GetDescriptionHeaders()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	err := s.store.UpdateProfile(ctx, req.UserId, req.Profile)
 	if err != nil {
@@ -156,6 +163,23 @@ func (s *Service) DeleteIntegration(ctx context.Context, req *pbsvc.DeleteIntegr
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) GetIntegrationSecret(ctx context.Context, req *pbsvc.GetIntegrationSecretRequest) (*pbsvc.GetIntegrationSecretResponse, error) {
+	if req.UserId == "" || req.Provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and provider are required")
+	}
+	if _, ok := storage.IntegrationSecretField(req.Provider); !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "provider %q has no integration secret", req.Provider)
+	}
+
+	secret, err := s.store.GetIntegrationSecret(ctx, req.UserId, req.Provider)
+	if err != nil {
+		s.logger.Error(ctx, "failed to get integration secret", "err", err, "user_id", req.UserId, "provider", req.Provider)
+		return nil, status.Error(codes.Internal, "failed to get integration secret")
+	}
+
+	return &pbsvc.GetIntegrationSecretResponse{Secret: secret}, nil
+}
+
 func (s *Service) ListIntegrations(ctx context.Context, req *pbsvc.ListIntegrationsRequest) (*pbuser.UserIntegrations, error) {
 	if req.UserId == "" {
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
@@ -347,6 +371,119 @@ func (s *Service) DeletePersonalRecord(ctx context.Context, req *pbsvc.DeletePer
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) ListGear(ctx context.Context, req *pbsvc.ListGearRequest) (*pbsvc.ListGearResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	gear, err := s.store.ListGear(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list gear", "err", err, "user_id", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to list gear")
+	}
+
+	return &pbsvc.ListGearResponse{Gear: gear}, nil
+}
+
+func (s *Service) SetGear(ctx context.Context, req *pbsvc.SetGearRequest) (*pbuser.Gear, error) {
+	if req.UserId == "" || req.GearId == "" || req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id, gear_id, and name are required")
+	}
+	if req.Type == pbuser.GearType_GEAR_TYPE_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "type is required")
+	}
+	if req.InitialDistanceMeters < 0 {
+		return nil, status.Error(codes.InvalidArgument, "initial_distance_meters cannot be negative")
+	}
+
+	gear, err := s.store.SetGear(ctx, req.UserId, &pbuser.Gear{
+		Id:                    req.GearId,
+		Name:                  req.Name,
+		Type:                  req.Type,
+		InitialDistanceMeters: req.InitialDistanceMeters,
+		Retired:               req.Retired,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "failed to set gear", "err", err, "user_id", req.UserId, "gear_id", req.GearId)
+		return nil, status.Error(codes.Internal, "failed to set gear")
+	}
+
+	return gear, nil
+}
+
+func (s *Service) DeleteGear(ctx context.Context, req *pbsvc.DeleteGearRequest) (*emptypb.Empty, error) {
+	if req.UserId == "" || req.GearId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and gear_id are required")
+	}
+
+	err := s.store.DeleteGear(ctx, req.UserId, req.GearId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to delete gear", "err", err, "user_id", req.UserId, "gear_id", req.GearId)
+		return nil, status.Error(codes.Internal, "failed to delete gear")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) ListGoals(ctx context.Context, req *pbsvc.ListGoalsRequest) (*pbsvc.ListGoalsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	goals, err := s.store.ListGoals(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list goals", "err", err, "user_id", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to list goals")
+	}
+
+	return &pbsvc.ListGoalsResponse{Goals: goals}, nil
+}
+
+func (s *Service) SetGoal(ctx context.Context, req *pbsvc.SetGoalRequest) (*pbuser.Goal, error) {
+	if req.UserId == "" || req.GoalId == "" || req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id, goal_id, and name are required")
+	}
+	if req.Metric == pbuser.GoalMetric_GOAL_METRIC_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "metric is required")
+	}
+	if req.Target <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "target must be positive")
+	}
+	if req.StartDate != nil && req.EndDate != nil && !req.EndDate.AsTime().After(req.StartDate.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "end_date must be after start_date")
+	}
+
+	goal, err := s.store.SetGoal(ctx, req.UserId, &pbuser.Goal{
+		Id:           req.GoalId,
+		Name:         req.Name,
+		Metric:       req.Metric,
+		Target:       req.Target,
+		ActivityType: req.ActivityType,
+		StartDate:    req.StartDate,
+		EndDate:      req.EndDate,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "failed to set goal", "err", err, "user_id", req.UserId, "goal_id", req.GoalId)
+		return nil, status.Error(codes.Internal, "failed to set goal")
+	}
+
+	return goal, nil
+}
+
+func (s *Service) DeleteGoal(ctx context.Context, req *pbsvc.DeleteGoalRequest) (*emptypb.Empty, error) {
+	if req.UserId == "" || req.GoalId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and goal_id are required")
+	}
+
+	err := s.store.DeleteGoal(ctx, req.UserId, req.GoalId)
+	if err != nil {
+		s.logger.Error(ctx, "failed to delete goal", "err", err, "user_id", req.UserId, "goal_id", req.GoalId)
+		return nil, status.Error(codes.Internal, "failed to delete goal")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *Service) ListPluginDefaults(ctx context.Context, req *pbsvc.ListPluginDefaultsRequest) (*pbsvc.ListPluginDefaultsResponse, error) {
 	if req.UserId == "" {
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
@@ -646,3 +783,67 @@ func (s *Service) ListUsers(ctx context.Context, req *pbsvc.ListUsersRequest) (*
 		NextPageToken: nextToken,
 	}, nil
 }
+
+// SearchUsers finds users by sign-in email or by their user ID on a
+// connected platform. A search with no match returns no users, not an error.
+func (s *Service) SearchUsers(ctx context.Context, req *pbsvc.SearchUsersRequest) (*pbsvc.SearchUsersResponse, error) {
+	email := strings.TrimSpace(req.Email)
+	if email == "" && (req.Provider == "" || req.ProviderUid == "") {
+		return nil, status.Error(codes.InvalidArgument, "email, or provider and provider_uid, are required")
+	}
+
+	seen := map[string]bool{}
+	var users []*pbuser.UserProfile
+	add := func(profile *pbuser.UserProfile, record *firebaseAuth.UserRecord) {
+		if seen[profile.UserId] {
+			return
+		}
+		seen[profile.UserId] = true
+		if record != nil && record.UserInfo != nil {
+			profile.Email = record.Email
+			profile.DisplayName = record.DisplayName
+		}
+		users = append(users, profile)
+	}
+
+	if email != "" {
+		record, err := s.authClient.GetUserByEmail(ctx, email)
+		switch {
+		case firebaseAuth.IsUserNotFound(err):
+		case err != nil:
+			s.logger.Error(ctx, "failed to look up user by email", "err", err)
+			return nil, status.Error(codes.Internal, "failed to search users")
+		default:
+			profile, err := s.store.GetProfile(ctx, record.UID)
+			if err != nil {
+				s.logger.Error(ctx, "failed to get profile", "err", err, "user_id", record.UID)
+				return nil, status.Error(codes.Internal, "failed to search users")
+			}
+			if profile == nil {
+				// Signed up but never onboarded
+				profile = &pbuser.UserProfile{UserId: record.UID, Tier: pbuser.UserTier_USER_TIER_HOBBYIST}
+			}
+			add(profile, record)
+		}
+	}
+
+	if req.Provider != "" && req.ProviderUid != "" {
+		profile, err := s.store.FindUserByIntegration(ctx, req.Provider, req.ProviderUid)
+		switch {
+		case status.Code(err) == codes.NotFound:
+		case status.Code(err) == codes.InvalidArgument:
+			return nil, err
+		case err != nil:
+			s.logger.Error(ctx, "failed to resolve user by integration", "err", err, "provider", req.Provider)
+			return nil, status.Error(codes.Internal, "failed to search users")
+		default:
+			record, err := s.authClient.GetUser(ctx, profile.UserId)
+			if err != nil {
+				s.logger.Warn(ctx, "failed to get auth user", "err", err, "user_id", profile.UserId)
+			}
+			add(profile, record)
+		}
+	}
+
+	return &pbsvc.SearchUsersResponse{Users: users}, nil
+}
@@ -83,6 +83,13 @@ func (m *mockStore) DeleteIntegration(ctx context.Context, userID string, provid
 	return m.err
 }
 
+func (m *mockStore) GetIntegrationSecret(ctx context.Context, userID string, provider string) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	return "secret-" + provider, nil
+}
+
 func (m *mockStore) ListCounters(ctx context.Context, userID string) ([]*pbuser.Counter, error) {
 	if m.err != nil {
 		return nil, m.err
@@ -127,6 +134,42 @@ func (m *mockStore) DeletePersonalRecord(ctx context.Context, userID, recordType
 	return m.err
 }
 
+func (m *mockStore) ListGear(ctx context.Context, userID string) ([]*pbuser.Gear, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return []*pbuser.Gear{}, nil
+}
+
+func (m *mockStore) SetGear(ctx context.Context, userID string, gear *pbuser.Gear) (*pbuser.Gear, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return gear, nil
+}
+
+func (m *mockStore) DeleteGear(ctx context.Context, userID, gearID string) error {
+	return m.err
+}
+
+func (m *mockStore) ListGoals(ctx context.Context, userID string) ([]*pbuser.Goal, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return []*pbuser.Goal{}, nil
+}
+
+func (m *mockStore) SetGoal(ctx context.Context, userID string, goal *pbuser.Goal) (*pbuser.Goal, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return goal, nil
+}
+
+func (m *mockStore) DeleteGoal(ctx context.Context, userID, goalID string) error {
+	return m.err
+}
+
 func (m *mockStore) ListPluginDefaults(ctx context.Context, userID string) (map[string]*structpb.Struct, error) {
 	if m.err != nil {
 		return nil, m.err
@@ -186,6 +229,17 @@ func (m *mockAuthClient) GetUser(ctx context.Context, uid string) (*firebaseAuth
 	return m.userRecord, nil
 }
 
+func (m *mockAuthClient) GetUserByEmail(ctx context.Context, email string) (*firebaseAuth.UserRecord, error) {
+	m.lastEmail = email
+	if m.err != nil {
+		return nil, m.err
+	}
+	if m.userRecord == nil {
+		return &firebaseAuth.UserRecord{UserInfo: &firebaseAuth.UserInfo{UID: "user123", Email: email}}, nil
+	}
+	return m.userRecord, nil
+}
+
 func (m *mockAuthClient) EmailVerificationLinkWithSettings(ctx context.Context, email string, settings *firebaseAuth.ActionCodeSettings) (string, error) {
 	m.lastEmail = email
 	return "https://mock.link", m.linkErr
@@ -539,6 +593,20 @@ func TestUpdateProfile(t *testing.T) {
 		assert.Equal(t, codes.InvalidArgument, status.Code(err))
 	})
 
+	t.Run("InvalidHeaderText", func(t *testing.T) {
+		req := &pbsvc.UpdateProfileRequest{
+			UserId: "user123",
+			Profile: &pbuser.UserProfile{
+				DescriptionHeaders: &pbuser.DescriptionHeaderPreferences{
+					CustomText: map[string]string{"not_a_section": "Hello"},
+				},
+			},
+		}
+		_, err := svc.UpdateProfile(context.Background(), req)
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
 	t.Run("StoreError", func(t *testing.T) {
 		store.err = errors.New("db error")
 		req := &pbsvc.UpdateProfileRequest{
@@ -599,6 +667,51 @@ func TestListUsers(t *testing.T) {
 	})
 }
 
+func TestSearchUsers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("NoCriteria", func(t *testing.T) {
+		svc, _, _, _ := setupTest()
+		_, err := svc.SearchUsers(ctx, &pbsvc.SearchUsersRequest{Provider: "strava"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ByEmail", func(t *testing.T) {
+		svc, store, _, auth := setupTest()
+		store.profile = &pbuser.UserProfile{UserId: "user123", Tier: pbuser.UserTier_USER_TIER_ATHLETE}
+		res, err := svc.SearchUsers(ctx, &pbsvc.SearchUsersRequest{Email: " sam@example.com "})
+		assert.NoError(t, err)
+		assert.Equal(t, "sam@example.com", auth.lastEmail)
+		if assert.Len(t, res.Users, 1) {
+			assert.Equal(t, "sam@example.com", res.Users[0].Email)
+			assert.Equal(t, pbuser.UserTier_USER_TIER_ATHLETE, res.Users[0].Tier)
+		}
+	})
+
+	t.Run("EmailLookupError", func(t *testing.T) {
+		svc, _, _, auth := setupTest()
+		auth.err = errors.New("auth unavailable")
+		_, err := svc.SearchUsers(ctx, &pbsvc.SearchUsersRequest{Email: "sam@example.com"})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("ByAthleteIDDeduplicatesEmailMatch", func(t *testing.T) {
+		svc, store, _, _ := setupTest()
+		store.profile = &pbuser.UserProfile{UserId: "user123"}
+		res, err := svc.SearchUsers(ctx, &pbsvc.SearchUsersRequest{Email: "sam@example.com", Provider: "strava", ProviderUid: "42"})
+		assert.NoError(t, err)
+		assert.Len(t, res.Users, 1)
+	})
+
+	t.Run("AthleteIDNotFound", func(t *testing.T) {
+		svc, store, _, _ := setupTest()
+		store.err = status.Error(codes.NotFound, "user not found for integration")
+		res, err := svc.SearchUsers(ctx, &pbsvc.SearchUsersRequest{Provider: "strava", ProviderUid: "42"})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Users)
+	})
+}
+
 func TestCreateUser(t *testing.T) {
 	svc, store, _, _ := setupTest()
 
@@ -680,6 +793,27 @@ func TestIntegrationRPCs(t *testing.T) {
 		assert.NotNil(t, resp.Integrations)
 	})
 
+	t.Run("GetIntegrationSecret_NotApiKeyProvider", func(t *testing.T) {
+		req := &pbsvc.GetIntegrationSecretRequest{UserId: "user123", Provider: "strava"}
+		_, err := svc.GetIntegrationSecret(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("GetIntegrationSecret_StoreError", func(t *testing.T) {
+		store.err = errors.New("db error")
+		req := &pbsvc.GetIntegrationSecretRequest{UserId: "user123", Provider: "hevy"}
+		_, err := svc.GetIntegrationSecret(context.Background(), req)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		store.err = nil
+	})
+
+	t.Run("GetIntegrationSecret_Success", func(t *testing.T) {
+		req := &pbsvc.GetIntegrationSecretRequest{UserId: "user123", Provider: "intervals"}
+		resp, err := svc.GetIntegrationSecret(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, "secret-intervals", resp.Secret)
+	})
+
 	t.Run("SetIntegration_EmptyUserId", func(t *testing.T) {
 		req := &pbsvc.SetIntegrationRequest{}
 		_, err := svc.SetIntegration(context.Background(), req)
@@ -879,6 +1013,122 @@ func TestCounterRPCs(t *testing.T) {
 	})
 }
 
+func TestGearRPCs(t *testing.T) {
+	svc, store, _, _ := setupTest()
+
+	t.Run("ListGear_EmptyUserId", func(t *testing.T) {
+		_, err := svc.ListGear(context.Background(), &pbsvc.ListGearRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ListGear_Success", func(t *testing.T) {
+		resp, err := svc.ListGear(context.Background(), &pbsvc.ListGearRequest{UserId: "user123"})
+		assert.NoError(t, err)
+		assert.NotNil(t, resp.Gear)
+	})
+
+	t.Run("SetGear_MissingType", func(t *testing.T) {
+		req := &pbsvc.SetGearRequest{UserId: "user123", GearId: "pegasus", Name: "Pegasus 40"}
+		_, err := svc.SetGear(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("SetGear_NegativeDistance", func(t *testing.T) {
+		req := &pbsvc.SetGearRequest{UserId: "user123", GearId: "pegasus", Name: "Pegasus 40", Type: pbuser.GearType_GEAR_TYPE_SHOES, InitialDistanceMeters: -1}
+		_, err := svc.SetGear(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("SetGear_StoreError", func(t *testing.T) {
+		store.err = errors.New("db error")
+		req := &pbsvc.SetGearRequest{UserId: "user123", GearId: "pegasus", Name: "Pegasus 40", Type: pbuser.GearType_GEAR_TYPE_SHOES}
+		_, err := svc.SetGear(context.Background(), req)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		store.err = nil
+	})
+
+	t.Run("SetGear_Success", func(t *testing.T) {
+		req := &pbsvc.SetGearRequest{UserId: "user123", GearId: "pegasus", Name: "Pegasus 40", Type: pbuser.GearType_GEAR_TYPE_SHOES, InitialDistanceMeters: 50000}
+		resp, err := svc.SetGear(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, "pegasus", resp.Id)
+		assert.Equal(t, 50000.0, resp.InitialDistanceMeters)
+	})
+
+	t.Run("DeleteGear_EmptyGearId", func(t *testing.T) {
+		_, err := svc.DeleteGear(context.Background(), &pbsvc.DeleteGearRequest{UserId: "user123"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("DeleteGear_Success", func(t *testing.T) {
+		_, err := svc.DeleteGear(context.Background(), &pbsvc.DeleteGearRequest{UserId: "user123", GearId: "pegasus"})
+		assert.NoError(t, err)
+	})
+}
+
+func TestGoalRPCs(t *testing.T) {
+	svc, store, _, _ := setupTest()
+
+	t.Run("ListGoals_EmptyUserId", func(t *testing.T) {
+		_, err := svc.ListGoals(context.Background(), &pbsvc.ListGoalsRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ListGoals_Success", func(t *testing.T) {
+		resp, err := svc.ListGoals(context.Background(), &pbsvc.ListGoalsRequest{UserId: "user123"})
+		assert.NoError(t, err)
+		assert.NotNil(t, resp.Goals)
+	})
+
+	t.Run("SetGoal_MissingMetric", func(t *testing.T) {
+		req := &pbsvc.SetGoalRequest{UserId: "user123", GoalId: "1000k", Name: "1000 km in 2025", Target: 1000000}
+		_, err := svc.SetGoal(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("SetGoal_NonPositiveTarget", func(t *testing.T) {
+		req := &pbsvc.SetGoalRequest{UserId: "user123", GoalId: "1000k", Name: "1000 km in 2025", Metric: pbuser.GoalMetric_GOAL_METRIC_DISTANCE}
+		_, err := svc.SetGoal(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("SetGoal_EndBeforeStart", func(t *testing.T) {
+		req := &pbsvc.SetGoalRequest{
+			UserId: "user123", GoalId: "1000k", Name: "1000 km in 2025", Metric: pbuser.GoalMetric_GOAL_METRIC_DISTANCE, Target: 1000000,
+			StartDate: timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+			EndDate:   timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		}
+		_, err := svc.SetGoal(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("SetGoal_StoreError", func(t *testing.T) {
+		store.err = errors.New("db error")
+		req := &pbsvc.SetGoalRequest{UserId: "user123", GoalId: "100-workouts", Name: "100 workouts", Metric: pbuser.GoalMetric_GOAL_METRIC_ACTIVITIES, Target: 100}
+		_, err := svc.SetGoal(context.Background(), req)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		store.err = nil
+	})
+
+	t.Run("SetGoal_Success", func(t *testing.T) {
+		req := &pbsvc.SetGoalRequest{UserId: "user123", GoalId: "100-workouts", Name: "100 workouts", Metric: pbuser.GoalMetric_GOAL_METRIC_ACTIVITIES, Target: 100}
+		resp, err := svc.SetGoal(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, "100-workouts", resp.Id)
+		assert.Equal(t, 100.0, resp.Target)
+	})
+
+	t.Run("DeleteGoal_EmptyGoalId", func(t *testing.T) {
+		_, err := svc.DeleteGoal(context.Background(), &pbsvc.DeleteGoalRequest{UserId: "user123"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("DeleteGoal_Success", func(t *testing.T) {
+		_, err := svc.DeleteGoal(context.Background(), &pbsvc.DeleteGoalRequest{UserId: "user123", GoalId: "100-workouts"})
+		assert.NoError(t, err)
+	})
+}
+
 func TestNotificationPrefsRPCs(t *testing.T) {
 	svc, store, _, _ := setupTest()
 
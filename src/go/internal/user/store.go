@@ -19,6 +19,8 @@ type Store interface {
 	GetIntegrations(ctx context.Context, userID string) (*pbuser.UserIntegrations, error)
 	SetIntegration(ctx context.Context, userID, provider string, data interface{}) error
 	DeleteIntegration(ctx context.Context, userID, provider string) error
+	// GetIntegrationSecret returns "" when the user has no secret for the provider
+	GetIntegrationSecret(ctx context.Context, userID, provider string) (string, error)
 	FindUserByIntegration(ctx context.Context, provider string, providerUID string) (*pbuser.UserProfile, error)
 
 	ListCounters(ctx context.Context, userID string) ([]*pbuser.Counter, error)
@@ -37,6 +39,14 @@ type Store interface {
 	SetPersonalRecord(ctx context.Context, userID, recordType string, record *pbuser.PersonalRecord) error
 	DeletePersonalRecord(ctx context.Context, userID, recordType string) error
 
+	ListGear(ctx context.Context, userID string) ([]*pbuser.Gear, error)
+	SetGear(ctx context.Context, userID string, gear *pbuser.Gear) (*pbuser.Gear, error)
+	DeleteGear(ctx context.Context, userID, gearID string) error
+
+	ListGoals(ctx context.Context, userID string) ([]*pbuser.Goal, error)
+	SetGoal(ctx context.Context, userID string, goal *pbuser.Goal) (*pbuser.Goal, error)
+	DeleteGoal(ctx context.Context, userID, goalID string) error
+
 	ListPluginDefaults(ctx context.Context, userID string) (map[string]*structpb.Struct, error)
 	SetPluginDefaults(ctx context.Context, userID, pluginID string, defaults *structpb.Struct) error
 	DeletePluginDefaults(ctx context.Context, userID, pluginID string) error
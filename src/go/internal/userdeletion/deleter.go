@@ -0,0 +1,230 @@
+// nolint:proto-json
+package userdeletion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/oauth"
+	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+// RequestedBySelf marks a deletion the user asked for themselves.
+const RequestedBySelf = "self"
+
+// artifactPrefixes are the per-user folders of the artifacts bucket, each
+// followed by the user ID: stored payloads, FIT files, deferred and enriched
+// events, exports, and the activity data offloaded from showcases.
+var artifactPrefixes = []string{"payloads", "activities", "deferred", "enriched_events", "exports", "showcase_data"}
+
+// showcaseAssetPrefixes are the per-user folders of the showcase assets
+// bucket, each followed by the user ID: profile pictures and feeds. Banners
+// and thumbnails are kept per activity instead; see ShowcaseStore.
+var showcaseAssetPrefixes = []string{"showcase_pictures", "showcase_feeds"}
+
+// Publisher defines the contract for publishing events (e.g., to Pub/Sub).
+type Publisher interface {
+	PublishCloudEvent(ctx context.Context, topic string, ce cloudevents.Event) (string, error)
+}
+
+// RequestDeletion publishes the event that makes the destination service
+// delete the user and everything held for them.
+func RequestDeletion(ctx context.Context, publisher Publisher, userID, requestedBy string) error {
+	ce, err := infrapubsub.NewCloudEvent(
+		infrapubsub.GetCloudEventSource(pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_USER_DELETION),
+		infrapubsub.GetCloudEventType(pbevents.CloudEventType_CLOUD_EVENT_TYPE_USER_DELETION_REQUESTED),
+		&pbevents.UserDeletionRequestedEvent{UserId: userID, RequestedBy: requestedBy},
+	)
+	if err != nil {
+		return fmt.Errorf("create cloud event: %w", err)
+	}
+	if _, err := publisher.PublishCloudEvent(ctx, shared.TopicUserDeletionRequested, ce); err != nil {
+		return fmt.Errorf("publish: %w", err)
+	}
+	return nil
+}
+
+// UserReader loads the user with their decrypted integrations.
+type UserReader interface {
+	GetUser(ctx context.Context, id string) (*user.Record, error)
+}
+
+// BlobStore deletes a user's stored artifacts.
+type BlobStore interface {
+	DeletePrefix(ctx context.Context, bucket, prefix string) (int, error)
+}
+
+// TokenFunc returns a valid OAuth token for one of the user's providers,
+// refreshing it if it has expired.
+type TokenFunc func(ctx context.Context, userID, provider string) (*oauth.Token, error)
+
+// Deleter removes a user and everything held for them: it revokes our
+// authorization at their OAuth providers, deletes their stored artifacts and
+// public showcase assets,
+// has the user service delete their Firestore data, and records an audit.
+type Deleter struct {
+	users        UserReader
+	tokens       TokenFunc
+	httpClient   *http.Client
+	blobs        BlobStore
+	bucket       string
+	showcases    ShowcaseStore
+	assetsBucket string
+	userSvc      userpb.UserServiceClient
+	audit        AuditStore
+	logger       infra.Logger
+}
+
+func NewDeleter(users UserReader, tokens TokenFunc, blobs BlobStore, bucket string, showcases ShowcaseStore, assetsBucket string, userSvc userpb.UserServiceClient, audit AuditStore, logger infra.Logger) *Deleter {
+	return &Deleter{
+		users:        users,
+		tokens:       tokens,
+		httpClient:   &http.Client{Timeout: 15 * time.Second},
+		blobs:        blobs,
+		bucket:       bucket,
+		showcases:    showcases,
+		assetsBucket: assetsBucket,
+		userSvc:      userSvc,
+		audit:        audit,
+		logger:       logger,
+	}
+}
+
+// HandlePubSubPush unwraps a Pub/Sub push envelope carrying a UserDeletionRequestedEvent
+func (d *Deleter) HandlePubSubPush(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		d.logger.Error(ctx, "Failed to read request body", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	var msg struct {
+		Message struct {
+			Data []byte `json:"data"`
+			ID   string `json:"messageId"`
+		} `json:"message"`
+	}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		d.logger.Error(ctx, "Failed to unmarshal pub/sub envelope", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	var ce event.Event
+	if err := json.Unmarshal(msg.Message.Data, &ce); err != nil {
+		d.logger.Error(ctx, "Failed to unmarshal inner CloudEvent", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	if err := d.Process(ctx, &ce); err != nil {
+		d.logger.Error(ctx, "Failed to delete user", "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "OK")
+}
+
+// Process unmarshals a UserDeletionRequestedEvent and runs the deletion.
+// Returned errors are retried by Pub/Sub.
+func (d *Deleter) Process(ctx context.Context, ce *event.Event) error {
+	var req pbevents.UserDeletionRequestedEvent
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(ce.Data(), &req); err != nil {
+		d.logger.Error(ctx, "Failed to unmarshal UserDeletionRequestedEvent", "error", err)
+		return nil // Ack malformed payloads
+	}
+	return d.Delete(ctx, req.UserId, req.RequestedBy)
+}
+
+// Delete runs the deletion. Every step is safe to repeat, so a retry after a
+// failure part way through finishes the job. Revocation failures don't stop
+// it: the tokens are deleted with the user either way.
+func (d *Deleter) Delete(ctx context.Context, userID, requestedBy string) error {
+	if userID == "" {
+		d.logger.Warn(ctx, "User deletion requested without a user")
+		return nil
+	}
+	audit := &Audit{UserID: userID, RequestedBy: requestedBy}
+
+	// 1. Provider authorizations, while the tokens still exist. A user
+	// already gone is a retry after the Firestore step.
+	record, err := d.users.GetUser(ctx, userID)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return fmt.Errorf("getting user: %w", err)
+	}
+	if record != nil {
+		for _, provider := range linkedProviders(record.Integrations) {
+			if err := d.revoke(ctx, userID, provider, record.Integrations); err != nil {
+				d.logger.Warn(ctx, "Failed to revoke provider authorization", "user_id", userID, "provider", provider, "error", err)
+				audit.RevokeFailed = append(audit.RevokeFailed, provider)
+				continue
+			}
+			audit.Revoked = append(audit.Revoked, provider)
+		}
+	}
+
+	// 2. Stored artifacts
+	for _, prefix := range artifactPrefixes {
+		n, err := d.blobs.DeletePrefix(ctx, d.bucket, prefix+"/"+userID+"/")
+		if err != nil {
+			return fmt.Errorf("deleting %s artifacts: %w", prefix, err)
+		}
+		audit.ObjectsDeleted += n
+	}
+
+	// 3. Showcase assets. The per-activity folders are found through the
+	// showcase records, so this also has to run before the Firestore step.
+	prefixes := make([]string, 0, len(showcaseAssetPrefixes))
+	for _, prefix := range showcaseAssetPrefixes {
+		prefixes = append(prefixes, prefix+"/"+userID+"/")
+	}
+	folders, err := d.showcases.ShowcaseAssetFolders(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("listing showcase asset folders: %w", err)
+	}
+	for _, folder := range folders {
+		prefixes = append(prefixes, folder+"/")
+	}
+	for _, prefix := range prefixes {
+		n, err := d.blobs.DeletePrefix(ctx, d.assetsBucket, prefix)
+		if err != nil {
+			return fmt.Errorf("deleting showcase assets %s: %w", prefix, err)
+		}
+		audit.ObjectsDeleted += n
+	}
+
+	// 4. Firestore, last, as it holds the tokens, run references and showcase
+	// records above
+	if _, err := d.userSvc.DeleteUser(ctx, &userpb.DeleteUserRequest{UserId: userID}); err != nil {
+		return fmt.Errorf("deleting user data: %w", err)
+	}
+
+	audit.CompletedAt = time.Now()
+	if err := d.audit.WriteAudit(ctx, audit); err != nil {
+		return fmt.Errorf("writing audit: %w", err)
+	}
+
+	d.logger.Info(ctx, "User deleted", "user_id", userID, "requested_by", requestedBy,
+		"revoked", audit.Revoked, "revoke_failed", audit.RevokeFailed, "objects_deleted", audit.ObjectsDeleted)
+	return nil
+}
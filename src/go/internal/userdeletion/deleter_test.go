@@ -0,0 +1,153 @@
+package userdeletion
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/oauth"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+type mockUsers struct {
+	record *user.Record
+	err    error
+}
+
+func (m *mockUsers) GetUser(ctx context.Context, id string) (*user.Record, error) {
+	return m.record, m.err
+}
+
+type mockBlobs struct {
+	prefixes []string
+}
+
+func (m *mockBlobs) DeletePrefix(ctx context.Context, bucket, prefix string) (int, error) {
+	m.prefixes = append(m.prefixes, bucket+"/"+prefix)
+	return 2, nil
+}
+
+type mockShowcases struct {
+	folders []string
+}
+
+func (m *mockShowcases) ShowcaseAssetFolders(ctx context.Context, userID string) ([]string, error) {
+	return m.folders, nil
+}
+
+type mockUserService struct {
+	userpb.UserServiceClient
+	deleted []string
+}
+
+func (m *mockUserService) DeleteUser(ctx context.Context, in *userpb.DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.deleted = append(m.deleted, in.UserId)
+	return &emptypb.Empty{}, nil
+}
+
+type mockAudit struct {
+	audits []*Audit
+}
+
+func (m *mockAudit) WriteAudit(ctx context.Context, audit *Audit) error {
+	m.audits = append(m.audits, audit)
+	return nil
+}
+
+func TestDelete(t *testing.T) {
+	var revoked []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		revoked = append(revoked, r.Method+" "+r.URL.Path)
+		if strings.HasPrefix(r.URL.Path, "/fitbit") {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+	for _, p := range []string{"strava", "fitbit"} {
+		orig := revokeURLs[p]
+		revokeURLs[p] = srv.URL + "/" + p
+		defer func(p string) { revokeURLs[p] = orig }(p)
+	}
+
+	users := &mockUsers{record: &user.Record{
+		UserProfile: &pbuser.UserProfile{UserId: "u1"},
+		Integrations: &pbuser.UserIntegrations{
+			Strava:  &pbuser.StravaIntegration{Enabled: true},
+			Fitbit:  &pbuser.FitbitIntegration{Enabled: true},
+			Spotify: &pbuser.SpotifyIntegration{Enabled: true},
+			Google:  &pbuser.GoogleIntegration{Enabled: false},
+		},
+	}}
+	tokens := func(ctx context.Context, userID, provider string) (*oauth.Token, error) {
+		return &oauth.Token{AccessToken: provider + "-token"}, nil
+	}
+	blobs := &mockBlobs{}
+	userSvc := &mockUserService{}
+	audit := &mockAudit{}
+	d := NewDeleter(users, tokens, blobs, "artifacts", &mockShowcases{folders: []string{"exec-1"}}, "assets", userSvc, audit, infra.NewLogger())
+
+	if err := d.Delete(context.Background(), "u1", RequestedBySelf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Spotify has no revocation endpoint and Google is disconnected
+	if strings.Join(revoked, ",") != "POST /fitbit,POST /strava" {
+		t.Errorf("unexpected revocations: %v", revoked)
+	}
+	expected := []string{
+		"artifacts/payloads/u1/",
+		"artifacts/activities/u1/",
+		"artifacts/deferred/u1/",
+		"artifacts/enriched_events/u1/",
+		"artifacts/exports/u1/",
+		"artifacts/showcase_data/u1/",
+		"assets/showcase_pictures/u1/",
+		"assets/showcase_feeds/u1/",
+		"assets/exec-1/",
+	}
+	if strings.Join(blobs.prefixes, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected deletions %v, got %v", expected, blobs.prefixes)
+	}
+	if len(userSvc.deleted) != 1 || userSvc.deleted[0] != "u1" {
+		t.Errorf("expected user data deleted, got %v", userSvc.deleted)
+	}
+
+	if len(audit.audits) != 1 {
+		t.Fatalf("expected one audit record, got %d", len(audit.audits))
+	}
+	a := audit.audits[0]
+	if a.RequestedBy != "self" || a.ObjectsDeleted != 2*len(expected) || a.CompletedAt.IsZero() {
+		t.Errorf("unexpected audit: %+v", a)
+	}
+	if strings.Join(a.Revoked, ",") != "strava" || strings.Join(a.RevokeFailed, ",") != "fitbit" {
+		t.Errorf("expected strava revoked and fitbit failed, got %v / %v", a.Revoked, a.RevokeFailed)
+	}
+}
+
+func TestDelete_RetryAfterUserGone(t *testing.T) {
+	users := &mockUsers{err: status.Error(codes.NotFound, "not found")}
+	tokens := func(ctx context.Context, userID, provider string) (*oauth.Token, error) {
+		t.Fatal("no tokens should be requested for a deleted user")
+		return nil, nil
+	}
+	userSvc := &mockUserService{}
+	audit := &mockAudit{}
+	d := NewDeleter(users, tokens, &mockBlobs{}, "artifacts", &mockShowcases{}, "assets", userSvc, audit, infra.NewLogger())
+
+	if err := d.Delete(context.Background(), "u1", "admin:a1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(userSvc.deleted) != 1 || len(audit.audits) != 1 {
+		t.Error("expected the deletion to finish")
+	}
+}
@@ -0,0 +1,162 @@
+package userdeletion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/fitglue/server/src/go/pkg/infrastructure/oauth"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// revokeURLs are the endpoints that end our authorization at each provider.
+// Providers without one (Komoot, Spotify, TrainingPeaks) are left out; their
+// tokens are deleted with the user and expire on their own.
+var revokeURLs = map[string]string{
+	"strava":  "https://www.strava.com/oauth/deauthorize",
+	"fitbit":  "https://api.fitbit.com/oauth2/revoke",
+	"google":  "https://oauth2.googleapis.com/revoke",
+	"github":  "https://api.github.com/applications/%s/grant",
+	"polar":   "https://www.polaraccesslink.com/v3/users/%s",
+	"dropbox": "https://api.dropboxapi.com/2/auth/token/revoke",
+	"whoop":   "https://api.prod.whoop.com/developer/v1/user/access",
+	"oura":    "https://api.ouraring.com/oauth/revoke",
+}
+
+// revokeRequests build each provider's revocation request.
+var revokeRequests = map[string]func(ctx context.Context, tok *oauth.Token, integrations *pbuser.UserIntegrations) (*http.Request, error){
+	"strava": func(ctx context.Context, tok *oauth.Token, _ *pbuser.UserIntegrations) (*http.Request, error) {
+		return formRequest(ctx, revokeURLs["strava"], url.Values{"access_token": {tok.AccessToken}})
+	},
+	"fitbit": func(ctx context.Context, tok *oauth.Token, _ *pbuser.UserIntegrations) (*http.Request, error) {
+		req, err := formRequest(ctx, revokeURLs["fitbit"], url.Values{"token": {refreshOrAccess(tok)}})
+		if err != nil {
+			return nil, err
+		}
+		id, secret := clientCredentials("fitbit")
+		req.SetBasicAuth(id, secret)
+		return req, nil
+	},
+	"google": func(ctx context.Context, tok *oauth.Token, _ *pbuser.UserIntegrations) (*http.Request, error) {
+		return formRequest(ctx, revokeURLs["google"], url.Values{"token": {refreshOrAccess(tok)}})
+	},
+	"github": func(ctx context.Context, tok *oauth.Token, _ *pbuser.UserIntegrations) (*http.Request, error) {
+		id, secret := clientCredentials("github")
+		body, err := json.Marshal(map[string]string{"access_token": tok.AccessToken})
+		if err != nil {
+			return nil, err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf(revokeURLs["github"], url.PathEscape(id)), bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.SetBasicAuth(id, secret)
+		req.Header.Set("Accept", "application/vnd.github+json")
+		return req, nil
+	},
+	"polar": func(ctx context.Context, tok *oauth.Token, integrations *pbuser.UserIntegrations) (*http.Request, error) {
+		polarUserID := integrations.GetPolar().GetPolarUserId()
+		if polarUserID == "" {
+			return nil, fmt.Errorf("no polar user id")
+		}
+		return bearerRequest(ctx, http.MethodDelete, fmt.Sprintf(revokeURLs["polar"], url.PathEscape(polarUserID)), tok)
+	},
+	"dropbox": func(ctx context.Context, tok *oauth.Token, _ *pbuser.UserIntegrations) (*http.Request, error) {
+		return bearerRequest(ctx, http.MethodPost, revokeURLs["dropbox"], tok)
+	},
+	"whoop": func(ctx context.Context, tok *oauth.Token, _ *pbuser.UserIntegrations) (*http.Request, error) {
+		return bearerRequest(ctx, http.MethodDelete, revokeURLs["whoop"], tok)
+	},
+	"oura": func(ctx context.Context, tok *oauth.Token, _ *pbuser.UserIntegrations) (*http.Request, error) {
+		return http.NewRequestWithContext(ctx, http.MethodGet, revokeURLs["oura"]+"?"+url.Values{"access_token": {tok.AccessToken}}.Encode(), nil)
+	},
+}
+
+// linkedProviders returns the providers with a revocation endpoint that the
+// user has connected, in a stable order.
+func linkedProviders(integrations *pbuser.UserIntegrations) []string {
+	if integrations == nil {
+		return nil
+	}
+	msg := integrations.ProtoReflect()
+	var providers []string
+	for provider := range revokeRequests {
+		field := msg.Descriptor().Fields().ByName(protoreflect.Name(provider))
+		if field == nil || !msg.Has(field) {
+			continue
+		}
+		integration := msg.Get(field).Message()
+		if enabled := integration.Descriptor().Fields().ByName("enabled"); enabled != nil && integration.Get(enabled).Bool() {
+			providers = append(providers, provider)
+		}
+	}
+	sort.Strings(providers)
+	return providers
+}
+
+// revoke ends our authorization at one provider. A token the provider no
+// longer accepts means the authorization is already gone.
+func (d *Deleter) revoke(ctx context.Context, userID, provider string, integrations *pbuser.UserIntegrations) error {
+	tok, err := d.tokens(ctx, userID, provider)
+	if err != nil {
+		return fmt.Errorf("get token: %w", err)
+	}
+	req, err := revokeRequests[provider](ctx, tok, integrations)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode < 400, resp.StatusCode == http.StatusUnauthorized, resp.StatusCode == http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+}
+
+func formRequest(ctx context.Context, endpoint string, form url.Values) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req, nil
+}
+
+func bearerRequest(ctx context.Context, method, endpoint string, tok *oauth.Token) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+tok.AccessToken)
+	return req, nil
+}
+
+// refreshOrAccess prefers the refresh token, as revoking it ends the whole
+// grant at providers that issue one.
+func refreshOrAccess(tok *oauth.Token) string {
+	if tok.RefreshToken != "" {
+		return tok.RefreshToken
+	}
+	return tok.AccessToken
+}
+
+// clientCredentials reads a provider's OAuth client from the environment, as
+// the token source does for refreshes (e.g. FITBIT_CLIENT_ID).
+func clientCredentials(provider string) (string, string) {
+	prefix := strings.ToUpper(provider)
+	return os.Getenv(prefix + "_CLIENT_ID"), os.Getenv(prefix + "_CLIENT_SECRET")
+}
@@ -0,0 +1,84 @@
+package userdeletion
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/firestore"
+)
+
+// CollectionAudit holds one record per completed user deletion. It is
+// top-level so it outlives the user, and holds nothing about them beyond
+// their ID.
+const CollectionAudit = "user_deletions"
+
+// Audit records what a user deletion did.
+type Audit struct {
+	UserID         string
+	RequestedBy    string
+	Revoked        []string // Providers whose authorization was revoked
+	RevokeFailed   []string // Providers where revocation failed; their tokens are deleted regardless
+	ObjectsDeleted int
+	CompletedAt    time.Time
+}
+
+// AuditStore persists deletion audit records.
+type AuditStore interface {
+	WriteAudit(ctx context.Context, audit *Audit) error
+}
+
+type FirestoreAuditStore struct {
+	client *firestore.Client
+}
+
+func NewFirestoreAuditStore(client *firestore.Client) *FirestoreAuditStore {
+	return &FirestoreAuditStore{client: client}
+}
+
+func (s *FirestoreAuditStore) WriteAudit(ctx context.Context, audit *Audit) error {
+	_, _, err := s.client.Collection(CollectionAudit).Add(ctx, map[string]interface{}{
+		"user_id":         audit.UserID,
+		"requested_by":    audit.RequestedBy,
+		"revoked":         audit.Revoked,
+		"revoke_failed":   audit.RevokeFailed,
+		"objects_deleted": audit.ObjectsDeleted,
+		"completed_at":    audit.CompletedAt,
+	})
+	return err
+}
+
+// ShowcaseStore finds the showcase assets bucket folders of a user's
+// showcased activities. Enrichers store an activity's banner, route
+// thumbnail and other images under a folder named after its pipeline
+// execution, which only the showcase record links back to the user.
+type ShowcaseStore interface {
+	ShowcaseAssetFolders(ctx context.Context, userID string) ([]string, error)
+}
+
+type FirestoreShowcaseStore struct {
+	client *firestore.Client
+}
+
+func NewFirestoreShowcaseStore(client *firestore.Client) *FirestoreShowcaseStore {
+	return &FirestoreShowcaseStore{client: client}
+}
+
+func (s *FirestoreShowcaseStore) ShowcaseAssetFolders(ctx context.Context, userID string) ([]string, error) {
+	docs, err := s.client.Collection("showcased_activities").Where("user_id", "==", userID).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	var folders []string
+	for _, doc := range docs {
+		// Showcases from before execution IDs have no folder of their own
+		folder, _ := doc.Data()["pipeline_execution_id"].(string)
+		if folder == "" || strings.Contains(folder, "/") || seen[folder] {
+			continue
+		}
+		seen[folder] = true
+		folders = append(folders, folder)
+	}
+	return folders, nil
+}
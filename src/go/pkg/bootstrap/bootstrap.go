@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"cloud.google.com/go/firestore"
 	"cloud.google.com/go/pubsub"
@@ -14,19 +16,25 @@ import (
 	"github.com/fitglue/server/src/go/internal/infra"
 	shared "github.com/fitglue/server/src/go/pkg"
 	"github.com/fitglue/server/src/go/pkg/infrastructure/database"
+	emailsender "github.com/fitglue/server/src/go/pkg/infrastructure/email"
 	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/ratelimit"
 	sentryPkg "github.com/fitglue/server/src/go/pkg/infrastructure/sentry"
 	infrastorage "github.com/fitglue/server/src/go/pkg/infrastructure/storage"
+	fsstorage "github.com/fitglue/server/src/go/pkg/storage/firestore"
 
 	firebase "firebase.google.com/go/v4"
 	"firebase.google.com/go/v4/auth"
 	"github.com/fitglue/server/src/go/pkg/infrastructure/notifications"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 )
 
 // Config holds standard configuration for all services
 type Config struct {
 	ProjectID         string
 	GCSArtifactBucket string
+	MinInstances      int // Instances Cloud Run keeps warm, from MIN_INSTANCES
 }
 
 // Service holds initialized dependencies
@@ -46,12 +54,44 @@ func LoadConfig() *Config {
 		projectID = shared.ProjectID // Fallback
 	}
 
+	minInstances, _ := strconv.Atoi(os.Getenv("MIN_INSTANCES"))
+
 	return &Config{
 		ProjectID:         projectID,
 		GCSArtifactBucket: os.Getenv("GCS_ARTIFACT_BUCKET"),
+		MinInstances:      minInstances,
 	}
 }
 
+// primeTimeout bounds how long a warm instance waits for its connections
+// before serving anyway
+const primeTimeout = 10 * time.Second
+
+// PrimeConnections starts the gRPC clients connecting at startup rather than
+// on their first call. When the service keeps warm instances, startup isn't
+// on any request's critical path, so it also waits for them to be ready;
+// otherwise an instance starting for a request mustn't hold it up.
+func PrimeConnections(ctx context.Context, cfg *Config, logger infra.Logger, conns ...*grpc.ClientConn) {
+	for _, conn := range conns {
+		conn.Connect()
+	}
+	if cfg.MinInstances <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, primeTimeout)
+	defer cancel()
+	for _, conn := range conns {
+		for state := conn.GetState(); state != connectivity.Ready; state = conn.GetState() {
+			if !conn.WaitForStateChange(ctx, state) {
+				logger.Warn(ctx, "gRPC connection not ready at startup", "target", conn.Target(), "state", state.String())
+				break
+			}
+		}
+	}
+	logger.Info(ctx, "Primed gRPC connections", "connections", len(conns), "min_instances", cfg.MinInstances)
+}
+
 // GetSlogHandlerOptions returns standard handler options for GCP.
 // Delegates to infra.GCPHandlerOptions for the ReplaceAttr mapping.
 func GetSlogHandlerOptions(level slog.Level) *slog.HandlerOptions {
@@ -107,6 +147,25 @@ func NewService(ctx context.Context) (*Service, error) {
 		return nil, fmt.Errorf("firestore init: %w", err)
 	}
 
+	// Integration tokens in user documents are encrypted when a KMS key is set
+	encrypted, err := fsstorage.ConfigureTokenEncryption(ctx)
+	if err != nil {
+		logger.Error(ctx, "Token encryption init failed", "error", err)
+		return nil, fmt.Errorf("token encryption init: %w", err)
+	}
+	logger.Info(ctx, "Token encryption configured", "enabled", encrypted)
+
+	// Sensitive booster data is encrypted per user when a KMS key is set
+	encrypted, err = fsstorage.ConfigureSensitiveDataEncryption(ctx, fsClient)
+	if err != nil {
+		logger.Error(ctx, "Sensitive data encryption init failed", "error", err)
+		return nil, fmt.Errorf("sensitive data encryption init: %w", err)
+	}
+	logger.Info(ctx, "Sensitive data encryption configured", "enabled", encrypted)
+
+	// Rate-limited provider APIs share their request budgets across instances
+	ratelimit.Configure(fsClient)
+
 	// Pub/Sub - always use real publisher
 	psClient, err := pubsub.NewClient(ctx, cfg.ProjectID)
 	if err != nil {
@@ -134,6 +193,15 @@ func NewService(ctx context.Context) (*Service, error) {
 	if err != nil {
 		logger.Warn(ctx, "FCM initialization failed (notifications will be disabled)", "error", err)
 	}
+	var notifier shared.NotificationService = fcmAdapter
+
+	// Email is only configured for services that send it
+	sender, err := emailsender.NewSenderFromEnv()
+	if err != nil {
+		logger.Warn(ctx, "Email sender initialization failed (email will be disabled)", "error", err)
+	} else if sender != nil {
+		notifier = notifications.WithEmail(fcmAdapter, sender)
+	}
 
 	// Firebase Auth (for user display name lookup)
 	authClient, err := fbApp.Auth(ctx)
@@ -177,7 +245,7 @@ func NewService(ctx context.Context) (*Service, error) {
 		DB:            database.NewFirestoreAdapter(fsClient),
 		Pub:           pubAdapter,
 		Store:         &infrastorage.StorageAdapter{Client: gcsClient},
-		Notifications: fcmAdapter,
+		Notifications: notifier,
 		Auth:          authClient,
 		Config:        cfg,
 	}, nil
@@ -10,24 +10,31 @@ func TestLoadConfig(t *testing.T) {
 	// Save original env
 	origProject := os.Getenv("GOOGLE_CLOUD_PROJECT")
 	origBucket := os.Getenv("GCS_ARTIFACT_BUCKET")
+	origMinInstances := os.Getenv("MIN_INSTANCES")
 	defer func() {
 		os.Setenv("GOOGLE_CLOUD_PROJECT", origProject)
 		os.Setenv("GCS_ARTIFACT_BUCKET", origBucket)
+		os.Setenv("MIN_INSTANCES", origMinInstances)
 	}()
 
 	t.Run("Defaults", func(t *testing.T) {
 		os.Unsetenv("GOOGLE_CLOUD_PROJECT")
 		os.Unsetenv("GCS_ARTIFACT_BUCKET")
+		os.Unsetenv("MIN_INSTANCES")
 
 		cfg := LoadConfig()
 		if cfg.ProjectID == "" {
 			t.Error("ProjectID should have default fallback")
 		}
+		if cfg.MinInstances != 0 {
+			t.Errorf("Expected no warm instances, got %d", cfg.MinInstances)
+		}
 	})
 
 	t.Run("Overrides", func(t *testing.T) {
 		os.Setenv("GOOGLE_CLOUD_PROJECT", "test-project")
 		os.Setenv("GCS_ARTIFACT_BUCKET", "test-bucket")
+		os.Setenv("MIN_INSTANCES", "2")
 
 		cfg := LoadConfig()
 		if cfg.ProjectID != "test-project" {
@@ -36,6 +43,9 @@ func TestLoadConfig(t *testing.T) {
 		if cfg.GCSArtifactBucket != "test-bucket" {
 			t.Errorf("Expected test-bucket, got %s", cfg.GCSArtifactBucket)
 		}
+		if cfg.MinInstances != 2 {
+			t.Errorf("Expected 2 warm instances, got %d", cfg.MinInstances)
+		}
 	})
 }
 
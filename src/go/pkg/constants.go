@@ -3,14 +3,21 @@ package shared
 const (
 	ProjectID = "fitglue-project" // Can be overridden by env var in main if needed
 
-	TopicRawActivity           = "topic-raw-activity"
-	TopicPipelineActivity      = "topic-pipeline-activity"
-	TopicEnrichedActivity      = "topic-enriched-activity"
-	TopicDestinationUpload     = "topic-destination-upload"
-	TopicJobUploadStrava       = "topic-job-upload-strava"
-	TopicFitbitUpdates         = "topic-fitbit-updates"
-	TopicEnrichmentLag         = "topic-enrichment-lag"
-	TopicParkrunResultsTrigger = "topic-parkrun-results-trigger"
+	TopicRawActivity            = "topic-raw-activity"
+	TopicPipelineActivity       = "topic-pipeline-activity"
+	TopicEnrichedActivity       = "topic-enriched-activity"
+	TopicDestinationUpload      = "topic-destination-upload"
+	TopicJobUploadStrava        = "topic-job-upload-strava"
+	TopicFitbitUpdates          = "topic-fitbit-updates"
+	TopicEnrichmentLag          = "topic-enrichment-lag"
+	TopicParkrunResultsTrigger  = "topic-parkrun-results-trigger"
+	TopicBackfillRequested      = "topic-backfill-requested"
+	TopicImportRequested        = "topic-import-requested"
+	TopicArchiveExportRequested = "topic-archive-export-requested"
+	TopicDataExportRequested    = "topic-data-export-requested"
+	TopicUserDeletionRequested  = "topic-user-deletion-requested"
+	TopicPipelineDeadLetter     = "topic-pipeline-dead-letter"
+	TopicWebhookReceived        = "topic-webhook-received"
 
 	CollectionUsers      = "users"
 	CollectionCursors    = "cursors"
@@ -1,7 +1,8 @@
 // Package description provides utilities for section-based description manipulation.
 // Sections are identified by header prefixes (typically emoji + text, e.g., "🏃 Parkrun Results:").
 // This enables enrichers to define replaceable sections that can be updated during resume flows
-// instead of being blindly appended.
+// instead of being blindly appended. It also renders user-defined description
+// templates that lay the sections out in a custom order.
 package description
 
 import (
@@ -34,8 +35,20 @@ func FindSection(description, headerPrefix string) (start, end int, found bool)
 		return 0, 0, false
 	}
 
-	// Find the start of the section
-	start = strings.Index(description, headerPrefix)
+	// Find the start of the section: the header must begin a line, so a
+	// header without an emoji doesn't match the tail of another one
+	start = -1
+	for from := 0; from < len(description); {
+		i := strings.Index(description[from:], headerPrefix)
+		if i == -1 {
+			break
+		}
+		if i += from; i == 0 || description[i-1] == '\n' {
+			start = i
+			break
+		}
+		from = i + 1
+	}
 	if start == -1 {
 		return 0, 0, false
 	}
@@ -55,10 +68,10 @@ func FindSection(description, headerPrefix string) (start, end int, found bool)
 			position++ // Account for newline
 		}
 
-		// Check for blank line followed by emoji start (section boundary)
+		// Check for blank line followed by a header (section boundary)
 		if strings.TrimSpace(line) == "" && i+1 < len(lines) {
 			nextLine := lines[i+1]
-			if isEmojiOrSpecialStart(strings.TrimSpace(nextLine)) {
+			if isHeaderLine(strings.TrimSpace(nextLine)) {
 				// Found section boundary - end is at the blank line
 				end = start + len(headerPrefix) + strings.Index(remaining, "\n"+nextLine) - 1
 				// Trim trailing whitespace from section
@@ -0,0 +1,179 @@
+package description
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+// MaxHeaderTextLength caps a user's custom header text, in characters.
+const MaxHeaderTextLength = 40
+
+// Section is the default header of an enricher's description section,
+// rendered as "<Emoji> <Title>:".
+type Section struct {
+	Emoji string
+	Title string
+}
+
+// Section keys, used by providers and as the keys of a user's custom header
+// text.
+const (
+	SectionActiveRecovery     = "active_recovery"
+	SectionAISummary          = "ai_summary"
+	SectionCadence            = "cadence"
+	SectionDetectedIntervals  = "detected_intervals"
+	SectionGoalProgress       = "goal_progress"
+	SectionGoals              = "goals"
+	SectionGradeAdjustedBests = "grade_adjusted_bests"
+	SectionHeartRate          = "heart_rate"
+	SectionHeartRateZones     = "heart_rate_zones"
+	SectionHybridRace         = "hybrid_race"
+	SectionIntervals          = "intervals"
+	SectionLifetimeDistance   = "lifetime_distance"
+	SectionMuscleHeatmap      = "muscle_heatmap"
+	SectionPaceTarget         = "pace_target"
+	SectionParkrun            = "parkrun"
+	SectionPersonalRecords    = "personal_records"
+	SectionPower              = "power"
+	SectionRecoveryAdvisor    = "recovery_advisor"
+	SectionRecoveryContext    = "recovery_context"
+	SectionSegments           = "segments"
+	SectionSoundtrack         = "soundtrack"
+	SectionSpeed              = "speed"
+	SectionStreakTracker      = "streak_tracker"
+	SectionWorkoutSummary     = "workout_summary"
+)
+
+// sections is the registry of default section headers. Sections whose title
+// depends on the activity (e.g. a workout name) register the title used when
+// there is nothing to add, and providers pass the full title to HeaderTitled.
+var sections = map[string]Section{
+	SectionActiveRecovery:     {"🧘", "Active Recovery"},
+	SectionAISummary:          {"✨", "AI Summary"},
+	SectionCadence:            {"🦶", "Cadence"},
+	SectionDetectedIntervals:  {"⏱️", "Detected Intervals"},
+	SectionGoalProgress:       {"🎯", "Goal Progress"},
+	SectionGoals:              {"🎯", "Goals"},
+	SectionGradeAdjustedBests: {"⛰️", "Grade-Adjusted Bests"},
+	SectionHeartRate:          {"❤️", "Heart Rate"},
+	SectionHeartRateZones:     {"❤️", "Heart Rate Zones"},
+	SectionHybridRace:         {"🏁", "Race"},
+	SectionIntervals:          {"⏱️", "Intervals"},
+	SectionLifetimeDistance:   {"📊", "Lifetime"},
+	SectionMuscleHeatmap:      {"🔥", "Muscle Heatmap"},
+	SectionPaceTarget:         {"🎯", "Pace Target"},
+	SectionParkrun:            {"🏃", "Parkrun Results"},
+	SectionPersonalRecords:    {"🏆", "Personal Records"},
+	SectionPower:              {"⚡", "Power"},
+	SectionRecoveryAdvisor:    {"💤", "Recovery Advisor"},
+	SectionRecoveryContext:    {"😴", "Recovery context"},
+	SectionSegments:           {"🏁", "Segments"},
+	SectionSoundtrack:         {"🎵", "Soundtrack"},
+	SectionSpeed:              {"🚀", "Speed"},
+	SectionStreakTracker:      {"🔥", "Streak Tracker"},
+	SectionWorkoutSummary:     {"📋", "Workout Summary"},
+}
+
+// DefaultSection returns the registered header of the section under key.
+func DefaultSection(key string) Section {
+	return sections[key]
+}
+
+// Header renders the header of the section registered under key, with the
+// user's preferences applied. prefs may be nil.
+func Header(key string, prefs *pbuser.DescriptionHeaderPreferences) string {
+	s := sections[key]
+	return HeaderTitled(key, s.Emoji, s.Title, prefs)
+}
+
+// HeaderTitled renders a header whose emoji and title the provider worked out
+// for this activity (e.g. "Intervals — 4×6"). The user's custom text for key
+// still replaces the title, and hide_emoji still drops the emoji.
+func HeaderTitled(key, emoji, title string, prefs *pbuser.DescriptionHeaderPreferences) string {
+	if custom := strings.TrimSpace(prefs.GetCustomText()[key]); custom != "" {
+		title = custom
+	}
+	if prefs.GetHideEmoji() || emoji == "" {
+		return title + ":"
+	}
+	return emoji + " " + title + ":"
+}
+
+// ValidateHeaderPreferences checks a user's header preferences: every custom
+// text must be for a registered section, fit on one line and be at most
+// MaxHeaderTextLength characters.
+func ValidateHeaderPreferences(prefs *pbuser.DescriptionHeaderPreferences) error {
+	keys := make([]string, 0, len(prefs.GetCustomText()))
+	for k := range prefs.GetCustomText() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if _, ok := sections[k]; !ok {
+			return fmt.Errorf("unknown description section: %s", k)
+		}
+		text := prefs.GetCustomText()[k]
+		if strings.ContainsAny(text, "\r\n") {
+			return fmt.Errorf("header text for %s must be a single line", k)
+		}
+		if utf8.RuneCountInString(text) > MaxHeaderTextLength {
+			return fmt.Errorf("header text for %s is longer than %d characters", k, MaxHeaderTextLength)
+		}
+	}
+	return nil
+}
+
+// headerVariants returns the other renderings of header that name the same
+// section: with or without its emoji. A section posted before the user turned
+// hide_emoji on or off is then still replaced on update, not duplicated.
+func headerVariants(header string) []string {
+	if title := strings.TrimSpace(stripLeadingEmoji(header)); title != header {
+		if title == "" {
+			return nil
+		}
+		return []string{title}
+	}
+	// No emoji: add back the emoji of the registered section it names
+	var variants []string
+	for _, s := range registeredSections(header) {
+		variants = append(variants, s.Emoji+" "+header)
+	}
+	sort.Strings(variants)
+	return variants
+}
+
+// registeredSections returns the sections a header without an emoji names:
+// its title is a registered title, or starts or ends with one (e.g.
+// "Intervals — 4×6", "Weekly Goal Progress").
+func registeredSections(header string) []Section {
+	if !strings.HasSuffix(header, ":") {
+		return nil
+	}
+	title := strings.TrimSuffix(header, ":")
+	var matched []Section
+	for _, s := range sections {
+		if title == s.Title || strings.HasPrefix(title, s.Title+" ") || strings.HasSuffix(title, " "+s.Title) {
+			matched = append(matched, s)
+		}
+	}
+	return matched
+}
+
+// stripLeadingEmoji drops the emoji and symbols at the start of s, with the
+// variation selectors and joiners that belong to them.
+func stripLeadingEmoji(s string) string {
+	return strings.TrimLeftFunc(s, func(r rune) bool {
+		return r > 127
+	})
+}
+
+// isHeaderLine reports whether a line looks like a section header: it starts
+// with an emoji or symbol, or it is a registered title rendered without one.
+func isHeaderLine(line string) bool {
+	return isEmojiOrSpecialStart(line) || len(registeredSections(line)) > 0
+}
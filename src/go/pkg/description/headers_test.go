@@ -0,0 +1,144 @@
+package description
+
+import (
+	"strings"
+	"testing"
+
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+func TestHeader(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		prefs    *pbuser.DescriptionHeaderPreferences
+		expected string
+	}{
+		{
+			name:     "Default",
+			key:      SectionHeartRate,
+			expected: "❤️ Heart Rate:",
+		},
+		{
+			name:     "Hide emoji",
+			key:      SectionHeartRate,
+			prefs:    &pbuser.DescriptionHeaderPreferences{HideEmoji: true},
+			expected: "Heart Rate:",
+		},
+		{
+			name:     "Custom text",
+			key:      SectionHeartRate,
+			prefs:    &pbuser.DescriptionHeaderPreferences{CustomText: map[string]string{SectionHeartRate: "HR"}},
+			expected: "❤️ HR:",
+		},
+		{
+			name: "Custom text without emoji",
+			key:  SectionHeartRate,
+			prefs: &pbuser.DescriptionHeaderPreferences{
+				HideEmoji:  true,
+				CustomText: map[string]string{SectionHeartRate: "HR"},
+			},
+			expected: "HR:",
+		},
+		{
+			name:     "Custom text for another section is ignored",
+			key:      SectionCadence,
+			prefs:    &pbuser.DescriptionHeaderPreferences{CustomText: map[string]string{SectionHeartRate: "HR"}},
+			expected: "🦶 Cadence:",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Header(tt.key, tt.prefs); got != tt.expected {
+				t.Errorf("Header() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHeaderTitled(t *testing.T) {
+	if got := HeaderTitled(SectionIntervals, "⏱️", "Intervals — 4×6", nil); got != "⏱️ Intervals — 4×6:" {
+		t.Errorf("HeaderTitled() = %q", got)
+	}
+	prefs := &pbuser.DescriptionHeaderPreferences{CustomText: map[string]string{SectionIntervals: "Reps"}}
+	if got := HeaderTitled(SectionIntervals, "⏱️", "Intervals — 4×6", prefs); got != "⏱️ Reps:" {
+		t.Errorf("HeaderTitled() = %q, want custom text to replace the title", got)
+	}
+}
+
+func TestValidateHeaderPreferences(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefs   *pbuser.DescriptionHeaderPreferences
+		wantErr bool
+	}{
+		{name: "Nil"},
+		{
+			name:  "Valid",
+			prefs: &pbuser.DescriptionHeaderPreferences{HideEmoji: true, CustomText: map[string]string{SectionParkrun: "parkrun"}},
+		},
+		{
+			name:    "Unknown section",
+			prefs:   &pbuser.DescriptionHeaderPreferences{CustomText: map[string]string{"weather": "Sky"}},
+			wantErr: true,
+		},
+		{
+			name:    "Multi-line",
+			prefs:   &pbuser.DescriptionHeaderPreferences{CustomText: map[string]string{SectionParkrun: "park\nrun"}},
+			wantErr: true,
+		},
+		{
+			name:    "Too long",
+			prefs:   &pbuser.DescriptionHeaderPreferences{CustomText: map[string]string{SectionParkrun: strings.Repeat("a", MaxHeaderTextLength+1)}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateHeaderPreferences(tt.prefs); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateHeaderPreferences() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMergeHeaderVariants(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		incoming string
+		header   string
+		expected string
+	}{
+		{
+			name:     "No-emoji header replaces the emoji section",
+			existing: "My notes\n\n❤️ Heart Rate:\n140 bpm\n\n🦶 Cadence:\n170 spm",
+			incoming: "Heart Rate:\n150 bpm",
+			header:   "Heart Rate:",
+			expected: "My notes\n\nHeart Rate:\n150 bpm\n\n🦶 Cadence:\n170 spm",
+		},
+		{
+			name:     "Emoji header replaces the no-emoji section",
+			existing: "My notes\n\nHeart Rate:\n140 bpm\n\nCadence:\n170 spm",
+			incoming: "❤️ Heart Rate:\n150 bpm",
+			header:   "❤️ Heart Rate:",
+			expected: "My notes\n\n❤️ Heart Rate:\n150 bpm\n\nCadence:\n170 spm",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Merge(tt.existing, tt.incoming, tt.header, false); got != tt.expected {
+				t.Errorf("Merge() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFindSectionLineStart(t *testing.T) {
+	if _, _, found := FindSection("Felt great. Heart Rate: steady", "Heart Rate:"); found {
+		t.Error("FindSection() matched a header in the middle of a line")
+	}
+}
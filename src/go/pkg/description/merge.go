@@ -0,0 +1,170 @@
+package description
+
+import (
+	"sort"
+	"strings"
+)
+
+// SectionHeader returns the replaceable section header an enricher declared
+// in the event metadata (section_header_<provider>), or "" if none did. When
+// several did, the first by key wins so the choice is stable.
+func SectionHeader(metadata map[string]string) string {
+	var keys []string
+	for k := range metadata {
+		if strings.HasPrefix(k, "section_header_") {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return ""
+	}
+	sort.Strings(keys)
+	return metadata[keys[0]]
+}
+
+// Merge combines the description already on a destination with FitGlue's new
+// one, as update flows do. With overwrite (the destination is also the
+// activity's source) the new description replaces the existing one outright.
+// Otherwise the sectionHeader section is replaced in place when the existing
+// description has it, with or without its emoji, and the new description is
+// appended when it doesn't.
+func Merge(existing, incoming, sectionHeader string, overwrite bool) string {
+	if overwrite {
+		return incoming
+	}
+	if incoming == "" {
+		return existing
+	}
+	if sectionHeader != "" {
+		for _, h := range append([]string{sectionHeader}, headerVariants(sectionHeader)...) {
+			if !HasSection(existing, h) {
+				continue
+			}
+			if content := ExtractSection(incoming, sectionHeader); content != "" {
+				return ReplaceSection(existing, h, content)
+			}
+			return existing
+		}
+	}
+	if existing == "" {
+		return incoming
+	}
+	return existing + "\n\n" + incoming
+}
+
+// LineOp is what a diff does to a line.
+type LineOp int
+
+const (
+	LineEqual LineOp = iota
+	LineAdded
+	LineRemoved
+)
+
+// DiffLine is one line of a description diff.
+type DiffLine struct {
+	Op   LineOp
+	Text string
+}
+
+// DiffLines returns a line-by-line diff turning before into after, using the
+// longest common subsequence. Descriptions are short enough for the O(n*m)
+// table.
+func DiffLines(before, after string) []DiffLine {
+	a, b := splitLines(before), splitLines(after)
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var diff []DiffLine
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			diff = append(diff, DiffLine{Op: LineEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, DiffLine{Op: LineRemoved, Text: a[i]})
+			i++
+		default:
+			diff = append(diff, DiffLine{Op: LineAdded, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		diff = append(diff, DiffLine{Op: LineRemoved, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		diff = append(diff, DiffLine{Op: LineAdded, Text: b[j]})
+	}
+	return diff
+}
+
+// DroppedUserLines returns the user's own lines in existing that merged no
+// longer has. The user's lines are everything before the first section
+// (a header line, at the top or after a blank line); sections are FitGlue's and are expected to change. A non-empty
+// result means the update would overwrite the user's edits.
+func DroppedUserLines(existing, merged string) []string {
+	kept := make(map[string]bool)
+	for _, line := range splitLines(merged) {
+		kept[strings.TrimSpace(line)] = true
+	}
+
+	var dropped []string
+	for _, line := range userLines(existing) {
+		if t := strings.TrimSpace(line); t != "" && !kept[t] {
+			dropped = append(dropped, line)
+		}
+	}
+	return dropped
+}
+
+// SectionHeaders returns the header line of each section in a description,
+// in order.
+func SectionHeaders(description string) []string {
+	var headers []string
+	lines := splitLines(description)
+	for i := range lines {
+		if isSectionStart(lines, i) {
+			headers = append(headers, strings.TrimSpace(lines[i]))
+		}
+	}
+	return headers
+}
+
+// userLines returns the lines before the description's first section.
+func userLines(description string) []string {
+	lines := splitLines(description)
+	for i := range lines {
+		if isSectionStart(lines, i) {
+			return lines[:i]
+		}
+	}
+	return lines
+}
+
+// isSectionStart reports whether lines[i] starts a section: it is a header
+// line and is the first line or follows a blank one.
+func isSectionStart(lines []string, i int) bool {
+	return (i == 0 || strings.TrimSpace(lines[i-1]) == "") && isHeaderLine(strings.TrimSpace(lines[i]))
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
@@ -0,0 +1,129 @@
+package description
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name      string
+		existing  string
+		incoming  string
+		header    string
+		overwrite bool
+		expected  string
+	}{
+		{
+			name:      "Overwrite replaces everything",
+			existing:  "Edited on Strava",
+			incoming:  "Morning run\n\n🌤️ Weather:\nSunny",
+			overwrite: true,
+			expected:  "Morning run\n\n🌤️ Weather:\nSunny",
+		},
+		{
+			name:     "Replaces an existing section in place",
+			existing: "My notes\n\n🏃 Parkrun Results:\nWaiting...",
+			incoming: "🏃 Parkrun Results:\n42nd place",
+			header:   "🏃 Parkrun Results:",
+			expected: "My notes\n\n🏃 Parkrun Results:\n42nd place",
+		},
+		{
+			name:     "Appends when the section is missing",
+			existing: "My notes",
+			incoming: "🌤️ Weather:\nSunny",
+			header:   "🌤️ Weather:",
+			expected: "My notes\n\n🌤️ Weather:\nSunny",
+		},
+		{
+			name:     "Empty incoming keeps existing",
+			existing: "My notes",
+			expected: "My notes",
+		},
+		{
+			name:     "Empty existing takes incoming",
+			incoming: "🌤️ Weather:\nSunny",
+			expected: "🌤️ Weather:\nSunny",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Merge(tt.existing, tt.incoming, tt.header, tt.overwrite); got != tt.expected {
+				t.Errorf("Merge() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSectionHeader(t *testing.T) {
+	metadata := map[string]string{
+		"section_header_ENRICHER_PROVIDER_WEATHER": "🌤️ Weather:",
+		"section_header_ENRICHER_PROVIDER_PARKRUN": "🏃 Parkrun Results:",
+		"hr_source": "activity",
+	}
+	if got := SectionHeader(metadata); got != "🏃 Parkrun Results:" {
+		t.Errorf("SectionHeader() = %q, want the first header by key", got)
+	}
+	if got := SectionHeader(map[string]string{"hr_source": "activity"}); got != "" {
+		t.Errorf("SectionHeader() = %q, want empty", got)
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	got := DiffLines("Run\n\n🌤️ Weather:\nCloudy", "Run\n\n🌤️ Weather:\nSunny\n\n❤️ HR: 150")
+	want := []DiffLine{
+		{Op: LineEqual, Text: "Run"},
+		{Op: LineEqual, Text: ""},
+		{Op: LineEqual, Text: "🌤️ Weather:"},
+		{Op: LineRemoved, Text: "Cloudy"},
+		{Op: LineAdded, Text: "Sunny"},
+		{Op: LineAdded, Text: ""},
+		{Op: LineAdded, Text: "❤️ HR: 150"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffLines() = %+v, want %+v", got, want)
+	}
+}
+
+func TestSectionHeaders(t *testing.T) {
+	got := SectionHeaders("🏁 Race day\nFelt great\n\n🌤️ Weather:\nSunny\n❤️ not a section\n\n❤️ Heart Rate:\n150")
+	want := []string{"🏁 Race day", "🌤️ Weather:", "❤️ Heart Rate:"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SectionHeaders() = %q, want %q", got, want)
+	}
+}
+
+func TestDroppedUserLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		merged   string
+		expected []string
+	}{
+		{
+			name:     "Refreshed section is not a user edit",
+			existing: "Morning run\n\n🌤️ Weather:\nCloudy",
+			merged:   "Morning run\n\n🌤️ Weather:\nSunny",
+		},
+		{
+			name:     "User text removed by an overwrite",
+			existing: "Morning run\nLegs felt heavy\n\n🌤️ Weather:\nCloudy",
+			merged:   "Morning run\n\n🌤️ Weather:\nSunny",
+			expected: []string{"Legs felt heavy"},
+		},
+		{
+			name:     "Description that is all sections",
+			existing: "🌤️ Weather:\nCloudy",
+			merged:   "🌤️ Weather:\nSunny",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DroppedUserLines(tt.existing, tt.merged); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("DroppedUserLines() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
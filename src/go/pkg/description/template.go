@@ -0,0 +1,118 @@
+package description
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+const (
+	// MaxTemplateLength caps the size of a user-supplied description template.
+	MaxTemplateLength = 4000
+	// maxRenderedLength caps the output of a template, so a loop can't blow up
+	// a description.
+	maxRenderedLength = 64 * 1024
+)
+
+// Template fields that aren't enricher sections.
+const (
+	FieldOriginal = "Original" // The description the activity arrived with
+	FieldSections = "Sections" // Every enricher section in pipeline order
+)
+
+// templateAliases are short names for the most commonly placed sections, on
+// top of the full provider field name.
+var templateAliases = map[pbplugin.EnricherProviderType][]string{
+	pbplugin.EnricherProviderType_ENRICHER_PROVIDER_HEART_RATE_SUMMARY: {"HR"},
+	pbplugin.EnricherProviderType_ENRICHER_PROVIDER_HEART_RATE_ZONES:   {"HRZones"},
+	pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PERSONAL_RECORDS:   {"PRs"},
+}
+
+var excessBlankLines = regexp.MustCompile(`\n{3,}`)
+
+// TemplateField returns the field an enricher's section is exposed as in a
+// description template: the provider type in CamelCase without its prefix,
+// e.g. ENRICHER_PROVIDER_HEART_RATE_ZONES -> HeartRateZones.
+func TemplateField(providerType pbplugin.EnricherProviderType) string {
+	name := strings.TrimPrefix(providerType.String(), "ENRICHER_PROVIDER_")
+	var sb strings.Builder
+	for _, word := range strings.Split(strings.ToLower(name), "_") {
+		if word == "" {
+			continue
+		}
+		sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return sb.String()
+}
+
+// TemplateData holds the values a description template can reference. Fields
+// that were never set render as empty strings.
+type TemplateData map[string]string
+
+// NewTemplateData starts template data from the activity's original description.
+func NewTemplateData(original string) TemplateData {
+	return TemplateData{FieldOriginal: original}
+}
+
+// AddSection exposes an enricher's section under its field name and aliases.
+// A provider that appears more than once in a pipeline gets its sections
+// joined with a blank line.
+func (d TemplateData) AddSection(providerType pbplugin.EnricherProviderType, section string) {
+	if section == "" {
+		return
+	}
+	fields := append([]string{TemplateField(providerType), FieldSections}, templateAliases[providerType]...)
+	for _, f := range fields {
+		if d[f] != "" {
+			d[f] += "\n\n"
+		}
+		d[f] += section
+	}
+}
+
+// ParseTemplate parses a description template and checks it renders against
+// empty data, so mistakes surface when the template is saved rather than when
+// an activity is processed.
+func ParseTemplate(text string) (*template.Template, error) {
+	if len(text) > MaxTemplateLength {
+		return nil, fmt.Errorf("template is longer than %d characters", MaxTemplateLength)
+	}
+	tmpl, err := template.New("description").Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := RenderTemplate(tmpl, TemplateData{}); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// RenderTemplate executes a description template. Runs of blank lines left by
+// empty sections collapse to one, and surrounding whitespace is trimmed.
+func RenderTemplate(tmpl *template.Template, data TemplateData) (string, error) {
+	out := &limitedBuffer{max: maxRenderedLength}
+	if err := tmpl.Execute(out, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(excessBlankLines.ReplaceAllString(out.String(), "\n\n")), nil
+}
+
+var errRenderedTooLong = errors.New("rendered description is too long")
+
+// limitedBuffer fails writes once max bytes have been written.
+type limitedBuffer struct {
+	bytes.Buffer
+	max int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.max {
+		return 0, errRenderedTooLong
+	}
+	return b.Buffer.Write(p)
+}
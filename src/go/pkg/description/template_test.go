@@ -0,0 +1,107 @@
+package description
+
+import (
+	"strings"
+	"testing"
+
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+func TestTemplateField(t *testing.T) {
+	tests := []struct {
+		providerType pbplugin.EnricherProviderType
+		expected     string
+	}{
+		{pbplugin.EnricherProviderType_ENRICHER_PROVIDER_HEART_RATE_ZONES, "HeartRateZones"},
+		{pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER, "Weather"},
+		{pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PERSONAL_RECORDS, "PersonalRecords"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if got := TemplateField(tt.providerType); got != tt.expected {
+				t.Errorf("TemplateField() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	data := NewTemplateData("Morning run")
+	data.AddSection(pbplugin.EnricherProviderType_ENRICHER_PROVIDER_HEART_RATE_ZONES, "❤️ Zones: Z2 80%")
+	data.AddSection(pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER, "🌤️ 12°C")
+	data.AddSection(pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PERSONAL_RECORDS, "")
+
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{
+			name:     "Reordered with heading",
+			template: "{{.Weather}}\n\n## Effort\n{{.HRZones}}\n\n{{.Original}}",
+			expected: "🌤️ 12°C\n\n## Effort\n❤️ Zones: Z2 80%\n\nMorning run",
+		},
+		{
+			name:     "Empty and unknown fields collapse",
+			template: "{{.Original}}\n\n{{.PRs}}\n\n{{.NotAField}}\n\n{{.Weather}}",
+			expected: "Morning run\n\n🌤️ 12°C",
+		},
+		{
+			name:     "Conditional section",
+			template: "{{.Original}}{{if .PRs}}\n\n🏆 {{.PRs}}{{end}}",
+			expected: "Morning run",
+		},
+		{
+			name:     "All sections",
+			template: "{{.Sections}}",
+			expected: "❤️ Zones: Z2 80%\n\n🌤️ 12°C",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseTemplate(tt.template)
+			if err != nil {
+				t.Fatalf("ParseTemplate() error = %v", err)
+			}
+			got, err := RenderTemplate(tmpl, data)
+			if err != nil {
+				t.Fatalf("RenderTemplate() error = %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("RenderTemplate() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTemplateData_RepeatedProvider(t *testing.T) {
+	data := NewTemplateData("")
+	data.AddSection(pbplugin.EnricherProviderType_ENRICHER_PROVIDER_SOURCE_LINK, "first")
+	data.AddSection(pbplugin.EnricherProviderType_ENRICHER_PROVIDER_SOURCE_LINK, "second")
+	if got := data["SourceLink"]; got != "first\n\nsecond" {
+		t.Errorf("SourceLink = %q, want both sections", got)
+	}
+}
+
+func TestParseTemplate_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"Syntax error":     "{{.Original",
+		"Unknown function": "{{shout .Original}}",
+		"Too long":         strings.Repeat("x", MaxTemplateLength+1),
+	}
+	for name, text := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseTemplate(text); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestParseTemplate_OutputCapped(t *testing.T) {
+	if _, err := ParseTemplate(`{{range 100000}}{{"0123456789"}}{{end}}`); err == nil {
+		t.Error("expected runaway output to be rejected")
+	}
+}
@@ -0,0 +1,135 @@
+package description
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/fitglue/server/src/go/pkg/types/formatters"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+// MaxTitleLength caps a rendered title, in characters; longer titles are cut.
+const MaxTitleLength = 255
+
+// Title template fields.
+const (
+	FieldTitle    = "Title"    // The enriched title
+	FieldType     = "Type"     // Activity type display name, e.g. "Trail Run"
+	FieldEmoji    = "Emoji"    // Activity type emoji
+	FieldDistance = "Distance" // e.g. "10.02 km"; empty without distance
+	FieldDuration = "Duration" // Elapsed time as M:SS or H:MM:SS
+)
+
+var errEmptyTitle = errors.New("title template rendered an empty title")
+
+// activityEmoji is the emoji shown for an activity type in titles.
+var activityEmoji = map[pbactivity.ActivityType]string{
+	pbactivity.ActivityType_ACTIVITY_TYPE_RUN:                              "🏃",
+	pbactivity.ActivityType_ACTIVITY_TYPE_TRAIL_RUN:                        "🏃",
+	pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_RUN:                      "🏃",
+	pbactivity.ActivityType_ACTIVITY_TYPE_WALK:                             "🚶",
+	pbactivity.ActivityType_ACTIVITY_TYPE_HIKE:                             "🥾",
+	pbactivity.ActivityType_ACTIVITY_TYPE_SNOWSHOE:                         "🥾",
+	pbactivity.ActivityType_ACTIVITY_TYPE_RIDE:                             "🚴",
+	pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_RIDE:                     "🚴",
+	pbactivity.ActivityType_ACTIVITY_TYPE_GRAVEL_RIDE:                      "🚴",
+	pbactivity.ActivityType_ACTIVITY_TYPE_EBIKE_RIDE:                       "🚴",
+	pbactivity.ActivityType_ACTIVITY_TYPE_VELOMOBILE:                       "🚴",
+	pbactivity.ActivityType_ACTIVITY_TYPE_HANDCYCLE:                        "🚴",
+	pbactivity.ActivityType_ACTIVITY_TYPE_MOUNTAIN_BIKE_RIDE:               "🚵",
+	pbactivity.ActivityType_ACTIVITY_TYPE_EMOUNTAIN_BIKE_RIDE:              "🚵",
+	pbactivity.ActivityType_ACTIVITY_TYPE_SWIM:                             "🏊",
+	pbactivity.ActivityType_ACTIVITY_TYPE_ROWING:                           "🚣",
+	pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_ROW:                      "🚣",
+	pbactivity.ActivityType_ACTIVITY_TYPE_CANOEING:                         "🛶",
+	pbactivity.ActivityType_ACTIVITY_TYPE_KAYAKING:                         "🛶",
+	pbactivity.ActivityType_ACTIVITY_TYPE_STAND_UP_PADDLING:                "🏄",
+	pbactivity.ActivityType_ACTIVITY_TYPE_SURFING:                          "🏄",
+	pbactivity.ActivityType_ACTIVITY_TYPE_KITESURF:                         "🏄",
+	pbactivity.ActivityType_ACTIVITY_TYPE_WINDSURF:                         "🏄",
+	pbactivity.ActivityType_ACTIVITY_TYPE_SAIL:                             "⛵",
+	pbactivity.ActivityType_ACTIVITY_TYPE_ALPINE_SKI:                       "⛷️",
+	pbactivity.ActivityType_ACTIVITY_TYPE_BACKCOUNTRY_SKI:                  "⛷️",
+	pbactivity.ActivityType_ACTIVITY_TYPE_NORDIC_SKI:                       "⛷️",
+	pbactivity.ActivityType_ACTIVITY_TYPE_ROLLER_SKI:                       "⛷️",
+	pbactivity.ActivityType_ACTIVITY_TYPE_SNOWBOARD:                        "🏂",
+	pbactivity.ActivityType_ACTIVITY_TYPE_ICE_SKATE:                        "⛸️",
+	pbactivity.ActivityType_ACTIVITY_TYPE_INLINE_SKATE:                     "🛼",
+	pbactivity.ActivityType_ACTIVITY_TYPE_SKATEBOARD:                       "🛹",
+	pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING:                  "🏋️",
+	pbactivity.ActivityType_ACTIVITY_TYPE_CROSSFIT:                         "🏋️",
+	pbactivity.ActivityType_ACTIVITY_TYPE_HIGH_INTENSITY_INTERVAL_TRAINING: "🔥",
+	pbactivity.ActivityType_ACTIVITY_TYPE_YOGA:                             "🧘",
+	pbactivity.ActivityType_ACTIVITY_TYPE_PILATES:                          "🧘",
+	pbactivity.ActivityType_ACTIVITY_TYPE_ROCK_CLIMBING:                    "🧗",
+	pbactivity.ActivityType_ACTIVITY_TYPE_GOLF:                             "⛳",
+	pbactivity.ActivityType_ACTIVITY_TYPE_SOCCER:                           "⚽",
+	pbactivity.ActivityType_ACTIVITY_TYPE_TENNIS:                           "🎾",
+	pbactivity.ActivityType_ACTIVITY_TYPE_PICKLEBALL:                       "🎾",
+	pbactivity.ActivityType_ACTIVITY_TYPE_SQUASH:                           "🎾",
+	pbactivity.ActivityType_ACTIVITY_TYPE_RACQUETBALL:                      "🎾",
+	pbactivity.ActivityType_ACTIVITY_TYPE_BADMINTON:                        "🏸",
+	pbactivity.ActivityType_ACTIVITY_TYPE_TABLE_TENNIS:                     "🏓",
+	pbactivity.ActivityType_ACTIVITY_TYPE_WHEELCHAIR:                       "♿",
+}
+
+// ActivityEmoji returns the emoji for an activity type, or a medal for types
+// without one.
+func ActivityEmoji(t pbactivity.ActivityType) string {
+	if e, ok := activityEmoji[t]; ok {
+		return e
+	}
+	return "🏅"
+}
+
+// NewTitleData builds the values a title template can reference from an
+// enriched activity. Distance and duration are summed over its sessions.
+func NewTitleData(a *pbactivity.StandardizedActivity) TemplateData {
+	var distance, elapsed float64
+	for _, s := range a.Sessions {
+		distance += s.TotalDistance
+		elapsed += s.TotalElapsedTime
+	}
+
+	data := TemplateData{
+		FieldTitle: a.Name,
+		FieldType:  formatters.FormatActivityType(a.Type),
+		FieldEmoji: ActivityEmoji(a.Type),
+	}
+	if distance > 0 {
+		data[FieldDistance] = fmt.Sprintf("%.2f km", distance/1000)
+	}
+	if elapsed > 0 {
+		data[FieldDuration] = formatTitleDuration(elapsed)
+	}
+	return data
+}
+
+// RenderTitle executes a title template. The result is one line with
+// whitespace collapsed, cut to MaxTitleLength. Rendering to nothing is an
+// error, so the caller can keep the enriched title.
+func RenderTitle(tmpl *template.Template, data TemplateData) (string, error) {
+	rendered, err := RenderTemplate(tmpl, data)
+	if err != nil {
+		return "", err
+	}
+	title := strings.Join(strings.Fields(rendered), " ")
+	if title == "" {
+		return "", errEmptyTitle
+	}
+	if runes := []rune(title); len(runes) > MaxTitleLength {
+		title = strings.TrimSpace(string(runes[:MaxTitleLength]))
+	}
+	return title, nil
+}
+
+func formatTitleDuration(seconds float64) string {
+	total := int(seconds + 0.5)
+	h, m, s := total/3600, (total%3600)/60, total%60
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%d:%02d", m, s)
+}
@@ -0,0 +1,66 @@
+package description
+
+import (
+	"strings"
+	"testing"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+func TestRenderTitle(t *testing.T) {
+	run := NewTitleData(&pbactivity.StandardizedActivity{
+		Name: "Morning Run",
+		Type: pbactivity.ActivityType_ACTIVITY_TYPE_TRAIL_RUN,
+		Sessions: []*pbactivity.Session{
+			{TotalDistance: 10020, TotalElapsedTime: 3134},
+		},
+	})
+	lift := NewTitleData(&pbactivity.StandardizedActivity{
+		Name: "Push Day",
+		Type: pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING,
+	})
+
+	tests := []struct {
+		name     string
+		template string
+		data     TemplateData
+		expected string
+	}{
+		{"Decorated", "{{.Emoji}} {{.Type}} — {{.Distance}}", run, "🏃 Trail Run — 10.02 km"},
+		{"Duration", "{{.Title}} ({{.Duration}})", run, "Morning Run (52:14)"},
+		{"NoDistance", "{{.Emoji}} {{.Title}}{{if .Distance}} · {{.Distance}}{{end}}", lift, "🏋️ Push Day"},
+		{"Multiline collapses", "{{.Title}}\n\n  {{.Type}}", lift, "Push Day Weight Training"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseTemplate(tt.template)
+			if err != nil {
+				t.Fatalf("ParseTemplate() error = %v", err)
+			}
+			got, err := RenderTitle(tmpl, tt.data)
+			if err != nil {
+				t.Fatalf("RenderTitle() error = %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("RenderTitle() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRenderTitle_Limits(t *testing.T) {
+	tmpl, _ := ParseTemplate("{{.Distance}}")
+	if _, err := RenderTitle(tmpl, TemplateData{}); err == nil {
+		t.Error("Expected an error for an empty title")
+	}
+
+	tmpl, _ = ParseTemplate("{{.Title}}")
+	got, err := RenderTitle(tmpl, TemplateData{FieldTitle: strings.Repeat("a", MaxTitleLength+10)})
+	if err != nil {
+		t.Fatalf("RenderTitle() error = %v", err)
+	}
+	if len(got) != MaxTitleLength {
+		t.Errorf("Expected title cut to %d characters, got %d", MaxTitleLength, len(got))
+	}
+}
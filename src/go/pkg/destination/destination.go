@@ -4,6 +4,7 @@ package destination
 import (
 	"context"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
+	storage "github.com/fitglue/server/src/go/pkg/storage/firestore"
 
 	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
@@ -24,3 +25,31 @@ type Destination interface {
 	// Name returns the destination identifier (e.g., "strava", "mock").
 	Name() string
 }
+
+// RemoveMetadataKey, set to "true" in an upload event's enrichment metadata,
+// asks for what the run uploaded to be taken down instead. The destination
+// service only acts on it if the user's source deletion policy is REMOVE.
+const RemoveMetadataKey = "remove_at_destination"
+
+// AddConfigMetadata copies a destination's config into an upload event's
+// enrichment metadata, prefixing each key with destID. The destination's
+// credential, if it has one, is left out: the destination reads it from the
+// user's integration secrets, so it never travels through Pub/Sub.
+func AddConfigMetadata(metadata map[string]string, destID string, config map[string]string) {
+	secretKey, hasSecret := storage.DestinationSecretKey(destID)
+	for k, v := range config {
+		if hasSecret && k == secretKey {
+			continue
+		}
+		metadata[destID+"_"+k] = v
+	}
+}
+
+// Remover is implemented by destinations that can take down an activity they
+// uploaded, once it has been deleted on its source platform. Destinations
+// whose API can't delete (e.g. Strava) archive it instead.
+type Remover interface {
+	// Remove deletes or archives the activity uploaded as externalID. An
+	// activity that is already gone is not an error.
+	Remove(ctx context.Context, payload *pbevents.ActivityPayload, user *user.Record, externalID string) error
+}
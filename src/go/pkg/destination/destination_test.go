@@ -0,0 +1,19 @@
+package destination
+
+import "testing"
+
+func TestAddConfigMetadata(t *testing.T) {
+	metadata := map[string]string{}
+	AddConfigMetadata(metadata, "webdav", map[string]string{"url": "https://dav.example.com", "password": "secret"})
+	AddConfigMetadata(metadata, "hevy", map[string]string{"password": "not-a-secret-here"})
+
+	if metadata["webdav_url"] != "https://dav.example.com" {
+		t.Errorf("Expected webdav_url, got %v", metadata)
+	}
+	if _, ok := metadata["webdav_password"]; ok {
+		t.Error("Expected the WebDAV password to be left out")
+	}
+	if metadata["hevy_password"] != "not-a-secret-here" {
+		t.Error("Expected keys of other destinations to be copied")
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/fitglue/server/src/go/internal/infra"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
+	httputil "github.com/fitglue/server/src/go/pkg/infrastructure/http"
+	storage "github.com/fitglue/server/src/go/pkg/storage/firestore"
 
 	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 
@@ -20,16 +23,18 @@ import (
 // Database interface subset needed for destination updates
 // This matches the shared Database interface in interfaces.go
 type Database interface {
-	UpdatePipelineRun(ctx context.Context, userId string, id string, data map[string]interface{}) error
-	SetDestinationOutcome(ctx context.Context, userId string, pipelineRunId string, outcome *pbpipeline.DestinationOutcome) error
-	GetDestinationOutcomes(ctx context.Context, userId string, pipelineRunId string) ([]*pbpipeline.DestinationOutcome, error)
+	UpdateDestinationOutcomes(ctx context.Context, userId string, pipelineRunId string, fn func(run *pbpipeline.PipelineRun, outcomes []*pbpipeline.DestinationOutcome) ([]*pbpipeline.DestinationOutcome, map[string]interface{})) error
+	ListStuckPipelineRuns(ctx context.Context, before time.Time, limit int) ([]*shared.UserPipelineRun, error)
 	GetUser(ctx context.Context, id string) (*user.Record, error)
 }
 
 // UpdateStatus updates a single destination's status using the subcollection pattern.
 // Each destination is written as a separate document in the destination_outcomes subcollection,
-// eliminating race conditions between parallel uploaders.
-// When all destinations have reached a terminal status, a push notification is sent to the user.
+// and the run's overall status is recomputed from every outcome in the same Firestore
+// transaction, so parallel uploaders can never leave a stale status behind.
+// When the run reaches a terminal status, a push notification is sent to the user
+// by whichever update moved it there. API calls recorded on ctx (see
+// httputil.WithCallRecorder) are kept on the outcome for troubleshooting.
 // Parameters:
 //   - db: the Database interface for Firestore operations
 //   - notifications: the notification service for sending push notifications (can be nil)
@@ -51,6 +56,7 @@ func UpdateStatus(ctx context.Context, db Database, notifications shared.Notific
 		Destination: dest,
 		Status:      status,
 		CompletedAt: timestamppb.Now(),
+		ApiCalls:    apiCalls(ctx),
 	}
 	if externalId != "" {
 		outcome.ExternalId = &externalId
@@ -59,27 +65,76 @@ func UpdateStatus(ctx context.Context, db Database, notifications shared.Notific
 		outcome.Error = &errMsg
 	}
 
-	// Write directly to subcollection - each destination has its own document
-	// No read-modify-write needed, eliminating race conditions
-	if err := db.SetDestinationOutcome(ctx, userId, pipelineRunId, outcome); err != nil {
-		logger.Error(ctx, "Failed to set destination outcome", "error", err, "pipeline_run_id", pipelineRunId, "destination", dest.String())
+	// Set by the last (committed) attempt of the transaction
+	var (
+		previousStatus pbpipeline.PipelineRunStatus
+		newStatus      pbpipeline.PipelineRunStatus
+		outcomes       []*pbpipeline.DestinationOutcome
+		runFound       bool
+	)
+	err := db.UpdateDestinationOutcomes(ctx, userId, pipelineRunId, func(run *pbpipeline.PipelineRun, current []*pbpipeline.DestinationOutcome) ([]*pbpipeline.DestinationOutcome, map[string]interface{}) {
+		outcomes = mergeOutcome(current, outcome)
+		newStatus = ComputePipelineRunStatus(outcomes)
+		runFound = run != nil
+		if !runFound {
+			return []*pbpipeline.DestinationOutcome{outcome}, nil
+		}
+		previousStatus = run.Status
+		if previousStatus == pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DELETED_AT_SOURCE {
+			// Removals after a source deletion don't bring the run back
+			newStatus = previousStatus
+		}
+
+		// Update the parent pipeline run's overall status AND inline destinations array
+		return []*pbpipeline.DestinationOutcome{outcome}, map[string]interface{}{
+			"status":       int32(newStatus),
+			"updated_at":   timestamppb.Now(),
+			"destinations": outcomesToFirestore(outcomes),
+		}
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to update destination outcome", "error", err, "pipeline_run_id", pipelineRunId, "destination", dest.String())
+		return
+	}
+	if !runFound {
+		logger.Warn(ctx, "Set destination outcome for a missing pipeline run", "pipeline_run_id", pipelineRunId, "destination", dest.String())
 		return
 	}
 
-	logger.Debug(ctx, "Set destination outcome in subcollection", "pipeline_run_id", pipelineRunId, "destination", dest.String(), "status", status.String())
+	logger.Debug(ctx, "Updated pipeline run status and destinations", "pipeline_run_id", pipelineRunId, "destination", dest.String(), "status", newStatus.String(), "destinations_count", len(outcomes))
 
-	// Now compute and update the overall pipeline status
-	// Read all destination outcomes from subcollection
-	outcomes, err := db.GetDestinationOutcomes(ctx, userId, pipelineRunId)
-	if err != nil {
-		logger.Warn(ctx, "Failed to get destination outcomes for status computation", "error", err, "pipeline_run_id", pipelineRunId)
+	// Send push notification when all destinations have reached a terminal status.
+	// Only the update that changed the run's status sends it, so a redelivered
+	// upload doesn't notify twice.
+	if newStatus == previousStatus {
 		return
 	}
+	if newStatus == pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED || newStatus == pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_PARTIAL {
+		sendSyncNotification(ctx, db, notifications, userId, activityName, activityId, newStatus, outcomes, logger)
+	}
+}
 
-	newStatus := ComputePipelineRunStatus(outcomes)
+// mergeOutcome returns outcomes with outcome in place of the entry for its
+// destination, or appended when the destination has none yet
+func mergeOutcome(outcomes []*pbpipeline.DestinationOutcome, outcome *pbpipeline.DestinationOutcome) []*pbpipeline.DestinationOutcome {
+	merged := make([]*pbpipeline.DestinationOutcome, 0, len(outcomes)+1)
+	replaced := false
+	for _, o := range outcomes {
+		if o.Destination == outcome.Destination {
+			o = outcome
+			replaced = true
+		}
+		merged = append(merged, o)
+	}
+	if !replaced {
+		merged = append(merged, outcome)
+	}
+	return merged
+}
 
-	// Convert outcomes to Firestore-compatible format for the inline destinations array
-	// This keeps the inline array in sync with the subcollection for UI consumers
+// outcomesToFirestore converts outcomes to the run's inline destinations array.
+// This keeps the inline array in sync with the subcollection for UI consumers.
+func outcomesToFirestore(outcomes []*pbpipeline.DestinationOutcome) []map[string]interface{} {
 	destinationsData := make([]map[string]interface{}, len(outcomes))
 	for i, o := range outcomes {
 		destData := map[string]interface{}{
@@ -95,26 +150,32 @@ func UpdateStatus(ctx context.Context, db Database, notifications shared.Notific
 		if o.CompletedAt != nil {
 			destData["completed_at"] = o.CompletedAt.AsTime()
 		}
+		if len(o.ApiCalls) > 0 {
+			destData["api_calls"] = storage.DestinationApiCallsToFirestore(o.ApiCalls)
+		}
 		destinationsData[i] = destData
 	}
+	return destinationsData
+}
 
-	// Update the parent pipeline run's overall status AND inline destinations array
-	updateData := map[string]interface{}{
-		"status":       int32(newStatus),
-		"updated_at":   timestamppb.Now(),
-		"destinations": destinationsData,
-	}
-
-	if err := db.UpdatePipelineRun(ctx, userId, pipelineRunId, updateData); err != nil {
-		logger.Error(ctx, "Failed to update pipeline run status", "error", err, "pipeline_run_id", pipelineRunId)
-	} else {
-		logger.Debug(ctx, "Updated pipeline run status and destinations", "pipeline_run_id", pipelineRunId, "status", newStatus.String(), "destinations_count", len(destinationsData))
-	}
-
-	// Send push notification when all destinations have reached a terminal status
-	if newStatus == pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED || newStatus == pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_PARTIAL {
-		sendSyncNotification(ctx, db, notifications, userId, activityName, activityId, newStatus, outcomes, logger)
+// apiCalls converts the calls recorded on ctx for an outcome
+func apiCalls(ctx context.Context) []*pbpipeline.DestinationApiCall {
+	recorded := httputil.CallRecorderFrom(ctx).Calls()
+	calls := make([]*pbpipeline.DestinationApiCall, 0, len(recorded))
+	for _, c := range recorded {
+		call := &pbpipeline.DestinationApiCall{
+			Method:     c.Method,
+			Url:        c.URL,
+			StatusCode: int32(c.StatusCode),
+			DurationMs: c.Duration.Milliseconds(),
+			StartedAt:  timestamppb.New(c.StartedAt),
+		}
+		if c.Error != "" {
+			call.Error = &c.Error
+		}
+		calls = append(calls, call)
 	}
+	return calls
 }
 
 // sendSyncNotification sends a push notification when all destinations have completed.
@@ -186,7 +247,11 @@ func FormatDestinationName(dest pbplugin.DestinationType) string {
 	return formatters.FormatDestination(dest)
 }
 
-// ComputePipelineRunStatus determines overall status from destination outcomes
+// ComputePipelineRunStatus determines overall status from destination outcomes.
+// Uploads queued behind a platform outage hold the run in QUEUED_PLATFORM_OUTAGE
+// (rather than PARTIAL) once everything else has finished, since they will
+// still complete on their own; uploads held by a posting schedule likewise
+// hold it in SCHEDULED.
 func ComputePipelineRunStatus(destinations []*pbpipeline.DestinationOutcome) pbpipeline.PipelineRunStatus {
 	if len(destinations) == 0 {
 		return pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING
@@ -195,6 +260,8 @@ func ComputePipelineRunStatus(destinations []*pbpipeline.DestinationOutcome) pbp
 	allSuccess := true
 	anyFailed := false
 	allComplete := true
+	anyQueued := false
+	anyScheduled := false
 
 	for _, d := range destinations {
 		switch d.Status {
@@ -208,12 +275,26 @@ func ComputePipelineRunStatus(destinations []*pbpipeline.DestinationOutcome) pbp
 			// Good
 		case pbpipeline.DestinationStatus_DESTINATION_STATUS_SKIPPED:
 			// Skipped doesn't count as failure
+		case pbpipeline.DestinationStatus_DESTINATION_STATUS_REMOVED:
+			// Only set on runs DELETED_AT_SOURCE, which keep that status
+		case pbpipeline.DestinationStatus_DESTINATION_STATUS_QUEUED_PLATFORM_OUTAGE:
+			anyQueued = true
+			allSuccess = false
+		case pbpipeline.DestinationStatus_DESTINATION_STATUS_SCHEDULED:
+			anyScheduled = true
+			allSuccess = false
 		}
 	}
 
 	if !allComplete {
 		return pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING
 	}
+	if anyQueued {
+		return pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_QUEUED_PLATFORM_OUTAGE
+	}
+	if anyScheduled {
+		return pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SCHEDULED
+	}
 	if allSuccess {
 		return pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED
 	}
@@ -10,41 +10,55 @@ import (
 	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 
 	"github.com/fitglue/server/src/go/internal/infra"
+	shared "github.com/fitglue/server/src/go/pkg"
+	httputil "github.com/fitglue/server/src/go/pkg/infrastructure/http"
 
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 // --- Mock Database ---
 
 type MockDatabase struct {
-	Outcomes       []*pbpipeline.DestinationOutcome
-	SetOutcomeFunc func(ctx context.Context, userId string, pipelineRunId string, outcome *pbpipeline.DestinationOutcome) error
-	UpdateRunFunc  func(ctx context.Context, userId string, id string, data map[string]interface{}) error
-	GetUserFunc    func(ctx context.Context, id string) (*user.Record, error)
+	Outcomes    []*pbpipeline.DestinationOutcome
+	Run         *pbpipeline.PipelineRun // defaults to a RUNNING run
+	RunUpdates  []map[string]interface{}
+	StuckRuns   []*shared.UserPipelineRun
+	GetUserFunc func(ctx context.Context, id string) (*user.Record, error)
 }
 
-func (m *MockDatabase) SetDestinationOutcome(ctx context.Context, userId string, pipelineRunId string, outcome *pbpipeline.DestinationOutcome) error {
-	if m.SetOutcomeFunc != nil {
-		return m.SetOutcomeFunc(ctx, userId, pipelineRunId, outcome)
+// UpdateDestinationOutcomes applies fn like the Firestore transaction would,
+// merging the outcomes it returns by destination
+func (m *MockDatabase) UpdateDestinationOutcomes(ctx context.Context, userId string, pipelineRunId string, fn func(run *pbpipeline.PipelineRun, outcomes []*pbpipeline.DestinationOutcome) ([]*pbpipeline.DestinationOutcome, map[string]interface{})) error {
+	if m.Run == nil {
+		m.Run = &pbpipeline.PipelineRun{Id: pipelineRunId, Status: pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING}
 	}
-	m.Outcomes = append(m.Outcomes, outcome)
-	return nil
-}
-
-func (m *MockDatabase) GetDestinationOutcomes(ctx context.Context, userId string, pipelineRunId string) ([]*pbpipeline.DestinationOutcome, error) {
-	return m.Outcomes, nil
-}
+	current := make([]*pbpipeline.DestinationOutcome, len(m.Outcomes))
+	copy(current, m.Outcomes)
 
-func (m *MockDatabase) UpdatePipelineRun(ctx context.Context, userId string, id string, data map[string]interface{}) error {
-	if m.UpdateRunFunc != nil {
-		return m.UpdateRunFunc(ctx, userId, id, data)
+	changed, data := fn(m.Run, current)
+	for _, o := range changed {
+		m.Outcomes = mergeOutcome(m.Outcomes, o)
+	}
+	if data != nil {
+		m.RunUpdates = append(m.RunUpdates, data)
+		if status, ok := data["status"].(int32); ok {
+			m.Run.Status = pbpipeline.PipelineRunStatus(status)
+		}
 	}
 	return nil
 }
 
+func (m *MockDatabase) ListStuckPipelineRuns(ctx context.Context, before time.Time, limit int) ([]*shared.UserPipelineRun, error) {
+	return m.StuckRuns, nil
+}
+
 func (m *MockDatabase) GetUser(ctx context.Context, id string) (*user.Record, error) {
 	if m.GetUserFunc != nil {
 		return m.GetUserFunc(ctx, id)
@@ -130,6 +144,37 @@ func TestComputePipelineRunStatus_SuccessAndSkipped(t *testing.T) {
 	}
 }
 
+func TestComputePipelineRunStatus_QueuedForOutage(t *testing.T) {
+	outcomes := []*pbpipeline.DestinationOutcome{
+		{Destination: pbplugin.DestinationType_DESTINATION_STRAVA, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_QUEUED_PLATFORM_OUTAGE},
+		{Destination: pbplugin.DestinationType_DESTINATION_HEVY, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_FAILED},
+	}
+	status := ComputePipelineRunStatus(outcomes)
+	if status != pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_QUEUED_PLATFORM_OUTAGE {
+		t.Errorf("expected QUEUED_PLATFORM_OUTAGE, got %v", status)
+	}
+
+	outcomes = append(outcomes, &pbpipeline.DestinationOutcome{Destination: pbplugin.DestinationType_DESTINATION_INTERVALS, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_PENDING})
+	if status := ComputePipelineRunStatus(outcomes); status != pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING {
+		t.Errorf("expected RUNNING while uploads are pending, got %v", status)
+	}
+}
+
+func TestComputePipelineRunStatus_Scheduled(t *testing.T) {
+	outcomes := []*pbpipeline.DestinationOutcome{
+		{Destination: pbplugin.DestinationType_DESTINATION_STRAVA, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_SCHEDULED},
+		{Destination: pbplugin.DestinationType_DESTINATION_HEVY, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS},
+	}
+	if status := ComputePipelineRunStatus(outcomes); status != pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SCHEDULED {
+		t.Errorf("expected SCHEDULED, got %v", status)
+	}
+
+	outcomes = append(outcomes, &pbpipeline.DestinationOutcome{Destination: pbplugin.DestinationType_DESTINATION_INTERVALS, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_QUEUED_PLATFORM_OUTAGE})
+	if status := ComputePipelineRunStatus(outcomes); status != pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_QUEUED_PLATFORM_OUTAGE {
+		t.Errorf("expected QUEUED_PLATFORM_OUTAGE to win over SCHEDULED, got %v", status)
+	}
+}
+
 func TestUpdateStatus_SendsNotificationOnSynced(t *testing.T) {
 	notifications := &MockNotifications{}
 	db := &MockDatabase{
@@ -206,6 +251,79 @@ func TestUpdateStatus_SendsNotificationOnPartial(t *testing.T) {
 	}
 }
 
+func TestUpdateStatus_NotifiesOnceOnRedelivery(t *testing.T) {
+	notifications := &MockNotifications{}
+	db := &MockDatabase{
+		Outcomes: []*pbpipeline.DestinationOutcome{
+			{Destination: pbplugin.DestinationType_DESTINATION_STRAVA, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_PENDING},
+			{Destination: pbplugin.DestinationType_DESTINATION_HEVY, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS},
+		},
+		GetUserFunc: func(ctx context.Context, id string) (*user.Record, error) {
+			return &user.Record{
+				UserProfile: &pbuser.UserProfile{
+					FcmTokens: []string{"token1"},
+				},
+			}, nil
+		},
+	}
+	logger := infra.NewLogger()
+
+	// The same upload delivered twice only moves the run to SYNCED once
+	for i := 0; i < 2; i++ {
+		UpdateStatus(context.Background(), db, notifications, "user1", "run1",
+			pbplugin.DestinationType_DESTINATION_STRAVA, pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS,
+			"strava-123", "", "Morning Run", "activity-7", logger)
+	}
+
+	if len(notifications.Sent) != 1 {
+		t.Fatalf("expected 1 notification, got %d", len(notifications.Sent))
+	}
+	if len(db.Outcomes) != 2 || db.Outcomes[0].GetExternalId() != "strava-123" {
+		t.Errorf("expected Strava's outcome to be replaced in place, got %v", db.Outcomes)
+	}
+	last := db.RunUpdates[len(db.RunUpdates)-1]
+	if last["status"] != int32(pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED) {
+		t.Errorf("expected SYNCED, got %v", last["status"])
+	}
+	if dests, _ := last["destinations"].([]map[string]interface{}); len(dests) != 2 {
+		t.Errorf("expected both destinations inline, got %v", last["destinations"])
+	}
+}
+
+func TestUpdateStatus_KeepsAPICalls(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `{"error":"Improperly formatted data"}`)
+	}))
+	defer server.Close()
+
+	ctx, _ := httputil.WithCallRecorder(context.Background())
+	client := &http.Client{Transport: &httputil.CallRecordingTransport{}}
+	req, _ := http.NewRequestWithContext(ctx, "POST", server.URL+"/api/v3/uploads", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	db := &MockDatabase{}
+	UpdateStatus(ctx, db, nil, "user1", "run1",
+		pbplugin.DestinationType_DESTINATION_STRAVA, pbpipeline.DestinationStatus_DESTINATION_STATUS_FAILED,
+		"", "Strava upload failed (status 400)", "Morning Run", "activity-8", infra.NewLogger())
+
+	if len(db.Outcomes) != 1 || len(db.Outcomes[0].ApiCalls) != 1 {
+		t.Fatalf("expected the outcome to keep 1 API call, got %v", db.Outcomes)
+	}
+	call := db.Outcomes[0].ApiCalls[0]
+	if call.Method != "POST" || call.Url != server.URL+"/api/v3/uploads" || call.StatusCode != 400 || call.GetError() != `{"error":"Improperly formatted data"}` {
+		t.Errorf("unexpected API call: %v", call)
+	}
+	dests, _ := db.RunUpdates[0]["destinations"].([]map[string]interface{})
+	if len(dests) != 1 || dests[0]["api_calls"] == nil {
+		t.Errorf("expected API calls in the inline destinations, got %v", db.RunUpdates[0]["destinations"])
+	}
+}
+
 func TestUpdateStatus_NoNotificationWhileRunning(t *testing.T) {
 	notifications := &MockNotifications{}
 	db := &MockDatabase{
@@ -0,0 +1,93 @@
+package destination
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// stuckRunBatchSize caps how many runs one reconciliation sweep settles.
+const stuckRunBatchSize = 100
+
+// stuckRunError is recorded on destinations still pending when their run is
+// reconciled, and as the status message of runs that never reached one.
+const stuckRunError = "Timed out waiting for the upload to finish"
+
+// ReconcileStuckRuns settles RUNNING runs that haven't been updated for
+// olderThan, returning how many it changed. Each run's status is recomputed
+// from its destination outcomes, which repairs runs whose last status write
+// was lost; destinations still pending by then are marked FAILED, and runs
+// with no destinations at all are marked FAILED. Runs waiting on a scheduled
+// enricher retry are left to the retry sweep. Users are not notified.
+func ReconcileStuckRuns(ctx context.Context, db Database, olderThan time.Duration, logger infra.Logger) (int, error) {
+	cutoff := time.Now().Add(-olderThan)
+	stuck, err := db.ListStuckPipelineRuns(ctx, cutoff, stuckRunBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("list stuck runs: %w", err)
+	}
+
+	reconciled := 0
+	for _, s := range stuck {
+		if s.Run.NextRetryAt != nil {
+			continue
+		}
+		var status pbpipeline.PipelineRunStatus
+		err := db.UpdateDestinationOutcomes(ctx, s.UserId, s.Run.Id, func(run *pbpipeline.PipelineRun, outcomes []*pbpipeline.DestinationOutcome) ([]*pbpipeline.DestinationOutcome, map[string]interface{}) {
+			status = pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_UNSPECIFIED
+			// Skip runs that moved on since they were listed
+			if run == nil || run.Status != pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING ||
+				run.UpdatedAt == nil || !run.UpdatedAt.AsTime().Before(cutoff) {
+				return nil, nil
+			}
+			changed, updateData := reconcileStuckRun(outcomes)
+			status = pbpipeline.PipelineRunStatus(updateData["status"].(int32))
+			return changed, updateData
+		})
+		if err != nil {
+			logger.Error(ctx, "Failed to reconcile stuck pipeline run", "error", err, "user_id", s.UserId, "pipeline_run_id", s.Run.Id)
+			continue
+		}
+		if status != pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_UNSPECIFIED {
+			logger.Info(ctx, "Reconciled stuck pipeline run", "user_id", s.UserId, "pipeline_run_id", s.Run.Id, "status", status.String())
+			reconciled++
+		}
+	}
+	return reconciled, nil
+}
+
+// reconcileStuckRun fails the run's pending destinations and returns them with
+// the run update
+func reconcileStuckRun(outcomes []*pbpipeline.DestinationOutcome) ([]*pbpipeline.DestinationOutcome, map[string]interface{}) {
+	var changed []*pbpipeline.DestinationOutcome
+	for i, o := range outcomes {
+		if o.Status != pbpipeline.DestinationStatus_DESTINATION_STATUS_PENDING {
+			continue
+		}
+		errMsg := stuckRunError
+		failed := &pbpipeline.DestinationOutcome{
+			Destination: o.Destination,
+			Status:      pbpipeline.DestinationStatus_DESTINATION_STATUS_FAILED,
+			Error:       &errMsg,
+			CompletedAt: timestamppb.Now(),
+		}
+		outcomes[i] = failed
+		changed = append(changed, failed)
+	}
+
+	updateData := map[string]interface{}{
+		"updated_at":   timestamppb.Now(),
+		"destinations": outcomesToFirestore(outcomes),
+	}
+	status := ComputePipelineRunStatus(outcomes)
+	if len(outcomes) == 0 {
+		status = pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_FAILED
+		updateData["status_message"] = stuckRunError
+	}
+	updateData["status"] = int32(status)
+	return changed, updateData
+}
@@ -0,0 +1,103 @@
+package destination
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	shared "github.com/fitglue/server/src/go/pkg"
+
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func stuckRun(updated time.Time) *pbpipeline.PipelineRun {
+	return &pbpipeline.PipelineRun{
+		Id:        "run1",
+		Status:    pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING,
+		UpdatedAt: timestamppb.New(updated),
+	}
+}
+
+func TestReconcileStuckRuns(t *testing.T) {
+	stale := time.Now().Add(-7 * time.Hour)
+
+	tests := []struct {
+		name       string
+		outcomes   []*pbpipeline.DestinationOutcome
+		wantStatus pbpipeline.PipelineRunStatus
+		wantFailed int
+	}{
+		{
+			name: "lost status write",
+			outcomes: []*pbpipeline.DestinationOutcome{
+				{Destination: pbplugin.DestinationType_DESTINATION_STRAVA, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS},
+				{Destination: pbplugin.DestinationType_DESTINATION_HEVY, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS},
+			},
+			wantStatus: pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED,
+		},
+		{
+			name: "upload never finished",
+			outcomes: []*pbpipeline.DestinationOutcome{
+				{Destination: pbplugin.DestinationType_DESTINATION_STRAVA, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS},
+				{Destination: pbplugin.DestinationType_DESTINATION_HEVY, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_PENDING},
+			},
+			wantStatus: pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_PARTIAL,
+			wantFailed: 1,
+		},
+		{
+			name:       "no destinations",
+			wantStatus: pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_FAILED,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &MockDatabase{
+				Outcomes:  tt.outcomes,
+				Run:       stuckRun(stale),
+				StuckRuns: []*shared.UserPipelineRun{{UserId: "user1", Run: stuckRun(stale)}},
+			}
+
+			reconciled, err := ReconcileStuckRuns(context.Background(), db, 6*time.Hour, infra.NewLogger())
+			if err != nil || reconciled != 1 {
+				t.Fatalf("expected 1 run reconciled, got %d (%v)", reconciled, err)
+			}
+			if db.Run.Status != tt.wantStatus {
+				t.Errorf("expected %v, got %v", tt.wantStatus, db.Run.Status)
+			}
+			failed := 0
+			for _, o := range db.Outcomes {
+				if o.Status == pbpipeline.DestinationStatus_DESTINATION_STATUS_PENDING {
+					t.Errorf("expected no pending destinations, got %v", o.Destination)
+				}
+				if o.GetError() == stuckRunError {
+					failed++
+				}
+			}
+			if failed != tt.wantFailed {
+				t.Errorf("expected %d timed out destinations, got %d", tt.wantFailed, failed)
+			}
+		})
+	}
+}
+
+func TestReconcileStuckRuns_SkipsActiveRuns(t *testing.T) {
+	stale := time.Now().Add(-7 * time.Hour)
+	waiting := stuckRun(stale)
+	waiting.NextRetryAt = timestamppb.Now()
+
+	for name, db := range map[string]*MockDatabase{
+		// Updated by an uploader after the sweep listed it
+		"moved on": {Run: stuckRun(time.Now()), StuckRuns: []*shared.UserPipelineRun{{UserId: "user1", Run: stuckRun(stale)}}},
+		"retrying": {Run: waiting, StuckRuns: []*shared.UserPipelineRun{{UserId: "user1", Run: waiting}}},
+		"finished": {Run: &pbpipeline.PipelineRun{Id: "run1", Status: pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED, UpdatedAt: timestamppb.New(stale)}, StuckRuns: []*shared.UserPipelineRun{{UserId: "user1", Run: stuckRun(stale)}}},
+	} {
+		reconciled, err := ReconcileStuckRuns(context.Background(), db, 6*time.Hour, infra.NewLogger())
+		if err != nil || reconciled != 0 || len(db.RunUpdates) != 0 {
+			t.Errorf("%s: expected the run to be left alone, got %d reconciled, %d updates (%v)", name, reconciled, len(db.RunUpdates), err)
+		}
+	}
+}
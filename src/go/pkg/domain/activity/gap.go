@@ -0,0 +1,159 @@
+package activity
+
+import (
+	"math"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+const (
+	// GradeWindow is the distance grade is measured over, long enough that
+	// altitude noise doesn't turn into spikes.
+	GradeWindow = 20.0 // meters
+
+	// MaxGrade clamps grade to the range the cost model was fitted on.
+	MaxGrade = 0.45
+
+	// HillyGainPerKm is the climbing above which a run counts as hilly, and
+	// grade-adjusted pace differs enough from pace to be worth showing.
+	HillyGainPerKm = 10.0 // meters per km
+)
+
+// GradeAdjustedSample is one record with its grade-adjusted speed: the speed
+// on flat ground that would cost the same energy.
+type GradeAdjustedSample struct {
+	Record   *pbactivity.Record
+	Seconds  float64 // since the previous sample
+	Speed    float64 // m/s
+	GAPSpeed float64 // m/s
+}
+
+// CostOfRunning is the Minetti et al. (2002) energy cost of running in J/kg/m
+// at gradient g (rise over run).
+func CostOfRunning(g float64) float64 {
+	return 155.4*math.Pow(g, 5) - 30.4*math.Pow(g, 4) - 43.3*math.Pow(g, 3) + 46.3*g*g + 19.5*g + 3.6
+}
+
+// GradeAdjustedSpeed converts speed at gradient g to its flat-ground
+// equivalent.
+func GradeAdjustedSpeed(speed, g float64) float64 {
+	g = math.Max(-MaxGrade, math.Min(MaxGrade, g))
+	return speed * CostOfRunning(g) / CostOfRunning(0)
+}
+
+// RecordSpeed returns the record's speed, falling back to the distance covered
+// since the previous record.
+func RecordSpeed(records []*pbactivity.Record, i int) float64 {
+	if records[i].Speed > 0 {
+		return records[i].Speed
+	}
+	if i == 0 {
+		return 0
+	}
+	dt := records[i].Timestamp.AsTime().Sub(records[i-1].Timestamp.AsTime()).Seconds()
+	dd := records[i].Distance - records[i-1].Distance
+	if dt <= 0 || dd <= 0 {
+		return 0
+	}
+	return dd / dt
+}
+
+// Grade returns the gradient leading up to record i over the last
+// GradeWindow meters, or 0 without enough distance or altitude data.
+func Grade(records []*pbactivity.Record, i int) float64 {
+	end := records[i]
+	if end.Distance <= 0 {
+		return 0
+	}
+	for j := i - 1; j >= 0; j-- {
+		run := end.Distance - records[j].Distance
+		if run < GradeWindow {
+			continue
+		}
+		if end.Altitude == 0 && records[j].Altitude == 0 {
+			return 0
+		}
+		g := (end.Altitude - records[j].Altitude) / run
+		return math.Max(-MaxGrade, math.Min(MaxGrade, g))
+	}
+	return 0
+}
+
+// GradeAdjust returns a grade-adjusted sample for each timed record of the
+// activity, or nil when it has no altitude data to grade with.
+func GradeAdjust(a *pbactivity.StandardizedActivity) []*GradeAdjustedSample {
+	records := timedRecords(a)
+	hasAltitude := false
+	for _, r := range records {
+		if r.Altitude != 0 {
+			hasAltitude = true
+			break
+		}
+	}
+	if !hasAltitude {
+		return nil
+	}
+
+	samples := make([]*GradeAdjustedSample, len(records))
+	for i, r := range records {
+		s := &GradeAdjustedSample{Record: r, Speed: RecordSpeed(records, i)}
+		if i > 0 {
+			s.Seconds = r.Timestamp.AsTime().Sub(records[i-1].Timestamp.AsTime()).Seconds()
+		}
+		s.GAPSpeed = GradeAdjustedSpeed(s.Speed, Grade(records, i))
+		samples[i] = s
+	}
+	return samples
+}
+
+// GradeAdjustmentFactor is the ratio of grade-adjusted pace to pace over the
+// samples: below 1 for a net climb, above 1 for a net descent, and 1 when
+// there's no moving data. Multiply a pace by it to grade-adjust it.
+func GradeAdjustmentFactor(samples []*GradeAdjustedSample) float64 {
+	var dist, flatDist float64
+	for _, s := range samples {
+		// Pauses carry no distance, and recording gaps no trustworthy speed
+		if s.Seconds <= 0 || s.Seconds > maxWorkGap {
+			continue
+		}
+		dist += s.Speed * s.Seconds
+		flatDist += s.GAPSpeed * s.Seconds
+	}
+	if dist <= 0 || flatDist <= 0 {
+		return 1
+	}
+	return dist / flatDist
+}
+
+// IsHilly reports whether the activity climbs at least HillyGainPerKm.
+func IsHilly(a *pbactivity.StandardizedActivity) bool {
+	var distance, gain, prev float64
+	for _, session := range a.GetSessions() {
+		distance += session.TotalDistance
+	}
+	for _, r := range timedRecords(a) {
+		if r.Altitude == 0 {
+			continue
+		}
+		if prev != 0 && r.Altitude > prev {
+			gain += r.Altitude - prev
+		}
+		prev = r.Altitude
+	}
+	return distance > 0 && gain/(distance/1000) >= HillyGainPerKm
+}
+
+// timedRecords flattens the activity's records that have a timestamp.
+func timedRecords(a *pbactivity.StandardizedActivity) []*pbactivity.Record {
+	var records []*pbactivity.Record
+	for _, session := range a.GetSessions() {
+		for _, lap := range session.Laps {
+			for _, r := range lap.Records {
+				if r.Timestamp != nil {
+					records = append(records, r)
+				}
+			}
+		}
+	}
+	return records
+}
@@ -0,0 +1,74 @@
+package activity
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// climb builds a 1 km run at 3 m/s on a constant gradient.
+func climb(gradient float64) *pbactivity.StandardizedActivity {
+	start := time.Date(2026, 5, 12, 18, 0, 0, 0, time.UTC)
+	var records []*pbactivity.Record
+	for i := 0; i <= 333; i++ {
+		d := float64(i) * 3
+		records = append(records, &pbactivity.Record{
+			Timestamp: timestamppb.New(start.Add(time.Duration(i) * time.Second)),
+			Speed:     3,
+			Distance:  d,
+			Altitude:  100 + d*gradient,
+		})
+	}
+	return &pbactivity.StandardizedActivity{
+		Sessions: []*pbactivity.Session{{TotalDistance: 999, Laps: []*pbactivity.Lap{{Records: records}}}},
+	}
+}
+
+func TestGradeAdjustedSpeed(t *testing.T) {
+	if got := GradeAdjustedSpeed(3, 0); got != 3 {
+		t.Errorf("GradeAdjustedSpeed() on the flat = %v, want 3", got)
+	}
+	if got := GradeAdjustedSpeed(3, 0.1); got <= 3 {
+		t.Errorf("GradeAdjustedSpeed() uphill = %v, want faster than 3", got)
+	}
+	if got := GradeAdjustedSpeed(3, -0.05); got >= 3 {
+		t.Errorf("GradeAdjustedSpeed() gentle downhill = %v, want slower than 3", got)
+	}
+	if GradeAdjustedSpeed(3, 2) != GradeAdjustedSpeed(3, MaxGrade) {
+		t.Error("GradeAdjustedSpeed() should clamp to MaxGrade")
+	}
+}
+
+func TestGradeAdjustmentFactor(t *testing.T) {
+	if f := GradeAdjustmentFactor(GradeAdjust(climb(0.05))); f >= 1 || f < 0.6 {
+		t.Errorf("GradeAdjustmentFactor() uphill = %.3f, want between 0.6 and 1", f)
+	}
+	if f := GradeAdjustmentFactor(GradeAdjust(climb(0))); math.Abs(f-1) > 1e-9 {
+		t.Errorf("GradeAdjustmentFactor() flat = %.3f, want 1", f)
+	}
+	if f := GradeAdjustmentFactor(nil); f != 1 {
+		t.Errorf("GradeAdjustmentFactor() without samples = %.3f, want 1", f)
+	}
+}
+
+func TestGradeAdjust_NoAltitude(t *testing.T) {
+	a := climb(0)
+	for _, r := range a.Sessions[0].Laps[0].Records {
+		r.Altitude = 0
+	}
+	if samples := GradeAdjust(a); samples != nil {
+		t.Errorf("GradeAdjust() without altitude = %d samples, want nil", len(samples))
+	}
+}
+
+func TestIsHilly(t *testing.T) {
+	if !IsHilly(climb(0.03)) {
+		t.Error("IsHilly() = false for 30 m/km of climbing")
+	}
+	if IsHilly(climb(0.005)) {
+		t.Error("IsHilly() = true for 5 m/km of climbing")
+	}
+}
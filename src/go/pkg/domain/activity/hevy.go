@@ -1,5 +1,5 @@
 // nolint:proto-json
-package hevy
+package activity
 
 import (
 	"encoding/json"
@@ -7,17 +7,32 @@ import (
 	"time"
 
 	hevyapi "github.com/fitglue/server/src/go/pkg/api/hevy"
-	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func mapToStandardizedActivity(rawJSON []byte, userID string, source activitypb.ActivitySource) (*activitypb.StandardizedActivity, error) {
+// hevySetTimes reads the per-set completion times a workout body may carry.
+// The generated client doesn't model them, so they are decoded separately.
+type hevySetTimes struct {
+	Exercises []struct {
+		Sets []struct {
+			CompletedAt *string `json:"completed_at"`
+		} `json:"sets"`
+	} `json:"exercises"`
+}
+
+// MapHevyWorkout converts a Hevy workout JSON body (either bare or wrapped in
+// {"workout": ...}) into a StandardizedActivity with a single strength session.
+// When every set carries a completion time, the sets are placed on the
+// workout timeline and a TimeMarker is added at each exercise change.
+func MapHevyWorkout(rawJSON []byte, userID string, source pbactivity.ActivitySource) (*pbactivity.StandardizedActivity, error) {
 	var raw map[string]interface{}
 	if err := json.Unmarshal(rawJSON, &raw); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal raw json: %w", err)
 	}
 
 	var workout hevyapi.Workout
+	workoutJSON := rawJSON
 	if workoutObj, ok := raw["workout"]; ok {
 		b, err := json.Marshal(workoutObj)
 		if err != nil {
@@ -26,16 +41,21 @@ func mapToStandardizedActivity(rawJSON []byte, userID string, source activitypb.
 		if err := json.Unmarshal(b, &workout); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal inner workout: %w", err)
 		}
+		workoutJSON = b
 	} else {
 		if err := json.Unmarshal(rawJSON, &workout); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal flat workout: %w", err)
 		}
 	}
 
-	act := &activitypb.StandardizedActivity{
+	// Set times are optional; a body without them maps as before
+	var setTimes hevySetTimes
+	_ = json.Unmarshal(workoutJSON, &setTimes)
+
+	act := &pbactivity.StandardizedActivity{
 		Source: source,
 		UserId: userID,
-		Type:   activitypb.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING,
+		Type:   pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING,
 	}
 
 	if workout.Id != nil {
@@ -62,7 +82,7 @@ func mapToStandardizedActivity(rawJSON []byte, userID string, source activitypb.
 
 	act.StartTime = timestamppb.New(startTime)
 
-	session := &activitypb.Session{
+	session := &pbactivity.Session{
 		StartTime: act.StartTime,
 	}
 
@@ -73,8 +93,9 @@ func mapToStandardizedActivity(rawJSON []byte, userID string, source activitypb.
 		}
 	}
 
+	var completedAt []time.Time
 	if workout.Exercises != nil {
-		for _, ex := range *workout.Exercises {
+		for exIdx, ex := range *workout.Exercises {
 			exName := ""
 			if ex.Title != nil {
 				exName = *ex.Title
@@ -90,8 +111,8 @@ func mapToStandardizedActivity(rawJSON []byte, userID string, source activitypb.
 			}
 
 			if ex.Sets != nil {
-				for _, s := range *ex.Sets {
-					set := &activitypb.StrengthSet{
+				for setIdx, s := range *ex.Sets {
+					set := &pbactivity.StrengthSet{
 						ExerciseName: exName,
 						Notes:        notes,
 						SupersetId:   supersetId,
@@ -112,6 +133,7 @@ func mapToStandardizedActivity(rawJSON []byte, userID string, source activitypb.
 						set.SetType = *s.Type
 					}
 					session.StrengthSets = append(session.StrengthSets, set)
+					completedAt = append(completedAt, setCompletedAt(setTimes, exIdx, setIdx))
 				}
 			}
 		}
@@ -135,7 +157,27 @@ func mapToStandardizedActivity(rawJSON []byte, userID string, source activitypb.
 		session.TotalElapsedTime = 60
 	}
 
-	act.Sessions = []*activitypb.Session{session}
+	act.Sessions = []*pbactivity.Session{session}
+
+	endTime := startTime.Add(time.Duration(session.TotalElapsedTime * float64(time.Second)))
+	act.TimeMarkers = reconstructSetTimeline(session.StrengthSets, completedAt, startTime, endTime)
 
 	return act, nil
 }
+
+// setCompletedAt returns when a set was completed, or the zero time when the
+// body doesn't say.
+func setCompletedAt(times hevySetTimes, exIdx, setIdx int) time.Time {
+	if exIdx >= len(times.Exercises) || setIdx >= len(times.Exercises[exIdx].Sets) {
+		return time.Time{}
+	}
+	raw := times.Exercises[exIdx].Sets[setIdx].CompletedAt
+	if raw == nil {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, *raw)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
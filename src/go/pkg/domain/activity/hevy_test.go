@@ -0,0 +1,145 @@
+package activity
+
+import (
+	"testing"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapHevyWorkout(t *testing.T) {
+	rawJSON := []byte(`{
+		"workout": {
+			"id": "123",
+			"title": "Push Day",
+			"start_time": "2025-12-29T08:00:06.000Z",
+			"end_time": "2025-12-29T09:00:06.000Z",
+			"exercises": [
+				{
+					"title": "Bench Press",
+					"sets": [
+						{
+							"reps": 10,
+							"weight_kg": 60,
+							"duration_seconds": 30
+						}
+					]
+				}
+			]
+		}
+	}`)
+
+	act, err := MapHevyWorkout(rawJSON, "user_uuid", pbactivity.ActivitySource_SOURCE_HEVY)
+	assert.NoError(t, err)
+	assert.NotNil(t, act)
+
+	assert.Equal(t, "123", act.ExternalId)
+	assert.Equal(t, "Push Day", act.Name)
+	assert.Equal(t, pbactivity.ActivitySource_SOURCE_HEVY, act.Source)
+	assert.Equal(t, "user_uuid", act.UserId)
+	assert.Equal(t, pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING, act.Type)
+
+	assert.Len(t, act.Sessions, 1)
+	assert.Equal(t, float64(3600), act.Sessions[0].TotalElapsedTime)
+
+	assert.Len(t, act.Sessions[0].StrengthSets, 1)
+	assert.Equal(t, "Bench Press", act.Sessions[0].StrengthSets[0].ExerciseName)
+	assert.Equal(t, int32(10), act.Sessions[0].StrengthSets[0].Reps)
+	assert.Equal(t, float64(60), act.Sessions[0].StrengthSets[0].WeightKg)
+}
+
+func TestMapHevyWorkout_SetTimeline(t *testing.T) {
+	rawJSON := []byte(`{
+		"workout": {
+			"id": "123",
+			"title": "Push Day",
+			"start_time": "2025-12-29T08:00:00Z",
+			"end_time": "2025-12-29T08:10:00Z",
+			"exercises": [
+				{
+					"title": "Bench Press",
+					"sets": [
+						{"reps": 10, "weight_kg": 60, "completed_at": "2025-12-29T08:01:00Z"},
+						{"reps": 8, "weight_kg": 60, "completed_at": "2025-12-29T08:03:00Z"}
+					]
+				},
+				{
+					"title": "Plank",
+					"sets": [
+						{"duration_seconds": 60, "completed_at": "2025-12-29T08:06:00Z"}
+					]
+				}
+			]
+		}
+	}`)
+
+	act, err := MapHevyWorkout(rawJSON, "user_uuid", pbactivity.ActivitySource_SOURCE_HEVY)
+	assert.NoError(t, err)
+
+	sets := act.Sessions[0].StrengthSets
+	assert.Len(t, sets, 3)
+
+	// 10 reps at 3s each, ending at completion
+	assert.Equal(t, "2025-12-29T08:00:30Z", sets[0].StartTime.AsTime().Format(time.RFC3339))
+	assert.Equal(t, "2025-12-29T08:01:00Z", sets[0].EndTime.AsTime().Format(time.RFC3339))
+	assert.Equal(t, "2025-12-29T08:02:36Z", sets[1].StartTime.AsTime().Format(time.RFC3339))
+	// Timed sets use their logged duration, which is left as logged
+	assert.Equal(t, "2025-12-29T08:05:00Z", sets[2].StartTime.AsTime().Format(time.RFC3339))
+	assert.Equal(t, int32(60), sets[2].DurationSeconds)
+	assert.Equal(t, int32(0), sets[0].DurationSeconds)
+
+	assert.Len(t, act.TimeMarkers, 2)
+	assert.Equal(t, "Bench Press", act.TimeMarkers[0].Label)
+	assert.Equal(t, ExerciseStartMarker, act.TimeMarkers[0].MarkerType)
+	assert.Equal(t, int32(270), act.TimeMarkers[0].DurationSeconds)
+	assert.Equal(t, "Plank", act.TimeMarkers[1].Label)
+	assert.Equal(t, int32(60), act.TimeMarkers[1].DurationSeconds)
+}
+
+func TestMapHevyWorkout_PartialSetTimesIgnored(t *testing.T) {
+	rawJSON := []byte(`{
+		"start_time": "2025-12-29T08:00:00Z",
+		"end_time": "2025-12-29T08:10:00Z",
+		"exercises": [
+			{
+				"title": "Squat",
+				"sets": [
+					{"reps": 5, "weight_kg": 100, "completed_at": "2025-12-29T08:01:00Z"},
+					{"reps": 5, "weight_kg": 100}
+				]
+			}
+		]
+	}`)
+
+	act, err := MapHevyWorkout(rawJSON, "user_uuid", pbactivity.ActivitySource_SOURCE_HEVY)
+	assert.NoError(t, err)
+	assert.Nil(t, act.Sessions[0].StrengthSets[0].StartTime)
+	assert.Nil(t, act.Sessions[0].StrengthSets[0].EndTime)
+	assert.Empty(t, act.TimeMarkers)
+}
+
+func TestReconstructSetTimeline_Superset(t *testing.T) {
+	start := time.Date(2025, 12, 29, 8, 0, 0, 0, time.UTC)
+	sets := []*pbactivity.StrengthSet{
+		{ExerciseName: "Curl", Reps: 10},
+		{ExerciseName: "Curl", Reps: 10},
+		{ExerciseName: "Pushdown", Reps: 10},
+		{ExerciseName: "Pushdown", Reps: 10},
+	}
+	// Alternating: curl, pushdown, curl, pushdown
+	completed := []time.Time{
+		start.Add(1 * time.Minute),
+		start.Add(3 * time.Minute),
+		start.Add(2 * time.Minute),
+		start.Add(4 * time.Minute),
+	}
+
+	markers := reconstructSetTimeline(sets, completed, start, start.Add(5*time.Minute))
+	assert.Len(t, markers, 4)
+	assert.Equal(t, []string{"Curl", "Pushdown", "Curl", "Pushdown"},
+		[]string{markers[0].Label, markers[1].Label, markers[2].Label, markers[3].Label})
+	// Order of the sets themselves is preserved
+	assert.Equal(t, "Curl", sets[1].ExerciseName)
+	assert.True(t, sets[2].EndTime.AsTime().Before(sets[1].EndTime.AsTime()))
+}
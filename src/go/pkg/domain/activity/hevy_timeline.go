@@ -0,0 +1,110 @@
+package activity
+
+import (
+	"sort"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// secondsPerRep estimates the working time of a set that logs reps but
+	// no duration.
+	secondsPerRep = 3
+	// minSetSeconds and maxSetSeconds bound an estimated set duration.
+	minSetSeconds = 15
+	maxSetSeconds = 90
+	// defaultSetSeconds is the estimate for a set with neither reps nor duration.
+	defaultSetSeconds = 30
+	// setTimeTolerance is how far outside the workout a completion time may
+	// fall, to allow for clock skew between the phone and Hevy's servers.
+	setTimeTolerance = time.Minute
+
+	// ExerciseStartMarker is the TimeMarker type placed where a new exercise begins.
+	ExerciseStartMarker = "exercise_start"
+)
+
+// reconstructSetTimeline places each set on the workout timeline from the
+// time it was completed: the set ends at its completion time and starts its
+// working time earlier, never before the previous set finished. The gaps
+// between sets are rest. It returns a TimeMarker at every exercise change.
+//
+// completedAt holds one time per set, in the same order as sets. The timeline
+// is only reconstructed when every set has a completion time within the
+// workout; otherwise the sets are left untouched and nil is returned.
+func reconstructSetTimeline(sets []*pbactivity.StrengthSet, completedAt []time.Time, start, end time.Time) []*pbactivity.TimeMarker {
+	if len(sets) == 0 || len(sets) != len(completedAt) {
+		return nil
+	}
+	for _, t := range completedAt {
+		if t.IsZero() || t.Before(start.Add(-setTimeTolerance)) || t.After(end.Add(setTimeTolerance)) {
+			return nil
+		}
+	}
+
+	// Supersets interleave exercises, so walk the sets in completion order
+	order := make([]int, len(sets))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return completedAt[order[a]].Before(completedAt[order[b]])
+	})
+
+	var markers []*pbactivity.TimeMarker
+	previousEnd := start
+	previousExercise := ""
+	for _, i := range order {
+		set := sets[i]
+		setEnd := completedAt[i]
+		setStart := setEnd.Add(-estimateSetDuration(set))
+		if setStart.Before(previousEnd) {
+			setStart = previousEnd
+		}
+		if setStart.After(setEnd) {
+			setStart = setEnd
+		}
+		set.StartTime = timestamppb.New(setStart)
+		set.EndTime = timestamppb.New(setEnd)
+
+		if set.ExerciseName != previousExercise {
+			markers = append(markers, &pbactivity.TimeMarker{
+				Timestamp:  set.StartTime,
+				Label:      set.ExerciseName,
+				MarkerType: ExerciseStartMarker,
+			})
+			previousExercise = set.ExerciseName
+		}
+		previousEnd = setEnd
+	}
+
+	// Each marker lasts until the next exercise starts, the last until the final set ends
+	for j, m := range markers {
+		until := previousEnd
+		if j+1 < len(markers) {
+			until = markers[j+1].Timestamp.AsTime()
+		}
+		m.DurationSeconds = int32(until.Sub(m.Timestamp.AsTime()).Seconds())
+	}
+	return markers
+}
+
+// estimateSetDuration is the logged duration of a timed set, or an estimate
+// from its reps.
+func estimateSetDuration(set *pbactivity.StrengthSet) time.Duration {
+	seconds := int32(defaultSetSeconds)
+	switch {
+	case set.DurationSeconds > 0:
+		return time.Duration(set.DurationSeconds) * time.Second
+	case set.Reps > 0:
+		seconds = set.Reps * secondsPerRep
+		if seconds < minSetSeconds {
+			seconds = minSetSeconds
+		}
+		if seconds > maxSetSeconds {
+			seconds = maxSetSeconds
+		}
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,48 @@
+package activity
+
+import (
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ShiftTimestamps moves every timestamp on the activity by d, keeping the
+// relative timing of sessions, laps, records, sets and markers intact. It is
+// used to correct activities recorded on a device with a wrong clock.
+func ShiftTimestamps(a *pbactivity.StandardizedActivity, d time.Duration) {
+	if a == nil || d == 0 {
+		return
+	}
+
+	a.StartTime = shift(a.StartTime, d)
+	for _, session := range a.Sessions {
+		session.StartTime = shift(session.StartTime, d)
+		for _, lap := range session.Laps {
+			lap.StartTime = shift(lap.StartTime, d)
+			for _, record := range lap.Records {
+				record.Timestamp = shift(record.Timestamp, d)
+			}
+		}
+		for _, set := range session.StrengthSets {
+			set.StartTime = shift(set.StartTime, d)
+			set.EndTime = shift(set.EndTime, d)
+		}
+	}
+	for _, marker := range a.TimeMarkers {
+		marker.Timestamp = shift(marker.Timestamp, d)
+	}
+	if a.HybridRaceSummary != nil {
+		for _, segment := range a.HybridRaceSummary.Segments {
+			segment.StartTime = shift(segment.StartTime, d)
+		}
+	}
+}
+
+// shift leaves unset timestamps unset rather than inventing a time for them.
+func shift(ts *timestamppb.Timestamp, d time.Duration) *timestamppb.Timestamp {
+	if ts == nil {
+		return nil
+	}
+	return timestamppb.New(ts.AsTime().Add(d))
+}
@@ -0,0 +1,57 @@
+package activity
+
+import (
+	"testing"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestShiftTimestamps(t *testing.T) {
+	start := time.Date(1989, 12, 31, 0, 0, 0, 0, time.UTC)
+	at := func(sec int) *timestamppb.Timestamp {
+		return timestamppb.New(start.Add(time.Duration(sec) * time.Second))
+	}
+	a := &pbactivity.StandardizedActivity{
+		StartTime: at(0),
+		Sessions: []*pbactivity.Session{{
+			StartTime: at(0),
+			Laps: []*pbactivity.Lap{{
+				StartTime: at(0),
+				Records:   []*pbactivity.Record{{Timestamp: at(0)}, {Timestamp: at(1)}, {}},
+			}},
+			StrengthSets: []*pbactivity.StrengthSet{{StartTime: at(30)}},
+		}},
+		TimeMarkers:       []*pbactivity.TimeMarker{{Timestamp: at(60)}},
+		HybridRaceSummary: &pbactivity.HybridRaceSummary{Segments: []*pbactivity.HybridRaceSegment{{StartTime: at(90)}}},
+	}
+
+	offset := 36*365*24*time.Hour + 5*time.Minute
+	ShiftTimestamps(a, offset)
+
+	want := func(sec int) time.Time { return start.Add(offset + time.Duration(sec)*time.Second) }
+	checks := []struct {
+		name string
+		got  *timestamppb.Timestamp
+		want time.Time
+	}{
+		{"activity start", a.StartTime, want(0)},
+		{"session start", a.Sessions[0].StartTime, want(0)},
+		{"lap start", a.Sessions[0].Laps[0].StartTime, want(0)},
+		{"record 0", a.Sessions[0].Laps[0].Records[0].Timestamp, want(0)},
+		{"record 1", a.Sessions[0].Laps[0].Records[1].Timestamp, want(1)},
+		{"strength set", a.Sessions[0].StrengthSets[0].StartTime, want(30)},
+		{"time marker", a.TimeMarkers[0].Timestamp, want(60)},
+		{"race segment", a.HybridRaceSummary.Segments[0].StartTime, want(90)},
+	}
+	for _, c := range checks {
+		if !c.got.AsTime().Equal(c.want) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, c.got.AsTime())
+		}
+	}
+
+	if a.Sessions[0].Laps[0].Records[2].Timestamp != nil {
+		t.Error("Expected an unset record timestamp to stay unset")
+	}
+}
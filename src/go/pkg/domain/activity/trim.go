@@ -0,0 +1,159 @@
+package activity
+
+import (
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+// TimeRange is a stretch of an activity's timeline from Start up to, but not
+// including, End.
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Contains reports whether t falls inside the range.
+func (r TimeRange) Contains(t time.Time) bool {
+	return !t.Before(r.Start) && t.Before(r.End)
+}
+
+// RecordField names a record value that ClearRecords can remove.
+type RecordField int
+
+const (
+	FieldHeartRate RecordField = iota
+	FieldPower
+	// FieldDistance clears speed and holds cumulative distance still, so the
+	// stretch no longer counts towards the activity's distance.
+	FieldDistance
+)
+
+// FieldRange clears one record field over a stretch of the timeline.
+type FieldRange struct {
+	Field RecordField
+	TimeRange
+}
+
+// TrimRecords removes every record inside the ranges, along with the distance
+// covered across each removed stretch, and returns how many were removed.
+// Session totals other than distance are left as recorded.
+func TrimRecords(a *pbactivity.StandardizedActivity, ranges []TimeRange) int {
+	if a == nil || len(ranges) == 0 {
+		return 0
+	}
+	n := 0
+	for _, session := range a.Sessions {
+		n += excise(session, func(t time.Time) bool { return inAny(ranges, t) }, true)
+	}
+	return n
+}
+
+// ClearRecords clears the named field on every record inside its range,
+// keeping the records themselves, and returns how many values were cleared.
+func ClearRecords(a *pbactivity.StandardizedActivity, ranges []FieldRange) int {
+	if a == nil || len(ranges) == 0 {
+		return 0
+	}
+
+	var distance []TimeRange
+	n := 0
+	for _, fr := range ranges {
+		if fr.Field == FieldDistance {
+			distance = append(distance, fr.TimeRange)
+			continue
+		}
+		for _, session := range a.Sessions {
+			for _, lap := range session.Laps {
+				for _, r := range lap.Records {
+					if r.Timestamp == nil || !fr.Contains(r.Timestamp.AsTime()) {
+						continue
+					}
+					switch fr.Field {
+					case FieldHeartRate:
+						if r.HeartRate != 0 {
+							r.HeartRate = 0
+							n++
+						}
+					case FieldPower:
+						if r.Power != 0 {
+							r.Power = 0
+							n++
+						}
+					}
+				}
+			}
+		}
+	}
+	if len(distance) > 0 {
+		for _, session := range a.Sessions {
+			n += excise(session, func(t time.Time) bool { return inAny(distance, t) }, false)
+		}
+	}
+	return n
+}
+
+// excise walks a session's records in order and takes the distance covered
+// across each stretch where inside reports true off every later record and
+// the session total. Records in a stretch are removed when trim is set;
+// otherwise their speed is cleared and their distance held. It returns the
+// number of records in a stretch.
+func excise(session *pbactivity.Session, inside func(time.Time) bool, trim bool) int {
+	var removed float64
+	var before, last float64 // raw distance before the current stretch, and within it
+	inStretch := false
+	n := 0
+
+	for _, lap := range session.Laps {
+		kept := lap.Records[:0]
+		for _, r := range lap.Records {
+			raw := r.Distance
+			if r.Timestamp != nil && inside(r.Timestamp.AsTime()) {
+				n++
+				inStretch = true
+				if raw > 0 {
+					last = raw
+				}
+				if trim {
+					continue
+				}
+				r.Speed = 0
+				if raw > 0 {
+					r.Distance = before - removed
+				}
+				kept = append(kept, r)
+				continue
+			}
+
+			if inStretch {
+				if raw > 0 {
+					removed += raw - before
+				}
+				inStretch = false
+			}
+			if raw > 0 {
+				before = raw
+				r.Distance = raw - removed
+			}
+			kept = append(kept, r)
+		}
+		lap.Records = kept
+	}
+	if inStretch && last > before {
+		removed += last - before
+	}
+
+	if removed > 0 {
+		session.TotalDistance = max(session.TotalDistance-removed, 0)
+	}
+	return n
+}
+
+func inAny(ranges []TimeRange, t time.Time) bool {
+	for _, r := range ranges {
+		if r.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package activity
+
+import (
+	"testing"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var trimStart = time.Date(2026, 5, 2, 8, 0, 0, 0, time.UTC)
+
+// trimActivity is ten seconds at 10 m/s split over two laps.
+func trimActivity() *pbactivity.StandardizedActivity {
+	var records []*pbactivity.Record
+	for i := 0; i < 10; i++ {
+		records = append(records, &pbactivity.Record{
+			Timestamp: timestamppb.New(trimStart.Add(time.Duration(i) * time.Second)),
+			HeartRate: 150,
+			Power:     250,
+			Speed:     10,
+			Distance:  float64(i) * 10,
+		})
+	}
+	return &pbactivity.StandardizedActivity{
+		Sessions: []*pbactivity.Session{{
+			TotalDistance: 90,
+			Laps: []*pbactivity.Lap{
+				{Records: records[:5]},
+				{Records: records[5:]},
+			},
+		}},
+	}
+}
+
+func secondsRange(from, to int) TimeRange {
+	return TimeRange{
+		Start: trimStart.Add(time.Duration(from) * time.Second),
+		End:   trimStart.Add(time.Duration(to) * time.Second),
+	}
+}
+
+func allRecords(a *pbactivity.StandardizedActivity) []*pbactivity.Record {
+	var out []*pbactivity.Record
+	for _, lap := range a.Sessions[0].Laps {
+		out = append(out, lap.Records...)
+	}
+	return out
+}
+
+func TestTrimRecords(t *testing.T) {
+	a := trimActivity()
+	if n := TrimRecords(a, []TimeRange{secondsRange(3, 6)}); n != 3 {
+		t.Fatalf("Expected 3 records removed, got %d", n)
+	}
+
+	records := allRecords(a)
+	if len(records) != 7 {
+		t.Fatalf("Expected 7 records left, got %d", len(records))
+	}
+	if len(a.Sessions[0].Laps[0].Records) != 3 || len(a.Sessions[0].Laps[1].Records) != 4 {
+		t.Errorf("Expected the trim to span both laps, got %d and %d records",
+			len(a.Sessions[0].Laps[0].Records), len(a.Sessions[0].Laps[1].Records))
+	}
+	// The 40m from second 2 to second 6 is taken off everything after it
+	if got := records[3].Distance; got != 20 {
+		t.Errorf("Expected distance 20 after the trim, got %v", got)
+	}
+	if got := records[6].Distance; got != 50 {
+		t.Errorf("Expected final distance 50, got %v", got)
+	}
+	if got := a.Sessions[0].TotalDistance; got != 50 {
+		t.Errorf("Expected total distance 50, got %v", got)
+	}
+}
+
+func TestTrimRecords_ToTheEnd(t *testing.T) {
+	a := trimActivity()
+	TrimRecords(a, []TimeRange{secondsRange(8, 20)})
+	if got := a.Sessions[0].TotalDistance; got != 70 {
+		t.Errorf("Expected total distance 70, got %v", got)
+	}
+}
+
+func TestClearRecords(t *testing.T) {
+	a := trimActivity()
+	n := ClearRecords(a, []FieldRange{
+		{Field: FieldHeartRate, TimeRange: secondsRange(0, 2)},
+		{Field: FieldPower, TimeRange: secondsRange(9, 10)},
+		{Field: FieldDistance, TimeRange: secondsRange(3, 6)},
+	})
+	if n != 6 {
+		t.Errorf("Expected 6 values cleared, got %d", n)
+	}
+
+	records := allRecords(a)
+	if len(records) != 10 {
+		t.Fatalf("Expected every record kept, got %d", len(records))
+	}
+	if records[1].HeartRate != 0 || records[2].HeartRate != 150 {
+		t.Errorf("Expected heart rate cleared for the first two seconds only")
+	}
+	if records[9].Power != 0 || records[8].Power != 250 {
+		t.Errorf("Expected power cleared for the last second only")
+	}
+	for i, want := range []float64{0, 10, 20, 20, 20, 20, 20, 30, 40, 50} {
+		if records[i].Distance != want {
+			t.Errorf("Record %d: expected distance %v, got %v", i, want, records[i].Distance)
+		}
+	}
+	if records[4].Speed != 0 || records[6].Speed != 10 {
+		t.Errorf("Expected speed cleared inside the stretch only")
+	}
+	if got := a.Sessions[0].TotalDistance; got != 50 {
+		t.Errorf("Expected total distance 50, got %v", got)
+	}
+}
@@ -0,0 +1,58 @@
+package activity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"unicode"
+
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+// TypeRuleLearnThreshold is how many times the user must make the same type
+// correction before the learned rule is applied to new activities.
+const TypeRuleLearnThreshold = 2
+
+// NormalizeTitlePattern reduces an activity title to the words that identify
+// the kind of session, so "Hyrox Sim #3" and "hyrox sim 4" learn one rule.
+// Letters are lowercased, digits and punctuation dropped and whitespace
+// collapsed.
+func NormalizeTitlePattern(title string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// TypeRuleID returns the stable document ID for a source + title pattern, so
+// repeated corrections of the same kind of activity update one rule.
+func TypeRuleID(source, titlePattern string) string {
+	sum := sha256.Sum256([]byte(source + "|" + titlePattern))
+	return hex.EncodeToString(sum[:8])
+}
+
+// MatchTypeRule returns the rule to apply to an activity from source with the
+// given title, or nil. Only enabled rules that reached TypeRuleLearnThreshold
+// are considered. A rule matches when its pattern appears as whole words in
+// the normalised title; the longest pattern wins.
+func MatchTypeRule(rules []*pbpipeline.ActivityTypeRule, source, title string) *pbpipeline.ActivityTypeRule {
+	normalized := " " + NormalizeTitlePattern(title) + " "
+	var best *pbpipeline.ActivityTypeRule
+	for _, r := range rules {
+		if r.Disabled || r.CorrectionCount < TypeRuleLearnThreshold || r.Source != source || r.TitlePattern == "" {
+			continue
+		}
+		if !strings.Contains(normalized, " "+r.TitlePattern+" ") {
+			continue
+		}
+		if best == nil || len(r.TitlePattern) > len(best.TitlePattern) {
+			best = r
+		}
+	}
+	return best
+}
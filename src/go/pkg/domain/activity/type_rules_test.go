@@ -0,0 +1,30 @@
+package activity
+
+import "testing"
+
+func TestNormalizeTitlePattern(t *testing.T) {
+	cases := map[string]string{
+		"Hyrox Sim #3":             "hyrox sim",
+		"  hyrox   SIM 4 ":         "hyrox sim",
+		"Leg-Day (heavy)":          "leg day heavy",
+		"Morning Run 🏃 10k":        "morning run k",
+		"#42":                      "",
+		"Zürich Lauf":              "zürich lauf",
+		"Upper/Lower Split, week2": "upper lower split week",
+	}
+	for in, want := range cases {
+		if got := NormalizeTitlePattern(in); got != want {
+			t.Errorf("NormalizeTitlePattern(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestTypeRuleID_Stable(t *testing.T) {
+	a := TypeRuleID("SOURCE_HEVY", "hyrox sim")
+	if a != TypeRuleID("SOURCE_HEVY", "hyrox sim") {
+		t.Error("expected the same ID for the same source and pattern")
+	}
+	if a == TypeRuleID("SOURCE_STRAVA", "hyrox sim") {
+		t.Error("expected different IDs per source")
+	}
+}
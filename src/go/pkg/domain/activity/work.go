@@ -0,0 +1,50 @@
+package activity
+
+import (
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+// DefaultEfficiency is the gross metabolic efficiency assumed when converting
+// mechanical work to calories. Trained cyclists sit around 20–25%.
+const DefaultEfficiency = 0.24
+
+// maxWorkGap caps the time a single power sample is held for, so recording
+// gaps and auto-pauses don't count as work.
+const maxWorkGap = 10.0 // seconds
+
+// MechanicalWork returns the mechanical work in joules done over the
+// activity's power records. It returns 0 when there is no power data.
+func MechanicalWork(a *pbactivity.StandardizedActivity) float64 {
+	if a == nil {
+		return 0
+	}
+
+	var joules float64
+	for _, session := range a.Sessions {
+		var prev *pbactivity.Record
+		for _, lap := range session.Laps {
+			for _, record := range lap.Records {
+				if record.Timestamp == nil {
+					continue
+				}
+				if prev != nil && prev.Power > 0 {
+					dt := record.Timestamp.AsTime().Sub(prev.Timestamp.AsTime()).Seconds()
+					if dt > 0 && dt <= maxWorkGap {
+						joules += float64(prev.Power) * dt
+					}
+				}
+				prev = record
+			}
+		}
+	}
+	return joules
+}
+
+// CaloriesFromWork converts mechanical work in joules to metabolic kcal at
+// the given gross efficiency (0–1). Out-of-range efficiencies use the default.
+func CaloriesFromWork(joules, efficiency float64) float64 {
+	if efficiency <= 0 || efficiency > 1 {
+		efficiency = DefaultEfficiency
+	}
+	return joules / efficiency / 4184
+}
@@ -0,0 +1,52 @@
+package activity
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMechanicalWork(t *testing.T) {
+	start := time.Date(2026, 5, 12, 18, 0, 0, 0, time.UTC)
+	var records []*pbactivity.Record
+	// 600s at 200W, a 5-minute pause, then 300s at 300W
+	for i := 0; i <= 600; i++ {
+		records = append(records, &pbactivity.Record{Timestamp: timestamppb.New(start.Add(time.Duration(i) * time.Second)), Power: 200})
+	}
+	resume := start.Add(905 * time.Second)
+	for i := 0; i <= 300; i++ {
+		records = append(records, &pbactivity.Record{Timestamp: timestamppb.New(resume.Add(time.Duration(i) * time.Second)), Power: 300})
+	}
+	a := &pbactivity.StandardizedActivity{
+		Sessions: []*pbactivity.Session{{Laps: []*pbactivity.Lap{{Records: records}}}},
+	}
+
+	// 200W × 600s + 300W × 300s
+	if got := MechanicalWork(a); got != 210000 {
+		t.Errorf("MechanicalWork() = %v, want 210000", got)
+	}
+	if got := MechanicalWork(&pbactivity.StandardizedActivity{}); got != 0 {
+		t.Errorf("MechanicalWork() without power = %v, want 0", got)
+	}
+}
+
+func TestCaloriesFromWork(t *testing.T) {
+	tests := []struct {
+		joules     float64
+		efficiency float64
+		want       float64
+	}{
+		{1000000, 0.24, 995.9},
+		{1000000, 0.20, 1195.0},
+		{1000000, 0, 995.9},   // default
+		{1000000, 1.5, 995.9}, // out of range
+	}
+	for _, tt := range tests {
+		if got := CaloriesFromWork(tt.joules, tt.efficiency); math.Abs(got-tt.want) > 0.1 {
+			t.Errorf("CaloriesFromWork(%v, %v) = %.1f, want %.1f", tt.joules, tt.efficiency, got, tt.want)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
+	"strings"
 )
 
 // GenerateRandomIngressKey creates a cryptographically random API key and its SHA-256 hash.
@@ -23,3 +24,28 @@ func HashIngressKey(rawKey string) string {
 	h := sha256.Sum256([]byte(rawKey))
 	return hex.EncodeToString(h[:])
 }
+
+const (
+	// PersonalKeyPrefix starts every personal API key, so the API can tell
+	// them apart from Firebase ID tokens in an Authorization header.
+	PersonalKeyPrefix = "fg_"
+
+	// ScopeAPI lets a key call the client API on its owner's behalf.
+	ScopeAPI = "api"
+)
+
+// GeneratePersonalKey creates a personal API key for scripts and the fitglue
+// CLI, and its SHA-256 hash. Like ingress keys, only the hash is stored.
+func GeneratePersonalKey() (rawKey string, keyHash string, err error) {
+	raw, _, err := GenerateRandomIngressKey()
+	if err != nil {
+		return "", "", err
+	}
+	raw = PersonalKeyPrefix + raw
+	return raw, HashIngressKey(raw), nil
+}
+
+// IsPersonalKey reports whether a bearer token is a personal API key.
+func IsPersonalKey(token string) bool {
+	return strings.HasPrefix(token, PersonalKeyPrefix)
+}
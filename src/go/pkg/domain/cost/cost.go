@@ -0,0 +1,104 @@
+// Package cost estimates what a pipeline run costs to process: AI tokens,
+// image generations, third-party API calls and compute. The figures are for
+// internal margin analysis and future usage-based pricing, not billing.
+package cost
+
+import (
+	"context"
+	"sync"
+
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+// List prices in US dollars. Update them when the providers' prices change;
+// stored runs keep their usage counts so they can be re-priced.
+const (
+	usdPerMillionInputTokens  = 0.10 // Gemini 2.0 Flash
+	usdPerMillionOutputTokens = 0.40
+	usdPerImage               = 0.04 // Imagen 3
+	// usdPerExternalAPICall is nominal: most enricher APIs are free or flat
+	// rate, but every call still has a quota and an operational cost.
+	usdPerExternalAPICall = 0.0001
+	// usdPerGBSecond blends Cloud Run memory with the vCPU allocated
+	// alongside every 512MiB.
+	usdPerGBSecond = 0.0000025 + 2*0.000024
+)
+
+// MemoryGB is the pipeline service's memory limit (terraform/cloud_run.tf),
+// used to turn wall time into GB-seconds.
+const MemoryGB = 0.5
+
+// Usage counts the billable work done for one run.
+type Usage struct {
+	AIInputTokens    int64
+	AIOutputTokens   int64
+	ImageGenerations int32
+	ExternalAPICalls int32
+}
+
+// Meter accumulates usage across the providers of a run. It is safe for
+// concurrent use.
+type Meter struct {
+	mu     sync.Mutex
+	usage  Usage
+	parent *Meter
+}
+
+// Usage returns the usage recorded so far.
+func (m *Meter) Usage() Usage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.usage
+}
+
+func (m *Meter) add(u Usage) {
+	m.mu.Lock()
+	m.usage.AIInputTokens += u.AIInputTokens
+	m.usage.AIOutputTokens += u.AIOutputTokens
+	m.usage.ImageGenerations += u.ImageGenerations
+	m.usage.ExternalAPICalls += u.ExternalAPICalls
+	m.mu.Unlock()
+	if m.parent != nil {
+		m.parent.add(u)
+	}
+}
+
+type meterKey struct{}
+
+// WithMeter returns a context that records usage to m.
+func WithMeter(ctx context.Context, m *Meter) context.Context {
+	return context.WithValue(ctx, meterKey{}, m)
+}
+
+// WithSubMeter returns a context recording usage to a new meter as well as
+// to ctx's meter, so a provider can tell what it used within a run.
+func WithSubMeter(ctx context.Context) (context.Context, *Meter) {
+	parent, _ := ctx.Value(meterKey{}).(*Meter)
+	m := &Meter{parent: parent}
+	return WithMeter(ctx, m), m
+}
+
+// Record adds usage to the context's meter. Without one it does nothing, so
+// code shared with other services can record unconditionally.
+func Record(ctx context.Context, u Usage) {
+	if m, ok := ctx.Value(meterKey{}).(*Meter); ok && m != nil {
+		m.add(u)
+	}
+}
+
+// Estimate prices usage and compute time at the list prices above.
+func Estimate(u Usage, gbSeconds float64) *pbpipeline.RunCost {
+	usd := float64(u.AIInputTokens)/1e6*usdPerMillionInputTokens +
+		float64(u.AIOutputTokens)/1e6*usdPerMillionOutputTokens +
+		float64(u.ImageGenerations)*usdPerImage +
+		float64(u.ExternalAPICalls)*usdPerExternalAPICall +
+		gbSeconds*usdPerGBSecond
+	return &pbpipeline.RunCost{
+		AiInputTokens:     u.AIInputTokens,
+		AiOutputTokens:    u.AIOutputTokens,
+		ImageGenerations:  u.ImageGenerations,
+		ExternalApiCalls:  u.ExternalAPICalls,
+		FunctionGbSeconds: gbSeconds,
+		EstimatedUsd:      usd,
+	}
+}
@@ -0,0 +1,69 @@
+package cost
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+)
+
+func TestRecord(t *testing.T) {
+	m := &Meter{}
+	ctx := WithMeter(context.Background(), m)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Record(ctx, Usage{AIInputTokens: 100, AIOutputTokens: 20, ExternalAPICalls: 1})
+		}()
+	}
+	wg.Wait()
+	Record(ctx, Usage{ImageGenerations: 1})
+
+	want := Usage{AIInputTokens: 1000, AIOutputTokens: 200, ImageGenerations: 1, ExternalAPICalls: 10}
+	if got := m.Usage(); got != want {
+		t.Errorf("Expected %+v, got %+v", want, got)
+	}
+}
+
+func TestRecord_NoMeter(t *testing.T) {
+	// Must not panic outside a metered run (e.g. in the destination service)
+	Record(context.Background(), Usage{ExternalAPICalls: 1})
+}
+
+func TestWithSubMeter(t *testing.T) {
+	run := &Meter{}
+	ctx := WithMeter(context.Background(), run)
+	Record(ctx, Usage{AIInputTokens: 50})
+
+	providerCtx, provider := WithSubMeter(ctx)
+	Record(providerCtx, Usage{AIInputTokens: 100, AIOutputTokens: 10})
+
+	if got := provider.Usage(); got != (Usage{AIInputTokens: 100, AIOutputTokens: 10}) {
+		t.Errorf("Sub-meter got %+v", got)
+	}
+	if got := run.Usage(); got != (Usage{AIInputTokens: 150, AIOutputTokens: 10}) {
+		t.Errorf("Run meter got %+v", got)
+	}
+
+	// Still counts outside a metered run
+	_, orphan := WithSubMeter(context.Background())
+	orphan.add(Usage{ImageGenerations: 1})
+	if orphan.Usage().ImageGenerations != 1 {
+		t.Error("Sub-meter without a parent should still count")
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	c := Estimate(Usage{AIInputTokens: 2_000_000, AIOutputTokens: 500_000, ImageGenerations: 2, ExternalAPICalls: 10}, 4)
+
+	// 0.20 + 0.20 + 0.08 + 0.001 + 4 * 0.0000505
+	if want := 0.481202; math.Abs(c.EstimatedUsd-want) > 1e-9 {
+		t.Errorf("Expected $%v, got $%v", want, c.EstimatedUsd)
+	}
+	if c.AiInputTokens != 2_000_000 || c.ImageGenerations != 2 || c.ExternalApiCalls != 10 || c.FunctionGbSeconds != 4 {
+		t.Errorf("Expected the usage counts kept, got %+v", c)
+	}
+}
@@ -2,6 +2,7 @@ package email
 
 import (
 	"fmt"
+	"html"
 	"strings"
 )
 
@@ -270,3 +271,177 @@ func RegistrationSummaryTemplate(dateStr string, users []RegistrationSummaryUser
 		Content:     content,
 	})
 }
+
+// ActivityRecap is one activity's summary for the recap email. Text fields
+// are plain text and escaped when rendered.
+type ActivityRecap struct {
+	Name        string
+	Type        string
+	Date        string
+	Distance    string
+	Duration    string
+	Pace        string
+	PRCount     int
+	Description string
+	ImageURL    string
+	Link        string
+}
+
+func ActivityRecapTemplate(recap ActivityRecap, baseURL string) string {
+	var stats []statTile
+	for _, s := range []statTile{{"Distance", recap.Distance}, {"Time", recap.Duration}, {"Pace", recap.Pace}} {
+		if s.Value != "" {
+			stats = append(stats, s)
+		}
+	}
+
+	parts := []string{
+		emoji("🏁"),
+		heading(html.EscapeString(recap.Name)),
+		paragraph(html.EscapeString(strings.TrimSpace(recap.Type + " • " + recap.Date))),
+	}
+	if badge := prBadge(recap.PRCount); badge != "" {
+		parts = append(parts, paragraph(fmt.Sprintf(`<strong style="color:%s;">%s</strong>`, Brand.Primary, badge)))
+	}
+	if len(stats) > 0 {
+		parts = append(parts, statTiles(stats))
+	}
+	if recap.ImageURL != "" {
+		parts = append(parts, fmt.Sprintf(`<img src="%s" alt="" width="100%%" style="display:block;width:100%%;border-radius:10px;margin:0 0 16px;">`, html.EscapeString(recap.ImageURL)))
+	}
+	if recap.Description != "" {
+		parts = append(parts, paragraph(strings.ReplaceAll(html.EscapeString(recap.Description), "\n", "<br>")))
+	}
+	if recap.Link != "" {
+		parts = append(parts, ctaButton("View Activity", html.EscapeString(recap.Link)))
+	}
+	parts = append(parts,
+		divider(),
+		smallText(fmt.Sprintf(`You're receiving this because a FitGlue pipeline sends your activities to email. Change this in your <a href="%s/app/pipelines" style="color:%s;">pipeline settings</a>.`, baseURL, Brand.Primary)),
+	)
+
+	return RenderLayout(LayoutOptions{
+		BaseURL:     baseURL,
+		PreviewText: strings.TrimSpace(recap.Name + " — " + strings.Join(statValues(stats), " • ")),
+		Content:     joinContent(parts...),
+	})
+}
+
+// WeeklyDigest summarises a user's week for the digest email.
+type WeeklyDigest struct {
+	WeekOf       string // First day of the week, e.g. "8 Jun 2026"
+	Activities   int
+	Distance     string
+	Duration     string
+	PRCount      int
+	ByType       []DigestTypeCount
+	PRActivities []string // Names of the activities that set PRs
+}
+
+// DigestTypeCount is how many activities of one type a week had.
+type DigestTypeCount struct {
+	Type  string
+	Count int
+}
+
+func WeeklyDigestTemplate(digest WeeklyDigest, baseURL string) string {
+	activities := fmt.Sprintf("%d activities", digest.Activities)
+	if digest.Activities == 1 {
+		activities = "1 activity"
+	}
+	preview := activities + " this week"
+	switch {
+	case digest.PRCount == 1:
+		preview += ", 1 PR"
+	case digest.PRCount > 1:
+		preview += fmt.Sprintf(", %d PRs", digest.PRCount)
+	}
+
+	stats := []statTile{{"Activities", fmt.Sprintf("%d", digest.Activities)}}
+	if digest.Distance != "" {
+		stats = append(stats, statTile{"Distance", digest.Distance})
+	}
+	if digest.Duration != "" {
+		stats = append(stats, statTile{"Time", digest.Duration})
+	}
+	stats = append(stats, statTile{"PRs", fmt.Sprintf("%d", digest.PRCount)})
+
+	parts := []string{
+		emoji("📅"),
+		heading("Your week in FitGlue"),
+		paragraph(fmt.Sprintf("Here's your week of %s: %s synced.", html.EscapeString(digest.WeekOf), activities)),
+		statTiles(stats),
+	}
+
+	if len(digest.ByType) > 0 {
+		var rows strings.Builder
+		for _, t := range digest.ByType {
+			rows.WriteString(fmt.Sprintf(`<tr><td style="padding:4px 0;color:%s;font-size:14px;">%s</td><td style="padding:4px 0;color:%s;font-size:14px;text-align:right;font-weight:600;">%d</td></tr>`,
+				Brand.TextSecondary, html.EscapeString(t.Type), Brand.TextPrimary, t.Count))
+		}
+		parts = append(parts, fmt.Sprintf(`<table role="presentation" cellpadding="0" cellspacing="0" border="0" width="100%%" style="margin:0 0 16px;">%s</table>`, rows.String()))
+	}
+
+	if len(digest.PRActivities) > 0 {
+		var items strings.Builder
+		for _, name := range digest.PRActivities {
+			items.WriteString(fmt.Sprintf(`<tr><td style="padding:4px 0;color:%s;font-size:14px;">🏆 %s</td></tr>`, Brand.TextSecondary, html.EscapeString(name)))
+		}
+		parts = append(parts,
+			paragraph(fmt.Sprintf(`<strong style="color:%s;">New personal records</strong>`, Brand.TextPrimary)),
+			fmt.Sprintf(`<table role="presentation" cellpadding="0" cellspacing="0" border="0" width="100%%" style="margin:0 0 16px;">%s</table>`, items.String()),
+		)
+	}
+
+	parts = append(parts,
+		ctaButton("See Your Activities", baseURL+"/app/activities"),
+		divider(),
+		smallText(fmt.Sprintf(`You're receiving this because you turned on the weekly digest. Change this in your <a href="%s/app/settings" style="color:%s;">notification settings</a>.`, baseURL, Brand.Primary)),
+	)
+
+	return RenderLayout(LayoutOptions{
+		BaseURL:     baseURL,
+		PreviewText: preview,
+		Content:     joinContent(parts...),
+	})
+}
+
+type statTile struct {
+	Label string
+	Value string
+}
+
+func statValues(stats []statTile) []string {
+	values := make([]string, 0, len(stats))
+	for _, s := range stats {
+		values = append(values, s.Value)
+	}
+	return values
+}
+
+// statTiles renders a row of highlighted numbers, as in the registration summary.
+func statTiles(stats []statTile) string {
+	var cells []string
+	width := 100 / len(stats)
+	for _, s := range stats {
+		cells = append(cells, fmt.Sprintf(`<td style="background:%s;border-radius:10px;padding:16px 8px;width:%d%%;text-align:center;">
+      <p style="color:%s;font-size:22px;font-weight:800;margin:0;">%s</p>
+      <p style="color:%s;font-size:12px;margin:4px 0 0;text-transform:uppercase;letter-spacing:0.05em;">%s</p>
+    </td>`, Brand.BgBody, width, Brand.Primary, html.EscapeString(s.Value), Brand.TextMuted, s.Label))
+	}
+	return fmt.Sprintf(`<table role="presentation" cellpadding="0" cellspacing="0" border="0" width="100%%" style="margin:24px 0;">
+  <tr>
+    %s
+  </tr>
+</table>`, strings.Join(cells, "\n    <td style=\"width:8px;\"></td>\n    "))
+}
+
+func prBadge(count int) string {
+	switch {
+	case count == 1:
+		return "🏆 New PR"
+	case count > 1:
+		return fmt.Sprintf("🏆 %d new PRs", count)
+	}
+	return ""
+}
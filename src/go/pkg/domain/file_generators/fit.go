@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"fmt"
 	"log/slog"
+	"math"
 	"time"
 
 	"github.com/muktihari/fit/encoder"
+	"github.com/muktihari/fit/profile/basetype"
 	"github.com/muktihari/fit/profile/mesgdef"
 	"github.com/muktihari/fit/profile/typedef"
 	"github.com/muktihari/fit/proto"
@@ -14,21 +16,70 @@ import (
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 )
 
+// DefaultMaxRecordInterval is the longest gap smart recording leaves between
+// kept records when no MaxRecordInterval is configured.
+const DefaultMaxRecordInterval = 10 * time.Second
+
+// FitOptions controls the size optimizations applied when encoding a FIT file.
+// The zero value produces a full-resolution file with standard headers.
+type FitOptions struct {
+	// SmartRecording drops records that barely differ from the last kept record,
+	// similar to a head unit's "smart recording" mode.
+	SmartRecording bool
+	// MaxRecordInterval bounds the gap between kept records under smart recording.
+	MaxRecordInterval time.Duration
+	// CompressedTimestampHeaders writes each record's timestamp as an offset in
+	// its compressed message header instead of a full 4-byte timestamp field.
+	// Only timestamps are affected; developer field values are written as-is.
+	CompressedTimestampHeaders bool
+}
+
+func (o FitOptions) maxRecordInterval() time.Duration {
+	if o.MaxRecordInterval <= 0 {
+		return DefaultMaxRecordInterval
+	}
+	return o.MaxRecordInterval
+}
+
+// fitGlueAppID is the developer_data_id application ID for FitGlue's own
+// developer fields.
+var fitGlueAppID = []byte("FitGlue-DevData1")
+
+// powerEstimatedField is FitGlue's session developer field set to 1 when the
+// power on the records was estimated rather than measured.
+const powerEstimatedField = 0
+
+// FitStats describes the effect of FitOptions on a generated file.
+type FitStats struct {
+	InputRecords   int
+	WrittenRecords int
+	Bytes          int
+}
+
 // GenerateFitFile creates a FIT file from StandardizedActivity
 // Supports multiple sport types and rich record data
 func GenerateFitFile(activity *pbactivity.StandardizedActivity) ([]byte, error) {
+	data, _, err := GenerateFitFileWithOptions(activity, FitOptions{})
+	return data, err
+}
+
+// GenerateFitFileWithOptions creates a FIT file from StandardizedActivity,
+// applying the given size optimizations, and reports record and byte counts.
+func GenerateFitFileWithOptions(activity *pbactivity.StandardizedActivity, opts FitOptions) ([]byte, FitStats, error) {
+	var stats FitStats
+
 	if activity == nil {
-		return nil, fmt.Errorf("activity cannot be nil")
+		return nil, stats, fmt.Errorf("activity cannot be nil")
 	}
 
 	if len(activity.Sessions) == 0 {
-		return nil, fmt.Errorf("activity must have at least one session")
+		return nil, stats, fmt.Errorf("activity must have at least one session")
 	}
 
 	// Parse start time
 	startTime := activity.StartTime.AsTime()
 	if startTime.IsZero() {
-		return nil, fmt.Errorf("invalid start time: zero")
+		return nil, stats, fmt.Errorf("invalid start time: zero")
 	}
 
 	// Strict Single Session Enforcement
@@ -75,6 +126,24 @@ func GenerateFitFile(activity *pbactivity.StandardizedActivity) ([]byte, error)
 		SetDeviceIndex(1) // Secondary device
 	fit.Messages = append(fit.Messages, fitGlueDeviceMsg.ToMesg(nil))
 
+	// 3c. Developer data describing FitGlue's flags. Developer fields need
+	// protocol 2.0, so files without them keep the default version.
+	powerEstimated := session.GetPowerEstimated()
+	if powerEstimated {
+		fit.Messages = append(fit.Messages,
+			mesgdef.NewDeveloperDataId(nil).
+				SetDeveloperDataIndex(0).
+				SetApplicationId(fitGlueAppID).
+				ToMesg(nil),
+			mesgdef.NewFieldDescription(nil).
+				SetDeveloperDataIndex(0).
+				SetFieldDefinitionNumber(powerEstimatedField).
+				SetFitBaseTypeId(basetype.Uint8).
+				SetFieldName([]string{"power_estimated"}).
+				ToMesg(nil),
+		)
+	}
+
 	// 4. Session message (Appended last)
 	sessionMsg := mesgdef.NewSession(nil).
 		SetTimestamp(startTime).
@@ -91,6 +160,13 @@ func GenerateFitFile(activity *pbactivity.StandardizedActivity) ([]byte, error)
 		// meters, Type: uint32, Scale: 100, Offset: 0, Units: m
 		sessionMsg.SetTotalDistance(uint32(session.TotalDistance * 100))
 	}
+	if session.GetTotalCalories() > 0 {
+		sessionMsg.SetTotalCalories(uint16(math.Round(session.GetTotalCalories())))
+	}
+	if session.GetTotalWork() > 0 {
+		// joules, Type: uint32, Units: J
+		sessionMsg.SetTotalWork(uint32(session.GetTotalWork()))
+	}
 
 	// 5. Lap message (One per session for now)
 	lapMsg := mesgdef.NewLap(nil).
@@ -107,85 +183,162 @@ func GenerateFitFile(activity *pbactivity.StandardizedActivity) ([]byte, error)
 	if session.TotalDistance > 0 {
 		lapMsg.SetTotalDistance(uint32(session.TotalDistance * 100))
 	}
+	if session.GetTotalCalories() > 0 {
+		lapMsg.SetTotalCalories(uint16(math.Round(session.GetTotalCalories())))
+	}
+	if session.GetTotalWork() > 0 {
+		lapMsg.SetTotalWork(uint32(session.GetTotalWork()))
+	}
+
+	// Time in HR zone, from heart rate zone analysis, so destinations that read
+	// the native fields show zones without deriving their own.
+	timeInHrZone := session.GetTimeInHrZone()
+	if len(timeInHrZone) > 0 {
+		sessionMsg.SetTimeInHrZoneScaled(timeInHrZone)
+		lapMsg.SetTimeInHrZoneScaled(timeInHrZone)
+	}
 
 	// 6. Records
 	// We iterate through laps in the session (though we only created one Lap msg above,
 	// ideally we'd map session.Laps to FIT Laps, but enforcing single Lap for robust uploads first)
 	// We'll flatten all records from all laps into this single FIT Lap/Session for safety.
 
-	recordCount := 0
+	var records []*pbactivity.Record
 	for _, lap := range session.Laps {
 		for _, record := range lap.Records {
-			ts := record.Timestamp.AsTime()
-			if ts.IsZero() {
+			if record.Timestamp.AsTime().IsZero() {
 				slog.Warn("Skipping record with invalid timestamp", "timestamp", record.Timestamp)
 				continue // Skip invalid records
 			}
+			records = append(records, record)
+		}
+	}
+	stats.InputRecords = len(records)
 
-			recordMsg := mesgdef.NewRecord(nil).SetTimestamp(ts)
+	if opts.SmartRecording {
+		records = thinRecords(records, opts.maxRecordInterval())
+	}
 
-			if record.HeartRate > 0 {
-				recordMsg.SetHeartRate(uint8(record.HeartRate))
-			}
-			if record.Power > 0 {
-				recordMsg.SetPower(uint16(record.Power))
-			}
-			if record.Cadence > 0 {
-				recordMsg.SetCadence(uint8(record.Cadence))
-			}
-			if record.Speed > 0 {
-				recordMsg.SetSpeed(uint16(record.Speed * 1000)) // m/s, scale 1000
-			}
-			if record.Altitude != 0 {
-				// Altitude: scale 5, offset 500
-				// Using SetAltitude which takes uint16 (scaled)
-				// Formula: scaled = (altitude + 500) * 5
-				alt := (record.Altitude + 500) * 5
-				if alt >= 0 {
-					recordMsg.SetAltitude(uint16(alt))
-				}
+	recordCount := 0
+	for _, record := range records {
+		ts := record.Timestamp.AsTime()
+		recordMsg := mesgdef.NewRecord(nil).SetTimestamp(ts)
+
+		if record.HeartRate > 0 {
+			recordMsg.SetHeartRate(uint8(record.HeartRate))
+		}
+		if record.Power > 0 {
+			recordMsg.SetPower(uint16(record.Power))
+		}
+		if record.Cadence > 0 {
+			recordMsg.SetCadence(uint8(record.Cadence))
+		}
+		if record.Speed > 0 {
+			recordMsg.SetSpeed(uint16(record.Speed * 1000)) // m/s, scale 1000
+		}
+		if record.Altitude != 0 {
+			// Altitude: scale 5, offset 500
+			// Using SetAltitude which takes uint16 (scaled)
+			// Formula: scaled = (altitude + 500) * 5
+			alt := (record.Altitude + 500) * 5
+			if alt >= 0 {
+				recordMsg.SetAltitude(uint16(alt))
 			}
+		}
+		if record.Temperature != nil {
+			recordMsg.SetTemperature(int8(*record.Temperature))
+		}
+		if record.CoreTemperature != nil && *record.CoreTemperature > 0 {
+			recordMsg.SetCoreTemperatureScaled(*record.CoreTemperature) // 0.01 °C
+		}
 
-			// Location (Semicircles)
-			// lat * (2^31 / 180)
+		// Running dynamics, written back at the FIT scales the parser read them from
+		if record.GroundContactTime != nil && *record.GroundContactTime > 0 {
+			recordMsg.SetStanceTime(uint16(*record.GroundContactTime * 10)) // 0.1 ms
+		}
+		if record.VerticalOscillation != nil && *record.VerticalOscillation > 0 {
+			recordMsg.SetVerticalOscillation(uint16(*record.VerticalOscillation * 10)) // 0.1 mm
+		}
+		if record.VerticalRatio != nil && *record.VerticalRatio > 0 {
+			recordMsg.SetVerticalRatio(uint16(*record.VerticalRatio * 10)) // 0.01 %
+		}
+		if record.StepLength != nil && *record.StepLength > 0 {
+			recordMsg.SetStepLength(uint16(math.Round(*record.StepLength * 10000))) // 0.1 mm
+		}
+
+		// Location (Semicircles)
+		// lat * (2^31 / 180)
+		if record.PositionLat != 0 || record.PositionLong != 0 {
+			const semicircleConst = 11930464.7111 // 2^31 / 180
+			lat := int32(record.PositionLat * semicircleConst)
+			long := int32(record.PositionLong * semicircleConst)
+			recordMsg.SetPositionLat(lat)
+			recordMsg.SetPositionLong(long)
+		}
+
+		if recordCount == 0 {
+			// Start Lat/Long for Lap/Session
 			if record.PositionLat != 0 || record.PositionLong != 0 {
-				const semicircleConst = 11930464.7111 // 2^31 / 180
+				const semicircleConst = 11930464.7111
 				lat := int32(record.PositionLat * semicircleConst)
 				long := int32(record.PositionLong * semicircleConst)
-				recordMsg.SetPositionLat(lat)
-				recordMsg.SetPositionLong(long)
-			}
-
-			if recordCount == 0 {
-				// Start Lat/Long for Lap/Session
-				if record.PositionLat != 0 || record.PositionLong != 0 {
-					const semicircleConst = 11930464.7111
-					lat := int32(record.PositionLat * semicircleConst)
-					long := int32(record.PositionLong * semicircleConst)
-					lapMsg.SetStartPositionLat(lat)
-					lapMsg.SetStartPositionLong(long)
-					sessionMsg.SetStartPositionLat(lat)
-					sessionMsg.SetStartPositionLong(long)
-				}
+				lapMsg.SetStartPositionLat(lat)
+				lapMsg.SetStartPositionLong(long)
+				sessionMsg.SetStartPositionLat(lat)
+				sessionMsg.SetStartPositionLong(long)
 			}
-
-			fit.Messages = append(fit.Messages, recordMsg.ToMesg(nil))
-			recordCount++
 		}
+
+		fit.Messages = append(fit.Messages, recordMsg.ToMesg(nil))
+		recordCount++
 	}
 
-	// Fallback: Synthesize records if none exist
+	// Fallback: Synthesize records if none exist.
+	// Empty records carry nothing to thin, so smart recording just spaces them out.
 	if recordCount == 0 && session.TotalElapsedTime > 0 {
-		duration := int(session.TotalElapsedTime)
-		for i := 0; i < duration; i++ {
-			ts := startTime.Add(time.Duration(i) * time.Second)
-			recordMsg := mesgdef.NewRecord(nil).SetTimestamp(ts)
+		step := time.Second
+		if opts.SmartRecording {
+			step = opts.maxRecordInterval()
+		}
+		duration := time.Duration(int(session.TotalElapsedTime)) * time.Second
+		for offset := time.Duration(0); offset < duration; offset += step {
+			recordMsg := mesgdef.NewRecord(nil).SetTimestamp(startTime.Add(offset))
 			fit.Messages = append(fit.Messages, recordMsg.ToMesg(nil))
+			recordCount++
 		}
 	}
+	stats.WrittenRecords = recordCount
 
 	// 7. Strength Sets (Only for training)
+	// Sets placed on a timeline are written in time order with rest sets
+	// between them, and each work and rest block gets its own lap.
+	var timeline []strengthBlock
 	if sport == typedef.SportTraining {
+		sessionEnd := startTime.Add(time.Duration(session.TotalElapsedTime * float64(time.Second)))
+		timeline = strengthTimeline(session.StrengthSets, startTime, sessionEnd)
+	}
+	if len(timeline) > 0 {
+		for i, block := range timeline {
+			setMsg := mesgdef.NewSet(nil).
+				SetTimestamp(block.end).
+				SetStartTime(block.start).
+				SetDuration(uint32(block.end.Sub(block.start).Milliseconds())).
+				SetMessageIndex(typedef.MessageIndex(i))
+			if block.set == nil {
+				setMsg.SetSetType(typedef.SetTypeRest)
+			} else {
+				setMsg.SetSetType(typedef.SetTypeActive).
+					SetCategory([]typedef.ExerciseCategory{MapExerciseToCategory(block.set.ExerciseName)})
+				if block.set.Reps > 0 {
+					setMsg.SetRepetitions(uint16(block.set.Reps))
+				}
+				if block.set.WeightKg > 0 {
+					setMsg.SetWeightScaled(block.set.WeightKg)
+				}
+			}
+			fit.Messages = append(fit.Messages, setMsg.ToMesg(nil))
+		}
+	} else if sport == typedef.SportTraining {
 		for i, set := range session.StrengthSets {
 			setStartTime := startTime
 			if set.StartTime != nil {
@@ -214,18 +367,81 @@ func GenerateFitFile(activity *pbactivity.StandardizedActivity) ([]byte, error)
 	}
 
 	// Append Summary
-	fit.Messages = append(fit.Messages, lapMsg.ToMesg(nil))
-	fit.Messages = append(fit.Messages, sessionMsg.ToMesg(nil))
+	if len(timeline) > 0 {
+		for i, block := range timeline {
+			intensity := typedef.IntensityActive
+			if block.set == nil {
+				intensity = typedef.IntensityRest
+			}
+			elapsed := uint32(block.end.Sub(block.start).Milliseconds())
+			blockLap := mesgdef.NewLap(nil).
+				SetTimestamp(block.end).
+				SetStartTime(block.start).
+				SetSport(sport).
+				SetSubSport(subSport).
+				SetIntensity(intensity).
+				SetTotalElapsedTime(elapsed).
+				SetTotalTimerTime(elapsed).
+				SetMessageIndex(typedef.MessageIndex(i))
+			if avg, peak := heartRateIn(records, block.start, block.end); avg > 0 {
+				blockLap.SetAvgHeartRate(avg).SetMaxHeartRate(peak)
+			}
+			fit.Messages = append(fit.Messages, blockLap.ToMesg(nil))
+		}
+	} else {
+		fit.Messages = append(fit.Messages, lapMsg.ToMesg(nil))
+	}
+	sessionMesg := sessionMsg.ToMesg(nil)
+	if powerEstimated {
+		sessionMesg.DeveloperFields = append(sessionMesg.DeveloperFields, proto.DeveloperField{
+			Num:                powerEstimatedField,
+			DeveloperDataIndex: 0,
+			Value:              proto.Uint8(1),
+		})
+	}
+	fit.Messages = append(fit.Messages, sessionMesg)
+	if len(timeInHrZone) > 0 {
+		fit.Messages = append(fit.Messages, timeInZoneMesg(startTime, timeInHrZone, session.GetHrZoneHighBoundary()))
+	}
 	fit.Messages = append(fit.Messages, activityMsg.ToMesg(nil))
 
 	// Encode
+	var encOpts []encoder.Option
+	if opts.CompressedTimestampHeaders {
+		// Local message type 3 is the widest the compressed header allows,
+		// letting up to four message definitions stay live without re-emitting them.
+		encOpts = append(encOpts, encoder.WithHeaderOption(encoder.HeaderOptionCompressedTimestamp, 3))
+	}
+	if powerEstimated {
+		encOpts = append(encOpts, encoder.WithProtocolVersion(proto.V2))
+	}
+
 	var buf bytes.Buffer
-	enc := encoder.New(&buf)
+	enc := encoder.New(&buf, encOpts...)
 	if err := enc.Encode(fit); err != nil {
-		return nil, fmt.Errorf("failed to encode FIT file: %w", err)
+		return nil, stats, fmt.Errorf("failed to encode FIT file: %w", err)
 	}
 
-	return buf.Bytes(), nil
+	stats.Bytes = buf.Len()
+	return buf.Bytes(), stats, nil
+}
+
+// timeInZoneMesg builds the session's time_in_zone message, which carries the
+// zone boundaries alongside the times.
+func timeInZoneMesg(timestamp time.Time, seconds []float64, highBoundary []int32) proto.Message {
+	msg := mesgdef.NewTimeInZone(nil).
+		SetTimestamp(timestamp).
+		SetReferenceMesg(typedef.MesgNumSession).
+		SetReferenceIndex(0).
+		SetTimeInHrZoneScaled(seconds)
+	if len(highBoundary) > 0 {
+		bpm := make([]uint8, len(highBoundary))
+		for i, b := range highBoundary {
+			bpm[i] = uint8(min(max(b, 0), math.MaxUint8))
+		}
+		msg.SetHrZoneHighBoundary(bpm)
+	}
+	return msg.ToMesg(nil)
 }
 
 func mapSport(activityType pbactivity.ActivityType) (typedef.Sport, typedef.SubSport) {
@@ -0,0 +1,77 @@
+package file_generators
+
+import (
+	"sort"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+// strengthBlock is a stretch of a strength workout: a set being worked, or
+// the rest before the next one.
+type strengthBlock struct {
+	start, end time.Time
+	set        *pbactivity.StrengthSet // nil for rest
+}
+
+// strengthTimeline splits a session into alternating work and rest blocks
+// from its sets' start and end times, covering sessionStart to sessionEnd.
+// It returns nil when any set lacks a start or end time; such sessions keep
+// a single lap.
+func strengthTimeline(sets []*pbactivity.StrengthSet, sessionStart, sessionEnd time.Time) []strengthBlock {
+	if len(sets) == 0 {
+		return nil
+	}
+	for _, set := range sets {
+		if set.StartTime == nil || set.EndTime == nil || set.EndTime.AsTime().Before(set.StartTime.AsTime()) {
+			return nil
+		}
+	}
+
+	ordered := make([]*pbactivity.StrengthSet, len(sets))
+	copy(ordered, sets)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].StartTime.AsTime().Before(ordered[j].StartTime.AsTime())
+	})
+
+	var blocks []strengthBlock
+	cursor := sessionStart
+	for _, set := range ordered {
+		start, end := set.StartTime.AsTime(), set.EndTime.AsTime()
+		if start.Before(cursor) {
+			start = cursor // Overlapping sets are clipped so blocks never overlap
+		}
+		if start.After(cursor) {
+			blocks = append(blocks, strengthBlock{start: cursor, end: start})
+		}
+		if end.After(start) {
+			blocks = append(blocks, strengthBlock{start: start, end: end, set: set})
+			cursor = end
+		}
+	}
+	if sessionEnd.After(cursor) {
+		blocks = append(blocks, strengthBlock{start: cursor, end: sessionEnd})
+	}
+	return blocks
+}
+
+// heartRateIn returns the average and maximum heart rate of the records in
+// [start, end), or zeros when there are none.
+func heartRateIn(records []*pbactivity.Record, start, end time.Time) (avg, max uint8) {
+	var sum, n, peak int32
+	for _, r := range records {
+		ts := r.Timestamp.AsTime()
+		if r.HeartRate <= 0 || ts.Before(start) || !ts.Before(end) {
+			continue
+		}
+		sum += r.HeartRate
+		n++
+		if r.HeartRate > peak {
+			peak = r.HeartRate
+		}
+	}
+	if n == 0 {
+		return 0, 0
+	}
+	return uint8(sum / n), uint8(peak)
+}
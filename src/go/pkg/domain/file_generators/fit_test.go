@@ -4,10 +4,12 @@ import (
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 
 	"bytes"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/muktihari/fit/decoder"
+	"github.com/muktihari/fit/profile/mesgdef"
 	"github.com/muktihari/fit/profile/typedef"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -140,3 +142,373 @@ func TestGenerateFitFile_NoHR(t *testing.T) {
 		t.Errorf("Expected 10 Record messages (synthesized), got %d", recordCount)
 	}
 }
+
+func steadyRideActivity(seconds int) *pbactivity.StandardizedActivity {
+	start := time.Date(2026, 5, 1, 7, 0, 0, 0, time.UTC)
+	records := make([]*pbactivity.Record, 0, seconds)
+	for i := 0; i < seconds; i++ {
+		hr := int32(140)
+		if i == seconds/2 {
+			hr = 170 // single spike that thinning must keep
+		}
+		records = append(records, &pbactivity.Record{
+			Timestamp: timestamppb.New(start.Add(time.Duration(i) * time.Second)),
+			HeartRate: hr,
+			Power:     200,
+			Speed:     8,
+		})
+	}
+	return &pbactivity.StandardizedActivity{
+		StartTime: timestamppb.New(start),
+		Type:      pbactivity.ActivityType_ACTIVITY_TYPE_RIDE,
+		Sessions: []*pbactivity.Session{
+			{
+				StartTime:        timestamppb.New(start),
+				TotalElapsedTime: float64(seconds),
+				Laps:             []*pbactivity.Lap{{Records: records}},
+			},
+		},
+	}
+}
+
+func TestGenerateFitFile_RunningDynamics(t *testing.T) {
+	startTime := timestamppb.New(time.Now())
+	gct, vo, vr, sl := int32(242), int32(84), int32(78), 1.12
+	activity := &pbactivity.StandardizedActivity{
+		StartTime: startTime,
+		Type:      pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		Sessions: []*pbactivity.Session{
+			{
+				StartTime:        startTime,
+				TotalElapsedTime: 1,
+				Laps: []*pbactivity.Lap{
+					{
+						Records: []*pbactivity.Record{
+							{Timestamp: startTime, Cadence: 86, GroundContactTime: &gct, VerticalOscillation: &vo, VerticalRatio: &vr, StepLength: &sl},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := GenerateFitFile(activity)
+	if err != nil {
+		t.Fatalf("GenerateFitFile failed: %v", err)
+	}
+
+	fitData, err := decoder.New(bytes.NewReader(result)).Decode()
+	if err != nil {
+		t.Fatalf("Failed to decode generated FIT file: %v", err)
+	}
+	for _, msg := range fitData.Messages {
+		if msg.Num != typedef.MesgNumRecord {
+			continue
+		}
+		record := mesgdef.NewRecord(&msg)
+		if record.StanceTime != 2420 || record.VerticalOscillation != 840 || record.VerticalRatio != 780 || record.StepLength != 11200 {
+			t.Errorf("Unexpected running dynamics: stance=%d vo=%d ratio=%d step=%d", record.StanceTime, record.VerticalOscillation, record.VerticalRatio, record.StepLength)
+		}
+		return
+	}
+	t.Fatal("Expected a record message")
+}
+
+func TestGenerateFitFile_Temperature(t *testing.T) {
+	startTime := timestamppb.New(time.Now())
+	temp, core := int32(31), 38.42
+	activity := &pbactivity.StandardizedActivity{
+		StartTime: startTime,
+		Type:      pbactivity.ActivityType_ACTIVITY_TYPE_RIDE,
+		Sessions: []*pbactivity.Session{
+			{
+				StartTime:        startTime,
+				TotalElapsedTime: 1,
+				Laps: []*pbactivity.Lap{
+					{
+						Records: []*pbactivity.Record{
+							{Timestamp: startTime, HeartRate: 150, Temperature: &temp, CoreTemperature: &core},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := GenerateFitFile(activity)
+	if err != nil {
+		t.Fatalf("GenerateFitFile failed: %v", err)
+	}
+
+	fitData, err := decoder.New(bytes.NewReader(result)).Decode()
+	if err != nil {
+		t.Fatalf("Failed to decode generated FIT file: %v", err)
+	}
+	for _, msg := range fitData.Messages {
+		if msg.Num != typedef.MesgNumRecord {
+			continue
+		}
+		record := mesgdef.NewRecord(&msg)
+		if record.Temperature != 31 || record.CoreTemperature != 3842 {
+			t.Errorf("Unexpected temperatures: ambient=%d core=%d", record.Temperature, record.CoreTemperature)
+		}
+		return
+	}
+	t.Fatal("Expected a record message")
+}
+
+func TestGenerateFitFile_WorkAndCalories(t *testing.T) {
+	activity := steadyRideActivity(600)
+	work, kcal := 120000.0, 119.6
+	activity.Sessions[0].TotalWork = &work
+	activity.Sessions[0].TotalCalories = &kcal
+
+	result, err := GenerateFitFile(activity)
+	if err != nil {
+		t.Fatalf("GenerateFitFile failed: %v", err)
+	}
+
+	fitData, err := decoder.New(bytes.NewReader(result)).Decode()
+	if err != nil {
+		t.Fatalf("Failed to decode generated FIT file: %v", err)
+	}
+	for _, msg := range fitData.Messages {
+		if msg.Num != typedef.MesgNumSession {
+			continue
+		}
+		session := mesgdef.NewSession(&msg)
+		if session.TotalWork != 120000 || session.TotalCalories != 120 {
+			t.Errorf("Expected 120000 J and 120 kcal, got %d J and %d kcal", session.TotalWork, session.TotalCalories)
+		}
+		return
+	}
+	t.Fatal("Expected a session message")
+}
+
+func TestGenerateFitFile_PowerEstimated(t *testing.T) {
+	activity := steadyRideActivity(60)
+	estimated := true
+	activity.Sessions[0].PowerEstimated = &estimated
+
+	result, err := GenerateFitFile(activity)
+	if err != nil {
+		t.Fatalf("GenerateFitFile failed: %v", err)
+	}
+
+	fitData, err := decoder.New(bytes.NewReader(result)).Decode()
+	if err != nil {
+		t.Fatalf("Failed to decode generated FIT file: %v", err)
+	}
+	var fieldName string
+	for _, msg := range fitData.Messages {
+		switch msg.Num {
+		case typedef.MesgNumFieldDescription:
+			fieldName = strings.Join(mesgdef.NewFieldDescription(&msg).FieldName, "")
+		case typedef.MesgNumSession:
+			if fieldName != "power_estimated" {
+				t.Fatalf("Expected power_estimated field description before the session, got %q", fieldName)
+			}
+			if len(msg.DeveloperFields) != 1 || msg.DeveloperFields[0].Value.Uint8() != 1 {
+				t.Errorf("Expected power_estimated = 1 on the session, got %v", msg.DeveloperFields)
+			}
+			return
+		}
+	}
+	t.Fatal("Expected a session message")
+}
+
+func TestGenerateFitFile_TimeInHrZone(t *testing.T) {
+	activity := steadyRideActivity(600)
+	activity.Sessions[0].TimeInHrZone = []float64{0, 60, 300, 240, 0, 0}
+	activity.Sessions[0].HrZoneHighBoundary = []int32{95, 114, 133, 152, 171, 190}
+
+	result, err := GenerateFitFile(activity)
+	if err != nil {
+		t.Fatalf("GenerateFitFile failed: %v", err)
+	}
+
+	fitData, err := decoder.New(bytes.NewReader(result)).Decode()
+	if err != nil {
+		t.Fatalf("Failed to decode generated FIT file: %v", err)
+	}
+	var sawSession, sawTimeInZone bool
+	for _, msg := range fitData.Messages {
+		switch msg.Num {
+		case typedef.MesgNumSession:
+			sawSession = true
+			if got := mesgdef.NewSession(&msg).TimeInHrZone; len(got) != 6 || got[2] != 300000 {
+				t.Errorf("Expected session time in zone 2 of 300000 ms, got %v", got)
+			}
+		case typedef.MesgNumTimeInZone:
+			sawTimeInZone = true
+			tiz := mesgdef.NewTimeInZone(&msg)
+			if tiz.ReferenceMesg != typedef.MesgNumSession || tiz.ReferenceIndex != 0 {
+				t.Errorf("Expected time_in_zone to reference session 0, got %v/%v", tiz.ReferenceMesg, tiz.ReferenceIndex)
+			}
+			if len(tiz.HrZoneHighBoundary) != 6 || tiz.HrZoneHighBoundary[5] != 190 {
+				t.Errorf("Expected zone boundaries up to 190 bpm, got %v", tiz.HrZoneHighBoundary)
+			}
+		}
+	}
+	if !sawSession || !sawTimeInZone {
+		t.Errorf("Expected session and time_in_zone messages, got session=%v time_in_zone=%v", sawSession, sawTimeInZone)
+	}
+
+	// Without zone analysis there is no time_in_zone message
+	result, err = GenerateFitFile(steadyRideActivity(60))
+	if err != nil {
+		t.Fatalf("GenerateFitFile failed: %v", err)
+	}
+	fitData, err = decoder.New(bytes.NewReader(result)).Decode()
+	if err != nil {
+		t.Fatalf("Failed to decode generated FIT file: %v", err)
+	}
+	for _, msg := range fitData.Messages {
+		if msg.Num == typedef.MesgNumTimeInZone {
+			t.Error("Expected no time_in_zone message")
+		}
+	}
+}
+
+func TestGenerateFitFileWithOptions_SmartRecording(t *testing.T) {
+	activity := steadyRideActivity(600)
+
+	full, fullStats, err := GenerateFitFileWithOptions(activity, FitOptions{})
+	if err != nil {
+		t.Fatalf("GenerateFitFileWithOptions failed: %v", err)
+	}
+	if fullStats.InputRecords != 600 || fullStats.WrittenRecords != 600 {
+		t.Errorf("Expected 600/600 records without thinning, got %d/%d", fullStats.WrittenRecords, fullStats.InputRecords)
+	}
+
+	thin, thinStats, err := GenerateFitFileWithOptions(activity, FitOptions{SmartRecording: true, CompressedTimestampHeaders: true})
+	if err != nil {
+		t.Fatalf("GenerateFitFileWithOptions failed: %v", err)
+	}
+	if thinStats.WrittenRecords >= 100 {
+		t.Errorf("Expected steady records to be thinned, wrote %d", thinStats.WrittenRecords)
+	}
+	if thinStats.Bytes != len(thin) || len(thin) >= len(full) {
+		t.Errorf("Expected thinned file (%d bytes) to be smaller than full file (%d bytes)", len(thin), len(full))
+	}
+
+	fitData, err := decoder.New(bytes.NewReader(thin)).Decode()
+	if err != nil {
+		t.Fatalf("Failed to decode thinned FIT file: %v", err)
+	}
+	var recordCount int
+	for _, msg := range fitData.Messages {
+		if msg.Num == typedef.MesgNumRecord {
+			recordCount++
+		}
+	}
+	if recordCount != thinStats.WrittenRecords {
+		t.Errorf("Expected %d decoded records, got %d", thinStats.WrittenRecords, recordCount)
+	}
+}
+
+func TestThinRecords(t *testing.T) {
+	records := steadyRideActivity(61).Sessions[0].Laps[0].Records
+	kept := thinRecords(records, 10*time.Second)
+
+	if kept[0] != records[0] || kept[len(kept)-1] != records[len(records)-1] {
+		t.Error("Expected first and last records to be kept")
+	}
+
+	var sawSpike bool
+	for i := 1; i < len(kept); i++ {
+		gap := kept[i].Timestamp.AsTime().Sub(kept[i-1].Timestamp.AsTime())
+		if gap > 10*time.Second {
+			t.Errorf("Gap of %v between kept records exceeds max interval", gap)
+		}
+		if kept[i].HeartRate == 170 {
+			sawSpike = true
+		}
+	}
+	if !sawSpike {
+		t.Error("Expected heart rate spike to be kept")
+	}
+}
+
+func TestGenerateFitFile_StrengthTimeline(t *testing.T) {
+	start := time.Date(2025, 12, 29, 8, 0, 0, 0, time.UTC)
+	at := func(s int) *timestamppb.Timestamp { return timestamppb.New(start.Add(time.Duration(s) * time.Second)) }
+
+	var records []*pbactivity.Record
+	for s := 0; s < 300; s++ {
+		hr := int32(100)
+		if s >= 30 && s < 60 {
+			hr = 150 // Working the first set
+		}
+		records = append(records, &pbactivity.Record{Timestamp: at(s), HeartRate: hr})
+	}
+
+	activity := &pbactivity.StandardizedActivity{
+		Type:      pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING,
+		StartTime: timestamppb.New(start),
+		Sessions: []*pbactivity.Session{{
+			StartTime:        timestamppb.New(start),
+			TotalElapsedTime: 300,
+			Laps:             []*pbactivity.Lap{{StartTime: timestamppb.New(start), TotalElapsedTime: 300, Records: records}},
+			StrengthSets: []*pbactivity.StrengthSet{
+				{ExerciseName: "Bench Press", Reps: 10, WeightKg: 60, StartTime: at(30), EndTime: at(60)},
+				{ExerciseName: "Bench Press", Reps: 8, WeightKg: 60, StartTime: at(150), EndTime: at(180)},
+			},
+		}},
+	}
+
+	result, err := GenerateFitFile(activity)
+	if err != nil {
+		t.Fatalf("GenerateFitFile failed: %v", err)
+	}
+	fitData, err := decoder.New(bytes.NewReader(result)).Decode()
+	if err != nil {
+		t.Fatalf("Failed to decode generated FIT file: %v", err)
+	}
+
+	var laps []*mesgdef.Lap
+	var setTypes []typedef.SetType
+	for i := range fitData.Messages {
+		msg := &fitData.Messages[i]
+		switch msg.Num {
+		case typedef.MesgNumLap:
+			laps = append(laps, mesgdef.NewLap(msg))
+		case typedef.MesgNumSet:
+			setTypes = append(setTypes, mesgdef.NewSet(msg).SetType)
+		}
+	}
+
+	// rest 0-30, work 30-60, rest 60-150, work 150-180, rest 180-300
+	if len(laps) != 5 {
+		t.Fatalf("Expected 5 laps, got %d", len(laps))
+	}
+	if laps[1].Intensity != typedef.IntensityActive || laps[2].Intensity != typedef.IntensityRest {
+		t.Errorf("Expected alternating work and rest laps, got %v and %v", laps[1].Intensity, laps[2].Intensity)
+	}
+	if laps[1].TotalElapsedTime != 30000 || laps[2].TotalElapsedTime != 90000 {
+		t.Errorf("Expected 30s work and 90s rest, got %d and %d ms", laps[1].TotalElapsedTime, laps[2].TotalElapsedTime)
+	}
+	if laps[1].AvgHeartRate != 150 || laps[2].AvgHeartRate != 100 {
+		t.Errorf("Expected lap heart rate to follow the sets, got %d and %d", laps[1].AvgHeartRate, laps[2].AvgHeartRate)
+	}
+	expectedSets := []typedef.SetType{typedef.SetTypeRest, typedef.SetTypeActive, typedef.SetTypeRest, typedef.SetTypeActive, typedef.SetTypeRest}
+	if len(setTypes) != len(expectedSets) {
+		t.Fatalf("Expected %d set messages, got %d", len(expectedSets), len(setTypes))
+	}
+	for i := range expectedSets {
+		if setTypes[i] != expectedSets[i] {
+			t.Errorf("Set %d: expected %v, got %v", i, expectedSets[i], setTypes[i])
+		}
+	}
+}
+
+func TestStrengthTimeline_MissingTimes(t *testing.T) {
+	start := time.Date(2025, 12, 29, 8, 0, 0, 0, time.UTC)
+	sets := []*pbactivity.StrengthSet{
+		{ExerciseName: "Squat", StartTime: timestamppb.New(start), EndTime: timestamppb.New(start.Add(time.Minute))},
+		{ExerciseName: "Squat", StartTime: timestamppb.New(start)},
+	}
+	if blocks := strengthTimeline(sets, start, start.Add(time.Hour)); blocks != nil {
+		t.Errorf("Expected no timeline when a set has no end time, got %d blocks", len(blocks))
+	}
+}
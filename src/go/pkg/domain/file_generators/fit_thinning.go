@@ -0,0 +1,87 @@
+package file_generators
+
+import (
+	"math"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+// Change thresholds used by smart recording. A record is kept when any channel
+// moves at least this far from the last kept record.
+const (
+	thinHeartRateDelta = 2    // bpm
+	thinCadenceDelta   = 2    // rpm / spm
+	thinPowerDelta     = 10   // watts
+	thinSpeedDelta     = 0.3  // m/s
+	thinAltitudeDelta  = 1.0  // meters
+	thinPositionDelta  = 20.0 // meters
+)
+
+// thinRecords drops records that carry no meaningful change relative to the
+// last kept record. The first and last records are always kept, and no gap
+// between kept records exceeds maxInterval, so summary stats and the route
+// shape survive while steady stretches collapse to a handful of points.
+func thinRecords(records []*pbactivity.Record, maxInterval time.Duration) []*pbactivity.Record {
+	if len(records) <= 2 {
+		return records
+	}
+
+	kept := make([]*pbactivity.Record, 0, len(records)/2)
+	last := records[0]
+	kept = append(kept, last)
+
+	for _, r := range records[1 : len(records)-1] {
+		if r.Timestamp.AsTime().Sub(last.Timestamp.AsTime()) >= maxInterval || recordChanged(last, r) {
+			kept = append(kept, r)
+			last = r
+		}
+	}
+
+	return append(kept, records[len(records)-1])
+}
+
+func recordChanged(prev, cur *pbactivity.Record) bool {
+	if absInt(cur.HeartRate-prev.HeartRate) >= thinHeartRateDelta {
+		return true
+	}
+	if absInt(cur.Cadence-prev.Cadence) >= thinCadenceDelta {
+		return true
+	}
+	if absInt(cur.Power-prev.Power) >= thinPowerDelta {
+		return true
+	}
+	if math.Abs(cur.Speed-prev.Speed) >= thinSpeedDelta {
+		return true
+	}
+	if math.Abs(cur.Altitude-prev.Altitude) >= thinAltitudeDelta {
+		return true
+	}
+	// A channel that appears or disappears (e.g. GPS dropout) is always a change
+	if (cur.PositionLat == 0 && cur.PositionLong == 0) != (prev.PositionLat == 0 && prev.PositionLong == 0) {
+		return true
+	}
+	if cur.PositionLat != 0 || cur.PositionLong != 0 {
+		if distanceMeters(prev.PositionLat, prev.PositionLong, cur.PositionLat, cur.PositionLong) >= thinPositionDelta {
+			return true
+		}
+	}
+	return false
+}
+
+func absInt(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// distanceMeters returns the haversine distance between two coordinates.
+func distanceMeters(lat1, lon1, lat2, lon2 float64) float64 {
+	const earthRadius = 6371000.0
+	dLat := (lat2 - lat1) * math.Pi / 180
+	dLon := (lon2 - lon1) * math.Pi / 180
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*math.Pi/180)*math.Cos(lat2*math.Pi/180)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return earthRadius * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
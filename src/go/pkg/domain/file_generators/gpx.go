@@ -0,0 +1,135 @@
+package file_generators
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+)
+
+// GPX 1.1 document structure with the Garmin TrackPointExtension for HR/cadence.
+type gpxFile struct {
+	XMLName     xml.Name    `xml:"gpx"`
+	Version     string      `xml:"version,attr"`
+	Creator     string      `xml:"creator,attr"`
+	Xmlns       string      `xml:"xmlns,attr"`
+	XmlnsGpxtpx string      `xml:"xmlns:gpxtpx,attr"`
+	Metadata    gpxMetadata `xml:"metadata"`
+	Tracks      []gpxTrack  `xml:"trk"`
+}
+
+type gpxMetadata struct {
+	Name string `xml:"name,omitempty"`
+	Time string `xml:"time,omitempty"`
+}
+
+type gpxTrack struct {
+	Name     string       `xml:"name,omitempty"`
+	Desc     string       `xml:"desc,omitempty"`
+	Type     string       `xml:"type,omitempty"`
+	Segments []gpxSegment `xml:"trkseg"`
+}
+
+type gpxSegment struct {
+	Points []gpxPoint `xml:"trkpt"`
+}
+
+type gpxPoint struct {
+	Lat        string         `xml:"lat,attr"`
+	Lon        string         `xml:"lon,attr"`
+	Ele        *float64       `xml:"ele,omitempty"`
+	Time       string         `xml:"time,omitempty"`
+	Extensions *gpxExtensions `xml:"extensions,omitempty"`
+}
+
+type gpxExtensions struct {
+	TrackPoint gpxTrackPointExtension `xml:"gpxtpx:TrackPointExtension"`
+}
+
+type gpxTrackPointExtension struct {
+	HeartRate int32 `xml:"gpxtpx:hr,omitempty"`
+	Cadence   int32 `xml:"gpxtpx:cad,omitempty"`
+}
+
+// GenerateGpxFile creates a GPX 1.1 track from a StandardizedActivity.
+// Only records with a GPS position are emitted; each lap becomes a track segment.
+// Returns an error if the activity has no positional data, since a GPX without
+// track points is rejected by route-based platforms (e.g. Komoot).
+func GenerateGpxFile(activity *pbactivity.StandardizedActivity) ([]byte, error) {
+	if activity == nil {
+		return nil, fmt.Errorf("activity cannot be nil")
+	}
+
+	if len(activity.Sessions) == 0 {
+		return nil, fmt.Errorf("activity must have at least one session")
+	}
+
+	track := gpxTrack{
+		Name: activity.Name,
+		Desc: activity.Description,
+		Type: activity.Type.String(),
+	}
+
+	pointCount := 0
+	for _, session := range activity.Sessions {
+		for _, lap := range session.Laps {
+			segment := gpxSegment{}
+			for _, record := range lap.Records {
+				if record.PositionLat == 0 && record.PositionLong == 0 {
+					continue
+				}
+
+				point := gpxPoint{
+					Lat: fmt.Sprintf("%.7f", record.PositionLat),
+					Lon: fmt.Sprintf("%.7f", record.PositionLong),
+				}
+				if record.Altitude != 0 {
+					ele := record.Altitude
+					point.Ele = &ele
+				}
+				if record.Timestamp != nil {
+					point.Time = record.Timestamp.AsTime().UTC().Format(time.RFC3339)
+				}
+				if record.HeartRate > 0 || record.Cadence > 0 {
+					point.Extensions = &gpxExtensions{
+						TrackPoint: gpxTrackPointExtension{
+							HeartRate: record.HeartRate,
+							Cadence:   record.Cadence,
+						},
+					}
+				}
+				segment.Points = append(segment.Points, point)
+			}
+			if len(segment.Points) > 0 {
+				track.Segments = append(track.Segments, segment)
+				pointCount += len(segment.Points)
+			}
+		}
+	}
+
+	if pointCount == 0 {
+		return nil, fmt.Errorf("activity has no GPS records")
+	}
+
+	doc := gpxFile{
+		Version:     "1.1",
+		Creator:     "FitGlue",
+		Xmlns:       "http://www.topografix.com/GPX/1/1",
+		XmlnsGpxtpx: "http://www.garmin.com/xmlschemas/TrackPointExtension/v1",
+		Metadata: gpxMetadata{
+			Name: activity.Name,
+		},
+		Tracks: []gpxTrack{track},
+	}
+	if activity.StartTime != nil {
+		doc.Metadata.Time = activity.StartTime.AsTime().UTC().Format(time.RFC3339)
+	}
+
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode GPX: %w", err)
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
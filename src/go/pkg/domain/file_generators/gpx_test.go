@@ -0,0 +1,73 @@
+package file_generators
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGenerateGpxFile(t *testing.T) {
+	start := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	activity := &pbactivity.StandardizedActivity{
+		Name:      "Morning Ride",
+		StartTime: timestamppb.New(start),
+		Type:      pbactivity.ActivityType_ACTIVITY_TYPE_RIDE,
+		Sessions: []*pbactivity.Session{
+			{
+				StartTime: timestamppb.New(start),
+				Laps: []*pbactivity.Lap{
+					{
+						Records: []*pbactivity.Record{
+							{Timestamp: timestamppb.New(start), PositionLat: 51.5, PositionLong: -0.12, Altitude: 20, HeartRate: 120},
+							{Timestamp: timestamppb.New(start.Add(time.Second))}, // No position - skipped
+							{Timestamp: timestamppb.New(start.Add(2 * time.Second)), PositionLat: 51.5001, PositionLong: -0.1201, Cadence: 85},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	data, err := GenerateGpxFile(activity)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var doc gpxFile
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Generated GPX is not valid XML: %v", err)
+	}
+	if len(doc.Tracks) != 1 || len(doc.Tracks[0].Segments) != 1 {
+		t.Fatalf("Expected 1 track with 1 segment, got %+v", doc.Tracks)
+	}
+	if got := len(doc.Tracks[0].Segments[0].Points); got != 2 {
+		t.Errorf("Expected 2 track points, got %d", got)
+	}
+	if !strings.Contains(string(data), "<gpxtpx:hr>120</gpxtpx:hr>") {
+		t.Errorf("Expected heart rate extension in output")
+	}
+	if !strings.Contains(string(data), `<time>2026-03-01T09:00:00Z</time>`) {
+		t.Errorf("Expected RFC3339 timestamps in output")
+	}
+}
+
+func TestGenerateGpxFile_NoGPS(t *testing.T) {
+	start := timestamppb.New(time.Now())
+	activity := &pbactivity.StandardizedActivity{
+		StartTime: start,
+		Sessions: []*pbactivity.Session{
+			{Laps: []*pbactivity.Lap{{Records: []*pbactivity.Record{{Timestamp: start, HeartRate: 100}}}}},
+		},
+	}
+
+	if _, err := GenerateGpxFile(activity); err == nil {
+		t.Error("Expected error for activity without GPS records")
+	}
+	if _, err := GenerateGpxFile(nil); err == nil {
+		t.Error("Expected error for nil activity")
+	}
+}
@@ -3,6 +3,7 @@ package fit_parser
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -35,6 +36,7 @@ func ParseFitFile(data []byte) (*pbactivity.StandardizedActivity, error) {
 	var setInfos []setInfo
 	var workoutSteps []workoutStepInfo
 	var workoutName string
+	coreTempFields := map[devFieldKey]bool{}
 
 	var activityType pbactivity.ActivityType
 	var activityName string
@@ -54,8 +56,14 @@ func ParseFitFile(data []byte) (*pbactivity.StandardizedActivity, error) {
 					startTime = fileId.TimeCreated.UTC()
 				}
 
+			case typedef.MesgNumFieldDescription:
+				descMsg := mesgdef.NewFieldDescription(&msg)
+				if isCoreTemperatureField(strings.Join(descMsg.FieldName, " ")) {
+					coreTempFields[devFieldKey{descMsg.DeveloperDataIndex, descMsg.FieldDefinitionNumber}] = true
+				}
+
 			case typedef.MesgNumRecord:
-				record := parseRecord(&msg)
+				record := parseRecord(&msg, coreTempFields)
 				if record != nil {
 					allRecords = append(allRecords, record)
 					if startTime.IsZero() && record.Timestamp != nil {
@@ -68,7 +76,9 @@ func ParseFitFile(data []byte) (*pbactivity.StandardizedActivity, error) {
 				li := lapInfo{
 					startTime:        lapMsg.StartTime.UTC(),
 					totalElapsedTime: float64(lapMsg.TotalElapsedTime) / 1000,
-					totalDistance:    float64(lapMsg.TotalDistance) / 100,
+				}
+				if lapMsg.TotalDistance != 0xFFFFFFFF {
+					li.totalDistance = float64(lapMsg.TotalDistance) / 100
 				}
 
 				// Extract workout step index for auto-detecting lap groups
@@ -99,14 +109,18 @@ func ParseFitFile(data []byte) (*pbactivity.StandardizedActivity, error) {
 
 			case typedef.MesgNumSession:
 				sessionMsg := mesgdef.NewSession(&msg)
-				sessionInfos = append(sessionInfos, sessionInfo{
+				si := sessionInfo{
 					startTime:        sessionMsg.StartTime.UTC(),
 					totalElapsedTime: float64(sessionMsg.TotalElapsedTime) / 1000,
-					totalDistance:    float64(sessionMsg.TotalDistance) / 100,
 					sport:            sessionMsg.Sport,
 					subSport:         sessionMsg.SubSport,
 					sportProfileName: sessionMsg.SportProfileName,
-				})
+				}
+				// 0xFFFFFFFF is invalid (e.g. strength sessions without distance)
+				if sessionMsg.TotalDistance != 0xFFFFFFFF {
+					si.totalDistance = float64(sessionMsg.TotalDistance) / 100
+				}
+				sessionInfos = append(sessionInfos, si)
 
 				// Set activity type from first session
 				if activityType == pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED {
@@ -539,8 +553,24 @@ func mergeConsecutiveLapInfos(group []lapInfo) lapInfo {
 	return merged
 }
 
-// parseRecord extracts record data from a FIT message
-func parseRecord(msg *proto.Message) *pbactivity.Record {
+// devFieldKey identifies a developer field by its app's developer_data_index
+// and its field_definition_number.
+type devFieldKey struct {
+	devIndex uint8
+	fieldNum uint8
+}
+
+// isCoreTemperatureField reports whether a developer field name is a core body
+// temperature, as written by the CORE sensor's Connect IQ app
+// ("core_temperature"). Skin temperature is deliberately excluded.
+func isCoreTemperatureField(name string) bool {
+	name = strings.ToLower(name)
+	return strings.Contains(name, "core") && strings.Contains(name, "temp") && !strings.Contains(name, "skin")
+}
+
+// parseRecord extracts record data from a FIT message. coreTempFields lists
+// the developer fields carrying core body temperature.
+func parseRecord(msg *proto.Message, coreTempFields map[devFieldKey]bool) *pbactivity.Record {
 	recordMsg := mesgdef.NewRecord(msg)
 
 	ts := recordMsg.Timestamp
@@ -583,6 +613,29 @@ func parseRecord(msg *proto.Message) *pbactivity.Record {
 		record.Altitude = (float64(recordMsg.EnhancedAltitude) / 5) - 500
 	}
 
+	// Temperature (sint8 degrees C, 0x7F is invalid)
+	if recordMsg.Temperature != 0x7F {
+		temp := int32(recordMsg.Temperature)
+		record.Temperature = &temp
+	}
+
+	// Core body temperature: the native field (scale 100) when the device
+	// pairs the sensor directly, otherwise the Connect IQ developer field
+	if recordMsg.CoreTemperature != 0xFFFF {
+		core := recordMsg.CoreTemperatureScaled()
+		record.CoreTemperature = &core
+	} else {
+		for _, devField := range msg.DeveloperFields {
+			if !coreTempFields[devFieldKey{devField.DeveloperDataIndex, devField.Num}] {
+				continue
+			}
+			if core, ok := devFieldFloat(devField.Value); ok && core > 0 {
+				record.CoreTemperature = &core
+			}
+			break
+		}
+	}
+
 	// Position (FIT uses semicircles, convert to decimal degrees)
 	if recordMsg.PositionLat != 0x7FFFFFFF && recordMsg.PositionLong != 0x7FFFFFFF {
 		const semicircleConst = 11930464.7111 // 2^31 / 180
@@ -613,9 +666,9 @@ func parseRecord(msg *proto.Message) *pbactivity.Record {
 		record.VerticalRatio = &vr
 	}
 
-	// Step Length (Stride Length) - FIT unit is mm
+	// Step Length (Stride Length) - FIT unit is 0.1mm
 	if recordMsg.StepLength != 0xFFFF {
-		sl := float64(recordMsg.StepLength) / 1000 // Convert to meters
+		sl := float64(recordMsg.StepLength) / 10000 // Convert to meters
 		record.StepLength = &sl
 	}
 
@@ -629,6 +682,29 @@ func parseRecord(msg *proto.Message) *pbactivity.Record {
 	return record
 }
 
+// devFieldFloat reads a numeric developer field value. Developer fields carry
+// no scale, so the value is used as written.
+func devFieldFloat(v proto.Value) (float64, bool) {
+	switch v.Type() {
+	case proto.TypeFloat32:
+		f := v.Float32()
+		if math.IsNaN(float64(f)) {
+			return 0, false
+		}
+		return float64(f), true
+	case proto.TypeFloat64:
+		f := v.Float64()
+		return f, !math.IsNaN(f)
+	case proto.TypeUint8:
+		return float64(v.Uint8()), v.Uint8() != 0xFF
+	case proto.TypeUint16:
+		return float64(v.Uint16()), v.Uint16() != 0xFFFF
+	case proto.TypeInt16:
+		return float64(v.Int16()), v.Int16() != 0x7FFF
+	}
+	return 0, false
+}
+
 // MergeSessions merges multiple sessions into a single session.
 // This is useful for FIT files that contain multiple sessions from device auto-pause.
 func MergeSessions(sessions []*pbactivity.Session) *pbactivity.Session {
@@ -3,11 +3,17 @@ package fit_parser
 import (
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 
+	"bytes"
+	"math"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/muktihari/fit/encoder"
+	"github.com/muktihari/fit/profile/basetype"
+	"github.com/muktihari/fit/profile/mesgdef"
 	"github.com/muktihari/fit/profile/typedef"
+	"github.com/muktihari/fit/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -104,6 +110,36 @@ func TestParseFitFile_InvalidData(t *testing.T) {
 	}
 }
 
+func TestParseFitFile_MissingDistance(t *testing.T) {
+	start := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	fit := proto.FIT{Messages: []proto.Message{
+		mesgdef.NewFileId(nil).SetType(typedef.FileActivity).SetTimeCreated(start).ToMesg(nil),
+		mesgdef.NewRecord(nil).SetTimestamp(start).SetHeartRate(120).ToMesg(nil),
+		mesgdef.NewLap(nil).SetTimestamp(start).SetStartTime(start).SetTotalElapsedTime(60000).ToMesg(nil),
+		mesgdef.NewSession(nil).SetTimestamp(start).SetStartTime(start).SetTotalElapsedTime(60000).SetSport(typedef.SportTraining).ToMesg(nil),
+	}}
+	var buf bytes.Buffer
+	if err := encoder.New(&buf).Encode(&fit); err != nil {
+		t.Fatalf("Failed to encode FIT: %v", err)
+	}
+
+	activity, err := ParseFitFile(buf.Bytes())
+	if err != nil {
+		t.Fatalf("ParseFitFile failed: %v", err)
+	}
+	if len(activity.Sessions) != 1 {
+		t.Fatalf("Expected 1 session, got %d", len(activity.Sessions))
+	}
+	if d := activity.Sessions[0].TotalDistance; d != 0 {
+		t.Errorf("Expected session distance 0 when unset, got %v", d)
+	}
+	for _, lap := range activity.Sessions[0].Laps {
+		if lap.TotalDistance != 0 {
+			t.Errorf("Expected lap distance 0 when unset, got %v", lap.TotalDistance)
+		}
+	}
+}
+
 func TestMergeSessions(t *testing.T) {
 	now := time.Now()
 	sessions := []*pbactivity.Session{
@@ -226,3 +262,80 @@ func TestGenerateActivityName(t *testing.T) {
 		}
 	}
 }
+
+func TestParseFitFile_Temperature(t *testing.T) {
+	start := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	fit := proto.FIT{Messages: []proto.Message{
+		mesgdef.NewFileId(nil).SetType(typedef.FileActivity).SetTimeCreated(start).ToMesg(nil),
+		mesgdef.NewRecord(nil).SetTimestamp(start).SetTemperature(-2).ToMesg(nil),
+		mesgdef.NewRecord(nil).SetTimestamp(start.Add(time.Second)).SetHeartRate(120).ToMesg(nil),
+		mesgdef.NewLap(nil).SetTimestamp(start).SetStartTime(start).SetTotalElapsedTime(2000).ToMesg(nil),
+		mesgdef.NewSession(nil).SetTimestamp(start).SetStartTime(start).SetTotalElapsedTime(2000).SetSport(typedef.SportRunning).ToMesg(nil),
+	}}
+	var buf bytes.Buffer
+	if err := encoder.New(&buf).Encode(&fit); err != nil {
+		t.Fatalf("Failed to encode FIT: %v", err)
+	}
+
+	activity, err := ParseFitFile(buf.Bytes())
+	if err != nil {
+		t.Fatalf("ParseFitFile failed: %v", err)
+	}
+	records := activity.Sessions[0].Laps[0].Records
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(records))
+	}
+	if records[0].Temperature == nil || *records[0].Temperature != -2 {
+		t.Errorf("Expected temperature -2, got %v", records[0].Temperature)
+	}
+	if records[1].Temperature != nil {
+		t.Errorf("Expected no temperature when unset, got %v", *records[1].Temperature)
+	}
+}
+
+func TestParseFitFile_CoreTemperature(t *testing.T) {
+	start := time.Date(2026, 7, 20, 14, 0, 0, 0, time.UTC)
+
+	// The CORE Connect IQ app records core temperature as a float32 developer field
+	coreRecord := mesgdef.NewRecord(nil).SetTimestamp(start.Add(time.Second)).ToMesg(nil)
+	coreRecord.DeveloperFields = []proto.DeveloperField{
+		{Num: 0, DeveloperDataIndex: 0, Value: proto.Float32(38.25)},
+		{Num: 1, DeveloperDataIndex: 0, Value: proto.Float32(35.5)},
+	}
+
+	fit := proto.FIT{Messages: []proto.Message{
+		mesgdef.NewFileId(nil).SetType(typedef.FileActivity).SetTimeCreated(start).ToMesg(nil),
+		mesgdef.NewDeveloperDataId(nil).SetDeveloperDataIndex(0).SetApplicationId(make([]byte, 16)).ToMesg(nil),
+		mesgdef.NewFieldDescription(nil).SetDeveloperDataIndex(0).SetFieldDefinitionNumber(0).
+			SetFitBaseTypeId(basetype.Float32).SetFieldName([]string{"core_temperature"}).SetUnits([]string{"°C"}).ToMesg(nil),
+		mesgdef.NewFieldDescription(nil).SetDeveloperDataIndex(0).SetFieldDefinitionNumber(1).
+			SetFitBaseTypeId(basetype.Float32).SetFieldName([]string{"skin_temperature"}).SetUnits([]string{"°C"}).ToMesg(nil),
+		mesgdef.NewRecord(nil).SetTimestamp(start).SetCoreTemperatureScaled(37.6).ToMesg(nil),
+		coreRecord,
+		mesgdef.NewRecord(nil).SetTimestamp(start.Add(2 * time.Second)).SetHeartRate(150).ToMesg(nil),
+		mesgdef.NewLap(nil).SetTimestamp(start).SetStartTime(start).SetTotalElapsedTime(3000).ToMesg(nil),
+		mesgdef.NewSession(nil).SetTimestamp(start).SetStartTime(start).SetTotalElapsedTime(3000).SetSport(typedef.SportCycling).ToMesg(nil),
+	}}
+	var buf bytes.Buffer
+	if err := encoder.New(&buf, encoder.WithProtocolVersion(proto.V2)).Encode(&fit); err != nil {
+		t.Fatalf("Failed to encode FIT: %v", err)
+	}
+
+	activity, err := ParseFitFile(buf.Bytes())
+	if err != nil {
+		t.Fatalf("ParseFitFile failed: %v", err)
+	}
+	records := activity.Sessions[0].Laps[0].Records
+	if len(records) != 3 {
+		t.Fatalf("Expected 3 records, got %d", len(records))
+	}
+	if records[0].CoreTemperature == nil || math.Abs(*records[0].CoreTemperature-37.6) > 0.01 {
+		t.Errorf("Expected native core temperature 37.6, got %v", records[0].CoreTemperature)
+	}
+	if records[1].CoreTemperature == nil || *records[1].CoreTemperature != 38.25 {
+		t.Errorf("Expected developer field core temperature 38.25, got %v", records[1].CoreTemperature)
+	}
+	if records[2].CoreTemperature != nil {
+		t.Errorf("Expected no core temperature when unset, got %v", *records[2].CoreTemperature)
+	}
+}
@@ -1,8 +1,16 @@
 package fit_parser
 
 import (
+	"bytes"
+	"math"
 	"os"
 	"testing"
+	"time"
+
+	"github.com/muktihari/fit/encoder"
+	"github.com/muktihari/fit/profile/mesgdef"
+	"github.com/muktihari/fit/profile/typedef"
+	"github.com/muktihari/fit/proto"
 )
 
 func TestParseFitFile_RunningDynamics(t *testing.T) {
@@ -72,3 +80,30 @@ func TestParseFitFile_RunningDynamics(t *testing.T) {
 	t.Logf("Verified Garmin Dynamics: Speed=%v, Alt=%v, GCT=%v, VO=%v, SL=%v (Total Records: %d)",
 		hasSpeed, hasAltitude, hasGCT, hasVO, hasSL, totalRecords)
 }
+
+func TestParseFitFile_RunningDynamicsScales(t *testing.T) {
+	start := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	fit := proto.FIT{Messages: []proto.Message{
+		mesgdef.NewFileId(nil).SetType(typedef.FileActivity).SetTimeCreated(start).ToMesg(nil),
+		mesgdef.NewRecord(nil).SetTimestamp(start).
+			SetStanceTime(2420).SetVerticalOscillation(840).SetVerticalRatio(780).SetStepLength(11200).ToMesg(nil),
+		mesgdef.NewLap(nil).SetTimestamp(start).SetStartTime(start).SetTotalElapsedTime(1000).ToMesg(nil),
+		mesgdef.NewSession(nil).SetTimestamp(start).SetStartTime(start).SetTotalElapsedTime(1000).SetSport(typedef.SportRunning).ToMesg(nil),
+	}}
+	var buf bytes.Buffer
+	if err := encoder.New(&buf).Encode(&fit); err != nil {
+		t.Fatalf("Failed to encode FIT: %v", err)
+	}
+
+	activity, err := ParseFitFile(buf.Bytes())
+	if err != nil {
+		t.Fatalf("ParseFitFile failed: %v", err)
+	}
+	record := activity.Sessions[0].Laps[0].Records[0]
+	if record.GetGroundContactTime() != 242 || record.GetVerticalOscillation() != 84 || record.GetVerticalRatio() != 78 {
+		t.Errorf("Unexpected dynamics: gct=%d vo=%d ratio=%d", record.GetGroundContactTime(), record.GetVerticalOscillation(), record.GetVerticalRatio())
+	}
+	if math.Abs(record.GetStepLength()-1.12) > 1e-9 {
+		t.Errorf("Expected step length 1.12 m, got %v", record.GetStepLength())
+	}
+}
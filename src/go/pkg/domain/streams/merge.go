@@ -0,0 +1,73 @@
+package streams
+
+// Channel identifies a record field that can be filled from a stream.
+type Channel int
+
+const (
+	HeartRate Channel = iota
+	Power
+	// Position covers latitude and longitude together so a route is never
+	// stitched from two different sources.
+	Position
+	Cadence
+	Altitude
+	Temperature
+)
+
+// Sensor describes the kind of device a stream was recorded with.
+type Sensor int
+
+const (
+	// SensorUnknown is a stream whose origin isn't declared.
+	SensorUnknown Sensor = iota
+	// SensorEstimated is synthesised rather than measured (e.g. virtual GPS).
+	SensorEstimated
+	// SensorOptical is a wrist or arm PPG heart rate sensor.
+	SensorOptical
+	// SensorChestStrap is an ECG chest strap heart rate monitor.
+	SensorChestStrap
+	// SensorPowerMeter is a crank, pedal or hub power meter.
+	SensorPowerMeter
+	// SensorGPS is a satellite position fix.
+	SensorGPS
+	// SensorSource is data recorded by the source activity's own device,
+	// whose sensor type the source doesn't tell us.
+	SensorSource
+)
+
+// MergePolicy ranks sensors per channel, lowest priority first. Sensors not
+// listed for a channel rank below every listed sensor.
+type MergePolicy map[Channel][]Sensor
+
+// DefaultMergePolicy prefers dedicated measurement hardware over wrist
+// sensors, and measured data over estimates. The source activity's own data
+// ranks above wrist optical heart rate, so a third-party wearable only fills
+// its gaps, but below a declared chest strap, power meter or GPS. Undeclared
+// streams sit just above estimates.
+func DefaultMergePolicy() MergePolicy {
+	return MergePolicy{
+		HeartRate:   {SensorEstimated, SensorUnknown, SensorOptical, SensorSource, SensorChestStrap},
+		Power:       {SensorEstimated, SensorUnknown, SensorSource, SensorPowerMeter},
+		Position:    {SensorEstimated, SensorUnknown, SensorSource, SensorGPS},
+		Cadence:     {SensorEstimated, SensorUnknown, SensorSource},
+		Altitude:    {SensorEstimated, SensorUnknown, SensorSource},
+		Temperature: {SensorEstimated, SensorUnknown, SensorSource},
+	}
+}
+
+func (p MergePolicy) rank(ch Channel, s Sensor) int {
+	for i, candidate := range p[ch] {
+		if candidate == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// Overrides reports whether samples from incoming should replace samples
+// already recorded by existing on the channel. Ties go to incoming, so later
+// sources of equal standing replace earlier ones. When this returns false the
+// incoming stream should only fill gaps.
+func (p MergePolicy) Overrides(ch Channel, existing, incoming Sensor) bool {
+	return p.rank(ch, incoming) >= p.rank(ch, existing)
+}
@@ -0,0 +1,41 @@
+package streams
+
+import "testing"
+
+func TestDefaultMergePolicy_Overrides(t *testing.T) {
+	policy := DefaultMergePolicy()
+
+	tests := []struct {
+		name     string
+		channel  Channel
+		existing Sensor
+		incoming Sensor
+		want     bool
+	}{
+		{"chest strap over optical", HeartRate, SensorOptical, SensorChestStrap, true},
+		{"optical does not override chest strap", HeartRate, SensorChestStrap, SensorOptical, false},
+		{"declared sensor over undeclared data", HeartRate, SensorUnknown, SensorOptical, true},
+		{"undeclared streams replace undeclared data", HeartRate, SensorUnknown, SensorUnknown, true},
+		{"estimate does not override recorded data", Position, SensorSource, SensorEstimated, false},
+		{"optical does not override source data", HeartRate, SensorSource, SensorOptical, false},
+		{"chest strap over source data", HeartRate, SensorSource, SensorChestStrap, true},
+		{"undeclared streams do not override source data", Cadence, SensorSource, SensorUnknown, false},
+		{"gps over estimate", Position, SensorEstimated, SensorGPS, true},
+		{"power meter over undeclared power", Power, SensorUnknown, SensorPowerMeter, true},
+		{"unlisted sensor ranks lowest", Power, SensorUnknown, SensorChestStrap, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.Overrides(tt.channel, tt.existing, tt.incoming); got != tt.want {
+				t.Errorf("Overrides(%v, %v, %v) = %v, want %v", tt.channel, tt.existing, tt.incoming, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergePolicy_NilAlwaysOverrides(t *testing.T) {
+	var policy MergePolicy
+	if !policy.Overrides(HeartRate, SensorChestStrap, SensorOptical) {
+		t.Error("Expected an empty policy to keep last-writer-wins behaviour")
+	}
+}
@@ -0,0 +1,62 @@
+package streams
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// DropoutSeconds is the shortest run of missing samples between two
+	// readings that counts as a dropout rather than jitter.
+	DropoutSeconds = 5
+	// dropoutPenalty is the score lost per dropout.
+	dropoutPenalty = 2
+)
+
+// Quality summarises how complete a 1Hz stream is over an activity.
+type Quality struct {
+	Coverage float64 // Fraction of the activity's seconds with a reading, 0-1
+	Dropouts int     // Gaps of DropoutSeconds or more between readings
+}
+
+// MeasureQuality scores samples (one per second, zero meaning no reading)
+// over an activity lasting duration seconds. Samples past the end are ignored.
+func MeasureQuality(samples []int, duration int) Quality {
+	if duration <= 0 {
+		return Quality{}
+	}
+
+	var q Quality
+	covered := 0
+	gap := 0
+	seenReading := false
+	for i := 0; i < duration; i++ {
+		if i < len(samples) && samples[i] > 0 {
+			covered++
+			// Leading and trailing gaps lower coverage but aren't dropouts
+			if seenReading && gap >= DropoutSeconds {
+				q.Dropouts++
+			}
+			seenReading = true
+			gap = 0
+			continue
+		}
+		gap++
+	}
+	q.Coverage = float64(covered) / float64(duration)
+	return q
+}
+
+// Score rates the stream from 0 to 100: its coverage percentage, less
+// dropoutPenalty for every dropout.
+func (q Quality) Score() int {
+	score := int(math.Round(q.Coverage*100)) - q.Dropouts*dropoutPenalty
+	if score < 0 {
+		return 0
+	}
+	return score
+}
+
+func (q Quality) String() string {
+	return fmt.Sprintf("score=%d coverage=%.0f%% dropouts=%d", q.Score(), q.Coverage*100, q.Dropouts)
+}
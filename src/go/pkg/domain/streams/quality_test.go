@@ -0,0 +1,55 @@
+package streams
+
+import "testing"
+
+func TestMeasureQuality(t *testing.T) {
+	hr := func(pattern ...int) []int {
+		// pattern alternates run lengths of readings and gaps, starting with readings
+		var out []int
+		for i, n := range pattern {
+			for j := 0; j < n; j++ {
+				if i%2 == 0 {
+					out = append(out, 120)
+				} else {
+					out = append(out, 0)
+				}
+			}
+		}
+		return out
+	}
+
+	tests := []struct {
+		name         string
+		samples      []int
+		duration     int
+		wantCoverage float64
+		wantDropouts int
+		wantScore    int
+	}{
+		{"complete", hr(100), 100, 1, 0, 100},
+		{"short gaps are jitter", hr(40, 4, 56), 100, 0.96, 0, 96},
+		{"dropouts", hr(30, 10, 30, 5, 25), 100, 0.85, 2, 81},
+		{"stream ends early", hr(50), 100, 0.5, 0, 50},
+		{"leading gap is not a dropout", append(make([]int, 20), hr(80)...), 100, 0.8, 0, 80},
+		{"no readings", nil, 100, 0, 0, 0},
+		{"samples past the end ignored", hr(200), 100, 1, 0, 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := MeasureQuality(tt.samples, tt.duration)
+			if q.Coverage != tt.wantCoverage || q.Dropouts != tt.wantDropouts {
+				t.Errorf("MeasureQuality() = %+v, want coverage %v and %d dropouts", q, tt.wantCoverage, tt.wantDropouts)
+			}
+			if q.Score() != tt.wantScore {
+				t.Errorf("Score() = %d, want %d", q.Score(), tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestQuality_String(t *testing.T) {
+	q := Quality{Coverage: 0.975, Dropouts: 1}
+	if got := q.String(); got != "score=96 coverage=98% dropouts=1" {
+		t.Errorf("String() = %q", got)
+	}
+}
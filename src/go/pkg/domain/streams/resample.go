@@ -0,0 +1,142 @@
+// Package streams provides utilities for aligning fixed-rate sample streams
+// (heart rate, power, position) onto a common time base.
+package streams
+
+import (
+	"math"
+	"time"
+)
+
+// Interpolation selects how values between two source samples are derived.
+type Interpolation int
+
+const (
+	// Linear blends the two neighbouring samples by distance. Suited to
+	// continuous physiological channels such as heart rate and power.
+	Linear Interpolation = iota
+	// Nearest takes the closest source sample. Suited to position, where a
+	// blended coordinate could fall off the recorded route.
+	Nearest
+)
+
+// Resample converts an integer stream sampled at sourceHz to targetHz using
+// linear interpolation. It is a rate-based convenience over ResampleInts for
+// sources that document their granularity as a frequency (e.g. Fitbit
+// intraday heart rate at 0.2Hz or 1/15Hz).
+func Resample(values []int, sourceHz, targetHz float64) []int {
+	if sourceHz <= 0 || targetHz <= 0 {
+		return values
+	}
+	return ResampleInts(values, hzToInterval(sourceHz), hzToInterval(targetHz), Linear)
+}
+
+func hzToInterval(hz float64) time.Duration {
+	return time.Duration(math.Round(float64(time.Second) / hz))
+}
+
+// ResampleInts converts a stream sampled every `from` into one sampled every
+// `to`. The output covers the same span as the input (len(values) * from);
+// samples past the last source point hold the last value.
+//
+// Zero is treated as "no reading": Linear never blends a real value with a
+// gap and falls back to the nearest present sample instead, so a dropout does
+// not drag a 150 bpm reading halfway to zero.
+func ResampleInts(values []int, from, to time.Duration, method Interpolation) []int {
+	if from == to || from <= 0 || to <= 0 || len(values) == 0 {
+		return values
+	}
+
+	out := make([]int, outputLength(len(values), from, to))
+	for i := range out {
+		lo, hi, frac := position(i, len(values), from, to)
+		a, b := values[lo], values[hi]
+		switch {
+		case lo == hi || frac == 0:
+			out[i] = a
+		case method == Linear && a != 0 && b != 0:
+			out[i] = int(math.Round(float64(a) + (float64(b)-float64(a))*frac))
+		case nearestIsUpper(frac, a, b):
+			out[i] = b
+		default:
+			out[i] = a
+		}
+	}
+	return out
+}
+
+// ResampleFloats is the float64 counterpart of ResampleInts.
+func ResampleFloats(values []float64, from, to time.Duration, method Interpolation) []float64 {
+	if from == to || from <= 0 || to <= 0 || len(values) == 0 {
+		return values
+	}
+
+	out := make([]float64, outputLength(len(values), from, to))
+	for i := range out {
+		lo, hi, frac := position(i, len(values), from, to)
+		a, b := values[lo], values[hi]
+		switch {
+		case lo == hi || frac == 0:
+			out[i] = a
+		case method == Linear && a != 0 && b != 0:
+			out[i] = a + (b-a)*frac
+		case nearestIsUpper(frac, a, b):
+			out[i] = b
+		default:
+			out[i] = a
+		}
+	}
+	return out
+}
+
+// FillGaps linearly interpolates runs of missing (zero) samples that have a
+// reading on both sides and span at most maxGap samples; maxGap <= 0 fills
+// every interior gap. Leading and trailing gaps are left as they are, since
+// there is nothing to interpolate towards. values is modified in place and
+// returned.
+func FillGaps(values []int, maxGap int) []int {
+	prev := -1
+	for i, v := range values {
+		if v == 0 {
+			continue
+		}
+		if gap := i - prev - 1; prev >= 0 && gap > 0 && (maxGap <= 0 || gap <= maxGap) {
+			a, b := float64(values[prev]), float64(v)
+			for j := prev + 1; j < i; j++ {
+				frac := float64(j-prev) / float64(i-prev)
+				values[j] = int(math.Round(a + (b-a)*frac))
+			}
+		}
+		prev = i
+	}
+	return values
+}
+
+// outputLength returns how many `to`-spaced samples cover n `from`-spaced samples.
+func outputLength(n int, from, to time.Duration) int {
+	span := time.Duration(n) * from
+	return int((span + to - 1) / to)
+}
+
+// position locates output sample i between source indices lo and hi, with
+// frac in [0, 1) giving the distance from lo. Past the end lo == hi.
+func position(i, n int, from, to time.Duration) (lo, hi int, frac float64) {
+	t := time.Duration(i) * to
+	lo = int(t / from)
+	if lo >= n-1 {
+		return n - 1, n - 1, 0
+	}
+	return lo, lo + 1, float64(t%from) / float64(from)
+}
+
+// nearestIsUpper reports whether the upper sample should be used. Ties go to
+// the lower sample, and a present reading always wins over a missing one.
+func nearestIsUpper[T comparable](frac float64, a, b T) bool {
+	var zero T
+	if a == zero {
+		return b != zero
+	}
+	if b == zero {
+		return false
+	}
+	return frac > 0.5
+}
@@ -0,0 +1,107 @@
+package streams
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResampleInts_UpsampleLinear(t *testing.T) {
+	got := ResampleInts([]int{100, 120, 130}, 5*time.Second, time.Second, Linear)
+	want := []int{100, 104, 108, 112, 116, 120, 122, 124, 126, 128, 130, 130, 130, 130, 130}
+
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d samples, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Sample %d: expected %d, got %d", i, want[i], got[i])
+		}
+	}
+}
+
+func TestResampleInts_DoesNotBlendGaps(t *testing.T) {
+	got := ResampleInts([]int{150, 0, 160}, 4*time.Second, time.Second, Linear)
+
+	// Either side of the missing sample snaps to the present reading rather
+	// than interpolating toward zero; the missing sample itself stays missing.
+	for i := 0; i < 4; i++ {
+		if got[i] != 150 {
+			t.Errorf("Sample %d: expected 150, got %d", i, got[i])
+		}
+	}
+	if got[4] != 0 {
+		t.Errorf("Sample 4: expected 0, got %d", got[4])
+	}
+	for i := 5; i < 8; i++ {
+		if got[i] != 160 {
+			t.Errorf("Sample %d: expected 160, got %d", i, got[i])
+		}
+	}
+}
+
+func TestResampleInts_Downsample(t *testing.T) {
+	// 2Hz to 1Hz keeps every other sample
+	got := ResampleInts([]int{200, 210, 220, 230, 240, 250}, 500*time.Millisecond, time.Second, Linear)
+	want := []int{200, 220, 240}
+
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d samples, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Sample %d: expected %d, got %d", i, want[i], got[i])
+		}
+	}
+}
+
+func TestResampleFloats_Nearest(t *testing.T) {
+	got := ResampleFloats([]float64{51.0, 51.1}, 4*time.Second, time.Second, Nearest)
+	want := []float64{51.0, 51.0, 51.0, 51.1, 51.1, 51.1, 51.1, 51.1}
+
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d samples, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Sample %d: expected %v, got %v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestResample_SameRateIsNoop(t *testing.T) {
+	in := []int{1, 2, 3}
+	got := ResampleInts(in, time.Second, time.Second, Linear)
+	if len(got) != 3 || &got[0] != &in[0] {
+		t.Error("Expected same-rate resample to return the input unchanged")
+	}
+}
+
+func TestResample_Hz(t *testing.T) {
+	// 1/15Hz (Fitbit's coarsest intraday granularity) up to 1Hz
+	got := Resample([]int{90, 120}, 1.0/15, 1)
+	if len(got) != 30 {
+		t.Fatalf("Expected 30 samples, got %d", len(got))
+	}
+	if got[0] != 90 || got[5] != 100 || got[15] != 120 || got[29] != 120 {
+		t.Errorf("Unexpected samples: %v", got)
+	}
+
+	if same := Resample([]int{1, 2}, 0, 1); len(same) != 2 {
+		t.Errorf("Expected invalid rate to return input unchanged, got %v", same)
+	}
+}
+
+func TestFillGaps(t *testing.T) {
+	got := FillGaps([]int{0, 100, 0, 0, 0, 140, 0, 0, 0, 0, 150, 0}, 3)
+	want := []int{0, 100, 110, 120, 130, 140, 0, 0, 0, 0, 150, 0}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Sample %d: expected %d, got %d", i, want[i], got[i])
+		}
+	}
+
+	unlimited := FillGaps([]int{100, 0, 0, 0, 0, 150}, 0)
+	if unlimited[1] != 110 || unlimited[4] != 140 {
+		t.Errorf("Expected every interior gap filled, got %v", unlimited)
+	}
+}
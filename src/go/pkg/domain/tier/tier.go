@@ -12,6 +12,14 @@ const (
 	HobbyistTierMaxConnections = 2
 )
 
+// Enrichment budgets cap the cumulative wall-clock time enrichers may spend in a
+// single pipeline run. Both sit well inside the enricher's 600s ack deadline so a
+// run always finishes (and publishes) before the invocation is cut off.
+const (
+	HobbyistTierEnrichmentBudget = 60 * time.Second
+	AthleteTierEnrichmentBudget  = 180 * time.Second
+)
+
 // Effective tier is used for internal logic
 type EffectiveTier string
 
@@ -72,6 +80,14 @@ func CanAddConnection(user *user.Record, currentCount int) (allowed bool, reason
 	return true, ""
 }
 
+// EnrichmentBudget returns the total provider execution time allowed per pipeline run.
+func EnrichmentBudget(user *user.Record) time.Duration {
+	if GetEffectiveTier(user) == TierAthlete {
+		return AthleteTierEnrichmentBudget
+	}
+	return HobbyistTierEnrichmentBudget
+}
+
 // ShouldResetSyncCount checks if the sync counter should be reset (monthly)
 func ShouldResetSyncCount(user *user.Record) bool {
 	if user.SyncCountResetAt == nil {
@@ -137,6 +137,18 @@ func TestCanSync(t *testing.T) {
 	}
 }
 
+func TestEnrichmentBudget(t *testing.T) {
+	hobbyist := &user.Record{UserProfile: &pbuser.UserProfile{Tier: pbuser.UserTier_USER_TIER_HOBBYIST}}
+	if got := EnrichmentBudget(hobbyist); got != HobbyistTierEnrichmentBudget {
+		t.Errorf("EnrichmentBudget(hobbyist) = %v, want %v", got, HobbyistTierEnrichmentBudget)
+	}
+
+	athlete := &user.Record{UserProfile: &pbuser.UserProfile{Tier: pbuser.UserTier_USER_TIER_ATHLETE}}
+	if got := EnrichmentBudget(athlete); got != AthleteTierEnrichmentBudget {
+		t.Errorf("EnrichmentBudget(athlete) = %v, want %v", got, AthleteTierEnrichmentBudget)
+	}
+}
+
 func TestShouldResetSyncCount(t *testing.T) {
 	now := time.Now()
 	// Use the 1st of the previous month to avoid Go's AddDate normalization
@@ -0,0 +1,66 @@
+package tier
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+)
+
+// Feature is a metered feature with a monthly quota per tier. Usage is
+// counted per month in users/{userId}/usage/{YYYY-MM}, one field per feature.
+type Feature string
+
+const (
+	FeatureAIBanner  Feature = "ai_banner_generations"
+	FeatureMapRender Feature = "map_renders"
+	FeatureAITokens  Feature = "ai_tokens" // Input and output tokens across AI enrichers
+)
+
+// Athlete monthly quotas. They are fair-use caps sized well above a daily
+// trainer's needs; every metered feature is athlete-only, so hobbyists have none.
+const (
+	AthleteTierAIBannersPerMonth  = 60
+	AthleteTierMapRendersPerMonth = 500
+	AthleteTierAITokensPerMonth   = 1_000_000
+)
+
+var athleteQuotas = map[Feature]int64{
+	FeatureAIBanner:  AthleteTierAIBannersPerMonth,
+	FeatureMapRender: AthleteTierMapRendersPerMonth,
+	FeatureAITokens:  AthleteTierAITokensPerMonth,
+}
+
+var featureLabels = map[Feature]string{
+	FeatureAIBanner:  "AI banner",
+	FeatureMapRender: "map render",
+	FeatureAITokens:  "AI token",
+}
+
+// Quota returns the user's monthly allowance of feature.
+func Quota(user *user.Record, feature Feature) int64 {
+	if GetEffectiveTier(user) == TierAthlete {
+		return athleteQuotas[feature]
+	}
+	return 0
+}
+
+// UsagePeriod is the usage document ID for t: its UTC month, as for
+// monthly_costs.
+func UsagePeriod(t time.Time) string {
+	return t.UTC().Format("2006-01")
+}
+
+// CheckUsage checks if user can use more of feature, given how much they
+// have used this month, and returns how much remains.
+func CheckUsage(user *user.Record, feature Feature, used int64) (allowed bool, remaining int64, reason string) {
+	quota := Quota(user, feature)
+	remaining = quota - used
+	if remaining > 0 {
+		return true, remaining, ""
+	}
+	if GetEffectiveTier(user) != TierAthlete {
+		return false, 0, "Athlete tier required."
+	}
+	return false, 0, fmt.Sprintf("Monthly %s limit reached (%d/month). It resets on the 1st.", featureLabels[feature], quota)
+}
@@ -0,0 +1,47 @@
+package tier
+
+import (
+	"testing"
+	"time"
+
+	user "github.com/fitglue/server/src/go/pkg/domain/user"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+func TestCheckUsage(t *testing.T) {
+	athlete := &user.Record{UserProfile: &pbuser.UserProfile{Tier: pbuser.UserTier_USER_TIER_ATHLETE}}
+	hobbyist := &user.Record{UserProfile: &pbuser.UserProfile{Tier: pbuser.UserTier_USER_TIER_HOBBYIST}}
+
+	tests := []struct {
+		name      string
+		user      *user.Record
+		feature   Feature
+		used      int64
+		allowed   bool
+		remaining int64
+	}{
+		{"Athlete under quota", athlete, FeatureAIBanner, 10, true, AthleteTierAIBannersPerMonth - 10},
+		{"Athlete at quota", athlete, FeatureAIBanner, AthleteTierAIBannersPerMonth, false, 0},
+		{"Athlete over token quota", athlete, FeatureAITokens, AthleteTierAITokensPerMonth + 500, false, 0},
+		{"Hobbyist has no quota", hobbyist, FeatureMapRender, 0, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, remaining, reason := CheckUsage(tt.user, tt.feature, tt.used)
+			if allowed != tt.allowed || remaining != tt.remaining {
+				t.Errorf("CheckUsage() = %v, %d, want %v, %d", allowed, remaining, tt.allowed, tt.remaining)
+			}
+			if !allowed && reason == "" {
+				t.Error("CheckUsage() should give a reason when not allowed")
+			}
+		})
+	}
+}
+
+func TestUsagePeriod(t *testing.T) {
+	ts := time.Date(2026, 3, 31, 23, 30, 0, 0, time.FixedZone("EST", -5*3600))
+	if got := UsagePeriod(ts); got != "2026-04" {
+		t.Errorf("UsagePeriod() = %q, want 2026-04", got)
+	}
+}
@@ -16,8 +16,10 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/cloudevents/sdk-go/v2/event"
+	shared "github.com/fitglue/server/src/go/pkg"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
 	"github.com/fitglue/server/src/go/pkg/types"
 )
@@ -46,6 +48,12 @@ func (m *MockDB) GetUser(ctx context.Context, id string) (*user.Record, error) {
 func (m *MockDB) UpdateUser(ctx context.Context, id string, data map[string]interface{}) error {
 	return nil
 }
+func (m *MockDB) GetIntegrationSecret(ctx context.Context, userId string, provider string) (string, error) {
+	return "", nil
+}
+func (m *MockDB) SetIntegrationSecret(ctx context.Context, userId string, provider string, secret string) error {
+	return nil
+}
 func (m *MockDB) CreatePendingInput(ctx context.Context, userId string, input *pbpipeline.PendingInput) error {
 	return nil
 }
@@ -79,6 +87,12 @@ func (m *MockDB) IncrementSyncCount(ctx context.Context, userID string) error {
 func (m *MockDB) IncrementPreventedSyncCount(ctx context.Context, userID string) error {
 	return nil
 }
+func (m *MockDB) GetUsage(ctx context.Context, userID string, period string) (map[string]int64, error) {
+	return map[string]int64{}, nil
+}
+func (m *MockDB) IncrementUsage(ctx context.Context, userID string, period string, feature string, amount int64) error {
+	return nil
+}
 func (m *MockDB) ResetSyncCount(ctx context.Context, userID string) error {
 	return nil
 }
@@ -121,15 +135,42 @@ func (m *MockDB) ListPersonalRecords(ctx context.Context, userId string) ([]*pbu
 func (m *MockDB) DeletePersonalRecord(ctx context.Context, userId string, recordType string) error {
 	return nil
 }
+func (m *MockDB) GetGear(ctx context.Context, userId string, gearId string) (*pbuser.Gear, error) {
+	return nil, nil
+}
+func (m *MockDB) RecordGearUse(ctx context.Context, userId string, gearId string, activityId string, distanceMeters float64, usedAt time.Time) (*pbuser.Gear, bool, error) {
+	return nil, false, nil
+}
+func (m *MockDB) ListGoals(ctx context.Context, userId string) ([]*pbuser.Goal, error) {
+	return nil, nil
+}
+func (m *MockDB) GetGoal(ctx context.Context, userId string, goalId string) (*pbuser.Goal, error) {
+	return nil, nil
+}
+func (m *MockDB) SetGoal(ctx context.Context, userId string, goal *pbuser.Goal) error {
+	return nil
+}
+func (m *MockDB) DeleteGoal(ctx context.Context, userId string, goalId string) error {
+	return nil
+}
 func (m *MockDB) GetUserPipelines(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
 	return []*pbpipeline.PipelineConfig{}, nil
 }
+func (m *MockDB) GetPipelineConfigVersion(ctx context.Context, userId string, pipelineId string, version int32) (*pbpipeline.PipelineConfigVersion, error) {
+	return nil, nil
+}
 func (m *MockDB) GetPluginDefault(ctx context.Context, userId string, pluginId string) (*pbpipeline.PluginDefault, error) {
 	return nil, nil
 }
 func (m *MockDB) SetPluginDefault(ctx context.Context, userId string, pluginDefault *pbpipeline.PluginDefault) error {
 	return nil
 }
+func (m *MockDB) ListActivityTypeRules(ctx context.Context, userId string) ([]*pbpipeline.ActivityTypeRule, error) {
+	return nil, nil
+}
+func (m *MockDB) MarkActivityTypeRuleApplied(ctx context.Context, userId string, ruleId string) error {
+	return nil
+}
 func (m *MockDB) SetUploadedActivity(ctx context.Context, userId string, record *pbactivity.UploadedActivityRecord) error {
 	return nil
 }
@@ -148,12 +189,24 @@ func (m *MockDB) GetPipelineRunByActivityId(ctx context.Context, userId string,
 func (m *MockDB) UpdatePipelineRun(ctx context.Context, userId string, id string, data map[string]interface{}) error {
 	return nil
 }
+func (m *MockDB) GetActivityDayCounts(ctx context.Context, userId string, since time.Time) (map[string]int, error) {
+	return nil, nil
+}
+func (m *MockDB) AddPipelineRunCost(ctx context.Context, userId string, id string, cost *pbpipeline.RunCost) error {
+	return nil
+}
 func (m *MockDB) SetDestinationOutcome(ctx context.Context, userId string, pipelineRunId string, outcome *pbpipeline.DestinationOutcome) error {
 	return nil
 }
 func (m *MockDB) GetDestinationOutcomes(ctx context.Context, userId string, pipelineRunId string) ([]*pbpipeline.DestinationOutcome, error) {
 	return nil, nil
 }
+func (m *MockDB) UpdateDestinationOutcomes(ctx context.Context, userId string, pipelineRunId string, fn func(run *pbpipeline.PipelineRun, outcomes []*pbpipeline.DestinationOutcome) ([]*pbpipeline.DestinationOutcome, map[string]interface{})) error {
+	return nil
+}
+func (m *MockDB) ListStuckPipelineRuns(ctx context.Context, before time.Time, limit int) ([]*shared.UserPipelineRun, error) {
+	return nil, nil
+}
 func (m *MockDB) GetBoosterData(ctx context.Context, userId string, boosterId string) (map[string]interface{}, error) {
 	return nil, nil
 }
@@ -163,6 +216,23 @@ func (m *MockDB) SetBoosterData(ctx context.Context, userId string, boosterId st
 func (m *MockDB) DeleteBoosterData(ctx context.Context, userId string, boosterId string) error {
 	return nil
 }
+func (m *MockDB) GetProviderCircuit(ctx context.Context, providerType pbplugin.EnricherProviderType) (*pbpipeline.ProviderCircuit, error) {
+	return nil, nil
+}
+func (m *MockDB) UpdateProviderCircuit(ctx context.Context, providerType pbplugin.EnricherProviderType, fn func(c *pbpipeline.ProviderCircuit)) (*pbpipeline.ProviderCircuit, error) {
+	c := &pbpipeline.ProviderCircuit{ProviderType: providerType}
+	fn(c)
+	return c, nil
+}
+func (m *MockDB) AddProviderCircuitChange(ctx context.Context, change *pbpipeline.ProviderCircuitChange) error {
+	return nil
+}
+func (m *MockDB) GetEnricherResultShare(ctx context.Context, userId string, id string) (*pbpipeline.EnricherResultShare, error) {
+	return nil, nil
+}
+func (m *MockDB) SetEnricherResultShare(ctx context.Context, userId string, share *pbpipeline.EnricherResultShare) error {
+	return nil
+}
 
 // Update Wrapper Test to expect metadata in LogStart updates
 func TestWrapCloudEvent(t *testing.T) {
@@ -3,10 +3,12 @@ package database
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"cloud.google.com/go/firestore"
+	shared "github.com/fitglue/server/src/go/pkg"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
 	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
@@ -17,6 +19,7 @@ import (
 
 	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 
+	"google.golang.org/api/iterator"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -68,6 +71,16 @@ func (a *FirestoreAdapter) UpdateUser(ctx context.Context, id string, data map[s
 	return a.storage.Users().Doc(id).Update(ctx, data)
 }
 
+// --- Integration Secrets ---
+
+func (a *FirestoreAdapter) GetIntegrationSecret(ctx context.Context, userId string, provider string) (string, error) {
+	return storage.GetIntegrationSecret(ctx, a.Client, userId, provider)
+}
+
+func (a *FirestoreAdapter) SetIntegrationSecret(ctx context.Context, userId string, provider string, secret string) error {
+	return storage.SetIntegrationSecret(ctx, a.Client, userId, provider, secret)
+}
+
 // --- Sync Count (for tier limits) ---
 
 func (a *FirestoreAdapter) IncrementSyncCount(ctx context.Context, userID string) error {
@@ -92,6 +105,35 @@ func (a *FirestoreAdapter) ResetSyncCount(ctx context.Context, userID string) er
 	return err
 }
 
+// --- Feature Usage (for tier quotas) ---
+
+func (a *FirestoreAdapter) GetUsage(ctx context.Context, userID string, period string) (map[string]int64, error) {
+	usage := map[string]int64{}
+	doc, err := a.Client.Collection("users").Doc(userID).Collection("usage").Doc(period).Get(ctx)
+	if err != nil {
+		if isNotFoundError(err) {
+			return usage, nil
+		}
+		return nil, err
+	}
+	for k, v := range doc.Data() {
+		if n, ok := v.(int64); ok {
+			usage[k] = n
+		}
+	}
+	return usage, nil
+}
+
+// IncrementUsage adds to a feature's counter in users/{userId}/usage/{period}
+func (a *FirestoreAdapter) IncrementUsage(ctx context.Context, userID string, period string, feature string, amount int64) error {
+	_, err := a.Client.Collection("users").Doc(userID).Collection("usage").Doc(period).Set(ctx, map[string]interface{}{
+		feature:      firestore.Increment(amount),
+		"period":     period,
+		"updated_at": time.Now(),
+	}, firestore.MergeAll)
+	return err
+}
+
 // --- Pending Inputs ---
 
 func (a *FirestoreAdapter) GetPendingInput(ctx context.Context, userId string, id string) (*pbpipeline.PendingInput, error) {
@@ -225,6 +267,115 @@ func (a *FirestoreAdapter) DeletePersonalRecord(ctx context.Context, userId stri
 	return err
 }
 
+// --- Gear ---
+
+// GetGear retrieves a piece of gear by ID
+func (a *FirestoreAdapter) GetGear(ctx context.Context, userId string, gearId string) (*pbuser.Gear, error) {
+	doc, err := a.storage.Gear(userId).Doc(gearId).Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	doc.Id = gearId
+	return doc, nil
+}
+
+// RecordGearUse adds an activity's distance to a piece of gear. It writes a
+// marker at users/{userId}/gear/{gearId}/uses/{activityId} and increments
+// the gear's totals in the same transaction, only when the marker is new, so
+// a redelivered or re-run activity is never counted twice and concurrent
+// activities can't overwrite each other's mileage. It returns the gear as
+// updated and whether this call counted the activity.
+func (a *FirestoreAdapter) RecordGearUse(ctx context.Context, userId string, gearId string, activityId string, distanceMeters float64, usedAt time.Time) (*pbuser.Gear, bool, error) {
+	gearRef := a.storage.Gear(userId).Doc(gearId).Ref
+	useRef := gearRef.Collection("uses").Doc(strings.ReplaceAll(activityId, "/", "_"))
+
+	var gear *pbuser.Gear
+	var counted bool
+	err := a.Client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		counted = false
+		gearDoc, err := tx.Get(gearRef)
+		if err != nil {
+			return err
+		}
+		gear = storage.FirestoreToGear(gearDoc.Data())
+		gear.Id = gearId
+
+		if _, err := tx.Get(useRef); err == nil {
+			return nil
+		} else if !isNotFoundError(err) {
+			return err
+		}
+
+		if err := tx.Create(useRef, map[string]interface{}{
+			"activity_id":     activityId,
+			"distance_meters": distanceMeters,
+			"used_at":         usedAt,
+		}); err != nil {
+			return err
+		}
+		if err := tx.Update(gearRef, []firestore.Update{
+			{Path: "distance_meters", Value: firestore.Increment(distanceMeters)},
+			{Path: "activity_count", Value: firestore.Increment(1)},
+			{Path: "last_activity_id", Value: activityId},
+			{Path: "last_used_at", Value: usedAt},
+		}); err != nil {
+			return err
+		}
+
+		gear.DistanceMeters += distanceMeters
+		gear.ActivityCount++
+		gear.LastActivityId = activityId
+		gear.LastUsedAt = timestamppb.New(usedAt)
+		counted = true
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return gear, counted, nil
+}
+
+// --- Goals ---
+
+// ListGoals returns all of a user's goals
+func (a *FirestoreAdapter) ListGoals(ctx context.Context, userId string) ([]*pbuser.Goal, error) {
+	docs, err := a.Client.Collection("users").Doc(userId).Collection("goals").Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var goals []*pbuser.Goal
+	for _, d := range docs {
+		goal := storage.FirestoreToGoal(d.Data())
+		if goal.Id == "" {
+			goal.Id = d.Ref.ID
+		}
+		goals = append(goals, goal)
+	}
+	return goals, nil
+}
+
+// GetGoal retrieves a goal by ID
+func (a *FirestoreAdapter) GetGoal(ctx context.Context, userId string, goalId string) (*pbuser.Goal, error) {
+	doc, err := a.storage.Goals(userId).Doc(goalId).Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	doc.Id = goalId
+	return doc, nil
+}
+
+// SetGoal creates or updates a goal
+func (a *FirestoreAdapter) SetGoal(ctx context.Context, userId string, goal *pbuser.Goal) error {
+	return a.storage.Goals(userId).Doc(goal.Id).Set(ctx, goal)
+}
+
+// DeleteGoal removes a goal by ID
+func (a *FirestoreAdapter) DeleteGoal(ctx context.Context, userId string, goalId string) error {
+	_, err := a.Client.Collection("users").Doc(userId).Collection("goals").Doc(goalId).Delete(ctx)
+	return err
+}
+
 func (a *FirestoreAdapter) ListPendingInputsByEnricher(ctx context.Context, enricherId string, status pbpipeline.PendingInput_Status) ([]*pbpipeline.PendingInput, error) {
 	// Query across all pending inputs using collection group query
 	iter := a.Client.CollectionGroup("pending_inputs").
@@ -363,6 +514,23 @@ func (a *FirestoreAdapter) GetUserPipelines(ctx context.Context, userId string)
 	return pipelines, nil
 }
 
+// GetPipelineConfigVersion retrieves an immutable config snapshot from
+// users/{uid}/pipelines/{pipelineId}/versions/{version}, or nil if the
+// version was never recorded.
+func (a *FirestoreAdapter) GetPipelineConfigVersion(ctx context.Context, userId string, pipelineId string, version int32) (*pbpipeline.PipelineConfigVersion, error) {
+	doc, err := a.Client.Collection("users").Doc(userId).
+		Collection("pipelines").Doc(pipelineId).
+		Collection("versions").Doc(strconv.Itoa(int(version))).
+		Get(ctx)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return storage.FirestoreToPipelineConfigVersion(doc.Data()), nil
+}
+
 // --- Plugin Defaults (user-level default config for sources/destinations) ---
 
 // GetPluginDefault retrieves a plugin default by plugin ID
@@ -385,6 +553,36 @@ func (a *FirestoreAdapter) SetPluginDefault(ctx context.Context, userId string,
 	return a.storage.PluginDefaults(userId).Doc(pluginDefault.PluginId).Set(ctx, pluginDefault)
 }
 
+// --- Activity Type Rules ---
+
+// ListActivityTypeRules returns every type rule learned for the user,
+// including disabled and not-yet-confident ones.
+func (a *FirestoreAdapter) ListActivityTypeRules(ctx context.Context, userId string) ([]*pbpipeline.ActivityTypeRule, error) {
+	docs, err := a.storage.ActivityTypeRules(userId).Ref.Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*pbpipeline.ActivityTypeRule, 0, len(docs))
+	for _, d := range docs {
+		rule := storage.FirestoreToActivityTypeRule(d.Data())
+		if rule.Id == "" {
+			rule.Id = d.Ref.ID
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// MarkActivityTypeRuleApplied counts an automatic application of a rule.
+func (a *FirestoreAdapter) MarkActivityTypeRuleApplied(ctx context.Context, userId string, ruleId string) error {
+	_, err := a.storage.ActivityTypeRules(userId).Ref.Doc(ruleId).Update(ctx, []firestore.Update{
+		{Path: "applied_count", Value: firestore.Increment(1)},
+		{Path: "last_applied_at", Value: time.Now()},
+	})
+	return err
+}
+
 // --- Uploaded Activities (for loop prevention) ---
 
 // SetUploadedActivity records that an activity was uploaded to a destination.
@@ -422,9 +620,15 @@ func (a *FirestoreAdapter) GetUploadedActivity(ctx context.Context, userId strin
 
 // --- Pipeline Runs (lifecycle tracking) ---
 
-// CreatePipelineRun creates a new pipeline run document
+// CreatePipelineRun creates a new pipeline run document and counts it in its
+// pipeline's daily stats
 func (a *FirestoreAdapter) CreatePipelineRun(ctx context.Context, userId string, run *pbpipeline.PipelineRun) error {
-	return a.storage.PipelineRuns(userId).Doc(run.Id).Set(ctx, run)
+	runs := a.storage.PipelineRuns(userId)
+	batch := a.Client.Batch()
+	batch.Set(runs.Doc(run.Id).Ref, runs.ToFirestore(run), firestore.MergeAll)
+	a.setPipelineDailyStats(batch, userId, run.PipelineId, run.Id, run.CreatedAt, run.Status)
+	_, err := batch.Commit(ctx)
+	return err
 }
 
 // GetPipelineRun retrieves a pipeline run by ID
@@ -466,9 +670,113 @@ func (a *FirestoreAdapter) GetPipelineRunByActivityId(ctx context.Context, userI
 	return run, nil
 }
 
-// UpdatePipelineRun updates specific fields on a pipeline run
+// UpdatePipelineRun updates specific fields on a pipeline run. Status changes
+// are mirrored into the pipeline's daily stats in the same write.
 func (a *FirestoreAdapter) UpdatePipelineRun(ctx context.Context, userId string, id string, data map[string]interface{}) error {
-	return a.storage.PipelineRuns(userId).Doc(id).Update(ctx, data)
+	status, ok := data["status"].(int32)
+	if !ok {
+		return a.storage.PipelineRuns(userId).Doc(id).Update(ctx, data)
+	}
+
+	run, err := a.GetPipelineRun(ctx, userId, id)
+	if err != nil {
+		return err
+	}
+	batch := a.Client.Batch()
+	batch.Set(a.storage.PipelineRuns(userId).Doc(id).Ref, data, firestore.MergeAll)
+	a.setPipelineDailyStats(batch, userId, run.PipelineId, id, run.CreatedAt, pbpipeline.PipelineRunStatus(status))
+	_, err = batch.Commit(ctx)
+	return err
+}
+
+// GetActivityDayCounts counts the distinct activities started on each day
+// since the given time. Every run counts whatever its outcome, since a failed
+// or filtered sync is still an activity the user did, and an activity run
+// through several pipelines counts once.
+func (a *FirestoreAdapter) GetActivityDayCounts(ctx context.Context, userId string, since time.Time) (map[string]int, error) {
+	docs, err := a.Client.Collection("users").Doc(userId).Collection("pipeline_runs").
+		Where("start_time", ">=", since).
+		Select("activity_id", "start_time").
+		Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(docs))
+	counts := make(map[string]int)
+	for _, doc := range docs {
+		m := doc.Data()
+		start, ok := m["start_time"].(time.Time)
+		if !ok {
+			continue
+		}
+		activityId, _ := m["activity_id"].(string)
+		if activityId == "" {
+			activityId = doc.Ref.ID
+		}
+		if seen[activityId] {
+			continue
+		}
+		seen[activityId] = true
+		counts[start.UTC().Format("2006-01-02")]++
+	}
+	return counts, nil
+}
+
+// setPipelineDailyStats records a run's latest status on the day it was
+// created, in users/{uid}/pipelines/{pipelineId}/daily_stats/{YYYY-MM-DD}.
+// These pre-aggregated documents back the pipeline execution calendar so it
+// never has to scan a year of pipeline_runs. Runs without a pipeline or
+// creation time are left out.
+func (a *FirestoreAdapter) setPipelineDailyStats(batch *firestore.WriteBatch, userId string, pipelineId string, runId string, createdAt *timestamppb.Timestamp, status pbpipeline.PipelineRunStatus) {
+	if ref, data := a.pipelineDailyStats(userId, pipelineId, runId, createdAt, status); ref != nil {
+		batch.Set(ref, data, firestore.MergeAll)
+	}
+}
+
+// pipelineDailyStats returns the daily stats document and merge data for a
+// run's status, or a nil ref when the run is left out
+func (a *FirestoreAdapter) pipelineDailyStats(userId string, pipelineId string, runId string, createdAt *timestamppb.Timestamp, status pbpipeline.PipelineRunStatus) (*firestore.DocumentRef, map[string]interface{}) {
+	if pipelineId == "" || createdAt == nil {
+		return nil, nil
+	}
+	date := createdAt.AsTime().UTC().Format("2006-01-02")
+	ref := a.Client.Collection("users").Doc(userId).
+		Collection("pipelines").Doc(pipelineId).
+		Collection("daily_stats").Doc(date)
+	return ref, map[string]interface{}{
+		"pipeline_id": pipelineId,
+		"date":        date,
+		"runs":        map[string]interface{}{runId: int32(status)},
+		"updated_at":  time.Now(),
+	}
+}
+
+// AddPipelineRunCost increments the run's cost and the user's monthly_costs
+// document for the current month (YYYY-MM, UTC) in one batch. The monthly
+// documents are internal: they feed margin analysis, not the user's bill.
+func (a *FirestoreAdapter) AddPipelineRunCost(ctx context.Context, userId string, id string, cost *pbpipeline.RunCost) error {
+	increments := map[string]interface{}{
+		"ai_input_tokens":     firestore.Increment(cost.AiInputTokens),
+		"ai_output_tokens":    firestore.Increment(cost.AiOutputTokens),
+		"image_generations":   firestore.Increment(cost.ImageGenerations),
+		"external_api_calls":  firestore.Increment(cost.ExternalApiCalls),
+		"function_gb_seconds": firestore.Increment(cost.FunctionGbSeconds),
+		"estimated_usd":       firestore.Increment(cost.EstimatedUsd),
+	}
+	month := time.Now().UTC().Format("2006-01")
+
+	batch := a.Client.Batch()
+	batch.Set(a.storage.PipelineRuns(userId).Doc(id).Ref, map[string]interface{}{
+		"cost": increments,
+	}, firestore.MergeAll)
+	batch.Set(a.Client.Collection("users").Doc(userId).Collection("monthly_costs").Doc(month), map[string]interface{}{
+		"month":      month,
+		"cost":       increments,
+		"updated_at": time.Now(),
+	}, firestore.MergeAll)
+	_, err := batch.Commit(ctx)
+	return err
 }
 
 // --- Destination Outcomes (subcollection of Pipeline Runs) ---
@@ -478,76 +786,164 @@ func (a *FirestoreAdapter) UpdatePipelineRun(ctx context.Context, userId string,
 // SetDestinationOutcome writes a destination outcome to the subcollection
 // Document ID is the destination enum value (e.g., "1" for STRAVA, "2" for SHOWCASE)
 func (a *FirestoreAdapter) SetDestinationOutcome(ctx context.Context, userId string, pipelineRunId string, outcome *pbpipeline.DestinationOutcome) error {
-	docId := fmt.Sprintf("%d", outcome.Destination)
-	data := map[string]interface{}{
-		"destination": int32(outcome.Destination),
-		"status":      int32(outcome.Status),
-		"updated_at":  time.Now(),
-	}
-	if outcome.ExternalId != nil {
-		data["external_id"] = *outcome.ExternalId
-	}
-	if outcome.Error != nil {
-		data["error"] = *outcome.Error
-	}
-	if outcome.CompletedAt != nil {
-		data["completed_at"] = outcome.CompletedAt.AsTime()
-	}
-
-	_, err := a.Client.Collection("users").Doc(userId).
-		Collection("pipeline_runs").Doc(pipelineRunId).
-		Collection("destination_outcomes").Doc(docId).
-		Set(ctx, data, firestore.MergeAll)
+	_, err := a.destinationOutcomes(userId, pipelineRunId).
+		Doc(fmt.Sprintf("%d", outcome.Destination)).
+		Set(ctx, destinationOutcomeToFirestore(outcome), firestore.MergeAll)
 	return err
 }
 
 // GetDestinationOutcomes retrieves all destination outcomes for a pipeline run
 func (a *FirestoreAdapter) GetDestinationOutcomes(ctx context.Context, userId string, pipelineRunId string) ([]*pbpipeline.DestinationOutcome, error) {
-	iter := a.Client.Collection("users").Doc(userId).
-		Collection("pipeline_runs").Doc(pipelineRunId).
-		Collection("destination_outcomes").
-		Documents(ctx)
-
-	docs, err := iter.GetAll()
+	docs, err := a.destinationOutcomes(userId, pipelineRunId).Documents(ctx).GetAll()
 	if err != nil {
 		return nil, err
 	}
 
 	outcomes := make([]*pbpipeline.DestinationOutcome, 0, len(docs))
 	for _, doc := range docs {
-		m := doc.Data()
-		outcome := &pbpipeline.DestinationOutcome{}
-
-		if v, ok := m["destination"]; ok {
-			switch val := v.(type) {
-			case int64:
-				outcome.Destination = pbplugin.DestinationType(val)
-			case float64:
-				outcome.Destination = pbplugin.DestinationType(int32(val))
+		outcomes = append(outcomes, firestoreToDestinationOutcome(doc.Data()))
+	}
+	return outcomes, nil
+}
+
+// UpdateDestinationOutcomes reads the run and all of its outcomes in one
+// transaction and writes back what fn returns, so the run's aggregate status
+// is always computed from the outcomes it is stored alongside. A concurrent
+// outcome write makes Firestore retry the transaction, calling fn again.
+// Status changes are mirrored into the pipeline's daily stats.
+func (a *FirestoreAdapter) UpdateDestinationOutcomes(ctx context.Context, userId string, pipelineRunId string, fn func(run *pbpipeline.PipelineRun, outcomes []*pbpipeline.DestinationOutcome) ([]*pbpipeline.DestinationOutcome, map[string]interface{})) error {
+	runRef := a.storage.PipelineRuns(userId).Doc(pipelineRunId).Ref
+	outcomesRef := a.destinationOutcomes(userId, pipelineRunId)
+
+	return a.Client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		var run *pbpipeline.PipelineRun
+		runDoc, err := tx.Get(runRef)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+		if err == nil {
+			run = storage.FirestoreToPipelineRun(runDoc.Data())
+			if run.Id == "" {
+				run.Id = pipelineRunId
 			}
 		}
-		if v, ok := m["status"]; ok {
-			switch val := v.(type) {
-			case int64:
-				outcome.Status = pbpipeline.DestinationStatus(val)
-			case float64:
-				outcome.Status = pbpipeline.DestinationStatus(int32(val))
+
+		docs, err := tx.Documents(outcomesRef).GetAll()
+		if err != nil {
+			return err
+		}
+		outcomes := make([]*pbpipeline.DestinationOutcome, 0, len(docs))
+		for _, doc := range docs {
+			outcomes = append(outcomes, firestoreToDestinationOutcome(doc.Data()))
+		}
+
+		changed, runData := fn(run, outcomes)
+
+		for _, outcome := range changed {
+			ref := outcomesRef.Doc(fmt.Sprintf("%d", outcome.Destination))
+			if err := tx.Set(ref, destinationOutcomeToFirestore(outcome), firestore.MergeAll); err != nil {
+				return err
 			}
 		}
-		if v, ok := m["external_id"].(string); ok {
-			outcome.ExternalId = &v
+		if run == nil || runData == nil {
+			return nil
 		}
-		if v, ok := m["error"].(string); ok {
-			outcome.Error = &v
+		if err := tx.Set(runRef, runData, firestore.MergeAll); err != nil {
+			return err
 		}
-		if v, ok := m["completed_at"].(time.Time); ok {
-			outcome.CompletedAt = timestamppb.New(v)
+		if status, ok := runData["status"].(int32); ok {
+			if ref, stats := a.pipelineDailyStats(userId, run.PipelineId, pipelineRunId, run.CreatedAt, pbpipeline.PipelineRunStatus(status)); ref != nil {
+				return tx.Set(ref, stats, firestore.MergeAll)
+			}
 		}
+		return nil
+	})
+}
+
+// ListStuckPipelineRuns returns RUNNING runs across all users that have not
+// been updated since before, oldest first
+func (a *FirestoreAdapter) ListStuckPipelineRuns(ctx context.Context, before time.Time, limit int) ([]*shared.UserPipelineRun, error) {
+	iter := a.Client.CollectionGroup("pipeline_runs").
+		Where("status", "==", int32(pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING)).
+		Where("updated_at", "<", before).
+		OrderBy("updated_at", firestore.Asc).
+		Limit(limit).
+		Documents(ctx)
+	defer iter.Stop()
 
-		outcomes = append(outcomes, outcome)
+	var runs []*shared.UserPipelineRun
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		run := storage.FirestoreToPipelineRun(doc.Data())
+		if run.Id == "" {
+			run.Id = doc.Ref.ID
+		}
+		// users/{userId}/pipeline_runs/{runId}
+		runs = append(runs, &shared.UserPipelineRun{UserId: doc.Ref.Parent.Parent.ID, Run: run})
 	}
+	return runs, nil
+}
 
-	return outcomes, nil
+func (a *FirestoreAdapter) destinationOutcomes(userId string, pipelineRunId string) *firestore.CollectionRef {
+	return a.Client.Collection("users").Doc(userId).
+		Collection("pipeline_runs").Doc(pipelineRunId).
+		Collection("destination_outcomes")
+}
+
+func destinationOutcomeToFirestore(outcome *pbpipeline.DestinationOutcome) map[string]interface{} {
+	data := map[string]interface{}{
+		"destination": int32(outcome.Destination),
+		"status":      int32(outcome.Status),
+		"updated_at":  time.Now(),
+	}
+	if outcome.ExternalId != nil {
+		data["external_id"] = *outcome.ExternalId
+	}
+	if outcome.Error != nil {
+		data["error"] = *outcome.Error
+	}
+	if outcome.CompletedAt != nil {
+		data["completed_at"] = outcome.CompletedAt.AsTime()
+	}
+	data["api_calls"] = storage.DestinationApiCallsToFirestore(outcome.ApiCalls)
+	return data
+}
+
+func firestoreToDestinationOutcome(m map[string]interface{}) *pbpipeline.DestinationOutcome {
+	outcome := &pbpipeline.DestinationOutcome{}
+	if v, ok := m["destination"]; ok {
+		switch val := v.(type) {
+		case int64:
+			outcome.Destination = pbplugin.DestinationType(val)
+		case float64:
+			outcome.Destination = pbplugin.DestinationType(int32(val))
+		}
+	}
+	if v, ok := m["status"]; ok {
+		switch val := v.(type) {
+		case int64:
+			outcome.Status = pbpipeline.DestinationStatus(val)
+		case float64:
+			outcome.Status = pbpipeline.DestinationStatus(int32(val))
+		}
+	}
+	if v, ok := m["external_id"].(string); ok {
+		outcome.ExternalId = &v
+	}
+	if v, ok := m["error"].(string); ok {
+		outcome.Error = &v
+	}
+	if v, ok := m["completed_at"].(time.Time); ok {
+		outcome.CompletedAt = timestamppb.New(v)
+	}
+	outcome.ApiCalls = storage.FirestoreToDestinationApiCalls(m["api_calls"])
+	return outcome
 }
 
 // --- Booster Data (generic key-value storage for enrichers) ---
@@ -561,11 +957,19 @@ func (a *FirestoreAdapter) GetBoosterData(ctx context.Context, userId string, bo
 		}
 		return nil, err
 	}
-	return doc.Data(), nil
+	data := doc.Data()
+	// Sensitive boosters (health data) are encrypted with the user's data key
+	if err := storage.DecryptBoosterData(ctx, userId, boosterId, data); err != nil {
+		return nil, fmt.Errorf("booster data %s: %w", boosterId, err)
+	}
+	return data, nil
 }
 
 // SetBoosterData creates or updates booster-specific data
 func (a *FirestoreAdapter) SetBoosterData(ctx context.Context, userId string, boosterId string, data map[string]interface{}) error {
+	if err := storage.EncryptBoosterData(ctx, userId, boosterId, data); err != nil {
+		return fmt.Errorf("booster data %s: %w", boosterId, err)
+	}
 	// Add timestamp
 	data["last_updated"] = time.Now()
 	_, err := a.Client.Collection("users").Doc(userId).Collection("booster_data").Doc(boosterId).Set(ctx, data, firestore.MergeAll)
@@ -577,3 +981,79 @@ func (a *FirestoreAdapter) DeleteBoosterData(ctx context.Context, userId string,
 	_, err := a.Client.Collection("users").Doc(userId).Collection("booster_data").Doc(boosterId).Delete(ctx)
 	return err
 }
+
+// --- Provider Circuits (shared circuit breaker state per enricher provider) ---
+
+// GetProviderCircuit retrieves the circuit for a provider type, or nil if it has never failed
+func (a *FirestoreAdapter) GetProviderCircuit(ctx context.Context, providerType pbplugin.EnricherProviderType) (*pbpipeline.ProviderCircuit, error) {
+	c, err := a.storage.ProviderCircuits().Doc(providerType.String()).Get(ctx)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// UpdateProviderCircuit applies fn to the provider's circuit inside a
+// transaction, so concurrent runs across users count every failure
+func (a *FirestoreAdapter) UpdateProviderCircuit(ctx context.Context, providerType pbplugin.EnricherProviderType, fn func(c *pbpipeline.ProviderCircuit)) (*pbpipeline.ProviderCircuit, error) {
+	ref := a.storage.ProviderCircuits().Ref.Doc(providerType.String())
+	var result *pbpipeline.ProviderCircuit
+
+	err := a.Client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		c := &pbpipeline.ProviderCircuit{ProviderType: providerType}
+		doc, err := tx.Get(ref)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+		if err == nil {
+			c = storage.FirestoreToProviderCircuit(doc.Data())
+			c.ProviderType = providerType
+		}
+
+		fn(c)
+		c.UpdatedAt = timestamppb.Now()
+		result = c
+		return tx.Set(ref, storage.ProviderCircuitToFirestore(c))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AddProviderCircuitChange appends to provider_circuits/{providerType}/history
+func (a *FirestoreAdapter) AddProviderCircuitChange(ctx context.Context, change *pbpipeline.ProviderCircuitChange) error {
+	col := a.storage.ProviderCircuitChanges(change.ProviderType.String())
+	if change.Id == "" {
+		change.Id = col.Ref.NewDoc().ID
+	}
+	if change.CreatedAt == nil {
+		change.CreatedAt = timestamppb.Now()
+	}
+	return col.Doc(change.Id).Set(ctx, change)
+}
+
+// --- Enricher Result Shares (provider results reused across a splitter fan-out) ---
+
+// GetEnricherResultShare retrieves a shared provider result, or nil if no pipeline has stored it yet
+func (a *FirestoreAdapter) GetEnricherResultShare(ctx context.Context, userId string, id string) (*pbpipeline.EnricherResultShare, error) {
+	s, err := a.storage.EnricherResultShares(userId).Doc(id).Get(ctx)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+// SetEnricherResultShare stores a provider result for the fan-out's other pipelines
+func (a *FirestoreAdapter) SetEnricherResultShare(ctx context.Context, userId string, share *pbpipeline.EnricherResultShare) error {
+	if share.CreatedAt == nil {
+		share.CreatedAt = timestamppb.Now()
+	}
+	return a.storage.EnricherResultShares(userId).Doc(share.Id).Set(ctx, share)
+}
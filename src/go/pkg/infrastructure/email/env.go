@@ -0,0 +1,53 @@
+package email
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// NewSenderFromEnv builds the Sender selected by EMAIL_PROVIDER:
+//
+//   - "sendgrid": SENDGRID_API_KEY
+//   - "ses": SES_REGION, SES_SMTP_USERNAME, SES_SMTP_PASSWORD
+//   - "smtp" (default): EMAIL_APP_PASSWORD, EMAIL_SMTP_HOST, EMAIL_SMTP_PORT
+//
+// Every provider sends from SYSTEM_EMAIL. Returns nil, nil when no provider
+// is configured, leaving email disabled.
+func NewSenderFromEnv() (Sender, error) {
+	from := os.Getenv("SYSTEM_EMAIL")
+
+	switch provider := os.Getenv("EMAIL_PROVIDER"); provider {
+	case "sendgrid":
+		apiKey := os.Getenv("SENDGRID_API_KEY")
+		if apiKey == "" || from == "" {
+			return nil, fmt.Errorf("SENDGRID_API_KEY and SYSTEM_EMAIL must be set")
+		}
+		return NewSendGridSender(apiKey, from), nil
+	case "ses":
+		region, username, password := os.Getenv("SES_REGION"), os.Getenv("SES_SMTP_USERNAME"), os.Getenv("SES_SMTP_PASSWORD")
+		if region == "" || username == "" || password == "" || from == "" {
+			return nil, fmt.Errorf("SES_REGION, SES_SMTP_USERNAME, SES_SMTP_PASSWORD and SYSTEM_EMAIL must be set")
+		}
+		return NewSESSender(region, username, password, from), nil
+	case "", "smtp":
+		password := os.Getenv("EMAIL_APP_PASSWORD")
+		if password == "" || from == "" {
+			if provider == "" {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("EMAIL_APP_PASSWORD and SYSTEM_EMAIL must be set")
+		}
+		host := os.Getenv("EMAIL_SMTP_HOST")
+		if host == "" {
+			host = "smtp.gmail.com"
+		}
+		port, err := strconv.Atoi(os.Getenv("EMAIL_SMTP_PORT"))
+		if err != nil {
+			port = 465
+		}
+		return NewSMTPSender(host, port, from, password), nil
+	default:
+		return nil, fmt.Errorf("unknown EMAIL_PROVIDER %q", provider)
+	}
+}
@@ -0,0 +1,63 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	httputil "github.com/fitglue/server/src/go/pkg/infrastructure/http"
+)
+
+const sendGridURL = "https://api.sendgrid.com/v3/mail/send"
+
+// SendGridSender delivers email through the SendGrid v3 Mail Send API.
+type SendGridSender struct {
+	apiKey string
+	from   string
+	url    string
+	client *http.Client
+}
+
+func NewSendGridSender(apiKey, from string) *SendGridSender {
+	return &SendGridSender{
+		apiKey: apiKey,
+		from:   from,
+		url:    sendGridURL,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (s *SendGridSender) SendEmail(ctx context.Context, to string, subject string, htmlContent string) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"personalizations": []map[string]interface{}{
+			{"to": []map[string]string{{"email": to}}},
+		},
+		"from":    map[string]string{"email": s.from, "name": "FitGlue"},
+		"subject": subject,
+		"content": []map[string]string{{"type": "text/html", "value": htmlContent}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal email: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return httputil.WrapResponseError(resp, "SendGrid error")
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package email
+
+import "fmt"
+
+// NewSESSender returns a Sender that delivers through Amazon SES's SMTP
+// interface. SES SMTP credentials are separate from the sending address.
+func NewSESSender(region, username, password, from string) *SMTPSender {
+	return &SMTPSender{
+		host:     fmt.Sprintf("email-smtp.%s.amazonaws.com", region),
+		port:     587,
+		username: username,
+		from:     from,
+		password: password,
+	}
+}
@@ -10,6 +10,7 @@ import (
 type SMTPSender struct {
 	host     string
 	port     int
+	username string
 	from     string
 	password string
 }
@@ -25,7 +26,11 @@ func NewSMTPSender(host string, port int, from string, password string) *SMTPSen
 
 func (s *SMTPSender) SendEmail(ctx context.Context, to string, subject string, htmlContent string) error {
 	addr := fmt.Sprintf("%s:%d", s.host, s.port)
-	auth := smtp.PlainAuth("", s.from, s.password, s.host)
+	username := s.username
+	if username == "" {
+		username = s.from
+	}
+	auth := smtp.PlainAuth("", username, s.password, s.host)
 
 	// Build the email headers and body
 	var body bytes.Buffer
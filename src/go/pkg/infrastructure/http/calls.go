@@ -0,0 +1,139 @@
+package httputil
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// MaxRecordedCalls caps how many calls a CallRecorder keeps; older calls are
+// dropped first, since the last ones usually explain a failure.
+const MaxRecordedCalls = 20
+
+// MaxCallErrorSize is the maximum size of a recorded error body excerpt
+const MaxCallErrorSize = 300
+
+// CallSummary is a sanitized record of one HTTP call. It holds no headers,
+// query strings or request bodies, so it is safe to store and show to users.
+type CallSummary struct {
+	Method     string
+	URL        string // Scheme, host and path only
+	StatusCode int    // 0 when no response was received
+	Duration   time.Duration
+	Error      string // Transport error or redacted error response body excerpt
+	StartedAt  time.Time
+}
+
+// CallRecorder collects summaries of the HTTP calls made with a context from
+// WithCallRecorder. It is safe for concurrent use.
+type CallRecorder struct {
+	mu    sync.Mutex
+	calls []CallSummary
+}
+
+type callRecorderKey struct{}
+
+// WithCallRecorder returns a context whose HTTP calls through a
+// CallRecordingTransport are recorded on the returned recorder
+func WithCallRecorder(ctx context.Context) (context.Context, *CallRecorder) {
+	r := &CallRecorder{}
+	return context.WithValue(ctx, callRecorderKey{}, r), r
+}
+
+// CallRecorderFrom returns the context's recorder, or nil if it has none
+func CallRecorderFrom(ctx context.Context) *CallRecorder {
+	r, _ := ctx.Value(callRecorderKey{}).(*CallRecorder)
+	return r
+}
+
+func (r *CallRecorder) add(call CallSummary) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, call)
+	if len(r.calls) > MaxRecordedCalls {
+		r.calls = r.calls[len(r.calls)-MaxRecordedCalls:]
+	}
+}
+
+// Calls returns the recorded calls, oldest first
+func (r *CallRecorder) Calls() []CallSummary {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]CallSummary(nil), r.calls...)
+}
+
+// CallRecordingTransport wraps a RoundTripper and records a CallSummary of
+// every request whose context carries a CallRecorder. Requests without one
+// pass straight through.
+type CallRecordingTransport struct {
+	Base http.RoundTripper
+}
+
+func (t *CallRecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	recorder := CallRecorderFrom(req.Context())
+	if recorder == nil {
+		return base.RoundTrip(req)
+	}
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	call := CallSummary{
+		Method:    req.Method,
+		URL:       sanitizeURL(req),
+		Duration:  time.Since(start),
+		StartedAt: start,
+	}
+	if err != nil {
+		call.Error = truncate(redactSecrets(err.Error()), MaxCallErrorSize)
+	} else {
+		call.StatusCode = resp.StatusCode
+		if resp.StatusCode >= 400 {
+			bodyBytes, readErr := io.ReadAll(io.LimitReader(resp.Body, MaxErrorBodySize+1))
+			rest := resp.Body
+			if readErr == nil {
+				call.Error = truncate(redactSecrets(string(bodyBytes)), MaxCallErrorSize)
+			}
+			// Re-wrap body so the caller can still read all of it
+			resp.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(bodyBytes), rest), rest}
+		}
+	}
+	recorder.add(call)
+	return resp, err
+}
+
+func sanitizeURL(req *http.Request) string {
+	u := *req.URL
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
+
+// secretPatterns match JSON fields, form values and auth headers that carry
+// credentials, capturing what comes before the value
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)("?(?:access_token|refresh_token|id_token|api_key|apikey|client_secret|password|secret|token)"?\s*[:=]\s*"?)[^"&,\s}]+`),
+	regexp.MustCompile(`(?i)((?:bearer|basic)\s+)[A-Za-z0-9._~+/=-]+`),
+}
+
+// redactSecrets blanks out credential values an API echoes back in an error
+func redactSecrets(s string) string {
+	for _, p := range secretPatterns {
+		s = p.ReplaceAllString(s, "${1}[redacted]")
+	}
+	return s
+}
@@ -0,0 +1,99 @@
+package httputil
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCallRecordingTransport(t *testing.T) {
+	body := `{"message":"Bad Request","errors":[{"field":"file","code":"empty"}],"access_token":"abc123"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ok" {
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, body)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &CallRecordingTransport{}}
+
+	// Without a recorder nothing is recorded
+	resp, err := client.Get(server.URL + "/ok")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	ctx, recorder := WithCallRecorder(context.Background())
+	for _, path := range []string{"/ok", "/uploads?api_key=secret"} {
+		req, _ := http.NewRequestWithContext(ctx, "POST", server.URL+path, strings.NewReader("payload"))
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusBadRequest && string(got) != body {
+			t.Errorf("Expected the caller to still read the full body, got %q", got)
+		}
+	}
+
+	calls := recorder.Calls()
+	if len(calls) != 2 {
+		t.Fatalf("Expected 2 recorded calls, got %d", len(calls))
+	}
+	if calls[0].StatusCode != 201 || calls[0].Error != "" {
+		t.Errorf("Expected a clean 201, got %+v", calls[0])
+	}
+	failed := calls[1]
+	if failed.Method != "POST" || failed.URL != server.URL+"/uploads" || failed.StatusCode != 400 {
+		t.Errorf("Unexpected call summary: %+v", failed)
+	}
+	if !strings.Contains(failed.Error, `"code":"empty"`) || strings.Contains(failed.Error, "abc123") {
+		t.Errorf("Expected a redacted body excerpt, got %q", failed.Error)
+	}
+}
+
+func TestCallRecorder_KeepsLatest(t *testing.T) {
+	transport := &CallRecordingTransport{Base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("dial tcp: connection refused")
+	})}
+	ctx, recorder := WithCallRecorder(context.Background())
+	for i := 0; i < MaxRecordedCalls+5; i++ {
+		req, _ := http.NewRequestWithContext(ctx, "GET", "https://api.example.com/status", nil)
+		transport.RoundTrip(req)
+	}
+
+	calls := recorder.Calls()
+	if len(calls) != MaxRecordedCalls {
+		t.Fatalf("Expected %d calls, got %d", MaxRecordedCalls, len(calls))
+	}
+	if calls[0].StatusCode != 0 || calls[0].Error != "dial tcp: connection refused" {
+		t.Errorf("Expected the transport error to be recorded, got %+v", calls[0])
+	}
+}
+
+func TestRedactSecrets(t *testing.T) {
+	tests := map[string]string{
+		`{"refresh_token": "r-1", "ok": true}`:   `{"refresh_token": "[redacted]", "ok": true}`,
+		`invalid api_key=k-1&user=2`:             `invalid api_key=[redacted]&user=2`,
+		`header was Authorization: Bearer abc.d`: `header was Authorization: Bearer [redacted]`,
+		`Invalid token supplied`:                 `Invalid token supplied`,
+	}
+	for in, want := range tests {
+		if got := redactSecrets(in); got != want {
+			t.Errorf("redactSecrets(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
@@ -61,15 +61,20 @@ func ParseErrorResponse(resp *http.Response) error {
 	}
 }
 
-// WrapResponseError reads the response body and returns a formatted error.
-// Unlike ParseErrorResponse, this does not re-wrap the body (for simple error cases).
+// WrapResponseError reads the response body and returns an HTTPError whose
+// message is prefixed with the caller's context. Unlike ParseErrorResponse,
+// this does not re-wrap the body (for simple error cases).
 func WrapResponseError(resp *http.Response, message string) error {
 	bodyBytes, _ := io.ReadAll(resp.Body)
 	resp.Body.Close()
 
-	bodyStr := truncate(string(bodyBytes), MaxErrorBodySize)
-	if bodyStr != "" {
-		return fmt.Errorf("%s (status %d): %s", message, resp.StatusCode, bodyStr)
+	httpErr := &HTTPError{
+		StatusCode: resp.StatusCode,
+		Status:     message,
+		Body:       truncate(string(bodyBytes), MaxErrorBodySize),
+	}
+	if resp.Request != nil {
+		httpErr.URL = resp.Request.URL.String()
 	}
-	return fmt.Errorf("%s (status %d)", message, resp.StatusCode)
+	return httpErr
 }
@@ -1,6 +1,8 @@
 package httputil
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -87,6 +89,23 @@ func TestWrapResponseError(t *testing.T) {
 	}
 }
 
+func TestWrapResponseError_ExposesStatusCode(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: 503,
+		Body:       io.NopCloser(strings.NewReader("upstream unavailable")),
+	}
+
+	err := fmt.Errorf("uploading: %w", WrapResponseError(resp, "Strava upload failed"))
+
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) {
+		t.Fatalf("Expected wrapped *HTTPError, got %T", err)
+	}
+	if httpErr.StatusCode != 503 {
+		t.Errorf("Expected status 503, got %d", httpErr.StatusCode)
+	}
+}
+
 func TestTruncate(t *testing.T) {
 	short := "hello"
 	if truncate(short, 10) != "hello" {
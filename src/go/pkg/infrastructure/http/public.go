@@ -0,0 +1,70 @@
+package httputil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrNonPublicAddress is returned when a request to a user-supplied URL would
+// connect to a loopback, private, link-local or otherwise internal address.
+var ErrNonPublicAddress = errors.New("address is not publicly routable")
+
+// sharedAddressSpace is carrier-grade NAT (RFC 6598), which netip doesn't
+// count as private but is never a user's public server.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPublicAddr reports whether addr is a publicly routable unicast address.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		addr.IsGlobalUnicast() &&
+		!addr.IsPrivate() &&
+		!addr.IsLoopback() &&
+		!addr.IsLinkLocalUnicast() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+// NewPublicOnlyClient returns a client for URLs users configure, such as a
+// self-hosted server. It only speaks HTTPS, including on redirects, and
+// refuses to connect to anything but public addresses, so a user can't point
+// it at the metadata server or other internal services. The check runs on
+// the address each connection actually dials, after DNS resolution, so
+// redirects and DNS rebinding can't get around it.
+func NewPublicOnlyClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			ap, err := netip.ParseAddrPort(address)
+			if err != nil || !IsPublicAddr(ap.Addr()) {
+				return fmt.Errorf("%w: %s", ErrNonPublicAddress, address)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil // A proxy would dial on our behalf, unchecked
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return dialer.DialContext(ctx, network, addr)
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &CallRecordingTransport{Base: transport},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if req.URL.Scheme != "https" {
+				return fmt.Errorf("refusing redirect to non-HTTPS URL %s", req.URL.Redacted())
+			}
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			return nil
+		},
+	}
+}
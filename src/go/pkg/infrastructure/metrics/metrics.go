@@ -0,0 +1,154 @@
+// Package metrics records FitGlue's operational metrics (enricher
+// executions, destination uploads and tier-blocked syncs) as OpenTelemetry
+// counters and histograms exported to Cloud Monitoring, so dashboards and
+// alerts no longer scrape logs.
+//
+// Until Init or Use is called every instrument is a no-op, which is what
+// tests and local development get.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	mexporter "github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/metric"
+	"go.opentelemetry.io/contrib/detectors/gcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// meterName scopes every FitGlue instrument. Exported metrics appear in
+// Cloud Monitoring as workload.googleapis.com/<instrument name>.
+const meterName = "github.com/fitglue/server"
+
+// exportInterval is how often metrics are pushed. Cloud Monitoring accepts
+// at most one point per series every 5 seconds; a minute keeps writes cheap.
+const exportInterval = time.Minute
+
+// Upload outcomes recorded by RecordUpload.
+const (
+	UploadSuccess  = "success"
+	UploadFailed   = "failed"
+	UploadDeferred = "deferred" // Held for a rate limit budget
+	UploadQueued   = "queued"   // Queued until the platform recovers
+)
+
+type instruments struct {
+	enricherExecutions metric.Int64Counter
+	enricherDuration   metric.Float64Histogram
+	uploads            metric.Int64Counter
+	uploadLatency      metric.Float64Histogram
+	tierBlocked        metric.Int64Counter
+}
+
+var current atomic.Pointer[instruments]
+
+func init() {
+	if err := Use(noop.NewMeterProvider()); err != nil {
+		panic(err)
+	}
+}
+
+// Init exports metrics for service to Cloud Monitoring in the project named
+// by PROJECT_ID. It returns a shutdown func that flushes pending points; call
+// it before the process exits. Without a project, metrics stay no-ops.
+func Init(ctx context.Context, service string) (func(context.Context) error, error) {
+	projectID := os.Getenv("PROJECT_ID")
+	if projectID == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := mexporter.New(mexporter.WithProjectID(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("metrics: failed to create exporter: %w", err)
+	}
+	// The detector identifies the Cloud Run instance, so each instance
+	// writes its own series instead of colliding with the others
+	res, err := resource.New(ctx,
+		resource.WithDetectors(gcp.NewDetector()),
+		resource.WithAttributes(semconv.ServiceName(service)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("metrics: failed to detect resource: %w", err)
+	}
+
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter, sdkmetric.WithInterval(exportInterval))),
+		sdkmetric.WithResource(res),
+	)
+	if err := Use(provider); err != nil {
+		return nil, err
+	}
+	return provider.Shutdown, nil
+}
+
+// Use records metrics through provider. Tests use it with a manual reader.
+func Use(provider metric.MeterProvider) error {
+	meter := provider.Meter(meterName)
+	var inst instruments
+	var err error
+
+	if inst.enricherExecutions, err = meter.Int64Counter("fitglue.enricher.executions",
+		metric.WithDescription("Enricher provider executions by provider and status")); err != nil {
+		return err
+	}
+	if inst.enricherDuration, err = meter.Float64Histogram("fitglue.enricher.duration",
+		metric.WithDescription("Enricher provider execution time"),
+		metric.WithUnit("ms")); err != nil {
+		return err
+	}
+	if inst.uploads, err = meter.Int64Counter("fitglue.destination.uploads",
+		metric.WithDescription("Destination upload attempts by destination, operation and outcome")); err != nil {
+		return err
+	}
+	if inst.uploadLatency, err = meter.Float64Histogram("fitglue.destination.upload_latency",
+		metric.WithDescription("Destination upload time, including the platform's API calls"),
+		metric.WithUnit("ms")); err != nil {
+		return err
+	}
+	if inst.tierBlocked, err = meter.Int64Counter("fitglue.pipeline.tier_blocked",
+		metric.WithDescription("Syncs blocked by the user's tier limits")); err != nil {
+		return err
+	}
+
+	current.Store(&inst)
+	return nil
+}
+
+// RecordEnricherExecution counts one provider execution in a pipeline run.
+// status is the execution's status, such as SUCCESS, FAILED or SKIPPED.
+func RecordEnricherExecution(ctx context.Context, provider, status string, duration time.Duration) {
+	inst := current.Load()
+	attrs := metric.WithAttributes(
+		attribute.String("provider", provider),
+		attribute.String("status", strings.ToLower(status)),
+	)
+	inst.enricherExecutions.Add(ctx, 1, attrs)
+	inst.enricherDuration.Record(ctx, float64(duration.Milliseconds()), attrs)
+}
+
+// RecordUpload counts one destination upload. operation is "create" or
+// "update" and outcome one of the Upload constants.
+func RecordUpload(ctx context.Context, destination, operation, outcome string, duration time.Duration) {
+	inst := current.Load()
+	attrs := metric.WithAttributes(
+		attribute.String("destination", destination),
+		attribute.String("operation", operation),
+		attribute.String("outcome", outcome),
+	)
+	inst.uploads.Add(ctx, 1, attrs)
+	inst.uploadLatency.Record(ctx, float64(duration.Milliseconds()), attrs)
+}
+
+// RecordTierBlocked counts a sync refused by the user's tier limits.
+func RecordTierBlocked(ctx context.Context, tier string) {
+	current.Load().tierBlocked.Add(ctx, 1, metric.WithAttributes(attribute.String("tier", tier)))
+}
@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func collect(t *testing.T, reader sdkmetric.Reader) map[string]metricdata.Aggregation {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	out := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			out[m.Name] = m.Data
+		}
+	}
+	return out
+}
+
+func TestRecord(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	require.NoError(t, Use(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))))
+	t.Cleanup(func() { _ = Use(noop.NewMeterProvider()) })
+
+	ctx := context.Background()
+	RecordEnricherExecution(ctx, "weather", "SUCCESS", 120*time.Millisecond)
+	RecordEnricherExecution(ctx, "weather", "SUCCESS", 80*time.Millisecond)
+	RecordEnricherExecution(ctx, "weather", "FAILED", time.Second)
+	RecordUpload(ctx, "strava", "create", UploadSuccess, 2*time.Second)
+	RecordUpload(ctx, "strava", "update", UploadDeferred, 0)
+	RecordTierBlocked(ctx, "hobbyist")
+
+	data := collect(t, reader)
+
+	executions := data["fitglue.enricher.executions"].(metricdata.Sum[int64])
+	counts := map[string]int64{}
+	for _, dp := range executions.DataPoints {
+		status, _ := dp.Attributes.Value(attribute.Key("status"))
+		counts[status.AsString()] = dp.Value
+	}
+	assert.Equal(t, map[string]int64{"success": 2, "failed": 1}, counts)
+
+	duration := data["fitglue.enricher.duration"].(metricdata.Histogram[float64])
+	for _, dp := range duration.DataPoints {
+		status, _ := dp.Attributes.Value(attribute.Key("status"))
+		if status.AsString() == "success" {
+			assert.Equal(t, uint64(2), dp.Count)
+			assert.Equal(t, 200.0, dp.Sum)
+		}
+	}
+
+	uploads := data["fitglue.destination.uploads"].(metricdata.Sum[int64])
+	assert.Len(t, uploads.DataPoints, 2)
+	latency := data["fitglue.destination.upload_latency"].(metricdata.Histogram[float64])
+	assert.Len(t, latency.DataPoints, 2)
+
+	blocked := data["fitglue.pipeline.tier_blocked"].(metricdata.Sum[int64])
+	require.Len(t, blocked.DataPoints, 1)
+	assert.Equal(t, int64(1), blocked.DataPoints[0].Value)
+}
+
+func TestInitWithoutProject(t *testing.T) {
+	t.Setenv("PROJECT_ID", "")
+	shutdown, err := Init(context.Background(), "test")
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+
+	// Still no-ops
+	RecordTierBlocked(context.Background(), "hobbyist")
+}
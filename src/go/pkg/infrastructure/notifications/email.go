@@ -0,0 +1,19 @@
+package notifications
+
+import (
+	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/email"
+)
+
+// EmailAdapter adds email delivery to a push NotificationService.
+type EmailAdapter struct {
+	shared.NotificationService
+	email.Sender
+}
+
+// WithEmail returns push extended with email sent through sender.
+func WithEmail(push shared.NotificationService, sender email.Sender) *EmailAdapter {
+	return &EmailAdapter{NotificationService: push, Sender: sender}
+}
+
+var _ shared.EmailNotificationService = (*EmailAdapter)(nil)
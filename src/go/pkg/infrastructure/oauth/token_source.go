@@ -21,6 +21,14 @@ var nonRefreshableProviders = map[string]bool{
 	"github": true,
 }
 
+// basicAuthProviders authenticate the refresh request with client credentials
+// in a Basic Auth header rather than in the form body.
+var basicAuthProviders = map[string]bool{
+	"fitbit":  true,
+	"spotify": true,
+	"komoot":  true,
+}
+
 // Token represents the OAuth token structure we care about
 type Token struct {
 	AccessToken  string
@@ -103,6 +111,26 @@ func (s *FirestoreTokenSource) ForceRefresh(ctx context.Context) (*Token, error)
 			return nil, fmt.Errorf("spotify not linked/enabled")
 		}
 		refreshToken = userData.Integrations.Spotify.RefreshToken
+	case "komoot":
+		if userData.Integrations.Komoot == nil || !userData.Integrations.Komoot.Enabled {
+			return nil, fmt.Errorf("komoot not linked/enabled")
+		}
+		refreshToken = userData.Integrations.Komoot.RefreshToken
+	case "dropbox":
+		if userData.Integrations.Dropbox == nil || !userData.Integrations.Dropbox.Enabled {
+			return nil, fmt.Errorf("dropbox not linked/enabled")
+		}
+		refreshToken = userData.Integrations.Dropbox.RefreshToken
+	case "whoop":
+		if userData.Integrations.Whoop == nil || !userData.Integrations.Whoop.Enabled {
+			return nil, fmt.Errorf("whoop not linked/enabled")
+		}
+		refreshToken = userData.Integrations.Whoop.RefreshToken
+	case "oura":
+		if userData.Integrations.Oura == nil || !userData.Integrations.Oura.Enabled {
+			return nil, fmt.Errorf("oura not linked/enabled")
+		}
+		refreshToken = userData.Integrations.Oura.RefreshToken
 	default:
 		return nil, fmt.Errorf("unknown provider %s", s.provider)
 	}
@@ -196,6 +224,42 @@ func (s *FirestoreTokenSource) Token(ctx context.Context) (*Token, error) {
 		if userData.Integrations.Spotify.ExpiresAt != nil {
 			expiry = userData.Integrations.Spotify.ExpiresAt.AsTime()
 		}
+	case "komoot":
+		if userData.Integrations.Komoot == nil || !userData.Integrations.Komoot.Enabled {
+			return nil, fmt.Errorf("komoot not linked/enabled")
+		}
+		accessToken = userData.Integrations.Komoot.AccessToken
+		refreshToken = userData.Integrations.Komoot.RefreshToken
+		if userData.Integrations.Komoot.ExpiresAt != nil {
+			expiry = userData.Integrations.Komoot.ExpiresAt.AsTime()
+		}
+	case "dropbox":
+		if userData.Integrations.Dropbox == nil || !userData.Integrations.Dropbox.Enabled {
+			return nil, fmt.Errorf("dropbox not linked/enabled")
+		}
+		accessToken = userData.Integrations.Dropbox.AccessToken
+		refreshToken = userData.Integrations.Dropbox.RefreshToken
+		if userData.Integrations.Dropbox.ExpiresAt != nil {
+			expiry = userData.Integrations.Dropbox.ExpiresAt.AsTime()
+		}
+	case "whoop":
+		if userData.Integrations.Whoop == nil || !userData.Integrations.Whoop.Enabled {
+			return nil, fmt.Errorf("whoop not linked/enabled")
+		}
+		accessToken = userData.Integrations.Whoop.AccessToken
+		refreshToken = userData.Integrations.Whoop.RefreshToken
+		if userData.Integrations.Whoop.ExpiresAt != nil {
+			expiry = userData.Integrations.Whoop.ExpiresAt.AsTime()
+		}
+	case "oura":
+		if userData.Integrations.Oura == nil || !userData.Integrations.Oura.Enabled {
+			return nil, fmt.Errorf("oura not linked/enabled")
+		}
+		accessToken = userData.Integrations.Oura.AccessToken
+		refreshToken = userData.Integrations.Oura.RefreshToken
+		if userData.Integrations.Oura.ExpiresAt != nil {
+			expiry = userData.Integrations.Oura.ExpiresAt.AsTime()
+		}
 	default:
 		return nil, fmt.Errorf("unknown provider %s", s.provider)
 	}
@@ -258,13 +322,21 @@ func (s *FirestoreTokenSource) refreshToken(ctx context.Context, refreshToken st
 		tokenURL = "https://github.com/login/oauth/access_token"
 	case "spotify":
 		tokenURL = "https://accounts.spotify.com/api/token"
+	case "komoot":
+		tokenURL = "https://auth-api.main.komoot.net/oauth/token"
+	case "dropbox":
+		tokenURL = "https://api.dropboxapi.com/oauth2/token"
+	case "whoop":
+		tokenURL = "https://api.prod.whoop.com/oauth/oauth2/token"
+	case "oura":
+		tokenURL = "https://api.ouraring.com/oauth/token"
 	default:
 		return nil, fmt.Errorf("unsupported provider for refresh: %s", s.provider)
 	}
 
 	data := url.Values{}
-	// Strava requires client_id/secret in body. Fitbit and Spotify use Basic Auth header (see below).
-	if s.provider != "fitbit" && s.provider != "spotify" {
+	// Strava requires client_id/secret in body. Fitbit, Spotify and Komoot use Basic Auth header (see below).
+	if !basicAuthProviders[s.provider] {
 		data.Set("client_id", clientID)
 		data.Set("client_secret", clientSecret)
 	}
@@ -277,7 +349,7 @@ func (s *FirestoreTokenSource) refreshToken(ctx context.Context, refreshToken st
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	if s.provider == "fitbit" || s.provider == "spotify" {
+	if basicAuthProviders[s.provider] {
 		req.SetBasicAuth(clientID, clientSecret)
 	}
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -12,6 +13,9 @@ import (
 
 	"github.com/fitglue/server/src/go/internal/infra"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/cost"
+	httputil "github.com/fitglue/server/src/go/pkg/infrastructure/http"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/ratelimit"
 )
 
 // Transport is an http.RoundTripper that authenticates all requests
@@ -125,6 +129,7 @@ func (t *UsageTrackingTransport) RoundTrip(req *http.Request) (*http.Response, e
 	}
 
 	resp, err := base.RoundTrip(req)
+	cost.Record(req.Context(), cost.Usage{ExternalAPICalls: 1})
 
 	// If request was successful (at transport level), update usage stats asynchronously
 	if err == nil {
@@ -201,27 +206,95 @@ func (t *ErrorLoggingTransport) RoundTrip(req *http.Request) (*http.Response, er
 	return resp, nil
 }
 
+// RateLimitTransport wraps a RoundTripper and spends each request from the
+// provider's shared rate limit budget. Requests that would exceed it wait for
+// the budget to reset, or fail with a *ratelimit.Error that callers turn into
+// a retry. A 429 response is returned as a *ratelimit.Error too.
+type RateLimitTransport struct {
+	Base     http.RoundTripper
+	Provider string
+	// Limiter defaults to ratelimit.Default().
+	Limiter *ratelimit.Limiter
+	Logger  *slog.Logger
+}
+
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	limiter := t.Limiter
+	if limiter == nil {
+		limiter = ratelimit.Default()
+	}
+	if limiter == nil || !ratelimit.Tracks(t.Provider) {
+		return base.RoundTrip(req)
+	}
+
+	logger := t.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	ctx := req.Context()
+
+	// A budget we can't read shouldn't stop the request
+	var limitErr *ratelimit.Error
+	if err := limiter.Wait(ctx, t.Provider); err != nil {
+		if errors.As(err, &limitErr) || ctx.Err() != nil {
+			return nil, err
+		}
+		logger.Warn("Rate limit budget unavailable, sending request anyway", "error", err)
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := limiter.Observe(ctx, t.Provider, resp); err != nil {
+		if errors.As(err, &limitErr) {
+			resp.Body.Close()
+			logger.Warn("Provider rate limit reached", "url", req.URL.String(), "retry_after", limitErr.RetryAfter)
+			return nil, err
+		}
+		logger.Warn("Failed to record rate limit headers", "error", err)
+	}
+	return resp, nil
+}
+
 // NewClientWithErrorLogging creates an HTTP client with automatic error response logging.
 // Use this for non-OAuth clients (like Hevy API key auth) that still need error body capture.
+// Calls are also summarized on any httputil.CallRecorder in the request context, and
+// rate-limited providers spend from their shared budget (see RateLimitTransport).
 func NewClientWithErrorLogging(logger *slog.Logger, provider string, timeout time.Duration) *http.Client {
 	if timeout == 0 {
 		timeout = 30 * time.Second
 	}
 
+	clientLogger := logger.With("component", "http-client", "provider", provider)
 	return &http.Client{
 		Timeout: timeout,
-		Transport: &ErrorLoggingTransport{
-			Logger: logger.With("component", "http-client", "provider", provider),
+		Transport: &httputil.CallRecordingTransport{
+			Base: &ErrorLoggingTransport{
+				Base:   &RateLimitTransport{Provider: provider, Logger: clientLogger},
+				Logger: clientLogger,
+			},
 		},
 	}
 }
 
 // NewClientWithUsageTracking creates an HTTP client that automatically handles OAuth,
 // tracks usage stats in Firestore, and logs HTTP error responses with their bodies.
+// Calls are also summarized on any httputil.CallRecorder in the request context, and
+// rate-limited providers spend from their shared budget (see RateLimitTransport).
 func NewClientWithUsageTracking(source TokenSource, service *bootstrap.Service, userID, provider string, logger infra.Logger) *http.Client {
 	oauthLogger := logger.With("component", "oauth")
-	// Stack: Client → ErrorLogging → UsageTracking → OAuth → Network
-	oauthTransport := &Transport{Source: source, Logger: oauthLogger}
+	// Stack: Client → CallRecording → ErrorLogging → UsageTracking → OAuth → RateLimit → Network
+	rateLimitTransport := &RateLimitTransport{
+		Provider: provider,
+		Logger:   slog.Default().With("component", "http-client", "provider", provider, "user_id", userID),
+	}
+	oauthTransport := &Transport{Source: source, Base: rateLimitTransport, Logger: oauthLogger}
 
 	usageTransport := &UsageTrackingTransport{
 		Base:     oauthTransport,
@@ -237,7 +310,7 @@ func NewClientWithUsageTracking(source TokenSource, service *bootstrap.Service,
 	}
 
 	return &http.Client{
-		Transport: errorLoggingTransport,
+		Transport: &httputil.CallRecordingTransport{Base: errorLoggingTransport},
 	}
 }
 
@@ -255,7 +328,12 @@ func NewClientWithUsageTrackingHTTP1(source TokenSource, service *bootstrap.Serv
 		TLSNextProto: make(map[string]func(authority string, c *tls.Conn) http.RoundTripper),
 	}
 
-	oauthTransport := &Transport{Source: source, Base: http1Transport, Logger: oauthLogger}
+	rateLimitTransport := &RateLimitTransport{
+		Base:     http1Transport,
+		Provider: provider,
+		Logger:   slog.Default().With("component", "http-client", "provider", provider, "user_id", userID),
+	}
+	oauthTransport := &Transport{Source: source, Base: rateLimitTransport, Logger: oauthLogger}
 
 	usageTransport := &UsageTrackingTransport{
 		Base:     oauthTransport,
@@ -271,6 +349,6 @@ func NewClientWithUsageTrackingHTTP1(source TokenSource, service *bootstrap.Serv
 	}
 
 	return &http.Client{
-		Transport: errorLoggingTransport,
+		Transport: &httputil.CallRecordingTransport{Base: errorLoggingTransport},
 	}
 }
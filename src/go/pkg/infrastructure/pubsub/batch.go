@@ -0,0 +1,48 @@
+package pubsub
+
+import (
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+)
+
+const (
+	// MaxBatchActivities caps the payloads in one activity batch, so the
+	// enricher works through a batch well within its request timeout.
+	MaxBatchActivities = 20
+
+	// maxBatchBytes keeps a batch under Pub/Sub's 10 MB message limit.
+	maxBatchBytes = 8 << 20
+)
+
+// BatchActivityPayloads packs per-pipeline payloads, in order, into as few
+// activity batches as the limits allow. A payload too big to share a batch
+// goes in one of its own.
+func BatchActivityPayloads(payloads []*pbevents.ActivityPayload) ([]*pbevents.ActivityPayloadBatch, error) {
+	var batches []*pbevents.ActivityPayloadBatch
+	var batch *pbevents.ActivityPayloadBatch
+	batchBytes := 0
+
+	for _, payload := range payloads {
+		data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		size := len(data)
+		if batch == nil || len(batch.Payloads) >= MaxBatchActivities || batchBytes+size > maxBatchBytes {
+			batch = &pbevents.ActivityPayloadBatch{}
+			batches = append(batches, batch)
+			batchBytes = 0
+		}
+		batch.Payloads = append(batch.Payloads, payload)
+		batchBytes += size
+	}
+	return batches, nil
+}
+
+// NewActivityBatchEvent wraps an activity batch in a CloudEvent for the
+// pipeline-activity topic.
+func NewActivityBatchEvent(source string, batch *pbevents.ActivityPayloadBatch) (cloudevents.Event, error) {
+	return NewCloudEvent(source, GetCloudEventType(pbevents.CloudEventType_CLOUD_EVENT_TYPE_ACTIVITY_BATCH), batch)
+}
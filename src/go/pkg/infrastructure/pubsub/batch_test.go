@@ -0,0 +1,46 @@
+package pubsub
+
+import (
+	"strings"
+	"testing"
+
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+)
+
+func TestBatchActivityPayloads(t *testing.T) {
+	payloads := func(n int, notes string) []*pbevents.ActivityPayload {
+		out := make([]*pbevents.ActivityPayload, n)
+		for i := range out {
+			out[i] = &pbevents.ActivityPayload{UserId: "user-1", OriginalPayloadJson: notes}
+		}
+		return out
+	}
+
+	t.Run("Splits by count", func(t *testing.T) {
+		batches, err := BatchActivityPayloads(payloads(MaxBatchActivities*2+1, ""))
+		if err != nil {
+			t.Fatalf("BatchActivityPayloads: %v", err)
+		}
+		if len(batches) != 3 || len(batches[0].Payloads) != MaxBatchActivities || len(batches[2].Payloads) != 1 {
+			t.Errorf("Expected batches of %d, %d and 1", MaxBatchActivities, MaxBatchActivities)
+		}
+	})
+
+	t.Run("Splits by size", func(t *testing.T) {
+		big := strings.Repeat("x", maxBatchBytes/2)
+		batches, err := BatchActivityPayloads(payloads(3, big))
+		if err != nil {
+			t.Fatalf("BatchActivityPayloads: %v", err)
+		}
+		if len(batches) != 3 {
+			t.Errorf("Expected each oversized payload in its own batch, got %d batches", len(batches))
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		batches, err := BatchActivityPayloads(nil)
+		if err != nil || len(batches) != 0 {
+			t.Errorf("Expected no batches, got %d, %v", len(batches), err)
+		}
+	})
+}
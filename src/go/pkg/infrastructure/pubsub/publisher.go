@@ -34,6 +34,12 @@ func (a *PubSubAdapter) PublishCloudEvent(ctx context.Context, topicID string, e
 	return a.publish(ctx, topicID, bytes)
 }
 
+// PublishMessage publishes data and attributes as they are, e.g. to replay a
+// message that was already a serialized CloudEvent.
+func (a *PubSubAdapter) PublishMessage(ctx context.Context, topicID string, data []byte, attributes map[string]string) (string, error) {
+	return a.publishWithAttrs(ctx, topicID, data, attributes)
+}
+
 func (a *PubSubAdapter) publish(ctx context.Context, topicID string, data []byte) (string, error) {
 	return a.publishWithAttrs(ctx, topicID, data, nil)
 }
@@ -0,0 +1,60 @@
+// nolint:proto-json
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+// CollectionProviderRateBudgets holds one budget document per provider.
+const CollectionProviderRateBudgets = "provider_rate_budgets"
+
+type FirestoreStore struct {
+	client *firestore.Client
+}
+
+func NewFirestoreStore(client *firestore.Client) *FirestoreStore {
+	return &FirestoreStore{client: client}
+}
+
+func (s *FirestoreStore) Update(ctx context.Context, provider string, fn func(b *pipeline.ProviderRateBudget) bool) error {
+	ref := s.client.Collection(CollectionProviderRateBudgets).Doc(provider)
+
+	return s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		b := &pipeline.ProviderRateBudget{Provider: provider}
+		doc, err := tx.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			raw, err := json.Marshal(doc.Data())
+			if err != nil {
+				return err
+			}
+			if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(raw, b); err != nil {
+				return err
+			}
+		}
+
+		if !fn(b) {
+			return nil
+		}
+
+		raw, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(b)
+		if err != nil {
+			return err
+		}
+		var data map[string]interface{}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return err
+		}
+		return tx.Set(ref, data)
+	})
+}
@@ -0,0 +1,98 @@
+package ratelimit
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+// parseWindows reads a response's rate limit windows. Two conventions are
+// understood:
+//
+//   - Strava: X-RateLimit-Limit and X-RateLimit-Usage list the 15-minute and
+//     daily limits as "200,2000" and "10,300". The windows reset on the
+//     quarter hour and at midnight UTC.
+//   - Common (Hevy): X-RateLimit-Limit, X-RateLimit-Remaining and
+//     X-RateLimit-Reset, where the reset is seconds from now or a Unix time.
+//
+// Returns nil if the response has neither.
+func parseWindows(h http.Header, now time.Time) []*pipeline.RateLimitWindow {
+	limits := parseInts(h.Get("X-RateLimit-Limit"))
+	if len(limits) == 0 {
+		return nil
+	}
+
+	if usage := parseInts(h.Get("X-RateLimit-Usage")); len(usage) > 0 {
+		resets := []time.Time{
+			now.UTC().Truncate(15 * time.Minute).Add(15 * time.Minute),
+			time.Date(now.UTC().Year(), now.UTC().Month(), now.UTC().Day()+1, 0, 0, 0, 0, time.UTC),
+		}
+		var windows []*pipeline.RateLimitWindow
+		for i := 0; i < len(limits) && i < len(usage) && i < len(resets); i++ {
+			windows = append(windows, &pipeline.RateLimitWindow{
+				Limit:     int32(limits[i]),
+				Remaining: int32(limits[i] - usage[i]),
+				ResetAt:   timestamppb.New(resets[i]),
+			})
+		}
+		return windows
+	}
+
+	remaining := parseInts(h.Get("X-RateLimit-Remaining"))
+	if len(remaining) == 0 {
+		return nil
+	}
+	reset := now.Add(time.Minute)
+	if v, err := strconv.ParseInt(strings.TrimSpace(h.Get("X-RateLimit-Reset")), 10, 64); err == nil && v > 0 {
+		// Large values are Unix times, small ones are seconds from now
+		if v > 1_000_000_000 {
+			reset = time.Unix(v, 0)
+		} else {
+			reset = now.Add(time.Duration(v) * time.Second)
+		}
+	}
+	return []*pipeline.RateLimitWindow{{
+		Limit:     int32(limits[0]),
+		Remaining: int32(remaining[0]),
+		ResetAt:   timestamppb.New(reset),
+	}}
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP
+// date. Returns 0 if it is missing or unparseable.
+func parseRetryAfter(h http.Header, now time.Time) time.Duration {
+	v := strings.TrimSpace(h.Get("Retry-After"))
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// parseInts parses a comma-separated list of integers, returning nil if any
+// entry isn't one.
+func parseInts(v string) []int {
+	if strings.TrimSpace(v) == "" {
+		return nil
+	}
+	parts := strings.Split(v, ",")
+	out := make([]int, 0, len(parts))
+	for _, p := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil {
+			return nil
+		}
+		out = append(out, n)
+	}
+	return out
+}
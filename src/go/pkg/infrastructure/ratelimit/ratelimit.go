@@ -0,0 +1,222 @@
+// Package ratelimit keeps shared request budgets for external APIs with
+// strict rate limits (Strava, Hevy), so a burst of activities waits or is
+// retried later instead of exhausting the limit and failing.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+const (
+	// DefaultMaxWait is how long a request may be held for a budget to reset
+	// before it is refused with an *Error instead.
+	DefaultMaxWait = 30 * time.Second
+
+	// reserveDivisor keeps 1/20th of each window spare for callers that
+	// don't go through a Limiter, such as backfills.
+	reserveDivisor = 20
+
+	// defaultRetryAfter is used for a 429 with no Retry-After or usable
+	// headers; Strava's short window is 15 minutes.
+	defaultRetryAfter = 15 * time.Minute
+)
+
+// tracked lists the providers whose responses carry rate limit headers.
+// Requests to other providers pass through untouched.
+var tracked = map[string]bool{
+	"strava": true,
+	"hevy":   true,
+}
+
+// Tracks reports whether provider's requests are budgeted.
+func Tracks(provider string) bool {
+	return tracked[provider]
+}
+
+// Error is returned when a provider's budget is exhausted. Callers should
+// retry the work after RetryAfter rather than fail it.
+type Error struct {
+	Provider   string
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s rate limit reached, retry after %v", e.Provider, e.RetryAfter.Round(time.Second))
+}
+
+// Store persists the shared budgets.
+type Store interface {
+	// Update applies fn to the provider's budget inside a transaction and
+	// stores it if fn returns true. fn receives an empty budget for new
+	// providers.
+	Update(ctx context.Context, provider string, fn func(b *pipeline.ProviderRateBudget) bool) error
+}
+
+// Limiter spends and refreshes the shared budgets. A nil *Limiter allows
+// every request.
+type Limiter struct {
+	store   Store
+	now     func() time.Time
+	sleep   func(ctx context.Context, d time.Duration) error
+	maxWait time.Duration
+}
+
+// NewLimiter creates a limiter backed by store.
+func NewLimiter(store Store) *Limiter {
+	return &Limiter{
+		store:   store,
+		now:     time.Now,
+		sleep:   sleepContext,
+		maxWait: DefaultMaxWait,
+	}
+}
+
+var defaultLimiter atomic.Pointer[Limiter]
+
+// SetDefault sets the limiter used by HTTP clients built without one. With
+// none, requests are not budgeted, which is what local development and tests
+// use.
+func SetDefault(l *Limiter) {
+	defaultLimiter.Store(l)
+}
+
+// Default returns the limiter set by SetDefault, or nil.
+func Default() *Limiter {
+	return defaultLimiter.Load()
+}
+
+// Configure sets the default limiter to one backed by Firestore.
+func Configure(client *firestore.Client) {
+	SetDefault(NewLimiter(NewFirestoreStore(client)))
+}
+
+// Wait spends one request from the provider's budget. If a window is
+// exhausted it waits for the reset when that is within the limiter's max
+// wait, and otherwise returns an *Error. Other errors come from the store;
+// callers should let the request through rather than fail on them.
+func (l *Limiter) Wait(ctx context.Context, provider string) error {
+	if l == nil || !Tracks(provider) {
+		return nil
+	}
+	for waited := false; ; waited = true {
+		wait, err := l.take(ctx, provider)
+		if err != nil {
+			return fmt.Errorf("ratelimit: failed to update %s budget: %w", provider, err)
+		}
+		if wait <= 0 {
+			return nil
+		}
+		if waited || wait > l.maxWait {
+			return &Error{Provider: provider, RetryAfter: wait}
+		}
+		if err := l.sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// take counts a request against every live window. It returns how long
+// until the latest exhausted window resets, without counting, if any is.
+func (l *Limiter) take(ctx context.Context, provider string) (time.Duration, error) {
+	var wait time.Duration
+	err := l.store.Update(ctx, provider, func(b *pipeline.ProviderRateBudget) bool {
+		now := l.now()
+		wait = 0
+		var live []*pipeline.RateLimitWindow
+		for _, w := range b.Windows {
+			// A window past its reset is full again; the next response
+			// brings its new counts.
+			if w.ResetAt == nil || !w.ResetAt.AsTime().After(now) {
+				continue
+			}
+			live = append(live, w)
+			if w.Remaining <= w.Limit/reserveDivisor {
+				if d := w.ResetAt.AsTime().Sub(now); d > wait {
+					wait = d
+				}
+			}
+		}
+		if wait > 0 || len(live) == 0 {
+			return false
+		}
+		for _, w := range live {
+			w.Remaining--
+		}
+		b.UpdatedAt = timestamppb.New(now)
+		return true
+	})
+	return wait, err
+}
+
+// Observe refreshes the provider's budget from a response's rate limit
+// headers. A 429 response exhausts the budget until it resets and returns an
+// *Error; other errors come from the store.
+func (l *Limiter) Observe(ctx context.Context, provider string, resp *http.Response) error {
+	if l == nil || !Tracks(provider) {
+		return nil
+	}
+	now := l.now()
+	windows := parseWindows(resp.Header, now)
+
+	var limited *Error
+	if resp.StatusCode == http.StatusTooManyRequests {
+		retryAfter := parseRetryAfter(resp.Header, now)
+		if retryAfter <= 0 {
+			retryAfter = exhaustedUntil(windows, now)
+		}
+		if retryAfter <= 0 {
+			retryAfter = defaultRetryAfter
+		}
+		limited = &Error{Provider: provider, RetryAfter: retryAfter}
+		// Hold everyone off until then, whatever the headers said
+		windows = append(windows, &pipeline.RateLimitWindow{ResetAt: timestamppb.New(now.Add(retryAfter))})
+	}
+
+	if len(windows) > 0 {
+		err := l.store.Update(ctx, provider, func(b *pipeline.ProviderRateBudget) bool {
+			b.Windows = windows
+			b.UpdatedAt = timestamppb.New(now)
+			return true
+		})
+		if err != nil && limited == nil {
+			return fmt.Errorf("ratelimit: failed to update %s budget: %w", provider, err)
+		}
+	}
+	if limited != nil {
+		return limited
+	}
+	return nil
+}
+
+// exhaustedUntil returns how long until the latest exhausted window resets.
+func exhaustedUntil(windows []*pipeline.RateLimitWindow, now time.Time) time.Duration {
+	var d time.Duration
+	for _, w := range windows {
+		if w.Remaining <= 0 {
+			if until := w.ResetAt.AsTime().Sub(now); until > d {
+				d = until
+			}
+		}
+	}
+	return d
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,165 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+type memoryStore struct {
+	budgets map[string]*pipeline.ProviderRateBudget
+}
+
+func (s *memoryStore) Update(ctx context.Context, provider string, fn func(b *pipeline.ProviderRateBudget) bool) error {
+	b := &pipeline.ProviderRateBudget{Provider: provider}
+	if existing, ok := s.budgets[provider]; ok {
+		b = proto.Clone(existing).(*pipeline.ProviderRateBudget)
+	}
+	if fn(b) {
+		s.budgets[provider] = b
+	}
+	return nil
+}
+
+var now = time.Date(2026, 6, 1, 10, 5, 0, 0, time.UTC)
+
+func newTestLimiter() (*Limiter, *memoryStore, *[]time.Duration) {
+	store := &memoryStore{budgets: map[string]*pipeline.ProviderRateBudget{}}
+	var slept []time.Duration
+	l := NewLimiter(store)
+	l.now = func() time.Time { return now }
+	l.sleep = func(ctx context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return nil
+	}
+	return l, store, &slept
+}
+
+func response(status int, headers map[string]string) *http.Response {
+	h := http.Header{}
+	for k, v := range headers {
+		h.Set(k, v)
+	}
+	return &http.Response{StatusCode: status, Header: h}
+}
+
+func TestParseWindows(t *testing.T) {
+	strava := parseWindows(response(200, map[string]string{
+		"X-RateLimit-Limit": "200,2000",
+		"X-RateLimit-Usage": "10,300",
+	}).Header, now)
+	if len(strava) != 2 {
+		t.Fatalf("Expected 2 Strava windows, got %d", len(strava))
+	}
+	if strava[0].Remaining != 190 || !strava[0].ResetAt.AsTime().Equal(time.Date(2026, 6, 1, 10, 15, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected 15-minute window: %v", strava[0])
+	}
+	if strava[1].Remaining != 1700 || !strava[1].ResetAt.AsTime().Equal(time.Date(2026, 6, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected daily window: %v", strava[1])
+	}
+
+	common := parseWindows(response(200, map[string]string{
+		"X-RateLimit-Limit":     "100",
+		"X-RateLimit-Remaining": "42",
+		"X-RateLimit-Reset":     "30",
+	}).Header, now)
+	if len(common) != 1 || common[0].Remaining != 42 || !common[0].ResetAt.AsTime().Equal(now.Add(30*time.Second)) {
+		t.Errorf("Unexpected window: %v", common)
+	}
+
+	if w := parseWindows(response(200, nil).Header, now); w != nil {
+		t.Errorf("Expected no windows without headers, got %v", w)
+	}
+}
+
+func TestLimiter_SpendsBudget(t *testing.T) {
+	l, store, _ := newTestLimiter()
+	ctx := context.Background()
+
+	// Nothing is known until the first response
+	if err := l.Wait(ctx, "strava"); err != nil {
+		t.Fatalf("Wait failed: %v", err)
+	}
+	if err := l.Observe(ctx, "strava", response(200, map[string]string{"X-RateLimit-Limit": "200,2000", "X-RateLimit-Usage": "10,300"})); err != nil {
+		t.Fatalf("Observe failed: %v", err)
+	}
+	if err := l.Wait(ctx, "strava"); err != nil {
+		t.Fatalf("Wait failed: %v", err)
+	}
+
+	windows := store.budgets["strava"].Windows
+	if windows[0].Remaining != 189 || windows[1].Remaining != 1699 {
+		t.Errorf("Expected one request counted against both windows, got %v", windows)
+	}
+}
+
+func TestLimiter_ExhaustedBudget(t *testing.T) {
+	ctx := context.Background()
+	exhausted := func(resetIn time.Duration) *pipeline.ProviderRateBudget {
+		return &pipeline.ProviderRateBudget{Windows: []*pipeline.RateLimitWindow{
+			{Limit: 200, Remaining: 5, ResetAt: timestamppb.New(now.Add(resetIn))},
+		}}
+	}
+
+	t.Run("waits for a reset within the max wait", func(t *testing.T) {
+		l, store, slept := newTestLimiter()
+		store.budgets["hevy"] = exhausted(10 * time.Second)
+		l.sleep = func(ctx context.Context, d time.Duration) error {
+			*slept = append(*slept, d)
+			now = now.Add(d)
+			return nil
+		}
+		defer func(start time.Time) { now = start }(now)
+
+		if err := l.Wait(ctx, "hevy"); err != nil {
+			t.Fatalf("Wait failed: %v", err)
+		}
+		if len(*slept) != 1 || (*slept)[0] != 10*time.Second {
+			t.Errorf("Expected one 10s wait, got %v", *slept)
+		}
+	})
+
+	t.Run("refuses a distant reset", func(t *testing.T) {
+		l, store, slept := newTestLimiter()
+		store.budgets["strava"] = exhausted(10 * time.Minute)
+
+		var limitErr *Error
+		if err := l.Wait(ctx, "strava"); !errors.As(err, &limitErr) || limitErr.RetryAfter != 10*time.Minute {
+			t.Fatalf("Expected a rate limit error after 10m, got %v", err)
+		}
+		if len(*slept) != 0 {
+			t.Errorf("Expected no wait, got %v", *slept)
+		}
+	})
+
+	t.Run("untracked providers pass", func(t *testing.T) {
+		l, store, _ := newTestLimiter()
+		store.budgets["komoot"] = exhausted(10 * time.Minute)
+		if err := l.Wait(ctx, "komoot"); err != nil {
+			t.Errorf("Expected untracked provider to pass, got %v", err)
+		}
+	})
+}
+
+func TestLimiter_TooManyRequests(t *testing.T) {
+	l, store, _ := newTestLimiter()
+	ctx := context.Background()
+
+	err := l.Observe(ctx, "strava", response(http.StatusTooManyRequests, map[string]string{"Retry-After": "120"}))
+	var limitErr *Error
+	if !errors.As(err, &limitErr) || limitErr.RetryAfter != 2*time.Minute {
+		t.Fatalf("Expected a rate limit error after 2m, got %v", err)
+	}
+
+	// Everyone else is held off until then too
+	if err := l.Wait(ctx, "strava"); !errors.As(err, &limitErr) || limitErr.RetryAfter != 2*time.Minute {
+		t.Errorf("Expected Wait to refuse for 2m, got %v (budget %v)", err, store.budgets["strava"])
+	}
+}
@@ -1,13 +1,17 @@
 package storage
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
 	"time"
 
 	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
 )
 
 // StorageAdapter provides blob storage operations using Google Cloud Storage
@@ -35,6 +39,29 @@ func (a *StorageAdapter) Write(ctx context.Context, bucketName, objectName strin
 	return wc.Close()
 }
 
+// WriteGzip stores data gzip-compressed with Content-Encoding: gzip and
+// returns the compressed size. GCS transcodes such objects on read, so Get
+// and signed download URLs still return the original bytes.
+func (a *StorageAdapter) WriteGzip(ctx context.Context, bucketName, objectName string, data []byte) (int, error) {
+	bucketName, objectName = parseURI(bucketName, objectName)
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err := zw.Write(data); err != nil {
+		return 0, err
+	}
+	if err := zw.Close(); err != nil {
+		return 0, err
+	}
+
+	wc := a.Client.Bucket(bucketName).Object(objectName).NewWriter(ctx)
+	wc.ContentEncoding = "gzip"
+	if _, err := wc.Write(compressed.Bytes()); err != nil {
+		return 0, err
+	}
+	return compressed.Len(), wc.Close()
+}
+
 func (a *StorageAdapter) Get(ctx context.Context, bucketName, objectName string) ([]byte, error) {
 	bucketName, objectName = parseURI(bucketName, objectName)
 	rc, err := a.Client.Bucket(bucketName).Object(objectName).NewReader(ctx)
@@ -45,11 +72,45 @@ func (a *StorageAdapter) Get(ctx context.Context, bucketName, objectName string)
 	return io.ReadAll(rc)
 }
 
+// NewReader opens an object for streaming reads. The caller must close it.
+func (a *StorageAdapter) NewReader(ctx context.Context, bucketName, objectName string) (io.ReadCloser, error) {
+	bucketName, objectName = parseURI(bucketName, objectName)
+	return a.Client.Bucket(bucketName).Object(objectName).NewReader(ctx)
+}
+
+// NewWriter opens an object for streaming writes. The object is only created
+// when the writer is closed; cancelling ctx before then abandons the upload.
+func (a *StorageAdapter) NewWriter(ctx context.Context, bucketName, objectName string) io.WriteCloser {
+	bucketName, objectName = parseURI(bucketName, objectName)
+	return a.Client.Bucket(bucketName).Object(objectName).NewWriter(ctx)
+}
+
 func (a *StorageAdapter) Delete(ctx context.Context, bucketName, objectName string) error {
 	bucketName, objectName = parseURI(bucketName, objectName)
 	return a.Client.Bucket(bucketName).Object(objectName).Delete(ctx)
 }
 
+// DeletePrefix deletes every object whose name starts with prefix and returns
+// how many were deleted. Objects that disappear meanwhile are not an error.
+func (a *StorageAdapter) DeletePrefix(ctx context.Context, bucketName, prefix string) (int, error) {
+	bucket := a.Client.Bucket(bucketName)
+	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	deleted := 0
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return deleted, nil
+		}
+		if err != nil {
+			return deleted, err
+		}
+		if err := bucket.Object(attrs.Name).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return deleted, err
+		}
+		deleted++
+	}
+}
+
 // SignedURL generates a V4 signed URL for uploading or downloading an object.
 // On Cloud Run with a service account, credentials are auto-detected.
 // contentLength is used for PUT uploads; pass 0 for GET downloads.
@@ -0,0 +1,126 @@
+package github_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fitglue/server/src/go/pkg/integrations/github"
+)
+
+// gitDataServer fakes the Git Data API, recording each request as
+// "METHOD path" and the body of the created tree and ref.
+type gitDataServer struct {
+	branchExists bool
+	calls        []string
+	tree         []map[string]string
+	ref          map[string]interface{}
+}
+
+func (g *gitDataServer) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		g.calls = append(g.calls, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/jo/site/git/ref/heads/gh-pages":
+			if !g.branchExists {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"object": {"sha": "head1"}}`))
+		case r.URL.Path == "/repos/jo/site/git/blobs":
+			_, _ = w.Write([]byte(`{"sha": "blob1"}`))
+		case r.URL.Path == "/repos/jo/site/git/trees":
+			var body struct {
+				Tree []map[string]string `json:"tree"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			g.tree = body.Tree
+			_, _ = w.Write([]byte(`{"sha": "tree1"}`))
+		case r.URL.Path == "/repos/jo/site/git/commits":
+			_, _ = w.Write([]byte(`{"sha": "commit1"}`))
+		case r.URL.Path == "/repos/jo/site/git/refs" || r.URL.Path == "/repos/jo/site/git/refs/heads/gh-pages":
+			_ = json.NewDecoder(r.Body).Decode(&g.ref)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func TestReplaceBranchContents_CreatesBranch(t *testing.T) {
+	fake := &gitDataServer{}
+	srv := httptest.NewServer(fake.handler(t))
+	defer srv.Close()
+
+	c, _ := github.NewClientWithResponses(srv.URL)
+	files := map[string][]byte{"index.md": []byte("# Hi"), "_config.yml": []byte("title: x")}
+	sha, err := c.ReplaceBranchContents(context.Background(), "jo", "site", "gh-pages", "Export", files, github.CommitAuthor{Name: "Bot", Email: "bot@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sha != "commit1" {
+		t.Errorf("expected commit1, got %s", sha)
+	}
+	if len(fake.tree) != 2 || fake.tree[0]["path"] != "_config.yml" || fake.tree[1]["path"] != "index.md" {
+		t.Errorf("unexpected tree: %v", fake.tree)
+	}
+	if fake.ref["ref"] != "refs/heads/gh-pages" || fake.ref["sha"] != "commit1" {
+		t.Errorf("expected branch to be created at commit1, got %v", fake.ref)
+	}
+	if last := fake.calls[len(fake.calls)-1]; last != "POST /repos/jo/site/git/refs" {
+		t.Errorf("expected ref creation last, got %s", last)
+	}
+}
+
+func TestReplaceBranchContents_UpdatesBranch(t *testing.T) {
+	fake := &gitDataServer{branchExists: true}
+	srv := httptest.NewServer(fake.handler(t))
+	defer srv.Close()
+
+	c, _ := github.NewClientWithResponses(srv.URL)
+	_, err := c.ReplaceBranchContents(context.Background(), "jo", "site", "gh-pages", "Export", map[string][]byte{"index.md": []byte("# Hi")}, github.CommitAuthor{Name: "Bot", Email: "bot@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if last := fake.calls[len(fake.calls)-1]; last != "PATCH /repos/jo/site/git/refs/heads/gh-pages" {
+		t.Errorf("expected ref update last, got %s", last)
+	}
+}
+
+func TestReplaceBranchContents_Error(t *testing.T) {
+	srv := ghFakeServer(http.StatusForbidden, map[string]interface{}{"message": "Resource not accessible"})
+	defer srv.Close()
+
+	c, _ := github.NewClientWithResponses(srv.URL)
+	if _, err := c.ReplaceBranchContents(context.Background(), "jo", "site", "gh-pages", "Export", nil, github.CommitAuthor{}); err == nil {
+		t.Error("expected error for 403")
+	}
+}
+
+func TestDeleteFile(t *testing.T) {
+	var method, path string
+	var body map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c, _ := github.NewClientWithResponses(srv.URL)
+	err := c.DeleteFile(context.Background(), "jo", "log", "workouts/2026-01-02-morning run/README.md", "blob1", "Remove", github.CommitAuthor{Name: "Bot", Email: "bot@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if method != http.MethodDelete || path != "/repos/jo/log/contents/workouts/2026-01-02-morning run/README.md" {
+		t.Errorf("unexpected request %s %s", method, path)
+	}
+	if body["sha"] != "blob1" || body["message"] != "Remove" {
+		t.Errorf("unexpected body: %v", body)
+	}
+}
@@ -0,0 +1,46 @@
+package strava
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Hand-written helpers over the generated client; kept out of client.gen.go
+// so they survive regeneration.
+
+// ActivitySegmentEfforts returns every segment effort recorded on an
+// activity, hidden ones included. Each effort's segment summary carries the
+// authenticated athlete's current PR on that segment.
+func (c *ClientWithResponses) ActivitySegmentEfforts(ctx context.Context, activityID int64) ([]DetailedSegmentEffort, error) {
+	includeAll := true
+	resp, err := c.GetActivityByIdWithResponse(ctx, activityID, &GetActivityByIdParams{IncludeAllEfforts: &includeAll})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("strava api error %d: %s", resp.StatusCode(), string(resp.Body))
+	}
+	if resp.JSON200.SegmentEfforts == nil {
+		return nil, nil
+	}
+	return *resp.JSON200.SegmentEfforts, nil
+}
+
+// AthleteSegmentEfforts lists the authenticated athlete's efforts on a
+// segment that started between start and end (local time, inclusive).
+func (c *ClientWithResponses) AthleteSegmentEfforts(ctx context.Context, segmentID int64, start, end time.Time, perPage int) ([]DetailedSegmentEffort, error) {
+	resp, err := c.GetEffortsBySegmentIdWithResponse(ctx, &GetEffortsBySegmentIdParams{
+		SegmentId:      int(segmentID),
+		StartDateLocal: &start,
+		EndDateLocal:   &end,
+		PerPage:        &perPage,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("strava api error %d: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return *resp.JSON200, nil
+}
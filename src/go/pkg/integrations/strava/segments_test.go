@@ -0,0 +1,63 @@
+package strava_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/integrations/strava"
+)
+
+func TestActivitySegmentEfforts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/activities/42" || r.URL.Query().Get("include_all_efforts") != "true" {
+			t.Errorf("unexpected request %s", r.URL.String())
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 42, "segment_efforts": [{"id": 7, "name": "Hill", "elapsed_time": 252, "pr_rank": 1}]}`))
+	}))
+	defer srv.Close()
+
+	c, _ := strava.NewClientWithResponses(srv.URL)
+	efforts, err := c.ActivitySegmentEfforts(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(efforts) != 1 || *efforts[0].Name != "Hill" || *efforts[0].ElapsedTime != 252 {
+		t.Errorf("unexpected efforts: %+v", efforts)
+	}
+}
+
+func TestActivitySegmentEfforts_Error(t *testing.T) {
+	srv := stravaFakeServer(http.StatusNotFound, map[string]interface{}{"message": "Record Not Found"})
+	defer srv.Close()
+
+	c, _ := strava.NewClientWithResponses(srv.URL)
+	if _, err := c.ActivitySegmentEfforts(context.Background(), 42); err == nil {
+		t.Error("expected error for 404")
+	}
+}
+
+func TestAthleteSegmentEfforts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/segment_efforts" || q.Get("segment_id") != "99" || q.Get("per_page") != "50" {
+			t.Errorf("unexpected request %s", r.URL.String())
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id": 1, "elapsed_time": 260}, {"id": 2, "elapsed_time": 270}]`))
+	}))
+	defer srv.Close()
+
+	c, _ := strava.NewClientWithResponses(srv.URL)
+	end := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	efforts, err := c.AthleteSegmentEfforts(context.Background(), 99, end.AddDate(-5, 0, 0), end, 50)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(efforts) != 2 {
+		t.Errorf("expected 2 efforts, got %d", len(efforts))
+	}
+}
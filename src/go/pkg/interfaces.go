@@ -2,6 +2,7 @@ package shared
 
 import (
 	"context"
+	"time"
 
 	"github.com/fitglue/server/src/go/pkg/domain/user"
 
@@ -22,11 +23,21 @@ type Database interface {
 	GetUser(ctx context.Context, id string) (*user.Record, error)
 	UpdateUser(ctx context.Context, id string, data map[string]interface{}) error
 
+	// Integration Secrets (API keys kept out of the user document)
+	// GetIntegrationSecret returns "" when the user has no secret for the provider
+	GetIntegrationSecret(ctx context.Context, userId string, provider string) (string, error)
+	SetIntegrationSecret(ctx context.Context, userId string, provider string, secret string) error
+
 	// Sync Count (for tier limits)
 	IncrementSyncCount(ctx context.Context, userID string) error
 	IncrementPreventedSyncCount(ctx context.Context, userID string) error
 	ResetSyncCount(ctx context.Context, userID string) error
 
+	// Feature Usage (monthly per-feature counters for tier quotas)
+	// GetUsage returns the user's usage in period (YYYY-MM) by feature; empty when nothing was used
+	GetUsage(ctx context.Context, userID string, period string) (map[string]int64, error)
+	IncrementUsage(ctx context.Context, userID string, period string, feature string, amount int64) error
+
 	// Pending Inputs
 	GetPendingInput(ctx context.Context, userId string, id string) (*pbpipeline.PendingInput, error)
 	CreatePendingInput(ctx context.Context, userId string, input *pbpipeline.PendingInput) error
@@ -47,13 +58,28 @@ type Database interface {
 	ListPersonalRecords(ctx context.Context, userId string) ([]*pbuser.PersonalRecord, error)
 	DeletePersonalRecord(ctx context.Context, userId string, recordType string) error
 
+	// Gear
+	GetGear(ctx context.Context, userId string, gearId string) (*pbuser.Gear, error)
+	RecordGearUse(ctx context.Context, userId string, gearId string, activityId string, distanceMeters float64, usedAt time.Time) (*pbuser.Gear, bool, error)
+
+	// Goals
+	ListGoals(ctx context.Context, userId string) ([]*pbuser.Goal, error)
+	GetGoal(ctx context.Context, userId string, goalId string) (*pbuser.Goal, error)
+	SetGoal(ctx context.Context, userId string, goal *pbuser.Goal) error
+	DeleteGoal(ctx context.Context, userId string, goalId string) error
+
 	// Pipelines (Sub-collection)
 	GetUserPipelines(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error)
+	GetPipelineConfigVersion(ctx context.Context, userId string, pipelineId string, version int32) (*pbpipeline.PipelineConfigVersion, error)
 
 	// Plugin Defaults (user-level default config for sources/destinations)
 	GetPluginDefault(ctx context.Context, userId string, pluginId string) (*pbpipeline.PluginDefault, error)
 	SetPluginDefault(ctx context.Context, userId string, pluginDefault *pbpipeline.PluginDefault) error
 
+	// Activity Type Rules (learned from the user's type corrections)
+	ListActivityTypeRules(ctx context.Context, userId string) ([]*pbpipeline.ActivityTypeRule, error)
+	MarkActivityTypeRuleApplied(ctx context.Context, userId string, ruleId string) error
+
 	// Showcased Activities (public shareable snapshots)
 	ShowcaseActivityExists(ctx context.Context, showcaseId string) (bool, error)
 	SetShowcasedActivity(ctx context.Context, activity *pbactivity.ShowcasedActivity) error
@@ -77,15 +103,47 @@ type Database interface {
 	GetPipelineRun(ctx context.Context, userId string, id string) (*pbpipeline.PipelineRun, error)
 	GetPipelineRunByActivityId(ctx context.Context, userId string, activityId string) (*pbpipeline.PipelineRun, error)
 	UpdatePipelineRun(ctx context.Context, userId string, id string, data map[string]interface{}) error
+	// GetActivityDayCounts aggregates pipeline runs into the number of distinct
+	// activities started on each day (YYYY-MM-DD, UTC) since the given time
+	GetActivityDayCounts(ctx context.Context, userId string, since time.Time) (map[string]int, error)
+	// AddPipelineRunCost adds processing cost to the run and to the user's
+	// monthly total. Resumed runs add to what earlier passes recorded.
+	AddPipelineRunCost(ctx context.Context, userId string, id string, cost *pbpipeline.RunCost) error
 
 	// Destination Outcomes (subcollection of Pipeline Runs - avoids race conditions)
 	SetDestinationOutcome(ctx context.Context, userId string, pipelineRunId string, outcome *pbpipeline.DestinationOutcome) error
 	GetDestinationOutcomes(ctx context.Context, userId string, pipelineRunId string) ([]*pbpipeline.DestinationOutcome, error)
+	// UpdateDestinationOutcomes applies fn to the run and its outcomes inside a
+	// transaction, then writes the outcomes fn returns and merges its run data.
+	// fn receives a nil run when the run does not exist, and may run more than once.
+	UpdateDestinationOutcomes(ctx context.Context, userId string, pipelineRunId string, fn func(run *pbpipeline.PipelineRun, outcomes []*pbpipeline.DestinationOutcome) ([]*pbpipeline.DestinationOutcome, map[string]interface{})) error
+	// ListStuckPipelineRuns returns RUNNING runs across all users last updated before the given time
+	ListStuckPipelineRuns(ctx context.Context, before time.Time, limit int) ([]*UserPipelineRun, error)
 
 	// Booster Data (generic key-value storage for enrichers that need persistence)
 	GetBoosterData(ctx context.Context, userId string, boosterId string) (map[string]interface{}, error)
 	SetBoosterData(ctx context.Context, userId string, boosterId string, data map[string]interface{}) error
 	DeleteBoosterData(ctx context.Context, userId string, boosterId string) error
+
+	// Provider Circuits (shared circuit breaker state per enricher provider type)
+	// GetProviderCircuit returns nil, nil when the provider has never failed
+	GetProviderCircuit(ctx context.Context, providerType pbplugin.EnricherProviderType) (*pbpipeline.ProviderCircuit, error)
+	// UpdateProviderCircuit applies fn to the circuit inside a transaction and
+	// returns the stored result. fn receives a fresh record for new providers.
+	UpdateProviderCircuit(ctx context.Context, providerType pbplugin.EnricherProviderType, fn func(c *pbpipeline.ProviderCircuit)) (*pbpipeline.ProviderCircuit, error)
+	// AddProviderCircuitChange appends to the provider's circuit history
+	AddProviderCircuitChange(ctx context.Context, change *pbpipeline.ProviderCircuitChange) error
+
+	// Enricher Result Shares (provider results reused by the other pipelines of a splitter fan-out)
+	// GetEnricherResultShare returns nil, nil when no pipeline has shared the result yet
+	GetEnricherResultShare(ctx context.Context, userId string, id string) (*pbpipeline.EnricherResultShare, error)
+	SetEnricherResultShare(ctx context.Context, userId string, share *pbpipeline.EnricherResultShare) error
+}
+
+// UserPipelineRun is a pipeline run listed across users, with the user it belongs to
+type UserPipelineRun struct {
+	UserId string
+	Run    *pbpipeline.PipelineRun
 }
 
 // --- Messaging Interfaces ---
@@ -107,3 +165,11 @@ type BlobStore interface {
 type NotificationService interface {
 	SendPushNotification(ctx context.Context, userID string, title, body string, tokens []string, data map[string]string) error
 }
+
+// EmailNotificationService is a NotificationService that can also send email.
+// Only services with an email provider configured get one, so callers check
+// for it with a type assertion.
+type EmailNotificationService interface {
+	NotificationService
+	SendEmail(ctx context.Context, to string, subject string, htmlContent string) error
+}
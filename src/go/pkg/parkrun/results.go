@@ -474,14 +474,15 @@ func isAgeGradePBThisYear(targetAG float64, thisYearAgeGrades []float64) bool {
 	return len(thisYearAgeGrades) > 1
 }
 
-// FormatResultsDescription formats results into a nice description with PB badges.
-func FormatResultsDescription(results *Result, eventName string) string {
+// FormatResultsDescription formats results into a nice description with PB
+// badges, under the given section header (e.g. "🏃 Parkrun Results:").
+func FormatResultsDescription(results *Result, eventName, header string) string {
 	if results == nil {
 		return ""
 	}
 
 	var sb strings.Builder
-	sb.WriteString("🏃 Parkrun Results:\n")
+	sb.WriteString(header + "\n")
 
 	// Position line with PB badges
 	sb.WriteString(fmt.Sprintf("• Position: %s", Ordinal(results.Position)))
@@ -2,6 +2,7 @@ package pending_input
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 )
 
@@ -26,3 +27,18 @@ func ParseID(id string) (source, externalID, enricherProviderID string, err erro
 func GetActivityKey(source, externalID string) string {
 	return fmt.Sprintf("%s:%s", source, externalID)
 }
+
+// UploadPrefix is where files uploaded for a pending input are kept in the
+// artifacts bucket until the enricher resumes. Submitted input fields carry
+// object paths under it rather than the files themselves, which wouldn't fit
+// in the pending input document.
+func UploadPrefix(userID, pendingInputID string) string {
+	return fmt.Sprintf("pending_uploads/%s/%s/", userID, url.PathEscape(pendingInputID))
+}
+
+// IsUploadFor reports whether object is a file uploaded for the user's
+// pending input, so a submitted path can't point at anything else.
+func IsUploadFor(object, userID, pendingInputID string) bool {
+	prefix := UploadPrefix(userID, pendingInputID)
+	return strings.HasPrefix(object, prefix) && len(object) > len(prefix) && !strings.Contains(object, "..")
+}
@@ -145,3 +145,30 @@ func TestGetActivityKey(t *testing.T) {
 		})
 	}
 }
+
+func TestIsUploadFor(t *testing.T) {
+	id := GenerateID("SOURCE_STRAVA", "12345", "photo-geotag")
+	prefix := UploadPrefix("user1", id)
+	if prefix != "pending_uploads/user1/SOURCE_STRAVA:12345:photo-geotag/" {
+		t.Errorf("UploadPrefix() = %q", prefix)
+	}
+
+	tests := []struct {
+		name     string
+		object   string
+		expected bool
+	}{
+		{"Own upload", prefix + "a.jpg", true},
+		{"Prefix only", prefix, false},
+		{"Another user's upload", UploadPrefix("user2", id) + "a.jpg", false},
+		{"Another input's upload", UploadPrefix("user1", GenerateID("SOURCE_STRAVA", "999", "photo-geotag")) + "a.jpg", false},
+		{"Path traversal", prefix + "../../user2/x.jpg", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsUploadFor(tt.object, "user1", id); got != tt.expected {
+				t.Errorf("IsUploadFor(%q) = %v, want %v", tt.object, got, tt.expected)
+			}
+		})
+	}
+}
@@ -22,11 +22,15 @@ func (c *Client) Close() error {
 	return c.fs.Close()
 }
 
+// Users encrypts integration tokens on write and decrypts them on read when
+// a token cipher is configured.
 func (c *Client) Users() *Collection[user.Record] {
 	return &Collection[user.Record]{
 		Ref:           c.fs.Collection("users"),
 		ToFirestore:   UserToFirestore,
 		FromFirestore: FirestoreToUser,
+		BeforeWrite:   EncryptUserTokens,
+		AfterRead:     DecryptUserTokens,
 	}
 }
 
@@ -98,6 +102,24 @@ func (c *Client) PersonalRecords(userId string) *Collection[pbuser.PersonalRecor
 	}
 }
 
+// Gear is a sub-collection of Users: users/{uid}/gear/{gearId}
+func (c *Client) Gear(userId string) *Collection[pbuser.Gear] {
+	return &Collection[pbuser.Gear]{
+		Ref:           c.fs.Collection("users").Doc(userId).Collection("gear"),
+		ToFirestore:   GearToFirestore,
+		FromFirestore: FirestoreToGear,
+	}
+}
+
+// Goals is a sub-collection of Users: users/{uid}/goals/{goalId}
+func (c *Client) Goals(userId string) *Collection[pbuser.Goal] {
+	return &Collection[pbuser.Goal]{
+		Ref:           c.fs.Collection("users").Doc(userId).Collection("goals"),
+		ToFirestore:   GoalToFirestore,
+		FromFirestore: FirestoreToGoal,
+	}
+}
+
 // ShowcasedActivities is a top-level collection: showcased_activities/{showcase_id}
 func (c *Client) ShowcasedActivities() *Collection[pbactivity.ShowcasedActivity] {
 	return &Collection[pbactivity.ShowcasedActivity]{
@@ -154,3 +176,43 @@ func (c *Client) PluginDefaults(userId string) *Collection[pbpipeline.PluginDefa
 		FromFirestore: FirestoreToPluginDefault,
 	}
 }
+
+// ActivityTypeRules are sub-collections of Users: users/{uid}/activity_type_rules/{id}
+// Stores activity type corrections learned from the user's edits
+func (c *Client) ActivityTypeRules(userId string) *Collection[pbpipeline.ActivityTypeRule] {
+	return &Collection[pbpipeline.ActivityTypeRule]{
+		Ref:           c.fs.Collection("users").Doc(userId).Collection("activity_type_rules"),
+		ToFirestore:   ActivityTypeRuleToFirestore,
+		FromFirestore: FirestoreToActivityTypeRule,
+	}
+}
+
+// EnricherResultShares are sub-collections of Users: users/{uid}/enricher_result_shares/{id}
+// Provider results shared between the pipelines of one splitter fan-out
+func (c *Client) EnricherResultShares(userId string) *Collection[pbpipeline.EnricherResultShare] {
+	return &Collection[pbpipeline.EnricherResultShare]{
+		Ref:           c.fs.Collection("users").Doc(userId).Collection("enricher_result_shares"),
+		ToFirestore:   EnricherResultShareToFirestore,
+		FromFirestore: FirestoreToEnricherResultShare,
+	}
+}
+
+// ProviderCircuits is a root collection: provider_circuits/{providerType}
+// Stores the circuit breaker state shared by every enricher instance
+func (c *Client) ProviderCircuits() *Collection[pbpipeline.ProviderCircuit] {
+	return &Collection[pbpipeline.ProviderCircuit]{
+		Ref:           c.fs.Collection("provider_circuits"),
+		ToFirestore:   ProviderCircuitToFirestore,
+		FromFirestore: FirestoreToProviderCircuit,
+	}
+}
+
+// ProviderCircuitChanges are sub-collections of ProviderCircuits: provider_circuits/{providerType}/history/{id}
+// Records circuit opens/closes and admin mode changes
+func (c *Client) ProviderCircuitChanges(providerType string) *Collection[pbpipeline.ProviderCircuitChange] {
+	return &Collection[pbpipeline.ProviderCircuitChange]{
+		Ref:           c.fs.Collection("provider_circuits").Doc(providerType).Collection("history"),
+		ToFirestore:   ProviderCircuitChangeToFirestore,
+		FromFirestore: FirestoreToProviderCircuitChange,
+	}
+}
@@ -0,0 +1,352 @@
+package firestore
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FieldCodec overrides how a single field is stored. Encode returning nil
+// leaves the field out of the document; Decode returning false leaves it
+// unset.
+type FieldCodec struct {
+	Encode func(v protoreflect.Value) interface{}
+	Decode func(raw interface{}) (protoreflect.Value, bool)
+}
+
+// ProtoCodec converts proto messages to and from Firestore document maps by
+// reflection, so fields added to a proto are stored without touching the
+// converters.
+//
+// Fields are stored under their proto names. Timestamps are stored as
+// time.Time, enums as their numbers, nested messages as maps and repeated
+// fields as arrays. Scalars without presence are always written; optional
+// fields, messages, lists and maps only when set. Decoding is lenient in the
+// ways older documents need: numbers may come back as any numeric type,
+// enums may be stored by name and timestamps as RFC 3339 strings.
+//
+// The overrides are keyed by full field name and apply wherever the message
+// appears, at any depth.
+type ProtoCodec struct {
+	// Keys maps fields to a Firestore key other than their proto name.
+	Keys map[protoreflect.FullName]string
+	// Fields replaces the default encoding of a field.
+	Fields map[protoreflect.FullName]FieldCodec
+	// OmitEmpty lists scalar fields that are left out when zero, for
+	// documents that have never stored them that way.
+	OmitEmpty map[protoreflect.FullName]bool
+	// Skip lists fields that are not stored in the document at all.
+	Skip map[protoreflect.FullName]bool
+}
+
+// FieldName is the full name of a field of msg, for the codec's override
+// maps. It panics when msg has no such field, so a renamed proto field
+// fails at startup rather than silently losing its override.
+func FieldName(msg proto.Message, name protoreflect.Name) protoreflect.FullName {
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName(name)
+	if fd == nil {
+		panic(fmt.Sprintf("firestore codec: %s has no field %q", msg.ProtoReflect().Descriptor().FullName(), name))
+	}
+	return fd.FullName()
+}
+
+// Encode converts msg to a document map. A nil message encodes as an empty map.
+func (c *ProtoCodec) Encode(msg proto.Message) map[string]interface{} {
+	return c.encodeMessage(msg.ProtoReflect())
+}
+
+// Decode sets the fields of msg from a document map, ignoring keys that
+// don't match a field.
+func (c *ProtoCodec) Decode(m map[string]interface{}, msg proto.Message) {
+	c.decodeMessage(m, msg.ProtoReflect())
+}
+
+func (c *ProtoCodec) key(fd protoreflect.FieldDescriptor) string {
+	if key, ok := c.Keys[fd.FullName()]; ok {
+		return key
+	}
+	return string(fd.Name())
+}
+
+func (c *ProtoCodec) encodeMessage(msg protoreflect.Message) map[string]interface{} {
+	m := map[string]interface{}{}
+	if !msg.IsValid() {
+		return m
+	}
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := fd.FullName()
+		if c.Skip[name] {
+			continue
+		}
+		if fc, ok := c.Fields[name]; ok && fc.Encode != nil {
+			if v := fc.Encode(msg.Get(fd)); v != nil {
+				m[c.key(fd)] = v
+			}
+			continue
+		}
+		// Has is false for empty lists and maps and zero scalars
+		if !msg.Has(fd) && (fd.HasPresence() || fd.IsList() || fd.IsMap() || c.OmitEmpty[name]) {
+			continue
+		}
+		m[c.key(fd)] = c.encodeField(fd, msg.Get(fd))
+	}
+	return m
+}
+
+func (c *ProtoCodec) encodeField(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		out := make([]interface{}, list.Len())
+		for i := range out {
+			out[i] = c.encodeValue(fd, list.Get(i))
+		}
+		return out
+	case fd.IsMap():
+		out := map[string]interface{}{}
+		v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			out[k.String()] = c.encodeValue(fd.MapValue(), v)
+			return true
+		})
+		return out
+	}
+	return c.encodeValue(fd, v)
+}
+
+func (c *ProtoCodec) encodeValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return v.Bool()
+	case protoreflect.EnumKind:
+		return int32(v.Enum())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return int32(v.Int())
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return v.Int()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// Firestore has no unsigned integers
+		return int64(v.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return v.Float()
+	case protoreflect.StringKind:
+		return v.String()
+	case protoreflect.BytesKind:
+		return v.Bytes()
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if ts, ok := v.Message().Interface().(*timestamppb.Timestamp); ok {
+			return ts.AsTime()
+		}
+		return c.encodeMessage(v.Message())
+	}
+	return nil
+}
+
+func (c *ProtoCodec) decodeMessage(m map[string]interface{}, msg protoreflect.Message) {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if c.Skip[fd.FullName()] {
+			continue
+		}
+		raw, ok := m[c.key(fd)]
+		if !ok || raw == nil {
+			continue
+		}
+		if fc, ok := c.Fields[fd.FullName()]; ok && fc.Decode != nil {
+			if v, ok := fc.Decode(raw); ok {
+				msg.Set(fd, v)
+			}
+			continue
+		}
+		switch {
+		case fd.IsList():
+			c.decodeList(fd, raw, msg)
+		case fd.IsMap():
+			c.decodeMap(fd, raw, msg)
+		case fd.Message() != nil:
+			if v, ok := c.decodeMessageValue(fd, raw, msg.NewField(fd).Message()); ok {
+				msg.Set(fd, v)
+			}
+		default:
+			if v, ok := decodeScalar(fd, raw); ok {
+				msg.Set(fd, v)
+			}
+		}
+	}
+}
+
+// decodeList accepts any slice, since documents built in memory (as in
+// tests) hold typed slices where Firestore returns []interface{}.
+func (c *ProtoCodec) decodeList(fd protoreflect.FieldDescriptor, raw interface{}, msg protoreflect.Message) {
+	rv := reflect.ValueOf(raw)
+	if rv.Kind() != reflect.Slice {
+		return
+	}
+	list := msg.Mutable(fd).List()
+	for i := 0; i < rv.Len(); i++ {
+		elem := rv.Index(i).Interface()
+		if fd.Message() != nil {
+			if v, ok := c.decodeMessageValue(fd, elem, list.NewElement().Message()); ok {
+				list.Append(v)
+			}
+		} else if v, ok := decodeScalar(fd, elem); ok {
+			list.Append(v)
+		}
+	}
+}
+
+func (c *ProtoCodec) decodeMap(fd protoreflect.FieldDescriptor, raw interface{}, msg protoreflect.Message) {
+	rv := reflect.ValueOf(raw)
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return
+	}
+	out := msg.Mutable(fd).Map()
+	keyFd, valFd := fd.MapKey(), fd.MapValue()
+	iter := rv.MapRange()
+	for iter.Next() {
+		key, ok := decodeMapKey(keyFd, iter.Key().String())
+		if !ok {
+			continue
+		}
+		elem := iter.Value().Interface()
+		if valFd.Message() != nil {
+			if v, ok := c.decodeMessageValue(valFd, elem, out.NewValue().Message()); ok {
+				out.Set(key, v)
+			}
+		} else if v, ok := decodeScalar(valFd, elem); ok {
+			out.Set(key, v)
+		}
+	}
+}
+
+// decodeMessageValue fills dst, a new message for fd, from raw.
+func (c *ProtoCodec) decodeMessageValue(fd protoreflect.FieldDescriptor, raw interface{}, dst protoreflect.Message) (protoreflect.Value, bool) {
+	if _, ok := dst.Interface().(*timestamppb.Timestamp); ok {
+		t, ok := decodeTime(raw)
+		if !ok {
+			return protoreflect.Value{}, false
+		}
+		return protoreflect.ValueOfMessage(timestamppb.New(t).ProtoReflect()), true
+	}
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		return protoreflect.Value{}, false
+	}
+	c.decodeMessage(m, dst)
+	return protoreflect.ValueOfMessage(dst), true
+}
+
+func decodeTime(raw interface{}) (time.Time, bool) {
+	switch v := raw.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		return t, err == nil
+	}
+	return time.Time{}, false
+}
+
+func decodeMapKey(fd protoreflect.FieldDescriptor, s string) (protoreflect.MapKey, bool) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s).MapKey(), true
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		return protoreflect.ValueOfBool(b).MapKey(), err == nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfInt32(int32(n)).MapKey(), err == nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(s, 10, 64)
+		return protoreflect.ValueOfInt64(n).MapKey(), err == nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(s, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)).MapKey(), err == nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(s, 10, 64)
+		return protoreflect.ValueOfUint64(n).MapKey(), err == nil
+	}
+	return protoreflect.MapKey{}, false
+}
+
+func decodeScalar(fd protoreflect.FieldDescriptor, raw interface{}) (protoreflect.Value, bool) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		b, ok := raw.(bool)
+		return protoreflect.ValueOfBool(b), ok
+	case protoreflect.EnumKind:
+		n, ok := decodeEnum(fd.Enum(), raw)
+		return protoreflect.ValueOfEnum(n), ok
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, ok := toFloat64(raw)
+		return protoreflect.ValueOfInt32(int32(n)), ok
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if n, ok := raw.(int64); ok {
+			// Exact, where a float64 round trip would lose precision
+			return protoreflect.ValueOfInt64(n), true
+		}
+		n, ok := toFloat64(raw)
+		return protoreflect.ValueOfInt64(int64(n)), ok
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, ok := toFloat64(raw)
+		return protoreflect.ValueOfUint32(uint32(n)), ok
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if n, ok := raw.(int64); ok {
+			return protoreflect.ValueOfUint64(uint64(n)), true
+		}
+		n, ok := toFloat64(raw)
+		return protoreflect.ValueOfUint64(uint64(n)), ok
+	case protoreflect.FloatKind:
+		n, ok := toFloat64(raw)
+		return protoreflect.ValueOfFloat32(float32(n)), ok
+	case protoreflect.DoubleKind:
+		n, ok := toFloat64(raw)
+		return protoreflect.ValueOfFloat64(n), ok
+	case protoreflect.StringKind:
+		s, ok := raw.(string)
+		return protoreflect.ValueOfString(s), ok
+	case protoreflect.BytesKind:
+		b, ok := raw.([]byte)
+		return protoreflect.ValueOfBytes(b), ok
+	}
+	return protoreflect.Value{}, false
+}
+
+// decodeEnum accepts an enum number or value name, in any case.
+func decodeEnum(ed protoreflect.EnumDescriptor, raw interface{}) (protoreflect.EnumNumber, bool) {
+	if s, ok := raw.(string); ok {
+		if v := ed.Values().ByName(protoreflect.Name(strings.ToUpper(s))); v != nil {
+			return v.Number(), true
+		}
+		return 0, false
+	}
+	n, ok := toFloat64(raw)
+	return protoreflect.EnumNumber(int32(n)), ok
+}
+
+// toFloat64 reads any numeric type; Firestore returns whole numbers as
+// int64, JSON and structpb as float64.
+func toFloat64(raw interface{}) (float64, bool) {
+	switch v := raw.(type) {
+	case int64:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	}
+	return 0, false
+}
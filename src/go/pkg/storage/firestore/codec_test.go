@@ -0,0 +1,260 @@
+package firestore
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func goldenTime(hour int) *timestamppb.Timestamp {
+	return timestamppb.New(time.Date(2026, 5, 2, hour, 15, 0, 0, time.UTC))
+}
+
+func goldenUser() *user.Record {
+	maxHR := int32(190)
+	return &user.Record{
+		UserProfile: &pbuser.UserProfile{
+			UserId:                  "user-1",
+			CreatedAt:               goldenTime(1),
+			FcmTokens:               []string{"token-a", "token-b"},
+			Tier:                    pbuser.UserTier_USER_TIER_ATHLETE,
+			IsAdmin:                 true,
+			SyncCountThisMonth:      12,
+			SyncCountResetAt:        goldenTime(2),
+			AccessEnabled:           true,
+			NotificationPreferences: &pbuser.NotificationPreferences{NotifyPendingInput: true},
+			TrialEndsAt:             goldenTime(3),
+			MaxHeartRate:            &maxHR,
+			DescriptionHeaders: &pbuser.DescriptionHeaderPreferences{
+				HideEmoji:  true,
+				CustomText: map[string]string{"personal_records": "PBs"},
+			},
+			AiPrivacy:            &pbuser.AIPrivacyPreferences{ShareNotes: true},
+			SourceDeletionPolicy: pbuser.SourceDeletionPolicy_SOURCE_DELETION_POLICY_REMOVE,
+		},
+		Integrations: &pbuser.UserIntegrations{
+			Hevy: &pbuser.HevyIntegration{Enabled: true, ApiKey: "hevy-key", UserId: "hevy-user", CreatedAt: goldenTime(4)},
+			Strava: &pbuser.StravaIntegration{
+				Enabled:       true,
+				AccessToken:   "access",
+				RefreshToken:  "refresh",
+				ExpiresAt:     goldenTime(5),
+				AthleteId:     123456789,
+				LastWebhookAt: goldenTime(6),
+			},
+		},
+		Billing: &pbuser.SubscriptionState{StripeCustomerId: "cus_123"},
+	}
+}
+
+func goldenPipelineRun() *pbpipeline.PipelineRun {
+	statusMessage := "Delivered to 1 of 2 destinations"
+	externalID := "ext-1"
+	errMsg := "rate limited"
+	return &pbpipeline.PipelineRun{
+		Id:               "run-1",
+		PipelineId:       "pipe-1",
+		ActivityId:       "act-1",
+		Source:           "SOURCE_HEVY",
+		SourceActivityId: "hevy-1",
+		Title:            "Morning Lift",
+		Type:             pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING,
+		StartTime:        goldenTime(7),
+		Status:           pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_PARTIAL,
+		CreatedAt:        goldenTime(8),
+		UpdatedAt:        goldenTime(9),
+		Boosters: []*pbpipeline.BoosterExecution{
+			{ProviderName: "workout-summary", Status: "SUCCESS", DurationMs: 42, Metadata: map[string]string{"sets": "12"}, ContributedDescription: true},
+			{ProviderName: "weather", Status: "FAILED", Error: &errMsg},
+		},
+		Destinations: []*pbpipeline.DestinationOutcome{
+			{Destination: pbplugin.DestinationType_DESTINATION_STRAVA, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS, ExternalId: &externalID, CompletedAt: goldenTime(10)},
+			{Destination: pbplugin.DestinationType_DESTINATION_INTERVALS, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_FAILED},
+		},
+		StatusMessage:         &statusMessage,
+		OriginalPayloadUri:    "gs://bucket/payloads/run-1.json",
+		Cost:                  &pbpipeline.RunCost{AiInputTokens: 1200, ImageGenerations: 1, EstimatedUsd: 0.04},
+		PipelineConfigVersion: 3,
+		RetryAttempts:         map[string]int32{"weather": 2},
+		NextRetryAt:           goldenTime(11),
+	}
+}
+
+func goldenPendingInput() *pbpipeline.PendingInput {
+	return &pbpipeline.PendingInput{
+		ActivityId:         "act-1",
+		UserId:             "user-1",
+		Status:             pbpipeline.PendingInput_STATUS_WAITING,
+		RequiredFields:     []string{"title", "description"},
+		InputData:          map[string]string{"title": "Parkrun"},
+		CreatedAt:          goldenTime(12),
+		UpdatedAt:          goldenTime(13),
+		PipelineId:         "pipe-1",
+		EnricherProviderId: "parkrun-results",
+		AutoPopulated:      true,
+		AutoDeadline:       goldenTime(14),
+		ProviderMetadata:   map[string]string{"event": "bushy"},
+	}
+}
+
+// checkGolden compares a document with testdata/<name>.json, rewriting the
+// file instead when the -update flag is set, and returns the golden document
+// as Firestore-free JSON: numbers as float64 and timestamps as strings.
+func checkGolden(t *testing.T, name string, doc map[string]interface{}) map[string]interface{} {
+	t.Helper()
+	got, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		t.Fatalf("marshal %s: %v", name, err)
+	}
+	got = append(got, '\n')
+
+	path := filepath.Join("testdata", name+".json")
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("write %s: %v", path, err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s (run with -update to create it): %v", path, err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("%s does not match the golden file (run with -update if the change is intended)\ngot:\n%s\nwant:\n%s", name, got, want)
+	}
+
+	var golden map[string]interface{}
+	if err := json.Unmarshal(want, &golden); err != nil {
+		t.Fatalf("unmarshal %s: %v", path, err)
+	}
+	return golden
+}
+
+func TestConverters_Golden(t *testing.T) {
+	t.Run("user", func(t *testing.T) {
+		want := goldenUser()
+		golden := checkGolden(t, "user", UserToFirestore(want))
+		got := FirestoreToUser(golden)
+		if !proto.Equal(got.UserProfile, want.UserProfile) || !proto.Equal(got.Integrations, want.Integrations) || !proto.Equal(got.Billing, want.Billing) {
+			t.Errorf("user did not round-trip:\ngot:  %v %v %v\nwant: %v %v %v", got.UserProfile, got.Integrations, got.Billing, want.UserProfile, want.Integrations, want.Billing)
+		}
+	})
+	t.Run("pipeline_run", func(t *testing.T) {
+		want := goldenPipelineRun()
+		if got := FirestoreToPipelineRun(checkGolden(t, "pipeline_run", PipelineRunToFirestore(want))); !proto.Equal(got, want) {
+			t.Errorf("run did not round-trip:\ngot:  %v\nwant: %v", got, want)
+		}
+	})
+	t.Run("pending_input", func(t *testing.T) {
+		want := goldenPendingInput()
+		if got := FirestoreToPendingInput(checkGolden(t, "pending_input", PendingInputToFirestore(want))); !proto.Equal(got, want) {
+			t.Errorf("pending input did not round-trip:\ngot:  %v\nwant: %v", got, want)
+		}
+	})
+}
+
+func TestProtoCodec_Presence(t *testing.T) {
+	m := PipelineRunToFirestore(&pbpipeline.PipelineRun{Id: "run-1"})
+
+	// Scalars without presence are stored even when zero, so queries match them
+	for _, key := range []string{"id", "pipeline_id", "status", "type"} {
+		if _, ok := m[key]; !ok {
+			t.Errorf("Expected %s to be stored", key)
+		}
+	}
+	for _, key := range []string{"status_message", "start_time", "boosters", "cost", "retry_attempts", "is_test", "pipeline_config_version"} {
+		if _, ok := m[key]; ok {
+			t.Errorf("Expected unset %s to be omitted, got %v", key, m[key])
+		}
+	}
+	if got := PipelineRunToFirestore(nil); len(got) != 0 {
+		t.Errorf("Expected a nil run to encode as an empty map, got %v", got)
+	}
+}
+
+func TestProtoCodec_DecodeStoredTypes(t *testing.T) {
+	run := FirestoreToPipelineRun(map[string]interface{}{
+		"id":                      "run-1",
+		"type":                    int64(pbactivity.ActivityType_ACTIVITY_TYPE_RUN),
+		"status":                  "pipeline_run_status_synced",
+		"pipeline_config_version": 4.0,
+		"created_at":              "2026-05-02T08:15:00Z",
+		"boosters": []map[string]interface{}{
+			{"provider_name": "weather", "duration_ms": 12.0, "metadata": map[string]string{"temp": "12C"}},
+		},
+		"destinations": []interface{}{
+			map[string]interface{}{"destination": "strava", "status": int64(2)},
+			map[string]interface{}{"destination": "not-a-destination"},
+			"not-a-map",
+		},
+		"cost":          map[string]interface{}{"image_generations": 2.0, "estimated_usd": int64(1)},
+		"unknown_field": true,
+	})
+
+	want := &pbpipeline.PipelineRun{
+		Id:                    "run-1",
+		Type:                  pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		Status:                pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED,
+		PipelineConfigVersion: 4,
+		CreatedAt:             goldenTime(8),
+		Boosters: []*pbpipeline.BoosterExecution{
+			{ProviderName: "weather", DurationMs: 12, Metadata: map[string]string{"temp": "12C"}},
+		},
+		Destinations: []*pbpipeline.DestinationOutcome{
+			{Destination: pbplugin.DestinationType_DESTINATION_STRAVA, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS},
+			{},
+		},
+		Cost: &pbpipeline.RunCost{ImageGenerations: 2, EstimatedUsd: 1},
+	}
+	if !proto.Equal(run, want) {
+		t.Errorf("got:  %v\nwant: %v", run, want)
+	}
+}
+
+func TestFirestoreToUser_Tier(t *testing.T) {
+	tests := []struct {
+		name string
+		tier interface{}
+		want pbuser.UserTier
+	}{
+		{"athlete", "athlete", pbuser.UserTier_USER_TIER_ATHLETE},
+		{"legacy pro", "pro", pbuser.UserTier_USER_TIER_ATHLETE},
+		{"legacy numeric athlete", int64(2), pbuser.UserTier_USER_TIER_ATHLETE},
+		{"legacy numeric hobbyist", int64(1), pbuser.UserTier_USER_TIER_HOBBYIST},
+		{"hobbyist", "hobbyist", pbuser.UserTier_USER_TIER_HOBBYIST},
+		{"missing", nil, pbuser.UserTier_USER_TIER_HOBBYIST},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := map[string]interface{}{"user_id": "user-1"}
+			if tt.tier != nil {
+				m["tier"] = tt.tier
+			}
+			if got := FirestoreToUser(m).Tier; got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFieldName_UnknownFieldPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic for an unknown field")
+		}
+	}()
+	FieldName(&pbpipeline.PipelineRun{}, "no_such_field")
+}
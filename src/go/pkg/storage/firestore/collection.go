@@ -9,10 +9,16 @@ import (
 type ToFirestoreFunc[T any] func(*T) map[string]interface{}
 type FromFirestoreFunc[T any] func(map[string]interface{}) *T
 
+// DocumentHook rewrites a raw document in place, after encoding on writes or
+// before decoding on reads.
+type DocumentHook func(ctx context.Context, data map[string]interface{}) error
+
 type Collection[T any] struct {
 	Ref           *firestore.CollectionRef
 	ToFirestore   ToFirestoreFunc[T]
 	FromFirestore FromFirestoreFunc[T]
+	BeforeWrite   DocumentHook
+	AfterRead     DocumentHook
 }
 
 func (c *Collection[T]) Doc(id string) *DocumentRef[T] {
@@ -20,6 +26,8 @@ func (c *Collection[T]) Doc(id string) *DocumentRef[T] {
 		Ref:           c.Ref.Doc(id),
 		ToFirestore:   c.ToFirestore,
 		FromFirestore: c.FromFirestore,
+		BeforeWrite:   c.BeforeWrite,
+		AfterRead:     c.AfterRead,
 	}
 }
 
@@ -28,6 +36,8 @@ func (c *Collection[T]) NewDoc() *DocumentRef[T] {
 		Ref:           c.Ref.NewDoc(),
 		ToFirestore:   c.ToFirestore,
 		FromFirestore: c.FromFirestore,
+		BeforeWrite:   c.BeforeWrite,
+		AfterRead:     c.AfterRead,
 	}
 }
 
@@ -35,6 +45,8 @@ type DocumentRef[T any] struct {
 	Ref           *firestore.DocumentRef
 	ToFirestore   ToFirestoreFunc[T]
 	FromFirestore FromFirestoreFunc[T]
+	BeforeWrite   DocumentHook
+	AfterRead     DocumentHook
 }
 
 func (d *DocumentRef[T]) ID() string {
@@ -46,11 +58,22 @@ func (d *DocumentRef[T]) Get(ctx context.Context) (*T, error) {
 	if err != nil {
 		return nil, err
 	}
-	return d.FromFirestore(snap.Data()), nil
+	m := snap.Data()
+	if d.AfterRead != nil {
+		if err := d.AfterRead(ctx, m); err != nil {
+			return nil, err
+		}
+	}
+	return d.FromFirestore(m), nil
 }
 
 func (d *DocumentRef[T]) Set(ctx context.Context, data *T) error {
 	m := d.ToFirestore(data)
+	if d.BeforeWrite != nil {
+		if err := d.BeforeWrite(ctx, m); err != nil {
+			return err
+		}
+	}
 	_, err := d.Ref.Set(ctx, m, firestore.MergeAll)
 	return err
 }
@@ -59,6 +82,11 @@ func (d *DocumentRef[T]) Update(ctx context.Context, updates map[string]interfac
 	// Simple map update - keys must match Firestore snake_case fields
 	// We do not run converter here because updates are often partials/dots
 	// If caller wants type safety, they should construct map carefully or we add TypedUpdate support later.
+	if d.BeforeWrite != nil {
+		if err := d.BeforeWrite(ctx, updates); err != nil {
+			return err
+		}
+	}
 	_, err := d.Ref.Set(ctx, updates, firestore.MergeAll)
 	return err
 }
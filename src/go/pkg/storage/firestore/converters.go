@@ -13,6 +13,7 @@ import (
 	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -44,6 +45,54 @@ func getBool(m map[string]interface{}, key string) bool {
 	return false
 }
 
+// Helper to safely get an optional int32 from map, returns nil when missing
+func getOptionalInt32(m map[string]interface{}, key string) *int32 {
+	var n int32
+	switch v := m[key].(type) {
+	case int32:
+		n = v
+	case int64:
+		n = int32(v)
+	case int:
+		n = int32(v)
+	case float64:
+		n = int32(v)
+	default:
+		return nil
+	}
+	return &n
+}
+
+// Helper to safely get an int64 from map, returns 0 when missing
+func getInt64(m map[string]interface{}, key string) int64 {
+	switch v := m[key].(type) {
+	case int64:
+		return v
+	case int32:
+		return int64(v)
+	case int:
+		return int64(v)
+	case float64:
+		return int64(v)
+	}
+	return 0
+}
+
+// Helper to safely get a float64 from map (Firestore returns whole numbers as int64)
+func getFloat64(m map[string]interface{}, key string) float64 {
+	switch v := m[key].(type) {
+	case float64:
+		return v
+	case int64:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int:
+		return float64(v)
+	}
+	return 0
+}
+
 // Helper to safely get string slice from map (handles Firestore's []interface{})
 func getStringSlice(m map[string]interface{}, key string) []string {
 	if v, ok := m[key].([]interface{}); ok {
@@ -71,472 +120,284 @@ func getTime(m map[string]interface{}, key string) *timestamppb.Timestamp {
 	return nil
 }
 
-// --- UserRecord Converters ---
-
-func UserToFirestore(u *user.Record) map[string]interface{} {
-	m := map[string]interface{}{
-		"user_id":    u.UserId,
-		"created_at": u.CreatedAt.AsTime(),
+// getTimeOrRFC3339 is getTime that also accepts RFC 3339 strings, which is how
+// documents written via protojson store timestamps.
+func getTimeOrRFC3339(m map[string]interface{}, key string) *timestamppb.Timestamp {
+	if ts := getTime(m, key); ts != nil {
+		return ts
 	}
-
-	if u.Integrations != nil {
-		integrations := make(map[string]interface{})
-		if u.Integrations.Hevy != nil {
-			integrations["hevy"] = map[string]interface{}{
-				"enabled": u.Integrations.Hevy.Enabled,
-				"api_key": u.Integrations.Hevy.ApiKey,
-				"user_id": u.Integrations.Hevy.UserId,
-			}
-		}
-		if u.Integrations.Fitbit != nil {
-			integrations["fitbit"] = map[string]interface{}{
-				"enabled":        u.Integrations.Fitbit.Enabled,
-				"access_token":   u.Integrations.Fitbit.AccessToken,
-				"refresh_token":  u.Integrations.Fitbit.RefreshToken,
-				"expires_at":     u.Integrations.Fitbit.ExpiresAt.AsTime(),
-				"fitbit_user_id": u.Integrations.Fitbit.FitbitUserId,
-			}
-		}
-		if u.Integrations.Strava != nil {
-			integrations["strava"] = map[string]interface{}{
-				"enabled":       u.Integrations.Strava.Enabled,
-				"access_token":  u.Integrations.Strava.AccessToken,
-				"refresh_token": u.Integrations.Strava.RefreshToken,
-				"expires_at":    u.Integrations.Strava.ExpiresAt.AsTime(),
-				"athlete_id":    u.Integrations.Strava.AthleteId,
-			}
-		}
-		if u.Integrations.Parkrun != nil {
-			integrations["parkrun"] = map[string]interface{}{
-				"enabled":       u.Integrations.Parkrun.Enabled,
-				"athlete_id":    u.Integrations.Parkrun.AthleteId,
-				"country_url":   u.Integrations.Parkrun.CountryUrl,
-				"consent_given": u.Integrations.Parkrun.ConsentGiven,
-				"created_at":    u.Integrations.Parkrun.CreatedAt.AsTime(),
-				"last_used_at":  u.Integrations.Parkrun.LastUsedAt.AsTime(),
-			}
-		}
-		if u.Integrations.Spotify != nil {
-			integrations["spotify"] = map[string]interface{}{
-				"enabled":         u.Integrations.Spotify.Enabled,
-				"access_token":    u.Integrations.Spotify.AccessToken,
-				"refresh_token":   u.Integrations.Spotify.RefreshToken,
-				"expires_at":      u.Integrations.Spotify.ExpiresAt.AsTime(),
-				"spotify_user_id": u.Integrations.Spotify.SpotifyUserId,
-				"created_at":      u.Integrations.Spotify.CreatedAt.AsTime(),
-				"last_used_at":    u.Integrations.Spotify.LastUsedAt.AsTime(),
-			}
-		}
-		if u.Integrations.Trainingpeaks != nil {
-			integrations["trainingpeaks"] = map[string]interface{}{
-				"enabled":       u.Integrations.Trainingpeaks.Enabled,
-				"access_token":  u.Integrations.Trainingpeaks.AccessToken,
-				"refresh_token": u.Integrations.Trainingpeaks.RefreshToken,
-				"expires_at":    u.Integrations.Trainingpeaks.ExpiresAt.AsTime(),
-				"athlete_id":    u.Integrations.Trainingpeaks.AthleteId,
-				"created_at":    u.Integrations.Trainingpeaks.CreatedAt.AsTime(),
-				"last_used_at":  u.Integrations.Trainingpeaks.LastUsedAt.AsTime(),
-			}
-		}
-		if u.Integrations.Intervals != nil {
-			integrations["intervals"] = map[string]interface{}{
-				"enabled":      u.Integrations.Intervals.Enabled,
-				"api_key":      u.Integrations.Intervals.ApiKey,
-				"athlete_id":   u.Integrations.Intervals.AthleteId,
-				"created_at":   u.Integrations.Intervals.CreatedAt.AsTime(),
-				"last_used_at": u.Integrations.Intervals.LastUsedAt.AsTime(),
-			}
-		}
-		if u.Integrations.Oura != nil {
-			integrations["oura"] = map[string]interface{}{
-				"enabled":       u.Integrations.Oura.Enabled,
-				"access_token":  u.Integrations.Oura.AccessToken,
-				"refresh_token": u.Integrations.Oura.RefreshToken,
-				"expires_at":    u.Integrations.Oura.ExpiresAt.AsTime(),
-				"oura_user_id":  u.Integrations.Oura.OuraUserId,
-				"created_at":    u.Integrations.Oura.CreatedAt.AsTime(),
-				"last_used_at":  u.Integrations.Oura.LastUsedAt.AsTime(),
-			}
-		}
-		if u.Integrations.Google != nil {
-			integrations["google"] = map[string]interface{}{
-				"enabled":        u.Integrations.Google.Enabled,
-				"access_token":   u.Integrations.Google.AccessToken,
-				"refresh_token":  u.Integrations.Google.RefreshToken,
-				"expires_at":     u.Integrations.Google.ExpiresAt.AsTime(),
-				"google_user_id": u.Integrations.Google.GoogleUserId,
-				"created_at":     u.Integrations.Google.CreatedAt.AsTime(),
-				"last_used_at":   u.Integrations.Google.LastUsedAt.AsTime(),
-			}
-		}
-		if u.Integrations.Polar != nil {
-			integrations["polar"] = map[string]interface{}{
-				"enabled":       u.Integrations.Polar.Enabled,
-				"access_token":  u.Integrations.Polar.AccessToken,
-				"refresh_token": u.Integrations.Polar.RefreshToken,
-				"expires_at":    u.Integrations.Polar.ExpiresAt.AsTime(),
-				"polar_user_id": u.Integrations.Polar.PolarUserId,
-				"created_at":    u.Integrations.Polar.CreatedAt.AsTime(),
-				"last_used_at":  u.Integrations.Polar.LastUsedAt.AsTime(),
-			}
-		}
-		if u.Integrations.Wahoo != nil {
-			integrations["wahoo"] = map[string]interface{}{
-				"enabled":       u.Integrations.Wahoo.Enabled,
-				"access_token":  u.Integrations.Wahoo.AccessToken,
-				"refresh_token": u.Integrations.Wahoo.RefreshToken,
-				"expires_at":    u.Integrations.Wahoo.ExpiresAt.AsTime(),
-				"wahoo_user_id": u.Integrations.Wahoo.WahooUserId,
-				"created_at":    u.Integrations.Wahoo.CreatedAt.AsTime(),
-				"last_used_at":  u.Integrations.Wahoo.LastUsedAt.AsTime(),
-			}
-		}
-		if u.Integrations.Github != nil {
-			integrations["github"] = map[string]interface{}{
-				"enabled":         u.Integrations.Github.Enabled,
-				"access_token":    u.Integrations.Github.AccessToken,
-				"refresh_token":   u.Integrations.Github.RefreshToken,
-				"expires_at":      u.Integrations.Github.ExpiresAt.AsTime(),
-				"github_user_id":  u.Integrations.Github.GithubUserId,
-				"github_username": u.Integrations.Github.GithubUsername,
-				"scope":           u.Integrations.Github.Scope,
-				"created_at":      u.Integrations.Github.CreatedAt.AsTime(),
-				"last_used_at":    u.Integrations.Github.LastUsedAt.AsTime(),
-			}
-		}
-		if u.Integrations.AppleHealth != nil {
-			integrations["apple_health"] = map[string]interface{}{
-				"enabled":      u.Integrations.AppleHealth.Enabled,
-				"created_at":   u.Integrations.AppleHealth.CreatedAt.AsTime(),
-				"last_used_at": u.Integrations.AppleHealth.LastUsedAt.AsTime(),
-			}
-		}
-		if u.Integrations.HealthConnect != nil {
-			integrations["health_connect"] = map[string]interface{}{
-				"enabled":      u.Integrations.HealthConnect.Enabled,
-				"created_at":   u.Integrations.HealthConnect.CreatedAt.AsTime(),
-				"last_used_at": u.Integrations.HealthConnect.LastUsedAt.AsTime(),
-			}
+	if s, ok := m[key].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+			return timestamppb.New(t)
 		}
-		m["integrations"] = integrations
 	}
+	return nil
+}
 
-	if len(u.FcmTokens) > 0 {
-		m["fcm_tokens"] = u.FcmTokens
-	}
+// --- UserRecord Converters ---
 
-	// Pipelines moved to sub-collection users/{userId}/pipelines
+// userCodec stores the profile at the top level of the user document and the
+// integrations under "integrations". Tiers are stored by name.
+var userCodec = &ProtoCodec{
+	Fields: map[protoreflect.FullName]FieldCodec{
+		FieldName(&pbuser.UserProfile{}, "tier"): {
+			Encode: func(v protoreflect.Value) interface{} {
+				if pbuser.UserTier(v.Enum()) == pbuser.UserTier_USER_TIER_ATHLETE {
+					return "athlete"
+				}
+				return "hobbyist"
+			},
+			Decode: func(raw interface{}) (protoreflect.Value, bool) {
+				tier := pbuser.UserTier_USER_TIER_HOBBYIST
+				switch val := raw.(type) {
+				case string:
+					if val == "athlete" || val == "pro" {
+						tier = pbuser.UserTier_USER_TIER_ATHLETE
+					}
+				default:
+					// Handle legacy numeric values (1=Hobbyist, 2=Athlete)
+					if n, ok := toFloat64(val); ok && n == 2 {
+						tier = pbuser.UserTier_USER_TIER_ATHLETE
+					}
+				}
+				return protoreflect.ValueOfEnum(tier.Number()), true
+			},
+		},
+	},
+}
 
-	// Tier management fields
-	if u.Tier == pbuser.UserTier_USER_TIER_ATHLETE {
-		m["tier"] = "athlete"
-	} else {
-		m["tier"] = "hobbyist"
-	}
-	if u.TrialEndsAt != nil {
-		m["trial_ends_at"] = u.TrialEndsAt.AsTime()
+func UserToFirestore(u *user.Record) map[string]interface{} {
+	m := userCodec.Encode(u.UserProfile)
+	if u.Integrations != nil {
+		m["integrations"] = userCodec.Encode(u.Integrations)
 	}
-	m["is_admin"] = u.IsAdmin
-	m["sync_count_this_month"] = u.SyncCountThisMonth
 	if u.Billing != nil {
 		m["stripe_customer_id"] = u.Billing.StripeCustomerId
 	}
-	m["access_enabled"] = u.AccessEnabled
-	m["prevented_sync_count"] = u.PreventedSyncCount
+
+	// Pipelines moved to sub-collection users/{userId}/pipelines
 
 	return m
 }
 
 func FirestoreToUser(m map[string]interface{}) *user.Record {
 	u := &user.Record{
-		UserProfile: &pbuser.UserProfile{
-			UserId:    getString(m, "user_id"),
-			CreatedAt: getTime(m, "created_at"),
-		},
+		UserProfile: &pbuser.UserProfile{},
 		Billing: &pbuser.SubscriptionState{
 			StripeCustomerId: getString(m, "stripe_customer_id"),
 		},
 	}
+	userCodec.Decode(m, u.UserProfile)
+	if u.Tier == pbuser.UserTier_USER_TIER_UNSPECIFIED {
+		u.Tier = pbuser.UserTier_USER_TIER_HOBBYIST
+	}
 
 	if iMap, ok := m["integrations"].(map[string]interface{}); ok {
 		u.Integrations = &pbuser.UserIntegrations{}
-		if hMap, ok := iMap["hevy"].(map[string]interface{}); ok {
-			u.Integrations.Hevy = &pbuser.HevyIntegration{
-				Enabled: getBool(hMap, "enabled"),
-				ApiKey:  getString(hMap, "api_key"),
-				UserId:  getString(hMap, "user_id"),
-			}
-		}
-		if fMap, ok := iMap["fitbit"].(map[string]interface{}); ok {
-			u.Integrations.Fitbit = &pbuser.FitbitIntegration{
-				Enabled:      getBool(fMap, "enabled"),
-				AccessToken:  getString(fMap, "access_token"),
-				RefreshToken: getString(fMap, "refresh_token"),
-				ExpiresAt:    getTime(fMap, "expires_at"),
-				FitbitUserId: getString(fMap, "fitbit_user_id"),
-			}
-		}
-		if sMap, ok := iMap["strava"].(map[string]interface{}); ok {
-			u.Integrations.Strava = &pbuser.StravaIntegration{
-				Enabled:      getBool(sMap, "enabled"),
-				AccessToken:  getString(sMap, "access_token"),
-				RefreshToken: getString(sMap, "refresh_token"),
-				ExpiresAt:    getTime(sMap, "expires_at"),
-			}
-			// Safe int64 conversion
-			if v, ok := sMap["athlete_id"]; ok {
-				// Firestore stores numbers as int64, float64 or int
-				switch n := v.(type) {
-				case int64:
-					u.Integrations.Strava.AthleteId = n
-				case int:
-					u.Integrations.Strava.AthleteId = int64(n)
-				case float64:
-					u.Integrations.Strava.AthleteId = int64(n)
-				}
-			}
-		}
-		if pMap, ok := iMap["parkrun"].(map[string]interface{}); ok {
-			u.Integrations.Parkrun = &pbuser.ParkrunIntegration{
-				Enabled:      getBool(pMap, "enabled"),
-				AthleteId:    getString(pMap, "athlete_id"),
-				CountryUrl:   getString(pMap, "country_url"),
-				ConsentGiven: getBool(pMap, "consent_given"),
-				CreatedAt:    getTime(pMap, "created_at"),
-				LastUsedAt:   getTime(pMap, "last_used_at"),
-			}
-		}
-		if spMap, ok := iMap["spotify"].(map[string]interface{}); ok {
-			u.Integrations.Spotify = &pbuser.SpotifyIntegration{
-				Enabled:       getBool(spMap, "enabled"),
-				AccessToken:   getString(spMap, "access_token"),
-				RefreshToken:  getString(spMap, "refresh_token"),
-				ExpiresAt:     getTime(spMap, "expires_at"),
-				SpotifyUserId: getString(spMap, "spotify_user_id"),
-				CreatedAt:     getTime(spMap, "created_at"),
-				LastUsedAt:    getTime(spMap, "last_used_at"),
-			}
-		}
-		if tpMap, ok := iMap["trainingpeaks"].(map[string]interface{}); ok {
-			u.Integrations.Trainingpeaks = &pbuser.TrainingPeaksIntegration{
-				Enabled:      getBool(tpMap, "enabled"),
-				AccessToken:  getString(tpMap, "access_token"),
-				RefreshToken: getString(tpMap, "refresh_token"),
-				ExpiresAt:    getTime(tpMap, "expires_at"),
-				AthleteId:    getString(tpMap, "athlete_id"),
-				CreatedAt:    getTime(tpMap, "created_at"),
-				LastUsedAt:   getTime(tpMap, "last_used_at"),
-			}
-		}
-		if ivMap, ok := iMap["intervals"].(map[string]interface{}); ok {
-			u.Integrations.Intervals = &pbuser.IntervalsIntegration{
-				Enabled:    getBool(ivMap, "enabled"),
-				ApiKey:     getString(ivMap, "api_key"),
-				AthleteId:  getString(ivMap, "athlete_id"),
-				CreatedAt:  getTime(ivMap, "created_at"),
-				LastUsedAt: getTime(ivMap, "last_used_at"),
-			}
-		}
-		if oMap, ok := iMap["oura"].(map[string]interface{}); ok {
-			u.Integrations.Oura = &pbuser.OuraIntegration{
-				Enabled:      getBool(oMap, "enabled"),
-				AccessToken:  getString(oMap, "access_token"),
-				RefreshToken: getString(oMap, "refresh_token"),
-				ExpiresAt:    getTime(oMap, "expires_at"),
-				OuraUserId:   getString(oMap, "oura_user_id"),
-				CreatedAt:    getTime(oMap, "created_at"),
-				LastUsedAt:   getTime(oMap, "last_used_at"),
-			}
+		userCodec.Decode(iMap, u.Integrations)
+	}
+
+	return u
+}
+
+// --- PipelineConfig Converters ---
+
+func PipelineToFirestore(p *pbpipeline.PipelineConfig) map[string]interface{} {
+	m := map[string]interface{}{
+		"id":           p.Id,
+		"name":         p.Name,
+		"source":       p.Source,
+		"destinations": p.Destinations,
+		"enrichers":    enrichersToFirestore(p.Enrichers),
+		"disabled":     p.Disabled,
+	}
+
+	// Source config
+	if len(p.SourceConfig) > 0 {
+		m["source_config"] = p.SourceConfig
+	}
+
+	// Destination configs
+	if len(p.DestinationConfigs) > 0 {
+		m["destination_configs"] = destinationConfigsToFirestore(p.DestinationConfigs)
+	}
+
+	// Race mode
+	if rm := p.RaceMode; rm != nil {
+		raceMode := map[string]interface{}{
+			"enabled":       rm.Enabled,
+			"skip_branding": rm.SkipBranding,
 		}
-		if gMap, ok := iMap["google"].(map[string]interface{}); ok {
-			u.Integrations.Google = &pbuser.GoogleIntegration{
-				Enabled:      getBool(gMap, "enabled"),
-				AccessToken:  getString(gMap, "access_token"),
-				RefreshToken: getString(gMap, "refresh_token"),
-				ExpiresAt:    getTime(gMap, "expires_at"),
-				GoogleUserId: getString(gMap, "google_user_id"),
-				CreatedAt:    getTime(gMap, "created_at"),
-				LastUsedAt:   getTime(gMap, "last_used_at"),
-			}
+		if rm.StartsAt != nil {
+			raceMode["starts_at"] = rm.StartsAt.AsTime()
 		}
-		if polMap, ok := iMap["polar"].(map[string]interface{}); ok {
-			u.Integrations.Polar = &pbuser.PolarIntegration{
-				Enabled:      getBool(polMap, "enabled"),
-				AccessToken:  getString(polMap, "access_token"),
-				RefreshToken: getString(polMap, "refresh_token"),
-				ExpiresAt:    getTime(polMap, "expires_at"),
-				PolarUserId:  getString(polMap, "polar_user_id"),
-				CreatedAt:    getTime(polMap, "created_at"),
-				LastUsedAt:   getTime(polMap, "last_used_at"),
-			}
+		if rm.EndsAt != nil {
+			raceMode["ends_at"] = rm.EndsAt.AsTime()
 		}
-		if wMap, ok := iMap["wahoo"].(map[string]interface{}); ok {
-			u.Integrations.Wahoo = &pbuser.WahooIntegration{
-				Enabled:      getBool(wMap, "enabled"),
-				AccessToken:  getString(wMap, "access_token"),
-				RefreshToken: getString(wMap, "refresh_token"),
-				ExpiresAt:    getTime(wMap, "expires_at"),
-				WahooUserId:  getString(wMap, "wahoo_user_id"),
-				CreatedAt:    getTime(wMap, "created_at"),
-				LastUsedAt:   getTime(wMap, "last_used_at"),
-			}
+		if len(rm.Enrichers) > 0 {
+			raceMode["enrichers"] = enrichersToFirestore(rm.Enrichers)
 		}
-		if ghMap, ok := iMap["github"].(map[string]interface{}); ok {
-			u.Integrations.Github = &pbuser.GitHubIntegration{
-				Enabled:        getBool(ghMap, "enabled"),
-				AccessToken:    getString(ghMap, "access_token"),
-				RefreshToken:   getString(ghMap, "refresh_token"),
-				ExpiresAt:      getTime(ghMap, "expires_at"),
-				GithubUserId:   getString(ghMap, "github_user_id"),
-				GithubUsername: getString(ghMap, "github_username"),
-				Scope:          getString(ghMap, "scope"),
-				CreatedAt:      getTime(ghMap, "created_at"),
-				LastUsedAt:     getTime(ghMap, "last_used_at"),
-			}
+		if len(rm.DestinationConfigs) > 0 {
+			raceMode["destination_configs"] = destinationConfigsToFirestore(rm.DestinationConfigs)
 		}
-		if ahMap, ok := iMap["apple_health"].(map[string]interface{}); ok {
-			u.Integrations.AppleHealth = &pbuser.AppleHealthIntegration{
-				Enabled:    getBool(ahMap, "enabled"),
-				CreatedAt:  getTime(ahMap, "created_at"),
-				LastUsedAt: getTime(ahMap, "last_used_at"),
-			}
+		m["race_mode"] = raceMode
+	}
+
+	if p.PausedUntil != nil {
+		m["paused_until"] = p.PausedUntil.AsTime()
+	}
+
+	if p.GetDescriptionTemplate() != "" {
+		m["description_template"] = p.GetDescriptionTemplate()
+	}
+
+	if p.HeartRateSource != pbpipeline.HeartRateSource_HEART_RATE_SOURCE_UNSPECIFIED {
+		m["heart_rate_source"] = int32(p.HeartRateSource)
+	}
+
+	if p.Version > 0 {
+		m["version"] = p.Version
+	}
+
+	return m
+}
+
+func enrichersToFirestore(list []*pbpipeline.EnricherConfig) []map[string]interface{} {
+	enrichers := make([]map[string]interface{}, len(list))
+	for i, e := range list {
+		enrichers[i] = map[string]interface{}{
+			"provider_type": int32(e.ProviderType),
+			"typed_config":  e.TypedConfig,
 		}
-		if hcMap, ok := iMap["health_connect"].(map[string]interface{}); ok {
-			u.Integrations.HealthConnect = &pbuser.HealthConnectIntegration{
-				Enabled:    getBool(hcMap, "enabled"),
-				CreatedAt:  getTime(hcMap, "created_at"),
-				LastUsedAt: getTime(hcMap, "last_used_at"),
-			}
+		if e.TimeoutSeconds > 0 {
+			enrichers[i]["timeout_seconds"] = e.TimeoutSeconds
 		}
 	}
+	return enrichers
+}
 
-	// Tier management fields
-	if v, ok := m["tier"]; ok {
-		switch val := v.(type) {
-		case string:
-			switch val {
-			case "athlete", "pro":
-				u.Tier = pbuser.UserTier_USER_TIER_ATHLETE
-			default:
-				u.Tier = pbuser.UserTier_USER_TIER_HOBBYIST
+func destinationConfigsToFirestore(configs map[string]*pbpipeline.DestinationConfig) map[string]interface{} {
+	destConfigs := make(map[string]interface{})
+	for k, v := range configs {
+		if v != nil {
+			dc := map[string]interface{}{
+				"config": v.Config,
 			}
-		case int64:
-			// Handle legacy numeric values (1=Hobbyist, 2=Athlete)
-			if val == 2 {
-				u.Tier = pbuser.UserTier_USER_TIER_ATHLETE
-			} else {
-				u.Tier = pbuser.UserTier_USER_TIER_HOBBYIST
+			if len(v.ExcludedEnrichers) > 0 {
+				dc["excluded_enrichers"] = v.ExcludedEnrichers
 			}
-		case int:
-			if val == 2 {
-				u.Tier = pbuser.UserTier_USER_TIER_ATHLETE
-			} else {
-				u.Tier = pbuser.UserTier_USER_TIER_HOBBYIST
+			if v.DescriptionTemplate != "" {
+				dc["description_template"] = v.DescriptionTemplate
 			}
-		case float64:
-			if val == 2 {
-				u.Tier = pbuser.UserTier_USER_TIER_ATHLETE
-			} else {
-				u.Tier = pbuser.UserTier_USER_TIER_HOBBYIST
+			if v.TitleTemplate != "" {
+				dc["title_template"] = v.TitleTemplate
 			}
-		default:
-			u.Tier = pbuser.UserTier_USER_TIER_HOBBYIST
-		}
-	} else {
-		u.Tier = pbuser.UserTier_USER_TIER_HOBBYIST
-	}
-	u.IsAdmin = getBool(m, "is_admin")
-	u.AccessEnabled = getBool(m, "access_enabled")
-	u.TrialEndsAt = getTime(m, "trial_ends_at")
-	u.SyncCountResetAt = getTime(m, "sync_count_reset_at")
-
-	if v, ok := m["sync_count_this_month"]; ok {
-		switch n := v.(type) {
-		case int64:
-			u.SyncCountThisMonth = int32(n)
-		case int:
-			u.SyncCountThisMonth = int32(n)
-		case float64:
-			u.SyncCountThisMonth = int32(n)
+			destConfigs[k] = dc
 		}
 	}
+	return destConfigs
+}
 
-	if v, ok := m["prevented_sync_count"]; ok {
-		switch n := v.(type) {
-		case int64:
-			u.PreventedSyncCount = int32(n)
-		case int:
-			u.PreventedSyncCount = int32(n)
-		case float64:
-			u.PreventedSyncCount = int32(n)
+func FirestoreToPipeline(m map[string]interface{}) *pbpipeline.PipelineConfig {
+	// Destinations - handle both legacy strings and new enum ints
+	var dests []pbplugin.DestinationType
+	if dList, ok := m["destinations"].([]interface{}); ok {
+		for _, d := range dList {
+			switch val := d.(type) {
+			case int64:
+				dests = append(dests, pbplugin.DestinationType(val))
+			case int:
+				dests = append(dests, pbplugin.DestinationType(val))
+			case float64:
+				dests = append(dests, pbplugin.DestinationType(int32(val)))
+			case string:
+				// String support: try proto enum name first, then short aliases
+				upper := strings.ToUpper(val)
+				if enumVal, ok := pbplugin.DestinationType_value[upper]; ok {
+					dests = append(dests, pbplugin.DestinationType(enumVal))
+				} else {
+					prefixed := "DESTINATION_" + upper
+					if enumVal, ok := pbplugin.DestinationType_value[prefixed]; ok {
+						dests = append(dests, pbplugin.DestinationType(enumVal))
+					}
+				}
+			}
 		}
 	}
 
-	if tokens, ok := m["fcm_tokens"].([]interface{}); ok {
-		u.FcmTokens = make([]string, len(tokens))
-		for i, v := range tokens {
+	// Source config
+	sourceConfig := make(map[string]string)
+	if scMap, ok := m["source_config"].(map[string]interface{}); ok {
+		for k, v := range scMap {
 			if s, ok := v.(string); ok {
-				u.FcmTokens[i] = s
+				sourceConfig[k] = s
 			}
 		}
-	} else if tokens, ok := m["fcm_tokens"].([]string); ok {
-		u.FcmTokens = tokens
 	}
 
-	// Pipelines moved to sub-collection users/{userId}/pipelines
-
-	return u
-}
-
-// --- PipelineConfig Converters ---
-
-func PipelineToFirestore(p *pbpipeline.PipelineConfig) map[string]interface{} {
-	enrichers := make([]map[string]interface{}, len(p.Enrichers))
-	for i, e := range p.Enrichers {
-		enrichers[i] = map[string]interface{}{
-			"provider_type": int32(e.ProviderType),
-			"typed_config":  e.TypedConfig,
+	// Race mode
+	var raceMode *pbpipeline.RaceModeConfig
+	if rmMap, ok := m["race_mode"].(map[string]interface{}); ok {
+		raceMode = &pbpipeline.RaceModeConfig{
+			Enabled:            getBool(rmMap, "enabled"),
+			StartsAt:           getTimeOrRFC3339(rmMap, "starts_at"),
+			EndsAt:             getTimeOrRFC3339(rmMap, "ends_at"),
+			Enrichers:          firestoreToEnrichers(rmMap["enrichers"]),
+			DestinationConfigs: firestoreToDestinationConfigs(rmMap["destination_configs"]),
+			SkipBranding:       getBool(rmMap, "skip_branding"),
 		}
 	}
 
-	m := map[string]interface{}{
-		"id":           p.Id,
-		"name":         p.Name,
-		"source":       p.Source,
-		"destinations": p.Destinations,
-		"enrichers":    enrichers,
-		"disabled":     p.Disabled,
+	// Description template: absent and empty both mean the default layout
+	var descriptionTemplate *string
+	if t := getString(m, "description_template"); t != "" {
+		descriptionTemplate = &t
 	}
 
-	// Source config
-	if len(p.SourceConfig) > 0 {
-		m["source_config"] = p.SourceConfig
+	// Heart rate source: a number, or an enum name when written via protojson
+	heartRateSource := pbpipeline.HeartRateSource_HEART_RATE_SOURCE_UNSPECIFIED
+	if n := getOptionalInt32(m, "heart_rate_source"); n != nil {
+		heartRateSource = pbpipeline.HeartRateSource(*n)
+	} else if val, ok := pbpipeline.HeartRateSource_value[getString(m, "heart_rate_source")]; ok {
+		heartRateSource = pbpipeline.HeartRateSource(val)
 	}
 
-	// Destination configs
-	if len(p.DestinationConfigs) > 0 {
-		destConfigs := make(map[string]interface{})
-		for k, v := range p.DestinationConfigs {
-			if v != nil {
-				dc := map[string]interface{}{
-					"config": v.Config,
-				}
-				if len(v.ExcludedEnrichers) > 0 {
-					dc["excluded_enrichers"] = v.ExcludedEnrichers
-				}
-				destConfigs[k] = dc
-			}
-		}
-		m["destination_configs"] = destConfigs
+	return &pbpipeline.PipelineConfig{
+		Id:                  getString(m, "id"),
+		Name:                getString(m, "name"),
+		Source:              getString(m, "source"),
+		Enrichers:           firestoreToEnrichers(m["enrichers"]),
+		Destinations:        dests,
+		Disabled:            getBool(m, "disabled"),
+		SourceConfig:        sourceConfig,
+		DestinationConfigs:  firestoreToDestinationConfigs(m["destination_configs"]),
+		RaceMode:            raceMode,
+		PausedUntil:         getTimeOrRFC3339(m, "paused_until"),
+		DescriptionTemplate: descriptionTemplate,
+		HeartRateSource:     heartRateSource,
+		Version:             int32(getInt64(m, "version")),
 	}
+}
 
-	return m
+// FirestoreToPipelineConfigVersion reads a snapshot from
+// users/{uid}/pipelines/{pipelineId}/versions/{version}.
+func FirestoreToPipelineConfigVersion(m map[string]interface{}) *pbpipeline.PipelineConfigVersion {
+	v := &pbpipeline.PipelineConfigVersion{
+		PipelineId: getString(m, "pipeline_id"),
+		Version:    int32(getInt64(m, "version")),
+		CreatedAt:  getTimeOrRFC3339(m, "created_at"),
+	}
+	if c, ok := m["config"].(map[string]interface{}); ok {
+		v.Config = FirestoreToPipeline(c)
+	}
+	return v
 }
 
-func FirestoreToPipeline(m map[string]interface{}) *pbpipeline.PipelineConfig {
-	// Enrichers
+// firestoreToEnrichers reads an enricher list. Provider types may be stored
+// as numbers or, when written via protojson, as enum names.
+func firestoreToEnrichers(raw interface{}) []*pbpipeline.EnricherConfig {
 	var enrichers []*pbpipeline.EnricherConfig
-	if eList, ok := m["enrichers"].([]interface{}); ok {
+	if eList, ok := raw.([]interface{}); ok {
 		enrichers = make([]*pbpipeline.EnricherConfig, len(eList))
 		for j, eRaw := range eList {
 			if eMap, ok := eRaw.(map[string]interface{}); ok {
@@ -570,49 +431,18 @@ func FirestoreToPipeline(m map[string]interface{}) *pbpipeline.PipelineConfig {
 					ProviderType: ptype,
 					TypedConfig:  typedConfig,
 				}
-			}
-		}
-	}
-
-	// Destinations - handle both legacy strings and new enum ints
-	var dests []pbplugin.DestinationType
-	if dList, ok := m["destinations"].([]interface{}); ok {
-		for _, d := range dList {
-			switch val := d.(type) {
-			case int64:
-				dests = append(dests, pbplugin.DestinationType(val))
-			case int:
-				dests = append(dests, pbplugin.DestinationType(val))
-			case float64:
-				dests = append(dests, pbplugin.DestinationType(int32(val)))
-			case string:
-				// String support: try proto enum name first, then short aliases
-				upper := strings.ToUpper(val)
-				if enumVal, ok := pbplugin.DestinationType_value[upper]; ok {
-					dests = append(dests, pbplugin.DestinationType(enumVal))
-				} else {
-					prefixed := "DESTINATION_" + upper
-					if enumVal, ok := pbplugin.DestinationType_value[prefixed]; ok {
-						dests = append(dests, pbplugin.DestinationType(enumVal))
-					}
+				if n := getOptionalInt32(eMap, "timeout_seconds"); n != nil {
+					enrichers[j].TimeoutSeconds = *n
 				}
 			}
 		}
 	}
+	return enrichers
+}
 
-	// Source config
-	sourceConfig := make(map[string]string)
-	if scMap, ok := m["source_config"].(map[string]interface{}); ok {
-		for k, v := range scMap {
-			if s, ok := v.(string); ok {
-				sourceConfig[k] = s
-			}
-		}
-	}
-
-	// Destination configs
+func firestoreToDestinationConfigs(raw interface{}) map[string]*pbpipeline.DestinationConfig {
 	destConfigs := make(map[string]*pbpipeline.DestinationConfig)
-	if dcMap, ok := m["destination_configs"].(map[string]interface{}); ok {
+	if dcMap, ok := raw.(map[string]interface{}); ok {
 		for destId, dcRaw := range dcMap {
 			if dcObj, ok := dcRaw.(map[string]interface{}); ok {
 				cfg := make(map[string]string)
@@ -624,23 +454,15 @@ func FirestoreToPipeline(m map[string]interface{}) *pbpipeline.PipelineConfig {
 					}
 				}
 				destConfigs[destId] = &pbpipeline.DestinationConfig{
-					Config:            cfg,
-					ExcludedEnrichers: getStringSlice(dcObj, "excluded_enrichers"),
+					Config:              cfg,
+					ExcludedEnrichers:   getStringSlice(dcObj, "excluded_enrichers"),
+					DescriptionTemplate: getString(dcObj, "description_template"),
+					TitleTemplate:       getString(dcObj, "title_template"),
 				}
 			}
 		}
 	}
-
-	return &pbpipeline.PipelineConfig{
-		Id:                 getString(m, "id"),
-		Name:               getString(m, "name"),
-		Source:             getString(m, "source"),
-		Enrichers:          enrichers,
-		Destinations:       dests,
-		Disabled:           getBool(m, "disabled"),
-		SourceConfig:       sourceConfig,
-		DestinationConfigs: destConfigs,
-	}
+	return destConfigs
 }
 
 // --- Execution Record ---
@@ -746,6 +568,15 @@ func PersonalRecordToFirestore(r *pbuser.PersonalRecord) map[string]interface{}
 	if r.Improvement != nil {
 		m["improvement"] = *r.Improvement
 	}
+	if r.EstimationFormula != nil {
+		m["estimation_formula"] = *r.EstimationFormula
+	}
+	if r.SourceWeightKg != nil {
+		m["source_weight_kg"] = *r.SourceWeightKg
+	}
+	if r.SourceReps != nil {
+		m["source_reps"] = *r.SourceReps
+	}
 	return m
 }
 
@@ -813,111 +644,187 @@ func FirestoreToPersonalRecord(m map[string]interface{}) *pbuser.PersonalRecord
 		}
 	}
 
+	// Optional 1RM estimation evidence
+	if v, ok := m["estimation_formula"].(string); ok {
+		r.EstimationFormula = &v
+	}
+	if v, ok := m["source_weight_kg"]; ok {
+		switch n := v.(type) {
+		case float64:
+			r.SourceWeightKg = &n
+		case int64:
+			f := float64(n)
+			r.SourceWeightKg = &f
+		case int:
+			f := float64(n)
+			r.SourceWeightKg = &f
+		}
+	}
+	if v, ok := m["source_reps"]; ok {
+		switch n := v.(type) {
+		case int64:
+			i := int32(n)
+			r.SourceReps = &i
+		case int:
+			i := int32(n)
+			r.SourceReps = &i
+		case float64:
+			i := int32(n)
+			r.SourceReps = &i
+		}
+	}
+
 	return r
 }
 
-// --- PendingInput Converters ---
+// --- Gear Converters ---
 
-func PendingInputToFirestore(p *pbpipeline.PendingInput) map[string]interface{} {
+func GearToFirestore(g *pbuser.Gear) map[string]interface{} {
 	m := map[string]interface{}{
-		"activity_id":                  p.ActivityId,
-		"user_id":                      p.UserId,
-		"status":                       int32(p.Status),
-		"required_fields":              p.RequiredFields,
-		"input_data":                   p.InputData,
-		"created_at":                   p.CreatedAt.AsTime(),
-		"updated_at":                   p.UpdatedAt.AsTime(),
-		"completed_at":                 p.CompletedAt.AsTime(),
-		"auto_populated":               p.AutoPopulated,
-		"continued_without_resolution": p.ContinuedWithoutResolution,
-		"enricher_provider_id":         p.EnricherProviderId,
-		"linked_activity_id":           p.LinkedActivityId,
-		"pipeline_id":                  p.PipelineId,
+		"id":                      g.Id,
+		"name":                    g.Name,
+		"type":                    int32(g.Type),
+		"distance_meters":         g.DistanceMeters,
+		"initial_distance_meters": g.InitialDistanceMeters,
+		"activity_count":          g.ActivityCount,
+		"retired":                 g.Retired,
+		"last_activity_id":        g.LastActivityId,
 	}
-
-	if p.AutoDeadline != nil {
-		m["auto_deadline"] = p.AutoDeadline.AsTime()
+	if g.CreatedAt != nil {
+		m["created_at"] = g.CreatedAt.AsTime()
 	}
-
-	if p.OriginalPayloadUri != "" {
-		m["original_payload_uri"] = p.OriginalPayloadUri
+	if g.LastUsedAt != nil {
+		m["last_used_at"] = g.LastUsedAt.AsTime()
 	}
+	return m
+}
 
-	// Provider metadata
-	if len(p.ProviderMetadata) > 0 {
-		m["provider_metadata"] = p.ProviderMetadata
+func FirestoreToGear(m map[string]interface{}) *pbuser.Gear {
+	g := &pbuser.Gear{
+		Id:             getString(m, "id"),
+		Name:           getString(m, "name"),
+		Retired:        getBool(m, "retired"),
+		LastActivityId: getString(m, "last_activity_id"),
+		CreatedAt:      getTimeOrRFC3339(m, "created_at"),
+		LastUsedAt:     getTimeOrRFC3339(m, "last_used_at"),
+	}
+
+	switch v := m["type"].(type) {
+	case int32:
+		g.Type = pbuser.GearType(v)
+	case int64:
+		g.Type = pbuser.GearType(v)
+	case int:
+		g.Type = pbuser.GearType(int32(v))
+	case float64:
+		g.Type = pbuser.GearType(int32(v))
+	case string:
+		if enumVal, ok := pbuser.GearType_value[v]; ok {
+			g.Type = pbuser.GearType(enumVal)
+		}
+	}
+
+	g.DistanceMeters = getFloat64(m, "distance_meters")
+	g.InitialDistanceMeters = getFloat64(m, "initial_distance_meters")
+	if n := getOptionalInt32(m, "activity_count"); n != nil {
+		g.ActivityCount = *n
+	}
+	return g
+}
+
+// --- Goal Converters ---
+
+func GoalToFirestore(g *pbuser.Goal) map[string]interface{} {
+	m := map[string]interface{}{
+		"id":               g.Id,
+		"name":             g.Name,
+		"metric":           int32(g.Metric),
+		"target":           g.Target,
+		"activity_type":    int32(g.ActivityType),
+		"progress":         g.Progress,
+		"activity_count":   g.ActivityCount,
+		"last_activity_id": g.LastActivityId,
+	}
+	if g.StartDate != nil {
+		m["start_date"] = g.StartDate.AsTime()
+	}
+	if g.EndDate != nil {
+		m["end_date"] = g.EndDate.AsTime()
+	}
+	if g.CreatedAt != nil {
+		m["created_at"] = g.CreatedAt.AsTime()
+	}
+	if g.CompletedAt != nil {
+		m["completed_at"] = g.CompletedAt.AsTime()
 	}
 	return m
 }
 
-func FirestoreToPendingInput(m map[string]interface{}) *pbpipeline.PendingInput {
-	p := &pbpipeline.PendingInput{
-		ActivityId: getString(m, "activity_id"),
-		UserId:     getString(m, "user_id"),
-		RequiredFields: func() []string {
-			if v, ok := m["required_fields"].([]string); ok {
-				return v
-			}
-			// Handle []interface{} from Firestore
-			if v, ok := m["required_fields"].([]interface{}); ok {
-				strs := make([]string, len(v))
-				for i, s := range v {
-					if str, ok := s.(string); ok {
-						strs[i] = str
-					}
-				}
-				return strs
-			}
-			return nil
-		}(),
-		InputData: func() map[string]string {
-			if v, ok := m["input_data"].(map[string]interface{}); ok {
-				out := make(map[string]string)
-				for k, val := range v {
-					if str, ok := val.(string); ok {
-						out[k] = str
-					}
-				}
-				return out
-			}
-			return nil
-		}(),
-		CreatedAt:                  getTime(m, "created_at"),
-		UpdatedAt:                  getTime(m, "updated_at"),
-		CompletedAt:                getTime(m, "completed_at"),
-		AutoPopulated:              getBool(m, "auto_populated"),
-		ContinuedWithoutResolution: getBool(m, "continued_without_resolution"),
-		EnricherProviderId:         getString(m, "enricher_provider_id"),
-		AutoDeadline:               getTime(m, "auto_deadline"),
-		LinkedActivityId:           getString(m, "linked_activity_id"),
-		PipelineId:                 getString(m, "pipeline_id"),
-		OriginalPayloadUri:         getString(m, "original_payload_uri"),
-	}
+func FirestoreToGoal(m map[string]interface{}) *pbuser.Goal {
+	g := &pbuser.Goal{
+		Id:             getString(m, "id"),
+		Name:           getString(m, "name"),
+		Target:         getFloat64(m, "target"),
+		Progress:       getFloat64(m, "progress"),
+		LastActivityId: getString(m, "last_activity_id"),
+		StartDate:      getTimeOrRFC3339(m, "start_date"),
+		EndDate:        getTimeOrRFC3339(m, "end_date"),
+		CreatedAt:      getTimeOrRFC3339(m, "created_at"),
+		CompletedAt:    getTimeOrRFC3339(m, "completed_at"),
+	}
+
+	switch v := m["metric"].(type) {
+	case int32:
+		g.Metric = pbuser.GoalMetric(v)
+	case int64:
+		g.Metric = pbuser.GoalMetric(v)
+	case int:
+		g.Metric = pbuser.GoalMetric(int32(v))
+	case float64:
+		g.Metric = pbuser.GoalMetric(int32(v))
+	case string:
+		if enumVal, ok := pbuser.GoalMetric_value[v]; ok {
+			g.Metric = pbuser.GoalMetric(enumVal)
+		}
+	}
+
+	switch v := m["activity_type"].(type) {
+	case int32:
+		g.ActivityType = pbactivity.ActivityType(v)
+	case int64:
+		g.ActivityType = pbactivity.ActivityType(v)
+	case int:
+		g.ActivityType = pbactivity.ActivityType(int32(v))
+	case float64:
+		g.ActivityType = pbactivity.ActivityType(int32(v))
+	case string:
+		if enumVal, ok := pbactivity.ActivityType_value[v]; ok {
+			g.ActivityType = pbactivity.ActivityType(enumVal)
+		}
+	}
+
+	if n := getOptionalInt32(m, "activity_count"); n != nil {
+		g.ActivityCount = *n
+	}
+	return g
+}
 
-	if v, ok := m["status"]; ok {
-		switch n := v.(type) {
-		case int64:
-			p.Status = pbpipeline.PendingInput_Status(n)
-		case int:
-			p.Status = pbpipeline.PendingInput_Status(int32(n))
-		case string:
-			if enumVal, ok := pbpipeline.PendingInput_Status_value[n]; ok {
-				p.Status = pbpipeline.PendingInput_Status(enumVal)
-			}
-		}
-	}
+// --- PendingInput Converters ---
 
-	// Note: original_payload is now stored in GCS via original_payload_uri
+// Note: original_payload is stored in GCS via original_payload_uri
+var pendingInputCodec = &ProtoCodec{
+	OmitEmpty: map[protoreflect.FullName]bool{
+		FieldName(&pbpipeline.PendingInput{}, "original_payload_uri"): true,
+	},
+}
 
-	// Provider metadata
-	if v, ok := m["provider_metadata"].(map[string]interface{}); ok {
-		p.ProviderMetadata = make(map[string]string)
-		for k, val := range v {
-			if str, ok := val.(string); ok {
-				p.ProviderMetadata[k] = str
-			}
-		}
-	}
+func PendingInputToFirestore(p *pbpipeline.PendingInput) map[string]interface{} {
+	return pendingInputCodec.Encode(p)
+}
+
+func FirestoreToPendingInput(m map[string]interface{}) *pbpipeline.PendingInput {
+	p := &pbpipeline.PendingInput{}
+	pendingInputCodec.Decode(m, p)
 	return p
 }
 
@@ -1075,6 +982,7 @@ func ShowcaseProfileEntryToFirestore(e *pbactivity.ShowcaseProfileEntry) map[str
 		"total_sets":          e.TotalSets,
 		"total_reps":          e.TotalReps,
 		"total_weight_kg":     e.TotalWeightKg,
+		"route_key":           e.RouteKey,
 	}
 	if e.StartTime != nil {
 		m["start_time"] = e.StartTime.AsTime()
@@ -1090,6 +998,7 @@ func FirestoreToShowcaseProfileEntry(m map[string]interface{}) *pbactivity.Showc
 		Title:             getString(m, "title"),
 		RouteThumbnailUrl: getString(m, "route_thumbnail_url"),
 		StartTime:         getTime(m, "start_time"),
+		RouteKey:          getString(m, "route_key"),
 	}
 
 	// ActivityType
@@ -1436,222 +1345,353 @@ func FirestoreToUploadedActivity(m map[string]interface{}) *pbactivity.UploadedA
 
 // --- PipelineRun Converters ---
 
+// destinationTypeField also reads destinations stored by their short names,
+// like "strava".
+var destinationTypeField = FieldCodec{
+	Encode: func(v protoreflect.Value) interface{} {
+		return int32(v.Enum())
+	},
+	Decode: func(raw interface{}) (protoreflect.Value, bool) {
+		ed := pbplugin.DestinationType(0).Descriptor()
+		if n, ok := decodeEnum(ed, raw); ok {
+			return protoreflect.ValueOfEnum(n), true
+		}
+		if s, ok := raw.(string); ok {
+			if n, ok := decodeEnum(ed, "DESTINATION_"+s); ok {
+				return protoreflect.ValueOfEnum(n), true
+			}
+		}
+		return protoreflect.Value{}, false
+	},
+}
+
+// pipelineRunCodec also covers the boosters, destinations and cost nested in
+// a run.
+var pipelineRunCodec = &ProtoCodec{
+	Fields: map[protoreflect.FullName]FieldCodec{
+		FieldName(&pbpipeline.DestinationOutcome{}, "destination"): destinationTypeField,
+	},
+	OmitEmpty: map[protoreflect.FullName]bool{
+		// Note: original_payload and enriched_event are stored in GCS via these URIs
+		FieldName(&pbpipeline.PipelineRun{}, "original_payload_uri"):         true,
+		FieldName(&pbpipeline.PipelineRun{}, "enriched_event_uri"):           true,
+		FieldName(&pbpipeline.PipelineRun{}, "pipeline_config_version"):      true,
+		FieldName(&pbpipeline.PipelineRun{}, "is_test"):                      true,
+		FieldName(&pbpipeline.BoosterExecution{}, "contributed_description"): true,
+	},
+	Skip: map[protoreflect.FullName]bool{
+		// The owner is the document's parent, filled in by admin listings
+		FieldName(&pbpipeline.PipelineRun{}, "user_id"): true,
+	},
+}
+
 func PipelineRunToFirestore(p *pbpipeline.PipelineRun) map[string]interface{} {
+	return pipelineRunCodec.Encode(p)
+}
+
+func FirestoreToPipelineRun(m map[string]interface{}) *pbpipeline.PipelineRun {
+	p := &pbpipeline.PipelineRun{}
+	pipelineRunCodec.Decode(m, p)
+	return p
+}
+
+func RunCostToFirestore(c *pbpipeline.RunCost) map[string]interface{} {
+	return pipelineRunCodec.Encode(c)
+}
+
+// FirestoreToRunCost reads a cost map built up with increments, so any field
+// may come back as an integer or a float
+func FirestoreToRunCost(m map[string]interface{}) *pbpipeline.RunCost {
+	c := &pbpipeline.RunCost{}
+	pipelineRunCodec.Decode(m, c)
+	return c
+}
+
+// DestinationApiCallsToFirestore encodes an outcome's API call summaries,
+// always returning a list so a write replaces the previous attempt's calls
+func DestinationApiCallsToFirestore(calls []*pbpipeline.DestinationApiCall) []interface{} {
+	list := make([]interface{}, 0, len(calls))
+	for _, c := range calls {
+		list = append(list, pipelineRunCodec.Encode(c))
+	}
+	return list
+}
+
+func FirestoreToDestinationApiCalls(raw interface{}) []*pbpipeline.DestinationApiCall {
+	list, _ := raw.([]interface{})
+	calls := make([]*pbpipeline.DestinationApiCall, 0, len(list))
+	for _, item := range list {
+		if m, ok := item.(map[string]interface{}); ok {
+			c := &pbpipeline.DestinationApiCall{}
+			pipelineRunCodec.Decode(m, c)
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// --- ActivityTypeRule Converters ---
+
+func ActivityTypeRuleToFirestore(r *pbpipeline.ActivityTypeRule) map[string]interface{} {
 	m := map[string]interface{}{
-		"id":                 p.Id,
-		"pipeline_id":        p.PipelineId,
-		"activity_id":        p.ActivityId,
-		"source":             p.Source,
-		"source_activity_id": p.SourceActivityId,
-		"title":              p.Title,
-		"description":        p.Description,
-		"type":               int32(p.Type),
-		"status":             int32(p.Status),
+		"id":               r.Id,
+		"source":           r.Source,
+		"title_pattern":    r.TitlePattern,
+		"target_type":      int32(r.TargetType),
+		"correction_count": r.CorrectionCount,
+		"disabled":         r.Disabled,
+		"applied_count":    r.AppliedCount,
+	}
+	if r.CreatedAt != nil {
+		m["created_at"] = r.CreatedAt.AsTime()
+	}
+	if r.UpdatedAt != nil {
+		m["updated_at"] = r.UpdatedAt.AsTime()
+	}
+	if r.LastAppliedAt != nil {
+		m["last_applied_at"] = r.LastAppliedAt.AsTime()
 	}
+	return m
+}
 
-	if p.StartTime != nil {
-		m["start_time"] = p.StartTime.AsTime()
+func FirestoreToActivityTypeRule(m map[string]interface{}) *pbpipeline.ActivityTypeRule {
+	r := &pbpipeline.ActivityTypeRule{
+		Id:            getString(m, "id"),
+		Source:        getString(m, "source"),
+		TitlePattern:  getString(m, "title_pattern"),
+		Disabled:      getBool(m, "disabled"),
+		CreatedAt:     getTime(m, "created_at"),
+		UpdatedAt:     getTime(m, "updated_at"),
+		LastAppliedAt: getTime(m, "last_applied_at"),
 	}
-	if p.CreatedAt != nil {
-		m["created_at"] = p.CreatedAt.AsTime()
+	if v := getOptionalInt32(m, "target_type"); v != nil {
+		r.TargetType = pbactivity.ActivityType(*v)
 	}
-	if p.UpdatedAt != nil {
-		m["updated_at"] = p.UpdatedAt.AsTime()
+	if v := getOptionalInt32(m, "correction_count"); v != nil {
+		r.CorrectionCount = *v
 	}
-	if p.StatusMessage != nil {
-		m["status_message"] = *p.StatusMessage
+	if v := getOptionalInt32(m, "applied_count"); v != nil {
+		r.AppliedCount = *v
 	}
-	if p.PendingInputId != nil {
-		m["pending_input_id"] = *p.PendingInputId
+	return r
+}
+
+// --- ProviderCircuit Converters ---
+
+func ProviderCircuitToFirestore(c *pbpipeline.ProviderCircuit) map[string]interface{} {
+	m := map[string]interface{}{
+		"provider_type":        int32(c.ProviderType),
+		"consecutive_failures": c.ConsecutiveFailures,
+		"mode":                 int32(c.Mode),
+		"total_calls":          c.TotalCalls,
+		"total_failures":       c.TotalFailures,
+		"times_opened":         c.TimesOpened,
+	}
+	if c.LastError != nil {
+		m["last_error"] = *c.LastError
+	}
+	if c.ModeReason != nil {
+		m["mode_reason"] = *c.ModeReason
+	}
+	if c.ModeSetBy != nil {
+		m["mode_set_by"] = *c.ModeSetBy
 	}
-	if p.OriginalPayloadUri != "" {
-		m["original_payload_uri"] = p.OriginalPayloadUri
+	if c.OpenedAt != nil {
+		m["opened_at"] = c.OpenedAt.AsTime()
 	}
+	if c.OpenUntil != nil {
+		m["open_until"] = c.OpenUntil.AsTime()
+	}
+	if c.UpdatedAt != nil {
+		m["updated_at"] = c.UpdatedAt.AsTime()
+	}
+	return m
+}
 
-	// Serialize boosters
-	if len(p.Boosters) > 0 {
-		boosters := make([]map[string]interface{}, len(p.Boosters))
-		for i, b := range p.Boosters {
-			booster := map[string]interface{}{
-				"provider_name": b.ProviderName,
-				"status":        b.Status,
-				"duration_ms":   b.DurationMs,
-				"metadata":      b.Metadata,
-			}
-			if b.Error != nil {
-				booster["error"] = *b.Error
-			}
-			boosters[i] = booster
-		}
-		m["boosters"] = boosters
+func FirestoreToProviderCircuit(m map[string]interface{}) *pbpipeline.ProviderCircuit {
+	c := &pbpipeline.ProviderCircuit{
+		OpenedAt:      getTime(m, "opened_at"),
+		OpenUntil:     getTime(m, "open_until"),
+		UpdatedAt:     getTime(m, "updated_at"),
+		TotalCalls:    getInt64(m, "total_calls"),
+		TotalFailures: getInt64(m, "total_failures"),
 	}
+	if v := getOptionalInt32(m, "provider_type"); v != nil {
+		c.ProviderType = pbplugin.EnricherProviderType(*v)
+	}
+	if v := getOptionalInt32(m, "consecutive_failures"); v != nil {
+		c.ConsecutiveFailures = *v
+	}
+	if v, ok := m["last_error"].(string); ok {
+		c.LastError = &v
+	}
+	if v := getOptionalInt32(m, "mode"); v != nil {
+		c.Mode = pbpipeline.ProviderCircuitMode(*v)
+	}
+	if v, ok := m["mode_reason"].(string); ok {
+		c.ModeReason = &v
+	}
+	if v, ok := m["mode_set_by"].(string); ok {
+		c.ModeSetBy = &v
+	}
+	if v := getOptionalInt32(m, "times_opened"); v != nil {
+		c.TimesOpened = *v
+	}
+	return c
+}
 
-	// Serialize destinations
-	if len(p.Destinations) > 0 {
-		dests := make([]map[string]interface{}, len(p.Destinations))
-		for i, d := range p.Destinations {
-			dest := map[string]interface{}{
-				"destination": int32(d.Destination),
-				"status":      int32(d.Status),
-			}
-			if d.ExternalId != nil {
-				dest["external_id"] = *d.ExternalId
-			}
-			if d.Error != nil {
-				dest["error"] = *d.Error
-			}
-			if d.CompletedAt != nil {
-				dest["completed_at"] = d.CompletedAt.AsTime()
-			}
-			dests[i] = dest
-		}
-		m["destinations"] = dests
+func ProviderCircuitChangeToFirestore(c *pbpipeline.ProviderCircuitChange) map[string]interface{} {
+	m := map[string]interface{}{
+		"id":            c.Id,
+		"provider_type": int32(c.ProviderType),
+		"event":         int32(c.Event),
+		"mode":          int32(c.Mode),
+		"actor":         c.Actor,
 	}
+	if c.Reason != nil {
+		m["reason"] = *c.Reason
+	}
+	if c.CreatedAt != nil {
+		m["created_at"] = c.CreatedAt.AsTime()
+	}
+	return m
+}
 
-	// Note: enriched_event is now stored in GCS via enriched_event_uri
-	if p.EnrichedEventUri != "" {
-		m["enriched_event_uri"] = p.EnrichedEventUri
+func FirestoreToProviderCircuitChange(m map[string]interface{}) *pbpipeline.ProviderCircuitChange {
+	c := &pbpipeline.ProviderCircuitChange{
+		Id:        getString(m, "id"),
+		Actor:     getString(m, "actor"),
+		CreatedAt: getTime(m, "created_at"),
+	}
+	if v := getOptionalInt32(m, "provider_type"); v != nil {
+		c.ProviderType = pbplugin.EnricherProviderType(*v)
+	}
+	if v := getOptionalInt32(m, "event"); v != nil {
+		c.Event = pbpipeline.ProviderCircuitEvent(*v)
+	}
+	if v := getOptionalInt32(m, "mode"); v != nil {
+		c.Mode = pbpipeline.ProviderCircuitMode(*v)
+	}
+	if v, ok := m["reason"].(string); ok {
+		c.Reason = &v
 	}
-	// Note: original_payload is now stored in GCS via original_payload_uri
+	return c
+}
 
+// --- EnricherResultShare Converters ---
+
+func EnricherResultShareToFirestore(s *pbpipeline.EnricherResultShare) map[string]interface{} {
+	m := map[string]interface{}{
+		"id":                   s.Id,
+		"fan_out_id":           s.FanOutId,
+		"activity_external_id": s.ActivityExternalId,
+		"provider_type":        int32(s.ProviderType),
+		"config_hash":          s.ConfigHash,
+		"pipeline_id":          s.PipelineId,
+		"description":          s.Description,
+		"section_header":       s.SectionHeader,
+		"name":                 s.Name,
+		"name_suffix":          s.NameSuffix,
+		"tags":                 s.Tags,
+		"metadata":             s.Metadata,
+	}
+	if s.CreatedAt != nil {
+		m["created_at"] = s.CreatedAt.AsTime()
+	}
+	if s.ExpiresAt != nil {
+		m["expires_at"] = s.ExpiresAt.AsTime()
+	}
 	return m
 }
 
-func FirestoreToPipelineRun(m map[string]interface{}) *pbpipeline.PipelineRun {
-	p := &pbpipeline.PipelineRun{
+func FirestoreToEnricherResultShare(m map[string]interface{}) *pbpipeline.EnricherResultShare {
+	s := &pbpipeline.EnricherResultShare{
 		Id:                 getString(m, "id"),
+		FanOutId:           getString(m, "fan_out_id"),
+		ActivityExternalId: getString(m, "activity_external_id"),
+		ConfigHash:         getString(m, "config_hash"),
 		PipelineId:         getString(m, "pipeline_id"),
-		ActivityId:         getString(m, "activity_id"),
-		Source:             getString(m, "source"),
-		SourceActivityId:   getString(m, "source_activity_id"),
-		Title:              getString(m, "title"),
 		Description:        getString(m, "description"),
-		StartTime:          getTime(m, "start_time"),
+		SectionHeader:      getString(m, "section_header"),
+		Name:               getString(m, "name"),
+		NameSuffix:         getString(m, "name_suffix"),
+		Tags:               getStringSlice(m, "tags"),
 		CreatedAt:          getTime(m, "created_at"),
-		UpdatedAt:          getTime(m, "updated_at"),
-		StatusMessage:      stringPtrOrNil(getString(m, "status_message")),
-		PendingInputId:     stringPtrOrNil(getString(m, "pending_input_id")),
-		OriginalPayloadUri: getString(m, "original_payload_uri"),
+		ExpiresAt:          getTime(m, "expires_at"),
 	}
-
-	// Type
-	if v, ok := m["type"]; ok {
-		switch val := v.(type) {
-		case int64:
-			p.Type = pbactivity.ActivityType(val)
-		case int:
-			p.Type = pbactivity.ActivityType(int32(val))
-		case float64:
-			p.Type = pbactivity.ActivityType(int32(val))
-		case string:
-			if enumVal, ok := pbactivity.ActivityType_value[val]; ok {
-				p.Type = pbactivity.ActivityType(enumVal)
-			}
-		}
+	if v := getOptionalInt32(m, "provider_type"); v != nil {
+		s.ProviderType = pbplugin.EnricherProviderType(*v)
 	}
-
-	// Status
-	if v, ok := m["status"]; ok {
-		switch val := v.(type) {
-		case int64:
-			p.Status = pbpipeline.PipelineRunStatus(val)
-		case int:
-			p.Status = pbpipeline.PipelineRunStatus(int32(val))
-		case float64:
-			p.Status = pbpipeline.PipelineRunStatus(int32(val))
-		case string:
-			if enumVal, ok := pbpipeline.PipelineRunStatus_value[val]; ok {
-				p.Status = pbpipeline.PipelineRunStatus(enumVal)
+	if v, ok := m["metadata"].(map[string]interface{}); ok {
+		s.Metadata = make(map[string]string)
+		for k, val := range v {
+			if str, ok := val.(string); ok {
+				s.Metadata[k] = str
 			}
 		}
 	}
+	return s
+}
 
-	// Boosters
-	if bList, ok := m["boosters"].([]interface{}); ok {
-		p.Boosters = make([]*pbpipeline.BoosterExecution, len(bList))
-		for i, bRaw := range bList {
-			if bMap, ok := bRaw.(map[string]interface{}); ok {
-				booster := &pbpipeline.BoosterExecution{
-					ProviderName: getString(bMap, "provider_name"),
-					Status:       getString(bMap, "status"),
-					Error:        stringPtrOrNil(getString(bMap, "error")),
-				}
-				if v, ok := bMap["duration_ms"]; ok {
-					switch n := v.(type) {
-					case int64:
-						booster.DurationMs = n
-					case int:
-						booster.DurationMs = int64(n)
-					case float64:
-						booster.DurationMs = int64(n)
-					}
-				}
-				if meta, ok := bMap["metadata"].(map[string]interface{}); ok {
-					booster.Metadata = make(map[string]string)
-					for k, v := range meta {
-						if s, ok := v.(string); ok {
-							booster.Metadata[k] = s
-						}
-					}
-				}
-				p.Boosters[i] = booster
-			}
-		}
+func FailedEventToFirestore(e *pbpipeline.FailedEvent) map[string]interface{} {
+	m := map[string]interface{}{
+		"id":           e.Id,
+		"user_id":      e.UserId,
+		"pipeline_id":  e.PipelineId,
+		"source_topic": e.SourceTopic,
+		"error":        e.Error,
+		"data":         e.Data,
+		"attributes":   e.Attributes,
+		"status":       int32(e.Status),
+		"attempts":     e.Attempts,
+	}
+	if e.FirstFailedAt != nil {
+		m["first_failed_at"] = e.FirstFailedAt.AsTime()
+	}
+	if e.LastFailedAt != nil {
+		m["last_failed_at"] = e.LastFailedAt.AsTime()
+	}
+	if e.NextAttemptAt != nil {
+		m["next_attempt_at"] = e.NextAttemptAt.AsTime()
 	}
+	if e.LastRedrivenAt != nil {
+		m["last_redriven_at"] = e.LastRedrivenAt.AsTime()
+	}
+	if e.ExpiresAt != nil {
+		m["expires_at"] = e.ExpiresAt.AsTime()
+	}
+	return m
+}
 
-	// Destinations
-	if dList, ok := m["destinations"].([]interface{}); ok {
-		p.Destinations = make([]*pbpipeline.DestinationOutcome, len(dList))
-		for i, dRaw := range dList {
-			if dMap, ok := dRaw.(map[string]interface{}); ok {
-				dest := &pbpipeline.DestinationOutcome{
-					ExternalId:  stringPtrOrNil(getString(dMap, "external_id")),
-					Error:       stringPtrOrNil(getString(dMap, "error")),
-					CompletedAt: getTime(dMap, "completed_at"),
-				}
-				if v, ok := dMap["destination"]; ok {
-					switch val := v.(type) {
-					case int64:
-						dest.Destination = pbplugin.DestinationType(val)
-					case int:
-						dest.Destination = pbplugin.DestinationType(int32(val))
-					case float64:
-						dest.Destination = pbplugin.DestinationType(int32(val))
-					case string:
-						upper := strings.ToUpper(val)
-						if enumVal, ok := pbplugin.DestinationType_value[upper]; ok {
-							dest.Destination = pbplugin.DestinationType(enumVal)
-						} else {
-							prefixed := "DESTINATION_" + upper
-							if enumVal, ok := pbplugin.DestinationType_value[prefixed]; ok {
-								dest.Destination = pbplugin.DestinationType(enumVal)
-							}
-						}
-					}
-				}
-				if v, ok := dMap["status"]; ok {
-					switch val := v.(type) {
-					case int64:
-						dest.Status = pbpipeline.DestinationStatus(val)
-					case int:
-						dest.Status = pbpipeline.DestinationStatus(int32(val))
-					case float64:
-						dest.Status = pbpipeline.DestinationStatus(int32(val))
-					case string:
-						if enumVal, ok := pbpipeline.DestinationStatus_value[val]; ok {
-							dest.Status = pbpipeline.DestinationStatus(enumVal)
-						}
-					}
-				}
-				p.Destinations[i] = dest
+func FirestoreToFailedEvent(m map[string]interface{}) *pbpipeline.FailedEvent {
+	e := &pbpipeline.FailedEvent{
+		Id:             getString(m, "id"),
+		UserId:         getString(m, "user_id"),
+		PipelineId:     getString(m, "pipeline_id"),
+		SourceTopic:    getString(m, "source_topic"),
+		Error:          getString(m, "error"),
+		FirstFailedAt:  getTime(m, "first_failed_at"),
+		LastFailedAt:   getTime(m, "last_failed_at"),
+		NextAttemptAt:  getTime(m, "next_attempt_at"),
+		LastRedrivenAt: getTime(m, "last_redriven_at"),
+		ExpiresAt:      getTime(m, "expires_at"),
+	}
+	if v, ok := m["data"].([]byte); ok {
+		e.Data = v
+	}
+	if v, ok := m["attributes"].(map[string]interface{}); ok {
+		e.Attributes = make(map[string]string)
+		for k, val := range v {
+			if str, ok := val.(string); ok {
+				e.Attributes[k] = str
 			}
 		}
 	}
-
-	// Note: enriched_event is now stored in GCS via enriched_event_uri
-	p.EnrichedEventUri = getString(m, "enriched_event_uri")
-
-	// Note: original_payload is now stored in GCS via original_payload_uri
-
-	return p
+	if v := getOptionalInt32(m, "status"); v != nil {
+		e.Status = pbpipeline.FailedEventStatus(*v)
+	}
+	if v := getOptionalInt32(m, "attempts"); v != nil {
+		e.Attempts = *v
+	}
+	return e
 }
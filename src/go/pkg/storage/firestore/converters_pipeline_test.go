@@ -2,10 +2,15 @@ package firestore
 
 import (
 	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 )
 
 func TestFirestoreToPipeline_ProviderTypeNumeric(t *testing.T) {
@@ -282,6 +287,31 @@ func TestFirestoreToPipelineRun_StringEnums(t *testing.T) {
 	}
 }
 
+func TestFirestoreToPipelineRun_Cost(t *testing.T) {
+	// Increments leave whole-number fields as int64 and fractional ones as float64
+	m := map[string]interface{}{
+		"id": "run-1",
+		"cost": map[string]interface{}{
+			"ai_input_tokens":     int64(1200),
+			"ai_output_tokens":    int64(180),
+			"image_generations":   int64(1),
+			"external_api_calls":  int64(3),
+			"function_gb_seconds": 2.5,
+			"estimated_usd":       0.0403,
+		},
+	}
+
+	run := FirestoreToPipelineRun(m)
+
+	want := &pbpipeline.RunCost{AiInputTokens: 1200, AiOutputTokens: 180, ImageGenerations: 1, ExternalApiCalls: 3, FunctionGbSeconds: 2.5, EstimatedUsd: 0.0403}
+	if !proto.Equal(run.Cost, want) {
+		t.Errorf("Expected cost %v, got %v", want, run.Cost)
+	}
+	if got := FirestoreToPipelineRun(PipelineRunToFirestore(run)).Cost; !proto.Equal(got, want) {
+		t.Errorf("Expected cost to round-trip, got %v", got)
+	}
+}
+
 // --- ShowcasedActivity string enum tests ---
 
 func TestFirestoreToShowcasedActivity_StringEnums(t *testing.T) {
@@ -336,6 +366,108 @@ func TestFirestoreToPersonalRecord_StringActivityType(t *testing.T) {
 	}
 }
 
+func TestPersonalRecord_EstimationEvidenceRoundTrip(t *testing.T) {
+	formula := "brzycki"
+	weight := 100.0
+	reps := int32(5)
+	in := &pbuser.PersonalRecord{
+		RecordType:        "bench_press_1rm",
+		Value:             112.5,
+		Unit:              "kg",
+		AchievedAt:        timestamppb.Now(),
+		EstimationFormula: &formula,
+		SourceWeightKg:    &weight,
+		SourceReps:        &reps,
+	}
+
+	m := PersonalRecordToFirestore(in)
+	// Firestore returns integers as int64
+	m["source_reps"] = int64(reps)
+	out := FirestoreToPersonalRecord(m)
+
+	if out.GetEstimationFormula() != "brzycki" || out.GetSourceWeightKg() != 100 || out.GetSourceReps() != 5 {
+		t.Errorf("Expected brzycki 100kg × 5, got %q %v × %v", out.GetEstimationFormula(), out.GetSourceWeightKg(), out.GetSourceReps())
+	}
+}
+
+// --- Gear tests ---
+
+func TestGear_RoundTrip(t *testing.T) {
+	in := &pbuser.Gear{
+		Id:                    "pegasus-40",
+		Name:                  "Pegasus 40",
+		Type:                  pbuser.GearType_GEAR_TYPE_SHOES,
+		DistanceMeters:        612000,
+		InitialDistanceMeters: 50000,
+		ActivityCount:         58,
+		LastUsedAt:            timestamppb.Now(),
+		LastActivityId:        "act-1",
+	}
+
+	m := GearToFirestore(in)
+	// Firestore returns integers as int64
+	m["activity_count"] = int64(58)
+	m["initial_distance_meters"] = int64(50000)
+	out := FirestoreToGear(m)
+
+	if out.Type != pbuser.GearType_GEAR_TYPE_SHOES || out.DistanceMeters != 612000 || out.InitialDistanceMeters != 50000 {
+		t.Errorf("Expected shoes at 612km from 50km, got %v %v %v", out.Type, out.DistanceMeters, out.InitialDistanceMeters)
+	}
+	if out.ActivityCount != 58 || out.LastActivityId != "act-1" || out.LastUsedAt == nil || out.CreatedAt != nil {
+		t.Errorf("Unexpected usage fields: %v", out)
+	}
+}
+
+func TestFirestoreToGear_StringType(t *testing.T) {
+	g := FirestoreToGear(map[string]interface{}{"id": "bike", "type": "GEAR_TYPE_BIKE"})
+
+	if g.Type != pbuser.GearType_GEAR_TYPE_BIKE {
+		t.Errorf("Expected GEAR_TYPE_BIKE, got %v", g.Type)
+	}
+}
+
+// --- Goal tests ---
+
+func TestGoal_RoundTrip(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	in := &pbuser.Goal{
+		Id:             "1000k",
+		Name:           "1000 km in 2025",
+		Metric:         pbuser.GoalMetric_GOAL_METRIC_DISTANCE,
+		Target:         1000000,
+		ActivityType:   pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		StartDate:      timestamppb.New(start),
+		EndDate:        timestamppb.New(start.AddDate(1, 0, 0)),
+		Progress:       620000,
+		ActivityCount:  61,
+		LastActivityId: "act-61",
+	}
+
+	m := GoalToFirestore(in)
+	// Firestore returns integers as int64
+	m["activity_count"] = int64(61)
+	m["target"] = int64(1000000)
+	out := FirestoreToGoal(m)
+
+	if out.Metric != pbuser.GoalMetric_GOAL_METRIC_DISTANCE || out.Target != 1000000 || out.Progress != 620000 {
+		t.Errorf("Expected 620km of 1000km, got %v %v %v", out.Metric, out.Progress, out.Target)
+	}
+	if out.ActivityType != pbactivity.ActivityType_ACTIVITY_TYPE_RUN || !out.StartDate.AsTime().Equal(start) || out.CompletedAt != nil {
+		t.Errorf("Unexpected scope fields: %v", out)
+	}
+	if out.ActivityCount != 61 || out.LastActivityId != "act-61" {
+		t.Errorf("Unexpected usage fields: %v", out)
+	}
+}
+
+func TestFirestoreToGoal_StringEnums(t *testing.T) {
+	g := FirestoreToGoal(map[string]interface{}{"id": "100", "metric": "GOAL_METRIC_ACTIVITIES", "activity_type": "ACTIVITY_TYPE_RIDE"})
+
+	if g.Metric != pbuser.GoalMetric_GOAL_METRIC_ACTIVITIES || g.ActivityType != pbactivity.ActivityType_ACTIVITY_TYPE_RIDE {
+		t.Errorf("Expected activities goal for rides, got %v %v", g.Metric, g.ActivityType)
+	}
+}
+
 // --- UploadedActivity string enum tests ---
 
 func TestFirestoreToUploadedActivity_StringEnums(t *testing.T) {
@@ -357,6 +489,289 @@ func TestFirestoreToUploadedActivity_StringEnums(t *testing.T) {
 	}
 }
 
+func TestFirestoreToPipeline_RaceMode(t *testing.T) {
+	// Shape written by the pipeline service via protojson: timestamps as
+	// RFC 3339 strings and provider types as enum names.
+	m := map[string]interface{}{
+		"id":     "p1",
+		"source": "SOURCE_STRAVA",
+		"race_mode": map[string]interface{}{
+			"enabled":   true,
+			"starts_at": "2026-04-25T00:00:00Z",
+			"ends_at":   time.Date(2026, 4, 27, 0, 0, 0, 0, time.UTC),
+			"enrichers": []interface{}{
+				map[string]interface{}{
+					"provider_type": "ENRICHER_PROVIDER_AI_COMPANION",
+					"typed_config":  map[string]interface{}{"style": "race_report"},
+				},
+			},
+			"destination_configs": map[string]interface{}{
+				"hevy": map[string]interface{}{
+					"config": map[string]interface{}{"is_private": "false"},
+				},
+			},
+			"skip_branding": true,
+		},
+	}
+
+	rm := FirestoreToPipeline(m).RaceMode
+	if rm == nil {
+		t.Fatal("Expected race mode to be set")
+	}
+	if !rm.Enabled || !rm.SkipBranding {
+		t.Errorf("Expected enabled and skip_branding, got %+v", rm)
+	}
+	if !rm.StartsAt.AsTime().Equal(time.Date(2026, 4, 25, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected starts_at %v", rm.StartsAt.AsTime())
+	}
+	if !rm.EndsAt.AsTime().Equal(time.Date(2026, 4, 27, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected ends_at %v", rm.EndsAt.AsTime())
+	}
+	if len(rm.Enrichers) != 1 || rm.Enrichers[0].ProviderType != pbplugin.EnricherProviderType_ENRICHER_PROVIDER_AI_COMPANION {
+		t.Errorf("Unexpected race mode enrichers %v", rm.Enrichers)
+	}
+	if rm.DestinationConfigs["hevy"].GetConfig()["is_private"] != "false" {
+		t.Errorf("Unexpected race mode destination configs %v", rm.DestinationConfigs)
+	}
+}
+
+func TestPipelineToFirestore_RaceModeRoundTrip(t *testing.T) {
+	original := &pbpipeline.PipelineConfig{
+		Id: "p1",
+		RaceMode: &pbpipeline.RaceModeConfig{
+			Enabled:  true,
+			StartsAt: timestamppb.New(time.Date(2026, 4, 25, 0, 0, 0, 0, time.UTC)),
+			EndsAt:   timestamppb.New(time.Date(2026, 4, 27, 0, 0, 0, 0, time.UTC)),
+		},
+	}
+
+	rm := FirestoreToPipeline(PipelineToFirestore(original)).RaceMode
+	if rm == nil || !rm.Enabled {
+		t.Fatalf("Expected enabled race mode, got %+v", rm)
+	}
+	if !rm.StartsAt.AsTime().Equal(original.RaceMode.StartsAt.AsTime()) || !rm.EndsAt.AsTime().Equal(original.RaceMode.EndsAt.AsTime()) {
+		t.Errorf("Window mismatch: %v-%v", rm.StartsAt.AsTime(), rm.EndsAt.AsTime())
+	}
+	if FirestoreToPipeline(PipelineToFirestore(&pbpipeline.PipelineConfig{Id: "p2"})).RaceMode != nil {
+		t.Error("Expected no race mode when unset")
+	}
+}
+
+func TestPipelineToFirestore_PausedUntilRoundTrip(t *testing.T) {
+	until := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+	p := FirestoreToPipeline(PipelineToFirestore(&pbpipeline.PipelineConfig{Id: "p1", PausedUntil: timestamppb.New(until)}))
+	if p.PausedUntil == nil || !p.PausedUntil.AsTime().Equal(until) {
+		t.Errorf("Expected paused until %v, got %v", until, p.PausedUntil)
+	}
+
+	// Pipelines saved through the pipeline service are protojson-encoded
+	p = FirestoreToPipeline(map[string]interface{}{"id": "p2", "paused_until": "2026-08-01T00:00:00Z"})
+	if p.PausedUntil == nil || !p.PausedUntil.AsTime().Equal(until) {
+		t.Errorf("Expected RFC3339 paused until %v, got %v", until, p.PausedUntil)
+	}
+}
+
+func TestPipelineToFirestore_DescriptionTemplateRoundTrip(t *testing.T) {
+	tmpl := "{{.Original}}\n\n{{.HRZones}}"
+	original := &pbpipeline.PipelineConfig{
+		Id:                  "p1",
+		DescriptionTemplate: &tmpl,
+		DestinationConfigs: map[string]*pbpipeline.DestinationConfig{
+			"strava": {DescriptionTemplate: "{{.PRs}}", TitleTemplate: "{{.Emoji}} {{.Title}}"},
+		},
+	}
+
+	p := FirestoreToPipeline(PipelineToFirestore(original))
+	if p.GetDescriptionTemplate() != tmpl {
+		t.Errorf("Expected template %q, got %q", tmpl, p.GetDescriptionTemplate())
+	}
+	if got := p.DestinationConfigs["strava"].GetDescriptionTemplate(); got != "{{.PRs}}" {
+		t.Errorf("Expected destination template {{.PRs}}, got %q", got)
+	}
+	if got := p.DestinationConfigs["strava"].GetTitleTemplate(); got != "{{.Emoji}} {{.Title}}" {
+		t.Errorf("Expected destination title template, got %q", got)
+	}
+
+	// An empty template is the default layout, stored as no template at all
+	empty := ""
+	m := PipelineToFirestore(&pbpipeline.PipelineConfig{Id: "p2", DescriptionTemplate: &empty})
+	if _, ok := m["description_template"]; ok {
+		t.Error("Expected empty template not to be stored")
+	}
+	if FirestoreToPipeline(m).DescriptionTemplate != nil {
+		t.Error("Expected no template when unset")
+	}
+}
+
+func TestPipelineToFirestore_HeartRateSourceRoundTrip(t *testing.T) {
+	best := pbpipeline.HeartRateSource_HEART_RATE_SOURCE_BEST_QUALITY
+	p := FirestoreToPipeline(PipelineToFirestore(&pbpipeline.PipelineConfig{Id: "p1", HeartRateSource: best}))
+	if p.HeartRateSource != best {
+		t.Errorf("Expected %v, got %v", best, p.HeartRateSource)
+	}
+
+	// Pipelines saved through the pipeline service are protojson-encoded
+	p = FirestoreToPipeline(map[string]interface{}{"id": "p2", "heart_rate_source": "HEART_RATE_SOURCE_ENRICHER"})
+	if p.HeartRateSource != pbpipeline.HeartRateSource_HEART_RATE_SOURCE_ENRICHER {
+		t.Errorf("Expected enricher from enum name, got %v", p.HeartRateSource)
+	}
+}
+
+func TestPipelineToFirestore_EnricherTimeout(t *testing.T) {
+	m := PipelineToFirestore(&pbpipeline.PipelineConfig{
+		Id: "p1",
+		Enrichers: []*pbpipeline.EnricherConfig{
+			{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_AI_COMPANION, TimeoutSeconds: 90},
+			{ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER},
+		},
+	})
+	enrichers := m["enrichers"].([]map[string]interface{})
+	if got := enrichers[0]["timeout_seconds"]; got != int32(90) {
+		t.Errorf("Expected a 90s timeout to be stored, got %v", got)
+	}
+	if _, ok := enrichers[1]["timeout_seconds"]; ok {
+		t.Error("Expected no timeout stored when unset")
+	}
+
+	// Firestore hands whole numbers back as int64
+	p := FirestoreToPipeline(map[string]interface{}{
+		"id": "p2",
+		"enrichers": []interface{}{
+			map[string]interface{}{"provider_type": int64(2), "timeout_seconds": int64(45)},
+			map[string]interface{}{"provider_type": int64(3)},
+		},
+	})
+	if got := p.Enrichers[0].TimeoutSeconds; got != 45 {
+		t.Errorf("Expected a 45s timeout, got %d", got)
+	}
+	if got := p.Enrichers[1].TimeoutSeconds; got != 0 {
+		t.Errorf("Expected no timeout when unset, got %d", got)
+	}
+}
+
+func TestFirestoreToPipelineConfigVersion(t *testing.T) {
+	// Snapshots are written by the pipeline service as protojson maps
+	v := FirestoreToPipelineConfigVersion(map[string]interface{}{
+		"pipeline_id": "p1",
+		"version":     float64(3),
+		"created_at":  "2026-05-02T08:15:00Z",
+		"config": map[string]interface{}{
+			"id":      "p1",
+			"version": float64(3),
+			"enrichers": []interface{}{
+				map[string]interface{}{"provider_type": "ENRICHER_PROVIDER_WEATHER"},
+			},
+		},
+	})
+	if v.Version != 3 || v.Config.GetVersion() != 3 {
+		t.Errorf("Expected version 3, got %d (config %d)", v.Version, v.Config.GetVersion())
+	}
+	if len(v.Config.GetEnrichers()) != 1 || v.Config.Enrichers[0].ProviderType != pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER {
+		t.Errorf("Expected the snapshot's weather enricher, got %v", v.Config.GetEnrichers())
+	}
+	if v.CreatedAt == nil {
+		t.Error("Expected created_at to be parsed")
+	}
+
+	run := FirestoreToPipelineRun(PipelineRunToFirestore(&pbpipeline.PipelineRun{Id: "r1", PipelineConfigVersion: 3}))
+	if run.PipelineConfigVersion != 3 {
+		t.Errorf("Expected run to keep config version 3, got %d", run.PipelineConfigVersion)
+	}
+}
+
+func TestPipelineRunIsTestRoundTrip(t *testing.T) {
+	m := PipelineRunToFirestore(&pbpipeline.PipelineRun{Id: "r1", IsTest: true})
+	if m["is_test"] != true {
+		t.Errorf("Expected is_test to be stored, got %v", m["is_test"])
+	}
+	if run := FirestoreToPipelineRun(m); !run.IsTest {
+		t.Error("Expected run to stay a test run")
+	}
+	if _, ok := PipelineRunToFirestore(&pbpipeline.PipelineRun{Id: "r2"})["is_test"]; ok {
+		t.Error("Expected is_test to be omitted for real runs")
+	}
+}
+
+func TestPipelineRunRetryRoundTrip(t *testing.T) {
+	nextRetry := time.Date(2026, 5, 2, 8, 15, 0, 0, time.UTC)
+	m := PipelineRunToFirestore(&pbpipeline.PipelineRun{
+		Id:            "r1",
+		RetryAttempts: map[string]int32{"fitbit-heart-rate": 2},
+		NextRetryAt:   timestamppb.New(nextRetry),
+	})
+	// Firestore reads integers back as int64
+	m["retry_attempts"] = map[string]interface{}{"fitbit-heart-rate": int64(2)}
+
+	run := FirestoreToPipelineRun(m)
+	if run.RetryAttempts["fitbit-heart-rate"] != 2 {
+		t.Errorf("Expected 2 retry attempts, got %v", run.RetryAttempts)
+	}
+	if !run.GetNextRetryAt().AsTime().Equal(nextRetry) {
+		t.Errorf("Expected next retry at %v, got %v", nextRetry, run.GetNextRetryAt().AsTime())
+	}
+	if _, ok := PipelineRunToFirestore(&pbpipeline.PipelineRun{Id: "r2"})["retry_attempts"]; ok {
+		t.Error("Expected retry_attempts to be omitted for runs without retries")
+	}
+}
+
+func TestProviderCircuitRoundTrip(t *testing.T) {
+	openUntil := time.Date(2026, 5, 2, 8, 15, 0, 0, time.UTC)
+	lastErr := "503 from upstream"
+	m := ProviderCircuitToFirestore(&pbpipeline.ProviderCircuit{
+		ProviderType:        pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER,
+		ConsecutiveFailures: 5,
+		LastError:           &lastErr,
+		OpenUntil:           timestamppb.New(openUntil),
+	})
+	if _, ok := m["opened_at"]; ok {
+		t.Error("Expected no opened_at stored when unset")
+	}
+
+	// Firestore hands whole numbers back as int64
+	m["provider_type"] = int64(m["provider_type"].(int32))
+	m["consecutive_failures"] = int64(m["consecutive_failures"].(int32))
+
+	c := FirestoreToProviderCircuit(m)
+	if c.ProviderType != pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER || c.ConsecutiveFailures != 5 {
+		t.Errorf("Unexpected circuit %+v", c)
+	}
+	if c.GetLastError() != lastErr || !c.OpenUntil.AsTime().Equal(openUntil) || c.OpenedAt != nil {
+		t.Errorf("Unexpected circuit times or error %+v", c)
+	}
+}
+
+func TestProviderCircuitModeAndHistoryRoundTrip(t *testing.T) {
+	reason := "Met Office API outage"
+	admin := "admin-1"
+	in := &pbpipeline.ProviderCircuit{
+		ProviderType:  pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER,
+		Mode:          pbpipeline.ProviderCircuitMode_PROVIDER_CIRCUIT_MODE_DISABLED,
+		ModeReason:    &reason,
+		ModeSetBy:     &admin,
+		TotalCalls:    1200,
+		TotalFailures: 36,
+		TimesOpened:   2,
+	}
+	if out := FirestoreToProviderCircuit(ProviderCircuitToFirestore(in)); !proto.Equal(in, out) {
+		t.Errorf("Expected circuit to round-trip, got %v", out)
+	}
+
+	change := &pbpipeline.ProviderCircuitChange{
+		Id:           "c1",
+		ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER,
+		Event:        pbpipeline.ProviderCircuitEvent_PROVIDER_CIRCUIT_EVENT_MODE_CHANGED,
+		Mode:         pbpipeline.ProviderCircuitMode_PROVIDER_CIRCUIT_MODE_DISABLED,
+		Reason:       &reason,
+		Actor:        admin,
+		CreatedAt:    timestamppb.New(time.Date(2026, 5, 2, 8, 0, 0, 0, time.UTC)),
+	}
+	m := ProviderCircuitChangeToFirestore(change)
+	m["event"] = int64(m["event"].(int32))
+	if out := FirestoreToProviderCircuitChange(m); !proto.Equal(change, out) {
+		t.Errorf("Expected change to round-trip, got %v", out)
+	}
+}
+
 // --- ShowcaseProfileEntry string enum tests ---
 
 func TestFirestoreToShowcaseProfileEntry_StringEnums(t *testing.T) {
@@ -376,3 +791,64 @@ func TestFirestoreToShowcaseProfileEntry_StringEnums(t *testing.T) {
 		t.Errorf("Expected SOURCE_HEVY, got %v", e.Source)
 	}
 }
+
+func TestEnricherResultShareRoundTrip(t *testing.T) {
+	expiresAt := time.Date(2026, 5, 3, 8, 15, 0, 0, time.UTC)
+	m := EnricherResultShareToFirestore(&pbpipeline.EnricherResultShare{
+		Id:           "share-1",
+		FanOutId:     "exec-1",
+		ProviderType: pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER,
+		PipelineId:   "p1",
+		Description:  "🌤️ Weather: 12°C, Sunny",
+		Tags:         []string{"sunny"},
+		Metadata:     map[string]string{"weather_status": "success"},
+		ExpiresAt:    timestamppb.New(expiresAt),
+	})
+
+	// Firestore hands back whole numbers as int64, arrays and maps untyped
+	m["provider_type"] = int64(m["provider_type"].(int32))
+	m["tags"] = []interface{}{"sunny"}
+	m["metadata"] = map[string]interface{}{"weather_status": "success"}
+
+	s := FirestoreToEnricherResultShare(m)
+	if s.ProviderType != pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER || s.PipelineId != "p1" || s.FanOutId != "exec-1" {
+		t.Errorf("Unexpected share %+v", s)
+	}
+	if s.Description != "🌤️ Weather: 12°C, Sunny" || len(s.Tags) != 1 || s.Metadata["weather_status"] != "success" {
+		t.Errorf("Expected the shared result to survive, got %+v", s)
+	}
+	if !s.ExpiresAt.AsTime().Equal(expiresAt) {
+		t.Errorf("Expected expires_at %v, got %v", expiresAt, s.ExpiresAt.AsTime())
+	}
+}
+
+func TestFailedEventRoundTrip(t *testing.T) {
+	nextAttemptAt := time.Date(2026, 5, 3, 8, 15, 0, 0, time.UTC)
+	m := FailedEventToFirestore(&pbpipeline.FailedEvent{
+		Id:            "msg-1",
+		UserId:        "u1",
+		SourceTopic:   "topic-pipeline-activity",
+		Error:         "provider timed out",
+		Data:          []byte(`{"data":{"user_id":"u1"}}`),
+		Attributes:    map[string]string{"origin": "webhook"},
+		Status:        pbpipeline.FailedEventStatus_FAILED_EVENT_STATUS_PENDING,
+		Attempts:      2,
+		NextAttemptAt: timestamppb.New(nextAttemptAt),
+	})
+
+	// Firestore hands back whole numbers as int64 and maps untyped
+	m["status"] = int64(m["status"].(int32))
+	m["attempts"] = int64(m["attempts"].(int32))
+	m["attributes"] = map[string]interface{}{"origin": "webhook"}
+
+	e := FirestoreToFailedEvent(m)
+	if e.Status != pbpipeline.FailedEventStatus_FAILED_EVENT_STATUS_PENDING || e.Attempts != 2 || e.SourceTopic != "topic-pipeline-activity" {
+		t.Errorf("Unexpected event %+v", e)
+	}
+	if string(e.Data) != `{"data":{"user_id":"u1"}}` || e.Attributes["origin"] != "webhook" || e.Error != "provider timed out" {
+		t.Errorf("Expected the message to survive, got %+v", e)
+	}
+	if !e.NextAttemptAt.AsTime().Equal(nextAttemptAt) {
+		t.Errorf("Expected next_attempt_at %v, got %v", nextAttemptAt, e.NextAttemptAt.AsTime())
+	}
+}
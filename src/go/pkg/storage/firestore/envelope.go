@@ -0,0 +1,106 @@
+package firestore
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// KeyWrapper encrypts data keys with a key encryption key held elsewhere,
+// normally in Cloud KMS.
+type KeyWrapper interface {
+	WrapKey(ctx context.Context, dek []byte) ([]byte, error)
+	UnwrapKey(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+// maxCachedKeys bounds a keyCache; the cache is emptied when it fills up.
+const maxCachedKeys = 10000
+
+// keyCache unwraps data keys, calling the KeyWrapper once per wrapped key.
+type keyCache struct {
+	wrapper KeyWrapper
+
+	mu   sync.Mutex
+	keys map[string]cipher.AEAD
+}
+
+func newKeyCache(wrapper KeyWrapper) *keyCache {
+	return &keyCache{wrapper: wrapper, keys: map[string]cipher.AEAD{}}
+}
+
+// generate creates a random AES-256 data key and returns it with its
+// wrapped form, which is what gets stored.
+func (k *keyCache) generate(ctx context.Context) (cipher.AEAD, []byte, error) {
+	dek := make([]byte, 32)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, nil, fmt.Errorf("generate data key: %w", err)
+	}
+	wrapped, err := k.wrapper.WrapKey(ctx, dek)
+	if err != nil {
+		return nil, nil, fmt.Errorf("wrap data key: %w", err)
+	}
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, nil, err
+	}
+	k.put(wrapped, aead)
+	return aead, wrapped, nil
+}
+
+func (k *keyCache) unwrap(ctx context.Context, wrapped []byte) (cipher.AEAD, error) {
+	k.mu.Lock()
+	aead, ok := k.keys[string(wrapped)]
+	k.mu.Unlock()
+	if ok {
+		return aead, nil
+	}
+
+	dek, err := k.wrapper.UnwrapKey(ctx, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key: %w", err)
+	}
+	if aead, err = newAEAD(dek); err != nil {
+		return nil, err
+	}
+	k.put(wrapped, aead)
+	return aead, nil
+}
+
+func (k *keyCache) put(wrapped []byte, aead cipher.AEAD) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if len(k.keys) >= maxCachedKeys {
+		k.keys = map[string]cipher.AEAD{}
+	}
+	k.keys[string(wrapped)] = aead
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("data key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal appends a fresh nonce and the ciphertext of plaintext to dst.
+func seal(dst []byte, aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	dst = append(dst, nonce...)
+	return aead.Seal(dst, nonce, plaintext, additionalData), nil
+}
+
+// open reverses seal.
+func open(aead cipher.AEAD, sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	return aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], additionalData)
+}
@@ -0,0 +1,85 @@
+package firestore
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"cloud.google.com/go/firestore"
+	cloudkms "google.golang.org/api/cloudkms/v1"
+)
+
+// TokenKeyEnv names the Cloud KMS key that wraps token data keys, as
+// projects/<p>/locations/<l>/keyRings/<r>/cryptoKeys/<k>.
+const TokenKeyEnv = "TOKEN_ENCRYPTION_KEY"
+
+// KMSKeyWrapper wraps data keys with a Cloud KMS symmetric key.
+type KMSKeyWrapper struct {
+	keys    *cloudkms.ProjectsLocationsKeyRingsCryptoKeysService
+	keyName string
+}
+
+func NewKMSKeyWrapper(ctx context.Context, keyName string) (*KMSKeyWrapper, error) {
+	svc, err := cloudkms.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("kms init: %w", err)
+	}
+	return &KMSKeyWrapper{keys: svc.Projects.Locations.KeyRings.CryptoKeys, keyName: keyName}, nil
+}
+
+func (k *KMSKeyWrapper) WrapKey(ctx context.Context, dek []byte) ([]byte, error) {
+	resp, err := k.keys.Encrypt(k.keyName, &cloudkms.EncryptRequest{
+		Plaintext: base64.StdEncoding.EncodeToString(dek),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Ciphertext)
+}
+
+func (k *KMSKeyWrapper) UnwrapKey(ctx context.Context, wrapped []byte) ([]byte, error) {
+	resp, err := k.keys.Decrypt(k.keyName, &cloudkms.DecryptRequest{
+		Ciphertext: base64.StdEncoding.EncodeToString(wrapped),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Plaintext)
+}
+
+// ConfigureTokenEncryption enables token encryption with the KMS key named by
+// TOKEN_ENCRYPTION_KEY. It returns false, leaving tokens in plaintext, when
+// the variable is unset.
+func ConfigureTokenEncryption(ctx context.Context) (bool, error) {
+	keyName := os.Getenv(TokenKeyEnv)
+	if keyName == "" {
+		return false, nil
+	}
+	wrapper, err := NewKMSKeyWrapper(ctx, keyName)
+	if err != nil {
+		return false, err
+	}
+	SetTokenCipher(NewTokenCipher(wrapper))
+	return true, nil
+}
+
+// SensitiveDataKeyEnv names the Cloud KMS key that wraps per-user data keys
+// for sensitive booster data.
+const SensitiveDataKeyEnv = "SENSITIVE_DATA_KEY"
+
+// ConfigureSensitiveDataEncryption enables per-user encryption of sensitive
+// booster data with the KMS key named by SENSITIVE_DATA_KEY. It returns
+// false, leaving the data in plaintext, when the variable is unset.
+func ConfigureSensitiveDataEncryption(ctx context.Context, fs *firestore.Client) (bool, error) {
+	keyName := os.Getenv(SensitiveDataKeyEnv)
+	if keyName == "" {
+		return false, nil
+	}
+	wrapper, err := NewKMSKeyWrapper(ctx, keyName)
+	if err != nil {
+		return false, err
+	}
+	SetUserDataCipher(NewUserDataCipher(wrapper, NewFirestoreUserKeyStore(fs)))
+	return true, nil
+}
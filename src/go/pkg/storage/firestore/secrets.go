@@ -0,0 +1,323 @@
+package firestore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+// integrationSecretsCollection holds one document per provider under each
+// user: users/{uid}/integration_secrets/{provider}. Keeping credentials out
+// of the user document means reading a profile never loads them.
+const integrationSecretsCollection = "integration_secrets"
+
+// integrationSecretFields maps API key providers to the integration field
+// that used to hold their secret in the user document.
+var integrationSecretFields = map[string]string{
+	"hevy":      "api_key",
+	"intervals": "api_key",
+	"notion":    "api_key",
+}
+
+// destinationSecretKeys maps destinations whose per-pipeline config holds a
+// credential to that config key. The credential is kept in
+// integration_secrets under the destination ID instead, so it never sits in
+// pipeline configs, plugin defaults or the events built from them.
+var destinationSecretKeys = map[string]string{
+	"webdav": "password",
+}
+
+// IntegrationSecretField returns the integration field holding the
+// provider's secret, and false for providers without one.
+func IntegrationSecretField(provider string) (string, bool) {
+	field, ok := integrationSecretFields[provider]
+	return field, ok
+}
+
+// DestinationSecretKey returns the config key holding the destination's
+// credential, and false for destinations without one.
+func DestinationSecretKey(destID string) (string, bool) {
+	key, ok := destinationSecretKeys[destID]
+	return key, ok
+}
+
+func hasIntegrationSecret(provider string) bool {
+	_, integration := integrationSecretFields[provider]
+	_, destination := destinationSecretKeys[provider]
+	return integration || destination
+}
+
+// SplitDestinationSecrets removes credentials from destination configs about
+// to be saved for the user and stores them in integration_secrets. A config
+// without the credential leaves the stored one alone, since the web app never
+// gets it back to resend.
+func SplitDestinationSecrets(ctx context.Context, fs *firestore.Client, userID string, configs map[string]*pbpipeline.DestinationConfig) error {
+	for destID, cfg := range configs {
+		key, ok := DestinationSecretKey(destID)
+		if !ok || cfg == nil {
+			continue
+		}
+		secret := cfg.Config[key]
+		delete(cfg.Config, key)
+		if secret == "" {
+			continue
+		}
+		if err := SetIntegrationSecret(ctx, fs, userID, destID, secret); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SplitIntegrationSecret removes the provider's secret from integration data
+// about to be written to the user document and returns it, with false when
+// the data holds none.
+func SplitIntegrationSecret(provider string, data map[string]interface{}) (string, bool) {
+	field, ok := IntegrationSecretField(provider)
+	if !ok {
+		return "", false
+	}
+	secret, ok := data[field].(string)
+	delete(data, field)
+	return secret, ok && secret != ""
+}
+
+func integrationSecretRef(fs *firestore.Client, userID, provider string) *firestore.DocumentRef {
+	return fs.Collection("users").Doc(userID).Collection(integrationSecretsCollection).Doc(provider)
+}
+
+// GetIntegrationSecret returns the provider's secret for the user, or "" when
+// none is stored. Users not yet migrated still have it in their user
+// document, which is read as a fallback.
+func GetIntegrationSecret(ctx context.Context, fs *firestore.Client, userID, provider string) (string, error) {
+	if !hasIntegrationSecret(provider) {
+		return "", fmt.Errorf("provider %q has no integration secret", provider)
+	}
+
+	snap, err := integrationSecretRef(fs, userID, provider).Get(ctx)
+	if err == nil {
+		secret, _ := snap.Data()["secret"].(string)
+		return decryptToken(ctx, secret)
+	}
+	if status.Code(err) != codes.NotFound {
+		return "", err
+	}
+	field, ok := IntegrationSecretField(provider)
+	if !ok {
+		return "", nil
+	}
+
+	userSnap, err := fs.Collection("users").Doc(userID).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	legacy, err := userSnap.DataAtPath(firestore.FieldPath{"integrations", provider, field})
+	if err != nil {
+		return "", nil
+	}
+	secret, _ := legacy.(string)
+	return decryptToken(ctx, secret)
+}
+
+// SetIntegrationSecret stores the provider's secret for the user, encrypted
+// with the token cipher when one is configured. An empty secret deletes it.
+func SetIntegrationSecret(ctx context.Context, fs *firestore.Client, userID, provider, secret string) error {
+	if !hasIntegrationSecret(provider) {
+		return fmt.Errorf("provider %q has no integration secret", provider)
+	}
+	if secret == "" {
+		return DeleteIntegrationSecret(ctx, fs, userID, provider)
+	}
+	encrypted, err := encryptToken(ctx, secret)
+	if err != nil {
+		return err
+	}
+	_, err = integrationSecretRef(fs, userID, provider).Set(ctx, map[string]interface{}{
+		"secret":     encrypted,
+		"updated_at": time.Now(),
+	})
+	return err
+}
+
+func DeleteIntegrationSecret(ctx context.Context, fs *firestore.Client, userID, provider string) error {
+	_, err := integrationSecretRef(fs, userID, provider).Delete(ctx)
+	return err
+}
+
+// MigrateIntegrationSecrets moves the API keys left in user documents into
+// integration_secrets, returning the number of users changed. A secret
+// already in the store is newer than the user document's copy and is kept.
+func MigrateIntegrationSecrets(ctx context.Context, fs *firestore.Client) (int, error) {
+	migrated := 0
+	iter := fs.Collection("users").Select("integrations").Documents(ctx)
+	defer iter.Stop()
+	for {
+		snap, err := iter.Next()
+		if err == iterator.Done {
+			return migrated, nil
+		}
+		if err != nil {
+			return migrated, err
+		}
+
+		integrations, _ := snap.Data()["integrations"].(map[string]interface{})
+		changed := false
+		for provider, field := range integrationSecretFields {
+			fields, _ := integrations[provider].(map[string]interface{})
+			if secret, _ := fields[field].(string); secret == "" {
+				continue
+			}
+			if err := migrateIntegrationSecret(ctx, fs, snap.Ref, provider, field); err != nil {
+				return migrated, fmt.Errorf("user %s: %s: %w", snap.Ref.ID, provider, err)
+			}
+			changed = true
+		}
+		if changed {
+			migrated++
+		}
+	}
+}
+
+func migrateIntegrationSecret(ctx context.Context, fs *firestore.Client, userRef *firestore.DocumentRef, provider, field string) error {
+	secretRef := integrationSecretRef(fs, userRef.ID, provider)
+	path := firestore.FieldPath{"integrations", provider, field}
+
+	return fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		userSnap, err := tx.Get(userRef)
+		if err != nil {
+			return err
+		}
+		secretSnap, err := tx.Get(secretRef)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+
+		legacy, err := userSnap.DataAtPath(path)
+		if err != nil {
+			return nil
+		}
+		secret, _ := legacy.(string)
+		if secret == "" {
+			return nil
+		}
+		if secretSnap == nil || !secretSnap.Exists() {
+			if !IsEncryptedToken(secret) {
+				if secret, err = encryptToken(ctx, secret); err != nil {
+					return err
+				}
+			}
+			if err := tx.Set(secretRef, map[string]interface{}{"secret": secret, "updated_at": time.Now()}); err != nil {
+				return err
+			}
+		}
+		return tx.Update(userRef, []firestore.Update{{FieldPath: path, Value: firestore.Delete}})
+	})
+}
+
+// MigrateDestinationSecrets moves destination credentials left in pipeline
+// configs and plugin defaults into integration_secrets, and removes them from
+// pipeline version snapshots, returning the number of documents changed. A
+// credential already in the store is kept, and the current pipeline's copy
+// wins over a plugin default's.
+func MigrateDestinationSecrets(ctx context.Context, fs *firestore.Client) (int, error) {
+	changed := 0
+	for destID, key := range destinationSecretKeys {
+		sources := []struct {
+			collection string
+			keep       bool // Whether its copy may be the one stored
+			paths      []firestore.FieldPath
+		}{
+			{"pipelines", true, []firestore.FieldPath{
+				{"destination_configs", destID, "config", key},
+				{"race_mode", "destination_configs", destID, "config", key},
+			}},
+			{"plugin_defaults", true, []firestore.FieldPath{{"config", key}}},
+			{"versions", false, []firestore.FieldPath{
+				{"config", "destination_configs", destID, "config", key},
+				{"config", "race_mode", "destination_configs", destID, "config", key},
+			}},
+		}
+		for _, src := range sources {
+			n, err := migrateDestinationSecretDocs(ctx, fs, src.collection, destID, src.keep, src.paths)
+			changed += n
+			if err != nil {
+				return changed, fmt.Errorf("%s %s: %w", destID, src.collection, err)
+			}
+		}
+	}
+	return changed, nil
+}
+
+func migrateDestinationSecretDocs(ctx context.Context, fs *firestore.Client, collection, destID string, keep bool, paths []firestore.FieldPath) (int, error) {
+	changed := 0
+	iter := fs.CollectionGroup(collection).Documents(ctx)
+	defer iter.Stop()
+	for {
+		snap, err := iter.Next()
+		if err == iterator.Done {
+			return changed, nil
+		}
+		if err != nil {
+			return changed, err
+		}
+		if collection == "plugin_defaults" && snap.Ref.ID != destID {
+			continue
+		}
+		userID := ownerID(snap.Ref)
+		if userID == "" {
+			continue
+		}
+
+		var updates []firestore.Update
+		for _, path := range paths {
+			value, err := snap.DataAtPath(path)
+			if err != nil {
+				continue
+			}
+			updates = append(updates, firestore.Update{FieldPath: path, Value: firestore.Delete})
+			secret, _ := value.(string)
+			if !keep || secret == "" {
+				continue
+			}
+			existing, err := GetIntegrationSecret(ctx, fs, userID, destID)
+			if err != nil {
+				return changed, fmt.Errorf("user %s: %w", userID, err)
+			}
+			if existing == "" {
+				if err := SetIntegrationSecret(ctx, fs, userID, destID, secret); err != nil {
+					return changed, fmt.Errorf("user %s: %w", userID, err)
+				}
+			}
+		}
+		if len(updates) == 0 {
+			continue
+		}
+		if _, err := snap.Ref.Update(ctx, updates); err != nil {
+			return changed, fmt.Errorf("%s: %w", snap.Ref.Path, err)
+		}
+		changed++
+	}
+}
+
+// ownerID returns the ID of the user whose sub-collection ref is in.
+func ownerID(ref *firestore.DocumentRef) string {
+	for doc := ref; doc != nil; doc = doc.Parent.Parent {
+		if doc.Parent.ID == "users" {
+			return doc.ID
+		}
+		if doc.Parent.Parent == nil {
+			break
+		}
+	}
+	return ""
+}
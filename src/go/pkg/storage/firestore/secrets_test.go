@@ -0,0 +1,36 @@
+package firestore
+
+import "testing"
+
+func TestSplitIntegrationSecret(t *testing.T) {
+	data := map[string]interface{}{"api_key": "key-1", "athlete_id": "i123", "enabled": true}
+	secret, ok := SplitIntegrationSecret("intervals", data)
+	if !ok || secret != "key-1" {
+		t.Errorf("Expected key-1, got %q (%v)", secret, ok)
+	}
+	if _, found := data["api_key"]; found || data["athlete_id"] != "i123" {
+		t.Errorf("Expected only the secret to be removed, got %v", data)
+	}
+
+	empty := map[string]interface{}{"api_key": ""}
+	if _, ok := SplitIntegrationSecret("hevy", empty); ok || len(empty) != 0 {
+		t.Errorf("Expected an empty secret to be dropped and not returned, got %v", empty)
+	}
+
+	oauth := map[string]interface{}{"access_token": "a1"}
+	if _, ok := SplitIntegrationSecret("strava", oauth); ok || oauth["access_token"] != "a1" {
+		t.Errorf("Expected OAuth integrations to be untouched, got %v", oauth)
+	}
+}
+
+func TestDestinationSecretKey(t *testing.T) {
+	if key, ok := DestinationSecretKey("webdav"); !ok || key != "password" {
+		t.Errorf("Expected webdav's password to be a secret, got %q (%v)", key, ok)
+	}
+	if _, ok := DestinationSecretKey("strava"); ok {
+		t.Error("Expected Strava to have no destination secret")
+	}
+	if !hasIntegrationSecret("webdav") || !hasIntegrationSecret("hevy") || hasIntegrationSecret("strava") {
+		t.Error("Expected secrets for webdav and hevy only")
+	}
+}
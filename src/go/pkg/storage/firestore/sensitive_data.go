@@ -0,0 +1,425 @@
+package firestore
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// encryptedFieldPrefix marks a booster data field encrypted with the user's
+// data key. Fields without it are plaintext written before encryption was
+// enabled.
+const encryptedFieldPrefix = "enc:u1:"
+
+// sensitiveBoosters are the booster_data documents holding health data, which
+// are encrypted field by field with a per-user data key. Enrichers storing
+// cycle tracking, injury or other biometric history belong here.
+var sensitiveBoosters = map[string]bool{
+	"recovery_advisor": true, // daily heart-rate training load
+	"effort_score":     true, // heart rate and TRIMP history
+}
+
+// unencryptedBoosterFields are written by the storage layer itself and stay
+// readable for queries and debugging.
+var unencryptedBoosterFields = map[string]bool{
+	"last_updated": true,
+}
+
+// IsSensitiveBoosterData reports whether a booster_data document is encrypted
+// with the user's data key.
+func IsSensitiveBoosterData(boosterID string) bool {
+	return sensitiveBoosters[boosterID]
+}
+
+// ErrUserDataCipherMissing is returned when encrypted booster data is read by
+// a service that has no cipher configured.
+var ErrUserDataCipherMissing = errors.New("booster data is encrypted but no user data cipher is configured")
+
+// UserKeys are a user's wrapped data keys by version. New data is encrypted
+// with the Current version; older versions are kept until rotation has
+// re-encrypted everything written with them.
+type UserKeys struct {
+	Current int
+	Wrapped map[int][]byte
+}
+
+// UserKeyStore persists each user's wrapped data keys.
+type UserKeyStore interface {
+	// GetUserKeys returns nil when the user has no keys yet.
+	GetUserKeys(ctx context.Context, userID string) (*UserKeys, error)
+	// CreateUserKeys fails with codes.AlreadyExists when the user has keys.
+	CreateUserKeys(ctx context.Context, userID string, keys *UserKeys) error
+	SaveUserKeys(ctx context.Context, userID string, keys *UserKeys) error
+}
+
+// UserDataCipher encrypts sensitive booster data with AES-256-GCM under a
+// data key per user, wrapped by KMS and stored with the user. Each field
+// records the key version it was encrypted with and is bound to its user and
+// document, so ciphertext copied elsewhere will not decrypt.
+type UserDataCipher struct {
+	store UserKeyStore
+	keys  *keyCache
+}
+
+func NewUserDataCipher(wrapper KeyWrapper, store UserKeyStore) *UserDataCipher {
+	return &UserDataCipher{store: store, keys: newKeyCache(wrapper)}
+}
+
+// EncryptFields encrypts, in place, every top-level field of a booster data
+// document or partial update. Each value is stored as "enc:u1:" followed by
+// the base64 of the key version (4 bytes), the nonce and the ciphertext of
+// its JSON encoding, so nested values come back as JSON types. Encrypting
+// per field keeps merge writes working.
+func (c *UserDataCipher) EncryptFields(ctx context.Context, userID, boosterID string, data map[string]interface{}) error {
+	keys, err := c.userKeys(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return c.encryptWith(ctx, keys, userID, boosterID, data)
+}
+
+func (c *UserDataCipher) encryptWith(ctx context.Context, keys *UserKeys, userID, boosterID string, data map[string]interface{}) error {
+	aead, err := c.keys.unwrap(ctx, keys.Wrapped[keys.Current])
+	if err != nil {
+		return err
+	}
+	ad := []byte(userID + "/" + boosterID)
+
+	for field, val := range data {
+		if unencryptedBoosterFields[field] {
+			continue
+		}
+		if s, ok := val.(string); ok && isEncryptedField(s) {
+			continue
+		}
+		plaintext, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Errorf("%s: %w", field, err)
+		}
+		buf := make([]byte, 4, 4+aead.NonceSize()+len(plaintext)+aead.Overhead())
+		binary.BigEndian.PutUint32(buf, uint32(keys.Current))
+		if buf, err = seal(buf, aead, plaintext, ad); err != nil {
+			return err
+		}
+		data[field] = encryptedFieldPrefix + base64.StdEncoding.EncodeToString(buf)
+	}
+	return nil
+}
+
+// DecryptFields reverses EncryptFields. Plaintext fields from before
+// encryption was enabled are left as they are.
+func (c *UserDataCipher) DecryptFields(ctx context.Context, userID, boosterID string, data map[string]interface{}) error {
+	var keys *UserKeys
+	ad := []byte(userID + "/" + boosterID)
+
+	for field, val := range data {
+		s, ok := val.(string)
+		if !ok || !isEncryptedField(s) {
+			continue
+		}
+		buf, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(s, encryptedFieldPrefix))
+		if err != nil || len(buf) < 4 {
+			return fmt.Errorf("%s: malformed encrypted field", field)
+		}
+		if keys == nil {
+			if keys, err = c.store.GetUserKeys(ctx, userID); err != nil {
+				return err
+			}
+			if keys == nil {
+				return fmt.Errorf("%s: user %s has no data keys", field, userID)
+			}
+		}
+
+		version := int(binary.BigEndian.Uint32(buf))
+		wrapped, ok := keys.Wrapped[version]
+		if !ok {
+			return fmt.Errorf("%s: data key version %d not found", field, version)
+		}
+		aead, err := c.keys.unwrap(ctx, wrapped)
+		if err != nil {
+			return err
+		}
+		plaintext, err := open(aead, buf[4:], ad)
+		if err != nil {
+			return fmt.Errorf("%s: decrypt: %w", field, err)
+		}
+		var out interface{}
+		if err := json.Unmarshal(plaintext, &out); err != nil {
+			return fmt.Errorf("%s: %w", field, err)
+		}
+		data[field] = out
+	}
+	return nil
+}
+
+// userKeys returns the user's keys, creating the first version on first use.
+func (c *UserDataCipher) userKeys(ctx context.Context, userID string) (*UserKeys, error) {
+	keys, err := c.store.GetUserKeys(ctx, userID)
+	if err != nil || keys != nil {
+		return keys, err
+	}
+
+	_, wrapped, err := c.keys.generate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys = &UserKeys{Current: 1, Wrapped: map[int][]byte{1: wrapped}}
+	if err := c.store.CreateUserKeys(ctx, userID, keys); err != nil {
+		if status.Code(err) != codes.AlreadyExists {
+			return nil, err
+		}
+		// Another writer created the user's first key; use theirs
+		return c.store.GetUserKeys(ctx, userID)
+	}
+	return keys, nil
+}
+
+// RotateUserKey adds a new data key version for the user, wrapped with the
+// current KMS key version, and makes it current. Existing data stays
+// readable; ReencryptFields moves it onto the new version.
+func (c *UserDataCipher) RotateUserKey(ctx context.Context, userID string) (*UserKeys, error) {
+	keys, err := c.userKeys(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	_, wrapped, err := c.keys.generate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rotated := &UserKeys{Current: keys.Current + 1, Wrapped: map[int][]byte{keys.Current + 1: wrapped}}
+	for v, w := range keys.Wrapped {
+		rotated.Wrapped[v] = w
+	}
+	if err := c.store.SaveUserKeys(ctx, userID, rotated); err != nil {
+		return nil, err
+	}
+	return rotated, nil
+}
+
+// ReencryptFields re-encrypts, in place, every field not already encrypted
+// with the current key version, including plaintext ones. It reports whether
+// anything changed.
+func (c *UserDataCipher) ReencryptFields(ctx context.Context, keys *UserKeys, userID, boosterID string, data map[string]interface{}) (bool, error) {
+	stale := map[string]interface{}{}
+	for field, val := range data {
+		if unencryptedBoosterFields[field] {
+			continue
+		}
+		if s, ok := val.(string); ok && isEncryptedField(s) && fieldKeyVersion(s) == keys.Current {
+			continue
+		}
+		stale[field] = val
+	}
+	if len(stale) == 0 {
+		return false, nil
+	}
+	if err := c.DecryptFields(ctx, userID, boosterID, stale); err != nil {
+		return false, err
+	}
+	if err := c.encryptWith(ctx, keys, userID, boosterID, stale); err != nil {
+		return false, err
+	}
+	for field, val := range stale {
+		data[field] = val
+	}
+	return true, nil
+}
+
+// PruneUserKeys drops key versions older than the one before current. The
+// previous version is kept because a writer that loaded the keys just before
+// a rotation may still be encrypting with it.
+func (c *UserDataCipher) PruneUserKeys(ctx context.Context, userID string, keys *UserKeys) error {
+	pruned := &UserKeys{Current: keys.Current, Wrapped: map[int][]byte{}}
+	for v, w := range keys.Wrapped {
+		if v >= keys.Current-1 {
+			pruned.Wrapped[v] = w
+		}
+	}
+	if len(pruned.Wrapped) == len(keys.Wrapped) {
+		return nil
+	}
+	return c.store.SaveUserKeys(ctx, userID, pruned)
+}
+
+func isEncryptedField(value string) bool {
+	return strings.HasPrefix(value, encryptedFieldPrefix)
+}
+
+// fieldKeyVersion returns the key version of an encrypted field, or 0 when it
+// cannot be read.
+func fieldKeyVersion(value string) int {
+	buf, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, encryptedFieldPrefix))
+	if err != nil || len(buf) < 4 {
+		return 0
+	}
+	return int(binary.BigEndian.Uint32(buf))
+}
+
+var userDataCipher atomic.Pointer[UserDataCipher]
+
+// SetUserDataCipher sets the cipher used for sensitive booster data. With no
+// cipher, booster data is written in plaintext, which is what local
+// development and tests use.
+func SetUserDataCipher(c *UserDataCipher) {
+	userDataCipher.Store(c)
+}
+
+// EncryptBoosterData encrypts, in place, a booster data document or partial
+// update when the booster is sensitive and a cipher is configured.
+func EncryptBoosterData(ctx context.Context, userID, boosterID string, data map[string]interface{}) error {
+	c := userDataCipher.Load()
+	if c == nil || !IsSensitiveBoosterData(boosterID) {
+		return nil
+	}
+	return c.EncryptFields(ctx, userID, boosterID, data)
+}
+
+// DecryptBoosterData decrypts, in place, a booster data document. Plaintext
+// fields are left as they are.
+func DecryptBoosterData(ctx context.Context, userID, boosterID string, data map[string]interface{}) error {
+	if c := userDataCipher.Load(); c != nil {
+		return c.DecryptFields(ctx, userID, boosterID, data)
+	}
+	for _, val := range data {
+		if s, ok := val.(string); ok && isEncryptedField(s) {
+			return ErrUserDataCipherMissing
+		}
+	}
+	return nil
+}
+
+// userKeysCollection holds one document, userKeysDoc, per user with a
+// data key.
+const (
+	userKeysCollection = "encryption_keys"
+	userKeysDoc        = "booster_data"
+)
+
+// FirestoreUserKeyStore keeps wrapped user data keys in
+// users/{uid}/encryption_keys/booster_data.
+type FirestoreUserKeyStore struct {
+	fs *firestore.Client
+}
+
+func NewFirestoreUserKeyStore(fs *firestore.Client) *FirestoreUserKeyStore {
+	return &FirestoreUserKeyStore{fs: fs}
+}
+
+func (s *FirestoreUserKeyStore) ref(userID string) *firestore.DocumentRef {
+	return s.fs.Collection("users").Doc(userID).Collection(userKeysCollection).Doc(userKeysDoc)
+}
+
+func (s *FirestoreUserKeyStore) GetUserKeys(ctx context.Context, userID string) (*UserKeys, error) {
+	snap, err := s.ref(userID).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	m := snap.Data()
+	keys := &UserKeys{Current: int(getInt64(m, "current_version")), Wrapped: map[int][]byte{}}
+	wrapped, _ := m["wrapped_keys"].(map[string]interface{})
+	for v, w := range wrapped {
+		version, err := strconv.Atoi(v)
+		if b, ok := w.([]byte); ok && err == nil {
+			keys.Wrapped[version] = b
+		}
+	}
+	return keys, nil
+}
+
+func (s *FirestoreUserKeyStore) CreateUserKeys(ctx context.Context, userID string, keys *UserKeys) error {
+	_, err := s.ref(userID).Create(ctx, userKeysToFirestore(keys))
+	return err
+}
+
+func (s *FirestoreUserKeyStore) SaveUserKeys(ctx context.Context, userID string, keys *UserKeys) error {
+	_, err := s.ref(userID).Set(ctx, userKeysToFirestore(keys))
+	return err
+}
+
+func userKeysToFirestore(keys *UserKeys) map[string]interface{} {
+	wrapped := make(map[string]interface{}, len(keys.Wrapped))
+	for v, w := range keys.Wrapped {
+		wrapped[strconv.Itoa(v)] = w
+	}
+	return map[string]interface{}{
+		"current_version": keys.Current,
+		"wrapped_keys":    wrapped,
+		"updated_at":      time.Now(),
+	}
+}
+
+// RotateUserDataKeys gives every user with a data key a new key version,
+// re-encrypts their sensitive booster data with it and drops versions no
+// longer needed, returning the number of users rotated. Plaintext left from
+// before encryption was enabled is encrypted along the way.
+func RotateUserDataKeys(ctx context.Context, fs *firestore.Client) (int, error) {
+	c := userDataCipher.Load()
+	if c == nil {
+		return 0, errors.New("no user data cipher configured")
+	}
+
+	rotated := 0
+	iter := fs.CollectionGroup(userKeysCollection).Documents(ctx)
+	defer iter.Stop()
+	for {
+		snap, err := iter.Next()
+		if err == iterator.Done {
+			return rotated, nil
+		}
+		if err != nil {
+			return rotated, err
+		}
+		if snap.Ref.ID != userKeysDoc || snap.Ref.Parent.Parent == nil {
+			continue
+		}
+		userID := snap.Ref.Parent.Parent.ID
+		if err := rotateUser(ctx, fs, c, userID); err != nil {
+			return rotated, fmt.Errorf("user %s: %w", userID, err)
+		}
+		rotated++
+	}
+}
+
+func rotateUser(ctx context.Context, fs *firestore.Client, c *UserDataCipher, userID string) error {
+	keys, err := c.RotateUserKey(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for boosterID := range sensitiveBoosters {
+		ref := fs.Collection("users").Doc(userID).Collection("booster_data").Doc(boosterID)
+		err := fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+			snap, err := tx.Get(ref)
+			if err != nil {
+				if status.Code(err) == codes.NotFound {
+					return nil
+				}
+				return err
+			}
+			data := snap.Data()
+			changed, err := c.ReencryptFields(ctx, keys, userID, boosterID, data)
+			if err != nil || !changed {
+				return err
+			}
+			return tx.Set(ref, data)
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", boosterID, err)
+		}
+	}
+	return c.PruneUserKeys(ctx, userID, keys)
+}
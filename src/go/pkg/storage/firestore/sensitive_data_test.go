@@ -0,0 +1,169 @@
+package firestore
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type memUserKeyStore struct {
+	keys map[string]*UserKeys
+}
+
+func newMemUserKeyStore() *memUserKeyStore {
+	return &memUserKeyStore{keys: map[string]*UserKeys{}}
+}
+
+func (m *memUserKeyStore) GetUserKeys(ctx context.Context, userID string) (*UserKeys, error) {
+	return m.keys[userID], nil
+}
+
+func (m *memUserKeyStore) CreateUserKeys(ctx context.Context, userID string, keys *UserKeys) error {
+	if m.keys[userID] != nil {
+		return status.Error(codes.AlreadyExists, "exists")
+	}
+	m.keys[userID] = keys
+	return nil
+}
+
+func (m *memUserKeyStore) SaveUserKeys(ctx context.Context, userID string, keys *UserKeys) error {
+	m.keys[userID] = keys
+	return nil
+}
+
+func withUserDataCipher(t *testing.T, c *UserDataCipher) {
+	t.Helper()
+	SetUserDataCipher(c)
+	t.Cleanup(func() { SetUserDataCipher(nil) })
+}
+
+func TestUserDataCipher_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	wrapper := &fakeKeyWrapper{}
+	store := newMemUserKeyStore()
+	c := NewUserDataCipher(wrapper, store)
+
+	updated := time.Date(2026, 5, 2, 8, 15, 0, 0, time.UTC)
+	data := map[string]interface{}{
+		"2026-05-02":           42.5,
+		"last_result_metadata": map[string]interface{}{"intensity": "hard"},
+		"last_updated":         updated,
+	}
+	if err := c.EncryptFields(ctx, "user-1", "recovery_advisor", data); err != nil {
+		t.Fatalf("EncryptFields: %v", err)
+	}
+	for _, field := range []string{"2026-05-02", "last_result_metadata"} {
+		if s, ok := data[field].(string); !ok || !isEncryptedField(s) || fieldKeyVersion(s) != 1 {
+			t.Errorf("Expected %s to be encrypted with version 1, got %v", field, data[field])
+		}
+	}
+	if data["last_updated"] != updated {
+		t.Errorf("Expected last_updated to stay readable, got %v", data["last_updated"])
+	}
+
+	// A second write reuses the user's key
+	more := map[string]interface{}{"2026-05-03": 10}
+	if err := c.EncryptFields(ctx, "user-1", "recovery_advisor", more); err != nil {
+		t.Fatalf("EncryptFields: %v", err)
+	}
+	if len(store.keys) != 1 || wrapper.wraps != 1 {
+		t.Errorf("Expected one key for one user, got %d keys and %d wraps", len(store.keys), wrapper.wraps)
+	}
+
+	// Ciphertext is bound to its user and document
+	for _, target := range [][2]string{{"user-2", "recovery_advisor"}, {"user-1", "effort_score"}} {
+		store.keys[target[0]] = store.keys["user-1"]
+		copied := map[string]interface{}{"2026-05-02": data["2026-05-02"]}
+		if err := c.DecryptFields(ctx, target[0], target[1], copied); err == nil {
+			t.Errorf("Expected ciphertext copied to %v not to decrypt", target)
+		}
+	}
+
+	data["legacy"] = "plaintext"
+	if err := c.DecryptFields(ctx, "user-1", "recovery_advisor", data); err != nil {
+		t.Fatalf("DecryptFields: %v", err)
+	}
+	want := map[string]interface{}{
+		"2026-05-02":           42.5,
+		"last_result_metadata": map[string]interface{}{"intensity": "hard"},
+		"last_updated":         updated,
+		"legacy":               "plaintext",
+	}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("got:  %v\nwant: %v", data, want)
+	}
+}
+
+func TestUserDataCipher_Rotation(t *testing.T) {
+	ctx := context.Background()
+	store := newMemUserKeyStore()
+	c := NewUserDataCipher(&fakeKeyWrapper{}, store)
+
+	data := map[string]interface{}{"history": []interface{}{map[string]interface{}{"trimp": 80.0}}}
+	if err := c.EncryptFields(ctx, "user-1", "effort_score", data); err != nil {
+		t.Fatalf("EncryptFields: %v", err)
+	}
+	data["legacy"] = 3.0
+
+	for version := 2; version <= 3; version++ {
+		keys, err := c.RotateUserKey(ctx, "user-1")
+		if err != nil || keys.Current != version {
+			t.Fatalf("Expected version %d, got %v (%v)", version, keys, err)
+		}
+		changed, err := c.ReencryptFields(ctx, keys, "user-1", "effort_score", data)
+		if err != nil || !changed {
+			t.Fatalf("Expected fields to be re-encrypted, got %v (%v)", changed, err)
+		}
+		for field, val := range data {
+			if fieldKeyVersion(val.(string)) != version {
+				t.Errorf("Expected %s to use version %d", field, version)
+			}
+		}
+		if changed, _ := c.ReencryptFields(ctx, keys, "user-1", "effort_score", data); changed {
+			t.Error("Expected current fields to be left alone")
+		}
+		if err := c.PruneUserKeys(ctx, "user-1", keys); err != nil {
+			t.Fatalf("PruneUserKeys: %v", err)
+		}
+	}
+
+	// The previous version survives for writers that loaded the keys before rotating
+	if got := store.keys["user-1"].Wrapped; len(got) != 2 || got[2] == nil || got[3] == nil {
+		t.Errorf("Expected versions 2 and 3 to be kept, got %v", got)
+	}
+	if err := c.DecryptFields(ctx, "user-1", "effort_score", data); err != nil {
+		t.Fatalf("DecryptFields: %v", err)
+	}
+	if data["legacy"] != 3.0 || !reflect.DeepEqual(data["history"], []interface{}{map[string]interface{}{"trimp": 80.0}}) {
+		t.Errorf("Expected data to survive rotation, got %v", data)
+	}
+}
+
+func TestBoosterData_SensitiveOnly(t *testing.T) {
+	ctx := context.Background()
+	c := NewUserDataCipher(&fakeKeyWrapper{}, newMemUserKeyStore())
+
+	plain := map[string]interface{}{"count": 3}
+	if err := DecryptBoosterData(ctx, "user-1", "recovery_advisor", plain); err != nil {
+		t.Errorf("Expected plaintext to read without a cipher, got %v", err)
+	}
+
+	withUserDataCipher(t, c)
+	if err := EncryptBoosterData(ctx, "user-1", "streak_tracker_run", plain); err != nil || plain["count"] != 3 {
+		t.Errorf("Expected non-sensitive booster data to stay plaintext, got %v (%v)", plain, err)
+	}
+	sensitive := map[string]interface{}{"2026-05-02": 42.5}
+	if err := EncryptBoosterData(ctx, "user-1", "recovery_advisor", sensitive); err != nil {
+		t.Fatalf("EncryptBoosterData: %v", err)
+	}
+
+	SetUserDataCipher(nil)
+	if err := DecryptBoosterData(ctx, "user-1", "recovery_advisor", sensitive); !errors.Is(err, ErrUserDataCipherMissing) {
+		t.Errorf("Expected ErrUserDataCipherMissing, got %v", err)
+	}
+}
@@ -0,0 +1,250 @@
+package firestore
+
+import (
+	"context"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// encryptedTokenPrefix marks a token encrypted by TokenCipher. Values without
+// it are plaintext written before encryption was enabled.
+const encryptedTokenPrefix = "enc:v1:"
+
+// tokenFields are the integration fields holding credentials.
+var tokenFields = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"api_key":       true,
+}
+
+// ErrTokenCipherMissing is returned when an encrypted token is read by a
+// service that has no cipher configured.
+var ErrTokenCipherMissing = errors.New("token is encrypted but no token cipher is configured")
+
+// TokenCipher envelope-encrypts tokens with AES-256-GCM. Each process
+// generates one data key and has it wrapped once; the wrapped key is stored
+// with every token so any process can decrypt it. Unwrapped keys are cached,
+// so KMS is called once per data key rather than once per token.
+type TokenCipher struct {
+	keys *keyCache
+
+	mu      sync.Mutex
+	aead    cipher.AEAD
+	wrapped []byte
+}
+
+func NewTokenCipher(wrapper KeyWrapper) *TokenCipher {
+	return &TokenCipher{keys: newKeyCache(wrapper)}
+}
+
+// Encrypt returns the token as "enc:v1:" followed by the base64 of the
+// wrapped key length (2 bytes), the wrapped key, the nonce and the
+// ciphertext. Empty and already encrypted values are returned unchanged.
+func (c *TokenCipher) Encrypt(ctx context.Context, plaintext string) (string, error) {
+	if plaintext == "" || IsEncryptedToken(plaintext) {
+		return plaintext, nil
+	}
+	aead, wrapped, err := c.dataKey(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, 2, 2+len(wrapped)+aead.NonceSize()+len(plaintext)+aead.Overhead())
+	binary.BigEndian.PutUint16(buf, uint16(len(wrapped)))
+	buf = append(buf, wrapped...)
+	if buf, err = seal(buf, aead, []byte(plaintext), nil); err != nil {
+		return "", err
+	}
+	return encryptedTokenPrefix + base64.StdEncoding.EncodeToString(buf), nil
+}
+
+// Decrypt reverses Encrypt. Plaintext values from before encryption was
+// enabled are returned unchanged.
+func (c *TokenCipher) Decrypt(ctx context.Context, value string) (string, error) {
+	if !IsEncryptedToken(value) {
+		return value, nil
+	}
+	buf, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, encryptedTokenPrefix))
+	if err != nil || len(buf) < 2 {
+		return "", errors.New("malformed encrypted token")
+	}
+	n := int(binary.BigEndian.Uint16(buf))
+	if len(buf) < 2+n {
+		return "", errors.New("malformed encrypted token")
+	}
+	wrapped, rest := buf[2:2+n], buf[2+n:]
+
+	aead, err := c.keys.unwrap(ctx, wrapped)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(aead, rest, nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypt token: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// dataKey returns the process's data key, generating and wrapping it on
+// first use.
+func (c *TokenCipher) dataKey(ctx context.Context) (cipher.AEAD, []byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.aead != nil {
+		return c.aead, c.wrapped, nil
+	}
+
+	aead, wrapped, err := c.keys.generate(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(wrapped) > 0xffff {
+		return nil, nil, errors.New("wrapped data key is too long")
+	}
+	c.aead, c.wrapped = aead, wrapped
+	return aead, wrapped, nil
+}
+
+// IsEncryptedToken reports whether a stored token was written by TokenCipher.
+func IsEncryptedToken(value string) bool {
+	return strings.HasPrefix(value, encryptedTokenPrefix)
+}
+
+var tokenCipher atomic.Pointer[TokenCipher]
+
+// SetTokenCipher sets the cipher used for integration tokens in user
+// documents. With no cipher, tokens are written in plaintext, which is what
+// local development and tests use.
+func SetTokenCipher(c *TokenCipher) {
+	tokenCipher.Store(c)
+}
+
+// EncryptUserTokens encrypts, in place, the integration tokens in a user
+// document or in a partial update of one. Keys may be dotted paths, so
+// {"integrations.strava.access_token": ...} and nested maps are both handled.
+func EncryptUserTokens(ctx context.Context, data map[string]interface{}) error {
+	if tokenCipher.Load() == nil {
+		return nil
+	}
+	return transformTokens(ctx, data, nil, encryptToken)
+}
+
+// DecryptUserTokens decrypts, in place, the integration tokens in a user
+// document. Plaintext tokens are left as they are.
+func DecryptUserTokens(ctx context.Context, data map[string]interface{}) error {
+	return transformTokens(ctx, data, nil, decryptToken)
+}
+
+// encryptToken encrypts a credential with the configured token cipher, or
+// returns it unchanged when there is none.
+func encryptToken(ctx context.Context, value string) (string, error) {
+	c := tokenCipher.Load()
+	if c == nil {
+		return value, nil
+	}
+	return c.Encrypt(ctx, value)
+}
+
+func decryptToken(ctx context.Context, value string) (string, error) {
+	c := tokenCipher.Load()
+	if c == nil {
+		if IsEncryptedToken(value) {
+			return "", ErrTokenCipherMissing
+		}
+		return value, nil
+	}
+	return c.Decrypt(ctx, value)
+}
+
+// transformTokens applies fn to every integrations.<provider>.<token field>
+// string under m, where path is the field path of m itself.
+func transformTokens(ctx context.Context, m map[string]interface{}, path []string, fn func(context.Context, string) (string, error)) error {
+	for key, val := range m {
+		p := append(append([]string(nil), path...), strings.Split(key, ".")...)
+		if p[0] != "integrations" || len(p) > 3 {
+			continue
+		}
+		switch v := val.(type) {
+		case map[string]interface{}:
+			if err := transformTokens(ctx, v, p, fn); err != nil {
+				return err
+			}
+		case string:
+			if len(p) != 3 || !tokenFields[p[2]] {
+				continue
+			}
+			out, err := fn(ctx, v)
+			if err != nil {
+				return fmt.Errorf("%s: %w", strings.Join(p, "."), err)
+			}
+			m[key] = out
+		}
+	}
+	return nil
+}
+
+// MigrateUserTokens encrypts the plaintext tokens left in user documents
+// written before encryption was enabled, returning the number of documents
+// changed. Documents updated while the migration runs are skipped and picked
+// up by the next run.
+func MigrateUserTokens(ctx context.Context, fs *firestore.Client) (int, error) {
+	c := tokenCipher.Load()
+	if c == nil {
+		return 0, errors.New("no token cipher configured")
+	}
+
+	migrated := 0
+	iter := fs.Collection("users").Select("integrations").Documents(ctx)
+	defer iter.Stop()
+	for {
+		snap, err := iter.Next()
+		if err == iterator.Done {
+			return migrated, nil
+		}
+		if err != nil {
+			return migrated, err
+		}
+
+		integrations, _ := snap.Data()["integrations"].(map[string]interface{})
+		var updates []firestore.Update
+		for provider, raw := range integrations {
+			fields, _ := raw.(map[string]interface{})
+			for field, val := range fields {
+				token, ok := val.(string)
+				if !ok || !tokenFields[field] || token == "" || IsEncryptedToken(token) {
+					continue
+				}
+				encrypted, err := c.Encrypt(ctx, token)
+				if err != nil {
+					return migrated, err
+				}
+				updates = append(updates, firestore.Update{
+					FieldPath: firestore.FieldPath{"integrations", provider, field},
+					Value:     encrypted,
+				})
+			}
+		}
+		if len(updates) == 0 {
+			continue
+		}
+
+		if _, err := snap.Ref.Update(ctx, updates, firestore.LastUpdateTime(snap.UpdateTime)); err != nil {
+			if status.Code(err) == codes.FailedPrecondition {
+				continue
+			}
+			return migrated, fmt.Errorf("user %s: %w", snap.Ref.ID, err)
+		}
+		migrated++
+	}
+}
@@ -0,0 +1,159 @@
+package firestore
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeKeyWrapper "wraps" keys by reversing them and counts the calls.
+type fakeKeyWrapper struct {
+	wraps, unwraps int
+}
+
+func (f *fakeKeyWrapper) WrapKey(ctx context.Context, dek []byte) ([]byte, error) {
+	f.wraps++
+	return reversed(dek), nil
+}
+
+func (f *fakeKeyWrapper) UnwrapKey(ctx context.Context, wrapped []byte) ([]byte, error) {
+	f.unwraps++
+	return reversed(wrapped), nil
+}
+
+func reversed(b []byte) []byte {
+	out := make([]byte, len(b))
+	for i := range b {
+		out[len(b)-1-i] = b[i]
+	}
+	return out
+}
+
+func withTokenCipher(t *testing.T, c *TokenCipher) {
+	t.Helper()
+	SetTokenCipher(c)
+	t.Cleanup(func() { SetTokenCipher(nil) })
+}
+
+func TestTokenCipher_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	wrapper := &fakeKeyWrapper{}
+	c := NewTokenCipher(wrapper)
+
+	first, err := c.Encrypt(ctx, "secret-token")
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	second, _ := c.Encrypt(ctx, "secret-token")
+	if !IsEncryptedToken(first) || strings.Contains(first, "secret") {
+		t.Fatalf("Expected an encrypted token, got %q", first)
+	}
+	if first == second {
+		t.Error("Expected a fresh nonce for every token")
+	}
+	if wrapper.wraps != 1 {
+		t.Errorf("Expected the data key to be wrapped once, got %d", wrapper.wraps)
+	}
+
+	// A second process unwraps the key once and caches it
+	other := NewTokenCipher(wrapper)
+	for _, enc := range []string{first, second} {
+		got, err := other.Decrypt(ctx, enc)
+		if err != nil || got != "secret-token" {
+			t.Errorf("Expected secret-token, got %q (%v)", got, err)
+		}
+	}
+	if wrapper.unwraps != 1 {
+		t.Errorf("Expected one unwrap, got %d", wrapper.unwraps)
+	}
+
+	if again, _ := c.Encrypt(ctx, first); again != first {
+		t.Error("Expected an encrypted token not to be encrypted twice")
+	}
+	if got, _ := c.Decrypt(ctx, "legacy-plaintext"); got != "legacy-plaintext" {
+		t.Errorf("Expected plaintext to pass through, got %q", got)
+	}
+	if _, err := c.Decrypt(ctx, first[:len(first)-8]); err == nil {
+		t.Error("Expected a truncated token to fail")
+	}
+}
+
+func TestEncryptUserTokens_UpdateShapes(t *testing.T) {
+	ctx := context.Background()
+	withTokenCipher(t, NewTokenCipher(&fakeKeyWrapper{}))
+
+	update := map[string]interface{}{
+		"integrations.google.access_token": "a1",
+		"integrations.google.expires_at":   "2026-05-02T08:15:00Z",
+		"integrations.strava":              map[string]interface{}{"refresh_token": "r1", "athlete_id": int64(1)},
+		"integrations":                     map[string]interface{}{"hevy": map[string]interface{}{"api_key": "k1", "user_id": "u1"}},
+		"access_token":                     "not-an-integration",
+	}
+	if err := EncryptUserTokens(ctx, update); err != nil {
+		t.Fatalf("EncryptUserTokens: %v", err)
+	}
+
+	encrypted := []string{
+		update["integrations.google.access_token"].(string),
+		update["integrations.strava"].(map[string]interface{})["refresh_token"].(string),
+		update["integrations"].(map[string]interface{})["hevy"].(map[string]interface{})["api_key"].(string),
+	}
+	for _, v := range encrypted {
+		if !IsEncryptedToken(v) {
+			t.Errorf("Expected %q to be encrypted", v)
+		}
+	}
+	if update["integrations.google.expires_at"] != "2026-05-02T08:15:00Z" || update["access_token"] != "not-an-integration" {
+		t.Errorf("Expected non-token fields to be untouched, got %v", update)
+	}
+	if update["integrations"].(map[string]interface{})["hevy"].(map[string]interface{})["user_id"] != "u1" {
+		t.Error("Expected user_id to be untouched")
+	}
+}
+
+func TestUserTokens_StoredEncryptedAndReadBack(t *testing.T) {
+	ctx := context.Background()
+	withTokenCipher(t, NewTokenCipher(&fakeKeyWrapper{}))
+
+	want := goldenUser()
+	doc := UserToFirestore(want)
+	if err := EncryptUserTokens(ctx, doc); err != nil {
+		t.Fatalf("EncryptUserTokens: %v", err)
+	}
+	strava := doc["integrations"].(map[string]interface{})["strava"].(map[string]interface{})
+	if !IsEncryptedToken(strava["access_token"].(string)) || !IsEncryptedToken(strava["refresh_token"].(string)) {
+		t.Fatalf("Expected Strava tokens to be encrypted, got %v", strava)
+	}
+
+	// A legacy document mixes plaintext tokens with encrypted ones
+	doc["integrations"].(map[string]interface{})["hevy"].(map[string]interface{})["api_key"] = "hevy-key"
+
+	if err := DecryptUserTokens(ctx, doc); err != nil {
+		t.Fatalf("DecryptUserTokens: %v", err)
+	}
+	if got := FirestoreToUser(doc); !proto.Equal(got.Integrations, want.Integrations) {
+		t.Errorf("got:  %v\nwant: %v", got.Integrations, want.Integrations)
+	}
+}
+
+func TestDecryptUserTokens_NoCipher(t *testing.T) {
+	ctx := context.Background()
+	c := NewTokenCipher(&fakeKeyWrapper{})
+	enc, _ := c.Encrypt(ctx, "secret")
+
+	plain := map[string]interface{}{"integrations": map[string]interface{}{"strava": map[string]interface{}{"access_token": "secret"}}}
+	if err := DecryptUserTokens(ctx, plain); err != nil {
+		t.Errorf("Expected plaintext to read without a cipher, got %v", err)
+	}
+	if err := EncryptUserTokens(ctx, plain); err != nil || plain["integrations"].(map[string]interface{})["strava"].(map[string]interface{})["access_token"] != "secret" {
+		t.Errorf("Expected tokens to be written in plaintext without a cipher, got %v (%v)", plain, err)
+	}
+
+	doc := map[string]interface{}{"integrations": map[string]interface{}{"strava": map[string]interface{}{"access_token": enc}}}
+	if err := DecryptUserTokens(ctx, doc); !errors.Is(err, ErrTokenCipherMissing) {
+		t.Errorf("Expected ErrTokenCipherMissing, got %v", err)
+	}
+}
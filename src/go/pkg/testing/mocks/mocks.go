@@ -3,7 +3,9 @@ package mocks
 import (
 	"context"
 	"fmt"
+	"time"
 
+	shared "github.com/fitglue/server/src/go/pkg"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
 
 	"github.com/cloudevents/sdk-go/v2/event"
@@ -22,18 +24,48 @@ type MockDatabase struct {
 	GetUserFunc         func(ctx context.Context, id string) (*user.Record, error)
 	UpdateUserFunc      func(ctx context.Context, id string, data map[string]interface{}) error
 
+	GetUsageFunc       func(ctx context.Context, userID string, period string) (map[string]int64, error)
+	IncrementUsageFunc func(ctx context.Context, userID string, period string, feature string, amount int64) error
+
+	GetIntegrationSecretFunc func(ctx context.Context, userId string, provider string) (string, error)
+	SetIntegrationSecretFunc func(ctx context.Context, userId string, provider string, secret string) error
+
 	CreatePendingInputFunc func(ctx context.Context, userId string, input *pbpipeline.PendingInput) error
 	GetPendingInputFunc    func(ctx context.Context, userId string, id string) (*pbpipeline.PendingInput, error)
 	UpdatePendingInputFunc func(ctx context.Context, userId string, id string, data map[string]interface{}) error
 	ListPendingInputsFunc  func(ctx context.Context, userID string) ([]*pbpipeline.PendingInput, error)
 
-	GetCounterFunc       func(ctx context.Context, userId string, id string) (*pbuser.Counter, error)
-	SetCounterFunc       func(ctx context.Context, userId string, counter *pbuser.Counter) error
-	ListCountersFunc     func(ctx context.Context, userId string) ([]*pbuser.Counter, error)
-	GetUserPipelinesFunc func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error)
+	GetCounterFunc               func(ctx context.Context, userId string, id string) (*pbuser.Counter, error)
+	SetCounterFunc               func(ctx context.Context, userId string, counter *pbuser.Counter) error
+	ListCountersFunc             func(ctx context.Context, userId string) ([]*pbuser.Counter, error)
+	GetUserPipelinesFunc         func(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error)
+	GetPipelineConfigVersionFunc func(ctx context.Context, userId string, pipelineId string, version int32) (*pbpipeline.PipelineConfigVersion, error)
+
+	GetGearFunc       func(ctx context.Context, userId string, gearId string) (*pbuser.Gear, error)
+	RecordGearUseFunc func(ctx context.Context, userId string, gearId string, activityId string, distanceMeters float64, usedAt time.Time) (*pbuser.Gear, bool, error)
+
+	ListGoalsFunc  func(ctx context.Context, userId string) ([]*pbuser.Goal, error)
+	GetGoalFunc    func(ctx context.Context, userId string, goalId string) (*pbuser.Goal, error)
+	SetGoalFunc    func(ctx context.Context, userId string, goal *pbuser.Goal) error
+	DeleteGoalFunc func(ctx context.Context, userId string, goalId string) error
+
+	GetActivityDayCountsFunc func(ctx context.Context, userId string, since time.Time) (map[string]int, error)
+	AddPipelineRunCostFunc   func(ctx context.Context, userId string, id string, cost *pbpipeline.RunCost) error
+
+	GetPersonalRecordFunc func(ctx context.Context, userId string, recordType string) (*pbuser.PersonalRecord, error)
+	SetPersonalRecordFunc func(ctx context.Context, userId string, record *pbuser.PersonalRecord) error
+
+	ListActivityTypeRulesFunc func(ctx context.Context, userId string) ([]*pbpipeline.ActivityTypeRule, error)
 
 	GetBoosterDataFunc func(ctx context.Context, userId string, boosterId string) (map[string]interface{}, error)
 	SetBoosterDataFunc func(ctx context.Context, userId string, boosterId string, data map[string]interface{}) error
+
+	GetProviderCircuitFunc       func(ctx context.Context, providerType pbplugin.EnricherProviderType) (*pbpipeline.ProviderCircuit, error)
+	UpdateProviderCircuitFunc    func(ctx context.Context, providerType pbplugin.EnricherProviderType, fn func(c *pbpipeline.ProviderCircuit)) (*pbpipeline.ProviderCircuit, error)
+	AddProviderCircuitChangeFunc func(ctx context.Context, change *pbpipeline.ProviderCircuitChange) error
+
+	GetEnricherResultShareFunc func(ctx context.Context, userId string, id string) (*pbpipeline.EnricherResultShare, error)
+	SetEnricherResultShareFunc func(ctx context.Context, userId string, share *pbpipeline.EnricherResultShare) error
 }
 
 func (m *MockDatabase) SetExecution(ctx context.Context, record *pbpipeline.ExecutionRecord) error {
@@ -61,6 +93,20 @@ func (m *MockDatabase) UpdateUser(ctx context.Context, id string, data map[strin
 	return nil
 }
 
+func (m *MockDatabase) GetIntegrationSecret(ctx context.Context, userId string, provider string) (string, error) {
+	if m.GetIntegrationSecretFunc != nil {
+		return m.GetIntegrationSecretFunc(ctx, userId, provider)
+	}
+	return "", nil
+}
+
+func (m *MockDatabase) SetIntegrationSecret(ctx context.Context, userId string, provider string, secret string) error {
+	if m.SetIntegrationSecretFunc != nil {
+		return m.SetIntegrationSecretFunc(ctx, userId, provider, secret)
+	}
+	return nil
+}
+
 func (m *MockDatabase) CreatePendingInput(ctx context.Context, userId string, input *pbpipeline.PendingInput) error {
 	if m.CreatePendingInputFunc != nil {
 		return m.CreatePendingInputFunc(ctx, userId, input)
@@ -137,6 +183,22 @@ func (m *MockDatabase) ResetSyncCount(ctx context.Context, userID string) error
 	return nil
 }
 
+// --- Feature Usage (for tier quotas) ---
+
+func (m *MockDatabase) GetUsage(ctx context.Context, userID string, period string) (map[string]int64, error) {
+	if m.GetUsageFunc != nil {
+		return m.GetUsageFunc(ctx, userID, period)
+	}
+	return map[string]int64{}, nil
+}
+
+func (m *MockDatabase) IncrementUsage(ctx context.Context, userID string, period string, feature string, amount int64) error {
+	if m.IncrementUsageFunc != nil {
+		return m.IncrementUsageFunc(ctx, userID, period, feature, amount)
+	}
+	return nil
+}
+
 func (m *MockDatabase) ListPendingInputsByEnricher(ctx context.Context, enricherId string, status pbpipeline.PendingInput_Status) ([]*pbpipeline.PendingInput, error) {
 	// No-op for tests by default
 	return nil, nil
@@ -187,11 +249,17 @@ func (m *MockDatabase) SetShowcaseProfileEntry(ctx context.Context, userID strin
 // --- Personal Records ---
 
 func (m *MockDatabase) GetPersonalRecord(ctx context.Context, userId string, recordType string) (*pbuser.PersonalRecord, error) {
+	if m.GetPersonalRecordFunc != nil {
+		return m.GetPersonalRecordFunc(ctx, userId, recordType)
+	}
 	// No-op for tests by default
 	return nil, nil
 }
 
 func (m *MockDatabase) SetPersonalRecord(ctx context.Context, userId string, record *pbuser.PersonalRecord) error {
+	if m.SetPersonalRecordFunc != nil {
+		return m.SetPersonalRecordFunc(ctx, userId, record)
+	}
 	// No-op for tests by default
 	return nil
 }
@@ -206,6 +274,52 @@ func (m *MockDatabase) DeletePersonalRecord(ctx context.Context, userId string,
 	return nil
 }
 
+// --- Gear ---
+
+func (m *MockDatabase) GetGear(ctx context.Context, userId string, gearId string) (*pbuser.Gear, error) {
+	if m.GetGearFunc != nil {
+		return m.GetGearFunc(ctx, userId, gearId)
+	}
+	return nil, nil
+}
+
+func (m *MockDatabase) RecordGearUse(ctx context.Context, userId string, gearId string, activityId string, distanceMeters float64, usedAt time.Time) (*pbuser.Gear, bool, error) {
+	if m.RecordGearUseFunc != nil {
+		return m.RecordGearUseFunc(ctx, userId, gearId, activityId, distanceMeters, usedAt)
+	}
+	return nil, false, nil
+}
+
+// --- Goals ---
+
+func (m *MockDatabase) ListGoals(ctx context.Context, userId string) ([]*pbuser.Goal, error) {
+	if m.ListGoalsFunc != nil {
+		return m.ListGoalsFunc(ctx, userId)
+	}
+	return nil, nil
+}
+
+func (m *MockDatabase) GetGoal(ctx context.Context, userId string, goalId string) (*pbuser.Goal, error) {
+	if m.GetGoalFunc != nil {
+		return m.GetGoalFunc(ctx, userId, goalId)
+	}
+	return nil, nil
+}
+
+func (m *MockDatabase) SetGoal(ctx context.Context, userId string, goal *pbuser.Goal) error {
+	if m.SetGoalFunc != nil {
+		return m.SetGoalFunc(ctx, userId, goal)
+	}
+	return nil
+}
+
+func (m *MockDatabase) DeleteGoal(ctx context.Context, userId string, goalId string) error {
+	if m.DeleteGoalFunc != nil {
+		return m.DeleteGoalFunc(ctx, userId, goalId)
+	}
+	return nil
+}
+
 // --- Pipelines (Sub-collection) ---
 
 func (m *MockDatabase) GetUserPipelines(ctx context.Context, userId string) ([]*pbpipeline.PipelineConfig, error) {
@@ -216,6 +330,13 @@ func (m *MockDatabase) GetUserPipelines(ctx context.Context, userId string) ([]*
 	return []*pbpipeline.PipelineConfig{}, nil
 }
 
+func (m *MockDatabase) GetPipelineConfigVersion(ctx context.Context, userId string, pipelineId string, version int32) (*pbpipeline.PipelineConfigVersion, error) {
+	if m.GetPipelineConfigVersionFunc != nil {
+		return m.GetPipelineConfigVersionFunc(ctx, userId, pipelineId, version)
+	}
+	return nil, nil
+}
+
 // --- Plugin Defaults (user-level default config) ---
 
 func (m *MockDatabase) GetPluginDefault(ctx context.Context, userId string, pluginId string) (*pbpipeline.PluginDefault, error) {
@@ -228,6 +349,21 @@ func (m *MockDatabase) SetPluginDefault(ctx context.Context, userId string, plug
 	return nil
 }
 
+// --- Activity Type Rules ---
+
+func (m *MockDatabase) ListActivityTypeRules(ctx context.Context, userId string) ([]*pbpipeline.ActivityTypeRule, error) {
+	if m.ListActivityTypeRulesFunc != nil {
+		return m.ListActivityTypeRulesFunc(ctx, userId)
+	}
+	// No-op for tests by default
+	return nil, nil
+}
+
+func (m *MockDatabase) MarkActivityTypeRuleApplied(ctx context.Context, userId string, ruleId string) error {
+	// No-op for tests by default
+	return nil
+}
+
 // --- Uploaded Activities (for loop prevention) ---
 
 func (m *MockDatabase) SetUploadedActivity(ctx context.Context, userId string, record *pbactivity.UploadedActivityRecord) error {
@@ -262,6 +398,20 @@ func (m *MockDatabase) UpdatePipelineRun(ctx context.Context, userId string, id
 	return nil
 }
 
+func (m *MockDatabase) GetActivityDayCounts(ctx context.Context, userId string, since time.Time) (map[string]int, error) {
+	if m.GetActivityDayCountsFunc != nil {
+		return m.GetActivityDayCountsFunc(ctx, userId, since)
+	}
+	return nil, nil
+}
+
+func (m *MockDatabase) AddPipelineRunCost(ctx context.Context, userId string, id string, cost *pbpipeline.RunCost) error {
+	if m.AddPipelineRunCostFunc != nil {
+		return m.AddPipelineRunCostFunc(ctx, userId, id, cost)
+	}
+	return nil
+}
+
 // --- Destination Outcomes (subcollection of Pipeline Runs) ---
 
 func (m *MockDatabase) SetDestinationOutcome(ctx context.Context, userId string, pipelineRunId string, outcome *pbpipeline.DestinationOutcome) error {
@@ -274,6 +424,15 @@ func (m *MockDatabase) GetDestinationOutcomes(ctx context.Context, userId string
 	return nil, nil
 }
 
+func (m *MockDatabase) UpdateDestinationOutcomes(ctx context.Context, userId string, pipelineRunId string, fn func(run *pbpipeline.PipelineRun, outcomes []*pbpipeline.DestinationOutcome) ([]*pbpipeline.DestinationOutcome, map[string]interface{})) error {
+	// No-op for tests by default
+	return nil
+}
+
+func (m *MockDatabase) ListStuckPipelineRuns(ctx context.Context, before time.Time, limit int) ([]*shared.UserPipelineRun, error) {
+	return nil, nil
+}
+
 // --- Booster Data (generic key-value storage for enrichers) ---
 
 func (m *MockDatabase) GetBoosterData(ctx context.Context, userId string, boosterId string) (map[string]interface{}, error) {
@@ -295,6 +454,45 @@ func (m *MockDatabase) DeleteBoosterData(ctx context.Context, userId string, boo
 	return nil
 }
 
+// --- Provider Circuits ---
+
+func (m *MockDatabase) GetProviderCircuit(ctx context.Context, providerType pbplugin.EnricherProviderType) (*pbpipeline.ProviderCircuit, error) {
+	if m.GetProviderCircuitFunc != nil {
+		return m.GetProviderCircuitFunc(ctx, providerType)
+	}
+	return nil, nil
+}
+
+func (m *MockDatabase) UpdateProviderCircuit(ctx context.Context, providerType pbplugin.EnricherProviderType, fn func(c *pbpipeline.ProviderCircuit)) (*pbpipeline.ProviderCircuit, error) {
+	if m.UpdateProviderCircuitFunc != nil {
+		return m.UpdateProviderCircuitFunc(ctx, providerType, fn)
+	}
+	c := &pbpipeline.ProviderCircuit{ProviderType: providerType}
+	fn(c)
+	return c, nil
+}
+
+func (m *MockDatabase) AddProviderCircuitChange(ctx context.Context, change *pbpipeline.ProviderCircuitChange) error {
+	if m.AddProviderCircuitChangeFunc != nil {
+		return m.AddProviderCircuitChangeFunc(ctx, change)
+	}
+	return nil
+}
+
+func (m *MockDatabase) GetEnricherResultShare(ctx context.Context, userId string, id string) (*pbpipeline.EnricherResultShare, error) {
+	if m.GetEnricherResultShareFunc != nil {
+		return m.GetEnricherResultShareFunc(ctx, userId, id)
+	}
+	return nil, nil
+}
+
+func (m *MockDatabase) SetEnricherResultShare(ctx context.Context, userId string, share *pbpipeline.EnricherResultShare) error {
+	if m.SetEnricherResultShareFunc != nil {
+		return m.SetEnricherResultShareFunc(ctx, userId, share)
+	}
+	return nil
+}
+
 // --- Mock Publisher ---
 type MockPublisher struct {
 	PublishCloudEventFunc func(ctx context.Context, topic string, e event.Event) (string, error)
@@ -400,6 +400,20 @@ func FormatDestination(value pbplugin.DestinationType) string {
 		return "Google Sheets"
 	case pbplugin.DestinationType_DESTINATION_GITHUB:
 		return "GitHub"
+	case pbplugin.DestinationType_DESTINATION_KOMOOT:
+		return "Komoot"
+	case pbplugin.DestinationType_DESTINATION_DROPBOX:
+		return "Dropbox"
+	case pbplugin.DestinationType_DESTINATION_WEBDAV:
+		return "WebDAV"
+	case pbplugin.DestinationType_DESTINATION_NOTION:
+		return "Notion"
+	case pbplugin.DestinationType_DESTINATION_DISCORD:
+		return "Discord"
+	case pbplugin.DestinationType_DESTINATION_SLACK:
+		return "Slack"
+	case pbplugin.DestinationType_DESTINATION_EMAIL:
+		return "Email"
 	case pbplugin.DestinationType_DESTINATION_MOCK:
 		return "Mock"
 	default:
@@ -434,6 +448,20 @@ func ParseDestination(input string) pbplugin.DestinationType {
 		"google sheets":             pbplugin.DestinationType_DESTINATION_GOOGLESHEETS,
 		"destination_github":        pbplugin.DestinationType_DESTINATION_GITHUB,
 		"github":                    pbplugin.DestinationType_DESTINATION_GITHUB,
+		"destination_komoot":        pbplugin.DestinationType_DESTINATION_KOMOOT,
+		"komoot":                    pbplugin.DestinationType_DESTINATION_KOMOOT,
+		"destination_dropbox":       pbplugin.DestinationType_DESTINATION_DROPBOX,
+		"dropbox":                   pbplugin.DestinationType_DESTINATION_DROPBOX,
+		"destination_webdav":        pbplugin.DestinationType_DESTINATION_WEBDAV,
+		"webdav":                    pbplugin.DestinationType_DESTINATION_WEBDAV,
+		"destination_notion":        pbplugin.DestinationType_DESTINATION_NOTION,
+		"notion":                    pbplugin.DestinationType_DESTINATION_NOTION,
+		"destination_discord":       pbplugin.DestinationType_DESTINATION_DISCORD,
+		"discord":                   pbplugin.DestinationType_DESTINATION_DISCORD,
+		"destination_slack":         pbplugin.DestinationType_DESTINATION_SLACK,
+		"slack":                     pbplugin.DestinationType_DESTINATION_SLACK,
+		"destination_email":         pbplugin.DestinationType_DESTINATION_EMAIL,
+		"email":                     pbplugin.DestinationType_DESTINATION_EMAIL,
 		"destination_mock":          pbplugin.DestinationType_DESTINATION_MOCK,
 		"mock":                      pbplugin.DestinationType_DESTINATION_MOCK,
 	}
@@ -463,6 +491,8 @@ func FormatCloudEventType(value pbevents.CloudEventType) string {
 		return "Input Resolved"
 	case pbevents.CloudEventType_CLOUD_EVENT_TYPE_PARKRUN_RESULTS:
 		return "Parkrun Results"
+	case pbevents.CloudEventType_CLOUD_EVENT_TYPE_BACKFILL_REQUESTED:
+		return "Backfill Requested"
 	default:
 		return "Unknown"
 	}
@@ -499,6 +529,9 @@ func ParseCloudEventType(input string) pbevents.CloudEventType {
 		"cloud_event_type_parkrun_results":     pbevents.CloudEventType_CLOUD_EVENT_TYPE_PARKRUN_RESULTS,
 		"parkrun_results":                      pbevents.CloudEventType_CLOUD_EVENT_TYPE_PARKRUN_RESULTS,
 		"parkrun results":                      pbevents.CloudEventType_CLOUD_EVENT_TYPE_PARKRUN_RESULTS,
+		"cloud_event_type_backfill_requested":  pbevents.CloudEventType_CLOUD_EVENT_TYPE_BACKFILL_REQUESTED,
+		"backfill_requested":                   pbevents.CloudEventType_CLOUD_EVENT_TYPE_BACKFILL_REQUESTED,
+		"backfill requested":                   pbevents.CloudEventType_CLOUD_EVENT_TYPE_BACKFILL_REQUESTED,
 	}
 
 	normalized := strings.ToLower(strings.TrimSpace(input))
@@ -544,6 +577,12 @@ func FormatCloudEventSource(value pbevents.CloudEventSource) string {
 		return "Apple Health"
 	case pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_HEALTH_CONNECT:
 		return "Health Connect"
+	case pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_WHOOP:
+		return "Whoop"
+	case pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_ZWIFT:
+		return "Zwift"
+	case pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_BACKFILL:
+		return "Backfill"
 	case pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_MOCK:
 		return "Mock"
 	default:
@@ -602,6 +641,12 @@ func ParseCloudEventSource(input string) pbevents.CloudEventSource {
 		"cloud_event_source_health_connect":    pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_HEALTH_CONNECT,
 		"health_connect":                       pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_HEALTH_CONNECT,
 		"health connect":                       pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_HEALTH_CONNECT,
+		"cloud_event_source_whoop":             pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_WHOOP,
+		"whoop":                                pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_WHOOP,
+		"cloud_event_source_zwift":             pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_ZWIFT,
+		"zwift":                                pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_ZWIFT,
+		"cloud_event_source_backfill":          pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_BACKFILL,
+		"backfill":                             pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_BACKFILL,
 		"cloud_event_source_mock":              pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_MOCK,
 		"mock":                                 pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_MOCK,
 	}
@@ -647,6 +692,10 @@ func FormatActivitySource(value pbactivity.ActivitySource) string {
 		return "Googlesheets"
 	case pbactivity.ActivitySource_SOURCE_GITHUB:
 		return "Github"
+	case pbactivity.ActivitySource_SOURCE_WHOOP:
+		return "Whoop"
+	case pbactivity.ActivitySource_SOURCE_ZWIFT:
+		return "Zwift"
 	case pbactivity.ActivitySource_SOURCE_TEST:
 		return "Test"
 	default:
@@ -698,6 +747,10 @@ func ParseActivitySource(input string) pbactivity.ActivitySource {
 		"googlesheets":           pbactivity.ActivitySource_SOURCE_GOOGLESHEETS,
 		"source_github":          pbactivity.ActivitySource_SOURCE_GITHUB,
 		"github":                 pbactivity.ActivitySource_SOURCE_GITHUB,
+		"source_whoop":           pbactivity.ActivitySource_SOURCE_WHOOP,
+		"whoop":                  pbactivity.ActivitySource_SOURCE_WHOOP,
+		"source_zwift":           pbactivity.ActivitySource_SOURCE_ZWIFT,
+		"zwift":                  pbactivity.ActivitySource_SOURCE_ZWIFT,
 		"source_test":            pbactivity.ActivitySource_SOURCE_TEST,
 		"test":                   pbactivity.ActivitySource_SOURCE_TEST,
 	}
@@ -789,6 +842,34 @@ func FormatEnricherProviderType(value pbplugin.EnricherProviderType) string {
 		return "Effort Score"
 	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_INTERVALS:
 		return "Intervals"
+	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_TIMESTAMP_SANITY:
+		return "Timestamp Sanity"
+	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PACE_TARGET:
+		return "Pace Target"
+	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PHOTO_GEOTAG:
+		return "Photo Geotag"
+	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_INTERVAL_DETECTION:
+		return "Interval Detection"
+	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_OURA_READINESS:
+		return "Oura Readiness"
+	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ENERGY_EXPENDITURE:
+		return "Energy Expenditure"
+	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_RUNNING_POWER:
+		return "Running Power"
+	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_GEAR_TRACKER:
+		return "Gear Tracker"
+	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_CONSISTENCY:
+		return "Consistency"
+	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_GOAL_PROGRESS:
+		return "Goal Progress"
+	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_STRAVA_SEGMENTS:
+		return "Strava Segments"
+	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_DATA_QUALITY:
+		return "Data Quality"
+	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ANOMALY_CHECK:
+		return "Anomaly Check"
+	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ACTIVE_RECOVERY:
+		return "Active Recovery"
 	case pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MOCK:
 		return "Mock"
 	default:
@@ -918,6 +999,47 @@ func ParseEnricherProviderType(input string) pbplugin.EnricherProviderType {
 		"effort score":                           pbplugin.EnricherProviderType_ENRICHER_PROVIDER_EFFORT_SCORE,
 		"enricher_provider_intervals":            pbplugin.EnricherProviderType_ENRICHER_PROVIDER_INTERVALS,
 		"intervals":                              pbplugin.EnricherProviderType_ENRICHER_PROVIDER_INTERVALS,
+		"enricher_provider_timestamp_sanity":     pbplugin.EnricherProviderType_ENRICHER_PROVIDER_TIMESTAMP_SANITY,
+		"timestamp_sanity":                       pbplugin.EnricherProviderType_ENRICHER_PROVIDER_TIMESTAMP_SANITY,
+		"timestamp sanity":                       pbplugin.EnricherProviderType_ENRICHER_PROVIDER_TIMESTAMP_SANITY,
+		"enricher_provider_pace_target":          pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PACE_TARGET,
+		"pace_target":                            pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PACE_TARGET,
+		"pace target":                            pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PACE_TARGET,
+		"enricher_provider_photo_geotag":         pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PHOTO_GEOTAG,
+		"photo_geotag":                           pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PHOTO_GEOTAG,
+		"photo geotag":                           pbplugin.EnricherProviderType_ENRICHER_PROVIDER_PHOTO_GEOTAG,
+		"enricher_provider_interval_detection":   pbplugin.EnricherProviderType_ENRICHER_PROVIDER_INTERVAL_DETECTION,
+		"interval_detection":                     pbplugin.EnricherProviderType_ENRICHER_PROVIDER_INTERVAL_DETECTION,
+		"interval detection":                     pbplugin.EnricherProviderType_ENRICHER_PROVIDER_INTERVAL_DETECTION,
+		"enricher_provider_oura_readiness":       pbplugin.EnricherProviderType_ENRICHER_PROVIDER_OURA_READINESS,
+		"oura_readiness":                         pbplugin.EnricherProviderType_ENRICHER_PROVIDER_OURA_READINESS,
+		"oura readiness":                         pbplugin.EnricherProviderType_ENRICHER_PROVIDER_OURA_READINESS,
+		"enricher_provider_energy_expenditure":   pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ENERGY_EXPENDITURE,
+		"energy_expenditure":                     pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ENERGY_EXPENDITURE,
+		"energy expenditure":                     pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ENERGY_EXPENDITURE,
+		"enricher_provider_running_power":        pbplugin.EnricherProviderType_ENRICHER_PROVIDER_RUNNING_POWER,
+		"running_power":                          pbplugin.EnricherProviderType_ENRICHER_PROVIDER_RUNNING_POWER,
+		"running power":                          pbplugin.EnricherProviderType_ENRICHER_PROVIDER_RUNNING_POWER,
+		"enricher_provider_gear_tracker":         pbplugin.EnricherProviderType_ENRICHER_PROVIDER_GEAR_TRACKER,
+		"gear_tracker":                           pbplugin.EnricherProviderType_ENRICHER_PROVIDER_GEAR_TRACKER,
+		"gear tracker":                           pbplugin.EnricherProviderType_ENRICHER_PROVIDER_GEAR_TRACKER,
+		"enricher_provider_consistency":          pbplugin.EnricherProviderType_ENRICHER_PROVIDER_CONSISTENCY,
+		"consistency":                            pbplugin.EnricherProviderType_ENRICHER_PROVIDER_CONSISTENCY,
+		"enricher_provider_goal_progress":        pbplugin.EnricherProviderType_ENRICHER_PROVIDER_GOAL_PROGRESS,
+		"goal_progress":                          pbplugin.EnricherProviderType_ENRICHER_PROVIDER_GOAL_PROGRESS,
+		"goal progress":                          pbplugin.EnricherProviderType_ENRICHER_PROVIDER_GOAL_PROGRESS,
+		"enricher_provider_strava_segments":      pbplugin.EnricherProviderType_ENRICHER_PROVIDER_STRAVA_SEGMENTS,
+		"strava_segments":                        pbplugin.EnricherProviderType_ENRICHER_PROVIDER_STRAVA_SEGMENTS,
+		"strava segments":                        pbplugin.EnricherProviderType_ENRICHER_PROVIDER_STRAVA_SEGMENTS,
+		"enricher_provider_data_quality":         pbplugin.EnricherProviderType_ENRICHER_PROVIDER_DATA_QUALITY,
+		"data_quality":                           pbplugin.EnricherProviderType_ENRICHER_PROVIDER_DATA_QUALITY,
+		"data quality":                           pbplugin.EnricherProviderType_ENRICHER_PROVIDER_DATA_QUALITY,
+		"enricher_provider_anomaly_check":        pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ANOMALY_CHECK,
+		"anomaly_check":                          pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ANOMALY_CHECK,
+		"anomaly check":                          pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ANOMALY_CHECK,
+		"enricher_provider_active_recovery":      pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ACTIVE_RECOVERY,
+		"active_recovery":                        pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ACTIVE_RECOVERY,
+		"active recovery":                        pbplugin.EnricherProviderType_ENRICHER_PROVIDER_ACTIVE_RECOVERY,
 		"enricher_provider_mock":                 pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MOCK,
 		"mock":                                   pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MOCK,
 	}
@@ -1093,6 +1215,8 @@ func FormatIntegrationAuthType(value pbplugin.IntegrationAuthType) string {
 		return "App Sync"
 	case pbplugin.IntegrationAuthType_INTEGRATION_AUTH_TYPE_PUBLIC_ID:
 		return "Public ID"
+	case pbplugin.IntegrationAuthType_INTEGRATION_AUTH_TYPE_CREDENTIALS:
+		return "Credentials"
 	default:
 		return "Manual"
 	}
@@ -1120,6 +1244,8 @@ func ParseIntegrationAuthType(input string) pbplugin.IntegrationAuthType {
 		"integration_auth_type_public_id":   pbplugin.IntegrationAuthType_INTEGRATION_AUTH_TYPE_PUBLIC_ID,
 		"public_id":                         pbplugin.IntegrationAuthType_INTEGRATION_AUTH_TYPE_PUBLIC_ID,
 		"public id":                         pbplugin.IntegrationAuthType_INTEGRATION_AUTH_TYPE_PUBLIC_ID,
+		"integration_auth_type_credentials": pbplugin.IntegrationAuthType_INTEGRATION_AUTH_TYPE_CREDENTIALS,
+		"credentials":                       pbplugin.IntegrationAuthType_INTEGRATION_AUTH_TYPE_CREDENTIALS,
 	}
 
 	normalized := strings.ToLower(strings.TrimSpace(input))
@@ -1427,6 +1553,10 @@ func FormatPipelineRunStatus(value pbpipeline.PipelineRunStatus) string {
 		return "Archived"
 	case pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_TIER_BLOCKED:
 		return "Tier Blocked"
+	case pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_QUEUED_PLATFORM_OUTAGE:
+		return "Queued Platform Outage"
+	case pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DEFERRED:
+		return "Deferred"
 	default:
 		return "Unknown"
 	}
@@ -1461,6 +1591,11 @@ func ParsePipelineRunStatus(input string) pbpipeline.PipelineRunStatus {
 		"pipeline_run_status_tier_blocked": pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_TIER_BLOCKED,
 		"tier_blocked":                     pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_TIER_BLOCKED,
 		"tier blocked":                     pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_TIER_BLOCKED,
+		"pipeline_run_status_queued_platform_outage": pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_QUEUED_PLATFORM_OUTAGE,
+		"queued_platform_outage":                     pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_QUEUED_PLATFORM_OUTAGE,
+		"queued platform outage":                     pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_QUEUED_PLATFORM_OUTAGE,
+		"pipeline_run_status_deferred":               pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DEFERRED,
+		"deferred":                                   pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DEFERRED,
 	}
 
 	normalized := strings.ToLower(strings.TrimSpace(input))
@@ -1482,6 +1617,8 @@ func FormatDestinationStatus(value pbpipeline.DestinationStatus) string {
 		return "Failed"
 	case pbpipeline.DestinationStatus_DESTINATION_STATUS_SKIPPED:
 		return "Skipped"
+	case pbpipeline.DestinationStatus_DESTINATION_STATUS_QUEUED_PLATFORM_OUTAGE:
+		return "Queued Platform Outage"
 	default:
 		return "Unknown"
 	}
@@ -1506,6 +1643,9 @@ func ParseDestinationStatus(input string) pbpipeline.DestinationStatus {
 		"failed":                         pbpipeline.DestinationStatus_DESTINATION_STATUS_FAILED,
 		"destination_status_skipped":     pbpipeline.DestinationStatus_DESTINATION_STATUS_SKIPPED,
 		"skipped":                        pbpipeline.DestinationStatus_DESTINATION_STATUS_SKIPPED,
+		"destination_status_queued_platform_outage": pbpipeline.DestinationStatus_DESTINATION_STATUS_QUEUED_PLATFORM_OUTAGE,
+		"queued_platform_outage":                    pbpipeline.DestinationStatus_DESTINATION_STATUS_QUEUED_PLATFORM_OUTAGE,
+		"queued platform outage":                    pbpipeline.DestinationStatus_DESTINATION_STATUS_QUEUED_PLATFORM_OUTAGE,
 	}
 
 	normalized := strings.ToLower(strings.TrimSpace(input))
@@ -338,6 +338,110 @@ func (x *ListUsersAdminResponse) GetNextPageToken() string {
 	return ""
 }
 
+type SearchUsersAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	AthleteId     string                 `protobuf:"bytes,2,opt,name=athlete_id,json=athleteId,proto3" json:"athlete_id,omitempty"` // User ID on a connected platform
+	Provider      string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`                    // Platform for athlete_id; defaults to strava
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersAdminRequest) Reset() {
+	*x = SearchUsersAdminRequest{}
+	mi := &file_gateway_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersAdminRequest) ProtoMessage() {}
+
+func (x *SearchUsersAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersAdminRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersAdminRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchUsersAdminRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SearchUsersAdminRequest) GetAthleteId() string {
+	if x != nil {
+		return x.AthleteId
+	}
+	return ""
+}
+
+func (x *SearchUsersAdminRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type SearchUsersAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*user.UserProfile    `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersAdminResponse) Reset() {
+	*x = SearchUsersAdminResponse{}
+	mi := &file_gateway_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersAdminResponse) ProtoMessage() {}
+
+func (x *SearchUsersAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersAdminResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersAdminResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchUsersAdminResponse) GetUsers() []*user.UserProfile {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
 type UserIdAdminRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -347,7 +451,7 @@ type UserIdAdminRequest struct {
 
 func (x *UserIdAdminRequest) Reset() {
 	*x = UserIdAdminRequest{}
-	mi := &file_gateway_admin_proto_msgTypes[6]
+	mi := &file_gateway_admin_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +463,7 @@ func (x *UserIdAdminRequest) String() string {
 func (*UserIdAdminRequest) ProtoMessage() {}
 
 func (x *UserIdAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_admin_proto_msgTypes[6]
+	mi := &file_gateway_admin_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +476,7 @@ func (x *UserIdAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserIdAdminRequest.ProtoReflect.Descriptor instead.
 func (*UserIdAdminRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_admin_proto_rawDescGZIP(), []int{6}
+	return file_gateway_admin_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UserIdAdminRequest) GetId() string {
@@ -386,13 +490,14 @@ type UpdateUserAdminRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	AccessEnabled bool                   `protobuf:"varint,2,opt,name=access_enabled,json=accessEnabled,proto3" json:"access_enabled,omitempty"`
+	Tier          *user.UserTier         `protobuf:"varint,3,opt,name=tier,proto3,enum=fitglue.models.user.UserTier,oneof" json:"tier,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateUserAdminRequest) Reset() {
 	*x = UpdateUserAdminRequest{}
-	mi := &file_gateway_admin_proto_msgTypes[7]
+	mi := &file_gateway_admin_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -404,7 +509,7 @@ func (x *UpdateUserAdminRequest) String() string {
 func (*UpdateUserAdminRequest) ProtoMessage() {}
 
 func (x *UpdateUserAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_admin_proto_msgTypes[7]
+	mi := &file_gateway_admin_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +522,7 @@ func (x *UpdateUserAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserAdminRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserAdminRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_admin_proto_rawDescGZIP(), []int{7}
+	return file_gateway_admin_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateUserAdminRequest) GetId() string {
@@ -434,6 +539,13 @@ func (x *UpdateUserAdminRequest) GetAccessEnabled() bool {
 	return false
 }
 
+func (x *UpdateUserAdminRequest) GetTier() user.UserTier {
+	if x != nil && x.Tier != nil {
+		return *x.Tier
+	}
+	return user.UserTier(0)
+}
+
 type DeleteUserDataAdminRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -444,7 +556,7 @@ type DeleteUserDataAdminRequest struct {
 
 func (x *DeleteUserDataAdminRequest) Reset() {
 	*x = DeleteUserDataAdminRequest{}
-	mi := &file_gateway_admin_proto_msgTypes[8]
+	mi := &file_gateway_admin_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -456,7 +568,7 @@ func (x *DeleteUserDataAdminRequest) String() string {
 func (*DeleteUserDataAdminRequest) ProtoMessage() {}
 
 func (x *DeleteUserDataAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_admin_proto_msgTypes[8]
+	mi := &file_gateway_admin_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,7 +581,7 @@ func (x *DeleteUserDataAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserDataAdminRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserDataAdminRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_admin_proto_rawDescGZIP(), []int{8}
+	return file_gateway_admin_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteUserDataAdminRequest) GetId() string {
@@ -496,7 +608,7 @@ type ListAllPipelinesAdminRequest struct {
 
 func (x *ListAllPipelinesAdminRequest) Reset() {
 	*x = ListAllPipelinesAdminRequest{}
-	mi := &file_gateway_admin_proto_msgTypes[9]
+	mi := &file_gateway_admin_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -508,7 +620,7 @@ func (x *ListAllPipelinesAdminRequest) String() string {
 func (*ListAllPipelinesAdminRequest) ProtoMessage() {}
 
 func (x *ListAllPipelinesAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_admin_proto_msgTypes[9]
+	mi := &file_gateway_admin_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,7 +633,7 @@ func (x *ListAllPipelinesAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAllPipelinesAdminRequest.ProtoReflect.Descriptor instead.
 func (*ListAllPipelinesAdminRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_admin_proto_rawDescGZIP(), []int{9}
+	return file_gateway_admin_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListAllPipelinesAdminRequest) GetUserId() string {
@@ -540,7 +652,7 @@ type ListAllPipelinesAdminResponse struct {
 
 func (x *ListAllPipelinesAdminResponse) Reset() {
 	*x = ListAllPipelinesAdminResponse{}
-	mi := &file_gateway_admin_proto_msgTypes[10]
+	mi := &file_gateway_admin_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -552,7 +664,7 @@ func (x *ListAllPipelinesAdminResponse) String() string {
 func (*ListAllPipelinesAdminResponse) ProtoMessage() {}
 
 func (x *ListAllPipelinesAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_admin_proto_msgTypes[10]
+	mi := &file_gateway_admin_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,7 +677,7 @@ func (x *ListAllPipelinesAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAllPipelinesAdminResponse.ProtoReflect.Descriptor instead.
 func (*ListAllPipelinesAdminResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_admin_proto_rawDescGZIP(), []int{10}
+	return file_gateway_admin_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListAllPipelinesAdminResponse) GetPipelines() []*pipeline.PipelineConfig {
@@ -582,13 +694,14 @@ type ListPipelineRunsAdminRequest struct {
 	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	ErrorsOnly    bool                   `protobuf:"varint,6,opt,name=errors_only,json=errorsOnly,proto3" json:"errors_only,omitempty"` // FAILED and PARTIAL runs only
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListPipelineRunsAdminRequest) Reset() {
 	*x = ListPipelineRunsAdminRequest{}
-	mi := &file_gateway_admin_proto_msgTypes[11]
+	mi := &file_gateway_admin_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -600,7 +713,7 @@ func (x *ListPipelineRunsAdminRequest) String() string {
 func (*ListPipelineRunsAdminRequest) ProtoMessage() {}
 
 func (x *ListPipelineRunsAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_admin_proto_msgTypes[11]
+	mi := &file_gateway_admin_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -613,7 +726,7 @@ func (x *ListPipelineRunsAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPipelineRunsAdminRequest.ProtoReflect.Descriptor instead.
 func (*ListPipelineRunsAdminRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_admin_proto_rawDescGZIP(), []int{11}
+	return file_gateway_admin_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListPipelineRunsAdminRequest) GetStatus() string {
@@ -651,6 +764,13 @@ func (x *ListPipelineRunsAdminRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListPipelineRunsAdminRequest) GetErrorsOnly() bool {
+	if x != nil {
+		return x.ErrorsOnly
+	}
+	return false
+}
+
 type ListPipelineRunsAdminResponse struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Runs          []*pipeline.PipelineRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
@@ -661,7 +781,7 @@ type ListPipelineRunsAdminResponse struct {
 
 func (x *ListPipelineRunsAdminResponse) Reset() {
 	*x = ListPipelineRunsAdminResponse{}
-	mi := &file_gateway_admin_proto_msgTypes[12]
+	mi := &file_gateway_admin_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -673,7 +793,7 @@ func (x *ListPipelineRunsAdminResponse) String() string {
 func (*ListPipelineRunsAdminResponse) ProtoMessage() {}
 
 func (x *ListPipelineRunsAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_admin_proto_msgTypes[12]
+	mi := &file_gateway_admin_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,7 +806,7 @@ func (x *ListPipelineRunsAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPipelineRunsAdminResponse.ProtoReflect.Descriptor instead.
 func (*ListPipelineRunsAdminResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_admin_proto_rawDescGZIP(), []int{12}
+	return file_gateway_admin_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListPipelineRunsAdminResponse) GetRuns() []*pipeline.PipelineRun {
@@ -703,11 +823,578 @@ func (x *ListPipelineRunsAdminResponse) GetNextPageToken() string {
 	return ""
 }
 
+type RetryPipelineRunAdminRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // user_id from path
+	RunId string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Provider names to re-run (e.g. "weather"); empty re-runs every enricher
+	Enrichers     []string `protobuf:"bytes,3,rep,name=enrichers,proto3" json:"enrichers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryPipelineRunAdminRequest) Reset() {
+	*x = RetryPipelineRunAdminRequest{}
+	mi := &file_gateway_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPipelineRunAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPipelineRunAdminRequest) ProtoMessage() {}
+
+func (x *RetryPipelineRunAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPipelineRunAdminRequest.ProtoReflect.Descriptor instead.
+func (*RetryPipelineRunAdminRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RetryPipelineRunAdminRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RetryPipelineRunAdminRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *RetryPipelineRunAdminRequest) GetEnrichers() []string {
+	if x != nil {
+		return x.Enrichers
+	}
+	return nil
+}
+
+type ForceCompletePipelineRunAdminRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Id            string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // user_id from path
+	RunId         string                     `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Status        pipeline.PipelineRunStatus `protobuf:"varint,3,opt,name=status,proto3,enum=fitglue.models.pipeline.PipelineRunStatus" json:"status,omitempty"` // SYNCED (default), FAILED or SKIPPED
+	Reason        string                     `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceCompletePipelineRunAdminRequest) Reset() {
+	*x = ForceCompletePipelineRunAdminRequest{}
+	mi := &file_gateway_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceCompletePipelineRunAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceCompletePipelineRunAdminRequest) ProtoMessage() {}
+
+func (x *ForceCompletePipelineRunAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceCompletePipelineRunAdminRequest.ProtoReflect.Descriptor instead.
+func (*ForceCompletePipelineRunAdminRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ForceCompletePipelineRunAdminRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ForceCompletePipelineRunAdminRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ForceCompletePipelineRunAdminRequest) GetStatus() pipeline.PipelineRunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return pipeline.PipelineRunStatus(0)
+}
+
+func (x *ForceCompletePipelineRunAdminRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Enricher Provider Circuits
+type ListProviderCircuitsAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProviderCircuitsAdminRequest) Reset() {
+	*x = ListProviderCircuitsAdminRequest{}
+	mi := &file_gateway_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProviderCircuitsAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProviderCircuitsAdminRequest) ProtoMessage() {}
+
+func (x *ListProviderCircuitsAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProviderCircuitsAdminRequest.ProtoReflect.Descriptor instead.
+func (*ListProviderCircuitsAdminRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_admin_proto_rawDescGZIP(), []int{17}
+}
+
+type ListProviderCircuitsAdminResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Circuits      []*pipeline.ProviderCircuit `protobuf:"bytes,1,rep,name=circuits,proto3" json:"circuits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProviderCircuitsAdminResponse) Reset() {
+	*x = ListProviderCircuitsAdminResponse{}
+	mi := &file_gateway_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProviderCircuitsAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProviderCircuitsAdminResponse) ProtoMessage() {}
+
+func (x *ListProviderCircuitsAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProviderCircuitsAdminResponse.ProtoReflect.Descriptor instead.
+func (*ListProviderCircuitsAdminResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListProviderCircuitsAdminResponse) GetCircuits() []*pipeline.ProviderCircuit {
+	if x != nil {
+		return x.Circuits
+	}
+	return nil
+}
+
+type SetProviderCircuitModeAdminRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	ProviderType  string                       `protobuf:"bytes,1,opt,name=provider_type,json=providerType,proto3" json:"provider_type,omitempty"` // EnricherProviderType name, e.g. ENRICHER_PROVIDER_WEATHER
+	Mode          pipeline.ProviderCircuitMode `protobuf:"varint,2,opt,name=mode,proto3,enum=fitglue.models.pipeline.ProviderCircuitMode" json:"mode,omitempty"`
+	Reason        string                       `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // required unless mode is AUTOMATIC
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProviderCircuitModeAdminRequest) Reset() {
+	*x = SetProviderCircuitModeAdminRequest{}
+	mi := &file_gateway_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProviderCircuitModeAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProviderCircuitModeAdminRequest) ProtoMessage() {}
+
+func (x *SetProviderCircuitModeAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProviderCircuitModeAdminRequest.ProtoReflect.Descriptor instead.
+func (*SetProviderCircuitModeAdminRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetProviderCircuitModeAdminRequest) GetProviderType() string {
+	if x != nil {
+		return x.ProviderType
+	}
+	return ""
+}
+
+func (x *SetProviderCircuitModeAdminRequest) GetMode() pipeline.ProviderCircuitMode {
+	if x != nil {
+		return x.Mode
+	}
+	return pipeline.ProviderCircuitMode(0)
+}
+
+func (x *SetProviderCircuitModeAdminRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListProviderCircuitChangesAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderType  string                 `protobuf:"bytes,1,opt,name=provider_type,json=providerType,proto3" json:"provider_type,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProviderCircuitChangesAdminRequest) Reset() {
+	*x = ListProviderCircuitChangesAdminRequest{}
+	mi := &file_gateway_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProviderCircuitChangesAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProviderCircuitChangesAdminRequest) ProtoMessage() {}
+
+func (x *ListProviderCircuitChangesAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProviderCircuitChangesAdminRequest.ProtoReflect.Descriptor instead.
+func (*ListProviderCircuitChangesAdminRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListProviderCircuitChangesAdminRequest) GetProviderType() string {
+	if x != nil {
+		return x.ProviderType
+	}
+	return ""
+}
+
+func (x *ListProviderCircuitChangesAdminRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListProviderCircuitChangesAdminResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Changes       []*pipeline.ProviderCircuitChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProviderCircuitChangesAdminResponse) Reset() {
+	*x = ListProviderCircuitChangesAdminResponse{}
+	mi := &file_gateway_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProviderCircuitChangesAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProviderCircuitChangesAdminResponse) ProtoMessage() {}
+
+func (x *ListProviderCircuitChangesAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProviderCircuitChangesAdminResponse.ProtoReflect.Descriptor instead.
+func (*ListProviderCircuitChangesAdminResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListProviderCircuitChangesAdminResponse) GetChanges() []*pipeline.ProviderCircuitChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// Failed Events
+type ListFailedEventsAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // user_id from path
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // FailedEventStatus name; empty lists every status
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFailedEventsAdminRequest) Reset() {
+	*x = ListFailedEventsAdminRequest{}
+	mi := &file_gateway_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFailedEventsAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFailedEventsAdminRequest) ProtoMessage() {}
+
+func (x *ListFailedEventsAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFailedEventsAdminRequest.ProtoReflect.Descriptor instead.
+func (*ListFailedEventsAdminRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListFailedEventsAdminRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListFailedEventsAdminRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListFailedEventsAdminRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFailedEventsAdminResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Events        []*pipeline.FailedEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFailedEventsAdminResponse) Reset() {
+	*x = ListFailedEventsAdminResponse{}
+	mi := &file_gateway_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFailedEventsAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFailedEventsAdminResponse) ProtoMessage() {}
+
+func (x *ListFailedEventsAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFailedEventsAdminResponse.ProtoReflect.Descriptor instead.
+func (*ListFailedEventsAdminResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListFailedEventsAdminResponse) GetEvents() []*pipeline.FailedEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type RedriveFailedEventsAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // user_id from path
+	EventIds      []string               `protobuf:"bytes,2,rep,name=event_ids,json=eventIds,proto3" json:"event_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedriveFailedEventsAdminRequest) Reset() {
+	*x = RedriveFailedEventsAdminRequest{}
+	mi := &file_gateway_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedriveFailedEventsAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedriveFailedEventsAdminRequest) ProtoMessage() {}
+
+func (x *RedriveFailedEventsAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedriveFailedEventsAdminRequest.ProtoReflect.Descriptor instead.
+func (*RedriveFailedEventsAdminRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RedriveFailedEventsAdminRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RedriveFailedEventsAdminRequest) GetEventIds() []string {
+	if x != nil {
+		return x.EventIds
+	}
+	return nil
+}
+
+type RedriveFailedEventsAdminResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Events        []*pipeline.FailedEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedriveFailedEventsAdminResponse) Reset() {
+	*x = RedriveFailedEventsAdminResponse{}
+	mi := &file_gateway_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedriveFailedEventsAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedriveFailedEventsAdminResponse) ProtoMessage() {}
+
+func (x *RedriveFailedEventsAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedriveFailedEventsAdminResponse.ProtoReflect.Descriptor instead.
+func (*RedriveFailedEventsAdminResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RedriveFailedEventsAdminResponse) GetEvents() []*pipeline.FailedEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
 var File_gateway_admin_proto protoreflect.FileDescriptor
 
 const file_gateway_admin_proto_rawDesc = "" +
 	"\n" +
-	"\x13gateway/admin.proto\x12\x0ffitglue.gateway\x1a\x1cgoogle/api/annotations.proto\x1a\x19models/user/profile.proto\x1a\x1cmodels/pipeline/config.proto\x1a\x1fmodels/pipeline/execution.proto\"\x14\n" +
+	"\x13gateway/admin.proto\x12\x0ffitglue.gateway\x1a\x1cgoogle/api/annotations.proto\x1a\x19models/user/profile.proto\x1a\x1cmodels/pipeline/config.proto\x1a\x1fmodels/pipeline/execution.proto\x1a\"models/pipeline/failed_event.proto\x1a&models/pipeline/provider_circuit.proto\"\x14\n" +
 	"\x12AdminEmptyResponse\"\x16\n" +
 	"\x14GetAdminStatsRequest\"e\n" +
 	"\x17RecentPipelineRunCounts\x12\x18\n" +
@@ -728,32 +1415,76 @@ const file_gateway_admin_proto_rawDesc = "" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\"x\n" +
 	"\x16ListUsersAdminResponse\x126\n" +
 	"\x05users\x18\x01 \x03(\v2 .fitglue.models.user.UserProfileR\x05users\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"$\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"j\n" +
+	"\x17SearchUsersAdminRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"athlete_id\x18\x02 \x01(\tR\tathleteId\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\"R\n" +
+	"\x18SearchUsersAdminResponse\x126\n" +
+	"\x05users\x18\x01 \x03(\v2 .fitglue.models.user.UserProfileR\x05users\"$\n" +
 	"\x12UserIdAdminRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"O\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x90\x01\n" +
 	"\x16UpdateUserAdminRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
-	"\x0eaccess_enabled\x18\x02 \x01(\bR\raccessEnabled\"I\n" +
+	"\x0eaccess_enabled\x18\x02 \x01(\bR\raccessEnabled\x126\n" +
+	"\x04tier\x18\x03 \x01(\x0e2\x1d.fitglue.models.user.UserTierH\x00R\x04tier\x88\x01\x01B\a\n" +
+	"\x05_tier\"I\n" +
 	"\x1aDeleteUserDataAdminRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tdata_type\x18\x02 \x01(\tR\bdataType\"7\n" +
 	"\x1cListAllPipelinesAdminRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"f\n" +
 	"\x1dListAllPipelinesAdminResponse\x12E\n" +
-	"\tpipelines\x18\x01 \x03(\v2'.fitglue.models.pipeline.PipelineConfigR\tpipelines\"\x9c\x01\n" +
+	"\tpipelines\x18\x01 \x03(\v2'.fitglue.models.pipeline.PipelineConfigR\tpipelines\"\xbd\x01\n" +
 	"\x1cListPipelineRunsAdminRequest\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x05 \x01(\tR\tpageToken\"\x81\x01\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\verrors_only\x18\x06 \x01(\bR\n" +
+	"errorsOnly\"\x81\x01\n" +
 	"\x1dListPipelineRunsAdminResponse\x128\n" +
 	"\x04runs\x18\x01 \x03(\v2$.fitglue.models.pipeline.PipelineRunR\x04runs\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xcd\a\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"c\n" +
+	"\x1cRetryPipelineRunAdminRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x1c\n" +
+	"\tenrichers\x18\x03 \x03(\tR\tenrichers\"\xa9\x01\n" +
+	"$ForceCompletePipelineRunAdminRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12B\n" +
+	"\x06status\x18\x03 \x01(\x0e2*.fitglue.models.pipeline.PipelineRunStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\"\n" +
+	" ListProviderCircuitsAdminRequest\"i\n" +
+	"!ListProviderCircuitsAdminResponse\x12D\n" +
+	"\bcircuits\x18\x01 \x03(\v2(.fitglue.models.pipeline.ProviderCircuitR\bcircuits\"\xa3\x01\n" +
+	"\"SetProviderCircuitModeAdminRequest\x12#\n" +
+	"\rprovider_type\x18\x01 \x01(\tR\fproviderType\x12@\n" +
+	"\x04mode\x18\x02 \x01(\x0e2,.fitglue.models.pipeline.ProviderCircuitModeR\x04mode\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"c\n" +
+	"&ListProviderCircuitChangesAdminRequest\x12#\n" +
+	"\rprovider_type\x18\x01 \x01(\tR\fproviderType\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"s\n" +
+	"'ListProviderCircuitChangesAdminResponse\x12H\n" +
+	"\achanges\x18\x01 \x03(\v2..fitglue.models.pipeline.ProviderCircuitChangeR\achanges\"\\\n" +
+	"\x1cListFailedEventsAdminRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"]\n" +
+	"\x1dListFailedEventsAdminResponse\x12<\n" +
+	"\x06events\x18\x01 \x03(\v2$.fitglue.models.pipeline.FailedEventR\x06events\"N\n" +
+	"\x1fRedriveFailedEventsAdminRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tevent_ids\x18\x02 \x03(\tR\beventIds\"`\n" +
+	" RedriveFailedEventsAdminResponse\x12<\n" +
+	"\x06events\x18\x01 \x03(\v2$.fitglue.models.pipeline.FailedEventR\x06events2\xf0\x11\n" +
 	"\x13AdminGatewayService\x12i\n" +
 	"\bGetStats\x12%.fitglue.gateway.GetAdminStatsRequest\x1a&.fitglue.gateway.GetAdminStatsResponse\"\x0e\x82\xd3\xe4\x93\x02\b\x12\x06/stats\x12l\n" +
-	"\tListUsers\x12&.fitglue.gateway.ListUsersAdminRequest\x1a'.fitglue.gateway.ListUsersAdminResponse\"\x0e\x82\xd3\xe4\x93\x02\b\x12\x06/users\x12e\n" +
+	"\tListUsers\x12&.fitglue.gateway.ListUsersAdminRequest\x1a'.fitglue.gateway.ListUsersAdminResponse\"\x0e\x82\xd3\xe4\x93\x02\b\x12\x06/users\x12y\n" +
+	"\vSearchUsers\x12(.fitglue.gateway.SearchUsersAdminRequest\x1a).fitglue.gateway.SearchUsersAdminResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/users/search\x12e\n" +
 	"\aGetUser\x12#.fitglue.gateway.UserIdAdminRequest\x1a .fitglue.models.user.UserProfile\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/users/{id}\x12o\n" +
 	"\n" +
 	"UpdateUser\x12'.fitglue.gateway.UpdateUserAdminRequest\x1a .fitglue.models.user.UserProfile\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\x1a\v/users/{id}\x12k\n" +
@@ -762,7 +1493,14 @@ const file_gateway_admin_proto_rawDesc = "" +
 	"\x0eDeleteUserData\x12+.fitglue.gateway.DeleteUserDataAdminRequest\x1a#.fitglue.gateway.AdminEmptyResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/users/{id}/{data_type}\x12\x85\x01\n" +
 	"\x10ListAllPipelines\x12-.fitglue.gateway.ListAllPipelinesAdminRequest\x1a..fitglue.gateway.ListAllPipelinesAdminResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/pipelines\x12\x89\x01\n" +
-	"\x10ListPipelineRuns\x12-.fitglue.gateway.ListPipelineRunsAdminRequest\x1a..fitglue.gateway.ListPipelineRunsAdminResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/pipeline-runsB7Z5github.com/fitglue/server/src/go/pkg/types/pb/gatewayb\x06proto3"
+	"\x10ListPipelineRuns\x12-.fitglue.gateway.ListPipelineRunsAdminRequest\x1a..fitglue.gateway.ListPipelineRunsAdminResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/pipeline-runs\x12\x9b\x01\n" +
+	"\x10RetryPipelineRun\x12-.fitglue.gateway.RetryPipelineRunAdminRequest\x1a#.fitglue.gateway.AdminEmptyResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/users/{id}/pipeline-runs/{run_id}/retry\x12\xb5\x01\n" +
+	"\x18ForceCompletePipelineRun\x125.fitglue.gateway.ForceCompletePipelineRunAdminRequest\x1a$.fitglue.models.pipeline.PipelineRun\"<\x82\xd3\xe4\x93\x026:\x01*\"1/users/{id}/pipeline-runs/{run_id}/force-complete\x12\x99\x01\n" +
+	"\x14ListProviderCircuits\x121.fitglue.gateway.ListProviderCircuitsAdminRequest\x1a2.fitglue.gateway.ListProviderCircuitsAdminResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/provider-circuits\x12\xab\x01\n" +
+	"\x16SetProviderCircuitMode\x123.fitglue.gateway.SetProviderCircuitModeAdminRequest\x1a(.fitglue.models.pipeline.ProviderCircuit\"2\x82\xd3\xe4\x93\x02,:\x01*\x1a'/provider-circuits/{provider_type}/mode\x12\xc3\x01\n" +
+	"\x1aListProviderCircuitChanges\x127.fitglue.gateway.ListProviderCircuitChangesAdminRequest\x1a8.fitglue.gateway.ListProviderCircuitChangesAdminResponse\"2\x82\xd3\xe4\x93\x02,\x12*/provider-circuits/{provider_type}/history\x12\x94\x01\n" +
+	"\x10ListFailedEvents\x12-.fitglue.gateway.ListFailedEventsAdminRequest\x1a..fitglue.gateway.ListFailedEventsAdminResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/users/{id}/failed-events\x12\xa8\x01\n" +
+	"\x13RedriveFailedEvents\x120.fitglue.gateway.RedriveFailedEventsAdminRequest\x1a1.fitglue.gateway.RedriveFailedEventsAdminResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/users/{id}/failed-events/redriveB7Z5github.com/fitglue/server/src/go/pkg/types/pb/gatewayb\x06proto3"
 
 var (
 	file_gateway_admin_proto_rawDescOnce sync.Once
@@ -776,51 +1514,94 @@ func file_gateway_admin_proto_rawDescGZIP() []byte {
 	return file_gateway_admin_proto_rawDescData
 }
 
-var file_gateway_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_gateway_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_gateway_admin_proto_goTypes = []any{
-	(*AdminEmptyResponse)(nil),            // 0: fitglue.gateway.AdminEmptyResponse
-	(*GetAdminStatsRequest)(nil),          // 1: fitglue.gateway.GetAdminStatsRequest
-	(*RecentPipelineRunCounts)(nil),       // 2: fitglue.gateway.RecentPipelineRunCounts
-	(*GetAdminStatsResponse)(nil),         // 3: fitglue.gateway.GetAdminStatsResponse
-	(*ListUsersAdminRequest)(nil),         // 4: fitglue.gateway.ListUsersAdminRequest
-	(*ListUsersAdminResponse)(nil),        // 5: fitglue.gateway.ListUsersAdminResponse
-	(*UserIdAdminRequest)(nil),            // 6: fitglue.gateway.UserIdAdminRequest
-	(*UpdateUserAdminRequest)(nil),        // 7: fitglue.gateway.UpdateUserAdminRequest
-	(*DeleteUserDataAdminRequest)(nil),    // 8: fitglue.gateway.DeleteUserDataAdminRequest
-	(*ListAllPipelinesAdminRequest)(nil),  // 9: fitglue.gateway.ListAllPipelinesAdminRequest
-	(*ListAllPipelinesAdminResponse)(nil), // 10: fitglue.gateway.ListAllPipelinesAdminResponse
-	(*ListPipelineRunsAdminRequest)(nil),  // 11: fitglue.gateway.ListPipelineRunsAdminRequest
-	(*ListPipelineRunsAdminResponse)(nil), // 12: fitglue.gateway.ListPipelineRunsAdminResponse
-	(*user.UserProfile)(nil),              // 13: fitglue.models.user.UserProfile
-	(*pipeline.PipelineConfig)(nil),       // 14: fitglue.models.pipeline.PipelineConfig
-	(*pipeline.PipelineRun)(nil),          // 15: fitglue.models.pipeline.PipelineRun
+	(*AdminEmptyResponse)(nil),                      // 0: fitglue.gateway.AdminEmptyResponse
+	(*GetAdminStatsRequest)(nil),                    // 1: fitglue.gateway.GetAdminStatsRequest
+	(*RecentPipelineRunCounts)(nil),                 // 2: fitglue.gateway.RecentPipelineRunCounts
+	(*GetAdminStatsResponse)(nil),                   // 3: fitglue.gateway.GetAdminStatsResponse
+	(*ListUsersAdminRequest)(nil),                   // 4: fitglue.gateway.ListUsersAdminRequest
+	(*ListUsersAdminResponse)(nil),                  // 5: fitglue.gateway.ListUsersAdminResponse
+	(*SearchUsersAdminRequest)(nil),                 // 6: fitglue.gateway.SearchUsersAdminRequest
+	(*SearchUsersAdminResponse)(nil),                // 7: fitglue.gateway.SearchUsersAdminResponse
+	(*UserIdAdminRequest)(nil),                      // 8: fitglue.gateway.UserIdAdminRequest
+	(*UpdateUserAdminRequest)(nil),                  // 9: fitglue.gateway.UpdateUserAdminRequest
+	(*DeleteUserDataAdminRequest)(nil),              // 10: fitglue.gateway.DeleteUserDataAdminRequest
+	(*ListAllPipelinesAdminRequest)(nil),            // 11: fitglue.gateway.ListAllPipelinesAdminRequest
+	(*ListAllPipelinesAdminResponse)(nil),           // 12: fitglue.gateway.ListAllPipelinesAdminResponse
+	(*ListPipelineRunsAdminRequest)(nil),            // 13: fitglue.gateway.ListPipelineRunsAdminRequest
+	(*ListPipelineRunsAdminResponse)(nil),           // 14: fitglue.gateway.ListPipelineRunsAdminResponse
+	(*RetryPipelineRunAdminRequest)(nil),            // 15: fitglue.gateway.RetryPipelineRunAdminRequest
+	(*ForceCompletePipelineRunAdminRequest)(nil),    // 16: fitglue.gateway.ForceCompletePipelineRunAdminRequest
+	(*ListProviderCircuitsAdminRequest)(nil),        // 17: fitglue.gateway.ListProviderCircuitsAdminRequest
+	(*ListProviderCircuitsAdminResponse)(nil),       // 18: fitglue.gateway.ListProviderCircuitsAdminResponse
+	(*SetProviderCircuitModeAdminRequest)(nil),      // 19: fitglue.gateway.SetProviderCircuitModeAdminRequest
+	(*ListProviderCircuitChangesAdminRequest)(nil),  // 20: fitglue.gateway.ListProviderCircuitChangesAdminRequest
+	(*ListProviderCircuitChangesAdminResponse)(nil), // 21: fitglue.gateway.ListProviderCircuitChangesAdminResponse
+	(*ListFailedEventsAdminRequest)(nil),            // 22: fitglue.gateway.ListFailedEventsAdminRequest
+	(*ListFailedEventsAdminResponse)(nil),           // 23: fitglue.gateway.ListFailedEventsAdminResponse
+	(*RedriveFailedEventsAdminRequest)(nil),         // 24: fitglue.gateway.RedriveFailedEventsAdminRequest
+	(*RedriveFailedEventsAdminResponse)(nil),        // 25: fitglue.gateway.RedriveFailedEventsAdminResponse
+	(*user.UserProfile)(nil),                        // 26: fitglue.models.user.UserProfile
+	(user.UserTier)(0),                              // 27: fitglue.models.user.UserTier
+	(*pipeline.PipelineConfig)(nil),                 // 28: fitglue.models.pipeline.PipelineConfig
+	(*pipeline.PipelineRun)(nil),                    // 29: fitglue.models.pipeline.PipelineRun
+	(pipeline.PipelineRunStatus)(0),                 // 30: fitglue.models.pipeline.PipelineRunStatus
+	(*pipeline.ProviderCircuit)(nil),                // 31: fitglue.models.pipeline.ProviderCircuit
+	(pipeline.ProviderCircuitMode)(0),               // 32: fitglue.models.pipeline.ProviderCircuitMode
+	(*pipeline.ProviderCircuitChange)(nil),          // 33: fitglue.models.pipeline.ProviderCircuitChange
+	(*pipeline.FailedEvent)(nil),                    // 34: fitglue.models.pipeline.FailedEvent
 }
 var file_gateway_admin_proto_depIdxs = []int32{
 	2,  // 0: fitglue.gateway.GetAdminStatsResponse.recent_executions:type_name -> fitglue.gateway.RecentPipelineRunCounts
-	13, // 1: fitglue.gateway.ListUsersAdminResponse.users:type_name -> fitglue.models.user.UserProfile
-	14, // 2: fitglue.gateway.ListAllPipelinesAdminResponse.pipelines:type_name -> fitglue.models.pipeline.PipelineConfig
-	15, // 3: fitglue.gateway.ListPipelineRunsAdminResponse.runs:type_name -> fitglue.models.pipeline.PipelineRun
-	1,  // 4: fitglue.gateway.AdminGatewayService.GetStats:input_type -> fitglue.gateway.GetAdminStatsRequest
-	4,  // 5: fitglue.gateway.AdminGatewayService.ListUsers:input_type -> fitglue.gateway.ListUsersAdminRequest
-	6,  // 6: fitglue.gateway.AdminGatewayService.GetUser:input_type -> fitglue.gateway.UserIdAdminRequest
-	7,  // 7: fitglue.gateway.AdminGatewayService.UpdateUser:input_type -> fitglue.gateway.UpdateUserAdminRequest
-	6,  // 8: fitglue.gateway.AdminGatewayService.DeleteUser:input_type -> fitglue.gateway.UserIdAdminRequest
-	8,  // 9: fitglue.gateway.AdminGatewayService.DeleteUserData:input_type -> fitglue.gateway.DeleteUserDataAdminRequest
-	9,  // 10: fitglue.gateway.AdminGatewayService.ListAllPipelines:input_type -> fitglue.gateway.ListAllPipelinesAdminRequest
-	11, // 11: fitglue.gateway.AdminGatewayService.ListPipelineRuns:input_type -> fitglue.gateway.ListPipelineRunsAdminRequest
-	3,  // 12: fitglue.gateway.AdminGatewayService.GetStats:output_type -> fitglue.gateway.GetAdminStatsResponse
-	5,  // 13: fitglue.gateway.AdminGatewayService.ListUsers:output_type -> fitglue.gateway.ListUsersAdminResponse
-	13, // 14: fitglue.gateway.AdminGatewayService.GetUser:output_type -> fitglue.models.user.UserProfile
-	13, // 15: fitglue.gateway.AdminGatewayService.UpdateUser:output_type -> fitglue.models.user.UserProfile
-	0,  // 16: fitglue.gateway.AdminGatewayService.DeleteUser:output_type -> fitglue.gateway.AdminEmptyResponse
-	0,  // 17: fitglue.gateway.AdminGatewayService.DeleteUserData:output_type -> fitglue.gateway.AdminEmptyResponse
-	10, // 18: fitglue.gateway.AdminGatewayService.ListAllPipelines:output_type -> fitglue.gateway.ListAllPipelinesAdminResponse
-	12, // 19: fitglue.gateway.AdminGatewayService.ListPipelineRuns:output_type -> fitglue.gateway.ListPipelineRunsAdminResponse
-	12, // [12:20] is the sub-list for method output_type
-	4,  // [4:12] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	26, // 1: fitglue.gateway.ListUsersAdminResponse.users:type_name -> fitglue.models.user.UserProfile
+	26, // 2: fitglue.gateway.SearchUsersAdminResponse.users:type_name -> fitglue.models.user.UserProfile
+	27, // 3: fitglue.gateway.UpdateUserAdminRequest.tier:type_name -> fitglue.models.user.UserTier
+	28, // 4: fitglue.gateway.ListAllPipelinesAdminResponse.pipelines:type_name -> fitglue.models.pipeline.PipelineConfig
+	29, // 5: fitglue.gateway.ListPipelineRunsAdminResponse.runs:type_name -> fitglue.models.pipeline.PipelineRun
+	30, // 6: fitglue.gateway.ForceCompletePipelineRunAdminRequest.status:type_name -> fitglue.models.pipeline.PipelineRunStatus
+	31, // 7: fitglue.gateway.ListProviderCircuitsAdminResponse.circuits:type_name -> fitglue.models.pipeline.ProviderCircuit
+	32, // 8: fitglue.gateway.SetProviderCircuitModeAdminRequest.mode:type_name -> fitglue.models.pipeline.ProviderCircuitMode
+	33, // 9: fitglue.gateway.ListProviderCircuitChangesAdminResponse.changes:type_name -> fitglue.models.pipeline.ProviderCircuitChange
+	34, // 10: fitglue.gateway.ListFailedEventsAdminResponse.events:type_name -> fitglue.models.pipeline.FailedEvent
+	34, // 11: fitglue.gateway.RedriveFailedEventsAdminResponse.events:type_name -> fitglue.models.pipeline.FailedEvent
+	1,  // 12: fitglue.gateway.AdminGatewayService.GetStats:input_type -> fitglue.gateway.GetAdminStatsRequest
+	4,  // 13: fitglue.gateway.AdminGatewayService.ListUsers:input_type -> fitglue.gateway.ListUsersAdminRequest
+	6,  // 14: fitglue.gateway.AdminGatewayService.SearchUsers:input_type -> fitglue.gateway.SearchUsersAdminRequest
+	8,  // 15: fitglue.gateway.AdminGatewayService.GetUser:input_type -> fitglue.gateway.UserIdAdminRequest
+	9,  // 16: fitglue.gateway.AdminGatewayService.UpdateUser:input_type -> fitglue.gateway.UpdateUserAdminRequest
+	8,  // 17: fitglue.gateway.AdminGatewayService.DeleteUser:input_type -> fitglue.gateway.UserIdAdminRequest
+	10, // 18: fitglue.gateway.AdminGatewayService.DeleteUserData:input_type -> fitglue.gateway.DeleteUserDataAdminRequest
+	11, // 19: fitglue.gateway.AdminGatewayService.ListAllPipelines:input_type -> fitglue.gateway.ListAllPipelinesAdminRequest
+	13, // 20: fitglue.gateway.AdminGatewayService.ListPipelineRuns:input_type -> fitglue.gateway.ListPipelineRunsAdminRequest
+	15, // 21: fitglue.gateway.AdminGatewayService.RetryPipelineRun:input_type -> fitglue.gateway.RetryPipelineRunAdminRequest
+	16, // 22: fitglue.gateway.AdminGatewayService.ForceCompletePipelineRun:input_type -> fitglue.gateway.ForceCompletePipelineRunAdminRequest
+	17, // 23: fitglue.gateway.AdminGatewayService.ListProviderCircuits:input_type -> fitglue.gateway.ListProviderCircuitsAdminRequest
+	19, // 24: fitglue.gateway.AdminGatewayService.SetProviderCircuitMode:input_type -> fitglue.gateway.SetProviderCircuitModeAdminRequest
+	20, // 25: fitglue.gateway.AdminGatewayService.ListProviderCircuitChanges:input_type -> fitglue.gateway.ListProviderCircuitChangesAdminRequest
+	22, // 26: fitglue.gateway.AdminGatewayService.ListFailedEvents:input_type -> fitglue.gateway.ListFailedEventsAdminRequest
+	24, // 27: fitglue.gateway.AdminGatewayService.RedriveFailedEvents:input_type -> fitglue.gateway.RedriveFailedEventsAdminRequest
+	3,  // 28: fitglue.gateway.AdminGatewayService.GetStats:output_type -> fitglue.gateway.GetAdminStatsResponse
+	5,  // 29: fitglue.gateway.AdminGatewayService.ListUsers:output_type -> fitglue.gateway.ListUsersAdminResponse
+	7,  // 30: fitglue.gateway.AdminGatewayService.SearchUsers:output_type -> fitglue.gateway.SearchUsersAdminResponse
+	26, // 31: fitglue.gateway.AdminGatewayService.GetUser:output_type -> fitglue.models.user.UserProfile
+	26, // 32: fitglue.gateway.AdminGatewayService.UpdateUser:output_type -> fitglue.models.user.UserProfile
+	0,  // 33: fitglue.gateway.AdminGatewayService.DeleteUser:output_type -> fitglue.gateway.AdminEmptyResponse
+	0,  // 34: fitglue.gateway.AdminGatewayService.DeleteUserData:output_type -> fitglue.gateway.AdminEmptyResponse
+	12, // 35: fitglue.gateway.AdminGatewayService.ListAllPipelines:output_type -> fitglue.gateway.ListAllPipelinesAdminResponse
+	14, // 36: fitglue.gateway.AdminGatewayService.ListPipelineRuns:output_type -> fitglue.gateway.ListPipelineRunsAdminResponse
+	0,  // 37: fitglue.gateway.AdminGatewayService.RetryPipelineRun:output_type -> fitglue.gateway.AdminEmptyResponse
+	29, // 38: fitglue.gateway.AdminGatewayService.ForceCompletePipelineRun:output_type -> fitglue.models.pipeline.PipelineRun
+	18, // 39: fitglue.gateway.AdminGatewayService.ListProviderCircuits:output_type -> fitglue.gateway.ListProviderCircuitsAdminResponse
+	31, // 40: fitglue.gateway.AdminGatewayService.SetProviderCircuitMode:output_type -> fitglue.models.pipeline.ProviderCircuit
+	21, // 41: fitglue.gateway.AdminGatewayService.ListProviderCircuitChanges:output_type -> fitglue.gateway.ListProviderCircuitChangesAdminResponse
+	23, // 42: fitglue.gateway.AdminGatewayService.ListFailedEvents:output_type -> fitglue.gateway.ListFailedEventsAdminResponse
+	25, // 43: fitglue.gateway.AdminGatewayService.RedriveFailedEvents:output_type -> fitglue.gateway.RedriveFailedEventsAdminResponse
+	28, // [28:44] is the sub-list for method output_type
+	12, // [12:28] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_gateway_admin_proto_init() }
@@ -828,13 +1609,14 @@ func file_gateway_admin_proto_init() {
 	if File_gateway_admin_proto != nil {
 		return
 	}
+	file_gateway_admin_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gateway_admin_proto_rawDesc), len(file_gateway_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
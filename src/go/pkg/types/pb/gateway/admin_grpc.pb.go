@@ -8,6 +8,7 @@ package gateway
 
 import (
 	context "context"
+	pipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 	user "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -20,14 +21,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminGatewayService_GetStats_FullMethodName         = "/fitglue.gateway.AdminGatewayService/GetStats"
-	AdminGatewayService_ListUsers_FullMethodName        = "/fitglue.gateway.AdminGatewayService/ListUsers"
-	AdminGatewayService_GetUser_FullMethodName          = "/fitglue.gateway.AdminGatewayService/GetUser"
-	AdminGatewayService_UpdateUser_FullMethodName       = "/fitglue.gateway.AdminGatewayService/UpdateUser"
-	AdminGatewayService_DeleteUser_FullMethodName       = "/fitglue.gateway.AdminGatewayService/DeleteUser"
-	AdminGatewayService_DeleteUserData_FullMethodName   = "/fitglue.gateway.AdminGatewayService/DeleteUserData"
-	AdminGatewayService_ListAllPipelines_FullMethodName = "/fitglue.gateway.AdminGatewayService/ListAllPipelines"
-	AdminGatewayService_ListPipelineRuns_FullMethodName = "/fitglue.gateway.AdminGatewayService/ListPipelineRuns"
+	AdminGatewayService_GetStats_FullMethodName                   = "/fitglue.gateway.AdminGatewayService/GetStats"
+	AdminGatewayService_ListUsers_FullMethodName                  = "/fitglue.gateway.AdminGatewayService/ListUsers"
+	AdminGatewayService_SearchUsers_FullMethodName                = "/fitglue.gateway.AdminGatewayService/SearchUsers"
+	AdminGatewayService_GetUser_FullMethodName                    = "/fitglue.gateway.AdminGatewayService/GetUser"
+	AdminGatewayService_UpdateUser_FullMethodName                 = "/fitglue.gateway.AdminGatewayService/UpdateUser"
+	AdminGatewayService_DeleteUser_FullMethodName                 = "/fitglue.gateway.AdminGatewayService/DeleteUser"
+	AdminGatewayService_DeleteUserData_FullMethodName             = "/fitglue.gateway.AdminGatewayService/DeleteUserData"
+	AdminGatewayService_ListAllPipelines_FullMethodName           = "/fitglue.gateway.AdminGatewayService/ListAllPipelines"
+	AdminGatewayService_ListPipelineRuns_FullMethodName           = "/fitglue.gateway.AdminGatewayService/ListPipelineRuns"
+	AdminGatewayService_RetryPipelineRun_FullMethodName           = "/fitglue.gateway.AdminGatewayService/RetryPipelineRun"
+	AdminGatewayService_ForceCompletePipelineRun_FullMethodName   = "/fitglue.gateway.AdminGatewayService/ForceCompletePipelineRun"
+	AdminGatewayService_ListProviderCircuits_FullMethodName       = "/fitglue.gateway.AdminGatewayService/ListProviderCircuits"
+	AdminGatewayService_SetProviderCircuitMode_FullMethodName     = "/fitglue.gateway.AdminGatewayService/SetProviderCircuitMode"
+	AdminGatewayService_ListProviderCircuitChanges_FullMethodName = "/fitglue.gateway.AdminGatewayService/ListProviderCircuitChanges"
+	AdminGatewayService_ListFailedEvents_FullMethodName           = "/fitglue.gateway.AdminGatewayService/ListFailedEvents"
+	AdminGatewayService_RedriveFailedEvents_FullMethodName        = "/fitglue.gateway.AdminGatewayService/RedriveFailedEvents"
 )
 
 // AdminGatewayServiceClient is the client API for AdminGatewayService service.
@@ -43,6 +52,7 @@ type AdminGatewayServiceClient interface {
 	GetStats(ctx context.Context, in *GetAdminStatsRequest, opts ...grpc.CallOption) (*GetAdminStatsResponse, error)
 	// ===================== User Management =====================
 	ListUsers(ctx context.Context, in *ListUsersAdminRequest, opts ...grpc.CallOption) (*ListUsersAdminResponse, error)
+	SearchUsers(ctx context.Context, in *SearchUsersAdminRequest, opts ...grpc.CallOption) (*SearchUsersAdminResponse, error)
 	GetUser(ctx context.Context, in *UserIdAdminRequest, opts ...grpc.CallOption) (*user.UserProfile, error)
 	UpdateUser(ctx context.Context, in *UpdateUserAdminRequest, opts ...grpc.CallOption) (*user.UserProfile, error)
 	DeleteUser(ctx context.Context, in *UserIdAdminRequest, opts ...grpc.CallOption) (*AdminEmptyResponse, error)
@@ -50,6 +60,15 @@ type AdminGatewayServiceClient interface {
 	// ===================== Pipeline Management =====================
 	ListAllPipelines(ctx context.Context, in *ListAllPipelinesAdminRequest, opts ...grpc.CallOption) (*ListAllPipelinesAdminResponse, error)
 	ListPipelineRuns(ctx context.Context, in *ListPipelineRunsAdminRequest, opts ...grpc.CallOption) (*ListPipelineRunsAdminResponse, error)
+	RetryPipelineRun(ctx context.Context, in *RetryPipelineRunAdminRequest, opts ...grpc.CallOption) (*AdminEmptyResponse, error)
+	ForceCompletePipelineRun(ctx context.Context, in *ForceCompletePipelineRunAdminRequest, opts ...grpc.CallOption) (*pipeline.PipelineRun, error)
+	// ===================== Enricher Provider Circuits =====================
+	ListProviderCircuits(ctx context.Context, in *ListProviderCircuitsAdminRequest, opts ...grpc.CallOption) (*ListProviderCircuitsAdminResponse, error)
+	SetProviderCircuitMode(ctx context.Context, in *SetProviderCircuitModeAdminRequest, opts ...grpc.CallOption) (*pipeline.ProviderCircuit, error)
+	ListProviderCircuitChanges(ctx context.Context, in *ListProviderCircuitChangesAdminRequest, opts ...grpc.CallOption) (*ListProviderCircuitChangesAdminResponse, error)
+	// ===================== Failed Events (dead letters) =====================
+	ListFailedEvents(ctx context.Context, in *ListFailedEventsAdminRequest, opts ...grpc.CallOption) (*ListFailedEventsAdminResponse, error)
+	RedriveFailedEvents(ctx context.Context, in *RedriveFailedEventsAdminRequest, opts ...grpc.CallOption) (*RedriveFailedEventsAdminResponse, error)
 }
 
 type adminGatewayServiceClient struct {
@@ -80,6 +99,16 @@ func (c *adminGatewayServiceClient) ListUsers(ctx context.Context, in *ListUsers
 	return out, nil
 }
 
+func (c *adminGatewayServiceClient) SearchUsers(ctx context.Context, in *SearchUsersAdminRequest, opts ...grpc.CallOption) (*SearchUsersAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersAdminResponse)
+	err := c.cc.Invoke(ctx, AdminGatewayService_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminGatewayServiceClient) GetUser(ctx context.Context, in *UserIdAdminRequest, opts ...grpc.CallOption) (*user.UserProfile, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(user.UserProfile)
@@ -140,6 +169,76 @@ func (c *adminGatewayServiceClient) ListPipelineRuns(ctx context.Context, in *Li
 	return out, nil
 }
 
+func (c *adminGatewayServiceClient) RetryPipelineRun(ctx context.Context, in *RetryPipelineRunAdminRequest, opts ...grpc.CallOption) (*AdminEmptyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminEmptyResponse)
+	err := c.cc.Invoke(ctx, AdminGatewayService_RetryPipelineRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminGatewayServiceClient) ForceCompletePipelineRun(ctx context.Context, in *ForceCompletePipelineRunAdminRequest, opts ...grpc.CallOption) (*pipeline.PipelineRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.PipelineRun)
+	err := c.cc.Invoke(ctx, AdminGatewayService_ForceCompletePipelineRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminGatewayServiceClient) ListProviderCircuits(ctx context.Context, in *ListProviderCircuitsAdminRequest, opts ...grpc.CallOption) (*ListProviderCircuitsAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProviderCircuitsAdminResponse)
+	err := c.cc.Invoke(ctx, AdminGatewayService_ListProviderCircuits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminGatewayServiceClient) SetProviderCircuitMode(ctx context.Context, in *SetProviderCircuitModeAdminRequest, opts ...grpc.CallOption) (*pipeline.ProviderCircuit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.ProviderCircuit)
+	err := c.cc.Invoke(ctx, AdminGatewayService_SetProviderCircuitMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminGatewayServiceClient) ListProviderCircuitChanges(ctx context.Context, in *ListProviderCircuitChangesAdminRequest, opts ...grpc.CallOption) (*ListProviderCircuitChangesAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProviderCircuitChangesAdminResponse)
+	err := c.cc.Invoke(ctx, AdminGatewayService_ListProviderCircuitChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminGatewayServiceClient) ListFailedEvents(ctx context.Context, in *ListFailedEventsAdminRequest, opts ...grpc.CallOption) (*ListFailedEventsAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFailedEventsAdminResponse)
+	err := c.cc.Invoke(ctx, AdminGatewayService_ListFailedEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminGatewayServiceClient) RedriveFailedEvents(ctx context.Context, in *RedriveFailedEventsAdminRequest, opts ...grpc.CallOption) (*RedriveFailedEventsAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedriveFailedEventsAdminResponse)
+	err := c.cc.Invoke(ctx, AdminGatewayService_RedriveFailedEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminGatewayServiceServer is the server API for AdminGatewayService service.
 // All implementations must embed UnimplementedAdminGatewayServiceServer
 // for forward compatibility.
@@ -153,6 +252,7 @@ type AdminGatewayServiceServer interface {
 	GetStats(context.Context, *GetAdminStatsRequest) (*GetAdminStatsResponse, error)
 	// ===================== User Management =====================
 	ListUsers(context.Context, *ListUsersAdminRequest) (*ListUsersAdminResponse, error)
+	SearchUsers(context.Context, *SearchUsersAdminRequest) (*SearchUsersAdminResponse, error)
 	GetUser(context.Context, *UserIdAdminRequest) (*user.UserProfile, error)
 	UpdateUser(context.Context, *UpdateUserAdminRequest) (*user.UserProfile, error)
 	DeleteUser(context.Context, *UserIdAdminRequest) (*AdminEmptyResponse, error)
@@ -160,6 +260,15 @@ type AdminGatewayServiceServer interface {
 	// ===================== Pipeline Management =====================
 	ListAllPipelines(context.Context, *ListAllPipelinesAdminRequest) (*ListAllPipelinesAdminResponse, error)
 	ListPipelineRuns(context.Context, *ListPipelineRunsAdminRequest) (*ListPipelineRunsAdminResponse, error)
+	RetryPipelineRun(context.Context, *RetryPipelineRunAdminRequest) (*AdminEmptyResponse, error)
+	ForceCompletePipelineRun(context.Context, *ForceCompletePipelineRunAdminRequest) (*pipeline.PipelineRun, error)
+	// ===================== Enricher Provider Circuits =====================
+	ListProviderCircuits(context.Context, *ListProviderCircuitsAdminRequest) (*ListProviderCircuitsAdminResponse, error)
+	SetProviderCircuitMode(context.Context, *SetProviderCircuitModeAdminRequest) (*pipeline.ProviderCircuit, error)
+	ListProviderCircuitChanges(context.Context, *ListProviderCircuitChangesAdminRequest) (*ListProviderCircuitChangesAdminResponse, error)
+	// ===================== Failed Events (dead letters) =====================
+	ListFailedEvents(context.Context, *ListFailedEventsAdminRequest) (*ListFailedEventsAdminResponse, error)
+	RedriveFailedEvents(context.Context, *RedriveFailedEventsAdminRequest) (*RedriveFailedEventsAdminResponse, error)
 	mustEmbedUnimplementedAdminGatewayServiceServer()
 }
 
@@ -176,6 +285,9 @@ func (UnimplementedAdminGatewayServiceServer) GetStats(context.Context, *GetAdmi
 func (UnimplementedAdminGatewayServiceServer) ListUsers(context.Context, *ListUsersAdminRequest) (*ListUsersAdminResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListUsers not implemented")
 }
+func (UnimplementedAdminGatewayServiceServer) SearchUsers(context.Context, *SearchUsersAdminRequest) (*SearchUsersAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchUsers not implemented")
+}
 func (UnimplementedAdminGatewayServiceServer) GetUser(context.Context, *UserIdAdminRequest) (*user.UserProfile, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUser not implemented")
 }
@@ -194,6 +306,27 @@ func (UnimplementedAdminGatewayServiceServer) ListAllPipelines(context.Context,
 func (UnimplementedAdminGatewayServiceServer) ListPipelineRuns(context.Context, *ListPipelineRunsAdminRequest) (*ListPipelineRunsAdminResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListPipelineRuns not implemented")
 }
+func (UnimplementedAdminGatewayServiceServer) RetryPipelineRun(context.Context, *RetryPipelineRunAdminRequest) (*AdminEmptyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryPipelineRun not implemented")
+}
+func (UnimplementedAdminGatewayServiceServer) ForceCompletePipelineRun(context.Context, *ForceCompletePipelineRunAdminRequest) (*pipeline.PipelineRun, error) {
+	return nil, status.Error(codes.Unimplemented, "method ForceCompletePipelineRun not implemented")
+}
+func (UnimplementedAdminGatewayServiceServer) ListProviderCircuits(context.Context, *ListProviderCircuitsAdminRequest) (*ListProviderCircuitsAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProviderCircuits not implemented")
+}
+func (UnimplementedAdminGatewayServiceServer) SetProviderCircuitMode(context.Context, *SetProviderCircuitModeAdminRequest) (*pipeline.ProviderCircuit, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProviderCircuitMode not implemented")
+}
+func (UnimplementedAdminGatewayServiceServer) ListProviderCircuitChanges(context.Context, *ListProviderCircuitChangesAdminRequest) (*ListProviderCircuitChangesAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProviderCircuitChanges not implemented")
+}
+func (UnimplementedAdminGatewayServiceServer) ListFailedEvents(context.Context, *ListFailedEventsAdminRequest) (*ListFailedEventsAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFailedEvents not implemented")
+}
+func (UnimplementedAdminGatewayServiceServer) RedriveFailedEvents(context.Context, *RedriveFailedEventsAdminRequest) (*RedriveFailedEventsAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RedriveFailedEvents not implemented")
+}
 func (UnimplementedAdminGatewayServiceServer) mustEmbedUnimplementedAdminGatewayServiceServer() {}
 func (UnimplementedAdminGatewayServiceServer) testEmbeddedByValue()                             {}
 
@@ -251,6 +384,24 @@ func _AdminGatewayService_ListUsers_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminGatewayService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGatewayServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGatewayService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGatewayServiceServer).SearchUsers(ctx, req.(*SearchUsersAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminGatewayService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserIdAdminRequest)
 	if err := dec(in); err != nil {
@@ -359,6 +510,132 @@ func _AdminGatewayService_ListPipelineRuns_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminGatewayService_RetryPipelineRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryPipelineRunAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGatewayServiceServer).RetryPipelineRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGatewayService_RetryPipelineRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGatewayServiceServer).RetryPipelineRun(ctx, req.(*RetryPipelineRunAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminGatewayService_ForceCompletePipelineRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceCompletePipelineRunAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGatewayServiceServer).ForceCompletePipelineRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGatewayService_ForceCompletePipelineRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGatewayServiceServer).ForceCompletePipelineRun(ctx, req.(*ForceCompletePipelineRunAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminGatewayService_ListProviderCircuits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProviderCircuitsAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGatewayServiceServer).ListProviderCircuits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGatewayService_ListProviderCircuits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGatewayServiceServer).ListProviderCircuits(ctx, req.(*ListProviderCircuitsAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminGatewayService_SetProviderCircuitMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProviderCircuitModeAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGatewayServiceServer).SetProviderCircuitMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGatewayService_SetProviderCircuitMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGatewayServiceServer).SetProviderCircuitMode(ctx, req.(*SetProviderCircuitModeAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminGatewayService_ListProviderCircuitChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProviderCircuitChangesAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGatewayServiceServer).ListProviderCircuitChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGatewayService_ListProviderCircuitChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGatewayServiceServer).ListProviderCircuitChanges(ctx, req.(*ListProviderCircuitChangesAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminGatewayService_ListFailedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFailedEventsAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGatewayServiceServer).ListFailedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGatewayService_ListFailedEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGatewayServiceServer).ListFailedEvents(ctx, req.(*ListFailedEventsAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminGatewayService_RedriveFailedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedriveFailedEventsAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGatewayServiceServer).RedriveFailedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGatewayService_RedriveFailedEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGatewayServiceServer).RedriveFailedEvents(ctx, req.(*RedriveFailedEventsAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminGatewayService_ServiceDesc is the grpc.ServiceDesc for AdminGatewayService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -374,6 +651,10 @@ var AdminGatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUsers",
 			Handler:    _AdminGatewayService_ListUsers_Handler,
 		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _AdminGatewayService_SearchUsers_Handler,
+		},
 		{
 			MethodName: "GetUser",
 			Handler:    _AdminGatewayService_GetUser_Handler,
@@ -398,6 +679,34 @@ var AdminGatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPipelineRuns",
 			Handler:    _AdminGatewayService_ListPipelineRuns_Handler,
 		},
+		{
+			MethodName: "RetryPipelineRun",
+			Handler:    _AdminGatewayService_RetryPipelineRun_Handler,
+		},
+		{
+			MethodName: "ForceCompletePipelineRun",
+			Handler:    _AdminGatewayService_ForceCompletePipelineRun_Handler,
+		},
+		{
+			MethodName: "ListProviderCircuits",
+			Handler:    _AdminGatewayService_ListProviderCircuits_Handler,
+		},
+		{
+			MethodName: "SetProviderCircuitMode",
+			Handler:    _AdminGatewayService_SetProviderCircuitMode_Handler,
+		},
+		{
+			MethodName: "ListProviderCircuitChanges",
+			Handler:    _AdminGatewayService_ListProviderCircuitChanges_Handler,
+		},
+		{
+			MethodName: "ListFailedEvents",
+			Handler:    _AdminGatewayService_ListFailedEvents_Handler,
+		},
+		{
+			MethodName: "RedriveFailedEvents",
+			Handler:    _AdminGatewayService_RedriveFailedEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gateway/admin.proto",
@@ -8,6 +8,7 @@ package gateway
 
 import (
 	activity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	events "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	pipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 	plugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 	user "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
@@ -16,6 +17,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -461,6 +463,94 @@ func (x *CounterNameRequest) GetName() string {
 	return ""
 }
 
+type GearIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GearId        string                 `protobuf:"bytes,1,opt,name=gear_id,json=gearId,proto3" json:"gear_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GearIdRequest) Reset() {
+	*x = GearIdRequest{}
+	mi := &file_gateway_client_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GearIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GearIdRequest) ProtoMessage() {}
+
+func (x *GearIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GearIdRequest.ProtoReflect.Descriptor instead.
+func (*GearIdRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GearIdRequest) GetGearId() string {
+	if x != nil {
+		return x.GearId
+	}
+	return ""
+}
+
+type GoalIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoalId        string                 `protobuf:"bytes,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoalIdRequest) Reset() {
+	*x = GoalIdRequest{}
+	mi := &file_gateway_client_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoalIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalIdRequest) ProtoMessage() {}
+
+func (x *GoalIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalIdRequest.ProtoReflect.Descriptor instead.
+func (*GoalIdRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GoalIdRequest) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
 type ShowcaseEntryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ShowcaseId    string                 `protobuf:"bytes,1,opt,name=showcase_id,json=showcaseId,proto3" json:"showcase_id,omitempty"`
@@ -470,7 +560,7 @@ type ShowcaseEntryRequest struct {
 
 func (x *ShowcaseEntryRequest) Reset() {
 	*x = ShowcaseEntryRequest{}
-	mi := &file_gateway_client_proto_msgTypes[10]
+	mi := &file_gateway_client_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -482,7 +572,7 @@ func (x *ShowcaseEntryRequest) String() string {
 func (*ShowcaseEntryRequest) ProtoMessage() {}
 
 func (x *ShowcaseEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[10]
+	mi := &file_gateway_client_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -495,7 +585,7 @@ func (x *ShowcaseEntryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShowcaseEntryRequest.ProtoReflect.Descriptor instead.
 func (*ShowcaseEntryRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{10}
+	return file_gateway_client_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ShowcaseEntryRequest) GetShowcaseId() string {
@@ -515,7 +605,7 @@ type UpdateProfileGatewayRequest struct {
 
 func (x *UpdateProfileGatewayRequest) Reset() {
 	*x = UpdateProfileGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[11]
+	mi := &file_gateway_client_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -527,7 +617,7 @@ func (x *UpdateProfileGatewayRequest) String() string {
 func (*UpdateProfileGatewayRequest) ProtoMessage() {}
 
 func (x *UpdateProfileGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[11]
+	mi := &file_gateway_client_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,7 +630,7 @@ func (x *UpdateProfileGatewayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileGatewayRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProfileGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{11}
+	return file_gateway_client_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateProfileGatewayRequest) GetProfile() *user.UserProfile {
@@ -560,7 +650,7 @@ type GetIntegrationGatewayResponse struct {
 
 func (x *GetIntegrationGatewayResponse) Reset() {
 	*x = GetIntegrationGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[12]
+	mi := &file_gateway_client_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -572,7 +662,7 @@ func (x *GetIntegrationGatewayResponse) String() string {
 func (*GetIntegrationGatewayResponse) ProtoMessage() {}
 
 func (x *GetIntegrationGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[12]
+	mi := &file_gateway_client_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,7 +675,7 @@ func (x *GetIntegrationGatewayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIntegrationGatewayResponse.ProtoReflect.Descriptor instead.
 func (*GetIntegrationGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{12}
+	return file_gateway_client_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetIntegrationGatewayResponse) GetIntegrations() *user.UserIntegrations {
@@ -605,7 +695,7 @@ type SetIntegrationGatewayRequest struct {
 
 func (x *SetIntegrationGatewayRequest) Reset() {
 	*x = SetIntegrationGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[13]
+	mi := &file_gateway_client_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -617,7 +707,7 @@ func (x *SetIntegrationGatewayRequest) String() string {
 func (*SetIntegrationGatewayRequest) ProtoMessage() {}
 
 func (x *SetIntegrationGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[13]
+	mi := &file_gateway_client_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +720,7 @@ func (x *SetIntegrationGatewayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetIntegrationGatewayRequest.ProtoReflect.Descriptor instead.
 func (*SetIntegrationGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{13}
+	return file_gateway_client_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SetIntegrationGatewayRequest) GetProvider() string {
@@ -656,7 +746,7 @@ type OAuthConnectResponse struct {
 
 func (x *OAuthConnectResponse) Reset() {
 	*x = OAuthConnectResponse{}
-	mi := &file_gateway_client_proto_msgTypes[14]
+	mi := &file_gateway_client_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -668,7 +758,7 @@ func (x *OAuthConnectResponse) String() string {
 func (*OAuthConnectResponse) ProtoMessage() {}
 
 func (x *OAuthConnectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[14]
+	mi := &file_gateway_client_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,7 +771,7 @@ func (x *OAuthConnectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthConnectResponse.ProtoReflect.Descriptor instead.
 func (*OAuthConnectResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{14}
+	return file_gateway_client_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *OAuthConnectResponse) GetUrl() string {
@@ -701,7 +791,7 @@ type ConnectionActionGatewayRequest struct {
 
 func (x *ConnectionActionGatewayRequest) Reset() {
 	*x = ConnectionActionGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[15]
+	mi := &file_gateway_client_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -713,7 +803,7 @@ func (x *ConnectionActionGatewayRequest) String() string {
 func (*ConnectionActionGatewayRequest) ProtoMessage() {}
 
 func (x *ConnectionActionGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[15]
+	mi := &file_gateway_client_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,7 +816,7 @@ func (x *ConnectionActionGatewayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectionActionGatewayRequest.ProtoReflect.Descriptor instead.
 func (*ConnectionActionGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{15}
+	return file_gateway_client_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ConnectionActionGatewayRequest) GetProvider() string {
@@ -753,7 +843,7 @@ type ListCountersGatewayResponse struct {
 
 func (x *ListCountersGatewayResponse) Reset() {
 	*x = ListCountersGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[16]
+	mi := &file_gateway_client_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -765,7 +855,7 @@ func (x *ListCountersGatewayResponse) String() string {
 func (*ListCountersGatewayResponse) ProtoMessage() {}
 
 func (x *ListCountersGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[16]
+	mi := &file_gateway_client_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,7 +868,7 @@ func (x *ListCountersGatewayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCountersGatewayResponse.ProtoReflect.Descriptor instead.
 func (*ListCountersGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{16}
+	return file_gateway_client_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListCountersGatewayResponse) GetCounters() []*user.Counter {
@@ -798,7 +888,7 @@ type UpdateCounterGatewayRequest struct {
 
 func (x *UpdateCounterGatewayRequest) Reset() {
 	*x = UpdateCounterGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[17]
+	mi := &file_gateway_client_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -810,7 +900,7 @@ func (x *UpdateCounterGatewayRequest) String() string {
 func (*UpdateCounterGatewayRequest) ProtoMessage() {}
 
 func (x *UpdateCounterGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[17]
+	mi := &file_gateway_client_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,7 +913,7 @@ func (x *UpdateCounterGatewayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCounterGatewayRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCounterGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{17}
+	return file_gateway_client_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateCounterGatewayRequest) GetName() string {
@@ -850,7 +940,7 @@ type GetBoosterDataGatewayResponse struct {
 
 func (x *GetBoosterDataGatewayResponse) Reset() {
 	*x = GetBoosterDataGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[18]
+	mi := &file_gateway_client_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -862,7 +952,7 @@ func (x *GetBoosterDataGatewayResponse) String() string {
 func (*GetBoosterDataGatewayResponse) ProtoMessage() {}
 
 func (x *GetBoosterDataGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[18]
+	mi := &file_gateway_client_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,7 +965,7 @@ func (x *GetBoosterDataGatewayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBoosterDataGatewayResponse.ProtoReflect.Descriptor instead.
 func (*GetBoosterDataGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{18}
+	return file_gateway_client_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetBoosterDataGatewayResponse) GetData() map[string]*structpb.Struct {
@@ -895,7 +985,7 @@ type SetBoosterDataGatewayRequest struct {
 
 func (x *SetBoosterDataGatewayRequest) Reset() {
 	*x = SetBoosterDataGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[19]
+	mi := &file_gateway_client_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -907,7 +997,7 @@ func (x *SetBoosterDataGatewayRequest) String() string {
 func (*SetBoosterDataGatewayRequest) ProtoMessage() {}
 
 func (x *SetBoosterDataGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[19]
+	mi := &file_gateway_client_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -920,7 +1010,7 @@ func (x *SetBoosterDataGatewayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetBoosterDataGatewayRequest.ProtoReflect.Descriptor instead.
 func (*SetBoosterDataGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{19}
+	return file_gateway_client_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SetBoosterDataGatewayRequest) GetBoosterId() string {
@@ -947,7 +1037,7 @@ type ListPersonalRecordsGatewayResponse struct {
 
 func (x *ListPersonalRecordsGatewayResponse) Reset() {
 	*x = ListPersonalRecordsGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[20]
+	mi := &file_gateway_client_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -959,7 +1049,7 @@ func (x *ListPersonalRecordsGatewayResponse) String() string {
 func (*ListPersonalRecordsGatewayResponse) ProtoMessage() {}
 
 func (x *ListPersonalRecordsGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[20]
+	mi := &file_gateway_client_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -972,7 +1062,7 @@ func (x *ListPersonalRecordsGatewayResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListPersonalRecordsGatewayResponse.ProtoReflect.Descriptor instead.
 func (*ListPersonalRecordsGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{20}
+	return file_gateway_client_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListPersonalRecordsGatewayResponse) GetRecords() []*user.PersonalRecord {
@@ -994,7 +1084,7 @@ type SetPersonalRecordGatewayRequest struct {
 
 func (x *SetPersonalRecordGatewayRequest) Reset() {
 	*x = SetPersonalRecordGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[21]
+	mi := &file_gateway_client_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1006,7 +1096,7 @@ func (x *SetPersonalRecordGatewayRequest) String() string {
 func (*SetPersonalRecordGatewayRequest) ProtoMessage() {}
 
 func (x *SetPersonalRecordGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[21]
+	mi := &file_gateway_client_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,7 +1109,7 @@ func (x *SetPersonalRecordGatewayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPersonalRecordGatewayRequest.ProtoReflect.Descriptor instead.
 func (*SetPersonalRecordGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{21}
+	return file_gateway_client_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SetPersonalRecordGatewayRequest) GetRecordType() string {
@@ -1050,29 +1140,29 @@ func (x *SetPersonalRecordGatewayRequest) GetActivityId() string {
 	return ""
 }
 
-// Plugin Defaults
-type ListPluginDefaultsGatewayResponse struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Defaults      map[string]*structpb.Struct `protobuf:"bytes,1,rep,name=defaults,proto3" json:"defaults,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+// Gear
+type ListGearGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gear          []*user.Gear           `protobuf:"bytes,1,rep,name=gear,proto3" json:"gear,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPluginDefaultsGatewayResponse) Reset() {
-	*x = ListPluginDefaultsGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[22]
+func (x *ListGearGatewayResponse) Reset() {
+	*x = ListGearGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPluginDefaultsGatewayResponse) String() string {
+func (x *ListGearGatewayResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPluginDefaultsGatewayResponse) ProtoMessage() {}
+func (*ListGearGatewayResponse) ProtoMessage() {}
 
-func (x *ListPluginDefaultsGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[22]
+func (x *ListGearGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,41 +1173,44 @@ func (x *ListPluginDefaultsGatewayResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPluginDefaultsGatewayResponse.ProtoReflect.Descriptor instead.
-func (*ListPluginDefaultsGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ListGearGatewayResponse.ProtoReflect.Descriptor instead.
+func (*ListGearGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListPluginDefaultsGatewayResponse) GetDefaults() map[string]*structpb.Struct {
+func (x *ListGearGatewayResponse) GetGear() []*user.Gear {
 	if x != nil {
-		return x.Defaults
+		return x.Gear
 	}
 	return nil
 }
 
-type SetPluginDefaultsGatewayRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PluginId      string                 `protobuf:"bytes,1,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
-	Defaults      *structpb.Struct       `protobuf:"bytes,2,opt,name=defaults,proto3" json:"defaults,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SetGearGatewayRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	GearId                string                 `protobuf:"bytes,1,opt,name=gear_id,json=gearId,proto3" json:"gear_id,omitempty"`
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type                  user.GearType          `protobuf:"varint,3,opt,name=type,proto3,enum=fitglue.models.user.GearType" json:"type,omitempty"`
+	InitialDistanceMeters float64                `protobuf:"fixed64,4,opt,name=initial_distance_meters,json=initialDistanceMeters,proto3" json:"initial_distance_meters,omitempty"`
+	Retired               bool                   `protobuf:"varint,5,opt,name=retired,proto3" json:"retired,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *SetPluginDefaultsGatewayRequest) Reset() {
-	*x = SetPluginDefaultsGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[23]
+func (x *SetGearGatewayRequest) Reset() {
+	*x = SetGearGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetPluginDefaultsGatewayRequest) String() string {
+func (x *SetGearGatewayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPluginDefaultsGatewayRequest) ProtoMessage() {}
+func (*SetGearGatewayRequest) ProtoMessage() {}
 
-func (x *SetPluginDefaultsGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[23]
+func (x *SetGearGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1128,48 +1221,69 @@ func (x *SetPluginDefaultsGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPluginDefaultsGatewayRequest.ProtoReflect.Descriptor instead.
-func (*SetPluginDefaultsGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use SetGearGatewayRequest.ProtoReflect.Descriptor instead.
+func (*SetGearGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *SetPluginDefaultsGatewayRequest) GetPluginId() string {
+func (x *SetGearGatewayRequest) GetGearId() string {
 	if x != nil {
-		return x.PluginId
+		return x.GearId
 	}
 	return ""
 }
 
-func (x *SetPluginDefaultsGatewayRequest) GetDefaults() *structpb.Struct {
+func (x *SetGearGatewayRequest) GetName() string {
 	if x != nil {
-		return x.Defaults
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// Auth Email
-type SendEmailChangeGatewayRequest struct {
+func (x *SetGearGatewayRequest) GetType() user.GearType {
+	if x != nil {
+		return x.Type
+	}
+	return user.GearType(0)
+}
+
+func (x *SetGearGatewayRequest) GetInitialDistanceMeters() float64 {
+	if x != nil {
+		return x.InitialDistanceMeters
+	}
+	return 0
+}
+
+func (x *SetGearGatewayRequest) GetRetired() bool {
+	if x != nil {
+		return x.Retired
+	}
+	return false
+}
+
+// Goals
+type ListGoalsGatewayResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NewEmail      string                 `protobuf:"bytes,1,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	Goals         []*user.Goal           `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendEmailChangeGatewayRequest) Reset() {
-	*x = SendEmailChangeGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[24]
+func (x *ListGoalsGatewayResponse) Reset() {
+	*x = ListGoalsGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendEmailChangeGatewayRequest) String() string {
+func (x *ListGoalsGatewayResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendEmailChangeGatewayRequest) ProtoMessage() {}
+func (*ListGoalsGatewayResponse) ProtoMessage() {}
 
-func (x *SendEmailChangeGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[24]
+func (x *ListGoalsGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1180,40 +1294,46 @@ func (x *SendEmailChangeGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendEmailChangeGatewayRequest.ProtoReflect.Descriptor instead.
-func (*SendEmailChangeGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListGoalsGatewayResponse.ProtoReflect.Descriptor instead.
+func (*ListGoalsGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *SendEmailChangeGatewayRequest) GetNewEmail() string {
+func (x *ListGoalsGatewayResponse) GetGoals() []*user.Goal {
 	if x != nil {
-		return x.NewEmail
+		return x.Goals
 	}
-	return ""
+	return nil
 }
 
-type SendPasswordResetGatewayRequest struct {
+type SetGoalGatewayRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	GoalId        string                 `protobuf:"bytes,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Metric        user.GoalMetric        `protobuf:"varint,3,opt,name=metric,proto3,enum=fitglue.models.user.GoalMetric" json:"metric,omitempty"`
+	Target        float64                `protobuf:"fixed64,4,opt,name=target,proto3" json:"target,omitempty"`
+	ActivityType  activity.ActivityType  `protobuf:"varint,5,opt,name=activity_type,json=activityType,proto3,enum=fitglue.models.activity.ActivityType" json:"activity_type,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendPasswordResetGatewayRequest) Reset() {
-	*x = SendPasswordResetGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[25]
+func (x *SetGoalGatewayRequest) Reset() {
+	*x = SetGoalGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendPasswordResetGatewayRequest) String() string {
+func (x *SetGoalGatewayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendPasswordResetGatewayRequest) ProtoMessage() {}
+func (*SetGoalGatewayRequest) ProtoMessage() {}
 
-func (x *SendPasswordResetGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[25]
+func (x *SetGoalGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1224,42 +1344,1720 @@ func (x *SendPasswordResetGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendPasswordResetGatewayRequest.ProtoReflect.Descriptor instead.
-func (*SendPasswordResetGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use SetGoalGatewayRequest.ProtoReflect.Descriptor instead.
+func (*SetGoalGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SendPasswordResetGatewayRequest) GetEmail() string {
+func (x *SetGoalGatewayRequest) GetGoalId() string {
 	if x != nil {
-		return x.Email
+		return x.GoalId
 	}
 	return ""
 }
 
-// FCM Token
-type SetFCMTokenGatewayRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"` // "web", "android", "ios"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SetGoalGatewayRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *SetFCMTokenGatewayRequest) Reset() {
-	*x = SetFCMTokenGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[26]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SetGoalGatewayRequest) GetMetric() user.GoalMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return user.GoalMetric(0)
 }
 
-func (x *SetFCMTokenGatewayRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+func (x *SetGoalGatewayRequest) GetTarget() float64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *SetGoalGatewayRequest) GetActivityType() activity.ActivityType {
+	if x != nil {
+		return x.ActivityType
+	}
+	return activity.ActivityType(0)
+}
+
+func (x *SetGoalGatewayRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *SetGoalGatewayRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+// Plugin Defaults
+type ListPluginDefaultsGatewayResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Defaults      map[string]*structpb.Struct `protobuf:"bytes,1,rep,name=defaults,proto3" json:"defaults,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPluginDefaultsGatewayResponse) Reset() {
+	*x = ListPluginDefaultsGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPluginDefaultsGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPluginDefaultsGatewayResponse) ProtoMessage() {}
+
+func (x *ListPluginDefaultsGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPluginDefaultsGatewayResponse.ProtoReflect.Descriptor instead.
+func (*ListPluginDefaultsGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListPluginDefaultsGatewayResponse) GetDefaults() map[string]*structpb.Struct {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+type SetPluginDefaultsGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PluginId      string                 `protobuf:"bytes,1,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+	Defaults      *structpb.Struct       `protobuf:"bytes,2,opt,name=defaults,proto3" json:"defaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPluginDefaultsGatewayRequest) Reset() {
+	*x = SetPluginDefaultsGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPluginDefaultsGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPluginDefaultsGatewayRequest) ProtoMessage() {}
+
+func (x *SetPluginDefaultsGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPluginDefaultsGatewayRequest.ProtoReflect.Descriptor instead.
+func (*SetPluginDefaultsGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetPluginDefaultsGatewayRequest) GetPluginId() string {
+	if x != nil {
+		return x.PluginId
+	}
+	return ""
+}
+
+func (x *SetPluginDefaultsGatewayRequest) GetDefaults() *structpb.Struct {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+// Auth Email
+type SendEmailChangeGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewEmail      string                 `protobuf:"bytes,1,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEmailChangeGatewayRequest) Reset() {
+	*x = SendEmailChangeGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEmailChangeGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEmailChangeGatewayRequest) ProtoMessage() {}
+
+func (x *SendEmailChangeGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEmailChangeGatewayRequest.ProtoReflect.Descriptor instead.
+func (*SendEmailChangeGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SendEmailChangeGatewayRequest) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+type SendPasswordResetGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendPasswordResetGatewayRequest) Reset() {
+	*x = SendPasswordResetGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPasswordResetGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPasswordResetGatewayRequest) ProtoMessage() {}
+
+func (x *SendPasswordResetGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPasswordResetGatewayRequest.ProtoReflect.Descriptor instead.
+func (*SendPasswordResetGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SendPasswordResetGatewayRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// FCM Token
+type SetFCMTokenGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"` // "web", "android", "ios"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFCMTokenGatewayRequest) Reset() {
+	*x = SetFCMTokenGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFCMTokenGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFCMTokenGatewayRequest) ProtoMessage() {}
+
+func (x *SetFCMTokenGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFCMTokenGatewayRequest.ProtoReflect.Descriptor instead.
+func (*SetFCMTokenGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetFCMTokenGatewayRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetFCMTokenGatewayRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+// API Keys
+type ApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // SHA-256 hash of the key; the key itself is only shown once
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_gateway_client_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ApiKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiKey) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ApiKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+type ListApiKeysGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*ApiKey              `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysGatewayResponse) Reset() {
+	*x = ListApiKeysGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysGatewayResponse) ProtoMessage() {}
+
+func (x *ListApiKeysGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysGatewayResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListApiKeysGatewayResponse) GetKeys() []*ApiKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type CreateApiKeyGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyGatewayRequest) Reset() {
+	*x = CreateApiKeyGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyGatewayRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyGatewayRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateApiKeyGatewayRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type CreateApiKeyGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // Sent as "Authorization: Bearer <api_key>"
+	Key           *ApiKey                `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyGatewayResponse) Reset() {
+	*x = CreateApiKeyGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyGatewayResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyGatewayResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateApiKeyGatewayResponse) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *CreateApiKeyGatewayResponse) GetKey() *ApiKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type ApiKeyIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyIdRequest) Reset() {
+	*x = ApiKeyIdRequest{}
+	mi := &file_gateway_client_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyIdRequest) ProtoMessage() {}
+
+func (x *ApiKeyIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyIdRequest.ProtoReflect.Descriptor instead.
+func (*ApiKeyIdRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ApiKeyIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Pipelines
+type ListPipelinesGatewayResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Pipelines     []*pipeline.PipelineConfig `protobuf:"bytes,1,rep,name=pipelines,proto3" json:"pipelines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPipelinesGatewayResponse) Reset() {
+	*x = ListPipelinesGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPipelinesGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPipelinesGatewayResponse) ProtoMessage() {}
+
+func (x *ListPipelinesGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPipelinesGatewayResponse.ProtoReflect.Descriptor instead.
+func (*ListPipelinesGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListPipelinesGatewayResponse) GetPipelines() []*pipeline.PipelineConfig {
+	if x != nil {
+		return x.Pipelines
+	}
+	return nil
+}
+
+type CreatePipelineGatewayRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Pipeline      *pipeline.PipelineConfig `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePipelineGatewayRequest) Reset() {
+	*x = CreatePipelineGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePipelineGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePipelineGatewayRequest) ProtoMessage() {}
+
+func (x *CreatePipelineGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePipelineGatewayRequest.ProtoReflect.Descriptor instead.
+func (*CreatePipelineGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreatePipelineGatewayRequest) GetPipeline() *pipeline.PipelineConfig {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+type UpdatePipelineGatewayRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pipeline      *pipeline.PipelineConfig `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePipelineGatewayRequest) Reset() {
+	*x = UpdatePipelineGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePipelineGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePipelineGatewayRequest) ProtoMessage() {}
+
+func (x *UpdatePipelineGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePipelineGatewayRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePipelineGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdatePipelineGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePipelineGatewayRequest) GetPipeline() *pipeline.PipelineConfig {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+type StartBackfillGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`      // pipeline_id from path
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"` // History window; defaults to 90 when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartBackfillGatewayRequest) Reset() {
+	*x = StartBackfillGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartBackfillGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBackfillGatewayRequest) ProtoMessage() {}
+
+func (x *StartBackfillGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBackfillGatewayRequest.ProtoReflect.Descriptor instead.
+func (*StartBackfillGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *StartBackfillGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StartBackfillGatewayRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type GetBackfillJobGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // pipeline_id from path
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBackfillJobGatewayRequest) Reset() {
+	*x = GetBackfillJobGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBackfillJobGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBackfillJobGatewayRequest) ProtoMessage() {}
+
+func (x *GetBackfillJobGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBackfillJobGatewayRequest.ProtoReflect.Descriptor instead.
+func (*GetBackfillJobGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetBackfillJobGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetBackfillJobGatewayRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type CreateImportGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                // pipeline_id from path
+	FileNames     []string               `protobuf:"bytes,2,rep,name=file_names,json=fileNames,proto3" json:"file_names,omitempty"` // One entry per file the client will upload
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateImportGatewayRequest) Reset() {
+	*x = CreateImportGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateImportGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateImportGatewayRequest) ProtoMessage() {}
+
+func (x *CreateImportGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateImportGatewayRequest.ProtoReflect.Descriptor instead.
+func (*CreateImportGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateImportGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateImportGatewayRequest) GetFileNames() []string {
+	if x != nil {
+		return x.FileNames
+	}
+	return nil
+}
+
+type UploadImportFileGatewayRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // pipeline_id from path
+	ImportId       string                 `protobuf:"bytes,2,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	Index          int32                  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"` // Position in file_names
+	FitFileContent []byte                 `protobuf:"bytes,4,opt,name=fit_file_content,json=fitFileContent,proto3" json:"fit_file_content,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UploadImportFileGatewayRequest) Reset() {
+	*x = UploadImportFileGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadImportFileGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImportFileGatewayRequest) ProtoMessage() {}
+
+func (x *UploadImportFileGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImportFileGatewayRequest.ProtoReflect.Descriptor instead.
+func (*UploadImportFileGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UploadImportFileGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UploadImportFileGatewayRequest) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+func (x *UploadImportFileGatewayRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *UploadImportFileGatewayRequest) GetFitFileContent() []byte {
+	if x != nil {
+		return x.FitFileContent
+	}
+	return nil
+}
+
+type ImportGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // pipeline_id from path
+	ImportId      string                 `protobuf:"bytes,2,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGatewayRequest) Reset() {
+	*x = ImportGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGatewayRequest) ProtoMessage() {}
+
+func (x *ImportGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGatewayRequest.ProtoReflect.Descriptor instead.
+func (*ImportGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ImportGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportGatewayRequest) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+type PlatformStatusGatewayResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Platforms currently in outage, for the web app's banner. Work for them
+	// is queued and drained automatically once they recover.
+	Outages       []*pipeline.PlatformHealth `protobuf:"bytes,1,rep,name=outages,proto3" json:"outages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlatformStatusGatewayResponse) Reset() {
+	*x = PlatformStatusGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlatformStatusGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformStatusGatewayResponse) ProtoMessage() {}
+
+func (x *PlatformStatusGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformStatusGatewayResponse.ProtoReflect.Descriptor instead.
+func (*PlatformStatusGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PlatformStatusGatewayResponse) GetOutages() []*pipeline.PlatformHealth {
+	if x != nil {
+		return x.Outages
+	}
+	return nil
+}
+
+type ListPipelineRunsGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // pipeline_id from path
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPipelineRunsGatewayRequest) Reset() {
+	*x = ListPipelineRunsGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPipelineRunsGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPipelineRunsGatewayRequest) ProtoMessage() {}
+
+func (x *ListPipelineRunsGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPipelineRunsGatewayRequest.ProtoReflect.Descriptor instead.
+func (*ListPipelineRunsGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListPipelineRunsGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListPipelineRunsGatewayRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPipelineRunsGatewayRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPipelineRunsGatewayResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Runs          []*pipeline.PipelineRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPipelineRunsGatewayResponse) Reset() {
+	*x = ListPipelineRunsGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPipelineRunsGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPipelineRunsGatewayResponse) ProtoMessage() {}
+
+func (x *ListPipelineRunsGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPipelineRunsGatewayResponse.ProtoReflect.Descriptor instead.
+func (*ListPipelineRunsGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListPipelineRunsGatewayResponse) GetRuns() []*pipeline.PipelineRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *ListPipelineRunsGatewayResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetPipelineRunGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // pipeline_id from path
+	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPipelineRunGatewayRequest) Reset() {
+	*x = GetPipelineRunGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPipelineRunGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineRunGatewayRequest) ProtoMessage() {}
+
+func (x *GetPipelineRunGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineRunGatewayRequest.ProtoReflect.Descriptor instead.
+func (*GetPipelineRunGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetPipelineRunGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetPipelineRunGatewayRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type RetryPipelineRunGatewayRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // pipeline_id from path
+	RunId string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Provider names to re-run (e.g. "weather"); empty re-runs every enricher
+	Enrichers     []string `protobuf:"bytes,3,rep,name=enrichers,proto3" json:"enrichers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryPipelineRunGatewayRequest) Reset() {
+	*x = RetryPipelineRunGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPipelineRunGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPipelineRunGatewayRequest) ProtoMessage() {}
+
+func (x *RetryPipelineRunGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPipelineRunGatewayRequest.ProtoReflect.Descriptor instead.
+func (*RetryPipelineRunGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RetryPipelineRunGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RetryPipelineRunGatewayRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *RetryPipelineRunGatewayRequest) GetEnrichers() []string {
+	if x != nil {
+		return x.Enrichers
+	}
+	return nil
+}
+
+// Pauses one pipeline, or every pipeline (vacation mode) when pipeline_id is
+// empty. Leaving paused_until unset clears the pause.
+type PausePipelinesGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PipelineId    string                 `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	PausedUntil   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=paused_until,json=pausedUntil,proto3" json:"paused_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PausePipelinesGatewayRequest) Reset() {
+	*x = PausePipelinesGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PausePipelinesGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PausePipelinesGatewayRequest) ProtoMessage() {}
+
+func (x *PausePipelinesGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PausePipelinesGatewayRequest.ProtoReflect.Descriptor instead.
+func (*PausePipelinesGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PausePipelinesGatewayRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *PausePipelinesGatewayRequest) GetPausedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedUntil
+	}
+	return nil
+}
+
+// Clears the pause and releases (or discards) the activities deferred while
+// paused.
+type ResumePipelinesGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PipelineId    string                 `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	Discard       bool                   `protobuf:"varint,2,opt,name=discard,proto3" json:"discard,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumePipelinesGatewayRequest) Reset() {
+	*x = ResumePipelinesGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumePipelinesGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumePipelinesGatewayRequest) ProtoMessage() {}
+
+func (x *ResumePipelinesGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumePipelinesGatewayRequest.ProtoReflect.Descriptor instead.
+func (*ResumePipelinesGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ResumePipelinesGatewayRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *ResumePipelinesGatewayRequest) GetDiscard() bool {
+	if x != nil {
+		return x.Discard
+	}
+	return false
+}
+
+type ResumePipelinesGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Released      int32                  `protobuf:"varint,1,opt,name=released,proto3" json:"released,omitempty"`
+	Discarded     int32                  `protobuf:"varint,2,opt,name=discarded,proto3" json:"discarded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumePipelinesGatewayResponse) Reset() {
+	*x = ResumePipelinesGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumePipelinesGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumePipelinesGatewayResponse) ProtoMessage() {}
+
+func (x *ResumePipelinesGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumePipelinesGatewayResponse.ProtoReflect.Descriptor instead.
+func (*ResumePipelinesGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ResumePipelinesGatewayResponse) GetReleased() int32 {
+	if x != nil {
+		return x.Released
+	}
+	return 0
+}
+
+func (x *ResumePipelinesGatewayResponse) GetDiscarded() int32 {
+	if x != nil {
+		return x.Discarded
+	}
+	return 0
+}
+
+// Records the user changing an activity's type; repeated corrections for the
+// same source and title are learned and applied to future activities.
+type CorrectActivityTypeGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // activity_id from path
+	ActivityType  activity.ActivityType  `protobuf:"varint,2,opt,name=activity_type,json=activityType,proto3,enum=fitglue.models.activity.ActivityType" json:"activity_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CorrectActivityTypeGatewayRequest) Reset() {
+	*x = CorrectActivityTypeGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorrectActivityTypeGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrectActivityTypeGatewayRequest) ProtoMessage() {}
+
+func (x *CorrectActivityTypeGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrectActivityTypeGatewayRequest.ProtoReflect.Descriptor instead.
+func (*CorrectActivityTypeGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CorrectActivityTypeGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CorrectActivityTypeGatewayRequest) GetActivityType() activity.ActivityType {
+	if x != nil {
+		return x.ActivityType
+	}
+	return activity.ActivityType(0)
+}
+
+type CorrectActivityTypeGatewayResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Rule          *pipeline.ActivityTypeRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CorrectActivityTypeGatewayResponse) Reset() {
+	*x = CorrectActivityTypeGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorrectActivityTypeGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrectActivityTypeGatewayResponse) ProtoMessage() {}
+
+func (x *CorrectActivityTypeGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrectActivityTypeGatewayResponse.ProtoReflect.Descriptor instead.
+func (*CorrectActivityTypeGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CorrectActivityTypeGatewayResponse) GetRule() *pipeline.ActivityTypeRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type ListActivityTypeRulesGatewayResponse struct {
+	state          protoimpl.MessageState       `protogen:"open.v1"`
+	Rules          []*pipeline.ActivityTypeRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	LearnThreshold int32                        `protobuf:"varint,2,opt,name=learn_threshold,json=learnThreshold,proto3" json:"learn_threshold,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListActivityTypeRulesGatewayResponse) Reset() {
+	*x = ListActivityTypeRulesGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivityTypeRulesGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivityTypeRulesGatewayResponse) ProtoMessage() {}
+
+func (x *ListActivityTypeRulesGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivityTypeRulesGatewayResponse.ProtoReflect.Descriptor instead.
+func (*ListActivityTypeRulesGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListActivityTypeRulesGatewayResponse) GetRules() []*pipeline.ActivityTypeRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ListActivityTypeRulesGatewayResponse) GetLearnThreshold() int32 {
+	if x != nil {
+		return x.LearnThreshold
+	}
+	return 0
+}
+
+type UpdateActivityTypeRuleGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // rule_id from path
+	Disabled      bool                   `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateActivityTypeRuleGatewayRequest) Reset() {
+	*x = UpdateActivityTypeRuleGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivityTypeRuleGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivityTypeRuleGatewayRequest) ProtoMessage() {}
+
+func (x *UpdateActivityTypeRuleGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivityTypeRuleGatewayRequest.ProtoReflect.Descriptor instead.
+func (*UpdateActivityTypeRuleGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UpdateActivityTypeRuleGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateActivityTypeRuleGatewayRequest) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type ActivityTypeRuleIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityTypeRuleIdRequest) Reset() {
+	*x = ActivityTypeRuleIdRequest{}
+	mi := &file_gateway_client_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityTypeRuleIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityTypeRuleIdRequest) ProtoMessage() {}
+
+func (x *ActivityTypeRuleIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityTypeRuleIdRequest.ProtoReflect.Descriptor instead.
+func (*ActivityTypeRuleIdRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ActivityTypeRuleIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PipelineCalendarGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // pipeline_id from path
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PipelineCalendarGatewayRequest) Reset() {
+	*x = PipelineCalendarGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PipelineCalendarGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineCalendarGatewayRequest) ProtoMessage() {}
+
+func (x *PipelineCalendarGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineCalendarGatewayRequest.ProtoReflect.Descriptor instead.
+func (*PipelineCalendarGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PipelineCalendarGatewayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PipelineCalendarGatewayRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type PipelineCalendarGatewayResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Days          []*pipeline.PipelineCalendarDay `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PipelineCalendarGatewayResponse) Reset() {
+	*x = PipelineCalendarGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-func (*SetFCMTokenGatewayRequest) ProtoMessage() {}
+func (x *PipelineCalendarGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (x *SetFCMTokenGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[26]
+func (*PipelineCalendarGatewayResponse) ProtoMessage() {}
+
+func (x *PipelineCalendarGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1270,48 +3068,41 @@ func (x *SetFCMTokenGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetFCMTokenGatewayRequest.ProtoReflect.Descriptor instead.
-func (*SetFCMTokenGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *SetFCMTokenGatewayRequest) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
+// Deprecated: Use PipelineCalendarGatewayResponse.ProtoReflect.Descriptor instead.
+func (*PipelineCalendarGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SetFCMTokenGatewayRequest) GetPlatform() string {
+func (x *PipelineCalendarGatewayResponse) GetDays() []*pipeline.PipelineCalendarDay {
 	if x != nil {
-		return x.Platform
+		return x.Days
 	}
-	return ""
+	return nil
 }
 
-// Pipelines
-type ListPipelinesGatewayResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Pipelines     []*pipeline.PipelineConfig `protobuf:"bytes,1,rep,name=pipelines,proto3" json:"pipelines,omitempty"`
+type PreviewPipelineGatewayRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Id            string                         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // pipeline_id from path
+	Activity      *activity.StandardizedActivity `protobuf:"bytes,2,opt,name=activity,proto3" json:"activity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPipelinesGatewayResponse) Reset() {
-	*x = ListPipelinesGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[27]
+func (x *PreviewPipelineGatewayRequest) Reset() {
+	*x = PreviewPipelineGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPipelinesGatewayResponse) String() string {
+func (x *PreviewPipelineGatewayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPipelinesGatewayResponse) ProtoMessage() {}
+func (*PreviewPipelineGatewayRequest) ProtoMessage() {}
 
-func (x *ListPipelinesGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[27]
+func (x *PreviewPipelineGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1322,40 +3113,48 @@ func (x *ListPipelinesGatewayResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPipelinesGatewayResponse.ProtoReflect.Descriptor instead.
-func (*ListPipelinesGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use PreviewPipelineGatewayRequest.ProtoReflect.Descriptor instead.
+func (*PreviewPipelineGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ListPipelinesGatewayResponse) GetPipelines() []*pipeline.PipelineConfig {
+func (x *PreviewPipelineGatewayRequest) GetId() string {
 	if x != nil {
-		return x.Pipelines
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PreviewPipelineGatewayRequest) GetActivity() *activity.StandardizedActivity {
+	if x != nil {
+		return x.Activity
 	}
 	return nil
 }
 
-type CreatePipelineGatewayRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Pipeline      *pipeline.PipelineConfig `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+type DescriptionPreviewGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                   // activity_id from path
+	Destination   string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"` // query param, e.g. DESTINATION_STRAVA or strava
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePipelineGatewayRequest) Reset() {
-	*x = CreatePipelineGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[28]
+func (x *DescriptionPreviewGatewayRequest) Reset() {
+	*x = DescriptionPreviewGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePipelineGatewayRequest) String() string {
+func (x *DescriptionPreviewGatewayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePipelineGatewayRequest) ProtoMessage() {}
+func (*DescriptionPreviewGatewayRequest) ProtoMessage() {}
 
-func (x *CreatePipelineGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[28]
+func (x *DescriptionPreviewGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1366,41 +3165,48 @@ func (x *CreatePipelineGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePipelineGatewayRequest.ProtoReflect.Descriptor instead.
-func (*CreatePipelineGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use DescriptionPreviewGatewayRequest.ProtoReflect.Descriptor instead.
+func (*DescriptionPreviewGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *CreatePipelineGatewayRequest) GetPipeline() *pipeline.PipelineConfig {
+func (x *DescriptionPreviewGatewayRequest) GetId() string {
 	if x != nil {
-		return x.Pipeline
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type UpdatePipelineGatewayRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Pipeline      *pipeline.PipelineConfig `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+func (x *DescriptionPreviewGatewayRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+type EnricherUsageGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PipelineId    string                 `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"` // optional filter
+	MaxRuns       int32                  `protobuf:"varint,2,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePipelineGatewayRequest) Reset() {
-	*x = UpdatePipelineGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[29]
+func (x *EnricherUsageGatewayRequest) Reset() {
+	*x = EnricherUsageGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePipelineGatewayRequest) String() string {
+func (x *EnricherUsageGatewayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePipelineGatewayRequest) ProtoMessage() {}
+func (*EnricherUsageGatewayRequest) ProtoMessage() {}
 
-func (x *UpdatePipelineGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[29]
+func (x *EnricherUsageGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1411,49 +3217,48 @@ func (x *UpdatePipelineGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePipelineGatewayRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePipelineGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use EnricherUsageGatewayRequest.ProtoReflect.Descriptor instead.
+func (*EnricherUsageGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *UpdatePipelineGatewayRequest) GetId() string {
+func (x *EnricherUsageGatewayRequest) GetPipelineId() string {
 	if x != nil {
-		return x.Id
+		return x.PipelineId
 	}
 	return ""
 }
 
-func (x *UpdatePipelineGatewayRequest) GetPipeline() *pipeline.PipelineConfig {
+func (x *EnricherUsageGatewayRequest) GetMaxRuns() int32 {
 	if x != nil {
-		return x.Pipeline
+		return x.MaxRuns
 	}
-	return nil
+	return 0
 }
 
-type ListPipelineRunsGatewayRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // pipeline_id from path
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+type EnricherUsageGatewayResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Enrichers     []*pipeline.EnricherUsage `protobuf:"bytes,1,rep,name=enrichers,proto3" json:"enrichers,omitempty"`
+	RunsAnalyzed  int32                     `protobuf:"varint,2,opt,name=runs_analyzed,json=runsAnalyzed,proto3" json:"runs_analyzed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPipelineRunsGatewayRequest) Reset() {
-	*x = ListPipelineRunsGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[30]
+func (x *EnricherUsageGatewayResponse) Reset() {
+	*x = EnricherUsageGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPipelineRunsGatewayRequest) String() string {
+func (x *EnricherUsageGatewayResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPipelineRunsGatewayRequest) ProtoMessage() {}
+func (*EnricherUsageGatewayResponse) ProtoMessage() {}
 
-func (x *ListPipelineRunsGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[30]
+func (x *EnricherUsageGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,55 +3269,95 @@ func (x *ListPipelineRunsGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPipelineRunsGatewayRequest.ProtoReflect.Descriptor instead.
-func (*ListPipelineRunsGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use EnricherUsageGatewayResponse.ProtoReflect.Descriptor instead.
+func (*EnricherUsageGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ListPipelineRunsGatewayRequest) GetId() string {
+func (x *EnricherUsageGatewayResponse) GetEnrichers() []*pipeline.EnricherUsage {
 	if x != nil {
-		return x.Id
+		return x.Enrichers
 	}
-	return ""
+	return nil
 }
 
-func (x *ListPipelineRunsGatewayRequest) GetLimit() int32 {
+func (x *EnricherUsageGatewayResponse) GetRunsAnalyzed() int32 {
 	if x != nil {
-		return x.Limit
+		return x.RunsAnalyzed
 	}
 	return 0
 }
 
-func (x *ListPipelineRunsGatewayRequest) GetPageToken() string {
+type ListPendingInputsGatewayResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Inputs        []*pipeline.PendingInput `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingInputsGatewayResponse) Reset() {
+	*x = ListPendingInputsGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingInputsGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingInputsGatewayResponse) ProtoMessage() {}
+
+func (x *ListPendingInputsGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[65]
 	if x != nil {
-		return x.PageToken
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type ListPipelineRunsGatewayResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Runs          []*pipeline.PipelineRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
-	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Deprecated: Use ListPendingInputsGatewayResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingInputsGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ListPipelineRunsGatewayResponse) Reset() {
-	*x = ListPipelineRunsGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[31]
+func (x *ListPendingInputsGatewayResponse) GetInputs() []*pipeline.PendingInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+type SubmitInputGatewayRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	InputId   string                 `protobuf:"bytes,1,opt,name=input_id,json=inputId,proto3" json:"input_id,omitempty"`
+	InputData map[string]string      `protobuf:"bytes,2,rep,name=input_data,json=inputData,proto3" json:"input_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Lets the resumed update overwrite description text the user edited on the
+	// destination (see PreviewDescriptionMerge).
+	ConfirmDescriptionOverwrite bool `protobuf:"varint,3,opt,name=confirm_description_overwrite,json=confirmDescriptionOverwrite,proto3" json:"confirm_description_overwrite,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *SubmitInputGatewayRequest) Reset() {
+	*x = SubmitInputGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPipelineRunsGatewayResponse) String() string {
+func (x *SubmitInputGatewayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPipelineRunsGatewayResponse) ProtoMessage() {}
+func (*SubmitInputGatewayRequest) ProtoMessage() {}
 
-func (x *ListPipelineRunsGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[31]
+func (x *SubmitInputGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1523,48 +3368,55 @@ func (x *ListPipelineRunsGatewayResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPipelineRunsGatewayResponse.ProtoReflect.Descriptor instead.
-func (*ListPipelineRunsGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SubmitInputGatewayRequest.ProtoReflect.Descriptor instead.
+func (*SubmitInputGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ListPipelineRunsGatewayResponse) GetRuns() []*pipeline.PipelineRun {
+func (x *SubmitInputGatewayRequest) GetInputId() string {
 	if x != nil {
-		return x.Runs
+		return x.InputId
+	}
+	return ""
+}
+
+func (x *SubmitInputGatewayRequest) GetInputData() map[string]string {
+	if x != nil {
+		return x.InputData
 	}
 	return nil
 }
 
-func (x *ListPipelineRunsGatewayResponse) GetNextPageToken() string {
+func (x *SubmitInputGatewayRequest) GetConfirmDescriptionOverwrite() bool {
 	if x != nil {
-		return x.NextPageToken
+		return x.ConfirmDescriptionOverwrite
 	}
-	return ""
+	return false
 }
 
-type GetPipelineRunGatewayRequest struct {
+type CreatePendingInputUploadGatewayRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // pipeline_id from path
-	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	InputId       string                 `protobuf:"bytes,1,opt,name=input_id,json=inputId,proto3" json:"input_id,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPipelineRunGatewayRequest) Reset() {
-	*x = GetPipelineRunGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[32]
+func (x *CreatePendingInputUploadGatewayRequest) Reset() {
+	*x = CreatePendingInputUploadGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPipelineRunGatewayRequest) String() string {
+func (x *CreatePendingInputUploadGatewayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPipelineRunGatewayRequest) ProtoMessage() {}
+func (*CreatePendingInputUploadGatewayRequest) ProtoMessage() {}
 
-func (x *GetPipelineRunGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[32]
+func (x *CreatePendingInputUploadGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1575,48 +3427,50 @@ func (x *GetPipelineRunGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPipelineRunGatewayRequest.ProtoReflect.Descriptor instead.
-func (*GetPipelineRunGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use CreatePendingInputUploadGatewayRequest.ProtoReflect.Descriptor instead.
+func (*CreatePendingInputUploadGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetPipelineRunGatewayRequest) GetId() string {
+func (x *CreatePendingInputUploadGatewayRequest) GetInputId() string {
 	if x != nil {
-		return x.Id
+		return x.InputId
 	}
 	return ""
 }
 
-func (x *GetPipelineRunGatewayRequest) GetRunId() string {
+func (x *CreatePendingInputUploadGatewayRequest) GetContentType() string {
 	if x != nil {
-		return x.RunId
+		return x.ContentType
 	}
 	return ""
 }
 
-type SubmitInputGatewayRequest struct {
+type CreatePendingInputUploadGatewayResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InputId       string                 `protobuf:"bytes,1,opt,name=input_id,json=inputId,proto3" json:"input_id,omitempty"`
-	InputData     map[string]string      `protobuf:"bytes,2,rep,name=input_data,json=inputData,proto3" json:"input_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UploadUrl     string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"` // PUT the file here with the same Content-Type
+	ObjectRef     string                 `protobuf:"bytes,2,opt,name=object_ref,json=objectRef,proto3" json:"object_ref,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	MaxSizeBytes  int64                  `protobuf:"varint,4,opt,name=max_size_bytes,json=maxSizeBytes,proto3" json:"max_size_bytes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitInputGatewayRequest) Reset() {
-	*x = SubmitInputGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[33]
+func (x *CreatePendingInputUploadGatewayResponse) Reset() {
+	*x = CreatePendingInputUploadGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitInputGatewayRequest) String() string {
+func (x *CreatePendingInputUploadGatewayResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitInputGatewayRequest) ProtoMessage() {}
+func (*CreatePendingInputUploadGatewayResponse) ProtoMessage() {}
 
-func (x *SubmitInputGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[33]
+func (x *CreatePendingInputUploadGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1627,23 +3481,37 @@ func (x *SubmitInputGatewayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitInputGatewayRequest.ProtoReflect.Descriptor instead.
-func (*SubmitInputGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use CreatePendingInputUploadGatewayResponse.ProtoReflect.Descriptor instead.
+func (*CreatePendingInputUploadGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *SubmitInputGatewayRequest) GetInputId() string {
+func (x *CreatePendingInputUploadGatewayResponse) GetUploadUrl() string {
 	if x != nil {
-		return x.InputId
+		return x.UploadUrl
 	}
 	return ""
 }
 
-func (x *SubmitInputGatewayRequest) GetInputData() map[string]string {
+func (x *CreatePendingInputUploadGatewayResponse) GetObjectRef() string {
 	if x != nil {
-		return x.InputData
+		return x.ObjectRef
 	}
-	return nil
+	return ""
+}
+
+func (x *CreatePendingInputUploadGatewayResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CreatePendingInputUploadGatewayResponse) GetMaxSizeBytes() int64 {
+	if x != nil {
+		return x.MaxSizeBytes
+	}
+	return 0
 }
 
 type RepostActivityGatewayRequest struct {
@@ -1655,7 +3523,7 @@ type RepostActivityGatewayRequest struct {
 
 func (x *RepostActivityGatewayRequest) Reset() {
 	*x = RepostActivityGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[34]
+	mi := &file_gateway_client_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1667,7 +3535,7 @@ func (x *RepostActivityGatewayRequest) String() string {
 func (*RepostActivityGatewayRequest) ProtoMessage() {}
 
 func (x *RepostActivityGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[34]
+	mi := &file_gateway_client_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1680,7 +3548,7 @@ func (x *RepostActivityGatewayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepostActivityGatewayRequest.ProtoReflect.Descriptor instead.
 func (*RepostActivityGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{34}
+	return file_gateway_client_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *RepostActivityGatewayRequest) GetId() string {
@@ -1701,7 +3569,7 @@ type ListActivitiesGatewayRequest struct {
 
 func (x *ListActivitiesGatewayRequest) Reset() {
 	*x = ListActivitiesGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[35]
+	mi := &file_gateway_client_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1713,7 +3581,7 @@ func (x *ListActivitiesGatewayRequest) String() string {
 func (*ListActivitiesGatewayRequest) ProtoMessage() {}
 
 func (x *ListActivitiesGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[35]
+	mi := &file_gateway_client_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1726,7 +3594,7 @@ func (x *ListActivitiesGatewayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActivitiesGatewayRequest.ProtoReflect.Descriptor instead.
 func (*ListActivitiesGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{35}
+	return file_gateway_client_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *ListActivitiesGatewayRequest) GetLimit() int32 {
@@ -1753,7 +3621,7 @@ type ListActivitiesGatewayResponse struct {
 
 func (x *ListActivitiesGatewayResponse) Reset() {
 	*x = ListActivitiesGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[36]
+	mi := &file_gateway_client_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1765,7 +3633,7 @@ func (x *ListActivitiesGatewayResponse) String() string {
 func (*ListActivitiesGatewayResponse) ProtoMessage() {}
 
 func (x *ListActivitiesGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[36]
+	mi := &file_gateway_client_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1778,7 +3646,7 @@ func (x *ListActivitiesGatewayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActivitiesGatewayResponse.ProtoReflect.Descriptor instead.
 func (*ListActivitiesGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{36}
+	return file_gateway_client_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *ListActivitiesGatewayResponse) GetActivities() []*activity.StandardizedActivity {
@@ -1806,7 +3674,7 @@ type GetActivityStatsGatewayResponse struct {
 
 func (x *GetActivityStatsGatewayResponse) Reset() {
 	*x = GetActivityStatsGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[37]
+	mi := &file_gateway_client_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1818,7 +3686,7 @@ func (x *GetActivityStatsGatewayResponse) String() string {
 func (*GetActivityStatsGatewayResponse) ProtoMessage() {}
 
 func (x *GetActivityStatsGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[37]
+	mi := &file_gateway_client_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1831,7 +3699,7 @@ func (x *GetActivityStatsGatewayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActivityStatsGatewayResponse.ProtoReflect.Descriptor instead.
 func (*GetActivityStatsGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{37}
+	return file_gateway_client_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *GetActivityStatsGatewayResponse) GetTotalActivities() int32 {
@@ -1865,7 +3733,7 @@ type ListShowcasesGatewayResponse struct {
 
 func (x *ListShowcasesGatewayResponse) Reset() {
 	*x = ListShowcasesGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[38]
+	mi := &file_gateway_client_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1877,7 +3745,7 @@ func (x *ListShowcasesGatewayResponse) String() string {
 func (*ListShowcasesGatewayResponse) ProtoMessage() {}
 
 func (x *ListShowcasesGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[38]
+	mi := &file_gateway_client_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1890,7 +3758,7 @@ func (x *ListShowcasesGatewayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListShowcasesGatewayResponse.ProtoReflect.Descriptor instead.
 func (*ListShowcasesGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{38}
+	return file_gateway_client_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *ListShowcasesGatewayResponse) GetShowcases() []*activity.ShowcaseProfileEntry {
@@ -1909,7 +3777,7 @@ type CreateShowcaseGatewayRequest struct {
 
 func (x *CreateShowcaseGatewayRequest) Reset() {
 	*x = CreateShowcaseGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[39]
+	mi := &file_gateway_client_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1921,7 +3789,7 @@ func (x *CreateShowcaseGatewayRequest) String() string {
 func (*CreateShowcaseGatewayRequest) ProtoMessage() {}
 
 func (x *CreateShowcaseGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[39]
+	mi := &file_gateway_client_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1934,7 +3802,7 @@ func (x *CreateShowcaseGatewayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateShowcaseGatewayRequest.ProtoReflect.Descriptor instead.
 func (*CreateShowcaseGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{39}
+	return file_gateway_client_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *CreateShowcaseGatewayRequest) GetShowcase() *activity.ShowcasedActivity {
@@ -1954,7 +3822,7 @@ type UpdateShowcaseGatewayRequest struct {
 
 func (x *UpdateShowcaseGatewayRequest) Reset() {
 	*x = UpdateShowcaseGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[40]
+	mi := &file_gateway_client_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1966,7 +3834,7 @@ func (x *UpdateShowcaseGatewayRequest) String() string {
 func (*UpdateShowcaseGatewayRequest) ProtoMessage() {}
 
 func (x *UpdateShowcaseGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[40]
+	mi := &file_gateway_client_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1979,7 +3847,7 @@ func (x *UpdateShowcaseGatewayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateShowcaseGatewayRequest.ProtoReflect.Descriptor instead.
 func (*UpdateShowcaseGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{40}
+	return file_gateway_client_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *UpdateShowcaseGatewayRequest) GetId() string {
@@ -2006,7 +3874,7 @@ type UpdateShowcasePreferencesGatewayRequest struct {
 
 func (x *UpdateShowcasePreferencesGatewayRequest) Reset() {
 	*x = UpdateShowcasePreferencesGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[41]
+	mi := &file_gateway_client_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2018,7 +3886,7 @@ func (x *UpdateShowcasePreferencesGatewayRequest) String() string {
 func (*UpdateShowcasePreferencesGatewayRequest) ProtoMessage() {}
 
 func (x *UpdateShowcasePreferencesGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[41]
+	mi := &file_gateway_client_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2031,7 +3899,7 @@ func (x *UpdateShowcasePreferencesGatewayRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use UpdateShowcasePreferencesGatewayRequest.ProtoReflect.Descriptor instead.
 func (*UpdateShowcasePreferencesGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{41}
+	return file_gateway_client_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *UpdateShowcasePreferencesGatewayRequest) GetPreferences() *activity.ShowcaseProfile {
@@ -2051,7 +3919,7 @@ type GetShowcaseSettingsGatewayResponse struct {
 
 func (x *GetShowcaseSettingsGatewayResponse) Reset() {
 	*x = GetShowcaseSettingsGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[42]
+	mi := &file_gateway_client_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2063,7 +3931,7 @@ func (x *GetShowcaseSettingsGatewayResponse) String() string {
 func (*GetShowcaseSettingsGatewayResponse) ProtoMessage() {}
 
 func (x *GetShowcaseSettingsGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[42]
+	mi := &file_gateway_client_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2076,7 +3944,7 @@ func (x *GetShowcaseSettingsGatewayResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetShowcaseSettingsGatewayResponse.ProtoReflect.Descriptor instead.
 func (*GetShowcaseSettingsGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{42}
+	return file_gateway_client_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *GetShowcaseSettingsGatewayResponse) GetProfile() *activity.ShowcaseProfile {
@@ -2108,7 +3976,7 @@ type ShowcaseActivityEntryGateway struct {
 
 func (x *ShowcaseActivityEntryGateway) Reset() {
 	*x = ShowcaseActivityEntryGateway{}
-	mi := &file_gateway_client_proto_msgTypes[43]
+	mi := &file_gateway_client_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2120,7 +3988,7 @@ func (x *ShowcaseActivityEntryGateway) String() string {
 func (*ShowcaseActivityEntryGateway) ProtoMessage() {}
 
 func (x *ShowcaseActivityEntryGateway) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[43]
+	mi := &file_gateway_client_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2133,7 +4001,7 @@ func (x *ShowcaseActivityEntryGateway) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShowcaseActivityEntryGateway.ProtoReflect.Descriptor instead.
 func (*ShowcaseActivityEntryGateway) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{43}
+	return file_gateway_client_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *ShowcaseActivityEntryGateway) GetShowcaseId() string {
@@ -2194,7 +4062,7 @@ type UpdateShowcaseSettingsGatewayRequest struct {
 
 func (x *UpdateShowcaseSettingsGatewayRequest) Reset() {
 	*x = UpdateShowcaseSettingsGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[44]
+	mi := &file_gateway_client_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2206,7 +4074,7 @@ func (x *UpdateShowcaseSettingsGatewayRequest) String() string {
 func (*UpdateShowcaseSettingsGatewayRequest) ProtoMessage() {}
 
 func (x *UpdateShowcaseSettingsGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[44]
+	mi := &file_gateway_client_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2219,7 +4087,7 @@ func (x *UpdateShowcaseSettingsGatewayRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use UpdateShowcaseSettingsGatewayRequest.ProtoReflect.Descriptor instead.
 func (*UpdateShowcaseSettingsGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{44}
+	return file_gateway_client_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *UpdateShowcaseSettingsGatewayRequest) GetSettings() *activity.ShowcaseProfile {
@@ -2238,7 +4106,7 @@ type UpdateShowcaseSlugGatewayRequest struct {
 
 func (x *UpdateShowcaseSlugGatewayRequest) Reset() {
 	*x = UpdateShowcaseSlugGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[45]
+	mi := &file_gateway_client_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2250,7 +4118,7 @@ func (x *UpdateShowcaseSlugGatewayRequest) String() string {
 func (*UpdateShowcaseSlugGatewayRequest) ProtoMessage() {}
 
 func (x *UpdateShowcaseSlugGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[45]
+	mi := &file_gateway_client_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2263,7 +4131,7 @@ func (x *UpdateShowcaseSlugGatewayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateShowcaseSlugGatewayRequest.ProtoReflect.Descriptor instead.
 func (*UpdateShowcaseSlugGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{45}
+	return file_gateway_client_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *UpdateShowcaseSlugGatewayRequest) GetSlug() string {
@@ -2282,7 +4150,7 @@ type UpdateShowcaseSlugGatewayResponse struct {
 
 func (x *UpdateShowcaseSlugGatewayResponse) Reset() {
 	*x = UpdateShowcaseSlugGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[46]
+	mi := &file_gateway_client_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2294,7 +4162,7 @@ func (x *UpdateShowcaseSlugGatewayResponse) String() string {
 func (*UpdateShowcaseSlugGatewayResponse) ProtoMessage() {}
 
 func (x *UpdateShowcaseSlugGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[46]
+	mi := &file_gateway_client_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2307,7 +4175,7 @@ func (x *UpdateShowcaseSlugGatewayResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateShowcaseSlugGatewayResponse.ProtoReflect.Descriptor instead.
 func (*UpdateShowcaseSlugGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{46}
+	return file_gateway_client_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *UpdateShowcaseSlugGatewayResponse) GetSlug() string {
@@ -2326,7 +4194,7 @@ type GetPictureUploadUrlGatewayRequest struct {
 
 func (x *GetPictureUploadUrlGatewayRequest) Reset() {
 	*x = GetPictureUploadUrlGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[47]
+	mi := &file_gateway_client_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2338,7 +4206,7 @@ func (x *GetPictureUploadUrlGatewayRequest) String() string {
 func (*GetPictureUploadUrlGatewayRequest) ProtoMessage() {}
 
 func (x *GetPictureUploadUrlGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[47]
+	mi := &file_gateway_client_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2351,7 +4219,7 @@ func (x *GetPictureUploadUrlGatewayRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetPictureUploadUrlGatewayRequest.ProtoReflect.Descriptor instead.
 func (*GetPictureUploadUrlGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{47}
+	return file_gateway_client_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *GetPictureUploadUrlGatewayRequest) GetContentType() string {
@@ -2373,7 +4241,7 @@ type GetPictureUploadUrlGatewayResponse struct {
 
 func (x *GetPictureUploadUrlGatewayResponse) Reset() {
 	*x = GetPictureUploadUrlGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[48]
+	mi := &file_gateway_client_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2385,7 +4253,7 @@ func (x *GetPictureUploadUrlGatewayResponse) String() string {
 func (*GetPictureUploadUrlGatewayResponse) ProtoMessage() {}
 
 func (x *GetPictureUploadUrlGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[48]
+	mi := &file_gateway_client_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2398,7 +4266,7 @@ func (x *GetPictureUploadUrlGatewayResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetPictureUploadUrlGatewayResponse.ProtoReflect.Descriptor instead.
 func (*GetPictureUploadUrlGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{48}
+	return file_gateway_client_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *GetPictureUploadUrlGatewayResponse) GetUploadUrl() string {
@@ -2429,6 +4297,110 @@ func (x *GetPictureUploadUrlGatewayResponse) GetMaxSizeBytes() int64 {
 	return 0
 }
 
+type CreateShowcaseEmbedLinkGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShowcaseId    string                 `protobuf:"bytes,1,opt,name=showcase_id,json=showcaseId,proto3" json:"showcase_id,omitempty"` // Pins the link to one entry; the latest when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShowcaseEmbedLinkGatewayRequest) Reset() {
+	*x = CreateShowcaseEmbedLinkGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShowcaseEmbedLinkGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShowcaseEmbedLinkGatewayRequest) ProtoMessage() {}
+
+func (x *CreateShowcaseEmbedLinkGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShowcaseEmbedLinkGatewayRequest.ProtoReflect.Descriptor instead.
+func (*CreateShowcaseEmbedLinkGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CreateShowcaseEmbedLinkGatewayRequest) GetShowcaseId() string {
+	if x != nil {
+		return x.ShowcaseId
+	}
+	return ""
+}
+
+type CreateShowcaseEmbedLinkGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SvgUrl        string                 `protobuf:"bytes,1,opt,name=svg_url,json=svgUrl,proto3" json:"svg_url,omitempty"`    // For <img> tags
+	HtmlUrl       string                 `protobuf:"bytes,2,opt,name=html_url,json=htmlUrl,proto3" json:"html_url,omitempty"` // For iframes; the card links to the showcase page
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShowcaseEmbedLinkGatewayResponse) Reset() {
+	*x = CreateShowcaseEmbedLinkGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShowcaseEmbedLinkGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShowcaseEmbedLinkGatewayResponse) ProtoMessage() {}
+
+func (x *CreateShowcaseEmbedLinkGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShowcaseEmbedLinkGatewayResponse.ProtoReflect.Descriptor instead.
+func (*CreateShowcaseEmbedLinkGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreateShowcaseEmbedLinkGatewayResponse) GetSvgUrl() string {
+	if x != nil {
+		return x.SvgUrl
+	}
+	return ""
+}
+
+func (x *CreateShowcaseEmbedLinkGatewayResponse) GetHtmlUrl() string {
+	if x != nil {
+		return x.HtmlUrl
+	}
+	return ""
+}
+
+func (x *CreateShowcaseEmbedLinkGatewayResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 // Data Export
 type ExportDataGatewayResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2439,7 +4411,7 @@ type ExportDataGatewayResponse struct {
 
 func (x *ExportDataGatewayResponse) Reset() {
 	*x = ExportDataGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[49]
+	mi := &file_gateway_client_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2451,7 +4423,7 @@ func (x *ExportDataGatewayResponse) String() string {
 func (*ExportDataGatewayResponse) ProtoMessage() {}
 
 func (x *ExportDataGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[49]
+	mi := &file_gateway_client_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2464,7 +4436,7 @@ func (x *ExportDataGatewayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportDataGatewayResponse.ProtoReflect.Descriptor instead.
 func (*ExportDataGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{49}
+	return file_gateway_client_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *ExportDataGatewayResponse) GetDownloadUrl() string {
@@ -2474,6 +4446,154 @@ func (x *ExportDataGatewayResponse) GetDownloadUrl() string {
 	return ""
 }
 
+type ExportArchiveGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`                                 // "zip" (default) or "github_pages"
+	GithubRepo    string                 `protobuf:"bytes,2,opt,name=github_repo,json=githubRepo,proto3" json:"github_repo,omitempty"`       // "owner/repo", required for github_pages
+	GithubBranch  string                 `protobuf:"bytes,3,opt,name=github_branch,json=githubBranch,proto3" json:"github_branch,omitempty"` // Defaults to gh-pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportArchiveGatewayRequest) Reset() {
+	*x = ExportArchiveGatewayRequest{}
+	mi := &file_gateway_client_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportArchiveGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportArchiveGatewayRequest) ProtoMessage() {}
+
+func (x *ExportArchiveGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportArchiveGatewayRequest.ProtoReflect.Descriptor instead.
+func (*ExportArchiveGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ExportArchiveGatewayRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ExportArchiveGatewayRequest) GetGithubRepo() string {
+	if x != nil {
+		return x.GithubRepo
+	}
+	return ""
+}
+
+func (x *ExportArchiveGatewayRequest) GetGithubBranch() string {
+	if x != nil {
+		return x.GithubBranch
+	}
+	return ""
+}
+
+type ExportArchiveGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "queued"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportArchiveGatewayResponse) Reset() {
+	*x = ExportArchiveGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportArchiveGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportArchiveGatewayResponse) ProtoMessage() {}
+
+func (x *ExportArchiveGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportArchiveGatewayResponse.ProtoReflect.Descriptor instead.
+func (*ExportArchiveGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ExportArchiveGatewayResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ExportUserDataGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "queued"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataGatewayResponse) Reset() {
+	*x = ExportUserDataGatewayResponse{}
+	mi := &file_gateway_client_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataGatewayResponse) ProtoMessage() {}
+
+func (x *ExportUserDataGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_client_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataGatewayResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_client_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ExportUserDataGatewayResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 // FIT File Parse
 type ParseFitFileGatewayRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -2487,7 +4607,7 @@ type ParseFitFileGatewayRequest struct {
 
 func (x *ParseFitFileGatewayRequest) Reset() {
 	*x = ParseFitFileGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[50]
+	mi := &file_gateway_client_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2499,7 +4619,7 @@ func (x *ParseFitFileGatewayRequest) String() string {
 func (*ParseFitFileGatewayRequest) ProtoMessage() {}
 
 func (x *ParseFitFileGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[50]
+	mi := &file_gateway_client_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2512,7 +4632,7 @@ func (x *ParseFitFileGatewayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseFitFileGatewayRequest.ProtoReflect.Descriptor instead.
 func (*ParseFitFileGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{50}
+	return file_gateway_client_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *ParseFitFileGatewayRequest) GetFitFileContent() []byte {
@@ -2545,16 +4665,18 @@ func (x *ParseFitFileGatewayRequest) GetPipelineId() string {
 
 // Repost Variants
 type RepostVariantGatewayRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActivityId    string                 `protobuf:"bytes,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
-	Destination   string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId  string                 `protobuf:"bytes,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Destination string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// full-pipeline only: replay with these enrichers/destinations instead
+	ReplayOverride *events.ReplayOverride `protobuf:"bytes,3,opt,name=replay_override,json=replayOverride,proto3" json:"replay_override,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *RepostVariantGatewayRequest) Reset() {
 	*x = RepostVariantGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[51]
+	mi := &file_gateway_client_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2566,7 +4688,7 @@ func (x *RepostVariantGatewayRequest) String() string {
 func (*RepostVariantGatewayRequest) ProtoMessage() {}
 
 func (x *RepostVariantGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[51]
+	mi := &file_gateway_client_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2579,7 +4701,7 @@ func (x *RepostVariantGatewayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepostVariantGatewayRequest.ProtoReflect.Descriptor instead.
 func (*RepostVariantGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{51}
+	return file_gateway_client_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *RepostVariantGatewayRequest) GetActivityId() string {
@@ -2596,6 +4718,13 @@ func (x *RepostVariantGatewayRequest) GetDestination() string {
 	return ""
 }
 
+func (x *RepostVariantGatewayRequest) GetReplayOverride() *events.ReplayOverride {
+	if x != nil {
+		return x.ReplayOverride
+	}
+	return nil
+}
+
 type RepostGatewayResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -2606,7 +4735,7 @@ type RepostGatewayResponse struct {
 
 func (x *RepostGatewayResponse) Reset() {
 	*x = RepostGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[52]
+	mi := &file_gateway_client_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2618,7 +4747,7 @@ func (x *RepostGatewayResponse) String() string {
 func (*RepostGatewayResponse) ProtoMessage() {}
 
 func (x *RepostGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[52]
+	mi := &file_gateway_client_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2631,7 +4760,7 @@ func (x *RepostGatewayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepostGatewayResponse.ProtoReflect.Descriptor instead.
 func (*RepostGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{52}
+	return file_gateway_client_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *RepostGatewayResponse) GetSuccess() bool {
@@ -2659,7 +4788,7 @@ type CreateCheckoutGatewayRequest struct {
 
 func (x *CreateCheckoutGatewayRequest) Reset() {
 	*x = CreateCheckoutGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[53]
+	mi := &file_gateway_client_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2671,7 +4800,7 @@ func (x *CreateCheckoutGatewayRequest) String() string {
 func (*CreateCheckoutGatewayRequest) ProtoMessage() {}
 
 func (x *CreateCheckoutGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[53]
+	mi := &file_gateway_client_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2684,7 +4813,7 @@ func (x *CreateCheckoutGatewayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCheckoutGatewayRequest.ProtoReflect.Descriptor instead.
 func (*CreateCheckoutGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{53}
+	return file_gateway_client_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *CreateCheckoutGatewayRequest) GetSuccessUrl() string {
@@ -2710,7 +4839,7 @@ type CreateCheckoutGatewayResponse struct {
 
 func (x *CreateCheckoutGatewayResponse) Reset() {
 	*x = CreateCheckoutGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[54]
+	mi := &file_gateway_client_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2722,7 +4851,7 @@ func (x *CreateCheckoutGatewayResponse) String() string {
 func (*CreateCheckoutGatewayResponse) ProtoMessage() {}
 
 func (x *CreateCheckoutGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[54]
+	mi := &file_gateway_client_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2735,7 +4864,7 @@ func (x *CreateCheckoutGatewayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCheckoutGatewayResponse.ProtoReflect.Descriptor instead.
 func (*CreateCheckoutGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{54}
+	return file_gateway_client_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *CreateCheckoutGatewayResponse) GetSessionUrl() string {
@@ -2755,7 +4884,7 @@ type GetTierStatusGatewayResponse struct {
 
 func (x *GetTierStatusGatewayResponse) Reset() {
 	*x = GetTierStatusGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[55]
+	mi := &file_gateway_client_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2767,7 +4896,7 @@ func (x *GetTierStatusGatewayResponse) String() string {
 func (*GetTierStatusGatewayResponse) ProtoMessage() {}
 
 func (x *GetTierStatusGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[55]
+	mi := &file_gateway_client_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2780,7 +4909,7 @@ func (x *GetTierStatusGatewayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTierStatusGatewayResponse.ProtoReflect.Descriptor instead.
 func (*GetTierStatusGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{55}
+	return file_gateway_client_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *GetTierStatusGatewayResponse) GetEffectiveTier() user.UserTier {
@@ -2806,7 +4935,7 @@ type CreateBillingPortalGatewayRequest struct {
 
 func (x *CreateBillingPortalGatewayRequest) Reset() {
 	*x = CreateBillingPortalGatewayRequest{}
-	mi := &file_gateway_client_proto_msgTypes[56]
+	mi := &file_gateway_client_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2818,7 +4947,7 @@ func (x *CreateBillingPortalGatewayRequest) String() string {
 func (*CreateBillingPortalGatewayRequest) ProtoMessage() {}
 
 func (x *CreateBillingPortalGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[56]
+	mi := &file_gateway_client_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2831,7 +4960,7 @@ func (x *CreateBillingPortalGatewayRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateBillingPortalGatewayRequest.ProtoReflect.Descriptor instead.
 func (*CreateBillingPortalGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{56}
+	return file_gateway_client_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *CreateBillingPortalGatewayRequest) GetReturnUrl() string {
@@ -2850,7 +4979,7 @@ type CreateBillingPortalGatewayResponse struct {
 
 func (x *CreateBillingPortalGatewayResponse) Reset() {
 	*x = CreateBillingPortalGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[57]
+	mi := &file_gateway_client_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2862,7 +4991,7 @@ func (x *CreateBillingPortalGatewayResponse) String() string {
 func (*CreateBillingPortalGatewayResponse) ProtoMessage() {}
 
 func (x *CreateBillingPortalGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[57]
+	mi := &file_gateway_client_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2875,7 +5004,7 @@ func (x *CreateBillingPortalGatewayResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateBillingPortalGatewayResponse.ProtoReflect.Descriptor instead.
 func (*CreateBillingPortalGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{57}
+	return file_gateway_client_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *CreateBillingPortalGatewayResponse) GetUrl() string {
@@ -2896,7 +5025,7 @@ type GetPluginIconGatewayResponse struct {
 
 func (x *GetPluginIconGatewayResponse) Reset() {
 	*x = GetPluginIconGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[58]
+	mi := &file_gateway_client_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2908,7 +5037,7 @@ func (x *GetPluginIconGatewayResponse) String() string {
 func (*GetPluginIconGatewayResponse) ProtoMessage() {}
 
 func (x *GetPluginIconGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[58]
+	mi := &file_gateway_client_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2921,7 +5050,7 @@ func (x *GetPluginIconGatewayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPluginIconGatewayResponse.ProtoReflect.Descriptor instead.
 func (*GetPluginIconGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{58}
+	return file_gateway_client_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *GetPluginIconGatewayResponse) GetIconData() []byte {
@@ -2947,7 +5076,7 @@ type ListCategoriesGatewayResponse struct {
 
 func (x *ListCategoriesGatewayResponse) Reset() {
 	*x = ListCategoriesGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[59]
+	mi := &file_gateway_client_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2959,7 +5088,7 @@ func (x *ListCategoriesGatewayResponse) String() string {
 func (*ListCategoriesGatewayResponse) ProtoMessage() {}
 
 func (x *ListCategoriesGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[59]
+	mi := &file_gateway_client_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2972,7 +5101,7 @@ func (x *ListCategoriesGatewayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCategoriesGatewayResponse.ProtoReflect.Descriptor instead.
 func (*ListCategoriesGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{59}
+	return file_gateway_client_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *ListCategoriesGatewayResponse) GetCategories() []string {
@@ -2991,7 +5120,7 @@ type ListSourcesGatewayResponse struct {
 
 func (x *ListSourcesGatewayResponse) Reset() {
 	*x = ListSourcesGatewayResponse{}
-	mi := &file_gateway_client_proto_msgTypes[60]
+	mi := &file_gateway_client_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3003,7 +5132,7 @@ func (x *ListSourcesGatewayResponse) String() string {
 func (*ListSourcesGatewayResponse) ProtoMessage() {}
 
 func (x *ListSourcesGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_client_proto_msgTypes[60]
+	mi := &file_gateway_client_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3016,7 +5145,7 @@ func (x *ListSourcesGatewayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSourcesGatewayResponse.ProtoReflect.Descriptor instead.
 func (*ListSourcesGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_client_proto_rawDescGZIP(), []int{60}
+	return file_gateway_client_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *ListSourcesGatewayResponse) GetSources() []*plugin.PluginManifest {
@@ -3030,7 +5159,7 @@ var File_gateway_client_proto protoreflect.FileDescriptor
 
 const file_gateway_client_proto_rawDesc = "" +
 	"\n" +
-	"\x14gateway/client.proto\x12\x0ffitglue.gateway\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x19models/user/profile.proto\x1a\x1dmodels/user/integration.proto\x1a\x19models/user/billing.proto\x1a\x1cmodels/plugin/manifest.proto\x1a\x1cmodels/pipeline/config.proto\x1a\x1fmodels/pipeline/execution.proto\x1a\"models/activity/standardized.proto\x1a\x1emodels/activity/uploaded.proto\"\x0e\n" +
+	"\x14gateway/client.proto\x12\x0ffitglue.gateway\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x19models/user/profile.proto\x1a\x1dmodels/user/integration.proto\x1a\x19models/user/billing.proto\x1a\x1cmodels/plugin/manifest.proto\x1a\x1cmodels/pipeline/config.proto\x1a\x1fmodels/pipeline/execution.proto\x1a\x1emodels/pipeline/backfill.proto\x1a\x1cmodels/pipeline/import.proto\x1a\"models/pipeline/debug_bundle.proto\x1a$models/pipeline/recommendation.proto\x1a\x1cmodels/pipeline/outage.proto\x1a#models/pipeline/pending_input.proto\x1a\x1dmodels/pipeline/preview.proto\x1a#models/pipeline/type_learning.proto\x1a\x1cmodels/activity/source.proto\x1a\"models/activity/standardized.proto\x1a\x1emodels/activity/uploaded.proto\x1a\x1cmodels/events/pipeline.proto\"\x0e\n" +
 	"\fEmptyRequest\"-\n" +
 	"\x0fProviderRequest\x12\x1a\n" +
 	"\bprovider\x18\x01 \x01(\tR\bprovider\"#\n" +
@@ -3051,7 +5180,11 @@ const file_gateway_client_proto_rawDesc = "" +
 	"\vrecord_type\x18\x01 \x01(\tR\n" +
 	"recordType\"(\n" +
 	"\x12CounterNameRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"7\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"(\n" +
+	"\rGearIdRequest\x12\x17\n" +
+	"\agear_id\x18\x01 \x01(\tR\x06gearId\"(\n" +
+	"\rGoalIdRequest\x12\x17\n" +
+	"\agoal_id\x18\x01 \x01(\tR\x06goalId\"7\n" +
 	"\x14ShowcaseEntryRequest\x12\x1f\n" +
 	"\vshowcase_id\x18\x01 \x01(\tR\n" +
 	"showcaseId\"Y\n" +
@@ -3089,7 +5222,26 @@ const file_gateway_client_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x12\n" +
 	"\x04unit\x18\x03 \x01(\tR\x04unit\x12\x1f\n" +
 	"\vactivity_id\x18\x04 \x01(\tR\n" +
-	"activityId\"\xd7\x01\n" +
+	"activityId\"H\n" +
+	"\x17ListGearGatewayResponse\x12-\n" +
+	"\x04gear\x18\x01 \x03(\v2\x19.fitglue.models.user.GearR\x04gear\"\xc9\x01\n" +
+	"\x15SetGearGatewayRequest\x12\x17\n" +
+	"\agear_id\x18\x01 \x01(\tR\x06gearId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x121\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1d.fitglue.models.user.GearTypeR\x04type\x126\n" +
+	"\x17initial_distance_meters\x18\x04 \x01(\x01R\x15initialDistanceMeters\x12\x18\n" +
+	"\aretired\x18\x05 \x01(\bR\aretired\"K\n" +
+	"\x18ListGoalsGatewayResponse\x12/\n" +
+	"\x05goals\x18\x01 \x03(\v2\x19.fitglue.models.user.GoalR\x05goals\"\xd3\x02\n" +
+	"\x15SetGoalGatewayRequest\x12\x17\n" +
+	"\agoal_id\x18\x01 \x01(\tR\x06goalId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x127\n" +
+	"\x06metric\x18\x03 \x01(\x0e2\x1f.fitglue.models.user.GoalMetricR\x06metric\x12\x16\n" +
+	"\x06target\x18\x04 \x01(\x01R\x06target\x12J\n" +
+	"\ractivity_type\x18\x05 \x01(\x0e2%.fitglue.models.activity.ActivityTypeR\factivityType\x129\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\xd7\x01\n" +
 	"!ListPluginDefaultsGatewayResponse\x12\\\n" +
 	"\bdefaults\x18\x01 \x03(\v2@.fitglue.gateway.ListPluginDefaultsGatewayResponse.DefaultsEntryR\bdefaults\x1aT\n" +
 	"\rDefaultsEntry\x12\x10\n" +
@@ -3104,14 +5256,50 @@ const file_gateway_client_proto_rawDesc = "" +
 	"\x05email\x18\x01 \x01(\tR\x05email\"M\n" +
 	"\x19SetFCMTokenGatewayRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
-	"\bplatform\x18\x02 \x01(\tR\bplatform\"e\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\"\xa7\x01\n" +
+	"\x06ApiKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"I\n" +
+	"\x1aListApiKeysGatewayResponse\x12+\n" +
+	"\x04keys\x18\x01 \x03(\v2\x17.fitglue.gateway.ApiKeyR\x04keys\"2\n" +
+	"\x1aCreateApiKeyGatewayRequest\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\"a\n" +
+	"\x1bCreateApiKeyGatewayResponse\x12\x17\n" +
+	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\x12)\n" +
+	"\x03key\x18\x02 \x01(\v2\x17.fitglue.gateway.ApiKeyR\x03key\"!\n" +
+	"\x0fApiKeyIdRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"e\n" +
 	"\x1cListPipelinesGatewayResponse\x12E\n" +
 	"\tpipelines\x18\x01 \x03(\v2'.fitglue.models.pipeline.PipelineConfigR\tpipelines\"c\n" +
 	"\x1cCreatePipelineGatewayRequest\x12C\n" +
 	"\bpipeline\x18\x01 \x01(\v2'.fitglue.models.pipeline.PipelineConfigR\bpipeline\"s\n" +
 	"\x1cUpdatePipelineGatewayRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12C\n" +
-	"\bpipeline\x18\x02 \x01(\v2'.fitglue.models.pipeline.PipelineConfigR\bpipeline\"e\n" +
+	"\bpipeline\x18\x02 \x01(\v2'.fitglue.models.pipeline.PipelineConfigR\bpipeline\"A\n" +
+	"\x1bStartBackfillGatewayRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"E\n" +
+	"\x1cGetBackfillJobGatewayRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\"K\n" +
+	"\x1aCreateImportGatewayRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"file_names\x18\x02 \x03(\tR\tfileNames\"\x8d\x01\n" +
+	"\x1eUploadImportFileGatewayRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\timport_id\x18\x02 \x01(\tR\bimportId\x12\x14\n" +
+	"\x05index\x18\x03 \x01(\x05R\x05index\x12(\n" +
+	"\x10fit_file_content\x18\x04 \x01(\fR\x0efitFileContent\"C\n" +
+	"\x14ImportGatewayRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\timport_id\x18\x02 \x01(\tR\bimportId\"b\n" +
+	"\x1dPlatformStatusGatewayResponse\x12A\n" +
+	"\aoutages\x18\x01 \x03(\v2'.fitglue.models.pipeline.PlatformHealthR\aoutages\"e\n" +
 	"\x1eListPipelineRunsGatewayRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
@@ -3122,14 +5310,73 @@ const file_gateway_client_proto_rawDesc = "" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"E\n" +
 	"\x1cGetPipelineRunGatewayRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
-	"\x06run_id\x18\x02 \x01(\tR\x05runId\"\xce\x01\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\"e\n" +
+	"\x1eRetryPipelineRunGatewayRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x1c\n" +
+	"\tenrichers\x18\x03 \x03(\tR\tenrichers\"~\n" +
+	"\x1cPausePipelinesGatewayRequest\x12\x1f\n" +
+	"\vpipeline_id\x18\x01 \x01(\tR\n" +
+	"pipelineId\x12=\n" +
+	"\fpaused_until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vpausedUntil\"Z\n" +
+	"\x1dResumePipelinesGatewayRequest\x12\x1f\n" +
+	"\vpipeline_id\x18\x01 \x01(\tR\n" +
+	"pipelineId\x12\x18\n" +
+	"\adiscard\x18\x02 \x01(\bR\adiscard\"Z\n" +
+	"\x1eResumePipelinesGatewayResponse\x12\x1a\n" +
+	"\breleased\x18\x01 \x01(\x05R\breleased\x12\x1c\n" +
+	"\tdiscarded\x18\x02 \x01(\x05R\tdiscarded\"\x7f\n" +
+	"!CorrectActivityTypeGatewayRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12J\n" +
+	"\ractivity_type\x18\x02 \x01(\x0e2%.fitglue.models.activity.ActivityTypeR\factivityType\"c\n" +
+	"\"CorrectActivityTypeGatewayResponse\x12=\n" +
+	"\x04rule\x18\x01 \x01(\v2).fitglue.models.pipeline.ActivityTypeRuleR\x04rule\"\x90\x01\n" +
+	"$ListActivityTypeRulesGatewayResponse\x12?\n" +
+	"\x05rules\x18\x01 \x03(\v2).fitglue.models.pipeline.ActivityTypeRuleR\x05rules\x12'\n" +
+	"\x0flearn_threshold\x18\x02 \x01(\x05R\x0elearnThreshold\"R\n" +
+	"$UpdateActivityTypeRuleGatewayRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bdisabled\x18\x02 \x01(\bR\bdisabled\"+\n" +
+	"\x19ActivityTypeRuleIdRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"D\n" +
+	"\x1ePipelineCalendarGatewayRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"c\n" +
+	"\x1fPipelineCalendarGatewayResponse\x12@\n" +
+	"\x04days\x18\x01 \x03(\v2,.fitglue.models.pipeline.PipelineCalendarDayR\x04days\"z\n" +
+	"\x1dPreviewPipelineGatewayRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12I\n" +
+	"\bactivity\x18\x02 \x01(\v2-.fitglue.models.activity.StandardizedActivityR\bactivity\"T\n" +
+	" DescriptionPreviewGatewayRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vdestination\x18\x02 \x01(\tR\vdestination\"Y\n" +
+	"\x1bEnricherUsageGatewayRequest\x12\x1f\n" +
+	"\vpipeline_id\x18\x01 \x01(\tR\n" +
+	"pipelineId\x12\x19\n" +
+	"\bmax_runs\x18\x02 \x01(\x05R\amaxRuns\"\x89\x01\n" +
+	"\x1cEnricherUsageGatewayResponse\x12D\n" +
+	"\tenrichers\x18\x01 \x03(\v2&.fitglue.models.pipeline.EnricherUsageR\tenrichers\x12#\n" +
+	"\rruns_analyzed\x18\x02 \x01(\x05R\frunsAnalyzed\"a\n" +
+	" ListPendingInputsGatewayResponse\x12=\n" +
+	"\x06inputs\x18\x01 \x03(\v2%.fitglue.models.pipeline.PendingInputR\x06inputs\"\x92\x02\n" +
 	"\x19SubmitInputGatewayRequest\x12\x19\n" +
 	"\binput_id\x18\x01 \x01(\tR\ainputId\x12X\n" +
 	"\n" +
-	"input_data\x18\x02 \x03(\v29.fitglue.gateway.SubmitInputGatewayRequest.InputDataEntryR\tinputData\x1a<\n" +
+	"input_data\x18\x02 \x03(\v29.fitglue.gateway.SubmitInputGatewayRequest.InputDataEntryR\tinputData\x12B\n" +
+	"\x1dconfirm_description_overwrite\x18\x03 \x01(\bR\x1bconfirmDescriptionOverwrite\x1a<\n" +
 	"\x0eInputDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\".\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"f\n" +
+	"&CreatePendingInputUploadGatewayRequest\x12\x19\n" +
+	"\binput_id\x18\x01 \x01(\tR\ainputId\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"\xb0\x01\n" +
+	"'CreatePendingInputUploadGatewayResponse\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12\x1d\n" +
+	"\n" +
+	"object_ref\x18\x02 \x01(\tR\tobjectRef\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12$\n" +
+	"\x0emax_size_bytes\x18\x04 \x01(\x03R\fmaxSizeBytes\".\n" +
 	"\x1cRepostActivityGatewayRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"S\n" +
 	"\x1cListActivitiesGatewayRequest\x12\x14\n" +
@@ -3185,19 +5432,37 @@ const file_gateway_client_proto_rawDesc = "" +
 	"\n" +
 	"public_url\x18\x02 \x01(\tR\tpublicUrl\x12!\n" +
 	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12$\n" +
-	"\x0emax_size_bytes\x18\x04 \x01(\x03R\fmaxSizeBytes\">\n" +
+	"\x0emax_size_bytes\x18\x04 \x01(\x03R\fmaxSizeBytes\"H\n" +
+	"%CreateShowcaseEmbedLinkGatewayRequest\x12\x1f\n" +
+	"\vshowcase_id\x18\x01 \x01(\tR\n" +
+	"showcaseId\"\x97\x01\n" +
+	"&CreateShowcaseEmbedLinkGatewayResponse\x12\x17\n" +
+	"\asvg_url\x18\x01 \x01(\tR\x06svgUrl\x12\x19\n" +
+	"\bhtml_url\x18\x02 \x01(\tR\ahtmlUrl\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\">\n" +
 	"\x19ExportDataGatewayResponse\x12!\n" +
-	"\fdownload_url\x18\x01 \x01(\tR\vdownloadUrl\"\x9f\x01\n" +
+	"\fdownload_url\x18\x01 \x01(\tR\vdownloadUrl\"{\n" +
+	"\x1bExportArchiveGatewayRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x1f\n" +
+	"\vgithub_repo\x18\x02 \x01(\tR\n" +
+	"githubRepo\x12#\n" +
+	"\rgithub_branch\x18\x03 \x01(\tR\fgithubBranch\"6\n" +
+	"\x1cExportArchiveGatewayResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"7\n" +
+	"\x1dExportUserDataGatewayResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\x9f\x01\n" +
 	"\x1aParseFitFileGatewayRequest\x12(\n" +
 	"\x10fit_file_content\x18\x01 \x01(\fR\x0efitFileContent\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
 	"\vpipeline_id\x18\x04 \x01(\tR\n" +
-	"pipelineId\"`\n" +
+	"pipelineId\"\xb0\x01\n" +
 	"\x1bRepostVariantGatewayRequest\x12\x1f\n" +
 	"\vactivity_id\x18\x01 \x01(\tR\n" +
 	"activityId\x12 \n" +
-	"\vdestination\x18\x02 \x01(\tR\vdestination\"K\n" +
+	"\vdestination\x18\x02 \x01(\tR\vdestination\x12N\n" +
+	"\x0freplay_override\x18\x03 \x01(\v2%.fitglue.models.events.ReplayOverrideR\x0ereplayOverride\"K\n" +
 	"\x15RepostGatewayResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"^\n" +
@@ -3225,7 +5490,7 @@ const file_gateway_client_proto_rawDesc = "" +
 	"categories\x18\x01 \x03(\tR\n" +
 	"categories\"]\n" +
 	"\x1aListSourcesGatewayResponse\x12?\n" +
-	"\asources\x18\x01 \x03(\v2%.fitglue.models.plugin.PluginManifestR\asources2\xf9L\n" +
+	"\asources\x18\x01 \x03(\v2%.fitglue.models.plugin.PluginManifestR\asources2\xd2s\n" +
 	"\x14ClientGatewayService\x12`\n" +
 	"\n" +
 	"GetProfile\x12\x1d.fitglue.gateway.EmptyRequest\x1a .fitglue.models.user.UserProfile\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/users/me\x12u\n" +
@@ -3248,14 +5513,25 @@ const file_gateway_client_proto_rawDesc = "" +
 	"\x11DeleteBoosterData\x12!.fitglue.gateway.BoosterIdRequest\x1a\x16.google.protobuf.Empty\"+\x82\xd3\xe4\x93\x02%*#/users/me/booster-data/{booster_id}\x12\x8d\x01\n" +
 	"\x13ListPersonalRecords\x12\x1d.fitglue.gateway.EmptyRequest\x1a3.fitglue.gateway.ListPersonalRecordsGatewayResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/users/me/personal-records\x12\x9f\x01\n" +
 	"\x11SetPersonalRecord\x120.fitglue.gateway.SetPersonalRecordGatewayRequest\x1a#.fitglue.models.user.PersonalRecord\"3\x82\xd3\xe4\x93\x02-:\x01*\x1a(/users/me/personal-records/{record_type}\x12\x84\x01\n" +
-	"\x14DeletePersonalRecord\x12\".fitglue.gateway.RecordTypeRequest\x1a\x16.google.protobuf.Empty\"0\x82\xd3\xe4\x93\x02**(/users/me/personal-records/{record_type}\x12\x8a\x01\n" +
+	"\x14DeletePersonalRecord\x12\".fitglue.gateway.RecordTypeRequest\x1a\x16.google.protobuf.Empty\"0\x82\xd3\xe4\x93\x02**(/users/me/personal-records/{record_type}\x12k\n" +
+	"\bListGear\x12\x1d.fitglue.gateway.EmptyRequest\x1a(.fitglue.gateway.ListGearGatewayResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/users/me/gear\x12q\n" +
+	"\aSetGear\x12&.fitglue.gateway.SetGearGatewayRequest\x1a\x19.fitglue.models.user.Gear\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/users/me/gear/{gear_id}\x12f\n" +
+	"\n" +
+	"DeleteGear\x12\x1e.fitglue.gateway.GearIdRequest\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x1a*\x18/users/me/gear/{gear_id}\x12n\n" +
+	"\tListGoals\x12\x1d.fitglue.gateway.EmptyRequest\x1a).fitglue.gateway.ListGoalsGatewayResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/users/me/goals\x12r\n" +
+	"\aSetGoal\x12&.fitglue.gateway.SetGoalGatewayRequest\x1a\x19.fitglue.models.user.Goal\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/users/me/goals/{goal_id}\x12g\n" +
+	"\n" +
+	"DeleteGoal\x12\x1e.fitglue.gateway.GoalIdRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b*\x19/users/me/goals/{goal_id}\x12\x8a\x01\n" +
 	"\x12ListPluginDefaults\x12\x1d.fitglue.gateway.EmptyRequest\x1a2.fitglue.gateway.ListPluginDefaultsGatewayResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/users/me/plugin-defaults\x12\x96\x01\n" +
 	"\x11SetPluginDefaults\x120.fitglue.gateway.SetPluginDefaultsGatewayRequest\x1a\x16.google.protobuf.Empty\"7\x82\xd3\xe4\x93\x021:\bdefaults\x1a%/users/me/plugin-defaults/{plugin_id}\x12\x7f\n" +
 	"\x14DeletePluginDefaults\x12 .fitglue.gateway.PluginIdRequest\x1a\x16.google.protobuf.Empty\"-\x82\xd3\xe4\x93\x02'*%/users/me/plugin-defaults/{plugin_id}\x12~\n" +
 	"\x15SendVerificationEmail\x12\x1d.fitglue.gateway.EmptyRequest\x1a\x16.google.protobuf.Empty\".\x82\xd3\xe4\x93\x02(\"&/users/me/auth-email/send-verification\x12\x98\x01\n" +
 	"\x1bSendEmailChangeVerification\x12..fitglue.gateway.SendEmailChangeGatewayRequest\x1a\x16.google.protobuf.Empty\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/users/me/auth-email/send-email-change\x12\x89\x01\n" +
 	"\x11SendPasswordReset\x120.fitglue.gateway.SendPasswordResetGatewayRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/auth-email/send-password-reset\x12q\n" +
-	"\vSetFCMToken\x12*.fitglue.gateway.SetFCMTokenGatewayRequest\x1a\x16.google.protobuf.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/users/me/fcm-token\x12b\n" +
+	"\vSetFCMToken\x12*.fitglue.gateway.SetFCMTokenGatewayRequest\x1a\x16.google.protobuf.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/users/me/fcm-token\x12u\n" +
+	"\vListApiKeys\x12\x1d.fitglue.gateway.EmptyRequest\x1a+.fitglue.gateway.ListApiKeysGatewayResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/users/me/api-keys\x12\x88\x01\n" +
+	"\fCreateApiKey\x12+.fitglue.gateway.CreateApiKeyGatewayRequest\x1a,.fitglue.gateway.CreateApiKeyGatewayResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/users/me/api-keys\x12i\n" +
+	"\fDeleteApiKey\x12 .fitglue.gateway.ApiKeyIdRequest\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/users/me/api-keys/{id}\x12b\n" +
 	"\n" +
 	"MobileSync\x12\x1d.fitglue.gateway.EmptyRequest\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x15/users/me/mobile/sync\x12z\n" +
 	"\rListPipelines\x12\x1d.fitglue.gateway.EmptyRequest\x1a-.fitglue.gateway.ListPipelinesGatewayResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/users/me/pipelines\x12|\n" +
@@ -3264,9 +5540,31 @@ const file_gateway_client_proto_rawDesc = "" +
 	"\x0eUpdatePipeline\x12-.fitglue.gateway.UpdatePipelineGatewayRequest\x1a'.fitglue.models.pipeline.PipelineConfig\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/users/me/pipelines/{id}\x12n\n" +
 	"\x0eDeletePipeline\x12\".fitglue.gateway.PipelineIdRequest\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x1a*\x18/users/me/pipelines/{id}\x12\x9c\x01\n" +
 	"\x10ListPipelineRuns\x12/.fitglue.gateway.ListPipelineRunsGatewayRequest\x1a0.fitglue.gateway.ListPipelineRunsGatewayResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/users/me/pipelines/{id}/runs\x12\x95\x01\n" +
-	"\x0eGetPipelineRun\x12-.fitglue.gateway.GetPipelineRunGatewayRequest\x1a$.fitglue.models.pipeline.PipelineRun\".\x82\xd3\xe4\x93\x02(\x12&/users/me/pipelines/{id}/runs/{run_id}\x12\x88\x01\n" +
-	"\vSubmitInput\x12*.fitglue.gateway.SubmitInputGatewayRequest\x1a\x16.google.protobuf.Empty\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/users/me/pending-inputs/{input_id}/submit\x12\x81\x01\n" +
-	"\x0eRepostActivity\x12-.fitglue.gateway.RepostActivityGatewayRequest\x1a\x16.google.protobuf.Empty\"(\x82\xd3\xe4\x93\x02\"\" /users/me/activities/{id}/repost\x12\x8d\x01\n" +
+	"\x0eGetPipelineRun\x12-.fitglue.gateway.GetPipelineRunGatewayRequest\x1a$.fitglue.models.pipeline.PipelineRun\".\x82\xd3\xe4\x93\x02(\x12&/users/me/pipelines/{id}/runs/{run_id}\x12\xb8\x01\n" +
+	"\x19GetPipelineRunDebugBundle\x12-.fitglue.gateway.GetPipelineRunGatewayRequest\x1a/.fitglue.models.pipeline.PipelineRunDebugBundle\";\x82\xd3\xe4\x93\x025\x123/users/me/pipelines/{id}/runs/{run_id}/debug-bundle\x12\x94\x01\n" +
+	"\x10RetryPipelineRun\x12/.fitglue.gateway.RetryPipelineRunGatewayRequest\x1a\x16.google.protobuf.Empty\"7\x82\xd3\xe4\x93\x021:\x01*\",/users/me/pipelines/{id}/runs/{run_id}/retry\x12s\n" +
+	"\x0ePausePipelines\x12-.fitglue.gateway.PausePipelinesGatewayRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\x1a\x0f/users/me/pause\x12\x8f\x01\n" +
+	"\x0fResumePipelines\x12..fitglue.gateway.ResumePipelinesGatewayRequest\x1a/.fitglue.gateway.ResumePipelinesGatewayResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/users/me/resume\x12\xa3\x01\n" +
+	"\x13GetPipelineCalendar\x12/.fitglue.gateway.PipelineCalendarGatewayRequest\x1a0.fitglue.gateway.PipelineCalendarGatewayResponse\")\x82\xd3\xe4\x93\x02#\x12!/users/me/pipelines/{id}/calendar\x12\x9f\x01\n" +
+	"\x0fPreviewPipeline\x12..fitglue.gateway.PreviewPipelineGatewayRequest\x1a(.fitglue.models.pipeline.PipelinePreview\"2\x82\xd3\xe4\x93\x02,:\bactivity\" /users/me/pipelines/{id}/preview\x12\x91\x01\n" +
+	"\x10GetEnricherUsage\x12,.fitglue.gateway.EnricherUsageGatewayRequest\x1a-.fitglue.gateway.EnricherUsageGatewayResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/users/me/enricher-usage\x12\x99\x01\n" +
+	"\x1aGetEnricherRecommendations\x12\x1d.fitglue.gateway.EmptyRequest\x1a0.fitglue.models.pipeline.EnricherRecommendations\"*\x82\xd3\xe4\x93\x02$\x12\"/users/me/enricher-recommendations\x12\xa9\x01\n" +
+	"\x13CorrectActivityType\x122.fitglue.gateway.CorrectActivityTypeGatewayRequest\x1a3.fitglue.gateway.CorrectActivityTypeGatewayResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/users/me/activities/{id}/type\x12\x94\x01\n" +
+	"\x15ListActivityTypeRules\x12\x1d.fitglue.gateway.EmptyRequest\x1a5.fitglue.gateway.ListActivityTypeRulesGatewayResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/users/me/activity-type-rules\x12\xa9\x01\n" +
+	"\x16UpdateActivityTypeRule\x125.fitglue.gateway.UpdateActivityTypeRuleGatewayRequest\x1a).fitglue.models.pipeline.ActivityTypeRule\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/users/me/activity-type-rules/{id}\x12\x88\x01\n" +
+	"\x16DeleteActivityTypeRule\x12*.fitglue.gateway.ActivityTypeRuleIdRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$*\"/users/me/activity-type-rules/{id}\x12\x91\x01\n" +
+	"\rStartBackfill\x12,.fitglue.gateway.StartBackfillGatewayRequest\x1a$.fitglue.models.pipeline.BackfillJob\",\x82\xd3\xe4\x93\x02&:\x01*\"!/users/me/pipelines/{id}/backfill\x12\x99\x01\n" +
+	"\x0eGetBackfillJob\x12-.fitglue.gateway.GetBackfillJobGatewayRequest\x1a$.fitglue.models.pipeline.BackfillJob\"2\x82\xd3\xe4\x93\x02,\x12*/users/me/pipelines/{id}/backfill/{job_id}\x12\x90\x01\n" +
+	"\fCreateImport\x12+.fitglue.gateway.CreateImportGatewayRequest\x1a&.fitglue.models.pipeline.ImportSession\"+\x82\xd3\xe4\x93\x02%:\x01*\" /users/me/pipelines/{id}/imports\x12\xb2\x01\n" +
+	"\x10UploadImportFile\x12/.fitglue.gateway.UploadImportFileGatewayRequest\x1a&.fitglue.models.pipeline.ImportSession\"E\x82\xd3\xe4\x93\x02?:\x01*\x1a:/users/me/pipelines/{id}/imports/{import_id}/files/{index}\x12\x98\x01\n" +
+	"\vStartImport\x12%.fitglue.gateway.ImportGatewayRequest\x1a&.fitglue.models.pipeline.ImportSession\":\x82\xd3\xe4\x93\x024\"2/users/me/pipelines/{id}/imports/{import_id}/start\x12\x90\x01\n" +
+	"\tGetImport\x12%.fitglue.gateway.ImportGatewayRequest\x1a&.fitglue.models.pipeline.ImportSession\"4\x82\xd3\xe4\x93\x02.\x12,/users/me/pipelines/{id}/imports/{import_id}\x12|\n" +
+	"\x11GetPlatformStatus\x12\x1d.fitglue.gateway.EmptyRequest\x1a..fitglue.gateway.PlatformStatusGatewayResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/platform-status\x12\x87\x01\n" +
+	"\x11ListPendingInputs\x12\x1d.fitglue.gateway.EmptyRequest\x1a1.fitglue.gateway.ListPendingInputsGatewayResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/users/me/pending-inputs\x12\x88\x01\n" +
+	"\vSubmitInput\x12*.fitglue.gateway.SubmitInputGatewayRequest\x1a\x16.google.protobuf.Empty\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/users/me/pending-inputs/{input_id}/submit\x12\xc5\x01\n" +
+	"\x18CreatePendingInputUpload\x127.fitglue.gateway.CreatePendingInputUploadGatewayRequest\x1a8.fitglue.gateway.CreatePendingInputUploadGatewayResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/users/me/pending-inputs/{input_id}/uploads\x12\x81\x01\n" +
+	"\x0eRepostActivity\x12-.fitglue.gateway.RepostActivityGatewayRequest\x1a\x16.google.protobuf.Empty\"(\x82\xd3\xe4\x93\x02\"\" /users/me/activities/{id}/repost\x12\xb5\x01\n" +
+	"\x17PreviewDescriptionMerge\x121.fitglue.gateway.DescriptionPreviewGatewayRequest\x1a0.fitglue.models.pipeline.DescriptionMergePreview\"5\x82\xd3\xe4\x93\x02/\x12-/users/me/activities/{id}/description-preview\x12\x8d\x01\n" +
 	"\x0eListActivities\x12-.fitglue.gateway.ListActivitiesGatewayRequest\x1a..fitglue.gateway.ListActivitiesGatewayResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/users/me/activities\x12\x83\x01\n" +
 	"\vGetActivity\x12\".fitglue.gateway.ActivityIdRequest\x1a-.fitglue.models.activity.StandardizedActivity\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/users/me/activities/{id}\x12o\n" +
 	"\x0eDeleteActivity\x12\".fitglue.gateway.ActivityIdRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b*\x19/users/me/activities/{id}\x12\x87\x01\n" +
@@ -3284,9 +5582,12 @@ const file_gateway_client_proto_rawDesc = "" +
 	"\x12UpdateShowcaseSlug\x121.fitglue.gateway.UpdateShowcaseSlugGatewayRequest\x1a2.fitglue.gateway.UpdateShowcaseSlugGatewayResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\x1a*/users/me/showcase-management/profile/slug\x12\x96\x01\n" +
 	"\x10AddShowcaseEntry\x12%.fitglue.gateway.ShowcaseEntryRequest\x1a\x16.google.protobuf.Empty\"C\x82\xd3\xe4\x93\x02=\";/users/me/showcase-management/profile/entries/{showcase_id}\x12\x99\x01\n" +
 	"\x13RemoveShowcaseEntry\x12%.fitglue.gateway.ShowcaseEntryRequest\x1a\x16.google.protobuf.Empty\"C\x82\xd3\xe4\x93\x02=*;/users/me/showcase-management/profile/entries/{showcase_id}\x12\xc7\x01\n" +
-	"\"GetShowcaseProfilePictureUploadUrl\x122.fitglue.gateway.GetPictureUploadUrlGatewayRequest\x1a3.fitglue.gateway.GetPictureUploadUrlGatewayResponse\"8\x82\xd3\xe4\x93\x022:\x01*\"-/users/me/showcase-management/profile/picture\x12q\n" +
+	"\"GetShowcaseProfilePictureUploadUrl\x122.fitglue.gateway.GetPictureUploadUrlGatewayRequest\x1a3.fitglue.gateway.GetPictureUploadUrlGatewayResponse\"8\x82\xd3\xe4\x93\x022:\x01*\"-/users/me/showcase-management/profile/picture\x12\xc7\x01\n" +
+	"\x17CreateShowcaseEmbedLink\x126.fitglue.gateway.CreateShowcaseEmbedLinkGatewayRequest\x1a7.fitglue.gateway.CreateShowcaseEmbedLinkGatewayResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/users/me/showcase-management/profile/embed-link\x12q\n" +
 	"\n" +
-	"ExportData\x12\x1d.fitglue.gateway.EmptyRequest\x1a*.fitglue.gateway.ExportDataGatewayResponse\"\x18\x82\xd3\xe4\x93\x02\x12\"\x10/users/me/export\x12\x8a\x01\n" +
+	"ExportData\x12\x1d.fitglue.gateway.EmptyRequest\x1a*.fitglue.gateway.ExportDataGatewayResponse\"\x18\x82\xd3\xe4\x93\x02\x12\"\x10/users/me/export\x12\x91\x01\n" +
+	"\rExportArchive\x12,.fitglue.gateway.ExportArchiveGatewayRequest\x1a-.fitglue.gateway.ExportArchiveGatewayResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/users/me/export/archive\x12~\n" +
+	"\x0eExportUserData\x12\x1d.fitglue.gateway.EmptyRequest\x1a..fitglue.gateway.ExportUserDataGatewayResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x15/users/me/export/data\x12\x8a\x01\n" +
 	"\fParseFitFile\x12+.fitglue.gateway.ParseFitFileGatewayRequest\x1a-.fitglue.models.activity.StandardizedActivity\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/users/me/parse-fit\x12\x96\x01\n" +
 	"\x17RepostMissedDestination\x12,.fitglue.gateway.RepostVariantGatewayRequest\x1a&.fitglue.gateway.RepostGatewayResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/repost/missed-destination\x12\x94\x01\n" +
 	"\x16RepostRetryDestination\x12,.fitglue.gateway.RepostVariantGatewayRequest\x1a&.fitglue.gateway.RepostGatewayResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/repost/retry-destination\x12\x8c\x01\n" +
@@ -3317,7 +5618,7 @@ func file_gateway_client_proto_rawDescGZIP() []byte {
 	return file_gateway_client_proto_rawDescData
 }
 
-var file_gateway_client_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
+var file_gateway_client_proto_msgTypes = make([]protoimpl.MessageInfo, 104)
 var file_gateway_client_proto_goTypes = []any{
 	(*EmptyRequest)(nil),                            // 0: fitglue.gateway.EmptyRequest
 	(*ProviderRequest)(nil),                         // 1: fitglue.gateway.ProviderRequest
@@ -3329,254 +5630,402 @@ var file_gateway_client_proto_goTypes = []any{
 	(*BoosterIdRequest)(nil),                        // 7: fitglue.gateway.BoosterIdRequest
 	(*RecordTypeRequest)(nil),                       // 8: fitglue.gateway.RecordTypeRequest
 	(*CounterNameRequest)(nil),                      // 9: fitglue.gateway.CounterNameRequest
-	(*ShowcaseEntryRequest)(nil),                    // 10: fitglue.gateway.ShowcaseEntryRequest
-	(*UpdateProfileGatewayRequest)(nil),             // 11: fitglue.gateway.UpdateProfileGatewayRequest
-	(*GetIntegrationGatewayResponse)(nil),           // 12: fitglue.gateway.GetIntegrationGatewayResponse
-	(*SetIntegrationGatewayRequest)(nil),            // 13: fitglue.gateway.SetIntegrationGatewayRequest
-	(*OAuthConnectResponse)(nil),                    // 14: fitglue.gateway.OAuthConnectResponse
-	(*ConnectionActionGatewayRequest)(nil),          // 15: fitglue.gateway.ConnectionActionGatewayRequest
-	(*ListCountersGatewayResponse)(nil),             // 16: fitglue.gateway.ListCountersGatewayResponse
-	(*UpdateCounterGatewayRequest)(nil),             // 17: fitglue.gateway.UpdateCounterGatewayRequest
-	(*GetBoosterDataGatewayResponse)(nil),           // 18: fitglue.gateway.GetBoosterDataGatewayResponse
-	(*SetBoosterDataGatewayRequest)(nil),            // 19: fitglue.gateway.SetBoosterDataGatewayRequest
-	(*ListPersonalRecordsGatewayResponse)(nil),      // 20: fitglue.gateway.ListPersonalRecordsGatewayResponse
-	(*SetPersonalRecordGatewayRequest)(nil),         // 21: fitglue.gateway.SetPersonalRecordGatewayRequest
-	(*ListPluginDefaultsGatewayResponse)(nil),       // 22: fitglue.gateway.ListPluginDefaultsGatewayResponse
-	(*SetPluginDefaultsGatewayRequest)(nil),         // 23: fitglue.gateway.SetPluginDefaultsGatewayRequest
-	(*SendEmailChangeGatewayRequest)(nil),           // 24: fitglue.gateway.SendEmailChangeGatewayRequest
-	(*SendPasswordResetGatewayRequest)(nil),         // 25: fitglue.gateway.SendPasswordResetGatewayRequest
-	(*SetFCMTokenGatewayRequest)(nil),               // 26: fitglue.gateway.SetFCMTokenGatewayRequest
-	(*ListPipelinesGatewayResponse)(nil),            // 27: fitglue.gateway.ListPipelinesGatewayResponse
-	(*CreatePipelineGatewayRequest)(nil),            // 28: fitglue.gateway.CreatePipelineGatewayRequest
-	(*UpdatePipelineGatewayRequest)(nil),            // 29: fitglue.gateway.UpdatePipelineGatewayRequest
-	(*ListPipelineRunsGatewayRequest)(nil),          // 30: fitglue.gateway.ListPipelineRunsGatewayRequest
-	(*ListPipelineRunsGatewayResponse)(nil),         // 31: fitglue.gateway.ListPipelineRunsGatewayResponse
-	(*GetPipelineRunGatewayRequest)(nil),            // 32: fitglue.gateway.GetPipelineRunGatewayRequest
-	(*SubmitInputGatewayRequest)(nil),               // 33: fitglue.gateway.SubmitInputGatewayRequest
-	(*RepostActivityGatewayRequest)(nil),            // 34: fitglue.gateway.RepostActivityGatewayRequest
-	(*ListActivitiesGatewayRequest)(nil),            // 35: fitglue.gateway.ListActivitiesGatewayRequest
-	(*ListActivitiesGatewayResponse)(nil),           // 36: fitglue.gateway.ListActivitiesGatewayResponse
-	(*GetActivityStatsGatewayResponse)(nil),         // 37: fitglue.gateway.GetActivityStatsGatewayResponse
-	(*ListShowcasesGatewayResponse)(nil),            // 38: fitglue.gateway.ListShowcasesGatewayResponse
-	(*CreateShowcaseGatewayRequest)(nil),            // 39: fitglue.gateway.CreateShowcaseGatewayRequest
-	(*UpdateShowcaseGatewayRequest)(nil),            // 40: fitglue.gateway.UpdateShowcaseGatewayRequest
-	(*UpdateShowcasePreferencesGatewayRequest)(nil), // 41: fitglue.gateway.UpdateShowcasePreferencesGatewayRequest
-	(*GetShowcaseSettingsGatewayResponse)(nil),      // 42: fitglue.gateway.GetShowcaseSettingsGatewayResponse
-	(*ShowcaseActivityEntryGateway)(nil),            // 43: fitglue.gateway.ShowcaseActivityEntryGateway
-	(*UpdateShowcaseSettingsGatewayRequest)(nil),    // 44: fitglue.gateway.UpdateShowcaseSettingsGatewayRequest
-	(*UpdateShowcaseSlugGatewayRequest)(nil),        // 45: fitglue.gateway.UpdateShowcaseSlugGatewayRequest
-	(*UpdateShowcaseSlugGatewayResponse)(nil),       // 46: fitglue.gateway.UpdateShowcaseSlugGatewayResponse
-	(*GetPictureUploadUrlGatewayRequest)(nil),       // 47: fitglue.gateway.GetPictureUploadUrlGatewayRequest
-	(*GetPictureUploadUrlGatewayResponse)(nil),      // 48: fitglue.gateway.GetPictureUploadUrlGatewayResponse
-	(*ExportDataGatewayResponse)(nil),               // 49: fitglue.gateway.ExportDataGatewayResponse
-	(*ParseFitFileGatewayRequest)(nil),              // 50: fitglue.gateway.ParseFitFileGatewayRequest
-	(*RepostVariantGatewayRequest)(nil),             // 51: fitglue.gateway.RepostVariantGatewayRequest
-	(*RepostGatewayResponse)(nil),                   // 52: fitglue.gateway.RepostGatewayResponse
-	(*CreateCheckoutGatewayRequest)(nil),            // 53: fitglue.gateway.CreateCheckoutGatewayRequest
-	(*CreateCheckoutGatewayResponse)(nil),           // 54: fitglue.gateway.CreateCheckoutGatewayResponse
-	(*GetTierStatusGatewayResponse)(nil),            // 55: fitglue.gateway.GetTierStatusGatewayResponse
-	(*CreateBillingPortalGatewayRequest)(nil),       // 56: fitglue.gateway.CreateBillingPortalGatewayRequest
-	(*CreateBillingPortalGatewayResponse)(nil),      // 57: fitglue.gateway.CreateBillingPortalGatewayResponse
-	(*GetPluginIconGatewayResponse)(nil),            // 58: fitglue.gateway.GetPluginIconGatewayResponse
-	(*ListCategoriesGatewayResponse)(nil),           // 59: fitglue.gateway.ListCategoriesGatewayResponse
-	(*ListSourcesGatewayResponse)(nil),              // 60: fitglue.gateway.ListSourcesGatewayResponse
-	nil,                                             // 61: fitglue.gateway.GetBoosterDataGatewayResponse.DataEntry
-	nil,                                             // 62: fitglue.gateway.ListPluginDefaultsGatewayResponse.DefaultsEntry
-	nil,                                             // 63: fitglue.gateway.SubmitInputGatewayRequest.InputDataEntry
-	(*user.UserProfile)(nil),                        // 64: fitglue.models.user.UserProfile
-	(*user.UserIntegrations)(nil),                   // 65: fitglue.models.user.UserIntegrations
-	(*structpb.Struct)(nil),                         // 66: google.protobuf.Struct
-	(*user.Counter)(nil),                            // 67: fitglue.models.user.Counter
-	(*user.PersonalRecord)(nil),                     // 68: fitglue.models.user.PersonalRecord
-	(*pipeline.PipelineConfig)(nil),                 // 69: fitglue.models.pipeline.PipelineConfig
-	(*pipeline.PipelineRun)(nil),                    // 70: fitglue.models.pipeline.PipelineRun
-	(*activity.StandardizedActivity)(nil),           // 71: fitglue.models.activity.StandardizedActivity
-	(*activity.ShowcaseProfileEntry)(nil),           // 72: fitglue.models.activity.ShowcaseProfileEntry
-	(*activity.ShowcasedActivity)(nil),              // 73: fitglue.models.activity.ShowcasedActivity
-	(*activity.ShowcaseProfile)(nil),                // 74: fitglue.models.activity.ShowcaseProfile
-	(user.UserTier)(0),                              // 75: fitglue.models.user.UserTier
-	(*plugin.PluginManifest)(nil),                   // 76: fitglue.models.plugin.PluginManifest
-	(*user.NotificationPreferences)(nil),            // 77: fitglue.models.user.NotificationPreferences
-	(*emptypb.Empty)(nil),                           // 78: google.protobuf.Empty
-	(*user.SubscriptionState)(nil),                  // 79: fitglue.models.user.SubscriptionState
-	(*plugin.PluginRegistryResponse)(nil),           // 80: fitglue.models.plugin.PluginRegistryResponse
+	(*GearIdRequest)(nil),                           // 10: fitglue.gateway.GearIdRequest
+	(*GoalIdRequest)(nil),                           // 11: fitglue.gateway.GoalIdRequest
+	(*ShowcaseEntryRequest)(nil),                    // 12: fitglue.gateway.ShowcaseEntryRequest
+	(*UpdateProfileGatewayRequest)(nil),             // 13: fitglue.gateway.UpdateProfileGatewayRequest
+	(*GetIntegrationGatewayResponse)(nil),           // 14: fitglue.gateway.GetIntegrationGatewayResponse
+	(*SetIntegrationGatewayRequest)(nil),            // 15: fitglue.gateway.SetIntegrationGatewayRequest
+	(*OAuthConnectResponse)(nil),                    // 16: fitglue.gateway.OAuthConnectResponse
+	(*ConnectionActionGatewayRequest)(nil),          // 17: fitglue.gateway.ConnectionActionGatewayRequest
+	(*ListCountersGatewayResponse)(nil),             // 18: fitglue.gateway.ListCountersGatewayResponse
+	(*UpdateCounterGatewayRequest)(nil),             // 19: fitglue.gateway.UpdateCounterGatewayRequest
+	(*GetBoosterDataGatewayResponse)(nil),           // 20: fitglue.gateway.GetBoosterDataGatewayResponse
+	(*SetBoosterDataGatewayRequest)(nil),            // 21: fitglue.gateway.SetBoosterDataGatewayRequest
+	(*ListPersonalRecordsGatewayResponse)(nil),      // 22: fitglue.gateway.ListPersonalRecordsGatewayResponse
+	(*SetPersonalRecordGatewayRequest)(nil),         // 23: fitglue.gateway.SetPersonalRecordGatewayRequest
+	(*ListGearGatewayResponse)(nil),                 // 24: fitglue.gateway.ListGearGatewayResponse
+	(*SetGearGatewayRequest)(nil),                   // 25: fitglue.gateway.SetGearGatewayRequest
+	(*ListGoalsGatewayResponse)(nil),                // 26: fitglue.gateway.ListGoalsGatewayResponse
+	(*SetGoalGatewayRequest)(nil),                   // 27: fitglue.gateway.SetGoalGatewayRequest
+	(*ListPluginDefaultsGatewayResponse)(nil),       // 28: fitglue.gateway.ListPluginDefaultsGatewayResponse
+	(*SetPluginDefaultsGatewayRequest)(nil),         // 29: fitglue.gateway.SetPluginDefaultsGatewayRequest
+	(*SendEmailChangeGatewayRequest)(nil),           // 30: fitglue.gateway.SendEmailChangeGatewayRequest
+	(*SendPasswordResetGatewayRequest)(nil),         // 31: fitglue.gateway.SendPasswordResetGatewayRequest
+	(*SetFCMTokenGatewayRequest)(nil),               // 32: fitglue.gateway.SetFCMTokenGatewayRequest
+	(*ApiKey)(nil),                                  // 33: fitglue.gateway.ApiKey
+	(*ListApiKeysGatewayResponse)(nil),              // 34: fitglue.gateway.ListApiKeysGatewayResponse
+	(*CreateApiKeyGatewayRequest)(nil),              // 35: fitglue.gateway.CreateApiKeyGatewayRequest
+	(*CreateApiKeyGatewayResponse)(nil),             // 36: fitglue.gateway.CreateApiKeyGatewayResponse
+	(*ApiKeyIdRequest)(nil),                         // 37: fitglue.gateway.ApiKeyIdRequest
+	(*ListPipelinesGatewayResponse)(nil),            // 38: fitglue.gateway.ListPipelinesGatewayResponse
+	(*CreatePipelineGatewayRequest)(nil),            // 39: fitglue.gateway.CreatePipelineGatewayRequest
+	(*UpdatePipelineGatewayRequest)(nil),            // 40: fitglue.gateway.UpdatePipelineGatewayRequest
+	(*StartBackfillGatewayRequest)(nil),             // 41: fitglue.gateway.StartBackfillGatewayRequest
+	(*GetBackfillJobGatewayRequest)(nil),            // 42: fitglue.gateway.GetBackfillJobGatewayRequest
+	(*CreateImportGatewayRequest)(nil),              // 43: fitglue.gateway.CreateImportGatewayRequest
+	(*UploadImportFileGatewayRequest)(nil),          // 44: fitglue.gateway.UploadImportFileGatewayRequest
+	(*ImportGatewayRequest)(nil),                    // 45: fitglue.gateway.ImportGatewayRequest
+	(*PlatformStatusGatewayResponse)(nil),           // 46: fitglue.gateway.PlatformStatusGatewayResponse
+	(*ListPipelineRunsGatewayRequest)(nil),          // 47: fitglue.gateway.ListPipelineRunsGatewayRequest
+	(*ListPipelineRunsGatewayResponse)(nil),         // 48: fitglue.gateway.ListPipelineRunsGatewayResponse
+	(*GetPipelineRunGatewayRequest)(nil),            // 49: fitglue.gateway.GetPipelineRunGatewayRequest
+	(*RetryPipelineRunGatewayRequest)(nil),          // 50: fitglue.gateway.RetryPipelineRunGatewayRequest
+	(*PausePipelinesGatewayRequest)(nil),            // 51: fitglue.gateway.PausePipelinesGatewayRequest
+	(*ResumePipelinesGatewayRequest)(nil),           // 52: fitglue.gateway.ResumePipelinesGatewayRequest
+	(*ResumePipelinesGatewayResponse)(nil),          // 53: fitglue.gateway.ResumePipelinesGatewayResponse
+	(*CorrectActivityTypeGatewayRequest)(nil),       // 54: fitglue.gateway.CorrectActivityTypeGatewayRequest
+	(*CorrectActivityTypeGatewayResponse)(nil),      // 55: fitglue.gateway.CorrectActivityTypeGatewayResponse
+	(*ListActivityTypeRulesGatewayResponse)(nil),    // 56: fitglue.gateway.ListActivityTypeRulesGatewayResponse
+	(*UpdateActivityTypeRuleGatewayRequest)(nil),    // 57: fitglue.gateway.UpdateActivityTypeRuleGatewayRequest
+	(*ActivityTypeRuleIdRequest)(nil),               // 58: fitglue.gateway.ActivityTypeRuleIdRequest
+	(*PipelineCalendarGatewayRequest)(nil),          // 59: fitglue.gateway.PipelineCalendarGatewayRequest
+	(*PipelineCalendarGatewayResponse)(nil),         // 60: fitglue.gateway.PipelineCalendarGatewayResponse
+	(*PreviewPipelineGatewayRequest)(nil),           // 61: fitglue.gateway.PreviewPipelineGatewayRequest
+	(*DescriptionPreviewGatewayRequest)(nil),        // 62: fitglue.gateway.DescriptionPreviewGatewayRequest
+	(*EnricherUsageGatewayRequest)(nil),             // 63: fitglue.gateway.EnricherUsageGatewayRequest
+	(*EnricherUsageGatewayResponse)(nil),            // 64: fitglue.gateway.EnricherUsageGatewayResponse
+	(*ListPendingInputsGatewayResponse)(nil),        // 65: fitglue.gateway.ListPendingInputsGatewayResponse
+	(*SubmitInputGatewayRequest)(nil),               // 66: fitglue.gateway.SubmitInputGatewayRequest
+	(*CreatePendingInputUploadGatewayRequest)(nil),  // 67: fitglue.gateway.CreatePendingInputUploadGatewayRequest
+	(*CreatePendingInputUploadGatewayResponse)(nil), // 68: fitglue.gateway.CreatePendingInputUploadGatewayResponse
+	(*RepostActivityGatewayRequest)(nil),            // 69: fitglue.gateway.RepostActivityGatewayRequest
+	(*ListActivitiesGatewayRequest)(nil),            // 70: fitglue.gateway.ListActivitiesGatewayRequest
+	(*ListActivitiesGatewayResponse)(nil),           // 71: fitglue.gateway.ListActivitiesGatewayResponse
+	(*GetActivityStatsGatewayResponse)(nil),         // 72: fitglue.gateway.GetActivityStatsGatewayResponse
+	(*ListShowcasesGatewayResponse)(nil),            // 73: fitglue.gateway.ListShowcasesGatewayResponse
+	(*CreateShowcaseGatewayRequest)(nil),            // 74: fitglue.gateway.CreateShowcaseGatewayRequest
+	(*UpdateShowcaseGatewayRequest)(nil),            // 75: fitglue.gateway.UpdateShowcaseGatewayRequest
+	(*UpdateShowcasePreferencesGatewayRequest)(nil), // 76: fitglue.gateway.UpdateShowcasePreferencesGatewayRequest
+	(*GetShowcaseSettingsGatewayResponse)(nil),      // 77: fitglue.gateway.GetShowcaseSettingsGatewayResponse
+	(*ShowcaseActivityEntryGateway)(nil),            // 78: fitglue.gateway.ShowcaseActivityEntryGateway
+	(*UpdateShowcaseSettingsGatewayRequest)(nil),    // 79: fitglue.gateway.UpdateShowcaseSettingsGatewayRequest
+	(*UpdateShowcaseSlugGatewayRequest)(nil),        // 80: fitglue.gateway.UpdateShowcaseSlugGatewayRequest
+	(*UpdateShowcaseSlugGatewayResponse)(nil),       // 81: fitglue.gateway.UpdateShowcaseSlugGatewayResponse
+	(*GetPictureUploadUrlGatewayRequest)(nil),       // 82: fitglue.gateway.GetPictureUploadUrlGatewayRequest
+	(*GetPictureUploadUrlGatewayResponse)(nil),      // 83: fitglue.gateway.GetPictureUploadUrlGatewayResponse
+	(*CreateShowcaseEmbedLinkGatewayRequest)(nil),   // 84: fitglue.gateway.CreateShowcaseEmbedLinkGatewayRequest
+	(*CreateShowcaseEmbedLinkGatewayResponse)(nil),  // 85: fitglue.gateway.CreateShowcaseEmbedLinkGatewayResponse
+	(*ExportDataGatewayResponse)(nil),               // 86: fitglue.gateway.ExportDataGatewayResponse
+	(*ExportArchiveGatewayRequest)(nil),             // 87: fitglue.gateway.ExportArchiveGatewayRequest
+	(*ExportArchiveGatewayResponse)(nil),            // 88: fitglue.gateway.ExportArchiveGatewayResponse
+	(*ExportUserDataGatewayResponse)(nil),           // 89: fitglue.gateway.ExportUserDataGatewayResponse
+	(*ParseFitFileGatewayRequest)(nil),              // 90: fitglue.gateway.ParseFitFileGatewayRequest
+	(*RepostVariantGatewayRequest)(nil),             // 91: fitglue.gateway.RepostVariantGatewayRequest
+	(*RepostGatewayResponse)(nil),                   // 92: fitglue.gateway.RepostGatewayResponse
+	(*CreateCheckoutGatewayRequest)(nil),            // 93: fitglue.gateway.CreateCheckoutGatewayRequest
+	(*CreateCheckoutGatewayResponse)(nil),           // 94: fitglue.gateway.CreateCheckoutGatewayResponse
+	(*GetTierStatusGatewayResponse)(nil),            // 95: fitglue.gateway.GetTierStatusGatewayResponse
+	(*CreateBillingPortalGatewayRequest)(nil),       // 96: fitglue.gateway.CreateBillingPortalGatewayRequest
+	(*CreateBillingPortalGatewayResponse)(nil),      // 97: fitglue.gateway.CreateBillingPortalGatewayResponse
+	(*GetPluginIconGatewayResponse)(nil),            // 98: fitglue.gateway.GetPluginIconGatewayResponse
+	(*ListCategoriesGatewayResponse)(nil),           // 99: fitglue.gateway.ListCategoriesGatewayResponse
+	(*ListSourcesGatewayResponse)(nil),              // 100: fitglue.gateway.ListSourcesGatewayResponse
+	nil,                                             // 101: fitglue.gateway.GetBoosterDataGatewayResponse.DataEntry
+	nil,                                             // 102: fitglue.gateway.ListPluginDefaultsGatewayResponse.DefaultsEntry
+	nil,                                             // 103: fitglue.gateway.SubmitInputGatewayRequest.InputDataEntry
+	(*user.UserProfile)(nil),                        // 104: fitglue.models.user.UserProfile
+	(*user.UserIntegrations)(nil),                   // 105: fitglue.models.user.UserIntegrations
+	(*structpb.Struct)(nil),                         // 106: google.protobuf.Struct
+	(*user.Counter)(nil),                            // 107: fitglue.models.user.Counter
+	(*user.PersonalRecord)(nil),                     // 108: fitglue.models.user.PersonalRecord
+	(*user.Gear)(nil),                               // 109: fitglue.models.user.Gear
+	(user.GearType)(0),                              // 110: fitglue.models.user.GearType
+	(*user.Goal)(nil),                               // 111: fitglue.models.user.Goal
+	(user.GoalMetric)(0),                            // 112: fitglue.models.user.GoalMetric
+	(activity.ActivityType)(0),                      // 113: fitglue.models.activity.ActivityType
+	(*timestamppb.Timestamp)(nil),                   // 114: google.protobuf.Timestamp
+	(*pipeline.PipelineConfig)(nil),                 // 115: fitglue.models.pipeline.PipelineConfig
+	(*pipeline.PlatformHealth)(nil),                 // 116: fitglue.models.pipeline.PlatformHealth
+	(*pipeline.PipelineRun)(nil),                    // 117: fitglue.models.pipeline.PipelineRun
+	(*pipeline.ActivityTypeRule)(nil),               // 118: fitglue.models.pipeline.ActivityTypeRule
+	(*pipeline.PipelineCalendarDay)(nil),            // 119: fitglue.models.pipeline.PipelineCalendarDay
+	(*activity.StandardizedActivity)(nil),           // 120: fitglue.models.activity.StandardizedActivity
+	(*pipeline.EnricherUsage)(nil),                  // 121: fitglue.models.pipeline.EnricherUsage
+	(*pipeline.PendingInput)(nil),                   // 122: fitglue.models.pipeline.PendingInput
+	(*activity.ShowcaseProfileEntry)(nil),           // 123: fitglue.models.activity.ShowcaseProfileEntry
+	(*activity.ShowcasedActivity)(nil),              // 124: fitglue.models.activity.ShowcasedActivity
+	(*activity.ShowcaseProfile)(nil),                // 125: fitglue.models.activity.ShowcaseProfile
+	(*events.ReplayOverride)(nil),                   // 126: fitglue.models.events.ReplayOverride
+	(user.UserTier)(0),                              // 127: fitglue.models.user.UserTier
+	(*plugin.PluginManifest)(nil),                   // 128: fitglue.models.plugin.PluginManifest
+	(*user.NotificationPreferences)(nil),            // 129: fitglue.models.user.NotificationPreferences
+	(*emptypb.Empty)(nil),                           // 130: google.protobuf.Empty
+	(*pipeline.PipelineRunDebugBundle)(nil),         // 131: fitglue.models.pipeline.PipelineRunDebugBundle
+	(*pipeline.PipelinePreview)(nil),                // 132: fitglue.models.pipeline.PipelinePreview
+	(*pipeline.EnricherRecommendations)(nil),        // 133: fitglue.models.pipeline.EnricherRecommendations
+	(*pipeline.BackfillJob)(nil),                    // 134: fitglue.models.pipeline.BackfillJob
+	(*pipeline.ImportSession)(nil),                  // 135: fitglue.models.pipeline.ImportSession
+	(*pipeline.DescriptionMergePreview)(nil),        // 136: fitglue.models.pipeline.DescriptionMergePreview
+	(*user.SubscriptionState)(nil),                  // 137: fitglue.models.user.SubscriptionState
+	(*plugin.PluginRegistryResponse)(nil),           // 138: fitglue.models.plugin.PluginRegistryResponse
 }
 var file_gateway_client_proto_depIdxs = []int32{
-	64, // 0: fitglue.gateway.UpdateProfileGatewayRequest.profile:type_name -> fitglue.models.user.UserProfile
-	65, // 1: fitglue.gateway.GetIntegrationGatewayResponse.integrations:type_name -> fitglue.models.user.UserIntegrations
-	66, // 2: fitglue.gateway.SetIntegrationGatewayRequest.integration_data:type_name -> google.protobuf.Struct
-	67, // 3: fitglue.gateway.ListCountersGatewayResponse.counters:type_name -> fitglue.models.user.Counter
-	61, // 4: fitglue.gateway.GetBoosterDataGatewayResponse.data:type_name -> fitglue.gateway.GetBoosterDataGatewayResponse.DataEntry
-	66, // 5: fitglue.gateway.SetBoosterDataGatewayRequest.data:type_name -> google.protobuf.Struct
-	68, // 6: fitglue.gateway.ListPersonalRecordsGatewayResponse.records:type_name -> fitglue.models.user.PersonalRecord
-	62, // 7: fitglue.gateway.ListPluginDefaultsGatewayResponse.defaults:type_name -> fitglue.gateway.ListPluginDefaultsGatewayResponse.DefaultsEntry
-	66, // 8: fitglue.gateway.SetPluginDefaultsGatewayRequest.defaults:type_name -> google.protobuf.Struct
-	69, // 9: fitglue.gateway.ListPipelinesGatewayResponse.pipelines:type_name -> fitglue.models.pipeline.PipelineConfig
-	69, // 10: fitglue.gateway.CreatePipelineGatewayRequest.pipeline:type_name -> fitglue.models.pipeline.PipelineConfig
-	69, // 11: fitglue.gateway.UpdatePipelineGatewayRequest.pipeline:type_name -> fitglue.models.pipeline.PipelineConfig
-	70, // 12: fitglue.gateway.ListPipelineRunsGatewayResponse.runs:type_name -> fitglue.models.pipeline.PipelineRun
-	63, // 13: fitglue.gateway.SubmitInputGatewayRequest.input_data:type_name -> fitglue.gateway.SubmitInputGatewayRequest.InputDataEntry
-	71, // 14: fitglue.gateway.ListActivitiesGatewayResponse.activities:type_name -> fitglue.models.activity.StandardizedActivity
-	72, // 15: fitglue.gateway.ListShowcasesGatewayResponse.showcases:type_name -> fitglue.models.activity.ShowcaseProfileEntry
-	73, // 16: fitglue.gateway.CreateShowcaseGatewayRequest.showcase:type_name -> fitglue.models.activity.ShowcasedActivity
-	73, // 17: fitglue.gateway.UpdateShowcaseGatewayRequest.showcase:type_name -> fitglue.models.activity.ShowcasedActivity
-	74, // 18: fitglue.gateway.UpdateShowcasePreferencesGatewayRequest.preferences:type_name -> fitglue.models.activity.ShowcaseProfile
-	74, // 19: fitglue.gateway.GetShowcaseSettingsGatewayResponse.profile:type_name -> fitglue.models.activity.ShowcaseProfile
-	43, // 20: fitglue.gateway.GetShowcaseSettingsGatewayResponse.activities:type_name -> fitglue.gateway.ShowcaseActivityEntryGateway
-	74, // 21: fitglue.gateway.UpdateShowcaseSettingsGatewayRequest.settings:type_name -> fitglue.models.activity.ShowcaseProfile
-	75, // 22: fitglue.gateway.GetTierStatusGatewayResponse.effective_tier:type_name -> fitglue.models.user.UserTier
-	76, // 23: fitglue.gateway.ListSourcesGatewayResponse.sources:type_name -> fitglue.models.plugin.PluginManifest
-	66, // 24: fitglue.gateway.GetBoosterDataGatewayResponse.DataEntry.value:type_name -> google.protobuf.Struct
-	66, // 25: fitglue.gateway.ListPluginDefaultsGatewayResponse.DefaultsEntry.value:type_name -> google.protobuf.Struct
-	0,  // 26: fitglue.gateway.ClientGatewayService.GetProfile:input_type -> fitglue.gateway.EmptyRequest
-	11, // 27: fitglue.gateway.ClientGatewayService.UpdateProfile:input_type -> fitglue.gateway.UpdateProfileGatewayRequest
-	0,  // 28: fitglue.gateway.ClientGatewayService.DeleteSelf:input_type -> fitglue.gateway.EmptyRequest
-	0,  // 29: fitglue.gateway.ClientGatewayService.ListIntegrations:input_type -> fitglue.gateway.EmptyRequest
-	1,  // 30: fitglue.gateway.ClientGatewayService.GetIntegration:input_type -> fitglue.gateway.ProviderRequest
-	13, // 31: fitglue.gateway.ClientGatewayService.SetIntegration:input_type -> fitglue.gateway.SetIntegrationGatewayRequest
-	1,  // 32: fitglue.gateway.ClientGatewayService.DeleteIntegration:input_type -> fitglue.gateway.ProviderRequest
-	1,  // 33: fitglue.gateway.ClientGatewayService.OAuthConnect:input_type -> fitglue.gateway.ProviderRequest
-	15, // 34: fitglue.gateway.ClientGatewayService.ConnectionAction:input_type -> fitglue.gateway.ConnectionActionGatewayRequest
-	0,  // 35: fitglue.gateway.ClientGatewayService.GetNotificationPrefs:input_type -> fitglue.gateway.EmptyRequest
-	77, // 36: fitglue.gateway.ClientGatewayService.UpdateNotificationPrefs:input_type -> fitglue.models.user.NotificationPreferences
-	0,  // 37: fitglue.gateway.ClientGatewayService.ListCounters:input_type -> fitglue.gateway.EmptyRequest
-	17, // 38: fitglue.gateway.ClientGatewayService.UpdateCounter:input_type -> fitglue.gateway.UpdateCounterGatewayRequest
-	9,  // 39: fitglue.gateway.ClientGatewayService.DeleteCounter:input_type -> fitglue.gateway.CounterNameRequest
-	0,  // 40: fitglue.gateway.ClientGatewayService.GetBoosterData:input_type -> fitglue.gateway.EmptyRequest
-	19, // 41: fitglue.gateway.ClientGatewayService.SetBoosterData:input_type -> fitglue.gateway.SetBoosterDataGatewayRequest
-	7,  // 42: fitglue.gateway.ClientGatewayService.DeleteBoosterData:input_type -> fitglue.gateway.BoosterIdRequest
-	0,  // 43: fitglue.gateway.ClientGatewayService.ListPersonalRecords:input_type -> fitglue.gateway.EmptyRequest
-	21, // 44: fitglue.gateway.ClientGatewayService.SetPersonalRecord:input_type -> fitglue.gateway.SetPersonalRecordGatewayRequest
-	8,  // 45: fitglue.gateway.ClientGatewayService.DeletePersonalRecord:input_type -> fitglue.gateway.RecordTypeRequest
-	0,  // 46: fitglue.gateway.ClientGatewayService.ListPluginDefaults:input_type -> fitglue.gateway.EmptyRequest
-	23, // 47: fitglue.gateway.ClientGatewayService.SetPluginDefaults:input_type -> fitglue.gateway.SetPluginDefaultsGatewayRequest
-	5,  // 48: fitglue.gateway.ClientGatewayService.DeletePluginDefaults:input_type -> fitglue.gateway.PluginIdRequest
-	0,  // 49: fitglue.gateway.ClientGatewayService.SendVerificationEmail:input_type -> fitglue.gateway.EmptyRequest
-	24, // 50: fitglue.gateway.ClientGatewayService.SendEmailChangeVerification:input_type -> fitglue.gateway.SendEmailChangeGatewayRequest
-	25, // 51: fitglue.gateway.ClientGatewayService.SendPasswordReset:input_type -> fitglue.gateway.SendPasswordResetGatewayRequest
-	26, // 52: fitglue.gateway.ClientGatewayService.SetFCMToken:input_type -> fitglue.gateway.SetFCMTokenGatewayRequest
-	0,  // 53: fitglue.gateway.ClientGatewayService.MobileSync:input_type -> fitglue.gateway.EmptyRequest
-	0,  // 54: fitglue.gateway.ClientGatewayService.ListPipelines:input_type -> fitglue.gateway.EmptyRequest
-	2,  // 55: fitglue.gateway.ClientGatewayService.GetPipeline:input_type -> fitglue.gateway.PipelineIdRequest
-	28, // 56: fitglue.gateway.ClientGatewayService.CreatePipeline:input_type -> fitglue.gateway.CreatePipelineGatewayRequest
-	29, // 57: fitglue.gateway.ClientGatewayService.UpdatePipeline:input_type -> fitglue.gateway.UpdatePipelineGatewayRequest
-	2,  // 58: fitglue.gateway.ClientGatewayService.DeletePipeline:input_type -> fitglue.gateway.PipelineIdRequest
-	30, // 59: fitglue.gateway.ClientGatewayService.ListPipelineRuns:input_type -> fitglue.gateway.ListPipelineRunsGatewayRequest
-	32, // 60: fitglue.gateway.ClientGatewayService.GetPipelineRun:input_type -> fitglue.gateway.GetPipelineRunGatewayRequest
-	33, // 61: fitglue.gateway.ClientGatewayService.SubmitInput:input_type -> fitglue.gateway.SubmitInputGatewayRequest
-	34, // 62: fitglue.gateway.ClientGatewayService.RepostActivity:input_type -> fitglue.gateway.RepostActivityGatewayRequest
-	35, // 63: fitglue.gateway.ClientGatewayService.ListActivities:input_type -> fitglue.gateway.ListActivitiesGatewayRequest
-	3,  // 64: fitglue.gateway.ClientGatewayService.GetActivity:input_type -> fitglue.gateway.ActivityIdRequest
-	3,  // 65: fitglue.gateway.ClientGatewayService.DeleteActivity:input_type -> fitglue.gateway.ActivityIdRequest
-	0,  // 66: fitglue.gateway.ClientGatewayService.GetActivityStats:input_type -> fitglue.gateway.EmptyRequest
-	0,  // 67: fitglue.gateway.ClientGatewayService.ListShowcases:input_type -> fitglue.gateway.EmptyRequest
-	4,  // 68: fitglue.gateway.ClientGatewayService.GetShowcase:input_type -> fitglue.gateway.ShowcaseIdRequest
-	39, // 69: fitglue.gateway.ClientGatewayService.CreateShowcase:input_type -> fitglue.gateway.CreateShowcaseGatewayRequest
-	40, // 70: fitglue.gateway.ClientGatewayService.UpdateShowcase:input_type -> fitglue.gateway.UpdateShowcaseGatewayRequest
-	4,  // 71: fitglue.gateway.ClientGatewayService.DeleteShowcase:input_type -> fitglue.gateway.ShowcaseIdRequest
-	4,  // 72: fitglue.gateway.ClientGatewayService.GenerateShowcaseImages:input_type -> fitglue.gateway.ShowcaseIdRequest
-	0,  // 73: fitglue.gateway.ClientGatewayService.GetShowcasePreferences:input_type -> fitglue.gateway.EmptyRequest
-	41, // 74: fitglue.gateway.ClientGatewayService.UpdateShowcasePreferences:input_type -> fitglue.gateway.UpdateShowcasePreferencesGatewayRequest
-	0,  // 75: fitglue.gateway.ClientGatewayService.GetShowcaseSettings:input_type -> fitglue.gateway.EmptyRequest
-	44, // 76: fitglue.gateway.ClientGatewayService.UpdateShowcaseSettings:input_type -> fitglue.gateway.UpdateShowcaseSettingsGatewayRequest
-	45, // 77: fitglue.gateway.ClientGatewayService.UpdateShowcaseSlug:input_type -> fitglue.gateway.UpdateShowcaseSlugGatewayRequest
-	10, // 78: fitglue.gateway.ClientGatewayService.AddShowcaseEntry:input_type -> fitglue.gateway.ShowcaseEntryRequest
-	10, // 79: fitglue.gateway.ClientGatewayService.RemoveShowcaseEntry:input_type -> fitglue.gateway.ShowcaseEntryRequest
-	47, // 80: fitglue.gateway.ClientGatewayService.GetShowcaseProfilePictureUploadUrl:input_type -> fitglue.gateway.GetPictureUploadUrlGatewayRequest
-	0,  // 81: fitglue.gateway.ClientGatewayService.ExportData:input_type -> fitglue.gateway.EmptyRequest
-	50, // 82: fitglue.gateway.ClientGatewayService.ParseFitFile:input_type -> fitglue.gateway.ParseFitFileGatewayRequest
-	51, // 83: fitglue.gateway.ClientGatewayService.RepostMissedDestination:input_type -> fitglue.gateway.RepostVariantGatewayRequest
-	51, // 84: fitglue.gateway.ClientGatewayService.RepostRetryDestination:input_type -> fitglue.gateway.RepostVariantGatewayRequest
-	51, // 85: fitglue.gateway.ClientGatewayService.RepostFullPipeline:input_type -> fitglue.gateway.RepostVariantGatewayRequest
-	0,  // 86: fitglue.gateway.ClientGatewayService.GetSubscription:input_type -> fitglue.gateway.EmptyRequest
-	53, // 87: fitglue.gateway.ClientGatewayService.CreateCheckoutSession:input_type -> fitglue.gateway.CreateCheckoutGatewayRequest
-	0,  // 88: fitglue.gateway.ClientGatewayService.CancelSubscription:input_type -> fitglue.gateway.EmptyRequest
-	0,  // 89: fitglue.gateway.ClientGatewayService.GetTierStatus:input_type -> fitglue.gateway.EmptyRequest
-	0,  // 90: fitglue.gateway.ClientGatewayService.StartTrial:input_type -> fitglue.gateway.EmptyRequest
-	56, // 91: fitglue.gateway.ClientGatewayService.CreateBillingPortal:input_type -> fitglue.gateway.CreateBillingPortalGatewayRequest
-	0,  // 92: fitglue.gateway.ClientGatewayService.GetPluginRegistry:input_type -> fitglue.gateway.EmptyRequest
-	0,  // 93: fitglue.gateway.ClientGatewayService.GetPluginRegistryPlugins:input_type -> fitglue.gateway.EmptyRequest
-	6,  // 94: fitglue.gateway.ClientGatewayService.GetPlugin:input_type -> fitglue.gateway.PluginIdPathRequest
-	6,  // 95: fitglue.gateway.ClientGatewayService.GetPluginIcon:input_type -> fitglue.gateway.PluginIdPathRequest
-	0,  // 96: fitglue.gateway.ClientGatewayService.ListCategories:input_type -> fitglue.gateway.EmptyRequest
-	0,  // 97: fitglue.gateway.ClientGatewayService.ListSources:input_type -> fitglue.gateway.EmptyRequest
-	64, // 98: fitglue.gateway.ClientGatewayService.GetProfile:output_type -> fitglue.models.user.UserProfile
-	64, // 99: fitglue.gateway.ClientGatewayService.UpdateProfile:output_type -> fitglue.models.user.UserProfile
-	78, // 100: fitglue.gateway.ClientGatewayService.DeleteSelf:output_type -> google.protobuf.Empty
-	65, // 101: fitglue.gateway.ClientGatewayService.ListIntegrations:output_type -> fitglue.models.user.UserIntegrations
-	12, // 102: fitglue.gateway.ClientGatewayService.GetIntegration:output_type -> fitglue.gateway.GetIntegrationGatewayResponse
-	78, // 103: fitglue.gateway.ClientGatewayService.SetIntegration:output_type -> google.protobuf.Empty
-	78, // 104: fitglue.gateway.ClientGatewayService.DeleteIntegration:output_type -> google.protobuf.Empty
-	14, // 105: fitglue.gateway.ClientGatewayService.OAuthConnect:output_type -> fitglue.gateway.OAuthConnectResponse
-	78, // 106: fitglue.gateway.ClientGatewayService.ConnectionAction:output_type -> google.protobuf.Empty
-	77, // 107: fitglue.gateway.ClientGatewayService.GetNotificationPrefs:output_type -> fitglue.models.user.NotificationPreferences
-	77, // 108: fitglue.gateway.ClientGatewayService.UpdateNotificationPrefs:output_type -> fitglue.models.user.NotificationPreferences
-	16, // 109: fitglue.gateway.ClientGatewayService.ListCounters:output_type -> fitglue.gateway.ListCountersGatewayResponse
-	67, // 110: fitglue.gateway.ClientGatewayService.UpdateCounter:output_type -> fitglue.models.user.Counter
-	78, // 111: fitglue.gateway.ClientGatewayService.DeleteCounter:output_type -> google.protobuf.Empty
-	18, // 112: fitglue.gateway.ClientGatewayService.GetBoosterData:output_type -> fitglue.gateway.GetBoosterDataGatewayResponse
-	78, // 113: fitglue.gateway.ClientGatewayService.SetBoosterData:output_type -> google.protobuf.Empty
-	78, // 114: fitglue.gateway.ClientGatewayService.DeleteBoosterData:output_type -> google.protobuf.Empty
-	20, // 115: fitglue.gateway.ClientGatewayService.ListPersonalRecords:output_type -> fitglue.gateway.ListPersonalRecordsGatewayResponse
-	68, // 116: fitglue.gateway.ClientGatewayService.SetPersonalRecord:output_type -> fitglue.models.user.PersonalRecord
-	78, // 117: fitglue.gateway.ClientGatewayService.DeletePersonalRecord:output_type -> google.protobuf.Empty
-	22, // 118: fitglue.gateway.ClientGatewayService.ListPluginDefaults:output_type -> fitglue.gateway.ListPluginDefaultsGatewayResponse
-	78, // 119: fitglue.gateway.ClientGatewayService.SetPluginDefaults:output_type -> google.protobuf.Empty
-	78, // 120: fitglue.gateway.ClientGatewayService.DeletePluginDefaults:output_type -> google.protobuf.Empty
-	78, // 121: fitglue.gateway.ClientGatewayService.SendVerificationEmail:output_type -> google.protobuf.Empty
-	78, // 122: fitglue.gateway.ClientGatewayService.SendEmailChangeVerification:output_type -> google.protobuf.Empty
-	78, // 123: fitglue.gateway.ClientGatewayService.SendPasswordReset:output_type -> google.protobuf.Empty
-	78, // 124: fitglue.gateway.ClientGatewayService.SetFCMToken:output_type -> google.protobuf.Empty
-	78, // 125: fitglue.gateway.ClientGatewayService.MobileSync:output_type -> google.protobuf.Empty
-	27, // 126: fitglue.gateway.ClientGatewayService.ListPipelines:output_type -> fitglue.gateway.ListPipelinesGatewayResponse
-	69, // 127: fitglue.gateway.ClientGatewayService.GetPipeline:output_type -> fitglue.models.pipeline.PipelineConfig
-	69, // 128: fitglue.gateway.ClientGatewayService.CreatePipeline:output_type -> fitglue.models.pipeline.PipelineConfig
-	69, // 129: fitglue.gateway.ClientGatewayService.UpdatePipeline:output_type -> fitglue.models.pipeline.PipelineConfig
-	78, // 130: fitglue.gateway.ClientGatewayService.DeletePipeline:output_type -> google.protobuf.Empty
-	31, // 131: fitglue.gateway.ClientGatewayService.ListPipelineRuns:output_type -> fitglue.gateway.ListPipelineRunsGatewayResponse
-	70, // 132: fitglue.gateway.ClientGatewayService.GetPipelineRun:output_type -> fitglue.models.pipeline.PipelineRun
-	78, // 133: fitglue.gateway.ClientGatewayService.SubmitInput:output_type -> google.protobuf.Empty
-	78, // 134: fitglue.gateway.ClientGatewayService.RepostActivity:output_type -> google.protobuf.Empty
-	36, // 135: fitglue.gateway.ClientGatewayService.ListActivities:output_type -> fitglue.gateway.ListActivitiesGatewayResponse
-	71, // 136: fitglue.gateway.ClientGatewayService.GetActivity:output_type -> fitglue.models.activity.StandardizedActivity
-	78, // 137: fitglue.gateway.ClientGatewayService.DeleteActivity:output_type -> google.protobuf.Empty
-	37, // 138: fitglue.gateway.ClientGatewayService.GetActivityStats:output_type -> fitglue.gateway.GetActivityStatsGatewayResponse
-	38, // 139: fitglue.gateway.ClientGatewayService.ListShowcases:output_type -> fitglue.gateway.ListShowcasesGatewayResponse
-	73, // 140: fitglue.gateway.ClientGatewayService.GetShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
-	73, // 141: fitglue.gateway.ClientGatewayService.CreateShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
-	73, // 142: fitglue.gateway.ClientGatewayService.UpdateShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
-	78, // 143: fitglue.gateway.ClientGatewayService.DeleteShowcase:output_type -> google.protobuf.Empty
-	78, // 144: fitglue.gateway.ClientGatewayService.GenerateShowcaseImages:output_type -> google.protobuf.Empty
-	74, // 145: fitglue.gateway.ClientGatewayService.GetShowcasePreferences:output_type -> fitglue.models.activity.ShowcaseProfile
-	74, // 146: fitglue.gateway.ClientGatewayService.UpdateShowcasePreferences:output_type -> fitglue.models.activity.ShowcaseProfile
-	42, // 147: fitglue.gateway.ClientGatewayService.GetShowcaseSettings:output_type -> fitglue.gateway.GetShowcaseSettingsGatewayResponse
-	74, // 148: fitglue.gateway.ClientGatewayService.UpdateShowcaseSettings:output_type -> fitglue.models.activity.ShowcaseProfile
-	46, // 149: fitglue.gateway.ClientGatewayService.UpdateShowcaseSlug:output_type -> fitglue.gateway.UpdateShowcaseSlugGatewayResponse
-	78, // 150: fitglue.gateway.ClientGatewayService.AddShowcaseEntry:output_type -> google.protobuf.Empty
-	78, // 151: fitglue.gateway.ClientGatewayService.RemoveShowcaseEntry:output_type -> google.protobuf.Empty
-	48, // 152: fitglue.gateway.ClientGatewayService.GetShowcaseProfilePictureUploadUrl:output_type -> fitglue.gateway.GetPictureUploadUrlGatewayResponse
-	49, // 153: fitglue.gateway.ClientGatewayService.ExportData:output_type -> fitglue.gateway.ExportDataGatewayResponse
-	71, // 154: fitglue.gateway.ClientGatewayService.ParseFitFile:output_type -> fitglue.models.activity.StandardizedActivity
-	52, // 155: fitglue.gateway.ClientGatewayService.RepostMissedDestination:output_type -> fitglue.gateway.RepostGatewayResponse
-	52, // 156: fitglue.gateway.ClientGatewayService.RepostRetryDestination:output_type -> fitglue.gateway.RepostGatewayResponse
-	52, // 157: fitglue.gateway.ClientGatewayService.RepostFullPipeline:output_type -> fitglue.gateway.RepostGatewayResponse
-	79, // 158: fitglue.gateway.ClientGatewayService.GetSubscription:output_type -> fitglue.models.user.SubscriptionState
-	54, // 159: fitglue.gateway.ClientGatewayService.CreateCheckoutSession:output_type -> fitglue.gateway.CreateCheckoutGatewayResponse
-	79, // 160: fitglue.gateway.ClientGatewayService.CancelSubscription:output_type -> fitglue.models.user.SubscriptionState
-	55, // 161: fitglue.gateway.ClientGatewayService.GetTierStatus:output_type -> fitglue.gateway.GetTierStatusGatewayResponse
-	79, // 162: fitglue.gateway.ClientGatewayService.StartTrial:output_type -> fitglue.models.user.SubscriptionState
-	57, // 163: fitglue.gateway.ClientGatewayService.CreateBillingPortal:output_type -> fitglue.gateway.CreateBillingPortalGatewayResponse
-	80, // 164: fitglue.gateway.ClientGatewayService.GetPluginRegistry:output_type -> fitglue.models.plugin.PluginRegistryResponse
-	80, // 165: fitglue.gateway.ClientGatewayService.GetPluginRegistryPlugins:output_type -> fitglue.models.plugin.PluginRegistryResponse
-	76, // 166: fitglue.gateway.ClientGatewayService.GetPlugin:output_type -> fitglue.models.plugin.PluginManifest
-	58, // 167: fitglue.gateway.ClientGatewayService.GetPluginIcon:output_type -> fitglue.gateway.GetPluginIconGatewayResponse
-	59, // 168: fitglue.gateway.ClientGatewayService.ListCategories:output_type -> fitglue.gateway.ListCategoriesGatewayResponse
-	60, // 169: fitglue.gateway.ClientGatewayService.ListSources:output_type -> fitglue.gateway.ListSourcesGatewayResponse
-	98, // [98:170] is the sub-list for method output_type
-	26, // [26:98] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	104, // 0: fitglue.gateway.UpdateProfileGatewayRequest.profile:type_name -> fitglue.models.user.UserProfile
+	105, // 1: fitglue.gateway.GetIntegrationGatewayResponse.integrations:type_name -> fitglue.models.user.UserIntegrations
+	106, // 2: fitglue.gateway.SetIntegrationGatewayRequest.integration_data:type_name -> google.protobuf.Struct
+	107, // 3: fitglue.gateway.ListCountersGatewayResponse.counters:type_name -> fitglue.models.user.Counter
+	101, // 4: fitglue.gateway.GetBoosterDataGatewayResponse.data:type_name -> fitglue.gateway.GetBoosterDataGatewayResponse.DataEntry
+	106, // 5: fitglue.gateway.SetBoosterDataGatewayRequest.data:type_name -> google.protobuf.Struct
+	108, // 6: fitglue.gateway.ListPersonalRecordsGatewayResponse.records:type_name -> fitglue.models.user.PersonalRecord
+	109, // 7: fitglue.gateway.ListGearGatewayResponse.gear:type_name -> fitglue.models.user.Gear
+	110, // 8: fitglue.gateway.SetGearGatewayRequest.type:type_name -> fitglue.models.user.GearType
+	111, // 9: fitglue.gateway.ListGoalsGatewayResponse.goals:type_name -> fitglue.models.user.Goal
+	112, // 10: fitglue.gateway.SetGoalGatewayRequest.metric:type_name -> fitglue.models.user.GoalMetric
+	113, // 11: fitglue.gateway.SetGoalGatewayRequest.activity_type:type_name -> fitglue.models.activity.ActivityType
+	114, // 12: fitglue.gateway.SetGoalGatewayRequest.start_date:type_name -> google.protobuf.Timestamp
+	114, // 13: fitglue.gateway.SetGoalGatewayRequest.end_date:type_name -> google.protobuf.Timestamp
+	102, // 14: fitglue.gateway.ListPluginDefaultsGatewayResponse.defaults:type_name -> fitglue.gateway.ListPluginDefaultsGatewayResponse.DefaultsEntry
+	106, // 15: fitglue.gateway.SetPluginDefaultsGatewayRequest.defaults:type_name -> google.protobuf.Struct
+	114, // 16: fitglue.gateway.ApiKey.created_at:type_name -> google.protobuf.Timestamp
+	114, // 17: fitglue.gateway.ApiKey.last_used_at:type_name -> google.protobuf.Timestamp
+	33,  // 18: fitglue.gateway.ListApiKeysGatewayResponse.keys:type_name -> fitglue.gateway.ApiKey
+	33,  // 19: fitglue.gateway.CreateApiKeyGatewayResponse.key:type_name -> fitglue.gateway.ApiKey
+	115, // 20: fitglue.gateway.ListPipelinesGatewayResponse.pipelines:type_name -> fitglue.models.pipeline.PipelineConfig
+	115, // 21: fitglue.gateway.CreatePipelineGatewayRequest.pipeline:type_name -> fitglue.models.pipeline.PipelineConfig
+	115, // 22: fitglue.gateway.UpdatePipelineGatewayRequest.pipeline:type_name -> fitglue.models.pipeline.PipelineConfig
+	116, // 23: fitglue.gateway.PlatformStatusGatewayResponse.outages:type_name -> fitglue.models.pipeline.PlatformHealth
+	117, // 24: fitglue.gateway.ListPipelineRunsGatewayResponse.runs:type_name -> fitglue.models.pipeline.PipelineRun
+	114, // 25: fitglue.gateway.PausePipelinesGatewayRequest.paused_until:type_name -> google.protobuf.Timestamp
+	113, // 26: fitglue.gateway.CorrectActivityTypeGatewayRequest.activity_type:type_name -> fitglue.models.activity.ActivityType
+	118, // 27: fitglue.gateway.CorrectActivityTypeGatewayResponse.rule:type_name -> fitglue.models.pipeline.ActivityTypeRule
+	118, // 28: fitglue.gateway.ListActivityTypeRulesGatewayResponse.rules:type_name -> fitglue.models.pipeline.ActivityTypeRule
+	119, // 29: fitglue.gateway.PipelineCalendarGatewayResponse.days:type_name -> fitglue.models.pipeline.PipelineCalendarDay
+	120, // 30: fitglue.gateway.PreviewPipelineGatewayRequest.activity:type_name -> fitglue.models.activity.StandardizedActivity
+	121, // 31: fitglue.gateway.EnricherUsageGatewayResponse.enrichers:type_name -> fitglue.models.pipeline.EnricherUsage
+	122, // 32: fitglue.gateway.ListPendingInputsGatewayResponse.inputs:type_name -> fitglue.models.pipeline.PendingInput
+	103, // 33: fitglue.gateway.SubmitInputGatewayRequest.input_data:type_name -> fitglue.gateway.SubmitInputGatewayRequest.InputDataEntry
+	120, // 34: fitglue.gateway.ListActivitiesGatewayResponse.activities:type_name -> fitglue.models.activity.StandardizedActivity
+	123, // 35: fitglue.gateway.ListShowcasesGatewayResponse.showcases:type_name -> fitglue.models.activity.ShowcaseProfileEntry
+	124, // 36: fitglue.gateway.CreateShowcaseGatewayRequest.showcase:type_name -> fitglue.models.activity.ShowcasedActivity
+	124, // 37: fitglue.gateway.UpdateShowcaseGatewayRequest.showcase:type_name -> fitglue.models.activity.ShowcasedActivity
+	125, // 38: fitglue.gateway.UpdateShowcasePreferencesGatewayRequest.preferences:type_name -> fitglue.models.activity.ShowcaseProfile
+	125, // 39: fitglue.gateway.GetShowcaseSettingsGatewayResponse.profile:type_name -> fitglue.models.activity.ShowcaseProfile
+	78,  // 40: fitglue.gateway.GetShowcaseSettingsGatewayResponse.activities:type_name -> fitglue.gateway.ShowcaseActivityEntryGateway
+	125, // 41: fitglue.gateway.UpdateShowcaseSettingsGatewayRequest.settings:type_name -> fitglue.models.activity.ShowcaseProfile
+	114, // 42: fitglue.gateway.CreateShowcaseEmbedLinkGatewayResponse.expires_at:type_name -> google.protobuf.Timestamp
+	126, // 43: fitglue.gateway.RepostVariantGatewayRequest.replay_override:type_name -> fitglue.models.events.ReplayOverride
+	127, // 44: fitglue.gateway.GetTierStatusGatewayResponse.effective_tier:type_name -> fitglue.models.user.UserTier
+	128, // 45: fitglue.gateway.ListSourcesGatewayResponse.sources:type_name -> fitglue.models.plugin.PluginManifest
+	106, // 46: fitglue.gateway.GetBoosterDataGatewayResponse.DataEntry.value:type_name -> google.protobuf.Struct
+	106, // 47: fitglue.gateway.ListPluginDefaultsGatewayResponse.DefaultsEntry.value:type_name -> google.protobuf.Struct
+	0,   // 48: fitglue.gateway.ClientGatewayService.GetProfile:input_type -> fitglue.gateway.EmptyRequest
+	13,  // 49: fitglue.gateway.ClientGatewayService.UpdateProfile:input_type -> fitglue.gateway.UpdateProfileGatewayRequest
+	0,   // 50: fitglue.gateway.ClientGatewayService.DeleteSelf:input_type -> fitglue.gateway.EmptyRequest
+	0,   // 51: fitglue.gateway.ClientGatewayService.ListIntegrations:input_type -> fitglue.gateway.EmptyRequest
+	1,   // 52: fitglue.gateway.ClientGatewayService.GetIntegration:input_type -> fitglue.gateway.ProviderRequest
+	15,  // 53: fitglue.gateway.ClientGatewayService.SetIntegration:input_type -> fitglue.gateway.SetIntegrationGatewayRequest
+	1,   // 54: fitglue.gateway.ClientGatewayService.DeleteIntegration:input_type -> fitglue.gateway.ProviderRequest
+	1,   // 55: fitglue.gateway.ClientGatewayService.OAuthConnect:input_type -> fitglue.gateway.ProviderRequest
+	17,  // 56: fitglue.gateway.ClientGatewayService.ConnectionAction:input_type -> fitglue.gateway.ConnectionActionGatewayRequest
+	0,   // 57: fitglue.gateway.ClientGatewayService.GetNotificationPrefs:input_type -> fitglue.gateway.EmptyRequest
+	129, // 58: fitglue.gateway.ClientGatewayService.UpdateNotificationPrefs:input_type -> fitglue.models.user.NotificationPreferences
+	0,   // 59: fitglue.gateway.ClientGatewayService.ListCounters:input_type -> fitglue.gateway.EmptyRequest
+	19,  // 60: fitglue.gateway.ClientGatewayService.UpdateCounter:input_type -> fitglue.gateway.UpdateCounterGatewayRequest
+	9,   // 61: fitglue.gateway.ClientGatewayService.DeleteCounter:input_type -> fitglue.gateway.CounterNameRequest
+	0,   // 62: fitglue.gateway.ClientGatewayService.GetBoosterData:input_type -> fitglue.gateway.EmptyRequest
+	21,  // 63: fitglue.gateway.ClientGatewayService.SetBoosterData:input_type -> fitglue.gateway.SetBoosterDataGatewayRequest
+	7,   // 64: fitglue.gateway.ClientGatewayService.DeleteBoosterData:input_type -> fitglue.gateway.BoosterIdRequest
+	0,   // 65: fitglue.gateway.ClientGatewayService.ListPersonalRecords:input_type -> fitglue.gateway.EmptyRequest
+	23,  // 66: fitglue.gateway.ClientGatewayService.SetPersonalRecord:input_type -> fitglue.gateway.SetPersonalRecordGatewayRequest
+	8,   // 67: fitglue.gateway.ClientGatewayService.DeletePersonalRecord:input_type -> fitglue.gateway.RecordTypeRequest
+	0,   // 68: fitglue.gateway.ClientGatewayService.ListGear:input_type -> fitglue.gateway.EmptyRequest
+	25,  // 69: fitglue.gateway.ClientGatewayService.SetGear:input_type -> fitglue.gateway.SetGearGatewayRequest
+	10,  // 70: fitglue.gateway.ClientGatewayService.DeleteGear:input_type -> fitglue.gateway.GearIdRequest
+	0,   // 71: fitglue.gateway.ClientGatewayService.ListGoals:input_type -> fitglue.gateway.EmptyRequest
+	27,  // 72: fitglue.gateway.ClientGatewayService.SetGoal:input_type -> fitglue.gateway.SetGoalGatewayRequest
+	11,  // 73: fitglue.gateway.ClientGatewayService.DeleteGoal:input_type -> fitglue.gateway.GoalIdRequest
+	0,   // 74: fitglue.gateway.ClientGatewayService.ListPluginDefaults:input_type -> fitglue.gateway.EmptyRequest
+	29,  // 75: fitglue.gateway.ClientGatewayService.SetPluginDefaults:input_type -> fitglue.gateway.SetPluginDefaultsGatewayRequest
+	5,   // 76: fitglue.gateway.ClientGatewayService.DeletePluginDefaults:input_type -> fitglue.gateway.PluginIdRequest
+	0,   // 77: fitglue.gateway.ClientGatewayService.SendVerificationEmail:input_type -> fitglue.gateway.EmptyRequest
+	30,  // 78: fitglue.gateway.ClientGatewayService.SendEmailChangeVerification:input_type -> fitglue.gateway.SendEmailChangeGatewayRequest
+	31,  // 79: fitglue.gateway.ClientGatewayService.SendPasswordReset:input_type -> fitglue.gateway.SendPasswordResetGatewayRequest
+	32,  // 80: fitglue.gateway.ClientGatewayService.SetFCMToken:input_type -> fitglue.gateway.SetFCMTokenGatewayRequest
+	0,   // 81: fitglue.gateway.ClientGatewayService.ListApiKeys:input_type -> fitglue.gateway.EmptyRequest
+	35,  // 82: fitglue.gateway.ClientGatewayService.CreateApiKey:input_type -> fitglue.gateway.CreateApiKeyGatewayRequest
+	37,  // 83: fitglue.gateway.ClientGatewayService.DeleteApiKey:input_type -> fitglue.gateway.ApiKeyIdRequest
+	0,   // 84: fitglue.gateway.ClientGatewayService.MobileSync:input_type -> fitglue.gateway.EmptyRequest
+	0,   // 85: fitglue.gateway.ClientGatewayService.ListPipelines:input_type -> fitglue.gateway.EmptyRequest
+	2,   // 86: fitglue.gateway.ClientGatewayService.GetPipeline:input_type -> fitglue.gateway.PipelineIdRequest
+	39,  // 87: fitglue.gateway.ClientGatewayService.CreatePipeline:input_type -> fitglue.gateway.CreatePipelineGatewayRequest
+	40,  // 88: fitglue.gateway.ClientGatewayService.UpdatePipeline:input_type -> fitglue.gateway.UpdatePipelineGatewayRequest
+	2,   // 89: fitglue.gateway.ClientGatewayService.DeletePipeline:input_type -> fitglue.gateway.PipelineIdRequest
+	47,  // 90: fitglue.gateway.ClientGatewayService.ListPipelineRuns:input_type -> fitglue.gateway.ListPipelineRunsGatewayRequest
+	49,  // 91: fitglue.gateway.ClientGatewayService.GetPipelineRun:input_type -> fitglue.gateway.GetPipelineRunGatewayRequest
+	49,  // 92: fitglue.gateway.ClientGatewayService.GetPipelineRunDebugBundle:input_type -> fitglue.gateway.GetPipelineRunGatewayRequest
+	50,  // 93: fitglue.gateway.ClientGatewayService.RetryPipelineRun:input_type -> fitglue.gateway.RetryPipelineRunGatewayRequest
+	51,  // 94: fitglue.gateway.ClientGatewayService.PausePipelines:input_type -> fitglue.gateway.PausePipelinesGatewayRequest
+	52,  // 95: fitglue.gateway.ClientGatewayService.ResumePipelines:input_type -> fitglue.gateway.ResumePipelinesGatewayRequest
+	59,  // 96: fitglue.gateway.ClientGatewayService.GetPipelineCalendar:input_type -> fitglue.gateway.PipelineCalendarGatewayRequest
+	61,  // 97: fitglue.gateway.ClientGatewayService.PreviewPipeline:input_type -> fitglue.gateway.PreviewPipelineGatewayRequest
+	63,  // 98: fitglue.gateway.ClientGatewayService.GetEnricherUsage:input_type -> fitglue.gateway.EnricherUsageGatewayRequest
+	0,   // 99: fitglue.gateway.ClientGatewayService.GetEnricherRecommendations:input_type -> fitglue.gateway.EmptyRequest
+	54,  // 100: fitglue.gateway.ClientGatewayService.CorrectActivityType:input_type -> fitglue.gateway.CorrectActivityTypeGatewayRequest
+	0,   // 101: fitglue.gateway.ClientGatewayService.ListActivityTypeRules:input_type -> fitglue.gateway.EmptyRequest
+	57,  // 102: fitglue.gateway.ClientGatewayService.UpdateActivityTypeRule:input_type -> fitglue.gateway.UpdateActivityTypeRuleGatewayRequest
+	58,  // 103: fitglue.gateway.ClientGatewayService.DeleteActivityTypeRule:input_type -> fitglue.gateway.ActivityTypeRuleIdRequest
+	41,  // 104: fitglue.gateway.ClientGatewayService.StartBackfill:input_type -> fitglue.gateway.StartBackfillGatewayRequest
+	42,  // 105: fitglue.gateway.ClientGatewayService.GetBackfillJob:input_type -> fitglue.gateway.GetBackfillJobGatewayRequest
+	43,  // 106: fitglue.gateway.ClientGatewayService.CreateImport:input_type -> fitglue.gateway.CreateImportGatewayRequest
+	44,  // 107: fitglue.gateway.ClientGatewayService.UploadImportFile:input_type -> fitglue.gateway.UploadImportFileGatewayRequest
+	45,  // 108: fitglue.gateway.ClientGatewayService.StartImport:input_type -> fitglue.gateway.ImportGatewayRequest
+	45,  // 109: fitglue.gateway.ClientGatewayService.GetImport:input_type -> fitglue.gateway.ImportGatewayRequest
+	0,   // 110: fitglue.gateway.ClientGatewayService.GetPlatformStatus:input_type -> fitglue.gateway.EmptyRequest
+	0,   // 111: fitglue.gateway.ClientGatewayService.ListPendingInputs:input_type -> fitglue.gateway.EmptyRequest
+	66,  // 112: fitglue.gateway.ClientGatewayService.SubmitInput:input_type -> fitglue.gateway.SubmitInputGatewayRequest
+	67,  // 113: fitglue.gateway.ClientGatewayService.CreatePendingInputUpload:input_type -> fitglue.gateway.CreatePendingInputUploadGatewayRequest
+	69,  // 114: fitglue.gateway.ClientGatewayService.RepostActivity:input_type -> fitglue.gateway.RepostActivityGatewayRequest
+	62,  // 115: fitglue.gateway.ClientGatewayService.PreviewDescriptionMerge:input_type -> fitglue.gateway.DescriptionPreviewGatewayRequest
+	70,  // 116: fitglue.gateway.ClientGatewayService.ListActivities:input_type -> fitglue.gateway.ListActivitiesGatewayRequest
+	3,   // 117: fitglue.gateway.ClientGatewayService.GetActivity:input_type -> fitglue.gateway.ActivityIdRequest
+	3,   // 118: fitglue.gateway.ClientGatewayService.DeleteActivity:input_type -> fitglue.gateway.ActivityIdRequest
+	0,   // 119: fitglue.gateway.ClientGatewayService.GetActivityStats:input_type -> fitglue.gateway.EmptyRequest
+	0,   // 120: fitglue.gateway.ClientGatewayService.ListShowcases:input_type -> fitglue.gateway.EmptyRequest
+	4,   // 121: fitglue.gateway.ClientGatewayService.GetShowcase:input_type -> fitglue.gateway.ShowcaseIdRequest
+	74,  // 122: fitglue.gateway.ClientGatewayService.CreateShowcase:input_type -> fitglue.gateway.CreateShowcaseGatewayRequest
+	75,  // 123: fitglue.gateway.ClientGatewayService.UpdateShowcase:input_type -> fitglue.gateway.UpdateShowcaseGatewayRequest
+	4,   // 124: fitglue.gateway.ClientGatewayService.DeleteShowcase:input_type -> fitglue.gateway.ShowcaseIdRequest
+	4,   // 125: fitglue.gateway.ClientGatewayService.GenerateShowcaseImages:input_type -> fitglue.gateway.ShowcaseIdRequest
+	0,   // 126: fitglue.gateway.ClientGatewayService.GetShowcasePreferences:input_type -> fitglue.gateway.EmptyRequest
+	76,  // 127: fitglue.gateway.ClientGatewayService.UpdateShowcasePreferences:input_type -> fitglue.gateway.UpdateShowcasePreferencesGatewayRequest
+	0,   // 128: fitglue.gateway.ClientGatewayService.GetShowcaseSettings:input_type -> fitglue.gateway.EmptyRequest
+	79,  // 129: fitglue.gateway.ClientGatewayService.UpdateShowcaseSettings:input_type -> fitglue.gateway.UpdateShowcaseSettingsGatewayRequest
+	80,  // 130: fitglue.gateway.ClientGatewayService.UpdateShowcaseSlug:input_type -> fitglue.gateway.UpdateShowcaseSlugGatewayRequest
+	12,  // 131: fitglue.gateway.ClientGatewayService.AddShowcaseEntry:input_type -> fitglue.gateway.ShowcaseEntryRequest
+	12,  // 132: fitglue.gateway.ClientGatewayService.RemoveShowcaseEntry:input_type -> fitglue.gateway.ShowcaseEntryRequest
+	82,  // 133: fitglue.gateway.ClientGatewayService.GetShowcaseProfilePictureUploadUrl:input_type -> fitglue.gateway.GetPictureUploadUrlGatewayRequest
+	84,  // 134: fitglue.gateway.ClientGatewayService.CreateShowcaseEmbedLink:input_type -> fitglue.gateway.CreateShowcaseEmbedLinkGatewayRequest
+	0,   // 135: fitglue.gateway.ClientGatewayService.ExportData:input_type -> fitglue.gateway.EmptyRequest
+	87,  // 136: fitglue.gateway.ClientGatewayService.ExportArchive:input_type -> fitglue.gateway.ExportArchiveGatewayRequest
+	0,   // 137: fitglue.gateway.ClientGatewayService.ExportUserData:input_type -> fitglue.gateway.EmptyRequest
+	90,  // 138: fitglue.gateway.ClientGatewayService.ParseFitFile:input_type -> fitglue.gateway.ParseFitFileGatewayRequest
+	91,  // 139: fitglue.gateway.ClientGatewayService.RepostMissedDestination:input_type -> fitglue.gateway.RepostVariantGatewayRequest
+	91,  // 140: fitglue.gateway.ClientGatewayService.RepostRetryDestination:input_type -> fitglue.gateway.RepostVariantGatewayRequest
+	91,  // 141: fitglue.gateway.ClientGatewayService.RepostFullPipeline:input_type -> fitglue.gateway.RepostVariantGatewayRequest
+	0,   // 142: fitglue.gateway.ClientGatewayService.GetSubscription:input_type -> fitglue.gateway.EmptyRequest
+	93,  // 143: fitglue.gateway.ClientGatewayService.CreateCheckoutSession:input_type -> fitglue.gateway.CreateCheckoutGatewayRequest
+	0,   // 144: fitglue.gateway.ClientGatewayService.CancelSubscription:input_type -> fitglue.gateway.EmptyRequest
+	0,   // 145: fitglue.gateway.ClientGatewayService.GetTierStatus:input_type -> fitglue.gateway.EmptyRequest
+	0,   // 146: fitglue.gateway.ClientGatewayService.StartTrial:input_type -> fitglue.gateway.EmptyRequest
+	96,  // 147: fitglue.gateway.ClientGatewayService.CreateBillingPortal:input_type -> fitglue.gateway.CreateBillingPortalGatewayRequest
+	0,   // 148: fitglue.gateway.ClientGatewayService.GetPluginRegistry:input_type -> fitglue.gateway.EmptyRequest
+	0,   // 149: fitglue.gateway.ClientGatewayService.GetPluginRegistryPlugins:input_type -> fitglue.gateway.EmptyRequest
+	6,   // 150: fitglue.gateway.ClientGatewayService.GetPlugin:input_type -> fitglue.gateway.PluginIdPathRequest
+	6,   // 151: fitglue.gateway.ClientGatewayService.GetPluginIcon:input_type -> fitglue.gateway.PluginIdPathRequest
+	0,   // 152: fitglue.gateway.ClientGatewayService.ListCategories:input_type -> fitglue.gateway.EmptyRequest
+	0,   // 153: fitglue.gateway.ClientGatewayService.ListSources:input_type -> fitglue.gateway.EmptyRequest
+	104, // 154: fitglue.gateway.ClientGatewayService.GetProfile:output_type -> fitglue.models.user.UserProfile
+	104, // 155: fitglue.gateway.ClientGatewayService.UpdateProfile:output_type -> fitglue.models.user.UserProfile
+	130, // 156: fitglue.gateway.ClientGatewayService.DeleteSelf:output_type -> google.protobuf.Empty
+	105, // 157: fitglue.gateway.ClientGatewayService.ListIntegrations:output_type -> fitglue.models.user.UserIntegrations
+	14,  // 158: fitglue.gateway.ClientGatewayService.GetIntegration:output_type -> fitglue.gateway.GetIntegrationGatewayResponse
+	130, // 159: fitglue.gateway.ClientGatewayService.SetIntegration:output_type -> google.protobuf.Empty
+	130, // 160: fitglue.gateway.ClientGatewayService.DeleteIntegration:output_type -> google.protobuf.Empty
+	16,  // 161: fitglue.gateway.ClientGatewayService.OAuthConnect:output_type -> fitglue.gateway.OAuthConnectResponse
+	130, // 162: fitglue.gateway.ClientGatewayService.ConnectionAction:output_type -> google.protobuf.Empty
+	129, // 163: fitglue.gateway.ClientGatewayService.GetNotificationPrefs:output_type -> fitglue.models.user.NotificationPreferences
+	129, // 164: fitglue.gateway.ClientGatewayService.UpdateNotificationPrefs:output_type -> fitglue.models.user.NotificationPreferences
+	18,  // 165: fitglue.gateway.ClientGatewayService.ListCounters:output_type -> fitglue.gateway.ListCountersGatewayResponse
+	107, // 166: fitglue.gateway.ClientGatewayService.UpdateCounter:output_type -> fitglue.models.user.Counter
+	130, // 167: fitglue.gateway.ClientGatewayService.DeleteCounter:output_type -> google.protobuf.Empty
+	20,  // 168: fitglue.gateway.ClientGatewayService.GetBoosterData:output_type -> fitglue.gateway.GetBoosterDataGatewayResponse
+	130, // 169: fitglue.gateway.ClientGatewayService.SetBoosterData:output_type -> google.protobuf.Empty
+	130, // 170: fitglue.gateway.ClientGatewayService.DeleteBoosterData:output_type -> google.protobuf.Empty
+	22,  // 171: fitglue.gateway.ClientGatewayService.ListPersonalRecords:output_type -> fitglue.gateway.ListPersonalRecordsGatewayResponse
+	108, // 172: fitglue.gateway.ClientGatewayService.SetPersonalRecord:output_type -> fitglue.models.user.PersonalRecord
+	130, // 173: fitglue.gateway.ClientGatewayService.DeletePersonalRecord:output_type -> google.protobuf.Empty
+	24,  // 174: fitglue.gateway.ClientGatewayService.ListGear:output_type -> fitglue.gateway.ListGearGatewayResponse
+	109, // 175: fitglue.gateway.ClientGatewayService.SetGear:output_type -> fitglue.models.user.Gear
+	130, // 176: fitglue.gateway.ClientGatewayService.DeleteGear:output_type -> google.protobuf.Empty
+	26,  // 177: fitglue.gateway.ClientGatewayService.ListGoals:output_type -> fitglue.gateway.ListGoalsGatewayResponse
+	111, // 178: fitglue.gateway.ClientGatewayService.SetGoal:output_type -> fitglue.models.user.Goal
+	130, // 179: fitglue.gateway.ClientGatewayService.DeleteGoal:output_type -> google.protobuf.Empty
+	28,  // 180: fitglue.gateway.ClientGatewayService.ListPluginDefaults:output_type -> fitglue.gateway.ListPluginDefaultsGatewayResponse
+	130, // 181: fitglue.gateway.ClientGatewayService.SetPluginDefaults:output_type -> google.protobuf.Empty
+	130, // 182: fitglue.gateway.ClientGatewayService.DeletePluginDefaults:output_type -> google.protobuf.Empty
+	130, // 183: fitglue.gateway.ClientGatewayService.SendVerificationEmail:output_type -> google.protobuf.Empty
+	130, // 184: fitglue.gateway.ClientGatewayService.SendEmailChangeVerification:output_type -> google.protobuf.Empty
+	130, // 185: fitglue.gateway.ClientGatewayService.SendPasswordReset:output_type -> google.protobuf.Empty
+	130, // 186: fitglue.gateway.ClientGatewayService.SetFCMToken:output_type -> google.protobuf.Empty
+	34,  // 187: fitglue.gateway.ClientGatewayService.ListApiKeys:output_type -> fitglue.gateway.ListApiKeysGatewayResponse
+	36,  // 188: fitglue.gateway.ClientGatewayService.CreateApiKey:output_type -> fitglue.gateway.CreateApiKeyGatewayResponse
+	130, // 189: fitglue.gateway.ClientGatewayService.DeleteApiKey:output_type -> google.protobuf.Empty
+	130, // 190: fitglue.gateway.ClientGatewayService.MobileSync:output_type -> google.protobuf.Empty
+	38,  // 191: fitglue.gateway.ClientGatewayService.ListPipelines:output_type -> fitglue.gateway.ListPipelinesGatewayResponse
+	115, // 192: fitglue.gateway.ClientGatewayService.GetPipeline:output_type -> fitglue.models.pipeline.PipelineConfig
+	115, // 193: fitglue.gateway.ClientGatewayService.CreatePipeline:output_type -> fitglue.models.pipeline.PipelineConfig
+	115, // 194: fitglue.gateway.ClientGatewayService.UpdatePipeline:output_type -> fitglue.models.pipeline.PipelineConfig
+	130, // 195: fitglue.gateway.ClientGatewayService.DeletePipeline:output_type -> google.protobuf.Empty
+	48,  // 196: fitglue.gateway.ClientGatewayService.ListPipelineRuns:output_type -> fitglue.gateway.ListPipelineRunsGatewayResponse
+	117, // 197: fitglue.gateway.ClientGatewayService.GetPipelineRun:output_type -> fitglue.models.pipeline.PipelineRun
+	131, // 198: fitglue.gateway.ClientGatewayService.GetPipelineRunDebugBundle:output_type -> fitglue.models.pipeline.PipelineRunDebugBundle
+	130, // 199: fitglue.gateway.ClientGatewayService.RetryPipelineRun:output_type -> google.protobuf.Empty
+	130, // 200: fitglue.gateway.ClientGatewayService.PausePipelines:output_type -> google.protobuf.Empty
+	53,  // 201: fitglue.gateway.ClientGatewayService.ResumePipelines:output_type -> fitglue.gateway.ResumePipelinesGatewayResponse
+	60,  // 202: fitglue.gateway.ClientGatewayService.GetPipelineCalendar:output_type -> fitglue.gateway.PipelineCalendarGatewayResponse
+	132, // 203: fitglue.gateway.ClientGatewayService.PreviewPipeline:output_type -> fitglue.models.pipeline.PipelinePreview
+	64,  // 204: fitglue.gateway.ClientGatewayService.GetEnricherUsage:output_type -> fitglue.gateway.EnricherUsageGatewayResponse
+	133, // 205: fitglue.gateway.ClientGatewayService.GetEnricherRecommendations:output_type -> fitglue.models.pipeline.EnricherRecommendations
+	55,  // 206: fitglue.gateway.ClientGatewayService.CorrectActivityType:output_type -> fitglue.gateway.CorrectActivityTypeGatewayResponse
+	56,  // 207: fitglue.gateway.ClientGatewayService.ListActivityTypeRules:output_type -> fitglue.gateway.ListActivityTypeRulesGatewayResponse
+	118, // 208: fitglue.gateway.ClientGatewayService.UpdateActivityTypeRule:output_type -> fitglue.models.pipeline.ActivityTypeRule
+	130, // 209: fitglue.gateway.ClientGatewayService.DeleteActivityTypeRule:output_type -> google.protobuf.Empty
+	134, // 210: fitglue.gateway.ClientGatewayService.StartBackfill:output_type -> fitglue.models.pipeline.BackfillJob
+	134, // 211: fitglue.gateway.ClientGatewayService.GetBackfillJob:output_type -> fitglue.models.pipeline.BackfillJob
+	135, // 212: fitglue.gateway.ClientGatewayService.CreateImport:output_type -> fitglue.models.pipeline.ImportSession
+	135, // 213: fitglue.gateway.ClientGatewayService.UploadImportFile:output_type -> fitglue.models.pipeline.ImportSession
+	135, // 214: fitglue.gateway.ClientGatewayService.StartImport:output_type -> fitglue.models.pipeline.ImportSession
+	135, // 215: fitglue.gateway.ClientGatewayService.GetImport:output_type -> fitglue.models.pipeline.ImportSession
+	46,  // 216: fitglue.gateway.ClientGatewayService.GetPlatformStatus:output_type -> fitglue.gateway.PlatformStatusGatewayResponse
+	65,  // 217: fitglue.gateway.ClientGatewayService.ListPendingInputs:output_type -> fitglue.gateway.ListPendingInputsGatewayResponse
+	130, // 218: fitglue.gateway.ClientGatewayService.SubmitInput:output_type -> google.protobuf.Empty
+	68,  // 219: fitglue.gateway.ClientGatewayService.CreatePendingInputUpload:output_type -> fitglue.gateway.CreatePendingInputUploadGatewayResponse
+	130, // 220: fitglue.gateway.ClientGatewayService.RepostActivity:output_type -> google.protobuf.Empty
+	136, // 221: fitglue.gateway.ClientGatewayService.PreviewDescriptionMerge:output_type -> fitglue.models.pipeline.DescriptionMergePreview
+	71,  // 222: fitglue.gateway.ClientGatewayService.ListActivities:output_type -> fitglue.gateway.ListActivitiesGatewayResponse
+	120, // 223: fitglue.gateway.ClientGatewayService.GetActivity:output_type -> fitglue.models.activity.StandardizedActivity
+	130, // 224: fitglue.gateway.ClientGatewayService.DeleteActivity:output_type -> google.protobuf.Empty
+	72,  // 225: fitglue.gateway.ClientGatewayService.GetActivityStats:output_type -> fitglue.gateway.GetActivityStatsGatewayResponse
+	73,  // 226: fitglue.gateway.ClientGatewayService.ListShowcases:output_type -> fitglue.gateway.ListShowcasesGatewayResponse
+	124, // 227: fitglue.gateway.ClientGatewayService.GetShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
+	124, // 228: fitglue.gateway.ClientGatewayService.CreateShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
+	124, // 229: fitglue.gateway.ClientGatewayService.UpdateShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
+	130, // 230: fitglue.gateway.ClientGatewayService.DeleteShowcase:output_type -> google.protobuf.Empty
+	130, // 231: fitglue.gateway.ClientGatewayService.GenerateShowcaseImages:output_type -> google.protobuf.Empty
+	125, // 232: fitglue.gateway.ClientGatewayService.GetShowcasePreferences:output_type -> fitglue.models.activity.ShowcaseProfile
+	125, // 233: fitglue.gateway.ClientGatewayService.UpdateShowcasePreferences:output_type -> fitglue.models.activity.ShowcaseProfile
+	77,  // 234: fitglue.gateway.ClientGatewayService.GetShowcaseSettings:output_type -> fitglue.gateway.GetShowcaseSettingsGatewayResponse
+	125, // 235: fitglue.gateway.ClientGatewayService.UpdateShowcaseSettings:output_type -> fitglue.models.activity.ShowcaseProfile
+	81,  // 236: fitglue.gateway.ClientGatewayService.UpdateShowcaseSlug:output_type -> fitglue.gateway.UpdateShowcaseSlugGatewayResponse
+	130, // 237: fitglue.gateway.ClientGatewayService.AddShowcaseEntry:output_type -> google.protobuf.Empty
+	130, // 238: fitglue.gateway.ClientGatewayService.RemoveShowcaseEntry:output_type -> google.protobuf.Empty
+	83,  // 239: fitglue.gateway.ClientGatewayService.GetShowcaseProfilePictureUploadUrl:output_type -> fitglue.gateway.GetPictureUploadUrlGatewayResponse
+	85,  // 240: fitglue.gateway.ClientGatewayService.CreateShowcaseEmbedLink:output_type -> fitglue.gateway.CreateShowcaseEmbedLinkGatewayResponse
+	86,  // 241: fitglue.gateway.ClientGatewayService.ExportData:output_type -> fitglue.gateway.ExportDataGatewayResponse
+	88,  // 242: fitglue.gateway.ClientGatewayService.ExportArchive:output_type -> fitglue.gateway.ExportArchiveGatewayResponse
+	89,  // 243: fitglue.gateway.ClientGatewayService.ExportUserData:output_type -> fitglue.gateway.ExportUserDataGatewayResponse
+	120, // 244: fitglue.gateway.ClientGatewayService.ParseFitFile:output_type -> fitglue.models.activity.StandardizedActivity
+	92,  // 245: fitglue.gateway.ClientGatewayService.RepostMissedDestination:output_type -> fitglue.gateway.RepostGatewayResponse
+	92,  // 246: fitglue.gateway.ClientGatewayService.RepostRetryDestination:output_type -> fitglue.gateway.RepostGatewayResponse
+	92,  // 247: fitglue.gateway.ClientGatewayService.RepostFullPipeline:output_type -> fitglue.gateway.RepostGatewayResponse
+	137, // 248: fitglue.gateway.ClientGatewayService.GetSubscription:output_type -> fitglue.models.user.SubscriptionState
+	94,  // 249: fitglue.gateway.ClientGatewayService.CreateCheckoutSession:output_type -> fitglue.gateway.CreateCheckoutGatewayResponse
+	137, // 250: fitglue.gateway.ClientGatewayService.CancelSubscription:output_type -> fitglue.models.user.SubscriptionState
+	95,  // 251: fitglue.gateway.ClientGatewayService.GetTierStatus:output_type -> fitglue.gateway.GetTierStatusGatewayResponse
+	137, // 252: fitglue.gateway.ClientGatewayService.StartTrial:output_type -> fitglue.models.user.SubscriptionState
+	97,  // 253: fitglue.gateway.ClientGatewayService.CreateBillingPortal:output_type -> fitglue.gateway.CreateBillingPortalGatewayResponse
+	138, // 254: fitglue.gateway.ClientGatewayService.GetPluginRegistry:output_type -> fitglue.models.plugin.PluginRegistryResponse
+	138, // 255: fitglue.gateway.ClientGatewayService.GetPluginRegistryPlugins:output_type -> fitglue.models.plugin.PluginRegistryResponse
+	128, // 256: fitglue.gateway.ClientGatewayService.GetPlugin:output_type -> fitglue.models.plugin.PluginManifest
+	98,  // 257: fitglue.gateway.ClientGatewayService.GetPluginIcon:output_type -> fitglue.gateway.GetPluginIconGatewayResponse
+	99,  // 258: fitglue.gateway.ClientGatewayService.ListCategories:output_type -> fitglue.gateway.ListCategoriesGatewayResponse
+	100, // 259: fitglue.gateway.ClientGatewayService.ListSources:output_type -> fitglue.gateway.ListSourcesGatewayResponse
+	154, // [154:260] is the sub-list for method output_type
+	48,  // [48:154] is the sub-list for method input_type
+	48,  // [48:48] is the sub-list for extension type_name
+	48,  // [48:48] is the sub-list for extension extendee
+	0,   // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_gateway_client_proto_init() }
@@ -3590,7 +6039,7 @@ func file_gateway_client_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gateway_client_proto_rawDesc), len(file_gateway_client_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   64,
+			NumMessages:   104,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -44,6 +44,12 @@ const (
 	ClientGatewayService_ListPersonalRecords_FullMethodName                = "/fitglue.gateway.ClientGatewayService/ListPersonalRecords"
 	ClientGatewayService_SetPersonalRecord_FullMethodName                  = "/fitglue.gateway.ClientGatewayService/SetPersonalRecord"
 	ClientGatewayService_DeletePersonalRecord_FullMethodName               = "/fitglue.gateway.ClientGatewayService/DeletePersonalRecord"
+	ClientGatewayService_ListGear_FullMethodName                           = "/fitglue.gateway.ClientGatewayService/ListGear"
+	ClientGatewayService_SetGear_FullMethodName                            = "/fitglue.gateway.ClientGatewayService/SetGear"
+	ClientGatewayService_DeleteGear_FullMethodName                         = "/fitglue.gateway.ClientGatewayService/DeleteGear"
+	ClientGatewayService_ListGoals_FullMethodName                          = "/fitglue.gateway.ClientGatewayService/ListGoals"
+	ClientGatewayService_SetGoal_FullMethodName                            = "/fitglue.gateway.ClientGatewayService/SetGoal"
+	ClientGatewayService_DeleteGoal_FullMethodName                         = "/fitglue.gateway.ClientGatewayService/DeleteGoal"
 	ClientGatewayService_ListPluginDefaults_FullMethodName                 = "/fitglue.gateway.ClientGatewayService/ListPluginDefaults"
 	ClientGatewayService_SetPluginDefaults_FullMethodName                  = "/fitglue.gateway.ClientGatewayService/SetPluginDefaults"
 	ClientGatewayService_DeletePluginDefaults_FullMethodName               = "/fitglue.gateway.ClientGatewayService/DeletePluginDefaults"
@@ -51,6 +57,9 @@ const (
 	ClientGatewayService_SendEmailChangeVerification_FullMethodName        = "/fitglue.gateway.ClientGatewayService/SendEmailChangeVerification"
 	ClientGatewayService_SendPasswordReset_FullMethodName                  = "/fitglue.gateway.ClientGatewayService/SendPasswordReset"
 	ClientGatewayService_SetFCMToken_FullMethodName                        = "/fitglue.gateway.ClientGatewayService/SetFCMToken"
+	ClientGatewayService_ListApiKeys_FullMethodName                        = "/fitglue.gateway.ClientGatewayService/ListApiKeys"
+	ClientGatewayService_CreateApiKey_FullMethodName                       = "/fitglue.gateway.ClientGatewayService/CreateApiKey"
+	ClientGatewayService_DeleteApiKey_FullMethodName                       = "/fitglue.gateway.ClientGatewayService/DeleteApiKey"
 	ClientGatewayService_MobileSync_FullMethodName                         = "/fitglue.gateway.ClientGatewayService/MobileSync"
 	ClientGatewayService_ListPipelines_FullMethodName                      = "/fitglue.gateway.ClientGatewayService/ListPipelines"
 	ClientGatewayService_GetPipeline_FullMethodName                        = "/fitglue.gateway.ClientGatewayService/GetPipeline"
@@ -59,8 +68,30 @@ const (
 	ClientGatewayService_DeletePipeline_FullMethodName                     = "/fitglue.gateway.ClientGatewayService/DeletePipeline"
 	ClientGatewayService_ListPipelineRuns_FullMethodName                   = "/fitglue.gateway.ClientGatewayService/ListPipelineRuns"
 	ClientGatewayService_GetPipelineRun_FullMethodName                     = "/fitglue.gateway.ClientGatewayService/GetPipelineRun"
+	ClientGatewayService_GetPipelineRunDebugBundle_FullMethodName          = "/fitglue.gateway.ClientGatewayService/GetPipelineRunDebugBundle"
+	ClientGatewayService_RetryPipelineRun_FullMethodName                   = "/fitglue.gateway.ClientGatewayService/RetryPipelineRun"
+	ClientGatewayService_PausePipelines_FullMethodName                     = "/fitglue.gateway.ClientGatewayService/PausePipelines"
+	ClientGatewayService_ResumePipelines_FullMethodName                    = "/fitglue.gateway.ClientGatewayService/ResumePipelines"
+	ClientGatewayService_GetPipelineCalendar_FullMethodName                = "/fitglue.gateway.ClientGatewayService/GetPipelineCalendar"
+	ClientGatewayService_PreviewPipeline_FullMethodName                    = "/fitglue.gateway.ClientGatewayService/PreviewPipeline"
+	ClientGatewayService_GetEnricherUsage_FullMethodName                   = "/fitglue.gateway.ClientGatewayService/GetEnricherUsage"
+	ClientGatewayService_GetEnricherRecommendations_FullMethodName         = "/fitglue.gateway.ClientGatewayService/GetEnricherRecommendations"
+	ClientGatewayService_CorrectActivityType_FullMethodName                = "/fitglue.gateway.ClientGatewayService/CorrectActivityType"
+	ClientGatewayService_ListActivityTypeRules_FullMethodName              = "/fitglue.gateway.ClientGatewayService/ListActivityTypeRules"
+	ClientGatewayService_UpdateActivityTypeRule_FullMethodName             = "/fitglue.gateway.ClientGatewayService/UpdateActivityTypeRule"
+	ClientGatewayService_DeleteActivityTypeRule_FullMethodName             = "/fitglue.gateway.ClientGatewayService/DeleteActivityTypeRule"
+	ClientGatewayService_StartBackfill_FullMethodName                      = "/fitglue.gateway.ClientGatewayService/StartBackfill"
+	ClientGatewayService_GetBackfillJob_FullMethodName                     = "/fitglue.gateway.ClientGatewayService/GetBackfillJob"
+	ClientGatewayService_CreateImport_FullMethodName                       = "/fitglue.gateway.ClientGatewayService/CreateImport"
+	ClientGatewayService_UploadImportFile_FullMethodName                   = "/fitglue.gateway.ClientGatewayService/UploadImportFile"
+	ClientGatewayService_StartImport_FullMethodName                        = "/fitglue.gateway.ClientGatewayService/StartImport"
+	ClientGatewayService_GetImport_FullMethodName                          = "/fitglue.gateway.ClientGatewayService/GetImport"
+	ClientGatewayService_GetPlatformStatus_FullMethodName                  = "/fitglue.gateway.ClientGatewayService/GetPlatformStatus"
+	ClientGatewayService_ListPendingInputs_FullMethodName                  = "/fitglue.gateway.ClientGatewayService/ListPendingInputs"
 	ClientGatewayService_SubmitInput_FullMethodName                        = "/fitglue.gateway.ClientGatewayService/SubmitInput"
+	ClientGatewayService_CreatePendingInputUpload_FullMethodName           = "/fitglue.gateway.ClientGatewayService/CreatePendingInputUpload"
 	ClientGatewayService_RepostActivity_FullMethodName                     = "/fitglue.gateway.ClientGatewayService/RepostActivity"
+	ClientGatewayService_PreviewDescriptionMerge_FullMethodName            = "/fitglue.gateway.ClientGatewayService/PreviewDescriptionMerge"
 	ClientGatewayService_ListActivities_FullMethodName                     = "/fitglue.gateway.ClientGatewayService/ListActivities"
 	ClientGatewayService_GetActivity_FullMethodName                        = "/fitglue.gateway.ClientGatewayService/GetActivity"
 	ClientGatewayService_DeleteActivity_FullMethodName                     = "/fitglue.gateway.ClientGatewayService/DeleteActivity"
@@ -79,7 +110,10 @@ const (
 	ClientGatewayService_AddShowcaseEntry_FullMethodName                   = "/fitglue.gateway.ClientGatewayService/AddShowcaseEntry"
 	ClientGatewayService_RemoveShowcaseEntry_FullMethodName                = "/fitglue.gateway.ClientGatewayService/RemoveShowcaseEntry"
 	ClientGatewayService_GetShowcaseProfilePictureUploadUrl_FullMethodName = "/fitglue.gateway.ClientGatewayService/GetShowcaseProfilePictureUploadUrl"
+	ClientGatewayService_CreateShowcaseEmbedLink_FullMethodName            = "/fitglue.gateway.ClientGatewayService/CreateShowcaseEmbedLink"
 	ClientGatewayService_ExportData_FullMethodName                         = "/fitglue.gateway.ClientGatewayService/ExportData"
+	ClientGatewayService_ExportArchive_FullMethodName                      = "/fitglue.gateway.ClientGatewayService/ExportArchive"
+	ClientGatewayService_ExportUserData_FullMethodName                     = "/fitglue.gateway.ClientGatewayService/ExportUserData"
 	ClientGatewayService_ParseFitFile_FullMethodName                       = "/fitglue.gateway.ClientGatewayService/ParseFitFile"
 	ClientGatewayService_RepostMissedDestination_FullMethodName            = "/fitglue.gateway.ClientGatewayService/RepostMissedDestination"
 	ClientGatewayService_RepostRetryDestination_FullMethodName             = "/fitglue.gateway.ClientGatewayService/RepostRetryDestination"
@@ -107,7 +141,9 @@ const (
 //
 // Auth is handled by gateway middleware — the user_id is injected from the
 // Firebase token, NEVER sent by the client. Request messages here reflect
-// what the frontend actually sends (no user_id fields).
+// what the frontend actually sends (no user_id fields). Scripts and the
+// fitglue CLI authenticate with a personal API key instead of a Firebase
+// token; those keys can't manage API keys or delete the account.
 type ClientGatewayServiceClient interface {
 	// ===================== User Profile =====================
 	GetProfile(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*user.UserProfile, error)
@@ -136,6 +172,14 @@ type ClientGatewayServiceClient interface {
 	ListPersonalRecords(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ListPersonalRecordsGatewayResponse, error)
 	SetPersonalRecord(ctx context.Context, in *SetPersonalRecordGatewayRequest, opts ...grpc.CallOption) (*user.PersonalRecord, error)
 	DeletePersonalRecord(ctx context.Context, in *RecordTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ===================== Gear =====================
+	ListGear(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ListGearGatewayResponse, error)
+	SetGear(ctx context.Context, in *SetGearGatewayRequest, opts ...grpc.CallOption) (*user.Gear, error)
+	DeleteGear(ctx context.Context, in *GearIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ===================== Goals =====================
+	ListGoals(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ListGoalsGatewayResponse, error)
+	SetGoal(ctx context.Context, in *SetGoalGatewayRequest, opts ...grpc.CallOption) (*user.Goal, error)
+	DeleteGoal(ctx context.Context, in *GoalIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ===================== Plugin Defaults =====================
 	ListPluginDefaults(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ListPluginDefaultsGatewayResponse, error)
 	SetPluginDefaults(ctx context.Context, in *SetPluginDefaultsGatewayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -146,6 +190,10 @@ type ClientGatewayServiceClient interface {
 	SendPasswordReset(ctx context.Context, in *SendPasswordResetGatewayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ===================== FCM Token =====================
 	SetFCMToken(ctx context.Context, in *SetFCMTokenGatewayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ===================== API Keys =====================
+	ListApiKeys(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ListApiKeysGatewayResponse, error)
+	CreateApiKey(ctx context.Context, in *CreateApiKeyGatewayRequest, opts ...grpc.CallOption) (*CreateApiKeyGatewayResponse, error)
+	DeleteApiKey(ctx context.Context, in *ApiKeyIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ===================== Mobile Sync =====================
 	MobileSync(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ===================== Pipelines =====================
@@ -156,8 +204,32 @@ type ClientGatewayServiceClient interface {
 	DeletePipeline(ctx context.Context, in *PipelineIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListPipelineRuns(ctx context.Context, in *ListPipelineRunsGatewayRequest, opts ...grpc.CallOption) (*ListPipelineRunsGatewayResponse, error)
 	GetPipelineRun(ctx context.Context, in *GetPipelineRunGatewayRequest, opts ...grpc.CallOption) (*pipeline.PipelineRun, error)
+	GetPipelineRunDebugBundle(ctx context.Context, in *GetPipelineRunGatewayRequest, opts ...grpc.CallOption) (*pipeline.PipelineRunDebugBundle, error)
+	RetryPipelineRun(ctx context.Context, in *RetryPipelineRunGatewayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PausePipelines(ctx context.Context, in *PausePipelinesGatewayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResumePipelines(ctx context.Context, in *ResumePipelinesGatewayRequest, opts ...grpc.CallOption) (*ResumePipelinesGatewayResponse, error)
+	GetPipelineCalendar(ctx context.Context, in *PipelineCalendarGatewayRequest, opts ...grpc.CallOption) (*PipelineCalendarGatewayResponse, error)
+	PreviewPipeline(ctx context.Context, in *PreviewPipelineGatewayRequest, opts ...grpc.CallOption) (*pipeline.PipelinePreview, error)
+	GetEnricherUsage(ctx context.Context, in *EnricherUsageGatewayRequest, opts ...grpc.CallOption) (*EnricherUsageGatewayResponse, error)
+	GetEnricherRecommendations(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*pipeline.EnricherRecommendations, error)
+	CorrectActivityType(ctx context.Context, in *CorrectActivityTypeGatewayRequest, opts ...grpc.CallOption) (*CorrectActivityTypeGatewayResponse, error)
+	ListActivityTypeRules(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ListActivityTypeRulesGatewayResponse, error)
+	UpdateActivityTypeRule(ctx context.Context, in *UpdateActivityTypeRuleGatewayRequest, opts ...grpc.CallOption) (*pipeline.ActivityTypeRule, error)
+	DeleteActivityTypeRule(ctx context.Context, in *ActivityTypeRuleIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StartBackfill(ctx context.Context, in *StartBackfillGatewayRequest, opts ...grpc.CallOption) (*pipeline.BackfillJob, error)
+	GetBackfillJob(ctx context.Context, in *GetBackfillJobGatewayRequest, opts ...grpc.CallOption) (*pipeline.BackfillJob, error)
+	CreateImport(ctx context.Context, in *CreateImportGatewayRequest, opts ...grpc.CallOption) (*pipeline.ImportSession, error)
+	UploadImportFile(ctx context.Context, in *UploadImportFileGatewayRequest, opts ...grpc.CallOption) (*pipeline.ImportSession, error)
+	StartImport(ctx context.Context, in *ImportGatewayRequest, opts ...grpc.CallOption) (*pipeline.ImportSession, error)
+	GetImport(ctx context.Context, in *ImportGatewayRequest, opts ...grpc.CallOption) (*pipeline.ImportSession, error)
+	GetPlatformStatus(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*PlatformStatusGatewayResponse, error)
+	ListPendingInputs(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ListPendingInputsGatewayResponse, error)
 	SubmitInput(ctx context.Context, in *SubmitInputGatewayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Signs a URL for uploading a file to a pending input's upload field. The
+	// returned object_ref goes in that field when submitting.
+	CreatePendingInputUpload(ctx context.Context, in *CreatePendingInputUploadGatewayRequest, opts ...grpc.CallOption) (*CreatePendingInputUploadGatewayResponse, error)
 	RepostActivity(ctx context.Context, in *RepostActivityGatewayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PreviewDescriptionMerge(ctx context.Context, in *DescriptionPreviewGatewayRequest, opts ...grpc.CallOption) (*pipeline.DescriptionMergePreview, error)
 	// ===================== Activities =====================
 	ListActivities(ctx context.Context, in *ListActivitiesGatewayRequest, opts ...grpc.CallOption) (*ListActivitiesGatewayResponse, error)
 	GetActivity(ctx context.Context, in *ActivityIdRequest, opts ...grpc.CallOption) (*activity.StandardizedActivity, error)
@@ -179,8 +251,11 @@ type ClientGatewayServiceClient interface {
 	AddShowcaseEntry(ctx context.Context, in *ShowcaseEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveShowcaseEntry(ctx context.Context, in *ShowcaseEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetShowcaseProfilePictureUploadUrl(ctx context.Context, in *GetPictureUploadUrlGatewayRequest, opts ...grpc.CallOption) (*GetPictureUploadUrlGatewayResponse, error)
+	CreateShowcaseEmbedLink(ctx context.Context, in *CreateShowcaseEmbedLinkGatewayRequest, opts ...grpc.CallOption) (*CreateShowcaseEmbedLinkGatewayResponse, error)
 	// ===================== Data Export =====================
 	ExportData(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ExportDataGatewayResponse, error)
+	ExportArchive(ctx context.Context, in *ExportArchiveGatewayRequest, opts ...grpc.CallOption) (*ExportArchiveGatewayResponse, error)
+	ExportUserData(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ExportUserDataGatewayResponse, error)
 	// ===================== FIT File Parse =====================
 	ParseFitFile(ctx context.Context, in *ParseFitFileGatewayRequest, opts ...grpc.CallOption) (*activity.StandardizedActivity, error)
 	// ===================== Repost Variants =====================
@@ -411,6 +486,66 @@ func (c *clientGatewayServiceClient) DeletePersonalRecord(ctx context.Context, i
 	return out, nil
 }
 
+func (c *clientGatewayServiceClient) ListGear(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ListGearGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGearGatewayResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_ListGear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) SetGear(ctx context.Context, in *SetGearGatewayRequest, opts ...grpc.CallOption) (*user.Gear, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(user.Gear)
+	err := c.cc.Invoke(ctx, ClientGatewayService_SetGear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) DeleteGear(ctx context.Context, in *GearIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ClientGatewayService_DeleteGear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) ListGoals(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ListGoalsGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGoalsGatewayResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_ListGoals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) SetGoal(ctx context.Context, in *SetGoalGatewayRequest, opts ...grpc.CallOption) (*user.Goal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(user.Goal)
+	err := c.cc.Invoke(ctx, ClientGatewayService_SetGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) DeleteGoal(ctx context.Context, in *GoalIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ClientGatewayService_DeleteGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientGatewayServiceClient) ListPluginDefaults(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ListPluginDefaultsGatewayResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPluginDefaultsGatewayResponse)
@@ -481,6 +616,36 @@ func (c *clientGatewayServiceClient) SetFCMToken(ctx context.Context, in *SetFCM
 	return out, nil
 }
 
+func (c *clientGatewayServiceClient) ListApiKeys(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ListApiKeysGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiKeysGatewayResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_ListApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyGatewayRequest, opts ...grpc.CallOption) (*CreateApiKeyGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyGatewayResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_CreateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) DeleteApiKey(ctx context.Context, in *ApiKeyIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ClientGatewayService_DeleteApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientGatewayServiceClient) MobileSync(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -561,6 +726,206 @@ func (c *clientGatewayServiceClient) GetPipelineRun(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *clientGatewayServiceClient) GetPipelineRunDebugBundle(ctx context.Context, in *GetPipelineRunGatewayRequest, opts ...grpc.CallOption) (*pipeline.PipelineRunDebugBundle, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.PipelineRunDebugBundle)
+	err := c.cc.Invoke(ctx, ClientGatewayService_GetPipelineRunDebugBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) RetryPipelineRun(ctx context.Context, in *RetryPipelineRunGatewayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ClientGatewayService_RetryPipelineRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) PausePipelines(ctx context.Context, in *PausePipelinesGatewayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ClientGatewayService_PausePipelines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) ResumePipelines(ctx context.Context, in *ResumePipelinesGatewayRequest, opts ...grpc.CallOption) (*ResumePipelinesGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumePipelinesGatewayResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_ResumePipelines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) GetPipelineCalendar(ctx context.Context, in *PipelineCalendarGatewayRequest, opts ...grpc.CallOption) (*PipelineCalendarGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PipelineCalendarGatewayResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_GetPipelineCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) PreviewPipeline(ctx context.Context, in *PreviewPipelineGatewayRequest, opts ...grpc.CallOption) (*pipeline.PipelinePreview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.PipelinePreview)
+	err := c.cc.Invoke(ctx, ClientGatewayService_PreviewPipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) GetEnricherUsage(ctx context.Context, in *EnricherUsageGatewayRequest, opts ...grpc.CallOption) (*EnricherUsageGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnricherUsageGatewayResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_GetEnricherUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) GetEnricherRecommendations(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*pipeline.EnricherRecommendations, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.EnricherRecommendations)
+	err := c.cc.Invoke(ctx, ClientGatewayService_GetEnricherRecommendations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) CorrectActivityType(ctx context.Context, in *CorrectActivityTypeGatewayRequest, opts ...grpc.CallOption) (*CorrectActivityTypeGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CorrectActivityTypeGatewayResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_CorrectActivityType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) ListActivityTypeRules(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ListActivityTypeRulesGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListActivityTypeRulesGatewayResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_ListActivityTypeRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) UpdateActivityTypeRule(ctx context.Context, in *UpdateActivityTypeRuleGatewayRequest, opts ...grpc.CallOption) (*pipeline.ActivityTypeRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.ActivityTypeRule)
+	err := c.cc.Invoke(ctx, ClientGatewayService_UpdateActivityTypeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) DeleteActivityTypeRule(ctx context.Context, in *ActivityTypeRuleIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ClientGatewayService_DeleteActivityTypeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) StartBackfill(ctx context.Context, in *StartBackfillGatewayRequest, opts ...grpc.CallOption) (*pipeline.BackfillJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.BackfillJob)
+	err := c.cc.Invoke(ctx, ClientGatewayService_StartBackfill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) GetBackfillJob(ctx context.Context, in *GetBackfillJobGatewayRequest, opts ...grpc.CallOption) (*pipeline.BackfillJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.BackfillJob)
+	err := c.cc.Invoke(ctx, ClientGatewayService_GetBackfillJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) CreateImport(ctx context.Context, in *CreateImportGatewayRequest, opts ...grpc.CallOption) (*pipeline.ImportSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.ImportSession)
+	err := c.cc.Invoke(ctx, ClientGatewayService_CreateImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) UploadImportFile(ctx context.Context, in *UploadImportFileGatewayRequest, opts ...grpc.CallOption) (*pipeline.ImportSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.ImportSession)
+	err := c.cc.Invoke(ctx, ClientGatewayService_UploadImportFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) StartImport(ctx context.Context, in *ImportGatewayRequest, opts ...grpc.CallOption) (*pipeline.ImportSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.ImportSession)
+	err := c.cc.Invoke(ctx, ClientGatewayService_StartImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) GetImport(ctx context.Context, in *ImportGatewayRequest, opts ...grpc.CallOption) (*pipeline.ImportSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.ImportSession)
+	err := c.cc.Invoke(ctx, ClientGatewayService_GetImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) GetPlatformStatus(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*PlatformStatusGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlatformStatusGatewayResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_GetPlatformStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) ListPendingInputs(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ListPendingInputsGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingInputsGatewayResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_ListPendingInputs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientGatewayServiceClient) SubmitInput(ctx context.Context, in *SubmitInputGatewayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -571,6 +936,16 @@ func (c *clientGatewayServiceClient) SubmitInput(ctx context.Context, in *Submit
 	return out, nil
 }
 
+func (c *clientGatewayServiceClient) CreatePendingInputUpload(ctx context.Context, in *CreatePendingInputUploadGatewayRequest, opts ...grpc.CallOption) (*CreatePendingInputUploadGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePendingInputUploadGatewayResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_CreatePendingInputUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientGatewayServiceClient) RepostActivity(ctx context.Context, in *RepostActivityGatewayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -581,6 +956,16 @@ func (c *clientGatewayServiceClient) RepostActivity(ctx context.Context, in *Rep
 	return out, nil
 }
 
+func (c *clientGatewayServiceClient) PreviewDescriptionMerge(ctx context.Context, in *DescriptionPreviewGatewayRequest, opts ...grpc.CallOption) (*pipeline.DescriptionMergePreview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.DescriptionMergePreview)
+	err := c.cc.Invoke(ctx, ClientGatewayService_PreviewDescriptionMerge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientGatewayServiceClient) ListActivities(ctx context.Context, in *ListActivitiesGatewayRequest, opts ...grpc.CallOption) (*ListActivitiesGatewayResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListActivitiesGatewayResponse)
@@ -761,6 +1146,16 @@ func (c *clientGatewayServiceClient) GetShowcaseProfilePictureUploadUrl(ctx cont
 	return out, nil
 }
 
+func (c *clientGatewayServiceClient) CreateShowcaseEmbedLink(ctx context.Context, in *CreateShowcaseEmbedLinkGatewayRequest, opts ...grpc.CallOption) (*CreateShowcaseEmbedLinkGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateShowcaseEmbedLinkGatewayResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_CreateShowcaseEmbedLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientGatewayServiceClient) ExportData(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ExportDataGatewayResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExportDataGatewayResponse)
@@ -771,6 +1166,26 @@ func (c *clientGatewayServiceClient) ExportData(ctx context.Context, in *EmptyRe
 	return out, nil
 }
 
+func (c *clientGatewayServiceClient) ExportArchive(ctx context.Context, in *ExportArchiveGatewayRequest, opts ...grpc.CallOption) (*ExportArchiveGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportArchiveGatewayResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_ExportArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) ExportUserData(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ExportUserDataGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataGatewayResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientGatewayServiceClient) ParseFitFile(ctx context.Context, in *ParseFitFileGatewayRequest, opts ...grpc.CallOption) (*activity.StandardizedActivity, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(activity.StandardizedActivity)
@@ -940,7 +1355,9 @@ func (c *clientGatewayServiceClient) ListSources(ctx context.Context, in *EmptyR
 //
 // Auth is handled by gateway middleware — the user_id is injected from the
 // Firebase token, NEVER sent by the client. Request messages here reflect
-// what the frontend actually sends (no user_id fields).
+// what the frontend actually sends (no user_id fields). Scripts and the
+// fitglue CLI authenticate with a personal API key instead of a Firebase
+// token; those keys can't manage API keys or delete the account.
 type ClientGatewayServiceServer interface {
 	// ===================== User Profile =====================
 	GetProfile(context.Context, *EmptyRequest) (*user.UserProfile, error)
@@ -969,6 +1386,14 @@ type ClientGatewayServiceServer interface {
 	ListPersonalRecords(context.Context, *EmptyRequest) (*ListPersonalRecordsGatewayResponse, error)
 	SetPersonalRecord(context.Context, *SetPersonalRecordGatewayRequest) (*user.PersonalRecord, error)
 	DeletePersonalRecord(context.Context, *RecordTypeRequest) (*emptypb.Empty, error)
+	// ===================== Gear =====================
+	ListGear(context.Context, *EmptyRequest) (*ListGearGatewayResponse, error)
+	SetGear(context.Context, *SetGearGatewayRequest) (*user.Gear, error)
+	DeleteGear(context.Context, *GearIdRequest) (*emptypb.Empty, error)
+	// ===================== Goals =====================
+	ListGoals(context.Context, *EmptyRequest) (*ListGoalsGatewayResponse, error)
+	SetGoal(context.Context, *SetGoalGatewayRequest) (*user.Goal, error)
+	DeleteGoal(context.Context, *GoalIdRequest) (*emptypb.Empty, error)
 	// ===================== Plugin Defaults =====================
 	ListPluginDefaults(context.Context, *EmptyRequest) (*ListPluginDefaultsGatewayResponse, error)
 	SetPluginDefaults(context.Context, *SetPluginDefaultsGatewayRequest) (*emptypb.Empty, error)
@@ -979,6 +1404,10 @@ type ClientGatewayServiceServer interface {
 	SendPasswordReset(context.Context, *SendPasswordResetGatewayRequest) (*emptypb.Empty, error)
 	// ===================== FCM Token =====================
 	SetFCMToken(context.Context, *SetFCMTokenGatewayRequest) (*emptypb.Empty, error)
+	// ===================== API Keys =====================
+	ListApiKeys(context.Context, *EmptyRequest) (*ListApiKeysGatewayResponse, error)
+	CreateApiKey(context.Context, *CreateApiKeyGatewayRequest) (*CreateApiKeyGatewayResponse, error)
+	DeleteApiKey(context.Context, *ApiKeyIdRequest) (*emptypb.Empty, error)
 	// ===================== Mobile Sync =====================
 	MobileSync(context.Context, *EmptyRequest) (*emptypb.Empty, error)
 	// ===================== Pipelines =====================
@@ -989,8 +1418,32 @@ type ClientGatewayServiceServer interface {
 	DeletePipeline(context.Context, *PipelineIdRequest) (*emptypb.Empty, error)
 	ListPipelineRuns(context.Context, *ListPipelineRunsGatewayRequest) (*ListPipelineRunsGatewayResponse, error)
 	GetPipelineRun(context.Context, *GetPipelineRunGatewayRequest) (*pipeline.PipelineRun, error)
+	GetPipelineRunDebugBundle(context.Context, *GetPipelineRunGatewayRequest) (*pipeline.PipelineRunDebugBundle, error)
+	RetryPipelineRun(context.Context, *RetryPipelineRunGatewayRequest) (*emptypb.Empty, error)
+	PausePipelines(context.Context, *PausePipelinesGatewayRequest) (*emptypb.Empty, error)
+	ResumePipelines(context.Context, *ResumePipelinesGatewayRequest) (*ResumePipelinesGatewayResponse, error)
+	GetPipelineCalendar(context.Context, *PipelineCalendarGatewayRequest) (*PipelineCalendarGatewayResponse, error)
+	PreviewPipeline(context.Context, *PreviewPipelineGatewayRequest) (*pipeline.PipelinePreview, error)
+	GetEnricherUsage(context.Context, *EnricherUsageGatewayRequest) (*EnricherUsageGatewayResponse, error)
+	GetEnricherRecommendations(context.Context, *EmptyRequest) (*pipeline.EnricherRecommendations, error)
+	CorrectActivityType(context.Context, *CorrectActivityTypeGatewayRequest) (*CorrectActivityTypeGatewayResponse, error)
+	ListActivityTypeRules(context.Context, *EmptyRequest) (*ListActivityTypeRulesGatewayResponse, error)
+	UpdateActivityTypeRule(context.Context, *UpdateActivityTypeRuleGatewayRequest) (*pipeline.ActivityTypeRule, error)
+	DeleteActivityTypeRule(context.Context, *ActivityTypeRuleIdRequest) (*emptypb.Empty, error)
+	StartBackfill(context.Context, *StartBackfillGatewayRequest) (*pipeline.BackfillJob, error)
+	GetBackfillJob(context.Context, *GetBackfillJobGatewayRequest) (*pipeline.BackfillJob, error)
+	CreateImport(context.Context, *CreateImportGatewayRequest) (*pipeline.ImportSession, error)
+	UploadImportFile(context.Context, *UploadImportFileGatewayRequest) (*pipeline.ImportSession, error)
+	StartImport(context.Context, *ImportGatewayRequest) (*pipeline.ImportSession, error)
+	GetImport(context.Context, *ImportGatewayRequest) (*pipeline.ImportSession, error)
+	GetPlatformStatus(context.Context, *EmptyRequest) (*PlatformStatusGatewayResponse, error)
+	ListPendingInputs(context.Context, *EmptyRequest) (*ListPendingInputsGatewayResponse, error)
 	SubmitInput(context.Context, *SubmitInputGatewayRequest) (*emptypb.Empty, error)
+	// Signs a URL for uploading a file to a pending input's upload field. The
+	// returned object_ref goes in that field when submitting.
+	CreatePendingInputUpload(context.Context, *CreatePendingInputUploadGatewayRequest) (*CreatePendingInputUploadGatewayResponse, error)
 	RepostActivity(context.Context, *RepostActivityGatewayRequest) (*emptypb.Empty, error)
+	PreviewDescriptionMerge(context.Context, *DescriptionPreviewGatewayRequest) (*pipeline.DescriptionMergePreview, error)
 	// ===================== Activities =====================
 	ListActivities(context.Context, *ListActivitiesGatewayRequest) (*ListActivitiesGatewayResponse, error)
 	GetActivity(context.Context, *ActivityIdRequest) (*activity.StandardizedActivity, error)
@@ -1012,8 +1465,11 @@ type ClientGatewayServiceServer interface {
 	AddShowcaseEntry(context.Context, *ShowcaseEntryRequest) (*emptypb.Empty, error)
 	RemoveShowcaseEntry(context.Context, *ShowcaseEntryRequest) (*emptypb.Empty, error)
 	GetShowcaseProfilePictureUploadUrl(context.Context, *GetPictureUploadUrlGatewayRequest) (*GetPictureUploadUrlGatewayResponse, error)
+	CreateShowcaseEmbedLink(context.Context, *CreateShowcaseEmbedLinkGatewayRequest) (*CreateShowcaseEmbedLinkGatewayResponse, error)
 	// ===================== Data Export =====================
 	ExportData(context.Context, *EmptyRequest) (*ExportDataGatewayResponse, error)
+	ExportArchive(context.Context, *ExportArchiveGatewayRequest) (*ExportArchiveGatewayResponse, error)
+	ExportUserData(context.Context, *EmptyRequest) (*ExportUserDataGatewayResponse, error)
 	// ===================== FIT File Parse =====================
 	ParseFitFile(context.Context, *ParseFitFileGatewayRequest) (*activity.StandardizedActivity, error)
 	// ===================== Repost Variants =====================
@@ -1104,6 +1560,24 @@ func (UnimplementedClientGatewayServiceServer) SetPersonalRecord(context.Context
 func (UnimplementedClientGatewayServiceServer) DeletePersonalRecord(context.Context, *RecordTypeRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeletePersonalRecord not implemented")
 }
+func (UnimplementedClientGatewayServiceServer) ListGear(context.Context, *EmptyRequest) (*ListGearGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGear not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) SetGear(context.Context, *SetGearGatewayRequest) (*user.Gear, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetGear not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) DeleteGear(context.Context, *GearIdRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGear not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) ListGoals(context.Context, *EmptyRequest) (*ListGoalsGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGoals not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) SetGoal(context.Context, *SetGoalGatewayRequest) (*user.Goal, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetGoal not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) DeleteGoal(context.Context, *GoalIdRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGoal not implemented")
+}
 func (UnimplementedClientGatewayServiceServer) ListPluginDefaults(context.Context, *EmptyRequest) (*ListPluginDefaultsGatewayResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListPluginDefaults not implemented")
 }
@@ -1125,6 +1599,15 @@ func (UnimplementedClientGatewayServiceServer) SendPasswordReset(context.Context
 func (UnimplementedClientGatewayServiceServer) SetFCMToken(context.Context, *SetFCMTokenGatewayRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetFCMToken not implemented")
 }
+func (UnimplementedClientGatewayServiceServer) ListApiKeys(context.Context, *EmptyRequest) (*ListApiKeysGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) CreateApiKey(context.Context, *CreateApiKeyGatewayRequest) (*CreateApiKeyGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) DeleteApiKey(context.Context, *ApiKeyIdRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteApiKey not implemented")
+}
 func (UnimplementedClientGatewayServiceServer) MobileSync(context.Context, *EmptyRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method MobileSync not implemented")
 }
@@ -1149,12 +1632,78 @@ func (UnimplementedClientGatewayServiceServer) ListPipelineRuns(context.Context,
 func (UnimplementedClientGatewayServiceServer) GetPipelineRun(context.Context, *GetPipelineRunGatewayRequest) (*pipeline.PipelineRun, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPipelineRun not implemented")
 }
-func (UnimplementedClientGatewayServiceServer) SubmitInput(context.Context, *SubmitInputGatewayRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method SubmitInput not implemented")
+func (UnimplementedClientGatewayServiceServer) GetPipelineRunDebugBundle(context.Context, *GetPipelineRunGatewayRequest) (*pipeline.PipelineRunDebugBundle, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPipelineRunDebugBundle not implemented")
 }
-func (UnimplementedClientGatewayServiceServer) RepostActivity(context.Context, *RepostActivityGatewayRequest) (*emptypb.Empty, error) {
+func (UnimplementedClientGatewayServiceServer) RetryPipelineRun(context.Context, *RetryPipelineRunGatewayRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryPipelineRun not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) PausePipelines(context.Context, *PausePipelinesGatewayRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PausePipelines not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) ResumePipelines(context.Context, *ResumePipelinesGatewayRequest) (*ResumePipelinesGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumePipelines not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) GetPipelineCalendar(context.Context, *PipelineCalendarGatewayRequest) (*PipelineCalendarGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPipelineCalendar not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) PreviewPipeline(context.Context, *PreviewPipelineGatewayRequest) (*pipeline.PipelinePreview, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewPipeline not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) GetEnricherUsage(context.Context, *EnricherUsageGatewayRequest) (*EnricherUsageGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEnricherUsage not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) GetEnricherRecommendations(context.Context, *EmptyRequest) (*pipeline.EnricherRecommendations, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEnricherRecommendations not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) CorrectActivityType(context.Context, *CorrectActivityTypeGatewayRequest) (*CorrectActivityTypeGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CorrectActivityType not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) ListActivityTypeRules(context.Context, *EmptyRequest) (*ListActivityTypeRulesGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListActivityTypeRules not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) UpdateActivityTypeRule(context.Context, *UpdateActivityTypeRuleGatewayRequest) (*pipeline.ActivityTypeRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateActivityTypeRule not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) DeleteActivityTypeRule(context.Context, *ActivityTypeRuleIdRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteActivityTypeRule not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) StartBackfill(context.Context, *StartBackfillGatewayRequest) (*pipeline.BackfillJob, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartBackfill not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) GetBackfillJob(context.Context, *GetBackfillJobGatewayRequest) (*pipeline.BackfillJob, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBackfillJob not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) CreateImport(context.Context, *CreateImportGatewayRequest) (*pipeline.ImportSession, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateImport not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) UploadImportFile(context.Context, *UploadImportFileGatewayRequest) (*pipeline.ImportSession, error) {
+	return nil, status.Error(codes.Unimplemented, "method UploadImportFile not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) StartImport(context.Context, *ImportGatewayRequest) (*pipeline.ImportSession, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartImport not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) GetImport(context.Context, *ImportGatewayRequest) (*pipeline.ImportSession, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetImport not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) GetPlatformStatus(context.Context, *EmptyRequest) (*PlatformStatusGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPlatformStatus not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) ListPendingInputs(context.Context, *EmptyRequest) (*ListPendingInputsGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPendingInputs not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) SubmitInput(context.Context, *SubmitInputGatewayRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitInput not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) CreatePendingInputUpload(context.Context, *CreatePendingInputUploadGatewayRequest) (*CreatePendingInputUploadGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePendingInputUpload not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) RepostActivity(context.Context, *RepostActivityGatewayRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method RepostActivity not implemented")
 }
+func (UnimplementedClientGatewayServiceServer) PreviewDescriptionMerge(context.Context, *DescriptionPreviewGatewayRequest) (*pipeline.DescriptionMergePreview, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewDescriptionMerge not implemented")
+}
 func (UnimplementedClientGatewayServiceServer) ListActivities(context.Context, *ListActivitiesGatewayRequest) (*ListActivitiesGatewayResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListActivities not implemented")
 }
@@ -1209,9 +1758,18 @@ func (UnimplementedClientGatewayServiceServer) RemoveShowcaseEntry(context.Conte
 func (UnimplementedClientGatewayServiceServer) GetShowcaseProfilePictureUploadUrl(context.Context, *GetPictureUploadUrlGatewayRequest) (*GetPictureUploadUrlGatewayResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetShowcaseProfilePictureUploadUrl not implemented")
 }
+func (UnimplementedClientGatewayServiceServer) CreateShowcaseEmbedLink(context.Context, *CreateShowcaseEmbedLinkGatewayRequest) (*CreateShowcaseEmbedLinkGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateShowcaseEmbedLink not implemented")
+}
 func (UnimplementedClientGatewayServiceServer) ExportData(context.Context, *EmptyRequest) (*ExportDataGatewayResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ExportData not implemented")
 }
+func (UnimplementedClientGatewayServiceServer) ExportArchive(context.Context, *ExportArchiveGatewayRequest) (*ExportArchiveGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportArchive not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) ExportUserData(context.Context, *EmptyRequest) (*ExportUserDataGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportUserData not implemented")
+}
 func (UnimplementedClientGatewayServiceServer) ParseFitFile(context.Context, *ParseFitFileGatewayRequest) (*activity.StandardizedActivity, error) {
 	return nil, status.Error(codes.Unimplemented, "method ParseFitFile not implemented")
 }
@@ -1489,460 +2047,1018 @@ func _ClientGatewayService_ListCounters_Handler(srv interface{}, ctx context.Con
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_ListCounters_FullMethodName,
+		FullMethod: ClientGatewayService_ListCounters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).ListCounters(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_UpdateCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCounterGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).UpdateCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_UpdateCounter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).UpdateCounter(ctx, req.(*UpdateCounterGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_DeleteCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CounterNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).DeleteCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_DeleteCounter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).DeleteCounter(ctx, req.(*CounterNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_GetBoosterData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).GetBoosterData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_GetBoosterData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).GetBoosterData(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_SetBoosterData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoosterDataGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).SetBoosterData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_SetBoosterData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).SetBoosterData(ctx, req.(*SetBoosterDataGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_DeleteBoosterData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoosterIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).DeleteBoosterData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_DeleteBoosterData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).DeleteBoosterData(ctx, req.(*BoosterIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_ListPersonalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).ListPersonalRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_ListPersonalRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).ListPersonalRecords(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_SetPersonalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPersonalRecordGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).SetPersonalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_SetPersonalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).SetPersonalRecord(ctx, req.(*SetPersonalRecordGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_DeletePersonalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).DeletePersonalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_DeletePersonalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).DeletePersonalRecord(ctx, req.(*RecordTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_ListGear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).ListGear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_ListGear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).ListGear(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_SetGear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGearGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).SetGear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_SetGear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).SetGear(ctx, req.(*SetGearGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_DeleteGear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GearIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).DeleteGear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_DeleteGear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).DeleteGear(ctx, req.(*GearIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_ListGoals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).ListGoals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_ListGoals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).ListGoals(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_SetGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGoalGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).SetGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_SetGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).SetGoal(ctx, req.(*SetGoalGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_DeleteGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoalIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).DeleteGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_DeleteGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).DeleteGoal(ctx, req.(*GoalIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_ListPluginDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).ListPluginDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_ListPluginDefaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).ListPluginDefaults(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_SetPluginDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPluginDefaultsGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).SetPluginDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_SetPluginDefaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).SetPluginDefaults(ctx, req.(*SetPluginDefaultsGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_DeletePluginDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).DeletePluginDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_DeletePluginDefaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).DeletePluginDefaults(ctx, req.(*PluginIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_SendVerificationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).SendVerificationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_SendVerificationEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).SendVerificationEmail(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_SendEmailChangeVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEmailChangeGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).SendEmailChangeVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_SendEmailChangeVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).SendEmailChangeVerification(ctx, req.(*SendEmailChangeGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_SendPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPasswordResetGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).SendPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_SendPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).SendPasswordReset(ctx, req.(*SendPasswordResetGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_SetFCMToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFCMTokenGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).SetFCMToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_SetFCMToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).SetFCMToken(ctx, req.(*SetFCMTokenGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_ListApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).ListApiKeys(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_CreateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_DeleteApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).DeleteApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_DeleteApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).DeleteApiKey(ctx, req.(*ApiKeyIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_MobileSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).MobileSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_MobileSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).MobileSync(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_ListPipelines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).ListPipelines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_ListPipelines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).ListPipelines(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_GetPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PipelineIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).GetPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_GetPipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).GetPipeline(ctx, req.(*PipelineIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePipelineGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).CreatePipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_CreatePipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).CreatePipeline(ctx, req.(*CreatePipelineGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_UpdatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePipelineGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).UpdatePipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_UpdatePipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).UpdatePipeline(ctx, req.(*UpdatePipelineGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_DeletePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PipelineIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).DeletePipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_DeletePipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).DeletePipeline(ctx, req.(*PipelineIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_ListPipelineRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPipelineRunsGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).ListPipelineRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_ListPipelineRuns_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).ListCounters(ctx, req.(*EmptyRequest))
+		return srv.(ClientGatewayServiceServer).ListPipelineRuns(ctx, req.(*ListPipelineRunsGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_UpdateCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCounterGatewayRequest)
+func _ClientGatewayService_GetPipelineRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineRunGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).UpdateCounter(ctx, in)
+		return srv.(ClientGatewayServiceServer).GetPipelineRun(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_UpdateCounter_FullMethodName,
+		FullMethod: ClientGatewayService_GetPipelineRun_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).UpdateCounter(ctx, req.(*UpdateCounterGatewayRequest))
+		return srv.(ClientGatewayServiceServer).GetPipelineRun(ctx, req.(*GetPipelineRunGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_DeleteCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CounterNameRequest)
+func _ClientGatewayService_GetPipelineRunDebugBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineRunGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).DeleteCounter(ctx, in)
+		return srv.(ClientGatewayServiceServer).GetPipelineRunDebugBundle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_DeleteCounter_FullMethodName,
+		FullMethod: ClientGatewayService_GetPipelineRunDebugBundle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).DeleteCounter(ctx, req.(*CounterNameRequest))
+		return srv.(ClientGatewayServiceServer).GetPipelineRunDebugBundle(ctx, req.(*GetPipelineRunGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_GetBoosterData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _ClientGatewayService_RetryPipelineRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryPipelineRunGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).GetBoosterData(ctx, in)
+		return srv.(ClientGatewayServiceServer).RetryPipelineRun(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_GetBoosterData_FullMethodName,
+		FullMethod: ClientGatewayService_RetryPipelineRun_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).GetBoosterData(ctx, req.(*EmptyRequest))
+		return srv.(ClientGatewayServiceServer).RetryPipelineRun(ctx, req.(*RetryPipelineRunGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_SetBoosterData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetBoosterDataGatewayRequest)
+func _ClientGatewayService_PausePipelines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PausePipelinesGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).SetBoosterData(ctx, in)
+		return srv.(ClientGatewayServiceServer).PausePipelines(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_SetBoosterData_FullMethodName,
+		FullMethod: ClientGatewayService_PausePipelines_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).SetBoosterData(ctx, req.(*SetBoosterDataGatewayRequest))
+		return srv.(ClientGatewayServiceServer).PausePipelines(ctx, req.(*PausePipelinesGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_DeleteBoosterData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BoosterIdRequest)
+func _ClientGatewayService_ResumePipelines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumePipelinesGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).DeleteBoosterData(ctx, in)
+		return srv.(ClientGatewayServiceServer).ResumePipelines(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_DeleteBoosterData_FullMethodName,
+		FullMethod: ClientGatewayService_ResumePipelines_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).DeleteBoosterData(ctx, req.(*BoosterIdRequest))
+		return srv.(ClientGatewayServiceServer).ResumePipelines(ctx, req.(*ResumePipelinesGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_ListPersonalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _ClientGatewayService_GetPipelineCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PipelineCalendarGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).ListPersonalRecords(ctx, in)
+		return srv.(ClientGatewayServiceServer).GetPipelineCalendar(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_ListPersonalRecords_FullMethodName,
+		FullMethod: ClientGatewayService_GetPipelineCalendar_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).ListPersonalRecords(ctx, req.(*EmptyRequest))
+		return srv.(ClientGatewayServiceServer).GetPipelineCalendar(ctx, req.(*PipelineCalendarGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_SetPersonalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetPersonalRecordGatewayRequest)
+func _ClientGatewayService_PreviewPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewPipelineGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).SetPersonalRecord(ctx, in)
+		return srv.(ClientGatewayServiceServer).PreviewPipeline(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_SetPersonalRecord_FullMethodName,
+		FullMethod: ClientGatewayService_PreviewPipeline_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).SetPersonalRecord(ctx, req.(*SetPersonalRecordGatewayRequest))
+		return srv.(ClientGatewayServiceServer).PreviewPipeline(ctx, req.(*PreviewPipelineGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_DeletePersonalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordTypeRequest)
+func _ClientGatewayService_GetEnricherUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnricherUsageGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).DeletePersonalRecord(ctx, in)
+		return srv.(ClientGatewayServiceServer).GetEnricherUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_DeletePersonalRecord_FullMethodName,
+		FullMethod: ClientGatewayService_GetEnricherUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).DeletePersonalRecord(ctx, req.(*RecordTypeRequest))
+		return srv.(ClientGatewayServiceServer).GetEnricherUsage(ctx, req.(*EnricherUsageGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_ListPluginDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ClientGatewayService_GetEnricherRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).ListPluginDefaults(ctx, in)
+		return srv.(ClientGatewayServiceServer).GetEnricherRecommendations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_ListPluginDefaults_FullMethodName,
+		FullMethod: ClientGatewayService_GetEnricherRecommendations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).ListPluginDefaults(ctx, req.(*EmptyRequest))
+		return srv.(ClientGatewayServiceServer).GetEnricherRecommendations(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_SetPluginDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetPluginDefaultsGatewayRequest)
+func _ClientGatewayService_CorrectActivityType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorrectActivityTypeGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).SetPluginDefaults(ctx, in)
+		return srv.(ClientGatewayServiceServer).CorrectActivityType(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_SetPluginDefaults_FullMethodName,
+		FullMethod: ClientGatewayService_CorrectActivityType_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).SetPluginDefaults(ctx, req.(*SetPluginDefaultsGatewayRequest))
+		return srv.(ClientGatewayServiceServer).CorrectActivityType(ctx, req.(*CorrectActivityTypeGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_DeletePluginDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PluginIdRequest)
+func _ClientGatewayService_ListActivityTypeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).DeletePluginDefaults(ctx, in)
+		return srv.(ClientGatewayServiceServer).ListActivityTypeRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_DeletePluginDefaults_FullMethodName,
+		FullMethod: ClientGatewayService_ListActivityTypeRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).DeletePluginDefaults(ctx, req.(*PluginIdRequest))
+		return srv.(ClientGatewayServiceServer).ListActivityTypeRules(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_SendVerificationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _ClientGatewayService_UpdateActivityTypeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivityTypeRuleGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).SendVerificationEmail(ctx, in)
+		return srv.(ClientGatewayServiceServer).UpdateActivityTypeRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_SendVerificationEmail_FullMethodName,
+		FullMethod: ClientGatewayService_UpdateActivityTypeRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).SendVerificationEmail(ctx, req.(*EmptyRequest))
+		return srv.(ClientGatewayServiceServer).UpdateActivityTypeRule(ctx, req.(*UpdateActivityTypeRuleGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_SendEmailChangeVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendEmailChangeGatewayRequest)
+func _ClientGatewayService_DeleteActivityTypeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivityTypeRuleIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).SendEmailChangeVerification(ctx, in)
+		return srv.(ClientGatewayServiceServer).DeleteActivityTypeRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_SendEmailChangeVerification_FullMethodName,
+		FullMethod: ClientGatewayService_DeleteActivityTypeRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).SendEmailChangeVerification(ctx, req.(*SendEmailChangeGatewayRequest))
+		return srv.(ClientGatewayServiceServer).DeleteActivityTypeRule(ctx, req.(*ActivityTypeRuleIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_SendPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendPasswordResetGatewayRequest)
+func _ClientGatewayService_StartBackfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartBackfillGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).SendPasswordReset(ctx, in)
+		return srv.(ClientGatewayServiceServer).StartBackfill(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_SendPasswordReset_FullMethodName,
+		FullMethod: ClientGatewayService_StartBackfill_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).SendPasswordReset(ctx, req.(*SendPasswordResetGatewayRequest))
+		return srv.(ClientGatewayServiceServer).StartBackfill(ctx, req.(*StartBackfillGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_SetFCMToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetFCMTokenGatewayRequest)
+func _ClientGatewayService_GetBackfillJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBackfillJobGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).SetFCMToken(ctx, in)
+		return srv.(ClientGatewayServiceServer).GetBackfillJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_SetFCMToken_FullMethodName,
+		FullMethod: ClientGatewayService_GetBackfillJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).SetFCMToken(ctx, req.(*SetFCMTokenGatewayRequest))
+		return srv.(ClientGatewayServiceServer).GetBackfillJob(ctx, req.(*GetBackfillJobGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_MobileSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _ClientGatewayService_CreateImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateImportGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).MobileSync(ctx, in)
+		return srv.(ClientGatewayServiceServer).CreateImport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_MobileSync_FullMethodName,
+		FullMethod: ClientGatewayService_CreateImport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).MobileSync(ctx, req.(*EmptyRequest))
+		return srv.(ClientGatewayServiceServer).CreateImport(ctx, req.(*CreateImportGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_ListPipelines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _ClientGatewayService_UploadImportFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadImportFileGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).ListPipelines(ctx, in)
+		return srv.(ClientGatewayServiceServer).UploadImportFile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_ListPipelines_FullMethodName,
+		FullMethod: ClientGatewayService_UploadImportFile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).ListPipelines(ctx, req.(*EmptyRequest))
+		return srv.(ClientGatewayServiceServer).UploadImportFile(ctx, req.(*UploadImportFileGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_GetPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PipelineIdRequest)
+func _ClientGatewayService_StartImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).GetPipeline(ctx, in)
+		return srv.(ClientGatewayServiceServer).StartImport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_GetPipeline_FullMethodName,
+		FullMethod: ClientGatewayService_StartImport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).GetPipeline(ctx, req.(*PipelineIdRequest))
+		return srv.(ClientGatewayServiceServer).StartImport(ctx, req.(*ImportGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePipelineGatewayRequest)
+func _ClientGatewayService_GetImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).CreatePipeline(ctx, in)
+		return srv.(ClientGatewayServiceServer).GetImport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_CreatePipeline_FullMethodName,
+		FullMethod: ClientGatewayService_GetImport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).CreatePipeline(ctx, req.(*CreatePipelineGatewayRequest))
+		return srv.(ClientGatewayServiceServer).GetImport(ctx, req.(*ImportGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_UpdatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePipelineGatewayRequest)
+func _ClientGatewayService_GetPlatformStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).UpdatePipeline(ctx, in)
+		return srv.(ClientGatewayServiceServer).GetPlatformStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_UpdatePipeline_FullMethodName,
+		FullMethod: ClientGatewayService_GetPlatformStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).UpdatePipeline(ctx, req.(*UpdatePipelineGatewayRequest))
+		return srv.(ClientGatewayServiceServer).GetPlatformStatus(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_DeletePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PipelineIdRequest)
+func _ClientGatewayService_ListPendingInputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).DeletePipeline(ctx, in)
+		return srv.(ClientGatewayServiceServer).ListPendingInputs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_DeletePipeline_FullMethodName,
+		FullMethod: ClientGatewayService_ListPendingInputs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).DeletePipeline(ctx, req.(*PipelineIdRequest))
+		return srv.(ClientGatewayServiceServer).ListPendingInputs(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_ListPipelineRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPipelineRunsGatewayRequest)
+func _ClientGatewayService_SubmitInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitInputGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).ListPipelineRuns(ctx, in)
+		return srv.(ClientGatewayServiceServer).SubmitInput(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_ListPipelineRuns_FullMethodName,
+		FullMethod: ClientGatewayService_SubmitInput_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).ListPipelineRuns(ctx, req.(*ListPipelineRunsGatewayRequest))
+		return srv.(ClientGatewayServiceServer).SubmitInput(ctx, req.(*SubmitInputGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_GetPipelineRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPipelineRunGatewayRequest)
+func _ClientGatewayService_CreatePendingInputUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePendingInputUploadGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).GetPipelineRun(ctx, in)
+		return srv.(ClientGatewayServiceServer).CreatePendingInputUpload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_GetPipelineRun_FullMethodName,
+		FullMethod: ClientGatewayService_CreatePendingInputUpload_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).GetPipelineRun(ctx, req.(*GetPipelineRunGatewayRequest))
+		return srv.(ClientGatewayServiceServer).CreatePendingInputUpload(ctx, req.(*CreatePendingInputUploadGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_SubmitInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitInputGatewayRequest)
+func _ClientGatewayService_RepostActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepostActivityGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).SubmitInput(ctx, in)
+		return srv.(ClientGatewayServiceServer).RepostActivity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_SubmitInput_FullMethodName,
+		FullMethod: ClientGatewayService_RepostActivity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).SubmitInput(ctx, req.(*SubmitInputGatewayRequest))
+		return srv.(ClientGatewayServiceServer).RepostActivity(ctx, req.(*RepostActivityGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientGatewayService_RepostActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RepostActivityGatewayRequest)
+func _ClientGatewayService_PreviewDescriptionMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescriptionPreviewGatewayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientGatewayServiceServer).RepostActivity(ctx, in)
+		return srv.(ClientGatewayServiceServer).PreviewDescriptionMerge(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClientGatewayService_RepostActivity_FullMethodName,
+		FullMethod: ClientGatewayService_PreviewDescriptionMerge_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientGatewayServiceServer).RepostActivity(ctx, req.(*RepostActivityGatewayRequest))
+		return srv.(ClientGatewayServiceServer).PreviewDescriptionMerge(ctx, req.(*DescriptionPreviewGatewayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2271,6 +3387,24 @@ func _ClientGatewayService_GetShowcaseProfilePictureUploadUrl_Handler(srv interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientGatewayService_CreateShowcaseEmbedLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShowcaseEmbedLinkGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).CreateShowcaseEmbedLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_CreateShowcaseEmbedLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).CreateShowcaseEmbedLink(ctx, req.(*CreateShowcaseEmbedLinkGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientGatewayService_ExportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
@@ -2289,6 +3423,42 @@ func _ClientGatewayService_ExportData_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientGatewayService_ExportArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportArchiveGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).ExportArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_ExportArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).ExportArchive(ctx, req.(*ExportArchiveGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).ExportUserData(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientGatewayService_ParseFitFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ParseFitFileGatewayRequest)
 	if err := dec(in); err != nil {
@@ -2664,6 +3834,30 @@ var ClientGatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePersonalRecord",
 			Handler:    _ClientGatewayService_DeletePersonalRecord_Handler,
 		},
+		{
+			MethodName: "ListGear",
+			Handler:    _ClientGatewayService_ListGear_Handler,
+		},
+		{
+			MethodName: "SetGear",
+			Handler:    _ClientGatewayService_SetGear_Handler,
+		},
+		{
+			MethodName: "DeleteGear",
+			Handler:    _ClientGatewayService_DeleteGear_Handler,
+		},
+		{
+			MethodName: "ListGoals",
+			Handler:    _ClientGatewayService_ListGoals_Handler,
+		},
+		{
+			MethodName: "SetGoal",
+			Handler:    _ClientGatewayService_SetGoal_Handler,
+		},
+		{
+			MethodName: "DeleteGoal",
+			Handler:    _ClientGatewayService_DeleteGoal_Handler,
+		},
 		{
 			MethodName: "ListPluginDefaults",
 			Handler:    _ClientGatewayService_ListPluginDefaults_Handler,
@@ -2692,6 +3886,18 @@ var ClientGatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetFCMToken",
 			Handler:    _ClientGatewayService_SetFCMToken_Handler,
 		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _ClientGatewayService_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _ClientGatewayService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "DeleteApiKey",
+			Handler:    _ClientGatewayService_DeleteApiKey_Handler,
+		},
 		{
 			MethodName: "MobileSync",
 			Handler:    _ClientGatewayService_MobileSync_Handler,
@@ -2724,14 +3930,102 @@ var ClientGatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPipelineRun",
 			Handler:    _ClientGatewayService_GetPipelineRun_Handler,
 		},
+		{
+			MethodName: "GetPipelineRunDebugBundle",
+			Handler:    _ClientGatewayService_GetPipelineRunDebugBundle_Handler,
+		},
+		{
+			MethodName: "RetryPipelineRun",
+			Handler:    _ClientGatewayService_RetryPipelineRun_Handler,
+		},
+		{
+			MethodName: "PausePipelines",
+			Handler:    _ClientGatewayService_PausePipelines_Handler,
+		},
+		{
+			MethodName: "ResumePipelines",
+			Handler:    _ClientGatewayService_ResumePipelines_Handler,
+		},
+		{
+			MethodName: "GetPipelineCalendar",
+			Handler:    _ClientGatewayService_GetPipelineCalendar_Handler,
+		},
+		{
+			MethodName: "PreviewPipeline",
+			Handler:    _ClientGatewayService_PreviewPipeline_Handler,
+		},
+		{
+			MethodName: "GetEnricherUsage",
+			Handler:    _ClientGatewayService_GetEnricherUsage_Handler,
+		},
+		{
+			MethodName: "GetEnricherRecommendations",
+			Handler:    _ClientGatewayService_GetEnricherRecommendations_Handler,
+		},
+		{
+			MethodName: "CorrectActivityType",
+			Handler:    _ClientGatewayService_CorrectActivityType_Handler,
+		},
+		{
+			MethodName: "ListActivityTypeRules",
+			Handler:    _ClientGatewayService_ListActivityTypeRules_Handler,
+		},
+		{
+			MethodName: "UpdateActivityTypeRule",
+			Handler:    _ClientGatewayService_UpdateActivityTypeRule_Handler,
+		},
+		{
+			MethodName: "DeleteActivityTypeRule",
+			Handler:    _ClientGatewayService_DeleteActivityTypeRule_Handler,
+		},
+		{
+			MethodName: "StartBackfill",
+			Handler:    _ClientGatewayService_StartBackfill_Handler,
+		},
+		{
+			MethodName: "GetBackfillJob",
+			Handler:    _ClientGatewayService_GetBackfillJob_Handler,
+		},
+		{
+			MethodName: "CreateImport",
+			Handler:    _ClientGatewayService_CreateImport_Handler,
+		},
+		{
+			MethodName: "UploadImportFile",
+			Handler:    _ClientGatewayService_UploadImportFile_Handler,
+		},
+		{
+			MethodName: "StartImport",
+			Handler:    _ClientGatewayService_StartImport_Handler,
+		},
+		{
+			MethodName: "GetImport",
+			Handler:    _ClientGatewayService_GetImport_Handler,
+		},
+		{
+			MethodName: "GetPlatformStatus",
+			Handler:    _ClientGatewayService_GetPlatformStatus_Handler,
+		},
+		{
+			MethodName: "ListPendingInputs",
+			Handler:    _ClientGatewayService_ListPendingInputs_Handler,
+		},
 		{
 			MethodName: "SubmitInput",
 			Handler:    _ClientGatewayService_SubmitInput_Handler,
 		},
+		{
+			MethodName: "CreatePendingInputUpload",
+			Handler:    _ClientGatewayService_CreatePendingInputUpload_Handler,
+		},
 		{
 			MethodName: "RepostActivity",
 			Handler:    _ClientGatewayService_RepostActivity_Handler,
 		},
+		{
+			MethodName: "PreviewDescriptionMerge",
+			Handler:    _ClientGatewayService_PreviewDescriptionMerge_Handler,
+		},
 		{
 			MethodName: "ListActivities",
 			Handler:    _ClientGatewayService_ListActivities_Handler,
@@ -2804,10 +4098,22 @@ var ClientGatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetShowcaseProfilePictureUploadUrl",
 			Handler:    _ClientGatewayService_GetShowcaseProfilePictureUploadUrl_Handler,
 		},
+		{
+			MethodName: "CreateShowcaseEmbedLink",
+			Handler:    _ClientGatewayService_CreateShowcaseEmbedLink_Handler,
+		},
 		{
 			MethodName: "ExportData",
 			Handler:    _ClientGatewayService_ExportData_Handler,
 		},
+		{
+			MethodName: "ExportArchive",
+			Handler:    _ClientGatewayService_ExportArchive_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _ClientGatewayService_ExportUserData_Handler,
+		},
 		{
 			MethodName: "ParseFitFile",
 			Handler:    _ClientGatewayService_ParseFitFile_Handler,
@@ -446,6 +446,378 @@ func (x *GetPublicShowcaseProfileResponse) GetCurrentPage() int32 {
 	return 0
 }
 
+type GetPublicShowcaseRouteStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicShowcaseRouteStatsRequest) Reset() {
+	*x = GetPublicShowcaseRouteStatsRequest{}
+	mi := &file_gateway_public_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicShowcaseRouteStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicShowcaseRouteStatsRequest) ProtoMessage() {}
+
+func (x *GetPublicShowcaseRouteStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_public_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicShowcaseRouteStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicShowcaseRouteStatsRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_public_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPublicShowcaseRouteStatsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type GetPublicShowcaseRouteStatsResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Routes        []*activity.ShowcaseRouteStats `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicShowcaseRouteStatsResponse) Reset() {
+	*x = GetPublicShowcaseRouteStatsResponse{}
+	mi := &file_gateway_public_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicShowcaseRouteStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicShowcaseRouteStatsResponse) ProtoMessage() {}
+
+func (x *GetPublicShowcaseRouteStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_public_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicShowcaseRouteStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicShowcaseRouteStatsResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_public_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPublicShowcaseRouteStatsResponse) GetRoutes() []*activity.ShowcaseRouteStats {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type GetPublicShowcaseFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // optional query param: "rss" (default) or "atom"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicShowcaseFeedRequest) Reset() {
+	*x = GetPublicShowcaseFeedRequest{}
+	mi := &file_gateway_public_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicShowcaseFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicShowcaseFeedRequest) ProtoMessage() {}
+
+func (x *GetPublicShowcaseFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_public_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicShowcaseFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicShowcaseFeedRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_public_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetPublicShowcaseFeedRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseFeedRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type GetPublicShowcaseFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicShowcaseFeedResponse) Reset() {
+	*x = GetPublicShowcaseFeedResponse{}
+	mi := &file_gateway_public_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicShowcaseFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicShowcaseFeedResponse) ProtoMessage() {}
+
+func (x *GetPublicShowcaseFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_public_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicShowcaseFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicShowcaseFeedResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_public_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPublicShowcaseFeedResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseFeedResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type GetPublicShowcaseComparisonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlugA         string                 `protobuf:"bytes,1,opt,name=slug_a,json=slugA,proto3" json:"slug_a,omitempty"`
+	SlugB         string                 `protobuf:"bytes,2,opt,name=slug_b,json=slugB,proto3" json:"slug_b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicShowcaseComparisonRequest) Reset() {
+	*x = GetPublicShowcaseComparisonRequest{}
+	mi := &file_gateway_public_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicShowcaseComparisonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicShowcaseComparisonRequest) ProtoMessage() {}
+
+func (x *GetPublicShowcaseComparisonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_public_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicShowcaseComparisonRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicShowcaseComparisonRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_public_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPublicShowcaseComparisonRequest) GetSlugA() string {
+	if x != nil {
+		return x.SlugA
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseComparisonRequest) GetSlugB() string {
+	if x != nil {
+		return x.SlugB
+	}
+	return ""
+}
+
+type GetPublicShowcaseEmbedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	ShowcaseId    string                 `protobuf:"bytes,2,opt,name=showcase_id,json=showcaseId,proto3" json:"showcase_id,omitempty"` // optional query param: the latest entry when empty
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`                           // optional query param: "svg" (default) or "html"
+	Expires       int64                  `protobuf:"varint,4,opt,name=expires,proto3" json:"expires,omitempty"`                        // signed link expiry, required for hidden profiles
+	Signature     string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`                     // signed link signature, required for hidden profiles
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicShowcaseEmbedRequest) Reset() {
+	*x = GetPublicShowcaseEmbedRequest{}
+	mi := &file_gateway_public_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicShowcaseEmbedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicShowcaseEmbedRequest) ProtoMessage() {}
+
+func (x *GetPublicShowcaseEmbedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_public_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicShowcaseEmbedRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicShowcaseEmbedRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_public_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPublicShowcaseEmbedRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseEmbedRequest) GetShowcaseId() string {
+	if x != nil {
+		return x.ShowcaseId
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseEmbedRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseEmbedRequest) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *GetPublicShowcaseEmbedRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type GetPublicShowcaseEmbedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicShowcaseEmbedResponse) Reset() {
+	*x = GetPublicShowcaseEmbedResponse{}
+	mi := &file_gateway_public_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicShowcaseEmbedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicShowcaseEmbedResponse) ProtoMessage() {}
+
+func (x *GetPublicShowcaseEmbedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_public_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicShowcaseEmbedResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicShowcaseEmbedResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_public_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetPublicShowcaseEmbedResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseEmbedResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
 var File_gateway_public_proto protoreflect.FileDescriptor
 
 const file_gateway_public_proto_rawDesc = "" +
@@ -474,7 +846,30 @@ const file_gateway_public_proto_rawDesc = "" +
 	"\tshowcases\x18\x02 \x03(\v2*.fitglue.models.activity.ShowcasedActivityR\tshowcases\x12\x1f\n" +
 	"\vtotal_pages\x18\x03 \x01(\x05R\n" +
 	"totalPages\x12!\n" +
-	"\fcurrent_page\x18\x04 \x01(\x05R\vcurrentPage2\xb9\a\n" +
+	"\fcurrent_page\x18\x04 \x01(\x05R\vcurrentPage\"8\n" +
+	"\"GetPublicShowcaseRouteStatsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"j\n" +
+	"#GetPublicShowcaseRouteStatsResponse\x12C\n" +
+	"\x06routes\x18\x01 \x03(\v2+.fitglue.models.activity.ShowcaseRouteStatsR\x06routes\"J\n" +
+	"\x1cGetPublicShowcaseFeedRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"V\n" +
+	"\x1dGetPublicShowcaseFeedResponse\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\"R\n" +
+	"\"GetPublicShowcaseComparisonRequest\x12\x15\n" +
+	"\x06slug_a\x18\x01 \x01(\tR\x05slugA\x12\x15\n" +
+	"\x06slug_b\x18\x02 \x01(\tR\x05slugB\"\xa4\x01\n" +
+	"\x1dGetPublicShowcaseEmbedRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x1f\n" +
+	"\vshowcase_id\x18\x02 \x01(\tR\n" +
+	"showcaseId\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x18\n" +
+	"\aexpires\x18\x04 \x01(\x03R\aexpires\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignature\"W\n" +
+	"\x1eGetPublicShowcaseEmbedResponse\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body2\xe0\f\n" +
 	"\x14PublicGatewayService\x12z\n" +
 	"\x11GetPluginRegistry\x12#.fitglue.gateway.PublicEmptyRequest\x1a-.fitglue.models.plugin.PluginRegistryResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/registry\x12\x7f\n" +
 	"\vListPlugins\x12).fitglue.gateway.ListPluginsPublicRequest\x1a*.fitglue.gateway.ListPluginsPublicResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/registry/plugins\x12{\n" +
@@ -482,7 +877,11 @@ const file_gateway_public_proto_rawDesc = "" +
 	"\x0eListCategories\x12#.fitglue.gateway.PublicEmptyRequest\x1a-.fitglue.gateway.ListCategoriesPublicResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/registry/categories\x12y\n" +
 	"\vListSources\x12#.fitglue.gateway.PublicEmptyRequest\x1a*.fitglue.gateway.ListSourcesPublicResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/registry/sources\x12\x82\x01\n" +
 	"\x11GetPublicShowcase\x12).fitglue.gateway.GetPublicShowcaseRequest\x1a*.fitglue.models.activity.ShowcasedActivity\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/showcase/{id}\x12\xa1\x01\n" +
-	"\x18GetPublicShowcaseProfile\x120.fitglue.gateway.GetPublicShowcaseProfileRequest\x1a1.fitglue.gateway.GetPublicShowcaseProfileResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/showcase/profile/{slug}B7Z5github.com/fitglue/server/src/go/pkg/types/pb/gatewayb\x06proto3"
+	"\x18GetPublicShowcaseProfile\x120.fitglue.gateway.GetPublicShowcaseProfileRequest\x1a1.fitglue.gateway.GetPublicShowcaseProfileResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/showcase/profile/{slug}\x12\xb1\x01\n" +
+	"\x1bGetPublicShowcaseRouteStats\x123.fitglue.gateway.GetPublicShowcaseRouteStatsRequest\x1a4.fitglue.gateway.GetPublicShowcaseRouteStatsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/showcase/profile/{slug}/routes\x12\x9d\x01\n" +
+	"\x15GetPublicShowcaseFeed\x12-.fitglue.gateway.GetPublicShowcaseFeedRequest\x1a..fitglue.gateway.GetPublicShowcaseFeedResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/showcase/profile/{slug}/feed\x12\xac\x01\n" +
+	"\x1bGetPublicShowcaseComparison\x123.fitglue.gateway.GetPublicShowcaseComparisonRequest\x1a+.fitglue.models.activity.ShowcaseComparison\"+\x82\xd3\xe4\x93\x02%\x12#/showcase/compare/{slug_a}/{slug_b}\x12\xa1\x01\n" +
+	"\x16GetPublicShowcaseEmbed\x12..fitglue.gateway.GetPublicShowcaseEmbedRequest\x1a/.fitglue.gateway.GetPublicShowcaseEmbedResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/showcase/profile/{slug}/embedB7Z5github.com/fitglue/server/src/go/pkg/types/pb/gatewayb\x06proto3"
 
 var (
 	file_gateway_public_proto_rawDescOnce sync.Once
@@ -496,46 +895,64 @@ func file_gateway_public_proto_rawDescGZIP() []byte {
 	return file_gateway_public_proto_rawDescData
 }
 
-var file_gateway_public_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_gateway_public_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_gateway_public_proto_goTypes = []any{
-	(*PublicEmptyRequest)(nil),               // 0: fitglue.gateway.PublicEmptyRequest
-	(*ListPluginsPublicRequest)(nil),         // 1: fitglue.gateway.ListPluginsPublicRequest
-	(*ListPluginsPublicResponse)(nil),        // 2: fitglue.gateway.ListPluginsPublicResponse
-	(*GetPluginPublicRequest)(nil),           // 3: fitglue.gateway.GetPluginPublicRequest
-	(*ListCategoriesPublicResponse)(nil),     // 4: fitglue.gateway.ListCategoriesPublicResponse
-	(*ListSourcesPublicResponse)(nil),        // 5: fitglue.gateway.ListSourcesPublicResponse
-	(*GetPublicShowcaseRequest)(nil),         // 6: fitglue.gateway.GetPublicShowcaseRequest
-	(*GetPublicShowcaseProfileRequest)(nil),  // 7: fitglue.gateway.GetPublicShowcaseProfileRequest
-	(*GetPublicShowcaseProfileResponse)(nil), // 8: fitglue.gateway.GetPublicShowcaseProfileResponse
-	(*plugin.PluginManifest)(nil),            // 9: fitglue.models.plugin.PluginManifest
-	(*activity.ShowcaseProfile)(nil),         // 10: fitglue.models.activity.ShowcaseProfile
-	(*activity.ShowcasedActivity)(nil),       // 11: fitglue.models.activity.ShowcasedActivity
-	(*plugin.PluginRegistryResponse)(nil),    // 12: fitglue.models.plugin.PluginRegistryResponse
+	(*PublicEmptyRequest)(nil),                  // 0: fitglue.gateway.PublicEmptyRequest
+	(*ListPluginsPublicRequest)(nil),            // 1: fitglue.gateway.ListPluginsPublicRequest
+	(*ListPluginsPublicResponse)(nil),           // 2: fitglue.gateway.ListPluginsPublicResponse
+	(*GetPluginPublicRequest)(nil),              // 3: fitglue.gateway.GetPluginPublicRequest
+	(*ListCategoriesPublicResponse)(nil),        // 4: fitglue.gateway.ListCategoriesPublicResponse
+	(*ListSourcesPublicResponse)(nil),           // 5: fitglue.gateway.ListSourcesPublicResponse
+	(*GetPublicShowcaseRequest)(nil),            // 6: fitglue.gateway.GetPublicShowcaseRequest
+	(*GetPublicShowcaseProfileRequest)(nil),     // 7: fitglue.gateway.GetPublicShowcaseProfileRequest
+	(*GetPublicShowcaseProfileResponse)(nil),    // 8: fitglue.gateway.GetPublicShowcaseProfileResponse
+	(*GetPublicShowcaseRouteStatsRequest)(nil),  // 9: fitglue.gateway.GetPublicShowcaseRouteStatsRequest
+	(*GetPublicShowcaseRouteStatsResponse)(nil), // 10: fitglue.gateway.GetPublicShowcaseRouteStatsResponse
+	(*GetPublicShowcaseFeedRequest)(nil),        // 11: fitglue.gateway.GetPublicShowcaseFeedRequest
+	(*GetPublicShowcaseFeedResponse)(nil),       // 12: fitglue.gateway.GetPublicShowcaseFeedResponse
+	(*GetPublicShowcaseComparisonRequest)(nil),  // 13: fitglue.gateway.GetPublicShowcaseComparisonRequest
+	(*GetPublicShowcaseEmbedRequest)(nil),       // 14: fitglue.gateway.GetPublicShowcaseEmbedRequest
+	(*GetPublicShowcaseEmbedResponse)(nil),      // 15: fitglue.gateway.GetPublicShowcaseEmbedResponse
+	(*plugin.PluginManifest)(nil),               // 16: fitglue.models.plugin.PluginManifest
+	(*activity.ShowcaseProfile)(nil),            // 17: fitglue.models.activity.ShowcaseProfile
+	(*activity.ShowcasedActivity)(nil),          // 18: fitglue.models.activity.ShowcasedActivity
+	(*activity.ShowcaseRouteStats)(nil),         // 19: fitglue.models.activity.ShowcaseRouteStats
+	(*plugin.PluginRegistryResponse)(nil),       // 20: fitglue.models.plugin.PluginRegistryResponse
+	(*activity.ShowcaseComparison)(nil),         // 21: fitglue.models.activity.ShowcaseComparison
 }
 var file_gateway_public_proto_depIdxs = []int32{
-	9,  // 0: fitglue.gateway.ListPluginsPublicResponse.plugins:type_name -> fitglue.models.plugin.PluginManifest
-	9,  // 1: fitglue.gateway.ListSourcesPublicResponse.sources:type_name -> fitglue.models.plugin.PluginManifest
-	10, // 2: fitglue.gateway.GetPublicShowcaseProfileResponse.profile:type_name -> fitglue.models.activity.ShowcaseProfile
-	11, // 3: fitglue.gateway.GetPublicShowcaseProfileResponse.showcases:type_name -> fitglue.models.activity.ShowcasedActivity
-	0,  // 4: fitglue.gateway.PublicGatewayService.GetPluginRegistry:input_type -> fitglue.gateway.PublicEmptyRequest
-	1,  // 5: fitglue.gateway.PublicGatewayService.ListPlugins:input_type -> fitglue.gateway.ListPluginsPublicRequest
-	3,  // 6: fitglue.gateway.PublicGatewayService.GetPlugin:input_type -> fitglue.gateway.GetPluginPublicRequest
-	0,  // 7: fitglue.gateway.PublicGatewayService.ListCategories:input_type -> fitglue.gateway.PublicEmptyRequest
-	0,  // 8: fitglue.gateway.PublicGatewayService.ListSources:input_type -> fitglue.gateway.PublicEmptyRequest
-	6,  // 9: fitglue.gateway.PublicGatewayService.GetPublicShowcase:input_type -> fitglue.gateway.GetPublicShowcaseRequest
-	7,  // 10: fitglue.gateway.PublicGatewayService.GetPublicShowcaseProfile:input_type -> fitglue.gateway.GetPublicShowcaseProfileRequest
-	12, // 11: fitglue.gateway.PublicGatewayService.GetPluginRegistry:output_type -> fitglue.models.plugin.PluginRegistryResponse
-	2,  // 12: fitglue.gateway.PublicGatewayService.ListPlugins:output_type -> fitglue.gateway.ListPluginsPublicResponse
-	9,  // 13: fitglue.gateway.PublicGatewayService.GetPlugin:output_type -> fitglue.models.plugin.PluginManifest
-	4,  // 14: fitglue.gateway.PublicGatewayService.ListCategories:output_type -> fitglue.gateway.ListCategoriesPublicResponse
-	5,  // 15: fitglue.gateway.PublicGatewayService.ListSources:output_type -> fitglue.gateway.ListSourcesPublicResponse
-	11, // 16: fitglue.gateway.PublicGatewayService.GetPublicShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
-	8,  // 17: fitglue.gateway.PublicGatewayService.GetPublicShowcaseProfile:output_type -> fitglue.gateway.GetPublicShowcaseProfileResponse
-	11, // [11:18] is the sub-list for method output_type
-	4,  // [4:11] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	16, // 0: fitglue.gateway.ListPluginsPublicResponse.plugins:type_name -> fitglue.models.plugin.PluginManifest
+	16, // 1: fitglue.gateway.ListSourcesPublicResponse.sources:type_name -> fitglue.models.plugin.PluginManifest
+	17, // 2: fitglue.gateway.GetPublicShowcaseProfileResponse.profile:type_name -> fitglue.models.activity.ShowcaseProfile
+	18, // 3: fitglue.gateway.GetPublicShowcaseProfileResponse.showcases:type_name -> fitglue.models.activity.ShowcasedActivity
+	19, // 4: fitglue.gateway.GetPublicShowcaseRouteStatsResponse.routes:type_name -> fitglue.models.activity.ShowcaseRouteStats
+	0,  // 5: fitglue.gateway.PublicGatewayService.GetPluginRegistry:input_type -> fitglue.gateway.PublicEmptyRequest
+	1,  // 6: fitglue.gateway.PublicGatewayService.ListPlugins:input_type -> fitglue.gateway.ListPluginsPublicRequest
+	3,  // 7: fitglue.gateway.PublicGatewayService.GetPlugin:input_type -> fitglue.gateway.GetPluginPublicRequest
+	0,  // 8: fitglue.gateway.PublicGatewayService.ListCategories:input_type -> fitglue.gateway.PublicEmptyRequest
+	0,  // 9: fitglue.gateway.PublicGatewayService.ListSources:input_type -> fitglue.gateway.PublicEmptyRequest
+	6,  // 10: fitglue.gateway.PublicGatewayService.GetPublicShowcase:input_type -> fitglue.gateway.GetPublicShowcaseRequest
+	7,  // 11: fitglue.gateway.PublicGatewayService.GetPublicShowcaseProfile:input_type -> fitglue.gateway.GetPublicShowcaseProfileRequest
+	9,  // 12: fitglue.gateway.PublicGatewayService.GetPublicShowcaseRouteStats:input_type -> fitglue.gateway.GetPublicShowcaseRouteStatsRequest
+	11, // 13: fitglue.gateway.PublicGatewayService.GetPublicShowcaseFeed:input_type -> fitglue.gateway.GetPublicShowcaseFeedRequest
+	13, // 14: fitglue.gateway.PublicGatewayService.GetPublicShowcaseComparison:input_type -> fitglue.gateway.GetPublicShowcaseComparisonRequest
+	14, // 15: fitglue.gateway.PublicGatewayService.GetPublicShowcaseEmbed:input_type -> fitglue.gateway.GetPublicShowcaseEmbedRequest
+	20, // 16: fitglue.gateway.PublicGatewayService.GetPluginRegistry:output_type -> fitglue.models.plugin.PluginRegistryResponse
+	2,  // 17: fitglue.gateway.PublicGatewayService.ListPlugins:output_type -> fitglue.gateway.ListPluginsPublicResponse
+	16, // 18: fitglue.gateway.PublicGatewayService.GetPlugin:output_type -> fitglue.models.plugin.PluginManifest
+	4,  // 19: fitglue.gateway.PublicGatewayService.ListCategories:output_type -> fitglue.gateway.ListCategoriesPublicResponse
+	5,  // 20: fitglue.gateway.PublicGatewayService.ListSources:output_type -> fitglue.gateway.ListSourcesPublicResponse
+	18, // 21: fitglue.gateway.PublicGatewayService.GetPublicShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
+	8,  // 22: fitglue.gateway.PublicGatewayService.GetPublicShowcaseProfile:output_type -> fitglue.gateway.GetPublicShowcaseProfileResponse
+	10, // 23: fitglue.gateway.PublicGatewayService.GetPublicShowcaseRouteStats:output_type -> fitglue.gateway.GetPublicShowcaseRouteStatsResponse
+	12, // 24: fitglue.gateway.PublicGatewayService.GetPublicShowcaseFeed:output_type -> fitglue.gateway.GetPublicShowcaseFeedResponse
+	21, // 25: fitglue.gateway.PublicGatewayService.GetPublicShowcaseComparison:output_type -> fitglue.models.activity.ShowcaseComparison
+	15, // 26: fitglue.gateway.PublicGatewayService.GetPublicShowcaseEmbed:output_type -> fitglue.gateway.GetPublicShowcaseEmbedResponse
+	16, // [16:27] is the sub-list for method output_type
+	5,  // [5:16] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_gateway_public_proto_init() }
@@ -549,7 +966,7 @@ func file_gateway_public_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gateway_public_proto_rawDesc), len(file_gateway_public_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,13 +21,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PublicGatewayService_GetPluginRegistry_FullMethodName        = "/fitglue.gateway.PublicGatewayService/GetPluginRegistry"
-	PublicGatewayService_ListPlugins_FullMethodName              = "/fitglue.gateway.PublicGatewayService/ListPlugins"
-	PublicGatewayService_GetPlugin_FullMethodName                = "/fitglue.gateway.PublicGatewayService/GetPlugin"
-	PublicGatewayService_ListCategories_FullMethodName           = "/fitglue.gateway.PublicGatewayService/ListCategories"
-	PublicGatewayService_ListSources_FullMethodName              = "/fitglue.gateway.PublicGatewayService/ListSources"
-	PublicGatewayService_GetPublicShowcase_FullMethodName        = "/fitglue.gateway.PublicGatewayService/GetPublicShowcase"
-	PublicGatewayService_GetPublicShowcaseProfile_FullMethodName = "/fitglue.gateway.PublicGatewayService/GetPublicShowcaseProfile"
+	PublicGatewayService_GetPluginRegistry_FullMethodName           = "/fitglue.gateway.PublicGatewayService/GetPluginRegistry"
+	PublicGatewayService_ListPlugins_FullMethodName                 = "/fitglue.gateway.PublicGatewayService/ListPlugins"
+	PublicGatewayService_GetPlugin_FullMethodName                   = "/fitglue.gateway.PublicGatewayService/GetPlugin"
+	PublicGatewayService_ListCategories_FullMethodName              = "/fitglue.gateway.PublicGatewayService/ListCategories"
+	PublicGatewayService_ListSources_FullMethodName                 = "/fitglue.gateway.PublicGatewayService/ListSources"
+	PublicGatewayService_GetPublicShowcase_FullMethodName           = "/fitglue.gateway.PublicGatewayService/GetPublicShowcase"
+	PublicGatewayService_GetPublicShowcaseProfile_FullMethodName    = "/fitglue.gateway.PublicGatewayService/GetPublicShowcaseProfile"
+	PublicGatewayService_GetPublicShowcaseRouteStats_FullMethodName = "/fitglue.gateway.PublicGatewayService/GetPublicShowcaseRouteStats"
+	PublicGatewayService_GetPublicShowcaseFeed_FullMethodName       = "/fitglue.gateway.PublicGatewayService/GetPublicShowcaseFeed"
+	PublicGatewayService_GetPublicShowcaseComparison_FullMethodName = "/fitglue.gateway.PublicGatewayService/GetPublicShowcaseComparison"
+	PublicGatewayService_GetPublicShowcaseEmbed_FullMethodName      = "/fitglue.gateway.PublicGatewayService/GetPublicShowcaseEmbed"
 )
 
 // PublicGatewayServiceClient is the client API for PublicGatewayService service.
@@ -49,6 +53,10 @@ type PublicGatewayServiceClient interface {
 	// ===================== Public Showcase =====================
 	GetPublicShowcase(ctx context.Context, in *GetPublicShowcaseRequest, opts ...grpc.CallOption) (*activity.ShowcasedActivity, error)
 	GetPublicShowcaseProfile(ctx context.Context, in *GetPublicShowcaseProfileRequest, opts ...grpc.CallOption) (*GetPublicShowcaseProfileResponse, error)
+	GetPublicShowcaseRouteStats(ctx context.Context, in *GetPublicShowcaseRouteStatsRequest, opts ...grpc.CallOption) (*GetPublicShowcaseRouteStatsResponse, error)
+	GetPublicShowcaseFeed(ctx context.Context, in *GetPublicShowcaseFeedRequest, opts ...grpc.CallOption) (*GetPublicShowcaseFeedResponse, error)
+	GetPublicShowcaseComparison(ctx context.Context, in *GetPublicShowcaseComparisonRequest, opts ...grpc.CallOption) (*activity.ShowcaseComparison, error)
+	GetPublicShowcaseEmbed(ctx context.Context, in *GetPublicShowcaseEmbedRequest, opts ...grpc.CallOption) (*GetPublicShowcaseEmbedResponse, error)
 }
 
 type publicGatewayServiceClient struct {
@@ -129,6 +137,46 @@ func (c *publicGatewayServiceClient) GetPublicShowcaseProfile(ctx context.Contex
 	return out, nil
 }
 
+func (c *publicGatewayServiceClient) GetPublicShowcaseRouteStats(ctx context.Context, in *GetPublicShowcaseRouteStatsRequest, opts ...grpc.CallOption) (*GetPublicShowcaseRouteStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicShowcaseRouteStatsResponse)
+	err := c.cc.Invoke(ctx, PublicGatewayService_GetPublicShowcaseRouteStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicGatewayServiceClient) GetPublicShowcaseFeed(ctx context.Context, in *GetPublicShowcaseFeedRequest, opts ...grpc.CallOption) (*GetPublicShowcaseFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicShowcaseFeedResponse)
+	err := c.cc.Invoke(ctx, PublicGatewayService_GetPublicShowcaseFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicGatewayServiceClient) GetPublicShowcaseComparison(ctx context.Context, in *GetPublicShowcaseComparisonRequest, opts ...grpc.CallOption) (*activity.ShowcaseComparison, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(activity.ShowcaseComparison)
+	err := c.cc.Invoke(ctx, PublicGatewayService_GetPublicShowcaseComparison_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicGatewayServiceClient) GetPublicShowcaseEmbed(ctx context.Context, in *GetPublicShowcaseEmbedRequest, opts ...grpc.CallOption) (*GetPublicShowcaseEmbedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicShowcaseEmbedResponse)
+	err := c.cc.Invoke(ctx, PublicGatewayService_GetPublicShowcaseEmbed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PublicGatewayServiceServer is the server API for PublicGatewayService service.
 // All implementations must embed UnimplementedPublicGatewayServiceServer
 // for forward compatibility.
@@ -148,6 +196,10 @@ type PublicGatewayServiceServer interface {
 	// ===================== Public Showcase =====================
 	GetPublicShowcase(context.Context, *GetPublicShowcaseRequest) (*activity.ShowcasedActivity, error)
 	GetPublicShowcaseProfile(context.Context, *GetPublicShowcaseProfileRequest) (*GetPublicShowcaseProfileResponse, error)
+	GetPublicShowcaseRouteStats(context.Context, *GetPublicShowcaseRouteStatsRequest) (*GetPublicShowcaseRouteStatsResponse, error)
+	GetPublicShowcaseFeed(context.Context, *GetPublicShowcaseFeedRequest) (*GetPublicShowcaseFeedResponse, error)
+	GetPublicShowcaseComparison(context.Context, *GetPublicShowcaseComparisonRequest) (*activity.ShowcaseComparison, error)
+	GetPublicShowcaseEmbed(context.Context, *GetPublicShowcaseEmbedRequest) (*GetPublicShowcaseEmbedResponse, error)
 	mustEmbedUnimplementedPublicGatewayServiceServer()
 }
 
@@ -179,6 +231,18 @@ func (UnimplementedPublicGatewayServiceServer) GetPublicShowcase(context.Context
 func (UnimplementedPublicGatewayServiceServer) GetPublicShowcaseProfile(context.Context, *GetPublicShowcaseProfileRequest) (*GetPublicShowcaseProfileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPublicShowcaseProfile not implemented")
 }
+func (UnimplementedPublicGatewayServiceServer) GetPublicShowcaseRouteStats(context.Context, *GetPublicShowcaseRouteStatsRequest) (*GetPublicShowcaseRouteStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPublicShowcaseRouteStats not implemented")
+}
+func (UnimplementedPublicGatewayServiceServer) GetPublicShowcaseFeed(context.Context, *GetPublicShowcaseFeedRequest) (*GetPublicShowcaseFeedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPublicShowcaseFeed not implemented")
+}
+func (UnimplementedPublicGatewayServiceServer) GetPublicShowcaseComparison(context.Context, *GetPublicShowcaseComparisonRequest) (*activity.ShowcaseComparison, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPublicShowcaseComparison not implemented")
+}
+func (UnimplementedPublicGatewayServiceServer) GetPublicShowcaseEmbed(context.Context, *GetPublicShowcaseEmbedRequest) (*GetPublicShowcaseEmbedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPublicShowcaseEmbed not implemented")
+}
 func (UnimplementedPublicGatewayServiceServer) mustEmbedUnimplementedPublicGatewayServiceServer() {}
 func (UnimplementedPublicGatewayServiceServer) testEmbeddedByValue()                              {}
 
@@ -326,6 +390,78 @@ func _PublicGatewayService_GetPublicShowcaseProfile_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicGatewayService_GetPublicShowcaseRouteStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicShowcaseRouteStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicGatewayServiceServer).GetPublicShowcaseRouteStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublicGatewayService_GetPublicShowcaseRouteStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicGatewayServiceServer).GetPublicShowcaseRouteStats(ctx, req.(*GetPublicShowcaseRouteStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicGatewayService_GetPublicShowcaseFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicShowcaseFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicGatewayServiceServer).GetPublicShowcaseFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublicGatewayService_GetPublicShowcaseFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicGatewayServiceServer).GetPublicShowcaseFeed(ctx, req.(*GetPublicShowcaseFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicGatewayService_GetPublicShowcaseComparison_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicShowcaseComparisonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicGatewayServiceServer).GetPublicShowcaseComparison(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublicGatewayService_GetPublicShowcaseComparison_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicGatewayServiceServer).GetPublicShowcaseComparison(ctx, req.(*GetPublicShowcaseComparisonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicGatewayService_GetPublicShowcaseEmbed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicShowcaseEmbedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicGatewayServiceServer).GetPublicShowcaseEmbed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublicGatewayService_GetPublicShowcaseEmbed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicGatewayServiceServer).GetPublicShowcaseEmbed(ctx, req.(*GetPublicShowcaseEmbedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PublicGatewayService_ServiceDesc is the grpc.ServiceDesc for PublicGatewayService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -361,6 +497,22 @@ var PublicGatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPublicShowcaseProfile",
 			Handler:    _PublicGatewayService_GetPublicShowcaseProfile_Handler,
 		},
+		{
+			MethodName: "GetPublicShowcaseRouteStats",
+			Handler:    _PublicGatewayService_GetPublicShowcaseRouteStats_Handler,
+		},
+		{
+			MethodName: "GetPublicShowcaseFeed",
+			Handler:    _PublicGatewayService_GetPublicShowcaseFeed_Handler,
+		},
+		{
+			MethodName: "GetPublicShowcaseComparison",
+			Handler:    _PublicGatewayService_GetPublicShowcaseComparison_Handler,
+		},
+		{
+			MethodName: "GetPublicShowcaseEmbed",
+			Handler:    _PublicGatewayService_GetPublicShowcaseEmbed_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gateway/public.proto",
@@ -42,6 +42,8 @@ const (
 	ActivitySource_SOURCE_TRAININGPEAKS   ActivitySource = 14
 	ActivitySource_SOURCE_GOOGLESHEETS    ActivitySource = 15
 	ActivitySource_SOURCE_GITHUB          ActivitySource = 16
+	ActivitySource_SOURCE_WHOOP           ActivitySource = 17
+	ActivitySource_SOURCE_ZWIFT           ActivitySource = 18
 	ActivitySource_SOURCE_TEST            ActivitySource = 99
 )
 
@@ -64,6 +66,8 @@ var (
 		14: "SOURCE_TRAININGPEAKS",
 		15: "SOURCE_GOOGLESHEETS",
 		16: "SOURCE_GITHUB",
+		17: "SOURCE_WHOOP",
+		18: "SOURCE_ZWIFT",
 		99: "SOURCE_TEST",
 	}
 	ActivitySource_value = map[string]int32{
@@ -83,6 +87,8 @@ var (
 		"SOURCE_TRAININGPEAKS":   14,
 		"SOURCE_GOOGLESHEETS":    15,
 		"SOURCE_GITHUB":          16,
+		"SOURCE_WHOOP":           17,
+		"SOURCE_ZWIFT":           18,
 		"SOURCE_TEST":            99,
 	}
 )
@@ -403,7 +409,7 @@ const file_models_activity_source_proto_rawDesc = "" +
 	"\x06source\x18\x01 \x01(\x0e2'.fitglue.models.activity.ActivitySourceR\x06source\x12\x1f\n" +
 	"\vexternal_id\x18\x02 \x01(\tR\n" +
 	"externalId\x12=\n" +
-	"\fprocessed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt*\xb2\x04\n" +
+	"\fprocessed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt*\xd6\x04\n" +
 	"\x0eActivitySource\x12\x16\n" +
 	"\x12SOURCE_UNSPECIFIED\x10\x00\x12%\n" +
 	"\vSOURCE_HEVY\x10\x01\x1a\x14\xa2\xb6\x18\x10DESTINATION_HEVY\x12)\n" +
@@ -421,7 +427,9 @@ const file_models_activity_source_proto_rawDesc = "" +
 	"\x10SOURCE_INTERVALS\x10\r\x1a\x19\xa2\xb6\x18\x15DESTINATION_INTERVALS\x127\n" +
 	"\x14SOURCE_TRAININGPEAKS\x10\x0e\x1a\x1d\xa2\xb6\x18\x19DESTINATION_TRAININGPEAKS\x125\n" +
 	"\x13SOURCE_GOOGLESHEETS\x10\x0f\x1a\x1c\xa2\xb6\x18\x18DESTINATION_GOOGLESHEETS\x12)\n" +
-	"\rSOURCE_GITHUB\x10\x10\x1a\x16\xa2\xb6\x18\x12DESTINATION_GITHUB\x12\x0f\n" +
+	"\rSOURCE_GITHUB\x10\x10\x1a\x16\xa2\xb6\x18\x12DESTINATION_GITHUB\x12\x10\n" +
+	"\fSOURCE_WHOOP\x10\x11\x12\x10\n" +
+	"\fSOURCE_ZWIFT\x10\x12\x12\x0f\n" +
 	"\vSOURCE_TEST\x10c*\xea\x11\n" +
 	"\fActivityType\x12\x1d\n" +
 	"\x19ACTIVITY_TYPE_UNSPECIFIED\x10\x00\x12+\n" +
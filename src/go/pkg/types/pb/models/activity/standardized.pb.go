@@ -140,6 +140,7 @@ type StandardizedActivity struct {
 	TimeMarkers       []*TimeMarker          `protobuf:"bytes,11,rep,name=time_markers,json=timeMarkers,proto3" json:"time_markers,omitempty"`
 	Workout           *WorkoutDefinition     `protobuf:"bytes,12,opt,name=workout,proto3,oneof" json:"workout,omitempty"`
 	HybridRaceSummary *HybridRaceSummary     `protobuf:"bytes,13,opt,name=hybrid_race_summary,json=hybridRaceSummary,proto3,oneof" json:"hybrid_race_summary,omitempty"`
+	DataQualityScore  *int32                 `protobuf:"varint,14,opt,name=data_quality_score,json=dataQualityScore,proto3,oneof" json:"data_quality_score,omitempty"` // 0-100, from the data quality enricher; gates PR detection
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -265,6 +266,13 @@ func (x *StandardizedActivity) GetHybridRaceSummary() *HybridRaceSummary {
 	return nil
 }
 
+func (x *StandardizedActivity) GetDataQualityScore() int32 {
+	if x != nil && x.DataQualityScore != nil {
+		return *x.DataQualityScore
+	}
+	return 0
+}
+
 type HybridRaceSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Segments      []*HybridRaceSegment   `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
@@ -391,6 +399,9 @@ type TimeMarker struct {
 	Label           string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
 	MarkerType      string                 `protobuf:"bytes,3,opt,name=marker_type,json=markerType,proto3" json:"marker_type,omitempty"`
 	DurationSeconds int32                  `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	AssetUrl        string                 `protobuf:"bytes,5,opt,name=asset_url,json=assetUrl,proto3" json:"asset_url,omitempty"` // e.g. a photo placed at this point
+	PositionLat     *float64               `protobuf:"fixed64,6,opt,name=position_lat,json=positionLat,proto3,oneof" json:"position_lat,omitempty"`
+	PositionLong    *float64               `protobuf:"fixed64,7,opt,name=position_long,json=positionLong,proto3,oneof" json:"position_long,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -453,18 +464,43 @@ func (x *TimeMarker) GetDurationSeconds() int32 {
 	return 0
 }
 
+func (x *TimeMarker) GetAssetUrl() string {
+	if x != nil {
+		return x.AssetUrl
+	}
+	return ""
+}
+
+func (x *TimeMarker) GetPositionLat() float64 {
+	if x != nil && x.PositionLat != nil {
+		return *x.PositionLat
+	}
+	return 0
+}
+
+func (x *TimeMarker) GetPositionLong() float64 {
+	if x != nil && x.PositionLong != nil {
+		return *x.PositionLong
+	}
+	return 0
+}
+
 type Session struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	StartTime        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	TotalElapsedTime float64                `protobuf:"fixed64,2,opt,name=total_elapsed_time,json=totalElapsedTime,proto3" json:"total_elapsed_time,omitempty"`
-	TotalDistance    float64                `protobuf:"fixed64,3,opt,name=total_distance,json=totalDistance,proto3" json:"total_distance,omitempty"`
-	Laps             []*Lap                 `protobuf:"bytes,4,rep,name=laps,proto3" json:"laps,omitempty"`
-	StrengthSets     []*StrengthSet         `protobuf:"bytes,5,rep,name=strength_sets,json=strengthSets,proto3" json:"strength_sets,omitempty"`
-	TotalCalories    *float64               `protobuf:"fixed64,6,opt,name=total_calories,json=totalCalories,proto3,oneof" json:"total_calories,omitempty"`
-	AvgHeartRate     *int32                 `protobuf:"varint,7,opt,name=avg_heart_rate,json=avgHeartRate,proto3,oneof" json:"avg_heart_rate,omitempty"`
-	MaxHeartRate     *int32                 `protobuf:"varint,8,opt,name=max_heart_rate,json=maxHeartRate,proto3,oneof" json:"max_heart_rate,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	StartTime          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TotalElapsedTime   float64                `protobuf:"fixed64,2,opt,name=total_elapsed_time,json=totalElapsedTime,proto3" json:"total_elapsed_time,omitempty"`
+	TotalDistance      float64                `protobuf:"fixed64,3,opt,name=total_distance,json=totalDistance,proto3" json:"total_distance,omitempty"`
+	Laps               []*Lap                 `protobuf:"bytes,4,rep,name=laps,proto3" json:"laps,omitempty"`
+	StrengthSets       []*StrengthSet         `protobuf:"bytes,5,rep,name=strength_sets,json=strengthSets,proto3" json:"strength_sets,omitempty"`
+	TotalCalories      *float64               `protobuf:"fixed64,6,opt,name=total_calories,json=totalCalories,proto3,oneof" json:"total_calories,omitempty"`
+	AvgHeartRate       *int32                 `protobuf:"varint,7,opt,name=avg_heart_rate,json=avgHeartRate,proto3,oneof" json:"avg_heart_rate,omitempty"`
+	MaxHeartRate       *int32                 `protobuf:"varint,8,opt,name=max_heart_rate,json=maxHeartRate,proto3,oneof" json:"max_heart_rate,omitempty"`
+	TotalWork          *float64               `protobuf:"fixed64,9,opt,name=total_work,json=totalWork,proto3,oneof" json:"total_work,omitempty"`                                 // Joules of mechanical work, from power data
+	PowerEstimated     *bool                  `protobuf:"varint,10,opt,name=power_estimated,json=powerEstimated,proto3,oneof" json:"power_estimated,omitempty"`                  // Power was estimated (e.g. running power from pace) rather than measured
+	TimeInHrZone       []float64              `protobuf:"fixed64,11,rep,packed,name=time_in_hr_zone,json=timeInHrZone,proto3" json:"time_in_hr_zone,omitempty"`                  // Seconds in each heart rate zone, lowest zone first
+	HrZoneHighBoundary []int32                `protobuf:"varint,12,rep,packed,name=hr_zone_high_boundary,json=hrZoneHighBoundary,proto3" json:"hr_zone_high_boundary,omitempty"` // Upper bpm of each zone in time_in_hr_zone
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Session) Reset() {
@@ -553,6 +589,34 @@ func (x *Session) GetMaxHeartRate() int32 {
 	return 0
 }
 
+func (x *Session) GetTotalWork() float64 {
+	if x != nil && x.TotalWork != nil {
+		return *x.TotalWork
+	}
+	return 0
+}
+
+func (x *Session) GetPowerEstimated() bool {
+	if x != nil && x.PowerEstimated != nil {
+		return *x.PowerEstimated
+	}
+	return false
+}
+
+func (x *Session) GetTimeInHrZone() []float64 {
+	if x != nil {
+		return x.TimeInHrZone
+	}
+	return nil
+}
+
+func (x *Session) GetHrZoneHighBoundary() []int32 {
+	if x != nil {
+		return x.HrZoneHighBoundary
+	}
+	return nil
+}
+
 type Lap struct {
 	state                    protoimpl.MessageState `protogen:"open.v1"`
 	StartTime                *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
@@ -659,7 +723,9 @@ type Record struct {
 	VerticalOscillation *int32                 `protobuf:"varint,10,opt,name=vertical_oscillation,json=verticalOscillation,proto3,oneof" json:"vertical_oscillation,omitempty"`
 	VerticalRatio       *int32                 `protobuf:"varint,11,opt,name=vertical_ratio,json=verticalRatio,proto3,oneof" json:"vertical_ratio,omitempty"`
 	StepLength          *float64               `protobuf:"fixed64,12,opt,name=step_length,json=stepLength,proto3,oneof" json:"step_length,omitempty"`
-	Distance            float64                `protobuf:"fixed64,13,opt,name=distance,proto3" json:"distance,omitempty"` // Cumulative distance in meters from activity start
+	Distance            float64                `protobuf:"fixed64,13,opt,name=distance,proto3" json:"distance,omitempty"`                                            // Cumulative distance in meters from activity start
+	Temperature         *int32                 `protobuf:"varint,14,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`                                 // Degrees Celsius; optional because 0 is a valid reading
+	CoreTemperature     *float64               `protobuf:"fixed64,15,opt,name=core_temperature,json=coreTemperature,proto3,oneof" json:"core_temperature,omitempty"` // Core body temperature in degrees Celsius (e.g. CORE sensor)
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -785,6 +851,20 @@ func (x *Record) GetDistance() float64 {
 	return 0
 }
 
+func (x *Record) GetTemperature() int32 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *Record) GetCoreTemperature() float64 {
+	if x != nil && x.CoreTemperature != nil {
+		return *x.CoreTemperature
+	}
+	return 0
+}
+
 type StrengthSet struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	ExerciseName          string                 `protobuf:"bytes,1,opt,name=exercise_name,json=exerciseName,proto3" json:"exercise_name,omitempty"`
@@ -798,8 +878,11 @@ type StrengthSet struct {
 	SecondaryMuscleGroups []MuscleGroup          `protobuf:"varint,9,rep,packed,name=secondary_muscle_groups,json=secondaryMuscleGroups,proto3,enum=fitglue.models.activity.MuscleGroup" json:"secondary_muscle_groups,omitempty"`
 	DistanceMeters        float64                `protobuf:"fixed64,10,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`
 	SetType               string                 `protobuf:"bytes,11,opt,name=set_type,json=setType,proto3" json:"set_type,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// When the set was finished. With start_time it places the set on the
+	// activity timeline; the gap to the next set is rest.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StrengthSet) Reset() {
@@ -909,6 +992,13 @@ func (x *StrengthSet) GetSetType() string {
 	return ""
 }
 
+func (x *StrengthSet) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
 type WorkoutDefinition struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1049,7 +1139,7 @@ var File_models_activity_standardized_proto protoreflect.FileDescriptor
 
 const file_models_activity_standardized_proto_rawDesc = "" +
 	"\n" +
-	"\"models/activity/standardized.proto\x12\x17fitglue.models.activity\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmodels/activity/source.proto\"\xbd\x05\n" +
+	"\"models/activity/standardized.proto\x12\x17fitglue.models.activity\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmodels/activity/source.proto\"\x87\x06\n" +
 	"\x14StandardizedActivity\x12?\n" +
 	"\x06source\x18\x01 \x01(\x0e2'.fitglue.models.activity.ActivitySourceR\x06source\x12\x1f\n" +
 	"\vexternal_id\x18\x02 \x01(\tR\n" +
@@ -1066,10 +1156,12 @@ const file_models_activity_standardized_proto_rawDesc = "" +
 	" \x01(\tR\x05notes\x12F\n" +
 	"\ftime_markers\x18\v \x03(\v2#.fitglue.models.activity.TimeMarkerR\vtimeMarkers\x12I\n" +
 	"\aworkout\x18\f \x01(\v2*.fitglue.models.activity.WorkoutDefinitionH\x00R\aworkout\x88\x01\x01\x12_\n" +
-	"\x13hybrid_race_summary\x18\r \x01(\v2*.fitglue.models.activity.HybridRaceSummaryH\x01R\x11hybridRaceSummary\x88\x01\x01B\n" +
+	"\x13hybrid_race_summary\x18\r \x01(\v2*.fitglue.models.activity.HybridRaceSummaryH\x01R\x11hybridRaceSummary\x88\x01\x01\x121\n" +
+	"\x12data_quality_score\x18\x0e \x01(\x05H\x02R\x10dataQualityScore\x88\x01\x01B\n" +
 	"\n" +
 	"\b_workoutB\x16\n" +
-	"\x14_hybrid_race_summary\"[\n" +
+	"\x14_hybrid_race_summaryB\x15\n" +
+	"\x13_data_quality_score\"[\n" +
 	"\x11HybridRaceSummary\x12F\n" +
 	"\bsegments\x18\x01 \x03(\v2*.fitglue.models.activity.HybridRaceSegmentR\bsegments\"\xba\x01\n" +
 	"\x11HybridRaceSegment\x129\n" +
@@ -1078,14 +1170,19 @@ const file_models_activity_standardized_proto_rawDesc = "" +
 	"\x10duration_seconds\x18\x02 \x01(\x05R\x0fdurationSeconds\x12\x14\n" +
 	"\x05label\x18\x03 \x01(\tR\x05label\x12\x12\n" +
 	"\x04icon\x18\x04 \x01(\tR\x04icon\x12\x15\n" +
-	"\x06is_run\x18\x05 \x01(\bR\x05isRun\"\xa8\x01\n" +
+	"\x06is_run\x18\x05 \x01(\bR\x05isRun\"\xba\x02\n" +
 	"\n" +
 	"TimeMarker\x128\n" +
 	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1f\n" +
 	"\vmarker_type\x18\x03 \x01(\tR\n" +
 	"markerType\x12)\n" +
-	"\x10duration_seconds\x18\x04 \x01(\x05R\x0fdurationSeconds\"\xd1\x03\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x05R\x0fdurationSeconds\x12\x1b\n" +
+	"\tasset_url\x18\x05 \x01(\tR\bassetUrl\x12&\n" +
+	"\fposition_lat\x18\x06 \x01(\x01H\x00R\vpositionLat\x88\x01\x01\x12(\n" +
+	"\rposition_long\x18\a \x01(\x01H\x01R\fpositionLong\x88\x01\x01B\x0f\n" +
+	"\r_position_latB\x10\n" +
+	"\x0e_position_long\"\xa0\x05\n" +
 	"\aSession\x129\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12,\n" +
@@ -1095,10 +1192,18 @@ const file_models_activity_standardized_proto_rawDesc = "" +
 	"\rstrength_sets\x18\x05 \x03(\v2$.fitglue.models.activity.StrengthSetR\fstrengthSets\x12*\n" +
 	"\x0etotal_calories\x18\x06 \x01(\x01H\x00R\rtotalCalories\x88\x01\x01\x12)\n" +
 	"\x0eavg_heart_rate\x18\a \x01(\x05H\x01R\favgHeartRate\x88\x01\x01\x12)\n" +
-	"\x0emax_heart_rate\x18\b \x01(\x05H\x02R\fmaxHeartRate\x88\x01\x01B\x11\n" +
+	"\x0emax_heart_rate\x18\b \x01(\x05H\x02R\fmaxHeartRate\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"total_work\x18\t \x01(\x01H\x03R\ttotalWork\x88\x01\x01\x12,\n" +
+	"\x0fpower_estimated\x18\n" +
+	" \x01(\bH\x04R\x0epowerEstimated\x88\x01\x01\x12%\n" +
+	"\x0ftime_in_hr_zone\x18\v \x03(\x01R\ftimeInHrZone\x121\n" +
+	"\x15hr_zone_high_boundary\x18\f \x03(\x05R\x12hrZoneHighBoundaryB\x11\n" +
 	"\x0f_total_caloriesB\x11\n" +
 	"\x0f_avg_heart_rateB\x11\n" +
-	"\x0f_max_heart_rate\"\xd2\x02\n" +
+	"\x0f_max_heart_rateB\r\n" +
+	"\v_total_workB\x12\n" +
+	"\x10_power_estimated\"\xd2\x02\n" +
 	"\x03Lap\x129\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12,\n" +
@@ -1107,7 +1212,7 @@ const file_models_activity_standardized_proto_rawDesc = "" +
 	"\arecords\x18\x04 \x03(\v2\x1f.fitglue.models.activity.RecordR\arecords\x12#\n" +
 	"\rexercise_name\x18\x05 \x01(\tR\fexerciseName\x12\x1c\n" +
 	"\tintensity\x18\x06 \x01(\tR\tintensity\x12=\n" +
-	"\x1bis_telemetry_container_only\x18\a \x01(\bR\x18isTelemetryContainerOnly\"\xba\x04\n" +
+	"\x1bis_telemetry_container_only\x18\a \x01(\bR\x18isTelemetryContainerOnly\"\xb6\x05\n" +
 	"\x06Record\x128\n" +
 	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1d\n" +
 	"\n" +
@@ -1124,11 +1229,15 @@ const file_models_activity_standardized_proto_rawDesc = "" +
 	"\x0evertical_ratio\x18\v \x01(\x05H\x02R\rverticalRatio\x88\x01\x01\x12$\n" +
 	"\vstep_length\x18\f \x01(\x01H\x03R\n" +
 	"stepLength\x88\x01\x01\x12\x1a\n" +
-	"\bdistance\x18\r \x01(\x01R\bdistanceB\x16\n" +
+	"\bdistance\x18\r \x01(\x01R\bdistance\x12%\n" +
+	"\vtemperature\x18\x0e \x01(\x05H\x04R\vtemperature\x88\x01\x01\x12.\n" +
+	"\x10core_temperature\x18\x0f \x01(\x01H\x05R\x0fcoreTemperature\x88\x01\x01B\x16\n" +
 	"\x14_ground_contact_timeB\x17\n" +
 	"\x15_vertical_oscillationB\x11\n" +
 	"\x0f_vertical_ratioB\x0e\n" +
-	"\f_step_length\"\xfa\x03\n" +
+	"\f_step_lengthB\x0e\n" +
+	"\f_temperatureB\x13\n" +
+	"\x11_core_temperature\"\xb1\x04\n" +
 	"\vStrengthSet\x12#\n" +
 	"\rexercise_name\x18\x01 \x01(\tR\fexerciseName\x12\x12\n" +
 	"\x04reps\x18\x02 \x01(\x05R\x04reps\x12\x1b\n" +
@@ -1143,7 +1252,8 @@ const file_models_activity_standardized_proto_rawDesc = "" +
 	"\x17secondary_muscle_groups\x18\t \x03(\x0e2$.fitglue.models.activity.MuscleGroupR\x15secondaryMuscleGroups\x12'\n" +
 	"\x0fdistance_meters\x18\n" +
 	" \x01(\x01R\x0edistanceMeters\x12\x19\n" +
-	"\bset_type\x18\v \x01(\tR\asetType\"c\n" +
+	"\bset_type\x18\v \x01(\tR\asetType\x125\n" +
+	"\bend_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"c\n" +
 	"\x11WorkoutDefinition\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12:\n" +
 	"\x05steps\x18\x02 \x03(\v2$.fitglue.models.activity.WorkoutStepR\x05steps\"\xd8\x01\n" +
@@ -1231,12 +1341,13 @@ var file_models_activity_standardized_proto_depIdxs = []int32{
 	12, // 16: fitglue.models.activity.StrengthSet.start_time:type_name -> google.protobuf.Timestamp
 	0,  // 17: fitglue.models.activity.StrengthSet.primary_muscle_group:type_name -> fitglue.models.activity.MuscleGroup
 	0,  // 18: fitglue.models.activity.StrengthSet.secondary_muscle_groups:type_name -> fitglue.models.activity.MuscleGroup
-	10, // 19: fitglue.models.activity.WorkoutDefinition.steps:type_name -> fitglue.models.activity.WorkoutStep
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	12, // 19: fitglue.models.activity.StrengthSet.end_time:type_name -> google.protobuf.Timestamp
+	10, // 20: fitglue.models.activity.WorkoutDefinition.steps:type_name -> fitglue.models.activity.WorkoutStep
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_models_activity_standardized_proto_init() }
@@ -1246,6 +1357,7 @@ func file_models_activity_standardized_proto_init() {
 	}
 	file_models_activity_source_proto_init()
 	file_models_activity_standardized_proto_msgTypes[0].OneofWrappers = []any{}
+	file_models_activity_standardized_proto_msgTypes[3].OneofWrappers = []any{}
 	file_models_activity_standardized_proto_msgTypes[4].OneofWrappers = []any{}
 	file_models_activity_standardized_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
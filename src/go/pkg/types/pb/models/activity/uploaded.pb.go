@@ -333,8 +333,16 @@ type ShowcaseProfileEntry struct {
 	TotalSets         int32                  `protobuf:"varint,9,opt,name=total_sets,json=totalSets,proto3" json:"total_sets,omitempty"`
 	TotalReps         int32                  `protobuf:"varint,10,opt,name=total_reps,json=totalReps,proto3" json:"total_reps,omitempty"`
 	TotalWeightKg     float64                `protobuf:"fixed64,11,opt,name=total_weight_kg,json=totalWeightKg,proto3" json:"total_weight_kg,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Fingerprint of the GPS route, shared by entries that cover the same
+	// course. Empty for activities without GPS data. Public endpoints return
+	// an opaque hash of it.
+	RouteKey string `protobuf:"bytes,12,opt,name=route_key,json=routeKey,proto3" json:"route_key,omitempty"`
+	// Shown in the profile's feeds
+	Description   string `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
+	BannerUrl     string `protobuf:"bytes,14,opt,name=banner_url,json=bannerUrl,proto3" json:"banner_url,omitempty"`
+	PrCount       int32  `protobuf:"varint,15,opt,name=pr_count,json=prCount,proto3" json:"pr_count,omitempty"` // New personal records set by the activity
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ShowcaseProfileEntry) Reset() {
@@ -444,6 +452,459 @@ func (x *ShowcaseProfileEntry) GetTotalWeightKg() float64 {
 	return 0
 }
 
+func (x *ShowcaseProfileEntry) GetRouteKey() string {
+	if x != nil {
+		return x.RouteKey
+	}
+	return ""
+}
+
+func (x *ShowcaseProfileEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ShowcaseProfileEntry) GetBannerUrl() string {
+	if x != nil {
+		return x.BannerUrl
+	}
+	return ""
+}
+
+func (x *ShowcaseProfileEntry) GetPrCount() int32 {
+	if x != nil {
+		return x.PrCount
+	}
+	return 0
+}
+
+// ShowcaseRouteEffort is a single showcased activity on a repeated route.
+type ShowcaseRouteEffort struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ShowcaseId      string                 `protobuf:"bytes,1,opt,name=showcase_id,json=showcaseId,proto3" json:"showcase_id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	DurationSeconds float64                `protobuf:"fixed64,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	DistanceMeters  float64                `protobuf:"fixed64,5,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShowcaseRouteEffort) Reset() {
+	*x = ShowcaseRouteEffort{}
+	mi := &file_models_activity_uploaded_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowcaseRouteEffort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowcaseRouteEffort) ProtoMessage() {}
+
+func (x *ShowcaseRouteEffort) ProtoReflect() protoreflect.Message {
+	mi := &file_models_activity_uploaded_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowcaseRouteEffort.ProtoReflect.Descriptor instead.
+func (*ShowcaseRouteEffort) Descriptor() ([]byte, []int) {
+	return file_models_activity_uploaded_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ShowcaseRouteEffort) GetShowcaseId() string {
+	if x != nil {
+		return x.ShowcaseId
+	}
+	return ""
+}
+
+func (x *ShowcaseRouteEffort) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ShowcaseRouteEffort) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ShowcaseRouteEffort) GetDurationSeconds() float64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *ShowcaseRouteEffort) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+// ShowcaseRouteStats aggregates every showcased activity sharing a route key.
+type ShowcaseRouteStats struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	RouteKey              string                 `protobuf:"bytes,1,opt,name=route_key,json=routeKey,proto3" json:"route_key,omitempty"` // Opaque; only identifies the route within the response
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                         // Title of the most recent effort
+	ActivityType          ActivityType           `protobuf:"varint,3,opt,name=activity_type,json=activityType,proto3,enum=fitglue.models.activity.ActivityType" json:"activity_type,omitempty"`
+	ActivityCount         int32                  `protobuf:"varint,4,opt,name=activity_count,json=activityCount,proto3" json:"activity_count,omitempty"`
+	AverageDistanceMeters float64                `protobuf:"fixed64,5,opt,name=average_distance_meters,json=averageDistanceMeters,proto3" json:"average_distance_meters,omitempty"`
+	TotalDistanceMeters   float64                `protobuf:"fixed64,6,opt,name=total_distance_meters,json=totalDistanceMeters,proto3" json:"total_distance_meters,omitempty"`
+	RouteThumbnailUrl     string                 `protobuf:"bytes,7,opt,name=route_thumbnail_url,json=routeThumbnailUrl,proto3" json:"route_thumbnail_url,omitempty"` // From the most recent effort
+	FirstActivityAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=first_activity_at,json=firstActivityAt,proto3" json:"first_activity_at,omitempty"`
+	LatestActivityAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=latest_activity_at,json=latestActivityAt,proto3" json:"latest_activity_at,omitempty"`
+	FastestEfforts        []*ShowcaseRouteEffort `protobuf:"bytes,10,rep,name=fastest_efforts,json=fastestEfforts,proto3" json:"fastest_efforts,omitempty"` // Fastest first
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ShowcaseRouteStats) Reset() {
+	*x = ShowcaseRouteStats{}
+	mi := &file_models_activity_uploaded_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowcaseRouteStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowcaseRouteStats) ProtoMessage() {}
+
+func (x *ShowcaseRouteStats) ProtoReflect() protoreflect.Message {
+	mi := &file_models_activity_uploaded_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowcaseRouteStats.ProtoReflect.Descriptor instead.
+func (*ShowcaseRouteStats) Descriptor() ([]byte, []int) {
+	return file_models_activity_uploaded_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ShowcaseRouteStats) GetRouteKey() string {
+	if x != nil {
+		return x.RouteKey
+	}
+	return ""
+}
+
+func (x *ShowcaseRouteStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShowcaseRouteStats) GetActivityType() ActivityType {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ActivityType_ACTIVITY_TYPE_UNSPECIFIED
+}
+
+func (x *ShowcaseRouteStats) GetActivityCount() int32 {
+	if x != nil {
+		return x.ActivityCount
+	}
+	return 0
+}
+
+func (x *ShowcaseRouteStats) GetAverageDistanceMeters() float64 {
+	if x != nil {
+		return x.AverageDistanceMeters
+	}
+	return 0
+}
+
+func (x *ShowcaseRouteStats) GetTotalDistanceMeters() float64 {
+	if x != nil {
+		return x.TotalDistanceMeters
+	}
+	return 0
+}
+
+func (x *ShowcaseRouteStats) GetRouteThumbnailUrl() string {
+	if x != nil {
+		return x.RouteThumbnailUrl
+	}
+	return ""
+}
+
+func (x *ShowcaseRouteStats) GetFirstActivityAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstActivityAt
+	}
+	return nil
+}
+
+func (x *ShowcaseRouteStats) GetLatestActivityAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LatestActivityAt
+	}
+	return nil
+}
+
+func (x *ShowcaseRouteStats) GetFastestEfforts() []*ShowcaseRouteEffort {
+	if x != nil {
+		return x.FastestEfforts
+	}
+	return nil
+}
+
+// ShowcaseComparison is a head-to-head of two public showcase profiles over
+// the weeks before it was computed. Stored in showcase_comparisons and
+// refreshed nightly so public pages read one document.
+type ShowcaseComparison struct {
+	state       protoimpl.MessageState  `protogen:"open.v1"`
+	Id          string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Both slugs, sorted and joined by "__"
+	A           *ShowcaseComparisonSide `protobuf:"bytes,2,opt,name=a,proto3" json:"a,omitempty"`
+	B           *ShowcaseComparisonSide `protobuf:"bytes,3,opt,name=b,proto3" json:"b,omitempty"`
+	WindowStart *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"` // Monday 00:00 UTC of the first week
+	Weeks       int32                   `protobuf:"varint,5,opt,name=weeks,proto3" json:"weeks,omitempty"`
+	ComputedAt  *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	// When the pair was last asked for. Stored comparisons unused for 30 days
+	// are removed by the nightly refresh. Not returned by the public API.
+	LastRequestedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_requested_at,json=lastRequestedAt,proto3" json:"last_requested_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShowcaseComparison) Reset() {
+	*x = ShowcaseComparison{}
+	mi := &file_models_activity_uploaded_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowcaseComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowcaseComparison) ProtoMessage() {}
+
+func (x *ShowcaseComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_models_activity_uploaded_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowcaseComparison.ProtoReflect.Descriptor instead.
+func (*ShowcaseComparison) Descriptor() ([]byte, []int) {
+	return file_models_activity_uploaded_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ShowcaseComparison) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShowcaseComparison) GetA() *ShowcaseComparisonSide {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *ShowcaseComparison) GetB() *ShowcaseComparisonSide {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+func (x *ShowcaseComparison) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *ShowcaseComparison) GetWeeks() int32 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+func (x *ShowcaseComparison) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+func (x *ShowcaseComparison) GetLastRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRequestedAt
+	}
+	return nil
+}
+
+// ShowcaseComparisonSide is one profile's showing in a comparison, counting
+// only its showcased activities.
+type ShowcaseComparisonSide struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Slug                 string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	DisplayName          string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	ProfilePictureUrl    string                 `protobuf:"bytes,3,opt,name=profile_picture_url,json=profilePictureUrl,proto3" json:"profile_picture_url,omitempty"`
+	WeeklyDistanceMeters []float64              `protobuf:"fixed64,4,rep,packed,name=weekly_distance_meters,json=weeklyDistanceMeters,proto3" json:"weekly_distance_meters,omitempty"` // One per week, oldest first
+	WeeklyActivities     []int32                `protobuf:"varint,5,rep,packed,name=weekly_activities,json=weeklyActivities,proto3" json:"weekly_activities,omitempty"`
+	PrCount              int32                  `protobuf:"varint,6,opt,name=pr_count,json=prCount,proto3" json:"pr_count,omitempty"`
+	ActiveWeeks          int32                  `protobuf:"varint,7,opt,name=active_weeks,json=activeWeeks,proto3" json:"active_weeks,omitempty"`                        // Weeks with at least one activity
+	Consistency          float64                `protobuf:"fixed64,8,opt,name=consistency,proto3" json:"consistency,omitempty"`                                          // active_weeks / weeks
+	LongestStreakWeeks   int32                  `protobuf:"varint,9,opt,name=longest_streak_weeks,json=longestStreakWeeks,proto3" json:"longest_streak_weeks,omitempty"` // Most consecutive active weeks
+	TotalActivities      int32                  `protobuf:"varint,10,opt,name=total_activities,json=totalActivities,proto3" json:"total_activities,omitempty"`
+	TotalDistanceMeters  float64                `protobuf:"fixed64,11,opt,name=total_distance_meters,json=totalDistanceMeters,proto3" json:"total_distance_meters,omitempty"`
+	TotalDurationSeconds float64                `protobuf:"fixed64,12,opt,name=total_duration_seconds,json=totalDurationSeconds,proto3" json:"total_duration_seconds,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ShowcaseComparisonSide) Reset() {
+	*x = ShowcaseComparisonSide{}
+	mi := &file_models_activity_uploaded_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowcaseComparisonSide) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowcaseComparisonSide) ProtoMessage() {}
+
+func (x *ShowcaseComparisonSide) ProtoReflect() protoreflect.Message {
+	mi := &file_models_activity_uploaded_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowcaseComparisonSide.ProtoReflect.Descriptor instead.
+func (*ShowcaseComparisonSide) Descriptor() ([]byte, []int) {
+	return file_models_activity_uploaded_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ShowcaseComparisonSide) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ShowcaseComparisonSide) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ShowcaseComparisonSide) GetProfilePictureUrl() string {
+	if x != nil {
+		return x.ProfilePictureUrl
+	}
+	return ""
+}
+
+func (x *ShowcaseComparisonSide) GetWeeklyDistanceMeters() []float64 {
+	if x != nil {
+		return x.WeeklyDistanceMeters
+	}
+	return nil
+}
+
+func (x *ShowcaseComparisonSide) GetWeeklyActivities() []int32 {
+	if x != nil {
+		return x.WeeklyActivities
+	}
+	return nil
+}
+
+func (x *ShowcaseComparisonSide) GetPrCount() int32 {
+	if x != nil {
+		return x.PrCount
+	}
+	return 0
+}
+
+func (x *ShowcaseComparisonSide) GetActiveWeeks() int32 {
+	if x != nil {
+		return x.ActiveWeeks
+	}
+	return 0
+}
+
+func (x *ShowcaseComparisonSide) GetConsistency() float64 {
+	if x != nil {
+		return x.Consistency
+	}
+	return 0
+}
+
+func (x *ShowcaseComparisonSide) GetLongestStreakWeeks() int32 {
+	if x != nil {
+		return x.LongestStreakWeeks
+	}
+	return 0
+}
+
+func (x *ShowcaseComparisonSide) GetTotalActivities() int32 {
+	if x != nil {
+		return x.TotalActivities
+	}
+	return 0
+}
+
+func (x *ShowcaseComparisonSide) GetTotalDistanceMeters() float64 {
+	if x != nil {
+		return x.TotalDistanceMeters
+	}
+	return 0
+}
+
+func (x *ShowcaseComparisonSide) GetTotalDurationSeconds() float64 {
+	if x != nil {
+		return x.TotalDurationSeconds
+	}
+	return 0
+}
+
 type ShowcaseTheme struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	ThemeId           string                 `protobuf:"bytes,1,opt,name=theme_id,json=themeId,proto3" json:"theme_id,omitempty"`
@@ -456,7 +917,7 @@ type ShowcaseTheme struct {
 
 func (x *ShowcaseTheme) Reset() {
 	*x = ShowcaseTheme{}
-	mi := &file_models_activity_uploaded_proto_msgTypes[3]
+	mi := &file_models_activity_uploaded_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -468,7 +929,7 @@ func (x *ShowcaseTheme) String() string {
 func (*ShowcaseTheme) ProtoMessage() {}
 
 func (x *ShowcaseTheme) ProtoReflect() protoreflect.Message {
-	mi := &file_models_activity_uploaded_proto_msgTypes[3]
+	mi := &file_models_activity_uploaded_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,7 +942,7 @@ func (x *ShowcaseTheme) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShowcaseTheme.ProtoReflect.Descriptor instead.
 func (*ShowcaseTheme) Descriptor() ([]byte, []int) {
-	return file_models_activity_uploaded_proto_rawDescGZIP(), []int{3}
+	return file_models_activity_uploaded_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ShowcaseTheme) GetThemeId() string {
@@ -539,7 +1000,7 @@ type ShowcaseProfile struct {
 
 func (x *ShowcaseProfile) Reset() {
 	*x = ShowcaseProfile{}
-	mi := &file_models_activity_uploaded_proto_msgTypes[4]
+	mi := &file_models_activity_uploaded_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -551,7 +1012,7 @@ func (x *ShowcaseProfile) String() string {
 func (*ShowcaseProfile) ProtoMessage() {}
 
 func (x *ShowcaseProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_models_activity_uploaded_proto_msgTypes[4]
+	mi := &file_models_activity_uploaded_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +1025,7 @@ func (x *ShowcaseProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShowcaseProfile.ProtoReflect.Descriptor instead.
 func (*ShowcaseProfile) Descriptor() ([]byte, []int) {
-	return file_models_activity_uploaded_proto_rawDescGZIP(), []int{4}
+	return file_models_activity_uploaded_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ShowcaseProfile) GetSlug() string {
@@ -748,7 +1209,7 @@ const file_models_activity_uploaded_proto_rawDesc = "" +
 	"\x17EnrichmentMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x18\n" +
-	"\x16_pipeline_execution_id\"\xff\x03\n" +
+	"\x16_pipeline_execution_id\"\xf8\x04\n" +
 	"\x14ShowcaseProfileEntry\x12\x1f\n" +
 	"\vshowcase_id\x18\x01 \x01(\tR\n" +
 	"showcaseId\x12\x14\n" +
@@ -765,7 +1226,55 @@ const file_models_activity_uploaded_proto_rawDesc = "" +
 	"\n" +
 	"total_reps\x18\n" +
 	" \x01(\x05R\ttotalReps\x12&\n" +
-	"\x0ftotal_weight_kg\x18\v \x01(\x01R\rtotalWeightKg\"\x9c\x01\n" +
+	"\x0ftotal_weight_kg\x18\v \x01(\x01R\rtotalWeightKg\x12\x1b\n" +
+	"\troute_key\x18\f \x01(\tR\brouteKey\x12 \n" +
+	"\vdescription\x18\r \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"banner_url\x18\x0e \x01(\tR\tbannerUrl\x12\x19\n" +
+	"\bpr_count\x18\x0f \x01(\x05R\aprCount\"\xdb\x01\n" +
+	"\x13ShowcaseRouteEffort\x12\x1f\n" +
+	"\vshowcase_id\x18\x01 \x01(\tR\n" +
+	"showcaseId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12)\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x01R\x0fdurationSeconds\x12'\n" +
+	"\x0fdistance_meters\x18\x05 \x01(\x01R\x0edistanceMeters\"\xbd\x04\n" +
+	"\x12ShowcaseRouteStats\x12\x1b\n" +
+	"\troute_key\x18\x01 \x01(\tR\brouteKey\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12J\n" +
+	"\ractivity_type\x18\x03 \x01(\x0e2%.fitglue.models.activity.ActivityTypeR\factivityType\x12%\n" +
+	"\x0eactivity_count\x18\x04 \x01(\x05R\ractivityCount\x126\n" +
+	"\x17average_distance_meters\x18\x05 \x01(\x01R\x15averageDistanceMeters\x122\n" +
+	"\x15total_distance_meters\x18\x06 \x01(\x01R\x13totalDistanceMeters\x12.\n" +
+	"\x13route_thumbnail_url\x18\a \x01(\tR\x11routeThumbnailUrl\x12F\n" +
+	"\x11first_activity_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0ffirstActivityAt\x12H\n" +
+	"\x12latest_activity_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x10latestActivityAt\x12U\n" +
+	"\x0ffastest_efforts\x18\n" +
+	" \x03(\v2,.fitglue.models.activity.ShowcaseRouteEffortR\x0efastestEfforts\"\xfc\x02\n" +
+	"\x12ShowcaseComparison\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12=\n" +
+	"\x01a\x18\x02 \x01(\v2/.fitglue.models.activity.ShowcaseComparisonSideR\x01a\x12=\n" +
+	"\x01b\x18\x03 \x01(\v2/.fitglue.models.activity.ShowcaseComparisonSideR\x01b\x12=\n" +
+	"\fwindow_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x12\x14\n" +
+	"\x05weeks\x18\x05 \x01(\x05R\x05weeks\x12;\n" +
+	"\vcomputed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\x12F\n" +
+	"\x11last_requested_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0flastRequestedAt\"\x89\x04\n" +
+	"\x16ShowcaseComparisonSide\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12.\n" +
+	"\x13profile_picture_url\x18\x03 \x01(\tR\x11profilePictureUrl\x124\n" +
+	"\x16weekly_distance_meters\x18\x04 \x03(\x01R\x14weeklyDistanceMeters\x12+\n" +
+	"\x11weekly_activities\x18\x05 \x03(\x05R\x10weeklyActivities\x12\x19\n" +
+	"\bpr_count\x18\x06 \x01(\x05R\aprCount\x12!\n" +
+	"\factive_weeks\x18\a \x01(\x05R\vactiveWeeks\x12 \n" +
+	"\vconsistency\x18\b \x01(\x01R\vconsistency\x120\n" +
+	"\x14longest_streak_weeks\x18\t \x01(\x05R\x12longestStreakWeeks\x12)\n" +
+	"\x10total_activities\x18\n" +
+	" \x01(\x05R\x0ftotalActivities\x122\n" +
+	"\x15total_distance_meters\x18\v \x01(\x01R\x13totalDistanceMeters\x124\n" +
+	"\x16total_duration_seconds\x18\f \x01(\x01R\x14totalDurationSeconds\"\x9c\x01\n" +
 	"\rShowcaseTheme\x12\x19\n" +
 	"\btheme_id\x18\x01 \x01(\tR\athemeId\x12.\n" +
 	"\x13custom_accent_color\x18\x02 \x01(\tR\x11customAccentColor\x12!\n" +
@@ -810,45 +1319,59 @@ func file_models_activity_uploaded_proto_rawDescGZIP() []byte {
 	return file_models_activity_uploaded_proto_rawDescData
 }
 
-var file_models_activity_uploaded_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_models_activity_uploaded_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_models_activity_uploaded_proto_goTypes = []any{
 	(*UploadedActivityRecord)(nil), // 0: fitglue.models.activity.UploadedActivityRecord
 	(*ShowcasedActivity)(nil),      // 1: fitglue.models.activity.ShowcasedActivity
 	(*ShowcaseProfileEntry)(nil),   // 2: fitglue.models.activity.ShowcaseProfileEntry
-	(*ShowcaseTheme)(nil),          // 3: fitglue.models.activity.ShowcaseTheme
-	(*ShowcaseProfile)(nil),        // 4: fitglue.models.activity.ShowcaseProfile
-	nil,                            // 5: fitglue.models.activity.ShowcasedActivity.EnrichmentMetadataEntry
-	(ActivitySource)(0),            // 6: fitglue.models.activity.ActivitySource
-	(*timestamppb.Timestamp)(nil),  // 7: google.protobuf.Timestamp
-	(plugin.DestinationType)(0),    // 8: fitglue.models.plugin.DestinationType
-	(ActivityType)(0),              // 9: fitglue.models.activity.ActivityType
-	(*StandardizedActivity)(nil),   // 10: fitglue.models.activity.StandardizedActivity
+	(*ShowcaseRouteEffort)(nil),    // 3: fitglue.models.activity.ShowcaseRouteEffort
+	(*ShowcaseRouteStats)(nil),     // 4: fitglue.models.activity.ShowcaseRouteStats
+	(*ShowcaseComparison)(nil),     // 5: fitglue.models.activity.ShowcaseComparison
+	(*ShowcaseComparisonSide)(nil), // 6: fitglue.models.activity.ShowcaseComparisonSide
+	(*ShowcaseTheme)(nil),          // 7: fitglue.models.activity.ShowcaseTheme
+	(*ShowcaseProfile)(nil),        // 8: fitglue.models.activity.ShowcaseProfile
+	nil,                            // 9: fitglue.models.activity.ShowcasedActivity.EnrichmentMetadataEntry
+	(ActivitySource)(0),            // 10: fitglue.models.activity.ActivitySource
+	(*timestamppb.Timestamp)(nil),  // 11: google.protobuf.Timestamp
+	(plugin.DestinationType)(0),    // 12: fitglue.models.plugin.DestinationType
+	(ActivityType)(0),              // 13: fitglue.models.activity.ActivityType
+	(*StandardizedActivity)(nil),   // 14: fitglue.models.activity.StandardizedActivity
 }
 var file_models_activity_uploaded_proto_depIdxs = []int32{
-	6,  // 0: fitglue.models.activity.UploadedActivityRecord.source:type_name -> fitglue.models.activity.ActivitySource
-	7,  // 1: fitglue.models.activity.UploadedActivityRecord.start_time:type_name -> google.protobuf.Timestamp
-	8,  // 2: fitglue.models.activity.UploadedActivityRecord.destination:type_name -> fitglue.models.plugin.DestinationType
-	7,  // 3: fitglue.models.activity.UploadedActivityRecord.uploaded_at:type_name -> google.protobuf.Timestamp
-	9,  // 4: fitglue.models.activity.ShowcasedActivity.activity_type:type_name -> fitglue.models.activity.ActivityType
-	6,  // 5: fitglue.models.activity.ShowcasedActivity.source:type_name -> fitglue.models.activity.ActivitySource
-	7,  // 6: fitglue.models.activity.ShowcasedActivity.start_time:type_name -> google.protobuf.Timestamp
-	10, // 7: fitglue.models.activity.ShowcasedActivity.activity_data:type_name -> fitglue.models.activity.StandardizedActivity
-	5,  // 8: fitglue.models.activity.ShowcasedActivity.enrichment_metadata:type_name -> fitglue.models.activity.ShowcasedActivity.EnrichmentMetadataEntry
-	7,  // 9: fitglue.models.activity.ShowcasedActivity.created_at:type_name -> google.protobuf.Timestamp
-	7,  // 10: fitglue.models.activity.ShowcasedActivity.expires_at:type_name -> google.protobuf.Timestamp
-	9,  // 11: fitglue.models.activity.ShowcaseProfileEntry.activity_type:type_name -> fitglue.models.activity.ActivityType
-	6,  // 12: fitglue.models.activity.ShowcaseProfileEntry.source:type_name -> fitglue.models.activity.ActivitySource
-	7,  // 13: fitglue.models.activity.ShowcaseProfileEntry.start_time:type_name -> google.protobuf.Timestamp
-	2,  // 14: fitglue.models.activity.ShowcaseProfile.entries:type_name -> fitglue.models.activity.ShowcaseProfileEntry
-	7,  // 15: fitglue.models.activity.ShowcaseProfile.latest_activity_at:type_name -> google.protobuf.Timestamp
-	7,  // 16: fitglue.models.activity.ShowcaseProfile.created_at:type_name -> google.protobuf.Timestamp
-	7,  // 17: fitglue.models.activity.ShowcaseProfile.updated_at:type_name -> google.protobuf.Timestamp
-	3,  // 18: fitglue.models.activity.ShowcaseProfile.theme:type_name -> fitglue.models.activity.ShowcaseTheme
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	10, // 0: fitglue.models.activity.UploadedActivityRecord.source:type_name -> fitglue.models.activity.ActivitySource
+	11, // 1: fitglue.models.activity.UploadedActivityRecord.start_time:type_name -> google.protobuf.Timestamp
+	12, // 2: fitglue.models.activity.UploadedActivityRecord.destination:type_name -> fitglue.models.plugin.DestinationType
+	11, // 3: fitglue.models.activity.UploadedActivityRecord.uploaded_at:type_name -> google.protobuf.Timestamp
+	13, // 4: fitglue.models.activity.ShowcasedActivity.activity_type:type_name -> fitglue.models.activity.ActivityType
+	10, // 5: fitglue.models.activity.ShowcasedActivity.source:type_name -> fitglue.models.activity.ActivitySource
+	11, // 6: fitglue.models.activity.ShowcasedActivity.start_time:type_name -> google.protobuf.Timestamp
+	14, // 7: fitglue.models.activity.ShowcasedActivity.activity_data:type_name -> fitglue.models.activity.StandardizedActivity
+	9,  // 8: fitglue.models.activity.ShowcasedActivity.enrichment_metadata:type_name -> fitglue.models.activity.ShowcasedActivity.EnrichmentMetadataEntry
+	11, // 9: fitglue.models.activity.ShowcasedActivity.created_at:type_name -> google.protobuf.Timestamp
+	11, // 10: fitglue.models.activity.ShowcasedActivity.expires_at:type_name -> google.protobuf.Timestamp
+	13, // 11: fitglue.models.activity.ShowcaseProfileEntry.activity_type:type_name -> fitglue.models.activity.ActivityType
+	10, // 12: fitglue.models.activity.ShowcaseProfileEntry.source:type_name -> fitglue.models.activity.ActivitySource
+	11, // 13: fitglue.models.activity.ShowcaseProfileEntry.start_time:type_name -> google.protobuf.Timestamp
+	11, // 14: fitglue.models.activity.ShowcaseRouteEffort.start_time:type_name -> google.protobuf.Timestamp
+	13, // 15: fitglue.models.activity.ShowcaseRouteStats.activity_type:type_name -> fitglue.models.activity.ActivityType
+	11, // 16: fitglue.models.activity.ShowcaseRouteStats.first_activity_at:type_name -> google.protobuf.Timestamp
+	11, // 17: fitglue.models.activity.ShowcaseRouteStats.latest_activity_at:type_name -> google.protobuf.Timestamp
+	3,  // 18: fitglue.models.activity.ShowcaseRouteStats.fastest_efforts:type_name -> fitglue.models.activity.ShowcaseRouteEffort
+	6,  // 19: fitglue.models.activity.ShowcaseComparison.a:type_name -> fitglue.models.activity.ShowcaseComparisonSide
+	6,  // 20: fitglue.models.activity.ShowcaseComparison.b:type_name -> fitglue.models.activity.ShowcaseComparisonSide
+	11, // 21: fitglue.models.activity.ShowcaseComparison.window_start:type_name -> google.protobuf.Timestamp
+	11, // 22: fitglue.models.activity.ShowcaseComparison.computed_at:type_name -> google.protobuf.Timestamp
+	11, // 23: fitglue.models.activity.ShowcaseComparison.last_requested_at:type_name -> google.protobuf.Timestamp
+	2,  // 24: fitglue.models.activity.ShowcaseProfile.entries:type_name -> fitglue.models.activity.ShowcaseProfileEntry
+	11, // 25: fitglue.models.activity.ShowcaseProfile.latest_activity_at:type_name -> google.protobuf.Timestamp
+	11, // 26: fitglue.models.activity.ShowcaseProfile.created_at:type_name -> google.protobuf.Timestamp
+	11, // 27: fitglue.models.activity.ShowcaseProfile.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 28: fitglue.models.activity.ShowcaseProfile.theme:type_name -> fitglue.models.activity.ShowcaseTheme
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_models_activity_uploaded_proto_init() }
@@ -865,7 +1388,7 @@ func file_models_activity_uploaded_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_activity_uploaded_proto_rawDesc), len(file_models_activity_uploaded_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
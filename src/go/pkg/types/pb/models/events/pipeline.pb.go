@@ -28,37 +28,58 @@ const (
 type CloudEventType int32
 
 const (
-	CloudEventType_CLOUD_EVENT_TYPE_UNSPECIFIED         CloudEventType = 0
-	CloudEventType_CLOUD_EVENT_TYPE_ACTIVITY_CREATED    CloudEventType = 1
-	CloudEventType_CLOUD_EVENT_TYPE_ACTIVITY_ENRICHED   CloudEventType = 2
-	CloudEventType_CLOUD_EVENT_TYPE_JOB_ROUTED          CloudEventType = 3
-	CloudEventType_CLOUD_EVENT_TYPE_FITBIT_NOTIFICATION CloudEventType = 4
-	CloudEventType_CLOUD_EVENT_TYPE_ENRICHMENT_LAG      CloudEventType = 5
-	CloudEventType_CLOUD_EVENT_TYPE_INPUT_RESOLVED      CloudEventType = 6
-	CloudEventType_CLOUD_EVENT_TYPE_PARKRUN_RESULTS     CloudEventType = 7
+	CloudEventType_CLOUD_EVENT_TYPE_UNSPECIFIED              CloudEventType = 0
+	CloudEventType_CLOUD_EVENT_TYPE_ACTIVITY_CREATED         CloudEventType = 1
+	CloudEventType_CLOUD_EVENT_TYPE_ACTIVITY_ENRICHED        CloudEventType = 2
+	CloudEventType_CLOUD_EVENT_TYPE_JOB_ROUTED               CloudEventType = 3
+	CloudEventType_CLOUD_EVENT_TYPE_FITBIT_NOTIFICATION      CloudEventType = 4
+	CloudEventType_CLOUD_EVENT_TYPE_ENRICHMENT_LAG           CloudEventType = 5
+	CloudEventType_CLOUD_EVENT_TYPE_INPUT_RESOLVED           CloudEventType = 6
+	CloudEventType_CLOUD_EVENT_TYPE_PARKRUN_RESULTS          CloudEventType = 7
+	CloudEventType_CLOUD_EVENT_TYPE_BACKFILL_REQUESTED       CloudEventType = 8
+	CloudEventType_CLOUD_EVENT_TYPE_ARCHIVE_EXPORT_REQUESTED CloudEventType = 9
+	CloudEventType_CLOUD_EVENT_TYPE_ACTIVITY_BATCH           CloudEventType = 10
+	CloudEventType_CLOUD_EVENT_TYPE_IMPORT_REQUESTED         CloudEventType = 11
+	CloudEventType_CLOUD_EVENT_TYPE_WEBHOOK_RECEIVED         CloudEventType = 12
+	CloudEventType_CLOUD_EVENT_TYPE_DATA_EXPORT_REQUESTED    CloudEventType = 13
+	CloudEventType_CLOUD_EVENT_TYPE_USER_DELETION_REQUESTED  CloudEventType = 14
 )
 
 // Enum value maps for CloudEventType.
 var (
 	CloudEventType_name = map[int32]string{
-		0: "CLOUD_EVENT_TYPE_UNSPECIFIED",
-		1: "CLOUD_EVENT_TYPE_ACTIVITY_CREATED",
-		2: "CLOUD_EVENT_TYPE_ACTIVITY_ENRICHED",
-		3: "CLOUD_EVENT_TYPE_JOB_ROUTED",
-		4: "CLOUD_EVENT_TYPE_FITBIT_NOTIFICATION",
-		5: "CLOUD_EVENT_TYPE_ENRICHMENT_LAG",
-		6: "CLOUD_EVENT_TYPE_INPUT_RESOLVED",
-		7: "CLOUD_EVENT_TYPE_PARKRUN_RESULTS",
+		0:  "CLOUD_EVENT_TYPE_UNSPECIFIED",
+		1:  "CLOUD_EVENT_TYPE_ACTIVITY_CREATED",
+		2:  "CLOUD_EVENT_TYPE_ACTIVITY_ENRICHED",
+		3:  "CLOUD_EVENT_TYPE_JOB_ROUTED",
+		4:  "CLOUD_EVENT_TYPE_FITBIT_NOTIFICATION",
+		5:  "CLOUD_EVENT_TYPE_ENRICHMENT_LAG",
+		6:  "CLOUD_EVENT_TYPE_INPUT_RESOLVED",
+		7:  "CLOUD_EVENT_TYPE_PARKRUN_RESULTS",
+		8:  "CLOUD_EVENT_TYPE_BACKFILL_REQUESTED",
+		9:  "CLOUD_EVENT_TYPE_ARCHIVE_EXPORT_REQUESTED",
+		10: "CLOUD_EVENT_TYPE_ACTIVITY_BATCH",
+		11: "CLOUD_EVENT_TYPE_IMPORT_REQUESTED",
+		12: "CLOUD_EVENT_TYPE_WEBHOOK_RECEIVED",
+		13: "CLOUD_EVENT_TYPE_DATA_EXPORT_REQUESTED",
+		14: "CLOUD_EVENT_TYPE_USER_DELETION_REQUESTED",
 	}
 	CloudEventType_value = map[string]int32{
-		"CLOUD_EVENT_TYPE_UNSPECIFIED":         0,
-		"CLOUD_EVENT_TYPE_ACTIVITY_CREATED":    1,
-		"CLOUD_EVENT_TYPE_ACTIVITY_ENRICHED":   2,
-		"CLOUD_EVENT_TYPE_JOB_ROUTED":          3,
-		"CLOUD_EVENT_TYPE_FITBIT_NOTIFICATION": 4,
-		"CLOUD_EVENT_TYPE_ENRICHMENT_LAG":      5,
-		"CLOUD_EVENT_TYPE_INPUT_RESOLVED":      6,
-		"CLOUD_EVENT_TYPE_PARKRUN_RESULTS":     7,
+		"CLOUD_EVENT_TYPE_UNSPECIFIED":              0,
+		"CLOUD_EVENT_TYPE_ACTIVITY_CREATED":         1,
+		"CLOUD_EVENT_TYPE_ACTIVITY_ENRICHED":        2,
+		"CLOUD_EVENT_TYPE_JOB_ROUTED":               3,
+		"CLOUD_EVENT_TYPE_FITBIT_NOTIFICATION":      4,
+		"CLOUD_EVENT_TYPE_ENRICHMENT_LAG":           5,
+		"CLOUD_EVENT_TYPE_INPUT_RESOLVED":           6,
+		"CLOUD_EVENT_TYPE_PARKRUN_RESULTS":          7,
+		"CLOUD_EVENT_TYPE_BACKFILL_REQUESTED":       8,
+		"CLOUD_EVENT_TYPE_ARCHIVE_EXPORT_REQUESTED": 9,
+		"CLOUD_EVENT_TYPE_ACTIVITY_BATCH":           10,
+		"CLOUD_EVENT_TYPE_IMPORT_REQUESTED":         11,
+		"CLOUD_EVENT_TYPE_WEBHOOK_RECEIVED":         12,
+		"CLOUD_EVENT_TYPE_DATA_EXPORT_REQUESTED":    13,
+		"CLOUD_EVENT_TYPE_USER_DELETION_REQUESTED":  14,
 	}
 )
 
@@ -109,6 +130,12 @@ const (
 	CloudEventSource_CLOUD_EVENT_SOURCE_GITHUB            CloudEventSource = 14
 	CloudEventSource_CLOUD_EVENT_SOURCE_APPLE_HEALTH      CloudEventSource = 15
 	CloudEventSource_CLOUD_EVENT_SOURCE_HEALTH_CONNECT    CloudEventSource = 16
+	CloudEventSource_CLOUD_EVENT_SOURCE_WHOOP             CloudEventSource = 17
+	CloudEventSource_CLOUD_EVENT_SOURCE_ZWIFT             CloudEventSource = 18
+	CloudEventSource_CLOUD_EVENT_SOURCE_BACKFILL          CloudEventSource = 19
+	CloudEventSource_CLOUD_EVENT_SOURCE_ARCHIVE_EXPORT    CloudEventSource = 20
+	CloudEventSource_CLOUD_EVENT_SOURCE_DATA_EXPORT       CloudEventSource = 21
+	CloudEventSource_CLOUD_EVENT_SOURCE_USER_DELETION     CloudEventSource = 22
 	CloudEventSource_CLOUD_EVENT_SOURCE_MOCK              CloudEventSource = 99
 )
 
@@ -132,6 +159,12 @@ var (
 		14: "CLOUD_EVENT_SOURCE_GITHUB",
 		15: "CLOUD_EVENT_SOURCE_APPLE_HEALTH",
 		16: "CLOUD_EVENT_SOURCE_HEALTH_CONNECT",
+		17: "CLOUD_EVENT_SOURCE_WHOOP",
+		18: "CLOUD_EVENT_SOURCE_ZWIFT",
+		19: "CLOUD_EVENT_SOURCE_BACKFILL",
+		20: "CLOUD_EVENT_SOURCE_ARCHIVE_EXPORT",
+		21: "CLOUD_EVENT_SOURCE_DATA_EXPORT",
+		22: "CLOUD_EVENT_SOURCE_USER_DELETION",
 		99: "CLOUD_EVENT_SOURCE_MOCK",
 	}
 	CloudEventSource_value = map[string]int32{
@@ -152,6 +185,12 @@ var (
 		"CLOUD_EVENT_SOURCE_GITHUB":            14,
 		"CLOUD_EVENT_SOURCE_APPLE_HEALTH":      15,
 		"CLOUD_EVENT_SOURCE_HEALTH_CONNECT":    16,
+		"CLOUD_EVENT_SOURCE_WHOOP":             17,
+		"CLOUD_EVENT_SOURCE_ZWIFT":             18,
+		"CLOUD_EVENT_SOURCE_BACKFILL":          19,
+		"CLOUD_EVENT_SOURCE_ARCHIVE_EXPORT":    20,
+		"CLOUD_EVENT_SOURCE_DATA_EXPORT":       21,
+		"CLOUD_EVENT_SOURCE_USER_DELETION":     22,
 		"CLOUD_EVENT_SOURCE_MOCK":              99,
 	}
 )
@@ -183,6 +222,56 @@ func (CloudEventSource) EnumDescriptor() ([]byte, []int) {
 	return file_models_events_pipeline_proto_rawDescGZIP(), []int{1}
 }
 
+// Where a static site export of the public archive is delivered.
+type ArchiveExportTarget int32
+
+const (
+	ArchiveExportTarget_ARCHIVE_EXPORT_TARGET_UNSPECIFIED  ArchiveExportTarget = 0
+	ArchiveExportTarget_ARCHIVE_EXPORT_TARGET_ZIP          ArchiveExportTarget = 1 // Zip in GCS, link sent as a push notification
+	ArchiveExportTarget_ARCHIVE_EXPORT_TARGET_GITHUB_PAGES ArchiveExportTarget = 2 // Committed to a branch of the user's repo
+)
+
+// Enum value maps for ArchiveExportTarget.
+var (
+	ArchiveExportTarget_name = map[int32]string{
+		0: "ARCHIVE_EXPORT_TARGET_UNSPECIFIED",
+		1: "ARCHIVE_EXPORT_TARGET_ZIP",
+		2: "ARCHIVE_EXPORT_TARGET_GITHUB_PAGES",
+	}
+	ArchiveExportTarget_value = map[string]int32{
+		"ARCHIVE_EXPORT_TARGET_UNSPECIFIED":  0,
+		"ARCHIVE_EXPORT_TARGET_ZIP":          1,
+		"ARCHIVE_EXPORT_TARGET_GITHUB_PAGES": 2,
+	}
+)
+
+func (x ArchiveExportTarget) Enum() *ArchiveExportTarget {
+	p := new(ArchiveExportTarget)
+	*p = x
+	return p
+}
+
+func (x ArchiveExportTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArchiveExportTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_events_pipeline_proto_enumTypes[2].Descriptor()
+}
+
+func (ArchiveExportTarget) Type() protoreflect.EnumType {
+	return &file_models_events_pipeline_proto_enumTypes[2]
+}
+
+func (x ArchiveExportTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArchiveExportTarget.Descriptor instead.
+func (ArchiveExportTarget) EnumDescriptor() ([]byte, []int) {
+	return file_models_events_pipeline_proto_rawDescGZIP(), []int{2}
+}
+
 type ActivityPayload struct {
 	state                protoimpl.MessageState         `protogen:"open.v1"`
 	Source               activity.ActivitySource        `protobuf:"varint,1,opt,name=source,proto3,enum=fitglue.models.activity.ActivitySource" json:"source,omitempty"`
@@ -202,8 +291,39 @@ type ActivityPayload struct {
 	IsRepost             bool                           `protobuf:"varint,15,opt,name=is_repost,json=isRepost,proto3" json:"is_repost,omitempty"`
 	RepostMode           string                         `protobuf:"bytes,16,opt,name=repost_mode,json=repostMode,proto3" json:"repost_mode,omitempty"`
 	RepostDestination    string                         `protobuf:"bytes,17,opt,name=repost_destination,json=repostDestination,proto3" json:"repost_destination,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	IsBackfill           bool                           `protobuf:"varint,18,opt,name=is_backfill,json=isBackfill,proto3" json:"is_backfill,omitempty"`
+	IsReconciled         bool                           `protobuf:"varint,19,opt,name=is_reconciled,json=isReconciled,proto3" json:"is_reconciled,omitempty"`
+	// Set on reposts so the enricher replays against the pipeline config
+	// version that originally ran, not the current one.
+	PipelineConfigVersion int32 `protobuf:"varint,20,opt,name=pipeline_config_version,json=pipelineConfigVersion,proto3" json:"pipeline_config_version,omitempty"`
+	// The user confirmed that a same-source update may overwrite description
+	// edits they made on the destination (see DescriptionMergePreview).
+	ConfirmDescriptionOverwrite bool `protobuf:"varint,21,opt,name=confirm_description_overwrite,json=confirmDescriptionOverwrite,proto3" json:"confirm_description_overwrite,omitempty"`
+	// Shared by every pipeline the splitter fanned this activity out to, so
+	// providers that opt in can reuse each other's results (see
+	// EnricherResultShare). Empty when the activity went to one pipeline.
+	FanOutId *string `protobuf:"bytes,22,opt,name=fan_out_id,json=fanOutId,proto3,oneof" json:"fan_out_id,omitempty"`
+	// A synthetic activity pushed through a new starter pipeline. The enricher
+	// routes it to the sandbox (mock) destination instead of the pipeline's.
+	IsTest bool `protobuf:"varint,23,opt,name=is_test,json=isTest,proto3" json:"is_test,omitempty"`
+	// Scheduled retries each enricher has already had for this run, by
+	// provider name. Set when a scheduled retry resumes the run.
+	RetryAttempts map[string]int32 `protobuf:"bytes,24,rep,name=retry_attempts,json=retryAttempts,proto3" json:"retry_attempts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Set on replays: a repost run with parts of the pipeline config replaced.
+	// The enricher labels the new run with replay_of.
+	ReplayOverride *ReplayOverride `protobuf:"bytes,25,opt,name=replay_override,json=replayOverride,proto3" json:"replay_override,omitempty"`
+	ReplayOf       *string         `protobuf:"bytes,26,opt,name=replay_of,json=replayOf,proto3,oneof" json:"replay_of,omitempty"` // PipelineRun id the replay was made from
+	// The user edited the activity on its source platform (e.g. renamed it on
+	// Strava). The splitter re-runs the activity's existing runs with the edited
+	// metadata instead of fanning it out, and the enricher runs a metadata-only
+	// pass that skips stream enrichers and doesn't write back to the source.
+	IsSourceUpdate bool `protobuf:"varint,27,opt,name=is_source_update,json=isSourceUpdate,proto3" json:"is_source_update,omitempty"`
+	// The activity was deleted on its source platform. The splitter marks its
+	// runs DELETED_AT_SOURCE and, if the user's deletion policy asks, has the
+	// destination service take down what was uploaded.
+	IsSourceDeletion bool `protobuf:"varint,28,opt,name=is_source_deletion,json=isSourceDeletion,proto3" json:"is_source_deletion,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ActivityPayload) Reset() {
@@ -355,6 +475,238 @@ func (x *ActivityPayload) GetRepostDestination() string {
 	return ""
 }
 
+func (x *ActivityPayload) GetIsBackfill() bool {
+	if x != nil {
+		return x.IsBackfill
+	}
+	return false
+}
+
+func (x *ActivityPayload) GetIsReconciled() bool {
+	if x != nil {
+		return x.IsReconciled
+	}
+	return false
+}
+
+func (x *ActivityPayload) GetPipelineConfigVersion() int32 {
+	if x != nil {
+		return x.PipelineConfigVersion
+	}
+	return 0
+}
+
+func (x *ActivityPayload) GetConfirmDescriptionOverwrite() bool {
+	if x != nil {
+		return x.ConfirmDescriptionOverwrite
+	}
+	return false
+}
+
+func (x *ActivityPayload) GetFanOutId() string {
+	if x != nil && x.FanOutId != nil {
+		return *x.FanOutId
+	}
+	return ""
+}
+
+func (x *ActivityPayload) GetIsTest() bool {
+	if x != nil {
+		return x.IsTest
+	}
+	return false
+}
+
+func (x *ActivityPayload) GetRetryAttempts() map[string]int32 {
+	if x != nil {
+		return x.RetryAttempts
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetReplayOverride() *ReplayOverride {
+	if x != nil {
+		return x.ReplayOverride
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetReplayOf() string {
+	if x != nil && x.ReplayOf != nil {
+		return *x.ReplayOf
+	}
+	return ""
+}
+
+func (x *ActivityPayload) GetIsSourceUpdate() bool {
+	if x != nil {
+		return x.IsSourceUpdate
+	}
+	return false
+}
+
+func (x *ActivityPayload) GetIsSourceDeletion() bool {
+	if x != nil {
+		return x.IsSourceDeletion
+	}
+	return false
+}
+
+// Replaces parts of the stored pipeline config for one replay run, e.g. to
+// try an enricher on an old activity. An empty list keeps the stored config.
+type ReplayOverride struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Enrichers     []*ReplayEnricher        `protobuf:"bytes,1,rep,name=enrichers,proto3" json:"enrichers,omitempty"`
+	Destinations  []plugin.DestinationType `protobuf:"varint,2,rep,packed,name=destinations,proto3,enum=fitglue.models.plugin.DestinationType" json:"destinations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayOverride) Reset() {
+	*x = ReplayOverride{}
+	mi := &file_models_events_pipeline_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayOverride) ProtoMessage() {}
+
+func (x *ReplayOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_models_events_pipeline_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayOverride.ProtoReflect.Descriptor instead.
+func (*ReplayOverride) Descriptor() ([]byte, []int) {
+	return file_models_events_pipeline_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReplayOverride) GetEnrichers() []*ReplayEnricher {
+	if x != nil {
+		return x.Enrichers
+	}
+	return nil
+}
+
+func (x *ReplayOverride) GetDestinations() []plugin.DestinationType {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+// An enricher in a ReplayOverride, mirroring pipeline.EnricherConfig (which
+// this package cannot import).
+type ReplayEnricher struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	ProviderType  plugin.EnricherProviderType `protobuf:"varint,1,opt,name=provider_type,json=providerType,proto3,enum=fitglue.models.plugin.EnricherProviderType" json:"provider_type,omitempty"`
+	TypedConfig   map[string]string           `protobuf:"bytes,2,rep,name=typed_config,json=typedConfig,proto3" json:"typed_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayEnricher) Reset() {
+	*x = ReplayEnricher{}
+	mi := &file_models_events_pipeline_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayEnricher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayEnricher) ProtoMessage() {}
+
+func (x *ReplayEnricher) ProtoReflect() protoreflect.Message {
+	mi := &file_models_events_pipeline_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayEnricher.ProtoReflect.Descriptor instead.
+func (*ReplayEnricher) Descriptor() ([]byte, []int) {
+	return file_models_events_pipeline_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReplayEnricher) GetProviderType() plugin.EnricherProviderType {
+	if x != nil {
+		return x.ProviderType
+	}
+	return plugin.EnricherProviderType(0)
+}
+
+func (x *ReplayEnricher) GetTypedConfig() map[string]string {
+	if x != nil {
+		return x.TypedConfig
+	}
+	return nil
+}
+
+// Several per-pipeline payloads in one pipeline-activity message, so bulk
+// imports pay for one enricher invocation rather than one per activity. Each
+// payload is processed, and gets its own PipelineRun, as if sent alone.
+type ActivityPayloadBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payloads      []*ActivityPayload     `protobuf:"bytes,1,rep,name=payloads,proto3" json:"payloads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityPayloadBatch) Reset() {
+	*x = ActivityPayloadBatch{}
+	mi := &file_models_events_pipeline_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityPayloadBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityPayloadBatch) ProtoMessage() {}
+
+func (x *ActivityPayloadBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_models_events_pipeline_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityPayloadBatch.ProtoReflect.Descriptor instead.
+func (*ActivityPayloadBatch) Descriptor() ([]byte, []int) {
+	return file_models_events_pipeline_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivityPayloadBatch) GetPayloads() []*ActivityPayload {
+	if x != nil {
+		return x.Payloads
+	}
+	return nil
+}
+
 type EnrichedActivityEvent struct {
 	state               protoimpl.MessageState         `protogen:"open.v1"`
 	ActivityId          string                         `protobuf:"bytes,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
@@ -379,7 +731,7 @@ type EnrichedActivityEvent struct {
 
 func (x *EnrichedActivityEvent) Reset() {
 	*x = EnrichedActivityEvent{}
-	mi := &file_models_events_pipeline_proto_msgTypes[1]
+	mi := &file_models_events_pipeline_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -391,7 +743,7 @@ func (x *EnrichedActivityEvent) String() string {
 func (*EnrichedActivityEvent) ProtoMessage() {}
 
 func (x *EnrichedActivityEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_models_events_pipeline_proto_msgTypes[1]
+	mi := &file_models_events_pipeline_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,7 +756,7 @@ func (x *EnrichedActivityEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnrichedActivityEvent.ProtoReflect.Descriptor instead.
 func (*EnrichedActivityEvent) Descriptor() ([]byte, []int) {
-	return file_models_events_pipeline_proto_rawDescGZIP(), []int{1}
+	return file_models_events_pipeline_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *EnrichedActivityEvent) GetActivityId() string {
@@ -531,7 +883,7 @@ type MessagePublishedData struct {
 
 func (x *MessagePublishedData) Reset() {
 	*x = MessagePublishedData{}
-	mi := &file_models_events_pipeline_proto_msgTypes[2]
+	mi := &file_models_events_pipeline_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -543,7 +895,7 @@ func (x *MessagePublishedData) String() string {
 func (*MessagePublishedData) ProtoMessage() {}
 
 func (x *MessagePublishedData) ProtoReflect() protoreflect.Message {
-	mi := &file_models_events_pipeline_proto_msgTypes[2]
+	mi := &file_models_events_pipeline_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -556,7 +908,7 @@ func (x *MessagePublishedData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessagePublishedData.ProtoReflect.Descriptor instead.
 func (*MessagePublishedData) Descriptor() ([]byte, []int) {
-	return file_models_events_pipeline_proto_rawDescGZIP(), []int{2}
+	return file_models_events_pipeline_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *MessagePublishedData) GetData() []byte {
@@ -587,6 +939,358 @@ func (x *MessagePublishedData) GetPublishTime() string {
 	return ""
 }
 
+type BackfillRequestedEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	JobId string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// The page this request is for: the job's pages_fetched and cursor when
+	// it was published. A redelivered request whose page has already been
+	// processed no longer matches the job and is dropped.
+	Page          int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackfillRequestedEvent) Reset() {
+	*x = BackfillRequestedEvent{}
+	mi := &file_models_events_pipeline_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillRequestedEvent) ProtoMessage() {}
+
+func (x *BackfillRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_models_events_pipeline_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillRequestedEvent.ProtoReflect.Descriptor instead.
+func (*BackfillRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_models_events_pipeline_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BackfillRequestedEvent) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *BackfillRequestedEvent) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *BackfillRequestedEvent) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ImportRequestedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRequestedEvent) Reset() {
+	*x = ImportRequestedEvent{}
+	mi := &file_models_events_pipeline_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRequestedEvent) ProtoMessage() {}
+
+func (x *ImportRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_models_events_pipeline_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRequestedEvent.ProtoReflect.Descriptor instead.
+func (*ImportRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_models_events_pipeline_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ImportRequestedEvent) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// A parsed webhook event, acknowledged to the provider but not yet resolved
+// to a user or fetched.
+type WebhookReceivedEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Provider       string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ProviderUserId string                 `protobuf:"bytes,2,opt,name=provider_user_id,json=providerUserId,proto3" json:"provider_user_id,omitempty"`
+	ActivityId     string                 `protobuf:"bytes,3,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Event          string                 `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	RawPayload     []byte                 `protobuf:"bytes,5,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookReceivedEvent) Reset() {
+	*x = WebhookReceivedEvent{}
+	mi := &file_models_events_pipeline_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookReceivedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookReceivedEvent) ProtoMessage() {}
+
+func (x *WebhookReceivedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_models_events_pipeline_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookReceivedEvent.ProtoReflect.Descriptor instead.
+func (*WebhookReceivedEvent) Descriptor() ([]byte, []int) {
+	return file_models_events_pipeline_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WebhookReceivedEvent) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *WebhookReceivedEvent) GetProviderUserId() string {
+	if x != nil {
+		return x.ProviderUserId
+	}
+	return ""
+}
+
+func (x *WebhookReceivedEvent) GetActivityId() string {
+	if x != nil {
+		return x.ActivityId
+	}
+	return ""
+}
+
+func (x *WebhookReceivedEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookReceivedEvent) GetRawPayload() []byte {
+	if x != nil {
+		return x.RawPayload
+	}
+	return nil
+}
+
+type ArchiveExportRequestedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Target        ArchiveExportTarget    `protobuf:"varint,2,opt,name=target,proto3,enum=fitglue.models.events.ArchiveExportTarget" json:"target,omitempty"`
+	GithubRepo    string                 `protobuf:"bytes,3,opt,name=github_repo,json=githubRepo,proto3" json:"github_repo,omitempty"`       // "owner/repo", GitHub Pages only
+	GithubBranch  string                 `protobuf:"bytes,4,opt,name=github_branch,json=githubBranch,proto3" json:"github_branch,omitempty"` // Defaults to gh-pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveExportRequestedEvent) Reset() {
+	*x = ArchiveExportRequestedEvent{}
+	mi := &file_models_events_pipeline_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveExportRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveExportRequestedEvent) ProtoMessage() {}
+
+func (x *ArchiveExportRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_models_events_pipeline_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveExportRequestedEvent.ProtoReflect.Descriptor instead.
+func (*ArchiveExportRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_models_events_pipeline_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ArchiveExportRequestedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ArchiveExportRequestedEvent) GetTarget() ArchiveExportTarget {
+	if x != nil {
+		return x.Target
+	}
+	return ArchiveExportTarget_ARCHIVE_EXPORT_TARGET_UNSPECIFIED
+}
+
+func (x *ArchiveExportRequestedEvent) GetGithubRepo() string {
+	if x != nil {
+		return x.GithubRepo
+	}
+	return ""
+}
+
+func (x *ArchiveExportRequestedEvent) GetGithubBranch() string {
+	if x != nil {
+		return x.GithubBranch
+	}
+	return ""
+}
+
+// Requests a zip of everything held for a user, link sent as a push notification.
+type DataExportRequestedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataExportRequestedEvent) Reset() {
+	*x = DataExportRequestedEvent{}
+	mi := &file_models_events_pipeline_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataExportRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExportRequestedEvent) ProtoMessage() {}
+
+func (x *DataExportRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_models_events_pipeline_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExportRequestedEvent.ProtoReflect.Descriptor instead.
+func (*DataExportRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_models_events_pipeline_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DataExportRequestedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Requests deletion of a user and everything held for them, including stored
+// artifacts and provider authorizations.
+type UserDeletionRequestedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"` // "self", or "admin:{uid}"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDeletionRequestedEvent) Reset() {
+	*x = UserDeletionRequestedEvent{}
+	mi := &file_models_events_pipeline_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDeletionRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeletionRequestedEvent) ProtoMessage() {}
+
+func (x *UserDeletionRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_models_events_pipeline_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeletionRequestedEvent.ProtoReflect.Descriptor instead.
+func (*UserDeletionRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_models_events_pipeline_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UserDeletionRequestedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserDeletionRequestedEvent) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
 var file_models_events_pipeline_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
@@ -618,7 +1322,7 @@ var File_models_events_pipeline_proto protoreflect.FileDescriptor
 
 const file_models_events_pipeline_proto_rawDesc = "" +
 	"\n" +
-	"\x1cmodels/events/pipeline.proto\x12\x15fitglue.models.events\x1a google/protobuf/descriptor.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"models/activity/standardized.proto\x1a\x1cmodels/activity/source.proto\x1a\x1cmodels/plugin/provider.proto\"\x98\b\n" +
+	"\x1cmodels/events/pipeline.proto\x12\x15fitglue.models.events\x1a google/protobuf/descriptor.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"models/activity/standardized.proto\x1a\x1cmodels/activity/source.proto\x1a\x1cmodels/plugin/provider.proto\"\xa1\r\n" +
 	"\x0fActivityPayload\x12?\n" +
 	"\x06source\x18\x01 \x01(\x0e2'.fitglue.models.activity.ActivitySourceR\x06source\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x128\n" +
@@ -640,15 +1344,45 @@ const file_models_events_pipeline_proto_rawDesc = "" +
 	"\tis_repost\x18\x0f \x01(\bR\bisRepost\x12\x1f\n" +
 	"\vrepost_mode\x18\x10 \x01(\tR\n" +
 	"repostMode\x12-\n" +
-	"\x12repost_destination\x18\x11 \x01(\tR\x11repostDestination\x1a;\n" +
+	"\x12repost_destination\x18\x11 \x01(\tR\x11repostDestination\x12\x1f\n" +
+	"\vis_backfill\x18\x12 \x01(\bR\n" +
+	"isBackfill\x12#\n" +
+	"\ris_reconciled\x18\x13 \x01(\bR\fisReconciled\x126\n" +
+	"\x17pipeline_config_version\x18\x14 \x01(\x05R\x15pipelineConfigVersion\x12B\n" +
+	"\x1dconfirm_description_overwrite\x18\x15 \x01(\bR\x1bconfirmDescriptionOverwrite\x12!\n" +
+	"\n" +
+	"fan_out_id\x18\x16 \x01(\tH\x05R\bfanOutId\x88\x01\x01\x12\x17\n" +
+	"\ais_test\x18\x17 \x01(\bR\x06isTest\x12`\n" +
+	"\x0eretry_attempts\x18\x18 \x03(\v29.fitglue.models.events.ActivityPayload.RetryAttemptsEntryR\rretryAttempts\x12N\n" +
+	"\x0freplay_override\x18\x19 \x01(\v2%.fitglue.models.events.ReplayOverrideR\x0ereplayOverride\x12 \n" +
+	"\treplay_of\x18\x1a \x01(\tH\x06R\breplayOf\x88\x01\x01\x12(\n" +
+	"\x10is_source_update\x18\x1b \x01(\bR\x0eisSourceUpdate\x12,\n" +
+	"\x12is_source_deletion\x18\x1c \x01(\bR\x10isSourceDeletion\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x18\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a@\n" +
+	"\x12RetryAttemptsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01B\x18\n" +
 	"\x16_pipeline_execution_idB\x0e\n" +
 	"\f_activity_idB\x0e\n" +
 	"\f_pipeline_idB\x1a\n" +
 	"\x18_resume_pending_input_idB\x15\n" +
-	"\x13_origin_destination\"\xb4\a\n" +
+	"\x13_origin_destinationB\r\n" +
+	"\v_fan_out_idB\f\n" +
+	"\n" +
+	"_replay_of\"\xa1\x01\n" +
+	"\x0eReplayOverride\x12C\n" +
+	"\tenrichers\x18\x01 \x03(\v2%.fitglue.models.events.ReplayEnricherR\tenrichers\x12J\n" +
+	"\fdestinations\x18\x02 \x03(\x0e2&.fitglue.models.plugin.DestinationTypeR\fdestinations\"\xfd\x01\n" +
+	"\x0eReplayEnricher\x12P\n" +
+	"\rprovider_type\x18\x01 \x01(\x0e2+.fitglue.models.plugin.EnricherProviderTypeR\fproviderType\x12Y\n" +
+	"\ftyped_config\x18\x02 \x03(\v26.fitglue.models.events.ReplayEnricher.TypedConfigEntryR\vtypedConfig\x1a>\n" +
+	"\x10TypedConfigEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Z\n" +
+	"\x14ActivityPayloadBatch\x12B\n" +
+	"\bpayloads\x18\x01 \x03(\v2&.fitglue.models.events.ActivityPayloadR\bpayloads\"\xb4\a\n" +
 	"\x15EnrichedActivityEvent\x12\x1f\n" +
 	"\vactivity_id\x18\x01 \x01(\tR\n" +
 	"activityId\x12\x17\n" +
@@ -685,7 +1419,33 @@ const file_models_events_pipeline_proto_rawDesc = "" +
 	"\fpublish_time\x18\x04 \x01(\tR\vpublishTime\x1a=\n" +
 	"\x0fAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\xa3\x04\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"[\n" +
+	"\x16BackfillRequestedEvent\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"5\n" +
+	"\x14ImportRequestedEvent\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\xb4\x01\n" +
+	"\x14WebhookReceivedEvent\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12(\n" +
+	"\x10provider_user_id\x18\x02 \x01(\tR\x0eproviderUserId\x12\x1f\n" +
+	"\vactivity_id\x18\x03 \x01(\tR\n" +
+	"activityId\x12\x14\n" +
+	"\x05event\x18\x04 \x01(\tR\x05event\x12\x1f\n" +
+	"\vraw_payload\x18\x05 \x01(\fR\n" +
+	"rawPayload\"\xc0\x01\n" +
+	"\x1bArchiveExportRequestedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12B\n" +
+	"\x06target\x18\x02 \x01(\x0e2*.fitglue.models.events.ArchiveExportTargetR\x06target\x12\x1f\n" +
+	"\vgithub_repo\x18\x03 \x01(\tR\n" +
+	"githubRepo\x12#\n" +
+	"\rgithub_branch\x18\x04 \x01(\tR\fgithubBranch\"3\n" +
+	"\x18DataExportRequestedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"X\n" +
+	"\x1aUserDeletionRequestedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy*\xca\b\n" +
 	"\x0eCloudEventType\x12 \n" +
 	"\x1cCLOUD_EVENT_TYPE_UNSPECIFIED\x10\x00\x12G\n" +
 	"!CLOUD_EVENT_TYPE_ACTIVITY_CREATED\x10\x01\x1a \x82\xb5\x18\x1ccom.fitglue.activity.created\x12I\n" +
@@ -694,7 +1454,15 @@ const file_models_events_pipeline_proto_rawDesc = "" +
 	"$CLOUD_EVENT_TYPE_FITBIT_NOTIFICATION\x10\x04\x1a#\x82\xb5\x18\x1fcom.fitglue.fitbit.notification\x12C\n" +
 	"\x1fCLOUD_EVENT_TYPE_ENRICHMENT_LAG\x10\x05\x1a\x1e\x82\xb5\x18\x1acom.fitglue.enrichment.lag\x12C\n" +
 	"\x1fCLOUD_EVENT_TYPE_INPUT_RESOLVED\x10\x06\x1a\x1e\x82\xb5\x18\x1acom.fitglue.input.resolved\x12E\n" +
-	" CLOUD_EVENT_TYPE_PARKRUN_RESULTS\x10\a\x1a\x1f\x82\xb5\x18\x1bcom.fitglue.parkrun.results*\xdf\b\n" +
+	" CLOUD_EVENT_TYPE_PARKRUN_RESULTS\x10\a\x1a\x1f\x82\xb5\x18\x1bcom.fitglue.parkrun.results\x12K\n" +
+	"#CLOUD_EVENT_TYPE_BACKFILL_REQUESTED\x10\b\x1a\"\x82\xb5\x18\x1ecom.fitglue.backfill.requested\x12W\n" +
+	")CLOUD_EVENT_TYPE_ARCHIVE_EXPORT_REQUESTED\x10\t\x1a(\x82\xb5\x18$com.fitglue.archive.export.requested\x12C\n" +
+	"\x1fCLOUD_EVENT_TYPE_ACTIVITY_BATCH\x10\n" +
+	"\x1a\x1e\x82\xb5\x18\x1acom.fitglue.activity.batch\x12G\n" +
+	"!CLOUD_EVENT_TYPE_IMPORT_REQUESTED\x10\v\x1a \x82\xb5\x18\x1ccom.fitglue.import.requested\x12G\n" +
+	"!CLOUD_EVENT_TYPE_WEBHOOK_RECEIVED\x10\f\x1a \x82\xb5\x18\x1ccom.fitglue.webhook.received\x12Q\n" +
+	"&CLOUD_EVENT_TYPE_DATA_EXPORT_REQUESTED\x10\r\x1a%\x82\xb5\x18!com.fitglue.data.export.requested\x12U\n" +
+	"(CLOUD_EVENT_TYPE_USER_DELETION_REQUESTED\x10\x0e\x1a'\x82\xb5\x18#com.fitglue.user.deletion.requested*\xbd\v\n" +
 	"\x10CloudEventSource\x12\"\n" +
 	"\x1eCLOUD_EVENT_SOURCE_UNSPECIFIED\x10\x00\x123\n" +
 	"\x17CLOUD_EVENT_SOURCE_HEVY\x10\x01\x1a\x16\x8a\xb5\x18\x12/integrations/hevy\x12G\n" +
@@ -713,8 +1481,18 @@ const file_models_events_pipeline_proto_rawDesc = "" +
 	"$CLOUD_EVENT_SOURCE_PIPELINE_SPLITTER\x10\r\x1a\x1b\x8a\xb5\x18\x17/core/pipeline-splitter\x127\n" +
 	"\x19CLOUD_EVENT_SOURCE_GITHUB\x10\x0e\x1a\x18\x8a\xb5\x18\x14/integrations/github\x12C\n" +
 	"\x1fCLOUD_EVENT_SOURCE_APPLE_HEALTH\x10\x0f\x1a\x1e\x8a\xb5\x18\x1a/integrations/apple-health\x12G\n" +
-	"!CLOUD_EVENT_SOURCE_HEALTH_CONNECT\x10\x10\x1a \x8a\xb5\x18\x1c/integrations/health-connect\x123\n" +
-	"\x17CLOUD_EVENT_SOURCE_MOCK\x10c\x1a\x16\x8a\xb5\x18\x12/integrations/mock:<\n" +
+	"!CLOUD_EVENT_SOURCE_HEALTH_CONNECT\x10\x10\x1a \x8a\xb5\x18\x1c/integrations/health-connect\x125\n" +
+	"\x18CLOUD_EVENT_SOURCE_WHOOP\x10\x11\x1a\x17\x8a\xb5\x18\x13/integrations/whoop\x125\n" +
+	"\x18CLOUD_EVENT_SOURCE_ZWIFT\x10\x12\x1a\x17\x8a\xb5\x18\x13/integrations/zwift\x123\n" +
+	"\x1bCLOUD_EVENT_SOURCE_BACKFILL\x10\x13\x1a\x12\x8a\xb5\x18\x0e/core/backfill\x12?\n" +
+	"!CLOUD_EVENT_SOURCE_ARCHIVE_EXPORT\x10\x14\x1a\x18\x8a\xb5\x18\x14/core/archive-export\x129\n" +
+	"\x1eCLOUD_EVENT_SOURCE_DATA_EXPORT\x10\x15\x1a\x15\x8a\xb5\x18\x11/core/data-export\x12=\n" +
+	" CLOUD_EVENT_SOURCE_USER_DELETION\x10\x16\x1a\x17\x8a\xb5\x18\x13/core/user-deletion\x123\n" +
+	"\x17CLOUD_EVENT_SOURCE_MOCK\x10c\x1a\x16\x8a\xb5\x18\x12/integrations/mock*\x83\x01\n" +
+	"\x13ArchiveExportTarget\x12%\n" +
+	"!ARCHIVE_EXPORT_TARGET_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19ARCHIVE_EXPORT_TARGET_ZIP\x10\x01\x12&\n" +
+	"\"ARCHIVE_EXPORT_TARGET_GITHUB_PAGES\x10\x02:<\n" +
 	"\ace_type\x12!.google.protobuf.EnumValueOptions\x18І\x03 \x01(\tR\x06ceType:@\n" +
 	"\tce_source\x12!.google.protobuf.EnumValueOptions\x18ц\x03 \x01(\tR\bceSourceB=Z;github.com/fitglue/server/src/go/pkg/types/pb/models/eventsb\x06proto3"
 
@@ -730,43 +1508,64 @@ func file_models_events_pipeline_proto_rawDescGZIP() []byte {
 	return file_models_events_pipeline_proto_rawDescData
 }
 
-var file_models_events_pipeline_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_models_events_pipeline_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_models_events_pipeline_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_models_events_pipeline_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_models_events_pipeline_proto_goTypes = []any{
 	(CloudEventType)(0),                   // 0: fitglue.models.events.CloudEventType
 	(CloudEventSource)(0),                 // 1: fitglue.models.events.CloudEventSource
-	(*ActivityPayload)(nil),               // 2: fitglue.models.events.ActivityPayload
-	(*EnrichedActivityEvent)(nil),         // 3: fitglue.models.events.EnrichedActivityEvent
-	(*MessagePublishedData)(nil),          // 4: fitglue.models.events.MessagePublishedData
-	nil,                                   // 5: fitglue.models.events.ActivityPayload.MetadataEntry
-	nil,                                   // 6: fitglue.models.events.EnrichedActivityEvent.EnrichmentMetadataEntry
-	nil,                                   // 7: fitglue.models.events.MessagePublishedData.AttributesEntry
-	(activity.ActivitySource)(0),          // 8: fitglue.models.activity.ActivitySource
-	(*timestamppb.Timestamp)(nil),         // 9: google.protobuf.Timestamp
-	(*activity.StandardizedActivity)(nil), // 10: fitglue.models.activity.StandardizedActivity
-	(activity.ActivityType)(0),            // 11: fitglue.models.activity.ActivityType
-	(plugin.DestinationType)(0),           // 12: fitglue.models.plugin.DestinationType
-	(*descriptorpb.EnumValueOptions)(nil), // 13: google.protobuf.EnumValueOptions
+	(ArchiveExportTarget)(0),              // 2: fitglue.models.events.ArchiveExportTarget
+	(*ActivityPayload)(nil),               // 3: fitglue.models.events.ActivityPayload
+	(*ReplayOverride)(nil),                // 4: fitglue.models.events.ReplayOverride
+	(*ReplayEnricher)(nil),                // 5: fitglue.models.events.ReplayEnricher
+	(*ActivityPayloadBatch)(nil),          // 6: fitglue.models.events.ActivityPayloadBatch
+	(*EnrichedActivityEvent)(nil),         // 7: fitglue.models.events.EnrichedActivityEvent
+	(*MessagePublishedData)(nil),          // 8: fitglue.models.events.MessagePublishedData
+	(*BackfillRequestedEvent)(nil),        // 9: fitglue.models.events.BackfillRequestedEvent
+	(*ImportRequestedEvent)(nil),          // 10: fitglue.models.events.ImportRequestedEvent
+	(*WebhookReceivedEvent)(nil),          // 11: fitglue.models.events.WebhookReceivedEvent
+	(*ArchiveExportRequestedEvent)(nil),   // 12: fitglue.models.events.ArchiveExportRequestedEvent
+	(*DataExportRequestedEvent)(nil),      // 13: fitglue.models.events.DataExportRequestedEvent
+	(*UserDeletionRequestedEvent)(nil),    // 14: fitglue.models.events.UserDeletionRequestedEvent
+	nil,                                   // 15: fitglue.models.events.ActivityPayload.MetadataEntry
+	nil,                                   // 16: fitglue.models.events.ActivityPayload.RetryAttemptsEntry
+	nil,                                   // 17: fitglue.models.events.ReplayEnricher.TypedConfigEntry
+	nil,                                   // 18: fitglue.models.events.EnrichedActivityEvent.EnrichmentMetadataEntry
+	nil,                                   // 19: fitglue.models.events.MessagePublishedData.AttributesEntry
+	(activity.ActivitySource)(0),          // 20: fitglue.models.activity.ActivitySource
+	(*timestamppb.Timestamp)(nil),         // 21: google.protobuf.Timestamp
+	(*activity.StandardizedActivity)(nil), // 22: fitglue.models.activity.StandardizedActivity
+	(plugin.DestinationType)(0),           // 23: fitglue.models.plugin.DestinationType
+	(plugin.EnricherProviderType)(0),      // 24: fitglue.models.plugin.EnricherProviderType
+	(activity.ActivityType)(0),            // 25: fitglue.models.activity.ActivityType
+	(*descriptorpb.EnumValueOptions)(nil), // 26: google.protobuf.EnumValueOptions
 }
 var file_models_events_pipeline_proto_depIdxs = []int32{
-	8,  // 0: fitglue.models.events.ActivityPayload.source:type_name -> fitglue.models.activity.ActivitySource
-	9,  // 1: fitglue.models.events.ActivityPayload.timestamp:type_name -> google.protobuf.Timestamp
-	5,  // 2: fitglue.models.events.ActivityPayload.metadata:type_name -> fitglue.models.events.ActivityPayload.MetadataEntry
-	10, // 3: fitglue.models.events.ActivityPayload.standardized_activity:type_name -> fitglue.models.activity.StandardizedActivity
-	11, // 4: fitglue.models.events.EnrichedActivityEvent.activity_type:type_name -> fitglue.models.activity.ActivityType
-	9,  // 5: fitglue.models.events.EnrichedActivityEvent.start_time:type_name -> google.protobuf.Timestamp
-	8,  // 6: fitglue.models.events.EnrichedActivityEvent.source:type_name -> fitglue.models.activity.ActivitySource
-	10, // 7: fitglue.models.events.EnrichedActivityEvent.activity_data:type_name -> fitglue.models.activity.StandardizedActivity
-	6,  // 8: fitglue.models.events.EnrichedActivityEvent.enrichment_metadata:type_name -> fitglue.models.events.EnrichedActivityEvent.EnrichmentMetadataEntry
-	12, // 9: fitglue.models.events.EnrichedActivityEvent.destinations:type_name -> fitglue.models.plugin.DestinationType
-	7,  // 10: fitglue.models.events.MessagePublishedData.attributes:type_name -> fitglue.models.events.MessagePublishedData.AttributesEntry
-	13, // 11: fitglue.models.events.ce_type:extendee -> google.protobuf.EnumValueOptions
-	13, // 12: fitglue.models.events.ce_source:extendee -> google.protobuf.EnumValueOptions
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	11, // [11:13] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	20, // 0: fitglue.models.events.ActivityPayload.source:type_name -> fitglue.models.activity.ActivitySource
+	21, // 1: fitglue.models.events.ActivityPayload.timestamp:type_name -> google.protobuf.Timestamp
+	15, // 2: fitglue.models.events.ActivityPayload.metadata:type_name -> fitglue.models.events.ActivityPayload.MetadataEntry
+	22, // 3: fitglue.models.events.ActivityPayload.standardized_activity:type_name -> fitglue.models.activity.StandardizedActivity
+	16, // 4: fitglue.models.events.ActivityPayload.retry_attempts:type_name -> fitglue.models.events.ActivityPayload.RetryAttemptsEntry
+	4,  // 5: fitglue.models.events.ActivityPayload.replay_override:type_name -> fitglue.models.events.ReplayOverride
+	5,  // 6: fitglue.models.events.ReplayOverride.enrichers:type_name -> fitglue.models.events.ReplayEnricher
+	23, // 7: fitglue.models.events.ReplayOverride.destinations:type_name -> fitglue.models.plugin.DestinationType
+	24, // 8: fitglue.models.events.ReplayEnricher.provider_type:type_name -> fitglue.models.plugin.EnricherProviderType
+	17, // 9: fitglue.models.events.ReplayEnricher.typed_config:type_name -> fitglue.models.events.ReplayEnricher.TypedConfigEntry
+	3,  // 10: fitglue.models.events.ActivityPayloadBatch.payloads:type_name -> fitglue.models.events.ActivityPayload
+	25, // 11: fitglue.models.events.EnrichedActivityEvent.activity_type:type_name -> fitglue.models.activity.ActivityType
+	21, // 12: fitglue.models.events.EnrichedActivityEvent.start_time:type_name -> google.protobuf.Timestamp
+	20, // 13: fitglue.models.events.EnrichedActivityEvent.source:type_name -> fitglue.models.activity.ActivitySource
+	22, // 14: fitglue.models.events.EnrichedActivityEvent.activity_data:type_name -> fitglue.models.activity.StandardizedActivity
+	18, // 15: fitglue.models.events.EnrichedActivityEvent.enrichment_metadata:type_name -> fitglue.models.events.EnrichedActivityEvent.EnrichmentMetadataEntry
+	23, // 16: fitglue.models.events.EnrichedActivityEvent.destinations:type_name -> fitglue.models.plugin.DestinationType
+	19, // 17: fitglue.models.events.MessagePublishedData.attributes:type_name -> fitglue.models.events.MessagePublishedData.AttributesEntry
+	2,  // 18: fitglue.models.events.ArchiveExportRequestedEvent.target:type_name -> fitglue.models.events.ArchiveExportTarget
+	26, // 19: fitglue.models.events.ce_type:extendee -> google.protobuf.EnumValueOptions
+	26, // 20: fitglue.models.events.ce_source:extendee -> google.protobuf.EnumValueOptions
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	19, // [19:21] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_models_events_pipeline_proto_init() }
@@ -775,14 +1574,14 @@ func file_models_events_pipeline_proto_init() {
 		return
 	}
 	file_models_events_pipeline_proto_msgTypes[0].OneofWrappers = []any{}
-	file_models_events_pipeline_proto_msgTypes[1].OneofWrappers = []any{}
+	file_models_events_pipeline_proto_msgTypes[4].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_events_pipeline_proto_rawDesc), len(file_models_events_pipeline_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   6,
+			NumEnums:      3,
+			NumMessages:   17,
 			NumExtensions: 2,
 			NumServices:   0,
 		},
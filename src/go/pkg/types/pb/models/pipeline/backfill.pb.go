@@ -0,0 +1,344 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: models/pipeline/backfill.proto
+
+package pipeline
+
+import (
+	activity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BackfillStatus int32
+
+const (
+	BackfillStatus_BACKFILL_STATUS_UNSPECIFIED BackfillStatus = 0
+	BackfillStatus_BACKFILL_STATUS_PENDING     BackfillStatus = 1
+	BackfillStatus_BACKFILL_STATUS_RUNNING     BackfillStatus = 2
+	BackfillStatus_BACKFILL_STATUS_COMPLETED   BackfillStatus = 3
+	BackfillStatus_BACKFILL_STATUS_FAILED      BackfillStatus = 4
+)
+
+// Enum value maps for BackfillStatus.
+var (
+	BackfillStatus_name = map[int32]string{
+		0: "BACKFILL_STATUS_UNSPECIFIED",
+		1: "BACKFILL_STATUS_PENDING",
+		2: "BACKFILL_STATUS_RUNNING",
+		3: "BACKFILL_STATUS_COMPLETED",
+		4: "BACKFILL_STATUS_FAILED",
+	}
+	BackfillStatus_value = map[string]int32{
+		"BACKFILL_STATUS_UNSPECIFIED": 0,
+		"BACKFILL_STATUS_PENDING":     1,
+		"BACKFILL_STATUS_RUNNING":     2,
+		"BACKFILL_STATUS_COMPLETED":   3,
+		"BACKFILL_STATUS_FAILED":      4,
+	}
+)
+
+func (x BackfillStatus) Enum() *BackfillStatus {
+	p := new(BackfillStatus)
+	*p = x
+	return p
+}
+
+func (x BackfillStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackfillStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_pipeline_backfill_proto_enumTypes[0].Descriptor()
+}
+
+func (BackfillStatus) Type() protoreflect.EnumType {
+	return &file_models_pipeline_backfill_proto_enumTypes[0]
+}
+
+func (x BackfillStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackfillStatus.Descriptor instead.
+func (BackfillStatus) EnumDescriptor() ([]byte, []int) {
+	return file_models_pipeline_backfill_proto_rawDescGZIP(), []int{0}
+}
+
+// BackfillJob tracks replaying a source's recent history into a single
+// pipeline. Stored in the top-level backfill_jobs collection and polled by
+// the web app while it runs.
+type BackfillJob struct {
+	state               protoimpl.MessageState  `protogen:"open.v1"`
+	Id                  string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId              string                  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PipelineId          string                  `protobuf:"bytes,3,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	Source              activity.ActivitySource `protobuf:"varint,4,opt,name=source,proto3,enum=fitglue.models.activity.ActivitySource" json:"source,omitempty"`
+	Status              BackfillStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=fitglue.models.pipeline.BackfillStatus" json:"status,omitempty"`
+	Since               *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"` // Oldest activity start time included
+	PagesFetched        int32                   `protobuf:"varint,7,opt,name=pages_fetched,json=pagesFetched,proto3" json:"pages_fetched,omitempty"`
+	ActivitiesPublished int32                   `protobuf:"varint,8,opt,name=activities_published,json=activitiesPublished,proto3" json:"activities_published,omitempty"`
+	ActivitiesFailed    int32                   `protobuf:"varint,9,opt,name=activities_failed,json=activitiesFailed,proto3" json:"activities_failed,omitempty"`
+	// Opaque source-specific paging cursor; empty before the first page.
+	Cursor      string                 `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Error       *string                `protobuf:"bytes,11,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Reconcile jobs come from the daily missed-activity check rather than a
+	// user request: they have no pipeline_id and only publish activities that
+	// never produced a pipeline run.
+	Reconcile         bool  `protobuf:"varint,15,opt,name=reconcile,proto3" json:"reconcile,omitempty"`
+	ActivitiesSkipped int32 `protobuf:"varint,16,opt,name=activities_skipped,json=activitiesSkipped,proto3" json:"activities_skipped,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BackfillJob) Reset() {
+	*x = BackfillJob{}
+	mi := &file_models_pipeline_backfill_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillJob) ProtoMessage() {}
+
+func (x *BackfillJob) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_backfill_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillJob.ProtoReflect.Descriptor instead.
+func (*BackfillJob) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_backfill_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BackfillJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BackfillJob) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BackfillJob) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *BackfillJob) GetSource() activity.ActivitySource {
+	if x != nil {
+		return x.Source
+	}
+	return activity.ActivitySource(0)
+}
+
+func (x *BackfillJob) GetStatus() BackfillStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BackfillStatus_BACKFILL_STATUS_UNSPECIFIED
+}
+
+func (x *BackfillJob) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *BackfillJob) GetPagesFetched() int32 {
+	if x != nil {
+		return x.PagesFetched
+	}
+	return 0
+}
+
+func (x *BackfillJob) GetActivitiesPublished() int32 {
+	if x != nil {
+		return x.ActivitiesPublished
+	}
+	return 0
+}
+
+func (x *BackfillJob) GetActivitiesFailed() int32 {
+	if x != nil {
+		return x.ActivitiesFailed
+	}
+	return 0
+}
+
+func (x *BackfillJob) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *BackfillJob) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *BackfillJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BackfillJob) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *BackfillJob) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *BackfillJob) GetReconcile() bool {
+	if x != nil {
+		return x.Reconcile
+	}
+	return false
+}
+
+func (x *BackfillJob) GetActivitiesSkipped() int32 {
+	if x != nil {
+		return x.ActivitiesSkipped
+	}
+	return 0
+}
+
+var File_models_pipeline_backfill_proto protoreflect.FileDescriptor
+
+const file_models_pipeline_backfill_proto_rawDesc = "" +
+	"\n" +
+	"\x1emodels/pipeline/backfill.proto\x12\x17fitglue.models.pipeline\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmodels/activity/source.proto\"\xcf\x05\n" +
+	"\vBackfillJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vpipeline_id\x18\x03 \x01(\tR\n" +
+	"pipelineId\x12?\n" +
+	"\x06source\x18\x04 \x01(\x0e2'.fitglue.models.activity.ActivitySourceR\x06source\x12?\n" +
+	"\x06status\x18\x05 \x01(\x0e2'.fitglue.models.pipeline.BackfillStatusR\x06status\x120\n" +
+	"\x05since\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12#\n" +
+	"\rpages_fetched\x18\a \x01(\x05R\fpagesFetched\x121\n" +
+	"\x14activities_published\x18\b \x01(\x05R\x13activitiesPublished\x12+\n" +
+	"\x11activities_failed\x18\t \x01(\x05R\x10activitiesFailed\x12\x16\n" +
+	"\x06cursor\x18\n" +
+	" \x01(\tR\x06cursor\x12\x19\n" +
+	"\x05error\x18\v \x01(\tH\x00R\x05error\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x1c\n" +
+	"\treconcile\x18\x0f \x01(\bR\treconcile\x12-\n" +
+	"\x12activities_skipped\x18\x10 \x01(\x05R\x11activitiesSkippedB\b\n" +
+	"\x06_error*\xa6\x01\n" +
+	"\x0eBackfillStatus\x12\x1f\n" +
+	"\x1bBACKFILL_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17BACKFILL_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17BACKFILL_STATUS_RUNNING\x10\x02\x12\x1d\n" +
+	"\x19BACKFILL_STATUS_COMPLETED\x10\x03\x12\x1a\n" +
+	"\x16BACKFILL_STATUS_FAILED\x10\x04B?Z=github.com/fitglue/server/src/go/pkg/types/pb/models/pipelineb\x06proto3"
+
+var (
+	file_models_pipeline_backfill_proto_rawDescOnce sync.Once
+	file_models_pipeline_backfill_proto_rawDescData []byte
+)
+
+func file_models_pipeline_backfill_proto_rawDescGZIP() []byte {
+	file_models_pipeline_backfill_proto_rawDescOnce.Do(func() {
+		file_models_pipeline_backfill_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_models_pipeline_backfill_proto_rawDesc), len(file_models_pipeline_backfill_proto_rawDesc)))
+	})
+	return file_models_pipeline_backfill_proto_rawDescData
+}
+
+var file_models_pipeline_backfill_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_models_pipeline_backfill_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_models_pipeline_backfill_proto_goTypes = []any{
+	(BackfillStatus)(0),           // 0: fitglue.models.pipeline.BackfillStatus
+	(*BackfillJob)(nil),           // 1: fitglue.models.pipeline.BackfillJob
+	(activity.ActivitySource)(0),  // 2: fitglue.models.activity.ActivitySource
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_models_pipeline_backfill_proto_depIdxs = []int32{
+	2, // 0: fitglue.models.pipeline.BackfillJob.source:type_name -> fitglue.models.activity.ActivitySource
+	0, // 1: fitglue.models.pipeline.BackfillJob.status:type_name -> fitglue.models.pipeline.BackfillStatus
+	3, // 2: fitglue.models.pipeline.BackfillJob.since:type_name -> google.protobuf.Timestamp
+	3, // 3: fitglue.models.pipeline.BackfillJob.created_at:type_name -> google.protobuf.Timestamp
+	3, // 4: fitglue.models.pipeline.BackfillJob.updated_at:type_name -> google.protobuf.Timestamp
+	3, // 5: fitglue.models.pipeline.BackfillJob.completed_at:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_models_pipeline_backfill_proto_init() }
+func file_models_pipeline_backfill_proto_init() {
+	if File_models_pipeline_backfill_proto != nil {
+		return
+	}
+	file_models_pipeline_backfill_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_pipeline_backfill_proto_rawDesc), len(file_models_pipeline_backfill_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_pipeline_backfill_proto_goTypes,
+		DependencyIndexes: file_models_pipeline_backfill_proto_depIdxs,
+		EnumInfos:         file_models_pipeline_backfill_proto_enumTypes,
+		MessageInfos:      file_models_pipeline_backfill_proto_msgTypes,
+	}.Build()
+	File_models_pipeline_backfill_proto = out.File
+	file_models_pipeline_backfill_proto_goTypes = nil
+	file_models_pipeline_backfill_proto_depIdxs = nil
+}
@@ -10,6 +10,7 @@ import (
 	plugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,6 +23,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// HeartRateSource picks which heart rate wins when the source activity and an
+// enricher (e.g. Fitbit intraday heart rate) both provide it. The losing
+// stream only fills gaps.
+type HeartRateSource int32
+
+const (
+	HeartRateSource_HEART_RATE_SOURCE_UNSPECIFIED  HeartRateSource = 0 // ranked by sensor type (chest strap over wrist)
+	HeartRateSource_HEART_RATE_SOURCE_ACTIVITY     HeartRateSource = 1 // the source activity's own heart rate
+	HeartRateSource_HEART_RATE_SOURCE_ENRICHER     HeartRateSource = 2 // the enricher's stream
+	HeartRateSource_HEART_RATE_SOURCE_BEST_QUALITY HeartRateSource = 3 // whichever scores higher on coverage and dropouts
+)
+
+// Enum value maps for HeartRateSource.
+var (
+	HeartRateSource_name = map[int32]string{
+		0: "HEART_RATE_SOURCE_UNSPECIFIED",
+		1: "HEART_RATE_SOURCE_ACTIVITY",
+		2: "HEART_RATE_SOURCE_ENRICHER",
+		3: "HEART_RATE_SOURCE_BEST_QUALITY",
+	}
+	HeartRateSource_value = map[string]int32{
+		"HEART_RATE_SOURCE_UNSPECIFIED":  0,
+		"HEART_RATE_SOURCE_ACTIVITY":     1,
+		"HEART_RATE_SOURCE_ENRICHER":     2,
+		"HEART_RATE_SOURCE_BEST_QUALITY": 3,
+	}
+)
+
+func (x HeartRateSource) Enum() *HeartRateSource {
+	p := new(HeartRateSource)
+	*p = x
+	return p
+}
+
+func (x HeartRateSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HeartRateSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_pipeline_config_proto_enumTypes[0].Descriptor()
+}
+
+func (HeartRateSource) Type() protoreflect.EnumType {
+	return &file_models_pipeline_config_proto_enumTypes[0]
+}
+
+func (x HeartRateSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HeartRateSource.Descriptor instead.
+func (HeartRateSource) EnumDescriptor() ([]byte, []int) {
+	return file_models_pipeline_config_proto_rawDescGZIP(), []int{0}
+}
+
 type PipelineConfig struct {
 	state              protoimpl.MessageState        `protogen:"open.v1"`
 	Id                 string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -32,8 +88,24 @@ type PipelineConfig struct {
 	Disabled           bool                          `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
 	SourceConfig       map[string]string             `protobuf:"bytes,7,rep,name=source_config,json=sourceConfig,proto3" json:"source_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	DestinationConfigs map[string]*DestinationConfig `protobuf:"bytes,8,rep,name=destination_configs,json=destinationConfigs,proto3" json:"destination_configs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	RaceMode           *RaceModeConfig               `protobuf:"bytes,9,opt,name=race_mode,json=raceMode,proto3" json:"race_mode,omitempty"`
+	// Activities arriving before this time are deferred instead of processed.
+	PausedUntil *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=paused_until,json=pausedUntil,proto3" json:"paused_until,omitempty"`
+	// Go text/template laying out the enriched description, e.g.
+	// "{{.Original}}\n\n{{.HRZones}}". Unset keeps the default layout: every
+	// section in pipeline order separated by blank lines. Set to "" to clear.
+	DescriptionTemplate *string `protobuf:"bytes,11,opt,name=description_template,json=descriptionTemplate,proto3,oneof" json:"description_template,omitempty"`
+	// Which heart rate wins when the activity and an enricher both provide it.
+	// Unset ranks them by sensor type.
+	HeartRateSource HeartRateSource `protobuf:"varint,12,opt,name=heart_rate_source,json=heartRateSource,proto3,enum=fitglue.models.pipeline.HeartRateSource" json:"heart_rate_source,omitempty"`
+	// Incremented on every edit; each version's config is kept as a
+	// PipelineConfigVersion. 0 for pipelines last saved before versioning.
+	Version int32 `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+	// Created by onboarding as the user's starter pipeline rather than by the
+	// user (see ProvisionStarterPipeline).
+	AutoCreated   bool `protobuf:"varint,14,opt,name=auto_created,json=autoCreated,proto3" json:"auto_created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PipelineConfig) Reset() {
@@ -122,17 +194,218 @@ func (x *PipelineConfig) GetDestinationConfigs() map[string]*DestinationConfig {
 	return nil
 }
 
+func (x *PipelineConfig) GetRaceMode() *RaceModeConfig {
+	if x != nil {
+		return x.RaceMode
+	}
+	return nil
+}
+
+func (x *PipelineConfig) GetPausedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedUntil
+	}
+	return nil
+}
+
+func (x *PipelineConfig) GetDescriptionTemplate() string {
+	if x != nil && x.DescriptionTemplate != nil {
+		return *x.DescriptionTemplate
+	}
+	return ""
+}
+
+func (x *PipelineConfig) GetHeartRateSource() HeartRateSource {
+	if x != nil {
+		return x.HeartRateSource
+	}
+	return HeartRateSource_HEART_RATE_SOURCE_UNSPECIFIED
+}
+
+func (x *PipelineConfig) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PipelineConfig) GetAutoCreated() bool {
+	if x != nil {
+		return x.AutoCreated
+	}
+	return false
+}
+
+// PipelineConfigVersion is an immutable snapshot of a pipeline's config,
+// stored at users/{uid}/pipelines/{pipeline_id}/versions/{version} each time
+// the pipeline is saved. Reposts replay against the version that originally ran.
+type PipelineConfigVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PipelineId    string                 `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Config        *PipelineConfig        `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PipelineConfigVersion) Reset() {
+	*x = PipelineConfigVersion{}
+	mi := &file_models_pipeline_config_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PipelineConfigVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineConfigVersion) ProtoMessage() {}
+
+func (x *PipelineConfigVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_config_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineConfigVersion.ProtoReflect.Descriptor instead.
+func (*PipelineConfigVersion) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PipelineConfigVersion) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *PipelineConfigVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PipelineConfigVersion) GetConfig() *PipelineConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *PipelineConfigVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// RaceModeConfig switches a pipeline to an alternate setup for activities that
+// start inside a date window, e.g. a race weekend.
+type RaceModeConfig struct {
+	state              protoimpl.MessageState        `protogen:"open.v1"`
+	Enabled            bool                          `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	StartsAt           *timestamppb.Timestamp        `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt             *timestamppb.Timestamp        `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`                                                                                                               // exclusive
+	Enrichers          []*EnricherConfig             `protobuf:"bytes,4,rep,name=enrichers,proto3" json:"enrichers,omitempty"`                                                                                                                       // replaces the pipeline's enrichers when non-empty
+	DestinationConfigs map[string]*DestinationConfig `protobuf:"bytes,5,rep,name=destination_configs,json=destinationConfigs,proto3" json:"destination_configs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // merged key-by-key over the pipeline's destination configs
+	SkipBranding       bool                          `protobuf:"varint,6,opt,name=skip_branding,json=skipBranding,proto3" json:"skip_branding,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RaceModeConfig) Reset() {
+	*x = RaceModeConfig{}
+	mi := &file_models_pipeline_config_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RaceModeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaceModeConfig) ProtoMessage() {}
+
+func (x *RaceModeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_config_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaceModeConfig.ProtoReflect.Descriptor instead.
+func (*RaceModeConfig) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RaceModeConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *RaceModeConfig) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *RaceModeConfig) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *RaceModeConfig) GetEnrichers() []*EnricherConfig {
+	if x != nil {
+		return x.Enrichers
+	}
+	return nil
+}
+
+func (x *RaceModeConfig) GetDestinationConfigs() map[string]*DestinationConfig {
+	if x != nil {
+		return x.DestinationConfigs
+	}
+	return nil
+}
+
+func (x *RaceModeConfig) GetSkipBranding() bool {
+	if x != nil {
+		return x.SkipBranding
+	}
+	return false
+}
+
 type DestinationConfig struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Config            map[string]string      `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ExcludedEnrichers []string               `protobuf:"bytes,2,rep,name=excluded_enrichers,json=excludedEnrichers,proto3" json:"excluded_enrichers,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Config              map[string]string      `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExcludedEnrichers   []string               `protobuf:"bytes,2,rep,name=excluded_enrichers,json=excludedEnrichers,proto3" json:"excluded_enrichers,omitempty"`
+	DescriptionTemplate string                 `protobuf:"bytes,3,opt,name=description_template,json=descriptionTemplate,proto3" json:"description_template,omitempty"` // overrides the pipeline's description_template for this destination
+	TitleTemplate       string                 `protobuf:"bytes,4,opt,name=title_template,json=titleTemplate,proto3" json:"title_template,omitempty"`                   // renders this destination's title, e.g. "{{.Emoji}} {{.Type}} — {{.Distance}}"; empty keeps the enriched title
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *DestinationConfig) Reset() {
 	*x = DestinationConfig{}
-	mi := &file_models_pipeline_config_proto_msgTypes[1]
+	mi := &file_models_pipeline_config_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -144,7 +417,7 @@ func (x *DestinationConfig) String() string {
 func (*DestinationConfig) ProtoMessage() {}
 
 func (x *DestinationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_models_pipeline_config_proto_msgTypes[1]
+	mi := &file_models_pipeline_config_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -157,7 +430,7 @@ func (x *DestinationConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DestinationConfig.ProtoReflect.Descriptor instead.
 func (*DestinationConfig) Descriptor() ([]byte, []int) {
-	return file_models_pipeline_config_proto_rawDescGZIP(), []int{1}
+	return file_models_pipeline_config_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *DestinationConfig) GetConfig() map[string]string {
@@ -174,6 +447,20 @@ func (x *DestinationConfig) GetExcludedEnrichers() []string {
 	return nil
 }
 
+func (x *DestinationConfig) GetDescriptionTemplate() string {
+	if x != nil {
+		return x.DescriptionTemplate
+	}
+	return ""
+}
+
+func (x *DestinationConfig) GetTitleTemplate() string {
+	if x != nil {
+		return x.TitleTemplate
+	}
+	return ""
+}
+
 type SourceEnrichmentConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Enrichers     []*EnricherConfig      `protobuf:"bytes,1,rep,name=enrichers,proto3" json:"enrichers,omitempty"`
@@ -183,7 +470,7 @@ type SourceEnrichmentConfig struct {
 
 func (x *SourceEnrichmentConfig) Reset() {
 	*x = SourceEnrichmentConfig{}
-	mi := &file_models_pipeline_config_proto_msgTypes[2]
+	mi := &file_models_pipeline_config_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -195,7 +482,7 @@ func (x *SourceEnrichmentConfig) String() string {
 func (*SourceEnrichmentConfig) ProtoMessage() {}
 
 func (x *SourceEnrichmentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_models_pipeline_config_proto_msgTypes[2]
+	mi := &file_models_pipeline_config_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -208,7 +495,7 @@ func (x *SourceEnrichmentConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SourceEnrichmentConfig.ProtoReflect.Descriptor instead.
 func (*SourceEnrichmentConfig) Descriptor() ([]byte, []int) {
-	return file_models_pipeline_config_proto_rawDescGZIP(), []int{2}
+	return file_models_pipeline_config_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SourceEnrichmentConfig) GetEnrichers() []*EnricherConfig {
@@ -219,16 +506,17 @@ func (x *SourceEnrichmentConfig) GetEnrichers() []*EnricherConfig {
 }
 
 type EnricherConfig struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	ProviderType  plugin.EnricherProviderType `protobuf:"varint,1,opt,name=provider_type,json=providerType,proto3,enum=fitglue.models.plugin.EnricherProviderType" json:"provider_type,omitempty"`
-	TypedConfig   map[string]string           `protobuf:"bytes,2,rep,name=typed_config,json=typedConfig,proto3" json:"typed_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState      `protogen:"open.v1"`
+	ProviderType   plugin.EnricherProviderType `protobuf:"varint,1,opt,name=provider_type,json=providerType,proto3,enum=fitglue.models.plugin.EnricherProviderType" json:"provider_type,omitempty"`
+	TypedConfig    map[string]string           `protobuf:"bytes,2,rep,name=typed_config,json=typedConfig,proto3" json:"typed_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TimeoutSeconds int32                       `protobuf:"varint,3,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // Deadline for each call to the provider; 0 uses the orchestrator default
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *EnricherConfig) Reset() {
 	*x = EnricherConfig{}
-	mi := &file_models_pipeline_config_proto_msgTypes[3]
+	mi := &file_models_pipeline_config_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -240,7 +528,7 @@ func (x *EnricherConfig) String() string {
 func (*EnricherConfig) ProtoMessage() {}
 
 func (x *EnricherConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_models_pipeline_config_proto_msgTypes[3]
+	mi := &file_models_pipeline_config_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,7 +541,7 @@ func (x *EnricherConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnricherConfig.ProtoReflect.Descriptor instead.
 func (*EnricherConfig) Descriptor() ([]byte, []int) {
-	return file_models_pipeline_config_proto_rawDescGZIP(), []int{3}
+	return file_models_pipeline_config_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EnricherConfig) GetProviderType() plugin.EnricherProviderType {
@@ -270,6 +558,13 @@ func (x *EnricherConfig) GetTypedConfig() map[string]string {
 	return nil
 }
 
+func (x *EnricherConfig) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
 type PluginDefault struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PluginId      string                 `protobuf:"bytes,1,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
@@ -282,7 +577,7 @@ type PluginDefault struct {
 
 func (x *PluginDefault) Reset() {
 	*x = PluginDefault{}
-	mi := &file_models_pipeline_config_proto_msgTypes[4]
+	mi := &file_models_pipeline_config_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -294,7 +589,7 @@ func (x *PluginDefault) String() string {
 func (*PluginDefault) ProtoMessage() {}
 
 func (x *PluginDefault) ProtoReflect() protoreflect.Message {
-	mi := &file_models_pipeline_config_proto_msgTypes[4]
+	mi := &file_models_pipeline_config_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,7 +602,7 @@ func (x *PluginDefault) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PluginDefault.ProtoReflect.Descriptor instead.
 func (*PluginDefault) Descriptor() ([]byte, []int) {
-	return file_models_pipeline_config_proto_rawDescGZIP(), []int{4}
+	return file_models_pipeline_config_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PluginDefault) GetPluginId() string {
@@ -342,7 +637,7 @@ var File_models_pipeline_config_proto protoreflect.FileDescriptor
 
 const file_models_pipeline_config_proto_rawDesc = "" +
 	"\n" +
-	"\x1cmodels/pipeline/config.proto\x12\x17fitglue.models.pipeline\x1a\x1cmodels/plugin/provider.proto\"\x81\x05\n" +
+	"\x1cmodels/pipeline/config.proto\x12\x17fitglue.models.pipeline\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmodels/plugin/provider.proto\"\xea\a\n" +
 	"\x0ePipelineConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x12E\n" +
@@ -351,24 +646,52 @@ const file_models_pipeline_config_proto_rawDesc = "" +
 	"\x04name\x18\x05 \x01(\tR\x04name\x12\x1a\n" +
 	"\bdisabled\x18\x06 \x01(\bR\bdisabled\x12^\n" +
 	"\rsource_config\x18\a \x03(\v29.fitglue.models.pipeline.PipelineConfig.SourceConfigEntryR\fsourceConfig\x12p\n" +
-	"\x13destination_configs\x18\b \x03(\v2?.fitglue.models.pipeline.PipelineConfig.DestinationConfigsEntryR\x12destinationConfigs\x1a?\n" +
+	"\x13destination_configs\x18\b \x03(\v2?.fitglue.models.pipeline.PipelineConfig.DestinationConfigsEntryR\x12destinationConfigs\x12D\n" +
+	"\trace_mode\x18\t \x01(\v2'.fitglue.models.pipeline.RaceModeConfigR\braceMode\x12=\n" +
+	"\fpaused_until\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vpausedUntil\x126\n" +
+	"\x14description_template\x18\v \x01(\tH\x00R\x13descriptionTemplate\x88\x01\x01\x12T\n" +
+	"\x11heart_rate_source\x18\f \x01(\x0e2(.fitglue.models.pipeline.HeartRateSourceR\x0fheartRateSource\x12\x18\n" +
+	"\aversion\x18\r \x01(\x05R\aversion\x12!\n" +
+	"\fauto_created\x18\x0e \x01(\bR\vautoCreated\x1a?\n" +
 	"\x11SourceConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aq\n" +
 	"\x17DestinationConfigsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12@\n" +
-	"\x05value\x18\x02 \x01(\v2*.fitglue.models.pipeline.DestinationConfigR\x05value:\x028\x01\"\xcd\x01\n" +
+	"\x05value\x18\x02 \x01(\v2*.fitglue.models.pipeline.DestinationConfigR\x05value:\x028\x01B\x17\n" +
+	"\x15_description_template\"\xce\x01\n" +
+	"\x15PipelineConfigVersion\x12\x1f\n" +
+	"\vpipeline_id\x18\x01 \x01(\tR\n" +
+	"pipelineId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12?\n" +
+	"\x06config\x18\x03 \x01(\v2'.fitglue.models.pipeline.PipelineConfigR\x06config\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xe9\x03\n" +
+	"\x0eRaceModeConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x127\n" +
+	"\tstarts_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12E\n" +
+	"\tenrichers\x18\x04 \x03(\v2'.fitglue.models.pipeline.EnricherConfigR\tenrichers\x12p\n" +
+	"\x13destination_configs\x18\x05 \x03(\v2?.fitglue.models.pipeline.RaceModeConfig.DestinationConfigsEntryR\x12destinationConfigs\x12#\n" +
+	"\rskip_branding\x18\x06 \x01(\bR\fskipBranding\x1aq\n" +
+	"\x17DestinationConfigsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12@\n" +
+	"\x05value\x18\x02 \x01(\v2*.fitglue.models.pipeline.DestinationConfigR\x05value:\x028\x01\"\xa7\x02\n" +
 	"\x11DestinationConfig\x12N\n" +
 	"\x06config\x18\x01 \x03(\v26.fitglue.models.pipeline.DestinationConfig.ConfigEntryR\x06config\x12-\n" +
-	"\x12excluded_enrichers\x18\x02 \x03(\tR\x11excludedEnrichers\x1a9\n" +
+	"\x12excluded_enrichers\x18\x02 \x03(\tR\x11excludedEnrichers\x121\n" +
+	"\x14description_template\x18\x03 \x01(\tR\x13descriptionTemplate\x12%\n" +
+	"\x0etitle_template\x18\x04 \x01(\tR\rtitleTemplate\x1a9\n" +
 	"\vConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"_\n" +
 	"\x16SourceEnrichmentConfig\x12E\n" +
-	"\tenrichers\x18\x01 \x03(\v2'.fitglue.models.pipeline.EnricherConfigR\tenrichers\"\xff\x01\n" +
+	"\tenrichers\x18\x01 \x03(\v2'.fitglue.models.pipeline.EnricherConfigR\tenrichers\"\xa8\x02\n" +
 	"\x0eEnricherConfig\x12P\n" +
 	"\rprovider_type\x18\x01 \x01(\x0e2+.fitglue.models.plugin.EnricherProviderTypeR\fproviderType\x12[\n" +
-	"\ftyped_config\x18\x02 \x03(\v28.fitglue.models.pipeline.EnricherConfig.TypedConfigEntryR\vtypedConfig\x1a>\n" +
+	"\ftyped_config\x18\x02 \x03(\v28.fitglue.models.pipeline.EnricherConfig.TypedConfigEntryR\vtypedConfig\x12'\n" +
+	"\x0ftimeout_seconds\x18\x03 \x01(\x05R\x0etimeoutSeconds\x1a>\n" +
 	"\x10TypedConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf1\x01\n" +
@@ -381,7 +704,12 @@ const file_models_pipeline_config_proto_rawDesc = "" +
 	"updated_at\x18\x04 \x01(\x03R\tupdatedAt\x1a9\n" +
 	"\vConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B?Z=github.com/fitglue/server/src/go/pkg/types/pb/models/pipelineb\x06proto3"
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\x98\x01\n" +
+	"\x0fHeartRateSource\x12!\n" +
+	"\x1dHEART_RATE_SOURCE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aHEART_RATE_SOURCE_ACTIVITY\x10\x01\x12\x1e\n" +
+	"\x1aHEART_RATE_SOURCE_ENRICHER\x10\x02\x12\"\n" +
+	"\x1eHEART_RATE_SOURCE_BEST_QUALITY\x10\x03B?Z=github.com/fitglue/server/src/go/pkg/types/pb/models/pipelineb\x06proto3"
 
 var (
 	file_models_pipeline_config_proto_rawDescOnce sync.Once
@@ -395,37 +723,53 @@ func file_models_pipeline_config_proto_rawDescGZIP() []byte {
 	return file_models_pipeline_config_proto_rawDescData
 }
 
-var file_models_pipeline_config_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_models_pipeline_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_models_pipeline_config_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_models_pipeline_config_proto_goTypes = []any{
-	(*PipelineConfig)(nil),           // 0: fitglue.models.pipeline.PipelineConfig
-	(*DestinationConfig)(nil),        // 1: fitglue.models.pipeline.DestinationConfig
-	(*SourceEnrichmentConfig)(nil),   // 2: fitglue.models.pipeline.SourceEnrichmentConfig
-	(*EnricherConfig)(nil),           // 3: fitglue.models.pipeline.EnricherConfig
-	(*PluginDefault)(nil),            // 4: fitglue.models.pipeline.PluginDefault
-	nil,                              // 5: fitglue.models.pipeline.PipelineConfig.SourceConfigEntry
-	nil,                              // 6: fitglue.models.pipeline.PipelineConfig.DestinationConfigsEntry
-	nil,                              // 7: fitglue.models.pipeline.DestinationConfig.ConfigEntry
-	nil,                              // 8: fitglue.models.pipeline.EnricherConfig.TypedConfigEntry
-	nil,                              // 9: fitglue.models.pipeline.PluginDefault.ConfigEntry
-	(plugin.DestinationType)(0),      // 10: fitglue.models.plugin.DestinationType
-	(plugin.EnricherProviderType)(0), // 11: fitglue.models.plugin.EnricherProviderType
+	(HeartRateSource)(0),             // 0: fitglue.models.pipeline.HeartRateSource
+	(*PipelineConfig)(nil),           // 1: fitglue.models.pipeline.PipelineConfig
+	(*PipelineConfigVersion)(nil),    // 2: fitglue.models.pipeline.PipelineConfigVersion
+	(*RaceModeConfig)(nil),           // 3: fitglue.models.pipeline.RaceModeConfig
+	(*DestinationConfig)(nil),        // 4: fitglue.models.pipeline.DestinationConfig
+	(*SourceEnrichmentConfig)(nil),   // 5: fitglue.models.pipeline.SourceEnrichmentConfig
+	(*EnricherConfig)(nil),           // 6: fitglue.models.pipeline.EnricherConfig
+	(*PluginDefault)(nil),            // 7: fitglue.models.pipeline.PluginDefault
+	nil,                              // 8: fitglue.models.pipeline.PipelineConfig.SourceConfigEntry
+	nil,                              // 9: fitglue.models.pipeline.PipelineConfig.DestinationConfigsEntry
+	nil,                              // 10: fitglue.models.pipeline.RaceModeConfig.DestinationConfigsEntry
+	nil,                              // 11: fitglue.models.pipeline.DestinationConfig.ConfigEntry
+	nil,                              // 12: fitglue.models.pipeline.EnricherConfig.TypedConfigEntry
+	nil,                              // 13: fitglue.models.pipeline.PluginDefault.ConfigEntry
+	(plugin.DestinationType)(0),      // 14: fitglue.models.plugin.DestinationType
+	(*timestamppb.Timestamp)(nil),    // 15: google.protobuf.Timestamp
+	(plugin.EnricherProviderType)(0), // 16: fitglue.models.plugin.EnricherProviderType
 }
 var file_models_pipeline_config_proto_depIdxs = []int32{
-	3,  // 0: fitglue.models.pipeline.PipelineConfig.enrichers:type_name -> fitglue.models.pipeline.EnricherConfig
-	10, // 1: fitglue.models.pipeline.PipelineConfig.destinations:type_name -> fitglue.models.plugin.DestinationType
-	5,  // 2: fitglue.models.pipeline.PipelineConfig.source_config:type_name -> fitglue.models.pipeline.PipelineConfig.SourceConfigEntry
-	6,  // 3: fitglue.models.pipeline.PipelineConfig.destination_configs:type_name -> fitglue.models.pipeline.PipelineConfig.DestinationConfigsEntry
-	7,  // 4: fitglue.models.pipeline.DestinationConfig.config:type_name -> fitglue.models.pipeline.DestinationConfig.ConfigEntry
-	3,  // 5: fitglue.models.pipeline.SourceEnrichmentConfig.enrichers:type_name -> fitglue.models.pipeline.EnricherConfig
-	11, // 6: fitglue.models.pipeline.EnricherConfig.provider_type:type_name -> fitglue.models.plugin.EnricherProviderType
-	8,  // 7: fitglue.models.pipeline.EnricherConfig.typed_config:type_name -> fitglue.models.pipeline.EnricherConfig.TypedConfigEntry
-	9,  // 8: fitglue.models.pipeline.PluginDefault.config:type_name -> fitglue.models.pipeline.PluginDefault.ConfigEntry
-	1,  // 9: fitglue.models.pipeline.PipelineConfig.DestinationConfigsEntry.value:type_name -> fitglue.models.pipeline.DestinationConfig
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	6,  // 0: fitglue.models.pipeline.PipelineConfig.enrichers:type_name -> fitglue.models.pipeline.EnricherConfig
+	14, // 1: fitglue.models.pipeline.PipelineConfig.destinations:type_name -> fitglue.models.plugin.DestinationType
+	8,  // 2: fitglue.models.pipeline.PipelineConfig.source_config:type_name -> fitglue.models.pipeline.PipelineConfig.SourceConfigEntry
+	9,  // 3: fitglue.models.pipeline.PipelineConfig.destination_configs:type_name -> fitglue.models.pipeline.PipelineConfig.DestinationConfigsEntry
+	3,  // 4: fitglue.models.pipeline.PipelineConfig.race_mode:type_name -> fitglue.models.pipeline.RaceModeConfig
+	15, // 5: fitglue.models.pipeline.PipelineConfig.paused_until:type_name -> google.protobuf.Timestamp
+	0,  // 6: fitglue.models.pipeline.PipelineConfig.heart_rate_source:type_name -> fitglue.models.pipeline.HeartRateSource
+	1,  // 7: fitglue.models.pipeline.PipelineConfigVersion.config:type_name -> fitglue.models.pipeline.PipelineConfig
+	15, // 8: fitglue.models.pipeline.PipelineConfigVersion.created_at:type_name -> google.protobuf.Timestamp
+	15, // 9: fitglue.models.pipeline.RaceModeConfig.starts_at:type_name -> google.protobuf.Timestamp
+	15, // 10: fitglue.models.pipeline.RaceModeConfig.ends_at:type_name -> google.protobuf.Timestamp
+	6,  // 11: fitglue.models.pipeline.RaceModeConfig.enrichers:type_name -> fitglue.models.pipeline.EnricherConfig
+	10, // 12: fitglue.models.pipeline.RaceModeConfig.destination_configs:type_name -> fitglue.models.pipeline.RaceModeConfig.DestinationConfigsEntry
+	11, // 13: fitglue.models.pipeline.DestinationConfig.config:type_name -> fitglue.models.pipeline.DestinationConfig.ConfigEntry
+	6,  // 14: fitglue.models.pipeline.SourceEnrichmentConfig.enrichers:type_name -> fitglue.models.pipeline.EnricherConfig
+	16, // 15: fitglue.models.pipeline.EnricherConfig.provider_type:type_name -> fitglue.models.plugin.EnricherProviderType
+	12, // 16: fitglue.models.pipeline.EnricherConfig.typed_config:type_name -> fitglue.models.pipeline.EnricherConfig.TypedConfigEntry
+	13, // 17: fitglue.models.pipeline.PluginDefault.config:type_name -> fitglue.models.pipeline.PluginDefault.ConfigEntry
+	4,  // 18: fitglue.models.pipeline.PipelineConfig.DestinationConfigsEntry.value:type_name -> fitglue.models.pipeline.DestinationConfig
+	4,  // 19: fitglue.models.pipeline.RaceModeConfig.DestinationConfigsEntry.value:type_name -> fitglue.models.pipeline.DestinationConfig
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_models_pipeline_config_proto_init() }
@@ -433,18 +777,20 @@ func file_models_pipeline_config_proto_init() {
 	if File_models_pipeline_config_proto != nil {
 		return
 	}
+	file_models_pipeline_config_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_pipeline_config_proto_rawDesc), len(file_models_pipeline_config_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      1,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_models_pipeline_config_proto_goTypes,
 		DependencyIndexes: file_models_pipeline_config_proto_depIdxs,
+		EnumInfos:         file_models_pipeline_config_proto_enumTypes,
 		MessageInfos:      file_models_pipeline_config_proto_msgTypes,
 	}.Build()
 	File_models_pipeline_config_proto = out.File
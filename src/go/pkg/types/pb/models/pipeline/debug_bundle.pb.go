@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: models/pipeline/debug_bundle.proto
+
+package pipeline
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PipelineRunDebugBundle collects everything known about a single pipeline
+// run into one document that users can download and attach to a support
+// ticket. Assembled on request; never stored.
+type PipelineRunDebugBundle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Run   *PipelineRun           `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	// The run's pipeline config as of the run: the version snapshot it used,
+	// or the current config for runs from before versioning (noted in
+	// warnings). Credentials and webhook URLs are redacted.
+	Pipeline *PipelineConfig `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// Service executions tagged with this run (enricher, router, uploaders),
+	// oldest first. Uploader inputs/outputs carry the destination requests
+	// and responses.
+	Executions []*ExecutionRecord `protobuf:"bytes,3,rep,name=executions,proto3" json:"executions,omitempty"`
+	// Contents of run.original_payload_uri and run.enriched_event_uri.
+	OriginalPayloadJson string `protobuf:"bytes,4,opt,name=original_payload_json,json=originalPayloadJson,proto3" json:"original_payload_json,omitempty"`
+	EnrichedEventJson   string `protobuf:"bytes,5,opt,name=enriched_event_json,json=enrichedEventJson,proto3" json:"enriched_event_json,omitempty"`
+	// Parts of the bundle that couldn't be assembled (missing pipeline,
+	// expired payloads, ...). The rest of the bundle is still returned.
+	Warnings      []string               `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PipelineRunDebugBundle) Reset() {
+	*x = PipelineRunDebugBundle{}
+	mi := &file_models_pipeline_debug_bundle_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PipelineRunDebugBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineRunDebugBundle) ProtoMessage() {}
+
+func (x *PipelineRunDebugBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_debug_bundle_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineRunDebugBundle.ProtoReflect.Descriptor instead.
+func (*PipelineRunDebugBundle) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_debug_bundle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PipelineRunDebugBundle) GetRun() *PipelineRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+func (x *PipelineRunDebugBundle) GetPipeline() *PipelineConfig {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+func (x *PipelineRunDebugBundle) GetExecutions() []*ExecutionRecord {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+func (x *PipelineRunDebugBundle) GetOriginalPayloadJson() string {
+	if x != nil {
+		return x.OriginalPayloadJson
+	}
+	return ""
+}
+
+func (x *PipelineRunDebugBundle) GetEnrichedEventJson() string {
+	if x != nil {
+		return x.EnrichedEventJson
+	}
+	return ""
+}
+
+func (x *PipelineRunDebugBundle) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *PipelineRunDebugBundle) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+var File_models_pipeline_debug_bundle_proto protoreflect.FileDescriptor
+
+const file_models_pipeline_debug_bundle_proto_rawDesc = "" +
+	"\n" +
+	"\"models/pipeline/debug_bundle.proto\x12\x17fitglue.models.pipeline\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmodels/pipeline/config.proto\x1a\x1fmodels/pipeline/execution.proto\"\x9e\x03\n" +
+	"\x16PipelineRunDebugBundle\x126\n" +
+	"\x03run\x18\x01 \x01(\v2$.fitglue.models.pipeline.PipelineRunR\x03run\x12C\n" +
+	"\bpipeline\x18\x02 \x01(\v2'.fitglue.models.pipeline.PipelineConfigR\bpipeline\x12H\n" +
+	"\n" +
+	"executions\x18\x03 \x03(\v2(.fitglue.models.pipeline.ExecutionRecordR\n" +
+	"executions\x122\n" +
+	"\x15original_payload_json\x18\x04 \x01(\tR\x13originalPayloadJson\x12.\n" +
+	"\x13enriched_event_json\x18\x05 \x01(\tR\x11enrichedEventJson\x12\x1a\n" +
+	"\bwarnings\x18\x06 \x03(\tR\bwarnings\x12=\n" +
+	"\fgenerated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAtB?Z=github.com/fitglue/server/src/go/pkg/types/pb/models/pipelineb\x06proto3"
+
+var (
+	file_models_pipeline_debug_bundle_proto_rawDescOnce sync.Once
+	file_models_pipeline_debug_bundle_proto_rawDescData []byte
+)
+
+func file_models_pipeline_debug_bundle_proto_rawDescGZIP() []byte {
+	file_models_pipeline_debug_bundle_proto_rawDescOnce.Do(func() {
+		file_models_pipeline_debug_bundle_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_models_pipeline_debug_bundle_proto_rawDesc), len(file_models_pipeline_debug_bundle_proto_rawDesc)))
+	})
+	return file_models_pipeline_debug_bundle_proto_rawDescData
+}
+
+var file_models_pipeline_debug_bundle_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_models_pipeline_debug_bundle_proto_goTypes = []any{
+	(*PipelineRunDebugBundle)(nil), // 0: fitglue.models.pipeline.PipelineRunDebugBundle
+	(*PipelineRun)(nil),            // 1: fitglue.models.pipeline.PipelineRun
+	(*PipelineConfig)(nil),         // 2: fitglue.models.pipeline.PipelineConfig
+	(*ExecutionRecord)(nil),        // 3: fitglue.models.pipeline.ExecutionRecord
+	(*timestamppb.Timestamp)(nil),  // 4: google.protobuf.Timestamp
+}
+var file_models_pipeline_debug_bundle_proto_depIdxs = []int32{
+	1, // 0: fitglue.models.pipeline.PipelineRunDebugBundle.run:type_name -> fitglue.models.pipeline.PipelineRun
+	2, // 1: fitglue.models.pipeline.PipelineRunDebugBundle.pipeline:type_name -> fitglue.models.pipeline.PipelineConfig
+	3, // 2: fitglue.models.pipeline.PipelineRunDebugBundle.executions:type_name -> fitglue.models.pipeline.ExecutionRecord
+	4, // 3: fitglue.models.pipeline.PipelineRunDebugBundle.generated_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_models_pipeline_debug_bundle_proto_init() }
+func file_models_pipeline_debug_bundle_proto_init() {
+	if File_models_pipeline_debug_bundle_proto != nil {
+		return
+	}
+	file_models_pipeline_config_proto_init()
+	file_models_pipeline_execution_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_pipeline_debug_bundle_proto_rawDesc), len(file_models_pipeline_debug_bundle_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_pipeline_debug_bundle_proto_goTypes,
+		DependencyIndexes: file_models_pipeline_debug_bundle_proto_depIdxs,
+		MessageInfos:      file_models_pipeline_debug_bundle_proto_msgTypes,
+	}.Build()
+	File_models_pipeline_debug_bundle_proto = out.File
+	file_models_pipeline_debug_bundle_proto_goTypes = nil
+	file_models_pipeline_debug_bundle_proto_depIdxs = nil
+}
@@ -0,0 +1,264 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: models/pipeline/enricher_result_share.proto
+
+package pipeline
+
+import (
+	plugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EnricherResultShare is one provider's result for an activity, stored at
+// users/{user_id}/enricher_result_shares/{id} by the first pipeline of a
+// splitter fan-out to run the provider. The activity's other pipelines reuse
+// it instead of calling the provider again (e.g. weather, AI banners). The id
+// is derived from the fan-out, the activity's external ID, the provider type
+// and a hash of the provider's config. Shares expire via a TTL on expires_at.
+type EnricherResultShare struct {
+	state              protoimpl.MessageState      `protogen:"open.v1"`
+	Id                 string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FanOutId           string                      `protobuf:"bytes,2,opt,name=fan_out_id,json=fanOutId,proto3" json:"fan_out_id,omitempty"`
+	ActivityExternalId string                      `protobuf:"bytes,3,opt,name=activity_external_id,json=activityExternalId,proto3" json:"activity_external_id,omitempty"`
+	ProviderType       plugin.EnricherProviderType `protobuf:"varint,4,opt,name=provider_type,json=providerType,proto3,enum=fitglue.models.plugin.EnricherProviderType" json:"provider_type,omitempty"`
+	ConfigHash         string                      `protobuf:"bytes,5,opt,name=config_hash,json=configHash,proto3" json:"config_hash,omitempty"`
+	PipelineId         string                      `protobuf:"bytes,6,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"` // Pipeline whose run produced the result
+	Description        string                      `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	SectionHeader      string                      `protobuf:"bytes,8,opt,name=section_header,json=sectionHeader,proto3" json:"section_header,omitempty"`
+	Name               string                      `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`
+	NameSuffix         string                      `protobuf:"bytes,10,opt,name=name_suffix,json=nameSuffix,proto3" json:"name_suffix,omitempty"`
+	Tags               []string                    `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	Metadata           map[string]string           `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt          *timestamppb.Timestamp      `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt          *timestamppb.Timestamp      `protobuf:"bytes,14,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *EnricherResultShare) Reset() {
+	*x = EnricherResultShare{}
+	mi := &file_models_pipeline_enricher_result_share_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnricherResultShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnricherResultShare) ProtoMessage() {}
+
+func (x *EnricherResultShare) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_enricher_result_share_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnricherResultShare.ProtoReflect.Descriptor instead.
+func (*EnricherResultShare) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_enricher_result_share_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EnricherResultShare) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EnricherResultShare) GetFanOutId() string {
+	if x != nil {
+		return x.FanOutId
+	}
+	return ""
+}
+
+func (x *EnricherResultShare) GetActivityExternalId() string {
+	if x != nil {
+		return x.ActivityExternalId
+	}
+	return ""
+}
+
+func (x *EnricherResultShare) GetProviderType() plugin.EnricherProviderType {
+	if x != nil {
+		return x.ProviderType
+	}
+	return plugin.EnricherProviderType(0)
+}
+
+func (x *EnricherResultShare) GetConfigHash() string {
+	if x != nil {
+		return x.ConfigHash
+	}
+	return ""
+}
+
+func (x *EnricherResultShare) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *EnricherResultShare) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *EnricherResultShare) GetSectionHeader() string {
+	if x != nil {
+		return x.SectionHeader
+	}
+	return ""
+}
+
+func (x *EnricherResultShare) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnricherResultShare) GetNameSuffix() string {
+	if x != nil {
+		return x.NameSuffix
+	}
+	return ""
+}
+
+func (x *EnricherResultShare) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *EnricherResultShare) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *EnricherResultShare) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EnricherResultShare) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+var File_models_pipeline_enricher_result_share_proto protoreflect.FileDescriptor
+
+const file_models_pipeline_enricher_result_share_proto_rawDesc = "" +
+	"\n" +
+	"+models/pipeline/enricher_result_share.proto\x12\x17fitglue.models.pipeline\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmodels/plugin/provider.proto\"\xa6\x05\n" +
+	"\x13EnricherResultShare\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\n" +
+	"fan_out_id\x18\x02 \x01(\tR\bfanOutId\x120\n" +
+	"\x14activity_external_id\x18\x03 \x01(\tR\x12activityExternalId\x12P\n" +
+	"\rprovider_type\x18\x04 \x01(\x0e2+.fitglue.models.plugin.EnricherProviderTypeR\fproviderType\x12\x1f\n" +
+	"\vconfig_hash\x18\x05 \x01(\tR\n" +
+	"configHash\x12\x1f\n" +
+	"\vpipeline_id\x18\x06 \x01(\tR\n" +
+	"pipelineId\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12%\n" +
+	"\x0esection_header\x18\b \x01(\tR\rsectionHeader\x12\x12\n" +
+	"\x04name\x18\t \x01(\tR\x04name\x12\x1f\n" +
+	"\vname_suffix\x18\n" +
+	" \x01(\tR\n" +
+	"nameSuffix\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12V\n" +
+	"\bmetadata\x18\f \x03(\v2:.fitglue.models.pipeline.EnricherResultShare.MetadataEntryR\bmetadata\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B?Z=github.com/fitglue/server/src/go/pkg/types/pb/models/pipelineb\x06proto3"
+
+var (
+	file_models_pipeline_enricher_result_share_proto_rawDescOnce sync.Once
+	file_models_pipeline_enricher_result_share_proto_rawDescData []byte
+)
+
+func file_models_pipeline_enricher_result_share_proto_rawDescGZIP() []byte {
+	file_models_pipeline_enricher_result_share_proto_rawDescOnce.Do(func() {
+		file_models_pipeline_enricher_result_share_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_models_pipeline_enricher_result_share_proto_rawDesc), len(file_models_pipeline_enricher_result_share_proto_rawDesc)))
+	})
+	return file_models_pipeline_enricher_result_share_proto_rawDescData
+}
+
+var file_models_pipeline_enricher_result_share_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_models_pipeline_enricher_result_share_proto_goTypes = []any{
+	(*EnricherResultShare)(nil),      // 0: fitglue.models.pipeline.EnricherResultShare
+	nil,                              // 1: fitglue.models.pipeline.EnricherResultShare.MetadataEntry
+	(plugin.EnricherProviderType)(0), // 2: fitglue.models.plugin.EnricherProviderType
+	(*timestamppb.Timestamp)(nil),    // 3: google.protobuf.Timestamp
+}
+var file_models_pipeline_enricher_result_share_proto_depIdxs = []int32{
+	2, // 0: fitglue.models.pipeline.EnricherResultShare.provider_type:type_name -> fitglue.models.plugin.EnricherProviderType
+	1, // 1: fitglue.models.pipeline.EnricherResultShare.metadata:type_name -> fitglue.models.pipeline.EnricherResultShare.MetadataEntry
+	3, // 2: fitglue.models.pipeline.EnricherResultShare.created_at:type_name -> google.protobuf.Timestamp
+	3, // 3: fitglue.models.pipeline.EnricherResultShare.expires_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_models_pipeline_enricher_result_share_proto_init() }
+func file_models_pipeline_enricher_result_share_proto_init() {
+	if File_models_pipeline_enricher_result_share_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_pipeline_enricher_result_share_proto_rawDesc), len(file_models_pipeline_enricher_result_share_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_pipeline_enricher_result_share_proto_goTypes,
+		DependencyIndexes: file_models_pipeline_enricher_result_share_proto_depIdxs,
+		MessageInfos:      file_models_pipeline_enricher_result_share_proto_msgTypes,
+	}.Build()
+	File_models_pipeline_enricher_result_share_proto = out.File
+	file_models_pipeline_enricher_result_share_proto_goTypes = nil
+	file_models_pipeline_enricher_result_share_proto_depIdxs = nil
+}
@@ -8,6 +8,7 @@ package pipeline
 
 import (
 	activity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	events "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	plugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -27,40 +28,52 @@ const (
 type PipelineRunStatus int32
 
 const (
-	PipelineRunStatus_PIPELINE_RUN_STATUS_UNSPECIFIED  PipelineRunStatus = 0
-	PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING      PipelineRunStatus = 1
-	PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED       PipelineRunStatus = 2
-	PipelineRunStatus_PIPELINE_RUN_STATUS_PARTIAL      PipelineRunStatus = 3
-	PipelineRunStatus_PIPELINE_RUN_STATUS_FAILED       PipelineRunStatus = 4
-	PipelineRunStatus_PIPELINE_RUN_STATUS_PENDING      PipelineRunStatus = 5
-	PipelineRunStatus_PIPELINE_RUN_STATUS_SKIPPED      PipelineRunStatus = 6
-	PipelineRunStatus_PIPELINE_RUN_STATUS_ARCHIVED     PipelineRunStatus = 7
-	PipelineRunStatus_PIPELINE_RUN_STATUS_TIER_BLOCKED PipelineRunStatus = 8
+	PipelineRunStatus_PIPELINE_RUN_STATUS_UNSPECIFIED            PipelineRunStatus = 0
+	PipelineRunStatus_PIPELINE_RUN_STATUS_RUNNING                PipelineRunStatus = 1
+	PipelineRunStatus_PIPELINE_RUN_STATUS_SYNCED                 PipelineRunStatus = 2
+	PipelineRunStatus_PIPELINE_RUN_STATUS_PARTIAL                PipelineRunStatus = 3
+	PipelineRunStatus_PIPELINE_RUN_STATUS_FAILED                 PipelineRunStatus = 4
+	PipelineRunStatus_PIPELINE_RUN_STATUS_PENDING                PipelineRunStatus = 5
+	PipelineRunStatus_PIPELINE_RUN_STATUS_SKIPPED                PipelineRunStatus = 6
+	PipelineRunStatus_PIPELINE_RUN_STATUS_ARCHIVED               PipelineRunStatus = 7
+	PipelineRunStatus_PIPELINE_RUN_STATUS_TIER_BLOCKED           PipelineRunStatus = 8
+	PipelineRunStatus_PIPELINE_RUN_STATUS_QUEUED_PLATFORM_OUTAGE PipelineRunStatus = 9  // Waiting for a destination platform to recover
+	PipelineRunStatus_PIPELINE_RUN_STATUS_DEFERRED               PipelineRunStatus = 10 // Arrived while paused; waiting to be released or discarded
+	PipelineRunStatus_PIPELINE_RUN_STATUS_DELETED_AT_SOURCE      PipelineRunStatus = 11 // The activity was deleted on its source platform
+	PipelineRunStatus_PIPELINE_RUN_STATUS_SCHEDULED              PipelineRunStatus = 12 // Waiting for a destination's posting schedule
 )
 
 // Enum value maps for PipelineRunStatus.
 var (
 	PipelineRunStatus_name = map[int32]string{
-		0: "PIPELINE_RUN_STATUS_UNSPECIFIED",
-		1: "PIPELINE_RUN_STATUS_RUNNING",
-		2: "PIPELINE_RUN_STATUS_SYNCED",
-		3: "PIPELINE_RUN_STATUS_PARTIAL",
-		4: "PIPELINE_RUN_STATUS_FAILED",
-		5: "PIPELINE_RUN_STATUS_PENDING",
-		6: "PIPELINE_RUN_STATUS_SKIPPED",
-		7: "PIPELINE_RUN_STATUS_ARCHIVED",
-		8: "PIPELINE_RUN_STATUS_TIER_BLOCKED",
+		0:  "PIPELINE_RUN_STATUS_UNSPECIFIED",
+		1:  "PIPELINE_RUN_STATUS_RUNNING",
+		2:  "PIPELINE_RUN_STATUS_SYNCED",
+		3:  "PIPELINE_RUN_STATUS_PARTIAL",
+		4:  "PIPELINE_RUN_STATUS_FAILED",
+		5:  "PIPELINE_RUN_STATUS_PENDING",
+		6:  "PIPELINE_RUN_STATUS_SKIPPED",
+		7:  "PIPELINE_RUN_STATUS_ARCHIVED",
+		8:  "PIPELINE_RUN_STATUS_TIER_BLOCKED",
+		9:  "PIPELINE_RUN_STATUS_QUEUED_PLATFORM_OUTAGE",
+		10: "PIPELINE_RUN_STATUS_DEFERRED",
+		11: "PIPELINE_RUN_STATUS_DELETED_AT_SOURCE",
+		12: "PIPELINE_RUN_STATUS_SCHEDULED",
 	}
 	PipelineRunStatus_value = map[string]int32{
-		"PIPELINE_RUN_STATUS_UNSPECIFIED":  0,
-		"PIPELINE_RUN_STATUS_RUNNING":      1,
-		"PIPELINE_RUN_STATUS_SYNCED":       2,
-		"PIPELINE_RUN_STATUS_PARTIAL":      3,
-		"PIPELINE_RUN_STATUS_FAILED":       4,
-		"PIPELINE_RUN_STATUS_PENDING":      5,
-		"PIPELINE_RUN_STATUS_SKIPPED":      6,
-		"PIPELINE_RUN_STATUS_ARCHIVED":     7,
-		"PIPELINE_RUN_STATUS_TIER_BLOCKED": 8,
+		"PIPELINE_RUN_STATUS_UNSPECIFIED":            0,
+		"PIPELINE_RUN_STATUS_RUNNING":                1,
+		"PIPELINE_RUN_STATUS_SYNCED":                 2,
+		"PIPELINE_RUN_STATUS_PARTIAL":                3,
+		"PIPELINE_RUN_STATUS_FAILED":                 4,
+		"PIPELINE_RUN_STATUS_PENDING":                5,
+		"PIPELINE_RUN_STATUS_SKIPPED":                6,
+		"PIPELINE_RUN_STATUS_ARCHIVED":               7,
+		"PIPELINE_RUN_STATUS_TIER_BLOCKED":           8,
+		"PIPELINE_RUN_STATUS_QUEUED_PLATFORM_OUTAGE": 9,
+		"PIPELINE_RUN_STATUS_DEFERRED":               10,
+		"PIPELINE_RUN_STATUS_DELETED_AT_SOURCE":      11,
+		"PIPELINE_RUN_STATUS_SCHEDULED":              12,
 	}
 )
 
@@ -94,11 +107,14 @@ func (PipelineRunStatus) EnumDescriptor() ([]byte, []int) {
 type DestinationStatus int32
 
 const (
-	DestinationStatus_DESTINATION_STATUS_UNSPECIFIED DestinationStatus = 0
-	DestinationStatus_DESTINATION_STATUS_PENDING     DestinationStatus = 1
-	DestinationStatus_DESTINATION_STATUS_SUCCESS     DestinationStatus = 2
-	DestinationStatus_DESTINATION_STATUS_FAILED      DestinationStatus = 3
-	DestinationStatus_DESTINATION_STATUS_SKIPPED     DestinationStatus = 4
+	DestinationStatus_DESTINATION_STATUS_UNSPECIFIED            DestinationStatus = 0
+	DestinationStatus_DESTINATION_STATUS_PENDING                DestinationStatus = 1
+	DestinationStatus_DESTINATION_STATUS_SUCCESS                DestinationStatus = 2
+	DestinationStatus_DESTINATION_STATUS_FAILED                 DestinationStatus = 3
+	DestinationStatus_DESTINATION_STATUS_SKIPPED                DestinationStatus = 4
+	DestinationStatus_DESTINATION_STATUS_QUEUED_PLATFORM_OUTAGE DestinationStatus = 5 // Deferred until the platform recovers
+	DestinationStatus_DESTINATION_STATUS_REMOVED                DestinationStatus = 6 // Deleted or archived after the source activity was deleted
+	DestinationStatus_DESTINATION_STATUS_SCHEDULED              DestinationStatus = 7 // Held until the destination's posting schedule allows it
 )
 
 // Enum value maps for DestinationStatus.
@@ -109,13 +125,19 @@ var (
 		2: "DESTINATION_STATUS_SUCCESS",
 		3: "DESTINATION_STATUS_FAILED",
 		4: "DESTINATION_STATUS_SKIPPED",
+		5: "DESTINATION_STATUS_QUEUED_PLATFORM_OUTAGE",
+		6: "DESTINATION_STATUS_REMOVED",
+		7: "DESTINATION_STATUS_SCHEDULED",
 	}
 	DestinationStatus_value = map[string]int32{
-		"DESTINATION_STATUS_UNSPECIFIED": 0,
-		"DESTINATION_STATUS_PENDING":     1,
-		"DESTINATION_STATUS_SUCCESS":     2,
-		"DESTINATION_STATUS_FAILED":      3,
-		"DESTINATION_STATUS_SKIPPED":     4,
+		"DESTINATION_STATUS_UNSPECIFIED":            0,
+		"DESTINATION_STATUS_PENDING":                1,
+		"DESTINATION_STATUS_SUCCESS":                2,
+		"DESTINATION_STATUS_FAILED":                 3,
+		"DESTINATION_STATUS_SKIPPED":                4,
+		"DESTINATION_STATUS_QUEUED_PLATFORM_OUTAGE": 5,
+		"DESTINATION_STATUS_REMOVED":                6,
+		"DESTINATION_STATUS_SCHEDULED":              7,
 	}
 )
 
@@ -211,27 +233,44 @@ func (ExecutionStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type PipelineRun struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	PipelineId         string                 `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
-	ActivityId         string                 `protobuf:"bytes,3,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
-	Source             string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
-	SourceActivityId   string                 `protobuf:"bytes,5,opt,name=source_activity_id,json=sourceActivityId,proto3" json:"source_activity_id,omitempty"`
-	Title              string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
-	Description        string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	Type               activity.ActivityType  `protobuf:"varint,8,opt,name=type,proto3,enum=fitglue.models.activity.ActivityType" json:"type,omitempty"`
-	StartTime          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	Status             PipelineRunStatus      `protobuf:"varint,10,opt,name=status,proto3,enum=fitglue.models.pipeline.PipelineRunStatus" json:"status,omitempty"`
-	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Boosters           []*BoosterExecution    `protobuf:"bytes,13,rep,name=boosters,proto3" json:"boosters,omitempty"`
-	Destinations       []*DestinationOutcome  `protobuf:"bytes,14,rep,name=destinations,proto3" json:"destinations,omitempty"`
-	StatusMessage      *string                `protobuf:"bytes,15,opt,name=status_message,json=statusMessage,proto3,oneof" json:"status_message,omitempty"`
-	PendingInputId     *string                `protobuf:"bytes,16,opt,name=pending_input_id,json=pendingInputId,proto3,oneof" json:"pending_input_id,omitempty"`
-	OriginalPayloadUri string                 `protobuf:"bytes,22,opt,name=original_payload_uri,json=originalPayloadUri,proto3" json:"original_payload_uri,omitempty"`
-	EnrichedEventUri   string                 `protobuf:"bytes,23,opt,name=enriched_event_uri,json=enrichedEventUri,proto3" json:"enriched_event_uri,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PipelineId            string                 `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	ActivityId            string                 `protobuf:"bytes,3,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Source                string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	SourceActivityId      string                 `protobuf:"bytes,5,opt,name=source_activity_id,json=sourceActivityId,proto3" json:"source_activity_id,omitempty"`
+	Title                 string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Description           string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Type                  activity.ActivityType  `protobuf:"varint,8,opt,name=type,proto3,enum=fitglue.models.activity.ActivityType" json:"type,omitempty"`
+	StartTime             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Status                PipelineRunStatus      `protobuf:"varint,10,opt,name=status,proto3,enum=fitglue.models.pipeline.PipelineRunStatus" json:"status,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Boosters              []*BoosterExecution    `protobuf:"bytes,13,rep,name=boosters,proto3" json:"boosters,omitempty"`
+	Destinations          []*DestinationOutcome  `protobuf:"bytes,14,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	StatusMessage         *string                `protobuf:"bytes,15,opt,name=status_message,json=statusMessage,proto3,oneof" json:"status_message,omitempty"`
+	PendingInputId        *string                `protobuf:"bytes,16,opt,name=pending_input_id,json=pendingInputId,proto3,oneof" json:"pending_input_id,omitempty"`
+	OriginalPayloadUri    string                 `protobuf:"bytes,22,opt,name=original_payload_uri,json=originalPayloadUri,proto3" json:"original_payload_uri,omitempty"`
+	EnrichedEventUri      string                 `protobuf:"bytes,23,opt,name=enriched_event_uri,json=enrichedEventUri,proto3" json:"enriched_event_uri,omitempty"`
+	Cost                  *RunCost               `protobuf:"bytes,24,opt,name=cost,proto3" json:"cost,omitempty"`                                                                   // Internal: estimated processing cost, not shown to users
+	PipelineConfigVersion int32                  `protobuf:"varint,25,opt,name=pipeline_config_version,json=pipelineConfigVersion,proto3" json:"pipeline_config_version,omitempty"` // PipelineConfig.version the run used; 0 when unversioned
+	IsTest                bool                   `protobuf:"varint,26,opt,name=is_test,json=isTest,proto3" json:"is_test,omitempty"`                                                // Synthetic onboarding activity, delivered only to the sandbox destination
+	// Enricher retries scheduled for this run so far, by provider name
+	RetryAttempts map[string]int32 `protobuf:"bytes,27,rep,name=retry_attempts,json=retryAttempts,proto3" json:"retry_attempts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// When a scheduled retry resumes the run; unset when none is pending
+	NextRetryAt *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=next_retry_at,json=nextRetryAt,proto3" json:"next_retry_at,omitempty"`
+	// Replays of another run with a config override; shown as replays, not syncs
+	ReplayOf       *string                `protobuf:"bytes,29,opt,name=replay_of,json=replayOf,proto3,oneof" json:"replay_of,omitempty"`
+	ReplayOverride *events.ReplayOverride `protobuf:"bytes,30,opt,name=replay_override,json=replayOverride,proto3" json:"replay_override,omitempty"`
+	// Activity totals from its first session, for digests and summaries
+	DistanceMeters  float64 `protobuf:"fixed64,31,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`
+	DurationSeconds float64 `protobuf:"fixed64,32,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// Owning user; only set on admin listings across users
+	UserId string `protobuf:"bytes,33,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// When an admin force-completed the run; unset otherwise
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,34,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PipelineRun) Reset() {
@@ -390,15 +429,93 @@ func (x *PipelineRun) GetEnrichedEventUri() string {
 	return ""
 }
 
+func (x *PipelineRun) GetCost() *RunCost {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+func (x *PipelineRun) GetPipelineConfigVersion() int32 {
+	if x != nil {
+		return x.PipelineConfigVersion
+	}
+	return 0
+}
+
+func (x *PipelineRun) GetIsTest() bool {
+	if x != nil {
+		return x.IsTest
+	}
+	return false
+}
+
+func (x *PipelineRun) GetRetryAttempts() map[string]int32 {
+	if x != nil {
+		return x.RetryAttempts
+	}
+	return nil
+}
+
+func (x *PipelineRun) GetNextRetryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRetryAt
+	}
+	return nil
+}
+
+func (x *PipelineRun) GetReplayOf() string {
+	if x != nil && x.ReplayOf != nil {
+		return *x.ReplayOf
+	}
+	return ""
+}
+
+func (x *PipelineRun) GetReplayOverride() *events.ReplayOverride {
+	if x != nil {
+		return x.ReplayOverride
+	}
+	return nil
+}
+
+func (x *PipelineRun) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+func (x *PipelineRun) GetDurationSeconds() float64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *PipelineRun) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PipelineRun) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
 type BoosterExecution struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProviderName  string                 `protobuf:"bytes,1,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	DurationMs    int64                  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
-	Metadata      map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Error         *string                `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ProviderName           string                 `protobuf:"bytes,1,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	Status                 string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	DurationMs             int64                  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Metadata               map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Error                  *string                `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	ContributedDescription bool                   `protobuf:"varint,6,opt,name=contributed_description,json=contributedDescription,proto3" json:"contributed_description,omitempty"` // Provider added text to the activity description
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *BoosterExecution) Reset() {
@@ -466,6 +583,368 @@ func (x *BoosterExecution) GetError() string {
 	return ""
 }
 
+func (x *BoosterExecution) GetContributedDescription() bool {
+	if x != nil {
+		return x.ContributedDescription
+	}
+	return false
+}
+
+// RunCost is the estimated cost of processing a pipeline run, for internal
+// margin analysis and future usage-based pricing. The usage counts are kept
+// next to the estimate so runs can be re-priced when list prices change.
+type RunCost struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AiInputTokens     int64                  `protobuf:"varint,1,opt,name=ai_input_tokens,json=aiInputTokens,proto3" json:"ai_input_tokens,omitempty"`
+	AiOutputTokens    int64                  `protobuf:"varint,2,opt,name=ai_output_tokens,json=aiOutputTokens,proto3" json:"ai_output_tokens,omitempty"`
+	ImageGenerations  int32                  `protobuf:"varint,3,opt,name=image_generations,json=imageGenerations,proto3" json:"image_generations,omitempty"`
+	ExternalApiCalls  int32                  `protobuf:"varint,4,opt,name=external_api_calls,json=externalApiCalls,proto3" json:"external_api_calls,omitempty"`     // Third-party APIs called by enrichers (weather, Strava, Fitbit...)
+	FunctionGbSeconds float64                `protobuf:"fixed64,5,opt,name=function_gb_seconds,json=functionGbSeconds,proto3" json:"function_gb_seconds,omitempty"` // Enricher wall time x memory limit; an upper bound under concurrency
+	EstimatedUsd      float64                `protobuf:"fixed64,6,opt,name=estimated_usd,json=estimatedUsd,proto3" json:"estimated_usd,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RunCost) Reset() {
+	*x = RunCost{}
+	mi := &file_models_pipeline_execution_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunCost) ProtoMessage() {}
+
+func (x *RunCost) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_execution_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunCost.ProtoReflect.Descriptor instead.
+func (*RunCost) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_execution_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RunCost) GetAiInputTokens() int64 {
+	if x != nil {
+		return x.AiInputTokens
+	}
+	return 0
+}
+
+func (x *RunCost) GetAiOutputTokens() int64 {
+	if x != nil {
+		return x.AiOutputTokens
+	}
+	return 0
+}
+
+func (x *RunCost) GetImageGenerations() int32 {
+	if x != nil {
+		return x.ImageGenerations
+	}
+	return 0
+}
+
+func (x *RunCost) GetExternalApiCalls() int32 {
+	if x != nil {
+		return x.ExternalApiCalls
+	}
+	return 0
+}
+
+func (x *RunCost) GetFunctionGbSeconds() float64 {
+	if x != nil {
+		return x.FunctionGbSeconds
+	}
+	return 0
+}
+
+func (x *RunCost) GetEstimatedUsd() float64 {
+	if x != nil {
+		return x.EstimatedUsd
+	}
+	return 0
+}
+
+// EnricherUsage summarises how one enricher has performed across a user's
+// recent pipeline runs.
+type EnricherUsage struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	ProviderName             string                 `protobuf:"bytes,1,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	Uses                     int32                  `protobuf:"varint,2,opt,name=uses,proto3" json:"uses,omitempty"` // Times the enricher appeared in a pipeline run
+	Succeeded                int32                  `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed                   int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped                  int32                  `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	DescriptionContributions int32                  `protobuf:"varint,6,opt,name=description_contributions,json=descriptionContributions,proto3" json:"description_contributions,omitempty"` // Successful runs that added description text
+	AverageDurationMs        int64                  `protobuf:"varint,7,opt,name=average_duration_ms,json=averageDurationMs,proto3" json:"average_duration_ms,omitempty"`                    // Across uses that recorded a duration
+	LastRunAt                *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *EnricherUsage) Reset() {
+	*x = EnricherUsage{}
+	mi := &file_models_pipeline_execution_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnricherUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnricherUsage) ProtoMessage() {}
+
+func (x *EnricherUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_execution_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnricherUsage.ProtoReflect.Descriptor instead.
+func (*EnricherUsage) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_execution_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EnricherUsage) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *EnricherUsage) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *EnricherUsage) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *EnricherUsage) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *EnricherUsage) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *EnricherUsage) GetDescriptionContributions() int32 {
+	if x != nil {
+		return x.DescriptionContributions
+	}
+	return 0
+}
+
+func (x *EnricherUsage) GetAverageDurationMs() int64 {
+	if x != nil {
+		return x.AverageDurationMs
+	}
+	return 0
+}
+
+func (x *EnricherUsage) GetLastRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return nil
+}
+
+// PipelineDailyStats pre-aggregates one pipeline's runs for a single UTC day,
+// stored at users/{uid}/pipelines/{pipeline_id}/daily_stats/{date}. Runs are
+// keyed by id with their latest status, so repeated status writes for the
+// same run never double count.
+type PipelineDailyStats struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	PipelineId    string                       `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	Date          string                       `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD (UTC)
+	Runs          map[string]PipelineRunStatus `protobuf:"bytes,3,rep,name=runs,proto3" json:"runs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=fitglue.models.pipeline.PipelineRunStatus"`
+	UpdatedAt     *timestamppb.Timestamp       `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PipelineDailyStats) Reset() {
+	*x = PipelineDailyStats{}
+	mi := &file_models_pipeline_execution_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PipelineDailyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineDailyStats) ProtoMessage() {}
+
+func (x *PipelineDailyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_execution_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineDailyStats.ProtoReflect.Descriptor instead.
+func (*PipelineDailyStats) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_execution_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PipelineDailyStats) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *PipelineDailyStats) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PipelineDailyStats) GetRuns() map[string]PipelineRunStatus {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *PipelineDailyStats) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// PipelineCalendarDay counts one day's pipeline runs by outcome, for the
+// execution history heatmap.
+type PipelineCalendarDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD (UTC)
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Synced        int32                  `protobuf:"varint,3,opt,name=synced,proto3" json:"synced,omitempty"`
+	Partial       int32                  `protobuf:"varint,4,opt,name=partial,proto3" json:"partial,omitempty"`
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped       int32                  `protobuf:"varint,6,opt,name=skipped,proto3" json:"skipped,omitempty"`                         // Skipped, archived or tier-blocked
+	InProgress    int32                  `protobuf:"varint,7,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"` // Running, pending input, scheduled or queued behind an outage
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PipelineCalendarDay) Reset() {
+	*x = PipelineCalendarDay{}
+	mi := &file_models_pipeline_execution_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PipelineCalendarDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineCalendarDay) ProtoMessage() {}
+
+func (x *PipelineCalendarDay) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_execution_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineCalendarDay.ProtoReflect.Descriptor instead.
+func (*PipelineCalendarDay) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_execution_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PipelineCalendarDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PipelineCalendarDay) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PipelineCalendarDay) GetSynced() int32 {
+	if x != nil {
+		return x.Synced
+	}
+	return 0
+}
+
+func (x *PipelineCalendarDay) GetPartial() int32 {
+	if x != nil {
+		return x.Partial
+	}
+	return 0
+}
+
+func (x *PipelineCalendarDay) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *PipelineCalendarDay) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *PipelineCalendarDay) GetInProgress() int32 {
+	if x != nil {
+		return x.InProgress
+	}
+	return 0
+}
+
 type DestinationOutcome struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Destination   plugin.DestinationType `protobuf:"varint,1,opt,name=destination,proto3,enum=fitglue.models.plugin.DestinationType" json:"destination,omitempty"`
@@ -473,13 +952,14 @@ type DestinationOutcome struct {
 	ExternalId    *string                `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
 	Error         *string                `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ApiCalls      []*DestinationApiCall  `protobuf:"bytes,6,rep,name=api_calls,json=apiCalls,proto3" json:"api_calls,omitempty"` // Latest calls to the destination's API, for troubleshooting
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DestinationOutcome) Reset() {
 	*x = DestinationOutcome{}
-	mi := &file_models_pipeline_execution_proto_msgTypes[2]
+	mi := &file_models_pipeline_execution_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -491,7 +971,7 @@ func (x *DestinationOutcome) String() string {
 func (*DestinationOutcome) ProtoMessage() {}
 
 func (x *DestinationOutcome) ProtoReflect() protoreflect.Message {
-	mi := &file_models_pipeline_execution_proto_msgTypes[2]
+	mi := &file_models_pipeline_execution_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +984,7 @@ func (x *DestinationOutcome) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DestinationOutcome.ProtoReflect.Descriptor instead.
 func (*DestinationOutcome) Descriptor() ([]byte, []int) {
-	return file_models_pipeline_execution_proto_rawDescGZIP(), []int{2}
+	return file_models_pipeline_execution_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DestinationOutcome) GetDestination() plugin.DestinationType {
@@ -542,6 +1022,189 @@ func (x *DestinationOutcome) GetCompletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *DestinationOutcome) GetApiCalls() []*DestinationApiCall {
+	if x != nil {
+		return x.ApiCalls
+	}
+	return nil
+}
+
+// DestinationApiCall summarizes one request an uploader made to its
+// destination's API. Headers, query strings and request bodies are never
+// kept; error responses keep a short, redacted excerpt of their body.
+type DestinationApiCall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                                  // Scheme, host and path only
+	StatusCode    int32                  `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 0 when no response was received
+	DurationMs    int64                  `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Error         *string                `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error,omitempty"` // Transport error or error response body excerpt
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DestinationApiCall) Reset() {
+	*x = DestinationApiCall{}
+	mi := &file_models_pipeline_execution_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestinationApiCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestinationApiCall) ProtoMessage() {}
+
+func (x *DestinationApiCall) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_execution_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestinationApiCall.ProtoReflect.Descriptor instead.
+func (*DestinationApiCall) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_execution_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DestinationApiCall) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *DestinationApiCall) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DestinationApiCall) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *DestinationApiCall) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *DestinationApiCall) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *DestinationApiCall) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+// ScheduledUpload is a destination upload held back by the destination's
+// posting schedule (a delay, or a window of local hours to post in), stored
+// at scheduled_uploads/{id} and released once due_at passes.
+type ScheduledUpload struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Destination plugin.DestinationType `protobuf:"varint,3,opt,name=destination,proto3,enum=fitglue.models.plugin.DestinationType" json:"destination,omitempty"`
+	DueAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	ScheduledAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	// Republished to topic-destination-upload with destinations narrowed to
+	// this destination
+	Upload        *events.EnrichedActivityEvent `protobuf:"bytes,6,opt,name=upload,proto3" json:"upload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledUpload) Reset() {
+	*x = ScheduledUpload{}
+	mi := &file_models_pipeline_execution_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledUpload) ProtoMessage() {}
+
+func (x *ScheduledUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_execution_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledUpload.ProtoReflect.Descriptor instead.
+func (*ScheduledUpload) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_execution_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ScheduledUpload) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduledUpload) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ScheduledUpload) GetDestination() plugin.DestinationType {
+	if x != nil {
+		return x.Destination
+	}
+	return plugin.DestinationType(0)
+}
+
+func (x *ScheduledUpload) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *ScheduledUpload) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *ScheduledUpload) GetUpload() *events.EnrichedActivityEvent {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
 type ExecutionRecord struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	ExecutionId         string                 `protobuf:"bytes,1,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
@@ -564,7 +1227,7 @@ type ExecutionRecord struct {
 
 func (x *ExecutionRecord) Reset() {
 	*x = ExecutionRecord{}
-	mi := &file_models_pipeline_execution_proto_msgTypes[3]
+	mi := &file_models_pipeline_execution_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -576,7 +1239,7 @@ func (x *ExecutionRecord) String() string {
 func (*ExecutionRecord) ProtoMessage() {}
 
 func (x *ExecutionRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_models_pipeline_execution_proto_msgTypes[3]
+	mi := &file_models_pipeline_execution_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,7 +1252,7 @@ func (x *ExecutionRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecutionRecord.ProtoReflect.Descriptor instead.
 func (*ExecutionRecord) Descriptor() ([]byte, []int) {
-	return file_models_pipeline_execution_proto_rawDescGZIP(), []int{3}
+	return file_models_pipeline_execution_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ExecutionRecord) GetExecutionId() string {
@@ -694,7 +1357,7 @@ var File_models_pipeline_execution_proto protoreflect.FileDescriptor
 
 const file_models_pipeline_execution_proto_rawDesc = "" +
 	"\n" +
-	"\x1fmodels/pipeline/execution.proto\x12\x17fitglue.models.pipeline\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmodels/activity/source.proto\x1a\x1cmodels/plugin/provider.proto\"\x88\a\n" +
+	"\x1fmodels/pipeline/execution.proto\x12\x17fitglue.models.pipeline\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmodels/activity/source.proto\x1a\x1cmodels/events/pipeline.proto\x1a\x1cmodels/plugin/provider.proto\"\x9d\f\n" +
 	"\vPipelineRun\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vpipeline_id\x18\x02 \x01(\tR\n" +
@@ -719,29 +1382,100 @@ const file_models_pipeline_execution_proto_rawDesc = "" +
 	"\x0estatus_message\x18\x0f \x01(\tH\x00R\rstatusMessage\x88\x01\x01\x12-\n" +
 	"\x10pending_input_id\x18\x10 \x01(\tH\x01R\x0ependingInputId\x88\x01\x01\x120\n" +
 	"\x14original_payload_uri\x18\x16 \x01(\tR\x12originalPayloadUri\x12,\n" +
-	"\x12enriched_event_uri\x18\x17 \x01(\tR\x10enrichedEventUriB\x11\n" +
+	"\x12enriched_event_uri\x18\x17 \x01(\tR\x10enrichedEventUri\x124\n" +
+	"\x04cost\x18\x18 \x01(\v2 .fitglue.models.pipeline.RunCostR\x04cost\x126\n" +
+	"\x17pipeline_config_version\x18\x19 \x01(\x05R\x15pipelineConfigVersion\x12\x17\n" +
+	"\ais_test\x18\x1a \x01(\bR\x06isTest\x12^\n" +
+	"\x0eretry_attempts\x18\x1b \x03(\v27.fitglue.models.pipeline.PipelineRun.RetryAttemptsEntryR\rretryAttempts\x12>\n" +
+	"\rnext_retry_at\x18\x1c \x01(\v2\x1a.google.protobuf.TimestampR\vnextRetryAt\x12 \n" +
+	"\treplay_of\x18\x1d \x01(\tH\x02R\breplayOf\x88\x01\x01\x12N\n" +
+	"\x0freplay_override\x18\x1e \x01(\v2%.fitglue.models.events.ReplayOverrideR\x0ereplayOverride\x12'\n" +
+	"\x0fdistance_meters\x18\x1f \x01(\x01R\x0edistanceMeters\x12)\n" +
+	"\x10duration_seconds\x18  \x01(\x01R\x0fdurationSeconds\x12\x17\n" +
+	"\auser_id\x18! \x01(\tR\x06userId\x12=\n" +
+	"\fcompleted_at\x18\" \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x1a@\n" +
+	"\x12RetryAttemptsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01B\x11\n" +
 	"\x0f_status_messageB\x13\n" +
-	"\x11_pending_input_id\"\xa7\x02\n" +
+	"\x11_pending_input_idB\f\n" +
+	"\n" +
+	"_replay_of\"\xe0\x02\n" +
 	"\x10BoosterExecution\x12#\n" +
 	"\rprovider_name\x18\x01 \x01(\tR\fproviderName\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1f\n" +
 	"\vduration_ms\x18\x03 \x01(\x03R\n" +
 	"durationMs\x12S\n" +
 	"\bmetadata\x18\x04 \x03(\v27.fitglue.models.pipeline.BoosterExecution.MetadataEntryR\bmetadata\x12\x19\n" +
-	"\x05error\x18\x05 \x01(\tH\x00R\x05error\x88\x01\x01\x1a;\n" +
+	"\x05error\x18\x05 \x01(\tH\x00R\x05error\x88\x01\x01\x127\n" +
+	"\x17contributed_description\x18\x06 \x01(\bR\x16contributedDescription\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
-	"\x06_error\"\xbc\x02\n" +
+	"\x06_error\"\x8b\x02\n" +
+	"\aRunCost\x12&\n" +
+	"\x0fai_input_tokens\x18\x01 \x01(\x03R\raiInputTokens\x12(\n" +
+	"\x10ai_output_tokens\x18\x02 \x01(\x03R\x0eaiOutputTokens\x12+\n" +
+	"\x11image_generations\x18\x03 \x01(\x05R\x10imageGenerations\x12,\n" +
+	"\x12external_api_calls\x18\x04 \x01(\x05R\x10externalApiCalls\x12.\n" +
+	"\x13function_gb_seconds\x18\x05 \x01(\x01R\x11functionGbSeconds\x12#\n" +
+	"\restimated_usd\x18\x06 \x01(\x01R\festimatedUsd\"\xc1\x02\n" +
+	"\rEnricherUsage\x12#\n" +
+	"\rprovider_name\x18\x01 \x01(\tR\fproviderName\x12\x12\n" +
+	"\x04uses\x18\x02 \x01(\x05R\x04uses\x12\x1c\n" +
+	"\tsucceeded\x18\x03 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12\x18\n" +
+	"\askipped\x18\x05 \x01(\x05R\askipped\x12;\n" +
+	"\x19description_contributions\x18\x06 \x01(\x05R\x18descriptionContributions\x12.\n" +
+	"\x13average_duration_ms\x18\a \x01(\x03R\x11averageDurationMs\x12:\n" +
+	"\vlast_run_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tlastRunAt\"\xb4\x02\n" +
+	"\x12PipelineDailyStats\x12\x1f\n" +
+	"\vpipeline_id\x18\x01 \x01(\tR\n" +
+	"pipelineId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12I\n" +
+	"\x04runs\x18\x03 \x03(\v25.fitglue.models.pipeline.PipelineDailyStats.RunsEntryR\x04runs\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1ac\n" +
+	"\tRunsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12@\n" +
+	"\x05value\x18\x02 \x01(\x0e2*.fitglue.models.pipeline.PipelineRunStatusR\x05value:\x028\x01\"\xc4\x01\n" +
+	"\x13PipelineCalendarDay\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x16\n" +
+	"\x06synced\x18\x03 \x01(\x05R\x06synced\x12\x18\n" +
+	"\apartial\x18\x04 \x01(\x05R\apartial\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12\x18\n" +
+	"\askipped\x18\x06 \x01(\x05R\askipped\x12\x1f\n" +
+	"\vin_progress\x18\a \x01(\x05R\n" +
+	"inProgress\"\x86\x03\n" +
 	"\x12DestinationOutcome\x12H\n" +
 	"\vdestination\x18\x01 \x01(\x0e2&.fitglue.models.plugin.DestinationTypeR\vdestination\x12B\n" +
 	"\x06status\x18\x02 \x01(\x0e2*.fitglue.models.pipeline.DestinationStatusR\x06status\x12$\n" +
 	"\vexternal_id\x18\x03 \x01(\tH\x00R\n" +
 	"externalId\x88\x01\x01\x12\x19\n" +
 	"\x05error\x18\x04 \x01(\tH\x01R\x05error\x88\x01\x01\x12=\n" +
-	"\fcompleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAtB\x0e\n" +
+	"\fcompleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12H\n" +
+	"\tapi_calls\x18\x06 \x03(\v2+.fitglue.models.pipeline.DestinationApiCallR\bapiCallsB\x0e\n" +
 	"\f_external_idB\b\n" +
-	"\x06_error\"\xae\x06\n" +
+	"\x06_error\"\xe0\x01\n" +
+	"\x12DestinationApiCall\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vstatus_code\x18\x03 \x01(\x05R\n" +
+	"statusCode\x12\x1f\n" +
+	"\vduration_ms\x18\x04 \x01(\x03R\n" +
+	"durationMs\x12\x19\n" +
+	"\x05error\x18\x05 \x01(\tH\x00R\x05error\x88\x01\x01\x129\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAtB\b\n" +
+	"\x06_error\"\xbc\x02\n" +
+	"\x0fScheduledUpload\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12H\n" +
+	"\vdestination\x18\x03 \x01(\x0e2&.fitglue.models.plugin.DestinationTypeR\vdestination\x121\n" +
+	"\x06due_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12=\n" +
+	"\fscheduled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12D\n" +
+	"\x06upload\x18\x06 \x01(\v2,.fitglue.models.events.EnrichedActivityEventR\x06upload\"\xae\x06\n" +
 	"\x0fExecutionRecord\x12!\n" +
 	"\fexecution_id\x18\x01 \x01(\tR\vexecutionId\x12\x18\n" +
 	"\aservice\x18\x02 \x01(\tR\aservice\x12@\n" +
@@ -770,7 +1504,7 @@ const file_models_pipeline_execution_proto_rawDesc = "" +
 	"\r_outputs_jsonB\f\n" +
 	"\n" +
 	"_expire_atB\x18\n" +
-	"\x16_pipeline_execution_id*\xc4\x02\n" +
+	"\x16_pipeline_execution_id*\xe4\x03\n" +
 	"\x11PipelineRunStatus\x12#\n" +
 	"\x1fPIPELINE_RUN_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bPIPELINE_RUN_STATUS_RUNNING\x10\x01\x12\x1e\n" +
@@ -780,13 +1514,21 @@ const file_models_pipeline_execution_proto_rawDesc = "" +
 	"\x1bPIPELINE_RUN_STATUS_PENDING\x10\x05\x12\x1f\n" +
 	"\x1bPIPELINE_RUN_STATUS_SKIPPED\x10\x06\x12 \n" +
 	"\x1cPIPELINE_RUN_STATUS_ARCHIVED\x10\a\x12$\n" +
-	" PIPELINE_RUN_STATUS_TIER_BLOCKED\x10\b*\xb6\x01\n" +
+	" PIPELINE_RUN_STATUS_TIER_BLOCKED\x10\b\x12.\n" +
+	"*PIPELINE_RUN_STATUS_QUEUED_PLATFORM_OUTAGE\x10\t\x12 \n" +
+	"\x1cPIPELINE_RUN_STATUS_DEFERRED\x10\n" +
+	"\x12)\n" +
+	"%PIPELINE_RUN_STATUS_DELETED_AT_SOURCE\x10\v\x12!\n" +
+	"\x1dPIPELINE_RUN_STATUS_SCHEDULED\x10\f*\xa7\x02\n" +
 	"\x11DestinationStatus\x12\"\n" +
 	"\x1eDESTINATION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aDESTINATION_STATUS_PENDING\x10\x01\x12\x1e\n" +
 	"\x1aDESTINATION_STATUS_SUCCESS\x10\x02\x12\x1d\n" +
 	"\x19DESTINATION_STATUS_FAILED\x10\x03\x12\x1e\n" +
-	"\x1aDESTINATION_STATUS_SKIPPED\x10\x04*\xb9\x01\n" +
+	"\x1aDESTINATION_STATUS_SKIPPED\x10\x04\x12-\n" +
+	")DESTINATION_STATUS_QUEUED_PLATFORM_OUTAGE\x10\x05\x12\x1e\n" +
+	"\x1aDESTINATION_STATUS_REMOVED\x10\x06\x12 \n" +
+	"\x1cDESTINATION_STATUS_SCHEDULED\x10\a*\xb9\x01\n" +
 	"\x0fExecutionStatus\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eSTATUS_STARTED\x10\x01\x12\x12\n" +
@@ -810,42 +1552,67 @@ func file_models_pipeline_execution_proto_rawDescGZIP() []byte {
 }
 
 var file_models_pipeline_execution_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_models_pipeline_execution_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_models_pipeline_execution_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_models_pipeline_execution_proto_goTypes = []any{
-	(PipelineRunStatus)(0),        // 0: fitglue.models.pipeline.PipelineRunStatus
-	(DestinationStatus)(0),        // 1: fitglue.models.pipeline.DestinationStatus
-	(ExecutionStatus)(0),          // 2: fitglue.models.pipeline.ExecutionStatus
-	(*PipelineRun)(nil),           // 3: fitglue.models.pipeline.PipelineRun
-	(*BoosterExecution)(nil),      // 4: fitglue.models.pipeline.BoosterExecution
-	(*DestinationOutcome)(nil),    // 5: fitglue.models.pipeline.DestinationOutcome
-	(*ExecutionRecord)(nil),       // 6: fitglue.models.pipeline.ExecutionRecord
-	nil,                           // 7: fitglue.models.pipeline.BoosterExecution.MetadataEntry
-	(activity.ActivityType)(0),    // 8: fitglue.models.activity.ActivityType
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
-	(plugin.DestinationType)(0),   // 10: fitglue.models.plugin.DestinationType
+	(PipelineRunStatus)(0),               // 0: fitglue.models.pipeline.PipelineRunStatus
+	(DestinationStatus)(0),               // 1: fitglue.models.pipeline.DestinationStatus
+	(ExecutionStatus)(0),                 // 2: fitglue.models.pipeline.ExecutionStatus
+	(*PipelineRun)(nil),                  // 3: fitglue.models.pipeline.PipelineRun
+	(*BoosterExecution)(nil),             // 4: fitglue.models.pipeline.BoosterExecution
+	(*RunCost)(nil),                      // 5: fitglue.models.pipeline.RunCost
+	(*EnricherUsage)(nil),                // 6: fitglue.models.pipeline.EnricherUsage
+	(*PipelineDailyStats)(nil),           // 7: fitglue.models.pipeline.PipelineDailyStats
+	(*PipelineCalendarDay)(nil),          // 8: fitglue.models.pipeline.PipelineCalendarDay
+	(*DestinationOutcome)(nil),           // 9: fitglue.models.pipeline.DestinationOutcome
+	(*DestinationApiCall)(nil),           // 10: fitglue.models.pipeline.DestinationApiCall
+	(*ScheduledUpload)(nil),              // 11: fitglue.models.pipeline.ScheduledUpload
+	(*ExecutionRecord)(nil),              // 12: fitglue.models.pipeline.ExecutionRecord
+	nil,                                  // 13: fitglue.models.pipeline.PipelineRun.RetryAttemptsEntry
+	nil,                                  // 14: fitglue.models.pipeline.BoosterExecution.MetadataEntry
+	nil,                                  // 15: fitglue.models.pipeline.PipelineDailyStats.RunsEntry
+	(activity.ActivityType)(0),           // 16: fitglue.models.activity.ActivityType
+	(*timestamppb.Timestamp)(nil),        // 17: google.protobuf.Timestamp
+	(*events.ReplayOverride)(nil),        // 18: fitglue.models.events.ReplayOverride
+	(plugin.DestinationType)(0),          // 19: fitglue.models.plugin.DestinationType
+	(*events.EnrichedActivityEvent)(nil), // 20: fitglue.models.events.EnrichedActivityEvent
 }
 var file_models_pipeline_execution_proto_depIdxs = []int32{
-	8,  // 0: fitglue.models.pipeline.PipelineRun.type:type_name -> fitglue.models.activity.ActivityType
-	9,  // 1: fitglue.models.pipeline.PipelineRun.start_time:type_name -> google.protobuf.Timestamp
+	16, // 0: fitglue.models.pipeline.PipelineRun.type:type_name -> fitglue.models.activity.ActivityType
+	17, // 1: fitglue.models.pipeline.PipelineRun.start_time:type_name -> google.protobuf.Timestamp
 	0,  // 2: fitglue.models.pipeline.PipelineRun.status:type_name -> fitglue.models.pipeline.PipelineRunStatus
-	9,  // 3: fitglue.models.pipeline.PipelineRun.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 4: fitglue.models.pipeline.PipelineRun.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 3: fitglue.models.pipeline.PipelineRun.created_at:type_name -> google.protobuf.Timestamp
+	17, // 4: fitglue.models.pipeline.PipelineRun.updated_at:type_name -> google.protobuf.Timestamp
 	4,  // 5: fitglue.models.pipeline.PipelineRun.boosters:type_name -> fitglue.models.pipeline.BoosterExecution
-	5,  // 6: fitglue.models.pipeline.PipelineRun.destinations:type_name -> fitglue.models.pipeline.DestinationOutcome
-	7,  // 7: fitglue.models.pipeline.BoosterExecution.metadata:type_name -> fitglue.models.pipeline.BoosterExecution.MetadataEntry
-	10, // 8: fitglue.models.pipeline.DestinationOutcome.destination:type_name -> fitglue.models.plugin.DestinationType
-	1,  // 9: fitglue.models.pipeline.DestinationOutcome.status:type_name -> fitglue.models.pipeline.DestinationStatus
-	9,  // 10: fitglue.models.pipeline.DestinationOutcome.completed_at:type_name -> google.protobuf.Timestamp
-	2,  // 11: fitglue.models.pipeline.ExecutionRecord.status:type_name -> fitglue.models.pipeline.ExecutionStatus
-	9,  // 12: fitglue.models.pipeline.ExecutionRecord.timestamp:type_name -> google.protobuf.Timestamp
-	9,  // 13: fitglue.models.pipeline.ExecutionRecord.start_time:type_name -> google.protobuf.Timestamp
-	9,  // 14: fitglue.models.pipeline.ExecutionRecord.end_time:type_name -> google.protobuf.Timestamp
-	9,  // 15: fitglue.models.pipeline.ExecutionRecord.expire_at:type_name -> google.protobuf.Timestamp
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	9,  // 6: fitglue.models.pipeline.PipelineRun.destinations:type_name -> fitglue.models.pipeline.DestinationOutcome
+	5,  // 7: fitglue.models.pipeline.PipelineRun.cost:type_name -> fitglue.models.pipeline.RunCost
+	13, // 8: fitglue.models.pipeline.PipelineRun.retry_attempts:type_name -> fitglue.models.pipeline.PipelineRun.RetryAttemptsEntry
+	17, // 9: fitglue.models.pipeline.PipelineRun.next_retry_at:type_name -> google.protobuf.Timestamp
+	18, // 10: fitglue.models.pipeline.PipelineRun.replay_override:type_name -> fitglue.models.events.ReplayOverride
+	17, // 11: fitglue.models.pipeline.PipelineRun.completed_at:type_name -> google.protobuf.Timestamp
+	14, // 12: fitglue.models.pipeline.BoosterExecution.metadata:type_name -> fitglue.models.pipeline.BoosterExecution.MetadataEntry
+	17, // 13: fitglue.models.pipeline.EnricherUsage.last_run_at:type_name -> google.protobuf.Timestamp
+	15, // 14: fitglue.models.pipeline.PipelineDailyStats.runs:type_name -> fitglue.models.pipeline.PipelineDailyStats.RunsEntry
+	17, // 15: fitglue.models.pipeline.PipelineDailyStats.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 16: fitglue.models.pipeline.DestinationOutcome.destination:type_name -> fitglue.models.plugin.DestinationType
+	1,  // 17: fitglue.models.pipeline.DestinationOutcome.status:type_name -> fitglue.models.pipeline.DestinationStatus
+	17, // 18: fitglue.models.pipeline.DestinationOutcome.completed_at:type_name -> google.protobuf.Timestamp
+	10, // 19: fitglue.models.pipeline.DestinationOutcome.api_calls:type_name -> fitglue.models.pipeline.DestinationApiCall
+	17, // 20: fitglue.models.pipeline.DestinationApiCall.started_at:type_name -> google.protobuf.Timestamp
+	19, // 21: fitglue.models.pipeline.ScheduledUpload.destination:type_name -> fitglue.models.plugin.DestinationType
+	17, // 22: fitglue.models.pipeline.ScheduledUpload.due_at:type_name -> google.protobuf.Timestamp
+	17, // 23: fitglue.models.pipeline.ScheduledUpload.scheduled_at:type_name -> google.protobuf.Timestamp
+	20, // 24: fitglue.models.pipeline.ScheduledUpload.upload:type_name -> fitglue.models.events.EnrichedActivityEvent
+	2,  // 25: fitglue.models.pipeline.ExecutionRecord.status:type_name -> fitglue.models.pipeline.ExecutionStatus
+	17, // 26: fitglue.models.pipeline.ExecutionRecord.timestamp:type_name -> google.protobuf.Timestamp
+	17, // 27: fitglue.models.pipeline.ExecutionRecord.start_time:type_name -> google.protobuf.Timestamp
+	17, // 28: fitglue.models.pipeline.ExecutionRecord.end_time:type_name -> google.protobuf.Timestamp
+	17, // 29: fitglue.models.pipeline.ExecutionRecord.expire_at:type_name -> google.protobuf.Timestamp
+	0,  // 30: fitglue.models.pipeline.PipelineDailyStats.RunsEntry.value:type_name -> fitglue.models.pipeline.PipelineRunStatus
+	31, // [31:31] is the sub-list for method output_type
+	31, // [31:31] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_models_pipeline_execution_proto_init() }
@@ -855,15 +1622,16 @@ func file_models_pipeline_execution_proto_init() {
 	}
 	file_models_pipeline_execution_proto_msgTypes[0].OneofWrappers = []any{}
 	file_models_pipeline_execution_proto_msgTypes[1].OneofWrappers = []any{}
-	file_models_pipeline_execution_proto_msgTypes[2].OneofWrappers = []any{}
-	file_models_pipeline_execution_proto_msgTypes[3].OneofWrappers = []any{}
+	file_models_pipeline_execution_proto_msgTypes[6].OneofWrappers = []any{}
+	file_models_pipeline_execution_proto_msgTypes[7].OneofWrappers = []any{}
+	file_models_pipeline_execution_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_pipeline_execution_proto_rawDesc), len(file_models_pipeline_execution_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   5,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
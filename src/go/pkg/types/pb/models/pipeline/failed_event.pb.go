@@ -0,0 +1,321 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: models/pipeline/failed_event.proto
+
+package pipeline
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FailedEventStatus int32
+
+const (
+	FailedEventStatus_FAILED_EVENT_STATUS_UNSPECIFIED FailedEventStatus = 0
+	FailedEventStatus_FAILED_EVENT_STATUS_PENDING     FailedEventStatus = 1 // Waiting for its next automatic redrive
+	FailedEventStatus_FAILED_EVENT_STATUS_REDRIVEN    FailedEventStatus = 2 // Republished; back to PENDING if it fails again
+	FailedEventStatus_FAILED_EVENT_STATUS_EXHAUSTED   FailedEventStatus = 3 // Out of automatic attempts; only an admin redrive retries it
+)
+
+// Enum value maps for FailedEventStatus.
+var (
+	FailedEventStatus_name = map[int32]string{
+		0: "FAILED_EVENT_STATUS_UNSPECIFIED",
+		1: "FAILED_EVENT_STATUS_PENDING",
+		2: "FAILED_EVENT_STATUS_REDRIVEN",
+		3: "FAILED_EVENT_STATUS_EXHAUSTED",
+	}
+	FailedEventStatus_value = map[string]int32{
+		"FAILED_EVENT_STATUS_UNSPECIFIED": 0,
+		"FAILED_EVENT_STATUS_PENDING":     1,
+		"FAILED_EVENT_STATUS_REDRIVEN":    2,
+		"FAILED_EVENT_STATUS_EXHAUSTED":   3,
+	}
+)
+
+func (x FailedEventStatus) Enum() *FailedEventStatus {
+	p := new(FailedEventStatus)
+	*p = x
+	return p
+}
+
+func (x FailedEventStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FailedEventStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_pipeline_failed_event_proto_enumTypes[0].Descriptor()
+}
+
+func (FailedEventStatus) Type() protoreflect.EnumType {
+	return &file_models_pipeline_failed_event_proto_enumTypes[0]
+}
+
+func (x FailedEventStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FailedEventStatus.Descriptor instead.
+func (FailedEventStatus) EnumDescriptor() ([]byte, []int) {
+	return file_models_pipeline_failed_event_proto_rawDescGZIP(), []int{0}
+}
+
+// FailedEvent is a pipeline Pub/Sub message that kept failing and was
+// dead-lettered, stored at users/{user_id}/failed_events/{id} by the redrive
+// handler. Redriving republishes data and attributes to source_topic
+// unchanged. Events expire via a TTL on expires_at.
+type FailedEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PipelineId     string                 `protobuf:"bytes,3,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"` // From the event payload, when it has one
+	SourceTopic    string                 `protobuf:"bytes,4,opt,name=source_topic,json=sourceTopic,proto3" json:"source_topic,omitempty"`
+	Error          string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"` // Last handler error; empty when Pub/Sub dead-lettered it without one
+	Data           []byte                 `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	Attributes     map[string]string      `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Status         FailedEventStatus      `protobuf:"varint,8,opt,name=status,proto3,enum=fitglue.models.pipeline.FailedEventStatus" json:"status,omitempty"`
+	Attempts       int32                  `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"` // Redrives so far
+	FirstFailedAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=first_failed_at,json=firstFailedAt,proto3" json:"first_failed_at,omitempty"`
+	LastFailedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_failed_at,json=lastFailedAt,proto3" json:"last_failed_at,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"` // Unset once exhausted
+	LastRedrivenAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_redriven_at,json=lastRedrivenAt,proto3" json:"last_redriven_at,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FailedEvent) Reset() {
+	*x = FailedEvent{}
+	mi := &file_models_pipeline_failed_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailedEvent) ProtoMessage() {}
+
+func (x *FailedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_failed_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailedEvent.ProtoReflect.Descriptor instead.
+func (*FailedEvent) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_failed_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FailedEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FailedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FailedEvent) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *FailedEvent) GetSourceTopic() string {
+	if x != nil {
+		return x.SourceTopic
+	}
+	return ""
+}
+
+func (x *FailedEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FailedEvent) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FailedEvent) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *FailedEvent) GetStatus() FailedEventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FailedEventStatus_FAILED_EVENT_STATUS_UNSPECIFIED
+}
+
+func (x *FailedEvent) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *FailedEvent) GetFirstFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstFailedAt
+	}
+	return nil
+}
+
+func (x *FailedEvent) GetLastFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFailedAt
+	}
+	return nil
+}
+
+func (x *FailedEvent) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *FailedEvent) GetLastRedrivenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRedrivenAt
+	}
+	return nil
+}
+
+func (x *FailedEvent) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+var File_models_pipeline_failed_event_proto protoreflect.FileDescriptor
+
+const file_models_pipeline_failed_event_proto_rawDesc = "" +
+	"\n" +
+	"\"models/pipeline/failed_event.proto\x12\x17fitglue.models.pipeline\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe4\x05\n" +
+	"\vFailedEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vpipeline_id\x18\x03 \x01(\tR\n" +
+	"pipelineId\x12!\n" +
+	"\fsource_topic\x18\x04 \x01(\tR\vsourceTopic\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x12\n" +
+	"\x04data\x18\x06 \x01(\fR\x04data\x12T\n" +
+	"\n" +
+	"attributes\x18\a \x03(\v24.fitglue.models.pipeline.FailedEvent.AttributesEntryR\n" +
+	"attributes\x12B\n" +
+	"\x06status\x18\b \x01(\x0e2*.fitglue.models.pipeline.FailedEventStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\t \x01(\x05R\battempts\x12B\n" +
+	"\x0ffirst_failed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rfirstFailedAt\x12@\n" +
+	"\x0elast_failed_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\flastFailedAt\x12B\n" +
+	"\x0fnext_attempt_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12D\n" +
+	"\x10last_redriven_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x0elastRedrivenAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\x9e\x01\n" +
+	"\x11FailedEventStatus\x12#\n" +
+	"\x1fFAILED_EVENT_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bFAILED_EVENT_STATUS_PENDING\x10\x01\x12 \n" +
+	"\x1cFAILED_EVENT_STATUS_REDRIVEN\x10\x02\x12!\n" +
+	"\x1dFAILED_EVENT_STATUS_EXHAUSTED\x10\x03B?Z=github.com/fitglue/server/src/go/pkg/types/pb/models/pipelineb\x06proto3"
+
+var (
+	file_models_pipeline_failed_event_proto_rawDescOnce sync.Once
+	file_models_pipeline_failed_event_proto_rawDescData []byte
+)
+
+func file_models_pipeline_failed_event_proto_rawDescGZIP() []byte {
+	file_models_pipeline_failed_event_proto_rawDescOnce.Do(func() {
+		file_models_pipeline_failed_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_models_pipeline_failed_event_proto_rawDesc), len(file_models_pipeline_failed_event_proto_rawDesc)))
+	})
+	return file_models_pipeline_failed_event_proto_rawDescData
+}
+
+var file_models_pipeline_failed_event_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_models_pipeline_failed_event_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_models_pipeline_failed_event_proto_goTypes = []any{
+	(FailedEventStatus)(0),        // 0: fitglue.models.pipeline.FailedEventStatus
+	(*FailedEvent)(nil),           // 1: fitglue.models.pipeline.FailedEvent
+	nil,                           // 2: fitglue.models.pipeline.FailedEvent.AttributesEntry
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_models_pipeline_failed_event_proto_depIdxs = []int32{
+	2, // 0: fitglue.models.pipeline.FailedEvent.attributes:type_name -> fitglue.models.pipeline.FailedEvent.AttributesEntry
+	0, // 1: fitglue.models.pipeline.FailedEvent.status:type_name -> fitglue.models.pipeline.FailedEventStatus
+	3, // 2: fitglue.models.pipeline.FailedEvent.first_failed_at:type_name -> google.protobuf.Timestamp
+	3, // 3: fitglue.models.pipeline.FailedEvent.last_failed_at:type_name -> google.protobuf.Timestamp
+	3, // 4: fitglue.models.pipeline.FailedEvent.next_attempt_at:type_name -> google.protobuf.Timestamp
+	3, // 5: fitglue.models.pipeline.FailedEvent.last_redriven_at:type_name -> google.protobuf.Timestamp
+	3, // 6: fitglue.models.pipeline.FailedEvent.expires_at:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_models_pipeline_failed_event_proto_init() }
+func file_models_pipeline_failed_event_proto_init() {
+	if File_models_pipeline_failed_event_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_pipeline_failed_event_proto_rawDesc), len(file_models_pipeline_failed_event_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_pipeline_failed_event_proto_goTypes,
+		DependencyIndexes: file_models_pipeline_failed_event_proto_depIdxs,
+		EnumInfos:         file_models_pipeline_failed_event_proto_enumTypes,
+		MessageInfos:      file_models_pipeline_failed_event_proto_msgTypes,
+	}.Build()
+	File_models_pipeline_failed_event_proto = out.File
+	file_models_pipeline_failed_event_proto_goTypes = nil
+	file_models_pipeline_failed_event_proto_depIdxs = nil
+}
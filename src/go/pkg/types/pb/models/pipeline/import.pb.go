@@ -0,0 +1,442 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: models/pipeline/import.proto
+
+package pipeline
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ImportStatus int32
+
+const (
+	ImportStatus_IMPORT_STATUS_UNSPECIFIED ImportStatus = 0
+	ImportStatus_IMPORT_STATUS_UPLOADING   ImportStatus = 1 // Waiting for the client to upload files
+	ImportStatus_IMPORT_STATUS_RUNNING     ImportStatus = 2
+	ImportStatus_IMPORT_STATUS_COMPLETED   ImportStatus = 3
+	ImportStatus_IMPORT_STATUS_FAILED      ImportStatus = 4 // Stopped partway; starting it again resumes
+)
+
+// Enum value maps for ImportStatus.
+var (
+	ImportStatus_name = map[int32]string{
+		0: "IMPORT_STATUS_UNSPECIFIED",
+		1: "IMPORT_STATUS_UPLOADING",
+		2: "IMPORT_STATUS_RUNNING",
+		3: "IMPORT_STATUS_COMPLETED",
+		4: "IMPORT_STATUS_FAILED",
+	}
+	ImportStatus_value = map[string]int32{
+		"IMPORT_STATUS_UNSPECIFIED": 0,
+		"IMPORT_STATUS_UPLOADING":   1,
+		"IMPORT_STATUS_RUNNING":     2,
+		"IMPORT_STATUS_COMPLETED":   3,
+		"IMPORT_STATUS_FAILED":      4,
+	}
+)
+
+func (x ImportStatus) Enum() *ImportStatus {
+	p := new(ImportStatus)
+	*p = x
+	return p
+}
+
+func (x ImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_pipeline_import_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportStatus) Type() protoreflect.EnumType {
+	return &file_models_pipeline_import_proto_enumTypes[0]
+}
+
+func (x ImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportStatus.Descriptor instead.
+func (ImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_models_pipeline_import_proto_rawDescGZIP(), []int{0}
+}
+
+type ImportFileStatus int32
+
+const (
+	ImportFileStatus_IMPORT_FILE_STATUS_UNSPECIFIED ImportFileStatus = 0
+	ImportFileStatus_IMPORT_FILE_STATUS_PENDING     ImportFileStatus = 1 // Not uploaded yet
+	ImportFileStatus_IMPORT_FILE_STATUS_UPLOADED    ImportFileStatus = 2
+	ImportFileStatus_IMPORT_FILE_STATUS_COMPLETED   ImportFileStatus = 3 // Published to the pipeline
+	ImportFileStatus_IMPORT_FILE_STATUS_FAILED      ImportFileStatus = 4
+)
+
+// Enum value maps for ImportFileStatus.
+var (
+	ImportFileStatus_name = map[int32]string{
+		0: "IMPORT_FILE_STATUS_UNSPECIFIED",
+		1: "IMPORT_FILE_STATUS_PENDING",
+		2: "IMPORT_FILE_STATUS_UPLOADED",
+		3: "IMPORT_FILE_STATUS_COMPLETED",
+		4: "IMPORT_FILE_STATUS_FAILED",
+	}
+	ImportFileStatus_value = map[string]int32{
+		"IMPORT_FILE_STATUS_UNSPECIFIED": 0,
+		"IMPORT_FILE_STATUS_PENDING":     1,
+		"IMPORT_FILE_STATUS_UPLOADED":    2,
+		"IMPORT_FILE_STATUS_COMPLETED":   3,
+		"IMPORT_FILE_STATUS_FAILED":      4,
+	}
+)
+
+func (x ImportFileStatus) Enum() *ImportFileStatus {
+	p := new(ImportFileStatus)
+	*p = x
+	return p
+}
+
+func (x ImportFileStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFileStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_pipeline_import_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportFileStatus) Type() protoreflect.EnumType {
+	return &file_models_pipeline_import_proto_enumTypes[1]
+}
+
+func (x ImportFileStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFileStatus.Descriptor instead.
+func (ImportFileStatus) EnumDescriptor() ([]byte, []int) {
+	return file_models_pipeline_import_proto_rawDescGZIP(), []int{1}
+}
+
+// ImportSession tracks a bulk import of activity files into a single
+// pipeline. Stored in the top-level import_sessions collection and polled by
+// the web app. Processing skips files that already completed, so a session
+// that fails partway resumes where it stopped rather than from zero.
+type ImportSession struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PipelineId     string                 `protobuf:"bytes,3,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	Status         ImportStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=fitglue.models.pipeline.ImportStatus" json:"status,omitempty"`
+	Files          []*ImportFile          `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"` // In upload order; addressed by index
+	FilesCompleted int32                  `protobuf:"varint,6,opt,name=files_completed,json=filesCompleted,proto3" json:"files_completed,omitempty"`
+	FilesFailed    int32                  `protobuf:"varint,7,opt,name=files_failed,json=filesFailed,proto3" json:"files_failed,omitempty"`
+	Error          *string                `protobuf:"bytes,8,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportSession) Reset() {
+	*x = ImportSession{}
+	mi := &file_models_pipeline_import_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSession) ProtoMessage() {}
+
+func (x *ImportSession) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_import_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSession.ProtoReflect.Descriptor instead.
+func (*ImportSession) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_import_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ImportSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportSession) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportSession) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *ImportSession) GetStatus() ImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportStatus_IMPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ImportSession) GetFiles() []*ImportFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ImportSession) GetFilesCompleted() int32 {
+	if x != nil {
+		return x.FilesCompleted
+	}
+	return 0
+}
+
+func (x *ImportSession) GetFilesFailed() int32 {
+	if x != nil {
+		return x.FilesFailed
+	}
+	return 0
+}
+
+func (x *ImportSession) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *ImportSession) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ImportSession) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ImportSession) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type ImportFile struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Client file name, for display only
+	Status              ImportFileStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=fitglue.models.pipeline.ImportFileStatus" json:"status,omitempty"`
+	Uri                 string                 `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"` // gs:// URI of the uploaded file; set once uploaded
+	Error               *string                `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	PipelineExecutionId string                 `protobuf:"bytes,5,opt,name=pipeline_execution_id,json=pipelineExecutionId,proto3" json:"pipeline_execution_id,omitempty"` // Set once the activity is published
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ImportFile) Reset() {
+	*x = ImportFile{}
+	mi := &file_models_pipeline_import_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFile) ProtoMessage() {}
+
+func (x *ImportFile) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_import_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFile.ProtoReflect.Descriptor instead.
+func (*ImportFile) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_import_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImportFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportFile) GetStatus() ImportFileStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportFileStatus_IMPORT_FILE_STATUS_UNSPECIFIED
+}
+
+func (x *ImportFile) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ImportFile) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *ImportFile) GetPipelineExecutionId() string {
+	if x != nil {
+		return x.PipelineExecutionId
+	}
+	return ""
+}
+
+var File_models_pipeline_import_proto protoreflect.FileDescriptor
+
+const file_models_pipeline_import_proto_rawDesc = "" +
+	"\n" +
+	"\x1cmodels/pipeline/import.proto\x12\x17fitglue.models.pipeline\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf9\x03\n" +
+	"\rImportSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vpipeline_id\x18\x03 \x01(\tR\n" +
+	"pipelineId\x12=\n" +
+	"\x06status\x18\x04 \x01(\x0e2%.fitglue.models.pipeline.ImportStatusR\x06status\x129\n" +
+	"\x05files\x18\x05 \x03(\v2#.fitglue.models.pipeline.ImportFileR\x05files\x12'\n" +
+	"\x0ffiles_completed\x18\x06 \x01(\x05R\x0efilesCompleted\x12!\n" +
+	"\ffiles_failed\x18\a \x01(\x05R\vfilesFailed\x12\x19\n" +
+	"\x05error\x18\b \x01(\tH\x00R\x05error\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAtB\b\n" +
+	"\x06_error\"\xce\x01\n" +
+	"\n" +
+	"ImportFile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12A\n" +
+	"\x06status\x18\x02 \x01(\x0e2).fitglue.models.pipeline.ImportFileStatusR\x06status\x12\x10\n" +
+	"\x03uri\x18\x03 \x01(\tR\x03uri\x12\x19\n" +
+	"\x05error\x18\x04 \x01(\tH\x00R\x05error\x88\x01\x01\x122\n" +
+	"\x15pipeline_execution_id\x18\x05 \x01(\tR\x13pipelineExecutionIdB\b\n" +
+	"\x06_error*\x9c\x01\n" +
+	"\fImportStatus\x12\x1d\n" +
+	"\x19IMPORT_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17IMPORT_STATUS_UPLOADING\x10\x01\x12\x19\n" +
+	"\x15IMPORT_STATUS_RUNNING\x10\x02\x12\x1b\n" +
+	"\x17IMPORT_STATUS_COMPLETED\x10\x03\x12\x18\n" +
+	"\x14IMPORT_STATUS_FAILED\x10\x04*\xb8\x01\n" +
+	"\x10ImportFileStatus\x12\"\n" +
+	"\x1eIMPORT_FILE_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aIMPORT_FILE_STATUS_PENDING\x10\x01\x12\x1f\n" +
+	"\x1bIMPORT_FILE_STATUS_UPLOADED\x10\x02\x12 \n" +
+	"\x1cIMPORT_FILE_STATUS_COMPLETED\x10\x03\x12\x1d\n" +
+	"\x19IMPORT_FILE_STATUS_FAILED\x10\x04B?Z=github.com/fitglue/server/src/go/pkg/types/pb/models/pipelineb\x06proto3"
+
+var (
+	file_models_pipeline_import_proto_rawDescOnce sync.Once
+	file_models_pipeline_import_proto_rawDescData []byte
+)
+
+func file_models_pipeline_import_proto_rawDescGZIP() []byte {
+	file_models_pipeline_import_proto_rawDescOnce.Do(func() {
+		file_models_pipeline_import_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_models_pipeline_import_proto_rawDesc), len(file_models_pipeline_import_proto_rawDesc)))
+	})
+	return file_models_pipeline_import_proto_rawDescData
+}
+
+var file_models_pipeline_import_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_models_pipeline_import_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_models_pipeline_import_proto_goTypes = []any{
+	(ImportStatus)(0),             // 0: fitglue.models.pipeline.ImportStatus
+	(ImportFileStatus)(0),         // 1: fitglue.models.pipeline.ImportFileStatus
+	(*ImportSession)(nil),         // 2: fitglue.models.pipeline.ImportSession
+	(*ImportFile)(nil),            // 3: fitglue.models.pipeline.ImportFile
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_models_pipeline_import_proto_depIdxs = []int32{
+	0, // 0: fitglue.models.pipeline.ImportSession.status:type_name -> fitglue.models.pipeline.ImportStatus
+	3, // 1: fitglue.models.pipeline.ImportSession.files:type_name -> fitglue.models.pipeline.ImportFile
+	4, // 2: fitglue.models.pipeline.ImportSession.created_at:type_name -> google.protobuf.Timestamp
+	4, // 3: fitglue.models.pipeline.ImportSession.updated_at:type_name -> google.protobuf.Timestamp
+	4, // 4: fitglue.models.pipeline.ImportSession.completed_at:type_name -> google.protobuf.Timestamp
+	1, // 5: fitglue.models.pipeline.ImportFile.status:type_name -> fitglue.models.pipeline.ImportFileStatus
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_models_pipeline_import_proto_init() }
+func file_models_pipeline_import_proto_init() {
+	if File_models_pipeline_import_proto != nil {
+		return
+	}
+	file_models_pipeline_import_proto_msgTypes[0].OneofWrappers = []any{}
+	file_models_pipeline_import_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_pipeline_import_proto_rawDesc), len(file_models_pipeline_import_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_pipeline_import_proto_goTypes,
+		DependencyIndexes: file_models_pipeline_import_proto_depIdxs,
+		EnumInfos:         file_models_pipeline_import_proto_enumTypes,
+		MessageInfos:      file_models_pipeline_import_proto_msgTypes,
+	}.Build()
+	File_models_pipeline_import_proto = out.File
+	file_models_pipeline_import_proto_goTypes = nil
+	file_models_pipeline_import_proto_depIdxs = nil
+}
@@ -0,0 +1,614 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: models/pipeline/outage.proto
+
+package pipeline
+
+import (
+	events "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PlatformHealthState int32
+
+const (
+	PlatformHealthState_PLATFORM_HEALTH_STATE_UNSPECIFIED PlatformHealthState = 0
+	PlatformHealthState_PLATFORM_HEALTH_STATE_HEALTHY     PlatformHealthState = 1
+	PlatformHealthState_PLATFORM_HEALTH_STATE_OUTAGE      PlatformHealthState = 2
+)
+
+// Enum value maps for PlatformHealthState.
+var (
+	PlatformHealthState_name = map[int32]string{
+		0: "PLATFORM_HEALTH_STATE_UNSPECIFIED",
+		1: "PLATFORM_HEALTH_STATE_HEALTHY",
+		2: "PLATFORM_HEALTH_STATE_OUTAGE",
+	}
+	PlatformHealthState_value = map[string]int32{
+		"PLATFORM_HEALTH_STATE_UNSPECIFIED": 0,
+		"PLATFORM_HEALTH_STATE_HEALTHY":     1,
+		"PLATFORM_HEALTH_STATE_OUTAGE":      2,
+	}
+)
+
+func (x PlatformHealthState) Enum() *PlatformHealthState {
+	p := new(PlatformHealthState)
+	*p = x
+	return p
+}
+
+func (x PlatformHealthState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlatformHealthState) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_pipeline_outage_proto_enumTypes[0].Descriptor()
+}
+
+func (PlatformHealthState) Type() protoreflect.EnumType {
+	return &file_models_pipeline_outage_proto_enumTypes[0]
+}
+
+func (x PlatformHealthState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlatformHealthState.Descriptor instead.
+func (PlatformHealthState) EnumDescriptor() ([]byte, []int) {
+	return file_models_pipeline_outage_proto_rawDescGZIP(), []int{0}
+}
+
+// PlatformHealth is the shared circuit breaker state for an external
+// platform, stored at platform_health/{platform}. Sources and destinations
+// on the same platform (e.g. Strava) share one document.
+type PlatformHealth struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Platform            string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"` // Lowercase platform id, e.g. "strava"
+	State               PlatformHealthState    `protobuf:"varint,2,opt,name=state,proto3,enum=fitglue.models.pipeline.PlatformHealthState" json:"state,omitempty"`
+	ConsecutiveFailures int32                  `protobuf:"varint,3,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	LastError           *string                `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	OutageStartedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=outage_started_at,json=outageStartedAt,proto3" json:"outage_started_at,omitempty"`
+	LastCheckedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_checked_at,json=lastCheckedAt,proto3" json:"last_checked_at,omitempty"` // Last scheduled health check while in outage
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PlatformHealth) Reset() {
+	*x = PlatformHealth{}
+	mi := &file_models_pipeline_outage_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlatformHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformHealth) ProtoMessage() {}
+
+func (x *PlatformHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_outage_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformHealth.ProtoReflect.Descriptor instead.
+func (*PlatformHealth) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_outage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlatformHealth) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *PlatformHealth) GetState() PlatformHealthState {
+	if x != nil {
+		return x.State
+	}
+	return PlatformHealthState_PLATFORM_HEALTH_STATE_UNSPECIFIED
+}
+
+func (x *PlatformHealth) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *PlatformHealth) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *PlatformHealth) GetOutageStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OutageStartedAt
+	}
+	return nil
+}
+
+func (x *PlatformHealth) GetLastCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCheckedAt
+	}
+	return nil
+}
+
+func (x *PlatformHealth) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// QueuedPlatformWork is work deferred while its platform is in outage,
+// replayed once health checks recover instead of being retried into a
+// failure. Uploads and source events are kept in separate collections
+// (platform_outage_uploads, platform_outage_source_events) so each service
+// drains only its own work.
+type QueuedPlatformWork struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Platform string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	UserId   string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	QueuedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
+	Reason   *string                `protobuf:"bytes,5,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// Types that are valid to be assigned to Work:
+	//
+	//	*QueuedPlatformWork_Upload
+	//	*QueuedPlatformWork_SourceEvent
+	Work          isQueuedPlatformWork_Work `protobuf_oneof:"work"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueuedPlatformWork) Reset() {
+	*x = QueuedPlatformWork{}
+	mi := &file_models_pipeline_outage_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueuedPlatformWork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedPlatformWork) ProtoMessage() {}
+
+func (x *QueuedPlatformWork) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_outage_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedPlatformWork.ProtoReflect.Descriptor instead.
+func (*QueuedPlatformWork) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_outage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueuedPlatformWork) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QueuedPlatformWork) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *QueuedPlatformWork) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QueuedPlatformWork) GetQueuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QueuedAt
+	}
+	return nil
+}
+
+func (x *QueuedPlatformWork) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *QueuedPlatformWork) GetWork() isQueuedPlatformWork_Work {
+	if x != nil {
+		return x.Work
+	}
+	return nil
+}
+
+func (x *QueuedPlatformWork) GetUpload() *events.EnrichedActivityEvent {
+	if x != nil {
+		if x, ok := x.Work.(*QueuedPlatformWork_Upload); ok {
+			return x.Upload
+		}
+	}
+	return nil
+}
+
+func (x *QueuedPlatformWork) GetSourceEvent() *QueuedSourceEvent {
+	if x != nil {
+		if x, ok := x.Work.(*QueuedPlatformWork_SourceEvent); ok {
+			return x.SourceEvent
+		}
+	}
+	return nil
+}
+
+type isQueuedPlatformWork_Work interface {
+	isQueuedPlatformWork_Work()
+}
+
+type QueuedPlatformWork_Upload struct {
+	// Destination upload; replayed to topic-destination-upload with
+	// destinations narrowed to the platform's destination.
+	Upload *events.EnrichedActivityEvent `protobuf:"bytes,6,opt,name=upload,proto3,oneof"`
+}
+
+type QueuedPlatformWork_SourceEvent struct {
+	// Source fetch that failed before the activity reached a pipeline;
+	// replayed through the webhook processor.
+	SourceEvent *QueuedSourceEvent `protobuf:"bytes,7,opt,name=source_event,json=sourceEvent,proto3,oneof"`
+}
+
+func (*QueuedPlatformWork_Upload) isQueuedPlatformWork_Work() {}
+
+func (*QueuedPlatformWork_SourceEvent) isQueuedPlatformWork_Work() {}
+
+// QueuedSourceEvent captures enough of a webhook event to fetch the
+// activity again once the source recovers.
+type QueuedSourceEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProviderUserId string                 `protobuf:"bytes,1,opt,name=provider_user_id,json=providerUserId,proto3" json:"provider_user_id,omitempty"`
+	ActivityId     string                 `protobuf:"bytes,2,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Event          string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	RawPayload     []byte                 `protobuf:"bytes,4,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QueuedSourceEvent) Reset() {
+	*x = QueuedSourceEvent{}
+	mi := &file_models_pipeline_outage_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueuedSourceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedSourceEvent) ProtoMessage() {}
+
+func (x *QueuedSourceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_outage_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedSourceEvent.ProtoReflect.Descriptor instead.
+func (*QueuedSourceEvent) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_outage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueuedSourceEvent) GetProviderUserId() string {
+	if x != nil {
+		return x.ProviderUserId
+	}
+	return ""
+}
+
+func (x *QueuedSourceEvent) GetActivityId() string {
+	if x != nil {
+		return x.ActivityId
+	}
+	return ""
+}
+
+func (x *QueuedSourceEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *QueuedSourceEvent) GetRawPayload() []byte {
+	if x != nil {
+		return x.RawPayload
+	}
+	return nil
+}
+
+// ProviderRateBudget is the shared rate limit budget for an external API,
+// stored at provider_rate_budgets/{provider}. Windows are refreshed from the
+// provider's X-RateLimit headers and counted down locally between responses
+// so a burst across instances stops before the provider starts refusing.
+type ProviderRateBudget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Windows       []*RateLimitWindow     `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderRateBudget) Reset() {
+	*x = ProviderRateBudget{}
+	mi := &file_models_pipeline_outage_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderRateBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderRateBudget) ProtoMessage() {}
+
+func (x *ProviderRateBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_outage_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderRateBudget.ProtoReflect.Descriptor instead.
+func (*ProviderRateBudget) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_outage_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProviderRateBudget) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ProviderRateBudget) GetWindows() []*RateLimitWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *ProviderRateBudget) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// RateLimitWindow is one of a provider's limits, e.g. Strava's 15-minute and
+// daily request limits.
+type RateLimitWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Remaining     int32                  `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	ResetAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=reset_at,json=resetAt,proto3" json:"reset_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitWindow) Reset() {
+	*x = RateLimitWindow{}
+	mi := &file_models_pipeline_outage_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitWindow) ProtoMessage() {}
+
+func (x *RateLimitWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_outage_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitWindow.ProtoReflect.Descriptor instead.
+func (*RateLimitWindow) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_outage_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RateLimitWindow) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RateLimitWindow) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *RateLimitWindow) GetResetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResetAt
+	}
+	return nil
+}
+
+var File_models_pipeline_outage_proto protoreflect.FileDescriptor
+
+const file_models_pipeline_outage_proto_rawDesc = "" +
+	"\n" +
+	"\x1cmodels/pipeline/outage.proto\x12\x17fitglue.models.pipeline\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmodels/events/pipeline.proto\"\x9d\x03\n" +
+	"\x0ePlatformHealth\x12\x1a\n" +
+	"\bplatform\x18\x01 \x01(\tR\bplatform\x12B\n" +
+	"\x05state\x18\x02 \x01(\x0e2,.fitglue.models.pipeline.PlatformHealthStateR\x05state\x121\n" +
+	"\x14consecutive_failures\x18\x03 \x01(\x05R\x13consecutiveFailures\x12\"\n" +
+	"\n" +
+	"last_error\x18\x04 \x01(\tH\x00R\tlastError\x88\x01\x01\x12F\n" +
+	"\x11outage_started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0foutageStartedAt\x12B\n" +
+	"\x0flast_checked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rlastCheckedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\r\n" +
+	"\v_last_error\"\xdb\x02\n" +
+	"\x12QueuedPlatformWork\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x127\n" +
+	"\tqueued_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bqueuedAt\x12\x1b\n" +
+	"\x06reason\x18\x05 \x01(\tH\x01R\x06reason\x88\x01\x01\x12F\n" +
+	"\x06upload\x18\x06 \x01(\v2,.fitglue.models.events.EnrichedActivityEventH\x00R\x06upload\x12O\n" +
+	"\fsource_event\x18\a \x01(\v2*.fitglue.models.pipeline.QueuedSourceEventH\x00R\vsourceEventB\x06\n" +
+	"\x04workB\t\n" +
+	"\a_reason\"\x95\x01\n" +
+	"\x11QueuedSourceEvent\x12(\n" +
+	"\x10provider_user_id\x18\x01 \x01(\tR\x0eproviderUserId\x12\x1f\n" +
+	"\vactivity_id\x18\x02 \x01(\tR\n" +
+	"activityId\x12\x14\n" +
+	"\x05event\x18\x03 \x01(\tR\x05event\x12\x1f\n" +
+	"\vraw_payload\x18\x04 \x01(\fR\n" +
+	"rawPayload\"\xaf\x01\n" +
+	"\x12ProviderRateBudget\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12B\n" +
+	"\awindows\x18\x02 \x03(\v2(.fitglue.models.pipeline.RateLimitWindowR\awindows\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"|\n" +
+	"\x0fRateLimitWindow\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1c\n" +
+	"\tremaining\x18\x02 \x01(\x05R\tremaining\x125\n" +
+	"\breset_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aresetAt*\x81\x01\n" +
+	"\x13PlatformHealthState\x12%\n" +
+	"!PLATFORM_HEALTH_STATE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dPLATFORM_HEALTH_STATE_HEALTHY\x10\x01\x12 \n" +
+	"\x1cPLATFORM_HEALTH_STATE_OUTAGE\x10\x02B?Z=github.com/fitglue/server/src/go/pkg/types/pb/models/pipelineb\x06proto3"
+
+var (
+	file_models_pipeline_outage_proto_rawDescOnce sync.Once
+	file_models_pipeline_outage_proto_rawDescData []byte
+)
+
+func file_models_pipeline_outage_proto_rawDescGZIP() []byte {
+	file_models_pipeline_outage_proto_rawDescOnce.Do(func() {
+		file_models_pipeline_outage_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_models_pipeline_outage_proto_rawDesc), len(file_models_pipeline_outage_proto_rawDesc)))
+	})
+	return file_models_pipeline_outage_proto_rawDescData
+}
+
+var file_models_pipeline_outage_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_models_pipeline_outage_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_models_pipeline_outage_proto_goTypes = []any{
+	(PlatformHealthState)(0),             // 0: fitglue.models.pipeline.PlatformHealthState
+	(*PlatformHealth)(nil),               // 1: fitglue.models.pipeline.PlatformHealth
+	(*QueuedPlatformWork)(nil),           // 2: fitglue.models.pipeline.QueuedPlatformWork
+	(*QueuedSourceEvent)(nil),            // 3: fitglue.models.pipeline.QueuedSourceEvent
+	(*ProviderRateBudget)(nil),           // 4: fitglue.models.pipeline.ProviderRateBudget
+	(*RateLimitWindow)(nil),              // 5: fitglue.models.pipeline.RateLimitWindow
+	(*timestamppb.Timestamp)(nil),        // 6: google.protobuf.Timestamp
+	(*events.EnrichedActivityEvent)(nil), // 7: fitglue.models.events.EnrichedActivityEvent
+}
+var file_models_pipeline_outage_proto_depIdxs = []int32{
+	0,  // 0: fitglue.models.pipeline.PlatformHealth.state:type_name -> fitglue.models.pipeline.PlatformHealthState
+	6,  // 1: fitglue.models.pipeline.PlatformHealth.outage_started_at:type_name -> google.protobuf.Timestamp
+	6,  // 2: fitglue.models.pipeline.PlatformHealth.last_checked_at:type_name -> google.protobuf.Timestamp
+	6,  // 3: fitglue.models.pipeline.PlatformHealth.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 4: fitglue.models.pipeline.QueuedPlatformWork.queued_at:type_name -> google.protobuf.Timestamp
+	7,  // 5: fitglue.models.pipeline.QueuedPlatformWork.upload:type_name -> fitglue.models.events.EnrichedActivityEvent
+	3,  // 6: fitglue.models.pipeline.QueuedPlatformWork.source_event:type_name -> fitglue.models.pipeline.QueuedSourceEvent
+	5,  // 7: fitglue.models.pipeline.ProviderRateBudget.windows:type_name -> fitglue.models.pipeline.RateLimitWindow
+	6,  // 8: fitglue.models.pipeline.ProviderRateBudget.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 9: fitglue.models.pipeline.RateLimitWindow.reset_at:type_name -> google.protobuf.Timestamp
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_models_pipeline_outage_proto_init() }
+func file_models_pipeline_outage_proto_init() {
+	if File_models_pipeline_outage_proto != nil {
+		return
+	}
+	file_models_pipeline_outage_proto_msgTypes[0].OneofWrappers = []any{}
+	file_models_pipeline_outage_proto_msgTypes[1].OneofWrappers = []any{
+		(*QueuedPlatformWork_Upload)(nil),
+		(*QueuedPlatformWork_SourceEvent)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_pipeline_outage_proto_rawDesc), len(file_models_pipeline_outage_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_pipeline_outage_proto_goTypes,
+		DependencyIndexes: file_models_pipeline_outage_proto_depIdxs,
+		EnumInfos:         file_models_pipeline_outage_proto_enumTypes,
+		MessageInfos:      file_models_pipeline_outage_proto_msgTypes,
+	}.Build()
+	File_models_pipeline_outage_proto = out.File
+	file_models_pipeline_outage_proto_goTypes = nil
+	file_models_pipeline_outage_proto_depIdxs = nil
+}
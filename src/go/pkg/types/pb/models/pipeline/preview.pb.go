@@ -0,0 +1,511 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: models/pipeline/preview.proto
+
+package pipeline
+
+import (
+	activity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	plugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DescriptionDiffOp int32
+
+const (
+	DescriptionDiffOp_DESCRIPTION_DIFF_OP_UNSPECIFIED DescriptionDiffOp = 0
+	DescriptionDiffOp_DESCRIPTION_DIFF_OP_EQUAL       DescriptionDiffOp = 1
+	DescriptionDiffOp_DESCRIPTION_DIFF_OP_ADDED       DescriptionDiffOp = 2
+	DescriptionDiffOp_DESCRIPTION_DIFF_OP_REMOVED     DescriptionDiffOp = 3
+)
+
+// Enum value maps for DescriptionDiffOp.
+var (
+	DescriptionDiffOp_name = map[int32]string{
+		0: "DESCRIPTION_DIFF_OP_UNSPECIFIED",
+		1: "DESCRIPTION_DIFF_OP_EQUAL",
+		2: "DESCRIPTION_DIFF_OP_ADDED",
+		3: "DESCRIPTION_DIFF_OP_REMOVED",
+	}
+	DescriptionDiffOp_value = map[string]int32{
+		"DESCRIPTION_DIFF_OP_UNSPECIFIED": 0,
+		"DESCRIPTION_DIFF_OP_EQUAL":       1,
+		"DESCRIPTION_DIFF_OP_ADDED":       2,
+		"DESCRIPTION_DIFF_OP_REMOVED":     3,
+	}
+)
+
+func (x DescriptionDiffOp) Enum() *DescriptionDiffOp {
+	p := new(DescriptionDiffOp)
+	*p = x
+	return p
+}
+
+func (x DescriptionDiffOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DescriptionDiffOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_pipeline_preview_proto_enumTypes[0].Descriptor()
+}
+
+func (DescriptionDiffOp) Type() protoreflect.EnumType {
+	return &file_models_pipeline_preview_proto_enumTypes[0]
+}
+
+func (x DescriptionDiffOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DescriptionDiffOp.Descriptor instead.
+func (DescriptionDiffOp) EnumDescriptor() ([]byte, []int) {
+	return file_models_pipeline_preview_proto_rawDescGZIP(), []int{0}
+}
+
+// PipelinePreview is what a pipeline would do to an activity, from a dry run
+// of its enrichers: nothing is written to Firestore or storage, no FIT file is
+// generated and nothing is sent to destinations. Built on request; never
+// stored.
+type PipelinePreview struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SUCCESS, SKIPPED when the pipeline is disabled or a provider halted it
+	// (e.g. a filter), or WAITING when a provider needs user input.
+	Status             ExecutionStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=fitglue.models.pipeline.ExecutionStatus" json:"status,omitempty"`
+	Name               string                `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ActivityType       activity.ActivityType `protobuf:"varint,4,opt,name=activity_type,json=activityType,proto3,enum=fitglue.models.activity.ActivityType" json:"activity_type,omitempty"`
+	AppliedEnrichments []string              `protobuf:"bytes,5,rep,name=applied_enrichments,json=appliedEnrichments,proto3" json:"applied_enrichments,omitempty"`
+	EnrichmentMetadata map[string]string     `protobuf:"bytes,6,rep,name=enrichment_metadata,json=enrichmentMetadata,proto3" json:"enrichment_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Boosters           []*BoosterExecution   `protobuf:"bytes,7,rep,name=boosters,proto3" json:"boosters,omitempty"`
+	// One entry per distinct event the destinations would receive. Pipelines
+	// with per-destination enricher exclusions or templates have several.
+	Destinations  []*DestinationPreview `protobuf:"bytes,8,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PipelinePreview) Reset() {
+	*x = PipelinePreview{}
+	mi := &file_models_pipeline_preview_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PipelinePreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelinePreview) ProtoMessage() {}
+
+func (x *PipelinePreview) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_preview_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelinePreview.ProtoReflect.Descriptor instead.
+func (*PipelinePreview) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_preview_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PipelinePreview) GetStatus() ExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExecutionStatus_STATUS_UNSPECIFIED
+}
+
+func (x *PipelinePreview) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PipelinePreview) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PipelinePreview) GetActivityType() activity.ActivityType {
+	if x != nil {
+		return x.ActivityType
+	}
+	return activity.ActivityType(0)
+}
+
+func (x *PipelinePreview) GetAppliedEnrichments() []string {
+	if x != nil {
+		return x.AppliedEnrichments
+	}
+	return nil
+}
+
+func (x *PipelinePreview) GetEnrichmentMetadata() map[string]string {
+	if x != nil {
+		return x.EnrichmentMetadata
+	}
+	return nil
+}
+
+func (x *PipelinePreview) GetBoosters() []*BoosterExecution {
+	if x != nil {
+		return x.Boosters
+	}
+	return nil
+}
+
+func (x *PipelinePreview) GetDestinations() []*DestinationPreview {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+type DestinationPreview struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Destinations  []plugin.DestinationType `protobuf:"varint,1,rep,packed,name=destinations,proto3,enum=fitglue.models.plugin.DestinationType" json:"destinations,omitempty"`
+	Name          string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DestinationPreview) Reset() {
+	*x = DestinationPreview{}
+	mi := &file_models_pipeline_preview_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestinationPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestinationPreview) ProtoMessage() {}
+
+func (x *DestinationPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_preview_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestinationPreview.ProtoReflect.Descriptor instead.
+func (*DestinationPreview) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_preview_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DestinationPreview) GetDestinations() []plugin.DestinationType {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *DestinationPreview) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DestinationPreview) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// DescriptionMergePreview shows what updating an activity would do to the
+// description already on a destination. Same-source updates replace the
+// description outright, so any text the user wrote there since is lost
+// unless they confirm. Built on request; never stored.
+type DescriptionMergePreview struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Destination        plugin.DestinationType `protobuf:"varint,1,opt,name=destination,proto3,enum=fitglue.models.plugin.DestinationType" json:"destination,omitempty"`
+	ExternalId         string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	CurrentDescription string                 `protobuf:"bytes,3,opt,name=current_description,json=currentDescription,proto3" json:"current_description,omitempty"` // As it is on the destination now
+	MergedDescription  string                 `protobuf:"bytes,4,opt,name=merged_description,json=mergedDescription,proto3" json:"merged_description,omitempty"`    // What the update would write
+	Diff               []*DescriptionDiffLine `protobuf:"bytes,5,rep,name=diff,proto3" json:"diff,omitempty"`                                                       // current_description -> merged_description
+	Overwrite          bool                   `protobuf:"varint,6,opt,name=overwrite,proto3" json:"overwrite,omitempty"`                                            // Same-source: replaced rather than merged by section
+	DroppedUserLines   []string               `protobuf:"bytes,7,rep,name=dropped_user_lines,json=droppedUserLines,proto3" json:"dropped_user_lines,omitempty"`     // Lines the user wrote that the update would remove
+	// Set when dropped_user_lines is non-empty. Unconfirmed updates leave such
+	// descriptions as they are.
+	RequiresConfirmation bool `protobuf:"varint,8,opt,name=requires_confirmation,json=requiresConfirmation,proto3" json:"requires_confirmation,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DescriptionMergePreview) Reset() {
+	*x = DescriptionMergePreview{}
+	mi := &file_models_pipeline_preview_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescriptionMergePreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptionMergePreview) ProtoMessage() {}
+
+func (x *DescriptionMergePreview) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_preview_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptionMergePreview.ProtoReflect.Descriptor instead.
+func (*DescriptionMergePreview) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_preview_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DescriptionMergePreview) GetDestination() plugin.DestinationType {
+	if x != nil {
+		return x.Destination
+	}
+	return plugin.DestinationType(0)
+}
+
+func (x *DescriptionMergePreview) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *DescriptionMergePreview) GetCurrentDescription() string {
+	if x != nil {
+		return x.CurrentDescription
+	}
+	return ""
+}
+
+func (x *DescriptionMergePreview) GetMergedDescription() string {
+	if x != nil {
+		return x.MergedDescription
+	}
+	return ""
+}
+
+func (x *DescriptionMergePreview) GetDiff() []*DescriptionDiffLine {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *DescriptionMergePreview) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+func (x *DescriptionMergePreview) GetDroppedUserLines() []string {
+	if x != nil {
+		return x.DroppedUserLines
+	}
+	return nil
+}
+
+func (x *DescriptionMergePreview) GetRequiresConfirmation() bool {
+	if x != nil {
+		return x.RequiresConfirmation
+	}
+	return false
+}
+
+type DescriptionDiffLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            DescriptionDiffOp      `protobuf:"varint,1,opt,name=op,proto3,enum=fitglue.models.pipeline.DescriptionDiffOp" json:"op,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescriptionDiffLine) Reset() {
+	*x = DescriptionDiffLine{}
+	mi := &file_models_pipeline_preview_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescriptionDiffLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptionDiffLine) ProtoMessage() {}
+
+func (x *DescriptionDiffLine) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_preview_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptionDiffLine.ProtoReflect.Descriptor instead.
+func (*DescriptionDiffLine) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_preview_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DescriptionDiffLine) GetOp() DescriptionDiffOp {
+	if x != nil {
+		return x.Op
+	}
+	return DescriptionDiffOp_DESCRIPTION_DIFF_OP_UNSPECIFIED
+}
+
+func (x *DescriptionDiffLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+var File_models_pipeline_preview_proto protoreflect.FileDescriptor
+
+const file_models_pipeline_preview_proto_rawDesc = "" +
+	"\n" +
+	"\x1dmodels/pipeline/preview.proto\x12\x17fitglue.models.pipeline\x1a\x1cmodels/activity/source.proto\x1a\x1fmodels/pipeline/execution.proto\x1a\x1cmodels/plugin/provider.proto\"\xd8\x04\n" +
+	"\x0fPipelinePreview\x12@\n" +
+	"\x06status\x18\x01 \x01(\x0e2(.fitglue.models.pipeline.ExecutionStatusR\x06status\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12J\n" +
+	"\ractivity_type\x18\x04 \x01(\x0e2%.fitglue.models.activity.ActivityTypeR\factivityType\x12/\n" +
+	"\x13applied_enrichments\x18\x05 \x03(\tR\x12appliedEnrichments\x12q\n" +
+	"\x13enrichment_metadata\x18\x06 \x03(\v2@.fitglue.models.pipeline.PipelinePreview.EnrichmentMetadataEntryR\x12enrichmentMetadata\x12E\n" +
+	"\bboosters\x18\a \x03(\v2).fitglue.models.pipeline.BoosterExecutionR\bboosters\x12O\n" +
+	"\fdestinations\x18\b \x03(\v2+.fitglue.models.pipeline.DestinationPreviewR\fdestinations\x1aE\n" +
+	"\x17EnrichmentMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x96\x01\n" +
+	"\x12DestinationPreview\x12J\n" +
+	"\fdestinations\x18\x01 \x03(\x0e2&.fitglue.models.plugin.DestinationTypeR\fdestinations\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\xa7\x03\n" +
+	"\x17DescriptionMergePreview\x12H\n" +
+	"\vdestination\x18\x01 \x01(\x0e2&.fitglue.models.plugin.DestinationTypeR\vdestination\x12\x1f\n" +
+	"\vexternal_id\x18\x02 \x01(\tR\n" +
+	"externalId\x12/\n" +
+	"\x13current_description\x18\x03 \x01(\tR\x12currentDescription\x12-\n" +
+	"\x12merged_description\x18\x04 \x01(\tR\x11mergedDescription\x12@\n" +
+	"\x04diff\x18\x05 \x03(\v2,.fitglue.models.pipeline.DescriptionDiffLineR\x04diff\x12\x1c\n" +
+	"\toverwrite\x18\x06 \x01(\bR\toverwrite\x12,\n" +
+	"\x12dropped_user_lines\x18\a \x03(\tR\x10droppedUserLines\x123\n" +
+	"\x15requires_confirmation\x18\b \x01(\bR\x14requiresConfirmation\"e\n" +
+	"\x13DescriptionDiffLine\x12:\n" +
+	"\x02op\x18\x01 \x01(\x0e2*.fitglue.models.pipeline.DescriptionDiffOpR\x02op\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text*\x97\x01\n" +
+	"\x11DescriptionDiffOp\x12#\n" +
+	"\x1fDESCRIPTION_DIFF_OP_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19DESCRIPTION_DIFF_OP_EQUAL\x10\x01\x12\x1d\n" +
+	"\x19DESCRIPTION_DIFF_OP_ADDED\x10\x02\x12\x1f\n" +
+	"\x1bDESCRIPTION_DIFF_OP_REMOVED\x10\x03B?Z=github.com/fitglue/server/src/go/pkg/types/pb/models/pipelineb\x06proto3"
+
+var (
+	file_models_pipeline_preview_proto_rawDescOnce sync.Once
+	file_models_pipeline_preview_proto_rawDescData []byte
+)
+
+func file_models_pipeline_preview_proto_rawDescGZIP() []byte {
+	file_models_pipeline_preview_proto_rawDescOnce.Do(func() {
+		file_models_pipeline_preview_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_models_pipeline_preview_proto_rawDesc), len(file_models_pipeline_preview_proto_rawDesc)))
+	})
+	return file_models_pipeline_preview_proto_rawDescData
+}
+
+var file_models_pipeline_preview_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_models_pipeline_preview_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_models_pipeline_preview_proto_goTypes = []any{
+	(DescriptionDiffOp)(0),          // 0: fitglue.models.pipeline.DescriptionDiffOp
+	(*PipelinePreview)(nil),         // 1: fitglue.models.pipeline.PipelinePreview
+	(*DestinationPreview)(nil),      // 2: fitglue.models.pipeline.DestinationPreview
+	(*DescriptionMergePreview)(nil), // 3: fitglue.models.pipeline.DescriptionMergePreview
+	(*DescriptionDiffLine)(nil),     // 4: fitglue.models.pipeline.DescriptionDiffLine
+	nil,                             // 5: fitglue.models.pipeline.PipelinePreview.EnrichmentMetadataEntry
+	(ExecutionStatus)(0),            // 6: fitglue.models.pipeline.ExecutionStatus
+	(activity.ActivityType)(0),      // 7: fitglue.models.activity.ActivityType
+	(*BoosterExecution)(nil),        // 8: fitglue.models.pipeline.BoosterExecution
+	(plugin.DestinationType)(0),     // 9: fitglue.models.plugin.DestinationType
+}
+var file_models_pipeline_preview_proto_depIdxs = []int32{
+	6, // 0: fitglue.models.pipeline.PipelinePreview.status:type_name -> fitglue.models.pipeline.ExecutionStatus
+	7, // 1: fitglue.models.pipeline.PipelinePreview.activity_type:type_name -> fitglue.models.activity.ActivityType
+	5, // 2: fitglue.models.pipeline.PipelinePreview.enrichment_metadata:type_name -> fitglue.models.pipeline.PipelinePreview.EnrichmentMetadataEntry
+	8, // 3: fitglue.models.pipeline.PipelinePreview.boosters:type_name -> fitglue.models.pipeline.BoosterExecution
+	2, // 4: fitglue.models.pipeline.PipelinePreview.destinations:type_name -> fitglue.models.pipeline.DestinationPreview
+	9, // 5: fitglue.models.pipeline.DestinationPreview.destinations:type_name -> fitglue.models.plugin.DestinationType
+	9, // 6: fitglue.models.pipeline.DescriptionMergePreview.destination:type_name -> fitglue.models.plugin.DestinationType
+	4, // 7: fitglue.models.pipeline.DescriptionMergePreview.diff:type_name -> fitglue.models.pipeline.DescriptionDiffLine
+	0, // 8: fitglue.models.pipeline.DescriptionDiffLine.op:type_name -> fitglue.models.pipeline.DescriptionDiffOp
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_models_pipeline_preview_proto_init() }
+func file_models_pipeline_preview_proto_init() {
+	if File_models_pipeline_preview_proto != nil {
+		return
+	}
+	file_models_pipeline_execution_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_pipeline_preview_proto_rawDesc), len(file_models_pipeline_preview_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_pipeline_preview_proto_goTypes,
+		DependencyIndexes: file_models_pipeline_preview_proto_depIdxs,
+		EnumInfos:         file_models_pipeline_preview_proto_enumTypes,
+		MessageInfos:      file_models_pipeline_preview_proto_msgTypes,
+	}.Build()
+	File_models_pipeline_preview_proto = out.File
+	file_models_pipeline_preview_proto_goTypes = nil
+	file_models_pipeline_preview_proto_depIdxs = nil
+}
@@ -0,0 +1,472 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: models/pipeline/provider_circuit.proto
+
+package pipeline
+
+import (
+	plugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ProviderCircuitMode lets an admin override the automatic circuit breaker.
+type ProviderCircuitMode int32
+
+const (
+	ProviderCircuitMode_PROVIDER_CIRCUIT_MODE_AUTOMATIC ProviderCircuitMode = 0 // Opened and closed by failure counts
+	ProviderCircuitMode_PROVIDER_CIRCUIT_MODE_DISABLED  ProviderCircuitMode = 1 // Always skipped, e.g. during a known upstream outage
+	ProviderCircuitMode_PROVIDER_CIRCUIT_MODE_ENABLED   ProviderCircuitMode = 2 // Never skipped, whatever the failure count
+)
+
+// Enum value maps for ProviderCircuitMode.
+var (
+	ProviderCircuitMode_name = map[int32]string{
+		0: "PROVIDER_CIRCUIT_MODE_AUTOMATIC",
+		1: "PROVIDER_CIRCUIT_MODE_DISABLED",
+		2: "PROVIDER_CIRCUIT_MODE_ENABLED",
+	}
+	ProviderCircuitMode_value = map[string]int32{
+		"PROVIDER_CIRCUIT_MODE_AUTOMATIC": 0,
+		"PROVIDER_CIRCUIT_MODE_DISABLED":  1,
+		"PROVIDER_CIRCUIT_MODE_ENABLED":   2,
+	}
+)
+
+func (x ProviderCircuitMode) Enum() *ProviderCircuitMode {
+	p := new(ProviderCircuitMode)
+	*p = x
+	return p
+}
+
+func (x ProviderCircuitMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProviderCircuitMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_pipeline_provider_circuit_proto_enumTypes[0].Descriptor()
+}
+
+func (ProviderCircuitMode) Type() protoreflect.EnumType {
+	return &file_models_pipeline_provider_circuit_proto_enumTypes[0]
+}
+
+func (x ProviderCircuitMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProviderCircuitMode.Descriptor instead.
+func (ProviderCircuitMode) EnumDescriptor() ([]byte, []int) {
+	return file_models_pipeline_provider_circuit_proto_rawDescGZIP(), []int{0}
+}
+
+// ProviderCircuitEvent is what changed in a ProviderCircuitChange.
+type ProviderCircuitEvent int32
+
+const (
+	ProviderCircuitEvent_PROVIDER_CIRCUIT_EVENT_UNSPECIFIED  ProviderCircuitEvent = 0
+	ProviderCircuitEvent_PROVIDER_CIRCUIT_EVENT_OPENED       ProviderCircuitEvent = 1 // Failure threshold reached
+	ProviderCircuitEvent_PROVIDER_CIRCUIT_EVENT_CLOSED       ProviderCircuitEvent = 2 // First success after the circuit opened
+	ProviderCircuitEvent_PROVIDER_CIRCUIT_EVENT_MODE_CHANGED ProviderCircuitEvent = 3 // Admin override
+)
+
+// Enum value maps for ProviderCircuitEvent.
+var (
+	ProviderCircuitEvent_name = map[int32]string{
+		0: "PROVIDER_CIRCUIT_EVENT_UNSPECIFIED",
+		1: "PROVIDER_CIRCUIT_EVENT_OPENED",
+		2: "PROVIDER_CIRCUIT_EVENT_CLOSED",
+		3: "PROVIDER_CIRCUIT_EVENT_MODE_CHANGED",
+	}
+	ProviderCircuitEvent_value = map[string]int32{
+		"PROVIDER_CIRCUIT_EVENT_UNSPECIFIED":  0,
+		"PROVIDER_CIRCUIT_EVENT_OPENED":       1,
+		"PROVIDER_CIRCUIT_EVENT_CLOSED":       2,
+		"PROVIDER_CIRCUIT_EVENT_MODE_CHANGED": 3,
+	}
+)
+
+func (x ProviderCircuitEvent) Enum() *ProviderCircuitEvent {
+	p := new(ProviderCircuitEvent)
+	*p = x
+	return p
+}
+
+func (x ProviderCircuitEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProviderCircuitEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_pipeline_provider_circuit_proto_enumTypes[1].Descriptor()
+}
+
+func (ProviderCircuitEvent) Type() protoreflect.EnumType {
+	return &file_models_pipeline_provider_circuit_proto_enumTypes[1]
+}
+
+func (x ProviderCircuitEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProviderCircuitEvent.Descriptor instead.
+func (ProviderCircuitEvent) EnumDescriptor() ([]byte, []int) {
+	return file_models_pipeline_provider_circuit_proto_rawDescGZIP(), []int{1}
+}
+
+// ProviderCircuit is the shared circuit breaker state for an enricher
+// provider, stored at provider_circuits/{provider_type}. Failures are counted
+// across all users, so a flaky upstream API is skipped for everyone during
+// the cool-down instead of failing each pipeline that uses it.
+type ProviderCircuit struct {
+	state               protoimpl.MessageState      `protogen:"open.v1"`
+	ProviderType        plugin.EnricherProviderType `protobuf:"varint,1,opt,name=provider_type,json=providerType,proto3,enum=fitglue.models.plugin.EnricherProviderType" json:"provider_type,omitempty"`
+	ConsecutiveFailures int32                       `protobuf:"varint,2,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	LastError           *string                     `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	OpenedAt            *timestamppb.Timestamp      `protobuf:"bytes,4,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	OpenUntil           *timestamppb.Timestamp      `protobuf:"bytes,5,opt,name=open_until,json=openUntil,proto3" json:"open_until,omitempty"` // Skipped until then; unset while closed
+	UpdatedAt           *timestamppb.Timestamp      `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Mode                ProviderCircuitMode         `protobuf:"varint,7,opt,name=mode,proto3,enum=fitglue.models.pipeline.ProviderCircuitMode" json:"mode,omitempty"`
+	ModeReason          *string                     `protobuf:"bytes,8,opt,name=mode_reason,json=modeReason,proto3,oneof" json:"mode_reason,omitempty"` // Why an admin set the mode
+	ModeSetBy           *string                     `protobuf:"bytes,9,opt,name=mode_set_by,json=modeSetBy,proto3,oneof" json:"mode_set_by,omitempty"`  // Admin user ID
+	// SLA counters. Successes are flushed in batches per instance, so these
+	// are approximate; availability is 1 - total_failures / total_calls.
+	TotalCalls    int64 `protobuf:"varint,10,opt,name=total_calls,json=totalCalls,proto3" json:"total_calls,omitempty"`
+	TotalFailures int64 `protobuf:"varint,11,opt,name=total_failures,json=totalFailures,proto3" json:"total_failures,omitempty"`
+	TimesOpened   int32 `protobuf:"varint,12,opt,name=times_opened,json=timesOpened,proto3" json:"times_opened,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderCircuit) Reset() {
+	*x = ProviderCircuit{}
+	mi := &file_models_pipeline_provider_circuit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderCircuit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderCircuit) ProtoMessage() {}
+
+func (x *ProviderCircuit) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_provider_circuit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderCircuit.ProtoReflect.Descriptor instead.
+func (*ProviderCircuit) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_provider_circuit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProviderCircuit) GetProviderType() plugin.EnricherProviderType {
+	if x != nil {
+		return x.ProviderType
+	}
+	return plugin.EnricherProviderType(0)
+}
+
+func (x *ProviderCircuit) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *ProviderCircuit) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *ProviderCircuit) GetOpenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return nil
+}
+
+func (x *ProviderCircuit) GetOpenUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenUntil
+	}
+	return nil
+}
+
+func (x *ProviderCircuit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ProviderCircuit) GetMode() ProviderCircuitMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ProviderCircuitMode_PROVIDER_CIRCUIT_MODE_AUTOMATIC
+}
+
+func (x *ProviderCircuit) GetModeReason() string {
+	if x != nil && x.ModeReason != nil {
+		return *x.ModeReason
+	}
+	return ""
+}
+
+func (x *ProviderCircuit) GetModeSetBy() string {
+	if x != nil && x.ModeSetBy != nil {
+		return *x.ModeSetBy
+	}
+	return ""
+}
+
+func (x *ProviderCircuit) GetTotalCalls() int64 {
+	if x != nil {
+		return x.TotalCalls
+	}
+	return 0
+}
+
+func (x *ProviderCircuit) GetTotalFailures() int64 {
+	if x != nil {
+		return x.TotalFailures
+	}
+	return 0
+}
+
+func (x *ProviderCircuit) GetTimesOpened() int32 {
+	if x != nil {
+		return x.TimesOpened
+	}
+	return 0
+}
+
+// ProviderCircuitChange is one entry in a provider's circuit history, stored
+// at provider_circuits/{provider_type}/history/{id}.
+type ProviderCircuitChange struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Id            string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProviderType  plugin.EnricherProviderType `protobuf:"varint,2,opt,name=provider_type,json=providerType,proto3,enum=fitglue.models.plugin.EnricherProviderType" json:"provider_type,omitempty"`
+	Event         ProviderCircuitEvent        `protobuf:"varint,3,opt,name=event,proto3,enum=fitglue.models.pipeline.ProviderCircuitEvent" json:"event,omitempty"`
+	Mode          ProviderCircuitMode         `protobuf:"varint,4,opt,name=mode,proto3,enum=fitglue.models.pipeline.ProviderCircuitMode" json:"mode,omitempty"` // Mode after the change
+	Reason        *string                     `protobuf:"bytes,5,opt,name=reason,proto3,oneof" json:"reason,omitempty"`                                         // Last error when opened; admin's reason for mode changes
+	Actor         string                      `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`                                                 // "circuit_breaker" or the admin's user ID
+	CreatedAt     *timestamppb.Timestamp      `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderCircuitChange) Reset() {
+	*x = ProviderCircuitChange{}
+	mi := &file_models_pipeline_provider_circuit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderCircuitChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderCircuitChange) ProtoMessage() {}
+
+func (x *ProviderCircuitChange) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_provider_circuit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderCircuitChange.ProtoReflect.Descriptor instead.
+func (*ProviderCircuitChange) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_provider_circuit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProviderCircuitChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProviderCircuitChange) GetProviderType() plugin.EnricherProviderType {
+	if x != nil {
+		return x.ProviderType
+	}
+	return plugin.EnricherProviderType(0)
+}
+
+func (x *ProviderCircuitChange) GetEvent() ProviderCircuitEvent {
+	if x != nil {
+		return x.Event
+	}
+	return ProviderCircuitEvent_PROVIDER_CIRCUIT_EVENT_UNSPECIFIED
+}
+
+func (x *ProviderCircuitChange) GetMode() ProviderCircuitMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ProviderCircuitMode_PROVIDER_CIRCUIT_MODE_AUTOMATIC
+}
+
+func (x *ProviderCircuitChange) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ProviderCircuitChange) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ProviderCircuitChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_models_pipeline_provider_circuit_proto protoreflect.FileDescriptor
+
+const file_models_pipeline_provider_circuit_proto_rawDesc = "" +
+	"\n" +
+	"&models/pipeline/provider_circuit.proto\x12\x17fitglue.models.pipeline\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmodels/plugin/provider.proto\"\x90\x05\n" +
+	"\x0fProviderCircuit\x12P\n" +
+	"\rprovider_type\x18\x01 \x01(\x0e2+.fitglue.models.plugin.EnricherProviderTypeR\fproviderType\x121\n" +
+	"\x14consecutive_failures\x18\x02 \x01(\x05R\x13consecutiveFailures\x12\"\n" +
+	"\n" +
+	"last_error\x18\x03 \x01(\tH\x00R\tlastError\x88\x01\x01\x127\n" +
+	"\topened_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bopenedAt\x129\n" +
+	"\n" +
+	"open_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\topenUntil\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12@\n" +
+	"\x04mode\x18\a \x01(\x0e2,.fitglue.models.pipeline.ProviderCircuitModeR\x04mode\x12$\n" +
+	"\vmode_reason\x18\b \x01(\tH\x01R\n" +
+	"modeReason\x88\x01\x01\x12#\n" +
+	"\vmode_set_by\x18\t \x01(\tH\x02R\tmodeSetBy\x88\x01\x01\x12\x1f\n" +
+	"\vtotal_calls\x18\n" +
+	" \x01(\x03R\n" +
+	"totalCalls\x12%\n" +
+	"\x0etotal_failures\x18\v \x01(\x03R\rtotalFailures\x12!\n" +
+	"\ftimes_opened\x18\f \x01(\x05R\vtimesOpenedB\r\n" +
+	"\v_last_errorB\x0e\n" +
+	"\f_mode_reasonB\x0e\n" +
+	"\f_mode_set_by\"\xf9\x02\n" +
+	"\x15ProviderCircuitChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12P\n" +
+	"\rprovider_type\x18\x02 \x01(\x0e2+.fitglue.models.plugin.EnricherProviderTypeR\fproviderType\x12C\n" +
+	"\x05event\x18\x03 \x01(\x0e2-.fitglue.models.pipeline.ProviderCircuitEventR\x05event\x12@\n" +
+	"\x04mode\x18\x04 \x01(\x0e2,.fitglue.models.pipeline.ProviderCircuitModeR\x04mode\x12\x1b\n" +
+	"\x06reason\x18\x05 \x01(\tH\x00R\x06reason\x88\x01\x01\x12\x14\n" +
+	"\x05actor\x18\x06 \x01(\tR\x05actor\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\t\n" +
+	"\a_reason*\x81\x01\n" +
+	"\x13ProviderCircuitMode\x12#\n" +
+	"\x1fPROVIDER_CIRCUIT_MODE_AUTOMATIC\x10\x00\x12\"\n" +
+	"\x1ePROVIDER_CIRCUIT_MODE_DISABLED\x10\x01\x12!\n" +
+	"\x1dPROVIDER_CIRCUIT_MODE_ENABLED\x10\x02*\xad\x01\n" +
+	"\x14ProviderCircuitEvent\x12&\n" +
+	"\"PROVIDER_CIRCUIT_EVENT_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dPROVIDER_CIRCUIT_EVENT_OPENED\x10\x01\x12!\n" +
+	"\x1dPROVIDER_CIRCUIT_EVENT_CLOSED\x10\x02\x12'\n" +
+	"#PROVIDER_CIRCUIT_EVENT_MODE_CHANGED\x10\x03B?Z=github.com/fitglue/server/src/go/pkg/types/pb/models/pipelineb\x06proto3"
+
+var (
+	file_models_pipeline_provider_circuit_proto_rawDescOnce sync.Once
+	file_models_pipeline_provider_circuit_proto_rawDescData []byte
+)
+
+func file_models_pipeline_provider_circuit_proto_rawDescGZIP() []byte {
+	file_models_pipeline_provider_circuit_proto_rawDescOnce.Do(func() {
+		file_models_pipeline_provider_circuit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_models_pipeline_provider_circuit_proto_rawDesc), len(file_models_pipeline_provider_circuit_proto_rawDesc)))
+	})
+	return file_models_pipeline_provider_circuit_proto_rawDescData
+}
+
+var file_models_pipeline_provider_circuit_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_models_pipeline_provider_circuit_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_models_pipeline_provider_circuit_proto_goTypes = []any{
+	(ProviderCircuitMode)(0),         // 0: fitglue.models.pipeline.ProviderCircuitMode
+	(ProviderCircuitEvent)(0),        // 1: fitglue.models.pipeline.ProviderCircuitEvent
+	(*ProviderCircuit)(nil),          // 2: fitglue.models.pipeline.ProviderCircuit
+	(*ProviderCircuitChange)(nil),    // 3: fitglue.models.pipeline.ProviderCircuitChange
+	(plugin.EnricherProviderType)(0), // 4: fitglue.models.plugin.EnricherProviderType
+	(*timestamppb.Timestamp)(nil),    // 5: google.protobuf.Timestamp
+}
+var file_models_pipeline_provider_circuit_proto_depIdxs = []int32{
+	4, // 0: fitglue.models.pipeline.ProviderCircuit.provider_type:type_name -> fitglue.models.plugin.EnricherProviderType
+	5, // 1: fitglue.models.pipeline.ProviderCircuit.opened_at:type_name -> google.protobuf.Timestamp
+	5, // 2: fitglue.models.pipeline.ProviderCircuit.open_until:type_name -> google.protobuf.Timestamp
+	5, // 3: fitglue.models.pipeline.ProviderCircuit.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 4: fitglue.models.pipeline.ProviderCircuit.mode:type_name -> fitglue.models.pipeline.ProviderCircuitMode
+	4, // 5: fitglue.models.pipeline.ProviderCircuitChange.provider_type:type_name -> fitglue.models.plugin.EnricherProviderType
+	1, // 6: fitglue.models.pipeline.ProviderCircuitChange.event:type_name -> fitglue.models.pipeline.ProviderCircuitEvent
+	0, // 7: fitglue.models.pipeline.ProviderCircuitChange.mode:type_name -> fitglue.models.pipeline.ProviderCircuitMode
+	5, // 8: fitglue.models.pipeline.ProviderCircuitChange.created_at:type_name -> google.protobuf.Timestamp
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_models_pipeline_provider_circuit_proto_init() }
+func file_models_pipeline_provider_circuit_proto_init() {
+	if File_models_pipeline_provider_circuit_proto != nil {
+		return
+	}
+	file_models_pipeline_provider_circuit_proto_msgTypes[0].OneofWrappers = []any{}
+	file_models_pipeline_provider_circuit_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_pipeline_provider_circuit_proto_rawDesc), len(file_models_pipeline_provider_circuit_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_pipeline_provider_circuit_proto_goTypes,
+		DependencyIndexes: file_models_pipeline_provider_circuit_proto_depIdxs,
+		EnumInfos:         file_models_pipeline_provider_circuit_proto_enumTypes,
+		MessageInfos:      file_models_pipeline_provider_circuit_proto_msgTypes,
+	}.Build()
+	File_models_pipeline_provider_circuit_proto = out.File
+	file_models_pipeline_provider_circuit_proto_goTypes = nil
+	file_models_pipeline_provider_circuit_proto_depIdxs = nil
+}
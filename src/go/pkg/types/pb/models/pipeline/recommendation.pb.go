@@ -0,0 +1,225 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: models/pipeline/recommendation.proto
+
+package pipeline
+
+import (
+	plugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EnricherRecommendation suggests an enricher the user hasn't added to any
+// pipeline, based on the activities they log.
+type EnricherRecommendation struct {
+	state              protoimpl.MessageState      `protogen:"open.v1"`
+	ProviderType       plugin.EnricherProviderType `protobuf:"varint,1,opt,name=provider_type,json=providerType,proto3,enum=fitglue.models.plugin.EnricherProviderType" json:"provider_type,omitempty"`
+	Reason             string                      `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                                                    // e.g. "You log strength workouts — try the Muscle Heatmap booster"
+	MatchingActivities int32                       `protobuf:"varint,3,opt,name=matching_activities,json=matchingActivities,proto3" json:"matching_activities,omitempty"` // Recent activities the enricher would have applied to
+	Score              float64                     `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`                                                    // matching_activities as a share of activities_analyzed
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *EnricherRecommendation) Reset() {
+	*x = EnricherRecommendation{}
+	mi := &file_models_pipeline_recommendation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnricherRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnricherRecommendation) ProtoMessage() {}
+
+func (x *EnricherRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_recommendation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnricherRecommendation.ProtoReflect.Descriptor instead.
+func (*EnricherRecommendation) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_recommendation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EnricherRecommendation) GetProviderType() plugin.EnricherProviderType {
+	if x != nil {
+		return x.ProviderType
+	}
+	return plugin.EnricherProviderType(0)
+}
+
+func (x *EnricherRecommendation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EnricherRecommendation) GetMatchingActivities() int32 {
+	if x != nil {
+		return x.MatchingActivities
+	}
+	return 0
+}
+
+func (x *EnricherRecommendation) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// EnricherRecommendations is the set computed for one user, refreshed by a
+// scheduled job and stored at users/{user_id}/recommendations/enrichers.
+type EnricherRecommendations struct {
+	state              protoimpl.MessageState    `protogen:"open.v1"`
+	Recommendations    []*EnricherRecommendation `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	ActivitiesAnalyzed int32                     `protobuf:"varint,2,opt,name=activities_analyzed,json=activitiesAnalyzed,proto3" json:"activities_analyzed,omitempty"`
+	GeneratedAt        *timestamppb.Timestamp    `protobuf:"bytes,3,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *EnricherRecommendations) Reset() {
+	*x = EnricherRecommendations{}
+	mi := &file_models_pipeline_recommendation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnricherRecommendations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnricherRecommendations) ProtoMessage() {}
+
+func (x *EnricherRecommendations) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_recommendation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnricherRecommendations.ProtoReflect.Descriptor instead.
+func (*EnricherRecommendations) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_recommendation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnricherRecommendations) GetRecommendations() []*EnricherRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+func (x *EnricherRecommendations) GetActivitiesAnalyzed() int32 {
+	if x != nil {
+		return x.ActivitiesAnalyzed
+	}
+	return 0
+}
+
+func (x *EnricherRecommendations) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+var File_models_pipeline_recommendation_proto protoreflect.FileDescriptor
+
+const file_models_pipeline_recommendation_proto_rawDesc = "" +
+	"\n" +
+	"$models/pipeline/recommendation.proto\x12\x17fitglue.models.pipeline\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmodels/plugin/provider.proto\"\xc9\x01\n" +
+	"\x16EnricherRecommendation\x12P\n" +
+	"\rprovider_type\x18\x01 \x01(\x0e2+.fitglue.models.plugin.EnricherProviderTypeR\fproviderType\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12/\n" +
+	"\x13matching_activities\x18\x03 \x01(\x05R\x12matchingActivities\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\"\xe4\x01\n" +
+	"\x17EnricherRecommendations\x12Y\n" +
+	"\x0frecommendations\x18\x01 \x03(\v2/.fitglue.models.pipeline.EnricherRecommendationR\x0frecommendations\x12/\n" +
+	"\x13activities_analyzed\x18\x02 \x01(\x05R\x12activitiesAnalyzed\x12=\n" +
+	"\fgenerated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAtB?Z=github.com/fitglue/server/src/go/pkg/types/pb/models/pipelineb\x06proto3"
+
+var (
+	file_models_pipeline_recommendation_proto_rawDescOnce sync.Once
+	file_models_pipeline_recommendation_proto_rawDescData []byte
+)
+
+func file_models_pipeline_recommendation_proto_rawDescGZIP() []byte {
+	file_models_pipeline_recommendation_proto_rawDescOnce.Do(func() {
+		file_models_pipeline_recommendation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_models_pipeline_recommendation_proto_rawDesc), len(file_models_pipeline_recommendation_proto_rawDesc)))
+	})
+	return file_models_pipeline_recommendation_proto_rawDescData
+}
+
+var file_models_pipeline_recommendation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_models_pipeline_recommendation_proto_goTypes = []any{
+	(*EnricherRecommendation)(nil),   // 0: fitglue.models.pipeline.EnricherRecommendation
+	(*EnricherRecommendations)(nil),  // 1: fitglue.models.pipeline.EnricherRecommendations
+	(plugin.EnricherProviderType)(0), // 2: fitglue.models.plugin.EnricherProviderType
+	(*timestamppb.Timestamp)(nil),    // 3: google.protobuf.Timestamp
+}
+var file_models_pipeline_recommendation_proto_depIdxs = []int32{
+	2, // 0: fitglue.models.pipeline.EnricherRecommendation.provider_type:type_name -> fitglue.models.plugin.EnricherProviderType
+	0, // 1: fitglue.models.pipeline.EnricherRecommendations.recommendations:type_name -> fitglue.models.pipeline.EnricherRecommendation
+	3, // 2: fitglue.models.pipeline.EnricherRecommendations.generated_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_models_pipeline_recommendation_proto_init() }
+func file_models_pipeline_recommendation_proto_init() {
+	if File_models_pipeline_recommendation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_pipeline_recommendation_proto_rawDesc), len(file_models_pipeline_recommendation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_pipeline_recommendation_proto_goTypes,
+		DependencyIndexes: file_models_pipeline_recommendation_proto_depIdxs,
+		MessageInfos:      file_models_pipeline_recommendation_proto_msgTypes,
+	}.Build()
+	File_models_pipeline_recommendation_proto = out.File
+	file_models_pipeline_recommendation_proto_goTypes = nil
+	file_models_pipeline_recommendation_proto_depIdxs = nil
+}
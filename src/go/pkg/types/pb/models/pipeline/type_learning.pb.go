@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: models/pipeline/type_learning.proto
+
+package pipeline
+
+import (
+	activity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ActivityTypeRule is a per-user activity type correction learned from the
+// user changing an activity's type, stored at
+// users/{user_id}/activity_type_rules/{id}. The core type learner applies it
+// to new activities from the same source whose title contains title_pattern,
+// once the same correction has been made often enough.
+type ActivityTypeRule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                         // Derived from source + title_pattern
+	Source          string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`                                 // ActivitySource enum name, e.g. "SOURCE_HEVY"
+	TitlePattern    string                 `protobuf:"bytes,3,opt,name=title_pattern,json=titlePattern,proto3" json:"title_pattern,omitempty"` // Normalised title: lowercase, numbers and punctuation stripped
+	TargetType      activity.ActivityType  `protobuf:"varint,4,opt,name=target_type,json=targetType,proto3,enum=fitglue.models.activity.ActivityType" json:"target_type,omitempty"`
+	CorrectionCount int32                  `protobuf:"varint,5,opt,name=correction_count,json=correctionCount,proto3" json:"correction_count,omitempty"` // Corrections to target_type; reset when corrected to another type
+	Disabled        bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`                                      // Switched off by the user; keeps learning but is never applied
+	AppliedCount    int32                  `protobuf:"varint,7,opt,name=applied_count,json=appliedCount,proto3" json:"applied_count,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LastAppliedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_applied_at,json=lastAppliedAt,proto3" json:"last_applied_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ActivityTypeRule) Reset() {
+	*x = ActivityTypeRule{}
+	mi := &file_models_pipeline_type_learning_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityTypeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityTypeRule) ProtoMessage() {}
+
+func (x *ActivityTypeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_models_pipeline_type_learning_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityTypeRule.ProtoReflect.Descriptor instead.
+func (*ActivityTypeRule) Descriptor() ([]byte, []int) {
+	return file_models_pipeline_type_learning_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ActivityTypeRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ActivityTypeRule) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ActivityTypeRule) GetTitlePattern() string {
+	if x != nil {
+		return x.TitlePattern
+	}
+	return ""
+}
+
+func (x *ActivityTypeRule) GetTargetType() activity.ActivityType {
+	if x != nil {
+		return x.TargetType
+	}
+	return activity.ActivityType(0)
+}
+
+func (x *ActivityTypeRule) GetCorrectionCount() int32 {
+	if x != nil {
+		return x.CorrectionCount
+	}
+	return 0
+}
+
+func (x *ActivityTypeRule) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *ActivityTypeRule) GetAppliedCount() int32 {
+	if x != nil {
+		return x.AppliedCount
+	}
+	return 0
+}
+
+func (x *ActivityTypeRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ActivityTypeRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ActivityTypeRule) GetLastAppliedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAppliedAt
+	}
+	return nil
+}
+
+var File_models_pipeline_type_learning_proto protoreflect.FileDescriptor
+
+const file_models_pipeline_type_learning_proto_rawDesc = "" +
+	"\n" +
+	"#models/pipeline/type_learning.proto\x12\x17fitglue.models.pipeline\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmodels/activity/source.proto\"\xcd\x03\n" +
+	"\x10ActivityTypeRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12#\n" +
+	"\rtitle_pattern\x18\x03 \x01(\tR\ftitlePattern\x12F\n" +
+	"\vtarget_type\x18\x04 \x01(\x0e2%.fitglue.models.activity.ActivityTypeR\n" +
+	"targetType\x12)\n" +
+	"\x10correction_count\x18\x05 \x01(\x05R\x0fcorrectionCount\x12\x1a\n" +
+	"\bdisabled\x18\x06 \x01(\bR\bdisabled\x12#\n" +
+	"\rapplied_count\x18\a \x01(\x05R\fappliedCount\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0flast_applied_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rlastAppliedAtB?Z=github.com/fitglue/server/src/go/pkg/types/pb/models/pipelineb\x06proto3"
+
+var (
+	file_models_pipeline_type_learning_proto_rawDescOnce sync.Once
+	file_models_pipeline_type_learning_proto_rawDescData []byte
+)
+
+func file_models_pipeline_type_learning_proto_rawDescGZIP() []byte {
+	file_models_pipeline_type_learning_proto_rawDescOnce.Do(func() {
+		file_models_pipeline_type_learning_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_models_pipeline_type_learning_proto_rawDesc), len(file_models_pipeline_type_learning_proto_rawDesc)))
+	})
+	return file_models_pipeline_type_learning_proto_rawDescData
+}
+
+var file_models_pipeline_type_learning_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_models_pipeline_type_learning_proto_goTypes = []any{
+	(*ActivityTypeRule)(nil),      // 0: fitglue.models.pipeline.ActivityTypeRule
+	(activity.ActivityType)(0),    // 1: fitglue.models.activity.ActivityType
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_models_pipeline_type_learning_proto_depIdxs = []int32{
+	1, // 0: fitglue.models.pipeline.ActivityTypeRule.target_type:type_name -> fitglue.models.activity.ActivityType
+	2, // 1: fitglue.models.pipeline.ActivityTypeRule.created_at:type_name -> google.protobuf.Timestamp
+	2, // 2: fitglue.models.pipeline.ActivityTypeRule.updated_at:type_name -> google.protobuf.Timestamp
+	2, // 3: fitglue.models.pipeline.ActivityTypeRule.last_applied_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_models_pipeline_type_learning_proto_init() }
+func file_models_pipeline_type_learning_proto_init() {
+	if File_models_pipeline_type_learning_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_pipeline_type_learning_proto_rawDesc), len(file_models_pipeline_type_learning_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_models_pipeline_type_learning_proto_goTypes,
+		DependencyIndexes: file_models_pipeline_type_learning_proto_depIdxs,
+		MessageInfos:      file_models_pipeline_type_learning_proto_msgTypes,
+	}.Build()
+	File_models_pipeline_type_learning_proto = out.File
+	file_models_pipeline_type_learning_proto_goTypes = nil
+	file_models_pipeline_type_learning_proto_depIdxs = nil
+}
@@ -145,6 +145,7 @@ const (
 	IntegrationAuthType_INTEGRATION_AUTH_TYPE_API_KEY     IntegrationAuthType = 2
 	IntegrationAuthType_INTEGRATION_AUTH_TYPE_APP_SYNC    IntegrationAuthType = 3
 	IntegrationAuthType_INTEGRATION_AUTH_TYPE_PUBLIC_ID   IntegrationAuthType = 4
+	IntegrationAuthType_INTEGRATION_AUTH_TYPE_CREDENTIALS IntegrationAuthType = 5 // Username/password exchanged for tokens on connect
 )
 
 // Enum value maps for IntegrationAuthType.
@@ -155,6 +156,7 @@ var (
 		2: "INTEGRATION_AUTH_TYPE_API_KEY",
 		3: "INTEGRATION_AUTH_TYPE_APP_SYNC",
 		4: "INTEGRATION_AUTH_TYPE_PUBLIC_ID",
+		5: "INTEGRATION_AUTH_TYPE_CREDENTIALS",
 	}
 	IntegrationAuthType_value = map[string]int32{
 		"INTEGRATION_AUTH_TYPE_UNSPECIFIED": 0,
@@ -162,6 +164,7 @@ var (
 		"INTEGRATION_AUTH_TYPE_API_KEY":     2,
 		"INTEGRATION_AUTH_TYPE_APP_SYNC":    3,
 		"INTEGRATION_AUTH_TYPE_PUBLIC_ID":   4,
+		"INTEGRATION_AUTH_TYPE_CREDENTIALS": 5,
 	}
 )
 
@@ -1284,13 +1287,14 @@ const file_models_plugin_manifest_proto_rawDesc = "" +
 	"\x18CONFIG_FIELD_TYPE_SELECT\x10\x04\x12\"\n" +
 	"\x1eCONFIG_FIELD_TYPE_MULTI_SELECT\x10\x05\x12#\n" +
 	"\x1fCONFIG_FIELD_TYPE_KEY_VALUE_MAP\x10\x06\x12$\n" +
-	" CONFIG_FIELD_TYPE_DYNAMIC_SELECT\x10\a*\xc9\x01\n" +
+	" CONFIG_FIELD_TYPE_DYNAMIC_SELECT\x10\a*\xf0\x01\n" +
 	"\x13IntegrationAuthType\x12%\n" +
 	"!INTEGRATION_AUTH_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bINTEGRATION_AUTH_TYPE_OAUTH\x10\x01\x12!\n" +
 	"\x1dINTEGRATION_AUTH_TYPE_API_KEY\x10\x02\x12\"\n" +
 	"\x1eINTEGRATION_AUTH_TYPE_APP_SYNC\x10\x03\x12#\n" +
-	"\x1fINTEGRATION_AUTH_TYPE_PUBLIC_ID\x10\x04B=Z;github.com/fitglue/server/src/go/pkg/types/pb/models/pluginb\x06proto3"
+	"\x1fINTEGRATION_AUTH_TYPE_PUBLIC_ID\x10\x04\x12%\n" +
+	"!INTEGRATION_AUTH_TYPE_CREDENTIALS\x10\x05B=Z;github.com/fitglue/server/src/go/pkg/types/pb/models/pluginb\x06proto3"
 
 var (
 	file_models_plugin_manifest_proto_rawDescOnce sync.Once
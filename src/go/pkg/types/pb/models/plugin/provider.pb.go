@@ -33,6 +33,13 @@ const (
 	DestinationType_DESTINATION_INTERVALS     DestinationType = 5
 	DestinationType_DESTINATION_GOOGLESHEETS  DestinationType = 6
 	DestinationType_DESTINATION_GITHUB        DestinationType = 7
+	DestinationType_DESTINATION_KOMOOT        DestinationType = 8
+	DestinationType_DESTINATION_DROPBOX       DestinationType = 9
+	DestinationType_DESTINATION_WEBDAV        DestinationType = 10
+	DestinationType_DESTINATION_NOTION        DestinationType = 11
+	DestinationType_DESTINATION_DISCORD       DestinationType = 12
+	DestinationType_DESTINATION_SLACK         DestinationType = 13
+	DestinationType_DESTINATION_EMAIL         DestinationType = 14
 	DestinationType_DESTINATION_MOCK          DestinationType = 99
 )
 
@@ -47,6 +54,13 @@ var (
 		5:  "DESTINATION_INTERVALS",
 		6:  "DESTINATION_GOOGLESHEETS",
 		7:  "DESTINATION_GITHUB",
+		8:  "DESTINATION_KOMOOT",
+		9:  "DESTINATION_DROPBOX",
+		10: "DESTINATION_WEBDAV",
+		11: "DESTINATION_NOTION",
+		12: "DESTINATION_DISCORD",
+		13: "DESTINATION_SLACK",
+		14: "DESTINATION_EMAIL",
 		99: "DESTINATION_MOCK",
 	}
 	DestinationType_value = map[string]int32{
@@ -58,6 +72,13 @@ var (
 		"DESTINATION_INTERVALS":     5,
 		"DESTINATION_GOOGLESHEETS":  6,
 		"DESTINATION_GITHUB":        7,
+		"DESTINATION_KOMOOT":        8,
+		"DESTINATION_DROPBOX":       9,
+		"DESTINATION_WEBDAV":        10,
+		"DESTINATION_NOTION":        11,
+		"DESTINATION_DISCORD":       12,
+		"DESTINATION_SLACK":         13,
+		"DESTINATION_EMAIL":         14,
 		"DESTINATION_MOCK":          99,
 	}
 )
@@ -131,6 +152,20 @@ const (
 	EnricherProviderType_ENRICHER_PROVIDER_RECOVERY_ADVISOR     EnricherProviderType = 37
 	EnricherProviderType_ENRICHER_PROVIDER_EFFORT_SCORE         EnricherProviderType = 38
 	EnricherProviderType_ENRICHER_PROVIDER_INTERVALS            EnricherProviderType = 39
+	EnricherProviderType_ENRICHER_PROVIDER_TIMESTAMP_SANITY     EnricherProviderType = 40
+	EnricherProviderType_ENRICHER_PROVIDER_PACE_TARGET          EnricherProviderType = 41
+	EnricherProviderType_ENRICHER_PROVIDER_PHOTO_GEOTAG         EnricherProviderType = 42
+	EnricherProviderType_ENRICHER_PROVIDER_INTERVAL_DETECTION   EnricherProviderType = 43
+	EnricherProviderType_ENRICHER_PROVIDER_OURA_READINESS       EnricherProviderType = 44
+	EnricherProviderType_ENRICHER_PROVIDER_ENERGY_EXPENDITURE   EnricherProviderType = 45
+	EnricherProviderType_ENRICHER_PROVIDER_RUNNING_POWER        EnricherProviderType = 46
+	EnricherProviderType_ENRICHER_PROVIDER_GEAR_TRACKER         EnricherProviderType = 47
+	EnricherProviderType_ENRICHER_PROVIDER_CONSISTENCY          EnricherProviderType = 48
+	EnricherProviderType_ENRICHER_PROVIDER_GOAL_PROGRESS        EnricherProviderType = 49
+	EnricherProviderType_ENRICHER_PROVIDER_STRAVA_SEGMENTS      EnricherProviderType = 50
+	EnricherProviderType_ENRICHER_PROVIDER_DATA_QUALITY         EnricherProviderType = 51
+	EnricherProviderType_ENRICHER_PROVIDER_ANOMALY_CHECK        EnricherProviderType = 52
+	EnricherProviderType_ENRICHER_PROVIDER_ACTIVE_RECOVERY      EnricherProviderType = 53
 	EnricherProviderType_ENRICHER_PROVIDER_MOCK                 EnricherProviderType = 99
 )
 
@@ -176,6 +211,20 @@ var (
 		37: "ENRICHER_PROVIDER_RECOVERY_ADVISOR",
 		38: "ENRICHER_PROVIDER_EFFORT_SCORE",
 		39: "ENRICHER_PROVIDER_INTERVALS",
+		40: "ENRICHER_PROVIDER_TIMESTAMP_SANITY",
+		41: "ENRICHER_PROVIDER_PACE_TARGET",
+		42: "ENRICHER_PROVIDER_PHOTO_GEOTAG",
+		43: "ENRICHER_PROVIDER_INTERVAL_DETECTION",
+		44: "ENRICHER_PROVIDER_OURA_READINESS",
+		45: "ENRICHER_PROVIDER_ENERGY_EXPENDITURE",
+		46: "ENRICHER_PROVIDER_RUNNING_POWER",
+		47: "ENRICHER_PROVIDER_GEAR_TRACKER",
+		48: "ENRICHER_PROVIDER_CONSISTENCY",
+		49: "ENRICHER_PROVIDER_GOAL_PROGRESS",
+		50: "ENRICHER_PROVIDER_STRAVA_SEGMENTS",
+		51: "ENRICHER_PROVIDER_DATA_QUALITY",
+		52: "ENRICHER_PROVIDER_ANOMALY_CHECK",
+		53: "ENRICHER_PROVIDER_ACTIVE_RECOVERY",
 		99: "ENRICHER_PROVIDER_MOCK",
 	}
 	EnricherProviderType_value = map[string]int32{
@@ -218,6 +267,20 @@ var (
 		"ENRICHER_PROVIDER_RECOVERY_ADVISOR":     37,
 		"ENRICHER_PROVIDER_EFFORT_SCORE":         38,
 		"ENRICHER_PROVIDER_INTERVALS":            39,
+		"ENRICHER_PROVIDER_TIMESTAMP_SANITY":     40,
+		"ENRICHER_PROVIDER_PACE_TARGET":          41,
+		"ENRICHER_PROVIDER_PHOTO_GEOTAG":         42,
+		"ENRICHER_PROVIDER_INTERVAL_DETECTION":   43,
+		"ENRICHER_PROVIDER_OURA_READINESS":       44,
+		"ENRICHER_PROVIDER_ENERGY_EXPENDITURE":   45,
+		"ENRICHER_PROVIDER_RUNNING_POWER":        46,
+		"ENRICHER_PROVIDER_GEAR_TRACKER":         47,
+		"ENRICHER_PROVIDER_CONSISTENCY":          48,
+		"ENRICHER_PROVIDER_GOAL_PROGRESS":        49,
+		"ENRICHER_PROVIDER_STRAVA_SEGMENTS":      50,
+		"ENRICHER_PROVIDER_DATA_QUALITY":         51,
+		"ENRICHER_PROVIDER_ANOMALY_CHECK":        52,
+		"ENRICHER_PROVIDER_ACTIVE_RECOVERY":      53,
 		"ENRICHER_PROVIDER_MOCK":                 99,
 	}
 )
@@ -475,7 +538,7 @@ var File_models_plugin_provider_proto protoreflect.FileDescriptor
 
 const file_models_plugin_provider_proto_rawDesc = "" +
 	"\n" +
-	"\x1cmodels/plugin/provider.proto\x12\x15fitglue.models.plugin\x1a google/protobuf/descriptor.proto*\xec\x03\n" +
+	"\x1cmodels/plugin/provider.proto\x12\x15fitglue.models.plugin\x1a google/protobuf/descriptor.proto*\xe6\x06\n" +
 	"\x0fDestinationType\x12\x1b\n" +
 	"\x17DESTINATION_UNSPECIFIED\x10\x00\x124\n" +
 	"\x12DESTINATION_STRAVA\x10\x01\x1a\x1c\x92\xb5\x18\x18topic-destination-upload\x126\n" +
@@ -484,8 +547,16 @@ const file_models_plugin_provider_proto_rawDesc = "" +
 	"\x19DESTINATION_TRAININGPEAKS\x10\x04\x1a\x1c\x92\xb5\x18\x18topic-destination-upload\x127\n" +
 	"\x15DESTINATION_INTERVALS\x10\x05\x1a\x1c\x92\xb5\x18\x18topic-destination-upload\x12:\n" +
 	"\x18DESTINATION_GOOGLESHEETS\x10\x06\x1a\x1c\x92\xb5\x18\x18topic-destination-upload\x124\n" +
-	"\x12DESTINATION_GITHUB\x10\a\x1a\x1c\x92\xb5\x18\x18topic-destination-upload\x122\n" +
-	"\x10DESTINATION_MOCK\x10c\x1a\x1c\x92\xb5\x18\x18topic-destination-upload*\xf4\v\n" +
+	"\x12DESTINATION_GITHUB\x10\a\x1a\x1c\x92\xb5\x18\x18topic-destination-upload\x124\n" +
+	"\x12DESTINATION_KOMOOT\x10\b\x1a\x1c\x92\xb5\x18\x18topic-destination-upload\x125\n" +
+	"\x13DESTINATION_DROPBOX\x10\t\x1a\x1c\x92\xb5\x18\x18topic-destination-upload\x124\n" +
+	"\x12DESTINATION_WEBDAV\x10\n" +
+	"\x1a\x1c\x92\xb5\x18\x18topic-destination-upload\x124\n" +
+	"\x12DESTINATION_NOTION\x10\v\x1a\x1c\x92\xb5\x18\x18topic-destination-upload\x125\n" +
+	"\x13DESTINATION_DISCORD\x10\f\x1a\x1c\x92\xb5\x18\x18topic-destination-upload\x123\n" +
+	"\x11DESTINATION_SLACK\x10\r\x1a\x1c\x92\xb5\x18\x18topic-destination-upload\x123\n" +
+	"\x11DESTINATION_EMAIL\x10\x0e\x1a\x1c\x92\xb5\x18\x18topic-destination-upload\x122\n" +
+	"\x10DESTINATION_MOCK\x10c\x1a\x1c\x92\xb5\x18\x18topic-destination-upload*\x85\x10\n" +
 	"\x14EnricherProviderType\x12!\n" +
 	"\x1dENRICHER_PROVIDER_UNSPECIFIED\x10\x00\x12'\n" +
 	"#ENRICHER_PROVIDER_FITBIT_HEART_RATE\x10\x01\x12%\n" +
@@ -526,7 +597,21 @@ const file_models_plugin_provider_proto_rawDesc = "" +
 	"%ENRICHER_PROVIDER_DISTANCE_MILESTONES\x10$\x12&\n" +
 	"\"ENRICHER_PROVIDER_RECOVERY_ADVISOR\x10%\x12\"\n" +
 	"\x1eENRICHER_PROVIDER_EFFORT_SCORE\x10&\x12\x1f\n" +
-	"\x1bENRICHER_PROVIDER_INTERVALS\x10'\x12\x1a\n" +
+	"\x1bENRICHER_PROVIDER_INTERVALS\x10'\x12&\n" +
+	"\"ENRICHER_PROVIDER_TIMESTAMP_SANITY\x10(\x12!\n" +
+	"\x1dENRICHER_PROVIDER_PACE_TARGET\x10)\x12\"\n" +
+	"\x1eENRICHER_PROVIDER_PHOTO_GEOTAG\x10*\x12(\n" +
+	"$ENRICHER_PROVIDER_INTERVAL_DETECTION\x10+\x12$\n" +
+	" ENRICHER_PROVIDER_OURA_READINESS\x10,\x12(\n" +
+	"$ENRICHER_PROVIDER_ENERGY_EXPENDITURE\x10-\x12#\n" +
+	"\x1fENRICHER_PROVIDER_RUNNING_POWER\x10.\x12\"\n" +
+	"\x1eENRICHER_PROVIDER_GEAR_TRACKER\x10/\x12!\n" +
+	"\x1dENRICHER_PROVIDER_CONSISTENCY\x100\x12#\n" +
+	"\x1fENRICHER_PROVIDER_GOAL_PROGRESS\x101\x12%\n" +
+	"!ENRICHER_PROVIDER_STRAVA_SEGMENTS\x102\x12\"\n" +
+	"\x1eENRICHER_PROVIDER_DATA_QUALITY\x103\x12#\n" +
+	"\x1fENRICHER_PROVIDER_ANOMALY_CHECK\x104\x12%\n" +
+	"!ENRICHER_PROVIDER_ACTIVE_RECOVERY\x105\x12\x1a\n" +
 	"\x16ENRICHER_PROVIDER_MOCK\x10c*\xab\x01\n" +
 	"\x14WorkoutSummaryFormat\x12&\n" +
 	"\"WORKOUT_SUMMARY_FORMAT_UNSPECIFIED\x10\x00\x12\"\n" +
@@ -40,6 +40,11 @@ type UserIntegrations struct {
 	Github        *GitHubIntegration        `protobuf:"bytes,13,opt,name=github,proto3" json:"github,omitempty"`
 	AppleHealth   *AppleHealthIntegration   `protobuf:"bytes,14,opt,name=apple_health,json=appleHealth,proto3" json:"apple_health,omitempty"`
 	HealthConnect *HealthConnectIntegration `protobuf:"bytes,15,opt,name=health_connect,json=healthConnect,proto3" json:"health_connect,omitempty"`
+	Komoot        *KomootIntegration        `protobuf:"bytes,16,opt,name=komoot,proto3" json:"komoot,omitempty"`
+	Dropbox       *DropboxIntegration       `protobuf:"bytes,17,opt,name=dropbox,proto3" json:"dropbox,omitempty"`
+	Whoop         *WhoopIntegration         `protobuf:"bytes,18,opt,name=whoop,proto3" json:"whoop,omitempty"`
+	Zwift         *ZwiftIntegration         `protobuf:"bytes,19,opt,name=zwift,proto3" json:"zwift,omitempty"`
+	Notion        *NotionIntegration        `protobuf:"bytes,20,opt,name=notion,proto3" json:"notion,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -179,6 +184,41 @@ func (x *UserIntegrations) GetHealthConnect() *HealthConnectIntegration {
 	return nil
 }
 
+func (x *UserIntegrations) GetKomoot() *KomootIntegration {
+	if x != nil {
+		return x.Komoot
+	}
+	return nil
+}
+
+func (x *UserIntegrations) GetDropbox() *DropboxIntegration {
+	if x != nil {
+		return x.Dropbox
+	}
+	return nil
+}
+
+func (x *UserIntegrations) GetWhoop() *WhoopIntegration {
+	if x != nil {
+		return x.Whoop
+	}
+	return nil
+}
+
+func (x *UserIntegrations) GetZwift() *ZwiftIntegration {
+	if x != nil {
+		return x.Zwift
+	}
+	return nil
+}
+
+func (x *UserIntegrations) GetNotion() *NotionIntegration {
+	if x != nil {
+		return x.Notion
+	}
+	return nil
+}
+
 type MockIntegration struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
@@ -240,8 +280,10 @@ func (x *MockIntegration) GetLastUsedAt() *timestamppb.Timestamp {
 }
 
 type HevyIntegration struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Legacy: API keys now live in users/{uid}/integration_secrets and are read
+	// with GetIntegrationSecret. Only set for users not yet migrated.
 	ApiKey        string                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
 	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
@@ -416,6 +458,7 @@ type StravaIntegration struct {
 	AthleteId     int64                  `protobuf:"varint,5,opt,name=athlete_id,json=athleteId,proto3" json:"athlete_id,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	LastWebhookAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_webhook_at,json=lastWebhookAt,proto3" json:"last_webhook_at,omitempty"` // When a push event last arrived for this athlete
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -499,6 +542,13 @@ func (x *StravaIntegration) GetLastUsedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *StravaIntegration) GetLastWebhookAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastWebhookAt
+	}
+	return nil
+}
+
 type ParkrunIntegration struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
@@ -768,8 +818,9 @@ func (x *TrainingPeaksIntegration) GetLastUsedAt() *timestamppb.Timestamp {
 }
 
 type IntervalsIntegration struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Legacy: see HevyIntegration.api_key
 	ApiKey        string                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
 	AthleteId     string                 `protobuf:"bytes,3,opt,name=athlete_id,json=athleteId,proto3" json:"athlete_id,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
@@ -1439,11 +1490,459 @@ func (x *HealthConnectIntegration) GetLastUsedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+type KomootIntegration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	KomootUserId  string                 `protobuf:"bytes,5,opt,name=komoot_user_id,json=komootUserId,proto3" json:"komoot_user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KomootIntegration) Reset() {
+	*x = KomootIntegration{}
+	mi := &file_models_user_integration_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KomootIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KomootIntegration) ProtoMessage() {}
+
+func (x *KomootIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_models_user_integration_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KomootIntegration.ProtoReflect.Descriptor instead.
+func (*KomootIntegration) Descriptor() ([]byte, []int) {
+	return file_models_user_integration_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *KomootIntegration) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *KomootIntegration) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *KomootIntegration) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *KomootIntegration) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *KomootIntegration) GetKomootUserId() string {
+	if x != nil {
+		return x.KomootUserId
+	}
+	return ""
+}
+
+func (x *KomootIntegration) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *KomootIntegration) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+type DropboxIntegration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	DropboxUserId string                 `protobuf:"bytes,5,opt,name=dropbox_user_id,json=dropboxUserId,proto3" json:"dropbox_user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropboxIntegration) Reset() {
+	*x = DropboxIntegration{}
+	mi := &file_models_user_integration_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropboxIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropboxIntegration) ProtoMessage() {}
+
+func (x *DropboxIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_models_user_integration_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropboxIntegration.ProtoReflect.Descriptor instead.
+func (*DropboxIntegration) Descriptor() ([]byte, []int) {
+	return file_models_user_integration_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DropboxIntegration) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *DropboxIntegration) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *DropboxIntegration) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *DropboxIntegration) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *DropboxIntegration) GetDropboxUserId() string {
+	if x != nil {
+		return x.DropboxUserId
+	}
+	return ""
+}
+
+func (x *DropboxIntegration) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DropboxIntegration) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+type WhoopIntegration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	WhoopUserId   string                 `protobuf:"bytes,5,opt,name=whoop_user_id,json=whoopUserId,proto3" json:"whoop_user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WhoopIntegration) Reset() {
+	*x = WhoopIntegration{}
+	mi := &file_models_user_integration_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhoopIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhoopIntegration) ProtoMessage() {}
+
+func (x *WhoopIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_models_user_integration_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhoopIntegration.ProtoReflect.Descriptor instead.
+func (*WhoopIntegration) Descriptor() ([]byte, []int) {
+	return file_models_user_integration_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WhoopIntegration) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WhoopIntegration) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *WhoopIntegration) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *WhoopIntegration) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *WhoopIntegration) GetWhoopUserId() string {
+	if x != nil {
+		return x.WhoopUserId
+	}
+	return ""
+}
+
+func (x *WhoopIntegration) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WhoopIntegration) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+type ZwiftIntegration struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Enabled        bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AccessToken    string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken   string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ZwiftProfileId string                 `protobuf:"bytes,5,opt,name=zwift_profile_id,json=zwiftProfileId,proto3" json:"zwift_profile_id,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// Newest activity already imported; polling resumes after this ID
+	LastActivityId string `protobuf:"bytes,8,opt,name=last_activity_id,json=lastActivityId,proto3" json:"last_activity_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ZwiftIntegration) Reset() {
+	*x = ZwiftIntegration{}
+	mi := &file_models_user_integration_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZwiftIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZwiftIntegration) ProtoMessage() {}
+
+func (x *ZwiftIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_models_user_integration_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZwiftIntegration.ProtoReflect.Descriptor instead.
+func (*ZwiftIntegration) Descriptor() ([]byte, []int) {
+	return file_models_user_integration_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ZwiftIntegration) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ZwiftIntegration) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ZwiftIntegration) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *ZwiftIntegration) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ZwiftIntegration) GetZwiftProfileId() string {
+	if x != nil {
+		return x.ZwiftProfileId
+	}
+	return ""
+}
+
+func (x *ZwiftIntegration) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ZwiftIntegration) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *ZwiftIntegration) GetLastActivityId() string {
+	if x != nil {
+		return x.LastActivityId
+	}
+	return ""
+}
+
+type NotionIntegration struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Internal integration secret. Moved to integration_secrets when the
+	// integration is saved; see HevyIntegration.api_key.
+	ApiKey        string                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotionIntegration) Reset() {
+	*x = NotionIntegration{}
+	mi := &file_models_user_integration_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotionIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotionIntegration) ProtoMessage() {}
+
+func (x *NotionIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_models_user_integration_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotionIntegration.ProtoReflect.Descriptor instead.
+func (*NotionIntegration) Descriptor() ([]byte, []int) {
+	return file_models_user_integration_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *NotionIntegration) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *NotionIntegration) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *NotionIntegration) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NotionIntegration) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
 var File_models_user_integration_proto protoreflect.FileDescriptor
 
 const file_models_user_integration_proto_rawDesc = "" +
 	"\n" +
-	"\x1dmodels/user/integration.proto\x12\x13fitglue.models.user\x1a\x1fgoogle/protobuf/timestamp.proto\"\x84\b\n" +
+	"\x1dmodels/user/integration.proto\x12\x13fitglue.models.user\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc1\n" +
+	"\n" +
 	"\x10UserIntegrations\x128\n" +
 	"\x04hevy\x18\x01 \x01(\v2$.fitglue.models.user.HevyIntegrationR\x04hevy\x12>\n" +
 	"\x06fitbit\x18\x02 \x01(\v2&.fitglue.models.user.FitbitIntegrationR\x06fitbit\x12>\n" +
@@ -1460,7 +1959,12 @@ const file_models_user_integration_proto_rawDesc = "" +
 	"\x05wahoo\x18\f \x01(\v2%.fitglue.models.user.WahooIntegrationR\x05wahoo\x12>\n" +
 	"\x06github\x18\r \x01(\v2&.fitglue.models.user.GitHubIntegrationR\x06github\x12N\n" +
 	"\fapple_health\x18\x0e \x01(\v2+.fitglue.models.user.AppleHealthIntegrationR\vappleHealth\x12T\n" +
-	"\x0ehealth_connect\x18\x0f \x01(\v2-.fitglue.models.user.HealthConnectIntegrationR\rhealthConnect\"\xa4\x01\n" +
+	"\x0ehealth_connect\x18\x0f \x01(\v2-.fitglue.models.user.HealthConnectIntegrationR\rhealthConnect\x12>\n" +
+	"\x06komoot\x18\x10 \x01(\v2&.fitglue.models.user.KomootIntegrationR\x06komoot\x12A\n" +
+	"\adropbox\x18\x11 \x01(\v2'.fitglue.models.user.DropboxIntegrationR\adropbox\x12;\n" +
+	"\x05whoop\x18\x12 \x01(\v2%.fitglue.models.user.WhoopIntegrationR\x05whoop\x12;\n" +
+	"\x05zwift\x18\x13 \x01(\v2%.fitglue.models.user.ZwiftIntegrationR\x05zwift\x12>\n" +
+	"\x06notion\x18\x14 \x01(\v2&.fitglue.models.user.NotionIntegrationR\x06notion\"\xa4\x01\n" +
 	"\x0fMockIntegration\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x129\n" +
 	"\n" +
@@ -1485,7 +1989,7 @@ const file_models_user_integration_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
 	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"lastUsedAt\"\xc8\x02\n" +
+	"lastUsedAt\"\x8c\x03\n" +
 	"\x11StravaIntegration\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12!\n" +
 	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
@@ -1497,7 +2001,8 @@ const file_models_user_integration_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
 	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"lastUsedAt\"\x8c\x02\n" +
+	"lastUsedAt\x12B\n" +
+	"\x0flast_webhook_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rlastWebhookAt\"\x8c\x02\n" +
 	"\x12ParkrunIntegration\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1d\n" +
 	"\n" +
@@ -1610,6 +2115,58 @@ const file_models_user_integration_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
 	"\flast_used_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"\xcf\x02\n" +
+	"\x11KomootIntegration\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12$\n" +
+	"\x0ekomoot_user_id\x18\x05 \x01(\tR\fkomootUserId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"\xd2\x02\n" +
+	"\x12DropboxIntegration\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12&\n" +
+	"\x0fdropbox_user_id\x18\x05 \x01(\tR\rdropboxUserId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"\xcc\x02\n" +
+	"\x10WhoopIntegration\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\"\n" +
+	"\rwhoop_user_id\x18\x05 \x01(\tR\vwhoopUserId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"\xfc\x02\n" +
+	"\x10ZwiftIntegration\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12(\n" +
+	"\x10zwift_profile_id\x18\x05 \x01(\tR\x0ezwiftProfileId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x12(\n" +
+	"\x10last_activity_id\x18\b \x01(\tR\x0elastActivityId\"\xbf\x01\n" +
+	"\x11NotionIntegration\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x17\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"lastUsedAtB;Z9github.com/fitglue/server/src/go/pkg/types/pb/models/userb\x06proto3"
 
 var (
@@ -1624,7 +2181,7 @@ func file_models_user_integration_proto_rawDescGZIP() []byte {
 	return file_models_user_integration_proto_rawDescData
 }
 
-var file_models_user_integration_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_models_user_integration_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_models_user_integration_proto_goTypes = []any{
 	(*UserIntegrations)(nil),         // 0: fitglue.models.user.UserIntegrations
 	(*MockIntegration)(nil),          // 1: fitglue.models.user.MockIntegration
@@ -1642,7 +2199,12 @@ var file_models_user_integration_proto_goTypes = []any{
 	(*GitHubIntegration)(nil),        // 13: fitglue.models.user.GitHubIntegration
 	(*AppleHealthIntegration)(nil),   // 14: fitglue.models.user.AppleHealthIntegration
 	(*HealthConnectIntegration)(nil), // 15: fitglue.models.user.HealthConnectIntegration
-	(*timestamppb.Timestamp)(nil),    // 16: google.protobuf.Timestamp
+	(*KomootIntegration)(nil),        // 16: fitglue.models.user.KomootIntegration
+	(*DropboxIntegration)(nil),       // 17: fitglue.models.user.DropboxIntegration
+	(*WhoopIntegration)(nil),         // 18: fitglue.models.user.WhoopIntegration
+	(*ZwiftIntegration)(nil),         // 19: fitglue.models.user.ZwiftIntegration
+	(*NotionIntegration)(nil),        // 20: fitglue.models.user.NotionIntegration
+	(*timestamppb.Timestamp)(nil),    // 21: google.protobuf.Timestamp
 }
 var file_models_user_integration_proto_depIdxs = []int32{
 	2,  // 0: fitglue.models.user.UserIntegrations.hevy:type_name -> fitglue.models.user.HevyIntegration
@@ -1660,50 +2222,70 @@ var file_models_user_integration_proto_depIdxs = []int32{
 	13, // 12: fitglue.models.user.UserIntegrations.github:type_name -> fitglue.models.user.GitHubIntegration
 	14, // 13: fitglue.models.user.UserIntegrations.apple_health:type_name -> fitglue.models.user.AppleHealthIntegration
 	15, // 14: fitglue.models.user.UserIntegrations.health_connect:type_name -> fitglue.models.user.HealthConnectIntegration
-	16, // 15: fitglue.models.user.MockIntegration.created_at:type_name -> google.protobuf.Timestamp
-	16, // 16: fitglue.models.user.MockIntegration.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // 17: fitglue.models.user.HevyIntegration.created_at:type_name -> google.protobuf.Timestamp
-	16, // 18: fitglue.models.user.HevyIntegration.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // 19: fitglue.models.user.FitbitIntegration.expires_at:type_name -> google.protobuf.Timestamp
-	16, // 20: fitglue.models.user.FitbitIntegration.created_at:type_name -> google.protobuf.Timestamp
-	16, // 21: fitglue.models.user.FitbitIntegration.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // 22: fitglue.models.user.StravaIntegration.expires_at:type_name -> google.protobuf.Timestamp
-	16, // 23: fitglue.models.user.StravaIntegration.created_at:type_name -> google.protobuf.Timestamp
-	16, // 24: fitglue.models.user.StravaIntegration.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // 25: fitglue.models.user.ParkrunIntegration.created_at:type_name -> google.protobuf.Timestamp
-	16, // 26: fitglue.models.user.ParkrunIntegration.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // 27: fitglue.models.user.SpotifyIntegration.expires_at:type_name -> google.protobuf.Timestamp
-	16, // 28: fitglue.models.user.SpotifyIntegration.created_at:type_name -> google.protobuf.Timestamp
-	16, // 29: fitglue.models.user.SpotifyIntegration.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // 30: fitglue.models.user.TrainingPeaksIntegration.expires_at:type_name -> google.protobuf.Timestamp
-	16, // 31: fitglue.models.user.TrainingPeaksIntegration.created_at:type_name -> google.protobuf.Timestamp
-	16, // 32: fitglue.models.user.TrainingPeaksIntegration.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // 33: fitglue.models.user.IntervalsIntegration.created_at:type_name -> google.protobuf.Timestamp
-	16, // 34: fitglue.models.user.IntervalsIntegration.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // 35: fitglue.models.user.OuraIntegration.expires_at:type_name -> google.protobuf.Timestamp
-	16, // 36: fitglue.models.user.OuraIntegration.created_at:type_name -> google.protobuf.Timestamp
-	16, // 37: fitglue.models.user.OuraIntegration.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // 38: fitglue.models.user.GoogleIntegration.expires_at:type_name -> google.protobuf.Timestamp
-	16, // 39: fitglue.models.user.GoogleIntegration.created_at:type_name -> google.protobuf.Timestamp
-	16, // 40: fitglue.models.user.GoogleIntegration.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // 41: fitglue.models.user.PolarIntegration.expires_at:type_name -> google.protobuf.Timestamp
-	16, // 42: fitglue.models.user.PolarIntegration.created_at:type_name -> google.protobuf.Timestamp
-	16, // 43: fitglue.models.user.PolarIntegration.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // 44: fitglue.models.user.WahooIntegration.expires_at:type_name -> google.protobuf.Timestamp
-	16, // 45: fitglue.models.user.WahooIntegration.created_at:type_name -> google.protobuf.Timestamp
-	16, // 46: fitglue.models.user.WahooIntegration.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // 47: fitglue.models.user.GitHubIntegration.expires_at:type_name -> google.protobuf.Timestamp
-	16, // 48: fitglue.models.user.GitHubIntegration.created_at:type_name -> google.protobuf.Timestamp
-	16, // 49: fitglue.models.user.GitHubIntegration.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // 50: fitglue.models.user.AppleHealthIntegration.created_at:type_name -> google.protobuf.Timestamp
-	16, // 51: fitglue.models.user.AppleHealthIntegration.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // 52: fitglue.models.user.HealthConnectIntegration.created_at:type_name -> google.protobuf.Timestamp
-	16, // 53: fitglue.models.user.HealthConnectIntegration.last_used_at:type_name -> google.protobuf.Timestamp
-	54, // [54:54] is the sub-list for method output_type
-	54, // [54:54] is the sub-list for method input_type
-	54, // [54:54] is the sub-list for extension type_name
-	54, // [54:54] is the sub-list for extension extendee
-	0,  // [0:54] is the sub-list for field type_name
+	16, // 15: fitglue.models.user.UserIntegrations.komoot:type_name -> fitglue.models.user.KomootIntegration
+	17, // 16: fitglue.models.user.UserIntegrations.dropbox:type_name -> fitglue.models.user.DropboxIntegration
+	18, // 17: fitglue.models.user.UserIntegrations.whoop:type_name -> fitglue.models.user.WhoopIntegration
+	19, // 18: fitglue.models.user.UserIntegrations.zwift:type_name -> fitglue.models.user.ZwiftIntegration
+	20, // 19: fitglue.models.user.UserIntegrations.notion:type_name -> fitglue.models.user.NotionIntegration
+	21, // 20: fitglue.models.user.MockIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 21: fitglue.models.user.MockIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 22: fitglue.models.user.HevyIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 23: fitglue.models.user.HevyIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 24: fitglue.models.user.FitbitIntegration.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 25: fitglue.models.user.FitbitIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 26: fitglue.models.user.FitbitIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 27: fitglue.models.user.StravaIntegration.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 28: fitglue.models.user.StravaIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 29: fitglue.models.user.StravaIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 30: fitglue.models.user.StravaIntegration.last_webhook_at:type_name -> google.protobuf.Timestamp
+	21, // 31: fitglue.models.user.ParkrunIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 32: fitglue.models.user.ParkrunIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 33: fitglue.models.user.SpotifyIntegration.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 34: fitglue.models.user.SpotifyIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 35: fitglue.models.user.SpotifyIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 36: fitglue.models.user.TrainingPeaksIntegration.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 37: fitglue.models.user.TrainingPeaksIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 38: fitglue.models.user.TrainingPeaksIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 39: fitglue.models.user.IntervalsIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 40: fitglue.models.user.IntervalsIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 41: fitglue.models.user.OuraIntegration.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 42: fitglue.models.user.OuraIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 43: fitglue.models.user.OuraIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 44: fitglue.models.user.GoogleIntegration.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 45: fitglue.models.user.GoogleIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 46: fitglue.models.user.GoogleIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 47: fitglue.models.user.PolarIntegration.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 48: fitglue.models.user.PolarIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 49: fitglue.models.user.PolarIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 50: fitglue.models.user.WahooIntegration.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 51: fitglue.models.user.WahooIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 52: fitglue.models.user.WahooIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 53: fitglue.models.user.GitHubIntegration.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 54: fitglue.models.user.GitHubIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 55: fitglue.models.user.GitHubIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 56: fitglue.models.user.AppleHealthIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 57: fitglue.models.user.AppleHealthIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 58: fitglue.models.user.HealthConnectIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 59: fitglue.models.user.HealthConnectIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 60: fitglue.models.user.KomootIntegration.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 61: fitglue.models.user.KomootIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 62: fitglue.models.user.KomootIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 63: fitglue.models.user.DropboxIntegration.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 64: fitglue.models.user.DropboxIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 65: fitglue.models.user.DropboxIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 66: fitglue.models.user.WhoopIntegration.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 67: fitglue.models.user.WhoopIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 68: fitglue.models.user.WhoopIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 69: fitglue.models.user.ZwiftIntegration.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 70: fitglue.models.user.ZwiftIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 71: fitglue.models.user.ZwiftIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	21, // 72: fitglue.models.user.NotionIntegration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 73: fitglue.models.user.NotionIntegration.last_used_at:type_name -> google.protobuf.Timestamp
+	74, // [74:74] is the sub-list for method output_type
+	74, // [74:74] is the sub-list for method input_type
+	74, // [74:74] is the sub-list for extension type_name
+	74, // [74:74] is the sub-list for extension extendee
+	0,  // [0:74] is the sub-list for field type_name
 }
 
 func init() { file_models_user_integration_proto_init() }
@@ -1717,7 +2299,7 @@ func file_models_user_integration_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_user_integration_proto_rawDesc), len(file_models_user_integration_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -23,6 +23,57 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SourceDeletionPolicy int32
+
+const (
+	SourceDeletionPolicy_SOURCE_DELETION_POLICY_UNSPECIFIED SourceDeletionPolicy = 0 // Same as KEEP
+	// Only mark the pipeline run deleted at source.
+	SourceDeletionPolicy_SOURCE_DELETION_POLICY_KEEP SourceDeletionPolicy = 1
+	// Also delete or archive the activity at destinations that support it.
+	SourceDeletionPolicy_SOURCE_DELETION_POLICY_REMOVE SourceDeletionPolicy = 2
+)
+
+// Enum value maps for SourceDeletionPolicy.
+var (
+	SourceDeletionPolicy_name = map[int32]string{
+		0: "SOURCE_DELETION_POLICY_UNSPECIFIED",
+		1: "SOURCE_DELETION_POLICY_KEEP",
+		2: "SOURCE_DELETION_POLICY_REMOVE",
+	}
+	SourceDeletionPolicy_value = map[string]int32{
+		"SOURCE_DELETION_POLICY_UNSPECIFIED": 0,
+		"SOURCE_DELETION_POLICY_KEEP":        1,
+		"SOURCE_DELETION_POLICY_REMOVE":      2,
+	}
+)
+
+func (x SourceDeletionPolicy) Enum() *SourceDeletionPolicy {
+	p := new(SourceDeletionPolicy)
+	*p = x
+	return p
+}
+
+func (x SourceDeletionPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SourceDeletionPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_user_profile_proto_enumTypes[0].Descriptor()
+}
+
+func (SourceDeletionPolicy) Type() protoreflect.EnumType {
+	return &file_models_user_profile_proto_enumTypes[0]
+}
+
+func (x SourceDeletionPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SourceDeletionPolicy.Descriptor instead.
+func (SourceDeletionPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_models_user_profile_proto_rawDescGZIP(), []int{0}
+}
+
 type UserTier int32
 
 const (
@@ -56,11 +107,11 @@ func (x UserTier) String() string {
 }
 
 func (UserTier) Descriptor() protoreflect.EnumDescriptor {
-	return file_models_user_profile_proto_enumTypes[0].Descriptor()
+	return file_models_user_profile_proto_enumTypes[1].Descriptor()
 }
 
 func (UserTier) Type() protoreflect.EnumType {
-	return &file_models_user_profile_proto_enumTypes[0]
+	return &file_models_user_profile_proto_enumTypes[1]
 }
 
 func (x UserTier) Number() protoreflect.EnumNumber {
@@ -69,7 +120,108 @@ func (x UserTier) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserTier.Descriptor instead.
 func (UserTier) EnumDescriptor() ([]byte, []int) {
-	return file_models_user_profile_proto_rawDescGZIP(), []int{0}
+	return file_models_user_profile_proto_rawDescGZIP(), []int{1}
+}
+
+type GearType int32
+
+const (
+	GearType_GEAR_TYPE_UNSPECIFIED GearType = 0
+	GearType_GEAR_TYPE_SHOES       GearType = 1
+	GearType_GEAR_TYPE_BIKE        GearType = 2
+)
+
+// Enum value maps for GearType.
+var (
+	GearType_name = map[int32]string{
+		0: "GEAR_TYPE_UNSPECIFIED",
+		1: "GEAR_TYPE_SHOES",
+		2: "GEAR_TYPE_BIKE",
+	}
+	GearType_value = map[string]int32{
+		"GEAR_TYPE_UNSPECIFIED": 0,
+		"GEAR_TYPE_SHOES":       1,
+		"GEAR_TYPE_BIKE":        2,
+	}
+)
+
+func (x GearType) Enum() *GearType {
+	p := new(GearType)
+	*p = x
+	return p
+}
+
+func (x GearType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GearType) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_user_profile_proto_enumTypes[2].Descriptor()
+}
+
+func (GearType) Type() protoreflect.EnumType {
+	return &file_models_user_profile_proto_enumTypes[2]
+}
+
+func (x GearType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GearType.Descriptor instead.
+func (GearType) EnumDescriptor() ([]byte, []int) {
+	return file_models_user_profile_proto_rawDescGZIP(), []int{2}
+}
+
+type GoalMetric int32
+
+const (
+	GoalMetric_GOAL_METRIC_UNSPECIFIED GoalMetric = 0
+	GoalMetric_GOAL_METRIC_DISTANCE    GoalMetric = 1 // Meters
+	GoalMetric_GOAL_METRIC_ACTIVITIES  GoalMetric = 2 // Number of activities
+	GoalMetric_GOAL_METRIC_DURATION    GoalMetric = 3 // Seconds of elapsed time
+)
+
+// Enum value maps for GoalMetric.
+var (
+	GoalMetric_name = map[int32]string{
+		0: "GOAL_METRIC_UNSPECIFIED",
+		1: "GOAL_METRIC_DISTANCE",
+		2: "GOAL_METRIC_ACTIVITIES",
+		3: "GOAL_METRIC_DURATION",
+	}
+	GoalMetric_value = map[string]int32{
+		"GOAL_METRIC_UNSPECIFIED": 0,
+		"GOAL_METRIC_DISTANCE":    1,
+		"GOAL_METRIC_ACTIVITIES":  2,
+		"GOAL_METRIC_DURATION":    3,
+	}
+)
+
+func (x GoalMetric) Enum() *GoalMetric {
+	p := new(GoalMetric)
+	*p = x
+	return p
+}
+
+func (x GoalMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GoalMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_user_profile_proto_enumTypes[3].Descriptor()
+}
+
+func (GoalMetric) Type() protoreflect.EnumType {
+	return &file_models_user_profile_proto_enumTypes[3]
+}
+
+func (x GoalMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GoalMetric.Descriptor instead.
+func (GoalMetric) EnumDescriptor() ([]byte, []int) {
+	return file_models_user_profile_proto_rawDescGZIP(), []int{3}
 }
 
 // UserProfile represents the core user identity and preferences,
@@ -92,8 +244,21 @@ type UserProfile struct {
 	TrialEndsAt             *timestamppb.Timestamp   `protobuf:"bytes,11,opt,name=trial_ends_at,json=trialEndsAt,proto3" json:"trial_ends_at,omitempty"`
 	Email                   string                   `protobuf:"bytes,12,opt,name=email,proto3" json:"email,omitempty"`
 	DisplayName             string                   `protobuf:"bytes,13,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	// Heart rate thresholds in bpm, used by heart-rate-based enrichers when
+	// their own config doesn't set one.
+	MaxHeartRate              *int32 `protobuf:"varint,14,opt,name=max_heart_rate,json=maxHeartRate,proto3,oneof" json:"max_heart_rate,omitempty"`
+	LactateThresholdHeartRate *int32 `protobuf:"varint,15,opt,name=lactate_threshold_heart_rate,json=lactateThresholdHeartRate,proto3,oneof" json:"lactate_threshold_heart_rate,omitempty"`
+	// Vacation mode: activities arriving before this time are deferred for
+	// every pipeline until the user releases or discards them.
+	PipelinesPausedUntil *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=pipelines_paused_until,json=pipelinesPausedUntil,proto3" json:"pipelines_paused_until,omitempty"`
+	// How enricher section headers in activity descriptions are rendered.
+	DescriptionHeaders *DescriptionHeaderPreferences `protobuf:"bytes,17,opt,name=description_headers,json=descriptionHeaders,proto3" json:"description_headers,omitempty"`
+	// Which activity data AI enrichers may send to external model providers.
+	AiPrivacy *AIPrivacyPreferences `protobuf:"bytes,18,opt,name=ai_privacy,json=aiPrivacy,proto3" json:"ai_privacy,omitempty"`
+	// What happens to synced copies of activities deleted on their source.
+	SourceDeletionPolicy SourceDeletionPolicy `protobuf:"varint,19,opt,name=source_deletion_policy,json=sourceDeletionPolicy,proto3,enum=fitglue.models.user.SourceDeletionPolicy" json:"source_deletion_policy,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *UserProfile) Reset() {
@@ -217,18 +382,183 @@ func (x *UserProfile) GetDisplayName() string {
 	return ""
 }
 
+func (x *UserProfile) GetMaxHeartRate() int32 {
+	if x != nil && x.MaxHeartRate != nil {
+		return *x.MaxHeartRate
+	}
+	return 0
+}
+
+func (x *UserProfile) GetLactateThresholdHeartRate() int32 {
+	if x != nil && x.LactateThresholdHeartRate != nil {
+		return *x.LactateThresholdHeartRate
+	}
+	return 0
+}
+
+func (x *UserProfile) GetPipelinesPausedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PipelinesPausedUntil
+	}
+	return nil
+}
+
+func (x *UserProfile) GetDescriptionHeaders() *DescriptionHeaderPreferences {
+	if x != nil {
+		return x.DescriptionHeaders
+	}
+	return nil
+}
+
+func (x *UserProfile) GetAiPrivacy() *AIPrivacyPreferences {
+	if x != nil {
+		return x.AiPrivacy
+	}
+	return nil
+}
+
+func (x *UserProfile) GetSourceDeletionPolicy() SourceDeletionPolicy {
+	if x != nil {
+		return x.SourceDeletionPolicy
+	}
+	return SourceDeletionPolicy_SOURCE_DELETION_POLICY_UNSPECIFIED
+}
+
+// Opt-ins for sending personal activity data to external AI models. Each
+// class is scrubbed from the model's input unless its flag is set.
+type AIPrivacyPreferences struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Activity titles and the user's name and email.
+	ShareNames bool `protobuf:"varint,1,opt,name=share_names,json=shareNames,proto3" json:"share_names,omitempty"`
+	// Precise coordinates.
+	ShareLocations bool `protobuf:"varint,2,opt,name=share_locations,json=shareLocations,proto3" json:"share_locations,omitempty"`
+	// Activity notes and the user's own description text.
+	ShareNotes    bool `protobuf:"varint,3,opt,name=share_notes,json=shareNotes,proto3" json:"share_notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIPrivacyPreferences) Reset() {
+	*x = AIPrivacyPreferences{}
+	mi := &file_models_user_profile_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIPrivacyPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIPrivacyPreferences) ProtoMessage() {}
+
+func (x *AIPrivacyPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_models_user_profile_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIPrivacyPreferences.ProtoReflect.Descriptor instead.
+func (*AIPrivacyPreferences) Descriptor() ([]byte, []int) {
+	return file_models_user_profile_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AIPrivacyPreferences) GetShareNames() bool {
+	if x != nil {
+		return x.ShareNames
+	}
+	return false
+}
+
+func (x *AIPrivacyPreferences) GetShareLocations() bool {
+	if x != nil {
+		return x.ShareLocations
+	}
+	return false
+}
+
+func (x *AIPrivacyPreferences) GetShareNotes() bool {
+	if x != nil {
+		return x.ShareNotes
+	}
+	return false
+}
+
+// Overrides for the default section headers in pkg/description/headers.go.
+type DescriptionHeaderPreferences struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Render headers without their emoji, e.g. "Personal Records:".
+	HideEmoji bool `protobuf:"varint,1,opt,name=hide_emoji,json=hideEmoji,proto3" json:"hide_emoji,omitempty"`
+	// Replacement header text by section key (e.g. "personal_records": "PBs"),
+	// without the emoji or trailing colon.
+	CustomText    map[string]string `protobuf:"bytes,2,rep,name=custom_text,json=customText,proto3" json:"custom_text,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescriptionHeaderPreferences) Reset() {
+	*x = DescriptionHeaderPreferences{}
+	mi := &file_models_user_profile_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescriptionHeaderPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptionHeaderPreferences) ProtoMessage() {}
+
+func (x *DescriptionHeaderPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_models_user_profile_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptionHeaderPreferences.ProtoReflect.Descriptor instead.
+func (*DescriptionHeaderPreferences) Descriptor() ([]byte, []int) {
+	return file_models_user_profile_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DescriptionHeaderPreferences) GetHideEmoji() bool {
+	if x != nil {
+		return x.HideEmoji
+	}
+	return false
+}
+
+func (x *DescriptionHeaderPreferences) GetCustomText() map[string]string {
+	if x != nil {
+		return x.CustomText
+	}
+	return nil
+}
+
 type NotificationPreferences struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	NotifyPendingInput    bool                   `protobuf:"varint,1,opt,name=notify_pending_input,json=notifyPendingInput,proto3" json:"notify_pending_input,omitempty"`
 	NotifyPipelineSuccess bool                   `protobuf:"varint,2,opt,name=notify_pipeline_success,json=notifyPipelineSuccess,proto3" json:"notify_pipeline_success,omitempty"`
 	NotifyPipelineFailure bool                   `protobuf:"varint,3,opt,name=notify_pipeline_failure,json=notifyPipelineFailure,proto3" json:"notify_pipeline_failure,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// Weekly email digest of the week's activities, totals and PRs
+	EmailWeeklyDigest bool `protobuf:"varint,4,opt,name=email_weekly_digest,json=emailWeeklyDigest,proto3" json:"email_weekly_digest,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *NotificationPreferences) Reset() {
 	*x = NotificationPreferences{}
-	mi := &file_models_user_profile_proto_msgTypes[1]
+	mi := &file_models_user_profile_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -240,7 +570,7 @@ func (x *NotificationPreferences) String() string {
 func (*NotificationPreferences) ProtoMessage() {}
 
 func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
-	mi := &file_models_user_profile_proto_msgTypes[1]
+	mi := &file_models_user_profile_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,7 +583,7 @@ func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
 func (*NotificationPreferences) Descriptor() ([]byte, []int) {
-	return file_models_user_profile_proto_rawDescGZIP(), []int{1}
+	return file_models_user_profile_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *NotificationPreferences) GetNotifyPendingInput() bool {
@@ -277,6 +607,13 @@ func (x *NotificationPreferences) GetNotifyPipelineFailure() bool {
 	return false
 }
 
+func (x *NotificationPreferences) GetEmailWeeklyDigest() bool {
+	if x != nil {
+		return x.EmailWeeklyDigest
+	}
+	return false
+}
+
 type Counter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Key, e.g. "parkrun_bushy"
@@ -288,7 +625,7 @@ type Counter struct {
 
 func (x *Counter) Reset() {
 	*x = Counter{}
-	mi := &file_models_user_profile_proto_msgTypes[2]
+	mi := &file_models_user_profile_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -300,7 +637,7 @@ func (x *Counter) String() string {
 func (*Counter) ProtoMessage() {}
 
 func (x *Counter) ProtoReflect() protoreflect.Message {
-	mi := &file_models_user_profile_proto_msgTypes[2]
+	mi := &file_models_user_profile_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,7 +650,7 @@ func (x *Counter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Counter.ProtoReflect.Descriptor instead.
 func (*Counter) Descriptor() ([]byte, []int) {
-	return file_models_user_profile_proto_rawDescGZIP(), []int{2}
+	return file_models_user_profile_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Counter) GetId() string {
@@ -348,13 +685,17 @@ type PersonalRecord struct {
 	ActivityType  activity.ActivityType  `protobuf:"varint,6,opt,name=activity_type,json=activityType,proto3,enum=fitglue.models.activity.ActivityType" json:"activity_type,omitempty"`
 	PreviousValue *float64               `protobuf:"fixed64,7,opt,name=previous_value,json=previousValue,proto3,oneof" json:"previous_value,omitempty"` // Previous PR value
 	Improvement   *float64               `protobuf:"fixed64,8,opt,name=improvement,proto3,oneof" json:"improvement,omitempty"`                          // Percentage improvement
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Estimated 1RM records only: the formula used and the set it was estimated from.
+	EstimationFormula *string  `protobuf:"bytes,9,opt,name=estimation_formula,json=estimationFormula,proto3,oneof" json:"estimation_formula,omitempty"` // "epley", "brzycki", "lombardi"
+	SourceWeightKg    *float64 `protobuf:"fixed64,10,opt,name=source_weight_kg,json=sourceWeightKg,proto3,oneof" json:"source_weight_kg,omitempty"`
+	SourceReps        *int32   `protobuf:"varint,11,opt,name=source_reps,json=sourceReps,proto3,oneof" json:"source_reps,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *PersonalRecord) Reset() {
 	*x = PersonalRecord{}
-	mi := &file_models_user_profile_proto_msgTypes[3]
+	mi := &file_models_user_profile_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -366,7 +707,7 @@ func (x *PersonalRecord) String() string {
 func (*PersonalRecord) ProtoMessage() {}
 
 func (x *PersonalRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_models_user_profile_proto_msgTypes[3]
+	mi := &file_models_user_profile_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -379,7 +720,7 @@ func (x *PersonalRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PersonalRecord.ProtoReflect.Descriptor instead.
 func (*PersonalRecord) Descriptor() ([]byte, []int) {
-	return file_models_user_profile_proto_rawDescGZIP(), []int{3}
+	return file_models_user_profile_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PersonalRecord) GetRecordType() string {
@@ -438,11 +779,283 @@ func (x *PersonalRecord) GetImprovement() float64 {
 	return 0
 }
 
+func (x *PersonalRecord) GetEstimationFormula() string {
+	if x != nil && x.EstimationFormula != nil {
+		return *x.EstimationFormula
+	}
+	return ""
+}
+
+func (x *PersonalRecord) GetSourceWeightKg() float64 {
+	if x != nil && x.SourceWeightKg != nil {
+		return *x.SourceWeightKg
+	}
+	return 0
+}
+
+func (x *PersonalRecord) GetSourceReps() int32 {
+	if x != nil && x.SourceReps != nil {
+		return *x.SourceReps
+	}
+	return 0
+}
+
+// Gear is a pair of shoes or a bike whose mileage is tracked across activities
+type Gear struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // e.g. "Pegasus 40"
+	Type                  GearType               `protobuf:"varint,3,opt,name=type,proto3,enum=fitglue.models.user.GearType" json:"type,omitempty"`
+	DistanceMeters        float64                `protobuf:"fixed64,4,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`                        // Total, including the starting distance
+	InitialDistanceMeters float64                `protobuf:"fixed64,5,opt,name=initial_distance_meters,json=initialDistanceMeters,proto3" json:"initial_distance_meters,omitempty"` // Distance already on the gear when registered
+	ActivityCount         int32                  `protobuf:"varint,6,opt,name=activity_count,json=activityCount,proto3" json:"activity_count,omitempty"`
+	Retired               bool                   `protobuf:"varint,7,opt,name=retired,proto3" json:"retired,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	LastActivityId        string                 `protobuf:"bytes,10,opt,name=last_activity_id,json=lastActivityId,proto3" json:"last_activity_id,omitempty"` // Guards against counting a re-run activity twice
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Gear) Reset() {
+	*x = Gear{}
+	mi := &file_models_user_profile_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Gear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gear) ProtoMessage() {}
+
+func (x *Gear) ProtoReflect() protoreflect.Message {
+	mi := &file_models_user_profile_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gear.ProtoReflect.Descriptor instead.
+func (*Gear) Descriptor() ([]byte, []int) {
+	return file_models_user_profile_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Gear) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Gear) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Gear) GetType() GearType {
+	if x != nil {
+		return x.Type
+	}
+	return GearType_GEAR_TYPE_UNSPECIFIED
+}
+
+func (x *Gear) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+func (x *Gear) GetInitialDistanceMeters() float64 {
+	if x != nil {
+		return x.InitialDistanceMeters
+	}
+	return 0
+}
+
+func (x *Gear) GetActivityCount() int32 {
+	if x != nil {
+		return x.ActivityCount
+	}
+	return 0
+}
+
+func (x *Gear) GetRetired() bool {
+	if x != nil {
+		return x.Retired
+	}
+	return false
+}
+
+func (x *Gear) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Gear) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *Gear) GetLastActivityId() string {
+	if x != nil {
+		return x.LastActivityId
+	}
+	return ""
+}
+
+// Goal is a target the user works towards over a period, e.g. "run 1000 km
+// in 2025" or "100 workouts"
+type Goal struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // e.g. "1000 km in 2025"
+	Metric         GoalMetric             `protobuf:"varint,3,opt,name=metric,proto3,enum=fitglue.models.user.GoalMetric" json:"metric,omitempty"`
+	Target         float64                `protobuf:"fixed64,4,opt,name=target,proto3" json:"target,omitempty"`                                                                          // In the metric's unit
+	ActivityType   activity.ActivityType  `protobuf:"varint,5,opt,name=activity_type,json=activityType,proto3,enum=fitglue.models.activity.ActivityType" json:"activity_type,omitempty"` // UNSPECIFIED counts every activity
+	StartDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                                                     // Unset means no start bound
+	EndDate        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                                                           // Exclusive; unset means open-ended
+	Progress       float64                `protobuf:"fixed64,8,opt,name=progress,proto3" json:"progress,omitempty"`                                                                      // In the metric's unit
+	ActivityCount  int32                  `protobuf:"varint,9,opt,name=activity_count,json=activityCount,proto3" json:"activity_count,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	LastActivityId string                 `protobuf:"bytes,12,opt,name=last_activity_id,json=lastActivityId,proto3" json:"last_activity_id,omitempty"` // Guards against counting a re-run activity twice
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Goal) Reset() {
+	*x = Goal{}
+	mi := &file_models_user_profile_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Goal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Goal) ProtoMessage() {}
+
+func (x *Goal) ProtoReflect() protoreflect.Message {
+	mi := &file_models_user_profile_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Goal.ProtoReflect.Descriptor instead.
+func (*Goal) Descriptor() ([]byte, []int) {
+	return file_models_user_profile_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Goal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Goal) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Goal) GetMetric() GoalMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return GoalMetric_GOAL_METRIC_UNSPECIFIED
+}
+
+func (x *Goal) GetTarget() float64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *Goal) GetActivityType() activity.ActivityType {
+	if x != nil {
+		return x.ActivityType
+	}
+	return activity.ActivityType(0)
+}
+
+func (x *Goal) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Goal) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *Goal) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Goal) GetActivityCount() int32 {
+	if x != nil {
+		return x.ActivityCount
+	}
+	return 0
+}
+
+func (x *Goal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Goal) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Goal) GetLastActivityId() string {
+	if x != nil {
+		return x.LastActivityId
+	}
+	return ""
+}
+
 var File_models_user_profile_proto protoreflect.FileDescriptor
 
 const file_models_user_profile_proto_rawDesc = "" +
 	"\n" +
-	"\x19models/user/profile.proto\x12\x13fitglue.models.user\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmodels/activity/source.proto\"\x87\x05\n" +
+	"\x19models/user/profile.proto\x12\x13fitglue.models.user\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cmodels/activity/source.proto\"\x8d\t\n" +
 	"\vUserProfile\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
 	"\n" +
@@ -459,15 +1072,39 @@ const file_models_user_profile_proto_rawDesc = "" +
 	" \x01(\v2,.fitglue.models.user.NotificationPreferencesR\x17notificationPreferences\x12>\n" +
 	"\rtrial_ends_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vtrialEndsAt\x12\x14\n" +
 	"\x05email\x18\f \x01(\tR\x05email\x12!\n" +
-	"\fdisplay_name\x18\r \x01(\tR\vdisplayName\"\xbb\x01\n" +
+	"\fdisplay_name\x18\r \x01(\tR\vdisplayName\x12)\n" +
+	"\x0emax_heart_rate\x18\x0e \x01(\x05H\x00R\fmaxHeartRate\x88\x01\x01\x12D\n" +
+	"\x1clactate_threshold_heart_rate\x18\x0f \x01(\x05H\x01R\x19lactateThresholdHeartRate\x88\x01\x01\x12P\n" +
+	"\x16pipelines_paused_until\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x14pipelinesPausedUntil\x12b\n" +
+	"\x13description_headers\x18\x11 \x01(\v21.fitglue.models.user.DescriptionHeaderPreferencesR\x12descriptionHeaders\x12H\n" +
+	"\n" +
+	"ai_privacy\x18\x12 \x01(\v2).fitglue.models.user.AIPrivacyPreferencesR\taiPrivacy\x12_\n" +
+	"\x16source_deletion_policy\x18\x13 \x01(\x0e2).fitglue.models.user.SourceDeletionPolicyR\x14sourceDeletionPolicyB\x11\n" +
+	"\x0f_max_heart_rateB\x1f\n" +
+	"\x1d_lactate_threshold_heart_rate\"\x81\x01\n" +
+	"\x14AIPrivacyPreferences\x12\x1f\n" +
+	"\vshare_names\x18\x01 \x01(\bR\n" +
+	"shareNames\x12'\n" +
+	"\x0fshare_locations\x18\x02 \x01(\bR\x0eshareLocations\x12\x1f\n" +
+	"\vshare_notes\x18\x03 \x01(\bR\n" +
+	"shareNotes\"\xe0\x01\n" +
+	"\x1cDescriptionHeaderPreferences\x12\x1d\n" +
+	"\n" +
+	"hide_emoji\x18\x01 \x01(\bR\thideEmoji\x12b\n" +
+	"\vcustom_text\x18\x02 \x03(\v2A.fitglue.models.user.DescriptionHeaderPreferences.CustomTextEntryR\n" +
+	"customText\x1a=\n" +
+	"\x0fCustomTextEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xeb\x01\n" +
 	"\x17NotificationPreferences\x120\n" +
 	"\x14notify_pending_input\x18\x01 \x01(\bR\x12notifyPendingInput\x126\n" +
 	"\x17notify_pipeline_success\x18\x02 \x01(\bR\x15notifyPipelineSuccess\x126\n" +
-	"\x17notify_pipeline_failure\x18\x03 \x01(\bR\x15notifyPipelineFailure\"n\n" +
+	"\x17notify_pipeline_failure\x18\x03 \x01(\bR\x15notifyPipelineFailure\x12.\n" +
+	"\x13email_weekly_digest\x18\x04 \x01(\bR\x11emailWeeklyDigest\"n\n" +
 	"\aCounter\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\x03R\x05count\x12=\n" +
-	"\flast_updated\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\"\xfb\x02\n" +
+	"\flast_updated\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\"\xc0\x04\n" +
 	"\x0ePersonalRecord\x12\x1f\n" +
 	"\vrecord_type\x18\x01 \x01(\tR\n" +
 	"recordType\x12\x14\n" +
@@ -479,13 +1116,65 @@ const file_models_user_profile_proto_rawDesc = "" +
 	"achievedAt\x12J\n" +
 	"\ractivity_type\x18\x06 \x01(\x0e2%.fitglue.models.activity.ActivityTypeR\factivityType\x12*\n" +
 	"\x0eprevious_value\x18\a \x01(\x01H\x00R\rpreviousValue\x88\x01\x01\x12%\n" +
-	"\vimprovement\x18\b \x01(\x01H\x01R\vimprovement\x88\x01\x01B\x11\n" +
+	"\vimprovement\x18\b \x01(\x01H\x01R\vimprovement\x88\x01\x01\x122\n" +
+	"\x12estimation_formula\x18\t \x01(\tH\x02R\x11estimationFormula\x88\x01\x01\x12-\n" +
+	"\x10source_weight_kg\x18\n" +
+	" \x01(\x01H\x03R\x0esourceWeightKg\x88\x01\x01\x12$\n" +
+	"\vsource_reps\x18\v \x01(\x05H\x04R\n" +
+	"sourceReps\x88\x01\x01B\x11\n" +
 	"\x0f_previous_valueB\x0e\n" +
-	"\f_improvement*T\n" +
+	"\f_improvementB\x15\n" +
+	"\x13_estimation_formulaB\x13\n" +
+	"\x11_source_weight_kgB\x0e\n" +
+	"\f_source_reps\"\xa2\x03\n" +
+	"\x04Gear\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x121\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1d.fitglue.models.user.GearTypeR\x04type\x12'\n" +
+	"\x0fdistance_meters\x18\x04 \x01(\x01R\x0edistanceMeters\x126\n" +
+	"\x17initial_distance_meters\x18\x05 \x01(\x01R\x15initialDistanceMeters\x12%\n" +
+	"\x0eactivity_count\x18\x06 \x01(\x05R\ractivityCount\x12\x18\n" +
+	"\aretired\x18\a \x01(\bR\aretired\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x12(\n" +
+	"\x10last_activity_id\x18\n" +
+	" \x01(\tR\x0elastActivityId\"\xa0\x04\n" +
+	"\x04Goal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x127\n" +
+	"\x06metric\x18\x03 \x01(\x0e2\x1f.fitglue.models.user.GoalMetricR\x06metric\x12\x16\n" +
+	"\x06target\x18\x04 \x01(\x01R\x06target\x12J\n" +
+	"\ractivity_type\x18\x05 \x01(\x0e2%.fitglue.models.activity.ActivityTypeR\factivityType\x129\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1a\n" +
+	"\bprogress\x18\b \x01(\x01R\bprogress\x12%\n" +
+	"\x0eactivity_count\x18\t \x01(\x05R\ractivityCount\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcompleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12(\n" +
+	"\x10last_activity_id\x18\f \x01(\tR\x0elastActivityId*\x82\x01\n" +
+	"\x14SourceDeletionPolicy\x12&\n" +
+	"\"SOURCE_DELETION_POLICY_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSOURCE_DELETION_POLICY_KEEP\x10\x01\x12!\n" +
+	"\x1dSOURCE_DELETION_POLICY_REMOVE\x10\x02*T\n" +
 	"\bUserTier\x12\x19\n" +
 	"\x15USER_TIER_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12USER_TIER_HOBBYIST\x10\x01\x12\x15\n" +
-	"\x11USER_TIER_ATHLETE\x10\x02B;Z9github.com/fitglue/server/src/go/pkg/types/pb/models/userb\x06proto3"
+	"\x11USER_TIER_ATHLETE\x10\x02*N\n" +
+	"\bGearType\x12\x19\n" +
+	"\x15GEAR_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fGEAR_TYPE_SHOES\x10\x01\x12\x12\n" +
+	"\x0eGEAR_TYPE_BIKE\x10\x02*y\n" +
+	"\n" +
+	"GoalMetric\x12\x1b\n" +
+	"\x17GOAL_METRIC_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14GOAL_METRIC_DISTANCE\x10\x01\x12\x1a\n" +
+	"\x16GOAL_METRIC_ACTIVITIES\x10\x02\x12\x18\n" +
+	"\x14GOAL_METRIC_DURATION\x10\x03B;Z9github.com/fitglue/server/src/go/pkg/types/pb/models/userb\x06proto3"
 
 var (
 	file_models_user_profile_proto_rawDescOnce sync.Once
@@ -499,31 +1188,53 @@ func file_models_user_profile_proto_rawDescGZIP() []byte {
 	return file_models_user_profile_proto_rawDescData
 }
 
-var file_models_user_profile_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_models_user_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_models_user_profile_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_models_user_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_models_user_profile_proto_goTypes = []any{
-	(UserTier)(0),                   // 0: fitglue.models.user.UserTier
-	(*UserProfile)(nil),             // 1: fitglue.models.user.UserProfile
-	(*NotificationPreferences)(nil), // 2: fitglue.models.user.NotificationPreferences
-	(*Counter)(nil),                 // 3: fitglue.models.user.Counter
-	(*PersonalRecord)(nil),          // 4: fitglue.models.user.PersonalRecord
-	(*timestamppb.Timestamp)(nil),   // 5: google.protobuf.Timestamp
-	(activity.ActivityType)(0),      // 6: fitglue.models.activity.ActivityType
+	(SourceDeletionPolicy)(0),            // 0: fitglue.models.user.SourceDeletionPolicy
+	(UserTier)(0),                        // 1: fitglue.models.user.UserTier
+	(GearType)(0),                        // 2: fitglue.models.user.GearType
+	(GoalMetric)(0),                      // 3: fitglue.models.user.GoalMetric
+	(*UserProfile)(nil),                  // 4: fitglue.models.user.UserProfile
+	(*AIPrivacyPreferences)(nil),         // 5: fitglue.models.user.AIPrivacyPreferences
+	(*DescriptionHeaderPreferences)(nil), // 6: fitglue.models.user.DescriptionHeaderPreferences
+	(*NotificationPreferences)(nil),      // 7: fitglue.models.user.NotificationPreferences
+	(*Counter)(nil),                      // 8: fitglue.models.user.Counter
+	(*PersonalRecord)(nil),               // 9: fitglue.models.user.PersonalRecord
+	(*Gear)(nil),                         // 10: fitglue.models.user.Gear
+	(*Goal)(nil),                         // 11: fitglue.models.user.Goal
+	nil,                                  // 12: fitglue.models.user.DescriptionHeaderPreferences.CustomTextEntry
+	(*timestamppb.Timestamp)(nil),        // 13: google.protobuf.Timestamp
+	(activity.ActivityType)(0),           // 14: fitglue.models.activity.ActivityType
 }
 var file_models_user_profile_proto_depIdxs = []int32{
-	5, // 0: fitglue.models.user.UserProfile.created_at:type_name -> google.protobuf.Timestamp
-	0, // 1: fitglue.models.user.UserProfile.tier:type_name -> fitglue.models.user.UserTier
-	5, // 2: fitglue.models.user.UserProfile.sync_count_reset_at:type_name -> google.protobuf.Timestamp
-	2, // 3: fitglue.models.user.UserProfile.notification_preferences:type_name -> fitglue.models.user.NotificationPreferences
-	5, // 4: fitglue.models.user.UserProfile.trial_ends_at:type_name -> google.protobuf.Timestamp
-	5, // 5: fitglue.models.user.Counter.last_updated:type_name -> google.protobuf.Timestamp
-	5, // 6: fitglue.models.user.PersonalRecord.achieved_at:type_name -> google.protobuf.Timestamp
-	6, // 7: fitglue.models.user.PersonalRecord.activity_type:type_name -> fitglue.models.activity.ActivityType
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	13, // 0: fitglue.models.user.UserProfile.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: fitglue.models.user.UserProfile.tier:type_name -> fitglue.models.user.UserTier
+	13, // 2: fitglue.models.user.UserProfile.sync_count_reset_at:type_name -> google.protobuf.Timestamp
+	7,  // 3: fitglue.models.user.UserProfile.notification_preferences:type_name -> fitglue.models.user.NotificationPreferences
+	13, // 4: fitglue.models.user.UserProfile.trial_ends_at:type_name -> google.protobuf.Timestamp
+	13, // 5: fitglue.models.user.UserProfile.pipelines_paused_until:type_name -> google.protobuf.Timestamp
+	6,  // 6: fitglue.models.user.UserProfile.description_headers:type_name -> fitglue.models.user.DescriptionHeaderPreferences
+	5,  // 7: fitglue.models.user.UserProfile.ai_privacy:type_name -> fitglue.models.user.AIPrivacyPreferences
+	0,  // 8: fitglue.models.user.UserProfile.source_deletion_policy:type_name -> fitglue.models.user.SourceDeletionPolicy
+	12, // 9: fitglue.models.user.DescriptionHeaderPreferences.custom_text:type_name -> fitglue.models.user.DescriptionHeaderPreferences.CustomTextEntry
+	13, // 10: fitglue.models.user.Counter.last_updated:type_name -> google.protobuf.Timestamp
+	13, // 11: fitglue.models.user.PersonalRecord.achieved_at:type_name -> google.protobuf.Timestamp
+	14, // 12: fitglue.models.user.PersonalRecord.activity_type:type_name -> fitglue.models.activity.ActivityType
+	2,  // 13: fitglue.models.user.Gear.type:type_name -> fitglue.models.user.GearType
+	13, // 14: fitglue.models.user.Gear.created_at:type_name -> google.protobuf.Timestamp
+	13, // 15: fitglue.models.user.Gear.last_used_at:type_name -> google.protobuf.Timestamp
+	3,  // 16: fitglue.models.user.Goal.metric:type_name -> fitglue.models.user.GoalMetric
+	14, // 17: fitglue.models.user.Goal.activity_type:type_name -> fitglue.models.activity.ActivityType
+	13, // 18: fitglue.models.user.Goal.start_date:type_name -> google.protobuf.Timestamp
+	13, // 19: fitglue.models.user.Goal.end_date:type_name -> google.protobuf.Timestamp
+	13, // 20: fitglue.models.user.Goal.created_at:type_name -> google.protobuf.Timestamp
+	13, // 21: fitglue.models.user.Goal.completed_at:type_name -> google.protobuf.Timestamp
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_models_user_profile_proto_init() }
@@ -531,14 +1242,15 @@ func file_models_user_profile_proto_init() {
 	if File_models_user_profile_proto != nil {
 		return
 	}
-	file_models_user_profile_proto_msgTypes[3].OneofWrappers = []any{}
+	file_models_user_profile_proto_msgTypes[0].OneofWrappers = []any{}
+	file_models_user_profile_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_user_profile_proto_rawDesc), len(file_models_user_profile_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   4,
+			NumEnums:      4,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
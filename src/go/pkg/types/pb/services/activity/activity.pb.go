@@ -1581,6 +1581,605 @@ func (x *GetPublicShowcaseProfileResponse) GetCurrentPage() int32 {
 	return 0
 }
 
+type GetPublicShowcaseRouteStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicShowcaseRouteStatsRequest) Reset() {
+	*x = GetPublicShowcaseRouteStatsRequest{}
+	mi := &file_services_activity_activity_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicShowcaseRouteStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicShowcaseRouteStatsRequest) ProtoMessage() {}
+
+func (x *GetPublicShowcaseRouteStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_activity_activity_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicShowcaseRouteStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicShowcaseRouteStatsRequest) Descriptor() ([]byte, []int) {
+	return file_services_activity_activity_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetPublicShowcaseRouteStatsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type GetPublicShowcaseRouteStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Routes completed more than once, most repeated first
+	Routes        []*activity.ShowcaseRouteStats `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicShowcaseRouteStatsResponse) Reset() {
+	*x = GetPublicShowcaseRouteStatsResponse{}
+	mi := &file_services_activity_activity_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicShowcaseRouteStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicShowcaseRouteStatsResponse) ProtoMessage() {}
+
+func (x *GetPublicShowcaseRouteStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_activity_activity_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicShowcaseRouteStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicShowcaseRouteStatsResponse) Descriptor() ([]byte, []int) {
+	return file_services_activity_activity_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetPublicShowcaseRouteStatsResponse) GetRoutes() []*activity.ShowcaseRouteStats {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type GetPublicShowcaseFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // "rss" (default) or "atom"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicShowcaseFeedRequest) Reset() {
+	*x = GetPublicShowcaseFeedRequest{}
+	mi := &file_services_activity_activity_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicShowcaseFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicShowcaseFeedRequest) ProtoMessage() {}
+
+func (x *GetPublicShowcaseFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_activity_activity_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicShowcaseFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicShowcaseFeedRequest) Descriptor() ([]byte, []int) {
+	return file_services_activity_activity_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetPublicShowcaseFeedRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseFeedRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type GetPublicShowcaseFeedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rendered feed document (RSS 2.0 or Atom), served verbatim
+	ContentType   string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Body          string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicShowcaseFeedResponse) Reset() {
+	*x = GetPublicShowcaseFeedResponse{}
+	mi := &file_services_activity_activity_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicShowcaseFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicShowcaseFeedResponse) ProtoMessage() {}
+
+func (x *GetPublicShowcaseFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_activity_activity_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicShowcaseFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicShowcaseFeedResponse) Descriptor() ([]byte, []int) {
+	return file_services_activity_activity_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetPublicShowcaseFeedResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseFeedResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type GetPublicShowcaseComparisonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlugA         string                 `protobuf:"bytes,1,opt,name=slug_a,json=slugA,proto3" json:"slug_a,omitempty"`
+	SlugB         string                 `protobuf:"bytes,2,opt,name=slug_b,json=slugB,proto3" json:"slug_b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicShowcaseComparisonRequest) Reset() {
+	*x = GetPublicShowcaseComparisonRequest{}
+	mi := &file_services_activity_activity_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicShowcaseComparisonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicShowcaseComparisonRequest) ProtoMessage() {}
+
+func (x *GetPublicShowcaseComparisonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_activity_activity_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicShowcaseComparisonRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicShowcaseComparisonRequest) Descriptor() ([]byte, []int) {
+	return file_services_activity_activity_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetPublicShowcaseComparisonRequest) GetSlugA() string {
+	if x != nil {
+		return x.SlugA
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseComparisonRequest) GetSlugB() string {
+	if x != nil {
+		return x.SlugB
+	}
+	return ""
+}
+
+type GetPublicShowcaseEmbedRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Slug       string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	ShowcaseId string                 `protobuf:"bytes,2,opt,name=showcase_id,json=showcaseId,proto3" json:"showcase_id,omitempty"` // Profile entry to show; the latest when empty
+	Format     string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`                           // "svg" (default) or "html"
+	// Signed link parameters, required when the profile is hidden
+	Expires       int64  `protobuf:"varint,4,opt,name=expires,proto3" json:"expires,omitempty"` // Unix seconds
+	Signature     string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicShowcaseEmbedRequest) Reset() {
+	*x = GetPublicShowcaseEmbedRequest{}
+	mi := &file_services_activity_activity_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicShowcaseEmbedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicShowcaseEmbedRequest) ProtoMessage() {}
+
+func (x *GetPublicShowcaseEmbedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_activity_activity_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicShowcaseEmbedRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicShowcaseEmbedRequest) Descriptor() ([]byte, []int) {
+	return file_services_activity_activity_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetPublicShowcaseEmbedRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseEmbedRequest) GetShowcaseId() string {
+	if x != nil {
+		return x.ShowcaseId
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseEmbedRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseEmbedRequest) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *GetPublicShowcaseEmbedRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type GetPublicShowcaseEmbedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Etag          string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	Signed        bool                   `protobuf:"varint,4,opt,name=signed,proto3" json:"signed,omitempty"` // Served through a signed link, so must not be cached publicly
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicShowcaseEmbedResponse) Reset() {
+	*x = GetPublicShowcaseEmbedResponse{}
+	mi := &file_services_activity_activity_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicShowcaseEmbedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicShowcaseEmbedResponse) ProtoMessage() {}
+
+func (x *GetPublicShowcaseEmbedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_activity_activity_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicShowcaseEmbedResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicShowcaseEmbedResponse) Descriptor() ([]byte, []int) {
+	return file_services_activity_activity_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetPublicShowcaseEmbedResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseEmbedResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseEmbedResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *GetPublicShowcaseEmbedResponse) GetSigned() bool {
+	if x != nil {
+		return x.Signed
+	}
+	return false
+}
+
+type CreateShowcaseEmbedLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ShowcaseId    string                 `protobuf:"bytes,2,opt,name=showcase_id,json=showcaseId,proto3" json:"showcase_id,omitempty"` // Pins the link to one entry; the latest when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShowcaseEmbedLinkRequest) Reset() {
+	*x = CreateShowcaseEmbedLinkRequest{}
+	mi := &file_services_activity_activity_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShowcaseEmbedLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShowcaseEmbedLinkRequest) ProtoMessage() {}
+
+func (x *CreateShowcaseEmbedLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_activity_activity_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShowcaseEmbedLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateShowcaseEmbedLinkRequest) Descriptor() ([]byte, []int) {
+	return file_services_activity_activity_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateShowcaseEmbedLinkRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateShowcaseEmbedLinkRequest) GetShowcaseId() string {
+	if x != nil {
+		return x.ShowcaseId
+	}
+	return ""
+}
+
+type CreateShowcaseEmbedLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	ShowcaseId    string                 `protobuf:"bytes,2,opt,name=showcase_id,json=showcaseId,proto3" json:"showcase_id,omitempty"`
+	Expires       int64                  `protobuf:"varint,3,opt,name=expires,proto3" json:"expires,omitempty"`
+	Signature     string                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShowcaseEmbedLinkResponse) Reset() {
+	*x = CreateShowcaseEmbedLinkResponse{}
+	mi := &file_services_activity_activity_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShowcaseEmbedLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShowcaseEmbedLinkResponse) ProtoMessage() {}
+
+func (x *CreateShowcaseEmbedLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_activity_activity_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShowcaseEmbedLinkResponse.ProtoReflect.Descriptor instead.
+func (*CreateShowcaseEmbedLinkResponse) Descriptor() ([]byte, []int) {
+	return file_services_activity_activity_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateShowcaseEmbedLinkResponse) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateShowcaseEmbedLinkResponse) GetShowcaseId() string {
+	if x != nil {
+		return x.ShowcaseId
+	}
+	return ""
+}
+
+func (x *CreateShowcaseEmbedLinkResponse) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *CreateShowcaseEmbedLinkResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type RefreshShowcaseComparisonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshShowcaseComparisonsRequest) Reset() {
+	*x = RefreshShowcaseComparisonsRequest{}
+	mi := &file_services_activity_activity_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshShowcaseComparisonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshShowcaseComparisonsRequest) ProtoMessage() {}
+
+func (x *RefreshShowcaseComparisonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_activity_activity_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshShowcaseComparisonsRequest.ProtoReflect.Descriptor instead.
+func (*RefreshShowcaseComparisonsRequest) Descriptor() ([]byte, []int) {
+	return file_services_activity_activity_proto_rawDescGZIP(), []int{38}
+}
+
+type RefreshShowcaseComparisonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refreshed     int32                  `protobuf:"varint,1,opt,name=refreshed,proto3" json:"refreshed,omitempty"`
+	Removed       int32                  `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"` // Comparisons whose profiles are gone or hidden
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshShowcaseComparisonsResponse) Reset() {
+	*x = RefreshShowcaseComparisonsResponse{}
+	mi := &file_services_activity_activity_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshShowcaseComparisonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshShowcaseComparisonsResponse) ProtoMessage() {}
+
+func (x *RefreshShowcaseComparisonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_activity_activity_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshShowcaseComparisonsResponse.ProtoReflect.Descriptor instead.
+func (*RefreshShowcaseComparisonsResponse) Descriptor() ([]byte, []int) {
+	return file_services_activity_activity_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RefreshShowcaseComparisonsResponse) GetRefreshed() int32 {
+	if x != nil {
+		return x.Refreshed
+	}
+	return 0
+}
+
+func (x *RefreshShowcaseComparisonsResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
 type GetActivityStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1590,7 +2189,7 @@ type GetActivityStatsRequest struct {
 
 func (x *GetActivityStatsRequest) Reset() {
 	*x = GetActivityStatsRequest{}
-	mi := &file_services_activity_activity_proto_msgTypes[29]
+	mi := &file_services_activity_activity_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1602,7 +2201,7 @@ func (x *GetActivityStatsRequest) String() string {
 func (*GetActivityStatsRequest) ProtoMessage() {}
 
 func (x *GetActivityStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_activity_activity_proto_msgTypes[29]
+	mi := &file_services_activity_activity_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1615,7 +2214,7 @@ func (x *GetActivityStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActivityStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetActivityStatsRequest) Descriptor() ([]byte, []int) {
-	return file_services_activity_activity_proto_rawDescGZIP(), []int{29}
+	return file_services_activity_activity_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetActivityStatsRequest) GetUserId() string {
@@ -1636,7 +2235,7 @@ type GetActivityStatsResponse struct {
 
 func (x *GetActivityStatsResponse) Reset() {
 	*x = GetActivityStatsResponse{}
-	mi := &file_services_activity_activity_proto_msgTypes[30]
+	mi := &file_services_activity_activity_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1648,7 +2247,7 @@ func (x *GetActivityStatsResponse) String() string {
 func (*GetActivityStatsResponse) ProtoMessage() {}
 
 func (x *GetActivityStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_activity_activity_proto_msgTypes[30]
+	mi := &file_services_activity_activity_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1661,7 +2260,7 @@ func (x *GetActivityStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActivityStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetActivityStatsResponse) Descriptor() ([]byte, []int) {
-	return file_services_activity_activity_proto_rawDescGZIP(), []int{30}
+	return file_services_activity_activity_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetActivityStatsResponse) GetTotalActivities() int32 {
@@ -1804,13 +2403,52 @@ const file_services_activity_activity_proto_rawDesc = "" +
 	"\tshowcases\x18\x02 \x03(\v2*.fitglue.models.activity.ShowcasedActivityR\tshowcases\x12\x1f\n" +
 	"\vtotal_pages\x18\x03 \x01(\x05R\n" +
 	"totalPages\x12!\n" +
-	"\fcurrent_page\x18\x04 \x01(\x05R\vcurrentPage\"2\n" +
+	"\fcurrent_page\x18\x04 \x01(\x05R\vcurrentPage\"8\n" +
+	"\"GetPublicShowcaseRouteStatsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"j\n" +
+	"#GetPublicShowcaseRouteStatsResponse\x12C\n" +
+	"\x06routes\x18\x01 \x03(\v2+.fitglue.models.activity.ShowcaseRouteStatsR\x06routes\"J\n" +
+	"\x1cGetPublicShowcaseFeedRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"V\n" +
+	"\x1dGetPublicShowcaseFeedResponse\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\"R\n" +
+	"\"GetPublicShowcaseComparisonRequest\x12\x15\n" +
+	"\x06slug_a\x18\x01 \x01(\tR\x05slugA\x12\x15\n" +
+	"\x06slug_b\x18\x02 \x01(\tR\x05slugB\"\xa4\x01\n" +
+	"\x1dGetPublicShowcaseEmbedRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x1f\n" +
+	"\vshowcase_id\x18\x02 \x01(\tR\n" +
+	"showcaseId\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x18\n" +
+	"\aexpires\x18\x04 \x01(\x03R\aexpires\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignature\"\x83\x01\n" +
+	"\x1eGetPublicShowcaseEmbedResponse\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\x12\x16\n" +
+	"\x06signed\x18\x04 \x01(\bR\x06signed\"Z\n" +
+	"\x1eCreateShowcaseEmbedLinkRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vshowcase_id\x18\x02 \x01(\tR\n" +
+	"showcaseId\"\x8e\x01\n" +
+	"\x1fCreateShowcaseEmbedLinkResponse\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x1f\n" +
+	"\vshowcase_id\x18\x02 \x01(\tR\n" +
+	"showcaseId\x12\x18\n" +
+	"\aexpires\x18\x03 \x01(\x03R\aexpires\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\tR\tsignature\"#\n" +
+	"!RefreshShowcaseComparisonsRequest\"\\\n" +
+	"\"RefreshShowcaseComparisonsResponse\x12\x1c\n" +
+	"\trefreshed\x18\x01 \x01(\x05R\trefreshed\x12\x18\n" +
+	"\aremoved\x18\x02 \x01(\x05R\aremoved\"2\n" +
 	"\x17GetActivityStatsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x98\x01\n" +
 	"\x18GetActivityStatsResponse\x12)\n" +
 	"\x10total_activities\x18\x01 \x01(\x05R\x0ftotalActivities\x12'\n" +
 	"\x0ftotal_showcases\x18\x02 \x01(\x05R\x0etotalShowcases\x12(\n" +
-	"\x10last_activity_at\x18\x03 \x01(\tR\x0elastActivityAt2\xa9\x1e\n" +
+	"\x10last_activity_at\x18\x03 \x01(\tR\x0elastActivityAt2\xe7'\n" +
 	"\x0fActivityService\x12\xa1\x01\n" +
 	"\vGetActivity\x12-.fitglue.services.activity.GetActivityRequest\x1a-.fitglue.models.activity.StandardizedActivity\"4\x82\xd3\xe4\x93\x02.\x12,/v2/users/{user_id}/activities/{activity_id}\x12\x9d\x01\n" +
 	"\x0eListActivities\x120.fitglue.services.activity.ListActivitiesRequest\x1a1.fitglue.services.activity.ListActivitiesResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v2/users/{user_id}/activities\x12\x90\x01\n" +
@@ -1827,14 +2465,20 @@ const file_services_activity_activity_proto_rawDesc = "" +
 	"\x19UpdateShowcasePreferences\x12;.fitglue.services.activity.UpdateShowcasePreferencesRequest\x1a(.fitglue.models.activity.ShowcaseProfile\"H\x82\xd3\xe4\x93\x02B:\vpreferences\x1a3/v2/users/{user_id}/showcase-management/preferences\x12\xab\x01\n" +
 	"\x16GenerateShowcaseImages\x128.fitglue.services.activity.GenerateShowcaseImagesRequest\x1a\x16.google.protobuf.Empty\"?\x82\xd3\xe4\x93\x029:\x01*\"4/v2/users/{user_id}/showcases/{showcase_id}/generate\x12\xa0\x01\n" +
 	"\x11GetPublicShowcase\x123.fitglue.services.activity.GetPublicShowcaseRequest\x1a*.fitglue.models.activity.ShowcasedActivity\"*\x82\xd3\xe4\x93\x02$\x12\"/v2/public/showcases/{showcase_id}\x12\xbf\x01\n" +
-	"\x18GetPublicShowcaseProfile\x12:.fitglue.services.activity.GetPublicShowcaseProfileRequest\x1a;.fitglue.services.activity.GetPublicShowcaseProfileResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v2/public/showcase/profile/{slug}\x12\xa9\x01\n" +
+	"\x18GetPublicShowcaseProfile\x12:.fitglue.services.activity.GetPublicShowcaseProfileRequest\x1a;.fitglue.services.activity.GetPublicShowcaseProfileResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v2/public/showcase/profile/{slug}\x12\xcf\x01\n" +
+	"\x1bGetPublicShowcaseRouteStats\x12=.fitglue.services.activity.GetPublicShowcaseRouteStatsRequest\x1a>.fitglue.services.activity.GetPublicShowcaseRouteStatsResponse\"1\x82\xd3\xe4\x93\x02+\x12)/v2/public/showcase/profile/{slug}/routes\x12\xbb\x01\n" +
+	"\x15GetPublicShowcaseFeed\x127.fitglue.services.activity.GetPublicShowcaseFeedRequest\x1a8.fitglue.services.activity.GetPublicShowcaseFeedResponse\"/\x82\xd3\xe4\x93\x02)\x12'/v2/public/showcase/profile/{slug}/feed\x12\xc0\x01\n" +
+	"\x1bGetPublicShowcaseComparison\x12=.fitglue.services.activity.GetPublicShowcaseComparisonRequest\x1a+.fitglue.models.activity.ShowcaseComparison\"5\x82\xd3\xe4\x93\x02/\x12-/v2/public/showcase/compare/{slug_a}/{slug_b}\x12\xbf\x01\n" +
+	"\x16GetPublicShowcaseEmbed\x128.fitglue.services.activity.GetPublicShowcaseEmbedRequest\x1a9.fitglue.services.activity.GetPublicShowcaseEmbedResponse\"0\x82\xd3\xe4\x93\x02*\x12(/v2/public/showcase/profile/{slug}/embed\x12\xcc\x01\n" +
+	"\x1aRefreshShowcaseComparisons\x12<.fitglue.services.activity.RefreshShowcaseComparisonsRequest\x1a=.fitglue.services.activity.RefreshShowcaseComparisonsResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v2/admin/showcase/comparisons/refresh\x12\xa9\x01\n" +
 	"\x10GetActivityStats\x122.fitglue.services.activity.GetActivityStatsRequest\x1a3.fitglue.services.activity.GetActivityStatsResponse\",\x82\xd3\xe4\x93\x02&\x12$/v2/users/{user_id}/activities/stats\x12\xbd\x01\n" +
 	"\x13GetShowcaseSettings\x125.fitglue.services.activity.GetShowcaseSettingsRequest\x1a6.fitglue.services.activity.GetShowcaseSettingsResponse\"7\x82\xd3\xe4\x93\x021\x12//v2/users/{user_id}/showcase-management/profile\x12\xbf\x01\n" +
 	"\x16UpdateShowcaseSettings\x128.fitglue.services.activity.UpdateShowcaseSettingsRequest\x1a(.fitglue.models.activity.ShowcaseProfile\"A\x82\xd3\xe4\x93\x02;:\bsettings\x1a//v2/users/{user_id}/showcase-management/profile\x12\xc2\x01\n" +
 	"\x12UpdateShowcaseSlug\x124.fitglue.services.activity.UpdateShowcaseSlugRequest\x1a5.fitglue.services.activity.UpdateShowcaseSlugResponse\"?\x82\xd3\xe4\x93\x029:\x01*\x1a4/v2/users/{user_id}/showcase-management/profile/slug\x12\xad\x01\n" +
 	"\x10AddShowcaseEntry\x122.fitglue.services.activity.AddShowcaseEntryRequest\x1a\x16.google.protobuf.Empty\"M\x82\xd3\xe4\x93\x02G\"E/v2/users/{user_id}/showcase-management/profile/entries/{showcase_id}\x12\xb3\x01\n" +
 	"\x13RemoveShowcaseEntry\x125.fitglue.services.activity.RemoveShowcaseEntryRequest\x1a\x16.google.protobuf.Empty\"M\x82\xd3\xe4\x93\x02G*E/v2/users/{user_id}/showcase-management/profile/entries/{showcase_id}\x12\xf5\x01\n" +
-	"\"GetShowcaseProfilePictureUploadUrl\x12D.fitglue.services.activity.GetShowcaseProfilePictureUploadUrlRequest\x1aE.fitglue.services.activity.GetShowcaseProfilePictureUploadUrlResponse\"B\x82\xd3\xe4\x93\x02<:\x01*\"7/v2/users/{user_id}/showcase-management/profile/pictureBAZ?github.com/fitglue/server/src/go/pkg/types/pb/services/activityb\x06proto3"
+	"\"GetShowcaseProfilePictureUploadUrl\x12D.fitglue.services.activity.GetShowcaseProfilePictureUploadUrlRequest\x1aE.fitglue.services.activity.GetShowcaseProfilePictureUploadUrlResponse\"B\x82\xd3\xe4\x93\x02<:\x01*\"7/v2/users/{user_id}/showcase-management/profile/picture\x12\xd7\x01\n" +
+	"\x17CreateShowcaseEmbedLink\x129.fitglue.services.activity.CreateShowcaseEmbedLinkRequest\x1a:.fitglue.services.activity.CreateShowcaseEmbedLinkResponse\"E\x82\xd3\xe4\x93\x02?:\x01*\":/v2/users/{user_id}/showcase-management/profile/embed-linkBAZ?github.com/fitglue/server/src/go/pkg/types/pb/services/activityb\x06proto3"
 
 var (
 	file_services_activity_activity_proto_rawDescOnce sync.Once
@@ -1848,7 +2492,7 @@ func file_services_activity_activity_proto_rawDescGZIP() []byte {
 	return file_services_activity_activity_proto_rawDescData
 }
 
-var file_services_activity_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_services_activity_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_services_activity_activity_proto_goTypes = []any{
 	(*GetActivityRequest)(nil),                         // 0: fitglue.services.activity.GetActivityRequest
 	(*ListActivitiesRequest)(nil),                      // 1: fitglue.services.activity.ListActivitiesRequest
@@ -1879,74 +2523,100 @@ var file_services_activity_activity_proto_goTypes = []any{
 	(*GetShowcaseProfilePictureUploadUrlResponse)(nil), // 26: fitglue.services.activity.GetShowcaseProfilePictureUploadUrlResponse
 	(*GetPublicShowcaseProfileRequest)(nil),            // 27: fitglue.services.activity.GetPublicShowcaseProfileRequest
 	(*GetPublicShowcaseProfileResponse)(nil),           // 28: fitglue.services.activity.GetPublicShowcaseProfileResponse
-	(*GetActivityStatsRequest)(nil),                    // 29: fitglue.services.activity.GetActivityStatsRequest
-	(*GetActivityStatsResponse)(nil),                   // 30: fitglue.services.activity.GetActivityStatsResponse
-	(*activity.StandardizedActivity)(nil),              // 31: fitglue.models.activity.StandardizedActivity
-	(*activity.ShowcaseProfileEntry)(nil),              // 32: fitglue.models.activity.ShowcaseProfileEntry
-	(*activity.ShowcasedActivity)(nil),                 // 33: fitglue.models.activity.ShowcasedActivity
-	(*activity.ShowcaseProfile)(nil),                   // 34: fitglue.models.activity.ShowcaseProfile
-	(*emptypb.Empty)(nil),                              // 35: google.protobuf.Empty
+	(*GetPublicShowcaseRouteStatsRequest)(nil),         // 29: fitglue.services.activity.GetPublicShowcaseRouteStatsRequest
+	(*GetPublicShowcaseRouteStatsResponse)(nil),        // 30: fitglue.services.activity.GetPublicShowcaseRouteStatsResponse
+	(*GetPublicShowcaseFeedRequest)(nil),               // 31: fitglue.services.activity.GetPublicShowcaseFeedRequest
+	(*GetPublicShowcaseFeedResponse)(nil),              // 32: fitglue.services.activity.GetPublicShowcaseFeedResponse
+	(*GetPublicShowcaseComparisonRequest)(nil),         // 33: fitglue.services.activity.GetPublicShowcaseComparisonRequest
+	(*GetPublicShowcaseEmbedRequest)(nil),              // 34: fitglue.services.activity.GetPublicShowcaseEmbedRequest
+	(*GetPublicShowcaseEmbedResponse)(nil),             // 35: fitglue.services.activity.GetPublicShowcaseEmbedResponse
+	(*CreateShowcaseEmbedLinkRequest)(nil),             // 36: fitglue.services.activity.CreateShowcaseEmbedLinkRequest
+	(*CreateShowcaseEmbedLinkResponse)(nil),            // 37: fitglue.services.activity.CreateShowcaseEmbedLinkResponse
+	(*RefreshShowcaseComparisonsRequest)(nil),          // 38: fitglue.services.activity.RefreshShowcaseComparisonsRequest
+	(*RefreshShowcaseComparisonsResponse)(nil),         // 39: fitglue.services.activity.RefreshShowcaseComparisonsResponse
+	(*GetActivityStatsRequest)(nil),                    // 40: fitglue.services.activity.GetActivityStatsRequest
+	(*GetActivityStatsResponse)(nil),                   // 41: fitglue.services.activity.GetActivityStatsResponse
+	(*activity.StandardizedActivity)(nil),              // 42: fitglue.models.activity.StandardizedActivity
+	(*activity.ShowcaseProfileEntry)(nil),              // 43: fitglue.models.activity.ShowcaseProfileEntry
+	(*activity.ShowcasedActivity)(nil),                 // 44: fitglue.models.activity.ShowcasedActivity
+	(*activity.ShowcaseProfile)(nil),                   // 45: fitglue.models.activity.ShowcaseProfile
+	(*activity.ShowcaseRouteStats)(nil),                // 46: fitglue.models.activity.ShowcaseRouteStats
+	(*emptypb.Empty)(nil),                              // 47: google.protobuf.Empty
+	(*activity.ShowcaseComparison)(nil),                // 48: fitglue.models.activity.ShowcaseComparison
 }
 var file_services_activity_activity_proto_depIdxs = []int32{
-	31, // 0: fitglue.services.activity.ListActivitiesResponse.activities:type_name -> fitglue.models.activity.StandardizedActivity
-	32, // 1: fitglue.services.activity.ListShowcasesResponse.showcases:type_name -> fitglue.models.activity.ShowcaseProfileEntry
-	33, // 2: fitglue.services.activity.CreateShowcaseRequest.showcase:type_name -> fitglue.models.activity.ShowcasedActivity
-	33, // 3: fitglue.services.activity.UpdateShowcaseRequest.showcase:type_name -> fitglue.models.activity.ShowcasedActivity
-	34, // 4: fitglue.services.activity.UpdateShowcasePreferencesRequest.preferences:type_name -> fitglue.models.activity.ShowcaseProfile
-	34, // 5: fitglue.services.activity.GetShowcaseSettingsResponse.profile:type_name -> fitglue.models.activity.ShowcaseProfile
+	42, // 0: fitglue.services.activity.ListActivitiesResponse.activities:type_name -> fitglue.models.activity.StandardizedActivity
+	43, // 1: fitglue.services.activity.ListShowcasesResponse.showcases:type_name -> fitglue.models.activity.ShowcaseProfileEntry
+	44, // 2: fitglue.services.activity.CreateShowcaseRequest.showcase:type_name -> fitglue.models.activity.ShowcasedActivity
+	44, // 3: fitglue.services.activity.UpdateShowcaseRequest.showcase:type_name -> fitglue.models.activity.ShowcasedActivity
+	45, // 4: fitglue.services.activity.UpdateShowcasePreferencesRequest.preferences:type_name -> fitglue.models.activity.ShowcaseProfile
+	45, // 5: fitglue.services.activity.GetShowcaseSettingsResponse.profile:type_name -> fitglue.models.activity.ShowcaseProfile
 	19, // 6: fitglue.services.activity.GetShowcaseSettingsResponse.activities:type_name -> fitglue.services.activity.ShowcaseActivityEntry
-	34, // 7: fitglue.services.activity.UpdateShowcaseSettingsRequest.settings:type_name -> fitglue.models.activity.ShowcaseProfile
-	34, // 8: fitglue.services.activity.GetPublicShowcaseProfileResponse.profile:type_name -> fitglue.models.activity.ShowcaseProfile
-	33, // 9: fitglue.services.activity.GetPublicShowcaseProfileResponse.showcases:type_name -> fitglue.models.activity.ShowcasedActivity
-	0,  // 10: fitglue.services.activity.ActivityService.GetActivity:input_type -> fitglue.services.activity.GetActivityRequest
-	1,  // 11: fitglue.services.activity.ActivityService.ListActivities:input_type -> fitglue.services.activity.ListActivitiesRequest
-	3,  // 12: fitglue.services.activity.ActivityService.DeleteActivity:input_type -> fitglue.services.activity.DeleteActivityRequest
-	4,  // 13: fitglue.services.activity.ActivityService.GetShowcase:input_type -> fitglue.services.activity.GetShowcaseRequest
-	5,  // 14: fitglue.services.activity.ActivityService.ListShowcases:input_type -> fitglue.services.activity.ListShowcasesRequest
-	7,  // 15: fitglue.services.activity.ActivityService.CreateShowcase:input_type -> fitglue.services.activity.CreateShowcaseRequest
-	8,  // 16: fitglue.services.activity.ActivityService.UpdateShowcase:input_type -> fitglue.services.activity.UpdateShowcaseRequest
-	9,  // 17: fitglue.services.activity.ActivityService.DeleteShowcase:input_type -> fitglue.services.activity.DeleteShowcaseRequest
-	10, // 18: fitglue.services.activity.ActivityService.ExportData:input_type -> fitglue.services.activity.ExportDataRequest
-	12, // 19: fitglue.services.activity.ActivityService.ParseFitFile:input_type -> fitglue.services.activity.ParseFitFileRequest
-	13, // 20: fitglue.services.activity.ActivityService.GetShowcasePreferences:input_type -> fitglue.services.activity.GetShowcasePreferencesRequest
-	14, // 21: fitglue.services.activity.ActivityService.UpdateShowcasePreferences:input_type -> fitglue.services.activity.UpdateShowcasePreferencesRequest
-	15, // 22: fitglue.services.activity.ActivityService.GenerateShowcaseImages:input_type -> fitglue.services.activity.GenerateShowcaseImagesRequest
-	16, // 23: fitglue.services.activity.ActivityService.GetPublicShowcase:input_type -> fitglue.services.activity.GetPublicShowcaseRequest
-	27, // 24: fitglue.services.activity.ActivityService.GetPublicShowcaseProfile:input_type -> fitglue.services.activity.GetPublicShowcaseProfileRequest
-	29, // 25: fitglue.services.activity.ActivityService.GetActivityStats:input_type -> fitglue.services.activity.GetActivityStatsRequest
-	17, // 26: fitglue.services.activity.ActivityService.GetShowcaseSettings:input_type -> fitglue.services.activity.GetShowcaseSettingsRequest
-	20, // 27: fitglue.services.activity.ActivityService.UpdateShowcaseSettings:input_type -> fitglue.services.activity.UpdateShowcaseSettingsRequest
-	21, // 28: fitglue.services.activity.ActivityService.UpdateShowcaseSlug:input_type -> fitglue.services.activity.UpdateShowcaseSlugRequest
-	23, // 29: fitglue.services.activity.ActivityService.AddShowcaseEntry:input_type -> fitglue.services.activity.AddShowcaseEntryRequest
-	24, // 30: fitglue.services.activity.ActivityService.RemoveShowcaseEntry:input_type -> fitglue.services.activity.RemoveShowcaseEntryRequest
-	25, // 31: fitglue.services.activity.ActivityService.GetShowcaseProfilePictureUploadUrl:input_type -> fitglue.services.activity.GetShowcaseProfilePictureUploadUrlRequest
-	31, // 32: fitglue.services.activity.ActivityService.GetActivity:output_type -> fitglue.models.activity.StandardizedActivity
-	2,  // 33: fitglue.services.activity.ActivityService.ListActivities:output_type -> fitglue.services.activity.ListActivitiesResponse
-	35, // 34: fitglue.services.activity.ActivityService.DeleteActivity:output_type -> google.protobuf.Empty
-	33, // 35: fitglue.services.activity.ActivityService.GetShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
-	6,  // 36: fitglue.services.activity.ActivityService.ListShowcases:output_type -> fitglue.services.activity.ListShowcasesResponse
-	33, // 37: fitglue.services.activity.ActivityService.CreateShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
-	33, // 38: fitglue.services.activity.ActivityService.UpdateShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
-	35, // 39: fitglue.services.activity.ActivityService.DeleteShowcase:output_type -> google.protobuf.Empty
-	11, // 40: fitglue.services.activity.ActivityService.ExportData:output_type -> fitglue.services.activity.ExportDataResponse
-	31, // 41: fitglue.services.activity.ActivityService.ParseFitFile:output_type -> fitglue.models.activity.StandardizedActivity
-	34, // 42: fitglue.services.activity.ActivityService.GetShowcasePreferences:output_type -> fitglue.models.activity.ShowcaseProfile
-	34, // 43: fitglue.services.activity.ActivityService.UpdateShowcasePreferences:output_type -> fitglue.models.activity.ShowcaseProfile
-	35, // 44: fitglue.services.activity.ActivityService.GenerateShowcaseImages:output_type -> google.protobuf.Empty
-	33, // 45: fitglue.services.activity.ActivityService.GetPublicShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
-	28, // 46: fitglue.services.activity.ActivityService.GetPublicShowcaseProfile:output_type -> fitglue.services.activity.GetPublicShowcaseProfileResponse
-	30, // 47: fitglue.services.activity.ActivityService.GetActivityStats:output_type -> fitglue.services.activity.GetActivityStatsResponse
-	18, // 48: fitglue.services.activity.ActivityService.GetShowcaseSettings:output_type -> fitglue.services.activity.GetShowcaseSettingsResponse
-	34, // 49: fitglue.services.activity.ActivityService.UpdateShowcaseSettings:output_type -> fitglue.models.activity.ShowcaseProfile
-	22, // 50: fitglue.services.activity.ActivityService.UpdateShowcaseSlug:output_type -> fitglue.services.activity.UpdateShowcaseSlugResponse
-	35, // 51: fitglue.services.activity.ActivityService.AddShowcaseEntry:output_type -> google.protobuf.Empty
-	35, // 52: fitglue.services.activity.ActivityService.RemoveShowcaseEntry:output_type -> google.protobuf.Empty
-	26, // 53: fitglue.services.activity.ActivityService.GetShowcaseProfilePictureUploadUrl:output_type -> fitglue.services.activity.GetShowcaseProfilePictureUploadUrlResponse
-	32, // [32:54] is the sub-list for method output_type
-	10, // [10:32] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	45, // 7: fitglue.services.activity.UpdateShowcaseSettingsRequest.settings:type_name -> fitglue.models.activity.ShowcaseProfile
+	45, // 8: fitglue.services.activity.GetPublicShowcaseProfileResponse.profile:type_name -> fitglue.models.activity.ShowcaseProfile
+	44, // 9: fitglue.services.activity.GetPublicShowcaseProfileResponse.showcases:type_name -> fitglue.models.activity.ShowcasedActivity
+	46, // 10: fitglue.services.activity.GetPublicShowcaseRouteStatsResponse.routes:type_name -> fitglue.models.activity.ShowcaseRouteStats
+	0,  // 11: fitglue.services.activity.ActivityService.GetActivity:input_type -> fitglue.services.activity.GetActivityRequest
+	1,  // 12: fitglue.services.activity.ActivityService.ListActivities:input_type -> fitglue.services.activity.ListActivitiesRequest
+	3,  // 13: fitglue.services.activity.ActivityService.DeleteActivity:input_type -> fitglue.services.activity.DeleteActivityRequest
+	4,  // 14: fitglue.services.activity.ActivityService.GetShowcase:input_type -> fitglue.services.activity.GetShowcaseRequest
+	5,  // 15: fitglue.services.activity.ActivityService.ListShowcases:input_type -> fitglue.services.activity.ListShowcasesRequest
+	7,  // 16: fitglue.services.activity.ActivityService.CreateShowcase:input_type -> fitglue.services.activity.CreateShowcaseRequest
+	8,  // 17: fitglue.services.activity.ActivityService.UpdateShowcase:input_type -> fitglue.services.activity.UpdateShowcaseRequest
+	9,  // 18: fitglue.services.activity.ActivityService.DeleteShowcase:input_type -> fitglue.services.activity.DeleteShowcaseRequest
+	10, // 19: fitglue.services.activity.ActivityService.ExportData:input_type -> fitglue.services.activity.ExportDataRequest
+	12, // 20: fitglue.services.activity.ActivityService.ParseFitFile:input_type -> fitglue.services.activity.ParseFitFileRequest
+	13, // 21: fitglue.services.activity.ActivityService.GetShowcasePreferences:input_type -> fitglue.services.activity.GetShowcasePreferencesRequest
+	14, // 22: fitglue.services.activity.ActivityService.UpdateShowcasePreferences:input_type -> fitglue.services.activity.UpdateShowcasePreferencesRequest
+	15, // 23: fitglue.services.activity.ActivityService.GenerateShowcaseImages:input_type -> fitglue.services.activity.GenerateShowcaseImagesRequest
+	16, // 24: fitglue.services.activity.ActivityService.GetPublicShowcase:input_type -> fitglue.services.activity.GetPublicShowcaseRequest
+	27, // 25: fitglue.services.activity.ActivityService.GetPublicShowcaseProfile:input_type -> fitglue.services.activity.GetPublicShowcaseProfileRequest
+	29, // 26: fitglue.services.activity.ActivityService.GetPublicShowcaseRouteStats:input_type -> fitglue.services.activity.GetPublicShowcaseRouteStatsRequest
+	31, // 27: fitglue.services.activity.ActivityService.GetPublicShowcaseFeed:input_type -> fitglue.services.activity.GetPublicShowcaseFeedRequest
+	33, // 28: fitglue.services.activity.ActivityService.GetPublicShowcaseComparison:input_type -> fitglue.services.activity.GetPublicShowcaseComparisonRequest
+	34, // 29: fitglue.services.activity.ActivityService.GetPublicShowcaseEmbed:input_type -> fitglue.services.activity.GetPublicShowcaseEmbedRequest
+	38, // 30: fitglue.services.activity.ActivityService.RefreshShowcaseComparisons:input_type -> fitglue.services.activity.RefreshShowcaseComparisonsRequest
+	40, // 31: fitglue.services.activity.ActivityService.GetActivityStats:input_type -> fitglue.services.activity.GetActivityStatsRequest
+	17, // 32: fitglue.services.activity.ActivityService.GetShowcaseSettings:input_type -> fitglue.services.activity.GetShowcaseSettingsRequest
+	20, // 33: fitglue.services.activity.ActivityService.UpdateShowcaseSettings:input_type -> fitglue.services.activity.UpdateShowcaseSettingsRequest
+	21, // 34: fitglue.services.activity.ActivityService.UpdateShowcaseSlug:input_type -> fitglue.services.activity.UpdateShowcaseSlugRequest
+	23, // 35: fitglue.services.activity.ActivityService.AddShowcaseEntry:input_type -> fitglue.services.activity.AddShowcaseEntryRequest
+	24, // 36: fitglue.services.activity.ActivityService.RemoveShowcaseEntry:input_type -> fitglue.services.activity.RemoveShowcaseEntryRequest
+	25, // 37: fitglue.services.activity.ActivityService.GetShowcaseProfilePictureUploadUrl:input_type -> fitglue.services.activity.GetShowcaseProfilePictureUploadUrlRequest
+	36, // 38: fitglue.services.activity.ActivityService.CreateShowcaseEmbedLink:input_type -> fitglue.services.activity.CreateShowcaseEmbedLinkRequest
+	42, // 39: fitglue.services.activity.ActivityService.GetActivity:output_type -> fitglue.models.activity.StandardizedActivity
+	2,  // 40: fitglue.services.activity.ActivityService.ListActivities:output_type -> fitglue.services.activity.ListActivitiesResponse
+	47, // 41: fitglue.services.activity.ActivityService.DeleteActivity:output_type -> google.protobuf.Empty
+	44, // 42: fitglue.services.activity.ActivityService.GetShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
+	6,  // 43: fitglue.services.activity.ActivityService.ListShowcases:output_type -> fitglue.services.activity.ListShowcasesResponse
+	44, // 44: fitglue.services.activity.ActivityService.CreateShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
+	44, // 45: fitglue.services.activity.ActivityService.UpdateShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
+	47, // 46: fitglue.services.activity.ActivityService.DeleteShowcase:output_type -> google.protobuf.Empty
+	11, // 47: fitglue.services.activity.ActivityService.ExportData:output_type -> fitglue.services.activity.ExportDataResponse
+	42, // 48: fitglue.services.activity.ActivityService.ParseFitFile:output_type -> fitglue.models.activity.StandardizedActivity
+	45, // 49: fitglue.services.activity.ActivityService.GetShowcasePreferences:output_type -> fitglue.models.activity.ShowcaseProfile
+	45, // 50: fitglue.services.activity.ActivityService.UpdateShowcasePreferences:output_type -> fitglue.models.activity.ShowcaseProfile
+	47, // 51: fitglue.services.activity.ActivityService.GenerateShowcaseImages:output_type -> google.protobuf.Empty
+	44, // 52: fitglue.services.activity.ActivityService.GetPublicShowcase:output_type -> fitglue.models.activity.ShowcasedActivity
+	28, // 53: fitglue.services.activity.ActivityService.GetPublicShowcaseProfile:output_type -> fitglue.services.activity.GetPublicShowcaseProfileResponse
+	30, // 54: fitglue.services.activity.ActivityService.GetPublicShowcaseRouteStats:output_type -> fitglue.services.activity.GetPublicShowcaseRouteStatsResponse
+	32, // 55: fitglue.services.activity.ActivityService.GetPublicShowcaseFeed:output_type -> fitglue.services.activity.GetPublicShowcaseFeedResponse
+	48, // 56: fitglue.services.activity.ActivityService.GetPublicShowcaseComparison:output_type -> fitglue.models.activity.ShowcaseComparison
+	35, // 57: fitglue.services.activity.ActivityService.GetPublicShowcaseEmbed:output_type -> fitglue.services.activity.GetPublicShowcaseEmbedResponse
+	39, // 58: fitglue.services.activity.ActivityService.RefreshShowcaseComparisons:output_type -> fitglue.services.activity.RefreshShowcaseComparisonsResponse
+	41, // 59: fitglue.services.activity.ActivityService.GetActivityStats:output_type -> fitglue.services.activity.GetActivityStatsResponse
+	18, // 60: fitglue.services.activity.ActivityService.GetShowcaseSettings:output_type -> fitglue.services.activity.GetShowcaseSettingsResponse
+	45, // 61: fitglue.services.activity.ActivityService.UpdateShowcaseSettings:output_type -> fitglue.models.activity.ShowcaseProfile
+	22, // 62: fitglue.services.activity.ActivityService.UpdateShowcaseSlug:output_type -> fitglue.services.activity.UpdateShowcaseSlugResponse
+	47, // 63: fitglue.services.activity.ActivityService.AddShowcaseEntry:output_type -> google.protobuf.Empty
+	47, // 64: fitglue.services.activity.ActivityService.RemoveShowcaseEntry:output_type -> google.protobuf.Empty
+	26, // 65: fitglue.services.activity.ActivityService.GetShowcaseProfilePictureUploadUrl:output_type -> fitglue.services.activity.GetShowcaseProfilePictureUploadUrlResponse
+	37, // 66: fitglue.services.activity.ActivityService.CreateShowcaseEmbedLink:output_type -> fitglue.services.activity.CreateShowcaseEmbedLinkResponse
+	39, // [39:67] is the sub-list for method output_type
+	11, // [11:39] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_services_activity_activity_proto_init() }
@@ -1960,7 +2630,7 @@ func file_services_activity_activity_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_activity_activity_proto_rawDesc), len(file_services_activity_activity_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
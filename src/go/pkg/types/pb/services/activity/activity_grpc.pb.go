@@ -36,6 +36,11 @@ const (
 	ActivityService_GenerateShowcaseImages_FullMethodName             = "/fitglue.services.activity.ActivityService/GenerateShowcaseImages"
 	ActivityService_GetPublicShowcase_FullMethodName                  = "/fitglue.services.activity.ActivityService/GetPublicShowcase"
 	ActivityService_GetPublicShowcaseProfile_FullMethodName           = "/fitglue.services.activity.ActivityService/GetPublicShowcaseProfile"
+	ActivityService_GetPublicShowcaseRouteStats_FullMethodName        = "/fitglue.services.activity.ActivityService/GetPublicShowcaseRouteStats"
+	ActivityService_GetPublicShowcaseFeed_FullMethodName              = "/fitglue.services.activity.ActivityService/GetPublicShowcaseFeed"
+	ActivityService_GetPublicShowcaseComparison_FullMethodName        = "/fitglue.services.activity.ActivityService/GetPublicShowcaseComparison"
+	ActivityService_GetPublicShowcaseEmbed_FullMethodName             = "/fitglue.services.activity.ActivityService/GetPublicShowcaseEmbed"
+	ActivityService_RefreshShowcaseComparisons_FullMethodName         = "/fitglue.services.activity.ActivityService/RefreshShowcaseComparisons"
 	ActivityService_GetActivityStats_FullMethodName                   = "/fitglue.services.activity.ActivityService/GetActivityStats"
 	ActivityService_GetShowcaseSettings_FullMethodName                = "/fitglue.services.activity.ActivityService/GetShowcaseSettings"
 	ActivityService_UpdateShowcaseSettings_FullMethodName             = "/fitglue.services.activity.ActivityService/UpdateShowcaseSettings"
@@ -43,6 +48,7 @@ const (
 	ActivityService_AddShowcaseEntry_FullMethodName                   = "/fitglue.services.activity.ActivityService/AddShowcaseEntry"
 	ActivityService_RemoveShowcaseEntry_FullMethodName                = "/fitglue.services.activity.ActivityService/RemoveShowcaseEntry"
 	ActivityService_GetShowcaseProfilePictureUploadUrl_FullMethodName = "/fitglue.services.activity.ActivityService/GetShowcaseProfilePictureUploadUrl"
+	ActivityService_CreateShowcaseEmbedLink_FullMethodName            = "/fitglue.services.activity.ActivityService/CreateShowcaseEmbedLink"
 )
 
 // ActivityServiceClient is the client API for ActivityService service.
@@ -64,6 +70,14 @@ type ActivityServiceClient interface {
 	GenerateShowcaseImages(ctx context.Context, in *GenerateShowcaseImagesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetPublicShowcase(ctx context.Context, in *GetPublicShowcaseRequest, opts ...grpc.CallOption) (*activity.ShowcasedActivity, error)
 	GetPublicShowcaseProfile(ctx context.Context, in *GetPublicShowcaseProfileRequest, opts ...grpc.CallOption) (*GetPublicShowcaseProfileResponse, error)
+	GetPublicShowcaseRouteStats(ctx context.Context, in *GetPublicShowcaseRouteStatsRequest, opts ...grpc.CallOption) (*GetPublicShowcaseRouteStatsResponse, error)
+	GetPublicShowcaseFeed(ctx context.Context, in *GetPublicShowcaseFeedRequest, opts ...grpc.CallOption) (*GetPublicShowcaseFeedResponse, error)
+	GetPublicShowcaseComparison(ctx context.Context, in *GetPublicShowcaseComparisonRequest, opts ...grpc.CallOption) (*activity.ShowcaseComparison, error)
+	// Small SVG or HTML card of a profile activity for embedding in other sites.
+	// Hidden profiles are only served through links from CreateShowcaseEmbedLink.
+	GetPublicShowcaseEmbed(ctx context.Context, in *GetPublicShowcaseEmbedRequest, opts ...grpc.CallOption) (*GetPublicShowcaseEmbedResponse, error)
+	// Recomputes every stored comparison; run nightly by Cloud Scheduler
+	RefreshShowcaseComparisons(ctx context.Context, in *RefreshShowcaseComparisonsRequest, opts ...grpc.CallOption) (*RefreshShowcaseComparisonsResponse, error)
 	GetActivityStats(ctx context.Context, in *GetActivityStatsRequest, opts ...grpc.CallOption) (*GetActivityStatsResponse, error)
 	// Showcase Settings Management (profile, entries, picture, slug)
 	GetShowcaseSettings(ctx context.Context, in *GetShowcaseSettingsRequest, opts ...grpc.CallOption) (*GetShowcaseSettingsResponse, error)
@@ -72,6 +86,8 @@ type ActivityServiceClient interface {
 	AddShowcaseEntry(ctx context.Context, in *AddShowcaseEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveShowcaseEntry(ctx context.Context, in *RemoveShowcaseEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetShowcaseProfilePictureUploadUrl(ctx context.Context, in *GetShowcaseProfilePictureUploadUrlRequest, opts ...grpc.CallOption) (*GetShowcaseProfilePictureUploadUrlResponse, error)
+	// Signs an embed link that works while the profile is hidden
+	CreateShowcaseEmbedLink(ctx context.Context, in *CreateShowcaseEmbedLinkRequest, opts ...grpc.CallOption) (*CreateShowcaseEmbedLinkResponse, error)
 }
 
 type activityServiceClient struct {
@@ -232,6 +248,56 @@ func (c *activityServiceClient) GetPublicShowcaseProfile(ctx context.Context, in
 	return out, nil
 }
 
+func (c *activityServiceClient) GetPublicShowcaseRouteStats(ctx context.Context, in *GetPublicShowcaseRouteStatsRequest, opts ...grpc.CallOption) (*GetPublicShowcaseRouteStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicShowcaseRouteStatsResponse)
+	err := c.cc.Invoke(ctx, ActivityService_GetPublicShowcaseRouteStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) GetPublicShowcaseFeed(ctx context.Context, in *GetPublicShowcaseFeedRequest, opts ...grpc.CallOption) (*GetPublicShowcaseFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicShowcaseFeedResponse)
+	err := c.cc.Invoke(ctx, ActivityService_GetPublicShowcaseFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) GetPublicShowcaseComparison(ctx context.Context, in *GetPublicShowcaseComparisonRequest, opts ...grpc.CallOption) (*activity.ShowcaseComparison, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(activity.ShowcaseComparison)
+	err := c.cc.Invoke(ctx, ActivityService_GetPublicShowcaseComparison_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) GetPublicShowcaseEmbed(ctx context.Context, in *GetPublicShowcaseEmbedRequest, opts ...grpc.CallOption) (*GetPublicShowcaseEmbedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicShowcaseEmbedResponse)
+	err := c.cc.Invoke(ctx, ActivityService_GetPublicShowcaseEmbed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) RefreshShowcaseComparisons(ctx context.Context, in *RefreshShowcaseComparisonsRequest, opts ...grpc.CallOption) (*RefreshShowcaseComparisonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshShowcaseComparisonsResponse)
+	err := c.cc.Invoke(ctx, ActivityService_RefreshShowcaseComparisons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *activityServiceClient) GetActivityStats(ctx context.Context, in *GetActivityStatsRequest, opts ...grpc.CallOption) (*GetActivityStatsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetActivityStatsResponse)
@@ -302,6 +368,16 @@ func (c *activityServiceClient) GetShowcaseProfilePictureUploadUrl(ctx context.C
 	return out, nil
 }
 
+func (c *activityServiceClient) CreateShowcaseEmbedLink(ctx context.Context, in *CreateShowcaseEmbedLinkRequest, opts ...grpc.CallOption) (*CreateShowcaseEmbedLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateShowcaseEmbedLinkResponse)
+	err := c.cc.Invoke(ctx, ActivityService_CreateShowcaseEmbedLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ActivityServiceServer is the server API for ActivityService service.
 // All implementations must embed UnimplementedActivityServiceServer
 // for forward compatibility.
@@ -321,6 +397,14 @@ type ActivityServiceServer interface {
 	GenerateShowcaseImages(context.Context, *GenerateShowcaseImagesRequest) (*emptypb.Empty, error)
 	GetPublicShowcase(context.Context, *GetPublicShowcaseRequest) (*activity.ShowcasedActivity, error)
 	GetPublicShowcaseProfile(context.Context, *GetPublicShowcaseProfileRequest) (*GetPublicShowcaseProfileResponse, error)
+	GetPublicShowcaseRouteStats(context.Context, *GetPublicShowcaseRouteStatsRequest) (*GetPublicShowcaseRouteStatsResponse, error)
+	GetPublicShowcaseFeed(context.Context, *GetPublicShowcaseFeedRequest) (*GetPublicShowcaseFeedResponse, error)
+	GetPublicShowcaseComparison(context.Context, *GetPublicShowcaseComparisonRequest) (*activity.ShowcaseComparison, error)
+	// Small SVG or HTML card of a profile activity for embedding in other sites.
+	// Hidden profiles are only served through links from CreateShowcaseEmbedLink.
+	GetPublicShowcaseEmbed(context.Context, *GetPublicShowcaseEmbedRequest) (*GetPublicShowcaseEmbedResponse, error)
+	// Recomputes every stored comparison; run nightly by Cloud Scheduler
+	RefreshShowcaseComparisons(context.Context, *RefreshShowcaseComparisonsRequest) (*RefreshShowcaseComparisonsResponse, error)
 	GetActivityStats(context.Context, *GetActivityStatsRequest) (*GetActivityStatsResponse, error)
 	// Showcase Settings Management (profile, entries, picture, slug)
 	GetShowcaseSettings(context.Context, *GetShowcaseSettingsRequest) (*GetShowcaseSettingsResponse, error)
@@ -329,6 +413,8 @@ type ActivityServiceServer interface {
 	AddShowcaseEntry(context.Context, *AddShowcaseEntryRequest) (*emptypb.Empty, error)
 	RemoveShowcaseEntry(context.Context, *RemoveShowcaseEntryRequest) (*emptypb.Empty, error)
 	GetShowcaseProfilePictureUploadUrl(context.Context, *GetShowcaseProfilePictureUploadUrlRequest) (*GetShowcaseProfilePictureUploadUrlResponse, error)
+	// Signs an embed link that works while the profile is hidden
+	CreateShowcaseEmbedLink(context.Context, *CreateShowcaseEmbedLinkRequest) (*CreateShowcaseEmbedLinkResponse, error)
 	mustEmbedUnimplementedActivityServiceServer()
 }
 
@@ -384,6 +470,21 @@ func (UnimplementedActivityServiceServer) GetPublicShowcase(context.Context, *Ge
 func (UnimplementedActivityServiceServer) GetPublicShowcaseProfile(context.Context, *GetPublicShowcaseProfileRequest) (*GetPublicShowcaseProfileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPublicShowcaseProfile not implemented")
 }
+func (UnimplementedActivityServiceServer) GetPublicShowcaseRouteStats(context.Context, *GetPublicShowcaseRouteStatsRequest) (*GetPublicShowcaseRouteStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPublicShowcaseRouteStats not implemented")
+}
+func (UnimplementedActivityServiceServer) GetPublicShowcaseFeed(context.Context, *GetPublicShowcaseFeedRequest) (*GetPublicShowcaseFeedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPublicShowcaseFeed not implemented")
+}
+func (UnimplementedActivityServiceServer) GetPublicShowcaseComparison(context.Context, *GetPublicShowcaseComparisonRequest) (*activity.ShowcaseComparison, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPublicShowcaseComparison not implemented")
+}
+func (UnimplementedActivityServiceServer) GetPublicShowcaseEmbed(context.Context, *GetPublicShowcaseEmbedRequest) (*GetPublicShowcaseEmbedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPublicShowcaseEmbed not implemented")
+}
+func (UnimplementedActivityServiceServer) RefreshShowcaseComparisons(context.Context, *RefreshShowcaseComparisonsRequest) (*RefreshShowcaseComparisonsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefreshShowcaseComparisons not implemented")
+}
 func (UnimplementedActivityServiceServer) GetActivityStats(context.Context, *GetActivityStatsRequest) (*GetActivityStatsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetActivityStats not implemented")
 }
@@ -405,6 +506,9 @@ func (UnimplementedActivityServiceServer) RemoveShowcaseEntry(context.Context, *
 func (UnimplementedActivityServiceServer) GetShowcaseProfilePictureUploadUrl(context.Context, *GetShowcaseProfilePictureUploadUrlRequest) (*GetShowcaseProfilePictureUploadUrlResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetShowcaseProfilePictureUploadUrl not implemented")
 }
+func (UnimplementedActivityServiceServer) CreateShowcaseEmbedLink(context.Context, *CreateShowcaseEmbedLinkRequest) (*CreateShowcaseEmbedLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateShowcaseEmbedLink not implemented")
+}
 func (UnimplementedActivityServiceServer) mustEmbedUnimplementedActivityServiceServer() {}
 func (UnimplementedActivityServiceServer) testEmbeddedByValue()                         {}
 
@@ -696,6 +800,96 @@ func _ActivityService_GetPublicShowcaseProfile_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ActivityService_GetPublicShowcaseRouteStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicShowcaseRouteStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetPublicShowcaseRouteStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetPublicShowcaseRouteStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetPublicShowcaseRouteStats(ctx, req.(*GetPublicShowcaseRouteStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_GetPublicShowcaseFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicShowcaseFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetPublicShowcaseFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetPublicShowcaseFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetPublicShowcaseFeed(ctx, req.(*GetPublicShowcaseFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_GetPublicShowcaseComparison_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicShowcaseComparisonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetPublicShowcaseComparison(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetPublicShowcaseComparison_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetPublicShowcaseComparison(ctx, req.(*GetPublicShowcaseComparisonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_GetPublicShowcaseEmbed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicShowcaseEmbedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetPublicShowcaseEmbed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetPublicShowcaseEmbed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetPublicShowcaseEmbed(ctx, req.(*GetPublicShowcaseEmbedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_RefreshShowcaseComparisons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshShowcaseComparisonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).RefreshShowcaseComparisons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_RefreshShowcaseComparisons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).RefreshShowcaseComparisons(ctx, req.(*RefreshShowcaseComparisonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ActivityService_GetActivityStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetActivityStatsRequest)
 	if err := dec(in); err != nil {
@@ -822,6 +1016,24 @@ func _ActivityService_GetShowcaseProfilePictureUploadUrl_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ActivityService_CreateShowcaseEmbedLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShowcaseEmbedLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).CreateShowcaseEmbedLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_CreateShowcaseEmbedLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).CreateShowcaseEmbedLink(ctx, req.(*CreateShowcaseEmbedLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ActivityService_ServiceDesc is the grpc.ServiceDesc for ActivityService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -889,6 +1101,26 @@ var ActivityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPublicShowcaseProfile",
 			Handler:    _ActivityService_GetPublicShowcaseProfile_Handler,
 		},
+		{
+			MethodName: "GetPublicShowcaseRouteStats",
+			Handler:    _ActivityService_GetPublicShowcaseRouteStats_Handler,
+		},
+		{
+			MethodName: "GetPublicShowcaseFeed",
+			Handler:    _ActivityService_GetPublicShowcaseFeed_Handler,
+		},
+		{
+			MethodName: "GetPublicShowcaseComparison",
+			Handler:    _ActivityService_GetPublicShowcaseComparison_Handler,
+		},
+		{
+			MethodName: "GetPublicShowcaseEmbed",
+			Handler:    _ActivityService_GetPublicShowcaseEmbed_Handler,
+		},
+		{
+			MethodName: "RefreshShowcaseComparisons",
+			Handler:    _ActivityService_RefreshShowcaseComparisons_Handler,
+		},
 		{
 			MethodName: "GetActivityStats",
 			Handler:    _ActivityService_GetActivityStats_Handler,
@@ -917,6 +1149,10 @@ var ActivityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetShowcaseProfilePictureUploadUrl",
 			Handler:    _ActivityService_GetShowcaseProfilePictureUploadUrl_Handler,
 		},
+		{
+			MethodName: "CreateShowcaseEmbedLink",
+			Handler:    _ActivityService_CreateShowcaseEmbedLink_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/activity/activity.proto",
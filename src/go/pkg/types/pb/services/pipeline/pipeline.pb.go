@@ -7,11 +7,15 @@
 package pipeline
 
 import (
+	activity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	events "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	pipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	plugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -27,11 +31,12 @@ const (
 // Admin pipeline runs
 type AdminListPipelineRunsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // PipelineRunStatus name, with or without the prefix
 	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional filter
 	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	ErrorsOnly    bool                   `protobuf:"varint,6,opt,name=errors_only,json=errorsOnly,proto3" json:"errors_only,omitempty"` // FAILED and PARTIAL runs only; status is ignored
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -101,9 +106,16 @@ func (x *AdminListPipelineRunsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *AdminListPipelineRunsRequest) GetErrorsOnly() bool {
+	if x != nil {
+		return x.ErrorsOnly
+	}
+	return false
+}
+
 type AdminListPipelineRunsResponse struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Runs          []*pipeline.PipelineRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	Runs          []*pipeline.PipelineRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"` // Newest first, with user_id set
 	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -153,27 +165,32 @@ func (x *AdminListPipelineRunsResponse) GetNextPageToken() string {
 	return ""
 }
 
-type ListPipelinesRequest struct {
+type AdminForceCompletePipelineRunRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PipelineRunId string                 `protobuf:"bytes,2,opt,name=pipeline_run_id,json=pipelineRunId,proto3" json:"pipeline_run_id,omitempty"`
+	// SYNCED, FAILED or SKIPPED; unspecified means SYNCED
+	Status        pipeline.PipelineRunStatus `protobuf:"varint,3,opt,name=status,proto3,enum=fitglue.models.pipeline.PipelineRunStatus" json:"status,omitempty"`
+	Reason        string                     `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	AdminUserId   string                     `protobuf:"bytes,5,opt,name=admin_user_id,json=adminUserId,proto3" json:"admin_user_id,omitempty"` // Set by api-admin from the admin's token
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPipelinesRequest) Reset() {
-	*x = ListPipelinesRequest{}
+func (x *AdminForceCompletePipelineRunRequest) Reset() {
+	*x = AdminForceCompletePipelineRunRequest{}
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPipelinesRequest) String() string {
+func (x *AdminForceCompletePipelineRunRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPipelinesRequest) ProtoMessage() {}
+func (*AdminForceCompletePipelineRunRequest) ProtoMessage() {}
 
-func (x *ListPipelinesRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminForceCompletePipelineRunRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -185,39 +202,67 @@ func (x *ListPipelinesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPipelinesRequest.ProtoReflect.Descriptor instead.
-func (*ListPipelinesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminForceCompletePipelineRunRequest.ProtoReflect.Descriptor instead.
+func (*AdminForceCompletePipelineRunRequest) Descriptor() ([]byte, []int) {
 	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ListPipelinesRequest) GetUserId() string {
+func (x *AdminForceCompletePipelineRunRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type ListPipelinesResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Pipelines     []*pipeline.PipelineConfig `protobuf:"bytes,1,rep,name=pipelines,proto3" json:"pipelines,omitempty"`
+func (x *AdminForceCompletePipelineRunRequest) GetPipelineRunId() string {
+	if x != nil {
+		return x.PipelineRunId
+	}
+	return ""
+}
+
+func (x *AdminForceCompletePipelineRunRequest) GetStatus() pipeline.PipelineRunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return pipeline.PipelineRunStatus(0)
+}
+
+func (x *AdminForceCompletePipelineRunRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminForceCompletePipelineRunRequest) GetAdminUserId() string {
+	if x != nil {
+		return x.AdminUserId
+	}
+	return ""
+}
+
+// Admin provider circuits
+type AdminListProviderCircuitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPipelinesResponse) Reset() {
-	*x = ListPipelinesResponse{}
+func (x *AdminListProviderCircuitsRequest) Reset() {
+	*x = AdminListProviderCircuitsRequest{}
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPipelinesResponse) String() string {
+func (x *AdminListProviderCircuitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPipelinesResponse) ProtoMessage() {}
+func (*AdminListProviderCircuitsRequest) ProtoMessage() {}
 
-func (x *ListPipelinesResponse) ProtoReflect() protoreflect.Message {
+func (x *AdminListProviderCircuitsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -229,40 +274,32 @@ func (x *ListPipelinesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPipelinesResponse.ProtoReflect.Descriptor instead.
-func (*ListPipelinesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminListProviderCircuitsRequest.ProtoReflect.Descriptor instead.
+func (*AdminListProviderCircuitsRequest) Descriptor() ([]byte, []int) {
 	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListPipelinesResponse) GetPipelines() []*pipeline.PipelineConfig {
-	if x != nil {
-		return x.Pipelines
-	}
-	return nil
-}
-
-type GetPipelineRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PipelineId    string                 `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+type AdminListProviderCircuitsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Circuits      []*pipeline.ProviderCircuit `protobuf:"bytes,1,rep,name=circuits,proto3" json:"circuits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPipelineRequest) Reset() {
-	*x = GetPipelineRequest{}
+func (x *AdminListProviderCircuitsResponse) Reset() {
+	*x = AdminListProviderCircuitsResponse{}
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPipelineRequest) String() string {
+func (x *AdminListProviderCircuitsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPipelineRequest) ProtoMessage() {}
+func (*AdminListProviderCircuitsResponse) ProtoMessage() {}
 
-func (x *GetPipelineRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminListProviderCircuitsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -274,47 +311,42 @@ func (x *GetPipelineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPipelineRequest.ProtoReflect.Descriptor instead.
-func (*GetPipelineRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminListProviderCircuitsResponse.ProtoReflect.Descriptor instead.
+func (*AdminListProviderCircuitsResponse) Descriptor() ([]byte, []int) {
 	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetPipelineRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *GetPipelineRequest) GetPipelineId() string {
+func (x *AdminListProviderCircuitsResponse) GetCircuits() []*pipeline.ProviderCircuit {
 	if x != nil {
-		return x.PipelineId
+		return x.Circuits
 	}
-	return ""
+	return nil
 }
 
-type CreatePipelineRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	UserId        string                   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Pipeline      *pipeline.PipelineConfig `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+type AdminSetProviderCircuitModeRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	ProviderType  plugin.EnricherProviderType  `protobuf:"varint,1,opt,name=provider_type,json=providerType,proto3,enum=fitglue.models.plugin.EnricherProviderType" json:"provider_type,omitempty"`
+	Mode          pipeline.ProviderCircuitMode `protobuf:"varint,2,opt,name=mode,proto3,enum=fitglue.models.pipeline.ProviderCircuitMode" json:"mode,omitempty"`
+	Reason        string                       `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	AdminUserId   string                       `protobuf:"bytes,4,opt,name=admin_user_id,json=adminUserId,proto3" json:"admin_user_id,omitempty"` // Set by api-admin from the admin's token
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePipelineRequest) Reset() {
-	*x = CreatePipelineRequest{}
+func (x *AdminSetProviderCircuitModeRequest) Reset() {
+	*x = AdminSetProviderCircuitModeRequest{}
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePipelineRequest) String() string {
+func (x *AdminSetProviderCircuitModeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePipelineRequest) ProtoMessage() {}
+func (*AdminSetProviderCircuitModeRequest) ProtoMessage() {}
 
-func (x *CreatePipelineRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminSetProviderCircuitModeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -326,48 +358,61 @@ func (x *CreatePipelineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePipelineRequest.ProtoReflect.Descriptor instead.
-func (*CreatePipelineRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminSetProviderCircuitModeRequest.ProtoReflect.Descriptor instead.
+func (*AdminSetProviderCircuitModeRequest) Descriptor() ([]byte, []int) {
 	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreatePipelineRequest) GetUserId() string {
+func (x *AdminSetProviderCircuitModeRequest) GetProviderType() plugin.EnricherProviderType {
 	if x != nil {
-		return x.UserId
+		return x.ProviderType
+	}
+	return plugin.EnricherProviderType(0)
+}
+
+func (x *AdminSetProviderCircuitModeRequest) GetMode() pipeline.ProviderCircuitMode {
+	if x != nil {
+		return x.Mode
+	}
+	return pipeline.ProviderCircuitMode(0)
+}
+
+func (x *AdminSetProviderCircuitModeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *CreatePipelineRequest) GetPipeline() *pipeline.PipelineConfig {
+func (x *AdminSetProviderCircuitModeRequest) GetAdminUserId() string {
 	if x != nil {
-		return x.Pipeline
+		return x.AdminUserId
 	}
-	return nil
+	return ""
 }
 
-type UpdatePipelineRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	UserId        string                   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PipelineId    string                   `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
-	Pipeline      *pipeline.PipelineConfig `protobuf:"bytes,3,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+type AdminListProviderCircuitChangesRequest struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	ProviderType  plugin.EnricherProviderType `protobuf:"varint,1,opt,name=provider_type,json=providerType,proto3,enum=fitglue.models.plugin.EnricherProviderType" json:"provider_type,omitempty"`
+	Limit         int32                       `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePipelineRequest) Reset() {
-	*x = UpdatePipelineRequest{}
+func (x *AdminListProviderCircuitChangesRequest) Reset() {
+	*x = AdminListProviderCircuitChangesRequest{}
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePipelineRequest) String() string {
+func (x *AdminListProviderCircuitChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePipelineRequest) ProtoMessage() {}
+func (*AdminListProviderCircuitChangesRequest) ProtoMessage() {}
 
-func (x *UpdatePipelineRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminListProviderCircuitChangesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -379,54 +424,46 @@ func (x *UpdatePipelineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePipelineRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePipelineRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminListProviderCircuitChangesRequest.ProtoReflect.Descriptor instead.
+func (*AdminListProviderCircuitChangesRequest) Descriptor() ([]byte, []int) {
 	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UpdatePipelineRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *UpdatePipelineRequest) GetPipelineId() string {
+func (x *AdminListProviderCircuitChangesRequest) GetProviderType() plugin.EnricherProviderType {
 	if x != nil {
-		return x.PipelineId
+		return x.ProviderType
 	}
-	return ""
+	return plugin.EnricherProviderType(0)
 }
 
-func (x *UpdatePipelineRequest) GetPipeline() *pipeline.PipelineConfig {
+func (x *AdminListProviderCircuitChangesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Pipeline
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type DeletePipelineRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PipelineId    string                 `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+type AdminListProviderCircuitChangesResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Changes       []*pipeline.ProviderCircuitChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePipelineRequest) Reset() {
-	*x = DeletePipelineRequest{}
+func (x *AdminListProviderCircuitChangesResponse) Reset() {
+	*x = AdminListProviderCircuitChangesResponse{}
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePipelineRequest) String() string {
+func (x *AdminListProviderCircuitChangesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePipelineRequest) ProtoMessage() {}
+func (*AdminListProviderCircuitChangesResponse) ProtoMessage() {}
 
-func (x *DeletePipelineRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminListProviderCircuitChangesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -438,48 +475,42 @@ func (x *DeletePipelineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePipelineRequest.ProtoReflect.Descriptor instead.
-func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminListProviderCircuitChangesResponse.ProtoReflect.Descriptor instead.
+func (*AdminListProviderCircuitChangesResponse) Descriptor() ([]byte, []int) {
 	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DeletePipelineRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *DeletePipelineRequest) GetPipelineId() string {
+func (x *AdminListProviderCircuitChangesResponse) GetChanges() []*pipeline.ProviderCircuitChange {
 	if x != nil {
-		return x.PipelineId
+		return x.Changes
 	}
-	return ""
+	return nil
 }
 
-type SubmitInputRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PendingInputId string                 `protobuf:"bytes,2,opt,name=pending_input_id,json=pendingInputId,proto3" json:"pending_input_id,omitempty"`
-	InputData      map[string]string      `protobuf:"bytes,3,rep,name=input_data,json=inputData,proto3" json:"input_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Admin failed events
+type AdminListFailedEventsRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	UserId        string                     `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        pipeline.FailedEventStatus `protobuf:"varint,2,opt,name=status,proto3,enum=fitglue.models.pipeline.FailedEventStatus" json:"status,omitempty"` // Unspecified lists every status
+	Limit         int32                      `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitInputRequest) Reset() {
-	*x = SubmitInputRequest{}
+func (x *AdminListFailedEventsRequest) Reset() {
+	*x = AdminListFailedEventsRequest{}
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitInputRequest) String() string {
+func (x *AdminListFailedEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitInputRequest) ProtoMessage() {}
+func (*AdminListFailedEventsRequest) ProtoMessage() {}
 
-func (x *SubmitInputRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminListFailedEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -491,53 +522,53 @@ func (x *SubmitInputRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitInputRequest.ProtoReflect.Descriptor instead.
-func (*SubmitInputRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminListFailedEventsRequest.ProtoReflect.Descriptor instead.
+func (*AdminListFailedEventsRequest) Descriptor() ([]byte, []int) {
 	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SubmitInputRequest) GetUserId() string {
+func (x *AdminListFailedEventsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *SubmitInputRequest) GetPendingInputId() string {
+func (x *AdminListFailedEventsRequest) GetStatus() pipeline.FailedEventStatus {
 	if x != nil {
-		return x.PendingInputId
+		return x.Status
 	}
-	return ""
+	return pipeline.FailedEventStatus(0)
 }
 
-func (x *SubmitInputRequest) GetInputData() map[string]string {
+func (x *AdminListFailedEventsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.InputData
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type ListPendingInputsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+type AdminListFailedEventsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Events        []*pipeline.FailedEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPendingInputsRequest) Reset() {
-	*x = ListPendingInputsRequest{}
+func (x *AdminListFailedEventsResponse) Reset() {
+	*x = AdminListFailedEventsResponse{}
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPendingInputsRequest) String() string {
+func (x *AdminListFailedEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPendingInputsRequest) ProtoMessage() {}
+func (*AdminListFailedEventsResponse) ProtoMessage() {}
 
-func (x *ListPendingInputsRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminListFailedEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -549,39 +580,40 @@ func (x *ListPendingInputsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPendingInputsRequest.ProtoReflect.Descriptor instead.
-func (*ListPendingInputsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminListFailedEventsResponse.ProtoReflect.Descriptor instead.
+func (*AdminListFailedEventsResponse) Descriptor() ([]byte, []int) {
 	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ListPendingInputsRequest) GetUserId() string {
+func (x *AdminListFailedEventsResponse) GetEvents() []*pipeline.FailedEvent {
 	if x != nil {
-		return x.UserId
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-type ListPendingInputsResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Inputs        []*pipeline.PendingInput `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+type AdminRedriveFailedEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventIds      []string               `protobuf:"bytes,2,rep,name=event_ids,json=eventIds,proto3" json:"event_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPendingInputsResponse) Reset() {
-	*x = ListPendingInputsResponse{}
+func (x *AdminRedriveFailedEventsRequest) Reset() {
+	*x = AdminRedriveFailedEventsRequest{}
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPendingInputsResponse) String() string {
+func (x *AdminRedriveFailedEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPendingInputsResponse) ProtoMessage() {}
+func (*AdminRedriveFailedEventsRequest) ProtoMessage() {}
 
-func (x *ListPendingInputsResponse) ProtoReflect() protoreflect.Message {
+func (x *AdminRedriveFailedEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -593,40 +625,46 @@ func (x *ListPendingInputsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPendingInputsResponse.ProtoReflect.Descriptor instead.
-func (*ListPendingInputsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminRedriveFailedEventsRequest.ProtoReflect.Descriptor instead.
+func (*AdminRedriveFailedEventsRequest) Descriptor() ([]byte, []int) {
 	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListPendingInputsResponse) GetInputs() []*pipeline.PendingInput {
+func (x *AdminRedriveFailedEventsRequest) GetUserId() string {
 	if x != nil {
-		return x.Inputs
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdminRedriveFailedEventsRequest) GetEventIds() []string {
+	if x != nil {
+		return x.EventIds
 	}
 	return nil
 }
 
-type ResolvePendingInputRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PendingInputId string                 `protobuf:"bytes,2,opt,name=pending_input_id,json=pendingInputId,proto3" json:"pending_input_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type AdminRedriveFailedEventsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Events        []*pipeline.FailedEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // As stored after the redrive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResolvePendingInputRequest) Reset() {
-	*x = ResolvePendingInputRequest{}
+func (x *AdminRedriveFailedEventsResponse) Reset() {
+	*x = AdminRedriveFailedEventsResponse{}
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolvePendingInputRequest) String() string {
+func (x *AdminRedriveFailedEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolvePendingInputRequest) ProtoMessage() {}
+func (*AdminRedriveFailedEventsResponse) ProtoMessage() {}
 
-func (x *ResolvePendingInputRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminRedriveFailedEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -638,51 +676,39 @@ func (x *ResolvePendingInputRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolvePendingInputRequest.ProtoReflect.Descriptor instead.
-func (*ResolvePendingInputRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminRedriveFailedEventsResponse.ProtoReflect.Descriptor instead.
+func (*AdminRedriveFailedEventsResponse) Descriptor() ([]byte, []int) {
 	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ResolvePendingInputRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *ResolvePendingInputRequest) GetPendingInputId() string {
+func (x *AdminRedriveFailedEventsResponse) GetEvents() []*pipeline.FailedEvent {
 	if x != nil {
-		return x.PendingInputId
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-type RepostActivityRequest struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ActivityId string                 `protobuf:"bytes,2,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
-	// Repost mode: "missed-destination", "retry-destination", "full-pipeline"
-	Mode string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
-	// Target destination (required for missed-destination and retry-destination modes)
-	Destination   string `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+type ListPipelinesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RepostActivityRequest) Reset() {
-	*x = RepostActivityRequest{}
+func (x *ListPipelinesRequest) Reset() {
+	*x = ListPipelinesRequest{}
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RepostActivityRequest) String() string {
+func (x *ListPipelinesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepostActivityRequest) ProtoMessage() {}
+func (*ListPipelinesRequest) ProtoMessage() {}
 
-func (x *RepostActivityRequest) ProtoReflect() protoreflect.Message {
+func (x *ListPipelinesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_pipeline_pipeline_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -694,62 +720,1555 @@ func (x *RepostActivityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepostActivityRequest.ProtoReflect.Descriptor instead.
-func (*RepostActivityRequest) Descriptor() ([]byte, []int) {
-	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListPipelinesRequest.ProtoReflect.Descriptor instead.
+func (*ListPipelinesRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPipelinesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListPipelinesResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Pipelines     []*pipeline.PipelineConfig `protobuf:"bytes,1,rep,name=pipelines,proto3" json:"pipelines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPipelinesResponse) Reset() {
+	*x = ListPipelinesResponse{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPipelinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPipelinesResponse) ProtoMessage() {}
+
+func (x *ListPipelinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPipelinesResponse.ProtoReflect.Descriptor instead.
+func (*ListPipelinesResponse) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListPipelinesResponse) GetPipelines() []*pipeline.PipelineConfig {
+	if x != nil {
+		return x.Pipelines
+	}
+	return nil
+}
+
+type GetPipelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PipelineId    string                 `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPipelineRequest) Reset() {
+	*x = GetPipelineRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineRequest) ProtoMessage() {}
+
+func (x *GetPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineRequest.ProtoReflect.Descriptor instead.
+func (*GetPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPipelineRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetPipelineRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+type CreatePipelineRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	UserId        string                   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Pipeline      *pipeline.PipelineConfig `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePipelineRequest) Reset() {
+	*x = CreatePipelineRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePipelineRequest) ProtoMessage() {}
+
+func (x *CreatePipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePipelineRequest.ProtoReflect.Descriptor instead.
+func (*CreatePipelineRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreatePipelineRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreatePipelineRequest) GetPipeline() *pipeline.PipelineConfig {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+type UpdatePipelineRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	UserId        string                   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PipelineId    string                   `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	Pipeline      *pipeline.PipelineConfig `protobuf:"bytes,3,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePipelineRequest) Reset() {
+	*x = UpdatePipelineRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePipelineRequest) ProtoMessage() {}
+
+func (x *UpdatePipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePipelineRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePipelineRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdatePipelineRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdatePipelineRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *UpdatePipelineRequest) GetPipeline() *pipeline.PipelineConfig {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+type DeletePipelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PipelineId    string                 `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePipelineRequest) Reset() {
+	*x = DeletePipelineRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePipelineRequest) ProtoMessage() {}
+
+func (x *DeletePipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePipelineRequest.ProtoReflect.Descriptor instead.
+func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeletePipelineRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeletePipelineRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+type SubmitInputRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PendingInputId string                 `protobuf:"bytes,2,opt,name=pending_input_id,json=pendingInputId,proto3" json:"pending_input_id,omitempty"`
+	InputData      map[string]string      `protobuf:"bytes,3,rep,name=input_data,json=inputData,proto3" json:"input_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Let the resumed update overwrite description edits the user made on the
+	// destination. Without it those descriptions are left as they are.
+	ConfirmDescriptionOverwrite bool `protobuf:"varint,4,opt,name=confirm_description_overwrite,json=confirmDescriptionOverwrite,proto3" json:"confirm_description_overwrite,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *SubmitInputRequest) Reset() {
+	*x = SubmitInputRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitInputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitInputRequest) ProtoMessage() {}
+
+func (x *SubmitInputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitInputRequest.ProtoReflect.Descriptor instead.
+func (*SubmitInputRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SubmitInputRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitInputRequest) GetPendingInputId() string {
+	if x != nil {
+		return x.PendingInputId
+	}
+	return ""
+}
+
+func (x *SubmitInputRequest) GetInputData() map[string]string {
+	if x != nil {
+		return x.InputData
+	}
+	return nil
+}
+
+func (x *SubmitInputRequest) GetConfirmDescriptionOverwrite() bool {
+	if x != nil {
+		return x.ConfirmDescriptionOverwrite
+	}
+	return false
+}
+
+type ListPendingInputsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingInputsRequest) Reset() {
+	*x = ListPendingInputsRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingInputsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingInputsRequest) ProtoMessage() {}
+
+func (x *ListPendingInputsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingInputsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingInputsRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListPendingInputsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListPendingInputsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Inputs        []*pipeline.PendingInput `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingInputsResponse) Reset() {
+	*x = ListPendingInputsResponse{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingInputsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingInputsResponse) ProtoMessage() {}
+
+func (x *ListPendingInputsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingInputsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingInputsResponse) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListPendingInputsResponse) GetInputs() []*pipeline.PendingInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+type ResolvePendingInputRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PendingInputId string                 `protobuf:"bytes,2,opt,name=pending_input_id,json=pendingInputId,proto3" json:"pending_input_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResolvePendingInputRequest) Reset() {
+	*x = ResolvePendingInputRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvePendingInputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvePendingInputRequest) ProtoMessage() {}
+
+func (x *ResolvePendingInputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvePendingInputRequest.ProtoReflect.Descriptor instead.
+func (*ResolvePendingInputRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ResolvePendingInputRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ResolvePendingInputRequest) GetPendingInputId() string {
+	if x != nil {
+		return x.PendingInputId
+	}
+	return ""
+}
+
+type RepostActivityRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActivityId string                 `protobuf:"bytes,2,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	// Repost mode: "missed-destination", "retry-destination", "full-pipeline"
+	Mode string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Target destination (required for missed-destination and retry-destination modes)
+	Destination string `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	// Runs the full pipeline as a new, labeled replay run with these parts of
+	// the config replaced (full-pipeline mode only)
+	ReplayOverride *events.ReplayOverride `protobuf:"bytes,5,opt,name=replay_override,json=replayOverride,proto3" json:"replay_override,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RepostActivityRequest) Reset() {
+	*x = RepostActivityRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepostActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepostActivityRequest) ProtoMessage() {}
+
+func (x *RepostActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepostActivityRequest.ProtoReflect.Descriptor instead.
+func (*RepostActivityRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RepostActivityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RepostActivityRequest) GetActivityId() string {
+	if x != nil {
+		return x.ActivityId
+	}
+	return ""
+}
+
+func (x *RepostActivityRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *RepostActivityRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *RepostActivityRequest) GetReplayOverride() *events.ReplayOverride {
+	if x != nil {
+		return x.ReplayOverride
+	}
+	return nil
+}
+
+type ProvisionStarterPipelineRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The user's connected integrations, by UserIntegrations field name
+	// (e.g. "hevy", "strava")
+	Integrations  []string `protobuf:"bytes,2,rep,name=integrations,proto3" json:"integrations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvisionStarterPipelineRequest) Reset() {
+	*x = ProvisionStarterPipelineRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvisionStarterPipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisionStarterPipelineRequest) ProtoMessage() {}
+
+func (x *ProvisionStarterPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisionStarterPipelineRequest.ProtoReflect.Descriptor instead.
+func (*ProvisionStarterPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ProvisionStarterPipelineRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ProvisionStarterPipelineRequest) GetIntegrations() []string {
+	if x != nil {
+		return x.Integrations
+	}
+	return nil
+}
+
+type ProvisionStarterPipelineResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unset when nothing was provisioned
+	Pipeline *pipeline.PipelineConfig `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// The run of the test activity; empty if it couldn't be published
+	TestRunId     string `protobuf:"bytes,2,opt,name=test_run_id,json=testRunId,proto3" json:"test_run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvisionStarterPipelineResponse) Reset() {
+	*x = ProvisionStarterPipelineResponse{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvisionStarterPipelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisionStarterPipelineResponse) ProtoMessage() {}
+
+func (x *ProvisionStarterPipelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisionStarterPipelineResponse.ProtoReflect.Descriptor instead.
+func (*ProvisionStarterPipelineResponse) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ProvisionStarterPipelineResponse) GetPipeline() *pipeline.PipelineConfig {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+func (x *ProvisionStarterPipelineResponse) GetTestRunId() string {
+	if x != nil {
+		return x.TestRunId
+	}
+	return ""
+}
+
+type RetryPipelineRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PipelineRunId string                 `protobuf:"bytes,2,opt,name=pipeline_run_id,json=pipelineRunId,proto3" json:"pipeline_run_id,omitempty"`
+	// Provider names (e.g. "weather") to re-run; the run's other enrichers are
+	// skipped. Empty re-runs every enricher.
+	Enrichers []string `protobuf:"bytes,3,rep,name=enrichers,proto3" json:"enrichers,omitempty"`
+	// When set, runs of other pipelines are not found (client API scoping)
+	PipelineId    string `protobuf:"bytes,4,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryPipelineRunRequest) Reset() {
+	*x = RetryPipelineRunRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPipelineRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPipelineRunRequest) ProtoMessage() {}
+
+func (x *RetryPipelineRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPipelineRunRequest.ProtoReflect.Descriptor instead.
+func (*RetryPipelineRunRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RetryPipelineRunRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RetryPipelineRunRequest) GetPipelineRunId() string {
+	if x != nil {
+		return x.PipelineRunId
+	}
+	return ""
+}
+
+func (x *RetryPipelineRunRequest) GetEnrichers() []string {
+	if x != nil {
+		return x.Enrichers
+	}
+	return nil
+}
+
+func (x *RetryPipelineRunRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+type GetPipelineRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPipelineRunRequest) Reset() {
+	*x = GetPipelineRunRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPipelineRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineRunRequest) ProtoMessage() {}
+
+func (x *GetPipelineRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineRunRequest.ProtoReflect.Descriptor instead.
+func (*GetPipelineRunRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetPipelineRunRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetPipelineRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type GetPipelineRunDebugBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	PipelineId    string                 `protobuf:"bytes,3,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"` // The run must belong to this pipeline
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPipelineRunDebugBundleRequest) Reset() {
+	*x = GetPipelineRunDebugBundleRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPipelineRunDebugBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineRunDebugBundleRequest) ProtoMessage() {}
+
+func (x *GetPipelineRunDebugBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineRunDebugBundleRequest.ProtoReflect.Descriptor instead.
+func (*GetPipelineRunDebugBundleRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetPipelineRunDebugBundleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetPipelineRunDebugBundleRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *GetPipelineRunDebugBundleRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+type ListPipelineRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PipelineId    string                 `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPipelineRunsRequest) Reset() {
+	*x = ListPipelineRunsRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPipelineRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPipelineRunsRequest) ProtoMessage() {}
+
+func (x *ListPipelineRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPipelineRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListPipelineRunsRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListPipelineRunsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListPipelineRunsRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *ListPipelineRunsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPipelineRunsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPipelineRunsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Runs          []*pipeline.PipelineRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPipelineRunsResponse) Reset() {
+	*x = ListPipelineRunsResponse{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPipelineRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPipelineRunsResponse) ProtoMessage() {}
+
+func (x *ListPipelineRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPipelineRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListPipelineRunsResponse) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListPipelineRunsResponse) GetRuns() []*pipeline.PipelineRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *ListPipelineRunsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetEnricherUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PipelineId    string                 `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"` // optional filter
+	MaxRuns       int32                  `protobuf:"varint,3,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`         // most recent runs to analyse; 0 uses the server default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnricherUsageRequest) Reset() {
+	*x = GetEnricherUsageRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnricherUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnricherUsageRequest) ProtoMessage() {}
+
+func (x *GetEnricherUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnricherUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetEnricherUsageRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetEnricherUsageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetEnricherUsageRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *GetEnricherUsageRequest) GetMaxRuns() int32 {
+	if x != nil {
+		return x.MaxRuns
+	}
+	return 0
+}
+
+type GetEnricherUsageResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Enrichers     []*pipeline.EnricherUsage `protobuf:"bytes,1,rep,name=enrichers,proto3" json:"enrichers,omitempty"`
+	RunsAnalyzed  int32                     `protobuf:"varint,2,opt,name=runs_analyzed,json=runsAnalyzed,proto3" json:"runs_analyzed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnricherUsageResponse) Reset() {
+	*x = GetEnricherUsageResponse{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnricherUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnricherUsageResponse) ProtoMessage() {}
+
+func (x *GetEnricherUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnricherUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetEnricherUsageResponse) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetEnricherUsageResponse) GetEnrichers() []*pipeline.EnricherUsage {
+	if x != nil {
+		return x.Enrichers
+	}
+	return nil
+}
+
+func (x *GetEnricherUsageResponse) GetRunsAnalyzed() int32 {
+	if x != nil {
+		return x.RunsAnalyzed
+	}
+	return 0
+}
+
+type PausePipelinesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PipelineId    string                 `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`    // empty pauses every pipeline (vacation mode)
+	PausedUntil   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=paused_until,json=pausedUntil,proto3" json:"paused_until,omitempty"` // unset clears the pause
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PausePipelinesRequest) Reset() {
+	*x = PausePipelinesRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PausePipelinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PausePipelinesRequest) ProtoMessage() {}
+
+func (x *PausePipelinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PausePipelinesRequest.ProtoReflect.Descriptor instead.
+func (*PausePipelinesRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PausePipelinesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PausePipelinesRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *PausePipelinesRequest) GetPausedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedUntil
+	}
+	return nil
+}
+
+type ResumePipelinesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PipelineId    string                 `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"` // empty resumes every pipeline
+	Discard       bool                   `protobuf:"varint,3,opt,name=discard,proto3" json:"discard,omitempty"`                        // discard deferred activities instead of releasing them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumePipelinesRequest) Reset() {
+	*x = ResumePipelinesRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumePipelinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumePipelinesRequest) ProtoMessage() {}
+
+func (x *ResumePipelinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumePipelinesRequest.ProtoReflect.Descriptor instead.
+func (*ResumePipelinesRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ResumePipelinesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ResumePipelinesRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *ResumePipelinesRequest) GetDiscard() bool {
+	if x != nil {
+		return x.Discard
+	}
+	return false
+}
+
+type ResumePipelinesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Released      int32                  `protobuf:"varint,1,opt,name=released,proto3" json:"released,omitempty"`
+	Discarded     int32                  `protobuf:"varint,2,opt,name=discarded,proto3" json:"discarded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumePipelinesResponse) Reset() {
+	*x = ResumePipelinesResponse{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumePipelinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumePipelinesResponse) ProtoMessage() {}
+
+func (x *ResumePipelinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumePipelinesResponse.ProtoReflect.Descriptor instead.
+func (*ResumePipelinesResponse) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ResumePipelinesResponse) GetReleased() int32 {
+	if x != nil {
+		return x.Released
+	}
+	return 0
+}
+
+func (x *ResumePipelinesResponse) GetDiscarded() int32 {
+	if x != nil {
+		return x.Discarded
+	}
+	return 0
+}
+
+type PreviewPipelineRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	UserId        string                         `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PipelineId    string                         `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	Activity      *activity.StandardizedActivity `protobuf:"bytes,3,opt,name=activity,proto3" json:"activity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewPipelineRequest) Reset() {
+	*x = PreviewPipelineRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewPipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewPipelineRequest) ProtoMessage() {}
+
+func (x *PreviewPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewPipelineRequest.ProtoReflect.Descriptor instead.
+func (*PreviewPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PreviewPipelineRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PreviewPipelineRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *PreviewPipelineRequest) GetActivity() *activity.StandardizedActivity {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+type PreviewDescriptionMergeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActivityId    string                 `protobuf:"bytes,2,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Destination   plugin.DestinationType `protobuf:"varint,3,opt,name=destination,proto3,enum=fitglue.models.plugin.DestinationType" json:"destination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewDescriptionMergeRequest) Reset() {
+	*x = PreviewDescriptionMergeRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewDescriptionMergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewDescriptionMergeRequest) ProtoMessage() {}
+
+func (x *PreviewDescriptionMergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewDescriptionMergeRequest.ProtoReflect.Descriptor instead.
+func (*PreviewDescriptionMergeRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PreviewDescriptionMergeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PreviewDescriptionMergeRequest) GetActivityId() string {
+	if x != nil {
+		return x.ActivityId
+	}
+	return ""
+}
+
+func (x *PreviewDescriptionMergeRequest) GetDestination() plugin.DestinationType {
+	if x != nil {
+		return x.Destination
+	}
+	return plugin.DestinationType(0)
+}
+
+type GetPipelineCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PipelineId    string                 `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	Days          int32                  `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"` // days of history ending today; 0 uses the server default (a year)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPipelineCalendarRequest) Reset() {
+	*x = GetPipelineCalendarRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPipelineCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineCalendarRequest) ProtoMessage() {}
+
+func (x *GetPipelineCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetPipelineCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *RepostActivityRequest) GetUserId() string {
+func (x *GetPipelineCalendarRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *RepostActivityRequest) GetActivityId() string {
+func (x *GetPipelineCalendarRequest) GetPipelineId() string {
 	if x != nil {
-		return x.ActivityId
+		return x.PipelineId
 	}
 	return ""
 }
 
-func (x *RepostActivityRequest) GetMode() string {
+func (x *GetPipelineCalendarRequest) GetDays() int32 {
 	if x != nil {
-		return x.Mode
+		return x.Days
 	}
-	return ""
+	return 0
 }
 
-func (x *RepostActivityRequest) GetDestination() string {
+type GetPipelineCalendarResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Days          []*pipeline.PipelineCalendarDay `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"` // oldest first, days without runs omitted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPipelineCalendarResponse) Reset() {
+	*x = GetPipelineCalendarResponse{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPipelineCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineCalendarResponse) ProtoMessage() {}
+
+func (x *GetPipelineCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[38]
 	if x != nil {
-		return x.Destination
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineCalendarResponse.ProtoReflect.Descriptor instead.
+func (*GetPipelineCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetPipelineCalendarResponse) GetDays() []*pipeline.PipelineCalendarDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type GetEnricherRecommendationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnricherRecommendationsRequest) Reset() {
+	*x = GetEnricherRecommendationsRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnricherRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnricherRecommendationsRequest) ProtoMessage() {}
+
+func (x *GetEnricherRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnricherRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetEnricherRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetEnricherRecommendationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-type GetPipelineRunRequest struct {
+type CorrectActivityTypeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	ActivityId    string                 `protobuf:"bytes,2,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	ActivityType  activity.ActivityType  `protobuf:"varint,3,opt,name=activity_type,json=activityType,proto3,enum=fitglue.models.activity.ActivityType" json:"activity_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPipelineRunRequest) Reset() {
-	*x = GetPipelineRunRequest{}
-	mi := &file_services_pipeline_pipeline_proto_msgTypes[13]
+func (x *CorrectActivityTypeRequest) Reset() {
+	*x = CorrectActivityTypeRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPipelineRunRequest) String() string {
+func (x *CorrectActivityTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPipelineRunRequest) ProtoMessage() {}
+func (*CorrectActivityTypeRequest) ProtoMessage() {}
 
-func (x *GetPipelineRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_pipeline_pipeline_proto_msgTypes[13]
+func (x *CorrectActivityTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -760,50 +2279,98 @@ func (x *GetPipelineRunRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPipelineRunRequest.ProtoReflect.Descriptor instead.
-func (*GetPipelineRunRequest) Descriptor() ([]byte, []int) {
-	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CorrectActivityTypeRequest.ProtoReflect.Descriptor instead.
+func (*CorrectActivityTypeRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetPipelineRunRequest) GetUserId() string {
+func (x *CorrectActivityTypeRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetPipelineRunRequest) GetRunId() string {
+func (x *CorrectActivityTypeRequest) GetActivityId() string {
 	if x != nil {
-		return x.RunId
+		return x.ActivityId
 	}
 	return ""
 }
 
-type ListPipelineRunsRequest struct {
+func (x *CorrectActivityTypeRequest) GetActivityType() activity.ActivityType {
+	if x != nil {
+		return x.ActivityType
+	}
+	return activity.ActivityType(0)
+}
+
+type CorrectActivityTypeResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Rule          *pipeline.ActivityTypeRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"` // unset when the title has nothing to learn from
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CorrectActivityTypeResponse) Reset() {
+	*x = CorrectActivityTypeResponse{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorrectActivityTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrectActivityTypeResponse) ProtoMessage() {}
+
+func (x *CorrectActivityTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrectActivityTypeResponse.ProtoReflect.Descriptor instead.
+func (*CorrectActivityTypeResponse) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CorrectActivityTypeResponse) GetRule() *pipeline.ActivityTypeRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type ListActivityTypeRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PipelineId    string                 `protobuf:"bytes,2,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPipelineRunsRequest) Reset() {
-	*x = ListPipelineRunsRequest{}
-	mi := &file_services_pipeline_pipeline_proto_msgTypes[14]
+func (x *ListActivityTypeRulesRequest) Reset() {
+	*x = ListActivityTypeRulesRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPipelineRunsRequest) String() string {
+func (x *ListActivityTypeRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPipelineRunsRequest) ProtoMessage() {}
+func (*ListActivityTypeRulesRequest) ProtoMessage() {}
 
-func (x *ListPipelineRunsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_pipeline_pipeline_proto_msgTypes[14]
+func (x *ListActivityTypeRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,62 +2381,153 @@ func (x *ListPipelineRunsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPipelineRunsRequest.ProtoReflect.Descriptor instead.
-func (*ListPipelineRunsRequest) Descriptor() ([]byte, []int) {
-	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListActivityTypeRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListActivityTypeRulesRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ListPipelineRunsRequest) GetUserId() string {
+func (x *ListActivityTypeRulesRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ListPipelineRunsRequest) GetPipelineId() string {
+type ListActivityTypeRulesResponse struct {
+	state          protoimpl.MessageState       `protogen:"open.v1"`
+	Rules          []*pipeline.ActivityTypeRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	LearnThreshold int32                        `protobuf:"varint,2,opt,name=learn_threshold,json=learnThreshold,proto3" json:"learn_threshold,omitempty"` // correction_count at which a rule starts being applied
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListActivityTypeRulesResponse) Reset() {
+	*x = ListActivityTypeRulesResponse{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivityTypeRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivityTypeRulesResponse) ProtoMessage() {}
+
+func (x *ListActivityTypeRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[43]
 	if x != nil {
-		return x.PipelineId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListPipelineRunsRequest) GetLimit() int32 {
+// Deprecated: Use ListActivityTypeRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListActivityTypeRulesResponse) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListActivityTypeRulesResponse) GetRules() []*pipeline.ActivityTypeRule {
 	if x != nil {
-		return x.Limit
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ListActivityTypeRulesResponse) GetLearnThreshold() int32 {
+	if x != nil {
+		return x.LearnThreshold
 	}
 	return 0
 }
 
-func (x *ListPipelineRunsRequest) GetPageToken() string {
+type UpdateActivityTypeRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Disabled      bool                   `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateActivityTypeRuleRequest) Reset() {
+	*x = UpdateActivityTypeRuleRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivityTypeRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivityTypeRuleRequest) ProtoMessage() {}
+
+func (x *UpdateActivityTypeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[44]
 	if x != nil {
-		return x.PageToken
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivityTypeRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateActivityTypeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateActivityTypeRuleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-type ListPipelineRunsResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Runs          []*pipeline.PipelineRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
-	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+func (x *UpdateActivityTypeRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *UpdateActivityTypeRuleRequest) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type DeleteActivityTypeRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPipelineRunsResponse) Reset() {
-	*x = ListPipelineRunsResponse{}
-	mi := &file_services_pipeline_pipeline_proto_msgTypes[15]
+func (x *DeleteActivityTypeRuleRequest) Reset() {
+	*x = DeleteActivityTypeRuleRequest{}
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPipelineRunsResponse) String() string {
+func (x *DeleteActivityTypeRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPipelineRunsResponse) ProtoMessage() {}
+func (*DeleteActivityTypeRuleRequest) ProtoMessage() {}
 
-func (x *ListPipelineRunsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_pipeline_pipeline_proto_msgTypes[15]
+func (x *DeleteActivityTypeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_pipeline_pipeline_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,21 +2538,21 @@ func (x *ListPipelineRunsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPipelineRunsResponse.ProtoReflect.Descriptor instead.
-func (*ListPipelineRunsResponse) Descriptor() ([]byte, []int) {
-	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DeleteActivityTypeRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteActivityTypeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_services_pipeline_pipeline_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ListPipelineRunsResponse) GetRuns() []*pipeline.PipelineRun {
+func (x *DeleteActivityTypeRuleRequest) GetUserId() string {
 	if x != nil {
-		return x.Runs
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListPipelineRunsResponse) GetNextPageToken() string {
+func (x *DeleteActivityTypeRuleRequest) GetRuleId() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.RuleId
 	}
 	return ""
 }
@@ -903,17 +2561,49 @@ var File_services_pipeline_pipeline_proto protoreflect.FileDescriptor
 
 const file_services_pipeline_pipeline_proto_rawDesc = "" +
 	"\n" +
-	" services/pipeline/pipeline.proto\x12\x19fitglue.services.pipeline\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cmodels/pipeline/config.proto\x1a\x1fmodels/pipeline/execution.proto\x1a#models/pipeline/pending_input.proto\"\x9c\x01\n" +
+	" services/pipeline/pipeline.proto\x12\x19fitglue.services.pipeline\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cmodels/activity/source.proto\x1a\"models/activity/standardized.proto\x1a\x1cmodels/events/pipeline.proto\x1a\x1cmodels/pipeline/config.proto\x1a\x1fmodels/pipeline/execution.proto\x1a\"models/pipeline/debug_bundle.proto\x1a\"models/pipeline/failed_event.proto\x1a$models/pipeline/recommendation.proto\x1a#models/pipeline/pending_input.proto\x1a\x1dmodels/pipeline/preview.proto\x1a&models/pipeline/provider_circuit.proto\x1a\x1cmodels/plugin/provider.proto\x1a#models/pipeline/type_learning.proto\"\xbd\x01\n" +
 	"\x1cAdminListPipelineRunsRequest\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x05 \x01(\tR\tpageToken\"\x81\x01\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\verrors_only\x18\x06 \x01(\bR\n" +
+	"errorsOnly\"\x81\x01\n" +
 	"\x1dAdminListPipelineRunsResponse\x128\n" +
 	"\x04runs\x18\x01 \x03(\v2$.fitglue.models.pipeline.PipelineRunR\x04runs\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"/\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe7\x01\n" +
+	"$AdminForceCompletePipelineRunRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
+	"\x0fpipeline_run_id\x18\x02 \x01(\tR\rpipelineRunId\x12B\n" +
+	"\x06status\x18\x03 \x01(\x0e2*.fitglue.models.pipeline.PipelineRunStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\"\n" +
+	"\radmin_user_id\x18\x05 \x01(\tR\vadminUserId\"\"\n" +
+	" AdminListProviderCircuitsRequest\"i\n" +
+	"!AdminListProviderCircuitsResponse\x12D\n" +
+	"\bcircuits\x18\x01 \x03(\v2(.fitglue.models.pipeline.ProviderCircuitR\bcircuits\"\xf4\x01\n" +
+	"\"AdminSetProviderCircuitModeRequest\x12P\n" +
+	"\rprovider_type\x18\x01 \x01(\x0e2+.fitglue.models.plugin.EnricherProviderTypeR\fproviderType\x12@\n" +
+	"\x04mode\x18\x02 \x01(\x0e2,.fitglue.models.pipeline.ProviderCircuitModeR\x04mode\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\"\n" +
+	"\radmin_user_id\x18\x04 \x01(\tR\vadminUserId\"\x90\x01\n" +
+	"&AdminListProviderCircuitChangesRequest\x12P\n" +
+	"\rprovider_type\x18\x01 \x01(\x0e2+.fitglue.models.plugin.EnricherProviderTypeR\fproviderType\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"s\n" +
+	"'AdminListProviderCircuitChangesResponse\x12H\n" +
+	"\achanges\x18\x01 \x03(\v2..fitglue.models.pipeline.ProviderCircuitChangeR\achanges\"\x91\x01\n" +
+	"\x1cAdminListFailedEventsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12B\n" +
+	"\x06status\x18\x02 \x01(\x0e2*.fitglue.models.pipeline.FailedEventStatusR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"]\n" +
+	"\x1dAdminListFailedEventsResponse\x12<\n" +
+	"\x06events\x18\x01 \x03(\v2$.fitglue.models.pipeline.FailedEventR\x06events\"W\n" +
+	"\x1fAdminRedriveFailedEventsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tevent_ids\x18\x02 \x03(\tR\beventIds\"`\n" +
+	" AdminRedriveFailedEventsResponse\x12<\n" +
+	"\x06events\x18\x01 \x03(\v2$.fitglue.models.pipeline.FailedEventR\x06events\"/\n" +
 	"\x14ListPipelinesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"^\n" +
 	"\x15ListPipelinesResponse\x12E\n" +
@@ -933,12 +2623,13 @@ const file_services_pipeline_pipeline_proto_rawDesc = "" +
 	"\x15DeletePipelineRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
 	"\vpipeline_id\x18\x02 \x01(\tR\n" +
-	"pipelineId\"\xf2\x01\n" +
+	"pipelineId\"\xb6\x02\n" +
 	"\x12SubmitInputRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12(\n" +
 	"\x10pending_input_id\x18\x02 \x01(\tR\x0ependingInputId\x12[\n" +
 	"\n" +
-	"input_data\x18\x03 \x03(\v2<.fitglue.services.pipeline.SubmitInputRequest.InputDataEntryR\tinputData\x1a<\n" +
+	"input_data\x18\x03 \x03(\v2<.fitglue.services.pipeline.SubmitInputRequest.InputDataEntryR\tinputData\x12B\n" +
+	"\x1dconfirm_description_overwrite\x18\x04 \x01(\bR\x1bconfirmDescriptionOverwrite\x1a<\n" +
 	"\x0eInputDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"3\n" +
@@ -948,16 +2639,34 @@ const file_services_pipeline_pipeline_proto_rawDesc = "" +
 	"\x06inputs\x18\x01 \x03(\v2%.fitglue.models.pipeline.PendingInputR\x06inputs\"_\n" +
 	"\x1aResolvePendingInputRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12(\n" +
-	"\x10pending_input_id\x18\x02 \x01(\tR\x0ependingInputId\"\x87\x01\n" +
+	"\x10pending_input_id\x18\x02 \x01(\tR\x0ependingInputId\"\xd7\x01\n" +
 	"\x15RepostActivityRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
 	"\vactivity_id\x18\x02 \x01(\tR\n" +
 	"activityId\x12\x12\n" +
 	"\x04mode\x18\x03 \x01(\tR\x04mode\x12 \n" +
-	"\vdestination\x18\x04 \x01(\tR\vdestination\"G\n" +
+	"\vdestination\x18\x04 \x01(\tR\vdestination\x12N\n" +
+	"\x0freplay_override\x18\x05 \x01(\v2%.fitglue.models.events.ReplayOverrideR\x0ereplayOverride\"^\n" +
+	"\x1fProvisionStarterPipelineRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\fintegrations\x18\x02 \x03(\tR\fintegrations\"\x87\x01\n" +
+	" ProvisionStarterPipelineResponse\x12C\n" +
+	"\bpipeline\x18\x01 \x01(\v2'.fitglue.models.pipeline.PipelineConfigR\bpipeline\x12\x1e\n" +
+	"\vtest_run_id\x18\x02 \x01(\tR\ttestRunId\"\x99\x01\n" +
+	"\x17RetryPipelineRunRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
+	"\x0fpipeline_run_id\x18\x02 \x01(\tR\rpipelineRunId\x12\x1c\n" +
+	"\tenrichers\x18\x03 \x03(\tR\tenrichers\x12\x1f\n" +
+	"\vpipeline_id\x18\x04 \x01(\tR\n" +
+	"pipelineId\"G\n" +
 	"\x15GetPipelineRunRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
-	"\x06run_id\x18\x02 \x01(\tR\x05runId\"\x88\x01\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\"s\n" +
+	" GetPipelineRunDebugBundleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x1f\n" +
+	"\vpipeline_id\x18\x03 \x01(\tR\n" +
+	"pipelineId\"\x88\x01\n" +
 	"\x17ListPipelineRunsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
 	"\vpipeline_id\x18\x02 \x01(\tR\n" +
@@ -967,20 +2676,99 @@ const file_services_pipeline_pipeline_proto_rawDesc = "" +
 	"page_token\x18\x04 \x01(\tR\tpageToken\"|\n" +
 	"\x18ListPipelineRunsResponse\x128\n" +
 	"\x04runs\x18\x01 \x03(\v2$.fitglue.models.pipeline.PipelineRunR\x04runs\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xb9\x0f\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"n\n" +
+	"\x17GetEnricherUsageRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vpipeline_id\x18\x02 \x01(\tR\n" +
+	"pipelineId\x12\x19\n" +
+	"\bmax_runs\x18\x03 \x01(\x05R\amaxRuns\"\x85\x01\n" +
+	"\x18GetEnricherUsageResponse\x12D\n" +
+	"\tenrichers\x18\x01 \x03(\v2&.fitglue.models.pipeline.EnricherUsageR\tenrichers\x12#\n" +
+	"\rruns_analyzed\x18\x02 \x01(\x05R\frunsAnalyzed\"\x90\x01\n" +
+	"\x15PausePipelinesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vpipeline_id\x18\x02 \x01(\tR\n" +
+	"pipelineId\x12=\n" +
+	"\fpaused_until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vpausedUntil\"l\n" +
+	"\x16ResumePipelinesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vpipeline_id\x18\x02 \x01(\tR\n" +
+	"pipelineId\x12\x18\n" +
+	"\adiscard\x18\x03 \x01(\bR\adiscard\"S\n" +
+	"\x17ResumePipelinesResponse\x12\x1a\n" +
+	"\breleased\x18\x01 \x01(\x05R\breleased\x12\x1c\n" +
+	"\tdiscarded\x18\x02 \x01(\x05R\tdiscarded\"\x9d\x01\n" +
+	"\x16PreviewPipelineRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vpipeline_id\x18\x02 \x01(\tR\n" +
+	"pipelineId\x12I\n" +
+	"\bactivity\x18\x03 \x01(\v2-.fitglue.models.activity.StandardizedActivityR\bactivity\"\xa4\x01\n" +
+	"\x1ePreviewDescriptionMergeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vactivity_id\x18\x02 \x01(\tR\n" +
+	"activityId\x12H\n" +
+	"\vdestination\x18\x03 \x01(\x0e2&.fitglue.models.plugin.DestinationTypeR\vdestination\"j\n" +
+	"\x1aGetPipelineCalendarRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vpipeline_id\x18\x02 \x01(\tR\n" +
+	"pipelineId\x12\x12\n" +
+	"\x04days\x18\x03 \x01(\x05R\x04days\"_\n" +
+	"\x1bGetPipelineCalendarResponse\x12@\n" +
+	"\x04days\x18\x01 \x03(\v2,.fitglue.models.pipeline.PipelineCalendarDayR\x04days\"<\n" +
+	"!GetEnricherRecommendationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xa2\x01\n" +
+	"\x1aCorrectActivityTypeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vactivity_id\x18\x02 \x01(\tR\n" +
+	"activityId\x12J\n" +
+	"\ractivity_type\x18\x03 \x01(\x0e2%.fitglue.models.activity.ActivityTypeR\factivityType\"\\\n" +
+	"\x1bCorrectActivityTypeResponse\x12=\n" +
+	"\x04rule\x18\x01 \x01(\v2).fitglue.models.pipeline.ActivityTypeRuleR\x04rule\"7\n" +
+	"\x1cListActivityTypeRulesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x89\x01\n" +
+	"\x1dListActivityTypeRulesResponse\x12?\n" +
+	"\x05rules\x18\x01 \x03(\v2).fitglue.models.pipeline.ActivityTypeRuleR\x05rules\x12'\n" +
+	"\x0flearn_threshold\x18\x02 \x01(\x05R\x0elearnThreshold\"m\n" +
+	"\x1dUpdateActivityTypeRuleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\x12\x1a\n" +
+	"\bdisabled\x18\x03 \x01(\bR\bdisabled\"Q\n" +
+	"\x1dDeleteActivityTypeRuleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId2\x9c-\n" +
 	"\x0fPipelineService\x12\x99\x01\n" +
 	"\rListPipelines\x12/.fitglue.services.pipeline.ListPipelinesRequest\x1a0.fitglue.services.pipeline.ListPipelinesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v2/users/{user_id}/pipelines\x12\x9a\x01\n" +
 	"\vGetPipeline\x12-.fitglue.services.pipeline.GetPipelineRequest\x1a'.fitglue.models.pipeline.PipelineConfig\"3\x82\xd3\xe4\x93\x02-\x12+/v2/users/{user_id}/pipelines/{pipeline_id}\x12\x9c\x01\n" +
 	"\x0eCreatePipeline\x120.fitglue.services.pipeline.CreatePipelineRequest\x1a'.fitglue.models.pipeline.PipelineConfig\"/\x82\xd3\xe4\x93\x02):\bpipeline\"\x1d/v2/users/{user_id}/pipelines\x12\xaa\x01\n" +
 	"\x0eUpdatePipeline\x120.fitglue.services.pipeline.UpdatePipelineRequest\x1a'.fitglue.models.pipeline.PipelineConfig\"=\x82\xd3\xe4\x93\x027:\bpipeline2+/v2/users/{user_id}/pipelines/{pipeline_id}\x12\x8f\x01\n" +
-	"\x0eDeletePipeline\x120.fitglue.services.pipeline.DeletePipelineRequest\x1a\x16.google.protobuf.Empty\"3\x82\xd3\xe4\x93\x02-*+/v2/users/{user_id}/pipelines/{pipeline_id}\x12\x9d\x01\n" +
+	"\x0eDeletePipeline\x120.fitglue.services.pipeline.DeletePipelineRequest\x1a\x16.google.protobuf.Empty\"3\x82\xd3\xe4\x93\x02-*+/v2/users/{user_id}/pipelines/{pipeline_id}\x12\xc5\x01\n" +
+	"\x18ProvisionStarterPipeline\x12:.fitglue.services.pipeline.ProvisionStarterPipelineRequest\x1a;.fitglue.services.pipeline.ProvisionStarterPipelineResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v2/users/{user_id}/pipelines/starter\x12\x9d\x01\n" +
 	"\vSubmitInput\x12-.fitglue.services.pipeline.SubmitInputRequest\x1a\x16.google.protobuf.Empty\"G\x82\xd3\xe4\x93\x02A:\x01*\"</v2/users/{user_id}/pending-inputs/{pending_input_id}/submit\x12\xaa\x01\n" +
 	"\x11ListPendingInputs\x123.fitglue.services.pipeline.ListPendingInputsRequest\x1a4.fitglue.services.pipeline.ListPendingInputsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v2/users/{user_id}/pending-inputs\x12\xae\x01\n" +
 	"\x13ResolvePendingInput\x125.fitglue.services.pipeline.ResolvePendingInputRequest\x1a\x16.google.protobuf.Empty\"H\x82\xd3\xe4\x93\x02B:\x01*\"=/v2/users/{user_id}/pending-inputs/{pending_input_id}/resolve\x12\x9a\x01\n" +
-	"\x0eRepostActivity\x120.fitglue.services.pipeline.RepostActivityRequest\x1a\x16.google.protobuf.Empty\">\x82\xd3\xe4\x93\x028:\x01*\"3/v2/users/{user_id}/activities/{activity_id}/repost\x12\x9c\x01\n" +
-	"\x0eGetPipelineRun\x120.fitglue.services.pipeline.GetPipelineRunRequest\x1a$.fitglue.models.pipeline.PipelineRun\"2\x82\xd3\xe4\x93\x02,\x12*/v2/users/{user_id}/pipeline-runs/{run_id}\x12\xa6\x01\n" +
-	"\x10ListPipelineRuns\x122.fitglue.services.pipeline.ListPipelineRunsRequest\x1a3.fitglue.services.pipeline.ListPipelineRunsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v2/users/{user_id}/pipeline-runs\x12\xab\x01\n" +
-	"\x15AdminListPipelineRuns\x127.fitglue.services.pipeline.AdminListPipelineRunsRequest\x1a8.fitglue.services.pipeline.AdminListPipelineRunsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v2/admin/pipeline-runsBAZ?github.com/fitglue/server/src/go/pkg/types/pb/services/pipelineb\x06proto3"
+	"\x0eRepostActivity\x120.fitglue.services.pipeline.RepostActivityRequest\x1a\x16.google.protobuf.Empty\">\x82\xd3\xe4\x93\x028:\x01*\"3/v2/users/{user_id}/activities/{activity_id}/repost\x12\xa4\x01\n" +
+	"\x10RetryPipelineRun\x122.fitglue.services.pipeline.RetryPipelineRunRequest\x1a\x16.google.protobuf.Empty\"D\x82\xd3\xe4\x93\x02>:\x01*\"9/v2/users/{user_id}/pipeline-runs/{pipeline_run_id}/retry\x12\x9c\x01\n" +
+	"\x0eGetPipelineRun\x120.fitglue.services.pipeline.GetPipelineRunRequest\x1a$.fitglue.models.pipeline.PipelineRun\"2\x82\xd3\xe4\x93\x02,\x12*/v2/users/{user_id}/pipeline-runs/{run_id}\x12\xca\x01\n" +
+	"\x19GetPipelineRunDebugBundle\x12;.fitglue.services.pipeline.GetPipelineRunDebugBundleRequest\x1a/.fitglue.models.pipeline.PipelineRunDebugBundle\"?\x82\xd3\xe4\x93\x029\x127/v2/users/{user_id}/pipeline-runs/{run_id}/debug-bundle\x12\xa6\x01\n" +
+	"\x10ListPipelineRuns\x122.fitglue.services.pipeline.ListPipelineRunsRequest\x1a3.fitglue.services.pipeline.ListPipelineRunsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v2/users/{user_id}/pipeline-runs\x12\xa7\x01\n" +
+	"\x10GetEnricherUsage\x122.fitglue.services.pipeline.GetEnricherUsageRequest\x1a3.fitglue.services.pipeline.GetEnricherUsageResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v2/users/{user_id}/enricher-usage\x12\x80\x01\n" +
+	"\x0ePausePipelines\x120.fitglue.services.pipeline.PausePipelinesRequest\x1a\x16.google.protobuf.Empty\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/v2/users/{user_id}/pause\x12\x9f\x01\n" +
+	"\x0fResumePipelines\x121.fitglue.services.pipeline.ResumePipelinesRequest\x1a2.fitglue.services.pipeline.ResumePipelinesResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v2/users/{user_id}/resume\x12\xc2\x01\n" +
+	"\x13GetPipelineCalendar\x125.fitglue.services.pipeline.GetPipelineCalendarRequest\x1a6.fitglue.services.pipeline.GetPipelineCalendarResponse\"<\x82\xd3\xe4\x93\x026\x124/v2/users/{user_id}/pipelines/{pipeline_id}/calendar\x12\xb5\x01\n" +
+	"\x0fPreviewPipeline\x121.fitglue.services.pipeline.PreviewPipelineRequest\x1a(.fitglue.models.pipeline.PipelinePreview\"E\x82\xd3\xe4\x93\x02?:\bactivity\"3/v2/users/{user_id}/pipelines/{pipeline_id}/preview\x12\xd0\x01\n" +
+	"\x17PreviewDescriptionMerge\x129.fitglue.services.pipeline.PreviewDescriptionMergeRequest\x1a0.fitglue.models.pipeline.DescriptionMergePreview\"H\x82\xd3\xe4\x93\x02B\x12@/v2/users/{user_id}/activities/{activity_id}/description-preview\x12\xc2\x01\n" +
+	"\x1aGetEnricherRecommendations\x12<.fitglue.services.pipeline.GetEnricherRecommendationsRequest\x1a0.fitglue.models.pipeline.EnricherRecommendations\"4\x82\xd3\xe4\x93\x02.\x12,/v2/users/{user_id}/enricher-recommendations\x12\xc2\x01\n" +
+	"\x13CorrectActivityType\x125.fitglue.services.pipeline.CorrectActivityTypeRequest\x1a6.fitglue.services.pipeline.CorrectActivityTypeResponse\"<\x82\xd3\xe4\x93\x026:\x01*\x1a1/v2/users/{user_id}/activities/{activity_id}/type\x12\xbb\x01\n" +
+	"\x15ListActivityTypeRules\x127.fitglue.services.pipeline.ListActivityTypeRulesRequest\x1a8.fitglue.services.pipeline.ListActivityTypeRulesResponse\"/\x82\xd3\xe4\x93\x02)\x12'/v2/users/{user_id}/activity-type-rules\x12\xbb\x01\n" +
+	"\x16UpdateActivityTypeRule\x128.fitglue.services.pipeline.UpdateActivityTypeRuleRequest\x1a).fitglue.models.pipeline.ActivityTypeRule\"<\x82\xd3\xe4\x93\x026:\x01*\x1a1/v2/users/{user_id}/activity-type-rules/{rule_id}\x12\xa5\x01\n" +
+	"\x16DeleteActivityTypeRule\x128.fitglue.services.pipeline.DeleteActivityTypeRuleRequest\x1a\x16.google.protobuf.Empty\"9\x82\xd3\xe4\x93\x023*1/v2/users/{user_id}/activity-type-rules/{rule_id}\x12\xab\x01\n" +
+	"\x15AdminListPipelineRuns\x127.fitglue.services.pipeline.AdminListPipelineRunsRequest\x1a8.fitglue.services.pipeline.AdminListPipelineRunsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v2/admin/pipeline-runs\x12\xdb\x01\n" +
+	"\x1dAdminForceCompletePipelineRun\x12?.fitglue.services.pipeline.AdminForceCompletePipelineRunRequest\x1a$.fitglue.models.pipeline.PipelineRun\"S\x82\xd3\xe4\x93\x02M:\x01*\"H/v2/admin/users/{user_id}/pipeline-runs/{pipeline_run_id}/force-complete\x12\xbb\x01\n" +
+	"\x19AdminListProviderCircuits\x12;.fitglue.services.pipeline.AdminListProviderCircuitsRequest\x1a<.fitglue.services.pipeline.AdminListProviderCircuitsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v2/admin/provider-circuits\x12\xc3\x01\n" +
+	"\x1bAdminSetProviderCircuitMode\x12=.fitglue.services.pipeline.AdminSetProviderCircuitModeRequest\x1a(.fitglue.models.pipeline.ProviderCircuit\";\x82\xd3\xe4\x93\x025:\x01*\x1a0/v2/admin/provider-circuits/{provider_type}/mode\x12\xe5\x01\n" +
+	"\x1fAdminListProviderCircuitChanges\x12A.fitglue.services.pipeline.AdminListProviderCircuitChangesRequest\x1aB.fitglue.services.pipeline.AdminListProviderCircuitChangesResponse\";\x82\xd3\xe4\x93\x025\x123/v2/admin/provider-circuits/{provider_type}/history\x12\xbb\x01\n" +
+	"\x15AdminListFailedEvents\x127.fitglue.services.pipeline.AdminListFailedEventsRequest\x1a8.fitglue.services.pipeline.AdminListFailedEventsResponse\"/\x82\xd3\xe4\x93\x02)\x12'/v2/admin/users/{user_id}/failed-events\x12\xcf\x01\n" +
+	"\x18AdminRedriveFailedEvents\x12:.fitglue.services.pipeline.AdminRedriveFailedEventsRequest\x1a;.fitglue.services.pipeline.AdminRedriveFailedEventsResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//v2/admin/users/{user_id}/failed-events/redriveBAZ?github.com/fitglue/server/src/go/pkg/types/pb/services/pipelineb\x06proto3"
 
 var (
 	file_services_pipeline_pipeline_proto_rawDescOnce sync.Once
@@ -994,67 +2782,175 @@ func file_services_pipeline_pipeline_proto_rawDescGZIP() []byte {
 	return file_services_pipeline_pipeline_proto_rawDescData
 }
 
-var file_services_pipeline_pipeline_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_services_pipeline_pipeline_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_services_pipeline_pipeline_proto_goTypes = []any{
-	(*AdminListPipelineRunsRequest)(nil),  // 0: fitglue.services.pipeline.AdminListPipelineRunsRequest
-	(*AdminListPipelineRunsResponse)(nil), // 1: fitglue.services.pipeline.AdminListPipelineRunsResponse
-	(*ListPipelinesRequest)(nil),          // 2: fitglue.services.pipeline.ListPipelinesRequest
-	(*ListPipelinesResponse)(nil),         // 3: fitglue.services.pipeline.ListPipelinesResponse
-	(*GetPipelineRequest)(nil),            // 4: fitglue.services.pipeline.GetPipelineRequest
-	(*CreatePipelineRequest)(nil),         // 5: fitglue.services.pipeline.CreatePipelineRequest
-	(*UpdatePipelineRequest)(nil),         // 6: fitglue.services.pipeline.UpdatePipelineRequest
-	(*DeletePipelineRequest)(nil),         // 7: fitglue.services.pipeline.DeletePipelineRequest
-	(*SubmitInputRequest)(nil),            // 8: fitglue.services.pipeline.SubmitInputRequest
-	(*ListPendingInputsRequest)(nil),      // 9: fitglue.services.pipeline.ListPendingInputsRequest
-	(*ListPendingInputsResponse)(nil),     // 10: fitglue.services.pipeline.ListPendingInputsResponse
-	(*ResolvePendingInputRequest)(nil),    // 11: fitglue.services.pipeline.ResolvePendingInputRequest
-	(*RepostActivityRequest)(nil),         // 12: fitglue.services.pipeline.RepostActivityRequest
-	(*GetPipelineRunRequest)(nil),         // 13: fitglue.services.pipeline.GetPipelineRunRequest
-	(*ListPipelineRunsRequest)(nil),       // 14: fitglue.services.pipeline.ListPipelineRunsRequest
-	(*ListPipelineRunsResponse)(nil),      // 15: fitglue.services.pipeline.ListPipelineRunsResponse
-	nil,                                   // 16: fitglue.services.pipeline.SubmitInputRequest.InputDataEntry
-	(*pipeline.PipelineRun)(nil),          // 17: fitglue.models.pipeline.PipelineRun
-	(*pipeline.PipelineConfig)(nil),       // 18: fitglue.models.pipeline.PipelineConfig
-	(*pipeline.PendingInput)(nil),         // 19: fitglue.models.pipeline.PendingInput
-	(*emptypb.Empty)(nil),                 // 20: google.protobuf.Empty
+	(*AdminListPipelineRunsRequest)(nil),            // 0: fitglue.services.pipeline.AdminListPipelineRunsRequest
+	(*AdminListPipelineRunsResponse)(nil),           // 1: fitglue.services.pipeline.AdminListPipelineRunsResponse
+	(*AdminForceCompletePipelineRunRequest)(nil),    // 2: fitglue.services.pipeline.AdminForceCompletePipelineRunRequest
+	(*AdminListProviderCircuitsRequest)(nil),        // 3: fitglue.services.pipeline.AdminListProviderCircuitsRequest
+	(*AdminListProviderCircuitsResponse)(nil),       // 4: fitglue.services.pipeline.AdminListProviderCircuitsResponse
+	(*AdminSetProviderCircuitModeRequest)(nil),      // 5: fitglue.services.pipeline.AdminSetProviderCircuitModeRequest
+	(*AdminListProviderCircuitChangesRequest)(nil),  // 6: fitglue.services.pipeline.AdminListProviderCircuitChangesRequest
+	(*AdminListProviderCircuitChangesResponse)(nil), // 7: fitglue.services.pipeline.AdminListProviderCircuitChangesResponse
+	(*AdminListFailedEventsRequest)(nil),            // 8: fitglue.services.pipeline.AdminListFailedEventsRequest
+	(*AdminListFailedEventsResponse)(nil),           // 9: fitglue.services.pipeline.AdminListFailedEventsResponse
+	(*AdminRedriveFailedEventsRequest)(nil),         // 10: fitglue.services.pipeline.AdminRedriveFailedEventsRequest
+	(*AdminRedriveFailedEventsResponse)(nil),        // 11: fitglue.services.pipeline.AdminRedriveFailedEventsResponse
+	(*ListPipelinesRequest)(nil),                    // 12: fitglue.services.pipeline.ListPipelinesRequest
+	(*ListPipelinesResponse)(nil),                   // 13: fitglue.services.pipeline.ListPipelinesResponse
+	(*GetPipelineRequest)(nil),                      // 14: fitglue.services.pipeline.GetPipelineRequest
+	(*CreatePipelineRequest)(nil),                   // 15: fitglue.services.pipeline.CreatePipelineRequest
+	(*UpdatePipelineRequest)(nil),                   // 16: fitglue.services.pipeline.UpdatePipelineRequest
+	(*DeletePipelineRequest)(nil),                   // 17: fitglue.services.pipeline.DeletePipelineRequest
+	(*SubmitInputRequest)(nil),                      // 18: fitglue.services.pipeline.SubmitInputRequest
+	(*ListPendingInputsRequest)(nil),                // 19: fitglue.services.pipeline.ListPendingInputsRequest
+	(*ListPendingInputsResponse)(nil),               // 20: fitglue.services.pipeline.ListPendingInputsResponse
+	(*ResolvePendingInputRequest)(nil),              // 21: fitglue.services.pipeline.ResolvePendingInputRequest
+	(*RepostActivityRequest)(nil),                   // 22: fitglue.services.pipeline.RepostActivityRequest
+	(*ProvisionStarterPipelineRequest)(nil),         // 23: fitglue.services.pipeline.ProvisionStarterPipelineRequest
+	(*ProvisionStarterPipelineResponse)(nil),        // 24: fitglue.services.pipeline.ProvisionStarterPipelineResponse
+	(*RetryPipelineRunRequest)(nil),                 // 25: fitglue.services.pipeline.RetryPipelineRunRequest
+	(*GetPipelineRunRequest)(nil),                   // 26: fitglue.services.pipeline.GetPipelineRunRequest
+	(*GetPipelineRunDebugBundleRequest)(nil),        // 27: fitglue.services.pipeline.GetPipelineRunDebugBundleRequest
+	(*ListPipelineRunsRequest)(nil),                 // 28: fitglue.services.pipeline.ListPipelineRunsRequest
+	(*ListPipelineRunsResponse)(nil),                // 29: fitglue.services.pipeline.ListPipelineRunsResponse
+	(*GetEnricherUsageRequest)(nil),                 // 30: fitglue.services.pipeline.GetEnricherUsageRequest
+	(*GetEnricherUsageResponse)(nil),                // 31: fitglue.services.pipeline.GetEnricherUsageResponse
+	(*PausePipelinesRequest)(nil),                   // 32: fitglue.services.pipeline.PausePipelinesRequest
+	(*ResumePipelinesRequest)(nil),                  // 33: fitglue.services.pipeline.ResumePipelinesRequest
+	(*ResumePipelinesResponse)(nil),                 // 34: fitglue.services.pipeline.ResumePipelinesResponse
+	(*PreviewPipelineRequest)(nil),                  // 35: fitglue.services.pipeline.PreviewPipelineRequest
+	(*PreviewDescriptionMergeRequest)(nil),          // 36: fitglue.services.pipeline.PreviewDescriptionMergeRequest
+	(*GetPipelineCalendarRequest)(nil),              // 37: fitglue.services.pipeline.GetPipelineCalendarRequest
+	(*GetPipelineCalendarResponse)(nil),             // 38: fitglue.services.pipeline.GetPipelineCalendarResponse
+	(*GetEnricherRecommendationsRequest)(nil),       // 39: fitglue.services.pipeline.GetEnricherRecommendationsRequest
+	(*CorrectActivityTypeRequest)(nil),              // 40: fitglue.services.pipeline.CorrectActivityTypeRequest
+	(*CorrectActivityTypeResponse)(nil),             // 41: fitglue.services.pipeline.CorrectActivityTypeResponse
+	(*ListActivityTypeRulesRequest)(nil),            // 42: fitglue.services.pipeline.ListActivityTypeRulesRequest
+	(*ListActivityTypeRulesResponse)(nil),           // 43: fitglue.services.pipeline.ListActivityTypeRulesResponse
+	(*UpdateActivityTypeRuleRequest)(nil),           // 44: fitglue.services.pipeline.UpdateActivityTypeRuleRequest
+	(*DeleteActivityTypeRuleRequest)(nil),           // 45: fitglue.services.pipeline.DeleteActivityTypeRuleRequest
+	nil,                                             // 46: fitglue.services.pipeline.SubmitInputRequest.InputDataEntry
+	(*pipeline.PipelineRun)(nil),                    // 47: fitglue.models.pipeline.PipelineRun
+	(pipeline.PipelineRunStatus)(0),                 // 48: fitglue.models.pipeline.PipelineRunStatus
+	(*pipeline.ProviderCircuit)(nil),                // 49: fitglue.models.pipeline.ProviderCircuit
+	(plugin.EnricherProviderType)(0),                // 50: fitglue.models.plugin.EnricherProviderType
+	(pipeline.ProviderCircuitMode)(0),               // 51: fitglue.models.pipeline.ProviderCircuitMode
+	(*pipeline.ProviderCircuitChange)(nil),          // 52: fitglue.models.pipeline.ProviderCircuitChange
+	(pipeline.FailedEventStatus)(0),                 // 53: fitglue.models.pipeline.FailedEventStatus
+	(*pipeline.FailedEvent)(nil),                    // 54: fitglue.models.pipeline.FailedEvent
+	(*pipeline.PipelineConfig)(nil),                 // 55: fitglue.models.pipeline.PipelineConfig
+	(*pipeline.PendingInput)(nil),                   // 56: fitglue.models.pipeline.PendingInput
+	(*events.ReplayOverride)(nil),                   // 57: fitglue.models.events.ReplayOverride
+	(*pipeline.EnricherUsage)(nil),                  // 58: fitglue.models.pipeline.EnricherUsage
+	(*timestamppb.Timestamp)(nil),                   // 59: google.protobuf.Timestamp
+	(*activity.StandardizedActivity)(nil),           // 60: fitglue.models.activity.StandardizedActivity
+	(plugin.DestinationType)(0),                     // 61: fitglue.models.plugin.DestinationType
+	(*pipeline.PipelineCalendarDay)(nil),            // 62: fitglue.models.pipeline.PipelineCalendarDay
+	(activity.ActivityType)(0),                      // 63: fitglue.models.activity.ActivityType
+	(*pipeline.ActivityTypeRule)(nil),               // 64: fitglue.models.pipeline.ActivityTypeRule
+	(*emptypb.Empty)(nil),                           // 65: google.protobuf.Empty
+	(*pipeline.PipelineRunDebugBundle)(nil),         // 66: fitglue.models.pipeline.PipelineRunDebugBundle
+	(*pipeline.PipelinePreview)(nil),                // 67: fitglue.models.pipeline.PipelinePreview
+	(*pipeline.DescriptionMergePreview)(nil),        // 68: fitglue.models.pipeline.DescriptionMergePreview
+	(*pipeline.EnricherRecommendations)(nil),        // 69: fitglue.models.pipeline.EnricherRecommendations
 }
 var file_services_pipeline_pipeline_proto_depIdxs = []int32{
-	17, // 0: fitglue.services.pipeline.AdminListPipelineRunsResponse.runs:type_name -> fitglue.models.pipeline.PipelineRun
-	18, // 1: fitglue.services.pipeline.ListPipelinesResponse.pipelines:type_name -> fitglue.models.pipeline.PipelineConfig
-	18, // 2: fitglue.services.pipeline.CreatePipelineRequest.pipeline:type_name -> fitglue.models.pipeline.PipelineConfig
-	18, // 3: fitglue.services.pipeline.UpdatePipelineRequest.pipeline:type_name -> fitglue.models.pipeline.PipelineConfig
-	16, // 4: fitglue.services.pipeline.SubmitInputRequest.input_data:type_name -> fitglue.services.pipeline.SubmitInputRequest.InputDataEntry
-	19, // 5: fitglue.services.pipeline.ListPendingInputsResponse.inputs:type_name -> fitglue.models.pipeline.PendingInput
-	17, // 6: fitglue.services.pipeline.ListPipelineRunsResponse.runs:type_name -> fitglue.models.pipeline.PipelineRun
-	2,  // 7: fitglue.services.pipeline.PipelineService.ListPipelines:input_type -> fitglue.services.pipeline.ListPipelinesRequest
-	4,  // 8: fitglue.services.pipeline.PipelineService.GetPipeline:input_type -> fitglue.services.pipeline.GetPipelineRequest
-	5,  // 9: fitglue.services.pipeline.PipelineService.CreatePipeline:input_type -> fitglue.services.pipeline.CreatePipelineRequest
-	6,  // 10: fitglue.services.pipeline.PipelineService.UpdatePipeline:input_type -> fitglue.services.pipeline.UpdatePipelineRequest
-	7,  // 11: fitglue.services.pipeline.PipelineService.DeletePipeline:input_type -> fitglue.services.pipeline.DeletePipelineRequest
-	8,  // 12: fitglue.services.pipeline.PipelineService.SubmitInput:input_type -> fitglue.services.pipeline.SubmitInputRequest
-	9,  // 13: fitglue.services.pipeline.PipelineService.ListPendingInputs:input_type -> fitglue.services.pipeline.ListPendingInputsRequest
-	11, // 14: fitglue.services.pipeline.PipelineService.ResolvePendingInput:input_type -> fitglue.services.pipeline.ResolvePendingInputRequest
-	12, // 15: fitglue.services.pipeline.PipelineService.RepostActivity:input_type -> fitglue.services.pipeline.RepostActivityRequest
-	13, // 16: fitglue.services.pipeline.PipelineService.GetPipelineRun:input_type -> fitglue.services.pipeline.GetPipelineRunRequest
-	14, // 17: fitglue.services.pipeline.PipelineService.ListPipelineRuns:input_type -> fitglue.services.pipeline.ListPipelineRunsRequest
-	0,  // 18: fitglue.services.pipeline.PipelineService.AdminListPipelineRuns:input_type -> fitglue.services.pipeline.AdminListPipelineRunsRequest
-	3,  // 19: fitglue.services.pipeline.PipelineService.ListPipelines:output_type -> fitglue.services.pipeline.ListPipelinesResponse
-	18, // 20: fitglue.services.pipeline.PipelineService.GetPipeline:output_type -> fitglue.models.pipeline.PipelineConfig
-	18, // 21: fitglue.services.pipeline.PipelineService.CreatePipeline:output_type -> fitglue.models.pipeline.PipelineConfig
-	18, // 22: fitglue.services.pipeline.PipelineService.UpdatePipeline:output_type -> fitglue.models.pipeline.PipelineConfig
-	20, // 23: fitglue.services.pipeline.PipelineService.DeletePipeline:output_type -> google.protobuf.Empty
-	20, // 24: fitglue.services.pipeline.PipelineService.SubmitInput:output_type -> google.protobuf.Empty
-	10, // 25: fitglue.services.pipeline.PipelineService.ListPendingInputs:output_type -> fitglue.services.pipeline.ListPendingInputsResponse
-	20, // 26: fitglue.services.pipeline.PipelineService.ResolvePendingInput:output_type -> google.protobuf.Empty
-	20, // 27: fitglue.services.pipeline.PipelineService.RepostActivity:output_type -> google.protobuf.Empty
-	17, // 28: fitglue.services.pipeline.PipelineService.GetPipelineRun:output_type -> fitglue.models.pipeline.PipelineRun
-	15, // 29: fitglue.services.pipeline.PipelineService.ListPipelineRuns:output_type -> fitglue.services.pipeline.ListPipelineRunsResponse
-	1,  // 30: fitglue.services.pipeline.PipelineService.AdminListPipelineRuns:output_type -> fitglue.services.pipeline.AdminListPipelineRunsResponse
-	19, // [19:31] is the sub-list for method output_type
-	7,  // [7:19] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	47, // 0: fitglue.services.pipeline.AdminListPipelineRunsResponse.runs:type_name -> fitglue.models.pipeline.PipelineRun
+	48, // 1: fitglue.services.pipeline.AdminForceCompletePipelineRunRequest.status:type_name -> fitglue.models.pipeline.PipelineRunStatus
+	49, // 2: fitglue.services.pipeline.AdminListProviderCircuitsResponse.circuits:type_name -> fitglue.models.pipeline.ProviderCircuit
+	50, // 3: fitglue.services.pipeline.AdminSetProviderCircuitModeRequest.provider_type:type_name -> fitglue.models.plugin.EnricherProviderType
+	51, // 4: fitglue.services.pipeline.AdminSetProviderCircuitModeRequest.mode:type_name -> fitglue.models.pipeline.ProviderCircuitMode
+	50, // 5: fitglue.services.pipeline.AdminListProviderCircuitChangesRequest.provider_type:type_name -> fitglue.models.plugin.EnricherProviderType
+	52, // 6: fitglue.services.pipeline.AdminListProviderCircuitChangesResponse.changes:type_name -> fitglue.models.pipeline.ProviderCircuitChange
+	53, // 7: fitglue.services.pipeline.AdminListFailedEventsRequest.status:type_name -> fitglue.models.pipeline.FailedEventStatus
+	54, // 8: fitglue.services.pipeline.AdminListFailedEventsResponse.events:type_name -> fitglue.models.pipeline.FailedEvent
+	54, // 9: fitglue.services.pipeline.AdminRedriveFailedEventsResponse.events:type_name -> fitglue.models.pipeline.FailedEvent
+	55, // 10: fitglue.services.pipeline.ListPipelinesResponse.pipelines:type_name -> fitglue.models.pipeline.PipelineConfig
+	55, // 11: fitglue.services.pipeline.CreatePipelineRequest.pipeline:type_name -> fitglue.models.pipeline.PipelineConfig
+	55, // 12: fitglue.services.pipeline.UpdatePipelineRequest.pipeline:type_name -> fitglue.models.pipeline.PipelineConfig
+	46, // 13: fitglue.services.pipeline.SubmitInputRequest.input_data:type_name -> fitglue.services.pipeline.SubmitInputRequest.InputDataEntry
+	56, // 14: fitglue.services.pipeline.ListPendingInputsResponse.inputs:type_name -> fitglue.models.pipeline.PendingInput
+	57, // 15: fitglue.services.pipeline.RepostActivityRequest.replay_override:type_name -> fitglue.models.events.ReplayOverride
+	55, // 16: fitglue.services.pipeline.ProvisionStarterPipelineResponse.pipeline:type_name -> fitglue.models.pipeline.PipelineConfig
+	47, // 17: fitglue.services.pipeline.ListPipelineRunsResponse.runs:type_name -> fitglue.models.pipeline.PipelineRun
+	58, // 18: fitglue.services.pipeline.GetEnricherUsageResponse.enrichers:type_name -> fitglue.models.pipeline.EnricherUsage
+	59, // 19: fitglue.services.pipeline.PausePipelinesRequest.paused_until:type_name -> google.protobuf.Timestamp
+	60, // 20: fitglue.services.pipeline.PreviewPipelineRequest.activity:type_name -> fitglue.models.activity.StandardizedActivity
+	61, // 21: fitglue.services.pipeline.PreviewDescriptionMergeRequest.destination:type_name -> fitglue.models.plugin.DestinationType
+	62, // 22: fitglue.services.pipeline.GetPipelineCalendarResponse.days:type_name -> fitglue.models.pipeline.PipelineCalendarDay
+	63, // 23: fitglue.services.pipeline.CorrectActivityTypeRequest.activity_type:type_name -> fitglue.models.activity.ActivityType
+	64, // 24: fitglue.services.pipeline.CorrectActivityTypeResponse.rule:type_name -> fitglue.models.pipeline.ActivityTypeRule
+	64, // 25: fitglue.services.pipeline.ListActivityTypeRulesResponse.rules:type_name -> fitglue.models.pipeline.ActivityTypeRule
+	12, // 26: fitglue.services.pipeline.PipelineService.ListPipelines:input_type -> fitglue.services.pipeline.ListPipelinesRequest
+	14, // 27: fitglue.services.pipeline.PipelineService.GetPipeline:input_type -> fitglue.services.pipeline.GetPipelineRequest
+	15, // 28: fitglue.services.pipeline.PipelineService.CreatePipeline:input_type -> fitglue.services.pipeline.CreatePipelineRequest
+	16, // 29: fitglue.services.pipeline.PipelineService.UpdatePipeline:input_type -> fitglue.services.pipeline.UpdatePipelineRequest
+	17, // 30: fitglue.services.pipeline.PipelineService.DeletePipeline:input_type -> fitglue.services.pipeline.DeletePipelineRequest
+	23, // 31: fitglue.services.pipeline.PipelineService.ProvisionStarterPipeline:input_type -> fitglue.services.pipeline.ProvisionStarterPipelineRequest
+	18, // 32: fitglue.services.pipeline.PipelineService.SubmitInput:input_type -> fitglue.services.pipeline.SubmitInputRequest
+	19, // 33: fitglue.services.pipeline.PipelineService.ListPendingInputs:input_type -> fitglue.services.pipeline.ListPendingInputsRequest
+	21, // 34: fitglue.services.pipeline.PipelineService.ResolvePendingInput:input_type -> fitglue.services.pipeline.ResolvePendingInputRequest
+	22, // 35: fitglue.services.pipeline.PipelineService.RepostActivity:input_type -> fitglue.services.pipeline.RepostActivityRequest
+	25, // 36: fitglue.services.pipeline.PipelineService.RetryPipelineRun:input_type -> fitglue.services.pipeline.RetryPipelineRunRequest
+	26, // 37: fitglue.services.pipeline.PipelineService.GetPipelineRun:input_type -> fitglue.services.pipeline.GetPipelineRunRequest
+	27, // 38: fitglue.services.pipeline.PipelineService.GetPipelineRunDebugBundle:input_type -> fitglue.services.pipeline.GetPipelineRunDebugBundleRequest
+	28, // 39: fitglue.services.pipeline.PipelineService.ListPipelineRuns:input_type -> fitglue.services.pipeline.ListPipelineRunsRequest
+	30, // 40: fitglue.services.pipeline.PipelineService.GetEnricherUsage:input_type -> fitglue.services.pipeline.GetEnricherUsageRequest
+	32, // 41: fitglue.services.pipeline.PipelineService.PausePipelines:input_type -> fitglue.services.pipeline.PausePipelinesRequest
+	33, // 42: fitglue.services.pipeline.PipelineService.ResumePipelines:input_type -> fitglue.services.pipeline.ResumePipelinesRequest
+	37, // 43: fitglue.services.pipeline.PipelineService.GetPipelineCalendar:input_type -> fitglue.services.pipeline.GetPipelineCalendarRequest
+	35, // 44: fitglue.services.pipeline.PipelineService.PreviewPipeline:input_type -> fitglue.services.pipeline.PreviewPipelineRequest
+	36, // 45: fitglue.services.pipeline.PipelineService.PreviewDescriptionMerge:input_type -> fitglue.services.pipeline.PreviewDescriptionMergeRequest
+	39, // 46: fitglue.services.pipeline.PipelineService.GetEnricherRecommendations:input_type -> fitglue.services.pipeline.GetEnricherRecommendationsRequest
+	40, // 47: fitglue.services.pipeline.PipelineService.CorrectActivityType:input_type -> fitglue.services.pipeline.CorrectActivityTypeRequest
+	42, // 48: fitglue.services.pipeline.PipelineService.ListActivityTypeRules:input_type -> fitglue.services.pipeline.ListActivityTypeRulesRequest
+	44, // 49: fitglue.services.pipeline.PipelineService.UpdateActivityTypeRule:input_type -> fitglue.services.pipeline.UpdateActivityTypeRuleRequest
+	45, // 50: fitglue.services.pipeline.PipelineService.DeleteActivityTypeRule:input_type -> fitglue.services.pipeline.DeleteActivityTypeRuleRequest
+	0,  // 51: fitglue.services.pipeline.PipelineService.AdminListPipelineRuns:input_type -> fitglue.services.pipeline.AdminListPipelineRunsRequest
+	2,  // 52: fitglue.services.pipeline.PipelineService.AdminForceCompletePipelineRun:input_type -> fitglue.services.pipeline.AdminForceCompletePipelineRunRequest
+	3,  // 53: fitglue.services.pipeline.PipelineService.AdminListProviderCircuits:input_type -> fitglue.services.pipeline.AdminListProviderCircuitsRequest
+	5,  // 54: fitglue.services.pipeline.PipelineService.AdminSetProviderCircuitMode:input_type -> fitglue.services.pipeline.AdminSetProviderCircuitModeRequest
+	6,  // 55: fitglue.services.pipeline.PipelineService.AdminListProviderCircuitChanges:input_type -> fitglue.services.pipeline.AdminListProviderCircuitChangesRequest
+	8,  // 56: fitglue.services.pipeline.PipelineService.AdminListFailedEvents:input_type -> fitglue.services.pipeline.AdminListFailedEventsRequest
+	10, // 57: fitglue.services.pipeline.PipelineService.AdminRedriveFailedEvents:input_type -> fitglue.services.pipeline.AdminRedriveFailedEventsRequest
+	13, // 58: fitglue.services.pipeline.PipelineService.ListPipelines:output_type -> fitglue.services.pipeline.ListPipelinesResponse
+	55, // 59: fitglue.services.pipeline.PipelineService.GetPipeline:output_type -> fitglue.models.pipeline.PipelineConfig
+	55, // 60: fitglue.services.pipeline.PipelineService.CreatePipeline:output_type -> fitglue.models.pipeline.PipelineConfig
+	55, // 61: fitglue.services.pipeline.PipelineService.UpdatePipeline:output_type -> fitglue.models.pipeline.PipelineConfig
+	65, // 62: fitglue.services.pipeline.PipelineService.DeletePipeline:output_type -> google.protobuf.Empty
+	24, // 63: fitglue.services.pipeline.PipelineService.ProvisionStarterPipeline:output_type -> fitglue.services.pipeline.ProvisionStarterPipelineResponse
+	65, // 64: fitglue.services.pipeline.PipelineService.SubmitInput:output_type -> google.protobuf.Empty
+	20, // 65: fitglue.services.pipeline.PipelineService.ListPendingInputs:output_type -> fitglue.services.pipeline.ListPendingInputsResponse
+	65, // 66: fitglue.services.pipeline.PipelineService.ResolvePendingInput:output_type -> google.protobuf.Empty
+	65, // 67: fitglue.services.pipeline.PipelineService.RepostActivity:output_type -> google.protobuf.Empty
+	65, // 68: fitglue.services.pipeline.PipelineService.RetryPipelineRun:output_type -> google.protobuf.Empty
+	47, // 69: fitglue.services.pipeline.PipelineService.GetPipelineRun:output_type -> fitglue.models.pipeline.PipelineRun
+	66, // 70: fitglue.services.pipeline.PipelineService.GetPipelineRunDebugBundle:output_type -> fitglue.models.pipeline.PipelineRunDebugBundle
+	29, // 71: fitglue.services.pipeline.PipelineService.ListPipelineRuns:output_type -> fitglue.services.pipeline.ListPipelineRunsResponse
+	31, // 72: fitglue.services.pipeline.PipelineService.GetEnricherUsage:output_type -> fitglue.services.pipeline.GetEnricherUsageResponse
+	65, // 73: fitglue.services.pipeline.PipelineService.PausePipelines:output_type -> google.protobuf.Empty
+	34, // 74: fitglue.services.pipeline.PipelineService.ResumePipelines:output_type -> fitglue.services.pipeline.ResumePipelinesResponse
+	38, // 75: fitglue.services.pipeline.PipelineService.GetPipelineCalendar:output_type -> fitglue.services.pipeline.GetPipelineCalendarResponse
+	67, // 76: fitglue.services.pipeline.PipelineService.PreviewPipeline:output_type -> fitglue.models.pipeline.PipelinePreview
+	68, // 77: fitglue.services.pipeline.PipelineService.PreviewDescriptionMerge:output_type -> fitglue.models.pipeline.DescriptionMergePreview
+	69, // 78: fitglue.services.pipeline.PipelineService.GetEnricherRecommendations:output_type -> fitglue.models.pipeline.EnricherRecommendations
+	41, // 79: fitglue.services.pipeline.PipelineService.CorrectActivityType:output_type -> fitglue.services.pipeline.CorrectActivityTypeResponse
+	43, // 80: fitglue.services.pipeline.PipelineService.ListActivityTypeRules:output_type -> fitglue.services.pipeline.ListActivityTypeRulesResponse
+	64, // 81: fitglue.services.pipeline.PipelineService.UpdateActivityTypeRule:output_type -> fitglue.models.pipeline.ActivityTypeRule
+	65, // 82: fitglue.services.pipeline.PipelineService.DeleteActivityTypeRule:output_type -> google.protobuf.Empty
+	1,  // 83: fitglue.services.pipeline.PipelineService.AdminListPipelineRuns:output_type -> fitglue.services.pipeline.AdminListPipelineRunsResponse
+	47, // 84: fitglue.services.pipeline.PipelineService.AdminForceCompletePipelineRun:output_type -> fitglue.models.pipeline.PipelineRun
+	4,  // 85: fitglue.services.pipeline.PipelineService.AdminListProviderCircuits:output_type -> fitglue.services.pipeline.AdminListProviderCircuitsResponse
+	49, // 86: fitglue.services.pipeline.PipelineService.AdminSetProviderCircuitMode:output_type -> fitglue.models.pipeline.ProviderCircuit
+	7,  // 87: fitglue.services.pipeline.PipelineService.AdminListProviderCircuitChanges:output_type -> fitglue.services.pipeline.AdminListProviderCircuitChangesResponse
+	9,  // 88: fitglue.services.pipeline.PipelineService.AdminListFailedEvents:output_type -> fitglue.services.pipeline.AdminListFailedEventsResponse
+	11, // 89: fitglue.services.pipeline.PipelineService.AdminRedriveFailedEvents:output_type -> fitglue.services.pipeline.AdminRedriveFailedEventsResponse
+	58, // [58:90] is the sub-list for method output_type
+	26, // [26:58] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_services_pipeline_pipeline_proto_init() }
@@ -1068,7 +2964,7 @@ func file_services_pipeline_pipeline_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_pipeline_pipeline_proto_rawDesc), len(file_services_pipeline_pipeline_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,18 +21,38 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PipelineService_ListPipelines_FullMethodName         = "/fitglue.services.pipeline.PipelineService/ListPipelines"
-	PipelineService_GetPipeline_FullMethodName           = "/fitglue.services.pipeline.PipelineService/GetPipeline"
-	PipelineService_CreatePipeline_FullMethodName        = "/fitglue.services.pipeline.PipelineService/CreatePipeline"
-	PipelineService_UpdatePipeline_FullMethodName        = "/fitglue.services.pipeline.PipelineService/UpdatePipeline"
-	PipelineService_DeletePipeline_FullMethodName        = "/fitglue.services.pipeline.PipelineService/DeletePipeline"
-	PipelineService_SubmitInput_FullMethodName           = "/fitglue.services.pipeline.PipelineService/SubmitInput"
-	PipelineService_ListPendingInputs_FullMethodName     = "/fitglue.services.pipeline.PipelineService/ListPendingInputs"
-	PipelineService_ResolvePendingInput_FullMethodName   = "/fitglue.services.pipeline.PipelineService/ResolvePendingInput"
-	PipelineService_RepostActivity_FullMethodName        = "/fitglue.services.pipeline.PipelineService/RepostActivity"
-	PipelineService_GetPipelineRun_FullMethodName        = "/fitglue.services.pipeline.PipelineService/GetPipelineRun"
-	PipelineService_ListPipelineRuns_FullMethodName      = "/fitglue.services.pipeline.PipelineService/ListPipelineRuns"
-	PipelineService_AdminListPipelineRuns_FullMethodName = "/fitglue.services.pipeline.PipelineService/AdminListPipelineRuns"
+	PipelineService_ListPipelines_FullMethodName                   = "/fitglue.services.pipeline.PipelineService/ListPipelines"
+	PipelineService_GetPipeline_FullMethodName                     = "/fitglue.services.pipeline.PipelineService/GetPipeline"
+	PipelineService_CreatePipeline_FullMethodName                  = "/fitglue.services.pipeline.PipelineService/CreatePipeline"
+	PipelineService_UpdatePipeline_FullMethodName                  = "/fitglue.services.pipeline.PipelineService/UpdatePipeline"
+	PipelineService_DeletePipeline_FullMethodName                  = "/fitglue.services.pipeline.PipelineService/DeletePipeline"
+	PipelineService_ProvisionStarterPipeline_FullMethodName        = "/fitglue.services.pipeline.PipelineService/ProvisionStarterPipeline"
+	PipelineService_SubmitInput_FullMethodName                     = "/fitglue.services.pipeline.PipelineService/SubmitInput"
+	PipelineService_ListPendingInputs_FullMethodName               = "/fitglue.services.pipeline.PipelineService/ListPendingInputs"
+	PipelineService_ResolvePendingInput_FullMethodName             = "/fitglue.services.pipeline.PipelineService/ResolvePendingInput"
+	PipelineService_RepostActivity_FullMethodName                  = "/fitglue.services.pipeline.PipelineService/RepostActivity"
+	PipelineService_RetryPipelineRun_FullMethodName                = "/fitglue.services.pipeline.PipelineService/RetryPipelineRun"
+	PipelineService_GetPipelineRun_FullMethodName                  = "/fitglue.services.pipeline.PipelineService/GetPipelineRun"
+	PipelineService_GetPipelineRunDebugBundle_FullMethodName       = "/fitglue.services.pipeline.PipelineService/GetPipelineRunDebugBundle"
+	PipelineService_ListPipelineRuns_FullMethodName                = "/fitglue.services.pipeline.PipelineService/ListPipelineRuns"
+	PipelineService_GetEnricherUsage_FullMethodName                = "/fitglue.services.pipeline.PipelineService/GetEnricherUsage"
+	PipelineService_PausePipelines_FullMethodName                  = "/fitglue.services.pipeline.PipelineService/PausePipelines"
+	PipelineService_ResumePipelines_FullMethodName                 = "/fitglue.services.pipeline.PipelineService/ResumePipelines"
+	PipelineService_GetPipelineCalendar_FullMethodName             = "/fitglue.services.pipeline.PipelineService/GetPipelineCalendar"
+	PipelineService_PreviewPipeline_FullMethodName                 = "/fitglue.services.pipeline.PipelineService/PreviewPipeline"
+	PipelineService_PreviewDescriptionMerge_FullMethodName         = "/fitglue.services.pipeline.PipelineService/PreviewDescriptionMerge"
+	PipelineService_GetEnricherRecommendations_FullMethodName      = "/fitglue.services.pipeline.PipelineService/GetEnricherRecommendations"
+	PipelineService_CorrectActivityType_FullMethodName             = "/fitglue.services.pipeline.PipelineService/CorrectActivityType"
+	PipelineService_ListActivityTypeRules_FullMethodName           = "/fitglue.services.pipeline.PipelineService/ListActivityTypeRules"
+	PipelineService_UpdateActivityTypeRule_FullMethodName          = "/fitglue.services.pipeline.PipelineService/UpdateActivityTypeRule"
+	PipelineService_DeleteActivityTypeRule_FullMethodName          = "/fitglue.services.pipeline.PipelineService/DeleteActivityTypeRule"
+	PipelineService_AdminListPipelineRuns_FullMethodName           = "/fitglue.services.pipeline.PipelineService/AdminListPipelineRuns"
+	PipelineService_AdminForceCompletePipelineRun_FullMethodName   = "/fitglue.services.pipeline.PipelineService/AdminForceCompletePipelineRun"
+	PipelineService_AdminListProviderCircuits_FullMethodName       = "/fitglue.services.pipeline.PipelineService/AdminListProviderCircuits"
+	PipelineService_AdminSetProviderCircuitMode_FullMethodName     = "/fitglue.services.pipeline.PipelineService/AdminSetProviderCircuitMode"
+	PipelineService_AdminListProviderCircuitChanges_FullMethodName = "/fitglue.services.pipeline.PipelineService/AdminListProviderCircuitChanges"
+	PipelineService_AdminListFailedEvents_FullMethodName           = "/fitglue.services.pipeline.PipelineService/AdminListFailedEvents"
+	PipelineService_AdminRedriveFailedEvents_FullMethodName        = "/fitglue.services.pipeline.PipelineService/AdminRedriveFailedEvents"
 )
 
 // PipelineServiceClient is the client API for PipelineService service.
@@ -44,13 +64,46 @@ type PipelineServiceClient interface {
 	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*pipeline.PipelineConfig, error)
 	UpdatePipeline(ctx context.Context, in *UpdatePipelineRequest, opts ...grpc.CallOption) (*pipeline.PipelineConfig, error)
 	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates a starter pipeline once the user has connected a source and a
+	// destination, and pushes a test activity through it to the sandbox
+	// (mock) destination. Does nothing for users who already had one.
+	ProvisionStarterPipeline(ctx context.Context, in *ProvisionStarterPipelineRequest, opts ...grpc.CallOption) (*ProvisionStarterPipelineResponse, error)
 	SubmitInput(ctx context.Context, in *SubmitInputRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListPendingInputs(ctx context.Context, in *ListPendingInputsRequest, opts ...grpc.CallOption) (*ListPendingInputsResponse, error)
 	ResolvePendingInput(ctx context.Context, in *ResolvePendingInputRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RepostActivity(ctx context.Context, in *RepostActivityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Re-runs a pipeline run from its original payload as a targeted resume,
+	// optionally limited to some of its enrichers
+	RetryPipelineRun(ctx context.Context, in *RetryPipelineRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetPipelineRun(ctx context.Context, in *GetPipelineRunRequest, opts ...grpc.CallOption) (*pipeline.PipelineRun, error)
+	GetPipelineRunDebugBundle(ctx context.Context, in *GetPipelineRunDebugBundleRequest, opts ...grpc.CallOption) (*pipeline.PipelineRunDebugBundle, error)
 	ListPipelineRuns(ctx context.Context, in *ListPipelineRunsRequest, opts ...grpc.CallOption) (*ListPipelineRunsResponse, error)
+	GetEnricherUsage(ctx context.Context, in *GetEnricherUsageRequest, opts ...grpc.CallOption) (*GetEnricherUsageResponse, error)
+	PausePipelines(ctx context.Context, in *PausePipelinesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResumePipelines(ctx context.Context, in *ResumePipelinesRequest, opts ...grpc.CallOption) (*ResumePipelinesResponse, error)
+	GetPipelineCalendar(ctx context.Context, in *GetPipelineCalendarRequest, opts ...grpc.CallOption) (*GetPipelineCalendarResponse, error)
+	// Runs the pipeline's enrichers over an activity without side effects,
+	// for live previews while editing a pipeline
+	PreviewPipeline(ctx context.Context, in *PreviewPipelineRequest, opts ...grpc.CallOption) (*pipeline.PipelinePreview, error)
+	// Shows how an update would change the description already on a
+	// destination, so the UI can confirm overwrites of the user's edits
+	PreviewDescriptionMerge(ctx context.Context, in *PreviewDescriptionMergeRequest, opts ...grpc.CallOption) (*pipeline.DescriptionMergePreview, error)
+	GetEnricherRecommendations(ctx context.Context, in *GetEnricherRecommendationsRequest, opts ...grpc.CallOption) (*pipeline.EnricherRecommendations, error)
+	// Activity type learning
+	CorrectActivityType(ctx context.Context, in *CorrectActivityTypeRequest, opts ...grpc.CallOption) (*CorrectActivityTypeResponse, error)
+	ListActivityTypeRules(ctx context.Context, in *ListActivityTypeRulesRequest, opts ...grpc.CallOption) (*ListActivityTypeRulesResponse, error)
+	UpdateActivityTypeRule(ctx context.Context, in *UpdateActivityTypeRuleRequest, opts ...grpc.CallOption) (*pipeline.ActivityTypeRule, error)
+	DeleteActivityTypeRule(ctx context.Context, in *DeleteActivityTypeRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AdminListPipelineRuns(ctx context.Context, in *AdminListPipelineRunsRequest, opts ...grpc.CallOption) (*AdminListPipelineRunsResponse, error)
+	// Marks a stuck PENDING or RUNNING run finished without running it further
+	AdminForceCompletePipelineRun(ctx context.Context, in *AdminForceCompletePipelineRunRequest, opts ...grpc.CallOption) (*pipeline.PipelineRun, error)
+	// Enricher provider circuits (shared skip-list) and their history
+	AdminListProviderCircuits(ctx context.Context, in *AdminListProviderCircuitsRequest, opts ...grpc.CallOption) (*AdminListProviderCircuitsResponse, error)
+	AdminSetProviderCircuitMode(ctx context.Context, in *AdminSetProviderCircuitModeRequest, opts ...grpc.CallOption) (*pipeline.ProviderCircuit, error)
+	AdminListProviderCircuitChanges(ctx context.Context, in *AdminListProviderCircuitChangesRequest, opts ...grpc.CallOption) (*AdminListProviderCircuitChangesResponse, error)
+	// Dead-lettered pipeline events
+	AdminListFailedEvents(ctx context.Context, in *AdminListFailedEventsRequest, opts ...grpc.CallOption) (*AdminListFailedEventsResponse, error)
+	AdminRedriveFailedEvents(ctx context.Context, in *AdminRedriveFailedEventsRequest, opts ...grpc.CallOption) (*AdminRedriveFailedEventsResponse, error)
 }
 
 type pipelineServiceClient struct {
@@ -111,6 +164,16 @@ func (c *pipelineServiceClient) DeletePipeline(ctx context.Context, in *DeletePi
 	return out, nil
 }
 
+func (c *pipelineServiceClient) ProvisionStarterPipeline(ctx context.Context, in *ProvisionStarterPipelineRequest, opts ...grpc.CallOption) (*ProvisionStarterPipelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProvisionStarterPipelineResponse)
+	err := c.cc.Invoke(ctx, PipelineService_ProvisionStarterPipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pipelineServiceClient) SubmitInput(ctx context.Context, in *SubmitInputRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -151,6 +214,16 @@ func (c *pipelineServiceClient) RepostActivity(ctx context.Context, in *RepostAc
 	return out, nil
 }
 
+func (c *pipelineServiceClient) RetryPipelineRun(ctx context.Context, in *RetryPipelineRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PipelineService_RetryPipelineRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pipelineServiceClient) GetPipelineRun(ctx context.Context, in *GetPipelineRunRequest, opts ...grpc.CallOption) (*pipeline.PipelineRun, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(pipeline.PipelineRun)
@@ -161,6 +234,16 @@ func (c *pipelineServiceClient) GetPipelineRun(ctx context.Context, in *GetPipel
 	return out, nil
 }
 
+func (c *pipelineServiceClient) GetPipelineRunDebugBundle(ctx context.Context, in *GetPipelineRunDebugBundleRequest, opts ...grpc.CallOption) (*pipeline.PipelineRunDebugBundle, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.PipelineRunDebugBundle)
+	err := c.cc.Invoke(ctx, PipelineService_GetPipelineRunDebugBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pipelineServiceClient) ListPipelineRuns(ctx context.Context, in *ListPipelineRunsRequest, opts ...grpc.CallOption) (*ListPipelineRunsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPipelineRunsResponse)
@@ -171,6 +254,116 @@ func (c *pipelineServiceClient) ListPipelineRuns(ctx context.Context, in *ListPi
 	return out, nil
 }
 
+func (c *pipelineServiceClient) GetEnricherUsage(ctx context.Context, in *GetEnricherUsageRequest, opts ...grpc.CallOption) (*GetEnricherUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEnricherUsageResponse)
+	err := c.cc.Invoke(ctx, PipelineService_GetEnricherUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) PausePipelines(ctx context.Context, in *PausePipelinesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PipelineService_PausePipelines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) ResumePipelines(ctx context.Context, in *ResumePipelinesRequest, opts ...grpc.CallOption) (*ResumePipelinesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumePipelinesResponse)
+	err := c.cc.Invoke(ctx, PipelineService_ResumePipelines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) GetPipelineCalendar(ctx context.Context, in *GetPipelineCalendarRequest, opts ...grpc.CallOption) (*GetPipelineCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPipelineCalendarResponse)
+	err := c.cc.Invoke(ctx, PipelineService_GetPipelineCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) PreviewPipeline(ctx context.Context, in *PreviewPipelineRequest, opts ...grpc.CallOption) (*pipeline.PipelinePreview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.PipelinePreview)
+	err := c.cc.Invoke(ctx, PipelineService_PreviewPipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) PreviewDescriptionMerge(ctx context.Context, in *PreviewDescriptionMergeRequest, opts ...grpc.CallOption) (*pipeline.DescriptionMergePreview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.DescriptionMergePreview)
+	err := c.cc.Invoke(ctx, PipelineService_PreviewDescriptionMerge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) GetEnricherRecommendations(ctx context.Context, in *GetEnricherRecommendationsRequest, opts ...grpc.CallOption) (*pipeline.EnricherRecommendations, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.EnricherRecommendations)
+	err := c.cc.Invoke(ctx, PipelineService_GetEnricherRecommendations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) CorrectActivityType(ctx context.Context, in *CorrectActivityTypeRequest, opts ...grpc.CallOption) (*CorrectActivityTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CorrectActivityTypeResponse)
+	err := c.cc.Invoke(ctx, PipelineService_CorrectActivityType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) ListActivityTypeRules(ctx context.Context, in *ListActivityTypeRulesRequest, opts ...grpc.CallOption) (*ListActivityTypeRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListActivityTypeRulesResponse)
+	err := c.cc.Invoke(ctx, PipelineService_ListActivityTypeRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) UpdateActivityTypeRule(ctx context.Context, in *UpdateActivityTypeRuleRequest, opts ...grpc.CallOption) (*pipeline.ActivityTypeRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.ActivityTypeRule)
+	err := c.cc.Invoke(ctx, PipelineService_UpdateActivityTypeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) DeleteActivityTypeRule(ctx context.Context, in *DeleteActivityTypeRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PipelineService_DeleteActivityTypeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pipelineServiceClient) AdminListPipelineRuns(ctx context.Context, in *AdminListPipelineRunsRequest, opts ...grpc.CallOption) (*AdminListPipelineRunsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AdminListPipelineRunsResponse)
@@ -181,6 +374,66 @@ func (c *pipelineServiceClient) AdminListPipelineRuns(ctx context.Context, in *A
 	return out, nil
 }
 
+func (c *pipelineServiceClient) AdminForceCompletePipelineRun(ctx context.Context, in *AdminForceCompletePipelineRunRequest, opts ...grpc.CallOption) (*pipeline.PipelineRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.PipelineRun)
+	err := c.cc.Invoke(ctx, PipelineService_AdminForceCompletePipelineRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) AdminListProviderCircuits(ctx context.Context, in *AdminListProviderCircuitsRequest, opts ...grpc.CallOption) (*AdminListProviderCircuitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListProviderCircuitsResponse)
+	err := c.cc.Invoke(ctx, PipelineService_AdminListProviderCircuits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) AdminSetProviderCircuitMode(ctx context.Context, in *AdminSetProviderCircuitModeRequest, opts ...grpc.CallOption) (*pipeline.ProviderCircuit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pipeline.ProviderCircuit)
+	err := c.cc.Invoke(ctx, PipelineService_AdminSetProviderCircuitMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) AdminListProviderCircuitChanges(ctx context.Context, in *AdminListProviderCircuitChangesRequest, opts ...grpc.CallOption) (*AdminListProviderCircuitChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListProviderCircuitChangesResponse)
+	err := c.cc.Invoke(ctx, PipelineService_AdminListProviderCircuitChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) AdminListFailedEvents(ctx context.Context, in *AdminListFailedEventsRequest, opts ...grpc.CallOption) (*AdminListFailedEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListFailedEventsResponse)
+	err := c.cc.Invoke(ctx, PipelineService_AdminListFailedEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) AdminRedriveFailedEvents(ctx context.Context, in *AdminRedriveFailedEventsRequest, opts ...grpc.CallOption) (*AdminRedriveFailedEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminRedriveFailedEventsResponse)
+	err := c.cc.Invoke(ctx, PipelineService_AdminRedriveFailedEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PipelineServiceServer is the server API for PipelineService service.
 // All implementations must embed UnimplementedPipelineServiceServer
 // for forward compatibility.
@@ -190,13 +443,46 @@ type PipelineServiceServer interface {
 	CreatePipeline(context.Context, *CreatePipelineRequest) (*pipeline.PipelineConfig, error)
 	UpdatePipeline(context.Context, *UpdatePipelineRequest) (*pipeline.PipelineConfig, error)
 	DeletePipeline(context.Context, *DeletePipelineRequest) (*emptypb.Empty, error)
+	// Creates a starter pipeline once the user has connected a source and a
+	// destination, and pushes a test activity through it to the sandbox
+	// (mock) destination. Does nothing for users who already had one.
+	ProvisionStarterPipeline(context.Context, *ProvisionStarterPipelineRequest) (*ProvisionStarterPipelineResponse, error)
 	SubmitInput(context.Context, *SubmitInputRequest) (*emptypb.Empty, error)
 	ListPendingInputs(context.Context, *ListPendingInputsRequest) (*ListPendingInputsResponse, error)
 	ResolvePendingInput(context.Context, *ResolvePendingInputRequest) (*emptypb.Empty, error)
 	RepostActivity(context.Context, *RepostActivityRequest) (*emptypb.Empty, error)
+	// Re-runs a pipeline run from its original payload as a targeted resume,
+	// optionally limited to some of its enrichers
+	RetryPipelineRun(context.Context, *RetryPipelineRunRequest) (*emptypb.Empty, error)
 	GetPipelineRun(context.Context, *GetPipelineRunRequest) (*pipeline.PipelineRun, error)
+	GetPipelineRunDebugBundle(context.Context, *GetPipelineRunDebugBundleRequest) (*pipeline.PipelineRunDebugBundle, error)
 	ListPipelineRuns(context.Context, *ListPipelineRunsRequest) (*ListPipelineRunsResponse, error)
+	GetEnricherUsage(context.Context, *GetEnricherUsageRequest) (*GetEnricherUsageResponse, error)
+	PausePipelines(context.Context, *PausePipelinesRequest) (*emptypb.Empty, error)
+	ResumePipelines(context.Context, *ResumePipelinesRequest) (*ResumePipelinesResponse, error)
+	GetPipelineCalendar(context.Context, *GetPipelineCalendarRequest) (*GetPipelineCalendarResponse, error)
+	// Runs the pipeline's enrichers over an activity without side effects,
+	// for live previews while editing a pipeline
+	PreviewPipeline(context.Context, *PreviewPipelineRequest) (*pipeline.PipelinePreview, error)
+	// Shows how an update would change the description already on a
+	// destination, so the UI can confirm overwrites of the user's edits
+	PreviewDescriptionMerge(context.Context, *PreviewDescriptionMergeRequest) (*pipeline.DescriptionMergePreview, error)
+	GetEnricherRecommendations(context.Context, *GetEnricherRecommendationsRequest) (*pipeline.EnricherRecommendations, error)
+	// Activity type learning
+	CorrectActivityType(context.Context, *CorrectActivityTypeRequest) (*CorrectActivityTypeResponse, error)
+	ListActivityTypeRules(context.Context, *ListActivityTypeRulesRequest) (*ListActivityTypeRulesResponse, error)
+	UpdateActivityTypeRule(context.Context, *UpdateActivityTypeRuleRequest) (*pipeline.ActivityTypeRule, error)
+	DeleteActivityTypeRule(context.Context, *DeleteActivityTypeRuleRequest) (*emptypb.Empty, error)
 	AdminListPipelineRuns(context.Context, *AdminListPipelineRunsRequest) (*AdminListPipelineRunsResponse, error)
+	// Marks a stuck PENDING or RUNNING run finished without running it further
+	AdminForceCompletePipelineRun(context.Context, *AdminForceCompletePipelineRunRequest) (*pipeline.PipelineRun, error)
+	// Enricher provider circuits (shared skip-list) and their history
+	AdminListProviderCircuits(context.Context, *AdminListProviderCircuitsRequest) (*AdminListProviderCircuitsResponse, error)
+	AdminSetProviderCircuitMode(context.Context, *AdminSetProviderCircuitModeRequest) (*pipeline.ProviderCircuit, error)
+	AdminListProviderCircuitChanges(context.Context, *AdminListProviderCircuitChangesRequest) (*AdminListProviderCircuitChangesResponse, error)
+	// Dead-lettered pipeline events
+	AdminListFailedEvents(context.Context, *AdminListFailedEventsRequest) (*AdminListFailedEventsResponse, error)
+	AdminRedriveFailedEvents(context.Context, *AdminRedriveFailedEventsRequest) (*AdminRedriveFailedEventsResponse, error)
 	mustEmbedUnimplementedPipelineServiceServer()
 }
 
@@ -222,6 +508,9 @@ func (UnimplementedPipelineServiceServer) UpdatePipeline(context.Context, *Updat
 func (UnimplementedPipelineServiceServer) DeletePipeline(context.Context, *DeletePipelineRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeletePipeline not implemented")
 }
+func (UnimplementedPipelineServiceServer) ProvisionStarterPipeline(context.Context, *ProvisionStarterPipelineRequest) (*ProvisionStarterPipelineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ProvisionStarterPipeline not implemented")
+}
 func (UnimplementedPipelineServiceServer) SubmitInput(context.Context, *SubmitInputRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method SubmitInput not implemented")
 }
@@ -234,15 +523,72 @@ func (UnimplementedPipelineServiceServer) ResolvePendingInput(context.Context, *
 func (UnimplementedPipelineServiceServer) RepostActivity(context.Context, *RepostActivityRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method RepostActivity not implemented")
 }
+func (UnimplementedPipelineServiceServer) RetryPipelineRun(context.Context, *RetryPipelineRunRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryPipelineRun not implemented")
+}
 func (UnimplementedPipelineServiceServer) GetPipelineRun(context.Context, *GetPipelineRunRequest) (*pipeline.PipelineRun, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPipelineRun not implemented")
 }
+func (UnimplementedPipelineServiceServer) GetPipelineRunDebugBundle(context.Context, *GetPipelineRunDebugBundleRequest) (*pipeline.PipelineRunDebugBundle, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPipelineRunDebugBundle not implemented")
+}
 func (UnimplementedPipelineServiceServer) ListPipelineRuns(context.Context, *ListPipelineRunsRequest) (*ListPipelineRunsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListPipelineRuns not implemented")
 }
+func (UnimplementedPipelineServiceServer) GetEnricherUsage(context.Context, *GetEnricherUsageRequest) (*GetEnricherUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEnricherUsage not implemented")
+}
+func (UnimplementedPipelineServiceServer) PausePipelines(context.Context, *PausePipelinesRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PausePipelines not implemented")
+}
+func (UnimplementedPipelineServiceServer) ResumePipelines(context.Context, *ResumePipelinesRequest) (*ResumePipelinesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumePipelines not implemented")
+}
+func (UnimplementedPipelineServiceServer) GetPipelineCalendar(context.Context, *GetPipelineCalendarRequest) (*GetPipelineCalendarResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPipelineCalendar not implemented")
+}
+func (UnimplementedPipelineServiceServer) PreviewPipeline(context.Context, *PreviewPipelineRequest) (*pipeline.PipelinePreview, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewPipeline not implemented")
+}
+func (UnimplementedPipelineServiceServer) PreviewDescriptionMerge(context.Context, *PreviewDescriptionMergeRequest) (*pipeline.DescriptionMergePreview, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewDescriptionMerge not implemented")
+}
+func (UnimplementedPipelineServiceServer) GetEnricherRecommendations(context.Context, *GetEnricherRecommendationsRequest) (*pipeline.EnricherRecommendations, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEnricherRecommendations not implemented")
+}
+func (UnimplementedPipelineServiceServer) CorrectActivityType(context.Context, *CorrectActivityTypeRequest) (*CorrectActivityTypeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CorrectActivityType not implemented")
+}
+func (UnimplementedPipelineServiceServer) ListActivityTypeRules(context.Context, *ListActivityTypeRulesRequest) (*ListActivityTypeRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListActivityTypeRules not implemented")
+}
+func (UnimplementedPipelineServiceServer) UpdateActivityTypeRule(context.Context, *UpdateActivityTypeRuleRequest) (*pipeline.ActivityTypeRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateActivityTypeRule not implemented")
+}
+func (UnimplementedPipelineServiceServer) DeleteActivityTypeRule(context.Context, *DeleteActivityTypeRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteActivityTypeRule not implemented")
+}
 func (UnimplementedPipelineServiceServer) AdminListPipelineRuns(context.Context, *AdminListPipelineRunsRequest) (*AdminListPipelineRunsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AdminListPipelineRuns not implemented")
 }
+func (UnimplementedPipelineServiceServer) AdminForceCompletePipelineRun(context.Context, *AdminForceCompletePipelineRunRequest) (*pipeline.PipelineRun, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminForceCompletePipelineRun not implemented")
+}
+func (UnimplementedPipelineServiceServer) AdminListProviderCircuits(context.Context, *AdminListProviderCircuitsRequest) (*AdminListProviderCircuitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListProviderCircuits not implemented")
+}
+func (UnimplementedPipelineServiceServer) AdminSetProviderCircuitMode(context.Context, *AdminSetProviderCircuitModeRequest) (*pipeline.ProviderCircuit, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminSetProviderCircuitMode not implemented")
+}
+func (UnimplementedPipelineServiceServer) AdminListProviderCircuitChanges(context.Context, *AdminListProviderCircuitChangesRequest) (*AdminListProviderCircuitChangesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListProviderCircuitChanges not implemented")
+}
+func (UnimplementedPipelineServiceServer) AdminListFailedEvents(context.Context, *AdminListFailedEventsRequest) (*AdminListFailedEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListFailedEvents not implemented")
+}
+func (UnimplementedPipelineServiceServer) AdminRedriveFailedEvents(context.Context, *AdminRedriveFailedEventsRequest) (*AdminRedriveFailedEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminRedriveFailedEvents not implemented")
+}
 func (UnimplementedPipelineServiceServer) mustEmbedUnimplementedPipelineServiceServer() {}
 func (UnimplementedPipelineServiceServer) testEmbeddedByValue()                         {}
 
@@ -354,6 +700,24 @@ func _PipelineService_DeletePipeline_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PipelineService_ProvisionStarterPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProvisionStarterPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).ProvisionStarterPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_ProvisionStarterPipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).ProvisionStarterPipeline(ctx, req.(*ProvisionStarterPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PipelineService_SubmitInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitInputRequest)
 	if err := dec(in); err != nil {
@@ -426,6 +790,24 @@ func _PipelineService_RepostActivity_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PipelineService_RetryPipelineRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryPipelineRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).RetryPipelineRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_RetryPipelineRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).RetryPipelineRun(ctx, req.(*RetryPipelineRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PipelineService_GetPipelineRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPipelineRunRequest)
 	if err := dec(in); err != nil {
@@ -444,6 +826,24 @@ func _PipelineService_GetPipelineRun_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PipelineService_GetPipelineRunDebugBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineRunDebugBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).GetPipelineRunDebugBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_GetPipelineRunDebugBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).GetPipelineRunDebugBundle(ctx, req.(*GetPipelineRunDebugBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PipelineService_ListPipelineRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPipelineRunsRequest)
 	if err := dec(in); err != nil {
@@ -462,6 +862,204 @@ func _PipelineService_ListPipelineRuns_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PipelineService_GetEnricherUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnricherUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).GetEnricherUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_GetEnricherUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).GetEnricherUsage(ctx, req.(*GetEnricherUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_PausePipelines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PausePipelinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).PausePipelines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_PausePipelines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).PausePipelines(ctx, req.(*PausePipelinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_ResumePipelines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumePipelinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).ResumePipelines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_ResumePipelines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).ResumePipelines(ctx, req.(*ResumePipelinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_GetPipelineCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).GetPipelineCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_GetPipelineCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).GetPipelineCalendar(ctx, req.(*GetPipelineCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_PreviewPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).PreviewPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_PreviewPipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).PreviewPipeline(ctx, req.(*PreviewPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_PreviewDescriptionMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewDescriptionMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).PreviewDescriptionMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_PreviewDescriptionMerge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).PreviewDescriptionMerge(ctx, req.(*PreviewDescriptionMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_GetEnricherRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnricherRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).GetEnricherRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_GetEnricherRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).GetEnricherRecommendations(ctx, req.(*GetEnricherRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_CorrectActivityType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorrectActivityTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).CorrectActivityType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_CorrectActivityType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).CorrectActivityType(ctx, req.(*CorrectActivityTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_ListActivityTypeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActivityTypeRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).ListActivityTypeRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_ListActivityTypeRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).ListActivityTypeRules(ctx, req.(*ListActivityTypeRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_UpdateActivityTypeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivityTypeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).UpdateActivityTypeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_UpdateActivityTypeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).UpdateActivityTypeRule(ctx, req.(*UpdateActivityTypeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_DeleteActivityTypeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteActivityTypeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).DeleteActivityTypeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_DeleteActivityTypeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).DeleteActivityTypeRule(ctx, req.(*DeleteActivityTypeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PipelineService_AdminListPipelineRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AdminListPipelineRunsRequest)
 	if err := dec(in); err != nil {
@@ -480,6 +1078,114 @@ func _PipelineService_AdminListPipelineRuns_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PipelineService_AdminForceCompletePipelineRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminForceCompletePipelineRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).AdminForceCompletePipelineRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_AdminForceCompletePipelineRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).AdminForceCompletePipelineRun(ctx, req.(*AdminForceCompletePipelineRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_AdminListProviderCircuits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListProviderCircuitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).AdminListProviderCircuits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_AdminListProviderCircuits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).AdminListProviderCircuits(ctx, req.(*AdminListProviderCircuitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_AdminSetProviderCircuitMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSetProviderCircuitModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).AdminSetProviderCircuitMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_AdminSetProviderCircuitMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).AdminSetProviderCircuitMode(ctx, req.(*AdminSetProviderCircuitModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_AdminListProviderCircuitChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListProviderCircuitChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).AdminListProviderCircuitChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_AdminListProviderCircuitChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).AdminListProviderCircuitChanges(ctx, req.(*AdminListProviderCircuitChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_AdminListFailedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListFailedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).AdminListFailedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_AdminListFailedEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).AdminListFailedEvents(ctx, req.(*AdminListFailedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_AdminRedriveFailedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRedriveFailedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).AdminRedriveFailedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_AdminRedriveFailedEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).AdminRedriveFailedEvents(ctx, req.(*AdminRedriveFailedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PipelineService_ServiceDesc is the grpc.ServiceDesc for PipelineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -507,6 +1213,10 @@ var PipelineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePipeline",
 			Handler:    _PipelineService_DeletePipeline_Handler,
 		},
+		{
+			MethodName: "ProvisionStarterPipeline",
+			Handler:    _PipelineService_ProvisionStarterPipeline_Handler,
+		},
 		{
 			MethodName: "SubmitInput",
 			Handler:    _PipelineService_SubmitInput_Handler,
@@ -523,18 +1233,94 @@ var PipelineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RepostActivity",
 			Handler:    _PipelineService_RepostActivity_Handler,
 		},
+		{
+			MethodName: "RetryPipelineRun",
+			Handler:    _PipelineService_RetryPipelineRun_Handler,
+		},
 		{
 			MethodName: "GetPipelineRun",
 			Handler:    _PipelineService_GetPipelineRun_Handler,
 		},
+		{
+			MethodName: "GetPipelineRunDebugBundle",
+			Handler:    _PipelineService_GetPipelineRunDebugBundle_Handler,
+		},
 		{
 			MethodName: "ListPipelineRuns",
 			Handler:    _PipelineService_ListPipelineRuns_Handler,
 		},
+		{
+			MethodName: "GetEnricherUsage",
+			Handler:    _PipelineService_GetEnricherUsage_Handler,
+		},
+		{
+			MethodName: "PausePipelines",
+			Handler:    _PipelineService_PausePipelines_Handler,
+		},
+		{
+			MethodName: "ResumePipelines",
+			Handler:    _PipelineService_ResumePipelines_Handler,
+		},
+		{
+			MethodName: "GetPipelineCalendar",
+			Handler:    _PipelineService_GetPipelineCalendar_Handler,
+		},
+		{
+			MethodName: "PreviewPipeline",
+			Handler:    _PipelineService_PreviewPipeline_Handler,
+		},
+		{
+			MethodName: "PreviewDescriptionMerge",
+			Handler:    _PipelineService_PreviewDescriptionMerge_Handler,
+		},
+		{
+			MethodName: "GetEnricherRecommendations",
+			Handler:    _PipelineService_GetEnricherRecommendations_Handler,
+		},
+		{
+			MethodName: "CorrectActivityType",
+			Handler:    _PipelineService_CorrectActivityType_Handler,
+		},
+		{
+			MethodName: "ListActivityTypeRules",
+			Handler:    _PipelineService_ListActivityTypeRules_Handler,
+		},
+		{
+			MethodName: "UpdateActivityTypeRule",
+			Handler:    _PipelineService_UpdateActivityTypeRule_Handler,
+		},
+		{
+			MethodName: "DeleteActivityTypeRule",
+			Handler:    _PipelineService_DeleteActivityTypeRule_Handler,
+		},
 		{
 			MethodName: "AdminListPipelineRuns",
 			Handler:    _PipelineService_AdminListPipelineRuns_Handler,
 		},
+		{
+			MethodName: "AdminForceCompletePipelineRun",
+			Handler:    _PipelineService_AdminForceCompletePipelineRun_Handler,
+		},
+		{
+			MethodName: "AdminListProviderCircuits",
+			Handler:    _PipelineService_AdminListProviderCircuits_Handler,
+		},
+		{
+			MethodName: "AdminSetProviderCircuitMode",
+			Handler:    _PipelineService_AdminSetProviderCircuitMode_Handler,
+		},
+		{
+			MethodName: "AdminListProviderCircuitChanges",
+			Handler:    _PipelineService_AdminListProviderCircuitChanges_Handler,
+		},
+		{
+			MethodName: "AdminListFailedEvents",
+			Handler:    _PipelineService_AdminListFailedEvents_Handler,
+		},
+		{
+			MethodName: "AdminRedriveFailedEvents",
+			Handler:    _PipelineService_AdminRedriveFailedEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/pipeline/pipeline.proto",
@@ -7,12 +7,14 @@
 package user
 
 import (
+	activity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	user "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -498,6 +500,112 @@ func (x *ListUsersResponse) GetNextPageToken() string {
 	return ""
 }
 
+type SearchUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Email string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// Connected platform (e.g. "strava") and the user's ID on it, as used by
+	// ResolveUserByIntegration. Either this or email is required.
+	Provider      string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	ProviderUid   string `protobuf:"bytes,3,opt,name=provider_uid,json=providerUid,proto3" json:"provider_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_services_user_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_user_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_services_user_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchUsersRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetProviderUid() string {
+	if x != nil {
+		return x.ProviderUid
+	}
+	return ""
+}
+
+type SearchUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*user.UserProfile    `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_services_user_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_user_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_services_user_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchUsersResponse) GetUsers() []*user.UserProfile {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
 type GetProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -507,7 +615,7 @@ type GetProfileRequest struct {
 
 func (x *GetProfileRequest) Reset() {
 	*x = GetProfileRequest{}
-	mi := &file_services_user_user_proto_msgTypes[10]
+	mi := &file_services_user_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -519,7 +627,7 @@ func (x *GetProfileRequest) String() string {
 func (*GetProfileRequest) ProtoMessage() {}
 
 func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[10]
+	mi := &file_services_user_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +640,7 @@ func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetProfileRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{10}
+	return file_services_user_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetProfileRequest) GetUserId() string {
@@ -552,7 +660,7 @@ type UpdateProfileRequest struct {
 
 func (x *UpdateProfileRequest) Reset() {
 	*x = UpdateProfileRequest{}
-	mi := &file_services_user_user_proto_msgTypes[11]
+	mi := &file_services_user_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -564,7 +672,7 @@ func (x *UpdateProfileRequest) String() string {
 func (*UpdateProfileRequest) ProtoMessage() {}
 
 func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[11]
+	mi := &file_services_user_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,7 +685,7 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{11}
+	return file_services_user_user_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateProfileRequest) GetUserId() string {
@@ -604,7 +712,7 @@ type GetIntegrationRequest struct {
 
 func (x *GetIntegrationRequest) Reset() {
 	*x = GetIntegrationRequest{}
-	mi := &file_services_user_user_proto_msgTypes[12]
+	mi := &file_services_user_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -616,7 +724,7 @@ func (x *GetIntegrationRequest) String() string {
 func (*GetIntegrationRequest) ProtoMessage() {}
 
 func (x *GetIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[12]
+	mi := &file_services_user_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,7 +737,7 @@ func (x *GetIntegrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIntegrationRequest.ProtoReflect.Descriptor instead.
 func (*GetIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{12}
+	return file_services_user_user_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetIntegrationRequest) GetUserId() string {
@@ -657,7 +765,7 @@ type GetIntegrationResponse struct {
 
 func (x *GetIntegrationResponse) Reset() {
 	*x = GetIntegrationResponse{}
-	mi := &file_services_user_user_proto_msgTypes[13]
+	mi := &file_services_user_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -669,7 +777,7 @@ func (x *GetIntegrationResponse) String() string {
 func (*GetIntegrationResponse) ProtoMessage() {}
 
 func (x *GetIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[13]
+	mi := &file_services_user_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -682,7 +790,7 @@ func (x *GetIntegrationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIntegrationResponse.ProtoReflect.Descriptor instead.
 func (*GetIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{13}
+	return file_services_user_user_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetIntegrationResponse) GetIntegrations() *user.UserIntegrations {
@@ -692,6 +800,103 @@ func (x *GetIntegrationResponse) GetIntegrations() *user.UserIntegrations {
 	return nil
 }
 
+type GetIntegrationSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIntegrationSecretRequest) Reset() {
+	*x = GetIntegrationSecretRequest{}
+	mi := &file_services_user_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIntegrationSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIntegrationSecretRequest) ProtoMessage() {}
+
+func (x *GetIntegrationSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_user_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIntegrationSecretRequest.ProtoReflect.Descriptor instead.
+func (*GetIntegrationSecretRequest) Descriptor() ([]byte, []int) {
+	return file_services_user_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetIntegrationSecretRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetIntegrationSecretRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type GetIntegrationSecretResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty when the user has no secret stored for the provider
+	Secret        string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIntegrationSecretResponse) Reset() {
+	*x = GetIntegrationSecretResponse{}
+	mi := &file_services_user_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIntegrationSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIntegrationSecretResponse) ProtoMessage() {}
+
+func (x *GetIntegrationSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_user_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIntegrationSecretResponse.ProtoReflect.Descriptor instead.
+func (*GetIntegrationSecretResponse) Descriptor() ([]byte, []int) {
+	return file_services_user_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetIntegrationSecretResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
 type SetIntegrationRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -703,7 +908,7 @@ type SetIntegrationRequest struct {
 
 func (x *SetIntegrationRequest) Reset() {
 	*x = SetIntegrationRequest{}
-	mi := &file_services_user_user_proto_msgTypes[14]
+	mi := &file_services_user_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -715,7 +920,7 @@ func (x *SetIntegrationRequest) String() string {
 func (*SetIntegrationRequest) ProtoMessage() {}
 
 func (x *SetIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[14]
+	mi := &file_services_user_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +933,7 @@ func (x *SetIntegrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetIntegrationRequest.ProtoReflect.Descriptor instead.
 func (*SetIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{14}
+	return file_services_user_user_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SetIntegrationRequest) GetUserId() string {
@@ -762,7 +967,7 @@ type DeleteIntegrationRequest struct {
 
 func (x *DeleteIntegrationRequest) Reset() {
 	*x = DeleteIntegrationRequest{}
-	mi := &file_services_user_user_proto_msgTypes[15]
+	mi := &file_services_user_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -774,7 +979,7 @@ func (x *DeleteIntegrationRequest) String() string {
 func (*DeleteIntegrationRequest) ProtoMessage() {}
 
 func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[15]
+	mi := &file_services_user_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,7 +992,7 @@ func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIntegrationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{15}
+	return file_services_user_user_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DeleteIntegrationRequest) GetUserId() string {
@@ -813,7 +1018,7 @@ type ListIntegrationsRequest struct {
 
 func (x *ListIntegrationsRequest) Reset() {
 	*x = ListIntegrationsRequest{}
-	mi := &file_services_user_user_proto_msgTypes[16]
+	mi := &file_services_user_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -825,7 +1030,7 @@ func (x *ListIntegrationsRequest) String() string {
 func (*ListIntegrationsRequest) ProtoMessage() {}
 
 func (x *ListIntegrationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[16]
+	mi := &file_services_user_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,7 +1043,7 @@ func (x *ListIntegrationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIntegrationsRequest.ProtoReflect.Descriptor instead.
 func (*ListIntegrationsRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{16}
+	return file_services_user_user_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListIntegrationsRequest) GetUserId() string {
@@ -857,7 +1062,7 @@ type GetNotificationPrefsRequest struct {
 
 func (x *GetNotificationPrefsRequest) Reset() {
 	*x = GetNotificationPrefsRequest{}
-	mi := &file_services_user_user_proto_msgTypes[17]
+	mi := &file_services_user_user_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -869,7 +1074,7 @@ func (x *GetNotificationPrefsRequest) String() string {
 func (*GetNotificationPrefsRequest) ProtoMessage() {}
 
 func (x *GetNotificationPrefsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[17]
+	mi := &file_services_user_user_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -882,7 +1087,7 @@ func (x *GetNotificationPrefsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNotificationPrefsRequest.ProtoReflect.Descriptor instead.
 func (*GetNotificationPrefsRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{17}
+	return file_services_user_user_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetNotificationPrefsRequest) GetUserId() string {
@@ -902,7 +1107,7 @@ type UpdateNotificationPrefsRequest struct {
 
 func (x *UpdateNotificationPrefsRequest) Reset() {
 	*x = UpdateNotificationPrefsRequest{}
-	mi := &file_services_user_user_proto_msgTypes[18]
+	mi := &file_services_user_user_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -914,7 +1119,7 @@ func (x *UpdateNotificationPrefsRequest) String() string {
 func (*UpdateNotificationPrefsRequest) ProtoMessage() {}
 
 func (x *UpdateNotificationPrefsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[18]
+	mi := &file_services_user_user_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -927,7 +1132,7 @@ func (x *UpdateNotificationPrefsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNotificationPrefsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateNotificationPrefsRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{18}
+	return file_services_user_user_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UpdateNotificationPrefsRequest) GetUserId() string {
@@ -953,7 +1158,7 @@ type ListCountersRequest struct {
 
 func (x *ListCountersRequest) Reset() {
 	*x = ListCountersRequest{}
-	mi := &file_services_user_user_proto_msgTypes[19]
+	mi := &file_services_user_user_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -965,7 +1170,7 @@ func (x *ListCountersRequest) String() string {
 func (*ListCountersRequest) ProtoMessage() {}
 
 func (x *ListCountersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[19]
+	mi := &file_services_user_user_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,7 +1183,7 @@ func (x *ListCountersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCountersRequest.ProtoReflect.Descriptor instead.
 func (*ListCountersRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{19}
+	return file_services_user_user_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListCountersRequest) GetUserId() string {
@@ -997,7 +1202,7 @@ type ListCountersResponse struct {
 
 func (x *ListCountersResponse) Reset() {
 	*x = ListCountersResponse{}
-	mi := &file_services_user_user_proto_msgTypes[20]
+	mi := &file_services_user_user_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1009,7 +1214,7 @@ func (x *ListCountersResponse) String() string {
 func (*ListCountersResponse) ProtoMessage() {}
 
 func (x *ListCountersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[20]
+	mi := &file_services_user_user_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,7 +1227,7 @@ func (x *ListCountersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCountersResponse.ProtoReflect.Descriptor instead.
 func (*ListCountersResponse) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{20}
+	return file_services_user_user_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListCountersResponse) GetCounters() []*user.Counter {
@@ -1043,7 +1248,7 @@ type UpdateCounterRequest struct {
 
 func (x *UpdateCounterRequest) Reset() {
 	*x = UpdateCounterRequest{}
-	mi := &file_services_user_user_proto_msgTypes[21]
+	mi := &file_services_user_user_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1055,7 +1260,7 @@ func (x *UpdateCounterRequest) String() string {
 func (*UpdateCounterRequest) ProtoMessage() {}
 
 func (x *UpdateCounterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[21]
+	mi := &file_services_user_user_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,7 +1273,7 @@ func (x *UpdateCounterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCounterRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCounterRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{21}
+	return file_services_user_user_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *UpdateCounterRequest) GetUserId() string {
@@ -1101,7 +1306,7 @@ type DeleteUserRequest struct {
 
 func (x *DeleteUserRequest) Reset() {
 	*x = DeleteUserRequest{}
-	mi := &file_services_user_user_proto_msgTypes[22]
+	mi := &file_services_user_user_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1113,7 +1318,7 @@ func (x *DeleteUserRequest) String() string {
 func (*DeleteUserRequest) ProtoMessage() {}
 
 func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[22]
+	mi := &file_services_user_user_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1126,7 +1331,7 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{22}
+	return file_services_user_user_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DeleteUserRequest) GetUserId() string {
@@ -1146,7 +1351,7 @@ type GetBoosterDataRequest struct {
 
 func (x *GetBoosterDataRequest) Reset() {
 	*x = GetBoosterDataRequest{}
-	mi := &file_services_user_user_proto_msgTypes[23]
+	mi := &file_services_user_user_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1158,7 +1363,7 @@ func (x *GetBoosterDataRequest) String() string {
 func (*GetBoosterDataRequest) ProtoMessage() {}
 
 func (x *GetBoosterDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[23]
+	mi := &file_services_user_user_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1171,7 +1376,7 @@ func (x *GetBoosterDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBoosterDataRequest.ProtoReflect.Descriptor instead.
 func (*GetBoosterDataRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{23}
+	return file_services_user_user_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetBoosterDataRequest) GetUserId() string {
@@ -1198,7 +1403,7 @@ type GetBoosterDataResponse struct {
 
 func (x *GetBoosterDataResponse) Reset() {
 	*x = GetBoosterDataResponse{}
-	mi := &file_services_user_user_proto_msgTypes[24]
+	mi := &file_services_user_user_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1210,7 +1415,7 @@ func (x *GetBoosterDataResponse) String() string {
 func (*GetBoosterDataResponse) ProtoMessage() {}
 
 func (x *GetBoosterDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[24]
+	mi := &file_services_user_user_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1223,7 +1428,7 @@ func (x *GetBoosterDataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBoosterDataResponse.ProtoReflect.Descriptor instead.
 func (*GetBoosterDataResponse) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{24}
+	return file_services_user_user_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetBoosterDataResponse) GetData() map[string]*structpb.Struct {
@@ -1244,7 +1449,7 @@ type SetBoosterDataRequest struct {
 
 func (x *SetBoosterDataRequest) Reset() {
 	*x = SetBoosterDataRequest{}
-	mi := &file_services_user_user_proto_msgTypes[25]
+	mi := &file_services_user_user_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1256,7 +1461,7 @@ func (x *SetBoosterDataRequest) String() string {
 func (*SetBoosterDataRequest) ProtoMessage() {}
 
 func (x *SetBoosterDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[25]
+	mi := &file_services_user_user_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1269,7 +1474,7 @@ func (x *SetBoosterDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetBoosterDataRequest.ProtoReflect.Descriptor instead.
 func (*SetBoosterDataRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{25}
+	return file_services_user_user_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *SetBoosterDataRequest) GetUserId() string {
@@ -1303,7 +1508,7 @@ type DeleteBoosterDataRequest struct {
 
 func (x *DeleteBoosterDataRequest) Reset() {
 	*x = DeleteBoosterDataRequest{}
-	mi := &file_services_user_user_proto_msgTypes[26]
+	mi := &file_services_user_user_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1315,7 +1520,7 @@ func (x *DeleteBoosterDataRequest) String() string {
 func (*DeleteBoosterDataRequest) ProtoMessage() {}
 
 func (x *DeleteBoosterDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[26]
+	mi := &file_services_user_user_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1328,7 +1533,7 @@ func (x *DeleteBoosterDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBoosterDataRequest.ProtoReflect.Descriptor instead.
 func (*DeleteBoosterDataRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{26}
+	return file_services_user_user_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DeleteBoosterDataRequest) GetUserId() string {
@@ -1355,7 +1560,7 @@ type ListPersonalRecordsRequest struct {
 
 func (x *ListPersonalRecordsRequest) Reset() {
 	*x = ListPersonalRecordsRequest{}
-	mi := &file_services_user_user_proto_msgTypes[27]
+	mi := &file_services_user_user_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1367,7 +1572,7 @@ func (x *ListPersonalRecordsRequest) String() string {
 func (*ListPersonalRecordsRequest) ProtoMessage() {}
 
 func (x *ListPersonalRecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[27]
+	mi := &file_services_user_user_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1380,7 +1585,7 @@ func (x *ListPersonalRecordsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPersonalRecordsRequest.ProtoReflect.Descriptor instead.
 func (*ListPersonalRecordsRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{27}
+	return file_services_user_user_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ListPersonalRecordsRequest) GetUserId() string {
@@ -1399,7 +1604,7 @@ type ListPersonalRecordsResponse struct {
 
 func (x *ListPersonalRecordsResponse) Reset() {
 	*x = ListPersonalRecordsResponse{}
-	mi := &file_services_user_user_proto_msgTypes[28]
+	mi := &file_services_user_user_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1411,7 +1616,7 @@ func (x *ListPersonalRecordsResponse) String() string {
 func (*ListPersonalRecordsResponse) ProtoMessage() {}
 
 func (x *ListPersonalRecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[28]
+	mi := &file_services_user_user_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1424,7 +1629,7 @@ func (x *ListPersonalRecordsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPersonalRecordsResponse.ProtoReflect.Descriptor instead.
 func (*ListPersonalRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{28}
+	return file_services_user_user_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ListPersonalRecordsResponse) GetRecords() []*user.PersonalRecord {
@@ -1447,7 +1652,7 @@ type SetPersonalRecordRequest struct {
 
 func (x *SetPersonalRecordRequest) Reset() {
 	*x = SetPersonalRecordRequest{}
-	mi := &file_services_user_user_proto_msgTypes[29]
+	mi := &file_services_user_user_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1459,7 +1664,7 @@ func (x *SetPersonalRecordRequest) String() string {
 func (*SetPersonalRecordRequest) ProtoMessage() {}
 
 func (x *SetPersonalRecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[29]
+	mi := &file_services_user_user_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1472,7 +1677,7 @@ func (x *SetPersonalRecordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPersonalRecordRequest.ProtoReflect.Descriptor instead.
 func (*SetPersonalRecordRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{29}
+	return file_services_user_user_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *SetPersonalRecordRequest) GetUserId() string {
@@ -1520,7 +1725,7 @@ type DeletePersonalRecordRequest struct {
 
 func (x *DeletePersonalRecordRequest) Reset() {
 	*x = DeletePersonalRecordRequest{}
-	mi := &file_services_user_user_proto_msgTypes[30]
+	mi := &file_services_user_user_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1532,7 +1737,7 @@ func (x *DeletePersonalRecordRequest) String() string {
 func (*DeletePersonalRecordRequest) ProtoMessage() {}
 
 func (x *DeletePersonalRecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[30]
+	mi := &file_services_user_user_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1545,7 +1750,7 @@ func (x *DeletePersonalRecordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePersonalRecordRequest.ProtoReflect.Descriptor instead.
 func (*DeletePersonalRecordRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{30}
+	return file_services_user_user_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DeletePersonalRecordRequest) GetUserId() string {
@@ -1562,6 +1767,472 @@ func (x *DeletePersonalRecordRequest) GetRecordType() string {
 	return ""
 }
 
+// Gear
+type ListGearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGearRequest) Reset() {
+	*x = ListGearRequest{}
+	mi := &file_services_user_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGearRequest) ProtoMessage() {}
+
+func (x *ListGearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_user_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGearRequest.ProtoReflect.Descriptor instead.
+func (*ListGearRequest) Descriptor() ([]byte, []int) {
+	return file_services_user_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListGearRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListGearResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gear          []*user.Gear           `protobuf:"bytes,1,rep,name=gear,proto3" json:"gear,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGearResponse) Reset() {
+	*x = ListGearResponse{}
+	mi := &file_services_user_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGearResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGearResponse) ProtoMessage() {}
+
+func (x *ListGearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_user_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGearResponse.ProtoReflect.Descriptor instead.
+func (*ListGearResponse) Descriptor() ([]byte, []int) {
+	return file_services_user_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListGearResponse) GetGear() []*user.Gear {
+	if x != nil {
+		return x.Gear
+	}
+	return nil
+}
+
+type SetGearRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	UserId                string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GearId                string                 `protobuf:"bytes,2,opt,name=gear_id,json=gearId,proto3" json:"gear_id,omitempty"`
+	Name                  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type                  user.GearType          `protobuf:"varint,4,opt,name=type,proto3,enum=fitglue.models.user.GearType" json:"type,omitempty"`
+	InitialDistanceMeters float64                `protobuf:"fixed64,5,opt,name=initial_distance_meters,json=initialDistanceMeters,proto3" json:"initial_distance_meters,omitempty"`
+	Retired               bool                   `protobuf:"varint,6,opt,name=retired,proto3" json:"retired,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SetGearRequest) Reset() {
+	*x = SetGearRequest{}
+	mi := &file_services_user_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGearRequest) ProtoMessage() {}
+
+func (x *SetGearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_user_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGearRequest.ProtoReflect.Descriptor instead.
+func (*SetGearRequest) Descriptor() ([]byte, []int) {
+	return file_services_user_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetGearRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetGearRequest) GetGearId() string {
+	if x != nil {
+		return x.GearId
+	}
+	return ""
+}
+
+func (x *SetGearRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetGearRequest) GetType() user.GearType {
+	if x != nil {
+		return x.Type
+	}
+	return user.GearType(0)
+}
+
+func (x *SetGearRequest) GetInitialDistanceMeters() float64 {
+	if x != nil {
+		return x.InitialDistanceMeters
+	}
+	return 0
+}
+
+func (x *SetGearRequest) GetRetired() bool {
+	if x != nil {
+		return x.Retired
+	}
+	return false
+}
+
+type DeleteGearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GearId        string                 `protobuf:"bytes,2,opt,name=gear_id,json=gearId,proto3" json:"gear_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGearRequest) Reset() {
+	*x = DeleteGearRequest{}
+	mi := &file_services_user_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGearRequest) ProtoMessage() {}
+
+func (x *DeleteGearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_user_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGearRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGearRequest) Descriptor() ([]byte, []int) {
+	return file_services_user_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteGearRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteGearRequest) GetGearId() string {
+	if x != nil {
+		return x.GearId
+	}
+	return ""
+}
+
+// Goals
+type ListGoalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGoalsRequest) Reset() {
+	*x = ListGoalsRequest{}
+	mi := &file_services_user_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGoalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGoalsRequest) ProtoMessage() {}
+
+func (x *ListGoalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_user_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGoalsRequest.ProtoReflect.Descriptor instead.
+func (*ListGoalsRequest) Descriptor() ([]byte, []int) {
+	return file_services_user_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListGoalsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListGoalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goals         []*user.Goal           `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGoalsResponse) Reset() {
+	*x = ListGoalsResponse{}
+	mi := &file_services_user_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGoalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGoalsResponse) ProtoMessage() {}
+
+func (x *ListGoalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_user_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGoalsResponse.ProtoReflect.Descriptor instead.
+func (*ListGoalsResponse) Descriptor() ([]byte, []int) {
+	return file_services_user_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListGoalsResponse) GetGoals() []*user.Goal {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+type SetGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GoalId        string                 `protobuf:"bytes,2,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Metric        user.GoalMetric        `protobuf:"varint,4,opt,name=metric,proto3,enum=fitglue.models.user.GoalMetric" json:"metric,omitempty"`
+	Target        float64                `protobuf:"fixed64,5,opt,name=target,proto3" json:"target,omitempty"`
+	ActivityType  activity.ActivityType  `protobuf:"varint,6,opt,name=activity_type,json=activityType,proto3,enum=fitglue.models.activity.ActivityType" json:"activity_type,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGoalRequest) Reset() {
+	*x = SetGoalRequest{}
+	mi := &file_services_user_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGoalRequest) ProtoMessage() {}
+
+func (x *SetGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_user_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGoalRequest.ProtoReflect.Descriptor instead.
+func (*SetGoalRequest) Descriptor() ([]byte, []int) {
+	return file_services_user_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetGoalRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetGoalRequest) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *SetGoalRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetGoalRequest) GetMetric() user.GoalMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return user.GoalMetric(0)
+}
+
+func (x *SetGoalRequest) GetTarget() float64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *SetGoalRequest) GetActivityType() activity.ActivityType {
+	if x != nil {
+		return x.ActivityType
+	}
+	return activity.ActivityType(0)
+}
+
+func (x *SetGoalRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *SetGoalRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type DeleteGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GoalId        string                 `protobuf:"bytes,2,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGoalRequest) Reset() {
+	*x = DeleteGoalRequest{}
+	mi := &file_services_user_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGoalRequest) ProtoMessage() {}
+
+func (x *DeleteGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_user_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGoalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGoalRequest) Descriptor() ([]byte, []int) {
+	return file_services_user_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteGoalRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteGoalRequest) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
 // Plugin Defaults
 type ListPluginDefaultsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1572,7 +2243,7 @@ type ListPluginDefaultsRequest struct {
 
 func (x *ListPluginDefaultsRequest) Reset() {
 	*x = ListPluginDefaultsRequest{}
-	mi := &file_services_user_user_proto_msgTypes[31]
+	mi := &file_services_user_user_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1584,7 +2255,7 @@ func (x *ListPluginDefaultsRequest) String() string {
 func (*ListPluginDefaultsRequest) ProtoMessage() {}
 
 func (x *ListPluginDefaultsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[31]
+	mi := &file_services_user_user_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1597,7 +2268,7 @@ func (x *ListPluginDefaultsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPluginDefaultsRequest.ProtoReflect.Descriptor instead.
 func (*ListPluginDefaultsRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{31}
+	return file_services_user_user_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ListPluginDefaultsRequest) GetUserId() string {
@@ -1616,7 +2287,7 @@ type ListPluginDefaultsResponse struct {
 
 func (x *ListPluginDefaultsResponse) Reset() {
 	*x = ListPluginDefaultsResponse{}
-	mi := &file_services_user_user_proto_msgTypes[32]
+	mi := &file_services_user_user_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1628,7 +2299,7 @@ func (x *ListPluginDefaultsResponse) String() string {
 func (*ListPluginDefaultsResponse) ProtoMessage() {}
 
 func (x *ListPluginDefaultsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[32]
+	mi := &file_services_user_user_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1641,7 +2312,7 @@ func (x *ListPluginDefaultsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPluginDefaultsResponse.ProtoReflect.Descriptor instead.
 func (*ListPluginDefaultsResponse) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{32}
+	return file_services_user_user_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ListPluginDefaultsResponse) GetDefaults() map[string]*structpb.Struct {
@@ -1662,7 +2333,7 @@ type SetPluginDefaultsRequest struct {
 
 func (x *SetPluginDefaultsRequest) Reset() {
 	*x = SetPluginDefaultsRequest{}
-	mi := &file_services_user_user_proto_msgTypes[33]
+	mi := &file_services_user_user_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1674,7 +2345,7 @@ func (x *SetPluginDefaultsRequest) String() string {
 func (*SetPluginDefaultsRequest) ProtoMessage() {}
 
 func (x *SetPluginDefaultsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[33]
+	mi := &file_services_user_user_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1687,7 +2358,7 @@ func (x *SetPluginDefaultsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPluginDefaultsRequest.ProtoReflect.Descriptor instead.
 func (*SetPluginDefaultsRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{33}
+	return file_services_user_user_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *SetPluginDefaultsRequest) GetUserId() string {
@@ -1721,7 +2392,7 @@ type DeletePluginDefaultsRequest struct {
 
 func (x *DeletePluginDefaultsRequest) Reset() {
 	*x = DeletePluginDefaultsRequest{}
-	mi := &file_services_user_user_proto_msgTypes[34]
+	mi := &file_services_user_user_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1733,7 +2404,7 @@ func (x *DeletePluginDefaultsRequest) String() string {
 func (*DeletePluginDefaultsRequest) ProtoMessage() {}
 
 func (x *DeletePluginDefaultsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[34]
+	mi := &file_services_user_user_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1746,7 +2417,7 @@ func (x *DeletePluginDefaultsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePluginDefaultsRequest.ProtoReflect.Descriptor instead.
 func (*DeletePluginDefaultsRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{34}
+	return file_services_user_user_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *DeletePluginDefaultsRequest) GetUserId() string {
@@ -1774,7 +2445,7 @@ type DeleteCounterRequest struct {
 
 func (x *DeleteCounterRequest) Reset() {
 	*x = DeleteCounterRequest{}
-	mi := &file_services_user_user_proto_msgTypes[35]
+	mi := &file_services_user_user_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1786,7 +2457,7 @@ func (x *DeleteCounterRequest) String() string {
 func (*DeleteCounterRequest) ProtoMessage() {}
 
 func (x *DeleteCounterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[35]
+	mi := &file_services_user_user_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,7 +2470,7 @@ func (x *DeleteCounterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCounterRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCounterRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{35}
+	return file_services_user_user_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *DeleteCounterRequest) GetUserId() string {
@@ -1827,7 +2498,7 @@ type SetFCMTokenRequest struct {
 
 func (x *SetFCMTokenRequest) Reset() {
 	*x = SetFCMTokenRequest{}
-	mi := &file_services_user_user_proto_msgTypes[36]
+	mi := &file_services_user_user_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1839,7 +2510,7 @@ func (x *SetFCMTokenRequest) String() string {
 func (*SetFCMTokenRequest) ProtoMessage() {}
 
 func (x *SetFCMTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_user_user_proto_msgTypes[36]
+	mi := &file_services_user_user_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1852,7 +2523,7 @@ func (x *SetFCMTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetFCMTokenRequest.ProtoReflect.Descriptor instead.
 func (*SetFCMTokenRequest) Descriptor() ([]byte, []int) {
-	return file_services_user_user_proto_rawDescGZIP(), []int{36}
+	return file_services_user_user_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *SetFCMTokenRequest) GetUserId() string {
@@ -1880,7 +2551,7 @@ var File_services_user_user_proto protoreflect.FileDescriptor
 
 const file_services_user_user_proto_rawDesc = "" +
 	"\n" +
-	"\x18services/user/user.proto\x12\x15fitglue.services.user\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x19models/user/profile.proto\x1a\x1dmodels/user/integration.proto\"`\n" +
+	"\x18services/user/user.proto\x12\x15fitglue.services.user\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cmodels/activity/source.proto\x1a\x19models/user/profile.proto\x1a\x1dmodels/user/integration.proto\"`\n" +
 	"\x1fResolveUserByIntegrationRequest\x12\x1a\n" +
 	"\bprovider\x18\x01 \x01(\tR\bprovider\x12!\n" +
 	"\fprovider_uid\x18\x02 \x01(\tR\vproviderUid\"^\n" +
@@ -1905,7 +2576,13 @@ const file_services_user_user_proto_rawDesc = "" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\"s\n" +
 	"\x11ListUsersResponse\x126\n" +
 	"\x05users\x18\x01 \x03(\v2 .fitglue.models.user.UserProfileR\x05users\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\",\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"i\n" +
+	"\x12SearchUsersRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12!\n" +
+	"\fprovider_uid\x18\x03 \x01(\tR\vproviderUid\"M\n" +
+	"\x13SearchUsersResponse\x126\n" +
+	"\x05users\x18\x01 \x03(\v2 .fitglue.models.user.UserProfileR\x05users\",\n" +
 	"\x11GetProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"k\n" +
 	"\x14UpdateProfileRequest\x12\x17\n" +
@@ -1915,7 +2592,12 @@ const file_services_user_user_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
 	"\bprovider\x18\x02 \x01(\tR\bprovider\"c\n" +
 	"\x16GetIntegrationResponse\x12I\n" +
-	"\fintegrations\x18\x01 \x01(\v2%.fitglue.models.user.UserIntegrationsR\fintegrations\"\x90\x01\n" +
+	"\fintegrations\x18\x01 \x01(\v2%.fitglue.models.user.UserIntegrationsR\fintegrations\"R\n" +
+	"\x1bGetIntegrationSecretRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\"6\n" +
+	"\x1cGetIntegrationSecretResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\"\x90\x01\n" +
 	"\x15SetIntegrationRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
 	"\bprovider\x18\x02 \x01(\tR\bprovider\x12B\n" +
@@ -1974,7 +2656,38 @@ const file_services_user_user_proto_rawDesc = "" +
 	"\x1bDeletePersonalRecordRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
 	"\vrecord_type\x18\x02 \x01(\tR\n" +
-	"recordType\"4\n" +
+	"recordType\"*\n" +
+	"\x0fListGearRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"A\n" +
+	"\x10ListGearResponse\x12-\n" +
+	"\x04gear\x18\x01 \x03(\v2\x19.fitglue.models.user.GearR\x04gear\"\xdb\x01\n" +
+	"\x0eSetGearRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\agear_id\x18\x02 \x01(\tR\x06gearId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x121\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1d.fitglue.models.user.GearTypeR\x04type\x126\n" +
+	"\x17initial_distance_meters\x18\x05 \x01(\x01R\x15initialDistanceMeters\x12\x18\n" +
+	"\aretired\x18\x06 \x01(\bR\aretired\"E\n" +
+	"\x11DeleteGearRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\agear_id\x18\x02 \x01(\tR\x06gearId\"+\n" +
+	"\x10ListGoalsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"D\n" +
+	"\x11ListGoalsResponse\x12/\n" +
+	"\x05goals\x18\x01 \x03(\v2\x19.fitglue.models.user.GoalR\x05goals\"\xe5\x02\n" +
+	"\x0eSetGoalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\agoal_id\x18\x02 \x01(\tR\x06goalId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x127\n" +
+	"\x06metric\x18\x04 \x01(\x0e2\x1f.fitglue.models.user.GoalMetricR\x06metric\x12\x16\n" +
+	"\x06target\x18\x05 \x01(\x01R\x06target\x12J\n" +
+	"\ractivity_type\x18\x06 \x01(\x0e2%.fitglue.models.activity.ActivityTypeR\factivityType\x129\n" +
+	"\n" +
+	"start_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"E\n" +
+	"\x11DeleteGoalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\agoal_id\x18\x02 \x01(\tR\x06goalId\"4\n" +
 	"\x19ListPluginDefaultsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xcf\x01\n" +
 	"\x1aListPluginDefaultsResponse\x12[\n" +
@@ -1996,18 +2709,20 @@ const file_services_user_user_proto_rawDesc = "" +
 	"\x12SetFCMTokenRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1a\n" +
-	"\bplatform\x18\x03 \x01(\tR\bplatform2\x8a\"\n" +
+	"\bplatform\x18\x03 \x01(\tR\bplatform2\x81*\n" +
 	"\vUserService\x12m\n" +
 	"\n" +
 	"CreateUser\x12(.fitglue.services.user.CreateUserRequest\x1a .fitglue.models.user.UserProfile\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v2/user\x12|\n" +
 	"\n" +
 	"GetProfile\x12(.fitglue.services.user.GetProfileRequest\x1a .fitglue.models.user.UserProfile\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v2/user/{user_id}/profile\x12w\n" +
-	"\tListUsers\x12'.fitglue.services.user.ListUsersRequest\x1a(.fitglue.services.user.ListUsersResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v2/admin/users\x12\x8b\x01\n" +
+	"\tListUsers\x12'.fitglue.services.user.ListUsersRequest\x1a(.fitglue.services.user.ListUsersResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v2/admin/users\x12\x84\x01\n" +
+	"\vSearchUsers\x12).fitglue.services.user.SearchUsersRequest\x1a*.fitglue.services.user.SearchUsersResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v2/admin/users/search\x12\x8b\x01\n" +
 	"\rUpdateProfile\x12+.fitglue.services.user.UpdateProfileRequest\x1a .fitglue.models.user.UserProfile\"+\x82\xd3\xe4\x93\x02%:\aprofile2\x1a/v2/user/{user_id}/profile\x12\xa1\x01\n" +
 	"\x0eGetIntegration\x12,.fitglue.services.user.GetIntegrationRequest\x1a-.fitglue.services.user.GetIntegrationResponse\"2\x82\xd3\xe4\x93\x02,\x12*/v2/user/{user_id}/integrations/{provider}\x12\x8d\x01\n" +
 	"\x0eSetIntegration\x12,.fitglue.services.user.SetIntegrationRequest\x1a\x16.google.protobuf.Empty\"5\x82\xd3\xe4\x93\x02/:\x01*\x1a*/v2/user/{user_id}/integrations/{provider}\x12\x90\x01\n" +
 	"\x11DeleteIntegration\x12/.fitglue.services.user.DeleteIntegrationRequest\x1a\x16.google.protobuf.Empty\"2\x82\xd3\xe4\x93\x02,**/v2/user/{user_id}/integrations/{provider}\x12\x92\x01\n" +
-	"\x10ListIntegrations\x12..fitglue.services.user.ListIntegrationsRequest\x1a%.fitglue.models.user.UserIntegrations\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v2/user/{user_id}/integrations\x12\xa2\x01\n" +
+	"\x10ListIntegrations\x12..fitglue.services.user.ListIntegrationsRequest\x1a%.fitglue.models.user.UserIntegrations\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v2/user/{user_id}/integrations\x12\x7f\n" +
+	"\x14GetIntegrationSecret\x122.fitglue.services.user.GetIntegrationSecretRequest\x1a3.fitglue.services.user.GetIntegrationSecretResponse\x12\xa2\x01\n" +
 	"\x14GetNotificationPrefs\x122.fitglue.services.user.GetNotificationPrefsRequest\x1a,.fitglue.models.user.NotificationPreferences\"(\x82\xd3\xe4\x93\x02\"\x12 /v2/user/{user_id}/notifications\x12\xaf\x01\n" +
 	"\x17UpdateNotificationPrefs\x125.fitglue.services.user.UpdateNotificationPrefsRequest\x1a,.fitglue.models.user.NotificationPreferences\"/\x82\xd3\xe4\x93\x02):\x05prefs2 /v2/user/{user_id}/notifications\x12\x8c\x01\n" +
 	"\fListCounters\x12*.fitglue.services.user.ListCountersRequest\x1a+.fitglue.services.user.ListCountersResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v2/user/{user_id}/counters\x12\x8f\x01\n" +
@@ -2024,7 +2739,15 @@ const file_services_user_user_proto_rawDesc = "" +
 	"\x18ResolveUserByIntegration\x126.fitglue.services.user.ResolveUserByIntegrationRequest\x1a7.fitglue.services.user.ResolveUserByIntegrationResponse\"2\x82\xd3\xe4\x93\x02,\x12*/v2/user/resolve/{provider}/{provider_uid}\x12\xa9\x01\n" +
 	"\x13ListPersonalRecords\x121.fitglue.services.user.ListPersonalRecordsRequest\x1a2.fitglue.services.user.ListPersonalRecordsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v2/user/{user_id}/personal-records\x12\xa7\x01\n" +
 	"\x11SetPersonalRecord\x12/.fitglue.services.user.SetPersonalRecordRequest\x1a#.fitglue.models.user.PersonalRecord\"<\x82\xd3\xe4\x93\x026:\x01*\x1a1/v2/user/{user_id}/personal-records/{record_type}\x12\x9d\x01\n" +
-	"\x14DeletePersonalRecord\x122.fitglue.services.user.DeletePersonalRecordRequest\x1a\x16.google.protobuf.Empty\"9\x82\xd3\xe4\x93\x023*1/v2/user/{user_id}/personal-records/{record_type}\x12\xa5\x01\n" +
+	"\x14DeletePersonalRecord\x122.fitglue.services.user.DeletePersonalRecordRequest\x1a\x16.google.protobuf.Empty\"9\x82\xd3\xe4\x93\x023*1/v2/user/{user_id}/personal-records/{record_type}\x12|\n" +
+	"\bListGear\x12&.fitglue.services.user.ListGearRequest\x1a'.fitglue.services.user.ListGearResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v2/user/{user_id}/gear\x12y\n" +
+	"\aSetGear\x12%.fitglue.services.user.SetGearRequest\x1a\x19.fitglue.models.user.Gear\",\x82\xd3\xe4\x93\x02&:\x01*\x1a!/v2/user/{user_id}/gear/{gear_id}\x12y\n" +
+	"\n" +
+	"DeleteGear\x12(.fitglue.services.user.DeleteGearRequest\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#*!/v2/user/{user_id}/gear/{gear_id}\x12\x80\x01\n" +
+	"\tListGoals\x12'.fitglue.services.user.ListGoalsRequest\x1a(.fitglue.services.user.ListGoalsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v2/user/{user_id}/goals\x12z\n" +
+	"\aSetGoal\x12%.fitglue.services.user.SetGoalRequest\x1a\x19.fitglue.models.user.Goal\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/v2/user/{user_id}/goals/{goal_id}\x12z\n" +
+	"\n" +
+	"DeleteGoal\x12(.fitglue.services.user.DeleteGoalRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$*\"/v2/user/{user_id}/goals/{goal_id}\x12\xa5\x01\n" +
 	"\x12ListPluginDefaults\x120.fitglue.services.user.ListPluginDefaultsRequest\x1a1.fitglue.services.user.ListPluginDefaultsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v2/user/{user_id}/plugin-defaults\x12\x9e\x01\n" +
 	"\x11SetPluginDefaults\x12/.fitglue.services.user.SetPluginDefaultsRequest\x1a\x16.google.protobuf.Empty\"@\x82\xd3\xe4\x93\x02::\bdefaults\x1a./v2/user/{user_id}/plugin-defaults/{plugin_id}\x12\x9a\x01\n" +
 	"\x14DeletePluginDefaults\x122.fitglue.services.user.DeletePluginDefaultsRequest\x1a\x16.google.protobuf.Empty\"6\x82\xd3\xe4\x93\x020*./v2/user/{user_id}/plugin-defaults/{plugin_id}\x12\x86\x01\n" +
@@ -2043,7 +2766,7 @@ func file_services_user_user_proto_rawDescGZIP() []byte {
 	return file_services_user_user_proto_rawDescData
 }
 
-var file_services_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_services_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_services_user_user_proto_goTypes = []any{
 	(*ResolveUserByIntegrationRequest)(nil),    // 0: fitglue.services.user.ResolveUserByIntegrationRequest
 	(*ResolveUserByIntegrationResponse)(nil),   // 1: fitglue.services.user.ResolveUserByIntegrationResponse
@@ -2055,121 +2778,163 @@ var file_services_user_user_proto_goTypes = []any{
 	(*CreateUserRequest)(nil),                  // 7: fitglue.services.user.CreateUserRequest
 	(*ListUsersRequest)(nil),                   // 8: fitglue.services.user.ListUsersRequest
 	(*ListUsersResponse)(nil),                  // 9: fitglue.services.user.ListUsersResponse
-	(*GetProfileRequest)(nil),                  // 10: fitglue.services.user.GetProfileRequest
-	(*UpdateProfileRequest)(nil),               // 11: fitglue.services.user.UpdateProfileRequest
-	(*GetIntegrationRequest)(nil),              // 12: fitglue.services.user.GetIntegrationRequest
-	(*GetIntegrationResponse)(nil),             // 13: fitglue.services.user.GetIntegrationResponse
-	(*SetIntegrationRequest)(nil),              // 14: fitglue.services.user.SetIntegrationRequest
-	(*DeleteIntegrationRequest)(nil),           // 15: fitglue.services.user.DeleteIntegrationRequest
-	(*ListIntegrationsRequest)(nil),            // 16: fitglue.services.user.ListIntegrationsRequest
-	(*GetNotificationPrefsRequest)(nil),        // 17: fitglue.services.user.GetNotificationPrefsRequest
-	(*UpdateNotificationPrefsRequest)(nil),     // 18: fitglue.services.user.UpdateNotificationPrefsRequest
-	(*ListCountersRequest)(nil),                // 19: fitglue.services.user.ListCountersRequest
-	(*ListCountersResponse)(nil),               // 20: fitglue.services.user.ListCountersResponse
-	(*UpdateCounterRequest)(nil),               // 21: fitglue.services.user.UpdateCounterRequest
-	(*DeleteUserRequest)(nil),                  // 22: fitglue.services.user.DeleteUserRequest
-	(*GetBoosterDataRequest)(nil),              // 23: fitglue.services.user.GetBoosterDataRequest
-	(*GetBoosterDataResponse)(nil),             // 24: fitglue.services.user.GetBoosterDataResponse
-	(*SetBoosterDataRequest)(nil),              // 25: fitglue.services.user.SetBoosterDataRequest
-	(*DeleteBoosterDataRequest)(nil),           // 26: fitglue.services.user.DeleteBoosterDataRequest
-	(*ListPersonalRecordsRequest)(nil),         // 27: fitglue.services.user.ListPersonalRecordsRequest
-	(*ListPersonalRecordsResponse)(nil),        // 28: fitglue.services.user.ListPersonalRecordsResponse
-	(*SetPersonalRecordRequest)(nil),           // 29: fitglue.services.user.SetPersonalRecordRequest
-	(*DeletePersonalRecordRequest)(nil),        // 30: fitglue.services.user.DeletePersonalRecordRequest
-	(*ListPluginDefaultsRequest)(nil),          // 31: fitglue.services.user.ListPluginDefaultsRequest
-	(*ListPluginDefaultsResponse)(nil),         // 32: fitglue.services.user.ListPluginDefaultsResponse
-	(*SetPluginDefaultsRequest)(nil),           // 33: fitglue.services.user.SetPluginDefaultsRequest
-	(*DeletePluginDefaultsRequest)(nil),        // 34: fitglue.services.user.DeletePluginDefaultsRequest
-	(*DeleteCounterRequest)(nil),               // 35: fitglue.services.user.DeleteCounterRequest
-	(*SetFCMTokenRequest)(nil),                 // 36: fitglue.services.user.SetFCMTokenRequest
-	nil,                                        // 37: fitglue.services.user.GetBoosterDataResponse.DataEntry
-	nil,                                        // 38: fitglue.services.user.ListPluginDefaultsResponse.DefaultsEntry
-	(*user.UserProfile)(nil),                   // 39: fitglue.models.user.UserProfile
-	(*user.UserIntegrations)(nil),              // 40: fitglue.models.user.UserIntegrations
-	(*structpb.Struct)(nil),                    // 41: google.protobuf.Struct
-	(*user.NotificationPreferences)(nil),       // 42: fitglue.models.user.NotificationPreferences
-	(*user.Counter)(nil),                       // 43: fitglue.models.user.Counter
-	(*user.PersonalRecord)(nil),                // 44: fitglue.models.user.PersonalRecord
-	(*emptypb.Empty)(nil),                      // 45: google.protobuf.Empty
+	(*SearchUsersRequest)(nil),                 // 10: fitglue.services.user.SearchUsersRequest
+	(*SearchUsersResponse)(nil),                // 11: fitglue.services.user.SearchUsersResponse
+	(*GetProfileRequest)(nil),                  // 12: fitglue.services.user.GetProfileRequest
+	(*UpdateProfileRequest)(nil),               // 13: fitglue.services.user.UpdateProfileRequest
+	(*GetIntegrationRequest)(nil),              // 14: fitglue.services.user.GetIntegrationRequest
+	(*GetIntegrationResponse)(nil),             // 15: fitglue.services.user.GetIntegrationResponse
+	(*GetIntegrationSecretRequest)(nil),        // 16: fitglue.services.user.GetIntegrationSecretRequest
+	(*GetIntegrationSecretResponse)(nil),       // 17: fitglue.services.user.GetIntegrationSecretResponse
+	(*SetIntegrationRequest)(nil),              // 18: fitglue.services.user.SetIntegrationRequest
+	(*DeleteIntegrationRequest)(nil),           // 19: fitglue.services.user.DeleteIntegrationRequest
+	(*ListIntegrationsRequest)(nil),            // 20: fitglue.services.user.ListIntegrationsRequest
+	(*GetNotificationPrefsRequest)(nil),        // 21: fitglue.services.user.GetNotificationPrefsRequest
+	(*UpdateNotificationPrefsRequest)(nil),     // 22: fitglue.services.user.UpdateNotificationPrefsRequest
+	(*ListCountersRequest)(nil),                // 23: fitglue.services.user.ListCountersRequest
+	(*ListCountersResponse)(nil),               // 24: fitglue.services.user.ListCountersResponse
+	(*UpdateCounterRequest)(nil),               // 25: fitglue.services.user.UpdateCounterRequest
+	(*DeleteUserRequest)(nil),                  // 26: fitglue.services.user.DeleteUserRequest
+	(*GetBoosterDataRequest)(nil),              // 27: fitglue.services.user.GetBoosterDataRequest
+	(*GetBoosterDataResponse)(nil),             // 28: fitglue.services.user.GetBoosterDataResponse
+	(*SetBoosterDataRequest)(nil),              // 29: fitglue.services.user.SetBoosterDataRequest
+	(*DeleteBoosterDataRequest)(nil),           // 30: fitglue.services.user.DeleteBoosterDataRequest
+	(*ListPersonalRecordsRequest)(nil),         // 31: fitglue.services.user.ListPersonalRecordsRequest
+	(*ListPersonalRecordsResponse)(nil),        // 32: fitglue.services.user.ListPersonalRecordsResponse
+	(*SetPersonalRecordRequest)(nil),           // 33: fitglue.services.user.SetPersonalRecordRequest
+	(*DeletePersonalRecordRequest)(nil),        // 34: fitglue.services.user.DeletePersonalRecordRequest
+	(*ListGearRequest)(nil),                    // 35: fitglue.services.user.ListGearRequest
+	(*ListGearResponse)(nil),                   // 36: fitglue.services.user.ListGearResponse
+	(*SetGearRequest)(nil),                     // 37: fitglue.services.user.SetGearRequest
+	(*DeleteGearRequest)(nil),                  // 38: fitglue.services.user.DeleteGearRequest
+	(*ListGoalsRequest)(nil),                   // 39: fitglue.services.user.ListGoalsRequest
+	(*ListGoalsResponse)(nil),                  // 40: fitglue.services.user.ListGoalsResponse
+	(*SetGoalRequest)(nil),                     // 41: fitglue.services.user.SetGoalRequest
+	(*DeleteGoalRequest)(nil),                  // 42: fitglue.services.user.DeleteGoalRequest
+	(*ListPluginDefaultsRequest)(nil),          // 43: fitglue.services.user.ListPluginDefaultsRequest
+	(*ListPluginDefaultsResponse)(nil),         // 44: fitglue.services.user.ListPluginDefaultsResponse
+	(*SetPluginDefaultsRequest)(nil),           // 45: fitglue.services.user.SetPluginDefaultsRequest
+	(*DeletePluginDefaultsRequest)(nil),        // 46: fitglue.services.user.DeletePluginDefaultsRequest
+	(*DeleteCounterRequest)(nil),               // 47: fitglue.services.user.DeleteCounterRequest
+	(*SetFCMTokenRequest)(nil),                 // 48: fitglue.services.user.SetFCMTokenRequest
+	nil,                                        // 49: fitglue.services.user.GetBoosterDataResponse.DataEntry
+	nil,                                        // 50: fitglue.services.user.ListPluginDefaultsResponse.DefaultsEntry
+	(*user.UserProfile)(nil),                   // 51: fitglue.models.user.UserProfile
+	(*user.UserIntegrations)(nil),              // 52: fitglue.models.user.UserIntegrations
+	(*structpb.Struct)(nil),                    // 53: google.protobuf.Struct
+	(*user.NotificationPreferences)(nil),       // 54: fitglue.models.user.NotificationPreferences
+	(*user.Counter)(nil),                       // 55: fitglue.models.user.Counter
+	(*user.PersonalRecord)(nil),                // 56: fitglue.models.user.PersonalRecord
+	(*user.Gear)(nil),                          // 57: fitglue.models.user.Gear
+	(user.GearType)(0),                         // 58: fitglue.models.user.GearType
+	(*user.Goal)(nil),                          // 59: fitglue.models.user.Goal
+	(user.GoalMetric)(0),                       // 60: fitglue.models.user.GoalMetric
+	(activity.ActivityType)(0),                 // 61: fitglue.models.activity.ActivityType
+	(*timestamppb.Timestamp)(nil),              // 62: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                      // 63: google.protobuf.Empty
 }
 var file_services_user_user_proto_depIdxs = []int32{
-	39, // 0: fitglue.services.user.ResolveUserByIntegrationResponse.profile:type_name -> fitglue.models.user.UserProfile
-	39, // 1: fitglue.services.user.ListUsersResponse.users:type_name -> fitglue.models.user.UserProfile
-	39, // 2: fitglue.services.user.UpdateProfileRequest.profile:type_name -> fitglue.models.user.UserProfile
-	40, // 3: fitglue.services.user.GetIntegrationResponse.integrations:type_name -> fitglue.models.user.UserIntegrations
-	41, // 4: fitglue.services.user.SetIntegrationRequest.integration_data:type_name -> google.protobuf.Struct
-	42, // 5: fitglue.services.user.UpdateNotificationPrefsRequest.prefs:type_name -> fitglue.models.user.NotificationPreferences
-	43, // 6: fitglue.services.user.ListCountersResponse.counters:type_name -> fitglue.models.user.Counter
-	37, // 7: fitglue.services.user.GetBoosterDataResponse.data:type_name -> fitglue.services.user.GetBoosterDataResponse.DataEntry
-	41, // 8: fitglue.services.user.SetBoosterDataRequest.data:type_name -> google.protobuf.Struct
-	44, // 9: fitglue.services.user.ListPersonalRecordsResponse.records:type_name -> fitglue.models.user.PersonalRecord
-	38, // 10: fitglue.services.user.ListPluginDefaultsResponse.defaults:type_name -> fitglue.services.user.ListPluginDefaultsResponse.DefaultsEntry
-	41, // 11: fitglue.services.user.SetPluginDefaultsRequest.defaults:type_name -> google.protobuf.Struct
-	41, // 12: fitglue.services.user.GetBoosterDataResponse.DataEntry.value:type_name -> google.protobuf.Struct
-	41, // 13: fitglue.services.user.ListPluginDefaultsResponse.DefaultsEntry.value:type_name -> google.protobuf.Struct
-	7,  // 14: fitglue.services.user.UserService.CreateUser:input_type -> fitglue.services.user.CreateUserRequest
-	10, // 15: fitglue.services.user.UserService.GetProfile:input_type -> fitglue.services.user.GetProfileRequest
-	8,  // 16: fitglue.services.user.UserService.ListUsers:input_type -> fitglue.services.user.ListUsersRequest
-	11, // 17: fitglue.services.user.UserService.UpdateProfile:input_type -> fitglue.services.user.UpdateProfileRequest
-	12, // 18: fitglue.services.user.UserService.GetIntegration:input_type -> fitglue.services.user.GetIntegrationRequest
-	14, // 19: fitglue.services.user.UserService.SetIntegration:input_type -> fitglue.services.user.SetIntegrationRequest
-	15, // 20: fitglue.services.user.UserService.DeleteIntegration:input_type -> fitglue.services.user.DeleteIntegrationRequest
-	16, // 21: fitglue.services.user.UserService.ListIntegrations:input_type -> fitglue.services.user.ListIntegrationsRequest
-	17, // 22: fitglue.services.user.UserService.GetNotificationPrefs:input_type -> fitglue.services.user.GetNotificationPrefsRequest
-	18, // 23: fitglue.services.user.UserService.UpdateNotificationPrefs:input_type -> fitglue.services.user.UpdateNotificationPrefsRequest
-	19, // 24: fitglue.services.user.UserService.ListCounters:input_type -> fitglue.services.user.ListCountersRequest
-	21, // 25: fitglue.services.user.UserService.UpdateCounter:input_type -> fitglue.services.user.UpdateCounterRequest
-	23, // 26: fitglue.services.user.UserService.GetBoosterData:input_type -> fitglue.services.user.GetBoosterDataRequest
-	25, // 27: fitglue.services.user.UserService.SetBoosterData:input_type -> fitglue.services.user.SetBoosterDataRequest
-	26, // 28: fitglue.services.user.UserService.DeleteBoosterData:input_type -> fitglue.services.user.DeleteBoosterDataRequest
-	22, // 29: fitglue.services.user.UserService.DeleteUser:input_type -> fitglue.services.user.DeleteUserRequest
-	2,  // 30: fitglue.services.user.UserService.SendVerificationEmail:input_type -> fitglue.services.user.SendVerificationEmailRequest
-	3,  // 31: fitglue.services.user.UserService.SendPasswordResetEmail:input_type -> fitglue.services.user.SendPasswordResetEmailRequest
-	4,  // 32: fitglue.services.user.UserService.SendEmailChangeVerification:input_type -> fitglue.services.user.SendEmailChangeVerificationRequest
-	6,  // 33: fitglue.services.user.UserService.GenerateRegistrationSummary:input_type -> fitglue.services.user.GenerateRegistrationSummaryRequest
-	0,  // 34: fitglue.services.user.UserService.ResolveUserByIntegration:input_type -> fitglue.services.user.ResolveUserByIntegrationRequest
-	27, // 35: fitglue.services.user.UserService.ListPersonalRecords:input_type -> fitglue.services.user.ListPersonalRecordsRequest
-	29, // 36: fitglue.services.user.UserService.SetPersonalRecord:input_type -> fitglue.services.user.SetPersonalRecordRequest
-	30, // 37: fitglue.services.user.UserService.DeletePersonalRecord:input_type -> fitglue.services.user.DeletePersonalRecordRequest
-	31, // 38: fitglue.services.user.UserService.ListPluginDefaults:input_type -> fitglue.services.user.ListPluginDefaultsRequest
-	33, // 39: fitglue.services.user.UserService.SetPluginDefaults:input_type -> fitglue.services.user.SetPluginDefaultsRequest
-	34, // 40: fitglue.services.user.UserService.DeletePluginDefaults:input_type -> fitglue.services.user.DeletePluginDefaultsRequest
-	35, // 41: fitglue.services.user.UserService.DeleteCounter:input_type -> fitglue.services.user.DeleteCounterRequest
-	36, // 42: fitglue.services.user.UserService.SetFCMToken:input_type -> fitglue.services.user.SetFCMTokenRequest
-	39, // 43: fitglue.services.user.UserService.CreateUser:output_type -> fitglue.models.user.UserProfile
-	39, // 44: fitglue.services.user.UserService.GetProfile:output_type -> fitglue.models.user.UserProfile
-	9,  // 45: fitglue.services.user.UserService.ListUsers:output_type -> fitglue.services.user.ListUsersResponse
-	39, // 46: fitglue.services.user.UserService.UpdateProfile:output_type -> fitglue.models.user.UserProfile
-	13, // 47: fitglue.services.user.UserService.GetIntegration:output_type -> fitglue.services.user.GetIntegrationResponse
-	45, // 48: fitglue.services.user.UserService.SetIntegration:output_type -> google.protobuf.Empty
-	45, // 49: fitglue.services.user.UserService.DeleteIntegration:output_type -> google.protobuf.Empty
-	40, // 50: fitglue.services.user.UserService.ListIntegrations:output_type -> fitglue.models.user.UserIntegrations
-	42, // 51: fitglue.services.user.UserService.GetNotificationPrefs:output_type -> fitglue.models.user.NotificationPreferences
-	42, // 52: fitglue.services.user.UserService.UpdateNotificationPrefs:output_type -> fitglue.models.user.NotificationPreferences
-	20, // 53: fitglue.services.user.UserService.ListCounters:output_type -> fitglue.services.user.ListCountersResponse
-	43, // 54: fitglue.services.user.UserService.UpdateCounter:output_type -> fitglue.models.user.Counter
-	24, // 55: fitglue.services.user.UserService.GetBoosterData:output_type -> fitglue.services.user.GetBoosterDataResponse
-	45, // 56: fitglue.services.user.UserService.SetBoosterData:output_type -> google.protobuf.Empty
-	45, // 57: fitglue.services.user.UserService.DeleteBoosterData:output_type -> google.protobuf.Empty
-	45, // 58: fitglue.services.user.UserService.DeleteUser:output_type -> google.protobuf.Empty
-	45, // 59: fitglue.services.user.UserService.SendVerificationEmail:output_type -> google.protobuf.Empty
-	45, // 60: fitglue.services.user.UserService.SendPasswordResetEmail:output_type -> google.protobuf.Empty
-	45, // 61: fitglue.services.user.UserService.SendEmailChangeVerification:output_type -> google.protobuf.Empty
-	45, // 62: fitglue.services.user.UserService.GenerateRegistrationSummary:output_type -> google.protobuf.Empty
-	1,  // 63: fitglue.services.user.UserService.ResolveUserByIntegration:output_type -> fitglue.services.user.ResolveUserByIntegrationResponse
-	28, // 64: fitglue.services.user.UserService.ListPersonalRecords:output_type -> fitglue.services.user.ListPersonalRecordsResponse
-	44, // 65: fitglue.services.user.UserService.SetPersonalRecord:output_type -> fitglue.models.user.PersonalRecord
-	45, // 66: fitglue.services.user.UserService.DeletePersonalRecord:output_type -> google.protobuf.Empty
-	32, // 67: fitglue.services.user.UserService.ListPluginDefaults:output_type -> fitglue.services.user.ListPluginDefaultsResponse
-	45, // 68: fitglue.services.user.UserService.SetPluginDefaults:output_type -> google.protobuf.Empty
-	45, // 69: fitglue.services.user.UserService.DeletePluginDefaults:output_type -> google.protobuf.Empty
-	45, // 70: fitglue.services.user.UserService.DeleteCounter:output_type -> google.protobuf.Empty
-	45, // 71: fitglue.services.user.UserService.SetFCMToken:output_type -> google.protobuf.Empty
-	43, // [43:72] is the sub-list for method output_type
-	14, // [14:43] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	51, // 0: fitglue.services.user.ResolveUserByIntegrationResponse.profile:type_name -> fitglue.models.user.UserProfile
+	51, // 1: fitglue.services.user.ListUsersResponse.users:type_name -> fitglue.models.user.UserProfile
+	51, // 2: fitglue.services.user.SearchUsersResponse.users:type_name -> fitglue.models.user.UserProfile
+	51, // 3: fitglue.services.user.UpdateProfileRequest.profile:type_name -> fitglue.models.user.UserProfile
+	52, // 4: fitglue.services.user.GetIntegrationResponse.integrations:type_name -> fitglue.models.user.UserIntegrations
+	53, // 5: fitglue.services.user.SetIntegrationRequest.integration_data:type_name -> google.protobuf.Struct
+	54, // 6: fitglue.services.user.UpdateNotificationPrefsRequest.prefs:type_name -> fitglue.models.user.NotificationPreferences
+	55, // 7: fitglue.services.user.ListCountersResponse.counters:type_name -> fitglue.models.user.Counter
+	49, // 8: fitglue.services.user.GetBoosterDataResponse.data:type_name -> fitglue.services.user.GetBoosterDataResponse.DataEntry
+	53, // 9: fitglue.services.user.SetBoosterDataRequest.data:type_name -> google.protobuf.Struct
+	56, // 10: fitglue.services.user.ListPersonalRecordsResponse.records:type_name -> fitglue.models.user.PersonalRecord
+	57, // 11: fitglue.services.user.ListGearResponse.gear:type_name -> fitglue.models.user.Gear
+	58, // 12: fitglue.services.user.SetGearRequest.type:type_name -> fitglue.models.user.GearType
+	59, // 13: fitglue.services.user.ListGoalsResponse.goals:type_name -> fitglue.models.user.Goal
+	60, // 14: fitglue.services.user.SetGoalRequest.metric:type_name -> fitglue.models.user.GoalMetric
+	61, // 15: fitglue.services.user.SetGoalRequest.activity_type:type_name -> fitglue.models.activity.ActivityType
+	62, // 16: fitglue.services.user.SetGoalRequest.start_date:type_name -> google.protobuf.Timestamp
+	62, // 17: fitglue.services.user.SetGoalRequest.end_date:type_name -> google.protobuf.Timestamp
+	50, // 18: fitglue.services.user.ListPluginDefaultsResponse.defaults:type_name -> fitglue.services.user.ListPluginDefaultsResponse.DefaultsEntry
+	53, // 19: fitglue.services.user.SetPluginDefaultsRequest.defaults:type_name -> google.protobuf.Struct
+	53, // 20: fitglue.services.user.GetBoosterDataResponse.DataEntry.value:type_name -> google.protobuf.Struct
+	53, // 21: fitglue.services.user.ListPluginDefaultsResponse.DefaultsEntry.value:type_name -> google.protobuf.Struct
+	7,  // 22: fitglue.services.user.UserService.CreateUser:input_type -> fitglue.services.user.CreateUserRequest
+	12, // 23: fitglue.services.user.UserService.GetProfile:input_type -> fitglue.services.user.GetProfileRequest
+	8,  // 24: fitglue.services.user.UserService.ListUsers:input_type -> fitglue.services.user.ListUsersRequest
+	10, // 25: fitglue.services.user.UserService.SearchUsers:input_type -> fitglue.services.user.SearchUsersRequest
+	13, // 26: fitglue.services.user.UserService.UpdateProfile:input_type -> fitglue.services.user.UpdateProfileRequest
+	14, // 27: fitglue.services.user.UserService.GetIntegration:input_type -> fitglue.services.user.GetIntegrationRequest
+	18, // 28: fitglue.services.user.UserService.SetIntegration:input_type -> fitglue.services.user.SetIntegrationRequest
+	19, // 29: fitglue.services.user.UserService.DeleteIntegration:input_type -> fitglue.services.user.DeleteIntegrationRequest
+	20, // 30: fitglue.services.user.UserService.ListIntegrations:input_type -> fitglue.services.user.ListIntegrationsRequest
+	16, // 31: fitglue.services.user.UserService.GetIntegrationSecret:input_type -> fitglue.services.user.GetIntegrationSecretRequest
+	21, // 32: fitglue.services.user.UserService.GetNotificationPrefs:input_type -> fitglue.services.user.GetNotificationPrefsRequest
+	22, // 33: fitglue.services.user.UserService.UpdateNotificationPrefs:input_type -> fitglue.services.user.UpdateNotificationPrefsRequest
+	23, // 34: fitglue.services.user.UserService.ListCounters:input_type -> fitglue.services.user.ListCountersRequest
+	25, // 35: fitglue.services.user.UserService.UpdateCounter:input_type -> fitglue.services.user.UpdateCounterRequest
+	27, // 36: fitglue.services.user.UserService.GetBoosterData:input_type -> fitglue.services.user.GetBoosterDataRequest
+	29, // 37: fitglue.services.user.UserService.SetBoosterData:input_type -> fitglue.services.user.SetBoosterDataRequest
+	30, // 38: fitglue.services.user.UserService.DeleteBoosterData:input_type -> fitglue.services.user.DeleteBoosterDataRequest
+	26, // 39: fitglue.services.user.UserService.DeleteUser:input_type -> fitglue.services.user.DeleteUserRequest
+	2,  // 40: fitglue.services.user.UserService.SendVerificationEmail:input_type -> fitglue.services.user.SendVerificationEmailRequest
+	3,  // 41: fitglue.services.user.UserService.SendPasswordResetEmail:input_type -> fitglue.services.user.SendPasswordResetEmailRequest
+	4,  // 42: fitglue.services.user.UserService.SendEmailChangeVerification:input_type -> fitglue.services.user.SendEmailChangeVerificationRequest
+	6,  // 43: fitglue.services.user.UserService.GenerateRegistrationSummary:input_type -> fitglue.services.user.GenerateRegistrationSummaryRequest
+	0,  // 44: fitglue.services.user.UserService.ResolveUserByIntegration:input_type -> fitglue.services.user.ResolveUserByIntegrationRequest
+	31, // 45: fitglue.services.user.UserService.ListPersonalRecords:input_type -> fitglue.services.user.ListPersonalRecordsRequest
+	33, // 46: fitglue.services.user.UserService.SetPersonalRecord:input_type -> fitglue.services.user.SetPersonalRecordRequest
+	34, // 47: fitglue.services.user.UserService.DeletePersonalRecord:input_type -> fitglue.services.user.DeletePersonalRecordRequest
+	35, // 48: fitglue.services.user.UserService.ListGear:input_type -> fitglue.services.user.ListGearRequest
+	37, // 49: fitglue.services.user.UserService.SetGear:input_type -> fitglue.services.user.SetGearRequest
+	38, // 50: fitglue.services.user.UserService.DeleteGear:input_type -> fitglue.services.user.DeleteGearRequest
+	39, // 51: fitglue.services.user.UserService.ListGoals:input_type -> fitglue.services.user.ListGoalsRequest
+	41, // 52: fitglue.services.user.UserService.SetGoal:input_type -> fitglue.services.user.SetGoalRequest
+	42, // 53: fitglue.services.user.UserService.DeleteGoal:input_type -> fitglue.services.user.DeleteGoalRequest
+	43, // 54: fitglue.services.user.UserService.ListPluginDefaults:input_type -> fitglue.services.user.ListPluginDefaultsRequest
+	45, // 55: fitglue.services.user.UserService.SetPluginDefaults:input_type -> fitglue.services.user.SetPluginDefaultsRequest
+	46, // 56: fitglue.services.user.UserService.DeletePluginDefaults:input_type -> fitglue.services.user.DeletePluginDefaultsRequest
+	47, // 57: fitglue.services.user.UserService.DeleteCounter:input_type -> fitglue.services.user.DeleteCounterRequest
+	48, // 58: fitglue.services.user.UserService.SetFCMToken:input_type -> fitglue.services.user.SetFCMTokenRequest
+	51, // 59: fitglue.services.user.UserService.CreateUser:output_type -> fitglue.models.user.UserProfile
+	51, // 60: fitglue.services.user.UserService.GetProfile:output_type -> fitglue.models.user.UserProfile
+	9,  // 61: fitglue.services.user.UserService.ListUsers:output_type -> fitglue.services.user.ListUsersResponse
+	11, // 62: fitglue.services.user.UserService.SearchUsers:output_type -> fitglue.services.user.SearchUsersResponse
+	51, // 63: fitglue.services.user.UserService.UpdateProfile:output_type -> fitglue.models.user.UserProfile
+	15, // 64: fitglue.services.user.UserService.GetIntegration:output_type -> fitglue.services.user.GetIntegrationResponse
+	63, // 65: fitglue.services.user.UserService.SetIntegration:output_type -> google.protobuf.Empty
+	63, // 66: fitglue.services.user.UserService.DeleteIntegration:output_type -> google.protobuf.Empty
+	52, // 67: fitglue.services.user.UserService.ListIntegrations:output_type -> fitglue.models.user.UserIntegrations
+	17, // 68: fitglue.services.user.UserService.GetIntegrationSecret:output_type -> fitglue.services.user.GetIntegrationSecretResponse
+	54, // 69: fitglue.services.user.UserService.GetNotificationPrefs:output_type -> fitglue.models.user.NotificationPreferences
+	54, // 70: fitglue.services.user.UserService.UpdateNotificationPrefs:output_type -> fitglue.models.user.NotificationPreferences
+	24, // 71: fitglue.services.user.UserService.ListCounters:output_type -> fitglue.services.user.ListCountersResponse
+	55, // 72: fitglue.services.user.UserService.UpdateCounter:output_type -> fitglue.models.user.Counter
+	28, // 73: fitglue.services.user.UserService.GetBoosterData:output_type -> fitglue.services.user.GetBoosterDataResponse
+	63, // 74: fitglue.services.user.UserService.SetBoosterData:output_type -> google.protobuf.Empty
+	63, // 75: fitglue.services.user.UserService.DeleteBoosterData:output_type -> google.protobuf.Empty
+	63, // 76: fitglue.services.user.UserService.DeleteUser:output_type -> google.protobuf.Empty
+	63, // 77: fitglue.services.user.UserService.SendVerificationEmail:output_type -> google.protobuf.Empty
+	63, // 78: fitglue.services.user.UserService.SendPasswordResetEmail:output_type -> google.protobuf.Empty
+	63, // 79: fitglue.services.user.UserService.SendEmailChangeVerification:output_type -> google.protobuf.Empty
+	63, // 80: fitglue.services.user.UserService.GenerateRegistrationSummary:output_type -> google.protobuf.Empty
+	1,  // 81: fitglue.services.user.UserService.ResolveUserByIntegration:output_type -> fitglue.services.user.ResolveUserByIntegrationResponse
+	32, // 82: fitglue.services.user.UserService.ListPersonalRecords:output_type -> fitglue.services.user.ListPersonalRecordsResponse
+	56, // 83: fitglue.services.user.UserService.SetPersonalRecord:output_type -> fitglue.models.user.PersonalRecord
+	63, // 84: fitglue.services.user.UserService.DeletePersonalRecord:output_type -> google.protobuf.Empty
+	36, // 85: fitglue.services.user.UserService.ListGear:output_type -> fitglue.services.user.ListGearResponse
+	57, // 86: fitglue.services.user.UserService.SetGear:output_type -> fitglue.models.user.Gear
+	63, // 87: fitglue.services.user.UserService.DeleteGear:output_type -> google.protobuf.Empty
+	40, // 88: fitglue.services.user.UserService.ListGoals:output_type -> fitglue.services.user.ListGoalsResponse
+	59, // 89: fitglue.services.user.UserService.SetGoal:output_type -> fitglue.models.user.Goal
+	63, // 90: fitglue.services.user.UserService.DeleteGoal:output_type -> google.protobuf.Empty
+	44, // 91: fitglue.services.user.UserService.ListPluginDefaults:output_type -> fitglue.services.user.ListPluginDefaultsResponse
+	63, // 92: fitglue.services.user.UserService.SetPluginDefaults:output_type -> google.protobuf.Empty
+	63, // 93: fitglue.services.user.UserService.DeletePluginDefaults:output_type -> google.protobuf.Empty
+	63, // 94: fitglue.services.user.UserService.DeleteCounter:output_type -> google.protobuf.Empty
+	63, // 95: fitglue.services.user.UserService.SetFCMToken:output_type -> google.protobuf.Empty
+	59, // [59:96] is the sub-list for method output_type
+	22, // [22:59] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_services_user_user_proto_init() }
@@ -2183,7 +2948,7 @@ func file_services_user_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_user_user_proto_rawDesc), len(file_services_user_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   39,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
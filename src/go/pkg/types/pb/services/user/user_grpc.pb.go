@@ -24,11 +24,13 @@ const (
 	UserService_CreateUser_FullMethodName                  = "/fitglue.services.user.UserService/CreateUser"
 	UserService_GetProfile_FullMethodName                  = "/fitglue.services.user.UserService/GetProfile"
 	UserService_ListUsers_FullMethodName                   = "/fitglue.services.user.UserService/ListUsers"
+	UserService_SearchUsers_FullMethodName                 = "/fitglue.services.user.UserService/SearchUsers"
 	UserService_UpdateProfile_FullMethodName               = "/fitglue.services.user.UserService/UpdateProfile"
 	UserService_GetIntegration_FullMethodName              = "/fitglue.services.user.UserService/GetIntegration"
 	UserService_SetIntegration_FullMethodName              = "/fitglue.services.user.UserService/SetIntegration"
 	UserService_DeleteIntegration_FullMethodName           = "/fitglue.services.user.UserService/DeleteIntegration"
 	UserService_ListIntegrations_FullMethodName            = "/fitglue.services.user.UserService/ListIntegrations"
+	UserService_GetIntegrationSecret_FullMethodName        = "/fitglue.services.user.UserService/GetIntegrationSecret"
 	UserService_GetNotificationPrefs_FullMethodName        = "/fitglue.services.user.UserService/GetNotificationPrefs"
 	UserService_UpdateNotificationPrefs_FullMethodName     = "/fitglue.services.user.UserService/UpdateNotificationPrefs"
 	UserService_ListCounters_FullMethodName                = "/fitglue.services.user.UserService/ListCounters"
@@ -45,6 +47,12 @@ const (
 	UserService_ListPersonalRecords_FullMethodName         = "/fitglue.services.user.UserService/ListPersonalRecords"
 	UserService_SetPersonalRecord_FullMethodName           = "/fitglue.services.user.UserService/SetPersonalRecord"
 	UserService_DeletePersonalRecord_FullMethodName        = "/fitglue.services.user.UserService/DeletePersonalRecord"
+	UserService_ListGear_FullMethodName                    = "/fitglue.services.user.UserService/ListGear"
+	UserService_SetGear_FullMethodName                     = "/fitglue.services.user.UserService/SetGear"
+	UserService_DeleteGear_FullMethodName                  = "/fitglue.services.user.UserService/DeleteGear"
+	UserService_ListGoals_FullMethodName                   = "/fitglue.services.user.UserService/ListGoals"
+	UserService_SetGoal_FullMethodName                     = "/fitglue.services.user.UserService/SetGoal"
+	UserService_DeleteGoal_FullMethodName                  = "/fitglue.services.user.UserService/DeleteGoal"
 	UserService_ListPluginDefaults_FullMethodName          = "/fitglue.services.user.UserService/ListPluginDefaults"
 	UserService_SetPluginDefaults_FullMethodName           = "/fitglue.services.user.UserService/SetPluginDefaults"
 	UserService_DeletePluginDefaults_FullMethodName        = "/fitglue.services.user.UserService/DeletePluginDefaults"
@@ -59,11 +67,17 @@ type UserServiceClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*user.UserProfile, error)
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*user.UserProfile, error)
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// Admin lookup by sign-in email or by a connected platform's user ID
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*user.UserProfile, error)
 	GetIntegration(ctx context.Context, in *GetIntegrationRequest, opts ...grpc.CallOption) (*GetIntegrationResponse, error)
 	SetIntegration(ctx context.Context, in *SetIntegrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteIntegration(ctx context.Context, in *DeleteIntegrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListIntegrations(ctx context.Context, in *ListIntegrationsRequest, opts ...grpc.CallOption) (*user.UserIntegrations, error)
+	// API keys are stored apart from the integration (see GetIntegration) and
+	// are only returned by this RPC, for sources calling the provider's API.
+	// Service-to-service only, so it has no HTTP mapping.
+	GetIntegrationSecret(ctx context.Context, in *GetIntegrationSecretRequest, opts ...grpc.CallOption) (*GetIntegrationSecretResponse, error)
 	GetNotificationPrefs(ctx context.Context, in *GetNotificationPrefsRequest, opts ...grpc.CallOption) (*user.NotificationPreferences, error)
 	UpdateNotificationPrefs(ctx context.Context, in *UpdateNotificationPrefsRequest, opts ...grpc.CallOption) (*user.NotificationPreferences, error)
 	ListCounters(ctx context.Context, in *ListCountersRequest, opts ...grpc.CallOption) (*ListCountersResponse, error)
@@ -84,6 +98,14 @@ type UserServiceClient interface {
 	ListPersonalRecords(ctx context.Context, in *ListPersonalRecordsRequest, opts ...grpc.CallOption) (*ListPersonalRecordsResponse, error)
 	SetPersonalRecord(ctx context.Context, in *SetPersonalRecordRequest, opts ...grpc.CallOption) (*user.PersonalRecord, error)
 	DeletePersonalRecord(ctx context.Context, in *DeletePersonalRecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Gear
+	ListGear(ctx context.Context, in *ListGearRequest, opts ...grpc.CallOption) (*ListGearResponse, error)
+	SetGear(ctx context.Context, in *SetGearRequest, opts ...grpc.CallOption) (*user.Gear, error)
+	DeleteGear(ctx context.Context, in *DeleteGearRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Goals
+	ListGoals(ctx context.Context, in *ListGoalsRequest, opts ...grpc.CallOption) (*ListGoalsResponse, error)
+	SetGoal(ctx context.Context, in *SetGoalRequest, opts ...grpc.CallOption) (*user.Goal, error)
+	DeleteGoal(ctx context.Context, in *DeleteGoalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Plugin Defaults
 	ListPluginDefaults(ctx context.Context, in *ListPluginDefaultsRequest, opts ...grpc.CallOption) (*ListPluginDefaultsResponse, error)
 	SetPluginDefaults(ctx context.Context, in *SetPluginDefaultsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -132,6 +154,16 @@ func (c *userServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest,
 	return out, nil
 }
 
+func (c *userServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*user.UserProfile, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(user.UserProfile)
@@ -182,6 +214,16 @@ func (c *userServiceClient) ListIntegrations(ctx context.Context, in *ListIntegr
 	return out, nil
 }
 
+func (c *userServiceClient) GetIntegrationSecret(ctx context.Context, in *GetIntegrationSecretRequest, opts ...grpc.CallOption) (*GetIntegrationSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIntegrationSecretResponse)
+	err := c.cc.Invoke(ctx, UserService_GetIntegrationSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetNotificationPrefs(ctx context.Context, in *GetNotificationPrefsRequest, opts ...grpc.CallOption) (*user.NotificationPreferences, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(user.NotificationPreferences)
@@ -342,6 +384,66 @@ func (c *userServiceClient) DeletePersonalRecord(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *userServiceClient) ListGear(ctx context.Context, in *ListGearRequest, opts ...grpc.CallOption) (*ListGearResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGearResponse)
+	err := c.cc.Invoke(ctx, UserService_ListGear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetGear(ctx context.Context, in *SetGearRequest, opts ...grpc.CallOption) (*user.Gear, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(user.Gear)
+	err := c.cc.Invoke(ctx, UserService_SetGear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteGear(ctx context.Context, in *DeleteGearRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteGear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListGoals(ctx context.Context, in *ListGoalsRequest, opts ...grpc.CallOption) (*ListGoalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGoalsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListGoals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetGoal(ctx context.Context, in *SetGoalRequest, opts ...grpc.CallOption) (*user.Goal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(user.Goal)
+	err := c.cc.Invoke(ctx, UserService_SetGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteGoal(ctx context.Context, in *DeleteGoalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ListPluginDefaults(ctx context.Context, in *ListPluginDefaultsRequest, opts ...grpc.CallOption) (*ListPluginDefaultsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPluginDefaultsResponse)
@@ -399,11 +501,17 @@ type UserServiceServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*user.UserProfile, error)
 	GetProfile(context.Context, *GetProfileRequest) (*user.UserProfile, error)
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// Admin lookup by sign-in email or by a connected platform's user ID
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*user.UserProfile, error)
 	GetIntegration(context.Context, *GetIntegrationRequest) (*GetIntegrationResponse, error)
 	SetIntegration(context.Context, *SetIntegrationRequest) (*emptypb.Empty, error)
 	DeleteIntegration(context.Context, *DeleteIntegrationRequest) (*emptypb.Empty, error)
 	ListIntegrations(context.Context, *ListIntegrationsRequest) (*user.UserIntegrations, error)
+	// API keys are stored apart from the integration (see GetIntegration) and
+	// are only returned by this RPC, for sources calling the provider's API.
+	// Service-to-service only, so it has no HTTP mapping.
+	GetIntegrationSecret(context.Context, *GetIntegrationSecretRequest) (*GetIntegrationSecretResponse, error)
 	GetNotificationPrefs(context.Context, *GetNotificationPrefsRequest) (*user.NotificationPreferences, error)
 	UpdateNotificationPrefs(context.Context, *UpdateNotificationPrefsRequest) (*user.NotificationPreferences, error)
 	ListCounters(context.Context, *ListCountersRequest) (*ListCountersResponse, error)
@@ -424,6 +532,14 @@ type UserServiceServer interface {
 	ListPersonalRecords(context.Context, *ListPersonalRecordsRequest) (*ListPersonalRecordsResponse, error)
 	SetPersonalRecord(context.Context, *SetPersonalRecordRequest) (*user.PersonalRecord, error)
 	DeletePersonalRecord(context.Context, *DeletePersonalRecordRequest) (*emptypb.Empty, error)
+	// Gear
+	ListGear(context.Context, *ListGearRequest) (*ListGearResponse, error)
+	SetGear(context.Context, *SetGearRequest) (*user.Gear, error)
+	DeleteGear(context.Context, *DeleteGearRequest) (*emptypb.Empty, error)
+	// Goals
+	ListGoals(context.Context, *ListGoalsRequest) (*ListGoalsResponse, error)
+	SetGoal(context.Context, *SetGoalRequest) (*user.Goal, error)
+	DeleteGoal(context.Context, *DeleteGoalRequest) (*emptypb.Empty, error)
 	// Plugin Defaults
 	ListPluginDefaults(context.Context, *ListPluginDefaultsRequest) (*ListPluginDefaultsResponse, error)
 	SetPluginDefaults(context.Context, *SetPluginDefaultsRequest) (*emptypb.Empty, error)
@@ -451,6 +567,9 @@ func (UnimplementedUserServiceServer) GetProfile(context.Context, *GetProfileReq
 func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListUsers not implemented")
 }
+func (UnimplementedUserServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchUsers not implemented")
+}
 func (UnimplementedUserServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*user.UserProfile, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateProfile not implemented")
 }
@@ -466,6 +585,9 @@ func (UnimplementedUserServiceServer) DeleteIntegration(context.Context, *Delete
 func (UnimplementedUserServiceServer) ListIntegrations(context.Context, *ListIntegrationsRequest) (*user.UserIntegrations, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListIntegrations not implemented")
 }
+func (UnimplementedUserServiceServer) GetIntegrationSecret(context.Context, *GetIntegrationSecretRequest) (*GetIntegrationSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIntegrationSecret not implemented")
+}
 func (UnimplementedUserServiceServer) GetNotificationPrefs(context.Context, *GetNotificationPrefsRequest) (*user.NotificationPreferences, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetNotificationPrefs not implemented")
 }
@@ -514,6 +636,24 @@ func (UnimplementedUserServiceServer) SetPersonalRecord(context.Context, *SetPer
 func (UnimplementedUserServiceServer) DeletePersonalRecord(context.Context, *DeletePersonalRecordRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeletePersonalRecord not implemented")
 }
+func (UnimplementedUserServiceServer) ListGear(context.Context, *ListGearRequest) (*ListGearResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGear not implemented")
+}
+func (UnimplementedUserServiceServer) SetGear(context.Context, *SetGearRequest) (*user.Gear, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetGear not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteGear(context.Context, *DeleteGearRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGear not implemented")
+}
+func (UnimplementedUserServiceServer) ListGoals(context.Context, *ListGoalsRequest) (*ListGoalsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGoals not implemented")
+}
+func (UnimplementedUserServiceServer) SetGoal(context.Context, *SetGoalRequest) (*user.Goal, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetGoal not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteGoal(context.Context, *DeleteGoalRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGoal not implemented")
+}
 func (UnimplementedUserServiceServer) ListPluginDefaults(context.Context, *ListPluginDefaultsRequest) (*ListPluginDefaultsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListPluginDefaults not implemented")
 }
@@ -604,6 +744,24 @@ func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateProfileRequest)
 	if err := dec(in); err != nil {
@@ -694,6 +852,24 @@ func _UserService_ListIntegrations_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetIntegrationSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIntegrationSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetIntegrationSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetIntegrationSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetIntegrationSecret(ctx, req.(*GetIntegrationSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetNotificationPrefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetNotificationPrefsRequest)
 	if err := dec(in); err != nil {
@@ -982,6 +1158,114 @@ func _UserService_DeletePersonalRecord_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListGear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListGear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListGear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListGear(ctx, req.(*ListGearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetGear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetGear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetGear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetGear(ctx, req.(*SetGearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteGear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteGear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteGear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteGear(ctx, req.(*DeleteGearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListGoals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGoalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListGoals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListGoals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListGoals(ctx, req.(*ListGoalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetGoal(ctx, req.(*SetGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteGoal(ctx, req.(*DeleteGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ListPluginDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPluginDefaultsRequest)
 	if err := dec(in); err != nil {
@@ -1091,6 +1375,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUsers",
 			Handler:    _UserService_ListUsers_Handler,
 		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _UserService_SearchUsers_Handler,
+		},
 		{
 			MethodName: "UpdateProfile",
 			Handler:    _UserService_UpdateProfile_Handler,
@@ -1111,6 +1399,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListIntegrations",
 			Handler:    _UserService_ListIntegrations_Handler,
 		},
+		{
+			MethodName: "GetIntegrationSecret",
+			Handler:    _UserService_GetIntegrationSecret_Handler,
+		},
 		{
 			MethodName: "GetNotificationPrefs",
 			Handler:    _UserService_GetNotificationPrefs_Handler,
@@ -1175,6 +1467,30 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePersonalRecord",
 			Handler:    _UserService_DeletePersonalRecord_Handler,
 		},
+		{
+			MethodName: "ListGear",
+			Handler:    _UserService_ListGear_Handler,
+		},
+		{
+			MethodName: "SetGear",
+			Handler:    _UserService_SetGear_Handler,
+		},
+		{
+			MethodName: "DeleteGear",
+			Handler:    _UserService_DeleteGear_Handler,
+		},
+		{
+			MethodName: "ListGoals",
+			Handler:    _UserService_ListGoals_Handler,
+		},
+		{
+			MethodName: "SetGoal",
+			Handler:    _UserService_SetGoal_Handler,
+		},
+		{
+			MethodName: "DeleteGoal",
+			Handler:    _UserService_DeleteGoal_Handler,
+		},
 		{
 			MethodName: "ListPluginDefaults",
 			Handler:    _UserService_ListPluginDefaults_Handler,
@@ -69,6 +69,8 @@ func main() {
 	}
 
 	svc := activity.NewService(store, blobStore, pub, bucketName, showcaseAssetsBucket, logger)
+	// Signs embed links to hidden showcase profiles; without it they can't be embedded
+	svc.SetEmbedSigningKey(os.Getenv("SHOWCASE_EMBED_SECRET"))
 
 	server := grpc.NewServer(grpc.UnaryInterceptor(infra.LoggingUnaryInterceptor(logger)))
 	pb.RegisterActivityServiceServer(server, svc)
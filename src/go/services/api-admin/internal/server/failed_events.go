@@ -0,0 +1,66 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+)
+
+// handleListFailedEvents lists a user's dead-lettered pipeline events,
+// optionally filtered by status (e.g. ?status=FAILED_EVENT_STATUS_EXHAUSTED).
+func (s *APIServer) handleListFailedEvents(w http.ResponseWriter, r *http.Request) {
+	req := &pipelinepb.AdminListFailedEventsRequest{UserId: chi.URLParam(r, "id")}
+	if st := r.URL.Query().Get("status"); st != "" {
+		v, ok := pbpipeline.FailedEventStatus_value[st]
+		if !ok {
+			WriteError(w, statusError(http.StatusBadRequest, fmt.Sprintf("unknown status: %s", st)))
+			return
+		}
+		req.Status = pbpipeline.FailedEventStatus(v)
+	}
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 && parsed <= 200 {
+			req.Limit = int32(parsed)
+		}
+	}
+
+	res, err := s.pipelineSvc.AdminListFailedEvents(r.Context(), req)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+// handleRedriveFailedEvents republishes the selected failed events now.
+func (s *APIServer) handleRedriveFailedEvents(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		EventIds []string `json:"eventIds"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+	if len(req.EventIds) == 0 {
+		WriteError(w, statusError(http.StatusBadRequest, "eventIds is required"))
+		return
+	}
+
+	res, err := s.pipelineSvc.AdminRedriveFailedEvents(r.Context(), &pipelinepb.AdminRedriveFailedEventsRequest{
+		UserId:   chi.URLParam(r, "id"),
+		EventIds: req.EventIds,
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
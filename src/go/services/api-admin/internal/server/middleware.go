@@ -66,6 +66,14 @@ func AdminMiddleware(authClient *auth.Client) func(http.Handler) http.Handler {
 	}
 }
 
+// adminUserID returns the signed-in admin's UID, or "" outside AdminMiddleware.
+func adminUserID(r *http.Request) string {
+	if token, ok := r.Context().Value(userContextKey).(*auth.Token); ok {
+		return token.UID
+	}
+	return ""
+}
+
 // LoggerMiddleware sets up structured logging on endpoints
 func LoggerMiddleware(logger infra.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
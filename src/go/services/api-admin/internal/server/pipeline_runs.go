@@ -1,10 +1,14 @@
 package server
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
 
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+	"github.com/go-chi/chi/v5"
 )
 
 func (s *APIServer) handleAdminPipelineRuns(w http.ResponseWriter, r *http.Request) {
@@ -21,11 +25,77 @@ func (s *APIServer) handleAdminPipelineRuns(w http.ResponseWriter, r *http.Reque
 	}
 
 	res, err := s.pipelineSvc.AdminListPipelineRuns(r.Context(), &pipelinepb.AdminListPipelineRunsRequest{
-		Status:    status,
-		Source:    source,
-		UserId:    userID,
-		Limit:     limit,
-		PageToken: pageToken,
+		Status:     status,
+		Source:     source,
+		UserId:     userID,
+		Limit:      limit,
+		PageToken:  pageToken,
+		ErrorsOnly: r.URL.Query().Get("errors_only") == "true",
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+// handleRetryPipelineRun re-runs a user's pipeline run from its original
+// payload. The body is optional and may limit the retry to some enrichers.
+func (s *APIServer) handleRetryPipelineRun(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Enrichers []string `json:"enrichers"`
+	}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+			return
+		}
+	}
+
+	_, err := s.pipelineSvc.RetryPipelineRun(r.Context(), &pipelinepb.RetryPipelineRunRequest{
+		UserId:        chi.URLParam(r, "id"),
+		PipelineRunId: chi.URLParam(r, "runId"),
+		Enrichers:     req.Enrichers,
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleForceCompletePipelineRun finishes a stuck PENDING or RUNNING run
+// without running it further. The reason is required and shown on the run.
+func (s *APIServer) handleForceCompletePipelineRun(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Status string `json:"status"`
+		Reason string `json:"reason"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+	var final pbpipeline.PipelineRunStatus
+	if req.Status != "" {
+		v, ok := pbpipeline.PipelineRunStatus_value[req.Status]
+		if !ok {
+			v, ok = pbpipeline.PipelineRunStatus_value["PIPELINE_RUN_STATUS_"+req.Status]
+		}
+		if !ok {
+			WriteError(w, statusError(http.StatusBadRequest, fmt.Sprintf("unknown status: %s", req.Status)))
+			return
+		}
+		final = pbpipeline.PipelineRunStatus(v)
+	}
+
+	res, err := s.pipelineSvc.AdminForceCompletePipelineRun(r.Context(), &pipelinepb.AdminForceCompletePipelineRunRequest{
+		UserId:        chi.URLParam(r, "id"),
+		PipelineRunId: chi.URLParam(r, "runId"),
+		Status:        final,
+		Reason:        req.Reason,
+		AdminUserId:   adminUserID(r),
 	})
 	if err != nil {
 		WriteError(w, err)
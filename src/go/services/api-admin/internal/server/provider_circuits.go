@@ -0,0 +1,95 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+)
+
+func (s *APIServer) handleListProviderCircuits(w http.ResponseWriter, r *http.Request) {
+	res, err := s.pipelineSvc.AdminListProviderCircuits(r.Context(), &pipelinepb.AdminListProviderCircuitsRequest{})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+func (s *APIServer) handleSetProviderCircuitMode(w http.ResponseWriter, r *http.Request) {
+	providerType, ok := providerTypeParam(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Mode   string `json:"mode"`
+		Reason string `json:"reason"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+	mode, ok := pbpipeline.ProviderCircuitMode_value[req.Mode]
+	if !ok {
+		WriteError(w, statusError(http.StatusBadRequest, fmt.Sprintf("unknown mode: %s", req.Mode)))
+		return
+	}
+
+	res, err := s.pipelineSvc.AdminSetProviderCircuitMode(r.Context(), &pipelinepb.AdminSetProviderCircuitModeRequest{
+		ProviderType: providerType,
+		Mode:         pbpipeline.ProviderCircuitMode(mode),
+		Reason:       req.Reason,
+		AdminUserId:  adminUserID(r),
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+func (s *APIServer) handleListProviderCircuitChanges(w http.ResponseWriter, r *http.Request) {
+	providerType, ok := providerTypeParam(w, r)
+	if !ok {
+		return
+	}
+
+	var limit int32
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 && parsed <= 200 {
+			limit = int32(parsed)
+		}
+	}
+
+	res, err := s.pipelineSvc.AdminListProviderCircuitChanges(r.Context(), &pipelinepb.AdminListProviderCircuitChangesRequest{
+		ProviderType: providerType,
+		Limit:        limit,
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+// providerTypeParam reads the {providerType} URL param, which is the enum
+// name (e.g. ENRICHER_PROVIDER_WEATHER) as stored in provider_circuits.
+func providerTypeParam(w http.ResponseWriter, r *http.Request) (pbplugin.EnricherProviderType, bool) {
+	name := chi.URLParam(r, "providerType")
+	v, ok := pbplugin.EnricherProviderType_value[name]
+	if !ok || v == 0 {
+		WriteError(w, statusError(http.StatusBadRequest, fmt.Sprintf("unknown provider type: %s", name)))
+		return 0, false
+	}
+	return pbplugin.EnricherProviderType(v), true
+}
@@ -70,6 +70,7 @@ func TestRouteCoverage(t *testing.T) {
 		&adminNopPipelineClient{},
 		nil, // activitySvc — only need router structure
 		nil, // firestoreClient — only need router structure
+		nil, // publisher — only need router structure
 	)
 
 	registeredRoutes := make(map[string]bool)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"cloud.google.com/go/firestore"
 	"firebase.google.com/go/v4/auth"
@@ -12,6 +13,8 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 
 	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/internal/userdeletion"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
 	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
 	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
@@ -26,6 +29,7 @@ type APIServer struct {
 	pipelineSvc     pipelinepb.PipelineServiceClient
 	activitySvc     activitypb.ActivityServiceClient
 	firestoreClient *firestore.Client
+	publisher       userdeletion.Publisher
 }
 
 // NewAPIServer constructs the application routing and API middleware stack
@@ -36,6 +40,7 @@ func NewAPIServer(
 	pipelineSvc pipelinepb.PipelineServiceClient,
 	activitySvc activitypb.ActivityServiceClient,
 	fsClient *firestore.Client,
+	publisher userdeletion.Publisher,
 ) *APIServer {
 	s := &APIServer{
 		router:          chi.NewRouter(),
@@ -45,6 +50,7 @@ func NewAPIServer(
 		pipelineSvc:     pipelineSvc,
 		activitySvc:     activitySvc,
 		firestoreClient: fsClient,
+		publisher:       publisher,
 	}
 
 	s.setupRoutes()
@@ -79,6 +85,7 @@ func (s *APIServer) registerAdminRoutes(r chi.Router) {
 	r.Get("/stats", s.handleGetStats)
 
 	r.Get("/users", s.handleListUsers)
+	r.Get("/users/search", s.handleSearchUsers)
 	r.Get("/users/{id}", s.handleGetUser)
 	r.Put("/users/{id}", s.handleUpdateUser)
 	r.Delete("/users/{id}", s.handleDeleteUser)
@@ -86,6 +93,14 @@ func (s *APIServer) registerAdminRoutes(r chi.Router) {
 
 	r.Get("/pipelines", s.handleListAllPipelines)
 	r.Get("/pipeline-runs", s.handleAdminPipelineRuns)
+	r.Post("/users/{id}/pipeline-runs/{runId}/retry", s.handleRetryPipelineRun)
+	r.Post("/users/{id}/pipeline-runs/{runId}/force-complete", s.handleForceCompletePipelineRun)
+	r.Get("/users/{id}/failed-events", s.handleListFailedEvents)
+	r.Post("/users/{id}/failed-events/redrive", s.handleRedriveFailedEvents)
+
+	r.Get("/provider-circuits", s.handleListProviderCircuits)
+	r.Put("/provider-circuits/{providerType}/mode", s.handleSetProviderCircuitMode)
+	r.Get("/provider-circuits/{providerType}/history", s.handleListProviderCircuitChanges)
 }
 
 func (s *APIServer) handleListUsers(w http.ResponseWriter, r *http.Request) {
@@ -110,6 +125,32 @@ func (s *APIServer) handleListUsers(w http.ResponseWriter, r *http.Request) {
 	WriteJSON(w, res)
 }
 
+// handleSearchUsers finds users by sign-in email or by their athlete ID on a
+// connected platform (Strava unless provider says otherwise).
+func (s *APIServer) handleSearchUsers(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	req := &userpb.SearchUsersRequest{Email: q.Get("email")}
+	if athleteID := q.Get("athlete_id"); athleteID != "" {
+		req.Provider = q.Get("provider")
+		if req.Provider == "" {
+			req.Provider = "strava"
+		}
+		req.ProviderUid = athleteID
+	}
+	if req.Email == "" && req.ProviderUid == "" {
+		WriteError(w, statusError(http.StatusBadRequest, "email or athlete_id query parameter is required"))
+		return
+	}
+
+	res, err := s.userService.SearchUsers(r.Context(), req)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
 func (s *APIServer) handleGetUser(w http.ResponseWriter, r *http.Request) {
 	userID := chi.URLParam(r, "id")
 	if userID == "" {
@@ -136,12 +177,26 @@ func (s *APIServer) handleUpdateUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req struct {
-		AccessEnabled *bool `json:"accessEnabled"`
+		AccessEnabled *bool   `json:"accessEnabled"`
+		Tier          *string `json:"tier"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
 		return
 	}
+	var tier pbuser.UserTier
+	if req.Tier != nil {
+		name := strings.ToUpper(*req.Tier)
+		if !strings.HasPrefix(name, "USER_TIER_") {
+			name = "USER_TIER_" + name
+		}
+		v, ok := pbuser.UserTier_value[name]
+		if !ok || v == 0 {
+			WriteError(w, statusError(http.StatusBadRequest, fmt.Sprintf("unknown tier: %s", *req.Tier)))
+			return
+		}
+		tier = pbuser.UserTier(v)
+	}
 
 	profile, err := s.userService.GetProfile(r.Context(), &userpb.GetProfileRequest{UserId: userID})
 	if err != nil {
@@ -152,6 +207,9 @@ func (s *APIServer) handleUpdateUser(w http.ResponseWriter, r *http.Request) {
 	if req.AccessEnabled != nil {
 		profile.AccessEnabled = *req.AccessEnabled
 	}
+	if req.Tier != nil {
+		profile.Tier = tier
+	}
 
 	res, err := s.userService.UpdateProfile(r.Context(), &userpb.UpdateProfileRequest{
 		UserId:  userID,
@@ -161,6 +219,7 @@ func (s *APIServer) handleUpdateUser(w http.ResponseWriter, r *http.Request) {
 		WriteError(w, err)
 		return
 	}
+	s.logger.Info(r.Context(), "admin updated user", "userId", userID, "accessEnabled", profile.AccessEnabled, "tier", profile.Tier.String(), "adminUserId", adminUserID(r))
 
 	WriteJSON(w, res)
 }
@@ -172,15 +231,18 @@ func (s *APIServer) handleDeleteUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err := s.userService.DeleteUser(r.Context(), &userpb.DeleteUserRequest{
-		UserId: userID,
-	})
-	if err != nil {
-		WriteError(w, err)
+	requestedBy := "admin"
+	if uid := adminUserID(r); uid != "" {
+		requestedBy = "admin:" + uid
+	}
+
+	if err := userdeletion.RequestDeletion(r.Context(), s.publisher, userID, requestedBy); err != nil {
+		s.logger.Error(r.Context(), "failed to queue user deletion", "userId", userID, "error", err)
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to start user deletion"))
 		return
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	w.WriteHeader(http.StatusAccepted)
 }
 
 func (s *APIServer) handleListAllPipelines(w http.ResponseWriter, r *http.Request) {
@@ -8,16 +8,22 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"firebase.google.com/go/v4/auth"
+	"github.com/cloudevents/sdk-go/v2/event"
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/fitglue/server/src/go/internal/infra"
+	shared "github.com/fitglue/server/src/go/pkg"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
 	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
@@ -33,7 +39,8 @@ type adminMockUserClient struct {
 	getErr     error
 	updateResp *pbuser.UserProfile
 	updateErr  error
-	deleteErr  error
+	lastSearch *userpb.SearchUsersRequest
+	lastUpdate *userpb.UpdateProfileRequest
 }
 
 func (m *adminMockUserClient) CreateUser(_ context.Context, _ *userpb.CreateUserRequest, _ ...grpc.CallOption) (*pbuser.UserProfile, error) {
@@ -48,6 +55,10 @@ func (m *adminMockUserClient) GetProfile(_ context.Context, _ *userpb.GetProfile
 	}
 	return &pbuser.UserProfile{}, nil
 }
+func (m *adminMockUserClient) SearchUsers(_ context.Context, in *userpb.SearchUsersRequest, _ ...grpc.CallOption) (*userpb.SearchUsersResponse, error) {
+	m.lastSearch = in
+	return &userpb.SearchUsersResponse{Users: []*pbuser.UserProfile{{UserId: "u1"}}}, nil
+}
 func (m *adminMockUserClient) ListUsers(_ context.Context, _ *userpb.ListUsersRequest, _ ...grpc.CallOption) (*userpb.ListUsersResponse, error) {
 	if m.listErr != nil {
 		return nil, m.listErr
@@ -57,7 +68,8 @@ func (m *adminMockUserClient) ListUsers(_ context.Context, _ *userpb.ListUsersRe
 	}
 	return &userpb.ListUsersResponse{}, nil
 }
-func (m *adminMockUserClient) UpdateProfile(_ context.Context, _ *userpb.UpdateProfileRequest, _ ...grpc.CallOption) (*pbuser.UserProfile, error) {
+func (m *adminMockUserClient) UpdateProfile(_ context.Context, in *userpb.UpdateProfileRequest, _ ...grpc.CallOption) (*pbuser.UserProfile, error) {
+	m.lastUpdate = in
 	if m.updateErr != nil {
 		return nil, m.updateErr
 	}
@@ -69,6 +81,9 @@ func (m *adminMockUserClient) UpdateProfile(_ context.Context, _ *userpb.UpdateP
 func (m *adminMockUserClient) GetIntegration(_ context.Context, _ *userpb.GetIntegrationRequest, _ ...grpc.CallOption) (*userpb.GetIntegrationResponse, error) {
 	return &userpb.GetIntegrationResponse{}, nil
 }
+func (m *adminMockUserClient) GetIntegrationSecret(_ context.Context, _ *userpb.GetIntegrationSecretRequest, _ ...grpc.CallOption) (*userpb.GetIntegrationSecretResponse, error) {
+	return &userpb.GetIntegrationSecretResponse{}, nil
+}
 func (m *adminMockUserClient) SetIntegration(_ context.Context, _ *userpb.SetIntegrationRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
 	return &emptypb.Empty{}, nil
 }
@@ -100,9 +115,6 @@ func (m *adminMockUserClient) DeleteBoosterData(_ context.Context, _ *userpb.Del
 	return &emptypb.Empty{}, nil
 }
 func (m *adminMockUserClient) DeleteUser(_ context.Context, _ *userpb.DeleteUserRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
-	if m.deleteErr != nil {
-		return nil, m.deleteErr
-	}
 	return &emptypb.Empty{}, nil
 }
 func (m *adminMockUserClient) SendVerificationEmail(_ context.Context, _ *userpb.SendVerificationEmailRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
@@ -135,6 +147,24 @@ func (m *adminMockUserClient) SetPersonalRecord(_ context.Context, _ *userpb.Set
 func (m *adminMockUserClient) DeletePersonalRecord(_ context.Context, _ *userpb.DeletePersonalRecordRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
 	return &emptypb.Empty{}, nil
 }
+func (m *adminMockUserClient) ListGear(_ context.Context, _ *userpb.ListGearRequest, _ ...grpc.CallOption) (*userpb.ListGearResponse, error) {
+	return &userpb.ListGearResponse{}, nil
+}
+func (m *adminMockUserClient) SetGear(_ context.Context, _ *userpb.SetGearRequest, _ ...grpc.CallOption) (*pbuser.Gear, error) {
+	return &pbuser.Gear{}, nil
+}
+func (m *adminMockUserClient) DeleteGear(_ context.Context, _ *userpb.DeleteGearRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+func (m *adminMockUserClient) ListGoals(_ context.Context, _ *userpb.ListGoalsRequest, _ ...grpc.CallOption) (*userpb.ListGoalsResponse, error) {
+	return &userpb.ListGoalsResponse{}, nil
+}
+func (m *adminMockUserClient) SetGoal(_ context.Context, _ *userpb.SetGoalRequest, _ ...grpc.CallOption) (*pbuser.Goal, error) {
+	return &pbuser.Goal{}, nil
+}
+func (m *adminMockUserClient) DeleteGoal(_ context.Context, _ *userpb.DeleteGoalRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
 func (m *adminMockUserClient) ListPluginDefaults(_ context.Context, _ *userpb.ListPluginDefaultsRequest, _ ...grpc.CallOption) (*userpb.ListPluginDefaultsResponse, error) {
 	return &userpb.ListPluginDefaultsResponse{}, nil
 }
@@ -150,7 +180,13 @@ func (m *adminMockUserClient) SetFCMToken(_ context.Context, _ *userpb.SetFCMTok
 
 // ---- Mock: PipelineServiceClient (nop) ----
 
-type adminNopPipelineClient struct{}
+type adminNopPipelineClient struct {
+	lastCircuitMode *pipelinepb.AdminSetProviderCircuitModeRequest
+	lastRetry       *pipelinepb.RetryPipelineRunRequest
+	lastRedrive     *pipelinepb.AdminRedriveFailedEventsRequest
+	lastRunsList    *pipelinepb.AdminListPipelineRunsRequest
+	lastForce       *pipelinepb.AdminForceCompletePipelineRunRequest
+}
 
 func (m *adminNopPipelineClient) ListPipelines(_ context.Context, _ *pipelinepb.ListPipelinesRequest, _ ...grpc.CallOption) (*pipelinepb.ListPipelinesResponse, error) {
 	return &pipelinepb.ListPipelinesResponse{}, nil
@@ -167,6 +203,9 @@ func (m *adminNopPipelineClient) UpdatePipeline(_ context.Context, _ *pipelinepb
 func (m *adminNopPipelineClient) DeletePipeline(_ context.Context, _ *pipelinepb.DeletePipelineRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
 	return &emptypb.Empty{}, nil
 }
+func (m *adminNopPipelineClient) ProvisionStarterPipeline(_ context.Context, _ *pipelinepb.ProvisionStarterPipelineRequest, _ ...grpc.CallOption) (*pipelinepb.ProvisionStarterPipelineResponse, error) {
+	return &pipelinepb.ProvisionStarterPipelineResponse{}, nil
+}
 func (m *adminNopPipelineClient) SubmitInput(_ context.Context, _ *pipelinepb.SubmitInputRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
 	return &emptypb.Empty{}, nil
 }
@@ -179,15 +218,77 @@ func (m *adminNopPipelineClient) ResolvePendingInput(_ context.Context, _ *pipel
 func (m *adminNopPipelineClient) RepostActivity(_ context.Context, _ *pipelinepb.RepostActivityRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
 	return &emptypb.Empty{}, nil
 }
+func (m *adminNopPipelineClient) RetryPipelineRun(_ context.Context, in *pipelinepb.RetryPipelineRunRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.lastRetry = in
+	return &emptypb.Empty{}, nil
+}
 func (m *adminNopPipelineClient) GetPipelineRun(_ context.Context, _ *pipelinepb.GetPipelineRunRequest, _ ...grpc.CallOption) (*pbpipeline.PipelineRun, error) {
 	return nil, nil
 }
+func (m *adminNopPipelineClient) PreviewPipeline(_ context.Context, _ *pipelinepb.PreviewPipelineRequest, _ ...grpc.CallOption) (*pbpipeline.PipelinePreview, error) {
+	return nil, nil
+}
+func (m *adminNopPipelineClient) PreviewDescriptionMerge(_ context.Context, _ *pipelinepb.PreviewDescriptionMergeRequest, _ ...grpc.CallOption) (*pbpipeline.DescriptionMergePreview, error) {
+	return nil, nil
+}
+func (m *adminNopPipelineClient) GetPipelineRunDebugBundle(_ context.Context, _ *pipelinepb.GetPipelineRunDebugBundleRequest, _ ...grpc.CallOption) (*pbpipeline.PipelineRunDebugBundle, error) {
+	return nil, nil
+}
+func (m *adminNopPipelineClient) GetPipelineCalendar(_ context.Context, _ *pipelinepb.GetPipelineCalendarRequest, _ ...grpc.CallOption) (*pipelinepb.GetPipelineCalendarResponse, error) {
+	return &pipelinepb.GetPipelineCalendarResponse{}, nil
+}
+func (m *adminNopPipelineClient) PausePipelines(_ context.Context, _ *pipelinepb.PausePipelinesRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+func (m *adminNopPipelineClient) ResumePipelines(_ context.Context, _ *pipelinepb.ResumePipelinesRequest, _ ...grpc.CallOption) (*pipelinepb.ResumePipelinesResponse, error) {
+	return &pipelinepb.ResumePipelinesResponse{}, nil
+}
+func (m *adminNopPipelineClient) CorrectActivityType(_ context.Context, _ *pipelinepb.CorrectActivityTypeRequest, _ ...grpc.CallOption) (*pipelinepb.CorrectActivityTypeResponse, error) {
+	return &pipelinepb.CorrectActivityTypeResponse{}, nil
+}
+func (m *adminNopPipelineClient) ListActivityTypeRules(_ context.Context, _ *pipelinepb.ListActivityTypeRulesRequest, _ ...grpc.CallOption) (*pipelinepb.ListActivityTypeRulesResponse, error) {
+	return &pipelinepb.ListActivityTypeRulesResponse{}, nil
+}
+func (m *adminNopPipelineClient) UpdateActivityTypeRule(_ context.Context, _ *pipelinepb.UpdateActivityTypeRuleRequest, _ ...grpc.CallOption) (*pbpipeline.ActivityTypeRule, error) {
+	return nil, nil
+}
+func (m *adminNopPipelineClient) DeleteActivityTypeRule(_ context.Context, _ *pipelinepb.DeleteActivityTypeRuleRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+func (m *adminNopPipelineClient) GetEnricherUsage(_ context.Context, _ *pipelinepb.GetEnricherUsageRequest, _ ...grpc.CallOption) (*pipelinepb.GetEnricherUsageResponse, error) {
+	return &pipelinepb.GetEnricherUsageResponse{}, nil
+}
+func (m *adminNopPipelineClient) GetEnricherRecommendations(_ context.Context, _ *pipelinepb.GetEnricherRecommendationsRequest, _ ...grpc.CallOption) (*pbpipeline.EnricherRecommendations, error) {
+	return &pbpipeline.EnricherRecommendations{}, nil
+}
 func (m *adminNopPipelineClient) ListPipelineRuns(_ context.Context, _ *pipelinepb.ListPipelineRunsRequest, _ ...grpc.CallOption) (*pipelinepb.ListPipelineRunsResponse, error) {
 	return &pipelinepb.ListPipelineRunsResponse{}, nil
 }
-func (m *adminNopPipelineClient) AdminListPipelineRuns(_ context.Context, _ *pipelinepb.AdminListPipelineRunsRequest, _ ...grpc.CallOption) (*pipelinepb.AdminListPipelineRunsResponse, error) {
+func (m *adminNopPipelineClient) AdminListPipelineRuns(_ context.Context, in *pipelinepb.AdminListPipelineRunsRequest, _ ...grpc.CallOption) (*pipelinepb.AdminListPipelineRunsResponse, error) {
+	m.lastRunsList = in
 	return &pipelinepb.AdminListPipelineRunsResponse{}, nil
 }
+func (m *adminNopPipelineClient) AdminForceCompletePipelineRun(_ context.Context, in *pipelinepb.AdminForceCompletePipelineRunRequest, _ ...grpc.CallOption) (*pbpipeline.PipelineRun, error) {
+	m.lastForce = in
+	return &pbpipeline.PipelineRun{Id: in.PipelineRunId, Status: in.Status}, nil
+}
+func (m *adminNopPipelineClient) AdminListProviderCircuits(_ context.Context, _ *pipelinepb.AdminListProviderCircuitsRequest, _ ...grpc.CallOption) (*pipelinepb.AdminListProviderCircuitsResponse, error) {
+	return &pipelinepb.AdminListProviderCircuitsResponse{}, nil
+}
+func (m *adminNopPipelineClient) AdminSetProviderCircuitMode(_ context.Context, in *pipelinepb.AdminSetProviderCircuitModeRequest, _ ...grpc.CallOption) (*pbpipeline.ProviderCircuit, error) {
+	m.lastCircuitMode = in
+	return &pbpipeline.ProviderCircuit{ProviderType: in.ProviderType, Mode: in.Mode}, nil
+}
+func (m *adminNopPipelineClient) AdminListProviderCircuitChanges(_ context.Context, _ *pipelinepb.AdminListProviderCircuitChangesRequest, _ ...grpc.CallOption) (*pipelinepb.AdminListProviderCircuitChangesResponse, error) {
+	return &pipelinepb.AdminListProviderCircuitChangesResponse{}, nil
+}
+func (m *adminNopPipelineClient) AdminListFailedEvents(_ context.Context, _ *pipelinepb.AdminListFailedEventsRequest, _ ...grpc.CallOption) (*pipelinepb.AdminListFailedEventsResponse, error) {
+	return &pipelinepb.AdminListFailedEventsResponse{}, nil
+}
+func (m *adminNopPipelineClient) AdminRedriveFailedEvents(_ context.Context, in *pipelinepb.AdminRedriveFailedEventsRequest, _ ...grpc.CallOption) (*pipelinepb.AdminRedriveFailedEventsResponse, error) {
+	m.lastRedrive = in
+	return &pipelinepb.AdminRedriveFailedEventsResponse{}, nil
+}
 
 // ---- Helpers ----
 
@@ -328,16 +429,38 @@ func TestAdminHandleGetUser_NotFound(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, w.Code)
 }
 
+type adminMockPublisher struct {
+	topics []string
+	events []event.Event
+	err    error
+}
+
+func (m *adminMockPublisher) PublishCloudEvent(_ context.Context, topic string, e event.Event) (string, error) {
+	m.topics = append(m.topics, topic)
+	m.events = append(m.events, e)
+	return "msg-id", m.err
+}
+
 func TestAdminHandleDeleteUser_Success(t *testing.T) {
 	svc := newAdminTestServer(&adminMockUserClient{})
+	pub := &adminMockPublisher{}
+	svc.publisher = pub
 	req := withAdminChiParam(httptest.NewRequest(http.MethodDelete, "/", nil), "id", "u1")
+	req = req.WithContext(context.WithValue(req.Context(), userContextKey, &auth.Token{UID: "admin1"}))
 	w := httptest.NewRecorder()
 	svc.handleDeleteUser(w, req)
-	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, http.StatusAccepted, w.Code)
+
+	require.Equal(t, []string{shared.TopicUserDeletionRequested}, pub.topics)
+	var evt pbevents.UserDeletionRequestedEvent
+	require.NoError(t, protojson.Unmarshal(pub.events[0].Data(), &evt))
+	assert.Equal(t, "u1", evt.UserId)
+	assert.Equal(t, "admin:admin1", evt.RequestedBy)
 }
 
 func TestAdminHandleDeleteUser_Error(t *testing.T) {
-	svc := newAdminTestServer(&adminMockUserClient{deleteErr: status.Error(codes.Internal, "fail")})
+	svc := newAdminTestServer(&adminMockUserClient{})
+	svc.publisher = &adminMockPublisher{err: status.Error(codes.Internal, "fail")}
 	req := withAdminChiParam(httptest.NewRequest(http.MethodDelete, "/", nil), "id", "u1")
 	w := httptest.NewRecorder()
 	svc.handleDeleteUser(w, req)
@@ -366,6 +489,94 @@ func TestAdminHandleUpdateUser_Success(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 }
 
+func TestAdminHandleUpdateUser_Tier(t *testing.T) {
+	client := &adminMockUserClient{getResp: &pbuser.UserProfile{UserId: "u1", AccessEnabled: true}}
+	svc := newAdminTestServer(client)
+	req := withAdminChiParam(
+		httptest.NewRequest(http.MethodPut, "/", bytes.NewBufferString(`{"tier":"athlete"}`)),
+		"id", "u1",
+	)
+	w := httptest.NewRecorder()
+	svc.handleUpdateUser(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, client.lastUpdate)
+	assert.Equal(t, pbuser.UserTier_USER_TIER_ATHLETE, client.lastUpdate.Profile.Tier)
+	assert.True(t, client.lastUpdate.Profile.AccessEnabled, "fields not in the body are kept")
+
+	client.lastUpdate = nil
+	req = withAdminChiParam(
+		httptest.NewRequest(http.MethodPut, "/", bytes.NewBufferString(`{"tier":"platinum"}`)),
+		"id", "u1",
+	)
+	w = httptest.NewRecorder()
+	svc.handleUpdateUser(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Nil(t, client.lastUpdate)
+}
+
+func TestAdminHandleSearchUsers(t *testing.T) {
+	client := &adminMockUserClient{}
+	svc := newAdminTestServer(client)
+
+	w := httptest.NewRecorder()
+	svc.handleSearchUsers(w, httptest.NewRequest(http.MethodGet, "/api/admin/users/search", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	svc.handleSearchUsers(w, httptest.NewRequest(http.MethodGet, "/api/admin/users/search?athlete_id=12345", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, client.lastSearch)
+	assert.Equal(t, "strava", client.lastSearch.Provider)
+	assert.Equal(t, "12345", client.lastSearch.ProviderUid)
+
+	w = httptest.NewRecorder()
+	svc.handleSearchUsers(w, httptest.NewRequest(http.MethodGet, "/api/admin/users/search?email=sam%40example.com", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "sam@example.com", client.lastSearch.Email)
+	assert.Empty(t, client.lastSearch.Provider)
+}
+
+func TestAdminHandlePipelineRuns_ErrorsOnly(t *testing.T) {
+	pipelineClient := &adminNopPipelineClient{}
+	svc := &APIServer{logger: infra.NewLogger(), pipelineSvc: pipelineClient}
+
+	w := httptest.NewRecorder()
+	svc.handleAdminPipelineRuns(w, httptest.NewRequest(http.MethodGet, "/api/admin/pipeline-runs?errors_only=true&limit=20", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, pipelineClient.lastRunsList)
+	assert.True(t, pipelineClient.lastRunsList.ErrorsOnly)
+	assert.Equal(t, int32(20), pipelineClient.lastRunsList.Limit)
+}
+
+func TestAdminHandleForceCompletePipelineRun(t *testing.T) {
+	pipelineClient := &adminNopPipelineClient{}
+	svc := &APIServer{logger: infra.NewLogger(), pipelineSvc: pipelineClient}
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", "u1")
+	rctx.URLParams.Add("runId", "r1")
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"status":"SKIPPED","reason":"duplicate upload"}`))
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	req = req.WithContext(context.WithValue(req.Context(), userContextKey, &auth.Token{UID: "admin-1"}))
+	w := httptest.NewRecorder()
+	svc.handleForceCompletePipelineRun(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, pipelineClient.lastForce)
+	assert.Equal(t, "u1", pipelineClient.lastForce.UserId)
+	assert.Equal(t, "r1", pipelineClient.lastForce.PipelineRunId)
+	assert.Equal(t, pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_SKIPPED, pipelineClient.lastForce.Status)
+	assert.Equal(t, "duplicate upload", pipelineClient.lastForce.Reason)
+	assert.Equal(t, "admin-1", pipelineClient.lastForce.AdminUserId)
+
+	pipelineClient.lastForce = nil
+	req = withAdminChiParam(httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"status":"DONE"}`)), "id", "u1")
+	w = httptest.NewRecorder()
+	svc.handleForceCompletePipelineRun(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Nil(t, pipelineClient.lastForce)
+}
+
 func TestAdminHandleListAllPipelines_MissingUserID(t *testing.T) {
 	svc := newAdminTestServer(&adminMockUserClient{})
 	req := httptest.NewRequest(http.MethodGet, "/api/admin/pipelines", nil)
@@ -381,3 +592,93 @@ func TestAdminHandleListAllPipelines_Success(t *testing.T) {
 	svc.handleListAllPipelines(w, req)
 	assert.Equal(t, http.StatusOK, w.Code)
 }
+
+func TestAdminHandleSetProviderCircuitMode(t *testing.T) {
+	pipelineClient := &adminNopPipelineClient{}
+	svc := &APIServer{logger: infra.NewLogger(), pipelineSvc: pipelineClient}
+
+	body := bytes.NewBufferString(`{"mode":"PROVIDER_CIRCUIT_MODE_DISABLED","reason":"Met Office outage"}`)
+	req := withAdminChiParam(httptest.NewRequest(http.MethodPut, "/", body), "providerType", "ENRICHER_PROVIDER_WEATHER")
+	req = req.WithContext(context.WithValue(req.Context(), userContextKey, &auth.Token{UID: "admin-1"}))
+	w := httptest.NewRecorder()
+	svc.handleSetProviderCircuitMode(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, pipelineClient.lastCircuitMode)
+	assert.Equal(t, pbplugin.EnricherProviderType_ENRICHER_PROVIDER_WEATHER, pipelineClient.lastCircuitMode.ProviderType)
+	assert.Equal(t, pbpipeline.ProviderCircuitMode_PROVIDER_CIRCUIT_MODE_DISABLED, pipelineClient.lastCircuitMode.Mode)
+	assert.Equal(t, "Met Office outage", pipelineClient.lastCircuitMode.Reason)
+	assert.Equal(t, "admin-1", pipelineClient.lastCircuitMode.AdminUserId)
+}
+
+func TestAdminHandleRetryPipelineRun(t *testing.T) {
+	pipelineClient := &adminNopPipelineClient{}
+	svc := &APIServer{logger: infra.NewLogger(), pipelineSvc: pipelineClient}
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", "u1")
+	rctx.URLParams.Add("runId", "r1")
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"enrichers":["weather"]}`))
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	w := httptest.NewRecorder()
+	svc.handleRetryPipelineRun(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	require.NotNil(t, pipelineClient.lastRetry)
+	assert.Equal(t, "u1", pipelineClient.lastRetry.UserId)
+	assert.Equal(t, "r1", pipelineClient.lastRetry.PipelineRunId)
+	assert.Equal(t, []string{"weather"}, pipelineClient.lastRetry.Enrichers)
+}
+
+func TestAdminHandleRedriveFailedEvents(t *testing.T) {
+	pipelineClient := &adminNopPipelineClient{}
+	svc := &APIServer{logger: infra.NewLogger(), pipelineSvc: pipelineClient}
+
+	req := withAdminChiParam(httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"eventIds":["e1","e2"]}`)), "id", "u1")
+	w := httptest.NewRecorder()
+	svc.handleRedriveFailedEvents(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, pipelineClient.lastRedrive)
+	assert.Equal(t, "u1", pipelineClient.lastRedrive.UserId)
+	assert.Equal(t, []string{"e1", "e2"}, pipelineClient.lastRedrive.EventIds)
+
+	pipelineClient.lastRedrive = nil
+	req = withAdminChiParam(httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"eventIds":[]}`)), "id", "u1")
+	w = httptest.NewRecorder()
+	svc.handleRedriveFailedEvents(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Nil(t, pipelineClient.lastRedrive)
+}
+
+func TestAdminHandleListFailedEvents_UnknownStatus(t *testing.T) {
+	svc := &APIServer{logger: infra.NewLogger(), pipelineSvc: &adminNopPipelineClient{}}
+	req := withAdminChiParam(httptest.NewRequest(http.MethodGet, "/?status=BROKEN", nil), "id", "u1")
+	w := httptest.NewRecorder()
+	svc.handleListFailedEvents(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAdminHandleSetProviderCircuitMode_BadInput(t *testing.T) {
+	tests := []struct {
+		name         string
+		providerType string
+		body         string
+	}{
+		{"unknown provider", "ENRICHER_PROVIDER_NOPE", `{"mode":"PROVIDER_CIRCUIT_MODE_DISABLED"}`},
+		{"unspecified provider", "ENRICHER_PROVIDER_UNSPECIFIED", `{"mode":"PROVIDER_CIRCUIT_MODE_DISABLED"}`},
+		{"unknown mode", "ENRICHER_PROVIDER_WEATHER", `{"mode":"OFF"}`},
+		{"bad body", "ENRICHER_PROVIDER_WEATHER", `{`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pipelineClient := &adminNopPipelineClient{}
+			svc := &APIServer{logger: infra.NewLogger(), pipelineSvc: pipelineClient}
+			req := withAdminChiParam(httptest.NewRequest(http.MethodPut, "/", bytes.NewBufferString(tc.body)), "providerType", tc.providerType)
+			w := httptest.NewRecorder()
+			svc.handleSetProviderCircuitMode(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Nil(t, pipelineClient.lastCircuitMode)
+		})
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"os"
 
 	"cloud.google.com/go/firestore"
+	"cloud.google.com/go/pubsub"
 	"github.com/fitglue/server/src/go/internal/infra"
+	infraps "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
 	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
 	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
 	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
@@ -93,6 +95,15 @@ func main() {
 	}
 	defer fsClient.Close()
 
+	// User deletions are queued for the destination service
+	pubsubClient, err := pubsub.NewClient(ctx, projectID)
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize Pub/Sub client", "error", err)
+		os.Exit(1)
+	}
+	defer pubsubClient.Close()
+	publisher := &infraps.PubSubAdapter{Client: pubsubClient, Logger: logger}
+
 	// 4. Initialize the HTTP Gateway Server
 	apiServer := server.NewAPIServer(
 		logger,
@@ -101,6 +112,7 @@ func main() {
 		pipelineClient,
 		activityClient,
 		fsClient,
+		publisher,
 	)
 
 	port := os.Getenv("PORT")
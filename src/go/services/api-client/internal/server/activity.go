@@ -2,11 +2,15 @@ package server
 
 import (
 	"net/http"
+	"net/url"
 	"strconv"
+	"time"
 
+	gatewaypb "github.com/fitglue/server/src/go/pkg/types/pb/gateway"
 	pbactivitym "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
 	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *APIServer) registerActivityRoutes(r chi.Router) {
@@ -26,6 +30,8 @@ func (s *APIServer) registerActivityRoutes(r chi.Router) {
 	r.Post("/users/me/showcases/{id}/generate", s.handleGenerateShowcaseImages)
 
 	r.Post("/users/me/export", s.handleExportData)
+	r.Post("/users/me/export/archive", s.handleExportArchive)
+	r.Post("/users/me/export/data", s.handleExportUserData)
 
 	r.Post("/users/me/parse-fit", s.handleParseFitFile)
 
@@ -36,6 +42,7 @@ func (s *APIServer) registerActivityRoutes(r chi.Router) {
 	r.Post("/users/me/showcase-management/profile/entries/{showcaseId}", s.handleAddShowcaseEntry)
 	r.Delete("/users/me/showcase-management/profile/entries/{showcaseId}", s.handleRemoveShowcaseEntry)
 	r.Post("/users/me/showcase-management/profile/picture", s.handleGetShowcaseProfilePictureUploadUrl)
+	r.Post("/users/me/showcase-management/profile/embed-link", s.handleCreateShowcaseEmbedLink)
 }
 
 func (s *APIServer) handleListActivities(w http.ResponseWriter, r *http.Request) {
@@ -465,3 +472,42 @@ func (s *APIServer) handleGetShowcaseProfilePictureUploadUrl(w http.ResponseWrit
 
 	WriteJSON(w, res)
 }
+
+// handleCreateShowcaseEmbedLink returns signed embed URLs for the user's
+// showcase profile, which keep working while the profile is hidden.
+func (s *APIServer) handleCreateShowcaseEmbedLink(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var reqBody gatewaypb.CreateShowcaseEmbedLinkGatewayRequest
+	if err := decodeProto(r, &reqBody); err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+
+	res, err := s.activitySvc.CreateShowcaseEmbedLink(r.Context(), &activitypb.CreateShowcaseEmbedLinkRequest{
+		UserId:     token.UID,
+		ShowcaseId: reqBody.ShowcaseId,
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	query := url.Values{}
+	if res.ShowcaseId != "" {
+		query.Set("showcase_id", res.ShowcaseId)
+	}
+	query.Set("expires", strconv.FormatInt(res.Expires, 10))
+	query.Set("signature", res.Signature)
+	embedURL := apiURL(r) + "/api/public/showcase/profile/" + url.PathEscape(res.Slug) + "/embed?"
+
+	WriteJSON(w, &gatewaypb.CreateShowcaseEmbedLinkGatewayResponse{
+		SvgUrl:    embedURL + query.Encode(),
+		HtmlUrl:   embedURL + "format=html&" + query.Encode(),
+		ExpiresAt: timestamppb.New(time.Unix(res.Expires, 0)),
+	})
+}
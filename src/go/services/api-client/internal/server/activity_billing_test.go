@@ -138,6 +138,24 @@ func (m *mockActivityServiceClient) GetShowcaseProfilePictureUploadUrl(ctx conte
 func (m *mockActivityServiceClient) GetPublicShowcaseProfile(ctx context.Context, in *activitypb.GetPublicShowcaseProfileRequest, opts ...grpc.CallOption) (*activitypb.GetPublicShowcaseProfileResponse, error) {
 	return &activitypb.GetPublicShowcaseProfileResponse{}, nil
 }
+func (m *mockActivityServiceClient) GetPublicShowcaseRouteStats(ctx context.Context, in *activitypb.GetPublicShowcaseRouteStatsRequest, opts ...grpc.CallOption) (*activitypb.GetPublicShowcaseRouteStatsResponse, error) {
+	return &activitypb.GetPublicShowcaseRouteStatsResponse{}, nil
+}
+func (m *mockActivityServiceClient) GetPublicShowcaseFeed(ctx context.Context, in *activitypb.GetPublicShowcaseFeedRequest, opts ...grpc.CallOption) (*activitypb.GetPublicShowcaseFeedResponse, error) {
+	return &activitypb.GetPublicShowcaseFeedResponse{}, nil
+}
+func (m *mockActivityServiceClient) GetPublicShowcaseComparison(ctx context.Context, in *activitypb.GetPublicShowcaseComparisonRequest, opts ...grpc.CallOption) (*pbactivity.ShowcaseComparison, error) {
+	return &pbactivity.ShowcaseComparison{}, nil
+}
+func (m *mockActivityServiceClient) RefreshShowcaseComparisons(ctx context.Context, in *activitypb.RefreshShowcaseComparisonsRequest, opts ...grpc.CallOption) (*activitypb.RefreshShowcaseComparisonsResponse, error) {
+	return &activitypb.RefreshShowcaseComparisonsResponse{}, nil
+}
+func (m *mockActivityServiceClient) GetPublicShowcaseEmbed(ctx context.Context, in *activitypb.GetPublicShowcaseEmbedRequest, opts ...grpc.CallOption) (*activitypb.GetPublicShowcaseEmbedResponse, error) {
+	return &activitypb.GetPublicShowcaseEmbedResponse{}, nil
+}
+func (m *mockActivityServiceClient) CreateShowcaseEmbedLink(ctx context.Context, in *activitypb.CreateShowcaseEmbedLinkRequest, opts ...grpc.CallOption) (*activitypb.CreateShowcaseEmbedLinkResponse, error) {
+	return &activitypb.CreateShowcaseEmbedLinkResponse{}, nil
+}
 func (m *mockActivityServiceClient) GetActivityStats(ctx context.Context, in *activitypb.GetActivityStatsRequest, opts ...grpc.CallOption) (*activitypb.GetActivityStatsResponse, error) {
 	return &activitypb.GetActivityStatsResponse{}, nil
 }
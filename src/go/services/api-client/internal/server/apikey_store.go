@@ -5,8 +5,16 @@ import (
 	"time"
 
 	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 )
 
+const apiKeysCollection = "ingress_api_keys"
+
 // FirestoreApiKeyStore implements ApiKeyStore using Cloud Firestore
 type FirestoreApiKeyStore struct {
 	client *firestore.Client
@@ -17,9 +25,31 @@ func NewFirestoreApiKeyStore(client *firestore.Client) *FirestoreApiKeyStore {
 	return &FirestoreApiKeyStore{client: client}
 }
 
+// apiKeyDoc is the stored form of an ApiKeyRecord, keyed by the key's hash
+type apiKeyDoc struct {
+	UserID     string    `firestore:"user_id"`
+	Label      string    `firestore:"label"`
+	Scopes     []string  `firestore:"scopes"`
+	CreatedAt  time.Time `firestore:"created_at"`
+	LastUsedAt time.Time `firestore:"last_used_at"`
+}
+
+func (d *apiKeyDoc) record() *pbuser.ApiKeyRecord {
+	rec := &pbuser.ApiKeyRecord{
+		UserId:    d.UserID,
+		Label:     d.Label,
+		Scopes:    d.Scopes,
+		CreatedAt: timestamppb.New(d.CreatedAt),
+	}
+	if !d.LastUsedAt.IsZero() {
+		rec.LastUsedAt = timestamppb.New(d.LastUsedAt)
+	}
+	return rec
+}
+
 // CreateIngressKey stores a hashed API key record in the ingress_api_keys collection
 func (s *FirestoreApiKeyStore) CreateIngressKey(ctx context.Context, keyHash, userID, label string, scopes []string, createdAt time.Time) error {
-	_, err := s.client.Collection("ingress_api_keys").Doc(keyHash).Set(ctx, map[string]interface{}{
+	_, err := s.client.Collection(apiKeysCollection).Doc(keyHash).Set(ctx, map[string]interface{}{
 		"user_id":    userID,
 		"label":      label,
 		"scopes":     scopes,
@@ -27,3 +57,56 @@ func (s *FirestoreApiKeyStore) CreateIngressKey(ctx context.Context, keyHash, us
 	})
 	return err
 }
+
+// GetKey looks up a key by its hash
+func (s *FirestoreApiKeyStore) GetKey(ctx context.Context, keyHash string) (*pbuser.ApiKeyRecord, error) {
+	snap, err := s.client.Collection(apiKeysCollection).Doc(keyHash).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var doc apiKeyDoc
+	if err := snap.DataTo(&doc); err != nil {
+		return nil, err
+	}
+	return doc.record(), nil
+}
+
+// ListKeys returns every key the user owns, ingress keys included
+func (s *FirestoreApiKeyStore) ListKeys(ctx context.Context, userID string) (map[string]*pbuser.ApiKeyRecord, error) {
+	iter := s.client.Collection(apiKeysCollection).Where("user_id", "==", userID).Documents(ctx)
+	defer iter.Stop()
+
+	keys := map[string]*pbuser.ApiKeyRecord{}
+	for {
+		snap, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var doc apiKeyDoc
+		if err := snap.DataTo(&doc); err != nil {
+			return nil, err
+		}
+		keys[snap.Ref.ID] = doc.record()
+	}
+	return keys, nil
+}
+
+// DeleteKey removes a key; deleting a missing key is not an error
+func (s *FirestoreApiKeyStore) DeleteKey(ctx context.Context, keyHash string) error {
+	_, err := s.client.Collection(apiKeysCollection).Doc(keyHash).Delete(ctx)
+	return err
+}
+
+// TouchKey records when a key was last used
+func (s *FirestoreApiKeyStore) TouchKey(ctx context.Context, keyHash string, usedAt time.Time) error {
+	_, err := s.client.Collection(apiKeysCollection).Doc(keyHash).Update(ctx, []firestore.Update{
+		{Path: "last_used_at", Value: usedAt},
+	})
+	return err
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"firebase.google.com/go/v4/auth"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/pkg/domain/apikey"
+	gatewaypb "github.com/fitglue/server/src/go/pkg/types/pb/gateway"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+const (
+	// maxApiKeysPerUser caps personal keys; ingress keys don't count
+	maxApiKeysPerUser = 10
+
+	maxApiKeyLabelLength = 64
+)
+
+// getKeyManager returns the signed-in user, writing an error unless the
+// request came from a Firebase sign-in. A personal API key can't manage keys,
+// so a leaked one can't mint more or revoke the owner's others.
+func (s *APIServer) getKeyManager(w http.ResponseWriter, r *http.Request) *auth.Token {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return nil
+	}
+	if isApiKeyToken(token) {
+		WriteError(w, statusError(http.StatusForbidden, "API keys can only be managed after signing in"))
+		return nil
+	}
+	return token
+}
+
+func (s *APIServer) handleListApiKeys(w http.ResponseWriter, r *http.Request) {
+	token := s.getKeyManager(w, r)
+	if token == nil {
+		return
+	}
+
+	keys, err := s.listPersonalKeys(r, token.UID)
+	if err != nil {
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to list API keys"))
+		return
+	}
+
+	WriteJSON(w, &gatewaypb.ListApiKeysGatewayResponse{Keys: keys})
+}
+
+// handleCreateApiKey issues a personal API key. The key is only returned
+// here; afterwards the user sees its label and when it was last used.
+func (s *APIServer) handleCreateApiKey(w http.ResponseWriter, r *http.Request) {
+	token := s.getKeyManager(w, r)
+	if token == nil {
+		return
+	}
+
+	var req gatewaypb.CreateApiKeyGatewayRequest
+	if err := decodeProto(r, &req); err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+	label := strings.TrimSpace(req.Label)
+	if label == "" || len(label) > maxApiKeyLabelLength {
+		WriteError(w, statusError(http.StatusBadRequest, fmt.Sprintf("label must be between 1 and %d characters", maxApiKeyLabelLength)))
+		return
+	}
+
+	existing, err := s.listPersonalKeys(r, token.UID)
+	if err != nil {
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to list API keys"))
+		return
+	}
+	if len(existing) >= maxApiKeysPerUser {
+		WriteError(w, statusError(http.StatusConflict, fmt.Sprintf("you already have %d API keys; delete one first", maxApiKeysPerUser)))
+		return
+	}
+
+	rawKey, keyHash, err := apikey.GeneratePersonalKey()
+	if err != nil {
+		s.logger.Error(r.Context(), "failed to generate API key", "error", err)
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to generate API key"))
+		return
+	}
+	now := time.Now()
+	if err := s.apiKeyStore.CreateIngressKey(r.Context(), keyHash, token.UID, label, []string{apikey.ScopeAPI}, now); err != nil {
+		s.logger.Error(r.Context(), "failed to store API key", "error", err)
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to store API key"))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	WriteJSON(w, &gatewaypb.CreateApiKeyGatewayResponse{
+		ApiKey: rawKey,
+		Key: toGatewayApiKey(keyHash, &pbuser.ApiKeyRecord{
+			UserId:    token.UID,
+			Label:     label,
+			Scopes:    []string{apikey.ScopeAPI},
+			CreatedAt: timestamppb.New(now),
+		}),
+	})
+}
+
+func (s *APIServer) handleDeleteApiKey(w http.ResponseWriter, r *http.Request) {
+	token := s.getKeyManager(w, r)
+	if token == nil {
+		return
+	}
+
+	keyHash := chi.URLParam(r, "id")
+	rec, err := s.apiKeyStore.GetKey(r.Context(), keyHash)
+	if err != nil {
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to load API key"))
+		return
+	}
+	// Ingress keys are removed with their integration, not here
+	if rec == nil || rec.UserId != token.UID || !slices.Contains(rec.Scopes, apikey.ScopeAPI) {
+		WriteError(w, statusError(http.StatusNotFound, "API key not found"))
+		return
+	}
+
+	if err := s.apiKeyStore.DeleteKey(r.Context(), keyHash); err != nil {
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to delete API key"))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listPersonalKeys returns the user's API-scoped keys, newest first.
+func (s *APIServer) listPersonalKeys(r *http.Request, userID string) ([]*gatewaypb.ApiKey, error) {
+	records, err := s.apiKeyStore.ListKeys(r.Context(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []*gatewaypb.ApiKey
+	for keyHash, rec := range records {
+		if slices.Contains(rec.Scopes, apikey.ScopeAPI) {
+			keys = append(keys, toGatewayApiKey(keyHash, rec))
+		}
+	}
+	slices.SortFunc(keys, func(a, b *gatewaypb.ApiKey) int {
+		return b.CreatedAt.AsTime().Compare(a.CreatedAt.AsTime())
+	})
+	return keys, nil
+}
+
+func toGatewayApiKey(keyHash string, rec *pbuser.ApiKeyRecord) *gatewaypb.ApiKey {
+	return &gatewaypb.ApiKey{
+		Id:         keyHash,
+		Label:      rec.Label,
+		CreatedAt:  rec.CreatedAt,
+		LastUsedAt: rec.LastUsedAt,
+	}
+}
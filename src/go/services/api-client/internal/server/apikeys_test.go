@@ -0,0 +1,184 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"firebase.google.com/go/v4/auth"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/pkg/domain/apikey"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+)
+
+func withApiKeyToken(r *http.Request, uid string) *http.Request {
+	token := &auth.Token{UID: uid, Claims: map[string]interface{}{apiKeyClaim: true}}
+	return r.WithContext(context.WithValue(r.Context(), userContextKey, token))
+}
+
+func TestAuthMiddleware_ApiKey(t *testing.T) {
+	store := &mockApiKeyStore{}
+	ctx := context.Background()
+	personal, personalHash, _ := apikey.GeneratePersonalKey()
+	store.CreateIngressKey(ctx, personalHash, "user1", "laptop", []string{apikey.ScopeAPI}, time.Now())
+	webhook, webhookHash, _ := apikey.GenerateRandomIngressKey()
+	store.CreateIngressKey(ctx, webhookHash, "user1", "hevy-webhook", []string{"webhook:hevy"}, time.Now())
+
+	var gotUID string
+	var gotApiKey bool
+	handler := AuthMiddleware(nil, store)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUID = getUserToken(r).UID
+		gotApiKey = isApiKeyToken(getUserToken(r))
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name string
+		key  string
+		want int
+	}{
+		{"personal key", personal, http.StatusOK},
+		{"unknown key", apikey.PersonalKeyPrefix + "nope", http.StatusUnauthorized},
+		// Only reaches the key store if it carries the prefix
+		{"webhook key with prefix", apikey.PersonalKeyPrefix + webhook, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.key)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			if w.Code != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+
+	if gotUID != "user1" || !gotApiKey {
+		t.Errorf("expected an API key token for user1, got %q (api key %v)", gotUID, gotApiKey)
+	}
+	if len(store.touched) != 1 || store.touched[0] != personalHash {
+		t.Errorf("expected the key's last use recorded once, got %v", store.touched)
+	}
+}
+
+func TestApiKeys_CreateListDelete(t *testing.T) {
+	store := &mockApiKeyStore{}
+	store.CreateIngressKey(context.Background(), "ingress-hash", "user1", "hevy-webhook", []string{"webhook:hevy"}, time.Now())
+	s := &APIServer{logger: infra.NewLogger(), apiKeyStore: store}
+
+	r := withToken(httptest.NewRequest(http.MethodPost, "/api/v2/users/me/api-keys", strings.NewReader(`{"label": "laptop"}`)), "user1")
+	w := httptest.NewRecorder()
+	s.handleCreateApiKey(w, r)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", w.Code, w.Body.String())
+	}
+	var created struct {
+		ApiKey string `json:"apiKey"`
+		Key    struct {
+			Id    string `json:"id"`
+			Label string `json:"label"`
+		} `json:"key"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &created); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if !apikey.IsPersonalKey(created.ApiKey) || created.Key.Id != apikey.HashIngressKey(created.ApiKey) || created.Key.Label != "laptop" {
+		t.Errorf("unexpected key: %+v", created)
+	}
+
+	// Ingress keys aren't listed
+	w = httptest.NewRecorder()
+	s.handleListApiKeys(w, withToken(httptest.NewRequest(http.MethodGet, "/api/v2/users/me/api-keys", nil), "user1"))
+	if w.Code != http.StatusOK || strings.Count(w.Body.String(), `"label"`) != 1 || !strings.Contains(w.Body.String(), created.Key.Id) {
+		t.Errorf("expected only the personal key listed, got %d: %s", w.Code, w.Body.String())
+	}
+
+	// Another user's or an ingress key can't be deleted here
+	for _, tc := range []struct{ uid, id string }{{"user2", created.Key.Id}, {"user1", "ingress-hash"}} {
+		w = httptest.NewRecorder()
+		s.handleDeleteApiKey(w, withApiKeyID(withToken(httptest.NewRequest(http.MethodDelete, "/", nil), tc.uid), tc.id))
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected 404 deleting %s as %s, got %d", tc.id, tc.uid, w.Code)
+		}
+	}
+
+	w = httptest.NewRecorder()
+	s.handleDeleteApiKey(w, withApiKeyID(withToken(httptest.NewRequest(http.MethodDelete, "/", nil), "user1"), created.Key.Id))
+	if w.Code != http.StatusNoContent || store.keys[created.Key.Id] != nil {
+		t.Errorf("expected the key deleted, got %d", w.Code)
+	}
+}
+
+func TestApiKeys_RequireSignIn(t *testing.T) {
+	s := &APIServer{logger: infra.NewLogger(), apiKeyStore: &mockApiKeyStore{}}
+
+	handlers := map[string]http.HandlerFunc{
+		"list":           s.handleListApiKeys,
+		"create":         s.handleCreateApiKey,
+		"delete":         s.handleDeleteApiKey,
+		"delete account": s.handleDeleteSelf,
+	}
+	for name, handler := range handlers {
+		w := httptest.NewRecorder()
+		handler(w, withApiKeyToken(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"label": "x"}`)), "user1"))
+		if w.Code != http.StatusForbidden {
+			t.Errorf("%s: expected 403 with an API key, got %d", name, w.Code)
+		}
+	}
+}
+
+func TestApiKeys_CreateValidation(t *testing.T) {
+	store := &mockApiKeyStore{}
+	s := &APIServer{logger: infra.NewLogger(), apiKeyStore: store}
+
+	w := httptest.NewRecorder()
+	s.handleCreateApiKey(w, withToken(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"label": "  "}`)), "user1"))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for a blank label, got %d", w.Code)
+	}
+
+	for i := 0; i < maxApiKeysPerUser; i++ {
+		_, keyHash, _ := apikey.GeneratePersonalKey()
+		store.CreateIngressKey(context.Background(), keyHash, "user1", "key", []string{apikey.ScopeAPI}, time.Now())
+	}
+	w = httptest.NewRecorder()
+	s.handleCreateApiKey(w, withToken(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"label": "one more"}`)), "user1"))
+	if w.Code != http.StatusConflict {
+		t.Errorf("expected 409 over the key limit, got %d", w.Code)
+	}
+}
+
+func TestHandleListPendingInputs(t *testing.T) {
+	svc := &mockPipelineServiceClient{
+		listPendingInputs: func(_ context.Context, in *pipelinepb.ListPendingInputsRequest, _ ...grpc.CallOption) (*pipelinepb.ListPendingInputsResponse, error) {
+			if in.UserId != "user1" {
+				t.Errorf("expected user1, got %s", in.UserId)
+			}
+			return &pipelinepb.ListPendingInputsResponse{Inputs: []*pbpipeline.PendingInput{
+				{ActivityId: "strava:1", RequiredFields: []string{"description"}},
+			}}, nil
+		},
+	}
+	s := buildPipelineServer(svc)
+
+	w := httptest.NewRecorder()
+	s.handleListPendingInputs(w, withApiKeyToken(httptest.NewRequest(http.MethodGet, "/api/v2/users/me/pending-inputs", nil), "user1"))
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"activityId":"strava:1"`) {
+		t.Errorf("expected the pending input listed, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func withApiKeyID(r *http.Request, id string) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", id)
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/fitglue/server/src/go/internal/archive"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+)
+
+// handleExportArchive queues a static site export of the user's showcased
+// activities. The destination service builds it and sends a push
+// notification with the download link or the GitHub Pages URL.
+func (s *APIServer) handleExportArchive(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var req struct {
+		Target       string `json:"target"`
+		GithubRepo   string `json:"githubRepo"`
+		GithubBranch string `json:"githubBranch"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+
+	event := &pbevents.ArchiveExportRequestedEvent{UserId: token.UID}
+	switch req.Target {
+	case "", "zip":
+		event.Target = pbevents.ArchiveExportTarget_ARCHIVE_EXPORT_TARGET_ZIP
+	case "github_pages":
+		owner, name, ok := strings.Cut(req.GithubRepo, "/")
+		if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+			WriteError(w, statusError(http.StatusBadRequest, "githubRepo must be in owner/repo format"))
+			return
+		}
+		if strings.ContainsAny(req.GithubBranch, " ~^:?*[\\") {
+			WriteError(w, statusError(http.StatusBadRequest, "invalid githubBranch"))
+			return
+		}
+		event.Target = pbevents.ArchiveExportTarget_ARCHIVE_EXPORT_TARGET_GITHUB_PAGES
+		event.GithubRepo = req.GithubRepo
+		event.GithubBranch = req.GithubBranch
+	default:
+		WriteError(w, statusError(http.StatusBadRequest, "target must be zip or github_pages"))
+		return
+	}
+
+	if err := archive.RequestExport(r.Context(), s.publisher, event); err != nil {
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to start archive export"))
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	WriteJSON(w, map[string]string{"status": "queued"})
+}
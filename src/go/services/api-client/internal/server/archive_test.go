@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	shared "github.com/fitglue/server/src/go/pkg"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+)
+
+func TestHandleExportArchive_GitHubPages(t *testing.T) {
+	var published []event.Event
+	var topics []string
+	pub := &mockPublisher{publishFunc: func(_ context.Context, topicID string, e event.Event) (string, error) {
+		topics = append(topics, topicID)
+		published = append(published, e)
+		return "msg-id", nil
+	}}
+	s := &APIServer{publisher: pub}
+
+	body := `{"target": "github_pages", "githubRepo": "jo/activities"}`
+	r := withToken(httptest.NewRequest(http.MethodPost, "/api/v2/users/me/export/archive", strings.NewReader(body)), "user1")
+	w := httptest.NewRecorder()
+	s.handleExportArchive(w, r)
+
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d: %s", w.Code, w.Body.String())
+	}
+	if len(topics) != 1 || topics[0] != shared.TopicArchiveExportRequested {
+		t.Fatalf("expected one publish to %s, got %v", shared.TopicArchiveExportRequested, topics)
+	}
+
+	var req pbevents.ArchiveExportRequestedEvent
+	if err := protojson.Unmarshal(published[0].Data(), &req); err != nil {
+		t.Fatalf("failed to decode event: %v", err)
+	}
+	if req.UserId != "user1" || req.Target != pbevents.ArchiveExportTarget_ARCHIVE_EXPORT_TARGET_GITHUB_PAGES || req.GithubRepo != "jo/activities" {
+		t.Errorf("unexpected event: %+v", &req)
+	}
+
+	var resp map[string]string
+	_ = json.Unmarshal(w.Body.Bytes(), &resp)
+	if resp["status"] != "queued" {
+		t.Errorf("expected queued status, got %v", resp)
+	}
+}
+
+func TestHandleExportArchive_EmptyBodyDefaultsToZip(t *testing.T) {
+	var published []event.Event
+	pub := &mockPublisher{publishFunc: func(_ context.Context, _ string, e event.Event) (string, error) {
+		published = append(published, e)
+		return "msg-id", nil
+	}}
+	s := &APIServer{publisher: pub}
+
+	r := withToken(httptest.NewRequest(http.MethodPost, "/api/v2/users/me/export/archive", nil), "user1")
+	w := httptest.NewRecorder()
+	s.handleExportArchive(w, r)
+
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d: %s", w.Code, w.Body.String())
+	}
+	var req pbevents.ArchiveExportRequestedEvent
+	if err := protojson.Unmarshal(published[0].Data(), &req); err != nil {
+		t.Fatalf("failed to decode event: %v", err)
+	}
+	if req.Target != pbevents.ArchiveExportTarget_ARCHIVE_EXPORT_TARGET_ZIP {
+		t.Errorf("expected zip target, got %s", req.Target)
+	}
+}
+
+func TestHandleExportArchive_InvalidRequests(t *testing.T) {
+	s := &APIServer{publisher: &mockPublisher{publishFunc: func(_ context.Context, _ string, _ event.Event) (string, error) {
+		t.Error("invalid requests must not be published")
+		return "", nil
+	}}}
+
+	for _, body := range []string{
+		`{"target": "dropbox"}`,
+		`{"target": "github_pages"}`,
+		`{"target": "github_pages", "githubRepo": "jo/a/b"}`,
+		`{"target": "github_pages", "githubRepo": "jo/site", "githubBranch": "bad branch"}`,
+		`not json`,
+	} {
+		r := withToken(httptest.NewRequest(http.MethodPost, "/api/v2/users/me/export/archive", strings.NewReader(body)), "user1")
+		w := httptest.NewRecorder()
+		s.handleExportArchive(w, r)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected 400, got %d", body, w.Code)
+		}
+	}
+}
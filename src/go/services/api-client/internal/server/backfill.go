@@ -0,0 +1,97 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/fitglue/server/src/go/internal/backfill"
+	"github.com/fitglue/server/src/go/pkg/types/formatters"
+	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+)
+
+// handleStartBackfill replays recent source history into a pipeline. The web
+// app offers this straight after a pipeline is created, then polls the
+// returned job for progress. Only one backfill of a pipeline runs at a time.
+func (s *APIServer) handleStartBackfill(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var req struct {
+		Days int `json:"days"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+	if req.Days == 0 {
+		req.Days = backfill.MaxDays
+	}
+	if req.Days < 0 || req.Days > backfill.MaxDays {
+		WriteError(w, statusError(http.StatusBadRequest, fmt.Sprintf("days must be between 1 and %d", backfill.MaxDays)))
+		return
+	}
+
+	pipeline, err := s.pipelineSvc.GetPipeline(r.Context(), &pipelinepb.GetPipelineRequest{
+		UserId:     token.UID,
+		PipelineId: chi.URLParam(r, "id"),
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	source := formatters.ParseActivitySource(pipeline.Source)
+	if !backfill.SupportsSource(source) {
+		WriteError(w, statusError(http.StatusBadRequest, fmt.Sprintf("backfill is not available for %s pipelines", formatters.FormatActivitySource(source))))
+		return
+	}
+
+	job := backfill.NewJob(token.UID, pipeline.Id, source, req.Days, time.Now())
+	active, err := s.backfillStore.StartJob(r.Context(), job)
+	if err != nil {
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to create backfill job"))
+		return
+	}
+	if active != nil {
+		WriteError(w, statusError(http.StatusConflict, fmt.Sprintf("a backfill of this pipeline is already in progress (job %s)", active.Id)))
+		return
+	}
+
+	if err := backfill.RequestPage(r.Context(), s.publisher, job); err != nil {
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to start backfill"))
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	WriteJSON(w, job)
+}
+
+func (s *APIServer) handleGetBackfillJob(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	job, err := s.backfillStore.GetJob(r.Context(), chi.URLParam(r, "jobId"))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	// Jobs live in a top-level collection, so ownership is checked here
+	if job == nil || job.UserId != token.UID || job.PipelineId != chi.URLParam(r, "id") {
+		WriteError(w, statusError(http.StatusNotFound, "backfill job not found"))
+		return
+	}
+
+	WriteJSON(w, job)
+}
@@ -0,0 +1,191 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
+
+	"github.com/fitglue/server/src/go/internal/backfill"
+	shared "github.com/fitglue/server/src/go/pkg"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+)
+
+type mockBackfillStore struct {
+	jobs map[string]*pbpipeline.BackfillJob
+}
+
+func (m *mockBackfillStore) StartJob(ctx context.Context, job *pbpipeline.BackfillJob) (*pbpipeline.BackfillJob, error) {
+	for _, existing := range m.jobs {
+		if existing.UserId == job.UserId && existing.PipelineId == job.PipelineId && backfill.InProgress(existing, time.Now()) {
+			return existing, nil
+		}
+	}
+	m.jobs[job.Id] = job
+	return nil, nil
+}
+
+func (m *mockBackfillStore) GetJob(ctx context.Context, jobID string) (*pbpipeline.BackfillJob, error) {
+	return m.jobs[jobID], nil
+}
+
+func withBackfillParams(r *http.Request, pipelineID, jobID string) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", pipelineID)
+	if jobID != "" {
+		rctx.URLParams.Add("jobId", jobID)
+	}
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+}
+
+func buildBackfillServer(source string, pub *mockPublisher) (*APIServer, *mockBackfillStore) {
+	store := &mockBackfillStore{jobs: map[string]*pbpipeline.BackfillJob{}}
+	pSvc := &mockPipelineServiceClient{
+		getPipeline: func(_ context.Context, in *pipelinepb.GetPipelineRequest, _ ...grpc.CallOption) (*pbpipeline.PipelineConfig, error) {
+			return &pbpipeline.PipelineConfig{Id: in.PipelineId, Source: source}, nil
+		},
+	}
+	return &APIServer{pipelineSvc: pSvc, publisher: pub, backfillStore: store}, store
+}
+
+func TestHandleStartBackfill_Success(t *testing.T) {
+	var topics []string
+	pub := &mockPublisher{publishFunc: func(_ context.Context, topicID string, _ event.Event) (string, error) {
+		topics = append(topics, topicID)
+		return "msg-id", nil
+	}}
+	s, store := buildBackfillServer("SOURCE_STRAVA", pub)
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v2/users/me/pipelines/pipe1/backfill", strings.NewReader(`{"days": 30}`))
+	r = withBackfillParams(withToken(r, "user1"), "pipe1", "")
+	w := httptest.NewRecorder()
+	s.handleStartBackfill(w, r)
+
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d: %s", w.Code, w.Body.String())
+	}
+	if len(store.jobs) != 1 {
+		t.Fatalf("expected 1 job to be created, got %d", len(store.jobs))
+	}
+	for _, job := range store.jobs {
+		if job.UserId != "user1" || job.PipelineId != "pipe1" || job.Status != pbpipeline.BackfillStatus_BACKFILL_STATUS_PENDING {
+			t.Errorf("unexpected job: %+v", job)
+		}
+	}
+	if len(topics) != 1 || topics[0] != shared.TopicBackfillRequested {
+		t.Errorf("expected one publish to %s, got %v", shared.TopicBackfillRequested, topics)
+	}
+}
+
+func TestHandleStartBackfill_EmptyBodyDefaultsWindow(t *testing.T) {
+	s, store := buildBackfillServer("hevy", &mockPublisher{})
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v2/users/me/pipelines/pipe1/backfill", nil)
+	r = withBackfillParams(withToken(r, "user1"), "pipe1", "")
+	w := httptest.NewRecorder()
+	s.handleStartBackfill(w, r)
+
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d: %s", w.Code, w.Body.String())
+	}
+	for _, job := range store.jobs {
+		days := job.CreatedAt.AsTime().Sub(job.Since.AsTime()).Hours() / 24
+		if days < 89 || days > 91 {
+			t.Errorf("expected a 90 day window, got %.1f days", days)
+		}
+	}
+}
+
+func TestHandleStartBackfill_UnsupportedSource(t *testing.T) {
+	s, store := buildBackfillServer("SOURCE_FILE_UPLOAD", &mockPublisher{})
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v2/users/me/pipelines/pipe1/backfill", nil)
+	r = withBackfillParams(withToken(r, "user1"), "pipe1", "")
+	w := httptest.NewRecorder()
+	s.handleStartBackfill(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", w.Code)
+	}
+	if len(store.jobs) != 0 {
+		t.Errorf("expected no job to be created")
+	}
+}
+
+func TestHandleStartBackfill_OneJobPerPipeline(t *testing.T) {
+	s, store := buildBackfillServer("SOURCE_STRAVA", &mockPublisher{})
+	start := func() int {
+		r := httptest.NewRequest(http.MethodPost, "/api/v2/users/me/pipelines/pipe1/backfill", nil)
+		r = withBackfillParams(withToken(r, "user1"), "pipe1", "")
+		w := httptest.NewRecorder()
+		s.handleStartBackfill(w, r)
+		return w.Code
+	}
+
+	if code := start(); code != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d", code)
+	}
+	if code := start(); code != http.StatusConflict {
+		t.Errorf("expected 409 while the first backfill is pending, got %d", code)
+	}
+
+	// Once it has finished, another may start
+	for _, job := range store.jobs {
+		job.Status = pbpipeline.BackfillStatus_BACKFILL_STATUS_COMPLETED
+	}
+	if code := start(); code != http.StatusAccepted {
+		t.Errorf("expected 202 after the first backfill completed, got %d", code)
+	}
+	if len(store.jobs) != 2 {
+		t.Errorf("expected 2 jobs, got %d", len(store.jobs))
+	}
+}
+
+func TestHandleStartBackfill_InvalidDays(t *testing.T) {
+	s, _ := buildBackfillServer("SOURCE_STRAVA", &mockPublisher{})
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v2/users/me/pipelines/pipe1/backfill", strings.NewReader(`{"days": 365}`))
+	r = withBackfillParams(withToken(r, "user1"), "pipe1", "")
+	w := httptest.NewRecorder()
+	s.handleStartBackfill(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", w.Code)
+	}
+}
+
+func TestHandleGetBackfillJob(t *testing.T) {
+	s, store := buildBackfillServer("SOURCE_STRAVA", &mockPublisher{})
+	store.jobs["job1"] = &pbpipeline.BackfillJob{Id: "job1", UserId: "user1", PipelineId: "pipe1", ActivitiesPublished: 4}
+
+	tests := []struct {
+		name       string
+		uid        string
+		pipelineID string
+		jobID      string
+		want       int
+	}{
+		{"owner", "user1", "pipe1", "job1", http.StatusOK},
+		{"other user", "user2", "pipe1", "job1", http.StatusNotFound},
+		{"other pipeline", "user1", "pipe2", "job1", http.StatusNotFound},
+		{"missing job", "user1", "pipe1", "nope", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/v2/users/me/pipelines/"+tt.pipelineID+"/backfill/"+tt.jobID, nil)
+			r = withBackfillParams(withToken(r, tt.uid), tt.pipelineID, tt.jobID)
+			w := httptest.NewRecorder()
+			s.handleGetBackfillJob(w, r)
+			if w.Code != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
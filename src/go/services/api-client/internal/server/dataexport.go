@@ -0,0 +1,26 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/fitglue/server/src/go/internal/dataexport"
+)
+
+// handleExportUserData queues an export of everything held for the user. The
+// destination service builds the zip and sends a push notification with a
+// download link that expires after 7 days.
+func (s *APIServer) handleExportUserData(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	if err := dataexport.RequestExport(r.Context(), s.publisher, token.UID); err != nil {
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to start data export"))
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	WriteJSON(w, map[string]string{"status": "queued"})
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	shared "github.com/fitglue/server/src/go/pkg"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+)
+
+func TestHandleExportUserData(t *testing.T) {
+	var published []event.Event
+	var topics []string
+	pub := &mockPublisher{publishFunc: func(_ context.Context, topicID string, e event.Event) (string, error) {
+		topics = append(topics, topicID)
+		published = append(published, e)
+		return "msg-id", nil
+	}}
+	s := &APIServer{publisher: pub}
+
+	r := withToken(httptest.NewRequest(http.MethodPost, "/api/v2/users/me/export/data", nil), "user1")
+	w := httptest.NewRecorder()
+	s.handleExportUserData(w, r)
+
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d: %s", w.Code, w.Body.String())
+	}
+	if len(topics) != 1 || topics[0] != shared.TopicDataExportRequested {
+		t.Fatalf("expected one publish to %s, got %v", shared.TopicDataExportRequested, topics)
+	}
+
+	var req pbevents.DataExportRequestedEvent
+	if err := protojson.Unmarshal(published[0].Data(), &req); err != nil {
+		t.Fatalf("failed to decode event: %v", err)
+	}
+	if req.UserId != "user1" {
+		t.Errorf("unexpected event: %+v", &req)
+	}
+}
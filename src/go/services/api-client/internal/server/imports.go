@@ -0,0 +1,180 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/fitglue/server/src/go/internal/backfill"
+	gatewaypb "github.com/fitglue/server/src/go/pkg/types/pb/gateway"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+)
+
+// handleCreateImport starts a bulk import of activity files into a pipeline.
+// The web app then uploads each file by index and starts the session, polling
+// it for per-file progress.
+func (s *APIServer) handleCreateImport(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var req gatewaypb.CreateImportGatewayRequest
+	if err := decodeProto(r, &req); err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+	if len(req.FileNames) == 0 || len(req.FileNames) > backfill.MaxImportFiles {
+		WriteError(w, statusError(http.StatusBadRequest, fmt.Sprintf("file_names must list between 1 and %d files", backfill.MaxImportFiles)))
+		return
+	}
+
+	pipeline, err := s.pipelineSvc.GetPipeline(r.Context(), &pipelinepb.GetPipelineRequest{
+		UserId:     token.UID,
+		PipelineId: chi.URLParam(r, "id"),
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	session := backfill.NewImport(token.UID, pipeline.Id, req.FileNames, time.Now())
+	if err := s.importStore.CreateImport(r.Context(), session); err != nil {
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to create import"))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	WriteJSON(w, session)
+}
+
+// handleUploadImportFile stores one file of a session. Files can be uploaded
+// in parallel, and re-uploaded after a failure, until the session is started.
+func (s *APIServer) handleUploadImportFile(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	index, err := strconv.Atoi(chi.URLParam(r, "index"))
+	if err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid file index"))
+		return
+	}
+
+	// Base64 in JSON grows the file by a third
+	r.Body = http.MaxBytesReader(w, r.Body, backfill.MaxImportFileBytes*4/3+4096)
+	var req gatewaypb.UploadImportFileGatewayRequest
+	if err := decodeProto(r, &req); err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+	if len(req.FitFileContent) == 0 || len(req.FitFileContent) > backfill.MaxImportFileBytes {
+		WriteError(w, statusError(http.StatusBadRequest, fmt.Sprintf("fit_file_content must be between 1 byte and %d MB", backfill.MaxImportFileBytes>>20)))
+		return
+	}
+
+	session, ok := s.getOwnedImport(w, r, token.UID)
+	if !ok {
+		return
+	}
+	if index < 0 || index >= len(session.Files) {
+		WriteError(w, statusError(http.StatusBadRequest, fmt.Sprintf("file index must be between 0 and %d", len(session.Files)-1)))
+		return
+	}
+
+	uri := backfill.ImportFileURI(s.uploadBucket, session, index)
+	if err := s.uploads.Write(r.Context(), "", uri, req.FitFileContent); err != nil {
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to store file"))
+		return
+	}
+
+	session, err = s.importStore.ModifyImport(r.Context(), session.Id, func(session *pbpipeline.ImportSession) error {
+		return backfill.MarkImportFileUploaded(session, index, uri, time.Now())
+	})
+	if err != nil {
+		writeImportError(w, err)
+		return
+	}
+
+	WriteJSON(w, session)
+}
+
+// handleStartImport starts a session once every file is uploaded. Starting
+// a failed or completed session again resumes it: files that completed are
+// skipped and failed files are tried again.
+func (s *APIServer) handleStartImport(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	session, ok := s.getOwnedImport(w, r, token.UID)
+	if !ok {
+		return
+	}
+
+	session, err := s.importStore.ModifyImport(r.Context(), session.Id, func(session *pbpipeline.ImportSession) error {
+		return backfill.StartImport(session, time.Now())
+	})
+	if err != nil {
+		writeImportError(w, err)
+		return
+	}
+
+	if err := backfill.RequestImport(r.Context(), s.publisher, session.Id); err != nil {
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to start import"))
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	WriteJSON(w, session)
+}
+
+func (s *APIServer) handleGetImport(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	session, ok := s.getOwnedImport(w, r, token.UID)
+	if !ok {
+		return
+	}
+
+	WriteJSON(w, session)
+}
+
+// getOwnedImport loads the session in the path, writing a 404 unless it
+// belongs to the user and the pipeline in the path.
+func (s *APIServer) getOwnedImport(w http.ResponseWriter, r *http.Request, userID string) (*pbpipeline.ImportSession, bool) {
+	session, err := s.importStore.GetImport(r.Context(), chi.URLParam(r, "importId"))
+	if err != nil {
+		WriteError(w, err)
+		return nil, false
+	}
+	// Sessions live in a top-level collection, so ownership is checked here
+	if session == nil || session.UserId != userID || session.PipelineId != chi.URLParam(r, "id") {
+		WriteError(w, statusError(http.StatusNotFound, "import not found"))
+		return nil, false
+	}
+	return session, true
+}
+
+func writeImportError(w http.ResponseWriter, err error) {
+	if errors.Is(err, backfill.ErrImportState) {
+		WriteError(w, statusError(http.StatusConflict, strings.TrimPrefix(err.Error(), backfill.ErrImportState.Error()+": ")))
+		return
+	}
+	WriteError(w, err)
+}
@@ -0,0 +1,239 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/fitglue/server/src/go/internal/backfill"
+	shared "github.com/fitglue/server/src/go/pkg"
+	pendinginput "github.com/fitglue/server/src/go/pkg/pending_input"
+	gatewaypb "github.com/fitglue/server/src/go/pkg/types/pb/gateway"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+)
+
+type mockImportStore struct {
+	sessions map[string]*pbpipeline.ImportSession
+}
+
+func (m *mockImportStore) CreateImport(ctx context.Context, session *pbpipeline.ImportSession) error {
+	m.sessions[session.Id] = session
+	return nil
+}
+
+func (m *mockImportStore) GetImport(ctx context.Context, sessionID string) (*pbpipeline.ImportSession, error) {
+	session, ok := m.sessions[sessionID]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(session).(*pbpipeline.ImportSession), nil
+}
+
+func (m *mockImportStore) ModifyImport(ctx context.Context, sessionID string, fn func(*pbpipeline.ImportSession) error) (*pbpipeline.ImportSession, error) {
+	session, _ := m.GetImport(ctx, sessionID)
+	if session == nil {
+		return nil, nil
+	}
+	if err := fn(session); err != nil {
+		return nil, err
+	}
+	m.sessions[sessionID] = session
+	return session, nil
+}
+
+type mockUploadStore struct {
+	files map[string][]byte
+}
+
+func (m *mockUploadStore) Write(ctx context.Context, bucket, object string, data []byte) error {
+	m.files[object] = data
+	return nil
+}
+
+func (m *mockUploadStore) SignedURL(ctx context.Context, bucket, object, contentType string, contentLength int64, expiry time.Duration) (string, error) {
+	return "https://storage.example.com/" + bucket + "/" + object + "?signed", nil
+}
+
+func withImportParams(r *http.Request, pipelineID, importID, index string) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", pipelineID)
+	if importID != "" {
+		rctx.URLParams.Add("importId", importID)
+	}
+	if index != "" {
+		rctx.URLParams.Add("index", index)
+	}
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+}
+
+func buildImportServer(pub *mockPublisher) (*APIServer, *mockImportStore, *mockUploadStore) {
+	store := &mockImportStore{sessions: map[string]*pbpipeline.ImportSession{}}
+	files := &mockUploadStore{files: map[string][]byte{}}
+	pSvc := &mockPipelineServiceClient{
+		getPipeline: func(_ context.Context, in *pipelinepb.GetPipelineRequest, _ ...grpc.CallOption) (*pbpipeline.PipelineConfig, error) {
+			return &pbpipeline.PipelineConfig{Id: in.PipelineId}, nil
+		},
+	}
+	return &APIServer{pipelineSvc: pSvc, publisher: pub, importStore: store, uploads: files, uploadBucket: "bucket"}, store, files
+}
+
+func TestHandleImport_Lifecycle(t *testing.T) {
+	var topics []string
+	pub := &mockPublisher{publishFunc: func(_ context.Context, topicID string, _ event.Event) (string, error) {
+		topics = append(topics, topicID)
+		return "msg-id", nil
+	}}
+	s, store, files := buildImportServer(pub)
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v2/users/me/pipelines/pipe1/imports", strings.NewReader(`{"fileNames": ["a.fit", "b.fit"]}`))
+	r = withImportParams(withToken(r, "user1"), "pipe1", "", "")
+	w := httptest.NewRecorder()
+	s.handleCreateImport(w, r)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", w.Code, w.Body.String())
+	}
+	if len(store.sessions) != 1 {
+		t.Fatalf("expected 1 session, got %d", len(store.sessions))
+	}
+	var session *pbpipeline.ImportSession
+	for _, created := range store.sessions {
+		session = created
+	}
+
+	start := func() int {
+		r := httptest.NewRequest(http.MethodPost, "/api/v2/users/me/pipelines/pipe1/imports/"+session.Id+"/start", nil)
+		r = withImportParams(withToken(r, "user1"), "pipe1", session.Id, "")
+		w := httptest.NewRecorder()
+		s.handleStartImport(w, r)
+		return w.Code
+	}
+	upload := func(index string) int {
+		body := `{"fitFileContent": "` + base64.StdEncoding.EncodeToString([]byte("fit-"+index)) + `"}`
+		r := httptest.NewRequest(http.MethodPut, "/api/v2/users/me/pipelines/pipe1/imports/"+session.Id+"/files/"+index, strings.NewReader(body))
+		r = withImportParams(withToken(r, "user1"), "pipe1", session.Id, index)
+		w := httptest.NewRecorder()
+		s.handleUploadImportFile(w, r)
+		return w.Code
+	}
+
+	if code := upload("0"); code != http.StatusOK {
+		t.Fatalf("expected upload 200, got %d", code)
+	}
+	if code := upload("5"); code != http.StatusBadRequest {
+		t.Errorf("expected out of range upload 400, got %d", code)
+	}
+	if code := start(); code != http.StatusConflict {
+		t.Errorf("expected start with a missing file 409, got %d", code)
+	}
+	if code := upload("1"); code != http.StatusOK {
+		t.Fatalf("expected upload 200, got %d", code)
+	}
+	if string(files.files[backfill.ImportFileURI("bucket", session, 1)]) != "fit-1" {
+		t.Errorf("expected the file to be stored, got %v", files.files)
+	}
+
+	if code := start(); code != http.StatusAccepted {
+		t.Fatalf("expected start 202, got %d", code)
+	}
+	if store.sessions[session.Id].Status != pbpipeline.ImportStatus_IMPORT_STATUS_RUNNING {
+		t.Errorf("expected RUNNING, got %s", store.sessions[session.Id].Status)
+	}
+	if len(topics) != 1 || topics[0] != shared.TopicImportRequested {
+		t.Errorf("expected one publish to %s, got %v", shared.TopicImportRequested, topics)
+	}
+	if code := upload("0"); code != http.StatusConflict {
+		t.Errorf("expected upload while running 409, got %d", code)
+	}
+}
+
+func TestHandleCreateImport_Validation(t *testing.T) {
+	s, store, _ := buildImportServer(&mockPublisher{})
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v2/users/me/pipelines/pipe1/imports", strings.NewReader(`{"fileNames": []}`))
+	r = withImportParams(withToken(r, "user1"), "pipe1", "", "")
+	w := httptest.NewRecorder()
+	s.handleCreateImport(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", w.Code)
+	}
+	if len(store.sessions) != 0 {
+		t.Errorf("expected no session to be created")
+	}
+}
+
+func TestHandleGetImport(t *testing.T) {
+	s, store, _ := buildImportServer(&mockPublisher{})
+	session := backfill.NewImport("user1", "pipe1", []string{"a.fit"}, time.Now())
+	_ = store.CreateImport(context.Background(), session)
+
+	tests := []struct {
+		name       string
+		uid        string
+		pipelineID string
+		importID   string
+		want       int
+	}{
+		{"owner", "user1", "pipe1", session.Id, http.StatusOK},
+		{"other user", "user2", "pipe1", session.Id, http.StatusNotFound},
+		{"other pipeline", "user1", "pipe2", session.Id, http.StatusNotFound},
+		{"missing import", "user1", "pipe1", "nope", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/v2/users/me/pipelines/"+tt.pipelineID+"/imports/"+tt.importID, nil)
+			r = withImportParams(withToken(r, tt.uid), tt.pipelineID, tt.importID, "")
+			w := httptest.NewRecorder()
+			s.handleGetImport(w, r)
+			if w.Code != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandleCreatePendingInputUpload(t *testing.T) {
+	s, _, _ := buildImportServer(&mockPublisher{})
+	inputID := "SOURCE_STRAVA:123:photo-geotag"
+
+	upload := func(body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/api/v2/users/me/pending-inputs/"+inputID+"/uploads", strings.NewReader(body))
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("inputId", inputID)
+		r = withToken(r, "user1")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+		w := httptest.NewRecorder()
+		s.handleCreatePendingInputUpload(w, r)
+		return w
+	}
+
+	w := upload(`{"contentType":"image/jpeg"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var res gatewaypb.CreatePendingInputUploadGatewayResponse
+	if err := protojson.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if !pendinginput.IsUploadFor(res.ObjectRef, "user1", inputID) || !strings.HasSuffix(res.ObjectRef, ".jpg") {
+		t.Errorf("expected an upload under the user's input, got %q", res.ObjectRef)
+	}
+	if !strings.Contains(res.UploadUrl, res.ObjectRef) || res.MaxSizeBytes != pendingUploadMaxBytes {
+		t.Errorf("unexpected upload %+v", &res)
+	}
+
+	if w := upload(`{"contentType":"image/gif"}`); w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an unsupported type, got %d", w.Code)
+	}
+}
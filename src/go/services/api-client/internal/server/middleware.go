@@ -3,11 +3,13 @@ package server
 import (
 	"context"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
 	"firebase.google.com/go/v4/auth"
 	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/pkg/domain/apikey"
 	"github.com/go-chi/chi/v5/middleware"
 )
 
@@ -15,8 +17,16 @@ type contextKey string
 
 const userContextKey = contextKey("userToken")
 
-// AuthMiddleware verifies the Firebase ID token
-func AuthMiddleware(authClient *auth.Client) func(http.Handler) http.Handler {
+// apiKeyClaim marks tokens built from a personal API key rather than a
+// Firebase ID token
+const apiKeyClaim = "fitglue_api_key"
+
+// apiKeyTouchInterval limits how often a key's last_used_at is written
+const apiKeyTouchInterval = time.Hour
+
+// AuthMiddleware verifies the Firebase ID token, or a personal API key for
+// scripts and the fitglue CLI
+func AuthMiddleware(authClient *auth.Client, apiKeys ApiKeyStore) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authHeader := r.Header.Get("Authorization")
@@ -26,10 +36,20 @@ func AuthMiddleware(authClient *auth.Client) func(http.Handler) http.Handler {
 			}
 
 			tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-			token, err := authClient.VerifyIDToken(r.Context(), tokenStr)
-			if err != nil {
-				http.Error(w, "invalid token", http.StatusUnauthorized)
-				return
+			var token *auth.Token
+			if apikey.IsPersonalKey(tokenStr) {
+				token = verifyApiKey(r.Context(), apiKeys, tokenStr)
+				if token == nil {
+					http.Error(w, "invalid API key", http.StatusUnauthorized)
+					return
+				}
+			} else {
+				var err error
+				token, err = authClient.VerifyIDToken(r.Context(), tokenStr)
+				if err != nil {
+					http.Error(w, "invalid token", http.StatusUnauthorized)
+					return
+				}
 			}
 
 			// Add token details to context
@@ -39,6 +59,38 @@ func AuthMiddleware(authClient *auth.Client) func(http.Handler) http.Handler {
 	}
 }
 
+// verifyApiKey resolves a personal API key to a token for its owner, or nil
+// if the key is unknown or isn't scoped for the API (e.g. a webhook key).
+func verifyApiKey(ctx context.Context, apiKeys ApiKeyStore, rawKey string) *auth.Token {
+	if apiKeys == nil {
+		return nil
+	}
+	keyHash := apikey.HashIngressKey(rawKey)
+	rec, err := apiKeys.GetKey(ctx, keyHash)
+	if err != nil || rec == nil || !slices.Contains(rec.Scopes, apikey.ScopeAPI) {
+		return nil
+	}
+
+	now := time.Now()
+	if rec.LastUsedAt == nil || now.Sub(rec.LastUsedAt.AsTime()) > apiKeyTouchInterval {
+		// Best effort; a failed write shouldn't fail the request
+		_ = apiKeys.TouchKey(ctx, keyHash, now)
+	}
+
+	return &auth.Token{
+		UID:    rec.UserId,
+		Claims: map[string]interface{}{apiKeyClaim: true},
+	}
+}
+
+// isApiKeyToken reports whether the request was authenticated with a personal
+// API key. Handlers that could let a leaked key lock the owner out (managing
+// keys, deleting the account) require a Firebase sign-in instead.
+func isApiKeyToken(token *auth.Token) bool {
+	isKey, _ := token.Claims[apiKeyClaim].(bool)
+	return isKey
+}
+
 // LoggerMiddleware sets up structured logging on endpoints
 func LoggerMiddleware(logger infra.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -45,7 +45,7 @@ func TestAuthMiddleware_MissingAuthHeader(t *testing.T) {
 	// AuthMiddleware requires a *auth.Client for token verification.
 	// We test only the "missing header" branch which doesn't reach Firebase.
 	// Pass nil since nil.VerifyIDToken is never called when header is absent.
-	handler := AuthMiddleware(nil)
+	handler := AuthMiddleware(nil, nil)
 	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	})
@@ -58,7 +58,7 @@ func TestAuthMiddleware_MissingAuthHeader(t *testing.T) {
 }
 
 func TestAuthMiddleware_MalformedAuthHeader(t *testing.T) {
-	handler := AuthMiddleware(nil)
+	handler := AuthMiddleware(nil, nil)
 	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	})
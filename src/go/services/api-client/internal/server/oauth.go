@@ -1,11 +1,13 @@
 package server
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"os"
@@ -86,6 +88,9 @@ func (s *APIServer) handleOAuthConnect(w http.ResponseWriter, r *http.Request) {
 		}
 		q.Set("scope", strings.Join(config.Scopes, sep))
 	}
+	for k, v := range config.AuthParams {
+		q.Set(k, v)
+	}
 	authURL.RawQuery = q.Encode()
 
 	WriteJSON(w, map[string]string{"url": authURL.String()})
@@ -158,7 +163,7 @@ func (s *APIServer) handleOAuthCallback(w http.ResponseWriter, r *http.Request)
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Accept", "application/json")
 
-	if provider == "fitbit" || provider == "spotify" {
+	if provider == "fitbit" || provider == "spotify" || provider == "komoot" {
 		req.SetBasicAuth(config.ClientID, config.ClientSecret)
 	}
 
@@ -213,6 +218,24 @@ func (s *APIServer) handleOAuthCallback(w http.ResponseWriter, r *http.Request)
 		if uid, ok := tokenResp["user_id"].(string); ok {
 			tokenResp["fitbit_user_id"] = uid
 		}
+	} else if provider == "komoot" {
+		// Komoot identifies the account by username; it is also the path key for tour uploads
+		if username, ok := tokenResp["username"].(string); ok {
+			tokenResp["komoot_user_id"] = username
+		}
+	} else if provider == "dropbox" {
+		if accountID, ok := tokenResp["account_id"].(string); ok {
+			tokenResp["dropbox_user_id"] = accountID
+		}
+	} else if provider == "whoop" {
+		// Whoop's token response carries no account identity, so look it up from the profile
+		if accessToken, ok := tokenResp["access_token"].(string); ok {
+			if uid, err := fetchWhoopUserID(r.Context(), accessToken); err == nil {
+				tokenResp["whoop_user_id"] = uid
+			} else {
+				s.logger.Warn(r.Context(), "failed to resolve whoop user id", "error", err)
+			}
+		}
 	}
 
 	// Create protobuf Struct containing the tokens
@@ -229,5 +252,37 @@ func (s *APIServer) handleOAuthCallback(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	http.Redirect(w, r, webURL()+"/connections/"+provider+"/success", http.StatusFound)
+	successURL := webURL() + "/connections/" + provider + "/success"
+	if pipelineID := s.provisionStarterPipeline(r.Context(), userID); pipelineID != "" {
+		// Lets the web app walk the user through their starter pipeline's test run
+		successURL += "?starterPipeline=" + url.QueryEscape(pipelineID)
+	}
+	http.Redirect(w, r, successURL, http.StatusFound)
+}
+
+// fetchWhoopUserID returns the Whoop member ID used to resolve incoming webhooks.
+func fetchWhoopUserID(ctx context.Context, accessToken string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.prod.whoop.com/developer/v2/user/profile/basic", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("whoop profile request failed with status: %d", resp.StatusCode)
+	}
+
+	var profile struct {
+		UserID int64 `json:"user_id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&profile); err != nil {
+		return "", fmt.Errorf("failed to decode whoop profile: %w", err)
+	}
+	return strconv.FormatInt(profile.UserID, 10), nil
 }
@@ -8,6 +8,8 @@ type OAuthProviderConfig struct {
 	ClientID     string
 	ClientSecret string
 	Scopes       []string
+	// AuthParams are extra provider-specific query parameters for the authorize URL
+	AuthParams map[string]string
 }
 
 func GetOAuthConfig(provider string) *OAuthProviderConfig {
@@ -68,6 +70,33 @@ func GetOAuthConfig(provider string) *OAuthProviderConfig {
 			ClientSecret: os.Getenv("GITHUB_CLIENT_SECRET"),
 			Scopes:       []string{"read:user"},
 		}
+	case "komoot":
+		return &OAuthProviderConfig{
+			AuthURL:      "https://auth-api.main.komoot.net/oauth/authorize",
+			TokenURL:     "https://auth-api.main.komoot.net/oauth/token",
+			ClientID:     os.Getenv("KOMOOT_CLIENT_ID"),
+			ClientSecret: os.Getenv("KOMOOT_CLIENT_SECRET"),
+			Scopes:       []string{"profile", "tour-upload"},
+		}
+	case "dropbox":
+		return &OAuthProviderConfig{
+			AuthURL:      "https://www.dropbox.com/oauth2/authorize",
+			TokenURL:     "https://api.dropboxapi.com/oauth2/token",
+			ClientID:     os.Getenv("DROPBOX_CLIENT_ID"),
+			ClientSecret: os.Getenv("DROPBOX_CLIENT_SECRET"),
+			Scopes:       []string{"files.content.write", "files.content.read"},
+			// Dropbox only issues refresh tokens for offline access
+			AuthParams: map[string]string{"token_access_type": "offline"},
+		}
+	case "whoop":
+		return &OAuthProviderConfig{
+			AuthURL:      "https://api.prod.whoop.com/oauth/oauth2/auth",
+			TokenURL:     "https://api.prod.whoop.com/oauth/oauth2/token",
+			ClientID:     os.Getenv("WHOOP_CLIENT_ID"),
+			ClientSecret: os.Getenv("WHOOP_CLIENT_SECRET"),
+			// offline is required for Whoop to issue a refresh token
+			Scopes: []string{"offline", "read:profile", "read:workout", "read:recovery"},
+		}
 	}
 	return nil
 }
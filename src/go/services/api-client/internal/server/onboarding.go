@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"sort"
+
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// provisionStarterPipeline asks the pipeline service for the user's starter
+// pipeline after they connect an integration, returning its ID if one was
+// created. It is best effort: failures are logged and never fail the
+// connection.
+func (s *APIServer) provisionStarterPipeline(ctx context.Context, userID string) string {
+	if s.pipelineSvc == nil {
+		return ""
+	}
+
+	integrations, err := s.userService.ListIntegrations(ctx, &userpb.ListIntegrationsRequest{UserId: userID})
+	if err != nil {
+		s.logger.Warn(ctx, "failed to list integrations for starter pipeline", "error", err)
+		return ""
+	}
+	connected := connectedIntegrations(integrations)
+	if len(connected) < 2 {
+		return ""
+	}
+
+	res, err := s.pipelineSvc.ProvisionStarterPipeline(ctx, &pipelinepb.ProvisionStarterPipelineRequest{
+		UserId:       userID,
+		Integrations: connected,
+	})
+	if err != nil {
+		s.logger.Warn(ctx, "failed to provision starter pipeline", "error", err)
+		return ""
+	}
+	return res.GetPipeline().GetId()
+}
+
+// connectedIntegrations returns the UserIntegrations field names (e.g.
+// "strava", "apple_health") of the user's enabled integrations, sorted.
+func connectedIntegrations(integrations *pbuser.UserIntegrations) []string {
+	var names []string
+	integrations.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind {
+			return true
+		}
+		enabled := fd.Message().Fields().ByName("enabled")
+		if enabled != nil && v.Message().Get(enabled).Bool() {
+			names = append(names, string(fd.Name()))
+		}
+		return true
+	})
+	sort.Strings(names)
+	return names
+}
@@ -2,12 +2,20 @@
 package server
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
+	pendinginput "github.com/fitglue/server/src/go/pkg/pending_input"
+	"github.com/fitglue/server/src/go/pkg/types/formatters"
+	gatewaypb "github.com/fitglue/server/src/go/pkg/types/pb/gateway"
+	activitym "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	pipelinem "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pluginm "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 )
 
 func (s *APIServer) registerPipelineRoutes(r chi.Router) {
@@ -17,11 +25,38 @@ func (s *APIServer) registerPipelineRoutes(r chi.Router) {
 	r.Put("/users/me/pipelines/{id}", s.handleUpdatePipeline)
 	r.Delete("/users/me/pipelines/{id}", s.handleDeletePipeline)
 
+	r.Put("/users/me/pipelines/{id}/race-mode", s.handleSetRaceMode)
+	r.Delete("/users/me/pipelines/{id}/race-mode", s.handleClearRaceMode)
+
 	r.Get("/users/me/pipelines/{id}/runs", s.handleListPipelineRuns)
 	r.Get("/users/me/pipelines/{id}/runs/{runId}", s.handleGetPipelineRun)
-
+	r.Get("/users/me/pipelines/{id}/runs/{runId}/debug-bundle", s.handleGetPipelineRunDebugBundle)
+	r.Post("/users/me/pipelines/{id}/runs/{runId}/retry", s.handleRetryPipelineRun)
+	r.Get("/users/me/pipelines/{id}/calendar", s.handleGetPipelineCalendar)
+	r.Post("/users/me/pipelines/{id}/preview", s.handlePreviewPipeline)
+	r.Get("/users/me/enricher-usage", s.handleGetEnricherUsage)
+	r.Get("/users/me/enricher-recommendations", s.handleGetEnricherRecommendations)
+
+	r.Post("/users/me/pipelines/{id}/backfill", s.handleStartBackfill)
+	r.Get("/users/me/pipelines/{id}/backfill/{jobId}", s.handleGetBackfillJob)
+	r.Post("/users/me/pipelines/{id}/imports", s.handleCreateImport)
+	r.Put("/users/me/pipelines/{id}/imports/{importId}/files/{index}", s.handleUploadImportFile)
+	r.Post("/users/me/pipelines/{id}/imports/{importId}/start", s.handleStartImport)
+	r.Get("/users/me/pipelines/{id}/imports/{importId}", s.handleGetImport)
+
+	r.Put("/users/me/pause", s.handlePausePipelines)
+	r.Post("/users/me/resume", s.handleResumePipelines)
+
+	r.Get("/users/me/pending-inputs", s.handleListPendingInputs)
 	r.Post("/users/me/pending-inputs/{inputId}/submit", s.handleSubmitInput)
+	r.Post("/users/me/pending-inputs/{inputId}/uploads", s.handleCreatePendingInputUpload)
 	r.Post("/users/me/activities/{id}/repost", s.handleRepostActivity)
+	r.Put("/users/me/activities/{id}/type", s.handleCorrectActivityType)
+	r.Get("/users/me/activities/{id}/description-preview", s.handlePreviewDescriptionMerge)
+
+	r.Get("/users/me/activity-type-rules", s.handleListActivityTypeRules)
+	r.Put("/users/me/activity-type-rules/{id}", s.handleUpdateActivityTypeRule)
+	r.Delete("/users/me/activity-type-rules/{id}", s.handleDeleteActivityTypeRule)
 }
 
 func (s *APIServer) handleListPipelines(w http.ResponseWriter, r *http.Request) {
@@ -140,6 +175,55 @@ func (s *APIServer) handleDeletePipeline(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// handleSetRaceMode replaces a pipeline's race mode config, e.g. to switch
+// enrichers and destination visibility for a race weekend.
+func (s *APIServer) handleSetRaceMode(w http.ResponseWriter, r *http.Request) {
+	var raceMode pipelinem.RaceModeConfig
+	if err := decodeProto(r, &raceMode); err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+	s.writeRaceMode(w, r, &raceMode)
+}
+
+// handleClearRaceMode turns race mode off and drops its config.
+func (s *APIServer) handleClearRaceMode(w http.ResponseWriter, r *http.Request) {
+	s.writeRaceMode(w, r, &pipelinem.RaceModeConfig{})
+}
+
+// writeRaceMode sends the whole existing pipeline back through UpdatePipeline
+// so fields that can't be partially updated (like disabled) are preserved.
+func (s *APIServer) writeRaceMode(w http.ResponseWriter, r *http.Request, raceMode *pipelinem.RaceModeConfig) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	pipelineID := chi.URLParam(r, "id")
+	existing, err := s.pipelineSvc.GetPipeline(r.Context(), &pipelinepb.GetPipelineRequest{
+		UserId:     token.UID,
+		PipelineId: pipelineID,
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	existing.RaceMode = raceMode
+
+	res, err := s.pipelineSvc.UpdatePipeline(r.Context(), &pipelinepb.UpdatePipelineRequest{
+		UserId:     token.UID,
+		PipelineId: pipelineID,
+		Pipeline:   existing,
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
 func (s *APIServer) handleListPipelineRuns(w http.ResponseWriter, r *http.Request) {
 	token := getUserToken(r)
 	if token == nil {
@@ -169,6 +253,9 @@ func (s *APIServer) handleListPipelineRuns(w http.ResponseWriter, r *http.Reques
 		WriteError(w, err)
 		return
 	}
+	for _, run := range res.Runs {
+		stripInternalRunFields(run)
+	}
 
 	WriteJSON(w, res)
 }
@@ -190,10 +277,279 @@ func (s *APIServer) handleGetPipelineRun(w http.ResponseWriter, r *http.Request)
 		WriteError(w, err)
 		return
 	}
+	stripInternalRunFields(res)
+
+	WriteJSON(w, res)
+}
+
+func (s *APIServer) handleGetPipelineRunDebugBundle(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	runID := chi.URLParam(r, "runId")
+	res, err := s.pipelineSvc.GetPipelineRunDebugBundle(r.Context(), &pipelinepb.GetPipelineRunDebugBundleRequest{
+		UserId:     token.UID,
+		RunId:      runID,
+		PipelineId: chi.URLParam(r, "id"),
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	stripInternalRunFields(res.GetRun())
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="fitglue-run-%s.json"`, runID))
+	WriteJSON(w, res)
+}
+
+func (s *APIServer) handleGetPipelineCalendar(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	req := &pipelinepb.GetPipelineCalendarRequest{
+		UserId:     token.UID,
+		PipelineId: chi.URLParam(r, "id"),
+	}
+	if days, err := strconv.Atoi(r.URL.Query().Get("days")); err == nil && days > 0 {
+		req.Days = int32(days)
+	}
+
+	res, err := s.pipelineSvc.GetPipelineCalendar(r.Context(), req)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+// handlePreviewPipeline dry-runs the pipeline's enrichers over the
+// StandardizedActivity in the body and returns what would be posted.
+func (s *APIServer) handlePreviewPipeline(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var activity activitym.StandardizedActivity
+	if err := decodeProto(r, &activity); err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+
+	res, err := s.pipelineSvc.PreviewPipeline(r.Context(), &pipelinepb.PreviewPipelineRequest{
+		UserId:     token.UID,
+		PipelineId: chi.URLParam(r, "id"),
+		Activity:   &activity,
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
 
 	WriteJSON(w, res)
 }
 
+// handlePausePipelines pauses one pipeline, or all of them (vacation mode)
+// when the body has no pipeline_id. Omitting paused_until unpauses.
+func (s *APIServer) handlePausePipelines(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var reqBody pipelinepb.PausePipelinesRequest
+	if err := decodeProto(r, &reqBody); err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+	reqBody.UserId = token.UID
+
+	if _, err := s.pipelineSvc.PausePipelines(r.Context(), &reqBody); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleResumePipelines ends a pause and releases (or, with discard, drops)
+// the activities deferred while it was active.
+func (s *APIServer) handleResumePipelines(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var reqBody pipelinepb.ResumePipelinesRequest
+	if err := decodeProto(r, &reqBody); err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+	reqBody.UserId = token.UID
+
+	res, err := s.pipelineSvc.ResumePipelines(r.Context(), &reqBody)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+// handleCorrectActivityType changes an activity's type and teaches the
+// pipeline to apply the same correction to similar activities.
+func (s *APIServer) handleCorrectActivityType(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var reqBody pipelinepb.CorrectActivityTypeRequest
+	if err := decodeProto(r, &reqBody); err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+	reqBody.UserId = token.UID
+	reqBody.ActivityId = chi.URLParam(r, "id")
+
+	res, err := s.pipelineSvc.CorrectActivityType(r.Context(), &reqBody)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+func (s *APIServer) handleListActivityTypeRules(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	res, err := s.pipelineSvc.ListActivityTypeRules(r.Context(), &pipelinepb.ListActivityTypeRulesRequest{
+		UserId: token.UID,
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+func (s *APIServer) handleUpdateActivityTypeRule(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var reqBody pipelinepb.UpdateActivityTypeRuleRequest
+	if err := decodeProto(r, &reqBody); err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+	reqBody.UserId = token.UID
+	reqBody.RuleId = chi.URLParam(r, "id")
+
+	res, err := s.pipelineSvc.UpdateActivityTypeRule(r.Context(), &reqBody)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+func (s *APIServer) handleDeleteActivityTypeRule(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	if _, err := s.pipelineSvc.DeleteActivityTypeRule(r.Context(), &pipelinepb.DeleteActivityTypeRuleRequest{
+		UserId: token.UID,
+		RuleId: chi.URLParam(r, "id"),
+	}); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *APIServer) handleGetEnricherUsage(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	req := &pipelinepb.GetEnricherUsageRequest{
+		UserId:     token.UID,
+		PipelineId: r.URL.Query().Get("pipeline_id"),
+	}
+	if maxRuns, err := strconv.Atoi(r.URL.Query().Get("max_runs")); err == nil && maxRuns > 0 {
+		req.MaxRuns = int32(maxRuns)
+	}
+
+	res, err := s.pipelineSvc.GetEnricherUsage(r.Context(), req)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+func (s *APIServer) handleGetEnricherRecommendations(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	res, err := s.pipelineSvc.GetEnricherRecommendations(r.Context(), &pipelinepb.GetEnricherRecommendationsRequest{UserId: token.UID})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+// handleListPendingInputs lists the activities waiting on the user for
+// input, newest first.
+func (s *APIServer) handleListPendingInputs(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	res, err := s.pipelineSvc.ListPendingInputs(r.Context(), &pipelinepb.ListPendingInputsRequest{UserId: token.UID})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, &gatewaypb.ListPendingInputsGatewayResponse{Inputs: res.Inputs})
+}
+
 func (s *APIServer) handleSubmitInput(w http.ResponseWriter, r *http.Request) {
 	token := getUserToken(r)
 	if token == nil {
@@ -219,6 +575,67 @@ func (s *APIServer) handleSubmitInput(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// pendingUploadTypes are the file types a pending input upload field takes,
+// with the extension each is stored under
+var pendingUploadTypes = map[string]string{
+	"image/jpeg": "jpg",
+}
+
+const (
+	pendingUploadMaxBytes = 20 * 1024 * 1024
+	pendingUploadExpiry   = 15 * time.Minute
+)
+
+// handleCreatePendingInputUpload signs a URL for the browser to upload one
+// file for a pending input straight to the artifacts bucket. Uploads are
+// scoped to the user and input, and the bucket's lifecycle rule removes any
+// that are never submitted.
+func (s *APIServer) handleCreatePendingInputUpload(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var reqBody gatewaypb.CreatePendingInputUploadGatewayRequest
+	if r.ContentLength != 0 {
+		if err := decodeProto(r, &reqBody); err != nil {
+			WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+			return
+		}
+	}
+	contentType := reqBody.ContentType
+	if contentType == "" {
+		contentType = "image/jpeg"
+	}
+	ext, ok := pendingUploadTypes[contentType]
+	if !ok {
+		WriteError(w, statusError(http.StatusBadRequest, "content_type must be image/jpeg"))
+		return
+	}
+
+	inputID := chi.URLParam(r, "inputId")
+	if inputID == "" {
+		WriteError(w, statusError(http.StatusBadRequest, "input id is required"))
+		return
+	}
+	object := pendinginput.UploadPrefix(token.UID, inputID) + uuid.NewString() + "." + ext
+
+	uploadURL, err := s.uploads.SignedURL(r.Context(), s.uploadBucket, object, contentType, pendingUploadMaxBytes, pendingUploadExpiry)
+	if err != nil {
+		s.logger.Error(r.Context(), "failed to sign pending input upload URL", "error", err)
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to generate upload URL"))
+		return
+	}
+
+	WriteJSON(w, &gatewaypb.CreatePendingInputUploadGatewayResponse{
+		UploadUrl:    uploadURL,
+		ObjectRef:    object,
+		ContentType:  contentType,
+		MaxSizeBytes: pendingUploadMaxBytes,
+	})
+}
+
 func (s *APIServer) handleRepostActivity(w http.ResponseWriter, r *http.Request) {
 	token := getUserToken(r)
 	if token == nil {
@@ -239,3 +656,68 @@ func (s *APIServer) handleRepostActivity(w http.ResponseWriter, r *http.Request)
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// handleRetryPipelineRun re-runs a pipeline run from its original payload,
+// limited to the enrichers in the body when it lists any.
+func (s *APIServer) handleRetryPipelineRun(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var reqBody pipelinepb.RetryPipelineRunRequest
+	if r.ContentLength != 0 {
+		if err := decodeProto(r, &reqBody); err != nil {
+			WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+			return
+		}
+	}
+
+	reqBody.UserId = token.UID
+	reqBody.PipelineId = chi.URLParam(r, "id")
+	reqBody.PipelineRunId = chi.URLParam(r, "runId")
+
+	if _, err := s.pipelineSvc.RetryPipelineRun(r.Context(), &reqBody); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handlePreviewDescriptionMerge shows how an update would change the
+// activity's description on the destination given by the destination query
+// parameter.
+func (s *APIServer) handlePreviewDescriptionMerge(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	destination := formatters.ParseDestination(r.URL.Query().Get("destination"))
+	if destination == pluginm.DestinationType_DESTINATION_UNSPECIFIED {
+		WriteError(w, statusError(http.StatusBadRequest, "destination is required"))
+		return
+	}
+
+	res, err := s.pipelineSvc.PreviewDescriptionMerge(r.Context(), &pipelinepb.PreviewDescriptionMergeRequest{
+		UserId:      token.UID,
+		ActivityId:  chi.URLParam(r, "id"),
+		Destination: destination,
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+// stripInternalRunFields clears run fields kept for internal analysis only.
+func stripInternalRunFields(run *pipelinem.PipelineRun) {
+	if run != nil {
+		run.Cost = nil
+	}
+}
@@ -8,12 +8,15 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-chi/chi/v5"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
@@ -25,15 +28,28 @@ import (
 // =============================================================
 
 type mockPipelineServiceClient struct {
-	listPipelines    func(ctx context.Context, in *pipelinepb.ListPipelinesRequest, opts ...grpc.CallOption) (*pipelinepb.ListPipelinesResponse, error)
-	getPipeline      func(ctx context.Context, in *pipelinepb.GetPipelineRequest, opts ...grpc.CallOption) (*pbpipeline.PipelineConfig, error)
-	createPipeline   func(ctx context.Context, in *pipelinepb.CreatePipelineRequest, opts ...grpc.CallOption) (*pbpipeline.PipelineConfig, error)
-	updatePipeline   func(ctx context.Context, in *pipelinepb.UpdatePipelineRequest, opts ...grpc.CallOption) (*pbpipeline.PipelineConfig, error)
-	deletePipeline   func(ctx context.Context, in *pipelinepb.DeletePipelineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	listPipelineRuns func(ctx context.Context, in *pipelinepb.ListPipelineRunsRequest, opts ...grpc.CallOption) (*pipelinepb.ListPipelineRunsResponse, error)
-	getPipelineRun   func(ctx context.Context, in *pipelinepb.GetPipelineRunRequest, opts ...grpc.CallOption) (*pbpipeline.PipelineRun, error)
-	submitInput      func(ctx context.Context, in *pipelinepb.SubmitInputRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	repostActivity   func(ctx context.Context, in *pipelinepb.RepostActivityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	listPipelines              func(ctx context.Context, in *pipelinepb.ListPipelinesRequest, opts ...grpc.CallOption) (*pipelinepb.ListPipelinesResponse, error)
+	getPipeline                func(ctx context.Context, in *pipelinepb.GetPipelineRequest, opts ...grpc.CallOption) (*pbpipeline.PipelineConfig, error)
+	createPipeline             func(ctx context.Context, in *pipelinepb.CreatePipelineRequest, opts ...grpc.CallOption) (*pbpipeline.PipelineConfig, error)
+	updatePipeline             func(ctx context.Context, in *pipelinepb.UpdatePipelineRequest, opts ...grpc.CallOption) (*pbpipeline.PipelineConfig, error)
+	deletePipeline             func(ctx context.Context, in *pipelinepb.DeletePipelineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	provisionStarterPipeline   func(ctx context.Context, in *pipelinepb.ProvisionStarterPipelineRequest, opts ...grpc.CallOption) (*pipelinepb.ProvisionStarterPipelineResponse, error)
+	listPipelineRuns           func(ctx context.Context, in *pipelinepb.ListPipelineRunsRequest, opts ...grpc.CallOption) (*pipelinepb.ListPipelineRunsResponse, error)
+	getPipelineRun             func(ctx context.Context, in *pipelinepb.GetPipelineRunRequest, opts ...grpc.CallOption) (*pbpipeline.PipelineRun, error)
+	getDebugBundle             func(ctx context.Context, in *pipelinepb.GetPipelineRunDebugBundleRequest, opts ...grpc.CallOption) (*pbpipeline.PipelineRunDebugBundle, error)
+	getPipelineCalendar        func(ctx context.Context, in *pipelinepb.GetPipelineCalendarRequest, opts ...grpc.CallOption) (*pipelinepb.GetPipelineCalendarResponse, error)
+	previewPipeline            func(ctx context.Context, in *pipelinepb.PreviewPipelineRequest, opts ...grpc.CallOption) (*pbpipeline.PipelinePreview, error)
+	previewDescriptionMerge    func(ctx context.Context, in *pipelinepb.PreviewDescriptionMergeRequest, opts ...grpc.CallOption) (*pbpipeline.DescriptionMergePreview, error)
+	pausePipelines             func(ctx context.Context, in *pipelinepb.PausePipelinesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	resumePipelines            func(ctx context.Context, in *pipelinepb.ResumePipelinesRequest, opts ...grpc.CallOption) (*pipelinepb.ResumePipelinesResponse, error)
+	getEnricherUsage           func(ctx context.Context, in *pipelinepb.GetEnricherUsageRequest, opts ...grpc.CallOption) (*pipelinepb.GetEnricherUsageResponse, error)
+	getEnricherRecommendations func(ctx context.Context, in *pipelinepb.GetEnricherRecommendationsRequest, opts ...grpc.CallOption) (*pbpipeline.EnricherRecommendations, error)
+	listPendingInputs          func(ctx context.Context, in *pipelinepb.ListPendingInputsRequest, opts ...grpc.CallOption) (*pipelinepb.ListPendingInputsResponse, error)
+	submitInput                func(ctx context.Context, in *pipelinepb.SubmitInputRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	repostActivity             func(ctx context.Context, in *pipelinepb.RepostActivityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	retryPipelineRun           func(ctx context.Context, in *pipelinepb.RetryPipelineRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	correctActivityType        func(ctx context.Context, in *pipelinepb.CorrectActivityTypeRequest, opts ...grpc.CallOption) (*pipelinepb.CorrectActivityTypeResponse, error)
+	updateActivityTypeRule     func(ctx context.Context, in *pipelinepb.UpdateActivityTypeRuleRequest, opts ...grpc.CallOption) (*pbpipeline.ActivityTypeRule, error)
 }
 
 func (m *mockPipelineServiceClient) ListPipelines(ctx context.Context, in *pipelinepb.ListPipelinesRequest, opts ...grpc.CallOption) (*pipelinepb.ListPipelinesResponse, error) {
@@ -66,6 +82,12 @@ func (m *mockPipelineServiceClient) DeletePipeline(ctx context.Context, in *pipe
 	}
 	return &emptypb.Empty{}, nil
 }
+func (m *mockPipelineServiceClient) ProvisionStarterPipeline(ctx context.Context, in *pipelinepb.ProvisionStarterPipelineRequest, opts ...grpc.CallOption) (*pipelinepb.ProvisionStarterPipelineResponse, error) {
+	if m.provisionStarterPipeline != nil {
+		return m.provisionStarterPipeline(ctx, in, opts...)
+	}
+	return &pipelinepb.ProvisionStarterPipelineResponse{}, nil
+}
 func (m *mockPipelineServiceClient) SubmitInput(ctx context.Context, in *pipelinepb.SubmitInputRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	if m.submitInput != nil {
 		return m.submitInput(ctx, in, opts...)
@@ -73,6 +95,9 @@ func (m *mockPipelineServiceClient) SubmitInput(ctx context.Context, in *pipelin
 	return &emptypb.Empty{}, nil
 }
 func (m *mockPipelineServiceClient) ListPendingInputs(ctx context.Context, in *pipelinepb.ListPendingInputsRequest, opts ...grpc.CallOption) (*pipelinepb.ListPendingInputsResponse, error) {
+	if m.listPendingInputs != nil {
+		return m.listPendingInputs(ctx, in, opts...)
+	}
 	return &pipelinepb.ListPendingInputsResponse{}, nil
 }
 func (m *mockPipelineServiceClient) ResolvePendingInput(ctx context.Context, in *pipelinepb.ResolvePendingInputRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
@@ -84,12 +109,86 @@ func (m *mockPipelineServiceClient) RepostActivity(ctx context.Context, in *pipe
 	}
 	return &emptypb.Empty{}, nil
 }
+func (m *mockPipelineServiceClient) RetryPipelineRun(ctx context.Context, in *pipelinepb.RetryPipelineRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if m.retryPipelineRun != nil {
+		return m.retryPipelineRun(ctx, in, opts...)
+	}
+	return &emptypb.Empty{}, nil
+}
 func (m *mockPipelineServiceClient) GetPipelineRun(ctx context.Context, in *pipelinepb.GetPipelineRunRequest, opts ...grpc.CallOption) (*pbpipeline.PipelineRun, error) {
 	if m.getPipelineRun != nil {
 		return m.getPipelineRun(ctx, in, opts...)
 	}
 	return &pbpipeline.PipelineRun{}, nil
 }
+func (m *mockPipelineServiceClient) GetPipelineRunDebugBundle(ctx context.Context, in *pipelinepb.GetPipelineRunDebugBundleRequest, opts ...grpc.CallOption) (*pbpipeline.PipelineRunDebugBundle, error) {
+	if m.getDebugBundle != nil {
+		return m.getDebugBundle(ctx, in, opts...)
+	}
+	return &pbpipeline.PipelineRunDebugBundle{}, nil
+}
+func (m *mockPipelineServiceClient) GetPipelineCalendar(ctx context.Context, in *pipelinepb.GetPipelineCalendarRequest, opts ...grpc.CallOption) (*pipelinepb.GetPipelineCalendarResponse, error) {
+	if m.getPipelineCalendar != nil {
+		return m.getPipelineCalendar(ctx, in, opts...)
+	}
+	return &pipelinepb.GetPipelineCalendarResponse{}, nil
+}
+func (m *mockPipelineServiceClient) PreviewPipeline(ctx context.Context, in *pipelinepb.PreviewPipelineRequest, opts ...grpc.CallOption) (*pbpipeline.PipelinePreview, error) {
+	if m.previewPipeline != nil {
+		return m.previewPipeline(ctx, in, opts...)
+	}
+	return &pbpipeline.PipelinePreview{}, nil
+}
+func (m *mockPipelineServiceClient) PreviewDescriptionMerge(ctx context.Context, in *pipelinepb.PreviewDescriptionMergeRequest, opts ...grpc.CallOption) (*pbpipeline.DescriptionMergePreview, error) {
+	if m.previewDescriptionMerge != nil {
+		return m.previewDescriptionMerge(ctx, in, opts...)
+	}
+	return &pbpipeline.DescriptionMergePreview{}, nil
+}
+func (m *mockPipelineServiceClient) PausePipelines(ctx context.Context, in *pipelinepb.PausePipelinesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if m.pausePipelines != nil {
+		return m.pausePipelines(ctx, in, opts...)
+	}
+	return &emptypb.Empty{}, nil
+}
+func (m *mockPipelineServiceClient) ResumePipelines(ctx context.Context, in *pipelinepb.ResumePipelinesRequest, opts ...grpc.CallOption) (*pipelinepb.ResumePipelinesResponse, error) {
+	if m.resumePipelines != nil {
+		return m.resumePipelines(ctx, in, opts...)
+	}
+	return &pipelinepb.ResumePipelinesResponse{}, nil
+}
+func (m *mockPipelineServiceClient) CorrectActivityType(ctx context.Context, in *pipelinepb.CorrectActivityTypeRequest, opts ...grpc.CallOption) (*pipelinepb.CorrectActivityTypeResponse, error) {
+	if m.correctActivityType != nil {
+		return m.correctActivityType(ctx, in, opts...)
+	}
+	return &pipelinepb.CorrectActivityTypeResponse{}, nil
+}
+func (m *mockPipelineServiceClient) ListActivityTypeRules(ctx context.Context, in *pipelinepb.ListActivityTypeRulesRequest, opts ...grpc.CallOption) (*pipelinepb.ListActivityTypeRulesResponse, error) {
+	return &pipelinepb.ListActivityTypeRulesResponse{}, nil
+}
+func (m *mockPipelineServiceClient) UpdateActivityTypeRule(ctx context.Context, in *pipelinepb.UpdateActivityTypeRuleRequest, opts ...grpc.CallOption) (*pbpipeline.ActivityTypeRule, error) {
+	if m.updateActivityTypeRule != nil {
+		return m.updateActivityTypeRule(ctx, in, opts...)
+	}
+	return &pbpipeline.ActivityTypeRule{}, nil
+}
+func (m *mockPipelineServiceClient) DeleteActivityTypeRule(ctx context.Context, in *pipelinepb.DeleteActivityTypeRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (m *mockPipelineServiceClient) GetEnricherUsage(ctx context.Context, in *pipelinepb.GetEnricherUsageRequest, opts ...grpc.CallOption) (*pipelinepb.GetEnricherUsageResponse, error) {
+	if m.getEnricherUsage != nil {
+		return m.getEnricherUsage(ctx, in, opts...)
+	}
+	return &pipelinepb.GetEnricherUsageResponse{}, nil
+}
+
+func (m *mockPipelineServiceClient) GetEnricherRecommendations(ctx context.Context, in *pipelinepb.GetEnricherRecommendationsRequest, opts ...grpc.CallOption) (*pbpipeline.EnricherRecommendations, error) {
+	if m.getEnricherRecommendations != nil {
+		return m.getEnricherRecommendations(ctx, in, opts...)
+	}
+	return &pbpipeline.EnricherRecommendations{}, nil
+}
 func (m *mockPipelineServiceClient) ListPipelineRuns(ctx context.Context, in *pipelinepb.ListPipelineRunsRequest, opts ...grpc.CallOption) (*pipelinepb.ListPipelineRunsResponse, error) {
 	if m.listPipelineRuns != nil {
 		return m.listPipelineRuns(ctx, in, opts...)
@@ -99,6 +198,24 @@ func (m *mockPipelineServiceClient) ListPipelineRuns(ctx context.Context, in *pi
 func (m *mockPipelineServiceClient) AdminListPipelineRuns(ctx context.Context, in *pipelinepb.AdminListPipelineRunsRequest, opts ...grpc.CallOption) (*pipelinepb.AdminListPipelineRunsResponse, error) {
 	return &pipelinepb.AdminListPipelineRunsResponse{}, nil
 }
+func (m *mockPipelineServiceClient) AdminForceCompletePipelineRun(ctx context.Context, in *pipelinepb.AdminForceCompletePipelineRunRequest, opts ...grpc.CallOption) (*pbpipeline.PipelineRun, error) {
+	return nil, nil
+}
+func (m *mockPipelineServiceClient) AdminListProviderCircuits(ctx context.Context, in *pipelinepb.AdminListProviderCircuitsRequest, opts ...grpc.CallOption) (*pipelinepb.AdminListProviderCircuitsResponse, error) {
+	return &pipelinepb.AdminListProviderCircuitsResponse{}, nil
+}
+func (m *mockPipelineServiceClient) AdminSetProviderCircuitMode(ctx context.Context, in *pipelinepb.AdminSetProviderCircuitModeRequest, opts ...grpc.CallOption) (*pbpipeline.ProviderCircuit, error) {
+	return &pbpipeline.ProviderCircuit{}, nil
+}
+func (m *mockPipelineServiceClient) AdminListProviderCircuitChanges(ctx context.Context, in *pipelinepb.AdminListProviderCircuitChangesRequest, opts ...grpc.CallOption) (*pipelinepb.AdminListProviderCircuitChangesResponse, error) {
+	return &pipelinepb.AdminListProviderCircuitChangesResponse{}, nil
+}
+func (m *mockPipelineServiceClient) AdminListFailedEvents(ctx context.Context, in *pipelinepb.AdminListFailedEventsRequest, opts ...grpc.CallOption) (*pipelinepb.AdminListFailedEventsResponse, error) {
+	return &pipelinepb.AdminListFailedEventsResponse{}, nil
+}
+func (m *mockPipelineServiceClient) AdminRedriveFailedEvents(ctx context.Context, in *pipelinepb.AdminRedriveFailedEventsRequest, opts ...grpc.CallOption) (*pipelinepb.AdminRedriveFailedEventsResponse, error) {
+	return &pipelinepb.AdminRedriveFailedEventsResponse{}, nil
+}
 
 // =============================================================
 // Mock RegistryServiceClient
@@ -285,6 +402,77 @@ func TestHandleDeletePipeline_NoToken(t *testing.T) {
 	}
 }
 
+func TestHandleSetRaceMode_PreservesPipeline(t *testing.T) {
+	var updated *pbpipeline.PipelineConfig
+	s := buildPipelineServer(&mockPipelineServiceClient{
+		getPipeline: func(_ context.Context, in *pipelinepb.GetPipelineRequest, _ ...grpc.CallOption) (*pbpipeline.PipelineConfig, error) {
+			return &pbpipeline.PipelineConfig{Id: in.PipelineId, Name: "Runs", Disabled: true}, nil
+		},
+		updatePipeline: func(_ context.Context, in *pipelinepb.UpdatePipelineRequest, _ ...grpc.CallOption) (*pbpipeline.PipelineConfig, error) {
+			updated = in.Pipeline
+			return in.Pipeline, nil
+		},
+	})
+	body := `{"enabled": true, "startsAt": "2026-04-25T00:00:00Z", "endsAt": "2026-04-27T00:00:00Z", "skipBranding": true}`
+	r := httptest.NewRequest(http.MethodPut, "/api/v2/users/me/pipelines/pipe1/race-mode", strings.NewReader(body))
+	r = withBackfillParams(withToken(r, "user1"), "pipe1", "")
+	w := httptest.NewRecorder()
+	s.handleSetRaceMode(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if updated == nil || !updated.GetRaceMode().GetEnabled() || !updated.RaceMode.SkipBranding {
+		t.Fatalf("expected race mode to be sent to UpdatePipeline, got %+v", updated)
+	}
+	if !updated.Disabled || updated.Name != "Runs" {
+		t.Errorf("expected the rest of the pipeline to be preserved, got %+v", updated)
+	}
+}
+
+func TestHandleSetRaceMode_InvalidJSON(t *testing.T) {
+	s := buildPipelineServer(&mockPipelineServiceClient{})
+	r := httptest.NewRequest(http.MethodPut, "/api/v2/users/me/pipelines/pipe1/race-mode", strings.NewReader("bad"))
+	r = withToken(r, "user1")
+	w := httptest.NewRecorder()
+	s.handleSetRaceMode(w, r)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", w.Code)
+	}
+}
+
+func TestHandleClearRaceMode(t *testing.T) {
+	var updated *pbpipeline.PipelineConfig
+	s := buildPipelineServer(&mockPipelineServiceClient{
+		getPipeline: func(_ context.Context, in *pipelinepb.GetPipelineRequest, _ ...grpc.CallOption) (*pbpipeline.PipelineConfig, error) {
+			return &pbpipeline.PipelineConfig{Id: in.PipelineId, RaceMode: &pbpipeline.RaceModeConfig{Enabled: true}}, nil
+		},
+		updatePipeline: func(_ context.Context, in *pipelinepb.UpdatePipelineRequest, _ ...grpc.CallOption) (*pbpipeline.PipelineConfig, error) {
+			updated = in.Pipeline
+			return in.Pipeline, nil
+		},
+	})
+	r := httptest.NewRequest(http.MethodDelete, "/api/v2/users/me/pipelines/pipe1/race-mode", nil)
+	r = withBackfillParams(withToken(r, "user1"), "pipe1", "")
+	w := httptest.NewRecorder()
+	s.handleClearRaceMode(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if updated == nil || updated.RaceMode == nil || updated.RaceMode.Enabled {
+		t.Errorf("expected race mode to be cleared, got %+v", updated)
+	}
+}
+
+func TestHandleClearRaceMode_NoToken(t *testing.T) {
+	s := buildPipelineServer(&mockPipelineServiceClient{})
+	r := httptest.NewRequest(http.MethodDelete, "/api/v2/users/me/pipelines/pipe1/race-mode", nil)
+	w := httptest.NewRecorder()
+	s.handleClearRaceMode(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %d", w.Code)
+	}
+}
+
 func TestHandleListPipelineRuns_Success(t *testing.T) {
 	s := buildPipelineServer(&mockPipelineServiceClient{})
 	r := httptest.NewRequest(http.MethodGet, "/api/v2/users/me/pipelines/pipe1/runs?limit=10", nil)
@@ -317,6 +505,254 @@ func TestHandleGetPipelineRun_Success(t *testing.T) {
 	}
 }
 
+func TestHandleGetPipelineRunDebugBundle_Success(t *testing.T) {
+	var gotReq *pipelinepb.GetPipelineRunDebugBundleRequest
+	s := buildPipelineServer(&mockPipelineServiceClient{
+		getDebugBundle: func(ctx context.Context, in *pipelinepb.GetPipelineRunDebugBundleRequest, opts ...grpc.CallOption) (*pbpipeline.PipelineRunDebugBundle, error) {
+			gotReq = in
+			return &pbpipeline.PipelineRunDebugBundle{Run: &pbpipeline.PipelineRun{Id: in.RunId}}, nil
+		},
+	})
+	r := httptest.NewRequest(http.MethodGet, "/api/v2/users/me/pipelines/pipe1/runs/run1/debug-bundle", nil)
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", "pipe1")
+	rctx.URLParams.Add("runId", "run1")
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	r = withToken(r, "user1")
+	w := httptest.NewRecorder()
+	s.handleGetPipelineRunDebugBundle(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if gotReq.GetUserId() != "user1" || gotReq.GetRunId() != "run1" || gotReq.GetPipelineId() != "pipe1" {
+		t.Errorf("unexpected request: %v", gotReq)
+	}
+	if cd := w.Header().Get("Content-Disposition"); cd != `attachment; filename="fitglue-run-run1.json"` {
+		t.Errorf("unexpected Content-Disposition: %q", cd)
+	}
+}
+
+func TestHandleGetPipelineRunDebugBundle_NoToken(t *testing.T) {
+	s := buildPipelineServer(&mockPipelineServiceClient{})
+	r := httptest.NewRequest(http.MethodGet, "/api/v2/users/me/pipelines/pipe1/runs/run1/debug-bundle", nil)
+	w := httptest.NewRecorder()
+	s.handleGetPipelineRunDebugBundle(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %d", w.Code)
+	}
+}
+
+func TestHandleGetPipelineCalendar_Success(t *testing.T) {
+	var gotReq *pipelinepb.GetPipelineCalendarRequest
+	s := buildPipelineServer(&mockPipelineServiceClient{
+		getPipelineCalendar: func(ctx context.Context, in *pipelinepb.GetPipelineCalendarRequest, opts ...grpc.CallOption) (*pipelinepb.GetPipelineCalendarResponse, error) {
+			gotReq = in
+			return &pipelinepb.GetPipelineCalendarResponse{
+				Days: []*pbpipeline.PipelineCalendarDay{{Date: "2026-05-01", Total: 2, Synced: 2}},
+			}, nil
+		},
+	})
+	r := httptest.NewRequest(http.MethodGet, "/api/v2/users/me/pipelines/pipe1/calendar?days=90", nil)
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", "pipe1")
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	r = withToken(r, "user1")
+	w := httptest.NewRecorder()
+	s.handleGetPipelineCalendar(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if gotReq.GetUserId() != "user1" || gotReq.GetPipelineId() != "pipe1" || gotReq.GetDays() != 90 {
+		t.Errorf("unexpected request: %v", gotReq)
+	}
+}
+
+func TestHandleGetPipelineCalendar_NoToken(t *testing.T) {
+	s := buildPipelineServer(&mockPipelineServiceClient{})
+	r := httptest.NewRequest(http.MethodGet, "/api/v2/users/me/pipelines/pipe1/calendar", nil)
+	w := httptest.NewRecorder()
+	s.handleGetPipelineCalendar(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %d", w.Code)
+	}
+}
+
+func TestHandlePausePipelines_Success(t *testing.T) {
+	var gotReq *pipelinepb.PausePipelinesRequest
+	s := buildPipelineServer(&mockPipelineServiceClient{
+		pausePipelines: func(ctx context.Context, in *pipelinepb.PausePipelinesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+			gotReq = in
+			return &emptypb.Empty{}, nil
+		},
+	})
+	body := `{"pipeline_id":"pipe1","paused_until":"2026-08-01T00:00:00Z"}`
+	r := httptest.NewRequest(http.MethodPut, "/api/v2/users/me/pause", strings.NewReader(body))
+	r = withToken(r, "user1")
+	w := httptest.NewRecorder()
+	s.handlePausePipelines(w, r)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", w.Code)
+	}
+	if gotReq.GetUserId() != "user1" || gotReq.GetPipelineId() != "pipe1" || gotReq.GetPausedUntil().AsTime().Format(time.DateOnly) != "2026-08-01" {
+		t.Errorf("unexpected request: %v", gotReq)
+	}
+}
+
+func TestHandleResumePipelines_Success(t *testing.T) {
+	var gotReq *pipelinepb.ResumePipelinesRequest
+	s := buildPipelineServer(&mockPipelineServiceClient{
+		resumePipelines: func(ctx context.Context, in *pipelinepb.ResumePipelinesRequest, opts ...grpc.CallOption) (*pipelinepb.ResumePipelinesResponse, error) {
+			gotReq = in
+			return &pipelinepb.ResumePipelinesResponse{Discarded: 3}, nil
+		},
+	})
+	r := httptest.NewRequest(http.MethodPost, "/api/v2/users/me/resume", strings.NewReader(`{"discard":true}`))
+	r = withToken(r, "user1")
+	w := httptest.NewRecorder()
+	s.handleResumePipelines(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if gotReq.GetUserId() != "user1" || !gotReq.GetDiscard() {
+		t.Errorf("unexpected request: %v", gotReq)
+	}
+}
+
+func TestHandleResumePipelines_NoToken(t *testing.T) {
+	s := buildPipelineServer(&mockPipelineServiceClient{})
+	r := httptest.NewRequest(http.MethodPost, "/api/v2/users/me/resume", strings.NewReader(`{}`))
+	w := httptest.NewRecorder()
+	s.handleResumePipelines(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %d", w.Code)
+	}
+}
+
+func TestHandleCorrectActivityType_Success(t *testing.T) {
+	var gotReq *pipelinepb.CorrectActivityTypeRequest
+	s := buildPipelineServer(&mockPipelineServiceClient{
+		correctActivityType: func(ctx context.Context, in *pipelinepb.CorrectActivityTypeRequest, opts ...grpc.CallOption) (*pipelinepb.CorrectActivityTypeResponse, error) {
+			gotReq = in
+			return &pipelinepb.CorrectActivityTypeResponse{Rule: &pbpipeline.ActivityTypeRule{Id: "r1", CorrectionCount: 2}}, nil
+		},
+	})
+	r := httptest.NewRequest(http.MethodPut, "/api/v2/users/me/activities/act1/type", strings.NewReader(`{"activity_type":"ACTIVITY_TYPE_CROSSFIT"}`))
+	r = withToken(r, "user1")
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", "act1")
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	w := httptest.NewRecorder()
+	s.handleCorrectActivityType(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if gotReq.GetUserId() != "user1" || gotReq.GetActivityId() != "act1" || gotReq.GetActivityType() != pbactivity.ActivityType_ACTIVITY_TYPE_CROSSFIT {
+		t.Errorf("unexpected request: %v", gotReq)
+	}
+}
+
+func TestHandleUpdateActivityTypeRule_Success(t *testing.T) {
+	var gotReq *pipelinepb.UpdateActivityTypeRuleRequest
+	s := buildPipelineServer(&mockPipelineServiceClient{
+		updateActivityTypeRule: func(ctx context.Context, in *pipelinepb.UpdateActivityTypeRuleRequest, opts ...grpc.CallOption) (*pbpipeline.ActivityTypeRule, error) {
+			gotReq = in
+			return &pbpipeline.ActivityTypeRule{Id: in.RuleId, Disabled: in.Disabled}, nil
+		},
+	})
+	r := httptest.NewRequest(http.MethodPut, "/api/v2/users/me/activity-type-rules/r1", strings.NewReader(`{"disabled":true}`))
+	r = withToken(r, "user1")
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", "r1")
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	w := httptest.NewRecorder()
+	s.handleUpdateActivityTypeRule(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if gotReq.GetUserId() != "user1" || gotReq.GetRuleId() != "r1" || !gotReq.GetDisabled() {
+		t.Errorf("unexpected request: %v", gotReq)
+	}
+}
+
+func TestHandleDeleteActivityTypeRule_NoToken(t *testing.T) {
+	s := buildPipelineServer(&mockPipelineServiceClient{})
+	r := httptest.NewRequest(http.MethodDelete, "/api/v2/users/me/activity-type-rules/r1", nil)
+	w := httptest.NewRecorder()
+	s.handleDeleteActivityTypeRule(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %d", w.Code)
+	}
+}
+
+func TestHandleGetEnricherUsage_Success(t *testing.T) {
+	var gotReq *pipelinepb.GetEnricherUsageRequest
+	s := buildPipelineServer(&mockPipelineServiceClient{
+		getEnricherUsage: func(ctx context.Context, in *pipelinepb.GetEnricherUsageRequest, opts ...grpc.CallOption) (*pipelinepb.GetEnricherUsageResponse, error) {
+			gotReq = in
+			return &pipelinepb.GetEnricherUsageResponse{
+				Enrichers:    []*pbpipeline.EnricherUsage{{ProviderName: "weather", Uses: 42}},
+				RunsAnalyzed: 42,
+			}, nil
+		},
+	})
+	r := httptest.NewRequest(http.MethodGet, "/api/v2/users/me/enricher-usage?pipeline_id=pipe1&max_runs=100", nil)
+	r = withToken(r, "user1")
+	w := httptest.NewRecorder()
+	s.handleGetEnricherUsage(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if gotReq.GetUserId() != "user1" || gotReq.GetPipelineId() != "pipe1" || gotReq.GetMaxRuns() != 100 {
+		t.Errorf("unexpected request: %v", gotReq)
+	}
+}
+
+func TestHandleGetEnricherUsage_NoToken(t *testing.T) {
+	s := buildPipelineServer(&mockPipelineServiceClient{})
+	r := httptest.NewRequest(http.MethodGet, "/api/v2/users/me/enricher-usage", nil)
+	w := httptest.NewRecorder()
+	s.handleGetEnricherUsage(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %d", w.Code)
+	}
+}
+
+func TestHandleGetEnricherRecommendations_Success(t *testing.T) {
+	var gotReq *pipelinepb.GetEnricherRecommendationsRequest
+	s := buildPipelineServer(&mockPipelineServiceClient{
+		getEnricherRecommendations: func(ctx context.Context, in *pipelinepb.GetEnricherRecommendationsRequest, opts ...grpc.CallOption) (*pbpipeline.EnricherRecommendations, error) {
+			gotReq = in
+			return &pbpipeline.EnricherRecommendations{
+				Recommendations: []*pbpipeline.EnricherRecommendation{{
+					ProviderType:       pbplugin.EnricherProviderType_ENRICHER_PROVIDER_MUSCLE_HEATMAP,
+					MatchingActivities: 12,
+				}},
+				ActivitiesAnalyzed: 20,
+			}, nil
+		},
+	})
+	r := httptest.NewRequest(http.MethodGet, "/api/v2/users/me/enricher-recommendations", nil)
+	r = withToken(r, "user1")
+	w := httptest.NewRecorder()
+	s.handleGetEnricherRecommendations(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if gotReq.GetUserId() != "user1" {
+		t.Errorf("unexpected request: %v", gotReq)
+	}
+}
+
+func TestHandleGetEnricherRecommendations_NoToken(t *testing.T) {
+	s := buildPipelineServer(&mockPipelineServiceClient{})
+	r := httptest.NewRequest(http.MethodGet, "/api/v2/users/me/enricher-recommendations", nil)
+	w := httptest.NewRecorder()
+	s.handleGetEnricherRecommendations(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %d", w.Code)
+	}
+}
+
 func TestHandleGetPipelineRun_NoToken(t *testing.T) {
 	s := buildPipelineServer(&mockPipelineServiceClient{})
 	r := httptest.NewRequest(http.MethodGet, "/api/v2/users/me/pipelines/pipe1/runs/run1", nil)
@@ -388,6 +824,72 @@ func TestHandleRepostActivity_ServiceError(t *testing.T) {
 	}
 }
 
+func TestHandleRepostFullPipeline_ReplayOverride(t *testing.T) {
+	var gotReq *pipelinepb.RepostActivityRequest
+	s := buildPipelineServer(&mockPipelineServiceClient{
+		repostActivity: func(_ context.Context, in *pipelinepb.RepostActivityRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+			gotReq = in
+			return &emptypb.Empty{}, nil
+		},
+	})
+	body := `{"activityId":"act1","replayOverride":{"enrichers":[{"providerType":"ENRICHER_PROVIDER_WEATHER","typedConfig":{"units":"metric"}}],"destinations":["DESTINATION_STRAVA"]}}`
+	r := withToken(httptest.NewRequest(http.MethodPost, "/api/v2/repost/full-pipeline", strings.NewReader(body)), "user1")
+	w := httptest.NewRecorder()
+	s.handleRepostFullPipeline(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	override := gotReq.GetReplayOverride()
+	if gotReq.Mode != "full-pipeline" || len(override.GetEnrichers()) != 1 || override.Enrichers[0].TypedConfig["units"] != "metric" || len(override.Destinations) != 1 {
+		t.Errorf("expected the override to be forwarded, got %v", gotReq)
+	}
+
+	r = withToken(httptest.NewRequest(http.MethodPost, "/api/v2/repost/full-pipeline", strings.NewReader(`{"activityId":"act1","replayOverride":{"enrichers":"weather"}}`)), "user1")
+	w = httptest.NewRecorder()
+	s.handleRepostFullPipeline(w, r)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for a malformed override, got %d", w.Code)
+	}
+}
+
+func TestHandleRetryPipelineRun_Success(t *testing.T) {
+	var gotReq *pipelinepb.RetryPipelineRunRequest
+	s := buildPipelineServer(&mockPipelineServiceClient{
+		retryPipelineRun: func(_ context.Context, in *pipelinepb.RetryPipelineRunRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+			gotReq = in
+			return &emptypb.Empty{}, nil
+		},
+	})
+	r := httptest.NewRequest(http.MethodPost, "/api/v2/users/me/pipelines/pipe1/runs/run1/retry", strings.NewReader(`{"enrichers":["weather"]}`))
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", "pipe1")
+	rctx.URLParams.Add("runId", "run1")
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	r = withToken(r, "user1")
+	w := httptest.NewRecorder()
+	s.handleRetryPipelineRun(w, r)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", w.Code)
+	}
+	if gotReq.GetUserId() != "user1" || gotReq.GetPipelineId() != "pipe1" || gotReq.GetPipelineRunId() != "run1" {
+		t.Errorf("unexpected request: %v", gotReq)
+	}
+	if len(gotReq.GetEnrichers()) != 1 || gotReq.GetEnrichers()[0] != "weather" {
+		t.Errorf("expected enrichers [weather], got %v", gotReq.GetEnrichers())
+	}
+}
+
+func TestHandleRetryPipelineRun_NoBody(t *testing.T) {
+	s := buildPipelineServer(&mockPipelineServiceClient{})
+	r := httptest.NewRequest(http.MethodPost, "/api/v2/users/me/pipelines/pipe1/runs/run1/retry", nil)
+	r = withToken(r, "user1")
+	w := httptest.NewRecorder()
+	s.handleRetryPipelineRun(w, r)
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected 204, got %d", w.Code)
+	}
+}
+
 // =============================================================
 // Registry Handler Tests
 // =============================================================
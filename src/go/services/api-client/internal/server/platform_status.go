@@ -0,0 +1,21 @@
+package server
+
+import (
+	"net/http"
+
+	pbgateway "github.com/fitglue/server/src/go/pkg/types/pb/gateway"
+)
+
+// handleGetPlatformStatus lists platforms currently in outage. The web app
+// shows a banner while any are listed; affected work is queued and resumes
+// automatically once the platform recovers.
+func (s *APIServer) handleGetPlatformStatus(w http.ResponseWriter, r *http.Request) {
+	outages, err := s.platformStatus.ListOutages(r.Context())
+	if err != nil {
+		s.logger.Error(r.Context(), "Failed to list platform outages", "error", err)
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to load platform status"))
+		return
+	}
+
+	WriteJSON(w, &pbgateway.PlatformStatusGatewayResponse{Outages: outages})
+}
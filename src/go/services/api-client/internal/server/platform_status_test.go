@@ -0,0 +1,46 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+)
+
+type mockPlatformStatusStore struct {
+	outages []*pbpipeline.PlatformHealth
+	err     error
+}
+
+func (m *mockPlatformStatusStore) ListOutages(ctx context.Context) ([]*pbpipeline.PlatformHealth, error) {
+	return m.outages, m.err
+}
+
+func TestHandleGetPlatformStatus(t *testing.T) {
+	store := &mockPlatformStatusStore{outages: []*pbpipeline.PlatformHealth{
+		{Platform: "strava", State: pbpipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_OUTAGE},
+	}}
+	s := &APIServer{logger: infra.NewLogger(), platformStatus: store}
+
+	w := httptest.NewRecorder()
+	s.handleGetPlatformStatus(w, withToken(httptest.NewRequest(http.MethodGet, "/api/v2/platform-status", nil), "user1"))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), `"platform":"strava"`) || !strings.Contains(w.Body.String(), "PLATFORM_HEALTH_STATE_OUTAGE") {
+		t.Errorf("expected strava outage in response, got %s", w.Body.String())
+	}
+
+	store.err = errors.New("firestore unavailable")
+	w = httptest.NewRecorder()
+	s.handleGetPlatformStatus(w, withToken(httptest.NewRequest(http.MethodGet, "/api/v2/platform-status", nil), "user1"))
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500 on store error, got %d", w.Code)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-chi/chi/v5"
 
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
 )
 
@@ -53,6 +54,8 @@ func (s *APIServer) handleIntegrationRequest(w http.ResponseWriter, r *http.Requ
 type repostRequestBody struct {
 	ActivityID  string `json:"activityId"`
 	Destination string `json:"destination,omitempty"`
+	// Full-pipeline only: replays as a new run with this config override
+	ReplayOverride json.RawMessage `json:"replayOverride,omitempty"`
 }
 
 func (s *APIServer) handleRepostMissedDestination(w http.ResponseWriter, r *http.Request) {
@@ -91,6 +94,13 @@ func (s *APIServer) handleRepostWithMode(w http.ResponseWriter, r *http.Request,
 		Mode:        mode,
 		Destination: body.Destination,
 	}
+	if len(body.ReplayOverride) > 0 {
+		req.ReplayOverride = &pbevents.ReplayOverride{}
+		if err := protoUnmarshaler.Unmarshal(body.ReplayOverride, req.ReplayOverride); err != nil {
+			WriteError(w, statusError(http.StatusBadRequest, "invalid replayOverride"))
+			return
+		}
+	}
 
 	_, err := s.pipelineSvc.RepostActivity(r.Context(), req)
 	if err != nil {
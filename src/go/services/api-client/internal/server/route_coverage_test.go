@@ -72,6 +72,11 @@ func TestRouteCoverage(t *testing.T) {
 		nil, // authClient
 		&mockPublisher{},
 		nil, // apiKeyStore
+		nil, // backfillStore
+		nil, // importStore
+		nil, // uploads
+		"",  // uploadBucket
+		nil, // platformStatus
 		&mockUserServiceClient{},
 		&mockBillingServiceClient{},
 		&mockPipelineServiceClient{},
@@ -12,6 +12,8 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 
 	"github.com/fitglue/server/src/go/internal/infra"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
 	billingpb "github.com/fitglue/server/src/go/pkg/types/pb/services/billing"
 	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
@@ -24,9 +26,45 @@ type Publisher interface {
 	PublishCloudEvent(ctx context.Context, topicID string, e event.Event) (string, error)
 }
 
-// ApiKeyStore provides persistence for ingress API keys generated during integration setup
+// ApiKeyStore provides persistence for API keys: ingress keys generated during
+// integration setup, and personal keys for scripts and the fitglue CLI
 type ApiKeyStore interface {
 	CreateIngressKey(ctx context.Context, keyHash, userID, label string, scopes []string, createdAt time.Time) error
+	// GetKey returns nil if there is no key with the hash
+	GetKey(ctx context.Context, keyHash string) (*pbuser.ApiKeyRecord, error)
+	// ListKeys returns the user's keys by hash
+	ListKeys(ctx context.Context, userID string) (map[string]*pbuser.ApiKeyRecord, error)
+	DeleteKey(ctx context.Context, keyHash string) error
+	TouchKey(ctx context.Context, keyHash string, usedAt time.Time) error
+}
+
+// BackfillJobStore provides persistence for the backfill jobs the web app polls for progress
+type BackfillJobStore interface {
+	// StartJob creates the job unless its pipeline already has one in
+	// progress, which it returns instead
+	StartJob(ctx context.Context, job *pbpipeline.BackfillJob) (*pbpipeline.BackfillJob, error)
+	GetJob(ctx context.Context, jobID string) (*pbpipeline.BackfillJob, error)
+}
+
+// ImportSessionStore provides persistence for file import sessions
+type ImportSessionStore interface {
+	CreateImport(ctx context.Context, session *pbpipeline.ImportSession) error
+	GetImport(ctx context.Context, sessionID string) (*pbpipeline.ImportSession, error)
+	ModifyImport(ctx context.Context, sessionID string, fn func(*pbpipeline.ImportSession) error) (*pbpipeline.ImportSession, error)
+}
+
+// UploadStore holds files users upload: import files written here for the
+// backfill service to read, and pending input files the browser uploads
+// straight to GCS with a signed URL
+type UploadStore interface {
+	Write(ctx context.Context, bucket, object string, data []byte) error
+	SignedURL(ctx context.Context, bucket, object, contentType string, contentLength int64, expiry time.Duration) (string, error)
+}
+
+// PlatformStatusStore lists platforms the outage circuit breaker has
+// tripped, for the web app's banner
+type PlatformStatusStore interface {
+	ListOutages(ctx context.Context) ([]*pbpipeline.PlatformHealth, error)
 }
 
 // APIServer implements the HTTP router interfacing with FitGlue domain gRPC services
@@ -36,6 +74,11 @@ type APIServer struct {
 	authClient     *auth.Client
 	publisher      Publisher
 	apiKeyStore    ApiKeyStore
+	backfillStore  BackfillJobStore
+	importStore    ImportSessionStore
+	uploads        UploadStore
+	uploadBucket   string
+	platformStatus PlatformStatusStore
 	userService    userpb.UserServiceClient
 	billingService billingpb.BillingServiceClient
 	pipelineSvc    pipelinepb.PipelineServiceClient
@@ -49,6 +92,11 @@ func NewAPIServer(
 	authClient *auth.Client,
 	publisher Publisher,
 	apiKeyStore ApiKeyStore,
+	backfillStore BackfillJobStore,
+	importStore ImportSessionStore,
+	uploads UploadStore,
+	uploadBucket string,
+	platformStatus PlatformStatusStore,
 	userSvc userpb.UserServiceClient,
 	billingSvc billingpb.BillingServiceClient,
 	pipelineSvc pipelinepb.PipelineServiceClient,
@@ -61,6 +109,11 @@ func NewAPIServer(
 		authClient:     authClient,
 		publisher:      publisher,
 		apiKeyStore:    apiKeyStore,
+		backfillStore:  backfillStore,
+		importStore:    importStore,
+		uploads:        uploads,
+		uploadBucket:   uploadBucket,
+		platformStatus: platformStatus,
 		userService:    userSvc,
 		billingService: billingSvc,
 		pipelineSvc:    pipelineSvc,
@@ -111,7 +164,7 @@ func (s *APIServer) setupRoutes() {
 
 		// Register domain routes here
 		r.Group(func(r chi.Router) {
-			r.Use(AuthMiddleware(s.authClient))
+			r.Use(AuthMiddleware(s.authClient, s.apiKeyStore))
 
 			s.registerUserRoutes(r)
 			s.registerBillingRoutes(r)
@@ -119,6 +172,8 @@ func (s *APIServer) setupRoutes() {
 			s.registerActivityRoutes(r)
 			s.registerOAuthRoutes(r)
 			s.registerRepostRoutes(r)
+
+			r.Get("/platform-status", s.handleGetPlatformStatus)
 		})
 	})
 }
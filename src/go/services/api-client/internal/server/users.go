@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/fitglue/server/src/go/internal/userdeletion"
 	"github.com/fitglue/server/src/go/pkg/domain/apikey"
 
 	infraps "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
@@ -60,6 +61,16 @@ func (s *APIServer) registerUserRoutes(r chi.Router) {
 	r.Put("/users/me/personal-records/{recordType}", s.handleSetPersonalRecord)
 	r.Delete("/users/me/personal-records/{recordType}", s.handleDeletePersonalRecord)
 
+	// Gear
+	r.Get("/users/me/gear", s.handleListGear)
+	r.Put("/users/me/gear/{gearId}", s.handleSetGear)
+	r.Delete("/users/me/gear/{gearId}", s.handleDeleteGear)
+
+	// Goals
+	r.Get("/users/me/goals", s.handleListGoals)
+	r.Put("/users/me/goals/{goalId}", s.handleSetGoal)
+	r.Delete("/users/me/goals/{goalId}", s.handleDeleteGoal)
+
 	// Plugin Defaults
 	r.Get("/users/me/plugin-defaults", s.handleListPluginDefaults)
 	r.Put("/users/me/plugin-defaults/{pluginId}", s.handleSetPluginDefaults)
@@ -71,6 +82,11 @@ func (s *APIServer) registerUserRoutes(r chi.Router) {
 	// FCM Token (push notifications)
 	r.Post("/users/me/fcm-token", s.handleSetFCMToken)
 
+	// Personal API keys (scripts and the fitglue CLI)
+	r.Get("/users/me/api-keys", s.handleListApiKeys)
+	r.Post("/users/me/api-keys", s.handleCreateApiKey)
+	r.Delete("/users/me/api-keys/{id}", s.handleDeleteApiKey)
+
 	// Mobile sync (trigger data sync)
 	r.Post("/users/me/mobile/sync", s.handleMobileSync)
 }
@@ -168,6 +184,18 @@ func (s *APIServer) handleSetIntegration(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if provider == "zwift" {
+		username, _ := bodyMap["username"].(string)
+		password, _ := bodyMap["password"].(string)
+		tokens, err := exchangeZwiftCredentials(r.Context(), username, password)
+		if err != nil {
+			s.logger.Warn(r.Context(), "zwift credential exchange failed", "error", err)
+			WriteError(w, statusError(http.StatusBadRequest, "failed to sign in to zwift"))
+			return
+		}
+		bodyMap = tokens
+	}
+
 	// Enrich integration data with standard metadata
 	bodyMap["enabled"] = true
 	bodyMap["consent_given"] = true
@@ -190,6 +218,7 @@ func (s *APIServer) handleSetIntegration(w http.ResponseWriter, r *http.Request)
 		WriteError(w, err)
 		return
 	}
+	s.provisionStarterPipeline(r.Context(), token.UID)
 
 	// For API_KEY providers, generate an ingress API key for webhooks
 	if isApiKeyProvider(provider) && s.apiKeyStore != nil {
@@ -305,6 +334,11 @@ func (s *APIServer) handleUpdateNotificationPrefs(w http.ResponseWriter, r *http
 			merged.NotifyPipelineFailure = b
 		}
 	}
+	if v, ok := partial["emailWeeklyDigest"]; ok {
+		if b, ok := v.(bool); ok {
+			merged.EmailWeeklyDigest = b
+		}
+	}
 
 	var req userpb.UpdateNotificationPrefsRequest
 	req.UserId = token.UID
@@ -312,6 +346,7 @@ func (s *APIServer) handleUpdateNotificationPrefs(w http.ResponseWriter, r *http
 		NotifyPendingInput:    merged.NotifyPendingInput,
 		NotifyPipelineSuccess: merged.NotifyPipelineSuccess,
 		NotifyPipelineFailure: merged.NotifyPipelineFailure,
+		EmailWeeklyDigest:     merged.EmailWeeklyDigest,
 	}
 
 	res, err := s.userService.UpdateNotificationPrefs(r.Context(), &req)
@@ -499,18 +534,19 @@ func (s *APIServer) handleDeleteSelf(w http.ResponseWriter, r *http.Request) {
 		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
 		return
 	}
-
-	req := &userpb.DeleteUserRequest{
-		UserId: token.UID,
+	if isApiKeyToken(token) {
+		WriteError(w, statusError(http.StatusForbidden, "sign in to delete your account"))
+		return
 	}
 
-	_, err := s.userService.DeleteUser(r.Context(), req)
-	if err != nil {
-		WriteError(w, err)
+	// Revoking provider tokens and deleting stored artifacts take a while, so
+	// the destination service does it
+	if err := userdeletion.RequestDeletion(r.Context(), s.publisher, token.UID, userdeletion.RequestedBySelf); err != nil {
+		WriteError(w, statusError(http.StatusInternalServerError, "failed to start account deletion"))
 		return
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	w.WriteHeader(http.StatusAccepted)
 }
 
 // =============================================================
@@ -653,6 +689,136 @@ func (s *APIServer) handleDeletePersonalRecord(w http.ResponseWriter, r *http.Re
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// =============================================================
+// Gear
+// =============================================================
+
+func (s *APIServer) handleListGear(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	res, err := s.userService.ListGear(r.Context(), &userpb.ListGearRequest{
+		UserId: token.UID,
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+func (s *APIServer) handleSetGear(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var reqBody userpb.SetGearRequest
+	if err := decodeProto(r, &reqBody); err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+	reqBody.UserId = token.UID
+	reqBody.GearId = chi.URLParam(r, "gearId")
+
+	res, err := s.userService.SetGear(r.Context(), &reqBody)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+func (s *APIServer) handleDeleteGear(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	_, err := s.userService.DeleteGear(r.Context(), &userpb.DeleteGearRequest{
+		UserId: token.UID,
+		GearId: chi.URLParam(r, "gearId"),
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// =============================================================
+// Goals
+// =============================================================
+
+func (s *APIServer) handleListGoals(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	res, err := s.userService.ListGoals(r.Context(), &userpb.ListGoalsRequest{
+		UserId: token.UID,
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+func (s *APIServer) handleSetGoal(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var reqBody userpb.SetGoalRequest
+	if err := decodeProto(r, &reqBody); err != nil {
+		WriteError(w, statusError(http.StatusBadRequest, "invalid request body"))
+		return
+	}
+	reqBody.UserId = token.UID
+	reqBody.GoalId = chi.URLParam(r, "goalId")
+
+	res, err := s.userService.SetGoal(r.Context(), &reqBody)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+func (s *APIServer) handleDeleteGoal(w http.ResponseWriter, r *http.Request) {
+	token := getUserToken(r)
+	if token == nil {
+		WriteError(w, statusError(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	_, err := s.userService.DeleteGoal(r.Context(), &userpb.DeleteGoalRequest{
+		UserId: token.UID,
+		GoalId: chi.URLParam(r, "goalId"),
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // =============================================================
 // Plugin Defaults
 // =============================================================
@@ -16,21 +16,58 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/fitglue/server/src/go/internal/infra"
+	shared "github.com/fitglue/server/src/go/pkg"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	pipelinepb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
 	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
 )
 
 // mockApiKeyStore implements ApiKeyStore for testing
 type mockApiKeyStore struct {
 	createFunc func(ctx context.Context, keyHash, userID, label string, scopes []string, createdAt time.Time) error
+	keys       map[string]*pbuser.ApiKeyRecord
+	touched    []string
 }
 
 func (m *mockApiKeyStore) CreateIngressKey(ctx context.Context, keyHash, userID, label string, scopes []string, createdAt time.Time) error {
 	if m.createFunc != nil {
 		return m.createFunc(ctx, keyHash, userID, label, scopes, createdAt)
 	}
+	if m.keys == nil {
+		m.keys = map[string]*pbuser.ApiKeyRecord{}
+	}
+	m.keys[keyHash] = &pbuser.ApiKeyRecord{UserId: userID, Label: label, Scopes: scopes, CreatedAt: timestamppb.New(createdAt)}
+	return nil
+}
+
+func (m *mockApiKeyStore) GetKey(ctx context.Context, keyHash string) (*pbuser.ApiKeyRecord, error) {
+	return m.keys[keyHash], nil
+}
+
+func (m *mockApiKeyStore) ListKeys(ctx context.Context, userID string) (map[string]*pbuser.ApiKeyRecord, error) {
+	keys := map[string]*pbuser.ApiKeyRecord{}
+	for keyHash, rec := range m.keys {
+		if rec.UserId == userID {
+			keys[keyHash] = rec
+		}
+	}
+	return keys, nil
+}
+
+func (m *mockApiKeyStore) DeleteKey(ctx context.Context, keyHash string) error {
+	delete(m.keys, keyHash)
+	return nil
+}
+
+func (m *mockApiKeyStore) TouchKey(ctx context.Context, keyHash string, usedAt time.Time) error {
+	m.touched = append(m.touched, keyHash)
 	return nil
 }
 
@@ -60,6 +97,9 @@ func (m *mockUserServiceClient) GetProfile(ctx context.Context, in *userpb.GetPr
 	}
 	return &pbuser.UserProfile{UserId: in.UserId}, nil
 }
+func (m *mockUserServiceClient) SearchUsers(ctx context.Context, in *userpb.SearchUsersRequest, opts ...grpc.CallOption) (*userpb.SearchUsersResponse, error) {
+	return &userpb.SearchUsersResponse{}, nil
+}
 func (m *mockUserServiceClient) ListUsers(ctx context.Context, in *userpb.ListUsersRequest, opts ...grpc.CallOption) (*userpb.ListUsersResponse, error) {
 	return &userpb.ListUsersResponse{}, nil
 }
@@ -75,6 +115,9 @@ func (m *mockUserServiceClient) GetIntegration(ctx context.Context, in *userpb.G
 	}
 	return &userpb.GetIntegrationResponse{}, nil
 }
+func (m *mockUserServiceClient) GetIntegrationSecret(ctx context.Context, in *userpb.GetIntegrationSecretRequest, opts ...grpc.CallOption) (*userpb.GetIntegrationSecretResponse, error) {
+	return &userpb.GetIntegrationSecretResponse{}, nil
+}
 func (m *mockUserServiceClient) SetIntegration(ctx context.Context, in *userpb.SetIntegrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	if m.setIntegration != nil {
 		return m.setIntegration(ctx, in, opts...)
@@ -156,6 +199,24 @@ func (m *mockUserServiceClient) SetPersonalRecord(ctx context.Context, in *userp
 func (m *mockUserServiceClient) DeletePersonalRecord(ctx context.Context, in *userpb.DeletePersonalRecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	return &emptypb.Empty{}, nil
 }
+func (m *mockUserServiceClient) ListGear(ctx context.Context, in *userpb.ListGearRequest, opts ...grpc.CallOption) (*userpb.ListGearResponse, error) {
+	return &userpb.ListGearResponse{}, nil
+}
+func (m *mockUserServiceClient) SetGear(ctx context.Context, in *userpb.SetGearRequest, opts ...grpc.CallOption) (*pbuser.Gear, error) {
+	return &pbuser.Gear{}, nil
+}
+func (m *mockUserServiceClient) DeleteGear(ctx context.Context, in *userpb.DeleteGearRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+func (m *mockUserServiceClient) ListGoals(ctx context.Context, in *userpb.ListGoalsRequest, opts ...grpc.CallOption) (*userpb.ListGoalsResponse, error) {
+	return &userpb.ListGoalsResponse{}, nil
+}
+func (m *mockUserServiceClient) SetGoal(ctx context.Context, in *userpb.SetGoalRequest, opts ...grpc.CallOption) (*pbuser.Goal, error) {
+	return &pbuser.Goal{}, nil
+}
+func (m *mockUserServiceClient) DeleteGoal(ctx context.Context, in *userpb.DeleteGoalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
 func (m *mockUserServiceClient) ListPluginDefaults(ctx context.Context, in *userpb.ListPluginDefaultsRequest, opts ...grpc.CallOption) (*userpb.ListPluginDefaultsResponse, error) {
 	return &userpb.ListPluginDefaultsResponse{}, nil
 }
@@ -454,6 +515,76 @@ func TestHandleSetIntegration_Success(t *testing.T) {
 	}
 }
 
+func TestHandleSetIntegration_ProvisionsStarterPipeline(t *testing.T) {
+	svc := &mockUserServiceClient{
+		listIntegrations: func(_ context.Context, _ *userpb.ListIntegrationsRequest, _ ...grpc.CallOption) (*pbuser.UserIntegrations, error) {
+			return &pbuser.UserIntegrations{
+				Strava:  &pbuser.StravaIntegration{Enabled: true},
+				Hevy:    &pbuser.HevyIntegration{Enabled: true},
+				Spotify: &pbuser.SpotifyIntegration{Enabled: false},
+			}, nil
+		},
+	}
+	var captured *pipelinepb.ProvisionStarterPipelineRequest
+	pSvc := &mockPipelineServiceClient{
+		provisionStarterPipeline: func(_ context.Context, in *pipelinepb.ProvisionStarterPipelineRequest, _ ...grpc.CallOption) (*pipelinepb.ProvisionStarterPipelineResponse, error) {
+			captured = in
+			return &pipelinepb.ProvisionStarterPipelineResponse{Pipeline: &pbpipeline.PipelineConfig{Id: "pipe_1"}}, nil
+		},
+	}
+	s := buildTestServer(svc, &mockPublisher{})
+	s.pipelineSvc = pSvc
+	s.logger = infra.NewLogger()
+
+	r := httptest.NewRequest(http.MethodPut, "/api/v2/users/me/integrations/strava", strings.NewReader(`{"access_token":"abc123"}`))
+	r = withToken(r, "user1")
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("provider", "strava")
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	w := httptest.NewRecorder()
+	s.handleSetIntegration(w, r)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected 204, got %d: %s", w.Code, w.Body.String())
+	}
+	if captured == nil {
+		t.Fatal("ProvisionStarterPipeline was not called")
+	}
+	if captured.UserId != "user1" || strings.Join(captured.Integrations, ",") != "hevy,strava" {
+		t.Errorf("expected user1 with hevy and strava, got %s with %v", captured.UserId, captured.Integrations)
+	}
+}
+
+func TestHandleSetIntegration_StarterPipelineFailureIgnored(t *testing.T) {
+	svc := &mockUserServiceClient{
+		listIntegrations: func(_ context.Context, _ *userpb.ListIntegrationsRequest, _ ...grpc.CallOption) (*pbuser.UserIntegrations, error) {
+			return &pbuser.UserIntegrations{
+				Strava: &pbuser.StravaIntegration{Enabled: true},
+				Hevy:   &pbuser.HevyIntegration{Enabled: true},
+			}, nil
+		},
+	}
+	s := buildTestServer(svc, &mockPublisher{})
+	s.pipelineSvc = &mockPipelineServiceClient{
+		provisionStarterPipeline: func(_ context.Context, _ *pipelinepb.ProvisionStarterPipelineRequest, _ ...grpc.CallOption) (*pipelinepb.ProvisionStarterPipelineResponse, error) {
+			return nil, status.Error(codes.Unavailable, "down")
+		},
+	}
+	s.logger = infra.NewLogger()
+
+	r := httptest.NewRequest(http.MethodPut, "/api/v2/users/me/integrations/strava", strings.NewReader(`{"access_token":"abc123"}`))
+	r = withToken(r, "user1")
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("provider", "strava")
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	w := httptest.NewRecorder()
+	s.handleSetIntegration(w, r)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected the connection to succeed anyway, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
 func TestHandleSetIntegration_ApiKeyProvider(t *testing.T) {
 	var createdKeyHash, createdUserID, createdLabel string
 	svc := &mockUserServiceClient{}
@@ -634,6 +765,60 @@ func TestHandleUpdateCounter_InvalidJSON(t *testing.T) {
 	}
 }
 
+// =============================================================
+// handleSetGear
+// =============================================================
+
+func TestHandleSetGear_Success(t *testing.T) {
+	s := buildTestServer(&mockUserServiceClient{}, &mockPublisher{})
+	body, _ := json.Marshal(map[string]interface{}{"name": "Pegasus 40", "type": "GEAR_TYPE_SHOES", "initialDistanceMeters": 50000})
+	r := httptest.NewRequest(http.MethodPut, "/api/v2/users/me/gear/pegasus-40", bytes.NewReader(body))
+	r = withToken(r, "user1")
+	w := httptest.NewRecorder()
+	s.handleSetGear(w, r)
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", w.Code)
+	}
+}
+
+func TestHandleSetGear_InvalidJSON(t *testing.T) {
+	s := buildTestServer(&mockUserServiceClient{}, &mockPublisher{})
+	r := httptest.NewRequest(http.MethodPut, "/api/v2/users/me/gear/pegasus-40", strings.NewReader("not json"))
+	r = withToken(r, "user1")
+	w := httptest.NewRecorder()
+	s.handleSetGear(w, r)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", w.Code)
+	}
+}
+
+// =============================================================
+// handleSetGoal
+// =============================================================
+
+func TestHandleSetGoal_Success(t *testing.T) {
+	s := buildTestServer(&mockUserServiceClient{}, &mockPublisher{})
+	body, _ := json.Marshal(map[string]interface{}{"name": "1000 km in 2025", "metric": "GOAL_METRIC_DISTANCE", "target": 1000000, "startDate": "2025-01-01T00:00:00Z"})
+	r := httptest.NewRequest(http.MethodPut, "/api/v2/users/me/goals/1000k", bytes.NewReader(body))
+	r = withToken(r, "user1")
+	w := httptest.NewRecorder()
+	s.handleSetGoal(w, r)
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", w.Code)
+	}
+}
+
+func TestHandleSetGoal_InvalidJSON(t *testing.T) {
+	s := buildTestServer(&mockUserServiceClient{}, &mockPublisher{})
+	r := httptest.NewRequest(http.MethodPut, "/api/v2/users/me/goals/1000k", strings.NewReader("not json"))
+	r = withToken(r, "user1")
+	w := httptest.NewRecorder()
+	s.handleSetGoal(w, r)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", w.Code)
+	}
+}
+
 // =============================================================
 // WriteError edge cases
 // =============================================================
@@ -667,3 +852,32 @@ func TestCustomError_Error(t *testing.T) {
 		t.Errorf("expected 'bad', got %q", e.Error())
 	}
 }
+
+func TestHandleDeleteSelf_QueuesDeletion(t *testing.T) {
+	var topics []string
+	var published []event.Event
+	pub := &mockPublisher{publishFunc: func(_ context.Context, topicID string, e event.Event) (string, error) {
+		topics = append(topics, topicID)
+		published = append(published, e)
+		return "msg-id", nil
+	}}
+	s := &APIServer{publisher: pub}
+
+	r := withToken(httptest.NewRequest(http.MethodDelete, "/api/v2/users/me", nil), "user1")
+	w := httptest.NewRecorder()
+	s.handleDeleteSelf(w, r)
+
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d: %s", w.Code, w.Body.String())
+	}
+	if len(topics) != 1 || topics[0] != shared.TopicUserDeletionRequested {
+		t.Fatalf("expected one publish to %s, got %v", shared.TopicUserDeletionRequested, topics)
+	}
+	var req pbevents.UserDeletionRequestedEvent
+	if err := protojson.Unmarshal(published[0].Data(), &req); err != nil {
+		t.Fatalf("failed to decode event: %v", err)
+	}
+	if req.UserId != "user1" || req.RequestedBy != "self" {
+		t.Errorf("unexpected event: %+v", &req)
+	}
+}
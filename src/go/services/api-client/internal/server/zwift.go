@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Zwift offers no third-party OAuth app flow, so integrations authenticate
+// with the Companion app client using the user's Zwift credentials once.
+var (
+	zwiftTokenURL   = "https://secure.zwift.com/auth/realms/zwift/protocol/openid-connect/token"
+	zwiftAPIBaseURL = "https://us-or-rly101.zwift.com"
+)
+
+// exchangeZwiftCredentials trades a Zwift username and password for Companion
+// API tokens and the profile ID used for polling. The password is discarded;
+// only the returned tokens are stored.
+func exchangeZwiftCredentials(ctx context.Context, username, password string) (map[string]interface{}, error) {
+	if username == "" || password == "" {
+		return nil, fmt.Errorf("username and password are required")
+	}
+
+	data := url.Values{}
+	data.Set("client_id", "Zwift_Mobile_Link")
+	data.Set("grant_type", "password")
+	data.Set("username", username)
+	data.Set("password", password)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, zwiftTokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("zwift sign-in failed with status: %d", resp.StatusCode)
+	}
+
+	var tokens struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil {
+		return nil, fmt.Errorf("failed to decode zwift token response: %w", err)
+	}
+
+	profileID, err := fetchZwiftProfileID(ctx, tokens.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"access_token":     tokens.AccessToken,
+		"refresh_token":    tokens.RefreshToken,
+		"expires_at":       time.Now().Add(time.Duration(tokens.ExpiresIn) * time.Second).UTC().Format(time.RFC3339),
+		"zwift_profile_id": profileID,
+		"created_at":       time.Now().UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// fetchZwiftProfileID returns the Zwift profile ID whose activities are polled.
+func fetchZwiftProfileID(ctx context.Context, accessToken string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, zwiftAPIBaseURL+"/api/profiles/me", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("zwift profile request failed with status: %d", resp.StatusCode)
+	}
+
+	var profile struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&profile); err != nil {
+		return "", fmt.Errorf("failed to decode zwift profile: %w", err)
+	}
+	if profile.ID == 0 {
+		return "", fmt.Errorf("zwift profile has no id")
+	}
+	return strconv.FormatInt(profile.ID, 10), nil
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeZwiftCredentials(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "password", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "rider@example.com", r.PostForm.Get("username"))
+			w.Write([]byte(`{"access_token": "access", "refresh_token": "refresh", "expires_in": 21600}`))
+		case "/api/profiles/me":
+			assert.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+			w.Write([]byte(`{"id": 123456, "firstName": "Rider"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer svr.Close()
+
+	origToken, origAPI := zwiftTokenURL, zwiftAPIBaseURL
+	zwiftTokenURL, zwiftAPIBaseURL = svr.URL+"/token", svr.URL
+	defer func() { zwiftTokenURL, zwiftAPIBaseURL = origToken, origAPI }()
+
+	data, err := exchangeZwiftCredentials(context.Background(), "rider@example.com", "hunter2")
+
+	require.NoError(t, err)
+	assert.Equal(t, "access", data["access_token"])
+	assert.Equal(t, "refresh", data["refresh_token"])
+	assert.Equal(t, "123456", data["zwift_profile_id"])
+	assert.NotContains(t, data, "password")
+
+	_, err = exchangeZwiftCredentials(context.Background(), "rider@example.com", "")
+	assert.Error(t, err)
+}
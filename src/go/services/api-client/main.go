@@ -7,8 +7,13 @@ import (
 	"os"
 
 	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/storage"
+	"github.com/fitglue/server/src/go/internal/backfill"
 	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/internal/outage"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
 	infraps "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
+	gcsstorage "github.com/fitglue/server/src/go/pkg/infrastructure/storage"
 	"github.com/fitglue/server/src/go/services/api-client/internal/server"
 
 	firebase "firebase.google.com/go/v4"
@@ -106,6 +111,21 @@ func main() {
 	}
 	defer firestoreClient.Close()
 	apiKeyStore := server.NewFirestoreApiKeyStore(firestoreClient)
+	backfillStore := backfill.NewFirestoreStore(firestoreClient)
+	outageStore := outage.NewFirestoreStore(firestoreClient)
+
+	// GCS holds uploaded import files and pending input uploads until the
+	// backfill and pipeline services process them
+	gcsClient, err := storage.NewClient(ctx)
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize GCS client", "error", err)
+		os.Exit(1)
+	}
+	defer gcsClient.Close()
+	uploadBucket := os.Getenv("GCS_ARTIFACT_BUCKET")
+	if uploadBucket == "" {
+		uploadBucket = "fitglue-server-dev-artifacts"
+	}
 
 	// Build API Gateway router
 	apiServer := server.NewAPIServer(
@@ -113,6 +133,11 @@ func main() {
 		authClient,
 		publisher,
 		apiKeyStore,
+		backfillStore,
+		backfillStore,
+		&gcsstorage.StorageAdapter{Client: gcsClient},
+		uploadBucket,
+		outageStore,
 		userClient,
 		billingClient,
 		pipelineClient,
@@ -120,6 +145,10 @@ func main() {
 		registryClient,
 	)
 
+	// Pending input resolution and other interactive calls go straight to
+	// the domain services, so warm instances connect before serving
+	bootstrap.PrimeConnections(ctx, bootstrap.LoadConfig(), logger, userConn, billingConn, pipelineConn, activityConn, registryConn)
+
 	logger.Info(ctx, "Starting service.api.client", "port", port)
 
 	if err := http.ListenAndServe(":"+port, infra.LoggingMiddleware(logger, apiServer)); err != nil {
@@ -3,6 +3,7 @@ package server
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -85,6 +86,10 @@ func (s *APIServer) handleGetPluginRegistry(w http.ResponseWriter, r *http.Reque
 func (s *APIServer) registerShowcaseRoutes(r chi.Router) {
 	r.Get("/showcase/{id}", s.handleGetPublicShowcase)
 	r.Get("/showcase/profile/{slug}", s.handleGetPublicShowcaseProfile)
+	r.Get("/showcase/profile/{slug}/routes", s.handleGetPublicShowcaseRouteStats)
+	r.Get("/showcase/profile/{slug}/feed", s.handleGetPublicShowcaseFeed)
+	r.Get("/showcase/compare/{slugA}/{slugB}", s.handleGetPublicShowcaseComparison)
+	r.Get("/showcase/profile/{slug}/embed", s.handleGetPublicShowcaseEmbed)
 }
 
 func (s *APIServer) handleListPlugins(w http.ResponseWriter, r *http.Request) {
@@ -176,6 +181,105 @@ func (s *APIServer) handleGetPublicShowcaseProfile(w http.ResponseWriter, r *htt
 	WriteJSON(w, res)
 }
 
+func (s *APIServer) handleGetPublicShowcaseRouteStats(w http.ResponseWriter, r *http.Request) {
+	req := &activitypb.GetPublicShowcaseRouteStatsRequest{
+		Slug: chi.URLParam(r, "slug"),
+	}
+
+	res, err := s.activitySvc.GetPublicShowcaseRouteStats(r.Context(), req)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+func (s *APIServer) handleGetPublicShowcaseComparison(w http.ResponseWriter, r *http.Request) {
+	req := &activitypb.GetPublicShowcaseComparisonRequest{
+		SlugA: chi.URLParam(r, "slugA"),
+		SlugB: chi.URLParam(r, "slugB"),
+	}
+
+	res, err := s.activitySvc.GetPublicShowcaseComparison(r.Context(), req)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	WriteJSON(w, res)
+}
+
+// handleGetPublicShowcaseFeed serves the profile's RSS or Atom feed as-is
+// rather than wrapping it in JSON, so feed readers can subscribe to the URL
+// directly.
+func (s *APIServer) handleGetPublicShowcaseFeed(w http.ResponseWriter, r *http.Request) {
+	req := &activitypb.GetPublicShowcaseFeedRequest{
+		Slug:   chi.URLParam(r, "slug"),
+		Format: r.URL.Query().Get("format"),
+	}
+
+	res, err := s.activitySvc.GetPublicShowcaseFeed(r.Context(), req)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", res.ContentType)
+	w.Header().Set("Cache-Control", "public, max-age=900")
+	_, _ = w.Write([]byte(res.Body))
+}
+
+// handleGetPublicShowcaseEmbed serves the embed card as-is for <img> tags and
+// iframes. Public cards are cached like feeds; cards reached through a signed
+// link are only cached by the viewer's browser. Both revalidate by ETag.
+func (s *APIServer) handleGetPublicShowcaseEmbed(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	expires, _ := strconv.ParseInt(q.Get("expires"), 10, 64)
+	req := &activitypb.GetPublicShowcaseEmbedRequest{
+		Slug:       chi.URLParam(r, "slug"),
+		ShowcaseId: q.Get("showcase_id"),
+		Format:     q.Get("format"),
+		Expires:    expires,
+		Signature:  q.Get("signature"),
+	}
+
+	res, err := s.activitySvc.GetPublicShowcaseEmbed(r.Context(), req)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	cacheControl := "public, max-age=900"
+	if res.Signed {
+		cacheControl = "private, max-age=300"
+	}
+	w.Header().Set("Cache-Control", cacheControl)
+	w.Header().Set("ETag", res.Etag)
+	if etagMatches(r.Header.Get("If-None-Match"), res.Etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", res.ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	// Cards are static markup; nothing in them needs to load or run
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; img-src data:")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(res.Body))
+}
+
+// etagMatches reports whether an If-None-Match header lists etag.
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
 // statusError is a helper for manually generating an error satisfying gRPC status layout
 func statusError(code int, msg string) error {
 	// Simple wrapper for non-gRPC errors to use WriteError
@@ -74,7 +74,10 @@ func (s *APIServer) setupRoutes() {
 		s.registerStravaRoutes(r)
 		s.registerFitbitRoutes(r)
 		s.registerHevyRoutes(r)
+		s.registerZwiftRoutes(r)
 		s.registerBillingRoutes(r)
+		s.registerOutageRoutes(r)
+		s.registerDeferredRoutes(r)
 	})
 }
 
@@ -82,6 +85,8 @@ func (s *APIServer) registerStravaRoutes(r chi.Router) {
 	// Strava requires a GET request during webhook registration and a POST request for event delivery
 	r.Get("/strava", s.handleStravaVerification)
 	r.Post("/strava", s.handleStravaEvent)
+	// Cloud Scheduler checks the push subscription is still alive
+	r.Post("/strava/subscription-check", s.handleStravaSubscriptionCheck)
 }
 
 func (s *APIServer) handleStravaVerification(w http.ResponseWriter, r *http.Request) {
@@ -92,6 +97,10 @@ func (s *APIServer) handleStravaEvent(w http.ResponseWriter, r *http.Request) {
 	s.processor.HandleEvent(w, r, "strava")
 }
 
+func (s *APIServer) handleStravaSubscriptionCheck(w http.ResponseWriter, r *http.Request) {
+	s.processor.HandleSubscriptionCheck(w, r, "strava")
+}
+
 func (s *APIServer) registerFitbitRoutes(r chi.Router) {
 	r.Get("/fitbit", s.handleFitbitVerification)
 	r.Post("/fitbit", s.handleFitbitEvent)
@@ -113,6 +122,33 @@ func (s *APIServer) handleHevyEvent(w http.ResponseWriter, r *http.Request) {
 	s.processor.HandleEvent(w, r, "hevy")
 }
 
+func (s *APIServer) registerZwiftRoutes(r chi.Router) {
+	// Zwift has no push webhooks; Cloud Scheduler triggers a poll instead
+	r.Post("/zwift/poll", s.handleZwiftPoll)
+}
+
+func (s *APIServer) handleZwiftPoll(w http.ResponseWriter, r *http.Request) {
+	s.processor.HandlePoll(w, r, "zwift")
+}
+
+func (s *APIServer) registerOutageRoutes(r chi.Router) {
+	// Cloud Scheduler replays webhook events queued during source outages
+	r.Post("/outage-check", s.handleOutageCheck)
+}
+
+func (s *APIServer) handleOutageCheck(w http.ResponseWriter, r *http.Request) {
+	s.processor.HandleOutageCheck(w, r)
+}
+
+func (s *APIServer) registerDeferredRoutes(r chi.Router) {
+	// Pub/Sub pushes webhook events that were acknowledged before processing
+	r.Post("/deferred", s.handleDeferredEvent)
+}
+
+func (s *APIServer) handleDeferredEvent(w http.ResponseWriter, r *http.Request) {
+	s.processor.HandleDeferredEvent(w, r)
+}
+
 func (s *APIServer) registerBillingRoutes(r chi.Router) {
 	r.Post("/billing", s.handleBillingEvent)
 }
@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	shared "github.com/fitglue/server/src/go/pkg"
+	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	"google.golang.org/api/idtoken"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// deferEvent publishes a parsed event to the webhook received topic so the
+// provider can be acknowledged straight away. Returns false if it couldn't be
+// published, so the caller processes it inline instead.
+func (p *Processor) deferEvent(ctx context.Context, provider SourceProvider, evt *WebhookEvent) bool {
+	ce, err := infrapubsub.NewCloudEvent(
+		fmt.Sprintf("/integrations/%s/webhook", provider.ID()),
+		infrapubsub.GetCloudEventType(pbevents.CloudEventType_CLOUD_EVENT_TYPE_WEBHOOK_RECEIVED),
+		&pbevents.WebhookReceivedEvent{
+			Provider:       provider.ID(),
+			ProviderUserId: evt.ProviderUID,
+			ActivityId:     evt.ActivityID,
+			Event:          evt.Event,
+			RawPayload:     evt.RawPayload,
+		},
+	)
+	if err == nil {
+		_, err = p.publisher.PublishCloudEvent(ctx, shared.TopicWebhookReceived, ce)
+	}
+	if err != nil {
+		p.logger.Warn(ctx, "Failed to defer webhook event, processing inline", "provider", evt.Provider, "provider_uid", evt.ProviderUID, "activity_id", evt.ActivityID, "error", err)
+		return false
+	}
+	return true
+}
+
+// HandleDeferredEvent processes a webhook event deferred by HandleEvent,
+// delivered by a Pub/Sub push subscription. Events are processed exactly as
+// they would have been inline, except that a failure to resolve the user,
+// fetch the activity or publish it returns a 500 so Pub/Sub redelivers the
+// event, until it is dead-lettered.
+func (p *Processor) HandleDeferredEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if p.verifyPush == nil {
+		p.logger.Warn(ctx, "Rejected deferred webhook event: deferral is not enabled")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if err := p.verifyPush(r); err != nil {
+		p.logger.Warn(ctx, "Rejected deferred webhook event", "error", err)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		p.logger.Error(ctx, "Failed to read request body", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	var msg struct {
+		Message struct {
+			Data []byte `json:"data"`
+			ID   string `json:"messageId"`
+		} `json:"message"`
+	}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		p.logger.Error(ctx, "Failed to unmarshal pub/sub envelope", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	var ce event.Event
+	if err := json.Unmarshal(msg.Message.Data, &ce); err != nil {
+		p.logger.Error(ctx, "Failed to unmarshal inner CloudEvent", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	var received pbevents.WebhookReceivedEvent
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(ce.Data(), &received); err != nil {
+		// Ack malformed payloads
+		p.logger.Error(ctx, "Failed to unmarshal WebhookReceivedEvent", "error", err)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	provider, ok := p.providers[received.Provider]
+	if !ok {
+		p.logger.Error(ctx, "Unknown provider for deferred event", "provider", received.Provider)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if err := p.processEvent(ctx, provider, &WebhookEvent{
+		Provider:    received.Provider,
+		ProviderUID: received.ProviderUserId,
+		ActivityID:  received.ActivityId,
+		Event:       received.Event,
+		RawPayload:  received.RawPayload,
+	}); err != nil {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// VerifyPushToken authenticates Pub/Sub push requests by the OIDC token
+// Pub/Sub attaches, which must be issued for audience to serviceAccount. The
+// webhook service is public, so Cloud Run doesn't check it for us.
+func VerifyPushToken(audience, serviceAccount string) func(r *http.Request) error {
+	return func(r *http.Request) error {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			return fmt.Errorf("missing bearer token")
+		}
+		payload, err := idtoken.Validate(r.Context(), token, audience)
+		if err != nil {
+			return err
+		}
+		if email, _ := payload.Claims["email"].(string); email != serviceAccount {
+			return fmt.Errorf("push token issued to %q", email)
+		}
+		return nil
+	}
+}
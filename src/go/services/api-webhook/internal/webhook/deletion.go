@@ -0,0 +1,18 @@
+package webhook
+
+import (
+	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+)
+
+// SourceDeletionPayload is what a provider returns from FetchActivity for an
+// activity deleted on its platform. The activity can no longer be fetched, so
+// it carries only the activity's ID for the splitter to find its runs by.
+func SourceDeletionPayload(source activitypb.ActivitySource, internalUserID, activityID string) *pbevents.ActivityPayload {
+	return &pbevents.ActivityPayload{
+		Source:           source,
+		UserId:           internalUserID,
+		ActivityId:       &activityID,
+		IsSourceDeletion: true,
+	}
+}
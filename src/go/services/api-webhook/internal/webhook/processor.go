@@ -2,14 +2,21 @@ package webhook
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"net/http"
+	"sort"
+	"time"
 
 	"github.com/cloudevents/sdk-go/v2/event"
 	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/internal/outage"
 	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
 	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // WebhookEvent represents a normalized event across all providers
@@ -37,6 +44,53 @@ type SourceProvider interface {
 	FetchActivity(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string, evt *WebhookEvent) (*pbevents.ActivityPayload, error)
 }
 
+// PollingSource is implemented by providers that offer no push webhooks and
+// must instead be polled for new activities on a schedule.
+type PollingSource interface {
+	SourceProvider
+
+	// VerifyPoll authenticates the scheduler request that triggers a poll
+	VerifyPoll(r *http.Request) error
+
+	// ListNewActivities returns events for activities the user has recorded since
+	// the previous poll, oldest first. Users without an enabled integration
+	// return no events.
+	ListNewActivities(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string) ([]*WebhookEvent, error)
+
+	// AdvanceCursor records evt, and every event listed before it, as
+	// published, so the next poll starts after it. Events after the first
+	// one that failed to publish are listed again.
+	AdvanceCursor(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string, evt *WebhookEvent) error
+}
+
+// SubscriptionMonitor is implemented by push providers whose webhook
+// subscription can lapse without notice. A scheduled check recreates the
+// subscription when it's gone and flags users whose events have stopped.
+type SubscriptionMonitor interface {
+	SourceProvider
+
+	// VerifyPoll authenticates the scheduler request that triggers a check
+	VerifyPoll(r *http.Request) error
+
+	// EnsureSubscription recreates the provider's push subscription if it no
+	// longer exists, reporting whether it had to.
+	EnsureSubscription(ctx context.Context) (bool, error)
+
+	// RecordEvent notes that a push event arrived for the user.
+	RecordEvent(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string) error
+
+	// EventsStalled reports whether the user has recorded activities recently
+	// without any push event arriving for them.
+	EventsStalled(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string) (bool, error)
+}
+
+// subscriptionAlert tags log entries that a log-based alert policy forwards to
+// admins.
+const subscriptionAlert = "webhook_subscription"
+
+// pollPageSize is the number of users fetched per ListUsers call while polling
+const pollPageSize = 100
+
 // Publisher defines the outbound event bus interface
 type Publisher interface {
 	PublishCloudEvent(ctx context.Context, topicID string, e event.Event) (string, error)
@@ -44,22 +98,35 @@ type Publisher interface {
 
 // Processor manages routing webhooks to the correct SourceProvider
 type Processor struct {
-	providers map[string]SourceProvider
-	userSvc   userpb.UserServiceClient
-	publisher Publisher
-	logger    infra.Logger
+	providers        map[string]SourceProvider
+	userSvc          userpb.UserServiceClient
+	publisher        Publisher
+	breaker          *outage.Breaker
+	outageCheckToken string
+	verifyPush       func(r *http.Request) error
+	logger           infra.Logger
 }
 
-// NewProcessor creates a new WebhookProcessor
-func NewProcessor(logger infra.Logger, userSvc userpb.UserServiceClient, publisher Publisher) *Processor {
+// NewProcessor creates a new WebhookProcessor. A nil breaker disables outage
+// queueing; outageCheckToken authenticates the scheduled outage check.
+func NewProcessor(logger infra.Logger, userSvc userpb.UserServiceClient, publisher Publisher, breaker *outage.Breaker, outageCheckToken string) *Processor {
 	return &Processor{
-		providers: make(map[string]SourceProvider),
-		userSvc:   userSvc,
-		publisher: publisher,
-		logger:    logger,
+		providers:        make(map[string]SourceProvider),
+		userSvc:          userSvc,
+		publisher:        publisher,
+		breaker:          breaker,
+		outageCheckToken: outageCheckToken,
+		logger:           logger,
 	}
 }
 
+// DeferEvents makes HandleEvent acknowledge webhooks as soon as they are
+// parsed, publishing each event for HandleDeferredEvent to process. verify
+// authenticates the Pub/Sub push requests that deliver them.
+func (p *Processor) DeferEvents(verify func(r *http.Request) error) {
+	p.verifyPush = verify
+}
+
 // Register adds a new SourceProvider to the processor
 func (p *Processor) Register(provider SourceProvider) {
 	p.providers[provider.ID()] = provider
@@ -100,49 +167,313 @@ func (p *Processor) HandleEvent(w http.ResponseWriter, r *http.Request, provider
 	}
 
 	for _, evt := range events {
-		// 1. Resolve internal user ID
-		resolveResp, err := p.userSvc.ResolveUserByIntegration(r.Context(), &userpb.ResolveUserByIntegrationRequest{
-			Provider:    evt.Provider,
-			ProviderUid: evt.ProviderUID,
-		})
-		if err != nil {
-			p.logger.Warn(r.Context(), "Skipping webhook event: User not found or resolve error", "provider", evt.Provider, "provider_uid", evt.ProviderUID, "error", err)
+		// Defer the rest to HandleDeferredEvent when enabled, falling back to
+		// processing inline if the event can't be published
+		if p.verifyPush != nil && p.deferEvent(r.Context(), provider, evt) {
 			continue
 		}
+		// Inline failures are logged and dropped; the provider is acknowledged
+		// either way
+		_ = p.processEvent(r.Context(), provider, evt)
+	}
+
+	// Always acknowledge receipt successfully if parsing succeeded
+	w.WriteHeader(http.StatusOK)
+}
 
-		internalUserID := resolveResp.Profile.UserId
+// processEvent resolves the user an event belongs to, then fetches and
+// publishes its activity. Events for users we don't know are dropped; other
+// failures are logged and returned so deferred events can be retried.
+func (p *Processor) processEvent(ctx context.Context, provider SourceProvider, evt *WebhookEvent) error {
+	// 1. Resolve internal user ID
+	resolveResp, err := p.userSvc.ResolveUserByIntegration(ctx, &userpb.ResolveUserByIntegrationRequest{
+		Provider:    evt.Provider,
+		ProviderUid: evt.ProviderUID,
+	})
+	if status.Code(err) == codes.NotFound {
+		p.logger.Warn(ctx, "Skipping webhook event: User not found", "provider", evt.Provider, "provider_uid", evt.ProviderUID)
+		return nil
+	}
+	if err != nil {
+		p.logger.Warn(ctx, "Failed to resolve user for webhook event", "provider", evt.Provider, "provider_uid", evt.ProviderUID, "error", err)
+		return fmt.Errorf("resolving user: %w", err)
+	}
+
+	internalUserID := resolveResp.Profile.UserId
+
+	if monitor, ok := provider.(SubscriptionMonitor); ok {
+		if err := monitor.RecordEvent(ctx, p.userSvc, internalUserID); err != nil {
+			p.logger.Warn(ctx, "Failed to record webhook event time", "provider", evt.Provider, "user_id", internalUserID, "error", err)
+		}
+	}
+
+	// 2. Fetch the full activity and publish it to the pipeline
+	return p.publish(ctx, provider, internalUserID, evt)
+}
 
-		// 2. Fetch the full activity data using SourceProvider
-		activityPayload, err := provider.FetchActivity(r.Context(), p.userSvc, internalUserID, evt)
+// HandlePoll runs a scheduled poll for a PollingSource, checking every user for
+// new activities and publishing them exactly as webhook events would be.
+func (p *Processor) HandlePoll(w http.ResponseWriter, r *http.Request, providerID string) {
+	provider, ok := p.providers[providerID]
+	if !ok {
+		p.logger.Error(r.Context(), "Unknown provider for poll", "provider", providerID)
+		http.Error(w, "Unknown provider", http.StatusNotFound)
+		return
+	}
+	poller, ok := provider.(PollingSource)
+	if !ok {
+		p.logger.Error(r.Context(), "Provider does not support polling", "provider", providerID)
+		http.Error(w, "Provider does not support polling", http.StatusNotFound)
+		return
+	}
+
+	if err := poller.VerifyPoll(r); err != nil {
+		p.logger.Warn(r.Context(), "Rejected poll trigger", "provider", providerID, "error", err)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	usersChecked, eventsFound := 0, 0
+	err := p.forEachUser(r.Context(), func(userID string) {
+		usersChecked++
+		events, err := poller.ListNewActivities(r.Context(), p.userSvc, userID)
 		if err != nil {
-			p.logger.Warn(r.Context(), "Skipping webhook event: Failed to fetch activity payload", "provider", evt.Provider, "user_id", internalUserID, "activity_id", evt.ActivityID, "error", err)
-			continue
+			p.logger.Warn(r.Context(), "Skipping user during poll: failed to list activities", "provider", providerID, "user_id", userID, "error", err)
+			return
 		}
-		if activityPayload == nil {
-			p.logger.Info(r.Context(), "Webhook event ignored by provider logic (returned nil payload)", "provider", evt.Provider, "user_id", internalUserID, "activity_id", evt.ActivityID)
-			continue
+		eventsFound += len(events)
+		var published *WebhookEvent
+		for _, evt := range events {
+			// Stop at the first failure so the cursor isn't moved past it
+			if err := p.publish(r.Context(), provider, userID, evt); err != nil {
+				break
+			}
+			published = evt
+		}
+		if published != nil {
+			if err := poller.AdvanceCursor(r.Context(), p.userSvc, userID, published); err != nil {
+				p.logger.Warn(r.Context(), "Failed to advance poll cursor, activities will be listed again", "provider", providerID, "user_id", userID, "activity_id", published.ActivityID, "error", err)
+			}
 		}
+	})
+	if err != nil {
+		p.logger.Error(r.Context(), "Failed to list users for poll", "provider", providerID, "error", err)
+		http.Error(w, "Failed to list users", http.StatusInternalServerError)
+		return
+	}
+
+	p.logger.Info(r.Context(), "Completed provider poll", "provider", providerID, "users_checked", usersChecked, "events_found", eventsFound)
+	w.WriteHeader(http.StatusOK)
+}
+
+// HandleSubscriptionCheck runs a scheduled health check for a
+// SubscriptionMonitor. It recreates the provider's push subscription if it has
+// been dropped and looks for users whose events have stopped arriving. Both
+// are logged with an alert tag so admins are notified.
+func (p *Processor) HandleSubscriptionCheck(w http.ResponseWriter, r *http.Request, providerID string) {
+	provider, ok := p.providers[providerID]
+	if !ok {
+		p.logger.Error(r.Context(), "Unknown provider for subscription check", "provider", providerID)
+		http.Error(w, "Unknown provider", http.StatusNotFound)
+		return
+	}
+	monitor, ok := provider.(SubscriptionMonitor)
+	if !ok {
+		p.logger.Error(r.Context(), "Provider does not support subscription checks", "provider", providerID)
+		http.Error(w, "Provider does not support subscription checks", http.StatusNotFound)
+		return
+	}
 
-		// 3. Construct and export the CloudEvent
-		ce, err := infrapubsub.NewCloudEvent(
-			fmt.Sprintf("/integrations/%s/webhook", evt.Provider),
-			"com.fitglue.activity.created",
-			activityPayload,
-		)
+	if err := monitor.VerifyPoll(r); err != nil {
+		p.logger.Warn(r.Context(), "Rejected subscription check trigger", "provider", providerID, "error", err)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	recreated, ensureErr := monitor.EnsureSubscription(r.Context())
+	if ensureErr != nil {
+		p.logger.Error(r.Context(), "Webhook subscription check failed", "provider", providerID, "alert", subscriptionAlert, "error", ensureErr)
+	} else if recreated {
+		p.logger.Error(r.Context(), "Webhook subscription was missing and has been recreated", "provider", providerID, "alert", subscriptionAlert)
+	}
+
+	usersChecked, stalledUsers := 0, 0
+	err := p.forEachUser(r.Context(), func(userID string) {
+		usersChecked++
+		stalled, err := monitor.EventsStalled(r.Context(), p.userSvc, userID)
 		if err != nil {
-			p.logger.Error(r.Context(), "Failed to pack CloudEvent data", "provider", evt.Provider, "user_id", internalUserID, "error", err)
-			continue
+			p.logger.Warn(r.Context(), "Skipping user during subscription check", "provider", providerID, "user_id", userID, "error", err)
+			return
 		}
+		if stalled {
+			stalledUsers++
+			p.logger.Warn(r.Context(), "No webhook events for user despite recent activities", "provider", providerID, "user_id", userID)
+		}
+	})
+	if err != nil {
+		p.logger.Error(r.Context(), "Failed to list users for subscription check", "provider", providerID, "error", err)
+		http.Error(w, "Failed to list users", http.StatusInternalServerError)
+		return
+	}
+	if stalledUsers > 0 {
+		p.logger.Error(r.Context(), "Webhook events have stalled for some users", "provider", providerID, "alert", subscriptionAlert, "stalled_users", stalledUsers)
+	}
+
+	p.logger.Info(r.Context(), "Completed webhook subscription check", "provider", providerID, "recreated", recreated, "users_checked", usersChecked, "stalled_users", stalledUsers)
+	if ensureErr != nil {
+		http.Error(w, "Subscription check failed", http.StatusBadGateway)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
 
-		msgID, err := p.publisher.PublishCloudEvent(r.Context(), "topic-raw-activity", ce)
+// forEachUser calls fn for every user, a page at a time.
+func (p *Processor) forEachUser(ctx context.Context, fn func(userID string)) error {
+	pageToken := ""
+	for {
+		page, err := p.userSvc.ListUsers(ctx, &userpb.ListUsersRequest{
+			Limit:     pollPageSize,
+			PageToken: pageToken,
+		})
 		if err != nil {
-			p.logger.Error(r.Context(), "Failed to publish webhook event to Pub/Sub", "provider", evt.Provider, "user_id", internalUserID, "error", err)
-			continue
+			return err
+		}
+
+		for _, profile := range page.Users {
+			fn(profile.UserId)
 		}
 
-		p.logger.Info(r.Context(), "Successfully published webhook event to Pipeline payload topic", "provider", evt.Provider, "user_id", internalUserID, "activity_id", evt.ActivityID, "msg_id", msgID)
+		if page.NextPageToken == "" {
+			return nil
+		}
+		pageToken = page.NextPageToken
 	}
+}
 
-	// Always acknowledge receipt successfully if parsing succeeded
+// publish fetches the full activity for an event and publishes it to the raw
+// activity topic. Failures are logged and returned. While the provider's
+// platform is in outage the event is queued instead, and replayed by
+// HandleOutageCheck once it recovers; that, like an event the provider
+// ignores, counts as handled.
+func (p *Processor) publish(ctx context.Context, provider SourceProvider, internalUserID string, evt *WebhookEvent) error {
+	platform := provider.ID()
+	if p.breaker.IsOpen(ctx, platform) && p.queueSourceEvent(ctx, platform, internalUserID, evt, "platform in outage") {
+		return nil
+	}
+
+	// 1. Fetch the full activity data using SourceProvider
+	activityPayload, err := provider.FetchActivity(ctx, p.userSvc, internalUserID, evt)
+	if err != nil {
+		if outage.IsOutageError(err) && p.breaker.RecordFailure(ctx, platform, err) && p.queueSourceEvent(ctx, platform, internalUserID, evt, err.Error()) {
+			return nil
+		}
+		p.logger.Warn(ctx, "Skipping webhook event: Failed to fetch activity payload", "provider", evt.Provider, "user_id", internalUserID, "activity_id", evt.ActivityID, "error", err)
+		return fmt.Errorf("fetching activity: %w", err)
+	}
+	p.breaker.RecordSuccess(ctx, platform)
+	if activityPayload == nil {
+		p.logger.Info(ctx, "Webhook event ignored by provider logic (returned nil payload)", "provider", evt.Provider, "user_id", internalUserID, "activity_id", evt.ActivityID)
+		return nil
+	}
+
+	// 2. Construct and export the CloudEvent
+	msgID, err := p.publishActivity(ctx, internalUserID, evt, activityPayload)
+	if err != nil {
+		p.logger.Error(ctx, "Failed to publish webhook event to Pub/Sub", "provider", evt.Provider, "user_id", internalUserID, "error", err)
+		return fmt.Errorf("publishing activity: %w", err)
+	}
+
+	p.logger.Info(ctx, "Successfully published webhook event to Pipeline payload topic", "provider", evt.Provider, "user_id", internalUserID, "activity_id", evt.ActivityID, "msg_id", msgID)
+	return nil
+}
+
+func (p *Processor) publishActivity(ctx context.Context, internalUserID string, evt *WebhookEvent, activityPayload *pbevents.ActivityPayload) (string, error) {
+	ce, err := infrapubsub.NewCloudEvent(
+		fmt.Sprintf("/integrations/%s/webhook", evt.Provider),
+		"com.fitglue.activity.created",
+		activityPayload,
+	)
+	if err != nil {
+		return "", fmt.Errorf("packing CloudEvent data: %w", err)
+	}
+	return p.publisher.PublishCloudEvent(ctx, "topic-raw-activity", ce)
+}
+
+// queueSourceEvent defers an event until its platform recovers. Returns
+// false if it couldn't be queued, so the caller drops it as before.
+func (p *Processor) queueSourceEvent(ctx context.Context, platform string, internalUserID string, evt *WebhookEvent, reason string) bool {
+	work := &pbpipeline.QueuedPlatformWork{
+		Platform: platform,
+		UserId:   internalUserID,
+		Reason:   &reason,
+		Work: &pbpipeline.QueuedPlatformWork_SourceEvent{SourceEvent: &pbpipeline.QueuedSourceEvent{
+			ProviderUserId: evt.ProviderUID,
+			ActivityId:     evt.ActivityID,
+			Event:          evt.Event,
+			RawPayload:     evt.RawPayload,
+		}},
+	}
+	if err := p.breaker.Queue(ctx, work); err != nil {
+		p.logger.Error(ctx, "Failed to queue webhook event during platform outage", "provider", platform, "user_id", internalUserID, "activity_id", evt.ActivityID, "error", err)
+		return false
+	}
+	p.logger.Warn(ctx, "Queued webhook event until platform recovers", "provider", platform, "user_id", internalUserID, "activity_id", evt.ActivityID, "work_id", work.Id, "reason", reason)
+	return true
+}
+
+// HandleOutageCheck runs a scheduled check of source platforms in outage and
+// replays queued webhook events for those that have recovered.
+func (p *Processor) HandleOutageCheck(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("X-Poll-Token")
+	if p.outageCheckToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(p.outageCheckToken)) != 1 {
+		p.logger.Warn(r.Context(), "Rejected outage check trigger")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	platforms := make([]string, 0, len(p.providers))
+	for id := range p.providers {
+		platforms = append(platforms, id)
+	}
+	sort.Strings(platforms)
+
+	replayed := p.breaker.Drain(r.Context(), outage.WorkSourceEvent, platforms, outage.ProbeHTTP(&http.Client{Timeout: 10 * time.Second}), p.replaySourceEvent)
+	p.logger.Info(r.Context(), "Completed source outage check", "platforms", len(platforms), "replayed", replayed)
 	w.WriteHeader(http.StatusOK)
 }
+
+func (p *Processor) replaySourceEvent(ctx context.Context, work *pbpipeline.QueuedPlatformWork) error {
+	provider, ok := p.providers[work.Platform]
+	if !ok {
+		return fmt.Errorf("unknown provider %q", work.Platform)
+	}
+	src := work.GetSourceEvent()
+	evt := &WebhookEvent{
+		Provider:    work.Platform,
+		ProviderUID: src.GetProviderUserId(),
+		ActivityID:  src.GetActivityId(),
+		Event:       src.GetEvent(),
+		RawPayload:  src.GetRawPayload(),
+	}
+
+	activityPayload, err := provider.FetchActivity(ctx, p.userSvc, work.UserId, evt)
+	if err != nil {
+		if outage.IsOutageError(err) {
+			p.breaker.RecordFailure(ctx, work.Platform, err)
+			return err
+		}
+		// Not an outage any more; drop it exactly as a live event would be
+		p.logger.Warn(ctx, "Dropping queued webhook event: Failed to fetch activity payload", "provider", work.Platform, "user_id", work.UserId, "activity_id", evt.ActivityID, "error", err)
+		return nil
+	}
+	if activityPayload == nil {
+		return nil
+	}
+
+	msgID, err := p.publishActivity(ctx, work.UserId, evt, activityPayload)
+	if err != nil {
+		return err
+	}
+	p.logger.Info(ctx, "Replayed queued webhook event", "provider", work.Platform, "user_id", work.UserId, "activity_id", evt.ActivityID, "msg_id", msgID)
+	return nil
+}
@@ -3,6 +3,7 @@ package webhook_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -10,12 +11,16 @@ import (
 
 	cloudevents "github.com/cloudevents/sdk-go/v2/event"
 	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/internal/outage"
 	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
 	"github.com/fitglue/server/src/go/services/api-webhook/internal/webhook"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func ptr[T any](v T) *T {
@@ -74,6 +79,74 @@ func (m *mockUserServiceClient) ResolveUserByIntegration(ctx context.Context, in
 	return m.resolveResp, nil
 }
 
+func (m *mockUserServiceClient) SearchUsers(ctx context.Context, in *userpb.SearchUsersRequest, opts ...grpc.CallOption) (*userpb.SearchUsersResponse, error) {
+	return &userpb.SearchUsersResponse{}, nil
+}
+func (m *mockUserServiceClient) ListUsers(ctx context.Context, in *userpb.ListUsersRequest, opts ...grpc.CallOption) (*userpb.ListUsersResponse, error) {
+	if in.PageToken == "" {
+		return &userpb.ListUsersResponse{
+			Users:         []*pbuser.UserProfile{{UserId: "user-a"}, {UserId: "user-b"}},
+			NextPageToken: "page-2",
+		}, nil
+	}
+	return &userpb.ListUsersResponse{Users: []*pbuser.UserProfile{{UserId: "user-c"}}}, nil
+}
+
+// mockPollingProvider implements webhook.PollingSource for testing
+type mockPollingProvider struct {
+	mockProvider
+	verifyErr   error
+	polledUsers []string
+	newEvents   map[string][]*webhook.WebhookEvent
+	cursors     map[string]string
+}
+
+func (m *mockPollingProvider) VerifyPoll(r *http.Request) error {
+	return m.verifyErr
+}
+
+func (m *mockPollingProvider) ListNewActivities(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string) ([]*webhook.WebhookEvent, error) {
+	m.polledUsers = append(m.polledUsers, internalUserID)
+	return m.newEvents[internalUserID], nil
+}
+
+func (m *mockPollingProvider) AdvanceCursor(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string, evt *webhook.WebhookEvent) error {
+	if m.cursors == nil {
+		m.cursors = map[string]string{}
+	}
+	m.cursors[internalUserID] = evt.ActivityID
+	return nil
+}
+
+// mockMonitorProvider implements webhook.SubscriptionMonitor for testing
+type mockMonitorProvider struct {
+	mockProvider
+	verifyErr     error
+	recreated     bool
+	ensureErr     error
+	recordedUsers []string
+	stalled       map[string]bool
+	checkedUsers  []string
+}
+
+func (m *mockMonitorProvider) VerifyPoll(r *http.Request) error {
+	return m.verifyErr
+}
+
+func (m *mockMonitorProvider) EnsureSubscription(ctx context.Context) (bool, error) {
+	return m.recreated, m.ensureErr
+}
+
+func (m *mockMonitorProvider) RecordEvent(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string) error {
+	m.recordedUsers = append(m.recordedUsers, internalUserID)
+	return nil
+}
+
+func (m *mockMonitorProvider) EventsStalled(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string) (bool, error) {
+	m.checkedUsers = append(m.checkedUsers, internalUserID)
+	return m.stalled[internalUserID], nil
+}
+
 // mockPublisher implements webhook.Publisher
 type mockPublisher struct {
 	publishedEvents []cloudevents.Event
@@ -90,7 +163,7 @@ func (m *mockPublisher) PublishCloudEvent(ctx context.Context, topic string, eve
 
 func TestProcessor_HandleVerification(t *testing.T) {
 	logger := infra.NewLogger()
-	processor := webhook.NewProcessor(logger, nil, nil)
+	processor := webhook.NewProcessor(logger, nil, nil, nil, "")
 	mock := &mockProvider{id: "testprovider"}
 	processor.Register(mock)
 
@@ -120,7 +193,7 @@ func TestProcessor_HandleEvent(t *testing.T) {
 	userClient := &mockUserServiceClient{}
 	publisher := &mockPublisher{}
 	logger := infra.NewLogger()
-	processor := webhook.NewProcessor(logger, userClient, publisher)
+	processor := webhook.NewProcessor(logger, userClient, publisher, nil, "")
 
 	mock := &mockProvider{
 		id: "testprovider",
@@ -233,3 +306,345 @@ func TestProcessor_HandleEvent(t *testing.T) {
 		assert.Empty(t, publisher.publishedEvents) // Nothing published
 	})
 }
+
+func TestProcessor_HandlePoll(t *testing.T) {
+	publisher := &mockPublisher{}
+	processor := webhook.NewProcessor(infra.NewLogger(), &mockUserServiceClient{}, publisher, nil, "")
+
+	poller := &mockPollingProvider{
+		mockProvider: mockProvider{
+			id:            "poller",
+			fetchActivity: &pbevents.ActivityPayload{ActivityId: ptr("act-1")},
+		},
+		newEvents: map[string][]*webhook.WebhookEvent{
+			"user-c": {{Provider: "poller", ActivityID: "act-1"}},
+		},
+	}
+	processor.Register(poller)
+	processor.Register(&mockProvider{id: "pushonly"})
+
+	t.Run("polls every user across pages", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		processor.HandlePoll(w, httptest.NewRequest(http.MethodPost, "/poller/poll", nil), "poller")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, []string{"user-a", "user-b", "user-c"}, poller.polledUsers)
+		assert.True(t, poller.fetchCalled)
+		assert.Len(t, publisher.publishedEvents, 1)
+		assert.Equal(t, map[string]string{"user-c": "act-1"}, poller.cursors)
+	})
+
+	t.Run("keeps the cursor before an activity that failed", func(t *testing.T) {
+		poller.cursors = nil
+		poller.fetchError = errors.New("fit file not ready")
+		defer func() { poller.fetchError = nil }()
+		w := httptest.NewRecorder()
+
+		processor.HandlePoll(w, httptest.NewRequest(http.MethodPost, "/poller/poll", nil), "poller")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, poller.cursors)
+	})
+
+	t.Run("rejects unverified trigger", func(t *testing.T) {
+		poller.verifyErr = errors.New("bad token")
+		defer func() { poller.verifyErr = nil }()
+		w := httptest.NewRecorder()
+
+		processor.HandlePoll(w, httptest.NewRequest(http.MethodPost, "/poller/poll", nil), "poller")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("provider without polling support", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		processor.HandlePoll(w, httptest.NewRequest(http.MethodPost, "/pushonly/poll", nil), "pushonly")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestProcessor_HandleSubscriptionCheck(t *testing.T) {
+	userSvc := &mockUserServiceClient{
+		resolveResp: &userpb.ResolveUserByIntegrationResponse{Profile: &pbuser.UserProfile{UserId: "user-a"}},
+	}
+	processor := webhook.NewProcessor(infra.NewLogger(), userSvc, &mockPublisher{}, nil, "")
+
+	monitor := &mockMonitorProvider{
+		mockProvider: mockProvider{
+			id:            "monitored",
+			parseEvents:   []*webhook.WebhookEvent{{Provider: "monitored", ProviderUID: "ext-1", ActivityID: "act-1"}},
+			fetchActivity: &pbevents.ActivityPayload{ActivityId: ptr("act-1")},
+		},
+		stalled: map[string]bool{"user-b": true},
+	}
+	processor.Register(monitor)
+	processor.Register(&mockProvider{id: "pushonly"})
+
+	t.Run("records event time for monitored providers", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		processor.HandleEvent(w, httptest.NewRequest(http.MethodPost, "/monitored", bytes.NewBufferString("{}")), "monitored")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, []string{"user-a"}, monitor.recordedUsers)
+	})
+
+	t.Run("checks subscription and every user", func(t *testing.T) {
+		monitor.recreated = true
+		defer func() { monitor.recreated = false }()
+		w := httptest.NewRecorder()
+
+		processor.HandleSubscriptionCheck(w, httptest.NewRequest(http.MethodPost, "/monitored/subscription-check", nil), "monitored")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, []string{"user-a", "user-b", "user-c"}, monitor.checkedUsers)
+	})
+
+	t.Run("subscription failure still checks users", func(t *testing.T) {
+		monitor.ensureErr = errors.New("strava down")
+		monitor.checkedUsers = nil
+		defer func() { monitor.ensureErr = nil }()
+		w := httptest.NewRecorder()
+
+		processor.HandleSubscriptionCheck(w, httptest.NewRequest(http.MethodPost, "/monitored/subscription-check", nil), "monitored")
+
+		assert.Equal(t, http.StatusBadGateway, w.Code)
+		assert.Len(t, monitor.checkedUsers, 3)
+	})
+
+	t.Run("rejects unverified trigger", func(t *testing.T) {
+		monitor.verifyErr = errors.New("bad token")
+		defer func() { monitor.verifyErr = nil }()
+		w := httptest.NewRecorder()
+
+		processor.HandleSubscriptionCheck(w, httptest.NewRequest(http.MethodPost, "/monitored/subscription-check", nil), "monitored")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("provider without subscription checks", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		processor.HandleSubscriptionCheck(w, httptest.NewRequest(http.MethodPost, "/pushonly/subscription-check", nil), "pushonly")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+// mockOutageStore is an in-memory outage.Store
+type mockOutageStore struct {
+	health map[string]*pbpipeline.PlatformHealth
+	queued []*pbpipeline.QueuedPlatformWork
+}
+
+func (s *mockOutageStore) GetHealth(ctx context.Context, platform string) (*pbpipeline.PlatformHealth, error) {
+	return s.health[platform], nil
+}
+func (s *mockOutageStore) UpdateHealth(ctx context.Context, platform string, fn func(h *pbpipeline.PlatformHealth)) (*pbpipeline.PlatformHealth, error) {
+	h, ok := s.health[platform]
+	if !ok {
+		h = &pbpipeline.PlatformHealth{Platform: platform}
+		s.health[platform] = h
+	}
+	fn(h)
+	return h, nil
+}
+func (s *mockOutageStore) ListOutages(ctx context.Context) ([]*pbpipeline.PlatformHealth, error) {
+	return nil, nil
+}
+func (s *mockOutageStore) Enqueue(ctx context.Context, work *pbpipeline.QueuedPlatformWork) error {
+	s.queued = append(s.queued, work)
+	return nil
+}
+func (s *mockOutageStore) ListQueued(ctx context.Context, kind outage.WorkKind, platform string, limit int) ([]*pbpipeline.QueuedPlatformWork, error) {
+	var out []*pbpipeline.QueuedPlatformWork
+	for _, w := range s.queued {
+		if w.Platform == platform && outage.KindOf(w) == kind {
+			out = append(out, w)
+		}
+	}
+	return out, nil
+}
+func (s *mockOutageStore) DeleteQueued(ctx context.Context, work *pbpipeline.QueuedPlatformWork) error {
+	for i, w := range s.queued {
+		if w.Id == work.Id {
+			s.queued = append(s.queued[:i], s.queued[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func TestProcessor_OutageQueueAndReplay(t *testing.T) {
+	store := &mockOutageStore{health: map[string]*pbpipeline.PlatformHealth{
+		"testprovider": {Platform: "testprovider", State: pbpipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_OUTAGE},
+	}}
+	logger := infra.NewLogger()
+	publisher := &mockPublisher{}
+	userClient := &mockUserServiceClient{
+		resolveResp: &userpb.ResolveUserByIntegrationResponse{Profile: &pbuser.UserProfile{UserId: "internal-user-abc"}},
+	}
+	processor := webhook.NewProcessor(logger, userClient, publisher, outage.NewBreaker(store, logger), "secret")
+
+	mock := &mockProvider{
+		id: "testprovider",
+		parseEvents: []*webhook.WebhookEvent{
+			{Provider: "testprovider", ProviderUID: "provider-uid-123", ActivityID: "act456", Event: "create"},
+		},
+		fetchActivity: &pbevents.ActivityPayload{ActivityId: ptr("act456")},
+	}
+	processor.Register(mock)
+
+	// While the platform is in outage the event is queued without a fetch
+	req := httptest.NewRequest(http.MethodPost, "/webhook/testprovider", bytes.NewBufferString("{}"))
+	w := httptest.NewRecorder()
+	processor.HandleEvent(w, req, "testprovider")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.False(t, mock.fetchCalled)
+	assert.Empty(t, publisher.publishedEvents)
+	if assert.Len(t, store.queued, 1) {
+		assert.Equal(t, "act456", store.queued[0].GetSourceEvent().ActivityId)
+		assert.Equal(t, "internal-user-abc", store.queued[0].UserId)
+	}
+
+	t.Run("rejects missing token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/outage-check", nil)
+		w := httptest.NewRecorder()
+		processor.HandleOutageCheck(w, req)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Len(t, store.queued, 1)
+	})
+
+	t.Run("replays once recovered", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/outage-check", nil)
+		req.Header.Set("X-Poll-Token", "secret")
+		w := httptest.NewRecorder()
+		processor.HandleOutageCheck(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, mock.fetchCalled)
+		assert.Len(t, publisher.publishedEvents, 1)
+		assert.Empty(t, store.queued)
+		assert.Equal(t, pbpipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_HEALTHY, store.health["testprovider"].State)
+	})
+}
+
+func TestProcessor_DeferredEvents(t *testing.T) {
+	publisher := &mockPublisher{}
+	userClient := &mockUserServiceClient{
+		resolveResp: &userpb.ResolveUserByIntegrationResponse{Profile: &pbuser.UserProfile{UserId: "internal-user-abc"}},
+	}
+	processor := webhook.NewProcessor(infra.NewLogger(), userClient, publisher, nil, "")
+	processor.DeferEvents(func(r *http.Request) error {
+		if r.Header.Get("Authorization") != "Bearer push-token" {
+			return errors.New("bad token")
+		}
+		return nil
+	})
+
+	mock := &mockProvider{
+		id: "testprovider",
+		parseEvents: []*webhook.WebhookEvent{
+			{Provider: "testprovider", ProviderUID: "provider-uid-123", ActivityID: "act456", Event: "create"},
+		},
+		fetchActivity: &pbevents.ActivityPayload{ActivityId: ptr("act456")},
+	}
+	processor.Register(mock)
+
+	// The webhook is acknowledged without resolving or fetching anything
+	req := httptest.NewRequest(http.MethodPost, "/webhook/testprovider", bytes.NewBufferString("{}"))
+	w := httptest.NewRecorder()
+	processor.HandleEvent(w, req, "testprovider")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.False(t, mock.fetchCalled)
+	if !assert.Len(t, publisher.publishedEvents, 1) {
+		return
+	}
+	deferred := publisher.publishedEvents[0]
+	assert.Equal(t, "com.fitglue.webhook.received", deferred.Type())
+
+	ceJSON, err := json.Marshal(deferred)
+	assert.NoError(t, err)
+	envelope, err := json.Marshal(map[string]interface{}{
+		"message": map[string]interface{}{"data": ceJSON, "messageId": "msg-1"},
+	})
+	assert.NoError(t, err)
+
+	t.Run("rejects unauthenticated push", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/deferred", bytes.NewReader(envelope))
+		w := httptest.NewRecorder()
+		processor.HandleDeferredEvent(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.False(t, mock.fetchCalled)
+	})
+
+	t.Run("processes pushed event", func(t *testing.T) {
+		publisher.publishedEvents = nil
+		req := httptest.NewRequest(http.MethodPost, "/deferred", bytes.NewReader(envelope))
+		req.Header.Set("Authorization", "Bearer push-token")
+		w := httptest.NewRecorder()
+		processor.HandleDeferredEvent(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, mock.fetchCalled)
+		if assert.Len(t, publisher.publishedEvents, 1) {
+			assert.Equal(t, "com.fitglue.activity.created", publisher.publishedEvents[0].Type())
+			assert.Equal(t, "/integrations/testprovider/webhook", publisher.publishedEvents[0].Source())
+		}
+	})
+
+	t.Run("returns 500 so a failed fetch is redelivered", func(t *testing.T) {
+		mock.fetchError = errors.New("provider unavailable")
+		defer func() { mock.fetchError = nil }()
+
+		req := httptest.NewRequest(http.MethodPost, "/deferred", bytes.NewReader(envelope))
+		req.Header.Set("Authorization", "Bearer push-token")
+		w := httptest.NewRecorder()
+		processor.HandleDeferredEvent(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("returns 500 so a failed user lookup is redelivered", func(t *testing.T) {
+		userClient.resolveErr = status.Error(codes.Unavailable, "user service unavailable")
+		defer func() { userClient.resolveErr = nil }()
+
+		req := httptest.NewRequest(http.MethodPost, "/deferred", bytes.NewReader(envelope))
+		req.Header.Set("Authorization", "Bearer push-token")
+		w := httptest.NewRecorder()
+		processor.HandleDeferredEvent(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("acknowledges events for unknown users", func(t *testing.T) {
+		userClient.resolveErr = status.Error(codes.NotFound, "no user")
+		defer func() { userClient.resolveErr = nil }()
+
+		req := httptest.NewRequest(http.MethodPost, "/deferred", bytes.NewReader(envelope))
+		req.Header.Set("Authorization", "Bearer push-token")
+		w := httptest.NewRecorder()
+		processor.HandleDeferredEvent(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("processes inline when deferral fails", func(t *testing.T) {
+		mock.fetchCalled = false
+		publisher.publishErr = errors.New("pubsub unavailable")
+		defer func() { publisher.publishErr = nil }()
+
+		req := httptest.NewRequest(http.MethodPost, "/webhook/testprovider", bytes.NewBufferString("{}"))
+		w := httptest.NewRecorder()
+		processor.HandleEvent(w, req, "testprovider")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, mock.fetchCalled)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/fitglue/server/src/go/pkg/domain/activity"
 	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
@@ -101,17 +102,15 @@ func (p *Provider) FetchActivity(ctx context.Context, userSvc userpb.UserService
 		return nil, fmt.Errorf("missing workout id for hevy activity fetch")
 	}
 
-	// 1. Fetch Hevy tokens for user
-	integResp, err := userSvc.GetIntegration(ctx, &userpb.GetIntegrationRequest{
+	// 1. Fetch the user's Hevy API key
+	secretResp, err := userSvc.GetIntegrationSecret(ctx, &userpb.GetIntegrationSecretRequest{
 		UserId:   internalUserID,
 		Provider: p.ID(),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get integration for user: %w", err)
+		return nil, fmt.Errorf("failed to get hevy api key for user: %w", err)
 	}
-
-	hevyInteg := integResp.Integrations.Hevy
-	if hevyInteg == nil || hevyInteg.ApiKey == "" {
+	if secretResp.Secret == "" {
 		return nil, fmt.Errorf("hevy integration not found or api key missing")
 	}
 
@@ -122,7 +121,7 @@ func (p *Provider) FetchActivity(ctx context.Context, userSvc userpb.UserService
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("api-key", hevyInteg.ApiKey)
+	req.Header.Set("api-key", secretResp.Secret)
 
 	client := &http.Client{}
 	resp, err := client.Do(req)
@@ -131,6 +130,11 @@ func (p *Provider) FetchActivity(ctx context.Context, userSvc userpb.UserService
 	}
 	defer resp.Body.Close()
 
+	// A workout that's gone by the time we fetch it was deleted in Hevy
+	if resp.StatusCode == http.StatusNotFound {
+		return webhook.SourceDeletionPayload(activitypb.ActivitySource_SOURCE_HEVY, internalUserID, workoutID), nil
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("hevy api error: status=%d body=%s", resp.StatusCode, string(body))
@@ -141,7 +145,7 @@ func (p *Provider) FetchActivity(ctx context.Context, userSvc userpb.UserService
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	stdActivity, err := mapToStandardizedActivity(rawBody, internalUserID, activitypb.ActivitySource_SOURCE_HEVY)
+	stdActivity, err := activity.MapHevyWorkout(rawBody, internalUserID, activitypb.ActivitySource_SOURCE_HEVY)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse hevy workout to standardized activity: %w", err)
 	}
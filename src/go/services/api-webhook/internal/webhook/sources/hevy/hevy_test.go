@@ -20,6 +20,7 @@ type mockUserServiceClient struct {
 	userpb.UserServiceClient
 	getIntegrationResp *userpb.GetIntegrationResponse
 	getIntegrationErr  error
+	secret             string
 }
 
 func (m *mockUserServiceClient) GetIntegration(ctx context.Context, in *userpb.GetIntegrationRequest, opts ...grpc.CallOption) (*userpb.GetIntegrationResponse, error) {
@@ -29,6 +30,13 @@ func (m *mockUserServiceClient) GetIntegration(ctx context.Context, in *userpb.G
 	return m.getIntegrationResp, nil
 }
 
+func (m *mockUserServiceClient) GetIntegrationSecret(ctx context.Context, in *userpb.GetIntegrationSecretRequest, opts ...grpc.CallOption) (*userpb.GetIntegrationSecretResponse, error) {
+	if m.getIntegrationErr != nil {
+		return nil, m.getIntegrationErr
+	}
+	return &userpb.GetIntegrationSecretResponse{Secret: m.secret}, nil
+}
+
 func TestProvider_ID(t *testing.T) {
 	p := hevy.NewProvider()
 	assert.Equal(t, "hevy", p.ID())
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
@@ -15,11 +16,27 @@ import (
 )
 
 type Provider struct {
-	verifyToken string
+	verifyToken  string
+	clientID     string
+	clientSecret string
+	callbackURL  string // where Strava delivers push events
+	checkToken   string // shared secret presented by the scheduled subscription check
+	apiBaseURL   string
+	tokenURL     string
+	httpClient   *http.Client
 }
 
-func NewProvider(verifyToken string) *Provider {
-	return &Provider{verifyToken: verifyToken}
+func NewProvider(verifyToken, clientID, clientSecret, callbackURL, checkToken string) *Provider {
+	return &Provider{
+		verifyToken:  verifyToken,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		callbackURL:  callbackURL,
+		checkToken:   checkToken,
+		apiBaseURL:   defaultAPIBaseURL,
+		tokenURL:     defaultTokenURL,
+		httpClient:   &http.Client{Timeout: 30 * time.Second},
+	}
 }
 
 func (p *Provider) ID() string {
@@ -76,10 +93,19 @@ func (p *Provider) ParseEvent(r *http.Request) ([]*webhook.WebhookEvent, error)
 		RawPayload:  body,
 	}
 
+	// Edits we can't propagate (e.g. privacy changes) aren't worth a fetch
+	if evt.Event == "update" && !isMetadataUpdate(payload.Updates) {
+		return nil, nil
+	}
+
 	return []*webhook.WebhookEvent{evt}, nil
 }
 
 func (p *Provider) FetchActivity(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string, evt *webhook.WebhookEvent) (*pbevents.ActivityPayload, error) {
+	if evt.Event == "delete" {
+		return webhook.SourceDeletionPayload(activitypb.ActivitySource_SOURCE_STRAVA, internalUserID, evt.ActivityID), nil
+	}
+
 	// 1. Fetch Strava tokens for user
 	integResp, err := userSvc.GetIntegration(ctx, &userpb.GetIntegrationRequest{
 		UserId:   internalUserID,
@@ -95,7 +121,7 @@ func (p *Provider) FetchActivity(ctx context.Context, userSvc userpb.UserService
 	}
 
 	// 2. Fetch activity from Strava API
-	url := fmt.Sprintf("https://www.strava.com/api/v3/activities/%s?include_all_efforts=true", evt.ActivityID)
+	url := fmt.Sprintf("%s/activities/%s?include_all_efforts=true", p.apiBaseURL, evt.ActivityID)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -103,8 +129,7 @@ func (p *Provider) FetchActivity(ctx context.Context, userSvc userpb.UserService
 
 	req.Header.Set("Authorization", "Bearer "+stravaInteg.AccessToken)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := p.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch strava activity: %w", err)
 	}
@@ -120,6 +145,10 @@ func (p *Provider) FetchActivity(ctx context.Context, userSvc userpb.UserService
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
+	if evt.Event == "update" {
+		return sourceUpdatePayload(internalUserID, evt.ActivityID, rawBody)
+	}
+
 	// 3. Map to StandardizedActivity
 	// For now we just create the skeleton with the raw json payload,
 	// Actual parsing of Strava types -> StandardizedActivity should be fully implemented here
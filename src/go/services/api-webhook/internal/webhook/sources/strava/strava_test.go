@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	"github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
 	"github.com/fitglue/server/src/go/services/api-webhook/internal/webhook"
@@ -32,7 +33,7 @@ func (m *mockUserServiceClient) GetIntegration(ctx context.Context, in *userpb.G
 }
 
 func TestVerifySubscription(t *testing.T) {
-	provider := strava.NewProvider("secret-token")
+	provider := strava.NewProvider("secret-token", "", "", "", "")
 
 	t.Run("success", func(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/?hub.mode=subscribe&hub.verify_token=secret-token&hub.challenge=12345", nil)
@@ -55,7 +56,7 @@ func TestVerifySubscription(t *testing.T) {
 }
 
 func TestParseEvent(t *testing.T) {
-	provider := strava.NewProvider("secret-token")
+	provider := strava.NewProvider("secret-token", "", "", "", "")
 
 	t.Run("valid activity create", func(t *testing.T) {
 		payload := map[string]interface{}{
@@ -95,7 +96,7 @@ func TestParseEvent(t *testing.T) {
 }
 
 func TestFetchActivity(t *testing.T) {
-	provider := strava.NewProvider("secret")
+	provider := strava.NewProvider("secret", "", "", "", "")
 
 	// Mock Strava API
 	stravaSvr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -133,4 +134,19 @@ func TestFetchActivity(t *testing.T) {
 		assert.Contains(t, err.Error(), "strava integration not found or access token missing")
 		assert.Nil(t, payload)
 	})
+
+	t.Run("delete event needs no fetch", func(t *testing.T) {
+		evt := &webhook.WebhookEvent{
+			Provider:   "strava",
+			ActivityID: "act123",
+			Event:      "delete",
+		}
+
+		payload, err := provider.FetchActivity(context.Background(), &mockUserServiceClient{}, "user1", evt)
+
+		assert.NoError(t, err)
+		assert.True(t, payload.IsSourceDeletion)
+		assert.Equal(t, activitypb.ActivitySource_SOURCE_STRAVA, payload.Source)
+		assert.Equal(t, "act123", payload.GetActivityId())
+	})
 }
@@ -0,0 +1,277 @@
+// nolint:proto-json
+package strava
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultAPIBaseURL = "https://www.strava.com/api/v3"
+	defaultTokenURL   = "https://www.strava.com/oauth/token"
+
+	// eventStallWindow is how long a user can keep recording activities on
+	// Strava without a push event arriving before their events count as stalled.
+	eventStallWindow = 3 * 24 * time.Hour
+
+	// eventGracePeriod ignores activities too recent for their event to be due.
+	eventGracePeriod = 1 * time.Hour
+)
+
+type pushSubscription struct {
+	ID          int64  `json:"id"`
+	CallbackURL string `json:"callback_url"`
+}
+
+// VerifyPoll checks the X-Poll-Token header sent by Cloud Scheduler when it
+// triggers the subscription check
+func (p *Provider) VerifyPoll(r *http.Request) error {
+	if p.checkToken == "" {
+		return fmt.Errorf("strava subscription check token is not configured")
+	}
+	token := r.Header.Get("X-Poll-Token")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(p.checkToken)) != 1 {
+		return fmt.Errorf("invalid X-Poll-Token")
+	}
+	return nil
+}
+
+// EnsureSubscription makes sure Strava still has a push subscription pointing
+// at our callback URL and creates one if there is none. Strava drops the
+// subscription without notice if the callback ever fails validation.
+func (p *Provider) EnsureSubscription(ctx context.Context) (bool, error) {
+	if p.clientID == "" || p.clientSecret == "" || p.callbackURL == "" {
+		return false, fmt.Errorf("strava client credentials or callback url are not configured")
+	}
+
+	q := url.Values{}
+	q.Set("client_id", p.clientID)
+	q.Set("client_secret", p.clientSecret)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.apiBaseURL+"/push_subscriptions?"+q.Encode(), nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+	rawBody, err := p.do(req, http.StatusOK)
+	if err != nil {
+		return false, fmt.Errorf("failed to list strava push subscriptions: %w", err)
+	}
+
+	var subs []pushSubscription
+	if err := json.Unmarshal(rawBody, &subs); err != nil {
+		return false, fmt.Errorf("failed to decode strava push subscriptions: %w", err)
+	}
+	for _, sub := range subs {
+		if sub.CallbackURL == p.callbackURL {
+			return false, nil
+		}
+	}
+	if len(subs) > 0 {
+		// Strava allows a single subscription per app, so one pointing elsewhere
+		// has to be removed by hand before ours can be created.
+		return false, fmt.Errorf("strava push subscription %d points at %s, not %s", subs[0].ID, subs[0].CallbackURL, p.callbackURL)
+	}
+
+	form := url.Values{}
+	form.Set("client_id", p.clientID)
+	form.Set("client_secret", p.clientSecret)
+	form.Set("callback_url", p.callbackURL)
+	form.Set("verify_token", p.verifyToken)
+	req, err = http.NewRequestWithContext(ctx, http.MethodPost, p.apiBaseURL+"/push_subscriptions", strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if _, err := p.do(req, http.StatusCreated); err != nil {
+		return false, fmt.Errorf("failed to create strava push subscription: %w", err)
+	}
+
+	return true, nil
+}
+
+// RecordEvent stores when a push event last arrived for the user, which
+// EventsStalled compares against their recent Strava activities.
+func (p *Provider) RecordEvent(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string) error {
+	integ, err := p.getIntegration(ctx, userSvc, internalUserID)
+	if err != nil {
+		return err
+	}
+	if integ == nil {
+		return nil
+	}
+	integ.LastWebhookAt = timestamppb.Now()
+	return p.saveIntegration(ctx, userSvc, internalUserID, integ)
+}
+
+// EventsStalled reports whether the user has recorded activities on Strava in
+// the last few days without any push event arriving for them.
+func (p *Provider) EventsStalled(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string) (bool, error) {
+	integ, err := p.getIntegration(ctx, userSvc, internalUserID)
+	if err != nil {
+		return false, err
+	}
+	if integ == nil || !integ.Enabled || integ.AccessToken == "" {
+		return false, nil
+	}
+
+	now := time.Now()
+	since := now.Add(-eventStallWindow)
+	if integ.LastWebhookAt != nil && integ.LastWebhookAt.AsTime().After(since) {
+		return false, nil
+	}
+	// Activities from before the user connected never produce events
+	if integ.CreatedAt != nil && integ.CreatedAt.AsTime().After(since) {
+		since = integ.CreatedAt.AsTime()
+	}
+	before := now.Add(-eventGracePeriod)
+	if !since.Before(before) {
+		return false, nil
+	}
+
+	accessToken, err := p.validAccessToken(ctx, userSvc, internalUserID, integ)
+	if err != nil {
+		return false, err
+	}
+
+	q := url.Values{}
+	q.Set("after", strconv.FormatInt(since.Unix(), 10))
+	q.Set("before", strconv.FormatInt(before.Unix(), 10))
+	q.Set("per_page", "1")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.apiBaseURL+"/athlete/activities?"+q.Encode(), nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	rawBody, err := p.do(req, http.StatusOK)
+	if err != nil {
+		return false, fmt.Errorf("failed to list strava activities: %w", err)
+	}
+
+	var activities []json.RawMessage
+	if err := json.Unmarshal(rawBody, &activities); err != nil {
+		return false, fmt.Errorf("failed to decode strava activities: %w", err)
+	}
+	return len(activities) > 0, nil
+}
+
+func (p *Provider) getIntegration(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string) (*pbuser.StravaIntegration, error) {
+	integResp, err := userSvc.GetIntegration(ctx, &userpb.GetIntegrationRequest{
+		UserId:   internalUserID,
+		Provider: p.ID(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get integration for user: %w", err)
+	}
+	return integResp.GetIntegrations().GetStrava(), nil
+}
+
+func (p *Provider) do(req *http.Request, wantStatus int) ([]byte, error) {
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	rawBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode != wantStatus {
+		return nil, fmt.Errorf("strava api error: status=%d body=%s", resp.StatusCode, string(rawBody))
+	}
+	return rawBody, nil
+}
+
+// validAccessToken returns the stored access token, refreshing it first if it
+// has expired or expires within the next minute.
+func (p *Provider) validAccessToken(ctx context.Context, userSvc userpb.UserServiceClient, userID string, integ *pbuser.StravaIntegration) (string, error) {
+	if integ.ExpiresAt == nil || time.Now().Add(1*time.Minute).Before(integ.ExpiresAt.AsTime()) {
+		return integ.AccessToken, nil
+	}
+
+	if integ.RefreshToken == "" {
+		return "", fmt.Errorf("strava access token expired and no refresh token is stored")
+	}
+
+	data := url.Values{}
+	data.Set("client_id", p.clientID)
+	data.Set("client_secret", p.clientSecret)
+	data.Set("grant_type", "refresh_token")
+	data.Set("refresh_token", integ.RefreshToken)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.tokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rawBody, err := p.do(req, http.StatusOK)
+	if err != nil {
+		return "", fmt.Errorf("strava refresh failed: %w", err)
+	}
+
+	var result struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresAt    int64  `json:"expires_at"`
+	}
+	if err := json.Unmarshal(rawBody, &result); err != nil {
+		return "", fmt.Errorf("failed to decode strava refresh response: %w", err)
+	}
+
+	integ.AccessToken = result.AccessToken
+	if result.RefreshToken != "" {
+		integ.RefreshToken = result.RefreshToken
+	}
+	integ.ExpiresAt = timestamppb.New(time.Unix(result.ExpiresAt, 0))
+
+	if err := p.saveIntegration(ctx, userSvc, userID, integ); err != nil {
+		return "", fmt.Errorf("failed to persist refreshed strava tokens: %w", err)
+	}
+
+	return result.AccessToken, nil
+}
+
+// saveIntegration writes the whole integration back, since SetIntegration
+// replaces the stored object rather than merging fields.
+func (p *Provider) saveIntegration(ctx context.Context, userSvc userpb.UserServiceClient, userID string, integ *pbuser.StravaIntegration) error {
+	integData := map[string]interface{}{
+		"enabled":       integ.Enabled,
+		"access_token":  integ.AccessToken,
+		"refresh_token": integ.RefreshToken,
+		"athlete_id":    integ.AthleteId,
+	}
+	for key, ts := range map[string]*timestamppb.Timestamp{
+		"expires_at":      integ.ExpiresAt,
+		"created_at":      integ.CreatedAt,
+		"last_used_at":    integ.LastUsedAt,
+		"last_webhook_at": integ.LastWebhookAt,
+	} {
+		if ts != nil {
+			integData[key] = ts.AsTime().UTC().Format(time.RFC3339)
+		}
+	}
+
+	pbStruct, err := structpb.NewStruct(integData)
+	if err != nil {
+		return err
+	}
+	_, err = userSvc.SetIntegration(ctx, &userpb.SetIntegrationRequest{
+		UserId:          userID,
+		Provider:        p.ID(),
+		IntegrationData: pbStruct,
+	})
+	return err
+}
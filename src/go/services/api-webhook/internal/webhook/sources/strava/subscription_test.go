@@ -0,0 +1,175 @@
+// nolint:proto-json
+package strava
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// integrationStore implements userpb.UserServiceClient for integration reads and writes
+type integrationStore struct {
+	userpb.UserServiceClient
+	integ *pbuser.StravaIntegration
+	saved map[string]interface{}
+}
+
+func (m *integrationStore) GetIntegration(ctx context.Context, in *userpb.GetIntegrationRequest, opts ...grpc.CallOption) (*userpb.GetIntegrationResponse, error) {
+	return &userpb.GetIntegrationResponse{Integrations: &pbuser.UserIntegrations{Strava: m.integ}}, nil
+}
+
+func (m *integrationStore) SetIntegration(ctx context.Context, in *userpb.SetIntegrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.saved = in.IntegrationData.AsMap()
+	return &emptypb.Empty{}, nil
+}
+
+func newTestProvider(serverURL string) *Provider {
+	p := NewProvider("verify-token", "client-id", "client-secret", "https://fitglue.test/api/webhooks/strava", "check-token")
+	p.apiBaseURL = serverURL
+	p.tokenURL = serverURL + "/oauth/token"
+	return p
+}
+
+func TestVerifyPoll(t *testing.T) {
+	p := newTestProvider("")
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("X-Poll-Token", "check-token")
+	assert.NoError(t, p.VerifyPoll(req))
+
+	req.Header.Set("X-Poll-Token", "wrong")
+	assert.Error(t, p.VerifyPoll(req))
+}
+
+func TestEnsureSubscription(t *testing.T) {
+	t.Run("existing subscription", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			_, _ = w.Write([]byte(`[{"id":1,"callback_url":"https://fitglue.test/api/webhooks/strava"}]`))
+		}))
+		defer server.Close()
+
+		created, err := newTestProvider(server.URL).EnsureSubscription(context.Background())
+
+		require.NoError(t, err)
+		assert.False(t, created)
+	})
+
+	t.Run("creates missing subscription", func(t *testing.T) {
+		var posted bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				require.NoError(t, r.ParseForm())
+				assert.Equal(t, "https://fitglue.test/api/webhooks/strava", r.PostForm.Get("callback_url"))
+				assert.Equal(t, "verify-token", r.PostForm.Get("verify_token"))
+				posted = true
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"id":2}`))
+				return
+			}
+			_, _ = w.Write([]byte(`[]`))
+		}))
+		defer server.Close()
+
+		created, err := newTestProvider(server.URL).EnsureSubscription(context.Background())
+
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.True(t, posted)
+	})
+
+	t.Run("subscription for another callback", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			_, _ = w.Write([]byte(`[{"id":1,"callback_url":"https://old.example.com/strava"}]`))
+		}))
+		defer server.Close()
+
+		_, err := newTestProvider(server.URL).EnsureSubscription(context.Background())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestEventsStalled(t *testing.T) {
+	connected := timestamppb.New(time.Now().Add(-30 * 24 * time.Hour))
+
+	t.Run("recent event", func(t *testing.T) {
+		store := &integrationStore{integ: &pbuser.StravaIntegration{
+			Enabled:       true,
+			AccessToken:   "token",
+			CreatedAt:     connected,
+			LastWebhookAt: timestamppb.New(time.Now().Add(-1 * time.Hour)),
+		}}
+
+		stalled, err := newTestProvider("http://unused.invalid").EventsStalled(context.Background(), store, "user-1")
+
+		require.NoError(t, err)
+		assert.False(t, stalled)
+	})
+
+	t.Run("activities without events", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/athlete/activities", r.URL.Path)
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`[{"id":123}]`))
+		}))
+		defer server.Close()
+		store := &integrationStore{integ: &pbuser.StravaIntegration{
+			Enabled:     true,
+			AccessToken: "token",
+			CreatedAt:   connected,
+			ExpiresAt:   timestamppb.New(time.Now().Add(1 * time.Hour)),
+		}}
+
+		stalled, err := newTestProvider(server.URL).EventsStalled(context.Background(), store, "user-1")
+
+		require.NoError(t, err)
+		assert.True(t, stalled)
+	})
+
+	t.Run("refreshes expired token", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/oauth/token" {
+				_, _ = w.Write([]byte(`{"access_token":"fresh","refresh_token":"refresh-2","expires_at":4102444800}`))
+				return
+			}
+			assert.Equal(t, "Bearer fresh", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`[]`))
+		}))
+		defer server.Close()
+		store := &integrationStore{integ: &pbuser.StravaIntegration{
+			Enabled:      true,
+			AccessToken:  "stale",
+			RefreshToken: "refresh-1",
+			CreatedAt:    connected,
+			ExpiresAt:    timestamppb.New(time.Now().Add(-1 * time.Hour)),
+		}}
+
+		stalled, err := newTestProvider(server.URL).EventsStalled(context.Background(), store, "user-1")
+
+		require.NoError(t, err)
+		assert.False(t, stalled)
+		assert.Equal(t, "fresh", store.saved["access_token"])
+		assert.Equal(t, "refresh-2", store.saved["refresh_token"])
+	})
+}
+
+func TestRecordEvent(t *testing.T) {
+	store := &integrationStore{integ: &pbuser.StravaIntegration{Enabled: true, AccessToken: "token", AthleteId: 42}}
+
+	require.NoError(t, newTestProvider("").RecordEvent(context.Background(), store, "user-1"))
+
+	assert.Equal(t, "token", store.saved["access_token"])
+	assert.NotEmpty(t, store.saved["last_webhook_at"])
+}
@@ -0,0 +1,64 @@
+// nolint:proto-json
+package strava
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/fitglue/server/src/go/pkg/domain/activity"
+	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+)
+
+// metadataUpdateKeys are the keys of a Strava update event's "updates" that
+// FitGlue propagates to other destinations. Strava sends no event for
+// description edits, so descriptions are only picked up alongside these.
+var metadataUpdateKeys = []string{"title", "type"}
+
+// isMetadataUpdate reports whether an update event changed metadata we sync
+func isMetadataUpdate(updates map[string]interface{}) bool {
+	for _, key := range metadataUpdateKeys {
+		if _, ok := updates[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+type stravaActivityMetadata struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	SportType   string `json:"sport_type"`
+	Type        string `json:"type"`
+}
+
+// sourceUpdatePayload builds the payload for an activity the user edited on
+// Strava. It carries only the activity's metadata: the splitter applies it to
+// the activity's existing runs rather than starting new ones.
+func sourceUpdatePayload(internalUserID, activityID string, rawBody []byte) (*pbevents.ActivityPayload, error) {
+	var meta stravaActivityMetadata
+	if err := json.Unmarshal(rawBody, &meta); err != nil {
+		return nil, fmt.Errorf("failed to decode strava activity: %w", err)
+	}
+
+	sportType := meta.SportType
+	if sportType == "" {
+		sportType = meta.Type
+	}
+
+	return &pbevents.ActivityPayload{
+		Source:              activitypb.ActivitySource_SOURCE_STRAVA,
+		UserId:              internalUserID,
+		OriginalPayloadJson: string(rawBody),
+		ActivityId:          &activityID,
+		IsSourceUpdate:      true,
+		StandardizedActivity: &activitypb.StandardizedActivity{
+			Source:      activitypb.ActivitySource_SOURCE_STRAVA,
+			ExternalId:  activityID,
+			UserId:      internalUserID,
+			Name:        meta.Name,
+			Description: meta.Description,
+			Type:        activity.ParseActivityTypeFromString(sportType),
+		},
+	}, nil
+}
@@ -0,0 +1,68 @@
+// nolint:proto-json
+package strava
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	"github.com/fitglue/server/src/go/services/api-webhook/internal/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEvent_Updates(t *testing.T) {
+	p := newTestProvider("")
+
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{"title change", `{"object_type":"activity","object_id":1,"aspect_type":"update","owner_id":2,"updates":{"title":"Renamed"}}`, 1},
+		{"type change", `{"object_type":"activity","object_id":1,"aspect_type":"update","owner_id":2,"updates":{"type":"Ride"}}`, 1},
+		{"privacy change", `{"object_type":"activity","object_id":1,"aspect_type":"update","owner_id":2,"updates":{"private":"true"}}`, 0},
+		{"no updates", `{"object_type":"activity","object_id":1,"aspect_type":"update","owner_id":2}`, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(tt.body))
+			events, err := p.ParseEvent(req)
+			require.NoError(t, err)
+			assert.Len(t, events, tt.want)
+		})
+	}
+}
+
+func TestFetchActivity_SourceUpdate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/activities/123", r.URL.Path)
+		assert.Equal(t, "Bearer strava-token", r.Header.Get("Authorization"))
+		w.Write([]byte(`{"id":123,"name":"Renamed Run","description":"Felt great","type":"Run","sport_type":"TrailRun"}`))
+	}))
+	defer server.Close()
+
+	p := newTestProvider(server.URL)
+	userSvc := &integrationStore{integ: &pbuser.StravaIntegration{Enabled: true, AccessToken: "strava-token"}}
+
+	payload, err := p.FetchActivity(context.Background(), userSvc, "user-1", &webhook.WebhookEvent{
+		Provider:   "strava",
+		ActivityID: "123",
+		Event:      "update",
+	})
+	require.NoError(t, err)
+
+	assert.True(t, payload.IsSourceUpdate)
+	assert.Equal(t, activitypb.ActivitySource_SOURCE_STRAVA, payload.Source)
+	assert.Equal(t, "123", payload.GetActivityId())
+	act := payload.StandardizedActivity
+	require.NotNil(t, act)
+	assert.Equal(t, "123", act.ExternalId)
+	assert.Equal(t, "Renamed Run", act.Name)
+	assert.Equal(t, "Felt great", act.Description)
+	assert.Equal(t, activitypb.ActivityType_ACTIVITY_TYPE_TRAIL_RUN, act.Type)
+}
@@ -0,0 +1,200 @@
+// nolint:proto-json
+package whoop
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// kilojoulesPerKcal converts Whoop's energy figure to the kcal used by Session.
+const kilojoulesPerKcal = 4.184
+
+type whoopWorkout struct {
+	ID         string             `json:"id"`
+	UserID     int64              `json:"user_id"`
+	Start      time.Time          `json:"start"`
+	End        time.Time          `json:"end"`
+	SportName  string             `json:"sport_name"`
+	ScoreState string             `json:"score_state"`
+	Score      *whoopWorkoutScore `json:"score"`
+}
+
+type whoopWorkoutScore struct {
+	Strain              float64            `json:"strain"`
+	AverageHeartRate    int32              `json:"average_heart_rate"`
+	MaxHeartRate        int32              `json:"max_heart_rate"`
+	Kilojoule           float64            `json:"kilojoule"`
+	PercentRecorded     float64            `json:"percent_recorded"`
+	DistanceMeter       float64            `json:"distance_meter"`
+	AltitudeGainMeter   float64            `json:"altitude_gain_meter"`
+	AltitudeChangeMeter float64            `json:"altitude_change_meter"`
+	ZoneDurations       *whoopZoneDuration `json:"zone_durations"`
+}
+
+type whoopZoneDuration struct {
+	ZoneZeroMilli  int64 `json:"zone_zero_milli"`
+	ZoneOneMilli   int64 `json:"zone_one_milli"`
+	ZoneTwoMilli   int64 `json:"zone_two_milli"`
+	ZoneThreeMilli int64 `json:"zone_three_milli"`
+	ZoneFourMilli  int64 `json:"zone_four_milli"`
+	ZoneFiveMilli  int64 `json:"zone_five_milli"`
+}
+
+func (z *whoopZoneDuration) millis() []int64 {
+	return []int64{z.ZoneZeroMilli, z.ZoneOneMilli, z.ZoneTwoMilli, z.ZoneThreeMilli, z.ZoneFourMilli, z.ZoneFiveMilli}
+}
+
+type whoopRecovery struct {
+	CycleID    int64               `json:"cycle_id"`
+	ScoreState string              `json:"score_state"`
+	Score      *whoopRecoveryScore `json:"score"`
+}
+
+type whoopRecoveryScore struct {
+	UserCalibrating  bool    `json:"user_calibrating"`
+	RecoveryScore    float64 `json:"recovery_score"`
+	RestingHeartRate float64 `json:"resting_heart_rate"`
+	HrvRmssdMilli    float64 `json:"hrv_rmssd_milli"`
+}
+
+// sportTypes maps Whoop sport names to FitGlue activity types.
+// Anything not listed is imported as a generic workout.
+var sportTypes = map[string]activitypb.ActivityType{
+	"running":              activitypb.ActivityType_ACTIVITY_TYPE_RUN,
+	"track & field":        activitypb.ActivityType_ACTIVITY_TYPE_RUN,
+	"cycling":              activitypb.ActivityType_ACTIVITY_TYPE_RIDE,
+	"mountain biking":      activitypb.ActivityType_ACTIVITY_TYPE_MOUNTAIN_BIKE_RIDE,
+	"spin":                 activitypb.ActivityType_ACTIVITY_TYPE_VIRTUAL_RIDE,
+	"walking":              activitypb.ActivityType_ACTIVITY_TYPE_WALK,
+	"hiking/rucking":       activitypb.ActivityType_ACTIVITY_TYPE_HIKE,
+	"swimming":             activitypb.ActivityType_ACTIVITY_TYPE_SWIM,
+	"rowing":               activitypb.ActivityType_ACTIVITY_TYPE_ROWING,
+	"weightlifting":        activitypb.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING,
+	"powerlifting":         activitypb.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING,
+	"functional fitness":   activitypb.ActivityType_ACTIVITY_TYPE_CROSSFIT,
+	"hiit":                 activitypb.ActivityType_ACTIVITY_TYPE_HIGH_INTENSITY_INTERVAL_TRAINING,
+	"yoga":                 activitypb.ActivityType_ACTIVITY_TYPE_YOGA,
+	"hot yoga":             activitypb.ActivityType_ACTIVITY_TYPE_YOGA,
+	"pilates":              activitypb.ActivityType_ACTIVITY_TYPE_PILATES,
+	"elliptical":           activitypb.ActivityType_ACTIVITY_TYPE_ELLIPTICAL,
+	"stairmaster":          activitypb.ActivityType_ACTIVITY_TYPE_STAIR_STEPPER,
+	"skiing":               activitypb.ActivityType_ACTIVITY_TYPE_ALPINE_SKI,
+	"cross country skiing": activitypb.ActivityType_ACTIVITY_TYPE_NORDIC_SKI,
+	"snowboarding":         activitypb.ActivityType_ACTIVITY_TYPE_SNOWBOARD,
+	"rock climbing":        activitypb.ActivityType_ACTIVITY_TYPE_ROCK_CLIMBING,
+	"tennis":               activitypb.ActivityType_ACTIVITY_TYPE_TENNIS,
+	"squash":               activitypb.ActivityType_ACTIVITY_TYPE_SQUASH,
+	"pickleball":           activitypb.ActivityType_ACTIVITY_TYPE_PICKLEBALL,
+	"soccer":               activitypb.ActivityType_ACTIVITY_TYPE_SOCCER,
+	"golf":                 activitypb.ActivityType_ACTIVITY_TYPE_GOLF,
+	"surfing":              activitypb.ActivityType_ACTIVITY_TYPE_SURFING,
+	"paddleboarding":       activitypb.ActivityType_ACTIVITY_TYPE_STAND_UP_PADDLING,
+	"kayaking":             activitypb.ActivityType_ACTIVITY_TYPE_KAYAKING,
+	"inline skating":       activitypb.ActivityType_ACTIVITY_TYPE_INLINE_SKATE,
+	"ice skating":          activitypb.ActivityType_ACTIVITY_TYPE_ICE_SKATE,
+}
+
+// mapToStandardizedActivity converts a scored Whoop workout into a
+// StandardizedActivity. Whoop's public API exposes heart rate only as
+// session averages and per-zone durations (no per-second samples), so the
+// session carries summary HR and the zone split is surfaced via metadata.
+func mapToStandardizedActivity(workout *whoopWorkout, recovery *whoopRecovery, userID string) (*activitypb.StandardizedActivity, error) {
+	if workout.ID == "" {
+		return nil, fmt.Errorf("whoop workout has no id")
+	}
+	if workout.Start.IsZero() {
+		return nil, fmt.Errorf("whoop workout %s has no start time", workout.ID)
+	}
+
+	act := &activitypb.StandardizedActivity{
+		Source:     activitypb.ActivitySource_SOURCE_WHOOP,
+		ExternalId: workout.ID,
+		UserId:     userID,
+		StartTime:  timestamppb.New(workout.Start),
+		Name:       sportDisplayName(workout.SportName),
+		Type:       activityType(workout.SportName),
+	}
+
+	session := &activitypb.Session{
+		StartTime: act.StartTime,
+	}
+	if !workout.End.IsZero() {
+		session.TotalElapsedTime = workout.End.Sub(workout.Start).Seconds()
+	}
+	// Orchestrator requires a positive duration
+	if session.TotalElapsedTime <= 0 {
+		session.TotalElapsedTime = 60
+	}
+
+	if s := workout.Score; s != nil {
+		session.TotalDistance = s.DistanceMeter
+		if s.Kilojoule > 0 {
+			kcal := s.Kilojoule / kilojoulesPerKcal
+			session.TotalCalories = &kcal
+		}
+		if s.AverageHeartRate > 0 {
+			avg := s.AverageHeartRate
+			session.AvgHeartRate = &avg
+		}
+		if s.MaxHeartRate > 0 {
+			maxHR := s.MaxHeartRate
+			session.MaxHeartRate = &maxHR
+		}
+		act.Notes = summaryNotes(s, recovery)
+	}
+
+	act.Sessions = []*activitypb.Session{session}
+
+	return act, nil
+}
+
+// buildMetadata exposes Whoop-specific scores that have no StandardizedActivity field.
+func buildMetadata(workout *whoopWorkout, recovery *whoopRecovery) map[string]string {
+	meta := map[string]string{}
+	if s := workout.Score; s != nil {
+		meta["whoop_strain"] = fmt.Sprintf("%.1f", s.Strain)
+		meta["whoop_percent_recorded"] = fmt.Sprintf("%.0f", s.PercentRecorded)
+		if s.ZoneDurations != nil {
+			for i, ms := range s.ZoneDurations.millis() {
+				meta[fmt.Sprintf("whoop_hr_zone_%d_seconds", i)] = fmt.Sprintf("%d", ms/1000)
+			}
+		}
+	}
+	if recovery != nil && recovery.Score != nil && !recovery.Score.UserCalibrating {
+		meta["whoop_recovery_score"] = fmt.Sprintf("%.0f", recovery.Score.RecoveryScore)
+		meta["whoop_resting_heart_rate"] = fmt.Sprintf("%.0f", recovery.Score.RestingHeartRate)
+		meta["whoop_hrv_rmssd_ms"] = fmt.Sprintf("%.1f", recovery.Score.HrvRmssdMilli)
+	}
+	return meta
+}
+
+func summaryNotes(score *whoopWorkoutScore, recovery *whoopRecovery) string {
+	notes := fmt.Sprintf("Whoop strain %.1f", score.Strain)
+	if recovery != nil && recovery.Score != nil && !recovery.Score.UserCalibrating {
+		notes += fmt.Sprintf(" · Recovery %.0f%%", recovery.Score.RecoveryScore)
+	}
+	return notes
+}
+
+func activityType(sportName string) activitypb.ActivityType {
+	if t, ok := sportTypes[strings.ToLower(strings.TrimSpace(sportName))]; ok {
+		return t
+	}
+	return activitypb.ActivityType_ACTIVITY_TYPE_WORKOUT
+}
+
+func sportDisplayName(sportName string) string {
+	name := strings.TrimSpace(sportName)
+	if name == "" || name == "activity" {
+		return "Whoop Workout"
+	}
+	words := strings.Fields(name)
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,316 @@
+// nolint:proto-json
+package whoop
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+	"github.com/fitglue/server/src/go/services/api-webhook/internal/webhook"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	defaultAPIBaseURL = "https://api.prod.whoop.com/developer"
+	defaultTokenURL   = "https://api.prod.whoop.com/oauth/oauth2/token"
+)
+
+// Provider implements webhook.SourceProvider for Whoop
+type Provider struct {
+	clientID     string
+	clientSecret string
+	apiBaseURL   string
+	tokenURL     string
+	httpClient   *http.Client
+}
+
+// NewProvider creates a new Whoop SourceProvider. The client credentials are
+// used both to verify webhook signatures and to refresh expired access tokens.
+func NewProvider(clientID, clientSecret string) *Provider {
+	return &Provider{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		apiBaseURL:   defaultAPIBaseURL,
+		tokenURL:     defaultTokenURL,
+		httpClient:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// ID returns the provider identifier
+func (p *Provider) ID() string {
+	return "whoop"
+}
+
+// VerifySubscription handles Whoop webhook verification.
+// Whoop registers webhooks from the developer dashboard and sends no challenge.
+func (p *Provider) VerifySubscription(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+type whoopWebhookPayload struct {
+	UserID  int64  `json:"user_id"`
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	TraceID string `json:"trace_id"`
+}
+
+// ParseEvent extracts events from a Whoop webhook
+func (p *Provider) ParseEvent(r *http.Request) ([]*webhook.WebhookEvent, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body: %w", err)
+	}
+
+	// Verify X-WHOOP-Signature: base64(HMAC-SHA256(timestamp + body, client secret))
+	if p.clientSecret != "" {
+		sig := r.Header.Get("X-WHOOP-Signature")
+		ts := r.Header.Get("X-WHOOP-Signature-Timestamp")
+		if sig == "" || ts == "" {
+			return nil, fmt.Errorf("missing X-WHOOP-Signature headers")
+		}
+
+		mac := hmac.New(sha256.New, []byte(p.clientSecret))
+		mac.Write([]byte(ts))
+		mac.Write(body)
+		expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+		if !hmac.Equal([]byte(sig), []byte(expected)) {
+			return nil, fmt.Errorf("invalid X-WHOOP-Signature")
+		}
+	}
+
+	var payload whoopWebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+
+	// Whoop sends workout.updated for both new and re-scored workouts.
+	// Sleep and recovery events are only consumed alongside a workout.
+	if payload.Type != "workout.updated" {
+		return nil, nil
+	}
+
+	if payload.ID == "" || payload.UserID == 0 {
+		return nil, fmt.Errorf("missing id or user_id")
+	}
+
+	evt := &webhook.WebhookEvent{
+		Provider:    p.ID(),
+		ProviderUID: fmt.Sprintf("%d", payload.UserID),
+		ActivityID:  payload.ID,
+		Event:       "update",
+		RawPayload:  body,
+	}
+
+	return []*webhook.WebhookEvent{evt}, nil
+}
+
+func (p *Provider) FetchActivity(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string, evt *webhook.WebhookEvent) (*pbevents.ActivityPayload, error) {
+	workoutID := evt.ActivityID
+	if workoutID == "" {
+		return nil, fmt.Errorf("missing workout id for whoop activity fetch")
+	}
+
+	// 1. Fetch Whoop tokens for user, refreshing if they are about to expire
+	integResp, err := userSvc.GetIntegration(ctx, &userpb.GetIntegrationRequest{
+		UserId:   internalUserID,
+		Provider: p.ID(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get integration for user: %w", err)
+	}
+
+	whoopInteg := integResp.Integrations.Whoop
+	if whoopInteg == nil || whoopInteg.AccessToken == "" {
+		return nil, fmt.Errorf("whoop integration not found or access token missing")
+	}
+
+	accessToken, err := p.validAccessToken(ctx, userSvc, internalUserID, whoopInteg)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Fetch workout from Whoop API
+	rawBody, err := p.get(ctx, accessToken, "/v2/activity/workout/"+url.PathEscape(workoutID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch whoop workout: %w", err)
+	}
+
+	var workout whoopWorkout
+	if err := json.Unmarshal(rawBody, &workout); err != nil {
+		return nil, fmt.Errorf("failed to decode whoop workout: %w", err)
+	}
+
+	if workout.ScoreState != "SCORED" {
+		// Whoop re-sends workout.updated once scoring completes
+		return nil, nil
+	}
+
+	// 3. Recovery is best-effort context; a workout is still published without it
+	recovery, _ := p.fetchRecovery(ctx, accessToken, workout)
+
+	stdActivity, err := mapToStandardizedActivity(&workout, recovery, internalUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse whoop workout to standardized activity: %w", err)
+	}
+
+	// 4. Construct Payload
+	payload := &pbevents.ActivityPayload{
+		Source:               activitypb.ActivitySource_SOURCE_WHOOP,
+		UserId:               internalUserID,
+		OriginalPayloadJson:  string(rawBody),
+		ActivityId:           &evt.ActivityID,
+		StandardizedActivity: stdActivity,
+		Metadata:             buildMetadata(&workout, recovery),
+	}
+
+	return payload, nil
+}
+
+// fetchRecovery returns the recovery scored for the physiological cycle the
+// workout started in, or nil if Whoop has not scored one.
+func (p *Provider) fetchRecovery(ctx context.Context, accessToken string, workout whoopWorkout) (*whoopRecovery, error) {
+	if workout.Start.IsZero() {
+		return nil, nil
+	}
+
+	q := url.Values{}
+	q.Set("start", workout.Start.Add(-24*time.Hour).UTC().Format(time.RFC3339))
+	q.Set("end", workout.Start.UTC().Format(time.RFC3339))
+	q.Set("limit", "1")
+
+	rawBody, err := p.get(ctx, accessToken, "/v2/recovery?"+q.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	var page struct {
+		Records []whoopRecovery `json:"records"`
+	}
+	if err := json.Unmarshal(rawBody, &page); err != nil {
+		return nil, fmt.Errorf("failed to decode whoop recovery: %w", err)
+	}
+
+	for i := range page.Records {
+		if page.Records[i].ScoreState == "SCORED" && page.Records[i].Score != nil {
+			return &page.Records[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (p *Provider) get(ctx context.Context, accessToken, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.apiBaseURL+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("whoop api error: status=%d body=%s", resp.StatusCode, string(body))
+	}
+
+	rawBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return rawBody, nil
+}
+
+// validAccessToken returns the stored access token, refreshing it first if it
+// has expired or expires within the next minute. Refreshed tokens are written
+// back through the user service so later fetches reuse them.
+func (p *Provider) validAccessToken(ctx context.Context, userSvc userpb.UserServiceClient, userID string, integ *pbuser.WhoopIntegration) (string, error) {
+	if integ.ExpiresAt == nil || time.Now().Add(1*time.Minute).Before(integ.ExpiresAt.AsTime()) {
+		return integ.AccessToken, nil
+	}
+
+	if integ.RefreshToken == "" {
+		return "", fmt.Errorf("whoop access token expired and no refresh token is stored")
+	}
+
+	data := url.Values{}
+	data.Set("client_id", p.clientID)
+	data.Set("client_secret", p.clientSecret)
+	data.Set("grant_type", "refresh_token")
+	data.Set("refresh_token", integ.RefreshToken)
+	data.Set("scope", "offline")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.tokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("whoop refresh request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("whoop refresh failed with status: %d", resp.StatusCode)
+	}
+
+	var result struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode whoop refresh response: %w", err)
+	}
+
+	// Whoop rotates refresh tokens; keep the old one only if none was returned
+	refreshToken := result.RefreshToken
+	if refreshToken == "" {
+		refreshToken = integ.RefreshToken
+	}
+
+	// SetIntegration replaces the whole integration object, so carry every field over
+	integData := map[string]interface{}{
+		"enabled":       integ.Enabled,
+		"access_token":  result.AccessToken,
+		"refresh_token": refreshToken,
+		"expires_at":    time.Now().Add(time.Duration(result.ExpiresIn) * time.Second).UTC().Format(time.RFC3339),
+		"whoop_user_id": integ.WhoopUserId,
+		"last_used_at":  time.Now().UTC().Format(time.RFC3339),
+	}
+	if integ.CreatedAt != nil {
+		integData["created_at"] = integ.CreatedAt.AsTime().UTC().Format(time.RFC3339)
+	}
+
+	pbStruct, err := structpb.NewStruct(integData)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode refreshed whoop tokens: %w", err)
+	}
+	if _, err := userSvc.SetIntegration(ctx, &userpb.SetIntegrationRequest{
+		UserId:          userID,
+		Provider:        p.ID(),
+		IntegrationData: pbStruct,
+	}); err != nil {
+		return "", fmt.Errorf("failed to persist refreshed whoop tokens: %w", err)
+	}
+
+	return result.AccessToken, nil
+}
@@ -0,0 +1,179 @@
+// nolint:proto-json
+package whoop
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+	"github.com/fitglue/server/src/go/services/api-webhook/internal/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type mockUserServiceClient struct {
+	userpb.UserServiceClient
+	integ  *pbuser.WhoopIntegration
+	setReq *userpb.SetIntegrationRequest
+}
+
+func (m *mockUserServiceClient) GetIntegration(ctx context.Context, in *userpb.GetIntegrationRequest, opts ...grpc.CallOption) (*userpb.GetIntegrationResponse, error) {
+	return &userpb.GetIntegrationResponse{Integrations: &pbuser.UserIntegrations{Whoop: m.integ}}, nil
+}
+
+func (m *mockUserServiceClient) SetIntegration(ctx context.Context, in *userpb.SetIntegrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.setReq = in
+	return &emptypb.Empty{}, nil
+}
+
+func sign(secret, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write(body)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestParseEvent(t *testing.T) {
+	provider := NewProvider("client", "secret")
+	body := []byte(`{"user_id": 10129, "id": "ecfc6a15-4661-442f-a9a4-f160dd7afae8", "type": "workout.updated", "trace_id": "t1"}`)
+
+	t.Run("valid signed workout", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		req.Header.Set("X-WHOOP-Signature-Timestamp", "1700000000000")
+		req.Header.Set("X-WHOOP-Signature", sign("secret", "1700000000000", body))
+
+		events, err := provider.ParseEvent(req)
+
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "whoop", events[0].Provider)
+		assert.Equal(t, "10129", events[0].ProviderUID)
+		assert.Equal(t, "ecfc6a15-4661-442f-a9a4-f160dd7afae8", events[0].ActivityID)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		req.Header.Set("X-WHOOP-Signature-Timestamp", "1700000000000")
+		req.Header.Set("X-WHOOP-Signature", sign("wrong", "1700000000000", body))
+
+		_, err := provider.ParseEvent(req)
+		assert.Error(t, err)
+	})
+
+	t.Run("ignore non-workout", func(t *testing.T) {
+		sleep := []byte(`{"user_id": 10129, "id": "abc", "type": "sleep.updated"}`)
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(sleep))
+		req.Header.Set("X-WHOOP-Signature-Timestamp", "1")
+		req.Header.Set("X-WHOOP-Signature", sign("secret", "1", sleep))
+
+		events, err := provider.ParseEvent(req)
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+	})
+}
+
+func TestFetchActivity_RefreshesExpiredToken(t *testing.T) {
+	var refreshed bool
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "old-refresh", r.PostForm.Get("refresh_token"))
+			refreshed = true
+			w.Write([]byte(`{"access_token": "new-access", "refresh_token": "new-refresh", "expires_in": 3600}`))
+		case "/v2/activity/workout/w1":
+			assert.Equal(t, "Bearer new-access", r.Header.Get("Authorization"))
+			w.Write([]byte(`{
+				"id": "w1", "user_id": 10129, "sport_name": "running", "score_state": "SCORED",
+				"start": "2026-05-01T07:00:00Z", "end": "2026-05-01T07:45:00Z",
+				"score": {"strain": 12.34, "average_heart_rate": 150, "max_heart_rate": 181,
+					"kilojoule": 2092, "percent_recorded": 100, "distance_meter": 8000,
+					"zone_durations": {"zone_two_milli": 600000, "zone_three_milli": 1200000}}
+			}`))
+		case "/v2/recovery":
+			w.Write([]byte(`{"records": [{"cycle_id": 1, "score_state": "SCORED",
+				"score": {"recovery_score": 67, "resting_heart_rate": 52, "hrv_rmssd_milli": 61.2}}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer svr.Close()
+
+	provider := NewProvider("client", "secret")
+	provider.apiBaseURL = svr.URL
+	provider.tokenURL = svr.URL + "/oauth/token"
+
+	userSvc := &mockUserServiceClient{integ: &pbuser.WhoopIntegration{
+		Enabled:      true,
+		AccessToken:  "old-access",
+		RefreshToken: "old-refresh",
+		ExpiresAt:    timestamppb.New(time.Now().Add(-time.Hour)),
+		WhoopUserId:  "10129",
+	}}
+
+	payload, err := provider.FetchActivity(context.Background(), userSvc, "user-1", &webhook.WebhookEvent{ActivityID: "w1"})
+	require.NoError(t, err)
+	require.NotNil(t, payload)
+
+	assert.True(t, refreshed)
+	require.NotNil(t, userSvc.setReq)
+	assert.Equal(t, "new-refresh", userSvc.setReq.IntegrationData.AsMap()["refresh_token"])
+	assert.Equal(t, "10129", userSvc.setReq.IntegrationData.AsMap()["whoop_user_id"])
+
+	act := payload.StandardizedActivity
+	require.NotNil(t, act)
+	assert.Equal(t, activitypb.ActivitySource_SOURCE_WHOOP, payload.Source)
+	assert.Equal(t, activitypb.ActivityType_ACTIVITY_TYPE_RUN, act.Type)
+	assert.Equal(t, "Running", act.Name)
+	require.Len(t, act.Sessions, 1)
+	assert.Equal(t, float64(2700), act.Sessions[0].TotalElapsedTime)
+	assert.Equal(t, float64(8000), act.Sessions[0].TotalDistance)
+	assert.Equal(t, int32(150), act.Sessions[0].GetAvgHeartRate())
+	assert.InDelta(t, 500, act.Sessions[0].GetTotalCalories(), 0.1)
+	assert.Equal(t, "Whoop strain 12.3 · Recovery 67%", act.Notes)
+
+	assert.Equal(t, "12.3", payload.Metadata["whoop_strain"])
+	assert.Equal(t, "67", payload.Metadata["whoop_recovery_score"])
+	assert.Equal(t, "1200", payload.Metadata["whoop_hr_zone_3_seconds"])
+}
+
+func TestFetchActivity_SkipsUnscoredWorkout(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "w1", "sport_name": "running", "score_state": "PENDING_SCORE", "start": "2026-05-01T07:00:00Z"}`))
+	}))
+	defer svr.Close()
+
+	provider := NewProvider("client", "secret")
+	provider.apiBaseURL = svr.URL
+
+	userSvc := &mockUserServiceClient{integ: &pbuser.WhoopIntegration{
+		Enabled:     true,
+		AccessToken: "access",
+		ExpiresAt:   timestamppb.New(time.Now().Add(time.Hour)),
+	}}
+
+	payload, err := provider.FetchActivity(context.Background(), userSvc, "user-1", &webhook.WebhookEvent{ActivityID: "w1"})
+	assert.NoError(t, err)
+	assert.Nil(t, payload)
+	assert.Nil(t, userSvc.setReq)
+}
+
+func TestActivityType(t *testing.T) {
+	assert.Equal(t, activitypb.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING, activityType("Weightlifting"))
+	assert.Equal(t, activitypb.ActivityType_ACTIVITY_TYPE_WORKOUT, activityType("sauna"))
+	assert.Equal(t, "Whoop Workout", sportDisplayName("activity"))
+	assert.Equal(t, "Functional Fitness", sportDisplayName("functional fitness"))
+}
@@ -0,0 +1,402 @@
+// nolint:proto-json
+package zwift
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/domain/fit_parser"
+	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+	"github.com/fitglue/server/src/go/services/api-webhook/internal/webhook"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultAPIBaseURL = "https://us-or-rly101.zwift.com"
+	defaultTokenURL   = "https://secure.zwift.com/auth/realms/zwift/protocol/openid-connect/token"
+
+	// clientID is the public client used by the Zwift Companion app
+	clientID = "Zwift_Mobile_Link"
+
+	// activityPageSize bounds how many recent activities are checked per poll
+	activityPageSize = 20
+
+	// fitFileGracePeriod is how long after an activity ends its FIT file may
+	// still appear. Activities older than that without one never get one.
+	fitFileGracePeriod = 24 * time.Hour
+)
+
+// Provider implements webhook.PollingSource for Zwift.
+// Zwift has no public webhooks, so activities are discovered by polling the
+// Companion app API and the recorded FIT file is imported directly.
+type Provider struct {
+	pollToken  string
+	apiBaseURL string
+	tokenURL   string
+	fitBaseURL string // overrides the S3 host for FIT downloads in tests
+	httpClient *http.Client
+}
+
+// NewProvider creates a new Zwift PollingSource. pollToken is the shared secret
+// Cloud Scheduler presents when triggering a poll.
+func NewProvider(pollToken string) *Provider {
+	return &Provider{
+		pollToken:  pollToken,
+		apiBaseURL: defaultAPIBaseURL,
+		tokenURL:   defaultTokenURL,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// ID returns the provider identifier
+func (p *Provider) ID() string {
+	return "zwift"
+}
+
+// VerifySubscription is a no-op; Zwift has no webhook subscriptions
+func (p *Provider) VerifySubscription(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// ParseEvent returns no events. Zwift activities only arrive via polling.
+func (p *Provider) ParseEvent(r *http.Request) ([]*webhook.WebhookEvent, error) {
+	return nil, nil
+}
+
+// VerifyPoll checks the X-Poll-Token header sent by Cloud Scheduler
+func (p *Provider) VerifyPoll(r *http.Request) error {
+	if p.pollToken == "" {
+		return fmt.Errorf("zwift poll token is not configured")
+	}
+	token := r.Header.Get("X-Poll-Token")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(p.pollToken)) != 1 {
+		return fmt.Errorf("invalid X-Poll-Token")
+	}
+	return nil
+}
+
+type zwiftActivity struct {
+	ID            int64  `json:"id"`
+	ProfileID     int64  `json:"profileId"`
+	Name          string `json:"name"`
+	Sport         string `json:"sport"`
+	StartDate     string `json:"startDate"`
+	EndDate       string `json:"endDate"`
+	FitFileBucket string `json:"fitFileBucket"`
+	FitFileKey    string `json:"fitFileKey"`
+}
+
+// endedBefore reports whether the activity ended before t. Activities with
+// no readable end date haven't.
+func (a zwiftActivity) endedBefore(t time.Time) bool {
+	for _, layout := range []string{"2006-01-02T15:04:05.000-0700", time.RFC3339} {
+		if end, err := time.Parse(layout, a.EndDate); err == nil {
+			return end.Before(t)
+		}
+	}
+	return false
+}
+
+// ListNewActivities returns activities recorded since the last poll, oldest
+// first. The first poll after connecting only records a cursor so existing
+// history is not backfilled; after that the cursor only moves in
+// AdvanceCursor, once the activities have been published. Zwift adds the FIT
+// file shortly after an activity is saved, so the list stops at the first
+// activity still without one, to be picked up by a later poll. Activities
+// that never got one within fitFileGracePeriod are skipped.
+func (p *Provider) ListNewActivities(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string) ([]*webhook.WebhookEvent, error) {
+	zwiftInteg, err := p.integration(ctx, userSvc, internalUserID)
+	if err != nil || zwiftInteg == nil {
+		return nil, err
+	}
+
+	accessToken, err := p.validAccessToken(ctx, userSvc, internalUserID, zwiftInteg)
+	if err != nil {
+		return nil, err
+	}
+
+	q := url.Values{}
+	q.Set("start", "0")
+	q.Set("limit", strconv.Itoa(activityPageSize))
+	rawBody, err := p.get(ctx, accessToken, "/api/profiles/"+url.PathEscape(zwiftInteg.ZwiftProfileId)+"/activities?"+q.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list zwift activities: %w", err)
+	}
+
+	var activities []zwiftActivity
+	if err := json.Unmarshal(rawBody, &activities); err != nil {
+		return nil, fmt.Errorf("failed to decode zwift activities: %w", err)
+	}
+	if len(activities) == 0 {
+		return nil, nil
+	}
+
+	// Zwift activity IDs increase monotonically, so the cursor is the newest ID seen
+	sort.Slice(activities, func(i, j int) bool { return activities[i].ID < activities[j].ID })
+
+	lastID, _ := strconv.ParseInt(zwiftInteg.LastActivityId, 10, 64)
+	if lastID == 0 {
+		zwiftInteg.LastActivityId = strconv.FormatInt(activities[len(activities)-1].ID, 10)
+		if err := p.saveIntegration(ctx, userSvc, internalUserID, zwiftInteg); err != nil {
+			return nil, fmt.Errorf("failed to record zwift poll cursor: %w", err)
+		}
+		return nil, nil
+	}
+
+	var events []*webhook.WebhookEvent
+	for _, a := range activities {
+		if a.ID <= lastID {
+			continue
+		}
+		if a.FitFileKey == "" {
+			if a.endedBefore(time.Now().Add(-fitFileGracePeriod)) {
+				continue
+			}
+			break
+		}
+		raw, err := json.Marshal(a)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode zwift activity: %w", err)
+		}
+		events = append(events, &webhook.WebhookEvent{
+			Provider:    p.ID(),
+			ProviderUID: zwiftInteg.ZwiftProfileId,
+			ActivityID:  strconv.FormatInt(a.ID, 10),
+			Event:       "create",
+			RawPayload:  raw,
+		})
+	}
+	return events, nil
+}
+
+// AdvanceCursor moves the poll cursor up to a published activity.
+func (p *Provider) AdvanceCursor(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string, evt *webhook.WebhookEvent) error {
+	id, err := strconv.ParseInt(evt.ActivityID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid zwift activity id %q: %w", evt.ActivityID, err)
+	}
+
+	// Re-read the integration, which may hold tokens refreshed while listing
+	zwiftInteg, err := p.integration(ctx, userSvc, internalUserID)
+	if err != nil || zwiftInteg == nil {
+		return err
+	}
+	if lastID, _ := strconv.ParseInt(zwiftInteg.LastActivityId, 10, 64); id <= lastID {
+		return nil
+	}
+
+	zwiftInteg.LastActivityId = evt.ActivityID
+	if err := p.saveIntegration(ctx, userSvc, internalUserID, zwiftInteg); err != nil {
+		return fmt.Errorf("failed to advance zwift poll cursor: %w", err)
+	}
+	return nil
+}
+
+// integration returns the user's Zwift integration, or nil when it isn't
+// connected.
+func (p *Provider) integration(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string) (*pbuser.ZwiftIntegration, error) {
+	integResp, err := userSvc.GetIntegration(ctx, &userpb.GetIntegrationRequest{
+		UserId:   internalUserID,
+		Provider: p.ID(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get integration for user: %w", err)
+	}
+
+	zwiftInteg := integResp.GetIntegrations().GetZwift()
+	if zwiftInteg == nil || !zwiftInteg.Enabled || zwiftInteg.AccessToken == "" || zwiftInteg.ZwiftProfileId == "" {
+		return nil, nil
+	}
+	return zwiftInteg, nil
+}
+
+// FetchActivity downloads the FIT file recorded for a polled activity and
+// converts it to a StandardizedActivity.
+func (p *Provider) FetchActivity(ctx context.Context, userSvc userpb.UserServiceClient, internalUserID string, evt *webhook.WebhookEvent) (*pbevents.ActivityPayload, error) {
+	if len(evt.RawPayload) == 0 {
+		return nil, fmt.Errorf("missing raw payload for zwift activity %s", evt.ActivityID)
+	}
+
+	var activity zwiftActivity
+	if err := json.Unmarshal(evt.RawPayload, &activity); err != nil {
+		return nil, fmt.Errorf("failed to decode zwift activity: %w", err)
+	}
+	if activity.FitFileBucket == "" || activity.FitFileKey == "" {
+		return nil, fmt.Errorf("zwift activity %s has no fit file", evt.ActivityID)
+	}
+
+	fitData, err := p.downloadFitFile(ctx, activity.FitFileBucket, activity.FitFileKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download zwift fit file: %w", err)
+	}
+
+	stdActivity, err := fit_parser.ParseFitFile(fitData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse zwift fit file: %w", err)
+	}
+
+	stdActivity.Source = activitypb.ActivitySource_SOURCE_ZWIFT
+	stdActivity.ExternalId = evt.ActivityID
+	stdActivity.UserId = internalUserID
+	if activity.Name != "" {
+		stdActivity.Name = activity.Name
+	}
+
+	payload := &pbevents.ActivityPayload{
+		Source:               activitypb.ActivitySource_SOURCE_ZWIFT,
+		UserId:               internalUserID,
+		OriginalPayloadJson:  string(evt.RawPayload),
+		ActivityId:           &evt.ActivityID,
+		StandardizedActivity: stdActivity,
+	}
+
+	return payload, nil
+}
+
+func (p *Provider) downloadFitFile(ctx context.Context, bucket, key string) ([]byte, error) {
+	fitURL := fmt.Sprintf("https://%s.s3.amazonaws.com/%s", bucket, key)
+	if p.fitBaseURL != "" {
+		fitURL = fmt.Sprintf("%s/%s/%s", p.fitBaseURL, bucket, key)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fitURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fit download failed with status: %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+func (p *Provider) get(ctx context.Context, accessToken, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.apiBaseURL+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("zwift api error: status=%d body=%s", resp.StatusCode, string(body))
+	}
+
+	rawBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return rawBody, nil
+}
+
+// validAccessToken returns the stored access token, refreshing it first if it
+// has expired or expires within the next minute.
+func (p *Provider) validAccessToken(ctx context.Context, userSvc userpb.UserServiceClient, userID string, integ *pbuser.ZwiftIntegration) (string, error) {
+	if integ.ExpiresAt == nil || time.Now().Add(1*time.Minute).Before(integ.ExpiresAt.AsTime()) {
+		return integ.AccessToken, nil
+	}
+
+	if integ.RefreshToken == "" {
+		return "", fmt.Errorf("zwift access token expired and no refresh token is stored")
+	}
+
+	data := url.Values{}
+	data.Set("client_id", clientID)
+	data.Set("grant_type", "refresh_token")
+	data.Set("refresh_token", integ.RefreshToken)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.tokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("zwift refresh request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("zwift refresh failed with status: %d", resp.StatusCode)
+	}
+
+	var result struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode zwift refresh response: %w", err)
+	}
+
+	integ.AccessToken = result.AccessToken
+	if result.RefreshToken != "" {
+		integ.RefreshToken = result.RefreshToken
+	}
+	integ.ExpiresAt = timestamppb.New(time.Now().Add(time.Duration(result.ExpiresIn) * time.Second))
+
+	if err := p.saveIntegration(ctx, userSvc, userID, integ); err != nil {
+		return "", fmt.Errorf("failed to persist refreshed zwift tokens: %w", err)
+	}
+
+	return result.AccessToken, nil
+}
+
+// saveIntegration writes the whole integration back, since SetIntegration
+// replaces the stored object rather than merging fields.
+func (p *Provider) saveIntegration(ctx context.Context, userSvc userpb.UserServiceClient, userID string, integ *pbuser.ZwiftIntegration) error {
+	integData := map[string]interface{}{
+		"enabled":          integ.Enabled,
+		"access_token":     integ.AccessToken,
+		"refresh_token":    integ.RefreshToken,
+		"zwift_profile_id": integ.ZwiftProfileId,
+		"last_activity_id": integ.LastActivityId,
+		"last_used_at":     time.Now().UTC().Format(time.RFC3339),
+	}
+	if integ.ExpiresAt != nil {
+		integData["expires_at"] = integ.ExpiresAt.AsTime().UTC().Format(time.RFC3339)
+	}
+	if integ.CreatedAt != nil {
+		integData["created_at"] = integ.CreatedAt.AsTime().UTC().Format(time.RFC3339)
+	}
+
+	pbStruct, err := structpb.NewStruct(integData)
+	if err != nil {
+		return err
+	}
+	_, err = userSvc.SetIntegration(ctx, &userpb.SetIntegrationRequest{
+		UserId:          userID,
+		Provider:        p.ID(),
+		IntegrationData: pbStruct,
+	})
+	return err
+}
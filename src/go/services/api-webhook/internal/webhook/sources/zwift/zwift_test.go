@@ -0,0 +1,219 @@
+// nolint:proto-json
+package zwift
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/domain/file_generators"
+	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+	"github.com/fitglue/server/src/go/services/api-webhook/internal/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type mockUserServiceClient struct {
+	userpb.UserServiceClient
+	integ  *pbuser.ZwiftIntegration
+	setReq *userpb.SetIntegrationRequest
+}
+
+func (m *mockUserServiceClient) GetIntegration(ctx context.Context, in *userpb.GetIntegrationRequest, opts ...grpc.CallOption) (*userpb.GetIntegrationResponse, error) {
+	return &userpb.GetIntegrationResponse{Integrations: &pbuser.UserIntegrations{Zwift: m.integ}}, nil
+}
+
+func (m *mockUserServiceClient) SetIntegration(ctx context.Context, in *userpb.SetIntegrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.setReq = in
+	return &emptypb.Empty{}, nil
+}
+
+const activitiesJSON = `[
+	{"id": 1003, "profileId": 42, "name": "Zwift - Watopia", "sport": "CYCLING", "fitFileBucket": "s3-fit-prd-uswest2-zwift", "fitFileKey": "prod/42/1003"},
+	{"id": 1001, "profileId": 42, "name": "Old ride", "sport": "CYCLING", "fitFileBucket": "s3-fit-prd-uswest2-zwift", "fitFileKey": "prod/42/1001"},
+	{"id": 1002, "profileId": 42, "name": "Zwift - Makuri", "sport": "CYCLING", "fitFileBucket": "s3-fit-prd-uswest2-zwift", "fitFileKey": "prod/42/1002"}
+]`
+
+func newTestProvider(t *testing.T, handler http.HandlerFunc) *Provider {
+	svr := httptest.NewServer(handler)
+	t.Cleanup(svr.Close)
+
+	provider := NewProvider("poll-secret")
+	provider.apiBaseURL = svr.URL
+	provider.tokenURL = svr.URL + "/token"
+	provider.fitBaseURL = svr.URL + "/fit"
+	return provider
+}
+
+func TestVerifyPoll(t *testing.T) {
+	provider := NewProvider("poll-secret")
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("X-Poll-Token", "poll-secret")
+	assert.NoError(t, provider.VerifyPoll(req))
+
+	req.Header.Set("X-Poll-Token", "wrong")
+	assert.Error(t, provider.VerifyPoll(req))
+
+	unconfigured := NewProvider("")
+	assert.Error(t, unconfigured.VerifyPoll(httptest.NewRequest(http.MethodPost, "/", nil)))
+}
+
+func TestListNewActivities(t *testing.T) {
+	provider := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/profiles/42/activities", r.URL.Path)
+		assert.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+		w.Write([]byte(activitiesJSON))
+	})
+
+	t.Run("first poll records cursor without backfilling", func(t *testing.T) {
+		userSvc := &mockUserServiceClient{integ: &pbuser.ZwiftIntegration{
+			Enabled:        true,
+			AccessToken:    "access",
+			ExpiresAt:      timestamppb.New(time.Now().Add(time.Hour)),
+			ZwiftProfileId: "42",
+		}}
+
+		events, err := provider.ListNewActivities(context.Background(), userSvc, "user-1")
+
+		require.NoError(t, err)
+		assert.Empty(t, events)
+		require.NotNil(t, userSvc.setReq)
+		assert.Equal(t, "1003", userSvc.setReq.IntegrationData.AsMap()["last_activity_id"])
+		assert.Equal(t, "access", userSvc.setReq.IntegrationData.AsMap()["access_token"])
+	})
+
+	t.Run("returns activities after cursor oldest first", func(t *testing.T) {
+		userSvc := &mockUserServiceClient{integ: &pbuser.ZwiftIntegration{
+			Enabled:        true,
+			AccessToken:    "access",
+			ExpiresAt:      timestamppb.New(time.Now().Add(time.Hour)),
+			ZwiftProfileId: "42",
+			LastActivityId: "1001",
+		}}
+
+		events, err := provider.ListNewActivities(context.Background(), userSvc, "user-1")
+
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, "1002", events[0].ActivityID)
+		assert.Equal(t, "1003", events[1].ActivityID)
+		assert.Equal(t, "zwift", events[0].Provider)
+		assert.Equal(t, "42", events[0].ProviderUID)
+		assert.Nil(t, userSvc.setReq, "the cursor only moves once activities are published")
+
+		require.NoError(t, provider.AdvanceCursor(context.Background(), userSvc, "user-1", events[0]))
+		require.NotNil(t, userSvc.setReq)
+		assert.Equal(t, "1002", userSvc.setReq.IntegrationData.AsMap()["last_activity_id"])
+	})
+
+	t.Run("skips users without zwift", func(t *testing.T) {
+		userSvc := &mockUserServiceClient{}
+
+		events, err := provider.ListNewActivities(context.Background(), userSvc, "user-1")
+
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+		assert.Nil(t, userSvc.setReq)
+	})
+}
+
+func TestListNewActivities_StopsAtActivityWithoutFitFile(t *testing.T) {
+	provider := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"id": 1004, "profileId": 42, "fitFileBucket": "s3-fit-prd-uswest2-zwift", "fitFileKey": "prod/42/1004"},
+			{"id": 1003, "profileId": 42, "endDate": "` + time.Now().Add(-10*time.Minute).UTC().Format("2006-01-02T15:04:05.000-0700") + `"},
+			{"id": 1002, "profileId": 42, "fitFileBucket": "s3-fit-prd-uswest2-zwift", "fitFileKey": "prod/42/1002"},
+			{"id": 1001, "profileId": 42, "endDate": "2026-01-01T18:00:00.000+0000"}
+		]`))
+	})
+	userSvc := &mockUserServiceClient{integ: &pbuser.ZwiftIntegration{
+		Enabled:        true,
+		AccessToken:    "access",
+		ExpiresAt:      timestamppb.New(time.Now().Add(time.Hour)),
+		ZwiftProfileId: "42",
+		LastActivityId: "1000",
+	}}
+
+	events, err := provider.ListNewActivities(context.Background(), userSvc, "user-1")
+
+	require.NoError(t, err)
+	// 1001 never got a FIT file; 1003's is still on its way
+	require.Len(t, events, 1)
+	assert.Equal(t, "1002", events[0].ActivityID)
+}
+
+func TestListNewActivities_RefreshesExpiredToken(t *testing.T) {
+	var refreshed bool
+	provider := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "Zwift_Mobile_Link", r.PostForm.Get("client_id"))
+			refreshed = true
+			w.Write([]byte(`{"access_token": "new-access", "refresh_token": "new-refresh", "expires_in": 21600}`))
+		default:
+			assert.Equal(t, "Bearer new-access", r.Header.Get("Authorization"))
+			w.Write([]byte(`[]`))
+		}
+	})
+
+	userSvc := &mockUserServiceClient{integ: &pbuser.ZwiftIntegration{
+		Enabled:        true,
+		AccessToken:    "old-access",
+		RefreshToken:   "old-refresh",
+		ExpiresAt:      timestamppb.New(time.Now().Add(-time.Hour)),
+		ZwiftProfileId: "42",
+		LastActivityId: "1001",
+	}}
+
+	_, err := provider.ListNewActivities(context.Background(), userSvc, "user-1")
+
+	require.NoError(t, err)
+	assert.True(t, refreshed)
+	require.NotNil(t, userSvc.setReq)
+	assert.Equal(t, "new-refresh", userSvc.setReq.IntegrationData.AsMap()["refresh_token"])
+	assert.Equal(t, "1001", userSvc.setReq.IntegrationData.AsMap()["last_activity_id"])
+}
+
+func TestFetchActivity(t *testing.T) {
+	start := time.Date(2026, 5, 1, 18, 0, 0, 0, time.UTC)
+	fitData, err := file_generators.GenerateFitFile(&activitypb.StandardizedActivity{
+		StartTime: timestamppb.New(start),
+		Type:      activitypb.ActivityType_ACTIVITY_TYPE_VIRTUAL_RIDE,
+		Sessions: []*activitypb.Session{
+			{StartTime: timestamppb.New(start), TotalElapsedTime: 60},
+		},
+	})
+	require.NoError(t, err)
+
+	provider := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/fit/s3-fit-prd-uswest2-zwift/prod/42/1003", r.URL.Path)
+		w.Write(fitData)
+	})
+
+	evt := &webhook.WebhookEvent{
+		Provider:   "zwift",
+		ActivityID: "1003",
+		RawPayload: []byte(`{"id": 1003, "name": "Zwift - Watopia", "fitFileBucket": "s3-fit-prd-uswest2-zwift", "fitFileKey": "prod/42/1003"}`),
+	}
+
+	payload, err := provider.FetchActivity(context.Background(), &mockUserServiceClient{}, "user-1", evt)
+
+	require.NoError(t, err)
+	assert.Equal(t, activitypb.ActivitySource_SOURCE_ZWIFT, payload.Source)
+	require.NotNil(t, payload.StandardizedActivity)
+	assert.Equal(t, activitypb.ActivitySource_SOURCE_ZWIFT, payload.StandardizedActivity.Source)
+	assert.Equal(t, "1003", payload.StandardizedActivity.ExternalId)
+	assert.Equal(t, "user-1", payload.StandardizedActivity.UserId)
+	assert.Equal(t, "Zwift - Watopia", payload.StandardizedActivity.Name)
+	assert.True(t, payload.StandardizedActivity.StartTime.AsTime().Equal(start))
+}
@@ -9,6 +9,8 @@ import (
 	"cloud.google.com/go/pubsub"
 	firebase "firebase.google.com/go/v4"
 	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/internal/outage"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
 	infraps "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
 	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
 	billingpb "github.com/fitglue/server/src/go/pkg/types/pb/services/billing"
@@ -26,6 +28,8 @@ import (
 	"github.com/fitglue/server/src/go/services/api-webhook/internal/webhook/sources/polar"
 	"github.com/fitglue/server/src/go/services/api-webhook/internal/webhook/sources/strava"
 	"github.com/fitglue/server/src/go/services/api-webhook/internal/webhook/sources/wahoo"
+	"github.com/fitglue/server/src/go/services/api-webhook/internal/webhook/sources/whoop"
+	"github.com/fitglue/server/src/go/services/api-webhook/internal/webhook/sources/zwift"
 
 	"google.golang.org/api/option"
 )
@@ -119,10 +123,30 @@ func main() {
 	publisher := &infraps.PubSubAdapter{Client: pubsubClient, Logger: logger}
 
 	// Instantiate Webhook Processor & Providers
-	processor := webhook.NewProcessor(logger, userClient, publisher)
+	// Platform health and the outage queue are shared with service.destination
+	firestoreClient, err := fbApp.Firestore(ctx)
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize Firestore client", "error", err)
+		os.Exit(1)
+	}
+	defer firestoreClient.Close()
+	breaker := outage.NewBreaker(outage.NewFirestoreStore(firestoreClient), logger)
+
+	processor := webhook.NewProcessor(logger, userClient, publisher, breaker, os.Getenv("OUTAGE_CHECK_TOKEN"))
+	// Acknowledge webhooks once parsed and process them from Pub/Sub, so
+	// providers aren't kept waiting on user resolution and activity fetches
+	if audience := os.Getenv("WEBHOOK_PUSH_AUDIENCE"); audience != "" {
+		processor.DeferEvents(webhook.VerifyPushToken(audience, os.Getenv("WEBHOOK_PUSH_SERVICE_ACCOUNT")))
+	}
 
 	stravaToken := os.Getenv("STRAVA_WEBHOOK_VERIFY_TOKEN")
-	processor.Register(strava.NewProvider(stravaToken))
+	processor.Register(strava.NewProvider(
+		stravaToken,
+		os.Getenv("STRAVA_CLIENT_ID"),
+		os.Getenv("STRAVA_CLIENT_SECRET"),
+		os.Getenv("STRAVA_WEBHOOK_CALLBACK_URL"),
+		os.Getenv("STRAVA_SUBSCRIPTION_CHECK_TOKEN"),
+	))
 
 	fitbitToken := os.Getenv("FITBIT_SUBSCRIBER_VERIFICATION_TOKEN")
 	fitbitClientSecret := os.Getenv("FITBIT_OAUTH_CLIENT_SECRET")
@@ -132,6 +156,8 @@ func main() {
 	processor.Register(github.NewProvider())
 	processor.Register(wahoo.NewProvider())
 	processor.Register(polar.NewProvider())
+	processor.Register(whoop.NewProvider(os.Getenv("WHOOP_CLIENT_ID"), os.Getenv("WHOOP_CLIENT_SECRET")))
+	processor.Register(zwift.NewProvider(os.Getenv("ZWIFT_POLL_TOKEN")))
 	processor.Register(mobile.NewProvider())
 	if os.Getenv("ENABLE_MOCK_PROVIDER") == "true" {
 		processor.Register(mock.NewProvider())
@@ -149,6 +175,8 @@ func main() {
 		processor,
 	)
 
+	bootstrap.PrimeConnections(ctx, bootstrap.LoadConfig(), logger, userConn, pipelineConn, activityConn, billingConn)
+
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080"
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"cloud.google.com/go/firestore"
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/storage"
+
+	"github.com/fitglue/server/src/go/internal/backfill"
+	"github.com/fitglue/server/src/go/internal/infra"
+	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
+	gcsstorage "github.com/fitglue/server/src/go/pkg/infrastructure/storage"
+	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
+)
+
+func main() {
+	logger := infra.NewLoggerWithComponent("backfill")
+	infra.InitSentry()
+	ctx := context.Background()
+	logger.Info(ctx, "Starting FitGlue Backfill Service", "version", "v1")
+
+	fsClient, err := firestore.NewClient(ctx, os.Getenv("PROJECT_ID"))
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize Firestore client", "error", err)
+		os.Exit(1)
+	}
+	defer fsClient.Close()
+
+	rawPubClient, err := pubsub.NewClient(ctx, os.Getenv("PROJECT_ID"))
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize Pub/Sub client", "error", err)
+		os.Exit(1)
+	}
+	defer rawPubClient.Close()
+	publisher := &infrapubsub.PubSubAdapter{Client: rawPubClient, Logger: logger}
+
+	gcsClient, err := storage.NewClient(ctx)
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize GCS client", "error", err)
+		os.Exit(1)
+	}
+	defer gcsClient.Close()
+
+	// Setup gRPC Connections to dependent Domain Services
+	userServiceURL := os.Getenv("USER_SERVICE_URL")
+	if userServiceURL == "" {
+		userServiceURL = "localhost:50051"
+	}
+	userConn, err := infra.GRPCDial(userServiceURL)
+	if err != nil {
+		logger.Error(ctx, "Failed to connect to User Service", "url", userServiceURL, "error", err)
+		os.Exit(1)
+	}
+	defer userConn.Close()
+	userClient := userpb.NewUserServiceClient(userConn)
+
+	store := backfill.NewFirestoreStore(fsClient)
+	svc := backfill.NewService(
+		store,
+		store,
+		publisher,
+		userClient,
+		logger,
+		backfill.NewStravaPager(),
+		backfill.NewHevyPager(),
+		backfill.NewFitbitPager(),
+	)
+
+	importer := backfill.NewImporter(store, &gcsstorage.StorageAdapter{Client: gcsClient}, publisher, logger)
+
+	// Create an HTTP handler to receive Pub/Sub pushes
+	mux := http.NewServeMux()
+	mux.HandleFunc("/reconcile", svc.HandleReconcilePush)
+	mux.HandleFunc("/import", importer.HandlePubSubPush)
+	mux.HandleFunc("/", svc.HandlePubSubPush)
+
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8080"
+	}
+
+	logger.Info(ctx, "Backfill Service listening", "port", port)
+	if err := http.ListenAndServe(fmt.Sprintf(":%s", port), infra.LoggingMiddleware(logger, mux)); err != nil {
+		logger.Error(ctx, "HTTP server failed", "error", err)
+		os.Exit(1)
+	}
+}
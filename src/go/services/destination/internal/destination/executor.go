@@ -8,19 +8,26 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/cloudevents/sdk-go/v2/event"
 	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/internal/outage"
+	"github.com/fitglue/server/src/go/internal/uploadschedule"
 	shared "github.com/fitglue/server/src/go/pkg"
 	"github.com/fitglue/server/src/go/pkg/destination"
 	activityPkg "github.com/fitglue/server/src/go/pkg/domain/activity"
 	"github.com/fitglue/server/src/go/pkg/domain/user"
+	httputil "github.com/fitglue/server/src/go/pkg/infrastructure/http"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/metrics"
+	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
 	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
 	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 // UploadExecutor handles the consumption of Pub/Sub messages
@@ -32,6 +39,9 @@ type UploadExecutor struct {
 	db             shared.Database
 	store          shared.BlobStore
 	notifications  shared.NotificationService
+	breaker        *outage.Breaker
+	schedules      uploadschedule.Store
+	publisher      shared.Publisher
 	logger         infra.Logger
 }
 
@@ -43,6 +53,9 @@ func NewUploadExecutor(
 	db shared.Database,
 	store shared.BlobStore,
 	notifications shared.NotificationService,
+	breaker *outage.Breaker,
+	schedules uploadschedule.Store,
+	publisher shared.Publisher,
 	logger infra.Logger,
 ) *UploadExecutor {
 	return &UploadExecutor{
@@ -52,6 +65,9 @@ func NewUploadExecutor(
 		db:             db,
 		store:          store,
 		notifications:  notifications,
+		breaker:        breaker,
+		schedules:      schedules,
+		publisher:      publisher,
 		logger:         logger,
 	}
 }
@@ -146,6 +162,11 @@ func (e *UploadExecutor) Process(ctx context.Context, ce *event.Event) error {
 		Integrations: integrationsResp,
 	}
 
+	if payload.EnrichmentMetadata[destination.RemoveMetadataKey] == "true" {
+		e.removeFromDestinations(ctx, &payload, userRecord, pipelineRunId)
+		return nil
+	}
+
 	// Merge EnrichmentMetadata into a new Metadata map
 	metadata := make(map[string]string)
 	if payload.EnrichmentMetadata != nil {
@@ -202,9 +223,11 @@ func (e *UploadExecutor) Process(ctx context.Context, ce *event.Event) error {
 		isUpdate = true
 	}
 
-	// Fetch the parent PipelineRun
+	// Fetch the parent PipelineRun so Update() can resolve previously uploaded external IDs
 	var pr *pbpipeline.PipelineRun
-	// pipelineRunId already extracted above for early failure writes
+	if isUpdate && pipelineRunId != "" {
+		pr = e.loadPipelineRun(ctx, payload.UserId, pipelineRunId)
+	}
 
 	for _, destEnum := range payload.Destinations {
 		if destEnum == pbplugin.DestinationType_DESTINATION_UNSPECIFIED {
@@ -220,29 +243,59 @@ func (e *UploadExecutor) Process(ctx context.Context, ce *event.Event) error {
 			continue
 		}
 
+		// Hold new posts until the destination's posting schedule allows them
+		if !isUpdate && payload.EnrichmentMetadata[uploadschedule.ReleasedMetadataKey] != "true" && e.scheduleUpload(ctx, &payload, destEnum, pipelineRunId) {
+			continue
+		}
+
+		// Don't burn an upload attempt on a platform that's known to be down
+		platform := outage.DestinationPlatform(destEnum)
+		if e.breaker.IsOpen(ctx, platform) && e.queueForOutage(ctx, &payload, destEnum, pipelineRunId, "platform in outage") {
+			continue
+		}
+
 		e.logger.Info(ctx, "Triggering destination uploader", "destination", destEnum.String(), "is_update", isUpdate)
 
+		// Record this destination's API calls for its outcome
+		uploadCtx, _ := httputil.WithCallRecorder(ctx)
+
 		var externalId string
 		var uploadErr error
 
 		// Create or Update
+		operation := "create"
+		started := time.Now()
 		if isUpdate {
-			uploadErr = uploader.Update(ctx, activityPayload, userRecord, pr)
+			operation = "update"
+			uploadErr = uploader.Update(uploadCtx, activityPayload, userRecord, pr)
 		} else {
-			externalId, uploadErr = uploader.Create(ctx, activityPayload, userRecord)
+			externalId, uploadErr = uploader.Create(uploadCtx, activityPayload, userRecord)
 		}
+		elapsed := time.Since(started)
 
 		if uploadErr != nil {
+			// A rate limit isn't an outage; wait out the budget instead
+			if e.deferForRateLimit(uploadCtx, &payload, destEnum, pipelineRunId, uploadErr) {
+				metrics.RecordUpload(ctx, platform, operation, metrics.UploadDeferred, elapsed)
+				continue
+			}
+			if outage.IsOutageError(uploadErr) && e.breaker.RecordFailure(ctx, platform, uploadErr) && e.queueForOutage(uploadCtx, &payload, destEnum, pipelineRunId, uploadErr.Error()) {
+				metrics.RecordUpload(ctx, platform, operation, metrics.UploadQueued, elapsed)
+				continue
+			}
+			metrics.RecordUpload(ctx, platform, operation, metrics.UploadFailed, elapsed)
 			e.logger.Error(ctx, "Destination uploader failed", "destination", destEnum.String(), "error", uploadErr)
 			if pipelineRunId != "" {
-				destination.UpdateStatus(ctx, e.db, e.notifications, payload.UserId, pipelineRunId, destEnum, pbpipeline.DestinationStatus_DESTINATION_STATUS_FAILED, externalId, uploadErr.Error(), payload.Name, payload.ActivityId, e.logger)
+				destination.UpdateStatus(uploadCtx, e.db, e.notifications, payload.UserId, pipelineRunId, destEnum, pbpipeline.DestinationStatus_DESTINATION_STATUS_FAILED, externalId, uploadErr.Error(), payload.Name, payload.ActivityId, e.logger)
 			}
 			continue
 		}
 
 		// Success
+		e.breaker.RecordSuccess(ctx, platform)
+		metrics.RecordUpload(ctx, platform, operation, metrics.UploadSuccess, elapsed)
 		if pipelineRunId != "" {
-			destination.UpdateStatus(ctx, e.db, e.notifications, payload.UserId, pipelineRunId, destEnum, pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS, externalId, "", payload.Name, payload.ActivityId, e.logger)
+			destination.UpdateStatus(uploadCtx, e.db, e.notifications, payload.UserId, pipelineRunId, destEnum, pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS, externalId, "", payload.Name, payload.ActivityId, e.logger)
 		}
 
 		e.logger.Info(ctx, "Destination uploader completed successfully", "destination", destEnum.String())
@@ -251,6 +304,117 @@ func (e *UploadExecutor) Process(ctx context.Context, ce *event.Event) error {
 	return nil
 }
 
+// queueForOutage defers a single destination's upload until its platform
+// recovers and marks it QUEUED_PLATFORM_OUTAGE on the pipeline run. Returns
+// false if the work couldn't be queued, so the caller fails it as usual.
+func (e *UploadExecutor) queueForOutage(ctx context.Context, payload *pbevents.EnrichedActivityEvent, destEnum pbplugin.DestinationType, pipelineRunId string, reason string) bool {
+	upload := proto.Clone(payload).(*pbevents.EnrichedActivityEvent)
+	upload.Destinations = []pbplugin.DestinationType{destEnum}
+
+	work := &pbpipeline.QueuedPlatformWork{
+		Platform: outage.DestinationPlatform(destEnum),
+		UserId:   payload.UserId,
+		Reason:   &reason,
+		Work:     &pbpipeline.QueuedPlatformWork_Upload{Upload: upload},
+	}
+	if err := e.breaker.Queue(ctx, work); err != nil {
+		e.logger.Error(ctx, "Failed to queue upload during platform outage", "destination", destEnum.String(), "error", err)
+		return false
+	}
+
+	e.logger.Warn(ctx, "Queued upload until platform recovers", "destination", destEnum.String(), "work_id", work.Id, "reason", reason)
+	if pipelineRunId != "" {
+		msg := fmt.Sprintf("%s is currently unavailable; the upload will resume automatically once it recovers", destination.FormatDestinationName(destEnum))
+		destination.UpdateStatus(ctx, e.db, e.notifications, payload.UserId, pipelineRunId, destEnum, pbpipeline.DestinationStatus_DESTINATION_STATUS_QUEUED_PLATFORM_OUTAGE, "", msg, payload.Name, payload.ActivityId, e.logger)
+	}
+	return true
+}
+
+// HandleOutageCheck is triggered by Cloud Scheduler via Pub/Sub. It probes
+// destination platforms in outage and replays queued uploads for those that
+// have recovered by routing them back onto the destination upload topic.
+func (e *UploadExecutor) HandleOutageCheck(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var platforms []string
+	for _, dest := range e.registry.Destinations() {
+		platforms = append(platforms, outage.DestinationPlatform(dest))
+	}
+
+	replayed := e.breaker.Drain(ctx, outage.WorkUpload, platforms, outage.ProbeHTTP(&http.Client{Timeout: 10 * time.Second}), e.replayUpload)
+	e.logger.Info(ctx, "Completed destination outage check", "platforms", len(platforms), "replayed", replayed)
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "OK")
+}
+
+// stuckRunAge is how long a run can sit in RUNNING without an update before
+// the reconciliation sweep settles it. Uploads retry for well under this.
+const stuckRunAge = 6 * time.Hour
+
+// HandleStuckRunSweep is triggered by Cloud Scheduler via Pub/Sub. It settles
+// pipeline runs left RUNNING by a lost status write or an upload that never
+// finished (see destination.ReconcileStuckRuns).
+func (e *UploadExecutor) HandleStuckRunSweep(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	reconciled, err := destination.ReconcileStuckRuns(ctx, e.db, stuckRunAge, e.logger)
+	if err != nil {
+		e.logger.Error(ctx, "Stuck pipeline run sweep failed", "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	e.logger.Info(ctx, "Completed stuck pipeline run sweep", "reconciled", reconciled)
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "OK")
+}
+
+// HandleShowcaseComparisonRefresh is triggered nightly by Cloud Scheduler via
+// Pub/Sub. It has the activity service recompute the stored head-to-head
+// comparisons that back the public compare page.
+func (e *UploadExecutor) HandleShowcaseComparisonRefresh(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	res, err := e.activityClient.RefreshShowcaseComparisons(ctx, &activitypb.RefreshShowcaseComparisonsRequest{})
+	if err != nil {
+		e.logger.Error(ctx, "Showcase comparison refresh failed", "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	e.logger.Info(ctx, "Completed showcase comparison refresh", "refreshed", res.Refreshed, "removed", res.Removed)
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "OK")
+}
+
+// replayUpload republishes a queued upload so it flows back through Process.
+func (e *UploadExecutor) replayUpload(ctx context.Context, work *pbpipeline.QueuedPlatformWork) error {
+	upload := work.GetUpload()
+	if upload == nil || len(upload.Destinations) == 0 {
+		return fmt.Errorf("queued work %s has no upload", work.Id)
+	}
+	return e.publishUpload(ctx, upload)
+}
+
+// publishUpload publishes an upload exactly as the pipeline router would
+// have, so it flows back through Process.
+func (e *UploadExecutor) publishUpload(ctx context.Context, upload *pbevents.EnrichedActivityEvent) error {
+	ce, err := infrapubsub.NewCloudEvent(
+		infrapubsub.GetCloudEventSource(pbevents.CloudEventSource_CLOUD_EVENT_SOURCE_ROUTER),
+		fmt.Sprintf("com.fitglue.job.%s", upload.Destinations[0].String()),
+		upload,
+	)
+	if err != nil {
+		return err
+	}
+	if upload.PipelineExecutionId != nil {
+		ce.SetExtension("pipeline_execution_id", *upload.PipelineExecutionId)
+	}
+	_, err = e.publisher.PublishCloudEvent(ctx, shared.TopicDestinationUpload, ce)
+	return err
+}
+
 // writeFailureForAllDestinations writes DESTINATION_STATUS_FAILED for every destination
 // in the payload. Used when a systemic error (e.g. user service 403) prevents any
 // uploader from running, so the user sees "failed" instead of "pending" forever.
@@ -265,3 +429,24 @@ func (e *UploadExecutor) writeFailureForAllDestinations(ctx context.Context, pay
 		destination.UpdateStatus(ctx, e.db, e.notifications, payload.UserId, pipelineRunId, destEnum, pbpipeline.DestinationStatus_DESTINATION_STATUS_FAILED, "", errMsg, payload.Name, payload.ActivityId, e.logger)
 	}
 }
+
+// loadPipelineRun fetches the pipeline run and overlays the destination outcomes
+// subcollection, which is the source of truth for per-destination external IDs.
+// Returns nil if the run cannot be loaded; uploaders treat that as "nothing to update".
+func (e *UploadExecutor) loadPipelineRun(ctx context.Context, userId, pipelineRunId string) *pbpipeline.PipelineRun {
+	pr, err := e.db.GetPipelineRun(ctx, userId, pipelineRunId)
+	if err != nil || pr == nil {
+		e.logger.Warn(ctx, "Failed to load pipeline run for update", "pipeline_run_id", pipelineRunId, "error", err)
+		return nil
+	}
+
+	outcomes, err := e.db.GetDestinationOutcomes(ctx, userId, pipelineRunId)
+	if err != nil {
+		e.logger.Warn(ctx, "Failed to load destination outcomes for update", "pipeline_run_id", pipelineRunId, "error", err)
+		return pr
+	}
+	if len(outcomes) > 0 {
+		pr.Destinations = outcomes
+	}
+	return pr
+}
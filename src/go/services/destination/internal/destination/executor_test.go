@@ -2,12 +2,22 @@ package destination
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/cloudevents/sdk-go/v2/event"
 	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/internal/outage"
+	"github.com/fitglue/server/src/go/internal/uploadschedule"
 	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/destination"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	httputil "github.com/fitglue/server/src/go/pkg/infrastructure/http"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/ratelimit"
 	"github.com/fitglue/server/src/go/pkg/testing/mocks"
 	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
 	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
@@ -20,6 +30,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type mockUserServiceClient struct {
@@ -42,6 +53,9 @@ func (m *mockUserServiceClient) UpdateProfile(ctx context.Context, in *userpb.Up
 func (m *mockUserServiceClient) GetIntegration(ctx context.Context, in *userpb.GetIntegrationRequest, opts ...grpc.CallOption) (*userpb.GetIntegrationResponse, error) {
 	return nil, nil
 }
+func (m *mockUserServiceClient) GetIntegrationSecret(ctx context.Context, in *userpb.GetIntegrationSecretRequest, opts ...grpc.CallOption) (*userpb.GetIntegrationSecretResponse, error) {
+	return nil, nil
+}
 func (m *mockUserServiceClient) SetIntegration(ctx context.Context, in *userpb.SetIntegrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	return nil, nil
 }
@@ -93,6 +107,9 @@ func (m *mockUserServiceClient) GenerateRegistrationSummary(ctx context.Context,
 func (m *mockUserServiceClient) ResolveUserByIntegration(ctx context.Context, in *userpb.ResolveUserByIntegrationRequest, opts ...grpc.CallOption) (*userpb.ResolveUserByIntegrationResponse, error) {
 	return nil, nil
 }
+func (m *mockUserServiceClient) SearchUsers(ctx context.Context, in *userpb.SearchUsersRequest, opts ...grpc.CallOption) (*userpb.SearchUsersResponse, error) {
+	return nil, nil
+}
 func (m *mockUserServiceClient) ListUsers(ctx context.Context, in *userpb.ListUsersRequest, opts ...grpc.CallOption) (*userpb.ListUsersResponse, error) {
 	return nil, nil
 }
@@ -105,6 +122,24 @@ func (m *mockUserServiceClient) SetPersonalRecord(ctx context.Context, in *userp
 func (m *mockUserServiceClient) DeletePersonalRecord(ctx context.Context, in *userpb.DeletePersonalRecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	return nil, nil
 }
+func (m *mockUserServiceClient) ListGear(ctx context.Context, in *userpb.ListGearRequest, opts ...grpc.CallOption) (*userpb.ListGearResponse, error) {
+	return nil, nil
+}
+func (m *mockUserServiceClient) SetGear(ctx context.Context, in *userpb.SetGearRequest, opts ...grpc.CallOption) (*pbuser.Gear, error) {
+	return nil, nil
+}
+func (m *mockUserServiceClient) DeleteGear(ctx context.Context, in *userpb.DeleteGearRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, nil
+}
+func (m *mockUserServiceClient) ListGoals(ctx context.Context, in *userpb.ListGoalsRequest, opts ...grpc.CallOption) (*userpb.ListGoalsResponse, error) {
+	return nil, nil
+}
+func (m *mockUserServiceClient) SetGoal(ctx context.Context, in *userpb.SetGoalRequest, opts ...grpc.CallOption) (*pbuser.Goal, error) {
+	return nil, nil
+}
+func (m *mockUserServiceClient) DeleteGoal(ctx context.Context, in *userpb.DeleteGoalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, nil
+}
 func (m *mockUserServiceClient) ListPluginDefaults(ctx context.Context, in *userpb.ListPluginDefaultsRequest, opts ...grpc.CallOption) (*userpb.ListPluginDefaultsResponse, error) {
 	return nil, nil
 }
@@ -186,6 +221,24 @@ func (m *mockActivityServiceClient) GetShowcaseProfilePictureUploadUrl(ctx conte
 func (m *mockActivityServiceClient) GetPublicShowcaseProfile(ctx context.Context, in *activitypb.GetPublicShowcaseProfileRequest, opts ...grpc.CallOption) (*activitypb.GetPublicShowcaseProfileResponse, error) {
 	return nil, nil
 }
+func (m *mockActivityServiceClient) GetPublicShowcaseRouteStats(ctx context.Context, in *activitypb.GetPublicShowcaseRouteStatsRequest, opts ...grpc.CallOption) (*activitypb.GetPublicShowcaseRouteStatsResponse, error) {
+	return nil, nil
+}
+func (m *mockActivityServiceClient) GetPublicShowcaseFeed(ctx context.Context, in *activitypb.GetPublicShowcaseFeedRequest, opts ...grpc.CallOption) (*activitypb.GetPublicShowcaseFeedResponse, error) {
+	return nil, nil
+}
+func (m *mockActivityServiceClient) GetPublicShowcaseComparison(ctx context.Context, in *activitypb.GetPublicShowcaseComparisonRequest, opts ...grpc.CallOption) (*pbactivity.ShowcaseComparison, error) {
+	return nil, nil
+}
+func (m *mockActivityServiceClient) RefreshShowcaseComparisons(ctx context.Context, in *activitypb.RefreshShowcaseComparisonsRequest, opts ...grpc.CallOption) (*activitypb.RefreshShowcaseComparisonsResponse, error) {
+	return nil, nil
+}
+func (m *mockActivityServiceClient) GetPublicShowcaseEmbed(ctx context.Context, in *activitypb.GetPublicShowcaseEmbedRequest, opts ...grpc.CallOption) (*activitypb.GetPublicShowcaseEmbedResponse, error) {
+	return nil, nil
+}
+func (m *mockActivityServiceClient) CreateShowcaseEmbedLink(ctx context.Context, in *activitypb.CreateShowcaseEmbedLinkRequest, opts ...grpc.CallOption) (*activitypb.CreateShowcaseEmbedLinkResponse, error) {
+	return nil, nil
+}
 func (m *mockActivityServiceClient) GetActivityStats(ctx context.Context, in *activitypb.GetActivityStatsRequest, opts ...grpc.CallOption) (*activitypb.GetActivityStatsResponse, error) {
 	return nil, nil
 }
@@ -219,7 +272,7 @@ func TestUploadExecutor_Process(t *testing.T) {
 	notifications := &mockNotificationService{}
 	logger := infra.NewLogger()
 
-	executor := NewUploadExecutor(registry, userClient, activityClient, db, nil, notifications, logger)
+	executor := NewUploadExecutor(registry, userClient, activityClient, db, nil, notifications, nil, nil, nil, logger)
 
 	// Create a test payload
 	payload := &pbevents.EnrichedActivityEvent{
@@ -264,7 +317,7 @@ func TestUploadExecutor_Process_GetProfileError_WritesFailure(t *testing.T) {
 	notifications := &mockNotificationService{}
 	logger := infra.NewLogger()
 
-	executor := NewUploadExecutor(registry, userClient, activityClient, db, nil, notifications, logger)
+	executor := NewUploadExecutor(registry, userClient, activityClient, db, nil, notifications, nil, nil, nil, logger)
 
 	// Override the DB to track outcomes written by writeFailureForAllDestinations
 	type outcomeTracker struct {
@@ -309,3 +362,422 @@ func TestUploadExecutor_Process_GetProfileError_WritesFailure(t *testing.T) {
 	_ = writtenOutcomes
 	_ = tracker
 }
+
+// outageStore is an in-memory outage.Store with Strava already in outage
+type outageStore struct {
+	health map[string]*pbpipeline.PlatformHealth
+	queued []*pbpipeline.QueuedPlatformWork
+}
+
+func (s *outageStore) GetHealth(ctx context.Context, platform string) (*pbpipeline.PlatformHealth, error) {
+	return s.health[platform], nil
+}
+func (s *outageStore) UpdateHealth(ctx context.Context, platform string, fn func(h *pbpipeline.PlatformHealth)) (*pbpipeline.PlatformHealth, error) {
+	h, ok := s.health[platform]
+	if !ok {
+		h = &pbpipeline.PlatformHealth{Platform: platform}
+		s.health[platform] = h
+	}
+	fn(h)
+	return h, nil
+}
+func (s *outageStore) ListOutages(ctx context.Context) ([]*pbpipeline.PlatformHealth, error) {
+	return nil, nil
+}
+func (s *outageStore) Enqueue(ctx context.Context, work *pbpipeline.QueuedPlatformWork) error {
+	s.queued = append(s.queued, work)
+	return nil
+}
+func (s *outageStore) ListQueued(ctx context.Context, kind outage.WorkKind, platform string, limit int) ([]*pbpipeline.QueuedPlatformWork, error) {
+	return s.queued, nil
+}
+func (s *outageStore) DeleteQueued(ctx context.Context, work *pbpipeline.QueuedPlatformWork) error {
+	return nil
+}
+
+type outcomeDB struct {
+	*mocks.MockDatabase
+	outcomes []*pbpipeline.DestinationOutcome
+}
+
+func (d *outcomeDB) UpdateDestinationOutcomes(ctx context.Context, userId string, pipelineRunId string, fn func(run *pbpipeline.PipelineRun, outcomes []*pbpipeline.DestinationOutcome) ([]*pbpipeline.DestinationOutcome, map[string]interface{})) error {
+	changed, _ := fn(&pbpipeline.PipelineRun{Id: pipelineRunId}, d.outcomes)
+	d.outcomes = append(d.outcomes, changed...)
+	return nil
+}
+
+func TestUploadExecutor_Process_QueuesDuringPlatformOutage(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(pbplugin.DestinationType_DESTINATION_STRAVA, &mockUploader{name: "strava", id: "s1"})
+	registry.Register(pbplugin.DestinationType_DESTINATION_HEVY, &mockUploader{name: "hevy", id: "h1"})
+
+	store := &outageStore{health: map[string]*pbpipeline.PlatformHealth{
+		"strava": {Platform: "strava", State: pbpipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_OUTAGE},
+	}}
+	logger := infra.NewLogger()
+	breaker := outage.NewBreaker(store, logger)
+	db := &outcomeDB{MockDatabase: &mocks.MockDatabase{}}
+
+	executor := NewUploadExecutor(registry, &mockUserServiceClient{}, &mockActivityServiceClient{}, db, nil, &mockNotificationService{}, breaker, nil, nil, logger)
+
+	pipelineRunId := "run-123"
+	payload := &pbevents.EnrichedActivityEvent{
+		UserId:              "user-1",
+		ActivityId:          "act-1",
+		PipelineExecutionId: &pipelineRunId,
+		Destinations: []pbplugin.DestinationType{
+			pbplugin.DestinationType_DESTINATION_STRAVA,
+			pbplugin.DestinationType_DESTINATION_HEVY,
+		},
+	}
+	payloadBytes, err := protojson.Marshal(payload)
+	assert.NoError(t, err)
+
+	ce := event.New()
+	ce.SetID("test-id-outage")
+	ce.SetType("com.fitglue.event.enriched")
+	ce.SetSource("test")
+	ce.SetData("application/json", payloadBytes)
+
+	assert.NoError(t, executor.Process(context.Background(), &ce))
+
+	// Only the Strava upload is queued, narrowed to its own destination
+	if assert.Len(t, store.queued, 1) {
+		assert.Equal(t, "strava", store.queued[0].Platform)
+		assert.Equal(t, []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA}, store.queued[0].GetUpload().Destinations)
+	}
+
+	statuses := map[pbplugin.DestinationType]pbpipeline.DestinationStatus{}
+	for _, o := range db.outcomes {
+		statuses[o.Destination] = o.Status
+	}
+	assert.Equal(t, pbpipeline.DestinationStatus_DESTINATION_STATUS_QUEUED_PLATFORM_OUTAGE, statuses[pbplugin.DestinationType_DESTINATION_STRAVA])
+	assert.Equal(t, pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS, statuses[pbplugin.DestinationType_DESTINATION_HEVY])
+}
+
+type scheduleStore struct {
+	scheduled []*pbpipeline.ScheduledUpload
+}
+
+func (s *scheduleStore) Enqueue(ctx context.Context, upload *pbpipeline.ScheduledUpload) error {
+	for i, existing := range s.scheduled {
+		if existing.Id == upload.Id {
+			s.scheduled[i] = upload
+			return nil
+		}
+	}
+	s.scheduled = append(s.scheduled, upload)
+	return nil
+}
+func (s *scheduleStore) ListDue(ctx context.Context, now time.Time, limit int) ([]*pbpipeline.ScheduledUpload, error) {
+	var due []*pbpipeline.ScheduledUpload
+	for _, upload := range s.scheduled {
+		if !upload.DueAt.AsTime().After(now) {
+			due = append(due, upload)
+		}
+	}
+	return due, nil
+}
+func (s *scheduleStore) Claim(ctx context.Context, id string) (bool, error) {
+	for i, upload := range s.scheduled {
+		if upload.Id == id {
+			s.scheduled = append(s.scheduled[:i], s.scheduled[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestUploadExecutor_Process_HoldsScheduledUploads(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(pbplugin.DestinationType_DESTINATION_STRAVA, &mockUploader{name: "strava", id: "s1"})
+	registry.Register(pbplugin.DestinationType_DESTINATION_HEVY, &mockUploader{name: "hevy", id: "h1"})
+
+	schedules := &scheduleStore{}
+	db := &outcomeDB{MockDatabase: &mocks.MockDatabase{}}
+	executor := NewUploadExecutor(registry, &mockUserServiceClient{}, &mockActivityServiceClient{}, db, nil, &mockNotificationService{}, nil, schedules, nil, infra.NewLogger())
+
+	pipelineRunId := "run-123"
+	payload := &pbevents.EnrichedActivityEvent{
+		UserId:              "user-1",
+		ActivityId:          "act-1",
+		PipelineExecutionId: &pipelineRunId,
+		EnrichmentMetadata:  map[string]string{"strava_" + uploadschedule.ConfigDelay: "2h"},
+		Destinations: []pbplugin.DestinationType{
+			pbplugin.DestinationType_DESTINATION_STRAVA,
+			pbplugin.DestinationType_DESTINATION_HEVY,
+		},
+	}
+	process := func(payload *pbevents.EnrichedActivityEvent) {
+		payloadBytes, err := protojson.Marshal(payload)
+		assert.NoError(t, err)
+		ce := event.New()
+		ce.SetID("test-id-schedule")
+		ce.SetType("com.fitglue.event.enriched")
+		ce.SetSource("test")
+		ce.SetData("application/json", payloadBytes)
+		assert.NoError(t, executor.Process(context.Background(), &ce))
+	}
+	statuses := func() map[pbplugin.DestinationType]pbpipeline.DestinationStatus {
+		latest := map[pbplugin.DestinationType]pbpipeline.DestinationStatus{}
+		for _, o := range db.outcomes {
+			latest[o.Destination] = o.Status
+		}
+		return latest
+	}
+	process(payload)
+	// A redelivered message replaces the upload it already held
+	process(payload)
+
+	// Only the Strava upload is held, narrowed to its own destination
+	if assert.Len(t, schedules.scheduled, 1) {
+		held := schedules.scheduled[0]
+		assert.Equal(t, []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA}, held.Upload.Destinations)
+		assert.Equal(t, "true", held.Upload.EnrichmentMetadata[uploadschedule.ReleasedMetadataKey])
+		assert.Equal(t, "run-123_DESTINATION_STRAVA", held.Id)
+		assert.WithinDuration(t, time.Now().Add(2*time.Hour), held.DueAt.AsTime(), time.Minute)
+	}
+	assert.Equal(t, pbpipeline.DestinationStatus_DESTINATION_STATUS_SCHEDULED, statuses()[pbplugin.DestinationType_DESTINATION_STRAVA])
+	assert.Equal(t, pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS, statuses()[pbplugin.DestinationType_DESTINATION_HEVY])
+
+	// Once released it is sent without being held again
+	process(schedules.scheduled[0].Upload)
+	assert.Len(t, schedules.scheduled, 1)
+	assert.Equal(t, pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS, statuses()[pbplugin.DestinationType_DESTINATION_STRAVA])
+}
+
+func TestUploadExecutor_HandleScheduledUploads(t *testing.T) {
+	pipelineRunId := "run-123"
+	held := func(id string) *pbpipeline.ScheduledUpload {
+		return &pbpipeline.ScheduledUpload{
+			Id:          id,
+			UserId:      "user-1",
+			Destination: pbplugin.DestinationType_DESTINATION_STRAVA,
+			DueAt:       timestamppb.New(time.Now().Add(-time.Minute)),
+			Upload: &pbevents.EnrichedActivityEvent{
+				UserId:              "user-1",
+				PipelineExecutionId: &pipelineRunId,
+				Destinations:        []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+			},
+		}
+	}
+	sweep := func(executor *UploadExecutor) {
+		rec := httptest.NewRecorder()
+		executor.HandleScheduledUploads(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	t.Run("publishes each upload once", func(t *testing.T) {
+		schedules := &scheduleStore{scheduled: []*pbpipeline.ScheduledUpload{held("run-123_DESTINATION_STRAVA")}}
+		published := 0
+		publisher := &mocks.MockPublisher{PublishCloudEventFunc: func(ctx context.Context, topic string, e event.Event) (string, error) {
+			published++
+			return "msg-id", nil
+		}}
+		executor := NewUploadExecutor(NewRegistry(), &mockUserServiceClient{}, &mockActivityServiceClient{}, &mocks.MockDatabase{}, nil, &mockNotificationService{}, nil, schedules, publisher, infra.NewLogger())
+
+		sweep(executor)
+		sweep(executor)
+
+		assert.Equal(t, 1, published)
+		assert.Empty(t, schedules.scheduled)
+	})
+
+	t.Run("skips uploads another sweep claimed", func(t *testing.T) {
+		upload := held("run-123_DESTINATION_STRAVA")
+		schedules := &claimedScheduleStore{due: []*pbpipeline.ScheduledUpload{upload}}
+		published := 0
+		publisher := &mocks.MockPublisher{PublishCloudEventFunc: func(ctx context.Context, topic string, e event.Event) (string, error) {
+			published++
+			return "msg-id", nil
+		}}
+		executor := NewUploadExecutor(NewRegistry(), &mockUserServiceClient{}, &mockActivityServiceClient{}, &mocks.MockDatabase{}, nil, &mockNotificationService{}, nil, schedules, publisher, infra.NewLogger())
+
+		sweep(executor)
+
+		assert.Zero(t, published)
+	})
+
+	t.Run("puts back uploads that fail to publish", func(t *testing.T) {
+		schedules := &scheduleStore{scheduled: []*pbpipeline.ScheduledUpload{held("run-123_DESTINATION_STRAVA")}}
+		publisher := &mocks.MockPublisher{PublishCloudEventFunc: func(ctx context.Context, topic string, e event.Event) (string, error) {
+			return "", errors.New("pubsub unavailable")
+		}}
+		executor := NewUploadExecutor(NewRegistry(), &mockUserServiceClient{}, &mockActivityServiceClient{}, &mocks.MockDatabase{}, nil, &mockNotificationService{}, nil, schedules, publisher, infra.NewLogger())
+
+		sweep(executor)
+
+		if assert.Len(t, schedules.scheduled, 1) {
+			assert.Equal(t, "run-123_DESTINATION_STRAVA", schedules.scheduled[0].Id)
+		}
+	})
+}
+
+// claimedScheduleStore lists uploads that a concurrent sweep has already
+// claimed.
+type claimedScheduleStore struct {
+	scheduleStore
+	due []*pbpipeline.ScheduledUpload
+}
+
+func (s *claimedScheduleStore) ListDue(ctx context.Context, now time.Time, limit int) ([]*pbpipeline.ScheduledUpload, error) {
+	return s.due, nil
+}
+
+func TestUploadExecutor_Process_HoldsRateLimitedUploads(t *testing.T) {
+	limitErr := fmt.Errorf("strava upload failed: %w", &ratelimit.Error{Provider: "strava", RetryAfter: 10 * time.Minute})
+	registry := NewRegistry()
+	registry.Register(pbplugin.DestinationType_DESTINATION_STRAVA, &mockUploader{name: "strava", err: limitErr})
+
+	schedules := &scheduleStore{}
+	store := &outageStore{health: map[string]*pbpipeline.PlatformHealth{}}
+	logger := infra.NewLogger()
+	db := &outcomeDB{MockDatabase: &mocks.MockDatabase{}}
+	executor := NewUploadExecutor(registry, &mockUserServiceClient{}, &mockActivityServiceClient{}, db, nil, &mockNotificationService{}, outage.NewBreaker(store, logger), schedules, nil, logger)
+
+	pipelineRunId := "run-123"
+	payloadBytes, err := protojson.Marshal(&pbevents.EnrichedActivityEvent{
+		UserId:              "user-1",
+		ActivityId:          "act-1",
+		PipelineExecutionId: &pipelineRunId,
+		Destinations:        []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+	})
+	assert.NoError(t, err)
+	ce := event.New()
+	ce.SetID("test-id-rate-limit")
+	ce.SetType("com.fitglue.event.enriched")
+	ce.SetSource("test")
+	ce.SetData("application/json", payloadBytes)
+	assert.NoError(t, executor.Process(context.Background(), &ce))
+
+	// Held until the budget resets, without counting towards an outage
+	if assert.Len(t, schedules.scheduled, 1) {
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), schedules.scheduled[0].DueAt.AsTime(), time.Minute)
+	}
+	assert.Nil(t, store.health["strava"])
+	if assert.NotEmpty(t, db.outcomes) {
+		assert.Equal(t, pbpipeline.DestinationStatus_DESTINATION_STATUS_SCHEDULED, db.outcomes[len(db.outcomes)-1].Status)
+	}
+}
+
+func TestUploadExecutor_Process_OpensBreakerOnRepeatedServerErrors(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(pbplugin.DestinationType_DESTINATION_STRAVA, &mockUploader{name: "strava", err: &httputil.HTTPError{StatusCode: 503, Status: "Strava upload failed"}})
+
+	store := &outageStore{health: map[string]*pbpipeline.PlatformHealth{}}
+	logger := infra.NewLogger()
+	executor := NewUploadExecutor(registry, &mockUserServiceClient{}, &mockActivityServiceClient{}, &mocks.MockDatabase{}, nil, &mockNotificationService{}, outage.NewBreaker(store, logger), nil, nil, logger)
+
+	for i := 0; i < outage.FailureThreshold; i++ {
+		payloadBytes, err := protojson.Marshal(&pbevents.EnrichedActivityEvent{
+			UserId:       "user-1",
+			ActivityId:   fmt.Sprintf("act-%d", i),
+			Destinations: []pbplugin.DestinationType{pbplugin.DestinationType_DESTINATION_STRAVA},
+		})
+		assert.NoError(t, err)
+		ce := event.New()
+		ce.SetID(fmt.Sprintf("test-id-%d", i))
+		ce.SetType("com.fitglue.event.enriched")
+		ce.SetSource("test")
+		ce.SetData("application/json", payloadBytes)
+		assert.NoError(t, executor.Process(context.Background(), &ce))
+	}
+
+	assert.Equal(t, pbpipeline.PlatformHealthState_PLATFORM_HEALTH_STATE_OUTAGE, store.health["strava"].State)
+	// Failures before the threshold fail normally; the one that trips the breaker is queued
+	assert.Len(t, store.queued, 1)
+}
+
+type removingUploader struct {
+	mockUploader
+	removed []string
+}
+
+func (m *removingUploader) Remove(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record, externalID string) error {
+	m.removed = append(m.removed, externalID)
+	return nil
+}
+
+type deletedRunDB struct {
+	*mocks.MockDatabase
+	run     *pbpipeline.PipelineRun
+	updates []map[string]interface{}
+}
+
+func (d *deletedRunDB) GetPipelineRun(ctx context.Context, userId string, id string) (*pbpipeline.PipelineRun, error) {
+	return d.run, nil
+}
+
+func (d *deletedRunDB) UpdateDestinationOutcomes(ctx context.Context, userId string, pipelineRunId string, fn func(run *pbpipeline.PipelineRun, outcomes []*pbpipeline.DestinationOutcome) ([]*pbpipeline.DestinationOutcome, map[string]interface{})) error {
+	changed, updates := fn(d.run, d.run.Destinations)
+	d.run.Destinations = changed
+	d.updates = append(d.updates, updates)
+	return nil
+}
+
+func TestUploadExecutor_Process_RemovesDeletedAtSource(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		policy  pbuser.SourceDeletionPolicy
+		removed []string
+	}{
+		{"remove policy", pbuser.SourceDeletionPolicy_SOURCE_DELETION_POLICY_REMOVE, []string{"gh/path.md"}},
+		{"keep by default", pbuser.SourceDeletionPolicy_SOURCE_DELETION_POLICY_UNSPECIFIED, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			github := &removingUploader{mockUploader: mockUploader{name: "github"}}
+			strava := &mockUploader{name: "strava"}
+			registry := NewRegistry()
+			registry.Register(pbplugin.DestinationType_DESTINATION_GITHUB, github)
+			registry.Register(pbplugin.DestinationType_DESTINATION_STRAVA, strava)
+
+			ghID, stravaID := "gh/path.md", "s1"
+			db := &deletedRunDB{MockDatabase: &mocks.MockDatabase{}, run: &pbpipeline.PipelineRun{
+				Id:     "run-123",
+				Status: pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DELETED_AT_SOURCE,
+				Destinations: []*pbpipeline.DestinationOutcome{
+					{Destination: pbplugin.DestinationType_DESTINATION_GITHUB, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS, ExternalId: &ghID},
+					{Destination: pbplugin.DestinationType_DESTINATION_STRAVA, Status: pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS, ExternalId: &stravaID},
+				},
+			}}
+			userClient := &mockUserServiceClient{
+				GetProfileFunc: func(ctx context.Context, in *userpb.GetProfileRequest, opts ...grpc.CallOption) (*pbuser.UserProfile, error) {
+					return &pbuser.UserProfile{SourceDeletionPolicy: tc.policy}, nil
+				},
+			}
+			executor := NewUploadExecutor(registry, userClient, &mockActivityServiceClient{}, db, nil, &mockNotificationService{}, nil, nil, nil, infra.NewLogger())
+
+			pipelineRunId := "run-123"
+			payloadBytes, err := protojson.Marshal(&pbevents.EnrichedActivityEvent{
+				UserId:              "user-1",
+				ActivityId:          "act-1",
+				PipelineExecutionId: &pipelineRunId,
+				EnrichmentMetadata:  map[string]string{destination.RemoveMetadataKey: "true"},
+				Destinations: []pbplugin.DestinationType{
+					pbplugin.DestinationType_DESTINATION_GITHUB,
+					pbplugin.DestinationType_DESTINATION_STRAVA,
+				},
+			})
+			assert.NoError(t, err)
+			ce := event.New()
+			ce.SetID("test-id-removal")
+			ce.SetType("com.fitglue.job.removal")
+			ce.SetSource("test")
+			ce.SetData("application/json", payloadBytes)
+
+			assert.NoError(t, executor.Process(context.Background(), &ce))
+
+			assert.Equal(t, tc.removed, github.removed)
+			for _, updates := range db.updates {
+				// The run stays DELETED_AT_SOURCE
+				assert.Equal(t, int32(pbpipeline.PipelineRunStatus_PIPELINE_RUN_STATUS_DELETED_AT_SOURCE), updates["status"])
+			}
+			if tc.removed != nil {
+				assert.Len(t, db.updates, 1)
+				assert.Equal(t, pbpipeline.DestinationStatus_DESTINATION_STATUS_REMOVED, db.run.Destinations[0].Status)
+			} else {
+				assert.Empty(t, db.updates)
+			}
+		})
+	}
+}
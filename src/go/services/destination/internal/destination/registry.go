@@ -1,6 +1,7 @@
 package destination
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/fitglue/server/src/go/pkg/destination"
@@ -34,3 +35,15 @@ func (r *Registry) Get(destType pbplugin.DestinationType) (destination.Destinati
 	uploader, ok := r.uploaders[destType]
 	return uploader, ok
 }
+
+// Destinations lists every registered destination type in enum order
+func (r *Registry) Destinations() []pbplugin.DestinationType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	dests := make([]pbplugin.DestinationType, 0, len(r.uploaders))
+	for d := range r.uploaders {
+		dests = append(dests, d)
+	}
+	sort.Slice(dests, func(i, j int) bool { return dests[i] < dests[j] })
+	return dests
+}
@@ -0,0 +1,70 @@
+package destination
+
+import (
+	"context"
+
+	"github.com/fitglue/server/src/go/pkg/destination"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	httputil "github.com/fitglue/server/src/go/pkg/infrastructure/http"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+)
+
+// removeFromDestinations takes down what a run uploaded once its activity was
+// deleted on its source platform, if the user's source deletion policy asks
+// for it. Destinations that can't remove anything keep their copy. Failures
+// are only logged; the run is already marked DELETED_AT_SOURCE.
+func (e *UploadExecutor) removeFromDestinations(ctx context.Context, payload *pbevents.EnrichedActivityEvent, userRecord *user.Record, pipelineRunId string) {
+	if userRecord.UserProfile.GetSourceDeletionPolicy() != pbuser.SourceDeletionPolicy_SOURCE_DELETION_POLICY_REMOVE {
+		e.logger.Info(ctx, "Keeping uploads of activity deleted at source", "pipeline_run_id", pipelineRunId)
+		return
+	}
+	if pipelineRunId == "" {
+		return
+	}
+	pr := e.loadPipelineRun(ctx, payload.UserId, pipelineRunId)
+	if pr == nil {
+		return
+	}
+
+	activityPayload := &pbevents.ActivityPayload{
+		Source:              payload.Source,
+		UserId:              payload.UserId,
+		ActivityId:          &payload.ActivityId,
+		Metadata:            payload.EnrichmentMetadata,
+		PipelineExecutionId: payload.PipelineExecutionId,
+	}
+
+	for _, destEnum := range payload.Destinations {
+		externalID := uploadedExternalID(pr, destEnum)
+		if externalID == "" {
+			continue
+		}
+		uploader, _ := e.registry.Get(destEnum)
+		remover, ok := uploader.(destination.Remover)
+		if !ok {
+			e.logger.Info(ctx, "Destination can't remove activities, keeping upload", "destination", destEnum.String(), "pipeline_run_id", pipelineRunId)
+			continue
+		}
+
+		removeCtx, _ := httputil.WithCallRecorder(ctx)
+		if err := remover.Remove(removeCtx, activityPayload, userRecord, externalID); err != nil {
+			e.logger.Error(ctx, "Failed to remove activity deleted at source", "destination", destEnum.String(), "pipeline_run_id", pipelineRunId, "error", err)
+			continue
+		}
+		destination.UpdateStatus(removeCtx, e.db, e.notifications, payload.UserId, pipelineRunId, destEnum, pbpipeline.DestinationStatus_DESTINATION_STATUS_REMOVED, externalID, "", payload.Name, payload.ActivityId, e.logger)
+		e.logger.Info(ctx, "Removed activity deleted at source", "destination", destEnum.String(), "pipeline_run_id", pipelineRunId)
+	}
+}
+
+// uploadedExternalID is the ID the destination gave the run's upload, if any.
+func uploadedExternalID(pr *pbpipeline.PipelineRun, dest pbplugin.DestinationType) string {
+	for _, d := range pr.Destinations {
+		if d.Destination == dest && d.Status == pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS {
+			return d.GetExternalId()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,148 @@
+package destination
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/fitglue/server/src/go/internal/outage"
+	"github.com/fitglue/server/src/go/internal/uploadschedule"
+	"github.com/fitglue/server/src/go/pkg/destination"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/ratelimit"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// scheduledUploadBatchSize caps how many uploads one release sweep sends.
+const scheduledUploadBatchSize = 200
+
+// scheduleUpload holds a single destination's upload back until its posting
+// schedule allows it. Returns false if the upload should go ahead now: no
+// schedule, a schedule that already allows it, or one that couldn't be
+// applied.
+func (e *UploadExecutor) scheduleUpload(ctx context.Context, payload *pbevents.EnrichedActivityEvent, destEnum pbplugin.DestinationType, pipelineRunId string) bool {
+	if e.schedules == nil {
+		return false
+	}
+	schedule, err := uploadschedule.FromMetadata(payload.EnrichmentMetadata, outage.DestinationPlatform(destEnum))
+	if err != nil {
+		e.logger.Warn(ctx, "Ignoring invalid posting schedule", "destination", destEnum.String(), "error", err)
+		return false
+	}
+	if schedule == nil {
+		return false
+	}
+	now := time.Now()
+	dueAt := schedule.ReleaseAt(now)
+	if !dueAt.After(now) {
+		return false
+	}
+	msg := fmt.Sprintf("Scheduled to post to %s at %s", destination.FormatDestinationName(destEnum), dueAt.In(schedule.Location).Format("Mon 2 Jan 15:04 MST"))
+	return e.holdUpload(ctx, payload, destEnum, pipelineRunId, dueAt, msg)
+}
+
+// deferForRateLimit holds an upload that failed on the destination's rate
+// limit until the budget resets, rather than failing it. Returns false for
+// other errors, or if it couldn't be held, so the caller handles the error as
+// usual.
+func (e *UploadExecutor) deferForRateLimit(ctx context.Context, payload *pbevents.EnrichedActivityEvent, destEnum pbplugin.DestinationType, pipelineRunId string, uploadErr error) bool {
+	var limitErr *ratelimit.Error
+	if e.schedules == nil || !errors.As(uploadErr, &limitErr) {
+		return false
+	}
+	dueAt := time.Now().Add(limitErr.RetryAfter)
+	msg := fmt.Sprintf("%s rate limit reached, retrying at %s", destination.FormatDestinationName(destEnum), dueAt.UTC().Format("15:04 MST"))
+	return e.holdUpload(ctx, payload, destEnum, pipelineRunId, dueAt, msg)
+}
+
+// holdUpload queues a single destination's upload for release at dueAt and
+// marks it SCHEDULED on the pipeline run with msg. Returns false if the
+// upload couldn't be queued.
+func (e *UploadExecutor) holdUpload(ctx context.Context, payload *pbevents.EnrichedActivityEvent, destEnum pbplugin.DestinationType, pipelineRunId string, dueAt time.Time, msg string) bool {
+	upload := proto.Clone(payload).(*pbevents.EnrichedActivityEvent)
+	upload.Destinations = []pbplugin.DestinationType{destEnum}
+	if upload.EnrichmentMetadata == nil {
+		upload.EnrichmentMetadata = map[string]string{}
+	}
+	upload.EnrichmentMetadata[uploadschedule.ReleasedMetadataKey] = "true"
+
+	scheduled := &pbpipeline.ScheduledUpload{
+		Id:          scheduledUploadID(payload, destEnum, pipelineRunId),
+		UserId:      payload.UserId,
+		Destination: destEnum,
+		DueAt:       timestamppb.New(dueAt),
+		ScheduledAt: timestamppb.Now(),
+		Upload:      upload,
+	}
+	if err := e.schedules.Enqueue(ctx, scheduled); err != nil {
+		e.logger.Error(ctx, "Failed to hold upload", "destination", destEnum.String(), "error", err)
+		return false
+	}
+
+	e.logger.Info(ctx, "Held upload", "destination", destEnum.String(), "scheduled_upload_id", scheduled.Id, "due_at", dueAt)
+	if pipelineRunId != "" {
+		destination.UpdateStatus(ctx, e.db, e.notifications, payload.UserId, pipelineRunId, destEnum, pbpipeline.DestinationStatus_DESTINATION_STATUS_SCHEDULED, "", msg, payload.Name, payload.ActivityId, e.logger)
+	}
+	return true
+}
+
+// scheduledUploadID keys a held upload by its run and destination, so a
+// redelivered or retried destination message replaces the upload it already
+// held instead of queueing a second copy.
+func scheduledUploadID(payload *pbevents.EnrichedActivityEvent, destEnum pbplugin.DestinationType, pipelineRunId string) string {
+	if pipelineRunId == "" {
+		return fmt.Sprintf("%s_%s_%s", payload.UserId, payload.ActivityId, destEnum.String())
+	}
+	return fmt.Sprintf("%s_%s", pipelineRunId, destEnum.String())
+}
+
+// HandleScheduledUploads is triggered by Cloud Scheduler via Pub/Sub. It
+// releases uploads whose posting schedule now allows them by routing them
+// back onto the destination upload topic. Each upload is claimed before it is
+// published, so overlapping sweeps send it once. An upload that fails to
+// publish is put back for the next sweep.
+func (e *UploadExecutor) HandleScheduledUploads(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	due, err := e.schedules.ListDue(ctx, time.Now(), scheduledUploadBatchSize)
+	if err != nil {
+		e.logger.Error(ctx, "Failed to list due scheduled uploads", "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	released := 0
+	for _, scheduled := range due {
+		claimed, err := e.schedules.Claim(ctx, scheduled.Id)
+		if err != nil {
+			e.logger.Error(ctx, "Failed to claim scheduled upload", "scheduled_upload_id", scheduled.Id, "error", err)
+			continue
+		}
+		if !claimed {
+			// Another sweep already released it
+			continue
+		}
+		if scheduled.Upload == nil {
+			e.logger.Warn(ctx, "Dropping scheduled upload without an upload", "scheduled_upload_id", scheduled.Id)
+			continue
+		}
+		if err := e.publishUpload(ctx, scheduled.Upload); err != nil {
+			e.logger.Error(ctx, "Failed to release scheduled upload", "scheduled_upload_id", scheduled.Id, "error", err)
+			if err := e.schedules.Enqueue(ctx, scheduled); err != nil {
+				e.logger.Error(ctx, "Failed to put back scheduled upload", "scheduled_upload_id", scheduled.Id, "error", err)
+			}
+			continue
+		}
+		released++
+	}
+	e.logger.Info(ctx, "Released scheduled uploads", "due", len(due), "released", released)
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "OK")
+}
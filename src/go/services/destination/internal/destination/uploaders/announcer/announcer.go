@@ -0,0 +1,297 @@
+// Package announcer implements chat webhook destinations (Discord, Slack) that
+// post a summary card for each activity to a user-provided channel webhook.
+package announcer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	httputil "github.com/fitglue/server/src/go/pkg/infrastructure/http"
+	"github.com/fitglue/server/src/go/pkg/loopprevention"
+	"github.com/fitglue/server/src/go/pkg/types/formatters"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// showcaseBaseURL prefixes a Showcase ID to make its public link.
+const showcaseBaseURL = "https://fitglue.tech/s/"
+
+// card is the platform-neutral summary posted for an activity.
+type card struct {
+	Title     string
+	Type      string
+	Distance  string
+	Duration  string
+	Pace      string
+	PRCount   int
+	BannerURL string
+	Link      string
+}
+
+// poster delivers a card to one chat platform's webhook.
+type poster interface {
+	// Post announces the card and returns an ID for the message, which is
+	// empty when the platform can't address it later.
+	Post(ctx context.Context, client *http.Client, webhookURL string, c *card) (string, error)
+	// Edit replaces a posted message. Platforms that can't edit return nil.
+	Edit(ctx context.Context, client *http.Client, webhookURL, messageID string, c *card) error
+	// ValidURL reports whether webhookURL belongs to the platform.
+	ValidURL(u *url.URL) bool
+}
+
+// Uploader implements destination.Destination for chat webhooks.
+type Uploader struct {
+	svc        *bootstrap.Service
+	name       string
+	destType   pbplugin.DestinationType
+	poster     poster
+	httpClient *http.Client
+	// validURL is swapped in tests, which post to a local server.
+	validURL func(u *url.URL) bool
+}
+
+func newUploader(svc *bootstrap.Service, name string, destType pbplugin.DestinationType, p poster) *Uploader {
+	return &Uploader{
+		svc:        svc,
+		name:       name,
+		destType:   destType,
+		poster:     p,
+		httpClient: &http.Client{Timeout: 30 * time.Second, Transport: &httputil.CallRecordingTransport{}},
+		validURL:   p.ValidURL,
+	}
+}
+
+// Name returns the identifier for this uploader
+func (u *Uploader) Name() string {
+	return u.name
+}
+
+// Create posts the activity's summary card to the configured webhook.
+// Returns the message ID where the platform has one, so updates can edit it.
+func (u *Uploader) Create(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record) (string, error) {
+	webhookURL, err := u.webhookURL(payload)
+	if err != nil {
+		return "", err
+	}
+	logger := slog.Default()
+
+	// Dedup: Pub/Sub redelivery or a re-post of the same run must not announce twice.
+	if existingID, ok := u.findExistingMessage(ctx, payload); ok {
+		logger.Info("Activity already announced for this pipeline run, skipping", "destination", u.name, "message_id", existingID)
+		return existingID, nil
+	}
+
+	messageID, err := u.poster.Post(ctx, u.httpClient, webhookURL, u.buildCard(ctx, payload))
+	if err != nil {
+		return "", fmt.Errorf("%s webhook post failed: %w", u.name, err)
+	}
+
+	recordID := messageID
+	if recordID == "" {
+		recordID = payload.GetActivityId()
+	}
+	uploadRecord := &pbactivity.UploadedActivityRecord{
+		Id:            loopprevention.BuildUploadedActivityID(u.destType, recordID),
+		UserId:        payload.UserId,
+		Source:        payload.Source,
+		ExternalId:    payload.StandardizedActivity.GetExternalId(),
+		StartTime:     payload.StandardizedActivity.GetStartTime(),
+		Destination:   u.destType,
+		DestinationId: recordID,
+		UploadedAt:    timestamppb.Now(),
+	}
+	_ = u.svc.DB.SetUploadedActivity(ctx, payload.UserId, uploadRecord)
+
+	_ = u.svc.DB.IncrementSyncCount(ctx, payload.UserId)
+
+	return messageID, nil
+}
+
+// Update edits the posted card in place. Nothing is re-posted when the
+// message can't be edited, so a channel isn't announced the same activity
+// twice.
+func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record, pipelineRun *pbpipeline.PipelineRun) error {
+	webhookURL, err := u.webhookURL(payload)
+	if err != nil {
+		return err
+	}
+
+	var messageID string
+	if pipelineRun != nil {
+		for _, dest := range pipelineRun.Destinations {
+			if dest.Destination == u.destType && dest.ExternalId != nil && *dest.ExternalId != "" {
+				messageID = *dest.ExternalId
+				break
+			}
+		}
+	}
+	if messageID == "" {
+		slog.Default().Info("No editable message for activity, skipping update", "destination", u.name)
+		return nil
+	}
+
+	if err := u.poster.Edit(ctx, u.httpClient, webhookURL, messageID, u.buildCard(ctx, payload)); err != nil {
+		return fmt.Errorf("%s webhook edit failed: %w", u.name, err)
+	}
+	return nil
+}
+
+// webhookURL reads and checks the destination's configured webhook. Only the
+// platform's own webhook hosts are accepted so the URL can't point FitGlue
+// at arbitrary endpoints.
+func (u *Uploader) webhookURL(payload *pbevents.ActivityPayload) (string, error) {
+	raw := strings.TrimSpace(payload.Metadata[u.name+"_webhook_url"])
+	if raw == "" {
+		return "", fmt.Errorf("%s_webhook_url not configured in metadata", u.name)
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || !u.validURL(parsed) {
+		return "", fmt.Errorf("invalid %s webhook URL", u.name)
+	}
+	return raw, nil
+}
+
+// findExistingMessage returns the message recorded for this destination on
+// the current pipeline run, if it was already announced.
+func (u *Uploader) findExistingMessage(ctx context.Context, payload *pbevents.ActivityPayload) (string, bool) {
+	if u.svc.DB == nil || payload.PipelineExecutionId == nil || *payload.PipelineExecutionId == "" {
+		return "", false
+	}
+
+	outcomes, err := u.svc.DB.GetDestinationOutcomes(ctx, payload.UserId, *payload.PipelineExecutionId)
+	if err != nil {
+		return "", false
+	}
+	for _, outcome := range outcomes {
+		if outcome.Destination == u.destType && outcome.Status == pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS {
+			return outcome.GetExternalId(), true
+		}
+	}
+	return "", false
+}
+
+// buildCard summarises the activity for a chat message.
+func (u *Uploader) buildCard(ctx context.Context, payload *pbevents.ActivityPayload) *card {
+	activityType := formatters.ParseActivityType(payload.Metadata["activity_type"])
+
+	c := &card{
+		Title:     payload.Metadata["activity_name"],
+		Type:      formatters.FormatActivityType(activityType),
+		BannerURL: payload.Metadata["asset_ai_banner"],
+		Link:      u.showcaseLink(ctx, payload),
+	}
+	if c.Title == "" {
+		c.Title = c.Type
+	}
+	if payload.Metadata["pr_status"] == "pr_detected" {
+		c.PRCount, _ = strconv.Atoi(payload.Metadata["pr_count"])
+	}
+
+	sessions := payload.StandardizedActivity.GetSessions()
+	if len(sessions) == 0 {
+		return c
+	}
+	seconds := sessions[0].TotalElapsedTime
+	meters := sessions[0].TotalDistance
+	if seconds > 0 {
+		c.Duration = formatDuration(seconds)
+	}
+	if meters > 0 {
+		c.Distance = fmt.Sprintf("%.2f km", meters/1000)
+	}
+	if seconds > 0 && meters > 0 {
+		c.Pace = formatPace(activityType, seconds, meters)
+	}
+	return c
+}
+
+// showcaseLink returns the activity's public Showcase page if this run has one.
+func (u *Uploader) showcaseLink(ctx context.Context, payload *pbevents.ActivityPayload) string {
+	if u.svc.DB == nil || payload.PipelineExecutionId == nil || *payload.PipelineExecutionId == "" {
+		return ""
+	}
+	outcomes, err := u.svc.DB.GetDestinationOutcomes(ctx, payload.UserId, *payload.PipelineExecutionId)
+	if err != nil {
+		return ""
+	}
+	for _, outcome := range outcomes {
+		if outcome.Destination == pbplugin.DestinationType_DESTINATION_SHOWCASE &&
+			outcome.Status == pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS &&
+			outcome.GetExternalId() != "" {
+			return showcaseBaseURL + outcome.GetExternalId()
+		}
+	}
+	return ""
+}
+
+// prBadge renders the PR count for a card, or "" when there are none.
+func (c *card) prBadge() string {
+	switch {
+	case c.PRCount == 1:
+		return "🏆 New PR"
+	case c.PRCount > 1:
+		return fmt.Sprintf("🏆 %d new PRs", c.PRCount)
+	}
+	return ""
+}
+
+func formatDuration(seconds float64) string {
+	total := int(seconds)
+	if total >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", total/3600, (total%3600)/60, total%60)
+	}
+	return fmt.Sprintf("%d:%02d", total/60, total%60)
+}
+
+// formatPace shows speed for rides and pace per km for everything else.
+func formatPace(activityType pbactivity.ActivityType, seconds, meters float64) string {
+	switch activityType {
+	case pbactivity.ActivityType_ACTIVITY_TYPE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_MOUNTAIN_BIKE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_GRAVEL_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_EBIKE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_EMOUNTAIN_BIKE_RIDE:
+		return fmt.Sprintf("%.1f km/h", meters/1000/(seconds/3600))
+	}
+	perKm := int(seconds / (meters / 1000))
+	return fmt.Sprintf("%d:%02d /km", perKm/60, perKm%60)
+}
+
+// postJSON sends body to url and returns the response body.
+func postJSON(ctx context.Context, client *http.Client, method, url string, body interface{}) ([]byte, error) {
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal message: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(bodyJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, httputil.WrapResponseError(resp, "webhook error")
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,136 @@
+package announcer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   map[string]interface{}
+}
+
+func newTestServer(t *testing.T, response string) (*httptest.Server, *[]request) {
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		requests = append(requests, request{Method: r.Method, Path: r.URL.Path, Query: r.URL.Query(), Body: body})
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func testUploader(u *Uploader) *Uploader {
+	u.svc = &bootstrap.Service{DB: &mocks.MockDatabase{}}
+	u.validURL = func(*url.URL) bool { return true }
+	return u
+}
+
+func testPayload(name, webhookURL string) *pbevents.ActivityPayload {
+	return &pbevents.ActivityPayload{
+		UserId: "user-1",
+		Metadata: map[string]string{
+			name + "_webhook_url": webhookURL,
+			"activity_name":       "Sunday <Long> Run",
+			"activity_type":       "ACTIVITY_TYPE_RUN",
+			"pr_status":           "pr_detected",
+			"pr_count":            "2",
+			"asset_ai_banner":     "https://storage.example.com/banner.png",
+		},
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			Sessions: []*pbactivity.Session{{TotalElapsedTime: 3000, TotalDistance: 10000}},
+		},
+	}
+}
+
+func TestDiscord_CreateAndEdit(t *testing.T) {
+	server, requests := newTestServer(t, `{"id":"msg-1"}`)
+	u := testUploader(NewDiscord(nil))
+	webhook := server.URL + "/api/webhooks/1/token"
+
+	id, err := u.Create(context.Background(), testPayload("discord", webhook), &user.Record{})
+	require.NoError(t, err)
+	assert.Equal(t, "msg-1", id)
+
+	require.Len(t, *requests, 1)
+	post := (*requests)[0]
+	assert.Equal(t, "true", post.Query.Get("wait"))
+	embed := post.Body["embeds"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "Sunday <Long> Run", embed["title"])
+	assert.Contains(t, embed["description"], "🏆 2 new PRs")
+	assert.Len(t, embed["fields"], 3)
+	assert.Equal(t, "https://storage.example.com/banner.png", embed["image"].(map[string]interface{})["url"])
+
+	run := &pbpipeline.PipelineRun{Destinations: []*pbpipeline.DestinationOutcome{
+		{Destination: pbplugin.DestinationType_DESTINATION_DISCORD, ExternalId: &id},
+	}}
+	require.NoError(t, u.Update(context.Background(), testPayload("discord", webhook), &user.Record{}, run))
+	require.Len(t, *requests, 2)
+	assert.Equal(t, "PATCH", (*requests)[1].Method)
+	assert.Equal(t, "/api/webhooks/1/token/messages/msg-1", (*requests)[1].Path)
+}
+
+func TestSlack_CreateDoesNotRepostOnUpdate(t *testing.T) {
+	server, requests := newTestServer(t, "ok")
+	u := testUploader(NewSlack(nil))
+	webhook := server.URL + "/services/T/B/X"
+
+	id, err := u.Create(context.Background(), testPayload("slack", webhook), &user.Record{})
+	require.NoError(t, err)
+	assert.Empty(t, id)
+
+	require.Len(t, *requests, 1)
+	blocks := (*requests)[0].Body["blocks"].([]interface{})
+	require.Len(t, blocks, 3)
+	text := blocks[0].(map[string]interface{})["text"].(map[string]interface{})["text"]
+	assert.Contains(t, text, "Sunday &lt;Long&gt; Run")
+	assert.Contains(t, text, "🏆 2 new PRs")
+
+	require.NoError(t, u.Update(context.Background(), testPayload("slack", webhook), &user.Record{}, &pbpipeline.PipelineRun{}))
+	assert.Len(t, *requests, 1, "Slack messages can't be edited, so updates must not post again")
+}
+
+func TestWebhookURL_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		u       *Uploader
+		webhook string
+		wantErr bool
+	}{
+		{"discord webhook", NewDiscord(nil), "https://discord.com/api/webhooks/1/abc", false},
+		{"discord other path", NewDiscord(nil), "https://discord.com/api/users/@me", true},
+		{"discord plain http", NewDiscord(nil), "http://discord.com/api/webhooks/1/abc", true},
+		{"slack webhook", NewSlack(nil), "https://hooks.slack.com/services/T/B/X", false},
+		{"slack other host", NewSlack(nil), "https://evil.example.com/services/T/B/X", true},
+		{"missing", NewSlack(nil), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.u.webhookURL(testPayload(tt.u.name, tt.webhook))
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+		})
+	}
+}
+
+func TestFormatPace(t *testing.T) {
+	assert.Equal(t, "5:00 /km", formatPace(pbactivity.ActivityType_ACTIVITY_TYPE_RUN, 3000, 10000))
+	assert.Equal(t, "30.0 km/h", formatPace(pbactivity.ActivityType_ACTIVITY_TYPE_RIDE, 3600, 30000))
+}
@@ -0,0 +1,116 @@
+package announcer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// discordColor is the embed accent colour (FitGlue orange).
+const discordColor = 0xFF6B35
+
+// NewDiscord returns an announcer that posts to a Discord channel webhook.
+func NewDiscord(svc *bootstrap.Service) *Uploader {
+	return newUploader(svc, "discord", pbplugin.DestinationType_DESTINATION_DISCORD, discordPoster{})
+}
+
+type discordPoster struct{}
+
+type discordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+type discordEmbed struct {
+	Title       string              `json:"title"`
+	URL         string              `json:"url,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Color       int                 `json:"color"`
+	Fields      []discordEmbedField `json:"fields,omitempty"`
+	Image       *struct {
+		URL string `json:"url"`
+	} `json:"image,omitempty"`
+}
+
+func (discordPoster) ValidURL(u *url.URL) bool {
+	switch u.Host {
+	case "discord.com", "discordapp.com", "ptb.discord.com", "canary.discord.com":
+		return u.Scheme == "https" && strings.HasPrefix(u.Path, "/api/webhooks/")
+	}
+	return false
+}
+
+func (discordPoster) Post(ctx context.Context, client *http.Client, webhookURL string, c *card) (string, error) {
+	// wait=true makes Discord return the message, whose ID is needed to edit it
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("wait", "true")
+	u.RawQuery = q.Encode()
+
+	body, err := postJSON(ctx, client, "POST", u.String(), discordMessage(c))
+	if err != nil {
+		return "", err
+	}
+	var message struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(body, &message); err != nil {
+		return "", fmt.Errorf("failed to decode Discord message: %w", err)
+	}
+	return message.ID, nil
+}
+
+func (discordPoster) Edit(ctx context.Context, client *http.Client, webhookURL, messageID string, c *card) error {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return err
+	}
+	u.Path = strings.TrimRight(u.Path, "/") + "/messages/" + url.PathEscape(messageID)
+
+	_, err = postJSON(ctx, client, "PATCH", u.String(), discordMessage(c))
+	return err
+}
+
+func discordMessage(c *card) map[string]interface{} {
+	embed := discordEmbed{
+		Title:       c.Title,
+		URL:         c.Link,
+		Description: c.Type,
+		Color:       discordColor,
+	}
+	if badge := c.prBadge(); badge != "" {
+		embed.Description += "\n**" + badge + "**"
+	}
+	for _, f := range []discordEmbedField{
+		{Name: "Distance", Value: c.Distance},
+		{Name: "Time", Value: c.Duration},
+		{Name: "Pace", Value: c.Pace},
+	} {
+		if f.Value != "" {
+			f.Inline = true
+			embed.Fields = append(embed.Fields, f)
+		}
+	}
+	if c.BannerURL != "" {
+		embed.Image = &struct {
+			URL string `json:"url"`
+		}{URL: c.BannerURL}
+	}
+
+	return map[string]interface{}{
+		"username": "FitGlue",
+		"embeds":   []discordEmbed{embed},
+		// Activity names are user content; never let them ping anyone
+		"allowed_mentions": map[string]interface{}{"parse": []string{}},
+	}
+}
@@ -0,0 +1,73 @@
+package announcer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// NewSlack returns an announcer that posts to a Slack incoming webhook.
+func NewSlack(svc *bootstrap.Service) *Uploader {
+	return newUploader(svc, "slack", pbplugin.DestinationType_DESTINATION_SLACK, slackPoster{})
+}
+
+// slackPoster posts with Slack incoming webhooks, which don't return the
+// message and so can't edit it later.
+type slackPoster struct{}
+
+func (slackPoster) ValidURL(u *url.URL) bool {
+	return u.Scheme == "https" && u.Host == "hooks.slack.com" && strings.HasPrefix(u.Path, "/services/")
+}
+
+func (slackPoster) Post(ctx context.Context, client *http.Client, webhookURL string, c *card) (string, error) {
+	_, err := postJSON(ctx, client, "POST", webhookURL, slackMessage(c))
+	return "", err
+}
+
+func (slackPoster) Edit(ctx context.Context, client *http.Client, webhookURL, messageID string, c *card) error {
+	return nil
+}
+
+func slackMessage(c *card) map[string]interface{} {
+	title := "*" + slackEscape(c.Title) + "*"
+	if c.Link != "" {
+		title = fmt.Sprintf("*<%s|%s>*", c.Link, slackEscape(c.Title))
+	}
+	text := title + "\n" + slackEscape(c.Type)
+	if badge := c.prBadge(); badge != "" {
+		text += "  •  *" + badge + "*"
+	}
+
+	blocks := []map[string]interface{}{
+		{"type": "section", "text": map[string]string{"type": "mrkdwn", "text": text}},
+	}
+
+	var fields []map[string]string
+	for _, f := range [][2]string{{"Distance", c.Distance}, {"Time", c.Duration}, {"Pace", c.Pace}} {
+		if f[1] != "" {
+			fields = append(fields, map[string]string{"type": "mrkdwn", "text": "*" + f[0] + "*\n" + f[1]})
+		}
+	}
+	if len(fields) > 0 {
+		blocks = append(blocks, map[string]interface{}{"type": "section", "fields": fields})
+	}
+	if c.BannerURL != "" {
+		blocks = append(blocks, map[string]interface{}{"type": "image", "image_url": c.BannerURL, "alt_text": c.Title})
+	}
+
+	return map[string]interface{}{
+		// text is the notification fallback for clients that don't render blocks
+		"text":   slackEscape(c.Title),
+		"blocks": blocks,
+	}
+}
+
+// slackEscape escapes the characters Slack treats as control sequences.
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
@@ -0,0 +1,192 @@
+// Package email implements the email destination, which sends the user a
+// recap of each activity through the service's email provider.
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	emailtemplate "github.com/fitglue/server/src/go/pkg/domain/email"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/loopprevention"
+	"github.com/fitglue/server/src/go/pkg/types/formatters"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	baseURL         = "https://fitglue.tech"
+	showcaseBaseURL = baseURL + "/s/"
+)
+
+// Uploader implements destination.Destination for activity recap emails.
+type Uploader struct {
+	svc *bootstrap.Service
+}
+
+func New(svc *bootstrap.Service) *Uploader {
+	return &Uploader{svc: svc}
+}
+
+// Name returns the identifier for this uploader
+func (u *Uploader) Name() string {
+	return "email"
+}
+
+// Create emails the activity's recap to the user's account address.
+// Returns the activity ID, as sent emails have no ID of their own.
+func (u *Uploader) Create(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record) (string, error) {
+	sender, ok := u.svc.Notifications.(shared.EmailNotificationService)
+	if !ok {
+		return "", errors.New("email delivery is not configured")
+	}
+	to := userRec.UserProfile.GetEmail()
+	if to == "" {
+		return "", errors.New("user has no email address")
+	}
+	logger := slog.Default()
+
+	// Dedup: Pub/Sub redelivery of the same run must not send the recap twice.
+	if u.alreadySent(ctx, payload) {
+		logger.Info("Activity recap already emailed for this pipeline run, skipping")
+		return payload.GetActivityId(), nil
+	}
+
+	recap := u.buildRecap(ctx, payload)
+	subject := recap.Name
+	if recap.PRCount > 0 {
+		subject += " 🏆"
+	}
+	if err := sender.SendEmail(ctx, to, subject, emailtemplate.ActivityRecapTemplate(recap, baseURL)); err != nil {
+		return "", fmt.Errorf("failed to send activity recap: %w", err)
+	}
+
+	uploadRecord := &pbactivity.UploadedActivityRecord{
+		Id:            loopprevention.BuildUploadedActivityID(pbplugin.DestinationType_DESTINATION_EMAIL, payload.GetActivityId()),
+		UserId:        payload.UserId,
+		Source:        payload.Source,
+		ExternalId:    payload.StandardizedActivity.GetExternalId(),
+		StartTime:     payload.StandardizedActivity.GetStartTime(),
+		Destination:   pbplugin.DestinationType_DESTINATION_EMAIL,
+		DestinationId: payload.GetActivityId(),
+		UploadedAt:    timestamppb.Now(),
+	}
+	_ = u.svc.DB.SetUploadedActivity(ctx, payload.UserId, uploadRecord)
+
+	_ = u.svc.DB.IncrementSyncCount(ctx, payload.UserId)
+
+	return payload.GetActivityId(), nil
+}
+
+// Update does nothing: a sent email can't be changed, and sending the recap
+// again for every edit would flood the user's inbox.
+func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record, pipelineRun *pbpipeline.PipelineRun) error {
+	slog.Default().Info("Email recaps are not resent on update, skipping")
+	return nil
+}
+
+// alreadySent reports whether this pipeline run's recap was already emailed.
+func (u *Uploader) alreadySent(ctx context.Context, payload *pbevents.ActivityPayload) bool {
+	if u.svc.DB == nil || payload.PipelineExecutionId == nil || *payload.PipelineExecutionId == "" {
+		return false
+	}
+	outcomes, err := u.svc.DB.GetDestinationOutcomes(ctx, payload.UserId, *payload.PipelineExecutionId)
+	if err != nil {
+		return false
+	}
+	for _, outcome := range outcomes {
+		if outcome.Destination == pbplugin.DestinationType_DESTINATION_EMAIL && outcome.Status == pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS {
+			return true
+		}
+	}
+	return false
+}
+
+// buildRecap summarises the activity for the recap email.
+func (u *Uploader) buildRecap(ctx context.Context, payload *pbevents.ActivityPayload) emailtemplate.ActivityRecap {
+	activityType := formatters.ParseActivityType(payload.Metadata["activity_type"])
+
+	recap := emailtemplate.ActivityRecap{
+		Name:        payload.Metadata["activity_name"],
+		Type:        formatters.FormatActivityType(activityType),
+		Description: payload.Metadata["description"],
+		ImageURL:    payload.Metadata["asset_ai_banner"],
+		Link:        u.showcaseLink(ctx, payload),
+	}
+	if recap.Name == "" {
+		recap.Name = recap.Type
+	}
+	if start := payload.StandardizedActivity.GetStartTime(); start != nil {
+		recap.Date = start.AsTime().Format("Mon 2 Jan 2006")
+	}
+	if payload.Metadata["pr_status"] == "pr_detected" {
+		recap.PRCount, _ = strconv.Atoi(payload.Metadata["pr_count"])
+	}
+
+	sessions := payload.StandardizedActivity.GetSessions()
+	if len(sessions) == 0 {
+		return recap
+	}
+	seconds := sessions[0].TotalElapsedTime
+	meters := sessions[0].TotalDistance
+	if seconds > 0 {
+		recap.Duration = formatDuration(seconds)
+	}
+	if meters > 0 {
+		recap.Distance = fmt.Sprintf("%.2f km", meters/1000)
+	}
+	if seconds > 0 && meters > 0 {
+		recap.Pace = formatPace(activityType, seconds, meters)
+	}
+	return recap
+}
+
+// showcaseLink returns the activity's public Showcase page if this run has one.
+func (u *Uploader) showcaseLink(ctx context.Context, payload *pbevents.ActivityPayload) string {
+	if u.svc.DB == nil || payload.PipelineExecutionId == nil || *payload.PipelineExecutionId == "" {
+		return ""
+	}
+	outcomes, err := u.svc.DB.GetDestinationOutcomes(ctx, payload.UserId, *payload.PipelineExecutionId)
+	if err != nil {
+		return ""
+	}
+	for _, outcome := range outcomes {
+		if outcome.Destination == pbplugin.DestinationType_DESTINATION_SHOWCASE &&
+			outcome.Status == pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS &&
+			outcome.GetExternalId() != "" {
+			return showcaseBaseURL + outcome.GetExternalId()
+		}
+	}
+	return ""
+}
+
+func formatDuration(seconds float64) string {
+	total := int(seconds)
+	if total >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", total/3600, (total%3600)/60, total%60)
+	}
+	return fmt.Sprintf("%d:%02d", total/60, total%60)
+}
+
+// formatPace shows speed for rides and pace per km for everything else.
+func formatPace(activityType pbactivity.ActivityType, seconds, meters float64) string {
+	switch activityType {
+	case pbactivity.ActivityType_ACTIVITY_TYPE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_VIRTUAL_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_MOUNTAIN_BIKE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_GRAVEL_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_EBIKE_RIDE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_EMOUNTAIN_BIKE_RIDE:
+		return fmt.Sprintf("%.1f km/h", meters/1000/(seconds/3600))
+	}
+	perKm := int(seconds / (meters / 1000))
+	return fmt.Sprintf("%d:%02d /km", perKm/60, perKm%60)
+}
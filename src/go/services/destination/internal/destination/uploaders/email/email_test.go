@@ -0,0 +1,110 @@
+package email
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type sentEmail struct {
+	to, subject, html string
+}
+
+// fakeNotifications is a NotificationService with email delivery.
+type fakeNotifications struct {
+	sent []sentEmail
+}
+
+func (f *fakeNotifications) SendPushNotification(ctx context.Context, userID string, title, body string, tokens []string, data map[string]string) error {
+	return nil
+}
+
+func (f *fakeNotifications) SendEmail(ctx context.Context, to, subject, html string) error {
+	f.sent = append(f.sent, sentEmail{to, subject, html})
+	return nil
+}
+
+type pushOnly struct{}
+
+func (pushOnly) SendPushNotification(ctx context.Context, userID string, title, body string, tokens []string, data map[string]string) error {
+	return nil
+}
+
+func testUser(address string) *user.Record {
+	return &user.Record{UserProfile: &pbuser.UserProfile{UserId: "user-1", Email: address}}
+}
+
+func testPayload() *pbevents.ActivityPayload {
+	activityID := "act-1"
+	return &pbevents.ActivityPayload{
+		UserId:     "user-1",
+		ActivityId: &activityID,
+		Metadata: map[string]string{
+			"activity_name": "Sunday <Long> Run",
+			"activity_type": "ACTIVITY_TYPE_RUN",
+			"description":   "Felt strong\nNegative split",
+			"pr_status":     "pr_detected",
+			"pr_count":      "1",
+		},
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			ExternalId: "strava-1",
+			StartTime:  timestamppb.New(time.Date(2026, 6, 7, 8, 0, 0, 0, time.UTC)),
+			Sessions:   []*pbactivity.Session{{TotalElapsedTime: 3000, TotalDistance: 10000}},
+		},
+	}
+}
+
+func TestEmailUploader_Name(t *testing.T) {
+	u := New(&bootstrap.Service{})
+	assert.Equal(t, "email", u.Name())
+}
+
+func TestEmailUploader_CreateSendsRecap(t *testing.T) {
+	notifications := &fakeNotifications{}
+	u := New(&bootstrap.Service{DB: &mocks.MockDatabase{}, Notifications: notifications})
+
+	id, err := u.Create(context.Background(), testPayload(), testUser("runner@example.com"))
+	require.NoError(t, err)
+	assert.Equal(t, "act-1", id)
+
+	require.Len(t, notifications.sent, 1)
+	sent := notifications.sent[0]
+	assert.Equal(t, "runner@example.com", sent.to)
+	assert.Equal(t, "Sunday <Long> Run 🏆", sent.subject)
+	assert.Contains(t, sent.html, "Sunday &lt;Long&gt; Run")
+	assert.Contains(t, sent.html, "10.00 km")
+	assert.Contains(t, sent.html, "50:00")
+	assert.Contains(t, sent.html, "5:00 /km")
+	assert.Contains(t, sent.html, "Felt strong<br>Negative split")
+	assert.Contains(t, sent.html, "Sun 7 Jun 2026")
+}
+
+func TestEmailUploader_CreateRequiresEmailDelivery(t *testing.T) {
+	u := New(&bootstrap.Service{DB: &mocks.MockDatabase{}, Notifications: pushOnly{}})
+	_, err := u.Create(context.Background(), testPayload(), testUser("runner@example.com"))
+	assert.EqualError(t, err, "email delivery is not configured")
+}
+
+func TestEmailUploader_CreateRequiresAddress(t *testing.T) {
+	u := New(&bootstrap.Service{DB: &mocks.MockDatabase{}, Notifications: &fakeNotifications{}})
+	_, err := u.Create(context.Background(), testPayload(), testUser(""))
+	assert.EqualError(t, err, "user has no email address")
+}
+
+func TestEmailUploader_UpdateDoesNotResend(t *testing.T) {
+	notifications := &fakeNotifications{}
+	u := New(&bootstrap.Service{DB: &mocks.MockDatabase{}, Notifications: notifications})
+
+	require.NoError(t, u.Update(context.Background(), testPayload(), testUser("runner@example.com"), nil))
+	assert.Empty(t, notifications.sent)
+}
@@ -0,0 +1,107 @@
+// nolint:proto-json
+package filedrop
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	httputil "github.com/fitglue/server/src/go/pkg/infrastructure/http"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/oauth"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+const dropboxContentURL = "https://content.dropboxapi.com/2"
+
+// NewDropbox returns a file-drop Uploader that writes to the user's Dropbox.
+func NewDropbox(svc *bootstrap.Service) *Uploader {
+	return &Uploader{
+		svc:      svc,
+		name:     "dropbox",
+		destType: pbplugin.DestinationType_DESTINATION_DROPBOX,
+		newStore: newDropboxStore,
+	}
+}
+
+func newDropboxStore(ctx context.Context, svc *bootstrap.Service, payload *pbevents.ActivityPayload, userRec *user.Record) (fileStore, string, error) {
+	if userRec.Integrations == nil || userRec.Integrations.Dropbox == nil || !userRec.Integrations.Dropbox.Enabled {
+		return nil, "", fmt.Errorf("user has no Dropbox integration configured")
+	}
+
+	tokenSource := oauth.NewFirestoreTokenSource(svc, payload.UserId, "dropbox")
+	httpClient := oauth.NewClientWithUsageTracking(tokenSource, svc, payload.UserId, "dropbox", infra.NewLogger())
+
+	return &dropboxStore{client: httpClient}, payload.Metadata["dropbox_folder"], nil
+}
+
+type dropboxStore struct {
+	client *http.Client
+}
+
+type dropboxArg struct {
+	Path       string `json:"path"`
+	Mode       string `json:"mode,omitempty"`
+	Autorename bool   `json:"autorename"`
+	Mute       bool   `json:"mute"`
+}
+
+func (s *dropboxStore) Put(ctx context.Context, filePath string, data []byte, contentType string) error {
+	arg, err := json.Marshal(dropboxArg{Path: filePath, Mode: "overwrite", Mute: true})
+	if err != nil {
+		return fmt.Errorf("failed to marshal Dropbox-API-Arg: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", dropboxContentURL+"/files/upload", bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	// Dropbox requires octet-stream for uploads regardless of file type
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Dropbox-API-Arg", string(arg))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Dropbox API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return httputil.WrapResponseError(resp, "Dropbox upload failed")
+	}
+	return nil
+}
+
+func (s *dropboxStore) Get(ctx context.Context, filePath string) ([]byte, error) {
+	arg, err := json.Marshal(map[string]string{"path": filePath})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Dropbox-API-Arg: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", dropboxContentURL+"/files/download", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Dropbox-API-Arg", string(arg))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Dropbox API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Dropbox reports a missing path as 409 path/not_found
+	if resp.StatusCode == http.StatusConflict {
+		return nil, nil
+	}
+	if resp.StatusCode >= 400 {
+		return nil, httputil.WrapResponseError(resp, "Dropbox download failed")
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,272 @@
+// Package filedrop implements file-drop destinations (Dropbox, WebDAV) that archive
+// the enriched activity as a Markdown file alongside its FIT artifact.
+package filedrop
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/loopprevention"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultFolder = "FitGlue/"
+	endMarker     = "<!-- fitglue:end -->"
+)
+
+// fileStore abstracts the remote storage backend a file-drop destination writes to.
+type fileStore interface {
+	// Put writes data to filePath, creating parent folders and overwriting existing content.
+	Put(ctx context.Context, filePath string, data []byte, contentType string) error
+	// Get returns the content at filePath, or (nil, nil) if it does not exist.
+	Get(ctx context.Context, filePath string) ([]byte, error)
+}
+
+// storeFactory builds a fileStore for a single upload using the user's integration and destination config.
+type storeFactory func(ctx context.Context, svc *bootstrap.Service, payload *pbevents.ActivityPayload, userRec *user.Record) (fileStore, string, error)
+
+// Uploader implements destination.Destination for file-drop targets.
+type Uploader struct {
+	svc      *bootstrap.Service
+	name     string
+	destType pbplugin.DestinationType
+	newStore storeFactory
+}
+
+// Name returns the identifier for this uploader
+func (u *Uploader) Name() string {
+	return u.name
+}
+
+// Create writes the Markdown summary (and FIT file when available) to the configured folder.
+// Returns the Markdown file path, which is used as the external ID for later updates.
+func (u *Uploader) Create(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record) (string, error) {
+	store, folder, err := u.newStore(ctx, u.svc, payload, userRec)
+	if err != nil {
+		return "", err
+	}
+	logger := slog.Default()
+
+	activityDate := time.Now()
+	if payload.Timestamp != nil {
+		activityDate = payload.Timestamp.AsTime()
+	}
+
+	activityName := payload.Metadata["activity_name"]
+	if activityName == "" {
+		activityName = "Activity"
+	}
+
+	filePath := buildFilePath(folder, activityName, activityDate)
+	fitFileName := u.writeFitFile(ctx, store, payload, filePath, logger)
+
+	markdownContent := buildMarkdownContent(payload, activityName, fitFileName)
+	if err := store.Put(ctx, filePath, []byte(markdownContent), "text/markdown"); err != nil {
+		return "", fmt.Errorf("%s write failed: %w", u.name, err)
+	}
+
+	logger.Info("Wrote activity to file drop", "destination", u.name, "path", filePath, "has_fit_file", fitFileName != "")
+
+	uploadRecord := &pbactivity.UploadedActivityRecord{
+		Id:            loopprevention.BuildUploadedActivityID(u.destType, filePath),
+		UserId:        payload.UserId,
+		Source:        payload.Source,
+		ExternalId:    payload.StandardizedActivity.GetExternalId(),
+		StartTime:     payload.Timestamp,
+		Destination:   u.destType,
+		DestinationId: filePath,
+		UploadedAt:    timestamppb.Now(),
+	}
+	_ = u.svc.DB.SetUploadedActivity(ctx, payload.UserId, uploadRecord)
+
+	_ = u.svc.DB.IncrementSyncCount(ctx, payload.UserId)
+
+	return filePath, nil
+}
+
+// Update rewrites the previously written Markdown file, preserving anything the
+// user added below the fitglue:end marker.
+func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record, pipelineRun *pbpipeline.PipelineRun) error {
+	store, _, err := u.newStore(ctx, u.svc, payload, userRec)
+	if err != nil {
+		return err
+	}
+	logger := slog.Default()
+
+	var existingFilePath string
+	if pipelineRun != nil {
+		for _, dest := range pipelineRun.Destinations {
+			if dest.Destination == u.destType && dest.ExternalId != nil && *dest.ExternalId != "" {
+				existingFilePath = *dest.ExternalId
+				break
+			}
+		}
+	}
+	if existingFilePath == "" {
+		return fmt.Errorf("no %s destination found in pipeline run", u.name)
+	}
+
+	existingContent, err := store.Get(ctx, existingFilePath)
+	if err != nil {
+		logger.Warn("Failed to fetch existing file for UPDATE", "destination", u.name, "error", err, "path", existingFilePath)
+		existingContent = nil
+	}
+
+	activityName := payload.Metadata["activity_name"]
+	if activityName == "" {
+		activityName = "Activity"
+	}
+
+	fitFileName := u.writeFitFile(ctx, store, payload, existingFilePath, logger)
+
+	markdownContent := buildMarkdownContent(payload, activityName, fitFileName)
+	if len(existingContent) > 0 {
+		markdownContent = mergeWithUserContent(markdownContent, string(existingContent))
+	}
+
+	if err := store.Put(ctx, existingFilePath, []byte(markdownContent), "text/markdown"); err != nil {
+		return fmt.Errorf("%s update failed: %w", u.name, err)
+	}
+
+	_ = u.svc.DB.IncrementSyncCount(ctx, payload.UserId)
+
+	return nil
+}
+
+// writeFitFile copies the FIT artifact next to the Markdown file.
+// Failures are logged and swallowed; the Markdown summary is the primary artifact.
+func (u *Uploader) writeFitFile(ctx context.Context, store fileStore, payload *pbevents.ActivityPayload, markdownPath string, logger *slog.Logger) string {
+	fitFileUri := payload.Metadata["fit_file_uri"]
+	if fitFileUri == "" {
+		return ""
+	}
+
+	bucketName := u.svc.Config.GCSArtifactBucket
+	if bucketName == "" {
+		bucketName = "fitglue-server-dev-artifacts"
+	}
+	objectName := strings.TrimPrefix(fitFileUri, "gs://"+bucketName+"/")
+
+	fitData, err := u.svc.Store.Get(ctx, bucketName, objectName)
+	if err != nil {
+		logger.Warn("Failed to download FIT file, continuing without it", "destination", u.name, "error", err)
+		return ""
+	}
+
+	fitFileName := "activity.fit"
+	fitPath := path.Join(path.Dir(markdownPath), fitFileName)
+	if err := store.Put(ctx, fitPath, fitData, "application/octet-stream"); err != nil {
+		logger.Warn("Failed to write FIT file, continuing without it", "destination", u.name, "error", err)
+		return ""
+	}
+	return fitFileName
+}
+
+// normalizeFolder returns an absolute folder path with a trailing slash.
+func normalizeFolder(folder string) string {
+	folder = strings.TrimSpace(folder)
+	if folder == "" {
+		folder = defaultFolder
+	}
+	if !strings.HasPrefix(folder, "/") {
+		folder = "/" + folder
+	}
+	if !strings.HasSuffix(folder, "/") {
+		folder += "/"
+	}
+	return folder
+}
+
+func buildMarkdownContent(payload *pbevents.ActivityPayload, activityName, fitFileName string) string {
+	var sb strings.Builder
+
+	sb.WriteString("---\n")
+	sb.WriteString(fmt.Sprintf("title: %q\n", activityName))
+
+	activityTypeStr := strings.TrimPrefix(payload.Metadata["activity_type"], "ACTIVITY_TYPE_")
+	sb.WriteString(fmt.Sprintf("type: %s\n", activityTypeStr))
+
+	if payload.Timestamp != nil {
+		sb.WriteString(fmt.Sprintf("date: %s\n", payload.Timestamp.AsTime().Format(time.RFC3339)))
+	}
+
+	sb.WriteString(fmt.Sprintf("source: %s\n", payload.Source.String()))
+	sb.WriteString(fmt.Sprintf("activity_id: %s\n", payload.GetActivityId()))
+	sb.WriteString(fmt.Sprintf("pipeline_id: %s\n", payload.GetPipelineId()))
+
+	if fitFileName != "" {
+		sb.WriteString(fmt.Sprintf("fit_file: %s\n", fitFileName))
+	}
+	if enrichments := payload.Metadata["applied_enrichments"]; enrichments != "" {
+		sb.WriteString(fmt.Sprintf("enrichments: [%s]\n", enrichments))
+	}
+	if tags := payload.Metadata["tags"]; tags != "" {
+		sb.WriteString(fmt.Sprintf("tags: [%s]\n", tags))
+	}
+	sb.WriteString("---\n\n")
+
+	sb.WriteString(fmt.Sprintf("# %s\n\n", activityName))
+
+	if description := payload.Metadata["description"]; description != "" {
+		sb.WriteString(description)
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n" + endMarker + "\n")
+
+	return sb.String()
+}
+
+func buildFilePath(folder string, activityName string, activityDate time.Time) string {
+	return fmt.Sprintf("%s%s/%s/%s-%s/activity.md",
+		normalizeFolder(folder),
+		activityDate.Format("2006"),
+		activityDate.Format("01"),
+		activityDate.Format("2006-01-02"),
+		sanitizeFileName(activityName),
+	)
+}
+
+func sanitizeFileName(name string) string {
+	lower := strings.ToLower(name)
+	replacer := strings.NewReplacer(
+		" ", "-", "/", "-", "\\", "-", ":", "-",
+		"'", "", "\"", "", "(", "", ")", "",
+		".", "-", ",", "",
+	)
+	result := replacer.Replace(lower)
+	for strings.Contains(result, "--") {
+		result = strings.ReplaceAll(result, "--", "-")
+	}
+	return strings.Trim(result, "-")
+}
+
+func mergeWithUserContent(newContent, existingContent string) string {
+	idx := strings.Index(existingContent, endMarker)
+	if idx == -1 {
+		return newContent
+	}
+
+	userContent := existingContent[idx+len(endMarker):]
+	if strings.TrimSpace(userContent) == "" {
+		return newContent
+	}
+
+	newIdx := strings.Index(newContent, endMarker)
+	if newIdx == -1 {
+		return newContent + "\n" + endMarker + userContent
+	}
+
+	return newContent[:newIdx+len(endMarker)] + userContent
+}
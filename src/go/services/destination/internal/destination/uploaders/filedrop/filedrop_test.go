@@ -0,0 +1,111 @@
+package filedrop
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileDropUploader_Name(t *testing.T) {
+	assert.Equal(t, "dropbox", NewDropbox(&bootstrap.Service{}).Name())
+	assert.Equal(t, "webdav", NewWebDAV(&bootstrap.Service{}).Name())
+}
+
+func TestBuildFilePath(t *testing.T) {
+	date := time.Date(2026, 2, 8, 7, 30, 0, 0, time.UTC)
+	assert.Equal(t, "/FitGlue/2026/02/2026-02-08-morning-run/activity.md", buildFilePath("", "Morning Run", date))
+	assert.Equal(t, "/archive/workouts/2026/02/2026-02-08-leg-day/activity.md", buildFilePath("archive/workouts", "Leg Day", date))
+}
+
+func TestFileDropUploader_MergeUserContent(t *testing.T) {
+	existing := "Some content\n<!-- fitglue:end -->\n\nUser edit!"
+	newContent := "New generated content\n<!-- fitglue:end -->"
+
+	assert.Equal(t, "New generated content\n<!-- fitglue:end -->\n\nUser edit!", mergeWithUserContent(newContent, existing))
+}
+
+func TestWebDAVStore_PutCreatesCollections(t *testing.T) {
+	var mu sync.Mutex
+	var methods []string
+	files := map[string]string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		user, pass, _ := r.BasicAuth()
+		assert.Equal(t, "athlete", user)
+		assert.Equal(t, "secret", pass)
+
+		methods = append(methods, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case "MKCOL":
+			w.WriteHeader(http.StatusMethodNotAllowed) // already exists
+		case "PUT":
+			body, _ := io.ReadAll(r.Body)
+			files[r.URL.Path] = string(body)
+			w.WriteHeader(http.StatusCreated)
+		case "GET":
+			if content, ok := files[r.URL.Path]; ok {
+				_, _ = w.Write([]byte(content))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	store := &webdavStore{client: server.Client(), baseURL: server.URL + "/dav", username: "athlete", password: "secret"}
+	ctx := context.Background()
+
+	require.NoError(t, store.Put(ctx, "/FitGlue/2026/activity.md", []byte("hello"), "text/markdown"))
+	assert.Equal(t, []string{"MKCOL /dav/FitGlue/", "MKCOL /dav/FitGlue/2026/", "PUT /dav/FitGlue/2026/activity.md"}, methods)
+
+	data, err := store.Get(ctx, "/FitGlue/2026/activity.md")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+
+	missing, err := store.Get(ctx, "/FitGlue/missing.md")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestNewWebDAVStore(t *testing.T) {
+	svc := &bootstrap.Service{DB: &mocks.MockDatabase{
+		GetIntegrationSecretFunc: func(ctx context.Context, userId string, provider string) (string, error) {
+			assert.Equal(t, "webdav", provider)
+			return "secret", nil
+		},
+	}}
+	userRec := &user.Record{UserProfile: &pbuser.UserProfile{UserId: "u1"}}
+	payload := func(webdavURL string) *pbevents.ActivityPayload {
+		return &pbevents.ActivityPayload{Metadata: map[string]string{
+			"webdav_url":      webdavURL,
+			"webdav_username": "athlete",
+			"webdav_folder":   "archive",
+		}}
+	}
+
+	fs, folder, err := newWebDAVStore(context.Background(), svc, payload("https://cloud.example.com/dav/"), userRec)
+	require.NoError(t, err)
+	assert.Equal(t, "archive", folder)
+	store := fs.(*webdavStore)
+	assert.Equal(t, "https://cloud.example.com/dav", store.baseURL)
+	assert.Equal(t, "secret", store.password)
+
+	for _, bad := range []string{"http://cloud.example.com/dav", "ftp://cloud.example.com", "https://"} {
+		_, _, err := newWebDAVStore(context.Background(), svc, payload(bad), userRec)
+		assert.Error(t, err, bad)
+	}
+}
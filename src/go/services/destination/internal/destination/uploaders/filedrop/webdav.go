@@ -0,0 +1,161 @@
+package filedrop
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	httputil "github.com/fitglue/server/src/go/pkg/infrastructure/http"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+)
+
+// NewWebDAV returns a file-drop Uploader that writes to a user-configured WebDAV endpoint
+// (e.g. Nextcloud, ownCloud, Synology).
+func NewWebDAV(svc *bootstrap.Service) *Uploader {
+	return &Uploader{
+		svc:      svc,
+		name:     "webdav",
+		destType: pbplugin.DestinationType_DESTINATION_WEBDAV,
+		newStore: newWebDAVStore,
+	}
+}
+
+// newWebDAVStore connects to the user's server. Since the URL is the user's
+// to choose, it must be HTTPS and resolve to a public address. The password
+// comes from the user's integration secrets rather than the event metadata.
+func newWebDAVStore(ctx context.Context, svc *bootstrap.Service, payload *pbevents.ActivityPayload, userRec *user.Record) (fileStore, string, error) {
+	baseURL := strings.TrimRight(payload.Metadata["webdav_url"], "/")
+	if baseURL == "" {
+		return nil, "", fmt.Errorf("webdav_url not configured in metadata")
+	}
+	parsed, err := url.Parse(baseURL)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		return nil, "", fmt.Errorf("invalid webdav_url, an https:// URL is required: %s", baseURL)
+	}
+
+	password, err := svc.DB.GetIntegrationSecret(ctx, userRec.UserId, "webdav")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read WebDAV password: %w", err)
+	}
+
+	return &webdavStore{
+		client:   httputil.NewPublicOnlyClient(30 * time.Second),
+		baseURL:  baseURL,
+		username: payload.Metadata["webdav_username"],
+		password: password,
+	}, payload.Metadata["webdav_folder"], nil
+}
+
+type webdavStore struct {
+	client   *http.Client
+	baseURL  string
+	username string
+	password string
+}
+
+func (s *webdavStore) newRequest(ctx context.Context, method, filePath string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.baseURL+escapePath(filePath), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s request: %w", method, err)
+	}
+	if s.username != "" || s.password != "" {
+		req.SetBasicAuth(s.username, s.password)
+	}
+	return req, nil
+}
+
+func (s *webdavStore) Put(ctx context.Context, filePath string, data []byte, contentType string) error {
+	if err := s.ensureCollections(ctx, path.Dir(filePath)); err != nil {
+		return err
+	}
+
+	req, err := s.newRequest(ctx, "PUT", filePath, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("WebDAV PUT request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return httputil.WrapResponseError(resp, "WebDAV PUT failed")
+	}
+	return nil
+}
+
+func (s *webdavStore) Get(ctx context.Context, filePath string) ([]byte, error) {
+	req, err := s.newRequest(ctx, "GET", filePath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("WebDAV GET request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode >= 400 {
+		return nil, httputil.WrapResponseError(resp, "WebDAV GET failed")
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// ensureCollections creates each folder along dir with MKCOL.
+// WebDAV does not create intermediate collections on PUT, and answers 405
+// for collections that already exist, which is treated as success.
+func (s *webdavStore) ensureCollections(ctx context.Context, dir string) error {
+	current := ""
+	for _, part := range strings.Split(strings.Trim(dir, "/"), "/") {
+		if part == "" {
+			continue
+		}
+		current += "/" + part
+		if err := s.mkcol(ctx, current+"/"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *webdavStore) mkcol(ctx context.Context, collection string) error {
+	req, err := s.newRequest(ctx, "MKCOL", collection, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("WebDAV MKCOL request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 && resp.StatusCode != http.StatusMethodNotAllowed {
+		return httputil.WrapResponseError(resp, "WebDAV MKCOL failed")
+	}
+	return nil
+}
+
+// escapePath percent-encodes each path segment while keeping the separators.
+func escapePath(p string) string {
+	parts := strings.Split(p, "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+	return strings.Join(parts, "/")
+}
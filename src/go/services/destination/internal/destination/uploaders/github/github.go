@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/fitglue/server/src/go/internal/archive"
 	"github.com/fitglue/server/src/go/internal/infra"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
 	"github.com/fitglue/server/src/go/pkg/description"
@@ -207,14 +208,7 @@ func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload
 
 	payloadDesc := payload.Metadata["description"]
 	if existingContent != "" && payloadDesc != "" {
-		sectionHeader := ""
-		for key, val := range payload.Metadata {
-			if strings.HasPrefix(key, "section_header_") {
-				sectionHeader = val
-				break
-			}
-		}
-
+		sectionHeader := description.SectionHeader(payload.Metadata)
 		if sectionHeader != "" && description.HasSection(payloadDesc, sectionHeader) {
 			newSectionContent := description.ExtractSection(payloadDesc, sectionHeader)
 			if newSectionContent != "" {
@@ -263,55 +257,109 @@ func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload
 	return nil
 }
 
-func buildMarkdownContent(payload *pbevents.ActivityPayload, activityName, fitFileName string) string {
-	var sb strings.Builder
+// Remove deletes an activity's Markdown file, and the FIT file committed next
+// to it, from the repository. Files that are already gone are skipped.
+func (u *Uploader) Remove(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record, externalID string) error {
+	if userRec.Integrations == nil || userRec.Integrations.Github == nil || !userRec.Integrations.Github.Enabled {
+		return fmt.Errorf("user has no GitHub integration configured")
+	}
 
-	sb.WriteString("---\n")
-	sb.WriteString(fmt.Sprintf("title: %q\n", activityName))
+	tokenSource := oauth.NewFirestoreTokenSource(u.svc, payload.UserId, "github")
+	httpClient := oauth.NewClientWithUsageTracking(tokenSource, u.svc, payload.UserId, "github", infra.NewLogger())
 
-	activityTypeStr := payload.Metadata["activity_type"]
-	activityTypeStr = strings.TrimPrefix(activityTypeStr, "ACTIVITY_TYPE_")
-	sb.WriteString(fmt.Sprintf("type: %s\n", activityTypeStr))
+	ghClient, err := ghclient.NewClientWithResponses("https://api.github.com",
+		ghclient.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create GitHub client: %w", err)
+	}
 
-	if payload.Timestamp != nil {
-		sb.WriteString(fmt.Sprintf("date: %s\n", payload.Timestamp.AsTime().Format("2006-01-02T15:04:05Z07:00")))
+	config, err := loadGitHubConfig(payload)
+	if err != nil {
+		return fmt.Errorf("failed to load GitHub config: %w", err)
 	}
 
-	sb.WriteString(fmt.Sprintf("source: %s\n", payload.Source.String()))
-	sb.WriteString(fmt.Sprintf("activity_id: %s\n", payload.GetActivityId()))
-	sb.WriteString(fmt.Sprintf("pipeline_id: %s\n", payload.GetPipelineId()))
+	committer := ghclient.CommitAuthor{Name: "FitGlue Bot", Email: "bot@fitglue.com"}
+	for _, filePath := range []string{externalID, path.Join(path.Dir(externalID), "activity.fit")} {
+		sha, _, err := u.getFileContent(ctx, ghClient, config, filePath)
+		if err != nil {
+			return fmt.Errorf("GitHub lookup failed for %s: %w", filePath, err)
+		}
+		if sha == nil {
+			continue
+		}
+		message := fmt.Sprintf("Remove %s (deleted at source)", path.Base(filePath))
+		if err := ghClient.DeleteFile(ctx, config.Owner, config.Name, filePath, *sha, message, committer, gitHubHeaders); err != nil {
+			return fmt.Errorf("GitHub delete failed: %w", err)
+		}
+	}
+	return nil
+}
 
-	if fitFileName != "" {
-		sb.WriteString(fmt.Sprintf("fit_file: %s\n", fitFileName))
+// PublishSite commits a static site export of the user's archive as the
+// whole contents of a branch, in one commit, and returns the GitHub Pages URL
+// it is served from once Pages is enabled for that branch.
+func (u *Uploader) PublishSite(ctx context.Context, userID, repo, branch string, site archive.Site) (string, error) {
+	parts := strings.SplitN(repo, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("invalid repo format: %s (expected owner/repo)", repo)
 	}
 
-	appliedEnrichmentsStr := payload.Metadata["applied_enrichments"]
-	if appliedEnrichmentsStr != "" {
-		sb.WriteString(fmt.Sprintf("enrichments: [%s]\n", appliedEnrichmentsStr))
+	tokenSource := oauth.NewFirestoreTokenSource(u.svc, userID, "github")
+	httpClient := oauth.NewClientWithUsageTracking(tokenSource, u.svc, userID, "github", infra.NewLogger())
+
+	ghClient, err := ghclient.NewClientWithResponses("https://api.github.com",
+		ghclient.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to create GitHub client: %w", err)
 	}
 
-	tagsStr := payload.Metadata["tags"]
-	if tagsStr != "" {
-		sb.WriteString(fmt.Sprintf("tags: [%s]\n", tagsStr))
+	committer := ghclient.CommitAuthor{Name: "FitGlue Bot", Email: "bot@fitglue.com"}
+	sha, err := ghClient.ReplaceBranchContents(ctx, parts[0], parts[1], branch, "Export activity archive", site, committer, gitHubHeaders)
+	if err != nil {
+		return "", fmt.Errorf("GitHub publish failed: %w", err)
 	}
-	sb.WriteString("---\n\n")
 
-	sb.WriteString(fmt.Sprintf("# %s\n\n", activityName))
+	slog.Default().Info("Published archive to GitHub", "repo", repo, "branch", branch, "commit", sha, "files", len(site))
+	return pagesURL(parts[0], parts[1]), nil
+}
 
-	description := payload.Metadata["description"]
-	if description != "" {
-		sb.WriteString(description)
-		sb.WriteString("\n")
+// pagesURL is where GitHub Pages serves a repository: the root of the
+// owner's domain for an {owner}.github.io repository, a subpath otherwise.
+func pagesURL(owner, name string) string {
+	host := strings.ToLower(owner) + ".github.io"
+	if strings.EqualFold(name, host) {
+		return "https://" + host + "/"
 	}
+	return fmt.Sprintf("https://%s/%s/", host, name)
+}
 
-	sb.WriteString("\n<!-- fitglue:end -->\n")
-
-	return sb.String()
+func buildMarkdownContent(payload *pbevents.ActivityPayload, activityName, fitFileName string) string {
+	doc := archive.Document{
+		Title:       activityName,
+		Type:        payload.Metadata["activity_type"],
+		Source:      payload.Source.String(),
+		ActivityID:  payload.GetActivityId(),
+		PipelineID:  payload.GetPipelineId(),
+		FitFile:     fitFileName,
+		Description: payload.Metadata["description"],
+	}
+	if payload.Timestamp != nil {
+		doc.Date = payload.Timestamp.AsTime()
+	}
+	if enrichments := payload.Metadata["applied_enrichments"]; enrichments != "" {
+		doc.Enrichments = strings.Split(enrichments, ",")
+	}
+	if tags := payload.Metadata["tags"]; tags != "" {
+		doc.Tags = strings.Split(tags, ",")
+	}
+	return archive.RenderMarkdown(doc)
 }
 
 func buildFilePath(folder string, activityName string, activityDate time.Time) string {
 	dateStr := activityDate.Format("2006-01-02")
-	safeName := sanitizeFileName(activityName)
+	safeName := archive.SanitizeFileName(activityName)
 	return fmt.Sprintf("%s%s/%s/%s-%s/activity.md",
 		folder,
 		activityDate.Format("2006"),
@@ -321,22 +369,8 @@ func buildFilePath(folder string, activityName string, activityDate time.Time) s
 	)
 }
 
-func sanitizeFileName(name string) string {
-	lower := strings.ToLower(name)
-	replacer := strings.NewReplacer(
-		" ", "-", "/", "-", "\\", "-", ":", "-",
-		"'", "", "\"", "", "(", "", ")", "",
-		".", "-", ",", "",
-	)
-	result := replacer.Replace(lower)
-	for strings.Contains(result, "--") {
-		result = strings.ReplaceAll(result, "--", "-")
-	}
-	return strings.Trim(result, "-")
-}
-
 func mergeWithUserContent(newContent, existingContent string) string {
-	marker := "<!-- fitglue:end -->"
+	marker := archive.EndMarker
 	idx := strings.Index(existingContent, marker)
 	if idx == -1 {
 		return newContent
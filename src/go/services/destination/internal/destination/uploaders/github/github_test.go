@@ -2,9 +2,14 @@ package github
 
 import (
 	"testing"
+	"time"
 
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestGitHubUploader_Name(t *testing.T) {
@@ -20,3 +25,37 @@ func TestGitHubUploader_MergeUserContent(t *testing.T) {
 	expected := "New generated content\n<!-- fitglue:end -->\n\nUser edit!"
 	assert.Equal(t, expected, merged)
 }
+
+func TestGitHubUploader_PagesURL(t *testing.T) {
+	assert.Equal(t, "https://jo.github.io/activities/", pagesURL("Jo", "activities"))
+	assert.Equal(t, "https://jo.github.io/", pagesURL("Jo", "jo.github.io"))
+}
+
+func TestGitHubUploader_BuildMarkdownContent(t *testing.T) {
+	payload := &pbevents.ActivityPayload{
+		Source:     pbactivity.ActivitySource_SOURCE_HEVY,
+		ActivityId: proto.String("a1"),
+		PipelineId: proto.String("p1"),
+		Timestamp:  timestamppb.New(time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)),
+		Metadata: map[string]string{
+			"activity_type":       "ACTIVITY_TYPE_WEIGHT_TRAINING",
+			"description":         "Heavy day",
+			"applied_enrichments": "muscle-heatmap,heart-rate",
+		},
+	}
+
+	expected := "---\n" +
+		"title: \"Push Day\"\n" +
+		"type: WEIGHT_TRAINING\n" +
+		"date: 2026-03-01T08:00:00Z\n" +
+		"source: SOURCE_HEVY\n" +
+		"activity_id: a1\n" +
+		"pipeline_id: p1\n" +
+		"fit_file: activity.fit\n" +
+		"enrichments: [muscle-heatmap,heart-rate]\n" +
+		"---\n\n" +
+		"# Push Day\n\n" +
+		"Heavy day\n" +
+		"\n<!-- fitglue:end -->\n"
+	assert.Equal(t, expected, buildMarkdownContent(payload, "Push Day", "activity.fit"))
+}
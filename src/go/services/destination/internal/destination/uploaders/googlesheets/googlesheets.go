@@ -7,7 +7,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -24,16 +29,66 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	sheetsBaseURL      = "https://sheets.googleapis.com/v4"
+	driveUploadBaseURL = "https://www.googleapis.com/upload/drive/v3"
+
+	// showcaseBaseURL prefixes a Showcase ID to make its public link.
+	showcaseBaseURL = "https://fitglue.tech/s/"
+
+	// lastColumn is the column of the last field in getHeaderRow.
+	lastColumn = "P"
+)
+
+// sourceActivityURLs links an activity on the platforms it can come from.
+var sourceActivityURLs = map[pbactivity.ActivitySource]string{
+	pbactivity.ActivitySource_SOURCE_STRAVA:    "https://www.strava.com/activities/%s",
+	pbactivity.ActivitySource_SOURCE_HEVY:      "https://hevy.com/workout/%s",
+	pbactivity.ActivitySource_SOURCE_INTERVALS: "https://intervals.icu/activities/%s",
+}
+
 // Uploader implements destination.Destination for Google Sheets
 type Uploader struct {
-	svc *bootstrap.Service
+	svc            *bootstrap.Service
+	sheetsURL      string
+	driveUploadURL string
+	httpClient     func(userID string) *http.Client
 }
 
 // New returns a new Google Sheets Uploader initialized with dependencies.
 func New(svc *bootstrap.Service) *Uploader {
 	return &Uploader{
-		svc: svc,
+		svc:            svc,
+		sheetsURL:      sheetsBaseURL,
+		driveUploadURL: driveUploadBaseURL,
+		httpClient: func(userID string) *http.Client {
+			tokenSource := oauth.NewFirestoreTokenSource(svc, userID, "google")
+			return oauth.NewClientWithUsageTracking(tokenSource, svc, userID, "google", infra.NewLogger())
+		},
+	}
+}
+
+// sheetConfig is the destination config injected into the payload metadata.
+type sheetConfig struct {
+	spreadsheetID       string
+	sheetName           string
+	includeVisuals      bool
+	includeShowcaseLink bool
+	driveFolderID       string
+}
+
+func parseSheetConfig(metadata map[string]string) sheetConfig {
+	cfg := sheetConfig{
+		spreadsheetID:       metadata["googlesheets_spreadsheet_id"],
+		sheetName:           "Activities",
+		includeVisuals:      metadata["googlesheets_include_visuals"] != "false",
+		includeShowcaseLink: metadata["googlesheets_include_showcase_link"] != "false",
+		driveFolderID:       metadata["googlesheets_drive_folder_id"],
+	}
+	if name := metadata["googlesheets_sheet_name"]; name != "" {
+		cfg.sheetName = name
 	}
+	return cfg
 }
 
 // Name returns the identifier for this uploader
@@ -41,72 +96,105 @@ func (u *Uploader) Name() string {
 	return "googlesheets"
 }
 
-// Create uploads a new activity to Google Sheets by appending a row.
+// Create uploads a new activity to Google Sheets by appending a row. If a
+// Drive folder is configured the FIT file is uploaded there first and linked
+// from the row.
 func (u *Uploader) Create(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record) (string, error) {
 	if userRec.Integrations == nil || userRec.Integrations.Google == nil || !userRec.Integrations.Google.Enabled {
 		return "", fmt.Errorf("user has no Google integration configured")
 	}
 
-	spreadsheetID := ""
-	sheetName := "Activities"
-	includeVisuals := true
-
-	if id, ok := payload.Metadata["googlesheets_spreadsheet_id"]; ok && id != "" {
-		spreadsheetID = id
-	}
-	if name, ok := payload.Metadata["googlesheets_sheet_name"]; ok && name != "" {
-		sheetName = name
-	}
-	if visuals, ok := payload.Metadata["googlesheets_include_visuals"]; ok && visuals == "false" {
-		includeVisuals = false
-	}
-
-	if spreadsheetID == "" {
+	cfg := parseSheetConfig(payload.Metadata)
+	if cfg.spreadsheetID == "" {
 		return "", fmt.Errorf("spreadsheet_id not configured in metadata")
 	}
 
-	tokenSource := oauth.NewFirestoreTokenSource(u.svc, payload.UserId, "google")
-	httpClient := oauth.NewClientWithUsageTracking(tokenSource, u.svc, payload.UserId, "google", infra.NewLogger())
+	httpClient := u.httpClient(payload.UserId)
 	logger := slog.Default()
 
-	if err := u.ensureHeaderRow(ctx, httpClient, spreadsheetID, sheetName, logger); err != nil {
+	if err := u.ensureHeaderRow(ctx, httpClient, cfg.spreadsheetID, cfg.sheetName, logger); err != nil {
 		logger.Warn("Failed to ensure header row", "error", err)
 	}
 
-	row := u.buildSheetRow(payload, includeVisuals)
+	fitFileLink := ""
+	if cfg.driveFolderID != "" {
+		link, err := u.uploadFitToDrive(ctx, httpClient, cfg.driveFolderID, payload)
+		if err != nil {
+			// The row is still worth having without the file
+			logger.Warn("Failed to upload FIT file to Google Drive", "folder_id", cfg.driveFolderID, "error", err)
+		}
+		fitFileLink = link
+	}
+
+	row := u.buildSheetRow(payload, cfg.includeVisuals, u.activityLink(ctx, payload, cfg.includeShowcaseLink), fitFileLink)
 
-	rowNumber, err := u.appendToSheet(ctx, httpClient, spreadsheetID, sheetName, row, logger)
+	rowNumber, err := u.appendToSheet(ctx, httpClient, cfg.spreadsheetID, cfg.sheetName, row, logger)
 	if err != nil {
 		return "", fmt.Errorf("failed to append to Google Sheets: %w", err)
 	}
+	if rowNumber == 0 {
+		return "", fmt.Errorf("no row number in Google Sheets response")
+	}
 
 	googlesheetsDestID := fmt.Sprintf("%d", rowNumber)
-	if googlesheetsDestID != "" {
-		uploadRecord := &pbactivity.UploadedActivityRecord{
-			Id:            loopprevention.BuildUploadedActivityID(pbplugin.DestinationType_DESTINATION_GOOGLESHEETS, googlesheetsDestID),
-			UserId:        payload.UserId,
-			Source:        payload.Source,
-			ExternalId:    payload.StandardizedActivity.GetExternalId(),
-			StartTime:     payload.Timestamp,
-			Destination:   pbplugin.DestinationType_DESTINATION_GOOGLESHEETS,
-			DestinationId: googlesheetsDestID,
-			UploadedAt:    timestamppb.Now(),
-		}
-		_ = u.svc.DB.SetUploadedActivity(ctx, payload.UserId, uploadRecord)
-	}
+	uploadRecord := &pbactivity.UploadedActivityRecord{
+		Id:            loopprevention.BuildUploadedActivityID(pbplugin.DestinationType_DESTINATION_GOOGLESHEETS, googlesheetsDestID),
+		UserId:        payload.UserId,
+		Source:        payload.Source,
+		ExternalId:    payload.StandardizedActivity.GetExternalId(),
+		StartTime:     payload.Timestamp,
+		Destination:   pbplugin.DestinationType_DESTINATION_GOOGLESHEETS,
+		DestinationId: googlesheetsDestID,
+		UploadedAt:    timestamppb.Now(),
+	}
+	_ = u.svc.DB.SetUploadedActivity(ctx, payload.UserId, uploadRecord)
 
 	_ = u.svc.DB.IncrementSyncCount(ctx, payload.UserId)
 
 	return googlesheetsDestID, nil
 }
 
-// Update modifies an existing activity. For Google Sheets, this appends a new row.
+// Update rewrites the activity's row in place, keeping its FIT file link.
+// Runs with no recorded row get a new one.
 func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record, pipelineRun *pbpipeline.PipelineRun) error {
+	if userRec.Integrations == nil || userRec.Integrations.Google == nil || !userRec.Integrations.Google.Enabled {
+		return fmt.Errorf("user has no Google integration configured")
+	}
+
+	rowNumber := 0
+	if pipelineRun != nil {
+		for _, dest := range pipelineRun.Destinations {
+			if dest.Destination == pbplugin.DestinationType_DESTINATION_GOOGLESHEETS && dest.ExternalId != nil {
+				rowNumber, _ = strconv.Atoi(*dest.ExternalId)
+				break
+			}
+		}
+	}
+
 	logger := slog.Default()
-	logger.Info("Handling Google Sheets UPDATE (append-only mode)", "activity_id", payload.ActivityId)
+	if rowNumber <= 1 {
+		logger.Info("No Google Sheets row recorded for run, appending", "activity_id", payload.GetActivityId())
+		_, err := u.Create(ctx, payload, userRec)
+		return err
+	}
+
+	cfg := parseSheetConfig(payload.Metadata)
+	if cfg.spreadsheetID == "" {
+		return fmt.Errorf("spreadsheet_id not configured in metadata")
+	}
 
-	_, err := u.Create(ctx, payload, userRec)
-	return err
+	// A nil FIT file link leaves that cell as it is
+	row := u.buildSheetRow(payload, cfg.includeVisuals, u.activityLink(ctx, payload, cfg.includeShowcaseLink), "")
+	row[len(row)-1] = nil
+
+	httpClient := u.httpClient(payload.UserId)
+	if err := u.writeRow(ctx, httpClient, cfg.spreadsheetID, cfg.sheetName, rowNumber, row); err != nil {
+		return fmt.Errorf("failed to update Google Sheets row: %w", err)
+	}
+
+	_ = u.svc.DB.IncrementSyncCount(ctx, payload.UserId)
+
+	return nil
 }
 
 func (u *Uploader) getHeaderRow() []interface{} {
@@ -124,11 +212,14 @@ func (u *Uploader) getHeaderRow() []interface{} {
 		"Elevation Gain (m)",
 		"Generated Images",
 		"Description",
+		"PRs",
+		"Link",
+		"FIT File",
 	}
 }
 
 func (u *Uploader) ensureHeaderRow(ctx context.Context, httpClient *http.Client, spreadsheetID, sheetName string, logger *slog.Logger) error {
-	url := fmt.Sprintf("https://sheets.googleapis.com/v4/spreadsheets/%s/values/%s!A1:M1", spreadsheetID, sheetName)
+	url := fmt.Sprintf("%s/spreadsheets/%s/values/%s", u.sheetsURL, spreadsheetID, a1Range(sheetName, "A1:"+lastColumn+"1"))
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create header check request: %w", err)
@@ -158,12 +249,18 @@ func (u *Uploader) ensureHeaderRow(ctx context.Context, httpClient *http.Client,
 		if err != nil {
 			return fmt.Errorf("failed to write header row: %w", err)
 		}
+	} else if len(respBody.Values[0]) < len(u.getHeaderRow()) {
+		// Sheets created before newer columns were added get their headers
+		logger.Info("Sheet header row is missing columns, extending it")
+		if err := u.writeRow(ctx, httpClient, spreadsheetID, sheetName, 1, u.getHeaderRow()); err != nil {
+			return fmt.Errorf("failed to extend header row: %w", err)
+		}
 	}
 
 	return nil
 }
 
-func (u *Uploader) buildSheetRow(payload *pbevents.ActivityPayload, includeVisuals bool) []interface{} {
+func (u *Uploader) buildSheetRow(payload *pbevents.ActivityPayload, includeVisuals bool, link, fitFileLink string) []interface{} {
 	row := []interface{}{}
 
 	row = append(row, time.Now().UTC().Format("2006-01-02 15:04:05"))
@@ -171,6 +268,8 @@ func (u *Uploader) buildSheetRow(payload *pbevents.ActivityPayload, includeVisua
 	date := ""
 	if payload.Timestamp != nil {
 		date = payload.Timestamp.AsTime().Format("2006-01-02")
+	} else if start := payload.StandardizedActivity.GetStartTime(); start != nil {
+		date = start.AsTime().Format("2006-01-02")
 	}
 	row = append(row, date)
 
@@ -289,9 +388,169 @@ func (u *Uploader) buildSheetRow(payload *pbevents.ActivityPayload, includeVisua
 	}
 	row = append(row, description)
 
+	prs := ""
+	if payload.Metadata["pr_status"] == "pr_detected" {
+		prs = payload.Metadata["pr_count"]
+	}
+	row = append(row, prs)
+
+	row = append(row, link)
+	row = append(row, fitFileLink)
+
 	return row
 }
 
+// activityLink returns the activity's Showcase link if it has one and that's
+// wanted, and otherwise its link on the source platform, if any.
+func (u *Uploader) activityLink(ctx context.Context, payload *pbevents.ActivityPayload, includeShowcase bool) string {
+	if includeShowcase && u.svc.DB != nil && payload.PipelineExecutionId != nil && *payload.PipelineExecutionId != "" {
+		outcomes, err := u.svc.DB.GetDestinationOutcomes(ctx, payload.UserId, *payload.PipelineExecutionId)
+		if err == nil {
+			for _, outcome := range outcomes {
+				if outcome.Destination == pbplugin.DestinationType_DESTINATION_SHOWCASE &&
+					outcome.Status == pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS &&
+					outcome.ExternalId != nil && *outcome.ExternalId != "" {
+					return showcaseBaseURL + *outcome.ExternalId
+				}
+			}
+		}
+	}
+
+	if tmpl, ok := sourceActivityURLs[payload.Source]; ok {
+		if id := payload.StandardizedActivity.GetExternalId(); id != "" {
+			return fmt.Sprintf(tmpl, id)
+		}
+	}
+	return ""
+}
+
+// uploadFitToDrive uploads the run's FIT artifact to a Drive folder and
+// returns a link to it.
+func (u *Uploader) uploadFitToDrive(ctx context.Context, httpClient *http.Client, folderID string, payload *pbevents.ActivityPayload) (string, error) {
+	fitFileUri := payload.Metadata["fit_file_uri"]
+	if fitFileUri == "" {
+		return "", fmt.Errorf("missing fit_file_uri in metadata")
+	}
+
+	bucketName := u.svc.Config.GCSArtifactBucket
+	if bucketName == "" {
+		bucketName = "fitglue-server-dev-artifacts"
+	}
+	fileData, err := u.svc.Store.Get(ctx, bucketName, strings.TrimPrefix(fitFileUri, "gs://"+bucketName+"/"))
+	if err != nil {
+		return "", fmt.Errorf("GCS Read Error: %w", err)
+	}
+
+	metadataJSON, err := json.Marshal(map[string]interface{}{
+		"name":     driveFileName(payload),
+		"parents":  []string{folderID},
+		"mimeType": "application/vnd.ant.fit",
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal file metadata: %w", err)
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	metaPart, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {"application/json; charset=UTF-8"}})
+	if err != nil {
+		return "", err
+	}
+	if _, err := metaPart.Write(metadataJSON); err != nil {
+		return "", err
+	}
+	filePart, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {"application/vnd.ant.fit"}})
+	if err != nil {
+		return "", err
+	}
+	if _, err := filePart.Write(fileData); err != nil {
+		return "", err
+	}
+	if err := mw.Close(); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", u.driveUploadURL+"/files?uploadType=multipart&fields=id,webViewLink", &body)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "multipart/related; boundary="+mw.Boundary())
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("Google Drive API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return "", httputil.WrapResponseError(resp, "Google Drive upload error")
+	}
+
+	var respBody struct {
+		ID          string `json:"id"`
+		WebViewLink string `json:"webViewLink"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return "", fmt.Errorf("failed to decode Google Drive response: %w", err)
+	}
+	if respBody.WebViewLink == "" && respBody.ID != "" {
+		return "https://drive.google.com/file/d/" + respBody.ID + "/view", nil
+	}
+	return respBody.WebViewLink, nil
+}
+
+// driveFileName names the FIT file after the activity's date and title, e.g.
+// "2026-02-08 Morning Run.fit".
+func driveFileName(payload *pbevents.ActivityPayload) string {
+	name := strings.TrimSpace(payload.Metadata["activity_name"])
+	if name == "" {
+		name = payload.GetActivityId()
+	}
+	if name == "" {
+		name = "activity"
+	}
+	name = strings.NewReplacer("/", "-", "\\", "-").Replace(name)
+	if start := payload.StandardizedActivity.GetStartTime(); start != nil {
+		name = start.AsTime().Format("2006-01-02") + " " + name
+	}
+	return name + ".fit"
+}
+
+// writeRow overwrites a whole row, skipping nil cells.
+func (u *Uploader) writeRow(ctx context.Context, httpClient *http.Client, spreadsheetID, sheetName string, rowNumber int, row []interface{}) error {
+	bodyJSON, err := json.Marshal(map[string]interface{}{
+		"values": [][]interface{}{row},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	rng := fmt.Sprintf("A%d:%s%d", rowNumber, lastColumn, rowNumber)
+	url := fmt.Sprintf("%s/spreadsheets/%s/values/%s?valueInputOption=USER_ENTERED", u.sheetsURL, spreadsheetID, a1Range(sheetName, rng))
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewReader(bodyJSON))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("Google Sheets API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return httputil.WrapResponseError(resp, "Google Sheets API error")
+	}
+	return nil
+}
+
+// a1Range builds an escaped A1 range on a sheet, quoting the sheet name so
+// names with spaces work.
+func a1Range(sheetName, rng string) string {
+	return url.PathEscape("'" + strings.ReplaceAll(sheetName, "'", "''") + "'!" + rng)
+}
+
 func (u *Uploader) appendToSheet(ctx context.Context, httpClient *http.Client, spreadsheetID, sheetName string, row []interface{}, logger *slog.Logger) (int, error) {
 	payload := map[string]interface{}{
 		"values": [][]interface{}{row},
@@ -302,7 +561,7 @@ func (u *Uploader) appendToSheet(ctx context.Context, httpClient *http.Client, s
 		return 0, fmt.Errorf("failed to marshal payload: %w", err)
 	}
 
-	url := fmt.Sprintf("https://sheets.googleapis.com/v4/spreadsheets/%s/values/%s:append?valueInputOption=USER_ENTERED", spreadsheetID, sheetName)
+	url := fmt.Sprintf("%s/spreadsheets/%s/values/%s:append?valueInputOption=USER_ENTERED", u.sheetsURL, spreadsheetID, a1Range(sheetName, "A1"))
 	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(bodyJSON))
 	if err != nil {
 		return 0, fmt.Errorf("failed to create request: %w", err)
@@ -333,19 +592,18 @@ func (u *Uploader) appendToSheet(ctx context.Context, httpClient *http.Client, s
 		return 0, fmt.Errorf("failed to decode Google Sheets response: %w", err)
 	}
 
-	rowNumber := 0
-	if respBody.Updates.UpdatedRange != "" {
-		parts := strings.Split(respBody.Updates.UpdatedRange, "!")
-		if len(parts) == 2 {
-			rangePart := parts[1]
-			if idx := strings.Index(rangePart, ":"); idx > 0 {
-				rowStr := rangePart[1:idx]
-				var dummyCol string
-				// e.g. "A2"
-				fmt.Sscanf(rowStr, "%[A-Z]%d", &dummyCol, &rowNumber)
-			}
-		}
-	}
+	return parseUpdatedRow(respBody.Updates.UpdatedRange), nil
+}
+
+var updatedRowPattern = regexp.MustCompile(`![A-Z]+(\d+)`)
 
-	return rowNumber, nil
+// parseUpdatedRow returns the first row of an updated range such as
+// "Activities!A12:P12", or 0 if there isn't one.
+func parseUpdatedRow(updatedRange string) int {
+	m := updatedRowPattern.FindStringSubmatch(updatedRange)
+	if m == nil {
+		return 0
+	}
+	rowNumber, _ := strconv.Atoi(m[1])
+	return rowNumber
 }
@@ -1,13 +1,106 @@
 package googlesheets
 
 import (
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestGoogleSheetsUploader_Name(t *testing.T) {
 	u := New(&bootstrap.Service{})
 	assert.Equal(t, "googlesheets", u.Name())
 }
+
+func TestParseUpdatedRow(t *testing.T) {
+	assert.Equal(t, 12, parseUpdatedRow("Activities!A12:P12"))
+	assert.Equal(t, 3, parseUpdatedRow("'My Log'!A3:P3"))
+	assert.Equal(t, 0, parseUpdatedRow(""))
+}
+
+func TestBuildSheetRow(t *testing.T) {
+	u := New(&bootstrap.Service{})
+	payload := &pbevents.ActivityPayload{
+		Source: pbactivity.ActivitySource_SOURCE_STRAVA,
+		Metadata: map[string]string{
+			"activity_name": "Morning Run",
+			"activity_type": "ACTIVITY_TYPE_TRAIL_RUN",
+			"pr_status":     "pr_detected",
+			"pr_count":      "2",
+		},
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			ExternalId: "123",
+			StartTime:  timestamppb.New(time.Date(2026, 2, 8, 7, 30, 0, 0, time.UTC)),
+			Sessions:   []*pbactivity.Session{{TotalElapsedTime: 3725, TotalDistance: 10500}},
+		},
+	}
+
+	row := u.buildSheetRow(payload, true, u.activityLink(context.Background(), payload, false), "https://drive.google.com/file/d/abc/view")
+	require.Len(t, row, len(u.getHeaderRow()))
+	assert.Equal(t, "2026-02-08", row[1])
+	assert.Equal(t, "TRAIL RUN", row[3])
+	assert.Equal(t, "01:02:05", row[5])
+	assert.Equal(t, "10.50", row[6])
+	assert.Equal(t, "2", row[13])
+	assert.Equal(t, "https://www.strava.com/activities/123", row[14])
+	assert.Equal(t, "https://drive.google.com/file/d/abc/view", row[15])
+}
+
+func TestUploadFitToDrive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/files", r.URL.Path)
+		mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/related", mediaType)
+
+		mr := multipart.NewReader(r.Body, params["boundary"])
+		metaPart, err := mr.NextPart()
+		require.NoError(t, err)
+		var meta map[string]interface{}
+		require.NoError(t, json.NewDecoder(metaPart).Decode(&meta))
+		assert.Equal(t, "2026-02-08 Morning Run.fit", meta["name"])
+		assert.Equal(t, []interface{}{"folder-1"}, meta["parents"])
+
+		filePart, err := mr.NextPart()
+		require.NoError(t, err)
+		data, _ := io.ReadAll(filePart)
+		assert.Equal(t, "FITDATA", string(data))
+
+		_, _ = w.Write([]byte(`{"id":"abc","webViewLink":"https://drive.google.com/file/d/abc/view"}`))
+	}))
+	defer server.Close()
+
+	u := New(&bootstrap.Service{
+		Store: &mocks.MockBlobStore{
+			GetFunc: func(ctx context.Context, bucket, object string) ([]byte, error) {
+				assert.Equal(t, "run.fit", object)
+				return []byte("FITDATA"), nil
+			},
+		},
+		Config: &bootstrap.Config{GCSArtifactBucket: "artifacts"},
+	})
+	u.driveUploadURL = server.URL
+
+	payload := &pbevents.ActivityPayload{
+		Metadata: map[string]string{"activity_name": "Morning Run", "fit_file_uri": "gs://artifacts/run.fit"},
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			StartTime: timestamppb.New(time.Date(2026, 2, 8, 7, 30, 0, 0, time.UTC)),
+		},
+	}
+	link, err := u.uploadFitToDrive(context.Background(), server.Client(), "folder-1", payload)
+	require.NoError(t, err)
+	assert.Equal(t, "https://drive.google.com/file/d/abc/view", link)
+}
@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
-	"strings"
 	"time"
 
 	hevyapi "github.com/fitglue/server/src/go/pkg/api/hevy"
@@ -42,12 +41,27 @@ func (u *Uploader) Name() string {
 	return "hevy"
 }
 
+// apiKey returns the user's Hevy API key from the integration secret store.
+func (u *Uploader) apiKey(ctx context.Context, userID string, userRec *user.Record) (string, error) {
+	if userRec.Integrations == nil || userRec.Integrations.Hevy == nil {
+		return "", fmt.Errorf("user has no Hevy API key configured")
+	}
+	apiKey, err := u.svc.DB.GetIntegrationSecret(ctx, userID, "hevy")
+	if err != nil {
+		return "", fmt.Errorf("failed to get Hevy API key: %w", err)
+	}
+	if apiKey == "" {
+		return "", fmt.Errorf("user has no Hevy API key configured")
+	}
+	return apiKey, nil
+}
+
 // Create uploads a new activity to Hevy.
 func (u *Uploader) Create(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record) (string, error) {
-	if userRec.Integrations == nil || userRec.Integrations.Hevy == nil || userRec.Integrations.Hevy.ApiKey == "" {
-		return "", fmt.Errorf("user has no Hevy API key configured")
+	apiKey, err := u.apiKey(ctx, payload.UserId, userRec)
+	if err != nil {
+		return "", err
 	}
-	apiKey := userRec.Integrations.Hevy.ApiKey
 	logger := slog.Default()
 
 	isPrivate := false
@@ -87,10 +101,10 @@ func (u *Uploader) Create(ctx context.Context, payload *pbevents.ActivityPayload
 
 // Update modifies an existing Hevy activity.
 func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record, pipelineRun *pbpipeline.PipelineRun) error {
-	if userRec.Integrations == nil || userRec.Integrations.Hevy == nil || userRec.Integrations.Hevy.ApiKey == "" {
-		return fmt.Errorf("user has no Hevy API key configured")
+	apiKey, err := u.apiKey(ctx, payload.UserId, userRec)
+	if err != nil {
+		return err
 	}
-	apiKey := userRec.Integrations.Hevy.ApiKey
 	logger := slog.Default()
 
 	isSameSource := false
@@ -172,33 +186,16 @@ func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload
 	payloadName := payload.Metadata["activity_name"]
 	payloadDesc := payload.Metadata["description"]
 
-	var mergedDescription string
-	var mergedTitle string
+	mergedTitle := existingTitle
 	if isSameSource {
-		mergedDescription = payloadDesc
 		mergedTitle = payloadName
-	} else {
-		mergedDescription = existingDesc
-		mergedTitle = existingTitle
-		if payloadDesc != "" {
-			sectionHeader := ""
-			for key, val := range payload.Metadata {
-				if strings.HasPrefix(key, "section_header_") {
-					sectionHeader = val
-					break
-				}
-			}
-
-			if sectionHeader != "" && description.HasSection(mergedDescription, sectionHeader) {
-				newSectionContent := description.ExtractSection(payloadDesc, sectionHeader)
-				if newSectionContent != "" {
-					mergedDescription = description.ReplaceSection(mergedDescription, sectionHeader, newSectionContent)
-				}
-			} else if mergedDescription != "" {
-				mergedDescription += "\n\n" + payloadDesc
-			} else {
-				mergedDescription = payloadDesc
-			}
+	}
+	mergedDescription := description.Merge(existingDesc, payloadDesc, description.SectionHeader(payload.Metadata), isSameSource)
+	if isSameSource && payload.Metadata["confirm_description_overwrite"] != "true" {
+		// The user edited the description in Hevy; don't overwrite it unconfirmed
+		if dropped := description.DroppedUserLines(existingDesc, mergedDescription); len(dropped) > 0 {
+			logger.Info("Keeping user-edited Hevy description", "workout_id", workoutID, "dropped_lines", len(dropped))
+			mergedDescription = existingDesc
 		}
 	}
 
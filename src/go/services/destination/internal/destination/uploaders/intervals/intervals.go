@@ -9,6 +9,7 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -22,23 +23,28 @@ import (
 	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
 	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
 	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
-	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
 	baseURL = "https://intervals.icu/api/v1"
+
+	// matchWindow is how far apart start times may be for an activity already
+	// on Intervals (e.g. synced from the device) to count as this one.
+	matchWindow = 2 * time.Minute
 )
 
 // Uploader implements destination.Destination for Intervals.icu
 type Uploader struct {
-	svc *bootstrap.Service
+	svc     *bootstrap.Service
+	baseURL string
 }
 
 // New returns a new Intervals Uploader initialized with dependencies.
 func New(svc *bootstrap.Service) *Uploader {
 	return &Uploader{
-		svc: svc,
+		svc:     svc,
+		baseURL: baseURL,
 	}
 }
 
@@ -47,19 +53,58 @@ func (u *Uploader) Name() string {
 	return "intervals"
 }
 
-// Create uploads a new activity to Intervals.
-func (u *Uploader) Create(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record) (string, error) {
+// client returns an API client for the user's Intervals account.
+func (u *Uploader) client(ctx context.Context, userID string, userRec *user.Record) (*apiClient, error) {
 	if userRec.Integrations == nil || userRec.Integrations.Intervals == nil || !userRec.Integrations.Intervals.Enabled {
-		return "", fmt.Errorf("user has no Intervals integration configured")
+		return nil, fmt.Errorf("user has no Intervals integration configured")
 	}
-
 	integration := userRec.Integrations.Intervals
-	if integration.ApiKey == "" || integration.AthleteId == "" {
-		return "", fmt.Errorf("Intervals credentials incomplete: missing API key or athlete ID")
+
+	apiKey, err := u.svc.DB.GetIntegrationSecret(ctx, userID, "intervals")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Intervals API key: %w", err)
+	}
+	if apiKey == "" || integration.AthleteId == "" {
+		return nil, fmt.Errorf("Intervals credentials incomplete: missing API key or athlete ID")
 	}
 
+	return &apiClient{
+		httpClient: &http.Client{Timeout: 30 * time.Second, Transport: &httputil.CallRecordingTransport{}},
+		baseURL:    u.baseURL,
+		athleteID:  integration.AthleteId,
+		apiKey:     apiKey,
+	}, nil
+}
+
+// Create uploads a new activity to Intervals. An activity that's already
+// there - because Intervals is the source, or the device synced it directly -
+// is updated with the enriched name and description instead of duplicated.
+func (u *Uploader) Create(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record) (string, error) {
+	c, err := u.client(ctx, payload.UserId, userRec)
+	if err != nil {
+		return "", err
+	}
 	logger := slog.Default()
-	httpClient := &http.Client{Timeout: 30 * time.Second}
+
+	if isSameSource(payload) {
+		if activityID := payload.StandardizedActivity.GetExternalId(); activityID != "" {
+			if err := u.overwrite(ctx, c, activityID, payload, true); err != nil {
+				return "", fmt.Errorf("failed to update source Intervals activity: %w", err)
+			}
+			return activityID, nil
+		}
+	}
+
+	if match, err := u.findMatchingActivity(ctx, c, payload); err != nil {
+		logger.Warn("Failed to look up existing Intervals activities", "error", err)
+	} else if match != "" {
+		logger.Info("Matched existing Intervals activity, updating instead of uploading", "activity_id", match)
+		if err := u.overwrite(ctx, c, match, payload, false); err != nil {
+			return "", fmt.Errorf("failed to update matched Intervals activity: %w", err)
+		}
+		u.recordUpload(ctx, payload, match)
+		return match, nil
+	}
 
 	fitFileUri := ""
 	if uri, ok := payload.Metadata["fit_file_uri"]; ok {
@@ -80,230 +125,246 @@ func (u *Uploader) Create(ctx context.Context, payload *pbevents.ActivityPayload
 		return "", fmt.Errorf("GCS Read Error: %w", err)
 	}
 
-	uploadURL := fmt.Sprintf("%s/athlete/%s/activities", baseURL, integration.AthleteId)
-	req, err := http.NewRequestWithContext(ctx, "POST", uploadURL, bytes.NewBuffer(fileData))
-	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
+	var uploadResp intervalsActivity
+	if err := c.do(ctx, "POST", c.athletePath("/activities"), "application/octet-stream", fileData, &uploadResp); err != nil {
+		return "", fmt.Errorf("Intervals upload failed: %w", err)
 	}
-
-	req.SetBasicAuth(integration.ApiKey, "")
-	req.Header.Set("Content-Type", "application/octet-stream")
-
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("Intervals API Error: %w", err)
+	activityID := string(uploadResp.ID)
+	if activityID == "" {
+		return "", fmt.Errorf("no activity ID in Intervals response")
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode >= 400 {
-		return "", httputil.WrapResponseError(resp, "Intervals upload failed")
+	updateBody := map[string]interface{}{}
+	if name := payload.Metadata["activity_name"]; name != "" {
+		updateBody["name"] = name
 	}
-
-	var uploadResp intervalsActivityResponse
-	if err := json.NewDecoder(resp.Body).Decode(&uploadResp); err != nil {
-		return "", fmt.Errorf("failed to decode response: %w", err)
+	if desc := payload.Metadata["description"]; desc != "" {
+		updateBody["description"] = desc
 	}
-
-	activityName := payload.Metadata["activity_name"]
-	descriptionText := payload.Metadata["description"]
-
-	if activityName != "" || descriptionText != "" {
-		updateResp, err := u.updateIntervalsActivity(ctx, httpClient, integration, uploadResp.ID, payload, logger)
-		if err != nil {
-			logger.Warn("Failed to update activity metadata", "error", err)
-		} else {
-			uploadResp = *updateResp
-		}
+	if activityType := parseActivityType(payload.Metadata["activity_type"]); activityType != pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED {
+		updateBody["type"] = activity.GetIntervalsActivityType(activityType)
 	}
-
-	intervalsDestID := fmt.Sprintf("%d", uploadResp.ID)
-	if intervalsDestID != "" {
-		uploadRecord := &pbactivity.UploadedActivityRecord{
-			Id:            loopprevention.BuildUploadedActivityID(pbplugin.DestinationType_DESTINATION_INTERVALS, intervalsDestID),
-			UserId:        payload.UserId,
-			Source:        payload.Source,
-			ExternalId:    payload.StandardizedActivity.GetExternalId(),
-			StartTime:     payload.Timestamp,
-			Destination:   pbplugin.DestinationType_DESTINATION_INTERVALS,
-			DestinationId: intervalsDestID,
-			UploadedAt:    timestamppb.Now(),
+	if len(updateBody) > 0 {
+		if err := c.putActivity(ctx, activityID, updateBody); err != nil {
+			logger.Warn("Failed to update activity metadata", "activity_id", activityID, "error", err)
 		}
-		_ = u.svc.DB.SetUploadedActivity(ctx, payload.UserId, uploadRecord)
 	}
 
-	_ = u.svc.DB.IncrementSyncCount(ctx, payload.UserId)
+	u.recordUpload(ctx, payload, activityID)
 
-	return intervalsDestID, nil
+	return activityID, nil
 }
 
 // Update modifies an existing Intervals activity.
 func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record, pipelineRun *pbpipeline.PipelineRun) error {
-	if userRec.Integrations == nil || userRec.Integrations.Intervals == nil || !userRec.Integrations.Intervals.Enabled {
-		return fmt.Errorf("user has no Intervals integration configured")
-	}
-
-	integration := userRec.Integrations.Intervals
-	if integration.ApiKey == "" || integration.AthleteId == "" {
-		return fmt.Errorf("Intervals credentials incomplete: missing API key or athlete ID")
+	c, err := u.client(ctx, payload.UserId, userRec)
+	if err != nil {
+		return err
 	}
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
+	sameSource := isSameSource(payload)
 
-	var intervalsIDStr string
+	var activityID string
 	if pipelineRun != nil {
 		for _, dest := range pipelineRun.Destinations {
 			if dest.Destination == pbplugin.DestinationType_DESTINATION_INTERVALS && dest.ExternalId != nil && *dest.ExternalId != "" {
-				intervalsIDStr = *dest.ExternalId
+				activityID = *dest.ExternalId
 				break
 			}
 		}
 	}
-	if intervalsIDStr == "" {
-		return fmt.Errorf("no Intervals destination found in pipeline run")
-	}
-
-	getURL := fmt.Sprintf("%s/athlete/%s/activities/%s", baseURL, integration.AthleteId, intervalsIDStr)
-	getReq, err := http.NewRequestWithContext(ctx, "GET", getURL, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create GET request: %w", err)
+	if activityID == "" && sameSource {
+		activityID = payload.StandardizedActivity.GetExternalId()
 	}
-	getReq.SetBasicAuth(integration.ApiKey, "")
-
-	getResp, err := httpClient.Do(getReq)
-	if err != nil {
-		return fmt.Errorf("failed to GET existing activity: %w", err)
+	if activityID == "" {
+		return fmt.Errorf("activity_not_found")
 	}
-	defer getResp.Body.Close()
 
-	if getResp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(getResp.Body)
-		return fmt.Errorf("GET activity failed: status %d, body: %s", getResp.StatusCode, string(bodyBytes))
+	if err := u.overwrite(ctx, c, activityID, payload, sameSource); err != nil {
+		return err
 	}
 
-	var existingActivity struct {
-		ID          int64  `json:"id"`
-		Name        string `json:"name"`
-		Description string `json:"description"`
-	}
-	if err := json.NewDecoder(getResp.Body).Decode(&existingActivity); err != nil {
-		return fmt.Errorf("failed to decode existing activity: %w", err)
+	if !sameSource {
+		_ = u.svc.DB.IncrementSyncCount(ctx, payload.UserId)
 	}
 
-	mergedDescription := existingActivity.Description
-	payloadDesc := payload.Metadata["description"]
+	return nil
+}
 
-	if payloadDesc != "" {
-		sectionHeader := ""
-		for key, val := range payload.Metadata {
-			if strings.HasPrefix(key, "section_header_") {
-				sectionHeader = val
-				break
-			}
-		}
+// overwrite merges the payload's name and description into an activity that
+// already exists on Intervals. With sameSource the description is replaced
+// outright (unless that would drop lines the user wrote and the overwrite
+// wasn't confirmed) and the name is overwritten; otherwise FitGlue's section
+// is merged into the existing description and the name is left alone.
+func (u *Uploader) overwrite(ctx context.Context, c *apiClient, activityID string, payload *pbevents.ActivityPayload, sameSource bool) error {
+	var existing intervalsActivity
+	if err := c.do(ctx, "GET", c.athletePath("/activities/"+url.PathEscape(activityID)), "", nil, &existing); err != nil {
+		return fmt.Errorf("failed to GET existing activity: %w", err)
+	}
 
-		if sectionHeader != "" && description.HasSection(mergedDescription, sectionHeader) {
-			newSectionContent := description.ExtractSection(payloadDesc, sectionHeader)
-			if newSectionContent != "" {
-				mergedDescription = description.ReplaceSection(mergedDescription, sectionHeader, newSectionContent)
-			}
-		} else if mergedDescription != "" {
-			mergedDescription += "\n\n" + payloadDesc
-		} else {
-			mergedDescription = payloadDesc
+	activityName := payload.Metadata["activity_name"]
+	mergedDescription := description.Merge(existing.Description, payload.Metadata["description"], description.SectionHeader(payload.Metadata), sameSource)
+	if sameSource && payload.Metadata["confirm_description_overwrite"] != "true" {
+		// The user edited the description on Intervals; don't overwrite it unconfirmed
+		if dropped := description.DroppedUserLines(existing.Description, mergedDescription); len(dropped) > 0 {
+			slog.Default().Info("Keeping user-edited Intervals description", "activity_id", activityID, "dropped_lines", len(dropped))
+			mergedDescription = existing.Description
 		}
 	}
 
 	updateBody := map[string]interface{}{}
-	if mergedDescription != existingActivity.Description {
+	if sameSource && activityName != "" && activityName != existing.Name {
+		updateBody["name"] = activityName
+	}
+	if mergedDescription != existing.Description {
 		updateBody["description"] = mergedDescription
 	}
 
 	if len(updateBody) == 0 {
 		return nil
 	}
+	return c.putActivity(ctx, activityID, updateBody)
+}
 
-	bodyJSON, err := json.Marshal(updateBody)
-	if err != nil {
-		return fmt.Errorf("failed to marshal update body: %w", err)
+// findMatchingActivity returns the ID of an Intervals activity starting
+// within matchWindow of the payload's activity, if there is one.
+func (u *Uploader) findMatchingActivity(ctx context.Context, c *apiClient, payload *pbevents.ActivityPayload) (string, error) {
+	startTime := payload.StandardizedActivity.GetStartTime()
+	if startTime == nil {
+		return "", nil
 	}
+	start := startTime.AsTime()
 
-	putURL := fmt.Sprintf("%s/athlete/%s/activities/%s", baseURL, integration.AthleteId, intervalsIDStr)
-	putReq, err := http.NewRequestWithContext(ctx, "PUT", putURL, bytes.NewReader(bodyJSON))
-	if err != nil {
-		return fmt.Errorf("failed to create PUT request: %w", err)
+	params := url.Values{}
+	params.Set("oldest", start.AddDate(0, 0, -1).Format("2006-01-02"))
+	params.Set("newest", start.AddDate(0, 0, 1).Format("2006-01-02"))
+
+	var activities []intervalsActivity
+	if err := c.do(ctx, "GET", c.athletePath("/activities?"+params.Encode()), "", nil, &activities); err != nil {
+		return "", err
 	}
-	putReq.SetBasicAuth(integration.ApiKey, "")
-	putReq.Header.Set("Content-Type", "application/json")
 
-	putResp, err := httpClient.Do(putReq)
-	if err != nil {
-		return fmt.Errorf("failed to PUT activity: %w", err)
+	for _, a := range activities {
+		if a.ID == "" || a.StartDate == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, a.StartDate)
+		if err != nil {
+			continue
+		}
+		if d := t.Sub(start); d > -matchWindow && d < matchWindow {
+			return string(a.ID), nil
+		}
 	}
-	defer putResp.Body.Close()
+	return "", nil
+}
 
-	if putResp.StatusCode >= 400 {
-		return httputil.WrapResponseError(putResp, "Intervals PUT failed")
+// recordUpload records the upload for loop prevention and counts the sync.
+func (u *Uploader) recordUpload(ctx context.Context, payload *pbevents.ActivityPayload, activityID string) {
+	uploadRecord := &pbactivity.UploadedActivityRecord{
+		Id:            loopprevention.BuildUploadedActivityID(pbplugin.DestinationType_DESTINATION_INTERVALS, activityID),
+		UserId:        payload.UserId,
+		Source:        payload.Source,
+		ExternalId:    payload.StandardizedActivity.GetExternalId(),
+		StartTime:     payload.Timestamp,
+		Destination:   pbplugin.DestinationType_DESTINATION_INTERVALS,
+		DestinationId: activityID,
+		UploadedAt:    timestamppb.Now(),
 	}
+	_ = u.svc.DB.SetUploadedActivity(ctx, payload.UserId, uploadRecord)
 
 	_ = u.svc.DB.IncrementSyncCount(ctx, payload.UserId)
+}
 
-	return nil
+func isSameSource(payload *pbevents.ActivityPayload) bool {
+	return payload.Metadata["same_source_destination_intervals"] == "true"
 }
 
-func (u *Uploader) updateIntervalsActivity(ctx context.Context, httpClient *http.Client, integration *pbuser.IntervalsIntegration, activityID int64, payload *pbevents.ActivityPayload, logger *slog.Logger) (*intervalsActivityResponse, error) {
-	updateBody := map[string]interface{}{}
-	if name, ok := payload.Metadata["activity_name"]; ok && name != "" {
-		updateBody["name"] = name
-	}
-	if desc, ok := payload.Metadata["description"]; ok && desc != "" {
-		updateBody["description"] = desc
+func parseActivityType(s string) pbactivity.ActivityType {
+	if v, ok := pbactivity.ActivityType_value[s]; ok {
+		return pbactivity.ActivityType(v)
 	}
+	return pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED
+}
 
-	activityTypeVal, ok := pbactivity.ActivityType_value[payload.Metadata["activity_type"]]
-	activityType := pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED
-	if ok {
-		activityType = pbactivity.ActivityType(activityTypeVal)
-	}
+// apiClient makes authenticated requests for one Intervals athlete.
+type apiClient struct {
+	httpClient *http.Client
+	baseURL    string
+	athleteID  string
+	apiKey     string
+}
 
-	if activityType != pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED {
-		updateBody["type"] = activity.GetIntervalsActivityType(activityType)
-	}
+func (c *apiClient) athletePath(path string) string {
+	return "/athlete/" + url.PathEscape(c.athleteID) + path
+}
 
-	bodyJSON, err := json.Marshal(updateBody)
+func (c *apiClient) putActivity(ctx context.Context, activityID string, body map[string]interface{}) error {
+	bodyJSON, err := json.Marshal(body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal update body: %w", err)
+		return fmt.Errorf("failed to marshal update body: %w", err)
+	}
+	if err := c.do(ctx, "PUT", c.athletePath("/activities/"+url.PathEscape(activityID)), "application/json", bodyJSON, nil); err != nil {
+		return fmt.Errorf("Intervals PUT failed: %w", err)
 	}
+	return nil
+}
 
-	putURL := fmt.Sprintf("%s/athlete/%s/activities/%d", baseURL, integration.AthleteId, activityID)
-	putReq, err := http.NewRequestWithContext(ctx, "PUT", putURL, bytes.NewReader(bodyJSON))
+// do sends a request and decodes a JSON response into out, if given.
+func (c *apiClient) do(ctx context.Context, method, path, contentType string, body []byte, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create PUT request: %w", err)
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.SetBasicAuth(c.apiKey, "")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
 	}
-	putReq.SetBasicAuth(integration.ApiKey, "")
-	putReq.Header.Set("Content-Type", "application/json")
 
-	putResp, err := httpClient.Do(putReq)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to PUT activity: %w", err)
+		return fmt.Errorf("Intervals API Error: %w", err)
 	}
-	defer putResp.Body.Close()
+	defer resp.Body.Close()
 
-	if putResp.StatusCode >= 400 {
-		return nil, httputil.WrapResponseError(putResp, "Intervals PUT failed")
+	if resp.StatusCode >= 400 {
+		return httputil.WrapResponseError(resp, fmt.Sprintf("Intervals %s failed", method))
 	}
-
-	var updatedActivity intervalsActivityResponse
-	if err := json.NewDecoder(putResp.Body).Decode(&updatedActivity); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
 	}
+	return nil
+}
+
+// activityID is an Intervals activity ID. The API returns IDs of uploaded
+// activities as strings (e.g. "i12345"), but older responses used numbers.
+type activityID string
 
-	return &updatedActivity, nil
+func (id *activityID) UnmarshalJSON(data []byte) error {
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err == nil {
+		*id = activityID(n.String())
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid Intervals activity ID %s", data)
+	}
+	*id = activityID(s)
+	return nil
 }
 
-type intervalsActivityResponse struct {
-	ID             int64  `json:"id"`
-	Name           string `json:"name"`
-	Description    string `json:"description"`
-	Type           string `json:"type"`
-	StartDateLocal string `json:"start_date_local"`
+type intervalsActivity struct {
+	ID          activityID `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Type        string     `json:"type"`
+	StartDate   string     `json:"start_date"`
 }
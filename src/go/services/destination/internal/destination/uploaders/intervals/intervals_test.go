@@ -1,13 +1,212 @@
 package intervals
 
 import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestIntervalsUploader_Name(t *testing.T) {
 	u := New(&bootstrap.Service{})
 	assert.Equal(t, "intervals", u.Name())
 }
+
+// fakeIntervals is a minimal Intervals.icu API holding one athlete's activities.
+type fakeIntervals struct {
+	mu         sync.Mutex
+	activities map[string]map[string]interface{}
+	uploads    int
+	puts       []map[string]interface{}
+}
+
+func (f *fakeIntervals) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		key, _, _ := r.BasicAuth()
+		assert.Equal(t, "secret", key)
+
+		const prefix = "/athlete/i42/activities"
+		switch {
+		case r.Method == "GET" && r.URL.Path == prefix:
+			list := []map[string]interface{}{}
+			for _, a := range f.activities {
+				list = append(list, a)
+			}
+			_ = json.NewEncoder(w).Encode(list)
+		case r.Method == "POST" && r.URL.Path == prefix:
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(t, "FITDATA", string(body))
+			f.uploads++
+			f.activities["i900"] = map[string]interface{}{"id": "i900", "start_date": "2026-06-01T07:00:00Z"}
+			_ = json.NewEncoder(w).Encode(f.activities["i900"])
+		case len(r.URL.Path) > len(prefix)+1 && r.URL.Path[:len(prefix)+1] == prefix+"/":
+			id := r.URL.Path[len(prefix)+1:]
+			a, ok := f.activities[id]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if r.Method == "PUT" {
+				var update map[string]interface{}
+				_ = json.NewDecoder(r.Body).Decode(&update)
+				f.puts = append(f.puts, update)
+				for k, v := range update {
+					a[k] = v
+				}
+			}
+			_ = json.NewEncoder(w).Encode(a)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func newTestUploader(t *testing.T, fake *fakeIntervals) *Uploader {
+	server := httptest.NewServer(fake.handler(t))
+	t.Cleanup(server.Close)
+
+	svc := &bootstrap.Service{
+		DB: &mocks.MockDatabase{
+			GetIntegrationSecretFunc: func(ctx context.Context, userId, provider string) (string, error) {
+				return "secret", nil
+			},
+		},
+		Store: &mocks.MockBlobStore{
+			GetFunc: func(ctx context.Context, bucket, object string) ([]byte, error) {
+				return []byte("FITDATA"), nil
+			},
+		},
+		Config: &bootstrap.Config{GCSArtifactBucket: "artifacts"},
+	}
+	u := New(svc)
+	u.baseURL = server.URL
+	return u
+}
+
+func testUser() *user.Record {
+	return &user.Record{Integrations: &pbuser.UserIntegrations{
+		Intervals: &pbuser.IntervalsIntegration{Enabled: true, AthleteId: "i42"},
+	}}
+}
+
+func testPayload(metadata map[string]string) *pbevents.ActivityPayload {
+	md := map[string]string{
+		"fit_file_uri":  "gs://artifacts/run.fit",
+		"activity_name": "Morning Run",
+		"description":   "Enriched by FitGlue",
+	}
+	for k, v := range metadata {
+		md[k] = v
+	}
+	return &pbevents.ActivityPayload{
+		UserId:   "user-1",
+		Metadata: md,
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			ExternalId: "src-1",
+			StartTime:  timestamppb.New(time.Date(2026, 6, 1, 7, 0, 0, 0, time.UTC)),
+		},
+	}
+}
+
+func TestIntervalsUploader_CreateUploadsFIT(t *testing.T) {
+	fake := &fakeIntervals{activities: map[string]map[string]interface{}{
+		"i1": {"id": "i1", "start_date": "2026-06-01T12:00:00Z"},
+	}}
+	u := newTestUploader(t, fake)
+
+	id, err := u.Create(context.Background(), testPayload(nil), testUser())
+	require.NoError(t, err)
+	assert.Equal(t, "i900", id)
+	assert.Equal(t, 1, fake.uploads)
+	require.Len(t, fake.puts, 1)
+	assert.Equal(t, "Morning Run", fake.puts[0]["name"])
+	assert.Equal(t, "Enriched by FitGlue", fake.puts[0]["description"])
+}
+
+func TestIntervalsUploader_CreateMatchesExisting(t *testing.T) {
+	fake := &fakeIntervals{activities: map[string]map[string]interface{}{
+		"i7": {"id": "i7", "name": "Device Run", "description": "My notes", "start_date": "2026-06-01T07:00:30Z"},
+	}}
+	u := newTestUploader(t, fake)
+
+	id, err := u.Create(context.Background(), testPayload(nil), testUser())
+	require.NoError(t, err)
+	assert.Equal(t, "i7", id)
+	assert.Equal(t, 0, fake.uploads, "a matched activity must not be uploaded again")
+	assert.Equal(t, "Device Run", fake.activities["i7"]["name"], "name is only overwritten for the same source")
+	assert.Contains(t, fake.activities["i7"]["description"], "My notes")
+	assert.Contains(t, fake.activities["i7"]["description"], "Enriched by FitGlue")
+}
+
+func TestIntervalsUploader_CreateSameSource(t *testing.T) {
+	fake := &fakeIntervals{activities: map[string]map[string]interface{}{
+		"src-1": {"id": "src-1", "name": "Afternoon Ride", "description": ""},
+	}}
+	u := newTestUploader(t, fake)
+
+	id, err := u.Create(context.Background(), testPayload(map[string]string{"same_source_destination_intervals": "true"}), testUser())
+	require.NoError(t, err)
+	assert.Equal(t, "src-1", id)
+	assert.Equal(t, 0, fake.uploads)
+	assert.Equal(t, "Morning Run", fake.activities["src-1"]["name"])
+	assert.Equal(t, "Enriched by FitGlue", fake.activities["src-1"]["description"])
+}
+
+func TestIntervalsUploader_UpdateSameSourceKeepsUserEdits(t *testing.T) {
+	fake := &fakeIntervals{activities: map[string]map[string]interface{}{
+		"src-1": {"id": "src-1", "name": "Morning Run", "description": "Legs felt heavy"},
+	}}
+	u := newTestUploader(t, fake)
+	payload := testPayload(map[string]string{"same_source_destination_intervals": "true"})
+
+	require.NoError(t, u.Update(context.Background(), payload, testUser(), nil))
+	assert.Empty(t, fake.puts, "user-written lines must not be overwritten unconfirmed")
+
+	payload.Metadata["confirm_description_overwrite"] = "true"
+	require.NoError(t, u.Update(context.Background(), payload, testUser(), nil))
+	assert.Equal(t, "Enriched by FitGlue", fake.activities["src-1"]["description"])
+}
+
+func TestIntervalsUploader_UpdateUsesRunOutcome(t *testing.T) {
+	fake := &fakeIntervals{activities: map[string]map[string]interface{}{
+		"i5": {"id": "i5", "name": "Morning Run", "description": "Old"},
+	}}
+	u := newTestUploader(t, fake)
+
+	err := u.Update(context.Background(), testPayload(nil), testUser(), &pbpipeline.PipelineRun{})
+	assert.EqualError(t, err, "activity_not_found")
+
+	id := "i5"
+	run := &pbpipeline.PipelineRun{Destinations: []*pbpipeline.DestinationOutcome{
+		{Destination: pbplugin.DestinationType_DESTINATION_INTERVALS, ExternalId: &id},
+	}}
+	require.NoError(t, u.Update(context.Background(), testPayload(nil), testUser(), run))
+	assert.Contains(t, fake.activities["i5"]["description"], "Enriched by FitGlue")
+}
+
+func TestActivityID_Unmarshal(t *testing.T) {
+	var a intervalsActivity
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"i123"}`), &a))
+	assert.Equal(t, activityID("i123"), a.ID)
+	require.NoError(t, json.Unmarshal([]byte(`{"id":456}`), &a))
+	assert.Equal(t, activityID("456"), a.ID)
+}
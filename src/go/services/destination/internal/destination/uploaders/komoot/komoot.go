@@ -0,0 +1,273 @@
+// nolint:proto-json
+package komoot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/description"
+	fit "github.com/fitglue/server/src/go/pkg/domain/file_generators"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	httputil "github.com/fitglue/server/src/go/pkg/infrastructure/http"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/oauth"
+	"github.com/fitglue/server/src/go/pkg/loopprevention"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	baseURL = "https://external-api.komoot.de/v007"
+)
+
+// Uploader implements destination.Destination for Komoot
+type Uploader struct {
+	svc *bootstrap.Service
+}
+
+// New returns a new Komoot Uploader initialized with dependencies.
+func New(svc *bootstrap.Service) *Uploader {
+	return &Uploader{
+		svc: svc,
+	}
+}
+
+// Name returns the identifier for this uploader
+func (u *Uploader) Name() string {
+	return "komoot"
+}
+
+// Create uploads a new activity to Komoot as a recorded tour (GPX).
+func (u *Uploader) Create(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record) (string, error) {
+	if userRec.Integrations == nil || userRec.Integrations.Komoot == nil || !userRec.Integrations.Komoot.Enabled {
+		return "", fmt.Errorf("user has no Komoot integration configured")
+	}
+
+	logger := slog.Default()
+
+	// Dedup: Pub/Sub redelivery or a re-post of the same run must not create a second tour.
+	if existingID := u.findExistingTourID(ctx, payload); existingID != "" {
+		logger.Info("Komoot tour already uploaded for this pipeline run, skipping", "tour_id", existingID)
+		return existingID, nil
+	}
+
+	if payload.StandardizedActivity == nil {
+		return "", fmt.Errorf("missing activity data for Komoot upload")
+	}
+
+	gpxData, err := fit.GenerateGpxFile(payload.StandardizedActivity)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate GPX for Komoot: %w", err)
+	}
+
+	tokenSource := oauth.NewFirestoreTokenSource(u.svc, payload.UserId, "komoot")
+	httpClient := oauth.NewClientWithUsageTracking(tokenSource, u.svc, payload.UserId, "komoot", infra.NewLogger())
+
+	activityType := parseActivityType(payload.Metadata["activity_type"])
+
+	tourID, err := u.uploadTour(ctx, httpClient, gpxData, payload.Metadata["activity_name"], mapToKomootSport(activityType))
+	if err != nil {
+		return "", fmt.Errorf("failed to upload Komoot tour: %w", err)
+	}
+
+	// The GPX upload endpoint only accepts a name, so the enriched description is applied separately.
+	if desc := payload.Metadata["description"]; desc != "" {
+		if err := u.patchTour(ctx, httpClient, tourID, &komootTourPatch{Description: desc}); err != nil {
+			logger.Warn("Failed to set Komoot tour description", "tour_id", tourID, "error", err)
+		}
+	}
+
+	uploadRecord := &pbactivity.UploadedActivityRecord{
+		Id:            loopprevention.BuildUploadedActivityID(pbplugin.DestinationType_DESTINATION_KOMOOT, tourID),
+		UserId:        payload.UserId,
+		Source:        payload.Source,
+		ExternalId:    payload.StandardizedActivity.GetExternalId(),
+		StartTime:     payload.Timestamp,
+		Destination:   pbplugin.DestinationType_DESTINATION_KOMOOT,
+		DestinationId: tourID,
+		UploadedAt:    timestamppb.Now(),
+	}
+	_ = u.svc.DB.SetUploadedActivity(ctx, payload.UserId, uploadRecord)
+
+	_ = u.svc.DB.IncrementSyncCount(ctx, payload.UserId)
+
+	return tourID, nil
+}
+
+// Update modifies an existing Komoot tour's name and description.
+func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record, pipelineRun *pbpipeline.PipelineRun) error {
+	if userRec.Integrations == nil || userRec.Integrations.Komoot == nil || !userRec.Integrations.Komoot.Enabled {
+		return fmt.Errorf("user has no Komoot integration configured")
+	}
+
+	var tourID string
+	if pipelineRun != nil {
+		for _, dest := range pipelineRun.Destinations {
+			if dest.Destination == pbplugin.DestinationType_DESTINATION_KOMOOT && dest.ExternalId != nil && *dest.ExternalId != "" {
+				tourID = *dest.ExternalId
+				break
+			}
+		}
+	}
+	if tourID == "" {
+		return fmt.Errorf("no Komoot destination found in pipeline run")
+	}
+
+	existingDescription := pipelineRun.Description
+	payloadDesc := payload.Metadata["description"]
+	mergedDescription := description.Merge(existingDescription, payloadDesc, description.SectionHeader(payload.Metadata), false)
+
+	patch := &komootTourPatch{}
+	hasChanges := false
+
+	if name := payload.Metadata["activity_name"]; name != "" && name != pipelineRun.Title {
+		patch.Name = name
+		hasChanges = true
+	}
+	if mergedDescription != existingDescription {
+		patch.Description = mergedDescription
+		hasChanges = true
+	}
+
+	if !hasChanges {
+		return nil
+	}
+
+	tokenSource := oauth.NewFirestoreTokenSource(u.svc, payload.UserId, "komoot")
+	httpClient := oauth.NewClientWithUsageTracking(tokenSource, u.svc, payload.UserId, "komoot", infra.NewLogger())
+
+	if err := u.patchTour(ctx, httpClient, tourID, patch); err != nil {
+		return fmt.Errorf("failed to update Komoot tour: %w", err)
+	}
+
+	_ = u.svc.DB.IncrementSyncCount(ctx, payload.UserId)
+
+	return nil
+}
+
+// findExistingTourID returns the tour ID recorded for Komoot on the current pipeline run, if any.
+func (u *Uploader) findExistingTourID(ctx context.Context, payload *pbevents.ActivityPayload) string {
+	if u.svc.DB == nil || payload.PipelineExecutionId == nil || *payload.PipelineExecutionId == "" {
+		return ""
+	}
+
+	outcomes, err := u.svc.DB.GetDestinationOutcomes(ctx, payload.UserId, *payload.PipelineExecutionId)
+	if err != nil {
+		return ""
+	}
+	for _, outcome := range outcomes {
+		if outcome.Destination == pbplugin.DestinationType_DESTINATION_KOMOOT &&
+			outcome.Status == pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS &&
+			outcome.ExternalId != nil && *outcome.ExternalId != "" {
+			return *outcome.ExternalId
+		}
+	}
+	return ""
+}
+
+type komootTourPatch struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func (u *Uploader) uploadTour(ctx context.Context, httpClient *http.Client, gpxData []byte, name, sport string) (string, error) {
+	params := url.Values{}
+	params.Set("data_type", "gpx")
+	params.Set("sport", sport)
+	if name != "" {
+		params.Set("name", name)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", baseURL+"/tours/?"+params.Encode(), bytes.NewReader(gpxData))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/gpx+xml")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("Komoot API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return "", httputil.WrapResponseError(resp, "Komoot upload failed")
+	}
+
+	var respBody struct {
+		ID json.Number `json:"id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return "", fmt.Errorf("failed to decode Komoot response: %w", err)
+	}
+	if respBody.ID == "" {
+		return "", fmt.Errorf("no tour ID in Komoot response")
+	}
+
+	return respBody.ID.String(), nil
+}
+
+func (u *Uploader) patchTour(ctx context.Context, httpClient *http.Client, tourID string, patch *komootTourPatch) error {
+	bodyJSON, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("failed to marshal tour update: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/tours/%s", baseURL, tourID), bytes.NewReader(bodyJSON))
+	if err != nil {
+		return fmt.Errorf("failed to create PATCH request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("Komoot API PATCH request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return httputil.WrapResponseError(resp, "Komoot PATCH error")
+	}
+
+	return nil
+}
+
+func parseActivityType(s string) pbactivity.ActivityType {
+	if v, ok := pbactivity.ActivityType_value[s]; ok {
+		return pbactivity.ActivityType(v)
+	}
+	return pbactivity.ActivityType_ACTIVITY_TYPE_UNSPECIFIED
+}
+
+// mapToKomootSport maps FitGlue activity types to Komoot sport identifiers.
+func mapToKomootSport(activityType pbactivity.ActivityType) string {
+	switch activityType {
+	case pbactivity.ActivityType_ACTIVITY_TYPE_RIDE:
+		return "touringbicycle"
+	case pbactivity.ActivityType_ACTIVITY_TYPE_GRAVEL_RIDE:
+		return "mtb_easy"
+	case pbactivity.ActivityType_ACTIVITY_TYPE_MOUNTAIN_BIKE_RIDE:
+		return "mtb"
+	case pbactivity.ActivityType_ACTIVITY_TYPE_EBIKE_RIDE:
+		return "e_touringbicycle"
+	case pbactivity.ActivityType_ACTIVITY_TYPE_EMOUNTAIN_BIKE_RIDE:
+		return "e_mtb"
+	case pbactivity.ActivityType_ACTIVITY_TYPE_RUN,
+		pbactivity.ActivityType_ACTIVITY_TYPE_TRAIL_RUN:
+		return "jogging"
+	case pbactivity.ActivityType_ACTIVITY_TYPE_HIKE,
+		pbactivity.ActivityType_ACTIVITY_TYPE_WALK:
+		return "hike"
+	default:
+		return "other"
+	}
+}
@@ -0,0 +1,21 @@
+package komoot
+
+import (
+	"testing"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKomootUploader_Name(t *testing.T) {
+	u := New(&bootstrap.Service{})
+	assert.Equal(t, "komoot", u.Name())
+}
+
+func TestMapToKomootSport(t *testing.T) {
+	assert.Equal(t, "touringbicycle", mapToKomootSport(pbactivity.ActivityType_ACTIVITY_TYPE_RIDE))
+	assert.Equal(t, "mtb", mapToKomootSport(pbactivity.ActivityType_ACTIVITY_TYPE_MOUNTAIN_BIKE_RIDE))
+	assert.Equal(t, "jogging", mapToKomootSport(pbactivity.ActivityType_ACTIVITY_TYPE_RUN))
+	assert.Equal(t, "other", mapToKomootSport(pbactivity.ActivityType_ACTIVITY_TYPE_WEIGHT_TRAINING))
+}
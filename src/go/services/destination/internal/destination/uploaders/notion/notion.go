@@ -0,0 +1,442 @@
+// nolint:proto-json
+package notion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	httputil "github.com/fitglue/server/src/go/pkg/infrastructure/http"
+	"github.com/fitglue/server/src/go/pkg/loopprevention"
+	"github.com/fitglue/server/src/go/pkg/types/formatters"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	baseURL       = "https://api.notion.com/v1"
+	notionVersion = "2022-06-28"
+
+	// maxRichTextLength is Notion's limit for a single rich text object.
+	maxRichTextLength = 2000
+	// maxChildren is Notion's limit for blocks in one request; one slot is
+	// kept for the divider closing FitGlue's content.
+	maxChildren = 99
+)
+
+// Uploader implements destination.Destination for Notion. Each activity
+// becomes a page in the user's chosen database.
+type Uploader struct {
+	svc        *bootstrap.Service
+	baseURL    string
+	httpClient *http.Client
+}
+
+// New returns a new Notion Uploader initialized with dependencies.
+func New(svc *bootstrap.Service) *Uploader {
+	return &Uploader{
+		svc:        svc,
+		baseURL:    baseURL,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Name returns the identifier for this uploader
+func (u *Uploader) Name() string {
+	return "notion"
+}
+
+// Create adds a page for the activity to the configured database. Properties
+// are only filled in when the database has a matching column, so users can
+// shape the database however they like.
+func (u *Uploader) Create(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record) (string, error) {
+	if userRec.Integrations == nil || userRec.Integrations.Notion == nil || !userRec.Integrations.Notion.Enabled {
+		return "", fmt.Errorf("user has no Notion integration configured")
+	}
+
+	databaseID := payload.Metadata["notion_database_id"]
+	if databaseID == "" {
+		return "", fmt.Errorf("notion database ID not configured")
+	}
+
+	logger := slog.Default()
+
+	// Dedup: Pub/Sub redelivery or a re-post of the same run must not create a second page.
+	if existingID := u.findExistingPageID(ctx, payload); existingID != "" {
+		logger.Info("Notion page already created for this pipeline run, skipping", "page_id", existingID)
+		return existingID, nil
+	}
+
+	client, err := u.client(ctx, payload.UserId)
+	if err != nil {
+		return "", err
+	}
+
+	var database struct {
+		Properties map[string]struct {
+			Type string `json:"type"`
+		} `json:"properties"`
+	}
+	if err := client.do(ctx, "GET", "/databases/"+databaseID, nil, &database); err != nil {
+		return "", fmt.Errorf("failed to read Notion database: %w", err)
+	}
+	schema := make(map[string]string, len(database.Properties))
+	for name, prop := range database.Properties {
+		schema[name] = prop.Type
+	}
+
+	var page struct {
+		ID string `json:"id"`
+	}
+	err = client.do(ctx, "POST", "/pages", map[string]interface{}{
+		"parent":     map[string]string{"database_id": databaseID},
+		"properties": buildProperties(schema, payload),
+		"children":   renderBlocks(payload.Metadata["description"]),
+	}, &page)
+	if err != nil {
+		return "", fmt.Errorf("failed to create Notion page: %w", err)
+	}
+
+	uploadRecord := &pbactivity.UploadedActivityRecord{
+		Id:            loopprevention.BuildUploadedActivityID(pbplugin.DestinationType_DESTINATION_NOTION, page.ID),
+		UserId:        payload.UserId,
+		Source:        payload.Source,
+		ExternalId:    payload.StandardizedActivity.GetExternalId(),
+		StartTime:     payload.StandardizedActivity.GetStartTime(),
+		Destination:   pbplugin.DestinationType_DESTINATION_NOTION,
+		DestinationId: page.ID,
+		UploadedAt:    timestamppb.Now(),
+	}
+	_ = u.svc.DB.SetUploadedActivity(ctx, payload.UserId, uploadRecord)
+	_ = u.svc.DB.IncrementSyncCount(ctx, payload.UserId)
+
+	return page.ID, nil
+}
+
+// Update refreshes the page's properties and replaces FitGlue's content,
+// which runs up to the first divider. Anything the user has written below
+// the divider is kept.
+func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record, pipelineRun *pbpipeline.PipelineRun) error {
+	if userRec.Integrations == nil || userRec.Integrations.Notion == nil || !userRec.Integrations.Notion.Enabled {
+		return fmt.Errorf("user has no Notion integration configured")
+	}
+
+	var pageID string
+	if pipelineRun != nil {
+		for _, dest := range pipelineRun.Destinations {
+			if dest.Destination == pbplugin.DestinationType_DESTINATION_NOTION && dest.ExternalId != nil && *dest.ExternalId != "" {
+				pageID = *dest.ExternalId
+				break
+			}
+		}
+	}
+	if pageID == "" {
+		return fmt.Errorf("no Notion destination found in pipeline run")
+	}
+
+	client, err := u.client(ctx, payload.UserId)
+	if err != nil {
+		return err
+	}
+
+	var page struct {
+		Properties map[string]struct {
+			Type string `json:"type"`
+		} `json:"properties"`
+	}
+	if err := client.do(ctx, "GET", "/pages/"+pageID, nil, &page); err != nil {
+		return fmt.Errorf("failed to read Notion page: %w", err)
+	}
+	schema := make(map[string]string, len(page.Properties))
+	for name, prop := range page.Properties {
+		schema[name] = prop.Type
+	}
+
+	if err := client.do(ctx, "PATCH", "/pages/"+pageID, map[string]interface{}{
+		"properties": buildProperties(schema, payload),
+	}, nil); err != nil {
+		return fmt.Errorf("failed to update Notion page properties: %w", err)
+	}
+
+	if err := u.replaceContent(ctx, client, pageID, renderBlocks(payload.Metadata["description"])); err != nil {
+		return fmt.Errorf("failed to update Notion page content: %w", err)
+	}
+
+	_ = u.svc.DB.IncrementSyncCount(ctx, payload.UserId)
+
+	return nil
+}
+
+// replaceContent swaps the blocks up to and including the first divider for
+// blocks. The first old block is kept as an anchor until the new blocks are
+// in place so they land above any user content.
+func (u *Uploader) replaceContent(ctx context.Context, client *apiClient, pageID string, blocks []map[string]interface{}) error {
+	children, err := client.listChildren(ctx, pageID)
+	if err != nil {
+		return err
+	}
+
+	// Without a divider (the user removed it) the whole page is rewritten
+	var owned []string
+	for _, child := range children {
+		owned = append(owned, child.ID)
+		if child.Type == "divider" {
+			break
+		}
+	}
+
+	if len(owned) == 0 {
+		return client.do(ctx, "PATCH", "/blocks/"+pageID+"/children", map[string]interface{}{"children": blocks}, nil)
+	}
+
+	anchor := owned[0]
+	for _, id := range owned[1:] {
+		if err := client.do(ctx, "DELETE", "/blocks/"+id, nil, nil); err != nil {
+			return err
+		}
+	}
+	if err := client.do(ctx, "PATCH", "/blocks/"+pageID+"/children", map[string]interface{}{
+		"children": blocks,
+		"after":    anchor,
+	}, nil); err != nil {
+		return err
+	}
+	return client.do(ctx, "DELETE", "/blocks/"+anchor, nil, nil)
+}
+
+// findExistingPageID returns the page ID recorded for Notion on the current pipeline run, if any.
+func (u *Uploader) findExistingPageID(ctx context.Context, payload *pbevents.ActivityPayload) string {
+	if u.svc.DB == nil || payload.PipelineExecutionId == nil || *payload.PipelineExecutionId == "" {
+		return ""
+	}
+
+	outcomes, err := u.svc.DB.GetDestinationOutcomes(ctx, payload.UserId, *payload.PipelineExecutionId)
+	if err != nil {
+		return ""
+	}
+	for _, outcome := range outcomes {
+		if outcome.Destination == pbplugin.DestinationType_DESTINATION_NOTION &&
+			outcome.Status == pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS &&
+			outcome.ExternalId != nil && *outcome.ExternalId != "" {
+			return *outcome.ExternalId
+		}
+	}
+	return ""
+}
+
+// buildProperties maps the activity onto the database's columns. Columns
+// are matched by name and only filled when their type fits; the title
+// column is always set whatever it is called.
+func buildProperties(schema map[string]string, payload *pbevents.ActivityPayload) map[string]interface{} {
+	props := map[string]interface{}{}
+	set := func(name, wantType string, value interface{}) {
+		if schema[name] == wantType {
+			props[name] = map[string]interface{}{wantType: value}
+		}
+	}
+
+	name := payload.Metadata["activity_name"]
+	if name == "" {
+		name = "Activity"
+	}
+	for prop, propType := range schema {
+		if propType == "title" {
+			props[prop] = map[string]interface{}{"title": richText(name)}
+		}
+	}
+
+	if start := payload.StandardizedActivity.GetStartTime(); start != nil {
+		set("Date", "date", map[string]string{"start": start.AsTime().UTC().Format(time.RFC3339)})
+	}
+
+	activityType := formatters.FormatActivityType(formatters.ParseActivityType(payload.Metadata["activity_type"]))
+	set("Type", "select", map[string]string{"name": activityType})
+
+	if payload.Source != pbactivity.ActivitySource_SOURCE_UNSPECIFIED {
+		set("Source", "select", map[string]string{"name": formatters.FormatActivitySource(payload.Source)})
+	}
+
+	if sessions := payload.StandardizedActivity.GetSessions(); len(sessions) > 0 {
+		seconds := sessions[0].TotalElapsedTime
+		if seconds > 0 {
+			set("Duration", "number", math.Round(seconds/60*10)/10)
+			total := int(seconds)
+			set("Duration", "rich_text", richText(fmt.Sprintf("%02d:%02d:%02d", total/3600, (total%3600)/60, total%60)))
+		}
+		if meters := sessions[0].TotalDistance; meters > 0 {
+			set("Distance", "number", math.Round(meters/10)/100)
+		}
+	}
+
+	tags := []map[string]string{}
+	for _, tag := range strings.Split(payload.Metadata["tags"], ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, map[string]string{"name": tag})
+		}
+	}
+	set("Tags", "multi_select", tags)
+
+	prDetected := payload.Metadata["pr_status"] == "pr_detected"
+	set("PR", "checkbox", prDetected)
+	if prDetected {
+		var count int
+		_, _ = fmt.Sscanf(payload.Metadata["pr_count"], "%d", &count)
+		set("PRs", "number", count)
+	} else {
+		set("PRs", "number", 0)
+	}
+
+	return props
+}
+
+// renderBlocks turns a description into Notion blocks: bullet lines become
+// bulleted list items and everything else a paragraph. A divider closes
+// the content so later updates know where FitGlue's blocks end.
+func renderBlocks(desc string) []map[string]interface{} {
+	blocks := []map[string]interface{}{}
+	for _, line := range strings.Split(strings.TrimSpace(desc), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if len(blocks) == maxChildren {
+			break
+		}
+
+		blockType := "paragraph"
+		for _, bullet := range []string{"• ", "- ", "* "} {
+			if strings.HasPrefix(line, bullet) {
+				blockType = "bulleted_list_item"
+				line = strings.TrimPrefix(line, bullet)
+				break
+			}
+		}
+		blocks = append(blocks, map[string]interface{}{
+			"object":  "block",
+			"type":    blockType,
+			blockType: map[string]interface{}{"rich_text": richText(line)},
+		})
+	}
+	return append(blocks, map[string]interface{}{
+		"object":  "block",
+		"type":    "divider",
+		"divider": map[string]interface{}{},
+	})
+}
+
+// richText splits text into rich text objects within Notion's length limit.
+func richText(text string) []map[string]interface{} {
+	var parts []map[string]interface{}
+	runes := []rune(text)
+	for len(runes) > 0 {
+		n := len(runes)
+		if n > maxRichTextLength {
+			n = maxRichTextLength
+		}
+		parts = append(parts, map[string]interface{}{
+			"type": "text",
+			"text": map[string]string{"content": string(runes[:n])},
+		})
+		runes = runes[n:]
+	}
+	if parts == nil {
+		parts = []map[string]interface{}{}
+	}
+	return parts
+}
+
+type apiClient struct {
+	httpClient *http.Client
+	baseURL    string
+	secret     string
+}
+
+func (u *Uploader) client(ctx context.Context, userID string) (*apiClient, error) {
+	secret, err := u.svc.DB.GetIntegrationSecret(ctx, userID, "notion")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Notion integration secret: %w", err)
+	}
+	if secret == "" {
+		return nil, fmt.Errorf("notion integration secret not configured")
+	}
+	return &apiClient{httpClient: u.httpClient, baseURL: u.baseURL, secret: secret}, nil
+}
+
+// do sends a JSON request and decodes the response into out when non-nil.
+func (c *apiClient) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		bodyJSON, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+		reader = bytes.NewReader(bodyJSON)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.secret)
+	req.Header.Set("Notion-Version", notionVersion)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("notion API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return httputil.WrapResponseError(resp, "Notion API error")
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+type block struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// listChildren returns every top-level block on a page.
+func (c *apiClient) listChildren(ctx context.Context, pageID string) ([]block, error) {
+	var blocks []block
+	cursor := ""
+	for {
+		path := "/blocks/" + pageID + "/children?page_size=100"
+		if cursor != "" {
+			path += "&start_cursor=" + url.QueryEscape(cursor)
+		}
+		var resp struct {
+			Results    []block `json:"results"`
+			HasMore    bool    `json:"has_more"`
+			NextCursor string  `json:"next_cursor"`
+		}
+		if err := c.do(ctx, "GET", path, nil, &resp); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, resp.Results...)
+		if !resp.HasMore || resp.NextCursor == "" {
+			return blocks, nil
+		}
+		cursor = resp.NextCursor
+	}
+}
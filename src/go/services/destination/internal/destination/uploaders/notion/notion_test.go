@@ -0,0 +1,217 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	pbactivity "github.com/fitglue/server/src/go/pkg/types/pb/models/activity"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNotionUploader_Name(t *testing.T) {
+	u := New(&bootstrap.Service{})
+	assert.Equal(t, "notion", u.Name())
+}
+
+var testSchema = map[string]interface{}{
+	"Name":     map[string]string{"type": "title"},
+	"Date":     map[string]string{"type": "date"},
+	"Type":     map[string]string{"type": "select"},
+	"Duration": map[string]string{"type": "number"},
+	"Tags":     map[string]string{"type": "multi_select"},
+	"PR":       map[string]string{"type": "checkbox"},
+	"Notes":    map[string]string{"type": "rich_text"},
+}
+
+// fakeNotion is a minimal Notion API holding one page's blocks.
+type fakeNotion struct {
+	mu         sync.Mutex
+	created    map[string]interface{}
+	properties map[string]interface{}
+	blocks     []block
+	nextID     int
+}
+
+func (f *fakeNotion) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, notionVersion, r.Header.Get("Notion-Version"))
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/databases/db-1":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"properties": testSchema})
+		case r.Method == "POST" && r.URL.Path == "/pages":
+			_ = json.NewDecoder(r.Body).Decode(&f.created)
+			_ = json.NewEncoder(w).Encode(map[string]string{"id": "page-1"})
+		case r.Method == "GET" && r.URL.Path == "/pages/page-1":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"properties": testSchema})
+		case r.Method == "PATCH" && r.URL.Path == "/pages/page-1":
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			f.properties = body["properties"].(map[string]interface{})
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == "GET" && r.URL.Path == "/blocks/page-1/children":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"results": f.blocks, "has_more": false})
+		case r.Method == "PATCH" && r.URL.Path == "/blocks/page-1/children":
+			var body struct {
+				Children []block `json:"children"`
+				After    string  `json:"after"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			at := 0
+			for i, b := range f.blocks {
+				if b.ID == body.After {
+					at = i + 1
+				}
+			}
+			var inserted []block
+			for _, b := range body.Children {
+				f.nextID++
+				inserted = append(inserted, block{ID: "new-" + strconv.Itoa(f.nextID), Type: b.Type})
+			}
+			f.blocks = append(f.blocks[:at], append(inserted, f.blocks[at:]...)...)
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/blocks/"):
+			id := strings.TrimPrefix(r.URL.Path, "/blocks/")
+			for i, b := range f.blocks {
+				if b.ID == id {
+					f.blocks = append(f.blocks[:i], f.blocks[i+1:]...)
+					break
+				}
+			}
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func newTestUploader(t *testing.T, fake *fakeNotion) *Uploader {
+	server := httptest.NewServer(fake.handler(t))
+	t.Cleanup(server.Close)
+
+	svc := &bootstrap.Service{
+		DB: &mocks.MockDatabase{
+			GetIntegrationSecretFunc: func(ctx context.Context, userId, provider string) (string, error) {
+				assert.Equal(t, "notion", provider)
+				return "secret", nil
+			},
+		},
+	}
+	u := New(svc)
+	u.baseURL = server.URL
+	return u
+}
+
+func testUser() *user.Record {
+	return &user.Record{Integrations: &pbuser.UserIntegrations{
+		Notion: &pbuser.NotionIntegration{Enabled: true},
+	}}
+}
+
+func testPayload() *pbevents.ActivityPayload {
+	return &pbevents.ActivityPayload{
+		UserId: "user-1",
+		Source: pbactivity.ActivitySource_SOURCE_HEVY,
+		Metadata: map[string]string{
+			"notion_database_id": "db-1",
+			"activity_name":      "Leg Day",
+			"activity_type":      "ACTIVITY_TYPE_WEIGHT_TRAINING",
+			"description":        "Great session\n\n• Squat: 3x5 @ 100kg\n- Deadlift: 1x5",
+			"tags":               "strength,gym",
+			"pr_status":          "pr_detected",
+			"pr_count":           "2",
+		},
+		StandardizedActivity: &pbactivity.StandardizedActivity{
+			ExternalId: "hevy-1",
+			StartTime:  timestamppb.New(time.Date(2026, 6, 1, 7, 0, 0, 0, time.UTC)),
+			Sessions:   []*pbactivity.Session{{TotalElapsedTime: 3600}},
+		},
+	}
+}
+
+func TestNotionUploader_Create(t *testing.T) {
+	fake := &fakeNotion{}
+	u := newTestUploader(t, fake)
+
+	id, err := u.Create(context.Background(), testPayload(), testUser())
+	require.NoError(t, err)
+	assert.Equal(t, "page-1", id)
+
+	assert.Equal(t, map[string]interface{}{"database_id": "db-1"}, fake.created["parent"])
+	props := fake.created["properties"].(map[string]interface{})
+	assert.Contains(t, props, "Name")
+	assert.Equal(t, map[string]interface{}{"number": float64(60)}, props["Duration"])
+	assert.Equal(t, map[string]interface{}{"checkbox": true}, props["PR"])
+	assert.Len(t, props["Tags"].(map[string]interface{})["multi_select"], 2)
+	assert.NotContains(t, props, "Notes", "unmapped columns are left alone")
+
+	children := fake.created["children"].([]interface{})
+	require.Len(t, children, 4)
+	assert.Equal(t, "paragraph", children[0].(map[string]interface{})["type"])
+	assert.Equal(t, "bulleted_list_item", children[1].(map[string]interface{})["type"])
+	assert.Equal(t, "bulleted_list_item", children[2].(map[string]interface{})["type"])
+	assert.Equal(t, "divider", children[3].(map[string]interface{})["type"])
+}
+
+func TestNotionUploader_CreateRequiresDatabase(t *testing.T) {
+	u := newTestUploader(t, &fakeNotion{})
+	payload := testPayload()
+	delete(payload.Metadata, "notion_database_id")
+
+	_, err := u.Create(context.Background(), payload, testUser())
+	assert.EqualError(t, err, "notion database ID not configured")
+}
+
+func TestNotionUploader_UpdateKeepsUserContent(t *testing.T) {
+	fake := &fakeNotion{blocks: []block{
+		{ID: "old-1", Type: "paragraph"},
+		{ID: "old-2", Type: "bulleted_list_item"},
+		{ID: "old-div", Type: "divider"},
+		{ID: "user-1", Type: "paragraph"},
+	}}
+	u := newTestUploader(t, fake)
+
+	pageID := "page-1"
+	run := &pbpipeline.PipelineRun{Destinations: []*pbpipeline.DestinationOutcome{
+		{Destination: pbplugin.DestinationType_DESTINATION_NOTION, ExternalId: &pageID},
+	}}
+	require.NoError(t, u.Update(context.Background(), testPayload(), testUser(), run))
+
+	assert.Contains(t, fake.properties, "Name")
+	require.Len(t, fake.blocks, 5)
+	assert.Equal(t, "paragraph", fake.blocks[0].Type)
+	assert.Equal(t, "divider", fake.blocks[3].Type)
+	assert.Equal(t, "user-1", fake.blocks[4].ID, "content below the divider must be kept")
+}
+
+func TestNotionUploader_UpdateWithoutPage(t *testing.T) {
+	u := newTestUploader(t, &fakeNotion{})
+	err := u.Update(context.Background(), testPayload(), testUser(), &pbpipeline.PipelineRun{})
+	assert.EqualError(t, err, "no Notion destination found in pipeline run")
+}
+
+func TestRichText_SplitsLongText(t *testing.T) {
+	parts := richText(strings.Repeat("a", maxRichTextLength+10))
+	require.Len(t, parts, 2)
+	assert.Len(t, parts[1]["text"].(map[string]string)["content"], 10)
+}
@@ -217,30 +217,12 @@ func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload
 	activityName := payload.Metadata["activity_name"]
 	payloadDescription := payload.Metadata["description"]
 
-	var mergedDescription string
-	if isSameSource {
-		mergedDescription = payloadDescription
-	} else {
-		mergedDescription = existingActivity.Description
-		if payloadDescription != "" {
-			sectionHeader := ""
-			for key, val := range payload.Metadata {
-				if strings.HasPrefix(key, "section_header_") {
-					sectionHeader = val
-					break
-				}
-			}
-
-			if sectionHeader != "" && description.HasSection(mergedDescription, sectionHeader) {
-				newSectionContent := description.ExtractSection(payloadDescription, sectionHeader)
-				if newSectionContent != "" {
-					mergedDescription = description.ReplaceSection(mergedDescription, sectionHeader, newSectionContent)
-				}
-			} else if mergedDescription != "" {
-				mergedDescription += "\n\n" + payloadDescription
-			} else {
-				mergedDescription = payloadDescription
-			}
+	mergedDescription := description.Merge(existingActivity.Description, payloadDescription, description.SectionHeader(payload.Metadata), isSameSource)
+	if isSameSource && payload.Metadata["confirm_description_overwrite"] != "true" {
+		// The user edited the description on Strava; don't overwrite it unconfirmed
+		if dropped := description.DroppedUserLines(existingActivity.Description, mergedDescription); len(dropped) > 0 {
+			infra.NewLogger().Info(ctx, "Keeping user-edited Strava description", "activity_id", stravaIDStr, "dropped_lines", len(dropped))
+			mergedDescription = existingActivity.Description
 		}
 	}
 
@@ -296,6 +278,40 @@ func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload
 	return nil
 }
 
+// Remove archives an activity uploaded to Strava. The Strava API can't delete
+// activities, so it is hidden from followers' feeds instead. An activity that
+// is already gone is not an error.
+func (u *Uploader) Remove(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record, externalID string) error {
+	tokenSource := oauth.NewFirestoreTokenSource(u.svc, payload.UserId, "strava")
+	httpClient := oauth.NewClientWithUsageTracking(tokenSource, u.svc, payload.UserId, "strava", infra.NewLogger())
+
+	bodyJSON, err := json.Marshal(map[string]interface{}{"hide_from_home": true})
+	if err != nil {
+		return fmt.Errorf("failed to marshal archive body: %w", err)
+	}
+
+	putURL := fmt.Sprintf("https://www.strava.com/api/v3/activities/%s", externalID)
+	putReq, err := http.NewRequestWithContext(ctx, "PUT", putURL, bytes.NewReader(bodyJSON))
+	if err != nil {
+		return fmt.Errorf("failed to create PUT request: %w", err)
+	}
+	putReq.Header.Set("Content-Type", "application/json")
+
+	putResp, err := httpClient.Do(putReq)
+	if err != nil {
+		return fmt.Errorf("failed to PUT activity: %w", err)
+	}
+	defer putResp.Body.Close()
+
+	if putResp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if putResp.StatusCode >= 400 {
+		return httputil.WrapResponseError(putResp, "Strava archive failed")
+	}
+	return nil
+}
+
 func waitForUploadCompletion(ctx context.Context, client *http.Client, uploadID int64) (*strava.Upload, error) {
 	ticker := time.NewTicker(2 * time.Second)
 	defer ticker.Stop()
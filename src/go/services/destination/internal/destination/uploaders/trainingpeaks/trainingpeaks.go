@@ -4,8 +4,10 @@ package trainingpeaks
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"strings"
@@ -25,15 +27,29 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	baseURL = "https://api.trainingpeaks.com"
+
+	// uploadClient identifies FitGlue on file uploads.
+	uploadClient = "FitGlue"
+)
+
 // Uploader implements destination.Destination for TrainingPeaks
 type Uploader struct {
-	svc *bootstrap.Service
+	svc        *bootstrap.Service
+	baseURL    string
+	httpClient func(userID string) *http.Client
 }
 
 // New returns a new TrainingPeaks Uploader initialized with dependencies.
 func New(svc *bootstrap.Service) *Uploader {
 	return &Uploader{
-		svc: svc,
+		svc:     svc,
+		baseURL: baseURL,
+		httpClient: func(userID string) *http.Client {
+			tokenSource := oauth.NewFirestoreTokenSource(svc, userID, "trainingpeaks")
+			return oauth.NewClientWithUsageTracking(tokenSource, svc, userID, "trainingpeaks", infra.NewLogger())
+		},
 	}
 }
 
@@ -42,22 +58,55 @@ func (u *Uploader) Name() string {
 	return "trainingpeaks"
 }
 
-// Create uploads a new activity to TrainingPeaks.
+// Create uploads a new activity to TrainingPeaks. The FIT artifact is
+// uploaded as a completed workout and then given the enriched title and
+// description; without an artifact a workout is created from the summary.
 func (u *Uploader) Create(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record) (string, error) {
 	if userRec.Integrations == nil || userRec.Integrations.Trainingpeaks == nil || !userRec.Integrations.Trainingpeaks.Enabled {
 		return "", fmt.Errorf("user has no TrainingPeaks integration configured")
 	}
 
 	athleteID := userRec.Integrations.Trainingpeaks.AthleteId
-	tokenSource := oauth.NewFirestoreTokenSource(u.svc, payload.UserId, "trainingpeaks")
-	httpClient := oauth.NewClientWithUsageTracking(tokenSource, u.svc, payload.UserId, "trainingpeaks", infra.NewLogger())
 	logger := slog.Default()
 
+	// Dedup: Pub/Sub redelivery or a re-post of the same run must not create a second workout.
+	if existingID := u.findExistingWorkoutID(ctx, payload); existingID != "" {
+		logger.Info("TrainingPeaks workout already uploaded for this pipeline run, skipping", "workout_id", existingID)
+		return existingID, nil
+	}
+
+	httpClient := u.httpClient(payload.UserId)
 	workout := buildTrainingPeaksWorkout(payload)
 
-	workoutID, err := u.createTrainingPeaksWorkout(ctx, httpClient, athleteID, workout, logger)
-	if err != nil {
-		return "", fmt.Errorf("failed to create TrainingPeaks workout: %w", err)
+	var workoutID string
+	if fitFileUri := payload.Metadata["fit_file_uri"]; fitFileUri != "" {
+		bucketName := u.svc.Config.GCSArtifactBucket
+		if bucketName == "" {
+			bucketName = "fitglue-server-dev-artifacts"
+		}
+		fileData, err := u.svc.Store.Get(ctx, bucketName, strings.TrimPrefix(fitFileUri, "gs://"+bucketName+"/"))
+		if err != nil {
+			return "", fmt.Errorf("GCS Read Error: %w", err)
+		}
+
+		workoutID, err = u.uploadTrainingPeaksFile(ctx, httpClient, fileData, workout, payload.GetActivityId())
+		if err != nil {
+			return "", fmt.Errorf("failed to upload TrainingPeaks workout file: %w", err)
+		}
+
+		// The file upload only carries a comment, so the title and description are set separately
+		if workout.Title != "" || workout.Description != "" {
+			update := &TrainingPeaksWorkout{Title: workout.Title, Description: workout.Description}
+			if err := u.updateTrainingPeaksWorkout(ctx, httpClient, athleteID, workoutID, update, logger); err != nil {
+				logger.Warn("Failed to set TrainingPeaks workout title and description", "workout_id", workoutID, "error", err)
+			}
+		}
+	} else {
+		var err error
+		workoutID, err = u.createTrainingPeaksWorkout(ctx, httpClient, athleteID, workout, logger)
+		if err != nil {
+			return "", fmt.Errorf("failed to create TrainingPeaks workout: %w", err)
+		}
 	}
 
 	if workoutID != "" {
@@ -79,7 +128,10 @@ func (u *Uploader) Create(ctx context.Context, payload *pbevents.ActivityPayload
 	return workoutID, nil
 }
 
-// Update modifies an existing TrainingPeaks activity.
+// Update modifies an existing TrainingPeaks workout's title and description.
+// The description is merged with the workout's current one rather than the
+// pipeline run's, which differs when TrainingPeaks has enricher exclusions or
+// its own template.
 func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload, userRec *user.Record, pipelineRun *pbpipeline.PipelineRun) error {
 	if userRec.Integrations == nil || userRec.Integrations.Trainingpeaks == nil || !userRec.Integrations.Trainingpeaks.Enabled {
 		return fmt.Errorf("user has no TrainingPeaks integration configured")
@@ -101,34 +153,40 @@ func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload
 		return fmt.Errorf("no TrainingPeaks destination found in pipeline run")
 	}
 
-	existingDescription := pipelineRun.Description
-	payloadDesc := payload.Metadata["description"]
-	mergedDescription := existingDescription
-	if payloadDesc != "" {
-		sectionHeader := ""
-		for key, val := range payload.Metadata {
-			if strings.HasPrefix(key, "section_header_") {
-				sectionHeader = val
-				break
-			}
-		}
+	httpClient := u.httpClient(payload.UserId)
 
-		if sectionHeader != "" && description.HasSection(mergedDescription, sectionHeader) {
-			newSectionContent := description.ExtractSection(payloadDesc, sectionHeader)
-			if newSectionContent != "" {
-				mergedDescription = description.ReplaceSection(mergedDescription, sectionHeader, newSectionContent)
-			}
-		} else if mergedDescription != "" {
-			mergedDescription += "\n\n" + payloadDesc
-		} else {
-			mergedDescription = payloadDesc
-		}
+	existing, err := u.getTrainingPeaksWorkout(ctx, httpClient, athleteID, workoutIDStr)
+	if err != nil {
+		return fmt.Errorf("failed to get TrainingPeaks workout: %w", err)
 	}
 
+	updatePayload := buildWorkoutUpdate(existing, payload)
+	if updatePayload == nil {
+		return nil
+	}
+
+	if err := u.updateTrainingPeaksWorkout(ctx, httpClient, athleteID, workoutIDStr, updatePayload, logger); err != nil {
+		return fmt.Errorf("failed to update TrainingPeaks workout: %w", err)
+	}
+
+	_ = u.svc.DB.IncrementSyncCount(ctx, payload.UserId)
+
+	return nil
+}
+
+// buildWorkoutUpdate returns the changes to bring an existing workout up to
+// date with the payload, or nil if there are none.
+func buildWorkoutUpdate(existing *TrainingPeaksWorkout, payload *pbevents.ActivityPayload) *TrainingPeaksWorkout {
+	mergedDescription := description.Merge(existing.Description, payload.Metadata["description"], description.SectionHeader(payload.Metadata), false)
+
 	updatePayload := &TrainingPeaksWorkout{}
 	hasChanges := false
 
-	if mergedDescription != existingDescription {
+	if name := payload.Metadata["activity_name"]; name != "" && name != existing.Title {
+		updatePayload.Title = name
+		hasChanges = true
+	}
+	if mergedDescription != existing.Description {
 		updatePayload.Description = mergedDescription
 		hasChanges = true
 	}
@@ -136,17 +194,27 @@ func (u *Uploader) Update(ctx context.Context, payload *pbevents.ActivityPayload
 	if !hasChanges {
 		return nil
 	}
+	return updatePayload
+}
 
-	tokenSource := oauth.NewFirestoreTokenSource(u.svc, payload.UserId, "trainingpeaks")
-	httpClient := oauth.NewClientWithUsageTracking(tokenSource, u.svc, payload.UserId, "trainingpeaks", infra.NewLogger())
-
-	if err := u.updateTrainingPeaksWorkout(ctx, httpClient, athleteID, workoutIDStr, updatePayload, logger); err != nil {
-		return fmt.Errorf("failed to update TrainingPeaks workout: %w", err)
+// findExistingWorkoutID returns the workout ID recorded for TrainingPeaks on the current pipeline run, if any.
+func (u *Uploader) findExistingWorkoutID(ctx context.Context, payload *pbevents.ActivityPayload) string {
+	if u.svc.DB == nil || payload.PipelineExecutionId == nil || *payload.PipelineExecutionId == "" {
+		return ""
 	}
 
-	_ = u.svc.DB.IncrementSyncCount(ctx, payload.UserId)
-
-	return nil
+	outcomes, err := u.svc.DB.GetDestinationOutcomes(ctx, payload.UserId, *payload.PipelineExecutionId)
+	if err != nil {
+		return ""
+	}
+	for _, outcome := range outcomes {
+		if outcome.Destination == pbplugin.DestinationType_DESTINATION_TRAININGPEAKS &&
+			outcome.Status == pbpipeline.DestinationStatus_DESTINATION_STATUS_SUCCESS &&
+			outcome.ExternalId != nil && *outcome.ExternalId != "" {
+			return *outcome.ExternalId
+		}
+	}
+	return ""
 }
 
 type TrainingPeaksWorkout struct {
@@ -249,7 +317,7 @@ func (u *Uploader) createTrainingPeaksWorkout(ctx context.Context, httpClient *h
 		return "", fmt.Errorf("failed to marshal workout: %w", err)
 	}
 
-	url := fmt.Sprintf("https://api.trainingpeaks.com/v1/athlete/%s/workouts", athleteID)
+	url := fmt.Sprintf("%s/v1/athlete/%s/workouts", u.baseURL, athleteID)
 	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(bodyJSON))
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %w", err)
@@ -267,26 +335,7 @@ func (u *Uploader) createTrainingPeaksWorkout(ctx context.Context, httpClient *h
 		return "", httputil.WrapResponseError(resp, "TrainingPeaks API error")
 	}
 
-	var respBody struct {
-		ID        interface{} `json:"Id"`
-		WorkoutId interface{} `json:"workoutId"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
-		return "", fmt.Errorf("failed to decode TrainingPeaks response: %w", err)
-	}
-
-	workoutID := ""
-	if respBody.ID != nil {
-		workoutID = fmt.Sprintf("%v", respBody.ID)
-	} else if respBody.WorkoutId != nil {
-		workoutID = fmt.Sprintf("%v", respBody.WorkoutId)
-	}
-
-	if workoutID == "" {
-		return "", fmt.Errorf("no workout ID in TrainingPeaks response")
-	}
-
-	return workoutID, nil
+	return decodeWorkoutID(resp.Body)
 }
 
 func (u *Uploader) updateTrainingPeaksWorkout(ctx context.Context, httpClient *http.Client, athleteID, workoutID string, workout *TrainingPeaksWorkout, logger *slog.Logger) error {
@@ -295,7 +344,7 @@ func (u *Uploader) updateTrainingPeaksWorkout(ctx context.Context, httpClient *h
 		return fmt.Errorf("failed to marshal workout update: %w", err)
 	}
 
-	url := fmt.Sprintf("https://api.trainingpeaks.com/v1/athlete/%s/workouts/%s", athleteID, workoutID)
+	url := fmt.Sprintf("%s/v1/athlete/%s/workouts/%s", u.baseURL, athleteID, workoutID)
 	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewReader(bodyJSON))
 	if err != nil {
 		return fmt.Errorf("failed to create PUT request: %w", err)
@@ -315,3 +364,108 @@ func (u *Uploader) updateTrainingPeaksWorkout(ctx context.Context, httpClient *h
 
 	return nil
 }
+
+// uploadTrainingPeaksFile uploads a FIT file as a completed workout and
+// returns its ID.
+func (u *Uploader) uploadTrainingPeaksFile(ctx context.Context, httpClient *http.Client, fileData []byte, workout *TrainingPeaksWorkout, activityID string) (string, error) {
+	filename := "activity.fit"
+	if activityID != "" {
+		filename = activityID + ".fit"
+	}
+	bodyJSON, err := json.Marshal(map[string]interface{}{
+		"UploadClient":     uploadClient,
+		"Filename":         filename,
+		"Data":             base64.StdEncoding.EncodeToString(fileData),
+		"Title":            workout.Title,
+		"Type":             workout.WorkoutType,
+		"SetWorkoutPublic": false,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal file upload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", u.baseURL+"/v3/file", bytes.NewReader(bodyJSON))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("TrainingPeaks API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return "", httputil.WrapResponseError(resp, "TrainingPeaks file upload error")
+	}
+
+	return decodeWorkoutID(resp.Body)
+}
+
+func (u *Uploader) getTrainingPeaksWorkout(ctx context.Context, httpClient *http.Client, athleteID, workoutID string) (*TrainingPeaksWorkout, error) {
+	url := fmt.Sprintf("%s/v1/athlete/%s/workouts/%s", u.baseURL, athleteID, workoutID)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w", err)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("TrainingPeaks API GET request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, httputil.WrapResponseError(resp, "TrainingPeaks GET error")
+	}
+
+	var workout TrainingPeaksWorkout
+	if err := json.NewDecoder(resp.Body).Decode(&workout); err != nil {
+		return nil, fmt.Errorf("failed to decode TrainingPeaks workout: %w", err)
+	}
+	return &workout, nil
+}
+
+// decodeWorkoutID reads the workout ID from a TrainingPeaks response, which
+// is an object with Id or workoutId, or a list of them for file uploads.
+func decodeWorkoutID(body io.Reader) (string, error) {
+	type idBody struct {
+		ID        interface{} `json:"Id"`
+		WorkoutId interface{} `json:"workoutId"`
+	}
+
+	raw, err := io.ReadAll(body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read TrainingPeaks response: %w", err)
+	}
+
+	// UseNumber keeps large numeric IDs from being formatted as floats
+	decode := func(v interface{}) error {
+		d := json.NewDecoder(bytes.NewReader(raw))
+		d.UseNumber()
+		return d.Decode(v)
+	}
+
+	var respBody idBody
+	if err := decode(&respBody); err != nil {
+		var list []idBody
+		if listErr := decode(&list); listErr != nil || len(list) == 0 {
+			return "", fmt.Errorf("failed to decode TrainingPeaks response: %w", err)
+		}
+		respBody = list[0]
+	}
+
+	workoutID := ""
+	if respBody.ID != nil {
+		workoutID = fmt.Sprintf("%v", respBody.ID)
+	} else if respBody.WorkoutId != nil {
+		workoutID = fmt.Sprintf("%v", respBody.WorkoutId)
+	}
+
+	if workoutID == "" {
+		return "", fmt.Errorf("no workout ID in TrainingPeaks response")
+	}
+
+	return workoutID, nil
+}
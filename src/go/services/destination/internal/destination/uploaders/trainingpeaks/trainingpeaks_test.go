@@ -1,13 +1,137 @@
 package trainingpeaks
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/domain/user"
+	"github.com/fitglue/server/src/go/pkg/testing/mocks"
+	pbevents "github.com/fitglue/server/src/go/pkg/types/pb/models/events"
+	pbpipeline "github.com/fitglue/server/src/go/pkg/types/pb/models/pipeline"
+	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
+	pbuser "github.com/fitglue/server/src/go/pkg/types/pb/models/user"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTrainingPeaksUploader_Name(t *testing.T) {
 	u := New(&bootstrap.Service{})
 	assert.Equal(t, "trainingpeaks", u.Name())
 }
+
+func newTestUploader(t *testing.T, handler http.HandlerFunc) *Uploader {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	u := New(&bootstrap.Service{
+		DB: &mocks.MockDatabase{},
+		Store: &mocks.MockBlobStore{
+			GetFunc: func(ctx context.Context, bucket, object string) ([]byte, error) {
+				return []byte("FITDATA"), nil
+			},
+		},
+		Config: &bootstrap.Config{GCSArtifactBucket: "artifacts"},
+	})
+	u.baseURL = server.URL
+	u.httpClient = func(string) *http.Client { return server.Client() }
+	return u
+}
+
+func testUser() *user.Record {
+	return &user.Record{Integrations: &pbuser.UserIntegrations{
+		Trainingpeaks: &pbuser.TrainingPeaksIntegration{Enabled: true, AthleteId: "42"},
+	}}
+}
+
+func TestTrainingPeaksUploader_CreateUploadsFile(t *testing.T) {
+	var requests []string
+	var upload map[string]interface{}
+	var update TrainingPeaksWorkout
+	u := newTestUploader(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/v3/file":
+			_ = json.NewDecoder(r.Body).Decode(&upload)
+			_, _ = w.Write([]byte(`[{"Id":1234567890}]`))
+		case "/v1/athlete/42/workouts/1234567890":
+			_ = json.NewDecoder(r.Body).Decode(&update)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	payload := &pbevents.ActivityPayload{
+		UserId: "user-1",
+		Metadata: map[string]string{
+			"fit_file_uri":  "gs://artifacts/run.fit",
+			"activity_name": "Morning Run",
+			"description":   "Enriched by FitGlue",
+			"activity_type": "ACTIVITY_TYPE_RUN",
+		},
+	}
+
+	id, err := u.Create(context.Background(), payload, testUser())
+	require.NoError(t, err)
+	assert.Equal(t, "1234567890", id)
+	assert.Equal(t, []string{"POST /v3/file", "PUT /v1/athlete/42/workouts/1234567890"}, requests)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("FITDATA")), upload["Data"])
+	assert.Equal(t, "Run", upload["Type"])
+	assert.Equal(t, "Morning Run", update.Title)
+	assert.Equal(t, "Enriched by FitGlue", update.Description)
+}
+
+func TestTrainingPeaksUploader_UpdateMergesWorkoutDescription(t *testing.T) {
+	var update *TrainingPeaksWorkout
+	u := newTestUploader(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/athlete/42/workouts/77", r.URL.Path)
+		if r.Method == "PUT" {
+			update = &TrainingPeaksWorkout{}
+			_ = json.NewDecoder(r.Body).Decode(update)
+		}
+		_, _ = w.Write([]byte(`{"title":"Morning Run","description":"Coach notes"}`))
+	})
+
+	workoutID := "77"
+	run := &pbpipeline.PipelineRun{
+		// The run's description includes sections TrainingPeaks was excluded from
+		Description: "Weather: sunny\n\nEnriched by FitGlue",
+		Destinations: []*pbpipeline.DestinationOutcome{
+			{Destination: pbplugin.DestinationType_DESTINATION_TRAININGPEAKS, ExternalId: &workoutID},
+		},
+	}
+	payload := &pbevents.ActivityPayload{
+		UserId:   "user-1",
+		Metadata: map[string]string{"activity_name": "Morning Run", "description": "Enriched by FitGlue"},
+	}
+
+	require.NoError(t, u.Update(context.Background(), payload, testUser(), run))
+	require.NotNil(t, update)
+	assert.Empty(t, update.Title, "unchanged title is not sent")
+	assert.True(t, strings.HasPrefix(update.Description, "Coach notes"))
+	assert.NotContains(t, update.Description, "Weather")
+
+	err := u.Update(context.Background(), payload, testUser(), &pbpipeline.PipelineRun{})
+	assert.EqualError(t, err, "no TrainingPeaks destination found in pipeline run")
+}
+
+func TestDecodeWorkoutID(t *testing.T) {
+	for body, want := range map[string]string{
+		`{"Id":98765432101}`:  "98765432101",
+		`{"workoutId":"abc"}`: "abc",
+		`[{"Id":5}]`:          "5",
+	} {
+		got, err := decodeWorkoutID(strings.NewReader(body))
+		require.NoError(t, err, body)
+		assert.Equal(t, want, got, body)
+	}
+
+	_, err := decodeWorkoutID(strings.NewReader(`{}`))
+	assert.Error(t, err)
+}
@@ -6,18 +6,32 @@ import (
 	"net/http"
 	"os"
 
+	"cloud.google.com/go/firestore"
+	"github.com/fitglue/server/src/go/internal/archive"
+	"github.com/fitglue/server/src/go/internal/dataexport"
+	"github.com/fitglue/server/src/go/internal/digest"
 	"github.com/fitglue/server/src/go/internal/infra"
+	"github.com/fitglue/server/src/go/internal/outage"
+	"github.com/fitglue/server/src/go/internal/uploadschedule"
+	"github.com/fitglue/server/src/go/internal/userdeletion"
 	"github.com/fitglue/server/src/go/pkg/bootstrap"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/metrics"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/oauth"
 	pbplugin "github.com/fitglue/server/src/go/pkg/types/pb/models/plugin"
 	activitypb "github.com/fitglue/server/src/go/pkg/types/pb/services/activity"
 	userpb "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
 
 	"github.com/fitglue/server/src/go/services/destination/internal/destination"
+	"github.com/fitglue/server/src/go/services/destination/internal/destination/uploaders/announcer"
+	"github.com/fitglue/server/src/go/services/destination/internal/destination/uploaders/email"
+	"github.com/fitglue/server/src/go/services/destination/internal/destination/uploaders/filedrop"
 	"github.com/fitglue/server/src/go/services/destination/internal/destination/uploaders/github"
 	"github.com/fitglue/server/src/go/services/destination/internal/destination/uploaders/googlesheets"
 	"github.com/fitglue/server/src/go/services/destination/internal/destination/uploaders/hevy"
 	"github.com/fitglue/server/src/go/services/destination/internal/destination/uploaders/intervals"
+	"github.com/fitglue/server/src/go/services/destination/internal/destination/uploaders/komoot"
 	"github.com/fitglue/server/src/go/services/destination/internal/destination/uploaders/mock"
+	"github.com/fitglue/server/src/go/services/destination/internal/destination/uploaders/notion"
 	"github.com/fitglue/server/src/go/services/destination/internal/destination/uploaders/showcase"
 	"github.com/fitglue/server/src/go/services/destination/internal/destination/uploaders/strava"
 	"github.com/fitglue/server/src/go/services/destination/internal/destination/uploaders/trainingpeaks"
@@ -29,6 +43,14 @@ func main() {
 	ctx := context.Background()
 	logger.Info(ctx, "Starting FitGlue Destination Service", "version", "v1")
 
+	// Export operational metrics to Cloud Monitoring
+	shutdownMetrics, err := metrics.Init(ctx, "destination")
+	if err != nil {
+		logger.Warn(ctx, "Failed to initialize metrics export", "error", err)
+	} else {
+		defer shutdownMetrics(context.Background())
+	}
+
 	svc, err := bootstrap.NewService(ctx)
 	if err != nil {
 		logger.Error(ctx, "Failed to initialize bootstrap service", "error", err)
@@ -68,15 +90,82 @@ func main() {
 	registry.Register(pbplugin.DestinationType_DESTINATION_TRAININGPEAKS, trainingpeaks.New(svc))
 	registry.Register(pbplugin.DestinationType_DESTINATION_INTERVALS, intervals.New(svc))
 	registry.Register(pbplugin.DestinationType_DESTINATION_GOOGLESHEETS, googlesheets.New(svc))
-	registry.Register(pbplugin.DestinationType_DESTINATION_GITHUB, github.New(svc))
+	githubUploader := github.New(svc)
+	registry.Register(pbplugin.DestinationType_DESTINATION_GITHUB, githubUploader)
+	registry.Register(pbplugin.DestinationType_DESTINATION_KOMOOT, komoot.New(svc))
+	registry.Register(pbplugin.DestinationType_DESTINATION_DROPBOX, filedrop.NewDropbox(svc))
+	registry.Register(pbplugin.DestinationType_DESTINATION_WEBDAV, filedrop.NewWebDAV(svc))
+	registry.Register(pbplugin.DestinationType_DESTINATION_NOTION, notion.New(svc))
+	registry.Register(pbplugin.DestinationType_DESTINATION_DISCORD, announcer.NewDiscord(svc))
+	registry.Register(pbplugin.DestinationType_DESTINATION_SLACK, announcer.NewSlack(svc))
+	registry.Register(pbplugin.DestinationType_DESTINATION_EMAIL, email.New(svc))
 	registry.Register(pbplugin.DestinationType_DESTINATION_SHOWCASE, showcase.New(svc, activityClient))
 	registry.Register(pbplugin.DestinationType_DESTINATION_MOCK, mock.New())
 
-	executor := destination.NewUploadExecutor(registry, userClient, activityClient, svc.DB, svc.Store, svc.Notifications, logger)
+	// Platform health and the outage queue are shared with api-webhook
+	fsClient, err := firestore.NewClient(ctx, svc.Config.ProjectID)
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize Firestore client", "error", err)
+		os.Exit(1)
+	}
+	defer fsClient.Close()
+	breaker := outage.NewBreaker(outage.NewFirestoreStore(fsClient), logger)
+
+	executor := destination.NewUploadExecutor(registry, userClient, activityClient, svc.DB, svc.Store, svc.Notifications, breaker, uploadschedule.NewFirestoreStore(fsClient), svc.Pub, logger)
+
+	// Static site exports of the public archive reuse the GitHub uploader's
+	// markdown and publish through it
+	archiveStore, ok := svc.Store.(archive.BlobStore)
+	if !ok {
+		logger.Error(ctx, "Blob store does not support signed URLs")
+		os.Exit(1)
+	}
+	exporter := archive.NewExporter(activityClient, userClient, archiveStore, svc.Config.GCSArtifactBucket, githubUploader, svc.Notifications, logger)
+
+	// Data exports (everything held for a user) read Firestore directly
+	dataStore, ok := svc.Store.(dataexport.BlobStore)
+	if !ok {
+		logger.Error(ctx, "Blob store does not support signed URLs")
+		os.Exit(1)
+	}
+	dataExporter := dataexport.NewExporter(dataexport.NewFirestoreStore(fsClient), dataStore, svc.Config.GCSArtifactBucket, userClient, svc.Notifications, logger)
+
+	// Weekly digests read opted-in users and their runs from Firestore
+	digester := digest.NewDigester(digest.NewFirestoreStore(fsClient), svc.Notifications, logger)
+
+	// User deletions revoke provider tokens before the user service deletes them
+	deletionStore, ok := svc.Store.(userdeletion.BlobStore)
+	if !ok {
+		logger.Error(ctx, "Blob store does not support prefix deletes")
+		os.Exit(1)
+	}
+	tokens := func(ctx context.Context, userID, provider string) (*oauth.Token, error) {
+		return oauth.NewFirestoreTokenSource(svc, userID, provider).Token(ctx)
+	}
+	showcaseAssetsBucket := os.Getenv("SHOWCASE_ASSETS_BUCKET")
+	if showcaseAssetsBucket == "" {
+		showcaseAssetsBucket = "fitglue-server-dev-showcase-assets"
+	}
+	deleter := userdeletion.NewDeleter(svc.DB, tokens, deletionStore, svc.Config.GCSArtifactBucket,
+		userdeletion.NewFirestoreShowcaseStore(fsClient), showcaseAssetsBucket,
+		userClient, userdeletion.NewFirestoreAuditStore(fsClient), logger)
 
 	// Create an HTTP handler to receive Pub/Sub pushes
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", executor.HandlePubSubPush)
+	// Cloud Scheduler (via Pub/Sub) drains uploads queued during platform outages
+	mux.HandleFunc("/outage-check", executor.HandleOutageCheck)
+	// Cloud Scheduler (via Pub/Sub) settles pipeline runs stuck in RUNNING
+	mux.HandleFunc("/stuck-runs", executor.HandleStuckRunSweep)
+	// Cloud Scheduler (via Pub/Sub) releases uploads held by posting schedules
+	mux.HandleFunc("/scheduled-uploads", executor.HandleScheduledUploads)
+	// Cloud Scheduler (via Pub/Sub) sends the weekly email digest
+	mux.HandleFunc("/weekly-digest", digester.HandlePubSubPush)
+	// Cloud Scheduler (via Pub/Sub) recomputes public showcase comparisons nightly
+	mux.HandleFunc("/showcase-comparisons", executor.HandleShowcaseComparisonRefresh)
+	mux.HandleFunc("/archive-export", exporter.HandlePubSubPush)
+	mux.HandleFunc("/data-export", dataExporter.HandlePubSubPush)
+	mux.HandleFunc("/user-deletion", deleter.HandlePubSubPush)
 
 	port := os.Getenv("PORT")
 	if port == "" {
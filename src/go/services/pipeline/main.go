@@ -18,9 +18,13 @@ import (
 	"github.com/fitglue/server/src/go/internal/infra"
 	"github.com/fitglue/server/src/go/internal/pipeline"
 	"github.com/fitglue/server/src/go/internal/pipeline/enricher"
+	"github.com/fitglue/server/src/go/internal/pipeline/redrive"
 	"github.com/fitglue/server/src/go/internal/pipeline/router"
 	"github.com/fitglue/server/src/go/internal/pipeline/splitter"
+	shared "github.com/fitglue/server/src/go/pkg"
+	"github.com/fitglue/server/src/go/pkg/infrastructure/metrics"
 	infrapubsub "github.com/fitglue/server/src/go/pkg/infrastructure/pubsub"
+	fsstorage "github.com/fitglue/server/src/go/pkg/storage/firestore"
 	pb "github.com/fitglue/server/src/go/pkg/types/pb/services/pipeline"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
@@ -34,6 +38,14 @@ func main() {
 	infra.InitSentry()
 	ctx := context.Background()
 
+	// Export operational metrics to Cloud Monitoring
+	shutdownMetrics, err := metrics.Init(ctx, "pipeline")
+	if err != nil {
+		logger.Warn(ctx, "Failed to initialize metrics export", "error", err)
+	} else {
+		defer shutdownMetrics(context.Background())
+	}
+
 	// Initialize dependencies
 	fsClient, err := firestore.NewClient(ctx, os.Getenv("PROJECT_ID"))
 	if err != nil {
@@ -43,6 +55,16 @@ func main() {
 
 	store := pipeline.NewFirestoreStore(fsClient)
 
+	// Destination credentials saved with a pipeline go to integration_secrets
+	if _, err := fsstorage.ConfigureTokenEncryption(ctx); err != nil {
+		log.Fatalf("failed to init token encryption: %v", err)
+	}
+
+	// Sensitive booster data keys are rotated by /pubsub/rotate-keys
+	if _, err := fsstorage.ConfigureSensitiveDataEncryption(ctx, fsClient); err != nil {
+		log.Fatalf("failed to init sensitive data encryption: %v", err)
+	}
+
 	// In the new architecture, we use a real publisher and blob store
 	rawPubClient, err := pubsub.NewClient(ctx, os.Getenv("PROJECT_ID"))
 	if err != nil {
@@ -63,6 +85,11 @@ func main() {
 
 	// 1. gRPC Service (CRUD) — serves on the same port as HTTP (required for Cloud Run single-port)
 	svc := pipeline.NewService(store, pubClient, blobStore, logger)
+	svc.SetPreviewer(enricher.Preview)
+	svc.SetDescriptionFetcher(enricher.FetchDestinationDescription)
+
+	redriver := redrive.NewRedriver(store, pubClient, logger)
+	svc.SetRedriver(redriver.Redrive)
 
 	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(infra.LoggingUnaryInterceptor(logger)))
 	pb.RegisterPipelineServiceServer(grpcServer, svc)
@@ -71,13 +98,26 @@ func main() {
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthcheck)
 
 	// 2. HTTP Server for Pub/Sub Pushes
-	splitterSvc := splitter.NewSplitter(store, pubClient, logger)
+	splitterSvc := splitter.NewSplitter(store, pubClient, blobStore, bucketName, logger)
 	routerSvc := router.NewRouter(store, pubClient, blobStore, bucketName, logger)
 
 	mux := http.NewServeMux()
-	mux.HandleFunc("/pubsub/raw", handlePubSubPush(logger, splitterSvc.SplitByPipeline))
-	mux.HandleFunc("/pubsub/run", enricher.EnrichActivityHTTP)
-	mux.HandleFunc("/pubsub/enriched", handlePubSubPush(logger, routerSvc.RouteActivity))
+	// Final delivery failures are dead-lettered with their error (see redrive.CaptureFailures)
+	mux.HandleFunc("/pubsub/raw", redriver.CaptureFailures(shared.TopicRawActivity, handlePubSubPush(logger, splitterSvc.SplitByPipeline)))
+	mux.HandleFunc("/pubsub/run", redriver.CaptureFailures(shared.TopicPipelineActivity, enricher.EnrichActivityHTTP))
+	mux.HandleFunc("/pubsub/enriched", redriver.CaptureFailures(shared.TopicEnrichedActivity, handlePubSubPush(logger, routerSvc.RouteActivity)))
+	mux.HandleFunc("/pubsub/dead-letter", redriver.HandleDeadLetterPush)
+	mux.HandleFunc("/pubsub/redrive", handlePubSubPush(logger, redriver.RedriveDue))
+	mux.HandleFunc("/pubsub/retry", handlePubSubPush(logger, svc.RetryDueRuns))
+	mux.HandleFunc("/pubsub/rotate-keys", handlePubSubPush(logger, func(ctx context.Context, _ cloudevents.Event) error {
+		rotated, err := fsstorage.RotateUserDataKeys(ctx, fsClient)
+		logger.Info(ctx, "Rotated user data keys", "users", rotated)
+		return err
+	}))
+	mux.HandleFunc("/pubsub/recommendations", handlePubSubPush(logger, func(ctx context.Context, _ cloudevents.Event) error {
+		_, err := svc.RefreshEnricherRecommendations(ctx)
+		return err
+	}))
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("ok"))
@@ -153,8 +193,9 @@ func handlePubSubPush(logger infra.Logger, handler func(context.Context, cloudev
 
 		if err := handler(ctx, *event); err != nil {
 			logger.Error(ctx, "Handler failed processing event", "error", err, "eventId", event.ID())
-			// Return 500 so Pub/Sub retries NACKs
-			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			// Return 500 so Pub/Sub retries NACKs. The error is recorded if the
+			// event is dead-lettered.
+			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 
@@ -5,7 +5,6 @@ import (
 	"log"
 	"net"
 	"os"
-	"strconv"
 
 	cloudFirestore "cloud.google.com/go/firestore"
 	firebase "firebase.google.com/go/v4"
@@ -13,6 +12,7 @@ import (
 	"github.com/fitglue/server/src/go/internal/infra"
 	"github.com/fitglue/server/src/go/internal/user"
 	emailsender "github.com/fitglue/server/src/go/pkg/infrastructure/email"
+	storage "github.com/fitglue/server/src/go/pkg/storage/firestore"
 	pbsvc "github.com/fitglue/server/src/go/pkg/types/pb/services/user"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
@@ -32,6 +32,10 @@ func (f *firebaseAuthWrapper) GetUser(ctx context.Context, uid string) (*firebas
 	return f.client.GetUser(ctx, uid)
 }
 
+func (f *firebaseAuthWrapper) GetUserByEmail(ctx context.Context, email string) (*firebaseAuth.UserRecord, error) {
+	return f.client.GetUserByEmail(ctx, email)
+}
+
 func (f *firebaseAuthWrapper) EmailVerificationLinkWithSettings(ctx context.Context, email string, settings *firebaseAuth.ActionCodeSettings) (string, error) {
 	return f.client.EmailVerificationLinkWithSettings(ctx, email, settings)
 }
@@ -90,26 +94,25 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Email Sender Setup
-	emailPass := os.Getenv("EMAIL_APP_PASSWORD")
-	emailUser := os.Getenv("SYSTEM_EMAIL")
-	if emailPass == "" || emailUser == "" {
-		logger.Error(ctx, "EMAIL_APP_PASSWORD and SYSTEM_EMAIL must be set")
+	// Integration tokens are encrypted when TOKEN_ENCRYPTION_KEY names a KMS key
+	if _, err := storage.ConfigureTokenEncryption(ctx); err != nil {
+		logger.Error(ctx, "failed to initialize token encryption", "err", err)
 		os.Exit(1)
 	}
 
-	smtpPortStr := os.Getenv("EMAIL_SMTP_PORT")
-	if smtpPortStr == "" {
-		smtpPortStr = "465"
+	// Sensitive booster data is encrypted per user when SENSITIVE_DATA_KEY names a KMS key
+	if _, err := storage.ConfigureSensitiveDataEncryption(ctx, fsClient); err != nil {
+		logger.Error(ctx, "failed to initialize sensitive data encryption", "err", err)
+		os.Exit(1)
 	}
-	smtpPort, _ := strconv.Atoi(smtpPortStr)
 
-	smtpHost := os.Getenv("EMAIL_SMTP_HOST")
-	if smtpHost == "" {
-		smtpHost = "smtp.gmail.com"
+	// Email Sender Setup
+	sender, err := emailsender.NewSenderFromEnv()
+	if err != nil || sender == nil {
+		logger.Error(ctx, "email sender must be configured (see EMAIL_PROVIDER)", "err", err)
+		os.Exit(1)
 	}
 
-	sender := emailsender.NewSMTPSender(smtpHost, smtpPort, emailUser, emailPass)
 	store := user.NewFirestoreStore(fsClient)
 	authWrapper := &firebaseAuthWrapper{client: authClient}
 
@@ -17,7 +17,8 @@ resource "google_project_service" "apis" {
     "fcm.googleapis.com",
     "aiplatform.googleapis.com",
     "cloudtasks.googleapis.com",
-    "sheets.googleapis.com"
+    "sheets.googleapis.com",
+    "cloudkms.googleapis.com"
   ])
 
   project = var.project_id
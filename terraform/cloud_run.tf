@@ -12,8 +12,13 @@ locals {
     "activity"    = { is_public = false }
     "registry"    = { is_public = false }
     "destination" = { is_public = false }
+    "backfill"    = { is_public = false }
   }
   all_services = merge(local.frontend_services, local.backend_services)
+
+  # Audience of the OIDC tokens Pub/Sub sends with deferred webhook events,
+  # which api-webhook checks itself as it's public
+  webhook_push_audience = "fitglue-webhook-received"
 }
 
 resource "google_artifact_registry_repository" "services" {
@@ -45,6 +50,7 @@ resource "google_cloud_run_v2_service" "backend" {
           cpu    = "1000m"
           memory = "512Mi"
         }
+        startup_cpu_boost = true
       }
 
       # ── Shared env vars (all backend services) ──
@@ -68,6 +74,10 @@ resource "google_cloud_run_v2_service" "backend" {
         name  = "SENTRY_DSN"
         value = var.sentry_dsn
       }
+      env {
+        name  = "MIN_INSTANCES"
+        value = tostring(lookup(var.min_instances, each.key, 0))
+      }
 
       # ── Pipeline-specific env vars ──
       dynamic "env" {
@@ -98,6 +108,18 @@ resource "google_cloud_run_v2_service" "backend" {
           value = google_storage_bucket.showcase_assets_bucket.name
         }
       }
+      dynamic "env" {
+        for_each = each.key == "activity" ? [1] : []
+        content {
+          name = "SHOWCASE_EMBED_SECRET"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.showcase_embed_secret.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
       dynamic "env" {
         for_each = each.key == "pipeline" ? [1] : []
         content {
@@ -122,16 +144,34 @@ resource "google_cloud_run_v2_service" "backend" {
         }
       }
       dynamic "env" {
-        for_each = each.key == "user" ? [1] : []
+        for_each = contains(["user", "destination"], each.key) ? [1] : []
         content {
           name  = "SYSTEM_EMAIL"
           value = "system@fitglue.tech"
         }
       }
 
-      # ── Activity service env vars ──
+      # ── Token encryption (services reading/writing integration tokens) ──
       dynamic "env" {
-        for_each = each.key == "activity" ? [1] : []
+        for_each = contains(local.token_services, each.key) ? [1] : []
+        content {
+          name  = "TOKEN_ENCRYPTION_KEY"
+          value = google_kms_crypto_key.tokens.id
+        }
+      }
+
+      # ── Sensitive data encryption (services reading/writing health booster data) ──
+      dynamic "env" {
+        for_each = contains(local.sensitive_data_services, each.key) ? [1] : []
+        content {
+          name  = "SENSITIVE_DATA_KEY"
+          value = google_kms_crypto_key.sensitive_data.id
+        }
+      }
+
+      # ── Activity service env vars (destination deletes users' showcase assets) ──
+      dynamic "env" {
+        for_each = contains(["activity", "destination"], each.key) ? [1] : []
         content {
           name  = "SHOWCASE_ASSETS_BUCKET"
           value = google_storage_bucket.showcase_assets_bucket.name
@@ -161,6 +201,22 @@ resource "google_cloud_run_v2_service" "backend" {
         }
       }
 
+      # ── Backfill service env vars ──
+      dynamic "env" {
+        for_each = each.key == "backfill" ? [1] : []
+        content {
+          name  = "USER_SERVICE_URL"
+          value = "https://user-${data.google_project.project.number}.${var.region}.run.app"
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "backfill" ? [1] : []
+        content {
+          name  = "GCS_ARTIFACT_BUCKET"
+          value = "${var.project_id}-artifacts"
+        }
+      }
+
       # ═══════════════════════════════════════════════════════════════
       # Secrets (from Secret Manager)
       # ═══════════════════════════════════════════════════════════════
@@ -226,6 +282,30 @@ resource "google_cloud_run_v2_service" "backend" {
           }
         }
       }
+      dynamic "env" {
+        for_each = each.key == "pipeline" ? [1] : []
+        content {
+          name = "OURA_CLIENT_ID"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.oura_client_id.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "pipeline" ? [1] : []
+        content {
+          name = "OURA_CLIENT_SECRET"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.oura_client_secret.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
 
       # ── Billing secrets (Stripe) ──
       dynamic "env" {
@@ -265,9 +345,9 @@ resource "google_cloud_run_v2_service" "backend" {
         }
       }
 
-      # ── User secrets (Email) ──
+      # ── Email secrets (account emails, activity recaps and weekly digests) ──
       dynamic "env" {
-        for_each = each.key == "user" ? [1] : []
+        for_each = contains(["user", "destination"], each.key) ? [1] : []
         content {
           name = "EMAIL_APP_PASSWORD"
           value_source {
@@ -424,6 +504,54 @@ resource "google_cloud_run_v2_service" "backend" {
           }
         }
       }
+      dynamic "env" {
+        for_each = each.key == "destination" ? [1] : []
+        content {
+          name = "KOMOOT_CLIENT_ID"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.komoot_client_id.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "destination" ? [1] : []
+        content {
+          name = "KOMOOT_CLIENT_SECRET"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.komoot_client_secret.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "destination" ? [1] : []
+        content {
+          name = "DROPBOX_CLIENT_ID"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.dropbox_client_id.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "destination" ? [1] : []
+        content {
+          name = "DROPBOX_CLIENT_SECRET"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.dropbox_client_secret.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
       dynamic "env" {
         for_each = each.key == "destination" ? [1] : []
         content {
@@ -498,8 +626,10 @@ resource "google_cloud_run_v2_service" "backend" {
       }
     }
     scaling {
-      min_instance_count = 0
-      max_instance_count = 3
+      min_instance_count = lookup(var.min_instances, each.key, 0)
+      # Backfill pagers rate-limit in process, so a single instance keeps
+      # source API usage within quota.
+      max_instance_count = each.key == "backfill" ? 1 : 3
     }
   }
 }
@@ -522,6 +652,7 @@ resource "google_cloud_run_v2_service" "frontend" {
           cpu    = "1000m"
           memory = "512Mi"
         }
+        startup_cpu_boost = true
       }
 
       # ── Shared env vars (all frontend services) ──
@@ -545,6 +676,10 @@ resource "google_cloud_run_v2_service" "frontend" {
         name  = "SENTRY_DSN"
         value = var.sentry_dsn
       }
+      env {
+        name  = "MIN_INSTANCES"
+        value = tostring(lookup(var.min_instances, each.key, 0))
+      }
       env {
         name  = "USER_SERVICE_URL"
         value = google_cloud_run_v2_service.backend["user"].uri
@@ -582,6 +717,15 @@ resource "google_cloud_run_v2_service" "frontend" {
         }
       }
 
+      # ── api-client: import file uploads ──
+      dynamic "env" {
+        for_each = each.key == "api-client" ? [1] : []
+        content {
+          name  = "GCS_ARTIFACT_BUCKET"
+          value = "${var.project_id}-artifacts"
+        }
+      }
+
       # ═══════════════════════════════════════════════════════════════
       # Secrets — api-client (all OAuth client pairs for connect flow)
       # ═══════════════════════════════════════════════════════════════
@@ -741,6 +885,78 @@ resource "google_cloud_run_v2_service" "frontend" {
           }
         }
       }
+      dynamic "env" {
+        for_each = each.key == "api-client" ? [1] : []
+        content {
+          name = "KOMOOT_CLIENT_ID"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.komoot_client_id.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "api-client" ? [1] : []
+        content {
+          name = "KOMOOT_CLIENT_SECRET"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.komoot_client_secret.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "api-client" ? [1] : []
+        content {
+          name = "DROPBOX_CLIENT_ID"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.dropbox_client_id.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "api-client" ? [1] : []
+        content {
+          name = "DROPBOX_CLIENT_SECRET"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.dropbox_client_secret.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "api-client" ? [1] : []
+        content {
+          name = "WHOOP_CLIENT_ID"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.whoop_client_id.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "api-client" ? [1] : []
+        content {
+          name = "WHOOP_CLIENT_SECRET"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.whoop_client_secret.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
       dynamic "env" {
         for_each = each.key == "api-client" ? [1] : []
         content {
@@ -865,9 +1081,114 @@ resource "google_cloud_run_v2_service" "frontend" {
           }
         }
       }
+      dynamic "env" {
+        for_each = each.key == "api-webhook" ? [1] : []
+        content {
+          name = "WHOOP_CLIENT_ID"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.whoop_client_id.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "api-webhook" ? [1] : []
+        content {
+          name = "WHOOP_CLIENT_SECRET"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.whoop_client_secret.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "api-webhook" ? [1] : []
+        content {
+          name = "ZWIFT_POLL_TOKEN"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.zwift_poll_token.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "api-webhook" ? [1] : []
+        content {
+          name = "STRAVA_CLIENT_ID"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.strava_client_id.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "api-webhook" ? [1] : []
+        content {
+          name = "STRAVA_CLIENT_SECRET"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.strava_client_secret.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "api-webhook" ? [1] : []
+        content {
+          name = "STRAVA_SUBSCRIPTION_CHECK_TOKEN"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.strava_subscription_check_token.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "api-webhook" ? [1] : []
+        content {
+          name = "OUTAGE_CHECK_TOKEN"
+          value_source {
+            secret_key_ref {
+              secret  = google_secret_manager_secret.outage_check_token.secret_id
+              version = "latest"
+            }
+          }
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "api-webhook" ? [1] : []
+        content {
+          name  = "STRAVA_WEBHOOK_CALLBACK_URL"
+          value = "${var.base_url}/api/webhooks/strava"
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "api-webhook" ? [1] : []
+        content {
+          name  = "WEBHOOK_PUSH_AUDIENCE"
+          value = local.webhook_push_audience
+        }
+      }
+      dynamic "env" {
+        for_each = each.key == "api-webhook" ? [1] : []
+        content {
+          name  = "WEBHOOK_PUSH_SERVICE_ACCOUNT"
+          value = google_service_account.cloud_run_sa["api-webhook"].email
+        }
+      }
     }
     scaling {
-      min_instance_count = 0
+      min_instance_count = lookup(var.min_instances, each.key, 0)
       max_instance_count = 3
     }
   }
@@ -73,6 +73,60 @@ resource "google_firestore_field" "showcased_activities_expires_at" {
   ttl_config {}
 }
 
+resource "google_firestore_field" "enricher_result_shares_expires_at" {
+  project    = var.project_id
+  database   = google_firestore_database.database.name
+  collection = "enricher_result_shares"
+  field      = "expires_at"
+
+  ttl_config {}
+}
+
+resource "google_firestore_field" "showcase_embed_hits_expires_at" {
+  project    = var.project_id
+  database   = google_firestore_database.database.name
+  collection = "showcase_embed_hits"
+  field      = "expires_at"
+
+  ttl_config {}
+}
+
+resource "google_firestore_field" "showcase_comparison_hits_expires_at" {
+  project    = var.project_id
+  database   = google_firestore_database.database.name
+  collection = "showcase_comparison_hits"
+  field      = "expires_at"
+
+  ttl_config {}
+}
+
+resource "google_firestore_field" "showcase_comparison_requests_expires_at" {
+  project    = var.project_id
+  database   = google_firestore_database.database.name
+  collection = "showcase_comparison_requests"
+  field      = "expires_at"
+
+  ttl_config {}
+}
+
+resource "google_firestore_field" "stripe_events_expires_at" {
+  project    = var.project_id
+  database   = google_firestore_database.database.name
+  collection = "stripe_events"
+  field      = "expires_at"
+
+  ttl_config {}
+}
+
+resource "google_firestore_field" "failed_events_expires_at" {
+  project    = var.project_id
+  database   = google_firestore_database.database.name
+  collection = "failed_events"
+  field      = "expires_at"
+
+  ttl_config {}
+}
+
 resource "google_firestore_index" "pending_inputs_user_status_created" {
   project    = var.project_id
   database   = google_firestore_database.database.name
@@ -366,6 +420,49 @@ resource "google_firestore_index" "pipeline_runs_cg_status_created" {
   }
 }
 
+# Collection group index for source + created_at
+# Used by the admin pipeline run listing when filtering by source
+resource "google_firestore_index" "pipeline_runs_cg_source_created" {
+  project     = var.project_id
+  database    = google_firestore_database.database.name
+  collection  = "pipeline_runs"
+  query_scope = "COLLECTION_GROUP"
+
+  fields {
+    field_path = "source"
+    order      = "ASCENDING"
+  }
+
+  fields {
+    field_path = "created_at"
+    order      = "DESCENDING"
+  }
+}
+
+# Collection group index for status + source + created_at
+# Used by the admin pipeline run listing when filtering by both
+resource "google_firestore_index" "pipeline_runs_cg_status_source_created" {
+  project     = var.project_id
+  database    = google_firestore_database.database.name
+  collection  = "pipeline_runs"
+  query_scope = "COLLECTION_GROUP"
+
+  fields {
+    field_path = "status"
+    order      = "ASCENDING"
+  }
+
+  fields {
+    field_path = "source"
+    order      = "ASCENDING"
+  }
+
+  fields {
+    field_path = "created_at"
+    order      = "DESCENDING"
+  }
+}
+
 # Note: A collection group index for created_at only is NOT needed here.
 # Firestore automatically creates single-field indexes (including collection group).
 # See also the comment on line 160 about this pattern.
@@ -437,3 +534,79 @@ resource "google_firestore_index" "pipeline_runs_cg_source_created" {
     order      = "DESCENDING"
   }
 }
+
+# -------------------------------------------------------------------
+# Failed Events Indexes (dead-lettered pipeline events)
+# -------------------------------------------------------------------
+
+# Admin listing of a user's failed events by status, most recent first
+resource "google_firestore_index" "failed_events_status_last_failed" {
+  project     = var.project_id
+  database    = google_firestore_database.database.name
+  collection  = "failed_events"
+  query_scope = "COLLECTION"
+
+  fields {
+    field_path = "status"
+    order      = "ASCENDING"
+  }
+
+  fields {
+    field_path = "last_failed_at"
+    order      = "DESCENDING"
+  }
+}
+
+# Scheduled redrive of PENDING events across all users whose next attempt is due
+resource "google_firestore_index" "failed_events_cg_status_next_attempt" {
+  project     = var.project_id
+  database    = google_firestore_database.database.name
+  collection  = "failed_events"
+  query_scope = "COLLECTION_GROUP"
+
+  fields {
+    field_path = "status"
+    order      = "ASCENDING"
+  }
+
+  fields {
+    field_path = "next_attempt_at"
+    order      = "ASCENDING"
+  }
+}
+
+# Scheduled enricher retries: RUNNING runs across all users whose retry is due
+resource "google_firestore_index" "pipeline_runs_cg_status_next_retry" {
+  project     = var.project_id
+  database    = google_firestore_database.database.name
+  collection  = "pipeline_runs"
+  query_scope = "COLLECTION_GROUP"
+
+  fields {
+    field_path = "status"
+    order      = "ASCENDING"
+  }
+
+  fields {
+    field_path = "next_retry_at"
+    order      = "ASCENDING"
+  }
+}
+
+# Stuck run sweep: RUNNING runs across all users not updated for hours
+resource "google_firestore_index" "pipeline_runs_cg_status_updated_at" {
+  project     = var.project_id
+  database    = google_firestore_database.database.name
+  collection  = "pipeline_runs"
+  query_scope = "COLLECTION_GROUP"
+
+  fields {
+    field_path = "status"
+    order      = "ASCENDING"
+  }
+
+  fields {
+    field_path = "updated_at"
+    order      = "ASCENDING"
+  }
+}
@@ -1,8 +1,9 @@
 locals {
-  firestore_services = ["user", "billing", "pipeline", "activity", "registry", "api-admin", "destination", "api-client"]
-  pubsub_publishers  = ["api-webhook", "pipeline", "activity", "api-client"]
+  firestore_services = ["user", "billing", "pipeline", "activity", "registry", "api-admin", "destination", "api-client", "backfill"]
+  pubsub_publishers  = ["api-webhook", "pipeline", "activity", "api-client", "api-admin", "backfill"]
   secret_accessors   = ["api-client", "user", "billing", "pipeline", "activity", "destination", "registry", "api-webhook"]
-  storage_services   = ["activity", "pipeline", "destination"]
+  storage_services   = ["activity", "pipeline", "destination", "api-client", "backfill"]
+  metric_writers     = ["pipeline", "destination"]
 }
 
 resource "google_project_iam_member" "cr_firestore_user" {
@@ -33,6 +34,13 @@ resource "google_project_iam_member" "cr_storage_admin" {
   member   = "serviceAccount:${google_service_account.cloud_run_sa[each.key].email}"
 }
 
+resource "google_project_iam_member" "cr_metric_writer" {
+  for_each = toset(local.metric_writers)
+  project  = var.project_id
+  role     = "roles/monitoring.metricWriter"
+  member   = "serviceAccount:${google_service_account.cloud_run_sa[each.key].email}"
+}
+
 resource "google_project_iam_member" "cr_fcm_admin" {
   project = var.project_id
   role    = "roles/firebasecloudmessaging.admin"
@@ -0,0 +1,62 @@
+# =============================================================================
+# Token Encryption (KMS envelope encryption of OAuth tokens in Firestore)
+# =============================================================================
+resource "google_kms_key_ring" "tokens" {
+  name     = "fitglue-tokens"
+  location = var.region
+
+  depends_on = [google_project_service.apis]
+}
+
+# Wraps the per-process data keys that encrypt access/refresh tokens and API
+# keys in user documents. Old key versions stay enabled so tokens written
+# before a rotation can still be decrypted.
+resource "google_kms_crypto_key" "tokens" {
+  name            = "integration-tokens"
+  key_ring        = google_kms_key_ring.tokens.id
+  rotation_period = "7776000s" # 90 days
+
+  lifecycle {
+    prevent_destroy = true
+  }
+}
+
+locals {
+  token_services = ["user", "destination", "pipeline"]
+}
+
+resource "google_kms_crypto_key_iam_member" "cr_token_encrypter" {
+  for_each      = toset(local.token_services)
+  crypto_key_id = google_kms_crypto_key.tokens.id
+  role          = "roles/cloudkms.cryptoKeyEncrypterDecrypter"
+  member        = "serviceAccount:${google_service_account.cloud_run_sa[each.key].email}"
+}
+
+# =============================================================================
+# Sensitive Data Encryption (per-user data keys for health booster data)
+# =============================================================================
+
+# Wraps each user's data keys for sensitive booster data (heart-rate load and
+# effort history). The monthly key rotation job re-wraps every user's new data
+# key with the primary version, so versions older than two rotation periods
+# can be disabled.
+resource "google_kms_crypto_key" "sensitive_data" {
+  name            = "sensitive-data"
+  key_ring        = google_kms_key_ring.tokens.id
+  rotation_period = "7776000s" # 90 days
+
+  lifecycle {
+    prevent_destroy = true
+  }
+}
+
+locals {
+  sensitive_data_services = ["pipeline", "user"]
+}
+
+resource "google_kms_crypto_key_iam_member" "cr_sensitive_data_encrypter" {
+  for_each      = toset(local.sensitive_data_services)
+  crypto_key_id = google_kms_crypto_key.sensitive_data.id
+  role          = "roles/cloudkms.cryptoKeyEncrypterDecrypter"
+  member        = "serviceAccount:${google_service_account.cloud_run_sa[each.key].email}"
+}
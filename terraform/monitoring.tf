@@ -24,73 +24,44 @@ locals {
 # =============================================================================
 # LOG-BASED METRICS
 # =============================================================================
+# Enricher, destination upload and tier metrics are written directly by the
+# services (see pkg/infrastructure/metrics) as workload.googleapis.com/fitglue.*
 
-resource "google_logging_metric" "pipeline_execution_status" {
-  name        = "pipeline_execution_status"
-  description = "Pipeline execution outcomes extracted from pipeline logs"
-  filter      = <<-EOT
-    resource.type="cloud_run_revision"
-    resource.labels.service_name="pipeline"
-    jsonPayload.status=~"SUCCESS|FAILED|SKIPPED"
-  EOT
-
-  metric_descriptor {
-    metric_kind = "DELTA"
-    value_type  = "INT64"
-    labels {
-      key         = "status"
-      value_type  = "STRING"
-      description = "Execution status"
-    }
-  }
-  label_extractors = {
-    "status" = "EXTRACT(jsonPayload.status)"
-  }
-}
-
-resource "google_logging_metric" "destination_upload_status" {
-  name        = "destination_upload_status"
-  description = "Destination upload status and volume"
+resource "google_logging_metric" "webhook_ingress_source" {
+  name        = "webhook_ingress_source"
+  description = "Webhook ingress volume by source"
   filter      = <<-EOT
     resource.type="cloud_run_revision"
-    resource.labels.service_name="destination"
-    jsonPayload.destination != ""
+    resource.labels.service_name="api-webhook"
+    jsonPayload.provider != ""
   EOT
 
   metric_descriptor {
     metric_kind = "DELTA"
     value_type  = "INT64"
     labels {
-      key         = "provider"
+      key         = "source"
       value_type  = "STRING"
-      description = "Destination provider name"
+      description = "Webhook source provider"
     }
   }
   label_extractors = {
-    "provider" = "EXTRACT(jsonPayload.destination)"
+    "source" = "EXTRACT(jsonPayload.provider)"
   }
 }
 
-resource "google_logging_metric" "webhook_ingress_source" {
-  name        = "webhook_ingress_source"
-  description = "Webhook ingress volume by source"
+resource "google_logging_metric" "webhook_subscription_alerts" {
+  name        = "webhook_subscription_alerts"
+  description = "Webhook subscription recreated, unhealthy, or no longer delivering events"
   filter      = <<-EOT
     resource.type="cloud_run_revision"
     resource.labels.service_name="api-webhook"
-    jsonPayload.provider != ""
+    jsonPayload.alert="webhook_subscription"
   EOT
 
   metric_descriptor {
     metric_kind = "DELTA"
     value_type  = "INT64"
-    labels {
-      key         = "source"
-      value_type  = "STRING"
-      description = "Webhook source provider"
-    }
-  }
-  label_extractors = {
-    "source" = "EXTRACT(jsonPayload.provider)"
   }
 }
 
@@ -149,6 +120,31 @@ resource "google_monitoring_alert_policy" "high_error_rate" {
   }
 }
 
+resource "google_monitoring_alert_policy" "webhook_subscription" {
+  display_name = "Webhook Subscription Unhealthy"
+  combiner     = "OR"
+
+  conditions {
+    display_name = "Subscription check raised an alert"
+    condition_threshold {
+      filter          = "metric.type=\"logging.googleapis.com/user/${google_logging_metric.webhook_subscription_alerts.name}\" AND resource.type=\"cloud_run_revision\""
+      duration        = "0s"
+      comparison      = "COMPARISON_GT"
+      threshold_value = 0
+
+      aggregations {
+        alignment_period   = "300s"
+        per_series_aligner = "ALIGN_SUM"
+      }
+    }
+  }
+
+  notification_channels = [google_monitoring_notification_channel.email.id]
+  alert_strategy {
+    auto_close = "86400s"
+  }
+}
+
 # We split the 10 services into 2 groups to avoid the 6-condition limit per policy
 locals {
   group_1 = slice(local.all_monitored_services, 0, 5)
@@ -440,16 +436,130 @@ resource "google_monitoring_dashboard" "business_metrics" {
               dataSets = [{
                 timeSeriesQuery = {
                   timeSeriesFilter = {
-                    filter      = "metric.type=\"logging.googleapis.com/user/destination_upload_status\""
-                    aggregation = { alignmentPeriod = "3600s", perSeriesAligner = "ALIGN_SUM", crossSeriesReducer = "REDUCE_SUM", groupByFields = ["metric.labels.provider"] }
+                    filter      = "metric.type=\"workload.googleapis.com/fitglue.destination.uploads\""
+                    aggregation = { alignmentPeriod = "3600s", perSeriesAligner = "ALIGN_DELTA", crossSeriesReducer = "REDUCE_SUM", groupByFields = ["metric.labels.destination"] }
                   }
                 }
                 plotType       = "STACKED_BAR"
-                legendTemplate = "$${metric.labels.provider}"
+                legendTemplate = "$${metric.labels.destination}"
               }]
               yAxis = { label = "Uploads" }
             }
           }
+        },
+        # ----- Enricher & Destination Health -----
+        {
+          yPos   = 5
+          width  = 6
+          height = 5
+          widget = {
+            title = "Enricher Executions by Provider & Status"
+            xyChart = {
+              dataSets = [{
+                timeSeriesQuery = {
+                  timeSeriesFilter = {
+                    filter      = "metric.type=\"workload.googleapis.com/fitglue.enricher.executions\""
+                    aggregation = { alignmentPeriod = "3600s", perSeriesAligner = "ALIGN_DELTA", crossSeriesReducer = "REDUCE_SUM", groupByFields = ["metric.labels.provider", "metric.labels.status"] }
+                  }
+                }
+                plotType       = "STACKED_BAR"
+                legendTemplate = "$${metric.labels.provider} $${metric.labels.status}"
+              }]
+              yAxis = { label = "Executions" }
+            }
+          }
+        },
+        {
+          xPos   = 6
+          yPos   = 5
+          width  = 6
+          height = 5
+          widget = {
+            title = "Enricher Duration p95 by Provider"
+            xyChart = {
+              dataSets = [{
+                timeSeriesQuery = {
+                  timeSeriesFilter = {
+                    filter      = "metric.type=\"workload.googleapis.com/fitglue.enricher.duration\""
+                    aggregation = { alignmentPeriod = "3600s", perSeriesAligner = "ALIGN_DELTA", crossSeriesReducer = "REDUCE_PERCENTILE_95", groupByFields = ["metric.labels.provider"] }
+                  }
+                }
+                plotType       = "LINE"
+                legendTemplate = "$${metric.labels.provider}"
+              }]
+              yAxis = { label = "ms" }
+            }
+          }
+        },
+        {
+          yPos   = 10
+          width  = 4
+          height = 5
+          widget = {
+            title = "Destination Upload Error Rate"
+            xyChart = {
+              dataSets = [{
+                timeSeriesQuery = {
+                  timeSeriesFilterRatio = {
+                    numerator = {
+                      filter      = "metric.type=\"workload.googleapis.com/fitglue.destination.uploads\" AND metric.labels.outcome=\"failed\""
+                      aggregation = { alignmentPeriod = "3600s", perSeriesAligner = "ALIGN_DELTA", crossSeriesReducer = "REDUCE_SUM", groupByFields = ["metric.labels.destination"] }
+                    }
+                    denominator = {
+                      filter      = "metric.type=\"workload.googleapis.com/fitglue.destination.uploads\""
+                      aggregation = { alignmentPeriod = "3600s", perSeriesAligner = "ALIGN_DELTA", crossSeriesReducer = "REDUCE_SUM", groupByFields = ["metric.labels.destination"] }
+                    }
+                  }
+                }
+                plotType       = "LINE"
+                legendTemplate = "$${metric.labels.destination}"
+              }]
+              yAxis = { label = "Failed / attempted" }
+            }
+          }
+        },
+        {
+          xPos   = 4
+          yPos   = 10
+          width  = 4
+          height = 5
+          widget = {
+            title = "Destination Upload Latency p95"
+            xyChart = {
+              dataSets = [{
+                timeSeriesQuery = {
+                  timeSeriesFilter = {
+                    filter      = "metric.type=\"workload.googleapis.com/fitglue.destination.upload_latency\""
+                    aggregation = { alignmentPeriod = "3600s", perSeriesAligner = "ALIGN_DELTA", crossSeriesReducer = "REDUCE_PERCENTILE_95", groupByFields = ["metric.labels.destination"] }
+                  }
+                }
+                plotType       = "LINE"
+                legendTemplate = "$${metric.labels.destination}"
+              }]
+              yAxis = { label = "ms" }
+            }
+          }
+        },
+        {
+          xPos   = 8
+          yPos   = 10
+          width  = 4
+          height = 5
+          widget = {
+            title = "Syncs Blocked by Tier Limits"
+            xyChart = {
+              dataSets = [{
+                timeSeriesQuery = {
+                  timeSeriesFilter = {
+                    filter      = "metric.type=\"workload.googleapis.com/fitglue.pipeline.tier_blocked\""
+                    aggregation = { alignmentPeriod = "86400s", perSeriesAligner = "ALIGN_DELTA", crossSeriesReducer = "REDUCE_SUM" }
+                  }
+                }
+                plotType = "STACKED_BAR"
+              }]
+              yAxis = { label = "Blocked syncs" }
+            }
+          }
         }
       ]
     }
@@ -6,6 +6,15 @@ resource "google_pubsub_topic" "raw_activity" {
   message_retention_duration = "3600s"
 }
 
+# Webhook received topic - api-webhook acknowledges provider webhooks once
+# parsed and resolves and fetches them from here
+resource "google_pubsub_topic" "webhook_received" {
+  name    = "topic-webhook-received"
+  project = var.project_id
+
+  message_retention_duration = "3600s"
+}
+
 # Mobile activity topic - carries mobile health activities from mobile-sync-handler
 # mobile-source-handler subscribes to process into StandardizedActivity
 resource "google_pubsub_topic" "mobile_activity" {
@@ -40,6 +49,45 @@ resource "google_pubsub_topic" "destination_upload" {
   message_retention_duration = "3600s"
 }
 
+# Backfill topic - one message per page of source history still to replay
+resource "google_pubsub_topic" "backfill_requested" {
+  name    = "topic-backfill-requested"
+  project = var.project_id
+
+  message_retention_duration = "3600s"
+}
+
+# Import topic - one message per batch of uploaded files still to import
+resource "google_pubsub_topic" "import_requested" {
+  name    = "topic-import-requested"
+  project = var.project_id
+
+  message_retention_duration = "3600s"
+}
+
+# Archive export topic - one message per static site export of a user's showcase
+resource "google_pubsub_topic" "archive_export_requested" {
+  name    = "topic-archive-export-requested"
+  project = var.project_id
+
+  message_retention_duration = "3600s"
+}
+
+# Data export topic - one message per export of everything held for a user
+resource "google_pubsub_topic" "data_export_requested" {
+  name    = "topic-data-export-requested"
+  project = var.project_id
+
+  message_retention_duration = "3600s"
+}
+
+# User deletion topic - one message per account deletion (self or admin)
+resource "google_pubsub_topic" "user_deletion_requested" {
+  name    = "topic-user-deletion-requested"
+  project = var.project_id
+
+  message_retention_duration = "86400s"
+}
 
 resource "google_pubsub_topic" "parkrun_results_trigger" {
   name    = "topic-parkrun-results-trigger"
@@ -52,6 +100,103 @@ resource "google_pubsub_topic" "registration_summary_trigger" {
   project = var.project_id
 }
 
+# Enricher recommendations topic - triggered daily by Cloud Scheduler
+resource "google_pubsub_topic" "recommendations_trigger" {
+  name    = "topic-recommendations-trigger"
+  project = var.project_id
+}
+
+# Missed-activity reconciliation topic - triggered daily by Cloud Scheduler
+resource "google_pubsub_topic" "reconcile_trigger" {
+  name    = "topic-reconcile-trigger"
+  project = var.project_id
+}
+
+# Platform outage check topic - triggered every few minutes by Cloud Scheduler
+resource "google_pubsub_topic" "outage_check_trigger" {
+  name    = "topic-outage-check"
+  project = var.project_id
+}
+
+# Dead letter topic - pipeline events that failed every delivery attempt.
+# service.pipeline stores them under users/{id}/failed_events for redrive
+resource "google_pubsub_topic" "pipeline_dead_letter" {
+  name    = "topic-pipeline-dead-letter"
+  project = var.project_id
+
+  message_retention_duration = "86400s"
+}
+
+# Failed event redrive topic - triggered every few minutes by Cloud Scheduler
+resource "google_pubsub_topic" "failed_event_redrive_trigger" {
+  name    = "topic-failed-event-redrive"
+  project = var.project_id
+}
+
+# Enricher retry topic - triggered every minute by Cloud Scheduler
+resource "google_pubsub_topic" "enricher_retry_trigger" {
+  name    = "topic-enricher-retry"
+  project = var.project_id
+}
+
+# Stuck pipeline run sweep topic - triggered hourly by Cloud Scheduler
+resource "google_pubsub_topic" "stuck_run_sweep_trigger" {
+  name    = "topic-stuck-run-sweep"
+  project = var.project_id
+}
+
+# Scheduled upload release topic - triggered every few minutes by Cloud Scheduler
+resource "google_pubsub_topic" "scheduled_upload_trigger" {
+  name    = "topic-scheduled-uploads"
+  project = var.project_id
+}
+
+# Weekly email digest topic - triggered every Monday morning by Cloud Scheduler
+resource "google_pubsub_topic" "weekly_digest_trigger" {
+  name    = "topic-weekly-digest"
+  project = var.project_id
+}
+
+# Showcase comparison refresh topic - triggered nightly by Cloud Scheduler
+resource "google_pubsub_topic" "showcase_comparison_refresh_trigger" {
+  name    = "topic-showcase-comparison-refresh"
+  project = var.project_id
+}
+
+# User data key rotation topic - triggered monthly by Cloud Scheduler
+resource "google_pubsub_topic" "data_key_rotation_trigger" {
+  name    = "topic-data-key-rotation"
+  project = var.project_id
+}
+
+resource "google_pubsub_subscription" "webhook_received_sub" {
+  name  = "sub-webhook-received"
+  topic = google_pubsub_topic.webhook_received.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.frontend["api-webhook"].uri}/api/webhooks/deferred"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["api-webhook"].email
+      audience              = local.webhook_push_audience
+    }
+  }
+
+  ack_deadline_seconds       = 120
+  message_retention_duration = "3600s"
+
+  # Failed resolves, fetches and publishes return a 500 and are retried
+  retry_policy {
+    minimum_backoff = "10s"
+    maximum_backoff = "600s"
+  }
+
+  # Keep in sync with redrive.MaxDeliveryAttempts
+  dead_letter_policy {
+    dead_letter_topic     = google_pubsub_topic.pipeline_dead_letter.id
+    max_delivery_attempts = 5
+  }
+}
+
 resource "google_pubsub_subscription" "destination_upload_sub" {
   name  = "sub-destination-upload"
   topic = google_pubsub_topic.destination_upload.name
@@ -72,6 +217,140 @@ resource "google_pubsub_subscription" "destination_upload_sub" {
   }
 }
 
+resource "google_pubsub_subscription" "destination_archive_export_sub" {
+  name  = "sub-destination-archive-export"
+  topic = google_pubsub_topic.archive_export_requested.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["destination"].uri}/archive-export"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["destination"].email
+    }
+  }
+
+  ack_deadline_seconds = 600
+  retry_policy {
+    minimum_backoff = "60s"
+    maximum_backoff = "600s"
+  }
+}
+
+resource "google_pubsub_subscription" "destination_data_export_sub" {
+  name  = "sub-destination-data-export"
+  topic = google_pubsub_topic.data_export_requested.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["destination"].uri}/data-export"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["destination"].email
+    }
+  }
+
+  ack_deadline_seconds = 600
+  retry_policy {
+    minimum_backoff = "60s"
+    maximum_backoff = "600s"
+  }
+}
+
+resource "google_pubsub_subscription" "destination_user_deletion_sub" {
+  name  = "sub-destination-user-deletion"
+  topic = google_pubsub_topic.user_deletion_requested.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["destination"].uri}/user-deletion"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["destination"].email
+    }
+  }
+
+  ack_deadline_seconds = 600
+  retry_policy {
+    minimum_backoff = "60s"
+    maximum_backoff = "600s"
+  }
+}
+
+resource "google_pubsub_subscription" "destination_outage_check_sub" {
+  name  = "sub-destination-outage-check"
+  topic = google_pubsub_topic.outage_check_trigger.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["destination"].uri}/outage-check"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["destination"].email
+    }
+  }
+
+  # A missed tick is picked up by the next one
+  ack_deadline_seconds       = 300
+  message_retention_duration = "600s"
+}
+
+resource "google_pubsub_subscription" "destination_stuck_run_sweep_sub" {
+  name  = "sub-destination-stuck-run-sweep"
+  topic = google_pubsub_topic.stuck_run_sweep_trigger.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["destination"].uri}/stuck-runs"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["destination"].email
+    }
+  }
+
+  # A missed tick is picked up by the next one
+  ack_deadline_seconds       = 300
+  message_retention_duration = "600s"
+}
+
+resource "google_pubsub_subscription" "destination_scheduled_upload_sub" {
+  name  = "sub-destination-scheduled-uploads"
+  topic = google_pubsub_topic.scheduled_upload_trigger.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["destination"].uri}/scheduled-uploads"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["destination"].email
+    }
+  }
+
+  # A missed tick is picked up by the next one
+  ack_deadline_seconds       = 300
+  message_retention_duration = "600s"
+}
+
+resource "google_pubsub_subscription" "destination_weekly_digest_sub" {
+  name  = "sub-destination-weekly-digest"
+  topic = google_pubsub_topic.weekly_digest_trigger.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["destination"].uri}/weekly-digest"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["destination"].email
+    }
+  }
+
+  # Sending every digest can take a while; a retry would email everyone twice
+  ack_deadline_seconds       = 600
+  message_retention_duration = "600s"
+}
+
+resource "google_pubsub_subscription" "destination_showcase_comparison_sub" {
+  name  = "sub-destination-showcase-comparisons"
+  topic = google_pubsub_topic.showcase_comparison_refresh_trigger.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["destination"].uri}/showcase-comparisons"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["destination"].email
+    }
+  }
+
+  # Stale comparisons are still served, so a missed night waits for the next
+  ack_deadline_seconds       = 600
+  message_retention_duration = "600s"
+}
+
 resource "google_pubsub_subscription" "pipeline_raw_sub" {
   name  = "sub-pipeline-raw"
   topic = google_pubsub_topic.raw_activity.name
@@ -88,6 +367,12 @@ resource "google_pubsub_subscription" "pipeline_raw_sub" {
     minimum_backoff = "10s"
     maximum_backoff = "600s"
   }
+
+  # Keep in sync with redrive.MaxDeliveryAttempts
+  dead_letter_policy {
+    dead_letter_topic     = google_pubsub_topic.pipeline_dead_letter.id
+    max_delivery_attempts = 5
+  }
 }
 
 resource "google_pubsub_subscription" "pipeline_enriched_sub" {
@@ -106,6 +391,12 @@ resource "google_pubsub_subscription" "pipeline_enriched_sub" {
     minimum_backoff = "10s"
     maximum_backoff = "600s"
   }
+
+  # Keep in sync with redrive.MaxDeliveryAttempts
+  dead_letter_policy {
+    dead_letter_topic     = google_pubsub_topic.pipeline_dead_letter.id
+    max_delivery_attempts = 5
+  }
 }
 
 resource "google_pubsub_subscription" "pipeline_run_sub" {
@@ -124,4 +415,169 @@ resource "google_pubsub_subscription" "pipeline_run_sub" {
     minimum_backoff = "10s"
     maximum_backoff = "600s"
   }
+
+  # Keep in sync with redrive.MaxDeliveryAttempts
+  dead_letter_policy {
+    dead_letter_topic     = google_pubsub_topic.pipeline_dead_letter.id
+    max_delivery_attempts = 5
+  }
+}
+
+resource "google_pubsub_subscription" "backfill_requested_sub" {
+  name  = "sub-backfill-requested"
+  topic = google_pubsub_topic.backfill_requested.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["backfill"].uri}/"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["backfill"].email
+    }
+  }
+
+  ack_deadline_seconds = 600
+  retry_policy {
+    minimum_backoff = "10s"
+    maximum_backoff = "600s"
+  }
+}
+
+resource "google_pubsub_subscription" "backfill_import_sub" {
+  name  = "sub-backfill-import"
+  topic = google_pubsub_topic.import_requested.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["backfill"].uri}/import"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["backfill"].email
+    }
+  }
+
+  ack_deadline_seconds = 600
+  retry_policy {
+    minimum_backoff = "10s"
+    maximum_backoff = "600s"
+  }
+}
+
+resource "google_pubsub_subscription" "backfill_reconcile_sub" {
+  name  = "sub-backfill-reconcile"
+  topic = google_pubsub_topic.reconcile_trigger.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["backfill"].uri}/reconcile"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["backfill"].email
+    }
+  }
+
+  ack_deadline_seconds = 600
+  retry_policy {
+    minimum_backoff = "60s"
+    maximum_backoff = "600s"
+  }
+}
+
+resource "google_pubsub_subscription" "pipeline_recommendations_sub" {
+  name  = "sub-pipeline-recommendations"
+  topic = google_pubsub_topic.recommendations_trigger.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["pipeline"].uri}/pubsub/recommendations"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["pipeline"].email
+    }
+  }
+
+  ack_deadline_seconds = 600
+  retry_policy {
+    minimum_backoff = "60s"
+    maximum_backoff = "600s"
+  }
+}
+
+resource "google_pubsub_subscription" "pipeline_dead_letter_sub" {
+  name  = "sub-pipeline-dead-letter"
+  topic = google_pubsub_topic.pipeline_dead_letter.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["pipeline"].uri}/pubsub/dead-letter"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["pipeline"].email
+    }
+  }
+
+  ack_deadline_seconds       = 60
+  message_retention_duration = "86400s"
+  retry_policy {
+    minimum_backoff = "10s"
+    maximum_backoff = "600s"
+  }
+}
+
+resource "google_pubsub_subscription" "pipeline_failed_event_redrive_sub" {
+  name  = "sub-pipeline-failed-event-redrive"
+  topic = google_pubsub_topic.failed_event_redrive_trigger.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["pipeline"].uri}/pubsub/redrive"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["pipeline"].email
+    }
+  }
+
+  # A missed tick is picked up by the next one
+  ack_deadline_seconds       = 300
+  message_retention_duration = "600s"
+}
+
+resource "google_pubsub_subscription" "pipeline_enricher_retry_sub" {
+  name  = "sub-pipeline-enricher-retry"
+  topic = google_pubsub_topic.enricher_retry_trigger.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["pipeline"].uri}/pubsub/retry"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["pipeline"].email
+    }
+  }
+
+  # A missed tick is picked up by the next one
+  ack_deadline_seconds       = 300
+  message_retention_duration = "600s"
+}
+
+resource "google_pubsub_subscription" "pipeline_data_key_rotation_sub" {
+  name  = "sub-pipeline-data-key-rotation"
+  topic = google_pubsub_topic.data_key_rotation_trigger.name
+
+  push_config {
+    push_endpoint = "${google_cloud_run_v2_service.backend["pipeline"].uri}/pubsub/rotate-keys"
+    oidc_token {
+      service_account_email = google_service_account.cloud_run_sa["pipeline"].email
+    }
+  }
+
+  # Rotation is safe to repeat, so a failed run is retried until it completes
+  ack_deadline_seconds       = 600
+  message_retention_duration = "86400s"
+}
+
+# Pub/Sub forwards dead letters as its own service agent, which needs to
+# publish to the dead letter topic and acknowledge on the source subscriptions
+resource "google_pubsub_topic_iam_member" "pipeline_dead_letter_publisher" {
+  topic  = google_pubsub_topic.pipeline_dead_letter.name
+  role   = "roles/pubsub.publisher"
+  member = "serviceAccount:service-${data.google_project.project.number}@gcp-sa-pubsub.iam.gserviceaccount.com"
+}
+
+resource "google_pubsub_subscription_iam_member" "pipeline_dead_letter_subscriber" {
+  for_each = toset([
+    google_pubsub_subscription.pipeline_raw_sub.name,
+    google_pubsub_subscription.pipeline_enriched_sub.name,
+    google_pubsub_subscription.pipeline_run_sub.name,
+    google_pubsub_subscription.webhook_received_sub.name,
+  ])
+  subscription = each.value
+  role         = "roles/pubsub.subscriber"
+  member       = "serviceAccount:service-${data.google_project.project.number}@gcp-sa-pubsub.iam.gserviceaccount.com"
 }
@@ -0,0 +1,216 @@
+# =============================================================================
+# Cloud Scheduler - polling sources without push webhooks and periodic jobs
+# =============================================================================
+
+data "google_secret_manager_secret_version" "zwift_poll_token" {
+  secret     = google_secret_manager_secret.zwift_poll_token.id
+  depends_on = [google_secret_manager_secret_version.zwift_poll_token_initial]
+}
+
+data "google_secret_manager_secret_version" "strava_subscription_check_token" {
+  secret     = google_secret_manager_secret.strava_subscription_check_token.id
+  depends_on = [google_secret_manager_secret_version.strava_subscription_check_token_initial]
+}
+
+data "google_secret_manager_secret_version" "outage_check_token" {
+  secret     = google_secret_manager_secret.outage_check_token.id
+  depends_on = [google_secret_manager_secret_version.outage_check_token_initial]
+}
+
+# Zwift has no webhooks; poll the Companion API for new activities every 15 minutes
+resource "google_cloud_scheduler_job" "zwift_poll" {
+  name             = "zwift-poll"
+  region           = var.region
+  schedule         = "*/15 * * * *"
+  time_zone        = "Etc/UTC"
+  attempt_deadline = "600s"
+
+  retry_config {
+    retry_count = 0
+  }
+
+  http_target {
+    http_method = "POST"
+    uri         = "${google_cloud_run_v2_service.frontend["api-webhook"].uri}/api/webhooks/zwift/poll"
+    headers = {
+      "X-Poll-Token" = data.google_secret_manager_secret_version.zwift_poll_token.secret_data
+    }
+  }
+}
+
+# Recompute enricher recommendations for recently active users once a day
+resource "google_cloud_scheduler_job" "enricher_recommendations" {
+  name      = "enricher-recommendations"
+  region    = var.region
+  schedule  = "0 4 * * *"
+  time_zone = "Etc/UTC"
+
+  pubsub_target {
+    topic_name = google_pubsub_topic.recommendations_trigger.id
+    data       = base64encode("{}")
+  }
+}
+
+# Re-list each user's last couple of days on every backfillable source and
+# push through any activity whose webhook never arrived
+resource "google_cloud_scheduler_job" "missed_activity_reconcile" {
+  name      = "missed-activity-reconcile"
+  region    = var.region
+  schedule  = "0 3 * * *"
+  time_zone = "Etc/UTC"
+
+  pubsub_target {
+    topic_name = google_pubsub_topic.reconcile_trigger.id
+    data       = base64encode("{}")
+  }
+}
+
+# Strava silently drops its push subscription if the callback ever fails, so
+# check it daily and flag users whose activities stopped producing events
+resource "google_cloud_scheduler_job" "strava_subscription_check" {
+  name             = "strava-subscription-check"
+  region           = var.region
+  schedule         = "30 5 * * *"
+  time_zone        = "Etc/UTC"
+  attempt_deadline = "600s"
+
+  retry_config {
+    retry_count = 0
+  }
+
+  http_target {
+    http_method = "POST"
+    uri         = "${google_cloud_run_v2_service.frontend["api-webhook"].uri}/api/webhooks/strava/subscription-check"
+    headers = {
+      "X-Poll-Token" = data.google_secret_manager_secret_version.strava_subscription_check_token.secret_data
+    }
+  }
+}
+
+# Probe platforms the circuit breaker has marked as down and replay the work
+# queued behind them once they recover: uploads in service.destination (via
+# Pub/Sub) and webhook events in api-webhook
+resource "google_cloud_scheduler_job" "destination_outage_check" {
+  name      = "destination-outage-check"
+  region    = var.region
+  schedule  = "*/5 * * * *"
+  time_zone = "Etc/UTC"
+
+  pubsub_target {
+    topic_name = google_pubsub_topic.outage_check_trigger.id
+    data       = base64encode("{}")
+  }
+}
+
+# Redrive dead-lettered pipeline events whose backoff has elapsed
+resource "google_cloud_scheduler_job" "failed_event_redrive" {
+  name      = "failed-event-redrive"
+  region    = var.region
+  schedule  = "*/5 * * * *"
+  time_zone = "Etc/UTC"
+
+  pubsub_target {
+    topic_name = google_pubsub_topic.failed_event_redrive_trigger.id
+    data       = base64encode("{}")
+  }
+}
+
+# Settle pipeline runs stuck in RUNNING (lost status writes, uploads that
+# never finished)
+resource "google_cloud_scheduler_job" "stuck_run_sweep" {
+  name      = "stuck-run-sweep"
+  region    = var.region
+  schedule  = "15 * * * *"
+  time_zone = "Etc/UTC"
+
+  pubsub_target {
+    topic_name = google_pubsub_topic.stuck_run_sweep_trigger.id
+    data       = base64encode("{}")
+  }
+}
+
+# Release uploads held back by destination posting schedules once they are due
+resource "google_cloud_scheduler_job" "scheduled_upload_release" {
+  name      = "scheduled-upload-release"
+  region    = var.region
+  schedule  = "*/5 * * * *"
+  time_zone = "Etc/UTC"
+
+  pubsub_target {
+    topic_name = google_pubsub_topic.scheduled_upload_trigger.id
+    data       = base64encode("{}")
+  }
+}
+
+# Email opted-in users a digest of the week that just ended
+resource "google_cloud_scheduler_job" "weekly_digest" {
+  name      = "weekly-digest"
+  region    = var.region
+  schedule  = "0 8 * * 1"
+  time_zone = "Etc/UTC"
+
+  pubsub_target {
+    topic_name = google_pubsub_topic.weekly_digest_trigger.id
+    data       = base64encode("{}")
+  }
+}
+
+# Recompute stored showcase comparisons so the public compare page stays fast
+resource "google_cloud_scheduler_job" "showcase_comparison_refresh" {
+  name      = "showcase-comparison-refresh"
+  region    = var.region
+  schedule  = "30 2 * * *"
+  time_zone = "Etc/UTC"
+
+  pubsub_target {
+    topic_name = google_pubsub_topic.showcase_comparison_refresh_trigger.id
+    data       = base64encode("{}")
+  }
+}
+
+# Resume pipeline runs whose scheduled enricher retry is due
+resource "google_cloud_scheduler_job" "enricher_retry" {
+  name      = "enricher-retry"
+  region    = var.region
+  schedule  = "* * * * *"
+  time_zone = "Etc/UTC"
+
+  pubsub_target {
+    topic_name = google_pubsub_topic.enricher_retry_trigger.id
+    data       = base64encode("{}")
+  }
+}
+
+resource "google_cloud_scheduler_job" "source_outage_check" {
+  name             = "source-outage-check"
+  region           = var.region
+  schedule         = "*/5 * * * *"
+  time_zone        = "Etc/UTC"
+  attempt_deadline = "300s"
+
+  retry_config {
+    retry_count = 0
+  }
+
+  http_target {
+    http_method = "POST"
+    uri         = "${google_cloud_run_v2_service.frontend["api-webhook"].uri}/api/webhooks/outage-check"
+    headers = {
+      "X-Poll-Token" = data.google_secret_manager_secret_version.outage_check_token.secret_data
+    }
+  }
+}
+
+# Give every user's sensitive booster data a fresh data key, wrapped with the
+# current KMS key version, once a month
+resource "google_cloud_scheduler_job" "data_key_rotation" {
+  name      = "data-key-rotation"
+  region    = var.region
+  schedule  = "0 2 1 * *"
+  time_zone = "Etc/UTC"
+
+  pubsub_target {
+    topic_name = google_pubsub_topic.data_key_rotation_trigger.id
+    data       = base64encode("{}")
+  }
+}
@@ -164,6 +164,168 @@ resource "google_secret_manager_secret_version" "spotify_client_secret_initial"
   }
 }
 
+# =============================================================================
+# Komoot OAuth Credentials
+# =============================================================================
+resource "google_secret_manager_secret" "komoot_client_id" {
+  secret_id = "komoot-client-id"
+  replication {
+    auto {}
+  }
+}
+
+resource "google_secret_manager_secret_version" "komoot_client_id_initial" {
+  secret      = google_secret_manager_secret.komoot_client_id.id
+  secret_data = "PLACEHOLDER_REPLACE_ME"
+
+  lifecycle {
+    ignore_changes = [secret_data]
+  }
+}
+
+resource "google_secret_manager_secret" "komoot_client_secret" {
+  secret_id = "komoot-client-secret"
+  replication {
+    auto {}
+  }
+}
+
+resource "google_secret_manager_secret_version" "komoot_client_secret_initial" {
+  secret      = google_secret_manager_secret.komoot_client_secret.id
+  secret_data = "PLACEHOLDER_REPLACE_ME"
+
+  lifecycle {
+    ignore_changes = [secret_data]
+  }
+}
+
+# =============================================================================
+# Dropbox OAuth Credentials
+# =============================================================================
+resource "google_secret_manager_secret" "dropbox_client_id" {
+  secret_id = "dropbox-client-id"
+  replication {
+    auto {}
+  }
+}
+
+resource "google_secret_manager_secret_version" "dropbox_client_id_initial" {
+  secret      = google_secret_manager_secret.dropbox_client_id.id
+  secret_data = "PLACEHOLDER_REPLACE_ME"
+
+  lifecycle {
+    ignore_changes = [secret_data]
+  }
+}
+
+resource "google_secret_manager_secret" "dropbox_client_secret" {
+  secret_id = "dropbox-client-secret"
+  replication {
+    auto {}
+  }
+}
+
+resource "google_secret_manager_secret_version" "dropbox_client_secret_initial" {
+  secret      = google_secret_manager_secret.dropbox_client_secret.id
+  secret_data = "PLACEHOLDER_REPLACE_ME"
+
+  lifecycle {
+    ignore_changes = [secret_data]
+  }
+}
+
+# =============================================================================
+# Whoop OAuth Credentials
+# =============================================================================
+resource "google_secret_manager_secret" "whoop_client_id" {
+  secret_id = "whoop-client-id"
+  replication {
+    auto {}
+  }
+}
+
+resource "google_secret_manager_secret_version" "whoop_client_id_initial" {
+  secret      = google_secret_manager_secret.whoop_client_id.id
+  secret_data = "PLACEHOLDER_REPLACE_ME"
+
+  lifecycle {
+    ignore_changes = [secret_data]
+  }
+}
+
+resource "google_secret_manager_secret" "whoop_client_secret" {
+  secret_id = "whoop-client-secret"
+  replication {
+    auto {}
+  }
+}
+
+resource "google_secret_manager_secret_version" "whoop_client_secret_initial" {
+  secret      = google_secret_manager_secret.whoop_client_secret.id
+  secret_data = "PLACEHOLDER_REPLACE_ME"
+
+  lifecycle {
+    ignore_changes = [secret_data]
+  }
+}
+
+# =============================================================================
+# Zwift Poll Trigger Token (shared between Cloud Scheduler and api-webhook)
+# =============================================================================
+resource "google_secret_manager_secret" "zwift_poll_token" {
+  secret_id = "zwift-poll-token"
+  replication {
+    auto {}
+  }
+}
+
+resource "google_secret_manager_secret_version" "zwift_poll_token_initial" {
+  secret      = google_secret_manager_secret.zwift_poll_token.id
+  secret_data = "PLACEHOLDER_REPLACE_ME"
+
+  lifecycle {
+    ignore_changes = [secret_data]
+  }
+}
+
+# =============================================================================
+# Strava Subscription Check Token (shared between Cloud Scheduler and api-webhook)
+# =============================================================================
+resource "google_secret_manager_secret" "strava_subscription_check_token" {
+  secret_id = "strava-subscription-check-token"
+  replication {
+    auto {}
+  }
+}
+
+resource "google_secret_manager_secret_version" "strava_subscription_check_token_initial" {
+  secret      = google_secret_manager_secret.strava_subscription_check_token.id
+  secret_data = "PLACEHOLDER_REPLACE_ME"
+
+  lifecycle {
+    ignore_changes = [secret_data]
+  }
+}
+
+# =============================================================================
+# Outage Check Token (shared between Cloud Scheduler and api-webhook)
+# =============================================================================
+resource "google_secret_manager_secret" "outage_check_token" {
+  secret_id = "outage-check-token"
+  replication {
+    auto {}
+  }
+}
+
+resource "google_secret_manager_secret_version" "outage_check_token_initial" {
+  secret      = google_secret_manager_secret.outage_check_token.id
+  secret_data = "PLACEHOLDER_REPLACE_ME"
+
+  lifecycle {
+    ignore_changes = [secret_data]
+  }
+}
+
 # =============================================================================
 # TrainingPeaks OAuth Credentials
 # =============================================================================
@@ -460,6 +622,25 @@ resource "google_secret_manager_secret_version" "github_webhook_secret_initial"
   }
 }
 
+# =============================================================================
+# Showcase Embed Secret (signs embed links to hidden showcase profiles)
+# =============================================================================
+resource "google_secret_manager_secret" "showcase_embed_secret" {
+  secret_id = "showcase-embed-secret"
+  replication {
+    auto {}
+  }
+}
+
+resource "google_secret_manager_secret_version" "showcase_embed_secret_initial" {
+  secret      = google_secret_manager_secret.showcase_embed_secret.id
+  secret_data = "PLACEHOLDER_REPLACE_ME"
+
+  lifecycle {
+    ignore_changes = [secret_data]
+  }
+}
+
 # Note: To update a secret value after initial creation, use:
 # gcloud secrets versions add <secret-id> --data-file=- <<< "your-actual-secret-value"
 
@@ -54,3 +54,9 @@ variable "image_tag" {
   type        = string
   default     = "latest"
 }
+
+variable "min_instances" {
+  description = "Cloud Run instances kept warm per service, for latency-critical paths. Services not listed scale to zero."
+  type        = map(number)
+  default     = {}
+}